@@ -0,0 +1,84 @@
+package main
+
+import (
+	"crypto/tls"
+	"net"
+	"net/http"
+	"strconv"
+
+	"github.com/hrz8/altalune"
+	"github.com/hrz8/altalune/server/httpserver"
+	"golang.org/x/crypto/acme"
+	"golang.org/x/crypto/acme/autocert"
+)
+
+// newACMEManager returns the manager obtaining and renewing certificates for
+// the configured domains, or nil when ACME is disabled. Challenges are
+// answered on the HTTPS port (TLS-ALPN-01) and, when acme.httpPort is set, on
+// the HTTP port (HTTP-01).
+func newACMEManager(cfg altalune.Config) *autocert.Manager {
+	if !cfg.IsACMEEnabled() {
+		return nil
+	}
+
+	m := &autocert.Manager{
+		Prompt:     autocert.AcceptTOS,
+		HostPolicy: autocert.HostWhitelist(cfg.GetACMEDomains()...),
+		Cache:      autocert.DirCache(cfg.GetACMECacheDir()),
+		Email:      cfg.GetACMEEmail(),
+	}
+	if url := cfg.GetACMEDirectoryURL(); url != "" {
+		m.Client = &acme.Client{DirectoryURL: url}
+	}
+	return m
+}
+
+// acmeTLSConfig returns the TLS configuration serving m's certificates, or nil
+// when m is nil.
+func acmeTLSConfig(m *autocert.Manager) *tls.Config {
+	if m == nil {
+		return nil
+	}
+	return m.TLSConfig()
+}
+
+// newHTTPSRedirectServer returns the plain HTTP server answering m's HTTP-01
+// challenges and redirecting every other request to the HTTPS server of its
+// hostname, or nil when ACME or its HTTP port is disabled. httpsPorts maps
+// hostnames to the port of their HTTPS server; the "" entry applies to the
+// other hostnames.
+func newHTTPSRedirectServer(cfg altalune.Config, m *autocert.Manager, bindHost string, httpsPorts map[string]int) *httpserver.Server {
+	if m == nil || cfg.GetACMEHTTPPort() == 0 {
+		return nil
+	}
+
+	return httpserver.NewHTTPServer(
+		httpserver.WithHandler(m.HTTPHandler(httpsRedirectHandler(httpsPorts))),
+		httpserver.WithHost(bindHost),
+		httpserver.WithPort(cfg.GetACMEHTTPPort()),
+		httpserver.WithReadTimeout(cfg.GetServerReadTimeout()),
+		httpserver.WithReadHeaderTimeout(cfg.GetServerReadHeaderTimeout()),
+		httpserver.WithWriteTimeout(cfg.GetServerWriteTimeout()),
+		httpserver.WithIdleTimeout(cfg.GetServerIdleTimeout()),
+		httpserver.WithCleanupTimeout(cfg.GetServerCleanupTimeout()),
+	)
+}
+
+// httpsRedirectHandler redirects every request to the HTTPS server of its
+// hostname, as mapped by httpsPorts.
+func httpsRedirectHandler(httpsPorts map[string]int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host, _, err := net.SplitHostPort(r.Host)
+		if err != nil {
+			host = r.Host
+		}
+		httpsPort, ok := httpsPorts[host]
+		if !ok {
+			httpsPort = httpsPorts[""]
+		}
+		if httpsPort != 443 {
+			host = net.JoinHostPort(host, strconv.Itoa(httpsPort))
+		}
+		http.Redirect(w, r, "https://"+host+r.URL.RequestURI(), http.StatusMovedPermanently)
+	})
+}
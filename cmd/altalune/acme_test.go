@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/hrz8/altalune"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/acme"
+)
+
+// acmeConfig holds the ACME configuration, the other getters are never
+// called
+type acmeConfig struct {
+	altalune.Config
+	enabled  bool
+	httpPort int
+}
+
+func (c *acmeConfig) IsACMEEnabled() bool         { return c.enabled }
+func (c *acmeConfig) GetACMEDomains() []string    { return []string{"api.example.com"} }
+func (c *acmeConfig) GetACMEEmail() string        { return "ops@example.com" }
+func (c *acmeConfig) GetACMECacheDir() string     { return ".acme" }
+func (c *acmeConfig) GetACMEDirectoryURL() string { return acme.LetsEncryptURL }
+func (c *acmeConfig) GetACMEHTTPPort() int        { return c.httpPort }
+
+func TestNewACMEManager(t *testing.T) {
+	assert.Nil(t, newACMEManager(&acmeConfig{}))
+	assert.Nil(t, acmeTLSConfig(nil), "no TLS configuration without ACME")
+	assert.Nil(t, newHTTPSRedirectServer(&acmeConfig{httpPort: 80}, nil, "", nil))
+
+	m := newACMEManager(&acmeConfig{enabled: true})
+	require.NotNil(t, m)
+	assert.Equal(t, "ops@example.com", m.Email)
+	assert.Equal(t, acme.LetsEncryptURL, m.Client.DirectoryURL)
+	assert.NoError(t, m.HostPolicy(context.Background(), "api.example.com"))
+	assert.Error(t, m.HostPolicy(context.Background(), "evil.example.com"), "only the configured domains get certificates")
+
+	tlsConfig := acmeTLSConfig(m)
+	require.NotNil(t, tlsConfig)
+	assert.True(t, slices.Contains(tlsConfig.NextProtos, acme.ALPNProto), "TLS-ALPN-01 challenges are answered on the HTTPS port")
+
+	assert.Nil(t, newHTTPSRedirectServer(&acmeConfig{enabled: true}, m, "", nil), "port 0 disables HTTP-01")
+}
+
+func TestHTTPSRedirectHandler(t *testing.T) {
+	handler := httpsRedirectHandler(map[string]int{"": 443, "auth.example.com": 3443})
+
+	tests := []struct {
+		host string
+		want string
+	}{
+		{host: "api.example.com", want: "https://api.example.com/path?q=1"},
+		{host: "api.example.com:80", want: "https://api.example.com/path?q=1"},
+		{host: "auth.example.com:80", want: "https://auth.example.com:3443/path?q=1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.host, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/path?q=1", nil)
+			req.Host = tt.host
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, http.StatusMovedPermanently, rec.Code)
+			assert.Equal(t, tt.want, rec.Header().Get("Location"))
+		})
+	}
+}
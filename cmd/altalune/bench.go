@@ -0,0 +1,287 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hrz8/altalune"
+	"github.com/hrz8/altalune/internal/domain/oauth_auth"
+	"github.com/hrz8/altalune/internal/postgres"
+	"github.com/hrz8/altalune/internal/shared/loadgen"
+	"github.com/hrz8/altalune/internal/shared/pkce"
+	"github.com/spf13/cobra"
+)
+
+const (
+	grantClientCredentials = "client_credentials"
+	grantAuthorizationCode = "authorization_code"
+
+	// benchCodeVerifier is the PKCE verifier of the seeded authorization codes,
+	// which public clients must send
+	benchCodeVerifier = "altalune-bench-code-verifier-0123456789abcdef"
+)
+
+func NewBenchCommand(rootCmd *cobra.Command) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bench",
+		Short: "Load-test the servers",
+		Long:  "Load-test the servers to plan their capacity",
+	}
+
+	cmd.AddCommand(
+		newBenchTokenCommand(rootCmd),
+	)
+
+	return cmd
+}
+
+func newBenchTokenCommand(rootCmd *cobra.Command) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token",
+		Short: "Load-test the OAuth token endpoint",
+		Long: `Load-test the token endpoint of a running auth server and report latency
+percentiles and error rates, to tune the Argon2id parameters and the
+database pool size.
+
+With --grant client_credentials (default) every request authenticates the
+client, verifying its secret with Argon2id. The server has no client
+credentials grant, so the expected answer is unsupported_grant_type.
+
+With --grant authorization_code, authorization codes of the user are seeded
+in the database up front and exchanged for tokens, so requests also issue an
+access token and store a refresh token. The exchanged codes and the refresh
+tokens are left in the database: do not run it against production.`,
+		RunE: benchToken(rootCmd),
+	}
+
+	cmd.Flags().Int("clients", 50, "Concurrent clients")
+	cmd.Flags().Int("rps", 500, "Requests started per second")
+	cmd.Flags().Duration("duration", 30*time.Second, "How long requests are started")
+	cmd.Flags().String("grant", grantClientCredentials, "Grant to request: client_credentials or authorization_code")
+	cmd.Flags().String("url", "", "Auth server URL (default: the configured JWT issuer)")
+	cmd.Flags().String("client-id", "", "OAuth client ID (default: the configured default client)")
+	cmd.Flags().String("client-secret", "", "OAuth client secret (default: the configured default client secret)")
+	cmd.Flags().String("user-email", "", "User the authorization codes are seeded for (default: the superadmin)")
+
+	return cmd
+}
+
+func benchToken(rootCmd *cobra.Command) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		cfg, err := loadConfig(rootCmd)
+		if err != nil {
+			return fmt.Errorf("load config: %w", err)
+		}
+
+		flags := cmd.Flags()
+		clients, _ := flags.GetInt("clients")
+		rps, _ := flags.GetInt("rps")
+		duration, _ := flags.GetDuration("duration")
+		grant, _ := flags.GetString("grant")
+		if clients < 1 || rps < 1 || duration <= 0 {
+			return errors.New("--clients, --rps and --duration must be positive")
+		}
+
+		serverURL := stringFlagOr(cmd, "url", cfg.GetJWTIssuer())
+		clientID := stringFlagOr(cmd, "client-id", cfg.GetDefaultOAuthClientID())
+		clientSecret := stringFlagOr(cmd, "client-secret", cfg.GetDefaultOAuthClientSecret())
+		tokenURL := strings.TrimSuffix(serverURL, "/") + "/oauth/token"
+
+		var form func() (url.Values, error)
+		switch grant {
+		case grantClientCredentials:
+			form = func() (url.Values, error) {
+				return url.Values{"grant_type": {grantClientCredentials}}, nil
+			}
+		case grantAuthorizationCode:
+			userEmail := stringFlagOr(cmd, "user-email", cfg.GetSuperadminEmail())
+			codes, redirectURI, err := seedAuthorizationCodes(ctx, cfg, clientID, userEmail, rps*int(duration.Seconds()+1), duration)
+			if err != nil {
+				return err
+			}
+			form = func() (url.Values, error) {
+				select {
+				case code := <-codes:
+					return url.Values{
+						"grant_type":    {grantAuthorizationCode},
+						"code":          {code},
+						"redirect_uri":  {redirectURI},
+						"code_verifier": {benchCodeVerifier},
+					}, nil
+				default:
+					return nil, errors.New("out of seeded codes")
+				}
+			}
+		default:
+			return fmt.Errorf("unknown grant %q", grant)
+		}
+
+		httpClient := &http.Client{
+			Timeout:   10 * time.Second,
+			Transport: &http.Transport{MaxIdleConnsPerHost: clients},
+		}
+		op := func(ctx context.Context) error {
+			values, err := form()
+			if err != nil {
+				return err
+			}
+			return requestToken(ctx, httpClient, tokenURL, clientID, clientSecret, values)
+		}
+
+		log.Printf("Benchmarking %s with %s grant: %d clients, %d requests/s for %s", tokenURL, grant, clients, rps, duration)
+		report := loadgen.Run(ctx, loadgen.Options{Workers: clients, Rate: rps, Duration: duration}, op)
+		printBenchReport(os.Stdout, report)
+
+		return nil
+	}
+}
+
+// stringFlagOr returns the value of the flag, or fallback when it is empty
+func stringFlagOr(cmd *cobra.Command, name, fallback string) string {
+	if value, _ := cmd.Flags().GetString(name); value != "" {
+		return value
+	}
+	return fallback
+}
+
+// seedAuthorizationCodes creates n authorization codes of the user with email
+// for the client, valid for the duration of the benchmark. It returns them
+// with the redirect URI they were issued for.
+func seedAuthorizationCodes(ctx context.Context, cfg altalune.Config, clientID, email string, n int, duration time.Duration) (<-chan string, string, error) {
+	clientUUID, err := uuid.Parse(clientID)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid client ID: %w", err)
+	}
+
+	db := postgres.MustConnect(postgres.ConnectionOptions{
+		URL:            cfg.GetDatabaseURL(),
+		MaxConnections: cfg.GetDatabaseMaxConnections(),
+		MaxIdleTime:    cfg.GetDatabaseMaxIdleTime(),
+		ConnectTimeout: cfg.GetDatabaseConnectTimeout(),
+	})
+	defer db.Close()
+
+	repo := oauth_auth.NewRepo(db)
+	client, err := repo.GetOAuthClientByClientID(ctx, clientUUID)
+	if err != nil {
+		return nil, "", fmt.Errorf("get client: %w", err)
+	}
+	if len(client.RedirectURIs) == 0 {
+		return nil, "", fmt.Errorf("client %s has no redirect URI", clientID)
+	}
+	user, err := oauth_auth.NewUserRepo(db).GetUserByEmail(ctx, email)
+	if err != nil {
+		return nil, "", fmt.Errorf("get user %s: %w", email, err)
+	}
+
+	log.Printf("Seeding %d authorization codes for %s", n, email)
+	codes := make(chan string, n)
+	expiresAt := time.Now().Add(duration + 5*time.Minute)
+	challenge, method := pkce.GenerateCodeChallenge(benchCodeVerifier, pkce.MethodS256), pkce.MethodS256
+	for range n {
+		code, err := repo.CreateAuthorizationCode(ctx, &oauth_auth.CreateAuthCodeInput{
+			ClientID:            clientUUID,
+			UserID:              user.ID,
+			RedirectURI:         client.RedirectURIs[0],
+			Scope:               "openid profile email",
+			CodeChallenge:       &challenge,
+			CodeChallengeMethod: &method,
+			ExpiresAt:           expiresAt,
+		})
+		if err != nil {
+			return nil, "", fmt.Errorf("seed authorization code: %w", err)
+		}
+		codes <- code.Code.String()
+	}
+
+	return codes, client.RedirectURIs[0], nil
+}
+
+// requestToken posts a token request and returns an error named after the
+// status code and OAuth error of unexpected answers. For the client
+// credentials grant, unsupported_grant_type is the expected answer.
+func requestToken(ctx context.Context, client *http.Client, tokenURL, clientID, clientSecret string, form url.Values) error {
+	// Public clients identify themselves in the body
+	if clientSecret == "" {
+		form.Set("client_id", clientID)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if clientSecret != "" {
+		req.SetBasicAuth(clientID, clientSecret)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		// Transport errors carry addresses, count them by kind only
+		var netErr net.Error
+		switch {
+		case errors.Is(err, context.Canceled):
+			return context.Canceled
+		case errors.As(err, &netErr) && netErr.Timeout():
+			return errors.New("timeout")
+		default:
+			return errors.New("transport error")
+		}
+	}
+	defer resp.Body.Close()
+
+	var body struct {
+		Error string `json:"error"`
+	}
+	_ = json.NewDecoder(resp.Body).Decode(&body)
+
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		return nil
+	case form.Get("grant_type") == grantClientCredentials && body.Error == "unsupported_grant_type":
+		return nil
+	default:
+		return fmt.Errorf("%d %s", resp.StatusCode, body.Error)
+	}
+}
+
+func printBenchReport(w io.Writer, r *loadgen.Report) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	defer tw.Flush()
+
+	errorRate := 0.0
+	if r.Calls > 0 {
+		errorRate = 100 * float64(r.Errors()) / float64(r.Calls)
+	}
+
+	fmt.Fprintf(tw, "requests\t%d (%.1f/s over %s)\n", r.Calls, r.Throughput(), r.Elapsed.Round(time.Millisecond))
+	fmt.Fprintf(tw, "errors\t%d (%.2f%%)\n", r.Errors(), errorRate)
+
+	failures := make([]string, 0, len(r.Failures))
+	for failure := range r.Failures {
+		failures = append(failures, failure)
+	}
+	sort.Strings(failures)
+	for _, failure := range failures {
+		fmt.Fprintf(tw, "  %s\t%d\n", failure, r.Failures[failure])
+	}
+
+	fmt.Fprintf(tw, "dropped\t%d (every client busy)\n", r.Dropped)
+	for _, p := range []float64{50, 90, 95, 99, 100} {
+		fmt.Fprintf(tw, "p%g\t%s\n", p, r.Percentile(p).Round(time.Microsecond))
+	}
+}
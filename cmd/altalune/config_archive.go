@@ -0,0 +1,160 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/hrz8/altalune/internal/container"
+	"github.com/hrz8/altalune/internal/domain/config_archive"
+	"github.com/hrz8/altalune/internal/shared/crypto"
+	"github.com/spf13/cobra"
+)
+
+// archiveKeyEnv is read when --key is not given, so the key stays out of the
+// shell history
+const archiveKeyEnv = "ALTALUNE_ARCHIVE_KEY"
+
+func NewExportConfigCommand(rootCmd *cobra.Command) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-config",
+		Short: "Export the OAuth infrastructure configuration to an encrypted archive",
+		Long: `Export the identity providers, the OAuth clients and the projects with their
+settings, hostnames and branding to an archive encrypted with AES-256-GCM, to
+restore them with import-config on this or another instance.
+
+The archive key is a base64-encoded 32-byte key (openssl rand -base64 32),
+given with --key or $ALTALUNE_ARCHIVE_KEY. Keep it apart from the archive: it
+decrypts the provider secrets. Client secrets are archived as hashes only.`,
+		RunE: exportConfig(rootCmd),
+	}
+
+	cmd.Flags().StringP("output", "o", "", "File to write the archive to")
+	cmd.Flags().String("key", "", "Archive key (default: $ALTALUNE_ARCHIVE_KEY)")
+	_ = cmd.MarkFlagRequired("output")
+
+	return cmd
+}
+
+func NewImportConfigCommand(rootCmd *cobra.Command) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-config",
+		Short: "Restore the OAuth infrastructure configuration from an archive",
+		Long: `Restore an archive written by export-config. Providers are matched by type,
+clients by client ID, projects by public ID and hostnames by hostname: matches
+are overwritten, the rest is created, and configuration missing from the
+archive is kept. The archive is restored in a single transaction.
+
+Provider secrets are encrypted again with the IAM encryption key of this
+instance. Run migrate up first so the instance has the schema of the archive.`,
+		RunE: importConfig(rootCmd),
+	}
+
+	cmd.Flags().StringP("file", "f", "", "Archive file to restore")
+	cmd.Flags().String("key", "", "Archive key (default: $ALTALUNE_ARCHIVE_KEY)")
+	_ = cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func exportConfig(rootCmd *cobra.Command) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		key, err := archiveKey(cmd)
+		if err != nil {
+			return err
+		}
+
+		c, archiver, err := archiveContainer(cmd, rootCmd)
+		if err != nil {
+			return err
+		}
+		defer c.Shutdown()
+
+		archive, err := archiver.Export(ctx, key)
+		if err != nil {
+			return fmt.Errorf("failed to export config: %w", err)
+		}
+		sealed, err := config_archive.Seal(archive, key)
+		if err != nil {
+			return fmt.Errorf("failed to seal archive: %w", err)
+		}
+
+		output, _ := cmd.Flags().GetString("output")
+		if err := os.WriteFile(output, sealed, 0o600); err != nil {
+			return fmt.Errorf("failed to write archive: %w", err)
+		}
+		log.Printf("Exported %d providers, %d clients and %d projects to %s",
+			len(archive.Providers), len(archive.Clients), len(archive.Projects), output)
+		return nil
+	}
+}
+
+func importConfig(rootCmd *cobra.Command) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		key, err := archiveKey(cmd)
+		if err != nil {
+			return err
+		}
+
+		file, _ := cmd.Flags().GetString("file")
+		sealed, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("failed to read archive: %w", err)
+		}
+		archive, err := config_archive.Open(sealed, key)
+		if err != nil {
+			return fmt.Errorf("failed to open archive: %w", err)
+		}
+
+		c, archiver, err := archiveContainer(cmd, rootCmd)
+		if err != nil {
+			return err
+		}
+		defer c.Shutdown()
+
+		result, err := archiver.Import(ctx, archive, key)
+		if err != nil {
+			return fmt.Errorf("failed to import config: %w", err)
+		}
+		log.Printf("Restored %d providers, %d clients and %d projects exported at %s",
+			result.Providers, result.Clients, result.Projects, archive.ExportedAt.Format("2006-01-02 15:04:05 MST"))
+		for _, note := range result.Notes {
+			log.Printf("  %s", note)
+		}
+		return nil
+	}
+}
+
+func archiveKey(cmd *cobra.Command) ([]byte, error) {
+	encoded, _ := cmd.Flags().GetString("key")
+	if encoded == "" {
+		encoded = os.Getenv(archiveKeyEnv)
+	}
+	return config_archive.ParseKey(encoded)
+}
+
+func archiveContainer(cmd *cobra.Command, rootCmd *cobra.Command) (*container.Container, *config_archive.Archiver, error) {
+	cfg, err := loadConfig(rootCmd)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load config: %w", err)
+	}
+
+	keyring, err := crypto.NewKeyring(cfg.GetIAMEncryptionKey(), cfg.GetIAMPreviousEncryptionKeys()...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load iam encryption keys: %w", err)
+	}
+
+	c, err := container.CreateContainer(cmd.Context(), cfg)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create application container: %w", err)
+	}
+	if !c.IsHealthy(cmd.Context()) {
+		c.Shutdown()
+		return nil, nil, fmt.Errorf("container is not healthy, cannot access the configuration")
+	}
+	return c, config_archive.NewArchiver(c.GetDB(), keyring), nil
+}
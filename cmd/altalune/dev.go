@@ -0,0 +1,189 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/hrz8/altalune/internal/container"
+	"github.com/hrz8/altalune/internal/server"
+	"github.com/hrz8/altalune/server/grpcserver"
+	"github.com/hrz8/altalune/server/httpserver"
+	"github.com/spf13/cobra"
+)
+
+func NewDevCommand(rootCmd *cobra.Command) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dev",
+		Short: "Start everything for local development",
+		Long: "Apply migrations, seed the database, and start the API, gRPC and OAuth authorization servers " +
+			"in one process, proxying the dashboard to the Nuxt dev server (cd frontend && pnpm dev)",
+		RunE: dev(rootCmd),
+	}
+
+	cmd.Flags().String("frontend-url", "http://localhost:8180", "Nuxt dev server the dashboard is proxied to")
+	cmd.Flags().Bool("skip-seed", false, "Skip database seeding after migrations")
+
+	return cmd
+}
+
+func dev(rootCmd *cobra.Command) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		frontendURL, _ := cmd.Flags().GetString("frontend-url")
+		frontendTarget, err := url.Parse(frontendURL)
+		if err != nil || frontendTarget.Host == "" {
+			return fmt.Errorf("invalid --frontend-url %q", frontendURL)
+		}
+
+		// Get and load configuration
+		cfg, err := loadConfig(rootCmd)
+		if err != nil {
+			return fmt.Errorf("error loading configuration file: %w", err)
+		}
+		if err := validateEncryptionKey(cfg); err != nil {
+			return err
+		}
+		if err := validateAuthConfig(cfg); err != nil {
+			return err
+		}
+
+		// Bootstrapping
+		c, err := container.CreateContainer(ctx, cfg)
+		if err != nil {
+			return fmt.Errorf("failed to create application container: %w", err)
+		}
+		if !c.IsHealthy(ctx) {
+			return fmt.Errorf("container is not healthy")
+		}
+
+		// Bring the database up to date
+		if err := c.GetMigrationService().MigrateUp(ctx); err != nil {
+			return fmt.Errorf("migration failed: %w", err)
+		}
+		if skipSeed, _ := cmd.Flags().GetBool("skip-seed"); !skipSeed {
+			if err := seedDatabase(ctx, c, cfg); err != nil {
+				return err
+			}
+		}
+		if err := checkProviderSecrets(ctx, c.GetOAuthProviderRepo()); err != nil {
+			return err
+		}
+		reencryptSecrets(ctx, c)
+
+		httpHandler, grpcServer := server.NewServer(c, server.WithFrontendProxy(frontendTarget)).Bootstrap()
+
+		httpSrv := httpserver.NewHTTPServer(
+			httpserver.WithHandler(httpHandler),
+			httpserver.WithHost(cfg.GetServerBindHost()),
+			httpserver.WithPort(cfg.GetServerPort()),
+			httpserver.WithReadTimeout(cfg.GetServerReadTimeout()),
+			httpserver.WithReadHeaderTimeout(cfg.GetServerReadHeaderTimeout()),
+			httpserver.WithWriteTimeout(cfg.GetServerWriteTimeout()),
+			httpserver.WithIdleTimeout(cfg.GetServerIdleTimeout()),
+			httpserver.WithCleanupTimeout(cfg.GetServerCleanupTimeout()),
+		)
+		grpcSrv := grpcserver.NewGRPCServer(
+			grpcserver.WithHandler(grpcServer),
+			grpcserver.WithHost(cfg.GetServerBindHost()),
+			grpcserver.WithPort(cfg.GetServerPort()+1),
+			grpcserver.WithCleanupTimeout(cfg.GetServerCleanupTimeout()),
+		)
+		authSrv, err := newAuthHTTPServer(cfg, c, nil)
+		if err != nil {
+			return err
+		}
+
+		janitor := c.GetTrashJanitor()
+		janitor.Start(ctx)
+		sched := c.GetScheduler()
+		sched.Start(ctx)
+		exportWorker := c.GetQueryExportWorker()
+		exportWorker.Start(ctx)
+		meter := c.GetUsageMeter()
+		if cfg.IsUsageMeteringEnabled() {
+			meter.Start(ctx)
+		}
+
+		httpSrv.Start()
+		grpcSrv.Start()
+		authSrv.Start()
+
+		log.Println("🚀 altalune is running in dev mode")
+		log.Printf("   Dashboard:   http://%s:%d (proxied to %s)\n", cfg.GetServerHost(), cfg.GetServerPort(), frontendTarget)
+		log.Printf("   API:         http://%s:%d/api\n", cfg.GetServerHost(), cfg.GetServerPort())
+		log.Printf("   gRPC:        %s:%d\n", cfg.GetServerHost(), cfg.GetServerPort()+1)
+		log.Printf("   Auth server: http://%s:%d\n", cfg.GetAuthHost(), cfg.GetAuthPort())
+		log.Printf("   Sign in as:  %s\n", cfg.GetSuperadminEmail())
+
+		defer cleanup(cfg,
+			func() error {
+				if err := httpSrv.Stop(); err != nil {
+					log.Printf("failed shutdown HTTP server: %v\n", err)
+					return err
+				}
+				return nil
+			},
+			func() error {
+				if err := grpcSrv.Stop(); err != nil {
+					log.Printf("failed shutdown gRPC server: %v\n", err)
+					return err
+				}
+				return nil
+			},
+			func() error {
+				if err := authSrv.Stop(); err != nil {
+					log.Printf("failed shutdown auth server: %v\n", err)
+					return err
+				}
+				return nil
+			},
+			func() error {
+				janitor.Stop()
+				return nil
+			},
+			func() error {
+				sched.Stop()
+				return nil
+			},
+			func() error {
+				exportWorker.Stop()
+				return nil
+			},
+			func() error {
+				meter.Stop()
+				return nil
+			},
+			func() error {
+				if err := c.Shutdown(); err != nil {
+					log.Printf("failed to shutdown application container: %v\n", err)
+					return err
+				}
+				return nil
+			},
+		)
+
+		select {
+		case <-ctx.Done():
+			time.Sleep(100 * time.Millisecond)
+			log.Println("🍀 performing graceful shutdown...")
+		case err := <-httpSrv.Notify():
+			if err != nil && err != http.ErrServerClosed {
+				return fmt.Errorf("HTTP server listen error: %w", err)
+			}
+		case err := <-grpcSrv.Notify():
+			if err != nil {
+				return fmt.Errorf("gRPC server listen error: %w", err)
+			}
+		case err := <-authSrv.Notify():
+			if err != nil && err != http.ErrServerClosed {
+				return fmt.Errorf("auth server listen error: %w", err)
+			}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDevInvalidFrontendURL(t *testing.T) {
+	for _, frontendURL := range []string{"localhost:8180", "://nope", ""} {
+		t.Run(frontendURL, func(t *testing.T) {
+			root := &cobra.Command{Use: "altalune"}
+			registerFlags(root)
+			root.AddCommand(NewDevCommand(root))
+			root.SetArgs([]string{"dev", "--config", "missing.yaml", "--frontend-url", frontendURL})
+			root.SilenceUsage = true
+			root.SilenceErrors = true
+
+			// Checked before anything is loaded or started
+			assert.EqualError(t, root.Execute(), `invalid --frontend-url "`+frontendURL+`"`)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hrz8/altalune/internal/container"
+	"github.com/spf13/cobra"
+)
+
+func NewDoctorCommand(rootCmd *cobra.Command) *cobra.Command {
+	return &cobra.Command{
+		Use:   "doctor",
+		Short: "Check the configuration and the database before starting",
+		Long: `Run the checks serve runs on startup and report each of them: the
+configuration, the IAM encryption keys, the database connection and whether
+the IAM encryption keys decrypt the client secrets of the OAuth providers.`,
+		RunE: doctor(rootCmd),
+	}
+}
+
+func doctor(rootCmd *cobra.Command) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		cfg, err := loadConfig(rootCmd)
+		if !doctorCheck("configuration", err) {
+			return fmt.Errorf("doctor found problems")
+		}
+
+		ok := doctorCheck("IAM encryption keys", validateEncryptionKey(cfg))
+		if cfg.IsAuthEmbedded() {
+			ok = doctorCheck("auth configuration", validateAuthConfig(cfg)) && ok
+		}
+		if !ok {
+			return fmt.Errorf("doctor found problems")
+		}
+
+		c, err := container.CreateContainer(ctx, cfg)
+		if !doctorCheck("application container", err) {
+			return fmt.Errorf("doctor found problems")
+		}
+		defer c.Shutdown()
+		if !c.IsHealthy(ctx) {
+			doctorCheck("database", fmt.Errorf("database is not reachable"))
+			return fmt.Errorf("doctor found problems")
+		}
+		doctorCheck("database", nil)
+
+		if !doctorCheck("OAuth provider client secrets", checkProviderSecrets(ctx, c.GetOAuthProviderRepo())) {
+			return fmt.Errorf("doctor found problems")
+		}
+		return nil
+	}
+}
+
+// doctorCheck reports the result of a check and whether it passed
+func doctorCheck(name string, err error) bool {
+	if err != nil {
+		log.Printf("❌ %s: %v\n", name, err)
+		return false
+	}
+	log.Printf("✅ %s\n", name)
+	return true
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	"github.com/hrz8/altalune/internal/container"
+	"github.com/spf13/cobra"
+)
+
+func NewIAMCommand(rootCmd *cobra.Command) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "iam",
+		Short: "Export and apply project IAM snapshots",
+		Long: `Export the IAM configuration of a project (members, their roles and direct
+permissions, and the permissions of those roles) as a YAML snapshot, and
+reconcile a project to a snapshot`,
+	}
+
+	cmd.PersistentFlags().String("project", "", "Public ID of the project")
+	_ = cmd.MarkPersistentFlagRequired("project")
+
+	cmd.AddCommand(
+		newIAMDumpCommand(rootCmd),
+		newIAMApplyCommand(rootCmd),
+	)
+
+	return cmd
+}
+
+func newIAMDumpCommand(rootCmd *cobra.Command) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dump",
+		Short: "Write the IAM snapshot of a project",
+		Long: `Write the IAM snapshot of a project to stdout, or to the file given with
+--output. Snapshots are sorted, so equal configurations dump identically and
+can be kept in version control.`,
+		RunE: dumpIAM(rootCmd),
+	}
+
+	cmd.Flags().StringP("output", "o", "", "File to write the snapshot to instead of stdout")
+
+	return cmd
+}
+
+func newIAMApplyCommand(rootCmd *cobra.Command) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Reconcile a project to an IAM snapshot",
+		Long: `Reconcile a project to an IAM snapshot: create the missing roles and
+permissions, and make the role permissions, the members and the roles and
+direct permissions of members match it. Members missing from the snapshot are
+removed from the project.
+
+Roles and permissions are global, so changing the permissions of a role also
+affects the other projects whose members hold it. Use --dry-run to review the
+changes first.`,
+		RunE: applyIAM(rootCmd),
+	}
+
+	cmd.Flags().StringP("file", "f", "", "Snapshot file to apply")
+	cmd.Flags().Bool("dry-run", false, "Only print the changes that would be made")
+	_ = cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func dumpIAM(rootCmd *cobra.Command) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		c, err := iamContainer(cmd, rootCmd)
+		if err != nil {
+			return err
+		}
+		defer c.Shutdown()
+
+		projectID, _ := cmd.Flags().GetString("project")
+		resp, err := c.GetIAMMapperService().DumpProjectIAM(ctx, &altalunev1.DumpProjectIAMRequest{
+			ProjectId: projectID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to dump project iam: %w", err)
+		}
+
+		output, _ := cmd.Flags().GetString("output")
+		if output == "" {
+			_, err = fmt.Fprint(cmd.OutOrStdout(), resp.Snapshot)
+			return err
+		}
+		if err := os.WriteFile(output, []byte(resp.Snapshot), 0o644); err != nil {
+			return fmt.Errorf("failed to write snapshot: %w", err)
+		}
+		log.Printf("IAM snapshot of project %s written to %s", projectID, output)
+		return nil
+	}
+}
+
+func applyIAM(rootCmd *cobra.Command) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		file, _ := cmd.Flags().GetString("file")
+		snapshot, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("failed to read snapshot: %w", err)
+		}
+
+		c, err := iamContainer(cmd, rootCmd)
+		if err != nil {
+			return err
+		}
+		defer c.Shutdown()
+
+		projectID, _ := cmd.Flags().GetString("project")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		resp, err := c.GetIAMMapperService().ApplyProjectIAM(ctx, &altalunev1.ApplyProjectIAMRequest{
+			ProjectId: projectID,
+			Snapshot:  string(snapshot),
+			DryRun:    dryRun,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to apply project iam: %w", err)
+		}
+
+		switch {
+		case len(resp.Changes) == 0:
+			log.Printf("Project %s already matches the snapshot", projectID)
+		case dryRun:
+			log.Printf("Changes that would be made to project %s:", projectID)
+		default:
+			log.Printf("Changes made to project %s:", projectID)
+		}
+		for _, change := range resp.Changes {
+			log.Printf("  %s", change)
+		}
+		return nil
+	}
+}
+
+func iamContainer(cmd *cobra.Command, rootCmd *cobra.Command) (*container.Container, error) {
+	cfg, err := loadConfig(rootCmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+
+	c, err := container.CreateContainer(cmd.Context(), cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create application container: %w", err)
+	}
+	if !c.IsHealthy(cmd.Context()) {
+		c.Shutdown()
+		return nil, fmt.Errorf("container is not healthy, cannot manage project iam")
+	}
+	return c, nil
+}
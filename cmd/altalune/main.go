@@ -7,6 +7,8 @@ import (
 	"os/signal"
 	"syscall"
 
+	"github.com/hrz8/altalune"
+	"github.com/hrz8/altalune/internal/config"
 	"github.com/spf13/cobra"
 )
 
@@ -36,12 +38,28 @@ func main() {
 
 func registerFlags(cmd *cobra.Command) {
 	cmd.PersistentFlags().StringP("config", "c", "config.yaml", "Configuration file path")
+	cmd.PersistentFlags().String("env", os.Getenv("ALTALUNE_ENV"), "Configuration profile merged over the configuration file, e.g. staging loads config.staging.yaml (default: $ALTALUNE_ENV)")
+}
+
+// loadConfig loads the configuration file and profile given to the root command
+func loadConfig(rootCmd *cobra.Command) (altalune.Config, error) {
+	configPath, _ := rootCmd.PersistentFlags().GetString("config")
+	profile, _ := rootCmd.PersistentFlags().GetString("env")
+	return config.LoadProfile(configPath, profile)
 }
 
 func registerCommands(cmd *cobra.Command) {
 	cmd.AddCommand(
 		NewServeCommand(cmd),
 		NewServeAuthCommand(cmd),
+		NewDevCommand(cmd),
 		NewMigrateCommand(cmd),
+		NewDoctorCommand(cmd),
+		NewPermissionsCommand(cmd),
+		NewIAMCommand(cmd),
+		NewMaintenanceCommand(cmd),
+		NewExportConfigCommand(cmd),
+		NewImportConfigCommand(cmd),
+		NewBenchCommand(cmd),
 	)
 }
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hrz8/altalune/internal/container"
+	maintenance_domain "github.com/hrz8/altalune/internal/domain/maintenance"
+	"github.com/spf13/cobra"
+)
+
+func NewMaintenanceCommand(rootCmd *cobra.Command) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "maintenance",
+		Short: "Switch maintenance mode",
+		Long: `Switch the maintenance mode every server follows through the database.
+
+In maintenance the API rejects mutating RPCs and the auth server shows a
+maintenance page, both with 503 and Retry-After, while health checks and
+requests carrying a maintenance.bypassTokens token are served as usual.
+Servers notice the switch within maintenance.pollInterval.`,
+	}
+
+	cmd.AddCommand(
+		newMaintenanceOnCommand(rootCmd),
+		newMaintenanceOffCommand(rootCmd),
+		newMaintenanceStatusCommand(rootCmd),
+	)
+
+	return cmd
+}
+
+func newMaintenanceOnCommand(rootCmd *cobra.Command) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "on",
+		Short: "Put the servers in maintenance",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			message, _ := cmd.Flags().GetString("message")
+			return withMaintenanceRepo(cmd.Context(), rootCmd, func(repo maintenance_domain.Repositor) error {
+				if err := repo.Set(cmd.Context(), true, message); err != nil {
+					return err
+				}
+				log.Println("Maintenance mode is on")
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().String("message", "", "Message shown to clients instead of maintenance.message")
+
+	return cmd
+}
+
+func newMaintenanceOffCommand(rootCmd *cobra.Command) *cobra.Command {
+	return &cobra.Command{
+		Use:   "off",
+		Short: "Take the servers out of maintenance",
+		Long:  "Take the servers out of maintenance. Servers started in maintenance by maintenance.enabled or --maintenance stay in it.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withMaintenanceRepo(cmd.Context(), rootCmd, func(repo maintenance_domain.Repositor) error {
+				if err := repo.Set(cmd.Context(), false, ""); err != nil {
+					return err
+				}
+				log.Println("Maintenance mode is off")
+				return nil
+			})
+		},
+	}
+}
+
+func newMaintenanceStatusCommand(rootCmd *cobra.Command) *cobra.Command {
+	return &cobra.Command{
+		Use:   "status",
+		Short: "Show the maintenance switch stored in the database",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withMaintenanceRepo(cmd.Context(), rootCmd, func(repo maintenance_domain.Repositor) error {
+				mode, err := repo.Get(cmd.Context())
+				if err != nil {
+					return err
+				}
+				if !mode.Enabled {
+					log.Println("Maintenance mode is off")
+					return nil
+				}
+				log.Printf("Maintenance mode is on since %s", mode.UpdatedAt.Format("2006-01-02 15:04:05 MST"))
+				if mode.Message != "" {
+					log.Printf("Message: %s", mode.Message)
+				}
+				return nil
+			})
+		},
+	}
+}
+
+func withMaintenanceRepo(ctx context.Context, rootCmd *cobra.Command, fn func(repo maintenance_domain.Repositor) error) error {
+	cfg, err := loadConfig(rootCmd)
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	c, err := container.CreateContainer(ctx, cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create application container: %w", err)
+	}
+	defer c.Shutdown()
+	if !c.IsHealthy(ctx) {
+		return fmt.Errorf("container is not healthy, cannot switch maintenance mode")
+	}
+
+	return fn(maintenance_domain.NewRepo(c.GetDB()))
+}
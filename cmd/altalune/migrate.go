@@ -1,14 +1,18 @@
 package main
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
+	"time"
 
-	"github.com/hrz8/altalune/internal/config"
+	"github.com/hrz8/altalune"
 	"github.com/hrz8/altalune/internal/container"
 	"github.com/hrz8/altalune/internal/domain/oauth_seeder"
+	permission_domain "github.com/hrz8/altalune/internal/domain/permission"
 	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/reflect/protoregistry"
 )
 
 func NewMigrateCommand(rootCmd *cobra.Command) *cobra.Command {
@@ -61,14 +65,13 @@ func runMigration(rootCmd *cobra.Command, action string) func(cmd *cobra.Command
 		ctx := cmd.Context()
 
 		// Get and load configuration
-		configPath, _ := rootCmd.PersistentFlags().GetString("config")
-		cfg, err := config.Load(configPath)
+		cfg, err := loadConfig(rootCmd)
 		if err != nil {
 			return fmt.Errorf("failed to load config: %w", err)
 		}
 
 		// Bootstrapping
-		c, err := container.CreateContainer(ctx, cfg)
+		c, err := container.CreateContainer(ctx, migrationConfig{cfg})
 		if err != nil {
 			log.Fatalf("failed to create application container: %v\n", err)
 		}
@@ -88,39 +91,26 @@ func runMigration(rootCmd *cobra.Command, action string) func(cmd *cobra.Command
 
 		switch action {
 		case "up":
-			// Run migrations first
-			if err := migrationSvc.MigrateUp(ctx); err != nil {
-				return err
-			}
-
-			// Check if seeding should be skipped
-			skipSeed, _ := cmd.Flags().GetBool("skip-seed")
-			if !skipSeed {
-				log.Println("Running database seeder...")
-
-				// Get database connection from container
-				dbManager := c.GetDBManager()
-				if dbManager == nil {
-					return errors.New("database manager not available")
+			// Hold the migration lock so concurrent jobs and replicas
+			// applying migrations on start do not race
+			return migrationSvc.WithLock(ctx, func() error {
+				// Run migrations first
+				if err := migrationSvc.MigrateUp(ctx); err != nil {
+					return err
 				}
 
-				// Initialize seeder with config (using interface)
-				seeder, err := oauth_seeder.NewSeeder(dbManager.GetDB(), cfg)
-				if err != nil {
-					return fmt.Errorf("failed to initialize seeder: %w", err)
+				// Check if seeding should be skipped
+				skipSeed, _ := cmd.Flags().GetBool("skip-seed")
+				if !skipSeed {
+					if err := seedDatabase(ctx, c, cfg); err != nil {
+						return err
+					}
+				} else {
+					log.Println("Skipping database seeding (--skip-seed flag set)")
 				}
 
-				// Run seeder
-				if err := seeder.Seed(ctx); err != nil {
-					return fmt.Errorf("seeding failed: %w", err)
-				}
-
-				log.Println("Database seeding completed successfully")
-			} else {
-				log.Println("Skipping database seeding (--skip-seed flag set)")
-			}
-
-			return nil
+				return nil
+			})
 
 		case "down":
 			return migrationSvc.MigrateDown(ctx)
@@ -131,3 +121,52 @@ func runMigration(rootCmd *cobra.Command, action string) func(cmd *cobra.Command
 		}
 	}
 }
+
+// migrationConfig lifts the statement timeout for migrations, whose schema
+// changes and backfills may run as long as the tables they rewrite take, and
+// exposes every project to them and to the seeding
+type migrationConfig struct {
+	altalune.Config
+}
+
+func (migrationConfig) GetDatabaseStatementTimeout() time.Duration {
+	return 0
+}
+
+func (migrationConfig) IsDatabaseRowLevelSecurityEnabled() bool {
+	return false
+}
+
+// seedDatabase creates the superadmin, OAuth providers and dashboard client
+// from the seeder configuration, and the permissions declared on the RPCs.
+func seedDatabase(ctx context.Context, c *container.Container, cfg altalune.Config) error {
+	log.Println("Running database seeder...")
+
+	// Get database connection from container
+	dbManager := c.GetDBManager()
+	if dbManager == nil {
+		return errors.New("database manager not available")
+	}
+
+	// Initialize seeder with config (using interface)
+	seeder, err := oauth_seeder.NewSeeder(dbManager.GetDB(), cfg)
+	if err != nil {
+		return fmt.Errorf("failed to initialize seeder: %w", err)
+	}
+
+	// Run seeder
+	if err := seeder.Seed(ctx); err != nil {
+		return fmt.Errorf("seeding failed: %w", err)
+	}
+
+	// Every permission the server enforces must be assignable
+	result, err := permission_domain.SyncCatalog(ctx, permission_domain.NewRepo(c.GetDB()),
+		permission_domain.Catalog(protoregistry.GlobalFiles), false)
+	if err != nil {
+		return fmt.Errorf("permission sync failed: %w", err)
+	}
+	logPermissions("Created permissions", result.Created)
+
+	log.Println("Database seeding completed successfully")
+	return nil
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hrz8/altalune/internal/container"
+	permission_domain "github.com/hrz8/altalune/internal/domain/permission"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+func NewPermissionsCommand(rootCmd *cobra.Command) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "permissions",
+		Short: "Manage the permission catalog",
+		Long:  "Manage the permission catalog declared on the RPCs in the proto",
+	}
+
+	cmd.AddCommand(
+		newPermissionsSyncCommand(rootCmd),
+	)
+
+	return cmd
+}
+
+func newPermissionsSyncCommand(rootCmd *cobra.Command) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sync",
+		Short: "Sync the permissions table with the proto annotations",
+		Long: `Create the permissions declared on RPCs with the altalune.v1.permission
+option that are missing from the permissions table, so that every permission
+the server enforces can be assigned.
+
+Permissions no RPC declares are reported. With --prune they are deleted,
+except root and the ones still assigned to a role or user. migrate up runs
+the sync without pruning.`,
+		RunE: syncPermissions(rootCmd),
+	}
+
+	cmd.Flags().Bool("prune", false, "Delete permissions no RPC declares")
+
+	return cmd
+}
+
+func syncPermissions(rootCmd *cobra.Command) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		cfg, err := loadConfig(rootCmd)
+		if err != nil {
+			return fmt.Errorf("failed to load config: %w", err)
+		}
+
+		c, err := container.CreateContainer(ctx, cfg)
+		if err != nil {
+			return fmt.Errorf("failed to create application container: %w", err)
+		}
+		defer c.Shutdown()
+		if !c.IsHealthy(ctx) {
+			return fmt.Errorf("container is not healthy, cannot sync permissions")
+		}
+
+		prune, _ := cmd.Flags().GetBool("prune")
+		catalog := permission_domain.Catalog(protoregistry.GlobalFiles)
+		result, err := permission_domain.SyncCatalog(ctx, permission_domain.NewRepo(c.GetDB()), catalog, prune)
+		if err != nil {
+			return fmt.Errorf("failed to sync permissions: %w", err)
+		}
+
+		log.Printf("Permission catalog: %d permissions declared", len(catalog))
+		logPermissions("Created", result.Created)
+		logPermissions("Pruned", result.Pruned)
+		logPermissions("Not declared on any RPC", result.Unused)
+		return nil
+	}
+}
+
+func logPermissions(label string, names []string) {
+	if len(names) > 0 {
+		log.Printf("%s: %s", label, strings.Join(names, ", "))
+	}
+}
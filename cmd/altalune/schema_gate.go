@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hrz8/altalune"
+	"github.com/hrz8/altalune/internal/container"
+	migration_domain "github.com/hrz8/altalune/internal/domain/migration"
+	"github.com/hrz8/altalune/internal/postgres"
+)
+
+// schemaPollInterval is how often a server waiting for migrations checks the
+// schema version
+const schemaPollInterval = 2 * time.Second
+
+// awaitSchema applies or waits for the pending migrations before serving, as
+// database.migrateOnStart says, so that no request reaches an older schema
+// when replicas start before the migrations finished.
+func awaitSchema(ctx context.Context, cfg altalune.Config, c *container.Container) error {
+	switch cfg.GetDatabaseMigrateOnStart() {
+	case "apply":
+		return applyMigrations(ctx, cfg, c)
+	case "wait":
+		return waitForSchema(ctx, c.GetMigrationService(), cfg.GetDatabaseMigrateWaitTimeout(), schemaPollInterval)
+	}
+	return nil
+}
+
+// waitForSchema waits up to timeout for another process to apply the pending
+// migrations
+func waitForSchema(ctx context.Context, svc *migration_domain.Service, timeout, interval time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	if err := svc.WaitForSchema(ctx, interval); err != nil {
+		return fmt.Errorf("%w\n\nRun `altalune migrate up`, or set database.migrateOnStart to apply", err)
+	}
+	return nil
+}
+
+// applyMigrations migrates and seeds the database holding the migration lock,
+// so that a single replica does while the others wait and then find the
+// schema up to date. It runs on a connection of its own without the statement
+// timeout, which migrations and the lock wait may exceed.
+func applyMigrations(ctx context.Context, cfg altalune.Config, c *container.Container) error {
+	conn := postgres.MustConnect(postgres.ConnectionOptions{
+		URL:            cfg.GetDatabaseURL(),
+		MaxConnections: 2, // The lock and the migrations
+		MaxIdleTime:    cfg.GetDatabaseMaxIdleTime(),
+		ConnectTimeout: cfg.GetDatabaseConnectTimeout(),
+	})
+	defer conn.GetDB().Close()
+	if err := conn.TestConnection(ctx); err != nil {
+		return fmt.Errorf("failed to connect for migrations: %w", err)
+	}
+
+	svc := migration_domain.NewService(c.GetLogger(), migration_domain.NewAltaluneMigrationRepo(conn))
+	return migrateLocked(ctx, svc, func() error { return seedDatabase(ctx, c, cfg) })
+}
+
+// migrateLocked migrates up and seeds holding the migration lock, unless the
+// schema is up to date once the lock is taken because another replica
+// migrated meanwhile
+func migrateLocked(ctx context.Context, svc *migration_domain.Service, seed func() error) error {
+	return svc.WithLock(ctx, func() error {
+		current, expected, err := svc.SchemaStatus(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to check schema version: %w", err)
+		}
+		if current >= expected {
+			log.Printf("Database schema is up to date (version %d)", current)
+			return nil
+		}
+
+		log.Printf("Applying migrations from version %d to %d", current, expected)
+		if err := svc.MigrateUp(ctx); err != nil {
+			return fmt.Errorf("migration failed: %w", err)
+		}
+		return seed()
+	})
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hrz8/altalune"
+	migration_domain "github.com/hrz8/altalune/internal/domain/migration"
+	"github.com/hrz8/altalune/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// schemaMigrator migrates from version to latest, recording whether it held
+// the lock while doing so; Down and PrintStatus are never called
+type schemaMigrator struct {
+	migration_domain.Migrator
+	version, latest int64
+	upErr           error
+
+	locked, migratedLocked bool
+}
+
+func (m *schemaMigrator) Version(context.Context) (int64, error) { return m.version, nil }
+func (m *schemaMigrator) LatestVersion() (int64, error)          { return m.latest, nil }
+
+func (m *schemaMigrator) Up(context.Context) error {
+	if m.upErr != nil {
+		return m.upErr
+	}
+	m.migratedLocked = m.locked
+	m.version = m.latest
+	return nil
+}
+
+func (m *schemaMigrator) Lock(context.Context) (func(), error) {
+	m.locked = true
+	return func() { m.locked = false }, nil
+}
+
+// migrateConfig holds database.migrateOnStart, the other getters are never
+// called
+type migrateConfig struct {
+	altalune.Config
+	mode string
+}
+
+func (c *migrateConfig) GetDatabaseMigrateOnStart() string { return c.mode }
+
+func TestAwaitSchemaOff(t *testing.T) {
+	assert.NoError(t, awaitSchema(context.Background(), &migrateConfig{mode: "off"}, nil), "no database access when off")
+}
+
+func TestWaitForSchema(t *testing.T) {
+	svc := migration_domain.NewService(logger.New("error"), &schemaMigrator{version: 5, latest: 5})
+	require.NoError(t, waitForSchema(context.Background(), svc, time.Second, time.Millisecond))
+
+	svc = migration_domain.NewService(logger.New("error"), &schemaMigrator{version: 3, latest: 5})
+	err := waitForSchema(context.Background(), svc, 20*time.Millisecond, time.Millisecond)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.ErrorContains(t, err, "Run `altalune migrate up`, or set database.migrateOnStart to apply")
+}
+
+func TestMigrateLocked(t *testing.T) {
+	tests := []struct {
+		name       string
+		migrator   *schemaMigrator
+		wantErr    string
+		wantSeeded bool
+	}{
+		{
+			name:       "pending migrations",
+			migrator:   &schemaMigrator{version: 3, latest: 5},
+			wantSeeded: true,
+		},
+		{
+			name:     "migrated by another replica",
+			migrator: &schemaMigrator{version: 5, latest: 5},
+		},
+		{
+			name:     "migration failure",
+			migrator: &schemaMigrator{version: 3, latest: 5, upErr: errors.New("syntax error")},
+			wantErr:  "migration failed: failed to run altalune migration: syntax error",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := migration_domain.NewService(logger.New("error"), tt.migrator)
+			seeded := false
+			err := migrateLocked(context.Background(), svc, func() error {
+				assert.True(t, tt.migrator.locked, "seeded holding the lock")
+				seeded = true
+				return nil
+			})
+
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantSeeded, seeded)
+			assert.Equal(t, tt.wantSeeded, tt.migrator.migratedLocked, "migrated holding the lock")
+			assert.False(t, tt.migrator.locked, "released once done")
+		})
+	}
+}
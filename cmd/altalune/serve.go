@@ -5,12 +5,13 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/hrz8/altalune"
-	"github.com/hrz8/altalune/internal/config"
 	"github.com/hrz8/altalune/internal/container"
+	oauth_provider_domain "github.com/hrz8/altalune/internal/domain/oauth_provider"
 	"github.com/hrz8/altalune/internal/server"
 	"github.com/hrz8/altalune/internal/shared/crypto"
 	"github.com/hrz8/altalune/server/grpcserver"
@@ -26,6 +27,8 @@ func NewServeCommand(rootCmd *cobra.Command) *cobra.Command {
 		RunE:  serve(rootCmd),
 	}
 
+	cmd.Flags().Bool("maintenance", false, "Start in maintenance mode whatever the configuration and database say")
+
 	return cmd
 }
 
@@ -34,8 +37,7 @@ func serve(rootCmd *cobra.Command) func(cmd *cobra.Command, args []string) error
 		ctx := cmd.Context()
 
 		// Get and load configuration
-		configPath, _ := rootCmd.PersistentFlags().GetString("config")
-		cfg, err := config.Load(configPath)
+		cfg, err := loadConfig(rootCmd)
 		if err != nil {
 			return fmt.Errorf("error loading configuration file: %w", err)
 		}
@@ -44,6 +46,11 @@ func serve(rootCmd *cobra.Command) func(cmd *cobra.Command, args []string) error
 		if err := validateEncryptionKey(cfg); err != nil {
 			return err // Exit application
 		}
+		if cfg.IsAuthEmbedded() {
+			if err := validateAuthConfig(cfg); err != nil {
+				return err
+			}
+		}
 
 		// Bootstrapping
 		c, err := container.CreateContainer(ctx, cfg)
@@ -53,14 +60,32 @@ func serve(rootCmd *cobra.Command) func(cmd *cobra.Command, args []string) error
 		if !c.IsHealthy(ctx) {
 			return fmt.Errorf("container is not healthy, cannot run migration")
 		}
+		if err := awaitSchema(ctx, cfg, c); err != nil {
+			return err
+		}
+		if maintenance, _ := cmd.Flags().GetBool("maintenance"); maintenance {
+			c.GetMaintenanceSwitch().Force()
+		}
+		if err := checkProviderSecrets(ctx, c.GetOAuthProviderRepo()); err != nil {
+			return err
+		}
+		reencryptSecrets(ctx, c)
 		srv := server.NewServer(c)
 		httpHandler, grpcServer := srv.Bootstrap()
 
+		// Get certificates from the ACME CA when enabled
+		acmeManager := newACMEManager(cfg)
+		redirectSrv := newHTTPSRedirectServer(cfg, acmeManager, cfg.GetServerBindHost(), serveHTTPSPorts(cfg))
+
 		// Create HTTP server
 		httpSrv := httpserver.NewHTTPServer(
 			httpserver.WithHandler(httpHandler),
+			httpserver.WithHost(cfg.GetServerBindHost()),
 			httpserver.WithPort(cfg.GetServerPort()),
+			httpserver.WithTLS(cfg.GetServerTLSCertFile(), cfg.GetServerTLSKeyFile()),
+			httpserver.WithTLSConfig(acmeTLSConfig(acmeManager)),
 			httpserver.WithReadTimeout(cfg.GetServerReadTimeout()),
+			httpserver.WithReadHeaderTimeout(cfg.GetServerReadHeaderTimeout()),
 			httpserver.WithWriteTimeout(cfg.GetServerWriteTimeout()),
 			httpserver.WithIdleTimeout(cfg.GetServerIdleTimeout()),
 			httpserver.WithCleanupTimeout(cfg.GetServerCleanupTimeout()),
@@ -69,10 +94,40 @@ func serve(rootCmd *cobra.Command) func(cmd *cobra.Command, args []string) error
 		// Create gRPC server
 		grpcSrv := grpcserver.NewGRPCServer(
 			grpcserver.WithHandler(grpcServer),
+			grpcserver.WithHost(cfg.GetServerBindHost()),
 			grpcserver.WithPort(cfg.GetServerPort()+1),
+			grpcserver.WithTLS(cfg.GetServerTLSCertFile(), cfg.GetServerTLSKeyFile()),
+			grpcserver.WithTLSConfig(acmeTLSConfig(acmeManager)),
 			grpcserver.WithCleanupTimeout(cfg.GetServerCleanupTimeout()),
 		)
 
+		// Run the auth server on its own listener in this process when embedded
+		var authSrv *httpserver.Server
+		if cfg.IsAuthEmbedded() {
+			authSrv, err = newAuthHTTPServer(cfg, c, acmeTLSConfig(acmeManager))
+			if err != nil {
+				return err
+			}
+		}
+
+		// Purge expired trash in the background
+		janitor := c.GetTrashJanitor()
+		janitor.Start(ctx)
+
+		// Run scheduled background jobs
+		sched := c.GetScheduler()
+		sched.Start(ctx)
+
+		// Generate the query exports in the background
+		exportWorker := c.GetQueryExportWorker()
+		exportWorker.Start(ctx)
+
+		// Meter the API calls of the projects, storing the counts periodically
+		meter := c.GetUsageMeter()
+		if cfg.IsUsageMeteringEnabled() {
+			meter.Start(ctx)
+		}
+
 		// Start servers
 		go func() {
 			log.Printf("🚀 starting HTTP server at port: %d\n", cfg.GetServerPort())
@@ -84,6 +139,24 @@ func serve(rootCmd *cobra.Command) func(cmd *cobra.Command, args []string) error
 			grpcSrv.Start()
 		}()
 
+		var authNotify <-chan error
+		if authSrv != nil {
+			authNotify = authSrv.Notify()
+			go func() {
+				log.Printf("🚀 starting OAuth authorization server at port: %d\n", cfg.GetAuthPort())
+				authSrv.Start()
+			}()
+		}
+
+		var redirectNotify <-chan error
+		if redirectSrv != nil {
+			redirectNotify = redirectSrv.Notify()
+			go func() {
+				log.Printf("🚀 starting HTTPS redirect server at port: %d\n", cfg.GetACMEHTTPPort())
+				redirectSrv.Start()
+			}()
+		}
+
 		defer cleanup(cfg,
 			func() error {
 				if err := httpSrv.Stop(); err != nil {
@@ -92,6 +165,26 @@ func serve(rootCmd *cobra.Command) func(cmd *cobra.Command, args []string) error
 				}
 				return nil
 			},
+			func() error {
+				if authSrv == nil {
+					return nil
+				}
+				if err := authSrv.Stop(); err != nil {
+					log.Printf("failed shutdown auth server: %v\n", err)
+					return err
+				}
+				return nil
+			},
+			func() error {
+				if redirectSrv == nil {
+					return nil
+				}
+				if err := redirectSrv.Stop(); err != nil {
+					log.Printf("failed shutdown HTTPS redirect server: %v\n", err)
+					return err
+				}
+				return nil
+			},
 			func() error {
 				if err := grpcSrv.Stop(); err != nil {
 					log.Printf("failed shutdown gRPC server: %v\n", err)
@@ -99,6 +192,22 @@ func serve(rootCmd *cobra.Command) func(cmd *cobra.Command, args []string) error
 				}
 				return nil
 			},
+			func() error {
+				janitor.Stop()
+				return nil
+			},
+			func() error {
+				sched.Stop()
+				return nil
+			},
+			func() error {
+				exportWorker.Stop()
+				return nil
+			},
+			func() error {
+				meter.Stop()
+				return nil
+			},
 			func() error {
 				if err := c.Shutdown(); err != nil {
 					log.Printf("failed to shutdown application container: %v\n", err)
@@ -116,6 +225,14 @@ func serve(rootCmd *cobra.Command) func(cmd *cobra.Command, args []string) error
 			if err != nil && err != http.ErrServerClosed {
 				return fmt.Errorf("HTTP server listen error: %w", err)
 			}
+		case err := <-authNotify:
+			if err != nil && err != http.ErrServerClosed {
+				return fmt.Errorf("auth server listen error: %w", err)
+			}
+		case err := <-redirectNotify:
+			if err != nil && err != http.ErrServerClosed {
+				return fmt.Errorf("HTTPS redirect server listen error: %w", err)
+			}
 		case err := <-grpcSrv.Notify():
 			if err != nil {
 				return fmt.Errorf("gRPC server listen error: %w", err)
@@ -170,5 +287,60 @@ func validateEncryptionKey(cfg altalune.Config) error {
 		return fmt.Errorf("IAM encryption key validation failed: %w", err)
 	}
 
+	for i, previous := range cfg.GetIAMPreviousEncryptionKeys() {
+		if err := crypto.ValidateKey(previous); err != nil {
+			return fmt.Errorf("previous IAM encryption key %d (security.iamPreviousKeys) validation failed: %w", i+1, err)
+		}
+	}
+
 	return nil
 }
+
+// checkProviderSecrets fails when the IAM encryption keys cannot decrypt the
+// client secret of an OAuth provider. It runs on startup so a changed key is
+// reported right away instead of as failed provider logins.
+func checkProviderSecrets(ctx context.Context, repo oauth_provider_domain.Repository) error {
+	failed, err := repo.UndecryptableClientSecrets(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check OAuth provider client secrets: %w", err)
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+
+	providers := make([]string, len(failed))
+	for i, providerType := range failed {
+		providers[i] = string(providerType)
+	}
+	return fmt.Errorf(`the IAM encryption key cannot decrypt the client secret of the OAuth providers %s.
+security.iamEncryptionKey was changed without rotating the secrets. Either:
+  - list the key they were encrypted with in security.iamPreviousKeys, they are re-encrypted on start
+  - or re-encrypt them with: secret_encrypter rotate-key --old-key <previous key>
+  - or, if the previous key is lost, set the client secrets of these providers again`,
+		strings.Join(providers, ", "))
+}
+
+// reencryptSecrets moves the OAuth provider client secrets still encrypted with
+// a previous IAM encryption key to the current one. Failures are only logged:
+// secrets keep decrypting with the previous keys until the next start.
+func reencryptSecrets(ctx context.Context, c *container.Container) {
+	n, err := c.GetOAuthProviderRepo().ReencryptClientSecrets(ctx)
+	if err != nil {
+		log.Printf("⚠️ failed to re-encrypt OAuth provider client secrets: %v\n", err)
+		return
+	}
+	if n > 0 {
+		log.Printf("🔑 re-encrypted %d OAuth provider client secrets with the current IAM encryption key\n", n)
+	}
+}
+
+// serveHTTPSPorts maps the hostnames served by serve to the port of their
+// HTTPS server: the auth host goes to the auth server when it is embedded,
+// every other hostname to the API server.
+func serveHTTPSPorts(cfg altalune.Config) map[string]int {
+	httpsPorts := map[string]int{"": cfg.GetServerPort()}
+	if cfg.IsAuthEmbedded() {
+		httpsPorts[cfg.GetAuthHost()] = cfg.GetAuthPort()
+	}
+	return httpsPorts
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"crypto/tls"
 	"fmt"
 	"log"
 	"net/http"
@@ -8,27 +9,29 @@ import (
 
 	"github.com/hrz8/altalune"
 	"github.com/hrz8/altalune/internal/authserver"
-	"github.com/hrz8/altalune/internal/config"
 	"github.com/hrz8/altalune/internal/container"
 	"github.com/hrz8/altalune/server/httpserver"
 	"github.com/spf13/cobra"
 )
 
 func NewServeAuthCommand(rootCmd *cobra.Command) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "serve-auth",
 		Short: "Start the OAuth authorization server",
 		Long:  "Start the OAuth authorization server for user authentication and OAuth 2.0 flows",
 		RunE:  serveAuth(rootCmd),
 	}
+
+	cmd.Flags().Bool("maintenance", false, "Start in maintenance mode whatever the configuration and database say")
+
+	return cmd
 }
 
 func serveAuth(rootCmd *cobra.Command) func(cmd *cobra.Command, args []string) error {
 	return func(cmd *cobra.Command, args []string) error {
 		ctx := cmd.Context()
 
-		configPath, _ := rootCmd.PersistentFlags().GetString("config")
-		cfg, err := config.Load(configPath)
+		cfg, err := loadConfig(rootCmd)
 		if err != nil {
 			return fmt.Errorf("load config: %w", err)
 		}
@@ -44,31 +47,38 @@ func serveAuth(rootCmd *cobra.Command) func(cmd *cobra.Command, args []string) e
 		if !c.IsHealthy(ctx) {
 			return fmt.Errorf("container is not healthy")
 		}
-
-		if c.GetJWTSigner() == nil {
-			return fmt.Errorf("JWT signer not initialized - check jwt key paths in config")
+		if err := awaitSchema(ctx, cfg, c); err != nil {
+			return err
 		}
-		if c.GetSessionStore() == nil {
-			return fmt.Errorf("session store not initialized - check sessionSecret in config")
+		if err := checkProviderSecrets(ctx, c.GetOAuthProviderRepo()); err != nil {
+			return err
+		}
+		if maintenance, _ := cmd.Flags().GetBool("maintenance"); maintenance {
+			c.GetMaintenanceSwitch().Force()
 		}
 
-		srv := authserver.NewServer(c)
-		handler := srv.Bootstrap()
+		acmeManager := newACMEManager(cfg)
+		redirectSrv := newHTTPSRedirectServer(cfg, acmeManager, cfg.GetAuthBindHost(), map[string]int{"": cfg.GetAuthPort()})
 
-		httpSrv := httpserver.NewHTTPServer(
-			httpserver.WithHandler(handler),
-			httpserver.WithPort(cfg.GetAuthPort()),
-			httpserver.WithReadTimeout(cfg.GetServerReadTimeout()),
-			httpserver.WithWriteTimeout(cfg.GetServerWriteTimeout()),
-			httpserver.WithIdleTimeout(cfg.GetServerIdleTimeout()),
-			httpserver.WithCleanupTimeout(cfg.GetServerCleanupTimeout()),
-		)
+		httpSrv, err := newAuthHTTPServer(cfg, c, acmeTLSConfig(acmeManager))
+		if err != nil {
+			return err
+		}
 
 		go func() {
 			log.Printf("🚀 starting OAuth authorization server at port: %d\n", cfg.GetAuthPort())
 			httpSrv.Start()
 		}()
 
+		var redirectNotify <-chan error
+		if redirectSrv != nil {
+			redirectNotify = redirectSrv.Notify()
+			go func() {
+				log.Printf("🚀 starting HTTPS redirect server at port: %d\n", cfg.GetACMEHTTPPort())
+				redirectSrv.Start()
+			}()
+		}
+
 		defer cleanup(cfg,
 			func() error {
 				if err := httpSrv.Stop(); err != nil {
@@ -77,6 +87,16 @@ func serveAuth(rootCmd *cobra.Command) func(cmd *cobra.Command, args []string) e
 				}
 				return nil
 			},
+			func() error {
+				if redirectSrv == nil {
+					return nil
+				}
+				if err := redirectSrv.Stop(); err != nil {
+					log.Printf("failed shutdown HTTPS redirect server: %v\n", err)
+					return err
+				}
+				return nil
+			},
 			func() error {
 				if err := c.Shutdown(); err != nil {
 					log.Printf("failed to shutdown container: %v\n", err)
@@ -94,12 +114,41 @@ func serveAuth(rootCmd *cobra.Command) func(cmd *cobra.Command, args []string) e
 			if err != nil && err != http.ErrServerClosed {
 				return fmt.Errorf("auth server listen error: %w", err)
 			}
+		case err := <-redirectNotify:
+			if err != nil && err != http.ErrServerClosed {
+				return fmt.Errorf("HTTPS redirect server listen error: %w", err)
+			}
 		}
 
 		return nil
 	}
 }
 
+// newAuthHTTPServer returns the OAuth authorization server listening on
+// auth.bindHost:auth.port, serving HTTPS with tlsConfig or the configured
+// certificate files.
+func newAuthHTTPServer(cfg altalune.Config, c *container.Container, tlsConfig *tls.Config) (*httpserver.Server, error) {
+	if c.GetJWTSigner() == nil {
+		return nil, fmt.Errorf("JWT signer not initialized - check jwt key paths in config")
+	}
+	if c.GetSessionStore() == nil {
+		return nil, fmt.Errorf("session store not initialized - check sessionSecret in config")
+	}
+
+	return httpserver.NewHTTPServer(
+		httpserver.WithHandler(authserver.NewServer(c).Bootstrap()),
+		httpserver.WithHost(cfg.GetAuthBindHost()),
+		httpserver.WithPort(cfg.GetAuthPort()),
+		httpserver.WithTLS(cfg.GetServerTLSCertFile(), cfg.GetServerTLSKeyFile()),
+		httpserver.WithTLSConfig(tlsConfig),
+		httpserver.WithReadTimeout(cfg.GetServerReadTimeout()),
+		httpserver.WithReadHeaderTimeout(cfg.GetServerReadHeaderTimeout()),
+		httpserver.WithWriteTimeout(cfg.GetServerWriteTimeout()),
+		httpserver.WithIdleTimeout(cfg.GetServerIdleTimeout()),
+		httpserver.WithCleanupTimeout(cfg.GetServerCleanupTimeout()),
+	), nil
+}
+
 func validateAuthConfig(cfg altalune.Config) error {
 	if cfg.GetJWTPrivateKeyPath() == "" {
 		return fmt.Errorf("jwt private key path is required (security.jwtPrivateKeyPath)")
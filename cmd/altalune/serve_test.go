@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hrz8/altalune"
+	"github.com/hrz8/altalune/internal/container"
+	oauth_provider_domain "github.com/hrz8/altalune/internal/domain/oauth_provider"
+	"github.com/stretchr/testify/assert"
+)
+
+// embeddedAuthConfig holds the listen addresses of serve, the other getters
+// are never called
+type embeddedAuthConfig struct {
+	altalune.Config
+	embedded bool
+}
+
+func (c *embeddedAuthConfig) IsAuthEmbedded() bool { return c.embedded }
+func (c *embeddedAuthConfig) GetServerPort() int   { return 3100 }
+func (c *embeddedAuthConfig) GetAuthHost() string  { return "auth.example.com" }
+func (c *embeddedAuthConfig) GetAuthPort() int     { return 3300 }
+
+func TestServeHTTPSPorts(t *testing.T) {
+	assert.Equal(t, map[string]int{"": 3100}, serveHTTPSPorts(&embeddedAuthConfig{}))
+	assert.Equal(t, map[string]int{"": 3100, "auth.example.com": 3300}, serveHTTPSPorts(&embeddedAuthConfig{embedded: true}),
+		"the auth host is redirected to the embedded auth server")
+}
+
+func TestNewAuthHTTPServerRequiresSigner(t *testing.T) {
+	_, err := newAuthHTTPServer(&embeddedAuthConfig{embedded: true}, &container.Container{}, nil)
+	assert.ErrorContains(t, err, "JWT signer not initialized")
+}
+
+// undecryptableSecrets reports fixed providers as undecryptable, the other
+// methods are never called
+type undecryptableSecrets struct {
+	oauth_provider_domain.Repository
+	failed []oauth_provider_domain.ProviderType
+	err    error
+}
+
+func (r *undecryptableSecrets) UndecryptableClientSecrets(context.Context) ([]oauth_provider_domain.ProviderType, error) {
+	return r.failed, r.err
+}
+
+func TestCheckProviderSecrets(t *testing.T) {
+	ctx := context.Background()
+
+	assert.NoError(t, checkProviderSecrets(ctx, &undecryptableSecrets{}))
+
+	err := checkProviderSecrets(ctx, &undecryptableSecrets{failed: []oauth_provider_domain.ProviderType{
+		oauth_provider_domain.ProviderTypeGoogle,
+		oauth_provider_domain.ProviderTypeGithub,
+	}})
+	assert.ErrorContains(t, err, "cannot decrypt the client secret of the OAuth providers google, github.")
+	assert.ErrorContains(t, err, "secret_encrypter rotate-key", "the error explains how to recover")
+
+	failure := errors.New("connection refused")
+	assert.ErrorIs(t, checkProviderSecrets(ctx, &undecryptableSecrets{err: failure}), failure)
+}
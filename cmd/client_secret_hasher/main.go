@@ -2,6 +2,7 @@ package main
 
 import (
 	"bufio"
+	"context"
 	"errors"
 	"flag"
 	"fmt"
@@ -11,6 +12,7 @@ import (
 	"strings"
 
 	"github.com/hrz8/altalune/internal/shared/password"
+	"github.com/hrz8/altalune/internal/shared/secretpolicy"
 )
 
 // Production parameters for Argon2id hashing
@@ -28,19 +30,54 @@ func main() {
 	memory := flag.Uint("memory", defaultMemory, "Memory cost in KB")
 	threads := flag.Uint("threads", defaultThreads, "Parallelism (threads)")
 	length := flag.Uint("length", defaultLength, "Hash length in bytes")
+	verifyHash := flag.String("verify", "", "PHC hash to check the client secret against, instead of hashing it")
+	inspect := flag.Bool("inspect", false, "Print the Argon2 parameters of the PHC hash given as argument")
+	minEntropy := flag.Float64("min-entropy", secretpolicy.MinClientSecretEntropy, "Minimum estimated entropy of the secret in bits, 0 to disable")
+	checkPwned := flag.Bool("check-pwned", false, "Reject secrets found in the Have I Been Pwned breach corpus (sends a 5-character hash prefix)")
 	flag.Parse()
 
+	if *inspect {
+		if flag.NArg() != 1 {
+			log.Fatal("usage: client_secret_hasher --inspect '<phc-hash>'")
+		}
+		if err := inspectHash(flag.Arg(0)); err != nil {
+			log.Fatalf("failed to inspect hash: %v", err)
+		}
+		return
+	}
+
 	// Resolve secret from flag or stdin
 	secret, err := resolveSecret(*secretFlag)
 	if err != nil {
 		log.Fatalf("failed to read client secret: %v", err)
 	}
 
+	if *verifyHash != "" {
+		match, err := password.VerifyPassword(secret, *verifyHash)
+		if err != nil {
+			log.Fatalf("failed to verify client secret: %v", err)
+		}
+		if !match {
+			fmt.Println("no match")
+			os.Exit(1)
+		}
+		fmt.Println("match")
+		return
+	}
+
 	// Validate minimum secret length (32 characters for OAuth client secrets)
 	if len(secret) < 32 {
 		log.Fatalf("client secret must be at least 32 characters, got %d", len(secret))
 	}
 
+	policy := secretpolicy.Policy{MinEntropy: *minEntropy}
+	if *checkPwned {
+		policy.Pwned = secretpolicy.NewPwnedChecker()
+	}
+	if err := policy.Check(context.Background(), secret); err != nil {
+		log.Fatalf("client secret rejected: %v", err)
+	}
+
 	// Hash the secret with Argon2id
 	hashedSecret, err := password.HashPassword(secret, password.HashOption{
 		Iterations: uint32(*iterations),
@@ -56,6 +93,31 @@ func main() {
 	fmt.Println(hashedSecret)
 }
 
+// inspectHash prints the parameters of a PHC hash, flagging those weaker than
+// the production defaults
+func inspectHash(encodedHash string) error {
+	info, err := password.InspectHash(encodedHash)
+	if err != nil {
+		return err
+	}
+
+	weaker := func(weak bool) string {
+		if weak {
+			return "  (below default)"
+		}
+		return ""
+	}
+
+	fmt.Printf("Algorithm:   %s\n", info.Algorithm)
+	fmt.Printf("Version:     %d\n", info.Version)
+	fmt.Printf("Memory:      %d KB%s\n", info.Memory, weaker(info.Memory < defaultMemory))
+	fmt.Printf("Iterations:  %d%s\n", info.Iterations, weaker(info.Iterations < defaultIterations))
+	fmt.Printf("Parallelism: %d\n", info.Threads)
+	fmt.Printf("Salt length: %d bytes\n", info.SaltLen)
+	fmt.Printf("Hash length: %d bytes%s\n", info.Len, weaker(info.Len < defaultLength))
+	return nil
+}
+
 // resolveSecret resolves the secret from flag, stdin pipe, or interactive prompt
 func resolveSecret(fromFlag string) (string, error) {
 	// If provided via flag, use it
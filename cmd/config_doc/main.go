@@ -0,0 +1,168 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hrz8/altalune/internal/config"
+	"github.com/spf13/cobra"
+)
+
+var (
+	output string
+	srcDir string
+)
+
+func main() {
+	rootCmd := &cobra.Command{
+		Use:   "config-doc",
+		Short: "Generate the configuration reference",
+		Long:  "Generate a Markdown reference of every config file key, its environment variable and its rules from the config structs",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			comments, err := fieldComments(srcDir)
+			if err != nil {
+				return err
+			}
+
+			if output == "" {
+				return render(os.Stdout, comments)
+			}
+
+			f, err := os.Create(output)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			return render(f, comments)
+		},
+	}
+
+	rootCmd.Flags().StringVarP(
+		&output,
+		"output",
+		"o",
+		"",
+		"Write the reference to this file instead of stdout",
+	)
+	rootCmd.Flags().StringVar(
+		&srcDir,
+		"src",
+		"internal/config",
+		"Directory of the config package, whose field comments describe the keys",
+	)
+
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Println("Error:", err)
+		os.Exit(1)
+	}
+}
+
+// fieldComments reads the doc comments of the config structs, keyed by their
+// name, and the comments of their fields, keyed by "Struct.Field"
+func fieldComments(dir string) (map[string]string, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	comments := make(map[string]string)
+	fset := token.NewFileSet()
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, file, nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		ast.Inspect(f, func(n ast.Node) bool {
+			decl, ok := n.(*ast.GenDecl)
+			if !ok || decl.Tok != token.TYPE || len(decl.Specs) != 1 {
+				return true
+			}
+			spec := decl.Specs[0].(*ast.TypeSpec)
+			st, ok := spec.Type.(*ast.StructType)
+			if !ok {
+				return false
+			}
+			comments[spec.Name.Name] = strings.Join(strings.Fields(decl.Doc.Text()), " ")
+			for _, field := range st.Fields.List {
+				text := field.Comment.Text()
+				if field.Doc != nil {
+					text = field.Doc.Text()
+				}
+				for _, name := range field.Names {
+					comments[spec.Name.Name+"."+name.Name] = strings.Join(strings.Fields(text), " ")
+				}
+			}
+			return false
+		})
+	}
+	return comments, nil
+}
+
+func render(w io.Writer, comments map[string]string) error {
+	var b strings.Builder
+
+	b.WriteString("# Configuration Reference\n\n")
+	b.WriteString("<!-- Code generated by cmd/config_doc. DO NOT EDIT. -->\n\n")
+	b.WriteString("Every key of `config.yaml`. `config.example.yaml` shows a complete file with its defaults.\n")
+	b.WriteString("A profile file such as `config.staging.yaml`, selected with `--env staging` or `ALTALUNE_ENV`, is\n")
+	b.WriteString("merged over it: sections key by key, lists and values replacing the base ones.\n")
+	b.WriteString("Each key can be overridden with its environment variable: lists are comma-separated, maps are\n")
+	b.WriteString("comma-separated `key=value` pairs whose list values are space-separated, and `<N>` is the index\n")
+	b.WriteString("of a list entry. Sections missing from the file are created by the variables setting their keys.\n")
+
+	for _, f := range config.Fields() {
+		if !strings.Contains(f.Key, ".") {
+			fmt.Fprintf(&b, "\n## `%s`\n\n", f.Key)
+			if desc := sectionDoc(f, comments); desc != "" {
+				b.WriteString(desc + "\n\n")
+			}
+			b.WriteString("| Key | Environment Variable | Type | Rules | Description |\n")
+			b.WriteString("|-----|----------------------|------|-------|-------------|\n")
+			continue
+		}
+
+		env := ""
+		if f.Env != "" {
+			env = "`" + f.Env + "`"
+		}
+		rules := ""
+		if f.Validate != "" {
+			rules = "`" + f.Validate + "`"
+		}
+		desc := comments[f.Struct+"."+f.Name]
+		if desc == "" && f.Section != "" {
+			desc = sectionDoc(f, comments)
+		}
+		fmt.Fprintf(&b, "| `%s` | %s | %s | %s | %s |\n",
+			f.Key, env, f.Type, escape(rules), escape(desc))
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// sectionDoc returns the doc comment of the struct holding the keys of a
+// section, without the leading "<Struct> is"
+func sectionDoc(f config.Field, comments map[string]string) string {
+	desc, ok := strings.CutPrefix(comments[f.Section], f.Section+" ")
+	if !ok || desc == "" {
+		return desc
+	}
+	desc = strings.TrimPrefix(desc, "is ")
+	return strings.ToUpper(desc[:1]) + desc[1:]
+}
+
+// escape keeps pipes from ending table cells
+func escape(s string) string {
+	return strings.ReplaceAll(s, "|", `\|`)
+}
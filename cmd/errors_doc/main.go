@@ -0,0 +1,71 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/hrz8/altalune"
+	"github.com/spf13/cobra"
+)
+
+var output string
+
+func main() {
+	rootCmd := &cobra.Command{
+		Use:   "errors-doc",
+		Short: "Generate the error code reference",
+		Long:  "Generate a Markdown reference of every application error code from the error catalog",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if output == "" {
+				return render(os.Stdout)
+			}
+
+			f, err := os.Create(output)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			return render(f)
+		},
+	}
+
+	rootCmd.Flags().StringVarP(
+		&output,
+		"output",
+		"o",
+		"",
+		"Write the reference to this file instead of stdout",
+	)
+
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Println("Error:", err)
+		os.Exit(1)
+	}
+}
+
+func render(w io.Writer) error {
+	var b strings.Builder
+
+	b.WriteString("# Error Codes Reference\n\n")
+	b.WriteString("<!-- Code generated by cmd/errors_doc. DO NOT EDIT. -->\n\n")
+	b.WriteString("Every API error carries an `altalune.v1.ErrorDetail` in its Connect/gRPC error details.\n")
+	b.WriteString("Clients should match on `code` instead of the error message. `domain`, `retryable` and\n")
+	b.WriteString("`http_status` are filled from this catalog; `meta` holds error-specific values.\n\n")
+	b.WriteString("| Code | Domain | gRPC Code | HTTP Status | Retryable | Description |\n")
+	b.WriteString("|------|--------|-----------|-------------|-----------|-------------|\n")
+
+	for _, d := range altalune.ErrorCatalog() {
+		retryable := "no"
+		if d.Retryable {
+			retryable = "yes"
+		}
+		fmt.Fprintf(&b, "| `%s` | %s | %s | %d | %s | %s |\n",
+			d.Code, d.Domain, d.GRPCCode.String(), d.HTTPStatus(), retryable, d.Description)
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
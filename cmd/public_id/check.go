@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/hrz8/altalune/internal/config"
+	"github.com/hrz8/altalune/internal/postgres"
+)
+
+var tableNamePattern = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+
+// maxCollisionRetries bounds the attempts to replace an ID already in use,
+// so a nearly exhausted ID space fails instead of looping.
+const maxCollisionRetries = 100
+
+// generateUnused generates n distinct IDs unused in the public_id column of
+// checkTable, regenerating the ones that collide.
+func generateUnused(ctx context.Context, n int, generate func() (string, error)) ([]string, error) {
+	if !tableNamePattern.MatchString(checkTable) {
+		return nil, fmt.Errorf("invalid table name %q", checkTable)
+	}
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+	conn := postgres.MustConnect(postgres.ConnectionOptions{
+		URL:            cfg.GetDatabaseURL(),
+		MaxConnections: 1,
+		MaxIdleTime:    cfg.GetDatabaseMaxIdleTime(),
+		ConnectTimeout: cfg.GetDatabaseConnectTimeout(),
+	})
+	defer conn.Close()
+	if err := conn.TestConnection(ctx); err != nil {
+		return nil, err
+	}
+
+	return generateUnusedIn(ctx, conn, checkTable, len(prefix)+length, n, generate)
+}
+
+// generateUnusedIn generates n distinct IDs of idLength characters unused in
+// the public_id column of table.
+func generateUnusedIn(ctx context.Context, db postgres.DB, table string, idLength, n int, generate func() (string, error)) ([]string, error) {
+	var maxLength sql.NullInt64
+	err := db.QueryRowContext(ctx, `
+		SELECT character_maximum_length
+		FROM information_schema.columns
+		WHERE table_schema = current_schema() AND table_name = $1 AND column_name = 'public_id'
+	`, table).Scan(&maxLength)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("table %s has no public_id column", table)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to inspect table %s: %w", table, err)
+	}
+	if maxLength.Valid && int64(idLength) > maxLength.Int64 {
+		return nil, fmt.Errorf("IDs of %d characters do not fit %s.public_id (max %d)", idLength, table, maxLength.Int64)
+	}
+
+	query := fmt.Sprintf(`SELECT EXISTS (SELECT 1 FROM %s WHERE public_id = $1)`, table)
+	ids := make([]string, 0, n)
+	seen := make(map[string]bool, n)
+	for retries := 0; len(ids) < n; {
+		id, err := generate()
+		if err != nil {
+			return nil, fmt.Errorf("failed generating ID at index %d: %w", len(ids), err)
+		}
+
+		var exists bool
+		if err := db.QueryRowContext(ctx, query, id).Scan(&exists); err != nil {
+			return nil, fmt.Errorf("failed to check ID %s: %w", id, err)
+		}
+		if exists || seen[id] {
+			if retries++; retries > maxCollisionRetries {
+				return nil, fmt.Errorf("too many collisions in %s, use a longer length or a larger alphabet", table)
+			}
+			continue
+		}
+
+		seen[id] = true
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
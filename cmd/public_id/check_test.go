@@ -0,0 +1,39 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hrz8/altalune/internal/testdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) { testdb.Main(m) }
+
+func TestGenerateUnusedIn(t *testing.T) {
+	ctx := context.Background()
+	db := testdb.Tx(t)
+	fixtures := testdb.Seed(t, db)
+
+	// The first candidate is taken, the second is free
+	fresh := testdb.Token(t)
+	candidates := []string{fixtures.ProjectPublicID, fresh}
+	ids, err := generateUnusedIn(ctx, db, "altalune_projects", len(fresh), 1, func() (string, error) {
+		id := candidates[0]
+		candidates = candidates[1:]
+		return id, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{fresh}, ids)
+
+	taken := func() (string, error) { return fixtures.ProjectPublicID, nil }
+	_, err = generateUnusedIn(ctx, db, "altalune_projects", len(fresh), 1, taken)
+	assert.ErrorContains(t, err, "too many collisions in altalune_projects")
+
+	_, err = generateUnusedIn(ctx, db, "altalune_projects", 1000, 1, taken)
+	assert.ErrorContains(t, err, "do not fit altalune_projects.public_id")
+
+	_, err = generateUnusedIn(ctx, db, "altalune_example_greeter_names", 14, 1, taken)
+	assert.EqualError(t, err, "table altalune_example_greeter_names has no public_id column")
+}
@@ -9,8 +9,13 @@ import (
 )
 
 var (
-	batch bool
-	count int
+	batch      bool
+	count      int
+	length     int
+	alphabet   string
+	prefix     string
+	checkTable string
+	configPath string
 )
 
 func main() {
@@ -19,24 +24,37 @@ func main() {
 		Short: "Generate Public IDs",
 		Long:  "Generate NanoID-based Public IDs in single or batch mode",
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateFlags(); err != nil {
+				return err
+			}
+
+			n := 1
 			if batch {
-				ids, err := nanoid.GeneratePublicIDBatch(count)
-				if err != nil {
-					return err
-				}
+				n = count
+			}
 
-				for _, id := range ids {
-					fmt.Println(id)
+			generate := func() (string, error) {
+				id, err := nanoid.GenerateString(alphabet, length)
+				if err != nil {
+					return "", err
 				}
-				return nil
+				return prefix + id, nil
 			}
 
-			id, err := nanoid.GeneratePublicID()
+			var ids []string
+			var err error
+			if checkTable != "" {
+				ids, err = generateUnused(cmd.Context(), n, generate)
+			} else {
+				ids, err = generateN(n, generate)
+			}
 			if err != nil {
 				return err
 			}
 
-			fmt.Println(id)
+			for _, id := range ids {
+				fmt.Println(id)
+			}
 			return nil
 		},
 	}
@@ -57,8 +75,87 @@ func main() {
 		"Number of IDs to generate (used with --batch)",
 	)
 
+	rootCmd.Flags().IntVarP(
+		&length,
+		"length",
+		"l",
+		nanoid.PublicIDSize,
+		"Number of random characters, excluding the prefix",
+	)
+
+	rootCmd.Flags().StringVarP(
+		&alphabet,
+		"alphabet",
+		"a",
+		nanoid.PublicIDAlphabet,
+		"Characters to generate IDs from",
+	)
+
+	rootCmd.Flags().StringVarP(
+		&prefix,
+		"prefix",
+		"p",
+		"",
+		"Prefix prepended to every ID, e.g. usr_",
+	)
+
+	rootCmd.Flags().StringVar(
+		&checkTable,
+		"check-table",
+		"",
+		"Only output IDs unused in the public_id column of this table (connects to the configured database)",
+	)
+
+	rootCmd.Flags().StringVar(
+		&configPath,
+		"config",
+		"config.yaml",
+		"Configuration file path (used with --check-table)",
+	)
+
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Println("Error:", err)
 		os.Exit(1)
 	}
 }
+
+func validateFlags() error {
+	if batch && count <= 0 {
+		return fmt.Errorf("count must be greater than 0")
+	}
+	if length <= 0 {
+		return fmt.Errorf("length must be greater than 0")
+	}
+	if len(alphabet) < 2 || len(alphabet) > 256 {
+		return fmt.Errorf("alphabet must have between 2 and 256 characters")
+	}
+	seen := make(map[rune]bool, len(alphabet))
+	for _, c := range alphabet {
+		if c > 127 {
+			return fmt.Errorf("alphabet must only contain ASCII characters")
+		}
+		if seen[c] {
+			return fmt.Errorf("alphabet contains %q more than once", c)
+		}
+		seen[c] = true
+	}
+	return nil
+}
+
+// generateN generates n distinct IDs.
+func generateN(n int, generate func() (string, error)) ([]string, error) {
+	ids := make([]string, 0, n)
+	seen := make(map[string]bool, n)
+	for len(ids) < n {
+		id, err := generate()
+		if err != nil {
+			return nil, fmt.Errorf("failed generating ID at index %d: %w", len(ids), err)
+		}
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hrz8/altalune/internal/shared/nanoid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setFlags sets the flag variables for one test, restoring them after it
+func setFlags(t *testing.T, b bool, c, l int, a string) {
+	t.Helper()
+
+	savedBatch, savedCount, savedLength, savedAlphabet := batch, count, length, alphabet
+	t.Cleanup(func() {
+		batch, count, length, alphabet = savedBatch, savedCount, savedLength, savedAlphabet
+	})
+	batch, count, length, alphabet = b, c, l, a
+}
+
+func TestValidateFlags(t *testing.T) {
+	tests := []struct {
+		name     string
+		batch    bool
+		count    int
+		length   int
+		alphabet string
+		wantErr  string
+	}{
+		{name: "defaults", count: 1, length: nanoid.PublicIDSize, alphabet: nanoid.PublicIDAlphabet},
+		{name: "count ignored without batch", count: 0, length: 8, alphabet: "ab"},
+		{name: "batch without count", batch: true, count: 0, length: 8, alphabet: "ab", wantErr: "count must be greater than 0"},
+		{name: "no length", count: 1, length: 0, alphabet: "ab", wantErr: "length must be greater than 0"},
+		{name: "single character", count: 1, length: 8, alphabet: "a", wantErr: "alphabet must have between 2 and 256 characters"},
+		{name: "non ASCII", count: 1, length: 8, alphabet: "aé", wantErr: "alphabet must only contain ASCII characters"},
+		{name: "repeated character", count: 1, length: 8, alphabet: "aba", wantErr: `alphabet contains 'a' more than once`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setFlags(t, tt.batch, tt.count, tt.length, tt.alphabet)
+
+			err := validateFlags()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestGenerateN(t *testing.T) {
+	i := 0
+	ids, err := generateN(3, func() (string, error) {
+		i++
+		return fmt.Sprintf("id%d", i/2), nil // every ID comes twice
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"id0", "id1", "id2"}, ids, "duplicates are regenerated")
+
+	failure := errors.New("no entropy")
+	_, err = generateN(1, func() (string, error) { return "", failure })
+	assert.ErrorIs(t, err, failure)
+}
+
+func TestGenerateUnusedInvalidTable(t *testing.T) {
+	saved := checkTable
+	t.Cleanup(func() { checkTable = saved })
+	checkTable = "users; DROP TABLE users"
+
+	_, err := generateUnused(t.Context(), 1, nil)
+	assert.EqualError(t, err, `invalid table name "users; DROP TABLE users"`, "rejected before connecting")
+}
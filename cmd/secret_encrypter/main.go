@@ -15,8 +15,16 @@ import (
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "rotate-key" {
+		if err := rotateKey(os.Args[2:]); err != nil {
+			log.Fatalf("key rotation failed: %v", err)
+		}
+		return
+	}
+
 	configPath := flag.String("config", "config.yaml", "Path to config file")
-	secretFlag := flag.String("secret", "", "Raw client secret (optional)")
+	secretFlag := flag.String("secret", "", "Raw client secret, or the encrypted one with --decrypt (optional)")
+	decrypt := flag.Bool("decrypt", false, "Decrypt an encrypted client secret instead of encrypting")
 	flag.Parse()
 
 	secret, err := resolveSecret(*secretFlag)
@@ -29,12 +37,21 @@ func main() {
 		log.Fatalf("failed to load config: %v", err)
 	}
 
-	key := cfg.GetIAMEncryptionKey()
-	if err := crypto.ValidateKey(key); err != nil {
+	keyring, err := crypto.NewKeyring(cfg.GetIAMEncryptionKey(), cfg.GetIAMPreviousEncryptionKeys()...)
+	if err != nil {
 		log.Fatalf("invalid encryption key: %v", err)
 	}
 
-	encrypted, err := crypto.Encrypt(secret, key)
+	if *decrypt {
+		plaintext, err := keyring.Decrypt(secret)
+		if err != nil {
+			log.Fatalf("failed to decrypt client secret: %v", err)
+		}
+		fmt.Println(plaintext)
+		return
+	}
+
+	encrypted, err := keyring.Encrypt(secret)
 	if err != nil {
 		log.Fatalf("failed to encrypt client secret: %v", err)
 	}
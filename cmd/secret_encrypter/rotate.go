@@ -0,0 +1,165 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+
+	"github.com/hrz8/altalune/internal/config"
+	"github.com/hrz8/altalune/internal/postgres"
+	"github.com/hrz8/altalune/internal/shared/crypto"
+)
+
+// rotateKey re-encrypts every OAuth provider client secret from the old key
+// to the new one in a single transaction, so a failure leaves all secrets
+// under the old key. Secrets already encrypted with the new key are skipped,
+// which makes an interrupted rotation safe to re-run.
+//
+// serve does the same on startup with security.iamPreviousKeys; this command
+// rotates without restarting and without listing the old key in the config.
+func rotateKey(args []string) error {
+	fs := flag.NewFlagSet("rotate-key", flag.ExitOnError)
+	configPath := fs.String("config", "config.yaml", "Path to config file")
+	oldKeyFlag := fs.String("old-key", "", "Base64 encryption key the secrets are currently encrypted with (required)")
+	newKeyFlag := fs.String("new-key", "", "Base64 encryption key to re-encrypt with (default: security.iamEncryptionKey)")
+	dryRun := fs.Bool("dry-run", false, "Check every secret decrypts with the old key without writing")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	oldKey, err := decodeKey(*oldKeyFlag)
+	if err != nil {
+		return fmt.Errorf("invalid --old-key: %w", err)
+	}
+	newKey := cfg.GetIAMEncryptionKey()
+	if *newKeyFlag != "" {
+		if newKey, err = decodeKey(*newKeyFlag); err != nil {
+			return fmt.Errorf("invalid --new-key: %w", err)
+		}
+	}
+	keyring, err := crypto.NewKeyring(newKey, oldKey)
+	if err != nil {
+		return fmt.Errorf("invalid new key: %w", err)
+	}
+
+	ctx := context.Background()
+	conn := postgres.MustConnect(postgres.ConnectionOptions{
+		URL:            cfg.GetDatabaseURL(),
+		MaxConnections: 1,
+		MaxIdleTime:    cfg.GetDatabaseMaxIdleTime(),
+		ConnectTimeout: cfg.GetDatabaseConnectTimeout(),
+	})
+	defer conn.Close()
+
+	tx, err := conn.GetDB().BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	rotated, skipped, err := rotateProviderSecrets(ctx, tx, keyring, *dryRun)
+	if err != nil {
+		return err
+	}
+
+	if *dryRun {
+		log.Printf("dry run: %d client secrets can be rotated, %d already use the new key", rotated, skipped)
+		return nil
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit: %w", err)
+	}
+	log.Printf("rotated %d client secrets, %d already used the new key", rotated, skipped)
+	return nil
+}
+
+// queryExecer is the part of a transaction rotateProviderSecrets needs
+type queryExecer interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// rotateProviderSecrets re-encrypts the client secret of every OAuth provider
+// with the current key of keyring, only checking they decrypt when dryRun is
+// set. It returns how many secrets were (or would be) rotated and how many
+// already used the current key.
+func rotateProviderSecrets(ctx context.Context, db queryExecer, keyring *crypto.Keyring, dryRun bool) (rotated, skipped int, err error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT id, provider_type, client_secret
+		FROM altalune_oauth_providers
+		ORDER BY id
+		FOR UPDATE
+	`)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to query oauth providers: %w", err)
+	}
+
+	type provider struct {
+		id           int64
+		providerType string
+		secret       string
+	}
+	var providers []provider
+	for rows.Next() {
+		var p provider
+		if err := rows.Scan(&p.id, &p.providerType, &p.secret); err != nil {
+			rows.Close()
+			return 0, 0, fmt.Errorf("failed to scan oauth provider: %w", err)
+		}
+		providers = append(providers, p)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, 0, fmt.Errorf("failed to iterate oauth providers: %w", err)
+	}
+
+	for _, p := range providers {
+		encrypted, changed, err := keyring.Reencrypt(p.secret)
+		if err != nil {
+			return 0, 0, fmt.Errorf("%s: client secret does not decrypt with the old or new key: %w", p.providerType, err)
+		}
+		if !changed {
+			log.Printf("%s: already encrypted with the new key, skipping", p.providerType)
+			skipped++
+			continue
+		}
+		if dryRun {
+			rotated++
+			continue
+		}
+
+		if _, err := db.ExecContext(ctx, `
+			UPDATE altalune_oauth_providers
+			SET client_secret = $1, updated_at = CURRENT_TIMESTAMP
+			WHERE id = $2
+		`, encrypted, p.id); err != nil {
+			return 0, 0, fmt.Errorf("%s: failed to update client secret: %w", p.providerType, err)
+		}
+		rotated++
+	}
+
+	return rotated, skipped, nil
+}
+
+func decodeKey(encoded string) ([]byte, error) {
+	if encoded == "" {
+		return nil, errors.New("key is required")
+	}
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+	if err := crypto.ValidateKey(key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
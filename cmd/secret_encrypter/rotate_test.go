@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+
+	"github.com/hrz8/altalune/internal/shared/crypto"
+	"github.com/hrz8/altalune/internal/testdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) { testdb.Main(m) }
+
+func randomKey(t *testing.T) []byte {
+	t.Helper()
+
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	return key
+}
+
+func TestDecodeKey(t *testing.T) {
+	key := randomKey(t)
+
+	got, err := decodeKey(base64.StdEncoding.EncodeToString(key))
+	require.NoError(t, err)
+	assert.Equal(t, key, got)
+
+	for _, encoded := range []string{"", "not base64!", base64.StdEncoding.EncodeToString(key[:16])} {
+		_, err := decodeKey(encoded)
+		assert.Error(t, err, encoded)
+	}
+}
+
+func TestRotateProviderSecrets(t *testing.T) {
+	ctx := context.Background()
+	db := testdb.Tx(t)
+	oldKey, newKey := randomKey(t), randomKey(t)
+	oldRing, err := crypto.NewKeyring(oldKey)
+	require.NoError(t, err)
+	rotation, err := crypto.NewKeyring(newKey, oldKey)
+	require.NoError(t, err)
+	newRing, err := crypto.NewKeyring(newKey)
+	require.NoError(t, err)
+
+	underOld, err := oldRing.Encrypt("google-secret")
+	require.NoError(t, err)
+	legacy, err := crypto.Encrypt("github-secret", oldKey) // before key IDs
+	require.NoError(t, err)
+	underNew, err := newRing.Encrypt("microsoft-secret")
+	require.NoError(t, err)
+
+	_, err = db.ExecContext(ctx, `DELETE FROM altalune_oauth_providers`)
+	require.NoError(t, err)
+	insert := func(providerType, secret string) {
+		t.Helper()
+		_, err := db.ExecContext(ctx, `
+			INSERT INTO altalune_oauth_providers (public_id, provider_type, client_id, client_secret, redirect_url)
+			VALUES ($1, $2, 'client', $3, 'http://localhost/callback')
+		`, testdb.Token(t), providerType, secret)
+		require.NoError(t, err)
+	}
+	insert("google", underOld)
+	insert("github", legacy)
+	insert("microsoft", underNew)
+
+	secrets := func() map[string]string {
+		t.Helper()
+		rows, err := db.QueryContext(ctx, `SELECT provider_type, client_secret FROM altalune_oauth_providers`)
+		require.NoError(t, err)
+		defer rows.Close()
+		got := make(map[string]string)
+		for rows.Next() {
+			var providerType, secret string
+			require.NoError(t, rows.Scan(&providerType, &secret))
+			got[providerType] = secret
+		}
+		require.NoError(t, rows.Err())
+		return got
+	}
+	before := secrets()
+
+	rotated, skipped, err := rotateProviderSecrets(ctx, db, rotation, true)
+	require.NoError(t, err)
+	assert.Equal(t, 2, rotated)
+	assert.Equal(t, 1, skipped)
+	assert.Equal(t, before, secrets(), "a dry run writes nothing")
+
+	rotated, skipped, err = rotateProviderSecrets(ctx, db, rotation, false)
+	require.NoError(t, err)
+	assert.Equal(t, 2, rotated)
+	assert.Equal(t, 1, skipped)
+	for providerType, secret := range secrets() {
+		plaintext, err := newRing.Decrypt(secret)
+		require.NoError(t, err, "%s decrypts with the new key alone", providerType)
+		assert.Equal(t, providerType+"-secret", plaintext)
+	}
+
+	rotated, skipped, err = rotateProviderSecrets(ctx, db, rotation, false)
+	require.NoError(t, err)
+	assert.Equal(t, 0, rotated, "an interrupted rotation can be re-run")
+	assert.Equal(t, 3, skipped)
+
+	foreign, err := crypto.Encrypt("apple-secret", randomKey(t))
+	require.NoError(t, err)
+	insert("apple", foreign)
+	_, _, err = rotateProviderSecrets(ctx, db, rotation, false)
+	assert.ErrorContains(t, err, "apple: client secret does not decrypt with the old or new key")
+}
@@ -13,6 +13,24 @@ type OAuthProviderConfig struct {
 	Enabled      bool
 }
 
+// BillingPlan is a plan organizations subscribe to. A limit of 0 is unlimited.
+type BillingPlan struct {
+	ID            string
+	Name          string
+	StripePriceID string // Stripe price of the subscriptions on the plan
+	MaxProjects   int    // Projects of an organization
+	MaxClients    int    // OAuth clients, billed to the default project
+	MaxMembers    int    // Members of a project
+}
+
+// CaptchaConfig is the bot challenge of the email sign-in and registration
+// forms of a project. An empty Provider disables the challenge.
+type CaptchaConfig struct {
+	Provider  string // turnstile, hcaptcha or recaptcha
+	SiteKey   string
+	SecretKey string
+}
+
 type Config interface {
 	// Server configuration
 	GetServerHost() string
@@ -21,23 +39,75 @@ type Config interface {
 	IsHTTPLoggingEnabled() bool
 	IsCORSEnabled() bool
 	GetServerReadTimeout() time.Duration
+	GetServerReadHeaderTimeout() time.Duration
 	GetServerWriteTimeout() time.Duration
 	GetServerIdleTimeout() time.Duration
 	GetServerCleanupTimeout() time.Duration
+	GetServerHandlerTimeout() time.Duration // Max duration of a single unary RPC
+	GetServerMaxRequestBytes() int64        // Max request body size in bytes
+	GetServerRateLimit() int                // Max API calls per minute per client IP, 0 when disabled
+	IsCompressionEnabled() bool             // gzip/deflate HTTP responses
+	GetServerBindHost() string              // Interface to listen on, empty for all
+	IsH2CEnabled() bool                     // HTTP/2 without TLS
+	GetServerTLSCertFile() string           // Empty unless TLS is enabled
+	GetServerTLSKeyFile() string            // Empty unless TLS is enabled
 
 	// Database configuration
 	GetDatabaseURL() string
 	GetDatabaseMaxConnections() int
 	GetDatabaseMaxIdleTime() time.Duration
 	GetDatabaseConnectTimeout() time.Duration
+	IsDatabaseRowLevelSecurityEnabled() bool
+	GetDatabaseStatementTimeout() time.Duration   // Server-side limit on a statement, 0 when disabled (default: 30s)
+	GetDatabaseSlowQueryThreshold() time.Duration // Statements running longer are logged, 0 when disabled (default: 500ms)
+	GetDatabaseCountMode() string                 // exact, estimated or none, unless the request picks one (default: exact)
+	GetDatabaseExactCountLimit() int64            // Estimated counts under it are made exact (default: 1000)
+	GetDatabaseMigrateOnStart() string            // off, wait or apply pending migrations before serving (default: off)
+	GetDatabaseMigrateWaitTimeout() time.Duration // How long serve waits for the expected schema (default: 5m)
 
 	// Security configuration
 	GetAllowedOrigins() []string
+	GetCORSAllowedOrigins() []string            // Origins allowed to call every project
+	GetCORSProjectOrigins() map[string][]string // Origins allowed to call only one project, by project public ID
+	IsCORSAllowCredentials() bool
+	GetCORSMaxAge() time.Duration // Preflight (Access-Control-Max-Age) cache duration
+
+	// Security headers configuration
+	GetHSTSMaxAge() time.Duration  // Strict-Transport-Security max-age, zero omits the header (default: 0)
+	IsHSTSIncludeSubdomains() bool // Apply HSTS to every subdomain (default: false)
+	IsHSTSPreload() bool           // Allow inclusion in browser HSTS preload lists (default: false)
+	GetFrameOptions() string       // X-Frame-Options (default: DENY)
+	GetReferrerPolicy() string     // Referrer-Policy (default: strict-origin-when-cross-origin)
+	GetAuthServerCSP() string      // Content-Security-Policy of the auth server, "{nonce}" is replaced per request (empty = none)
+	GetDashboardCSP() string       // Content-Security-Policy of the API server and dashboard (empty = none)
+	IsCSPReportOnly() bool         // Send policies as Content-Security-Policy-Report-Only (default: false)
+
+	// Cookie configuration (auth server session and dashboard token cookies)
+	IsCookieSecure() bool      // Only send cookies over HTTPS; with no domain and path "/" they get the __Host- prefix (default: false)
+	GetCookieSameSite() string // lax, strict or none (default: lax)
+	GetCookieDomain() string   // Domain whose subdomains share the cookies (empty = this host only)
+	GetCookiePath() string     // Path cookies are sent for (default: /)
+
+	// Reverse proxies in front of the servers
+	GetTrustedProxies() []string // IPs or CIDR ranges whose X-Forwarded-For and X-Forwarded-Proto are believed (default: none)
 
 	// IAM encryption configuration
 	// GetIAMEncryptionKey returns the 32-byte encryption key for IAM secrets
 	// This key is used to encrypt/decrypt OAuth client secrets
 	GetIAMEncryptionKey() []byte
+	// GetIAMPreviousEncryptionKeys returns the keys rotated out of
+	// GetIAMEncryptionKey, still accepted to decrypt secrets not yet re-encrypted
+	GetIAMPreviousEncryptionKeys() [][]byte
+
+	// Password hashing configuration (Argon2id cost of new hashes; hashes made
+	// with other parameters are re-hashed when next verified)
+	GetPasswordHashIterations() uint32 // Time cost (default: 2)
+	GetPasswordHashMemory() uint32     // Memory cost in KiB (default: 65536)
+	GetPasswordHashThreads() uint8     // Parallelism (default: 4)
+	GetPasswordHashLength() uint32     // Hash length in bytes (default: 32)
+	// GetClientSecretCacheTTL is how long a verified client secret is accepted
+	// again without Argon2id, zero verifies every time (default: 0)
+	GetClientSecretCacheTTL() time.Duration
 
 	// JWT configuration
 	GetJWTPrivateKeyPath() string
@@ -48,11 +118,29 @@ type Config interface {
 	// Auth configuration
 	GetAuthHost() string
 	GetAuthPort() int
+	GetAuthBindHost() string
+	IsAuthEmbedded() bool // Whether `serve` also runs the auth server on its own listener
 	GetSessionSecret() string
+	GetSessionIdleTimeout() int // Seconds without a request after which a session ends, 0 = never (default: 1800)
+	GetSessionLifetime() int    // Seconds after sign-in after which a session ends whatever the activity (default: 36000)
 	GetCodeExpiry() int
 	GetAccessTokenExpiry() int
 	GetRefreshTokenExpiry() int
-	IsAutoActivate() bool // Whether new users are automatically activated (default: true)
+	IsAutoActivate() bool                // Whether new users are automatically activated (default: true)
+	GetAuthDefaultLocale() string        // Auth page locale when Accept-Language matches no catalog (default: en)
+	GetLockoutMaxAttempts() int          // Failed sign-ins in a row before the account is locked (default: 5)
+	GetLockoutDuration() int             // Account lock duration in seconds (default: 900)
+	IsAuthHostIssuer() bool              // Whether requests on a project hostname get that hostname as issuer (default: false)
+	IsRememberMeEnabled() bool           // Whether the sign-in pages offer "keep me signed in" (default: false)
+	GetRememberMeLifetime() int          // How long a remembered device stays signed in, in seconds (default: 2592000)
+	GetAuthServiceDocumentation() string // Developer documentation URL advertised by discovery (empty = none)
+	GetAuthPolicyURI() string            // Relying party data policy URL advertised by discovery (empty = none)
+	GetAuthTOSURI() string               // Terms of service URL advertised by discovery (empty = none)
+
+	// Bot challenge configuration
+	GetCaptcha(projectID string) CaptchaConfig // Challenge of the pages of a project, empty project ID for the default one
+	GetCaptchaProviders() []string             // Every provider in use, default and per project, sorted
+	GetCaptchaVerifyTimeout() time.Duration    // How long to wait for the provider verification (default: 5s)
 
 	// Seeder configuration
 	GetSuperadminEmail() string
@@ -87,6 +175,102 @@ type Config interface {
 	GetDashboardBrandingName() string
 	GetAuthServerBrandingName() string
 
+	// Frontend configuration
+	IsFrontendEnabled() bool // Whether the API server serves the dashboard SPA (default: true)
+	GetFrontendDir() string  // Filesystem directory to serve instead of the embedded build (empty = embedded)
+
+	// Trash configuration (soft-deleted records)
+	GetTrashRetentionDays() int        // Days a deleted record stays restorable before it is purged (default: 30)
+	GetTrashPurgeIntervalMinutes() int // Minutes between purge runs (default: 60)
+
+	// Query export configuration (CSV files of Query results)
+	GetQueryExportDir() string     // Directory export files are kept in; shared by every server when several run (default: data/exports)
+	GetQueryExportWorkers() int    // Exports generated at once per server (default: 2)
+	GetQueryExportMaxRows() int32  // Exports matching more rows fail (default: 1000000)
+	GetQueryExportMaxBytes() int64 // Exports growing larger fail, in bytes (default: 256 MB)
+
+	// Digest configuration (periodic activity summaries for project owners)
+	IsDigestEnabled() bool      // Whether owners are emailed digests; needs an email provider (default: false)
+	GetDigestFrequency() string // daily or weekly (default: daily)
+
+	// Token stats configuration (hourly token endpoint counts per OAuth client)
+	GetTokenStatsRetentionDays() int      // Days hourly counts are kept (default: 90)
+	IsTokenAlertsEnabled() bool           // Whether unusual hours are alerted on (default: false)
+	GetTokenAlertMinRequests() int        // Requests of an hour below which a client is not checked (default: 20)
+	GetTokenAlertMaxFailureRate() float64 // Failed share of the requests above which to alert (default: 0.5)
+	GetTokenAlertSpikeFactor() float64    // Multiple of the baseline hourly requests that alerts (default: 5)
+	GetTokenAlertBaselineHours() int      // Hours the baseline averages (default: 24)
+	GetTokenAlertWebhookURL() string      // Endpoint alerts are posted to, empty for none
+	GetTokenAlertEmails() []string        // Recipients of alert emails
+
+	// Usage configuration (API calls per project and monthly quotas)
+	IsUsageMeteringEnabled() bool         // Whether calls are counted and quotas enforced (default: false)
+	GetUsageFlushInterval() time.Duration // How long counts are kept in memory before they are stored (default: 1m)
+	GetUsageRetentionDays() int           // Days hourly counts are kept (default: 400)
+
+	// Billing configuration (Stripe subscriptions of organizations and their plan limits)
+	IsBillingEnabled() bool            // Whether plan limits are enforced and Stripe webhooks received (default: false)
+	GetStripeSecretKey() string        // Secret API key opening customer portal sessions
+	GetStripeWebhookSecret() string    // Signing secret of the webhook endpoint
+	GetBillingPortalReturnURL() string // Where the customer portal links back to by default
+	GetBillingDefaultPlan() string     // Plan without an active subscription (default: free)
+	GetBillingPlans() []BillingPlan    // Plans in configuration order
+
+	// Maintenance configuration (mutating RPCs and auth pages answer 503)
+	IsMaintenanceEnabled() bool                // Start in maintenance whatever the database switch says (default: false)
+	GetMaintenanceMessage() string             // Shown to clients unless the database switch sets one
+	GetMaintenanceRetryAfter() time.Duration   // Retry-After of 503 responses (default: 5m)
+	GetMaintenancePollInterval() time.Duration // How long the database switch is cached (default: 10s)
+	GetMaintenanceBypassTokens() []string      // Tokens sent in X-Maintenance-Bypass that skip maintenance
+
+	// Metrics configuration (Prometheus metrics on /metrics of the API server)
+	IsMetricsEnabled() bool  // Whether /metrics is served (default: false)
+	GetMetricsToken() string // Bearer token scrapers must send, empty for none
+
+	// Feature flag configuration (flags are toggled through the FeatureFlagService)
+	GetFeatureFlagRefreshInterval() time.Duration // How long flags are cached before changes made on other replicas are seen (default: 30s)
+
+	// Redis configuration (optional shared store for rate limits, sessions and caches)
+	IsRedisEnabled() bool               // Whether to connect to Redis; false keeps that state per process (default: false)
+	GetRedisAddr() string               // host:port of the Redis server (default: localhost:6379)
+	GetRedisUsername() string           // ACL username, empty for password-only auth
+	GetRedisPassword() string           // Password, empty when auth is disabled
+	GetRedisDB() int                    // Logical database index (default: 0)
+	IsRedisTLS() bool                   // Connect over TLS (default: false)
+	GetRedisPoolSize() int              // Max open connections per replica (default: 10)
+	GetRedisDialTimeout() time.Duration // Connect timeout (default: 5s)
+	GetRedisIOTimeout() time.Duration   // Per-command timeout (default: 3s)
+	GetRedisKeyPrefix() string          // Prepended to every key (default: "altalune:")
+
+	// Logging configuration (the default level is GetServerLogLevel)
+	GetLogFormat() string                  // console or json (default: console)
+	GetLogModuleLevels() map[string]string // Level overrides per module
+	GetLogSampling() map[string]int        // Request path -> log 1 in N HTTP requests
+
+	// Error report configuration (unexpected errors sent to an error tracker)
+	GetErrorReportDSN() string         // Sentry DSN, empty when reporting is disabled
+	GetErrorReportEnvironment() string // Environment the errors are tagged with
+
+	// Domain events configuration (side effects of sign-ups and consents)
+	GetEventWebhookURL() string    // Endpoint every domain event is posted to, empty for none
+	GetEventWebhookSecret() string // Key signing the webhook bodies, empty for unsigned
+
+	// Email validation configuration (addresses the servers send emails to)
+	IsEmailMXCheckEnabled() bool      // Reject domains without a mail server (default: false)
+	GetEmailMXTimeout() time.Duration // DNS wait before accepting the address (default: 3s)
+	IsDisposableEmailBlocked() bool   // Reject the built-in disposable inbox providers (default: false)
+	GetBlockedEmailDomains() []string // Rejected besides the built-in list
+	GetAllowedEmailDomains() []string // Never rejected
+	GetEmailBlocklistFile() string    // File of rejected domains, empty for none
+
+	// ACME configuration (automatic TLS certificates, e.g. Let's Encrypt)
+	IsACMEEnabled() bool         // Whether the servers get their certificates from the ACME CA (default: false)
+	GetACMEDomains() []string    // Hostnames certificates may be requested for
+	GetACMEEmail() string        // Account contact, empty for none
+	GetACMECacheDir() string     // Directory storing account keys and certificates (default: ".acme")
+	GetACMEDirectoryURL() string // ACME directory, empty for Let's Encrypt production
+	GetACMEHTTPPort() int        // HTTP-01 challenge and HTTPS redirect port, 0 when disabled (default: 80)
+
 	// Auth Validation configuration (resource server JWT validation)
 	GetAuthValidationJWKSURL() string
 	GetAuthValidationJWKSCacheTTL() int
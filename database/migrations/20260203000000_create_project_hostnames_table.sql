@@ -0,0 +1,49 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- =============================================================================
+-- PROJECT HOSTNAMES (GLOBAL)
+-- =============================================================================
+-- Custom hostnames a project serves its auth pages from. The auth server
+-- resolves the request Host against this table to white-label the login and
+-- consent pages (branding) and to pick a default OAuth client for the tenant.
+-- Not partitioned: lookups happen by hostname before any project is known.
+-- =============================================================================
+CREATE TABLE IF NOT EXISTS altalune_project_hostnames (
+  id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  public_id VARCHAR(20) NOT NULL,
+  project_id BIGINT NOT NULL,
+  hostname VARCHAR(253) NOT NULL,
+  branding_name VARCHAR(100),
+  logo_url VARCHAR(500),
+  primary_color VARCHAR(7),
+  default_oauth_client_id BIGINT,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT fk_altalune_project_hostnames_project_id
+    FOREIGN KEY (project_id) REFERENCES altalune_projects (id)
+    ON DELETE CASCADE ON UPDATE CASCADE,
+  CONSTRAINT fk_altalune_project_hostnames_default_oauth_client_id
+    FOREIGN KEY (default_oauth_client_id) REFERENCES altalune_oauth_clients (id)
+    ON DELETE SET NULL ON UPDATE CASCADE,
+  CONSTRAINT chk_altalune_project_hostnames_hostname_lower
+    CHECK (hostname = LOWER(hostname)),
+  CONSTRAINT chk_altalune_project_hostnames_primary_color
+    CHECK (primary_color IS NULL OR primary_color ~ '^#[0-9a-fA-F]{6}$')
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS ux_altalune_project_hostnames_public_id
+  ON altalune_project_hostnames (public_id);
+
+-- A hostname can only ever belong to one project
+CREATE UNIQUE INDEX IF NOT EXISTS ux_altalune_project_hostnames_hostname
+  ON altalune_project_hostnames (hostname);
+
+CREATE INDEX IF NOT EXISTS idx_altalune_project_hostnames_project_id
+  ON altalune_project_hostnames (project_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS altalune_project_hostnames;
+-- +goose StatementEnd
@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- =============================================================================
+-- PROJECT BRANDING (GLOBAL, ONE ROW PER PROJECT)
+-- =============================================================================
+-- Branding applied to the auth pages of every project hostname: an uploaded
+-- logo, a primary color and custom footer links. Hostname-level branding
+-- (altalune_project_hostnames) takes precedence over these values.
+-- The logo is stored inline; it is small (max 512 KiB) and served by the auth
+-- server with long-lived caching, versioned by logo_updated_at.
+-- =============================================================================
+CREATE TABLE IF NOT EXISTS altalune_project_branding (
+  project_id BIGINT PRIMARY KEY,
+  primary_color VARCHAR(7),
+  logo BYTEA,
+  logo_content_type VARCHAR(50),
+  logo_updated_at TIMESTAMPTZ,
+  footer_links JSONB NOT NULL DEFAULT '[]',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT fk_altalune_project_branding_project_id
+    FOREIGN KEY (project_id) REFERENCES altalune_projects (id)
+    ON DELETE CASCADE ON UPDATE CASCADE,
+  CONSTRAINT chk_altalune_project_branding_primary_color
+    CHECK (primary_color IS NULL OR primary_color ~ '^#[0-9a-fA-F]{6}$'),
+  CONSTRAINT chk_altalune_project_branding_logo
+    CHECK ((logo IS NULL) = (logo_content_type IS NULL))
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS altalune_project_branding;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Locale of the project's auth pages when the browser's Accept-Language
+-- matches no supported locale. NULL falls back to auth.defaultLocale.
+ALTER TABLE altalune_project_branding
+ADD COLUMN default_locale VARCHAR(10);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE altalune_project_branding
+DROP COLUMN default_locale;
+-- +goose StatementEnd
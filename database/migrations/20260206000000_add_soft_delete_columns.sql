@@ -0,0 +1,53 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- =============================================================================
+-- SOFT DELETE
+-- =============================================================================
+-- Deleting a user, OAuth client, API key or employee only sets deleted_at; the
+-- row can be restored until the janitor hard-deletes it once the trash
+-- retention period has passed. Trashed rows keep their unique values (emails,
+-- client IDs, keys) so restoring never conflicts with a live row.
+-- =============================================================================
+ALTER TABLE altalune_users
+ADD COLUMN deleted_at TIMESTAMPTZ;
+
+ALTER TABLE altalune_oauth_clients
+ADD COLUMN deleted_at TIMESTAMPTZ;
+
+ALTER TABLE altalune_project_api_keys
+ADD COLUMN deleted_at TIMESTAMPTZ;
+
+ALTER TABLE altalune_example_employees
+ADD COLUMN deleted_at TIMESTAMPTZ;
+
+-- Partial indexes for trash listings and the janitor
+CREATE INDEX IF NOT EXISTS ix_altalune_users_deleted_at
+  ON altalune_users (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS ix_altalune_oauth_clients_deleted_at
+  ON altalune_oauth_clients (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS ix_altalune_project_api_keys_deleted_at
+  ON altalune_project_api_keys (project_id, deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS ix_altalune_example_employees_deleted_at
+  ON altalune_example_employees (project_id, deleted_at) WHERE deleted_at IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS ix_altalune_example_employees_deleted_at;
+DROP INDEX IF EXISTS ix_altalune_project_api_keys_deleted_at;
+DROP INDEX IF EXISTS ix_altalune_oauth_clients_deleted_at;
+DROP INDEX IF EXISTS ix_altalune_users_deleted_at;
+
+ALTER TABLE altalune_example_employees
+DROP COLUMN deleted_at;
+
+ALTER TABLE altalune_project_api_keys
+DROP COLUMN deleted_at;
+
+ALTER TABLE altalune_oauth_clients
+DROP COLUMN deleted_at;
+
+ALTER TABLE altalune_users
+DROP COLUMN deleted_at;
+-- +goose StatementEnd
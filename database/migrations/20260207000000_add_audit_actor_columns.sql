@@ -0,0 +1,54 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- =============================================================================
+-- ROW-LEVEL AUDIT
+-- =============================================================================
+-- created_by / updated_by hold the public ID of the user who created or last
+-- modified a row. They are NULL for rows written outside of an authenticated
+-- request (migrations, seeders, CLI). Public IDs are stored instead of foreign
+-- keys so the audit trail survives the user being purged.
+-- =============================================================================
+ALTER TABLE altalune_projects
+ADD COLUMN created_by VARCHAR(20),
+ADD COLUMN updated_by VARCHAR(20);
+
+ALTER TABLE altalune_oauth_clients
+ADD COLUMN created_by VARCHAR(20),
+ADD COLUMN updated_by VARCHAR(20);
+
+ALTER TABLE altalune_project_api_keys
+ADD COLUMN created_by VARCHAR(20),
+ADD COLUMN updated_by VARCHAR(20);
+
+ALTER TABLE altalune_roles
+ADD COLUMN created_by VARCHAR(20),
+ADD COLUMN updated_by VARCHAR(20);
+
+ALTER TABLE altalune_permissions
+ADD COLUMN created_by VARCHAR(20),
+ADD COLUMN updated_by VARCHAR(20);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE altalune_permissions
+DROP COLUMN updated_by,
+DROP COLUMN created_by;
+
+ALTER TABLE altalune_roles
+DROP COLUMN updated_by,
+DROP COLUMN created_by;
+
+ALTER TABLE altalune_project_api_keys
+DROP COLUMN updated_by,
+DROP COLUMN created_by;
+
+ALTER TABLE altalune_oauth_clients
+DROP COLUMN updated_by,
+DROP COLUMN created_by;
+
+ALTER TABLE altalune_projects
+DROP COLUMN updated_by,
+DROP COLUMN created_by;
+-- +goose StatementEnd
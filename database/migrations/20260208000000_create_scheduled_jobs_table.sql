@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- =============================================================================
+-- SCHEDULED JOBS
+-- =============================================================================
+-- One row per background job, holding the latest occurrence that was claimed
+-- by a replica. Replicas run the same schedules; an occurrence only runs on
+-- the replica that moves last_run_at forward, so it runs exactly once.
+-- =============================================================================
+CREATE TABLE IF NOT EXISTS altalune_scheduled_jobs (
+  name VARCHAR(100) PRIMARY KEY,
+  last_run_at TIMESTAMPTZ NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS altalune_scheduled_jobs;
+-- +goose StatementEnd
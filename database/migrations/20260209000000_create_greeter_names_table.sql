@@ -0,0 +1,52 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- =============================================================================
+-- GREETER ALLOWED NAMES (EXAMPLE)
+-- =============================================================================
+-- Names the greeter example service is allowed to greet, managed through the
+-- GreeterService CRUD RPCs. Seeded with the names that used to be hardcoded.
+-- =============================================================================
+CREATE TABLE IF NOT EXISTS altalune_example_greeter_names (
+  id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  name VARCHAR(50) NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT ux_altalune_example_greeter_names_name UNIQUE (name)
+);
+
+INSERT INTO altalune_example_greeter_names (name)
+VALUES
+    ('Alina'), ('Bryce'), ('Carmen'), ('Darius'), ('Elena'),
+    ('Felix'), ('Gianna'), ('Hassan'), ('Irene'), ('Jasper'),
+    ('Kiana'), ('Luther'), ('Maya'), ('Nolan'), ('Orlando'),
+    ('Priya'), ('Quincy'), ('Rafael'), ('Sienna'), ('Tobias'),
+    ('Umair'), ('Vera'), ('Wesley'), ('Xavier'), ('Yasmin'),
+    ('Zane'), ('Adriana'), ('Bennett'), ('Clarissa'), ('Devonte'),
+    ('Estella'), ('Finnegan'), ('Gracelyn'), ('Harvey'), ('Isidora'),
+    ('Jovani'), ('Katarina'), ('Leonidas'), ('Mirella'), ('Nikolas'),
+    ('Octavia'), ('Percival'), ('Quintessa'), ('Romero'), ('Salvador'),
+    ('Theodora'), ('Ulrich'), ('Valeria'), ('Winslow'), ('Xiomara'),
+    ('Yuridia'), ('Zephyrus'), ('Aurelius'), ('Bellatrix'), ('Caspian'),
+    ('Demetrius'), ('Evangeline'), ('Florentino'), ('Galadriel'), ('Hermione'),
+    ('Ignatius'), ('Julianna'), ('Kristoffer'), ('Lysandra'), ('Maximiliano'),
+    ('Nefertari'), ('Olivander'), ('Philomena'), ('Quetzalcoatl'), ('Rhiannon'),
+    ('Sebastiana'), ('Thessalonia'), ('Ulyssiana'), ('Vladimir'), ('Wilhelmina'),
+    ('Xenophilius'), ('Yggdrasila'), ('Zaphkiel'), ('Alejandrina'), ('Balthazar'),
+    ('Christabelle'), ('Domenico'), ('Euphrosyne'), ('Featherstone'), ('Gwendolyn'),
+    ('Hyacinthus'), ('Isambard'), ('Jacqueline'), ('Kallistrate'), ('Leontius'),
+    ('Marcellinus'), ('Nicomachus'), ('Ozymandias'), ('Petronella'), ('Quintilius'),
+    ('Rosencrantz'), ('Seraphimiel'), ('Timotheus'), ('Ultraviolet'), ('Valentinian')
+ON CONFLICT (name) DO NOTHING;
+
+-- Managing the names requires greeter:write; greeting stays public
+INSERT INTO altalune_permissions (public_id, name, description)
+VALUES (lower(substring(md5(random()::text) from 1 for 14)), 'greeter:write', 'Manage the names allowed by the greeter example')
+ON CONFLICT (name) DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM altalune_permissions WHERE name = 'greeter:write';
+DROP TABLE IF EXISTS altalune_example_greeter_names;
+-- +goose StatementEnd
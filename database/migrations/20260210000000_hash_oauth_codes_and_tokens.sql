@@ -0,0 +1,99 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Authorization codes and refresh tokens are only stored as the hex SHA-256
+-- of their string form, so a leaked database does not expose live
+-- credentials. Existing rows are hashed in place and stay usable.
+ALTER TABLE altalune_oauth_authorization_codes
+  ADD COLUMN IF NOT EXISTS code_hash CHAR(64);
+
+UPDATE altalune_oauth_authorization_codes
+  SET code_hash = encode(sha256(convert_to(code::text, 'UTF8')), 'hex')
+  WHERE code_hash IS NULL;
+
+ALTER TABLE altalune_oauth_authorization_codes
+  ALTER COLUMN code_hash SET NOT NULL;
+
+-- Dropping the column drops its indexes and unique constraint
+ALTER TABLE altalune_oauth_authorization_codes
+  DROP COLUMN IF EXISTS code;
+
+CREATE UNIQUE INDEX IF NOT EXISTS ux_oauth_authorization_codes_code_hash
+  ON altalune_oauth_authorization_codes (code_hash);
+
+-- Partial index for active (non-exchanged) codes only
+CREATE INDEX IF NOT EXISTS idx_oauth_authorization_codes_code_hash_active
+  ON altalune_oauth_authorization_codes (code_hash)
+  WHERE exchange_at IS NULL;
+
+ALTER TABLE altalune_oauth_refresh_tokens
+  ADD COLUMN IF NOT EXISTS token_hash CHAR(64);
+
+UPDATE altalune_oauth_refresh_tokens
+  SET token_hash = encode(sha256(convert_to(token::text, 'UTF8')), 'hex')
+  WHERE token_hash IS NULL;
+
+ALTER TABLE altalune_oauth_refresh_tokens
+  ALTER COLUMN token_hash SET NOT NULL;
+
+ALTER TABLE altalune_oauth_refresh_tokens
+  DROP COLUMN IF EXISTS token;
+
+CREATE UNIQUE INDEX IF NOT EXISTS ux_oauth_refresh_tokens_token_hash
+  ON altalune_oauth_refresh_tokens (token_hash);
+
+-- Partial index for active (non-exchanged) tokens only
+CREATE INDEX IF NOT EXISTS idx_oauth_refresh_tokens_token_hash_active
+  ON altalune_oauth_refresh_tokens (token_hash)
+  WHERE exchange_at IS NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+-- The raw values cannot be recovered from their hashes: rows get random ones,
+-- which invalidates every outstanding authorization code and refresh token
+ALTER TABLE altalune_oauth_refresh_tokens
+  ADD COLUMN IF NOT EXISTS token UUID;
+
+UPDATE altalune_oauth_refresh_tokens
+  SET token = gen_random_uuid()
+  WHERE token IS NULL;
+
+ALTER TABLE altalune_oauth_refresh_tokens
+  ALTER COLUMN token SET NOT NULL,
+  ADD CONSTRAINT altalune_oauth_refresh_tokens_token_key UNIQUE (token);
+
+ALTER TABLE altalune_oauth_refresh_tokens
+  DROP COLUMN IF EXISTS token_hash;
+
+CREATE UNIQUE INDEX IF NOT EXISTS ux_oauth_refresh_tokens_token
+  ON altalune_oauth_refresh_tokens (token);
+
+CREATE INDEX IF NOT EXISTS idx_oauth_refresh_tokens_token_active
+  ON altalune_oauth_refresh_tokens (token)
+  WHERE exchange_at IS NULL;
+
+ALTER TABLE altalune_oauth_authorization_codes
+  ADD COLUMN IF NOT EXISTS code UUID;
+
+UPDATE altalune_oauth_authorization_codes
+  SET code = gen_random_uuid()
+  WHERE code IS NULL;
+
+ALTER TABLE altalune_oauth_authorization_codes
+  ALTER COLUMN code SET NOT NULL,
+  ADD CONSTRAINT altalune_oauth_authorization_codes_code_key UNIQUE (code);
+
+ALTER TABLE altalune_oauth_authorization_codes
+  DROP COLUMN IF EXISTS code_hash;
+
+CREATE UNIQUE INDEX IF NOT EXISTS ux_oauth_authorization_codes_code
+  ON altalune_oauth_authorization_codes (code);
+
+CREATE INDEX IF NOT EXISTS idx_oauth_authorization_codes_code_active
+  ON altalune_oauth_authorization_codes (code)
+  WHERE exchange_at IS NULL;
+
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Per-project onboarding policy for self-registered users. NULL inherits the
+-- auth server configuration (registration context role, auth.autoActivate).
+ALTER TABLE altalune_projects
+  ADD COLUMN IF NOT EXISTS default_member_role VARCHAR(20),
+  ADD COLUMN IF NOT EXISTS auto_activate BOOLEAN;
+
+-- Owners are reserved for the superadmin and are never granted on sign-up
+ALTER TABLE altalune_projects
+  ADD CONSTRAINT chk_projects_default_member_role
+  CHECK (default_member_role IN ('admin', 'member', 'user'));
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE altalune_projects
+  DROP CONSTRAINT IF EXISTS chk_projects_default_member_role;
+
+ALTER TABLE altalune_projects
+  DROP COLUMN IF EXISTS auto_activate,
+  DROP COLUMN IF EXISTS default_member_role;
+
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Set when a self-registered user is created inactive and waits for a project
+-- owner to approve or reject the registration. Users deactivated by an admin
+-- never have it, so they stay out of the approval queue.
+ALTER TABLE altalune_users
+  ADD COLUMN IF NOT EXISTS pending_approval_since TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_altalune_users_pending_approval
+  ON altalune_users (pending_approval_since)
+  WHERE pending_approval_since IS NOT NULL AND deleted_at IS NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS idx_altalune_users_pending_approval;
+
+ALTER TABLE altalune_users
+  DROP COLUMN IF EXISTS pending_approval_since;
+
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Account lockout: failed sign-in attempts count towards a temporary lock,
+-- whatever the sign-in method. The count resets on success and when it locks.
+ALTER TABLE altalune_users
+  ADD COLUMN IF NOT EXISTS failed_login_attempts INT NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS locked_until TIMESTAMPTZ;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE altalune_users
+  DROP COLUMN IF EXISTS locked_until,
+  DROP COLUMN IF EXISTS failed_login_attempts;
+
+-- +goose StatementEnd
@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Service accounts are users that automation runs as: they own API keys and
+-- receive roles and permissions like humans do, but have no email and so can
+-- never sign in interactively.
+ALTER TABLE altalune_users
+  ADD COLUMN IF NOT EXISTS user_type VARCHAR(20) NOT NULL DEFAULT 'human',
+  ALTER COLUMN email DROP NOT NULL,
+  ADD CONSTRAINT chk_users_user_type CHECK (user_type IN ('human', 'service_account')),
+  ADD CONSTRAINT chk_users_email_by_type CHECK ((user_type = 'human') = (email IS NOT NULL));
+
+CREATE INDEX IF NOT EXISTS idx_users_user_type ON altalune_users (user_type);
+
+-- Public ID of the service account an API key belongs to, NULL for keys that
+-- belong to the project only
+ALTER TABLE altalune_project_api_keys
+  ADD COLUMN IF NOT EXISTS owner_id VARCHAR(20);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE altalune_project_api_keys
+  DROP COLUMN IF EXISTS owner_id;
+
+DELETE FROM altalune_users WHERE user_type = 'service_account';
+
+DROP INDEX IF EXISTS idx_users_user_type;
+
+ALTER TABLE altalune_users
+  DROP CONSTRAINT IF EXISTS chk_users_email_by_type,
+  DROP CONSTRAINT IF EXISTS chk_users_user_type,
+  ALTER COLUMN email SET NOT NULL,
+  DROP COLUMN IF EXISTS user_type;
+
+-- +goose StatementEnd
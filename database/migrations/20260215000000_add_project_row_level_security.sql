@@ -0,0 +1,64 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Project of the current session, NULL unless the server scoped the
+-- connection to a project (database.rowLevelSecurity)
+CREATE OR REPLACE FUNCTION altalune_current_project() RETURNS BIGINT
+  LANGUAGE sql STABLE
+  AS $$ SELECT NULLIF(current_setting('app.current_project', true), '')::BIGINT $$;
+
+-- Rows of project-scoped tables are only visible to, and writable by, sessions
+-- scoped to their project; unscoped sessions (migrations, background jobs,
+-- lookups across projects) see every row. Forcing the policies makes them
+-- apply to the table owner too, which the server usually connects as.
+-- Superusers and roles with BYPASSRLS are never subject to them.
+DO $$
+DECLARE
+  t TEXT;
+BEGIN
+  FOREACH t IN ARRAY ARRAY[
+    'altalune_example_employees',
+    'altalune_project_api_keys',
+    'altalune_project_hostnames',
+    'altalune_project_branding',
+    'altalune_chatbot_configs',
+    'altalune_chatbot_nodes'
+  ] LOOP
+    EXECUTE format('DROP POLICY IF EXISTS project_isolation ON %I', t);
+    EXECUTE format(
+      'CREATE POLICY project_isolation ON %I
+         USING (altalune_current_project() IS NULL OR project_id = altalune_current_project())
+         WITH CHECK (altalune_current_project() IS NULL OR project_id = altalune_current_project())',
+      t
+    );
+    EXECUTE format('ALTER TABLE %I ENABLE ROW LEVEL SECURITY', t);
+    EXECUTE format('ALTER TABLE %I FORCE ROW LEVEL SECURITY', t);
+  END LOOP;
+END $$;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DO $$
+DECLARE
+  t TEXT;
+BEGIN
+  FOREACH t IN ARRAY ARRAY[
+    'altalune_example_employees',
+    'altalune_project_api_keys',
+    'altalune_project_hostnames',
+    'altalune_project_branding',
+    'altalune_chatbot_configs',
+    'altalune_chatbot_nodes'
+  ] LOOP
+    EXECUTE format('ALTER TABLE %I NO FORCE ROW LEVEL SECURITY', t);
+    EXECUTE format('ALTER TABLE %I DISABLE ROW LEVEL SECURITY', t);
+    EXECUTE format('DROP POLICY IF EXISTS project_isolation ON %I', t);
+  END LOOP;
+END $$;
+
+DROP FUNCTION IF EXISTS altalune_current_project();
+
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- =============================================================================
+-- MAINTENANCE
+-- =============================================================================
+-- Single row holding the maintenance switch every replica polls, so that
+-- `altalune maintenance on` puts all of them in maintenance without a restart.
+-- =============================================================================
+CREATE TABLE IF NOT EXISTS altalune_maintenance (
+  id BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (id),
+  enabled BOOLEAN NOT NULL DEFAULT FALSE,
+  message TEXT NOT NULL DEFAULT '',
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS altalune_maintenance;
+-- +goose StatementEnd
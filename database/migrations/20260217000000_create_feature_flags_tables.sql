@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- =============================================================================
+-- FEATURE FLAGS
+-- =============================================================================
+-- Configuration of the flags declared in internal/featureflag. A flag without
+-- a row keeps its declared default. A project override wins over the rollout,
+-- which turns the flag on for rollout_percentage percent of the projects.
+-- =============================================================================
+CREATE TABLE IF NOT EXISTS altalune_feature_flags (
+  name VARCHAR(100) PRIMARY KEY,
+  enabled BOOLEAN NOT NULL DEFAULT FALSE,
+  rollout_percentage SMALLINT NOT NULL DEFAULT 0 CHECK (rollout_percentage BETWEEN 0 AND 100),
+  created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS altalune_feature_flag_projects (
+  flag_name VARCHAR(100) NOT NULL REFERENCES altalune_feature_flags(name) ON DELETE CASCADE,
+  project_id BIGINT NOT NULL REFERENCES altalune_projects(id) ON DELETE CASCADE,
+  enabled BOOLEAN NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (flag_name, project_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_feature_flag_projects_project_id
+  ON altalune_feature_flag_projects (project_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS altalune_feature_flag_projects;
+DROP TABLE IF EXISTS altalune_feature_flags;
+-- +goose StatementEnd
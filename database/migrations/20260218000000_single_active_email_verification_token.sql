@@ -0,0 +1,56 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- =============================================================================
+-- SINGLE ACTIVE EMAIL VERIFICATION TOKEN
+-- =============================================================================
+-- A user has at most one verification link that works: sending a new one
+-- invalidates the previous one in the same statement. Invalidated tokens get
+-- invalidated_at instead of used_at, so used_at only marks verified emails
+-- and the verification conversion can be counted from here on.
+-- =============================================================================
+ALTER TABLE altalune_email_verification_tokens
+  ADD COLUMN IF NOT EXISTS invalidated_at TIMESTAMPTZ;
+
+-- Keep only the newest pending token of each user
+UPDATE altalune_email_verification_tokens t
+SET invalidated_at = NOW()
+WHERE t.used_at IS NULL
+  AND t.invalidated_at IS NULL
+  AND EXISTS (
+    SELECT 1 FROM altalune_email_verification_tokens newer
+    WHERE newer.user_id = t.user_id
+      AND newer.used_at IS NULL
+      AND (newer.created_at, newer.id) > (t.created_at, t.id)
+  );
+
+-- Deferred so the statement replacing a token can insert the new one before
+-- the old one is invalidated
+ALTER TABLE altalune_email_verification_tokens
+  ADD CONSTRAINT ex_email_verification_active_token
+  EXCLUDE USING btree (user_id WITH =)
+  WHERE (used_at IS NULL AND invalidated_at IS NULL)
+  DEFERRABLE INITIALLY DEFERRED;
+
+DROP INDEX IF EXISTS ix_email_verification_expires;
+CREATE INDEX ix_email_verification_expires ON altalune_email_verification_tokens(expires_at)
+  WHERE used_at IS NULL AND invalidated_at IS NULL;
+
+-- Conversion statistics scan the tokens sent over a period
+CREATE INDEX IF NOT EXISTS ix_email_verification_created_at ON altalune_email_verification_tokens(created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS ix_email_verification_created_at;
+DROP INDEX IF EXISTS ix_email_verification_expires;
+CREATE INDEX ix_email_verification_expires ON altalune_email_verification_tokens(expires_at)
+  WHERE used_at IS NULL;
+ALTER TABLE altalune_email_verification_tokens
+  DROP CONSTRAINT IF EXISTS ex_email_verification_active_token;
+UPDATE altalune_email_verification_tokens
+SET used_at = invalidated_at
+WHERE invalidated_at IS NOT NULL AND used_at IS NULL;
+ALTER TABLE altalune_email_verification_tokens
+  DROP COLUMN IF EXISTS invalidated_at;
+-- +goose StatementEnd
@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- =============================================================================
+-- REFRESH TOKEN REVOCATION
+-- =============================================================================
+-- Administrators list the refresh tokens of a user or a client and revoke
+-- them. A revoked token also gets exchange_at, which every lookup of a usable
+-- token already checks; revoked_at tells it apart from a rotated one.
+-- =============================================================================
+ALTER TABLE altalune_oauth_refresh_tokens
+  ADD COLUMN IF NOT EXISTS revoked_at TIMESTAMPTZ;
+
+-- Listings filter by user or client and page newest first, so the single
+-- column indexes give way to ones also serving the order
+DROP INDEX IF EXISTS idx_oauth_refresh_tokens_user_id;
+DROP INDEX IF EXISTS idx_oauth_refresh_tokens_client_id;
+
+CREATE INDEX IF NOT EXISTS idx_oauth_refresh_tokens_user_id_created_at
+  ON altalune_oauth_refresh_tokens (user_id, created_at DESC, id DESC);
+
+CREATE INDEX IF NOT EXISTS idx_oauth_refresh_tokens_client_id_created_at
+  ON altalune_oauth_refresh_tokens (client_id, created_at DESC, id DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_oauth_refresh_tokens_client_id_created_at;
+DROP INDEX IF EXISTS idx_oauth_refresh_tokens_user_id_created_at;
+
+CREATE INDEX IF NOT EXISTS idx_oauth_refresh_tokens_client_id
+  ON altalune_oauth_refresh_tokens (client_id);
+
+CREATE INDEX IF NOT EXISTS idx_oauth_refresh_tokens_user_id
+  ON altalune_oauth_refresh_tokens (user_id);
+
+ALTER TABLE altalune_oauth_refresh_tokens
+  DROP COLUMN IF EXISTS revoked_at;
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- =============================================================================
+-- OAUTH CLIENT TOKEN STATS (GLOBAL)
+-- =============================================================================
+-- Hourly counts of the token endpoint requests of each OAuth client: the
+-- requests that issued tokens and the ones that failed. The token endpoint
+-- increments the row of the current hour; dashboards chart them and the alert
+-- job compares the last complete hour with the ones before.
+-- =============================================================================
+CREATE TABLE IF NOT EXISTS altalune_oauth_client_token_stats (
+  client_id UUID NOT NULL,
+  hour TIMESTAMPTZ NOT NULL,
+  issued BIGINT NOT NULL DEFAULT 0,
+  failed BIGINT NOT NULL DEFAULT 0,
+  PRIMARY KEY (client_id, hour)
+);
+
+-- The alert job reads every client over a range of hours, the purge old hours
+CREATE INDEX IF NOT EXISTS idx_oauth_client_token_stats_hour
+  ON altalune_oauth_client_token_stats (hour);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS altalune_oauth_client_token_stats;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- =============================================================================
+-- OAUTH CLIENT PERMS CLAIM
+-- =============================================================================
+-- Access tokens list every permission of their user in the perms claim, which
+-- grows past the header size limits of some gateways. Each client picks how
+-- its tokens carry them: all of them, none (resource servers introspect), or
+-- the role names with the directly granted ones; and may cap the claim size.
+-- =============================================================================
+ALTER TABLE altalune_oauth_clients
+  ADD COLUMN IF NOT EXISTS perms_claim VARCHAR(10) NOT NULL DEFAULT 'full'
+    CHECK (perms_claim IN ('full', 'omit', 'roles')),
+  ADD COLUMN IF NOT EXISTS perms_claim_max_bytes INTEGER NOT NULL DEFAULT 0
+    CHECK (perms_claim_max_bytes >= 0);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE altalune_oauth_clients
+  DROP COLUMN IF EXISTS perms_claim_max_bytes,
+  DROP COLUMN IF EXISTS perms_claim;
+-- +goose StatementEnd
@@ -0,0 +1,45 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- =============================================================================
+-- REMEMBER-ME TOKENS (GLOBAL)
+-- =============================================================================
+-- Devices a user signed in on with "keep me signed in". The device keeps a
+-- cookie holding the series and a token; the auth server restores the session
+-- from it once the session cookie expired, replacing the token every time.
+-- series: Public identifier of the device, stable across rotations
+-- token_hash: SHA256 hash of the current token (64-char hex string)
+-- previous_token_hash: Token replaced at rotated_at, still accepted briefly
+--   for requests racing the rotation; any older token reveals a stolen cookie
+-- device_hash: SHA256 hash of the User-Agent the device signed in with
+-- =============================================================================
+CREATE TABLE IF NOT EXISTS altalune_remember_tokens (
+  id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  series VARCHAR(32) NOT NULL,
+  user_id BIGINT NOT NULL REFERENCES altalune_users(id) ON DELETE CASCADE,
+  token_hash VARCHAR(64) NOT NULL,
+  previous_token_hash VARCHAR(64),
+  rotated_at TIMESTAMPTZ,
+  device_hash VARCHAR(64) NOT NULL,
+  user_agent VARCHAR(512) NOT NULL DEFAULT '',
+  ip_address VARCHAR(45) NOT NULL DEFAULT '',
+  expires_at TIMESTAMPTZ NOT NULL,
+  last_used_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  revoked_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  CONSTRAINT ux_remember_tokens_series UNIQUE (series)
+);
+
+-- The profile page lists the devices of a user, newest first
+CREATE INDEX IF NOT EXISTS ix_remember_tokens_user_id
+  ON altalune_remember_tokens (user_id, created_at DESC);
+
+-- The trash janitor purges revoked and expired tokens
+CREATE INDEX IF NOT EXISTS ix_remember_tokens_expires_at
+  ON altalune_remember_tokens (expires_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS altalune_remember_tokens;
+-- +goose StatementEnd
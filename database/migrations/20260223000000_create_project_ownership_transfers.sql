@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- =============================================================================
+-- PROJECT OWNERSHIP TRANSFERS (PROJECT-SCOPED)
+-- =============================================================================
+-- An owner hands the owner role of a project to another member in two steps:
+-- the owner initiates the transfer, and it only takes effect once the target
+-- member accepts it. Rows are kept once resolved as the audit trail of who
+-- owned the project when.
+-- public_id: Identifies the transfer to accept or cancel
+-- status: pending until accepted, cancelled by the owner or declined by the
+--   target; a pending transfer past expires_at can no longer be accepted
+-- =============================================================================
+CREATE TABLE IF NOT EXISTS altalune_project_ownership_transfers (
+  id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  public_id VARCHAR(20) NOT NULL,
+  project_id BIGINT NOT NULL REFERENCES altalune_projects(id) ON DELETE CASCADE,
+  from_user_id BIGINT NOT NULL REFERENCES altalune_users(id) ON DELETE CASCADE,
+  to_user_id BIGINT NOT NULL REFERENCES altalune_users(id) ON DELETE CASCADE,
+  status VARCHAR(20) NOT NULL DEFAULT 'pending',
+  expires_at TIMESTAMPTZ NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  resolved_at TIMESTAMPTZ,
+  CONSTRAINT ux_project_ownership_transfers_public_id UNIQUE (public_id),
+  CONSTRAINT ck_project_ownership_transfers_status CHECK (status IN ('pending', 'accepted', 'cancelled', 'declined')),
+  CONSTRAINT ck_project_ownership_transfers_users CHECK (from_user_id <> to_user_id)
+);
+
+-- A project has at most one pending transfer; initiating another cancels it
+CREATE UNIQUE INDEX IF NOT EXISTS ux_project_ownership_transfers_pending
+  ON altalune_project_ownership_transfers (project_id)
+  WHERE status = 'pending';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS altalune_project_ownership_transfers;
+-- +goose StatementEnd
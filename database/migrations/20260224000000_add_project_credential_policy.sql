@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Per-project credential policy. api_key_max_lifetime_days caps how long after
+-- its creation an API key may expire, NULL for no cap beyond the two years any
+-- key is limited to. Keys already out of policy when it is set or tightened
+-- are reported to the owners by the credential policy job, or deactivated
+-- when api_key_policy_action is 'disable'.
+ALTER TABLE altalune_projects
+  ADD COLUMN IF NOT EXISTS api_key_max_lifetime_days INTEGER,
+  ADD COLUMN IF NOT EXISTS api_key_policy_action VARCHAR(10) NOT NULL DEFAULT 'flag';
+
+ALTER TABLE altalune_projects
+  ADD CONSTRAINT chk_projects_api_key_max_lifetime_days
+  CHECK (api_key_max_lifetime_days BETWEEN 1 AND 730);
+
+ALTER TABLE altalune_projects
+  ADD CONSTRAINT chk_projects_api_key_policy_action
+  CHECK (api_key_policy_action IN ('flag', 'disable'));
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE altalune_projects
+  DROP CONSTRAINT IF EXISTS chk_projects_api_key_policy_action;
+
+ALTER TABLE altalune_projects
+  DROP CONSTRAINT IF EXISTS chk_projects_api_key_max_lifetime_days;
+
+ALTER TABLE altalune_projects
+  DROP COLUMN IF EXISTS api_key_policy_action,
+  DROP COLUMN IF EXISTS api_key_max_lifetime_days;
+
+-- +goose StatementEnd
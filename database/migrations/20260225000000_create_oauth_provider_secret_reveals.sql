@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- =============================================================================
+-- OAUTH PROVIDER SECRET REVEALS (GLOBAL)
+-- =============================================================================
+-- Audit trail of every plaintext client secret shown to a user through the
+-- RevealClientSecret RPC. Reveals the server makes itself to sign users in are
+-- not recorded.
+-- actor_id: Public ID of the user the secret was revealed to; a public ID
+--   rather than a foreign key so the trail survives the user being purged
+-- reason: Why the user needed the secret, as they entered it
+-- =============================================================================
+CREATE TABLE IF NOT EXISTS altalune_oauth_provider_secret_reveals (
+  id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  provider_id BIGINT NOT NULL REFERENCES altalune_oauth_providers(id) ON DELETE CASCADE,
+  actor_id VARCHAR(20) NOT NULL,
+  reason VARCHAR(500) NOT NULL,
+  revealed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS ix_oauth_provider_secret_reveals_provider
+  ON altalune_oauth_provider_secret_reveals (provider_id, revealed_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS altalune_oauth_provider_secret_reveals;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- How the secrets generated on creation are handed over: 'reveal' returns
+-- them in plaintext in the create response, 'link' through a one-time
+-- retrieval link, and 'public_key' encrypted to a public key given on
+-- creation. API keys follow the policy of their project, OAuth clients, which
+-- are global, the policy of the default project.
+ALTER TABLE altalune_projects
+  ADD COLUMN IF NOT EXISTS secret_delivery VARCHAR(10) NOT NULL DEFAULT 'reveal';
+
+ALTER TABLE altalune_projects
+  ADD CONSTRAINT chk_projects_secret_delivery
+  CHECK (secret_delivery IN ('reveal', 'link', 'public_key'));
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE altalune_projects
+  DROP CONSTRAINT IF EXISTS chk_projects_secret_delivery;
+
+ALTER TABLE altalune_projects
+  DROP COLUMN IF EXISTS secret_delivery;
+
+-- +goose StatementEnd
@@ -0,0 +1,49 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- =============================================================================
+-- PROJECT API USAGE (PROJECT-SCOPED)
+-- =============================================================================
+-- Hourly counts of the API calls naming a project, by the OAuth client of the
+-- caller's token. Each server counts in memory and adds its counts to the rows
+-- of the hour every usage.flushInterval, rather than writing on every call.
+-- client_id: OAuth client of the caller's token, the nil UUID for calls
+--   without one
+-- rejected: calls refused because the project was over its monthly quota
+-- =============================================================================
+CREATE TABLE IF NOT EXISTS altalune_project_api_usage (
+  project_id BIGINT NOT NULL REFERENCES altalune_projects(id) ON DELETE CASCADE,
+  client_id UUID NOT NULL,
+  hour TIMESTAMPTZ NOT NULL,
+  requests BIGINT NOT NULL DEFAULT 0,
+  rejected BIGINT NOT NULL DEFAULT 0,
+  PRIMARY KEY (project_id, hour, client_id)
+);
+
+-- The purge removes old hours across projects
+CREATE INDEX IF NOT EXISTS idx_project_api_usage_hour
+  ON altalune_project_api_usage (hour);
+
+-- Calls a project may make in a calendar month (UTC) before the API answers
+-- them with ResourceExhausted; 0 for no quota
+ALTER TABLE altalune_projects
+  ADD COLUMN IF NOT EXISTS api_monthly_quota BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE altalune_projects
+  ADD CONSTRAINT chk_projects_api_monthly_quota
+  CHECK (api_monthly_quota >= 0);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE altalune_projects
+  DROP CONSTRAINT IF EXISTS chk_projects_api_monthly_quota;
+
+ALTER TABLE altalune_projects
+  DROP COLUMN IF EXISTS api_monthly_quota;
+
+DROP TABLE IF EXISTS altalune_project_api_usage;
+
+-- +goose StatementEnd
@@ -0,0 +1,61 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- =============================================================================
+-- ORGANIZATIONS
+-- =============================================================================
+-- Groups projects of one tenant. Owners and admins of an organization hold
+-- that role in every project of it, without being members of each project.
+-- billing_email: Where invoices are sent
+-- billing_customer_id: ID of the organization at the billing provider, e.g. a
+--   Stripe customer, so billing attaches to the tenant rather than a project
+-- =============================================================================
+CREATE TABLE IF NOT EXISTS altalune_organizations (
+  id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  public_id VARCHAR(20) NOT NULL,
+  name VARCHAR(100) NOT NULL,
+  billing_email VARCHAR(254) NOT NULL DEFAULT '',
+  billing_customer_id VARCHAR(100) NOT NULL DEFAULT '',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT ux_organizations_public_id UNIQUE (public_id)
+);
+
+-- Organization roles: owner, admin; an organization keeps at least one owner
+CREATE TABLE IF NOT EXISTS altalune_organization_members (
+  organization_id BIGINT NOT NULL REFERENCES altalune_organizations(id) ON DELETE CASCADE,
+  user_id BIGINT NOT NULL REFERENCES altalune_users(id) ON DELETE CASCADE,
+  role VARCHAR(20) NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (organization_id, user_id),
+  CONSTRAINT chk_organization_members_role CHECK (role IN ('owner', 'admin'))
+);
+
+-- Tokens list the organizations of a user on every issue
+CREATE INDEX IF NOT EXISTS idx_organization_members_user_id
+  ON altalune_organization_members (user_id);
+
+-- A project belongs to one organization at most; deleting the organization
+-- keeps its projects
+ALTER TABLE altalune_projects
+  ADD COLUMN IF NOT EXISTS organization_id BIGINT REFERENCES altalune_organizations(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_projects_organization_id
+  ON altalune_projects (organization_id)
+  WHERE organization_id IS NOT NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS idx_projects_organization_id;
+
+ALTER TABLE altalune_projects
+  DROP COLUMN IF EXISTS organization_id;
+
+DROP TABLE IF EXISTS altalune_organization_members;
+DROP TABLE IF EXISTS altalune_organizations;
+
+-- +goose StatementEnd
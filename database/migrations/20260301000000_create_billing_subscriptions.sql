@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- =============================================================================
+-- BILLING SUBSCRIPTIONS
+-- =============================================================================
+-- Stripe subscription of an organization, kept up to date by the Stripe
+-- webhooks. The organization is found by its billing_customer_id.
+-- stripe_price_id: Price subscribed to, mapped to a plan of billing.plans when
+--   read so plans can be edited without touching the rows
+-- event_created_at: Creation time of the last applied event; Stripe does not
+--   deliver events in order, so older ones are ignored
+-- =============================================================================
+CREATE TABLE IF NOT EXISTS altalune_billing_subscriptions (
+  organization_id BIGINT PRIMARY KEY REFERENCES altalune_organizations(id) ON DELETE CASCADE,
+  stripe_subscription_id VARCHAR(255) NOT NULL,
+  stripe_price_id VARCHAR(255) NOT NULL DEFAULT '',
+  status VARCHAR(30) NOT NULL,
+  current_period_end TIMESTAMPTZ,
+  cancel_at_period_end BOOLEAN NOT NULL DEFAULT false,
+  event_created_at TIMESTAMPTZ NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Webhooks find organizations by their Stripe customer, which pays for one
+-- organization only
+CREATE UNIQUE INDEX IF NOT EXISTS ux_organizations_billing_customer_id
+  ON altalune_organizations (billing_customer_id)
+  WHERE billing_customer_id <> '';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS ux_organizations_billing_customer_id;
+DROP TABLE IF EXISTS altalune_billing_subscriptions;
+
+-- +goose StatementEnd
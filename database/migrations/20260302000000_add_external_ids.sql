@@ -0,0 +1,52 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- =============================================================================
+-- EXTERNAL IDS
+-- =============================================================================
+-- Identify OAuth clients, API keys and roles by a caller-chosen key, such as a
+-- Terraform resource address, so provisioning tools can create or update them
+-- idempotently. Trashed rows keep their external ID, so applying the same
+-- configuration again restores them instead of creating a duplicate.
+-- =============================================================================
+ALTER TABLE altalune_oauth_clients
+  ADD COLUMN IF NOT EXISTS external_id VARCHAR(100);
+
+ALTER TABLE altalune_project_api_keys
+  ADD COLUMN IF NOT EXISTS external_id VARCHAR(100);
+
+ALTER TABLE altalune_roles
+  ADD COLUMN IF NOT EXISTS external_id VARCHAR(100);
+
+CREATE UNIQUE INDEX IF NOT EXISTS ux_oauth_clients_external_id
+  ON altalune_oauth_clients (external_id)
+  WHERE external_id IS NOT NULL;
+
+-- API keys are unique per project; the partition key is part of the index
+CREATE UNIQUE INDEX IF NOT EXISTS ux_altalune_project_api_keys_external_id
+  ON altalune_project_api_keys (project_id, external_id)
+  WHERE external_id IS NOT NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS ux_roles_external_id
+  ON altalune_roles (external_id)
+  WHERE external_id IS NOT NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS ux_roles_external_id;
+DROP INDEX IF EXISTS ux_altalune_project_api_keys_external_id;
+DROP INDEX IF EXISTS ux_oauth_clients_external_id;
+
+ALTER TABLE altalune_roles
+  DROP COLUMN IF EXISTS external_id;
+
+ALTER TABLE altalune_project_api_keys
+  DROP COLUMN IF EXISTS external_id;
+
+ALTER TABLE altalune_oauth_clients
+  DROP COLUMN IF EXISTS external_id;
+
+-- +goose StatementEnd
@@ -0,0 +1,46 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- =============================================================================
+-- SAVED VIEWS
+-- =============================================================================
+-- Named filter and sort combinations a user keeps for a queryable resource,
+-- offered by the dashboard tables as quick views. Views are private to the
+-- user who saved them.
+-- resource: Query endpoint the view applies to
+-- project_id: Project of the view for project-scoped resources, NULL for
+--   global ones (users)
+-- filters: Filter values by field, as sent in QueryRequest.filters
+-- sort_field / sort_order: Sorting of the view, empty for the default order
+-- =============================================================================
+CREATE TABLE IF NOT EXISTS altalune_saved_views (
+  id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  public_id VARCHAR(20) NOT NULL,
+  user_id BIGINT NOT NULL REFERENCES altalune_users(id) ON DELETE CASCADE,
+  project_id BIGINT REFERENCES altalune_projects(id) ON DELETE CASCADE,
+  resource VARCHAR(30) NOT NULL,
+  name VARCHAR(100) NOT NULL,
+  keyword VARCHAR(256) NOT NULL DEFAULT '',
+  filters JSONB NOT NULL DEFAULT '{}'::jsonb,
+  sort_field VARCHAR(100) NOT NULL DEFAULT '',
+  sort_order VARCHAR(4) NOT NULL DEFAULT '',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT ux_saved_views_public_id UNIQUE (public_id),
+  CONSTRAINT chk_saved_views_resource CHECK (resource IN ('api_keys', 'users', 'employees')),
+  CONSTRAINT chk_saved_views_sort_order CHECK (sort_order IN ('', 'asc', 'desc'))
+);
+
+-- View names are unique per user and table, whatever their case; the index
+-- also serves listing the views of a table
+CREATE UNIQUE INDEX IF NOT EXISTS ux_saved_views_name
+  ON altalune_saved_views (user_id, resource, COALESCE(project_id, 0), lower(name));
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS altalune_saved_views;
+
+-- +goose StatementEnd
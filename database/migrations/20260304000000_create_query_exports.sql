@@ -0,0 +1,51 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- =============================================================================
+-- QUERY EXPORTS
+-- =============================================================================
+-- CSV files of every row a Query endpoint matches, generated in the background
+-- and downloaded once completed. The file is kept in the row, as project logos
+-- are, until the export expires.
+-- user_id: Who started the export, the only one seeing it; NULL when
+--   authentication is disabled
+-- project_id: Project of the export for project-scoped resources
+-- status: pending, running, completed or failed
+-- rows_total: Rows the query matches, estimated until the export completes
+-- download_token: Random token of the download link, granting no more than
+--   the content stored along
+-- =============================================================================
+CREATE TABLE IF NOT EXISTS altalune_query_exports (
+  id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  public_id VARCHAR(20) NOT NULL,
+  user_id BIGINT REFERENCES altalune_users(id) ON DELETE CASCADE,
+  project_id BIGINT REFERENCES altalune_projects(id) ON DELETE CASCADE,
+  resource VARCHAR(30) NOT NULL,
+  status VARCHAR(20) NOT NULL DEFAULT 'pending',
+  rows_written INTEGER NOT NULL DEFAULT 0,
+  rows_total INTEGER NOT NULL DEFAULT 0,
+  error TEXT NOT NULL DEFAULT '',
+  content BYTEA,
+  download_token VARCHAR(64) NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  completed_at TIMESTAMPTZ,
+  expires_at TIMESTAMPTZ NOT NULL,
+  CONSTRAINT ux_query_exports_public_id UNIQUE (public_id),
+  CONSTRAINT ux_query_exports_download_token UNIQUE (download_token),
+  CONSTRAINT chk_query_exports_resource CHECK (resource IN ('users', 'api_keys')),
+  CONSTRAINT chk_query_exports_status CHECK (status IN ('pending', 'running', 'completed', 'failed'))
+);
+
+-- The purge job deletes expired exports
+CREATE INDEX IF NOT EXISTS idx_query_exports_expires_at
+  ON altalune_query_exports (expires_at);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS altalune_query_exports;
+
+-- +goose StatementEnd
@@ -0,0 +1,50 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- =============================================================================
+-- PROJECT ACTIVITIES
+-- =============================================================================
+-- Feed of the notable domain events of each project, newest first, shown on
+-- the dashboard home page. Rows are recorded by an event bus subscriber and
+-- deleted once past the retention of the feed.
+-- category: Feed filter of the event (members, clients, api_keys, security)
+-- event: Name of the domain event, e.g. 'project.member_added'
+-- actor_id: Public ID of the user who caused the event, empty for sign-ins
+--   and unauthenticated changes
+-- subject_id / subject_name: What the event happened to: the member, OAuth
+--   client, API key or user failing to sign in
+-- details: Further fields of the event for display, e.g. the member role
+-- Global events (OAuth clients) are recorded in the default project, and the
+-- failed sign-ins of a user in every project they are a member of.
+-- =============================================================================
+CREATE TABLE IF NOT EXISTS altalune_project_activities (
+  id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  public_id VARCHAR(20) NOT NULL,
+  project_id BIGINT NOT NULL REFERENCES altalune_projects(id) ON DELETE CASCADE,
+  category VARCHAR(20) NOT NULL,
+  event VARCHAR(100) NOT NULL,
+  actor_id VARCHAR(20) NOT NULL DEFAULT '',
+  subject_id VARCHAR(50) NOT NULL DEFAULT '',
+  subject_name VARCHAR(255) NOT NULL DEFAULT '',
+  details JSONB NOT NULL DEFAULT '{}'::jsonb,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT ux_project_activities_public_id UNIQUE (public_id),
+  CONSTRAINT chk_project_activities_category CHECK (category IN ('members', 'clients', 'api_keys', 'security'))
+);
+
+-- Pages of the feed are read newest first, the public ID breaking ties
+CREATE INDEX IF NOT EXISTS idx_project_activities_feed
+  ON altalune_project_activities (project_id, created_at DESC, public_id DESC);
+
+-- Retention deletes by age across projects
+CREATE INDEX IF NOT EXISTS idx_project_activities_created_at
+  ON altalune_project_activities (created_at);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS altalune_project_activities;
+
+-- +goose StatementEnd
@@ -0,0 +1,74 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Project of the current transaction, NULL unless the server scoped it to a
+-- project; app.current_project is 'all' for sessions working across projects
+CREATE OR REPLACE FUNCTION altalune_current_project() RETURNS BIGINT
+  LANGUAGE sql STABLE
+  AS $$ SELECT NULLIF(NULLIF(current_setting('app.current_project', true), ''), 'all')::BIGINT $$;
+
+CREATE OR REPLACE FUNCTION altalune_all_projects() RETURNS BOOLEAN
+  LANGUAGE sql STABLE
+  AS $$ SELECT COALESCE(current_setting('app.current_project', true) = 'all', false) $$;
+
+-- Sessions without a project see no row of project-scoped tables: the server
+-- connects with app.current_project set to 'all' unless database.rowLevelSecurity
+-- is enabled, in which case every statement runs scoped to a project or to all
+-- of them explicitly. Data migrations on these tables have to
+-- SET LOCAL app.current_project = 'all' when the server enforces the policies.
+DO $$
+DECLARE
+  t TEXT;
+BEGIN
+  FOREACH t IN ARRAY ARRAY[
+    'altalune_example_employees',
+    'altalune_project_api_keys',
+    'altalune_project_hostnames',
+    'altalune_project_branding',
+    'altalune_chatbot_configs',
+    'altalune_chatbot_nodes'
+  ] LOOP
+    EXECUTE format('DROP POLICY IF EXISTS project_isolation ON %I', t);
+    EXECUTE format(
+      'CREATE POLICY project_isolation ON %I
+         USING (altalune_all_projects() OR project_id = altalune_current_project())
+         WITH CHECK (altalune_all_projects() OR project_id = altalune_current_project())',
+      t
+    );
+  END LOOP;
+END $$;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DO $$
+DECLARE
+  t TEXT;
+BEGIN
+  FOREACH t IN ARRAY ARRAY[
+    'altalune_example_employees',
+    'altalune_project_api_keys',
+    'altalune_project_hostnames',
+    'altalune_project_branding',
+    'altalune_chatbot_configs',
+    'altalune_chatbot_nodes'
+  ] LOOP
+    EXECUTE format('DROP POLICY IF EXISTS project_isolation ON %I', t);
+    EXECUTE format(
+      'CREATE POLICY project_isolation ON %I
+         USING (altalune_current_project() IS NULL OR project_id = altalune_current_project())
+         WITH CHECK (altalune_current_project() IS NULL OR project_id = altalune_current_project())',
+      t
+    );
+  END LOOP;
+END $$;
+
+DROP FUNCTION IF EXISTS altalune_all_projects();
+
+CREATE OR REPLACE FUNCTION altalune_current_project() RETURNS BIGINT
+  LANGUAGE sql STABLE
+  AS $$ SELECT NULLIF(current_setting('app.current_project', true), '')::BIGINT $$;
+
+-- +goose StatementEnd
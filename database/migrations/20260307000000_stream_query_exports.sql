@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- =============================================================================
+-- QUERY EXPORTS ON A FILE STORE
+-- =============================================================================
+-- Export files are streamed to the file store of the server instead of being
+-- kept in the row, and exports are claimed by the export workers from this
+-- table, so the request only records what to export.
+-- query: QueryRequest of the export, as protojson
+-- trashed: Export the soft-deleted rows instead of the live ones
+-- size_bytes: Size of the file of a completed export
+-- Exports completed before keep no file, and their links answer as expired.
+-- =============================================================================
+ALTER TABLE altalune_query_exports
+  DROP COLUMN IF EXISTS content,
+  ADD COLUMN IF NOT EXISTS query JSONB NOT NULL DEFAULT '{}',
+  ADD COLUMN IF NOT EXISTS trashed BOOLEAN NOT NULL DEFAULT false,
+  ADD COLUMN IF NOT EXISTS size_bytes BIGINT NOT NULL DEFAULT 0;
+
+-- Workers claim the pending exports oldest first
+CREATE INDEX IF NOT EXISTS idx_query_exports_pending
+  ON altalune_query_exports (created_at, id)
+  WHERE status = 'pending';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS idx_query_exports_pending;
+
+-- The files stay in the file store: completed exports lose their content
+ALTER TABLE altalune_query_exports
+  DROP COLUMN IF EXISTS size_bytes,
+  DROP COLUMN IF EXISTS trashed,
+  DROP COLUMN IF EXISTS query,
+  ADD COLUMN IF NOT EXISTS content BYTEA;
+
+-- +goose StatementEnd
@@ -0,0 +1,45 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Maximum lifetime of the OAuth client secrets. Clients are global, so only
+-- the value of the default project applies, like its secret_delivery. NULL
+-- lets secrets live until they are rotated.
+ALTER TABLE altalune_projects
+  ADD COLUMN IF NOT EXISTS client_secret_max_lifetime_days INTEGER;
+
+ALTER TABLE altalune_projects
+  ADD CONSTRAINT chk_projects_client_secret_max_lifetime_days
+  CHECK (client_secret_max_lifetime_days BETWEEN 1 AND 730);
+
+-- client_secret_set_at: When the current secret was generated, on creation or
+-- rotation; the lifetime counts from it
+-- client_secret_expires_at: When the secret stops authenticating the client,
+-- NULL for never
+ALTER TABLE altalune_oauth_clients
+  ADD COLUMN IF NOT EXISTS client_secret_set_at TIMESTAMPTZ,
+  ADD COLUMN IF NOT EXISTS client_secret_expires_at TIMESTAMPTZ;
+
+UPDATE altalune_oauth_clients
+SET client_secret_set_at = created_at
+WHERE client_secret_set_at IS NULL;
+
+ALTER TABLE altalune_oauth_clients
+  ALTER COLUMN client_secret_set_at SET DEFAULT CURRENT_TIMESTAMP,
+  ALTER COLUMN client_secret_set_at SET NOT NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE altalune_oauth_clients
+  DROP COLUMN IF EXISTS client_secret_expires_at,
+  DROP COLUMN IF EXISTS client_secret_set_at;
+
+ALTER TABLE altalune_projects
+  DROP CONSTRAINT IF EXISTS chk_projects_client_secret_max_lifetime_days;
+
+ALTER TABLE altalune_projects
+  DROP COLUMN IF EXISTS client_secret_max_lifetime_days;
+
+-- +goose StatementEnd
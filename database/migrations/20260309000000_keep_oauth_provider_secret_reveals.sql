@@ -0,0 +1,48 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- =============================================================================
+-- OAUTH PROVIDER SECRET REVEALS OUTLIVE THEIR PROVIDER
+-- =============================================================================
+-- The reveals of a deleted provider are kept: provider_id is cleared instead
+-- of the rows being deleted, and the provider stays identified by the public
+-- ID and type it had.
+-- provider_public_id: Public ID of the provider when its secret was revealed
+-- provider_type: Type of the provider when its secret was revealed
+-- ip_address: Address of the client the secret was revealed to, empty when
+--   it could not be resolved
+-- =============================================================================
+ALTER TABLE altalune_oauth_provider_secret_reveals
+  ADD COLUMN IF NOT EXISTS provider_public_id VARCHAR(20) NOT NULL DEFAULT '',
+  ADD COLUMN IF NOT EXISTS provider_type VARCHAR(20) NOT NULL DEFAULT '',
+  ADD COLUMN IF NOT EXISTS ip_address VARCHAR(45) NOT NULL DEFAULT '';
+
+UPDATE altalune_oauth_provider_secret_reveals r
+SET provider_public_id = p.public_id, provider_type = p.provider_type
+FROM altalune_oauth_providers p
+WHERE p.id = r.provider_id;
+
+ALTER TABLE altalune_oauth_provider_secret_reveals
+  ALTER COLUMN provider_id DROP NOT NULL,
+  DROP CONSTRAINT IF EXISTS altalune_oauth_provider_secret_reveals_provider_id_fkey,
+  ADD CONSTRAINT altalune_oauth_provider_secret_reveals_provider_id_fkey
+    FOREIGN KEY (provider_id) REFERENCES altalune_oauth_providers(id) ON DELETE SET NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+-- The reveals of deleted providers cannot reference them again and are dropped
+DELETE FROM altalune_oauth_provider_secret_reveals WHERE provider_id IS NULL;
+
+ALTER TABLE altalune_oauth_provider_secret_reveals
+  DROP CONSTRAINT IF EXISTS altalune_oauth_provider_secret_reveals_provider_id_fkey,
+  ADD CONSTRAINT altalune_oauth_provider_secret_reveals_provider_id_fkey
+    FOREIGN KEY (provider_id) REFERENCES altalune_oauth_providers(id) ON DELETE CASCADE,
+  ALTER COLUMN provider_id SET NOT NULL,
+  DROP COLUMN IF EXISTS ip_address,
+  DROP COLUMN IF EXISTS provider_type,
+  DROP COLUMN IF EXISTS provider_public_id;
+
+-- +goose StatementEnd
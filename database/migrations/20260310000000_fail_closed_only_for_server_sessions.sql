@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Only the server's sessions fail closed. With database.rowLevelSecurity
+-- enabled it connects with app.current_project set to 'none', so its
+-- statements outside a scope see no row of the project tables. Sessions that
+-- never set app.current_project, such as psql, pg_dump, cron or reporting
+-- jobs connecting as the owner role, see every row as they did before the
+-- policies failed closed. Roles that must never see across projects can be
+-- made to fail closed too with ALTER ROLE ... SET app.current_project = 'none'.
+CREATE OR REPLACE FUNCTION altalune_current_project() RETURNS BIGINT
+  LANGUAGE sql STABLE
+  AS $$
+    SELECT CASE WHEN current_setting('app.current_project', true) ~ '^[0-9]+$'
+                THEN current_setting('app.current_project', true)::BIGINT
+           END
+  $$;
+
+CREATE OR REPLACE FUNCTION altalune_all_projects() RETURNS BOOLEAN
+  LANGUAGE sql STABLE
+  AS $$ SELECT COALESCE(current_setting('app.current_project', true), 'all') = 'all' $$;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+CREATE OR REPLACE FUNCTION altalune_all_projects() RETURNS BOOLEAN
+  LANGUAGE sql STABLE
+  AS $$ SELECT COALESCE(current_setting('app.current_project', true) = 'all', false) $$;
+
+CREATE OR REPLACE FUNCTION altalune_current_project() RETURNS BIGINT
+  LANGUAGE sql STABLE
+  AS $$ SELECT NULLIF(NULLIF(current_setting('app.current_project', true), ''), 'all')::BIGINT $$;
+
+-- +goose StatementEnd
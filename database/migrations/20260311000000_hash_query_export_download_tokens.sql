@@ -0,0 +1,48 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- The tokens of the download links of query exports are only stored as their
+-- hex SHA-256, as OAuth codes and refresh tokens are, so a leaked database
+-- does not expose live links. Existing rows are hashed in place and their
+-- links keep working.
+ALTER TABLE altalune_query_exports
+  ADD COLUMN IF NOT EXISTS download_token_hash CHAR(64);
+
+UPDATE altalune_query_exports
+  SET download_token_hash = encode(sha256(convert_to(download_token, 'UTF8')), 'hex')
+  WHERE download_token_hash IS NULL;
+
+ALTER TABLE altalune_query_exports
+  ALTER COLUMN download_token_hash SET NOT NULL;
+
+-- Dropping the column drops its unique constraint
+ALTER TABLE altalune_query_exports
+  DROP COLUMN IF EXISTS download_token;
+
+CREATE UNIQUE INDEX IF NOT EXISTS ux_query_exports_download_token_hash
+  ON altalune_query_exports (download_token_hash);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+-- The tokens cannot be recovered from their hashes: rows get random ones,
+-- which invalidates every outstanding download link
+ALTER TABLE altalune_query_exports
+  ADD COLUMN IF NOT EXISTS download_token VARCHAR(64);
+
+UPDATE altalune_query_exports
+  SET download_token = replace(gen_random_uuid()::text, '-', '')
+  WHERE download_token IS NULL;
+
+ALTER TABLE altalune_query_exports
+  ALTER COLUMN download_token SET NOT NULL;
+
+ALTER TABLE altalune_query_exports
+  DROP COLUMN IF EXISTS download_token_hash;
+
+ALTER TABLE altalune_query_exports
+  ADD CONSTRAINT ux_query_exports_download_token UNIQUE (download_token);
+
+-- +goose StatementEnd
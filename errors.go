@@ -3,6 +3,8 @@ package altalune
 import (
 	"errors"
 	"fmt"
+	"strconv"
+	"time"
 
 	"connectrpc.com/connect"
 	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
@@ -13,20 +15,28 @@ import (
 	"google.golang.org/protobuf/types/known/anypb"
 )
 
-// Error code constants for consistent error handling across the application
+// Error code constants for consistent error handling across the application.
+// Every code must also be registered in errorCatalog (errors_catalog.go).
 const (
 	// Validation Errors (600XX)
-	CodeInvalidPayload = "60001"
+	CodeInvalidPayload  = "60001"
+	CodeVersionConflict = "60002"
 
 	// Greeting Domain Errors (601XX)
-	CodeGreetingUnrecognized = "60101"
+	CodeGreetingUnrecognized      = "60101"
+	CodeGreetingNameAlreadyExists = "60102"
+	CodeGreetingNameNotFound      = "60103"
 
 	// Example/Employee Domain Errors (602XX)
 	CodeEmployeeNotFound      = "60201"
 	CodeEmployeeAlreadyExists = "60202"
 
 	// Project Domain Errors (603XX)
-	CodeProjectNotFound = "60301"
+	CodeProjectNotFound              = "60301"
+	CodeProjectHostnameNotFound      = "60302"
+	CodeProjectHostnameAlreadyExists = "60303"
+	CodeProjectQuotaExceeded         = "60304"
+	CodeProjectNotSandbox            = "60305"
 
 	// API Key Domain Errors (604XX)
 	CodeApiKeyNotFound      = "60401"
@@ -39,6 +49,11 @@ const (
 	CodeUserAlreadyActive    = "60503"
 	CodeUserAlreadyInactive  = "60504"
 	CodeUserCannotDeleteSelf = "60505"
+	CodeUserNotPending       = "60506"
+	CodeUserNotLocked        = "60507"
+	CodeUserIsServiceAccount = "60508"
+	CodeUserEmailBlocked     = "60509"
+	CodeUserEmailNoMX        = "60510"
 
 	// Role Domain Errors (606XX)
 	CodeRoleNotFound      = "60600"
@@ -63,18 +78,23 @@ const (
 	CodeMappingRoleNotFound       = "60805"
 	CodeMappingPermissionNotFound = "60806"
 	CodeMappingProjectNotFound    = "60807"
+	CodeProjectRoleGrantDenied    = "60808"
+	CodeOwnershipTransferNotFound = "60809"
 
 	// OAuth Provider Domain Errors (608XX continued)
-	CodeOAuthProviderNotFound        = "60810"
-	CodeOAuthProviderDuplicateType   = "60811"
-	CodeOAuthProviderEncryptionError = "60812"
-	CodeOAuthProviderDecryptionError = "60813"
+	CodeOAuthProviderNotFound          = "60810"
+	CodeOAuthProviderDuplicateType     = "60811"
+	CodeOAuthProviderEncryptionError   = "60812"
+	CodeOAuthProviderDecryptionError   = "60813"
+	CodeOAuthProviderRevealUnconfirmed = "60814"
+	CodeOAuthProviderRevealRateLimited = "60815"
 
 	// OAuth Client Domain Errors (609XX)
 	CodeOAuthClientNotFound      = "60900"
 	CodeOAuthClientAlreadyExists = "60901"
 	CodeInvalidRedirectURI       = "60902"
 	CodeOAuthClientSecretInvalid = "60903"
+	CodeRefreshTokenNotFound     = "60904"
 
 	// Chatbot Node Domain Errors (610XX)
 	CodeChatbotNodeNotFound       = "61001"
@@ -86,6 +106,39 @@ const (
 	CodeChatbotNodeInvalidTrigger = "61007"
 	CodeChatbotNodeInvalidRegex   = "61008"
 
+	// Feature Flag Errors (611XX)
+	CodeFeatureFlagNotFound         = "61101"
+	CodeFeatureFlagOverrideNotFound = "61102"
+
+	// Organization Domain Errors (612XX)
+	CodeOrganizationNotFound              = "61201"
+	CodeOrganizationAccessDenied          = "61202"
+	CodeOrganizationRoleGrantDenied       = "61203"
+	CodeCannotRemoveLastOrganizationOwner = "61204"
+	CodeOrganizationMemberNotFound        = "61205"
+	CodeProjectInOtherOrganization        = "61206"
+	CodeProjectNotInOrganization          = "61207"
+
+	// Billing Domain Errors (613XX)
+	CodePlanLimitReached           = "61301"
+	CodeBillingCustomerMissing     = "61302"
+	CodeBillingProviderUnavailable = "61303"
+
+	// Provisioning Errors (614XX)
+	CodeExternalIDTaken        = "61401"
+	CodeResourceAlreadyManaged = "61402"
+	CodeImportTargetNotFound   = "61403"
+	CodeImportTargetAmbiguous  = "61404"
+	CodeImmutableFieldChanged  = "61405"
+
+	// Saved View Domain Errors (615XX)
+	CodeSavedViewNotFound      = "61501"
+	CodeSavedViewAlreadyExists = "61502"
+	CodeSavedViewOwnerRequired = "61503"
+
+	// Query Export Domain Errors (616XX)
+	CodeQueryExportNotFound = "61601"
+
 	// Internal Errors (699XX)
 	CodeUnexpectedError = "69901"
 )
@@ -129,7 +182,7 @@ func ToConnectError(err error) error {
 			cErr = connect.NewError(connect.CodeInternal, appErr)
 		}
 
-		for _, d := range appErr.details {
+		for _, d := range appErr.catalogDetails() {
 			if errDetail, err := connect.NewErrorDetail(d); err == nil {
 				cErr.AddDetail(errDetail)
 			}
@@ -145,13 +198,20 @@ func (e *AppError) Error() string {
 	return fmt.Sprintf("%s: %s", e.code, e.message)
 }
 
+// HasCode reports whether err is, or wraps, an AppError with the catalog code
+func HasCode(err error, code string) bool {
+	var appErr *AppError
+	return errors.As(err, &appErr) && appErr.code == code
+}
+
 // GRPCStatus converts to traditional gRPC status (for pure gRPC services)
 func (e *AppError) GRPCStatus() *status.Status {
 	st := status.New(e.grpcCode, e.Error())
 
-	detailAnys := make([]protoadapt.MessageV1, 0, len(e.details))
+	details := e.catalogDetails()
+	detailAnys := make([]protoadapt.MessageV1, 0, len(details))
 
-	for _, d := range e.details {
+	for _, d := range details {
 		anyDetail, err := anypb.New(d)
 		if err != nil {
 			continue
@@ -199,6 +259,26 @@ func NewUnexpectedError(message string, err error) *AppError {
 	}
 }
 
+// NewVersionConflictError creates an error for an update made against a stale
+// version of a resource, which was modified by someone else in the meantime
+func NewVersionConflictError(resource, id string) *AppError {
+	code := CodeVersionConflict
+	return &AppError{
+		code:     code,
+		message:  fmt.Sprintf("The %s was modified by someone else, reload it and try again", resource),
+		grpcCode: codes.FailedPrecondition,
+		details: []proto.Message{
+			&altalunev1.ErrorDetail{
+				Code: code,
+				Meta: map[string]string{
+					"resource": resource,
+					"id":       id,
+				},
+			},
+		},
+	}
+}
+
 func NewProjectNotFound(projectID string) *AppError {
 	code := CodeProjectNotFound
 	return &AppError{
@@ -216,6 +296,82 @@ func NewProjectNotFound(projectID string) *AppError {
 	}
 }
 
+// NewProjectHostnameNotFoundError creates an error for when a project hostname is not found
+func NewProjectHostnameNotFoundError(publicID string) *AppError {
+	code := CodeProjectHostnameNotFound
+	return &AppError{
+		code:     code,
+		message:  fmt.Sprintf("Project hostname with ID '%s' not found", publicID),
+		grpcCode: codes.NotFound,
+		details: []proto.Message{
+			&altalunev1.ErrorDetail{
+				Code: code,
+				Meta: map[string]string{
+					"hostname_id": publicID,
+				},
+			},
+		},
+	}
+}
+
+// NewProjectHostnameAlreadyExistsError creates an error for a hostname already registered by a project
+func NewProjectHostnameAlreadyExistsError(hostname string) *AppError {
+	code := CodeProjectHostnameAlreadyExists
+	return &AppError{
+		code:     code,
+		message:  fmt.Sprintf("Hostname '%s' is already registered", hostname),
+		grpcCode: codes.AlreadyExists,
+		details: []proto.Message{
+			&altalunev1.ErrorDetail{
+				Code: code,
+				Meta: map[string]string{
+					"hostname": hostname,
+				},
+			},
+		},
+	}
+}
+
+// NewProjectQuotaExceededError creates an error for an API call of a project
+// over its monthly quota
+func NewProjectQuotaExceededError(projectID string, quota int64, resetsAt time.Time) *AppError {
+	code := CodeProjectQuotaExceeded
+	return &AppError{
+		code:     code,
+		message:  fmt.Sprintf("Project has used its monthly quota of %d API calls", quota),
+		grpcCode: codes.ResourceExhausted,
+		details: []proto.Message{
+			&altalunev1.ErrorDetail{
+				Code: code,
+				Meta: map[string]string{
+					"project_id": projectID,
+					"quota":      strconv.FormatInt(quota, 10),
+					"resets_at":  resetsAt.UTC().Format(time.RFC3339),
+				},
+			},
+		},
+	}
+}
+
+// NewProjectNotSandboxError creates an error for a tool only sandbox projects
+// allow, used on a live project
+func NewProjectNotSandboxError(projectID string) *AppError {
+	code := CodeProjectNotSandbox
+	return &AppError{
+		code:     code,
+		message:  fmt.Sprintf("Project '%s' is not a sandbox", projectID),
+		grpcCode: codes.FailedPrecondition,
+		details: []proto.Message{
+			&altalunev1.ErrorDetail{
+				Code: code,
+				Meta: map[string]string{
+					"project_id": projectID,
+				},
+			},
+		},
+	}
+}
+
 // domain-based
 func NewGreetingUnrecognize(greeting string) *AppError {
 	code := CodeGreetingUnrecognized
@@ -234,6 +390,42 @@ func NewGreetingUnrecognize(greeting string) *AppError {
 	}
 }
 
+// NewGreetingNameAlreadyExistsError creates an error for when a name is already allowed by the greeter
+func NewGreetingNameAlreadyExistsError(name string) *AppError {
+	code := CodeGreetingNameAlreadyExists
+	return &AppError{
+		code:     code,
+		message:  fmt.Sprintf("Name '%s' is already allowed", name),
+		grpcCode: codes.AlreadyExists,
+		details: []proto.Message{
+			&altalunev1.ErrorDetail{
+				Code: code,
+				Meta: map[string]string{
+					"name": name,
+				},
+			},
+		},
+	}
+}
+
+// NewGreetingNameNotFoundError creates an error for when a name is not allowed by the greeter
+func NewGreetingNameNotFoundError(name string) *AppError {
+	code := CodeGreetingNameNotFound
+	return &AppError{
+		code:     code,
+		message:  fmt.Sprintf("Name '%s' is not in the allowed names", name),
+		grpcCode: codes.NotFound,
+		details: []proto.Message{
+			&altalunev1.ErrorDetail{
+				Code: code,
+				Meta: map[string]string{
+					"name": name,
+				},
+			},
+		},
+	}
+}
+
 // NewEmployeeNotFoundError creates an error for when an employee is not found
 func NewEmployeeNotFoundError(publicID string) *AppError {
 	code := CodeEmployeeNotFound
@@ -398,6 +590,99 @@ func NewUserAlreadyInactiveError(userID string) *AppError {
 	}
 }
 
+// NewUserNotPendingError creates an error when a user has no registration awaiting approval
+func NewUserNotPendingError(userID string) *AppError {
+	code := CodeUserNotPending
+	return &AppError{
+		code:     code,
+		message:  "User is not awaiting approval",
+		grpcCode: codes.FailedPrecondition,
+		details: []proto.Message{
+			&altalunev1.ErrorDetail{
+				Code: code,
+				Meta: map[string]string{
+					"user_id": userID,
+				},
+			},
+		},
+	}
+}
+
+// NewUserNotLockedError creates an error when a user account is not locked
+func NewUserNotLockedError(userID string) *AppError {
+	code := CodeUserNotLocked
+	return &AppError{
+		code:     code,
+		message:  "User is not locked",
+		grpcCode: codes.FailedPrecondition,
+		details: []proto.Message{
+			&altalunev1.ErrorDetail{
+				Code: code,
+				Meta: map[string]string{
+					"user_id": userID,
+				},
+			},
+		},
+	}
+}
+
+// NewUserIsServiceAccountError creates an error when an operation needs a
+// human user, such as anything involving an email address
+func NewUserIsServiceAccountError(userID string) *AppError {
+	code := CodeUserIsServiceAccount
+	return &AppError{
+		code:     code,
+		message:  "Not available for service accounts",
+		grpcCode: codes.FailedPrecondition,
+		details: []proto.Message{
+			&altalunev1.ErrorDetail{
+				Code: code,
+				Meta: map[string]string{
+					"user_id": userID,
+				},
+			},
+		},
+	}
+}
+
+// NewUserEmailDomainBlockedError creates an error when the domain of an email
+// is blocked, such as a disposable inbox provider
+func NewUserEmailDomainBlockedError(email string) *AppError {
+	code := CodeUserEmailBlocked
+	return &AppError{
+		code:     code,
+		message:  fmt.Sprintf("Email domain is not allowed: '%s'", email),
+		grpcCode: codes.InvalidArgument,
+		details: []proto.Message{
+			&altalunev1.ErrorDetail{
+				Code: code,
+				Meta: map[string]string{
+					"email": email,
+				},
+			},
+		},
+	}
+}
+
+// NewUserEmailUndeliverableError creates an error when the domain of an email
+// has no mail server
+func NewUserEmailUndeliverableError(email string) *AppError {
+	code := CodeUserEmailNoMX
+	return &AppError{
+		code:     code,
+		message:  fmt.Sprintf("Email domain cannot receive emails: '%s'", email),
+		grpcCode: codes.InvalidArgument,
+		details: []proto.Message{
+			&altalunev1.ErrorDetail{
+				Code: code,
+				Meta: map[string]string{
+					"email": email,
+				},
+			},
+		},
+	}
+}
+
 // NewUserCannotDeleteSelfError creates an error when user tries to delete themselves
 func NewUserCannotDeleteSelfError(userID string) *AppError {
 	code := CodeUserCannotDeleteSelf
@@ -638,6 +923,45 @@ func NewCannotRemoveLastOwnerError(projectID string) *AppError {
 	}
 }
 
+// NewProjectRoleGrantDeniedError creates an error when a project member tries
+// to grant or manage a role above their own
+func NewProjectRoleGrantDeniedError(projectID, role string) *AppError {
+	code := CodeProjectRoleGrantDenied
+	return &AppError{
+		code:     code,
+		message:  fmt.Sprintf("Cannot grant or manage the '%s' role, it is above your own role in this project", role),
+		grpcCode: codes.PermissionDenied,
+		details: []proto.Message{
+			&altalunev1.ErrorDetail{
+				Code: code,
+				Meta: map[string]string{
+					"project_id": projectID,
+					"role":       role,
+				},
+			},
+		},
+	}
+}
+
+// NewOwnershipTransferNotFoundError creates an error for when a project
+// ownership transfer is not found or no longer pending
+func NewOwnershipTransferNotFoundError(transferID string) *AppError {
+	code := CodeOwnershipTransferNotFound
+	return &AppError{
+		code:     code,
+		message:  fmt.Sprintf("Pending ownership transfer with ID '%s' not found", transferID),
+		grpcCode: codes.NotFound,
+		details: []proto.Message{
+			&altalunev1.ErrorDetail{
+				Code: code,
+				Meta: map[string]string{
+					"transfer_id": transferID,
+				},
+			},
+		},
+	}
+}
+
 // NewOAuthProviderNotFoundError creates an error for when an OAuth provider is not found
 func NewOAuthProviderNotFoundError(providerID string) *AppError {
 	code := CodeOAuthProviderNotFound
@@ -710,6 +1034,44 @@ func NewOAuthProviderDecryptionError(providerID string) *AppError {
 	}
 }
 
+// NewOAuthProviderRevealUnconfirmedError creates an error for a client secret
+// reveal without a valid confirmation code
+func NewOAuthProviderRevealUnconfirmedError(providerID string) *AppError {
+	code := CodeOAuthProviderRevealUnconfirmed
+	return &AppError{
+		code:     code,
+		message:  "Confirmation code is missing, expired or invalid; request a new one to reveal the client secret",
+		grpcCode: codes.PermissionDenied,
+		details: []proto.Message{
+			&altalunev1.ErrorDetail{
+				Code: code,
+				Meta: map[string]string{
+					"provider_id": providerID,
+				},
+			},
+		},
+	}
+}
+
+// NewOAuthProviderRevealRateLimitedError creates an error for too many client
+// secret reveal attempts
+func NewOAuthProviderRevealRateLimitedError(providerID string) *AppError {
+	code := CodeOAuthProviderRevealRateLimited
+	return &AppError{
+		code:     code,
+		message:  "Too many client secret reveal attempts, try again later",
+		grpcCode: codes.ResourceExhausted,
+		details: []proto.Message{
+			&altalunev1.ErrorDetail{
+				Code: code,
+				Meta: map[string]string{
+					"provider_id": providerID,
+				},
+			},
+		},
+	}
+}
+
 // ==================== OAuth Client Domain Errors ====================
 
 // NewOAuthClientNotFoundError creates an error for OAuth client not found
@@ -748,6 +1110,24 @@ func NewOAuthClientAlreadyExistsError(name string) *AppError {
 	}
 }
 
+// NewRefreshTokenNotFoundError creates an error for refresh token not found
+func NewRefreshTokenNotFoundError(tokenID int64) *AppError {
+	code := CodeRefreshTokenNotFound
+	return &AppError{
+		code:     code,
+		message:  fmt.Sprintf("Refresh token with ID '%d' not found", tokenID),
+		grpcCode: codes.NotFound,
+		details: []proto.Message{
+			&altalunev1.ErrorDetail{
+				Code: code,
+				Meta: map[string]string{
+					"token_id": strconv.FormatInt(tokenID, 10),
+				},
+			},
+		},
+	}
+}
+
 // ==================== Chatbot Node Domain Errors ====================
 
 // NewChatbotNodeNotFoundError creates an error for when a chatbot node is not found
@@ -786,3 +1166,407 @@ func NewChatbotNodeDuplicateNameError(name, lang string) *AppError {
 		},
 	}
 }
+
+// NewFeatureFlagNotFoundError creates an error for a flag no feature declares
+func NewFeatureFlagNotFoundError(name string) *AppError {
+	code := CodeFeatureFlagNotFound
+	return &AppError{
+		code:     code,
+		message:  fmt.Sprintf("Feature flag '%s' not found", name),
+		grpcCode: codes.NotFound,
+		details: []proto.Message{
+			&altalunev1.ErrorDetail{
+				Code: code,
+				Meta: map[string]string{
+					"name": name,
+				},
+			},
+		},
+	}
+}
+
+// NewFeatureFlagOverrideNotFoundError creates an error for a project without
+// an override of the flag
+func NewFeatureFlagOverrideNotFoundError(name, projectID string) *AppError {
+	code := CodeFeatureFlagOverrideNotFound
+	return &AppError{
+		code:     code,
+		message:  fmt.Sprintf("Feature flag '%s' has no override for project '%s'", name, projectID),
+		grpcCode: codes.NotFound,
+		details: []proto.Message{
+			&altalunev1.ErrorDetail{
+				Code: code,
+				Meta: map[string]string{
+					"name":       name,
+					"project_id": projectID,
+				},
+			},
+		},
+	}
+}
+
+// NewOrganizationNotFoundError creates an error for organization not found
+func NewOrganizationNotFoundError(organizationID string) *AppError {
+	code := CodeOrganizationNotFound
+	return &AppError{
+		code:     code,
+		message:  fmt.Sprintf("Organization with ID '%s' not found", organizationID),
+		grpcCode: codes.NotFound,
+		details: []proto.Message{
+			&altalunev1.ErrorDetail{
+				Code: code,
+				Meta: map[string]string{
+					"organization_id": organizationID,
+				},
+			},
+		},
+	}
+}
+
+// NewOrganizationAccessDeniedError creates an error for a caller who is neither
+// an owner nor an admin of the organization
+func NewOrganizationAccessDeniedError(organizationID string) *AppError {
+	code := CodeOrganizationAccessDenied
+	return &AppError{
+		code:     code,
+		message:  "Only owners and admins of the organization can do this",
+		grpcCode: codes.PermissionDenied,
+		details: []proto.Message{
+			&altalunev1.ErrorDetail{
+				Code: code,
+				Meta: map[string]string{
+					"organization_id": organizationID,
+				},
+			},
+		},
+	}
+}
+
+// NewOrganizationRoleGrantDeniedError creates an error when an organization admin
+// tries to make an owner or to manage one
+func NewOrganizationRoleGrantDeniedError(organizationID, role string) *AppError {
+	code := CodeOrganizationRoleGrantDenied
+	return &AppError{
+		code:     code,
+		message:  fmt.Sprintf("Only owners of the organization can manage the role '%s'", role),
+		grpcCode: codes.PermissionDenied,
+		details: []proto.Message{
+			&altalunev1.ErrorDetail{
+				Code: code,
+				Meta: map[string]string{
+					"organization_id": organizationID,
+					"role":            role,
+				},
+			},
+		},
+	}
+}
+
+// NewCannotRemoveLastOrganizationOwnerError creates an error for removing or
+// demoting the last owner of an organization
+func NewCannotRemoveLastOrganizationOwnerError(organizationID string) *AppError {
+	code := CodeCannotRemoveLastOrganizationOwner
+	return &AppError{
+		code:     code,
+		message:  "Cannot remove the last owner from the organization",
+		grpcCode: codes.FailedPrecondition,
+		details: []proto.Message{
+			&altalunev1.ErrorDetail{
+				Code: code,
+				Meta: map[string]string{
+					"organization_id": organizationID,
+				},
+			},
+		},
+	}
+}
+
+// NewOrganizationMemberNotFoundError creates an error for a user who is not a
+// member of the organization
+func NewOrganizationMemberNotFoundError(organizationID, userID string) *AppError {
+	code := CodeOrganizationMemberNotFound
+	return &AppError{
+		code:     code,
+		message:  fmt.Sprintf("User '%s' is not a member of the organization", userID),
+		grpcCode: codes.NotFound,
+		details: []proto.Message{
+			&altalunev1.ErrorDetail{
+				Code: code,
+				Meta: map[string]string{
+					"organization_id": organizationID,
+					"user_id":         userID,
+				},
+			},
+		},
+	}
+}
+
+// NewProjectInOtherOrganizationError creates an error for adding a project that
+// already belongs to another organization
+func NewProjectInOtherOrganizationError(projectID string) *AppError {
+	code := CodeProjectInOtherOrganization
+	return &AppError{
+		code:     code,
+		message:  fmt.Sprintf("Project '%s' already belongs to another organization", projectID),
+		grpcCode: codes.FailedPrecondition,
+		details: []proto.Message{
+			&altalunev1.ErrorDetail{
+				Code: code,
+				Meta: map[string]string{
+					"project_id": projectID,
+				},
+			},
+		},
+	}
+}
+
+// NewProjectNotInOrganizationError creates an error for a project that does not
+// belong to the organization
+func NewProjectNotInOrganizationError(organizationID, projectID string) *AppError {
+	code := CodeProjectNotInOrganization
+	return &AppError{
+		code:     code,
+		message:  fmt.Sprintf("Project '%s' does not belong to the organization", projectID),
+		grpcCode: codes.NotFound,
+		details: []proto.Message{
+			&altalunev1.ErrorDetail{
+				Code: code,
+				Meta: map[string]string{
+					"organization_id": organizationID,
+					"project_id":      projectID,
+				},
+			},
+		},
+	}
+}
+
+// NewPlanLimitReachedError creates an error for creating a resource beyond the
+// limit of the billing plan, resource being projects, clients or members
+func NewPlanLimitReachedError(resource string, limit int, plan string) *AppError {
+	code := CodePlanLimitReached
+	return &AppError{
+		code:     code,
+		message:  fmt.Sprintf("The %s plan allows %d %s at most, upgrade to add more", plan, limit, resource),
+		grpcCode: codes.FailedPrecondition,
+		details: []proto.Message{
+			&altalunev1.ErrorDetail{
+				Code: code,
+				Meta: map[string]string{
+					"resource": resource,
+					"limit":    strconv.Itoa(limit),
+					"plan":     plan,
+				},
+			},
+		},
+	}
+}
+
+// NewBillingCustomerMissingError creates an error for billing an organization
+// that is not a customer of the billing provider yet
+func NewBillingCustomerMissingError(organizationID string) *AppError {
+	code := CodeBillingCustomerMissing
+	return &AppError{
+		code:     code,
+		message:  fmt.Sprintf("Organization '%s' has no billing customer, subscribe to a plan first", organizationID),
+		grpcCode: codes.FailedPrecondition,
+		details: []proto.Message{
+			&altalunev1.ErrorDetail{
+				Code: code,
+				Meta: map[string]string{
+					"organization_id": organizationID,
+				},
+			},
+		},
+	}
+}
+
+// NewBillingProviderUnavailableError creates an error for a billing provider
+// that cannot be reached or is not configured
+func NewBillingProviderUnavailableError() *AppError {
+	code := CodeBillingProviderUnavailable
+	return &AppError{
+		code:     code,
+		message:  "The billing provider is unavailable, try again later",
+		grpcCode: codes.Unavailable,
+		details: []proto.Message{
+			&altalunev1.ErrorDetail{
+				Code: code,
+			},
+		},
+	}
+}
+
+// NewExternalIDTakenError creates an error for an external ID that already
+// identifies another resource of the kind
+func NewExternalIDTakenError(resource, externalID string) *AppError {
+	code := CodeExternalIDTaken
+	return &AppError{
+		code:     code,
+		message:  fmt.Sprintf("External ID '%s' already identifies another %s", externalID, resource),
+		grpcCode: codes.AlreadyExists,
+		details: []proto.Message{
+			&altalunev1.ErrorDetail{
+				Code: code,
+				Meta: map[string]string{
+					"resource":    resource,
+					"external_id": externalID,
+				},
+			},
+		},
+	}
+}
+
+// NewResourceAlreadyManagedError creates an error for importing a resource
+// that is already managed under another external ID
+func NewResourceAlreadyManagedError(resource, name, externalID string) *AppError {
+	code := CodeResourceAlreadyManaged
+	return &AppError{
+		code:     code,
+		message:  fmt.Sprintf("The %s '%s' is already managed under external ID '%s'", resource, name, externalID),
+		grpcCode: codes.FailedPrecondition,
+		details: []proto.Message{
+			&altalunev1.ErrorDetail{
+				Code: code,
+				Meta: map[string]string{
+					"resource":    resource,
+					"name":        name,
+					"external_id": externalID,
+				},
+			},
+		},
+	}
+}
+
+// NewImportTargetNotFoundError creates an error for importing a resource by a
+// name no live resource has
+func NewImportTargetNotFoundError(resource, name string) *AppError {
+	code := CodeImportTargetNotFound
+	return &AppError{
+		code:     code,
+		message:  fmt.Sprintf("No %s named '%s' to import", resource, name),
+		grpcCode: codes.NotFound,
+		details: []proto.Message{
+			&altalunev1.ErrorDetail{
+				Code: code,
+				Meta: map[string]string{
+					"resource": resource,
+					"name":     name,
+				},
+			},
+		},
+	}
+}
+
+// NewImportTargetAmbiguousError creates an error for importing a resource by
+// a name several live resources have
+func NewImportTargetAmbiguousError(resource, name string, count int) *AppError {
+	code := CodeImportTargetAmbiguous
+	return &AppError{
+		code:     code,
+		message:  fmt.Sprintf("%d records of %s are named '%s', rename all but one to import it", count, resource, name),
+		grpcCode: codes.FailedPrecondition,
+		details: []proto.Message{
+			&altalunev1.ErrorDetail{
+				Code: code,
+				Meta: map[string]string{
+					"resource": resource,
+					"name":     name,
+					"count":    strconv.Itoa(count),
+				},
+			},
+		},
+	}
+}
+
+// NewImmutableFieldChangedError creates an error for applying a change to a
+// field that is fixed once the resource exists
+func NewImmutableFieldChangedError(resource, field string) *AppError {
+	code := CodeImmutableFieldChanged
+	return &AppError{
+		code:     code,
+		message:  fmt.Sprintf("The %s of an existing %s cannot change, delete it and apply it again", field, resource),
+		grpcCode: codes.FailedPrecondition,
+		details: []proto.Message{
+			&altalunev1.ErrorDetail{
+				Code: code,
+				Meta: map[string]string{
+					"resource": resource,
+					"field":    field,
+				},
+			},
+		},
+	}
+}
+
+// NewSavedViewNotFoundError creates an error for a saved view the caller has
+// not saved
+func NewSavedViewNotFoundError(publicID string) *AppError {
+	code := CodeSavedViewNotFound
+	return &AppError{
+		code:     code,
+		message:  fmt.Sprintf("Saved view with ID '%s' not found", publicID),
+		grpcCode: codes.NotFound,
+		details: []proto.Message{
+			&altalunev1.ErrorDetail{
+				Code: code,
+				Meta: map[string]string{
+					"view_id": publicID,
+				},
+			},
+		},
+	}
+}
+
+// NewSavedViewAlreadyExistsError creates an error for a view name the caller
+// already saved for the same table
+func NewSavedViewAlreadyExistsError(name string) *AppError {
+	code := CodeSavedViewAlreadyExists
+	return &AppError{
+		code:     code,
+		message:  fmt.Sprintf("A saved view named '%s' already exists", name),
+		grpcCode: codes.AlreadyExists,
+		details: []proto.Message{
+			&altalunev1.ErrorDetail{
+				Code: code,
+				Meta: map[string]string{
+					"name": name,
+				},
+			},
+		},
+	}
+}
+
+// NewSavedViewOwnerRequiredError creates an error for a caller without a user
+// to own saved views, such as requests with authentication disabled
+func NewSavedViewOwnerRequiredError() *AppError {
+	code := CodeSavedViewOwnerRequired
+	return &AppError{
+		code:     code,
+		message:  "Saved views belong to a user, sign in to use them",
+		grpcCode: codes.FailedPrecondition,
+		details: []proto.Message{
+			&altalunev1.ErrorDetail{
+				Code: code,
+			},
+		},
+	}
+}
+
+// NewQueryExportNotFoundError creates an error for an export the caller has
+// not started, or that expired
+func NewQueryExportNotFoundError(publicID string) *AppError {
+	code := CodeQueryExportNotFound
+	return &AppError{
+		code:     code,
+		message:  fmt.Sprintf("Export with ID '%s' not found", publicID),
+		grpcCode: codes.NotFound,
+		details: []proto.Message{
+			&altalunev1.ErrorDetail{
+				Code: code,
+				Meta: map[string]string{
+					"export_id": publicID,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,238 @@
+package altalune
+
+import (
+	"net/http"
+
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrorDefinition describes a single entry of the error catalog.
+// Codes are stable: clients may rely on them, so never reuse or renumber one.
+type ErrorDefinition struct {
+	Code        string
+	Domain      string
+	GRPCCode    codes.Code
+	Retryable   bool
+	Description string
+}
+
+// HTTPStatus returns the HTTP status code Connect uses for the definition's gRPC code.
+func (d ErrorDefinition) HTTPStatus() int {
+	return httpStatusFromGRPC(d.GRPCCode)
+}
+
+// errorCatalog is the single source of truth for application error codes.
+// Keep it ordered by code; docs/errors.md is generated from it (make errors-doc).
+var errorCatalog = []ErrorDefinition{
+	// Validation Errors (600XX)
+	{CodeInvalidPayload, "validation", codes.InvalidArgument, false, "Request payload failed validation"},
+	{CodeVersionConflict, "validation", codes.FailedPrecondition, false, "Resource was modified since the version the update was based on"},
+
+	// Greeting Domain Errors (601XX)
+	{CodeGreetingUnrecognized, "greeting", codes.InvalidArgument, false, "Greeting name is not recognized"},
+	{CodeGreetingNameAlreadyExists, "greeting", codes.AlreadyExists, false, "Name is already allowed by the greeter"},
+	{CodeGreetingNameNotFound, "greeting", codes.NotFound, false, "Name is not in the greeter's allowed names"},
+
+	// Example/Employee Domain Errors (602XX)
+	{CodeEmployeeNotFound, "employee", codes.NotFound, false, "Employee does not exist in the project"},
+	{CodeEmployeeAlreadyExists, "employee", codes.AlreadyExists, false, "Employee with the same email already exists"},
+
+	// Project Domain Errors (603XX)
+	{CodeProjectNotFound, "project", codes.NotFound, false, "Project does not exist"},
+	{CodeProjectHostnameNotFound, "project", codes.NotFound, false, "Project hostname does not exist in the project"},
+	{CodeProjectHostnameAlreadyExists, "project", codes.AlreadyExists, false, "Hostname is already registered by a project"},
+	{CodeProjectQuotaExceeded, "project", codes.ResourceExhausted, false, "Project has used its monthly API call quota"},
+	{CodeProjectNotSandbox, "project", codes.FailedPrecondition, false, "Project is live, and the tool is for sandbox projects only"},
+
+	// API Key Domain Errors (604XX)
+	{CodeApiKeyNotFound, "api_key", codes.NotFound, false, "API key does not exist in the project"},
+	{CodeApiKeyAlreadyExists, "api_key", codes.AlreadyExists, false, "API key with the same name already exists"},
+
+	// User Domain Errors (605XX)
+	{CodeUserNotFound, "user", codes.NotFound, false, "User does not exist"},
+	{CodeUserAlreadyExists, "user", codes.AlreadyExists, false, "User with the same email already exists"},
+	{CodeUserInvalidEmail, "user", codes.InvalidArgument, false, "Email address is not valid"},
+	{CodeUserAlreadyActive, "user", codes.FailedPrecondition, false, "User is already active"},
+	{CodeUserAlreadyInactive, "user", codes.FailedPrecondition, false, "User is already inactive"},
+	{CodeUserCannotDeleteSelf, "user", codes.PermissionDenied, false, "Users cannot delete their own account"},
+	{CodeUserNotPending, "user", codes.FailedPrecondition, false, "User registration is not awaiting approval"},
+	{CodeUserNotLocked, "user", codes.FailedPrecondition, false, "User account is not locked after failed sign-ins"},
+	{CodeUserIsServiceAccount, "user", codes.FailedPrecondition, false, "Operation needs a human user, service accounts have no email"},
+	{CodeUserEmailBlocked, "user", codes.InvalidArgument, false, "Email domain is blocked, e.g. a disposable inbox provider"},
+	{CodeUserEmailNoMX, "user", codes.InvalidArgument, false, "Email domain has no mail server to receive emails"},
+
+	// Role Domain Errors (606XX)
+	{CodeRoleNotFound, "role", codes.NotFound, false, "Role does not exist"},
+	{CodeRoleAlreadyExists, "role", codes.AlreadyExists, false, "Role with the same name already exists"},
+	{CodeRoleInvalidName, "role", codes.InvalidArgument, false, "Role name is not valid"},
+	{CodeRoleInUse, "role", codes.FailedPrecondition, false, "Role is still assigned and cannot be deleted"},
+	{CodeRoleProtected, "role", codes.PermissionDenied, false, "Role is protected and cannot be modified"},
+
+	// Permission Domain Errors (607XX)
+	{CodePermissionNotFound, "permission", codes.NotFound, false, "Permission does not exist"},
+	{CodePermissionAlreadyExists, "permission", codes.AlreadyExists, false, "Permission with the same name already exists"},
+	{CodePermissionInvalidName, "permission", codes.InvalidArgument, false, "Permission name is not valid"},
+	{CodePermissionInUse, "permission", codes.FailedPrecondition, false, "Permission is still assigned and cannot be deleted"},
+	{CodePermissionProtected, "permission", codes.PermissionDenied, false, "Permission is protected and cannot be modified"},
+
+	// IAM Mapper Domain Errors (608XX)
+	{CodeMappingNotFound, "iam_mapper", codes.NotFound, false, "Mapping does not exist"},
+	{CodeMappingAlreadyExists, "iam_mapper", codes.AlreadyExists, false, "Mapping already exists"},
+	{CodeInvalidProjectRole, "iam_mapper", codes.InvalidArgument, false, "Project member role is not valid"},
+	{CodeCannotRemoveLastOwner, "iam_mapper", codes.FailedPrecondition, false, "Project must keep at least one owner"},
+	{CodeMappingUserNotFound, "iam_mapper", codes.NotFound, false, "Mapped user does not exist"},
+	{CodeMappingRoleNotFound, "iam_mapper", codes.NotFound, false, "Mapped role does not exist"},
+	{CodeMappingPermissionNotFound, "iam_mapper", codes.NotFound, false, "Mapped permission does not exist"},
+	{CodeMappingProjectNotFound, "iam_mapper", codes.NotFound, false, "Mapped project does not exist"},
+	{CodeProjectRoleGrantDenied, "iam_mapper", codes.PermissionDenied, false, "Project role ranks above the caller's own role"},
+	{CodeOwnershipTransferNotFound, "iam_mapper", codes.NotFound, false, "Pending project ownership transfer does not exist"},
+
+	// OAuth Provider Domain Errors (608XX continued)
+	{CodeOAuthProviderNotFound, "oauth_provider", codes.NotFound, false, "OAuth provider does not exist"},
+	{CodeOAuthProviderDuplicateType, "oauth_provider", codes.AlreadyExists, false, "OAuth provider of the same type already exists"},
+	{CodeOAuthProviderEncryptionError, "oauth_provider", codes.Internal, true, "OAuth provider secret could not be encrypted"},
+	{CodeOAuthProviderDecryptionError, "oauth_provider", codes.Internal, false, "OAuth provider secret could not be decrypted"},
+	{CodeOAuthProviderRevealUnconfirmed, "oauth_provider", codes.PermissionDenied, false, "OAuth provider secret reveal lacks a valid confirmation code"},
+	{CodeOAuthProviderRevealRateLimited, "oauth_provider", codes.ResourceExhausted, true, "OAuth provider secret reveals are rate limited"},
+
+	// OAuth Client Domain Errors (609XX)
+	{CodeOAuthClientNotFound, "oauth_client", codes.NotFound, false, "OAuth client does not exist"},
+	{CodeOAuthClientAlreadyExists, "oauth_client", codes.AlreadyExists, false, "OAuth client with the same name already exists"},
+	{CodeInvalidRedirectURI, "oauth_client", codes.InvalidArgument, false, "Redirect URI is not valid"},
+	{CodeOAuthClientSecretInvalid, "oauth_client", codes.InvalidArgument, false, "OAuth client secret is not valid"},
+	{CodeRefreshTokenNotFound, "oauth_client", codes.NotFound, false, "Refresh token does not exist"},
+
+	// Chatbot Node Domain Errors (610XX)
+	{CodeChatbotNodeNotFound, "chatbot_node", codes.NotFound, false, "Chatbot node does not exist"},
+	{CodeChatbotNodeInvalidName, "chatbot_node", codes.InvalidArgument, false, "Chatbot node name is not valid"},
+	{CodeChatbotNodeInvalidLang, "chatbot_node", codes.InvalidArgument, false, "Chatbot node language is not supported"},
+	{CodeChatbotNodeDuplicateName, "chatbot_node", codes.AlreadyExists, false, "Chatbot node with the same name and language already exists"},
+	{CodeChatbotNodeNoTriggers, "chatbot_node", codes.InvalidArgument, false, "Chatbot node requires at least one trigger"},
+	{CodeChatbotNodeNoMessages, "chatbot_node", codes.InvalidArgument, false, "Chatbot node requires at least one message"},
+	{CodeChatbotNodeInvalidTrigger, "chatbot_node", codes.InvalidArgument, false, "Chatbot node trigger is not valid"},
+	{CodeChatbotNodeInvalidRegex, "chatbot_node", codes.InvalidArgument, false, "Chatbot node trigger regex does not compile"},
+
+	// Feature Flag Errors (611XX)
+	{CodeFeatureFlagNotFound, "feature_flag", codes.NotFound, false, "Feature flag is not declared by any feature"},
+	{CodeFeatureFlagOverrideNotFound, "feature_flag", codes.NotFound, false, "Project has no override of the feature flag"},
+
+	// Organization Domain Errors (612XX)
+	{CodeOrganizationNotFound, "organization", codes.NotFound, false, "Organization does not exist"},
+	{CodeOrganizationAccessDenied, "organization", codes.PermissionDenied, false, "Caller is neither an owner nor an admin of the organization"},
+	{CodeOrganizationRoleGrantDenied, "organization", codes.PermissionDenied, false, "Only organization owners can make or manage owners"},
+	{CodeCannotRemoveLastOrganizationOwner, "organization", codes.FailedPrecondition, false, "Organization must keep at least one owner"},
+	{CodeOrganizationMemberNotFound, "organization", codes.NotFound, false, "User is not a member of the organization"},
+	{CodeProjectInOtherOrganization, "organization", codes.FailedPrecondition, false, "Project already belongs to another organization"},
+	{CodeProjectNotInOrganization, "organization", codes.NotFound, false, "Project does not belong to the organization"},
+
+	// Billing Domain Errors (613XX)
+	{CodePlanLimitReached, "billing", codes.FailedPrecondition, false, "Billing plan does not allow more projects, clients or members"},
+	{CodeBillingCustomerMissing, "billing", codes.FailedPrecondition, false, "Organization is not a customer of the billing provider"},
+	{CodeBillingProviderUnavailable, "billing", codes.Unavailable, true, "Billing provider cannot be reached or is not configured"},
+
+	// Provisioning Errors (614XX)
+	{CodeExternalIDTaken, "provisioning", codes.AlreadyExists, false, "External ID already identifies another resource of the kind"},
+	{CodeResourceAlreadyManaged, "provisioning", codes.FailedPrecondition, false, "Resource to import is already managed under another external ID"},
+	{CodeImportTargetNotFound, "provisioning", codes.NotFound, false, "No live resource has the name to import"},
+	{CodeImportTargetAmbiguous, "provisioning", codes.FailedPrecondition, false, "Several live resources have the name to import"},
+	{CodeImmutableFieldChanged, "provisioning", codes.FailedPrecondition, false, "Field cannot change once the resource exists"},
+
+	// Saved View Domain Errors (615XX)
+	{CodeSavedViewNotFound, "saved_view", codes.NotFound, false, "Saved view not found among the caller's views"},
+	{CodeSavedViewAlreadyExists, "saved_view", codes.AlreadyExists, false, "Caller already saved a view with the name for the table"},
+	{CodeSavedViewOwnerRequired, "saved_view", codes.FailedPrecondition, false, "Caller has no user to own saved views"},
+
+	// Query Export Domain Errors (616XX)
+	{CodeQueryExportNotFound, "query_export", codes.NotFound, false, "Export not found among the caller's exports, or expired"},
+
+	// Internal Errors (699XX)
+	{CodeUnexpectedError, "internal", codes.Internal, true, "Unexpected server error"},
+}
+
+var errorCatalogByCode = func() map[string]ErrorDefinition {
+	m := make(map[string]ErrorDefinition, len(errorCatalog))
+	for _, d := range errorCatalog {
+		m[d.Code] = d
+	}
+	return m
+}()
+
+// ErrorCatalog returns every registered error definition ordered by code.
+func ErrorCatalog() []ErrorDefinition {
+	out := make([]ErrorDefinition, len(errorCatalog))
+	copy(out, errorCatalog)
+	return out
+}
+
+// LookupErrorDefinition returns the catalog entry for the given code.
+func LookupErrorDefinition(code string) (ErrorDefinition, bool) {
+	d, ok := errorCatalogByCode[code]
+	return d, ok
+}
+
+// catalogDetails returns the error details with catalog metadata attached to
+// every ErrorDetail. An ErrorDetail is added when the constructor set none, so
+// clients can always rely on finding the code in the details.
+func (e *AppError) catalogDetails() []proto.Message {
+	def, ok := LookupErrorDefinition(e.code)
+	if !ok {
+		def = ErrorDefinition{Code: e.code, GRPCCode: e.grpcCode}
+	}
+
+	details := make([]proto.Message, 0, len(e.details)+1)
+	hasErrorDetail := false
+	for _, d := range e.details {
+		if errDetail, ok := d.(*altalunev1.ErrorDetail); ok {
+			hasErrorDetail = true
+			enriched := proto.Clone(errDetail).(*altalunev1.ErrorDetail)
+			enriched.Domain = def.Domain
+			enriched.Retryable = def.Retryable
+			enriched.HttpStatus = int32(httpStatusFromGRPC(e.grpcCode))
+			d = enriched
+		}
+		details = append(details, d)
+	}
+
+	if !hasErrorDetail {
+		details = append([]proto.Message{&altalunev1.ErrorDetail{
+			Code:       e.code,
+			Domain:     def.Domain,
+			Retryable:  def.Retryable,
+			HttpStatus: int32(httpStatusFromGRPC(e.grpcCode)),
+		}}, details...)
+	}
+
+	return details
+}
+
+// httpStatusFromGRPC mirrors the Connect protocol's mapping of error codes to HTTP statuses.
+func httpStatusFromGRPC(c codes.Code) int {
+	switch c {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,66 @@
+package altalune
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+)
+
+func TestErrorCatalogCodesAreUniqueAndOrdered(t *testing.T) {
+	seen := make(map[string]bool)
+	prev := ""
+	for _, d := range ErrorCatalog() {
+		assert.False(t, seen[d.Code], "duplicate error code %s", d.Code)
+		assert.Greater(t, d.Code, prev, "error catalog must be ordered by code")
+		assert.NotEmpty(t, d.Domain, "error code %s has no domain", d.Code)
+		assert.NotEmpty(t, d.Description, "error code %s has no description", d.Code)
+		seen[d.Code] = true
+		prev = d.Code
+	}
+}
+
+func TestToConnectErrorAttachesCatalogMetadata(t *testing.T) {
+	err := ToConnectError(NewProjectNotFound("abc123"))
+
+	var cErr *connect.Error
+	require.True(t, errors.As(err, &cErr))
+	assert.Equal(t, connect.CodeNotFound, cErr.Code())
+
+	detail := findErrorDetail(t, cErr)
+	assert.Equal(t, CodeProjectNotFound, detail.Code)
+	assert.Equal(t, "project", detail.Domain)
+	assert.False(t, detail.Retryable)
+	assert.Equal(t, int32(http.StatusNotFound), detail.HttpStatus)
+	assert.Equal(t, "abc123", detail.Meta["project_id"])
+}
+
+func TestToConnectErrorAddsDetailWhenMissing(t *testing.T) {
+	err := ToConnectError(NewInvalidPayloadError("name is required"))
+
+	var cErr *connect.Error
+	require.True(t, errors.As(err, &cErr))
+
+	detail := findErrorDetail(t, cErr)
+	assert.Equal(t, CodeInvalidPayload, detail.Code)
+	assert.Equal(t, "validation", detail.Domain)
+	assert.Equal(t, int32(http.StatusBadRequest), detail.HttpStatus)
+}
+
+func findErrorDetail(t *testing.T, cErr *connect.Error) *altalunev1.ErrorDetail {
+	t.Helper()
+	for _, d := range cErr.Details() {
+		msg, err := d.Value()
+		require.NoError(t, err)
+		if detail, ok := msg.(*altalunev1.ErrorDetail); ok {
+			return detail
+		}
+	}
+	t.Fatal("ErrorDetail not found in connect error details")
+	return nil
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	sessionCookieName  = "example_oauthclient_session_id"
+	returnToCookieName = "return_to"
+)
+
+// CookieOptions are the attributes of every cookie the client sets, so it
+// can run behind a TLS-terminating proxy, under a sub path or share its
+// cookies with sibling subdomains.
+type CookieOptions struct {
+	Secure   bool
+	SameSite http.SameSite
+	Domain   string // Empty scopes cookies to the client's host
+	Path     string
+}
+
+// parseSameSite parses a SameSite mode: lax, strict or none
+func parseSameSite(mode string) (http.SameSite, error) {
+	switch strings.ToLower(mode) {
+	case "lax":
+		return http.SameSiteLaxMode, nil
+	case "strict":
+		return http.SameSiteStrictMode, nil
+	case "none":
+		return http.SameSiteNoneMode, nil
+	default:
+		return 0, fmt.Errorf("invalid SameSite mode %q (must be one of: lax, strict, none)", mode)
+	}
+}
+
+// name returns name with the __Host- prefix when the cookie qualifies for it:
+// Secure, without a domain and at path "/"
+func (o CookieOptions) name(name string) string {
+	if o.Secure && o.Domain == "" && o.Path == "/" {
+		return "__Host-" + name
+	}
+	return name
+}
+
+// newCookie returns an HttpOnly cookie with the options applied, kept for
+// maxAge seconds
+func (o CookieOptions) newCookie(name, value string, maxAge int) *http.Cookie {
+	return &http.Cookie{
+		Name:     o.name(name),
+		Value:    value,
+		Path:     o.Path,
+		Domain:   o.Domain,
+		MaxAge:   maxAge,
+		HttpOnly: true,
+		Secure:   o.Secure,
+		SameSite: o.SameSite,
+	}
+}
+
+// expireCookie returns the cookie deleting the one newCookie(name, ...) sets
+func (o CookieOptions) expireCookie(name string) *http.Cookie {
+	return o.newCookie(name, "", -1)
+}
+
+// readCookie returns the value of the cookie newCookie(name, ...) set, empty
+// when the request has none
+func (o CookieOptions) readCookie(r *http.Request, name string) string {
+	cookie, err := r.Cookie(o.name(name))
+	if err != nil {
+		return ""
+	}
+	return cookie.Value
+}
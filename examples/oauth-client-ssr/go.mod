@@ -1,21 +1,10 @@
 module examples/oauth-client-ssr
 
-go 1.23
+go 1.24.0
 
 require (
-	github.com/golang-jwt/jwt/v5 v5.2.1
-	github.com/lestrrat-go/jwx/v2 v2.1.3
+	github.com/golang-jwt/jwt/v5 v5.3.0
+	github.com/hrz8/altalune v0.0.0
 )
 
-require (
-	github.com/decred/dcrd/dcrec/secp256k1/v4 v4.3.0 // indirect
-	github.com/goccy/go-json v0.10.4 // indirect
-	github.com/lestrrat-go/blackmagic v1.0.2 // indirect
-	github.com/lestrrat-go/httpcc v1.0.1 // indirect
-	github.com/lestrrat-go/httprc v1.0.6 // indirect
-	github.com/lestrrat-go/iter v1.0.2 // indirect
-	github.com/lestrrat-go/option v1.0.1 // indirect
-	github.com/segmentio/asm v1.2.0 // indirect
-	golang.org/x/crypto v0.31.0 // indirect
-	golang.org/x/sys v0.28.0 // indirect
-)
+replace github.com/hrz8/altalune => ../..
@@ -20,7 +20,7 @@ import (
 	"examples/oauth-client-ssr/views"
 
 	"github.com/golang-jwt/jwt/v5"
-	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/hrz8/altalune/jwkscache"
 )
 
 // Config holds the OAuth client configuration
@@ -29,8 +29,13 @@ type Config struct {
 	ClientID      string
 	ClientSecret  string
 	RedirectURI   string
+	LogoutURI     string // Where the auth server sends users back after logout
 	Scopes        []string
 	Port          int
+
+	SessionFile       string        // Persist sessions to this file; in memory when empty
+	BackgroundRefresh time.Duration // Refresh expiring sessions at this interval; only on request when 0
+	Cookies           CookieOptions // Attributes of the session and return_to cookies
 }
 
 // TokenResponse represents the token endpoint response
@@ -48,20 +53,6 @@ type OAuthError struct {
 	Description string `json:"error_description,omitempty"`
 }
 
-// SessionStore manages user sessions
-type SessionStore struct {
-	mu       sync.RWMutex
-	sessions map[string]*Session
-}
-
-// Session holds user session data
-type Session struct {
-	AccessToken  string
-	RefreshToken string
-	ExpiresAt    time.Time
-	UserInfo     map[string]any
-}
-
 // PendingAuth tracks pending OAuth authorization requests
 type PendingAuth struct {
 	State        string
@@ -69,14 +60,33 @@ type PendingAuth struct {
 	CreatedAt    time.Time
 }
 
+// sessionCookieMaxAge keeps the session cookie for the server's default
+// refresh token lifetime (30 days): the session outlives its access tokens,
+// and the server ends it by rejecting the refresh token.
+const sessionCookieMaxAge = 30 * 24 * 60 * 60
+
 var (
 	config       *Config
-	sessionStore = &SessionStore{sessions: make(map[string]*Session)}
+	sessionStore *SessionStore
 	pendingAuths = &sync.Map{}
+
+	// jwks caches the authorization server's signing keys between requests,
+	// revalidating them with the server's ETag once an hour
+	jwks *jwkscache.Cache
 )
 
 func main() {
 	config = parseFlags()
+	jwks = jwkscache.New(config.AuthServerURL+"/.well-known/jwks.json", jwkscache.Options{})
+
+	var err error
+	sessionStore, err = NewSessionStore(config.SessionFile)
+	if err != nil {
+		log.Fatalf("Failed to load sessions: %v", err)
+	}
+	if config.BackgroundRefresh > 0 {
+		go refreshSessionsInBackground(config.BackgroundRefresh)
+	}
 
 	mux := http.NewServeMux()
 	mux.HandleFunc("/", handleHome)
@@ -94,6 +104,9 @@ func main() {
 	log.Printf("OAuth Example Client starting at http://localhost%s", addr)
 	log.Printf("Authorization Server: %s", config.AuthServerURL)
 	log.Printf("Client ID: %s", config.ClientID)
+	if config.SessionFile != "" {
+		log.Printf("Sessions: %s", config.SessionFile)
+	}
 	log.Println()
 	log.Println("Open your browser and navigate to the home page to begin")
 
@@ -108,6 +121,12 @@ func parseFlags() *Config {
 	clientSecret := flag.String("client-secret", "", "OAuth client secret (required)")
 	port := flag.Int("port", 8080, "Local web server port")
 	scopes := flag.String("scopes", "openid profile email", "Space-separated scopes")
+	sessionFile := flag.String("session-file", "", "File to persist sessions to, so restarts keep users logged in (default: in memory)")
+	backgroundRefresh := flag.Duration("background-refresh", 0, "Interval at which expiring sessions are refreshed in the background, e.g. 30s (default: refresh on request only)")
+	cookieSecure := flag.Bool("cookie-secure", false, "Only send cookies over HTTPS; without -cookie-domain and at path / they get the __Host- prefix")
+	cookieSameSite := flag.String("cookie-samesite", "lax", "SameSite mode of the cookies: lax, strict or none (requires -cookie-secure)")
+	cookieDomain := flag.String("cookie-domain", "", "Share the cookies with every subdomain of this domain (default: this host only)")
+	cookiePath := flag.String("cookie-path", "/", "Path the cookies are sent for")
 
 	flag.Parse()
 
@@ -118,25 +137,42 @@ func parseFlags() *Config {
 		os.Exit(1)
 	}
 
+	sameSite, err := parseSameSite(*cookieSameSite)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if sameSite == http.SameSiteNoneMode && !*cookieSecure {
+		log.Fatal("-cookie-samesite none requires -cookie-secure")
+	}
+
 	return &Config{
 		AuthServerURL: *authServer,
 		ClientID:      *clientID,
 		ClientSecret:  *clientSecret,
 		RedirectURI:   fmt.Sprintf("http://localhost:%d/callback", *port),
+		LogoutURI:     fmt.Sprintf("http://localhost:%d/", *port),
 		Scopes:        strings.Split(*scopes, " "),
 		Port:          *port,
+
+		SessionFile:       *sessionFile,
+		BackgroundRefresh: *backgroundRefresh,
+		Cookies: CookieOptions{
+			Secure:   *cookieSecure,
+			SameSite: sameSite,
+			Domain:   *cookieDomain,
+			Path:     *cookiePath,
+		},
 	}
 }
 
 func handleHome(w http.ResponseWriter, r *http.Request) {
-	sessionID := getSessionID(r)
-	session := sessionStore.Get(sessionID)
+	session := currentSession(w, r)
 
 	data := map[string]any{
-		"Authenticated": session != nil && !session.IsExpired(),
+		"Authenticated": session != nil,
 	}
 
-	if session != nil && !session.IsExpired() {
+	if session != nil {
 		data["AccessToken"] = truncateToken(session.AccessToken)
 		if session.UserInfo != nil {
 			userInfoJSON, _ := json.MarshalIndent(session.UserInfo, "", "  ")
@@ -152,10 +188,7 @@ func handleHome(w http.ResponseWriter, r *http.Request) {
 
 func handleLogin(w http.ResponseWriter, r *http.Request) {
 	// Check if user is already logged in
-	sessionID := getSessionID(r)
-	session := sessionStore.Get(sessionID)
-
-	if session != nil && !session.IsExpired() {
+	if currentSession(w, r) != nil {
 		// Already logged in, redirect to home
 		http.Redirect(w, r, "/", http.StatusFound)
 		return
@@ -261,26 +294,14 @@ func handleCallback(w http.ResponseWriter, r *http.Request) {
 	sessionStore.Set(sessionID, session)
 
 	// Set session cookie
-	http.SetCookie(w, &http.Cookie{
-		Name:     "example_oauthclient_session_id",
-		Value:    sessionID,
-		Path:     "/",
-		HttpOnly: true,
-		MaxAge:   tokens.ExpiresIn,
-	})
+	setSessionCookie(w, sessionID)
 
 	// Check for return_to cookie
 	returnTo := "/"
-	if cookie, err := r.Cookie("return_to"); err == nil && cookie.Value != "" {
-		returnTo = cookie.Value
+	if value := config.Cookies.readCookie(r, returnToCookieName); value != "" {
+		returnTo = value
 		// Clear the return_to cookie
-		http.SetCookie(w, &http.Cookie{
-			Name:     "return_to",
-			Value:    "",
-			Path:     "/",
-			HttpOnly: true,
-			MaxAge:   -1,
-		})
+		http.SetCookie(w, config.Cookies.expireCookie(returnToCookieName))
 	}
 
 	// Show success page with redirect
@@ -289,105 +310,150 @@ func handleCallback(w http.ResponseWriter, r *http.Request) {
 
 func handleLogout(w http.ResponseWriter, r *http.Request) {
 	sessionID := getSessionID(r)
-	if sessionID != "" {
+	if session := sessionStore.Get(sessionID); session != nil {
+		// Revoke the refresh token so it can't outlive the session
+		if session.RefreshToken != "" {
+			if oauthErr := revokeToken(session.RefreshToken, "refresh_token"); oauthErr != nil {
+				log.Printf("[Auth] Token revocation failed: %s - %s", oauthErr.Error, oauthErr.Description)
+			}
+		}
 		sessionStore.Delete(sessionID)
 	}
 
 	// Clear session cookie
-	http.SetCookie(w, &http.Cookie{
-		Name:     "example_oauthclient_session_id",
-		Value:    "",
-		Path:     "/",
-		HttpOnly: true,
-		MaxAge:   -1,
-	})
+	clearSessionCookie(w)
 
-	http.Redirect(w, r, "/", http.StatusFound)
+	// End the session on the auth server too, otherwise the next login would
+	// skip the login page; the auth server redirects back to the home page
+	params := url.Values{
+		"client_id":                {config.ClientID},
+		"post_logout_redirect_uri": {config.LogoutURI},
+	}
+	http.Redirect(w, r, config.AuthServerURL+"/oauth/end_session?"+params.Encode(), http.StatusFound)
 }
 
 // requireAuth is middleware that protects routes requiring authentication
 func requireAuth(next http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		sessionID := getSessionID(r)
-		session := sessionStore.Get(sessionID)
-
-		// No session at all
-		if session == nil {
+		if currentSession(w, r) == nil {
 			redirectToLogin(w, r)
 			return
 		}
 
-		// Session expired or about to expire - attempt refresh
-		if session.IsExpired() || session.IsAboutToExpire() {
-			if session.RefreshToken == "" {
-				log.Printf("[Auth] No refresh token available, redirecting to login")
-				sessionStore.Delete(sessionID)
-				redirectToLogin(w, r)
-				return
-			}
+		next(w, r)
+	}
+}
 
-			log.Printf("[Auth] Token expired/expiring, attempting refresh...")
-			tokens, oauthErr := refreshAccessToken(session.RefreshToken)
-			if oauthErr != nil {
-				log.Printf("[Auth] Token refresh failed: %s - %s", oauthErr.Error, oauthErr.Description)
-
-				// If refresh token is invalid/expired, clear session and redirect to login
-				if oauthErr.Error == "invalid_grant" {
-					log.Printf("[Auth] Refresh token invalid, clearing session")
-					sessionStore.Delete(sessionID)
-					// Clear session cookie
-					http.SetCookie(w, &http.Cookie{
-						Name:     "example_oauthclient_session_id",
-						Value:    "",
-						Path:     "/",
-						HttpOnly: true,
-						MaxAge:   -1,
-					})
-				}
-				redirectToLogin(w, r)
-				return
-			}
+// currentSession returns the session of the request, refreshing its access
+// token first when it is expired or about to expire. It returns nil when the
+// user is not logged in or the session could not be refreshed.
+func currentSession(w http.ResponseWriter, r *http.Request) *Session {
+	sessionID := getSessionID(r)
+	session := sessionStore.Get(sessionID)
 
-			// Update session with new tokens
-			sessionStore.UpdateTokens(sessionID, tokens)
+	// No session at all
+	if session == nil {
+		return nil
+	}
+	if !session.IsAboutToExpire() {
+		return session
+	}
 
-			// Update session cookie expiry
-			http.SetCookie(w, &http.Cookie{
-				Name:     "example_oauthclient_session_id",
-				Value:    sessionID,
-				Path:     "/",
-				HttpOnly: true,
-				MaxAge:   tokens.ExpiresIn,
-			})
+	if session.RefreshToken == "" {
+		log.Printf("[Auth] No refresh token available, ending session")
+		sessionStore.Delete(sessionID)
+		clearSessionCookie(w)
+		return nil
+	}
 
-			log.Printf("[Auth] Session refreshed, continuing to protected route")
+	refreshed, oauthErr := refreshSession(sessionID, refreshBuffer)
+	if oauthErr != nil {
+		// If refresh token is invalid/expired, the session is over
+		if oauthErr.Error == "invalid_grant" {
+			clearSessionCookie(w)
+			return nil
 		}
+		// Keep using the current token while the auth server is unreachable
+		if !session.IsExpired() {
+			return session
+		}
+		return nil
+	}
+	return refreshed
+}
 
-		next(w, r)
+// refreshSession exchanges the refresh token of a session whose access token
+// expires within d, and stores the new tokens. Concurrent refreshes of a
+// session are serialized: the refresh token is single-use, so the late
+// callers reuse the tokens of the first.
+func refreshSession(sessionID string, d time.Duration) (*Session, *OAuthError) {
+	unlock := sessionStore.lockRefresh(sessionID)
+	defer unlock()
+
+	session := sessionStore.Get(sessionID)
+	if session == nil {
+		return nil, &OAuthError{Error: "invalid_grant", Description: "session ended"}
+	}
+	if !session.ExpiresWithin(d) {
+		return session, nil // Refreshed while we waited for the lock
+	}
+
+	log.Printf("[Auth] Token expired/expiring, attempting refresh...")
+	tokens, oauthErr := refreshAccessToken(session.RefreshToken)
+	if oauthErr != nil {
+		log.Printf("[Auth] Token refresh failed: %s - %s", oauthErr.Error, oauthErr.Description)
+		if oauthErr.Error == "invalid_grant" {
+			log.Printf("[Auth] Refresh token invalid, clearing session")
+			sessionStore.Delete(sessionID)
+		}
+		return nil, oauthErr
 	}
+
+	if err := validateAccessToken(tokens.AccessToken); err != nil {
+		log.Printf("Warning: JWT validation failed: %v", err)
+	}
+
+	// Update session with new tokens; the server rotates the refresh token
+	return sessionStore.UpdateTokens(sessionID, tokens), nil
+}
+
+// refreshSessionsInBackground refreshes the sessions about to expire every
+// interval, so returning users never wait on the token endpoint.
+func refreshSessionsInBackground(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		// Sessions expiring before the next tick are refreshed on this one
+		within := interval + refreshBuffer
+		for _, sessionID := range sessionStore.ExpiringWithin(within) {
+			refreshSession(sessionID, within)
+		}
+	}
+}
+
+func setSessionCookie(w http.ResponseWriter, sessionID string) {
+	http.SetCookie(w, config.Cookies.newCookie(sessionCookieName, sessionID, sessionCookieMaxAge))
+}
+
+func clearSessionCookie(w http.ResponseWriter) {
+	http.SetCookie(w, config.Cookies.expireCookie(sessionCookieName))
 }
 
 // redirectToLogin stores return URL and redirects to login page
 func redirectToLogin(w http.ResponseWriter, r *http.Request) {
-	http.SetCookie(w, &http.Cookie{
-		Name:     "return_to",
-		Value:    r.URL.Path,
-		Path:     "/",
-		HttpOnly: true,
-		MaxAge:   300, // 5 minutes
-	})
+	http.SetCookie(w, config.Cookies.newCookie(returnToCookieName, r.URL.Path, 300)) // 5 minutes
 	http.Redirect(w, r, "/login", http.StatusFound)
 }
 
 func handlePublic(w http.ResponseWriter, r *http.Request) {
-	sessionID := getSessionID(r)
-	session := sessionStore.Get(sessionID)
+	session := currentSession(w, r)
 
 	data := map[string]any{
-		"Authenticated": session != nil && !session.IsExpired(),
+		"Authenticated": session != nil,
 	}
 
-	if session != nil && !session.IsExpired() {
+	if session != nil {
 		if email, ok := session.UserInfo["email"].(string); ok {
 			data["UserEmail"] = email
 		}
@@ -490,41 +556,7 @@ func exchangeCodeForTokens(code, codeVerifier string) (*TokenResponse, error) {
 }
 
 func validateAccessToken(accessToken string) error {
-	// Fetch JWKS
-	jwksURL := config.AuthServerURL + "/.well-known/jwks.json"
-	keySet, err := jwk.Fetch(context.Background(), jwksURL)
-	if err != nil {
-		return fmt.Errorf("failed to fetch JWKS: %v", err)
-	}
-
-	// Parse and validate token
-	token, err := jwt.Parse(accessToken, func(token *jwt.Token) (any, error) {
-		// Verify algorithm
-		if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-
-		// Get key ID from token header
-		kid, ok := token.Header["kid"].(string)
-		if !ok {
-			return nil, fmt.Errorf("missing kid in token header")
-		}
-
-		// Find key in JWKS
-		key, found := keySet.LookupKeyID(kid)
-		if !found {
-			return nil, fmt.Errorf("key %s not found in JWKS", kid)
-		}
-
-		// Convert to crypto key
-		var rawKey any
-		if err := key.Raw(&rawKey); err != nil {
-			return nil, fmt.Errorf("failed to get raw key: %v", err)
-		}
-
-		return rawKey, nil
-	})
-
+	token, err := jwt.Parse(accessToken, jwks.Keyfunc(context.Background()), jwt.WithValidMethods([]string{"RS256"}))
 	if err != nil {
 		return err
 	}
@@ -568,11 +600,7 @@ func generateRandomString(length int) string {
 }
 
 func getSessionID(r *http.Request) string {
-	cookie, err := r.Cookie("example_oauthclient_session_id")
-	if err != nil {
-		return ""
-	}
-	return cookie.Value
+	return config.Cookies.readCookie(r, sessionCookieName)
 }
 
 func truncateToken(token string) string {
@@ -657,48 +685,38 @@ func renderError(w http.ResponseWriter, message string) {
 	}
 }
 
-// SessionStore methods
-func (s *SessionStore) Get(id string) *Session {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
-	return s.sessions[id]
-}
-
-func (s *SessionStore) Set(id string, session *Session) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	s.sessions[id] = session
-}
+// revokeToken revokes a token at the auth server (RFC 7009)
+func revokeToken(token, tokenTypeHint string) *OAuthError {
+	data := url.Values{
+		"token":           {token},
+		"token_type_hint": {tokenTypeHint},
+	}
 
-func (s *SessionStore) Delete(id string) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	delete(s.sessions, id)
-}
+	req, err := http.NewRequest("POST", config.AuthServerURL+"/oauth/revoke", strings.NewReader(data.Encode()))
+	if err != nil {
+		return &OAuthError{Error: "request_error", Description: err.Error()}
+	}
 
-// Session methods
-func (s *Session) IsExpired() bool {
-	return time.Now().After(s.ExpiresAt)
-}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(config.ClientID, config.ClientSecret)
 
-// IsAboutToExpire checks if token expires within the buffer time (1 minute)
-func (s *Session) IsAboutToExpire() bool {
-	return time.Now().After(s.ExpiresAt.Add(-60 * time.Second))
-}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return &OAuthError{Error: "network_error", Description: err.Error()}
+	}
+	defer resp.Body.Close()
 
-// UpdateTokens updates the session with new tokens from refresh
-func (s *SessionStore) UpdateTokens(id string, tokens *TokenResponse) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	if session, ok := s.sessions[id]; ok {
-		session.AccessToken = tokens.AccessToken
-		if tokens.RefreshToken != "" {
-			session.RefreshToken = tokens.RefreshToken
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		var oauthErr OAuthError
+		if err := json.Unmarshal(body, &oauthErr); err != nil {
+			return &OAuthError{Error: "unknown_error", Description: string(body)}
 		}
-		session.ExpiresAt = time.Now().Add(time.Duration(tokens.ExpiresIn) * time.Second)
-		// Update user info from new token
-		session.UserInfo = extractUserInfo(tokens.AccessToken)
+		return &oauthErr
 	}
+
+	log.Printf("[Auth] Token revoked successfully")
+	return nil
 }
 
 // refreshAccessToken exchanges a refresh token for new access token
@@ -0,0 +1,199 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// refreshBuffer is how long before the access token expires a request
+// refreshes it, so the token never expires mid-request.
+const refreshBuffer = 60 * time.Second
+
+// SessionStore manages user sessions. When file is set, every change is
+// written to it and the sessions are loaded back on startup, so restarting
+// the client doesn't log everyone out.
+type SessionStore struct {
+	mu       sync.RWMutex
+	sessions map[string]*Session
+	file     string
+
+	// Refresh tokens are single-use: two requests refreshing the same
+	// session at once would make the second one fail with invalid_grant
+	refreshMu    sync.Mutex
+	refreshLocks map[string]*sync.Mutex
+}
+
+// Session holds user session data. Sessions are replaced, never modified,
+// once stored, so handlers can read them without locking.
+type Session struct {
+	AccessToken  string         `json:"access_token"`
+	RefreshToken string         `json:"refresh_token,omitempty"`
+	ExpiresAt    time.Time      `json:"expires_at"`
+	UserInfo     map[string]any `json:"user_info,omitempty"`
+}
+
+// NewSessionStore creates a session store, persisted to file when it is not
+// empty.
+func NewSessionStore(file string) (*SessionStore, error) {
+	s := &SessionStore{
+		sessions:     make(map[string]*Session),
+		file:         file,
+		refreshLocks: make(map[string]*sync.Mutex),
+	}
+	if file == "" {
+		return s, nil
+	}
+
+	data, err := os.ReadFile(file)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read session file: %w", err)
+	}
+	if err := json.Unmarshal(data, &s.sessions); err != nil {
+		return nil, fmt.Errorf("parse session file: %w", err)
+	}
+
+	// Sessions that can no longer be refreshed are dead
+	for id, session := range s.sessions {
+		if session.IsExpired() && session.RefreshToken == "" {
+			delete(s.sessions, id)
+		}
+	}
+	return s, nil
+}
+
+func (s *SessionStore) Get(id string) *Session {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.sessions[id]
+}
+
+func (s *SessionStore) Set(id string, session *Session) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sessions[id] = session
+	s.saveLocked()
+}
+
+func (s *SessionStore) Delete(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.sessions, id)
+	s.saveLocked()
+
+	s.refreshMu.Lock()
+	delete(s.refreshLocks, id)
+	s.refreshMu.Unlock()
+}
+
+// UpdateTokens replaces the session with one holding the tokens of a refresh
+// and returns it. The refresh token is kept when the server didn't rotate it.
+func (s *SessionStore) UpdateTokens(id string, tokens *TokenResponse) *Session {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, ok := s.sessions[id]
+	if !ok {
+		return nil
+	}
+	updated := &Session{
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: session.RefreshToken,
+		ExpiresAt:    time.Now().Add(time.Duration(tokens.ExpiresIn) * time.Second),
+		// Update user info from new token
+		UserInfo: extractUserInfo(tokens.AccessToken),
+	}
+	if tokens.RefreshToken != "" {
+		updated.RefreshToken = tokens.RefreshToken
+	}
+	s.sessions[id] = updated
+	s.saveLocked()
+	return updated
+}
+
+// ExpiringWithin returns the IDs of the refreshable sessions whose access
+// token expires within d.
+func (s *SessionStore) ExpiringWithin(d time.Duration) []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var ids []string
+	for id, session := range s.sessions {
+		if session.RefreshToken != "" && session.ExpiresWithin(d) {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// lockRefresh serializes the refreshes of one session and returns the
+// matching unlock function.
+func (s *SessionStore) lockRefresh(id string) func() {
+	s.refreshMu.Lock()
+	lock, ok := s.refreshLocks[id]
+	if !ok {
+		lock = &sync.Mutex{}
+		s.refreshLocks[id] = lock
+	}
+	s.refreshMu.Unlock()
+
+	lock.Lock()
+	return lock.Unlock
+}
+
+// saveLocked writes the sessions to the session file, if any. The file is
+// replaced atomically so a crash mid-write can't corrupt it.
+func (s *SessionStore) saveLocked() {
+	if s.file == "" {
+		return
+	}
+
+	data, err := json.Marshal(s.sessions)
+	if err != nil {
+		log.Printf("[Session] Failed to encode sessions: %v", err)
+		return
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(s.file), ".sessions-*")
+	if err != nil {
+		log.Printf("[Session] Failed to save sessions: %v", err)
+		return
+	}
+	defer os.Remove(tmp.Name())
+
+	// The file holds tokens: keep it private (CreateTemp uses 0600)
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		log.Printf("[Session] Failed to save sessions: %v", err)
+		return
+	}
+	if err := tmp.Close(); err != nil {
+		log.Printf("[Session] Failed to save sessions: %v", err)
+		return
+	}
+	if err := os.Rename(tmp.Name(), s.file); err != nil {
+		log.Printf("[Session] Failed to save sessions: %v", err)
+	}
+}
+
+// Session methods
+func (s *Session) IsExpired() bool {
+	return time.Now().After(s.ExpiresAt)
+}
+
+// IsAboutToExpire checks if token expires within the buffer time (1 minute)
+func (s *Session) IsAboutToExpire() bool {
+	return s.ExpiresWithin(refreshBuffer)
+}
+
+// ExpiresWithin checks if token expires within d
+func (s *Session) ExpiresWithin(d time.Duration) bool {
+	return time.Now().After(s.ExpiresAt.Add(-d))
+}
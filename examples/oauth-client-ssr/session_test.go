@@ -0,0 +1,286 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hrz8/altalune/jwkscache"
+)
+
+// tokenServer is a fake authorization server rotating refresh tokens on every
+// refresh, like the real one: a refresh token works once.
+type tokenServer struct {
+	mu       sync.Mutex
+	valid    map[string]bool
+	refresh  atomic.Int32
+	failWith int // respond with this status and server_error when set
+}
+
+func newTokenServer(t *testing.T, refreshTokens ...string) (*tokenServer, *httptest.Server) {
+	t.Helper()
+
+	ts := &tokenServer{valid: make(map[string]bool)}
+	for _, token := range refreshTokens {
+		ts.valid[token] = true
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/oauth/token" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if ts.failWith != 0 {
+			w.WriteHeader(ts.failWith)
+			json.NewEncoder(w).Encode(OAuthError{Error: "server_error"})
+			return
+		}
+
+		n := ts.refresh.Add(1)
+		ts.mu.Lock()
+		defer ts.mu.Unlock()
+		token := r.PostFormValue("refresh_token")
+		if !ts.valid[token] {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(OAuthError{Error: "invalid_grant"})
+			return
+		}
+		delete(ts.valid, token)
+		rotated := fmt.Sprintf("refresh-%d", n)
+		ts.valid[rotated] = true
+		json.NewEncoder(w).Encode(TokenResponse{
+			AccessToken:  fmt.Sprintf("access-%d", n),
+			TokenType:    "Bearer",
+			ExpiresIn:    3600,
+			RefreshToken: rotated,
+		})
+	}))
+	t.Cleanup(srv.Close)
+	return ts, srv
+}
+
+// useClient points the client globals at the auth server and a fresh
+// in-memory session store.
+func useClient(t *testing.T, authServerURL string) {
+	t.Helper()
+
+	config = &Config{AuthServerURL: authServerURL, ClientID: "client", ClientSecret: "secret", Cookies: CookieOptions{Path: "/"}}
+	jwks = jwkscache.New(authServerURL+"/.well-known/jwks.json", jwkscache.Options{})
+	store, err := NewSessionStore("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sessionStore = store
+}
+
+func TestSessionStorePersistence(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "sessions.json")
+
+	store, err := NewSessionStore(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	store.Set("live", &Session{AccessToken: "a1", RefreshToken: "r1", ExpiresAt: time.Now().Add(-time.Minute)})
+	store.Set("dead", &Session{AccessToken: "a2", ExpiresAt: time.Now().Add(-time.Minute)})
+	store.Set("logged-out", &Session{AccessToken: "a3", ExpiresAt: time.Now().Add(time.Hour)})
+	store.Delete("logged-out")
+
+	info, err := os.Stat(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if perm := info.Mode().Perm(); perm != 0o600 {
+		t.Errorf("session file mode = %v, want 0600", perm)
+	}
+
+	reloaded, err := NewSessionStore(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := reloaded.Get("live"); got == nil || got.RefreshToken != "r1" {
+		t.Errorf("expired session with a refresh token = %+v, want it kept", got)
+	}
+	if got := reloaded.Get("dead"); got != nil {
+		t.Errorf("expired session without a refresh token = %+v, want it dropped", got)
+	}
+	if got := reloaded.Get("logged-out"); got != nil {
+		t.Errorf("deleted session = %+v, want it gone", got)
+	}
+}
+
+func TestNewSessionStoreCorruptFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "sessions.json")
+	if err := os.WriteFile(file, []byte("{"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewSessionStore(file); err == nil {
+		t.Error("NewSessionStore() error = nil, want the parse error")
+	}
+}
+
+func TestSessionStoreUpdateTokens(t *testing.T) {
+	tests := []struct {
+		name    string
+		tokens  TokenResponse
+		refresh string
+	}{
+		{name: "rotated", tokens: TokenResponse{AccessToken: "a2", ExpiresIn: 60, RefreshToken: "r2"}, refresh: "r2"},
+		{name: "not rotated", tokens: TokenResponse{AccessToken: "a2", ExpiresIn: 60}, refresh: "r1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store, _ := NewSessionStore("")
+			store.Set("id", &Session{AccessToken: "a1", RefreshToken: "r1", ExpiresAt: time.Now()})
+
+			got := store.UpdateTokens("id", &tt.tokens)
+			if got.AccessToken != "a2" || got.RefreshToken != tt.refresh {
+				t.Errorf("UpdateTokens() = %+v, want access a2 and refresh %s", got, tt.refresh)
+			}
+			if got.ExpiresWithin(30*time.Second) || !got.ExpiresWithin(90*time.Second) {
+				t.Errorf("ExpiresAt = %v, want a minute from now", got.ExpiresAt)
+			}
+			if store.Get("id") != got {
+				t.Error("the updated session is not stored")
+			}
+		})
+	}
+
+	store, _ := NewSessionStore("")
+	if got := store.UpdateTokens("missing", &TokenResponse{AccessToken: "a"}); got != nil {
+		t.Errorf("UpdateTokens() of an ended session = %+v, want nil", got)
+	}
+}
+
+func TestSessionStoreExpiringWithin(t *testing.T) {
+	store, _ := NewSessionStore("")
+	store.Set("soon", &Session{RefreshToken: "r", ExpiresAt: time.Now().Add(30 * time.Second)})
+	store.Set("later", &Session{RefreshToken: "r", ExpiresAt: time.Now().Add(time.Hour)})
+	store.Set("no-refresh", &Session{ExpiresAt: time.Now()})
+
+	got := store.ExpiringWithin(time.Minute)
+	if len(got) != 1 || got[0] != "soon" {
+		t.Errorf("ExpiringWithin() = %v, want [soon]", got)
+	}
+}
+
+func TestRefreshSessionConcurrent(t *testing.T) {
+	ts, srv := newTokenServer(t, "r0")
+	useClient(t, srv.URL)
+	sessionStore.Set("id", &Session{AccessToken: "a0", RefreshToken: "r0", ExpiresAt: time.Now()})
+
+	const callers = 10
+	results := make([]*Session, callers)
+	var wg sync.WaitGroup
+	for i := range callers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			session, oauthErr := refreshSession("id", refreshBuffer)
+			if oauthErr != nil {
+				t.Errorf("refreshSession() error = %+v", oauthErr)
+			}
+			results[i] = session
+		}()
+	}
+	wg.Wait()
+
+	if n := ts.refresh.Load(); n != 1 {
+		t.Errorf("token endpoint called %d times, want once", n)
+	}
+	for _, session := range results {
+		if session == nil || session.AccessToken != "access-1" || session.RefreshToken != "refresh-1" {
+			t.Errorf("refreshSession() = %+v, want the tokens of the single refresh", session)
+		}
+	}
+}
+
+func TestCurrentSession(t *testing.T) {
+	tests := []struct {
+		name        string
+		session     *Session
+		failWith    int
+		wantAccess  string // empty when no session is returned
+		wantDeleted bool
+		wantRefresh int32
+		wantCleared bool
+	}{
+		{
+			name:       "fresh",
+			session:    &Session{AccessToken: "a0", RefreshToken: "r0", ExpiresAt: time.Now().Add(time.Hour)},
+			wantAccess: "a0",
+		},
+		{
+			name:        "about to expire",
+			session:     &Session{AccessToken: "a0", RefreshToken: "r0", ExpiresAt: time.Now().Add(30 * time.Second)},
+			wantAccess:  "access-1",
+			wantRefresh: 1,
+		},
+		{
+			name:        "revoked refresh token",
+			session:     &Session{AccessToken: "a0", RefreshToken: "revoked", ExpiresAt: time.Now()},
+			wantDeleted: true,
+			wantRefresh: 1,
+			wantCleared: true,
+		},
+		{
+			name:        "no refresh token",
+			session:     &Session{AccessToken: "a0", ExpiresAt: time.Now()},
+			wantDeleted: true,
+			wantCleared: true,
+		},
+		{
+			name:       "auth server down before expiry",
+			session:    &Session{AccessToken: "a0", RefreshToken: "r0", ExpiresAt: time.Now().Add(30 * time.Second)},
+			failWith:   http.StatusServiceUnavailable,
+			wantAccess: "a0",
+		},
+		{
+			name:     "auth server down after expiry",
+			session:  &Session{AccessToken: "a0", RefreshToken: "r0", ExpiresAt: time.Now().Add(-time.Second)},
+			failWith: http.StatusServiceUnavailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts, srv := newTokenServer(t, "r0")
+			ts.failWith = tt.failWith
+			useClient(t, srv.URL)
+			sessionStore.Set("id", tt.session)
+
+			req := httptest.NewRequest(http.MethodGet, "/private/dashboard", nil)
+			req.AddCookie(&http.Cookie{Name: sessionCookieName, Value: "id"})
+			rec := httptest.NewRecorder()
+			got := currentSession(rec, req)
+
+			switch {
+			case tt.wantAccess == "" && got != nil:
+				t.Errorf("currentSession() = %+v, want nil", got)
+			case tt.wantAccess != "" && (got == nil || got.AccessToken != tt.wantAccess):
+				t.Errorf("currentSession() = %+v, want access token %s", got, tt.wantAccess)
+			}
+			if deleted := sessionStore.Get("id") == nil; deleted != tt.wantDeleted {
+				t.Errorf("session deleted = %v, want %v", deleted, tt.wantDeleted)
+			}
+			if tt.failWith == 0 {
+				if n := ts.refresh.Load(); n != tt.wantRefresh {
+					t.Errorf("token endpoint called %d times, want %d", n, tt.wantRefresh)
+				}
+			}
+
+			cleared := false
+			for _, c := range rec.Result().Cookies() {
+				cleared = cleared || (c.Name == sessionCookieName && c.MaxAge < 0)
+			}
+			if cleared != tt.wantCleared {
+				t.Errorf("session cookie cleared = %v, want %v", cleared, tt.wantCleared)
+			}
+		})
+	}
+}
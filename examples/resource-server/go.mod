@@ -0,0 +1,13 @@
+module examples/resource-server
+
+go 1.24.0
+
+require (
+	connectrpc.com/connect v1.18.1
+	github.com/hrz8/altalune v0.0.0
+	google.golang.org/protobuf v1.36.11
+)
+
+require github.com/golang-jwt/jwt/v5 v5.3.0 // indirect
+
+replace github.com/hrz8/altalune => ../..
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"examples/resource-server/gen/notes/v1/notesv1connect"
+
+	"connectrpc.com/connect"
+	"github.com/hrz8/altalune/tokenauth"
+)
+
+func main() {
+	issuer := flag.String("issuer", "http://localhost:3300", "Altalune authorization server URL, the \"iss\" of its tokens")
+	audience := flag.String("audience", "", "Comma-separated OAuth client IDs whose tokens are accepted (default: any client)")
+	port := flag.Int("port", 8090, "Port to listen on")
+	flag.Parse()
+
+	var audiences []string
+	if *audience != "" {
+		audiences = strings.Split(*audience, ",")
+	}
+	verifier := tokenauth.NewVerifier(tokenauth.Options{
+		Issuer:    *issuer,
+		Audiences: audiences,
+	})
+
+	mux := http.NewServeMux()
+	mux.Handle(notesv1connect.NewNotesServiceHandler(
+		&NotesService{},
+		connect.WithInterceptors(tokenauth.NewInterceptor(verifier)),
+	))
+
+	addr := fmt.Sprintf(":%d", *port)
+	log.Printf("Resource server starting at http://localhost%s", addr)
+	log.Printf("Accepting tokens issued by: %s", *issuer)
+
+	if err := http.ListenAndServe(addr, mux); err != nil {
+		log.Fatalf("Server failed: %v", err)
+	}
+}
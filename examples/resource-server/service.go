@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	notesv1 "examples/resource-server/gen/notes/v1"
+
+	"connectrpc.com/connect"
+	"github.com/hrz8/altalune/tokenauth"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// NotesService implements notesv1connect.NotesServiceHandler with in-memory
+// storage. The interceptor has verified the token before any method runs;
+// methods only check the permissions they need.
+type NotesService struct {
+	mu    sync.RWMutex
+	notes []*notesv1.Note
+}
+
+func (s *NotesService) WhoAmI(ctx context.Context, req *connect.Request[notesv1.WhoAmIRequest]) (*connect.Response[notesv1.WhoAmIResponse], error) {
+	claims, _ := tokenauth.FromContext(ctx)
+
+	clientID := ""
+	if len(claims.Audience) > 0 {
+		clientID = claims.Audience[0]
+	}
+	return connect.NewResponse(&notesv1.WhoAmIResponse{
+		UserId:   claims.Subject,
+		Email:    claims.Email,
+		ClientId: clientID,
+		Perms:    claims.Perms,
+	}), nil
+}
+
+func (s *NotesService) ListNotes(ctx context.Context, req *connect.Request[notesv1.ListNotesRequest]) (*connect.Response[notesv1.ListNotesResponse], error) {
+	if err := tokenauth.RequirePerm(ctx, "notes:read"); err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return connect.NewResponse(&notesv1.ListNotesResponse{Notes: s.notes}), nil
+}
+
+func (s *NotesService) CreateNote(ctx context.Context, req *connect.Request[notesv1.CreateNoteRequest]) (*connect.Response[notesv1.CreateNoteResponse], error) {
+	if err := tokenauth.RequirePerm(ctx, "notes:write"); err != nil {
+		return nil, err
+	}
+
+	text := strings.TrimSpace(req.Msg.GetText())
+	if text == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("text is required"))
+	}
+
+	claims, _ := tokenauth.FromContext(ctx)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	note := &notesv1.Note{
+		Id:        fmt.Sprintf("note-%d", len(s.notes)+1),
+		Text:      text,
+		Author:    claims.Subject,
+		CreatedAt: timestamppb.New(time.Now()),
+	}
+	s.notes = append(s.notes, note)
+	return connect.NewResponse(&notesv1.CreateNoteResponse{Note: note}), nil
+}
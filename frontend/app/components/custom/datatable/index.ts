@@ -6,5 +6,6 @@ export { default as DataTableColumnHeader } from './DataTableColumnHeader.vue';
 export { default as DataTableContent } from './DataTableContent.vue';
 export { default as DataTableFacetedFilter } from './DataTableFacetedFilter.vue';
 export { default as DataTablePagination } from './DataTablePagination.vue';
+export { default as DataTableSavedViews } from './DataTableSavedViews.vue';
 export { default as DataTableToolbar } from './DataTableToolbar.vue';
 export { default as DataTableViewOptions } from './DataTableViewOptions.vue';
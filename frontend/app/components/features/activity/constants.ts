@@ -0,0 +1,24 @@
+import { ActivityCategory } from '~~/gen/altalune/v1/activity_pb';
+
+/**
+ * Categories the feed is filtered on, with their icon and label key
+ */
+export const ACTIVITY_CATEGORY_OPTIONS = [
+  { value: ActivityCategory.MEMBERS, icon: 'lucide:user-plus', labelKey: 'members' },
+  { value: ActivityCategory.CLIENTS, icon: 'lucide:app-window', labelKey: 'clients' },
+  { value: ActivityCategory.API_KEYS, icon: 'lucide:key-round', labelKey: 'apiKeys' },
+  { value: ActivityCategory.SECURITY, icon: 'lucide:shield-alert', labelKey: 'security' },
+] as const;
+
+/**
+ * Message keys of the domain events recorded in the feed; events missing here
+ * show under their raw name
+ */
+export const ACTIVITY_EVENT_MESSAGE_KEYS: Record<string, string> = {
+  'project.member_added': 'memberAdded',
+  'oauth_client.created': 'clientCreated',
+  'api_key.created': 'apiKeyCreated',
+  'user.login_failed': 'loginFailed',
+};
+
+export const ACTIVITY_PAGE_SIZE = 20;
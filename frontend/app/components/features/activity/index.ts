@@ -0,0 +1 @@
+export { default as ActivityFeed } from './ActivityFeed.vue';
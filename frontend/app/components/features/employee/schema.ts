@@ -41,10 +41,13 @@ export const employeeUpdateSchema = z.object({
     .min(2, 'Name must be at least 2 characters')
     .max(50, 'Name must be at most 50 characters')
     .trim(),
+  // Empty keeps the current email, which is hidden from callers without the
+  // PII permission
   email: z
     .string()
     .email('Must be a valid email address')
-    .trim(),
+    .trim()
+    .or(z.literal('')),
   role: z
     .string()
     .min(1, 'Role is required')
@@ -4,6 +4,7 @@ export { default as OAuthClientCreateSheet } from './OAuthClientCreateSheet.vue'
 export { default as OAuthClientDeleteDialog } from './OAuthClientDeleteDialog.vue';
 export { default as OAuthClientEditSheet } from './OAuthClientEditSheet.vue';
 export { default as OAuthClientRevealDialog } from './OAuthClientRevealDialog.vue';
+export { default as OAuthClientRotateDialog } from './OAuthClientRotateDialog.vue';
 export { default as OAuthClientRowActions } from './OAuthClientRowActions.vue';
 export { default as OAuthClientSecretDisplay } from './OAuthClientSecretDisplay.vue';
 export { default as OAuthClientTable } from './OAuthClientTable.vue';
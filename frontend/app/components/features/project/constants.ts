@@ -42,3 +42,22 @@ export const PROJECT_ENVIRONMENTS = {
 } as const;
 
 export type ProjectEnvironment = typeof PROJECT_ENVIRONMENTS[keyof typeof PROJECT_ENVIRONMENTS];
+
+/**
+ * Locales of the auth pages, matching the catalogs of the auth server
+ * Labelled in their own language, as language switchers are
+ */
+export const AUTH_PAGE_LOCALE_OPTIONS = [
+  { value: 'en', label: 'English' },
+  { value: 'id', label: 'Bahasa Indonesia' },
+] as const;
+
+// Select value standing for an empty default_locale, as select items cannot
+// have an empty value
+export const SERVER_DEFAULT_LOCALE = 'server-default';
+
+/**
+ * Project logo constraints, matching UploadProjectLogoRequest validation
+ */
+export const LOGO_CONTENT_TYPES = ['image/png', 'image/jpeg', 'image/webp', 'image/svg+xml'] as const;
+export const LOGO_MAX_BYTES = 512 * 1024;
@@ -24,3 +24,18 @@ export const projectCreateSchema = z.object({
 });
 
 export type ProjectCreateFormData = z.infer<typeof projectCreateSchema>;
+
+/**
+ * Project Branding Form Schema
+ * Matches UpdateProjectBrandingRequest protobuf validation
+ */
+export const projectBrandingSchema = z.object({
+  primaryColor: z.string().regex(/^(#[0-9a-f]{6})?$/i, 'Color must be a hex color such as #0d6efd'),
+  defaultLocale: z.string(),
+  footerLinks: z.array(z.object({
+    label: z.string().min(1, 'Label is required').max(50, 'Label must be 50 characters or less'),
+    url: z.string().url('URL must be a valid URL').max(500, 'URL must be 500 characters or less'),
+  })).max(10, 'At most 10 footer links'),
+});
+
+export type ProjectBrandingFormData = z.infer<typeof projectBrandingSchema>;
@@ -0,0 +1,61 @@
+import type { MessageInitShape } from '@bufbuild/protobuf';
+import type { ListProjectActivityResponse } from '~~/gen/altalune/v1/activity_pb';
+
+import { activityRepository } from '#shared/repository/activity';
+import { create } from '@bufbuild/protobuf';
+import { ListProjectActivityRequestSchema } from '~~/gen/altalune/v1/activity_pb';
+import { useConnectValidator } from '../useConnectValidator';
+import { useErrorMessage } from '../useErrorMessage';
+
+export function useActivityService() {
+  const { $activityClient } = useNuxtApp();
+  const activity = activityRepository($activityClient);
+  const { parseError } = useErrorMessage();
+
+  const listValidator = useConnectValidator(ListProjectActivityRequestSchema);
+
+  // List state for fetching a page of the feed
+  const listState = reactive({
+    loading: false,
+    error: '',
+    success: false,
+  });
+
+  async function listProjectActivity(
+    req: MessageInitShape<typeof ListProjectActivityRequestSchema>,
+  ): Promise<ListProjectActivityResponse | null> {
+    listState.loading = true;
+    listState.error = '';
+    listState.success = false;
+
+    listValidator.reset();
+
+    if (!listValidator.validate(req)) {
+      console.warn('Validation failed for ListProjectActivityRequest:', listValidator.errors.value);
+      listState.loading = false;
+      return null;
+    }
+
+    try {
+      const message = create(ListProjectActivityRequestSchema, req);
+      const result = await activity.listProjectActivity(message);
+      listState.success = true;
+      return result;
+    }
+    catch (err) {
+      listState.error = parseError(err);
+      throw new Error(listState.error);
+    }
+    finally {
+      listState.loading = false;
+    }
+  }
+
+  return {
+    // List
+    listProjectActivity,
+    listLoading: computed(() => listState.loading),
+    listError: computed(() => listState.error),
+    listValidationErrors: listValidator.errors,
+  };
+}
@@ -2,6 +2,7 @@ import type { MessageInitShape } from '@bufbuild/protobuf';
 import type { ApiKey } from '~~/gen/altalune/v1/api_key_pb';
 
 import type { QueryMetaResponseSchema } from '~~/gen/altalune/v1/common_pb';
+import { deliveredSecretValue } from '#shared/helpers/protobuf';
 import { apiKeyRepository } from '#shared/repository/api_key';
 
 import { create } from '@bufbuild/protobuf';
@@ -21,6 +22,7 @@ export function useApiKeyService() {
   const { $apiKeyClient } = useNuxtApp();
   const apiKey = apiKeyRepository($apiKeyClient);
   const { parseError } = useErrorMessage();
+  const apiUrl = String(useRuntimeConfig().public.apiUrl || '');
 
   const queryValidator = useConnectValidator(QueryApiKeysRequestSchema);
   const createValidator = useConnectValidator(CreateApiKeyRequestSchema);
@@ -125,7 +127,7 @@ export function useApiKeyService() {
       createState.success = true;
       return {
         apiKey: result.apiKey || null,
-        keyValue: result.keyValue || '',
+        keyValue: result.keyValue || deliveredSecretValue(result.deliveredKeyValue, apiUrl),
       };
     }
     catch (err) {
@@ -2,6 +2,7 @@ import type { MessageInitShape } from '@bufbuild/protobuf';
 import type { QueryMetaResponse } from '~~/gen/altalune/v1/common_pb';
 
 import type { OAuthClient } from '~~/gen/altalune/v1/oauth_client_pb';
+import { deliveredSecretValue } from '#shared/helpers/protobuf';
 import { oauthClientRepository } from '#shared/repository/oauth_client';
 
 import { create } from '@bufbuild/protobuf';
@@ -11,6 +12,7 @@ import {
   GetOAuthClientRequestSchema,
   QueryOAuthClientsRequestSchema,
   RevealOAuthClientSecretRequestSchema,
+  RotateOAuthClientSecretRequestSchema,
   UpdateOAuthClientRequestSchema,
 } from '~~/gen/altalune/v1/oauth_client_pb';
 import { useConnectValidator } from '../useConnectValidator';
@@ -20,6 +22,7 @@ export function useOAuthClientService() {
   const { $oauthClientClient } = useNuxtApp();
   const oauthClient = oauthClientRepository($oauthClientClient);
   const { parseError } = useErrorMessage();
+  const apiUrl = String(useRuntimeConfig().public.apiUrl || '');
 
   const queryValidator = useConnectValidator(QueryOAuthClientsRequestSchema);
   const createValidator = useConnectValidator(CreateOAuthClientRequestSchema);
@@ -27,6 +30,7 @@ export function useOAuthClientService() {
   const updateValidator = useConnectValidator(UpdateOAuthClientRequestSchema);
   const deleteValidator = useConnectValidator(DeleteOAuthClientRequestSchema);
   const revealValidator = useConnectValidator(RevealOAuthClientSecretRequestSchema);
+  const rotateValidator = useConnectValidator(RotateOAuthClientSecretRequestSchema);
 
   // Create state for form submission
   const createState = reactive({
@@ -70,6 +74,13 @@ export function useOAuthClientService() {
     clientSecret: '',
   });
 
+  // Rotate state for replacing client secret
+  const rotateState = reactive({
+    loading: false,
+    error: '',
+    clientSecret: '',
+  });
+
   async function query(
     req: MessageInitShape<typeof QueryOAuthClientsRequestSchema>,
   ): Promise<{
@@ -126,10 +137,11 @@ export function useOAuthClientService() {
       const message = create(CreateOAuthClientRequestSchema, req);
       const result = await oauthClient.createOAuthClient(message);
       createState.success = true;
-      createState.clientSecret = result.clientSecret;
+      const clientSecret = result.clientSecret || deliveredSecretValue(result.deliveredClientSecret, apiUrl);
+      createState.clientSecret = clientSecret;
       return {
         client: result.client || null,
-        clientSecret: result.clientSecret || '',
+        clientSecret,
       };
     }
     catch (err) {
@@ -293,6 +305,46 @@ export function useOAuthClientService() {
     revealValidator.reset();
   }
 
+  async function rotateOAuthClientSecret(
+    req: MessageInitShape<typeof RotateOAuthClientSecretRequestSchema>,
+  ): Promise<{ client: OAuthClient | null; clientSecret: string }> {
+    rotateState.loading = true;
+    rotateState.error = '';
+    rotateState.clientSecret = '';
+
+    rotateValidator.reset();
+
+    if (!rotateValidator.validate(req)) {
+      rotateState.loading = false;
+      return { client: null, clientSecret: '' };
+    }
+
+    try {
+      const message = create(RotateOAuthClientSecretRequestSchema, req);
+      const result = await oauthClient.rotateOAuthClientSecret(message);
+      const clientSecret = result.clientSecret || deliveredSecretValue(result.deliveredClientSecret, apiUrl);
+      rotateState.clientSecret = clientSecret;
+      return {
+        client: result.client || null,
+        clientSecret,
+      };
+    }
+    catch (err) {
+      rotateState.error = parseError(err);
+      throw new Error(rotateState.error);
+    }
+    finally {
+      rotateState.loading = false;
+    }
+  }
+
+  function resetRotateState() {
+    rotateState.loading = false;
+    rotateState.error = '';
+    rotateState.clientSecret = '';
+    rotateValidator.reset();
+  }
+
   return {
     // Query
     query,
@@ -340,5 +392,13 @@ export function useOAuthClientService() {
     revealedSecret: computed(() => revealState.clientSecret),
     revealValidationErrors: revealValidator.errors,
     resetRevealState,
+
+    // Rotate
+    rotateOAuthClientSecret,
+    rotateLoading: computed(() => rotateState.loading),
+    rotateError: computed(() => rotateState.error),
+    rotatedSecret: computed(() => rotateState.clientSecret),
+    rotateValidationErrors: rotateValidator.errors,
+    resetRotateState,
   };
 }
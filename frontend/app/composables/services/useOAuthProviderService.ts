@@ -9,6 +9,7 @@ import {
   DeleteOAuthProviderRequestSchema,
   GetOAuthProviderRequestSchema,
   QueryOAuthProvidersRequestSchema,
+  RequestClientSecretRevealRequestSchema,
   RevealClientSecretRequestSchema,
   UpdateOAuthProviderRequestSchema,
 } from '~~/gen/altalune/v1/oauth_provider_pb';
@@ -36,6 +37,7 @@ export function useOAuthProviderService() {
   const getValidator = useConnectValidator(GetOAuthProviderRequestSchema);
   const updateValidator = useConnectValidator(UpdateOAuthProviderRequestSchema);
   const deleteValidator = useConnectValidator(DeleteOAuthProviderRequestSchema);
+  const requestRevealValidator = useConnectValidator(RequestClientSecretRevealRequestSchema);
   const revealValidator = useConnectValidator(RevealClientSecretRequestSchema);
 
   // Create state for form submission
@@ -75,6 +77,12 @@ export function useOAuthProviderService() {
     error: '',
   });
 
+  // Request reveal state for emailing the confirmation code
+  const requestRevealState = reactive({
+    loading: false,
+    error: '',
+  });
+
   // Timer references for cleanup
   let countdownInterval: ReturnType<typeof setInterval> | null = null;
   let autoHideTimeout: ReturnType<typeof setTimeout> | null = null;
@@ -318,8 +326,50 @@ export function useOAuthProviderService() {
     deleteValidator.reset();
   }
 
+  /**
+   * Request a confirmation code for revealing a client secret
+   * The code is emailed to the signed-in user and expires after a few minutes
+   */
+  async function requestClientSecretReveal(
+    req: MessageInitShape<typeof RequestClientSecretRevealRequestSchema>,
+  ): Promise<boolean> {
+    requestRevealState.loading = true;
+    requestRevealState.error = '';
+
+    requestRevealValidator.reset();
+
+    if (!requestRevealValidator.validate(req)) {
+      requestRevealState.loading = false;
+      return false;
+    }
+
+    try {
+      const message = create(RequestClientSecretRevealRequestSchema, req);
+      await oauthProvider.requestClientSecretReveal(message);
+      return true;
+    }
+    catch (err) {
+      requestRevealState.error = parseError(err);
+      throw new Error(requestRevealState.error);
+    }
+    finally {
+      requestRevealState.loading = false;
+    }
+  }
+
+  /**
+   * Reset request reveal state
+   */
+  function resetRequestRevealState() {
+    requestRevealState.loading = false;
+    requestRevealState.error = '';
+    requestRevealValidator.reset();
+    revealValidator.reset();
+  }
+
   /**
    * Reveal client secret (decrypts and displays plaintext)
+   * SECURITY: Requires the emailed confirmation code and a reason
    * SECURITY: Starts 30-second auto-hide timer
    * After 30 seconds, secret is automatically hidden
    */
@@ -415,8 +465,15 @@ export function useOAuthProviderService() {
     deleteValidationErrors: deleteValidator.errors,
     resetDeleteState,
 
+    // Request reveal (emails a confirmation code)
+    requestClientSecretReveal,
+    requestRevealLoading: computed(() => requestRevealState.loading),
+    requestRevealError: computed(() => requestRevealState.error),
+    resetRequestRevealState,
+
     // Reveal (with 30-second auto-hide timer)
     revealClientSecret,
+    revealValidationErrors: revealValidator.errors,
     revealLoading: computed(() => revealState.loading),
     revealError: computed(() => revealState.error),
     revealSecondsRemaining: computed(() => revealState.secondsRemaining),
@@ -0,0 +1,203 @@
+import type { MessageInitShape } from '@bufbuild/protobuf';
+import type { ProjectBranding } from '~~/gen/altalune/v1/project_branding_pb';
+
+import { projectBrandingRepository } from '#shared/repository/project_branding';
+import { create } from '@bufbuild/protobuf';
+import {
+  DeleteProjectLogoRequestSchema,
+  GetProjectBrandingRequestSchema,
+  UpdateProjectBrandingRequestSchema,
+  UploadProjectLogoRequestSchema,
+} from '~~/gen/altalune/v1/project_branding_pb';
+import { useConnectValidator } from '../useConnectValidator';
+import { useErrorMessage } from '../useErrorMessage';
+
+export function useProjectBrandingService() {
+  const { $projectBrandingClient } = useNuxtApp();
+  const projectBranding = projectBrandingRepository($projectBrandingClient);
+  const { parseError } = useErrorMessage();
+
+  const getValidator = useConnectValidator(GetProjectBrandingRequestSchema);
+  const updateValidator = useConnectValidator(UpdateProjectBrandingRequestSchema);
+  const uploadLogoValidator = useConnectValidator(UploadProjectLogoRequestSchema);
+  const deleteLogoValidator = useConnectValidator(DeleteProjectLogoRequestSchema);
+
+  // Get state for fetching the branding
+  const getState = reactive({
+    loading: false,
+    error: '',
+    success: false,
+  });
+
+  // Update state for form submission
+  const updateState = reactive({
+    loading: false,
+    error: '',
+    success: false,
+  });
+
+  // Upload logo state
+  const uploadLogoState = reactive({
+    loading: false,
+    error: '',
+    success: false,
+  });
+
+  // Delete logo state
+  const deleteLogoState = reactive({
+    loading: false,
+    error: '',
+    success: false,
+  });
+
+  async function getProjectBranding(
+    req: MessageInitShape<typeof GetProjectBrandingRequestSchema>,
+  ): Promise<ProjectBranding | null> {
+    getState.loading = true;
+    getState.error = '';
+    getState.success = false;
+
+    getValidator.reset();
+
+    if (!getValidator.validate(req)) {
+      getState.loading = false;
+      return null;
+    }
+
+    try {
+      const message = create(GetProjectBrandingRequestSchema, req);
+      const result = await projectBranding.getProjectBranding(message);
+      getState.success = true;
+      return result.branding || null;
+    }
+    catch (err) {
+      getState.error = parseError(err);
+      throw new Error(getState.error);
+    }
+    finally {
+      getState.loading = false;
+    }
+  }
+
+  async function updateProjectBranding(
+    req: MessageInitShape<typeof UpdateProjectBrandingRequestSchema>,
+  ): Promise<ProjectBranding | null> {
+    updateState.loading = true;
+    updateState.error = '';
+    updateState.success = false;
+
+    updateValidator.reset();
+
+    if (!updateValidator.validate(req)) {
+      updateState.loading = false;
+      return null;
+    }
+
+    try {
+      const message = create(UpdateProjectBrandingRequestSchema, req);
+      const result = await projectBranding.updateProjectBranding(message);
+      updateState.success = true;
+      return result.branding || null;
+    }
+    catch (err) {
+      updateState.error = parseError(err);
+      throw new Error(updateState.error);
+    }
+    finally {
+      updateState.loading = false;
+    }
+  }
+
+  async function uploadProjectLogo(
+    req: MessageInitShape<typeof UploadProjectLogoRequestSchema>,
+  ): Promise<ProjectBranding | null> {
+    uploadLogoState.loading = true;
+    uploadLogoState.error = '';
+    uploadLogoState.success = false;
+
+    uploadLogoValidator.reset();
+
+    if (!uploadLogoValidator.validate(req)) {
+      uploadLogoState.loading = false;
+      return null;
+    }
+
+    try {
+      const message = create(UploadProjectLogoRequestSchema, req);
+      const result = await projectBranding.uploadProjectLogo(message);
+      uploadLogoState.success = true;
+      return result.branding || null;
+    }
+    catch (err) {
+      uploadLogoState.error = parseError(err);
+      throw new Error(uploadLogoState.error);
+    }
+    finally {
+      uploadLogoState.loading = false;
+    }
+  }
+
+  async function deleteProjectLogo(
+    req: MessageInitShape<typeof DeleteProjectLogoRequestSchema>,
+  ): Promise<ProjectBranding | null> {
+    deleteLogoState.loading = true;
+    deleteLogoState.error = '';
+    deleteLogoState.success = false;
+
+    deleteLogoValidator.reset();
+
+    if (!deleteLogoValidator.validate(req)) {
+      deleteLogoState.loading = false;
+      return null;
+    }
+
+    try {
+      const message = create(DeleteProjectLogoRequestSchema, req);
+      const result = await projectBranding.deleteProjectLogo(message);
+      deleteLogoState.success = true;
+      return result.branding || null;
+    }
+    catch (err) {
+      deleteLogoState.error = parseError(err);
+      throw new Error(deleteLogoState.error);
+    }
+    finally {
+      deleteLogoState.loading = false;
+    }
+  }
+
+  function resetUpdateState() {
+    updateState.loading = false;
+    updateState.error = '';
+    updateState.success = false;
+    updateValidator.reset();
+  }
+
+  return {
+    // Get
+    getProjectBranding,
+    getLoading: computed(() => getState.loading),
+    getError: computed(() => getState.error),
+    getSuccess: computed(() => getState.success),
+    getValidationErrors: getValidator.errors,
+
+    // Update
+    updateProjectBranding,
+    updateLoading: computed(() => updateState.loading),
+    updateError: computed(() => updateState.error),
+    updateSuccess: computed(() => updateState.success),
+    updateValidationErrors: updateValidator.errors,
+    resetUpdateState,
+
+    // Upload logo
+    uploadProjectLogo,
+    uploadLogoLoading: computed(() => uploadLogoState.loading),
+    uploadLogoError: computed(() => uploadLogoState.error),
+    uploadLogoValidationErrors: uploadLogoValidator.errors,
+
+    // Delete logo
+    deleteProjectLogo,
+    deleteLogoLoading: computed(() => deleteLogoState.loading),
+    deleteLogoError: computed(() => deleteLogoState.error),
+  };
+}
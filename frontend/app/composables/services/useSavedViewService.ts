@@ -0,0 +1,182 @@
+import type { MessageInitShape } from '@bufbuild/protobuf';
+import type { SavedView } from '~~/gen/altalune/v1/saved_view_pb';
+
+import { savedViewRepository } from '#shared/repository/saved_view';
+import { create } from '@bufbuild/protobuf';
+import {
+  CreateSavedViewRequestSchema,
+  DeleteSavedViewRequestSchema,
+  ListSavedViewsRequestSchema,
+  UpdateSavedViewRequestSchema,
+} from '~~/gen/altalune/v1/saved_view_pb';
+import { useConnectValidator } from '../useConnectValidator';
+import { useErrorMessage } from '../useErrorMessage';
+
+export function useSavedViewService() {
+  const { $savedViewClient } = useNuxtApp();
+  const savedView = savedViewRepository($savedViewClient);
+  const { parseError } = useErrorMessage();
+
+  const listValidator = useConnectValidator(ListSavedViewsRequestSchema);
+  const createValidator = useConnectValidator(CreateSavedViewRequestSchema);
+  const updateValidator = useConnectValidator(UpdateSavedViewRequestSchema);
+  const deleteValidator = useConnectValidator(DeleteSavedViewRequestSchema);
+
+  // Create state for form submission
+  const createState = reactive({
+    loading: false,
+    error: '',
+    success: false,
+  });
+
+  // Update state for overwriting a view
+  const updateState = reactive({
+    loading: false,
+    error: '',
+    success: false,
+  });
+
+  // Delete state for confirmation and deletion
+  const deleteState = reactive({
+    loading: false,
+    error: '',
+    success: false,
+  });
+
+  async function listSavedViews(
+    req: MessageInitShape<typeof ListSavedViewsRequestSchema>,
+  ): Promise<SavedView[]> {
+    listValidator.reset();
+    if (!listValidator.validate(req)) {
+      console.warn('Validation failed for ListSavedViewsRequest:', listValidator.errors.value);
+      return [];
+    }
+
+    try {
+      const message = create(ListSavedViewsRequestSchema, req);
+      const result = await savedView.listSavedViews(message);
+      return result.data;
+    }
+    catch (err) {
+      const errorMessage = parseError(err);
+      throw new Error(errorMessage);
+    }
+  }
+
+  async function createSavedView(
+    req: MessageInitShape<typeof CreateSavedViewRequestSchema>,
+  ): Promise<SavedView | null> {
+    createState.loading = true;
+    createState.error = '';
+    createState.success = false;
+
+    createValidator.reset();
+
+    if (!createValidator.validate(req)) {
+      createState.loading = false;
+      return null;
+    }
+
+    try {
+      const message = create(CreateSavedViewRequestSchema, req);
+      const result = await savedView.createSavedView(message);
+      createState.success = true;
+      return result.view || null;
+    }
+    catch (err) {
+      createState.error = parseError(err);
+      throw new Error(createState.error);
+    }
+    finally {
+      createState.loading = false;
+    }
+  }
+
+  async function updateSavedView(
+    req: MessageInitShape<typeof UpdateSavedViewRequestSchema>,
+  ): Promise<SavedView | null> {
+    updateState.loading = true;
+    updateState.error = '';
+    updateState.success = false;
+
+    updateValidator.reset();
+
+    if (!updateValidator.validate(req)) {
+      updateState.loading = false;
+      return null;
+    }
+
+    try {
+      const message = create(UpdateSavedViewRequestSchema, req);
+      const result = await savedView.updateSavedView(message);
+      updateState.success = true;
+      return result.view || null;
+    }
+    catch (err) {
+      updateState.error = parseError(err);
+      throw new Error(updateState.error);
+    }
+    finally {
+      updateState.loading = false;
+    }
+  }
+
+  async function deleteSavedView(
+    req: MessageInitShape<typeof DeleteSavedViewRequestSchema>,
+  ): Promise<boolean> {
+    deleteState.loading = true;
+    deleteState.error = '';
+    deleteState.success = false;
+
+    deleteValidator.reset();
+
+    if (!deleteValidator.validate(req)) {
+      deleteState.loading = false;
+      return false;
+    }
+
+    try {
+      const message = create(DeleteSavedViewRequestSchema, req);
+      await savedView.deleteSavedView(message);
+      deleteState.success = true;
+      return true;
+    }
+    catch (err) {
+      deleteState.error = parseError(err);
+      throw new Error(deleteState.error);
+    }
+    finally {
+      deleteState.loading = false;
+    }
+  }
+
+  function resetCreateState() {
+    createState.loading = false;
+    createState.error = '';
+    createState.success = false;
+    createValidator.reset();
+  }
+
+  return {
+    // List
+    listSavedViews,
+    listValidationErrors: listValidator.errors,
+
+    // Create
+    createSavedView,
+    createLoading: computed(() => createState.loading),
+    createError: computed(() => createState.error),
+    createValidationErrors: createValidator.errors,
+    resetCreateState,
+
+    // Update
+    updateSavedView,
+    updateLoading: computed(() => updateState.loading),
+    updateError: computed(() => updateState.error),
+
+    // Delete
+    deleteSavedView,
+    deleteLoading: computed(() => deleteState.loading),
+    deleteError: computed(() => deleteState.error),
+  };
+}
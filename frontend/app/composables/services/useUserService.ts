@@ -4,11 +4,13 @@ import { userRepository } from '#shared/repository/user';
 import { create } from '@bufbuild/protobuf';
 import {
   ActivateUserRequestSchema,
+  CreateServiceAccountRequestSchema,
   CreateUserRequestSchema,
   DeactivateUserRequestSchema,
   DeleteUserRequestSchema,
   GetUserRequestSchema,
   QueryUsersRequestSchema,
+  UnlockUserRequestSchema,
   UpdateUserRequestSchema,
 } from '~~/gen/altalune/v1/user_pb';
 import { useConnectValidator } from '../useConnectValidator';
@@ -26,6 +28,8 @@ export function useUserService() {
   const deleteValidator = useConnectValidator(DeleteUserRequestSchema);
   const activateValidator = useConnectValidator(ActivateUserRequestSchema);
   const deactivateValidator = useConnectValidator(DeactivateUserRequestSchema);
+  const unlockValidator = useConnectValidator(UnlockUserRequestSchema);
+  const createServiceAccountValidator = useConnectValidator(CreateServiceAccountRequestSchema);
 
   const createState = reactive({
     loading: false,
@@ -250,6 +254,34 @@ export function useUserService() {
     return result.user || null;
   }
 
+  async function unlockUser(
+    req: MessageInitShape<typeof UnlockUserRequestSchema>,
+  ): Promise<User | null> {
+    unlockValidator.reset();
+
+    if (!unlockValidator.validate(req)) {
+      return null;
+    }
+
+    const message = create(UnlockUserRequestSchema, req);
+    const result = await user.unlockUser(message);
+    return result.user || null;
+  }
+
+  async function createServiceAccount(
+    req: MessageInitShape<typeof CreateServiceAccountRequestSchema>,
+  ): Promise<User | null> {
+    createServiceAccountValidator.reset();
+
+    if (!createServiceAccountValidator.validate(req)) {
+      return null;
+    }
+
+    const message = create(CreateServiceAccountRequestSchema, req);
+    const result = await user.createServiceAccount(message);
+    return result.user || null;
+  }
+
   return {
     query,
     createUser,
@@ -258,6 +290,8 @@ export function useUserService() {
     deleteUser,
     activateUser,
     deactivateUser,
+    unlockUser,
+    createServiceAccount,
     createLoading: computed(() => createState.loading),
     createError: computed(() => createState.error),
     createSuccess: computed(() => createState.success),
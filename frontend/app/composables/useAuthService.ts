@@ -1,5 +1,5 @@
 import type { AuthExchangeResponse } from '~~/shared/repository/auth';
-import { authRepository } from '~~/shared/repository/auth';
+import { authRepository, sessionRepository } from '~~/shared/repository/auth';
 import { useAuthStore } from '@/stores/auth';
 
 // Module-level state for refresh deduplication (shared across all instances)
@@ -22,6 +22,9 @@ export function useAuthService() {
   const authServerClient = $api.createClient(config.public.authServerUrl);
   const authServerRepo = authRepository(authServerClient);
 
+  // Create API client for the session heartbeat (apiUrl)
+  const sessionRepo = sessionRepository($api.createClient());
+
   async function handleCallback(code: string, state: string): Promise<AuthExchangeResponse> {
     // Validate state parameter
     const storedState = sessionStorage.getItem('oauth_state');
@@ -151,6 +154,32 @@ export function useAuthService() {
     navigateTo('/auth/login');
   }
 
+  /**
+   * Ask the backend whether the session is still alive.
+   * Clears auth and returns false once the session ended on the auth server
+   * (logout there or token revocation). Network and server errors keep the
+   * session: only an explicit 401 ends it.
+   */
+  async function checkSession(): Promise<boolean> {
+    if (!authStore.isAuthenticated) {
+      return false;
+    }
+
+    try {
+      await sessionRepo.heartbeat();
+      return true;
+    }
+    catch (error) {
+      const err = error as { status?: number; statusCode?: number };
+      if ((err.status ?? err.statusCode) === 401) {
+        console.warn('[Auth] Session ended on the auth server, clearing auth');
+        authStore.clearAuth();
+        return false;
+      }
+      return true;
+    }
+  }
+
   async function resendVerificationEmail(): Promise<void> {
     try {
       await authServerRepo.resendVerification();
@@ -171,6 +200,7 @@ export function useAuthService() {
     logout,
     isTokenExpired,
     checkAndRefreshIfNeeded,
+    checkSession,
     resendVerificationEmail,
   };
 }
@@ -1,6 +1,8 @@
-import { ConnectError } from '@connectrpc/connect';
+import { Code, ConnectError } from '@connectrpc/connect';
 import { ErrorDetailSchema } from '~~/gen/altalune/v1/common_pb';
 
+const VERSION_CONFLICT_CODE = '60002';
+
 export function useErrorMessage() {
   const { t } = useI18n();
 
@@ -18,7 +20,18 @@ export function useErrorMessage() {
     return t(`errorCodes.${code}`, meta);
   }
 
+  // An update sent with an expected_updated_at the resource no longer has,
+  // which the form has to reload before saving again
+  function isVersionConflict(err: unknown): boolean {
+    if (!(err instanceof ConnectError) || err.code !== Code.FailedPrecondition)
+      return false;
+
+    const detail = err.findDetails(ErrorDetailSchema)[0];
+    return detail?.code === VERSION_CONFLICT_CODE;
+  }
+
   return {
     parseError,
+    isVersionConflict,
   };
 }
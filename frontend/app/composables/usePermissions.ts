@@ -1,5 +1,12 @@
 import { useAuthStore } from '~/stores/auth';
 
+// Project roles from least to most privileged
+const PROJECT_ROLE_ORDER = ['user', 'member', 'admin', 'owner'];
+
+function projectRoleRank(role: string): number {
+  return PROJECT_ROLE_ORDER.indexOf(role);
+}
+
 /**
  * Permission checking composable
  * Provides reactive permission helpers that respect superadmin status
@@ -79,6 +86,20 @@ export function usePermissions() {
     return can(permission) && isMemberOf(projectId);
   }
 
+  /**
+   * Check if user may grant a project role, or manage a member holding it
+   * This mirrors the backend grant constraint: members only manage roles at
+   * or below their own in the project
+   * @param projectId - Project public ID
+   * @param role - Project role to grant
+   */
+  function canGrantProjectRole(projectId: string, role: string): boolean {
+    if (authStore.isSuperAdmin)
+      return true;
+    const own = getProjectRole(projectId);
+    return own !== null && projectRoleRank(role) <= projectRoleRank(own);
+  }
+
   /**
    * Check if user is superadmin
    */
@@ -102,5 +123,6 @@ export function usePermissions() {
     isMemberOf,
     getProjectRole,
     canAccessProject,
+    canGrantProjectRole,
   };
 }
@@ -9,6 +9,10 @@ export const PERMISSIONS = {
     WRITE: 'employee:write',
     DELETE: 'employee:delete',
   },
+  // Greeter example allowed names
+  GREETER: {
+    WRITE: 'greeter:write',
+  },
   // User entity (IAM)
   USER: {
     READ: 'user:read',
@@ -67,6 +71,25 @@ export const PERMISSIONS = {
     READ: 'iam:read',
     WRITE: 'iam:write',
   },
+  // Feature flags
+  FEATURE_FLAG: {
+    READ: 'featureflag:read',
+    WRITE: 'featureflag:write',
+  },
+  // API usage quotas
+  USAGE: {
+    WRITE: 'usage:write',
+  },
+  // Organizations
+  ORG: {
+    READ: 'org:read',
+    WRITE: 'org:write',
+  },
+  // Billing plans and subscriptions
+  BILLING: {
+    READ: 'billing:read',
+    WRITE: 'billing:write',
+  },
   // Special permissions
   ROOT: 'root',
 } as const;
@@ -107,6 +130,7 @@ export const PERMISSION_DESCRIPTIONS: Record<string, string> = {
   'employee:read': 'View employee records',
   'employee:write': 'Create and update employee records',
   'employee:delete': 'Delete employee records',
+  'greeter:write': 'Manage the names allowed by the greeter example',
   'user:read': 'View user accounts',
   'user:write': 'Create and update user accounts',
   'user:delete': 'Delete user accounts',
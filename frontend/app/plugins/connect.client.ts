@@ -2,6 +2,7 @@ import type { Interceptor } from '@connectrpc/connect';
 import { createValidator } from '@bufbuild/protovalidate';
 import { Code, ConnectError, createClient } from '@connectrpc/connect';
 import { createConnectTransport } from '@connectrpc/connect-web';
+import { ActivityService } from '~~/gen/altalune/v1/activity_pb';
 import { ApiKeyService } from '~~/gen/altalune/v1/api_key_pb';
 import { ChatbotNodeService } from '~~/gen/altalune/v1/chatbot_node_pb';
 import { ChatbotService } from '~~/gen/altalune/v1/chatbot_pb';
@@ -11,8 +12,10 @@ import { IAMMapperService } from '~~/gen/altalune/v1/iam_mapper_pb';
 import { OAuthClientService } from '~~/gen/altalune/v1/oauth_client_pb';
 import { OAuthProviderService } from '~~/gen/altalune/v1/oauth_provider_pb';
 import { PermissionService } from '~~/gen/altalune/v1/permission_pb';
+import { ProjectBrandingService } from '~~/gen/altalune/v1/project_branding_pb';
 import { ProjectService } from '~~/gen/altalune/v1/project_pb';
 import { RoleService } from '~~/gen/altalune/v1/role_pb';
+import { SavedViewService } from '~~/gen/altalune/v1/saved_view_pb';
 import { UserService } from '~~/gen/altalune/v1/user_pb';
 import { GreeterService } from '~~/gen/greeter/v1/greeter_pb';
 import { useAuthService } from '@/composables/useAuthService';
@@ -89,12 +92,15 @@ export default defineNuxtPlugin({
     const greeterClient = createClient(GreeterService, transport);
     const employeeClient = createClient(EmployeeService, transport);
     const projectClient = createClient(ProjectService, transport);
+    const projectBrandingClient = createClient(ProjectBrandingService, transport);
     const userClient = createClient(UserService, transport);
     const roleClient = createClient(RoleService, transport);
     const permissionClient = createClient(PermissionService, transport);
     const iamMapperClient = createClient(IAMMapperService, transport);
     const oauthClientClient = createClient(OAuthClientService, transport);
     const oauthProviderClient = createClient(OAuthProviderService, transport);
+    const savedViewClient = createClient(SavedViewService, transport);
+    const activityClient = createClient(ActivityService, transport);
 
     return {
       provide: {
@@ -106,12 +112,15 @@ export default defineNuxtPlugin({
         greeterClient,
         employeeClient,
         projectClient,
+        projectBrandingClient,
         userClient,
         roleClient,
         permissionClient,
         iamMapperClient,
         oauthClientClient,
         oauthProviderClient,
+        savedViewClient,
+        activityClient,
       },
     };
   },
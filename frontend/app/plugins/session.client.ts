@@ -0,0 +1,45 @@
+import { useAuthService } from '@/composables/useAuthService';
+import { useAuthStore } from '@/stores/auth';
+
+// Polls the session heartbeat so that a logout on the auth server (or a
+// revoked refresh token) signs the dashboard out right away instead of when
+// the access token expires.
+export default defineNuxtPlugin({
+  name: 'session',
+  setup() {
+    const config = useRuntimeConfig();
+    const intervalMs = Number(config.public.sessionHeartbeatInterval) * 1000;
+    if (!(intervalMs > 0)) {
+      return;
+    }
+
+    const authStore = useAuthStore();
+    const authService = useAuthService();
+    const router = useRouter();
+    let checking = false;
+
+    async function check() {
+      if (checking || !authStore.isAuthenticated || document.visibilityState !== 'visible') {
+        return;
+      }
+
+      checking = true;
+      try {
+        const active = await authService.checkSession();
+        if (!active) {
+          const current = router.currentRoute.value.fullPath;
+          const nextUrl = current !== '/' && !current.startsWith('/auth/') ? current : undefined;
+          authStore.setReturnUrl(nextUrl || null);
+          await navigateTo(nextUrl ? `/auth/login?next=${encodeURIComponent(nextUrl)}` : '/auth/login');
+        }
+      }
+      finally {
+        checking = false;
+      }
+    }
+
+    setInterval(check, intervalMs);
+    // Check right away when coming back to a tab that sat in the background
+    document.addEventListener('visibilitychange', check);
+  },
+});
@@ -0,0 +1,213 @@
+// @generated by protoc-gen-es v2.6.3 with parameter "target=ts,import_extension=js"
+// @generated from file altalune/v1/activity.proto (package altalune.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import { file_buf_validate_validate } from "../../buf/validate/validate_pb.js";
+import { file_altalune_v1_options } from "./options_pb.js";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file altalune/v1/activity.proto.
+ */
+export const file_altalune_v1_activity: GenFile = /*@__PURE__*/
+  fileDesc("ChphbHRhbHVuZS92MS9hY3Rpdml0eS5wcm90bxILYWx0YWx1bmUudjEipwIKCEFjdGl2aXR5EgoKAmlkGAEgASgJEi8KCGNhdGVnb3J5GAIgASgOMh0uYWx0YWx1bmUudjEuQWN0aXZpdHlDYXRlZ29yeRINCgVldmVudBgDIAEoCRIQCghhY3Rvcl9pZBgEIAEoCRISCgpzdWJqZWN0X2lkGAUgASgJEhQKDHN1YmplY3RfbmFtZRgGIAEoCRIzCgdkZXRhaWxzGAcgAygLMiIuYWx0YWx1bmUudjEuQWN0aXZpdHkuRGV0YWlsc0VudHJ5Ei4KCmNyZWF0ZWRfYXQYYyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wGi4KDERldGFpbHNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIrsBChpMaXN0UHJvamVjdEFjdGl2aXR5UmVxdWVzdBIfCgpwcm9qZWN0X2lkGAEgASgJQgu6SAjIAQFyA5gBDhJECgpjYXRlZ29yaWVzGAIgAygOMh0uYWx0YWx1bmUudjEuQWN0aXZpdHlDYXRlZ29yeUIRukgOkgELEAQiB4IBBBABIAASHAoJcGFnZV9zaXplGAMgASgFQgm6SAYaBBhkKAASGAoGY3Vyc29yGAQgASgJQgi6SAVyAxiABCJdChtMaXN0UHJvamVjdEFjdGl2aXR5UmVzcG9uc2USKQoKYWN0aXZpdGllcxgBIAMoCzIVLmFsdGFsdW5lLnYxLkFjdGl2aXR5EhMKC25leHRfY3Vyc29yGAIgASgJKrMBChBBY3Rpdml0eUNhdGVnb3J5EiEKHUFDVElWSVRZX0NBVEVHT1JZX1VOU1BFQ0lGSUVEEAASHQoZQUNUSVZJVFlfQ0FURUdPUllfTUVNQkVSUxABEh0KGUFDVElWSVRZX0NBVEVHT1JZX0NMSUVOVFMQAhIeChpBQ1RJVklUWV9DQVRFR09SWV9BUElfS0VZUxADEh4KGkFDVElWSVRZX0NBVEVHT1JZX1NFQ1VSSVRZEAQyjQEKD0FjdGl2aXR5U2VydmljZRJ6ChNMaXN0UHJvamVjdEFjdGl2aXR5EicuYWx0YWx1bmUudjEuTGlzdFByb2plY3RBY3Rpdml0eVJlcXVlc3QaKC5hbHRhbHVuZS52MS5MaXN0UHJvamVjdEFjdGl2aXR5UmVzcG9uc2UiEIq1GAxwcm9qZWN0OnJlYWRCogEKD2NvbS5hbHRhbHVuZS52MUINQWN0aXZpdHlQcm90b1ABWjNnaXRodWIuY29tL2hyejgvYWx0YWx1bmUvZ2VuL2FsdGFsdW5lL3YxO2FsdGFsdW5ldjGiAgNBWFiqAgtBbHRhbHVuZS5WMcoCC0FsdGFsdW5lXFYx4gIXQWx0YWx1bmVcVjFcR1BCTWV0YWRhdGHqAgxBbHRhbHVuZTo6VjFiBnByb3RvMw", [file_google_protobuf_timestamp, file_buf_validate_validate, file_altalune_v1_options]);
+
+/**
+ * Activity Message
+ *
+ * @generated from message altalune.v1.Activity
+ */
+export type Activity = Message<"altalune.v1.Activity"> & {
+  /**
+   * Public nanoid
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: altalune.v1.ActivityCategory category = 2;
+   */
+  category: ActivityCategory;
+
+  /**
+   * Domain event name, e.g. "project.member_added"
+   *
+   * @generated from field: string event = 3;
+   */
+  event: string;
+
+  /**
+   * actor_id - public ID of the user who caused the event, empty for sign-ins
+   * and unauthenticated changes
+   *
+   * @generated from field: string actor_id = 4;
+   */
+  actorId: string;
+
+  /**
+   * subject_id / subject_name - what the event happened to: the member, OAuth
+   * client, API key or user failing to sign in, and its email or name
+   *
+   * @generated from field: string subject_id = 5;
+   */
+  subjectId: string;
+
+  /**
+   * @generated from field: string subject_name = 6;
+   */
+  subjectName: string;
+
+  /**
+   * e.g. the role of a member, whether a sign-in locked the account
+   *
+   * @generated from field: map<string, string> details = 7;
+   */
+  details: { [key: string]: string };
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 99;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message altalune.v1.Activity.
+ * Use `create(ActivitySchema)` to create a new message.
+ */
+export const ActivitySchema: GenMessage<Activity> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_activity, 0);
+
+/**
+ * @generated from message altalune.v1.ListProjectActivityRequest
+ */
+export type ListProjectActivityRequest = Message<"altalune.v1.ListProjectActivityRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * categories - categories to return, all when empty
+   *
+   * @generated from field: repeated altalune.v1.ActivityCategory categories = 2;
+   */
+  categories: ActivityCategory[];
+
+  /**
+   * 20 when 0
+   *
+   * @generated from field: int32 page_size = 3;
+   */
+  pageSize: number;
+
+  /**
+   * cursor - next_cursor of the previous page, to fetch the older events
+   * following it; the newest page when empty
+   *
+   * @generated from field: string cursor = 4;
+   */
+  cursor: string;
+};
+
+/**
+ * Describes the message altalune.v1.ListProjectActivityRequest.
+ * Use `create(ListProjectActivityRequestSchema)` to create a new message.
+ */
+export const ListProjectActivityRequestSchema: GenMessage<ListProjectActivityRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_activity, 1);
+
+/**
+ * @generated from message altalune.v1.ListProjectActivityResponse
+ */
+export type ListProjectActivityResponse = Message<"altalune.v1.ListProjectActivityResponse"> & {
+  /**
+   * @generated from field: repeated altalune.v1.Activity activities = 1;
+   */
+  activities: Activity[];
+
+  /**
+   * next_cursor - cursor of the page following this one, empty on the last page
+   *
+   * @generated from field: string next_cursor = 2;
+   */
+  nextCursor: string;
+};
+
+/**
+ * Describes the message altalune.v1.ListProjectActivityResponse.
+ * Use `create(ListProjectActivityResponseSchema)` to create a new message.
+ */
+export const ListProjectActivityResponseSchema: GenMessage<ListProjectActivityResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_activity, 2);
+
+/**
+ * ActivityCategory - Kind of event, the feed filters on
+ *
+ * @generated from enum altalune.v1.ActivityCategory
+ */
+export enum ActivityCategory {
+  /**
+   * @generated from enum value: ACTIVITY_CATEGORY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Members added to the project
+   *
+   * @generated from enum value: ACTIVITY_CATEGORY_MEMBERS = 1;
+   */
+  MEMBERS = 1,
+
+  /**
+   * OAuth clients created
+   *
+   * @generated from enum value: ACTIVITY_CATEGORY_CLIENTS = 2;
+   */
+  CLIENTS = 2,
+
+  /**
+   * API keys created, including rotations
+   *
+   * @generated from enum value: ACTIVITY_CATEGORY_API_KEYS = 3;
+   */
+  API_KEYS = 3,
+
+  /**
+   * Failed sign-ins and lockouts of members
+   *
+   * @generated from enum value: ACTIVITY_CATEGORY_SECURITY = 4;
+   */
+  SECURITY = 4,
+}
+
+/**
+ * Describes the enum altalune.v1.ActivityCategory.
+ */
+export const ActivityCategorySchema: GenEnum<ActivityCategory> = /*@__PURE__*/
+  enumDesc(file_altalune_v1_activity, 0);
+
+/**
+ * Activity Service - Feed of the notable events of a project, newest first,
+ * for the dashboard home page. Events are recorded as they are published:
+ * members joining, OAuth clients and API keys created, and failed sign-ins
+ * of members. Global events (OAuth clients) show in the default project.
+ *
+ * @generated from service altalune.v1.ActivityService
+ */
+export const ActivityService: GenService<{
+  /**
+   * @generated from rpc altalune.v1.ActivityService.ListProjectActivity
+   */
+  listProjectActivity: {
+    methodKind: "unary";
+    input: typeof ListProjectActivityRequestSchema;
+    output: typeof ListProjectActivityResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_altalune_v1_activity, 0);
+
@@ -2,20 +2,21 @@
 // @generated from file altalune/v1/api_key.proto (package altalune.v1, syntax proto3)
 /* eslint-disable */
 
-import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
-import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
 import type { Timestamp } from "@bufbuild/protobuf/wkt";
 import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import { file_buf_validate_validate } from "../../buf/validate/validate_pb.js";
-import type { QueryMetaResponse, QueryRequest } from "./common_pb.js";
+import type { DeliveredSecret, QueryMetaResponse, QueryRequest } from "./common_pb.js";
 import { file_altalune_v1_common } from "./common_pb.js";
+import { file_altalune_v1_options } from "./options_pb.js";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file altalune/v1/api_key.proto.
  */
 export const file_altalune_v1_api_key: GenFile = /*@__PURE__*/
-  fileDesc("ChlhbHRhbHVuZS92MS9hcGlfa2V5LnByb3RvEgthbHRhbHVuZS52MSLCAQoGQXBpS2V5EgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSLgoKZXhwaXJhdGlvbhgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDgoGYWN0aXZlGAQgASgIEi4KCmNyZWF0ZWRfYXQYYiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYYyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIqsBChNDcmVhdGVBcGlLZXlSZXF1ZXN0Eh8KCnByb2plY3RfaWQYASABKAlCC7pICMgBAXIDmAEOEi8KBG5hbWUYAiABKAlCIbpIHsgBAXIZEAIYMjITXlthLXpBLVowLTlcc1wtX10rJBJCCgpleHBpcmF0aW9uGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEISukgPyAEBsgEJSgUIgM6JHkABImAKFENyZWF0ZUFwaUtleVJlc3BvbnNlEiQKB2FwaV9rZXkYASABKAsyEy5hbHRhbHVuZS52MS5BcGlLZXkSEQoJa2V5X3ZhbHVlGAIgASgJEg8KB21lc3NhZ2UYAyABKAkiYAoTUXVlcnlBcGlLZXlzUmVxdWVzdBIfCgpwcm9qZWN0X2lkGAEgASgJQgu6SAjIAQFyA5gBDhIoCgVxdWVyeRgCIAEoCzIZLmFsdGFsdW5lLnYxLlF1ZXJ5UmVxdWVzdCJnChRRdWVyeUFwaUtleXNSZXNwb25zZRIhCgRkYXRhGAEgAygLMhMuYWx0YWx1bmUudjEuQXBpS2V5EiwKBG1ldGEYAiABKAsyHi5hbHRhbHVuZS52MS5RdWVyeU1ldGFSZXNwb25zZSJUChBHZXRBcGlLZXlSZXF1ZXN0Eh8KCnByb2plY3RfaWQYASABKAlCC7pICMgBAXIDmAEOEh8KCmFwaV9rZXlfaWQYAiABKAlCC7pICMgBAXIDmAEOIjkKEUdldEFwaUtleVJlc3BvbnNlEiQKB2FwaV9rZXkYASABKAsyEy5hbHRhbHVuZS52MS5BcGlLZXkizAEKE1VwZGF0ZUFwaUtleVJlcXVlc3QSHwoKcHJvamVjdF9pZBgBIAEoCUILukgIyAEBcgOYAQ4SHwoKYXBpX2tleV9pZBgCIAEoCUILukgIyAEBcgOYAQ4SLwoEbmFtZRgDIAEoCUIhukgeyAEBchkQAhgyMhNeW2EtekEtWjAtOVxzXC1fXSskEkIKCmV4cGlyYXRpb24YBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQhK6SA/IAQGyAQlKBQiAzokeQAEiTQoUVXBkYXRlQXBpS2V5UmVzcG9uc2USJAoHYXBpX2tleRgBIAEoCzITLmFsdGFsdW5lLnYxLkFwaUtleRIPCgdtZXNzYWdlGAIgASgJIlcKE0RlbGV0ZUFwaUtleVJlcXVlc3QSHwoKcHJvamVjdF9pZBgBIAEoCUILukgIyAEBcgOYAQ4SHwoKYXBpX2tleV9pZBgCIAEoCUILukgIyAEBcgOYAQ4iJwoURGVsZXRlQXBpS2V5UmVzcG9uc2USDwoHbWVzc2FnZRgBIAEoCSJZChVBY3RpdmF0ZUFwaUtleVJlcXVlc3QSHwoKcHJvamVjdF9pZBgBIAEoCUILukgIyAEBcgOYAQ4SHwoKYXBpX2tleV9pZBgCIAEoCUILukgIyAEBcgOYAQ4iTwoWQWN0aXZhdGVBcGlLZXlSZXNwb25zZRIkCgdhcGlfa2V5GAEgASgLMhMuYWx0YWx1bmUudjEuQXBpS2V5Eg8KB21lc3NhZ2UYAiABKAkiWwoXRGVhY3RpdmF0ZUFwaUtleVJlcXVlc3QSHwoKcHJvamVjdF9pZBgBIAEoCUILukgIyAEBcgOYAQ4SHwoKYXBpX2tleV9pZBgCIAEoCUILukgIyAEBcgOYAQ4iUQoYRGVhY3RpdmF0ZUFwaUtleVJlc3BvbnNlEiQKB2FwaV9rZXkYASABKAsyEy5hbHRhbHVuZS52MS5BcGlLZXkSDwoHbWVzc2FnZRgCIAEoCTL5BAoNQXBpS2V5U2VydmljZRJVCgxRdWVyeUFwaUtleXMSIC5hbHRhbHVuZS52MS5RdWVyeUFwaUtleXNSZXF1ZXN0GiEuYWx0YWx1bmUudjEuUXVlcnlBcGlLZXlzUmVzcG9uc2UiABJVCgxDcmVhdGVBcGlLZXkSIC5hbHRhbHVuZS52MS5DcmVhdGVBcGlLZXlSZXF1ZXN0GiEuYWx0YWx1bmUudjEuQ3JlYXRlQXBpS2V5UmVzcG9uc2UiABJMCglHZXRBcGlLZXkSHS5hbHRhbHVuZS52MS5HZXRBcGlLZXlSZXF1ZXN0Gh4uYWx0YWx1bmUudjEuR2V0QXBpS2V5UmVzcG9uc2UiABJVCgxVcGRhdGVBcGlLZXkSIC5hbHRhbHVuZS52MS5VcGRhdGVBcGlLZXlSZXF1ZXN0GiEuYWx0YWx1bmUudjEuVXBkYXRlQXBpS2V5UmVzcG9uc2UiABJVCgxEZWxldGVBcGlLZXkSIC5hbHRhbHVuZS52MS5EZWxldGVBcGlLZXlSZXF1ZXN0GiEuYWx0YWx1bmUudjEuRGVsZXRlQXBpS2V5UmVzcG9uc2UiABJbCg5BY3RpdmF0ZUFwaUtleRIiLmFsdGFsdW5lLnYxLkFjdGl2YXRlQXBpS2V5UmVxdWVzdBojLmFsdGFsdW5lLnYxLkFjdGl2YXRlQXBpS2V5UmVzcG9uc2UiABJhChBEZWFjdGl2YXRlQXBpS2V5EiQuYWx0YWx1bmUudjEuRGVhY3RpdmF0ZUFwaUtleVJlcXVlc3QaJS5hbHRhbHVuZS52MS5EZWFjdGl2YXRlQXBpS2V5UmVzcG9uc2UiAEKgAQoPY29tLmFsdGFsdW5lLnYxQgtBcGlLZXlQcm90b1ABWjNnaXRodWIuY29tL2hyejgvYWx0YWx1bmUvZ2VuL2FsdGFsdW5lL3YxO2FsdGFsdW5ldjGiAgNBWFiqAgtBbHRhbHVuZS5WMcoCC0FsdGFsdW5lXFYx4gIXQWx0YWx1bmVcVjFcR1BCTWV0YWRhdGHqAgxBbHRhbHVuZTo6VjFiBnByb3RvMw", [file_google_protobuf_timestamp, file_buf_validate_validate, file_altalune_v1_common]);
+  fileDesc("ChlhbHRhbHVuZS92MS9hcGlfa2V5LnByb3RvEgthbHRhbHVuZS52MSLsAgoGQXBpS2V5EgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSLgoKZXhwaXJhdGlvbhgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDgoGYWN0aXZlGAQgASgIEi4KCmRlbGV0ZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhIKCmNyZWF0ZWRfYnkYBiABKAkSEgoKdXBkYXRlZF9ieRgHIAEoCRIQCghvd25lcl9pZBgIIAEoCRIpCgZzdGF0dXMYCSABKA4yGS5hbHRhbHVuZS52MS5BcGlLZXlTdGF0dXMSEwoLZXh0ZXJuYWxfaWQYCiABKAkSLgoKY3JlYXRlZF9hdBhiIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBhjIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAipQIKE0NyZWF0ZUFwaUtleVJlcXVlc3QSHwoKcHJvamVjdF9pZBgBIAEoCUILukgIyAEBcgOYAQ4SLwoEbmFtZRgCIAEoCUIhukgeyAEBchkQAhgyMhNeW2EtekEtWjAtOVxzXC1fXSskEkIKCmV4cGlyYXRpb24YAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQhK6SA/IAQGyAQlKBQiAzokeQAESGQoIb3duZXJfaWQYBCABKAlCB7pIBHICGBQSJgoUcmVjaXBpZW50X3B1YmxpY19rZXkYBSABKAlCCLpIBXIDGIAgEjUKC2V4dGVybmFsX2lkGAYgASgJQiC6SB3YAQFyGBhkMhReW0EtWmEtejAtOS5fOi9ALV0rJCKbAQoUQ3JlYXRlQXBpS2V5UmVzcG9uc2USJAoHYXBpX2tleRgBIAEoCzITLmFsdGFsdW5lLnYxLkFwaUtleRIRCglrZXlfdmFsdWUYAiABKAkSDwoHbWVzc2FnZRgDIAEoCRI5ChNkZWxpdmVyZWRfa2V5X3ZhbHVlGAQgASgLMhwuYWx0YWx1bmUudjEuRGVsaXZlcmVkU2VjcmV0InEKE1F1ZXJ5QXBpS2V5c1JlcXVlc3QSHwoKcHJvamVjdF9pZBgBIAEoCUILukgIyAEBcgOYAQ4SKAoFcXVlcnkYAiABKAsyGS5hbHRhbHVuZS52MS5RdWVyeVJlcXVlc3QSDwoHdHJhc2hlZBgDIAEoCCJnChRRdWVyeUFwaUtleXNSZXNwb25zZRIhCgRkYXRhGAEgAygLMhMuYWx0YWx1bmUudjEuQXBpS2V5EiwKBG1ldGEYAiABKAsyHi5hbHRhbHVuZS52MS5RdWVyeU1ldGFSZXNwb25zZSJUChBHZXRBcGlLZXlSZXF1ZXN0Eh8KCnByb2plY3RfaWQYASABKAlCC7pICMgBAXIDmAEOEh8KCmFwaV9rZXlfaWQYAiABKAlCC7pICMgBAXIDmAEOIjkKEUdldEFwaUtleVJlc3BvbnNlEiQKB2FwaV9rZXkYASABKAsyEy5hbHRhbHVuZS52MS5BcGlLZXkihQIKE1VwZGF0ZUFwaUtleVJlcXVlc3QSHwoKcHJvamVjdF9pZBgBIAEoCUILukgIyAEBcgOYAQ4SHwoKYXBpX2tleV9pZBgCIAEoCUILukgIyAEBcgOYAQ4SLwoEbmFtZRgDIAEoCUIhukgeyAEBchkQAhgyMhNeW2EtekEtWjAtOVxzXC1fXSskEkIKCmV4cGlyYXRpb24YBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQhK6SA/IAQGyAQlKBQiAzokeQAESNwoTZXhwZWN0ZWRfdXBkYXRlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiTQoUVXBkYXRlQXBpS2V5UmVzcG9uc2USJAoHYXBpX2tleRgBIAEoCzITLmFsdGFsdW5lLnYxLkFwaUtleRIPCgdtZXNzYWdlGAIgASgJIlcKE0RlbGV0ZUFwaUtleVJlcXVlc3QSHwoKcHJvamVjdF9pZBgBIAEoCUILukgIyAEBcgOYAQ4SHwoKYXBpX2tleV9pZBgCIAEoCUILukgIyAEBcgOYAQ4iJwoURGVsZXRlQXBpS2V5UmVzcG9uc2USDwoHbWVzc2FnZRgBIAEoCSJYChRSZXN0b3JlQXBpS2V5UmVxdWVzdBIfCgpwcm9qZWN0X2lkGAEgASgJQgu6SAjIAQFyA5gBDhIfCgphcGlfa2V5X2lkGAIgASgJQgu6SAjIAQFyA5gBDiJOChVSZXN0b3JlQXBpS2V5UmVzcG9uc2USJAoHYXBpX2tleRgBIAEoCzITLmFsdGFsdW5lLnYxLkFwaUtleRIPCgdtZXNzYWdlGAIgASgJIlkKFUFjdGl2YXRlQXBpS2V5UmVxdWVzdBIfCgpwcm9qZWN0X2lkGAEgASgJQgu6SAjIAQFyA5gBDhIfCgphcGlfa2V5X2lkGAIgASgJQgu6SAjIAQFyA5gBDiJPChZBY3RpdmF0ZUFwaUtleVJlc3BvbnNlEiQKB2FwaV9rZXkYASABKAsyEy5hbHRhbHVuZS52MS5BcGlLZXkSDwoHbWVzc2FnZRgCIAEoCSJbChdEZWFjdGl2YXRlQXBpS2V5UmVxdWVzdBIfCgpwcm9qZWN0X2lkGAEgASgJQgu6SAjIAQFyA5gBDhIfCgphcGlfa2V5X2lkGAIgASgJQgu6SAjIAQFyA5gBDiJRChhEZWFjdGl2YXRlQXBpS2V5UmVzcG9uc2USJAoHYXBpX2tleRgBIAEoCzITLmFsdGFsdW5lLnYxLkFwaUtleRIPCgdtZXNzYWdlGAIgASgJIpgCChJBcHBseUFwaUtleVJlcXVlc3QSHwoKcHJvamVjdF9pZBgBIAEoCUILukgIyAEBcgOYAQ4SNQoLZXh0ZXJuYWxfaWQYAiABKAlCILpIHcgBAXIYGGQyFF5bQS1aYS16MC05Ll86L0AtXSskEi8KBG5hbWUYAyABKAlCIbpIHsgBAXIZEAIYMjITXlthLXpBLVowLTlcc1wtX10rJBI2CgpleHBpcmF0aW9uGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIGukgDyAEBEhkKCG93bmVyX2lkGAUgASgJQge6SARyAhgUEiYKFHJlY2lwaWVudF9wdWJsaWNfa2V5GAYgASgJQgi6SAVyAxiAICKrAQoTQXBwbHlBcGlLZXlSZXNwb25zZRIkCgdhcGlfa2V5GAEgASgLMhMuYWx0YWx1bmUudjEuQXBpS2V5Eg8KB2NyZWF0ZWQYAiABKAgSEQoJa2V5X3ZhbHVlGAMgASgJEjkKE2RlbGl2ZXJlZF9rZXlfdmFsdWUYBCABKAsyHC5hbHRhbHVuZS52MS5EZWxpdmVyZWRTZWNyZXQSDwoHbWVzc2FnZRgFIAEoCSKJAQoTSW1wb3J0QXBpS2V5UmVxdWVzdBIfCgpwcm9qZWN0X2lkGAEgASgJQgu6SAjIAQFyA5gBDhIaCgRuYW1lGAIgASgJQgy6SAnIAQFyBBABGDISNQoLZXh0ZXJuYWxfaWQYAyABKAlCILpIHcgBAXIYGGQyFF5bQS1aYS16MC05Ll86L0AtXSskIk0KFEltcG9ydEFwaUtleVJlc3BvbnNlEiQKB2FwaV9rZXkYASABKAsyEy5hbHRhbHVuZS52MS5BcGlLZXkSDwoHbWVzc2FnZRgCIAEoCSqkAQoMQXBpS2V5U3RhdHVzEh4KGkFQSV9LRVlfU1RBVFVTX1VOU1BFQ0lGSUVEEAASGQoVQVBJX0tFWV9TVEFUVVNfQUNUSVZFEAESGwoXQVBJX0tFWV9TVEFUVVNfSU5BQ1RJVkUQAhIaChZBUElfS0VZX1NUQVRVU19FWFBJUkVEEAMSIAocQVBJX0tFWV9TVEFUVVNfRVhQSVJJTkdfU09PThAEMp4ICg1BcGlLZXlTZXJ2aWNlEmQKDFF1ZXJ5QXBpS2V5cxIgLmFsdGFsdW5lLnYxLlF1ZXJ5QXBpS2V5c1JlcXVlc3QaIS5hbHRhbHVuZS52MS5RdWVyeUFwaUtleXNSZXNwb25zZSIPirUYC2FwaWtleTpyZWFkEmUKDENyZWF0ZUFwaUtleRIgLmFsdGFsdW5lLnYxLkNyZWF0ZUFwaUtleVJlcXVlc3QaIS5hbHRhbHVuZS52MS5DcmVhdGVBcGlLZXlSZXNwb25zZSIQirUYDGFwaWtleTp3cml0ZRJbCglHZXRBcGlLZXkSHS5hbHRhbHVuZS52MS5HZXRBcGlLZXlSZXF1ZXN0Gh4uYWx0YWx1bmUudjEuR2V0QXBpS2V5UmVzcG9uc2UiD4q1GAthcGlrZXk6cmVhZBJlCgxVcGRhdGVBcGlLZXkSIC5hbHRhbHVuZS52MS5VcGRhdGVBcGlLZXlSZXF1ZXN0GiEuYWx0YWx1bmUudjEuVXBkYXRlQXBpS2V5UmVzcG9uc2UiEIq1GAxhcGlrZXk6d3JpdGUSZgoMRGVsZXRlQXBpS2V5EiAuYWx0YWx1bmUudjEuRGVsZXRlQXBpS2V5UmVxdWVzdBohLmFsdGFsdW5lLnYxLkRlbGV0ZUFwaUtleVJlc3BvbnNlIhGKtRgNYXBpa2V5OmRlbGV0ZRJpCg1SZXN0b3JlQXBpS2V5EiEuYWx0YWx1bmUudjEuUmVzdG9yZUFwaUtleVJlcXVlc3QaIi5hbHRhbHVuZS52MS5SZXN0b3JlQXBpS2V5UmVzcG9uc2UiEYq1GA1hcGlrZXk6ZGVsZXRlEmsKDkFjdGl2YXRlQXBpS2V5EiIuYWx0YWx1bmUudjEuQWN0aXZhdGVBcGlLZXlSZXF1ZXN0GiMuYWx0YWx1bmUudjEuQWN0aXZhdGVBcGlLZXlSZXNwb25zZSIQirUYDGFwaWtleTp3cml0ZRJxChBEZWFjdGl2YXRlQXBpS2V5EiQuYWx0YWx1bmUudjEuRGVhY3RpdmF0ZUFwaUtleVJlcXVlc3QaJS5hbHRhbHVuZS52MS5EZWFjdGl2YXRlQXBpS2V5UmVzcG9uc2UiEIq1GAxhcGlrZXk6d3JpdGUSYgoLQXBwbHlBcGlLZXkSHy5hbHRhbHVuZS52MS5BcHBseUFwaUtleVJlcXVlc3QaIC5hbHRhbHVuZS52MS5BcHBseUFwaUtleVJlc3BvbnNlIhCKtRgMYXBpa2V5OndyaXRlEmUKDEltcG9ydEFwaUtleRIgLmFsdGFsdW5lLnYxLkltcG9ydEFwaUtleVJlcXVlc3QaIS5hbHRhbHVuZS52MS5JbXBvcnRBcGlLZXlSZXNwb25zZSIQirUYDGFwaWtleTp3cml0ZUKgAQoPY29tLmFsdGFsdW5lLnYxQgtBcGlLZXlQcm90b1ABWjNnaXRodWIuY29tL2hyejgvYWx0YWx1bmUvZ2VuL2FsdGFsdW5lL3YxO2FsdGFsdW5ldjGiAgNBWFiqAgtBbHRhbHVuZS5WMcoCC0FsdGFsdW5lXFYx4gIXQWx0YWx1bmVcVjFcR1BCTWV0YWRhdGHqAgxBbHRhbHVuZTo6VjFiBnByb3RvMw", [file_google_protobuf_timestamp, file_buf_validate_validate, file_altalune_v1_common, file_altalune_v1_options]);
 
 /**
  * @generated from message altalune.v1.ApiKey
@@ -43,6 +44,48 @@ export type ApiKey = Message<"altalune.v1.ApiKey"> & {
    */
   active: boolean;
 
+  /**
+   * Set only for API keys in the trash
+   *
+   * @generated from field: google.protobuf.Timestamp deleted_at = 5;
+   */
+  deletedAt?: Timestamp;
+
+  /**
+   * Public ID of the user who created the API key, empty if unknown
+   *
+   * @generated from field: string created_by = 6;
+   */
+  createdBy: string;
+
+  /**
+   * Public ID of the user who last updated the API key, empty if unknown
+   *
+   * @generated from field: string updated_by = 7;
+   */
+  updatedBy: string;
+
+  /**
+   * Public ID of the service account the API key belongs to, empty for project keys
+   *
+   * @generated from field: string owner_id = 8;
+   */
+  ownerId: string;
+
+  /**
+   * Combined status at the time of the response
+   *
+   * @generated from field: altalune.v1.ApiKeyStatus status = 9;
+   */
+  status: ApiKeyStatus;
+
+  /**
+   * Key of the API key in a provisioning tool, empty if unmanaged
+   *
+   * @generated from field: string external_id = 10;
+   */
+  externalId: string;
+
   /**
    * @generated from field: google.protobuf.Timestamp created_at = 98;
    */
@@ -79,6 +122,29 @@ export type CreateApiKeyRequest = Message<"altalune.v1.CreateApiKeyRequest"> & {
    * @generated from field: google.protobuf.Timestamp expiration = 3;
    */
   expiration?: Timestamp;
+
+  /**
+   * Public ID of the service account the API key belongs to, empty for a
+   * project key
+   *
+   * @generated from field: string owner_id = 4;
+   */
+  ownerId: string;
+
+  /**
+   * PEM RSA public key to encrypt the key value to, required when the project
+   * delivers secrets to public keys and honored whatever the policy
+   *
+   * @generated from field: string recipient_public_key = 5;
+   */
+  recipientPublicKey: string;
+
+  /**
+   * Key of the API key in a provisioning tool, unique within the project
+   *
+   * @generated from field: string external_id = 6;
+   */
+  externalId: string;
 };
 
 /**
@@ -98,7 +164,7 @@ export type CreateApiKeyResponse = Message<"altalune.v1.CreateApiKeyResponse"> &
   apiKey?: ApiKey;
 
   /**
-   * The actual API key value (shown only once)
+   * The actual API key value (shown only once), empty when delivered
    *
    * @generated from field: string key_value = 2;
    */
@@ -108,6 +174,13 @@ export type CreateApiKeyResponse = Message<"altalune.v1.CreateApiKeyResponse"> &
    * @generated from field: string message = 3;
    */
   message: string;
+
+  /**
+   * Set instead of key_value when the policy forbids plaintext
+   *
+   * @generated from field: altalune.v1.DeliveredSecret delivered_key_value = 4;
+   */
+  deliveredKeyValue?: DeliveredSecret;
 };
 
 /**
@@ -130,6 +203,13 @@ export type QueryApiKeysRequest = Message<"altalune.v1.QueryApiKeysRequest"> & {
    * @generated from field: altalune.v1.QueryRequest query = 2;
    */
   query?: QueryRequest;
+
+  /**
+   * List deleted API keys instead of live ones
+   *
+   * @generated from field: bool trashed = 3;
+   */
+  trashed: boolean;
 };
 
 /**
@@ -223,6 +303,14 @@ export type UpdateApiKeyRequest = Message<"altalune.v1.UpdateApiKeyRequest"> & {
    * @generated from field: google.protobuf.Timestamp expiration = 4;
    */
   expiration?: Timestamp;
+
+  /**
+   * updated_at of the API key the edit is based on. When set, the update is
+   * rejected with FAILED_PRECONDITION if the API key changed since then.
+   *
+   * @generated from field: google.protobuf.Timestamp expected_updated_at = 5;
+   */
+  expectedUpdatedAt?: Timestamp;
 };
 
 /**
@@ -293,6 +381,50 @@ export type DeleteApiKeyResponse = Message<"altalune.v1.DeleteApiKeyResponse"> &
 export const DeleteApiKeyResponseSchema: GenMessage<DeleteApiKeyResponse> = /*@__PURE__*/
   messageDesc(file_altalune_v1_api_key, 10);
 
+/**
+ * @generated from message altalune.v1.RestoreApiKeyRequest
+ */
+export type RestoreApiKeyRequest = Message<"altalune.v1.RestoreApiKeyRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: string api_key_id = 2;
+   */
+  apiKeyId: string;
+};
+
+/**
+ * Describes the message altalune.v1.RestoreApiKeyRequest.
+ * Use `create(RestoreApiKeyRequestSchema)` to create a new message.
+ */
+export const RestoreApiKeyRequestSchema: GenMessage<RestoreApiKeyRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_api_key, 11);
+
+/**
+ * @generated from message altalune.v1.RestoreApiKeyResponse
+ */
+export type RestoreApiKeyResponse = Message<"altalune.v1.RestoreApiKeyResponse"> & {
+  /**
+   * @generated from field: altalune.v1.ApiKey api_key = 1;
+   */
+  apiKey?: ApiKey;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.RestoreApiKeyResponse.
+ * Use `create(RestoreApiKeyResponseSchema)` to create a new message.
+ */
+export const RestoreApiKeyResponseSchema: GenMessage<RestoreApiKeyResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_api_key, 12);
+
 /**
  * @generated from message altalune.v1.ActivateApiKeyRequest
  */
@@ -313,7 +445,7 @@ export type ActivateApiKeyRequest = Message<"altalune.v1.ActivateApiKeyRequest">
  * Use `create(ActivateApiKeyRequestSchema)` to create a new message.
  */
 export const ActivateApiKeyRequestSchema: GenMessage<ActivateApiKeyRequest> = /*@__PURE__*/
-  messageDesc(file_altalune_v1_api_key, 11);
+  messageDesc(file_altalune_v1_api_key, 13);
 
 /**
  * @generated from message altalune.v1.ActivateApiKeyResponse
@@ -335,7 +467,7 @@ export type ActivateApiKeyResponse = Message<"altalune.v1.ActivateApiKeyResponse
  * Use `create(ActivateApiKeyResponseSchema)` to create a new message.
  */
 export const ActivateApiKeyResponseSchema: GenMessage<ActivateApiKeyResponse> = /*@__PURE__*/
-  messageDesc(file_altalune_v1_api_key, 12);
+  messageDesc(file_altalune_v1_api_key, 14);
 
 /**
  * @generated from message altalune.v1.DeactivateApiKeyRequest
@@ -357,7 +489,7 @@ export type DeactivateApiKeyRequest = Message<"altalune.v1.DeactivateApiKeyReque
  * Use `create(DeactivateApiKeyRequestSchema)` to create a new message.
  */
 export const DeactivateApiKeyRequestSchema: GenMessage<DeactivateApiKeyRequest> = /*@__PURE__*/
-  messageDesc(file_altalune_v1_api_key, 13);
+  messageDesc(file_altalune_v1_api_key, 15);
 
 /**
  * @generated from message altalune.v1.DeactivateApiKeyResponse
@@ -379,7 +511,204 @@ export type DeactivateApiKeyResponse = Message<"altalune.v1.DeactivateApiKeyResp
  * Use `create(DeactivateApiKeyResponseSchema)` to create a new message.
  */
 export const DeactivateApiKeyResponseSchema: GenMessage<DeactivateApiKeyResponse> = /*@__PURE__*/
-  messageDesc(file_altalune_v1_api_key, 14);
+  messageDesc(file_altalune_v1_api_key, 16);
+
+/**
+ * Creates the API key with the external ID, or brings the existing one,
+ * restored from the trash, to the given name and expiration. Applying the
+ * same request again changes nothing, even once the key has expired.
+ *
+ * @generated from message altalune.v1.ApplyApiKeyRequest
+ */
+export type ApplyApiKeyRequest = Message<"altalune.v1.ApplyApiKeyRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: string external_id = 2;
+   */
+  externalId: string;
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name: string;
+
+  /**
+   * Must be in the future when the key is created or its expiration changes
+   *
+   * @generated from field: google.protobuf.Timestamp expiration = 4;
+   */
+  expiration?: Timestamp;
+
+  /**
+   * Public ID of the service account the API key belongs to, empty for a
+   * project key. Cannot change once the key exists.
+   *
+   * @generated from field: string owner_id = 5;
+   */
+  ownerId: string;
+
+  /**
+   * PEM RSA public key to encrypt the key value to when the key is created,
+   * see CreateApiKeyRequest
+   *
+   * @generated from field: string recipient_public_key = 6;
+   */
+  recipientPublicKey: string;
+};
+
+/**
+ * Describes the message altalune.v1.ApplyApiKeyRequest.
+ * Use `create(ApplyApiKeyRequestSchema)` to create a new message.
+ */
+export const ApplyApiKeyRequestSchema: GenMessage<ApplyApiKeyRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_api_key, 17);
+
+/**
+ * @generated from message altalune.v1.ApplyApiKeyResponse
+ */
+export type ApplyApiKeyResponse = Message<"altalune.v1.ApplyApiKeyResponse"> & {
+  /**
+   * @generated from field: altalune.v1.ApiKey api_key = 1;
+   */
+  apiKey?: ApiKey;
+
+  /**
+   * Whether the key was created rather than updated
+   *
+   * @generated from field: bool created = 2;
+   */
+  created: boolean;
+
+  /**
+   * ONLY returned when created, empty when delivered
+   *
+   * @generated from field: string key_value = 3;
+   */
+  keyValue: string;
+
+  /**
+   * Set instead of key_value when the policy forbids plaintext
+   *
+   * @generated from field: altalune.v1.DeliveredSecret delivered_key_value = 4;
+   */
+  deliveredKeyValue?: DeliveredSecret;
+
+  /**
+   * @generated from field: string message = 5;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.ApplyApiKeyResponse.
+ * Use `create(ApplyApiKeyResponseSchema)` to create a new message.
+ */
+export const ApplyApiKeyResponseSchema: GenMessage<ApplyApiKeyResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_api_key, 18);
+
+/**
+ * Sets the external ID of the live API key with the name, so a provisioning
+ * tool can manage a key it did not create
+ *
+ * @generated from message altalune.v1.ImportApiKeyRequest
+ */
+export type ImportApiKeyRequest = Message<"altalune.v1.ImportApiKeyRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string external_id = 3;
+   */
+  externalId: string;
+};
+
+/**
+ * Describes the message altalune.v1.ImportApiKeyRequest.
+ * Use `create(ImportApiKeyRequestSchema)` to create a new message.
+ */
+export const ImportApiKeyRequestSchema: GenMessage<ImportApiKeyRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_api_key, 19);
+
+/**
+ * @generated from message altalune.v1.ImportApiKeyResponse
+ */
+export type ImportApiKeyResponse = Message<"altalune.v1.ImportApiKeyResponse"> & {
+  /**
+   * @generated from field: altalune.v1.ApiKey api_key = 1;
+   */
+  apiKey?: ApiKey;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.ImportApiKeyResponse.
+ * Use `create(ImportApiKeyResponseSchema)` to create a new message.
+ */
+export const ImportApiKeyResponseSchema: GenMessage<ImportApiKeyResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_api_key, 20);
+
+/**
+ * ApiKeyStatus - the combined status of an API key, from whether it is active
+ * and how close its expiration is, counting days in the project's time zone.
+ * Also the values of the "statuses" filter, lowercased without the prefix.
+ *
+ * @generated from enum altalune.v1.ApiKeyStatus
+ */
+export enum ApiKeyStatus {
+  /**
+   * @generated from enum value: API_KEY_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Active and expiring after the next 10 days
+   *
+   * @generated from enum value: API_KEY_STATUS_ACTIVE = 1;
+   */
+  ACTIVE = 1,
+
+  /**
+   * Deactivated before its expiration
+   *
+   * @generated from enum value: API_KEY_STATUS_INACTIVE = 2;
+   */
+  INACTIVE = 2,
+
+  /**
+   * Past its expiration, whether active or not
+   *
+   * @generated from enum value: API_KEY_STATUS_EXPIRED = 3;
+   */
+  EXPIRED = 3,
+
+  /**
+   * Active and expiring within today and the next 10 days
+   *
+   * @generated from enum value: API_KEY_STATUS_EXPIRING_SOON = 4;
+   */
+  EXPIRING_SOON = 4,
+}
+
+/**
+ * Describes the enum altalune.v1.ApiKeyStatus.
+ */
+export const ApiKeyStatusSchema: GenEnum<ApiKeyStatus> = /*@__PURE__*/
+  enumDesc(file_altalune_v1_api_key, 0);
 
 /**
  * @generated from service altalune.v1.ApiKeyService
@@ -425,6 +754,14 @@ export const ApiKeyService: GenService<{
     input: typeof DeleteApiKeyRequestSchema;
     output: typeof DeleteApiKeyResponseSchema;
   },
+  /**
+   * @generated from rpc altalune.v1.ApiKeyService.RestoreApiKey
+   */
+  restoreApiKey: {
+    methodKind: "unary";
+    input: typeof RestoreApiKeyRequestSchema;
+    output: typeof RestoreApiKeyResponseSchema;
+  },
   /**
    * @generated from rpc altalune.v1.ApiKeyService.ActivateApiKey
    */
@@ -441,6 +778,26 @@ export const ApiKeyService: GenService<{
     input: typeof DeactivateApiKeyRequestSchema;
     output: typeof DeactivateApiKeyResponseSchema;
   },
+  /**
+   * Create-or-update by external ID, for declarative provisioning
+   *
+   * @generated from rpc altalune.v1.ApiKeyService.ApplyApiKey
+   */
+  applyApiKey: {
+    methodKind: "unary";
+    input: typeof ApplyApiKeyRequestSchema;
+    output: typeof ApplyApiKeyResponseSchema;
+  },
+  /**
+   * Bring an existing API key under an external ID, found by name
+   *
+   * @generated from rpc altalune.v1.ApiKeyService.ImportApiKey
+   */
+  importApiKey: {
+    methodKind: "unary";
+    input: typeof ImportApiKeyRequestSchema;
+    output: typeof ImportApiKeyResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_altalune_v1_api_key, 0);
 
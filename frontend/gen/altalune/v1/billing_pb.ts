@@ -0,0 +1,249 @@
+// @generated by protoc-gen-es v2.6.3 with parameter "target=ts,import_extension=js"
+// @generated from file altalune/v1/billing.proto (package altalune.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import { file_buf_validate_validate } from "../../buf/validate/validate_pb.js";
+import { file_altalune_v1_options } from "./options_pb.js";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file altalune/v1/billing.proto.
+ */
+export const file_altalune_v1_billing: GenFile = /*@__PURE__*/
+  fileDesc("ChlhbHRhbHVuZS92MS9iaWxsaW5nLnByb3RvEgthbHRhbHVuZS52MSJnCgtCaWxsaW5nUGxhbhIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhQKDG1heF9wcm9qZWN0cxgDIAEoBRITCgttYXhfY2xpZW50cxgEIAEoBRITCgttYXhfbWVtYmVycxgFIAEoBSISChBMaXN0UGxhbnNSZXF1ZXN0IlIKEUxpc3RQbGFuc1Jlc3BvbnNlEicKBXBsYW5zGAEgAygLMhguYWx0YWx1bmUudjEuQmlsbGluZ1BsYW4SFAoMZGVmYXVsdF9wbGFuGAIgASgJIkUKHUdldE9yZ2FuaXphdGlvbkJpbGxpbmdSZXF1ZXN0EiQKD29yZ2FuaXphdGlvbl9pZBgBIAEoCUILukgIyAEBcgOYAQ4i6AEKHkdldE9yZ2FuaXphdGlvbkJpbGxpbmdSZXNwb25zZRImCgRwbGFuGAEgASgLMhguYWx0YWx1bmUudjEuQmlsbGluZ1BsYW4SGwoTc3Vic2NyaXB0aW9uX3N0YXR1cxgCIAEoCRI2ChJjdXJyZW50X3BlcmlvZF9lbmQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhwKFGNhbmNlbF9hdF9wZXJpb2RfZW5kGAQgASgIEhUKDXByb2plY3RfY291bnQYBSABKAUSFAoMaGFzX2N1c3RvbWVyGAYgASgIImYKGkNyZWF0ZVBvcnRhbFNlc3Npb25SZXF1ZXN0EiQKD29yZ2FuaXphdGlvbl9pZBgBIAEoCUILukgIyAEBcgOYAQ4SIgoKcmV0dXJuX3VybBgCIAEoCUIOukgL2AEBcgYYgBCIAQEiKgobQ3JlYXRlUG9ydGFsU2Vzc2lvblJlc3BvbnNlEgsKA3VybBgBIAEoCTLxAgoOQmlsbGluZ1NlcnZpY2USXAoJTGlzdFBsYW5zEh0uYWx0YWx1bmUudjEuTGlzdFBsYW5zUmVxdWVzdBoeLmFsdGFsdW5lLnYxLkxpc3RQbGFuc1Jlc3BvbnNlIhCKtRgMYmlsbGluZzpyZWFkEoMBChZHZXRPcmdhbml6YXRpb25CaWxsaW5nEiouYWx0YWx1bmUudjEuR2V0T3JnYW5pemF0aW9uQmlsbGluZ1JlcXVlc3QaKy5hbHRhbHVuZS52MS5HZXRPcmdhbml6YXRpb25CaWxsaW5nUmVzcG9uc2UiEIq1GAxiaWxsaW5nOnJlYWQSewoTQ3JlYXRlUG9ydGFsU2Vzc2lvbhInLmFsdGFsdW5lLnYxLkNyZWF0ZVBvcnRhbFNlc3Npb25SZXF1ZXN0GiguYWx0YWx1bmUudjEuQ3JlYXRlUG9ydGFsU2Vzc2lvblJlc3BvbnNlIhGKtRgNYmlsbGluZzp3cml0ZUKhAQoPY29tLmFsdGFsdW5lLnYxQgxCaWxsaW5nUHJvdG9QAVozZ2l0aHViLmNvbS9ocno4L2FsdGFsdW5lL2dlbi9hbHRhbHVuZS92MTthbHRhbHVuZXYxogIDQVhYqgILQWx0YWx1bmUuVjHKAgtBbHRhbHVuZVxWMeICF0FsdGFsdW5lXFYxXEdQQk1ldGFkYXRh6gIMQWx0YWx1bmU6OlYxYgZwcm90bzM", [file_google_protobuf_timestamp, file_buf_validate_validate, file_altalune_v1_options]);
+
+/**
+ * Billing Plan Message. A limit of 0 is unlimited.
+ *
+ * @generated from message altalune.v1.BillingPlan
+ */
+export type BillingPlan = Message<"altalune.v1.BillingPlan"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * Projects of an organization
+   *
+   * @generated from field: int32 max_projects = 3;
+   */
+  maxProjects: number;
+
+  /**
+   * OAuth clients, billed to the default project
+   *
+   * @generated from field: int32 max_clients = 4;
+   */
+  maxClients: number;
+
+  /**
+   * Members of a project
+   *
+   * @generated from field: int32 max_members = 5;
+   */
+  maxMembers: number;
+};
+
+/**
+ * Describes the message altalune.v1.BillingPlan.
+ * Use `create(BillingPlanSchema)` to create a new message.
+ */
+export const BillingPlanSchema: GenMessage<BillingPlan> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_billing, 0);
+
+/**
+ * @generated from message altalune.v1.ListPlansRequest
+ */
+export type ListPlansRequest = Message<"altalune.v1.ListPlansRequest"> & {
+};
+
+/**
+ * Describes the message altalune.v1.ListPlansRequest.
+ * Use `create(ListPlansRequestSchema)` to create a new message.
+ */
+export const ListPlansRequestSchema: GenMessage<ListPlansRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_billing, 1);
+
+/**
+ * @generated from message altalune.v1.ListPlansResponse
+ */
+export type ListPlansResponse = Message<"altalune.v1.ListPlansResponse"> & {
+  /**
+   * In configuration order
+   *
+   * @generated from field: repeated altalune.v1.BillingPlan plans = 1;
+   */
+  plans: BillingPlan[];
+
+  /**
+   * Plan without an active subscription
+   *
+   * @generated from field: string default_plan = 2;
+   */
+  defaultPlan: string;
+};
+
+/**
+ * Describes the message altalune.v1.ListPlansResponse.
+ * Use `create(ListPlansResponseSchema)` to create a new message.
+ */
+export const ListPlansResponseSchema: GenMessage<ListPlansResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_billing, 2);
+
+/**
+ * @generated from message altalune.v1.GetOrganizationBillingRequest
+ */
+export type GetOrganizationBillingRequest = Message<"altalune.v1.GetOrganizationBillingRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+};
+
+/**
+ * Describes the message altalune.v1.GetOrganizationBillingRequest.
+ * Use `create(GetOrganizationBillingRequestSchema)` to create a new message.
+ */
+export const GetOrganizationBillingRequestSchema: GenMessage<GetOrganizationBillingRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_billing, 3);
+
+/**
+ * @generated from message altalune.v1.GetOrganizationBillingResponse
+ */
+export type GetOrganizationBillingResponse = Message<"altalune.v1.GetOrganizationBillingResponse"> & {
+  /**
+   * Plan in effect, the default one without an active subscription
+   *
+   * @generated from field: altalune.v1.BillingPlan plan = 1;
+   */
+  plan?: BillingPlan;
+
+  /**
+   * Stripe status, e.g. active, past_due, canceled; empty without a subscription
+   *
+   * @generated from field: string subscription_status = 2;
+   */
+  subscriptionStatus: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp current_period_end = 3;
+   */
+  currentPeriodEnd?: Timestamp;
+
+  /**
+   * @generated from field: bool cancel_at_period_end = 4;
+   */
+  cancelAtPeriodEnd: boolean;
+
+  /**
+   * @generated from field: int32 project_count = 5;
+   */
+  projectCount: number;
+
+  /**
+   * Whether the customer portal can be opened
+   *
+   * @generated from field: bool has_customer = 6;
+   */
+  hasCustomer: boolean;
+};
+
+/**
+ * Describes the message altalune.v1.GetOrganizationBillingResponse.
+ * Use `create(GetOrganizationBillingResponseSchema)` to create a new message.
+ */
+export const GetOrganizationBillingResponseSchema: GenMessage<GetOrganizationBillingResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_billing, 4);
+
+/**
+ * @generated from message altalune.v1.CreatePortalSessionRequest
+ */
+export type CreatePortalSessionRequest = Message<"altalune.v1.CreatePortalSessionRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * Defaults to billing.portalReturnURL
+   *
+   * @generated from field: string return_url = 2;
+   */
+  returnUrl: string;
+};
+
+/**
+ * Describes the message altalune.v1.CreatePortalSessionRequest.
+ * Use `create(CreatePortalSessionRequestSchema)` to create a new message.
+ */
+export const CreatePortalSessionRequestSchema: GenMessage<CreatePortalSessionRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_billing, 5);
+
+/**
+ * @generated from message altalune.v1.CreatePortalSessionResponse
+ */
+export type CreatePortalSessionResponse = Message<"altalune.v1.CreatePortalSessionResponse"> & {
+  /**
+   * Short-lived portal link
+   *
+   * @generated from field: string url = 1;
+   */
+  url: string;
+};
+
+/**
+ * Describes the message altalune.v1.CreatePortalSessionResponse.
+ * Use `create(CreatePortalSessionResponseSchema)` to create a new message.
+ */
+export const CreatePortalSessionResponseSchema: GenMessage<CreatePortalSessionResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_billing, 6);
+
+/**
+ * Billing Service - Plans of the organizations, subscribed to through Stripe,
+ * and the limits they set
+ *
+ * @generated from service altalune.v1.BillingService
+ */
+export const BillingService: GenService<{
+  /**
+   * @generated from rpc altalune.v1.BillingService.ListPlans
+   */
+  listPlans: {
+    methodKind: "unary";
+    input: typeof ListPlansRequestSchema;
+    output: typeof ListPlansResponseSchema;
+  },
+  /**
+   * Subscription of an organization, for its owners and admins
+   *
+   * @generated from rpc altalune.v1.BillingService.GetOrganizationBilling
+   */
+  getOrganizationBilling: {
+    methodKind: "unary";
+    input: typeof GetOrganizationBillingRequestSchema;
+    output: typeof GetOrganizationBillingResponseSchema;
+  },
+  /**
+   * Link to the Stripe customer portal, where the owners of an organization
+   * change its plan, payment methods and billing details
+   *
+   * @generated from rpc altalune.v1.BillingService.CreatePortalSession
+   */
+  createPortalSession: {
+    methodKind: "unary";
+    input: typeof CreatePortalSessionRequestSchema;
+    output: typeof CreatePortalSessionResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_altalune_v1_billing, 0);
+
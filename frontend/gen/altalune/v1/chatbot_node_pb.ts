@@ -7,13 +7,14 @@ import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2
 import { file_buf_validate_validate } from "../../buf/validate/validate_pb.js";
 import type { ChatbotNode, ChatbotNodeMessage, ChatbotNodeTrigger, NodeCondition, NodeEffect, NodeNextAction } from "../../chatbot/nodes/v1/node_pb.js";
 import { file_chatbot_nodes_v1_node } from "../../chatbot/nodes/v1/node_pb.js";
+import { file_altalune_v1_options } from "./options_pb.js";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file altalune/v1/chatbot_node.proto.
  */
 export const file_altalune_v1_chatbot_node: GenFile = /*@__PURE__*/
-  fileDesc("Ch5hbHRhbHVuZS92MS9jaGF0Ym90X25vZGUucHJvdG8SC2FsdGFsdW5lLnYxIjMKEExpc3ROb2Rlc1JlcXVlc3QSHwoKcHJvamVjdF9pZBgBIAEoCUILukgIyAEBcgOYAQ4iQQoRTGlzdE5vZGVzUmVzcG9uc2USLAoFbm9kZXMYASADKAsyHS5jaGF0Ym90Lm5vZGVzLnYxLkNoYXRib3ROb2RlItUBChFDcmVhdGVOb2RlUmVxdWVzdBIfCgpwcm9qZWN0X2lkGAEgASgJQgu6SAjIAQFyA5gBDhItCgRuYW1lGAIgASgJQh+6SBzIAQFyFxACGGQyEV5bYS16XVthLXowLTlfXSokEiQKBGxhbmcYAyABKAlCFrpIE8gBAXIOUgVlbi1VU1IFaWQtSUQSDAoEdGFncxgEIAMoCRIwCgd2ZXJzaW9uGAUgASgJQhq6SBdyFRgyMhFeW2Etel1bYS16MC05X10qJEgAiAEBQgoKCF92ZXJzaW9uIkEKEkNyZWF0ZU5vZGVSZXNwb25zZRIrCgRub2RlGAEgASgLMh0uY2hhdGJvdC5ub2Rlcy52MS5DaGF0Ym90Tm9kZSJPCg5HZXROb2RlUmVxdWVzdBIfCgpwcm9qZWN0X2lkGAEgASgJQgu6SAjIAQFyA5gBDhIcCgdub2RlX2lkGAIgASgJQgu6SAjIAQFyA5gBDiI+Cg9HZXROb2RlUmVzcG9uc2USKwoEbm9kZRgBIAEoCzIdLmNoYXRib3Qubm9kZXMudjEuQ2hhdGJvdE5vZGUi5QQKEVVwZGF0ZU5vZGVSZXF1ZXN0Eh8KCnByb2plY3RfaWQYASABKAlCC7pICMgBAXIDmAEOEhwKB25vZGVfaWQYAiABKAlCC7pICMgBAXIDmAEOEi8KBG5hbWUYAyABKAlCHLpIGXIXEAIYZDIRXlthLXpdW2EtejAtOV9dKiRIAIgBARIMCgR0YWdzGAQgAygJEhQKB2VuYWJsZWQYBSABKAhIAYgBARI2Cgh0cmlnZ2VycxgGIAMoCzIkLmNoYXRib3Qubm9kZXMudjEuQ2hhdGJvdE5vZGVUcmlnZ2VyEjYKCG1lc3NhZ2VzGAcgAygLMiQuY2hhdGJvdC5ub2Rlcy52MS5DaGF0Ym90Tm9kZU1lc3NhZ2USFQoIcHJpb3JpdHkYCCABKAVIAogBARIyCgljb25kaXRpb24YCSABKAsyHy5jaGF0Ym90Lm5vZGVzLnYxLk5vZGVDb25kaXRpb24SLAoGZWZmZWN0GAogASgLMhwuY2hhdGJvdC5ub2Rlcy52MS5Ob2RlRWZmZWN0EjUKC25leHRfYWN0aW9uGAsgASgLMiAuY2hhdGJvdC5ub2Rlcy52MS5Ob2RlTmV4dEFjdGlvbhIXCg9jbGVhcl9jb25kaXRpb24YDCABKAgSFAoMY2xlYXJfZWZmZWN0GA0gASgIEhkKEWNsZWFyX25leHRfYWN0aW9uGA4gASgIEhwKD2ZvcmNlX2NvbmRpdGlvbhgPIAEoCEgDiAEBQgcKBV9uYW1lQgoKCF9lbmFibGVkQgsKCV9wcmlvcml0eUISChBfZm9yY2VfY29uZGl0aW9uIlIKElVwZGF0ZU5vZGVSZXNwb25zZRIrCgRub2RlGAEgASgLMh0uY2hhdGJvdC5ub2Rlcy52MS5DaGF0Ym90Tm9kZRIPCgdtZXNzYWdlGAIgASgJIlIKEURlbGV0ZU5vZGVSZXF1ZXN0Eh8KCnByb2plY3RfaWQYASABKAlCC7pICMgBAXIDmAEOEhwKB25vZGVfaWQYAiABKAlCC7pICMgBAXIDmAEOIiUKEkRlbGV0ZU5vZGVSZXNwb25zZRIPCgdtZXNzYWdlGAEgASgJMp0DChJDaGF0Ym90Tm9kZVNlcnZpY2USTAoJTGlzdE5vZGVzEh0uYWx0YWx1bmUudjEuTGlzdE5vZGVzUmVxdWVzdBoeLmFsdGFsdW5lLnYxLkxpc3ROb2Rlc1Jlc3BvbnNlIgASTwoKQ3JlYXRlTm9kZRIeLmFsdGFsdW5lLnYxLkNyZWF0ZU5vZGVSZXF1ZXN0Gh8uYWx0YWx1bmUudjEuQ3JlYXRlTm9kZVJlc3BvbnNlIgASRgoHR2V0Tm9kZRIbLmFsdGFsdW5lLnYxLkdldE5vZGVSZXF1ZXN0GhwuYWx0YWx1bmUudjEuR2V0Tm9kZVJlc3BvbnNlIgASTwoKVXBkYXRlTm9kZRIeLmFsdGFsdW5lLnYxLlVwZGF0ZU5vZGVSZXF1ZXN0Gh8uYWx0YWx1bmUudjEuVXBkYXRlTm9kZVJlc3BvbnNlIgASTwoKRGVsZXRlTm9kZRIeLmFsdGFsdW5lLnYxLkRlbGV0ZU5vZGVSZXF1ZXN0Gh8uYWx0YWx1bmUudjEuRGVsZXRlTm9kZVJlc3BvbnNlIgBCpQEKD2NvbS5hbHRhbHVuZS52MUIQQ2hhdGJvdE5vZGVQcm90b1ABWjNnaXRodWIuY29tL2hyejgvYWx0YWx1bmUvZ2VuL2FsdGFsdW5lL3YxO2FsdGFsdW5ldjGiAgNBWFiqAgtBbHRhbHVuZS5WMcoCC0FsdGFsdW5lXFYx4gIXQWx0YWx1bmVcVjFcR1BCTWV0YWRhdGHqAgxBbHRhbHVuZTo6VjFiBnByb3RvMw", [file_buf_validate_validate, file_chatbot_nodes_v1_node]);
+  fileDesc("Ch5hbHRhbHVuZS92MS9jaGF0Ym90X25vZGUucHJvdG8SC2FsdGFsdW5lLnYxIjMKEExpc3ROb2Rlc1JlcXVlc3QSHwoKcHJvamVjdF9pZBgBIAEoCUILukgIyAEBcgOYAQ4iQQoRTGlzdE5vZGVzUmVzcG9uc2USLAoFbm9kZXMYASADKAsyHS5jaGF0Ym90Lm5vZGVzLnYxLkNoYXRib3ROb2RlItUBChFDcmVhdGVOb2RlUmVxdWVzdBIfCgpwcm9qZWN0X2lkGAEgASgJQgu6SAjIAQFyA5gBDhItCgRuYW1lGAIgASgJQh+6SBzIAQFyFxACGGQyEV5bYS16XVthLXowLTlfXSokEiQKBGxhbmcYAyABKAlCFrpIE8gBAXIOUgVlbi1VU1IFaWQtSUQSDAoEdGFncxgEIAMoCRIwCgd2ZXJzaW9uGAUgASgJQhq6SBdyFRgyMhFeW2Etel1bYS16MC05X10qJEgAiAEBQgoKCF92ZXJzaW9uIkEKEkNyZWF0ZU5vZGVSZXNwb25zZRIrCgRub2RlGAEgASgLMh0uY2hhdGJvdC5ub2Rlcy52MS5DaGF0Ym90Tm9kZSJPCg5HZXROb2RlUmVxdWVzdBIfCgpwcm9qZWN0X2lkGAEgASgJQgu6SAjIAQFyA5gBDhIcCgdub2RlX2lkGAIgASgJQgu6SAjIAQFyA5gBDiI+Cg9HZXROb2RlUmVzcG9uc2USKwoEbm9kZRgBIAEoCzIdLmNoYXRib3Qubm9kZXMudjEuQ2hhdGJvdE5vZGUi5QQKEVVwZGF0ZU5vZGVSZXF1ZXN0Eh8KCnByb2plY3RfaWQYASABKAlCC7pICMgBAXIDmAEOEhwKB25vZGVfaWQYAiABKAlCC7pICMgBAXIDmAEOEi8KBG5hbWUYAyABKAlCHLpIGXIXEAIYZDIRXlthLXpdW2EtejAtOV9dKiRIAIgBARIMCgR0YWdzGAQgAygJEhQKB2VuYWJsZWQYBSABKAhIAYgBARI2Cgh0cmlnZ2VycxgGIAMoCzIkLmNoYXRib3Qubm9kZXMudjEuQ2hhdGJvdE5vZGVUcmlnZ2VyEjYKCG1lc3NhZ2VzGAcgAygLMiQuY2hhdGJvdC5ub2Rlcy52MS5DaGF0Ym90Tm9kZU1lc3NhZ2USFQoIcHJpb3JpdHkYCCABKAVIAogBARIyCgljb25kaXRpb24YCSABKAsyHy5jaGF0Ym90Lm5vZGVzLnYxLk5vZGVDb25kaXRpb24SLAoGZWZmZWN0GAogASgLMhwuY2hhdGJvdC5ub2Rlcy52MS5Ob2RlRWZmZWN0EjUKC25leHRfYWN0aW9uGAsgASgLMiAuY2hhdGJvdC5ub2Rlcy52MS5Ob2RlTmV4dEFjdGlvbhIXCg9jbGVhcl9jb25kaXRpb24YDCABKAgSFAoMY2xlYXJfZWZmZWN0GA0gASgIEhkKEWNsZWFyX25leHRfYWN0aW9uGA4gASgIEhwKD2ZvcmNlX2NvbmRpdGlvbhgPIAEoCEgDiAEBQgcKBV9uYW1lQgoKCF9lbmFibGVkQgsKCV9wcmlvcml0eUISChBfZm9yY2VfY29uZGl0aW9uIlIKElVwZGF0ZU5vZGVSZXNwb25zZRIrCgRub2RlGAEgASgLMh0uY2hhdGJvdC5ub2Rlcy52MS5DaGF0Ym90Tm9kZRIPCgdtZXNzYWdlGAIgASgJIlIKEURlbGV0ZU5vZGVSZXF1ZXN0Eh8KCnByb2plY3RfaWQYASABKAlCC7pICMgBAXIDmAEOEhwKB25vZGVfaWQYAiABKAlCC7pICMgBAXIDmAEOIiUKEkRlbGV0ZU5vZGVSZXNwb25zZRIPCgdtZXNzYWdlGAEgASgJMvEDChJDaGF0Ym90Tm9kZVNlcnZpY2USXAoJTGlzdE5vZGVzEh0uYWx0YWx1bmUudjEuTGlzdE5vZGVzUmVxdWVzdBoeLmFsdGFsdW5lLnYxLkxpc3ROb2Rlc1Jlc3BvbnNlIhCKtRgMY2hhdGJvdDpyZWFkEmAKCkNyZWF0ZU5vZGUSHi5hbHRhbHVuZS52MS5DcmVhdGVOb2RlUmVxdWVzdBofLmFsdGFsdW5lLnYxLkNyZWF0ZU5vZGVSZXNwb25zZSIRirUYDWNoYXRib3Q6d3JpdGUSVgoHR2V0Tm9kZRIbLmFsdGFsdW5lLnYxLkdldE5vZGVSZXF1ZXN0GhwuYWx0YWx1bmUudjEuR2V0Tm9kZVJlc3BvbnNlIhCKtRgMY2hhdGJvdDpyZWFkEmAKClVwZGF0ZU5vZGUSHi5hbHRhbHVuZS52MS5VcGRhdGVOb2RlUmVxdWVzdBofLmFsdGFsdW5lLnYxLlVwZGF0ZU5vZGVSZXNwb25zZSIRirUYDWNoYXRib3Q6d3JpdGUSYQoKRGVsZXRlTm9kZRIeLmFsdGFsdW5lLnYxLkRlbGV0ZU5vZGVSZXF1ZXN0Gh8uYWx0YWx1bmUudjEuRGVsZXRlTm9kZVJlc3BvbnNlIhKKtRgOY2hhdGJvdDpkZWxldGVCpQEKD2NvbS5hbHRhbHVuZS52MUIQQ2hhdGJvdE5vZGVQcm90b1ABWjNnaXRodWIuY29tL2hyejgvYWx0YWx1bmUvZ2VuL2FsdGFsdW5lL3YxO2FsdGFsdW5ldjGiAgNBWFiqAgtBbHRhbHVuZS5WMcoCC0FsdGFsdW5lXFYx4gIXQWx0YWx1bmVcVjFcR1BCTWV0YWRhdGHqAgxBbHRhbHVuZTo6VjFiBnByb3RvMw", [file_buf_validate_validate, file_chatbot_nodes_v1_node, file_altalune_v1_options]);
 
 /**
  * ListNodesRequest retrieves all nodes for sidebar display
@@ -7,13 +7,14 @@ import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2
 import type { Timestamp } from "@bufbuild/protobuf/wkt";
 import { file_google_protobuf_struct, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import { file_buf_validate_validate } from "../../buf/validate/validate_pb.js";
+import { file_altalune_v1_options } from "./options_pb.js";
 import type { JsonObject, Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file altalune/v1/chatbot.proto.
  */
 export const file_altalune_v1_chatbot: GenFile = /*@__PURE__*/
-  fileDesc("ChlhbHRhbHVuZS92MS9jaGF0Ym90LnByb3RvEgthbHRhbHVuZS52MSKsAQoNQ2hhdGJvdENvbmZpZxIKCgJpZBgBIAEoCRIvCg5tb2R1bGVzX2NvbmZpZxgCIAEoCzIXLmdvb2dsZS5wcm90b2J1Zi5TdHJ1Y3QSLgoKY3JlYXRlZF9hdBhiIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBhjIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiOgoXR2V0Q2hhdGJvdENvbmZpZ1JlcXVlc3QSHwoKcHJvamVjdF9pZBgBIAEoCUILukgIyAEBcgOYAQ4iTgoYR2V0Q2hhdGJvdENvbmZpZ1Jlc3BvbnNlEjIKDmNoYXRib3RfY29uZmlnGAEgASgLMhouYWx0YWx1bmUudjEuQ2hhdGJvdENvbmZpZyLAAQoZVXBkYXRlTW9kdWxlQ29uZmlnUmVxdWVzdBIfCgpwcm9qZWN0X2lkGAEgASgJQgu6SAjIAQFyA5gBDhJRCgttb2R1bGVfbmFtZRgCIAEoCUI8ukg5yAEBcjQQARgyUgRjb3JlUghsaXZlQ2hhdFIDbGxtUgltY3BTZXJ2ZXJSBndpZGdldFIGcHJvbXB0Ei8KBmNvbmZpZxgDIAEoCzIXLmdvb2dsZS5wcm90b2J1Zi5TdHJ1Y3RCBrpIA8gBASJhChpVcGRhdGVNb2R1bGVDb25maWdSZXNwb25zZRIyCg5jaGF0Ym90X2NvbmZpZxgBIAEoCzIaLmFsdGFsdW5lLnYxLkNoYXRib3RDb25maWcSDwoHbWVzc2FnZRgCIAEoCTLcAQoOQ2hhdGJvdFNlcnZpY2USYQoQR2V0Q2hhdGJvdENvbmZpZxIkLmFsdGFsdW5lLnYxLkdldENoYXRib3RDb25maWdSZXF1ZXN0GiUuYWx0YWx1bmUudjEuR2V0Q2hhdGJvdENvbmZpZ1Jlc3BvbnNlIgASZwoSVXBkYXRlTW9kdWxlQ29uZmlnEiYuYWx0YWx1bmUudjEuVXBkYXRlTW9kdWxlQ29uZmlnUmVxdWVzdBonLmFsdGFsdW5lLnYxLlVwZGF0ZU1vZHVsZUNvbmZpZ1Jlc3BvbnNlIgBCoQEKD2NvbS5hbHRhbHVuZS52MUIMQ2hhdGJvdFByb3RvUAFaM2dpdGh1Yi5jb20vaHJ6OC9hbHRhbHVuZS9nZW4vYWx0YWx1bmUvdjE7YWx0YWx1bmV2MaICA0FYWKoCC0FsdGFsdW5lLlYxygILQWx0YWx1bmVcVjHiAhdBbHRhbHVuZVxWMVxHUEJNZXRhZGF0YeoCDEFsdGFsdW5lOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp, file_google_protobuf_struct, file_buf_validate_validate]);
+  fileDesc("ChlhbHRhbHVuZS92MS9jaGF0Ym90LnByb3RvEgthbHRhbHVuZS52MSKsAQoNQ2hhdGJvdENvbmZpZxIKCgJpZBgBIAEoCRIvCg5tb2R1bGVzX2NvbmZpZxgCIAEoCzIXLmdvb2dsZS5wcm90b2J1Zi5TdHJ1Y3QSLgoKY3JlYXRlZF9hdBhiIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBhjIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiOgoXR2V0Q2hhdGJvdENvbmZpZ1JlcXVlc3QSHwoKcHJvamVjdF9pZBgBIAEoCUILukgIyAEBcgOYAQ4iTgoYR2V0Q2hhdGJvdENvbmZpZ1Jlc3BvbnNlEjIKDmNoYXRib3RfY29uZmlnGAEgASgLMhouYWx0YWx1bmUudjEuQ2hhdGJvdENvbmZpZyLAAQoZVXBkYXRlTW9kdWxlQ29uZmlnUmVxdWVzdBIfCgpwcm9qZWN0X2lkGAEgASgJQgu6SAjIAQFyA5gBDhJRCgttb2R1bGVfbmFtZRgCIAEoCUI8ukg5yAEBcjQQARgyUgRjb3JlUghsaXZlQ2hhdFIDbGxtUgltY3BTZXJ2ZXJSBndpZGdldFIGcHJvbXB0Ei8KBmNvbmZpZxgDIAEoCzIXLmdvb2dsZS5wcm90b2J1Zi5TdHJ1Y3RCBrpIA8gBASJhChpVcGRhdGVNb2R1bGVDb25maWdSZXNwb25zZRIyCg5jaGF0Ym90X2NvbmZpZxgBIAEoCzIaLmFsdGFsdW5lLnYxLkNoYXRib3RDb25maWcSDwoHbWVzc2FnZRgCIAEoCTL9AQoOQ2hhdGJvdFNlcnZpY2UScQoQR2V0Q2hhdGJvdENvbmZpZxIkLmFsdGFsdW5lLnYxLkdldENoYXRib3RDb25maWdSZXF1ZXN0GiUuYWx0YWx1bmUudjEuR2V0Q2hhdGJvdENvbmZpZ1Jlc3BvbnNlIhCKtRgMY2hhdGJvdDpyZWFkEngKElVwZGF0ZU1vZHVsZUNvbmZpZxImLmFsdGFsdW5lLnYxLlVwZGF0ZU1vZHVsZUNvbmZpZ1JlcXVlc3QaJy5hbHRhbHVuZS52MS5VcGRhdGVNb2R1bGVDb25maWdSZXNwb25zZSIRirUYDWNoYXRib3Q6d3JpdGVCoQEKD2NvbS5hbHRhbHVuZS52MUIMQ2hhdGJvdFByb3RvUAFaM2dpdGh1Yi5jb20vaHJ6OC9hbHRhbHVuZS9nZW4vYWx0YWx1bmUvdjE7YWx0YWx1bmV2MaICA0FYWKoCC0FsdGFsdW5lLlYxygILQWx0YWx1bmVcVjHiAhdBbHRhbHVuZVxWMVxHUEJNZXRhZGF0YeoCDEFsdGFsdW5lOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp, file_google_protobuf_struct, file_buf_validate_validate, file_altalune_v1_options]);
 
 /**
  * @generated from message altalune.v1.ChatbotConfig
@@ -4,6 +4,8 @@
 
 import type { GenEnum, GenFile, GenMessage } from "@bufbuild/protobuf/codegenv2";
 import { enumDesc, fileDesc, messageDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import { file_buf_validate_validate } from "../../buf/validate/validate_pb.js";
 import type { Message } from "@bufbuild/protobuf";
 
@@ -11,13 +13,18 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file altalune/v1/common.proto.
  */
 export const file_altalune_v1_common: GenFile = /*@__PURE__*/
-  fileDesc("ChhhbHRhbHVuZS92MS9jb21tb24ucHJvdG8SC2FsdGFsdW5lLnYxInoKC0Vycm9yRGV0YWlsEgwKBGNvZGUYASABKAkSMAoEbWV0YRgDIAMoCzIiLmFsdGFsdW5lLnYxLkVycm9yRGV0YWlsLk1ldGFFbnRyeRorCglNZXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASImCgpTdHJpbmdMaXN0EhgKBnZhbHVlcxgBIAMoCUIIukgFkgECCAEiSAoKUGFnaW5hdGlvbhIYCgRwYWdlGAEgASgFQgq6SAfIAQEaAiAAEiAKCXBhZ2Vfc2l6ZRgCIAEoBUINukgKyAEBGgUYkE4gACJRCgdTb3J0aW5nEhUKBWZpZWxkGAEgASgJQga6SAPIAQESLwoFb3JkZXIYAiABKA4yFi5hbHRhbHVuZS52MS5Tb3J0T3JkZXJCCLpIBYIBAhABIocCCgxRdWVyeVJlcXVlc3QSMwoKcGFnaW5hdGlvbhgBIAEoCzIXLmFsdGFsdW5lLnYxLlBhZ2luYXRpb25CBrpIA8gBARIZCgdrZXl3b3JkGAIgASgJQgi6SAVyAxiAAhI3CgdmaWx0ZXJzGAMgAygLMiYuYWx0YWx1bmUudjEuUXVlcnlSZXF1ZXN0LkZpbHRlcnNFbnRyeRIlCgdzb3J0aW5nGAQgASgLMhQuYWx0YWx1bmUudjEuU29ydGluZxpHCgxGaWx0ZXJzRW50cnkSCwoDa2V5GAEgASgJEiYKBXZhbHVlGAIgASgLMhcuYWx0YWx1bmUudjEuU3RyaW5nTGlzdDoCOAEilgEKDkZpbHRlcnNDYXRhbG9nEjkKB2ZpbHRlcnMYASADKAsyKC5hbHRhbHVuZS52MS5GaWx0ZXJzQ2F0YWxvZy5GaWx0ZXJzRW50cnkaSQoMRmlsdGVyc0VudHJ5EgsKA2tleRgBIAEoCRIoCgV2YWx1ZRgCIAEoCzIZLmFsdGFsdW5lLnYxLkZpbHRlclZhbHVlczoCOAEiHgoMRmlsdGVyVmFsdWVzEg4KBnZhbHVlcxgBIAMoCSLDAQoRUXVlcnlNZXRhUmVzcG9uc2USEQoJcm93X2NvdW50GAEgASgFEhIKCnBhZ2VfY291bnQYAiABKAUSPAoHZmlsdGVycxgDIAMoCzIrLmFsdGFsdW5lLnYxLlF1ZXJ5TWV0YVJlc3BvbnNlLkZpbHRlcnNFbnRyeRpJCgxGaWx0ZXJzRW50cnkSCwoDa2V5GAEgASgJEigKBXZhbHVlGAIgASgLMhkuYWx0YWx1bmUudjEuRmlsdGVyVmFsdWVzOgI4ASpQCglTb3J0T3JkZXISGgoWU09SVF9PUkRFUl9VTlNQRUNJRklFRBAAEhIKDlNPUlRfT1JERVJfQVNDEAESEwoPU09SVF9PUkRFUl9ERVNDEAJCoAEKD2NvbS5hbHRhbHVuZS52MUILQ29tbW9uUHJvdG9QAVozZ2l0aHViLmNvbS9ocno4L2FsdGFsdW5lL2dlbi9hbHRhbHVuZS92MTthbHRhbHVuZXYxogIDQVhYqgILQWx0YWx1bmUuVjHKAgtBbHRhbHVuZVxWMeICF0FsdGFsdW5lXFYxXEdQQk1ldGFkYXRh6gIMQWx0YWx1bmU6OlYxYgZwcm90bzM", [file_buf_validate_validate]);
+  fileDesc("ChhhbHRhbHVuZS92MS9jb21tb24ucHJvdG8SC2FsdGFsdW5lLnYxIrIBCgtFcnJvckRldGFpbBIMCgRjb2RlGAEgASgJEjAKBG1ldGEYAyADKAsyIi5hbHRhbHVuZS52MS5FcnJvckRldGFpbC5NZXRhRW50cnkSDgoGZG9tYWluGAQgASgJEhEKCXJldHJ5YWJsZRgFIAEoCBITCgtodHRwX3N0YXR1cxgGIAEoBRorCglNZXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASImCgpTdHJpbmdMaXN0EhgKBnZhbHVlcxgBIAMoCUIIukgFkgECCAEiSAoKUGFnaW5hdGlvbhIYCgRwYWdlGAEgASgFQgq6SAfIAQEaAiAAEiAKCXBhZ2Vfc2l6ZRgCIAEoBUINukgKyAEBGgUYkE4gACJRCgdTb3J0aW5nEhUKBWZpZWxkGAEgASgJQga6SAPIAQESLwoFb3JkZXIYAiABKA4yFi5hbHRhbHVuZS52MS5Tb3J0T3JkZXJCCLpIBYIBAhABItcCCgxRdWVyeVJlcXVlc3QSMwoKcGFnaW5hdGlvbhgBIAEoCzIXLmFsdGFsdW5lLnYxLlBhZ2luYXRpb25CBrpIA8gBARIZCgdrZXl3b3JkGAIgASgJQgi6SAVyAxiAAhI3CgdmaWx0ZXJzGAMgAygLMiYuYWx0YWx1bmUudjEuUXVlcnlSZXF1ZXN0LkZpbHRlcnNFbnRyeRIlCgdzb3J0aW5nGAQgASgLMhQuYWx0YWx1bmUudjEuU29ydGluZxI0Cgpjb3VudF9tb2RlGAUgASgOMhYuYWx0YWx1bmUudjEuQ291bnRNb2RlQgi6SAWCAQIQARIYCgZjdXJzb3IYBiABKAlCCLpIBXIDGIAgGkcKDEZpbHRlcnNFbnRyeRILCgNrZXkYASABKAkSJgoFdmFsdWUYAiABKAsyFy5hbHRhbHVuZS52MS5TdHJpbmdMaXN0OgI4ASKWAQoORmlsdGVyc0NhdGFsb2cSOQoHZmlsdGVycxgBIAMoCzIoLmFsdGFsdW5lLnYxLkZpbHRlcnNDYXRhbG9nLkZpbHRlcnNFbnRyeRpJCgxGaWx0ZXJzRW50cnkSCwoDa2V5GAEgASgJEigKBXZhbHVlGAIgASgLMhkuYWx0YWx1bmUudjEuRmlsdGVyVmFsdWVzOgI4ASIeCgxGaWx0ZXJWYWx1ZXMSDgoGdmFsdWVzGAEgAygJIrACChFRdWVyeU1ldGFSZXNwb25zZRIRCglyb3dfY291bnQYASABKAUSEgoKcGFnZV9jb3VudBgCIAEoBRI8CgdmaWx0ZXJzGAMgAygLMisuYWx0YWx1bmUudjEuUXVlcnlNZXRhUmVzcG9uc2UuRmlsdGVyc0VudHJ5EioKCmNvdW50X21vZGUYBCABKA4yFi5hbHRhbHVuZS52MS5Db3VudE1vZGUSFQoNaGFzX25leHRfcGFnZRgFIAEoCBITCgtuZXh0X2N1cnNvchgGIAEoCRITCgtleGFjdF90b3RhbBgHIAEoCBpJCgxGaWx0ZXJzRW50cnkSCwoDa2V5GAEgASgJEigKBXZhbHVlGAIgASgLMhkuYWx0YWx1bmUudjEuRmlsdGVyVmFsdWVzOgI4ASKrAQoPRGVsaXZlcmVkU2VjcmV0Ei0KBG1vZGUYASABKA4yHy5hbHRhbHVuZS52MS5TZWNyZXREZWxpdmVyeU1vZGUSFQoNcmV0cmlldmFsX3VybBgCIAEoCRI4ChRyZXRyaWV2YWxfZXhwaXJlc19hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASGAoQZW5jcnlwdGVkX3NlY3JldBgEIAEoCSpQCglTb3J0T3JkZXISGgoWU09SVF9PUkRFUl9VTlNQRUNJRklFRBAAEhIKDlNPUlRfT1JERVJfQVNDEAESEwoPU09SVF9PUkRFUl9ERVNDEAIqbAoJQ291bnRNb2RlEhoKFkNPVU5UX01PREVfVU5TUEVDSUZJRUQQABIUChBDT1VOVF9NT0RFX0VYQUNUEAESGAoUQ09VTlRfTU9ERV9FU1RJTUFURUQQAhITCg9DT1VOVF9NT0RFX05PTkUQAyqfAQoSU2VjcmV0RGVsaXZlcnlNb2RlEiQKIFNFQ1JFVF9ERUxJVkVSWV9NT0RFX1VOU1BFQ0lGSUVEEAASHwobU0VDUkVUX0RFTElWRVJZX01PREVfUkVWRUFMEAESHQoZU0VDUkVUX0RFTElWRVJZX01PREVfTElOSxACEiMKH1NFQ1JFVF9ERUxJVkVSWV9NT0RFX1BVQkxJQ19LRVkQA0KgAQoPY29tLmFsdGFsdW5lLnYxQgtDb21tb25Qcm90b1ABWjNnaXRodWIuY29tL2hyejgvYWx0YWx1bmUvZ2VuL2FsdGFsdW5lL3YxO2FsdGFsdW5ldjGiAgNBWFiqAgtBbHRhbHVuZS5WMcoCC0FsdGFsdW5lXFYx4gIXQWx0YWx1bmVcVjFcR1BCTWV0YWRhdGHqAgxBbHRhbHVuZTo6VjFiBnByb3RvMw", [file_google_protobuf_timestamp, file_buf_validate_validate]);
 
 /**
+ * ErrorDetail is attached to every application error. Clients should match on
+ * code rather than on the error message, which is meant for humans only.
+ *
  * @generated from message altalune.v1.ErrorDetail
  */
 export type ErrorDetail = Message<"altalune.v1.ErrorDetail"> & {
   /**
+   * code - stable machine-readable error code (see docs/errors.md)
+   *
    * @generated from field: string code = 1;
    */
   code: string;
@@ -26,6 +33,27 @@ export type ErrorDetail = Message<"altalune.v1.ErrorDetail"> & {
    * @generated from field: map<string, string> meta = 3;
    */
   meta: { [key: string]: string };
+
+  /**
+   * domain - the domain that produced the error (e.g., "project", "user")
+   *
+   * @generated from field: string domain = 4;
+   */
+  domain: string;
+
+  /**
+   * retryable - whether the same request may succeed when retried
+   *
+   * @generated from field: bool retryable = 5;
+   */
+  retryable: boolean;
+
+  /**
+   * http_status - HTTP status code corresponding to the error
+   *
+   * @generated from field: int32 http_status = 6;
+   */
+  httpStatus: number;
 };
 
 /**
@@ -119,6 +147,21 @@ export type QueryRequest = Message<"altalune.v1.QueryRequest"> & {
    * @generated from field: altalune.v1.Sorting sorting = 4;
    */
   sorting?: Sorting;
+
+  /**
+   * @generated from field: altalune.v1.CountMode count_mode = 5;
+   */
+  countMode: CountMode;
+
+  /**
+   * cursor - next_cursor of the previous page, to fetch the page following it
+   * instead of pagination.page; the other fields must not change. A cursor is
+   * the sort value and ID of the last row seen, so rows inserted or deleted
+   * between fetches neither repeat nor skip a row of an infinite scroll
+   *
+   * @generated from field: string cursor = 6;
+   */
+  cursor: string;
 };
 
 /**
@@ -167,6 +210,9 @@ export const FilterValuesSchema: GenMessage<FilterValues> = /*@__PURE__*/
  */
 export type QueryMetaResponse = Message<"altalune.v1.QueryMetaResponse"> & {
   /**
+   * row_count - estimated when count_mode is COUNT_MODE_ESTIMATED, and the
+   * rows up to this page when it is COUNT_MODE_NONE
+   *
    * @generated from field: int32 row_count = 1;
    */
   rowCount: number;
@@ -180,6 +226,36 @@ export type QueryMetaResponse = Message<"altalune.v1.QueryMetaResponse"> & {
    * @generated from field: map<string, altalune.v1.FilterValues> filters = 3;
    */
   filters: { [key: string]: FilterValues };
+
+  /**
+   * count_mode - how row_count was obtained
+   *
+   * @generated from field: altalune.v1.CountMode count_mode = 4;
+   */
+  countMode: CountMode;
+
+  /**
+   * has_next_page - whether a page follows this one
+   *
+   * @generated from field: bool has_next_page = 5;
+   */
+  hasNextPage: boolean;
+
+  /**
+   * next_cursor - position after the last row of this page, to pass as
+   * QueryRequest.cursor for the page following it; empty on the last page
+   *
+   * @generated from field: string next_cursor = 6;
+   */
+  nextCursor: string;
+
+  /**
+   * exact_total - whether row_count is the exact number of matching rows:
+   * always with an exact count, and with the others once the last page tells
+   *
+   * @generated from field: bool exact_total = 7;
+   */
+  exactTotal: boolean;
 };
 
 /**
@@ -189,6 +265,47 @@ export type QueryMetaResponse = Message<"altalune.v1.QueryMetaResponse"> & {
 export const QueryMetaResponseSchema: GenMessage<QueryMetaResponse> = /*@__PURE__*/
   messageDesc(file_altalune_v1_common, 7);
 
+/**
+ * DeliveredSecret - a secret generated on creation that was not returned in
+ * plaintext
+ *
+ * @generated from message altalune.v1.DeliveredSecret
+ */
+export type DeliveredSecret = Message<"altalune.v1.DeliveredSecret"> & {
+  /**
+   * @generated from field: altalune.v1.SecretDeliveryMode mode = 1;
+   */
+  mode: SecretDeliveryMode;
+
+  /**
+   * retrieval_url - path of the one-time link on the API server, for
+   * SECRET_DELIVERY_MODE_LINK. The first visit reveals the secret and burns it.
+   *
+   * @generated from field: string retrieval_url = 2;
+   */
+  retrievalUrl: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp retrieval_expires_at = 3;
+   */
+  retrievalExpiresAt?: Timestamp;
+
+  /**
+   * encrypted_secret - base64 RSA-OAEP (SHA-256) ciphertext of the secret, for
+   * SECRET_DELIVERY_MODE_PUBLIC_KEY
+   *
+   * @generated from field: string encrypted_secret = 4;
+   */
+  encryptedSecret: string;
+};
+
+/**
+ * Describes the message altalune.v1.DeliveredSecret.
+ * Use `create(DeliveredSecretSchema)` to create a new message.
+ */
+export const DeliveredSecretSchema: GenMessage<DeliveredSecret> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_common, 8);
+
 /**
  * @generated from enum altalune.v1.SortOrder
  */
@@ -215,3 +332,85 @@ export enum SortOrder {
 export const SortOrderSchema: GenEnum<SortOrder> = /*@__PURE__*/
   enumDesc(file_altalune_v1_common, 0);
 
+/**
+ * CountMode - how a query counts the rows it matches
+ *
+ * @generated from enum altalune.v1.CountMode
+ */
+export enum CountMode {
+  /**
+   * COUNT_MODE_UNSPECIFIED - the server default (database.countMode)
+   *
+   * @generated from enum value: COUNT_MODE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * COUNT_MODE_EXACT - COUNT(*) over the matching rows
+   *
+   * @generated from enum value: COUNT_MODE_EXACT = 1;
+   */
+  EXACT = 1,
+
+  /**
+   * COUNT_MODE_ESTIMATED - the planner's estimate, exact for small results
+   *
+   * @generated from enum value: COUNT_MODE_ESTIMATED = 2;
+   */
+  ESTIMATED = 2,
+
+  /**
+   * COUNT_MODE_NONE - no count, only whether another page follows
+   *
+   * @generated from enum value: COUNT_MODE_NONE = 3;
+   */
+  NONE = 3,
+}
+
+/**
+ * Describes the enum altalune.v1.CountMode.
+ */
+export const CountModeSchema: GenEnum<CountMode> = /*@__PURE__*/
+  enumDesc(file_altalune_v1_common, 1);
+
+/**
+ * SecretDeliveryMode - how a secret generated on creation, an API key or an
+ * OAuth client secret, is handed over, as the credential policy of the
+ * project requires
+ *
+ * @generated from enum altalune.v1.SecretDeliveryMode
+ */
+export enum SecretDeliveryMode {
+  /**
+   * @generated from enum value: SECRET_DELIVERY_MODE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Returned in plaintext in the response, once
+   *
+   * @generated from enum value: SECRET_DELIVERY_MODE_REVEAL = 1;
+   */
+  REVEAL = 1,
+
+  /**
+   * Retrieved once from a link expiring after 24 hours
+   *
+   * @generated from enum value: SECRET_DELIVERY_MODE_LINK = 2;
+   */
+  LINK = 2,
+
+  /**
+   * Encrypted to a public key given on creation
+   *
+   * @generated from enum value: SECRET_DELIVERY_MODE_PUBLIC_KEY = 3;
+   */
+  PUBLIC_KEY = 3,
+}
+
+/**
+ * Describes the enum altalune.v1.SecretDeliveryMode.
+ */
+export const SecretDeliveryModeSchema: GenEnum<SecretDeliveryMode> = /*@__PURE__*/
+  enumDesc(file_altalune_v1_common, 2);
+
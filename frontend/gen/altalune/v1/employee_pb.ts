@@ -4,18 +4,19 @@
 
 import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
-import type { Timestamp } from "@bufbuild/protobuf/wkt";
-import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { FieldMask, Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_field_mask, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import { file_buf_validate_validate } from "../../buf/validate/validate_pb.js";
 import type { QueryMetaResponse, QueryRequest } from "./common_pb.js";
 import { file_altalune_v1_common } from "./common_pb.js";
+import { file_altalune_v1_options } from "./options_pb.js";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file altalune/v1/employee.proto.
  */
 export const file_altalune_v1_employee: GenFile = /*@__PURE__*/
-  fileDesc("ChphbHRhbHVuZS92MS9lbXBsb3llZS5wcm90bxILYWx0YWx1bmUudjEi4gEKCEVtcGxveWVlEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSDQoFZW1haWwYAyABKAkSDAoEcm9sZRgEIAEoCRISCgpkZXBhcnRtZW50GAUgASgJEisKBnN0YXR1cxgGIAEoDjIbLmFsdGFsdW5lLnYxLkVtcGxveWVlU3RhdHVzEi4KCmNyZWF0ZWRfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIvcBChVDcmVhdGVFbXBsb3llZVJlcXVlc3QSHwoKcHJvamVjdF9pZBgBIAEoCUILukgIyAEBcgOYAQ4SKQoEbmFtZRgCIAEoCUIbukgYyAEBchMQAhgyMg1eW2EtekEtWlxzXSskEhsKBWVtYWlsGAMgASgJQgy6SAnIAQFyBBhkYAESGgoEcm9sZRgEIAEoCUIMukgJyAEBcgQQAhhkEiAKCmRlcGFydG1lbnQYBSABKAlCDLpICcgBAXIEEAIYZBI3CgZzdGF0dXMYBiABKA4yGy5hbHRhbHVuZS52MS5FbXBsb3llZVN0YXR1c0IKukgHggEEEAEgACJSChZDcmVhdGVFbXBsb3llZVJlc3BvbnNlEicKCGVtcGxveWVlGAEgASgLMhUuYWx0YWx1bmUudjEuRW1wbG95ZWUSDwoHbWVzc2FnZRgCIAEoCSJiChVRdWVyeUVtcGxveWVlc1JlcXVlc3QSHwoKcHJvamVjdF9pZBgBIAEoCUILukgIyAEBcgOYAQ4SKAoFcXVlcnkYAiABKAsyGS5hbHRhbHVuZS52MS5RdWVyeVJlcXVlc3QiawoWUXVlcnlFbXBsb3llZXNSZXNwb25zZRIjCgRkYXRhGAEgAygLMhUuYWx0YWx1bmUudjEuRW1wbG95ZWUSLAoEbWV0YRgCIAEoCzIeLmFsdGFsdW5lLnYxLlF1ZXJ5TWV0YVJlc3BvbnNlIlgKEkdldEVtcGxveWVlUmVxdWVzdBIfCgpwcm9qZWN0X2lkGAEgASgJQgu6SAjIAQFyA5gBDhIhCgtlbXBsb3llZV9pZBgCIAEoCUIMukgJyAEBcgQQDhgOIj4KE0dldEVtcGxveWVlUmVzcG9uc2USJwoIZW1wbG95ZWUYASABKAsyFS5hbHRhbHVuZS52MS5FbXBsb3llZSKaAgoVVXBkYXRlRW1wbG95ZWVSZXF1ZXN0Eh8KCnByb2plY3RfaWQYASABKAlCC7pICMgBAXIDmAEOEiEKC2VtcGxveWVlX2lkGAIgASgJQgy6SAnIAQFyBBAOGA4SKQoEbmFtZRgDIAEoCUIbukgYyAEBchMQAhgyMg1eW2EtekEtWlxzXSskEhsKBWVtYWlsGAQgASgJQgy6SAnIAQFyBBhkYAESGgoEcm9sZRgFIAEoCUIMukgJyAEBcgQQAhhkEiAKCmRlcGFydG1lbnQYBiABKAlCDLpICcgBAXIEEAIYZBI3CgZzdGF0dXMYByABKA4yGy5hbHRhbHVuZS52MS5FbXBsb3llZVN0YXR1c0IKukgHggEEEAEgACJSChZVcGRhdGVFbXBsb3llZVJlc3BvbnNlEicKCGVtcGxveWVlGAEgASgLMhUuYWx0YWx1bmUudjEuRW1wbG95ZWUSDwoHbWVzc2FnZRgCIAEoCSJbChVEZWxldGVFbXBsb3llZVJlcXVlc3QSHwoKcHJvamVjdF9pZBgBIAEoCUILukgIyAEBcgOYAQ4SIQoLZW1wbG95ZWVfaWQYAiABKAlCDLpICcgBAXIEEA4YDiIpChZEZWxldGVFbXBsb3llZVJlc3BvbnNlEg8KB21lc3NhZ2UYASABKAkqawoORW1wbG95ZWVTdGF0dXMSHwobRU1QTE9ZRUVfU1RBVFVTX1VOU1BFQ0lGSUVEEAASGgoWRU1QTE9ZRUVfU1RBVFVTX0FDVElWRRABEhwKGEVNUExPWUVFX1NUQVRVU19JTkFDVElWRRACMtkDCg9FbXBsb3llZVNlcnZpY2USWwoOUXVlcnlFbXBsb3llZXMSIi5hbHRhbHVuZS52MS5RdWVyeUVtcGxveWVlc1JlcXVlc3QaIy5hbHRhbHVuZS52MS5RdWVyeUVtcGxveWVlc1Jlc3BvbnNlIgASWwoOQ3JlYXRlRW1wbG95ZWUSIi5hbHRhbHVuZS52MS5DcmVhdGVFbXBsb3llZVJlcXVlc3QaIy5hbHRhbHVuZS52MS5DcmVhdGVFbXBsb3llZVJlc3BvbnNlIgASUgoLR2V0RW1wbG95ZWUSHy5hbHRhbHVuZS52MS5HZXRFbXBsb3llZVJlcXVlc3QaIC5hbHRhbHVuZS52MS5HZXRFbXBsb3llZVJlc3BvbnNlIgASWwoOVXBkYXRlRW1wbG95ZWUSIi5hbHRhbHVuZS52MS5VcGRhdGVFbXBsb3llZVJlcXVlc3QaIy5hbHRhbHVuZS52MS5VcGRhdGVFbXBsb3llZVJlc3BvbnNlIgASWwoORGVsZXRlRW1wbG95ZWUSIi5hbHRhbHVuZS52MS5EZWxldGVFbXBsb3llZVJlcXVlc3QaIy5hbHRhbHVuZS52MS5EZWxldGVFbXBsb3llZVJlc3BvbnNlIgBCogEKD2NvbS5hbHRhbHVuZS52MUINRW1wbG95ZWVQcm90b1ABWjNnaXRodWIuY29tL2hyejgvYWx0YWx1bmUvZ2VuL2FsdGFsdW5lL3YxO2FsdGFsdW5ldjGiAgNBWFiqAgtBbHRhbHVuZS5WMcoCC0FsdGFsdW5lXFYx4gIXQWx0YWx1bmVcVjFcR1BCTWV0YWRhdGHqAgxBbHRhbHVuZTo6VjFiBnByb3RvMw", [file_google_protobuf_timestamp, file_buf_validate_validate, file_altalune_v1_common]);
+  fileDesc("ChphbHRhbHVuZS92MS9lbXBsb3llZS5wcm90bxILYWx0YWx1bmUudjEiqQIKCEVtcGxveWVlEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSJAoFZW1haWwYAyABKAlCFZK1GBFlbXBsb3llZTpyZWFkX3BpaRIMCgRyb2xlGAQgASgJEhIKCmRlcGFydG1lbnQYBSABKAkSKwoGc3RhdHVzGAYgASgOMhsuYWx0YWx1bmUudjEuRW1wbG95ZWVTdGF0dXMSLgoKY3JlYXRlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKZGVsZXRlZF9hdBgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAi9wEKFUNyZWF0ZUVtcGxveWVlUmVxdWVzdBIfCgpwcm9qZWN0X2lkGAEgASgJQgu6SAjIAQFyA5gBDhIpCgRuYW1lGAIgASgJQhu6SBjIAQFyExACGDIyDV5bYS16QS1aXHNdKyQSGwoFZW1haWwYAyABKAlCDLpICcgBAXIEGGRgARIaCgRyb2xlGAQgASgJQgy6SAnIAQFyBBACGGQSIAoKZGVwYXJ0bWVudBgFIAEoCUIMukgJyAEBcgQQAhhkEjcKBnN0YXR1cxgGIAEoDjIbLmFsdGFsdW5lLnYxLkVtcGxveWVlU3RhdHVzQgq6SAeCAQQQASAAIlIKFkNyZWF0ZUVtcGxveWVlUmVzcG9uc2USJwoIZW1wbG95ZWUYASABKAsyFS5hbHRhbHVuZS52MS5FbXBsb3llZRIPCgdtZXNzYWdlGAIgASgJIqIBChVRdWVyeUVtcGxveWVlc1JlcXVlc3QSHwoKcHJvamVjdF9pZBgBIAEoCUILukgIyAEBcgOYAQ4SKAoFcXVlcnkYAiABKAsyGS5hbHRhbHVuZS52MS5RdWVyeVJlcXVlc3QSDwoHdHJhc2hlZBgDIAEoCBItCglyZWFkX21hc2sYBCABKAsyGi5nb29nbGUucHJvdG9idWYuRmllbGRNYXNrImsKFlF1ZXJ5RW1wbG95ZWVzUmVzcG9uc2USIwoEZGF0YRgBIAMoCzIVLmFsdGFsdW5lLnYxLkVtcGxveWVlEiwKBG1ldGEYAiABKAsyHi5hbHRhbHVuZS52MS5RdWVyeU1ldGFSZXNwb25zZSKaAQoWU3RyZWFtRW1wbG95ZWVzUmVxdWVzdBIfCgpwcm9qZWN0X2lkGAEgASgJQgu6SAjIAQFyA5gBDhIwCgVxdWVyeRgCIAEoCzIZLmFsdGFsdW5lLnYxLlF1ZXJ5UmVxdWVzdEIGukgDyAEBEi0KCXJlYWRfbWFzaxgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2sibAoXU3RyZWFtRW1wbG95ZWVzUmVzcG9uc2USIwoEZGF0YRgBIAMoCzIVLmFsdGFsdW5lLnYxLkVtcGxveWVlEiwKBG1ldGEYAiABKAsyHi5hbHRhbHVuZS52MS5RdWVyeU1ldGFSZXNwb25zZSJYChJHZXRFbXBsb3llZVJlcXVlc3QSHwoKcHJvamVjdF9pZBgBIAEoCUILukgIyAEBcgOYAQ4SIQoLZW1wbG95ZWVfaWQYAiABKAlCDLpICcgBAXIEEA4YDiI+ChNHZXRFbXBsb3llZVJlc3BvbnNlEicKCGVtcGxveWVlGAEgASgLMhUuYWx0YWx1bmUudjEuRW1wbG95ZWUi0wIKFVVwZGF0ZUVtcGxveWVlUmVxdWVzdBIfCgpwcm9qZWN0X2lkGAEgASgJQgu6SAjIAQFyA5gBDhIhCgtlbXBsb3llZV9pZBgCIAEoCUIMukgJyAEBcgQQDhgOEikKBG5hbWUYAyABKAlCG7pIGMgBAXITEAIYMjINXlthLXpBLVpcc10rJBIbCgVlbWFpbBgEIAEoCUIMukgJ2AEBcgQYZGABEhoKBHJvbGUYBSABKAlCDLpICcgBAXIEEAIYZBIgCgpkZXBhcnRtZW50GAYgASgJQgy6SAnIAQFyBBACGGQSNwoGc3RhdHVzGAcgASgOMhsuYWx0YWx1bmUudjEuRW1wbG95ZWVTdGF0dXNCCrpIB4IBBBABIAASNwoTZXhwZWN0ZWRfdXBkYXRlZF9hdBgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiUgoWVXBkYXRlRW1wbG95ZWVSZXNwb25zZRInCghlbXBsb3llZRgBIAEoCzIVLmFsdGFsdW5lLnYxLkVtcGxveWVlEg8KB21lc3NhZ2UYAiABKAkiWwoVRGVsZXRlRW1wbG95ZWVSZXF1ZXN0Eh8KCnByb2plY3RfaWQYASABKAlCC7pICMgBAXIDmAEOEiEKC2VtcGxveWVlX2lkGAIgASgJQgy6SAnIAQFyBBAOGA4iKQoWRGVsZXRlRW1wbG95ZWVSZXNwb25zZRIPCgdtZXNzYWdlGAEgASgJIlwKFlJlc3RvcmVFbXBsb3llZVJlcXVlc3QSHwoKcHJvamVjdF9pZBgBIAEoCUILukgIyAEBcgOYAQ4SIQoLZW1wbG95ZWVfaWQYAiABKAlCDLpICcgBAXIEEA4YDiJTChdSZXN0b3JlRW1wbG95ZWVSZXNwb25zZRInCghlbXBsb3llZRgBIAEoCzIVLmFsdGFsdW5lLnYxLkVtcGxveWVlEg8KB21lc3NhZ2UYAiABKAkigAEKFkltcG9ydEVtcGxveWVlc1JlcXVlc3QSOAoIbWV0YWRhdGEYASABKAsyJC5hbHRhbHVuZS52MS5JbXBvcnRFbXBsb3llZXNNZXRhZGF0YUgAEhoKBWNodW5rGAIgASgMQgm6SAZ6BBiAgEBIAEIQCgdwYXlsb2FkEgW6SAIIASJLChdJbXBvcnRFbXBsb3llZXNNZXRhZGF0YRIfCgpwcm9qZWN0X2lkGAEgASgJQgu6SAjIAQFyA5gBDhIPCgdkcnlfcnVuGAIgASgIIkcKF0ltcG9ydEVtcGxveWVlc1Jvd0Vycm9yEgwKBGxpbmUYASABKAUSDQoFZmllbGQYAiABKAkSDwoHbWVzc2FnZRgDIAEoCSKyAQoXSW1wb3J0RW1wbG95ZWVzUmVzcG9uc2USEgoKdG90YWxfcm93cxgBIAEoBRIVCg1pbXBvcnRlZF9yb3dzGAIgASgFEhQKDGludmFsaWRfcm93cxgDIAEoBRI0CgZlcnJvcnMYBCADKAsyJC5hbHRhbHVuZS52MS5JbXBvcnRFbXBsb3llZXNSb3dFcnJvchIPCgdkcnlfcnVuGAUgASgIEg8KB21lc3NhZ2UYBiABKAkieAoWRXhwb3J0RW1wbG95ZWVzUmVxdWVzdBIfCgpwcm9qZWN0X2lkGAEgASgJQgu6SAjIAQFyA5gBDhI9CgZmb3JtYXQYAiABKA4yIS5hbHRhbHVuZS52MS5FbXBsb3llZUV4cG9ydEZvcm1hdEIKukgHggEEEAEgACJQChdFeHBvcnRFbXBsb3llZXNSZXNwb25zZRIQCghmaWxlbmFtZRgBIAEoCRIUCgxjb250ZW50X3R5cGUYAiABKAkSDQoFY2h1bmsYAyABKAwqawoORW1wbG95ZWVTdGF0dXMSHwobRU1QTE9ZRUVfU1RBVFVTX1VOU1BFQ0lGSUVEEAASGgoWRU1QTE9ZRUVfU1RBVFVTX0FDVElWRRABEhwKGEVNUExPWUVFX1NUQVRVU19JTkFDVElWRRACKn8KFEVtcGxveWVlRXhwb3J0Rm9ybWF0EiYKIkVNUExPWUVFX0VYUE9SVF9GT1JNQVRfVU5TUEVDSUZJRUQQABIeChpFTVBMT1lFRV9FWFBPUlRfRk9STUFUX0NTVhABEh8KG0VNUExPWUVFX0VYUE9SVF9GT1JNQVRfWExTWBACMv8HCg9FbXBsb3llZVNlcnZpY2USbAoOUXVlcnlFbXBsb3llZXMSIi5hbHRhbHVuZS52MS5RdWVyeUVtcGxveWVlc1JlcXVlc3QaIy5hbHRhbHVuZS52MS5RdWVyeUVtcGxveWVlc1Jlc3BvbnNlIhGKtRgNZW1wbG95ZWU6cmVhZBJxCg9TdHJlYW1FbXBsb3llZXMSIy5hbHRhbHVuZS52MS5TdHJlYW1FbXBsb3llZXNSZXF1ZXN0GiQuYWx0YWx1bmUudjEuU3RyZWFtRW1wbG95ZWVzUmVzcG9uc2UiEYq1GA1lbXBsb3llZTpyZWFkMAESbQoOQ3JlYXRlRW1wbG95ZWUSIi5hbHRhbHVuZS52MS5DcmVhdGVFbXBsb3llZVJlcXVlc3QaIy5hbHRhbHVuZS52MS5DcmVhdGVFbXBsb3llZVJlc3BvbnNlIhKKtRgOZW1wbG95ZWU6d3JpdGUSYwoLR2V0RW1wbG95ZWUSHy5hbHRhbHVuZS52MS5HZXRFbXBsb3llZVJlcXVlc3QaIC5hbHRhbHVuZS52MS5HZXRFbXBsb3llZVJlc3BvbnNlIhGKtRgNZW1wbG95ZWU6cmVhZBJtCg5VcGRhdGVFbXBsb3llZRIiLmFsdGFsdW5lLnYxLlVwZGF0ZUVtcGxveWVlUmVxdWVzdBojLmFsdGFsdW5lLnYxLlVwZGF0ZUVtcGxveWVlUmVzcG9uc2UiEoq1GA5lbXBsb3llZTp3cml0ZRJuCg5EZWxldGVFbXBsb3llZRIiLmFsdGFsdW5lLnYxLkRlbGV0ZUVtcGxveWVlUmVxdWVzdBojLmFsdGFsdW5lLnYxLkRlbGV0ZUVtcGxveWVlUmVzcG9uc2UiE4q1GA9lbXBsb3llZTpkZWxldGUScQoPUmVzdG9yZUVtcGxveWVlEiMuYWx0YWx1bmUudjEuUmVzdG9yZUVtcGxveWVlUmVxdWVzdBokLmFsdGFsdW5lLnYxLlJlc3RvcmVFbXBsb3llZVJlc3BvbnNlIhOKtRgPZW1wbG95ZWU6ZGVsZXRlEnIKD0ltcG9ydEVtcGxveWVlcxIjLmFsdGFsdW5lLnYxLkltcG9ydEVtcGxveWVlc1JlcXVlc3QaJC5hbHRhbHVuZS52MS5JbXBvcnRFbXBsb3llZXNSZXNwb25zZSISirUYDmVtcGxveWVlOndyaXRlKAEScQoPRXhwb3J0RW1wbG95ZWVzEiMuYWx0YWx1bmUudjEuRXhwb3J0RW1wbG95ZWVzUmVxdWVzdBokLmFsdGFsdW5lLnYxLkV4cG9ydEVtcGxveWVlc1Jlc3BvbnNlIhGKtRgNZW1wbG95ZWU6cmVhZDABQqIBCg9jb20uYWx0YWx1bmUudjFCDUVtcGxveWVlUHJvdG9QAVozZ2l0aHViLmNvbS9ocno4L2FsdGFsdW5lL2dlbi9hbHRhbHVuZS92MTthbHRhbHVuZXYxogIDQVhYqgILQWx0YWx1bmUuVjHKAgtBbHRhbHVuZVxWMeICF0FsdGFsdW5lXFYxXEdQQk1ldGFkYXRh6gIMQWx0YWx1bmU6OlYxYgZwcm90bzM", [file_google_protobuf_timestamp, file_google_protobuf_field_mask, file_buf_validate_validate, file_altalune_v1_common, file_altalune_v1_options]);
 
 /**
  * @generated from message altalune.v1.Employee
@@ -32,6 +33,8 @@ export type Employee = Message<"altalune.v1.Employee"> & {
   name: string;
 
   /**
+   * PII
+   *
    * @generated from field: string email = 3;
    */
   email: string;
@@ -60,6 +63,13 @@ export type Employee = Message<"altalune.v1.Employee"> & {
    * @generated from field: google.protobuf.Timestamp updated_at = 8;
    */
   updatedAt?: Timestamp;
+
+  /**
+   * Set only for employees in the trash
+   *
+   * @generated from field: google.protobuf.Timestamp deleted_at = 9;
+   */
+  deletedAt?: Timestamp;
 };
 
 /**
@@ -146,6 +156,20 @@ export type QueryEmployeesRequest = Message<"altalune.v1.QueryEmployeesRequest">
    * @generated from field: altalune.v1.QueryRequest query = 2;
    */
   query?: QueryRequest;
+
+  /**
+   * List deleted employees that can still be restored instead of live ones.
+   *
+   * @generated from field: bool trashed = 3;
+   */
+  trashed: boolean;
+
+  /**
+   * Employee fields to return, all when empty. Only top-level fields.
+   *
+   * @generated from field: google.protobuf.FieldMask read_mask = 4;
+   */
+  readMask?: FieldMask;
 };
 
 /**
@@ -177,6 +201,62 @@ export type QueryEmployeesResponse = Message<"altalune.v1.QueryEmployeesResponse
 export const QueryEmployeesResponseSchema: GenMessage<QueryEmployeesResponse> = /*@__PURE__*/
   messageDesc(file_altalune_v1_employee, 4);
 
+/**
+ * Streams every employee matching the query instead of a single page. Each
+ * message carries up to pagination.page_size rows, starting after the row of
+ * the query cursor if any, at pagination.page otherwise; meta is only set on
+ * the first message. Employees come in the order of the sorting of the query.
+ *
+ * @generated from message altalune.v1.StreamEmployeesRequest
+ */
+export type StreamEmployeesRequest = Message<"altalune.v1.StreamEmployeesRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: altalune.v1.QueryRequest query = 2;
+   */
+  query?: QueryRequest;
+
+  /**
+   * Employee fields to return, all when empty. Only top-level fields.
+   *
+   * @generated from field: google.protobuf.FieldMask read_mask = 3;
+   */
+  readMask?: FieldMask;
+};
+
+/**
+ * Describes the message altalune.v1.StreamEmployeesRequest.
+ * Use `create(StreamEmployeesRequestSchema)` to create a new message.
+ */
+export const StreamEmployeesRequestSchema: GenMessage<StreamEmployeesRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_employee, 5);
+
+/**
+ * @generated from message altalune.v1.StreamEmployeesResponse
+ */
+export type StreamEmployeesResponse = Message<"altalune.v1.StreamEmployeesResponse"> & {
+  /**
+   * @generated from field: repeated altalune.v1.Employee data = 1;
+   */
+  data: Employee[];
+
+  /**
+   * @generated from field: altalune.v1.QueryMetaResponse meta = 2;
+   */
+  meta?: QueryMetaResponse;
+};
+
+/**
+ * Describes the message altalune.v1.StreamEmployeesResponse.
+ * Use `create(StreamEmployeesResponseSchema)` to create a new message.
+ */
+export const StreamEmployeesResponseSchema: GenMessage<StreamEmployeesResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_employee, 6);
+
 /**
  * @generated from message altalune.v1.GetEmployeeRequest
  */
@@ -197,7 +277,7 @@ export type GetEmployeeRequest = Message<"altalune.v1.GetEmployeeRequest"> & {
  * Use `create(GetEmployeeRequestSchema)` to create a new message.
  */
 export const GetEmployeeRequestSchema: GenMessage<GetEmployeeRequest> = /*@__PURE__*/
-  messageDesc(file_altalune_v1_employee, 5);
+  messageDesc(file_altalune_v1_employee, 7);
 
 /**
  * @generated from message altalune.v1.GetEmployeeResponse
@@ -214,7 +294,7 @@ export type GetEmployeeResponse = Message<"altalune.v1.GetEmployeeResponse"> & {
  * Use `create(GetEmployeeResponseSchema)` to create a new message.
  */
 export const GetEmployeeResponseSchema: GenMessage<GetEmployeeResponse> = /*@__PURE__*/
-  messageDesc(file_altalune_v1_employee, 6);
+  messageDesc(file_altalune_v1_employee, 8);
 
 /**
  * @generated from message altalune.v1.UpdateEmployeeRequest
@@ -236,6 +316,8 @@ export type UpdateEmployeeRequest = Message<"altalune.v1.UpdateEmployeeRequest">
   name: string;
 
   /**
+   * Empty keeps the current email, for callers who may not see it
+   *
    * @generated from field: string email = 4;
    */
   email: string;
@@ -254,6 +336,14 @@ export type UpdateEmployeeRequest = Message<"altalune.v1.UpdateEmployeeRequest">
    * @generated from field: altalune.v1.EmployeeStatus status = 7;
    */
   status: EmployeeStatus;
+
+  /**
+   * updated_at of the employee the edit is based on. When set, the update is
+   * rejected with FAILED_PRECONDITION if the employee changed since then.
+   *
+   * @generated from field: google.protobuf.Timestamp expected_updated_at = 8;
+   */
+  expectedUpdatedAt?: Timestamp;
 };
 
 /**
@@ -261,7 +351,7 @@ export type UpdateEmployeeRequest = Message<"altalune.v1.UpdateEmployeeRequest">
  * Use `create(UpdateEmployeeRequestSchema)` to create a new message.
  */
 export const UpdateEmployeeRequestSchema: GenMessage<UpdateEmployeeRequest> = /*@__PURE__*/
-  messageDesc(file_altalune_v1_employee, 7);
+  messageDesc(file_altalune_v1_employee, 9);
 
 /**
  * @generated from message altalune.v1.UpdateEmployeeResponse
@@ -283,7 +373,7 @@ export type UpdateEmployeeResponse = Message<"altalune.v1.UpdateEmployeeResponse
  * Use `create(UpdateEmployeeResponseSchema)` to create a new message.
  */
 export const UpdateEmployeeResponseSchema: GenMessage<UpdateEmployeeResponse> = /*@__PURE__*/
-  messageDesc(file_altalune_v1_employee, 8);
+  messageDesc(file_altalune_v1_employee, 10);
 
 /**
  * @generated from message altalune.v1.DeleteEmployeeRequest
@@ -305,7 +395,7 @@ export type DeleteEmployeeRequest = Message<"altalune.v1.DeleteEmployeeRequest">
  * Use `create(DeleteEmployeeRequestSchema)` to create a new message.
  */
 export const DeleteEmployeeRequestSchema: GenMessage<DeleteEmployeeRequest> = /*@__PURE__*/
-  messageDesc(file_altalune_v1_employee, 9);
+  messageDesc(file_altalune_v1_employee, 11);
 
 /**
  * @generated from message altalune.v1.DeleteEmployeeResponse
@@ -322,7 +412,240 @@ export type DeleteEmployeeResponse = Message<"altalune.v1.DeleteEmployeeResponse
  * Use `create(DeleteEmployeeResponseSchema)` to create a new message.
  */
 export const DeleteEmployeeResponseSchema: GenMessage<DeleteEmployeeResponse> = /*@__PURE__*/
-  messageDesc(file_altalune_v1_employee, 10);
+  messageDesc(file_altalune_v1_employee, 12);
+
+/**
+ * @generated from message altalune.v1.RestoreEmployeeRequest
+ */
+export type RestoreEmployeeRequest = Message<"altalune.v1.RestoreEmployeeRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: string employee_id = 2;
+   */
+  employeeId: string;
+};
+
+/**
+ * Describes the message altalune.v1.RestoreEmployeeRequest.
+ * Use `create(RestoreEmployeeRequestSchema)` to create a new message.
+ */
+export const RestoreEmployeeRequestSchema: GenMessage<RestoreEmployeeRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_employee, 13);
+
+/**
+ * @generated from message altalune.v1.RestoreEmployeeResponse
+ */
+export type RestoreEmployeeResponse = Message<"altalune.v1.RestoreEmployeeResponse"> & {
+  /**
+   * @generated from field: altalune.v1.Employee employee = 1;
+   */
+  employee?: Employee;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.RestoreEmployeeResponse.
+ * Use `create(RestoreEmployeeResponseSchema)` to create a new message.
+ */
+export const RestoreEmployeeResponseSchema: GenMessage<RestoreEmployeeResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_employee, 14);
+
+/**
+ * Bulk import: the first message carries the metadata, every following
+ * message a chunk of the CSV file. The CSV needs a header row with the
+ * name, email, role and department columns; status is optional (active or
+ * inactive, default active) and unknown columns are ignored.
+ *
+ * @generated from message altalune.v1.ImportEmployeesRequest
+ */
+export type ImportEmployeesRequest = Message<"altalune.v1.ImportEmployeesRequest"> & {
+  /**
+   * @generated from oneof altalune.v1.ImportEmployeesRequest.payload
+   */
+  payload: {
+    /**
+     * @generated from field: altalune.v1.ImportEmployeesMetadata metadata = 1;
+     */
+    value: ImportEmployeesMetadata;
+    case: "metadata";
+  } | {
+    /**
+     * 1 MiB
+     *
+     * @generated from field: bytes chunk = 2;
+     */
+    value: Uint8Array;
+    case: "chunk";
+  } | { case: undefined; value?: undefined };
+};
+
+/**
+ * Describes the message altalune.v1.ImportEmployeesRequest.
+ * Use `create(ImportEmployeesRequestSchema)` to create a new message.
+ */
+export const ImportEmployeesRequestSchema: GenMessage<ImportEmployeesRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_employee, 15);
+
+/**
+ * @generated from message altalune.v1.ImportEmployeesMetadata
+ */
+export type ImportEmployeesMetadata = Message<"altalune.v1.ImportEmployeesMetadata"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * Validate the file without importing anything.
+   *
+   * @generated from field: bool dry_run = 2;
+   */
+  dryRun: boolean;
+};
+
+/**
+ * Describes the message altalune.v1.ImportEmployeesMetadata.
+ * Use `create(ImportEmployeesMetadataSchema)` to create a new message.
+ */
+export const ImportEmployeesMetadataSchema: GenMessage<ImportEmployeesMetadata> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_employee, 16);
+
+/**
+ * @generated from message altalune.v1.ImportEmployeesRowError
+ */
+export type ImportEmployeesRowError = Message<"altalune.v1.ImportEmployeesRowError"> & {
+  /**
+   * 1-based line in the CSV file, the header is line 1
+   *
+   * @generated from field: int32 line = 1;
+   */
+  line: number;
+
+  /**
+   * Column name, empty for row-level errors
+   *
+   * @generated from field: string field = 2;
+   */
+  field: string;
+
+  /**
+   * @generated from field: string message = 3;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.ImportEmployeesRowError.
+ * Use `create(ImportEmployeesRowErrorSchema)` to create a new message.
+ */
+export const ImportEmployeesRowErrorSchema: GenMessage<ImportEmployeesRowError> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_employee, 17);
+
+/**
+ * @generated from message altalune.v1.ImportEmployeesResponse
+ */
+export type ImportEmployeesResponse = Message<"altalune.v1.ImportEmployeesResponse"> & {
+  /**
+   * @generated from field: int32 total_rows = 1;
+   */
+  totalRows: number;
+
+  /**
+   * 0 when any row is invalid; imports are all-or-nothing
+   *
+   * @generated from field: int32 imported_rows = 2;
+   */
+  importedRows: number;
+
+  /**
+   * @generated from field: int32 invalid_rows = 3;
+   */
+  invalidRows: number;
+
+  /**
+   * Capped at the first 100 errors
+   *
+   * @generated from field: repeated altalune.v1.ImportEmployeesRowError errors = 4;
+   */
+  errors: ImportEmployeesRowError[];
+
+  /**
+   * @generated from field: bool dry_run = 5;
+   */
+  dryRun: boolean;
+
+  /**
+   * @generated from field: string message = 6;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.ImportEmployeesResponse.
+ * Use `create(ImportEmployeesResponseSchema)` to create a new message.
+ */
+export const ImportEmployeesResponseSchema: GenMessage<ImportEmployeesResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_employee, 18);
+
+/**
+ * @generated from message altalune.v1.ExportEmployeesRequest
+ */
+export type ExportEmployeesRequest = Message<"altalune.v1.ExportEmployeesRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: altalune.v1.EmployeeExportFormat format = 2;
+   */
+  format: EmployeeExportFormat;
+};
+
+/**
+ * Describes the message altalune.v1.ExportEmployeesRequest.
+ * Use `create(ExportEmployeesRequestSchema)` to create a new message.
+ */
+export const ExportEmployeesRequestSchema: GenMessage<ExportEmployeesRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_employee, 19);
+
+/**
+ * Bulk export: the file is streamed in chunks. The first message also
+ * carries the suggested filename and the content type.
+ *
+ * @generated from message altalune.v1.ExportEmployeesResponse
+ */
+export type ExportEmployeesResponse = Message<"altalune.v1.ExportEmployeesResponse"> & {
+  /**
+   * @generated from field: string filename = 1;
+   */
+  filename: string;
+
+  /**
+   * @generated from field: string content_type = 2;
+   */
+  contentType: string;
+
+  /**
+   * @generated from field: bytes chunk = 3;
+   */
+  chunk: Uint8Array;
+};
+
+/**
+ * Describes the message altalune.v1.ExportEmployeesResponse.
+ * Use `create(ExportEmployeesResponseSchema)` to create a new message.
+ */
+export const ExportEmployeesResponseSchema: GenMessage<ExportEmployeesResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_employee, 20);
 
 /**
  * @generated from enum altalune.v1.EmployeeStatus
@@ -350,6 +673,32 @@ export enum EmployeeStatus {
 export const EmployeeStatusSchema: GenEnum<EmployeeStatus> = /*@__PURE__*/
   enumDesc(file_altalune_v1_employee, 0);
 
+/**
+ * @generated from enum altalune.v1.EmployeeExportFormat
+ */
+export enum EmployeeExportFormat {
+  /**
+   * @generated from enum value: EMPLOYEE_EXPORT_FORMAT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: EMPLOYEE_EXPORT_FORMAT_CSV = 1;
+   */
+  CSV = 1,
+
+  /**
+   * @generated from enum value: EMPLOYEE_EXPORT_FORMAT_XLSX = 2;
+   */
+  XLSX = 2,
+}
+
+/**
+ * Describes the enum altalune.v1.EmployeeExportFormat.
+ */
+export const EmployeeExportFormatSchema: GenEnum<EmployeeExportFormat> = /*@__PURE__*/
+  enumDesc(file_altalune_v1_employee, 1);
+
 /**
  * @generated from service altalune.v1.EmployeeService
  */
@@ -362,6 +711,14 @@ export const EmployeeService: GenService<{
     input: typeof QueryEmployeesRequestSchema;
     output: typeof QueryEmployeesResponseSchema;
   },
+  /**
+   * @generated from rpc altalune.v1.EmployeeService.StreamEmployees
+   */
+  streamEmployees: {
+    methodKind: "server_streaming";
+    input: typeof StreamEmployeesRequestSchema;
+    output: typeof StreamEmployeesResponseSchema;
+  },
   /**
    * @generated from rpc altalune.v1.EmployeeService.CreateEmployee
    */
@@ -394,6 +751,30 @@ export const EmployeeService: GenService<{
     input: typeof DeleteEmployeeRequestSchema;
     output: typeof DeleteEmployeeResponseSchema;
   },
+  /**
+   * @generated from rpc altalune.v1.EmployeeService.RestoreEmployee
+   */
+  restoreEmployee: {
+    methodKind: "unary";
+    input: typeof RestoreEmployeeRequestSchema;
+    output: typeof RestoreEmployeeResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.EmployeeService.ImportEmployees
+   */
+  importEmployees: {
+    methodKind: "client_streaming";
+    input: typeof ImportEmployeesRequestSchema;
+    output: typeof ImportEmployeesResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.EmployeeService.ExportEmployees
+   */
+  exportEmployees: {
+    methodKind: "server_streaming";
+    input: typeof ExportEmployeesRequestSchema;
+    output: typeof ExportEmployeesResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_altalune_v1_employee, 0);
 
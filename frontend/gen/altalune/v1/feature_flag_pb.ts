@@ -0,0 +1,320 @@
+// @generated by protoc-gen-es v2.6.3 with parameter "target=ts,import_extension=js"
+// @generated from file altalune/v1/feature_flag.proto (package altalune.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import { file_buf_validate_validate } from "../../buf/validate/validate_pb.js";
+import { file_altalune_v1_options } from "./options_pb.js";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file altalune/v1/feature_flag.proto.
+ */
+export const file_altalune_v1_feature_flag: GenFile = /*@__PURE__*/
+  fileDesc("Ch5hbHRhbHVuZS92MS9mZWF0dXJlX2ZsYWcucHJvdG8SC2FsdGFsdW5lLnYxIu0BCgtGZWF0dXJlRmxhZxIMCgRuYW1lGAEgASgJEhMKC2Rlc2NyaXB0aW9uGAIgASgJEhcKD2RlZmF1bHRfZW5hYmxlZBgDIAEoCBISCgpjb25maWd1cmVkGAQgASgIEg8KB2VuYWJsZWQYBSABKAgSGgoScm9sbG91dF9wZXJjZW50YWdlGAYgASgFEjEKCHByb2plY3RzGAcgAygLMh8uYWx0YWx1bmUudjEuUHJvamVjdEZlYXR1cmVGbGFnEi4KCnVwZGF0ZWRfYXQYYyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIjkKElByb2plY3RGZWF0dXJlRmxhZxISCgpwcm9qZWN0X2lkGAEgASgJEg8KB2VuYWJsZWQYAiABKAgiGQoXTGlzdEZlYXR1cmVGbGFnc1JlcXVlc3QiQgoYTGlzdEZlYXR1cmVGbGFnc1Jlc3BvbnNlEiYKBGRhdGEYASADKAsyGC5hbHRhbHVuZS52MS5GZWF0dXJlRmxhZyJsChhVcGRhdGVGZWF0dXJlRmxhZ1JlcXVlc3QSGAoEbmFtZRgBIAEoCUIKukgHyAEBcgIYZBIPCgdlbmFibGVkGAIgASgIEiUKEnJvbGxvdXRfcGVyY2VudGFnZRgDIAEoBUIJukgGGgQYZCgAIlwKGVVwZGF0ZUZlYXR1cmVGbGFnUmVzcG9uc2USLgoMZmVhdHVyZV9mbGFnGAEgASgLMhguYWx0YWx1bmUudjEuRmVhdHVyZUZsYWcSDwoHbWVzc2FnZRgCIAEoCSJqChxTZXRQcm9qZWN0RmVhdHVyZUZsYWdSZXF1ZXN0EhgKBG5hbWUYASABKAlCCrpIB8gBAXICGGQSHwoKcHJvamVjdF9pZBgCIAEoCUILukgIyAEBcgOYAQ4SDwoHZW5hYmxlZBgDIAEoCCJgCh1TZXRQcm9qZWN0RmVhdHVyZUZsYWdSZXNwb25zZRIuCgxmZWF0dXJlX2ZsYWcYASABKAsyGC5hbHRhbHVuZS52MS5GZWF0dXJlRmxhZxIPCgdtZXNzYWdlGAIgASgJIlsKHkNsZWFyUHJvamVjdEZlYXR1cmVGbGFnUmVxdWVzdBIYCgRuYW1lGAEgASgJQgq6SAfIAQFyAhhkEh8KCnByb2plY3RfaWQYAiABKAlCC7pICMgBAXIDmAEOImIKH0NsZWFyUHJvamVjdEZlYXR1cmVGbGFnUmVzcG9uc2USLgoMZmVhdHVyZV9mbGFnGAEgASgLMhguYWx0YWx1bmUudjEuRmVhdHVyZUZsYWcSDwoHbWVzc2FnZRgCIAEoCTKcBAoSRmVhdHVyZUZsYWdTZXJ2aWNlEnUKEExpc3RGZWF0dXJlRmxhZ3MSJC5hbHRhbHVuZS52MS5MaXN0RmVhdHVyZUZsYWdzUmVxdWVzdBolLmFsdGFsdW5lLnYxLkxpc3RGZWF0dXJlRmxhZ3NSZXNwb25zZSIUirUYEGZlYXR1cmVmbGFnOnJlYWQSeQoRVXBkYXRlRmVhdHVyZUZsYWcSJS5hbHRhbHVuZS52MS5VcGRhdGVGZWF0dXJlRmxhZ1JlcXVlc3QaJi5hbHRhbHVuZS52MS5VcGRhdGVGZWF0dXJlRmxhZ1Jlc3BvbnNlIhWKtRgRZmVhdHVyZWZsYWc6d3JpdGUShQEKFVNldFByb2plY3RGZWF0dXJlRmxhZxIpLmFsdGFsdW5lLnYxLlNldFByb2plY3RGZWF0dXJlRmxhZ1JlcXVlc3QaKi5hbHRhbHVuZS52MS5TZXRQcm9qZWN0RmVhdHVyZUZsYWdSZXNwb25zZSIVirUYEWZlYXR1cmVmbGFnOndyaXRlEosBChdDbGVhclByb2plY3RGZWF0dXJlRmxhZxIrLmFsdGFsdW5lLnYxLkNsZWFyUHJvamVjdEZlYXR1cmVGbGFnUmVxdWVzdBosLmFsdGFsdW5lLnYxLkNsZWFyUHJvamVjdEZlYXR1cmVGbGFnUmVzcG9uc2UiFYq1GBFmZWF0dXJlZmxhZzp3cml0ZUKlAQoPY29tLmFsdGFsdW5lLnYxQhBGZWF0dXJlRmxhZ1Byb3RvUAFaM2dpdGh1Yi5jb20vaHJ6OC9hbHRhbHVuZS9nZW4vYWx0YWx1bmUvdjE7YWx0YWx1bmV2MaICA0FYWKoCC0FsdGFsdW5lLlYxygILQWx0YWx1bmVcVjHiAhdBbHRhbHVuZVxWMVxHUEJNZXRhZGF0YeoCDEFsdGFsdW5lOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp, file_buf_validate_validate, file_altalune_v1_options]);
+
+/**
+ * Feature Flag Message
+ * A flag is on for a project when the project overrides it on, or when it is
+ * enabled and the project falls within the rollout percentage.
+ *
+ * @generated from message altalune.v1.FeatureFlag
+ */
+export type FeatureFlag = Message<"altalune.v1.FeatureFlag"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string description = 2;
+   */
+  description: string;
+
+  /**
+   * Value of the flag until it is configured
+   *
+   * @generated from field: bool default_enabled = 3;
+   */
+  defaultEnabled: boolean;
+
+  /**
+   * False while the flag keeps its default
+   *
+   * @generated from field: bool configured = 4;
+   */
+  configured: boolean;
+
+  /**
+   * @generated from field: bool enabled = 5;
+   */
+  enabled: boolean;
+
+  /**
+   * 0-100
+   *
+   * @generated from field: int32 rollout_percentage = 6;
+   */
+  rolloutPercentage: number;
+
+  /**
+   * @generated from field: repeated altalune.v1.ProjectFeatureFlag projects = 7;
+   */
+  projects: ProjectFeatureFlag[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 99;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message altalune.v1.FeatureFlag.
+ * Use `create(FeatureFlagSchema)` to create a new message.
+ */
+export const FeatureFlagSchema: GenMessage<FeatureFlag> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_feature_flag, 0);
+
+/**
+ * Project override of a flag, winning over the rollout
+ *
+ * @generated from message altalune.v1.ProjectFeatureFlag
+ */
+export type ProjectFeatureFlag = Message<"altalune.v1.ProjectFeatureFlag"> & {
+  /**
+   * Public nanoid
+   *
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: bool enabled = 2;
+   */
+  enabled: boolean;
+};
+
+/**
+ * Describes the message altalune.v1.ProjectFeatureFlag.
+ * Use `create(ProjectFeatureFlagSchema)` to create a new message.
+ */
+export const ProjectFeatureFlagSchema: GenMessage<ProjectFeatureFlag> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_feature_flag, 1);
+
+/**
+ * @generated from message altalune.v1.ListFeatureFlagsRequest
+ */
+export type ListFeatureFlagsRequest = Message<"altalune.v1.ListFeatureFlagsRequest"> & {
+};
+
+/**
+ * Describes the message altalune.v1.ListFeatureFlagsRequest.
+ * Use `create(ListFeatureFlagsRequestSchema)` to create a new message.
+ */
+export const ListFeatureFlagsRequestSchema: GenMessage<ListFeatureFlagsRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_feature_flag, 2);
+
+/**
+ * @generated from message altalune.v1.ListFeatureFlagsResponse
+ */
+export type ListFeatureFlagsResponse = Message<"altalune.v1.ListFeatureFlagsResponse"> & {
+  /**
+   * @generated from field: repeated altalune.v1.FeatureFlag data = 1;
+   */
+  data: FeatureFlag[];
+};
+
+/**
+ * Describes the message altalune.v1.ListFeatureFlagsResponse.
+ * Use `create(ListFeatureFlagsResponseSchema)` to create a new message.
+ */
+export const ListFeatureFlagsResponseSchema: GenMessage<ListFeatureFlagsResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_feature_flag, 3);
+
+/**
+ * @generated from message altalune.v1.UpdateFeatureFlagRequest
+ */
+export type UpdateFeatureFlagRequest = Message<"altalune.v1.UpdateFeatureFlagRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: bool enabled = 2;
+   */
+  enabled: boolean;
+
+  /**
+   * @generated from field: int32 rollout_percentage = 3;
+   */
+  rolloutPercentage: number;
+};
+
+/**
+ * Describes the message altalune.v1.UpdateFeatureFlagRequest.
+ * Use `create(UpdateFeatureFlagRequestSchema)` to create a new message.
+ */
+export const UpdateFeatureFlagRequestSchema: GenMessage<UpdateFeatureFlagRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_feature_flag, 4);
+
+/**
+ * @generated from message altalune.v1.UpdateFeatureFlagResponse
+ */
+export type UpdateFeatureFlagResponse = Message<"altalune.v1.UpdateFeatureFlagResponse"> & {
+  /**
+   * @generated from field: altalune.v1.FeatureFlag feature_flag = 1;
+   */
+  featureFlag?: FeatureFlag;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.UpdateFeatureFlagResponse.
+ * Use `create(UpdateFeatureFlagResponseSchema)` to create a new message.
+ */
+export const UpdateFeatureFlagResponseSchema: GenMessage<UpdateFeatureFlagResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_feature_flag, 5);
+
+/**
+ * @generated from message altalune.v1.SetProjectFeatureFlagRequest
+ */
+export type SetProjectFeatureFlagRequest = Message<"altalune.v1.SetProjectFeatureFlagRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string project_id = 2;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: bool enabled = 3;
+   */
+  enabled: boolean;
+};
+
+/**
+ * Describes the message altalune.v1.SetProjectFeatureFlagRequest.
+ * Use `create(SetProjectFeatureFlagRequestSchema)` to create a new message.
+ */
+export const SetProjectFeatureFlagRequestSchema: GenMessage<SetProjectFeatureFlagRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_feature_flag, 6);
+
+/**
+ * @generated from message altalune.v1.SetProjectFeatureFlagResponse
+ */
+export type SetProjectFeatureFlagResponse = Message<"altalune.v1.SetProjectFeatureFlagResponse"> & {
+  /**
+   * @generated from field: altalune.v1.FeatureFlag feature_flag = 1;
+   */
+  featureFlag?: FeatureFlag;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.SetProjectFeatureFlagResponse.
+ * Use `create(SetProjectFeatureFlagResponseSchema)` to create a new message.
+ */
+export const SetProjectFeatureFlagResponseSchema: GenMessage<SetProjectFeatureFlagResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_feature_flag, 7);
+
+/**
+ * @generated from message altalune.v1.ClearProjectFeatureFlagRequest
+ */
+export type ClearProjectFeatureFlagRequest = Message<"altalune.v1.ClearProjectFeatureFlagRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string project_id = 2;
+   */
+  projectId: string;
+};
+
+/**
+ * Describes the message altalune.v1.ClearProjectFeatureFlagRequest.
+ * Use `create(ClearProjectFeatureFlagRequestSchema)` to create a new message.
+ */
+export const ClearProjectFeatureFlagRequestSchema: GenMessage<ClearProjectFeatureFlagRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_feature_flag, 8);
+
+/**
+ * @generated from message altalune.v1.ClearProjectFeatureFlagResponse
+ */
+export type ClearProjectFeatureFlagResponse = Message<"altalune.v1.ClearProjectFeatureFlagResponse"> & {
+  /**
+   * @generated from field: altalune.v1.FeatureFlag feature_flag = 1;
+   */
+  featureFlag?: FeatureFlag;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.ClearProjectFeatureFlagResponse.
+ * Use `create(ClearProjectFeatureFlagResponseSchema)` to create a new message.
+ */
+export const ClearProjectFeatureFlagResponseSchema: GenMessage<ClearProjectFeatureFlagResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_feature_flag, 9);
+
+/**
+ * Feature Flag Service - Toggle the flags gating risky features, globally,
+ * by percentage of projects and per project
+ *
+ * @generated from service altalune.v1.FeatureFlagService
+ */
+export const FeatureFlagService: GenService<{
+  /**
+   * @generated from rpc altalune.v1.FeatureFlagService.ListFeatureFlags
+   */
+  listFeatureFlags: {
+    methodKind: "unary";
+    input: typeof ListFeatureFlagsRequestSchema;
+    output: typeof ListFeatureFlagsResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.FeatureFlagService.UpdateFeatureFlag
+   */
+  updateFeatureFlag: {
+    methodKind: "unary";
+    input: typeof UpdateFeatureFlagRequestSchema;
+    output: typeof UpdateFeatureFlagResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.FeatureFlagService.SetProjectFeatureFlag
+   */
+  setProjectFeatureFlag: {
+    methodKind: "unary";
+    input: typeof SetProjectFeatureFlagRequestSchema;
+    output: typeof SetProjectFeatureFlagResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.FeatureFlagService.ClearProjectFeatureFlag
+   */
+  clearProjectFeatureFlag: {
+    methodKind: "unary";
+    input: typeof ClearProjectFeatureFlagRequestSchema;
+    output: typeof ClearProjectFeatureFlagResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_altalune_v1_feature_flag, 0);
+
@@ -2,8 +2,8 @@
 // @generated from file altalune/v1/iam_mapper.proto (package altalune.v1, syntax proto3)
 /* eslint-disable */
 
-import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
-import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
 import type { EmptySchema, Timestamp } from "@bufbuild/protobuf/wkt";
 import { file_google_protobuf_empty, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import { file_buf_validate_validate } from "../../buf/validate/validate_pb.js";
@@ -13,13 +13,14 @@ import type { Role } from "./role_pb.js";
 import { file_altalune_v1_role } from "./role_pb.js";
 import type { Permission } from "./permission_pb.js";
 import { file_altalune_v1_permission } from "./permission_pb.js";
+import { file_altalune_v1_options } from "./options_pb.js";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file altalune/v1/iam_mapper.proto.
  */
 export const file_altalune_v1_iam_mapper: GenFile = /*@__PURE__*/
-  fileDesc("ChxhbHRhbHVuZS92MS9pYW1fbWFwcGVyLnByb3RvEgthbHRhbHVuZS52MSJTChZBc3NpZ25Vc2VyUm9sZXNSZXF1ZXN0Eh0KB3VzZXJfaWQYASABKAlCDLpICcgBAXIEEA4YFBIaCghyb2xlX2lkcxgCIAMoCUIIukgFkgECCAEiUwoWUmVtb3ZlVXNlclJvbGVzUmVxdWVzdBIdCgd1c2VyX2lkGAEgASgJQgy6SAnIAQFyBBAOGBQSGgoIcm9sZV9pZHMYAiADKAlCCLpIBZIBAggBIjQKE0dldFVzZXJSb2xlc1JlcXVlc3QSHQoHdXNlcl9pZBgBIAEoCUIMukgJyAEBcgQQDhgUIjgKFEdldFVzZXJSb2xlc1Jlc3BvbnNlEiAKBXJvbGVzGAEgAygLMhEuYWx0YWx1bmUudjEuUm9sZSJfChxBc3NpZ25Sb2xlUGVybWlzc2lvbnNSZXF1ZXN0Eh0KB3JvbGVfaWQYASABKAlCDLpICcgBAXIEEA4YFBIgCg5wZXJtaXNzaW9uX2lkcxgCIAMoCUIIukgFkgECCAEiXwocUmVtb3ZlUm9sZVBlcm1pc3Npb25zUmVxdWVzdBIdCgdyb2xlX2lkGAEgASgJQgy6SAnIAQFyBBAOGBQSIAoOcGVybWlzc2lvbl9pZHMYAiADKAlCCLpIBZIBAggBIjoKGUdldFJvbGVQZXJtaXNzaW9uc1JlcXVlc3QSHQoHcm9sZV9pZBgBIAEoCUIMukgJyAEBcgQQDhgUIkoKGkdldFJvbGVQZXJtaXNzaW9uc1Jlc3BvbnNlEiwKC3Blcm1pc3Npb25zGAEgAygLMhcuYWx0YWx1bmUudjEuUGVybWlzc2lvbiJfChxBc3NpZ25Vc2VyUGVybWlzc2lvbnNSZXF1ZXN0Eh0KB3VzZXJfaWQYASABKAlCDLpICcgBAXIEEA4YFBIgCg5wZXJtaXNzaW9uX2lkcxgCIAMoCUIIukgFkgECCAEiXwocUmVtb3ZlVXNlclBlcm1pc3Npb25zUmVxdWVzdBIdCgd1c2VyX2lkGAEgASgJQgy6SAnIAQFyBBAOGBQSIAoOcGVybWlzc2lvbl9pZHMYAiADKAlCCLpIBZIBAggBIjoKGUdldFVzZXJQZXJtaXNzaW9uc1JlcXVlc3QSHQoHdXNlcl9pZBgBIAEoCUIMukgJyAEBcgQQDhgUIkoKGkdldFVzZXJQZXJtaXNzaW9uc1Jlc3BvbnNlEiwKC3Blcm1pc3Npb25zGAEgAygLMhcuYWx0YWx1bmUudjEuUGVybWlzc2lvbiJiCg1Qcm9qZWN0TWVtYmVyEh0KB3VzZXJfaWQYASABKAlCDLpICcgBAXIEEA4YFBIyCgRyb2xlGAIgASgJQiS6SCHIAQFyHFIFb3duZXJSBWFkbWluUgZtZW1iZXJSBHVzZXIidgobQXNzaWduUHJvamVjdE1lbWJlcnNSZXF1ZXN0EiAKCnByb2plY3RfaWQYASABKAlCDLpICcgBAXIEEA4YFBI1CgdtZW1iZXJzGAIgAygLMhouYWx0YWx1bmUudjEuUHJvamVjdE1lbWJlckIIukgFkgECCAEiWwobUmVtb3ZlUHJvamVjdE1lbWJlcnNSZXF1ZXN0EiAKCnByb2plY3RfaWQYASABKAlCDLpICcgBAXIEEA4YFBIaCgh1c2VyX2lkcxgCIAMoCUIIukgFkgECCAEiPAoYR2V0UHJvamVjdE1lbWJlcnNSZXF1ZXN0EiAKCnByb2plY3RfaWQYASABKAlCDLpICcgBAXIEEA4YFCJ2ChVQcm9qZWN0TWVtYmVyV2l0aFVzZXISHwoEdXNlchgBIAEoCzIRLmFsdGFsdW5lLnYxLlVzZXISDAoEcm9sZRgCIAEoCRIuCgpjcmVhdGVkX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJQChlHZXRQcm9qZWN0TWVtYmVyc1Jlc3BvbnNlEjMKB21lbWJlcnMYASADKAsyIi5hbHRhbHVuZS52MS5Qcm9qZWN0TWVtYmVyV2l0aFVzZXIiNwoWR2V0VXNlclByb2plY3RzUmVxdWVzdBIdCgd1c2VyX2lkGAEgASgJQgy6SAnIAQFyBBAOGBQifgoVVXNlclByb2plY3RNZW1iZXJzaGlwEhIKCnByb2plY3RfaWQYASABKAkSFAoMcHJvamVjdF9uYW1lGAIgASgJEgwKBHJvbGUYAyABKAkSLQoJam9pbmVkX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJPChdHZXRVc2VyUHJvamVjdHNSZXNwb25zZRI0Cghwcm9qZWN0cxgBIAMoCzIiLmFsdGFsdW5lLnYxLlVzZXJQcm9qZWN0TWVtYmVyc2hpcDLVCQoQSUFNTWFwcGVyU2VydmljZRJQCg9Bc3NpZ25Vc2VyUm9sZXMSIy5hbHRhbHVuZS52MS5Bc3NpZ25Vc2VyUm9sZXNSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IgASUAoPUmVtb3ZlVXNlclJvbGVzEiMuYWx0YWx1bmUudjEuUmVtb3ZlVXNlclJvbGVzUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSIAElUKDEdldFVzZXJSb2xlcxIgLmFsdGFsdW5lLnYxLkdldFVzZXJSb2xlc1JlcXVlc3QaIS5hbHRhbHVuZS52MS5HZXRVc2VyUm9sZXNSZXNwb25zZSIAElwKFUFzc2lnblJvbGVQZXJtaXNzaW9ucxIpLmFsdGFsdW5lLnYxLkFzc2lnblJvbGVQZXJtaXNzaW9uc1JlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiABJcChVSZW1vdmVSb2xlUGVybWlzc2lvbnMSKS5hbHRhbHVuZS52MS5SZW1vdmVSb2xlUGVybWlzc2lvbnNSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IgASZwoSR2V0Um9sZVBlcm1pc3Npb25zEiYuYWx0YWx1bmUudjEuR2V0Um9sZVBlcm1pc3Npb25zUmVxdWVzdBonLmFsdGFsdW5lLnYxLkdldFJvbGVQZXJtaXNzaW9uc1Jlc3BvbnNlIgASXAoVQXNzaWduVXNlclBlcm1pc3Npb25zEikuYWx0YWx1bmUudjEuQXNzaWduVXNlclBlcm1pc3Npb25zUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSIAElwKFVJlbW92ZVVzZXJQZXJtaXNzaW9ucxIpLmFsdGFsdW5lLnYxLlJlbW92ZVVzZXJQZXJtaXNzaW9uc1JlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiABJnChJHZXRVc2VyUGVybWlzc2lvbnMSJi5hbHRhbHVuZS52MS5HZXRVc2VyUGVybWlzc2lvbnNSZXF1ZXN0GicuYWx0YWx1bmUudjEuR2V0VXNlclBlcm1pc3Npb25zUmVzcG9uc2UiABJaChRBc3NpZ25Qcm9qZWN0TWVtYmVycxIoLmFsdGFsdW5lLnYxLkFzc2lnblByb2plY3RNZW1iZXJzUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSIAEloKFFJlbW92ZVByb2plY3RNZW1iZXJzEiguYWx0YWx1bmUudjEuUmVtb3ZlUHJvamVjdE1lbWJlcnNSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IgASZAoRR2V0UHJvamVjdE1lbWJlcnMSJS5hbHRhbHVuZS52MS5HZXRQcm9qZWN0TWVtYmVyc1JlcXVlc3QaJi5hbHRhbHVuZS52MS5HZXRQcm9qZWN0TWVtYmVyc1Jlc3BvbnNlIgASXgoPR2V0VXNlclByb2plY3RzEiMuYWx0YWx1bmUudjEuR2V0VXNlclByb2plY3RzUmVxdWVzdBokLmFsdGFsdW5lLnYxLkdldFVzZXJQcm9qZWN0c1Jlc3BvbnNlIgBCowEKD2NvbS5hbHRhbHVuZS52MUIOSWFtTWFwcGVyUHJvdG9QAVozZ2l0aHViLmNvbS9ocno4L2FsdGFsdW5lL2dlbi9hbHRhbHVuZS92MTthbHRhbHVuZXYxogIDQVhYqgILQWx0YWx1bmUuVjHKAgtBbHRhbHVuZVxWMeICF0FsdGFsdW5lXFYxXEdQQk1ldGFkYXRh6gIMQWx0YWx1bmU6OlYxYgZwcm90bzM", [file_google_protobuf_empty, file_google_protobuf_timestamp, file_buf_validate_validate, file_altalune_v1_user, file_altalune_v1_role, file_altalune_v1_permission]);
+  fileDesc("ChxhbHRhbHVuZS92MS9pYW1fbWFwcGVyLnByb3RvEgthbHRhbHVuZS52MSJTChZBc3NpZ25Vc2VyUm9sZXNSZXF1ZXN0Eh0KB3VzZXJfaWQYASABKAlCDLpICcgBAXIEEA4YFBIaCghyb2xlX2lkcxgCIAMoCUIIukgFkgECCAEiUwoWUmVtb3ZlVXNlclJvbGVzUmVxdWVzdBIdCgd1c2VyX2lkGAEgASgJQgy6SAnIAQFyBBAOGBQSGgoIcm9sZV9pZHMYAiADKAlCCLpIBZIBAggBIjQKE0dldFVzZXJSb2xlc1JlcXVlc3QSHQoHdXNlcl9pZBgBIAEoCUIMukgJyAEBcgQQDhgUIjgKFEdldFVzZXJSb2xlc1Jlc3BvbnNlEiAKBXJvbGVzGAEgAygLMhEuYWx0YWx1bmUudjEuUm9sZSJfChxBc3NpZ25Sb2xlUGVybWlzc2lvbnNSZXF1ZXN0Eh0KB3JvbGVfaWQYASABKAlCDLpICcgBAXIEEA4YFBIgCg5wZXJtaXNzaW9uX2lkcxgCIAMoCUIIukgFkgECCAEiXwocUmVtb3ZlUm9sZVBlcm1pc3Npb25zUmVxdWVzdBIdCgdyb2xlX2lkGAEgASgJQgy6SAnIAQFyBBAOGBQSIAoOcGVybWlzc2lvbl9pZHMYAiADKAlCCLpIBZIBAggBIjoKGUdldFJvbGVQZXJtaXNzaW9uc1JlcXVlc3QSHQoHcm9sZV9pZBgBIAEoCUIMukgJyAEBcgQQDhgUIkoKGkdldFJvbGVQZXJtaXNzaW9uc1Jlc3BvbnNlEiwKC3Blcm1pc3Npb25zGAEgAygLMhcuYWx0YWx1bmUudjEuUGVybWlzc2lvbiJfChxBc3NpZ25Vc2VyUGVybWlzc2lvbnNSZXF1ZXN0Eh0KB3VzZXJfaWQYASABKAlCDLpICcgBAXIEEA4YFBIgCg5wZXJtaXNzaW9uX2lkcxgCIAMoCUIIukgFkgECCAEiXwocUmVtb3ZlVXNlclBlcm1pc3Npb25zUmVxdWVzdBIdCgd1c2VyX2lkGAEgASgJQgy6SAnIAQFyBBAOGBQSIAoOcGVybWlzc2lvbl9pZHMYAiADKAlCCLpIBZIBAggBIjoKGUdldFVzZXJQZXJtaXNzaW9uc1JlcXVlc3QSHQoHdXNlcl9pZBgBIAEoCUIMukgJyAEBcgQQDhgUIkoKGkdldFVzZXJQZXJtaXNzaW9uc1Jlc3BvbnNlEiwKC3Blcm1pc3Npb25zGAEgAygLMhcuYWx0YWx1bmUudjEuUGVybWlzc2lvbiJiCg1Qcm9qZWN0TWVtYmVyEh0KB3VzZXJfaWQYASABKAlCDLpICcgBAXIEEA4YFBIyCgRyb2xlGAIgASgJQiS6SCHIAQFyHFIFb3duZXJSBWFkbWluUgZtZW1iZXJSBHVzZXIidgobQXNzaWduUHJvamVjdE1lbWJlcnNSZXF1ZXN0EiAKCnByb2plY3RfaWQYASABKAlCDLpICcgBAXIEEA4YFBI1CgdtZW1iZXJzGAIgAygLMhouYWx0YWx1bmUudjEuUHJvamVjdE1lbWJlckIIukgFkgECCAEiWwobUmVtb3ZlUHJvamVjdE1lbWJlcnNSZXF1ZXN0EiAKCnByb2plY3RfaWQYASABKAlCDLpICcgBAXIEEA4YFBIaCgh1c2VyX2lkcxgCIAMoCUIIukgFkgECCAEiPAoYR2V0UHJvamVjdE1lbWJlcnNSZXF1ZXN0EiAKCnByb2plY3RfaWQYASABKAlCDLpICcgBAXIEEA4YFCJ2ChVQcm9qZWN0TWVtYmVyV2l0aFVzZXISHwoEdXNlchgBIAEoCzIRLmFsdGFsdW5lLnYxLlVzZXISDAoEcm9sZRgCIAEoCRIuCgpjcmVhdGVkX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJQChlHZXRQcm9qZWN0TWVtYmVyc1Jlc3BvbnNlEjMKB21lbWJlcnMYASADKAsyIi5hbHRhbHVuZS52MS5Qcm9qZWN0TWVtYmVyV2l0aFVzZXIiNwoWR2V0VXNlclByb2plY3RzUmVxdWVzdBIdCgd1c2VyX2lkGAEgASgJQgy6SAnIAQFyBBAOGBQifgoVVXNlclByb2plY3RNZW1iZXJzaGlwEhIKCnByb2plY3RfaWQYASABKAkSFAoMcHJvamVjdF9uYW1lGAIgASgJEgwKBHJvbGUYAyABKAkSLQoJam9pbmVkX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJPChdHZXRVc2VyUHJvamVjdHNSZXNwb25zZRI0Cghwcm9qZWN0cxgBIAMoCzIiLmFsdGFsdW5lLnYxLlVzZXJQcm9qZWN0TWVtYmVyc2hpcCLnAgoYUHJvamVjdE93bmVyc2hpcFRyYW5zZmVyEgoKAmlkGAEgASgJEhIKCnByb2plY3RfaWQYAiABKAkSFAoMcHJvamVjdF9uYW1lGAMgASgJEhQKDGZyb21fdXNlcl9pZBgEIAEoCRISCgpmcm9tX2VtYWlsGAUgASgJEhIKCnRvX3VzZXJfaWQYBiABKAkSEAoIdG9fZW1haWwYByABKAkSNAoGc3RhdHVzGAggASgOMiQuYWx0YWx1bmUudjEuT3duZXJzaGlwVHJhbnNmZXJTdGF0dXMSLgoKZXhwaXJlc19hdBgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKY3JlYXRlZF9hdBgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLwoLcmVzb2x2ZWRfYXQYCyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wImoKJ0luaXRpYXRlUHJvamVjdE93bmVyc2hpcFRyYW5zZmVyUmVxdWVzdBIgCgpwcm9qZWN0X2lkGAEgASgJQgy6SAnIAQFyBBAOGBQSHQoHdXNlcl9pZBgCIAEoCUIMukgJyAEBcgQQDhgUImMKKEluaXRpYXRlUHJvamVjdE93bmVyc2hpcFRyYW5zZmVyUmVzcG9uc2USNwoIdHJhbnNmZXIYASABKAsyJS5hbHRhbHVuZS52MS5Qcm9qZWN0T3duZXJzaGlwVHJhbnNmZXIiRgoiR2V0UHJvamVjdE93bmVyc2hpcFRyYW5zZmVyUmVxdWVzdBIgCgpwcm9qZWN0X2lkGAEgASgJQgy6SAnIAQFyBBAOGBQiXgojR2V0UHJvamVjdE93bmVyc2hpcFRyYW5zZmVyUmVzcG9uc2USNwoIdHJhbnNmZXIYASABKAsyJS5hbHRhbHVuZS52MS5Qcm9qZWN0T3duZXJzaGlwVHJhbnNmZXIiSgolQWNjZXB0UHJvamVjdE93bmVyc2hpcFRyYW5zZmVyUmVxdWVzdBIhCgt0cmFuc2Zlcl9pZBgBIAEoCUIMukgJyAEBcgQQDhgUImEKJkFjY2VwdFByb2plY3RPd25lcnNoaXBUcmFuc2ZlclJlc3BvbnNlEjcKCHRyYW5zZmVyGAEgASgLMiUuYWx0YWx1bmUudjEuUHJvamVjdE93bmVyc2hpcFRyYW5zZmVyIkoKJUNhbmNlbFByb2plY3RPd25lcnNoaXBUcmFuc2ZlclJlcXVlc3QSIQoLdHJhbnNmZXJfaWQYASABKAlCDLpICcgBAXIEEA4YFCI5ChVEdW1wUHJvamVjdElBTVJlcXVlc3QSIAoKcHJvamVjdF9pZBgBIAEoCUIMukgJyAEBcgQQDhgUIioKFkR1bXBQcm9qZWN0SUFNUmVzcG9uc2USEAoIc25hcHNob3QYASABKAkiawoWQXBwbHlQcm9qZWN0SUFNUmVxdWVzdBIgCgpwcm9qZWN0X2lkGAEgASgJQgy6SAnIAQFyBBAOGBQSHgoIc25hcHNob3QYAiABKAlCDLpICcgBAXIEGICAQBIPCgdkcnlfcnVuGAMgASgIIioKF0FwcGx5UHJvamVjdElBTVJlc3BvbnNlEg8KB2NoYW5nZXMYASADKAkqiwIKF093bmVyc2hpcFRyYW5zZmVyU3RhdHVzEikKJU9XTkVSU0hJUF9UUkFOU0ZFUl9TVEFUVVNfVU5TUEVDSUZJRUQQABIlCiFPV05FUlNISVBfVFJBTlNGRVJfU1RBVFVTX1BFTkRJTkcQARImCiJPV05FUlNISVBfVFJBTlNGRVJfU1RBVFVTX0FDQ0VQVEVEEAISJwojT1dORVJTSElQX1RSQU5TRkVSX1NUQVRVU19DQU5DRUxMRUQQAxImCiJPV05FUlNISVBfVFJBTlNGRVJfU1RBVFVTX0RFQ0xJTkVEEAQSJQohT1dORVJTSElQX1RSQU5TRkVSX1NUQVRVU19FWFBJUkVEEAUyghEKEElBTU1hcHBlclNlcnZpY2USXQoPQXNzaWduVXNlclJvbGVzEiMuYWx0YWx1bmUudjEuQXNzaWduVXNlclJvbGVzUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSINirUYCWlhbTp3cml0ZRJdCg9SZW1vdmVVc2VyUm9sZXMSIy5hbHRhbHVuZS52MS5SZW1vdmVVc2VyUm9sZXNSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5Ig2KtRgJaWFtOndyaXRlEmEKDEdldFVzZXJSb2xlcxIgLmFsdGFsdW5lLnYxLkdldFVzZXJSb2xlc1JlcXVlc3QaIS5hbHRhbHVuZS52MS5HZXRVc2VyUm9sZXNSZXNwb25zZSIMirUYCGlhbTpyZWFkEmkKFUFzc2lnblJvbGVQZXJtaXNzaW9ucxIpLmFsdGFsdW5lLnYxLkFzc2lnblJvbGVQZXJtaXNzaW9uc1JlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiDYq1GAlpYW06d3JpdGUSaQoVUmVtb3ZlUm9sZVBlcm1pc3Npb25zEikuYWx0YWx1bmUudjEuUmVtb3ZlUm9sZVBlcm1pc3Npb25zUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSINirUYCWlhbTp3cml0ZRJzChJHZXRSb2xlUGVybWlzc2lvbnMSJi5hbHRhbHVuZS52MS5HZXRSb2xlUGVybWlzc2lvbnNSZXF1ZXN0GicuYWx0YWx1bmUudjEuR2V0Um9sZVBlcm1pc3Npb25zUmVzcG9uc2UiDIq1GAhpYW06cmVhZBJpChVBc3NpZ25Vc2VyUGVybWlzc2lvbnMSKS5hbHRhbHVuZS52MS5Bc3NpZ25Vc2VyUGVybWlzc2lvbnNSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5Ig2KtRgJaWFtOndyaXRlEmkKFVJlbW92ZVVzZXJQZXJtaXNzaW9ucxIpLmFsdGFsdW5lLnYxLlJlbW92ZVVzZXJQZXJtaXNzaW9uc1JlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiDYq1GAlpYW06d3JpdGUScwoSR2V0VXNlclBlcm1pc3Npb25zEiYuYWx0YWx1bmUudjEuR2V0VXNlclBlcm1pc3Npb25zUmVxdWVzdBonLmFsdGFsdW5lLnYxLkdldFVzZXJQZXJtaXNzaW9uc1Jlc3BvbnNlIgyKtRgIaWFtOnJlYWQSagoUQXNzaWduUHJvamVjdE1lbWJlcnMSKC5hbHRhbHVuZS52MS5Bc3NpZ25Qcm9qZWN0TWVtYmVyc1JlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiEIq1GAxtZW1iZXI6d3JpdGUSagoUUmVtb3ZlUHJvamVjdE1lbWJlcnMSKC5hbHRhbHVuZS52MS5SZW1vdmVQcm9qZWN0TWVtYmVyc1JlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiEIq1GAxtZW1iZXI6d3JpdGUScwoRR2V0UHJvamVjdE1lbWJlcnMSJS5hbHRhbHVuZS52MS5HZXRQcm9qZWN0TWVtYmVyc1JlcXVlc3QaJi5hbHRhbHVuZS52MS5HZXRQcm9qZWN0TWVtYmVyc1Jlc3BvbnNlIg+KtRgLbWVtYmVyOnJlYWQSoQEKIEluaXRpYXRlUHJvamVjdE93bmVyc2hpcFRyYW5zZmVyEjQuYWx0YWx1bmUudjEuSW5pdGlhdGVQcm9qZWN0T3duZXJzaGlwVHJhbnNmZXJSZXF1ZXN0GjUuYWx0YWx1bmUudjEuSW5pdGlhdGVQcm9qZWN0T3duZXJzaGlwVHJhbnNmZXJSZXNwb25zZSIQirUYDG1lbWJlcjp3cml0ZRKRAQobR2V0UHJvamVjdE93bmVyc2hpcFRyYW5zZmVyEi8uYWx0YWx1bmUudjEuR2V0UHJvamVjdE93bmVyc2hpcFRyYW5zZmVyUmVxdWVzdBowLmFsdGFsdW5lLnYxLkdldFByb2plY3RPd25lcnNoaXBUcmFuc2ZlclJlc3BvbnNlIg+KtRgLbWVtYmVyOnJlYWQSiwEKHkFjY2VwdFByb2plY3RPd25lcnNoaXBUcmFuc2ZlchIyLmFsdGFsdW5lLnYxLkFjY2VwdFByb2plY3RPd25lcnNoaXBUcmFuc2ZlclJlcXVlc3QaMy5hbHRhbHVuZS52MS5BY2NlcHRQcm9qZWN0T3duZXJzaGlwVHJhbnNmZXJSZXNwb25zZSIAEm4KHkNhbmNlbFByb2plY3RPd25lcnNoaXBUcmFuc2ZlchIyLmFsdGFsdW5lLnYxLkNhbmNlbFByb2plY3RPd25lcnNoaXBUcmFuc2ZlclJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiABJeCg9HZXRVc2VyUHJvamVjdHMSIy5hbHRhbHVuZS52MS5HZXRVc2VyUHJvamVjdHNSZXF1ZXN0GiQuYWx0YWx1bmUudjEuR2V0VXNlclByb2plY3RzUmVzcG9uc2UiABJnCg5EdW1wUHJvamVjdElBTRIiLmFsdGFsdW5lLnYxLkR1bXBQcm9qZWN0SUFNUmVxdWVzdBojLmFsdGFsdW5lLnYxLkR1bXBQcm9qZWN0SUFNUmVzcG9uc2UiDIq1GAhpYW06cmVhZBJrCg9BcHBseVByb2plY3RJQU0SIy5hbHRhbHVuZS52MS5BcHBseVByb2plY3RJQU1SZXF1ZXN0GiQuYWx0YWx1bmUudjEuQXBwbHlQcm9qZWN0SUFNUmVzcG9uc2UiDYq1GAlpYW06d3JpdGVCowEKD2NvbS5hbHRhbHVuZS52MUIOSWFtTWFwcGVyUHJvdG9QAVozZ2l0aHViLmNvbS9ocno4L2FsdGFsdW5lL2dlbi9hbHRhbHVuZS92MTthbHRhbHVuZXYxogIDQVhYqgILQWx0YWx1bmUuVjHKAgtBbHRhbHVuZVxWMeICF0FsdGFsdW5lXFYxXEdQQk1ldGFkYXRh6gIMQWx0YWx1bmU6OlYxYgZwcm90bzM", [file_google_protobuf_empty, file_google_protobuf_timestamp, file_buf_validate_validate, file_altalune_v1_user, file_altalune_v1_role, file_altalune_v1_permission, file_altalune_v1_options]);
 
 /**
  * AssignUserRolesRequest for assigning roles to a user
@@ -490,6 +491,364 @@ export type GetUserProjectsResponse = Message<"altalune.v1.GetUserProjectsRespon
 export const GetUserProjectsResponseSchema: GenMessage<GetUserProjectsResponse> = /*@__PURE__*/
   messageDesc(file_altalune_v1_iam_mapper, 20);
 
+/**
+ * Request of a project owner to hand the owner role over to another member.
+ * Accepting it swaps the roles of both: the target member becomes owner and
+ * the initiator takes the role the target member held.
+ *
+ * @generated from message altalune.v1.ProjectOwnershipTransfer
+ */
+export type ProjectOwnershipTransfer = Message<"altalune.v1.ProjectOwnershipTransfer"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string project_id = 2;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: string project_name = 3;
+   */
+  projectName: string;
+
+  /**
+   * Public ID of the owner who initiated it
+   *
+   * @generated from field: string from_user_id = 4;
+   */
+  fromUserId: string;
+
+  /**
+   * @generated from field: string from_email = 5;
+   */
+  fromEmail: string;
+
+  /**
+   * Public ID of the member receiving ownership
+   *
+   * @generated from field: string to_user_id = 6;
+   */
+  toUserId: string;
+
+  /**
+   * @generated from field: string to_email = 7;
+   */
+  toEmail: string;
+
+  /**
+   * @generated from field: altalune.v1.OwnershipTransferStatus status = 8;
+   */
+  status: OwnershipTransferStatus;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 9;
+   */
+  expiresAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 10;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * Unset while pending
+   *
+   * @generated from field: google.protobuf.Timestamp resolved_at = 11;
+   */
+  resolvedAt?: Timestamp;
+};
+
+/**
+ * Describes the message altalune.v1.ProjectOwnershipTransfer.
+ * Use `create(ProjectOwnershipTransferSchema)` to create a new message.
+ */
+export const ProjectOwnershipTransferSchema: GenMessage<ProjectOwnershipTransfer> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_iam_mapper, 21);
+
+/**
+ * InitiateProjectOwnershipTransferRequest for offering the ownership of a
+ * project to one of its members. It replaces any pending transfer.
+ *
+ * @generated from message altalune.v1.InitiateProjectOwnershipTransferRequest
+ */
+export type InitiateProjectOwnershipTransferRequest = Message<"altalune.v1.InitiateProjectOwnershipTransferRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message altalune.v1.InitiateProjectOwnershipTransferRequest.
+ * Use `create(InitiateProjectOwnershipTransferRequestSchema)` to create a new message.
+ */
+export const InitiateProjectOwnershipTransferRequestSchema: GenMessage<InitiateProjectOwnershipTransferRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_iam_mapper, 22);
+
+/**
+ * @generated from message altalune.v1.InitiateProjectOwnershipTransferResponse
+ */
+export type InitiateProjectOwnershipTransferResponse = Message<"altalune.v1.InitiateProjectOwnershipTransferResponse"> & {
+  /**
+   * @generated from field: altalune.v1.ProjectOwnershipTransfer transfer = 1;
+   */
+  transfer?: ProjectOwnershipTransfer;
+};
+
+/**
+ * Describes the message altalune.v1.InitiateProjectOwnershipTransferResponse.
+ * Use `create(InitiateProjectOwnershipTransferResponseSchema)` to create a new message.
+ */
+export const InitiateProjectOwnershipTransferResponseSchema: GenMessage<InitiateProjectOwnershipTransferResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_iam_mapper, 23);
+
+/**
+ * GetProjectOwnershipTransferRequest for retrieving the pending transfer of a project
+ *
+ * @generated from message altalune.v1.GetProjectOwnershipTransferRequest
+ */
+export type GetProjectOwnershipTransferRequest = Message<"altalune.v1.GetProjectOwnershipTransferRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+};
+
+/**
+ * Describes the message altalune.v1.GetProjectOwnershipTransferRequest.
+ * Use `create(GetProjectOwnershipTransferRequestSchema)` to create a new message.
+ */
+export const GetProjectOwnershipTransferRequestSchema: GenMessage<GetProjectOwnershipTransferRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_iam_mapper, 24);
+
+/**
+ * @generated from message altalune.v1.GetProjectOwnershipTransferResponse
+ */
+export type GetProjectOwnershipTransferResponse = Message<"altalune.v1.GetProjectOwnershipTransferResponse"> & {
+  /**
+   * Unset when none is pending
+   *
+   * @generated from field: altalune.v1.ProjectOwnershipTransfer transfer = 1;
+   */
+  transfer?: ProjectOwnershipTransfer;
+};
+
+/**
+ * Describes the message altalune.v1.GetProjectOwnershipTransferResponse.
+ * Use `create(GetProjectOwnershipTransferResponseSchema)` to create a new message.
+ */
+export const GetProjectOwnershipTransferResponseSchema: GenMessage<GetProjectOwnershipTransferResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_iam_mapper, 25);
+
+/**
+ * AcceptProjectOwnershipTransferRequest for the target member taking ownership
+ *
+ * @generated from message altalune.v1.AcceptProjectOwnershipTransferRequest
+ */
+export type AcceptProjectOwnershipTransferRequest = Message<"altalune.v1.AcceptProjectOwnershipTransferRequest"> & {
+  /**
+   * @generated from field: string transfer_id = 1;
+   */
+  transferId: string;
+};
+
+/**
+ * Describes the message altalune.v1.AcceptProjectOwnershipTransferRequest.
+ * Use `create(AcceptProjectOwnershipTransferRequestSchema)` to create a new message.
+ */
+export const AcceptProjectOwnershipTransferRequestSchema: GenMessage<AcceptProjectOwnershipTransferRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_iam_mapper, 26);
+
+/**
+ * @generated from message altalune.v1.AcceptProjectOwnershipTransferResponse
+ */
+export type AcceptProjectOwnershipTransferResponse = Message<"altalune.v1.AcceptProjectOwnershipTransferResponse"> & {
+  /**
+   * @generated from field: altalune.v1.ProjectOwnershipTransfer transfer = 1;
+   */
+  transfer?: ProjectOwnershipTransfer;
+};
+
+/**
+ * Describes the message altalune.v1.AcceptProjectOwnershipTransferResponse.
+ * Use `create(AcceptProjectOwnershipTransferResponseSchema)` to create a new message.
+ */
+export const AcceptProjectOwnershipTransferResponseSchema: GenMessage<AcceptProjectOwnershipTransferResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_iam_mapper, 27);
+
+/**
+ * CancelProjectOwnershipTransferRequest for the initiator withdrawing a
+ * transfer, or the target member declining it
+ *
+ * @generated from message altalune.v1.CancelProjectOwnershipTransferRequest
+ */
+export type CancelProjectOwnershipTransferRequest = Message<"altalune.v1.CancelProjectOwnershipTransferRequest"> & {
+  /**
+   * @generated from field: string transfer_id = 1;
+   */
+  transferId: string;
+};
+
+/**
+ * Describes the message altalune.v1.CancelProjectOwnershipTransferRequest.
+ * Use `create(CancelProjectOwnershipTransferRequestSchema)` to create a new message.
+ */
+export const CancelProjectOwnershipTransferRequestSchema: GenMessage<CancelProjectOwnershipTransferRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_iam_mapper, 28);
+
+/**
+ * DumpProjectIAMRequest for exporting the IAM configuration of a project
+ *
+ * @generated from message altalune.v1.DumpProjectIAMRequest
+ */
+export type DumpProjectIAMRequest = Message<"altalune.v1.DumpProjectIAMRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+};
+
+/**
+ * Describes the message altalune.v1.DumpProjectIAMRequest.
+ * Use `create(DumpProjectIAMRequestSchema)` to create a new message.
+ */
+export const DumpProjectIAMRequestSchema: GenMessage<DumpProjectIAMRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_iam_mapper, 29);
+
+/**
+ * DumpProjectIAMResponse with the IAM configuration as a YAML snapshot
+ *
+ * @generated from message altalune.v1.DumpProjectIAMResponse
+ */
+export type DumpProjectIAMResponse = Message<"altalune.v1.DumpProjectIAMResponse"> & {
+  /**
+   * Members with their project role, the roles and direct permissions they
+   * hold and the permissions of those roles, sorted so equal configurations
+   * dump identically
+   *
+   * @generated from field: string snapshot = 1;
+   */
+  snapshot: string;
+};
+
+/**
+ * Describes the message altalune.v1.DumpProjectIAMResponse.
+ * Use `create(DumpProjectIAMResponseSchema)` to create a new message.
+ */
+export const DumpProjectIAMResponseSchema: GenMessage<DumpProjectIAMResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_iam_mapper, 30);
+
+/**
+ * ApplyProjectIAMRequest for reconciling a project to a YAML snapshot
+ *
+ * @generated from message altalune.v1.ApplyProjectIAMRequest
+ */
+export type ApplyProjectIAMRequest = Message<"altalune.v1.ApplyProjectIAMRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * Snapshot as returned by DumpProjectIAM, possibly of another project
+   *
+   * @generated from field: string snapshot = 2;
+   */
+  snapshot: string;
+
+  /**
+   * Only report the changes that would be made
+   *
+   * @generated from field: bool dry_run = 3;
+   */
+  dryRun: boolean;
+};
+
+/**
+ * Describes the message altalune.v1.ApplyProjectIAMRequest.
+ * Use `create(ApplyProjectIAMRequestSchema)` to create a new message.
+ */
+export const ApplyProjectIAMRequestSchema: GenMessage<ApplyProjectIAMRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_iam_mapper, 31);
+
+/**
+ * ApplyProjectIAMResponse with the changes made, or that would be made
+ *
+ * @generated from message altalune.v1.ApplyProjectIAMResponse
+ */
+export type ApplyProjectIAMResponse = Message<"altalune.v1.ApplyProjectIAMResponse"> & {
+  /**
+   * @generated from field: repeated string changes = 1;
+   */
+  changes: string[];
+};
+
+/**
+ * Describes the message altalune.v1.ApplyProjectIAMResponse.
+ * Use `create(ApplyProjectIAMResponseSchema)` to create a new message.
+ */
+export const ApplyProjectIAMResponseSchema: GenMessage<ApplyProjectIAMResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_iam_mapper, 32);
+
+/**
+ * @generated from enum altalune.v1.OwnershipTransferStatus
+ */
+export enum OwnershipTransferStatus {
+  /**
+   * @generated from enum value: OWNERSHIP_TRANSFER_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Awaiting the target member
+   *
+   * @generated from enum value: OWNERSHIP_TRANSFER_STATUS_PENDING = 1;
+   */
+  PENDING = 1,
+
+  /**
+   * The target member became owner
+   *
+   * @generated from enum value: OWNERSHIP_TRANSFER_STATUS_ACCEPTED = 2;
+   */
+  ACCEPTED = 2,
+
+  /**
+   * Withdrawn by the owner, or replaced by a newer transfer
+   *
+   * @generated from enum value: OWNERSHIP_TRANSFER_STATUS_CANCELLED = 3;
+   */
+  CANCELLED = 3,
+
+  /**
+   * Refused by the target member
+   *
+   * @generated from enum value: OWNERSHIP_TRANSFER_STATUS_DECLINED = 4;
+   */
+  DECLINED = 4,
+
+  /**
+   * Not accepted in time
+   *
+   * @generated from enum value: OWNERSHIP_TRANSFER_STATUS_EXPIRED = 5;
+   */
+  EXPIRED = 5,
+}
+
+/**
+ * Describes the enum altalune.v1.OwnershipTransferStatus.
+ */
+export const OwnershipTransferStatusSchema: GenEnum<OwnershipTransferStatus> = /*@__PURE__*/
+  enumDesc(file_altalune_v1_iam_mapper, 0);
+
 /**
  * @generated from service altalune.v1.IAMMapperService
  */
@@ -598,6 +957,41 @@ export const IAMMapperService: GenService<{
     input: typeof GetProjectMembersRequestSchema;
     output: typeof GetProjectMembersResponseSchema;
   },
+  /**
+   * Project Ownership Transfers. Accepting and cancelling is checked against
+   * the parties of the transfer rather than a permission.
+   *
+   * @generated from rpc altalune.v1.IAMMapperService.InitiateProjectOwnershipTransfer
+   */
+  initiateProjectOwnershipTransfer: {
+    methodKind: "unary";
+    input: typeof InitiateProjectOwnershipTransferRequestSchema;
+    output: typeof InitiateProjectOwnershipTransferResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.IAMMapperService.GetProjectOwnershipTransfer
+   */
+  getProjectOwnershipTransfer: {
+    methodKind: "unary";
+    input: typeof GetProjectOwnershipTransferRequestSchema;
+    output: typeof GetProjectOwnershipTransferResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.IAMMapperService.AcceptProjectOwnershipTransfer
+   */
+  acceptProjectOwnershipTransfer: {
+    methodKind: "unary";
+    input: typeof AcceptProjectOwnershipTransferRequestSchema;
+    output: typeof AcceptProjectOwnershipTransferResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.IAMMapperService.CancelProjectOwnershipTransfer
+   */
+  cancelProjectOwnershipTransfer: {
+    methodKind: "unary";
+    input: typeof CancelProjectOwnershipTransferRequestSchema;
+    output: typeof EmptySchema;
+  },
   /**
    * User Projects (reverse lookup - projects a user belongs to)
    *
@@ -608,6 +1002,25 @@ export const IAMMapperService: GenService<{
     input: typeof GetUserProjectsRequestSchema;
     output: typeof GetUserProjectsResponseSchema;
   },
+  /**
+   * IAM Snapshots. Roles and permissions are global: applying a snapshot
+   * also changes them for the other projects whose members hold them.
+   *
+   * @generated from rpc altalune.v1.IAMMapperService.DumpProjectIAM
+   */
+  dumpProjectIAM: {
+    methodKind: "unary";
+    input: typeof DumpProjectIAMRequestSchema;
+    output: typeof DumpProjectIAMResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.IAMMapperService.ApplyProjectIAM
+   */
+  applyProjectIAM: {
+    methodKind: "unary";
+    input: typeof ApplyProjectIAMRequestSchema;
+    output: typeof ApplyProjectIAMResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_altalune_v1_iam_mapper, 0);
 
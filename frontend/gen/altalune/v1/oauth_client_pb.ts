@@ -2,20 +2,21 @@
 // @generated from file altalune/v1/oauth_client.proto (package altalune.v1, syntax proto3)
 /* eslint-disable */
 
-import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
-import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
 import type { Timestamp } from "@bufbuild/protobuf/wkt";
 import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import { file_buf_validate_validate } from "../../buf/validate/validate_pb.js";
-import type { QueryMetaResponse, QueryRequest } from "./common_pb.js";
+import type { DeliveredSecret, QueryMetaResponse, QueryRequest } from "./common_pb.js";
 import { file_altalune_v1_common } from "./common_pb.js";
+import { file_altalune_v1_options } from "./options_pb.js";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file altalune/v1/oauth_client.proto.
  */
 export const file_altalune_v1_oauth_client: GenFile = /*@__PURE__*/
-  fileDesc("Ch5hbHRhbHVuZS92MS9vYXV0aF9jbGllbnQucHJvdG8SC2FsdGFsdW5lLnYxIqUCCgtPQXV0aENsaWVudBIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhEKCWNsaWVudF9pZBgDIAEoCRIVCg1yZWRpcmVjdF91cmlzGAQgAygJEhUKDXBrY2VfcmVxdWlyZWQYBSABKAgSEgoKaXNfZGVmYXVsdBgGIAEoCBIZChFjbGllbnRfc2VjcmV0X3NldBgHIAEoCBIWCg5hbGxvd2VkX3Njb3BlcxgIIAMoCRIUCgxjb25maWRlbnRpYWwYCSABKAgSLgoKY3JlYXRlZF9hdBhiIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBhjIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAivQEKGENyZWF0ZU9BdXRoQ2xpZW50UmVxdWVzdBIvCgRuYW1lGAEgASgJQiG6SB7IAQFyGRABGGQyE15bYS16QS1aMC05XHNcLV9dKyQSKwoNcmVkaXJlY3RfdXJpcxgCIAMoCUIUukgRkgEOCAEQCiIIcgYY9AOIAQESFQoNcGtjZV9yZXF1aXJlZBgDIAEoCBIWCg5hbGxvd2VkX3Njb3BlcxgEIAMoCRIUCgxjb25maWRlbnRpYWwYBSABKAgibQoZQ3JlYXRlT0F1dGhDbGllbnRSZXNwb25zZRIoCgZjbGllbnQYASABKAsyGC5hbHRhbHVuZS52MS5PQXV0aENsaWVudBIVCg1jbGllbnRfc2VjcmV0GAIgASgJEg8KB21lc3NhZ2UYAyABKAkiRAoYUXVlcnlPQXV0aENsaWVudHNSZXF1ZXN0EigKBXF1ZXJ5GAEgASgLMhkuYWx0YWx1bmUudjEuUXVlcnlSZXF1ZXN0IoUBChlRdWVyeU9BdXRoQ2xpZW50c1Jlc3BvbnNlEikKB2NsaWVudHMYASADKAsyGC5hbHRhbHVuZS52MS5PQXV0aENsaWVudBIsCgRtZXRhGAIgASgLMh4uYWx0YWx1bmUudjEuUXVlcnlNZXRhUmVzcG9uc2USDwoHbWVzc2FnZRgDIAEoCSIwChVHZXRPQXV0aENsaWVudFJlcXVlc3QSFwoCaWQYASABKAlCC7pICMgBAXIDmAEOIlMKFkdldE9BdXRoQ2xpZW50UmVzcG9uc2USKAoGY2xpZW50GAEgASgLMhguYWx0YWx1bmUudjEuT0F1dGhDbGllbnQSDwoHbWVzc2FnZRgCIAEoCSK3AQoYVXBkYXRlT0F1dGhDbGllbnRSZXF1ZXN0EhcKAmlkGAEgASgJQgu6SAjIAQFyA5gBDhIcCgRuYW1lGAIgASgJQgm6SAZyBBABGGRIAIgBARIVCg1yZWRpcmVjdF91cmlzGAMgAygJEhoKDXBrY2VfcmVxdWlyZWQYBCABKAhIAYgBARIWCg5hbGxvd2VkX3Njb3BlcxgFIAMoCUIHCgVfbmFtZUIQCg5fcGtjZV9yZXF1aXJlZCJWChlVcGRhdGVPQXV0aENsaWVudFJlc3BvbnNlEigKBmNsaWVudBgBIAEoCzIYLmFsdGFsdW5lLnYxLk9BdXRoQ2xpZW50Eg8KB21lc3NhZ2UYAiABKAkiMwoYRGVsZXRlT0F1dGhDbGllbnRSZXF1ZXN0EhcKAmlkGAEgASgJQgu6SAjIAQFyA5gBDiIsChlEZWxldGVPQXV0aENsaWVudFJlc3BvbnNlEg8KB21lc3NhZ2UYASABKAkiOQoeUmV2ZWFsT0F1dGhDbGllbnRTZWNyZXRSZXF1ZXN0EhcKAmlkGAEgASgJQgu6SAjIAQFyA5gBDiJJCh9SZXZlYWxPQXV0aENsaWVudFNlY3JldFJlc3BvbnNlEhUKDWNsaWVudF9zZWNyZXQYASABKAkSDwoHbWVzc2FnZRgCIAEoCTKBBQoST0F1dGhDbGllbnRTZXJ2aWNlEmQKEUNyZWF0ZU9BdXRoQ2xpZW50EiUuYWx0YWx1bmUudjEuQ3JlYXRlT0F1dGhDbGllbnRSZXF1ZXN0GiYuYWx0YWx1bmUudjEuQ3JlYXRlT0F1dGhDbGllbnRSZXNwb25zZSIAEmQKEVF1ZXJ5T0F1dGhDbGllbnRzEiUuYWx0YWx1bmUudjEuUXVlcnlPQXV0aENsaWVudHNSZXF1ZXN0GiYuYWx0YWx1bmUudjEuUXVlcnlPQXV0aENsaWVudHNSZXNwb25zZSIAElsKDkdldE9BdXRoQ2xpZW50EiIuYWx0YWx1bmUudjEuR2V0T0F1dGhDbGllbnRSZXF1ZXN0GiMuYWx0YWx1bmUudjEuR2V0T0F1dGhDbGllbnRSZXNwb25zZSIAEmQKEVVwZGF0ZU9BdXRoQ2xpZW50EiUuYWx0YWx1bmUudjEuVXBkYXRlT0F1dGhDbGllbnRSZXF1ZXN0GiYuYWx0YWx1bmUudjEuVXBkYXRlT0F1dGhDbGllbnRSZXNwb25zZSIAEmQKEURlbGV0ZU9BdXRoQ2xpZW50EiUuYWx0YWx1bmUudjEuRGVsZXRlT0F1dGhDbGllbnRSZXF1ZXN0GiYuYWx0YWx1bmUudjEuRGVsZXRlT0F1dGhDbGllbnRSZXNwb25zZSIAEnYKF1JldmVhbE9BdXRoQ2xpZW50U2VjcmV0EisuYWx0YWx1bmUudjEuUmV2ZWFsT0F1dGhDbGllbnRTZWNyZXRSZXF1ZXN0GiwuYWx0YWx1bmUudjEuUmV2ZWFsT0F1dGhDbGllbnRTZWNyZXRSZXNwb25zZSIAQqUBCg9jb20uYWx0YWx1bmUudjFCEE9hdXRoQ2xpZW50UHJvdG9QAVozZ2l0aHViLmNvbS9ocno4L2FsdGFsdW5lL2dlbi9hbHRhbHVuZS92MTthbHRhbHVuZXYxogIDQVhYqgILQWx0YWx1bmUuVjHKAgtBbHRhbHVuZVxWMeICF0FsdGFsdW5lXFYxXEdQQk1ldGFkYXRh6gIMQWx0YWx1bmU6OlYxYgZwcm90bzM", [file_google_protobuf_timestamp, file_buf_validate_validate, file_altalune_v1_common]);
+  fileDesc("Ch5hbHRhbHVuZS92MS9vYXV0aF9jbGllbnQucHJvdG8SC2FsdGFsdW5lLnYxItADCgtPQXV0aENsaWVudBIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhEKCWNsaWVudF9pZBgDIAEoCRIVCg1yZWRpcmVjdF91cmlzGAQgAygJEhUKDXBrY2VfcmVxdWlyZWQYBSABKAgSEgoKaXNfZGVmYXVsdBgGIAEoCBIZChFjbGllbnRfc2VjcmV0X3NldBgHIAEoCBIWCg5hbGxvd2VkX3Njb3BlcxgIIAMoCRIUCgxjb25maWRlbnRpYWwYCSABKAgSLgoKZGVsZXRlZF9hdBgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEgoKY3JlYXRlZF9ieRgLIAEoCRISCgp1cGRhdGVkX2J5GAwgASgJEhMKC2V4dGVybmFsX2lkGA0gASgJEjwKGGNsaWVudF9zZWNyZXRfZXhwaXJlc19hdBgOIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKY3JlYXRlZF9hdBhiIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBhjIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAinAIKGENyZWF0ZU9BdXRoQ2xpZW50UmVxdWVzdBIvCgRuYW1lGAEgASgJQiG6SB7IAQFyGRABGGQyE15bYS16QS1aMC05XHNcLV9dKyQSKwoNcmVkaXJlY3RfdXJpcxgCIAMoCUIUukgRkgEOCAEQCiIIcgYY9AOIAQESFQoNcGtjZV9yZXF1aXJlZBgDIAEoCBIWCg5hbGxvd2VkX3Njb3BlcxgEIAMoCRIUCgxjb25maWRlbnRpYWwYBSABKAgSJgoUcmVjaXBpZW50X3B1YmxpY19rZXkYBiABKAlCCLpIBXIDGIAgEjUKC2V4dGVybmFsX2lkGAcgASgJQiC6SB3YAQFyGBhkMhReW0EtWmEtejAtOS5fOi9ALV0rJCKsAQoZQ3JlYXRlT0F1dGhDbGllbnRSZXNwb25zZRIoCgZjbGllbnQYASABKAsyGC5hbHRhbHVuZS52MS5PQXV0aENsaWVudBIVCg1jbGllbnRfc2VjcmV0GAIgASgJEg8KB21lc3NhZ2UYAyABKAkSPQoXZGVsaXZlcmVkX2NsaWVudF9zZWNyZXQYBCABKAsyHC5hbHRhbHVuZS52MS5EZWxpdmVyZWRTZWNyZXQiVQoYUXVlcnlPQXV0aENsaWVudHNSZXF1ZXN0EigKBXF1ZXJ5GAEgASgLMhkuYWx0YWx1bmUudjEuUXVlcnlSZXF1ZXN0Eg8KB3RyYXNoZWQYAiABKAgihQEKGVF1ZXJ5T0F1dGhDbGllbnRzUmVzcG9uc2USKQoHY2xpZW50cxgBIAMoCzIYLmFsdGFsdW5lLnYxLk9BdXRoQ2xpZW50EiwKBG1ldGEYAiABKAsyHi5hbHRhbHVuZS52MS5RdWVyeU1ldGFSZXNwb25zZRIPCgdtZXNzYWdlGAMgASgJIjAKFUdldE9BdXRoQ2xpZW50UmVxdWVzdBIXCgJpZBgBIAEoCUILukgIyAEBcgOYAQ4iUwoWR2V0T0F1dGhDbGllbnRSZXNwb25zZRIoCgZjbGllbnQYASABKAsyGC5hbHRhbHVuZS52MS5PQXV0aENsaWVudBIPCgdtZXNzYWdlGAIgASgJIvABChhVcGRhdGVPQXV0aENsaWVudFJlcXVlc3QSFwoCaWQYASABKAlCC7pICMgBAXIDmAEOEhwKBG5hbWUYAiABKAlCCbpIBnIEEAEYZEgAiAEBEhUKDXJlZGlyZWN0X3VyaXMYAyADKAkSGgoNcGtjZV9yZXF1aXJlZBgEIAEoCEgBiAEBEhYKDmFsbG93ZWRfc2NvcGVzGAUgAygJEjcKE2V4cGVjdGVkX3VwZGF0ZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgcKBV9uYW1lQhAKDl9wa2NlX3JlcXVpcmVkIlYKGVVwZGF0ZU9BdXRoQ2xpZW50UmVzcG9uc2USKAoGY2xpZW50GAEgASgLMhguYWx0YWx1bmUudjEuT0F1dGhDbGllbnQSDwoHbWVzc2FnZRgCIAEoCSIzChhEZWxldGVPQXV0aENsaWVudFJlcXVlc3QSFwoCaWQYASABKAlCC7pICMgBAXIDmAEOIiwKGURlbGV0ZU9BdXRoQ2xpZW50UmVzcG9uc2USDwoHbWVzc2FnZRgBIAEoCSI0ChlSZXN0b3JlT0F1dGhDbGllbnRSZXF1ZXN0EhcKAmlkGAEgASgJQgu6SAjIAQFyA5gBDiJXChpSZXN0b3JlT0F1dGhDbGllbnRSZXNwb25zZRIoCgZjbGllbnQYASABKAsyGC5hbHRhbHVuZS52MS5PQXV0aENsaWVudBIPCgdtZXNzYWdlGAIgASgJIpsCChdBcHBseU9BdXRoQ2xpZW50UmVxdWVzdBI1CgtleHRlcm5hbF9pZBgBIAEoCUIgukgdyAEBchgYZDIUXltBLVphLXowLTkuXzovQC1dKyQSLwoEbmFtZRgCIAEoCUIhukgeyAEBchkQARhkMhNeW2EtekEtWjAtOVxzXC1fXSskEisKDXJlZGlyZWN0X3VyaXMYAyADKAlCFLpIEZIBDggBEAoiCHIGGPQDiAEBEhUKDXBrY2VfcmVxdWlyZWQYBCABKAgSFgoOYWxsb3dlZF9zY29wZXMYBSADKAkSFAoMY29uZmlkZW50aWFsGAYgASgIEiYKFHJlY2lwaWVudF9wdWJsaWNfa2V5GAcgASgJQgi6SAVyAxiAICK8AQoYQXBwbHlPQXV0aENsaWVudFJlc3BvbnNlEigKBmNsaWVudBgBIAEoCzIYLmFsdGFsdW5lLnYxLk9BdXRoQ2xpZW50Eg8KB2NyZWF0ZWQYAiABKAgSFQoNY2xpZW50X3NlY3JldBgDIAEoCRI9ChdkZWxpdmVyZWRfY2xpZW50X3NlY3JldBgEIAEoCzIcLmFsdGFsdW5lLnYxLkRlbGl2ZXJlZFNlY3JldBIPCgdtZXNzYWdlGAUgASgJIm0KGEltcG9ydE9BdXRoQ2xpZW50UmVxdWVzdBIaCgRuYW1lGAEgASgJQgy6SAnIAQFyBBABGGQSNQoLZXh0ZXJuYWxfaWQYAiABKAlCILpIHcgBAXIYGGQyFF5bQS1aYS16MC05Ll86L0AtXSskIlYKGUltcG9ydE9BdXRoQ2xpZW50UmVzcG9uc2USKAoGY2xpZW50GAEgASgLMhguYWx0YWx1bmUudjEuT0F1dGhDbGllbnQSDwoHbWVzc2FnZRgCIAEoCSI5Ch5SZXZlYWxPQXV0aENsaWVudFNlY3JldFJlcXVlc3QSFwoCaWQYASABKAlCC7pICMgBAXIDmAEOIkkKH1JldmVhbE9BdXRoQ2xpZW50U2VjcmV0UmVzcG9uc2USFQoNY2xpZW50X3NlY3JldBgBIAEoCRIPCgdtZXNzYWdlGAIgASgJImEKHlJvdGF0ZU9BdXRoQ2xpZW50U2VjcmV0UmVxdWVzdBIXCgJpZBgBIAEoCUILukgIyAEBcgOYAQ4SJgoUcmVjaXBpZW50X3B1YmxpY19rZXkYAiABKAlCCLpIBXIDGIAgIrIBCh9Sb3RhdGVPQXV0aENsaWVudFNlY3JldFJlc3BvbnNlEigKBmNsaWVudBgBIAEoCzIYLmFsdGFsdW5lLnYxLk9BdXRoQ2xpZW50EhUKDWNsaWVudF9zZWNyZXQYAiABKAkSDwoHbWVzc2FnZRgDIAEoCRI9ChdkZWxpdmVyZWRfY2xpZW50X3NlY3JldBgEIAEoCzIcLmFsdGFsdW5lLnYxLkRlbGl2ZXJlZFNlY3JldCLqAgoMUmVmcmVzaFRva2VuEgoKAmlkGAEgASgDEg8KB3VzZXJfaWQYAiABKAkSEgoKdXNlcl9lbWFpbBgDIAEoCRIRCgljbGllbnRfaWQYBCABKAkSEwoLY2xpZW50X25hbWUYBSABKAkSDgoGc2NvcGVzGAYgAygJEi8KBnN0YXR1cxgHIAEoDjIfLmFsdGFsdW5lLnYxLlJlZnJlc2hUb2tlblN0YXR1cxIuCgpleHBpcmVzX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIwCgxleGNoYW5nZWRfYXQYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnJldm9rZWRfYXQYCiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCmNyZWF0ZWRfYXQYYiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIoMBChlRdWVyeVJlZnJlc2hUb2tlbnNSZXF1ZXN0EjAKBXF1ZXJ5GAEgASgLMhkuYWx0YWx1bmUudjEuUXVlcnlSZXF1ZXN0Qga6SAPIAQESGAoHdXNlcl9pZBgCIAEoCUIHukgEcgIYFBIaCgljbGllbnRfaWQYAyABKAlCB7pIBHICGA4ihgEKGlF1ZXJ5UmVmcmVzaFRva2Vuc1Jlc3BvbnNlEikKBnRva2VucxgBIAMoCzIZLmFsdGFsdW5lLnYxLlJlZnJlc2hUb2tlbhIsCgRtZXRhGAIgASgLMh4uYWx0YWx1bmUudjEuUXVlcnlNZXRhUmVzcG9uc2USDwoHbWVzc2FnZRgDIAEoCSIwChlSZXZva2VSZWZyZXNoVG9rZW5SZXF1ZXN0EhMKAmlkGAEgASgDQge6SAQiAiAAImgKGlJldm9rZVJlZnJlc2hUb2tlblJlc3BvbnNlEigKBXRva2VuGAEgASgLMhkuYWx0YWx1bmUudjEuUmVmcmVzaFRva2VuEg8KB3Jldm9rZWQYAiABKAgSDwoHbWVzc2FnZRgDIAEoCSJcChBUb2tlblN0YXRzQnVja2V0EigKBGhvdXIYASABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg4KBmlzc3VlZBgCIAEoAxIOCgZmYWlsZWQYAyABKAMiVQofR2V0T0F1dGhDbGllbnRUb2tlblN0YXRzUmVxdWVzdBIXCgJpZBgBIAEoCUILukgIyAEBcgOYAQ4SGQoFaG91cnMYAiABKAVCCrpIBxoFGNAFKAAijwEKIEdldE9BdXRoQ2xpZW50VG9rZW5TdGF0c1Jlc3BvbnNlEi4KB2J1Y2tldHMYASADKAsyHS5hbHRhbHVuZS52MS5Ub2tlblN0YXRzQnVja2V0EhQKDHRvdGFsX2lzc3VlZBgCIAEoAxIUCgx0b3RhbF9mYWlsZWQYAyABKAMSDwoHbWVzc2FnZRgEIAEoCSJpChZPQXV0aENsaWVudFRva2VuQ2xhaW1zEjAKC3Blcm1zX2NsYWltGAEgASgOMhsuYWx0YWx1bmUudjEuUGVybXNDbGFpbU1vZGUSHQoVcGVybXNfY2xhaW1fbWF4X2J5dGVzGAIgASgFIjsKIEdldE9BdXRoQ2xpZW50VG9rZW5DbGFpbXNSZXF1ZXN0EhcKAmlkGAEgASgJQgu6SAjIAQFyA5gBDiJeCiFHZXRPQXV0aENsaWVudFRva2VuQ2xhaW1zUmVzcG9uc2USOQoMdG9rZW5fY2xhaW1zGAEgASgLMiMuYWx0YWx1bmUudjEuT0F1dGhDbGllbnRUb2tlbkNsYWltcyKmAQojVXBkYXRlT0F1dGhDbGllbnRUb2tlbkNsYWltc1JlcXVlc3QSFwoCaWQYASABKAlCC7pICMgBAXIDmAEOEjoKC3Blcm1zX2NsYWltGAIgASgOMhsuYWx0YWx1bmUudjEuUGVybXNDbGFpbU1vZGVCCLpIBYIBAhABEioKFXBlcm1zX2NsYWltX21heF9ieXRlcxgDIAEoBUILukgIGgYYgIAEKAAicgokVXBkYXRlT0F1dGhDbGllbnRUb2tlbkNsYWltc1Jlc3BvbnNlEjkKDHRva2VuX2NsYWltcxgBIAEoCzIjLmFsdGFsdW5lLnYxLk9BdXRoQ2xpZW50VG9rZW5DbGFpbXMSDwoHbWVzc2FnZRgCIAEoCSLqAQocVGVzdEF1dGhvcml6YXRpb25GbG93UmVxdWVzdBIfCgpwcm9qZWN0X2lkGAEgASgJQgu6SAjIAQFyA5gBDhIXCgJpZBgCIAEoCUILukgIyAEBcgOYAQ4SIQoMcmVkaXJlY3RfdXJpGAMgASgJQgu6SAjIAQFyAxj0AxIXCgVzY29wZRgEIAEoCUIIukgFcgMY9AMSMwoVY29kZV9jaGFsbGVuZ2VfbWV0aG9kGAUgASgJQhS6SBFyD1IAUgRTMjU2UgVwbGFpbhIfCg1jbGllbnRfc2VjcmV0GAYgASgJQgi6SAVyAxiAASKAAQoVQXV0aG9yaXphdGlvbkZsb3dTdGVwEgwKBG5hbWUYASABKAkSOgoHb3V0Y29tZRgCIAEoDjIpLmFsdGFsdW5lLnYxLkF1dGhvcml6YXRpb25GbG93U3RlcE91dGNvbWUSDgoGZGV0YWlsGAMgASgJEg0KBWVycm9yGAQgASgJIoYBCh1UZXN0QXV0aG9yaXphdGlvbkZsb3dSZXNwb25zZRIRCglzdWNjZWVkZWQYASABKAgSMQoFc3RlcHMYAiADKAsyIi5hbHRhbHVuZS52MS5BdXRob3JpemF0aW9uRmxvd1N0ZXASDgoGY2xhaW1zGAMgAygJEg8KB21lc3NhZ2UYBCABKAkqwwEKElJlZnJlc2hUb2tlblN0YXR1cxIkCiBSRUZSRVNIX1RPS0VOX1NUQVRVU19VTlNQRUNJRklFRBAAEh8KG1JFRlJFU0hfVE9LRU5fU1RBVFVTX0FDVElWRRABEiIKHlJFRlJFU0hfVE9LRU5fU1RBVFVTX0VYQ0hBTkdFRBACEiAKHFJFRlJFU0hfVE9LRU5fU1RBVFVTX1JFVk9LRUQQAxIgChxSRUZSRVNIX1RPS0VOX1NUQVRVU19FWFBJUkVEEAQqhAEKDlBlcm1zQ2xhaW1Nb2RlEiAKHFBFUk1TX0NMQUlNX01PREVfVU5TUEVDSUZJRUQQABIZChVQRVJNU19DTEFJTV9NT0RFX0ZVTEwQARIZChVQRVJNU19DTEFJTV9NT0RFX09NSVQQAhIaChZQRVJNU19DTEFJTV9NT0RFX1JPTEVTEAMqgQIKHEF1dGhvcml6YXRpb25GbG93U3RlcE91dGNvbWUSLworQVVUSE9SSVpBVElPTl9GTE9XX1NURVBfT1VUQ09NRV9VTlNQRUNJRklFRBAAEioKJkFVVEhPUklaQVRJT05fRkxPV19TVEVQX09VVENPTUVfUEFTU0VEEAESKwonQVVUSE9SSVpBVElPTl9GTE9XX1NURVBfT1VUQ09NRV9XQVJOSU5HEAISKgomQVVUSE9SSVpBVElPTl9GTE9XX1NURVBfT1VUQ09NRV9GQUlMRUQQAxIrCidBVVRIT1JJWkFUSU9OX0ZMT1dfU1RFUF9PVVRDT01FX1NLSVBQRUQQBDLxDwoST0F1dGhDbGllbnRTZXJ2aWNlEnQKEUNyZWF0ZU9BdXRoQ2xpZW50EiUuYWx0YWx1bmUudjEuQ3JlYXRlT0F1dGhDbGllbnRSZXF1ZXN0GiYuYWx0YWx1bmUudjEuQ3JlYXRlT0F1dGhDbGllbnRSZXNwb25zZSIQirUYDGNsaWVudDp3cml0ZRJzChFRdWVyeU9BdXRoQ2xpZW50cxIlLmFsdGFsdW5lLnYxLlF1ZXJ5T0F1dGhDbGllbnRzUmVxdWVzdBomLmFsdGFsdW5lLnYxLlF1ZXJ5T0F1dGhDbGllbnRzUmVzcG9uc2UiD4q1GAtjbGllbnQ6cmVhZBJqCg5HZXRPQXV0aENsaWVudBIiLmFsdGFsdW5lLnYxLkdldE9BdXRoQ2xpZW50UmVxdWVzdBojLmFsdGFsdW5lLnYxLkdldE9BdXRoQ2xpZW50UmVzcG9uc2UiD4q1GAtjbGllbnQ6cmVhZBJ0ChFVcGRhdGVPQXV0aENsaWVudBIlLmFsdGFsdW5lLnYxLlVwZGF0ZU9BdXRoQ2xpZW50UmVxdWVzdBomLmFsdGFsdW5lLnYxLlVwZGF0ZU9BdXRoQ2xpZW50UmVzcG9uc2UiEIq1GAxjbGllbnQ6d3JpdGUSdQoRRGVsZXRlT0F1dGhDbGllbnQSJS5hbHRhbHVuZS52MS5EZWxldGVPQXV0aENsaWVudFJlcXVlc3QaJi5hbHRhbHVuZS52MS5EZWxldGVPQXV0aENsaWVudFJlc3BvbnNlIhGKtRgNY2xpZW50OmRlbGV0ZRJ4ChJSZXN0b3JlT0F1dGhDbGllbnQSJi5hbHRhbHVuZS52MS5SZXN0b3JlT0F1dGhDbGllbnRSZXF1ZXN0GicuYWx0YWx1bmUudjEuUmVzdG9yZU9BdXRoQ2xpZW50UmVzcG9uc2UiEYq1GA1jbGllbnQ6ZGVsZXRlEoUBChdSZXZlYWxPQXV0aENsaWVudFNlY3JldBIrLmFsdGFsdW5lLnYxLlJldmVhbE9BdXRoQ2xpZW50U2VjcmV0UmVxdWVzdBosLmFsdGFsdW5lLnYxLlJldmVhbE9BdXRoQ2xpZW50U2VjcmV0UmVzcG9uc2UiD4q1GAtjbGllbnQ6cmVhZBKGAQoXUm90YXRlT0F1dGhDbGllbnRTZWNyZXQSKy5hbHRhbHVuZS52MS5Sb3RhdGVPQXV0aENsaWVudFNlY3JldFJlcXVlc3QaLC5hbHRhbHVuZS52MS5Sb3RhdGVPQXV0aENsaWVudFNlY3JldFJlc3BvbnNlIhCKtRgMY2xpZW50OndyaXRlEnYKElF1ZXJ5UmVmcmVzaFRva2VucxImLmFsdGFsdW5lLnYxLlF1ZXJ5UmVmcmVzaFRva2Vuc1JlcXVlc3QaJy5hbHRhbHVuZS52MS5RdWVyeVJlZnJlc2hUb2tlbnNSZXNwb25zZSIPirUYC2NsaWVudDpyZWFkEncKElJldm9rZVJlZnJlc2hUb2tlbhImLmFsdGFsdW5lLnYxLlJldm9rZVJlZnJlc2hUb2tlblJlcXVlc3QaJy5hbHRhbHVuZS52MS5SZXZva2VSZWZyZXNoVG9rZW5SZXNwb25zZSIQirUYDGNsaWVudDp3cml0ZRKIAQoYR2V0T0F1dGhDbGllbnRUb2tlblN0YXRzEiwuYWx0YWx1bmUudjEuR2V0T0F1dGhDbGllbnRUb2tlblN0YXRzUmVxdWVzdBotLmFsdGFsdW5lLnYxLkdldE9BdXRoQ2xpZW50VG9rZW5TdGF0c1Jlc3BvbnNlIg+KtRgLY2xpZW50OnJlYWQSiwEKGUdldE9BdXRoQ2xpZW50VG9rZW5DbGFpbXMSLS5hbHRhbHVuZS52MS5HZXRPQXV0aENsaWVudFRva2VuQ2xhaW1zUmVxdWVzdBouLmFsdGFsdW5lLnYxLkdldE9BdXRoQ2xpZW50VG9rZW5DbGFpbXNSZXNwb25zZSIPirUYC2NsaWVudDpyZWFkEpUBChxVcGRhdGVPQXV0aENsaWVudFRva2VuQ2xhaW1zEjAuYWx0YWx1bmUudjEuVXBkYXRlT0F1dGhDbGllbnRUb2tlbkNsYWltc1JlcXVlc3QaMS5hbHRhbHVuZS52MS5VcGRhdGVPQXV0aENsaWVudFRva2VuQ2xhaW1zUmVzcG9uc2UiEIq1GAxjbGllbnQ6d3JpdGUScQoQQXBwbHlPQXV0aENsaWVudBIkLmFsdGFsdW5lLnYxLkFwcGx5T0F1dGhDbGllbnRSZXF1ZXN0GiUuYWx0YWx1bmUudjEuQXBwbHlPQXV0aENsaWVudFJlc3BvbnNlIhCKtRgMY2xpZW50OndyaXRlEnQKEUltcG9ydE9BdXRoQ2xpZW50EiUuYWx0YWx1bmUudjEuSW1wb3J0T0F1dGhDbGllbnRSZXF1ZXN0GiYuYWx0YWx1bmUudjEuSW1wb3J0T0F1dGhDbGllbnRSZXNwb25zZSIQirUYDGNsaWVudDp3cml0ZRKAAQoVVGVzdEF1dGhvcml6YXRpb25GbG93EikuYWx0YWx1bmUudjEuVGVzdEF1dGhvcml6YXRpb25GbG93UmVxdWVzdBoqLmFsdGFsdW5lLnYxLlRlc3RBdXRob3JpemF0aW9uRmxvd1Jlc3BvbnNlIhCKtRgMY2xpZW50OndyaXRlQqUBCg9jb20uYWx0YWx1bmUudjFCEE9hdXRoQ2xpZW50UHJvdG9QAVozZ2l0aHViLmNvbS9ocno4L2FsdGFsdW5lL2dlbi9hbHRhbHVuZS92MTthbHRhbHVuZXYxogIDQVhYqgILQWx0YWx1bmUuVjHKAgtBbHRhbHVuZVxWMeICF0FsdGFsdW5lXFYxXEdQQk1ldGFkYXRh6gIMQWx0YWx1bmU6OlYxYgZwcm90bzM", [file_google_protobuf_timestamp, file_buf_validate_validate, file_altalune_v1_common, file_altalune_v1_options]);
 
 /**
  * OAuth Client Message
@@ -80,6 +81,41 @@ export type OAuthClient = Message<"altalune.v1.OAuthClient"> & {
    */
   confidential: boolean;
 
+  /**
+   * Set only for clients in the trash
+   *
+   * @generated from field: google.protobuf.Timestamp deleted_at = 10;
+   */
+  deletedAt?: Timestamp;
+
+  /**
+   * Public ID of the user who created the client, empty if unknown
+   *
+   * @generated from field: string created_by = 11;
+   */
+  createdBy: string;
+
+  /**
+   * Public ID of the user who last updated the client, empty if unknown
+   *
+   * @generated from field: string updated_by = 12;
+   */
+  updatedBy: string;
+
+  /**
+   * Key of the client in a provisioning tool, empty if unmanaged
+   *
+   * @generated from field: string external_id = 13;
+   */
+  externalId: string;
+
+  /**
+   * When the secret stops authenticating the client, unset for never
+   *
+   * @generated from field: google.protobuf.Timestamp client_secret_expires_at = 14;
+   */
+  clientSecretExpiresAt?: Timestamp;
+
   /**
    * @generated from field: google.protobuf.Timestamp created_at = 98;
    */
@@ -132,6 +168,21 @@ export type CreateOAuthClientRequest = Message<"altalune.v1.CreateOAuthClientReq
    * @generated from field: bool confidential = 5;
    */
   confidential: boolean;
+
+  /**
+   * PEM RSA public key to encrypt the client secret to, required when the
+   * default project delivers secrets to public keys
+   *
+   * @generated from field: string recipient_public_key = 6;
+   */
+  recipientPublicKey: string;
+
+  /**
+   * Key of the client in a provisioning tool, unique among clients
+   *
+   * @generated from field: string external_id = 7;
+   */
+  externalId: string;
 };
 
 /**
@@ -151,7 +202,7 @@ export type CreateOAuthClientResponse = Message<"altalune.v1.CreateOAuthClientRe
   client?: OAuthClient;
 
   /**
-   * ONLY returned during creation
+   * ONLY returned during creation, empty when delivered
    *
    * @generated from field: string client_secret = 2;
    */
@@ -161,6 +212,13 @@ export type CreateOAuthClientResponse = Message<"altalune.v1.CreateOAuthClientRe
    * @generated from field: string message = 3;
    */
   message: string;
+
+  /**
+   * Set instead of client_secret when the policy forbids plaintext
+   *
+   * @generated from field: altalune.v1.DeliveredSecret delivered_client_secret = 4;
+   */
+  deliveredClientSecret?: DeliveredSecret;
 };
 
 /**
@@ -180,6 +238,13 @@ export type QueryOAuthClientsRequest = Message<"altalune.v1.QueryOAuthClientsReq
    * @generated from field: altalune.v1.QueryRequest query = 1;
    */
   query?: QueryRequest;
+
+  /**
+   * List deleted clients instead of live ones
+   *
+   * @generated from field: bool trashed = 2;
+   */
+  trashed: boolean;
 };
 
 /**
@@ -287,6 +352,14 @@ export type UpdateOAuthClientRequest = Message<"altalune.v1.UpdateOAuthClientReq
    * @generated from field: repeated string allowed_scopes = 5;
    */
   allowedScopes: string[];
+
+  /**
+   * updated_at of the client the edit is based on. When set, the update is
+   * rejected with FAILED_PRECONDITION if the client changed since then.
+   *
+   * @generated from field: google.protobuf.Timestamp expected_updated_at = 6;
+   */
+  expectedUpdatedAt?: Timestamp;
 };
 
 /**
@@ -354,6 +427,195 @@ export type DeleteOAuthClientResponse = Message<"altalune.v1.DeleteOAuthClientRe
 export const DeleteOAuthClientResponseSchema: GenMessage<DeleteOAuthClientResponse> = /*@__PURE__*/
   messageDesc(file_altalune_v1_oauth_client, 10);
 
+/**
+ * Restore OAuth Client Request (Global - no project_id needed)
+ *
+ * @generated from message altalune.v1.RestoreOAuthClientRequest
+ */
+export type RestoreOAuthClientRequest = Message<"altalune.v1.RestoreOAuthClientRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message altalune.v1.RestoreOAuthClientRequest.
+ * Use `create(RestoreOAuthClientRequestSchema)` to create a new message.
+ */
+export const RestoreOAuthClientRequestSchema: GenMessage<RestoreOAuthClientRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_oauth_client, 11);
+
+/**
+ * @generated from message altalune.v1.RestoreOAuthClientResponse
+ */
+export type RestoreOAuthClientResponse = Message<"altalune.v1.RestoreOAuthClientResponse"> & {
+  /**
+   * @generated from field: altalune.v1.OAuthClient client = 1;
+   */
+  client?: OAuthClient;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.RestoreOAuthClientResponse.
+ * Use `create(RestoreOAuthClientResponseSchema)` to create a new message.
+ */
+export const RestoreOAuthClientResponseSchema: GenMessage<RestoreOAuthClientResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_oauth_client, 12);
+
+/**
+ * Apply OAuth Client Request - creates the client with the external ID, or
+ * brings the existing one, restored from the trash, to the given fields.
+ * Applying the same request again changes nothing.
+ *
+ * @generated from message altalune.v1.ApplyOAuthClientRequest
+ */
+export type ApplyOAuthClientRequest = Message<"altalune.v1.ApplyOAuthClientRequest"> & {
+  /**
+   * @generated from field: string external_id = 1;
+   */
+  externalId: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: repeated string redirect_uris = 3;
+   */
+  redirectUris: string[];
+
+  /**
+   * Always true for public clients
+   *
+   * @generated from field: bool pkce_required = 4;
+   */
+  pkceRequired: boolean;
+
+  /**
+   * @generated from field: repeated string allowed_scopes = 5;
+   */
+  allowedScopes: string[];
+
+  /**
+   * Cannot change once the client exists
+   *
+   * @generated from field: bool confidential = 6;
+   */
+  confidential: boolean;
+
+  /**
+   * PEM RSA public key to encrypt the client secret to when the client is
+   * created, see CreateOAuthClientRequest
+   *
+   * @generated from field: string recipient_public_key = 7;
+   */
+  recipientPublicKey: string;
+};
+
+/**
+ * Describes the message altalune.v1.ApplyOAuthClientRequest.
+ * Use `create(ApplyOAuthClientRequestSchema)` to create a new message.
+ */
+export const ApplyOAuthClientRequestSchema: GenMessage<ApplyOAuthClientRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_oauth_client, 13);
+
+/**
+ * @generated from message altalune.v1.ApplyOAuthClientResponse
+ */
+export type ApplyOAuthClientResponse = Message<"altalune.v1.ApplyOAuthClientResponse"> & {
+  /**
+   * @generated from field: altalune.v1.OAuthClient client = 1;
+   */
+  client?: OAuthClient;
+
+  /**
+   * Whether the client was created rather than updated
+   *
+   * @generated from field: bool created = 2;
+   */
+  created: boolean;
+
+  /**
+   * ONLY returned when created, empty when delivered
+   *
+   * @generated from field: string client_secret = 3;
+   */
+  clientSecret: string;
+
+  /**
+   * Set instead of client_secret when the policy forbids plaintext
+   *
+   * @generated from field: altalune.v1.DeliveredSecret delivered_client_secret = 4;
+   */
+  deliveredClientSecret?: DeliveredSecret;
+
+  /**
+   * @generated from field: string message = 5;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.ApplyOAuthClientResponse.
+ * Use `create(ApplyOAuthClientResponseSchema)` to create a new message.
+ */
+export const ApplyOAuthClientResponseSchema: GenMessage<ApplyOAuthClientResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_oauth_client, 14);
+
+/**
+ * Import OAuth Client Request - sets the external ID of the one live client
+ * with the name, so a provisioning tool can manage a client it did not create
+ *
+ * @generated from message altalune.v1.ImportOAuthClientRequest
+ */
+export type ImportOAuthClientRequest = Message<"altalune.v1.ImportOAuthClientRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string external_id = 2;
+   */
+  externalId: string;
+};
+
+/**
+ * Describes the message altalune.v1.ImportOAuthClientRequest.
+ * Use `create(ImportOAuthClientRequestSchema)` to create a new message.
+ */
+export const ImportOAuthClientRequestSchema: GenMessage<ImportOAuthClientRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_oauth_client, 15);
+
+/**
+ * @generated from message altalune.v1.ImportOAuthClientResponse
+ */
+export type ImportOAuthClientResponse = Message<"altalune.v1.ImportOAuthClientResponse"> & {
+  /**
+   * @generated from field: altalune.v1.OAuthClient client = 1;
+   */
+  client?: OAuthClient;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.ImportOAuthClientResponse.
+ * Use `create(ImportOAuthClientResponseSchema)` to create a new message.
+ */
+export const ImportOAuthClientResponseSchema: GenMessage<ImportOAuthClientResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_oauth_client, 16);
+
 /**
  * Reveal OAuth Client Secret Request (Global - no project_id needed)
  *
@@ -371,7 +633,7 @@ export type RevealOAuthClientSecretRequest = Message<"altalune.v1.RevealOAuthCli
  * Use `create(RevealOAuthClientSecretRequestSchema)` to create a new message.
  */
 export const RevealOAuthClientSecretRequestSchema: GenMessage<RevealOAuthClientSecretRequest> = /*@__PURE__*/
-  messageDesc(file_altalune_v1_oauth_client, 11);
+  messageDesc(file_altalune_v1_oauth_client, 17);
 
 /**
  * @generated from message altalune.v1.RevealOAuthClientSecretResponse
@@ -393,62 +655,862 @@ export type RevealOAuthClientSecretResponse = Message<"altalune.v1.RevealOAuthCl
  * Use `create(RevealOAuthClientSecretResponseSchema)` to create a new message.
  */
 export const RevealOAuthClientSecretResponseSchema: GenMessage<RevealOAuthClientSecretResponse> = /*@__PURE__*/
-  messageDesc(file_altalune_v1_oauth_client, 12);
+  messageDesc(file_altalune_v1_oauth_client, 18);
 
 /**
- * OAuth Client Service - Manage OAuth client applications
+ * @generated from message altalune.v1.RotateOAuthClientSecretRequest
+ */
+export type RotateOAuthClientSecretRequest = Message<"altalune.v1.RotateOAuthClientSecretRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * PEM RSA public key to encrypt the new secret to, required when the
+   * default project delivers secrets to public keys
+   *
+   * @generated from field: string recipient_public_key = 2;
+   */
+  recipientPublicKey: string;
+};
+
+/**
+ * Describes the message altalune.v1.RotateOAuthClientSecretRequest.
+ * Use `create(RotateOAuthClientSecretRequestSchema)` to create a new message.
+ */
+export const RotateOAuthClientSecretRequestSchema: GenMessage<RotateOAuthClientSecretRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_oauth_client, 19);
+
+/**
+ * @generated from message altalune.v1.RotateOAuthClientSecretResponse
+ */
+export type RotateOAuthClientSecretResponse = Message<"altalune.v1.RotateOAuthClientSecretResponse"> & {
+  /**
+   * @generated from field: altalune.v1.OAuthClient client = 1;
+   */
+  client?: OAuthClient;
+
+  /**
+   * ONLY returned once, empty when delivered
+   *
+   * @generated from field: string client_secret = 2;
+   */
+  clientSecret: string;
+
+  /**
+   * @generated from field: string message = 3;
+   */
+  message: string;
+
+  /**
+   * Set instead of client_secret when the policy forbids plaintext
+   *
+   * @generated from field: altalune.v1.DeliveredSecret delivered_client_secret = 4;
+   */
+  deliveredClientSecret?: DeliveredSecret;
+};
+
+/**
+ * Describes the message altalune.v1.RotateOAuthClientSecretResponse.
+ * Use `create(RotateOAuthClientSecretResponseSchema)` to create a new message.
+ */
+export const RotateOAuthClientSecretResponseSchema: GenMessage<RotateOAuthClientSecretResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_oauth_client, 20);
+
+/**
+ * Refresh token issued to an OAuth client. Only a hash of the token is
+ * stored, so the token itself cannot be shown.
  *
- * @generated from service altalune.v1.OAuthClientService
+ * @generated from message altalune.v1.RefreshToken
  */
-export const OAuthClientService: GenService<{
+export type RefreshToken = Message<"altalune.v1.RefreshToken"> & {
   /**
-   * @generated from rpc altalune.v1.OAuthClientService.CreateOAuthClient
+   * Identifies the token for revocation
+   *
+   * @generated from field: int64 id = 1;
    */
-  createOAuthClient: {
-    methodKind: "unary";
-    input: typeof CreateOAuthClientRequestSchema;
-    output: typeof CreateOAuthClientResponseSchema;
-  },
+  id: bigint;
+
   /**
-   * @generated from rpc altalune.v1.OAuthClientService.QueryOAuthClients
+   * Public ID of the user
+   *
+   * @generated from field: string user_id = 2;
    */
-  queryOAuthClients: {
-    methodKind: "unary";
-    input: typeof QueryOAuthClientsRequestSchema;
-    output: typeof QueryOAuthClientsResponseSchema;
-  },
+  userId: string;
+
   /**
-   * @generated from rpc altalune.v1.OAuthClientService.GetOAuthClient
+   * @generated from field: string user_email = 3;
    */
-  getOAuthClient: {
-    methodKind: "unary";
-    input: typeof GetOAuthClientRequestSchema;
-    output: typeof GetOAuthClientResponseSchema;
-  },
+  userEmail: string;
+
   /**
-   * @generated from rpc altalune.v1.OAuthClientService.UpdateOAuthClient
+   * Public nanoid of the client, empty if it was purged
+   *
+   * @generated from field: string client_id = 4;
    */
-  updateOAuthClient: {
-    methodKind: "unary";
-    input: typeof UpdateOAuthClientRequestSchema;
-    output: typeof UpdateOAuthClientResponseSchema;
-  },
+  clientId: string;
+
   /**
-   * @generated from rpc altalune.v1.OAuthClientService.DeleteOAuthClient
+   * @generated from field: string client_name = 5;
    */
-  deleteOAuthClient: {
-    methodKind: "unary";
-    input: typeof DeleteOAuthClientRequestSchema;
-    output: typeof DeleteOAuthClientResponseSchema;
-  },
+  clientName: string;
+
   /**
-   * @generated from rpc altalune.v1.OAuthClientService.RevealOAuthClientSecret
+   * @generated from field: repeated string scopes = 6;
    */
-  revealOAuthClientSecret: {
-    methodKind: "unary";
+  scopes: string[];
+
+  /**
+   * @generated from field: altalune.v1.RefreshTokenStatus status = 7;
+   */
+  status: RefreshTokenStatus;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 8;
+   */
+  expiresAt?: Timestamp;
+
+  /**
+   * Set once exchanged or revoked
+   *
+   * @generated from field: google.protobuf.Timestamp exchanged_at = 9;
+   */
+  exchangedAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp revoked_at = 10;
+   */
+  revokedAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 98;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message altalune.v1.RefreshToken.
+ * Use `create(RefreshTokenSchema)` to create a new message.
+ */
+export const RefreshTokenSchema: GenMessage<RefreshToken> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_oauth_client, 21);
+
+/**
+ * Query Refresh Tokens Request, for the tokens of a user, a client or both.
+ * The "statuses" filter takes active, exchanged, revoked and expired; the
+ * keyword searches the user email and client name.
+ *
+ * @generated from message altalune.v1.QueryRefreshTokensRequest
+ */
+export type QueryRefreshTokensRequest = Message<"altalune.v1.QueryRefreshTokensRequest"> & {
+  /**
+   * @generated from field: altalune.v1.QueryRequest query = 1;
+   */
+  query?: QueryRequest;
+
+  /**
+   * Public ID of the user
+   *
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * Public nanoid of the client
+   *
+   * @generated from field: string client_id = 3;
+   */
+  clientId: string;
+};
+
+/**
+ * Describes the message altalune.v1.QueryRefreshTokensRequest.
+ * Use `create(QueryRefreshTokensRequestSchema)` to create a new message.
+ */
+export const QueryRefreshTokensRequestSchema: GenMessage<QueryRefreshTokensRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_oauth_client, 22);
+
+/**
+ * @generated from message altalune.v1.QueryRefreshTokensResponse
+ */
+export type QueryRefreshTokensResponse = Message<"altalune.v1.QueryRefreshTokensResponse"> & {
+  /**
+   * @generated from field: repeated altalune.v1.RefreshToken tokens = 1;
+   */
+  tokens: RefreshToken[];
+
+  /**
+   * @generated from field: altalune.v1.QueryMetaResponse meta = 2;
+   */
+  meta?: QueryMetaResponse;
+
+  /**
+   * @generated from field: string message = 3;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.QueryRefreshTokensResponse.
+ * Use `create(QueryRefreshTokensResponseSchema)` to create a new message.
+ */
+export const QueryRefreshTokensResponseSchema: GenMessage<QueryRefreshTokensResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_oauth_client, 23);
+
+/**
+ * Revoke Refresh Token Request. Revoking a token that is no longer active
+ * leaves it unchanged.
+ *
+ * @generated from message altalune.v1.RevokeRefreshTokenRequest
+ */
+export type RevokeRefreshTokenRequest = Message<"altalune.v1.RevokeRefreshTokenRequest"> & {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+};
+
+/**
+ * Describes the message altalune.v1.RevokeRefreshTokenRequest.
+ * Use `create(RevokeRefreshTokenRequestSchema)` to create a new message.
+ */
+export const RevokeRefreshTokenRequestSchema: GenMessage<RevokeRefreshTokenRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_oauth_client, 24);
+
+/**
+ * @generated from message altalune.v1.RevokeRefreshTokenResponse
+ */
+export type RevokeRefreshTokenResponse = Message<"altalune.v1.RevokeRefreshTokenResponse"> & {
+  /**
+   * @generated from field: altalune.v1.RefreshToken token = 1;
+   */
+  token?: RefreshToken;
+
+  /**
+   * False when the token was no longer active
+   *
+   * @generated from field: bool revoked = 2;
+   */
+  revoked: boolean;
+
+  /**
+   * @generated from field: string message = 3;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.RevokeRefreshTokenResponse.
+ * Use `create(RevokeRefreshTokenResponseSchema)` to create a new message.
+ */
+export const RevokeRefreshTokenResponseSchema: GenMessage<RevokeRefreshTokenResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_oauth_client, 25);
+
+/**
+ * Token endpoint requests of a client in an hour
+ *
+ * @generated from message altalune.v1.TokenStatsBucket
+ */
+export type TokenStatsBucket = Message<"altalune.v1.TokenStatsBucket"> & {
+  /**
+   * Start of the hour
+   *
+   * @generated from field: google.protobuf.Timestamp hour = 1;
+   */
+  hour?: Timestamp;
+
+  /**
+   * Requests that issued tokens
+   *
+   * @generated from field: int64 issued = 2;
+   */
+  issued: bigint;
+
+  /**
+   * Requests that were rejected
+   *
+   * @generated from field: int64 failed = 3;
+   */
+  failed: bigint;
+};
+
+/**
+ * Describes the message altalune.v1.TokenStatsBucket.
+ * Use `create(TokenStatsBucketSchema)` to create a new message.
+ */
+export const TokenStatsBucketSchema: GenMessage<TokenStatsBucket> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_oauth_client, 26);
+
+/**
+ * Get OAuth Client Token Stats Request, for charting the token requests of a
+ * client over the last hours
+ *
+ * @generated from message altalune.v1.GetOAuthClientTokenStatsRequest
+ */
+export type GetOAuthClientTokenStatsRequest = Message<"altalune.v1.GetOAuthClientTokenStatsRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * Defaults to 24
+   *
+   * @generated from field: int32 hours = 2;
+   */
+  hours: number;
+};
+
+/**
+ * Describes the message altalune.v1.GetOAuthClientTokenStatsRequest.
+ * Use `create(GetOAuthClientTokenStatsRequestSchema)` to create a new message.
+ */
+export const GetOAuthClientTokenStatsRequestSchema: GenMessage<GetOAuthClientTokenStatsRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_oauth_client, 27);
+
+/**
+ * @generated from message altalune.v1.GetOAuthClientTokenStatsResponse
+ */
+export type GetOAuthClientTokenStatsResponse = Message<"altalune.v1.GetOAuthClientTokenStatsResponse"> & {
+  /**
+   * One per hour, oldest first, the current hour last
+   *
+   * @generated from field: repeated altalune.v1.TokenStatsBucket buckets = 1;
+   */
+  buckets: TokenStatsBucket[];
+
+  /**
+   * @generated from field: int64 total_issued = 2;
+   */
+  totalIssued: bigint;
+
+  /**
+   * @generated from field: int64 total_failed = 3;
+   */
+  totalFailed: bigint;
+
+  /**
+   * @generated from field: string message = 4;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.GetOAuthClientTokenStatsResponse.
+ * Use `create(GetOAuthClientTokenStatsResponseSchema)` to create a new message.
+ */
+export const GetOAuthClientTokenStatsResponseSchema: GenMessage<GetOAuthClientTokenStatsResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_oauth_client, 28);
+
+/**
+ * Access token claims of a client, slimmed for gateways limiting header
+ * sizes. Tokens leaving permissions out set perms_omitted or perms_overflow,
+ * or carry roles; introspection returns all of them.
+ *
+ * @generated from message altalune.v1.OAuthClientTokenClaims
+ */
+export type OAuthClientTokenClaims = Message<"altalune.v1.OAuthClientTokenClaims"> & {
+  /**
+   * @generated from field: altalune.v1.PermsClaimMode perms_claim = 1;
+   */
+  permsClaim: PermsClaimMode;
+
+  /**
+   * Cap on the perms claim, cut with perms_overflow set; 0 for none
+   *
+   * @generated from field: int32 perms_claim_max_bytes = 2;
+   */
+  permsClaimMaxBytes: number;
+};
+
+/**
+ * Describes the message altalune.v1.OAuthClientTokenClaims.
+ * Use `create(OAuthClientTokenClaimsSchema)` to create a new message.
+ */
+export const OAuthClientTokenClaimsSchema: GenMessage<OAuthClientTokenClaims> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_oauth_client, 29);
+
+/**
+ * @generated from message altalune.v1.GetOAuthClientTokenClaimsRequest
+ */
+export type GetOAuthClientTokenClaimsRequest = Message<"altalune.v1.GetOAuthClientTokenClaimsRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message altalune.v1.GetOAuthClientTokenClaimsRequest.
+ * Use `create(GetOAuthClientTokenClaimsRequestSchema)` to create a new message.
+ */
+export const GetOAuthClientTokenClaimsRequestSchema: GenMessage<GetOAuthClientTokenClaimsRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_oauth_client, 30);
+
+/**
+ * @generated from message altalune.v1.GetOAuthClientTokenClaimsResponse
+ */
+export type GetOAuthClientTokenClaimsResponse = Message<"altalune.v1.GetOAuthClientTokenClaimsResponse"> & {
+  /**
+   * @generated from field: altalune.v1.OAuthClientTokenClaims token_claims = 1;
+   */
+  tokenClaims?: OAuthClientTokenClaims;
+};
+
+/**
+ * Describes the message altalune.v1.GetOAuthClientTokenClaimsResponse.
+ * Use `create(GetOAuthClientTokenClaimsResponseSchema)` to create a new message.
+ */
+export const GetOAuthClientTokenClaimsResponseSchema: GenMessage<GetOAuthClientTokenClaimsResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_oauth_client, 31);
+
+/**
+ * Update OAuth Client Token Claims Request. The default dashboard client
+ * reads permissions from its tokens, so it keeps them all.
+ *
+ * @generated from message altalune.v1.UpdateOAuthClientTokenClaimsRequest
+ */
+export type UpdateOAuthClientTokenClaimsRequest = Message<"altalune.v1.UpdateOAuthClientTokenClaimsRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * Unspecified is full
+   *
+   * @generated from field: altalune.v1.PermsClaimMode perms_claim = 2;
+   */
+  permsClaim: PermsClaimMode;
+
+  /**
+   * @generated from field: int32 perms_claim_max_bytes = 3;
+   */
+  permsClaimMaxBytes: number;
+};
+
+/**
+ * Describes the message altalune.v1.UpdateOAuthClientTokenClaimsRequest.
+ * Use `create(UpdateOAuthClientTokenClaimsRequestSchema)` to create a new message.
+ */
+export const UpdateOAuthClientTokenClaimsRequestSchema: GenMessage<UpdateOAuthClientTokenClaimsRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_oauth_client, 32);
+
+/**
+ * @generated from message altalune.v1.UpdateOAuthClientTokenClaimsResponse
+ */
+export type UpdateOAuthClientTokenClaimsResponse = Message<"altalune.v1.UpdateOAuthClientTokenClaimsResponse"> & {
+  /**
+   * @generated from field: altalune.v1.OAuthClientTokenClaims token_claims = 1;
+   */
+  tokenClaims?: OAuthClientTokenClaims;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.UpdateOAuthClientTokenClaimsResponse.
+ * Use `create(UpdateOAuthClientTokenClaimsResponseSchema)` to create a new message.
+ */
+export const UpdateOAuthClientTokenClaimsResponseSchema: GenMessage<UpdateOAuthClientTokenClaimsResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_oauth_client, 33);
+
+/**
+ * Test Authorization Flow Request. The flow runs as a synthetic user of a
+ * sandbox project: nothing is stored, and no usable token is issued.
+ *
+ * @generated from message altalune.v1.TestAuthorizationFlowRequest
+ */
+export type TestAuthorizationFlowRequest = Message<"altalune.v1.TestAuthorizationFlowRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: string id = 2;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string redirect_uri = 3;
+   */
+  redirectUri: string;
+
+  /**
+   * Space-separated, empty for openid
+   *
+   * @generated from field: string scope = 4;
+   */
+  scope: string;
+
+  /**
+   * PKCE method the client application uses, empty when it sends no
+   * code_challenge
+   *
+   * @generated from field: string code_challenge_method = 5;
+   */
+  codeChallengeMethod: string;
+
+  /**
+   * Sent by confidential clients only
+   *
+   * @generated from field: string client_secret = 6;
+   */
+  clientSecret: string;
+};
+
+/**
+ * Describes the message altalune.v1.TestAuthorizationFlowRequest.
+ * Use `create(TestAuthorizationFlowRequestSchema)` to create a new message.
+ */
+export const TestAuthorizationFlowRequestSchema: GenMessage<TestAuthorizationFlowRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_oauth_client, 34);
+
+/**
+ * @generated from message altalune.v1.AuthorizationFlowStep
+ */
+export type AuthorizationFlowStep = Message<"altalune.v1.AuthorizationFlowStep"> & {
+  /**
+   * e.g. redirect_uri, pkce, code_exchange
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: altalune.v1.AuthorizationFlowStepOutcome outcome = 2;
+   */
+  outcome: AuthorizationFlowStepOutcome;
+
+  /**
+   * @generated from field: string detail = 3;
+   */
+  detail: string;
+
+  /**
+   * OAuth error the real flow answers with, e.g. invalid_grant
+   *
+   * @generated from field: string error = 4;
+   */
+  error: string;
+};
+
+/**
+ * Describes the message altalune.v1.AuthorizationFlowStep.
+ * Use `create(AuthorizationFlowStepSchema)` to create a new message.
+ */
+export const AuthorizationFlowStepSchema: GenMessage<AuthorizationFlowStep> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_oauth_client, 35);
+
+/**
+ * @generated from message altalune.v1.TestAuthorizationFlowResponse
+ */
+export type TestAuthorizationFlowResponse = Message<"altalune.v1.TestAuthorizationFlowResponse"> & {
+  /**
+   * Whether a real flow would issue tokens
+   *
+   * @generated from field: bool succeeded = 1;
+   */
+  succeeded: boolean;
+
+  /**
+   * @generated from field: repeated altalune.v1.AuthorizationFlowStep steps = 2;
+   */
+  steps: AuthorizationFlowStep[];
+
+  /**
+   * Names of the access token claims a user would get
+   *
+   * @generated from field: repeated string claims = 3;
+   */
+  claims: string[];
+
+  /**
+   * @generated from field: string message = 4;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.TestAuthorizationFlowResponse.
+ * Use `create(TestAuthorizationFlowResponseSchema)` to create a new message.
+ */
+export const TestAuthorizationFlowResponseSchema: GenMessage<TestAuthorizationFlowResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_oauth_client, 36);
+
+/**
+ * @generated from enum altalune.v1.RefreshTokenStatus
+ */
+export enum RefreshTokenStatus {
+  /**
+   * @generated from enum value: REFRESH_TOKEN_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Usable until it expires
+   *
+   * @generated from enum value: REFRESH_TOKEN_STATUS_ACTIVE = 1;
+   */
+  ACTIVE = 1,
+
+  /**
+   * Exchanged for a new token
+   *
+   * @generated from enum value: REFRESH_TOKEN_STATUS_EXCHANGED = 2;
+   */
+  EXCHANGED = 2,
+
+  /**
+   * Revoked by sign-out or an administrator
+   *
+   * @generated from enum value: REFRESH_TOKEN_STATUS_REVOKED = 3;
+   */
+  REVOKED = 3,
+
+  /**
+   * Past its expiration unused
+   *
+   * @generated from enum value: REFRESH_TOKEN_STATUS_EXPIRED = 4;
+   */
+  EXPIRED = 4,
+}
+
+/**
+ * Describes the enum altalune.v1.RefreshTokenStatus.
+ */
+export const RefreshTokenStatusSchema: GenEnum<RefreshTokenStatus> = /*@__PURE__*/
+  enumDesc(file_altalune_v1_oauth_client, 0);
+
+/**
+ * How access tokens carry the permissions of their user
+ *
+ * @generated from enum altalune.v1.PermsClaimMode
+ */
+export enum PermsClaimMode {
+  /**
+   * @generated from enum value: PERMS_CLAIM_MODE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Every permission in perms
+   *
+   * @generated from enum value: PERMS_CLAIM_MODE_FULL = 1;
+   */
+  FULL = 1,
+
+  /**
+   * No perms; resource servers introspect the token
+   *
+   * @generated from enum value: PERMS_CLAIM_MODE_OMIT = 2;
+   */
+  OMIT = 2,
+
+  /**
+   * Role names in roles, directly granted permissions in perms
+   *
+   * @generated from enum value: PERMS_CLAIM_MODE_ROLES = 3;
+   */
+  ROLES = 3,
+}
+
+/**
+ * Describes the enum altalune.v1.PermsClaimMode.
+ */
+export const PermsClaimModeSchema: GenEnum<PermsClaimMode> = /*@__PURE__*/
+  enumDesc(file_altalune_v1_oauth_client, 1);
+
+/**
+ * @generated from enum altalune.v1.AuthorizationFlowStepOutcome
+ */
+export enum AuthorizationFlowStepOutcome {
+  /**
+   * @generated from enum value: AUTHORIZATION_FLOW_STEP_OUTCOME_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: AUTHORIZATION_FLOW_STEP_OUTCOME_PASSED = 1;
+   */
+  PASSED = 1,
+
+  /**
+   * Passed, but likely not what the client wants
+   *
+   * @generated from enum value: AUTHORIZATION_FLOW_STEP_OUTCOME_WARNING = 2;
+   */
+  WARNING = 2,
+
+  /**
+   * The flow stops here
+   *
+   * @generated from enum value: AUTHORIZATION_FLOW_STEP_OUTCOME_FAILED = 3;
+   */
+  FAILED = 3,
+
+  /**
+   * Not reached after a failed step
+   *
+   * @generated from enum value: AUTHORIZATION_FLOW_STEP_OUTCOME_SKIPPED = 4;
+   */
+  SKIPPED = 4,
+}
+
+/**
+ * Describes the enum altalune.v1.AuthorizationFlowStepOutcome.
+ */
+export const AuthorizationFlowStepOutcomeSchema: GenEnum<AuthorizationFlowStepOutcome> = /*@__PURE__*/
+  enumDesc(file_altalune_v1_oauth_client, 2);
+
+/**
+ * OAuth Client Service - Manage OAuth client applications
+ *
+ * @generated from service altalune.v1.OAuthClientService
+ */
+export const OAuthClientService: GenService<{
+  /**
+   * @generated from rpc altalune.v1.OAuthClientService.CreateOAuthClient
+   */
+  createOAuthClient: {
+    methodKind: "unary";
+    input: typeof CreateOAuthClientRequestSchema;
+    output: typeof CreateOAuthClientResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.OAuthClientService.QueryOAuthClients
+   */
+  queryOAuthClients: {
+    methodKind: "unary";
+    input: typeof QueryOAuthClientsRequestSchema;
+    output: typeof QueryOAuthClientsResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.OAuthClientService.GetOAuthClient
+   */
+  getOAuthClient: {
+    methodKind: "unary";
+    input: typeof GetOAuthClientRequestSchema;
+    output: typeof GetOAuthClientResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.OAuthClientService.UpdateOAuthClient
+   */
+  updateOAuthClient: {
+    methodKind: "unary";
+    input: typeof UpdateOAuthClientRequestSchema;
+    output: typeof UpdateOAuthClientResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.OAuthClientService.DeleteOAuthClient
+   */
+  deleteOAuthClient: {
+    methodKind: "unary";
+    input: typeof DeleteOAuthClientRequestSchema;
+    output: typeof DeleteOAuthClientResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.OAuthClientService.RestoreOAuthClient
+   */
+  restoreOAuthClient: {
+    methodKind: "unary";
+    input: typeof RestoreOAuthClientRequestSchema;
+    output: typeof RestoreOAuthClientResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.OAuthClientService.RevealOAuthClientSecret
+   */
+  revealOAuthClientSecret: {
+    methodKind: "unary";
     input: typeof RevealOAuthClientSecretRequestSchema;
     output: typeof RevealOAuthClientSecretResponseSchema;
   },
+  /**
+   * Replace the secret of a confidential client, handed over like on creation
+   *
+   * @generated from rpc altalune.v1.OAuthClientService.RotateOAuthClientSecret
+   */
+  rotateOAuthClientSecret: {
+    methodKind: "unary";
+    input: typeof RotateOAuthClientSecretRequestSchema;
+    output: typeof RotateOAuthClientSecretResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.OAuthClientService.QueryRefreshTokens
+   */
+  queryRefreshTokens: {
+    methodKind: "unary";
+    input: typeof QueryRefreshTokensRequestSchema;
+    output: typeof QueryRefreshTokensResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.OAuthClientService.RevokeRefreshToken
+   */
+  revokeRefreshToken: {
+    methodKind: "unary";
+    input: typeof RevokeRefreshTokenRequestSchema;
+    output: typeof RevokeRefreshTokenResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.OAuthClientService.GetOAuthClientTokenStats
+   */
+  getOAuthClientTokenStats: {
+    methodKind: "unary";
+    input: typeof GetOAuthClientTokenStatsRequestSchema;
+    output: typeof GetOAuthClientTokenStatsResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.OAuthClientService.GetOAuthClientTokenClaims
+   */
+  getOAuthClientTokenClaims: {
+    methodKind: "unary";
+    input: typeof GetOAuthClientTokenClaimsRequestSchema;
+    output: typeof GetOAuthClientTokenClaimsResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.OAuthClientService.UpdateOAuthClientTokenClaims
+   */
+  updateOAuthClientTokenClaims: {
+    methodKind: "unary";
+    input: typeof UpdateOAuthClientTokenClaimsRequestSchema;
+    output: typeof UpdateOAuthClientTokenClaimsResponseSchema;
+  },
+  /**
+   * Create-or-update by external ID, for declarative provisioning
+   *
+   * @generated from rpc altalune.v1.OAuthClientService.ApplyOAuthClient
+   */
+  applyOAuthClient: {
+    methodKind: "unary";
+    input: typeof ApplyOAuthClientRequestSchema;
+    output: typeof ApplyOAuthClientResponseSchema;
+  },
+  /**
+   * Bring an existing client under an external ID, found by name
+   *
+   * @generated from rpc altalune.v1.OAuthClientService.ImportOAuthClient
+   */
+  importOAuthClient: {
+    methodKind: "unary";
+    input: typeof ImportOAuthClientRequestSchema;
+    output: typeof ImportOAuthClientResponseSchema;
+  },
+  /**
+   * Walk a synthetic user of a sandbox project through the authorization code
+   * flow of a client, without a browser, reporting the outcome of each step
+   *
+   * @generated from rpc altalune.v1.OAuthClientService.TestAuthorizationFlow
+   */
+  testAuthorizationFlow: {
+    methodKind: "unary";
+    input: typeof TestAuthorizationFlowRequestSchema;
+    output: typeof TestAuthorizationFlowResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_altalune_v1_oauth_client, 0);
 
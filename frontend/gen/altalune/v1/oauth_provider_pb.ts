@@ -9,13 +9,14 @@ import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import { file_buf_validate_validate } from "../../buf/validate/validate_pb.js";
 import type { QueryMetaResponse, QueryRequest } from "./common_pb.js";
 import { file_altalune_v1_common } from "./common_pb.js";
+import { file_altalune_v1_options } from "./options_pb.js";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file altalune/v1/oauth_provider.proto.
  */
 export const file_altalune_v1_oauth_provider: GenFile = /*@__PURE__*/
-  fileDesc("CiBhbHRhbHVuZS92MS9vYXV0aF9wcm92aWRlci5wcm90bxILYWx0YWx1bmUudjEikgIKDU9BdXRoUHJvdmlkZXISCgoCaWQYASABKAkSMAoNcHJvdmlkZXJfdHlwZRgCIAEoDjIZLmFsdGFsdW5lLnYxLlByb3ZpZGVyVHlwZRIRCgljbGllbnRfaWQYAyABKAkSGQoRY2xpZW50X3NlY3JldF9zZXQYBCABKAgSFAoMcmVkaXJlY3RfdXJsGAUgASgJEg4KBnNjb3BlcxgGIAEoCRIPCgdlbmFibGVkGAcgASgIEi4KCmNyZWF0ZWRfYXQYYiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYYyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIkYKGlF1ZXJ5T0F1dGhQcm92aWRlcnNSZXF1ZXN0EigKBXF1ZXJ5GAEgASgLMhkuYWx0YWx1bmUudjEuUXVlcnlSZXF1ZXN0InUKG1F1ZXJ5T0F1dGhQcm92aWRlcnNSZXNwb25zZRIoCgRkYXRhGAEgAygLMhouYWx0YWx1bmUudjEuT0F1dGhQcm92aWRlchIsCgRtZXRhGAIgASgLMh4uYWx0YWx1bmUudjEuUXVlcnlNZXRhUmVzcG9uc2Ui9AEKGkNyZWF0ZU9BdXRoUHJvdmlkZXJSZXF1ZXN0Ej0KDXByb3ZpZGVyX3R5cGUYASABKA4yGS5hbHRhbHVuZS52MS5Qcm92aWRlclR5cGVCC7pICMgBAYIBAhABEiAKCWNsaWVudF9pZBgCIAEoCUINukgKyAEBcgUQARj0AxIkCg1jbGllbnRfc2VjcmV0GAMgASgJQg26SArIAQFyBRABGPQDEiQKDHJlZGlyZWN0X3VybBgEIAEoCUIOukgLyAEBcgYY9AOIAQESGAoGc2NvcGVzGAUgASgJQgi6SAVyAxjoBxIPCgdlbmFibGVkGAYgASgIIlwKG0NyZWF0ZU9BdXRoUHJvdmlkZXJSZXNwb25zZRIsCghwcm92aWRlchgBIAEoCzIaLmFsdGFsdW5lLnYxLk9BdXRoUHJvdmlkZXISDwoHbWVzc2FnZRgCIAEoCSIzChdHZXRPQXV0aFByb3ZpZGVyUmVxdWVzdBIYCgJpZBgBIAEoCUIMukgJyAEBcgQQDhgUIkgKGEdldE9BdXRoUHJvdmlkZXJSZXNwb25zZRIsCghwcm92aWRlchgBIAEoCzIaLmFsdGFsdW5lLnYxLk9BdXRoUHJvdmlkZXIiygEKGlVwZGF0ZU9BdXRoUHJvdmlkZXJSZXF1ZXN0EhgKAmlkGAEgASgJQgy6SAnIAQFyBBAOGBQSIAoJY2xpZW50X2lkGAIgASgJQg26SArIAQFyBRABGPQDEh8KDWNsaWVudF9zZWNyZXQYAyABKAlCCLpIBXIDGPQDEiQKDHJlZGlyZWN0X3VybBgEIAEoCUIOukgLyAEBcgYY9AOIAQESGAoGc2NvcGVzGAUgASgJQgi6SAVyAxjoBxIPCgdlbmFibGVkGAYgASgIIlwKG1VwZGF0ZU9BdXRoUHJvdmlkZXJSZXNwb25zZRIsCghwcm92aWRlchgBIAEoCzIaLmFsdGFsdW5lLnYxLk9BdXRoUHJvdmlkZXISDwoHbWVzc2FnZRgCIAEoCSI2ChpEZWxldGVPQXV0aFByb3ZpZGVyUmVxdWVzdBIYCgJpZBgBIAEoCUIMukgJyAEBcgQQDhgUIi4KG0RlbGV0ZU9BdXRoUHJvdmlkZXJSZXNwb25zZRIPCgdtZXNzYWdlGAEgASgJIjUKGVJldmVhbENsaWVudFNlY3JldFJlcXVlc3QSGAoCaWQYASABKAlCDLpICcgBAXIEEA4YFCIzChpSZXZlYWxDbGllbnRTZWNyZXRSZXNwb25zZRIVCg1jbGllbnRfc2VjcmV0GAEgASgJKpcBCgxQcm92aWRlclR5cGUSHQoZUFJPVklERVJfVFlQRV9VTlNQRUNJRklFRBAAEhgKFFBST1ZJREVSX1RZUEVfR09PR0xFEAESGAoUUFJPVklERVJfVFlQRV9HSVRIVUIQAhIbChdQUk9WSURFUl9UWVBFX01JQ1JPU09GVBADEhcKE1BST1ZJREVSX1RZUEVfQVBQTEUQBDKSBQoUT0F1dGhQcm92aWRlclNlcnZpY2USagoTUXVlcnlPQXV0aFByb3ZpZGVycxInLmFsdGFsdW5lLnYxLlF1ZXJ5T0F1dGhQcm92aWRlcnNSZXF1ZXN0GiguYWx0YWx1bmUudjEuUXVlcnlPQXV0aFByb3ZpZGVyc1Jlc3BvbnNlIgASagoTQ3JlYXRlT0F1dGhQcm92aWRlchInLmFsdGFsdW5lLnYxLkNyZWF0ZU9BdXRoUHJvdmlkZXJSZXF1ZXN0GiguYWx0YWx1bmUudjEuQ3JlYXRlT0F1dGhQcm92aWRlclJlc3BvbnNlIgASYQoQR2V0T0F1dGhQcm92aWRlchIkLmFsdGFsdW5lLnYxLkdldE9BdXRoUHJvdmlkZXJSZXF1ZXN0GiUuYWx0YWx1bmUudjEuR2V0T0F1dGhQcm92aWRlclJlc3BvbnNlIgASagoTVXBkYXRlT0F1dGhQcm92aWRlchInLmFsdGFsdW5lLnYxLlVwZGF0ZU9BdXRoUHJvdmlkZXJSZXF1ZXN0GiguYWx0YWx1bmUudjEuVXBkYXRlT0F1dGhQcm92aWRlclJlc3BvbnNlIgASagoTRGVsZXRlT0F1dGhQcm92aWRlchInLmFsdGFsdW5lLnYxLkRlbGV0ZU9BdXRoUHJvdmlkZXJSZXF1ZXN0GiguYWx0YWx1bmUudjEuRGVsZXRlT0F1dGhQcm92aWRlclJlc3BvbnNlIgASZwoSUmV2ZWFsQ2xpZW50U2VjcmV0EiYuYWx0YWx1bmUudjEuUmV2ZWFsQ2xpZW50U2VjcmV0UmVxdWVzdBonLmFsdGFsdW5lLnYxLlJldmVhbENsaWVudFNlY3JldFJlc3BvbnNlIgBCpwEKD2NvbS5hbHRhbHVuZS52MUIST2F1dGhQcm92aWRlclByb3RvUAFaM2dpdGh1Yi5jb20vaHJ6OC9hbHRhbHVuZS9nZW4vYWx0YWx1bmUvdjE7YWx0YWx1bmV2MaICA0FYWKoCC0FsdGFsdW5lLlYxygILQWx0YWx1bmVcVjHiAhdBbHRhbHVuZVxWMVxHUEJNZXRhZGF0YeoCDEFsdGFsdW5lOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp, file_buf_validate_validate, file_altalune_v1_common]);
+  fileDesc("CiBhbHRhbHVuZS92MS9vYXV0aF9wcm92aWRlci5wcm90bxILYWx0YWx1bmUudjEikgIKDU9BdXRoUHJvdmlkZXISCgoCaWQYASABKAkSMAoNcHJvdmlkZXJfdHlwZRgCIAEoDjIZLmFsdGFsdW5lLnYxLlByb3ZpZGVyVHlwZRIRCgljbGllbnRfaWQYAyABKAkSGQoRY2xpZW50X3NlY3JldF9zZXQYBCABKAgSFAoMcmVkaXJlY3RfdXJsGAUgASgJEg4KBnNjb3BlcxgGIAEoCRIPCgdlbmFibGVkGAcgASgIEi4KCmNyZWF0ZWRfYXQYYiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYYyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIkYKGlF1ZXJ5T0F1dGhQcm92aWRlcnNSZXF1ZXN0EigKBXF1ZXJ5GAEgASgLMhkuYWx0YWx1bmUudjEuUXVlcnlSZXF1ZXN0InUKG1F1ZXJ5T0F1dGhQcm92aWRlcnNSZXNwb25zZRIoCgRkYXRhGAEgAygLMhouYWx0YWx1bmUudjEuT0F1dGhQcm92aWRlchIsCgRtZXRhGAIgASgLMh4uYWx0YWx1bmUudjEuUXVlcnlNZXRhUmVzcG9uc2Ui9AEKGkNyZWF0ZU9BdXRoUHJvdmlkZXJSZXF1ZXN0Ej0KDXByb3ZpZGVyX3R5cGUYASABKA4yGS5hbHRhbHVuZS52MS5Qcm92aWRlclR5cGVCC7pICMgBAYIBAhABEiAKCWNsaWVudF9pZBgCIAEoCUINukgKyAEBcgUQARj0AxIkCg1jbGllbnRfc2VjcmV0GAMgASgJQg26SArIAQFyBRABGPQDEiQKDHJlZGlyZWN0X3VybBgEIAEoCUIOukgLyAEBcgYY9AOIAQESGAoGc2NvcGVzGAUgASgJQgi6SAVyAxjoBxIPCgdlbmFibGVkGAYgASgIIlwKG0NyZWF0ZU9BdXRoUHJvdmlkZXJSZXNwb25zZRIsCghwcm92aWRlchgBIAEoCzIaLmFsdGFsdW5lLnYxLk9BdXRoUHJvdmlkZXISDwoHbWVzc2FnZRgCIAEoCSIzChdHZXRPQXV0aFByb3ZpZGVyUmVxdWVzdBIYCgJpZBgBIAEoCUIMukgJyAEBcgQQDhgUIkgKGEdldE9BdXRoUHJvdmlkZXJSZXNwb25zZRIsCghwcm92aWRlchgBIAEoCzIaLmFsdGFsdW5lLnYxLk9BdXRoUHJvdmlkZXIigwIKGlVwZGF0ZU9BdXRoUHJvdmlkZXJSZXF1ZXN0EhgKAmlkGAEgASgJQgy6SAnIAQFyBBAOGBQSIAoJY2xpZW50X2lkGAIgASgJQg26SArIAQFyBRABGPQDEh8KDWNsaWVudF9zZWNyZXQYAyABKAlCCLpIBXIDGPQDEiQKDHJlZGlyZWN0X3VybBgEIAEoCUIOukgLyAEBcgYY9AOIAQESGAoGc2NvcGVzGAUgASgJQgi6SAVyAxjoBxIPCgdlbmFibGVkGAYgASgIEjcKE2V4cGVjdGVkX3VwZGF0ZWRfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIlwKG1VwZGF0ZU9BdXRoUHJvdmlkZXJSZXNwb25zZRIsCghwcm92aWRlchgBIAEoCzIaLmFsdGFsdW5lLnYxLk9BdXRoUHJvdmlkZXISDwoHbWVzc2FnZRgCIAEoCSI2ChpEZWxldGVPQXV0aFByb3ZpZGVyUmVxdWVzdBIYCgJpZBgBIAEoCUIMukgJyAEBcgQQDhgUIi4KG0RlbGV0ZU9BdXRoUHJvdmlkZXJSZXNwb25zZRIPCgdtZXNzYWdlGAEgASgJIjwKIFJlcXVlc3RDbGllbnRTZWNyZXRSZXZlYWxSZXF1ZXN0EhgKAmlkGAEgASgJQgy6SAnIAQFyBBAOGBQiZAohUmVxdWVzdENsaWVudFNlY3JldFJldmVhbFJlc3BvbnNlEg8KB21lc3NhZ2UYASABKAkSLgoKZXhwaXJlc19hdBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAihQEKGVJldmVhbENsaWVudFNlY3JldFJlcXVlc3QSGAoCaWQYASABKAlCDLpICcgBAXIEEA4YFBIvChFjb25maXJtYXRpb25fY29kZRgCIAEoCUIUukgRyAEBcgwyCl5bMC05XXs2fSQSHQoGcmVhc29uGAMgASgJQg26SArIAQFyBRADGPQDIjMKGlJldmVhbENsaWVudFNlY3JldFJlc3BvbnNlEhUKDWNsaWVudF9zZWNyZXQYASABKAkqlwEKDFByb3ZpZGVyVHlwZRIdChlQUk9WSURFUl9UWVBFX1VOU1BFQ0lGSUVEEAASGAoUUFJPVklERVJfVFlQRV9HT09HTEUQARIYChRQUk9WSURFUl9UWVBFX0dJVEhVQhACEhsKF1BST1ZJREVSX1RZUEVfTUlDUk9TT0ZUEAMSFwoTUFJPVklERVJfVFlQRV9BUFBMRRAEMv4GChRPQXV0aFByb3ZpZGVyU2VydmljZRJ5ChNRdWVyeU9BdXRoUHJvdmlkZXJzEicuYWx0YWx1bmUudjEuUXVlcnlPQXV0aFByb3ZpZGVyc1JlcXVlc3QaKC5hbHRhbHVuZS52MS5RdWVyeU9BdXRoUHJvdmlkZXJzUmVzcG9uc2UiD4q1GAtjbGllbnQ6cmVhZBJ6ChNDcmVhdGVPQXV0aFByb3ZpZGVyEicuYWx0YWx1bmUudjEuQ3JlYXRlT0F1dGhQcm92aWRlclJlcXVlc3QaKC5hbHRhbHVuZS52MS5DcmVhdGVPQXV0aFByb3ZpZGVyUmVzcG9uc2UiEIq1GAxjbGllbnQ6d3JpdGUScAoQR2V0T0F1dGhQcm92aWRlchIkLmFsdGFsdW5lLnYxLkdldE9BdXRoUHJvdmlkZXJSZXF1ZXN0GiUuYWx0YWx1bmUudjEuR2V0T0F1dGhQcm92aWRlclJlc3BvbnNlIg+KtRgLY2xpZW50OnJlYWQSegoTVXBkYXRlT0F1dGhQcm92aWRlchInLmFsdGFsdW5lLnYxLlVwZGF0ZU9BdXRoUHJvdmlkZXJSZXF1ZXN0GiguYWx0YWx1bmUudjEuVXBkYXRlT0F1dGhQcm92aWRlclJlc3BvbnNlIhCKtRgMY2xpZW50OndyaXRlEnsKE0RlbGV0ZU9BdXRoUHJvdmlkZXISJy5hbHRhbHVuZS52MS5EZWxldGVPQXV0aFByb3ZpZGVyUmVxdWVzdBooLmFsdGFsdW5lLnYxLkRlbGV0ZU9BdXRoUHJvdmlkZXJSZXNwb25zZSIRirUYDWNsaWVudDpkZWxldGUSiwEKGVJlcXVlc3RDbGllbnRTZWNyZXRSZXZlYWwSLS5hbHRhbHVuZS52MS5SZXF1ZXN0Q2xpZW50U2VjcmV0UmV2ZWFsUmVxdWVzdBouLmFsdGFsdW5lLnYxLlJlcXVlc3RDbGllbnRTZWNyZXRSZXZlYWxSZXNwb25zZSIPirUYC2NsaWVudDpyZWFkEnYKElJldmVhbENsaWVudFNlY3JldBImLmFsdGFsdW5lLnYxLlJldmVhbENsaWVudFNlY3JldFJlcXVlc3QaJy5hbHRhbHVuZS52MS5SZXZlYWxDbGllbnRTZWNyZXRSZXNwb25zZSIPirUYC2NsaWVudDpyZWFkQqcBCg9jb20uYWx0YWx1bmUudjFCEk9hdXRoUHJvdmlkZXJQcm90b1ABWjNnaXRodWIuY29tL2hyejgvYWx0YWx1bmUvZ2VuL2FsdGFsdW5lL3YxO2FsdGFsdW5ldjGiAgNBWFiqAgtBbHRhbHVuZS5WMcoCC0FsdGFsdW5lXFYx4gIXQWx0YWx1bmVcVjFcR1BCTWV0YWRhdGHqAgxBbHRhbHVuZTo6VjFiBnByb3RvMw", [file_google_protobuf_timestamp, file_buf_validate_validate, file_altalune_v1_common, file_altalune_v1_options]);
 
 /**
  * OAuthProvider represents an OAuth provider configuration
@@ -278,6 +279,14 @@ export type UpdateOAuthProviderRequest = Message<"altalune.v1.UpdateOAuthProvide
    * @generated from field: bool enabled = 6;
    */
   enabled: boolean;
+
+  /**
+   * updated_at of the provider the edit is based on. When set, the update is
+   * rejected with FAILED_PRECONDITION if the provider changed since then.
+   *
+   * @generated from field: google.protobuf.Timestamp expected_updated_at = 7;
+   */
+  expectedUpdatedAt?: Timestamp;
 };
 
 /**
@@ -349,6 +358,50 @@ export type DeleteOAuthProviderResponse = Message<"altalune.v1.DeleteOAuthProvid
 export const DeleteOAuthProviderResponseSchema: GenMessage<DeleteOAuthProviderResponse> = /*@__PURE__*/
   messageDesc(file_altalune_v1_oauth_provider, 10);
 
+/**
+ * RequestClientSecretRevealRequest asks for a confirmation code to reveal
+ * the client secret of a provider
+ *
+ * @generated from message altalune.v1.RequestClientSecretRevealRequest
+ */
+export type RequestClientSecretRevealRequest = Message<"altalune.v1.RequestClientSecretRevealRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message altalune.v1.RequestClientSecretRevealRequest.
+ * Use `create(RequestClientSecretRevealRequestSchema)` to create a new message.
+ */
+export const RequestClientSecretRevealRequestSchema: GenMessage<RequestClientSecretRevealRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_oauth_provider, 11);
+
+/**
+ * RequestClientSecretRevealResponse tells when the emailed code lapses
+ *
+ * @generated from message altalune.v1.RequestClientSecretRevealResponse
+ */
+export type RequestClientSecretRevealResponse = Message<"altalune.v1.RequestClientSecretRevealResponse"> & {
+  /**
+   * @generated from field: string message = 1;
+   */
+  message: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 2;
+   */
+  expiresAt?: Timestamp;
+};
+
+/**
+ * Describes the message altalune.v1.RequestClientSecretRevealResponse.
+ * Use `create(RequestClientSecretRevealResponseSchema)` to create a new message.
+ */
+export const RequestClientSecretRevealResponseSchema: GenMessage<RequestClientSecretRevealResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_oauth_provider, 12);
+
 /**
  * RevealClientSecretRequest for decrypting client secret
  * SECURITY: This is an explicit, separate RPC to track secret access
@@ -360,6 +413,20 @@ export type RevealClientSecretRequest = Message<"altalune.v1.RevealClientSecretR
    * @generated from field: string id = 1;
    */
   id: string;
+
+  /**
+   * Code emailed to the caller by RequestClientSecretReveal, single use
+   *
+   * @generated from field: string confirmation_code = 2;
+   */
+  confirmationCode: string;
+
+  /**
+   * Why the secret is needed, recorded in the audit trail
+   *
+   * @generated from field: string reason = 3;
+   */
+  reason: string;
 };
 
 /**
@@ -367,7 +434,7 @@ export type RevealClientSecretRequest = Message<"altalune.v1.RevealClientSecretR
  * Use `create(RevealClientSecretRequestSchema)` to create a new message.
  */
 export const RevealClientSecretRequestSchema: GenMessage<RevealClientSecretRequest> = /*@__PURE__*/
-  messageDesc(file_altalune_v1_oauth_provider, 11);
+  messageDesc(file_altalune_v1_oauth_provider, 13);
 
 /**
  * RevealClientSecretResponse with plaintext client secret
@@ -389,7 +456,7 @@ export type RevealClientSecretResponse = Message<"altalune.v1.RevealClientSecret
  * Use `create(RevealClientSecretResponseSchema)` to create a new message.
  */
 export const RevealClientSecretResponseSchema: GenMessage<RevealClientSecretResponse> = /*@__PURE__*/
-  messageDesc(file_altalune_v1_oauth_provider, 12);
+  messageDesc(file_altalune_v1_oauth_provider, 14);
 
 /**
  * ProviderType enum for supported OAuth providers
@@ -489,9 +556,21 @@ export const OAuthProviderService: GenService<{
     input: typeof DeleteOAuthProviderRequestSchema;
     output: typeof DeleteOAuthProviderResponseSchema;
   },
+  /**
+   * RequestClientSecretReveal emails the caller a short-lived code confirming
+   * a client secret reveal
+   *
+   * @generated from rpc altalune.v1.OAuthProviderService.RequestClientSecretReveal
+   */
+  requestClientSecretReveal: {
+    methodKind: "unary";
+    input: typeof RequestClientSecretRevealRequestSchema;
+    output: typeof RequestClientSecretRevealResponseSchema;
+  },
   /**
    * RevealClientSecret decrypts and returns the plaintext client secret
-   * SECURITY: Separate RPC for audit logging and access control
+   * SECURITY: Separate RPC for audit logging and access control. Requires a
+   * code from RequestClientSecretReveal and a reason, and is rate limited.
    *
    * @generated from rpc altalune.v1.OAuthProviderService.RevealClientSecret
    */
@@ -0,0 +1,14 @@
+// @generated by protoc-gen-es v2.6.3 with parameter "target=ts,import_extension=js"
+// @generated from file altalune/v1/options.proto (package altalune.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_google_protobuf_descriptor } from "@bufbuild/protobuf/wkt";
+
+/**
+ * Describes the file altalune/v1/options.proto.
+ */
+export const file_altalune_v1_options: GenFile = /*@__PURE__*/
+  fileDesc("ChlhbHRhbHVuZS92MS9vcHRpb25zLnByb3RvEgthbHRhbHVuZS52MTpACgpwZXJtaXNzaW9uEh4uZ29vZ2xlLnByb3RvYnVmLk1ldGhvZE9wdGlvbnMY0YYDIAMoCVIKcGVybWlzc2lvbjpICg9yZWFkX3Blcm1pc3Npb24SHS5nb29nbGUucHJvdG9idWYuRmllbGRPcHRpb25zGNKGAyABKAlSDnJlYWRQZXJtaXNzaW9uQqEBCg9jb20uYWx0YWx1bmUudjFCDE9wdGlvbnNQcm90b1ABWjNnaXRodWIuY29tL2hyejgvYWx0YWx1bmUvZ2VuL2FsdGFsdW5lL3YxO2FsdGFsdW5ldjGiAgNBWFiqAgtBbHRhbHVuZS5WMcoCC0FsdGFsdW5lXFYx4gIXQWx0YWx1bmVcVjFcR1BCTWV0YWRhdGHqAgxBbHRhbHVuZTo6VjFiBnByb3RvMw", [file_google_protobuf_descriptor]);
+
@@ -0,0 +1,697 @@
+// @generated by protoc-gen-es v2.6.3 with parameter "target=ts,import_extension=js"
+// @generated from file altalune/v1/organization.proto (package altalune.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import { file_buf_validate_validate } from "../../buf/validate/validate_pb.js";
+import { file_altalune_v1_options } from "./options_pb.js";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file altalune/v1/organization.proto.
+ */
+export const file_altalune_v1_organization: GenFile = /*@__PURE__*/
+  fileDesc("Ch5hbHRhbHVuZS92MS9vcmdhbml6YXRpb24ucHJvdG8SC2FsdGFsdW5lLnYxIuEBCgxPcmdhbml6YXRpb24SCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRIVCg1iaWxsaW5nX2VtYWlsGAMgASgJEhsKE2JpbGxpbmdfY3VzdG9tZXJfaWQYBCABKAkSFQoNcHJvamVjdF9jb3VudBgFIAEoBRIMCgRyb2xlGAYgASgJEi4KCmNyZWF0ZWRfYXQYYiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYYyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIkwKE09yZ2FuaXphdGlvblByb2plY3QSEgoKcHJvamVjdF9pZBgBIAEoCRIMCgRuYW1lGAIgASgJEhMKC2Vudmlyb25tZW50GAMgASgJIj0KGU9yZ2FuaXphdGlvbk1lbWJlclByb2plY3QSEgoKcHJvamVjdF9pZBgBIAEoCRIMCgRyb2xlGAIgASgJIqMBChJPcmdhbml6YXRpb25NZW1iZXISDwoHdXNlcl9pZBgBIAEoCRINCgVlbWFpbBgCIAEoCRISCgpmaXJzdF9uYW1lGAMgASgJEhEKCWxhc3RfbmFtZRgEIAEoCRIMCgRyb2xlGAUgASgJEjgKCHByb2plY3RzGAYgAygLMiYuYWx0YWx1bmUudjEuT3JnYW5pemF0aW9uTWVtYmVyUHJvamVjdCIaChhMaXN0T3JnYW5pemF0aW9uc1JlcXVlc3QiRAoZTGlzdE9yZ2FuaXphdGlvbnNSZXNwb25zZRInCgRkYXRhGAEgAygLMhkuYWx0YWx1bmUudjEuT3JnYW5pemF0aW9uIjEKFkdldE9yZ2FuaXphdGlvblJlcXVlc3QSFwoCaWQYASABKAlCC7pICMgBAXIDmAEOIn4KF0dldE9yZ2FuaXphdGlvblJlc3BvbnNlEi8KDG9yZ2FuaXphdGlvbhgBIAEoCzIZLmFsdGFsdW5lLnYxLk9yZ2FuaXphdGlvbhIyCghwcm9qZWN0cxgCIAMoCzIgLmFsdGFsdW5lLnYxLk9yZ2FuaXphdGlvblByb2plY3QigwEKGUNyZWF0ZU9yZ2FuaXphdGlvblJlcXVlc3QSGgoEbmFtZRgBIAEoCUIMukgJyAEBcgQQARhkEiQKDWJpbGxpbmdfZW1haWwYAiABKAlCDbpICtgBAXIFGP4BYAESJAoTYmlsbGluZ19jdXN0b21lcl9pZBgDIAEoCUIHukgEcgIYZCJeChpDcmVhdGVPcmdhbml6YXRpb25SZXNwb25zZRIvCgxvcmdhbml6YXRpb24YASABKAsyGS5hbHRhbHVuZS52MS5Pcmdhbml6YXRpb24SDwoHbWVzc2FnZRgCIAEoCSKcAQoZVXBkYXRlT3JnYW5pemF0aW9uUmVxdWVzdBIXCgJpZBgBIAEoCUILukgIyAEBcgOYAQ4SGgoEbmFtZRgCIAEoCUIMukgJyAEBcgQQARhkEiQKDWJpbGxpbmdfZW1haWwYAyABKAlCDbpICtgBAXIFGP4BYAESJAoTYmlsbGluZ19jdXN0b21lcl9pZBgEIAEoCUIHukgEcgIYZCJeChpVcGRhdGVPcmdhbml6YXRpb25SZXNwb25zZRIvCgxvcmdhbml6YXRpb24YASABKAsyGS5hbHRhbHVuZS52MS5Pcmdhbml6YXRpb24SDwoHbWVzc2FnZRgCIAEoCSI0ChlEZWxldGVPcmdhbml6YXRpb25SZXF1ZXN0EhcKAmlkGAEgASgJQgu6SAjIAQFyA5gBDiItChpEZWxldGVPcmdhbml6YXRpb25SZXNwb25zZRIPCgdtZXNzYWdlGAEgASgJIlkKHUFkZE9yZ2FuaXphdGlvblByb2plY3RSZXF1ZXN0EhcKAmlkGAEgASgJQgu6SAjIAQFyA5gBDhIfCgpwcm9qZWN0X2lkGAIgASgJQgu6SAjIAQFyA5gBDiIxCh5BZGRPcmdhbml6YXRpb25Qcm9qZWN0UmVzcG9uc2USDwoHbWVzc2FnZRgBIAEoCSJcCiBSZW1vdmVPcmdhbml6YXRpb25Qcm9qZWN0UmVxdWVzdBIXCgJpZBgBIAEoCUILukgIyAEBcgOYAQ4SHwoKcHJvamVjdF9pZBgCIAEoCUILukgIyAEBcgOYAQ4iNAohUmVtb3ZlT3JnYW5pemF0aW9uUHJvamVjdFJlc3BvbnNlEg8KB21lc3NhZ2UYASABKAkiOQoeTGlzdE9yZ2FuaXphdGlvbk1lbWJlcnNSZXF1ZXN0EhcKAmlkGAEgASgJQgu6SAjIAQFyA5gBDiJTCh9MaXN0T3JnYW5pemF0aW9uTWVtYmVyc1Jlc3BvbnNlEjAKB21lbWJlcnMYASADKAsyHy5hbHRhbHVuZS52MS5Pcmdhbml6YXRpb25NZW1iZXIifAocU2V0T3JnYW5pemF0aW9uTWVtYmVyUmVxdWVzdBIXCgJpZBgBIAEoCUILukgIyAEBcgOYAQ4SHQoHdXNlcl9pZBgCIAEoCUIMukgJyAEBcgQQDhgUEiQKBHJvbGUYAyABKAlCFrpIE8gBAXIOUgVvd25lclIFYWRtaW4iMAodU2V0T3JnYW5pemF0aW9uTWVtYmVyUmVzcG9uc2USDwoHbWVzc2FnZRgBIAEoCSJZCh9SZW1vdmVPcmdhbml6YXRpb25NZW1iZXJSZXF1ZXN0EhcKAmlkGAEgASgJQgu6SAjIAQFyA5gBDhIdCgd1c2VyX2lkGAIgASgJQgy6SAnIAQFyBBAOGBQiMwogUmVtb3ZlT3JnYW5pemF0aW9uTWVtYmVyUmVzcG9uc2USDwoHbWVzc2FnZRgBIAEoCTLxCQoTT3JnYW5pemF0aW9uU2VydmljZRJwChFMaXN0T3JnYW5pemF0aW9ucxIlLmFsdGFsdW5lLnYxLkxpc3RPcmdhbml6YXRpb25zUmVxdWVzdBomLmFsdGFsdW5lLnYxLkxpc3RPcmdhbml6YXRpb25zUmVzcG9uc2UiDIq1GAhvcmc6cmVhZBJqCg9HZXRPcmdhbml6YXRpb24SIy5hbHRhbHVuZS52MS5HZXRPcmdhbml6YXRpb25SZXF1ZXN0GiQuYWx0YWx1bmUudjEuR2V0T3JnYW5pemF0aW9uUmVzcG9uc2UiDIq1GAhvcmc6cmVhZBJ0ChJDcmVhdGVPcmdhbml6YXRpb24SJi5hbHRhbHVuZS52MS5DcmVhdGVPcmdhbml6YXRpb25SZXF1ZXN0GicuYWx0YWx1bmUudjEuQ3JlYXRlT3JnYW5pemF0aW9uUmVzcG9uc2UiDYq1GAlvcmc6d3JpdGUSdAoSVXBkYXRlT3JnYW5pemF0aW9uEiYuYWx0YWx1bmUudjEuVXBkYXRlT3JnYW5pemF0aW9uUmVxdWVzdBonLmFsdGFsdW5lLnYxLlVwZGF0ZU9yZ2FuaXphdGlvblJlc3BvbnNlIg2KtRgJb3JnOndyaXRlEnQKEkRlbGV0ZU9yZ2FuaXphdGlvbhImLmFsdGFsdW5lLnYxLkRlbGV0ZU9yZ2FuaXphdGlvblJlcXVlc3QaJy5hbHRhbHVuZS52MS5EZWxldGVPcmdhbml6YXRpb25SZXNwb25zZSINirUYCW9yZzp3cml0ZRKAAQoWQWRkT3JnYW5pemF0aW9uUHJvamVjdBIqLmFsdGFsdW5lLnYxLkFkZE9yZ2FuaXphdGlvblByb2plY3RSZXF1ZXN0GisuYWx0YWx1bmUudjEuQWRkT3JnYW5pemF0aW9uUHJvamVjdFJlc3BvbnNlIg2KtRgJb3JnOndyaXRlEokBChlSZW1vdmVPcmdhbml6YXRpb25Qcm9qZWN0Ei0uYWx0YWx1bmUudjEuUmVtb3ZlT3JnYW5pemF0aW9uUHJvamVjdFJlcXVlc3QaLi5hbHRhbHVuZS52MS5SZW1vdmVPcmdhbml6YXRpb25Qcm9qZWN0UmVzcG9uc2UiDYq1GAlvcmc6d3JpdGUSggEKF0xpc3RPcmdhbml6YXRpb25NZW1iZXJzEisuYWx0YWx1bmUudjEuTGlzdE9yZ2FuaXphdGlvbk1lbWJlcnNSZXF1ZXN0GiwuYWx0YWx1bmUudjEuTGlzdE9yZ2FuaXphdGlvbk1lbWJlcnNSZXNwb25zZSIMirUYCG9yZzpyZWFkEn0KFVNldE9yZ2FuaXphdGlvbk1lbWJlchIpLmFsdGFsdW5lLnYxLlNldE9yZ2FuaXphdGlvbk1lbWJlclJlcXVlc3QaKi5hbHRhbHVuZS52MS5TZXRPcmdhbml6YXRpb25NZW1iZXJSZXNwb25zZSINirUYCW9yZzp3cml0ZRKGAQoYUmVtb3ZlT3JnYW5pemF0aW9uTWVtYmVyEiwuYWx0YWx1bmUudjEuUmVtb3ZlT3JnYW5pemF0aW9uTWVtYmVyUmVxdWVzdBotLmFsdGFsdW5lLnYxLlJlbW92ZU9yZ2FuaXphdGlvbk1lbWJlclJlc3BvbnNlIg2KtRgJb3JnOndyaXRlQqYBCg9jb20uYWx0YWx1bmUudjFCEU9yZ2FuaXphdGlvblByb3RvUAFaM2dpdGh1Yi5jb20vaHJ6OC9hbHRhbHVuZS9nZW4vYWx0YWx1bmUvdjE7YWx0YWx1bmV2MaICA0FYWKoCC0FsdGFsdW5lLlYxygILQWx0YWx1bmVcVjHiAhdBbHRhbHVuZVxWMVxHUEJNZXRhZGF0YeoCDEFsdGFsdW5lOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp, file_buf_validate_validate, file_altalune_v1_options]);
+
+/**
+ * Organization Message
+ *
+ * @generated from message altalune.v1.Organization
+ */
+export type Organization = Message<"altalune.v1.Organization"> & {
+  /**
+   * Public nanoid
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * Where invoices are sent, empty for none
+   *
+   * @generated from field: string billing_email = 3;
+   */
+  billingEmail: string;
+
+  /**
+   * ID of the organization at the billing provider, e.g. a Stripe customer
+   *
+   * @generated from field: string billing_customer_id = 4;
+   */
+  billingCustomerId: string;
+
+  /**
+   * @generated from field: int32 project_count = 5;
+   */
+  projectCount: number;
+
+  /**
+   * Role of the caller, empty when the caller is not a member
+   *
+   * @generated from field: string role = 6;
+   */
+  role: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 98;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 99;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message altalune.v1.Organization.
+ * Use `create(OrganizationSchema)` to create a new message.
+ */
+export const OrganizationSchema: GenMessage<Organization> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_organization, 0);
+
+/**
+ * Project of an organization
+ *
+ * @generated from message altalune.v1.OrganizationProject
+ */
+export type OrganizationProject = Message<"altalune.v1.OrganizationProject"> & {
+  /**
+   * Public nanoid
+   *
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string environment = 3;
+   */
+  environment: string;
+};
+
+/**
+ * Describes the message altalune.v1.OrganizationProject.
+ * Use `create(OrganizationProjectSchema)` to create a new message.
+ */
+export const OrganizationProjectSchema: GenMessage<OrganizationProject> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_organization, 1);
+
+/**
+ * Role of a user in a project of the organization
+ *
+ * @generated from message altalune.v1.OrganizationMemberProject
+ */
+export type OrganizationMemberProject = Message<"altalune.v1.OrganizationMemberProject"> & {
+  /**
+   * Public nanoid
+   *
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * Role held as a member of the project
+   *
+   * @generated from field: string role = 2;
+   */
+  role: string;
+};
+
+/**
+ * Describes the message altalune.v1.OrganizationMemberProject.
+ * Use `create(OrganizationMemberProjectSchema)` to create a new message.
+ */
+export const OrganizationMemberProjectSchema: GenMessage<OrganizationMemberProject> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_organization, 2);
+
+/**
+ * User holding a role in the organization, in one of its projects, or both
+ *
+ * @generated from message altalune.v1.OrganizationMember
+ */
+export type OrganizationMember = Message<"altalune.v1.OrganizationMember"> & {
+  /**
+   * Public nanoid
+   *
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string email = 2;
+   */
+  email: string;
+
+  /**
+   * @generated from field: string first_name = 3;
+   */
+  firstName: string;
+
+  /**
+   * @generated from field: string last_name = 4;
+   */
+  lastName: string;
+
+  /**
+   * owner or admin, empty when only a project member
+   *
+   * @generated from field: string role = 5;
+   */
+  role: string;
+
+  /**
+   * @generated from field: repeated altalune.v1.OrganizationMemberProject projects = 6;
+   */
+  projects: OrganizationMemberProject[];
+};
+
+/**
+ * Describes the message altalune.v1.OrganizationMember.
+ * Use `create(OrganizationMemberSchema)` to create a new message.
+ */
+export const OrganizationMemberSchema: GenMessage<OrganizationMember> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_organization, 3);
+
+/**
+ * @generated from message altalune.v1.ListOrganizationsRequest
+ */
+export type ListOrganizationsRequest = Message<"altalune.v1.ListOrganizationsRequest"> & {
+};
+
+/**
+ * Describes the message altalune.v1.ListOrganizationsRequest.
+ * Use `create(ListOrganizationsRequestSchema)` to create a new message.
+ */
+export const ListOrganizationsRequestSchema: GenMessage<ListOrganizationsRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_organization, 4);
+
+/**
+ * @generated from message altalune.v1.ListOrganizationsResponse
+ */
+export type ListOrganizationsResponse = Message<"altalune.v1.ListOrganizationsResponse"> & {
+  /**
+   * The organizations of the caller, all of them for superadmins
+   *
+   * @generated from field: repeated altalune.v1.Organization data = 1;
+   */
+  data: Organization[];
+};
+
+/**
+ * Describes the message altalune.v1.ListOrganizationsResponse.
+ * Use `create(ListOrganizationsResponseSchema)` to create a new message.
+ */
+export const ListOrganizationsResponseSchema: GenMessage<ListOrganizationsResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_organization, 5);
+
+/**
+ * @generated from message altalune.v1.GetOrganizationRequest
+ */
+export type GetOrganizationRequest = Message<"altalune.v1.GetOrganizationRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message altalune.v1.GetOrganizationRequest.
+ * Use `create(GetOrganizationRequestSchema)` to create a new message.
+ */
+export const GetOrganizationRequestSchema: GenMessage<GetOrganizationRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_organization, 6);
+
+/**
+ * @generated from message altalune.v1.GetOrganizationResponse
+ */
+export type GetOrganizationResponse = Message<"altalune.v1.GetOrganizationResponse"> & {
+  /**
+   * @generated from field: altalune.v1.Organization organization = 1;
+   */
+  organization?: Organization;
+
+  /**
+   * @generated from field: repeated altalune.v1.OrganizationProject projects = 2;
+   */
+  projects: OrganizationProject[];
+};
+
+/**
+ * Describes the message altalune.v1.GetOrganizationResponse.
+ * Use `create(GetOrganizationResponseSchema)` to create a new message.
+ */
+export const GetOrganizationResponseSchema: GenMessage<GetOrganizationResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_organization, 7);
+
+/**
+ * @generated from message altalune.v1.CreateOrganizationRequest
+ */
+export type CreateOrganizationRequest = Message<"altalune.v1.CreateOrganizationRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string billing_email = 2;
+   */
+  billingEmail: string;
+
+  /**
+   * @generated from field: string billing_customer_id = 3;
+   */
+  billingCustomerId: string;
+};
+
+/**
+ * Describes the message altalune.v1.CreateOrganizationRequest.
+ * Use `create(CreateOrganizationRequestSchema)` to create a new message.
+ */
+export const CreateOrganizationRequestSchema: GenMessage<CreateOrganizationRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_organization, 8);
+
+/**
+ * @generated from message altalune.v1.CreateOrganizationResponse
+ */
+export type CreateOrganizationResponse = Message<"altalune.v1.CreateOrganizationResponse"> & {
+  /**
+   * The caller is its owner
+   *
+   * @generated from field: altalune.v1.Organization organization = 1;
+   */
+  organization?: Organization;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.CreateOrganizationResponse.
+ * Use `create(CreateOrganizationResponseSchema)` to create a new message.
+ */
+export const CreateOrganizationResponseSchema: GenMessage<CreateOrganizationResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_organization, 9);
+
+/**
+ * @generated from message altalune.v1.UpdateOrganizationRequest
+ */
+export type UpdateOrganizationRequest = Message<"altalune.v1.UpdateOrganizationRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string billing_email = 3;
+   */
+  billingEmail: string;
+
+  /**
+   * @generated from field: string billing_customer_id = 4;
+   */
+  billingCustomerId: string;
+};
+
+/**
+ * Describes the message altalune.v1.UpdateOrganizationRequest.
+ * Use `create(UpdateOrganizationRequestSchema)` to create a new message.
+ */
+export const UpdateOrganizationRequestSchema: GenMessage<UpdateOrganizationRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_organization, 10);
+
+/**
+ * @generated from message altalune.v1.UpdateOrganizationResponse
+ */
+export type UpdateOrganizationResponse = Message<"altalune.v1.UpdateOrganizationResponse"> & {
+  /**
+   * @generated from field: altalune.v1.Organization organization = 1;
+   */
+  organization?: Organization;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.UpdateOrganizationResponse.
+ * Use `create(UpdateOrganizationResponseSchema)` to create a new message.
+ */
+export const UpdateOrganizationResponseSchema: GenMessage<UpdateOrganizationResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_organization, 11);
+
+/**
+ * Deleting an organization keeps its projects, without an organization
+ *
+ * @generated from message altalune.v1.DeleteOrganizationRequest
+ */
+export type DeleteOrganizationRequest = Message<"altalune.v1.DeleteOrganizationRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message altalune.v1.DeleteOrganizationRequest.
+ * Use `create(DeleteOrganizationRequestSchema)` to create a new message.
+ */
+export const DeleteOrganizationRequestSchema: GenMessage<DeleteOrganizationRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_organization, 12);
+
+/**
+ * @generated from message altalune.v1.DeleteOrganizationResponse
+ */
+export type DeleteOrganizationResponse = Message<"altalune.v1.DeleteOrganizationResponse"> & {
+  /**
+   * @generated from field: string message = 1;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.DeleteOrganizationResponse.
+ * Use `create(DeleteOrganizationResponseSchema)` to create a new message.
+ */
+export const DeleteOrganizationResponseSchema: GenMessage<DeleteOrganizationResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_organization, 13);
+
+/**
+ * The caller must own the project, a project belonging to one organization
+ * at most
+ *
+ * @generated from message altalune.v1.AddOrganizationProjectRequest
+ */
+export type AddOrganizationProjectRequest = Message<"altalune.v1.AddOrganizationProjectRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string project_id = 2;
+   */
+  projectId: string;
+};
+
+/**
+ * Describes the message altalune.v1.AddOrganizationProjectRequest.
+ * Use `create(AddOrganizationProjectRequestSchema)` to create a new message.
+ */
+export const AddOrganizationProjectRequestSchema: GenMessage<AddOrganizationProjectRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_organization, 14);
+
+/**
+ * @generated from message altalune.v1.AddOrganizationProjectResponse
+ */
+export type AddOrganizationProjectResponse = Message<"altalune.v1.AddOrganizationProjectResponse"> & {
+  /**
+   * @generated from field: string message = 1;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.AddOrganizationProjectResponse.
+ * Use `create(AddOrganizationProjectResponseSchema)` to create a new message.
+ */
+export const AddOrganizationProjectResponseSchema: GenMessage<AddOrganizationProjectResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_organization, 15);
+
+/**
+ * @generated from message altalune.v1.RemoveOrganizationProjectRequest
+ */
+export type RemoveOrganizationProjectRequest = Message<"altalune.v1.RemoveOrganizationProjectRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string project_id = 2;
+   */
+  projectId: string;
+};
+
+/**
+ * Describes the message altalune.v1.RemoveOrganizationProjectRequest.
+ * Use `create(RemoveOrganizationProjectRequestSchema)` to create a new message.
+ */
+export const RemoveOrganizationProjectRequestSchema: GenMessage<RemoveOrganizationProjectRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_organization, 16);
+
+/**
+ * @generated from message altalune.v1.RemoveOrganizationProjectResponse
+ */
+export type RemoveOrganizationProjectResponse = Message<"altalune.v1.RemoveOrganizationProjectResponse"> & {
+  /**
+   * @generated from field: string message = 1;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.RemoveOrganizationProjectResponse.
+ * Use `create(RemoveOrganizationProjectResponseSchema)` to create a new message.
+ */
+export const RemoveOrganizationProjectResponseSchema: GenMessage<RemoveOrganizationProjectResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_organization, 17);
+
+/**
+ * @generated from message altalune.v1.ListOrganizationMembersRequest
+ */
+export type ListOrganizationMembersRequest = Message<"altalune.v1.ListOrganizationMembersRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message altalune.v1.ListOrganizationMembersRequest.
+ * Use `create(ListOrganizationMembersRequestSchema)` to create a new message.
+ */
+export const ListOrganizationMembersRequestSchema: GenMessage<ListOrganizationMembersRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_organization, 18);
+
+/**
+ * @generated from message altalune.v1.ListOrganizationMembersResponse
+ */
+export type ListOrganizationMembersResponse = Message<"altalune.v1.ListOrganizationMembersResponse"> & {
+  /**
+   * Organization owners first, then admins, then by email
+   *
+   * @generated from field: repeated altalune.v1.OrganizationMember members = 1;
+   */
+  members: OrganizationMember[];
+};
+
+/**
+ * Describes the message altalune.v1.ListOrganizationMembersResponse.
+ * Use `create(ListOrganizationMembersResponseSchema)` to create a new message.
+ */
+export const ListOrganizationMembersResponseSchema: GenMessage<ListOrganizationMembersResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_organization, 19);
+
+/**
+ * Adds a user to the organization or changes their role. Admins can neither
+ * make owners nor change the role of one.
+ *
+ * @generated from message altalune.v1.SetOrganizationMemberRequest
+ */
+export type SetOrganizationMemberRequest = Message<"altalune.v1.SetOrganizationMemberRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string role = 3;
+   */
+  role: string;
+};
+
+/**
+ * Describes the message altalune.v1.SetOrganizationMemberRequest.
+ * Use `create(SetOrganizationMemberRequestSchema)` to create a new message.
+ */
+export const SetOrganizationMemberRequestSchema: GenMessage<SetOrganizationMemberRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_organization, 20);
+
+/**
+ * @generated from message altalune.v1.SetOrganizationMemberResponse
+ */
+export type SetOrganizationMemberResponse = Message<"altalune.v1.SetOrganizationMemberResponse"> & {
+  /**
+   * @generated from field: string message = 1;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.SetOrganizationMemberResponse.
+ * Use `create(SetOrganizationMemberResponseSchema)` to create a new message.
+ */
+export const SetOrganizationMemberResponseSchema: GenMessage<SetOrganizationMemberResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_organization, 21);
+
+/**
+ * An organization keeps at least one owner
+ *
+ * @generated from message altalune.v1.RemoveOrganizationMemberRequest
+ */
+export type RemoveOrganizationMemberRequest = Message<"altalune.v1.RemoveOrganizationMemberRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message altalune.v1.RemoveOrganizationMemberRequest.
+ * Use `create(RemoveOrganizationMemberRequestSchema)` to create a new message.
+ */
+export const RemoveOrganizationMemberRequestSchema: GenMessage<RemoveOrganizationMemberRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_organization, 22);
+
+/**
+ * @generated from message altalune.v1.RemoveOrganizationMemberResponse
+ */
+export type RemoveOrganizationMemberResponse = Message<"altalune.v1.RemoveOrganizationMemberResponse"> & {
+  /**
+   * @generated from field: string message = 1;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.RemoveOrganizationMemberResponse.
+ * Use `create(RemoveOrganizationMemberResponseSchema)` to create a new message.
+ */
+export const RemoveOrganizationMemberResponseSchema: GenMessage<RemoveOrganizationMemberResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_organization, 23);
+
+/**
+ * Organization Service - Group projects under an organization whose owners
+ * and admins hold that role in every project of it, and which billing is
+ * attached to
+ *
+ * @generated from service altalune.v1.OrganizationService
+ */
+export const OrganizationService: GenService<{
+  /**
+   * @generated from rpc altalune.v1.OrganizationService.ListOrganizations
+   */
+  listOrganizations: {
+    methodKind: "unary";
+    input: typeof ListOrganizationsRequestSchema;
+    output: typeof ListOrganizationsResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.OrganizationService.GetOrganization
+   */
+  getOrganization: {
+    methodKind: "unary";
+    input: typeof GetOrganizationRequestSchema;
+    output: typeof GetOrganizationResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.OrganizationService.CreateOrganization
+   */
+  createOrganization: {
+    methodKind: "unary";
+    input: typeof CreateOrganizationRequestSchema;
+    output: typeof CreateOrganizationResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.OrganizationService.UpdateOrganization
+   */
+  updateOrganization: {
+    methodKind: "unary";
+    input: typeof UpdateOrganizationRequestSchema;
+    output: typeof UpdateOrganizationResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.OrganizationService.DeleteOrganization
+   */
+  deleteOrganization: {
+    methodKind: "unary";
+    input: typeof DeleteOrganizationRequestSchema;
+    output: typeof DeleteOrganizationResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.OrganizationService.AddOrganizationProject
+   */
+  addOrganizationProject: {
+    methodKind: "unary";
+    input: typeof AddOrganizationProjectRequestSchema;
+    output: typeof AddOrganizationProjectResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.OrganizationService.RemoveOrganizationProject
+   */
+  removeOrganizationProject: {
+    methodKind: "unary";
+    input: typeof RemoveOrganizationProjectRequestSchema;
+    output: typeof RemoveOrganizationProjectResponseSchema;
+  },
+  /**
+   * Members of the organization and of each of its projects
+   *
+   * @generated from rpc altalune.v1.OrganizationService.ListOrganizationMembers
+   */
+  listOrganizationMembers: {
+    methodKind: "unary";
+    input: typeof ListOrganizationMembersRequestSchema;
+    output: typeof ListOrganizationMembersResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.OrganizationService.SetOrganizationMember
+   */
+  setOrganizationMember: {
+    methodKind: "unary";
+    input: typeof SetOrganizationMemberRequestSchema;
+    output: typeof SetOrganizationMemberResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.OrganizationService.RemoveOrganizationMember
+   */
+  removeOrganizationMember: {
+    methodKind: "unary";
+    input: typeof RemoveOrganizationMemberRequestSchema;
+    output: typeof RemoveOrganizationMemberResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_altalune_v1_organization, 0);
+
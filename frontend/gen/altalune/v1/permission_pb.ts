@@ -9,13 +9,14 @@ import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import { file_buf_validate_validate } from "../../buf/validate/validate_pb.js";
 import type { QueryMetaResponse, QueryRequest } from "./common_pb.js";
 import { file_altalune_v1_common } from "./common_pb.js";
+import { file_altalune_v1_options } from "./options_pb.js";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file altalune/v1/permission.proto.
  */
 export const file_altalune_v1_permission: GenFile = /*@__PURE__*/
-  fileDesc("ChxhbHRhbHVuZS92MS9wZXJtaXNzaW9uLnByb3RvEgthbHRhbHVuZS52MSKbAQoKUGVybWlzc2lvbhIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEi4KCmNyZWF0ZWRfYXQYYiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYYyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIkMKF1F1ZXJ5UGVybWlzc2lvbnNSZXF1ZXN0EigKBXF1ZXJ5GAEgASgLMhkuYWx0YWx1bmUudjEuUXVlcnlSZXF1ZXN0Im8KGFF1ZXJ5UGVybWlzc2lvbnNSZXNwb25zZRIlCgRkYXRhGAEgAygLMhcuYWx0YWx1bmUudjEuUGVybWlzc2lvbhIsCgRtZXRhGAIgASgLMh4uYWx0YWx1bmUudjEuUXVlcnlNZXRhUmVzcG9uc2UiZgoXQ3JlYXRlUGVybWlzc2lvblJlcXVlc3QSLAoEbmFtZRgBIAEoCUIeukgbyAEBchYQAhhkMhBeW2EtekEtWjAtOV86XSskEh0KC2Rlc2NyaXB0aW9uGAIgASgJQgi6SAVyAxj0AyJYChhDcmVhdGVQZXJtaXNzaW9uUmVzcG9uc2USKwoKcGVybWlzc2lvbhgBIAEoCzIXLmFsdGFsdW5lLnYxLlBlcm1pc3Npb24SDwoHbWVzc2FnZRgCIAEoCSIwChRHZXRQZXJtaXNzaW9uUmVxdWVzdBIYCgJpZBgBIAEoCUIMukgJyAEBcgQQDhgUIkQKFUdldFBlcm1pc3Npb25SZXNwb25zZRIrCgpwZXJtaXNzaW9uGAEgASgLMhcuYWx0YWx1bmUudjEuUGVybWlzc2lvbiKAAQoXVXBkYXRlUGVybWlzc2lvblJlcXVlc3QSGAoCaWQYASABKAlCDLpICcgBAXIEEA4YFBIsCgRuYW1lGAIgASgJQh66SBvIAQFyFhACGGQyEF5bYS16QS1aMC05XzpdKyQSHQoLZGVzY3JpcHRpb24YAyABKAlCCLpIBXIDGPQDIlgKGFVwZGF0ZVBlcm1pc3Npb25SZXNwb25zZRIrCgpwZXJtaXNzaW9uGAEgASgLMhcuYWx0YWx1bmUudjEuUGVybWlzc2lvbhIPCgdtZXNzYWdlGAIgASgJIjMKF0RlbGV0ZVBlcm1pc3Npb25SZXF1ZXN0EhgKAmlkGAEgASgJQgy6SAnIAQFyBBAOGBQiKwoYRGVsZXRlUGVybWlzc2lvblJlc3BvbnNlEg8KB21lc3NhZ2UYASABKAky+QMKEVBlcm1pc3Npb25TZXJ2aWNlEmEKEFF1ZXJ5UGVybWlzc2lvbnMSJC5hbHRhbHVuZS52MS5RdWVyeVBlcm1pc3Npb25zUmVxdWVzdBolLmFsdGFsdW5lLnYxLlF1ZXJ5UGVybWlzc2lvbnNSZXNwb25zZSIAEmEKEENyZWF0ZVBlcm1pc3Npb24SJC5hbHRhbHVuZS52MS5DcmVhdGVQZXJtaXNzaW9uUmVxdWVzdBolLmFsdGFsdW5lLnYxLkNyZWF0ZVBlcm1pc3Npb25SZXNwb25zZSIAElgKDUdldFBlcm1pc3Npb24SIS5hbHRhbHVuZS52MS5HZXRQZXJtaXNzaW9uUmVxdWVzdBoiLmFsdGFsdW5lLnYxLkdldFBlcm1pc3Npb25SZXNwb25zZSIAEmEKEFVwZGF0ZVBlcm1pc3Npb24SJC5hbHRhbHVuZS52MS5VcGRhdGVQZXJtaXNzaW9uUmVxdWVzdBolLmFsdGFsdW5lLnYxLlVwZGF0ZVBlcm1pc3Npb25SZXNwb25zZSIAEmEKEERlbGV0ZVBlcm1pc3Npb24SJC5hbHRhbHVuZS52MS5EZWxldGVQZXJtaXNzaW9uUmVxdWVzdBolLmFsdGFsdW5lLnYxLkRlbGV0ZVBlcm1pc3Npb25SZXNwb25zZSIAQqQBCg9jb20uYWx0YWx1bmUudjFCD1Blcm1pc3Npb25Qcm90b1ABWjNnaXRodWIuY29tL2hyejgvYWx0YWx1bmUvZ2VuL2FsdGFsdW5lL3YxO2FsdGFsdW5ldjGiAgNBWFiqAgtBbHRhbHVuZS5WMcoCC0FsdGFsdW5lXFYx4gIXQWx0YWx1bmVcVjFcR1BCTWV0YWRhdGHqAgxBbHRhbHVuZTo6VjFiBnByb3RvMw", [file_google_protobuf_timestamp, file_buf_validate_validate, file_altalune_v1_common]);
+  fileDesc("ChxhbHRhbHVuZS92MS9wZXJtaXNzaW9uLnByb3RvEgthbHRhbHVuZS52MSLDAQoKUGVybWlzc2lvbhIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEhIKCmNyZWF0ZWRfYnkYBCABKAkSEgoKdXBkYXRlZF9ieRgFIAEoCRIuCgpjcmVhdGVkX2F0GGIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GGMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJDChdRdWVyeVBlcm1pc3Npb25zUmVxdWVzdBIoCgVxdWVyeRgBIAEoCzIZLmFsdGFsdW5lLnYxLlF1ZXJ5UmVxdWVzdCJvChhRdWVyeVBlcm1pc3Npb25zUmVzcG9uc2USJQoEZGF0YRgBIAMoCzIXLmFsdGFsdW5lLnYxLlBlcm1pc3Npb24SLAoEbWV0YRgCIAEoCzIeLmFsdGFsdW5lLnYxLlF1ZXJ5TWV0YVJlc3BvbnNlImYKF0NyZWF0ZVBlcm1pc3Npb25SZXF1ZXN0EiwKBG5hbWUYASABKAlCHrpIG8gBAXIWEAIYZDIQXlthLXpBLVowLTlfOl0rJBIdCgtkZXNjcmlwdGlvbhgCIAEoCUIIukgFcgMY9AMiWAoYQ3JlYXRlUGVybWlzc2lvblJlc3BvbnNlEisKCnBlcm1pc3Npb24YASABKAsyFy5hbHRhbHVuZS52MS5QZXJtaXNzaW9uEg8KB21lc3NhZ2UYAiABKAkiMAoUR2V0UGVybWlzc2lvblJlcXVlc3QSGAoCaWQYASABKAlCDLpICcgBAXIEEA4YFCJEChVHZXRQZXJtaXNzaW9uUmVzcG9uc2USKwoKcGVybWlzc2lvbhgBIAEoCzIXLmFsdGFsdW5lLnYxLlBlcm1pc3Npb24iuQEKF1VwZGF0ZVBlcm1pc3Npb25SZXF1ZXN0EhgKAmlkGAEgASgJQgy6SAnIAQFyBBAOGBQSLAoEbmFtZRgCIAEoCUIeukgbyAEBchYQAhhkMhBeW2EtekEtWjAtOV86XSskEh0KC2Rlc2NyaXB0aW9uGAMgASgJQgi6SAVyAxj0AxI3ChNleHBlY3RlZF91cGRhdGVkX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJYChhVcGRhdGVQZXJtaXNzaW9uUmVzcG9uc2USKwoKcGVybWlzc2lvbhgBIAEoCzIXLmFsdGFsdW5lLnYxLlBlcm1pc3Npb24SDwoHbWVzc2FnZRgCIAEoCSIzChdEZWxldGVQZXJtaXNzaW9uUmVxdWVzdBIYCgJpZBgBIAEoCUIMukgJyAEBcgQQDhgUIisKGERlbGV0ZVBlcm1pc3Npb25SZXNwb25zZRIPCgdtZXNzYWdlGAEgASgJMtwEChFQZXJtaXNzaW9uU2VydmljZRJ0ChBRdWVyeVBlcm1pc3Npb25zEiQuYWx0YWx1bmUudjEuUXVlcnlQZXJtaXNzaW9uc1JlcXVlc3QaJS5hbHRhbHVuZS52MS5RdWVyeVBlcm1pc3Npb25zUmVzcG9uc2UiE4q1GA9wZXJtaXNzaW9uOnJlYWQSdQoQQ3JlYXRlUGVybWlzc2lvbhIkLmFsdGFsdW5lLnYxLkNyZWF0ZVBlcm1pc3Npb25SZXF1ZXN0GiUuYWx0YWx1bmUudjEuQ3JlYXRlUGVybWlzc2lvblJlc3BvbnNlIhSKtRgQcGVybWlzc2lvbjp3cml0ZRJrCg1HZXRQZXJtaXNzaW9uEiEuYWx0YWx1bmUudjEuR2V0UGVybWlzc2lvblJlcXVlc3QaIi5hbHRhbHVuZS52MS5HZXRQZXJtaXNzaW9uUmVzcG9uc2UiE4q1GA9wZXJtaXNzaW9uOnJlYWQSdQoQVXBkYXRlUGVybWlzc2lvbhIkLmFsdGFsdW5lLnYxLlVwZGF0ZVBlcm1pc3Npb25SZXF1ZXN0GiUuYWx0YWx1bmUudjEuVXBkYXRlUGVybWlzc2lvblJlc3BvbnNlIhSKtRgQcGVybWlzc2lvbjp3cml0ZRJ2ChBEZWxldGVQZXJtaXNzaW9uEiQuYWx0YWx1bmUudjEuRGVsZXRlUGVybWlzc2lvblJlcXVlc3QaJS5hbHRhbHVuZS52MS5EZWxldGVQZXJtaXNzaW9uUmVzcG9uc2UiFYq1GBFwZXJtaXNzaW9uOmRlbGV0ZUKkAQoPY29tLmFsdGFsdW5lLnYxQg9QZXJtaXNzaW9uUHJvdG9QAVozZ2l0aHViLmNvbS9ocno4L2FsdGFsdW5lL2dlbi9hbHRhbHVuZS92MTthbHRhbHVuZXYxogIDQVhYqgILQWx0YWx1bmUuVjHKAgtBbHRhbHVuZVxWMeICF0FsdGFsdW5lXFYxXEdQQk1ldGFkYXRh6gIMQWx0YWx1bmU6OlYxYgZwcm90bzM", [file_google_protobuf_timestamp, file_buf_validate_validate, file_altalune_v1_common, file_altalune_v1_options]);
 
 /**
  * Permission represents a system permission
@@ -44,6 +45,20 @@ export type Permission = Message<"altalune.v1.Permission"> & {
    */
   description: string;
 
+  /**
+   * Public ID of the user who created the permission, empty if unknown
+   *
+   * @generated from field: string created_by = 4;
+   */
+  createdBy: string;
+
+  /**
+   * Public ID of the user who last updated the permission, empty if unknown
+   *
+   * @generated from field: string updated_by = 5;
+   */
+  updatedBy: string;
+
   /**
    * @generated from field: google.protobuf.Timestamp created_at = 98;
    */
@@ -211,6 +226,14 @@ export type UpdatePermissionRequest = Message<"altalune.v1.UpdatePermissionReque
    * @generated from field: string description = 3;
    */
   description: string;
+
+  /**
+   * updated_at of the permission the edit is based on. When set, the update is
+   * rejected with FAILED_PRECONDITION if the permission changed since then.
+   *
+   * @generated from field: google.protobuf.Timestamp expected_updated_at = 4;
+   */
+  expectedUpdatedAt?: Timestamp;
 };
 
 /**
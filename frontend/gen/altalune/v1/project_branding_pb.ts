@@ -0,0 +1,303 @@
+// @generated by protoc-gen-es v2.6.3 with parameter "target=ts,import_extension=js"
+// @generated from file altalune/v1/project_branding.proto (package altalune.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import { file_buf_validate_validate } from "../../buf/validate/validate_pb.js";
+import { file_altalune_v1_options } from "./options_pb.js";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file altalune/v1/project_branding.proto.
+ */
+export const file_altalune_v1_project_branding: GenFile = /*@__PURE__*/
+  fileDesc("CiJhbHRhbHVuZS92MS9wcm9qZWN0X2JyYW5kaW5nLnByb3RvEgthbHRhbHVuZS52MSJGCgpGb290ZXJMaW5rEhsKBWxhYmVsGAEgASgJQgy6SAnIAQFyBBABGDISGwoDdXJsGAIgASgJQg66SAvIAQFyBhj0A4gBASKxAQoPUHJvamVjdEJyYW5kaW5nEhUKDXByaW1hcnlfY29sb3IYASABKAkSEAoIbG9nb191cmwYAiABKAkSLQoMZm9vdGVyX2xpbmtzGAMgAygLMhcuYWx0YWx1bmUudjEuRm9vdGVyTGluaxIWCg5kZWZhdWx0X2xvY2FsZRgEIAEoCRIuCgp1cGRhdGVkX2F0GGMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCI8ChlHZXRQcm9qZWN0QnJhbmRpbmdSZXF1ZXN0Eh8KCnByb2plY3RfaWQYASABKAlCC7pICMgBAXIDmAEOIkwKGkdldFByb2plY3RCcmFuZGluZ1Jlc3BvbnNlEi4KCGJyYW5kaW5nGAEgASgLMhwuYWx0YWx1bmUudjEuUHJvamVjdEJyYW5kaW5nItABChxVcGRhdGVQcm9qZWN0QnJhbmRpbmdSZXF1ZXN0Eh8KCnByb2plY3RfaWQYASABKAlCC7pICMgBAXIDmAEOEjIKDXByaW1hcnlfY29sb3IYAiABKAlCG7pIGNgBAXITMhFeI1swLTlhLWZBLUZdezZ9JBI3Cgxmb290ZXJfbGlua3MYAyADKAsyFy5hbHRhbHVuZS52MS5Gb290ZXJMaW5rQgi6SAWSAQIQChIiCg5kZWZhdWx0X2xvY2FsZRgEIAEoCUIKukgH2AEBcgIYCiJgCh1VcGRhdGVQcm9qZWN0QnJhbmRpbmdSZXNwb25zZRIuCghicmFuZGluZxgBIAEoCzIcLmFsdGFsdW5lLnYxLlByb2plY3RCcmFuZGluZxIPCgdtZXNzYWdlGAIgASgJIqwBChhVcGxvYWRQcm9qZWN0TG9nb1JlcXVlc3QSHwoKcHJvamVjdF9pZBgBIAEoCUILukgIyAEBcgOYAQ4SHQoHY29udGVudBgCIAEoDEIMukgJyAEBegQYgIAgElAKDGNvbnRlbnRfdHlwZRgDIAEoCUI6ukg3yAEBcjJSCWltYWdlL3BuZ1IKaW1hZ2UvanBlZ1IKaW1hZ2Uvd2VicFINaW1hZ2Uvc3ZnK3htbCJcChlVcGxvYWRQcm9qZWN0TG9nb1Jlc3BvbnNlEi4KCGJyYW5kaW5nGAEgASgLMhwuYWx0YWx1bmUudjEuUHJvamVjdEJyYW5kaW5nEg8KB21lc3NhZ2UYAiABKAkiOwoYRGVsZXRlUHJvamVjdExvZ29SZXF1ZXN0Eh8KCnByb2plY3RfaWQYASABKAlCC7pICMgBAXIDmAEOIlwKGURlbGV0ZVByb2plY3RMb2dvUmVzcG9uc2USLgoIYnJhbmRpbmcYASABKAsyHC5hbHRhbHVuZS52MS5Qcm9qZWN0QnJhbmRpbmcSDwoHbWVzc2FnZRgCIAEoCTKDBAoWUHJvamVjdEJyYW5kaW5nU2VydmljZRJ3ChJHZXRQcm9qZWN0QnJhbmRpbmcSJi5hbHRhbHVuZS52MS5HZXRQcm9qZWN0QnJhbmRpbmdSZXF1ZXN0GicuYWx0YWx1bmUudjEuR2V0UHJvamVjdEJyYW5kaW5nUmVzcG9uc2UiEIq1GAxwcm9qZWN0OnJlYWQSgQEKFVVwZGF0ZVByb2plY3RCcmFuZGluZxIpLmFsdGFsdW5lLnYxLlVwZGF0ZVByb2plY3RCcmFuZGluZ1JlcXVlc3QaKi5hbHRhbHVuZS52MS5VcGRhdGVQcm9qZWN0QnJhbmRpbmdSZXNwb25zZSIRirUYDXByb2plY3Q6d3JpdGUSdQoRVXBsb2FkUHJvamVjdExvZ28SJS5hbHRhbHVuZS52MS5VcGxvYWRQcm9qZWN0TG9nb1JlcXVlc3QaJi5hbHRhbHVuZS52MS5VcGxvYWRQcm9qZWN0TG9nb1Jlc3BvbnNlIhGKtRgNcHJvamVjdDp3cml0ZRJ1ChFEZWxldGVQcm9qZWN0TG9nbxIlLmFsdGFsdW5lLnYxLkRlbGV0ZVByb2plY3RMb2dvUmVxdWVzdBomLmFsdGFsdW5lLnYxLkRlbGV0ZVByb2plY3RMb2dvUmVzcG9uc2UiEYq1GA1wcm9qZWN0OndyaXRlQqkBCg9jb20uYWx0YWx1bmUudjFCFFByb2plY3RCcmFuZGluZ1Byb3RvUAFaM2dpdGh1Yi5jb20vaHJ6OC9hbHRhbHVuZS9nZW4vYWx0YWx1bmUvdjE7YWx0YWx1bmV2MaICA0FYWKoCC0FsdGFsdW5lLlYxygILQWx0YWx1bmVcVjHiAhdBbHRhbHVuZVxWMVxHUEJNZXRhZGF0YeoCDEFsdGFsdW5lOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp, file_buf_validate_validate, file_altalune_v1_options]);
+
+/**
+ * @generated from message altalune.v1.FooterLink
+ */
+export type FooterLink = Message<"altalune.v1.FooterLink"> & {
+  /**
+   * @generated from field: string label = 1;
+   */
+  label: string;
+
+  /**
+   * @generated from field: string url = 2;
+   */
+  url: string;
+};
+
+/**
+ * Describes the message altalune.v1.FooterLink.
+ * Use `create(FooterLinkSchema)` to create a new message.
+ */
+export const FooterLinkSchema: GenMessage<FooterLink> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_project_branding, 0);
+
+/**
+ * Project Branding Message
+ * Applies to every hostname of the project; hostname-level branding wins.
+ *
+ * @generated from message altalune.v1.ProjectBranding
+ */
+export type ProjectBranding = Message<"altalune.v1.ProjectBranding"> & {
+  /**
+   * Hex color, e.g. #0d6efd
+   *
+   * @generated from field: string primary_color = 1;
+   */
+  primaryColor: string;
+
+  /**
+   * Auth server path of the uploaded logo, empty if none
+   *
+   * @generated from field: string logo_url = 2;
+   */
+  logoUrl: string;
+
+  /**
+   * @generated from field: repeated altalune.v1.FooterLink footer_links = 3;
+   */
+  footerLinks: FooterLink[];
+
+  /**
+   * Fallback auth page locale, empty for the server default
+   *
+   * @generated from field: string default_locale = 4;
+   */
+  defaultLocale: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 99;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message altalune.v1.ProjectBranding.
+ * Use `create(ProjectBrandingSchema)` to create a new message.
+ */
+export const ProjectBrandingSchema: GenMessage<ProjectBranding> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_project_branding, 1);
+
+/**
+ * @generated from message altalune.v1.GetProjectBrandingRequest
+ */
+export type GetProjectBrandingRequest = Message<"altalune.v1.GetProjectBrandingRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+};
+
+/**
+ * Describes the message altalune.v1.GetProjectBrandingRequest.
+ * Use `create(GetProjectBrandingRequestSchema)` to create a new message.
+ */
+export const GetProjectBrandingRequestSchema: GenMessage<GetProjectBrandingRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_project_branding, 2);
+
+/**
+ * @generated from message altalune.v1.GetProjectBrandingResponse
+ */
+export type GetProjectBrandingResponse = Message<"altalune.v1.GetProjectBrandingResponse"> & {
+  /**
+   * @generated from field: altalune.v1.ProjectBranding branding = 1;
+   */
+  branding?: ProjectBranding;
+};
+
+/**
+ * Describes the message altalune.v1.GetProjectBrandingResponse.
+ * Use `create(GetProjectBrandingResponseSchema)` to create a new message.
+ */
+export const GetProjectBrandingResponseSchema: GenMessage<GetProjectBrandingResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_project_branding, 3);
+
+/**
+ * @generated from message altalune.v1.UpdateProjectBrandingRequest
+ */
+export type UpdateProjectBrandingRequest = Message<"altalune.v1.UpdateProjectBrandingRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: string primary_color = 2;
+   */
+  primaryColor: string;
+
+  /**
+   * @generated from field: repeated altalune.v1.FooterLink footer_links = 3;
+   */
+  footerLinks: FooterLink[];
+
+  /**
+   * @generated from field: string default_locale = 4;
+   */
+  defaultLocale: string;
+};
+
+/**
+ * Describes the message altalune.v1.UpdateProjectBrandingRequest.
+ * Use `create(UpdateProjectBrandingRequestSchema)` to create a new message.
+ */
+export const UpdateProjectBrandingRequestSchema: GenMessage<UpdateProjectBrandingRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_project_branding, 4);
+
+/**
+ * @generated from message altalune.v1.UpdateProjectBrandingResponse
+ */
+export type UpdateProjectBrandingResponse = Message<"altalune.v1.UpdateProjectBrandingResponse"> & {
+  /**
+   * @generated from field: altalune.v1.ProjectBranding branding = 1;
+   */
+  branding?: ProjectBranding;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.UpdateProjectBrandingResponse.
+ * Use `create(UpdateProjectBrandingResponseSchema)` to create a new message.
+ */
+export const UpdateProjectBrandingResponseSchema: GenMessage<UpdateProjectBrandingResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_project_branding, 5);
+
+/**
+ * @generated from message altalune.v1.UploadProjectLogoRequest
+ */
+export type UploadProjectLogoRequest = Message<"altalune.v1.UploadProjectLogoRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: bytes content = 2;
+   */
+  content: Uint8Array;
+
+  /**
+   * @generated from field: string content_type = 3;
+   */
+  contentType: string;
+};
+
+/**
+ * Describes the message altalune.v1.UploadProjectLogoRequest.
+ * Use `create(UploadProjectLogoRequestSchema)` to create a new message.
+ */
+export const UploadProjectLogoRequestSchema: GenMessage<UploadProjectLogoRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_project_branding, 6);
+
+/**
+ * @generated from message altalune.v1.UploadProjectLogoResponse
+ */
+export type UploadProjectLogoResponse = Message<"altalune.v1.UploadProjectLogoResponse"> & {
+  /**
+   * @generated from field: altalune.v1.ProjectBranding branding = 1;
+   */
+  branding?: ProjectBranding;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.UploadProjectLogoResponse.
+ * Use `create(UploadProjectLogoResponseSchema)` to create a new message.
+ */
+export const UploadProjectLogoResponseSchema: GenMessage<UploadProjectLogoResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_project_branding, 7);
+
+/**
+ * @generated from message altalune.v1.DeleteProjectLogoRequest
+ */
+export type DeleteProjectLogoRequest = Message<"altalune.v1.DeleteProjectLogoRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+};
+
+/**
+ * Describes the message altalune.v1.DeleteProjectLogoRequest.
+ * Use `create(DeleteProjectLogoRequestSchema)` to create a new message.
+ */
+export const DeleteProjectLogoRequestSchema: GenMessage<DeleteProjectLogoRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_project_branding, 8);
+
+/**
+ * @generated from message altalune.v1.DeleteProjectLogoResponse
+ */
+export type DeleteProjectLogoResponse = Message<"altalune.v1.DeleteProjectLogoResponse"> & {
+  /**
+   * @generated from field: altalune.v1.ProjectBranding branding = 1;
+   */
+  branding?: ProjectBranding;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.DeleteProjectLogoResponse.
+ * Use `create(DeleteProjectLogoResponseSchema)` to create a new message.
+ */
+export const DeleteProjectLogoResponseSchema: GenMessage<DeleteProjectLogoResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_project_branding, 9);
+
+/**
+ * Project Branding Service - Manage the look of a project's auth pages
+ *
+ * @generated from service altalune.v1.ProjectBrandingService
+ */
+export const ProjectBrandingService: GenService<{
+  /**
+   * @generated from rpc altalune.v1.ProjectBrandingService.GetProjectBranding
+   */
+  getProjectBranding: {
+    methodKind: "unary";
+    input: typeof GetProjectBrandingRequestSchema;
+    output: typeof GetProjectBrandingResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.ProjectBrandingService.UpdateProjectBranding
+   */
+  updateProjectBranding: {
+    methodKind: "unary";
+    input: typeof UpdateProjectBrandingRequestSchema;
+    output: typeof UpdateProjectBrandingResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.ProjectBrandingService.UploadProjectLogo
+   */
+  uploadProjectLogo: {
+    methodKind: "unary";
+    input: typeof UploadProjectLogoRequestSchema;
+    output: typeof UploadProjectLogoResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.ProjectBrandingService.DeleteProjectLogo
+   */
+  deleteProjectLogo: {
+    methodKind: "unary";
+    input: typeof DeleteProjectLogoRequestSchema;
+    output: typeof DeleteProjectLogoResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_altalune_v1_project_branding, 0);
+
@@ -0,0 +1,332 @@
+// @generated by protoc-gen-es v2.6.3 with parameter "target=ts,import_extension=js"
+// @generated from file altalune/v1/project_hostname.proto (package altalune.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import { file_buf_validate_validate } from "../../buf/validate/validate_pb.js";
+import { file_altalune_v1_options } from "./options_pb.js";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file altalune/v1/project_hostname.proto.
+ */
+export const file_altalune_v1_project_hostname: GenFile = /*@__PURE__*/
+  fileDesc("CiJhbHRhbHVuZS92MS9wcm9qZWN0X2hvc3RuYW1lLnByb3RvEgthbHRhbHVuZS52MSLwAQoPUHJvamVjdEhvc3RuYW1lEgoKAmlkGAEgASgJEhAKCGhvc3RuYW1lGAIgASgJEhUKDWJyYW5kaW5nX25hbWUYAyABKAkSEAoIbG9nb191cmwYBCABKAkSFQoNcHJpbWFyeV9jb2xvchgFIAEoCRIfChdkZWZhdWx0X29hdXRoX2NsaWVudF9pZBgGIAEoCRIuCgpjcmVhdGVkX2F0GGIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GGMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCI+ChtMaXN0UHJvamVjdEhvc3RuYW1lc1JlcXVlc3QSHwoKcHJvamVjdF9pZBgBIAEoCUILukgIyAEBcgOYAQ4iSgocTGlzdFByb2plY3RIb3N0bmFtZXNSZXNwb25zZRIqCgRkYXRhGAEgAygLMhwuYWx0YWx1bmUudjEuUHJvamVjdEhvc3RuYW1lIoQCChxDcmVhdGVQcm9qZWN0SG9zdG5hbWVSZXF1ZXN0Eh8KCnByb2plY3RfaWQYASABKAlCC7pICMgBAXIDmAEOEh8KCGhvc3RuYW1lGAIgASgJQg26SArIAQFyBRj9AWgBEh4KDWJyYW5kaW5nX25hbWUYAyABKAlCB7pIBHICGGQSIAoIbG9nb191cmwYBCABKAlCDrpIC9gBAXIGGPQDiAEBEjIKDXByaW1hcnlfY29sb3IYBSABKAlCG7pIGNgBAXITMhFeI1swLTlhLWZBLUZdezZ9JBIsChdkZWZhdWx0X29hdXRoX2NsaWVudF9pZBgGIAEoCUILukgI2AEBcgOYAQ4iYAodQ3JlYXRlUHJvamVjdEhvc3RuYW1lUmVzcG9uc2USLgoIaG9zdG5hbWUYASABKAsyHC5hbHRhbHVuZS52MS5Qcm9qZWN0SG9zdG5hbWUSDwoHbWVzc2FnZRgCIAEoCSK+AgocVXBkYXRlUHJvamVjdEhvc3RuYW1lUmVxdWVzdBIfCgpwcm9qZWN0X2lkGAEgASgJQgu6SAjIAQFyA5gBDhIgCgtob3N0bmFtZV9pZBgCIAEoCUILukgIyAEBcgOYAQ4SHgoNYnJhbmRpbmdfbmFtZRgDIAEoCUIHukgEcgIYZBIgCghsb2dvX3VybBgEIAEoCUIOukgL2AEBcgYY9AOIAQESMgoNcHJpbWFyeV9jb2xvchgFIAEoCUIbukgY2AEBchMyEV4jWzAtOWEtZkEtRl17Nn0kEiwKF2RlZmF1bHRfb2F1dGhfY2xpZW50X2lkGAYgASgJQgu6SAjYAQFyA5gBDhI3ChNleHBlY3RlZF91cGRhdGVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJgCh1VcGRhdGVQcm9qZWN0SG9zdG5hbWVSZXNwb25zZRIuCghob3N0bmFtZRgBIAEoCzIcLmFsdGFsdW5lLnYxLlByb2plY3RIb3N0bmFtZRIPCgdtZXNzYWdlGAIgASgJImEKHERlbGV0ZVByb2plY3RIb3N0bmFtZVJlcXVlc3QSHwoKcHJvamVjdF9pZBgBIAEoCUILukgIyAEBcgOYAQ4SIAoLaG9zdG5hbWVfaWQYAiABKAlCC7pICMgBAXIDmAEOIjAKHURlbGV0ZVByb2plY3RIb3N0bmFtZVJlc3BvbnNlEg8KB21lc3NhZ2UYASABKAkyowQKFlByb2plY3RIb3N0bmFtZVNlcnZpY2USfQoUTGlzdFByb2plY3RIb3N0bmFtZXMSKC5hbHRhbHVuZS52MS5MaXN0UHJvamVjdEhvc3RuYW1lc1JlcXVlc3QaKS5hbHRhbHVuZS52MS5MaXN0UHJvamVjdEhvc3RuYW1lc1Jlc3BvbnNlIhCKtRgMcHJvamVjdDpyZWFkEoEBChVDcmVhdGVQcm9qZWN0SG9zdG5hbWUSKS5hbHRhbHVuZS52MS5DcmVhdGVQcm9qZWN0SG9zdG5hbWVSZXF1ZXN0GiouYWx0YWx1bmUudjEuQ3JlYXRlUHJvamVjdEhvc3RuYW1lUmVzcG9uc2UiEYq1GA1wcm9qZWN0OndyaXRlEoEBChVVcGRhdGVQcm9qZWN0SG9zdG5hbWUSKS5hbHRhbHVuZS52MS5VcGRhdGVQcm9qZWN0SG9zdG5hbWVSZXF1ZXN0GiouYWx0YWx1bmUudjEuVXBkYXRlUHJvamVjdEhvc3RuYW1lUmVzcG9uc2UiEYq1GA1wcm9qZWN0OndyaXRlEoEBChVEZWxldGVQcm9qZWN0SG9zdG5hbWUSKS5hbHRhbHVuZS52MS5EZWxldGVQcm9qZWN0SG9zdG5hbWVSZXF1ZXN0GiouYWx0YWx1bmUudjEuRGVsZXRlUHJvamVjdEhvc3RuYW1lUmVzcG9uc2UiEYq1GA1wcm9qZWN0OndyaXRlQqkBCg9jb20uYWx0YWx1bmUudjFCFFByb2plY3RIb3N0bmFtZVByb3RvUAFaM2dpdGh1Yi5jb20vaHJ6OC9hbHRhbHVuZS9nZW4vYWx0YWx1bmUvdjE7YWx0YWx1bmV2MaICA0FYWKoCC0FsdGFsdW5lLlYxygILQWx0YWx1bmVcVjHiAhdBbHRhbHVuZVxWMVxHUEJNZXRhZGF0YeoCDEFsdGFsdW5lOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp, file_buf_validate_validate, file_altalune_v1_options]);
+
+/**
+ * Project Hostname Message
+ * The auth server matches the request Host against hostname to pick the
+ * branding and default OAuth client of the project.
+ *
+ * @generated from message altalune.v1.ProjectHostname
+ */
+export type ProjectHostname = Message<"altalune.v1.ProjectHostname"> & {
+  /**
+   * Public nanoid
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string hostname = 2;
+   */
+  hostname: string;
+
+  /**
+   * Empty = auth server default
+   *
+   * @generated from field: string branding_name = 3;
+   */
+  brandingName: string;
+
+  /**
+   * @generated from field: string logo_url = 4;
+   */
+  logoUrl: string;
+
+  /**
+   * Hex color, e.g. #0d6efd
+   *
+   * @generated from field: string primary_color = 5;
+   */
+  primaryColor: string;
+
+  /**
+   * Public nanoid of the OAuth client
+   *
+   * @generated from field: string default_oauth_client_id = 6;
+   */
+  defaultOauthClientId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 98;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 99;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message altalune.v1.ProjectHostname.
+ * Use `create(ProjectHostnameSchema)` to create a new message.
+ */
+export const ProjectHostnameSchema: GenMessage<ProjectHostname> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_project_hostname, 0);
+
+/**
+ * @generated from message altalune.v1.ListProjectHostnamesRequest
+ */
+export type ListProjectHostnamesRequest = Message<"altalune.v1.ListProjectHostnamesRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+};
+
+/**
+ * Describes the message altalune.v1.ListProjectHostnamesRequest.
+ * Use `create(ListProjectHostnamesRequestSchema)` to create a new message.
+ */
+export const ListProjectHostnamesRequestSchema: GenMessage<ListProjectHostnamesRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_project_hostname, 1);
+
+/**
+ * @generated from message altalune.v1.ListProjectHostnamesResponse
+ */
+export type ListProjectHostnamesResponse = Message<"altalune.v1.ListProjectHostnamesResponse"> & {
+  /**
+   * @generated from field: repeated altalune.v1.ProjectHostname data = 1;
+   */
+  data: ProjectHostname[];
+};
+
+/**
+ * Describes the message altalune.v1.ListProjectHostnamesResponse.
+ * Use `create(ListProjectHostnamesResponseSchema)` to create a new message.
+ */
+export const ListProjectHostnamesResponseSchema: GenMessage<ListProjectHostnamesResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_project_hostname, 2);
+
+/**
+ * @generated from message altalune.v1.CreateProjectHostnameRequest
+ */
+export type CreateProjectHostnameRequest = Message<"altalune.v1.CreateProjectHostnameRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: string hostname = 2;
+   */
+  hostname: string;
+
+  /**
+   * @generated from field: string branding_name = 3;
+   */
+  brandingName: string;
+
+  /**
+   * @generated from field: string logo_url = 4;
+   */
+  logoUrl: string;
+
+  /**
+   * @generated from field: string primary_color = 5;
+   */
+  primaryColor: string;
+
+  /**
+   * @generated from field: string default_oauth_client_id = 6;
+   */
+  defaultOauthClientId: string;
+};
+
+/**
+ * Describes the message altalune.v1.CreateProjectHostnameRequest.
+ * Use `create(CreateProjectHostnameRequestSchema)` to create a new message.
+ */
+export const CreateProjectHostnameRequestSchema: GenMessage<CreateProjectHostnameRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_project_hostname, 3);
+
+/**
+ * @generated from message altalune.v1.CreateProjectHostnameResponse
+ */
+export type CreateProjectHostnameResponse = Message<"altalune.v1.CreateProjectHostnameResponse"> & {
+  /**
+   * @generated from field: altalune.v1.ProjectHostname hostname = 1;
+   */
+  hostname?: ProjectHostname;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.CreateProjectHostnameResponse.
+ * Use `create(CreateProjectHostnameResponseSchema)` to create a new message.
+ */
+export const CreateProjectHostnameResponseSchema: GenMessage<CreateProjectHostnameResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_project_hostname, 4);
+
+/**
+ * @generated from message altalune.v1.UpdateProjectHostnameRequest
+ */
+export type UpdateProjectHostnameRequest = Message<"altalune.v1.UpdateProjectHostnameRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: string hostname_id = 2;
+   */
+  hostnameId: string;
+
+  /**
+   * @generated from field: string branding_name = 3;
+   */
+  brandingName: string;
+
+  /**
+   * @generated from field: string logo_url = 4;
+   */
+  logoUrl: string;
+
+  /**
+   * @generated from field: string primary_color = 5;
+   */
+  primaryColor: string;
+
+  /**
+   * @generated from field: string default_oauth_client_id = 6;
+   */
+  defaultOauthClientId: string;
+
+  /**
+   * updated_at of the hostname the edit is based on. When set, the update is
+   * rejected with FAILED_PRECONDITION if the hostname changed since then.
+   *
+   * @generated from field: google.protobuf.Timestamp expected_updated_at = 7;
+   */
+  expectedUpdatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message altalune.v1.UpdateProjectHostnameRequest.
+ * Use `create(UpdateProjectHostnameRequestSchema)` to create a new message.
+ */
+export const UpdateProjectHostnameRequestSchema: GenMessage<UpdateProjectHostnameRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_project_hostname, 5);
+
+/**
+ * @generated from message altalune.v1.UpdateProjectHostnameResponse
+ */
+export type UpdateProjectHostnameResponse = Message<"altalune.v1.UpdateProjectHostnameResponse"> & {
+  /**
+   * @generated from field: altalune.v1.ProjectHostname hostname = 1;
+   */
+  hostname?: ProjectHostname;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.UpdateProjectHostnameResponse.
+ * Use `create(UpdateProjectHostnameResponseSchema)` to create a new message.
+ */
+export const UpdateProjectHostnameResponseSchema: GenMessage<UpdateProjectHostnameResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_project_hostname, 6);
+
+/**
+ * @generated from message altalune.v1.DeleteProjectHostnameRequest
+ */
+export type DeleteProjectHostnameRequest = Message<"altalune.v1.DeleteProjectHostnameRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: string hostname_id = 2;
+   */
+  hostnameId: string;
+};
+
+/**
+ * Describes the message altalune.v1.DeleteProjectHostnameRequest.
+ * Use `create(DeleteProjectHostnameRequestSchema)` to create a new message.
+ */
+export const DeleteProjectHostnameRequestSchema: GenMessage<DeleteProjectHostnameRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_project_hostname, 7);
+
+/**
+ * @generated from message altalune.v1.DeleteProjectHostnameResponse
+ */
+export type DeleteProjectHostnameResponse = Message<"altalune.v1.DeleteProjectHostnameResponse"> & {
+  /**
+   * @generated from field: string message = 1;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.DeleteProjectHostnameResponse.
+ * Use `create(DeleteProjectHostnameResponseSchema)` to create a new message.
+ */
+export const DeleteProjectHostnameResponseSchema: GenMessage<DeleteProjectHostnameResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_project_hostname, 8);
+
+/**
+ * Project Hostname Service - Manage custom hostnames serving white-labeled auth pages
+ *
+ * @generated from service altalune.v1.ProjectHostnameService
+ */
+export const ProjectHostnameService: GenService<{
+  /**
+   * @generated from rpc altalune.v1.ProjectHostnameService.ListProjectHostnames
+   */
+  listProjectHostnames: {
+    methodKind: "unary";
+    input: typeof ListProjectHostnamesRequestSchema;
+    output: typeof ListProjectHostnamesResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.ProjectHostnameService.CreateProjectHostname
+   */
+  createProjectHostname: {
+    methodKind: "unary";
+    input: typeof CreateProjectHostnameRequestSchema;
+    output: typeof CreateProjectHostnameResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.ProjectHostnameService.UpdateProjectHostname
+   */
+  updateProjectHostname: {
+    methodKind: "unary";
+    input: typeof UpdateProjectHostnameRequestSchema;
+    output: typeof UpdateProjectHostnameResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.ProjectHostnameService.DeleteProjectHostname
+   */
+  deleteProjectHostname: {
+    methodKind: "unary";
+    input: typeof DeleteProjectHostnameRequestSchema;
+    output: typeof DeleteProjectHostnameResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_altalune_v1_project_hostname, 0);
+
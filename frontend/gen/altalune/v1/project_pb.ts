@@ -2,20 +2,21 @@
 // @generated from file altalune/v1/project.proto (package altalune.v1, syntax proto3)
 /* eslint-disable */
 
-import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
-import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
 import type { Timestamp } from "@bufbuild/protobuf/wkt";
 import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import { file_buf_validate_validate } from "../../buf/validate/validate_pb.js";
-import type { QueryMetaResponse, QueryRequest } from "./common_pb.js";
+import type { QueryMetaResponse, QueryRequest, SecretDeliveryMode } from "./common_pb.js";
 import { file_altalune_v1_common } from "./common_pb.js";
+import { file_altalune_v1_options } from "./options_pb.js";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file altalune/v1/project.proto.
  */
 export const file_altalune_v1_project: GenFile = /*@__PURE__*/
-  fileDesc("ChlhbHRhbHVuZS92MS9wcm9qZWN0LnByb3RvEgthbHRhbHVuZS52MSLTAQoHUHJvamVjdBIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEhAKCHRpbWV6b25lGAQgASgJEhMKC2Vudmlyb25tZW50GAUgASgJEhIKCmlzX2RlZmF1bHQYBiABKAgSLgoKY3JlYXRlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiQAoUUXVlcnlQcm9qZWN0c1JlcXVlc3QSKAoFcXVlcnkYASABKAsyGS5hbHRhbHVuZS52MS5RdWVyeVJlcXVlc3QiaQoVUXVlcnlQcm9qZWN0c1Jlc3BvbnNlEiIKBGRhdGEYASADKAsyFC5hbHRhbHVuZS52MS5Qcm9qZWN0EiwKBG1ldGEYAiABKAsyHi5hbHRhbHVuZS52MS5RdWVyeU1ldGFSZXNwb25zZSKzAQoUQ3JlYXRlUHJvamVjdFJlcXVlc3QSLwoEbmFtZRgBIAEoCUIhukgeyAEBchkQARgyMhNeW2EtekEtWjAtOVxzXC1fXSskEhwKC2Rlc2NyaXB0aW9uGAIgASgJQge6SARyAhhkEh4KCHRpbWV6b25lGAMgASgJQgy6SAnIAQFyBBABGDISLAoLZW52aXJvbm1lbnQYBCABKAlCF7pIFMgBAXIPUgRsaXZlUgdzYW5kYm94Ik8KFUNyZWF0ZVByb2plY3RSZXNwb25zZRIlCgdwcm9qZWN0GAEgASgLMhQuYWx0YWx1bmUudjEuUHJvamVjdBIPCgdtZXNzYWdlGAIgASgJIiwKEUdldFByb2plY3RSZXF1ZXN0EhcKAmlkGAEgASgJQgu6SAjIAQFyA5gBDiI7ChJHZXRQcm9qZWN0UmVzcG9uc2USJQoHcHJvamVjdBgBIAEoCzIULmFsdGFsdW5lLnYxLlByb2plY3QingEKFFVwZGF0ZVByb2plY3RSZXF1ZXN0EhcKAmlkGAEgASgJQgu6SAjIAQFyA5gBDhIvCgRuYW1lGAIgASgJQiG6SB7IAQFyGRABGDIyE15bYS16QS1aMC05XHNcLV9dKyQSHAoLZGVzY3JpcHRpb24YAyABKAlCB7pIBHICGGQSHgoIdGltZXpvbmUYBCABKAlCDLpICcgBAXIEEAEYMiJPChVVcGRhdGVQcm9qZWN0UmVzcG9uc2USJQoHcHJvamVjdBgBIAEoCzIULmFsdGFsdW5lLnYxLlByb2plY3QSDwoHbWVzc2FnZRgCIAEoCSIvChREZWxldGVQcm9qZWN0UmVxdWVzdBIXCgJpZBgBIAEoCUILukgIyAEBcgOYAQ4iKAoVRGVsZXRlUHJvamVjdFJlc3BvbnNlEg8KB21lc3NhZ2UYASABKAkyyQMKDlByb2plY3RTZXJ2aWNlElgKDVF1ZXJ5UHJvamVjdHMSIS5hbHRhbHVuZS52MS5RdWVyeVByb2plY3RzUmVxdWVzdBoiLmFsdGFsdW5lLnYxLlF1ZXJ5UHJvamVjdHNSZXNwb25zZSIAElgKDUNyZWF0ZVByb2plY3QSIS5hbHRhbHVuZS52MS5DcmVhdGVQcm9qZWN0UmVxdWVzdBoiLmFsdGFsdW5lLnYxLkNyZWF0ZVByb2plY3RSZXNwb25zZSIAEk8KCkdldFByb2plY3QSHi5hbHRhbHVuZS52MS5HZXRQcm9qZWN0UmVxdWVzdBofLmFsdGFsdW5lLnYxLkdldFByb2plY3RSZXNwb25zZSIAElgKDVVwZGF0ZVByb2plY3QSIS5hbHRhbHVuZS52MS5VcGRhdGVQcm9qZWN0UmVxdWVzdBoiLmFsdGFsdW5lLnYxLlVwZGF0ZVByb2plY3RSZXNwb25zZSIAElgKDURlbGV0ZVByb2plY3QSIS5hbHRhbHVuZS52MS5EZWxldGVQcm9qZWN0UmVxdWVzdBoiLmFsdGFsdW5lLnYxLkRlbGV0ZVByb2plY3RSZXNwb25zZSIAQqEBCg9jb20uYWx0YWx1bmUudjFCDFByb2plY3RQcm90b1ABWjNnaXRodWIuY29tL2hyejgvYWx0YWx1bmUvZ2VuL2FsdGFsdW5lL3YxO2FsdGFsdW5ldjGiAgNBWFiqAgtBbHRhbHVuZS5WMcoCC0FsdGFsdW5lXFYx4gIXQWx0YWx1bmVcVjFcR1BCTWV0YWRhdGHqAgxBbHRhbHVuZTo6VjFiBnByb3RvMw", [file_google_protobuf_timestamp, file_buf_validate_validate, file_altalune_v1_common]);
+  fileDesc("ChlhbHRhbHVuZS92MS9wcm9qZWN0LnByb3RvEgthbHRhbHVuZS52MSL7AQoHUHJvamVjdBIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEhAKCHRpbWV6b25lGAQgASgJEhMKC2Vudmlyb25tZW50GAUgASgJEhIKCmlzX2RlZmF1bHQYBiABKAgSLgoKY3JlYXRlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEgoKY3JlYXRlZF9ieRgJIAEoCRISCgp1cGRhdGVkX2J5GAogASgJIkAKFFF1ZXJ5UHJvamVjdHNSZXF1ZXN0EigKBXF1ZXJ5GAEgASgLMhkuYWx0YWx1bmUudjEuUXVlcnlSZXF1ZXN0ImkKFVF1ZXJ5UHJvamVjdHNSZXNwb25zZRIiCgRkYXRhGAEgAygLMhQuYWx0YWx1bmUudjEuUHJvamVjdBIsCgRtZXRhGAIgASgLMh4uYWx0YWx1bmUudjEuUXVlcnlNZXRhUmVzcG9uc2UiswEKFENyZWF0ZVByb2plY3RSZXF1ZXN0Ei8KBG5hbWUYASABKAlCIbpIHsgBAXIZEAEYMjITXlthLXpBLVowLTlcc1wtX10rJBIcCgtkZXNjcmlwdGlvbhgCIAEoCUIHukgEcgIYZBIeCgh0aW1lem9uZRgDIAEoCUIMukgJyAEBcgQQARgyEiwKC2Vudmlyb25tZW50GAQgASgJQhe6SBTIAQFyD1IEbGl2ZVIHc2FuZGJveCJPChVDcmVhdGVQcm9qZWN0UmVzcG9uc2USJQoHcHJvamVjdBgBIAEoCzIULmFsdGFsdW5lLnYxLlByb2plY3QSDwoHbWVzc2FnZRgCIAEoCSIsChFHZXRQcm9qZWN0UmVxdWVzdBIXCgJpZBgBIAEoCUILukgIyAEBcgOYAQ4iOwoSR2V0UHJvamVjdFJlc3BvbnNlEiUKB3Byb2plY3QYASABKAsyFC5hbHRhbHVuZS52MS5Qcm9qZWN0ItcBChRVcGRhdGVQcm9qZWN0UmVxdWVzdBIXCgJpZBgBIAEoCUILukgIyAEBcgOYAQ4SLwoEbmFtZRgCIAEoCUIhukgeyAEBchkQARgyMhNeW2EtekEtWjAtOVxzXC1fXSskEhwKC2Rlc2NyaXB0aW9uGAMgASgJQge6SARyAhhkEh4KCHRpbWV6b25lGAQgASgJQgy6SAnIAQFyBBABGDISNwoTZXhwZWN0ZWRfdXBkYXRlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiTwoVVXBkYXRlUHJvamVjdFJlc3BvbnNlEiUKB3Byb2plY3QYASABKAsyFC5hbHRhbHVuZS52MS5Qcm9qZWN0Eg8KB21lc3NhZ2UYAiABKAkiLwoURGVsZXRlUHJvamVjdFJlcXVlc3QSFwoCaWQYASABKAlCC7pICMgBAXIDmAEOIigKFURlbGV0ZVByb2plY3RSZXNwb25zZRIPCgdtZXNzYWdlGAEgASgJIl4KEVByb2plY3RPbmJvYXJkaW5nEhsKE2RlZmF1bHRfbWVtYmVyX3JvbGUYASABKAkSGgoNYXV0b19hY3RpdmF0ZRgCIAEoCEgAiAEBQhAKDl9hdXRvX2FjdGl2YXRlIj4KG0dldFByb2plY3RPbmJvYXJkaW5nUmVxdWVzdBIfCgpwcm9qZWN0X2lkGAEgASgJQgu6SAjIAQFyA5gBDiJSChxHZXRQcm9qZWN0T25ib2FyZGluZ1Jlc3BvbnNlEjIKCm9uYm9hcmRpbmcYASABKAsyHi5hbHRhbHVuZS52MS5Qcm9qZWN0T25ib2FyZGluZyKqAQoeVXBkYXRlUHJvamVjdE9uYm9hcmRpbmdSZXF1ZXN0Eh8KCnByb2plY3RfaWQYASABKAlCC7pICMgBAXIDmAEOEjkKE2RlZmF1bHRfbWVtYmVyX3JvbGUYAiABKAlCHLpIGXIXUgBSBWFkbWluUgZtZW1iZXJSBHVzZXISGgoNYXV0b19hY3RpdmF0ZRgDIAEoCEgAiAEBQhAKDl9hdXRvX2FjdGl2YXRlImYKH1VwZGF0ZVByb2plY3RPbmJvYXJkaW5nUmVzcG9uc2USMgoKb25ib2FyZGluZxgBIAEoCzIeLmFsdGFsdW5lLnYxLlByb2plY3RPbmJvYXJkaW5nEg8KB21lc3NhZ2UYAiABKAki1AEKF1Byb2plY3RDcmVkZW50aWFsUG9saWN5EiEKGWFwaV9rZXlfbWF4X2xpZmV0aW1lX2RheXMYASABKAUSMwoGYWN0aW9uGAIgASgOMiMuYWx0YWx1bmUudjEuQ3JlZGVudGlhbFBvbGljeUFjdGlvbhI4Cg9zZWNyZXRfZGVsaXZlcnkYAyABKA4yHy5hbHRhbHVuZS52MS5TZWNyZXREZWxpdmVyeU1vZGUSJwofY2xpZW50X3NlY3JldF9tYXhfbGlmZXRpbWVfZGF5cxgEIAEoBSJECiFHZXRQcm9qZWN0Q3JlZGVudGlhbFBvbGljeVJlcXVlc3QSHwoKcHJvamVjdF9pZBgBIAEoCUILukgIyAEBcgOYAQ4iWgoiR2V0UHJvamVjdENyZWRlbnRpYWxQb2xpY3lSZXNwb25zZRI0CgZwb2xpY3kYASABKAsyJC5hbHRhbHVuZS52MS5Qcm9qZWN0Q3JlZGVudGlhbFBvbGljeSKuAgokVXBkYXRlUHJvamVjdENyZWRlbnRpYWxQb2xpY3lSZXF1ZXN0Eh8KCnByb2plY3RfaWQYASABKAlCC7pICMgBAXIDmAEOEi0KGWFwaV9rZXlfbWF4X2xpZmV0aW1lX2RheXMYAiABKAVCCrpIBxoFGNoFKAASPQoGYWN0aW9uGAMgASgOMiMuYWx0YWx1bmUudjEuQ3JlZGVudGlhbFBvbGljeUFjdGlvbkIIukgFggECEAESQgoPc2VjcmV0X2RlbGl2ZXJ5GAQgASgOMh8uYWx0YWx1bmUudjEuU2VjcmV0RGVsaXZlcnlNb2RlQgi6SAWCAQIQARIzCh9jbGllbnRfc2VjcmV0X21heF9saWZldGltZV9kYXlzGAUgASgFQgq6SAcaBRjaBSgAIm4KJVVwZGF0ZVByb2plY3RDcmVkZW50aWFsUG9saWN5UmVzcG9uc2USNAoGcG9saWN5GAEgASgLMiQuYWx0YWx1bmUudjEuUHJvamVjdENyZWRlbnRpYWxQb2xpY3kSDwoHbWVzc2FnZRgCIAEoCSqLAQoWQ3JlZGVudGlhbFBvbGljeUFjdGlvbhIoCiRDUkVERU5USUFMX1BPTElDWV9BQ1RJT05fVU5TUEVDSUZJRUQQABIhCh1DUkVERU5USUFMX1BPTElDWV9BQ1RJT05fRkxBRxABEiQKIENSRURFTlRJQUxfUE9MSUNZX0FDVElPTl9ESVNBQkxFEAIy5ggKDlByb2plY3RTZXJ2aWNlEnoKDVF1ZXJ5UHJvamVjdHMSIS5hbHRhbHVuZS52MS5RdWVyeVByb2plY3RzUmVxdWVzdBoiLmFsdGFsdW5lLnYxLlF1ZXJ5UHJvamVjdHNSZXNwb25zZSIiirUYDHByb2plY3Q6cmVhZIq1GA5kYXNoYm9hcmQ6cmVhZBJpCg1DcmVhdGVQcm9qZWN0EiEuYWx0YWx1bmUudjEuQ3JlYXRlUHJvamVjdFJlcXVlc3QaIi5hbHRhbHVuZS52MS5DcmVhdGVQcm9qZWN0UmVzcG9uc2UiEYq1GA1wcm9qZWN0OndyaXRlEl8KCkdldFByb2plY3QSHi5hbHRhbHVuZS52MS5HZXRQcm9qZWN0UmVxdWVzdBofLmFsdGFsdW5lLnYxLkdldFByb2plY3RSZXNwb25zZSIQirUYDHByb2plY3Q6cmVhZBJpCg1VcGRhdGVQcm9qZWN0EiEuYWx0YWx1bmUudjEuVXBkYXRlUHJvamVjdFJlcXVlc3QaIi5hbHRhbHVuZS52MS5VcGRhdGVQcm9qZWN0UmVzcG9uc2UiEYq1GA1wcm9qZWN0OndyaXRlEmoKDURlbGV0ZVByb2plY3QSIS5hbHRhbHVuZS52MS5EZWxldGVQcm9qZWN0UmVxdWVzdBoiLmFsdGFsdW5lLnYxLkRlbGV0ZVByb2plY3RSZXNwb25zZSISirUYDnByb2plY3Q6ZGVsZXRlEn0KFEdldFByb2plY3RPbmJvYXJkaW5nEiguYWx0YWx1bmUudjEuR2V0UHJvamVjdE9uYm9hcmRpbmdSZXF1ZXN0GikuYWx0YWx1bmUudjEuR2V0UHJvamVjdE9uYm9hcmRpbmdSZXNwb25zZSIQirUYDHByb2plY3Q6cmVhZBKHAQoXVXBkYXRlUHJvamVjdE9uYm9hcmRpbmcSKy5hbHRhbHVuZS52MS5VcGRhdGVQcm9qZWN0T25ib2FyZGluZ1JlcXVlc3QaLC5hbHRhbHVuZS52MS5VcGRhdGVQcm9qZWN0T25ib2FyZGluZ1Jlc3BvbnNlIhGKtRgNcHJvamVjdDp3cml0ZRKPAQoaR2V0UHJvamVjdENyZWRlbnRpYWxQb2xpY3kSLi5hbHRhbHVuZS52MS5HZXRQcm9qZWN0Q3JlZGVudGlhbFBvbGljeVJlcXVlc3QaLy5hbHRhbHVuZS52MS5HZXRQcm9qZWN0Q3JlZGVudGlhbFBvbGljeVJlc3BvbnNlIhCKtRgMcHJvamVjdDpyZWFkEpkBCh1VcGRhdGVQcm9qZWN0Q3JlZGVudGlhbFBvbGljeRIxLmFsdGFsdW5lLnYxLlVwZGF0ZVByb2plY3RDcmVkZW50aWFsUG9saWN5UmVxdWVzdBoyLmFsdGFsdW5lLnYxLlVwZGF0ZVByb2plY3RDcmVkZW50aWFsUG9saWN5UmVzcG9uc2UiEYq1GA1wcm9qZWN0OndyaXRlQqEBCg9jb20uYWx0YWx1bmUudjFCDFByb2plY3RQcm90b1ABWjNnaXRodWIuY29tL2hyejgvYWx0YWx1bmUvZ2VuL2FsdGFsdW5lL3YxO2FsdGFsdW5ldjGiAgNBWFiqAgtBbHRhbHVuZS5WMcoCC0FsdGFsdW5lXFYx4gIXQWx0YWx1bmVcVjFcR1BCTWV0YWRhdGHqAgxBbHRhbHVuZTo6VjFiBnByb3RvMw", [file_google_protobuf_timestamp, file_buf_validate_validate, file_altalune_v1_common, file_altalune_v1_options]);
 
 /**
  * @generated from message altalune.v1.Project
@@ -60,6 +61,20 @@ export type Project = Message<"altalune.v1.Project"> & {
    * @generated from field: google.protobuf.Timestamp updated_at = 8;
    */
   updatedAt?: Timestamp;
+
+  /**
+   * Public ID of the user who created the project, empty if unknown
+   *
+   * @generated from field: string created_by = 9;
+   */
+  createdBy: string;
+
+  /**
+   * Public ID of the user who last updated the project, empty if unknown
+   *
+   * @generated from field: string updated_by = 10;
+   */
+  updatedBy: string;
 };
 
 /**
@@ -219,6 +234,14 @@ export type UpdateProjectRequest = Message<"altalune.v1.UpdateProjectRequest"> &
    * @generated from field: string timezone = 4;
    */
   timezone: string;
+
+  /**
+   * updated_at of the project the edit is based on. When set, the update is
+   * rejected with FAILED_PRECONDITION if the project changed since then.
+   *
+   * @generated from field: google.protobuf.Timestamp expected_updated_at = 5;
+   */
+  expectedUpdatedAt?: Timestamp;
 };
 
 /**
@@ -284,6 +307,295 @@ export type DeleteProjectResponse = Message<"altalune.v1.DeleteProjectResponse">
 export const DeleteProjectResponseSchema: GenMessage<DeleteProjectResponse> = /*@__PURE__*/
   messageDesc(file_altalune_v1_project, 10);
 
+/**
+ * ProjectOnboarding is how self-registered users join a project. Unset fields
+ * fall back to the auth server configuration.
+ *
+ * @generated from message altalune.v1.ProjectOnboarding
+ */
+export type ProjectOnboarding = Message<"altalune.v1.ProjectOnboarding"> & {
+  /**
+   * Empty picks the role from the registration context
+   *
+   * @generated from field: string default_member_role = 1;
+   */
+  defaultMemberRole: string;
+
+  /**
+   * Unset follows auth.autoActivate
+   *
+   * @generated from field: optional bool auto_activate = 2;
+   */
+  autoActivate?: boolean;
+};
+
+/**
+ * Describes the message altalune.v1.ProjectOnboarding.
+ * Use `create(ProjectOnboardingSchema)` to create a new message.
+ */
+export const ProjectOnboardingSchema: GenMessage<ProjectOnboarding> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_project, 11);
+
+/**
+ * @generated from message altalune.v1.GetProjectOnboardingRequest
+ */
+export type GetProjectOnboardingRequest = Message<"altalune.v1.GetProjectOnboardingRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+};
+
+/**
+ * Describes the message altalune.v1.GetProjectOnboardingRequest.
+ * Use `create(GetProjectOnboardingRequestSchema)` to create a new message.
+ */
+export const GetProjectOnboardingRequestSchema: GenMessage<GetProjectOnboardingRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_project, 12);
+
+/**
+ * @generated from message altalune.v1.GetProjectOnboardingResponse
+ */
+export type GetProjectOnboardingResponse = Message<"altalune.v1.GetProjectOnboardingResponse"> & {
+  /**
+   * @generated from field: altalune.v1.ProjectOnboarding onboarding = 1;
+   */
+  onboarding?: ProjectOnboarding;
+};
+
+/**
+ * Describes the message altalune.v1.GetProjectOnboardingResponse.
+ * Use `create(GetProjectOnboardingResponseSchema)` to create a new message.
+ */
+export const GetProjectOnboardingResponseSchema: GenMessage<GetProjectOnboardingResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_project, 13);
+
+/**
+ * @generated from message altalune.v1.UpdateProjectOnboardingRequest
+ */
+export type UpdateProjectOnboardingRequest = Message<"altalune.v1.UpdateProjectOnboardingRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: string default_member_role = 2;
+   */
+  defaultMemberRole: string;
+
+  /**
+   * @generated from field: optional bool auto_activate = 3;
+   */
+  autoActivate?: boolean;
+};
+
+/**
+ * Describes the message altalune.v1.UpdateProjectOnboardingRequest.
+ * Use `create(UpdateProjectOnboardingRequestSchema)` to create a new message.
+ */
+export const UpdateProjectOnboardingRequestSchema: GenMessage<UpdateProjectOnboardingRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_project, 14);
+
+/**
+ * @generated from message altalune.v1.UpdateProjectOnboardingResponse
+ */
+export type UpdateProjectOnboardingResponse = Message<"altalune.v1.UpdateProjectOnboardingResponse"> & {
+  /**
+   * @generated from field: altalune.v1.ProjectOnboarding onboarding = 1;
+   */
+  onboarding?: ProjectOnboarding;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.UpdateProjectOnboardingResponse.
+ * Use `create(UpdateProjectOnboardingResponseSchema)` to create a new message.
+ */
+export const UpdateProjectOnboardingResponseSchema: GenMessage<UpdateProjectOnboardingResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_project, 15);
+
+/**
+ * ProjectCredentialPolicy caps the lifetime of the API keys of a project.
+ * Creating, updating and reactivating keys is refused past it, and a daily
+ * job applies the action to the keys that were out of policy before it was
+ * set or tightened. OAuth clients are global, not tied to a project, so their
+ * secrets follow the policy of the default project.
+ *
+ * @generated from message altalune.v1.ProjectCredentialPolicy
+ */
+export type ProjectCredentialPolicy = Message<"altalune.v1.ProjectCredentialPolicy"> & {
+  /**
+   * Days after creation a key may expire, 0 for no maximum
+   *
+   * @generated from field: int32 api_key_max_lifetime_days = 1;
+   */
+  apiKeyMaxLifetimeDays: number;
+
+  /**
+   * @generated from field: altalune.v1.CredentialPolicyAction action = 2;
+   */
+  action: CredentialPolicyAction;
+
+  /**
+   * How the secrets of new API keys are handed over. OAuth clients are global
+   * and follow the policy of the default project.
+   *
+   * @generated from field: altalune.v1.SecretDeliveryMode secret_delivery = 3;
+   */
+  secretDelivery: SecretDeliveryMode;
+
+  /**
+   * Days after creation or rotation an OAuth client secret expires, 0 for
+   * never. Only the policy of the default project applies.
+   *
+   * @generated from field: int32 client_secret_max_lifetime_days = 4;
+   */
+  clientSecretMaxLifetimeDays: number;
+};
+
+/**
+ * Describes the message altalune.v1.ProjectCredentialPolicy.
+ * Use `create(ProjectCredentialPolicySchema)` to create a new message.
+ */
+export const ProjectCredentialPolicySchema: GenMessage<ProjectCredentialPolicy> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_project, 16);
+
+/**
+ * @generated from message altalune.v1.GetProjectCredentialPolicyRequest
+ */
+export type GetProjectCredentialPolicyRequest = Message<"altalune.v1.GetProjectCredentialPolicyRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+};
+
+/**
+ * Describes the message altalune.v1.GetProjectCredentialPolicyRequest.
+ * Use `create(GetProjectCredentialPolicyRequestSchema)` to create a new message.
+ */
+export const GetProjectCredentialPolicyRequestSchema: GenMessage<GetProjectCredentialPolicyRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_project, 17);
+
+/**
+ * @generated from message altalune.v1.GetProjectCredentialPolicyResponse
+ */
+export type GetProjectCredentialPolicyResponse = Message<"altalune.v1.GetProjectCredentialPolicyResponse"> & {
+  /**
+   * @generated from field: altalune.v1.ProjectCredentialPolicy policy = 1;
+   */
+  policy?: ProjectCredentialPolicy;
+};
+
+/**
+ * Describes the message altalune.v1.GetProjectCredentialPolicyResponse.
+ * Use `create(GetProjectCredentialPolicyResponseSchema)` to create a new message.
+ */
+export const GetProjectCredentialPolicyResponseSchema: GenMessage<GetProjectCredentialPolicyResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_project, 18);
+
+/**
+ * @generated from message altalune.v1.UpdateProjectCredentialPolicyRequest
+ */
+export type UpdateProjectCredentialPolicyRequest = Message<"altalune.v1.UpdateProjectCredentialPolicyRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * 0 removes the maximum; keys can never live longer than two years
+   *
+   * @generated from field: int32 api_key_max_lifetime_days = 2;
+   */
+  apiKeyMaxLifetimeDays: number;
+
+  /**
+   * Unspecified flags out-of-policy keys
+   *
+   * @generated from field: altalune.v1.CredentialPolicyAction action = 3;
+   */
+  action: CredentialPolicyAction;
+
+  /**
+   * Unspecified reveals secrets in the create responses
+   *
+   * @generated from field: altalune.v1.SecretDeliveryMode secret_delivery = 4;
+   */
+  secretDelivery: SecretDeliveryMode;
+
+  /**
+   * 0 lets OAuth client secrets live until they are rotated
+   *
+   * @generated from field: int32 client_secret_max_lifetime_days = 5;
+   */
+  clientSecretMaxLifetimeDays: number;
+};
+
+/**
+ * Describes the message altalune.v1.UpdateProjectCredentialPolicyRequest.
+ * Use `create(UpdateProjectCredentialPolicyRequestSchema)` to create a new message.
+ */
+export const UpdateProjectCredentialPolicyRequestSchema: GenMessage<UpdateProjectCredentialPolicyRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_project, 19);
+
+/**
+ * @generated from message altalune.v1.UpdateProjectCredentialPolicyResponse
+ */
+export type UpdateProjectCredentialPolicyResponse = Message<"altalune.v1.UpdateProjectCredentialPolicyResponse"> & {
+  /**
+   * @generated from field: altalune.v1.ProjectCredentialPolicy policy = 1;
+   */
+  policy?: ProjectCredentialPolicy;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.UpdateProjectCredentialPolicyResponse.
+ * Use `create(UpdateProjectCredentialPolicyResponseSchema)` to create a new message.
+ */
+export const UpdateProjectCredentialPolicyResponseSchema: GenMessage<UpdateProjectCredentialPolicyResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_project, 20);
+
+/**
+ * @generated from enum altalune.v1.CredentialPolicyAction
+ */
+export enum CredentialPolicyAction {
+  /**
+   * @generated from enum value: CREDENTIAL_POLICY_ACTION_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Report out-of-policy keys to the project owners
+   *
+   * @generated from enum value: CREDENTIAL_POLICY_ACTION_FLAG = 1;
+   */
+  FLAG = 1,
+
+  /**
+   * Deactivate out-of-policy keys
+   *
+   * @generated from enum value: CREDENTIAL_POLICY_ACTION_DISABLE = 2;
+   */
+  DISABLE = 2,
+}
+
+/**
+ * Describes the enum altalune.v1.CredentialPolicyAction.
+ */
+export const CredentialPolicyActionSchema: GenEnum<CredentialPolicyAction> = /*@__PURE__*/
+  enumDesc(file_altalune_v1_project, 0);
+
 /**
  * @generated from service altalune.v1.ProjectService
  */
@@ -328,6 +640,38 @@ export const ProjectService: GenService<{
     input: typeof DeleteProjectRequestSchema;
     output: typeof DeleteProjectResponseSchema;
   },
+  /**
+   * @generated from rpc altalune.v1.ProjectService.GetProjectOnboarding
+   */
+  getProjectOnboarding: {
+    methodKind: "unary";
+    input: typeof GetProjectOnboardingRequestSchema;
+    output: typeof GetProjectOnboardingResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.ProjectService.UpdateProjectOnboarding
+   */
+  updateProjectOnboarding: {
+    methodKind: "unary";
+    input: typeof UpdateProjectOnboardingRequestSchema;
+    output: typeof UpdateProjectOnboardingResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.ProjectService.GetProjectCredentialPolicy
+   */
+  getProjectCredentialPolicy: {
+    methodKind: "unary";
+    input: typeof GetProjectCredentialPolicyRequestSchema;
+    output: typeof GetProjectCredentialPolicyResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.ProjectService.UpdateProjectCredentialPolicy
+   */
+  updateProjectCredentialPolicy: {
+    methodKind: "unary";
+    input: typeof UpdateProjectCredentialPolicyRequestSchema;
+    output: typeof UpdateProjectCredentialPolicyResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_altalune_v1_project, 0);
 
@@ -0,0 +1,294 @@
+// @generated by protoc-gen-es v2.6.3 with parameter "target=ts,import_extension=js"
+// @generated from file altalune/v1/query_export.proto (package altalune.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import { file_buf_validate_validate } from "../../buf/validate/validate_pb.js";
+import type { QueryRequest } from "./common_pb.js";
+import { file_altalune_v1_common } from "./common_pb.js";
+import { file_altalune_v1_options } from "./options_pb.js";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file altalune/v1/query_export.proto.
+ */
+export const file_altalune_v1_query_export: GenFile = /*@__PURE__*/
+  fileDesc("Ch5hbHRhbHVuZS92MS9xdWVyeV9leHBvcnQucHJvdG8SC2FsdGFsdW5lLnYxIvICCgtRdWVyeUV4cG9ydBIKCgJpZBgBIAEoCRIyCghyZXNvdXJjZRgCIAEoDjIgLmFsdGFsdW5lLnYxLlF1ZXJ5RXhwb3J0UmVzb3VyY2USEgoKcHJvamVjdF9pZBgDIAEoCRIuCgZzdGF0dXMYBCABKA4yHi5hbHRhbHVuZS52MS5RdWVyeUV4cG9ydFN0YXR1cxIUCgxyb3dzX3dyaXR0ZW4YBSABKAUSEgoKcm93c190b3RhbBgGIAEoBRIUCgxkb3dubG9hZF91cmwYByABKAkSDQoFZXJyb3IYCCABKAkSLgoKY3JlYXRlZF9hdBhhIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMAoMY29tcGxldGVkX2F0GGIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgpleHBpcmVzX2F0GGMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCKxAQoSRXhwb3J0UXVlcnlSZXF1ZXN0Ej8KCHJlc291cmNlGAEgASgOMiAuYWx0YWx1bmUudjEuUXVlcnlFeHBvcnRSZXNvdXJjZUILukgIyAEBggECEAESHwoKcHJvamVjdF9pZBgCIAEoCUILukgI2AEBcgOYAQ4SKAoFcXVlcnkYAyABKAsyGS5hbHRhbHVuZS52MS5RdWVyeVJlcXVlc3QSDwoHdHJhc2hlZBgEIAEoCCJQChNFeHBvcnRRdWVyeVJlc3BvbnNlEigKBmV4cG9ydBgBIAEoCzIYLmFsdGFsdW5lLnYxLlF1ZXJ5RXhwb3J0Eg8KB21lc3NhZ2UYAiABKAkiNwoVR2V0UXVlcnlFeHBvcnRSZXF1ZXN0Eh4KCWV4cG9ydF9pZBgBIAEoCUILukgIyAEBcgOYAQ4iQgoWR2V0UXVlcnlFeHBvcnRSZXNwb25zZRIoCgZleHBvcnQYASABKAsyGC5hbHRhbHVuZS52MS5RdWVyeUV4cG9ydCqBAQoTUXVlcnlFeHBvcnRSZXNvdXJjZRIlCiFRVUVSWV9FWFBPUlRfUkVTT1VSQ0VfVU5TUEVDSUZJRUQQABIfChtRVUVSWV9FWFBPUlRfUkVTT1VSQ0VfVVNFUlMQARIiCh5RVUVSWV9FWFBPUlRfUkVTT1VSQ0VfQVBJX0tFWVMQAiq9AQoRUXVlcnlFeHBvcnRTdGF0dXMSIwofUVVFUllfRVhQT1JUX1NUQVRVU19VTlNQRUNJRklFRBAAEh8KG1FVRVJZX0VYUE9SVF9TVEFUVVNfUEVORElORxABEh8KG1FVRVJZX0VYUE9SVF9TVEFUVVNfUlVOTklORxACEiEKHVFVRVJZX0VYUE9SVF9TVEFUVVNfQ09NUExFVEVEEAMSHgoaUVVFUllfRVhQT1JUX1NUQVRVU19GQUlMRUQQBDL9AQoSUXVlcnlFeHBvcnRTZXJ2aWNlEm4KC0V4cG9ydFF1ZXJ5Eh8uYWx0YWx1bmUudjEuRXhwb3J0UXVlcnlSZXF1ZXN0GiAuYWx0YWx1bmUudjEuRXhwb3J0UXVlcnlSZXNwb25zZSIcirUYCXVzZXI6cmVhZIq1GAthcGlrZXk6cmVhZBJ3Cg5HZXRRdWVyeUV4cG9ydBIiLmFsdGFsdW5lLnYxLkdldFF1ZXJ5RXhwb3J0UmVxdWVzdBojLmFsdGFsdW5lLnYxLkdldFF1ZXJ5RXhwb3J0UmVzcG9uc2UiHIq1GAl1c2VyOnJlYWSKtRgLYXBpa2V5OnJlYWRCpQEKD2NvbS5hbHRhbHVuZS52MUIQUXVlcnlFeHBvcnRQcm90b1ABWjNnaXRodWIuY29tL2hyejgvYWx0YWx1bmUvZ2VuL2FsdGFsdW5lL3YxO2FsdGFsdW5ldjGiAgNBWFiqAgtBbHRhbHVuZS5WMcoCC0FsdGFsdW5lXFYx4gIXQWx0YWx1bmVcVjFcR1BCTWV0YWRhdGHqAgxBbHRhbHVuZTo6VjFiBnByb3RvMw", [file_google_protobuf_timestamp, file_buf_validate_validate, file_altalune_v1_common, file_altalune_v1_options]);
+
+/**
+ * Query Export Message
+ *
+ * @generated from message altalune.v1.QueryExport
+ */
+export type QueryExport = Message<"altalune.v1.QueryExport"> & {
+  /**
+   * Public nanoid
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: altalune.v1.QueryExportResource resource = 2;
+   */
+  resource: QueryExportResource;
+
+  /**
+   * Empty for global resources
+   *
+   * @generated from field: string project_id = 3;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: altalune.v1.QueryExportStatus status = 4;
+   */
+  status: QueryExportStatus;
+
+  /**
+   * @generated from field: int32 rows_written = 5;
+   */
+  rowsWritten: number;
+
+  /**
+   * rows_total - rows the query matches, estimated until the export completes
+   *
+   * @generated from field: int32 rows_total = 6;
+   */
+  rowsTotal: number;
+
+  /**
+   * download_url - link of the CSV file relative to the API server, only
+   * returned by ExportQuery since the server keeps a hash of its token; it
+   * needs no credentials, serves the file once the export completes and works
+   * until expires_at
+   *
+   * @generated from field: string download_url = 7;
+   */
+  downloadUrl: string;
+
+  /**
+   * Why the export failed
+   *
+   * @generated from field: string error = 8;
+   */
+  error: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 97;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp completed_at = 98;
+   */
+  completedAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 99;
+   */
+  expiresAt?: Timestamp;
+};
+
+/**
+ * Describes the message altalune.v1.QueryExport.
+ * Use `create(QueryExportSchema)` to create a new message.
+ */
+export const QueryExportSchema: GenMessage<QueryExport> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_query_export, 0);
+
+/**
+ * @generated from message altalune.v1.ExportQueryRequest
+ */
+export type ExportQueryRequest = Message<"altalune.v1.ExportQueryRequest"> & {
+  /**
+   * @generated from field: altalune.v1.QueryExportResource resource = 1;
+   */
+  resource: QueryExportResource;
+
+  /**
+   * project_id - required for project-scoped resources, ignored otherwise
+   *
+   * @generated from field: string project_id = 2;
+   */
+  projectId: string;
+
+  /**
+   * query - keyword and filters of the rows to export, as sent to the Query
+   * endpoint, and its sorting; every matching row is exported, so its
+   * pagination and cursor are ignored
+   *
+   * @generated from field: altalune.v1.QueryRequest query = 3;
+   */
+  query?: QueryRequest;
+
+  /**
+   * Export soft-deleted rows instead of live ones
+   *
+   * @generated from field: bool trashed = 4;
+   */
+  trashed: boolean;
+};
+
+/**
+ * Describes the message altalune.v1.ExportQueryRequest.
+ * Use `create(ExportQueryRequestSchema)` to create a new message.
+ */
+export const ExportQueryRequestSchema: GenMessage<ExportQueryRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_query_export, 1);
+
+/**
+ * @generated from message altalune.v1.ExportQueryResponse
+ */
+export type ExportQueryResponse = Message<"altalune.v1.ExportQueryResponse"> & {
+  /**
+   * @generated from field: altalune.v1.QueryExport export = 1;
+   */
+  export?: QueryExport;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.ExportQueryResponse.
+ * Use `create(ExportQueryResponseSchema)` to create a new message.
+ */
+export const ExportQueryResponseSchema: GenMessage<ExportQueryResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_query_export, 2);
+
+/**
+ * @generated from message altalune.v1.GetQueryExportRequest
+ */
+export type GetQueryExportRequest = Message<"altalune.v1.GetQueryExportRequest"> & {
+  /**
+   * @generated from field: string export_id = 1;
+   */
+  exportId: string;
+};
+
+/**
+ * Describes the message altalune.v1.GetQueryExportRequest.
+ * Use `create(GetQueryExportRequestSchema)` to create a new message.
+ */
+export const GetQueryExportRequestSchema: GenMessage<GetQueryExportRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_query_export, 3);
+
+/**
+ * @generated from message altalune.v1.GetQueryExportResponse
+ */
+export type GetQueryExportResponse = Message<"altalune.v1.GetQueryExportResponse"> & {
+  /**
+   * @generated from field: altalune.v1.QueryExport export = 1;
+   */
+  export?: QueryExport;
+};
+
+/**
+ * Describes the message altalune.v1.GetQueryExportResponse.
+ * Use `create(GetQueryExportResponseSchema)` to create a new message.
+ */
+export const GetQueryExportResponseSchema: GenMessage<GetQueryExportResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_query_export, 4);
+
+/**
+ * QueryExportResource - Query endpoint an export runs
+ *
+ * @generated from enum altalune.v1.QueryExportResource
+ */
+export enum QueryExportResource {
+  /**
+   * @generated from enum value: QUERY_EXPORT_RESOURCE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * QUERY_EXPORT_RESOURCE_USERS - QueryUsers, global
+   *
+   * @generated from enum value: QUERY_EXPORT_RESOURCE_USERS = 1;
+   */
+  USERS = 1,
+
+  /**
+   * QUERY_EXPORT_RESOURCE_API_KEYS - QueryApiKeys, scoped to a project
+   *
+   * @generated from enum value: QUERY_EXPORT_RESOURCE_API_KEYS = 2;
+   */
+  API_KEYS = 2,
+}
+
+/**
+ * Describes the enum altalune.v1.QueryExportResource.
+ */
+export const QueryExportResourceSchema: GenEnum<QueryExportResource> = /*@__PURE__*/
+  enumDesc(file_altalune_v1_query_export, 0);
+
+/**
+ * @generated from enum altalune.v1.QueryExportStatus
+ */
+export enum QueryExportStatus {
+  /**
+   * @generated from enum value: QUERY_EXPORT_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: QUERY_EXPORT_STATUS_PENDING = 1;
+   */
+  PENDING = 1,
+
+  /**
+   * @generated from enum value: QUERY_EXPORT_STATUS_RUNNING = 2;
+   */
+  RUNNING = 2,
+
+  /**
+   * @generated from enum value: QUERY_EXPORT_STATUS_COMPLETED = 3;
+   */
+  COMPLETED = 3,
+
+  /**
+   * @generated from enum value: QUERY_EXPORT_STATUS_FAILED = 4;
+   */
+  FAILED = 4,
+}
+
+/**
+ * Describes the enum altalune.v1.QueryExportStatus.
+ */
+export const QueryExportStatusSchema: GenEnum<QueryExportStatus> = /*@__PURE__*/
+  enumDesc(file_altalune_v1_query_export, 1);
+
+/**
+ * Query Export Service - Export every row a Query endpoint matches to CSV
+ * Exports run in the background: ExportQuery starts one and GetQueryExport
+ * polls its progress until the download link is ready. Exports are private
+ * to the user who started them.
+ *
+ * @generated from service altalune.v1.QueryExportService
+ */
+export const QueryExportService: GenService<{
+  /**
+   * @generated from rpc altalune.v1.QueryExportService.ExportQuery
+   */
+  exportQuery: {
+    methodKind: "unary";
+    input: typeof ExportQueryRequestSchema;
+    output: typeof ExportQueryResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.QueryExportService.GetQueryExport
+   */
+  getQueryExport: {
+    methodKind: "unary";
+    input: typeof GetQueryExportRequestSchema;
+    output: typeof GetQueryExportResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_altalune_v1_query_export, 0);
+
@@ -9,13 +9,14 @@ import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import { file_buf_validate_validate } from "../../buf/validate/validate_pb.js";
 import type { QueryMetaResponse, QueryRequest } from "./common_pb.js";
 import { file_altalune_v1_common } from "./common_pb.js";
+import { file_altalune_v1_options } from "./options_pb.js";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file altalune/v1/role.proto.
  */
 export const file_altalune_v1_role: GenFile = /*@__PURE__*/
-  fileDesc("ChZhbHRhbHVuZS92MS9yb2xlLnByb3RvEgthbHRhbHVuZS52MSKVAQoEUm9sZRIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEi4KCmNyZWF0ZWRfYXQYYiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYYyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIj0KEVF1ZXJ5Um9sZXNSZXF1ZXN0EigKBXF1ZXJ5GAEgASgLMhkuYWx0YWx1bmUudjEuUXVlcnlSZXF1ZXN0ImMKElF1ZXJ5Um9sZXNSZXNwb25zZRIfCgRkYXRhGAEgAygLMhEuYWx0YWx1bmUudjEuUm9sZRIsCgRtZXRhGAIgASgLMh4uYWx0YWx1bmUudjEuUXVlcnlNZXRhUmVzcG9uc2UiYwoRQ3JlYXRlUm9sZVJlcXVlc3QSLwoEbmFtZRgBIAEoCUIhukgeyAEBchkQAhhkMhNeW2EtekEtWjAtOVxzXC1fXSskEh0KC2Rlc2NyaXB0aW9uGAIgASgJQgi6SAVyAxj0AyJGChJDcmVhdGVSb2xlUmVzcG9uc2USHwoEcm9sZRgBIAEoCzIRLmFsdGFsdW5lLnYxLlJvbGUSDwoHbWVzc2FnZRgCIAEoCSIqCg5HZXRSb2xlUmVxdWVzdBIYCgJpZBgBIAEoCUIMukgJyAEBcgQQDhgUIjIKD0dldFJvbGVSZXNwb25zZRIfCgRyb2xlGAEgASgLMhEuYWx0YWx1bmUudjEuUm9sZSJ9ChFVcGRhdGVSb2xlUmVxdWVzdBIYCgJpZBgBIAEoCUIMukgJyAEBcgQQDhgUEi8KBG5hbWUYAiABKAlCIbpIHsgBAXIZEAIYZDITXlthLXpBLVowLTlcc1wtX10rJBIdCgtkZXNjcmlwdGlvbhgDIAEoCUIIukgFcgMY9AMiRgoSVXBkYXRlUm9sZVJlc3BvbnNlEh8KBHJvbGUYASABKAsyES5hbHRhbHVuZS52MS5Sb2xlEg8KB21lc3NhZ2UYAiABKAkiLQoRRGVsZXRlUm9sZVJlcXVlc3QSGAoCaWQYASABKAlCDLpICcgBAXIEEA4YFCIlChJEZWxldGVSb2xlUmVzcG9uc2USDwoHbWVzc2FnZRgBIAEoCTKZAwoLUm9sZVNlcnZpY2USTwoKUXVlcnlSb2xlcxIeLmFsdGFsdW5lLnYxLlF1ZXJ5Um9sZXNSZXF1ZXN0Gh8uYWx0YWx1bmUudjEuUXVlcnlSb2xlc1Jlc3BvbnNlIgASTwoKQ3JlYXRlUm9sZRIeLmFsdGFsdW5lLnYxLkNyZWF0ZVJvbGVSZXF1ZXN0Gh8uYWx0YWx1bmUudjEuQ3JlYXRlUm9sZVJlc3BvbnNlIgASRgoHR2V0Um9sZRIbLmFsdGFsdW5lLnYxLkdldFJvbGVSZXF1ZXN0GhwuYWx0YWx1bmUudjEuR2V0Um9sZVJlc3BvbnNlIgASTwoKVXBkYXRlUm9sZRIeLmFsdGFsdW5lLnYxLlVwZGF0ZVJvbGVSZXF1ZXN0Gh8uYWx0YWx1bmUudjEuVXBkYXRlUm9sZVJlc3BvbnNlIgASTwoKRGVsZXRlUm9sZRIeLmFsdGFsdW5lLnYxLkRlbGV0ZVJvbGVSZXF1ZXN0Gh8uYWx0YWx1bmUudjEuRGVsZXRlUm9sZVJlc3BvbnNlIgBCngEKD2NvbS5hbHRhbHVuZS52MUIJUm9sZVByb3RvUAFaM2dpdGh1Yi5jb20vaHJ6OC9hbHRhbHVuZS9nZW4vYWx0YWx1bmUvdjE7YWx0YWx1bmV2MaICA0FYWKoCC0FsdGFsdW5lLlYxygILQWx0YWx1bmVcVjHiAhdBbHRhbHVuZVxWMVxHUEJNZXRhZGF0YeoCDEFsdGFsdW5lOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp, file_buf_validate_validate, file_altalune_v1_common]);
+  fileDesc("ChZhbHRhbHVuZS92MS9yb2xlLnByb3RvEgthbHRhbHVuZS52MSLSAQoEUm9sZRIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEhIKCmNyZWF0ZWRfYnkYBCABKAkSEgoKdXBkYXRlZF9ieRgFIAEoCRITCgtleHRlcm5hbF9pZBgGIAEoCRIuCgpjcmVhdGVkX2F0GGIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GGMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCI9ChFRdWVyeVJvbGVzUmVxdWVzdBIoCgVxdWVyeRgBIAEoCzIZLmFsdGFsdW5lLnYxLlF1ZXJ5UmVxdWVzdCJjChJRdWVyeVJvbGVzUmVzcG9uc2USHwoEZGF0YRgBIAMoCzIRLmFsdGFsdW5lLnYxLlJvbGUSLAoEbWV0YRgCIAEoCzIeLmFsdGFsdW5lLnYxLlF1ZXJ5TWV0YVJlc3BvbnNlIpoBChFDcmVhdGVSb2xlUmVxdWVzdBIvCgRuYW1lGAEgASgJQiG6SB7IAQFyGRACGGQyE15bYS16QS1aMC05XHNcLV9dKyQSHQoLZGVzY3JpcHRpb24YAiABKAlCCLpIBXIDGPQDEjUKC2V4dGVybmFsX2lkGAMgASgJQiC6SB3YAQFyGBhkMhReW0EtWmEtejAtOS5fOi9ALV0rJCJGChJDcmVhdGVSb2xlUmVzcG9uc2USHwoEcm9sZRgBIAEoCzIRLmFsdGFsdW5lLnYxLlJvbGUSDwoHbWVzc2FnZRgCIAEoCSIqCg5HZXRSb2xlUmVxdWVzdBIYCgJpZBgBIAEoCUIMukgJyAEBcgQQDhgUIjIKD0dldFJvbGVSZXNwb25zZRIfCgRyb2xlGAEgASgLMhEuYWx0YWx1bmUudjEuUm9sZSK2AQoRVXBkYXRlUm9sZVJlcXVlc3QSGAoCaWQYASABKAlCDLpICcgBAXIEEA4YFBIvCgRuYW1lGAIgASgJQiG6SB7IAQFyGRACGGQyE15bYS16QS1aMC05XHNcLV9dKyQSHQoLZGVzY3JpcHRpb24YAyABKAlCCLpIBXIDGPQDEjcKE2V4cGVjdGVkX3VwZGF0ZWRfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIkYKElVwZGF0ZVJvbGVSZXNwb25zZRIfCgRyb2xlGAEgASgLMhEuYWx0YWx1bmUudjEuUm9sZRIPCgdtZXNzYWdlGAIgASgJIi0KEURlbGV0ZVJvbGVSZXF1ZXN0EhgKAmlkGAEgASgJQgy6SAnIAQFyBBAOGBQiJQoSRGVsZXRlUm9sZVJlc3BvbnNlEg8KB21lc3NhZ2UYASABKAkimQEKEEFwcGx5Um9sZVJlcXVlc3QSNQoLZXh0ZXJuYWxfaWQYASABKAlCILpIHcgBAXIYGGQyFF5bQS1aYS16MC05Ll86L0AtXSskEi8KBG5hbWUYAiABKAlCIbpIHsgBAXIZEAIYZDITXlthLXpBLVowLTlcc1wtX10rJBIdCgtkZXNjcmlwdGlvbhgDIAEoCUIIukgFcgMY9AMiVgoRQXBwbHlSb2xlUmVzcG9uc2USHwoEcm9sZRgBIAEoCzIRLmFsdGFsdW5lLnYxLlJvbGUSDwoHY3JlYXRlZBgCIAEoCBIPCgdtZXNzYWdlGAMgASgJImYKEUltcG9ydFJvbGVSZXF1ZXN0EhoKBG5hbWUYASABKAlCDLpICcgBAXIEEAIYZBI1CgtleHRlcm5hbF9pZBgCIAEoCUIgukgdyAEBchgYZDIUXltBLVphLXowLTkuXzovQC1dKyQiRgoSSW1wb3J0Um9sZVJlc3BvbnNlEh8KBHJvbGUYASABKAsyES5hbHRhbHVuZS52MS5Sb2xlEg8KB21lc3NhZ2UYAiABKAkymQUKC1JvbGVTZXJ2aWNlElwKClF1ZXJ5Um9sZXMSHi5hbHRhbHVuZS52MS5RdWVyeVJvbGVzUmVxdWVzdBofLmFsdGFsdW5lLnYxLlF1ZXJ5Um9sZXNSZXNwb25zZSINirUYCXJvbGU6cmVhZBJdCgpDcmVhdGVSb2xlEh4uYWx0YWx1bmUudjEuQ3JlYXRlUm9sZVJlcXVlc3QaHy5hbHRhbHVuZS52MS5DcmVhdGVSb2xlUmVzcG9uc2UiDoq1GApyb2xlOndyaXRlElMKB0dldFJvbGUSGy5hbHRhbHVuZS52MS5HZXRSb2xlUmVxdWVzdBocLmFsdGFsdW5lLnYxLkdldFJvbGVSZXNwb25zZSINirUYCXJvbGU6cmVhZBJdCgpVcGRhdGVSb2xlEh4uYWx0YWx1bmUudjEuVXBkYXRlUm9sZVJlcXVlc3QaHy5hbHRhbHVuZS52MS5VcGRhdGVSb2xlUmVzcG9uc2UiDoq1GApyb2xlOndyaXRlEl4KCkRlbGV0ZVJvbGUSHi5hbHRhbHVuZS52MS5EZWxldGVSb2xlUmVxdWVzdBofLmFsdGFsdW5lLnYxLkRlbGV0ZVJvbGVSZXNwb25zZSIPirUYC3JvbGU6ZGVsZXRlEloKCUFwcGx5Um9sZRIdLmFsdGFsdW5lLnYxLkFwcGx5Um9sZVJlcXVlc3QaHi5hbHRhbHVuZS52MS5BcHBseVJvbGVSZXNwb25zZSIOirUYCnJvbGU6d3JpdGUSXQoKSW1wb3J0Um9sZRIeLmFsdGFsdW5lLnYxLkltcG9ydFJvbGVSZXF1ZXN0Gh8uYWx0YWx1bmUudjEuSW1wb3J0Um9sZVJlc3BvbnNlIg6KtRgKcm9sZTp3cml0ZUKeAQoPY29tLmFsdGFsdW5lLnYxQglSb2xlUHJvdG9QAVozZ2l0aHViLmNvbS9ocno4L2FsdGFsdW5lL2dlbi9hbHRhbHVuZS92MTthbHRhbHVuZXYxogIDQVhYqgILQWx0YWx1bmUuVjHKAgtBbHRhbHVuZVxWMeICF0FsdGFsdW5lXFYxXEdQQk1ldGFkYXRh6gIMQWx0YWx1bmU6OlYxYgZwcm90bzM", [file_google_protobuf_timestamp, file_buf_validate_validate, file_altalune_v1_common, file_altalune_v1_options]);
 
 /**
  * Role represents a system-wide role that can be assigned to users
@@ -44,6 +45,27 @@ export type Role = Message<"altalune.v1.Role"> & {
    */
   description: string;
 
+  /**
+   * Public ID of the user who created the role, empty if unknown
+   *
+   * @generated from field: string created_by = 4;
+   */
+  createdBy: string;
+
+  /**
+   * Public ID of the user who last updated the role, empty if unknown
+   *
+   * @generated from field: string updated_by = 5;
+   */
+  updatedBy: string;
+
+  /**
+   * Key of the role in a provisioning tool, empty if unmanaged
+   *
+   * @generated from field: string external_id = 6;
+   */
+  externalId: string;
+
   /**
    * @generated from field: google.protobuf.Timestamp created_at = 98;
    */
@@ -120,6 +142,13 @@ export type CreateRoleRequest = Message<"altalune.v1.CreateRoleRequest"> & {
    * @generated from field: string description = 2;
    */
   description: string;
+
+  /**
+   * Key of the role in a provisioning tool, unique among roles
+   *
+   * @generated from field: string external_id = 3;
+   */
+  externalId: string;
 };
 
 /**
@@ -211,6 +240,14 @@ export type UpdateRoleRequest = Message<"altalune.v1.UpdateRoleRequest"> & {
    * @generated from field: string description = 3;
    */
   description: string;
+
+  /**
+   * updated_at of the role the edit is based on. When set, the update is
+   * rejected with FAILED_PRECONDITION if the role changed since then.
+   *
+   * @generated from field: google.protobuf.Timestamp expected_updated_at = 4;
+   */
+  expectedUpdatedAt?: Timestamp;
 };
 
 /**
@@ -282,6 +319,117 @@ export type DeleteRoleResponse = Message<"altalune.v1.DeleteRoleResponse"> & {
 export const DeleteRoleResponseSchema: GenMessage<DeleteRoleResponse> = /*@__PURE__*/
   messageDesc(file_altalune_v1_role, 10);
 
+/**
+ * ApplyRoleRequest creates the role with the external ID, or brings the
+ * existing one to the given name and description. Applying the same request
+ * again changes nothing.
+ *
+ * @generated from message altalune.v1.ApplyRoleRequest
+ */
+export type ApplyRoleRequest = Message<"altalune.v1.ApplyRoleRequest"> & {
+  /**
+   * @generated from field: string external_id = 1;
+   */
+  externalId: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string description = 3;
+   */
+  description: string;
+};
+
+/**
+ * Describes the message altalune.v1.ApplyRoleRequest.
+ * Use `create(ApplyRoleRequestSchema)` to create a new message.
+ */
+export const ApplyRoleRequestSchema: GenMessage<ApplyRoleRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_role, 11);
+
+/**
+ * ApplyRoleResponse with the role as applied
+ *
+ * @generated from message altalune.v1.ApplyRoleResponse
+ */
+export type ApplyRoleResponse = Message<"altalune.v1.ApplyRoleResponse"> & {
+  /**
+   * @generated from field: altalune.v1.Role role = 1;
+   */
+  role?: Role;
+
+  /**
+   * Whether the role was created rather than updated
+   *
+   * @generated from field: bool created = 2;
+   */
+  created: boolean;
+
+  /**
+   * @generated from field: string message = 3;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.ApplyRoleResponse.
+ * Use `create(ApplyRoleResponseSchema)` to create a new message.
+ */
+export const ApplyRoleResponseSchema: GenMessage<ApplyRoleResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_role, 12);
+
+/**
+ * ImportRoleRequest sets the external ID of the role with the name, so a
+ * provisioning tool can manage a role it did not create
+ *
+ * @generated from message altalune.v1.ImportRoleRequest
+ */
+export type ImportRoleRequest = Message<"altalune.v1.ImportRoleRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string external_id = 2;
+   */
+  externalId: string;
+};
+
+/**
+ * Describes the message altalune.v1.ImportRoleRequest.
+ * Use `create(ImportRoleRequestSchema)` to create a new message.
+ */
+export const ImportRoleRequestSchema: GenMessage<ImportRoleRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_role, 13);
+
+/**
+ * ImportRoleResponse with the imported role
+ *
+ * @generated from message altalune.v1.ImportRoleResponse
+ */
+export type ImportRoleResponse = Message<"altalune.v1.ImportRoleResponse"> & {
+  /**
+   * @generated from field: altalune.v1.Role role = 1;
+   */
+  role?: Role;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.ImportRoleResponse.
+ * Use `create(ImportRoleResponseSchema)` to create a new message.
+ */
+export const ImportRoleResponseSchema: GenMessage<ImportRoleResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_role, 14);
+
 /**
  * RoleService provides CRUD operations for role management
  *
@@ -328,6 +476,26 @@ export const RoleService: GenService<{
     input: typeof DeleteRoleRequestSchema;
     output: typeof DeleteRoleResponseSchema;
   },
+  /**
+   * Create-or-update by external ID, for declarative provisioning
+   *
+   * @generated from rpc altalune.v1.RoleService.ApplyRole
+   */
+  applyRole: {
+    methodKind: "unary";
+    input: typeof ApplyRoleRequestSchema;
+    output: typeof ApplyRoleResponseSchema;
+  },
+  /**
+   * Bring an existing role under an external ID, found by name
+   *
+   * @generated from rpc altalune.v1.RoleService.ImportRole
+   */
+  importRole: {
+    methodKind: "unary";
+    input: typeof ImportRoleRequestSchema;
+    output: typeof ImportRoleResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_altalune_v1_role, 0);
 
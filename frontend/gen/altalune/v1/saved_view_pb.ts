@@ -0,0 +1,373 @@
+// @generated by protoc-gen-es v2.6.3 with parameter "target=ts,import_extension=js"
+// @generated from file altalune/v1/saved_view.proto (package altalune.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import { file_buf_validate_validate } from "../../buf/validate/validate_pb.js";
+import type { Sorting, StringList } from "./common_pb.js";
+import { file_altalune_v1_common } from "./common_pb.js";
+import { file_altalune_v1_options } from "./options_pb.js";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file altalune/v1/saved_view.proto.
+ */
+export const file_altalune_v1_saved_view: GenFile = /*@__PURE__*/
+  fileDesc("ChxhbHRhbHVuZS92MS9zYXZlZF92aWV3LnByb3RvEgthbHRhbHVuZS52MSKCAwoJU2F2ZWRWaWV3EgoKAmlkGAEgASgJEjAKCHJlc291cmNlGAIgASgOMh4uYWx0YWx1bmUudjEuU2F2ZWRWaWV3UmVzb3VyY2USEgoKcHJvamVjdF9pZBgDIAEoCRIMCgRuYW1lGAQgASgJEg8KB2tleXdvcmQYBSABKAkSNAoHZmlsdGVycxgGIAMoCzIjLmFsdGFsdW5lLnYxLlNhdmVkVmlldy5GaWx0ZXJzRW50cnkSJQoHc29ydGluZxgHIAEoCzIULmFsdGFsdW5lLnYxLlNvcnRpbmcSLgoKY3JlYXRlZF9hdBhiIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBhjIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAaRwoMRmlsdGVyc0VudHJ5EgsKA2tleRgBIAEoCRImCgV2YWx1ZRgCIAEoCzIXLmFsdGFsdW5lLnYxLlN0cmluZ0xpc3Q6AjgBIncKFUxpc3RTYXZlZFZpZXdzUmVxdWVzdBI9CghyZXNvdXJjZRgBIAEoDjIeLmFsdGFsdW5lLnYxLlNhdmVkVmlld1Jlc291cmNlQgu6SAjIAQGCAQIQARIfCgpwcm9qZWN0X2lkGAIgASgJQgu6SAjYAQFyA5gBDiI+ChZMaXN0U2F2ZWRWaWV3c1Jlc3BvbnNlEiQKBGRhdGEYASADKAsyFi5hbHRhbHVuZS52MS5TYXZlZFZpZXci7AIKFkNyZWF0ZVNhdmVkVmlld1JlcXVlc3QSPQoIcmVzb3VyY2UYASABKA4yHi5hbHRhbHVuZS52MS5TYXZlZFZpZXdSZXNvdXJjZUILukgIyAEBggECEAESHwoKcHJvamVjdF9pZBgCIAEoCUILukgI2AEBcgOYAQ4SGgoEbmFtZRgDIAEoCUIMukgJyAEBcgQQARhkEhkKB2tleXdvcmQYBCABKAlCCLpIBXIDGIACEksKB2ZpbHRlcnMYBSADKAsyMC5hbHRhbHVuZS52MS5DcmVhdGVTYXZlZFZpZXdSZXF1ZXN0LkZpbHRlcnNFbnRyeUIIukgFmgECEBQSJQoHc29ydGluZxgGIAEoCzIULmFsdGFsdW5lLnYxLlNvcnRpbmcaRwoMRmlsdGVyc0VudHJ5EgsKA2tleRgBIAEoCRImCgV2YWx1ZRgCIAEoCzIXLmFsdGFsdW5lLnYxLlN0cmluZ0xpc3Q6AjgBIlAKF0NyZWF0ZVNhdmVkVmlld1Jlc3BvbnNlEiQKBHZpZXcYASABKAsyFi5hbHRhbHVuZS52MS5TYXZlZFZpZXcSDwoHbWVzc2FnZRgCIAEoCSKqAgoWVXBkYXRlU2F2ZWRWaWV3UmVxdWVzdBIcCgd2aWV3X2lkGAEgASgJQgu6SAjIAQFyA5gBDhIaCgRuYW1lGAIgASgJQgy6SAnIAQFyBBABGGQSGQoHa2V5d29yZBgDIAEoCUIIukgFcgMYgAISSwoHZmlsdGVycxgEIAMoCzIwLmFsdGFsdW5lLnYxLlVwZGF0ZVNhdmVkVmlld1JlcXVlc3QuRmlsdGVyc0VudHJ5Qgi6SAWaAQIQFBIlCgdzb3J0aW5nGAUgASgLMhQuYWx0YWx1bmUudjEuU29ydGluZxpHCgxGaWx0ZXJzRW50cnkSCwoDa2V5GAEgASgJEiYKBXZhbHVlGAIgASgLMhcuYWx0YWx1bmUudjEuU3RyaW5nTGlzdDoCOAEiUAoXVXBkYXRlU2F2ZWRWaWV3UmVzcG9uc2USJAoEdmlldxgBIAEoCzIWLmFsdGFsdW5lLnYxLlNhdmVkVmlldxIPCgdtZXNzYWdlGAIgASgJIjYKFkRlbGV0ZVNhdmVkVmlld1JlcXVlc3QSHAoHdmlld19pZBgBIAEoCUILukgIyAEBcgOYAQ4iKgoXRGVsZXRlU2F2ZWRWaWV3UmVzcG9uc2USDwoHbWVzc2FnZRgBIAEoCSqcAQoRU2F2ZWRWaWV3UmVzb3VyY2USIwofU0FWRURfVklFV19SRVNPVVJDRV9VTlNQRUNJRklFRBAAEiAKHFNBVkVEX1ZJRVdfUkVTT1VSQ0VfQVBJX0tFWVMQARIdChlTQVZFRF9WSUVXX1JFU09VUkNFX1VTRVJTEAISIQodU0FWRURfVklFV19SRVNPVVJDRV9FTVBMT1lFRVMQAzLHBAoQU2F2ZWRWaWV3U2VydmljZRKIAQoOTGlzdFNhdmVkVmlld3MSIi5hbHRhbHVuZS52MS5MaXN0U2F2ZWRWaWV3c1JlcXVlc3QaIy5hbHRhbHVuZS52MS5MaXN0U2F2ZWRWaWV3c1Jlc3BvbnNlIi2KtRgLYXBpa2V5OnJlYWSKtRgJdXNlcjpyZWFkirUYDWVtcGxveWVlOnJlYWQSiwEKD0NyZWF0ZVNhdmVkVmlldxIjLmFsdGFsdW5lLnYxLkNyZWF0ZVNhdmVkVmlld1JlcXVlc3QaJC5hbHRhbHVuZS52MS5DcmVhdGVTYXZlZFZpZXdSZXNwb25zZSItirUYC2FwaWtleTpyZWFkirUYCXVzZXI6cmVhZIq1GA1lbXBsb3llZTpyZWFkEosBCg9VcGRhdGVTYXZlZFZpZXcSIy5hbHRhbHVuZS52MS5VcGRhdGVTYXZlZFZpZXdSZXF1ZXN0GiQuYWx0YWx1bmUudjEuVXBkYXRlU2F2ZWRWaWV3UmVzcG9uc2UiLYq1GAthcGlrZXk6cmVhZIq1GAl1c2VyOnJlYWSKtRgNZW1wbG95ZWU6cmVhZBKLAQoPRGVsZXRlU2F2ZWRWaWV3EiMuYWx0YWx1bmUudjEuRGVsZXRlU2F2ZWRWaWV3UmVxdWVzdBokLmFsdGFsdW5lLnYxLkRlbGV0ZVNhdmVkVmlld1Jlc3BvbnNlIi2KtRgLYXBpa2V5OnJlYWSKtRgJdXNlcjpyZWFkirUYDWVtcGxveWVlOnJlYWRCowEKD2NvbS5hbHRhbHVuZS52MUIOU2F2ZWRWaWV3UHJvdG9QAVozZ2l0aHViLmNvbS9ocno4L2FsdGFsdW5lL2dlbi9hbHRhbHVuZS92MTthbHRhbHVuZXYxogIDQVhYqgILQWx0YWx1bmUuVjHKAgtBbHRhbHVuZVxWMeICF0FsdGFsdW5lXFYxXEdQQk1ldGFkYXRh6gIMQWx0YWx1bmU6OlYxYgZwcm90bzM", [file_google_protobuf_timestamp, file_buf_validate_validate, file_altalune_v1_common, file_altalune_v1_options]);
+
+/**
+ * Saved View Message
+ * keyword, filters and sorting are sent as is in the QueryRequest of the
+ * resource to apply the view.
+ *
+ * @generated from message altalune.v1.SavedView
+ */
+export type SavedView = Message<"altalune.v1.SavedView"> & {
+  /**
+   * Public nanoid
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: altalune.v1.SavedViewResource resource = 2;
+   */
+  resource: SavedViewResource;
+
+  /**
+   * Empty for global resources
+   *
+   * @generated from field: string project_id = 3;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: string name = 4;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string keyword = 5;
+   */
+  keyword: string;
+
+  /**
+   * @generated from field: map<string, altalune.v1.StringList> filters = 6;
+   */
+  filters: { [key: string]: StringList };
+
+  /**
+   * Unset for the default order
+   *
+   * @generated from field: altalune.v1.Sorting sorting = 7;
+   */
+  sorting?: Sorting;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 98;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 99;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message altalune.v1.SavedView.
+ * Use `create(SavedViewSchema)` to create a new message.
+ */
+export const SavedViewSchema: GenMessage<SavedView> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_saved_view, 0);
+
+/**
+ * @generated from message altalune.v1.ListSavedViewsRequest
+ */
+export type ListSavedViewsRequest = Message<"altalune.v1.ListSavedViewsRequest"> & {
+  /**
+   * @generated from field: altalune.v1.SavedViewResource resource = 1;
+   */
+  resource: SavedViewResource;
+
+  /**
+   * project_id - required for project-scoped resources, ignored otherwise
+   *
+   * @generated from field: string project_id = 2;
+   */
+  projectId: string;
+};
+
+/**
+ * Describes the message altalune.v1.ListSavedViewsRequest.
+ * Use `create(ListSavedViewsRequestSchema)` to create a new message.
+ */
+export const ListSavedViewsRequestSchema: GenMessage<ListSavedViewsRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_saved_view, 1);
+
+/**
+ * @generated from message altalune.v1.ListSavedViewsResponse
+ */
+export type ListSavedViewsResponse = Message<"altalune.v1.ListSavedViewsResponse"> & {
+  /**
+   * @generated from field: repeated altalune.v1.SavedView data = 1;
+   */
+  data: SavedView[];
+};
+
+/**
+ * Describes the message altalune.v1.ListSavedViewsResponse.
+ * Use `create(ListSavedViewsResponseSchema)` to create a new message.
+ */
+export const ListSavedViewsResponseSchema: GenMessage<ListSavedViewsResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_saved_view, 2);
+
+/**
+ * @generated from message altalune.v1.CreateSavedViewRequest
+ */
+export type CreateSavedViewRequest = Message<"altalune.v1.CreateSavedViewRequest"> & {
+  /**
+   * @generated from field: altalune.v1.SavedViewResource resource = 1;
+   */
+  resource: SavedViewResource;
+
+  /**
+   * project_id - required for project-scoped resources, ignored otherwise
+   *
+   * @generated from field: string project_id = 2;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string keyword = 4;
+   */
+  keyword: string;
+
+  /**
+   * @generated from field: map<string, altalune.v1.StringList> filters = 5;
+   */
+  filters: { [key: string]: StringList };
+
+  /**
+   * @generated from field: altalune.v1.Sorting sorting = 6;
+   */
+  sorting?: Sorting;
+};
+
+/**
+ * Describes the message altalune.v1.CreateSavedViewRequest.
+ * Use `create(CreateSavedViewRequestSchema)` to create a new message.
+ */
+export const CreateSavedViewRequestSchema: GenMessage<CreateSavedViewRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_saved_view, 3);
+
+/**
+ * @generated from message altalune.v1.CreateSavedViewResponse
+ */
+export type CreateSavedViewResponse = Message<"altalune.v1.CreateSavedViewResponse"> & {
+  /**
+   * @generated from field: altalune.v1.SavedView view = 1;
+   */
+  view?: SavedView;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.CreateSavedViewResponse.
+ * Use `create(CreateSavedViewResponseSchema)` to create a new message.
+ */
+export const CreateSavedViewResponseSchema: GenMessage<CreateSavedViewResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_saved_view, 4);
+
+/**
+ * UpdateSavedViewRequest replaces the name, keyword, filters and sorting of
+ * a view; its resource and project never change.
+ *
+ * @generated from message altalune.v1.UpdateSavedViewRequest
+ */
+export type UpdateSavedViewRequest = Message<"altalune.v1.UpdateSavedViewRequest"> & {
+  /**
+   * @generated from field: string view_id = 1;
+   */
+  viewId: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string keyword = 3;
+   */
+  keyword: string;
+
+  /**
+   * @generated from field: map<string, altalune.v1.StringList> filters = 4;
+   */
+  filters: { [key: string]: StringList };
+
+  /**
+   * @generated from field: altalune.v1.Sorting sorting = 5;
+   */
+  sorting?: Sorting;
+};
+
+/**
+ * Describes the message altalune.v1.UpdateSavedViewRequest.
+ * Use `create(UpdateSavedViewRequestSchema)` to create a new message.
+ */
+export const UpdateSavedViewRequestSchema: GenMessage<UpdateSavedViewRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_saved_view, 5);
+
+/**
+ * @generated from message altalune.v1.UpdateSavedViewResponse
+ */
+export type UpdateSavedViewResponse = Message<"altalune.v1.UpdateSavedViewResponse"> & {
+  /**
+   * @generated from field: altalune.v1.SavedView view = 1;
+   */
+  view?: SavedView;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.UpdateSavedViewResponse.
+ * Use `create(UpdateSavedViewResponseSchema)` to create a new message.
+ */
+export const UpdateSavedViewResponseSchema: GenMessage<UpdateSavedViewResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_saved_view, 6);
+
+/**
+ * @generated from message altalune.v1.DeleteSavedViewRequest
+ */
+export type DeleteSavedViewRequest = Message<"altalune.v1.DeleteSavedViewRequest"> & {
+  /**
+   * @generated from field: string view_id = 1;
+   */
+  viewId: string;
+};
+
+/**
+ * Describes the message altalune.v1.DeleteSavedViewRequest.
+ * Use `create(DeleteSavedViewRequestSchema)` to create a new message.
+ */
+export const DeleteSavedViewRequestSchema: GenMessage<DeleteSavedViewRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_saved_view, 7);
+
+/**
+ * @generated from message altalune.v1.DeleteSavedViewResponse
+ */
+export type DeleteSavedViewResponse = Message<"altalune.v1.DeleteSavedViewResponse"> & {
+  /**
+   * @generated from field: string message = 1;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.DeleteSavedViewResponse.
+ * Use `create(DeleteSavedViewResponseSchema)` to create a new message.
+ */
+export const DeleteSavedViewResponseSchema: GenMessage<DeleteSavedViewResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_saved_view, 8);
+
+/**
+ * SavedViewResource - Query endpoint a saved view applies to
+ *
+ * @generated from enum altalune.v1.SavedViewResource
+ */
+export enum SavedViewResource {
+  /**
+   * @generated from enum value: SAVED_VIEW_RESOURCE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * SAVED_VIEW_RESOURCE_API_KEYS - QueryApiKeys, scoped to a project
+   *
+   * @generated from enum value: SAVED_VIEW_RESOURCE_API_KEYS = 1;
+   */
+  API_KEYS = 1,
+
+  /**
+   * SAVED_VIEW_RESOURCE_USERS - QueryUsers, global
+   *
+   * @generated from enum value: SAVED_VIEW_RESOURCE_USERS = 2;
+   */
+  USERS = 2,
+
+  /**
+   * SAVED_VIEW_RESOURCE_EMPLOYEES - QueryEmployees, scoped to a project
+   *
+   * @generated from enum value: SAVED_VIEW_RESOURCE_EMPLOYEES = 3;
+   */
+  EMPLOYEES = 3,
+}
+
+/**
+ * Describes the enum altalune.v1.SavedViewResource.
+ */
+export const SavedViewResourceSchema: GenEnum<SavedViewResource> = /*@__PURE__*/
+  enumDesc(file_altalune_v1_saved_view, 0);
+
+/**
+ * Saved View Service - Manage the named filters of the Query endpoints
+ * Views are private to the user who saved them. Listing and saving the views
+ * of a table needs the read permission of its resource, and project
+ * membership for project-scoped resources.
+ *
+ * @generated from service altalune.v1.SavedViewService
+ */
+export const SavedViewService: GenService<{
+  /**
+   * @generated from rpc altalune.v1.SavedViewService.ListSavedViews
+   */
+  listSavedViews: {
+    methodKind: "unary";
+    input: typeof ListSavedViewsRequestSchema;
+    output: typeof ListSavedViewsResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.SavedViewService.CreateSavedView
+   */
+  createSavedView: {
+    methodKind: "unary";
+    input: typeof CreateSavedViewRequestSchema;
+    output: typeof CreateSavedViewResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.SavedViewService.UpdateSavedView
+   */
+  updateSavedView: {
+    methodKind: "unary";
+    input: typeof UpdateSavedViewRequestSchema;
+    output: typeof UpdateSavedViewResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.SavedViewService.DeleteSavedView
+   */
+  deleteSavedView: {
+    methodKind: "unary";
+    input: typeof DeleteSavedViewRequestSchema;
+    output: typeof DeleteSavedViewResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_altalune_v1_saved_view, 0);
+
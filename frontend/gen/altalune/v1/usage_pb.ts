@@ -0,0 +1,244 @@
+// @generated by protoc-gen-es v2.6.3 with parameter "target=ts,import_extension=js"
+// @generated from file altalune/v1/usage.proto (package altalune.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import { file_buf_validate_validate } from "../../buf/validate/validate_pb.js";
+import { file_altalune_v1_options } from "./options_pb.js";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file altalune/v1/usage.proto.
+ */
+export const file_altalune_v1_usage: GenFile = /*@__PURE__*/
+  fileDesc("ChdhbHRhbHVuZS92MS91c2FnZS5wcm90bxILYWx0YWx1bmUudjEiWwoLVXNhZ2VCdWNrZXQSKAoEaG91chgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEAoIcmVxdWVzdHMYAiABKAMSEAoIcmVqZWN0ZWQYAyABKAMiWQoLQ2xpZW50VXNhZ2USEQoJY2xpZW50X2lkGAEgASgJEhMKC2NsaWVudF9uYW1lGAIgASgJEhAKCHJlcXVlc3RzGAMgASgDEhAKCHJlamVjdGVkGAQgASgDIk0KD0dldFVzYWdlUmVxdWVzdBIfCgpwcm9qZWN0X2lkGAEgASgJQgu6SAjIAQFyA5gBDhIZCgVob3VycxgCIAEoBUIKukgHGgUY6AUoACLdAQoQR2V0VXNhZ2VSZXNwb25zZRIpCgdidWNrZXRzGAEgAygLMhguYWx0YWx1bmUudjEuVXNhZ2VCdWNrZXQSKQoHY2xpZW50cxgCIAMoCzIYLmFsdGFsdW5lLnYxLkNsaWVudFVzYWdlEhYKDm1vbnRoX3JlcXVlc3RzGAMgASgDEhUKDW1vbnRobHlfcXVvdGEYBCABKAMSMwoPcXVvdGFfcmVzZXRzX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIPCgdtZXNzYWdlGAYgASgJIloKF1VwZGF0ZVVzYWdlUXVvdGFSZXF1ZXN0Eh8KCnByb2plY3RfaWQYASABKAlCC7pICMgBAXIDmAEOEh4KDW1vbnRobHlfcXVvdGEYAiABKANCB7pIBCICKAAiQgoYVXBkYXRlVXNhZ2VRdW90YVJlc3BvbnNlEhUKDW1vbnRobHlfcXVvdGEYASABKAMSDwoHbWVzc2FnZRgCIAEoCTLbAQoMVXNhZ2VTZXJ2aWNlElkKCEdldFVzYWdlEhwuYWx0YWx1bmUudjEuR2V0VXNhZ2VSZXF1ZXN0Gh0uYWx0YWx1bmUudjEuR2V0VXNhZ2VSZXNwb25zZSIQirUYDHByb2plY3Q6cmVhZBJwChBVcGRhdGVVc2FnZVF1b3RhEiQuYWx0YWx1bmUudjEuVXBkYXRlVXNhZ2VRdW90YVJlcXVlc3QaJS5hbHRhbHVuZS52MS5VcGRhdGVVc2FnZVF1b3RhUmVzcG9uc2UiD4q1GAt1c2FnZTp3cml0ZUKfAQoPY29tLmFsdGFsdW5lLnYxQgpVc2FnZVByb3RvUAFaM2dpdGh1Yi5jb20vaHJ6OC9hbHRhbHVuZS9nZW4vYWx0YWx1bmUvdjE7YWx0YWx1bmV2MaICA0FYWKoCC0FsdGFsdW5lLlYxygILQWx0YWx1bmVcVjHiAhdBbHRhbHVuZVxWMVxHUEJNZXRhZGF0YeoCDEFsdGFsdW5lOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp, file_buf_validate_validate, file_altalune_v1_options]);
+
+/**
+ * API calls of a project in an hour
+ *
+ * @generated from message altalune.v1.UsageBucket
+ */
+export type UsageBucket = Message<"altalune.v1.UsageBucket"> & {
+  /**
+   * Start of the hour
+   *
+   * @generated from field: google.protobuf.Timestamp hour = 1;
+   */
+  hour?: Timestamp;
+
+  /**
+   * Calls served
+   *
+   * @generated from field: int64 requests = 2;
+   */
+  requests: bigint;
+
+  /**
+   * Calls refused over the monthly quota
+   *
+   * @generated from field: int64 rejected = 3;
+   */
+  rejected: bigint;
+};
+
+/**
+ * Describes the message altalune.v1.UsageBucket.
+ * Use `create(UsageBucketSchema)` to create a new message.
+ */
+export const UsageBucketSchema: GenMessage<UsageBucket> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_usage, 0);
+
+/**
+ * API calls of a project made with the tokens of an OAuth client
+ *
+ * @generated from message altalune.v1.ClientUsage
+ */
+export type ClientUsage = Message<"altalune.v1.ClientUsage"> & {
+  /**
+   * OAuth client ID, empty for the calls without one
+   *
+   * @generated from field: string client_id = 1;
+   */
+  clientId: string;
+
+  /**
+   * Empty when the client was deleted
+   *
+   * @generated from field: string client_name = 2;
+   */
+  clientName: string;
+
+  /**
+   * @generated from field: int64 requests = 3;
+   */
+  requests: bigint;
+
+  /**
+   * @generated from field: int64 rejected = 4;
+   */
+  rejected: bigint;
+};
+
+/**
+ * Describes the message altalune.v1.ClientUsage.
+ * Use `create(ClientUsageSchema)` to create a new message.
+ */
+export const ClientUsageSchema: GenMessage<ClientUsage> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_usage, 1);
+
+/**
+ * Get Usage Request, for charting the API calls of a project over the last
+ * hours
+ *
+ * @generated from message altalune.v1.GetUsageRequest
+ */
+export type GetUsageRequest = Message<"altalune.v1.GetUsageRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * Defaults to 24
+   *
+   * @generated from field: int32 hours = 2;
+   */
+  hours: number;
+};
+
+/**
+ * Describes the message altalune.v1.GetUsageRequest.
+ * Use `create(GetUsageRequestSchema)` to create a new message.
+ */
+export const GetUsageRequestSchema: GenMessage<GetUsageRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_usage, 2);
+
+/**
+ * @generated from message altalune.v1.GetUsageResponse
+ */
+export type GetUsageResponse = Message<"altalune.v1.GetUsageResponse"> & {
+  /**
+   * One per hour, oldest first, the current hour last
+   *
+   * @generated from field: repeated altalune.v1.UsageBucket buckets = 1;
+   */
+  buckets: UsageBucket[];
+
+  /**
+   * Totals over the hours by client, busiest first
+   *
+   * @generated from field: repeated altalune.v1.ClientUsage clients = 2;
+   */
+  clients: ClientUsage[];
+
+  /**
+   * Calls served in the current month (UTC)
+   *
+   * @generated from field: int64 month_requests = 3;
+   */
+  monthRequests: bigint;
+
+  /**
+   * Calls allowed a month, 0 for no quota
+   *
+   * @generated from field: int64 monthly_quota = 4;
+   */
+  monthlyQuota: bigint;
+
+  /**
+   * Start of the next month (UTC)
+   *
+   * @generated from field: google.protobuf.Timestamp quota_resets_at = 5;
+   */
+  quotaResetsAt?: Timestamp;
+
+  /**
+   * @generated from field: string message = 6;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.GetUsageResponse.
+ * Use `create(GetUsageResponseSchema)` to create a new message.
+ */
+export const GetUsageResponseSchema: GenMessage<GetUsageResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_usage, 3);
+
+/**
+ * @generated from message altalune.v1.UpdateUsageQuotaRequest
+ */
+export type UpdateUsageQuotaRequest = Message<"altalune.v1.UpdateUsageQuotaRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * 0 removes the quota
+   *
+   * @generated from field: int64 monthly_quota = 2;
+   */
+  monthlyQuota: bigint;
+};
+
+/**
+ * Describes the message altalune.v1.UpdateUsageQuotaRequest.
+ * Use `create(UpdateUsageQuotaRequestSchema)` to create a new message.
+ */
+export const UpdateUsageQuotaRequestSchema: GenMessage<UpdateUsageQuotaRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_usage, 4);
+
+/**
+ * @generated from message altalune.v1.UpdateUsageQuotaResponse
+ */
+export type UpdateUsageQuotaResponse = Message<"altalune.v1.UpdateUsageQuotaResponse"> & {
+  /**
+   * @generated from field: int64 monthly_quota = 1;
+   */
+  monthlyQuota: bigint;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.UpdateUsageQuotaResponse.
+ * Use `create(UpdateUsageQuotaResponseSchema)` to create a new message.
+ */
+export const UpdateUsageQuotaResponseSchema: GenMessage<UpdateUsageQuotaResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_usage, 5);
+
+/**
+ * Usage Service - API calls of a project and its monthly quota
+ *
+ * @generated from service altalune.v1.UsageService
+ */
+export const UsageService: GenService<{
+  /**
+   * @generated from rpc altalune.v1.UsageService.GetUsage
+   */
+  getUsage: {
+    methodKind: "unary";
+    input: typeof GetUsageRequestSchema;
+    output: typeof GetUsageResponseSchema;
+  },
+  /**
+   * Quotas are set by the operators of the deployment, not by the members of
+   * the project
+   *
+   * @generated from rpc altalune.v1.UsageService.UpdateUsageQuota
+   */
+  updateUsageQuota: {
+    methodKind: "unary";
+    input: typeof UpdateUsageQuotaRequestSchema;
+    output: typeof UpdateUsageQuotaResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_altalune_v1_usage, 0);
+
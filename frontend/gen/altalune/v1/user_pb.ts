@@ -2,20 +2,21 @@
 // @generated from file altalune/v1/user.proto (package altalune.v1, syntax proto3)
 /* eslint-disable */
 
-import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
-import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
-import type { Timestamp } from "@bufbuild/protobuf/wkt";
-import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { FieldMask, Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_field_mask, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import { file_buf_validate_validate } from "../../buf/validate/validate_pb.js";
 import type { QueryMetaResponse, QueryRequest } from "./common_pb.js";
 import { file_altalune_v1_common } from "./common_pb.js";
+import { file_altalune_v1_options } from "./options_pb.js";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file altalune/v1/user.proto.
  */
 export const file_altalune_v1_user: GenFile = /*@__PURE__*/
-  fileDesc("ChZhbHRhbHVuZS92MS91c2VyLnByb3RvEgthbHRhbHVuZS52MSLTAQoEVXNlchIKCgJpZBgBIAEoCRINCgVlbWFpbBgCIAEoCRISCgpmaXJzdF9uYW1lGAMgASgJEhEKCWxhc3RfbmFtZRgEIAEoCRIRCglpc19hY3RpdmUYBSABKAgSFgoOZW1haWxfdmVyaWZpZWQYBiABKAgSLgoKY3JlYXRlZF9hdBhiIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBhjIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiowMKDFVzZXJJZGVudGl0eRIRCglwdWJsaWNfaWQYASABKAkSEAoIcHJvdmlkZXIYAiABKAkSGAoQcHJvdmlkZXJfdXNlcl9pZBgDIAEoCRINCgVlbWFpbBgEIAEoCRISCgpmaXJzdF9uYW1lGAUgASgJEhEKCWxhc3RfbmFtZRgGIAEoCRIcCg9vYXV0aF9jbGllbnRfaWQYByABKAlIAIgBARIlChhvcmlnaW5fb2F1dGhfY2xpZW50X25hbWUYCCABKAlIAYgBARI2Cg1sYXN0X2xvZ2luX2F0GAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgCiAEBEi4KCmNyZWF0ZWRfYXQYYiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYYyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQhIKEF9vYXV0aF9jbGllbnRfaWRCGwoZX29yaWdpbl9vYXV0aF9jbGllbnRfbmFtZUIQCg5fbGFzdF9sb2dpbl9hdCI9ChFRdWVyeVVzZXJzUmVxdWVzdBIoCgVxdWVyeRgBIAEoCzIZLmFsdGFsdW5lLnYxLlF1ZXJ5UmVxdWVzdCJjChJRdWVyeVVzZXJzUmVzcG9uc2USHwoEZGF0YRgBIAMoCzIRLmFsdGFsdW5lLnYxLlVzZXISLAoEbWV0YRgCIAEoCzIeLmFsdGFsdW5lLnYxLlF1ZXJ5TWV0YVJlc3BvbnNlIm4KEUNyZWF0ZVVzZXJSZXF1ZXN0EhwKBWVtYWlsGAEgASgJQg26SArIAQFyBRj/AWABEh0KCmZpcnN0X25hbWUYAiABKAlCCbpIBnIEEAEYZBIcCglsYXN0X25hbWUYAyABKAlCCbpIBnIEEAEYZCJGChJDcmVhdGVVc2VyUmVzcG9uc2USHwoEdXNlchgBIAEoCzIRLmFsdGFsdW5lLnYxLlVzZXISDwoHbWVzc2FnZRgCIAEoCSIqCg5HZXRVc2VyUmVxdWVzdBIYCgJpZBgBIAEoCUIMukgJyAEBcgQQDhgUImEKD0dldFVzZXJSZXNwb25zZRIfCgR1c2VyGAEgASgLMhEuYWx0YWx1bmUudjEuVXNlchItCgppZGVudGl0aWVzGAIgAygLMhkuYWx0YWx1bmUudjEuVXNlcklkZW50aXR5IogBChFVcGRhdGVVc2VyUmVxdWVzdBIYCgJpZBgBIAEoCUIMukgJyAEBcgQQDhgUEhwKBWVtYWlsGAIgASgJQg26SArIAQFyBRj/AWABEh0KCmZpcnN0X25hbWUYAyABKAlCCbpIBnIEEAEYZBIcCglsYXN0X25hbWUYBCABKAlCCbpIBnIEEAEYZCJGChJVcGRhdGVVc2VyUmVzcG9uc2USHwoEdXNlchgBIAEoCzIRLmFsdGFsdW5lLnYxLlVzZXISDwoHbWVzc2FnZRgCIAEoCSItChFEZWxldGVVc2VyUmVxdWVzdBIYCgJpZBgBIAEoCUIMukgJyAEBcgQQDhgUIiUKEkRlbGV0ZVVzZXJSZXNwb25zZRIPCgdtZXNzYWdlGAEgASgJIi8KE0FjdGl2YXRlVXNlclJlcXVlc3QSGAoCaWQYASABKAlCDLpICcgBAXIEEA4YFCJIChRBY3RpdmF0ZVVzZXJSZXNwb25zZRIfCgR1c2VyGAEgASgLMhEuYWx0YWx1bmUudjEuVXNlchIPCgdtZXNzYWdlGAIgASgJIjEKFURlYWN0aXZhdGVVc2VyUmVxdWVzdBIYCgJpZBgBIAEoCUIMukgJyAEBcgQQDhgUIkoKFkRlYWN0aXZhdGVVc2VyUmVzcG9uc2USHwoEdXNlchgBIAEoCzIRLmFsdGFsdW5lLnYxLlVzZXISDwoHbWVzc2FnZRgCIAEoCTLNBAoLVXNlclNlcnZpY2USTwoKUXVlcnlVc2VycxIeLmFsdGFsdW5lLnYxLlF1ZXJ5VXNlcnNSZXF1ZXN0Gh8uYWx0YWx1bmUudjEuUXVlcnlVc2Vyc1Jlc3BvbnNlIgASTwoKQ3JlYXRlVXNlchIeLmFsdGFsdW5lLnYxLkNyZWF0ZVVzZXJSZXF1ZXN0Gh8uYWx0YWx1bmUudjEuQ3JlYXRlVXNlclJlc3BvbnNlIgASRgoHR2V0VXNlchIbLmFsdGFsdW5lLnYxLkdldFVzZXJSZXF1ZXN0GhwuYWx0YWx1bmUudjEuR2V0VXNlclJlc3BvbnNlIgASTwoKVXBkYXRlVXNlchIeLmFsdGFsdW5lLnYxLlVwZGF0ZVVzZXJSZXF1ZXN0Gh8uYWx0YWx1bmUudjEuVXBkYXRlVXNlclJlc3BvbnNlIgASTwoKRGVsZXRlVXNlchIeLmFsdGFsdW5lLnYxLkRlbGV0ZVVzZXJSZXF1ZXN0Gh8uYWx0YWx1bmUudjEuRGVsZXRlVXNlclJlc3BvbnNlIgASVQoMQWN0aXZhdGVVc2VyEiAuYWx0YWx1bmUudjEuQWN0aXZhdGVVc2VyUmVxdWVzdBohLmFsdGFsdW5lLnYxLkFjdGl2YXRlVXNlclJlc3BvbnNlIgASWwoORGVhY3RpdmF0ZVVzZXISIi5hbHRhbHVuZS52MS5EZWFjdGl2YXRlVXNlclJlcXVlc3QaIy5hbHRhbHVuZS52MS5EZWFjdGl2YXRlVXNlclJlc3BvbnNlIgBCngEKD2NvbS5hbHRhbHVuZS52MUIJVXNlclByb3RvUAFaM2dpdGh1Yi5jb20vaHJ6OC9hbHRhbHVuZS9nZW4vYWx0YWx1bmUvdjE7YWx0YWx1bmV2MaICA0FYWKoCC0FsdGFsdW5lLlYxygILQWx0YWx1bmVcVjHiAhdBbHRhbHVuZVxWMVxHUEJNZXRhZGF0YeoCDEFsdGFsdW5lOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp, file_buf_validate_validate, file_altalune_v1_common]);
+  fileDesc("ChZhbHRhbHVuZS92MS91c2VyLnByb3RvEgthbHRhbHVuZS52MSLaAgoEVXNlchIKCgJpZBgBIAEoCRINCgVlbWFpbBgCIAEoCRISCgpmaXJzdF9uYW1lGAMgASgJEhEKCWxhc3RfbmFtZRgEIAEoCRIRCglpc19hY3RpdmUYBSABKAgSFgoOZW1haWxfdmVyaWZpZWQYBiABKAgSLgoKZGVsZXRlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMAoMbG9ja2VkX3VudGlsGAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIjCgR0eXBlGAkgASgOMhUuYWx0YWx1bmUudjEuVXNlclR5cGUSLgoKY3JlYXRlZF9hdBhiIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBhjIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiowMKDFVzZXJJZGVudGl0eRIRCglwdWJsaWNfaWQYASABKAkSEAoIcHJvdmlkZXIYAiABKAkSGAoQcHJvdmlkZXJfdXNlcl9pZBgDIAEoCRINCgVlbWFpbBgEIAEoCRISCgpmaXJzdF9uYW1lGAUgASgJEhEKCWxhc3RfbmFtZRgGIAEoCRIcCg9vYXV0aF9jbGllbnRfaWQYByABKAlIAIgBARIlChhvcmlnaW5fb2F1dGhfY2xpZW50X25hbWUYCCABKAlIAYgBARI2Cg1sYXN0X2xvZ2luX2F0GAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgCiAEBEi4KCmNyZWF0ZWRfYXQYYiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYYyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQhIKEF9vYXV0aF9jbGllbnRfaWRCGwoZX29yaWdpbl9vYXV0aF9jbGllbnRfbmFtZUIQCg5fbGFzdF9sb2dpbl9hdCJ9ChFRdWVyeVVzZXJzUmVxdWVzdBIoCgVxdWVyeRgBIAEoCzIZLmFsdGFsdW5lLnYxLlF1ZXJ5UmVxdWVzdBIPCgd0cmFzaGVkGAIgASgIEi0KCXJlYWRfbWFzaxgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2siYwoSUXVlcnlVc2Vyc1Jlc3BvbnNlEh8KBGRhdGEYASADKAsyES5hbHRhbHVuZS52MS5Vc2VyEiwKBG1ldGEYAiABKAsyHi5hbHRhbHVuZS52MS5RdWVyeU1ldGFSZXNwb25zZSJ1ChJTdHJlYW1Vc2Vyc1JlcXVlc3QSMAoFcXVlcnkYASABKAsyGS5hbHRhbHVuZS52MS5RdWVyeVJlcXVlc3RCBrpIA8gBARItCglyZWFkX21hc2sYAiABKAsyGi5nb29nbGUucHJvdG9idWYuRmllbGRNYXNrImQKE1N0cmVhbVVzZXJzUmVzcG9uc2USHwoEZGF0YRgBIAMoCzIRLmFsdGFsdW5lLnYxLlVzZXISLAoEbWV0YRgCIAEoCzIeLmFsdGFsdW5lLnYxLlF1ZXJ5TWV0YVJlc3BvbnNlIm4KEUNyZWF0ZVVzZXJSZXF1ZXN0EhwKBWVtYWlsGAEgASgJQg26SArIAQFyBRj/AWABEh0KCmZpcnN0X25hbWUYAiABKAlCCbpIBnIEEAEYZBIcCglsYXN0X25hbWUYAyABKAlCCbpIBnIEEAEYZCJGChJDcmVhdGVVc2VyUmVzcG9uc2USHwoEdXNlchgBIAEoCzIRLmFsdGFsdW5lLnYxLlVzZXISDwoHbWVzc2FnZRgCIAEoCSJPChtDcmVhdGVTZXJ2aWNlQWNjb3VudFJlcXVlc3QSMAoEbmFtZRgBIAEoCUIiukgfyAEBchoQAhhkMhReW2EtekEtWjAtOVxzXC1fLl0rJCJQChxDcmVhdGVTZXJ2aWNlQWNjb3VudFJlc3BvbnNlEh8KBHVzZXIYASABKAsyES5hbHRhbHVuZS52MS5Vc2VyEg8KB21lc3NhZ2UYAiABKAkiKgoOR2V0VXNlclJlcXVlc3QSGAoCaWQYASABKAlCDLpICcgBAXIEEA4YFCJhCg9HZXRVc2VyUmVzcG9uc2USHwoEdXNlchgBIAEoCzIRLmFsdGFsdW5lLnYxLlVzZXISLQoKaWRlbnRpdGllcxgCIAMoCzIZLmFsdGFsdW5lLnYxLlVzZXJJZGVudGl0eSLBAQoRVXBkYXRlVXNlclJlcXVlc3QSGAoCaWQYASABKAlCDLpICcgBAXIEEA4YFBIcCgVlbWFpbBgCIAEoCUINukgKyAEBcgUY/wFgARIdCgpmaXJzdF9uYW1lGAMgASgJQgm6SAZyBBABGGQSHAoJbGFzdF9uYW1lGAQgASgJQgm6SAZyBBABGGQSNwoTZXhwZWN0ZWRfdXBkYXRlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiRgoSVXBkYXRlVXNlclJlc3BvbnNlEh8KBHVzZXIYASABKAsyES5hbHRhbHVuZS52MS5Vc2VyEg8KB21lc3NhZ2UYAiABKAkiLQoRRGVsZXRlVXNlclJlcXVlc3QSGAoCaWQYASABKAlCDLpICcgBAXIEEA4YFCIlChJEZWxldGVVc2VyUmVzcG9uc2USDwoHbWVzc2FnZRgBIAEoCSIuChJSZXN0b3JlVXNlclJlcXVlc3QSGAoCaWQYASABKAlCDLpICcgBAXIEEA4YFCJHChNSZXN0b3JlVXNlclJlc3BvbnNlEh8KBHVzZXIYASABKAsyES5hbHRhbHVuZS52MS5Vc2VyEg8KB21lc3NhZ2UYAiABKAkiLwoTQWN0aXZhdGVVc2VyUmVxdWVzdBIYCgJpZBgBIAEoCUIMukgJyAEBcgQQDhgUIkgKFEFjdGl2YXRlVXNlclJlc3BvbnNlEh8KBHVzZXIYASABKAsyES5hbHRhbHVuZS52MS5Vc2VyEg8KB21lc3NhZ2UYAiABKAkiMQoVRGVhY3RpdmF0ZVVzZXJSZXF1ZXN0EhgKAmlkGAEgASgJQgy6SAnIAQFyBBAOGBQiSgoWRGVhY3RpdmF0ZVVzZXJSZXNwb25zZRIfCgR1c2VyGAEgASgLMhEuYWx0YWx1bmUudjEuVXNlchIPCgdtZXNzYWdlGAIgASgJIkQKGFF1ZXJ5UGVuZGluZ1VzZXJzUmVxdWVzdBIoCgVxdWVyeRgBIAEoCzIZLmFsdGFsdW5lLnYxLlF1ZXJ5UmVxdWVzdCJqChlRdWVyeVBlbmRpbmdVc2Vyc1Jlc3BvbnNlEh8KBGRhdGEYASADKAsyES5hbHRhbHVuZS52MS5Vc2VyEiwKBG1ldGEYAiABKAsyHi5hbHRhbHVuZS52MS5RdWVyeU1ldGFSZXNwb25zZSIuChJBcHByb3ZlVXNlclJlcXVlc3QSGAoCaWQYASABKAlCDLpICcgBAXIEEA4YFCJbChNBcHByb3ZlVXNlclJlc3BvbnNlEh8KBHVzZXIYASABKAsyES5hbHRhbHVuZS52MS5Vc2VyEhIKCmVtYWlsX3NlbnQYAiABKAgSDwoHbWVzc2FnZRgDIAEoCSItChFSZWplY3RVc2VyUmVxdWVzdBIYCgJpZBgBIAEoCUIMukgJyAEBcgQQDhgUIiUKElJlamVjdFVzZXJSZXNwb25zZRIPCgdtZXNzYWdlGAEgASgJIi0KEVVubG9ja1VzZXJSZXF1ZXN0EhgKAmlkGAEgASgJQgy6SAnIAQFyBBAOGBQiRgoSVW5sb2NrVXNlclJlc3BvbnNlEh8KBHVzZXIYASABKAsyES5hbHRhbHVuZS52MS5Vc2VyEg8KB21lc3NhZ2UYAiABKAkieAoWRW1haWxWZXJpZmljYXRpb25Ub2tlbhIuCgpleHBpcmVzX2F0GAEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgpjcmVhdGVkX2F0GGIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCI+CiJMaXN0RW1haWxWZXJpZmljYXRpb25Ub2tlbnNSZXF1ZXN0EhgKAmlkGAEgASgJQgy6SAnIAQFyBBAOGBQiWgojTGlzdEVtYWlsVmVyaWZpY2F0aW9uVG9rZW5zUmVzcG9uc2USMwoGdG9rZW5zGAEgAygLMiMuYWx0YWx1bmUudjEuRW1haWxWZXJpZmljYXRpb25Ub2tlbiJECihJbnZhbGlkYXRlRW1haWxWZXJpZmljYXRpb25Ub2tlbnNSZXF1ZXN0EhgKAmlkGAEgASgJQgy6SAnIAQFyBBAOGBQiVwopSW52YWxpZGF0ZUVtYWlsVmVyaWZpY2F0aW9uVG9rZW5zUmVzcG9uc2USGQoRaW52YWxpZGF0ZWRfY291bnQYASABKAUSDwoHbWVzc2FnZRgCIAEoCSI7Ch9Gb3JjZUVtYWlsUmV2ZXJpZmljYXRpb25SZXF1ZXN0EhgKAmlkGAEgASgJQgy6SAnIAQFyBBAOGBQiaAogRm9yY2VFbWFpbFJldmVyaWZpY2F0aW9uUmVzcG9uc2USHwoEdXNlchgBIAEoCzIRLmFsdGFsdW5lLnYxLlVzZXISEgoKZW1haWxfc2VudBgCIAEoCBIPCgdtZXNzYWdlGAMgASgJIjwKIEdldEVtYWlsVmVyaWZpY2F0aW9uU3RhdHNSZXF1ZXN0EhgKBGRheXMYASABKAVCCrpIBxoFGO0CKAAiwgEKIUdldEVtYWlsVmVyaWZpY2F0aW9uU3RhdHNSZXNwb25zZRIMCgRzZW50GAEgASgDEhAKCHZlcmlmaWVkGAIgASgDEg8KB3BlbmRpbmcYAyABKAMSDwoHZXhwaXJlZBgEIAEoAxISCgpzdXBlcnNlZGVkGAUgASgDEhcKD2NvbnZlcnNpb25fcmF0ZRgGIAEoARIgChhtZWRpYW5fc2Vjb25kc190b192ZXJpZnkYByABKAMSDAoEZGF5cxgIIAEoBSpZCghVc2VyVHlwZRIZChVVU0VSX1RZUEVfVU5TUEVDSUZJRUQQABITCg9VU0VSX1RZUEVfSFVNQU4QARIdChlVU0VSX1RZUEVfU0VSVklDRV9BQ0NPVU5UEAIy0Q8KC1VzZXJTZXJ2aWNlElwKClF1ZXJ5VXNlcnMSHi5hbHRhbHVuZS52MS5RdWVyeVVzZXJzUmVxdWVzdBofLmFsdGFsdW5lLnYxLlF1ZXJ5VXNlcnNSZXNwb25zZSINirUYCXVzZXI6cmVhZBJhCgtTdHJlYW1Vc2VycxIfLmFsdGFsdW5lLnYxLlN0cmVhbVVzZXJzUmVxdWVzdBogLmFsdGFsdW5lLnYxLlN0cmVhbVVzZXJzUmVzcG9uc2UiDYq1GAl1c2VyOnJlYWQwARJdCgpDcmVhdGVVc2VyEh4uYWx0YWx1bmUudjEuQ3JlYXRlVXNlclJlcXVlc3QaHy5hbHRhbHVuZS52MS5DcmVhdGVVc2VyUmVzcG9uc2UiDoq1GAp1c2VyOndyaXRlEnsKFENyZWF0ZVNlcnZpY2VBY2NvdW50EiguYWx0YWx1bmUudjEuQ3JlYXRlU2VydmljZUFjY291bnRSZXF1ZXN0GikuYWx0YWx1bmUudjEuQ3JlYXRlU2VydmljZUFjY291bnRSZXNwb25zZSIOirUYCnVzZXI6d3JpdGUSUwoHR2V0VXNlchIbLmFsdGFsdW5lLnYxLkdldFVzZXJSZXF1ZXN0GhwuYWx0YWx1bmUudjEuR2V0VXNlclJlc3BvbnNlIg2KtRgJdXNlcjpyZWFkEl0KClVwZGF0ZVVzZXISHi5hbHRhbHVuZS52MS5VcGRhdGVVc2VyUmVxdWVzdBofLmFsdGFsdW5lLnYxLlVwZGF0ZVVzZXJSZXNwb25zZSIOirUYCnVzZXI6d3JpdGUSXgoKRGVsZXRlVXNlchIeLmFsdGFsdW5lLnYxLkRlbGV0ZVVzZXJSZXF1ZXN0Gh8uYWx0YWx1bmUudjEuRGVsZXRlVXNlclJlc3BvbnNlIg+KtRgLdXNlcjpkZWxldGUSYQoLUmVzdG9yZVVzZXISHy5hbHRhbHVuZS52MS5SZXN0b3JlVXNlclJlcXVlc3QaIC5hbHRhbHVuZS52MS5SZXN0b3JlVXNlclJlc3BvbnNlIg+KtRgLdXNlcjpkZWxldGUSYwoMQWN0aXZhdGVVc2VyEiAuYWx0YWx1bmUudjEuQWN0aXZhdGVVc2VyUmVxdWVzdBohLmFsdGFsdW5lLnYxLkFjdGl2YXRlVXNlclJlc3BvbnNlIg6KtRgKdXNlcjp3cml0ZRJpCg5EZWFjdGl2YXRlVXNlchIiLmFsdGFsdW5lLnYxLkRlYWN0aXZhdGVVc2VyUmVxdWVzdBojLmFsdGFsdW5lLnYxLkRlYWN0aXZhdGVVc2VyUmVzcG9uc2UiDoq1GAp1c2VyOndyaXRlEnEKEVF1ZXJ5UGVuZGluZ1VzZXJzEiUuYWx0YWx1bmUudjEuUXVlcnlQZW5kaW5nVXNlcnNSZXF1ZXN0GiYuYWx0YWx1bmUudjEuUXVlcnlQZW5kaW5nVXNlcnNSZXNwb25zZSINirUYCXVzZXI6cmVhZBJgCgtBcHByb3ZlVXNlchIfLmFsdGFsdW5lLnYxLkFwcHJvdmVVc2VyUmVxdWVzdBogLmFsdGFsdW5lLnYxLkFwcHJvdmVVc2VyUmVzcG9uc2UiDoq1GAp1c2VyOndyaXRlEl0KClJlamVjdFVzZXISHi5hbHRhbHVuZS52MS5SZWplY3RVc2VyUmVxdWVzdBofLmFsdGFsdW5lLnYxLlJlamVjdFVzZXJSZXNwb25zZSIOirUYCnVzZXI6d3JpdGUSXQoKVW5sb2NrVXNlchIeLmFsdGFsdW5lLnYxLlVubG9ja1VzZXJSZXF1ZXN0Gh8uYWx0YWx1bmUudjEuVW5sb2NrVXNlclJlc3BvbnNlIg6KtRgKdXNlcjp3cml0ZRKPAQobTGlzdEVtYWlsVmVyaWZpY2F0aW9uVG9rZW5zEi8uYWx0YWx1bmUudjEuTGlzdEVtYWlsVmVyaWZpY2F0aW9uVG9rZW5zUmVxdWVzdBowLmFsdGFsdW5lLnYxLkxpc3RFbWFpbFZlcmlmaWNhdGlvblRva2Vuc1Jlc3BvbnNlIg2KtRgJdXNlcjpyZWFkEqIBCiFJbnZhbGlkYXRlRW1haWxWZXJpZmljYXRpb25Ub2tlbnMSNS5hbHRhbHVuZS52MS5JbnZhbGlkYXRlRW1haWxWZXJpZmljYXRpb25Ub2tlbnNSZXF1ZXN0GjYuYWx0YWx1bmUudjEuSW52YWxpZGF0ZUVtYWlsVmVyaWZpY2F0aW9uVG9rZW5zUmVzcG9uc2UiDoq1GAp1c2VyOndyaXRlEocBChhGb3JjZUVtYWlsUmV2ZXJpZmljYXRpb24SLC5hbHRhbHVuZS52MS5Gb3JjZUVtYWlsUmV2ZXJpZmljYXRpb25SZXF1ZXN0Gi0uYWx0YWx1bmUudjEuRm9yY2VFbWFpbFJldmVyaWZpY2F0aW9uUmVzcG9uc2UiDoq1GAp1c2VyOndyaXRlEokBChlHZXRFbWFpbFZlcmlmaWNhdGlvblN0YXRzEi0uYWx0YWx1bmUudjEuR2V0RW1haWxWZXJpZmljYXRpb25TdGF0c1JlcXVlc3QaLi5hbHRhbHVuZS52MS5HZXRFbWFpbFZlcmlmaWNhdGlvblN0YXRzUmVzcG9uc2UiDYq1GAl1c2VyOnJlYWRCngEKD2NvbS5hbHRhbHVuZS52MUIJVXNlclByb3RvUAFaM2dpdGh1Yi5jb20vaHJ6OC9hbHRhbHVuZS9nZW4vYWx0YWx1bmUvdjE7YWx0YWx1bmV2MaICA0FYWKoCC0FsdGFsdW5lLlYxygILQWx0YWx1bmVcVjHiAhdBbHRhbHVuZVxWMVxHUEJNZXRhZGF0YeoCDEFsdGFsdW5lOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp, file_google_protobuf_field_mask, file_buf_validate_validate, file_altalune_v1_common, file_altalune_v1_options]);
 
 /**
  * User represents a global system user with OAuth-only authentication
@@ -31,7 +32,7 @@ export type User = Message<"altalune.v1.User"> & {
   id: string;
 
   /**
-   * Unique, case-insensitive
+   * Unique, case-insensitive; empty for service accounts
    *
    * @generated from field: string email = 2;
    */
@@ -65,6 +66,25 @@ export type User = Message<"altalune.v1.User"> & {
    */
   emailVerified: boolean;
 
+  /**
+   * Set only for users in the trash
+   *
+   * @generated from field: google.protobuf.Timestamp deleted_at = 7;
+   */
+  deletedAt?: Timestamp;
+
+  /**
+   * Set only while locked after too many failed sign-ins
+   *
+   * @generated from field: google.protobuf.Timestamp locked_until = 8;
+   */
+  lockedUntil?: Timestamp;
+
+  /**
+   * @generated from field: altalune.v1.UserType type = 9;
+   */
+  type: UserType;
+
   /**
    * @generated from field: google.protobuf.Timestamp created_at = 98;
    */
@@ -178,6 +198,20 @@ export type QueryUsersRequest = Message<"altalune.v1.QueryUsersRequest"> & {
    * @generated from field: altalune.v1.QueryRequest query = 1;
    */
   query?: QueryRequest;
+
+  /**
+   * List deleted users instead of live ones
+   *
+   * @generated from field: bool trashed = 2;
+   */
+  trashed: boolean;
+
+  /**
+   * User fields to return, all when empty; top-level only
+   *
+   * @generated from field: google.protobuf.FieldMask read_mask = 3;
+   */
+  readMask?: FieldMask;
 };
 
 /**
@@ -211,6 +245,60 @@ export type QueryUsersResponse = Message<"altalune.v1.QueryUsersResponse"> & {
 export const QueryUsersResponseSchema: GenMessage<QueryUsersResponse> = /*@__PURE__*/
   messageDesc(file_altalune_v1_user, 3);
 
+/**
+ * StreamUsersRequest streams every user matching the query instead of a
+ * single page. Each message carries up to pagination.page_size rows, starting
+ * after the row of the query cursor if any, at pagination.page otherwise;
+ * meta is only set on the first message. Users come in the order of the
+ * sorting of the query.
+ *
+ * @generated from message altalune.v1.StreamUsersRequest
+ */
+export type StreamUsersRequest = Message<"altalune.v1.StreamUsersRequest"> & {
+  /**
+   * @generated from field: altalune.v1.QueryRequest query = 1;
+   */
+  query?: QueryRequest;
+
+  /**
+   * User fields to return, all when empty; top-level only
+   *
+   * @generated from field: google.protobuf.FieldMask read_mask = 2;
+   */
+  readMask?: FieldMask;
+};
+
+/**
+ * Describes the message altalune.v1.StreamUsersRequest.
+ * Use `create(StreamUsersRequestSchema)` to create a new message.
+ */
+export const StreamUsersRequestSchema: GenMessage<StreamUsersRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_user, 4);
+
+/**
+ * StreamUsersResponse with a page of users
+ *
+ * @generated from message altalune.v1.StreamUsersResponse
+ */
+export type StreamUsersResponse = Message<"altalune.v1.StreamUsersResponse"> & {
+  /**
+   * @generated from field: repeated altalune.v1.User data = 1;
+   */
+  data: User[];
+
+  /**
+   * @generated from field: altalune.v1.QueryMetaResponse meta = 2;
+   */
+  meta?: QueryMetaResponse;
+};
+
+/**
+ * Describes the message altalune.v1.StreamUsersResponse.
+ * Use `create(StreamUsersResponseSchema)` to create a new message.
+ */
+export const StreamUsersResponseSchema: GenMessage<StreamUsersResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_user, 5);
+
 /**
  * CreateUserRequest for creating a new user
  *
@@ -238,7 +326,7 @@ export type CreateUserRequest = Message<"altalune.v1.CreateUserRequest"> & {
  * Use `create(CreateUserRequestSchema)` to create a new message.
  */
 export const CreateUserRequestSchema: GenMessage<CreateUserRequest> = /*@__PURE__*/
-  messageDesc(file_altalune_v1_user, 4);
+  messageDesc(file_altalune_v1_user, 6);
 
 /**
  * CreateUserResponse with created user
@@ -262,7 +350,51 @@ export type CreateUserResponse = Message<"altalune.v1.CreateUserResponse"> & {
  * Use `create(CreateUserResponseSchema)` to create a new message.
  */
 export const CreateUserResponseSchema: GenMessage<CreateUserResponse> = /*@__PURE__*/
-  messageDesc(file_altalune_v1_user, 5);
+  messageDesc(file_altalune_v1_user, 7);
+
+/**
+ * CreateServiceAccountRequest for creating a service account. The name is
+ * stored as its first name.
+ *
+ * @generated from message altalune.v1.CreateServiceAccountRequest
+ */
+export type CreateServiceAccountRequest = Message<"altalune.v1.CreateServiceAccountRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message altalune.v1.CreateServiceAccountRequest.
+ * Use `create(CreateServiceAccountRequestSchema)` to create a new message.
+ */
+export const CreateServiceAccountRequestSchema: GenMessage<CreateServiceAccountRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_user, 8);
+
+/**
+ * CreateServiceAccountResponse with the created service account
+ *
+ * @generated from message altalune.v1.CreateServiceAccountResponse
+ */
+export type CreateServiceAccountResponse = Message<"altalune.v1.CreateServiceAccountResponse"> & {
+  /**
+   * @generated from field: altalune.v1.User user = 1;
+   */
+  user?: User;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.CreateServiceAccountResponse.
+ * Use `create(CreateServiceAccountResponseSchema)` to create a new message.
+ */
+export const CreateServiceAccountResponseSchema: GenMessage<CreateServiceAccountResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_user, 9);
 
 /**
  * GetUserRequest for retrieving a single user
@@ -281,7 +413,7 @@ export type GetUserRequest = Message<"altalune.v1.GetUserRequest"> & {
  * Use `create(GetUserRequestSchema)` to create a new message.
  */
 export const GetUserRequestSchema: GenMessage<GetUserRequest> = /*@__PURE__*/
-  messageDesc(file_altalune_v1_user, 6);
+  messageDesc(file_altalune_v1_user, 10);
 
 /**
  * GetUserResponse with user data and linked identities
@@ -305,7 +437,7 @@ export type GetUserResponse = Message<"altalune.v1.GetUserResponse"> & {
  * Use `create(GetUserResponseSchema)` to create a new message.
  */
 export const GetUserResponseSchema: GenMessage<GetUserResponse> = /*@__PURE__*/
-  messageDesc(file_altalune_v1_user, 7);
+  messageDesc(file_altalune_v1_user, 11);
 
 /**
  * UpdateUserRequest for updating user profile
@@ -332,6 +464,14 @@ export type UpdateUserRequest = Message<"altalune.v1.UpdateUserRequest"> & {
    * @generated from field: string last_name = 4;
    */
   lastName: string;
+
+  /**
+   * updated_at of the user the edit is based on. When set, the update is
+   * rejected with FAILED_PRECONDITION if the user changed since then.
+   *
+   * @generated from field: google.protobuf.Timestamp expected_updated_at = 5;
+   */
+  expectedUpdatedAt?: Timestamp;
 };
 
 /**
@@ -339,7 +479,7 @@ export type UpdateUserRequest = Message<"altalune.v1.UpdateUserRequest"> & {
  * Use `create(UpdateUserRequestSchema)` to create a new message.
  */
 export const UpdateUserRequestSchema: GenMessage<UpdateUserRequest> = /*@__PURE__*/
-  messageDesc(file_altalune_v1_user, 8);
+  messageDesc(file_altalune_v1_user, 12);
 
 /**
  * UpdateUserResponse with updated user
@@ -363,7 +503,7 @@ export type UpdateUserResponse = Message<"altalune.v1.UpdateUserResponse"> & {
  * Use `create(UpdateUserResponseSchema)` to create a new message.
  */
 export const UpdateUserResponseSchema: GenMessage<UpdateUserResponse> = /*@__PURE__*/
-  messageDesc(file_altalune_v1_user, 9);
+  messageDesc(file_altalune_v1_user, 13);
 
 /**
  * DeleteUserRequest for deleting a user
@@ -382,7 +522,7 @@ export type DeleteUserRequest = Message<"altalune.v1.DeleteUserRequest"> & {
  * Use `create(DeleteUserRequestSchema)` to create a new message.
  */
 export const DeleteUserRequestSchema: GenMessage<DeleteUserRequest> = /*@__PURE__*/
-  messageDesc(file_altalune_v1_user, 10);
+  messageDesc(file_altalune_v1_user, 14);
 
 /**
  * DeleteUserResponse with confirmation message
@@ -401,7 +541,50 @@ export type DeleteUserResponse = Message<"altalune.v1.DeleteUserResponse"> & {
  * Use `create(DeleteUserResponseSchema)` to create a new message.
  */
 export const DeleteUserResponseSchema: GenMessage<DeleteUserResponse> = /*@__PURE__*/
-  messageDesc(file_altalune_v1_user, 11);
+  messageDesc(file_altalune_v1_user, 15);
+
+/**
+ * RestoreUserRequest for restoring a deleted user from the trash
+ *
+ * @generated from message altalune.v1.RestoreUserRequest
+ */
+export type RestoreUserRequest = Message<"altalune.v1.RestoreUserRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message altalune.v1.RestoreUserRequest.
+ * Use `create(RestoreUserRequestSchema)` to create a new message.
+ */
+export const RestoreUserRequestSchema: GenMessage<RestoreUserRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_user, 16);
+
+/**
+ * RestoreUserResponse with restored user
+ *
+ * @generated from message altalune.v1.RestoreUserResponse
+ */
+export type RestoreUserResponse = Message<"altalune.v1.RestoreUserResponse"> & {
+  /**
+   * @generated from field: altalune.v1.User user = 1;
+   */
+  user?: User;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.RestoreUserResponse.
+ * Use `create(RestoreUserResponseSchema)` to create a new message.
+ */
+export const RestoreUserResponseSchema: GenMessage<RestoreUserResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_user, 17);
 
 /**
  * ActivateUserRequest for activating a user account
@@ -420,7 +603,7 @@ export type ActivateUserRequest = Message<"altalune.v1.ActivateUserRequest"> & {
  * Use `create(ActivateUserRequestSchema)` to create a new message.
  */
 export const ActivateUserRequestSchema: GenMessage<ActivateUserRequest> = /*@__PURE__*/
-  messageDesc(file_altalune_v1_user, 12);
+  messageDesc(file_altalune_v1_user, 18);
 
 /**
  * ActivateUserResponse with updated user
@@ -444,7 +627,7 @@ export type ActivateUserResponse = Message<"altalune.v1.ActivateUserResponse"> &
  * Use `create(ActivateUserResponseSchema)` to create a new message.
  */
 export const ActivateUserResponseSchema: GenMessage<ActivateUserResponse> = /*@__PURE__*/
-  messageDesc(file_altalune_v1_user, 13);
+  messageDesc(file_altalune_v1_user, 19);
 
 /**
  * DeactivateUserRequest for deactivating a user account
@@ -463,7 +646,7 @@ export type DeactivateUserRequest = Message<"altalune.v1.DeactivateUserRequest">
  * Use `create(DeactivateUserRequestSchema)` to create a new message.
  */
 export const DeactivateUserRequestSchema: GenMessage<DeactivateUserRequest> = /*@__PURE__*/
-  messageDesc(file_altalune_v1_user, 14);
+  messageDesc(file_altalune_v1_user, 20);
 
 /**
  * DeactivateUserResponse with updated user
@@ -487,7 +670,456 @@ export type DeactivateUserResponse = Message<"altalune.v1.DeactivateUserResponse
  * Use `create(DeactivateUserResponseSchema)` to create a new message.
  */
 export const DeactivateUserResponseSchema: GenMessage<DeactivateUserResponse> = /*@__PURE__*/
-  messageDesc(file_altalune_v1_user, 15);
+  messageDesc(file_altalune_v1_user, 21);
+
+/**
+ * QueryPendingUsersRequest for listing self-registered users whose
+ * registration awaits approval, oldest first by default
+ *
+ * @generated from message altalune.v1.QueryPendingUsersRequest
+ */
+export type QueryPendingUsersRequest = Message<"altalune.v1.QueryPendingUsersRequest"> & {
+  /**
+   * @generated from field: altalune.v1.QueryRequest query = 1;
+   */
+  query?: QueryRequest;
+};
+
+/**
+ * Describes the message altalune.v1.QueryPendingUsersRequest.
+ * Use `create(QueryPendingUsersRequestSchema)` to create a new message.
+ */
+export const QueryPendingUsersRequestSchema: GenMessage<QueryPendingUsersRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_user, 22);
+
+/**
+ * QueryPendingUsersResponse with pending user list and metadata
+ *
+ * @generated from message altalune.v1.QueryPendingUsersResponse
+ */
+export type QueryPendingUsersResponse = Message<"altalune.v1.QueryPendingUsersResponse"> & {
+  /**
+   * @generated from field: repeated altalune.v1.User data = 1;
+   */
+  data: User[];
+
+  /**
+   * @generated from field: altalune.v1.QueryMetaResponse meta = 2;
+   */
+  meta?: QueryMetaResponse;
+};
+
+/**
+ * Describes the message altalune.v1.QueryPendingUsersResponse.
+ * Use `create(QueryPendingUsersResponseSchema)` to create a new message.
+ */
+export const QueryPendingUsersResponseSchema: GenMessage<QueryPendingUsersResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_user, 23);
+
+/**
+ * ApproveUserRequest for approving a pending registration
+ *
+ * @generated from message altalune.v1.ApproveUserRequest
+ */
+export type ApproveUserRequest = Message<"altalune.v1.ApproveUserRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message altalune.v1.ApproveUserRequest.
+ * Use `create(ApproveUserRequestSchema)` to create a new message.
+ */
+export const ApproveUserRequestSchema: GenMessage<ApproveUserRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_user, 24);
+
+/**
+ * ApproveUserResponse with the activated user
+ *
+ * @generated from message altalune.v1.ApproveUserResponse
+ */
+export type ApproveUserResponse = Message<"altalune.v1.ApproveUserResponse"> & {
+  /**
+   * @generated from field: altalune.v1.User user = 1;
+   */
+  user?: User;
+
+  /**
+   * Whether the verification email was sent
+   *
+   * @generated from field: bool email_sent = 2;
+   */
+  emailSent: boolean;
+
+  /**
+   * @generated from field: string message = 3;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.ApproveUserResponse.
+ * Use `create(ApproveUserResponseSchema)` to create a new message.
+ */
+export const ApproveUserResponseSchema: GenMessage<ApproveUserResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_user, 25);
+
+/**
+ * RejectUserRequest for rejecting a pending registration. The user is moved
+ * to the trash and purged with it.
+ *
+ * @generated from message altalune.v1.RejectUserRequest
+ */
+export type RejectUserRequest = Message<"altalune.v1.RejectUserRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message altalune.v1.RejectUserRequest.
+ * Use `create(RejectUserRequestSchema)` to create a new message.
+ */
+export const RejectUserRequestSchema: GenMessage<RejectUserRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_user, 26);
+
+/**
+ * RejectUserResponse for rejecting a pending registration
+ *
+ * @generated from message altalune.v1.RejectUserResponse
+ */
+export type RejectUserResponse = Message<"altalune.v1.RejectUserResponse"> & {
+  /**
+   * @generated from field: string message = 1;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.RejectUserResponse.
+ * Use `create(RejectUserResponseSchema)` to create a new message.
+ */
+export const RejectUserResponseSchema: GenMessage<RejectUserResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_user, 27);
+
+/**
+ * UnlockUserRequest for lifting the lock of an account locked after too many
+ * failed sign-ins
+ *
+ * @generated from message altalune.v1.UnlockUserRequest
+ */
+export type UnlockUserRequest = Message<"altalune.v1.UnlockUserRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message altalune.v1.UnlockUserRequest.
+ * Use `create(UnlockUserRequestSchema)` to create a new message.
+ */
+export const UnlockUserRequestSchema: GenMessage<UnlockUserRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_user, 28);
+
+/**
+ * UnlockUserResponse with the unlocked user
+ *
+ * @generated from message altalune.v1.UnlockUserResponse
+ */
+export type UnlockUserResponse = Message<"altalune.v1.UnlockUserResponse"> & {
+  /**
+   * @generated from field: altalune.v1.User user = 1;
+   */
+  user?: User;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.UnlockUserResponse.
+ * Use `create(UnlockUserResponseSchema)` to create a new message.
+ */
+export const UnlockUserResponseSchema: GenMessage<UnlockUserResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_user, 29);
+
+/**
+ * EmailVerificationToken is a pending email verification link sent to a
+ * user. Only a hash of the token is stored, so the link cannot be shown.
+ *
+ * @generated from message altalune.v1.EmailVerificationToken
+ */
+export type EmailVerificationToken = Message<"altalune.v1.EmailVerificationToken"> & {
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 1;
+   */
+  expiresAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 98;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message altalune.v1.EmailVerificationToken.
+ * Use `create(EmailVerificationTokenSchema)` to create a new message.
+ */
+export const EmailVerificationTokenSchema: GenMessage<EmailVerificationToken> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_user, 30);
+
+/**
+ * ListEmailVerificationTokensRequest for listing the pending verification
+ * tokens of a user
+ *
+ * @generated from message altalune.v1.ListEmailVerificationTokensRequest
+ */
+export type ListEmailVerificationTokensRequest = Message<"altalune.v1.ListEmailVerificationTokensRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message altalune.v1.ListEmailVerificationTokensRequest.
+ * Use `create(ListEmailVerificationTokensRequestSchema)` to create a new message.
+ */
+export const ListEmailVerificationTokensRequestSchema: GenMessage<ListEmailVerificationTokensRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_user, 31);
+
+/**
+ * ListEmailVerificationTokensResponse with the unused, unexpired tokens, newest first
+ *
+ * @generated from message altalune.v1.ListEmailVerificationTokensResponse
+ */
+export type ListEmailVerificationTokensResponse = Message<"altalune.v1.ListEmailVerificationTokensResponse"> & {
+  /**
+   * @generated from field: repeated altalune.v1.EmailVerificationToken tokens = 1;
+   */
+  tokens: EmailVerificationToken[];
+};
+
+/**
+ * Describes the message altalune.v1.ListEmailVerificationTokensResponse.
+ * Use `create(ListEmailVerificationTokensResponseSchema)` to create a new message.
+ */
+export const ListEmailVerificationTokensResponseSchema: GenMessage<ListEmailVerificationTokensResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_user, 32);
+
+/**
+ * InvalidateEmailVerificationTokensRequest for invalidating every pending
+ * verification token of a user
+ *
+ * @generated from message altalune.v1.InvalidateEmailVerificationTokensRequest
+ */
+export type InvalidateEmailVerificationTokensRequest = Message<"altalune.v1.InvalidateEmailVerificationTokensRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message altalune.v1.InvalidateEmailVerificationTokensRequest.
+ * Use `create(InvalidateEmailVerificationTokensRequestSchema)` to create a new message.
+ */
+export const InvalidateEmailVerificationTokensRequestSchema: GenMessage<InvalidateEmailVerificationTokensRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_user, 33);
+
+/**
+ * InvalidateEmailVerificationTokensResponse with the number of invalidated tokens
+ *
+ * @generated from message altalune.v1.InvalidateEmailVerificationTokensResponse
+ */
+export type InvalidateEmailVerificationTokensResponse = Message<"altalune.v1.InvalidateEmailVerificationTokensResponse"> & {
+  /**
+   * @generated from field: int32 invalidated_count = 1;
+   */
+  invalidatedCount: number;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.InvalidateEmailVerificationTokensResponse.
+ * Use `create(InvalidateEmailVerificationTokensResponseSchema)` to create a new message.
+ */
+export const InvalidateEmailVerificationTokensResponseSchema: GenMessage<InvalidateEmailVerificationTokensResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_user, 34);
+
+/**
+ * ForceEmailReverificationRequest for marking the email of a user unverified
+ * and sending a new verification email
+ *
+ * @generated from message altalune.v1.ForceEmailReverificationRequest
+ */
+export type ForceEmailReverificationRequest = Message<"altalune.v1.ForceEmailReverificationRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message altalune.v1.ForceEmailReverificationRequest.
+ * Use `create(ForceEmailReverificationRequestSchema)` to create a new message.
+ */
+export const ForceEmailReverificationRequestSchema: GenMessage<ForceEmailReverificationRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_user, 35);
+
+/**
+ * ForceEmailReverificationResponse with updated user
+ *
+ * @generated from message altalune.v1.ForceEmailReverificationResponse
+ */
+export type ForceEmailReverificationResponse = Message<"altalune.v1.ForceEmailReverificationResponse"> & {
+  /**
+   * @generated from field: altalune.v1.User user = 1;
+   */
+  user?: User;
+
+  /**
+   * False when no email provider is configured
+   *
+   * @generated from field: bool email_sent = 2;
+   */
+  emailSent: boolean;
+
+  /**
+   * @generated from field: string message = 3;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message altalune.v1.ForceEmailReverificationResponse.
+ * Use `create(ForceEmailReverificationResponseSchema)` to create a new message.
+ */
+export const ForceEmailReverificationResponseSchema: GenMessage<ForceEmailReverificationResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_user, 36);
+
+/**
+ * GetEmailVerificationStatsRequest for the verification conversion over the
+ * last days
+ *
+ * @generated from message altalune.v1.GetEmailVerificationStatsRequest
+ */
+export type GetEmailVerificationStatsRequest = Message<"altalune.v1.GetEmailVerificationStatsRequest"> & {
+  /**
+   * 0 for the last 30 days
+   *
+   * @generated from field: int32 days = 1;
+   */
+  days: number;
+};
+
+/**
+ * Describes the message altalune.v1.GetEmailVerificationStatsRequest.
+ * Use `create(GetEmailVerificationStatsRequestSchema)` to create a new message.
+ */
+export const GetEmailVerificationStatsRequestSchema: GenMessage<GetEmailVerificationStatsRequest> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_user, 37);
+
+/**
+ * GetEmailVerificationStatsResponse counts the verification emails sent over
+ * the period by outcome: each is verified, pending, expired or superseded by a
+ * newer email or an administrator
+ *
+ * @generated from message altalune.v1.GetEmailVerificationStatsResponse
+ */
+export type GetEmailVerificationStatsResponse = Message<"altalune.v1.GetEmailVerificationStatsResponse"> & {
+  /**
+   * @generated from field: int64 sent = 1;
+   */
+  sent: bigint;
+
+  /**
+   * @generated from field: int64 verified = 2;
+   */
+  verified: bigint;
+
+  /**
+   * @generated from field: int64 pending = 3;
+   */
+  pending: bigint;
+
+  /**
+   * @generated from field: int64 expired = 4;
+   */
+  expired: bigint;
+
+  /**
+   * @generated from field: int64 superseded = 5;
+   */
+  superseded: bigint;
+
+  /**
+   * Verified share of sent, from 0 to 1
+   *
+   * @generated from field: double conversion_rate = 6;
+   */
+  conversionRate: number;
+
+  /**
+   * 0 when none was verified
+   *
+   * @generated from field: int64 median_seconds_to_verify = 7;
+   */
+  medianSecondsToVerify: bigint;
+
+  /**
+   * @generated from field: int32 days = 8;
+   */
+  days: number;
+};
+
+/**
+ * Describes the message altalune.v1.GetEmailVerificationStatsResponse.
+ * Use `create(GetEmailVerificationStatsResponseSchema)` to create a new message.
+ */
+export const GetEmailVerificationStatsResponseSchema: GenMessage<GetEmailVerificationStatsResponse> = /*@__PURE__*/
+  messageDesc(file_altalune_v1_user, 38);
+
+/**
+ * UserType tells human users apart from service accounts
+ *
+ * @generated from enum altalune.v1.UserType
+ */
+export enum UserType {
+  /**
+   * @generated from enum value: USER_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: USER_TYPE_HUMAN = 1;
+   */
+  HUMAN = 1,
+
+  /**
+   * Automation identity: owns API keys and holds roles and permissions, but
+   * has no email and cannot sign in interactively
+   *
+   * @generated from enum value: USER_TYPE_SERVICE_ACCOUNT = 2;
+   */
+  SERVICE_ACCOUNT = 2,
+}
+
+/**
+ * Describes the enum altalune.v1.UserType.
+ */
+export const UserTypeSchema: GenEnum<UserType> = /*@__PURE__*/
+  enumDesc(file_altalune_v1_user, 0);
 
 /**
  * UserService provides CRUD operations for user management
@@ -503,6 +1135,14 @@ export const UserService: GenService<{
     input: typeof QueryUsersRequestSchema;
     output: typeof QueryUsersResponseSchema;
   },
+  /**
+   * @generated from rpc altalune.v1.UserService.StreamUsers
+   */
+  streamUsers: {
+    methodKind: "server_streaming";
+    input: typeof StreamUsersRequestSchema;
+    output: typeof StreamUsersResponseSchema;
+  },
   /**
    * @generated from rpc altalune.v1.UserService.CreateUser
    */
@@ -511,6 +1151,14 @@ export const UserService: GenService<{
     input: typeof CreateUserRequestSchema;
     output: typeof CreateUserResponseSchema;
   },
+  /**
+   * @generated from rpc altalune.v1.UserService.CreateServiceAccount
+   */
+  createServiceAccount: {
+    methodKind: "unary";
+    input: typeof CreateServiceAccountRequestSchema;
+    output: typeof CreateServiceAccountResponseSchema;
+  },
   /**
    * @generated from rpc altalune.v1.UserService.GetUser
    */
@@ -535,6 +1183,14 @@ export const UserService: GenService<{
     input: typeof DeleteUserRequestSchema;
     output: typeof DeleteUserResponseSchema;
   },
+  /**
+   * @generated from rpc altalune.v1.UserService.RestoreUser
+   */
+  restoreUser: {
+    methodKind: "unary";
+    input: typeof RestoreUserRequestSchema;
+    output: typeof RestoreUserResponseSchema;
+  },
   /**
    * @generated from rpc altalune.v1.UserService.ActivateUser
    */
@@ -551,6 +1207,70 @@ export const UserService: GenService<{
     input: typeof DeactivateUserRequestSchema;
     output: typeof DeactivateUserResponseSchema;
   },
+  /**
+   * @generated from rpc altalune.v1.UserService.QueryPendingUsers
+   */
+  queryPendingUsers: {
+    methodKind: "unary";
+    input: typeof QueryPendingUsersRequestSchema;
+    output: typeof QueryPendingUsersResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.UserService.ApproveUser
+   */
+  approveUser: {
+    methodKind: "unary";
+    input: typeof ApproveUserRequestSchema;
+    output: typeof ApproveUserResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.UserService.RejectUser
+   */
+  rejectUser: {
+    methodKind: "unary";
+    input: typeof RejectUserRequestSchema;
+    output: typeof RejectUserResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.UserService.UnlockUser
+   */
+  unlockUser: {
+    methodKind: "unary";
+    input: typeof UnlockUserRequestSchema;
+    output: typeof UnlockUserResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.UserService.ListEmailVerificationTokens
+   */
+  listEmailVerificationTokens: {
+    methodKind: "unary";
+    input: typeof ListEmailVerificationTokensRequestSchema;
+    output: typeof ListEmailVerificationTokensResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.UserService.InvalidateEmailVerificationTokens
+   */
+  invalidateEmailVerificationTokens: {
+    methodKind: "unary";
+    input: typeof InvalidateEmailVerificationTokensRequestSchema;
+    output: typeof InvalidateEmailVerificationTokensResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.UserService.ForceEmailReverification
+   */
+  forceEmailReverification: {
+    methodKind: "unary";
+    input: typeof ForceEmailReverificationRequestSchema;
+    output: typeof ForceEmailReverificationResponseSchema;
+  },
+  /**
+   * @generated from rpc altalune.v1.UserService.GetEmailVerificationStats
+   */
+  getEmailVerificationStats: {
+    methodKind: "unary";
+    input: typeof GetEmailVerificationStatsRequestSchema;
+    output: typeof GetEmailVerificationStatsResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_altalune_v1_user, 0);
 
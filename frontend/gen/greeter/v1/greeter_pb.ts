@@ -4,16 +4,17 @@
 
 import type { GenFile, GenService } from "@bufbuild/protobuf/codegenv2";
 import { fileDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
-import type { SayHelloRequestSchema, SayHelloResponseSchema } from "./hello_pb.js";
+import type { SayHelloRequestSchema, SayHelloResponseSchema, SayHelloToManyRequestSchema, SayHelloToManyResponseSchema } from "./hello_pb.js";
 import { file_greeter_v1_hello } from "./hello_pb.js";
-import type { GetAllowedNamesRequestSchema, GetAllowedNamesResponseSchema } from "./name_pb.js";
+import type { CreateAllowedNameRequestSchema, CreateAllowedNameResponseSchema, DeleteAllowedNameRequestSchema, DeleteAllowedNameResponseSchema, GetAllowedNamesRequestSchema, GetAllowedNamesResponseSchema, UpdateAllowedNameRequestSchema, UpdateAllowedNameResponseSchema } from "./name_pb.js";
 import { file_greeter_v1_name } from "./name_pb.js";
+import { file_altalune_v1_options } from "../../altalune/v1/options_pb.js";
 
 /**
  * Describes the file greeter/v1/greeter.proto.
  */
 export const file_greeter_v1_greeter: GenFile = /*@__PURE__*/
-  fileDesc("ChhncmVldGVyL3YxL2dyZWV0ZXIucHJvdG8SCmdyZWV0ZXIudjEytwEKDkdyZWV0ZXJTZXJ2aWNlEkcKCFNheUhlbGxvEhsuZ3JlZXRlci52MS5TYXlIZWxsb1JlcXVlc3QaHC5ncmVldGVyLnYxLlNheUhlbGxvUmVzcG9uc2UiABJcCg9HZXRBbGxvd2VkTmFtZXMSIi5ncmVldGVyLnYxLkdldEFsbG93ZWROYW1lc1JlcXVlc3QaIy5ncmVldGVyLnYxLkdldEFsbG93ZWROYW1lc1Jlc3BvbnNlIgBCmgEKDmNvbS5ncmVldGVyLnYxQgxHcmVldGVyUHJvdG9QAVoxZ2l0aHViLmNvbS9ocno4L2FsdGFsdW5lL2dlbi9ncmVldGVyL3YxO2dyZWV0ZXJ2MaICA0dYWKoCCkdyZWV0ZXIuVjHKAgpHcmVldGVyXFYx4gIWR3JlZXRlclxWMVxHUEJNZXRhZGF0YeoCC0dyZWV0ZXI6OlYxYgZwcm90bzM", [file_greeter_v1_hello, file_greeter_v1_name]);
+  fileDesc("ChhncmVldGVyL3YxL2dyZWV0ZXIucHJvdG8SCmdyZWV0ZXIudjEy8wQKDkdyZWV0ZXJTZXJ2aWNlEkcKCFNheUhlbGxvEhsuZ3JlZXRlci52MS5TYXlIZWxsb1JlcXVlc3QaHC5ncmVldGVyLnYxLlNheUhlbGxvUmVzcG9uc2UiABJbCg5TYXlIZWxsb1RvTWFueRIhLmdyZWV0ZXIudjEuU2F5SGVsbG9Ub01hbnlSZXF1ZXN0GiIuZ3JlZXRlci52MS5TYXlIZWxsb1RvTWFueVJlc3BvbnNlIgAwARJcCg9HZXRBbGxvd2VkTmFtZXMSIi5ncmVldGVyLnYxLkdldEFsbG93ZWROYW1lc1JlcXVlc3QaIy5ncmVldGVyLnYxLkdldEFsbG93ZWROYW1lc1Jlc3BvbnNlIgAScwoRQ3JlYXRlQWxsb3dlZE5hbWUSJC5ncmVldGVyLnYxLkNyZWF0ZUFsbG93ZWROYW1lUmVxdWVzdBolLmdyZWV0ZXIudjEuQ3JlYXRlQWxsb3dlZE5hbWVSZXNwb25zZSIRirUYDWdyZWV0ZXI6d3JpdGUScwoRVXBkYXRlQWxsb3dlZE5hbWUSJC5ncmVldGVyLnYxLlVwZGF0ZUFsbG93ZWROYW1lUmVxdWVzdBolLmdyZWV0ZXIudjEuVXBkYXRlQWxsb3dlZE5hbWVSZXNwb25zZSIRirUYDWdyZWV0ZXI6d3JpdGUScwoRRGVsZXRlQWxsb3dlZE5hbWUSJC5ncmVldGVyLnYxLkRlbGV0ZUFsbG93ZWROYW1lUmVxdWVzdBolLmdyZWV0ZXIudjEuRGVsZXRlQWxsb3dlZE5hbWVSZXNwb25zZSIRirUYDWdyZWV0ZXI6d3JpdGVCmgEKDmNvbS5ncmVldGVyLnYxQgxHcmVldGVyUHJvdG9QAVoxZ2l0aHViLmNvbS9ocno4L2FsdGFsdW5lL2dlbi9ncmVldGVyL3YxO2dyZWV0ZXJ2MaICA0dYWKoCCkdyZWV0ZXIuVjHKAgpHcmVldGVyXFYx4gIWR3JlZXRlclxWMVxHUEJNZXRhZGF0YeoCC0dyZWV0ZXI6OlYxYgZwcm90bzM", [file_greeter_v1_hello, file_greeter_v1_name, file_altalune_v1_options]);
 
 /**
  * @generated from service greeter.v1.GreeterService
@@ -27,6 +28,14 @@ export const GreeterService: GenService<{
     input: typeof SayHelloRequestSchema;
     output: typeof SayHelloResponseSchema;
   },
+  /**
+   * @generated from rpc greeter.v1.GreeterService.SayHelloToMany
+   */
+  sayHelloToMany: {
+    methodKind: "server_streaming";
+    input: typeof SayHelloToManyRequestSchema;
+    output: typeof SayHelloToManyResponseSchema;
+  },
   /**
    * @generated from rpc greeter.v1.GreeterService.GetAllowedNames
    */
@@ -35,6 +44,30 @@ export const GreeterService: GenService<{
     input: typeof GetAllowedNamesRequestSchema;
     output: typeof GetAllowedNamesResponseSchema;
   },
+  /**
+   * @generated from rpc greeter.v1.GreeterService.CreateAllowedName
+   */
+  createAllowedName: {
+    methodKind: "unary";
+    input: typeof CreateAllowedNameRequestSchema;
+    output: typeof CreateAllowedNameResponseSchema;
+  },
+  /**
+   * @generated from rpc greeter.v1.GreeterService.UpdateAllowedName
+   */
+  updateAllowedName: {
+    methodKind: "unary";
+    input: typeof UpdateAllowedNameRequestSchema;
+    output: typeof UpdateAllowedNameResponseSchema;
+  },
+  /**
+   * @generated from rpc greeter.v1.GreeterService.DeleteAllowedName
+   */
+  deleteAllowedName: {
+    methodKind: "unary";
+    input: typeof DeleteAllowedNameRequestSchema;
+    output: typeof DeleteAllowedNameResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_greeter_v1_greeter, 0);
 
@@ -11,7 +11,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file greeter/v1/hello.proto.
  */
 export const file_greeter_v1_hello: GenFile = /*@__PURE__*/
-  fileDesc("ChZncmVldGVyL3YxL2hlbGxvLnByb3RvEgpncmVldGVyLnYxIj8KD1NheUhlbGxvUmVxdWVzdBIsCgRuYW1lGAEgASgJQh66SBvIAQFyFhADGDIyEF5bQS1aYS16MC05Xy1dKyQiIwoQU2F5SGVsbG9SZXNwb25zZRIPCgdtZXNzYWdlGAEgASgJQpgBCg5jb20uZ3JlZXRlci52MUIKSGVsbG9Qcm90b1ABWjFnaXRodWIuY29tL2hyejgvYWx0YWx1bmUvZ2VuL2dyZWV0ZXIvdjE7Z3JlZXRlcnYxogIDR1hYqgIKR3JlZXRlci5WMcoCCkdyZWV0ZXJcVjHiAhZHcmVldGVyXFYxXEdQQk1ldGFkYXRh6gILR3JlZXRlcjo6VjFiBnByb3RvMw", [file_buf_validate_validate]);
+  fileDesc("ChZncmVldGVyL3YxL2hlbGxvLnByb3RvEgpncmVldGVyLnYxIj8KD1NheUhlbGxvUmVxdWVzdBIsCgRuYW1lGAEgASgJQh66SBvIAQFyFhADGDIyEF5bQS1aYS16MC05Xy1dKyQiIwoQU2F5SGVsbG9SZXNwb25zZRIPCgdtZXNzYWdlGAEgASgJIk4KFVNheUhlbGxvVG9NYW55UmVxdWVzdBI1CgVuYW1lcxgBIAMoCUImukgjkgEgCAEQFBgBIhhyFhADGDIyEF5bQS1aYS16MC05Xy1dKyQiNwoWU2F5SGVsbG9Ub01hbnlSZXNwb25zZRIMCgRuYW1lGAEgASgJEg8KB21lc3NhZ2UYAiABKAlCmAEKDmNvbS5ncmVldGVyLnYxQgpIZWxsb1Byb3RvUAFaMWdpdGh1Yi5jb20vaHJ6OC9hbHRhbHVuZS9nZW4vZ3JlZXRlci92MTtncmVldGVydjGiAgNHWFiqAgpHcmVldGVyLlYxygIKR3JlZXRlclxWMeICFkdyZWV0ZXJcVjFcR1BCTWV0YWRhdGHqAgtHcmVldGVyOjpWMWIGcHJvdG8z", [file_buf_validate_validate]);
 
 /**
  * @generated from message greeter.v1.SayHelloRequest
@@ -47,3 +47,44 @@ export type SayHelloResponse = Message<"greeter.v1.SayHelloResponse"> & {
 export const SayHelloResponseSchema: GenMessage<SayHelloResponse> = /*@__PURE__*/
   messageDesc(file_greeter_v1_hello, 1);
 
+/**
+ * SayHelloToManyRequest greets several names; one response is streamed per name.
+ *
+ * @generated from message greeter.v1.SayHelloToManyRequest
+ */
+export type SayHelloToManyRequest = Message<"greeter.v1.SayHelloToManyRequest"> & {
+  /**
+   * @generated from field: repeated string names = 1;
+   */
+  names: string[];
+};
+
+/**
+ * Describes the message greeter.v1.SayHelloToManyRequest.
+ * Use `create(SayHelloToManyRequestSchema)` to create a new message.
+ */
+export const SayHelloToManyRequestSchema: GenMessage<SayHelloToManyRequest> = /*@__PURE__*/
+  messageDesc(file_greeter_v1_hello, 2);
+
+/**
+ * @generated from message greeter.v1.SayHelloToManyResponse
+ */
+export type SayHelloToManyResponse = Message<"greeter.v1.SayHelloToManyResponse"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message greeter.v1.SayHelloToManyResponse.
+ * Use `create(SayHelloToManyResponseSchema)` to create a new message.
+ */
+export const SayHelloToManyResponseSchema: GenMessage<SayHelloToManyResponse> = /*@__PURE__*/
+  messageDesc(file_greeter_v1_hello, 3);
+
@@ -13,7 +13,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file greeter/v1/name.proto.
  */
 export const file_greeter_v1_name: GenFile = /*@__PURE__*/
-  fileDesc("ChVncmVldGVyL3YxL25hbWUucHJvdG8SCmdyZWV0ZXIudjEiTwoWR2V0QWxsb3dlZE5hbWVzUmVxdWVzdBIYCgRwYWdlGAEgASgFQgq6SAfIAQEaAiAAEhsKBWxpbWl0GAIgASgFQgy6SAnIAQEaBBhkIAAiUgoXR2V0QWxsb3dlZE5hbWVzUmVzcG9uc2USDQoFbmFtZXMYASADKAkSKAoEbWV0YRgCIAEoCzIaLmdyZWV0ZXIudjEuUGFnaW5hdGlvbk1ldGFClwEKDmNvbS5ncmVldGVyLnYxQglOYW1lUHJvdG9QAVoxZ2l0aHViLmNvbS9ocno4L2FsdGFsdW5lL2dlbi9ncmVldGVyL3YxO2dyZWV0ZXJ2MaICA0dYWKoCCkdyZWV0ZXIuVjHKAgpHcmVldGVyXFYx4gIWR3JlZXRlclxWMVxHUEJNZXRhZGF0YeoCC0dyZWV0ZXI6OlYxYgZwcm90bzM", [file_buf_validate_validate, file_greeter_v1_common]);
+  fileDesc("ChVncmVldGVyL3YxL25hbWUucHJvdG8SCmdyZWV0ZXIudjEiTwoWR2V0QWxsb3dlZE5hbWVzUmVxdWVzdBIYCgRwYWdlGAEgASgFQgq6SAfIAQEaAiAAEhsKBWxpbWl0GAIgASgFQgy6SAnIAQEaBBhkIAAiUgoXR2V0QWxsb3dlZE5hbWVzUmVzcG9uc2USDQoFbmFtZXMYASADKAkSKAoEbWV0YRgCIAEoCzIaLmdyZWV0ZXIudjEuUGFnaW5hdGlvbk1ldGEiSAoYQ3JlYXRlQWxsb3dlZE5hbWVSZXF1ZXN0EiwKBG5hbWUYASABKAlCHrpIG8gBAXIWEAMYMjIQXltBLVphLXowLTlfLV0rJCIpChlDcmVhdGVBbGxvd2VkTmFtZVJlc3BvbnNlEgwKBG5hbWUYASABKAkiegoYVXBkYXRlQWxsb3dlZE5hbWVSZXF1ZXN0EiwKBG5hbWUYASABKAlCHrpIG8gBAXIWEAMYMjIQXltBLVphLXowLTlfLV0rJBIwCghuZXdfbmFtZRgCIAEoCUIeukgbyAEBchYQAxgyMhBeW0EtWmEtejAtOV8tXSskIikKGVVwZGF0ZUFsbG93ZWROYW1lUmVzcG9uc2USDAoEbmFtZRgBIAEoCSJIChhEZWxldGVBbGxvd2VkTmFtZVJlcXVlc3QSLAoEbmFtZRgBIAEoCUIeukgbyAEBchYQAxgyMhBeW0EtWmEtejAtOV8tXSskIhsKGURlbGV0ZUFsbG93ZWROYW1lUmVzcG9uc2VClwEKDmNvbS5ncmVldGVyLnYxQglOYW1lUHJvdG9QAVoxZ2l0aHViLmNvbS9ocno4L2FsdGFsdW5lL2dlbi9ncmVldGVyL3YxO2dyZWV0ZXJ2MaICA0dYWKoCCkdyZWV0ZXIuVjHKAgpHcmVldGVyXFYx4gIWR3JlZXRlclxWMVxHUEJNZXRhZGF0YeoCC0dyZWV0ZXI6OlYxYgZwcm90bzM", [file_buf_validate_validate, file_greeter_v1_common]);
 
 /**
  * @generated from message greeter.v1.GetAllowedNamesRequest
@@ -59,3 +59,106 @@ export type GetAllowedNamesResponse = Message<"greeter.v1.GetAllowedNamesRespons
 export const GetAllowedNamesResponseSchema: GenMessage<GetAllowedNamesResponse> = /*@__PURE__*/
   messageDesc(file_greeter_v1_name, 1);
 
+/**
+ * @generated from message greeter.v1.CreateAllowedNameRequest
+ */
+export type CreateAllowedNameRequest = Message<"greeter.v1.CreateAllowedNameRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message greeter.v1.CreateAllowedNameRequest.
+ * Use `create(CreateAllowedNameRequestSchema)` to create a new message.
+ */
+export const CreateAllowedNameRequestSchema: GenMessage<CreateAllowedNameRequest> = /*@__PURE__*/
+  messageDesc(file_greeter_v1_name, 2);
+
+/**
+ * @generated from message greeter.v1.CreateAllowedNameResponse
+ */
+export type CreateAllowedNameResponse = Message<"greeter.v1.CreateAllowedNameResponse"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message greeter.v1.CreateAllowedNameResponse.
+ * Use `create(CreateAllowedNameResponseSchema)` to create a new message.
+ */
+export const CreateAllowedNameResponseSchema: GenMessage<CreateAllowedNameResponse> = /*@__PURE__*/
+  messageDesc(file_greeter_v1_name, 3);
+
+/**
+ * @generated from message greeter.v1.UpdateAllowedNameRequest
+ */
+export type UpdateAllowedNameRequest = Message<"greeter.v1.UpdateAllowedNameRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string new_name = 2;
+   */
+  newName: string;
+};
+
+/**
+ * Describes the message greeter.v1.UpdateAllowedNameRequest.
+ * Use `create(UpdateAllowedNameRequestSchema)` to create a new message.
+ */
+export const UpdateAllowedNameRequestSchema: GenMessage<UpdateAllowedNameRequest> = /*@__PURE__*/
+  messageDesc(file_greeter_v1_name, 4);
+
+/**
+ * @generated from message greeter.v1.UpdateAllowedNameResponse
+ */
+export type UpdateAllowedNameResponse = Message<"greeter.v1.UpdateAllowedNameResponse"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message greeter.v1.UpdateAllowedNameResponse.
+ * Use `create(UpdateAllowedNameResponseSchema)` to create a new message.
+ */
+export const UpdateAllowedNameResponseSchema: GenMessage<UpdateAllowedNameResponse> = /*@__PURE__*/
+  messageDesc(file_greeter_v1_name, 5);
+
+/**
+ * @generated from message greeter.v1.DeleteAllowedNameRequest
+ */
+export type DeleteAllowedNameRequest = Message<"greeter.v1.DeleteAllowedNameRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message greeter.v1.DeleteAllowedNameRequest.
+ * Use `create(DeleteAllowedNameRequestSchema)` to create a new message.
+ */
+export const DeleteAllowedNameRequestSchema: GenMessage<DeleteAllowedNameRequest> = /*@__PURE__*/
+  messageDesc(file_greeter_v1_name, 6);
+
+/**
+ * @generated from message greeter.v1.DeleteAllowedNameResponse
+ */
+export type DeleteAllowedNameResponse = Message<"greeter.v1.DeleteAllowedNameResponse"> & {
+};
+
+/**
+ * Describes the message greeter.v1.DeleteAllowedNameResponse.
+ * Use `create(DeleteAllowedNameResponseSchema)` to create a new message.
+ */
+export const DeleteAllowedNameResponseSchema: GenMessage<DeleteAllowedNameResponse> = /*@__PURE__*/
+  messageDesc(file_greeter_v1_name, 7);
+
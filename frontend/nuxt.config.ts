@@ -51,6 +51,7 @@ export default defineNuxtConfig({
       oauthBackendUrl: '', // Backend BFF URL for OAuth endpoints (/oauth/exchange, /oauth/me, etc.)
       oauthClientId: '', // Dashboard OAuth client ID
       oauthRedirectUri: '', // OAuth callback URL
+      sessionHeartbeatInterval: 30, // Seconds between session heartbeats, 0 disables them
     },
   },
   css: ['~/assets/css/style.css'],
@@ -3,6 +3,8 @@
  * google.protobuf.Struct and google.protobuf.Value types.
  */
 
+import type { DeliveredSecret } from '~~/gen/altalune/v1/common_pb';
+
 /**
  * Clean an object for protobuf serialization.
  *
@@ -82,3 +84,23 @@ export function getProtobufErrorMessage(errorMessage: string): string {
   }
   return errorMessage;
 }
+
+/**
+ * Get the value to show for a secret the credential policy kept out of a
+ * create response: the absolute one-time retrieval link, which lives on the
+ * API server, or the secret encrypted to the given public key.
+ *
+ * @param delivered - The delivered secret of the response, if any
+ * @param apiUrl - The configured API URL, empty for the current origin
+ * @returns The link or the encrypted secret, empty without delivery
+ */
+export function deliveredSecretValue(delivered?: DeliveredSecret, apiUrl = ''): string {
+  if (!delivered) {
+    return '';
+  }
+  if (delivered.retrievalUrl) {
+    const base = new URL(apiUrl || '/', window.location.origin);
+    return new URL(delivered.retrievalUrl, base).toString();
+  }
+  return delivered.encryptedSecret;
+}
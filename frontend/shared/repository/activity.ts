@@ -0,0 +1,25 @@
+import type { Client } from '@connectrpc/connect';
+
+import type {
+  ActivityService,
+  ListProjectActivityRequest,
+  ListProjectActivityResponse,
+} from '~~/gen/altalune/v1/activity_pb';
+import { ConnectError } from '@connectrpc/connect';
+
+export function activityRepository(client: Client<typeof ActivityService>) {
+  return {
+    async listProjectActivity(req: ListProjectActivityRequest): Promise<ListProjectActivityResponse> {
+      try {
+        const response = await client.listProjectActivity(req);
+        return response;
+      }
+      catch (err) {
+        if (err instanceof ConnectError) {
+          console.error('ConnectError:', err);
+        }
+        throw err;
+      }
+    },
+  };
+}
@@ -23,6 +23,11 @@ export interface AuthExchangeResponse {
   expires_in: number;
 }
 
+export interface SessionHeartbeatResponse {
+  active: boolean;
+  expires_in: number;
+}
+
 export interface AuthErrorResponse {
   error: string;
   error_description?: string;
@@ -97,3 +102,15 @@ export function authRepository(f: $Fetch) {
     },
   };
 }
+
+// Session endpoints live under the API URL, not the BFF OAuth URL
+export function sessionRepository(f: $Fetch) {
+  return {
+    async heartbeat(): Promise<SessionHeartbeatResponse> {
+      return await f<SessionHeartbeatResponse>('/session/heartbeat', {
+        method: 'GET',
+        credentials: 'include',
+      });
+    },
+  };
+}
@@ -12,6 +12,8 @@ import type {
   QueryOAuthClientsResponse,
   RevealOAuthClientSecretRequest,
   RevealOAuthClientSecretResponse,
+  RotateOAuthClientSecretRequest,
+  RotateOAuthClientSecretResponse,
   UpdateOAuthClientRequest,
   UpdateOAuthClientResponse,
 } from '~~/gen/altalune/v1/oauth_client_pb';
@@ -122,5 +124,24 @@ export function oauthClientRepository(client: Client<typeof OAuthClientService>)
         throw err;
       }
     },
+
+    /**
+     * Rotate client secret
+     * Returns the new plaintext client_secret (shown once)
+     */
+    async rotateOAuthClientSecret(
+      req: RotateOAuthClientSecretRequest,
+    ): Promise<RotateOAuthClientSecretResponse> {
+      try {
+        const response = await client.rotateOAuthClientSecret(req);
+        return response;
+      }
+      catch (err) {
+        if (err instanceof ConnectError) {
+          console.error('ConnectError:', err);
+        }
+        throw err;
+      }
+    },
   };
 }
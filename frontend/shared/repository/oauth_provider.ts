@@ -9,6 +9,8 @@ import type {
   OAuthProviderService,
   QueryOAuthProvidersRequest,
   QueryOAuthProvidersResponse,
+  RequestClientSecretRevealRequest,
+  RequestClientSecretRevealResponse,
   RevealClientSecretRequest,
   RevealClientSecretResponse,
   UpdateOAuthProviderRequest,
@@ -91,6 +93,21 @@ export function oauthProviderRepository(client: Client<typeof OAuthProviderServi
       }
     },
 
+    async requestClientSecretReveal(
+      req: RequestClientSecretRevealRequest,
+    ): Promise<RequestClientSecretRevealResponse> {
+      try {
+        const response = await client.requestClientSecretReveal(req);
+        return response;
+      }
+      catch (err) {
+        if (err instanceof ConnectError) {
+          console.error('ConnectError:', err);
+        }
+        throw err;
+      }
+    },
+
     async revealClientSecret(req: RevealClientSecretRequest): Promise<RevealClientSecretResponse> {
       try {
         const response = await client.revealClientSecret(req);
@@ -0,0 +1,70 @@
+import type { Client } from '@connectrpc/connect';
+
+import type {
+  DeleteProjectLogoRequest,
+  DeleteProjectLogoResponse,
+  GetProjectBrandingRequest,
+  GetProjectBrandingResponse,
+  ProjectBrandingService,
+  UpdateProjectBrandingRequest,
+  UpdateProjectBrandingResponse,
+  UploadProjectLogoRequest,
+  UploadProjectLogoResponse,
+} from '~~/gen/altalune/v1/project_branding_pb';
+import { ConnectError } from '@connectrpc/connect';
+
+export function projectBrandingRepository(client: Client<typeof ProjectBrandingService>) {
+  return {
+    async getProjectBranding(req: GetProjectBrandingRequest): Promise<GetProjectBrandingResponse> {
+      try {
+        const response = await client.getProjectBranding(req);
+        return response;
+      }
+      catch (err) {
+        if (err instanceof ConnectError) {
+          console.error('ConnectError:', err);
+        }
+        throw err;
+      }
+    },
+
+    async updateProjectBranding(req: UpdateProjectBrandingRequest): Promise<UpdateProjectBrandingResponse> {
+      try {
+        const response = await client.updateProjectBranding(req);
+        return response;
+      }
+      catch (err) {
+        if (err instanceof ConnectError) {
+          console.error('ConnectError:', err);
+        }
+        throw err;
+      }
+    },
+
+    async uploadProjectLogo(req: UploadProjectLogoRequest): Promise<UploadProjectLogoResponse> {
+      try {
+        const response = await client.uploadProjectLogo(req);
+        return response;
+      }
+      catch (err) {
+        if (err instanceof ConnectError) {
+          console.error('ConnectError:', err);
+        }
+        throw err;
+      }
+    },
+
+    async deleteProjectLogo(req: DeleteProjectLogoRequest): Promise<DeleteProjectLogoResponse> {
+      try {
+        const response = await client.deleteProjectLogo(req);
+        return response;
+      }
+      catch (err) {
+        if (err instanceof ConnectError) {
+          console.error('ConnectError:', err);
+        }
+        throw err;
+      }
+    },
+  };
+}
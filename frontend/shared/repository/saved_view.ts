@@ -0,0 +1,70 @@
+import type { Client } from '@connectrpc/connect';
+
+import type {
+  CreateSavedViewRequest,
+  CreateSavedViewResponse,
+  DeleteSavedViewRequest,
+  DeleteSavedViewResponse,
+  ListSavedViewsRequest,
+  ListSavedViewsResponse,
+  SavedViewService,
+  UpdateSavedViewRequest,
+  UpdateSavedViewResponse,
+} from '~~/gen/altalune/v1/saved_view_pb';
+import { ConnectError } from '@connectrpc/connect';
+
+export function savedViewRepository(client: Client<typeof SavedViewService>) {
+  return {
+    async listSavedViews(req: ListSavedViewsRequest): Promise<ListSavedViewsResponse> {
+      try {
+        const response = await client.listSavedViews(req);
+        return response;
+      }
+      catch (err) {
+        if (err instanceof ConnectError) {
+          console.error('ConnectError:', err);
+        }
+        throw err;
+      }
+    },
+
+    async createSavedView(req: CreateSavedViewRequest): Promise<CreateSavedViewResponse> {
+      try {
+        const response = await client.createSavedView(req);
+        return response;
+      }
+      catch (err) {
+        if (err instanceof ConnectError) {
+          console.error('ConnectError:', err);
+        }
+        throw err;
+      }
+    },
+
+    async updateSavedView(req: UpdateSavedViewRequest): Promise<UpdateSavedViewResponse> {
+      try {
+        const response = await client.updateSavedView(req);
+        return response;
+      }
+      catch (err) {
+        if (err instanceof ConnectError) {
+          console.error('ConnectError:', err);
+        }
+        throw err;
+      }
+    },
+
+    async deleteSavedView(req: DeleteSavedViewRequest): Promise<DeleteSavedViewResponse> {
+      try {
+        const response = await client.deleteSavedView(req);
+        return response;
+      }
+      catch (err) {
+        if (err instanceof ConnectError) {
+          console.error('ConnectError:', err);
+        }
+        throw err;
+      }
+    },
+  };
+}
@@ -2,6 +2,8 @@ import type { Client } from '@connectrpc/connect';
 import type {
   ActivateUserRequest,
   ActivateUserResponse,
+  CreateServiceAccountRequest,
+  CreateServiceAccountResponse,
   CreateUserRequest,
   CreateUserResponse,
   DeactivateUserRequest,
@@ -12,6 +14,8 @@ import type {
   GetUserResponse,
   QueryUsersRequest,
   QueryUsersResponse,
+  UnlockUserRequest,
+  UnlockUserResponse,
   UpdateUserRequest,
   UpdateUserResponse,
   UserService,
@@ -110,5 +114,30 @@ export function userRepository(client: Client<typeof UserService>) {
         throw err;
       }
     },
+
+    async unlockUser(req: UnlockUserRequest): Promise<UnlockUserResponse> {
+      try {
+        const response = await client.unlockUser(req);
+        return response;
+      }
+      catch (err) {
+        if (err instanceof ConnectError) {
+          console.error('ConnectError:', err);
+        }
+        throw err;
+      }
+    },
+    async createServiceAccount(req: CreateServiceAccountRequest): Promise<CreateServiceAccountResponse> {
+      try {
+        const response = await client.createServiceAccount(req);
+        return response;
+      }
+      catch (err) {
+        if (err instanceof ConnectError) {
+          console.error('ConnectError:', err);
+        }
+        throw err;
+      }
+    },
   };
 }
@@ -47,12 +47,21 @@ const (
 	// ApiKeyServiceDeleteApiKeyProcedure is the fully-qualified name of the ApiKeyService's
 	// DeleteApiKey RPC.
 	ApiKeyServiceDeleteApiKeyProcedure = "/altalune.v1.ApiKeyService/DeleteApiKey"
+	// ApiKeyServiceRestoreApiKeyProcedure is the fully-qualified name of the ApiKeyService's
+	// RestoreApiKey RPC.
+	ApiKeyServiceRestoreApiKeyProcedure = "/altalune.v1.ApiKeyService/RestoreApiKey"
 	// ApiKeyServiceActivateApiKeyProcedure is the fully-qualified name of the ApiKeyService's
 	// ActivateApiKey RPC.
 	ApiKeyServiceActivateApiKeyProcedure = "/altalune.v1.ApiKeyService/ActivateApiKey"
 	// ApiKeyServiceDeactivateApiKeyProcedure is the fully-qualified name of the ApiKeyService's
 	// DeactivateApiKey RPC.
 	ApiKeyServiceDeactivateApiKeyProcedure = "/altalune.v1.ApiKeyService/DeactivateApiKey"
+	// ApiKeyServiceApplyApiKeyProcedure is the fully-qualified name of the ApiKeyService's ApplyApiKey
+	// RPC.
+	ApiKeyServiceApplyApiKeyProcedure = "/altalune.v1.ApiKeyService/ApplyApiKey"
+	// ApiKeyServiceImportApiKeyProcedure is the fully-qualified name of the ApiKeyService's
+	// ImportApiKey RPC.
+	ApiKeyServiceImportApiKeyProcedure = "/altalune.v1.ApiKeyService/ImportApiKey"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
@@ -63,8 +72,11 @@ var (
 	apiKeyServiceGetApiKeyMethodDescriptor        = apiKeyServiceServiceDescriptor.Methods().ByName("GetApiKey")
 	apiKeyServiceUpdateApiKeyMethodDescriptor     = apiKeyServiceServiceDescriptor.Methods().ByName("UpdateApiKey")
 	apiKeyServiceDeleteApiKeyMethodDescriptor     = apiKeyServiceServiceDescriptor.Methods().ByName("DeleteApiKey")
+	apiKeyServiceRestoreApiKeyMethodDescriptor    = apiKeyServiceServiceDescriptor.Methods().ByName("RestoreApiKey")
 	apiKeyServiceActivateApiKeyMethodDescriptor   = apiKeyServiceServiceDescriptor.Methods().ByName("ActivateApiKey")
 	apiKeyServiceDeactivateApiKeyMethodDescriptor = apiKeyServiceServiceDescriptor.Methods().ByName("DeactivateApiKey")
+	apiKeyServiceApplyApiKeyMethodDescriptor      = apiKeyServiceServiceDescriptor.Methods().ByName("ApplyApiKey")
+	apiKeyServiceImportApiKeyMethodDescriptor     = apiKeyServiceServiceDescriptor.Methods().ByName("ImportApiKey")
 )
 
 // ApiKeyServiceClient is a client for the altalune.v1.ApiKeyService service.
@@ -74,8 +86,13 @@ type ApiKeyServiceClient interface {
 	GetApiKey(context.Context, *connect.Request[v1.GetApiKeyRequest]) (*connect.Response[v1.GetApiKeyResponse], error)
 	UpdateApiKey(context.Context, *connect.Request[v1.UpdateApiKeyRequest]) (*connect.Response[v1.UpdateApiKeyResponse], error)
 	DeleteApiKey(context.Context, *connect.Request[v1.DeleteApiKeyRequest]) (*connect.Response[v1.DeleteApiKeyResponse], error)
+	RestoreApiKey(context.Context, *connect.Request[v1.RestoreApiKeyRequest]) (*connect.Response[v1.RestoreApiKeyResponse], error)
 	ActivateApiKey(context.Context, *connect.Request[v1.ActivateApiKeyRequest]) (*connect.Response[v1.ActivateApiKeyResponse], error)
 	DeactivateApiKey(context.Context, *connect.Request[v1.DeactivateApiKeyRequest]) (*connect.Response[v1.DeactivateApiKeyResponse], error)
+	// Create-or-update by external ID, for declarative provisioning
+	ApplyApiKey(context.Context, *connect.Request[v1.ApplyApiKeyRequest]) (*connect.Response[v1.ApplyApiKeyResponse], error)
+	// Bring an existing API key under an external ID, found by name
+	ImportApiKey(context.Context, *connect.Request[v1.ImportApiKeyRequest]) (*connect.Response[v1.ImportApiKeyResponse], error)
 }
 
 // NewApiKeyServiceClient constructs a client for the altalune.v1.ApiKeyService service. By default,
@@ -118,6 +135,12 @@ func NewApiKeyServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(apiKeyServiceDeleteApiKeyMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		restoreApiKey: connect.NewClient[v1.RestoreApiKeyRequest, v1.RestoreApiKeyResponse](
+			httpClient,
+			baseURL+ApiKeyServiceRestoreApiKeyProcedure,
+			connect.WithSchema(apiKeyServiceRestoreApiKeyMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		activateApiKey: connect.NewClient[v1.ActivateApiKeyRequest, v1.ActivateApiKeyResponse](
 			httpClient,
 			baseURL+ApiKeyServiceActivateApiKeyProcedure,
@@ -130,6 +153,18 @@ func NewApiKeyServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(apiKeyServiceDeactivateApiKeyMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		applyApiKey: connect.NewClient[v1.ApplyApiKeyRequest, v1.ApplyApiKeyResponse](
+			httpClient,
+			baseURL+ApiKeyServiceApplyApiKeyProcedure,
+			connect.WithSchema(apiKeyServiceApplyApiKeyMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		importApiKey: connect.NewClient[v1.ImportApiKeyRequest, v1.ImportApiKeyResponse](
+			httpClient,
+			baseURL+ApiKeyServiceImportApiKeyProcedure,
+			connect.WithSchema(apiKeyServiceImportApiKeyMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -140,8 +175,11 @@ type apiKeyServiceClient struct {
 	getApiKey        *connect.Client[v1.GetApiKeyRequest, v1.GetApiKeyResponse]
 	updateApiKey     *connect.Client[v1.UpdateApiKeyRequest, v1.UpdateApiKeyResponse]
 	deleteApiKey     *connect.Client[v1.DeleteApiKeyRequest, v1.DeleteApiKeyResponse]
+	restoreApiKey    *connect.Client[v1.RestoreApiKeyRequest, v1.RestoreApiKeyResponse]
 	activateApiKey   *connect.Client[v1.ActivateApiKeyRequest, v1.ActivateApiKeyResponse]
 	deactivateApiKey *connect.Client[v1.DeactivateApiKeyRequest, v1.DeactivateApiKeyResponse]
+	applyApiKey      *connect.Client[v1.ApplyApiKeyRequest, v1.ApplyApiKeyResponse]
+	importApiKey     *connect.Client[v1.ImportApiKeyRequest, v1.ImportApiKeyResponse]
 }
 
 // QueryApiKeys calls altalune.v1.ApiKeyService.QueryApiKeys.
@@ -169,6 +207,11 @@ func (c *apiKeyServiceClient) DeleteApiKey(ctx context.Context, req *connect.Req
 	return c.deleteApiKey.CallUnary(ctx, req)
 }
 
+// RestoreApiKey calls altalune.v1.ApiKeyService.RestoreApiKey.
+func (c *apiKeyServiceClient) RestoreApiKey(ctx context.Context, req *connect.Request[v1.RestoreApiKeyRequest]) (*connect.Response[v1.RestoreApiKeyResponse], error) {
+	return c.restoreApiKey.CallUnary(ctx, req)
+}
+
 // ActivateApiKey calls altalune.v1.ApiKeyService.ActivateApiKey.
 func (c *apiKeyServiceClient) ActivateApiKey(ctx context.Context, req *connect.Request[v1.ActivateApiKeyRequest]) (*connect.Response[v1.ActivateApiKeyResponse], error) {
 	return c.activateApiKey.CallUnary(ctx, req)
@@ -179,6 +222,16 @@ func (c *apiKeyServiceClient) DeactivateApiKey(ctx context.Context, req *connect
 	return c.deactivateApiKey.CallUnary(ctx, req)
 }
 
+// ApplyApiKey calls altalune.v1.ApiKeyService.ApplyApiKey.
+func (c *apiKeyServiceClient) ApplyApiKey(ctx context.Context, req *connect.Request[v1.ApplyApiKeyRequest]) (*connect.Response[v1.ApplyApiKeyResponse], error) {
+	return c.applyApiKey.CallUnary(ctx, req)
+}
+
+// ImportApiKey calls altalune.v1.ApiKeyService.ImportApiKey.
+func (c *apiKeyServiceClient) ImportApiKey(ctx context.Context, req *connect.Request[v1.ImportApiKeyRequest]) (*connect.Response[v1.ImportApiKeyResponse], error) {
+	return c.importApiKey.CallUnary(ctx, req)
+}
+
 // ApiKeyServiceHandler is an implementation of the altalune.v1.ApiKeyService service.
 type ApiKeyServiceHandler interface {
 	QueryApiKeys(context.Context, *connect.Request[v1.QueryApiKeysRequest]) (*connect.Response[v1.QueryApiKeysResponse], error)
@@ -186,8 +239,13 @@ type ApiKeyServiceHandler interface {
 	GetApiKey(context.Context, *connect.Request[v1.GetApiKeyRequest]) (*connect.Response[v1.GetApiKeyResponse], error)
 	UpdateApiKey(context.Context, *connect.Request[v1.UpdateApiKeyRequest]) (*connect.Response[v1.UpdateApiKeyResponse], error)
 	DeleteApiKey(context.Context, *connect.Request[v1.DeleteApiKeyRequest]) (*connect.Response[v1.DeleteApiKeyResponse], error)
+	RestoreApiKey(context.Context, *connect.Request[v1.RestoreApiKeyRequest]) (*connect.Response[v1.RestoreApiKeyResponse], error)
 	ActivateApiKey(context.Context, *connect.Request[v1.ActivateApiKeyRequest]) (*connect.Response[v1.ActivateApiKeyResponse], error)
 	DeactivateApiKey(context.Context, *connect.Request[v1.DeactivateApiKeyRequest]) (*connect.Response[v1.DeactivateApiKeyResponse], error)
+	// Create-or-update by external ID, for declarative provisioning
+	ApplyApiKey(context.Context, *connect.Request[v1.ApplyApiKeyRequest]) (*connect.Response[v1.ApplyApiKeyResponse], error)
+	// Bring an existing API key under an external ID, found by name
+	ImportApiKey(context.Context, *connect.Request[v1.ImportApiKeyRequest]) (*connect.Response[v1.ImportApiKeyResponse], error)
 }
 
 // NewApiKeyServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -226,6 +284,12 @@ func NewApiKeyServiceHandler(svc ApiKeyServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(apiKeyServiceDeleteApiKeyMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	apiKeyServiceRestoreApiKeyHandler := connect.NewUnaryHandler(
+		ApiKeyServiceRestoreApiKeyProcedure,
+		svc.RestoreApiKey,
+		connect.WithSchema(apiKeyServiceRestoreApiKeyMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	apiKeyServiceActivateApiKeyHandler := connect.NewUnaryHandler(
 		ApiKeyServiceActivateApiKeyProcedure,
 		svc.ActivateApiKey,
@@ -238,6 +302,18 @@ func NewApiKeyServiceHandler(svc ApiKeyServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(apiKeyServiceDeactivateApiKeyMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	apiKeyServiceApplyApiKeyHandler := connect.NewUnaryHandler(
+		ApiKeyServiceApplyApiKeyProcedure,
+		svc.ApplyApiKey,
+		connect.WithSchema(apiKeyServiceApplyApiKeyMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	apiKeyServiceImportApiKeyHandler := connect.NewUnaryHandler(
+		ApiKeyServiceImportApiKeyProcedure,
+		svc.ImportApiKey,
+		connect.WithSchema(apiKeyServiceImportApiKeyMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/altalune.v1.ApiKeyService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ApiKeyServiceQueryApiKeysProcedure:
@@ -250,10 +326,16 @@ func NewApiKeyServiceHandler(svc ApiKeyServiceHandler, opts ...connect.HandlerOp
 			apiKeyServiceUpdateApiKeyHandler.ServeHTTP(w, r)
 		case ApiKeyServiceDeleteApiKeyProcedure:
 			apiKeyServiceDeleteApiKeyHandler.ServeHTTP(w, r)
+		case ApiKeyServiceRestoreApiKeyProcedure:
+			apiKeyServiceRestoreApiKeyHandler.ServeHTTP(w, r)
 		case ApiKeyServiceActivateApiKeyProcedure:
 			apiKeyServiceActivateApiKeyHandler.ServeHTTP(w, r)
 		case ApiKeyServiceDeactivateApiKeyProcedure:
 			apiKeyServiceDeactivateApiKeyHandler.ServeHTTP(w, r)
+		case ApiKeyServiceApplyApiKeyProcedure:
+			apiKeyServiceApplyApiKeyHandler.ServeHTTP(w, r)
+		case ApiKeyServiceImportApiKeyProcedure:
+			apiKeyServiceImportApiKeyHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -283,6 +365,10 @@ func (UnimplementedApiKeyServiceHandler) DeleteApiKey(context.Context, *connect.
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.ApiKeyService.DeleteApiKey is not implemented"))
 }
 
+func (UnimplementedApiKeyServiceHandler) RestoreApiKey(context.Context, *connect.Request[v1.RestoreApiKeyRequest]) (*connect.Response[v1.RestoreApiKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.ApiKeyService.RestoreApiKey is not implemented"))
+}
+
 func (UnimplementedApiKeyServiceHandler) ActivateApiKey(context.Context, *connect.Request[v1.ActivateApiKeyRequest]) (*connect.Response[v1.ActivateApiKeyResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.ApiKeyService.ActivateApiKey is not implemented"))
 }
@@ -290,3 +376,11 @@ func (UnimplementedApiKeyServiceHandler) ActivateApiKey(context.Context, *connec
 func (UnimplementedApiKeyServiceHandler) DeactivateApiKey(context.Context, *connect.Request[v1.DeactivateApiKeyRequest]) (*connect.Response[v1.DeactivateApiKeyResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.ApiKeyService.DeactivateApiKey is not implemented"))
 }
+
+func (UnimplementedApiKeyServiceHandler) ApplyApiKey(context.Context, *connect.Request[v1.ApplyApiKeyRequest]) (*connect.Response[v1.ApplyApiKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.ApiKeyService.ApplyApiKey is not implemented"))
+}
+
+func (UnimplementedApiKeyServiceHandler) ImportApiKey(context.Context, *connect.Request[v1.ImportApiKeyRequest]) (*connect.Response[v1.ImportApiKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.ApiKeyService.ImportApiKey is not implemented"))
+}
@@ -36,6 +36,9 @@ const (
 	// EmployeeServiceQueryEmployeesProcedure is the fully-qualified name of the EmployeeService's
 	// QueryEmployees RPC.
 	EmployeeServiceQueryEmployeesProcedure = "/altalune.v1.EmployeeService/QueryEmployees"
+	// EmployeeServiceStreamEmployeesProcedure is the fully-qualified name of the EmployeeService's
+	// StreamEmployees RPC.
+	EmployeeServiceStreamEmployeesProcedure = "/altalune.v1.EmployeeService/StreamEmployees"
 	// EmployeeServiceCreateEmployeeProcedure is the fully-qualified name of the EmployeeService's
 	// CreateEmployee RPC.
 	EmployeeServiceCreateEmployeeProcedure = "/altalune.v1.EmployeeService/CreateEmployee"
@@ -48,25 +51,42 @@ const (
 	// EmployeeServiceDeleteEmployeeProcedure is the fully-qualified name of the EmployeeService's
 	// DeleteEmployee RPC.
 	EmployeeServiceDeleteEmployeeProcedure = "/altalune.v1.EmployeeService/DeleteEmployee"
+	// EmployeeServiceRestoreEmployeeProcedure is the fully-qualified name of the EmployeeService's
+	// RestoreEmployee RPC.
+	EmployeeServiceRestoreEmployeeProcedure = "/altalune.v1.EmployeeService/RestoreEmployee"
+	// EmployeeServiceImportEmployeesProcedure is the fully-qualified name of the EmployeeService's
+	// ImportEmployees RPC.
+	EmployeeServiceImportEmployeesProcedure = "/altalune.v1.EmployeeService/ImportEmployees"
+	// EmployeeServiceExportEmployeesProcedure is the fully-qualified name of the EmployeeService's
+	// ExportEmployees RPC.
+	EmployeeServiceExportEmployeesProcedure = "/altalune.v1.EmployeeService/ExportEmployees"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	employeeServiceServiceDescriptor              = v1.File_altalune_v1_employee_proto.Services().ByName("EmployeeService")
-	employeeServiceQueryEmployeesMethodDescriptor = employeeServiceServiceDescriptor.Methods().ByName("QueryEmployees")
-	employeeServiceCreateEmployeeMethodDescriptor = employeeServiceServiceDescriptor.Methods().ByName("CreateEmployee")
-	employeeServiceGetEmployeeMethodDescriptor    = employeeServiceServiceDescriptor.Methods().ByName("GetEmployee")
-	employeeServiceUpdateEmployeeMethodDescriptor = employeeServiceServiceDescriptor.Methods().ByName("UpdateEmployee")
-	employeeServiceDeleteEmployeeMethodDescriptor = employeeServiceServiceDescriptor.Methods().ByName("DeleteEmployee")
+	employeeServiceServiceDescriptor               = v1.File_altalune_v1_employee_proto.Services().ByName("EmployeeService")
+	employeeServiceQueryEmployeesMethodDescriptor  = employeeServiceServiceDescriptor.Methods().ByName("QueryEmployees")
+	employeeServiceStreamEmployeesMethodDescriptor = employeeServiceServiceDescriptor.Methods().ByName("StreamEmployees")
+	employeeServiceCreateEmployeeMethodDescriptor  = employeeServiceServiceDescriptor.Methods().ByName("CreateEmployee")
+	employeeServiceGetEmployeeMethodDescriptor     = employeeServiceServiceDescriptor.Methods().ByName("GetEmployee")
+	employeeServiceUpdateEmployeeMethodDescriptor  = employeeServiceServiceDescriptor.Methods().ByName("UpdateEmployee")
+	employeeServiceDeleteEmployeeMethodDescriptor  = employeeServiceServiceDescriptor.Methods().ByName("DeleteEmployee")
+	employeeServiceRestoreEmployeeMethodDescriptor = employeeServiceServiceDescriptor.Methods().ByName("RestoreEmployee")
+	employeeServiceImportEmployeesMethodDescriptor = employeeServiceServiceDescriptor.Methods().ByName("ImportEmployees")
+	employeeServiceExportEmployeesMethodDescriptor = employeeServiceServiceDescriptor.Methods().ByName("ExportEmployees")
 )
 
 // EmployeeServiceClient is a client for the altalune.v1.EmployeeService service.
 type EmployeeServiceClient interface {
 	QueryEmployees(context.Context, *connect.Request[v1.QueryEmployeesRequest]) (*connect.Response[v1.QueryEmployeesResponse], error)
+	StreamEmployees(context.Context, *connect.Request[v1.StreamEmployeesRequest]) (*connect.ServerStreamForClient[v1.StreamEmployeesResponse], error)
 	CreateEmployee(context.Context, *connect.Request[v1.CreateEmployeeRequest]) (*connect.Response[v1.CreateEmployeeResponse], error)
 	GetEmployee(context.Context, *connect.Request[v1.GetEmployeeRequest]) (*connect.Response[v1.GetEmployeeResponse], error)
 	UpdateEmployee(context.Context, *connect.Request[v1.UpdateEmployeeRequest]) (*connect.Response[v1.UpdateEmployeeResponse], error)
 	DeleteEmployee(context.Context, *connect.Request[v1.DeleteEmployeeRequest]) (*connect.Response[v1.DeleteEmployeeResponse], error)
+	RestoreEmployee(context.Context, *connect.Request[v1.RestoreEmployeeRequest]) (*connect.Response[v1.RestoreEmployeeResponse], error)
+	ImportEmployees(context.Context) *connect.ClientStreamForClient[v1.ImportEmployeesRequest, v1.ImportEmployeesResponse]
+	ExportEmployees(context.Context, *connect.Request[v1.ExportEmployeesRequest]) (*connect.ServerStreamForClient[v1.ExportEmployeesResponse], error)
 }
 
 // NewEmployeeServiceClient constructs a client for the altalune.v1.EmployeeService service. By
@@ -85,6 +105,12 @@ func NewEmployeeServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(employeeServiceQueryEmployeesMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		streamEmployees: connect.NewClient[v1.StreamEmployeesRequest, v1.StreamEmployeesResponse](
+			httpClient,
+			baseURL+EmployeeServiceStreamEmployeesProcedure,
+			connect.WithSchema(employeeServiceStreamEmployeesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		createEmployee: connect.NewClient[v1.CreateEmployeeRequest, v1.CreateEmployeeResponse](
 			httpClient,
 			baseURL+EmployeeServiceCreateEmployeeProcedure,
@@ -109,16 +135,38 @@ func NewEmployeeServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(employeeServiceDeleteEmployeeMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		restoreEmployee: connect.NewClient[v1.RestoreEmployeeRequest, v1.RestoreEmployeeResponse](
+			httpClient,
+			baseURL+EmployeeServiceRestoreEmployeeProcedure,
+			connect.WithSchema(employeeServiceRestoreEmployeeMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		importEmployees: connect.NewClient[v1.ImportEmployeesRequest, v1.ImportEmployeesResponse](
+			httpClient,
+			baseURL+EmployeeServiceImportEmployeesProcedure,
+			connect.WithSchema(employeeServiceImportEmployeesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		exportEmployees: connect.NewClient[v1.ExportEmployeesRequest, v1.ExportEmployeesResponse](
+			httpClient,
+			baseURL+EmployeeServiceExportEmployeesProcedure,
+			connect.WithSchema(employeeServiceExportEmployeesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // employeeServiceClient implements EmployeeServiceClient.
 type employeeServiceClient struct {
-	queryEmployees *connect.Client[v1.QueryEmployeesRequest, v1.QueryEmployeesResponse]
-	createEmployee *connect.Client[v1.CreateEmployeeRequest, v1.CreateEmployeeResponse]
-	getEmployee    *connect.Client[v1.GetEmployeeRequest, v1.GetEmployeeResponse]
-	updateEmployee *connect.Client[v1.UpdateEmployeeRequest, v1.UpdateEmployeeResponse]
-	deleteEmployee *connect.Client[v1.DeleteEmployeeRequest, v1.DeleteEmployeeResponse]
+	queryEmployees  *connect.Client[v1.QueryEmployeesRequest, v1.QueryEmployeesResponse]
+	streamEmployees *connect.Client[v1.StreamEmployeesRequest, v1.StreamEmployeesResponse]
+	createEmployee  *connect.Client[v1.CreateEmployeeRequest, v1.CreateEmployeeResponse]
+	getEmployee     *connect.Client[v1.GetEmployeeRequest, v1.GetEmployeeResponse]
+	updateEmployee  *connect.Client[v1.UpdateEmployeeRequest, v1.UpdateEmployeeResponse]
+	deleteEmployee  *connect.Client[v1.DeleteEmployeeRequest, v1.DeleteEmployeeResponse]
+	restoreEmployee *connect.Client[v1.RestoreEmployeeRequest, v1.RestoreEmployeeResponse]
+	importEmployees *connect.Client[v1.ImportEmployeesRequest, v1.ImportEmployeesResponse]
+	exportEmployees *connect.Client[v1.ExportEmployeesRequest, v1.ExportEmployeesResponse]
 }
 
 // QueryEmployees calls altalune.v1.EmployeeService.QueryEmployees.
@@ -126,6 +174,11 @@ func (c *employeeServiceClient) QueryEmployees(ctx context.Context, req *connect
 	return c.queryEmployees.CallUnary(ctx, req)
 }
 
+// StreamEmployees calls altalune.v1.EmployeeService.StreamEmployees.
+func (c *employeeServiceClient) StreamEmployees(ctx context.Context, req *connect.Request[v1.StreamEmployeesRequest]) (*connect.ServerStreamForClient[v1.StreamEmployeesResponse], error) {
+	return c.streamEmployees.CallServerStream(ctx, req)
+}
+
 // CreateEmployee calls altalune.v1.EmployeeService.CreateEmployee.
 func (c *employeeServiceClient) CreateEmployee(ctx context.Context, req *connect.Request[v1.CreateEmployeeRequest]) (*connect.Response[v1.CreateEmployeeResponse], error) {
 	return c.createEmployee.CallUnary(ctx, req)
@@ -146,13 +199,32 @@ func (c *employeeServiceClient) DeleteEmployee(ctx context.Context, req *connect
 	return c.deleteEmployee.CallUnary(ctx, req)
 }
 
+// RestoreEmployee calls altalune.v1.EmployeeService.RestoreEmployee.
+func (c *employeeServiceClient) RestoreEmployee(ctx context.Context, req *connect.Request[v1.RestoreEmployeeRequest]) (*connect.Response[v1.RestoreEmployeeResponse], error) {
+	return c.restoreEmployee.CallUnary(ctx, req)
+}
+
+// ImportEmployees calls altalune.v1.EmployeeService.ImportEmployees.
+func (c *employeeServiceClient) ImportEmployees(ctx context.Context) *connect.ClientStreamForClient[v1.ImportEmployeesRequest, v1.ImportEmployeesResponse] {
+	return c.importEmployees.CallClientStream(ctx)
+}
+
+// ExportEmployees calls altalune.v1.EmployeeService.ExportEmployees.
+func (c *employeeServiceClient) ExportEmployees(ctx context.Context, req *connect.Request[v1.ExportEmployeesRequest]) (*connect.ServerStreamForClient[v1.ExportEmployeesResponse], error) {
+	return c.exportEmployees.CallServerStream(ctx, req)
+}
+
 // EmployeeServiceHandler is an implementation of the altalune.v1.EmployeeService service.
 type EmployeeServiceHandler interface {
 	QueryEmployees(context.Context, *connect.Request[v1.QueryEmployeesRequest]) (*connect.Response[v1.QueryEmployeesResponse], error)
+	StreamEmployees(context.Context, *connect.Request[v1.StreamEmployeesRequest], *connect.ServerStream[v1.StreamEmployeesResponse]) error
 	CreateEmployee(context.Context, *connect.Request[v1.CreateEmployeeRequest]) (*connect.Response[v1.CreateEmployeeResponse], error)
 	GetEmployee(context.Context, *connect.Request[v1.GetEmployeeRequest]) (*connect.Response[v1.GetEmployeeResponse], error)
 	UpdateEmployee(context.Context, *connect.Request[v1.UpdateEmployeeRequest]) (*connect.Response[v1.UpdateEmployeeResponse], error)
 	DeleteEmployee(context.Context, *connect.Request[v1.DeleteEmployeeRequest]) (*connect.Response[v1.DeleteEmployeeResponse], error)
+	RestoreEmployee(context.Context, *connect.Request[v1.RestoreEmployeeRequest]) (*connect.Response[v1.RestoreEmployeeResponse], error)
+	ImportEmployees(context.Context, *connect.ClientStream[v1.ImportEmployeesRequest]) (*connect.Response[v1.ImportEmployeesResponse], error)
+	ExportEmployees(context.Context, *connect.Request[v1.ExportEmployeesRequest], *connect.ServerStream[v1.ExportEmployeesResponse]) error
 }
 
 // NewEmployeeServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -167,6 +239,12 @@ func NewEmployeeServiceHandler(svc EmployeeServiceHandler, opts ...connect.Handl
 		connect.WithSchema(employeeServiceQueryEmployeesMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	employeeServiceStreamEmployeesHandler := connect.NewServerStreamHandler(
+		EmployeeServiceStreamEmployeesProcedure,
+		svc.StreamEmployees,
+		connect.WithSchema(employeeServiceStreamEmployeesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	employeeServiceCreateEmployeeHandler := connect.NewUnaryHandler(
 		EmployeeServiceCreateEmployeeProcedure,
 		svc.CreateEmployee,
@@ -191,10 +269,30 @@ func NewEmployeeServiceHandler(svc EmployeeServiceHandler, opts ...connect.Handl
 		connect.WithSchema(employeeServiceDeleteEmployeeMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	employeeServiceRestoreEmployeeHandler := connect.NewUnaryHandler(
+		EmployeeServiceRestoreEmployeeProcedure,
+		svc.RestoreEmployee,
+		connect.WithSchema(employeeServiceRestoreEmployeeMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	employeeServiceImportEmployeesHandler := connect.NewClientStreamHandler(
+		EmployeeServiceImportEmployeesProcedure,
+		svc.ImportEmployees,
+		connect.WithSchema(employeeServiceImportEmployeesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	employeeServiceExportEmployeesHandler := connect.NewServerStreamHandler(
+		EmployeeServiceExportEmployeesProcedure,
+		svc.ExportEmployees,
+		connect.WithSchema(employeeServiceExportEmployeesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/altalune.v1.EmployeeService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case EmployeeServiceQueryEmployeesProcedure:
 			employeeServiceQueryEmployeesHandler.ServeHTTP(w, r)
+		case EmployeeServiceStreamEmployeesProcedure:
+			employeeServiceStreamEmployeesHandler.ServeHTTP(w, r)
 		case EmployeeServiceCreateEmployeeProcedure:
 			employeeServiceCreateEmployeeHandler.ServeHTTP(w, r)
 		case EmployeeServiceGetEmployeeProcedure:
@@ -203,6 +301,12 @@ func NewEmployeeServiceHandler(svc EmployeeServiceHandler, opts ...connect.Handl
 			employeeServiceUpdateEmployeeHandler.ServeHTTP(w, r)
 		case EmployeeServiceDeleteEmployeeProcedure:
 			employeeServiceDeleteEmployeeHandler.ServeHTTP(w, r)
+		case EmployeeServiceRestoreEmployeeProcedure:
+			employeeServiceRestoreEmployeeHandler.ServeHTTP(w, r)
+		case EmployeeServiceImportEmployeesProcedure:
+			employeeServiceImportEmployeesHandler.ServeHTTP(w, r)
+		case EmployeeServiceExportEmployeesProcedure:
+			employeeServiceExportEmployeesHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -216,6 +320,10 @@ func (UnimplementedEmployeeServiceHandler) QueryEmployees(context.Context, *conn
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.EmployeeService.QueryEmployees is not implemented"))
 }
 
+func (UnimplementedEmployeeServiceHandler) StreamEmployees(context.Context, *connect.Request[v1.StreamEmployeesRequest], *connect.ServerStream[v1.StreamEmployeesResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.EmployeeService.StreamEmployees is not implemented"))
+}
+
 func (UnimplementedEmployeeServiceHandler) CreateEmployee(context.Context, *connect.Request[v1.CreateEmployeeRequest]) (*connect.Response[v1.CreateEmployeeResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.EmployeeService.CreateEmployee is not implemented"))
 }
@@ -231,3 +339,15 @@ func (UnimplementedEmployeeServiceHandler) UpdateEmployee(context.Context, *conn
 func (UnimplementedEmployeeServiceHandler) DeleteEmployee(context.Context, *connect.Request[v1.DeleteEmployeeRequest]) (*connect.Response[v1.DeleteEmployeeResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.EmployeeService.DeleteEmployee is not implemented"))
 }
+
+func (UnimplementedEmployeeServiceHandler) RestoreEmployee(context.Context, *connect.Request[v1.RestoreEmployeeRequest]) (*connect.Response[v1.RestoreEmployeeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.EmployeeService.RestoreEmployee is not implemented"))
+}
+
+func (UnimplementedEmployeeServiceHandler) ImportEmployees(context.Context, *connect.ClientStream[v1.ImportEmployeesRequest]) (*connect.Response[v1.ImportEmployeesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.EmployeeService.ImportEmployees is not implemented"))
+}
+
+func (UnimplementedEmployeeServiceHandler) ExportEmployees(context.Context, *connect.Request[v1.ExportEmployeesRequest], *connect.ServerStream[v1.ExportEmployeesResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.EmployeeService.ExportEmployees is not implemented"))
+}
@@ -70,27 +70,51 @@ const (
 	// IAMMapperServiceGetProjectMembersProcedure is the fully-qualified name of the IAMMapperService's
 	// GetProjectMembers RPC.
 	IAMMapperServiceGetProjectMembersProcedure = "/altalune.v1.IAMMapperService/GetProjectMembers"
+	// IAMMapperServiceInitiateProjectOwnershipTransferProcedure is the fully-qualified name of the
+	// IAMMapperService's InitiateProjectOwnershipTransfer RPC.
+	IAMMapperServiceInitiateProjectOwnershipTransferProcedure = "/altalune.v1.IAMMapperService/InitiateProjectOwnershipTransfer"
+	// IAMMapperServiceGetProjectOwnershipTransferProcedure is the fully-qualified name of the
+	// IAMMapperService's GetProjectOwnershipTransfer RPC.
+	IAMMapperServiceGetProjectOwnershipTransferProcedure = "/altalune.v1.IAMMapperService/GetProjectOwnershipTransfer"
+	// IAMMapperServiceAcceptProjectOwnershipTransferProcedure is the fully-qualified name of the
+	// IAMMapperService's AcceptProjectOwnershipTransfer RPC.
+	IAMMapperServiceAcceptProjectOwnershipTransferProcedure = "/altalune.v1.IAMMapperService/AcceptProjectOwnershipTransfer"
+	// IAMMapperServiceCancelProjectOwnershipTransferProcedure is the fully-qualified name of the
+	// IAMMapperService's CancelProjectOwnershipTransfer RPC.
+	IAMMapperServiceCancelProjectOwnershipTransferProcedure = "/altalune.v1.IAMMapperService/CancelProjectOwnershipTransfer"
 	// IAMMapperServiceGetUserProjectsProcedure is the fully-qualified name of the IAMMapperService's
 	// GetUserProjects RPC.
 	IAMMapperServiceGetUserProjectsProcedure = "/altalune.v1.IAMMapperService/GetUserProjects"
+	// IAMMapperServiceDumpProjectIAMProcedure is the fully-qualified name of the IAMMapperService's
+	// DumpProjectIAM RPC.
+	IAMMapperServiceDumpProjectIAMProcedure = "/altalune.v1.IAMMapperService/DumpProjectIAM"
+	// IAMMapperServiceApplyProjectIAMProcedure is the fully-qualified name of the IAMMapperService's
+	// ApplyProjectIAM RPC.
+	IAMMapperServiceApplyProjectIAMProcedure = "/altalune.v1.IAMMapperService/ApplyProjectIAM"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	iAMMapperServiceServiceDescriptor                     = v1.File_altalune_v1_iam_mapper_proto.Services().ByName("IAMMapperService")
-	iAMMapperServiceAssignUserRolesMethodDescriptor       = iAMMapperServiceServiceDescriptor.Methods().ByName("AssignUserRoles")
-	iAMMapperServiceRemoveUserRolesMethodDescriptor       = iAMMapperServiceServiceDescriptor.Methods().ByName("RemoveUserRoles")
-	iAMMapperServiceGetUserRolesMethodDescriptor          = iAMMapperServiceServiceDescriptor.Methods().ByName("GetUserRoles")
-	iAMMapperServiceAssignRolePermissionsMethodDescriptor = iAMMapperServiceServiceDescriptor.Methods().ByName("AssignRolePermissions")
-	iAMMapperServiceRemoveRolePermissionsMethodDescriptor = iAMMapperServiceServiceDescriptor.Methods().ByName("RemoveRolePermissions")
-	iAMMapperServiceGetRolePermissionsMethodDescriptor    = iAMMapperServiceServiceDescriptor.Methods().ByName("GetRolePermissions")
-	iAMMapperServiceAssignUserPermissionsMethodDescriptor = iAMMapperServiceServiceDescriptor.Methods().ByName("AssignUserPermissions")
-	iAMMapperServiceRemoveUserPermissionsMethodDescriptor = iAMMapperServiceServiceDescriptor.Methods().ByName("RemoveUserPermissions")
-	iAMMapperServiceGetUserPermissionsMethodDescriptor    = iAMMapperServiceServiceDescriptor.Methods().ByName("GetUserPermissions")
-	iAMMapperServiceAssignProjectMembersMethodDescriptor  = iAMMapperServiceServiceDescriptor.Methods().ByName("AssignProjectMembers")
-	iAMMapperServiceRemoveProjectMembersMethodDescriptor  = iAMMapperServiceServiceDescriptor.Methods().ByName("RemoveProjectMembers")
-	iAMMapperServiceGetProjectMembersMethodDescriptor     = iAMMapperServiceServiceDescriptor.Methods().ByName("GetProjectMembers")
-	iAMMapperServiceGetUserProjectsMethodDescriptor       = iAMMapperServiceServiceDescriptor.Methods().ByName("GetUserProjects")
+	iAMMapperServiceServiceDescriptor                                = v1.File_altalune_v1_iam_mapper_proto.Services().ByName("IAMMapperService")
+	iAMMapperServiceAssignUserRolesMethodDescriptor                  = iAMMapperServiceServiceDescriptor.Methods().ByName("AssignUserRoles")
+	iAMMapperServiceRemoveUserRolesMethodDescriptor                  = iAMMapperServiceServiceDescriptor.Methods().ByName("RemoveUserRoles")
+	iAMMapperServiceGetUserRolesMethodDescriptor                     = iAMMapperServiceServiceDescriptor.Methods().ByName("GetUserRoles")
+	iAMMapperServiceAssignRolePermissionsMethodDescriptor            = iAMMapperServiceServiceDescriptor.Methods().ByName("AssignRolePermissions")
+	iAMMapperServiceRemoveRolePermissionsMethodDescriptor            = iAMMapperServiceServiceDescriptor.Methods().ByName("RemoveRolePermissions")
+	iAMMapperServiceGetRolePermissionsMethodDescriptor               = iAMMapperServiceServiceDescriptor.Methods().ByName("GetRolePermissions")
+	iAMMapperServiceAssignUserPermissionsMethodDescriptor            = iAMMapperServiceServiceDescriptor.Methods().ByName("AssignUserPermissions")
+	iAMMapperServiceRemoveUserPermissionsMethodDescriptor            = iAMMapperServiceServiceDescriptor.Methods().ByName("RemoveUserPermissions")
+	iAMMapperServiceGetUserPermissionsMethodDescriptor               = iAMMapperServiceServiceDescriptor.Methods().ByName("GetUserPermissions")
+	iAMMapperServiceAssignProjectMembersMethodDescriptor             = iAMMapperServiceServiceDescriptor.Methods().ByName("AssignProjectMembers")
+	iAMMapperServiceRemoveProjectMembersMethodDescriptor             = iAMMapperServiceServiceDescriptor.Methods().ByName("RemoveProjectMembers")
+	iAMMapperServiceGetProjectMembersMethodDescriptor                = iAMMapperServiceServiceDescriptor.Methods().ByName("GetProjectMembers")
+	iAMMapperServiceInitiateProjectOwnershipTransferMethodDescriptor = iAMMapperServiceServiceDescriptor.Methods().ByName("InitiateProjectOwnershipTransfer")
+	iAMMapperServiceGetProjectOwnershipTransferMethodDescriptor      = iAMMapperServiceServiceDescriptor.Methods().ByName("GetProjectOwnershipTransfer")
+	iAMMapperServiceAcceptProjectOwnershipTransferMethodDescriptor   = iAMMapperServiceServiceDescriptor.Methods().ByName("AcceptProjectOwnershipTransfer")
+	iAMMapperServiceCancelProjectOwnershipTransferMethodDescriptor   = iAMMapperServiceServiceDescriptor.Methods().ByName("CancelProjectOwnershipTransfer")
+	iAMMapperServiceGetUserProjectsMethodDescriptor                  = iAMMapperServiceServiceDescriptor.Methods().ByName("GetUserProjects")
+	iAMMapperServiceDumpProjectIAMMethodDescriptor                   = iAMMapperServiceServiceDescriptor.Methods().ByName("DumpProjectIAM")
+	iAMMapperServiceApplyProjectIAMMethodDescriptor                  = iAMMapperServiceServiceDescriptor.Methods().ByName("ApplyProjectIAM")
 )
 
 // IAMMapperServiceClient is a client for the altalune.v1.IAMMapperService service.
@@ -111,8 +135,18 @@ type IAMMapperServiceClient interface {
 	AssignProjectMembers(context.Context, *connect.Request[v1.AssignProjectMembersRequest]) (*connect.Response[emptypb.Empty], error)
 	RemoveProjectMembers(context.Context, *connect.Request[v1.RemoveProjectMembersRequest]) (*connect.Response[emptypb.Empty], error)
 	GetProjectMembers(context.Context, *connect.Request[v1.GetProjectMembersRequest]) (*connect.Response[v1.GetProjectMembersResponse], error)
+	// Project Ownership Transfers. Accepting and cancelling is checked against
+	// the parties of the transfer rather than a permission.
+	InitiateProjectOwnershipTransfer(context.Context, *connect.Request[v1.InitiateProjectOwnershipTransferRequest]) (*connect.Response[v1.InitiateProjectOwnershipTransferResponse], error)
+	GetProjectOwnershipTransfer(context.Context, *connect.Request[v1.GetProjectOwnershipTransferRequest]) (*connect.Response[v1.GetProjectOwnershipTransferResponse], error)
+	AcceptProjectOwnershipTransfer(context.Context, *connect.Request[v1.AcceptProjectOwnershipTransferRequest]) (*connect.Response[v1.AcceptProjectOwnershipTransferResponse], error)
+	CancelProjectOwnershipTransfer(context.Context, *connect.Request[v1.CancelProjectOwnershipTransferRequest]) (*connect.Response[emptypb.Empty], error)
 	// User Projects (reverse lookup - projects a user belongs to)
 	GetUserProjects(context.Context, *connect.Request[v1.GetUserProjectsRequest]) (*connect.Response[v1.GetUserProjectsResponse], error)
+	// IAM Snapshots. Roles and permissions are global: applying a snapshot
+	// also changes them for the other projects whose members hold them.
+	DumpProjectIAM(context.Context, *connect.Request[v1.DumpProjectIAMRequest]) (*connect.Response[v1.DumpProjectIAMResponse], error)
+	ApplyProjectIAM(context.Context, *connect.Request[v1.ApplyProjectIAMRequest]) (*connect.Response[v1.ApplyProjectIAMResponse], error)
 }
 
 // NewIAMMapperServiceClient constructs a client for the altalune.v1.IAMMapperService service. By
@@ -197,30 +231,72 @@ func NewIAMMapperServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(iAMMapperServiceGetProjectMembersMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		initiateProjectOwnershipTransfer: connect.NewClient[v1.InitiateProjectOwnershipTransferRequest, v1.InitiateProjectOwnershipTransferResponse](
+			httpClient,
+			baseURL+IAMMapperServiceInitiateProjectOwnershipTransferProcedure,
+			connect.WithSchema(iAMMapperServiceInitiateProjectOwnershipTransferMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getProjectOwnershipTransfer: connect.NewClient[v1.GetProjectOwnershipTransferRequest, v1.GetProjectOwnershipTransferResponse](
+			httpClient,
+			baseURL+IAMMapperServiceGetProjectOwnershipTransferProcedure,
+			connect.WithSchema(iAMMapperServiceGetProjectOwnershipTransferMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		acceptProjectOwnershipTransfer: connect.NewClient[v1.AcceptProjectOwnershipTransferRequest, v1.AcceptProjectOwnershipTransferResponse](
+			httpClient,
+			baseURL+IAMMapperServiceAcceptProjectOwnershipTransferProcedure,
+			connect.WithSchema(iAMMapperServiceAcceptProjectOwnershipTransferMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		cancelProjectOwnershipTransfer: connect.NewClient[v1.CancelProjectOwnershipTransferRequest, emptypb.Empty](
+			httpClient,
+			baseURL+IAMMapperServiceCancelProjectOwnershipTransferProcedure,
+			connect.WithSchema(iAMMapperServiceCancelProjectOwnershipTransferMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		getUserProjects: connect.NewClient[v1.GetUserProjectsRequest, v1.GetUserProjectsResponse](
 			httpClient,
 			baseURL+IAMMapperServiceGetUserProjectsProcedure,
 			connect.WithSchema(iAMMapperServiceGetUserProjectsMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		dumpProjectIAM: connect.NewClient[v1.DumpProjectIAMRequest, v1.DumpProjectIAMResponse](
+			httpClient,
+			baseURL+IAMMapperServiceDumpProjectIAMProcedure,
+			connect.WithSchema(iAMMapperServiceDumpProjectIAMMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		applyProjectIAM: connect.NewClient[v1.ApplyProjectIAMRequest, v1.ApplyProjectIAMResponse](
+			httpClient,
+			baseURL+IAMMapperServiceApplyProjectIAMProcedure,
+			connect.WithSchema(iAMMapperServiceApplyProjectIAMMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // iAMMapperServiceClient implements IAMMapperServiceClient.
 type iAMMapperServiceClient struct {
-	assignUserRoles       *connect.Client[v1.AssignUserRolesRequest, emptypb.Empty]
-	removeUserRoles       *connect.Client[v1.RemoveUserRolesRequest, emptypb.Empty]
-	getUserRoles          *connect.Client[v1.GetUserRolesRequest, v1.GetUserRolesResponse]
-	assignRolePermissions *connect.Client[v1.AssignRolePermissionsRequest, emptypb.Empty]
-	removeRolePermissions *connect.Client[v1.RemoveRolePermissionsRequest, emptypb.Empty]
-	getRolePermissions    *connect.Client[v1.GetRolePermissionsRequest, v1.GetRolePermissionsResponse]
-	assignUserPermissions *connect.Client[v1.AssignUserPermissionsRequest, emptypb.Empty]
-	removeUserPermissions *connect.Client[v1.RemoveUserPermissionsRequest, emptypb.Empty]
-	getUserPermissions    *connect.Client[v1.GetUserPermissionsRequest, v1.GetUserPermissionsResponse]
-	assignProjectMembers  *connect.Client[v1.AssignProjectMembersRequest, emptypb.Empty]
-	removeProjectMembers  *connect.Client[v1.RemoveProjectMembersRequest, emptypb.Empty]
-	getProjectMembers     *connect.Client[v1.GetProjectMembersRequest, v1.GetProjectMembersResponse]
-	getUserProjects       *connect.Client[v1.GetUserProjectsRequest, v1.GetUserProjectsResponse]
+	assignUserRoles                  *connect.Client[v1.AssignUserRolesRequest, emptypb.Empty]
+	removeUserRoles                  *connect.Client[v1.RemoveUserRolesRequest, emptypb.Empty]
+	getUserRoles                     *connect.Client[v1.GetUserRolesRequest, v1.GetUserRolesResponse]
+	assignRolePermissions            *connect.Client[v1.AssignRolePermissionsRequest, emptypb.Empty]
+	removeRolePermissions            *connect.Client[v1.RemoveRolePermissionsRequest, emptypb.Empty]
+	getRolePermissions               *connect.Client[v1.GetRolePermissionsRequest, v1.GetRolePermissionsResponse]
+	assignUserPermissions            *connect.Client[v1.AssignUserPermissionsRequest, emptypb.Empty]
+	removeUserPermissions            *connect.Client[v1.RemoveUserPermissionsRequest, emptypb.Empty]
+	getUserPermissions               *connect.Client[v1.GetUserPermissionsRequest, v1.GetUserPermissionsResponse]
+	assignProjectMembers             *connect.Client[v1.AssignProjectMembersRequest, emptypb.Empty]
+	removeProjectMembers             *connect.Client[v1.RemoveProjectMembersRequest, emptypb.Empty]
+	getProjectMembers                *connect.Client[v1.GetProjectMembersRequest, v1.GetProjectMembersResponse]
+	initiateProjectOwnershipTransfer *connect.Client[v1.InitiateProjectOwnershipTransferRequest, v1.InitiateProjectOwnershipTransferResponse]
+	getProjectOwnershipTransfer      *connect.Client[v1.GetProjectOwnershipTransferRequest, v1.GetProjectOwnershipTransferResponse]
+	acceptProjectOwnershipTransfer   *connect.Client[v1.AcceptProjectOwnershipTransferRequest, v1.AcceptProjectOwnershipTransferResponse]
+	cancelProjectOwnershipTransfer   *connect.Client[v1.CancelProjectOwnershipTransferRequest, emptypb.Empty]
+	getUserProjects                  *connect.Client[v1.GetUserProjectsRequest, v1.GetUserProjectsResponse]
+	dumpProjectIAM                   *connect.Client[v1.DumpProjectIAMRequest, v1.DumpProjectIAMResponse]
+	applyProjectIAM                  *connect.Client[v1.ApplyProjectIAMRequest, v1.ApplyProjectIAMResponse]
 }
 
 // AssignUserRoles calls altalune.v1.IAMMapperService.AssignUserRoles.
@@ -283,11 +359,42 @@ func (c *iAMMapperServiceClient) GetProjectMembers(ctx context.Context, req *con
 	return c.getProjectMembers.CallUnary(ctx, req)
 }
 
+// InitiateProjectOwnershipTransfer calls
+// altalune.v1.IAMMapperService.InitiateProjectOwnershipTransfer.
+func (c *iAMMapperServiceClient) InitiateProjectOwnershipTransfer(ctx context.Context, req *connect.Request[v1.InitiateProjectOwnershipTransferRequest]) (*connect.Response[v1.InitiateProjectOwnershipTransferResponse], error) {
+	return c.initiateProjectOwnershipTransfer.CallUnary(ctx, req)
+}
+
+// GetProjectOwnershipTransfer calls altalune.v1.IAMMapperService.GetProjectOwnershipTransfer.
+func (c *iAMMapperServiceClient) GetProjectOwnershipTransfer(ctx context.Context, req *connect.Request[v1.GetProjectOwnershipTransferRequest]) (*connect.Response[v1.GetProjectOwnershipTransferResponse], error) {
+	return c.getProjectOwnershipTransfer.CallUnary(ctx, req)
+}
+
+// AcceptProjectOwnershipTransfer calls altalune.v1.IAMMapperService.AcceptProjectOwnershipTransfer.
+func (c *iAMMapperServiceClient) AcceptProjectOwnershipTransfer(ctx context.Context, req *connect.Request[v1.AcceptProjectOwnershipTransferRequest]) (*connect.Response[v1.AcceptProjectOwnershipTransferResponse], error) {
+	return c.acceptProjectOwnershipTransfer.CallUnary(ctx, req)
+}
+
+// CancelProjectOwnershipTransfer calls altalune.v1.IAMMapperService.CancelProjectOwnershipTransfer.
+func (c *iAMMapperServiceClient) CancelProjectOwnershipTransfer(ctx context.Context, req *connect.Request[v1.CancelProjectOwnershipTransferRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.cancelProjectOwnershipTransfer.CallUnary(ctx, req)
+}
+
 // GetUserProjects calls altalune.v1.IAMMapperService.GetUserProjects.
 func (c *iAMMapperServiceClient) GetUserProjects(ctx context.Context, req *connect.Request[v1.GetUserProjectsRequest]) (*connect.Response[v1.GetUserProjectsResponse], error) {
 	return c.getUserProjects.CallUnary(ctx, req)
 }
 
+// DumpProjectIAM calls altalune.v1.IAMMapperService.DumpProjectIAM.
+func (c *iAMMapperServiceClient) DumpProjectIAM(ctx context.Context, req *connect.Request[v1.DumpProjectIAMRequest]) (*connect.Response[v1.DumpProjectIAMResponse], error) {
+	return c.dumpProjectIAM.CallUnary(ctx, req)
+}
+
+// ApplyProjectIAM calls altalune.v1.IAMMapperService.ApplyProjectIAM.
+func (c *iAMMapperServiceClient) ApplyProjectIAM(ctx context.Context, req *connect.Request[v1.ApplyProjectIAMRequest]) (*connect.Response[v1.ApplyProjectIAMResponse], error) {
+	return c.applyProjectIAM.CallUnary(ctx, req)
+}
+
 // IAMMapperServiceHandler is an implementation of the altalune.v1.IAMMapperService service.
 type IAMMapperServiceHandler interface {
 	// User-Role Mappings
@@ -306,8 +413,18 @@ type IAMMapperServiceHandler interface {
 	AssignProjectMembers(context.Context, *connect.Request[v1.AssignProjectMembersRequest]) (*connect.Response[emptypb.Empty], error)
 	RemoveProjectMembers(context.Context, *connect.Request[v1.RemoveProjectMembersRequest]) (*connect.Response[emptypb.Empty], error)
 	GetProjectMembers(context.Context, *connect.Request[v1.GetProjectMembersRequest]) (*connect.Response[v1.GetProjectMembersResponse], error)
+	// Project Ownership Transfers. Accepting and cancelling is checked against
+	// the parties of the transfer rather than a permission.
+	InitiateProjectOwnershipTransfer(context.Context, *connect.Request[v1.InitiateProjectOwnershipTransferRequest]) (*connect.Response[v1.InitiateProjectOwnershipTransferResponse], error)
+	GetProjectOwnershipTransfer(context.Context, *connect.Request[v1.GetProjectOwnershipTransferRequest]) (*connect.Response[v1.GetProjectOwnershipTransferResponse], error)
+	AcceptProjectOwnershipTransfer(context.Context, *connect.Request[v1.AcceptProjectOwnershipTransferRequest]) (*connect.Response[v1.AcceptProjectOwnershipTransferResponse], error)
+	CancelProjectOwnershipTransfer(context.Context, *connect.Request[v1.CancelProjectOwnershipTransferRequest]) (*connect.Response[emptypb.Empty], error)
 	// User Projects (reverse lookup - projects a user belongs to)
 	GetUserProjects(context.Context, *connect.Request[v1.GetUserProjectsRequest]) (*connect.Response[v1.GetUserProjectsResponse], error)
+	// IAM Snapshots. Roles and permissions are global: applying a snapshot
+	// also changes them for the other projects whose members hold them.
+	DumpProjectIAM(context.Context, *connect.Request[v1.DumpProjectIAMRequest]) (*connect.Response[v1.DumpProjectIAMResponse], error)
+	ApplyProjectIAM(context.Context, *connect.Request[v1.ApplyProjectIAMRequest]) (*connect.Response[v1.ApplyProjectIAMResponse], error)
 }
 
 // NewIAMMapperServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -388,12 +505,48 @@ func NewIAMMapperServiceHandler(svc IAMMapperServiceHandler, opts ...connect.Han
 		connect.WithSchema(iAMMapperServiceGetProjectMembersMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	iAMMapperServiceInitiateProjectOwnershipTransferHandler := connect.NewUnaryHandler(
+		IAMMapperServiceInitiateProjectOwnershipTransferProcedure,
+		svc.InitiateProjectOwnershipTransfer,
+		connect.WithSchema(iAMMapperServiceInitiateProjectOwnershipTransferMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	iAMMapperServiceGetProjectOwnershipTransferHandler := connect.NewUnaryHandler(
+		IAMMapperServiceGetProjectOwnershipTransferProcedure,
+		svc.GetProjectOwnershipTransfer,
+		connect.WithSchema(iAMMapperServiceGetProjectOwnershipTransferMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	iAMMapperServiceAcceptProjectOwnershipTransferHandler := connect.NewUnaryHandler(
+		IAMMapperServiceAcceptProjectOwnershipTransferProcedure,
+		svc.AcceptProjectOwnershipTransfer,
+		connect.WithSchema(iAMMapperServiceAcceptProjectOwnershipTransferMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	iAMMapperServiceCancelProjectOwnershipTransferHandler := connect.NewUnaryHandler(
+		IAMMapperServiceCancelProjectOwnershipTransferProcedure,
+		svc.CancelProjectOwnershipTransfer,
+		connect.WithSchema(iAMMapperServiceCancelProjectOwnershipTransferMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	iAMMapperServiceGetUserProjectsHandler := connect.NewUnaryHandler(
 		IAMMapperServiceGetUserProjectsProcedure,
 		svc.GetUserProjects,
 		connect.WithSchema(iAMMapperServiceGetUserProjectsMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	iAMMapperServiceDumpProjectIAMHandler := connect.NewUnaryHandler(
+		IAMMapperServiceDumpProjectIAMProcedure,
+		svc.DumpProjectIAM,
+		connect.WithSchema(iAMMapperServiceDumpProjectIAMMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	iAMMapperServiceApplyProjectIAMHandler := connect.NewUnaryHandler(
+		IAMMapperServiceApplyProjectIAMProcedure,
+		svc.ApplyProjectIAM,
+		connect.WithSchema(iAMMapperServiceApplyProjectIAMMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/altalune.v1.IAMMapperService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case IAMMapperServiceAssignUserRolesProcedure:
@@ -420,8 +573,20 @@ func NewIAMMapperServiceHandler(svc IAMMapperServiceHandler, opts ...connect.Han
 			iAMMapperServiceRemoveProjectMembersHandler.ServeHTTP(w, r)
 		case IAMMapperServiceGetProjectMembersProcedure:
 			iAMMapperServiceGetProjectMembersHandler.ServeHTTP(w, r)
+		case IAMMapperServiceInitiateProjectOwnershipTransferProcedure:
+			iAMMapperServiceInitiateProjectOwnershipTransferHandler.ServeHTTP(w, r)
+		case IAMMapperServiceGetProjectOwnershipTransferProcedure:
+			iAMMapperServiceGetProjectOwnershipTransferHandler.ServeHTTP(w, r)
+		case IAMMapperServiceAcceptProjectOwnershipTransferProcedure:
+			iAMMapperServiceAcceptProjectOwnershipTransferHandler.ServeHTTP(w, r)
+		case IAMMapperServiceCancelProjectOwnershipTransferProcedure:
+			iAMMapperServiceCancelProjectOwnershipTransferHandler.ServeHTTP(w, r)
 		case IAMMapperServiceGetUserProjectsProcedure:
 			iAMMapperServiceGetUserProjectsHandler.ServeHTTP(w, r)
+		case IAMMapperServiceDumpProjectIAMProcedure:
+			iAMMapperServiceDumpProjectIAMHandler.ServeHTTP(w, r)
+		case IAMMapperServiceApplyProjectIAMProcedure:
+			iAMMapperServiceApplyProjectIAMHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -479,6 +644,30 @@ func (UnimplementedIAMMapperServiceHandler) GetProjectMembers(context.Context, *
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.IAMMapperService.GetProjectMembers is not implemented"))
 }
 
+func (UnimplementedIAMMapperServiceHandler) InitiateProjectOwnershipTransfer(context.Context, *connect.Request[v1.InitiateProjectOwnershipTransferRequest]) (*connect.Response[v1.InitiateProjectOwnershipTransferResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.IAMMapperService.InitiateProjectOwnershipTransfer is not implemented"))
+}
+
+func (UnimplementedIAMMapperServiceHandler) GetProjectOwnershipTransfer(context.Context, *connect.Request[v1.GetProjectOwnershipTransferRequest]) (*connect.Response[v1.GetProjectOwnershipTransferResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.IAMMapperService.GetProjectOwnershipTransfer is not implemented"))
+}
+
+func (UnimplementedIAMMapperServiceHandler) AcceptProjectOwnershipTransfer(context.Context, *connect.Request[v1.AcceptProjectOwnershipTransferRequest]) (*connect.Response[v1.AcceptProjectOwnershipTransferResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.IAMMapperService.AcceptProjectOwnershipTransfer is not implemented"))
+}
+
+func (UnimplementedIAMMapperServiceHandler) CancelProjectOwnershipTransfer(context.Context, *connect.Request[v1.CancelProjectOwnershipTransferRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.IAMMapperService.CancelProjectOwnershipTransfer is not implemented"))
+}
+
 func (UnimplementedIAMMapperServiceHandler) GetUserProjects(context.Context, *connect.Request[v1.GetUserProjectsRequest]) (*connect.Response[v1.GetUserProjectsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.IAMMapperService.GetUserProjects is not implemented"))
 }
+
+func (UnimplementedIAMMapperServiceHandler) DumpProjectIAM(context.Context, *connect.Request[v1.DumpProjectIAMRequest]) (*connect.Response[v1.DumpProjectIAMResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.IAMMapperService.DumpProjectIAM is not implemented"))
+}
+
+func (UnimplementedIAMMapperServiceHandler) ApplyProjectIAM(context.Context, *connect.Request[v1.ApplyProjectIAMRequest]) (*connect.Response[v1.ApplyProjectIAMResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.IAMMapperService.ApplyProjectIAM is not implemented"))
+}
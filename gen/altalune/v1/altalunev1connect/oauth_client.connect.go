@@ -48,20 +48,60 @@ const (
 	// OAuthClientServiceDeleteOAuthClientProcedure is the fully-qualified name of the
 	// OAuthClientService's DeleteOAuthClient RPC.
 	OAuthClientServiceDeleteOAuthClientProcedure = "/altalune.v1.OAuthClientService/DeleteOAuthClient"
+	// OAuthClientServiceRestoreOAuthClientProcedure is the fully-qualified name of the
+	// OAuthClientService's RestoreOAuthClient RPC.
+	OAuthClientServiceRestoreOAuthClientProcedure = "/altalune.v1.OAuthClientService/RestoreOAuthClient"
 	// OAuthClientServiceRevealOAuthClientSecretProcedure is the fully-qualified name of the
 	// OAuthClientService's RevealOAuthClientSecret RPC.
 	OAuthClientServiceRevealOAuthClientSecretProcedure = "/altalune.v1.OAuthClientService/RevealOAuthClientSecret"
+	// OAuthClientServiceRotateOAuthClientSecretProcedure is the fully-qualified name of the
+	// OAuthClientService's RotateOAuthClientSecret RPC.
+	OAuthClientServiceRotateOAuthClientSecretProcedure = "/altalune.v1.OAuthClientService/RotateOAuthClientSecret"
+	// OAuthClientServiceQueryRefreshTokensProcedure is the fully-qualified name of the
+	// OAuthClientService's QueryRefreshTokens RPC.
+	OAuthClientServiceQueryRefreshTokensProcedure = "/altalune.v1.OAuthClientService/QueryRefreshTokens"
+	// OAuthClientServiceRevokeRefreshTokenProcedure is the fully-qualified name of the
+	// OAuthClientService's RevokeRefreshToken RPC.
+	OAuthClientServiceRevokeRefreshTokenProcedure = "/altalune.v1.OAuthClientService/RevokeRefreshToken"
+	// OAuthClientServiceGetOAuthClientTokenStatsProcedure is the fully-qualified name of the
+	// OAuthClientService's GetOAuthClientTokenStats RPC.
+	OAuthClientServiceGetOAuthClientTokenStatsProcedure = "/altalune.v1.OAuthClientService/GetOAuthClientTokenStats"
+	// OAuthClientServiceGetOAuthClientTokenClaimsProcedure is the fully-qualified name of the
+	// OAuthClientService's GetOAuthClientTokenClaims RPC.
+	OAuthClientServiceGetOAuthClientTokenClaimsProcedure = "/altalune.v1.OAuthClientService/GetOAuthClientTokenClaims"
+	// OAuthClientServiceUpdateOAuthClientTokenClaimsProcedure is the fully-qualified name of the
+	// OAuthClientService's UpdateOAuthClientTokenClaims RPC.
+	OAuthClientServiceUpdateOAuthClientTokenClaimsProcedure = "/altalune.v1.OAuthClientService/UpdateOAuthClientTokenClaims"
+	// OAuthClientServiceApplyOAuthClientProcedure is the fully-qualified name of the
+	// OAuthClientService's ApplyOAuthClient RPC.
+	OAuthClientServiceApplyOAuthClientProcedure = "/altalune.v1.OAuthClientService/ApplyOAuthClient"
+	// OAuthClientServiceImportOAuthClientProcedure is the fully-qualified name of the
+	// OAuthClientService's ImportOAuthClient RPC.
+	OAuthClientServiceImportOAuthClientProcedure = "/altalune.v1.OAuthClientService/ImportOAuthClient"
+	// OAuthClientServiceTestAuthorizationFlowProcedure is the fully-qualified name of the
+	// OAuthClientService's TestAuthorizationFlow RPC.
+	OAuthClientServiceTestAuthorizationFlowProcedure = "/altalune.v1.OAuthClientService/TestAuthorizationFlow"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	oAuthClientServiceServiceDescriptor                       = v1.File_altalune_v1_oauth_client_proto.Services().ByName("OAuthClientService")
-	oAuthClientServiceCreateOAuthClientMethodDescriptor       = oAuthClientServiceServiceDescriptor.Methods().ByName("CreateOAuthClient")
-	oAuthClientServiceQueryOAuthClientsMethodDescriptor       = oAuthClientServiceServiceDescriptor.Methods().ByName("QueryOAuthClients")
-	oAuthClientServiceGetOAuthClientMethodDescriptor          = oAuthClientServiceServiceDescriptor.Methods().ByName("GetOAuthClient")
-	oAuthClientServiceUpdateOAuthClientMethodDescriptor       = oAuthClientServiceServiceDescriptor.Methods().ByName("UpdateOAuthClient")
-	oAuthClientServiceDeleteOAuthClientMethodDescriptor       = oAuthClientServiceServiceDescriptor.Methods().ByName("DeleteOAuthClient")
-	oAuthClientServiceRevealOAuthClientSecretMethodDescriptor = oAuthClientServiceServiceDescriptor.Methods().ByName("RevealOAuthClientSecret")
+	oAuthClientServiceServiceDescriptor                            = v1.File_altalune_v1_oauth_client_proto.Services().ByName("OAuthClientService")
+	oAuthClientServiceCreateOAuthClientMethodDescriptor            = oAuthClientServiceServiceDescriptor.Methods().ByName("CreateOAuthClient")
+	oAuthClientServiceQueryOAuthClientsMethodDescriptor            = oAuthClientServiceServiceDescriptor.Methods().ByName("QueryOAuthClients")
+	oAuthClientServiceGetOAuthClientMethodDescriptor               = oAuthClientServiceServiceDescriptor.Methods().ByName("GetOAuthClient")
+	oAuthClientServiceUpdateOAuthClientMethodDescriptor            = oAuthClientServiceServiceDescriptor.Methods().ByName("UpdateOAuthClient")
+	oAuthClientServiceDeleteOAuthClientMethodDescriptor            = oAuthClientServiceServiceDescriptor.Methods().ByName("DeleteOAuthClient")
+	oAuthClientServiceRestoreOAuthClientMethodDescriptor           = oAuthClientServiceServiceDescriptor.Methods().ByName("RestoreOAuthClient")
+	oAuthClientServiceRevealOAuthClientSecretMethodDescriptor      = oAuthClientServiceServiceDescriptor.Methods().ByName("RevealOAuthClientSecret")
+	oAuthClientServiceRotateOAuthClientSecretMethodDescriptor      = oAuthClientServiceServiceDescriptor.Methods().ByName("RotateOAuthClientSecret")
+	oAuthClientServiceQueryRefreshTokensMethodDescriptor           = oAuthClientServiceServiceDescriptor.Methods().ByName("QueryRefreshTokens")
+	oAuthClientServiceRevokeRefreshTokenMethodDescriptor           = oAuthClientServiceServiceDescriptor.Methods().ByName("RevokeRefreshToken")
+	oAuthClientServiceGetOAuthClientTokenStatsMethodDescriptor     = oAuthClientServiceServiceDescriptor.Methods().ByName("GetOAuthClientTokenStats")
+	oAuthClientServiceGetOAuthClientTokenClaimsMethodDescriptor    = oAuthClientServiceServiceDescriptor.Methods().ByName("GetOAuthClientTokenClaims")
+	oAuthClientServiceUpdateOAuthClientTokenClaimsMethodDescriptor = oAuthClientServiceServiceDescriptor.Methods().ByName("UpdateOAuthClientTokenClaims")
+	oAuthClientServiceApplyOAuthClientMethodDescriptor             = oAuthClientServiceServiceDescriptor.Methods().ByName("ApplyOAuthClient")
+	oAuthClientServiceImportOAuthClientMethodDescriptor            = oAuthClientServiceServiceDescriptor.Methods().ByName("ImportOAuthClient")
+	oAuthClientServiceTestAuthorizationFlowMethodDescriptor        = oAuthClientServiceServiceDescriptor.Methods().ByName("TestAuthorizationFlow")
 )
 
 // OAuthClientServiceClient is a client for the altalune.v1.OAuthClientService service.
@@ -71,7 +111,22 @@ type OAuthClientServiceClient interface {
 	GetOAuthClient(context.Context, *connect.Request[v1.GetOAuthClientRequest]) (*connect.Response[v1.GetOAuthClientResponse], error)
 	UpdateOAuthClient(context.Context, *connect.Request[v1.UpdateOAuthClientRequest]) (*connect.Response[v1.UpdateOAuthClientResponse], error)
 	DeleteOAuthClient(context.Context, *connect.Request[v1.DeleteOAuthClientRequest]) (*connect.Response[v1.DeleteOAuthClientResponse], error)
+	RestoreOAuthClient(context.Context, *connect.Request[v1.RestoreOAuthClientRequest]) (*connect.Response[v1.RestoreOAuthClientResponse], error)
 	RevealOAuthClientSecret(context.Context, *connect.Request[v1.RevealOAuthClientSecretRequest]) (*connect.Response[v1.RevealOAuthClientSecretResponse], error)
+	// Replace the secret of a confidential client, handed over like on creation
+	RotateOAuthClientSecret(context.Context, *connect.Request[v1.RotateOAuthClientSecretRequest]) (*connect.Response[v1.RotateOAuthClientSecretResponse], error)
+	QueryRefreshTokens(context.Context, *connect.Request[v1.QueryRefreshTokensRequest]) (*connect.Response[v1.QueryRefreshTokensResponse], error)
+	RevokeRefreshToken(context.Context, *connect.Request[v1.RevokeRefreshTokenRequest]) (*connect.Response[v1.RevokeRefreshTokenResponse], error)
+	GetOAuthClientTokenStats(context.Context, *connect.Request[v1.GetOAuthClientTokenStatsRequest]) (*connect.Response[v1.GetOAuthClientTokenStatsResponse], error)
+	GetOAuthClientTokenClaims(context.Context, *connect.Request[v1.GetOAuthClientTokenClaimsRequest]) (*connect.Response[v1.GetOAuthClientTokenClaimsResponse], error)
+	UpdateOAuthClientTokenClaims(context.Context, *connect.Request[v1.UpdateOAuthClientTokenClaimsRequest]) (*connect.Response[v1.UpdateOAuthClientTokenClaimsResponse], error)
+	// Create-or-update by external ID, for declarative provisioning
+	ApplyOAuthClient(context.Context, *connect.Request[v1.ApplyOAuthClientRequest]) (*connect.Response[v1.ApplyOAuthClientResponse], error)
+	// Bring an existing client under an external ID, found by name
+	ImportOAuthClient(context.Context, *connect.Request[v1.ImportOAuthClientRequest]) (*connect.Response[v1.ImportOAuthClientResponse], error)
+	// Walk a synthetic user of a sandbox project through the authorization code
+	// flow of a client, without a browser, reporting the outcome of each step
+	TestAuthorizationFlow(context.Context, *connect.Request[v1.TestAuthorizationFlowRequest]) (*connect.Response[v1.TestAuthorizationFlowResponse], error)
 }
 
 // NewOAuthClientServiceClient constructs a client for the altalune.v1.OAuthClientService service.
@@ -114,23 +169,93 @@ func NewOAuthClientServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(oAuthClientServiceDeleteOAuthClientMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		restoreOAuthClient: connect.NewClient[v1.RestoreOAuthClientRequest, v1.RestoreOAuthClientResponse](
+			httpClient,
+			baseURL+OAuthClientServiceRestoreOAuthClientProcedure,
+			connect.WithSchema(oAuthClientServiceRestoreOAuthClientMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		revealOAuthClientSecret: connect.NewClient[v1.RevealOAuthClientSecretRequest, v1.RevealOAuthClientSecretResponse](
 			httpClient,
 			baseURL+OAuthClientServiceRevealOAuthClientSecretProcedure,
 			connect.WithSchema(oAuthClientServiceRevealOAuthClientSecretMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		rotateOAuthClientSecret: connect.NewClient[v1.RotateOAuthClientSecretRequest, v1.RotateOAuthClientSecretResponse](
+			httpClient,
+			baseURL+OAuthClientServiceRotateOAuthClientSecretProcedure,
+			connect.WithSchema(oAuthClientServiceRotateOAuthClientSecretMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		queryRefreshTokens: connect.NewClient[v1.QueryRefreshTokensRequest, v1.QueryRefreshTokensResponse](
+			httpClient,
+			baseURL+OAuthClientServiceQueryRefreshTokensProcedure,
+			connect.WithSchema(oAuthClientServiceQueryRefreshTokensMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		revokeRefreshToken: connect.NewClient[v1.RevokeRefreshTokenRequest, v1.RevokeRefreshTokenResponse](
+			httpClient,
+			baseURL+OAuthClientServiceRevokeRefreshTokenProcedure,
+			connect.WithSchema(oAuthClientServiceRevokeRefreshTokenMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getOAuthClientTokenStats: connect.NewClient[v1.GetOAuthClientTokenStatsRequest, v1.GetOAuthClientTokenStatsResponse](
+			httpClient,
+			baseURL+OAuthClientServiceGetOAuthClientTokenStatsProcedure,
+			connect.WithSchema(oAuthClientServiceGetOAuthClientTokenStatsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getOAuthClientTokenClaims: connect.NewClient[v1.GetOAuthClientTokenClaimsRequest, v1.GetOAuthClientTokenClaimsResponse](
+			httpClient,
+			baseURL+OAuthClientServiceGetOAuthClientTokenClaimsProcedure,
+			connect.WithSchema(oAuthClientServiceGetOAuthClientTokenClaimsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateOAuthClientTokenClaims: connect.NewClient[v1.UpdateOAuthClientTokenClaimsRequest, v1.UpdateOAuthClientTokenClaimsResponse](
+			httpClient,
+			baseURL+OAuthClientServiceUpdateOAuthClientTokenClaimsProcedure,
+			connect.WithSchema(oAuthClientServiceUpdateOAuthClientTokenClaimsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		applyOAuthClient: connect.NewClient[v1.ApplyOAuthClientRequest, v1.ApplyOAuthClientResponse](
+			httpClient,
+			baseURL+OAuthClientServiceApplyOAuthClientProcedure,
+			connect.WithSchema(oAuthClientServiceApplyOAuthClientMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		importOAuthClient: connect.NewClient[v1.ImportOAuthClientRequest, v1.ImportOAuthClientResponse](
+			httpClient,
+			baseURL+OAuthClientServiceImportOAuthClientProcedure,
+			connect.WithSchema(oAuthClientServiceImportOAuthClientMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		testAuthorizationFlow: connect.NewClient[v1.TestAuthorizationFlowRequest, v1.TestAuthorizationFlowResponse](
+			httpClient,
+			baseURL+OAuthClientServiceTestAuthorizationFlowProcedure,
+			connect.WithSchema(oAuthClientServiceTestAuthorizationFlowMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // oAuthClientServiceClient implements OAuthClientServiceClient.
 type oAuthClientServiceClient struct {
-	createOAuthClient       *connect.Client[v1.CreateOAuthClientRequest, v1.CreateOAuthClientResponse]
-	queryOAuthClients       *connect.Client[v1.QueryOAuthClientsRequest, v1.QueryOAuthClientsResponse]
-	getOAuthClient          *connect.Client[v1.GetOAuthClientRequest, v1.GetOAuthClientResponse]
-	updateOAuthClient       *connect.Client[v1.UpdateOAuthClientRequest, v1.UpdateOAuthClientResponse]
-	deleteOAuthClient       *connect.Client[v1.DeleteOAuthClientRequest, v1.DeleteOAuthClientResponse]
-	revealOAuthClientSecret *connect.Client[v1.RevealOAuthClientSecretRequest, v1.RevealOAuthClientSecretResponse]
+	createOAuthClient            *connect.Client[v1.CreateOAuthClientRequest, v1.CreateOAuthClientResponse]
+	queryOAuthClients            *connect.Client[v1.QueryOAuthClientsRequest, v1.QueryOAuthClientsResponse]
+	getOAuthClient               *connect.Client[v1.GetOAuthClientRequest, v1.GetOAuthClientResponse]
+	updateOAuthClient            *connect.Client[v1.UpdateOAuthClientRequest, v1.UpdateOAuthClientResponse]
+	deleteOAuthClient            *connect.Client[v1.DeleteOAuthClientRequest, v1.DeleteOAuthClientResponse]
+	restoreOAuthClient           *connect.Client[v1.RestoreOAuthClientRequest, v1.RestoreOAuthClientResponse]
+	revealOAuthClientSecret      *connect.Client[v1.RevealOAuthClientSecretRequest, v1.RevealOAuthClientSecretResponse]
+	rotateOAuthClientSecret      *connect.Client[v1.RotateOAuthClientSecretRequest, v1.RotateOAuthClientSecretResponse]
+	queryRefreshTokens           *connect.Client[v1.QueryRefreshTokensRequest, v1.QueryRefreshTokensResponse]
+	revokeRefreshToken           *connect.Client[v1.RevokeRefreshTokenRequest, v1.RevokeRefreshTokenResponse]
+	getOAuthClientTokenStats     *connect.Client[v1.GetOAuthClientTokenStatsRequest, v1.GetOAuthClientTokenStatsResponse]
+	getOAuthClientTokenClaims    *connect.Client[v1.GetOAuthClientTokenClaimsRequest, v1.GetOAuthClientTokenClaimsResponse]
+	updateOAuthClientTokenClaims *connect.Client[v1.UpdateOAuthClientTokenClaimsRequest, v1.UpdateOAuthClientTokenClaimsResponse]
+	applyOAuthClient             *connect.Client[v1.ApplyOAuthClientRequest, v1.ApplyOAuthClientResponse]
+	importOAuthClient            *connect.Client[v1.ImportOAuthClientRequest, v1.ImportOAuthClientResponse]
+	testAuthorizationFlow        *connect.Client[v1.TestAuthorizationFlowRequest, v1.TestAuthorizationFlowResponse]
 }
 
 // CreateOAuthClient calls altalune.v1.OAuthClientService.CreateOAuthClient.
@@ -158,11 +283,61 @@ func (c *oAuthClientServiceClient) DeleteOAuthClient(ctx context.Context, req *c
 	return c.deleteOAuthClient.CallUnary(ctx, req)
 }
 
+// RestoreOAuthClient calls altalune.v1.OAuthClientService.RestoreOAuthClient.
+func (c *oAuthClientServiceClient) RestoreOAuthClient(ctx context.Context, req *connect.Request[v1.RestoreOAuthClientRequest]) (*connect.Response[v1.RestoreOAuthClientResponse], error) {
+	return c.restoreOAuthClient.CallUnary(ctx, req)
+}
+
 // RevealOAuthClientSecret calls altalune.v1.OAuthClientService.RevealOAuthClientSecret.
 func (c *oAuthClientServiceClient) RevealOAuthClientSecret(ctx context.Context, req *connect.Request[v1.RevealOAuthClientSecretRequest]) (*connect.Response[v1.RevealOAuthClientSecretResponse], error) {
 	return c.revealOAuthClientSecret.CallUnary(ctx, req)
 }
 
+// RotateOAuthClientSecret calls altalune.v1.OAuthClientService.RotateOAuthClientSecret.
+func (c *oAuthClientServiceClient) RotateOAuthClientSecret(ctx context.Context, req *connect.Request[v1.RotateOAuthClientSecretRequest]) (*connect.Response[v1.RotateOAuthClientSecretResponse], error) {
+	return c.rotateOAuthClientSecret.CallUnary(ctx, req)
+}
+
+// QueryRefreshTokens calls altalune.v1.OAuthClientService.QueryRefreshTokens.
+func (c *oAuthClientServiceClient) QueryRefreshTokens(ctx context.Context, req *connect.Request[v1.QueryRefreshTokensRequest]) (*connect.Response[v1.QueryRefreshTokensResponse], error) {
+	return c.queryRefreshTokens.CallUnary(ctx, req)
+}
+
+// RevokeRefreshToken calls altalune.v1.OAuthClientService.RevokeRefreshToken.
+func (c *oAuthClientServiceClient) RevokeRefreshToken(ctx context.Context, req *connect.Request[v1.RevokeRefreshTokenRequest]) (*connect.Response[v1.RevokeRefreshTokenResponse], error) {
+	return c.revokeRefreshToken.CallUnary(ctx, req)
+}
+
+// GetOAuthClientTokenStats calls altalune.v1.OAuthClientService.GetOAuthClientTokenStats.
+func (c *oAuthClientServiceClient) GetOAuthClientTokenStats(ctx context.Context, req *connect.Request[v1.GetOAuthClientTokenStatsRequest]) (*connect.Response[v1.GetOAuthClientTokenStatsResponse], error) {
+	return c.getOAuthClientTokenStats.CallUnary(ctx, req)
+}
+
+// GetOAuthClientTokenClaims calls altalune.v1.OAuthClientService.GetOAuthClientTokenClaims.
+func (c *oAuthClientServiceClient) GetOAuthClientTokenClaims(ctx context.Context, req *connect.Request[v1.GetOAuthClientTokenClaimsRequest]) (*connect.Response[v1.GetOAuthClientTokenClaimsResponse], error) {
+	return c.getOAuthClientTokenClaims.CallUnary(ctx, req)
+}
+
+// UpdateOAuthClientTokenClaims calls altalune.v1.OAuthClientService.UpdateOAuthClientTokenClaims.
+func (c *oAuthClientServiceClient) UpdateOAuthClientTokenClaims(ctx context.Context, req *connect.Request[v1.UpdateOAuthClientTokenClaimsRequest]) (*connect.Response[v1.UpdateOAuthClientTokenClaimsResponse], error) {
+	return c.updateOAuthClientTokenClaims.CallUnary(ctx, req)
+}
+
+// ApplyOAuthClient calls altalune.v1.OAuthClientService.ApplyOAuthClient.
+func (c *oAuthClientServiceClient) ApplyOAuthClient(ctx context.Context, req *connect.Request[v1.ApplyOAuthClientRequest]) (*connect.Response[v1.ApplyOAuthClientResponse], error) {
+	return c.applyOAuthClient.CallUnary(ctx, req)
+}
+
+// ImportOAuthClient calls altalune.v1.OAuthClientService.ImportOAuthClient.
+func (c *oAuthClientServiceClient) ImportOAuthClient(ctx context.Context, req *connect.Request[v1.ImportOAuthClientRequest]) (*connect.Response[v1.ImportOAuthClientResponse], error) {
+	return c.importOAuthClient.CallUnary(ctx, req)
+}
+
+// TestAuthorizationFlow calls altalune.v1.OAuthClientService.TestAuthorizationFlow.
+func (c *oAuthClientServiceClient) TestAuthorizationFlow(ctx context.Context, req *connect.Request[v1.TestAuthorizationFlowRequest]) (*connect.Response[v1.TestAuthorizationFlowResponse], error) {
+	return c.testAuthorizationFlow.CallUnary(ctx, req)
+}
+
 // OAuthClientServiceHandler is an implementation of the altalune.v1.OAuthClientService service.
 type OAuthClientServiceHandler interface {
 	CreateOAuthClient(context.Context, *connect.Request[v1.CreateOAuthClientRequest]) (*connect.Response[v1.CreateOAuthClientResponse], error)
@@ -170,7 +345,22 @@ type OAuthClientServiceHandler interface {
 	GetOAuthClient(context.Context, *connect.Request[v1.GetOAuthClientRequest]) (*connect.Response[v1.GetOAuthClientResponse], error)
 	UpdateOAuthClient(context.Context, *connect.Request[v1.UpdateOAuthClientRequest]) (*connect.Response[v1.UpdateOAuthClientResponse], error)
 	DeleteOAuthClient(context.Context, *connect.Request[v1.DeleteOAuthClientRequest]) (*connect.Response[v1.DeleteOAuthClientResponse], error)
+	RestoreOAuthClient(context.Context, *connect.Request[v1.RestoreOAuthClientRequest]) (*connect.Response[v1.RestoreOAuthClientResponse], error)
 	RevealOAuthClientSecret(context.Context, *connect.Request[v1.RevealOAuthClientSecretRequest]) (*connect.Response[v1.RevealOAuthClientSecretResponse], error)
+	// Replace the secret of a confidential client, handed over like on creation
+	RotateOAuthClientSecret(context.Context, *connect.Request[v1.RotateOAuthClientSecretRequest]) (*connect.Response[v1.RotateOAuthClientSecretResponse], error)
+	QueryRefreshTokens(context.Context, *connect.Request[v1.QueryRefreshTokensRequest]) (*connect.Response[v1.QueryRefreshTokensResponse], error)
+	RevokeRefreshToken(context.Context, *connect.Request[v1.RevokeRefreshTokenRequest]) (*connect.Response[v1.RevokeRefreshTokenResponse], error)
+	GetOAuthClientTokenStats(context.Context, *connect.Request[v1.GetOAuthClientTokenStatsRequest]) (*connect.Response[v1.GetOAuthClientTokenStatsResponse], error)
+	GetOAuthClientTokenClaims(context.Context, *connect.Request[v1.GetOAuthClientTokenClaimsRequest]) (*connect.Response[v1.GetOAuthClientTokenClaimsResponse], error)
+	UpdateOAuthClientTokenClaims(context.Context, *connect.Request[v1.UpdateOAuthClientTokenClaimsRequest]) (*connect.Response[v1.UpdateOAuthClientTokenClaimsResponse], error)
+	// Create-or-update by external ID, for declarative provisioning
+	ApplyOAuthClient(context.Context, *connect.Request[v1.ApplyOAuthClientRequest]) (*connect.Response[v1.ApplyOAuthClientResponse], error)
+	// Bring an existing client under an external ID, found by name
+	ImportOAuthClient(context.Context, *connect.Request[v1.ImportOAuthClientRequest]) (*connect.Response[v1.ImportOAuthClientResponse], error)
+	// Walk a synthetic user of a sandbox project through the authorization code
+	// flow of a client, without a browser, reporting the outcome of each step
+	TestAuthorizationFlow(context.Context, *connect.Request[v1.TestAuthorizationFlowRequest]) (*connect.Response[v1.TestAuthorizationFlowResponse], error)
 }
 
 // NewOAuthClientServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -209,12 +399,72 @@ func NewOAuthClientServiceHandler(svc OAuthClientServiceHandler, opts ...connect
 		connect.WithSchema(oAuthClientServiceDeleteOAuthClientMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	oAuthClientServiceRestoreOAuthClientHandler := connect.NewUnaryHandler(
+		OAuthClientServiceRestoreOAuthClientProcedure,
+		svc.RestoreOAuthClient,
+		connect.WithSchema(oAuthClientServiceRestoreOAuthClientMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	oAuthClientServiceRevealOAuthClientSecretHandler := connect.NewUnaryHandler(
 		OAuthClientServiceRevealOAuthClientSecretProcedure,
 		svc.RevealOAuthClientSecret,
 		connect.WithSchema(oAuthClientServiceRevealOAuthClientSecretMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	oAuthClientServiceRotateOAuthClientSecretHandler := connect.NewUnaryHandler(
+		OAuthClientServiceRotateOAuthClientSecretProcedure,
+		svc.RotateOAuthClientSecret,
+		connect.WithSchema(oAuthClientServiceRotateOAuthClientSecretMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	oAuthClientServiceQueryRefreshTokensHandler := connect.NewUnaryHandler(
+		OAuthClientServiceQueryRefreshTokensProcedure,
+		svc.QueryRefreshTokens,
+		connect.WithSchema(oAuthClientServiceQueryRefreshTokensMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	oAuthClientServiceRevokeRefreshTokenHandler := connect.NewUnaryHandler(
+		OAuthClientServiceRevokeRefreshTokenProcedure,
+		svc.RevokeRefreshToken,
+		connect.WithSchema(oAuthClientServiceRevokeRefreshTokenMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	oAuthClientServiceGetOAuthClientTokenStatsHandler := connect.NewUnaryHandler(
+		OAuthClientServiceGetOAuthClientTokenStatsProcedure,
+		svc.GetOAuthClientTokenStats,
+		connect.WithSchema(oAuthClientServiceGetOAuthClientTokenStatsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	oAuthClientServiceGetOAuthClientTokenClaimsHandler := connect.NewUnaryHandler(
+		OAuthClientServiceGetOAuthClientTokenClaimsProcedure,
+		svc.GetOAuthClientTokenClaims,
+		connect.WithSchema(oAuthClientServiceGetOAuthClientTokenClaimsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	oAuthClientServiceUpdateOAuthClientTokenClaimsHandler := connect.NewUnaryHandler(
+		OAuthClientServiceUpdateOAuthClientTokenClaimsProcedure,
+		svc.UpdateOAuthClientTokenClaims,
+		connect.WithSchema(oAuthClientServiceUpdateOAuthClientTokenClaimsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	oAuthClientServiceApplyOAuthClientHandler := connect.NewUnaryHandler(
+		OAuthClientServiceApplyOAuthClientProcedure,
+		svc.ApplyOAuthClient,
+		connect.WithSchema(oAuthClientServiceApplyOAuthClientMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	oAuthClientServiceImportOAuthClientHandler := connect.NewUnaryHandler(
+		OAuthClientServiceImportOAuthClientProcedure,
+		svc.ImportOAuthClient,
+		connect.WithSchema(oAuthClientServiceImportOAuthClientMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	oAuthClientServiceTestAuthorizationFlowHandler := connect.NewUnaryHandler(
+		OAuthClientServiceTestAuthorizationFlowProcedure,
+		svc.TestAuthorizationFlow,
+		connect.WithSchema(oAuthClientServiceTestAuthorizationFlowMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/altalune.v1.OAuthClientService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case OAuthClientServiceCreateOAuthClientProcedure:
@@ -227,8 +477,28 @@ func NewOAuthClientServiceHandler(svc OAuthClientServiceHandler, opts ...connect
 			oAuthClientServiceUpdateOAuthClientHandler.ServeHTTP(w, r)
 		case OAuthClientServiceDeleteOAuthClientProcedure:
 			oAuthClientServiceDeleteOAuthClientHandler.ServeHTTP(w, r)
+		case OAuthClientServiceRestoreOAuthClientProcedure:
+			oAuthClientServiceRestoreOAuthClientHandler.ServeHTTP(w, r)
 		case OAuthClientServiceRevealOAuthClientSecretProcedure:
 			oAuthClientServiceRevealOAuthClientSecretHandler.ServeHTTP(w, r)
+		case OAuthClientServiceRotateOAuthClientSecretProcedure:
+			oAuthClientServiceRotateOAuthClientSecretHandler.ServeHTTP(w, r)
+		case OAuthClientServiceQueryRefreshTokensProcedure:
+			oAuthClientServiceQueryRefreshTokensHandler.ServeHTTP(w, r)
+		case OAuthClientServiceRevokeRefreshTokenProcedure:
+			oAuthClientServiceRevokeRefreshTokenHandler.ServeHTTP(w, r)
+		case OAuthClientServiceGetOAuthClientTokenStatsProcedure:
+			oAuthClientServiceGetOAuthClientTokenStatsHandler.ServeHTTP(w, r)
+		case OAuthClientServiceGetOAuthClientTokenClaimsProcedure:
+			oAuthClientServiceGetOAuthClientTokenClaimsHandler.ServeHTTP(w, r)
+		case OAuthClientServiceUpdateOAuthClientTokenClaimsProcedure:
+			oAuthClientServiceUpdateOAuthClientTokenClaimsHandler.ServeHTTP(w, r)
+		case OAuthClientServiceApplyOAuthClientProcedure:
+			oAuthClientServiceApplyOAuthClientHandler.ServeHTTP(w, r)
+		case OAuthClientServiceImportOAuthClientProcedure:
+			oAuthClientServiceImportOAuthClientHandler.ServeHTTP(w, r)
+		case OAuthClientServiceTestAuthorizationFlowProcedure:
+			oAuthClientServiceTestAuthorizationFlowHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -258,6 +528,46 @@ func (UnimplementedOAuthClientServiceHandler) DeleteOAuthClient(context.Context,
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.OAuthClientService.DeleteOAuthClient is not implemented"))
 }
 
+func (UnimplementedOAuthClientServiceHandler) RestoreOAuthClient(context.Context, *connect.Request[v1.RestoreOAuthClientRequest]) (*connect.Response[v1.RestoreOAuthClientResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.OAuthClientService.RestoreOAuthClient is not implemented"))
+}
+
 func (UnimplementedOAuthClientServiceHandler) RevealOAuthClientSecret(context.Context, *connect.Request[v1.RevealOAuthClientSecretRequest]) (*connect.Response[v1.RevealOAuthClientSecretResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.OAuthClientService.RevealOAuthClientSecret is not implemented"))
 }
+
+func (UnimplementedOAuthClientServiceHandler) RotateOAuthClientSecret(context.Context, *connect.Request[v1.RotateOAuthClientSecretRequest]) (*connect.Response[v1.RotateOAuthClientSecretResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.OAuthClientService.RotateOAuthClientSecret is not implemented"))
+}
+
+func (UnimplementedOAuthClientServiceHandler) QueryRefreshTokens(context.Context, *connect.Request[v1.QueryRefreshTokensRequest]) (*connect.Response[v1.QueryRefreshTokensResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.OAuthClientService.QueryRefreshTokens is not implemented"))
+}
+
+func (UnimplementedOAuthClientServiceHandler) RevokeRefreshToken(context.Context, *connect.Request[v1.RevokeRefreshTokenRequest]) (*connect.Response[v1.RevokeRefreshTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.OAuthClientService.RevokeRefreshToken is not implemented"))
+}
+
+func (UnimplementedOAuthClientServiceHandler) GetOAuthClientTokenStats(context.Context, *connect.Request[v1.GetOAuthClientTokenStatsRequest]) (*connect.Response[v1.GetOAuthClientTokenStatsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.OAuthClientService.GetOAuthClientTokenStats is not implemented"))
+}
+
+func (UnimplementedOAuthClientServiceHandler) GetOAuthClientTokenClaims(context.Context, *connect.Request[v1.GetOAuthClientTokenClaimsRequest]) (*connect.Response[v1.GetOAuthClientTokenClaimsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.OAuthClientService.GetOAuthClientTokenClaims is not implemented"))
+}
+
+func (UnimplementedOAuthClientServiceHandler) UpdateOAuthClientTokenClaims(context.Context, *connect.Request[v1.UpdateOAuthClientTokenClaimsRequest]) (*connect.Response[v1.UpdateOAuthClientTokenClaimsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.OAuthClientService.UpdateOAuthClientTokenClaims is not implemented"))
+}
+
+func (UnimplementedOAuthClientServiceHandler) ApplyOAuthClient(context.Context, *connect.Request[v1.ApplyOAuthClientRequest]) (*connect.Response[v1.ApplyOAuthClientResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.OAuthClientService.ApplyOAuthClient is not implemented"))
+}
+
+func (UnimplementedOAuthClientServiceHandler) ImportOAuthClient(context.Context, *connect.Request[v1.ImportOAuthClientRequest]) (*connect.Response[v1.ImportOAuthClientResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.OAuthClientService.ImportOAuthClient is not implemented"))
+}
+
+func (UnimplementedOAuthClientServiceHandler) TestAuthorizationFlow(context.Context, *connect.Request[v1.TestAuthorizationFlowRequest]) (*connect.Response[v1.TestAuthorizationFlowResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.OAuthClientService.TestAuthorizationFlow is not implemented"))
+}
@@ -48,6 +48,9 @@ const (
 	// OAuthProviderServiceDeleteOAuthProviderProcedure is the fully-qualified name of the
 	// OAuthProviderService's DeleteOAuthProvider RPC.
 	OAuthProviderServiceDeleteOAuthProviderProcedure = "/altalune.v1.OAuthProviderService/DeleteOAuthProvider"
+	// OAuthProviderServiceRequestClientSecretRevealProcedure is the fully-qualified name of the
+	// OAuthProviderService's RequestClientSecretReveal RPC.
+	OAuthProviderServiceRequestClientSecretRevealProcedure = "/altalune.v1.OAuthProviderService/RequestClientSecretReveal"
 	// OAuthProviderServiceRevealClientSecretProcedure is the fully-qualified name of the
 	// OAuthProviderService's RevealClientSecret RPC.
 	OAuthProviderServiceRevealClientSecretProcedure = "/altalune.v1.OAuthProviderService/RevealClientSecret"
@@ -55,13 +58,14 @@ const (
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	oAuthProviderServiceServiceDescriptor                   = v1.File_altalune_v1_oauth_provider_proto.Services().ByName("OAuthProviderService")
-	oAuthProviderServiceQueryOAuthProvidersMethodDescriptor = oAuthProviderServiceServiceDescriptor.Methods().ByName("QueryOAuthProviders")
-	oAuthProviderServiceCreateOAuthProviderMethodDescriptor = oAuthProviderServiceServiceDescriptor.Methods().ByName("CreateOAuthProvider")
-	oAuthProviderServiceGetOAuthProviderMethodDescriptor    = oAuthProviderServiceServiceDescriptor.Methods().ByName("GetOAuthProvider")
-	oAuthProviderServiceUpdateOAuthProviderMethodDescriptor = oAuthProviderServiceServiceDescriptor.Methods().ByName("UpdateOAuthProvider")
-	oAuthProviderServiceDeleteOAuthProviderMethodDescriptor = oAuthProviderServiceServiceDescriptor.Methods().ByName("DeleteOAuthProvider")
-	oAuthProviderServiceRevealClientSecretMethodDescriptor  = oAuthProviderServiceServiceDescriptor.Methods().ByName("RevealClientSecret")
+	oAuthProviderServiceServiceDescriptor                         = v1.File_altalune_v1_oauth_provider_proto.Services().ByName("OAuthProviderService")
+	oAuthProviderServiceQueryOAuthProvidersMethodDescriptor       = oAuthProviderServiceServiceDescriptor.Methods().ByName("QueryOAuthProviders")
+	oAuthProviderServiceCreateOAuthProviderMethodDescriptor       = oAuthProviderServiceServiceDescriptor.Methods().ByName("CreateOAuthProvider")
+	oAuthProviderServiceGetOAuthProviderMethodDescriptor          = oAuthProviderServiceServiceDescriptor.Methods().ByName("GetOAuthProvider")
+	oAuthProviderServiceUpdateOAuthProviderMethodDescriptor       = oAuthProviderServiceServiceDescriptor.Methods().ByName("UpdateOAuthProvider")
+	oAuthProviderServiceDeleteOAuthProviderMethodDescriptor       = oAuthProviderServiceServiceDescriptor.Methods().ByName("DeleteOAuthProvider")
+	oAuthProviderServiceRequestClientSecretRevealMethodDescriptor = oAuthProviderServiceServiceDescriptor.Methods().ByName("RequestClientSecretReveal")
+	oAuthProviderServiceRevealClientSecretMethodDescriptor        = oAuthProviderServiceServiceDescriptor.Methods().ByName("RevealClientSecret")
 )
 
 // OAuthProviderServiceClient is a client for the altalune.v1.OAuthProviderService service.
@@ -80,8 +84,12 @@ type OAuthProviderServiceClient interface {
 	UpdateOAuthProvider(context.Context, *connect.Request[v1.UpdateOAuthProviderRequest]) (*connect.Response[v1.UpdateOAuthProviderResponse], error)
 	// DeleteOAuthProvider deletes an OAuth provider
 	DeleteOAuthProvider(context.Context, *connect.Request[v1.DeleteOAuthProviderRequest]) (*connect.Response[v1.DeleteOAuthProviderResponse], error)
+	// RequestClientSecretReveal emails the caller a short-lived code confirming
+	// a client secret reveal
+	RequestClientSecretReveal(context.Context, *connect.Request[v1.RequestClientSecretRevealRequest]) (*connect.Response[v1.RequestClientSecretRevealResponse], error)
 	// RevealClientSecret decrypts and returns the plaintext client secret
-	// SECURITY: Separate RPC for audit logging and access control
+	// SECURITY: Separate RPC for audit logging and access control. Requires a
+	// code from RequestClientSecretReveal and a reason, and is rate limited.
 	RevealClientSecret(context.Context, *connect.Request[v1.RevealClientSecretRequest]) (*connect.Response[v1.RevealClientSecretResponse], error)
 }
 
@@ -125,6 +133,12 @@ func NewOAuthProviderServiceClient(httpClient connect.HTTPClient, baseURL string
 			connect.WithSchema(oAuthProviderServiceDeleteOAuthProviderMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		requestClientSecretReveal: connect.NewClient[v1.RequestClientSecretRevealRequest, v1.RequestClientSecretRevealResponse](
+			httpClient,
+			baseURL+OAuthProviderServiceRequestClientSecretRevealProcedure,
+			connect.WithSchema(oAuthProviderServiceRequestClientSecretRevealMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		revealClientSecret: connect.NewClient[v1.RevealClientSecretRequest, v1.RevealClientSecretResponse](
 			httpClient,
 			baseURL+OAuthProviderServiceRevealClientSecretProcedure,
@@ -136,12 +150,13 @@ func NewOAuthProviderServiceClient(httpClient connect.HTTPClient, baseURL string
 
 // oAuthProviderServiceClient implements OAuthProviderServiceClient.
 type oAuthProviderServiceClient struct {
-	queryOAuthProviders *connect.Client[v1.QueryOAuthProvidersRequest, v1.QueryOAuthProvidersResponse]
-	createOAuthProvider *connect.Client[v1.CreateOAuthProviderRequest, v1.CreateOAuthProviderResponse]
-	getOAuthProvider    *connect.Client[v1.GetOAuthProviderRequest, v1.GetOAuthProviderResponse]
-	updateOAuthProvider *connect.Client[v1.UpdateOAuthProviderRequest, v1.UpdateOAuthProviderResponse]
-	deleteOAuthProvider *connect.Client[v1.DeleteOAuthProviderRequest, v1.DeleteOAuthProviderResponse]
-	revealClientSecret  *connect.Client[v1.RevealClientSecretRequest, v1.RevealClientSecretResponse]
+	queryOAuthProviders       *connect.Client[v1.QueryOAuthProvidersRequest, v1.QueryOAuthProvidersResponse]
+	createOAuthProvider       *connect.Client[v1.CreateOAuthProviderRequest, v1.CreateOAuthProviderResponse]
+	getOAuthProvider          *connect.Client[v1.GetOAuthProviderRequest, v1.GetOAuthProviderResponse]
+	updateOAuthProvider       *connect.Client[v1.UpdateOAuthProviderRequest, v1.UpdateOAuthProviderResponse]
+	deleteOAuthProvider       *connect.Client[v1.DeleteOAuthProviderRequest, v1.DeleteOAuthProviderResponse]
+	requestClientSecretReveal *connect.Client[v1.RequestClientSecretRevealRequest, v1.RequestClientSecretRevealResponse]
+	revealClientSecret        *connect.Client[v1.RevealClientSecretRequest, v1.RevealClientSecretResponse]
 }
 
 // QueryOAuthProviders calls altalune.v1.OAuthProviderService.QueryOAuthProviders.
@@ -169,6 +184,11 @@ func (c *oAuthProviderServiceClient) DeleteOAuthProvider(ctx context.Context, re
 	return c.deleteOAuthProvider.CallUnary(ctx, req)
 }
 
+// RequestClientSecretReveal calls altalune.v1.OAuthProviderService.RequestClientSecretReveal.
+func (c *oAuthProviderServiceClient) RequestClientSecretReveal(ctx context.Context, req *connect.Request[v1.RequestClientSecretRevealRequest]) (*connect.Response[v1.RequestClientSecretRevealResponse], error) {
+	return c.requestClientSecretReveal.CallUnary(ctx, req)
+}
+
 // RevealClientSecret calls altalune.v1.OAuthProviderService.RevealClientSecret.
 func (c *oAuthProviderServiceClient) RevealClientSecret(ctx context.Context, req *connect.Request[v1.RevealClientSecretRequest]) (*connect.Response[v1.RevealClientSecretResponse], error) {
 	return c.revealClientSecret.CallUnary(ctx, req)
@@ -190,8 +210,12 @@ type OAuthProviderServiceHandler interface {
 	UpdateOAuthProvider(context.Context, *connect.Request[v1.UpdateOAuthProviderRequest]) (*connect.Response[v1.UpdateOAuthProviderResponse], error)
 	// DeleteOAuthProvider deletes an OAuth provider
 	DeleteOAuthProvider(context.Context, *connect.Request[v1.DeleteOAuthProviderRequest]) (*connect.Response[v1.DeleteOAuthProviderResponse], error)
+	// RequestClientSecretReveal emails the caller a short-lived code confirming
+	// a client secret reveal
+	RequestClientSecretReveal(context.Context, *connect.Request[v1.RequestClientSecretRevealRequest]) (*connect.Response[v1.RequestClientSecretRevealResponse], error)
 	// RevealClientSecret decrypts and returns the plaintext client secret
-	// SECURITY: Separate RPC for audit logging and access control
+	// SECURITY: Separate RPC for audit logging and access control. Requires a
+	// code from RequestClientSecretReveal and a reason, and is rate limited.
 	RevealClientSecret(context.Context, *connect.Request[v1.RevealClientSecretRequest]) (*connect.Response[v1.RevealClientSecretResponse], error)
 }
 
@@ -231,6 +255,12 @@ func NewOAuthProviderServiceHandler(svc OAuthProviderServiceHandler, opts ...con
 		connect.WithSchema(oAuthProviderServiceDeleteOAuthProviderMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	oAuthProviderServiceRequestClientSecretRevealHandler := connect.NewUnaryHandler(
+		OAuthProviderServiceRequestClientSecretRevealProcedure,
+		svc.RequestClientSecretReveal,
+		connect.WithSchema(oAuthProviderServiceRequestClientSecretRevealMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	oAuthProviderServiceRevealClientSecretHandler := connect.NewUnaryHandler(
 		OAuthProviderServiceRevealClientSecretProcedure,
 		svc.RevealClientSecret,
@@ -249,6 +279,8 @@ func NewOAuthProviderServiceHandler(svc OAuthProviderServiceHandler, opts ...con
 			oAuthProviderServiceUpdateOAuthProviderHandler.ServeHTTP(w, r)
 		case OAuthProviderServiceDeleteOAuthProviderProcedure:
 			oAuthProviderServiceDeleteOAuthProviderHandler.ServeHTTP(w, r)
+		case OAuthProviderServiceRequestClientSecretRevealProcedure:
+			oAuthProviderServiceRequestClientSecretRevealHandler.ServeHTTP(w, r)
 		case OAuthProviderServiceRevealClientSecretProcedure:
 			oAuthProviderServiceRevealClientSecretHandler.ServeHTTP(w, r)
 		default:
@@ -280,6 +312,10 @@ func (UnimplementedOAuthProviderServiceHandler) DeleteOAuthProvider(context.Cont
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.OAuthProviderService.DeleteOAuthProvider is not implemented"))
 }
 
+func (UnimplementedOAuthProviderServiceHandler) RequestClientSecretReveal(context.Context, *connect.Request[v1.RequestClientSecretRevealRequest]) (*connect.Response[v1.RequestClientSecretRevealResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.OAuthProviderService.RequestClientSecretReveal is not implemented"))
+}
+
 func (UnimplementedOAuthProviderServiceHandler) RevealClientSecret(context.Context, *connect.Request[v1.RevealClientSecretRequest]) (*connect.Response[v1.RevealClientSecretResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.OAuthProviderService.RevealClientSecret is not implemented"))
 }
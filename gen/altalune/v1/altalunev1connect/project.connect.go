@@ -48,16 +48,32 @@ const (
 	// ProjectServiceDeleteProjectProcedure is the fully-qualified name of the ProjectService's
 	// DeleteProject RPC.
 	ProjectServiceDeleteProjectProcedure = "/altalune.v1.ProjectService/DeleteProject"
+	// ProjectServiceGetProjectOnboardingProcedure is the fully-qualified name of the ProjectService's
+	// GetProjectOnboarding RPC.
+	ProjectServiceGetProjectOnboardingProcedure = "/altalune.v1.ProjectService/GetProjectOnboarding"
+	// ProjectServiceUpdateProjectOnboardingProcedure is the fully-qualified name of the
+	// ProjectService's UpdateProjectOnboarding RPC.
+	ProjectServiceUpdateProjectOnboardingProcedure = "/altalune.v1.ProjectService/UpdateProjectOnboarding"
+	// ProjectServiceGetProjectCredentialPolicyProcedure is the fully-qualified name of the
+	// ProjectService's GetProjectCredentialPolicy RPC.
+	ProjectServiceGetProjectCredentialPolicyProcedure = "/altalune.v1.ProjectService/GetProjectCredentialPolicy"
+	// ProjectServiceUpdateProjectCredentialPolicyProcedure is the fully-qualified name of the
+	// ProjectService's UpdateProjectCredentialPolicy RPC.
+	ProjectServiceUpdateProjectCredentialPolicyProcedure = "/altalune.v1.ProjectService/UpdateProjectCredentialPolicy"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	projectServiceServiceDescriptor             = v1.File_altalune_v1_project_proto.Services().ByName("ProjectService")
-	projectServiceQueryProjectsMethodDescriptor = projectServiceServiceDescriptor.Methods().ByName("QueryProjects")
-	projectServiceCreateProjectMethodDescriptor = projectServiceServiceDescriptor.Methods().ByName("CreateProject")
-	projectServiceGetProjectMethodDescriptor    = projectServiceServiceDescriptor.Methods().ByName("GetProject")
-	projectServiceUpdateProjectMethodDescriptor = projectServiceServiceDescriptor.Methods().ByName("UpdateProject")
-	projectServiceDeleteProjectMethodDescriptor = projectServiceServiceDescriptor.Methods().ByName("DeleteProject")
+	projectServiceServiceDescriptor                             = v1.File_altalune_v1_project_proto.Services().ByName("ProjectService")
+	projectServiceQueryProjectsMethodDescriptor                 = projectServiceServiceDescriptor.Methods().ByName("QueryProjects")
+	projectServiceCreateProjectMethodDescriptor                 = projectServiceServiceDescriptor.Methods().ByName("CreateProject")
+	projectServiceGetProjectMethodDescriptor                    = projectServiceServiceDescriptor.Methods().ByName("GetProject")
+	projectServiceUpdateProjectMethodDescriptor                 = projectServiceServiceDescriptor.Methods().ByName("UpdateProject")
+	projectServiceDeleteProjectMethodDescriptor                 = projectServiceServiceDescriptor.Methods().ByName("DeleteProject")
+	projectServiceGetProjectOnboardingMethodDescriptor          = projectServiceServiceDescriptor.Methods().ByName("GetProjectOnboarding")
+	projectServiceUpdateProjectOnboardingMethodDescriptor       = projectServiceServiceDescriptor.Methods().ByName("UpdateProjectOnboarding")
+	projectServiceGetProjectCredentialPolicyMethodDescriptor    = projectServiceServiceDescriptor.Methods().ByName("GetProjectCredentialPolicy")
+	projectServiceUpdateProjectCredentialPolicyMethodDescriptor = projectServiceServiceDescriptor.Methods().ByName("UpdateProjectCredentialPolicy")
 )
 
 // ProjectServiceClient is a client for the altalune.v1.ProjectService service.
@@ -67,6 +83,10 @@ type ProjectServiceClient interface {
 	GetProject(context.Context, *connect.Request[v1.GetProjectRequest]) (*connect.Response[v1.GetProjectResponse], error)
 	UpdateProject(context.Context, *connect.Request[v1.UpdateProjectRequest]) (*connect.Response[v1.UpdateProjectResponse], error)
 	DeleteProject(context.Context, *connect.Request[v1.DeleteProjectRequest]) (*connect.Response[v1.DeleteProjectResponse], error)
+	GetProjectOnboarding(context.Context, *connect.Request[v1.GetProjectOnboardingRequest]) (*connect.Response[v1.GetProjectOnboardingResponse], error)
+	UpdateProjectOnboarding(context.Context, *connect.Request[v1.UpdateProjectOnboardingRequest]) (*connect.Response[v1.UpdateProjectOnboardingResponse], error)
+	GetProjectCredentialPolicy(context.Context, *connect.Request[v1.GetProjectCredentialPolicyRequest]) (*connect.Response[v1.GetProjectCredentialPolicyResponse], error)
+	UpdateProjectCredentialPolicy(context.Context, *connect.Request[v1.UpdateProjectCredentialPolicyRequest]) (*connect.Response[v1.UpdateProjectCredentialPolicyResponse], error)
 }
 
 // NewProjectServiceClient constructs a client for the altalune.v1.ProjectService service. By
@@ -109,16 +129,44 @@ func NewProjectServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(projectServiceDeleteProjectMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getProjectOnboarding: connect.NewClient[v1.GetProjectOnboardingRequest, v1.GetProjectOnboardingResponse](
+			httpClient,
+			baseURL+ProjectServiceGetProjectOnboardingProcedure,
+			connect.WithSchema(projectServiceGetProjectOnboardingMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateProjectOnboarding: connect.NewClient[v1.UpdateProjectOnboardingRequest, v1.UpdateProjectOnboardingResponse](
+			httpClient,
+			baseURL+ProjectServiceUpdateProjectOnboardingProcedure,
+			connect.WithSchema(projectServiceUpdateProjectOnboardingMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getProjectCredentialPolicy: connect.NewClient[v1.GetProjectCredentialPolicyRequest, v1.GetProjectCredentialPolicyResponse](
+			httpClient,
+			baseURL+ProjectServiceGetProjectCredentialPolicyProcedure,
+			connect.WithSchema(projectServiceGetProjectCredentialPolicyMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateProjectCredentialPolicy: connect.NewClient[v1.UpdateProjectCredentialPolicyRequest, v1.UpdateProjectCredentialPolicyResponse](
+			httpClient,
+			baseURL+ProjectServiceUpdateProjectCredentialPolicyProcedure,
+			connect.WithSchema(projectServiceUpdateProjectCredentialPolicyMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // projectServiceClient implements ProjectServiceClient.
 type projectServiceClient struct {
-	queryProjects *connect.Client[v1.QueryProjectsRequest, v1.QueryProjectsResponse]
-	createProject *connect.Client[v1.CreateProjectRequest, v1.CreateProjectResponse]
-	getProject    *connect.Client[v1.GetProjectRequest, v1.GetProjectResponse]
-	updateProject *connect.Client[v1.UpdateProjectRequest, v1.UpdateProjectResponse]
-	deleteProject *connect.Client[v1.DeleteProjectRequest, v1.DeleteProjectResponse]
+	queryProjects                 *connect.Client[v1.QueryProjectsRequest, v1.QueryProjectsResponse]
+	createProject                 *connect.Client[v1.CreateProjectRequest, v1.CreateProjectResponse]
+	getProject                    *connect.Client[v1.GetProjectRequest, v1.GetProjectResponse]
+	updateProject                 *connect.Client[v1.UpdateProjectRequest, v1.UpdateProjectResponse]
+	deleteProject                 *connect.Client[v1.DeleteProjectRequest, v1.DeleteProjectResponse]
+	getProjectOnboarding          *connect.Client[v1.GetProjectOnboardingRequest, v1.GetProjectOnboardingResponse]
+	updateProjectOnboarding       *connect.Client[v1.UpdateProjectOnboardingRequest, v1.UpdateProjectOnboardingResponse]
+	getProjectCredentialPolicy    *connect.Client[v1.GetProjectCredentialPolicyRequest, v1.GetProjectCredentialPolicyResponse]
+	updateProjectCredentialPolicy *connect.Client[v1.UpdateProjectCredentialPolicyRequest, v1.UpdateProjectCredentialPolicyResponse]
 }
 
 // QueryProjects calls altalune.v1.ProjectService.QueryProjects.
@@ -146,6 +194,26 @@ func (c *projectServiceClient) DeleteProject(ctx context.Context, req *connect.R
 	return c.deleteProject.CallUnary(ctx, req)
 }
 
+// GetProjectOnboarding calls altalune.v1.ProjectService.GetProjectOnboarding.
+func (c *projectServiceClient) GetProjectOnboarding(ctx context.Context, req *connect.Request[v1.GetProjectOnboardingRequest]) (*connect.Response[v1.GetProjectOnboardingResponse], error) {
+	return c.getProjectOnboarding.CallUnary(ctx, req)
+}
+
+// UpdateProjectOnboarding calls altalune.v1.ProjectService.UpdateProjectOnboarding.
+func (c *projectServiceClient) UpdateProjectOnboarding(ctx context.Context, req *connect.Request[v1.UpdateProjectOnboardingRequest]) (*connect.Response[v1.UpdateProjectOnboardingResponse], error) {
+	return c.updateProjectOnboarding.CallUnary(ctx, req)
+}
+
+// GetProjectCredentialPolicy calls altalune.v1.ProjectService.GetProjectCredentialPolicy.
+func (c *projectServiceClient) GetProjectCredentialPolicy(ctx context.Context, req *connect.Request[v1.GetProjectCredentialPolicyRequest]) (*connect.Response[v1.GetProjectCredentialPolicyResponse], error) {
+	return c.getProjectCredentialPolicy.CallUnary(ctx, req)
+}
+
+// UpdateProjectCredentialPolicy calls altalune.v1.ProjectService.UpdateProjectCredentialPolicy.
+func (c *projectServiceClient) UpdateProjectCredentialPolicy(ctx context.Context, req *connect.Request[v1.UpdateProjectCredentialPolicyRequest]) (*connect.Response[v1.UpdateProjectCredentialPolicyResponse], error) {
+	return c.updateProjectCredentialPolicy.CallUnary(ctx, req)
+}
+
 // ProjectServiceHandler is an implementation of the altalune.v1.ProjectService service.
 type ProjectServiceHandler interface {
 	QueryProjects(context.Context, *connect.Request[v1.QueryProjectsRequest]) (*connect.Response[v1.QueryProjectsResponse], error)
@@ -153,6 +221,10 @@ type ProjectServiceHandler interface {
 	GetProject(context.Context, *connect.Request[v1.GetProjectRequest]) (*connect.Response[v1.GetProjectResponse], error)
 	UpdateProject(context.Context, *connect.Request[v1.UpdateProjectRequest]) (*connect.Response[v1.UpdateProjectResponse], error)
 	DeleteProject(context.Context, *connect.Request[v1.DeleteProjectRequest]) (*connect.Response[v1.DeleteProjectResponse], error)
+	GetProjectOnboarding(context.Context, *connect.Request[v1.GetProjectOnboardingRequest]) (*connect.Response[v1.GetProjectOnboardingResponse], error)
+	UpdateProjectOnboarding(context.Context, *connect.Request[v1.UpdateProjectOnboardingRequest]) (*connect.Response[v1.UpdateProjectOnboardingResponse], error)
+	GetProjectCredentialPolicy(context.Context, *connect.Request[v1.GetProjectCredentialPolicyRequest]) (*connect.Response[v1.GetProjectCredentialPolicyResponse], error)
+	UpdateProjectCredentialPolicy(context.Context, *connect.Request[v1.UpdateProjectCredentialPolicyRequest]) (*connect.Response[v1.UpdateProjectCredentialPolicyResponse], error)
 }
 
 // NewProjectServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -191,6 +263,30 @@ func NewProjectServiceHandler(svc ProjectServiceHandler, opts ...connect.Handler
 		connect.WithSchema(projectServiceDeleteProjectMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	projectServiceGetProjectOnboardingHandler := connect.NewUnaryHandler(
+		ProjectServiceGetProjectOnboardingProcedure,
+		svc.GetProjectOnboarding,
+		connect.WithSchema(projectServiceGetProjectOnboardingMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	projectServiceUpdateProjectOnboardingHandler := connect.NewUnaryHandler(
+		ProjectServiceUpdateProjectOnboardingProcedure,
+		svc.UpdateProjectOnboarding,
+		connect.WithSchema(projectServiceUpdateProjectOnboardingMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	projectServiceGetProjectCredentialPolicyHandler := connect.NewUnaryHandler(
+		ProjectServiceGetProjectCredentialPolicyProcedure,
+		svc.GetProjectCredentialPolicy,
+		connect.WithSchema(projectServiceGetProjectCredentialPolicyMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	projectServiceUpdateProjectCredentialPolicyHandler := connect.NewUnaryHandler(
+		ProjectServiceUpdateProjectCredentialPolicyProcedure,
+		svc.UpdateProjectCredentialPolicy,
+		connect.WithSchema(projectServiceUpdateProjectCredentialPolicyMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/altalune.v1.ProjectService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ProjectServiceQueryProjectsProcedure:
@@ -203,6 +299,14 @@ func NewProjectServiceHandler(svc ProjectServiceHandler, opts ...connect.Handler
 			projectServiceUpdateProjectHandler.ServeHTTP(w, r)
 		case ProjectServiceDeleteProjectProcedure:
 			projectServiceDeleteProjectHandler.ServeHTTP(w, r)
+		case ProjectServiceGetProjectOnboardingProcedure:
+			projectServiceGetProjectOnboardingHandler.ServeHTTP(w, r)
+		case ProjectServiceUpdateProjectOnboardingProcedure:
+			projectServiceUpdateProjectOnboardingHandler.ServeHTTP(w, r)
+		case ProjectServiceGetProjectCredentialPolicyProcedure:
+			projectServiceGetProjectCredentialPolicyHandler.ServeHTTP(w, r)
+		case ProjectServiceUpdateProjectCredentialPolicyProcedure:
+			projectServiceUpdateProjectCredentialPolicyHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -231,3 +335,19 @@ func (UnimplementedProjectServiceHandler) UpdateProject(context.Context, *connec
 func (UnimplementedProjectServiceHandler) DeleteProject(context.Context, *connect.Request[v1.DeleteProjectRequest]) (*connect.Response[v1.DeleteProjectResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.ProjectService.DeleteProject is not implemented"))
 }
+
+func (UnimplementedProjectServiceHandler) GetProjectOnboarding(context.Context, *connect.Request[v1.GetProjectOnboardingRequest]) (*connect.Response[v1.GetProjectOnboardingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.ProjectService.GetProjectOnboarding is not implemented"))
+}
+
+func (UnimplementedProjectServiceHandler) UpdateProjectOnboarding(context.Context, *connect.Request[v1.UpdateProjectOnboardingRequest]) (*connect.Response[v1.UpdateProjectOnboardingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.ProjectService.UpdateProjectOnboarding is not implemented"))
+}
+
+func (UnimplementedProjectServiceHandler) GetProjectCredentialPolicy(context.Context, *connect.Request[v1.GetProjectCredentialPolicyRequest]) (*connect.Response[v1.GetProjectCredentialPolicyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.ProjectService.GetProjectCredentialPolicy is not implemented"))
+}
+
+func (UnimplementedProjectServiceHandler) UpdateProjectCredentialPolicy(context.Context, *connect.Request[v1.UpdateProjectCredentialPolicyRequest]) (*connect.Response[v1.UpdateProjectCredentialPolicyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.ProjectService.UpdateProjectCredentialPolicy is not implemented"))
+}
@@ -43,6 +43,10 @@ const (
 	RoleServiceUpdateRoleProcedure = "/altalune.v1.RoleService/UpdateRole"
 	// RoleServiceDeleteRoleProcedure is the fully-qualified name of the RoleService's DeleteRole RPC.
 	RoleServiceDeleteRoleProcedure = "/altalune.v1.RoleService/DeleteRole"
+	// RoleServiceApplyRoleProcedure is the fully-qualified name of the RoleService's ApplyRole RPC.
+	RoleServiceApplyRoleProcedure = "/altalune.v1.RoleService/ApplyRole"
+	// RoleServiceImportRoleProcedure is the fully-qualified name of the RoleService's ImportRole RPC.
+	RoleServiceImportRoleProcedure = "/altalune.v1.RoleService/ImportRole"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
@@ -53,6 +57,8 @@ var (
 	roleServiceGetRoleMethodDescriptor    = roleServiceServiceDescriptor.Methods().ByName("GetRole")
 	roleServiceUpdateRoleMethodDescriptor = roleServiceServiceDescriptor.Methods().ByName("UpdateRole")
 	roleServiceDeleteRoleMethodDescriptor = roleServiceServiceDescriptor.Methods().ByName("DeleteRole")
+	roleServiceApplyRoleMethodDescriptor  = roleServiceServiceDescriptor.Methods().ByName("ApplyRole")
+	roleServiceImportRoleMethodDescriptor = roleServiceServiceDescriptor.Methods().ByName("ImportRole")
 )
 
 // RoleServiceClient is a client for the altalune.v1.RoleService service.
@@ -62,6 +68,10 @@ type RoleServiceClient interface {
 	GetRole(context.Context, *connect.Request[v1.GetRoleRequest]) (*connect.Response[v1.GetRoleResponse], error)
 	UpdateRole(context.Context, *connect.Request[v1.UpdateRoleRequest]) (*connect.Response[v1.UpdateRoleResponse], error)
 	DeleteRole(context.Context, *connect.Request[v1.DeleteRoleRequest]) (*connect.Response[v1.DeleteRoleResponse], error)
+	// Create-or-update by external ID, for declarative provisioning
+	ApplyRole(context.Context, *connect.Request[v1.ApplyRoleRequest]) (*connect.Response[v1.ApplyRoleResponse], error)
+	// Bring an existing role under an external ID, found by name
+	ImportRole(context.Context, *connect.Request[v1.ImportRoleRequest]) (*connect.Response[v1.ImportRoleResponse], error)
 }
 
 // NewRoleServiceClient constructs a client for the altalune.v1.RoleService service. By default, it
@@ -104,6 +114,18 @@ func NewRoleServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(roleServiceDeleteRoleMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		applyRole: connect.NewClient[v1.ApplyRoleRequest, v1.ApplyRoleResponse](
+			httpClient,
+			baseURL+RoleServiceApplyRoleProcedure,
+			connect.WithSchema(roleServiceApplyRoleMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		importRole: connect.NewClient[v1.ImportRoleRequest, v1.ImportRoleResponse](
+			httpClient,
+			baseURL+RoleServiceImportRoleProcedure,
+			connect.WithSchema(roleServiceImportRoleMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -114,6 +136,8 @@ type roleServiceClient struct {
 	getRole    *connect.Client[v1.GetRoleRequest, v1.GetRoleResponse]
 	updateRole *connect.Client[v1.UpdateRoleRequest, v1.UpdateRoleResponse]
 	deleteRole *connect.Client[v1.DeleteRoleRequest, v1.DeleteRoleResponse]
+	applyRole  *connect.Client[v1.ApplyRoleRequest, v1.ApplyRoleResponse]
+	importRole *connect.Client[v1.ImportRoleRequest, v1.ImportRoleResponse]
 }
 
 // QueryRoles calls altalune.v1.RoleService.QueryRoles.
@@ -141,6 +165,16 @@ func (c *roleServiceClient) DeleteRole(ctx context.Context, req *connect.Request
 	return c.deleteRole.CallUnary(ctx, req)
 }
 
+// ApplyRole calls altalune.v1.RoleService.ApplyRole.
+func (c *roleServiceClient) ApplyRole(ctx context.Context, req *connect.Request[v1.ApplyRoleRequest]) (*connect.Response[v1.ApplyRoleResponse], error) {
+	return c.applyRole.CallUnary(ctx, req)
+}
+
+// ImportRole calls altalune.v1.RoleService.ImportRole.
+func (c *roleServiceClient) ImportRole(ctx context.Context, req *connect.Request[v1.ImportRoleRequest]) (*connect.Response[v1.ImportRoleResponse], error) {
+	return c.importRole.CallUnary(ctx, req)
+}
+
 // RoleServiceHandler is an implementation of the altalune.v1.RoleService service.
 type RoleServiceHandler interface {
 	QueryRoles(context.Context, *connect.Request[v1.QueryRolesRequest]) (*connect.Response[v1.QueryRolesResponse], error)
@@ -148,6 +182,10 @@ type RoleServiceHandler interface {
 	GetRole(context.Context, *connect.Request[v1.GetRoleRequest]) (*connect.Response[v1.GetRoleResponse], error)
 	UpdateRole(context.Context, *connect.Request[v1.UpdateRoleRequest]) (*connect.Response[v1.UpdateRoleResponse], error)
 	DeleteRole(context.Context, *connect.Request[v1.DeleteRoleRequest]) (*connect.Response[v1.DeleteRoleResponse], error)
+	// Create-or-update by external ID, for declarative provisioning
+	ApplyRole(context.Context, *connect.Request[v1.ApplyRoleRequest]) (*connect.Response[v1.ApplyRoleResponse], error)
+	// Bring an existing role under an external ID, found by name
+	ImportRole(context.Context, *connect.Request[v1.ImportRoleRequest]) (*connect.Response[v1.ImportRoleResponse], error)
 }
 
 // NewRoleServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -186,6 +224,18 @@ func NewRoleServiceHandler(svc RoleServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(roleServiceDeleteRoleMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	roleServiceApplyRoleHandler := connect.NewUnaryHandler(
+		RoleServiceApplyRoleProcedure,
+		svc.ApplyRole,
+		connect.WithSchema(roleServiceApplyRoleMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	roleServiceImportRoleHandler := connect.NewUnaryHandler(
+		RoleServiceImportRoleProcedure,
+		svc.ImportRole,
+		connect.WithSchema(roleServiceImportRoleMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/altalune.v1.RoleService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case RoleServiceQueryRolesProcedure:
@@ -198,6 +248,10 @@ func NewRoleServiceHandler(svc RoleServiceHandler, opts ...connect.HandlerOption
 			roleServiceUpdateRoleHandler.ServeHTTP(w, r)
 		case RoleServiceDeleteRoleProcedure:
 			roleServiceDeleteRoleHandler.ServeHTTP(w, r)
+		case RoleServiceApplyRoleProcedure:
+			roleServiceApplyRoleHandler.ServeHTTP(w, r)
+		case RoleServiceImportRoleProcedure:
+			roleServiceImportRoleHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -226,3 +280,11 @@ func (UnimplementedRoleServiceHandler) UpdateRole(context.Context, *connect.Requ
 func (UnimplementedRoleServiceHandler) DeleteRole(context.Context, *connect.Request[v1.DeleteRoleRequest]) (*connect.Response[v1.DeleteRoleResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.RoleService.DeleteRole is not implemented"))
 }
+
+func (UnimplementedRoleServiceHandler) ApplyRole(context.Context, *connect.Request[v1.ApplyRoleRequest]) (*connect.Response[v1.ApplyRoleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.RoleService.ApplyRole is not implemented"))
+}
+
+func (UnimplementedRoleServiceHandler) ImportRole(context.Context, *connect.Request[v1.ImportRoleRequest]) (*connect.Response[v1.ImportRoleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.RoleService.ImportRole is not implemented"))
+}
@@ -35,43 +35,93 @@ const (
 const (
 	// UserServiceQueryUsersProcedure is the fully-qualified name of the UserService's QueryUsers RPC.
 	UserServiceQueryUsersProcedure = "/altalune.v1.UserService/QueryUsers"
+	// UserServiceStreamUsersProcedure is the fully-qualified name of the UserService's StreamUsers RPC.
+	UserServiceStreamUsersProcedure = "/altalune.v1.UserService/StreamUsers"
 	// UserServiceCreateUserProcedure is the fully-qualified name of the UserService's CreateUser RPC.
 	UserServiceCreateUserProcedure = "/altalune.v1.UserService/CreateUser"
+	// UserServiceCreateServiceAccountProcedure is the fully-qualified name of the UserService's
+	// CreateServiceAccount RPC.
+	UserServiceCreateServiceAccountProcedure = "/altalune.v1.UserService/CreateServiceAccount"
 	// UserServiceGetUserProcedure is the fully-qualified name of the UserService's GetUser RPC.
 	UserServiceGetUserProcedure = "/altalune.v1.UserService/GetUser"
 	// UserServiceUpdateUserProcedure is the fully-qualified name of the UserService's UpdateUser RPC.
 	UserServiceUpdateUserProcedure = "/altalune.v1.UserService/UpdateUser"
 	// UserServiceDeleteUserProcedure is the fully-qualified name of the UserService's DeleteUser RPC.
 	UserServiceDeleteUserProcedure = "/altalune.v1.UserService/DeleteUser"
+	// UserServiceRestoreUserProcedure is the fully-qualified name of the UserService's RestoreUser RPC.
+	UserServiceRestoreUserProcedure = "/altalune.v1.UserService/RestoreUser"
 	// UserServiceActivateUserProcedure is the fully-qualified name of the UserService's ActivateUser
 	// RPC.
 	UserServiceActivateUserProcedure = "/altalune.v1.UserService/ActivateUser"
 	// UserServiceDeactivateUserProcedure is the fully-qualified name of the UserService's
 	// DeactivateUser RPC.
 	UserServiceDeactivateUserProcedure = "/altalune.v1.UserService/DeactivateUser"
+	// UserServiceQueryPendingUsersProcedure is the fully-qualified name of the UserService's
+	// QueryPendingUsers RPC.
+	UserServiceQueryPendingUsersProcedure = "/altalune.v1.UserService/QueryPendingUsers"
+	// UserServiceApproveUserProcedure is the fully-qualified name of the UserService's ApproveUser RPC.
+	UserServiceApproveUserProcedure = "/altalune.v1.UserService/ApproveUser"
+	// UserServiceRejectUserProcedure is the fully-qualified name of the UserService's RejectUser RPC.
+	UserServiceRejectUserProcedure = "/altalune.v1.UserService/RejectUser"
+	// UserServiceUnlockUserProcedure is the fully-qualified name of the UserService's UnlockUser RPC.
+	UserServiceUnlockUserProcedure = "/altalune.v1.UserService/UnlockUser"
+	// UserServiceListEmailVerificationTokensProcedure is the fully-qualified name of the UserService's
+	// ListEmailVerificationTokens RPC.
+	UserServiceListEmailVerificationTokensProcedure = "/altalune.v1.UserService/ListEmailVerificationTokens"
+	// UserServiceInvalidateEmailVerificationTokensProcedure is the fully-qualified name of the
+	// UserService's InvalidateEmailVerificationTokens RPC.
+	UserServiceInvalidateEmailVerificationTokensProcedure = "/altalune.v1.UserService/InvalidateEmailVerificationTokens"
+	// UserServiceForceEmailReverificationProcedure is the fully-qualified name of the UserService's
+	// ForceEmailReverification RPC.
+	UserServiceForceEmailReverificationProcedure = "/altalune.v1.UserService/ForceEmailReverification"
+	// UserServiceGetEmailVerificationStatsProcedure is the fully-qualified name of the UserService's
+	// GetEmailVerificationStats RPC.
+	UserServiceGetEmailVerificationStatsProcedure = "/altalune.v1.UserService/GetEmailVerificationStats"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	userServiceServiceDescriptor              = v1.File_altalune_v1_user_proto.Services().ByName("UserService")
-	userServiceQueryUsersMethodDescriptor     = userServiceServiceDescriptor.Methods().ByName("QueryUsers")
-	userServiceCreateUserMethodDescriptor     = userServiceServiceDescriptor.Methods().ByName("CreateUser")
-	userServiceGetUserMethodDescriptor        = userServiceServiceDescriptor.Methods().ByName("GetUser")
-	userServiceUpdateUserMethodDescriptor     = userServiceServiceDescriptor.Methods().ByName("UpdateUser")
-	userServiceDeleteUserMethodDescriptor     = userServiceServiceDescriptor.Methods().ByName("DeleteUser")
-	userServiceActivateUserMethodDescriptor   = userServiceServiceDescriptor.Methods().ByName("ActivateUser")
-	userServiceDeactivateUserMethodDescriptor = userServiceServiceDescriptor.Methods().ByName("DeactivateUser")
+	userServiceServiceDescriptor                                 = v1.File_altalune_v1_user_proto.Services().ByName("UserService")
+	userServiceQueryUsersMethodDescriptor                        = userServiceServiceDescriptor.Methods().ByName("QueryUsers")
+	userServiceStreamUsersMethodDescriptor                       = userServiceServiceDescriptor.Methods().ByName("StreamUsers")
+	userServiceCreateUserMethodDescriptor                        = userServiceServiceDescriptor.Methods().ByName("CreateUser")
+	userServiceCreateServiceAccountMethodDescriptor              = userServiceServiceDescriptor.Methods().ByName("CreateServiceAccount")
+	userServiceGetUserMethodDescriptor                           = userServiceServiceDescriptor.Methods().ByName("GetUser")
+	userServiceUpdateUserMethodDescriptor                        = userServiceServiceDescriptor.Methods().ByName("UpdateUser")
+	userServiceDeleteUserMethodDescriptor                        = userServiceServiceDescriptor.Methods().ByName("DeleteUser")
+	userServiceRestoreUserMethodDescriptor                       = userServiceServiceDescriptor.Methods().ByName("RestoreUser")
+	userServiceActivateUserMethodDescriptor                      = userServiceServiceDescriptor.Methods().ByName("ActivateUser")
+	userServiceDeactivateUserMethodDescriptor                    = userServiceServiceDescriptor.Methods().ByName("DeactivateUser")
+	userServiceQueryPendingUsersMethodDescriptor                 = userServiceServiceDescriptor.Methods().ByName("QueryPendingUsers")
+	userServiceApproveUserMethodDescriptor                       = userServiceServiceDescriptor.Methods().ByName("ApproveUser")
+	userServiceRejectUserMethodDescriptor                        = userServiceServiceDescriptor.Methods().ByName("RejectUser")
+	userServiceUnlockUserMethodDescriptor                        = userServiceServiceDescriptor.Methods().ByName("UnlockUser")
+	userServiceListEmailVerificationTokensMethodDescriptor       = userServiceServiceDescriptor.Methods().ByName("ListEmailVerificationTokens")
+	userServiceInvalidateEmailVerificationTokensMethodDescriptor = userServiceServiceDescriptor.Methods().ByName("InvalidateEmailVerificationTokens")
+	userServiceForceEmailReverificationMethodDescriptor          = userServiceServiceDescriptor.Methods().ByName("ForceEmailReverification")
+	userServiceGetEmailVerificationStatsMethodDescriptor         = userServiceServiceDescriptor.Methods().ByName("GetEmailVerificationStats")
 )
 
 // UserServiceClient is a client for the altalune.v1.UserService service.
 type UserServiceClient interface {
 	QueryUsers(context.Context, *connect.Request[v1.QueryUsersRequest]) (*connect.Response[v1.QueryUsersResponse], error)
+	StreamUsers(context.Context, *connect.Request[v1.StreamUsersRequest]) (*connect.ServerStreamForClient[v1.StreamUsersResponse], error)
 	CreateUser(context.Context, *connect.Request[v1.CreateUserRequest]) (*connect.Response[v1.CreateUserResponse], error)
+	CreateServiceAccount(context.Context, *connect.Request[v1.CreateServiceAccountRequest]) (*connect.Response[v1.CreateServiceAccountResponse], error)
 	GetUser(context.Context, *connect.Request[v1.GetUserRequest]) (*connect.Response[v1.GetUserResponse], error)
 	UpdateUser(context.Context, *connect.Request[v1.UpdateUserRequest]) (*connect.Response[v1.UpdateUserResponse], error)
 	DeleteUser(context.Context, *connect.Request[v1.DeleteUserRequest]) (*connect.Response[v1.DeleteUserResponse], error)
+	RestoreUser(context.Context, *connect.Request[v1.RestoreUserRequest]) (*connect.Response[v1.RestoreUserResponse], error)
 	ActivateUser(context.Context, *connect.Request[v1.ActivateUserRequest]) (*connect.Response[v1.ActivateUserResponse], error)
 	DeactivateUser(context.Context, *connect.Request[v1.DeactivateUserRequest]) (*connect.Response[v1.DeactivateUserResponse], error)
+	QueryPendingUsers(context.Context, *connect.Request[v1.QueryPendingUsersRequest]) (*connect.Response[v1.QueryPendingUsersResponse], error)
+	ApproveUser(context.Context, *connect.Request[v1.ApproveUserRequest]) (*connect.Response[v1.ApproveUserResponse], error)
+	RejectUser(context.Context, *connect.Request[v1.RejectUserRequest]) (*connect.Response[v1.RejectUserResponse], error)
+	UnlockUser(context.Context, *connect.Request[v1.UnlockUserRequest]) (*connect.Response[v1.UnlockUserResponse], error)
+	ListEmailVerificationTokens(context.Context, *connect.Request[v1.ListEmailVerificationTokensRequest]) (*connect.Response[v1.ListEmailVerificationTokensResponse], error)
+	InvalidateEmailVerificationTokens(context.Context, *connect.Request[v1.InvalidateEmailVerificationTokensRequest]) (*connect.Response[v1.InvalidateEmailVerificationTokensResponse], error)
+	ForceEmailReverification(context.Context, *connect.Request[v1.ForceEmailReverificationRequest]) (*connect.Response[v1.ForceEmailReverificationResponse], error)
+	GetEmailVerificationStats(context.Context, *connect.Request[v1.GetEmailVerificationStatsRequest]) (*connect.Response[v1.GetEmailVerificationStatsResponse], error)
 }
 
 // NewUserServiceClient constructs a client for the altalune.v1.UserService service. By default, it
@@ -90,12 +140,24 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(userServiceQueryUsersMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		streamUsers: connect.NewClient[v1.StreamUsersRequest, v1.StreamUsersResponse](
+			httpClient,
+			baseURL+UserServiceStreamUsersProcedure,
+			connect.WithSchema(userServiceStreamUsersMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		createUser: connect.NewClient[v1.CreateUserRequest, v1.CreateUserResponse](
 			httpClient,
 			baseURL+UserServiceCreateUserProcedure,
 			connect.WithSchema(userServiceCreateUserMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		createServiceAccount: connect.NewClient[v1.CreateServiceAccountRequest, v1.CreateServiceAccountResponse](
+			httpClient,
+			baseURL+UserServiceCreateServiceAccountProcedure,
+			connect.WithSchema(userServiceCreateServiceAccountMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		getUser: connect.NewClient[v1.GetUserRequest, v1.GetUserResponse](
 			httpClient,
 			baseURL+UserServiceGetUserProcedure,
@@ -114,6 +176,12 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(userServiceDeleteUserMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		restoreUser: connect.NewClient[v1.RestoreUserRequest, v1.RestoreUserResponse](
+			httpClient,
+			baseURL+UserServiceRestoreUserProcedure,
+			connect.WithSchema(userServiceRestoreUserMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		activateUser: connect.NewClient[v1.ActivateUserRequest, v1.ActivateUserResponse](
 			httpClient,
 			baseURL+UserServiceActivateUserProcedure,
@@ -126,18 +194,77 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(userServiceDeactivateUserMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		queryPendingUsers: connect.NewClient[v1.QueryPendingUsersRequest, v1.QueryPendingUsersResponse](
+			httpClient,
+			baseURL+UserServiceQueryPendingUsersProcedure,
+			connect.WithSchema(userServiceQueryPendingUsersMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		approveUser: connect.NewClient[v1.ApproveUserRequest, v1.ApproveUserResponse](
+			httpClient,
+			baseURL+UserServiceApproveUserProcedure,
+			connect.WithSchema(userServiceApproveUserMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		rejectUser: connect.NewClient[v1.RejectUserRequest, v1.RejectUserResponse](
+			httpClient,
+			baseURL+UserServiceRejectUserProcedure,
+			connect.WithSchema(userServiceRejectUserMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		unlockUser: connect.NewClient[v1.UnlockUserRequest, v1.UnlockUserResponse](
+			httpClient,
+			baseURL+UserServiceUnlockUserProcedure,
+			connect.WithSchema(userServiceUnlockUserMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listEmailVerificationTokens: connect.NewClient[v1.ListEmailVerificationTokensRequest, v1.ListEmailVerificationTokensResponse](
+			httpClient,
+			baseURL+UserServiceListEmailVerificationTokensProcedure,
+			connect.WithSchema(userServiceListEmailVerificationTokensMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		invalidateEmailVerificationTokens: connect.NewClient[v1.InvalidateEmailVerificationTokensRequest, v1.InvalidateEmailVerificationTokensResponse](
+			httpClient,
+			baseURL+UserServiceInvalidateEmailVerificationTokensProcedure,
+			connect.WithSchema(userServiceInvalidateEmailVerificationTokensMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		forceEmailReverification: connect.NewClient[v1.ForceEmailReverificationRequest, v1.ForceEmailReverificationResponse](
+			httpClient,
+			baseURL+UserServiceForceEmailReverificationProcedure,
+			connect.WithSchema(userServiceForceEmailReverificationMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getEmailVerificationStats: connect.NewClient[v1.GetEmailVerificationStatsRequest, v1.GetEmailVerificationStatsResponse](
+			httpClient,
+			baseURL+UserServiceGetEmailVerificationStatsProcedure,
+			connect.WithSchema(userServiceGetEmailVerificationStatsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // userServiceClient implements UserServiceClient.
 type userServiceClient struct {
-	queryUsers     *connect.Client[v1.QueryUsersRequest, v1.QueryUsersResponse]
-	createUser     *connect.Client[v1.CreateUserRequest, v1.CreateUserResponse]
-	getUser        *connect.Client[v1.GetUserRequest, v1.GetUserResponse]
-	updateUser     *connect.Client[v1.UpdateUserRequest, v1.UpdateUserResponse]
-	deleteUser     *connect.Client[v1.DeleteUserRequest, v1.DeleteUserResponse]
-	activateUser   *connect.Client[v1.ActivateUserRequest, v1.ActivateUserResponse]
-	deactivateUser *connect.Client[v1.DeactivateUserRequest, v1.DeactivateUserResponse]
+	queryUsers                        *connect.Client[v1.QueryUsersRequest, v1.QueryUsersResponse]
+	streamUsers                       *connect.Client[v1.StreamUsersRequest, v1.StreamUsersResponse]
+	createUser                        *connect.Client[v1.CreateUserRequest, v1.CreateUserResponse]
+	createServiceAccount              *connect.Client[v1.CreateServiceAccountRequest, v1.CreateServiceAccountResponse]
+	getUser                           *connect.Client[v1.GetUserRequest, v1.GetUserResponse]
+	updateUser                        *connect.Client[v1.UpdateUserRequest, v1.UpdateUserResponse]
+	deleteUser                        *connect.Client[v1.DeleteUserRequest, v1.DeleteUserResponse]
+	restoreUser                       *connect.Client[v1.RestoreUserRequest, v1.RestoreUserResponse]
+	activateUser                      *connect.Client[v1.ActivateUserRequest, v1.ActivateUserResponse]
+	deactivateUser                    *connect.Client[v1.DeactivateUserRequest, v1.DeactivateUserResponse]
+	queryPendingUsers                 *connect.Client[v1.QueryPendingUsersRequest, v1.QueryPendingUsersResponse]
+	approveUser                       *connect.Client[v1.ApproveUserRequest, v1.ApproveUserResponse]
+	rejectUser                        *connect.Client[v1.RejectUserRequest, v1.RejectUserResponse]
+	unlockUser                        *connect.Client[v1.UnlockUserRequest, v1.UnlockUserResponse]
+	listEmailVerificationTokens       *connect.Client[v1.ListEmailVerificationTokensRequest, v1.ListEmailVerificationTokensResponse]
+	invalidateEmailVerificationTokens *connect.Client[v1.InvalidateEmailVerificationTokensRequest, v1.InvalidateEmailVerificationTokensResponse]
+	forceEmailReverification          *connect.Client[v1.ForceEmailReverificationRequest, v1.ForceEmailReverificationResponse]
+	getEmailVerificationStats         *connect.Client[v1.GetEmailVerificationStatsRequest, v1.GetEmailVerificationStatsResponse]
 }
 
 // QueryUsers calls altalune.v1.UserService.QueryUsers.
@@ -145,11 +272,21 @@ func (c *userServiceClient) QueryUsers(ctx context.Context, req *connect.Request
 	return c.queryUsers.CallUnary(ctx, req)
 }
 
+// StreamUsers calls altalune.v1.UserService.StreamUsers.
+func (c *userServiceClient) StreamUsers(ctx context.Context, req *connect.Request[v1.StreamUsersRequest]) (*connect.ServerStreamForClient[v1.StreamUsersResponse], error) {
+	return c.streamUsers.CallServerStream(ctx, req)
+}
+
 // CreateUser calls altalune.v1.UserService.CreateUser.
 func (c *userServiceClient) CreateUser(ctx context.Context, req *connect.Request[v1.CreateUserRequest]) (*connect.Response[v1.CreateUserResponse], error) {
 	return c.createUser.CallUnary(ctx, req)
 }
 
+// CreateServiceAccount calls altalune.v1.UserService.CreateServiceAccount.
+func (c *userServiceClient) CreateServiceAccount(ctx context.Context, req *connect.Request[v1.CreateServiceAccountRequest]) (*connect.Response[v1.CreateServiceAccountResponse], error) {
+	return c.createServiceAccount.CallUnary(ctx, req)
+}
+
 // GetUser calls altalune.v1.UserService.GetUser.
 func (c *userServiceClient) GetUser(ctx context.Context, req *connect.Request[v1.GetUserRequest]) (*connect.Response[v1.GetUserResponse], error) {
 	return c.getUser.CallUnary(ctx, req)
@@ -165,6 +302,11 @@ func (c *userServiceClient) DeleteUser(ctx context.Context, req *connect.Request
 	return c.deleteUser.CallUnary(ctx, req)
 }
 
+// RestoreUser calls altalune.v1.UserService.RestoreUser.
+func (c *userServiceClient) RestoreUser(ctx context.Context, req *connect.Request[v1.RestoreUserRequest]) (*connect.Response[v1.RestoreUserResponse], error) {
+	return c.restoreUser.CallUnary(ctx, req)
+}
+
 // ActivateUser calls altalune.v1.UserService.ActivateUser.
 func (c *userServiceClient) ActivateUser(ctx context.Context, req *connect.Request[v1.ActivateUserRequest]) (*connect.Response[v1.ActivateUserResponse], error) {
 	return c.activateUser.CallUnary(ctx, req)
@@ -175,15 +317,67 @@ func (c *userServiceClient) DeactivateUser(ctx context.Context, req *connect.Req
 	return c.deactivateUser.CallUnary(ctx, req)
 }
 
+// QueryPendingUsers calls altalune.v1.UserService.QueryPendingUsers.
+func (c *userServiceClient) QueryPendingUsers(ctx context.Context, req *connect.Request[v1.QueryPendingUsersRequest]) (*connect.Response[v1.QueryPendingUsersResponse], error) {
+	return c.queryPendingUsers.CallUnary(ctx, req)
+}
+
+// ApproveUser calls altalune.v1.UserService.ApproveUser.
+func (c *userServiceClient) ApproveUser(ctx context.Context, req *connect.Request[v1.ApproveUserRequest]) (*connect.Response[v1.ApproveUserResponse], error) {
+	return c.approveUser.CallUnary(ctx, req)
+}
+
+// RejectUser calls altalune.v1.UserService.RejectUser.
+func (c *userServiceClient) RejectUser(ctx context.Context, req *connect.Request[v1.RejectUserRequest]) (*connect.Response[v1.RejectUserResponse], error) {
+	return c.rejectUser.CallUnary(ctx, req)
+}
+
+// UnlockUser calls altalune.v1.UserService.UnlockUser.
+func (c *userServiceClient) UnlockUser(ctx context.Context, req *connect.Request[v1.UnlockUserRequest]) (*connect.Response[v1.UnlockUserResponse], error) {
+	return c.unlockUser.CallUnary(ctx, req)
+}
+
+// ListEmailVerificationTokens calls altalune.v1.UserService.ListEmailVerificationTokens.
+func (c *userServiceClient) ListEmailVerificationTokens(ctx context.Context, req *connect.Request[v1.ListEmailVerificationTokensRequest]) (*connect.Response[v1.ListEmailVerificationTokensResponse], error) {
+	return c.listEmailVerificationTokens.CallUnary(ctx, req)
+}
+
+// InvalidateEmailVerificationTokens calls
+// altalune.v1.UserService.InvalidateEmailVerificationTokens.
+func (c *userServiceClient) InvalidateEmailVerificationTokens(ctx context.Context, req *connect.Request[v1.InvalidateEmailVerificationTokensRequest]) (*connect.Response[v1.InvalidateEmailVerificationTokensResponse], error) {
+	return c.invalidateEmailVerificationTokens.CallUnary(ctx, req)
+}
+
+// ForceEmailReverification calls altalune.v1.UserService.ForceEmailReverification.
+func (c *userServiceClient) ForceEmailReverification(ctx context.Context, req *connect.Request[v1.ForceEmailReverificationRequest]) (*connect.Response[v1.ForceEmailReverificationResponse], error) {
+	return c.forceEmailReverification.CallUnary(ctx, req)
+}
+
+// GetEmailVerificationStats calls altalune.v1.UserService.GetEmailVerificationStats.
+func (c *userServiceClient) GetEmailVerificationStats(ctx context.Context, req *connect.Request[v1.GetEmailVerificationStatsRequest]) (*connect.Response[v1.GetEmailVerificationStatsResponse], error) {
+	return c.getEmailVerificationStats.CallUnary(ctx, req)
+}
+
 // UserServiceHandler is an implementation of the altalune.v1.UserService service.
 type UserServiceHandler interface {
 	QueryUsers(context.Context, *connect.Request[v1.QueryUsersRequest]) (*connect.Response[v1.QueryUsersResponse], error)
+	StreamUsers(context.Context, *connect.Request[v1.StreamUsersRequest], *connect.ServerStream[v1.StreamUsersResponse]) error
 	CreateUser(context.Context, *connect.Request[v1.CreateUserRequest]) (*connect.Response[v1.CreateUserResponse], error)
+	CreateServiceAccount(context.Context, *connect.Request[v1.CreateServiceAccountRequest]) (*connect.Response[v1.CreateServiceAccountResponse], error)
 	GetUser(context.Context, *connect.Request[v1.GetUserRequest]) (*connect.Response[v1.GetUserResponse], error)
 	UpdateUser(context.Context, *connect.Request[v1.UpdateUserRequest]) (*connect.Response[v1.UpdateUserResponse], error)
 	DeleteUser(context.Context, *connect.Request[v1.DeleteUserRequest]) (*connect.Response[v1.DeleteUserResponse], error)
+	RestoreUser(context.Context, *connect.Request[v1.RestoreUserRequest]) (*connect.Response[v1.RestoreUserResponse], error)
 	ActivateUser(context.Context, *connect.Request[v1.ActivateUserRequest]) (*connect.Response[v1.ActivateUserResponse], error)
 	DeactivateUser(context.Context, *connect.Request[v1.DeactivateUserRequest]) (*connect.Response[v1.DeactivateUserResponse], error)
+	QueryPendingUsers(context.Context, *connect.Request[v1.QueryPendingUsersRequest]) (*connect.Response[v1.QueryPendingUsersResponse], error)
+	ApproveUser(context.Context, *connect.Request[v1.ApproveUserRequest]) (*connect.Response[v1.ApproveUserResponse], error)
+	RejectUser(context.Context, *connect.Request[v1.RejectUserRequest]) (*connect.Response[v1.RejectUserResponse], error)
+	UnlockUser(context.Context, *connect.Request[v1.UnlockUserRequest]) (*connect.Response[v1.UnlockUserResponse], error)
+	ListEmailVerificationTokens(context.Context, *connect.Request[v1.ListEmailVerificationTokensRequest]) (*connect.Response[v1.ListEmailVerificationTokensResponse], error)
+	InvalidateEmailVerificationTokens(context.Context, *connect.Request[v1.InvalidateEmailVerificationTokensRequest]) (*connect.Response[v1.InvalidateEmailVerificationTokensResponse], error)
+	ForceEmailReverification(context.Context, *connect.Request[v1.ForceEmailReverificationRequest]) (*connect.Response[v1.ForceEmailReverificationResponse], error)
+	GetEmailVerificationStats(context.Context, *connect.Request[v1.GetEmailVerificationStatsRequest]) (*connect.Response[v1.GetEmailVerificationStatsResponse], error)
 }
 
 // NewUserServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -198,12 +392,24 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(userServiceQueryUsersMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	userServiceStreamUsersHandler := connect.NewServerStreamHandler(
+		UserServiceStreamUsersProcedure,
+		svc.StreamUsers,
+		connect.WithSchema(userServiceStreamUsersMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	userServiceCreateUserHandler := connect.NewUnaryHandler(
 		UserServiceCreateUserProcedure,
 		svc.CreateUser,
 		connect.WithSchema(userServiceCreateUserMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	userServiceCreateServiceAccountHandler := connect.NewUnaryHandler(
+		UserServiceCreateServiceAccountProcedure,
+		svc.CreateServiceAccount,
+		connect.WithSchema(userServiceCreateServiceAccountMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	userServiceGetUserHandler := connect.NewUnaryHandler(
 		UserServiceGetUserProcedure,
 		svc.GetUser,
@@ -222,6 +428,12 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(userServiceDeleteUserMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	userServiceRestoreUserHandler := connect.NewUnaryHandler(
+		UserServiceRestoreUserProcedure,
+		svc.RestoreUser,
+		connect.WithSchema(userServiceRestoreUserMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	userServiceActivateUserHandler := connect.NewUnaryHandler(
 		UserServiceActivateUserProcedure,
 		svc.ActivateUser,
@@ -234,22 +446,92 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(userServiceDeactivateUserMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	userServiceQueryPendingUsersHandler := connect.NewUnaryHandler(
+		UserServiceQueryPendingUsersProcedure,
+		svc.QueryPendingUsers,
+		connect.WithSchema(userServiceQueryPendingUsersMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceApproveUserHandler := connect.NewUnaryHandler(
+		UserServiceApproveUserProcedure,
+		svc.ApproveUser,
+		connect.WithSchema(userServiceApproveUserMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceRejectUserHandler := connect.NewUnaryHandler(
+		UserServiceRejectUserProcedure,
+		svc.RejectUser,
+		connect.WithSchema(userServiceRejectUserMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceUnlockUserHandler := connect.NewUnaryHandler(
+		UserServiceUnlockUserProcedure,
+		svc.UnlockUser,
+		connect.WithSchema(userServiceUnlockUserMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceListEmailVerificationTokensHandler := connect.NewUnaryHandler(
+		UserServiceListEmailVerificationTokensProcedure,
+		svc.ListEmailVerificationTokens,
+		connect.WithSchema(userServiceListEmailVerificationTokensMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceInvalidateEmailVerificationTokensHandler := connect.NewUnaryHandler(
+		UserServiceInvalidateEmailVerificationTokensProcedure,
+		svc.InvalidateEmailVerificationTokens,
+		connect.WithSchema(userServiceInvalidateEmailVerificationTokensMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceForceEmailReverificationHandler := connect.NewUnaryHandler(
+		UserServiceForceEmailReverificationProcedure,
+		svc.ForceEmailReverification,
+		connect.WithSchema(userServiceForceEmailReverificationMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceGetEmailVerificationStatsHandler := connect.NewUnaryHandler(
+		UserServiceGetEmailVerificationStatsProcedure,
+		svc.GetEmailVerificationStats,
+		connect.WithSchema(userServiceGetEmailVerificationStatsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/altalune.v1.UserService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case UserServiceQueryUsersProcedure:
 			userServiceQueryUsersHandler.ServeHTTP(w, r)
+		case UserServiceStreamUsersProcedure:
+			userServiceStreamUsersHandler.ServeHTTP(w, r)
 		case UserServiceCreateUserProcedure:
 			userServiceCreateUserHandler.ServeHTTP(w, r)
+		case UserServiceCreateServiceAccountProcedure:
+			userServiceCreateServiceAccountHandler.ServeHTTP(w, r)
 		case UserServiceGetUserProcedure:
 			userServiceGetUserHandler.ServeHTTP(w, r)
 		case UserServiceUpdateUserProcedure:
 			userServiceUpdateUserHandler.ServeHTTP(w, r)
 		case UserServiceDeleteUserProcedure:
 			userServiceDeleteUserHandler.ServeHTTP(w, r)
+		case UserServiceRestoreUserProcedure:
+			userServiceRestoreUserHandler.ServeHTTP(w, r)
 		case UserServiceActivateUserProcedure:
 			userServiceActivateUserHandler.ServeHTTP(w, r)
 		case UserServiceDeactivateUserProcedure:
 			userServiceDeactivateUserHandler.ServeHTTP(w, r)
+		case UserServiceQueryPendingUsersProcedure:
+			userServiceQueryPendingUsersHandler.ServeHTTP(w, r)
+		case UserServiceApproveUserProcedure:
+			userServiceApproveUserHandler.ServeHTTP(w, r)
+		case UserServiceRejectUserProcedure:
+			userServiceRejectUserHandler.ServeHTTP(w, r)
+		case UserServiceUnlockUserProcedure:
+			userServiceUnlockUserHandler.ServeHTTP(w, r)
+		case UserServiceListEmailVerificationTokensProcedure:
+			userServiceListEmailVerificationTokensHandler.ServeHTTP(w, r)
+		case UserServiceInvalidateEmailVerificationTokensProcedure:
+			userServiceInvalidateEmailVerificationTokensHandler.ServeHTTP(w, r)
+		case UserServiceForceEmailReverificationProcedure:
+			userServiceForceEmailReverificationHandler.ServeHTTP(w, r)
+		case UserServiceGetEmailVerificationStatsProcedure:
+			userServiceGetEmailVerificationStatsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -263,10 +545,18 @@ func (UnimplementedUserServiceHandler) QueryUsers(context.Context, *connect.Requ
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.UserService.QueryUsers is not implemented"))
 }
 
+func (UnimplementedUserServiceHandler) StreamUsers(context.Context, *connect.Request[v1.StreamUsersRequest], *connect.ServerStream[v1.StreamUsersResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.UserService.StreamUsers is not implemented"))
+}
+
 func (UnimplementedUserServiceHandler) CreateUser(context.Context, *connect.Request[v1.CreateUserRequest]) (*connect.Response[v1.CreateUserResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.UserService.CreateUser is not implemented"))
 }
 
+func (UnimplementedUserServiceHandler) CreateServiceAccount(context.Context, *connect.Request[v1.CreateServiceAccountRequest]) (*connect.Response[v1.CreateServiceAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.UserService.CreateServiceAccount is not implemented"))
+}
+
 func (UnimplementedUserServiceHandler) GetUser(context.Context, *connect.Request[v1.GetUserRequest]) (*connect.Response[v1.GetUserResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.UserService.GetUser is not implemented"))
 }
@@ -279,6 +569,10 @@ func (UnimplementedUserServiceHandler) DeleteUser(context.Context, *connect.Requ
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.UserService.DeleteUser is not implemented"))
 }
 
+func (UnimplementedUserServiceHandler) RestoreUser(context.Context, *connect.Request[v1.RestoreUserRequest]) (*connect.Response[v1.RestoreUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.UserService.RestoreUser is not implemented"))
+}
+
 func (UnimplementedUserServiceHandler) ActivateUser(context.Context, *connect.Request[v1.ActivateUserRequest]) (*connect.Response[v1.ActivateUserResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.UserService.ActivateUser is not implemented"))
 }
@@ -286,3 +580,35 @@ func (UnimplementedUserServiceHandler) ActivateUser(context.Context, *connect.Re
 func (UnimplementedUserServiceHandler) DeactivateUser(context.Context, *connect.Request[v1.DeactivateUserRequest]) (*connect.Response[v1.DeactivateUserResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.UserService.DeactivateUser is not implemented"))
 }
+
+func (UnimplementedUserServiceHandler) QueryPendingUsers(context.Context, *connect.Request[v1.QueryPendingUsersRequest]) (*connect.Response[v1.QueryPendingUsersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.UserService.QueryPendingUsers is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ApproveUser(context.Context, *connect.Request[v1.ApproveUserRequest]) (*connect.Response[v1.ApproveUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.UserService.ApproveUser is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) RejectUser(context.Context, *connect.Request[v1.RejectUserRequest]) (*connect.Response[v1.RejectUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.UserService.RejectUser is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) UnlockUser(context.Context, *connect.Request[v1.UnlockUserRequest]) (*connect.Response[v1.UnlockUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.UserService.UnlockUser is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ListEmailVerificationTokens(context.Context, *connect.Request[v1.ListEmailVerificationTokensRequest]) (*connect.Response[v1.ListEmailVerificationTokensResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.UserService.ListEmailVerificationTokens is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) InvalidateEmailVerificationTokens(context.Context, *connect.Request[v1.InvalidateEmailVerificationTokensRequest]) (*connect.Response[v1.InvalidateEmailVerificationTokensResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.UserService.InvalidateEmailVerificationTokens is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ForceEmailReverification(context.Context, *connect.Request[v1.ForceEmailReverificationRequest]) (*connect.Response[v1.ForceEmailReverificationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.UserService.ForceEmailReverification is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) GetEmailVerificationStats(context.Context, *connect.Request[v1.GetEmailVerificationStatsRequest]) (*connect.Response[v1.GetEmailVerificationStatsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("altalune.v1.UserService.GetEmailVerificationStats is not implemented"))
+}
@@ -23,12 +23,76 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ApiKeyStatus - the combined status of an API key, from whether it is active
+// and how close its expiration is, counting days in the project's time zone.
+// Also the values of the "statuses" filter, lowercased without the prefix.
+type ApiKeyStatus int32
+
+const (
+	ApiKeyStatus_API_KEY_STATUS_UNSPECIFIED   ApiKeyStatus = 0
+	ApiKeyStatus_API_KEY_STATUS_ACTIVE        ApiKeyStatus = 1 // Active and expiring after the next 10 days
+	ApiKeyStatus_API_KEY_STATUS_INACTIVE      ApiKeyStatus = 2 // Deactivated before its expiration
+	ApiKeyStatus_API_KEY_STATUS_EXPIRED       ApiKeyStatus = 3 // Past its expiration, whether active or not
+	ApiKeyStatus_API_KEY_STATUS_EXPIRING_SOON ApiKeyStatus = 4 // Active and expiring within today and the next 10 days
+)
+
+// Enum value maps for ApiKeyStatus.
+var (
+	ApiKeyStatus_name = map[int32]string{
+		0: "API_KEY_STATUS_UNSPECIFIED",
+		1: "API_KEY_STATUS_ACTIVE",
+		2: "API_KEY_STATUS_INACTIVE",
+		3: "API_KEY_STATUS_EXPIRED",
+		4: "API_KEY_STATUS_EXPIRING_SOON",
+	}
+	ApiKeyStatus_value = map[string]int32{
+		"API_KEY_STATUS_UNSPECIFIED":   0,
+		"API_KEY_STATUS_ACTIVE":        1,
+		"API_KEY_STATUS_INACTIVE":      2,
+		"API_KEY_STATUS_EXPIRED":       3,
+		"API_KEY_STATUS_EXPIRING_SOON": 4,
+	}
+)
+
+func (x ApiKeyStatus) Enum() *ApiKeyStatus {
+	p := new(ApiKeyStatus)
+	*p = x
+	return p
+}
+
+func (x ApiKeyStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ApiKeyStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_altalune_v1_api_key_proto_enumTypes[0].Descriptor()
+}
+
+func (ApiKeyStatus) Type() protoreflect.EnumType {
+	return &file_altalune_v1_api_key_proto_enumTypes[0]
+}
+
+func (x ApiKeyStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ApiKeyStatus.Descriptor instead.
+func (ApiKeyStatus) EnumDescriptor() ([]byte, []int) {
+	return file_altalune_v1_api_key_proto_rawDescGZIP(), []int{0}
+}
+
 type ApiKey struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Expiration    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expiration,proto3" json:"expiration,omitempty"`
-	Active        bool                   `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"` // Whether the API key is active or deactivated
+	Active        bool                   `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`                               // Whether the API key is active or deactivated
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`         // Set only for API keys in the trash
+	CreatedBy     string                 `protobuf:"bytes,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`         // Public ID of the user who created the API key, empty if unknown
+	UpdatedBy     string                 `protobuf:"bytes,7,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`         // Public ID of the user who last updated the API key, empty if unknown
+	OwnerId       string                 `protobuf:"bytes,8,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`               // Public ID of the service account the API key belongs to, empty for project keys
+	Status        ApiKeyStatus           `protobuf:"varint,9,opt,name=status,proto3,enum=altalune.v1.ApiKeyStatus" json:"status,omitempty"` // Combined status at the time of the response
+	ExternalId    string                 `protobuf:"bytes,10,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`     // Key of the API key in a provisioning tool, empty if unmanaged
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,98,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,99,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -93,6 +157,48 @@ func (x *ApiKey) GetActive() bool {
 	return false
 }
 
+func (x *ApiKey) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *ApiKey) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *ApiKey) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *ApiKey) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *ApiKey) GetStatus() ApiKeyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ApiKeyStatus_API_KEY_STATUS_UNSPECIFIED
+}
+
+func (x *ApiKey) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
 func (x *ApiKey) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
@@ -108,10 +214,18 @@ func (x *ApiKey) GetUpdatedAt() *timestamppb.Timestamp {
 }
 
 type CreateApiKeyRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Expiration    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId  string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Name       string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Expiration *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// Public ID of the service account the API key belongs to, empty for a
+	// project key
+	OwnerId string `protobuf:"bytes,4,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	// PEM RSA public key to encrypt the key value to, required when the project
+	// delivers secrets to public keys and honored whatever the policy
+	RecipientPublicKey string `protobuf:"bytes,5,opt,name=recipient_public_key,json=recipientPublicKey,proto3" json:"recipient_public_key,omitempty"`
+	// Key of the API key in a provisioning tool, unique within the project
+	ExternalId    string `protobuf:"bytes,6,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -167,13 +281,35 @@ func (x *CreateApiKeyRequest) GetExpiration() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *CreateApiKeyRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *CreateApiKeyRequest) GetRecipientPublicKey() string {
+	if x != nil {
+		return x.RecipientPublicKey
+	}
+	return ""
+}
+
+func (x *CreateApiKeyRequest) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
 type CreateApiKeyResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ApiKey        *ApiKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
-	KeyValue      string                 `protobuf:"bytes,2,opt,name=key_value,json=keyValue,proto3" json:"key_value,omitempty"` // The actual API key value (shown only once)
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey            *ApiKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	KeyValue          string                 `protobuf:"bytes,2,opt,name=key_value,json=keyValue,proto3" json:"key_value,omitempty"` // The actual API key value (shown only once), empty when delivered
+	Message           string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	DeliveredKeyValue *DeliveredSecret       `protobuf:"bytes,4,opt,name=delivered_key_value,json=deliveredKeyValue,proto3" json:"delivered_key_value,omitempty"` // Set instead of key_value when the policy forbids plaintext
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *CreateApiKeyResponse) Reset() {
@@ -227,10 +363,18 @@ func (x *CreateApiKeyResponse) GetMessage() string {
 	return ""
 }
 
+func (x *CreateApiKeyResponse) GetDeliveredKeyValue() *DeliveredSecret {
+	if x != nil {
+		return x.DeliveredKeyValue
+	}
+	return nil
+}
+
 type QueryApiKeysRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
 	Query         *QueryRequest          `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	Trashed       bool                   `protobuf:"varint,3,opt,name=trashed,proto3" json:"trashed,omitempty"` // List deleted API keys instead of live ones
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -279,6 +423,13 @@ func (x *QueryApiKeysRequest) GetQuery() *QueryRequest {
 	return nil
 }
 
+func (x *QueryApiKeysRequest) GetTrashed() bool {
+	if x != nil {
+		return x.Trashed
+	}
+	return false
+}
+
 type QueryApiKeysResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Data          []*ApiKey              `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
@@ -428,13 +579,16 @@ func (x *GetApiKeyResponse) GetApiKey() *ApiKey {
 }
 
 type UpdateApiKeyRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	ApiKeyId      string                 `protobuf:"bytes,2,opt,name=api_key_id,json=apiKeyId,proto3" json:"api_key_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Expiration    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expiration,proto3" json:"expiration,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId  string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	ApiKeyId   string                 `protobuf:"bytes,2,opt,name=api_key_id,json=apiKeyId,proto3" json:"api_key_id,omitempty"`
+	Name       string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Expiration *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// updated_at of the API key the edit is based on. When set, the update is
+	// rejected with FAILED_PRECONDITION if the API key changed since then.
+	ExpectedUpdatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expected_updated_at,json=expectedUpdatedAt,proto3" json:"expected_updated_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *UpdateApiKeyRequest) Reset() {
@@ -495,6 +649,13 @@ func (x *UpdateApiKeyRequest) GetExpiration() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *UpdateApiKeyRequest) GetExpectedUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpectedUpdatedAt
+	}
+	return nil
+}
+
 type UpdateApiKeyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ApiKey        *ApiKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
@@ -643,6 +804,110 @@ func (x *DeleteApiKeyResponse) GetMessage() string {
 	return ""
 }
 
+type RestoreApiKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	ApiKeyId      string                 `protobuf:"bytes,2,opt,name=api_key_id,json=apiKeyId,proto3" json:"api_key_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreApiKeyRequest) Reset() {
+	*x = RestoreApiKeyRequest{}
+	mi := &file_altalune_v1_api_key_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreApiKeyRequest) ProtoMessage() {}
+
+func (x *RestoreApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_api_key_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*RestoreApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_api_key_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RestoreApiKeyRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *RestoreApiKeyRequest) GetApiKeyId() string {
+	if x != nil {
+		return x.ApiKeyId
+	}
+	return ""
+}
+
+type RestoreApiKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey        *ApiKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreApiKeyResponse) Reset() {
+	*x = RestoreApiKeyResponse{}
+	mi := &file_altalune_v1_api_key_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreApiKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreApiKeyResponse) ProtoMessage() {}
+
+func (x *RestoreApiKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_api_key_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreApiKeyResponse.ProtoReflect.Descriptor instead.
+func (*RestoreApiKeyResponse) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_api_key_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RestoreApiKeyResponse) GetApiKey() *ApiKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+func (x *RestoreApiKeyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 type ActivateApiKeyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
@@ -653,7 +918,7 @@ type ActivateApiKeyRequest struct {
 
 func (x *ActivateApiKeyRequest) Reset() {
 	*x = ActivateApiKeyRequest{}
-	mi := &file_altalune_v1_api_key_proto_msgTypes[11]
+	mi := &file_altalune_v1_api_key_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -665,7 +930,7 @@ func (x *ActivateApiKeyRequest) String() string {
 func (*ActivateApiKeyRequest) ProtoMessage() {}
 
 func (x *ActivateApiKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_altalune_v1_api_key_proto_msgTypes[11]
+	mi := &file_altalune_v1_api_key_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -678,7 +943,7 @@ func (x *ActivateApiKeyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ActivateApiKeyRequest.ProtoReflect.Descriptor instead.
 func (*ActivateApiKeyRequest) Descriptor() ([]byte, []int) {
-	return file_altalune_v1_api_key_proto_rawDescGZIP(), []int{11}
+	return file_altalune_v1_api_key_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ActivateApiKeyRequest) GetProjectId() string {
@@ -705,7 +970,7 @@ type ActivateApiKeyResponse struct {
 
 func (x *ActivateApiKeyResponse) Reset() {
 	*x = ActivateApiKeyResponse{}
-	mi := &file_altalune_v1_api_key_proto_msgTypes[12]
+	mi := &file_altalune_v1_api_key_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -717,7 +982,7 @@ func (x *ActivateApiKeyResponse) String() string {
 func (*ActivateApiKeyResponse) ProtoMessage() {}
 
 func (x *ActivateApiKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_altalune_v1_api_key_proto_msgTypes[12]
+	mi := &file_altalune_v1_api_key_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -730,7 +995,7 @@ func (x *ActivateApiKeyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ActivateApiKeyResponse.ProtoReflect.Descriptor instead.
 func (*ActivateApiKeyResponse) Descriptor() ([]byte, []int) {
-	return file_altalune_v1_api_key_proto_rawDescGZIP(), []int{12}
+	return file_altalune_v1_api_key_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ActivateApiKeyResponse) GetApiKey() *ApiKey {
@@ -757,7 +1022,7 @@ type DeactivateApiKeyRequest struct {
 
 func (x *DeactivateApiKeyRequest) Reset() {
 	*x = DeactivateApiKeyRequest{}
-	mi := &file_altalune_v1_api_key_proto_msgTypes[13]
+	mi := &file_altalune_v1_api_key_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -769,7 +1034,7 @@ func (x *DeactivateApiKeyRequest) String() string {
 func (*DeactivateApiKeyRequest) ProtoMessage() {}
 
 func (x *DeactivateApiKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_altalune_v1_api_key_proto_msgTypes[13]
+	mi := &file_altalune_v1_api_key_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -782,7 +1047,7 @@ func (x *DeactivateApiKeyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeactivateApiKeyRequest.ProtoReflect.Descriptor instead.
 func (*DeactivateApiKeyRequest) Descriptor() ([]byte, []int) {
-	return file_altalune_v1_api_key_proto_rawDescGZIP(), []int{13}
+	return file_altalune_v1_api_key_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *DeactivateApiKeyRequest) GetProjectId() string {
@@ -809,7 +1074,7 @@ type DeactivateApiKeyResponse struct {
 
 func (x *DeactivateApiKeyResponse) Reset() {
 	*x = DeactivateApiKeyResponse{}
-	mi := &file_altalune_v1_api_key_proto_msgTypes[14]
+	mi := &file_altalune_v1_api_key_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -821,7 +1086,7 @@ func (x *DeactivateApiKeyResponse) String() string {
 func (*DeactivateApiKeyResponse) ProtoMessage() {}
 
 func (x *DeactivateApiKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_altalune_v1_api_key_proto_msgTypes[14]
+	mi := &file_altalune_v1_api_key_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -834,7 +1099,7 @@ func (x *DeactivateApiKeyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeactivateApiKeyResponse.ProtoReflect.Descriptor instead.
 func (*DeactivateApiKeyResponse) Descriptor() ([]byte, []int) {
-	return file_altalune_v1_api_key_proto_rawDescGZIP(), []int{14}
+	return file_altalune_v1_api_key_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *DeactivateApiKeyResponse) GetApiKey() *ApiKey {
@@ -851,11 +1116,293 @@ func (x *DeactivateApiKeyResponse) GetMessage() string {
 	return ""
 }
 
+// Creates the API key with the external ID, or brings the existing one,
+// restored from the trash, to the given name and expiration. Applying the
+// same request again changes nothing, even once the key has expired.
+type ApplyApiKeyRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId  string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	ExternalId string                 `protobuf:"bytes,2,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	Name       string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Must be in the future when the key is created or its expiration changes
+	Expiration *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// Public ID of the service account the API key belongs to, empty for a
+	// project key. Cannot change once the key exists.
+	OwnerId string `protobuf:"bytes,5,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	// PEM RSA public key to encrypt the key value to when the key is created,
+	// see CreateApiKeyRequest
+	RecipientPublicKey string `protobuf:"bytes,6,opt,name=recipient_public_key,json=recipientPublicKey,proto3" json:"recipient_public_key,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ApplyApiKeyRequest) Reset() {
+	*x = ApplyApiKeyRequest{}
+	mi := &file_altalune_v1_api_key_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyApiKeyRequest) ProtoMessage() {}
+
+func (x *ApplyApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_api_key_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*ApplyApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_api_key_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ApplyApiKeyRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ApplyApiKeyRequest) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *ApplyApiKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApplyApiKeyRequest) GetExpiration() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expiration
+	}
+	return nil
+}
+
+func (x *ApplyApiKeyRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *ApplyApiKeyRequest) GetRecipientPublicKey() string {
+	if x != nil {
+		return x.RecipientPublicKey
+	}
+	return ""
+}
+
+type ApplyApiKeyResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey            *ApiKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	Created           bool                   `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`                                               // Whether the key was created rather than updated
+	KeyValue          string                 `protobuf:"bytes,3,opt,name=key_value,json=keyValue,proto3" json:"key_value,omitempty"`                              // ONLY returned when created, empty when delivered
+	DeliveredKeyValue *DeliveredSecret       `protobuf:"bytes,4,opt,name=delivered_key_value,json=deliveredKeyValue,proto3" json:"delivered_key_value,omitempty"` // Set instead of key_value when the policy forbids plaintext
+	Message           string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ApplyApiKeyResponse) Reset() {
+	*x = ApplyApiKeyResponse{}
+	mi := &file_altalune_v1_api_key_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyApiKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyApiKeyResponse) ProtoMessage() {}
+
+func (x *ApplyApiKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_api_key_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyApiKeyResponse.ProtoReflect.Descriptor instead.
+func (*ApplyApiKeyResponse) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_api_key_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ApplyApiKeyResponse) GetApiKey() *ApiKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+func (x *ApplyApiKeyResponse) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+func (x *ApplyApiKeyResponse) GetKeyValue() string {
+	if x != nil {
+		return x.KeyValue
+	}
+	return ""
+}
+
+func (x *ApplyApiKeyResponse) GetDeliveredKeyValue() *DeliveredSecret {
+	if x != nil {
+		return x.DeliveredKeyValue
+	}
+	return nil
+}
+
+func (x *ApplyApiKeyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Sets the external ID of the live API key with the name, so a provisioning
+// tool can manage a key it did not create
+type ImportApiKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ExternalId    string                 `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportApiKeyRequest) Reset() {
+	*x = ImportApiKeyRequest{}
+	mi := &file_altalune_v1_api_key_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportApiKeyRequest) ProtoMessage() {}
+
+func (x *ImportApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_api_key_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*ImportApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_api_key_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ImportApiKeyRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ImportApiKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImportApiKeyRequest) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+type ImportApiKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey        *ApiKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportApiKeyResponse) Reset() {
+	*x = ImportApiKeyResponse{}
+	mi := &file_altalune_v1_api_key_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportApiKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportApiKeyResponse) ProtoMessage() {}
+
+func (x *ImportApiKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_api_key_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportApiKeyResponse.ProtoReflect.Descriptor instead.
+func (*ImportApiKeyResponse) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_api_key_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ImportApiKeyResponse) GetApiKey() *ApiKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+func (x *ImportApiKeyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 var File_altalune_v1_api_key_proto protoreflect.FileDescriptor
 
 const file_altalune_v1_api_key_proto_rawDesc = "" +
 	"\n" +
-	"\x19altalune/v1/api_key.proto\x12\valtalune.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\x1a\x18altalune/v1/common.proto\"\xf6\x01\n" +
+	"\x19altalune/v1/api_key.proto\x12\valtalune.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\x1a\x18altalune/v1/common.proto\x1a\x19altalune/v1/options.proto\"\xde\x03\n" +
 	"\x06ApiKey\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12:\n" +
@@ -864,24 +1411,41 @@ const file_altalune_v1_api_key_proto_rawDesc = "" +
 	"expiration\x12\x16\n" +
 	"\x06active\x18\x04 \x01(\bR\x06active\x129\n" +
 	"\n" +
+	"deleted_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x06 \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\a \x01(\tR\tupdatedBy\x12\x19\n" +
+	"\bowner_id\x18\b \x01(\tR\aownerId\x121\n" +
+	"\x06status\x18\t \x01(\x0e2\x19.altalune.v1.ApiKeyStatusR\x06status\x12\x1f\n" +
+	"\vexternal_id\x18\n" +
+	" \x01(\tR\n" +
+	"externalId\x129\n" +
+	"\n" +
 	"created_at\x18b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18c \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xc8\x01\n" +
+	"updated_at\x18c \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xeb\x02\n" +
 	"\x13CreateApiKeyRequest\x12*\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\tprojectId\x125\n" +
 	"\x04name\x18\x02 \x01(\tB!\xbaH\x1e\xc8\x01\x01r\x19\x10\x02\x1822\x13^[a-zA-Z0-9\\s\\-_]+$R\x04name\x12N\n" +
 	"\n" +
 	"expiration\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaH\x0f\xc8\x01\x01\xb2\x01\tJ\x05\b\x80Ή\x1e@\x01R\n" +
-	"expiration\"{\n" +
+	"expiration\x12\"\n" +
+	"\bowner_id\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x18\x14R\aownerId\x12:\n" +
+	"\x14recipient_public_key\x18\x05 \x01(\tB\b\xbaH\x05r\x03\x18\x80 R\x12recipientPublicKey\x12A\n" +
+	"\vexternal_id\x18\x06 \x01(\tB \xbaH\x1d\xd8\x01\x01r\x18\x18d2\x14^[A-Za-z0-9._:/@-]+$R\n" +
+	"externalId\"\xc9\x01\n" +
 	"\x14CreateApiKeyResponse\x12,\n" +
 	"\aapi_key\x18\x01 \x01(\v2\x13.altalune.v1.ApiKeyR\x06apiKey\x12\x1b\n" +
 	"\tkey_value\x18\x02 \x01(\tR\bkeyValue\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"r\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12L\n" +
+	"\x13delivered_key_value\x18\x04 \x01(\v2\x1c.altalune.v1.DeliveredSecretR\x11deliveredKeyValue\"\x8c\x01\n" +
 	"\x13QueryApiKeysRequest\x12*\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\tprojectId\x12/\n" +
-	"\x05query\x18\x02 \x01(\v2\x19.altalune.v1.QueryRequestR\x05query\"s\n" +
+	"\x05query\x18\x02 \x01(\v2\x19.altalune.v1.QueryRequestR\x05query\x12\x18\n" +
+	"\atrashed\x18\x03 \x01(\bR\atrashed\"s\n" +
 	"\x14QueryApiKeysResponse\x12'\n" +
 	"\x04data\x18\x01 \x03(\v2\x13.altalune.v1.ApiKeyR\x04data\x122\n" +
 	"\x04meta\x18\x02 \x01(\v2\x1e.altalune.v1.QueryMetaResponseR\x04meta\"i\n" +
@@ -891,7 +1455,7 @@ const file_altalune_v1_api_key_proto_rawDesc = "" +
 	"\n" +
 	"api_key_id\x18\x02 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\bapiKeyId\"A\n" +
 	"\x11GetApiKeyResponse\x12,\n" +
-	"\aapi_key\x18\x01 \x01(\v2\x13.altalune.v1.ApiKeyR\x06apiKey\"\xf3\x01\n" +
+	"\aapi_key\x18\x01 \x01(\v2\x13.altalune.v1.ApiKeyR\x06apiKey\"\xbf\x02\n" +
 	"\x13UpdateApiKeyRequest\x12*\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\tprojectId\x12)\n" +
@@ -900,7 +1464,8 @@ const file_altalune_v1_api_key_proto_rawDesc = "" +
 	"\x04name\x18\x03 \x01(\tB!\xbaH\x1e\xc8\x01\x01r\x19\x10\x02\x1822\x13^[a-zA-Z0-9\\s\\-_]+$R\x04name\x12N\n" +
 	"\n" +
 	"expiration\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaH\x0f\xc8\x01\x01\xb2\x01\tJ\x05\b\x80Ή\x1e@\x01R\n" +
-	"expiration\"^\n" +
+	"expiration\x12J\n" +
+	"\x13expected_updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x11expectedUpdatedAt\"^\n" +
 	"\x14UpdateApiKeyResponse\x12,\n" +
 	"\aapi_key\x18\x01 \x01(\v2\x13.altalune.v1.ApiKeyR\x06apiKey\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\"l\n" +
@@ -910,7 +1475,15 @@ const file_altalune_v1_api_key_proto_rawDesc = "" +
 	"\n" +
 	"api_key_id\x18\x02 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\bapiKeyId\"0\n" +
 	"\x14DeleteApiKeyResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"n\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"m\n" +
+	"\x14RestoreApiKeyRequest\x12*\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\tprojectId\x12)\n" +
+	"\n" +
+	"api_key_id\x18\x02 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\bapiKeyId\"_\n" +
+	"\x15RestoreApiKeyResponse\x12,\n" +
+	"\aapi_key\x18\x01 \x01(\v2\x13.altalune.v1.ApiKeyR\x06apiKey\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"n\n" +
 	"\x15ActivateApiKeyRequest\x12*\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\tprojectId\x12)\n" +
@@ -926,15 +1499,50 @@ const file_altalune_v1_api_key_proto_rawDesc = "" +
 	"api_key_id\x18\x02 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\bapiKeyId\"b\n" +
 	"\x18DeactivateApiKeyResponse\x12,\n" +
 	"\aapi_key\x18\x01 \x01(\v2\x13.altalune.v1.ApiKeyR\x06apiKey\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage2\xf9\x04\n" +
-	"\rApiKeyService\x12U\n" +
-	"\fQueryApiKeys\x12 .altalune.v1.QueryApiKeysRequest\x1a!.altalune.v1.QueryApiKeysResponse\"\x00\x12U\n" +
-	"\fCreateApiKey\x12 .altalune.v1.CreateApiKeyRequest\x1a!.altalune.v1.CreateApiKeyResponse\"\x00\x12L\n" +
-	"\tGetApiKey\x12\x1d.altalune.v1.GetApiKeyRequest\x1a\x1e.altalune.v1.GetApiKeyResponse\"\x00\x12U\n" +
-	"\fUpdateApiKey\x12 .altalune.v1.UpdateApiKeyRequest\x1a!.altalune.v1.UpdateApiKeyResponse\"\x00\x12U\n" +
-	"\fDeleteApiKey\x12 .altalune.v1.DeleteApiKeyRequest\x1a!.altalune.v1.DeleteApiKeyResponse\"\x00\x12[\n" +
-	"\x0eActivateApiKey\x12\".altalune.v1.ActivateApiKeyRequest\x1a#.altalune.v1.ActivateApiKeyResponse\"\x00\x12a\n" +
-	"\x10DeactivateApiKey\x12$.altalune.v1.DeactivateApiKeyRequest\x1a%.altalune.v1.DeactivateApiKeyResponse\"\x00B\xa0\x01\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xde\x02\n" +
+	"\x12ApplyApiKeyRequest\x12*\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\tprojectId\x12A\n" +
+	"\vexternal_id\x18\x02 \x01(\tB \xbaH\x1d\xc8\x01\x01r\x18\x18d2\x14^[A-Za-z0-9._:/@-]+$R\n" +
+	"externalId\x125\n" +
+	"\x04name\x18\x03 \x01(\tB!\xbaH\x1e\xc8\x01\x01r\x19\x10\x02\x1822\x13^[a-zA-Z0-9\\s\\-_]+$R\x04name\x12B\n" +
+	"\n" +
+	"expiration\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\n" +
+	"expiration\x12\"\n" +
+	"\bowner_id\x18\x05 \x01(\tB\a\xbaH\x04r\x02\x18\x14R\aownerId\x12:\n" +
+	"\x14recipient_public_key\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x18\x80 R\x12recipientPublicKey\"\xe2\x01\n" +
+	"\x13ApplyApiKeyResponse\x12,\n" +
+	"\aapi_key\x18\x01 \x01(\v2\x13.altalune.v1.ApiKeyR\x06apiKey\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\bR\acreated\x12\x1b\n" +
+	"\tkey_value\x18\x03 \x01(\tR\bkeyValue\x12L\n" +
+	"\x13delivered_key_value\x18\x04 \x01(\v2\x1c.altalune.v1.DeliveredSecretR\x11deliveredKeyValue\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\"\xa6\x01\n" +
+	"\x13ImportApiKeyRequest\x12*\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\tprojectId\x12 \n" +
+	"\x04name\x18\x02 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x01\x182R\x04name\x12A\n" +
+	"\vexternal_id\x18\x03 \x01(\tB \xbaH\x1d\xc8\x01\x01r\x18\x18d2\x14^[A-Za-z0-9._:/@-]+$R\n" +
+	"externalId\"^\n" +
+	"\x14ImportApiKeyResponse\x12,\n" +
+	"\aapi_key\x18\x01 \x01(\v2\x13.altalune.v1.ApiKeyR\x06apiKey\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage*\xa4\x01\n" +
+	"\fApiKeyStatus\x12\x1e\n" +
+	"\x1aAPI_KEY_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15API_KEY_STATUS_ACTIVE\x10\x01\x12\x1b\n" +
+	"\x17API_KEY_STATUS_INACTIVE\x10\x02\x12\x1a\n" +
+	"\x16API_KEY_STATUS_EXPIRED\x10\x03\x12 \n" +
+	"\x1cAPI_KEY_STATUS_EXPIRING_SOON\x10\x042\x9e\b\n" +
+	"\rApiKeyService\x12d\n" +
+	"\fQueryApiKeys\x12 .altalune.v1.QueryApiKeysRequest\x1a!.altalune.v1.QueryApiKeysResponse\"\x0f\x8a\xb5\x18\vapikey:read\x12e\n" +
+	"\fCreateApiKey\x12 .altalune.v1.CreateApiKeyRequest\x1a!.altalune.v1.CreateApiKeyResponse\"\x10\x8a\xb5\x18\fapikey:write\x12[\n" +
+	"\tGetApiKey\x12\x1d.altalune.v1.GetApiKeyRequest\x1a\x1e.altalune.v1.GetApiKeyResponse\"\x0f\x8a\xb5\x18\vapikey:read\x12e\n" +
+	"\fUpdateApiKey\x12 .altalune.v1.UpdateApiKeyRequest\x1a!.altalune.v1.UpdateApiKeyResponse\"\x10\x8a\xb5\x18\fapikey:write\x12f\n" +
+	"\fDeleteApiKey\x12 .altalune.v1.DeleteApiKeyRequest\x1a!.altalune.v1.DeleteApiKeyResponse\"\x11\x8a\xb5\x18\rapikey:delete\x12i\n" +
+	"\rRestoreApiKey\x12!.altalune.v1.RestoreApiKeyRequest\x1a\".altalune.v1.RestoreApiKeyResponse\"\x11\x8a\xb5\x18\rapikey:delete\x12k\n" +
+	"\x0eActivateApiKey\x12\".altalune.v1.ActivateApiKeyRequest\x1a#.altalune.v1.ActivateApiKeyResponse\"\x10\x8a\xb5\x18\fapikey:write\x12q\n" +
+	"\x10DeactivateApiKey\x12$.altalune.v1.DeactivateApiKeyRequest\x1a%.altalune.v1.DeactivateApiKeyResponse\"\x10\x8a\xb5\x18\fapikey:write\x12b\n" +
+	"\vApplyApiKey\x12\x1f.altalune.v1.ApplyApiKeyRequest\x1a .altalune.v1.ApplyApiKeyResponse\"\x10\x8a\xb5\x18\fapikey:write\x12e\n" +
+	"\fImportApiKey\x12 .altalune.v1.ImportApiKeyRequest\x1a!.altalune.v1.ImportApiKeyResponse\"\x10\x8a\xb5\x18\fapikey:writeB\xa0\x01\n" +
 	"\x0fcom.altalune.v1B\vApiKeyProtoP\x01Z3github.com/hrz8/altalune/gen/altalune/v1;altalunev1\xa2\x02\x03AXX\xaa\x02\vAltalune.V1\xca\x02\vAltalune\\V1\xe2\x02\x17Altalune\\V1\\GPBMetadata\xea\x02\fAltalune::V1b\x06proto3"
 
 var (
@@ -949,60 +1557,84 @@ func file_altalune_v1_api_key_proto_rawDescGZIP() []byte {
 	return file_altalune_v1_api_key_proto_rawDescData
 }
 
-var file_altalune_v1_api_key_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_altalune_v1_api_key_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_altalune_v1_api_key_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_altalune_v1_api_key_proto_goTypes = []any{
-	(*ApiKey)(nil),                   // 0: altalune.v1.ApiKey
-	(*CreateApiKeyRequest)(nil),      // 1: altalune.v1.CreateApiKeyRequest
-	(*CreateApiKeyResponse)(nil),     // 2: altalune.v1.CreateApiKeyResponse
-	(*QueryApiKeysRequest)(nil),      // 3: altalune.v1.QueryApiKeysRequest
-	(*QueryApiKeysResponse)(nil),     // 4: altalune.v1.QueryApiKeysResponse
-	(*GetApiKeyRequest)(nil),         // 5: altalune.v1.GetApiKeyRequest
-	(*GetApiKeyResponse)(nil),        // 6: altalune.v1.GetApiKeyResponse
-	(*UpdateApiKeyRequest)(nil),      // 7: altalune.v1.UpdateApiKeyRequest
-	(*UpdateApiKeyResponse)(nil),     // 8: altalune.v1.UpdateApiKeyResponse
-	(*DeleteApiKeyRequest)(nil),      // 9: altalune.v1.DeleteApiKeyRequest
-	(*DeleteApiKeyResponse)(nil),     // 10: altalune.v1.DeleteApiKeyResponse
-	(*ActivateApiKeyRequest)(nil),    // 11: altalune.v1.ActivateApiKeyRequest
-	(*ActivateApiKeyResponse)(nil),   // 12: altalune.v1.ActivateApiKeyResponse
-	(*DeactivateApiKeyRequest)(nil),  // 13: altalune.v1.DeactivateApiKeyRequest
-	(*DeactivateApiKeyResponse)(nil), // 14: altalune.v1.DeactivateApiKeyResponse
-	(*timestamppb.Timestamp)(nil),    // 15: google.protobuf.Timestamp
-	(*QueryRequest)(nil),             // 16: altalune.v1.QueryRequest
-	(*QueryMetaResponse)(nil),        // 17: altalune.v1.QueryMetaResponse
+	(ApiKeyStatus)(0),                // 0: altalune.v1.ApiKeyStatus
+	(*ApiKey)(nil),                   // 1: altalune.v1.ApiKey
+	(*CreateApiKeyRequest)(nil),      // 2: altalune.v1.CreateApiKeyRequest
+	(*CreateApiKeyResponse)(nil),     // 3: altalune.v1.CreateApiKeyResponse
+	(*QueryApiKeysRequest)(nil),      // 4: altalune.v1.QueryApiKeysRequest
+	(*QueryApiKeysResponse)(nil),     // 5: altalune.v1.QueryApiKeysResponse
+	(*GetApiKeyRequest)(nil),         // 6: altalune.v1.GetApiKeyRequest
+	(*GetApiKeyResponse)(nil),        // 7: altalune.v1.GetApiKeyResponse
+	(*UpdateApiKeyRequest)(nil),      // 8: altalune.v1.UpdateApiKeyRequest
+	(*UpdateApiKeyResponse)(nil),     // 9: altalune.v1.UpdateApiKeyResponse
+	(*DeleteApiKeyRequest)(nil),      // 10: altalune.v1.DeleteApiKeyRequest
+	(*DeleteApiKeyResponse)(nil),     // 11: altalune.v1.DeleteApiKeyResponse
+	(*RestoreApiKeyRequest)(nil),     // 12: altalune.v1.RestoreApiKeyRequest
+	(*RestoreApiKeyResponse)(nil),    // 13: altalune.v1.RestoreApiKeyResponse
+	(*ActivateApiKeyRequest)(nil),    // 14: altalune.v1.ActivateApiKeyRequest
+	(*ActivateApiKeyResponse)(nil),   // 15: altalune.v1.ActivateApiKeyResponse
+	(*DeactivateApiKeyRequest)(nil),  // 16: altalune.v1.DeactivateApiKeyRequest
+	(*DeactivateApiKeyResponse)(nil), // 17: altalune.v1.DeactivateApiKeyResponse
+	(*ApplyApiKeyRequest)(nil),       // 18: altalune.v1.ApplyApiKeyRequest
+	(*ApplyApiKeyResponse)(nil),      // 19: altalune.v1.ApplyApiKeyResponse
+	(*ImportApiKeyRequest)(nil),      // 20: altalune.v1.ImportApiKeyRequest
+	(*ImportApiKeyResponse)(nil),     // 21: altalune.v1.ImportApiKeyResponse
+	(*timestamppb.Timestamp)(nil),    // 22: google.protobuf.Timestamp
+	(*DeliveredSecret)(nil),          // 23: altalune.v1.DeliveredSecret
+	(*QueryRequest)(nil),             // 24: altalune.v1.QueryRequest
+	(*QueryMetaResponse)(nil),        // 25: altalune.v1.QueryMetaResponse
 }
 var file_altalune_v1_api_key_proto_depIdxs = []int32{
-	15, // 0: altalune.v1.ApiKey.expiration:type_name -> google.protobuf.Timestamp
-	15, // 1: altalune.v1.ApiKey.created_at:type_name -> google.protobuf.Timestamp
-	15, // 2: altalune.v1.ApiKey.updated_at:type_name -> google.protobuf.Timestamp
-	15, // 3: altalune.v1.CreateApiKeyRequest.expiration:type_name -> google.protobuf.Timestamp
-	0,  // 4: altalune.v1.CreateApiKeyResponse.api_key:type_name -> altalune.v1.ApiKey
-	16, // 5: altalune.v1.QueryApiKeysRequest.query:type_name -> altalune.v1.QueryRequest
-	0,  // 6: altalune.v1.QueryApiKeysResponse.data:type_name -> altalune.v1.ApiKey
-	17, // 7: altalune.v1.QueryApiKeysResponse.meta:type_name -> altalune.v1.QueryMetaResponse
-	0,  // 8: altalune.v1.GetApiKeyResponse.api_key:type_name -> altalune.v1.ApiKey
-	15, // 9: altalune.v1.UpdateApiKeyRequest.expiration:type_name -> google.protobuf.Timestamp
-	0,  // 10: altalune.v1.UpdateApiKeyResponse.api_key:type_name -> altalune.v1.ApiKey
-	0,  // 11: altalune.v1.ActivateApiKeyResponse.api_key:type_name -> altalune.v1.ApiKey
-	0,  // 12: altalune.v1.DeactivateApiKeyResponse.api_key:type_name -> altalune.v1.ApiKey
-	3,  // 13: altalune.v1.ApiKeyService.QueryApiKeys:input_type -> altalune.v1.QueryApiKeysRequest
-	1,  // 14: altalune.v1.ApiKeyService.CreateApiKey:input_type -> altalune.v1.CreateApiKeyRequest
-	5,  // 15: altalune.v1.ApiKeyService.GetApiKey:input_type -> altalune.v1.GetApiKeyRequest
-	7,  // 16: altalune.v1.ApiKeyService.UpdateApiKey:input_type -> altalune.v1.UpdateApiKeyRequest
-	9,  // 17: altalune.v1.ApiKeyService.DeleteApiKey:input_type -> altalune.v1.DeleteApiKeyRequest
-	11, // 18: altalune.v1.ApiKeyService.ActivateApiKey:input_type -> altalune.v1.ActivateApiKeyRequest
-	13, // 19: altalune.v1.ApiKeyService.DeactivateApiKey:input_type -> altalune.v1.DeactivateApiKeyRequest
-	4,  // 20: altalune.v1.ApiKeyService.QueryApiKeys:output_type -> altalune.v1.QueryApiKeysResponse
-	2,  // 21: altalune.v1.ApiKeyService.CreateApiKey:output_type -> altalune.v1.CreateApiKeyResponse
-	6,  // 22: altalune.v1.ApiKeyService.GetApiKey:output_type -> altalune.v1.GetApiKeyResponse
-	8,  // 23: altalune.v1.ApiKeyService.UpdateApiKey:output_type -> altalune.v1.UpdateApiKeyResponse
-	10, // 24: altalune.v1.ApiKeyService.DeleteApiKey:output_type -> altalune.v1.DeleteApiKeyResponse
-	12, // 25: altalune.v1.ApiKeyService.ActivateApiKey:output_type -> altalune.v1.ActivateApiKeyResponse
-	14, // 26: altalune.v1.ApiKeyService.DeactivateApiKey:output_type -> altalune.v1.DeactivateApiKeyResponse
-	20, // [20:27] is the sub-list for method output_type
-	13, // [13:20] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	22, // 0: altalune.v1.ApiKey.expiration:type_name -> google.protobuf.Timestamp
+	22, // 1: altalune.v1.ApiKey.deleted_at:type_name -> google.protobuf.Timestamp
+	0,  // 2: altalune.v1.ApiKey.status:type_name -> altalune.v1.ApiKeyStatus
+	22, // 3: altalune.v1.ApiKey.created_at:type_name -> google.protobuf.Timestamp
+	22, // 4: altalune.v1.ApiKey.updated_at:type_name -> google.protobuf.Timestamp
+	22, // 5: altalune.v1.CreateApiKeyRequest.expiration:type_name -> google.protobuf.Timestamp
+	1,  // 6: altalune.v1.CreateApiKeyResponse.api_key:type_name -> altalune.v1.ApiKey
+	23, // 7: altalune.v1.CreateApiKeyResponse.delivered_key_value:type_name -> altalune.v1.DeliveredSecret
+	24, // 8: altalune.v1.QueryApiKeysRequest.query:type_name -> altalune.v1.QueryRequest
+	1,  // 9: altalune.v1.QueryApiKeysResponse.data:type_name -> altalune.v1.ApiKey
+	25, // 10: altalune.v1.QueryApiKeysResponse.meta:type_name -> altalune.v1.QueryMetaResponse
+	1,  // 11: altalune.v1.GetApiKeyResponse.api_key:type_name -> altalune.v1.ApiKey
+	22, // 12: altalune.v1.UpdateApiKeyRequest.expiration:type_name -> google.protobuf.Timestamp
+	22, // 13: altalune.v1.UpdateApiKeyRequest.expected_updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 14: altalune.v1.UpdateApiKeyResponse.api_key:type_name -> altalune.v1.ApiKey
+	1,  // 15: altalune.v1.RestoreApiKeyResponse.api_key:type_name -> altalune.v1.ApiKey
+	1,  // 16: altalune.v1.ActivateApiKeyResponse.api_key:type_name -> altalune.v1.ApiKey
+	1,  // 17: altalune.v1.DeactivateApiKeyResponse.api_key:type_name -> altalune.v1.ApiKey
+	22, // 18: altalune.v1.ApplyApiKeyRequest.expiration:type_name -> google.protobuf.Timestamp
+	1,  // 19: altalune.v1.ApplyApiKeyResponse.api_key:type_name -> altalune.v1.ApiKey
+	23, // 20: altalune.v1.ApplyApiKeyResponse.delivered_key_value:type_name -> altalune.v1.DeliveredSecret
+	1,  // 21: altalune.v1.ImportApiKeyResponse.api_key:type_name -> altalune.v1.ApiKey
+	4,  // 22: altalune.v1.ApiKeyService.QueryApiKeys:input_type -> altalune.v1.QueryApiKeysRequest
+	2,  // 23: altalune.v1.ApiKeyService.CreateApiKey:input_type -> altalune.v1.CreateApiKeyRequest
+	6,  // 24: altalune.v1.ApiKeyService.GetApiKey:input_type -> altalune.v1.GetApiKeyRequest
+	8,  // 25: altalune.v1.ApiKeyService.UpdateApiKey:input_type -> altalune.v1.UpdateApiKeyRequest
+	10, // 26: altalune.v1.ApiKeyService.DeleteApiKey:input_type -> altalune.v1.DeleteApiKeyRequest
+	12, // 27: altalune.v1.ApiKeyService.RestoreApiKey:input_type -> altalune.v1.RestoreApiKeyRequest
+	14, // 28: altalune.v1.ApiKeyService.ActivateApiKey:input_type -> altalune.v1.ActivateApiKeyRequest
+	16, // 29: altalune.v1.ApiKeyService.DeactivateApiKey:input_type -> altalune.v1.DeactivateApiKeyRequest
+	18, // 30: altalune.v1.ApiKeyService.ApplyApiKey:input_type -> altalune.v1.ApplyApiKeyRequest
+	20, // 31: altalune.v1.ApiKeyService.ImportApiKey:input_type -> altalune.v1.ImportApiKeyRequest
+	5,  // 32: altalune.v1.ApiKeyService.QueryApiKeys:output_type -> altalune.v1.QueryApiKeysResponse
+	3,  // 33: altalune.v1.ApiKeyService.CreateApiKey:output_type -> altalune.v1.CreateApiKeyResponse
+	7,  // 34: altalune.v1.ApiKeyService.GetApiKey:output_type -> altalune.v1.GetApiKeyResponse
+	9,  // 35: altalune.v1.ApiKeyService.UpdateApiKey:output_type -> altalune.v1.UpdateApiKeyResponse
+	11, // 36: altalune.v1.ApiKeyService.DeleteApiKey:output_type -> altalune.v1.DeleteApiKeyResponse
+	13, // 37: altalune.v1.ApiKeyService.RestoreApiKey:output_type -> altalune.v1.RestoreApiKeyResponse
+	15, // 38: altalune.v1.ApiKeyService.ActivateApiKey:output_type -> altalune.v1.ActivateApiKeyResponse
+	17, // 39: altalune.v1.ApiKeyService.DeactivateApiKey:output_type -> altalune.v1.DeactivateApiKeyResponse
+	19, // 40: altalune.v1.ApiKeyService.ApplyApiKey:output_type -> altalune.v1.ApplyApiKeyResponse
+	21, // 41: altalune.v1.ApiKeyService.ImportApiKey:output_type -> altalune.v1.ImportApiKeyResponse
+	32, // [32:42] is the sub-list for method output_type
+	22, // [22:32] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_altalune_v1_api_key_proto_init() }
@@ -1011,18 +1643,20 @@ func file_altalune_v1_api_key_proto_init() {
 		return
 	}
 	file_altalune_v1_common_proto_init()
+	file_altalune_v1_options_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_altalune_v1_api_key_proto_rawDesc), len(file_altalune_v1_api_key_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   15,
+			NumEnums:      1,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_altalune_v1_api_key_proto_goTypes,
 		DependencyIndexes: file_altalune_v1_api_key_proto_depIdxs,
+		EnumInfos:         file_altalune_v1_api_key_proto_enumTypes,
 		MessageInfos:      file_altalune_v1_api_key_proto_msgTypes,
 	}.Build()
 	File_altalune_v1_api_key_proto = out.File
@@ -24,8 +24,11 @@ const (
 	ApiKeyService_GetApiKey_FullMethodName        = "/altalune.v1.ApiKeyService/GetApiKey"
 	ApiKeyService_UpdateApiKey_FullMethodName     = "/altalune.v1.ApiKeyService/UpdateApiKey"
 	ApiKeyService_DeleteApiKey_FullMethodName     = "/altalune.v1.ApiKeyService/DeleteApiKey"
+	ApiKeyService_RestoreApiKey_FullMethodName    = "/altalune.v1.ApiKeyService/RestoreApiKey"
 	ApiKeyService_ActivateApiKey_FullMethodName   = "/altalune.v1.ApiKeyService/ActivateApiKey"
 	ApiKeyService_DeactivateApiKey_FullMethodName = "/altalune.v1.ApiKeyService/DeactivateApiKey"
+	ApiKeyService_ApplyApiKey_FullMethodName      = "/altalune.v1.ApiKeyService/ApplyApiKey"
+	ApiKeyService_ImportApiKey_FullMethodName     = "/altalune.v1.ApiKeyService/ImportApiKey"
 )
 
 // ApiKeyServiceClient is the client API for ApiKeyService service.
@@ -37,8 +40,13 @@ type ApiKeyServiceClient interface {
 	GetApiKey(ctx context.Context, in *GetApiKeyRequest, opts ...grpc.CallOption) (*GetApiKeyResponse, error)
 	UpdateApiKey(ctx context.Context, in *UpdateApiKeyRequest, opts ...grpc.CallOption) (*UpdateApiKeyResponse, error)
 	DeleteApiKey(ctx context.Context, in *DeleteApiKeyRequest, opts ...grpc.CallOption) (*DeleteApiKeyResponse, error)
+	RestoreApiKey(ctx context.Context, in *RestoreApiKeyRequest, opts ...grpc.CallOption) (*RestoreApiKeyResponse, error)
 	ActivateApiKey(ctx context.Context, in *ActivateApiKeyRequest, opts ...grpc.CallOption) (*ActivateApiKeyResponse, error)
 	DeactivateApiKey(ctx context.Context, in *DeactivateApiKeyRequest, opts ...grpc.CallOption) (*DeactivateApiKeyResponse, error)
+	// Create-or-update by external ID, for declarative provisioning
+	ApplyApiKey(ctx context.Context, in *ApplyApiKeyRequest, opts ...grpc.CallOption) (*ApplyApiKeyResponse, error)
+	// Bring an existing API key under an external ID, found by name
+	ImportApiKey(ctx context.Context, in *ImportApiKeyRequest, opts ...grpc.CallOption) (*ImportApiKeyResponse, error)
 }
 
 type apiKeyServiceClient struct {
@@ -99,6 +107,16 @@ func (c *apiKeyServiceClient) DeleteApiKey(ctx context.Context, in *DeleteApiKey
 	return out, nil
 }
 
+func (c *apiKeyServiceClient) RestoreApiKey(ctx context.Context, in *RestoreApiKeyRequest, opts ...grpc.CallOption) (*RestoreApiKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreApiKeyResponse)
+	err := c.cc.Invoke(ctx, ApiKeyService_RestoreApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiKeyServiceClient) ActivateApiKey(ctx context.Context, in *ActivateApiKeyRequest, opts ...grpc.CallOption) (*ActivateApiKeyResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ActivateApiKeyResponse)
@@ -119,6 +137,26 @@ func (c *apiKeyServiceClient) DeactivateApiKey(ctx context.Context, in *Deactiva
 	return out, nil
 }
 
+func (c *apiKeyServiceClient) ApplyApiKey(ctx context.Context, in *ApplyApiKeyRequest, opts ...grpc.CallOption) (*ApplyApiKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyApiKeyResponse)
+	err := c.cc.Invoke(ctx, ApiKeyService_ApplyApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiKeyServiceClient) ImportApiKey(ctx context.Context, in *ImportApiKeyRequest, opts ...grpc.CallOption) (*ImportApiKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportApiKeyResponse)
+	err := c.cc.Invoke(ctx, ApiKeyService_ImportApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ApiKeyServiceServer is the server API for ApiKeyService service.
 // All implementations must embed UnimplementedApiKeyServiceServer
 // for forward compatibility.
@@ -128,8 +166,13 @@ type ApiKeyServiceServer interface {
 	GetApiKey(context.Context, *GetApiKeyRequest) (*GetApiKeyResponse, error)
 	UpdateApiKey(context.Context, *UpdateApiKeyRequest) (*UpdateApiKeyResponse, error)
 	DeleteApiKey(context.Context, *DeleteApiKeyRequest) (*DeleteApiKeyResponse, error)
+	RestoreApiKey(context.Context, *RestoreApiKeyRequest) (*RestoreApiKeyResponse, error)
 	ActivateApiKey(context.Context, *ActivateApiKeyRequest) (*ActivateApiKeyResponse, error)
 	DeactivateApiKey(context.Context, *DeactivateApiKeyRequest) (*DeactivateApiKeyResponse, error)
+	// Create-or-update by external ID, for declarative provisioning
+	ApplyApiKey(context.Context, *ApplyApiKeyRequest) (*ApplyApiKeyResponse, error)
+	// Bring an existing API key under an external ID, found by name
+	ImportApiKey(context.Context, *ImportApiKeyRequest) (*ImportApiKeyResponse, error)
 	mustEmbedUnimplementedApiKeyServiceServer()
 }
 
@@ -155,12 +198,21 @@ func (UnimplementedApiKeyServiceServer) UpdateApiKey(context.Context, *UpdateApi
 func (UnimplementedApiKeyServiceServer) DeleteApiKey(context.Context, *DeleteApiKeyRequest) (*DeleteApiKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteApiKey not implemented")
 }
+func (UnimplementedApiKeyServiceServer) RestoreApiKey(context.Context, *RestoreApiKeyRequest) (*RestoreApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreApiKey not implemented")
+}
 func (UnimplementedApiKeyServiceServer) ActivateApiKey(context.Context, *ActivateApiKeyRequest) (*ActivateApiKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ActivateApiKey not implemented")
 }
 func (UnimplementedApiKeyServiceServer) DeactivateApiKey(context.Context, *DeactivateApiKeyRequest) (*DeactivateApiKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeactivateApiKey not implemented")
 }
+func (UnimplementedApiKeyServiceServer) ApplyApiKey(context.Context, *ApplyApiKeyRequest) (*ApplyApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyApiKey not implemented")
+}
+func (UnimplementedApiKeyServiceServer) ImportApiKey(context.Context, *ImportApiKeyRequest) (*ImportApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportApiKey not implemented")
+}
 func (UnimplementedApiKeyServiceServer) mustEmbedUnimplementedApiKeyServiceServer() {}
 func (UnimplementedApiKeyServiceServer) testEmbeddedByValue()                       {}
 
@@ -272,6 +324,24 @@ func _ApiKeyService_DeleteApiKey_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiKeyService_RestoreApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiKeyServiceServer).RestoreApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiKeyService_RestoreApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiKeyServiceServer).RestoreApiKey(ctx, req.(*RestoreApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiKeyService_ActivateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ActivateApiKeyRequest)
 	if err := dec(in); err != nil {
@@ -308,6 +378,42 @@ func _ApiKeyService_DeactivateApiKey_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiKeyService_ApplyApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiKeyServiceServer).ApplyApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiKeyService_ApplyApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiKeyServiceServer).ApplyApiKey(ctx, req.(*ApplyApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiKeyService_ImportApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiKeyServiceServer).ImportApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiKeyService_ImportApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiKeyServiceServer).ImportApiKey(ctx, req.(*ImportApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ApiKeyService_ServiceDesc is the grpc.ServiceDesc for ApiKeyService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -335,6 +441,10 @@ var ApiKeyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteApiKey",
 			Handler:    _ApiKeyService_DeleteApiKey_Handler,
 		},
+		{
+			MethodName: "RestoreApiKey",
+			Handler:    _ApiKeyService_RestoreApiKey_Handler,
+		},
 		{
 			MethodName: "ActivateApiKey",
 			Handler:    _ApiKeyService_ActivateApiKey_Handler,
@@ -343,6 +453,14 @@ var ApiKeyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeactivateApiKey",
 			Handler:    _ApiKeyService_DeactivateApiKey_Handler,
 		},
+		{
+			MethodName: "ApplyApiKey",
+			Handler:    _ApiKeyService_ApplyApiKey_Handler,
+		},
+		{
+			MethodName: "ImportApiKey",
+			Handler:    _ApiKeyService_ImportApiKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "altalune/v1/api_key.proto",
@@ -296,7 +296,7 @@ var File_altalune_v1_chatbot_proto protoreflect.FileDescriptor
 
 const file_altalune_v1_chatbot_proto_rawDesc = "" +
 	"\n" +
-	"\x19altalune/v1/chatbot.proto\x12\valtalune.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1bbuf/validate/validate.proto\"\xd5\x01\n" +
+	"\x19altalune/v1/chatbot.proto\x12\valtalune.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1bbuf/validate/validate.proto\x1a\x19altalune/v1/options.proto\"\xd5\x01\n" +
 	"\rChatbotConfig\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12>\n" +
 	"\x0emodules_config\x18\x02 \x01(\v2\x17.google.protobuf.StructR\rmodulesConfig\x129\n" +
@@ -308,19 +308,19 @@ const file_altalune_v1_chatbot_proto_rawDesc = "" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\tprojectId\"]\n" +
 	"\x18GetChatbotConfigResponse\x12A\n" +
-	"\x0echatbot_config\x18\x01 \x01(\v2\x1a.altalune.v1.ChatbotConfigR\rchatbotConfig\"\xcf\x01\n" +
+	"\x0echatbot_config\x18\x01 \x01(\v2\x1a.altalune.v1.ChatbotConfigR\rchatbotConfig\"\xdf\x01\n" +
 	"\x19UpdateModuleConfigRequest\x12*\n" +
 	"\n" +
-	"project_id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\tprojectId\x12M\n" +
-	"\vmodule_name\x18\x02 \x01(\tB,\xbaH)\xc8\x01\x01r$\x10\x01\x182R\x03llmR\tmcpServerR\x06widgetR\x06promptR\n" +
+	"project_id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\tprojectId\x12]\n" +
+	"\vmodule_name\x18\x02 \x01(\tB<\xbaH9\xc8\x01\x01r4\x10\x01\x182R\x04coreR\bliveChatR\x03llmR\tmcpServerR\x06widgetR\x06promptR\n" +
 	"moduleName\x127\n" +
 	"\x06config\x18\x03 \x01(\v2\x17.google.protobuf.StructB\x06\xbaH\x03\xc8\x01\x01R\x06config\"y\n" +
 	"\x1aUpdateModuleConfigResponse\x12A\n" +
 	"\x0echatbot_config\x18\x01 \x01(\v2\x1a.altalune.v1.ChatbotConfigR\rchatbotConfig\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage2\xdc\x01\n" +
-	"\x0eChatbotService\x12a\n" +
-	"\x10GetChatbotConfig\x12$.altalune.v1.GetChatbotConfigRequest\x1a%.altalune.v1.GetChatbotConfigResponse\"\x00\x12g\n" +
-	"\x12UpdateModuleConfig\x12&.altalune.v1.UpdateModuleConfigRequest\x1a'.altalune.v1.UpdateModuleConfigResponse\"\x00B\xa1\x01\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage2\xfd\x01\n" +
+	"\x0eChatbotService\x12q\n" +
+	"\x10GetChatbotConfig\x12$.altalune.v1.GetChatbotConfigRequest\x1a%.altalune.v1.GetChatbotConfigResponse\"\x10\x8a\xb5\x18\fchatbot:read\x12x\n" +
+	"\x12UpdateModuleConfig\x12&.altalune.v1.UpdateModuleConfigRequest\x1a'.altalune.v1.UpdateModuleConfigResponse\"\x11\x8a\xb5\x18\rchatbot:writeB\xa1\x01\n" +
 	"\x0fcom.altalune.v1B\fChatbotProtoP\x01Z3github.com/hrz8/altalune/gen/altalune/v1;altalunev1\xa2\x02\x03AXX\xaa\x02\vAltalune.V1\xca\x02\vAltalune\\V1\xe2\x02\x17Altalune\\V1\\GPBMetadata\xea\x02\fAltalune::V1b\x06proto3"
 
 var (
@@ -368,6 +368,7 @@ func file_altalune_v1_chatbot_proto_init() {
 	if File_altalune_v1_chatbot_proto != nil {
 		return
 	}
+	file_altalune_v1_options_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
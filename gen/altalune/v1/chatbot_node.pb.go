@@ -115,11 +115,13 @@ func (x *ListNodesResponse) GetNodes() []*v1.ChatbotNode {
 
 // CreateNodeRequest creates a new chatbot node
 type CreateNodeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Lang          string                 `protobuf:"bytes,3,opt,name=lang,proto3" json:"lang,omitempty"`
-	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Lang      string                 `protobuf:"bytes,3,opt,name=lang,proto3" json:"lang,omitempty"`
+	Tags      []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	// version - optional version identifier for node variants (e.g., "roundtrip", "oneway")
+	Version       *string `protobuf:"bytes,5,opt,name=version,proto3,oneof" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -182,6 +184,13 @@ func (x *CreateNodeRequest) GetTags() []string {
 	return nil
 }
 
+func (x *CreateNodeRequest) GetVersion() string {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return ""
+}
+
 // CreateNodeResponse returns the created node
 type CreateNodeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -339,9 +348,25 @@ type UpdateNodeRequest struct {
 	// triggers - at least one required when provided
 	Triggers []*v1.ChatbotNodeTrigger `protobuf:"bytes,6,rep,name=triggers,proto3" json:"triggers,omitempty"`
 	// messages - at least one required when provided
-	Messages      []*v1.ChatbotNodeMessage `protobuf:"bytes,7,rep,name=messages,proto3" json:"messages,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Messages []*v1.ChatbotNodeMessage `protobuf:"bytes,7,rep,name=messages,proto3" json:"messages,omitempty"`
+	// priority - matching order (higher = checked first)
+	Priority *int32 `protobuf:"varint,8,opt,name=priority,proto3,oneof" json:"priority,omitempty"`
+	// condition - json-rules-engine conditions (optional, null to clear)
+	Condition *v1.NodeCondition `protobuf:"bytes,9,opt,name=condition,proto3" json:"condition,omitempty"`
+	// effect - immediate action (set_mode, set_context, goto) executed after messages
+	Effect *v1.NodeEffect `protobuf:"bytes,10,opt,name=effect,proto3" json:"effect,omitempty"`
+	// next_action - deferred action (goto, capture) executed when user replies
+	NextAction *v1.NodeNextAction `protobuf:"bytes,11,opt,name=next_action,json=nextAction,proto3" json:"next_action,omitempty"`
+	// clear_condition - set to true to explicitly clear the condition
+	ClearCondition bool `protobuf:"varint,12,opt,name=clear_condition,json=clearCondition,proto3" json:"clear_condition,omitempty"`
+	// clear_effect - set to true to explicitly clear the effect
+	ClearEffect bool `protobuf:"varint,13,opt,name=clear_effect,json=clearEffect,proto3" json:"clear_effect,omitempty"`
+	// clear_next_action - set to true to explicitly clear the next action
+	ClearNextAction bool `protobuf:"varint,14,opt,name=clear_next_action,json=clearNextAction,proto3" json:"clear_next_action,omitempty"`
+	// force_condition - when true, conditions are always evaluated on goto navigation
+	ForceCondition *bool `protobuf:"varint,15,opt,name=force_condition,json=forceCondition,proto3,oneof" json:"force_condition,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *UpdateNodeRequest) Reset() {
@@ -423,6 +448,62 @@ func (x *UpdateNodeRequest) GetMessages() []*v1.ChatbotNodeMessage {
 	return nil
 }
 
+func (x *UpdateNodeRequest) GetPriority() int32 {
+	if x != nil && x.Priority != nil {
+		return *x.Priority
+	}
+	return 0
+}
+
+func (x *UpdateNodeRequest) GetCondition() *v1.NodeCondition {
+	if x != nil {
+		return x.Condition
+	}
+	return nil
+}
+
+func (x *UpdateNodeRequest) GetEffect() *v1.NodeEffect {
+	if x != nil {
+		return x.Effect
+	}
+	return nil
+}
+
+func (x *UpdateNodeRequest) GetNextAction() *v1.NodeNextAction {
+	if x != nil {
+		return x.NextAction
+	}
+	return nil
+}
+
+func (x *UpdateNodeRequest) GetClearCondition() bool {
+	if x != nil {
+		return x.ClearCondition
+	}
+	return false
+}
+
+func (x *UpdateNodeRequest) GetClearEffect() bool {
+	if x != nil {
+		return x.ClearEffect
+	}
+	return false
+}
+
+func (x *UpdateNodeRequest) GetClearNextAction() bool {
+	if x != nil {
+		return x.ClearNextAction
+	}
+	return false
+}
+
+func (x *UpdateNodeRequest) GetForceCondition() bool {
+	if x != nil && x.ForceCondition != nil {
+		return *x.ForceCondition
+	}
+	return false
+}
+
 // UpdateNodeResponse returns the updated node
 type UpdateNodeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -578,18 +659,21 @@ var File_altalune_v1_chatbot_node_proto protoreflect.FileDescriptor
 
 const file_altalune_v1_chatbot_node_proto_rawDesc = "" +
 	"\n" +
-	"\x1ealtalune/v1/chatbot_node.proto\x12\valtalune.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1bchatbot/nodes/v1/node.proto\">\n" +
+	"\x1ealtalune/v1/chatbot_node.proto\x12\valtalune.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1bchatbot/nodes/v1/node.proto\x1a\x19altalune/v1/options.proto\">\n" +
 	"\x10ListNodesRequest\x12*\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\tprojectId\"H\n" +
 	"\x11ListNodesResponse\x123\n" +
-	"\x05nodes\x18\x01 \x03(\v2\x1d.chatbot.nodes.v1.ChatbotNodeR\x05nodes\"\xb4\x01\n" +
+	"\x05nodes\x18\x01 \x03(\v2\x1d.chatbot.nodes.v1.ChatbotNodeR\x05nodes\"\xfb\x01\n" +
 	"\x11CreateNodeRequest\x12*\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\tprojectId\x123\n" +
 	"\x04name\x18\x02 \x01(\tB\x1f\xbaH\x1c\xc8\x01\x01r\x17\x10\x02\x18d2\x11^[a-z][a-z0-9_]*$R\x04name\x12*\n" +
 	"\x04lang\x18\x03 \x01(\tB\x16\xbaH\x13\xc8\x01\x01r\x0eR\x05en-USR\x05id-IDR\x04lang\x12\x12\n" +
-	"\x04tags\x18\x04 \x03(\tR\x04tags\"G\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x129\n" +
+	"\aversion\x18\x05 \x01(\tB\x1a\xbaH\x17r\x15\x1822\x11^[a-z][a-z0-9_]*$H\x00R\aversion\x88\x01\x01B\n" +
+	"\n" +
+	"\b_version\"G\n" +
 	"\x12CreateNodeResponse\x121\n" +
 	"\x04node\x18\x01 \x01(\v2\x1d.chatbot.nodes.v1.ChatbotNodeR\x04node\"b\n" +
 	"\x0eGetNodeRequest\x12*\n" +
@@ -597,7 +681,7 @@ const file_altalune_v1_chatbot_node_proto_rawDesc = "" +
 	"project_id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\tprojectId\x12$\n" +
 	"\anode_id\x18\x02 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\x06nodeId\"D\n" +
 	"\x0fGetNodeResponse\x121\n" +
-	"\x04node\x18\x01 \x01(\v2\x1d.chatbot.nodes.v1.ChatbotNodeR\x04node\"\xe8\x02\n" +
+	"\x04node\x18\x01 \x01(\v2\x1d.chatbot.nodes.v1.ChatbotNodeR\x04node\"\x88\x06\n" +
 	"\x11UpdateNodeRequest\x12*\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\tprojectId\x12$\n" +
@@ -606,10 +690,22 @@ const file_altalune_v1_chatbot_node_proto_rawDesc = "" +
 	"\x04tags\x18\x04 \x03(\tR\x04tags\x12\x1d\n" +
 	"\aenabled\x18\x05 \x01(\bH\x01R\aenabled\x88\x01\x01\x12@\n" +
 	"\btriggers\x18\x06 \x03(\v2$.chatbot.nodes.v1.ChatbotNodeTriggerR\btriggers\x12@\n" +
-	"\bmessages\x18\a \x03(\v2$.chatbot.nodes.v1.ChatbotNodeMessageR\bmessagesB\a\n" +
+	"\bmessages\x18\a \x03(\v2$.chatbot.nodes.v1.ChatbotNodeMessageR\bmessages\x12\x1f\n" +
+	"\bpriority\x18\b \x01(\x05H\x02R\bpriority\x88\x01\x01\x12=\n" +
+	"\tcondition\x18\t \x01(\v2\x1f.chatbot.nodes.v1.NodeConditionR\tcondition\x124\n" +
+	"\x06effect\x18\n" +
+	" \x01(\v2\x1c.chatbot.nodes.v1.NodeEffectR\x06effect\x12A\n" +
+	"\vnext_action\x18\v \x01(\v2 .chatbot.nodes.v1.NodeNextActionR\n" +
+	"nextAction\x12'\n" +
+	"\x0fclear_condition\x18\f \x01(\bR\x0eclearCondition\x12!\n" +
+	"\fclear_effect\x18\r \x01(\bR\vclearEffect\x12*\n" +
+	"\x11clear_next_action\x18\x0e \x01(\bR\x0fclearNextAction\x12,\n" +
+	"\x0fforce_condition\x18\x0f \x01(\bH\x03R\x0eforceCondition\x88\x01\x01B\a\n" +
 	"\x05_nameB\n" +
 	"\n" +
-	"\b_enabled\"a\n" +
+	"\b_enabledB\v\n" +
+	"\t_priorityB\x12\n" +
+	"\x10_force_condition\"a\n" +
 	"\x12UpdateNodeResponse\x121\n" +
 	"\x04node\x18\x01 \x01(\v2\x1d.chatbot.nodes.v1.ChatbotNodeR\x04node\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\"e\n" +
@@ -618,16 +714,16 @@ const file_altalune_v1_chatbot_node_proto_rawDesc = "" +
 	"project_id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\tprojectId\x12$\n" +
 	"\anode_id\x18\x02 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\x06nodeId\".\n" +
 	"\x12DeleteNodeResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage2\x9d\x03\n" +
-	"\x12ChatbotNodeService\x12L\n" +
-	"\tListNodes\x12\x1d.altalune.v1.ListNodesRequest\x1a\x1e.altalune.v1.ListNodesResponse\"\x00\x12O\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage2\xf1\x03\n" +
+	"\x12ChatbotNodeService\x12\\\n" +
+	"\tListNodes\x12\x1d.altalune.v1.ListNodesRequest\x1a\x1e.altalune.v1.ListNodesResponse\"\x10\x8a\xb5\x18\fchatbot:read\x12`\n" +
 	"\n" +
-	"CreateNode\x12\x1e.altalune.v1.CreateNodeRequest\x1a\x1f.altalune.v1.CreateNodeResponse\"\x00\x12F\n" +
-	"\aGetNode\x12\x1b.altalune.v1.GetNodeRequest\x1a\x1c.altalune.v1.GetNodeResponse\"\x00\x12O\n" +
+	"CreateNode\x12\x1e.altalune.v1.CreateNodeRequest\x1a\x1f.altalune.v1.CreateNodeResponse\"\x11\x8a\xb5\x18\rchatbot:write\x12V\n" +
+	"\aGetNode\x12\x1b.altalune.v1.GetNodeRequest\x1a\x1c.altalune.v1.GetNodeResponse\"\x10\x8a\xb5\x18\fchatbot:read\x12`\n" +
 	"\n" +
-	"UpdateNode\x12\x1e.altalune.v1.UpdateNodeRequest\x1a\x1f.altalune.v1.UpdateNodeResponse\"\x00\x12O\n" +
+	"UpdateNode\x12\x1e.altalune.v1.UpdateNodeRequest\x1a\x1f.altalune.v1.UpdateNodeResponse\"\x11\x8a\xb5\x18\rchatbot:write\x12a\n" +
 	"\n" +
-	"DeleteNode\x12\x1e.altalune.v1.DeleteNodeRequest\x1a\x1f.altalune.v1.DeleteNodeResponse\"\x00B\xa5\x01\n" +
+	"DeleteNode\x12\x1e.altalune.v1.DeleteNodeRequest\x1a\x1f.altalune.v1.DeleteNodeResponse\"\x12\x8a\xb5\x18\x0echatbot:deleteB\xa5\x01\n" +
 	"\x0fcom.altalune.v1B\x10ChatbotNodeProtoP\x01Z3github.com/hrz8/altalune/gen/altalune/v1;altalunev1\xa2\x02\x03AXX\xaa\x02\vAltalune.V1\xca\x02\vAltalune\\V1\xe2\x02\x17Altalune\\V1\\GPBMetadata\xea\x02\fAltalune::V1b\x06proto3"
 
 var (
@@ -657,6 +753,9 @@ var file_altalune_v1_chatbot_node_proto_goTypes = []any{
 	(*v1.ChatbotNode)(nil),        // 10: chatbot.nodes.v1.ChatbotNode
 	(*v1.ChatbotNodeTrigger)(nil), // 11: chatbot.nodes.v1.ChatbotNodeTrigger
 	(*v1.ChatbotNodeMessage)(nil), // 12: chatbot.nodes.v1.ChatbotNodeMessage
+	(*v1.NodeCondition)(nil),      // 13: chatbot.nodes.v1.NodeCondition
+	(*v1.NodeEffect)(nil),         // 14: chatbot.nodes.v1.NodeEffect
+	(*v1.NodeNextAction)(nil),     // 15: chatbot.nodes.v1.NodeNextAction
 }
 var file_altalune_v1_chatbot_node_proto_depIdxs = []int32{
 	10, // 0: altalune.v1.ListNodesResponse.nodes:type_name -> chatbot.nodes.v1.ChatbotNode
@@ -664,22 +763,25 @@ var file_altalune_v1_chatbot_node_proto_depIdxs = []int32{
 	10, // 2: altalune.v1.GetNodeResponse.node:type_name -> chatbot.nodes.v1.ChatbotNode
 	11, // 3: altalune.v1.UpdateNodeRequest.triggers:type_name -> chatbot.nodes.v1.ChatbotNodeTrigger
 	12, // 4: altalune.v1.UpdateNodeRequest.messages:type_name -> chatbot.nodes.v1.ChatbotNodeMessage
-	10, // 5: altalune.v1.UpdateNodeResponse.node:type_name -> chatbot.nodes.v1.ChatbotNode
-	0,  // 6: altalune.v1.ChatbotNodeService.ListNodes:input_type -> altalune.v1.ListNodesRequest
-	2,  // 7: altalune.v1.ChatbotNodeService.CreateNode:input_type -> altalune.v1.CreateNodeRequest
-	4,  // 8: altalune.v1.ChatbotNodeService.GetNode:input_type -> altalune.v1.GetNodeRequest
-	6,  // 9: altalune.v1.ChatbotNodeService.UpdateNode:input_type -> altalune.v1.UpdateNodeRequest
-	8,  // 10: altalune.v1.ChatbotNodeService.DeleteNode:input_type -> altalune.v1.DeleteNodeRequest
-	1,  // 11: altalune.v1.ChatbotNodeService.ListNodes:output_type -> altalune.v1.ListNodesResponse
-	3,  // 12: altalune.v1.ChatbotNodeService.CreateNode:output_type -> altalune.v1.CreateNodeResponse
-	5,  // 13: altalune.v1.ChatbotNodeService.GetNode:output_type -> altalune.v1.GetNodeResponse
-	7,  // 14: altalune.v1.ChatbotNodeService.UpdateNode:output_type -> altalune.v1.UpdateNodeResponse
-	9,  // 15: altalune.v1.ChatbotNodeService.DeleteNode:output_type -> altalune.v1.DeleteNodeResponse
-	11, // [11:16] is the sub-list for method output_type
-	6,  // [6:11] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	13, // 5: altalune.v1.UpdateNodeRequest.condition:type_name -> chatbot.nodes.v1.NodeCondition
+	14, // 6: altalune.v1.UpdateNodeRequest.effect:type_name -> chatbot.nodes.v1.NodeEffect
+	15, // 7: altalune.v1.UpdateNodeRequest.next_action:type_name -> chatbot.nodes.v1.NodeNextAction
+	10, // 8: altalune.v1.UpdateNodeResponse.node:type_name -> chatbot.nodes.v1.ChatbotNode
+	0,  // 9: altalune.v1.ChatbotNodeService.ListNodes:input_type -> altalune.v1.ListNodesRequest
+	2,  // 10: altalune.v1.ChatbotNodeService.CreateNode:input_type -> altalune.v1.CreateNodeRequest
+	4,  // 11: altalune.v1.ChatbotNodeService.GetNode:input_type -> altalune.v1.GetNodeRequest
+	6,  // 12: altalune.v1.ChatbotNodeService.UpdateNode:input_type -> altalune.v1.UpdateNodeRequest
+	8,  // 13: altalune.v1.ChatbotNodeService.DeleteNode:input_type -> altalune.v1.DeleteNodeRequest
+	1,  // 14: altalune.v1.ChatbotNodeService.ListNodes:output_type -> altalune.v1.ListNodesResponse
+	3,  // 15: altalune.v1.ChatbotNodeService.CreateNode:output_type -> altalune.v1.CreateNodeResponse
+	5,  // 16: altalune.v1.ChatbotNodeService.GetNode:output_type -> altalune.v1.GetNodeResponse
+	7,  // 17: altalune.v1.ChatbotNodeService.UpdateNode:output_type -> altalune.v1.UpdateNodeResponse
+	9,  // 18: altalune.v1.ChatbotNodeService.DeleteNode:output_type -> altalune.v1.DeleteNodeResponse
+	14, // [14:19] is the sub-list for method output_type
+	9,  // [9:14] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_altalune_v1_chatbot_node_proto_init() }
@@ -687,6 +789,8 @@ func file_altalune_v1_chatbot_node_proto_init() {
 	if File_altalune_v1_chatbot_node_proto != nil {
 		return
 	}
+	file_altalune_v1_options_proto_init()
+	file_altalune_v1_chatbot_node_proto_msgTypes[2].OneofWrappers = []any{}
 	file_altalune_v1_chatbot_node_proto_msgTypes[6].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -10,6 +10,7 @@ import (
 	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -71,10 +72,131 @@ func (SortOrder) EnumDescriptor() ([]byte, []int) {
 	return file_altalune_v1_common_proto_rawDescGZIP(), []int{0}
 }
 
+// CountMode - how a query counts the rows it matches
+type CountMode int32
+
+const (
+	// COUNT_MODE_UNSPECIFIED - the server default (database.countMode)
+	CountMode_COUNT_MODE_UNSPECIFIED CountMode = 0
+	// COUNT_MODE_EXACT - COUNT(*) over the matching rows
+	CountMode_COUNT_MODE_EXACT CountMode = 1
+	// COUNT_MODE_ESTIMATED - the planner's estimate, exact for small results
+	CountMode_COUNT_MODE_ESTIMATED CountMode = 2
+	// COUNT_MODE_NONE - no count, only whether another page follows
+	CountMode_COUNT_MODE_NONE CountMode = 3
+)
+
+// Enum value maps for CountMode.
+var (
+	CountMode_name = map[int32]string{
+		0: "COUNT_MODE_UNSPECIFIED",
+		1: "COUNT_MODE_EXACT",
+		2: "COUNT_MODE_ESTIMATED",
+		3: "COUNT_MODE_NONE",
+	}
+	CountMode_value = map[string]int32{
+		"COUNT_MODE_UNSPECIFIED": 0,
+		"COUNT_MODE_EXACT":       1,
+		"COUNT_MODE_ESTIMATED":   2,
+		"COUNT_MODE_NONE":        3,
+	}
+)
+
+func (x CountMode) Enum() *CountMode {
+	p := new(CountMode)
+	*p = x
+	return p
+}
+
+func (x CountMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CountMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_altalune_v1_common_proto_enumTypes[1].Descriptor()
+}
+
+func (CountMode) Type() protoreflect.EnumType {
+	return &file_altalune_v1_common_proto_enumTypes[1]
+}
+
+func (x CountMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CountMode.Descriptor instead.
+func (CountMode) EnumDescriptor() ([]byte, []int) {
+	return file_altalune_v1_common_proto_rawDescGZIP(), []int{1}
+}
+
+// SecretDeliveryMode - how a secret generated on creation, an API key or an
+// OAuth client secret, is handed over, as the credential policy of the
+// project requires
+type SecretDeliveryMode int32
+
+const (
+	SecretDeliveryMode_SECRET_DELIVERY_MODE_UNSPECIFIED SecretDeliveryMode = 0
+	SecretDeliveryMode_SECRET_DELIVERY_MODE_REVEAL      SecretDeliveryMode = 1 // Returned in plaintext in the response, once
+	SecretDeliveryMode_SECRET_DELIVERY_MODE_LINK        SecretDeliveryMode = 2 // Retrieved once from a link expiring after 24 hours
+	SecretDeliveryMode_SECRET_DELIVERY_MODE_PUBLIC_KEY  SecretDeliveryMode = 3 // Encrypted to a public key given on creation
+)
+
+// Enum value maps for SecretDeliveryMode.
+var (
+	SecretDeliveryMode_name = map[int32]string{
+		0: "SECRET_DELIVERY_MODE_UNSPECIFIED",
+		1: "SECRET_DELIVERY_MODE_REVEAL",
+		2: "SECRET_DELIVERY_MODE_LINK",
+		3: "SECRET_DELIVERY_MODE_PUBLIC_KEY",
+	}
+	SecretDeliveryMode_value = map[string]int32{
+		"SECRET_DELIVERY_MODE_UNSPECIFIED": 0,
+		"SECRET_DELIVERY_MODE_REVEAL":      1,
+		"SECRET_DELIVERY_MODE_LINK":        2,
+		"SECRET_DELIVERY_MODE_PUBLIC_KEY":  3,
+	}
+)
+
+func (x SecretDeliveryMode) Enum() *SecretDeliveryMode {
+	p := new(SecretDeliveryMode)
+	*p = x
+	return p
+}
+
+func (x SecretDeliveryMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SecretDeliveryMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_altalune_v1_common_proto_enumTypes[2].Descriptor()
+}
+
+func (SecretDeliveryMode) Type() protoreflect.EnumType {
+	return &file_altalune_v1_common_proto_enumTypes[2]
+}
+
+func (x SecretDeliveryMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SecretDeliveryMode.Descriptor instead.
+func (SecretDeliveryMode) EnumDescriptor() ([]byte, []int) {
+	return file_altalune_v1_common_proto_rawDescGZIP(), []int{2}
+}
+
+// ErrorDetail is attached to every application error. Clients should match on
+// code rather than on the error message, which is meant for humans only.
 type ErrorDetail struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
-	Meta          map[string]string      `protobuf:"bytes,3,rep,name=meta,proto3" json:"meta,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// code - stable machine-readable error code (see docs/errors.md)
+	Code string            `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Meta map[string]string `protobuf:"bytes,3,rep,name=meta,proto3" json:"meta,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// domain - the domain that produced the error (e.g., "project", "user")
+	Domain string `protobuf:"bytes,4,opt,name=domain,proto3" json:"domain,omitempty"`
+	// retryable - whether the same request may succeed when retried
+	Retryable bool `protobuf:"varint,5,opt,name=retryable,proto3" json:"retryable,omitempty"`
+	// http_status - HTTP status code corresponding to the error
+	HttpStatus    int32 `protobuf:"varint,6,opt,name=http_status,json=httpStatus,proto3" json:"http_status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -123,6 +245,27 @@ func (x *ErrorDetail) GetMeta() map[string]string {
 	return nil
 }
 
+func (x *ErrorDetail) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *ErrorDetail) GetRetryable() bool {
+	if x != nil {
+		return x.Retryable
+	}
+	return false
+}
+
+func (x *ErrorDetail) GetHttpStatus() int32 {
+	if x != nil {
+		return x.HttpStatus
+	}
+	return 0
+}
+
 type StringList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Values        []string               `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
@@ -272,11 +415,17 @@ func (x *Sorting) GetOrder() SortOrder {
 }
 
 type QueryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pagination    *Pagination            `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	Keyword       string                 `protobuf:"bytes,2,opt,name=keyword,proto3" json:"keyword,omitempty"`
-	Filters       map[string]*StringList `protobuf:"bytes,3,rep,name=filters,proto3" json:"filters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Sorting       *Sorting               `protobuf:"bytes,4,opt,name=sorting,proto3" json:"sorting,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Pagination *Pagination            `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Keyword    string                 `protobuf:"bytes,2,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	Filters    map[string]*StringList `protobuf:"bytes,3,rep,name=filters,proto3" json:"filters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Sorting    *Sorting               `protobuf:"bytes,4,opt,name=sorting,proto3" json:"sorting,omitempty"`
+	CountMode  CountMode              `protobuf:"varint,5,opt,name=count_mode,json=countMode,proto3,enum=altalune.v1.CountMode" json:"count_mode,omitempty"`
+	// cursor - next_cursor of the previous page, to fetch the page following it
+	// instead of pagination.page; the other fields must not change. A cursor is
+	// the sort value and ID of the last row seen, so rows inserted or deleted
+	// between fetches neither repeat nor skip a row of an infinite scroll
+	Cursor        string `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -339,6 +488,20 @@ func (x *QueryRequest) GetSorting() *Sorting {
 	return nil
 }
 
+func (x *QueryRequest) GetCountMode() CountMode {
+	if x != nil {
+		return x.CountMode
+	}
+	return CountMode_COUNT_MODE_UNSPECIFIED
+}
+
+func (x *QueryRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
 type FiltersCatalog struct {
 	state         protoimpl.MessageState   `protogen:"open.v1"`
 	Filters       map[string]*FilterValues `protobuf:"bytes,1,rep,name=filters,proto3" json:"filters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
@@ -428,10 +591,22 @@ func (x *FilterValues) GetValues() []string {
 }
 
 type QueryMetaResponse struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	RowCount      int32                    `protobuf:"varint,1,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
-	PageCount     int32                    `protobuf:"varint,2,opt,name=page_count,json=pageCount,proto3" json:"page_count,omitempty"`
-	Filters       map[string]*FilterValues `protobuf:"bytes,3,rep,name=filters,proto3" json:"filters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// row_count - estimated when count_mode is COUNT_MODE_ESTIMATED, and the
+	// rows up to this page when it is COUNT_MODE_NONE
+	RowCount  int32                    `protobuf:"varint,1,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	PageCount int32                    `protobuf:"varint,2,opt,name=page_count,json=pageCount,proto3" json:"page_count,omitempty"`
+	Filters   map[string]*FilterValues `protobuf:"bytes,3,rep,name=filters,proto3" json:"filters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// count_mode - how row_count was obtained
+	CountMode CountMode `protobuf:"varint,4,opt,name=count_mode,json=countMode,proto3,enum=altalune.v1.CountMode" json:"count_mode,omitempty"`
+	// has_next_page - whether a page follows this one
+	HasNextPage bool `protobuf:"varint,5,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+	// next_cursor - position after the last row of this page, to pass as
+	// QueryRequest.cursor for the page following it; empty on the last page
+	NextCursor string `protobuf:"bytes,6,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	// exact_total - whether row_count is the exact number of matching rows:
+	// always with an exact count, and with the others once the last page tells
+	ExactTotal    bool `protobuf:"varint,7,opt,name=exact_total,json=exactTotal,proto3" json:"exact_total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -487,14 +662,120 @@ func (x *QueryMetaResponse) GetFilters() map[string]*FilterValues {
 	return nil
 }
 
+func (x *QueryMetaResponse) GetCountMode() CountMode {
+	if x != nil {
+		return x.CountMode
+	}
+	return CountMode_COUNT_MODE_UNSPECIFIED
+}
+
+func (x *QueryMetaResponse) GetHasNextPage() bool {
+	if x != nil {
+		return x.HasNextPage
+	}
+	return false
+}
+
+func (x *QueryMetaResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *QueryMetaResponse) GetExactTotal() bool {
+	if x != nil {
+		return x.ExactTotal
+	}
+	return false
+}
+
+// DeliveredSecret - a secret generated on creation that was not returned in
+// plaintext
+type DeliveredSecret struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Mode  SecretDeliveryMode     `protobuf:"varint,1,opt,name=mode,proto3,enum=altalune.v1.SecretDeliveryMode" json:"mode,omitempty"`
+	// retrieval_url - path of the one-time link on the API server, for
+	// SECRET_DELIVERY_MODE_LINK. The first visit reveals the secret and burns it.
+	RetrievalUrl       string                 `protobuf:"bytes,2,opt,name=retrieval_url,json=retrievalUrl,proto3" json:"retrieval_url,omitempty"`
+	RetrievalExpiresAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=retrieval_expires_at,json=retrievalExpiresAt,proto3" json:"retrieval_expires_at,omitempty"`
+	// encrypted_secret - base64 RSA-OAEP (SHA-256) ciphertext of the secret, for
+	// SECRET_DELIVERY_MODE_PUBLIC_KEY
+	EncryptedSecret string `protobuf:"bytes,4,opt,name=encrypted_secret,json=encryptedSecret,proto3" json:"encrypted_secret,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeliveredSecret) Reset() {
+	*x = DeliveredSecret{}
+	mi := &file_altalune_v1_common_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeliveredSecret) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliveredSecret) ProtoMessage() {}
+
+func (x *DeliveredSecret) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_common_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliveredSecret.ProtoReflect.Descriptor instead.
+func (*DeliveredSecret) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_common_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeliveredSecret) GetMode() SecretDeliveryMode {
+	if x != nil {
+		return x.Mode
+	}
+	return SecretDeliveryMode_SECRET_DELIVERY_MODE_UNSPECIFIED
+}
+
+func (x *DeliveredSecret) GetRetrievalUrl() string {
+	if x != nil {
+		return x.RetrievalUrl
+	}
+	return ""
+}
+
+func (x *DeliveredSecret) GetRetrievalExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RetrievalExpiresAt
+	}
+	return nil
+}
+
+func (x *DeliveredSecret) GetEncryptedSecret() string {
+	if x != nil {
+		return x.EncryptedSecret
+	}
+	return ""
+}
+
 var File_altalune_v1_common_proto protoreflect.FileDescriptor
 
 const file_altalune_v1_common_proto_rawDesc = "" +
 	"\n" +
-	"\x18altalune/v1/common.proto\x12\valtalune.v1\x1a\x1bbuf/validate/validate.proto\"\x92\x01\n" +
+	"\x18altalune/v1/common.proto\x12\valtalune.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\"\xe9\x01\n" +
 	"\vErrorDetail\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\tR\x04code\x126\n" +
-	"\x04meta\x18\x03 \x03(\v2\".altalune.v1.ErrorDetail.MetaEntryR\x04meta\x1a7\n" +
+	"\x04meta\x18\x03 \x03(\v2\".altalune.v1.ErrorDetail.MetaEntryR\x04meta\x12\x16\n" +
+	"\x06domain\x18\x04 \x01(\tR\x06domain\x12\x1c\n" +
+	"\tretryable\x18\x05 \x01(\bR\tretryable\x12\x1f\n" +
+	"\vhttp_status\x18\x06 \x01(\x05R\n" +
+	"httpStatus\x1a7\n" +
 	"\tMetaEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\".\n" +
@@ -509,14 +790,17 @@ const file_altalune_v1_common_proto_rawDesc = "" +
 	"\xc8\x01\x01\x1a\x05\x18\x90N \x00R\bpageSize\"_\n" +
 	"\aSorting\x12\x1c\n" +
 	"\x05field\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x05field\x126\n" +
-	"\x05order\x18\x02 \x01(\x0e2\x16.altalune.v1.SortOrderB\b\xbaH\x05\x82\x01\x02\x10\x01R\x05order\"\xba\x02\n" +
+	"\x05order\x18\x02 \x01(\x0e2\x16.altalune.v1.SortOrderB\b\xbaH\x05\x82\x01\x02\x10\x01R\x05order\"\x9d\x03\n" +
 	"\fQueryRequest\x12?\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2\x17.altalune.v1.PaginationB\x06\xbaH\x03\xc8\x01\x01R\n" +
 	"pagination\x12\"\n" +
 	"\akeyword\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x02R\akeyword\x12@\n" +
 	"\afilters\x18\x03 \x03(\v2&.altalune.v1.QueryRequest.FiltersEntryR\afilters\x12.\n" +
-	"\asorting\x18\x04 \x01(\v2\x14.altalune.v1.SortingR\asorting\x1aS\n" +
+	"\asorting\x18\x04 \x01(\v2\x14.altalune.v1.SortingR\asorting\x12?\n" +
+	"\n" +
+	"count_mode\x18\x05 \x01(\x0e2\x16.altalune.v1.CountModeB\b\xbaH\x05\x82\x01\x02\x10\x01R\tcountMode\x12 \n" +
+	"\x06cursor\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x18\x80 R\x06cursor\x1aS\n" +
 	"\fFiltersEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12-\n" +
 	"\x05value\x18\x02 \x01(\v2\x17.altalune.v1.StringListR\x05value:\x028\x01\"\xab\x01\n" +
@@ -526,19 +810,41 @@ const file_altalune_v1_common_proto_rawDesc = "" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12/\n" +
 	"\x05value\x18\x02 \x01(\v2\x19.altalune.v1.FilterValuesR\x05value:\x028\x01\"&\n" +
 	"\fFilterValues\x12\x16\n" +
-	"\x06values\x18\x01 \x03(\tR\x06values\"\xed\x01\n" +
+	"\x06values\x18\x01 \x03(\tR\x06values\"\x8a\x03\n" +
 	"\x11QueryMetaResponse\x12\x1b\n" +
 	"\trow_count\x18\x01 \x01(\x05R\browCount\x12\x1d\n" +
 	"\n" +
 	"page_count\x18\x02 \x01(\x05R\tpageCount\x12E\n" +
-	"\afilters\x18\x03 \x03(\v2+.altalune.v1.QueryMetaResponse.FiltersEntryR\afilters\x1aU\n" +
+	"\afilters\x18\x03 \x03(\v2+.altalune.v1.QueryMetaResponse.FiltersEntryR\afilters\x125\n" +
+	"\n" +
+	"count_mode\x18\x04 \x01(\x0e2\x16.altalune.v1.CountModeR\tcountMode\x12\"\n" +
+	"\rhas_next_page\x18\x05 \x01(\bR\vhasNextPage\x12\x1f\n" +
+	"\vnext_cursor\x18\x06 \x01(\tR\n" +
+	"nextCursor\x12\x1f\n" +
+	"\vexact_total\x18\a \x01(\bR\n" +
+	"exactTotal\x1aU\n" +
 	"\fFiltersEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12/\n" +
-	"\x05value\x18\x02 \x01(\v2\x19.altalune.v1.FilterValuesR\x05value:\x028\x01*P\n" +
+	"\x05value\x18\x02 \x01(\v2\x19.altalune.v1.FilterValuesR\x05value:\x028\x01\"\xe4\x01\n" +
+	"\x0fDeliveredSecret\x123\n" +
+	"\x04mode\x18\x01 \x01(\x0e2\x1f.altalune.v1.SecretDeliveryModeR\x04mode\x12#\n" +
+	"\rretrieval_url\x18\x02 \x01(\tR\fretrievalUrl\x12L\n" +
+	"\x14retrieval_expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x12retrievalExpiresAt\x12)\n" +
+	"\x10encrypted_secret\x18\x04 \x01(\tR\x0fencryptedSecret*P\n" +
 	"\tSortOrder\x12\x1a\n" +
 	"\x16SORT_ORDER_UNSPECIFIED\x10\x00\x12\x12\n" +
 	"\x0eSORT_ORDER_ASC\x10\x01\x12\x13\n" +
-	"\x0fSORT_ORDER_DESC\x10\x02B\xa0\x01\n" +
+	"\x0fSORT_ORDER_DESC\x10\x02*l\n" +
+	"\tCountMode\x12\x1a\n" +
+	"\x16COUNT_MODE_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10COUNT_MODE_EXACT\x10\x01\x12\x18\n" +
+	"\x14COUNT_MODE_ESTIMATED\x10\x02\x12\x13\n" +
+	"\x0fCOUNT_MODE_NONE\x10\x03*\x9f\x01\n" +
+	"\x12SecretDeliveryMode\x12$\n" +
+	" SECRET_DELIVERY_MODE_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bSECRET_DELIVERY_MODE_REVEAL\x10\x01\x12\x1d\n" +
+	"\x19SECRET_DELIVERY_MODE_LINK\x10\x02\x12#\n" +
+	"\x1fSECRET_DELIVERY_MODE_PUBLIC_KEY\x10\x03B\xa0\x01\n" +
 	"\x0fcom.altalune.v1B\vCommonProtoP\x01Z3github.com/hrz8/altalune/gen/altalune/v1;altalunev1\xa2\x02\x03AXX\xaa\x02\vAltalune.V1\xca\x02\vAltalune\\V1\xe2\x02\x17Altalune\\V1\\GPBMetadata\xea\x02\fAltalune::V1b\x06proto3"
 
 var (
@@ -553,39 +859,47 @@ func file_altalune_v1_common_proto_rawDescGZIP() []byte {
 	return file_altalune_v1_common_proto_rawDescData
 }
 
-var file_altalune_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_altalune_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_altalune_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_altalune_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_altalune_v1_common_proto_goTypes = []any{
-	(SortOrder)(0),            // 0: altalune.v1.SortOrder
-	(*ErrorDetail)(nil),       // 1: altalune.v1.ErrorDetail
-	(*StringList)(nil),        // 2: altalune.v1.StringList
-	(*Pagination)(nil),        // 3: altalune.v1.Pagination
-	(*Sorting)(nil),           // 4: altalune.v1.Sorting
-	(*QueryRequest)(nil),      // 5: altalune.v1.QueryRequest
-	(*FiltersCatalog)(nil),    // 6: altalune.v1.FiltersCatalog
-	(*FilterValues)(nil),      // 7: altalune.v1.FilterValues
-	(*QueryMetaResponse)(nil), // 8: altalune.v1.QueryMetaResponse
-	nil,                       // 9: altalune.v1.ErrorDetail.MetaEntry
-	nil,                       // 10: altalune.v1.QueryRequest.FiltersEntry
-	nil,                       // 11: altalune.v1.FiltersCatalog.FiltersEntry
-	nil,                       // 12: altalune.v1.QueryMetaResponse.FiltersEntry
+	(SortOrder)(0),                // 0: altalune.v1.SortOrder
+	(CountMode)(0),                // 1: altalune.v1.CountMode
+	(SecretDeliveryMode)(0),       // 2: altalune.v1.SecretDeliveryMode
+	(*ErrorDetail)(nil),           // 3: altalune.v1.ErrorDetail
+	(*StringList)(nil),            // 4: altalune.v1.StringList
+	(*Pagination)(nil),            // 5: altalune.v1.Pagination
+	(*Sorting)(nil),               // 6: altalune.v1.Sorting
+	(*QueryRequest)(nil),          // 7: altalune.v1.QueryRequest
+	(*FiltersCatalog)(nil),        // 8: altalune.v1.FiltersCatalog
+	(*FilterValues)(nil),          // 9: altalune.v1.FilterValues
+	(*QueryMetaResponse)(nil),     // 10: altalune.v1.QueryMetaResponse
+	(*DeliveredSecret)(nil),       // 11: altalune.v1.DeliveredSecret
+	nil,                           // 12: altalune.v1.ErrorDetail.MetaEntry
+	nil,                           // 13: altalune.v1.QueryRequest.FiltersEntry
+	nil,                           // 14: altalune.v1.FiltersCatalog.FiltersEntry
+	nil,                           // 15: altalune.v1.QueryMetaResponse.FiltersEntry
+	(*timestamppb.Timestamp)(nil), // 16: google.protobuf.Timestamp
 }
 var file_altalune_v1_common_proto_depIdxs = []int32{
-	9,  // 0: altalune.v1.ErrorDetail.meta:type_name -> altalune.v1.ErrorDetail.MetaEntry
+	12, // 0: altalune.v1.ErrorDetail.meta:type_name -> altalune.v1.ErrorDetail.MetaEntry
 	0,  // 1: altalune.v1.Sorting.order:type_name -> altalune.v1.SortOrder
-	3,  // 2: altalune.v1.QueryRequest.pagination:type_name -> altalune.v1.Pagination
-	10, // 3: altalune.v1.QueryRequest.filters:type_name -> altalune.v1.QueryRequest.FiltersEntry
-	4,  // 4: altalune.v1.QueryRequest.sorting:type_name -> altalune.v1.Sorting
-	11, // 5: altalune.v1.FiltersCatalog.filters:type_name -> altalune.v1.FiltersCatalog.FiltersEntry
-	12, // 6: altalune.v1.QueryMetaResponse.filters:type_name -> altalune.v1.QueryMetaResponse.FiltersEntry
-	2,  // 7: altalune.v1.QueryRequest.FiltersEntry.value:type_name -> altalune.v1.StringList
-	7,  // 8: altalune.v1.FiltersCatalog.FiltersEntry.value:type_name -> altalune.v1.FilterValues
-	7,  // 9: altalune.v1.QueryMetaResponse.FiltersEntry.value:type_name -> altalune.v1.FilterValues
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	5,  // 2: altalune.v1.QueryRequest.pagination:type_name -> altalune.v1.Pagination
+	13, // 3: altalune.v1.QueryRequest.filters:type_name -> altalune.v1.QueryRequest.FiltersEntry
+	6,  // 4: altalune.v1.QueryRequest.sorting:type_name -> altalune.v1.Sorting
+	1,  // 5: altalune.v1.QueryRequest.count_mode:type_name -> altalune.v1.CountMode
+	14, // 6: altalune.v1.FiltersCatalog.filters:type_name -> altalune.v1.FiltersCatalog.FiltersEntry
+	15, // 7: altalune.v1.QueryMetaResponse.filters:type_name -> altalune.v1.QueryMetaResponse.FiltersEntry
+	1,  // 8: altalune.v1.QueryMetaResponse.count_mode:type_name -> altalune.v1.CountMode
+	2,  // 9: altalune.v1.DeliveredSecret.mode:type_name -> altalune.v1.SecretDeliveryMode
+	16, // 10: altalune.v1.DeliveredSecret.retrieval_expires_at:type_name -> google.protobuf.Timestamp
+	4,  // 11: altalune.v1.QueryRequest.FiltersEntry.value:type_name -> altalune.v1.StringList
+	9,  // 12: altalune.v1.FiltersCatalog.FiltersEntry.value:type_name -> altalune.v1.FilterValues
+	9,  // 13: altalune.v1.QueryMetaResponse.FiltersEntry.value:type_name -> altalune.v1.FilterValues
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_altalune_v1_common_proto_init() }
@@ -598,8 +912,8 @@ func file_altalune_v1_common_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_altalune_v1_common_proto_rawDesc), len(file_altalune_v1_common_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   12,
+			NumEnums:      3,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
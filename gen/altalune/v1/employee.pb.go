@@ -10,6 +10,7 @@ import (
 	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -72,16 +73,66 @@ func (EmployeeStatus) EnumDescriptor() ([]byte, []int) {
 	return file_altalune_v1_employee_proto_rawDescGZIP(), []int{0}
 }
 
+type EmployeeExportFormat int32
+
+const (
+	EmployeeExportFormat_EMPLOYEE_EXPORT_FORMAT_UNSPECIFIED EmployeeExportFormat = 0
+	EmployeeExportFormat_EMPLOYEE_EXPORT_FORMAT_CSV         EmployeeExportFormat = 1
+	EmployeeExportFormat_EMPLOYEE_EXPORT_FORMAT_XLSX        EmployeeExportFormat = 2
+)
+
+// Enum value maps for EmployeeExportFormat.
+var (
+	EmployeeExportFormat_name = map[int32]string{
+		0: "EMPLOYEE_EXPORT_FORMAT_UNSPECIFIED",
+		1: "EMPLOYEE_EXPORT_FORMAT_CSV",
+		2: "EMPLOYEE_EXPORT_FORMAT_XLSX",
+	}
+	EmployeeExportFormat_value = map[string]int32{
+		"EMPLOYEE_EXPORT_FORMAT_UNSPECIFIED": 0,
+		"EMPLOYEE_EXPORT_FORMAT_CSV":         1,
+		"EMPLOYEE_EXPORT_FORMAT_XLSX":        2,
+	}
+)
+
+func (x EmployeeExportFormat) Enum() *EmployeeExportFormat {
+	p := new(EmployeeExportFormat)
+	*p = x
+	return p
+}
+
+func (x EmployeeExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EmployeeExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_altalune_v1_employee_proto_enumTypes[1].Descriptor()
+}
+
+func (EmployeeExportFormat) Type() protoreflect.EnumType {
+	return &file_altalune_v1_employee_proto_enumTypes[1]
+}
+
+func (x EmployeeExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EmployeeExportFormat.Descriptor instead.
+func (EmployeeExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_altalune_v1_employee_proto_rawDescGZIP(), []int{1}
+}
+
 type Employee struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"` // PII
 	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
 	Department    string                 `protobuf:"bytes,5,opt,name=department,proto3" json:"department,omitempty"`
 	Status        EmployeeStatus         `protobuf:"varint,6,opt,name=status,proto3,enum=altalune.v1.EmployeeStatus" json:"status,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"` // Set only for employees in the trash
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -172,6 +223,13 @@ func (x *Employee) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Employee) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
 type CreateEmployeeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
@@ -309,9 +367,13 @@ func (x *CreateEmployeeResponse) GetMessage() string {
 }
 
 type QueryEmployeesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	Query         *QueryRequest          `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Query     *QueryRequest          `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	// List deleted employees that can still be restored instead of live ones.
+	Trashed bool `protobuf:"varint,3,opt,name=trashed,proto3" json:"trashed,omitempty"`
+	// Employee fields to return, all when empty. Only top-level fields.
+	ReadMask      *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -360,6 +422,20 @@ func (x *QueryEmployeesRequest) GetQuery() *QueryRequest {
 	return nil
 }
 
+func (x *QueryEmployeesRequest) GetTrashed() bool {
+	if x != nil {
+		return x.Trashed
+	}
+	return false
+}
+
+func (x *QueryEmployeesRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
 type QueryEmployeesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Data          []*Employee            `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
@@ -412,6 +488,123 @@ func (x *QueryEmployeesResponse) GetMeta() *QueryMetaResponse {
 	return nil
 }
 
+// Streams every employee matching the query instead of a single page. Each
+// message carries up to pagination.page_size rows, starting after the row of
+// the query cursor if any, at pagination.page otherwise; meta is only set on
+// the first message. Employees come in the order of the sorting of the query.
+type StreamEmployeesRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Query     *QueryRequest          `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	// Employee fields to return, all when empty. Only top-level fields.
+	ReadMask      *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamEmployeesRequest) Reset() {
+	*x = StreamEmployeesRequest{}
+	mi := &file_altalune_v1_employee_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamEmployeesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEmployeesRequest) ProtoMessage() {}
+
+func (x *StreamEmployeesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_employee_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEmployeesRequest.ProtoReflect.Descriptor instead.
+func (*StreamEmployeesRequest) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_employee_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *StreamEmployeesRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *StreamEmployeesRequest) GetQuery() *QueryRequest {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *StreamEmployeesRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+type StreamEmployeesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*Employee            `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Meta          *QueryMetaResponse     `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamEmployeesResponse) Reset() {
+	*x = StreamEmployeesResponse{}
+	mi := &file_altalune_v1_employee_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamEmployeesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEmployeesResponse) ProtoMessage() {}
+
+func (x *StreamEmployeesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_employee_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEmployeesResponse.ProtoReflect.Descriptor instead.
+func (*StreamEmployeesResponse) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_employee_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StreamEmployeesResponse) GetData() []*Employee {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *StreamEmployeesResponse) GetMeta() *QueryMetaResponse {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
 type GetEmployeeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
@@ -422,7 +615,7 @@ type GetEmployeeRequest struct {
 
 func (x *GetEmployeeRequest) Reset() {
 	*x = GetEmployeeRequest{}
-	mi := &file_altalune_v1_employee_proto_msgTypes[5]
+	mi := &file_altalune_v1_employee_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -434,7 +627,7 @@ func (x *GetEmployeeRequest) String() string {
 func (*GetEmployeeRequest) ProtoMessage() {}
 
 func (x *GetEmployeeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_altalune_v1_employee_proto_msgTypes[5]
+	mi := &file_altalune_v1_employee_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -447,7 +640,7 @@ func (x *GetEmployeeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEmployeeRequest.ProtoReflect.Descriptor instead.
 func (*GetEmployeeRequest) Descriptor() ([]byte, []int) {
-	return file_altalune_v1_employee_proto_rawDescGZIP(), []int{5}
+	return file_altalune_v1_employee_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetEmployeeRequest) GetProjectId() string {
@@ -473,7 +666,7 @@ type GetEmployeeResponse struct {
 
 func (x *GetEmployeeResponse) Reset() {
 	*x = GetEmployeeResponse{}
-	mi := &file_altalune_v1_employee_proto_msgTypes[6]
+	mi := &file_altalune_v1_employee_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -485,7 +678,7 @@ func (x *GetEmployeeResponse) String() string {
 func (*GetEmployeeResponse) ProtoMessage() {}
 
 func (x *GetEmployeeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_altalune_v1_employee_proto_msgTypes[6]
+	mi := &file_altalune_v1_employee_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -498,7 +691,7 @@ func (x *GetEmployeeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEmployeeResponse.ProtoReflect.Descriptor instead.
 func (*GetEmployeeResponse) Descriptor() ([]byte, []int) {
-	return file_altalune_v1_employee_proto_rawDescGZIP(), []int{6}
+	return file_altalune_v1_employee_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetEmployeeResponse) GetEmployee() *Employee {
@@ -509,21 +702,25 @@ func (x *GetEmployeeResponse) GetEmployee() *Employee {
 }
 
 type UpdateEmployeeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	EmployeeId    string                 `protobuf:"bytes,2,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
-	Role          string                 `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
-	Department    string                 `protobuf:"bytes,6,opt,name=department,proto3" json:"department,omitempty"`
-	Status        EmployeeStatus         `protobuf:"varint,7,opt,name=status,proto3,enum=altalune.v1.EmployeeStatus" json:"status,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId  string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	EmployeeId string                 `protobuf:"bytes,2,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	Name       string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Empty keeps the current email, for callers who may not see it
+	Email      string         `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Role       string         `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
+	Department string         `protobuf:"bytes,6,opt,name=department,proto3" json:"department,omitempty"`
+	Status     EmployeeStatus `protobuf:"varint,7,opt,name=status,proto3,enum=altalune.v1.EmployeeStatus" json:"status,omitempty"`
+	// updated_at of the employee the edit is based on. When set, the update is
+	// rejected with FAILED_PRECONDITION if the employee changed since then.
+	ExpectedUpdatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expected_updated_at,json=expectedUpdatedAt,proto3" json:"expected_updated_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *UpdateEmployeeRequest) Reset() {
 	*x = UpdateEmployeeRequest{}
-	mi := &file_altalune_v1_employee_proto_msgTypes[7]
+	mi := &file_altalune_v1_employee_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -535,7 +732,7 @@ func (x *UpdateEmployeeRequest) String() string {
 func (*UpdateEmployeeRequest) ProtoMessage() {}
 
 func (x *UpdateEmployeeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_altalune_v1_employee_proto_msgTypes[7]
+	mi := &file_altalune_v1_employee_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -548,7 +745,7 @@ func (x *UpdateEmployeeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateEmployeeRequest.ProtoReflect.Descriptor instead.
 func (*UpdateEmployeeRequest) Descriptor() ([]byte, []int) {
-	return file_altalune_v1_employee_proto_rawDescGZIP(), []int{7}
+	return file_altalune_v1_employee_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UpdateEmployeeRequest) GetProjectId() string {
@@ -600,6 +797,13 @@ func (x *UpdateEmployeeRequest) GetStatus() EmployeeStatus {
 	return EmployeeStatus_EMPLOYEE_STATUS_UNSPECIFIED
 }
 
+func (x *UpdateEmployeeRequest) GetExpectedUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpectedUpdatedAt
+	}
+	return nil
+}
+
 type UpdateEmployeeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Employee      *Employee              `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
@@ -610,7 +814,7 @@ type UpdateEmployeeResponse struct {
 
 func (x *UpdateEmployeeResponse) Reset() {
 	*x = UpdateEmployeeResponse{}
-	mi := &file_altalune_v1_employee_proto_msgTypes[8]
+	mi := &file_altalune_v1_employee_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -622,7 +826,7 @@ func (x *UpdateEmployeeResponse) String() string {
 func (*UpdateEmployeeResponse) ProtoMessage() {}
 
 func (x *UpdateEmployeeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_altalune_v1_employee_proto_msgTypes[8]
+	mi := &file_altalune_v1_employee_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -635,7 +839,7 @@ func (x *UpdateEmployeeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateEmployeeResponse.ProtoReflect.Descriptor instead.
 func (*UpdateEmployeeResponse) Descriptor() ([]byte, []int) {
-	return file_altalune_v1_employee_proto_rawDescGZIP(), []int{8}
+	return file_altalune_v1_employee_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UpdateEmployeeResponse) GetEmployee() *Employee {
@@ -662,7 +866,7 @@ type DeleteEmployeeRequest struct {
 
 func (x *DeleteEmployeeRequest) Reset() {
 	*x = DeleteEmployeeRequest{}
-	mi := &file_altalune_v1_employee_proto_msgTypes[9]
+	mi := &file_altalune_v1_employee_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -674,7 +878,7 @@ func (x *DeleteEmployeeRequest) String() string {
 func (*DeleteEmployeeRequest) ProtoMessage() {}
 
 func (x *DeleteEmployeeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_altalune_v1_employee_proto_msgTypes[9]
+	mi := &file_altalune_v1_employee_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -687,7 +891,7 @@ func (x *DeleteEmployeeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteEmployeeRequest.ProtoReflect.Descriptor instead.
 func (*DeleteEmployeeRequest) Descriptor() ([]byte, []int) {
-	return file_altalune_v1_employee_proto_rawDescGZIP(), []int{9}
+	return file_altalune_v1_employee_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *DeleteEmployeeRequest) GetProjectId() string {
@@ -713,7 +917,7 @@ type DeleteEmployeeResponse struct {
 
 func (x *DeleteEmployeeResponse) Reset() {
 	*x = DeleteEmployeeResponse{}
-	mi := &file_altalune_v1_employee_proto_msgTypes[10]
+	mi := &file_altalune_v1_employee_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -725,7 +929,7 @@ func (x *DeleteEmployeeResponse) String() string {
 func (*DeleteEmployeeResponse) ProtoMessage() {}
 
 func (x *DeleteEmployeeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_altalune_v1_employee_proto_msgTypes[10]
+	mi := &file_altalune_v1_employee_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -738,7 +942,7 @@ func (x *DeleteEmployeeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteEmployeeResponse.ProtoReflect.Descriptor instead.
 func (*DeleteEmployeeResponse) Descriptor() ([]byte, []int) {
-	return file_altalune_v1_employee_proto_rawDescGZIP(), []int{10}
+	return file_altalune_v1_employee_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DeleteEmployeeResponse) GetMessage() string {
@@ -748,15 +952,516 @@ func (x *DeleteEmployeeResponse) GetMessage() string {
 	return ""
 }
 
+type RestoreEmployeeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	EmployeeId    string                 `protobuf:"bytes,2,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreEmployeeRequest) Reset() {
+	*x = RestoreEmployeeRequest{}
+	mi := &file_altalune_v1_employee_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreEmployeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreEmployeeRequest) ProtoMessage() {}
+
+func (x *RestoreEmployeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_employee_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreEmployeeRequest.ProtoReflect.Descriptor instead.
+func (*RestoreEmployeeRequest) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_employee_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RestoreEmployeeRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *RestoreEmployeeRequest) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+type RestoreEmployeeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Employee      *Employee              `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreEmployeeResponse) Reset() {
+	*x = RestoreEmployeeResponse{}
+	mi := &file_altalune_v1_employee_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreEmployeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreEmployeeResponse) ProtoMessage() {}
+
+func (x *RestoreEmployeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_employee_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreEmployeeResponse.ProtoReflect.Descriptor instead.
+func (*RestoreEmployeeResponse) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_employee_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RestoreEmployeeResponse) GetEmployee() *Employee {
+	if x != nil {
+		return x.Employee
+	}
+	return nil
+}
+
+func (x *RestoreEmployeeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Bulk import: the first message carries the metadata, every following
+// message a chunk of the CSV file. The CSV needs a header row with the
+// name, email, role and department columns; status is optional (active or
+// inactive, default active) and unknown columns are ignored.
+type ImportEmployeesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*ImportEmployeesRequest_Metadata
+	//	*ImportEmployeesRequest_Chunk
+	Payload       isImportEmployeesRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportEmployeesRequest) Reset() {
+	*x = ImportEmployeesRequest{}
+	mi := &file_altalune_v1_employee_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportEmployeesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportEmployeesRequest) ProtoMessage() {}
+
+func (x *ImportEmployeesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_employee_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportEmployeesRequest.ProtoReflect.Descriptor instead.
+func (*ImportEmployeesRequest) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_employee_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ImportEmployeesRequest) GetPayload() isImportEmployeesRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ImportEmployeesRequest) GetMetadata() *ImportEmployeesMetadata {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportEmployeesRequest_Metadata); ok {
+			return x.Metadata
+		}
+	}
+	return nil
+}
+
+func (x *ImportEmployeesRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportEmployeesRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isImportEmployeesRequest_Payload interface {
+	isImportEmployeesRequest_Payload()
+}
+
+type ImportEmployeesRequest_Metadata struct {
+	Metadata *ImportEmployeesMetadata `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
+}
+
+type ImportEmployeesRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"` // 1 MiB
+}
+
+func (*ImportEmployeesRequest_Metadata) isImportEmployeesRequest_Payload() {}
+
+func (*ImportEmployeesRequest_Chunk) isImportEmployeesRequest_Payload() {}
+
+type ImportEmployeesMetadata struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// Validate the file without importing anything.
+	DryRun        bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportEmployeesMetadata) Reset() {
+	*x = ImportEmployeesMetadata{}
+	mi := &file_altalune_v1_employee_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportEmployeesMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportEmployeesMetadata) ProtoMessage() {}
+
+func (x *ImportEmployeesMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_employee_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportEmployeesMetadata.ProtoReflect.Descriptor instead.
+func (*ImportEmployeesMetadata) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_employee_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ImportEmployeesMetadata) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ImportEmployeesMetadata) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportEmployeesRowError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          int32                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`  // 1-based line in the CSV file, the header is line 1
+	Field         string                 `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"` // Column name, empty for row-level errors
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportEmployeesRowError) Reset() {
+	*x = ImportEmployeesRowError{}
+	mi := &file_altalune_v1_employee_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportEmployeesRowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportEmployeesRowError) ProtoMessage() {}
+
+func (x *ImportEmployeesRowError) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_employee_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportEmployeesRowError.ProtoReflect.Descriptor instead.
+func (*ImportEmployeesRowError) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_employee_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ImportEmployeesRowError) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportEmployeesRowError) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ImportEmployeesRowError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ImportEmployeesResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	TotalRows     int32                      `protobuf:"varint,1,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	ImportedRows  int32                      `protobuf:"varint,2,opt,name=imported_rows,json=importedRows,proto3" json:"imported_rows,omitempty"` // 0 when any row is invalid; imports are all-or-nothing
+	InvalidRows   int32                      `protobuf:"varint,3,opt,name=invalid_rows,json=invalidRows,proto3" json:"invalid_rows,omitempty"`
+	Errors        []*ImportEmployeesRowError `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"` // Capped at the first 100 errors
+	DryRun        bool                       `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Message       string                     `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportEmployeesResponse) Reset() {
+	*x = ImportEmployeesResponse{}
+	mi := &file_altalune_v1_employee_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportEmployeesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportEmployeesResponse) ProtoMessage() {}
+
+func (x *ImportEmployeesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_employee_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportEmployeesResponse.ProtoReflect.Descriptor instead.
+func (*ImportEmployeesResponse) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_employee_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ImportEmployeesResponse) GetTotalRows() int32 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *ImportEmployeesResponse) GetImportedRows() int32 {
+	if x != nil {
+		return x.ImportedRows
+	}
+	return 0
+}
+
+func (x *ImportEmployeesResponse) GetInvalidRows() int32 {
+	if x != nil {
+		return x.InvalidRows
+	}
+	return 0
+}
+
+func (x *ImportEmployeesResponse) GetErrors() []*ImportEmployeesRowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ImportEmployeesResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportEmployeesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ExportEmployeesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Format        EmployeeExportFormat   `protobuf:"varint,2,opt,name=format,proto3,enum=altalune.v1.EmployeeExportFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportEmployeesRequest) Reset() {
+	*x = ExportEmployeesRequest{}
+	mi := &file_altalune_v1_employee_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportEmployeesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportEmployeesRequest) ProtoMessage() {}
+
+func (x *ExportEmployeesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_employee_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportEmployeesRequest.ProtoReflect.Descriptor instead.
+func (*ExportEmployeesRequest) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_employee_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ExportEmployeesRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ExportEmployeesRequest) GetFormat() EmployeeExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return EmployeeExportFormat_EMPLOYEE_EXPORT_FORMAT_UNSPECIFIED
+}
+
+// Bulk export: the file is streamed in chunks. The first message also
+// carries the suggested filename and the content type.
+type ExportEmployeesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Chunk         []byte                 `protobuf:"bytes,3,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportEmployeesResponse) Reset() {
+	*x = ExportEmployeesResponse{}
+	mi := &file_altalune_v1_employee_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportEmployeesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportEmployeesResponse) ProtoMessage() {}
+
+func (x *ExportEmployeesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_employee_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportEmployeesResponse.ProtoReflect.Descriptor instead.
+func (*ExportEmployeesResponse) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_employee_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ExportEmployeesResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ExportEmployeesResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportEmployeesResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
 var File_altalune_v1_employee_proto protoreflect.FileDescriptor
 
 const file_altalune_v1_employee_proto_rawDesc = "" +
 	"\n" +
-	"\x1aaltalune/v1/employee.proto\x12\valtalune.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\x1a\x18altalune/v1/common.proto\"\xa3\x02\n" +
+	"\x1aaltalune/v1/employee.proto\x12\valtalune.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a google/protobuf/field_mask.proto\x1a\x1bbuf/validate/validate.proto\x1a\x18altalune/v1/common.proto\x1a\x19altalune/v1/options.proto\"\xf5\x02\n" +
 	"\bEmployee\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05email\x18\x03 \x01(\tR\x05email\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12+\n" +
+	"\x05email\x18\x03 \x01(\tB\x15\x92\xb5\x18\x11employee:read_piiR\x05email\x12\x12\n" +
 	"\x04role\x18\x04 \x01(\tR\x04role\x12\x1e\n" +
 	"\n" +
 	"department\x18\x05 \x01(\tR\n" +
@@ -765,7 +1470,9 @@ const file_altalune_v1_employee_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xa9\x02\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x129\n" +
+	"\n" +
+	"deleted_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\"\xa9\x02\n" +
 	"\x15CreateEmployeeRequest\x12*\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\tprojectId\x12/\n" +
@@ -779,13 +1486,23 @@ const file_altalune_v1_employee_proto_rawDesc = "" +
 	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x06status\"e\n" +
 	"\x16CreateEmployeeResponse\x121\n" +
 	"\bemployee\x18\x01 \x01(\v2\x15.altalune.v1.EmployeeR\bemployee\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"t\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xc7\x01\n" +
 	"\x15QueryEmployeesRequest\x12*\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\tprojectId\x12/\n" +
-	"\x05query\x18\x02 \x01(\v2\x19.altalune.v1.QueryRequestR\x05query\"w\n" +
+	"\x05query\x18\x02 \x01(\v2\x19.altalune.v1.QueryRequestR\x05query\x12\x18\n" +
+	"\atrashed\x18\x03 \x01(\bR\atrashed\x127\n" +
+	"\tread_mask\x18\x04 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\"w\n" +
 	"\x16QueryEmployeesResponse\x12)\n" +
 	"\x04data\x18\x01 \x03(\v2\x15.altalune.v1.EmployeeR\x04data\x122\n" +
+	"\x04meta\x18\x02 \x01(\v2\x1e.altalune.v1.QueryMetaResponseR\x04meta\"\xb6\x01\n" +
+	"\x16StreamEmployeesRequest\x12*\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\tprojectId\x127\n" +
+	"\x05query\x18\x02 \x01(\v2\x19.altalune.v1.QueryRequestB\x06\xbaH\x03\xc8\x01\x01R\x05query\x127\n" +
+	"\tread_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\"x\n" +
+	"\x17StreamEmployeesResponse\x12)\n" +
+	"\x04data\x18\x01 \x03(\v2\x15.altalune.v1.EmployeeR\x04data\x122\n" +
 	"\x04meta\x18\x02 \x01(\v2\x1e.altalune.v1.QueryMetaResponseR\x04meta\"o\n" +
 	"\x12GetEmployeeRequest\x12*\n" +
 	"\n" +
@@ -793,20 +1510,21 @@ const file_altalune_v1_employee_proto_rawDesc = "" +
 	"\vemployee_id\x18\x02 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x0e\x18\x0eR\n" +
 	"employeeId\"H\n" +
 	"\x13GetEmployeeResponse\x121\n" +
-	"\bemployee\x18\x01 \x01(\v2\x15.altalune.v1.EmployeeR\bemployee\"\xd8\x02\n" +
+	"\bemployee\x18\x01 \x01(\v2\x15.altalune.v1.EmployeeR\bemployee\"\xa4\x03\n" +
 	"\x15UpdateEmployeeRequest\x12*\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\tprojectId\x12-\n" +
 	"\vemployee_id\x18\x02 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x0e\x18\x0eR\n" +
 	"employeeId\x12/\n" +
 	"\x04name\x18\x03 \x01(\tB\x1b\xbaH\x18\xc8\x01\x01r\x13\x10\x02\x1822\r^[a-zA-Z\\s]+$R\x04name\x12\"\n" +
-	"\x05email\x18\x04 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x18d`\x01R\x05email\x12 \n" +
+	"\x05email\x18\x04 \x01(\tB\f\xbaH\t\xd8\x01\x01r\x04\x18d`\x01R\x05email\x12 \n" +
 	"\x04role\x18\x05 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x02\x18dR\x04role\x12,\n" +
 	"\n" +
 	"department\x18\x06 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x02\x18dR\n" +
 	"department\x12?\n" +
 	"\x06status\x18\a \x01(\x0e2\x1b.altalune.v1.EmployeeStatusB\n" +
-	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x06status\"e\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x06status\x12J\n" +
+	"\x13expected_updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x11expectedUpdatedAt\"e\n" +
 	"\x16UpdateEmployeeResponse\x121\n" +
 	"\bemployee\x18\x01 \x01(\v2\x15.altalune.v1.EmployeeR\bemployee\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\"r\n" +
@@ -816,17 +1534,62 @@ const file_altalune_v1_employee_proto_rawDesc = "" +
 	"\vemployee_id\x18\x02 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x0e\x18\x0eR\n" +
 	"employeeId\"2\n" +
 	"\x16DeleteEmployeeResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage*k\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"s\n" +
+	"\x16RestoreEmployeeRequest\x12*\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\tprojectId\x12-\n" +
+	"\vemployee_id\x18\x02 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x0e\x18\x0eR\n" +
+	"employeeId\"f\n" +
+	"\x17RestoreEmployeeResponse\x121\n" +
+	"\bemployee\x18\x01 \x01(\v2\x15.altalune.v1.EmployeeR\bemployee\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x91\x01\n" +
+	"\x16ImportEmployeesRequest\x12B\n" +
+	"\bmetadata\x18\x01 \x01(\v2$.altalune.v1.ImportEmployeesMetadataH\x00R\bmetadata\x12!\n" +
+	"\x05chunk\x18\x02 \x01(\fB\t\xbaH\x06z\x04\x18\x80\x80@H\x00R\x05chunkB\x10\n" +
+	"\apayload\x12\x05\xbaH\x02\b\x01\"^\n" +
+	"\x17ImportEmployeesMetadata\x12*\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\tprojectId\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\"]\n" +
+	"\x17ImportEmployeesRowError\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x14\n" +
+	"\x05field\x18\x02 \x01(\tR\x05field\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xf1\x01\n" +
+	"\x17ImportEmployeesResponse\x12\x1d\n" +
+	"\n" +
+	"total_rows\x18\x01 \x01(\x05R\ttotalRows\x12#\n" +
+	"\rimported_rows\x18\x02 \x01(\x05R\fimportedRows\x12!\n" +
+	"\finvalid_rows\x18\x03 \x01(\x05R\vinvalidRows\x12<\n" +
+	"\x06errors\x18\x04 \x03(\v2$.altalune.v1.ImportEmployeesRowErrorR\x06errors\x12\x17\n" +
+	"\adry_run\x18\x05 \x01(\bR\x06dryRun\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\"\x8b\x01\n" +
+	"\x16ExportEmployeesRequest\x12*\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\tprojectId\x12E\n" +
+	"\x06format\x18\x02 \x01(\x0e2!.altalune.v1.EmployeeExportFormatB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x06format\"n\n" +
+	"\x17ExportEmployeesResponse\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x14\n" +
+	"\x05chunk\x18\x03 \x01(\fR\x05chunk*k\n" +
 	"\x0eEmployeeStatus\x12\x1f\n" +
 	"\x1bEMPLOYEE_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
 	"\x16EMPLOYEE_STATUS_ACTIVE\x10\x01\x12\x1c\n" +
-	"\x18EMPLOYEE_STATUS_INACTIVE\x10\x022\xd9\x03\n" +
-	"\x0fEmployeeService\x12[\n" +
-	"\x0eQueryEmployees\x12\".altalune.v1.QueryEmployeesRequest\x1a#.altalune.v1.QueryEmployeesResponse\"\x00\x12[\n" +
-	"\x0eCreateEmployee\x12\".altalune.v1.CreateEmployeeRequest\x1a#.altalune.v1.CreateEmployeeResponse\"\x00\x12R\n" +
-	"\vGetEmployee\x12\x1f.altalune.v1.GetEmployeeRequest\x1a .altalune.v1.GetEmployeeResponse\"\x00\x12[\n" +
-	"\x0eUpdateEmployee\x12\".altalune.v1.UpdateEmployeeRequest\x1a#.altalune.v1.UpdateEmployeeResponse\"\x00\x12[\n" +
-	"\x0eDeleteEmployee\x12\".altalune.v1.DeleteEmployeeRequest\x1a#.altalune.v1.DeleteEmployeeResponse\"\x00B\xa2\x01\n" +
+	"\x18EMPLOYEE_STATUS_INACTIVE\x10\x02*\x7f\n" +
+	"\x14EmployeeExportFormat\x12&\n" +
+	"\"EMPLOYEE_EXPORT_FORMAT_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aEMPLOYEE_EXPORT_FORMAT_CSV\x10\x01\x12\x1f\n" +
+	"\x1bEMPLOYEE_EXPORT_FORMAT_XLSX\x10\x022\xff\a\n" +
+	"\x0fEmployeeService\x12l\n" +
+	"\x0eQueryEmployees\x12\".altalune.v1.QueryEmployeesRequest\x1a#.altalune.v1.QueryEmployeesResponse\"\x11\x8a\xb5\x18\remployee:read\x12q\n" +
+	"\x0fStreamEmployees\x12#.altalune.v1.StreamEmployeesRequest\x1a$.altalune.v1.StreamEmployeesResponse\"\x11\x8a\xb5\x18\remployee:read0\x01\x12m\n" +
+	"\x0eCreateEmployee\x12\".altalune.v1.CreateEmployeeRequest\x1a#.altalune.v1.CreateEmployeeResponse\"\x12\x8a\xb5\x18\x0eemployee:write\x12c\n" +
+	"\vGetEmployee\x12\x1f.altalune.v1.GetEmployeeRequest\x1a .altalune.v1.GetEmployeeResponse\"\x11\x8a\xb5\x18\remployee:read\x12m\n" +
+	"\x0eUpdateEmployee\x12\".altalune.v1.UpdateEmployeeRequest\x1a#.altalune.v1.UpdateEmployeeResponse\"\x12\x8a\xb5\x18\x0eemployee:write\x12n\n" +
+	"\x0eDeleteEmployee\x12\".altalune.v1.DeleteEmployeeRequest\x1a#.altalune.v1.DeleteEmployeeResponse\"\x13\x8a\xb5\x18\x0femployee:delete\x12q\n" +
+	"\x0fRestoreEmployee\x12#.altalune.v1.RestoreEmployeeRequest\x1a$.altalune.v1.RestoreEmployeeResponse\"\x13\x8a\xb5\x18\x0femployee:delete\x12r\n" +
+	"\x0fImportEmployees\x12#.altalune.v1.ImportEmployeesRequest\x1a$.altalune.v1.ImportEmployeesResponse\"\x12\x8a\xb5\x18\x0eemployee:write(\x01\x12q\n" +
+	"\x0fExportEmployees\x12#.altalune.v1.ExportEmployeesRequest\x1a$.altalune.v1.ExportEmployeesResponse\"\x11\x8a\xb5\x18\remployee:read0\x01B\xa2\x01\n" +
 	"\x0fcom.altalune.v1B\rEmployeeProtoP\x01Z3github.com/hrz8/altalune/gen/altalune/v1;altalunev1\xa2\x02\x03AXX\xaa\x02\vAltalune.V1\xca\x02\vAltalune\\V1\xe2\x02\x17Altalune\\V1\\GPBMetadata\xea\x02\fAltalune::V1b\x06proto3"
 
 var (
@@ -841,52 +1604,83 @@ func file_altalune_v1_employee_proto_rawDescGZIP() []byte {
 	return file_altalune_v1_employee_proto_rawDescData
 }
 
-var file_altalune_v1_employee_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_altalune_v1_employee_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_altalune_v1_employee_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_altalune_v1_employee_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_altalune_v1_employee_proto_goTypes = []any{
-	(EmployeeStatus)(0),            // 0: altalune.v1.EmployeeStatus
-	(*Employee)(nil),               // 1: altalune.v1.Employee
-	(*CreateEmployeeRequest)(nil),  // 2: altalune.v1.CreateEmployeeRequest
-	(*CreateEmployeeResponse)(nil), // 3: altalune.v1.CreateEmployeeResponse
-	(*QueryEmployeesRequest)(nil),  // 4: altalune.v1.QueryEmployeesRequest
-	(*QueryEmployeesResponse)(nil), // 5: altalune.v1.QueryEmployeesResponse
-	(*GetEmployeeRequest)(nil),     // 6: altalune.v1.GetEmployeeRequest
-	(*GetEmployeeResponse)(nil),    // 7: altalune.v1.GetEmployeeResponse
-	(*UpdateEmployeeRequest)(nil),  // 8: altalune.v1.UpdateEmployeeRequest
-	(*UpdateEmployeeResponse)(nil), // 9: altalune.v1.UpdateEmployeeResponse
-	(*DeleteEmployeeRequest)(nil),  // 10: altalune.v1.DeleteEmployeeRequest
-	(*DeleteEmployeeResponse)(nil), // 11: altalune.v1.DeleteEmployeeResponse
-	(*timestamppb.Timestamp)(nil),  // 12: google.protobuf.Timestamp
-	(*QueryRequest)(nil),           // 13: altalune.v1.QueryRequest
-	(*QueryMetaResponse)(nil),      // 14: altalune.v1.QueryMetaResponse
+	(EmployeeStatus)(0),             // 0: altalune.v1.EmployeeStatus
+	(EmployeeExportFormat)(0),       // 1: altalune.v1.EmployeeExportFormat
+	(*Employee)(nil),                // 2: altalune.v1.Employee
+	(*CreateEmployeeRequest)(nil),   // 3: altalune.v1.CreateEmployeeRequest
+	(*CreateEmployeeResponse)(nil),  // 4: altalune.v1.CreateEmployeeResponse
+	(*QueryEmployeesRequest)(nil),   // 5: altalune.v1.QueryEmployeesRequest
+	(*QueryEmployeesResponse)(nil),  // 6: altalune.v1.QueryEmployeesResponse
+	(*StreamEmployeesRequest)(nil),  // 7: altalune.v1.StreamEmployeesRequest
+	(*StreamEmployeesResponse)(nil), // 8: altalune.v1.StreamEmployeesResponse
+	(*GetEmployeeRequest)(nil),      // 9: altalune.v1.GetEmployeeRequest
+	(*GetEmployeeResponse)(nil),     // 10: altalune.v1.GetEmployeeResponse
+	(*UpdateEmployeeRequest)(nil),   // 11: altalune.v1.UpdateEmployeeRequest
+	(*UpdateEmployeeResponse)(nil),  // 12: altalune.v1.UpdateEmployeeResponse
+	(*DeleteEmployeeRequest)(nil),   // 13: altalune.v1.DeleteEmployeeRequest
+	(*DeleteEmployeeResponse)(nil),  // 14: altalune.v1.DeleteEmployeeResponse
+	(*RestoreEmployeeRequest)(nil),  // 15: altalune.v1.RestoreEmployeeRequest
+	(*RestoreEmployeeResponse)(nil), // 16: altalune.v1.RestoreEmployeeResponse
+	(*ImportEmployeesRequest)(nil),  // 17: altalune.v1.ImportEmployeesRequest
+	(*ImportEmployeesMetadata)(nil), // 18: altalune.v1.ImportEmployeesMetadata
+	(*ImportEmployeesRowError)(nil), // 19: altalune.v1.ImportEmployeesRowError
+	(*ImportEmployeesResponse)(nil), // 20: altalune.v1.ImportEmployeesResponse
+	(*ExportEmployeesRequest)(nil),  // 21: altalune.v1.ExportEmployeesRequest
+	(*ExportEmployeesResponse)(nil), // 22: altalune.v1.ExportEmployeesResponse
+	(*timestamppb.Timestamp)(nil),   // 23: google.protobuf.Timestamp
+	(*QueryRequest)(nil),            // 24: altalune.v1.QueryRequest
+	(*fieldmaskpb.FieldMask)(nil),   // 25: google.protobuf.FieldMask
+	(*QueryMetaResponse)(nil),       // 26: altalune.v1.QueryMetaResponse
 }
 var file_altalune_v1_employee_proto_depIdxs = []int32{
 	0,  // 0: altalune.v1.Employee.status:type_name -> altalune.v1.EmployeeStatus
-	12, // 1: altalune.v1.Employee.created_at:type_name -> google.protobuf.Timestamp
-	12, // 2: altalune.v1.Employee.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 3: altalune.v1.CreateEmployeeRequest.status:type_name -> altalune.v1.EmployeeStatus
-	1,  // 4: altalune.v1.CreateEmployeeResponse.employee:type_name -> altalune.v1.Employee
-	13, // 5: altalune.v1.QueryEmployeesRequest.query:type_name -> altalune.v1.QueryRequest
-	1,  // 6: altalune.v1.QueryEmployeesResponse.data:type_name -> altalune.v1.Employee
-	14, // 7: altalune.v1.QueryEmployeesResponse.meta:type_name -> altalune.v1.QueryMetaResponse
-	1,  // 8: altalune.v1.GetEmployeeResponse.employee:type_name -> altalune.v1.Employee
-	0,  // 9: altalune.v1.UpdateEmployeeRequest.status:type_name -> altalune.v1.EmployeeStatus
-	1,  // 10: altalune.v1.UpdateEmployeeResponse.employee:type_name -> altalune.v1.Employee
-	4,  // 11: altalune.v1.EmployeeService.QueryEmployees:input_type -> altalune.v1.QueryEmployeesRequest
-	2,  // 12: altalune.v1.EmployeeService.CreateEmployee:input_type -> altalune.v1.CreateEmployeeRequest
-	6,  // 13: altalune.v1.EmployeeService.GetEmployee:input_type -> altalune.v1.GetEmployeeRequest
-	8,  // 14: altalune.v1.EmployeeService.UpdateEmployee:input_type -> altalune.v1.UpdateEmployeeRequest
-	10, // 15: altalune.v1.EmployeeService.DeleteEmployee:input_type -> altalune.v1.DeleteEmployeeRequest
-	5,  // 16: altalune.v1.EmployeeService.QueryEmployees:output_type -> altalune.v1.QueryEmployeesResponse
-	3,  // 17: altalune.v1.EmployeeService.CreateEmployee:output_type -> altalune.v1.CreateEmployeeResponse
-	7,  // 18: altalune.v1.EmployeeService.GetEmployee:output_type -> altalune.v1.GetEmployeeResponse
-	9,  // 19: altalune.v1.EmployeeService.UpdateEmployee:output_type -> altalune.v1.UpdateEmployeeResponse
-	11, // 20: altalune.v1.EmployeeService.DeleteEmployee:output_type -> altalune.v1.DeleteEmployeeResponse
-	16, // [16:21] is the sub-list for method output_type
-	11, // [11:16] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	23, // 1: altalune.v1.Employee.created_at:type_name -> google.protobuf.Timestamp
+	23, // 2: altalune.v1.Employee.updated_at:type_name -> google.protobuf.Timestamp
+	23, // 3: altalune.v1.Employee.deleted_at:type_name -> google.protobuf.Timestamp
+	0,  // 4: altalune.v1.CreateEmployeeRequest.status:type_name -> altalune.v1.EmployeeStatus
+	2,  // 5: altalune.v1.CreateEmployeeResponse.employee:type_name -> altalune.v1.Employee
+	24, // 6: altalune.v1.QueryEmployeesRequest.query:type_name -> altalune.v1.QueryRequest
+	25, // 7: altalune.v1.QueryEmployeesRequest.read_mask:type_name -> google.protobuf.FieldMask
+	2,  // 8: altalune.v1.QueryEmployeesResponse.data:type_name -> altalune.v1.Employee
+	26, // 9: altalune.v1.QueryEmployeesResponse.meta:type_name -> altalune.v1.QueryMetaResponse
+	24, // 10: altalune.v1.StreamEmployeesRequest.query:type_name -> altalune.v1.QueryRequest
+	25, // 11: altalune.v1.StreamEmployeesRequest.read_mask:type_name -> google.protobuf.FieldMask
+	2,  // 12: altalune.v1.StreamEmployeesResponse.data:type_name -> altalune.v1.Employee
+	26, // 13: altalune.v1.StreamEmployeesResponse.meta:type_name -> altalune.v1.QueryMetaResponse
+	2,  // 14: altalune.v1.GetEmployeeResponse.employee:type_name -> altalune.v1.Employee
+	0,  // 15: altalune.v1.UpdateEmployeeRequest.status:type_name -> altalune.v1.EmployeeStatus
+	23, // 16: altalune.v1.UpdateEmployeeRequest.expected_updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 17: altalune.v1.UpdateEmployeeResponse.employee:type_name -> altalune.v1.Employee
+	2,  // 18: altalune.v1.RestoreEmployeeResponse.employee:type_name -> altalune.v1.Employee
+	18, // 19: altalune.v1.ImportEmployeesRequest.metadata:type_name -> altalune.v1.ImportEmployeesMetadata
+	19, // 20: altalune.v1.ImportEmployeesResponse.errors:type_name -> altalune.v1.ImportEmployeesRowError
+	1,  // 21: altalune.v1.ExportEmployeesRequest.format:type_name -> altalune.v1.EmployeeExportFormat
+	5,  // 22: altalune.v1.EmployeeService.QueryEmployees:input_type -> altalune.v1.QueryEmployeesRequest
+	7,  // 23: altalune.v1.EmployeeService.StreamEmployees:input_type -> altalune.v1.StreamEmployeesRequest
+	3,  // 24: altalune.v1.EmployeeService.CreateEmployee:input_type -> altalune.v1.CreateEmployeeRequest
+	9,  // 25: altalune.v1.EmployeeService.GetEmployee:input_type -> altalune.v1.GetEmployeeRequest
+	11, // 26: altalune.v1.EmployeeService.UpdateEmployee:input_type -> altalune.v1.UpdateEmployeeRequest
+	13, // 27: altalune.v1.EmployeeService.DeleteEmployee:input_type -> altalune.v1.DeleteEmployeeRequest
+	15, // 28: altalune.v1.EmployeeService.RestoreEmployee:input_type -> altalune.v1.RestoreEmployeeRequest
+	17, // 29: altalune.v1.EmployeeService.ImportEmployees:input_type -> altalune.v1.ImportEmployeesRequest
+	21, // 30: altalune.v1.EmployeeService.ExportEmployees:input_type -> altalune.v1.ExportEmployeesRequest
+	6,  // 31: altalune.v1.EmployeeService.QueryEmployees:output_type -> altalune.v1.QueryEmployeesResponse
+	8,  // 32: altalune.v1.EmployeeService.StreamEmployees:output_type -> altalune.v1.StreamEmployeesResponse
+	4,  // 33: altalune.v1.EmployeeService.CreateEmployee:output_type -> altalune.v1.CreateEmployeeResponse
+	10, // 34: altalune.v1.EmployeeService.GetEmployee:output_type -> altalune.v1.GetEmployeeResponse
+	12, // 35: altalune.v1.EmployeeService.UpdateEmployee:output_type -> altalune.v1.UpdateEmployeeResponse
+	14, // 36: altalune.v1.EmployeeService.DeleteEmployee:output_type -> altalune.v1.DeleteEmployeeResponse
+	16, // 37: altalune.v1.EmployeeService.RestoreEmployee:output_type -> altalune.v1.RestoreEmployeeResponse
+	20, // 38: altalune.v1.EmployeeService.ImportEmployees:output_type -> altalune.v1.ImportEmployeesResponse
+	22, // 39: altalune.v1.EmployeeService.ExportEmployees:output_type -> altalune.v1.ExportEmployeesResponse
+	31, // [31:40] is the sub-list for method output_type
+	22, // [22:31] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_altalune_v1_employee_proto_init() }
@@ -895,13 +1689,18 @@ func file_altalune_v1_employee_proto_init() {
 		return
 	}
 	file_altalune_v1_common_proto_init()
+	file_altalune_v1_options_proto_init()
+	file_altalune_v1_employee_proto_msgTypes[15].OneofWrappers = []any{
+		(*ImportEmployeesRequest_Metadata)(nil),
+		(*ImportEmployeesRequest_Chunk)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_altalune_v1_employee_proto_rawDesc), len(file_altalune_v1_employee_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   11,
+			NumEnums:      2,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
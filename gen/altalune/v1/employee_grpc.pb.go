@@ -19,11 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	EmployeeService_QueryEmployees_FullMethodName = "/altalune.v1.EmployeeService/QueryEmployees"
-	EmployeeService_CreateEmployee_FullMethodName = "/altalune.v1.EmployeeService/CreateEmployee"
-	EmployeeService_GetEmployee_FullMethodName    = "/altalune.v1.EmployeeService/GetEmployee"
-	EmployeeService_UpdateEmployee_FullMethodName = "/altalune.v1.EmployeeService/UpdateEmployee"
-	EmployeeService_DeleteEmployee_FullMethodName = "/altalune.v1.EmployeeService/DeleteEmployee"
+	EmployeeService_QueryEmployees_FullMethodName  = "/altalune.v1.EmployeeService/QueryEmployees"
+	EmployeeService_StreamEmployees_FullMethodName = "/altalune.v1.EmployeeService/StreamEmployees"
+	EmployeeService_CreateEmployee_FullMethodName  = "/altalune.v1.EmployeeService/CreateEmployee"
+	EmployeeService_GetEmployee_FullMethodName     = "/altalune.v1.EmployeeService/GetEmployee"
+	EmployeeService_UpdateEmployee_FullMethodName  = "/altalune.v1.EmployeeService/UpdateEmployee"
+	EmployeeService_DeleteEmployee_FullMethodName  = "/altalune.v1.EmployeeService/DeleteEmployee"
+	EmployeeService_RestoreEmployee_FullMethodName = "/altalune.v1.EmployeeService/RestoreEmployee"
+	EmployeeService_ImportEmployees_FullMethodName = "/altalune.v1.EmployeeService/ImportEmployees"
+	EmployeeService_ExportEmployees_FullMethodName = "/altalune.v1.EmployeeService/ExportEmployees"
 )
 
 // EmployeeServiceClient is the client API for EmployeeService service.
@@ -31,10 +35,14 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type EmployeeServiceClient interface {
 	QueryEmployees(ctx context.Context, in *QueryEmployeesRequest, opts ...grpc.CallOption) (*QueryEmployeesResponse, error)
+	StreamEmployees(ctx context.Context, in *StreamEmployeesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamEmployeesResponse], error)
 	CreateEmployee(ctx context.Context, in *CreateEmployeeRequest, opts ...grpc.CallOption) (*CreateEmployeeResponse, error)
 	GetEmployee(ctx context.Context, in *GetEmployeeRequest, opts ...grpc.CallOption) (*GetEmployeeResponse, error)
 	UpdateEmployee(ctx context.Context, in *UpdateEmployeeRequest, opts ...grpc.CallOption) (*UpdateEmployeeResponse, error)
 	DeleteEmployee(ctx context.Context, in *DeleteEmployeeRequest, opts ...grpc.CallOption) (*DeleteEmployeeResponse, error)
+	RestoreEmployee(ctx context.Context, in *RestoreEmployeeRequest, opts ...grpc.CallOption) (*RestoreEmployeeResponse, error)
+	ImportEmployees(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportEmployeesRequest, ImportEmployeesResponse], error)
+	ExportEmployees(ctx context.Context, in *ExportEmployeesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportEmployeesResponse], error)
 }
 
 type employeeServiceClient struct {
@@ -55,6 +63,25 @@ func (c *employeeServiceClient) QueryEmployees(ctx context.Context, in *QueryEmp
 	return out, nil
 }
 
+func (c *employeeServiceClient) StreamEmployees(ctx context.Context, in *StreamEmployeesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamEmployeesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &EmployeeService_ServiceDesc.Streams[0], EmployeeService_StreamEmployees_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamEmployeesRequest, StreamEmployeesResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type EmployeeService_StreamEmployeesClient = grpc.ServerStreamingClient[StreamEmployeesResponse]
+
 func (c *employeeServiceClient) CreateEmployee(ctx context.Context, in *CreateEmployeeRequest, opts ...grpc.CallOption) (*CreateEmployeeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateEmployeeResponse)
@@ -95,15 +122,61 @@ func (c *employeeServiceClient) DeleteEmployee(ctx context.Context, in *DeleteEm
 	return out, nil
 }
 
+func (c *employeeServiceClient) RestoreEmployee(ctx context.Context, in *RestoreEmployeeRequest, opts ...grpc.CallOption) (*RestoreEmployeeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreEmployeeResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_RestoreEmployee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) ImportEmployees(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportEmployeesRequest, ImportEmployeesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &EmployeeService_ServiceDesc.Streams[1], EmployeeService_ImportEmployees_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportEmployeesRequest, ImportEmployeesResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type EmployeeService_ImportEmployeesClient = grpc.ClientStreamingClient[ImportEmployeesRequest, ImportEmployeesResponse]
+
+func (c *employeeServiceClient) ExportEmployees(ctx context.Context, in *ExportEmployeesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportEmployeesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &EmployeeService_ServiceDesc.Streams[2], EmployeeService_ExportEmployees_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportEmployeesRequest, ExportEmployeesResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type EmployeeService_ExportEmployeesClient = grpc.ServerStreamingClient[ExportEmployeesResponse]
+
 // EmployeeServiceServer is the server API for EmployeeService service.
 // All implementations must embed UnimplementedEmployeeServiceServer
 // for forward compatibility.
 type EmployeeServiceServer interface {
 	QueryEmployees(context.Context, *QueryEmployeesRequest) (*QueryEmployeesResponse, error)
+	StreamEmployees(*StreamEmployeesRequest, grpc.ServerStreamingServer[StreamEmployeesResponse]) error
 	CreateEmployee(context.Context, *CreateEmployeeRequest) (*CreateEmployeeResponse, error)
 	GetEmployee(context.Context, *GetEmployeeRequest) (*GetEmployeeResponse, error)
 	UpdateEmployee(context.Context, *UpdateEmployeeRequest) (*UpdateEmployeeResponse, error)
 	DeleteEmployee(context.Context, *DeleteEmployeeRequest) (*DeleteEmployeeResponse, error)
+	RestoreEmployee(context.Context, *RestoreEmployeeRequest) (*RestoreEmployeeResponse, error)
+	ImportEmployees(grpc.ClientStreamingServer[ImportEmployeesRequest, ImportEmployeesResponse]) error
+	ExportEmployees(*ExportEmployeesRequest, grpc.ServerStreamingServer[ExportEmployeesResponse]) error
 	mustEmbedUnimplementedEmployeeServiceServer()
 }
 
@@ -117,6 +190,9 @@ type UnimplementedEmployeeServiceServer struct{}
 func (UnimplementedEmployeeServiceServer) QueryEmployees(context.Context, *QueryEmployeesRequest) (*QueryEmployeesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryEmployees not implemented")
 }
+func (UnimplementedEmployeeServiceServer) StreamEmployees(*StreamEmployeesRequest, grpc.ServerStreamingServer[StreamEmployeesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamEmployees not implemented")
+}
 func (UnimplementedEmployeeServiceServer) CreateEmployee(context.Context, *CreateEmployeeRequest) (*CreateEmployeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateEmployee not implemented")
 }
@@ -129,6 +205,15 @@ func (UnimplementedEmployeeServiceServer) UpdateEmployee(context.Context, *Updat
 func (UnimplementedEmployeeServiceServer) DeleteEmployee(context.Context, *DeleteEmployeeRequest) (*DeleteEmployeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteEmployee not implemented")
 }
+func (UnimplementedEmployeeServiceServer) RestoreEmployee(context.Context, *RestoreEmployeeRequest) (*RestoreEmployeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreEmployee not implemented")
+}
+func (UnimplementedEmployeeServiceServer) ImportEmployees(grpc.ClientStreamingServer[ImportEmployeesRequest, ImportEmployeesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportEmployees not implemented")
+}
+func (UnimplementedEmployeeServiceServer) ExportEmployees(*ExportEmployeesRequest, grpc.ServerStreamingServer[ExportEmployeesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportEmployees not implemented")
+}
 func (UnimplementedEmployeeServiceServer) mustEmbedUnimplementedEmployeeServiceServer() {}
 func (UnimplementedEmployeeServiceServer) testEmbeddedByValue()                         {}
 
@@ -168,6 +253,17 @@ func _EmployeeService_QueryEmployees_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EmployeeService_StreamEmployees_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamEmployeesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EmployeeServiceServer).StreamEmployees(m, &grpc.GenericServerStream[StreamEmployeesRequest, StreamEmployeesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type EmployeeService_StreamEmployeesServer = grpc.ServerStreamingServer[StreamEmployeesResponse]
+
 func _EmployeeService_CreateEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateEmployeeRequest)
 	if err := dec(in); err != nil {
@@ -240,6 +336,42 @@ func _EmployeeService_DeleteEmployee_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EmployeeService_RestoreEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreEmployeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).RestoreEmployee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_RestoreEmployee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).RestoreEmployee(ctx, req.(*RestoreEmployeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_ImportEmployees_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(EmployeeServiceServer).ImportEmployees(&grpc.GenericServerStream[ImportEmployeesRequest, ImportEmployeesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type EmployeeService_ImportEmployeesServer = grpc.ClientStreamingServer[ImportEmployeesRequest, ImportEmployeesResponse]
+
+func _EmployeeService_ExportEmployees_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportEmployeesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EmployeeServiceServer).ExportEmployees(m, &grpc.GenericServerStream[ExportEmployeesRequest, ExportEmployeesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type EmployeeService_ExportEmployeesServer = grpc.ServerStreamingServer[ExportEmployeesResponse]
+
 // EmployeeService_ServiceDesc is the grpc.ServiceDesc for EmployeeService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -267,7 +399,27 @@ var EmployeeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteEmployee",
 			Handler:    _EmployeeService_DeleteEmployee_Handler,
 		},
+		{
+			MethodName: "RestoreEmployee",
+			Handler:    _EmployeeService_RestoreEmployee_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamEmployees",
+			Handler:       _EmployeeService_StreamEmployees_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportEmployees",
+			Handler:       _EmployeeService_ImportEmployees_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportEmployees",
+			Handler:       _EmployeeService_ExportEmployees_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "altalune/v1/employee.proto",
 }
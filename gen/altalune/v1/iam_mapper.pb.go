@@ -24,6 +24,64 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type OwnershipTransferStatus int32
+
+const (
+	OwnershipTransferStatus_OWNERSHIP_TRANSFER_STATUS_UNSPECIFIED OwnershipTransferStatus = 0
+	OwnershipTransferStatus_OWNERSHIP_TRANSFER_STATUS_PENDING     OwnershipTransferStatus = 1 // Awaiting the target member
+	OwnershipTransferStatus_OWNERSHIP_TRANSFER_STATUS_ACCEPTED    OwnershipTransferStatus = 2 // The target member became owner
+	OwnershipTransferStatus_OWNERSHIP_TRANSFER_STATUS_CANCELLED   OwnershipTransferStatus = 3 // Withdrawn by the owner, or replaced by a newer transfer
+	OwnershipTransferStatus_OWNERSHIP_TRANSFER_STATUS_DECLINED    OwnershipTransferStatus = 4 // Refused by the target member
+	OwnershipTransferStatus_OWNERSHIP_TRANSFER_STATUS_EXPIRED     OwnershipTransferStatus = 5 // Not accepted in time
+)
+
+// Enum value maps for OwnershipTransferStatus.
+var (
+	OwnershipTransferStatus_name = map[int32]string{
+		0: "OWNERSHIP_TRANSFER_STATUS_UNSPECIFIED",
+		1: "OWNERSHIP_TRANSFER_STATUS_PENDING",
+		2: "OWNERSHIP_TRANSFER_STATUS_ACCEPTED",
+		3: "OWNERSHIP_TRANSFER_STATUS_CANCELLED",
+		4: "OWNERSHIP_TRANSFER_STATUS_DECLINED",
+		5: "OWNERSHIP_TRANSFER_STATUS_EXPIRED",
+	}
+	OwnershipTransferStatus_value = map[string]int32{
+		"OWNERSHIP_TRANSFER_STATUS_UNSPECIFIED": 0,
+		"OWNERSHIP_TRANSFER_STATUS_PENDING":     1,
+		"OWNERSHIP_TRANSFER_STATUS_ACCEPTED":    2,
+		"OWNERSHIP_TRANSFER_STATUS_CANCELLED":   3,
+		"OWNERSHIP_TRANSFER_STATUS_DECLINED":    4,
+		"OWNERSHIP_TRANSFER_STATUS_EXPIRED":     5,
+	}
+)
+
+func (x OwnershipTransferStatus) Enum() *OwnershipTransferStatus {
+	p := new(OwnershipTransferStatus)
+	*p = x
+	return p
+}
+
+func (x OwnershipTransferStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OwnershipTransferStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_altalune_v1_iam_mapper_proto_enumTypes[0].Descriptor()
+}
+
+func (OwnershipTransferStatus) Type() protoreflect.EnumType {
+	return &file_altalune_v1_iam_mapper_proto_enumTypes[0]
+}
+
+func (x OwnershipTransferStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OwnershipTransferStatus.Descriptor instead.
+func (OwnershipTransferStatus) EnumDescriptor() ([]byte, []int) {
+	return file_altalune_v1_iam_mapper_proto_rawDescGZIP(), []int{0}
+}
+
 // AssignUserRolesRequest for assigning roles to a user
 type AssignUserRolesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1081,11 +1139,661 @@ func (x *GetUserProjectsResponse) GetProjects() []*UserProjectMembership {
 	return nil
 }
 
+// Request of a project owner to hand the owner role over to another member.
+// Accepting it swaps the roles of both: the target member becomes owner and
+// the initiator takes the role the target member held.
+type ProjectOwnershipTransfer struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProjectId     string                  `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	ProjectName   string                  `protobuf:"bytes,3,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	FromUserId    string                  `protobuf:"bytes,4,opt,name=from_user_id,json=fromUserId,proto3" json:"from_user_id,omitempty"` // Public ID of the owner who initiated it
+	FromEmail     string                  `protobuf:"bytes,5,opt,name=from_email,json=fromEmail,proto3" json:"from_email,omitempty"`
+	ToUserId      string                  `protobuf:"bytes,6,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"` // Public ID of the member receiving ownership
+	ToEmail       string                  `protobuf:"bytes,7,opt,name=to_email,json=toEmail,proto3" json:"to_email,omitempty"`
+	Status        OwnershipTransferStatus `protobuf:"varint,8,opt,name=status,proto3,enum=altalune.v1.OwnershipTransferStatus" json:"status,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp  `protobuf:"bytes,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp  `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ResolvedAt    *timestamppb.Timestamp  `protobuf:"bytes,11,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"` // Unset while pending
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectOwnershipTransfer) Reset() {
+	*x = ProjectOwnershipTransfer{}
+	mi := &file_altalune_v1_iam_mapper_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectOwnershipTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectOwnershipTransfer) ProtoMessage() {}
+
+func (x *ProjectOwnershipTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_iam_mapper_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectOwnershipTransfer.ProtoReflect.Descriptor instead.
+func (*ProjectOwnershipTransfer) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_iam_mapper_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ProjectOwnershipTransfer) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProjectOwnershipTransfer) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ProjectOwnershipTransfer) GetProjectName() string {
+	if x != nil {
+		return x.ProjectName
+	}
+	return ""
+}
+
+func (x *ProjectOwnershipTransfer) GetFromUserId() string {
+	if x != nil {
+		return x.FromUserId
+	}
+	return ""
+}
+
+func (x *ProjectOwnershipTransfer) GetFromEmail() string {
+	if x != nil {
+		return x.FromEmail
+	}
+	return ""
+}
+
+func (x *ProjectOwnershipTransfer) GetToUserId() string {
+	if x != nil {
+		return x.ToUserId
+	}
+	return ""
+}
+
+func (x *ProjectOwnershipTransfer) GetToEmail() string {
+	if x != nil {
+		return x.ToEmail
+	}
+	return ""
+}
+
+func (x *ProjectOwnershipTransfer) GetStatus() OwnershipTransferStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OwnershipTransferStatus_OWNERSHIP_TRANSFER_STATUS_UNSPECIFIED
+}
+
+func (x *ProjectOwnershipTransfer) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *ProjectOwnershipTransfer) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ProjectOwnershipTransfer) GetResolvedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return nil
+}
+
+// InitiateProjectOwnershipTransferRequest for offering the ownership of a
+// project to one of its members. It replaces any pending transfer.
+type InitiateProjectOwnershipTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InitiateProjectOwnershipTransferRequest) Reset() {
+	*x = InitiateProjectOwnershipTransferRequest{}
+	mi := &file_altalune_v1_iam_mapper_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InitiateProjectOwnershipTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitiateProjectOwnershipTransferRequest) ProtoMessage() {}
+
+func (x *InitiateProjectOwnershipTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_iam_mapper_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitiateProjectOwnershipTransferRequest.ProtoReflect.Descriptor instead.
+func (*InitiateProjectOwnershipTransferRequest) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_iam_mapper_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *InitiateProjectOwnershipTransferRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *InitiateProjectOwnershipTransferRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type InitiateProjectOwnershipTransferResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Transfer      *ProjectOwnershipTransfer `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InitiateProjectOwnershipTransferResponse) Reset() {
+	*x = InitiateProjectOwnershipTransferResponse{}
+	mi := &file_altalune_v1_iam_mapper_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InitiateProjectOwnershipTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitiateProjectOwnershipTransferResponse) ProtoMessage() {}
+
+func (x *InitiateProjectOwnershipTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_iam_mapper_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitiateProjectOwnershipTransferResponse.ProtoReflect.Descriptor instead.
+func (*InitiateProjectOwnershipTransferResponse) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_iam_mapper_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *InitiateProjectOwnershipTransferResponse) GetTransfer() *ProjectOwnershipTransfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+// GetProjectOwnershipTransferRequest for retrieving the pending transfer of a project
+type GetProjectOwnershipTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectOwnershipTransferRequest) Reset() {
+	*x = GetProjectOwnershipTransferRequest{}
+	mi := &file_altalune_v1_iam_mapper_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectOwnershipTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectOwnershipTransferRequest) ProtoMessage() {}
+
+func (x *GetProjectOwnershipTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_iam_mapper_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectOwnershipTransferRequest.ProtoReflect.Descriptor instead.
+func (*GetProjectOwnershipTransferRequest) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_iam_mapper_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetProjectOwnershipTransferRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type GetProjectOwnershipTransferResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Transfer      *ProjectOwnershipTransfer `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"` // Unset when none is pending
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectOwnershipTransferResponse) Reset() {
+	*x = GetProjectOwnershipTransferResponse{}
+	mi := &file_altalune_v1_iam_mapper_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectOwnershipTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectOwnershipTransferResponse) ProtoMessage() {}
+
+func (x *GetProjectOwnershipTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_iam_mapper_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectOwnershipTransferResponse.ProtoReflect.Descriptor instead.
+func (*GetProjectOwnershipTransferResponse) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_iam_mapper_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetProjectOwnershipTransferResponse) GetTransfer() *ProjectOwnershipTransfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+// AcceptProjectOwnershipTransferRequest for the target member taking ownership
+type AcceptProjectOwnershipTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransferId    string                 `protobuf:"bytes,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptProjectOwnershipTransferRequest) Reset() {
+	*x = AcceptProjectOwnershipTransferRequest{}
+	mi := &file_altalune_v1_iam_mapper_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptProjectOwnershipTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptProjectOwnershipTransferRequest) ProtoMessage() {}
+
+func (x *AcceptProjectOwnershipTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_iam_mapper_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptProjectOwnershipTransferRequest.ProtoReflect.Descriptor instead.
+func (*AcceptProjectOwnershipTransferRequest) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_iam_mapper_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AcceptProjectOwnershipTransferRequest) GetTransferId() string {
+	if x != nil {
+		return x.TransferId
+	}
+	return ""
+}
+
+type AcceptProjectOwnershipTransferResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Transfer      *ProjectOwnershipTransfer `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptProjectOwnershipTransferResponse) Reset() {
+	*x = AcceptProjectOwnershipTransferResponse{}
+	mi := &file_altalune_v1_iam_mapper_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptProjectOwnershipTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptProjectOwnershipTransferResponse) ProtoMessage() {}
+
+func (x *AcceptProjectOwnershipTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_iam_mapper_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptProjectOwnershipTransferResponse.ProtoReflect.Descriptor instead.
+func (*AcceptProjectOwnershipTransferResponse) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_iam_mapper_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AcceptProjectOwnershipTransferResponse) GetTransfer() *ProjectOwnershipTransfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+// CancelProjectOwnershipTransferRequest for the initiator withdrawing a
+// transfer, or the target member declining it
+type CancelProjectOwnershipTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransferId    string                 `protobuf:"bytes,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelProjectOwnershipTransferRequest) Reset() {
+	*x = CancelProjectOwnershipTransferRequest{}
+	mi := &file_altalune_v1_iam_mapper_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelProjectOwnershipTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelProjectOwnershipTransferRequest) ProtoMessage() {}
+
+func (x *CancelProjectOwnershipTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_iam_mapper_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelProjectOwnershipTransferRequest.ProtoReflect.Descriptor instead.
+func (*CancelProjectOwnershipTransferRequest) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_iam_mapper_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CancelProjectOwnershipTransferRequest) GetTransferId() string {
+	if x != nil {
+		return x.TransferId
+	}
+	return ""
+}
+
+// DumpProjectIAMRequest for exporting the IAM configuration of a project
+type DumpProjectIAMRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DumpProjectIAMRequest) Reset() {
+	*x = DumpProjectIAMRequest{}
+	mi := &file_altalune_v1_iam_mapper_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DumpProjectIAMRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DumpProjectIAMRequest) ProtoMessage() {}
+
+func (x *DumpProjectIAMRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_iam_mapper_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DumpProjectIAMRequest.ProtoReflect.Descriptor instead.
+func (*DumpProjectIAMRequest) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_iam_mapper_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DumpProjectIAMRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+// DumpProjectIAMResponse with the IAM configuration as a YAML snapshot
+type DumpProjectIAMResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Members with their project role, the roles and direct permissions they
+	// hold and the permissions of those roles, sorted so equal configurations
+	// dump identically
+	Snapshot      string `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DumpProjectIAMResponse) Reset() {
+	*x = DumpProjectIAMResponse{}
+	mi := &file_altalune_v1_iam_mapper_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DumpProjectIAMResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DumpProjectIAMResponse) ProtoMessage() {}
+
+func (x *DumpProjectIAMResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_iam_mapper_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DumpProjectIAMResponse.ProtoReflect.Descriptor instead.
+func (*DumpProjectIAMResponse) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_iam_mapper_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DumpProjectIAMResponse) GetSnapshot() string {
+	if x != nil {
+		return x.Snapshot
+	}
+	return ""
+}
+
+// ApplyProjectIAMRequest for reconciling a project to a YAML snapshot
+type ApplyProjectIAMRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// Snapshot as returned by DumpProjectIAM, possibly of another project
+	Snapshot string `protobuf:"bytes,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	// Only report the changes that would be made
+	DryRun        bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyProjectIAMRequest) Reset() {
+	*x = ApplyProjectIAMRequest{}
+	mi := &file_altalune_v1_iam_mapper_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyProjectIAMRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyProjectIAMRequest) ProtoMessage() {}
+
+func (x *ApplyProjectIAMRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_iam_mapper_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyProjectIAMRequest.ProtoReflect.Descriptor instead.
+func (*ApplyProjectIAMRequest) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_iam_mapper_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ApplyProjectIAMRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ApplyProjectIAMRequest) GetSnapshot() string {
+	if x != nil {
+		return x.Snapshot
+	}
+	return ""
+}
+
+func (x *ApplyProjectIAMRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// ApplyProjectIAMResponse with the changes made, or that would be made
+type ApplyProjectIAMResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []string               `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyProjectIAMResponse) Reset() {
+	*x = ApplyProjectIAMResponse{}
+	mi := &file_altalune_v1_iam_mapper_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyProjectIAMResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyProjectIAMResponse) ProtoMessage() {}
+
+func (x *ApplyProjectIAMResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_iam_mapper_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyProjectIAMResponse.ProtoReflect.Descriptor instead.
+func (*ApplyProjectIAMResponse) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_iam_mapper_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ApplyProjectIAMResponse) GetChanges() []string {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
 var File_altalune_v1_iam_mapper_proto protoreflect.FileDescriptor
 
 const file_altalune_v1_iam_mapper_proto_rawDesc = "" +
 	"\n" +
-	"\x1caltalune/v1/iam_mapper.proto\x12\valtalune.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\x1a\x16altalune/v1/user.proto\x1a\x16altalune/v1/role.proto\x1a\x1caltalune/v1/permission.proto\"d\n" +
+	"\x1caltalune/v1/iam_mapper.proto\x12\valtalune.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\x1a\x16altalune/v1/user.proto\x1a\x16altalune/v1/role.proto\x1a\x1caltalune/v1/permission.proto\x1a\x19altalune/v1/options.proto\"d\n" +
 	"\x16AssignUserRolesRequest\x12%\n" +
 	"\auser_id\x18\x01 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x0e\x18\x14R\x06userId\x12#\n" +
 	"\brole_ids\x18\x02 \x03(\tB\b\xbaH\x05\x92\x01\x02\b\x01R\aroleIds\"d\n" +
@@ -1146,21 +1854,85 @@ const file_altalune_v1_iam_mapper_proto_rawDesc = "" +
 	"\x04role\x18\x03 \x01(\tR\x04role\x127\n" +
 	"\tjoined_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bjoinedAt\"Y\n" +
 	"\x17GetUserProjectsResponse\x12>\n" +
-	"\bprojects\x18\x01 \x03(\v2\".altalune.v1.UserProjectMembershipR\bprojects2\xd5\t\n" +
-	"\x10IAMMapperService\x12P\n" +
-	"\x0fAssignUserRoles\x12#.altalune.v1.AssignUserRolesRequest\x1a\x16.google.protobuf.Empty\"\x00\x12P\n" +
-	"\x0fRemoveUserRoles\x12#.altalune.v1.RemoveUserRolesRequest\x1a\x16.google.protobuf.Empty\"\x00\x12U\n" +
-	"\fGetUserRoles\x12 .altalune.v1.GetUserRolesRequest\x1a!.altalune.v1.GetUserRolesResponse\"\x00\x12\\\n" +
-	"\x15AssignRolePermissions\x12).altalune.v1.AssignRolePermissionsRequest\x1a\x16.google.protobuf.Empty\"\x00\x12\\\n" +
-	"\x15RemoveRolePermissions\x12).altalune.v1.RemoveRolePermissionsRequest\x1a\x16.google.protobuf.Empty\"\x00\x12g\n" +
-	"\x12GetRolePermissions\x12&.altalune.v1.GetRolePermissionsRequest\x1a'.altalune.v1.GetRolePermissionsResponse\"\x00\x12\\\n" +
-	"\x15AssignUserPermissions\x12).altalune.v1.AssignUserPermissionsRequest\x1a\x16.google.protobuf.Empty\"\x00\x12\\\n" +
-	"\x15RemoveUserPermissions\x12).altalune.v1.RemoveUserPermissionsRequest\x1a\x16.google.protobuf.Empty\"\x00\x12g\n" +
-	"\x12GetUserPermissions\x12&.altalune.v1.GetUserPermissionsRequest\x1a'.altalune.v1.GetUserPermissionsResponse\"\x00\x12Z\n" +
-	"\x14AssignProjectMembers\x12(.altalune.v1.AssignProjectMembersRequest\x1a\x16.google.protobuf.Empty\"\x00\x12Z\n" +
-	"\x14RemoveProjectMembers\x12(.altalune.v1.RemoveProjectMembersRequest\x1a\x16.google.protobuf.Empty\"\x00\x12d\n" +
-	"\x11GetProjectMembers\x12%.altalune.v1.GetProjectMembersRequest\x1a&.altalune.v1.GetProjectMembersResponse\"\x00\x12^\n" +
-	"\x0fGetUserProjects\x12#.altalune.v1.GetUserProjectsRequest\x1a$.altalune.v1.GetUserProjectsResponse\"\x00B\xa3\x01\n" +
+	"\bprojects\x18\x01 \x03(\v2\".altalune.v1.UserProjectMembershipR\bprojects\"\xd7\x03\n" +
+	"\x18ProjectOwnershipTransfer\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\x12!\n" +
+	"\fproject_name\x18\x03 \x01(\tR\vprojectName\x12 \n" +
+	"\ffrom_user_id\x18\x04 \x01(\tR\n" +
+	"fromUserId\x12\x1d\n" +
+	"\n" +
+	"from_email\x18\x05 \x01(\tR\tfromEmail\x12\x1c\n" +
+	"\n" +
+	"to_user_id\x18\x06 \x01(\tR\btoUserId\x12\x19\n" +
+	"\bto_email\x18\a \x01(\tR\atoEmail\x12<\n" +
+	"\x06status\x18\b \x01(\x0e2$.altalune.v1.OwnershipTransferStatusR\x06status\x129\n" +
+	"\n" +
+	"expires_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12;\n" +
+	"\vresolved_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"resolvedAt\"}\n" +
+	"'InitiateProjectOwnershipTransferRequest\x12+\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x0e\x18\x14R\tprojectId\x12%\n" +
+	"\auser_id\x18\x02 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x0e\x18\x14R\x06userId\"m\n" +
+	"(InitiateProjectOwnershipTransferResponse\x12A\n" +
+	"\btransfer\x18\x01 \x01(\v2%.altalune.v1.ProjectOwnershipTransferR\btransfer\"Q\n" +
+	"\"GetProjectOwnershipTransferRequest\x12+\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x0e\x18\x14R\tprojectId\"h\n" +
+	"#GetProjectOwnershipTransferResponse\x12A\n" +
+	"\btransfer\x18\x01 \x01(\v2%.altalune.v1.ProjectOwnershipTransferR\btransfer\"V\n" +
+	"%AcceptProjectOwnershipTransferRequest\x12-\n" +
+	"\vtransfer_id\x18\x01 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x0e\x18\x14R\n" +
+	"transferId\"k\n" +
+	"&AcceptProjectOwnershipTransferResponse\x12A\n" +
+	"\btransfer\x18\x01 \x01(\v2%.altalune.v1.ProjectOwnershipTransferR\btransfer\"V\n" +
+	"%CancelProjectOwnershipTransferRequest\x12-\n" +
+	"\vtransfer_id\x18\x01 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x0e\x18\x14R\n" +
+	"transferId\"D\n" +
+	"\x15DumpProjectIAMRequest\x12+\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x0e\x18\x14R\tprojectId\"4\n" +
+	"\x16DumpProjectIAMResponse\x12\x1a\n" +
+	"\bsnapshot\x18\x01 \x01(\tR\bsnapshot\"\x88\x01\n" +
+	"\x16ApplyProjectIAMRequest\x12+\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x0e\x18\x14R\tprojectId\x12(\n" +
+	"\bsnapshot\x18\x02 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x18\x80\x80@R\bsnapshot\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\"3\n" +
+	"\x17ApplyProjectIAMResponse\x12\x18\n" +
+	"\achanges\x18\x01 \x03(\tR\achanges*\x8b\x02\n" +
+	"\x17OwnershipTransferStatus\x12)\n" +
+	"%OWNERSHIP_TRANSFER_STATUS_UNSPECIFIED\x10\x00\x12%\n" +
+	"!OWNERSHIP_TRANSFER_STATUS_PENDING\x10\x01\x12&\n" +
+	"\"OWNERSHIP_TRANSFER_STATUS_ACCEPTED\x10\x02\x12'\n" +
+	"#OWNERSHIP_TRANSFER_STATUS_CANCELLED\x10\x03\x12&\n" +
+	"\"OWNERSHIP_TRANSFER_STATUS_DECLINED\x10\x04\x12%\n" +
+	"!OWNERSHIP_TRANSFER_STATUS_EXPIRED\x10\x052\x82\x11\n" +
+	"\x10IAMMapperService\x12]\n" +
+	"\x0fAssignUserRoles\x12#.altalune.v1.AssignUserRolesRequest\x1a\x16.google.protobuf.Empty\"\r\x8a\xb5\x18\tiam:write\x12]\n" +
+	"\x0fRemoveUserRoles\x12#.altalune.v1.RemoveUserRolesRequest\x1a\x16.google.protobuf.Empty\"\r\x8a\xb5\x18\tiam:write\x12a\n" +
+	"\fGetUserRoles\x12 .altalune.v1.GetUserRolesRequest\x1a!.altalune.v1.GetUserRolesResponse\"\f\x8a\xb5\x18\biam:read\x12i\n" +
+	"\x15AssignRolePermissions\x12).altalune.v1.AssignRolePermissionsRequest\x1a\x16.google.protobuf.Empty\"\r\x8a\xb5\x18\tiam:write\x12i\n" +
+	"\x15RemoveRolePermissions\x12).altalune.v1.RemoveRolePermissionsRequest\x1a\x16.google.protobuf.Empty\"\r\x8a\xb5\x18\tiam:write\x12s\n" +
+	"\x12GetRolePermissions\x12&.altalune.v1.GetRolePermissionsRequest\x1a'.altalune.v1.GetRolePermissionsResponse\"\f\x8a\xb5\x18\biam:read\x12i\n" +
+	"\x15AssignUserPermissions\x12).altalune.v1.AssignUserPermissionsRequest\x1a\x16.google.protobuf.Empty\"\r\x8a\xb5\x18\tiam:write\x12i\n" +
+	"\x15RemoveUserPermissions\x12).altalune.v1.RemoveUserPermissionsRequest\x1a\x16.google.protobuf.Empty\"\r\x8a\xb5\x18\tiam:write\x12s\n" +
+	"\x12GetUserPermissions\x12&.altalune.v1.GetUserPermissionsRequest\x1a'.altalune.v1.GetUserPermissionsResponse\"\f\x8a\xb5\x18\biam:read\x12j\n" +
+	"\x14AssignProjectMembers\x12(.altalune.v1.AssignProjectMembersRequest\x1a\x16.google.protobuf.Empty\"\x10\x8a\xb5\x18\fmember:write\x12j\n" +
+	"\x14RemoveProjectMembers\x12(.altalune.v1.RemoveProjectMembersRequest\x1a\x16.google.protobuf.Empty\"\x10\x8a\xb5\x18\fmember:write\x12s\n" +
+	"\x11GetProjectMembers\x12%.altalune.v1.GetProjectMembersRequest\x1a&.altalune.v1.GetProjectMembersResponse\"\x0f\x8a\xb5\x18\vmember:read\x12\xa1\x01\n" +
+	" InitiateProjectOwnershipTransfer\x124.altalune.v1.InitiateProjectOwnershipTransferRequest\x1a5.altalune.v1.InitiateProjectOwnershipTransferResponse\"\x10\x8a\xb5\x18\fmember:write\x12\x91\x01\n" +
+	"\x1bGetProjectOwnershipTransfer\x12/.altalune.v1.GetProjectOwnershipTransferRequest\x1a0.altalune.v1.GetProjectOwnershipTransferResponse\"\x0f\x8a\xb5\x18\vmember:read\x12\x8b\x01\n" +
+	"\x1eAcceptProjectOwnershipTransfer\x122.altalune.v1.AcceptProjectOwnershipTransferRequest\x1a3.altalune.v1.AcceptProjectOwnershipTransferResponse\"\x00\x12n\n" +
+	"\x1eCancelProjectOwnershipTransfer\x122.altalune.v1.CancelProjectOwnershipTransferRequest\x1a\x16.google.protobuf.Empty\"\x00\x12^\n" +
+	"\x0fGetUserProjects\x12#.altalune.v1.GetUserProjectsRequest\x1a$.altalune.v1.GetUserProjectsResponse\"\x00\x12g\n" +
+	"\x0eDumpProjectIAM\x12\".altalune.v1.DumpProjectIAMRequest\x1a#.altalune.v1.DumpProjectIAMResponse\"\f\x8a\xb5\x18\biam:read\x12k\n" +
+	"\x0fApplyProjectIAM\x12#.altalune.v1.ApplyProjectIAMRequest\x1a$.altalune.v1.ApplyProjectIAMResponse\"\r\x8a\xb5\x18\tiam:writeB\xa3\x01\n" +
 	"\x0fcom.altalune.v1B\x0eIamMapperProtoP\x01Z3github.com/hrz8/altalune/gen/altalune/v1;altalunev1\xa2\x02\x03AXX\xaa\x02\vAltalune.V1\xca\x02\vAltalune\\V1\xe2\x02\x17Altalune\\V1\\GPBMetadata\xea\x02\fAltalune::V1b\x06proto3"
 
 var (
@@ -1175,76 +1947,109 @@ func file_altalune_v1_iam_mapper_proto_rawDescGZIP() []byte {
 	return file_altalune_v1_iam_mapper_proto_rawDescData
 }
 
-var file_altalune_v1_iam_mapper_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_altalune_v1_iam_mapper_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_altalune_v1_iam_mapper_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_altalune_v1_iam_mapper_proto_goTypes = []any{
-	(*AssignUserRolesRequest)(nil),       // 0: altalune.v1.AssignUserRolesRequest
-	(*RemoveUserRolesRequest)(nil),       // 1: altalune.v1.RemoveUserRolesRequest
-	(*GetUserRolesRequest)(nil),          // 2: altalune.v1.GetUserRolesRequest
-	(*GetUserRolesResponse)(nil),         // 3: altalune.v1.GetUserRolesResponse
-	(*AssignRolePermissionsRequest)(nil), // 4: altalune.v1.AssignRolePermissionsRequest
-	(*RemoveRolePermissionsRequest)(nil), // 5: altalune.v1.RemoveRolePermissionsRequest
-	(*GetRolePermissionsRequest)(nil),    // 6: altalune.v1.GetRolePermissionsRequest
-	(*GetRolePermissionsResponse)(nil),   // 7: altalune.v1.GetRolePermissionsResponse
-	(*AssignUserPermissionsRequest)(nil), // 8: altalune.v1.AssignUserPermissionsRequest
-	(*RemoveUserPermissionsRequest)(nil), // 9: altalune.v1.RemoveUserPermissionsRequest
-	(*GetUserPermissionsRequest)(nil),    // 10: altalune.v1.GetUserPermissionsRequest
-	(*GetUserPermissionsResponse)(nil),   // 11: altalune.v1.GetUserPermissionsResponse
-	(*ProjectMember)(nil),                // 12: altalune.v1.ProjectMember
-	(*AssignProjectMembersRequest)(nil),  // 13: altalune.v1.AssignProjectMembersRequest
-	(*RemoveProjectMembersRequest)(nil),  // 14: altalune.v1.RemoveProjectMembersRequest
-	(*GetProjectMembersRequest)(nil),     // 15: altalune.v1.GetProjectMembersRequest
-	(*ProjectMemberWithUser)(nil),        // 16: altalune.v1.ProjectMemberWithUser
-	(*GetProjectMembersResponse)(nil),    // 17: altalune.v1.GetProjectMembersResponse
-	(*GetUserProjectsRequest)(nil),       // 18: altalune.v1.GetUserProjectsRequest
-	(*UserProjectMembership)(nil),        // 19: altalune.v1.UserProjectMembership
-	(*GetUserProjectsResponse)(nil),      // 20: altalune.v1.GetUserProjectsResponse
-	(*Role)(nil),                         // 21: altalune.v1.Role
-	(*Permission)(nil),                   // 22: altalune.v1.Permission
-	(*User)(nil),                         // 23: altalune.v1.User
-	(*timestamppb.Timestamp)(nil),        // 24: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                // 25: google.protobuf.Empty
+	(OwnershipTransferStatus)(0),                     // 0: altalune.v1.OwnershipTransferStatus
+	(*AssignUserRolesRequest)(nil),                   // 1: altalune.v1.AssignUserRolesRequest
+	(*RemoveUserRolesRequest)(nil),                   // 2: altalune.v1.RemoveUserRolesRequest
+	(*GetUserRolesRequest)(nil),                      // 3: altalune.v1.GetUserRolesRequest
+	(*GetUserRolesResponse)(nil),                     // 4: altalune.v1.GetUserRolesResponse
+	(*AssignRolePermissionsRequest)(nil),             // 5: altalune.v1.AssignRolePermissionsRequest
+	(*RemoveRolePermissionsRequest)(nil),             // 6: altalune.v1.RemoveRolePermissionsRequest
+	(*GetRolePermissionsRequest)(nil),                // 7: altalune.v1.GetRolePermissionsRequest
+	(*GetRolePermissionsResponse)(nil),               // 8: altalune.v1.GetRolePermissionsResponse
+	(*AssignUserPermissionsRequest)(nil),             // 9: altalune.v1.AssignUserPermissionsRequest
+	(*RemoveUserPermissionsRequest)(nil),             // 10: altalune.v1.RemoveUserPermissionsRequest
+	(*GetUserPermissionsRequest)(nil),                // 11: altalune.v1.GetUserPermissionsRequest
+	(*GetUserPermissionsResponse)(nil),               // 12: altalune.v1.GetUserPermissionsResponse
+	(*ProjectMember)(nil),                            // 13: altalune.v1.ProjectMember
+	(*AssignProjectMembersRequest)(nil),              // 14: altalune.v1.AssignProjectMembersRequest
+	(*RemoveProjectMembersRequest)(nil),              // 15: altalune.v1.RemoveProjectMembersRequest
+	(*GetProjectMembersRequest)(nil),                 // 16: altalune.v1.GetProjectMembersRequest
+	(*ProjectMemberWithUser)(nil),                    // 17: altalune.v1.ProjectMemberWithUser
+	(*GetProjectMembersResponse)(nil),                // 18: altalune.v1.GetProjectMembersResponse
+	(*GetUserProjectsRequest)(nil),                   // 19: altalune.v1.GetUserProjectsRequest
+	(*UserProjectMembership)(nil),                    // 20: altalune.v1.UserProjectMembership
+	(*GetUserProjectsResponse)(nil),                  // 21: altalune.v1.GetUserProjectsResponse
+	(*ProjectOwnershipTransfer)(nil),                 // 22: altalune.v1.ProjectOwnershipTransfer
+	(*InitiateProjectOwnershipTransferRequest)(nil),  // 23: altalune.v1.InitiateProjectOwnershipTransferRequest
+	(*InitiateProjectOwnershipTransferResponse)(nil), // 24: altalune.v1.InitiateProjectOwnershipTransferResponse
+	(*GetProjectOwnershipTransferRequest)(nil),       // 25: altalune.v1.GetProjectOwnershipTransferRequest
+	(*GetProjectOwnershipTransferResponse)(nil),      // 26: altalune.v1.GetProjectOwnershipTransferResponse
+	(*AcceptProjectOwnershipTransferRequest)(nil),    // 27: altalune.v1.AcceptProjectOwnershipTransferRequest
+	(*AcceptProjectOwnershipTransferResponse)(nil),   // 28: altalune.v1.AcceptProjectOwnershipTransferResponse
+	(*CancelProjectOwnershipTransferRequest)(nil),    // 29: altalune.v1.CancelProjectOwnershipTransferRequest
+	(*DumpProjectIAMRequest)(nil),                    // 30: altalune.v1.DumpProjectIAMRequest
+	(*DumpProjectIAMResponse)(nil),                   // 31: altalune.v1.DumpProjectIAMResponse
+	(*ApplyProjectIAMRequest)(nil),                   // 32: altalune.v1.ApplyProjectIAMRequest
+	(*ApplyProjectIAMResponse)(nil),                  // 33: altalune.v1.ApplyProjectIAMResponse
+	(*Role)(nil),                                     // 34: altalune.v1.Role
+	(*Permission)(nil),                               // 35: altalune.v1.Permission
+	(*User)(nil),                                     // 36: altalune.v1.User
+	(*timestamppb.Timestamp)(nil),                    // 37: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                            // 38: google.protobuf.Empty
 }
 var file_altalune_v1_iam_mapper_proto_depIdxs = []int32{
-	21, // 0: altalune.v1.GetUserRolesResponse.roles:type_name -> altalune.v1.Role
-	22, // 1: altalune.v1.GetRolePermissionsResponse.permissions:type_name -> altalune.v1.Permission
-	22, // 2: altalune.v1.GetUserPermissionsResponse.permissions:type_name -> altalune.v1.Permission
-	12, // 3: altalune.v1.AssignProjectMembersRequest.members:type_name -> altalune.v1.ProjectMember
-	23, // 4: altalune.v1.ProjectMemberWithUser.user:type_name -> altalune.v1.User
-	24, // 5: altalune.v1.ProjectMemberWithUser.created_at:type_name -> google.protobuf.Timestamp
-	16, // 6: altalune.v1.GetProjectMembersResponse.members:type_name -> altalune.v1.ProjectMemberWithUser
-	24, // 7: altalune.v1.UserProjectMembership.joined_at:type_name -> google.protobuf.Timestamp
-	19, // 8: altalune.v1.GetUserProjectsResponse.projects:type_name -> altalune.v1.UserProjectMembership
-	0,  // 9: altalune.v1.IAMMapperService.AssignUserRoles:input_type -> altalune.v1.AssignUserRolesRequest
-	1,  // 10: altalune.v1.IAMMapperService.RemoveUserRoles:input_type -> altalune.v1.RemoveUserRolesRequest
-	2,  // 11: altalune.v1.IAMMapperService.GetUserRoles:input_type -> altalune.v1.GetUserRolesRequest
-	4,  // 12: altalune.v1.IAMMapperService.AssignRolePermissions:input_type -> altalune.v1.AssignRolePermissionsRequest
-	5,  // 13: altalune.v1.IAMMapperService.RemoveRolePermissions:input_type -> altalune.v1.RemoveRolePermissionsRequest
-	6,  // 14: altalune.v1.IAMMapperService.GetRolePermissions:input_type -> altalune.v1.GetRolePermissionsRequest
-	8,  // 15: altalune.v1.IAMMapperService.AssignUserPermissions:input_type -> altalune.v1.AssignUserPermissionsRequest
-	9,  // 16: altalune.v1.IAMMapperService.RemoveUserPermissions:input_type -> altalune.v1.RemoveUserPermissionsRequest
-	10, // 17: altalune.v1.IAMMapperService.GetUserPermissions:input_type -> altalune.v1.GetUserPermissionsRequest
-	13, // 18: altalune.v1.IAMMapperService.AssignProjectMembers:input_type -> altalune.v1.AssignProjectMembersRequest
-	14, // 19: altalune.v1.IAMMapperService.RemoveProjectMembers:input_type -> altalune.v1.RemoveProjectMembersRequest
-	15, // 20: altalune.v1.IAMMapperService.GetProjectMembers:input_type -> altalune.v1.GetProjectMembersRequest
-	18, // 21: altalune.v1.IAMMapperService.GetUserProjects:input_type -> altalune.v1.GetUserProjectsRequest
-	25, // 22: altalune.v1.IAMMapperService.AssignUserRoles:output_type -> google.protobuf.Empty
-	25, // 23: altalune.v1.IAMMapperService.RemoveUserRoles:output_type -> google.protobuf.Empty
-	3,  // 24: altalune.v1.IAMMapperService.GetUserRoles:output_type -> altalune.v1.GetUserRolesResponse
-	25, // 25: altalune.v1.IAMMapperService.AssignRolePermissions:output_type -> google.protobuf.Empty
-	25, // 26: altalune.v1.IAMMapperService.RemoveRolePermissions:output_type -> google.protobuf.Empty
-	7,  // 27: altalune.v1.IAMMapperService.GetRolePermissions:output_type -> altalune.v1.GetRolePermissionsResponse
-	25, // 28: altalune.v1.IAMMapperService.AssignUserPermissions:output_type -> google.protobuf.Empty
-	25, // 29: altalune.v1.IAMMapperService.RemoveUserPermissions:output_type -> google.protobuf.Empty
-	11, // 30: altalune.v1.IAMMapperService.GetUserPermissions:output_type -> altalune.v1.GetUserPermissionsResponse
-	25, // 31: altalune.v1.IAMMapperService.AssignProjectMembers:output_type -> google.protobuf.Empty
-	25, // 32: altalune.v1.IAMMapperService.RemoveProjectMembers:output_type -> google.protobuf.Empty
-	17, // 33: altalune.v1.IAMMapperService.GetProjectMembers:output_type -> altalune.v1.GetProjectMembersResponse
-	20, // 34: altalune.v1.IAMMapperService.GetUserProjects:output_type -> altalune.v1.GetUserProjectsResponse
-	22, // [22:35] is the sub-list for method output_type
-	9,  // [9:22] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	34, // 0: altalune.v1.GetUserRolesResponse.roles:type_name -> altalune.v1.Role
+	35, // 1: altalune.v1.GetRolePermissionsResponse.permissions:type_name -> altalune.v1.Permission
+	35, // 2: altalune.v1.GetUserPermissionsResponse.permissions:type_name -> altalune.v1.Permission
+	13, // 3: altalune.v1.AssignProjectMembersRequest.members:type_name -> altalune.v1.ProjectMember
+	36, // 4: altalune.v1.ProjectMemberWithUser.user:type_name -> altalune.v1.User
+	37, // 5: altalune.v1.ProjectMemberWithUser.created_at:type_name -> google.protobuf.Timestamp
+	17, // 6: altalune.v1.GetProjectMembersResponse.members:type_name -> altalune.v1.ProjectMemberWithUser
+	37, // 7: altalune.v1.UserProjectMembership.joined_at:type_name -> google.protobuf.Timestamp
+	20, // 8: altalune.v1.GetUserProjectsResponse.projects:type_name -> altalune.v1.UserProjectMembership
+	0,  // 9: altalune.v1.ProjectOwnershipTransfer.status:type_name -> altalune.v1.OwnershipTransferStatus
+	37, // 10: altalune.v1.ProjectOwnershipTransfer.expires_at:type_name -> google.protobuf.Timestamp
+	37, // 11: altalune.v1.ProjectOwnershipTransfer.created_at:type_name -> google.protobuf.Timestamp
+	37, // 12: altalune.v1.ProjectOwnershipTransfer.resolved_at:type_name -> google.protobuf.Timestamp
+	22, // 13: altalune.v1.InitiateProjectOwnershipTransferResponse.transfer:type_name -> altalune.v1.ProjectOwnershipTransfer
+	22, // 14: altalune.v1.GetProjectOwnershipTransferResponse.transfer:type_name -> altalune.v1.ProjectOwnershipTransfer
+	22, // 15: altalune.v1.AcceptProjectOwnershipTransferResponse.transfer:type_name -> altalune.v1.ProjectOwnershipTransfer
+	1,  // 16: altalune.v1.IAMMapperService.AssignUserRoles:input_type -> altalune.v1.AssignUserRolesRequest
+	2,  // 17: altalune.v1.IAMMapperService.RemoveUserRoles:input_type -> altalune.v1.RemoveUserRolesRequest
+	3,  // 18: altalune.v1.IAMMapperService.GetUserRoles:input_type -> altalune.v1.GetUserRolesRequest
+	5,  // 19: altalune.v1.IAMMapperService.AssignRolePermissions:input_type -> altalune.v1.AssignRolePermissionsRequest
+	6,  // 20: altalune.v1.IAMMapperService.RemoveRolePermissions:input_type -> altalune.v1.RemoveRolePermissionsRequest
+	7,  // 21: altalune.v1.IAMMapperService.GetRolePermissions:input_type -> altalune.v1.GetRolePermissionsRequest
+	9,  // 22: altalune.v1.IAMMapperService.AssignUserPermissions:input_type -> altalune.v1.AssignUserPermissionsRequest
+	10, // 23: altalune.v1.IAMMapperService.RemoveUserPermissions:input_type -> altalune.v1.RemoveUserPermissionsRequest
+	11, // 24: altalune.v1.IAMMapperService.GetUserPermissions:input_type -> altalune.v1.GetUserPermissionsRequest
+	14, // 25: altalune.v1.IAMMapperService.AssignProjectMembers:input_type -> altalune.v1.AssignProjectMembersRequest
+	15, // 26: altalune.v1.IAMMapperService.RemoveProjectMembers:input_type -> altalune.v1.RemoveProjectMembersRequest
+	16, // 27: altalune.v1.IAMMapperService.GetProjectMembers:input_type -> altalune.v1.GetProjectMembersRequest
+	23, // 28: altalune.v1.IAMMapperService.InitiateProjectOwnershipTransfer:input_type -> altalune.v1.InitiateProjectOwnershipTransferRequest
+	25, // 29: altalune.v1.IAMMapperService.GetProjectOwnershipTransfer:input_type -> altalune.v1.GetProjectOwnershipTransferRequest
+	27, // 30: altalune.v1.IAMMapperService.AcceptProjectOwnershipTransfer:input_type -> altalune.v1.AcceptProjectOwnershipTransferRequest
+	29, // 31: altalune.v1.IAMMapperService.CancelProjectOwnershipTransfer:input_type -> altalune.v1.CancelProjectOwnershipTransferRequest
+	19, // 32: altalune.v1.IAMMapperService.GetUserProjects:input_type -> altalune.v1.GetUserProjectsRequest
+	30, // 33: altalune.v1.IAMMapperService.DumpProjectIAM:input_type -> altalune.v1.DumpProjectIAMRequest
+	32, // 34: altalune.v1.IAMMapperService.ApplyProjectIAM:input_type -> altalune.v1.ApplyProjectIAMRequest
+	38, // 35: altalune.v1.IAMMapperService.AssignUserRoles:output_type -> google.protobuf.Empty
+	38, // 36: altalune.v1.IAMMapperService.RemoveUserRoles:output_type -> google.protobuf.Empty
+	4,  // 37: altalune.v1.IAMMapperService.GetUserRoles:output_type -> altalune.v1.GetUserRolesResponse
+	38, // 38: altalune.v1.IAMMapperService.AssignRolePermissions:output_type -> google.protobuf.Empty
+	38, // 39: altalune.v1.IAMMapperService.RemoveRolePermissions:output_type -> google.protobuf.Empty
+	8,  // 40: altalune.v1.IAMMapperService.GetRolePermissions:output_type -> altalune.v1.GetRolePermissionsResponse
+	38, // 41: altalune.v1.IAMMapperService.AssignUserPermissions:output_type -> google.protobuf.Empty
+	38, // 42: altalune.v1.IAMMapperService.RemoveUserPermissions:output_type -> google.protobuf.Empty
+	12, // 43: altalune.v1.IAMMapperService.GetUserPermissions:output_type -> altalune.v1.GetUserPermissionsResponse
+	38, // 44: altalune.v1.IAMMapperService.AssignProjectMembers:output_type -> google.protobuf.Empty
+	38, // 45: altalune.v1.IAMMapperService.RemoveProjectMembers:output_type -> google.protobuf.Empty
+	18, // 46: altalune.v1.IAMMapperService.GetProjectMembers:output_type -> altalune.v1.GetProjectMembersResponse
+	24, // 47: altalune.v1.IAMMapperService.InitiateProjectOwnershipTransfer:output_type -> altalune.v1.InitiateProjectOwnershipTransferResponse
+	26, // 48: altalune.v1.IAMMapperService.GetProjectOwnershipTransfer:output_type -> altalune.v1.GetProjectOwnershipTransferResponse
+	28, // 49: altalune.v1.IAMMapperService.AcceptProjectOwnershipTransfer:output_type -> altalune.v1.AcceptProjectOwnershipTransferResponse
+	38, // 50: altalune.v1.IAMMapperService.CancelProjectOwnershipTransfer:output_type -> google.protobuf.Empty
+	21, // 51: altalune.v1.IAMMapperService.GetUserProjects:output_type -> altalune.v1.GetUserProjectsResponse
+	31, // 52: altalune.v1.IAMMapperService.DumpProjectIAM:output_type -> altalune.v1.DumpProjectIAMResponse
+	33, // 53: altalune.v1.IAMMapperService.ApplyProjectIAM:output_type -> altalune.v1.ApplyProjectIAMResponse
+	35, // [35:54] is the sub-list for method output_type
+	16, // [16:35] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_altalune_v1_iam_mapper_proto_init() }
@@ -1255,18 +2060,20 @@ func file_altalune_v1_iam_mapper_proto_init() {
 	file_altalune_v1_user_proto_init()
 	file_altalune_v1_role_proto_init()
 	file_altalune_v1_permission_proto_init()
+	file_altalune_v1_options_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_altalune_v1_iam_mapper_proto_rawDesc), len(file_altalune_v1_iam_mapper_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   21,
+			NumEnums:      1,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_altalune_v1_iam_mapper_proto_goTypes,
 		DependencyIndexes: file_altalune_v1_iam_mapper_proto_depIdxs,
+		EnumInfos:         file_altalune_v1_iam_mapper_proto_enumTypes,
 		MessageInfos:      file_altalune_v1_iam_mapper_proto_msgTypes,
 	}.Build()
 	File_altalune_v1_iam_mapper_proto = out.File
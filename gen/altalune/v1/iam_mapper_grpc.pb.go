@@ -20,19 +20,25 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	IAMMapperService_AssignUserRoles_FullMethodName       = "/altalune.v1.IAMMapperService/AssignUserRoles"
-	IAMMapperService_RemoveUserRoles_FullMethodName       = "/altalune.v1.IAMMapperService/RemoveUserRoles"
-	IAMMapperService_GetUserRoles_FullMethodName          = "/altalune.v1.IAMMapperService/GetUserRoles"
-	IAMMapperService_AssignRolePermissions_FullMethodName = "/altalune.v1.IAMMapperService/AssignRolePermissions"
-	IAMMapperService_RemoveRolePermissions_FullMethodName = "/altalune.v1.IAMMapperService/RemoveRolePermissions"
-	IAMMapperService_GetRolePermissions_FullMethodName    = "/altalune.v1.IAMMapperService/GetRolePermissions"
-	IAMMapperService_AssignUserPermissions_FullMethodName = "/altalune.v1.IAMMapperService/AssignUserPermissions"
-	IAMMapperService_RemoveUserPermissions_FullMethodName = "/altalune.v1.IAMMapperService/RemoveUserPermissions"
-	IAMMapperService_GetUserPermissions_FullMethodName    = "/altalune.v1.IAMMapperService/GetUserPermissions"
-	IAMMapperService_AssignProjectMembers_FullMethodName  = "/altalune.v1.IAMMapperService/AssignProjectMembers"
-	IAMMapperService_RemoveProjectMembers_FullMethodName  = "/altalune.v1.IAMMapperService/RemoveProjectMembers"
-	IAMMapperService_GetProjectMembers_FullMethodName     = "/altalune.v1.IAMMapperService/GetProjectMembers"
-	IAMMapperService_GetUserProjects_FullMethodName       = "/altalune.v1.IAMMapperService/GetUserProjects"
+	IAMMapperService_AssignUserRoles_FullMethodName                  = "/altalune.v1.IAMMapperService/AssignUserRoles"
+	IAMMapperService_RemoveUserRoles_FullMethodName                  = "/altalune.v1.IAMMapperService/RemoveUserRoles"
+	IAMMapperService_GetUserRoles_FullMethodName                     = "/altalune.v1.IAMMapperService/GetUserRoles"
+	IAMMapperService_AssignRolePermissions_FullMethodName            = "/altalune.v1.IAMMapperService/AssignRolePermissions"
+	IAMMapperService_RemoveRolePermissions_FullMethodName            = "/altalune.v1.IAMMapperService/RemoveRolePermissions"
+	IAMMapperService_GetRolePermissions_FullMethodName               = "/altalune.v1.IAMMapperService/GetRolePermissions"
+	IAMMapperService_AssignUserPermissions_FullMethodName            = "/altalune.v1.IAMMapperService/AssignUserPermissions"
+	IAMMapperService_RemoveUserPermissions_FullMethodName            = "/altalune.v1.IAMMapperService/RemoveUserPermissions"
+	IAMMapperService_GetUserPermissions_FullMethodName               = "/altalune.v1.IAMMapperService/GetUserPermissions"
+	IAMMapperService_AssignProjectMembers_FullMethodName             = "/altalune.v1.IAMMapperService/AssignProjectMembers"
+	IAMMapperService_RemoveProjectMembers_FullMethodName             = "/altalune.v1.IAMMapperService/RemoveProjectMembers"
+	IAMMapperService_GetProjectMembers_FullMethodName                = "/altalune.v1.IAMMapperService/GetProjectMembers"
+	IAMMapperService_InitiateProjectOwnershipTransfer_FullMethodName = "/altalune.v1.IAMMapperService/InitiateProjectOwnershipTransfer"
+	IAMMapperService_GetProjectOwnershipTransfer_FullMethodName      = "/altalune.v1.IAMMapperService/GetProjectOwnershipTransfer"
+	IAMMapperService_AcceptProjectOwnershipTransfer_FullMethodName   = "/altalune.v1.IAMMapperService/AcceptProjectOwnershipTransfer"
+	IAMMapperService_CancelProjectOwnershipTransfer_FullMethodName   = "/altalune.v1.IAMMapperService/CancelProjectOwnershipTransfer"
+	IAMMapperService_GetUserProjects_FullMethodName                  = "/altalune.v1.IAMMapperService/GetUserProjects"
+	IAMMapperService_DumpProjectIAM_FullMethodName                   = "/altalune.v1.IAMMapperService/DumpProjectIAM"
+	IAMMapperService_ApplyProjectIAM_FullMethodName                  = "/altalune.v1.IAMMapperService/ApplyProjectIAM"
 )
 
 // IAMMapperServiceClient is the client API for IAMMapperService service.
@@ -55,8 +61,18 @@ type IAMMapperServiceClient interface {
 	AssignProjectMembers(ctx context.Context, in *AssignProjectMembersRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	RemoveProjectMembers(ctx context.Context, in *RemoveProjectMembersRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetProjectMembers(ctx context.Context, in *GetProjectMembersRequest, opts ...grpc.CallOption) (*GetProjectMembersResponse, error)
+	// Project Ownership Transfers. Accepting and cancelling is checked against
+	// the parties of the transfer rather than a permission.
+	InitiateProjectOwnershipTransfer(ctx context.Context, in *InitiateProjectOwnershipTransferRequest, opts ...grpc.CallOption) (*InitiateProjectOwnershipTransferResponse, error)
+	GetProjectOwnershipTransfer(ctx context.Context, in *GetProjectOwnershipTransferRequest, opts ...grpc.CallOption) (*GetProjectOwnershipTransferResponse, error)
+	AcceptProjectOwnershipTransfer(ctx context.Context, in *AcceptProjectOwnershipTransferRequest, opts ...grpc.CallOption) (*AcceptProjectOwnershipTransferResponse, error)
+	CancelProjectOwnershipTransfer(ctx context.Context, in *CancelProjectOwnershipTransferRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// User Projects (reverse lookup - projects a user belongs to)
 	GetUserProjects(ctx context.Context, in *GetUserProjectsRequest, opts ...grpc.CallOption) (*GetUserProjectsResponse, error)
+	// IAM Snapshots. Roles and permissions are global: applying a snapshot
+	// also changes them for the other projects whose members hold them.
+	DumpProjectIAM(ctx context.Context, in *DumpProjectIAMRequest, opts ...grpc.CallOption) (*DumpProjectIAMResponse, error)
+	ApplyProjectIAM(ctx context.Context, in *ApplyProjectIAMRequest, opts ...grpc.CallOption) (*ApplyProjectIAMResponse, error)
 }
 
 type iAMMapperServiceClient struct {
@@ -187,6 +203,46 @@ func (c *iAMMapperServiceClient) GetProjectMembers(ctx context.Context, in *GetP
 	return out, nil
 }
 
+func (c *iAMMapperServiceClient) InitiateProjectOwnershipTransfer(ctx context.Context, in *InitiateProjectOwnershipTransferRequest, opts ...grpc.CallOption) (*InitiateProjectOwnershipTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InitiateProjectOwnershipTransferResponse)
+	err := c.cc.Invoke(ctx, IAMMapperService_InitiateProjectOwnershipTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMMapperServiceClient) GetProjectOwnershipTransfer(ctx context.Context, in *GetProjectOwnershipTransferRequest, opts ...grpc.CallOption) (*GetProjectOwnershipTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProjectOwnershipTransferResponse)
+	err := c.cc.Invoke(ctx, IAMMapperService_GetProjectOwnershipTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMMapperServiceClient) AcceptProjectOwnershipTransfer(ctx context.Context, in *AcceptProjectOwnershipTransferRequest, opts ...grpc.CallOption) (*AcceptProjectOwnershipTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptProjectOwnershipTransferResponse)
+	err := c.cc.Invoke(ctx, IAMMapperService_AcceptProjectOwnershipTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMMapperServiceClient) CancelProjectOwnershipTransfer(ctx context.Context, in *CancelProjectOwnershipTransferRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, IAMMapperService_CancelProjectOwnershipTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *iAMMapperServiceClient) GetUserProjects(ctx context.Context, in *GetUserProjectsRequest, opts ...grpc.CallOption) (*GetUserProjectsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetUserProjectsResponse)
@@ -197,6 +253,26 @@ func (c *iAMMapperServiceClient) GetUserProjects(ctx context.Context, in *GetUse
 	return out, nil
 }
 
+func (c *iAMMapperServiceClient) DumpProjectIAM(ctx context.Context, in *DumpProjectIAMRequest, opts ...grpc.CallOption) (*DumpProjectIAMResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DumpProjectIAMResponse)
+	err := c.cc.Invoke(ctx, IAMMapperService_DumpProjectIAM_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMMapperServiceClient) ApplyProjectIAM(ctx context.Context, in *ApplyProjectIAMRequest, opts ...grpc.CallOption) (*ApplyProjectIAMResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyProjectIAMResponse)
+	err := c.cc.Invoke(ctx, IAMMapperService_ApplyProjectIAM_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // IAMMapperServiceServer is the server API for IAMMapperService service.
 // All implementations must embed UnimplementedIAMMapperServiceServer
 // for forward compatibility.
@@ -217,8 +293,18 @@ type IAMMapperServiceServer interface {
 	AssignProjectMembers(context.Context, *AssignProjectMembersRequest) (*emptypb.Empty, error)
 	RemoveProjectMembers(context.Context, *RemoveProjectMembersRequest) (*emptypb.Empty, error)
 	GetProjectMembers(context.Context, *GetProjectMembersRequest) (*GetProjectMembersResponse, error)
+	// Project Ownership Transfers. Accepting and cancelling is checked against
+	// the parties of the transfer rather than a permission.
+	InitiateProjectOwnershipTransfer(context.Context, *InitiateProjectOwnershipTransferRequest) (*InitiateProjectOwnershipTransferResponse, error)
+	GetProjectOwnershipTransfer(context.Context, *GetProjectOwnershipTransferRequest) (*GetProjectOwnershipTransferResponse, error)
+	AcceptProjectOwnershipTransfer(context.Context, *AcceptProjectOwnershipTransferRequest) (*AcceptProjectOwnershipTransferResponse, error)
+	CancelProjectOwnershipTransfer(context.Context, *CancelProjectOwnershipTransferRequest) (*emptypb.Empty, error)
 	// User Projects (reverse lookup - projects a user belongs to)
 	GetUserProjects(context.Context, *GetUserProjectsRequest) (*GetUserProjectsResponse, error)
+	// IAM Snapshots. Roles and permissions are global: applying a snapshot
+	// also changes them for the other projects whose members hold them.
+	DumpProjectIAM(context.Context, *DumpProjectIAMRequest) (*DumpProjectIAMResponse, error)
+	ApplyProjectIAM(context.Context, *ApplyProjectIAMRequest) (*ApplyProjectIAMResponse, error)
 	mustEmbedUnimplementedIAMMapperServiceServer()
 }
 
@@ -265,9 +351,27 @@ func (UnimplementedIAMMapperServiceServer) RemoveProjectMembers(context.Context,
 func (UnimplementedIAMMapperServiceServer) GetProjectMembers(context.Context, *GetProjectMembersRequest) (*GetProjectMembersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProjectMembers not implemented")
 }
+func (UnimplementedIAMMapperServiceServer) InitiateProjectOwnershipTransfer(context.Context, *InitiateProjectOwnershipTransferRequest) (*InitiateProjectOwnershipTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitiateProjectOwnershipTransfer not implemented")
+}
+func (UnimplementedIAMMapperServiceServer) GetProjectOwnershipTransfer(context.Context, *GetProjectOwnershipTransferRequest) (*GetProjectOwnershipTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProjectOwnershipTransfer not implemented")
+}
+func (UnimplementedIAMMapperServiceServer) AcceptProjectOwnershipTransfer(context.Context, *AcceptProjectOwnershipTransferRequest) (*AcceptProjectOwnershipTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptProjectOwnershipTransfer not implemented")
+}
+func (UnimplementedIAMMapperServiceServer) CancelProjectOwnershipTransfer(context.Context, *CancelProjectOwnershipTransferRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelProjectOwnershipTransfer not implemented")
+}
 func (UnimplementedIAMMapperServiceServer) GetUserProjects(context.Context, *GetUserProjectsRequest) (*GetUserProjectsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserProjects not implemented")
 }
+func (UnimplementedIAMMapperServiceServer) DumpProjectIAM(context.Context, *DumpProjectIAMRequest) (*DumpProjectIAMResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DumpProjectIAM not implemented")
+}
+func (UnimplementedIAMMapperServiceServer) ApplyProjectIAM(context.Context, *ApplyProjectIAMRequest) (*ApplyProjectIAMResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyProjectIAM not implemented")
+}
 func (UnimplementedIAMMapperServiceServer) mustEmbedUnimplementedIAMMapperServiceServer() {}
 func (UnimplementedIAMMapperServiceServer) testEmbeddedByValue()                          {}
 
@@ -505,6 +609,78 @@ func _IAMMapperService_GetProjectMembers_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IAMMapperService_InitiateProjectOwnershipTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InitiateProjectOwnershipTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMMapperServiceServer).InitiateProjectOwnershipTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMMapperService_InitiateProjectOwnershipTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMMapperServiceServer).InitiateProjectOwnershipTransfer(ctx, req.(*InitiateProjectOwnershipTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMMapperService_GetProjectOwnershipTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectOwnershipTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMMapperServiceServer).GetProjectOwnershipTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMMapperService_GetProjectOwnershipTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMMapperServiceServer).GetProjectOwnershipTransfer(ctx, req.(*GetProjectOwnershipTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMMapperService_AcceptProjectOwnershipTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptProjectOwnershipTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMMapperServiceServer).AcceptProjectOwnershipTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMMapperService_AcceptProjectOwnershipTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMMapperServiceServer).AcceptProjectOwnershipTransfer(ctx, req.(*AcceptProjectOwnershipTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMMapperService_CancelProjectOwnershipTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelProjectOwnershipTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMMapperServiceServer).CancelProjectOwnershipTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMMapperService_CancelProjectOwnershipTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMMapperServiceServer).CancelProjectOwnershipTransfer(ctx, req.(*CancelProjectOwnershipTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IAMMapperService_GetUserProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetUserProjectsRequest)
 	if err := dec(in); err != nil {
@@ -523,6 +699,42 @@ func _IAMMapperService_GetUserProjects_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IAMMapperService_DumpProjectIAM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DumpProjectIAMRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMMapperServiceServer).DumpProjectIAM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMMapperService_DumpProjectIAM_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMMapperServiceServer).DumpProjectIAM(ctx, req.(*DumpProjectIAMRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMMapperService_ApplyProjectIAM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyProjectIAMRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMMapperServiceServer).ApplyProjectIAM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IAMMapperService_ApplyProjectIAM_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMMapperServiceServer).ApplyProjectIAM(ctx, req.(*ApplyProjectIAMRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // IAMMapperService_ServiceDesc is the grpc.ServiceDesc for IAMMapperService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -578,10 +790,34 @@ var IAMMapperService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetProjectMembers",
 			Handler:    _IAMMapperService_GetProjectMembers_Handler,
 		},
+		{
+			MethodName: "InitiateProjectOwnershipTransfer",
+			Handler:    _IAMMapperService_InitiateProjectOwnershipTransfer_Handler,
+		},
+		{
+			MethodName: "GetProjectOwnershipTransfer",
+			Handler:    _IAMMapperService_GetProjectOwnershipTransfer_Handler,
+		},
+		{
+			MethodName: "AcceptProjectOwnershipTransfer",
+			Handler:    _IAMMapperService_AcceptProjectOwnershipTransfer_Handler,
+		},
+		{
+			MethodName: "CancelProjectOwnershipTransfer",
+			Handler:    _IAMMapperService_CancelProjectOwnershipTransfer_Handler,
+		},
 		{
 			MethodName: "GetUserProjects",
 			Handler:    _IAMMapperService_GetUserProjects_Handler,
 		},
+		{
+			MethodName: "DumpProjectIAM",
+			Handler:    _IAMMapperService_DumpProjectIAM_Handler,
+		},
+		{
+			MethodName: "ApplyProjectIAM",
+			Handler:    _IAMMapperService_ApplyProjectIAM_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "altalune/v1/iam_mapper.proto",
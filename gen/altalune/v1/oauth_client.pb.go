@@ -23,24 +23,192 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type RefreshTokenStatus int32
+
+const (
+	RefreshTokenStatus_REFRESH_TOKEN_STATUS_UNSPECIFIED RefreshTokenStatus = 0
+	RefreshTokenStatus_REFRESH_TOKEN_STATUS_ACTIVE      RefreshTokenStatus = 1 // Usable until it expires
+	RefreshTokenStatus_REFRESH_TOKEN_STATUS_EXCHANGED   RefreshTokenStatus = 2 // Exchanged for a new token
+	RefreshTokenStatus_REFRESH_TOKEN_STATUS_REVOKED     RefreshTokenStatus = 3 // Revoked by sign-out or an administrator
+	RefreshTokenStatus_REFRESH_TOKEN_STATUS_EXPIRED     RefreshTokenStatus = 4 // Past its expiration unused
+)
+
+// Enum value maps for RefreshTokenStatus.
+var (
+	RefreshTokenStatus_name = map[int32]string{
+		0: "REFRESH_TOKEN_STATUS_UNSPECIFIED",
+		1: "REFRESH_TOKEN_STATUS_ACTIVE",
+		2: "REFRESH_TOKEN_STATUS_EXCHANGED",
+		3: "REFRESH_TOKEN_STATUS_REVOKED",
+		4: "REFRESH_TOKEN_STATUS_EXPIRED",
+	}
+	RefreshTokenStatus_value = map[string]int32{
+		"REFRESH_TOKEN_STATUS_UNSPECIFIED": 0,
+		"REFRESH_TOKEN_STATUS_ACTIVE":      1,
+		"REFRESH_TOKEN_STATUS_EXCHANGED":   2,
+		"REFRESH_TOKEN_STATUS_REVOKED":     3,
+		"REFRESH_TOKEN_STATUS_EXPIRED":     4,
+	}
+)
+
+func (x RefreshTokenStatus) Enum() *RefreshTokenStatus {
+	p := new(RefreshTokenStatus)
+	*p = x
+	return p
+}
+
+func (x RefreshTokenStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RefreshTokenStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_altalune_v1_oauth_client_proto_enumTypes[0].Descriptor()
+}
+
+func (RefreshTokenStatus) Type() protoreflect.EnumType {
+	return &file_altalune_v1_oauth_client_proto_enumTypes[0]
+}
+
+func (x RefreshTokenStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RefreshTokenStatus.Descriptor instead.
+func (RefreshTokenStatus) EnumDescriptor() ([]byte, []int) {
+	return file_altalune_v1_oauth_client_proto_rawDescGZIP(), []int{0}
+}
+
+// How access tokens carry the permissions of their user
+type PermsClaimMode int32
+
+const (
+	PermsClaimMode_PERMS_CLAIM_MODE_UNSPECIFIED PermsClaimMode = 0
+	PermsClaimMode_PERMS_CLAIM_MODE_FULL        PermsClaimMode = 1 // Every permission in perms
+	PermsClaimMode_PERMS_CLAIM_MODE_OMIT        PermsClaimMode = 2 // No perms; resource servers introspect the token
+	PermsClaimMode_PERMS_CLAIM_MODE_ROLES       PermsClaimMode = 3 // Role names in roles, directly granted permissions in perms
+)
+
+// Enum value maps for PermsClaimMode.
+var (
+	PermsClaimMode_name = map[int32]string{
+		0: "PERMS_CLAIM_MODE_UNSPECIFIED",
+		1: "PERMS_CLAIM_MODE_FULL",
+		2: "PERMS_CLAIM_MODE_OMIT",
+		3: "PERMS_CLAIM_MODE_ROLES",
+	}
+	PermsClaimMode_value = map[string]int32{
+		"PERMS_CLAIM_MODE_UNSPECIFIED": 0,
+		"PERMS_CLAIM_MODE_FULL":        1,
+		"PERMS_CLAIM_MODE_OMIT":        2,
+		"PERMS_CLAIM_MODE_ROLES":       3,
+	}
+)
+
+func (x PermsClaimMode) Enum() *PermsClaimMode {
+	p := new(PermsClaimMode)
+	*p = x
+	return p
+}
+
+func (x PermsClaimMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PermsClaimMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_altalune_v1_oauth_client_proto_enumTypes[1].Descriptor()
+}
+
+func (PermsClaimMode) Type() protoreflect.EnumType {
+	return &file_altalune_v1_oauth_client_proto_enumTypes[1]
+}
+
+func (x PermsClaimMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PermsClaimMode.Descriptor instead.
+func (PermsClaimMode) EnumDescriptor() ([]byte, []int) {
+	return file_altalune_v1_oauth_client_proto_rawDescGZIP(), []int{1}
+}
+
+type AuthorizationFlowStepOutcome int32
+
+const (
+	AuthorizationFlowStepOutcome_AUTHORIZATION_FLOW_STEP_OUTCOME_UNSPECIFIED AuthorizationFlowStepOutcome = 0
+	AuthorizationFlowStepOutcome_AUTHORIZATION_FLOW_STEP_OUTCOME_PASSED      AuthorizationFlowStepOutcome = 1
+	AuthorizationFlowStepOutcome_AUTHORIZATION_FLOW_STEP_OUTCOME_WARNING     AuthorizationFlowStepOutcome = 2 // Passed, but likely not what the client wants
+	AuthorizationFlowStepOutcome_AUTHORIZATION_FLOW_STEP_OUTCOME_FAILED      AuthorizationFlowStepOutcome = 3 // The flow stops here
+	AuthorizationFlowStepOutcome_AUTHORIZATION_FLOW_STEP_OUTCOME_SKIPPED     AuthorizationFlowStepOutcome = 4 // Not reached after a failed step
+)
+
+// Enum value maps for AuthorizationFlowStepOutcome.
+var (
+	AuthorizationFlowStepOutcome_name = map[int32]string{
+		0: "AUTHORIZATION_FLOW_STEP_OUTCOME_UNSPECIFIED",
+		1: "AUTHORIZATION_FLOW_STEP_OUTCOME_PASSED",
+		2: "AUTHORIZATION_FLOW_STEP_OUTCOME_WARNING",
+		3: "AUTHORIZATION_FLOW_STEP_OUTCOME_FAILED",
+		4: "AUTHORIZATION_FLOW_STEP_OUTCOME_SKIPPED",
+	}
+	AuthorizationFlowStepOutcome_value = map[string]int32{
+		"AUTHORIZATION_FLOW_STEP_OUTCOME_UNSPECIFIED": 0,
+		"AUTHORIZATION_FLOW_STEP_OUTCOME_PASSED":      1,
+		"AUTHORIZATION_FLOW_STEP_OUTCOME_WARNING":     2,
+		"AUTHORIZATION_FLOW_STEP_OUTCOME_FAILED":      3,
+		"AUTHORIZATION_FLOW_STEP_OUTCOME_SKIPPED":     4,
+	}
+)
+
+func (x AuthorizationFlowStepOutcome) Enum() *AuthorizationFlowStepOutcome {
+	p := new(AuthorizationFlowStepOutcome)
+	*p = x
+	return p
+}
+
+func (x AuthorizationFlowStepOutcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuthorizationFlowStepOutcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_altalune_v1_oauth_client_proto_enumTypes[2].Descriptor()
+}
+
+func (AuthorizationFlowStepOutcome) Type() protoreflect.EnumType {
+	return &file_altalune_v1_oauth_client_proto_enumTypes[2]
+}
+
+func (x AuthorizationFlowStepOutcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuthorizationFlowStepOutcome.Descriptor instead.
+func (AuthorizationFlowStepOutcome) EnumDescriptor() ([]byte, []int) {
+	return file_altalune_v1_oauth_client_proto_rawDescGZIP(), []int{2}
+}
+
 // OAuth Client Message
 // OAuth clients are GLOBAL entities (infrastructure-level, like Auth0 Applications)
 // not project-scoped business data. This follows Keycloak/Auth0 patterns.
 type OAuthClient struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Public nanoid
-	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	ClientId        string                 `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"` // UUID
-	RedirectUris    []string               `protobuf:"bytes,4,rep,name=redirect_uris,json=redirectUris,proto3" json:"redirect_uris,omitempty"`
-	PkceRequired    bool                   `protobuf:"varint,5,opt,name=pkce_required,json=pkceRequired,proto3" json:"pkce_required,omitempty"`
-	IsDefault       bool                   `protobuf:"varint,6,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
-	ClientSecretSet bool                   `protobuf:"varint,7,opt,name=client_secret_set,json=clientSecretSet,proto3" json:"client_secret_set,omitempty"` // Boolean flag, NOT actual secret
-	AllowedScopes   []string               `protobuf:"bytes,8,rep,name=allowed_scopes,json=allowedScopes,proto3" json:"allowed_scopes,omitempty"`          // Scope names
-	Confidential    bool                   `protobuf:"varint,9,opt,name=confidential,proto3" json:"confidential,omitempty"`                                // true = requires secret (confidential), false = public/SPA
-	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,98,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,99,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Public nanoid
+	Name                  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ClientId              string                 `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"` // UUID
+	RedirectUris          []string               `protobuf:"bytes,4,rep,name=redirect_uris,json=redirectUris,proto3" json:"redirect_uris,omitempty"`
+	PkceRequired          bool                   `protobuf:"varint,5,opt,name=pkce_required,json=pkceRequired,proto3" json:"pkce_required,omitempty"`
+	IsDefault             bool                   `protobuf:"varint,6,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	ClientSecretSet       bool                   `protobuf:"varint,7,opt,name=client_secret_set,json=clientSecretSet,proto3" json:"client_secret_set,omitempty"`                     // Boolean flag, NOT actual secret
+	AllowedScopes         []string               `protobuf:"bytes,8,rep,name=allowed_scopes,json=allowedScopes,proto3" json:"allowed_scopes,omitempty"`                              // Scope names
+	Confidential          bool                   `protobuf:"varint,9,opt,name=confidential,proto3" json:"confidential,omitempty"`                                                    // true = requires secret (confidential), false = public/SPA
+	DeletedAt             *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`                                         // Set only for clients in the trash
+	CreatedBy             string                 `protobuf:"bytes,11,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`                                         // Public ID of the user who created the client, empty if unknown
+	UpdatedBy             string                 `protobuf:"bytes,12,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`                                         // Public ID of the user who last updated the client, empty if unknown
+	ExternalId            string                 `protobuf:"bytes,13,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`                                      // Key of the client in a provisioning tool, empty if unmanaged
+	ClientSecretExpiresAt *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=client_secret_expires_at,json=clientSecretExpiresAt,proto3" json:"client_secret_expires_at,omitempty"` // When the secret stops authenticating the client, unset for never
+	CreatedAt             *timestamppb.Timestamp `protobuf:"bytes,98,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt             *timestamppb.Timestamp `protobuf:"bytes,99,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *OAuthClient) Reset() {
@@ -136,6 +304,41 @@ func (x *OAuthClient) GetConfidential() bool {
 	return false
 }
 
+func (x *OAuthClient) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *OAuthClient) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *OAuthClient) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *OAuthClient) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *OAuthClient) GetClientSecretExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClientSecretExpiresAt
+	}
+	return nil
+}
+
 func (x *OAuthClient) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
@@ -158,6 +361,11 @@ type CreateOAuthClientRequest struct {
 	PkceRequired  bool                   `protobuf:"varint,3,opt,name=pkce_required,json=pkceRequired,proto3" json:"pkce_required,omitempty"`
 	AllowedScopes []string               `protobuf:"bytes,4,rep,name=allowed_scopes,json=allowedScopes,proto3" json:"allowed_scopes,omitempty"` // Optional scope names
 	Confidential  bool                   `protobuf:"varint,5,opt,name=confidential,proto3" json:"confidential,omitempty"`                       // Client type: true = confidential (default), false = public
+	// PEM RSA public key to encrypt the client secret to, required when the
+	// default project delivers secrets to public keys
+	RecipientPublicKey string `protobuf:"bytes,6,opt,name=recipient_public_key,json=recipientPublicKey,proto3" json:"recipient_public_key,omitempty"`
+	// Key of the client in a provisioning tool, unique among clients
+	ExternalId    string `protobuf:"bytes,7,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -227,13 +435,28 @@ func (x *CreateOAuthClientRequest) GetConfidential() bool {
 	return false
 }
 
+func (x *CreateOAuthClientRequest) GetRecipientPublicKey() string {
+	if x != nil {
+		return x.RecipientPublicKey
+	}
+	return ""
+}
+
+func (x *CreateOAuthClientRequest) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
 type CreateOAuthClientResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Client        *OAuthClient           `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
-	ClientSecret  string                 `protobuf:"bytes,2,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"` // ONLY returned during creation
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Client                *OAuthClient           `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	ClientSecret          string                 `protobuf:"bytes,2,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"` // ONLY returned during creation, empty when delivered
+	Message               string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	DeliveredClientSecret *DeliveredSecret       `protobuf:"bytes,4,opt,name=delivered_client_secret,json=deliveredClientSecret,proto3" json:"delivered_client_secret,omitempty"` // Set instead of client_secret when the policy forbids plaintext
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *CreateOAuthClientResponse) Reset() {
@@ -287,10 +510,18 @@ func (x *CreateOAuthClientResponse) GetMessage() string {
 	return ""
 }
 
+func (x *CreateOAuthClientResponse) GetDeliveredClientSecret() *DeliveredSecret {
+	if x != nil {
+		return x.DeliveredClientSecret
+	}
+	return nil
+}
+
 // Query OAuth Clients Request (Global - no project_id needed)
 type QueryOAuthClientsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Query         *QueryRequest          `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Trashed       bool                   `protobuf:"varint,2,opt,name=trashed,proto3" json:"trashed,omitempty"` // List deleted clients instead of live ones
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -332,6 +563,13 @@ func (x *QueryOAuthClientsRequest) GetQuery() *QueryRequest {
 	return nil
 }
 
+func (x *QueryOAuthClientsRequest) GetTrashed() bool {
+	if x != nil {
+		return x.Trashed
+	}
+	return false
+}
+
 type QueryOAuthClientsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Clients       []*OAuthClient         `protobuf:"bytes,1,rep,name=clients,proto3" json:"clients,omitempty"`
@@ -497,8 +735,11 @@ type UpdateOAuthClientRequest struct {
 	RedirectUris  []string               `protobuf:"bytes,3,rep,name=redirect_uris,json=redirectUris,proto3" json:"redirect_uris,omitempty"`
 	PkceRequired  *bool                  `protobuf:"varint,4,opt,name=pkce_required,json=pkceRequired,proto3,oneof" json:"pkce_required,omitempty"`
 	AllowedScopes []string               `protobuf:"bytes,5,rep,name=allowed_scopes,json=allowedScopes,proto3" json:"allowed_scopes,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// updated_at of the client the edit is based on. When set, the update is
+	// rejected with FAILED_PRECONDITION if the client changed since then.
+	ExpectedUpdatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expected_updated_at,json=expectedUpdatedAt,proto3" json:"expected_updated_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *UpdateOAuthClientRequest) Reset() {
@@ -566,6 +807,13 @@ func (x *UpdateOAuthClientRequest) GetAllowedScopes() []string {
 	return nil
 }
 
+func (x *UpdateOAuthClientRequest) GetExpectedUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpectedUpdatedAt
+	}
+	return nil
+}
+
 type UpdateOAuthClientResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Client        *OAuthClient           `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
@@ -707,28 +955,28 @@ func (x *DeleteOAuthClientResponse) GetMessage() string {
 	return ""
 }
 
-// Reveal OAuth Client Secret Request (Global - no project_id needed)
-type RevealOAuthClientSecretRequest struct {
+// Restore OAuth Client Request (Global - no project_id needed)
+type RestoreOAuthClientRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RevealOAuthClientSecretRequest) Reset() {
-	*x = RevealOAuthClientSecretRequest{}
+func (x *RestoreOAuthClientRequest) Reset() {
+	*x = RestoreOAuthClientRequest{}
 	mi := &file_altalune_v1_oauth_client_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RevealOAuthClientSecretRequest) String() string {
+func (x *RestoreOAuthClientRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RevealOAuthClientSecretRequest) ProtoMessage() {}
+func (*RestoreOAuthClientRequest) ProtoMessage() {}
 
-func (x *RevealOAuthClientSecretRequest) ProtoReflect() protoreflect.Message {
+func (x *RestoreOAuthClientRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_altalune_v1_oauth_client_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -740,40 +988,40 @@ func (x *RevealOAuthClientSecretRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RevealOAuthClientSecretRequest.ProtoReflect.Descriptor instead.
-func (*RevealOAuthClientSecretRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestoreOAuthClientRequest.ProtoReflect.Descriptor instead.
+func (*RestoreOAuthClientRequest) Descriptor() ([]byte, []int) {
 	return file_altalune_v1_oauth_client_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *RevealOAuthClientSecretRequest) GetId() string {
+func (x *RestoreOAuthClientRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type RevealOAuthClientSecretResponse struct {
+type RestoreOAuthClientResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ClientSecret  string                 `protobuf:"bytes,1,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	Client        *OAuthClient           `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RevealOAuthClientSecretResponse) Reset() {
-	*x = RevealOAuthClientSecretResponse{}
+func (x *RestoreOAuthClientResponse) Reset() {
+	*x = RestoreOAuthClientResponse{}
 	mi := &file_altalune_v1_oauth_client_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RevealOAuthClientSecretResponse) String() string {
+func (x *RestoreOAuthClientResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RevealOAuthClientSecretResponse) ProtoMessage() {}
+func (*RestoreOAuthClientResponse) ProtoMessage() {}
 
-func (x *RevealOAuthClientSecretResponse) ProtoReflect() protoreflect.Message {
+func (x *RestoreOAuthClientResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_altalune_v1_oauth_client_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -785,94 +1033,1769 @@ func (x *RevealOAuthClientSecretResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RevealOAuthClientSecretResponse.ProtoReflect.Descriptor instead.
-func (*RevealOAuthClientSecretResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestoreOAuthClientResponse.ProtoReflect.Descriptor instead.
+func (*RestoreOAuthClientResponse) Descriptor() ([]byte, []int) {
 	return file_altalune_v1_oauth_client_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *RevealOAuthClientSecretResponse) GetClientSecret() string {
+func (x *RestoreOAuthClientResponse) GetClient() *OAuthClient {
 	if x != nil {
-		return x.ClientSecret
+		return x.Client
 	}
-	return ""
+	return nil
 }
 
-func (x *RevealOAuthClientSecretResponse) GetMessage() string {
+func (x *RestoreOAuthClientResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-var File_altalune_v1_oauth_client_proto protoreflect.FileDescriptor
+// Apply OAuth Client Request - creates the client with the external ID, or
+// brings the existing one, restored from the trash, to the given fields.
+// Applying the same request again changes nothing.
+type ApplyOAuthClientRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExternalId    string                 `protobuf:"bytes,1,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	RedirectUris  []string               `protobuf:"bytes,3,rep,name=redirect_uris,json=redirectUris,proto3" json:"redirect_uris,omitempty"`
+	PkceRequired  bool                   `protobuf:"varint,4,opt,name=pkce_required,json=pkceRequired,proto3" json:"pkce_required,omitempty"` // Always true for public clients
+	AllowedScopes []string               `protobuf:"bytes,5,rep,name=allowed_scopes,json=allowedScopes,proto3" json:"allowed_scopes,omitempty"`
+	Confidential  bool                   `protobuf:"varint,6,opt,name=confidential,proto3" json:"confidential,omitempty"` // Cannot change once the client exists
+	// PEM RSA public key to encrypt the client secret to when the client is
+	// created, see CreateOAuthClientRequest
+	RecipientPublicKey string `protobuf:"bytes,7,opt,name=recipient_public_key,json=recipientPublicKey,proto3" json:"recipient_public_key,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
 
-const file_altalune_v1_oauth_client_proto_rawDesc = "" +
-	"\n" +
-	"\x1ealtalune/v1/oauth_client.proto\x12\valtalune.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\x1a\x18altalune/v1/common.proto\"\xa4\x03\n" +
-	"\vOAuthClient\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
-	"\tclient_id\x18\x03 \x01(\tR\bclientId\x12#\n" +
-	"\rredirect_uris\x18\x04 \x03(\tR\fredirectUris\x12#\n" +
-	"\rpkce_required\x18\x05 \x01(\bR\fpkceRequired\x12\x1d\n" +
-	"\n" +
-	"is_default\x18\x06 \x01(\bR\tisDefault\x12*\n" +
-	"\x11client_secret_set\x18\a \x01(\bR\x0fclientSecretSet\x12%\n" +
-	"\x0eallowed_scopes\x18\b \x03(\tR\rallowedScopes\x12\"\n" +
-	"\fconfidential\x18\t \x01(\bR\fconfidential\x129\n" +
-	"\n" +
-	"created_at\x18b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18c \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xfc\x01\n" +
-	"\x18CreateOAuthClientRequest\x125\n" +
-	"\x04name\x18\x01 \x01(\tB!\xbaH\x1e\xc8\x01\x01r\x19\x10\x01\x18d2\x13^[a-zA-Z0-9\\s\\-_]+$R\x04name\x129\n" +
-	"\rredirect_uris\x18\x02 \x03(\tB\x14\xbaH\x11\x92\x01\x0e\b\x01\x10\n" +
-	"\"\br\x06\x18\xf4\x03\x88\x01\x01R\fredirectUris\x12#\n" +
-	"\rpkce_required\x18\x03 \x01(\bR\fpkceRequired\x12%\n" +
-	"\x0eallowed_scopes\x18\x04 \x03(\tR\rallowedScopes\x12\"\n" +
-	"\fconfidential\x18\x05 \x01(\bR\fconfidential\"\x8c\x01\n" +
-	"\x19CreateOAuthClientResponse\x120\n" +
-	"\x06client\x18\x01 \x01(\v2\x18.altalune.v1.OAuthClientR\x06client\x12#\n" +
-	"\rclient_secret\x18\x02 \x01(\tR\fclientSecret\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"K\n" +
-	"\x18QueryOAuthClientsRequest\x12/\n" +
-	"\x05query\x18\x01 \x01(\v2\x19.altalune.v1.QueryRequestR\x05query\"\x9d\x01\n" +
-	"\x19QueryOAuthClientsResponse\x122\n" +
-	"\aclients\x18\x01 \x03(\v2\x18.altalune.v1.OAuthClientR\aclients\x122\n" +
-	"\x04meta\x18\x02 \x01(\v2\x1e.altalune.v1.QueryMetaResponseR\x04meta\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"4\n" +
-	"\x15GetOAuthClientRequest\x12\x1b\n" +
-	"\x02id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\x02id\"d\n" +
-	"\x16GetOAuthClientResponse\x120\n" +
-	"\x06client\x18\x01 \x01(\v2\x18.altalune.v1.OAuthClientR\x06client\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\xec\x01\n" +
-	"\x18UpdateOAuthClientRequest\x12\x1b\n" +
-	"\x02id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\x02id\x12\"\n" +
-	"\x04name\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dH\x00R\x04name\x88\x01\x01\x12#\n" +
-	"\rredirect_uris\x18\x03 \x03(\tR\fredirectUris\x12(\n" +
-	"\rpkce_required\x18\x04 \x01(\bH\x01R\fpkceRequired\x88\x01\x01\x12%\n" +
-	"\x0eallowed_scopes\x18\x05 \x03(\tR\rallowedScopesB\a\n" +
-	"\x05_nameB\x10\n" +
-	"\x0e_pkce_required\"g\n" +
-	"\x19UpdateOAuthClientResponse\x120\n" +
-	"\x06client\x18\x01 \x01(\v2\x18.altalune.v1.OAuthClientR\x06client\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"7\n" +
-	"\x18DeleteOAuthClientRequest\x12\x1b\n" +
-	"\x02id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\x02id\"5\n" +
-	"\x19DeleteOAuthClientResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"=\n" +
-	"\x1eRevealOAuthClientSecretRequest\x12\x1b\n" +
-	"\x02id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\x02id\"`\n" +
-	"\x1fRevealOAuthClientSecretResponse\x12#\n" +
-	"\rclient_secret\x18\x01 \x01(\tR\fclientSecret\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage2\x81\x05\n" +
-	"\x12OAuthClientService\x12d\n" +
-	"\x11CreateOAuthClient\x12%.altalune.v1.CreateOAuthClientRequest\x1a&.altalune.v1.CreateOAuthClientResponse\"\x00\x12d\n" +
-	"\x11QueryOAuthClients\x12%.altalune.v1.QueryOAuthClientsRequest\x1a&.altalune.v1.QueryOAuthClientsResponse\"\x00\x12[\n" +
-	"\x0eGetOAuthClient\x12\".altalune.v1.GetOAuthClientRequest\x1a#.altalune.v1.GetOAuthClientResponse\"\x00\x12d\n" +
-	"\x11UpdateOAuthClient\x12%.altalune.v1.UpdateOAuthClientRequest\x1a&.altalune.v1.UpdateOAuthClientResponse\"\x00\x12d\n" +
-	"\x11DeleteOAuthClient\x12%.altalune.v1.DeleteOAuthClientRequest\x1a&.altalune.v1.DeleteOAuthClientResponse\"\x00\x12v\n" +
-	"\x17RevealOAuthClientSecret\x12+.altalune.v1.RevealOAuthClientSecretRequest\x1a,.altalune.v1.RevealOAuthClientSecretResponse\"\x00B\xa5\x01\n" +
+func (x *ApplyOAuthClientRequest) Reset() {
+	*x = ApplyOAuthClientRequest{}
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyOAuthClientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyOAuthClientRequest) ProtoMessage() {}
+
+func (x *ApplyOAuthClientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyOAuthClientRequest.ProtoReflect.Descriptor instead.
+func (*ApplyOAuthClientRequest) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_oauth_client_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ApplyOAuthClientRequest) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *ApplyOAuthClientRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApplyOAuthClientRequest) GetRedirectUris() []string {
+	if x != nil {
+		return x.RedirectUris
+	}
+	return nil
+}
+
+func (x *ApplyOAuthClientRequest) GetPkceRequired() bool {
+	if x != nil {
+		return x.PkceRequired
+	}
+	return false
+}
+
+func (x *ApplyOAuthClientRequest) GetAllowedScopes() []string {
+	if x != nil {
+		return x.AllowedScopes
+	}
+	return nil
+}
+
+func (x *ApplyOAuthClientRequest) GetConfidential() bool {
+	if x != nil {
+		return x.Confidential
+	}
+	return false
+}
+
+func (x *ApplyOAuthClientRequest) GetRecipientPublicKey() string {
+	if x != nil {
+		return x.RecipientPublicKey
+	}
+	return ""
+}
+
+type ApplyOAuthClientResponse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Client                *OAuthClient           `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	Created               bool                   `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`                                                           // Whether the client was created rather than updated
+	ClientSecret          string                 `protobuf:"bytes,3,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`                              // ONLY returned when created, empty when delivered
+	DeliveredClientSecret *DeliveredSecret       `protobuf:"bytes,4,opt,name=delivered_client_secret,json=deliveredClientSecret,proto3" json:"delivered_client_secret,omitempty"` // Set instead of client_secret when the policy forbids plaintext
+	Message               string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ApplyOAuthClientResponse) Reset() {
+	*x = ApplyOAuthClientResponse{}
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyOAuthClientResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyOAuthClientResponse) ProtoMessage() {}
+
+func (x *ApplyOAuthClientResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyOAuthClientResponse.ProtoReflect.Descriptor instead.
+func (*ApplyOAuthClientResponse) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_oauth_client_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ApplyOAuthClientResponse) GetClient() *OAuthClient {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *ApplyOAuthClientResponse) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+func (x *ApplyOAuthClientResponse) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+func (x *ApplyOAuthClientResponse) GetDeliveredClientSecret() *DeliveredSecret {
+	if x != nil {
+		return x.DeliveredClientSecret
+	}
+	return nil
+}
+
+func (x *ApplyOAuthClientResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Import OAuth Client Request - sets the external ID of the one live client
+// with the name, so a provisioning tool can manage a client it did not create
+type ImportOAuthClientRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ExternalId    string                 `protobuf:"bytes,2,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportOAuthClientRequest) Reset() {
+	*x = ImportOAuthClientRequest{}
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportOAuthClientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOAuthClientRequest) ProtoMessage() {}
+
+func (x *ImportOAuthClientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportOAuthClientRequest.ProtoReflect.Descriptor instead.
+func (*ImportOAuthClientRequest) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_oauth_client_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ImportOAuthClientRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImportOAuthClientRequest) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+type ImportOAuthClientResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Client        *OAuthClient           `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportOAuthClientResponse) Reset() {
+	*x = ImportOAuthClientResponse{}
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportOAuthClientResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOAuthClientResponse) ProtoMessage() {}
+
+func (x *ImportOAuthClientResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportOAuthClientResponse.ProtoReflect.Descriptor instead.
+func (*ImportOAuthClientResponse) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_oauth_client_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ImportOAuthClientResponse) GetClient() *OAuthClient {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *ImportOAuthClientResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Reveal OAuth Client Secret Request (Global - no project_id needed)
+type RevealOAuthClientSecretRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevealOAuthClientSecretRequest) Reset() {
+	*x = RevealOAuthClientSecretRequest{}
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevealOAuthClientSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevealOAuthClientSecretRequest) ProtoMessage() {}
+
+func (x *RevealOAuthClientSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevealOAuthClientSecretRequest.ProtoReflect.Descriptor instead.
+func (*RevealOAuthClientSecretRequest) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_oauth_client_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RevealOAuthClientSecretRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RevealOAuthClientSecretResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientSecret  string                 `protobuf:"bytes,1,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevealOAuthClientSecretResponse) Reset() {
+	*x = RevealOAuthClientSecretResponse{}
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevealOAuthClientSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevealOAuthClientSecretResponse) ProtoMessage() {}
+
+func (x *RevealOAuthClientSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevealOAuthClientSecretResponse.ProtoReflect.Descriptor instead.
+func (*RevealOAuthClientSecretResponse) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_oauth_client_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RevealOAuthClientSecretResponse) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+func (x *RevealOAuthClientSecretResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type RotateOAuthClientSecretRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// PEM RSA public key to encrypt the new secret to, required when the
+	// default project delivers secrets to public keys
+	RecipientPublicKey string `protobuf:"bytes,2,opt,name=recipient_public_key,json=recipientPublicKey,proto3" json:"recipient_public_key,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RotateOAuthClientSecretRequest) Reset() {
+	*x = RotateOAuthClientSecretRequest{}
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateOAuthClientSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateOAuthClientSecretRequest) ProtoMessage() {}
+
+func (x *RotateOAuthClientSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateOAuthClientSecretRequest.ProtoReflect.Descriptor instead.
+func (*RotateOAuthClientSecretRequest) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_oauth_client_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RotateOAuthClientSecretRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RotateOAuthClientSecretRequest) GetRecipientPublicKey() string {
+	if x != nil {
+		return x.RecipientPublicKey
+	}
+	return ""
+}
+
+type RotateOAuthClientSecretResponse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Client                *OAuthClient           `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	ClientSecret          string                 `protobuf:"bytes,2,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"` // ONLY returned once, empty when delivered
+	Message               string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	DeliveredClientSecret *DeliveredSecret       `protobuf:"bytes,4,opt,name=delivered_client_secret,json=deliveredClientSecret,proto3" json:"delivered_client_secret,omitempty"` // Set instead of client_secret when the policy forbids plaintext
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *RotateOAuthClientSecretResponse) Reset() {
+	*x = RotateOAuthClientSecretResponse{}
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateOAuthClientSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateOAuthClientSecretResponse) ProtoMessage() {}
+
+func (x *RotateOAuthClientSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateOAuthClientSecretResponse.ProtoReflect.Descriptor instead.
+func (*RotateOAuthClientSecretResponse) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_oauth_client_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RotateOAuthClientSecretResponse) GetClient() *OAuthClient {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *RotateOAuthClientSecretResponse) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+func (x *RotateOAuthClientSecretResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RotateOAuthClientSecretResponse) GetDeliveredClientSecret() *DeliveredSecret {
+	if x != nil {
+		return x.DeliveredClientSecret
+	}
+	return nil
+}
+
+// Refresh token issued to an OAuth client. Only a hash of the token is
+// stored, so the token itself cannot be shown.
+type RefreshToken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                      // Identifies the token for revocation
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Public ID of the user
+	UserEmail     string                 `protobuf:"bytes,3,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	ClientId      string                 `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"` // Public nanoid of the client, empty if it was purged
+	ClientName    string                 `protobuf:"bytes,5,opt,name=client_name,json=clientName,proto3" json:"client_name,omitempty"`
+	Scopes        []string               `protobuf:"bytes,6,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	Status        RefreshTokenStatus     `protobuf:"varint,7,opt,name=status,proto3,enum=altalune.v1.RefreshTokenStatus" json:"status,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	ExchangedAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=exchanged_at,json=exchangedAt,proto3" json:"exchanged_at,omitempty"` // Set once exchanged or revoked
+	RevokedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,98,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshToken) Reset() {
+	*x = RefreshToken{}
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshToken) ProtoMessage() {}
+
+func (x *RefreshToken) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshToken.ProtoReflect.Descriptor instead.
+func (*RefreshToken) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_oauth_client_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RefreshToken) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RefreshToken) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RefreshToken) GetUserEmail() string {
+	if x != nil {
+		return x.UserEmail
+	}
+	return ""
+}
+
+func (x *RefreshToken) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *RefreshToken) GetClientName() string {
+	if x != nil {
+		return x.ClientName
+	}
+	return ""
+}
+
+func (x *RefreshToken) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *RefreshToken) GetStatus() RefreshTokenStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RefreshTokenStatus_REFRESH_TOKEN_STATUS_UNSPECIFIED
+}
+
+func (x *RefreshToken) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *RefreshToken) GetExchangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExchangedAt
+	}
+	return nil
+}
+
+func (x *RefreshToken) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+func (x *RefreshToken) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Query Refresh Tokens Request, for the tokens of a user, a client or both.
+// The "statuses" filter takes active, exchanged, revoked and expired; the
+// keyword searches the user email and client name.
+type QueryRefreshTokensRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         *QueryRequest          `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // Public ID of the user
+	ClientId      string                 `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"` // Public nanoid of the client
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryRefreshTokensRequest) Reset() {
+	*x = QueryRefreshTokensRequest{}
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryRefreshTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryRefreshTokensRequest) ProtoMessage() {}
+
+func (x *QueryRefreshTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryRefreshTokensRequest.ProtoReflect.Descriptor instead.
+func (*QueryRefreshTokensRequest) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_oauth_client_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *QueryRefreshTokensRequest) GetQuery() *QueryRequest {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *QueryRefreshTokensRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *QueryRefreshTokensRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+type QueryRefreshTokensResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tokens        []*RefreshToken        `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	Meta          *QueryMetaResponse     `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryRefreshTokensResponse) Reset() {
+	*x = QueryRefreshTokensResponse{}
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryRefreshTokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryRefreshTokensResponse) ProtoMessage() {}
+
+func (x *QueryRefreshTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryRefreshTokensResponse.ProtoReflect.Descriptor instead.
+func (*QueryRefreshTokensResponse) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_oauth_client_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *QueryRefreshTokensResponse) GetTokens() []*RefreshToken {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+func (x *QueryRefreshTokensResponse) GetMeta() *QueryMetaResponse {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *QueryRefreshTokensResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Revoke Refresh Token Request. Revoking a token that is no longer active
+// leaves it unchanged.
+type RevokeRefreshTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeRefreshTokenRequest) Reset() {
+	*x = RevokeRefreshTokenRequest{}
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeRefreshTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeRefreshTokenRequest) ProtoMessage() {}
+
+func (x *RevokeRefreshTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeRefreshTokenRequest.ProtoReflect.Descriptor instead.
+func (*RevokeRefreshTokenRequest) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_oauth_client_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RevokeRefreshTokenRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RevokeRefreshTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         *RefreshToken          `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Revoked       bool                   `protobuf:"varint,2,opt,name=revoked,proto3" json:"revoked,omitempty"` // False when the token was no longer active
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeRefreshTokenResponse) Reset() {
+	*x = RevokeRefreshTokenResponse{}
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeRefreshTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeRefreshTokenResponse) ProtoMessage() {}
+
+func (x *RevokeRefreshTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeRefreshTokenResponse.ProtoReflect.Descriptor instead.
+func (*RevokeRefreshTokenResponse) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_oauth_client_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RevokeRefreshTokenResponse) GetToken() *RefreshToken {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *RevokeRefreshTokenResponse) GetRevoked() bool {
+	if x != nil {
+		return x.Revoked
+	}
+	return false
+}
+
+func (x *RevokeRefreshTokenResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Token endpoint requests of a client in an hour
+type TokenStatsBucket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hour          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=hour,proto3" json:"hour,omitempty"`      // Start of the hour
+	Issued        int64                  `protobuf:"varint,2,opt,name=issued,proto3" json:"issued,omitempty"` // Requests that issued tokens
+	Failed        int64                  `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"` // Requests that were rejected
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TokenStatsBucket) Reset() {
+	*x = TokenStatsBucket{}
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenStatsBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenStatsBucket) ProtoMessage() {}
+
+func (x *TokenStatsBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenStatsBucket.ProtoReflect.Descriptor instead.
+func (*TokenStatsBucket) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_oauth_client_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TokenStatsBucket) GetHour() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Hour
+	}
+	return nil
+}
+
+func (x *TokenStatsBucket) GetIssued() int64 {
+	if x != nil {
+		return x.Issued
+	}
+	return 0
+}
+
+func (x *TokenStatsBucket) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+// Get OAuth Client Token Stats Request, for charting the token requests of a
+// client over the last hours
+type GetOAuthClientTokenStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Hours         int32                  `protobuf:"varint,2,opt,name=hours,proto3" json:"hours,omitempty"` // Defaults to 24
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOAuthClientTokenStatsRequest) Reset() {
+	*x = GetOAuthClientTokenStatsRequest{}
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOAuthClientTokenStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOAuthClientTokenStatsRequest) ProtoMessage() {}
+
+func (x *GetOAuthClientTokenStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOAuthClientTokenStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetOAuthClientTokenStatsRequest) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_oauth_client_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetOAuthClientTokenStatsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetOAuthClientTokenStatsRequest) GetHours() int32 {
+	if x != nil {
+		return x.Hours
+	}
+	return 0
+}
+
+type GetOAuthClientTokenStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Buckets       []*TokenStatsBucket    `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"` // One per hour, oldest first, the current hour last
+	TotalIssued   int64                  `protobuf:"varint,2,opt,name=total_issued,json=totalIssued,proto3" json:"total_issued,omitempty"`
+	TotalFailed   int64                  `protobuf:"varint,3,opt,name=total_failed,json=totalFailed,proto3" json:"total_failed,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOAuthClientTokenStatsResponse) Reset() {
+	*x = GetOAuthClientTokenStatsResponse{}
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOAuthClientTokenStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOAuthClientTokenStatsResponse) ProtoMessage() {}
+
+func (x *GetOAuthClientTokenStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOAuthClientTokenStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetOAuthClientTokenStatsResponse) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_oauth_client_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetOAuthClientTokenStatsResponse) GetBuckets() []*TokenStatsBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+func (x *GetOAuthClientTokenStatsResponse) GetTotalIssued() int64 {
+	if x != nil {
+		return x.TotalIssued
+	}
+	return 0
+}
+
+func (x *GetOAuthClientTokenStatsResponse) GetTotalFailed() int64 {
+	if x != nil {
+		return x.TotalFailed
+	}
+	return 0
+}
+
+func (x *GetOAuthClientTokenStatsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Access token claims of a client, slimmed for gateways limiting header
+// sizes. Tokens leaving permissions out set perms_omitted or perms_overflow,
+// or carry roles; introspection returns all of them.
+type OAuthClientTokenClaims struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	PermsClaim         PermsClaimMode         `protobuf:"varint,1,opt,name=perms_claim,json=permsClaim,proto3,enum=altalune.v1.PermsClaimMode" json:"perms_claim,omitempty"`
+	PermsClaimMaxBytes int32                  `protobuf:"varint,2,opt,name=perms_claim_max_bytes,json=permsClaimMaxBytes,proto3" json:"perms_claim_max_bytes,omitempty"` // Cap on the perms claim, cut with perms_overflow set; 0 for none
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *OAuthClientTokenClaims) Reset() {
+	*x = OAuthClientTokenClaims{}
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OAuthClientTokenClaims) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OAuthClientTokenClaims) ProtoMessage() {}
+
+func (x *OAuthClientTokenClaims) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OAuthClientTokenClaims.ProtoReflect.Descriptor instead.
+func (*OAuthClientTokenClaims) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_oauth_client_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *OAuthClientTokenClaims) GetPermsClaim() PermsClaimMode {
+	if x != nil {
+		return x.PermsClaim
+	}
+	return PermsClaimMode_PERMS_CLAIM_MODE_UNSPECIFIED
+}
+
+func (x *OAuthClientTokenClaims) GetPermsClaimMaxBytes() int32 {
+	if x != nil {
+		return x.PermsClaimMaxBytes
+	}
+	return 0
+}
+
+type GetOAuthClientTokenClaimsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOAuthClientTokenClaimsRequest) Reset() {
+	*x = GetOAuthClientTokenClaimsRequest{}
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOAuthClientTokenClaimsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOAuthClientTokenClaimsRequest) ProtoMessage() {}
+
+func (x *GetOAuthClientTokenClaimsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOAuthClientTokenClaimsRequest.ProtoReflect.Descriptor instead.
+func (*GetOAuthClientTokenClaimsRequest) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_oauth_client_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetOAuthClientTokenClaimsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetOAuthClientTokenClaimsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	TokenClaims   *OAuthClientTokenClaims `protobuf:"bytes,1,opt,name=token_claims,json=tokenClaims,proto3" json:"token_claims,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOAuthClientTokenClaimsResponse) Reset() {
+	*x = GetOAuthClientTokenClaimsResponse{}
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOAuthClientTokenClaimsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOAuthClientTokenClaimsResponse) ProtoMessage() {}
+
+func (x *GetOAuthClientTokenClaimsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOAuthClientTokenClaimsResponse.ProtoReflect.Descriptor instead.
+func (*GetOAuthClientTokenClaimsResponse) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_oauth_client_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetOAuthClientTokenClaimsResponse) GetTokenClaims() *OAuthClientTokenClaims {
+	if x != nil {
+		return x.TokenClaims
+	}
+	return nil
+}
+
+// Update OAuth Client Token Claims Request. The default dashboard client
+// reads permissions from its tokens, so it keeps them all.
+type UpdateOAuthClientTokenClaimsRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PermsClaim         PermsClaimMode         `protobuf:"varint,2,opt,name=perms_claim,json=permsClaim,proto3,enum=altalune.v1.PermsClaimMode" json:"perms_claim,omitempty"` // Unspecified is full
+	PermsClaimMaxBytes int32                  `protobuf:"varint,3,opt,name=perms_claim_max_bytes,json=permsClaimMaxBytes,proto3" json:"perms_claim_max_bytes,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *UpdateOAuthClientTokenClaimsRequest) Reset() {
+	*x = UpdateOAuthClientTokenClaimsRequest{}
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOAuthClientTokenClaimsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOAuthClientTokenClaimsRequest) ProtoMessage() {}
+
+func (x *UpdateOAuthClientTokenClaimsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOAuthClientTokenClaimsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOAuthClientTokenClaimsRequest) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_oauth_client_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UpdateOAuthClientTokenClaimsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateOAuthClientTokenClaimsRequest) GetPermsClaim() PermsClaimMode {
+	if x != nil {
+		return x.PermsClaim
+	}
+	return PermsClaimMode_PERMS_CLAIM_MODE_UNSPECIFIED
+}
+
+func (x *UpdateOAuthClientTokenClaimsRequest) GetPermsClaimMaxBytes() int32 {
+	if x != nil {
+		return x.PermsClaimMaxBytes
+	}
+	return 0
+}
+
+type UpdateOAuthClientTokenClaimsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	TokenClaims   *OAuthClientTokenClaims `protobuf:"bytes,1,opt,name=token_claims,json=tokenClaims,proto3" json:"token_claims,omitempty"`
+	Message       string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOAuthClientTokenClaimsResponse) Reset() {
+	*x = UpdateOAuthClientTokenClaimsResponse{}
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOAuthClientTokenClaimsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOAuthClientTokenClaimsResponse) ProtoMessage() {}
+
+func (x *UpdateOAuthClientTokenClaimsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOAuthClientTokenClaimsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateOAuthClientTokenClaimsResponse) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_oauth_client_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UpdateOAuthClientTokenClaimsResponse) GetTokenClaims() *OAuthClientTokenClaims {
+	if x != nil {
+		return x.TokenClaims
+	}
+	return nil
+}
+
+func (x *UpdateOAuthClientTokenClaimsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Test Authorization Flow Request. The flow runs as a synthetic user of a
+// sandbox project: nothing is stored, and no usable token is issued.
+type TestAuthorizationFlowRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId   string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Id          string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	RedirectUri string                 `protobuf:"bytes,3,opt,name=redirect_uri,json=redirectUri,proto3" json:"redirect_uri,omitempty"`
+	Scope       string                 `protobuf:"bytes,4,opt,name=scope,proto3" json:"scope,omitempty"` // Space-separated, empty for openid
+	// PKCE method the client application uses, empty when it sends no
+	// code_challenge
+	CodeChallengeMethod string `protobuf:"bytes,5,opt,name=code_challenge_method,json=codeChallengeMethod,proto3" json:"code_challenge_method,omitempty"`
+	ClientSecret        string `protobuf:"bytes,6,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"` // Sent by confidential clients only
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *TestAuthorizationFlowRequest) Reset() {
+	*x = TestAuthorizationFlowRequest{}
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestAuthorizationFlowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestAuthorizationFlowRequest) ProtoMessage() {}
+
+func (x *TestAuthorizationFlowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestAuthorizationFlowRequest.ProtoReflect.Descriptor instead.
+func (*TestAuthorizationFlowRequest) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_oauth_client_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *TestAuthorizationFlowRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *TestAuthorizationFlowRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TestAuthorizationFlowRequest) GetRedirectUri() string {
+	if x != nil {
+		return x.RedirectUri
+	}
+	return ""
+}
+
+func (x *TestAuthorizationFlowRequest) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *TestAuthorizationFlowRequest) GetCodeChallengeMethod() string {
+	if x != nil {
+		return x.CodeChallengeMethod
+	}
+	return ""
+}
+
+func (x *TestAuthorizationFlowRequest) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+type AuthorizationFlowStep struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Name          string                       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // e.g. redirect_uri, pkce, code_exchange
+	Outcome       AuthorizationFlowStepOutcome `protobuf:"varint,2,opt,name=outcome,proto3,enum=altalune.v1.AuthorizationFlowStepOutcome" json:"outcome,omitempty"`
+	Detail        string                       `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
+	Error         string                       `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"` // OAuth error the real flow answers with, e.g. invalid_grant
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthorizationFlowStep) Reset() {
+	*x = AuthorizationFlowStep{}
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorizationFlowStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizationFlowStep) ProtoMessage() {}
+
+func (x *AuthorizationFlowStep) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizationFlowStep.ProtoReflect.Descriptor instead.
+func (*AuthorizationFlowStep) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_oauth_client_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *AuthorizationFlowStep) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AuthorizationFlowStep) GetOutcome() AuthorizationFlowStepOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return AuthorizationFlowStepOutcome_AUTHORIZATION_FLOW_STEP_OUTCOME_UNSPECIFIED
+}
+
+func (x *AuthorizationFlowStep) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *AuthorizationFlowStep) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type TestAuthorizationFlowResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Succeeded     bool                     `protobuf:"varint,1,opt,name=succeeded,proto3" json:"succeeded,omitempty"` // Whether a real flow would issue tokens
+	Steps         []*AuthorizationFlowStep `protobuf:"bytes,2,rep,name=steps,proto3" json:"steps,omitempty"`
+	Claims        []string                 `protobuf:"bytes,3,rep,name=claims,proto3" json:"claims,omitempty"` // Names of the access token claims a user would get
+	Message       string                   `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestAuthorizationFlowResponse) Reset() {
+	*x = TestAuthorizationFlowResponse{}
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestAuthorizationFlowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestAuthorizationFlowResponse) ProtoMessage() {}
+
+func (x *TestAuthorizationFlowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_oauth_client_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestAuthorizationFlowResponse.ProtoReflect.Descriptor instead.
+func (*TestAuthorizationFlowResponse) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_oauth_client_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *TestAuthorizationFlowResponse) GetSucceeded() bool {
+	if x != nil {
+		return x.Succeeded
+	}
+	return false
+}
+
+func (x *TestAuthorizationFlowResponse) GetSteps() []*AuthorizationFlowStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *TestAuthorizationFlowResponse) GetClaims() []string {
+	if x != nil {
+		return x.Claims
+	}
+	return nil
+}
+
+func (x *TestAuthorizationFlowResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_altalune_v1_oauth_client_proto protoreflect.FileDescriptor
+
+const file_altalune_v1_oauth_client_proto_rawDesc = "" +
+	"\n" +
+	"\x1ealtalune/v1/oauth_client.proto\x12\valtalune.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\x1a\x18altalune/v1/common.proto\x1a\x19altalune/v1/options.proto\"\x93\x05\n" +
+	"\vOAuthClient\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tclient_id\x18\x03 \x01(\tR\bclientId\x12#\n" +
+	"\rredirect_uris\x18\x04 \x03(\tR\fredirectUris\x12#\n" +
+	"\rpkce_required\x18\x05 \x01(\bR\fpkceRequired\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\x06 \x01(\bR\tisDefault\x12*\n" +
+	"\x11client_secret_set\x18\a \x01(\bR\x0fclientSecretSet\x12%\n" +
+	"\x0eallowed_scopes\x18\b \x03(\tR\rallowedScopes\x12\"\n" +
+	"\fconfidential\x18\t \x01(\bR\fconfidential\x129\n" +
+	"\n" +
+	"deleted_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\v \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\f \x01(\tR\tupdatedBy\x12\x1f\n" +
+	"\vexternal_id\x18\r \x01(\tR\n" +
+	"externalId\x12S\n" +
+	"\x18client_secret_expires_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\x15clientSecretExpiresAt\x129\n" +
+	"\n" +
+	"created_at\x18b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18c \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xfb\x02\n" +
+	"\x18CreateOAuthClientRequest\x125\n" +
+	"\x04name\x18\x01 \x01(\tB!\xbaH\x1e\xc8\x01\x01r\x19\x10\x01\x18d2\x13^[a-zA-Z0-9\\s\\-_]+$R\x04name\x129\n" +
+	"\rredirect_uris\x18\x02 \x03(\tB\x14\xbaH\x11\x92\x01\x0e\b\x01\x10\n" +
+	"\"\br\x06\x18\xf4\x03\x88\x01\x01R\fredirectUris\x12#\n" +
+	"\rpkce_required\x18\x03 \x01(\bR\fpkceRequired\x12%\n" +
+	"\x0eallowed_scopes\x18\x04 \x03(\tR\rallowedScopes\x12\"\n" +
+	"\fconfidential\x18\x05 \x01(\bR\fconfidential\x12:\n" +
+	"\x14recipient_public_key\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x18\x80 R\x12recipientPublicKey\x12A\n" +
+	"\vexternal_id\x18\a \x01(\tB \xbaH\x1d\xd8\x01\x01r\x18\x18d2\x14^[A-Za-z0-9._:/@-]+$R\n" +
+	"externalId\"\xe2\x01\n" +
+	"\x19CreateOAuthClientResponse\x120\n" +
+	"\x06client\x18\x01 \x01(\v2\x18.altalune.v1.OAuthClientR\x06client\x12#\n" +
+	"\rclient_secret\x18\x02 \x01(\tR\fclientSecret\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12T\n" +
+	"\x17delivered_client_secret\x18\x04 \x01(\v2\x1c.altalune.v1.DeliveredSecretR\x15deliveredClientSecret\"e\n" +
+	"\x18QueryOAuthClientsRequest\x12/\n" +
+	"\x05query\x18\x01 \x01(\v2\x19.altalune.v1.QueryRequestR\x05query\x12\x18\n" +
+	"\atrashed\x18\x02 \x01(\bR\atrashed\"\x9d\x01\n" +
+	"\x19QueryOAuthClientsResponse\x122\n" +
+	"\aclients\x18\x01 \x03(\v2\x18.altalune.v1.OAuthClientR\aclients\x122\n" +
+	"\x04meta\x18\x02 \x01(\v2\x1e.altalune.v1.QueryMetaResponseR\x04meta\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"4\n" +
+	"\x15GetOAuthClientRequest\x12\x1b\n" +
+	"\x02id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\x02id\"d\n" +
+	"\x16GetOAuthClientResponse\x120\n" +
+	"\x06client\x18\x01 \x01(\v2\x18.altalune.v1.OAuthClientR\x06client\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xb8\x02\n" +
+	"\x18UpdateOAuthClientRequest\x12\x1b\n" +
+	"\x02id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\x02id\x12\"\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dH\x00R\x04name\x88\x01\x01\x12#\n" +
+	"\rredirect_uris\x18\x03 \x03(\tR\fredirectUris\x12(\n" +
+	"\rpkce_required\x18\x04 \x01(\bH\x01R\fpkceRequired\x88\x01\x01\x12%\n" +
+	"\x0eallowed_scopes\x18\x05 \x03(\tR\rallowedScopes\x12J\n" +
+	"\x13expected_updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x11expectedUpdatedAtB\a\n" +
+	"\x05_nameB\x10\n" +
+	"\x0e_pkce_required\"g\n" +
+	"\x19UpdateOAuthClientResponse\x120\n" +
+	"\x06client\x18\x01 \x01(\v2\x18.altalune.v1.OAuthClientR\x06client\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"7\n" +
+	"\x18DeleteOAuthClientRequest\x12\x1b\n" +
+	"\x02id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\x02id\"5\n" +
+	"\x19DeleteOAuthClientResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"8\n" +
+	"\x19RestoreOAuthClientRequest\x12\x1b\n" +
+	"\x02id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\x02id\"h\n" +
+	"\x1aRestoreOAuthClientResponse\x120\n" +
+	"\x06client\x18\x01 \x01(\v2\x18.altalune.v1.OAuthClientR\x06client\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xfa\x02\n" +
+	"\x17ApplyOAuthClientRequest\x12A\n" +
+	"\vexternal_id\x18\x01 \x01(\tB \xbaH\x1d\xc8\x01\x01r\x18\x18d2\x14^[A-Za-z0-9._:/@-]+$R\n" +
+	"externalId\x125\n" +
+	"\x04name\x18\x02 \x01(\tB!\xbaH\x1e\xc8\x01\x01r\x19\x10\x01\x18d2\x13^[a-zA-Z0-9\\s\\-_]+$R\x04name\x129\n" +
+	"\rredirect_uris\x18\x03 \x03(\tB\x14\xbaH\x11\x92\x01\x0e\b\x01\x10\n" +
+	"\"\br\x06\x18\xf4\x03\x88\x01\x01R\fredirectUris\x12#\n" +
+	"\rpkce_required\x18\x04 \x01(\bR\fpkceRequired\x12%\n" +
+	"\x0eallowed_scopes\x18\x05 \x03(\tR\rallowedScopes\x12\"\n" +
+	"\fconfidential\x18\x06 \x01(\bR\fconfidential\x12:\n" +
+	"\x14recipient_public_key\x18\a \x01(\tB\b\xbaH\x05r\x03\x18\x80 R\x12recipientPublicKey\"\xfb\x01\n" +
+	"\x18ApplyOAuthClientResponse\x120\n" +
+	"\x06client\x18\x01 \x01(\v2\x18.altalune.v1.OAuthClientR\x06client\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\bR\acreated\x12#\n" +
+	"\rclient_secret\x18\x03 \x01(\tR\fclientSecret\x12T\n" +
+	"\x17delivered_client_secret\x18\x04 \x01(\v2\x1c.altalune.v1.DeliveredSecretR\x15deliveredClientSecret\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\"\x7f\n" +
+	"\x18ImportOAuthClientRequest\x12 \n" +
+	"\x04name\x18\x01 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x01\x18dR\x04name\x12A\n" +
+	"\vexternal_id\x18\x02 \x01(\tB \xbaH\x1d\xc8\x01\x01r\x18\x18d2\x14^[A-Za-z0-9._:/@-]+$R\n" +
+	"externalId\"g\n" +
+	"\x19ImportOAuthClientResponse\x120\n" +
+	"\x06client\x18\x01 \x01(\v2\x18.altalune.v1.OAuthClientR\x06client\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"=\n" +
+	"\x1eRevealOAuthClientSecretRequest\x12\x1b\n" +
+	"\x02id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\x02id\"`\n" +
+	"\x1fRevealOAuthClientSecretResponse\x12#\n" +
+	"\rclient_secret\x18\x01 \x01(\tR\fclientSecret\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"y\n" +
+	"\x1eRotateOAuthClientSecretRequest\x12\x1b\n" +
+	"\x02id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\x02id\x12:\n" +
+	"\x14recipient_public_key\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\x80 R\x12recipientPublicKey\"\xe8\x01\n" +
+	"\x1fRotateOAuthClientSecretResponse\x120\n" +
+	"\x06client\x18\x01 \x01(\v2\x18.altalune.v1.OAuthClientR\x06client\x12#\n" +
+	"\rclient_secret\x18\x02 \x01(\tR\fclientSecret\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12T\n" +
+	"\x17delivered_client_secret\x18\x04 \x01(\v2\x1c.altalune.v1.DeliveredSecretR\x15deliveredClientSecret\"\xd5\x03\n" +
+	"\fRefreshToken\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"user_email\x18\x03 \x01(\tR\tuserEmail\x12\x1b\n" +
+	"\tclient_id\x18\x04 \x01(\tR\bclientId\x12\x1f\n" +
+	"\vclient_name\x18\x05 \x01(\tR\n" +
+	"clientName\x12\x16\n" +
+	"\x06scopes\x18\x06 \x03(\tR\x06scopes\x127\n" +
+	"\x06status\x18\a \x01(\x0e2\x1f.altalune.v1.RefreshTokenStatusR\x06status\x129\n" +
+	"\n" +
+	"expires_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12=\n" +
+	"\fexchanged_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vexchangedAt\x129\n" +
+	"\n" +
+	"revoked_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\trevokedAt\x129\n" +
+	"\n" +
+	"created_at\x18b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x9c\x01\n" +
+	"\x19QueryRefreshTokensRequest\x127\n" +
+	"\x05query\x18\x01 \x01(\v2\x19.altalune.v1.QueryRequestB\x06\xbaH\x03\xc8\x01\x01R\x05query\x12 \n" +
+	"\auser_id\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x18\x14R\x06userId\x12$\n" +
+	"\tclient_id\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x18\x0eR\bclientId\"\x9d\x01\n" +
+	"\x1aQueryRefreshTokensResponse\x121\n" +
+	"\x06tokens\x18\x01 \x03(\v2\x19.altalune.v1.RefreshTokenR\x06tokens\x122\n" +
+	"\x04meta\x18\x02 \x01(\v2\x1e.altalune.v1.QueryMetaResponseR\x04meta\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"4\n" +
+	"\x19RevokeRefreshTokenRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\"\x81\x01\n" +
+	"\x1aRevokeRefreshTokenResponse\x12/\n" +
+	"\x05token\x18\x01 \x01(\v2\x19.altalune.v1.RefreshTokenR\x05token\x12\x18\n" +
+	"\arevoked\x18\x02 \x01(\bR\arevoked\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"r\n" +
+	"\x10TokenStatsBucket\x12.\n" +
+	"\x04hour\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04hour\x12\x16\n" +
+	"\x06issued\x18\x02 \x01(\x03R\x06issued\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x03R\x06failed\"`\n" +
+	"\x1fGetOAuthClientTokenStatsRequest\x12\x1b\n" +
+	"\x02id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\x02id\x12 \n" +
+	"\x05hours\x18\x02 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xd0\x05(\x00R\x05hours\"\xbb\x01\n" +
+	" GetOAuthClientTokenStatsResponse\x127\n" +
+	"\abuckets\x18\x01 \x03(\v2\x1d.altalune.v1.TokenStatsBucketR\abuckets\x12!\n" +
+	"\ftotal_issued\x18\x02 \x01(\x03R\vtotalIssued\x12!\n" +
+	"\ftotal_failed\x18\x03 \x01(\x03R\vtotalFailed\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\x89\x01\n" +
+	"\x16OAuthClientTokenClaims\x12<\n" +
+	"\vperms_claim\x18\x01 \x01(\x0e2\x1b.altalune.v1.PermsClaimModeR\n" +
+	"permsClaim\x121\n" +
+	"\x15perms_claim_max_bytes\x18\x02 \x01(\x05R\x12permsClaimMaxBytes\"?\n" +
+	" GetOAuthClientTokenClaimsRequest\x12\x1b\n" +
+	"\x02id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\x02id\"k\n" +
+	"!GetOAuthClientTokenClaimsResponse\x12F\n" +
+	"\ftoken_claims\x18\x01 \x01(\v2#.altalune.v1.OAuthClientTokenClaimsR\vtokenClaims\"\xca\x01\n" +
+	"#UpdateOAuthClientTokenClaimsRequest\x12\x1b\n" +
+	"\x02id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\x02id\x12F\n" +
+	"\vperms_claim\x18\x02 \x01(\x0e2\x1b.altalune.v1.PermsClaimModeB\b\xbaH\x05\x82\x01\x02\x10\x01R\n" +
+	"permsClaim\x12>\n" +
+	"\x15perms_claim_max_bytes\x18\x03 \x01(\x05B\v\xbaH\b\x1a\x06\x18\x80\x80\x04(\x00R\x12permsClaimMaxBytes\"\x88\x01\n" +
+	"$UpdateOAuthClientTokenClaimsResponse\x12F\n" +
+	"\ftoken_claims\x18\x01 \x01(\v2#.altalune.v1.OAuthClientTokenClaimsR\vtokenClaims\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xb0\x02\n" +
+	"\x1cTestAuthorizationFlowRequest\x12*\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\tprojectId\x12\x1b\n" +
+	"\x02id\x18\x02 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\x02id\x12.\n" +
+	"\fredirect_uri\x18\x03 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x18\xf4\x03R\vredirectUri\x12\x1e\n" +
+	"\x05scope\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\xf4\x03R\x05scope\x12H\n" +
+	"\x15code_challenge_method\x18\x05 \x01(\tB\x14\xbaH\x11r\x0fR\x00R\x04S256R\x05plainR\x13codeChallengeMethod\x12-\n" +
+	"\rclient_secret\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x01R\fclientSecret\"\x9e\x01\n" +
+	"\x15AuthorizationFlowStep\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12C\n" +
+	"\aoutcome\x18\x02 \x01(\x0e2).altalune.v1.AuthorizationFlowStepOutcomeR\aoutcome\x12\x16\n" +
+	"\x06detail\x18\x03 \x01(\tR\x06detail\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\xa9\x01\n" +
+	"\x1dTestAuthorizationFlowResponse\x12\x1c\n" +
+	"\tsucceeded\x18\x01 \x01(\bR\tsucceeded\x128\n" +
+	"\x05steps\x18\x02 \x03(\v2\".altalune.v1.AuthorizationFlowStepR\x05steps\x12\x16\n" +
+	"\x06claims\x18\x03 \x03(\tR\x06claims\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage*\xc3\x01\n" +
+	"\x12RefreshTokenStatus\x12$\n" +
+	" REFRESH_TOKEN_STATUS_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bREFRESH_TOKEN_STATUS_ACTIVE\x10\x01\x12\"\n" +
+	"\x1eREFRESH_TOKEN_STATUS_EXCHANGED\x10\x02\x12 \n" +
+	"\x1cREFRESH_TOKEN_STATUS_REVOKED\x10\x03\x12 \n" +
+	"\x1cREFRESH_TOKEN_STATUS_EXPIRED\x10\x04*\x84\x01\n" +
+	"\x0ePermsClaimMode\x12 \n" +
+	"\x1cPERMS_CLAIM_MODE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15PERMS_CLAIM_MODE_FULL\x10\x01\x12\x19\n" +
+	"\x15PERMS_CLAIM_MODE_OMIT\x10\x02\x12\x1a\n" +
+	"\x16PERMS_CLAIM_MODE_ROLES\x10\x03*\x81\x02\n" +
+	"\x1cAuthorizationFlowStepOutcome\x12/\n" +
+	"+AUTHORIZATION_FLOW_STEP_OUTCOME_UNSPECIFIED\x10\x00\x12*\n" +
+	"&AUTHORIZATION_FLOW_STEP_OUTCOME_PASSED\x10\x01\x12+\n" +
+	"'AUTHORIZATION_FLOW_STEP_OUTCOME_WARNING\x10\x02\x12*\n" +
+	"&AUTHORIZATION_FLOW_STEP_OUTCOME_FAILED\x10\x03\x12+\n" +
+	"'AUTHORIZATION_FLOW_STEP_OUTCOME_SKIPPED\x10\x042\xf1\x0f\n" +
+	"\x12OAuthClientService\x12t\n" +
+	"\x11CreateOAuthClient\x12%.altalune.v1.CreateOAuthClientRequest\x1a&.altalune.v1.CreateOAuthClientResponse\"\x10\x8a\xb5\x18\fclient:write\x12s\n" +
+	"\x11QueryOAuthClients\x12%.altalune.v1.QueryOAuthClientsRequest\x1a&.altalune.v1.QueryOAuthClientsResponse\"\x0f\x8a\xb5\x18\vclient:read\x12j\n" +
+	"\x0eGetOAuthClient\x12\".altalune.v1.GetOAuthClientRequest\x1a#.altalune.v1.GetOAuthClientResponse\"\x0f\x8a\xb5\x18\vclient:read\x12t\n" +
+	"\x11UpdateOAuthClient\x12%.altalune.v1.UpdateOAuthClientRequest\x1a&.altalune.v1.UpdateOAuthClientResponse\"\x10\x8a\xb5\x18\fclient:write\x12u\n" +
+	"\x11DeleteOAuthClient\x12%.altalune.v1.DeleteOAuthClientRequest\x1a&.altalune.v1.DeleteOAuthClientResponse\"\x11\x8a\xb5\x18\rclient:delete\x12x\n" +
+	"\x12RestoreOAuthClient\x12&.altalune.v1.RestoreOAuthClientRequest\x1a'.altalune.v1.RestoreOAuthClientResponse\"\x11\x8a\xb5\x18\rclient:delete\x12\x85\x01\n" +
+	"\x17RevealOAuthClientSecret\x12+.altalune.v1.RevealOAuthClientSecretRequest\x1a,.altalune.v1.RevealOAuthClientSecretResponse\"\x0f\x8a\xb5\x18\vclient:read\x12\x86\x01\n" +
+	"\x17RotateOAuthClientSecret\x12+.altalune.v1.RotateOAuthClientSecretRequest\x1a,.altalune.v1.RotateOAuthClientSecretResponse\"\x10\x8a\xb5\x18\fclient:write\x12v\n" +
+	"\x12QueryRefreshTokens\x12&.altalune.v1.QueryRefreshTokensRequest\x1a'.altalune.v1.QueryRefreshTokensResponse\"\x0f\x8a\xb5\x18\vclient:read\x12w\n" +
+	"\x12RevokeRefreshToken\x12&.altalune.v1.RevokeRefreshTokenRequest\x1a'.altalune.v1.RevokeRefreshTokenResponse\"\x10\x8a\xb5\x18\fclient:write\x12\x88\x01\n" +
+	"\x18GetOAuthClientTokenStats\x12,.altalune.v1.GetOAuthClientTokenStatsRequest\x1a-.altalune.v1.GetOAuthClientTokenStatsResponse\"\x0f\x8a\xb5\x18\vclient:read\x12\x8b\x01\n" +
+	"\x19GetOAuthClientTokenClaims\x12-.altalune.v1.GetOAuthClientTokenClaimsRequest\x1a..altalune.v1.GetOAuthClientTokenClaimsResponse\"\x0f\x8a\xb5\x18\vclient:read\x12\x95\x01\n" +
+	"\x1cUpdateOAuthClientTokenClaims\x120.altalune.v1.UpdateOAuthClientTokenClaimsRequest\x1a1.altalune.v1.UpdateOAuthClientTokenClaimsResponse\"\x10\x8a\xb5\x18\fclient:write\x12q\n" +
+	"\x10ApplyOAuthClient\x12$.altalune.v1.ApplyOAuthClientRequest\x1a%.altalune.v1.ApplyOAuthClientResponse\"\x10\x8a\xb5\x18\fclient:write\x12t\n" +
+	"\x11ImportOAuthClient\x12%.altalune.v1.ImportOAuthClientRequest\x1a&.altalune.v1.ImportOAuthClientResponse\"\x10\x8a\xb5\x18\fclient:write\x12\x80\x01\n" +
+	"\x15TestAuthorizationFlow\x12).altalune.v1.TestAuthorizationFlowRequest\x1a*.altalune.v1.TestAuthorizationFlowResponse\"\x10\x8a\xb5\x18\fclient:writeB\xa5\x01\n" +
 	"\x0fcom.altalune.v1B\x10OauthClientProtoP\x01Z3github.com/hrz8/altalune/gen/altalune/v1;altalunev1\xa2\x02\x03AXX\xaa\x02\vAltalune.V1\xca\x02\vAltalune\\V1\xe2\x02\x17Altalune\\V1\\GPBMetadata\xea\x02\fAltalune::V1b\x06proto3"
 
 var (
@@ -887,51 +2810,127 @@ func file_altalune_v1_oauth_client_proto_rawDescGZIP() []byte {
 	return file_altalune_v1_oauth_client_proto_rawDescData
 }
 
-var file_altalune_v1_oauth_client_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_altalune_v1_oauth_client_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_altalune_v1_oauth_client_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
 var file_altalune_v1_oauth_client_proto_goTypes = []any{
-	(*OAuthClient)(nil),                     // 0: altalune.v1.OAuthClient
-	(*CreateOAuthClientRequest)(nil),        // 1: altalune.v1.CreateOAuthClientRequest
-	(*CreateOAuthClientResponse)(nil),       // 2: altalune.v1.CreateOAuthClientResponse
-	(*QueryOAuthClientsRequest)(nil),        // 3: altalune.v1.QueryOAuthClientsRequest
-	(*QueryOAuthClientsResponse)(nil),       // 4: altalune.v1.QueryOAuthClientsResponse
-	(*GetOAuthClientRequest)(nil),           // 5: altalune.v1.GetOAuthClientRequest
-	(*GetOAuthClientResponse)(nil),          // 6: altalune.v1.GetOAuthClientResponse
-	(*UpdateOAuthClientRequest)(nil),        // 7: altalune.v1.UpdateOAuthClientRequest
-	(*UpdateOAuthClientResponse)(nil),       // 8: altalune.v1.UpdateOAuthClientResponse
-	(*DeleteOAuthClientRequest)(nil),        // 9: altalune.v1.DeleteOAuthClientRequest
-	(*DeleteOAuthClientResponse)(nil),       // 10: altalune.v1.DeleteOAuthClientResponse
-	(*RevealOAuthClientSecretRequest)(nil),  // 11: altalune.v1.RevealOAuthClientSecretRequest
-	(*RevealOAuthClientSecretResponse)(nil), // 12: altalune.v1.RevealOAuthClientSecretResponse
-	(*timestamppb.Timestamp)(nil),           // 13: google.protobuf.Timestamp
-	(*QueryRequest)(nil),                    // 14: altalune.v1.QueryRequest
-	(*QueryMetaResponse)(nil),               // 15: altalune.v1.QueryMetaResponse
+	(RefreshTokenStatus)(0),                      // 0: altalune.v1.RefreshTokenStatus
+	(PermsClaimMode)(0),                          // 1: altalune.v1.PermsClaimMode
+	(AuthorizationFlowStepOutcome)(0),            // 2: altalune.v1.AuthorizationFlowStepOutcome
+	(*OAuthClient)(nil),                          // 3: altalune.v1.OAuthClient
+	(*CreateOAuthClientRequest)(nil),             // 4: altalune.v1.CreateOAuthClientRequest
+	(*CreateOAuthClientResponse)(nil),            // 5: altalune.v1.CreateOAuthClientResponse
+	(*QueryOAuthClientsRequest)(nil),             // 6: altalune.v1.QueryOAuthClientsRequest
+	(*QueryOAuthClientsResponse)(nil),            // 7: altalune.v1.QueryOAuthClientsResponse
+	(*GetOAuthClientRequest)(nil),                // 8: altalune.v1.GetOAuthClientRequest
+	(*GetOAuthClientResponse)(nil),               // 9: altalune.v1.GetOAuthClientResponse
+	(*UpdateOAuthClientRequest)(nil),             // 10: altalune.v1.UpdateOAuthClientRequest
+	(*UpdateOAuthClientResponse)(nil),            // 11: altalune.v1.UpdateOAuthClientResponse
+	(*DeleteOAuthClientRequest)(nil),             // 12: altalune.v1.DeleteOAuthClientRequest
+	(*DeleteOAuthClientResponse)(nil),            // 13: altalune.v1.DeleteOAuthClientResponse
+	(*RestoreOAuthClientRequest)(nil),            // 14: altalune.v1.RestoreOAuthClientRequest
+	(*RestoreOAuthClientResponse)(nil),           // 15: altalune.v1.RestoreOAuthClientResponse
+	(*ApplyOAuthClientRequest)(nil),              // 16: altalune.v1.ApplyOAuthClientRequest
+	(*ApplyOAuthClientResponse)(nil),             // 17: altalune.v1.ApplyOAuthClientResponse
+	(*ImportOAuthClientRequest)(nil),             // 18: altalune.v1.ImportOAuthClientRequest
+	(*ImportOAuthClientResponse)(nil),            // 19: altalune.v1.ImportOAuthClientResponse
+	(*RevealOAuthClientSecretRequest)(nil),       // 20: altalune.v1.RevealOAuthClientSecretRequest
+	(*RevealOAuthClientSecretResponse)(nil),      // 21: altalune.v1.RevealOAuthClientSecretResponse
+	(*RotateOAuthClientSecretRequest)(nil),       // 22: altalune.v1.RotateOAuthClientSecretRequest
+	(*RotateOAuthClientSecretResponse)(nil),      // 23: altalune.v1.RotateOAuthClientSecretResponse
+	(*RefreshToken)(nil),                         // 24: altalune.v1.RefreshToken
+	(*QueryRefreshTokensRequest)(nil),            // 25: altalune.v1.QueryRefreshTokensRequest
+	(*QueryRefreshTokensResponse)(nil),           // 26: altalune.v1.QueryRefreshTokensResponse
+	(*RevokeRefreshTokenRequest)(nil),            // 27: altalune.v1.RevokeRefreshTokenRequest
+	(*RevokeRefreshTokenResponse)(nil),           // 28: altalune.v1.RevokeRefreshTokenResponse
+	(*TokenStatsBucket)(nil),                     // 29: altalune.v1.TokenStatsBucket
+	(*GetOAuthClientTokenStatsRequest)(nil),      // 30: altalune.v1.GetOAuthClientTokenStatsRequest
+	(*GetOAuthClientTokenStatsResponse)(nil),     // 31: altalune.v1.GetOAuthClientTokenStatsResponse
+	(*OAuthClientTokenClaims)(nil),               // 32: altalune.v1.OAuthClientTokenClaims
+	(*GetOAuthClientTokenClaimsRequest)(nil),     // 33: altalune.v1.GetOAuthClientTokenClaimsRequest
+	(*GetOAuthClientTokenClaimsResponse)(nil),    // 34: altalune.v1.GetOAuthClientTokenClaimsResponse
+	(*UpdateOAuthClientTokenClaimsRequest)(nil),  // 35: altalune.v1.UpdateOAuthClientTokenClaimsRequest
+	(*UpdateOAuthClientTokenClaimsResponse)(nil), // 36: altalune.v1.UpdateOAuthClientTokenClaimsResponse
+	(*TestAuthorizationFlowRequest)(nil),         // 37: altalune.v1.TestAuthorizationFlowRequest
+	(*AuthorizationFlowStep)(nil),                // 38: altalune.v1.AuthorizationFlowStep
+	(*TestAuthorizationFlowResponse)(nil),        // 39: altalune.v1.TestAuthorizationFlowResponse
+	(*timestamppb.Timestamp)(nil),                // 40: google.protobuf.Timestamp
+	(*DeliveredSecret)(nil),                      // 41: altalune.v1.DeliveredSecret
+	(*QueryRequest)(nil),                         // 42: altalune.v1.QueryRequest
+	(*QueryMetaResponse)(nil),                    // 43: altalune.v1.QueryMetaResponse
 }
 var file_altalune_v1_oauth_client_proto_depIdxs = []int32{
-	13, // 0: altalune.v1.OAuthClient.created_at:type_name -> google.protobuf.Timestamp
-	13, // 1: altalune.v1.OAuthClient.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 2: altalune.v1.CreateOAuthClientResponse.client:type_name -> altalune.v1.OAuthClient
-	14, // 3: altalune.v1.QueryOAuthClientsRequest.query:type_name -> altalune.v1.QueryRequest
-	0,  // 4: altalune.v1.QueryOAuthClientsResponse.clients:type_name -> altalune.v1.OAuthClient
-	15, // 5: altalune.v1.QueryOAuthClientsResponse.meta:type_name -> altalune.v1.QueryMetaResponse
-	0,  // 6: altalune.v1.GetOAuthClientResponse.client:type_name -> altalune.v1.OAuthClient
-	0,  // 7: altalune.v1.UpdateOAuthClientResponse.client:type_name -> altalune.v1.OAuthClient
-	1,  // 8: altalune.v1.OAuthClientService.CreateOAuthClient:input_type -> altalune.v1.CreateOAuthClientRequest
-	3,  // 9: altalune.v1.OAuthClientService.QueryOAuthClients:input_type -> altalune.v1.QueryOAuthClientsRequest
-	5,  // 10: altalune.v1.OAuthClientService.GetOAuthClient:input_type -> altalune.v1.GetOAuthClientRequest
-	7,  // 11: altalune.v1.OAuthClientService.UpdateOAuthClient:input_type -> altalune.v1.UpdateOAuthClientRequest
-	9,  // 12: altalune.v1.OAuthClientService.DeleteOAuthClient:input_type -> altalune.v1.DeleteOAuthClientRequest
-	11, // 13: altalune.v1.OAuthClientService.RevealOAuthClientSecret:input_type -> altalune.v1.RevealOAuthClientSecretRequest
-	2,  // 14: altalune.v1.OAuthClientService.CreateOAuthClient:output_type -> altalune.v1.CreateOAuthClientResponse
-	4,  // 15: altalune.v1.OAuthClientService.QueryOAuthClients:output_type -> altalune.v1.QueryOAuthClientsResponse
-	6,  // 16: altalune.v1.OAuthClientService.GetOAuthClient:output_type -> altalune.v1.GetOAuthClientResponse
-	8,  // 17: altalune.v1.OAuthClientService.UpdateOAuthClient:output_type -> altalune.v1.UpdateOAuthClientResponse
-	10, // 18: altalune.v1.OAuthClientService.DeleteOAuthClient:output_type -> altalune.v1.DeleteOAuthClientResponse
-	12, // 19: altalune.v1.OAuthClientService.RevealOAuthClientSecret:output_type -> altalune.v1.RevealOAuthClientSecretResponse
-	14, // [14:20] is the sub-list for method output_type
-	8,  // [8:14] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	40, // 0: altalune.v1.OAuthClient.deleted_at:type_name -> google.protobuf.Timestamp
+	40, // 1: altalune.v1.OAuthClient.client_secret_expires_at:type_name -> google.protobuf.Timestamp
+	40, // 2: altalune.v1.OAuthClient.created_at:type_name -> google.protobuf.Timestamp
+	40, // 3: altalune.v1.OAuthClient.updated_at:type_name -> google.protobuf.Timestamp
+	3,  // 4: altalune.v1.CreateOAuthClientResponse.client:type_name -> altalune.v1.OAuthClient
+	41, // 5: altalune.v1.CreateOAuthClientResponse.delivered_client_secret:type_name -> altalune.v1.DeliveredSecret
+	42, // 6: altalune.v1.QueryOAuthClientsRequest.query:type_name -> altalune.v1.QueryRequest
+	3,  // 7: altalune.v1.QueryOAuthClientsResponse.clients:type_name -> altalune.v1.OAuthClient
+	43, // 8: altalune.v1.QueryOAuthClientsResponse.meta:type_name -> altalune.v1.QueryMetaResponse
+	3,  // 9: altalune.v1.GetOAuthClientResponse.client:type_name -> altalune.v1.OAuthClient
+	40, // 10: altalune.v1.UpdateOAuthClientRequest.expected_updated_at:type_name -> google.protobuf.Timestamp
+	3,  // 11: altalune.v1.UpdateOAuthClientResponse.client:type_name -> altalune.v1.OAuthClient
+	3,  // 12: altalune.v1.RestoreOAuthClientResponse.client:type_name -> altalune.v1.OAuthClient
+	3,  // 13: altalune.v1.ApplyOAuthClientResponse.client:type_name -> altalune.v1.OAuthClient
+	41, // 14: altalune.v1.ApplyOAuthClientResponse.delivered_client_secret:type_name -> altalune.v1.DeliveredSecret
+	3,  // 15: altalune.v1.ImportOAuthClientResponse.client:type_name -> altalune.v1.OAuthClient
+	3,  // 16: altalune.v1.RotateOAuthClientSecretResponse.client:type_name -> altalune.v1.OAuthClient
+	41, // 17: altalune.v1.RotateOAuthClientSecretResponse.delivered_client_secret:type_name -> altalune.v1.DeliveredSecret
+	0,  // 18: altalune.v1.RefreshToken.status:type_name -> altalune.v1.RefreshTokenStatus
+	40, // 19: altalune.v1.RefreshToken.expires_at:type_name -> google.protobuf.Timestamp
+	40, // 20: altalune.v1.RefreshToken.exchanged_at:type_name -> google.protobuf.Timestamp
+	40, // 21: altalune.v1.RefreshToken.revoked_at:type_name -> google.protobuf.Timestamp
+	40, // 22: altalune.v1.RefreshToken.created_at:type_name -> google.protobuf.Timestamp
+	42, // 23: altalune.v1.QueryRefreshTokensRequest.query:type_name -> altalune.v1.QueryRequest
+	24, // 24: altalune.v1.QueryRefreshTokensResponse.tokens:type_name -> altalune.v1.RefreshToken
+	43, // 25: altalune.v1.QueryRefreshTokensResponse.meta:type_name -> altalune.v1.QueryMetaResponse
+	24, // 26: altalune.v1.RevokeRefreshTokenResponse.token:type_name -> altalune.v1.RefreshToken
+	40, // 27: altalune.v1.TokenStatsBucket.hour:type_name -> google.protobuf.Timestamp
+	29, // 28: altalune.v1.GetOAuthClientTokenStatsResponse.buckets:type_name -> altalune.v1.TokenStatsBucket
+	1,  // 29: altalune.v1.OAuthClientTokenClaims.perms_claim:type_name -> altalune.v1.PermsClaimMode
+	32, // 30: altalune.v1.GetOAuthClientTokenClaimsResponse.token_claims:type_name -> altalune.v1.OAuthClientTokenClaims
+	1,  // 31: altalune.v1.UpdateOAuthClientTokenClaimsRequest.perms_claim:type_name -> altalune.v1.PermsClaimMode
+	32, // 32: altalune.v1.UpdateOAuthClientTokenClaimsResponse.token_claims:type_name -> altalune.v1.OAuthClientTokenClaims
+	2,  // 33: altalune.v1.AuthorizationFlowStep.outcome:type_name -> altalune.v1.AuthorizationFlowStepOutcome
+	38, // 34: altalune.v1.TestAuthorizationFlowResponse.steps:type_name -> altalune.v1.AuthorizationFlowStep
+	4,  // 35: altalune.v1.OAuthClientService.CreateOAuthClient:input_type -> altalune.v1.CreateOAuthClientRequest
+	6,  // 36: altalune.v1.OAuthClientService.QueryOAuthClients:input_type -> altalune.v1.QueryOAuthClientsRequest
+	8,  // 37: altalune.v1.OAuthClientService.GetOAuthClient:input_type -> altalune.v1.GetOAuthClientRequest
+	10, // 38: altalune.v1.OAuthClientService.UpdateOAuthClient:input_type -> altalune.v1.UpdateOAuthClientRequest
+	12, // 39: altalune.v1.OAuthClientService.DeleteOAuthClient:input_type -> altalune.v1.DeleteOAuthClientRequest
+	14, // 40: altalune.v1.OAuthClientService.RestoreOAuthClient:input_type -> altalune.v1.RestoreOAuthClientRequest
+	20, // 41: altalune.v1.OAuthClientService.RevealOAuthClientSecret:input_type -> altalune.v1.RevealOAuthClientSecretRequest
+	22, // 42: altalune.v1.OAuthClientService.RotateOAuthClientSecret:input_type -> altalune.v1.RotateOAuthClientSecretRequest
+	25, // 43: altalune.v1.OAuthClientService.QueryRefreshTokens:input_type -> altalune.v1.QueryRefreshTokensRequest
+	27, // 44: altalune.v1.OAuthClientService.RevokeRefreshToken:input_type -> altalune.v1.RevokeRefreshTokenRequest
+	30, // 45: altalune.v1.OAuthClientService.GetOAuthClientTokenStats:input_type -> altalune.v1.GetOAuthClientTokenStatsRequest
+	33, // 46: altalune.v1.OAuthClientService.GetOAuthClientTokenClaims:input_type -> altalune.v1.GetOAuthClientTokenClaimsRequest
+	35, // 47: altalune.v1.OAuthClientService.UpdateOAuthClientTokenClaims:input_type -> altalune.v1.UpdateOAuthClientTokenClaimsRequest
+	16, // 48: altalune.v1.OAuthClientService.ApplyOAuthClient:input_type -> altalune.v1.ApplyOAuthClientRequest
+	18, // 49: altalune.v1.OAuthClientService.ImportOAuthClient:input_type -> altalune.v1.ImportOAuthClientRequest
+	37, // 50: altalune.v1.OAuthClientService.TestAuthorizationFlow:input_type -> altalune.v1.TestAuthorizationFlowRequest
+	5,  // 51: altalune.v1.OAuthClientService.CreateOAuthClient:output_type -> altalune.v1.CreateOAuthClientResponse
+	7,  // 52: altalune.v1.OAuthClientService.QueryOAuthClients:output_type -> altalune.v1.QueryOAuthClientsResponse
+	9,  // 53: altalune.v1.OAuthClientService.GetOAuthClient:output_type -> altalune.v1.GetOAuthClientResponse
+	11, // 54: altalune.v1.OAuthClientService.UpdateOAuthClient:output_type -> altalune.v1.UpdateOAuthClientResponse
+	13, // 55: altalune.v1.OAuthClientService.DeleteOAuthClient:output_type -> altalune.v1.DeleteOAuthClientResponse
+	15, // 56: altalune.v1.OAuthClientService.RestoreOAuthClient:output_type -> altalune.v1.RestoreOAuthClientResponse
+	21, // 57: altalune.v1.OAuthClientService.RevealOAuthClientSecret:output_type -> altalune.v1.RevealOAuthClientSecretResponse
+	23, // 58: altalune.v1.OAuthClientService.RotateOAuthClientSecret:output_type -> altalune.v1.RotateOAuthClientSecretResponse
+	26, // 59: altalune.v1.OAuthClientService.QueryRefreshTokens:output_type -> altalune.v1.QueryRefreshTokensResponse
+	28, // 60: altalune.v1.OAuthClientService.RevokeRefreshToken:output_type -> altalune.v1.RevokeRefreshTokenResponse
+	31, // 61: altalune.v1.OAuthClientService.GetOAuthClientTokenStats:output_type -> altalune.v1.GetOAuthClientTokenStatsResponse
+	34, // 62: altalune.v1.OAuthClientService.GetOAuthClientTokenClaims:output_type -> altalune.v1.GetOAuthClientTokenClaimsResponse
+	36, // 63: altalune.v1.OAuthClientService.UpdateOAuthClientTokenClaims:output_type -> altalune.v1.UpdateOAuthClientTokenClaimsResponse
+	17, // 64: altalune.v1.OAuthClientService.ApplyOAuthClient:output_type -> altalune.v1.ApplyOAuthClientResponse
+	19, // 65: altalune.v1.OAuthClientService.ImportOAuthClient:output_type -> altalune.v1.ImportOAuthClientResponse
+	39, // 66: altalune.v1.OAuthClientService.TestAuthorizationFlow:output_type -> altalune.v1.TestAuthorizationFlowResponse
+	51, // [51:67] is the sub-list for method output_type
+	35, // [35:51] is the sub-list for method input_type
+	35, // [35:35] is the sub-list for extension type_name
+	35, // [35:35] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
 }
 
 func init() { file_altalune_v1_oauth_client_proto_init() }
@@ -940,19 +2939,21 @@ func file_altalune_v1_oauth_client_proto_init() {
 		return
 	}
 	file_altalune_v1_common_proto_init()
+	file_altalune_v1_options_proto_init()
 	file_altalune_v1_oauth_client_proto_msgTypes[7].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_altalune_v1_oauth_client_proto_rawDesc), len(file_altalune_v1_oauth_client_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   13,
+			NumEnums:      3,
+			NumMessages:   37,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_altalune_v1_oauth_client_proto_goTypes,
 		DependencyIndexes: file_altalune_v1_oauth_client_proto_depIdxs,
+		EnumInfos:         file_altalune_v1_oauth_client_proto_enumTypes,
 		MessageInfos:      file_altalune_v1_oauth_client_proto_msgTypes,
 	}.Build()
 	File_altalune_v1_oauth_client_proto = out.File
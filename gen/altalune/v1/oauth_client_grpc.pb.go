@@ -19,12 +19,22 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	OAuthClientService_CreateOAuthClient_FullMethodName       = "/altalune.v1.OAuthClientService/CreateOAuthClient"
-	OAuthClientService_QueryOAuthClients_FullMethodName       = "/altalune.v1.OAuthClientService/QueryOAuthClients"
-	OAuthClientService_GetOAuthClient_FullMethodName          = "/altalune.v1.OAuthClientService/GetOAuthClient"
-	OAuthClientService_UpdateOAuthClient_FullMethodName       = "/altalune.v1.OAuthClientService/UpdateOAuthClient"
-	OAuthClientService_DeleteOAuthClient_FullMethodName       = "/altalune.v1.OAuthClientService/DeleteOAuthClient"
-	OAuthClientService_RevealOAuthClientSecret_FullMethodName = "/altalune.v1.OAuthClientService/RevealOAuthClientSecret"
+	OAuthClientService_CreateOAuthClient_FullMethodName            = "/altalune.v1.OAuthClientService/CreateOAuthClient"
+	OAuthClientService_QueryOAuthClients_FullMethodName            = "/altalune.v1.OAuthClientService/QueryOAuthClients"
+	OAuthClientService_GetOAuthClient_FullMethodName               = "/altalune.v1.OAuthClientService/GetOAuthClient"
+	OAuthClientService_UpdateOAuthClient_FullMethodName            = "/altalune.v1.OAuthClientService/UpdateOAuthClient"
+	OAuthClientService_DeleteOAuthClient_FullMethodName            = "/altalune.v1.OAuthClientService/DeleteOAuthClient"
+	OAuthClientService_RestoreOAuthClient_FullMethodName           = "/altalune.v1.OAuthClientService/RestoreOAuthClient"
+	OAuthClientService_RevealOAuthClientSecret_FullMethodName      = "/altalune.v1.OAuthClientService/RevealOAuthClientSecret"
+	OAuthClientService_RotateOAuthClientSecret_FullMethodName      = "/altalune.v1.OAuthClientService/RotateOAuthClientSecret"
+	OAuthClientService_QueryRefreshTokens_FullMethodName           = "/altalune.v1.OAuthClientService/QueryRefreshTokens"
+	OAuthClientService_RevokeRefreshToken_FullMethodName           = "/altalune.v1.OAuthClientService/RevokeRefreshToken"
+	OAuthClientService_GetOAuthClientTokenStats_FullMethodName     = "/altalune.v1.OAuthClientService/GetOAuthClientTokenStats"
+	OAuthClientService_GetOAuthClientTokenClaims_FullMethodName    = "/altalune.v1.OAuthClientService/GetOAuthClientTokenClaims"
+	OAuthClientService_UpdateOAuthClientTokenClaims_FullMethodName = "/altalune.v1.OAuthClientService/UpdateOAuthClientTokenClaims"
+	OAuthClientService_ApplyOAuthClient_FullMethodName             = "/altalune.v1.OAuthClientService/ApplyOAuthClient"
+	OAuthClientService_ImportOAuthClient_FullMethodName            = "/altalune.v1.OAuthClientService/ImportOAuthClient"
+	OAuthClientService_TestAuthorizationFlow_FullMethodName        = "/altalune.v1.OAuthClientService/TestAuthorizationFlow"
 )
 
 // OAuthClientServiceClient is the client API for OAuthClientService service.
@@ -38,7 +48,22 @@ type OAuthClientServiceClient interface {
 	GetOAuthClient(ctx context.Context, in *GetOAuthClientRequest, opts ...grpc.CallOption) (*GetOAuthClientResponse, error)
 	UpdateOAuthClient(ctx context.Context, in *UpdateOAuthClientRequest, opts ...grpc.CallOption) (*UpdateOAuthClientResponse, error)
 	DeleteOAuthClient(ctx context.Context, in *DeleteOAuthClientRequest, opts ...grpc.CallOption) (*DeleteOAuthClientResponse, error)
+	RestoreOAuthClient(ctx context.Context, in *RestoreOAuthClientRequest, opts ...grpc.CallOption) (*RestoreOAuthClientResponse, error)
 	RevealOAuthClientSecret(ctx context.Context, in *RevealOAuthClientSecretRequest, opts ...grpc.CallOption) (*RevealOAuthClientSecretResponse, error)
+	// Replace the secret of a confidential client, handed over like on creation
+	RotateOAuthClientSecret(ctx context.Context, in *RotateOAuthClientSecretRequest, opts ...grpc.CallOption) (*RotateOAuthClientSecretResponse, error)
+	QueryRefreshTokens(ctx context.Context, in *QueryRefreshTokensRequest, opts ...grpc.CallOption) (*QueryRefreshTokensResponse, error)
+	RevokeRefreshToken(ctx context.Context, in *RevokeRefreshTokenRequest, opts ...grpc.CallOption) (*RevokeRefreshTokenResponse, error)
+	GetOAuthClientTokenStats(ctx context.Context, in *GetOAuthClientTokenStatsRequest, opts ...grpc.CallOption) (*GetOAuthClientTokenStatsResponse, error)
+	GetOAuthClientTokenClaims(ctx context.Context, in *GetOAuthClientTokenClaimsRequest, opts ...grpc.CallOption) (*GetOAuthClientTokenClaimsResponse, error)
+	UpdateOAuthClientTokenClaims(ctx context.Context, in *UpdateOAuthClientTokenClaimsRequest, opts ...grpc.CallOption) (*UpdateOAuthClientTokenClaimsResponse, error)
+	// Create-or-update by external ID, for declarative provisioning
+	ApplyOAuthClient(ctx context.Context, in *ApplyOAuthClientRequest, opts ...grpc.CallOption) (*ApplyOAuthClientResponse, error)
+	// Bring an existing client under an external ID, found by name
+	ImportOAuthClient(ctx context.Context, in *ImportOAuthClientRequest, opts ...grpc.CallOption) (*ImportOAuthClientResponse, error)
+	// Walk a synthetic user of a sandbox project through the authorization code
+	// flow of a client, without a browser, reporting the outcome of each step
+	TestAuthorizationFlow(ctx context.Context, in *TestAuthorizationFlowRequest, opts ...grpc.CallOption) (*TestAuthorizationFlowResponse, error)
 }
 
 type oAuthClientServiceClient struct {
@@ -99,6 +124,16 @@ func (c *oAuthClientServiceClient) DeleteOAuthClient(ctx context.Context, in *De
 	return out, nil
 }
 
+func (c *oAuthClientServiceClient) RestoreOAuthClient(ctx context.Context, in *RestoreOAuthClientRequest, opts ...grpc.CallOption) (*RestoreOAuthClientResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreOAuthClientResponse)
+	err := c.cc.Invoke(ctx, OAuthClientService_RestoreOAuthClient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *oAuthClientServiceClient) RevealOAuthClientSecret(ctx context.Context, in *RevealOAuthClientSecretRequest, opts ...grpc.CallOption) (*RevealOAuthClientSecretResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RevealOAuthClientSecretResponse)
@@ -109,6 +144,96 @@ func (c *oAuthClientServiceClient) RevealOAuthClientSecret(ctx context.Context,
 	return out, nil
 }
 
+func (c *oAuthClientServiceClient) RotateOAuthClientSecret(ctx context.Context, in *RotateOAuthClientSecretRequest, opts ...grpc.CallOption) (*RotateOAuthClientSecretResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateOAuthClientSecretResponse)
+	err := c.cc.Invoke(ctx, OAuthClientService_RotateOAuthClientSecret_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oAuthClientServiceClient) QueryRefreshTokens(ctx context.Context, in *QueryRefreshTokensRequest, opts ...grpc.CallOption) (*QueryRefreshTokensResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryRefreshTokensResponse)
+	err := c.cc.Invoke(ctx, OAuthClientService_QueryRefreshTokens_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oAuthClientServiceClient) RevokeRefreshToken(ctx context.Context, in *RevokeRefreshTokenRequest, opts ...grpc.CallOption) (*RevokeRefreshTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeRefreshTokenResponse)
+	err := c.cc.Invoke(ctx, OAuthClientService_RevokeRefreshToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oAuthClientServiceClient) GetOAuthClientTokenStats(ctx context.Context, in *GetOAuthClientTokenStatsRequest, opts ...grpc.CallOption) (*GetOAuthClientTokenStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOAuthClientTokenStatsResponse)
+	err := c.cc.Invoke(ctx, OAuthClientService_GetOAuthClientTokenStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oAuthClientServiceClient) GetOAuthClientTokenClaims(ctx context.Context, in *GetOAuthClientTokenClaimsRequest, opts ...grpc.CallOption) (*GetOAuthClientTokenClaimsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOAuthClientTokenClaimsResponse)
+	err := c.cc.Invoke(ctx, OAuthClientService_GetOAuthClientTokenClaims_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oAuthClientServiceClient) UpdateOAuthClientTokenClaims(ctx context.Context, in *UpdateOAuthClientTokenClaimsRequest, opts ...grpc.CallOption) (*UpdateOAuthClientTokenClaimsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateOAuthClientTokenClaimsResponse)
+	err := c.cc.Invoke(ctx, OAuthClientService_UpdateOAuthClientTokenClaims_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oAuthClientServiceClient) ApplyOAuthClient(ctx context.Context, in *ApplyOAuthClientRequest, opts ...grpc.CallOption) (*ApplyOAuthClientResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyOAuthClientResponse)
+	err := c.cc.Invoke(ctx, OAuthClientService_ApplyOAuthClient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oAuthClientServiceClient) ImportOAuthClient(ctx context.Context, in *ImportOAuthClientRequest, opts ...grpc.CallOption) (*ImportOAuthClientResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportOAuthClientResponse)
+	err := c.cc.Invoke(ctx, OAuthClientService_ImportOAuthClient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oAuthClientServiceClient) TestAuthorizationFlow(ctx context.Context, in *TestAuthorizationFlowRequest, opts ...grpc.CallOption) (*TestAuthorizationFlowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TestAuthorizationFlowResponse)
+	err := c.cc.Invoke(ctx, OAuthClientService_TestAuthorizationFlow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OAuthClientServiceServer is the server API for OAuthClientService service.
 // All implementations must embed UnimplementedOAuthClientServiceServer
 // for forward compatibility.
@@ -120,7 +245,22 @@ type OAuthClientServiceServer interface {
 	GetOAuthClient(context.Context, *GetOAuthClientRequest) (*GetOAuthClientResponse, error)
 	UpdateOAuthClient(context.Context, *UpdateOAuthClientRequest) (*UpdateOAuthClientResponse, error)
 	DeleteOAuthClient(context.Context, *DeleteOAuthClientRequest) (*DeleteOAuthClientResponse, error)
+	RestoreOAuthClient(context.Context, *RestoreOAuthClientRequest) (*RestoreOAuthClientResponse, error)
 	RevealOAuthClientSecret(context.Context, *RevealOAuthClientSecretRequest) (*RevealOAuthClientSecretResponse, error)
+	// Replace the secret of a confidential client, handed over like on creation
+	RotateOAuthClientSecret(context.Context, *RotateOAuthClientSecretRequest) (*RotateOAuthClientSecretResponse, error)
+	QueryRefreshTokens(context.Context, *QueryRefreshTokensRequest) (*QueryRefreshTokensResponse, error)
+	RevokeRefreshToken(context.Context, *RevokeRefreshTokenRequest) (*RevokeRefreshTokenResponse, error)
+	GetOAuthClientTokenStats(context.Context, *GetOAuthClientTokenStatsRequest) (*GetOAuthClientTokenStatsResponse, error)
+	GetOAuthClientTokenClaims(context.Context, *GetOAuthClientTokenClaimsRequest) (*GetOAuthClientTokenClaimsResponse, error)
+	UpdateOAuthClientTokenClaims(context.Context, *UpdateOAuthClientTokenClaimsRequest) (*UpdateOAuthClientTokenClaimsResponse, error)
+	// Create-or-update by external ID, for declarative provisioning
+	ApplyOAuthClient(context.Context, *ApplyOAuthClientRequest) (*ApplyOAuthClientResponse, error)
+	// Bring an existing client under an external ID, found by name
+	ImportOAuthClient(context.Context, *ImportOAuthClientRequest) (*ImportOAuthClientResponse, error)
+	// Walk a synthetic user of a sandbox project through the authorization code
+	// flow of a client, without a browser, reporting the outcome of each step
+	TestAuthorizationFlow(context.Context, *TestAuthorizationFlowRequest) (*TestAuthorizationFlowResponse, error)
 	mustEmbedUnimplementedOAuthClientServiceServer()
 }
 
@@ -146,9 +286,39 @@ func (UnimplementedOAuthClientServiceServer) UpdateOAuthClient(context.Context,
 func (UnimplementedOAuthClientServiceServer) DeleteOAuthClient(context.Context, *DeleteOAuthClientRequest) (*DeleteOAuthClientResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteOAuthClient not implemented")
 }
+func (UnimplementedOAuthClientServiceServer) RestoreOAuthClient(context.Context, *RestoreOAuthClientRequest) (*RestoreOAuthClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreOAuthClient not implemented")
+}
 func (UnimplementedOAuthClientServiceServer) RevealOAuthClientSecret(context.Context, *RevealOAuthClientSecretRequest) (*RevealOAuthClientSecretResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RevealOAuthClientSecret not implemented")
 }
+func (UnimplementedOAuthClientServiceServer) RotateOAuthClientSecret(context.Context, *RotateOAuthClientSecretRequest) (*RotateOAuthClientSecretResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateOAuthClientSecret not implemented")
+}
+func (UnimplementedOAuthClientServiceServer) QueryRefreshTokens(context.Context, *QueryRefreshTokensRequest) (*QueryRefreshTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryRefreshTokens not implemented")
+}
+func (UnimplementedOAuthClientServiceServer) RevokeRefreshToken(context.Context, *RevokeRefreshTokenRequest) (*RevokeRefreshTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeRefreshToken not implemented")
+}
+func (UnimplementedOAuthClientServiceServer) GetOAuthClientTokenStats(context.Context, *GetOAuthClientTokenStatsRequest) (*GetOAuthClientTokenStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOAuthClientTokenStats not implemented")
+}
+func (UnimplementedOAuthClientServiceServer) GetOAuthClientTokenClaims(context.Context, *GetOAuthClientTokenClaimsRequest) (*GetOAuthClientTokenClaimsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOAuthClientTokenClaims not implemented")
+}
+func (UnimplementedOAuthClientServiceServer) UpdateOAuthClientTokenClaims(context.Context, *UpdateOAuthClientTokenClaimsRequest) (*UpdateOAuthClientTokenClaimsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOAuthClientTokenClaims not implemented")
+}
+func (UnimplementedOAuthClientServiceServer) ApplyOAuthClient(context.Context, *ApplyOAuthClientRequest) (*ApplyOAuthClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyOAuthClient not implemented")
+}
+func (UnimplementedOAuthClientServiceServer) ImportOAuthClient(context.Context, *ImportOAuthClientRequest) (*ImportOAuthClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportOAuthClient not implemented")
+}
+func (UnimplementedOAuthClientServiceServer) TestAuthorizationFlow(context.Context, *TestAuthorizationFlowRequest) (*TestAuthorizationFlowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestAuthorizationFlow not implemented")
+}
 func (UnimplementedOAuthClientServiceServer) mustEmbedUnimplementedOAuthClientServiceServer() {}
 func (UnimplementedOAuthClientServiceServer) testEmbeddedByValue()                            {}
 
@@ -260,6 +430,24 @@ func _OAuthClientService_DeleteOAuthClient_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OAuthClientService_RestoreOAuthClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreOAuthClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuthClientServiceServer).RestoreOAuthClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OAuthClientService_RestoreOAuthClient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuthClientServiceServer).RestoreOAuthClient(ctx, req.(*RestoreOAuthClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OAuthClientService_RevealOAuthClientSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RevealOAuthClientSecretRequest)
 	if err := dec(in); err != nil {
@@ -278,6 +466,168 @@ func _OAuthClientService_RevealOAuthClientSecret_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OAuthClientService_RotateOAuthClientSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateOAuthClientSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuthClientServiceServer).RotateOAuthClientSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OAuthClientService_RotateOAuthClientSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuthClientServiceServer).RotateOAuthClientSecret(ctx, req.(*RotateOAuthClientSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OAuthClientService_QueryRefreshTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRefreshTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuthClientServiceServer).QueryRefreshTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OAuthClientService_QueryRefreshTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuthClientServiceServer).QueryRefreshTokens(ctx, req.(*QueryRefreshTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OAuthClientService_RevokeRefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeRefreshTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuthClientServiceServer).RevokeRefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OAuthClientService_RevokeRefreshToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuthClientServiceServer).RevokeRefreshToken(ctx, req.(*RevokeRefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OAuthClientService_GetOAuthClientTokenStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOAuthClientTokenStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuthClientServiceServer).GetOAuthClientTokenStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OAuthClientService_GetOAuthClientTokenStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuthClientServiceServer).GetOAuthClientTokenStats(ctx, req.(*GetOAuthClientTokenStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OAuthClientService_GetOAuthClientTokenClaims_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOAuthClientTokenClaimsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuthClientServiceServer).GetOAuthClientTokenClaims(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OAuthClientService_GetOAuthClientTokenClaims_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuthClientServiceServer).GetOAuthClientTokenClaims(ctx, req.(*GetOAuthClientTokenClaimsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OAuthClientService_UpdateOAuthClientTokenClaims_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOAuthClientTokenClaimsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuthClientServiceServer).UpdateOAuthClientTokenClaims(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OAuthClientService_UpdateOAuthClientTokenClaims_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuthClientServiceServer).UpdateOAuthClientTokenClaims(ctx, req.(*UpdateOAuthClientTokenClaimsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OAuthClientService_ApplyOAuthClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyOAuthClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuthClientServiceServer).ApplyOAuthClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OAuthClientService_ApplyOAuthClient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuthClientServiceServer).ApplyOAuthClient(ctx, req.(*ApplyOAuthClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OAuthClientService_ImportOAuthClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportOAuthClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuthClientServiceServer).ImportOAuthClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OAuthClientService_ImportOAuthClient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuthClientServiceServer).ImportOAuthClient(ctx, req.(*ImportOAuthClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OAuthClientService_TestAuthorizationFlow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestAuthorizationFlowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuthClientServiceServer).TestAuthorizationFlow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OAuthClientService_TestAuthorizationFlow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuthClientServiceServer).TestAuthorizationFlow(ctx, req.(*TestAuthorizationFlowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OAuthClientService_ServiceDesc is the grpc.ServiceDesc for OAuthClientService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -305,10 +655,50 @@ var OAuthClientService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteOAuthClient",
 			Handler:    _OAuthClientService_DeleteOAuthClient_Handler,
 		},
+		{
+			MethodName: "RestoreOAuthClient",
+			Handler:    _OAuthClientService_RestoreOAuthClient_Handler,
+		},
 		{
 			MethodName: "RevealOAuthClientSecret",
 			Handler:    _OAuthClientService_RevealOAuthClientSecret_Handler,
 		},
+		{
+			MethodName: "RotateOAuthClientSecret",
+			Handler:    _OAuthClientService_RotateOAuthClientSecret_Handler,
+		},
+		{
+			MethodName: "QueryRefreshTokens",
+			Handler:    _OAuthClientService_QueryRefreshTokens_Handler,
+		},
+		{
+			MethodName: "RevokeRefreshToken",
+			Handler:    _OAuthClientService_RevokeRefreshToken_Handler,
+		},
+		{
+			MethodName: "GetOAuthClientTokenStats",
+			Handler:    _OAuthClientService_GetOAuthClientTokenStats_Handler,
+		},
+		{
+			MethodName: "GetOAuthClientTokenClaims",
+			Handler:    _OAuthClientService_GetOAuthClientTokenClaims_Handler,
+		},
+		{
+			MethodName: "UpdateOAuthClientTokenClaims",
+			Handler:    _OAuthClientService_UpdateOAuthClientTokenClaims_Handler,
+		},
+		{
+			MethodName: "ApplyOAuthClient",
+			Handler:    _OAuthClientService_ApplyOAuthClient_Handler,
+		},
+		{
+			MethodName: "ImportOAuthClient",
+			Handler:    _OAuthClientService_ImportOAuthClient_Handler,
+		},
+		{
+			MethodName: "TestAuthorizationFlow",
+			Handler:    _OAuthClientService_TestAuthorizationFlow_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "altalune/v1/oauth_client.proto",
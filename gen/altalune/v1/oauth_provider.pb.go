@@ -522,12 +522,15 @@ type UpdateOAuthProviderRequest struct {
 	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	ClientId string                 `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
 	// Optional - if empty, existing secret is retained
-	ClientSecret  string `protobuf:"bytes,3,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
-	RedirectUrl   string `protobuf:"bytes,4,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirect_url,omitempty"`
-	Scopes        string `protobuf:"bytes,5,opt,name=scopes,proto3" json:"scopes,omitempty"`
-	Enabled       bool   `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	ClientSecret string `protobuf:"bytes,3,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	RedirectUrl  string `protobuf:"bytes,4,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirect_url,omitempty"`
+	Scopes       string `protobuf:"bytes,5,opt,name=scopes,proto3" json:"scopes,omitempty"`
+	Enabled      bool   `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// updated_at of the provider the edit is based on. When set, the update is
+	// rejected with FAILED_PRECONDITION if the provider changed since then.
+	ExpectedUpdatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expected_updated_at,json=expectedUpdatedAt,proto3" json:"expected_updated_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *UpdateOAuthProviderRequest) Reset() {
@@ -602,6 +605,13 @@ func (x *UpdateOAuthProviderRequest) GetEnabled() bool {
 	return false
 }
 
+func (x *UpdateOAuthProviderRequest) GetExpectedUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpectedUpdatedAt
+	}
+	return nil
+}
+
 // UpdateOAuthProviderResponse with updated provider
 type UpdateOAuthProviderResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -745,18 +755,121 @@ func (x *DeleteOAuthProviderResponse) GetMessage() string {
 	return ""
 }
 
+// RequestClientSecretRevealRequest asks for a confirmation code to reveal
+// the client secret of a provider
+type RequestClientSecretRevealRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestClientSecretRevealRequest) Reset() {
+	*x = RequestClientSecretRevealRequest{}
+	mi := &file_altalune_v1_oauth_provider_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestClientSecretRevealRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestClientSecretRevealRequest) ProtoMessage() {}
+
+func (x *RequestClientSecretRevealRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_oauth_provider_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestClientSecretRevealRequest.ProtoReflect.Descriptor instead.
+func (*RequestClientSecretRevealRequest) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_oauth_provider_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RequestClientSecretRevealRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// RequestClientSecretRevealResponse tells when the emailed code lapses
+type RequestClientSecretRevealResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestClientSecretRevealResponse) Reset() {
+	*x = RequestClientSecretRevealResponse{}
+	mi := &file_altalune_v1_oauth_provider_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestClientSecretRevealResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestClientSecretRevealResponse) ProtoMessage() {}
+
+func (x *RequestClientSecretRevealResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_oauth_provider_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestClientSecretRevealResponse.ProtoReflect.Descriptor instead.
+func (*RequestClientSecretRevealResponse) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_oauth_provider_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RequestClientSecretRevealResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RequestClientSecretRevealResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
 // RevealClientSecretRequest for decrypting client secret
 // SECURITY: This is an explicit, separate RPC to track secret access
 type RevealClientSecretRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Code emailed to the caller by RequestClientSecretReveal, single use
+	ConfirmationCode string `protobuf:"bytes,2,opt,name=confirmation_code,json=confirmationCode,proto3" json:"confirmation_code,omitempty"`
+	// Why the secret is needed, recorded in the audit trail
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RevealClientSecretRequest) Reset() {
 	*x = RevealClientSecretRequest{}
-	mi := &file_altalune_v1_oauth_provider_proto_msgTypes[11]
+	mi := &file_altalune_v1_oauth_provider_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -768,7 +881,7 @@ func (x *RevealClientSecretRequest) String() string {
 func (*RevealClientSecretRequest) ProtoMessage() {}
 
 func (x *RevealClientSecretRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_altalune_v1_oauth_provider_proto_msgTypes[11]
+	mi := &file_altalune_v1_oauth_provider_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -781,7 +894,7 @@ func (x *RevealClientSecretRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevealClientSecretRequest.ProtoReflect.Descriptor instead.
 func (*RevealClientSecretRequest) Descriptor() ([]byte, []int) {
-	return file_altalune_v1_oauth_provider_proto_rawDescGZIP(), []int{11}
+	return file_altalune_v1_oauth_provider_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *RevealClientSecretRequest) GetId() string {
@@ -791,6 +904,20 @@ func (x *RevealClientSecretRequest) GetId() string {
 	return ""
 }
 
+func (x *RevealClientSecretRequest) GetConfirmationCode() string {
+	if x != nil {
+		return x.ConfirmationCode
+	}
+	return ""
+}
+
+func (x *RevealClientSecretRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 // RevealClientSecretResponse with plaintext client secret
 // WARNING: Only use for explicit reveal operations
 type RevealClientSecretResponse struct {
@@ -802,7 +929,7 @@ type RevealClientSecretResponse struct {
 
 func (x *RevealClientSecretResponse) Reset() {
 	*x = RevealClientSecretResponse{}
-	mi := &file_altalune_v1_oauth_provider_proto_msgTypes[12]
+	mi := &file_altalune_v1_oauth_provider_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -814,7 +941,7 @@ func (x *RevealClientSecretResponse) String() string {
 func (*RevealClientSecretResponse) ProtoMessage() {}
 
 func (x *RevealClientSecretResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_altalune_v1_oauth_provider_proto_msgTypes[12]
+	mi := &file_altalune_v1_oauth_provider_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -827,7 +954,7 @@ func (x *RevealClientSecretResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevealClientSecretResponse.ProtoReflect.Descriptor instead.
 func (*RevealClientSecretResponse) Descriptor() ([]byte, []int) {
-	return file_altalune_v1_oauth_provider_proto_rawDescGZIP(), []int{12}
+	return file_altalune_v1_oauth_provider_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *RevealClientSecretResponse) GetClientSecret() string {
@@ -841,7 +968,7 @@ var File_altalune_v1_oauth_provider_proto protoreflect.FileDescriptor
 
 const file_altalune_v1_oauth_provider_proto_rawDesc = "" +
 	"\n" +
-	" altalune/v1/oauth_provider.proto\x12\valtalune.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\x1a\x18altalune/v1/common.proto\"\xf3\x02\n" +
+	" altalune/v1/oauth_provider.proto\x12\valtalune.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\x1a\x18altalune/v1/common.proto\x1a\x19altalune/v1/options.proto\"\xf3\x02\n" +
 	"\rOAuthProvider\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12>\n" +
 	"\rprovider_type\x18\x02 \x01(\x0e2\x19.altalune.v1.ProviderTypeR\fproviderType\x12\x1b\n" +
@@ -874,7 +1001,7 @@ const file_altalune_v1_oauth_provider_proto_rawDesc = "" +
 	"\x17GetOAuthProviderRequest\x12\x1c\n" +
 	"\x02id\x18\x01 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x0e\x18\x14R\x02id\"R\n" +
 	"\x18GetOAuthProviderResponse\x126\n" +
-	"\bprovider\x18\x01 \x01(\v2\x1a.altalune.v1.OAuthProviderR\bprovider\"\x84\x02\n" +
+	"\bprovider\x18\x01 \x01(\v2\x1a.altalune.v1.OAuthProviderR\bprovider\"\xd0\x02\n" +
 	"\x1aUpdateOAuthProviderRequest\x12\x1c\n" +
 	"\x02id\x18\x01 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x0e\x18\x14R\x02id\x12*\n" +
 	"\tclient_id\x18\x02 \x01(\tB\r\xbaH\n" +
@@ -882,16 +1009,27 @@ const file_altalune_v1_oauth_provider_proto_rawDesc = "" +
 	"\rclient_secret\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xf4\x03R\fclientSecret\x121\n" +
 	"\fredirect_url\x18\x04 \x01(\tB\x0e\xbaH\v\xc8\x01\x01r\x06\x18\xf4\x03\x88\x01\x01R\vredirectUrl\x12 \n" +
 	"\x06scopes\x18\x05 \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aR\x06scopes\x12\x18\n" +
-	"\aenabled\x18\x06 \x01(\bR\aenabled\"o\n" +
+	"\aenabled\x18\x06 \x01(\bR\aenabled\x12J\n" +
+	"\x13expected_updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x11expectedUpdatedAt\"o\n" +
 	"\x1bUpdateOAuthProviderResponse\x126\n" +
 	"\bprovider\x18\x01 \x01(\v2\x1a.altalune.v1.OAuthProviderR\bprovider\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\":\n" +
 	"\x1aDeleteOAuthProviderRequest\x12\x1c\n" +
 	"\x02id\x18\x01 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x0e\x18\x14R\x02id\"7\n" +
 	"\x1bDeleteOAuthProviderResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"9\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"@\n" +
+	" RequestClientSecretRevealRequest\x12\x1c\n" +
+	"\x02id\x18\x01 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x0e\x18\x14R\x02id\"x\n" +
+	"!RequestClientSecretRevealResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\xa3\x01\n" +
 	"\x19RevealClientSecretRequest\x12\x1c\n" +
-	"\x02id\x18\x01 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x0e\x18\x14R\x02id\"A\n" +
+	"\x02id\x18\x01 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x0e\x18\x14R\x02id\x12A\n" +
+	"\x11confirmation_code\x18\x02 \x01(\tB\x14\xbaH\x11\xc8\x01\x01r\f2\n" +
+	"^[0-9]{6}$R\x10confirmationCode\x12%\n" +
+	"\x06reason\x18\x03 \x01(\tB\r\xbaH\n" +
+	"\xc8\x01\x01r\x05\x10\x03\x18\xf4\x03R\x06reason\"A\n" +
 	"\x1aRevealClientSecretResponse\x12#\n" +
 	"\rclient_secret\x18\x01 \x01(\tR\fclientSecret*\x97\x01\n" +
 	"\fProviderType\x12\x1d\n" +
@@ -899,14 +1037,15 @@ const file_altalune_v1_oauth_provider_proto_rawDesc = "" +
 	"\x14PROVIDER_TYPE_GOOGLE\x10\x01\x12\x18\n" +
 	"\x14PROVIDER_TYPE_GITHUB\x10\x02\x12\x1b\n" +
 	"\x17PROVIDER_TYPE_MICROSOFT\x10\x03\x12\x17\n" +
-	"\x13PROVIDER_TYPE_APPLE\x10\x042\x92\x05\n" +
-	"\x14OAuthProviderService\x12j\n" +
-	"\x13QueryOAuthProviders\x12'.altalune.v1.QueryOAuthProvidersRequest\x1a(.altalune.v1.QueryOAuthProvidersResponse\"\x00\x12j\n" +
-	"\x13CreateOAuthProvider\x12'.altalune.v1.CreateOAuthProviderRequest\x1a(.altalune.v1.CreateOAuthProviderResponse\"\x00\x12a\n" +
-	"\x10GetOAuthProvider\x12$.altalune.v1.GetOAuthProviderRequest\x1a%.altalune.v1.GetOAuthProviderResponse\"\x00\x12j\n" +
-	"\x13UpdateOAuthProvider\x12'.altalune.v1.UpdateOAuthProviderRequest\x1a(.altalune.v1.UpdateOAuthProviderResponse\"\x00\x12j\n" +
-	"\x13DeleteOAuthProvider\x12'.altalune.v1.DeleteOAuthProviderRequest\x1a(.altalune.v1.DeleteOAuthProviderResponse\"\x00\x12g\n" +
-	"\x12RevealClientSecret\x12&.altalune.v1.RevealClientSecretRequest\x1a'.altalune.v1.RevealClientSecretResponse\"\x00B\xa7\x01\n" +
+	"\x13PROVIDER_TYPE_APPLE\x10\x042\xfe\x06\n" +
+	"\x14OAuthProviderService\x12y\n" +
+	"\x13QueryOAuthProviders\x12'.altalune.v1.QueryOAuthProvidersRequest\x1a(.altalune.v1.QueryOAuthProvidersResponse\"\x0f\x8a\xb5\x18\vclient:read\x12z\n" +
+	"\x13CreateOAuthProvider\x12'.altalune.v1.CreateOAuthProviderRequest\x1a(.altalune.v1.CreateOAuthProviderResponse\"\x10\x8a\xb5\x18\fclient:write\x12p\n" +
+	"\x10GetOAuthProvider\x12$.altalune.v1.GetOAuthProviderRequest\x1a%.altalune.v1.GetOAuthProviderResponse\"\x0f\x8a\xb5\x18\vclient:read\x12z\n" +
+	"\x13UpdateOAuthProvider\x12'.altalune.v1.UpdateOAuthProviderRequest\x1a(.altalune.v1.UpdateOAuthProviderResponse\"\x10\x8a\xb5\x18\fclient:write\x12{\n" +
+	"\x13DeleteOAuthProvider\x12'.altalune.v1.DeleteOAuthProviderRequest\x1a(.altalune.v1.DeleteOAuthProviderResponse\"\x11\x8a\xb5\x18\rclient:delete\x12\x8b\x01\n" +
+	"\x19RequestClientSecretReveal\x12-.altalune.v1.RequestClientSecretRevealRequest\x1a..altalune.v1.RequestClientSecretRevealResponse\"\x0f\x8a\xb5\x18\vclient:read\x12v\n" +
+	"\x12RevealClientSecret\x12&.altalune.v1.RevealClientSecretRequest\x1a'.altalune.v1.RevealClientSecretResponse\"\x0f\x8a\xb5\x18\vclient:readB\xa7\x01\n" +
 	"\x0fcom.altalune.v1B\x12OauthProviderProtoP\x01Z3github.com/hrz8/altalune/gen/altalune/v1;altalunev1\xa2\x02\x03AXX\xaa\x02\vAltalune.V1\xca\x02\vAltalune\\V1\xe2\x02\x17Altalune\\V1\\GPBMetadata\xea\x02\fAltalune::V1b\x06proto3"
 
 var (
@@ -922,54 +1061,60 @@ func file_altalune_v1_oauth_provider_proto_rawDescGZIP() []byte {
 }
 
 var file_altalune_v1_oauth_provider_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_altalune_v1_oauth_provider_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_altalune_v1_oauth_provider_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_altalune_v1_oauth_provider_proto_goTypes = []any{
-	(ProviderType)(0),                   // 0: altalune.v1.ProviderType
-	(*OAuthProvider)(nil),               // 1: altalune.v1.OAuthProvider
-	(*QueryOAuthProvidersRequest)(nil),  // 2: altalune.v1.QueryOAuthProvidersRequest
-	(*QueryOAuthProvidersResponse)(nil), // 3: altalune.v1.QueryOAuthProvidersResponse
-	(*CreateOAuthProviderRequest)(nil),  // 4: altalune.v1.CreateOAuthProviderRequest
-	(*CreateOAuthProviderResponse)(nil), // 5: altalune.v1.CreateOAuthProviderResponse
-	(*GetOAuthProviderRequest)(nil),     // 6: altalune.v1.GetOAuthProviderRequest
-	(*GetOAuthProviderResponse)(nil),    // 7: altalune.v1.GetOAuthProviderResponse
-	(*UpdateOAuthProviderRequest)(nil),  // 8: altalune.v1.UpdateOAuthProviderRequest
-	(*UpdateOAuthProviderResponse)(nil), // 9: altalune.v1.UpdateOAuthProviderResponse
-	(*DeleteOAuthProviderRequest)(nil),  // 10: altalune.v1.DeleteOAuthProviderRequest
-	(*DeleteOAuthProviderResponse)(nil), // 11: altalune.v1.DeleteOAuthProviderResponse
-	(*RevealClientSecretRequest)(nil),   // 12: altalune.v1.RevealClientSecretRequest
-	(*RevealClientSecretResponse)(nil),  // 13: altalune.v1.RevealClientSecretResponse
-	(*timestamppb.Timestamp)(nil),       // 14: google.protobuf.Timestamp
-	(*QueryRequest)(nil),                // 15: altalune.v1.QueryRequest
-	(*QueryMetaResponse)(nil),           // 16: altalune.v1.QueryMetaResponse
+	(ProviderType)(0),                         // 0: altalune.v1.ProviderType
+	(*OAuthProvider)(nil),                     // 1: altalune.v1.OAuthProvider
+	(*QueryOAuthProvidersRequest)(nil),        // 2: altalune.v1.QueryOAuthProvidersRequest
+	(*QueryOAuthProvidersResponse)(nil),       // 3: altalune.v1.QueryOAuthProvidersResponse
+	(*CreateOAuthProviderRequest)(nil),        // 4: altalune.v1.CreateOAuthProviderRequest
+	(*CreateOAuthProviderResponse)(nil),       // 5: altalune.v1.CreateOAuthProviderResponse
+	(*GetOAuthProviderRequest)(nil),           // 6: altalune.v1.GetOAuthProviderRequest
+	(*GetOAuthProviderResponse)(nil),          // 7: altalune.v1.GetOAuthProviderResponse
+	(*UpdateOAuthProviderRequest)(nil),        // 8: altalune.v1.UpdateOAuthProviderRequest
+	(*UpdateOAuthProviderResponse)(nil),       // 9: altalune.v1.UpdateOAuthProviderResponse
+	(*DeleteOAuthProviderRequest)(nil),        // 10: altalune.v1.DeleteOAuthProviderRequest
+	(*DeleteOAuthProviderResponse)(nil),       // 11: altalune.v1.DeleteOAuthProviderResponse
+	(*RequestClientSecretRevealRequest)(nil),  // 12: altalune.v1.RequestClientSecretRevealRequest
+	(*RequestClientSecretRevealResponse)(nil), // 13: altalune.v1.RequestClientSecretRevealResponse
+	(*RevealClientSecretRequest)(nil),         // 14: altalune.v1.RevealClientSecretRequest
+	(*RevealClientSecretResponse)(nil),        // 15: altalune.v1.RevealClientSecretResponse
+	(*timestamppb.Timestamp)(nil),             // 16: google.protobuf.Timestamp
+	(*QueryRequest)(nil),                      // 17: altalune.v1.QueryRequest
+	(*QueryMetaResponse)(nil),                 // 18: altalune.v1.QueryMetaResponse
 }
 var file_altalune_v1_oauth_provider_proto_depIdxs = []int32{
 	0,  // 0: altalune.v1.OAuthProvider.provider_type:type_name -> altalune.v1.ProviderType
-	14, // 1: altalune.v1.OAuthProvider.created_at:type_name -> google.protobuf.Timestamp
-	14, // 2: altalune.v1.OAuthProvider.updated_at:type_name -> google.protobuf.Timestamp
-	15, // 3: altalune.v1.QueryOAuthProvidersRequest.query:type_name -> altalune.v1.QueryRequest
+	16, // 1: altalune.v1.OAuthProvider.created_at:type_name -> google.protobuf.Timestamp
+	16, // 2: altalune.v1.OAuthProvider.updated_at:type_name -> google.protobuf.Timestamp
+	17, // 3: altalune.v1.QueryOAuthProvidersRequest.query:type_name -> altalune.v1.QueryRequest
 	1,  // 4: altalune.v1.QueryOAuthProvidersResponse.data:type_name -> altalune.v1.OAuthProvider
-	16, // 5: altalune.v1.QueryOAuthProvidersResponse.meta:type_name -> altalune.v1.QueryMetaResponse
+	18, // 5: altalune.v1.QueryOAuthProvidersResponse.meta:type_name -> altalune.v1.QueryMetaResponse
 	0,  // 6: altalune.v1.CreateOAuthProviderRequest.provider_type:type_name -> altalune.v1.ProviderType
 	1,  // 7: altalune.v1.CreateOAuthProviderResponse.provider:type_name -> altalune.v1.OAuthProvider
 	1,  // 8: altalune.v1.GetOAuthProviderResponse.provider:type_name -> altalune.v1.OAuthProvider
-	1,  // 9: altalune.v1.UpdateOAuthProviderResponse.provider:type_name -> altalune.v1.OAuthProvider
-	2,  // 10: altalune.v1.OAuthProviderService.QueryOAuthProviders:input_type -> altalune.v1.QueryOAuthProvidersRequest
-	4,  // 11: altalune.v1.OAuthProviderService.CreateOAuthProvider:input_type -> altalune.v1.CreateOAuthProviderRequest
-	6,  // 12: altalune.v1.OAuthProviderService.GetOAuthProvider:input_type -> altalune.v1.GetOAuthProviderRequest
-	8,  // 13: altalune.v1.OAuthProviderService.UpdateOAuthProvider:input_type -> altalune.v1.UpdateOAuthProviderRequest
-	10, // 14: altalune.v1.OAuthProviderService.DeleteOAuthProvider:input_type -> altalune.v1.DeleteOAuthProviderRequest
-	12, // 15: altalune.v1.OAuthProviderService.RevealClientSecret:input_type -> altalune.v1.RevealClientSecretRequest
-	3,  // 16: altalune.v1.OAuthProviderService.QueryOAuthProviders:output_type -> altalune.v1.QueryOAuthProvidersResponse
-	5,  // 17: altalune.v1.OAuthProviderService.CreateOAuthProvider:output_type -> altalune.v1.CreateOAuthProviderResponse
-	7,  // 18: altalune.v1.OAuthProviderService.GetOAuthProvider:output_type -> altalune.v1.GetOAuthProviderResponse
-	9,  // 19: altalune.v1.OAuthProviderService.UpdateOAuthProvider:output_type -> altalune.v1.UpdateOAuthProviderResponse
-	11, // 20: altalune.v1.OAuthProviderService.DeleteOAuthProvider:output_type -> altalune.v1.DeleteOAuthProviderResponse
-	13, // 21: altalune.v1.OAuthProviderService.RevealClientSecret:output_type -> altalune.v1.RevealClientSecretResponse
-	16, // [16:22] is the sub-list for method output_type
-	10, // [10:16] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	16, // 9: altalune.v1.UpdateOAuthProviderRequest.expected_updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 10: altalune.v1.UpdateOAuthProviderResponse.provider:type_name -> altalune.v1.OAuthProvider
+	16, // 11: altalune.v1.RequestClientSecretRevealResponse.expires_at:type_name -> google.protobuf.Timestamp
+	2,  // 12: altalune.v1.OAuthProviderService.QueryOAuthProviders:input_type -> altalune.v1.QueryOAuthProvidersRequest
+	4,  // 13: altalune.v1.OAuthProviderService.CreateOAuthProvider:input_type -> altalune.v1.CreateOAuthProviderRequest
+	6,  // 14: altalune.v1.OAuthProviderService.GetOAuthProvider:input_type -> altalune.v1.GetOAuthProviderRequest
+	8,  // 15: altalune.v1.OAuthProviderService.UpdateOAuthProvider:input_type -> altalune.v1.UpdateOAuthProviderRequest
+	10, // 16: altalune.v1.OAuthProviderService.DeleteOAuthProvider:input_type -> altalune.v1.DeleteOAuthProviderRequest
+	12, // 17: altalune.v1.OAuthProviderService.RequestClientSecretReveal:input_type -> altalune.v1.RequestClientSecretRevealRequest
+	14, // 18: altalune.v1.OAuthProviderService.RevealClientSecret:input_type -> altalune.v1.RevealClientSecretRequest
+	3,  // 19: altalune.v1.OAuthProviderService.QueryOAuthProviders:output_type -> altalune.v1.QueryOAuthProvidersResponse
+	5,  // 20: altalune.v1.OAuthProviderService.CreateOAuthProvider:output_type -> altalune.v1.CreateOAuthProviderResponse
+	7,  // 21: altalune.v1.OAuthProviderService.GetOAuthProvider:output_type -> altalune.v1.GetOAuthProviderResponse
+	9,  // 22: altalune.v1.OAuthProviderService.UpdateOAuthProvider:output_type -> altalune.v1.UpdateOAuthProviderResponse
+	11, // 23: altalune.v1.OAuthProviderService.DeleteOAuthProvider:output_type -> altalune.v1.DeleteOAuthProviderResponse
+	13, // 24: altalune.v1.OAuthProviderService.RequestClientSecretReveal:output_type -> altalune.v1.RequestClientSecretRevealResponse
+	15, // 25: altalune.v1.OAuthProviderService.RevealClientSecret:output_type -> altalune.v1.RevealClientSecretResponse
+	19, // [19:26] is the sub-list for method output_type
+	12, // [12:19] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_altalune_v1_oauth_provider_proto_init() }
@@ -978,13 +1123,14 @@ func file_altalune_v1_oauth_provider_proto_init() {
 		return
 	}
 	file_altalune_v1_common_proto_init()
+	file_altalune_v1_options_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_altalune_v1_oauth_provider_proto_rawDesc), len(file_altalune_v1_oauth_provider_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   13,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,12 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	OAuthProviderService_QueryOAuthProviders_FullMethodName = "/altalune.v1.OAuthProviderService/QueryOAuthProviders"
-	OAuthProviderService_CreateOAuthProvider_FullMethodName = "/altalune.v1.OAuthProviderService/CreateOAuthProvider"
-	OAuthProviderService_GetOAuthProvider_FullMethodName    = "/altalune.v1.OAuthProviderService/GetOAuthProvider"
-	OAuthProviderService_UpdateOAuthProvider_FullMethodName = "/altalune.v1.OAuthProviderService/UpdateOAuthProvider"
-	OAuthProviderService_DeleteOAuthProvider_FullMethodName = "/altalune.v1.OAuthProviderService/DeleteOAuthProvider"
-	OAuthProviderService_RevealClientSecret_FullMethodName  = "/altalune.v1.OAuthProviderService/RevealClientSecret"
+	OAuthProviderService_QueryOAuthProviders_FullMethodName       = "/altalune.v1.OAuthProviderService/QueryOAuthProviders"
+	OAuthProviderService_CreateOAuthProvider_FullMethodName       = "/altalune.v1.OAuthProviderService/CreateOAuthProvider"
+	OAuthProviderService_GetOAuthProvider_FullMethodName          = "/altalune.v1.OAuthProviderService/GetOAuthProvider"
+	OAuthProviderService_UpdateOAuthProvider_FullMethodName       = "/altalune.v1.OAuthProviderService/UpdateOAuthProvider"
+	OAuthProviderService_DeleteOAuthProvider_FullMethodName       = "/altalune.v1.OAuthProviderService/DeleteOAuthProvider"
+	OAuthProviderService_RequestClientSecretReveal_FullMethodName = "/altalune.v1.OAuthProviderService/RequestClientSecretReveal"
+	OAuthProviderService_RevealClientSecret_FullMethodName        = "/altalune.v1.OAuthProviderService/RevealClientSecret"
 )
 
 // OAuthProviderServiceClient is the client API for OAuthProviderService service.
@@ -47,8 +48,12 @@ type OAuthProviderServiceClient interface {
 	UpdateOAuthProvider(ctx context.Context, in *UpdateOAuthProviderRequest, opts ...grpc.CallOption) (*UpdateOAuthProviderResponse, error)
 	// DeleteOAuthProvider deletes an OAuth provider
 	DeleteOAuthProvider(ctx context.Context, in *DeleteOAuthProviderRequest, opts ...grpc.CallOption) (*DeleteOAuthProviderResponse, error)
+	// RequestClientSecretReveal emails the caller a short-lived code confirming
+	// a client secret reveal
+	RequestClientSecretReveal(ctx context.Context, in *RequestClientSecretRevealRequest, opts ...grpc.CallOption) (*RequestClientSecretRevealResponse, error)
 	// RevealClientSecret decrypts and returns the plaintext client secret
-	// SECURITY: Separate RPC for audit logging and access control
+	// SECURITY: Separate RPC for audit logging and access control. Requires a
+	// code from RequestClientSecretReveal and a reason, and is rate limited.
 	RevealClientSecret(ctx context.Context, in *RevealClientSecretRequest, opts ...grpc.CallOption) (*RevealClientSecretResponse, error)
 }
 
@@ -110,6 +115,16 @@ func (c *oAuthProviderServiceClient) DeleteOAuthProvider(ctx context.Context, in
 	return out, nil
 }
 
+func (c *oAuthProviderServiceClient) RequestClientSecretReveal(ctx context.Context, in *RequestClientSecretRevealRequest, opts ...grpc.CallOption) (*RequestClientSecretRevealResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestClientSecretRevealResponse)
+	err := c.cc.Invoke(ctx, OAuthProviderService_RequestClientSecretReveal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *oAuthProviderServiceClient) RevealClientSecret(ctx context.Context, in *RevealClientSecretRequest, opts ...grpc.CallOption) (*RevealClientSecretResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RevealClientSecretResponse)
@@ -140,8 +155,12 @@ type OAuthProviderServiceServer interface {
 	UpdateOAuthProvider(context.Context, *UpdateOAuthProviderRequest) (*UpdateOAuthProviderResponse, error)
 	// DeleteOAuthProvider deletes an OAuth provider
 	DeleteOAuthProvider(context.Context, *DeleteOAuthProviderRequest) (*DeleteOAuthProviderResponse, error)
+	// RequestClientSecretReveal emails the caller a short-lived code confirming
+	// a client secret reveal
+	RequestClientSecretReveal(context.Context, *RequestClientSecretRevealRequest) (*RequestClientSecretRevealResponse, error)
 	// RevealClientSecret decrypts and returns the plaintext client secret
-	// SECURITY: Separate RPC for audit logging and access control
+	// SECURITY: Separate RPC for audit logging and access control. Requires a
+	// code from RequestClientSecretReveal and a reason, and is rate limited.
 	RevealClientSecret(context.Context, *RevealClientSecretRequest) (*RevealClientSecretResponse, error)
 	mustEmbedUnimplementedOAuthProviderServiceServer()
 }
@@ -168,6 +187,9 @@ func (UnimplementedOAuthProviderServiceServer) UpdateOAuthProvider(context.Conte
 func (UnimplementedOAuthProviderServiceServer) DeleteOAuthProvider(context.Context, *DeleteOAuthProviderRequest) (*DeleteOAuthProviderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteOAuthProvider not implemented")
 }
+func (UnimplementedOAuthProviderServiceServer) RequestClientSecretReveal(context.Context, *RequestClientSecretRevealRequest) (*RequestClientSecretRevealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestClientSecretReveal not implemented")
+}
 func (UnimplementedOAuthProviderServiceServer) RevealClientSecret(context.Context, *RevealClientSecretRequest) (*RevealClientSecretResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RevealClientSecret not implemented")
 }
@@ -282,6 +304,24 @@ func _OAuthProviderService_DeleteOAuthProvider_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OAuthProviderService_RequestClientSecretReveal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestClientSecretRevealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuthProviderServiceServer).RequestClientSecretReveal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OAuthProviderService_RequestClientSecretReveal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuthProviderServiceServer).RequestClientSecretReveal(ctx, req.(*RequestClientSecretRevealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OAuthProviderService_RevealClientSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RevealClientSecretRequest)
 	if err := dec(in); err != nil {
@@ -327,6 +367,10 @@ var OAuthProviderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteOAuthProvider",
 			Handler:    _OAuthProviderService_DeleteOAuthProvider_Handler,
 		},
+		{
+			MethodName: "RequestClientSecretReveal",
+			Handler:    _OAuthProviderService_RequestClientSecretReveal_Handler,
+		},
 		{
 			MethodName: "RevealClientSecret",
 			Handler:    _OAuthProviderService_RevealClientSecret_Handler,
@@ -26,9 +26,11 @@ const (
 // Permission represents a system permission
 type Permission struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                   // Public nanoid (14 chars)
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`               // Machine-readable: "project:read"
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"` // Human-readable (optional)
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                // Public nanoid (14 chars)
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                            // Machine-readable: "project:read"
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`              // Human-readable (optional)
+	CreatedBy     string                 `protobuf:"bytes,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"` // Public ID of the user who created the permission, empty if unknown
+	UpdatedBy     string                 `protobuf:"bytes,5,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"` // Public ID of the user who last updated the permission, empty if unknown
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,98,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,99,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -86,6 +88,20 @@ func (x *Permission) GetDescription() string {
 	return ""
 }
 
+func (x *Permission) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Permission) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
 func (x *Permission) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
@@ -396,12 +412,15 @@ func (x *GetPermissionResponse) GetPermission() *Permission {
 
 // UpdatePermissionRequest for updating permission details
 type UpdatePermissionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// updated_at of the permission the edit is based on. When set, the update is
+	// rejected with FAILED_PRECONDITION if the permission changed since then.
+	ExpectedUpdatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expected_updated_at,json=expectedUpdatedAt,proto3" json:"expected_updated_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *UpdatePermissionRequest) Reset() {
@@ -455,6 +474,13 @@ func (x *UpdatePermissionRequest) GetDescription() string {
 	return ""
 }
 
+func (x *UpdatePermissionRequest) GetExpectedUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpectedUpdatedAt
+	}
+	return nil
+}
+
 // UpdatePermissionResponse with updated permission
 type UpdatePermissionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -602,12 +628,16 @@ var File_altalune_v1_permission_proto protoreflect.FileDescriptor
 
 const file_altalune_v1_permission_proto_rawDesc = "" +
 	"\n" +
-	"\x1caltalune/v1/permission.proto\x12\valtalune.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\x1a\x18altalune/v1/common.proto\"\xc8\x01\n" +
+	"\x1caltalune/v1/permission.proto\x12\valtalune.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\x1a\x18altalune/v1/common.proto\x1a\x19altalune/v1/options.proto\"\x86\x02\n" +
 	"\n" +
 	"Permission\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x129\n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x04 \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x05 \x01(\tR\tupdatedBy\x129\n" +
 	"\n" +
 	"created_at\x18b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
@@ -630,11 +660,12 @@ const file_altalune_v1_permission_proto_rawDesc = "" +
 	"\x15GetPermissionResponse\x127\n" +
 	"\n" +
 	"permission\x18\x01 \x01(\v2\x17.altalune.v1.PermissionR\n" +
-	"permission\"\x97\x01\n" +
+	"permission\"\xe3\x01\n" +
 	"\x17UpdatePermissionRequest\x12\x1c\n" +
 	"\x02id\x18\x01 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x0e\x18\x14R\x02id\x122\n" +
 	"\x04name\x18\x02 \x01(\tB\x1e\xbaH\x1b\xc8\x01\x01r\x16\x10\x02\x18d2\x10^[a-zA-Z0-9_:]+$R\x04name\x12*\n" +
-	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xf4\x03R\vdescription\"m\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xf4\x03R\vdescription\x12J\n" +
+	"\x13expected_updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x11expectedUpdatedAt\"m\n" +
 	"\x18UpdatePermissionResponse\x127\n" +
 	"\n" +
 	"permission\x18\x01 \x01(\v2\x17.altalune.v1.PermissionR\n" +
@@ -643,13 +674,13 @@ const file_altalune_v1_permission_proto_rawDesc = "" +
 	"\x17DeletePermissionRequest\x12\x1c\n" +
 	"\x02id\x18\x01 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x0e\x18\x14R\x02id\"4\n" +
 	"\x18DeletePermissionResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage2\xf9\x03\n" +
-	"\x11PermissionService\x12a\n" +
-	"\x10QueryPermissions\x12$.altalune.v1.QueryPermissionsRequest\x1a%.altalune.v1.QueryPermissionsResponse\"\x00\x12a\n" +
-	"\x10CreatePermission\x12$.altalune.v1.CreatePermissionRequest\x1a%.altalune.v1.CreatePermissionResponse\"\x00\x12X\n" +
-	"\rGetPermission\x12!.altalune.v1.GetPermissionRequest\x1a\".altalune.v1.GetPermissionResponse\"\x00\x12a\n" +
-	"\x10UpdatePermission\x12$.altalune.v1.UpdatePermissionRequest\x1a%.altalune.v1.UpdatePermissionResponse\"\x00\x12a\n" +
-	"\x10DeletePermission\x12$.altalune.v1.DeletePermissionRequest\x1a%.altalune.v1.DeletePermissionResponse\"\x00B\xa4\x01\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage2\xdc\x04\n" +
+	"\x11PermissionService\x12t\n" +
+	"\x10QueryPermissions\x12$.altalune.v1.QueryPermissionsRequest\x1a%.altalune.v1.QueryPermissionsResponse\"\x13\x8a\xb5\x18\x0fpermission:read\x12u\n" +
+	"\x10CreatePermission\x12$.altalune.v1.CreatePermissionRequest\x1a%.altalune.v1.CreatePermissionResponse\"\x14\x8a\xb5\x18\x10permission:write\x12k\n" +
+	"\rGetPermission\x12!.altalune.v1.GetPermissionRequest\x1a\".altalune.v1.GetPermissionResponse\"\x13\x8a\xb5\x18\x0fpermission:read\x12u\n" +
+	"\x10UpdatePermission\x12$.altalune.v1.UpdatePermissionRequest\x1a%.altalune.v1.UpdatePermissionResponse\"\x14\x8a\xb5\x18\x10permission:write\x12v\n" +
+	"\x10DeletePermission\x12$.altalune.v1.DeletePermissionRequest\x1a%.altalune.v1.DeletePermissionResponse\"\x15\x8a\xb5\x18\x11permission:deleteB\xa4\x01\n" +
 	"\x0fcom.altalune.v1B\x0fPermissionProtoP\x01Z3github.com/hrz8/altalune/gen/altalune/v1;altalunev1\xa2\x02\x03AXX\xaa\x02\vAltalune.V1\xca\x02\vAltalune\\V1\xe2\x02\x17Altalune\\V1\\GPBMetadata\xea\x02\fAltalune::V1b\x06proto3"
 
 var (
@@ -689,22 +720,23 @@ var file_altalune_v1_permission_proto_depIdxs = []int32{
 	13, // 4: altalune.v1.QueryPermissionsResponse.meta:type_name -> altalune.v1.QueryMetaResponse
 	0,  // 5: altalune.v1.CreatePermissionResponse.permission:type_name -> altalune.v1.Permission
 	0,  // 6: altalune.v1.GetPermissionResponse.permission:type_name -> altalune.v1.Permission
-	0,  // 7: altalune.v1.UpdatePermissionResponse.permission:type_name -> altalune.v1.Permission
-	1,  // 8: altalune.v1.PermissionService.QueryPermissions:input_type -> altalune.v1.QueryPermissionsRequest
-	3,  // 9: altalune.v1.PermissionService.CreatePermission:input_type -> altalune.v1.CreatePermissionRequest
-	5,  // 10: altalune.v1.PermissionService.GetPermission:input_type -> altalune.v1.GetPermissionRequest
-	7,  // 11: altalune.v1.PermissionService.UpdatePermission:input_type -> altalune.v1.UpdatePermissionRequest
-	9,  // 12: altalune.v1.PermissionService.DeletePermission:input_type -> altalune.v1.DeletePermissionRequest
-	2,  // 13: altalune.v1.PermissionService.QueryPermissions:output_type -> altalune.v1.QueryPermissionsResponse
-	4,  // 14: altalune.v1.PermissionService.CreatePermission:output_type -> altalune.v1.CreatePermissionResponse
-	6,  // 15: altalune.v1.PermissionService.GetPermission:output_type -> altalune.v1.GetPermissionResponse
-	8,  // 16: altalune.v1.PermissionService.UpdatePermission:output_type -> altalune.v1.UpdatePermissionResponse
-	10, // 17: altalune.v1.PermissionService.DeletePermission:output_type -> altalune.v1.DeletePermissionResponse
-	13, // [13:18] is the sub-list for method output_type
-	8,  // [8:13] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	11, // 7: altalune.v1.UpdatePermissionRequest.expected_updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 8: altalune.v1.UpdatePermissionResponse.permission:type_name -> altalune.v1.Permission
+	1,  // 9: altalune.v1.PermissionService.QueryPermissions:input_type -> altalune.v1.QueryPermissionsRequest
+	3,  // 10: altalune.v1.PermissionService.CreatePermission:input_type -> altalune.v1.CreatePermissionRequest
+	5,  // 11: altalune.v1.PermissionService.GetPermission:input_type -> altalune.v1.GetPermissionRequest
+	7,  // 12: altalune.v1.PermissionService.UpdatePermission:input_type -> altalune.v1.UpdatePermissionRequest
+	9,  // 13: altalune.v1.PermissionService.DeletePermission:input_type -> altalune.v1.DeletePermissionRequest
+	2,  // 14: altalune.v1.PermissionService.QueryPermissions:output_type -> altalune.v1.QueryPermissionsResponse
+	4,  // 15: altalune.v1.PermissionService.CreatePermission:output_type -> altalune.v1.CreatePermissionResponse
+	6,  // 16: altalune.v1.PermissionService.GetPermission:output_type -> altalune.v1.GetPermissionResponse
+	8,  // 17: altalune.v1.PermissionService.UpdatePermission:output_type -> altalune.v1.UpdatePermissionResponse
+	10, // 18: altalune.v1.PermissionService.DeletePermission:output_type -> altalune.v1.DeletePermissionResponse
+	14, // [14:19] is the sub-list for method output_type
+	9,  // [9:14] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_altalune_v1_permission_proto_init() }
@@ -713,6 +745,7 @@ func file_altalune_v1_permission_proto_init() {
 		return
 	}
 	file_altalune_v1_common_proto_init()
+	file_altalune_v1_options_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
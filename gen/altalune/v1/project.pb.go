@@ -23,6 +23,55 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type CredentialPolicyAction int32
+
+const (
+	CredentialPolicyAction_CREDENTIAL_POLICY_ACTION_UNSPECIFIED CredentialPolicyAction = 0
+	CredentialPolicyAction_CREDENTIAL_POLICY_ACTION_FLAG        CredentialPolicyAction = 1 // Report out-of-policy keys to the project owners
+	CredentialPolicyAction_CREDENTIAL_POLICY_ACTION_DISABLE     CredentialPolicyAction = 2 // Deactivate out-of-policy keys
+)
+
+// Enum value maps for CredentialPolicyAction.
+var (
+	CredentialPolicyAction_name = map[int32]string{
+		0: "CREDENTIAL_POLICY_ACTION_UNSPECIFIED",
+		1: "CREDENTIAL_POLICY_ACTION_FLAG",
+		2: "CREDENTIAL_POLICY_ACTION_DISABLE",
+	}
+	CredentialPolicyAction_value = map[string]int32{
+		"CREDENTIAL_POLICY_ACTION_UNSPECIFIED": 0,
+		"CREDENTIAL_POLICY_ACTION_FLAG":        1,
+		"CREDENTIAL_POLICY_ACTION_DISABLE":     2,
+	}
+)
+
+func (x CredentialPolicyAction) Enum() *CredentialPolicyAction {
+	p := new(CredentialPolicyAction)
+	*p = x
+	return p
+}
+
+func (x CredentialPolicyAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CredentialPolicyAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_altalune_v1_project_proto_enumTypes[0].Descriptor()
+}
+
+func (CredentialPolicyAction) Type() protoreflect.EnumType {
+	return &file_altalune_v1_project_proto_enumTypes[0]
+}
+
+func (x CredentialPolicyAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CredentialPolicyAction.Descriptor instead.
+func (CredentialPolicyAction) EnumDescriptor() ([]byte, []int) {
+	return file_altalune_v1_project_proto_rawDescGZIP(), []int{0}
+}
+
 type Project struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -33,6 +82,8 @@ type Project struct {
 	IsDefault     bool                   `protobuf:"varint,6,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,9,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`  // Public ID of the user who created the project, empty if unknown
+	UpdatedBy     string                 `protobuf:"bytes,10,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"` // Public ID of the user who last updated the project, empty if unknown
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -123,6 +174,20 @@ func (x *Project) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Project) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Project) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
 type QueryProjectsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Query         *QueryRequest          `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
@@ -428,13 +493,16 @@ func (x *GetProjectResponse) GetProject() *Project {
 }
 
 type UpdateProjectRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Timezone      string                 `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Timezone    string                 `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// updated_at of the project the edit is based on. When set, the update is
+	// rejected with FAILED_PRECONDITION if the project changed since then.
+	ExpectedUpdatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expected_updated_at,json=expectedUpdatedAt,proto3" json:"expected_updated_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *UpdateProjectRequest) Reset() {
@@ -495,6 +563,13 @@ func (x *UpdateProjectRequest) GetTimezone() string {
 	return ""
 }
 
+func (x *UpdateProjectRequest) GetExpectedUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpectedUpdatedAt
+	}
+	return nil
+}
+
 type UpdateProjectResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
@@ -635,11 +710,562 @@ func (x *DeleteProjectResponse) GetMessage() string {
 	return ""
 }
 
+// ProjectOnboarding is how self-registered users join a project. Unset fields
+// fall back to the auth server configuration.
+type ProjectOnboarding struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	DefaultMemberRole string                 `protobuf:"bytes,1,opt,name=default_member_role,json=defaultMemberRole,proto3" json:"default_member_role,omitempty"` // Empty picks the role from the registration context
+	AutoActivate      *bool                  `protobuf:"varint,2,opt,name=auto_activate,json=autoActivate,proto3,oneof" json:"auto_activate,omitempty"`           // Unset follows auth.autoActivate
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ProjectOnboarding) Reset() {
+	*x = ProjectOnboarding{}
+	mi := &file_altalune_v1_project_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectOnboarding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectOnboarding) ProtoMessage() {}
+
+func (x *ProjectOnboarding) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_project_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectOnboarding.ProtoReflect.Descriptor instead.
+func (*ProjectOnboarding) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_project_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ProjectOnboarding) GetDefaultMemberRole() string {
+	if x != nil {
+		return x.DefaultMemberRole
+	}
+	return ""
+}
+
+func (x *ProjectOnboarding) GetAutoActivate() bool {
+	if x != nil && x.AutoActivate != nil {
+		return *x.AutoActivate
+	}
+	return false
+}
+
+type GetProjectOnboardingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectOnboardingRequest) Reset() {
+	*x = GetProjectOnboardingRequest{}
+	mi := &file_altalune_v1_project_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectOnboardingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectOnboardingRequest) ProtoMessage() {}
+
+func (x *GetProjectOnboardingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_project_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectOnboardingRequest.ProtoReflect.Descriptor instead.
+func (*GetProjectOnboardingRequest) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_project_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetProjectOnboardingRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type GetProjectOnboardingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Onboarding    *ProjectOnboarding     `protobuf:"bytes,1,opt,name=onboarding,proto3" json:"onboarding,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectOnboardingResponse) Reset() {
+	*x = GetProjectOnboardingResponse{}
+	mi := &file_altalune_v1_project_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectOnboardingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectOnboardingResponse) ProtoMessage() {}
+
+func (x *GetProjectOnboardingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_project_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectOnboardingResponse.ProtoReflect.Descriptor instead.
+func (*GetProjectOnboardingResponse) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_project_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetProjectOnboardingResponse) GetOnboarding() *ProjectOnboarding {
+	if x != nil {
+		return x.Onboarding
+	}
+	return nil
+}
+
+type UpdateProjectOnboardingRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId         string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	DefaultMemberRole string                 `protobuf:"bytes,2,opt,name=default_member_role,json=defaultMemberRole,proto3" json:"default_member_role,omitempty"`
+	AutoActivate      *bool                  `protobuf:"varint,3,opt,name=auto_activate,json=autoActivate,proto3,oneof" json:"auto_activate,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UpdateProjectOnboardingRequest) Reset() {
+	*x = UpdateProjectOnboardingRequest{}
+	mi := &file_altalune_v1_project_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProjectOnboardingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProjectOnboardingRequest) ProtoMessage() {}
+
+func (x *UpdateProjectOnboardingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_project_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProjectOnboardingRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProjectOnboardingRequest) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_project_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateProjectOnboardingRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *UpdateProjectOnboardingRequest) GetDefaultMemberRole() string {
+	if x != nil {
+		return x.DefaultMemberRole
+	}
+	return ""
+}
+
+func (x *UpdateProjectOnboardingRequest) GetAutoActivate() bool {
+	if x != nil && x.AutoActivate != nil {
+		return *x.AutoActivate
+	}
+	return false
+}
+
+type UpdateProjectOnboardingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Onboarding    *ProjectOnboarding     `protobuf:"bytes,1,opt,name=onboarding,proto3" json:"onboarding,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProjectOnboardingResponse) Reset() {
+	*x = UpdateProjectOnboardingResponse{}
+	mi := &file_altalune_v1_project_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProjectOnboardingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProjectOnboardingResponse) ProtoMessage() {}
+
+func (x *UpdateProjectOnboardingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_project_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProjectOnboardingResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProjectOnboardingResponse) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_project_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdateProjectOnboardingResponse) GetOnboarding() *ProjectOnboarding {
+	if x != nil {
+		return x.Onboarding
+	}
+	return nil
+}
+
+func (x *UpdateProjectOnboardingResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ProjectCredentialPolicy caps the lifetime of the API keys of a project.
+// Creating, updating and reactivating keys is refused past it, and a daily
+// job applies the action to the keys that were out of policy before it was
+// set or tightened. OAuth clients are global, not tied to a project, so their
+// secrets follow the policy of the default project.
+type ProjectCredentialPolicy struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	ApiKeyMaxLifetimeDays int32                  `protobuf:"varint,1,opt,name=api_key_max_lifetime_days,json=apiKeyMaxLifetimeDays,proto3" json:"api_key_max_lifetime_days,omitempty"` // Days after creation a key may expire, 0 for no maximum
+	Action                CredentialPolicyAction `protobuf:"varint,2,opt,name=action,proto3,enum=altalune.v1.CredentialPolicyAction" json:"action,omitempty"`
+	// How the secrets of new API keys are handed over. OAuth clients are global
+	// and follow the policy of the default project.
+	SecretDelivery SecretDeliveryMode `protobuf:"varint,3,opt,name=secret_delivery,json=secretDelivery,proto3,enum=altalune.v1.SecretDeliveryMode" json:"secret_delivery,omitempty"`
+	// Days after creation or rotation an OAuth client secret expires, 0 for
+	// never. Only the policy of the default project applies.
+	ClientSecretMaxLifetimeDays int32 `protobuf:"varint,4,opt,name=client_secret_max_lifetime_days,json=clientSecretMaxLifetimeDays,proto3" json:"client_secret_max_lifetime_days,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *ProjectCredentialPolicy) Reset() {
+	*x = ProjectCredentialPolicy{}
+	mi := &file_altalune_v1_project_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectCredentialPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectCredentialPolicy) ProtoMessage() {}
+
+func (x *ProjectCredentialPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_project_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectCredentialPolicy.ProtoReflect.Descriptor instead.
+func (*ProjectCredentialPolicy) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_project_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ProjectCredentialPolicy) GetApiKeyMaxLifetimeDays() int32 {
+	if x != nil {
+		return x.ApiKeyMaxLifetimeDays
+	}
+	return 0
+}
+
+func (x *ProjectCredentialPolicy) GetAction() CredentialPolicyAction {
+	if x != nil {
+		return x.Action
+	}
+	return CredentialPolicyAction_CREDENTIAL_POLICY_ACTION_UNSPECIFIED
+}
+
+func (x *ProjectCredentialPolicy) GetSecretDelivery() SecretDeliveryMode {
+	if x != nil {
+		return x.SecretDelivery
+	}
+	return SecretDeliveryMode_SECRET_DELIVERY_MODE_UNSPECIFIED
+}
+
+func (x *ProjectCredentialPolicy) GetClientSecretMaxLifetimeDays() int32 {
+	if x != nil {
+		return x.ClientSecretMaxLifetimeDays
+	}
+	return 0
+}
+
+type GetProjectCredentialPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectCredentialPolicyRequest) Reset() {
+	*x = GetProjectCredentialPolicyRequest{}
+	mi := &file_altalune_v1_project_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectCredentialPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectCredentialPolicyRequest) ProtoMessage() {}
+
+func (x *GetProjectCredentialPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_project_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectCredentialPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetProjectCredentialPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_project_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetProjectCredentialPolicyRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type GetProjectCredentialPolicyResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Policy        *ProjectCredentialPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectCredentialPolicyResponse) Reset() {
+	*x = GetProjectCredentialPolicyResponse{}
+	mi := &file_altalune_v1_project_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectCredentialPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectCredentialPolicyResponse) ProtoMessage() {}
+
+func (x *GetProjectCredentialPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_project_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectCredentialPolicyResponse.ProtoReflect.Descriptor instead.
+func (*GetProjectCredentialPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_project_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetProjectCredentialPolicyResponse) GetPolicy() *ProjectCredentialPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type UpdateProjectCredentialPolicyRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// 0 removes the maximum; keys can never live longer than two years
+	ApiKeyMaxLifetimeDays int32 `protobuf:"varint,2,opt,name=api_key_max_lifetime_days,json=apiKeyMaxLifetimeDays,proto3" json:"api_key_max_lifetime_days,omitempty"`
+	// Unspecified flags out-of-policy keys
+	Action CredentialPolicyAction `protobuf:"varint,3,opt,name=action,proto3,enum=altalune.v1.CredentialPolicyAction" json:"action,omitempty"`
+	// Unspecified reveals secrets in the create responses
+	SecretDelivery SecretDeliveryMode `protobuf:"varint,4,opt,name=secret_delivery,json=secretDelivery,proto3,enum=altalune.v1.SecretDeliveryMode" json:"secret_delivery,omitempty"`
+	// 0 lets OAuth client secrets live until they are rotated
+	ClientSecretMaxLifetimeDays int32 `protobuf:"varint,5,opt,name=client_secret_max_lifetime_days,json=clientSecretMaxLifetimeDays,proto3" json:"client_secret_max_lifetime_days,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *UpdateProjectCredentialPolicyRequest) Reset() {
+	*x = UpdateProjectCredentialPolicyRequest{}
+	mi := &file_altalune_v1_project_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProjectCredentialPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProjectCredentialPolicyRequest) ProtoMessage() {}
+
+func (x *UpdateProjectCredentialPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_project_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProjectCredentialPolicyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProjectCredentialPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_project_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateProjectCredentialPolicyRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *UpdateProjectCredentialPolicyRequest) GetApiKeyMaxLifetimeDays() int32 {
+	if x != nil {
+		return x.ApiKeyMaxLifetimeDays
+	}
+	return 0
+}
+
+func (x *UpdateProjectCredentialPolicyRequest) GetAction() CredentialPolicyAction {
+	if x != nil {
+		return x.Action
+	}
+	return CredentialPolicyAction_CREDENTIAL_POLICY_ACTION_UNSPECIFIED
+}
+
+func (x *UpdateProjectCredentialPolicyRequest) GetSecretDelivery() SecretDeliveryMode {
+	if x != nil {
+		return x.SecretDelivery
+	}
+	return SecretDeliveryMode_SECRET_DELIVERY_MODE_UNSPECIFIED
+}
+
+func (x *UpdateProjectCredentialPolicyRequest) GetClientSecretMaxLifetimeDays() int32 {
+	if x != nil {
+		return x.ClientSecretMaxLifetimeDays
+	}
+	return 0
+}
+
+type UpdateProjectCredentialPolicyResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Policy        *ProjectCredentialPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	Message       string                   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProjectCredentialPolicyResponse) Reset() {
+	*x = UpdateProjectCredentialPolicyResponse{}
+	mi := &file_altalune_v1_project_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProjectCredentialPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProjectCredentialPolicyResponse) ProtoMessage() {}
+
+func (x *UpdateProjectCredentialPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_project_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProjectCredentialPolicyResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProjectCredentialPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_project_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateProjectCredentialPolicyResponse) GetPolicy() *ProjectCredentialPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+func (x *UpdateProjectCredentialPolicyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 var File_altalune_v1_project_proto protoreflect.FileDescriptor
 
 const file_altalune_v1_project_proto_rawDesc = "" +
 	"\n" +
-	"\x19altalune/v1/project.proto\x12\valtalune.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\x1a\x18altalune/v1/common.proto\"\xa2\x02\n" +
+	"\x19altalune/v1/project.proto\x12\valtalune.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\x1a\x18altalune/v1/common.proto\x1a\x19altalune/v1/options.proto\"\xe0\x02\n" +
 	"\aProject\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -651,7 +1277,12 @@ const file_altalune_v1_project_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"G\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\t \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\n" +
+	" \x01(\tR\tupdatedBy\"G\n" +
 	"\x14QueryProjectsRequest\x12/\n" +
 	"\x05query\x18\x01 \x01(\v2\x19.altalune.v1.QueryRequestR\x05query\"u\n" +
 	"\x15QueryProjectsResponse\x12(\n" +
@@ -668,26 +1299,79 @@ const file_altalune_v1_project_proto_rawDesc = "" +
 	"\x11GetProjectRequest\x12\x1b\n" +
 	"\x02id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\x02id\"D\n" +
 	"\x12GetProjectResponse\x12.\n" +
-	"\aproject\x18\x01 \x01(\v2\x14.altalune.v1.ProjectR\aproject\"\xbf\x01\n" +
+	"\aproject\x18\x01 \x01(\v2\x14.altalune.v1.ProjectR\aproject\"\x8b\x02\n" +
 	"\x14UpdateProjectRequest\x12\x1b\n" +
 	"\x02id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\x02id\x125\n" +
 	"\x04name\x18\x02 \x01(\tB!\xbaH\x1e\xc8\x01\x01r\x19\x10\x01\x1822\x13^[a-zA-Z0-9\\s\\-_]+$R\x04name\x12)\n" +
 	"\vdescription\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x18dR\vdescription\x12(\n" +
-	"\btimezone\x18\x04 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x01\x182R\btimezone\"a\n" +
+	"\btimezone\x18\x04 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x01\x182R\btimezone\x12J\n" +
+	"\x13expected_updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x11expectedUpdatedAt\"a\n" +
 	"\x15UpdateProjectResponse\x12.\n" +
 	"\aproject\x18\x01 \x01(\v2\x14.altalune.v1.ProjectR\aproject\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\"3\n" +
 	"\x14DeleteProjectRequest\x12\x1b\n" +
 	"\x02id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\x02id\"1\n" +
 	"\x15DeleteProjectResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage2\xc9\x03\n" +
-	"\x0eProjectService\x12X\n" +
-	"\rQueryProjects\x12!.altalune.v1.QueryProjectsRequest\x1a\".altalune.v1.QueryProjectsResponse\"\x00\x12X\n" +
-	"\rCreateProject\x12!.altalune.v1.CreateProjectRequest\x1a\".altalune.v1.CreateProjectResponse\"\x00\x12O\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\x7f\n" +
+	"\x11ProjectOnboarding\x12.\n" +
+	"\x13default_member_role\x18\x01 \x01(\tR\x11defaultMemberRole\x12(\n" +
+	"\rauto_activate\x18\x02 \x01(\bH\x00R\fautoActivate\x88\x01\x01B\x10\n" +
+	"\x0e_auto_activate\"I\n" +
+	"\x1bGetProjectOnboardingRequest\x12*\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\tprojectId\"^\n" +
+	"\x1cGetProjectOnboardingResponse\x12>\n" +
+	"\n" +
+	"onboarding\x18\x01 \x01(\v2\x1e.altalune.v1.ProjectOnboardingR\n" +
+	"onboarding\"\xd6\x01\n" +
+	"\x1eUpdateProjectOnboardingRequest\x12*\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\tprojectId\x12L\n" +
+	"\x13default_member_role\x18\x02 \x01(\tB\x1c\xbaH\x19r\x17R\x00R\x05adminR\x06memberR\x04userR\x11defaultMemberRole\x12(\n" +
+	"\rauto_activate\x18\x03 \x01(\bH\x00R\fautoActivate\x88\x01\x01B\x10\n" +
+	"\x0e_auto_activate\"{\n" +
+	"\x1fUpdateProjectOnboardingResponse\x12>\n" +
+	"\n" +
+	"onboarding\x18\x01 \x01(\v2\x1e.altalune.v1.ProjectOnboardingR\n" +
+	"onboarding\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xa0\x02\n" +
+	"\x17ProjectCredentialPolicy\x128\n" +
+	"\x19api_key_max_lifetime_days\x18\x01 \x01(\x05R\x15apiKeyMaxLifetimeDays\x12;\n" +
+	"\x06action\x18\x02 \x01(\x0e2#.altalune.v1.CredentialPolicyActionR\x06action\x12H\n" +
+	"\x0fsecret_delivery\x18\x03 \x01(\x0e2\x1f.altalune.v1.SecretDeliveryModeR\x0esecretDelivery\x12D\n" +
+	"\x1fclient_secret_max_lifetime_days\x18\x04 \x01(\x05R\x1bclientSecretMaxLifetimeDays\"O\n" +
+	"!GetProjectCredentialPolicyRequest\x12*\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\tprojectId\"b\n" +
+	"\"GetProjectCredentialPolicyResponse\x12<\n" +
+	"\x06policy\x18\x01 \x01(\v2$.altalune.v1.ProjectCredentialPolicyR\x06policy\"\x85\x03\n" +
+	"$UpdateProjectCredentialPolicyRequest\x12*\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01\x0eR\tprojectId\x12D\n" +
+	"\x19api_key_max_lifetime_days\x18\x02 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xda\x05(\x00R\x15apiKeyMaxLifetimeDays\x12E\n" +
+	"\x06action\x18\x03 \x01(\x0e2#.altalune.v1.CredentialPolicyActionB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06action\x12R\n" +
+	"\x0fsecret_delivery\x18\x04 \x01(\x0e2\x1f.altalune.v1.SecretDeliveryModeB\b\xbaH\x05\x82\x01\x02\x10\x01R\x0esecretDelivery\x12P\n" +
+	"\x1fclient_secret_max_lifetime_days\x18\x05 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xda\x05(\x00R\x1bclientSecretMaxLifetimeDays\"\x7f\n" +
+	"%UpdateProjectCredentialPolicyResponse\x12<\n" +
+	"\x06policy\x18\x01 \x01(\v2$.altalune.v1.ProjectCredentialPolicyR\x06policy\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage*\x8b\x01\n" +
+	"\x16CredentialPolicyAction\x12(\n" +
+	"$CREDENTIAL_POLICY_ACTION_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dCREDENTIAL_POLICY_ACTION_FLAG\x10\x01\x12$\n" +
+	" CREDENTIAL_POLICY_ACTION_DISABLE\x10\x022\xe6\b\n" +
+	"\x0eProjectService\x12z\n" +
+	"\rQueryProjects\x12!.altalune.v1.QueryProjectsRequest\x1a\".altalune.v1.QueryProjectsResponse\"\"\x8a\xb5\x18\fproject:read\x8a\xb5\x18\x0edashboard:read\x12i\n" +
+	"\rCreateProject\x12!.altalune.v1.CreateProjectRequest\x1a\".altalune.v1.CreateProjectResponse\"\x11\x8a\xb5\x18\rproject:write\x12_\n" +
 	"\n" +
-	"GetProject\x12\x1e.altalune.v1.GetProjectRequest\x1a\x1f.altalune.v1.GetProjectResponse\"\x00\x12X\n" +
-	"\rUpdateProject\x12!.altalune.v1.UpdateProjectRequest\x1a\".altalune.v1.UpdateProjectResponse\"\x00\x12X\n" +
-	"\rDeleteProject\x12!.altalune.v1.DeleteProjectRequest\x1a\".altalune.v1.DeleteProjectResponse\"\x00B\xa1\x01\n" +
+	"GetProject\x12\x1e.altalune.v1.GetProjectRequest\x1a\x1f.altalune.v1.GetProjectResponse\"\x10\x8a\xb5\x18\fproject:read\x12i\n" +
+	"\rUpdateProject\x12!.altalune.v1.UpdateProjectRequest\x1a\".altalune.v1.UpdateProjectResponse\"\x11\x8a\xb5\x18\rproject:write\x12j\n" +
+	"\rDeleteProject\x12!.altalune.v1.DeleteProjectRequest\x1a\".altalune.v1.DeleteProjectResponse\"\x12\x8a\xb5\x18\x0eproject:delete\x12}\n" +
+	"\x14GetProjectOnboarding\x12(.altalune.v1.GetProjectOnboardingRequest\x1a).altalune.v1.GetProjectOnboardingResponse\"\x10\x8a\xb5\x18\fproject:read\x12\x87\x01\n" +
+	"\x17UpdateProjectOnboarding\x12+.altalune.v1.UpdateProjectOnboardingRequest\x1a,.altalune.v1.UpdateProjectOnboardingResponse\"\x11\x8a\xb5\x18\rproject:write\x12\x8f\x01\n" +
+	"\x1aGetProjectCredentialPolicy\x12..altalune.v1.GetProjectCredentialPolicyRequest\x1a/.altalune.v1.GetProjectCredentialPolicyResponse\"\x10\x8a\xb5\x18\fproject:read\x12\x99\x01\n" +
+	"\x1dUpdateProjectCredentialPolicy\x121.altalune.v1.UpdateProjectCredentialPolicyRequest\x1a2.altalune.v1.UpdateProjectCredentialPolicyResponse\"\x11\x8a\xb5\x18\rproject:writeB\xa1\x01\n" +
 	"\x0fcom.altalune.v1B\fProjectProtoP\x01Z3github.com/hrz8/altalune/gen/altalune/v1;altalunev1\xa2\x02\x03AXX\xaa\x02\vAltalune.V1\xca\x02\vAltalune\\V1\xe2\x02\x17Altalune\\V1\\GPBMetadata\xea\x02\fAltalune::V1b\x06proto3"
 
 var (
@@ -702,47 +1386,77 @@ func file_altalune_v1_project_proto_rawDescGZIP() []byte {
 	return file_altalune_v1_project_proto_rawDescData
 }
 
-var file_altalune_v1_project_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_altalune_v1_project_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_altalune_v1_project_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_altalune_v1_project_proto_goTypes = []any{
-	(*Project)(nil),               // 0: altalune.v1.Project
-	(*QueryProjectsRequest)(nil),  // 1: altalune.v1.QueryProjectsRequest
-	(*QueryProjectsResponse)(nil), // 2: altalune.v1.QueryProjectsResponse
-	(*CreateProjectRequest)(nil),  // 3: altalune.v1.CreateProjectRequest
-	(*CreateProjectResponse)(nil), // 4: altalune.v1.CreateProjectResponse
-	(*GetProjectRequest)(nil),     // 5: altalune.v1.GetProjectRequest
-	(*GetProjectResponse)(nil),    // 6: altalune.v1.GetProjectResponse
-	(*UpdateProjectRequest)(nil),  // 7: altalune.v1.UpdateProjectRequest
-	(*UpdateProjectResponse)(nil), // 8: altalune.v1.UpdateProjectResponse
-	(*DeleteProjectRequest)(nil),  // 9: altalune.v1.DeleteProjectRequest
-	(*DeleteProjectResponse)(nil), // 10: altalune.v1.DeleteProjectResponse
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
-	(*QueryRequest)(nil),          // 12: altalune.v1.QueryRequest
-	(*QueryMetaResponse)(nil),     // 13: altalune.v1.QueryMetaResponse
+	(CredentialPolicyAction)(0),                   // 0: altalune.v1.CredentialPolicyAction
+	(*Project)(nil),                               // 1: altalune.v1.Project
+	(*QueryProjectsRequest)(nil),                  // 2: altalune.v1.QueryProjectsRequest
+	(*QueryProjectsResponse)(nil),                 // 3: altalune.v1.QueryProjectsResponse
+	(*CreateProjectRequest)(nil),                  // 4: altalune.v1.CreateProjectRequest
+	(*CreateProjectResponse)(nil),                 // 5: altalune.v1.CreateProjectResponse
+	(*GetProjectRequest)(nil),                     // 6: altalune.v1.GetProjectRequest
+	(*GetProjectResponse)(nil),                    // 7: altalune.v1.GetProjectResponse
+	(*UpdateProjectRequest)(nil),                  // 8: altalune.v1.UpdateProjectRequest
+	(*UpdateProjectResponse)(nil),                 // 9: altalune.v1.UpdateProjectResponse
+	(*DeleteProjectRequest)(nil),                  // 10: altalune.v1.DeleteProjectRequest
+	(*DeleteProjectResponse)(nil),                 // 11: altalune.v1.DeleteProjectResponse
+	(*ProjectOnboarding)(nil),                     // 12: altalune.v1.ProjectOnboarding
+	(*GetProjectOnboardingRequest)(nil),           // 13: altalune.v1.GetProjectOnboardingRequest
+	(*GetProjectOnboardingResponse)(nil),          // 14: altalune.v1.GetProjectOnboardingResponse
+	(*UpdateProjectOnboardingRequest)(nil),        // 15: altalune.v1.UpdateProjectOnboardingRequest
+	(*UpdateProjectOnboardingResponse)(nil),       // 16: altalune.v1.UpdateProjectOnboardingResponse
+	(*ProjectCredentialPolicy)(nil),               // 17: altalune.v1.ProjectCredentialPolicy
+	(*GetProjectCredentialPolicyRequest)(nil),     // 18: altalune.v1.GetProjectCredentialPolicyRequest
+	(*GetProjectCredentialPolicyResponse)(nil),    // 19: altalune.v1.GetProjectCredentialPolicyResponse
+	(*UpdateProjectCredentialPolicyRequest)(nil),  // 20: altalune.v1.UpdateProjectCredentialPolicyRequest
+	(*UpdateProjectCredentialPolicyResponse)(nil), // 21: altalune.v1.UpdateProjectCredentialPolicyResponse
+	(*timestamppb.Timestamp)(nil),                 // 22: google.protobuf.Timestamp
+	(*QueryRequest)(nil),                          // 23: altalune.v1.QueryRequest
+	(*QueryMetaResponse)(nil),                     // 24: altalune.v1.QueryMetaResponse
+	(SecretDeliveryMode)(0),                       // 25: altalune.v1.SecretDeliveryMode
 }
 var file_altalune_v1_project_proto_depIdxs = []int32{
-	11, // 0: altalune.v1.Project.created_at:type_name -> google.protobuf.Timestamp
-	11, // 1: altalune.v1.Project.updated_at:type_name -> google.protobuf.Timestamp
-	12, // 2: altalune.v1.QueryProjectsRequest.query:type_name -> altalune.v1.QueryRequest
-	0,  // 3: altalune.v1.QueryProjectsResponse.data:type_name -> altalune.v1.Project
-	13, // 4: altalune.v1.QueryProjectsResponse.meta:type_name -> altalune.v1.QueryMetaResponse
-	0,  // 5: altalune.v1.CreateProjectResponse.project:type_name -> altalune.v1.Project
-	0,  // 6: altalune.v1.GetProjectResponse.project:type_name -> altalune.v1.Project
-	0,  // 7: altalune.v1.UpdateProjectResponse.project:type_name -> altalune.v1.Project
-	1,  // 8: altalune.v1.ProjectService.QueryProjects:input_type -> altalune.v1.QueryProjectsRequest
-	3,  // 9: altalune.v1.ProjectService.CreateProject:input_type -> altalune.v1.CreateProjectRequest
-	5,  // 10: altalune.v1.ProjectService.GetProject:input_type -> altalune.v1.GetProjectRequest
-	7,  // 11: altalune.v1.ProjectService.UpdateProject:input_type -> altalune.v1.UpdateProjectRequest
-	9,  // 12: altalune.v1.ProjectService.DeleteProject:input_type -> altalune.v1.DeleteProjectRequest
-	2,  // 13: altalune.v1.ProjectService.QueryProjects:output_type -> altalune.v1.QueryProjectsResponse
-	4,  // 14: altalune.v1.ProjectService.CreateProject:output_type -> altalune.v1.CreateProjectResponse
-	6,  // 15: altalune.v1.ProjectService.GetProject:output_type -> altalune.v1.GetProjectResponse
-	8,  // 16: altalune.v1.ProjectService.UpdateProject:output_type -> altalune.v1.UpdateProjectResponse
-	10, // 17: altalune.v1.ProjectService.DeleteProject:output_type -> altalune.v1.DeleteProjectResponse
-	13, // [13:18] is the sub-list for method output_type
-	8,  // [8:13] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	22, // 0: altalune.v1.Project.created_at:type_name -> google.protobuf.Timestamp
+	22, // 1: altalune.v1.Project.updated_at:type_name -> google.protobuf.Timestamp
+	23, // 2: altalune.v1.QueryProjectsRequest.query:type_name -> altalune.v1.QueryRequest
+	1,  // 3: altalune.v1.QueryProjectsResponse.data:type_name -> altalune.v1.Project
+	24, // 4: altalune.v1.QueryProjectsResponse.meta:type_name -> altalune.v1.QueryMetaResponse
+	1,  // 5: altalune.v1.CreateProjectResponse.project:type_name -> altalune.v1.Project
+	1,  // 6: altalune.v1.GetProjectResponse.project:type_name -> altalune.v1.Project
+	22, // 7: altalune.v1.UpdateProjectRequest.expected_updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 8: altalune.v1.UpdateProjectResponse.project:type_name -> altalune.v1.Project
+	12, // 9: altalune.v1.GetProjectOnboardingResponse.onboarding:type_name -> altalune.v1.ProjectOnboarding
+	12, // 10: altalune.v1.UpdateProjectOnboardingResponse.onboarding:type_name -> altalune.v1.ProjectOnboarding
+	0,  // 11: altalune.v1.ProjectCredentialPolicy.action:type_name -> altalune.v1.CredentialPolicyAction
+	25, // 12: altalune.v1.ProjectCredentialPolicy.secret_delivery:type_name -> altalune.v1.SecretDeliveryMode
+	17, // 13: altalune.v1.GetProjectCredentialPolicyResponse.policy:type_name -> altalune.v1.ProjectCredentialPolicy
+	0,  // 14: altalune.v1.UpdateProjectCredentialPolicyRequest.action:type_name -> altalune.v1.CredentialPolicyAction
+	25, // 15: altalune.v1.UpdateProjectCredentialPolicyRequest.secret_delivery:type_name -> altalune.v1.SecretDeliveryMode
+	17, // 16: altalune.v1.UpdateProjectCredentialPolicyResponse.policy:type_name -> altalune.v1.ProjectCredentialPolicy
+	2,  // 17: altalune.v1.ProjectService.QueryProjects:input_type -> altalune.v1.QueryProjectsRequest
+	4,  // 18: altalune.v1.ProjectService.CreateProject:input_type -> altalune.v1.CreateProjectRequest
+	6,  // 19: altalune.v1.ProjectService.GetProject:input_type -> altalune.v1.GetProjectRequest
+	8,  // 20: altalune.v1.ProjectService.UpdateProject:input_type -> altalune.v1.UpdateProjectRequest
+	10, // 21: altalune.v1.ProjectService.DeleteProject:input_type -> altalune.v1.DeleteProjectRequest
+	13, // 22: altalune.v1.ProjectService.GetProjectOnboarding:input_type -> altalune.v1.GetProjectOnboardingRequest
+	15, // 23: altalune.v1.ProjectService.UpdateProjectOnboarding:input_type -> altalune.v1.UpdateProjectOnboardingRequest
+	18, // 24: altalune.v1.ProjectService.GetProjectCredentialPolicy:input_type -> altalune.v1.GetProjectCredentialPolicyRequest
+	20, // 25: altalune.v1.ProjectService.UpdateProjectCredentialPolicy:input_type -> altalune.v1.UpdateProjectCredentialPolicyRequest
+	3,  // 26: altalune.v1.ProjectService.QueryProjects:output_type -> altalune.v1.QueryProjectsResponse
+	5,  // 27: altalune.v1.ProjectService.CreateProject:output_type -> altalune.v1.CreateProjectResponse
+	7,  // 28: altalune.v1.ProjectService.GetProject:output_type -> altalune.v1.GetProjectResponse
+	9,  // 29: altalune.v1.ProjectService.UpdateProject:output_type -> altalune.v1.UpdateProjectResponse
+	11, // 30: altalune.v1.ProjectService.DeleteProject:output_type -> altalune.v1.DeleteProjectResponse
+	14, // 31: altalune.v1.ProjectService.GetProjectOnboarding:output_type -> altalune.v1.GetProjectOnboardingResponse
+	16, // 32: altalune.v1.ProjectService.UpdateProjectOnboarding:output_type -> altalune.v1.UpdateProjectOnboardingResponse
+	19, // 33: altalune.v1.ProjectService.GetProjectCredentialPolicy:output_type -> altalune.v1.GetProjectCredentialPolicyResponse
+	21, // 34: altalune.v1.ProjectService.UpdateProjectCredentialPolicy:output_type -> altalune.v1.UpdateProjectCredentialPolicyResponse
+	26, // [26:35] is the sub-list for method output_type
+	17, // [17:26] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_altalune_v1_project_proto_init() }
@@ -751,18 +1465,22 @@ func file_altalune_v1_project_proto_init() {
 		return
 	}
 	file_altalune_v1_common_proto_init()
+	file_altalune_v1_options_proto_init()
+	file_altalune_v1_project_proto_msgTypes[11].OneofWrappers = []any{}
+	file_altalune_v1_project_proto_msgTypes[14].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_altalune_v1_project_proto_rawDesc), len(file_altalune_v1_project_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   11,
+			NumEnums:      1,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_altalune_v1_project_proto_goTypes,
 		DependencyIndexes: file_altalune_v1_project_proto_depIdxs,
+		EnumInfos:         file_altalune_v1_project_proto_enumTypes,
 		MessageInfos:      file_altalune_v1_project_proto_msgTypes,
 	}.Build()
 	File_altalune_v1_project_proto = out.File
@@ -19,11 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ProjectService_QueryProjects_FullMethodName = "/altalune.v1.ProjectService/QueryProjects"
-	ProjectService_CreateProject_FullMethodName = "/altalune.v1.ProjectService/CreateProject"
-	ProjectService_GetProject_FullMethodName    = "/altalune.v1.ProjectService/GetProject"
-	ProjectService_UpdateProject_FullMethodName = "/altalune.v1.ProjectService/UpdateProject"
-	ProjectService_DeleteProject_FullMethodName = "/altalune.v1.ProjectService/DeleteProject"
+	ProjectService_QueryProjects_FullMethodName                 = "/altalune.v1.ProjectService/QueryProjects"
+	ProjectService_CreateProject_FullMethodName                 = "/altalune.v1.ProjectService/CreateProject"
+	ProjectService_GetProject_FullMethodName                    = "/altalune.v1.ProjectService/GetProject"
+	ProjectService_UpdateProject_FullMethodName                 = "/altalune.v1.ProjectService/UpdateProject"
+	ProjectService_DeleteProject_FullMethodName                 = "/altalune.v1.ProjectService/DeleteProject"
+	ProjectService_GetProjectOnboarding_FullMethodName          = "/altalune.v1.ProjectService/GetProjectOnboarding"
+	ProjectService_UpdateProjectOnboarding_FullMethodName       = "/altalune.v1.ProjectService/UpdateProjectOnboarding"
+	ProjectService_GetProjectCredentialPolicy_FullMethodName    = "/altalune.v1.ProjectService/GetProjectCredentialPolicy"
+	ProjectService_UpdateProjectCredentialPolicy_FullMethodName = "/altalune.v1.ProjectService/UpdateProjectCredentialPolicy"
 )
 
 // ProjectServiceClient is the client API for ProjectService service.
@@ -35,6 +39,10 @@ type ProjectServiceClient interface {
 	GetProject(ctx context.Context, in *GetProjectRequest, opts ...grpc.CallOption) (*GetProjectResponse, error)
 	UpdateProject(ctx context.Context, in *UpdateProjectRequest, opts ...grpc.CallOption) (*UpdateProjectResponse, error)
 	DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*DeleteProjectResponse, error)
+	GetProjectOnboarding(ctx context.Context, in *GetProjectOnboardingRequest, opts ...grpc.CallOption) (*GetProjectOnboardingResponse, error)
+	UpdateProjectOnboarding(ctx context.Context, in *UpdateProjectOnboardingRequest, opts ...grpc.CallOption) (*UpdateProjectOnboardingResponse, error)
+	GetProjectCredentialPolicy(ctx context.Context, in *GetProjectCredentialPolicyRequest, opts ...grpc.CallOption) (*GetProjectCredentialPolicyResponse, error)
+	UpdateProjectCredentialPolicy(ctx context.Context, in *UpdateProjectCredentialPolicyRequest, opts ...grpc.CallOption) (*UpdateProjectCredentialPolicyResponse, error)
 }
 
 type projectServiceClient struct {
@@ -95,6 +103,46 @@ func (c *projectServiceClient) DeleteProject(ctx context.Context, in *DeleteProj
 	return out, nil
 }
 
+func (c *projectServiceClient) GetProjectOnboarding(ctx context.Context, in *GetProjectOnboardingRequest, opts ...grpc.CallOption) (*GetProjectOnboardingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProjectOnboardingResponse)
+	err := c.cc.Invoke(ctx, ProjectService_GetProjectOnboarding_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) UpdateProjectOnboarding(ctx context.Context, in *UpdateProjectOnboardingRequest, opts ...grpc.CallOption) (*UpdateProjectOnboardingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateProjectOnboardingResponse)
+	err := c.cc.Invoke(ctx, ProjectService_UpdateProjectOnboarding_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) GetProjectCredentialPolicy(ctx context.Context, in *GetProjectCredentialPolicyRequest, opts ...grpc.CallOption) (*GetProjectCredentialPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProjectCredentialPolicyResponse)
+	err := c.cc.Invoke(ctx, ProjectService_GetProjectCredentialPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) UpdateProjectCredentialPolicy(ctx context.Context, in *UpdateProjectCredentialPolicyRequest, opts ...grpc.CallOption) (*UpdateProjectCredentialPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateProjectCredentialPolicyResponse)
+	err := c.cc.Invoke(ctx, ProjectService_UpdateProjectCredentialPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProjectServiceServer is the server API for ProjectService service.
 // All implementations must embed UnimplementedProjectServiceServer
 // for forward compatibility.
@@ -104,6 +152,10 @@ type ProjectServiceServer interface {
 	GetProject(context.Context, *GetProjectRequest) (*GetProjectResponse, error)
 	UpdateProject(context.Context, *UpdateProjectRequest) (*UpdateProjectResponse, error)
 	DeleteProject(context.Context, *DeleteProjectRequest) (*DeleteProjectResponse, error)
+	GetProjectOnboarding(context.Context, *GetProjectOnboardingRequest) (*GetProjectOnboardingResponse, error)
+	UpdateProjectOnboarding(context.Context, *UpdateProjectOnboardingRequest) (*UpdateProjectOnboardingResponse, error)
+	GetProjectCredentialPolicy(context.Context, *GetProjectCredentialPolicyRequest) (*GetProjectCredentialPolicyResponse, error)
+	UpdateProjectCredentialPolicy(context.Context, *UpdateProjectCredentialPolicyRequest) (*UpdateProjectCredentialPolicyResponse, error)
 	mustEmbedUnimplementedProjectServiceServer()
 }
 
@@ -129,6 +181,18 @@ func (UnimplementedProjectServiceServer) UpdateProject(context.Context, *UpdateP
 func (UnimplementedProjectServiceServer) DeleteProject(context.Context, *DeleteProjectRequest) (*DeleteProjectResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteProject not implemented")
 }
+func (UnimplementedProjectServiceServer) GetProjectOnboarding(context.Context, *GetProjectOnboardingRequest) (*GetProjectOnboardingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProjectOnboarding not implemented")
+}
+func (UnimplementedProjectServiceServer) UpdateProjectOnboarding(context.Context, *UpdateProjectOnboardingRequest) (*UpdateProjectOnboardingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProjectOnboarding not implemented")
+}
+func (UnimplementedProjectServiceServer) GetProjectCredentialPolicy(context.Context, *GetProjectCredentialPolicyRequest) (*GetProjectCredentialPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProjectCredentialPolicy not implemented")
+}
+func (UnimplementedProjectServiceServer) UpdateProjectCredentialPolicy(context.Context, *UpdateProjectCredentialPolicyRequest) (*UpdateProjectCredentialPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProjectCredentialPolicy not implemented")
+}
 func (UnimplementedProjectServiceServer) mustEmbedUnimplementedProjectServiceServer() {}
 func (UnimplementedProjectServiceServer) testEmbeddedByValue()                        {}
 
@@ -240,6 +304,78 @@ func _ProjectService_DeleteProject_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProjectService_GetProjectOnboarding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectOnboardingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).GetProjectOnboarding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_GetProjectOnboarding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).GetProjectOnboarding(ctx, req.(*GetProjectOnboardingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_UpdateProjectOnboarding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProjectOnboardingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).UpdateProjectOnboarding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_UpdateProjectOnboarding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).UpdateProjectOnboarding(ctx, req.(*UpdateProjectOnboardingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_GetProjectCredentialPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectCredentialPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).GetProjectCredentialPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_GetProjectCredentialPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).GetProjectCredentialPolicy(ctx, req.(*GetProjectCredentialPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_UpdateProjectCredentialPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProjectCredentialPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).UpdateProjectCredentialPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_UpdateProjectCredentialPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).UpdateProjectCredentialPolicy(ctx, req.(*UpdateProjectCredentialPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProjectService_ServiceDesc is the grpc.ServiceDesc for ProjectService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -267,6 +403,22 @@ var ProjectService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteProject",
 			Handler:    _ProjectService_DeleteProject_Handler,
 		},
+		{
+			MethodName: "GetProjectOnboarding",
+			Handler:    _ProjectService_GetProjectOnboarding_Handler,
+		},
+		{
+			MethodName: "UpdateProjectOnboarding",
+			Handler:    _ProjectService_UpdateProjectOnboarding_Handler,
+		},
+		{
+			MethodName: "GetProjectCredentialPolicy",
+			Handler:    _ProjectService_GetProjectCredentialPolicy_Handler,
+		},
+		{
+			MethodName: "UpdateProjectCredentialPolicy",
+			Handler:    _ProjectService_UpdateProjectCredentialPolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "altalune/v1/project.proto",
@@ -26,9 +26,12 @@ const (
 // Role represents a system-wide role that can be assigned to users
 type Role struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                   // Public nanoid (14 chars)
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`               // Unique role name
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"` // Optional description
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                   // Public nanoid (14 chars)
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                               // Unique role name
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`                 // Optional description
+	CreatedBy     string                 `protobuf:"bytes,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`    // Public ID of the user who created the role, empty if unknown
+	UpdatedBy     string                 `protobuf:"bytes,5,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`    // Public ID of the user who last updated the role, empty if unknown
+	ExternalId    string                 `protobuf:"bytes,6,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"` // Key of the role in a provisioning tool, empty if unmanaged
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,98,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,99,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -86,6 +89,27 @@ func (x *Role) GetDescription() string {
 	return ""
 }
 
+func (x *Role) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Role) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *Role) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
 func (x *Role) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
@@ -200,9 +224,11 @@ func (x *QueryRolesResponse) GetMeta() *QueryMetaResponse {
 
 // CreateRoleRequest for creating a new role
 type CreateRoleRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Key of the role in a provisioning tool, unique among roles
+	ExternalId    string `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -251,6 +277,13 @@ func (x *CreateRoleRequest) GetDescription() string {
 	return ""
 }
 
+func (x *CreateRoleRequest) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
 // CreateRoleResponse with created role
 type CreateRoleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -396,12 +429,15 @@ func (x *GetRoleResponse) GetRole() *Role {
 
 // UpdateRoleRequest for updating role details
 type UpdateRoleRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// updated_at of the role the edit is based on. When set, the update is
+	// rejected with FAILED_PRECONDITION if the role changed since then.
+	ExpectedUpdatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expected_updated_at,json=expectedUpdatedAt,proto3" json:"expected_updated_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *UpdateRoleRequest) Reset() {
@@ -455,6 +491,13 @@ func (x *UpdateRoleRequest) GetDescription() string {
 	return ""
 }
 
+func (x *UpdateRoleRequest) GetExpectedUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpectedUpdatedAt
+	}
+	return nil
+}
+
 // UpdateRoleResponse with updated role
 type UpdateRoleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -598,15 +641,252 @@ func (x *DeleteRoleResponse) GetMessage() string {
 	return ""
 }
 
+// ApplyRoleRequest creates the role with the external ID, or brings the
+// existing one to the given name and description. Applying the same request
+// again changes nothing.
+type ApplyRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExternalId    string                 `protobuf:"bytes,1,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyRoleRequest) Reset() {
+	*x = ApplyRoleRequest{}
+	mi := &file_altalune_v1_role_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyRoleRequest) ProtoMessage() {}
+
+func (x *ApplyRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_role_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyRoleRequest.ProtoReflect.Descriptor instead.
+func (*ApplyRoleRequest) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_role_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ApplyRoleRequest) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *ApplyRoleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApplyRoleRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// ApplyRoleResponse with the role as applied
+type ApplyRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          *Role                  `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	Created       bool                   `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"` // Whether the role was created rather than updated
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyRoleResponse) Reset() {
+	*x = ApplyRoleResponse{}
+	mi := &file_altalune_v1_role_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyRoleResponse) ProtoMessage() {}
+
+func (x *ApplyRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_role_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyRoleResponse.ProtoReflect.Descriptor instead.
+func (*ApplyRoleResponse) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_role_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ApplyRoleResponse) GetRole() *Role {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+func (x *ApplyRoleResponse) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+func (x *ApplyRoleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ImportRoleRequest sets the external ID of the role with the name, so a
+// provisioning tool can manage a role it did not create
+type ImportRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ExternalId    string                 `protobuf:"bytes,2,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRoleRequest) Reset() {
+	*x = ImportRoleRequest{}
+	mi := &file_altalune_v1_role_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRoleRequest) ProtoMessage() {}
+
+func (x *ImportRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_role_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRoleRequest.ProtoReflect.Descriptor instead.
+func (*ImportRoleRequest) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_role_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ImportRoleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImportRoleRequest) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+// ImportRoleResponse with the imported role
+type ImportRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          *Role                  `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRoleResponse) Reset() {
+	*x = ImportRoleResponse{}
+	mi := &file_altalune_v1_role_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRoleResponse) ProtoMessage() {}
+
+func (x *ImportRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_role_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRoleResponse.ProtoReflect.Descriptor instead.
+func (*ImportRoleResponse) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_role_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ImportRoleResponse) GetRole() *Role {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+func (x *ImportRoleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 var File_altalune_v1_role_proto protoreflect.FileDescriptor
 
 const file_altalune_v1_role_proto_rawDesc = "" +
 	"\n" +
-	"\x16altalune/v1/role.proto\x12\valtalune.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\x1a\x18altalune/v1/common.proto\"\xc2\x01\n" +
+	"\x16altalune/v1/role.proto\x12\valtalune.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\x1a\x18altalune/v1/common.proto\x1a\x19altalune/v1/options.proto\"\xa1\x02\n" +
 	"\x04Role\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x129\n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x04 \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x05 \x01(\tR\tupdatedBy\x12\x1f\n" +
+	"\vexternal_id\x18\x06 \x01(\tR\n" +
+	"externalId\x129\n" +
 	"\n" +
 	"created_at\x18b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
@@ -615,38 +895,64 @@ const file_altalune_v1_role_proto_rawDesc = "" +
 	"\x05query\x18\x01 \x01(\v2\x19.altalune.v1.QueryRequestR\x05query\"o\n" +
 	"\x12QueryRolesResponse\x12%\n" +
 	"\x04data\x18\x01 \x03(\v2\x11.altalune.v1.RoleR\x04data\x122\n" +
-	"\x04meta\x18\x02 \x01(\v2\x1e.altalune.v1.QueryMetaResponseR\x04meta\"v\n" +
+	"\x04meta\x18\x02 \x01(\v2\x1e.altalune.v1.QueryMetaResponseR\x04meta\"\xb9\x01\n" +
 	"\x11CreateRoleRequest\x125\n" +
 	"\x04name\x18\x01 \x01(\tB!\xbaH\x1e\xc8\x01\x01r\x19\x10\x02\x18d2\x13^[a-zA-Z0-9\\s\\-_]+$R\x04name\x12*\n" +
-	"\vdescription\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xf4\x03R\vdescription\"U\n" +
+	"\vdescription\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xf4\x03R\vdescription\x12A\n" +
+	"\vexternal_id\x18\x03 \x01(\tB \xbaH\x1d\xd8\x01\x01r\x18\x18d2\x14^[A-Za-z0-9._:/@-]+$R\n" +
+	"externalId\"U\n" +
 	"\x12CreateRoleResponse\x12%\n" +
 	"\x04role\x18\x01 \x01(\v2\x11.altalune.v1.RoleR\x04role\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\".\n" +
 	"\x0eGetRoleRequest\x12\x1c\n" +
 	"\x02id\x18\x01 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x0e\x18\x14R\x02id\"8\n" +
 	"\x0fGetRoleResponse\x12%\n" +
-	"\x04role\x18\x01 \x01(\v2\x11.altalune.v1.RoleR\x04role\"\x94\x01\n" +
+	"\x04role\x18\x01 \x01(\v2\x11.altalune.v1.RoleR\x04role\"\xe0\x01\n" +
 	"\x11UpdateRoleRequest\x12\x1c\n" +
 	"\x02id\x18\x01 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x0e\x18\x14R\x02id\x125\n" +
 	"\x04name\x18\x02 \x01(\tB!\xbaH\x1e\xc8\x01\x01r\x19\x10\x02\x18d2\x13^[a-zA-Z0-9\\s\\-_]+$R\x04name\x12*\n" +
-	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xf4\x03R\vdescription\"U\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xf4\x03R\vdescription\x12J\n" +
+	"\x13expected_updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x11expectedUpdatedAt\"U\n" +
 	"\x12UpdateRoleResponse\x12%\n" +
 	"\x04role\x18\x01 \x01(\v2\x11.altalune.v1.RoleR\x04role\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\"1\n" +
 	"\x11DeleteRoleRequest\x12\x1c\n" +
 	"\x02id\x18\x01 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x0e\x18\x14R\x02id\".\n" +
 	"\x12DeleteRoleResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage2\x99\x03\n" +
-	"\vRoleService\x12O\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\xb8\x01\n" +
+	"\x10ApplyRoleRequest\x12A\n" +
+	"\vexternal_id\x18\x01 \x01(\tB \xbaH\x1d\xc8\x01\x01r\x18\x18d2\x14^[A-Za-z0-9._:/@-]+$R\n" +
+	"externalId\x125\n" +
+	"\x04name\x18\x02 \x01(\tB!\xbaH\x1e\xc8\x01\x01r\x19\x10\x02\x18d2\x13^[a-zA-Z0-9\\s\\-_]+$R\x04name\x12*\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xf4\x03R\vdescription\"n\n" +
+	"\x11ApplyRoleResponse\x12%\n" +
+	"\x04role\x18\x01 \x01(\v2\x11.altalune.v1.RoleR\x04role\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\bR\acreated\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"x\n" +
+	"\x11ImportRoleRequest\x12 \n" +
+	"\x04name\x18\x01 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x02\x18dR\x04name\x12A\n" +
+	"\vexternal_id\x18\x02 \x01(\tB \xbaH\x1d\xc8\x01\x01r\x18\x18d2\x14^[A-Za-z0-9._:/@-]+$R\n" +
+	"externalId\"U\n" +
+	"\x12ImportRoleResponse\x12%\n" +
+	"\x04role\x18\x01 \x01(\v2\x11.altalune.v1.RoleR\x04role\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage2\x99\x05\n" +
+	"\vRoleService\x12\\\n" +
+	"\n" +
+	"QueryRoles\x12\x1e.altalune.v1.QueryRolesRequest\x1a\x1f.altalune.v1.QueryRolesResponse\"\r\x8a\xb5\x18\trole:read\x12]\n" +
 	"\n" +
-	"QueryRoles\x12\x1e.altalune.v1.QueryRolesRequest\x1a\x1f.altalune.v1.QueryRolesResponse\"\x00\x12O\n" +
+	"CreateRole\x12\x1e.altalune.v1.CreateRoleRequest\x1a\x1f.altalune.v1.CreateRoleResponse\"\x0e\x8a\xb5\x18\n" +
+	"role:write\x12S\n" +
+	"\aGetRole\x12\x1b.altalune.v1.GetRoleRequest\x1a\x1c.altalune.v1.GetRoleResponse\"\r\x8a\xb5\x18\trole:read\x12]\n" +
 	"\n" +
-	"CreateRole\x12\x1e.altalune.v1.CreateRoleRequest\x1a\x1f.altalune.v1.CreateRoleResponse\"\x00\x12F\n" +
-	"\aGetRole\x12\x1b.altalune.v1.GetRoleRequest\x1a\x1c.altalune.v1.GetRoleResponse\"\x00\x12O\n" +
+	"UpdateRole\x12\x1e.altalune.v1.UpdateRoleRequest\x1a\x1f.altalune.v1.UpdateRoleResponse\"\x0e\x8a\xb5\x18\n" +
+	"role:write\x12^\n" +
 	"\n" +
-	"UpdateRole\x12\x1e.altalune.v1.UpdateRoleRequest\x1a\x1f.altalune.v1.UpdateRoleResponse\"\x00\x12O\n" +
+	"DeleteRole\x12\x1e.altalune.v1.DeleteRoleRequest\x1a\x1f.altalune.v1.DeleteRoleResponse\"\x0f\x8a\xb5\x18\vrole:delete\x12Z\n" +
+	"\tApplyRole\x12\x1d.altalune.v1.ApplyRoleRequest\x1a\x1e.altalune.v1.ApplyRoleResponse\"\x0e\x8a\xb5\x18\n" +
+	"role:write\x12]\n" +
 	"\n" +
-	"DeleteRole\x12\x1e.altalune.v1.DeleteRoleRequest\x1a\x1f.altalune.v1.DeleteRoleResponse\"\x00B\x9e\x01\n" +
+	"ImportRole\x12\x1e.altalune.v1.ImportRoleRequest\x1a\x1f.altalune.v1.ImportRoleResponse\"\x0e\x8a\xb5\x18\n" +
+	"role:writeB\x9e\x01\n" +
 	"\x0fcom.altalune.v1B\tRoleProtoP\x01Z3github.com/hrz8/altalune/gen/altalune/v1;altalunev1\xa2\x02\x03AXX\xaa\x02\vAltalune.V1\xca\x02\vAltalune\\V1\xe2\x02\x17Altalune\\V1\\GPBMetadata\xea\x02\fAltalune::V1b\x06proto3"
 
 var (
@@ -661,7 +967,7 @@ func file_altalune_v1_role_proto_rawDescGZIP() []byte {
 	return file_altalune_v1_role_proto_rawDescData
 }
 
-var file_altalune_v1_role_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_altalune_v1_role_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_altalune_v1_role_proto_goTypes = []any{
 	(*Role)(nil),                  // 0: altalune.v1.Role
 	(*QueryRolesRequest)(nil),     // 1: altalune.v1.QueryRolesRequest
@@ -674,34 +980,45 @@ var file_altalune_v1_role_proto_goTypes = []any{
 	(*UpdateRoleResponse)(nil),    // 8: altalune.v1.UpdateRoleResponse
 	(*DeleteRoleRequest)(nil),     // 9: altalune.v1.DeleteRoleRequest
 	(*DeleteRoleResponse)(nil),    // 10: altalune.v1.DeleteRoleResponse
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
-	(*QueryRequest)(nil),          // 12: altalune.v1.QueryRequest
-	(*QueryMetaResponse)(nil),     // 13: altalune.v1.QueryMetaResponse
+	(*ApplyRoleRequest)(nil),      // 11: altalune.v1.ApplyRoleRequest
+	(*ApplyRoleResponse)(nil),     // 12: altalune.v1.ApplyRoleResponse
+	(*ImportRoleRequest)(nil),     // 13: altalune.v1.ImportRoleRequest
+	(*ImportRoleResponse)(nil),    // 14: altalune.v1.ImportRoleResponse
+	(*timestamppb.Timestamp)(nil), // 15: google.protobuf.Timestamp
+	(*QueryRequest)(nil),          // 16: altalune.v1.QueryRequest
+	(*QueryMetaResponse)(nil),     // 17: altalune.v1.QueryMetaResponse
 }
 var file_altalune_v1_role_proto_depIdxs = []int32{
-	11, // 0: altalune.v1.Role.created_at:type_name -> google.protobuf.Timestamp
-	11, // 1: altalune.v1.Role.updated_at:type_name -> google.protobuf.Timestamp
-	12, // 2: altalune.v1.QueryRolesRequest.query:type_name -> altalune.v1.QueryRequest
+	15, // 0: altalune.v1.Role.created_at:type_name -> google.protobuf.Timestamp
+	15, // 1: altalune.v1.Role.updated_at:type_name -> google.protobuf.Timestamp
+	16, // 2: altalune.v1.QueryRolesRequest.query:type_name -> altalune.v1.QueryRequest
 	0,  // 3: altalune.v1.QueryRolesResponse.data:type_name -> altalune.v1.Role
-	13, // 4: altalune.v1.QueryRolesResponse.meta:type_name -> altalune.v1.QueryMetaResponse
+	17, // 4: altalune.v1.QueryRolesResponse.meta:type_name -> altalune.v1.QueryMetaResponse
 	0,  // 5: altalune.v1.CreateRoleResponse.role:type_name -> altalune.v1.Role
 	0,  // 6: altalune.v1.GetRoleResponse.role:type_name -> altalune.v1.Role
-	0,  // 7: altalune.v1.UpdateRoleResponse.role:type_name -> altalune.v1.Role
-	1,  // 8: altalune.v1.RoleService.QueryRoles:input_type -> altalune.v1.QueryRolesRequest
-	3,  // 9: altalune.v1.RoleService.CreateRole:input_type -> altalune.v1.CreateRoleRequest
-	5,  // 10: altalune.v1.RoleService.GetRole:input_type -> altalune.v1.GetRoleRequest
-	7,  // 11: altalune.v1.RoleService.UpdateRole:input_type -> altalune.v1.UpdateRoleRequest
-	9,  // 12: altalune.v1.RoleService.DeleteRole:input_type -> altalune.v1.DeleteRoleRequest
-	2,  // 13: altalune.v1.RoleService.QueryRoles:output_type -> altalune.v1.QueryRolesResponse
-	4,  // 14: altalune.v1.RoleService.CreateRole:output_type -> altalune.v1.CreateRoleResponse
-	6,  // 15: altalune.v1.RoleService.GetRole:output_type -> altalune.v1.GetRoleResponse
-	8,  // 16: altalune.v1.RoleService.UpdateRole:output_type -> altalune.v1.UpdateRoleResponse
-	10, // 17: altalune.v1.RoleService.DeleteRole:output_type -> altalune.v1.DeleteRoleResponse
-	13, // [13:18] is the sub-list for method output_type
-	8,  // [8:13] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	15, // 7: altalune.v1.UpdateRoleRequest.expected_updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 8: altalune.v1.UpdateRoleResponse.role:type_name -> altalune.v1.Role
+	0,  // 9: altalune.v1.ApplyRoleResponse.role:type_name -> altalune.v1.Role
+	0,  // 10: altalune.v1.ImportRoleResponse.role:type_name -> altalune.v1.Role
+	1,  // 11: altalune.v1.RoleService.QueryRoles:input_type -> altalune.v1.QueryRolesRequest
+	3,  // 12: altalune.v1.RoleService.CreateRole:input_type -> altalune.v1.CreateRoleRequest
+	5,  // 13: altalune.v1.RoleService.GetRole:input_type -> altalune.v1.GetRoleRequest
+	7,  // 14: altalune.v1.RoleService.UpdateRole:input_type -> altalune.v1.UpdateRoleRequest
+	9,  // 15: altalune.v1.RoleService.DeleteRole:input_type -> altalune.v1.DeleteRoleRequest
+	11, // 16: altalune.v1.RoleService.ApplyRole:input_type -> altalune.v1.ApplyRoleRequest
+	13, // 17: altalune.v1.RoleService.ImportRole:input_type -> altalune.v1.ImportRoleRequest
+	2,  // 18: altalune.v1.RoleService.QueryRoles:output_type -> altalune.v1.QueryRolesResponse
+	4,  // 19: altalune.v1.RoleService.CreateRole:output_type -> altalune.v1.CreateRoleResponse
+	6,  // 20: altalune.v1.RoleService.GetRole:output_type -> altalune.v1.GetRoleResponse
+	8,  // 21: altalune.v1.RoleService.UpdateRole:output_type -> altalune.v1.UpdateRoleResponse
+	10, // 22: altalune.v1.RoleService.DeleteRole:output_type -> altalune.v1.DeleteRoleResponse
+	12, // 23: altalune.v1.RoleService.ApplyRole:output_type -> altalune.v1.ApplyRoleResponse
+	14, // 24: altalune.v1.RoleService.ImportRole:output_type -> altalune.v1.ImportRoleResponse
+	18, // [18:25] is the sub-list for method output_type
+	11, // [11:18] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_altalune_v1_role_proto_init() }
@@ -710,13 +1027,14 @@ func file_altalune_v1_role_proto_init() {
 		return
 	}
 	file_altalune_v1_common_proto_init()
+	file_altalune_v1_options_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_altalune_v1_role_proto_rawDesc), len(file_altalune_v1_role_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
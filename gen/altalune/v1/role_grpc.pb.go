@@ -24,6 +24,8 @@ const (
 	RoleService_GetRole_FullMethodName    = "/altalune.v1.RoleService/GetRole"
 	RoleService_UpdateRole_FullMethodName = "/altalune.v1.RoleService/UpdateRole"
 	RoleService_DeleteRole_FullMethodName = "/altalune.v1.RoleService/DeleteRole"
+	RoleService_ApplyRole_FullMethodName  = "/altalune.v1.RoleService/ApplyRole"
+	RoleService_ImportRole_FullMethodName = "/altalune.v1.RoleService/ImportRole"
 )
 
 // RoleServiceClient is the client API for RoleService service.
@@ -37,6 +39,10 @@ type RoleServiceClient interface {
 	GetRole(ctx context.Context, in *GetRoleRequest, opts ...grpc.CallOption) (*GetRoleResponse, error)
 	UpdateRole(ctx context.Context, in *UpdateRoleRequest, opts ...grpc.CallOption) (*UpdateRoleResponse, error)
 	DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...grpc.CallOption) (*DeleteRoleResponse, error)
+	// Create-or-update by external ID, for declarative provisioning
+	ApplyRole(ctx context.Context, in *ApplyRoleRequest, opts ...grpc.CallOption) (*ApplyRoleResponse, error)
+	// Bring an existing role under an external ID, found by name
+	ImportRole(ctx context.Context, in *ImportRoleRequest, opts ...grpc.CallOption) (*ImportRoleResponse, error)
 }
 
 type roleServiceClient struct {
@@ -97,6 +103,26 @@ func (c *roleServiceClient) DeleteRole(ctx context.Context, in *DeleteRoleReques
 	return out, nil
 }
 
+func (c *roleServiceClient) ApplyRole(ctx context.Context, in *ApplyRoleRequest, opts ...grpc.CallOption) (*ApplyRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyRoleResponse)
+	err := c.cc.Invoke(ctx, RoleService_ApplyRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleServiceClient) ImportRole(ctx context.Context, in *ImportRoleRequest, opts ...grpc.CallOption) (*ImportRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportRoleResponse)
+	err := c.cc.Invoke(ctx, RoleService_ImportRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RoleServiceServer is the server API for RoleService service.
 // All implementations must embed UnimplementedRoleServiceServer
 // for forward compatibility.
@@ -108,6 +134,10 @@ type RoleServiceServer interface {
 	GetRole(context.Context, *GetRoleRequest) (*GetRoleResponse, error)
 	UpdateRole(context.Context, *UpdateRoleRequest) (*UpdateRoleResponse, error)
 	DeleteRole(context.Context, *DeleteRoleRequest) (*DeleteRoleResponse, error)
+	// Create-or-update by external ID, for declarative provisioning
+	ApplyRole(context.Context, *ApplyRoleRequest) (*ApplyRoleResponse, error)
+	// Bring an existing role under an external ID, found by name
+	ImportRole(context.Context, *ImportRoleRequest) (*ImportRoleResponse, error)
 	mustEmbedUnimplementedRoleServiceServer()
 }
 
@@ -133,6 +163,12 @@ func (UnimplementedRoleServiceServer) UpdateRole(context.Context, *UpdateRoleReq
 func (UnimplementedRoleServiceServer) DeleteRole(context.Context, *DeleteRoleRequest) (*DeleteRoleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteRole not implemented")
 }
+func (UnimplementedRoleServiceServer) ApplyRole(context.Context, *ApplyRoleRequest) (*ApplyRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyRole not implemented")
+}
+func (UnimplementedRoleServiceServer) ImportRole(context.Context, *ImportRoleRequest) (*ImportRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportRole not implemented")
+}
 func (UnimplementedRoleServiceServer) mustEmbedUnimplementedRoleServiceServer() {}
 func (UnimplementedRoleServiceServer) testEmbeddedByValue()                     {}
 
@@ -244,6 +280,42 @@ func _RoleService_DeleteRole_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RoleService_ApplyRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).ApplyRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleService_ApplyRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).ApplyRole(ctx, req.(*ApplyRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleService_ImportRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).ImportRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleService_ImportRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).ImportRole(ctx, req.(*ImportRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RoleService_ServiceDesc is the grpc.ServiceDesc for RoleService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -271,6 +343,14 @@ var RoleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteRole",
 			Handler:    _RoleService_DeleteRole_Handler,
 		},
+		{
+			MethodName: "ApplyRole",
+			Handler:    _RoleService_ApplyRole_Handler,
+		},
+		{
+			MethodName: "ImportRole",
+			Handler:    _RoleService_ImportRole_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "altalune/v1/role.proto",
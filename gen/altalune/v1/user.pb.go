@@ -10,6 +10,7 @@ import (
 	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -23,15 +24,70 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// UserType tells human users apart from service accounts
+type UserType int32
+
+const (
+	UserType_USER_TYPE_UNSPECIFIED UserType = 0
+	UserType_USER_TYPE_HUMAN       UserType = 1
+	// Automation identity: owns API keys and holds roles and permissions, but
+	// has no email and cannot sign in interactively
+	UserType_USER_TYPE_SERVICE_ACCOUNT UserType = 2
+)
+
+// Enum value maps for UserType.
+var (
+	UserType_name = map[int32]string{
+		0: "USER_TYPE_UNSPECIFIED",
+		1: "USER_TYPE_HUMAN",
+		2: "USER_TYPE_SERVICE_ACCOUNT",
+	}
+	UserType_value = map[string]int32{
+		"USER_TYPE_UNSPECIFIED":     0,
+		"USER_TYPE_HUMAN":           1,
+		"USER_TYPE_SERVICE_ACCOUNT": 2,
+	}
+)
+
+func (x UserType) Enum() *UserType {
+	p := new(UserType)
+	*p = x
+	return p
+}
+
+func (x UserType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserType) Descriptor() protoreflect.EnumDescriptor {
+	return file_altalune_v1_user_proto_enumTypes[0].Descriptor()
+}
+
+func (UserType) Type() protoreflect.EnumType {
+	return &file_altalune_v1_user_proto_enumTypes[0]
+}
+
+func (x UserType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserType.Descriptor instead.
+func (UserType) EnumDescriptor() ([]byte, []int) {
+	return file_altalune_v1_user_proto_rawDescGZIP(), []int{0}
+}
+
 // User represents a global system user with OAuth-only authentication
 type User struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                             // Public nanoid (14 chars)
-	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`                                       // Unique, case-insensitive
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`                                       // Unique, case-insensitive; empty for service accounts
 	FirstName     string                 `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`              // Optional
 	LastName      string                 `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`                 // Optional
 	IsActive      bool                   `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`                // User activation status
 	EmailVerified bool                   `protobuf:"varint,6,opt,name=email_verified,json=emailVerified,proto3" json:"email_verified,omitempty"` // Email verification status
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`              // Set only for users in the trash
+	LockedUntil   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=locked_until,json=lockedUntil,proto3" json:"locked_until,omitempty"`        // Set only while locked after too many failed sign-ins
+	Type          UserType               `protobuf:"varint,9,opt,name=type,proto3,enum=altalune.v1.UserType" json:"type,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,98,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,99,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -110,6 +166,27 @@ func (x *User) GetEmailVerified() bool {
 	return false
 }
 
+func (x *User) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *User) GetLockedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LockedUntil
+	}
+	return nil
+}
+
+func (x *User) GetType() UserType {
+	if x != nil {
+		return x.Type
+	}
+	return UserType_USER_TYPE_UNSPECIFIED
+}
+
 func (x *User) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
@@ -253,6 +330,8 @@ func (x *UserIdentity) GetUpdatedAt() *timestamppb.Timestamp {
 type QueryUsersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Query         *QueryRequest          `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Trashed       bool                   `protobuf:"varint,2,opt,name=trashed,proto3" json:"trashed,omitempty"`                  // List deleted users instead of live ones
+	ReadMask      *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"` // User fields to return, all when empty; top-level only
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -294,6 +373,20 @@ func (x *QueryUsersRequest) GetQuery() *QueryRequest {
 	return nil
 }
 
+func (x *QueryUsersRequest) GetTrashed() bool {
+	if x != nil {
+		return x.Trashed
+	}
+	return false
+}
+
+func (x *QueryUsersRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
 // QueryUsersResponse with user list and metadata
 type QueryUsersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -347,6 +440,116 @@ func (x *QueryUsersResponse) GetMeta() *QueryMetaResponse {
 	return nil
 }
 
+// StreamUsersRequest streams every user matching the query instead of a
+// single page. Each message carries up to pagination.page_size rows, starting
+// after the row of the query cursor if any, at pagination.page otherwise;
+// meta is only set on the first message. Users come in the order of the
+// sorting of the query.
+type StreamUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         *QueryRequest          `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	ReadMask      *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"` // User fields to return, all when empty; top-level only
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamUsersRequest) Reset() {
+	*x = StreamUsersRequest{}
+	mi := &file_altalune_v1_user_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamUsersRequest) ProtoMessage() {}
+
+func (x *StreamUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_user_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamUsersRequest.ProtoReflect.Descriptor instead.
+func (*StreamUsersRequest) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_user_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StreamUsersRequest) GetQuery() *QueryRequest {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *StreamUsersRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+// StreamUsersResponse with a page of users
+type StreamUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*User                `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Meta          *QueryMetaResponse     `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamUsersResponse) Reset() {
+	*x = StreamUsersResponse{}
+	mi := &file_altalune_v1_user_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamUsersResponse) ProtoMessage() {}
+
+func (x *StreamUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_user_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamUsersResponse.ProtoReflect.Descriptor instead.
+func (*StreamUsersResponse) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_user_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *StreamUsersResponse) GetData() []*User {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *StreamUsersResponse) GetMeta() *QueryMetaResponse {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
 // CreateUserRequest for creating a new user
 type CreateUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -359,7 +562,7 @@ type CreateUserRequest struct {
 
 func (x *CreateUserRequest) Reset() {
 	*x = CreateUserRequest{}
-	mi := &file_altalune_v1_user_proto_msgTypes[4]
+	mi := &file_altalune_v1_user_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -371,7 +574,7 @@ func (x *CreateUserRequest) String() string {
 func (*CreateUserRequest) ProtoMessage() {}
 
 func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_altalune_v1_user_proto_msgTypes[4]
+	mi := &file_altalune_v1_user_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -384,7 +587,7 @@ func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
 func (*CreateUserRequest) Descriptor() ([]byte, []int) {
-	return file_altalune_v1_user_proto_rawDescGZIP(), []int{4}
+	return file_altalune_v1_user_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CreateUserRequest) GetEmail() string {
@@ -419,7 +622,7 @@ type CreateUserResponse struct {
 
 func (x *CreateUserResponse) Reset() {
 	*x = CreateUserResponse{}
-	mi := &file_altalune_v1_user_proto_msgTypes[5]
+	mi := &file_altalune_v1_user_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -431,7 +634,7 @@ func (x *CreateUserResponse) String() string {
 func (*CreateUserResponse) ProtoMessage() {}
 
 func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_altalune_v1_user_proto_msgTypes[5]
+	mi := &file_altalune_v1_user_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -444,7 +647,7 @@ func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateUserResponse.ProtoReflect.Descriptor instead.
 func (*CreateUserResponse) Descriptor() ([]byte, []int) {
-	return file_altalune_v1_user_proto_rawDescGZIP(), []int{5}
+	return file_altalune_v1_user_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CreateUserResponse) GetUser() *User {
@@ -461,6 +664,105 @@ func (x *CreateUserResponse) GetMessage() string {
 	return ""
 }
 
+// CreateServiceAccountRequest for creating a service account. The name is
+// stored as its first name.
+type CreateServiceAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateServiceAccountRequest) Reset() {
+	*x = CreateServiceAccountRequest{}
+	mi := &file_altalune_v1_user_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateServiceAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateServiceAccountRequest) ProtoMessage() {}
+
+func (x *CreateServiceAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_user_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateServiceAccountRequest.ProtoReflect.Descriptor instead.
+func (*CreateServiceAccountRequest) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_user_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateServiceAccountRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// CreateServiceAccountResponse with the created service account
+type CreateServiceAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateServiceAccountResponse) Reset() {
+	*x = CreateServiceAccountResponse{}
+	mi := &file_altalune_v1_user_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateServiceAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateServiceAccountResponse) ProtoMessage() {}
+
+func (x *CreateServiceAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_user_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateServiceAccountResponse.ProtoReflect.Descriptor instead.
+func (*CreateServiceAccountResponse) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_user_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateServiceAccountResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *CreateServiceAccountResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 // GetUserRequest for retrieving a single user
 type GetUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -471,7 +773,7 @@ type GetUserRequest struct {
 
 func (x *GetUserRequest) Reset() {
 	*x = GetUserRequest{}
-	mi := &file_altalune_v1_user_proto_msgTypes[6]
+	mi := &file_altalune_v1_user_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -483,7 +785,7 @@ func (x *GetUserRequest) String() string {
 func (*GetUserRequest) ProtoMessage() {}
 
 func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_altalune_v1_user_proto_msgTypes[6]
+	mi := &file_altalune_v1_user_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -496,7 +798,7 @@ func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
 func (*GetUserRequest) Descriptor() ([]byte, []int) {
-	return file_altalune_v1_user_proto_rawDescGZIP(), []int{6}
+	return file_altalune_v1_user_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetUserRequest) GetId() string {
@@ -517,7 +819,7 @@ type GetUserResponse struct {
 
 func (x *GetUserResponse) Reset() {
 	*x = GetUserResponse{}
-	mi := &file_altalune_v1_user_proto_msgTypes[7]
+	mi := &file_altalune_v1_user_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -529,7 +831,7 @@ func (x *GetUserResponse) String() string {
 func (*GetUserResponse) ProtoMessage() {}
 
 func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_altalune_v1_user_proto_msgTypes[7]
+	mi := &file_altalune_v1_user_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -542,7 +844,7 @@ func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
 func (*GetUserResponse) Descriptor() ([]byte, []int) {
-	return file_altalune_v1_user_proto_rawDescGZIP(), []int{7}
+	return file_altalune_v1_user_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetUserResponse) GetUser() *User {
@@ -561,18 +863,21 @@ func (x *GetUserResponse) GetIdentities() []*UserIdentity {
 
 // UpdateUserRequest for updating user profile
 type UpdateUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	FirstName     string                 `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName      string                 `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email     string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	FirstName string                 `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName  string                 `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	// updated_at of the user the edit is based on. When set, the update is
+	// rejected with FAILED_PRECONDITION if the user changed since then.
+	ExpectedUpdatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expected_updated_at,json=expectedUpdatedAt,proto3" json:"expected_updated_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *UpdateUserRequest) Reset() {
 	*x = UpdateUserRequest{}
-	mi := &file_altalune_v1_user_proto_msgTypes[8]
+	mi := &file_altalune_v1_user_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -584,7 +889,7 @@ func (x *UpdateUserRequest) String() string {
 func (*UpdateUserRequest) ProtoMessage() {}
 
 func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_altalune_v1_user_proto_msgTypes[8]
+	mi := &file_altalune_v1_user_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -597,7 +902,7 @@ func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
 func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
-	return file_altalune_v1_user_proto_rawDescGZIP(), []int{8}
+	return file_altalune_v1_user_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *UpdateUserRequest) GetId() string {
@@ -628,6 +933,13 @@ func (x *UpdateUserRequest) GetLastName() string {
 	return ""
 }
 
+func (x *UpdateUserRequest) GetExpectedUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpectedUpdatedAt
+	}
+	return nil
+}
+
 // UpdateUserResponse with updated user
 type UpdateUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -639,7 +951,7 @@ type UpdateUserResponse struct {
 
 func (x *UpdateUserResponse) Reset() {
 	*x = UpdateUserResponse{}
-	mi := &file_altalune_v1_user_proto_msgTypes[9]
+	mi := &file_altalune_v1_user_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -651,7 +963,7 @@ func (x *UpdateUserResponse) String() string {
 func (*UpdateUserResponse) ProtoMessage() {}
 
 func (x *UpdateUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_altalune_v1_user_proto_msgTypes[9]
+	mi := &file_altalune_v1_user_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -664,7 +976,7 @@ func (x *UpdateUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserResponse.ProtoReflect.Descriptor instead.
 func (*UpdateUserResponse) Descriptor() ([]byte, []int) {
-	return file_altalune_v1_user_proto_rawDescGZIP(), []int{9}
+	return file_altalune_v1_user_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *UpdateUserResponse) GetUser() *User {
@@ -691,7 +1003,7 @@ type DeleteUserRequest struct {
 
 func (x *DeleteUserRequest) Reset() {
 	*x = DeleteUserRequest{}
-	mi := &file_altalune_v1_user_proto_msgTypes[10]
+	mi := &file_altalune_v1_user_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -703,7 +1015,7 @@ func (x *DeleteUserRequest) String() string {
 func (*DeleteUserRequest) ProtoMessage() {}
 
 func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_altalune_v1_user_proto_msgTypes[10]
+	mi := &file_altalune_v1_user_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -716,7 +1028,7 @@ func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
 func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
-	return file_altalune_v1_user_proto_rawDescGZIP(), []int{10}
+	return file_altalune_v1_user_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *DeleteUserRequest) GetId() string {
@@ -736,7 +1048,7 @@ type DeleteUserResponse struct {
 
 func (x *DeleteUserResponse) Reset() {
 	*x = DeleteUserResponse{}
-	mi := &file_altalune_v1_user_proto_msgTypes[11]
+	mi := &file_altalune_v1_user_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -748,7 +1060,743 @@ func (x *DeleteUserResponse) String() string {
 func (*DeleteUserResponse) ProtoMessage() {}
 
 func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_altalune_v1_user_proto_msgTypes[11]
+	mi := &file_altalune_v1_user_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserResponse.ProtoReflect.Descriptor instead.
+func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_user_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeleteUserResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// RestoreUserRequest for restoring a deleted user from the trash
+type RestoreUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreUserRequest) Reset() {
+	*x = RestoreUserRequest{}
+	mi := &file_altalune_v1_user_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreUserRequest) ProtoMessage() {}
+
+func (x *RestoreUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_user_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreUserRequest.ProtoReflect.Descriptor instead.
+func (*RestoreUserRequest) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_user_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RestoreUserRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// RestoreUserResponse with restored user
+type RestoreUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreUserResponse) Reset() {
+	*x = RestoreUserResponse{}
+	mi := &file_altalune_v1_user_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreUserResponse) ProtoMessage() {}
+
+func (x *RestoreUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_user_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreUserResponse.ProtoReflect.Descriptor instead.
+func (*RestoreUserResponse) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_user_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RestoreUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *RestoreUserResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ActivateUserRequest for activating a user account
+type ActivateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivateUserRequest) Reset() {
+	*x = ActivateUserRequest{}
+	mi := &file_altalune_v1_user_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateUserRequest) ProtoMessage() {}
+
+func (x *ActivateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_user_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateUserRequest.ProtoReflect.Descriptor instead.
+func (*ActivateUserRequest) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_user_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ActivateUserRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// ActivateUserResponse with updated user
+type ActivateUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivateUserResponse) Reset() {
+	*x = ActivateUserResponse{}
+	mi := &file_altalune_v1_user_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateUserResponse) ProtoMessage() {}
+
+func (x *ActivateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_user_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateUserResponse.ProtoReflect.Descriptor instead.
+func (*ActivateUserResponse) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_user_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ActivateUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *ActivateUserResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// DeactivateUserRequest for deactivating a user account
+type DeactivateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateUserRequest) Reset() {
+	*x = DeactivateUserRequest{}
+	mi := &file_altalune_v1_user_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateUserRequest) ProtoMessage() {}
+
+func (x *DeactivateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_user_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateUserRequest.ProtoReflect.Descriptor instead.
+func (*DeactivateUserRequest) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_user_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeactivateUserRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// DeactivateUserResponse with updated user
+type DeactivateUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateUserResponse) Reset() {
+	*x = DeactivateUserResponse{}
+	mi := &file_altalune_v1_user_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateUserResponse) ProtoMessage() {}
+
+func (x *DeactivateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_user_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateUserResponse.ProtoReflect.Descriptor instead.
+func (*DeactivateUserResponse) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_user_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeactivateUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *DeactivateUserResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// QueryPendingUsersRequest for listing self-registered users whose
+// registration awaits approval, oldest first by default
+type QueryPendingUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         *QueryRequest          `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryPendingUsersRequest) Reset() {
+	*x = QueryPendingUsersRequest{}
+	mi := &file_altalune_v1_user_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryPendingUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPendingUsersRequest) ProtoMessage() {}
+
+func (x *QueryPendingUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_user_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryPendingUsersRequest.ProtoReflect.Descriptor instead.
+func (*QueryPendingUsersRequest) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_user_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *QueryPendingUsersRequest) GetQuery() *QueryRequest {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+// QueryPendingUsersResponse with pending user list and metadata
+type QueryPendingUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*User                `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Meta          *QueryMetaResponse     `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryPendingUsersResponse) Reset() {
+	*x = QueryPendingUsersResponse{}
+	mi := &file_altalune_v1_user_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryPendingUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPendingUsersResponse) ProtoMessage() {}
+
+func (x *QueryPendingUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_user_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryPendingUsersResponse.ProtoReflect.Descriptor instead.
+func (*QueryPendingUsersResponse) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_user_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *QueryPendingUsersResponse) GetData() []*User {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *QueryPendingUsersResponse) GetMeta() *QueryMetaResponse {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+// ApproveUserRequest for approving a pending registration
+type ApproveUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveUserRequest) Reset() {
+	*x = ApproveUserRequest{}
+	mi := &file_altalune_v1_user_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveUserRequest) ProtoMessage() {}
+
+func (x *ApproveUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_user_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveUserRequest.ProtoReflect.Descriptor instead.
+func (*ApproveUserRequest) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_user_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ApproveUserRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// ApproveUserResponse with the activated user
+type ApproveUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	EmailSent     bool                   `protobuf:"varint,2,opt,name=email_sent,json=emailSent,proto3" json:"email_sent,omitempty"` // Whether the verification email was sent
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveUserResponse) Reset() {
+	*x = ApproveUserResponse{}
+	mi := &file_altalune_v1_user_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveUserResponse) ProtoMessage() {}
+
+func (x *ApproveUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_user_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveUserResponse.ProtoReflect.Descriptor instead.
+func (*ApproveUserResponse) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_user_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ApproveUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *ApproveUserResponse) GetEmailSent() bool {
+	if x != nil {
+		return x.EmailSent
+	}
+	return false
+}
+
+func (x *ApproveUserResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// RejectUserRequest for rejecting a pending registration. The user is moved
+// to the trash and purged with it.
+type RejectUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectUserRequest) Reset() {
+	*x = RejectUserRequest{}
+	mi := &file_altalune_v1_user_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectUserRequest) ProtoMessage() {}
+
+func (x *RejectUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_user_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectUserRequest.ProtoReflect.Descriptor instead.
+func (*RejectUserRequest) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_user_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RejectUserRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// RejectUserResponse for rejecting a pending registration
+type RejectUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectUserResponse) Reset() {
+	*x = RejectUserResponse{}
+	mi := &file_altalune_v1_user_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectUserResponse) ProtoMessage() {}
+
+func (x *RejectUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_user_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectUserResponse.ProtoReflect.Descriptor instead.
+func (*RejectUserResponse) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_user_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RejectUserResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// UnlockUserRequest for lifting the lock of an account locked after too many
+// failed sign-ins
+type UnlockUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockUserRequest) Reset() {
+	*x = UnlockUserRequest{}
+	mi := &file_altalune_v1_user_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockUserRequest) ProtoMessage() {}
+
+func (x *UnlockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_user_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockUserRequest.ProtoReflect.Descriptor instead.
+func (*UnlockUserRequest) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_user_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UnlockUserRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// UnlockUserResponse with the unlocked user
+type UnlockUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockUserResponse) Reset() {
+	*x = UnlockUserResponse{}
+	mi := &file_altalune_v1_user_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockUserResponse) ProtoMessage() {}
+
+func (x *UnlockUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_user_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockUserResponse.ProtoReflect.Descriptor instead.
+func (*UnlockUserResponse) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_user_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UnlockUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *UnlockUserResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// EmailVerificationToken is a pending email verification link sent to a
+// user. Only a hash of the token is stored, so the link cannot be shown.
+type EmailVerificationToken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,98,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmailVerificationToken) Reset() {
+	*x = EmailVerificationToken{}
+	mi := &file_altalune_v1_user_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmailVerificationToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmailVerificationToken) ProtoMessage() {}
+
+func (x *EmailVerificationToken) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_user_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -759,41 +1807,49 @@ func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserResponse.ProtoReflect.Descriptor instead.
-func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
-	return file_altalune_v1_user_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use EmailVerificationToken.ProtoReflect.Descriptor instead.
+func (*EmailVerificationToken) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_user_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *DeleteUserResponse) GetMessage() string {
+func (x *EmailVerificationToken) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Message
+		return x.ExpiresAt
 	}
-	return ""
+	return nil
 }
 
-// ActivateUserRequest for activating a user account
-type ActivateUserRequest struct {
+func (x *EmailVerificationToken) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// ListEmailVerificationTokensRequest for listing the pending verification
+// tokens of a user
+type ListEmailVerificationTokensRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ActivateUserRequest) Reset() {
-	*x = ActivateUserRequest{}
-	mi := &file_altalune_v1_user_proto_msgTypes[12]
+func (x *ListEmailVerificationTokensRequest) Reset() {
+	*x = ListEmailVerificationTokensRequest{}
+	mi := &file_altalune_v1_user_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ActivateUserRequest) String() string {
+func (x *ListEmailVerificationTokensRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivateUserRequest) ProtoMessage() {}
+func (*ListEmailVerificationTokensRequest) ProtoMessage() {}
 
-func (x *ActivateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_altalune_v1_user_proto_msgTypes[12]
+func (x *ListEmailVerificationTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_user_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -804,42 +1860,41 @@ func (x *ActivateUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivateUserRequest.ProtoReflect.Descriptor instead.
-func (*ActivateUserRequest) Descriptor() ([]byte, []int) {
-	return file_altalune_v1_user_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ListEmailVerificationTokensRequest.ProtoReflect.Descriptor instead.
+func (*ListEmailVerificationTokensRequest) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_user_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *ActivateUserRequest) GetId() string {
+func (x *ListEmailVerificationTokensRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-// ActivateUserResponse with updated user
-type ActivateUserResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+// ListEmailVerificationTokensResponse with the unused, unexpired tokens, newest first
+type ListEmailVerificationTokensResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Tokens        []*EmailVerificationToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ActivateUserResponse) Reset() {
-	*x = ActivateUserResponse{}
-	mi := &file_altalune_v1_user_proto_msgTypes[13]
+func (x *ListEmailVerificationTokensResponse) Reset() {
+	*x = ListEmailVerificationTokensResponse{}
+	mi := &file_altalune_v1_user_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ActivateUserResponse) String() string {
+func (x *ListEmailVerificationTokensResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivateUserResponse) ProtoMessage() {}
+func (*ListEmailVerificationTokensResponse) ProtoMessage() {}
 
-func (x *ActivateUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_altalune_v1_user_proto_msgTypes[13]
+func (x *ListEmailVerificationTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_user_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -850,48 +1905,141 @@ func (x *ActivateUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivateUserResponse.ProtoReflect.Descriptor instead.
-func (*ActivateUserResponse) Descriptor() ([]byte, []int) {
-	return file_altalune_v1_user_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ListEmailVerificationTokensResponse.ProtoReflect.Descriptor instead.
+func (*ListEmailVerificationTokensResponse) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_user_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *ActivateUserResponse) GetUser() *User {
+func (x *ListEmailVerificationTokensResponse) GetTokens() []*EmailVerificationToken {
 	if x != nil {
-		return x.User
+		return x.Tokens
 	}
 	return nil
 }
 
-func (x *ActivateUserResponse) GetMessage() string {
+// InvalidateEmailVerificationTokensRequest for invalidating every pending
+// verification token of a user
+type InvalidateEmailVerificationTokensRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvalidateEmailVerificationTokensRequest) Reset() {
+	*x = InvalidateEmailVerificationTokensRequest{}
+	mi := &file_altalune_v1_user_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidateEmailVerificationTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidateEmailVerificationTokensRequest) ProtoMessage() {}
+
+func (x *InvalidateEmailVerificationTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_user_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvalidateEmailVerificationTokensRequest.ProtoReflect.Descriptor instead.
+func (*InvalidateEmailVerificationTokensRequest) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_user_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *InvalidateEmailVerificationTokensRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// InvalidateEmailVerificationTokensResponse with the number of invalidated tokens
+type InvalidateEmailVerificationTokensResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	InvalidatedCount int32                  `protobuf:"varint,1,opt,name=invalidated_count,json=invalidatedCount,proto3" json:"invalidated_count,omitempty"`
+	Message          string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *InvalidateEmailVerificationTokensResponse) Reset() {
+	*x = InvalidateEmailVerificationTokensResponse{}
+	mi := &file_altalune_v1_user_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidateEmailVerificationTokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidateEmailVerificationTokensResponse) ProtoMessage() {}
+
+func (x *InvalidateEmailVerificationTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_user_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvalidateEmailVerificationTokensResponse.ProtoReflect.Descriptor instead.
+func (*InvalidateEmailVerificationTokensResponse) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_user_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *InvalidateEmailVerificationTokensResponse) GetInvalidatedCount() int32 {
+	if x != nil {
+		return x.InvalidatedCount
+	}
+	return 0
+}
+
+func (x *InvalidateEmailVerificationTokensResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-// DeactivateUserRequest for deactivating a user account
-type DeactivateUserRequest struct {
+// ForceEmailReverificationRequest for marking the email of a user unverified
+// and sending a new verification email
+type ForceEmailReverificationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeactivateUserRequest) Reset() {
-	*x = DeactivateUserRequest{}
-	mi := &file_altalune_v1_user_proto_msgTypes[14]
+func (x *ForceEmailReverificationRequest) Reset() {
+	*x = ForceEmailReverificationRequest{}
+	mi := &file_altalune_v1_user_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeactivateUserRequest) String() string {
+func (x *ForceEmailReverificationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeactivateUserRequest) ProtoMessage() {}
+func (*ForceEmailReverificationRequest) ProtoMessage() {}
 
-func (x *DeactivateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_altalune_v1_user_proto_msgTypes[14]
+func (x *ForceEmailReverificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_user_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -902,42 +2050,43 @@ func (x *DeactivateUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeactivateUserRequest.ProtoReflect.Descriptor instead.
-func (*DeactivateUserRequest) Descriptor() ([]byte, []int) {
-	return file_altalune_v1_user_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ForceEmailReverificationRequest.ProtoReflect.Descriptor instead.
+func (*ForceEmailReverificationRequest) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_user_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *DeactivateUserRequest) GetId() string {
+func (x *ForceEmailReverificationRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-// DeactivateUserResponse with updated user
-type DeactivateUserResponse struct {
+// ForceEmailReverificationResponse with updated user
+type ForceEmailReverificationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	EmailSent     bool                   `protobuf:"varint,2,opt,name=email_sent,json=emailSent,proto3" json:"email_sent,omitempty"` // False when no email provider is configured
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeactivateUserResponse) Reset() {
-	*x = DeactivateUserResponse{}
-	mi := &file_altalune_v1_user_proto_msgTypes[15]
+func (x *ForceEmailReverificationResponse) Reset() {
+	*x = ForceEmailReverificationResponse{}
+	mi := &file_altalune_v1_user_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeactivateUserResponse) String() string {
+func (x *ForceEmailReverificationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeactivateUserResponse) ProtoMessage() {}
+func (*ForceEmailReverificationResponse) ProtoMessage() {}
 
-func (x *DeactivateUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_altalune_v1_user_proto_msgTypes[15]
+func (x *ForceEmailReverificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_user_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -948,30 +2097,186 @@ func (x *DeactivateUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeactivateUserResponse.ProtoReflect.Descriptor instead.
-func (*DeactivateUserResponse) Descriptor() ([]byte, []int) {
-	return file_altalune_v1_user_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ForceEmailReverificationResponse.ProtoReflect.Descriptor instead.
+func (*ForceEmailReverificationResponse) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_user_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *DeactivateUserResponse) GetUser() *User {
+func (x *ForceEmailReverificationResponse) GetUser() *User {
 	if x != nil {
 		return x.User
 	}
 	return nil
 }
 
-func (x *DeactivateUserResponse) GetMessage() string {
+func (x *ForceEmailReverificationResponse) GetEmailSent() bool {
+	if x != nil {
+		return x.EmailSent
+	}
+	return false
+}
+
+func (x *ForceEmailReverificationResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
+// GetEmailVerificationStatsRequest for the verification conversion over the
+// last days
+type GetEmailVerificationStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          int32                  `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty"` // 0 for the last 30 days
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEmailVerificationStatsRequest) Reset() {
+	*x = GetEmailVerificationStatsRequest{}
+	mi := &file_altalune_v1_user_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEmailVerificationStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEmailVerificationStatsRequest) ProtoMessage() {}
+
+func (x *GetEmailVerificationStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_user_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEmailVerificationStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetEmailVerificationStatsRequest) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_user_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetEmailVerificationStatsRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+// GetEmailVerificationStatsResponse counts the verification emails sent over
+// the period by outcome: each is verified, pending, expired or superseded by a
+// newer email or an administrator
+type GetEmailVerificationStatsResponse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Sent                  int64                  `protobuf:"varint,1,opt,name=sent,proto3" json:"sent,omitempty"`
+	Verified              int64                  `protobuf:"varint,2,opt,name=verified,proto3" json:"verified,omitempty"`
+	Pending               int64                  `protobuf:"varint,3,opt,name=pending,proto3" json:"pending,omitempty"`
+	Expired               int64                  `protobuf:"varint,4,opt,name=expired,proto3" json:"expired,omitempty"`
+	Superseded            int64                  `protobuf:"varint,5,opt,name=superseded,proto3" json:"superseded,omitempty"`
+	ConversionRate        float64                `protobuf:"fixed64,6,opt,name=conversion_rate,json=conversionRate,proto3" json:"conversion_rate,omitempty"`                         // Verified share of sent, from 0 to 1
+	MedianSecondsToVerify int64                  `protobuf:"varint,7,opt,name=median_seconds_to_verify,json=medianSecondsToVerify,proto3" json:"median_seconds_to_verify,omitempty"` // 0 when none was verified
+	Days                  int32                  `protobuf:"varint,8,opt,name=days,proto3" json:"days,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GetEmailVerificationStatsResponse) Reset() {
+	*x = GetEmailVerificationStatsResponse{}
+	mi := &file_altalune_v1_user_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEmailVerificationStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEmailVerificationStatsResponse) ProtoMessage() {}
+
+func (x *GetEmailVerificationStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_altalune_v1_user_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEmailVerificationStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetEmailVerificationStatsResponse) Descriptor() ([]byte, []int) {
+	return file_altalune_v1_user_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetEmailVerificationStatsResponse) GetSent() int64 {
+	if x != nil {
+		return x.Sent
+	}
+	return 0
+}
+
+func (x *GetEmailVerificationStatsResponse) GetVerified() int64 {
+	if x != nil {
+		return x.Verified
+	}
+	return 0
+}
+
+func (x *GetEmailVerificationStatsResponse) GetPending() int64 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+func (x *GetEmailVerificationStatsResponse) GetExpired() int64 {
+	if x != nil {
+		return x.Expired
+	}
+	return 0
+}
+
+func (x *GetEmailVerificationStatsResponse) GetSuperseded() int64 {
+	if x != nil {
+		return x.Superseded
+	}
+	return 0
+}
+
+func (x *GetEmailVerificationStatsResponse) GetConversionRate() float64 {
+	if x != nil {
+		return x.ConversionRate
+	}
+	return 0
+}
+
+func (x *GetEmailVerificationStatsResponse) GetMedianSecondsToVerify() int64 {
+	if x != nil {
+		return x.MedianSecondsToVerify
+	}
+	return 0
+}
+
+func (x *GetEmailVerificationStatsResponse) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
 var File_altalune_v1_user_proto protoreflect.FileDescriptor
 
 const file_altalune_v1_user_proto_rawDesc = "" +
 	"\n" +
-	"\x16altalune/v1/user.proto\x12\valtalune.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\x1a\x18altalune/v1/common.proto\"\xa2\x02\n" +
+	"\x16altalune/v1/user.proto\x12\valtalune.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a google/protobuf/field_mask.proto\x1a\x1bbuf/validate/validate.proto\x1a\x18altalune/v1/common.proto\x1a\x19altalune/v1/options.proto\"\xc7\x03\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1d\n" +
@@ -981,6 +2286,10 @@ const file_altalune_v1_user_proto_rawDesc = "" +
 	"\tis_active\x18\x05 \x01(\bR\bisActive\x12%\n" +
 	"\x0eemail_verified\x18\x06 \x01(\bR\remailVerified\x129\n" +
 	"\n" +
+	"deleted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12=\n" +
+	"\flocked_until\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vlockedUntil\x12)\n" +
+	"\x04type\x18\t \x01(\x0e2\x15.altalune.v1.UserTypeR\x04type\x129\n" +
+	"\n" +
 	"created_at\x18b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"updated_at\x18c \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xac\x04\n" +
@@ -1001,11 +2310,19 @@ const file_altalune_v1_user_proto_rawDesc = "" +
 	"updated_at\x18c \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x12\n" +
 	"\x10_oauth_client_idB\x1b\n" +
 	"\x19_origin_oauth_client_nameB\x10\n" +
-	"\x0e_last_login_at\"D\n" +
+	"\x0e_last_login_at\"\x97\x01\n" +
 	"\x11QueryUsersRequest\x12/\n" +
-	"\x05query\x18\x01 \x01(\v2\x19.altalune.v1.QueryRequestR\x05query\"o\n" +
+	"\x05query\x18\x01 \x01(\v2\x19.altalune.v1.QueryRequestR\x05query\x12\x18\n" +
+	"\atrashed\x18\x02 \x01(\bR\atrashed\x127\n" +
+	"\tread_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\"o\n" +
 	"\x12QueryUsersResponse\x12%\n" +
 	"\x04data\x18\x01 \x03(\v2\x11.altalune.v1.UserR\x04data\x122\n" +
+	"\x04meta\x18\x02 \x01(\v2\x1e.altalune.v1.QueryMetaResponseR\x04meta\"\x86\x01\n" +
+	"\x12StreamUsersRequest\x127\n" +
+	"\x05query\x18\x01 \x01(\v2\x19.altalune.v1.QueryRequestB\x06\xbaH\x03\xc8\x01\x01R\x05query\x127\n" +
+	"\tread_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\"p\n" +
+	"\x13StreamUsersResponse\x12%\n" +
+	"\x04data\x18\x01 \x03(\v2\x11.altalune.v1.UserR\x04data\x122\n" +
 	"\x04meta\x18\x02 \x01(\v2\x1e.altalune.v1.QueryMetaResponseR\x04meta\"\x8a\x01\n" +
 	"\x11CreateUserRequest\x12#\n" +
 	"\x05email\x18\x01 \x01(\tB\r\xbaH\n" +
@@ -1015,6 +2332,11 @@ const file_altalune_v1_user_proto_rawDesc = "" +
 	"\tlast_name\x18\x03 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\blastName\"U\n" +
 	"\x12CreateUserResponse\x12%\n" +
 	"\x04user\x18\x01 \x01(\v2\x11.altalune.v1.UserR\x04user\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"U\n" +
+	"\x1bCreateServiceAccountRequest\x126\n" +
+	"\x04name\x18\x01 \x01(\tB\"\xbaH\x1f\xc8\x01\x01r\x1a\x10\x02\x18d2\x14^[a-zA-Z0-9\\s\\-_.]+$R\x04name\"_\n" +
+	"\x1cCreateServiceAccountResponse\x12%\n" +
+	"\x04user\x18\x01 \x01(\v2\x11.altalune.v1.UserR\x04user\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\".\n" +
 	"\x0eGetUserRequest\x12\x1c\n" +
 	"\x02id\x18\x01 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x0e\x18\x14R\x02id\"s\n" +
@@ -1022,21 +2344,27 @@ const file_altalune_v1_user_proto_rawDesc = "" +
 	"\x04user\x18\x01 \x01(\v2\x11.altalune.v1.UserR\x04user\x129\n" +
 	"\n" +
 	"identities\x18\x02 \x03(\v2\x19.altalune.v1.UserIdentityR\n" +
-	"identities\"\xa8\x01\n" +
+	"identities\"\xf4\x01\n" +
 	"\x11UpdateUserRequest\x12\x1c\n" +
 	"\x02id\x18\x01 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x0e\x18\x14R\x02id\x12#\n" +
 	"\x05email\x18\x02 \x01(\tB\r\xbaH\n" +
 	"\xc8\x01\x01r\x05\x18\xff\x01`\x01R\x05email\x12(\n" +
 	"\n" +
 	"first_name\x18\x03 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\tfirstName\x12&\n" +
-	"\tlast_name\x18\x04 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\blastName\"U\n" +
+	"\tlast_name\x18\x04 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\blastName\x12J\n" +
+	"\x13expected_updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x11expectedUpdatedAt\"U\n" +
 	"\x12UpdateUserResponse\x12%\n" +
 	"\x04user\x18\x01 \x01(\v2\x11.altalune.v1.UserR\x04user\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\"1\n" +
 	"\x11DeleteUserRequest\x12\x1c\n" +
 	"\x02id\x18\x01 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x0e\x18\x14R\x02id\".\n" +
 	"\x12DeleteUserResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"3\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"2\n" +
+	"\x12RestoreUserRequest\x12\x1c\n" +
+	"\x02id\x18\x01 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x0e\x18\x14R\x02id\"V\n" +
+	"\x13RestoreUserResponse\x12%\n" +
+	"\x04user\x18\x01 \x01(\v2\x11.altalune.v1.UserR\x04user\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"3\n" +
 	"\x13ActivateUserRequest\x12\x1c\n" +
 	"\x02id\x18\x01 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x0e\x18\x14R\x02id\"W\n" +
 	"\x14ActivateUserResponse\x12%\n" +
@@ -1046,19 +2374,102 @@ const file_altalune_v1_user_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x0e\x18\x14R\x02id\"Y\n" +
 	"\x16DeactivateUserResponse\x12%\n" +
 	"\x04user\x18\x01 \x01(\v2\x11.altalune.v1.UserR\x04user\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage2\xcd\x04\n" +
-	"\vUserService\x12O\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"K\n" +
+	"\x18QueryPendingUsersRequest\x12/\n" +
+	"\x05query\x18\x01 \x01(\v2\x19.altalune.v1.QueryRequestR\x05query\"v\n" +
+	"\x19QueryPendingUsersResponse\x12%\n" +
+	"\x04data\x18\x01 \x03(\v2\x11.altalune.v1.UserR\x04data\x122\n" +
+	"\x04meta\x18\x02 \x01(\v2\x1e.altalune.v1.QueryMetaResponseR\x04meta\"2\n" +
+	"\x12ApproveUserRequest\x12\x1c\n" +
+	"\x02id\x18\x01 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x0e\x18\x14R\x02id\"u\n" +
+	"\x13ApproveUserResponse\x12%\n" +
+	"\x04user\x18\x01 \x01(\v2\x11.altalune.v1.UserR\x04user\x12\x1d\n" +
+	"\n" +
+	"email_sent\x18\x02 \x01(\bR\temailSent\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"1\n" +
+	"\x11RejectUserRequest\x12\x1c\n" +
+	"\x02id\x18\x01 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x0e\x18\x14R\x02id\".\n" +
+	"\x12RejectUserResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"1\n" +
+	"\x11UnlockUserRequest\x12\x1c\n" +
+	"\x02id\x18\x01 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x0e\x18\x14R\x02id\"U\n" +
+	"\x12UnlockUserResponse\x12%\n" +
+	"\x04user\x18\x01 \x01(\v2\x11.altalune.v1.UserR\x04user\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x8e\x01\n" +
+	"\x16EmailVerificationToken\x129\n" +
+	"\n" +
+	"expires_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"created_at\x18b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"B\n" +
+	"\"ListEmailVerificationTokensRequest\x12\x1c\n" +
+	"\x02id\x18\x01 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x0e\x18\x14R\x02id\"b\n" +
+	"#ListEmailVerificationTokensResponse\x12;\n" +
+	"\x06tokens\x18\x01 \x03(\v2#.altalune.v1.EmailVerificationTokenR\x06tokens\"H\n" +
+	"(InvalidateEmailVerificationTokensRequest\x12\x1c\n" +
+	"\x02id\x18\x01 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x0e\x18\x14R\x02id\"r\n" +
+	")InvalidateEmailVerificationTokensResponse\x12+\n" +
+	"\x11invalidated_count\x18\x01 \x01(\x05R\x10invalidatedCount\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"?\n" +
+	"\x1fForceEmailReverificationRequest\x12\x1c\n" +
+	"\x02id\x18\x01 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x0e\x18\x14R\x02id\"\x82\x01\n" +
+	" ForceEmailReverificationResponse\x12%\n" +
+	"\x04user\x18\x01 \x01(\v2\x11.altalune.v1.UserR\x04user\x12\x1d\n" +
+	"\n" +
+	"email_sent\x18\x02 \x01(\bR\temailSent\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"B\n" +
+	" GetEmailVerificationStatsRequest\x12\x1e\n" +
+	"\x04days\x18\x01 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xed\x02(\x00R\x04days\"\x9d\x02\n" +
+	"!GetEmailVerificationStatsResponse\x12\x12\n" +
+	"\x04sent\x18\x01 \x01(\x03R\x04sent\x12\x1a\n" +
+	"\bverified\x18\x02 \x01(\x03R\bverified\x12\x18\n" +
+	"\apending\x18\x03 \x01(\x03R\apending\x12\x18\n" +
+	"\aexpired\x18\x04 \x01(\x03R\aexpired\x12\x1e\n" +
+	"\n" +
+	"superseded\x18\x05 \x01(\x03R\n" +
+	"superseded\x12'\n" +
+	"\x0fconversion_rate\x18\x06 \x01(\x01R\x0econversionRate\x127\n" +
+	"\x18median_seconds_to_verify\x18\a \x01(\x03R\x15medianSecondsToVerify\x12\x12\n" +
+	"\x04days\x18\b \x01(\x05R\x04days*Y\n" +
+	"\bUserType\x12\x19\n" +
+	"\x15USER_TYPE_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fUSER_TYPE_HUMAN\x10\x01\x12\x1d\n" +
+	"\x19USER_TYPE_SERVICE_ACCOUNT\x10\x022\xd1\x0f\n" +
+	"\vUserService\x12\\\n" +
+	"\n" +
+	"QueryUsers\x12\x1e.altalune.v1.QueryUsersRequest\x1a\x1f.altalune.v1.QueryUsersResponse\"\r\x8a\xb5\x18\tuser:read\x12a\n" +
+	"\vStreamUsers\x12\x1f.altalune.v1.StreamUsersRequest\x1a .altalune.v1.StreamUsersResponse\"\r\x8a\xb5\x18\tuser:read0\x01\x12]\n" +
+	"\n" +
+	"CreateUser\x12\x1e.altalune.v1.CreateUserRequest\x1a\x1f.altalune.v1.CreateUserResponse\"\x0e\x8a\xb5\x18\n" +
+	"user:write\x12{\n" +
+	"\x14CreateServiceAccount\x12(.altalune.v1.CreateServiceAccountRequest\x1a).altalune.v1.CreateServiceAccountResponse\"\x0e\x8a\xb5\x18\n" +
+	"user:write\x12S\n" +
+	"\aGetUser\x12\x1b.altalune.v1.GetUserRequest\x1a\x1c.altalune.v1.GetUserResponse\"\r\x8a\xb5\x18\tuser:read\x12]\n" +
 	"\n" +
-	"QueryUsers\x12\x1e.altalune.v1.QueryUsersRequest\x1a\x1f.altalune.v1.QueryUsersResponse\"\x00\x12O\n" +
+	"UpdateUser\x12\x1e.altalune.v1.UpdateUserRequest\x1a\x1f.altalune.v1.UpdateUserResponse\"\x0e\x8a\xb5\x18\n" +
+	"user:write\x12^\n" +
 	"\n" +
-	"CreateUser\x12\x1e.altalune.v1.CreateUserRequest\x1a\x1f.altalune.v1.CreateUserResponse\"\x00\x12F\n" +
-	"\aGetUser\x12\x1b.altalune.v1.GetUserRequest\x1a\x1c.altalune.v1.GetUserResponse\"\x00\x12O\n" +
+	"DeleteUser\x12\x1e.altalune.v1.DeleteUserRequest\x1a\x1f.altalune.v1.DeleteUserResponse\"\x0f\x8a\xb5\x18\vuser:delete\x12a\n" +
+	"\vRestoreUser\x12\x1f.altalune.v1.RestoreUserRequest\x1a .altalune.v1.RestoreUserResponse\"\x0f\x8a\xb5\x18\vuser:delete\x12c\n" +
+	"\fActivateUser\x12 .altalune.v1.ActivateUserRequest\x1a!.altalune.v1.ActivateUserResponse\"\x0e\x8a\xb5\x18\n" +
+	"user:write\x12i\n" +
+	"\x0eDeactivateUser\x12\".altalune.v1.DeactivateUserRequest\x1a#.altalune.v1.DeactivateUserResponse\"\x0e\x8a\xb5\x18\n" +
+	"user:write\x12q\n" +
+	"\x11QueryPendingUsers\x12%.altalune.v1.QueryPendingUsersRequest\x1a&.altalune.v1.QueryPendingUsersResponse\"\r\x8a\xb5\x18\tuser:read\x12`\n" +
+	"\vApproveUser\x12\x1f.altalune.v1.ApproveUserRequest\x1a .altalune.v1.ApproveUserResponse\"\x0e\x8a\xb5\x18\n" +
+	"user:write\x12]\n" +
 	"\n" +
-	"UpdateUser\x12\x1e.altalune.v1.UpdateUserRequest\x1a\x1f.altalune.v1.UpdateUserResponse\"\x00\x12O\n" +
+	"RejectUser\x12\x1e.altalune.v1.RejectUserRequest\x1a\x1f.altalune.v1.RejectUserResponse\"\x0e\x8a\xb5\x18\n" +
+	"user:write\x12]\n" +
 	"\n" +
-	"DeleteUser\x12\x1e.altalune.v1.DeleteUserRequest\x1a\x1f.altalune.v1.DeleteUserResponse\"\x00\x12U\n" +
-	"\fActivateUser\x12 .altalune.v1.ActivateUserRequest\x1a!.altalune.v1.ActivateUserResponse\"\x00\x12[\n" +
-	"\x0eDeactivateUser\x12\".altalune.v1.DeactivateUserRequest\x1a#.altalune.v1.DeactivateUserResponse\"\x00B\x9e\x01\n" +
+	"UnlockUser\x12\x1e.altalune.v1.UnlockUserRequest\x1a\x1f.altalune.v1.UnlockUserResponse\"\x0e\x8a\xb5\x18\n" +
+	"user:write\x12\x8f\x01\n" +
+	"\x1bListEmailVerificationTokens\x12/.altalune.v1.ListEmailVerificationTokensRequest\x1a0.altalune.v1.ListEmailVerificationTokensResponse\"\r\x8a\xb5\x18\tuser:read\x12\xa2\x01\n" +
+	"!InvalidateEmailVerificationTokens\x125.altalune.v1.InvalidateEmailVerificationTokensRequest\x1a6.altalune.v1.InvalidateEmailVerificationTokensResponse\"\x0e\x8a\xb5\x18\n" +
+	"user:write\x12\x87\x01\n" +
+	"\x18ForceEmailReverification\x12,.altalune.v1.ForceEmailReverificationRequest\x1a-.altalune.v1.ForceEmailReverificationResponse\"\x0e\x8a\xb5\x18\n" +
+	"user:write\x12\x89\x01\n" +
+	"\x19GetEmailVerificationStats\x12-.altalune.v1.GetEmailVerificationStatsRequest\x1a..altalune.v1.GetEmailVerificationStatsResponse\"\r\x8a\xb5\x18\tuser:readB\x9e\x01\n" +
 	"\x0fcom.altalune.v1B\tUserProtoP\x01Z3github.com/hrz8/altalune/gen/altalune/v1;altalunev1\xa2\x02\x03AXX\xaa\x02\vAltalune.V1\xca\x02\vAltalune\\V1\xe2\x02\x17Altalune\\V1\\GPBMetadata\xea\x02\fAltalune::V1b\x06proto3"
 
 var (
@@ -1073,62 +2484,130 @@ func file_altalune_v1_user_proto_rawDescGZIP() []byte {
 	return file_altalune_v1_user_proto_rawDescData
 }
 
-var file_altalune_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_altalune_v1_user_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_altalune_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
 var file_altalune_v1_user_proto_goTypes = []any{
-	(*User)(nil),                   // 0: altalune.v1.User
-	(*UserIdentity)(nil),           // 1: altalune.v1.UserIdentity
-	(*QueryUsersRequest)(nil),      // 2: altalune.v1.QueryUsersRequest
-	(*QueryUsersResponse)(nil),     // 3: altalune.v1.QueryUsersResponse
-	(*CreateUserRequest)(nil),      // 4: altalune.v1.CreateUserRequest
-	(*CreateUserResponse)(nil),     // 5: altalune.v1.CreateUserResponse
-	(*GetUserRequest)(nil),         // 6: altalune.v1.GetUserRequest
-	(*GetUserResponse)(nil),        // 7: altalune.v1.GetUserResponse
-	(*UpdateUserRequest)(nil),      // 8: altalune.v1.UpdateUserRequest
-	(*UpdateUserResponse)(nil),     // 9: altalune.v1.UpdateUserResponse
-	(*DeleteUserRequest)(nil),      // 10: altalune.v1.DeleteUserRequest
-	(*DeleteUserResponse)(nil),     // 11: altalune.v1.DeleteUserResponse
-	(*ActivateUserRequest)(nil),    // 12: altalune.v1.ActivateUserRequest
-	(*ActivateUserResponse)(nil),   // 13: altalune.v1.ActivateUserResponse
-	(*DeactivateUserRequest)(nil),  // 14: altalune.v1.DeactivateUserRequest
-	(*DeactivateUserResponse)(nil), // 15: altalune.v1.DeactivateUserResponse
-	(*timestamppb.Timestamp)(nil),  // 16: google.protobuf.Timestamp
-	(*QueryRequest)(nil),           // 17: altalune.v1.QueryRequest
-	(*QueryMetaResponse)(nil),      // 18: altalune.v1.QueryMetaResponse
+	(UserType)(0),                                     // 0: altalune.v1.UserType
+	(*User)(nil),                                      // 1: altalune.v1.User
+	(*UserIdentity)(nil),                              // 2: altalune.v1.UserIdentity
+	(*QueryUsersRequest)(nil),                         // 3: altalune.v1.QueryUsersRequest
+	(*QueryUsersResponse)(nil),                        // 4: altalune.v1.QueryUsersResponse
+	(*StreamUsersRequest)(nil),                        // 5: altalune.v1.StreamUsersRequest
+	(*StreamUsersResponse)(nil),                       // 6: altalune.v1.StreamUsersResponse
+	(*CreateUserRequest)(nil),                         // 7: altalune.v1.CreateUserRequest
+	(*CreateUserResponse)(nil),                        // 8: altalune.v1.CreateUserResponse
+	(*CreateServiceAccountRequest)(nil),               // 9: altalune.v1.CreateServiceAccountRequest
+	(*CreateServiceAccountResponse)(nil),              // 10: altalune.v1.CreateServiceAccountResponse
+	(*GetUserRequest)(nil),                            // 11: altalune.v1.GetUserRequest
+	(*GetUserResponse)(nil),                           // 12: altalune.v1.GetUserResponse
+	(*UpdateUserRequest)(nil),                         // 13: altalune.v1.UpdateUserRequest
+	(*UpdateUserResponse)(nil),                        // 14: altalune.v1.UpdateUserResponse
+	(*DeleteUserRequest)(nil),                         // 15: altalune.v1.DeleteUserRequest
+	(*DeleteUserResponse)(nil),                        // 16: altalune.v1.DeleteUserResponse
+	(*RestoreUserRequest)(nil),                        // 17: altalune.v1.RestoreUserRequest
+	(*RestoreUserResponse)(nil),                       // 18: altalune.v1.RestoreUserResponse
+	(*ActivateUserRequest)(nil),                       // 19: altalune.v1.ActivateUserRequest
+	(*ActivateUserResponse)(nil),                      // 20: altalune.v1.ActivateUserResponse
+	(*DeactivateUserRequest)(nil),                     // 21: altalune.v1.DeactivateUserRequest
+	(*DeactivateUserResponse)(nil),                    // 22: altalune.v1.DeactivateUserResponse
+	(*QueryPendingUsersRequest)(nil),                  // 23: altalune.v1.QueryPendingUsersRequest
+	(*QueryPendingUsersResponse)(nil),                 // 24: altalune.v1.QueryPendingUsersResponse
+	(*ApproveUserRequest)(nil),                        // 25: altalune.v1.ApproveUserRequest
+	(*ApproveUserResponse)(nil),                       // 26: altalune.v1.ApproveUserResponse
+	(*RejectUserRequest)(nil),                         // 27: altalune.v1.RejectUserRequest
+	(*RejectUserResponse)(nil),                        // 28: altalune.v1.RejectUserResponse
+	(*UnlockUserRequest)(nil),                         // 29: altalune.v1.UnlockUserRequest
+	(*UnlockUserResponse)(nil),                        // 30: altalune.v1.UnlockUserResponse
+	(*EmailVerificationToken)(nil),                    // 31: altalune.v1.EmailVerificationToken
+	(*ListEmailVerificationTokensRequest)(nil),        // 32: altalune.v1.ListEmailVerificationTokensRequest
+	(*ListEmailVerificationTokensResponse)(nil),       // 33: altalune.v1.ListEmailVerificationTokensResponse
+	(*InvalidateEmailVerificationTokensRequest)(nil),  // 34: altalune.v1.InvalidateEmailVerificationTokensRequest
+	(*InvalidateEmailVerificationTokensResponse)(nil), // 35: altalune.v1.InvalidateEmailVerificationTokensResponse
+	(*ForceEmailReverificationRequest)(nil),           // 36: altalune.v1.ForceEmailReverificationRequest
+	(*ForceEmailReverificationResponse)(nil),          // 37: altalune.v1.ForceEmailReverificationResponse
+	(*GetEmailVerificationStatsRequest)(nil),          // 38: altalune.v1.GetEmailVerificationStatsRequest
+	(*GetEmailVerificationStatsResponse)(nil),         // 39: altalune.v1.GetEmailVerificationStatsResponse
+	(*timestamppb.Timestamp)(nil),                     // 40: google.protobuf.Timestamp
+	(*QueryRequest)(nil),                              // 41: altalune.v1.QueryRequest
+	(*fieldmaskpb.FieldMask)(nil),                     // 42: google.protobuf.FieldMask
+	(*QueryMetaResponse)(nil),                         // 43: altalune.v1.QueryMetaResponse
 }
 var file_altalune_v1_user_proto_depIdxs = []int32{
-	16, // 0: altalune.v1.User.created_at:type_name -> google.protobuf.Timestamp
-	16, // 1: altalune.v1.User.updated_at:type_name -> google.protobuf.Timestamp
-	16, // 2: altalune.v1.UserIdentity.last_login_at:type_name -> google.protobuf.Timestamp
-	16, // 3: altalune.v1.UserIdentity.created_at:type_name -> google.protobuf.Timestamp
-	16, // 4: altalune.v1.UserIdentity.updated_at:type_name -> google.protobuf.Timestamp
-	17, // 5: altalune.v1.QueryUsersRequest.query:type_name -> altalune.v1.QueryRequest
-	0,  // 6: altalune.v1.QueryUsersResponse.data:type_name -> altalune.v1.User
-	18, // 7: altalune.v1.QueryUsersResponse.meta:type_name -> altalune.v1.QueryMetaResponse
-	0,  // 8: altalune.v1.CreateUserResponse.user:type_name -> altalune.v1.User
-	0,  // 9: altalune.v1.GetUserResponse.user:type_name -> altalune.v1.User
-	1,  // 10: altalune.v1.GetUserResponse.identities:type_name -> altalune.v1.UserIdentity
-	0,  // 11: altalune.v1.UpdateUserResponse.user:type_name -> altalune.v1.User
-	0,  // 12: altalune.v1.ActivateUserResponse.user:type_name -> altalune.v1.User
-	0,  // 13: altalune.v1.DeactivateUserResponse.user:type_name -> altalune.v1.User
-	2,  // 14: altalune.v1.UserService.QueryUsers:input_type -> altalune.v1.QueryUsersRequest
-	4,  // 15: altalune.v1.UserService.CreateUser:input_type -> altalune.v1.CreateUserRequest
-	6,  // 16: altalune.v1.UserService.GetUser:input_type -> altalune.v1.GetUserRequest
-	8,  // 17: altalune.v1.UserService.UpdateUser:input_type -> altalune.v1.UpdateUserRequest
-	10, // 18: altalune.v1.UserService.DeleteUser:input_type -> altalune.v1.DeleteUserRequest
-	12, // 19: altalune.v1.UserService.ActivateUser:input_type -> altalune.v1.ActivateUserRequest
-	14, // 20: altalune.v1.UserService.DeactivateUser:input_type -> altalune.v1.DeactivateUserRequest
-	3,  // 21: altalune.v1.UserService.QueryUsers:output_type -> altalune.v1.QueryUsersResponse
-	5,  // 22: altalune.v1.UserService.CreateUser:output_type -> altalune.v1.CreateUserResponse
-	7,  // 23: altalune.v1.UserService.GetUser:output_type -> altalune.v1.GetUserResponse
-	9,  // 24: altalune.v1.UserService.UpdateUser:output_type -> altalune.v1.UpdateUserResponse
-	11, // 25: altalune.v1.UserService.DeleteUser:output_type -> altalune.v1.DeleteUserResponse
-	13, // 26: altalune.v1.UserService.ActivateUser:output_type -> altalune.v1.ActivateUserResponse
-	15, // 27: altalune.v1.UserService.DeactivateUser:output_type -> altalune.v1.DeactivateUserResponse
-	21, // [21:28] is the sub-list for method output_type
-	14, // [14:21] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	40, // 0: altalune.v1.User.deleted_at:type_name -> google.protobuf.Timestamp
+	40, // 1: altalune.v1.User.locked_until:type_name -> google.protobuf.Timestamp
+	0,  // 2: altalune.v1.User.type:type_name -> altalune.v1.UserType
+	40, // 3: altalune.v1.User.created_at:type_name -> google.protobuf.Timestamp
+	40, // 4: altalune.v1.User.updated_at:type_name -> google.protobuf.Timestamp
+	40, // 5: altalune.v1.UserIdentity.last_login_at:type_name -> google.protobuf.Timestamp
+	40, // 6: altalune.v1.UserIdentity.created_at:type_name -> google.protobuf.Timestamp
+	40, // 7: altalune.v1.UserIdentity.updated_at:type_name -> google.protobuf.Timestamp
+	41, // 8: altalune.v1.QueryUsersRequest.query:type_name -> altalune.v1.QueryRequest
+	42, // 9: altalune.v1.QueryUsersRequest.read_mask:type_name -> google.protobuf.FieldMask
+	1,  // 10: altalune.v1.QueryUsersResponse.data:type_name -> altalune.v1.User
+	43, // 11: altalune.v1.QueryUsersResponse.meta:type_name -> altalune.v1.QueryMetaResponse
+	41, // 12: altalune.v1.StreamUsersRequest.query:type_name -> altalune.v1.QueryRequest
+	42, // 13: altalune.v1.StreamUsersRequest.read_mask:type_name -> google.protobuf.FieldMask
+	1,  // 14: altalune.v1.StreamUsersResponse.data:type_name -> altalune.v1.User
+	43, // 15: altalune.v1.StreamUsersResponse.meta:type_name -> altalune.v1.QueryMetaResponse
+	1,  // 16: altalune.v1.CreateUserResponse.user:type_name -> altalune.v1.User
+	1,  // 17: altalune.v1.CreateServiceAccountResponse.user:type_name -> altalune.v1.User
+	1,  // 18: altalune.v1.GetUserResponse.user:type_name -> altalune.v1.User
+	2,  // 19: altalune.v1.GetUserResponse.identities:type_name -> altalune.v1.UserIdentity
+	40, // 20: altalune.v1.UpdateUserRequest.expected_updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 21: altalune.v1.UpdateUserResponse.user:type_name -> altalune.v1.User
+	1,  // 22: altalune.v1.RestoreUserResponse.user:type_name -> altalune.v1.User
+	1,  // 23: altalune.v1.ActivateUserResponse.user:type_name -> altalune.v1.User
+	1,  // 24: altalune.v1.DeactivateUserResponse.user:type_name -> altalune.v1.User
+	41, // 25: altalune.v1.QueryPendingUsersRequest.query:type_name -> altalune.v1.QueryRequest
+	1,  // 26: altalune.v1.QueryPendingUsersResponse.data:type_name -> altalune.v1.User
+	43, // 27: altalune.v1.QueryPendingUsersResponse.meta:type_name -> altalune.v1.QueryMetaResponse
+	1,  // 28: altalune.v1.ApproveUserResponse.user:type_name -> altalune.v1.User
+	1,  // 29: altalune.v1.UnlockUserResponse.user:type_name -> altalune.v1.User
+	40, // 30: altalune.v1.EmailVerificationToken.expires_at:type_name -> google.protobuf.Timestamp
+	40, // 31: altalune.v1.EmailVerificationToken.created_at:type_name -> google.protobuf.Timestamp
+	31, // 32: altalune.v1.ListEmailVerificationTokensResponse.tokens:type_name -> altalune.v1.EmailVerificationToken
+	1,  // 33: altalune.v1.ForceEmailReverificationResponse.user:type_name -> altalune.v1.User
+	3,  // 34: altalune.v1.UserService.QueryUsers:input_type -> altalune.v1.QueryUsersRequest
+	5,  // 35: altalune.v1.UserService.StreamUsers:input_type -> altalune.v1.StreamUsersRequest
+	7,  // 36: altalune.v1.UserService.CreateUser:input_type -> altalune.v1.CreateUserRequest
+	9,  // 37: altalune.v1.UserService.CreateServiceAccount:input_type -> altalune.v1.CreateServiceAccountRequest
+	11, // 38: altalune.v1.UserService.GetUser:input_type -> altalune.v1.GetUserRequest
+	13, // 39: altalune.v1.UserService.UpdateUser:input_type -> altalune.v1.UpdateUserRequest
+	15, // 40: altalune.v1.UserService.DeleteUser:input_type -> altalune.v1.DeleteUserRequest
+	17, // 41: altalune.v1.UserService.RestoreUser:input_type -> altalune.v1.RestoreUserRequest
+	19, // 42: altalune.v1.UserService.ActivateUser:input_type -> altalune.v1.ActivateUserRequest
+	21, // 43: altalune.v1.UserService.DeactivateUser:input_type -> altalune.v1.DeactivateUserRequest
+	23, // 44: altalune.v1.UserService.QueryPendingUsers:input_type -> altalune.v1.QueryPendingUsersRequest
+	25, // 45: altalune.v1.UserService.ApproveUser:input_type -> altalune.v1.ApproveUserRequest
+	27, // 46: altalune.v1.UserService.RejectUser:input_type -> altalune.v1.RejectUserRequest
+	29, // 47: altalune.v1.UserService.UnlockUser:input_type -> altalune.v1.UnlockUserRequest
+	32, // 48: altalune.v1.UserService.ListEmailVerificationTokens:input_type -> altalune.v1.ListEmailVerificationTokensRequest
+	34, // 49: altalune.v1.UserService.InvalidateEmailVerificationTokens:input_type -> altalune.v1.InvalidateEmailVerificationTokensRequest
+	36, // 50: altalune.v1.UserService.ForceEmailReverification:input_type -> altalune.v1.ForceEmailReverificationRequest
+	38, // 51: altalune.v1.UserService.GetEmailVerificationStats:input_type -> altalune.v1.GetEmailVerificationStatsRequest
+	4,  // 52: altalune.v1.UserService.QueryUsers:output_type -> altalune.v1.QueryUsersResponse
+	6,  // 53: altalune.v1.UserService.StreamUsers:output_type -> altalune.v1.StreamUsersResponse
+	8,  // 54: altalune.v1.UserService.CreateUser:output_type -> altalune.v1.CreateUserResponse
+	10, // 55: altalune.v1.UserService.CreateServiceAccount:output_type -> altalune.v1.CreateServiceAccountResponse
+	12, // 56: altalune.v1.UserService.GetUser:output_type -> altalune.v1.GetUserResponse
+	14, // 57: altalune.v1.UserService.UpdateUser:output_type -> altalune.v1.UpdateUserResponse
+	16, // 58: altalune.v1.UserService.DeleteUser:output_type -> altalune.v1.DeleteUserResponse
+	18, // 59: altalune.v1.UserService.RestoreUser:output_type -> altalune.v1.RestoreUserResponse
+	20, // 60: altalune.v1.UserService.ActivateUser:output_type -> altalune.v1.ActivateUserResponse
+	22, // 61: altalune.v1.UserService.DeactivateUser:output_type -> altalune.v1.DeactivateUserResponse
+	24, // 62: altalune.v1.UserService.QueryPendingUsers:output_type -> altalune.v1.QueryPendingUsersResponse
+	26, // 63: altalune.v1.UserService.ApproveUser:output_type -> altalune.v1.ApproveUserResponse
+	28, // 64: altalune.v1.UserService.RejectUser:output_type -> altalune.v1.RejectUserResponse
+	30, // 65: altalune.v1.UserService.UnlockUser:output_type -> altalune.v1.UnlockUserResponse
+	33, // 66: altalune.v1.UserService.ListEmailVerificationTokens:output_type -> altalune.v1.ListEmailVerificationTokensResponse
+	35, // 67: altalune.v1.UserService.InvalidateEmailVerificationTokens:output_type -> altalune.v1.InvalidateEmailVerificationTokensResponse
+	37, // 68: altalune.v1.UserService.ForceEmailReverification:output_type -> altalune.v1.ForceEmailReverificationResponse
+	39, // 69: altalune.v1.UserService.GetEmailVerificationStats:output_type -> altalune.v1.GetEmailVerificationStatsResponse
+	52, // [52:70] is the sub-list for method output_type
+	34, // [34:52] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
 }
 
 func init() { file_altalune_v1_user_proto_init() }
@@ -1137,19 +2616,21 @@ func file_altalune_v1_user_proto_init() {
 		return
 	}
 	file_altalune_v1_common_proto_init()
+	file_altalune_v1_options_proto_init()
 	file_altalune_v1_user_proto_msgTypes[1].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_altalune_v1_user_proto_rawDesc), len(file_altalune_v1_user_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   16,
+			NumEnums:      1,
+			NumMessages:   39,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_altalune_v1_user_proto_goTypes,
 		DependencyIndexes: file_altalune_v1_user_proto_depIdxs,
+		EnumInfos:         file_altalune_v1_user_proto_enumTypes,
 		MessageInfos:      file_altalune_v1_user_proto_msgTypes,
 	}.Build()
 	File_altalune_v1_user_proto = out.File
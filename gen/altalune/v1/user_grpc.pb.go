@@ -19,13 +19,24 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserService_QueryUsers_FullMethodName     = "/altalune.v1.UserService/QueryUsers"
-	UserService_CreateUser_FullMethodName     = "/altalune.v1.UserService/CreateUser"
-	UserService_GetUser_FullMethodName        = "/altalune.v1.UserService/GetUser"
-	UserService_UpdateUser_FullMethodName     = "/altalune.v1.UserService/UpdateUser"
-	UserService_DeleteUser_FullMethodName     = "/altalune.v1.UserService/DeleteUser"
-	UserService_ActivateUser_FullMethodName   = "/altalune.v1.UserService/ActivateUser"
-	UserService_DeactivateUser_FullMethodName = "/altalune.v1.UserService/DeactivateUser"
+	UserService_QueryUsers_FullMethodName                        = "/altalune.v1.UserService/QueryUsers"
+	UserService_StreamUsers_FullMethodName                       = "/altalune.v1.UserService/StreamUsers"
+	UserService_CreateUser_FullMethodName                        = "/altalune.v1.UserService/CreateUser"
+	UserService_CreateServiceAccount_FullMethodName              = "/altalune.v1.UserService/CreateServiceAccount"
+	UserService_GetUser_FullMethodName                           = "/altalune.v1.UserService/GetUser"
+	UserService_UpdateUser_FullMethodName                        = "/altalune.v1.UserService/UpdateUser"
+	UserService_DeleteUser_FullMethodName                        = "/altalune.v1.UserService/DeleteUser"
+	UserService_RestoreUser_FullMethodName                       = "/altalune.v1.UserService/RestoreUser"
+	UserService_ActivateUser_FullMethodName                      = "/altalune.v1.UserService/ActivateUser"
+	UserService_DeactivateUser_FullMethodName                    = "/altalune.v1.UserService/DeactivateUser"
+	UserService_QueryPendingUsers_FullMethodName                 = "/altalune.v1.UserService/QueryPendingUsers"
+	UserService_ApproveUser_FullMethodName                       = "/altalune.v1.UserService/ApproveUser"
+	UserService_RejectUser_FullMethodName                        = "/altalune.v1.UserService/RejectUser"
+	UserService_UnlockUser_FullMethodName                        = "/altalune.v1.UserService/UnlockUser"
+	UserService_ListEmailVerificationTokens_FullMethodName       = "/altalune.v1.UserService/ListEmailVerificationTokens"
+	UserService_InvalidateEmailVerificationTokens_FullMethodName = "/altalune.v1.UserService/InvalidateEmailVerificationTokens"
+	UserService_ForceEmailReverification_FullMethodName          = "/altalune.v1.UserService/ForceEmailReverification"
+	UserService_GetEmailVerificationStats_FullMethodName         = "/altalune.v1.UserService/GetEmailVerificationStats"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -35,12 +46,23 @@ const (
 // UserService provides CRUD operations for user management
 type UserServiceClient interface {
 	QueryUsers(ctx context.Context, in *QueryUsersRequest, opts ...grpc.CallOption) (*QueryUsersResponse, error)
+	StreamUsers(ctx context.Context, in *StreamUsersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamUsersResponse], error)
 	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error)
+	CreateServiceAccount(ctx context.Context, in *CreateServiceAccountRequest, opts ...grpc.CallOption) (*CreateServiceAccountResponse, error)
 	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
 	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error)
 	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
+	RestoreUser(ctx context.Context, in *RestoreUserRequest, opts ...grpc.CallOption) (*RestoreUserResponse, error)
 	ActivateUser(ctx context.Context, in *ActivateUserRequest, opts ...grpc.CallOption) (*ActivateUserResponse, error)
 	DeactivateUser(ctx context.Context, in *DeactivateUserRequest, opts ...grpc.CallOption) (*DeactivateUserResponse, error)
+	QueryPendingUsers(ctx context.Context, in *QueryPendingUsersRequest, opts ...grpc.CallOption) (*QueryPendingUsersResponse, error)
+	ApproveUser(ctx context.Context, in *ApproveUserRequest, opts ...grpc.CallOption) (*ApproveUserResponse, error)
+	RejectUser(ctx context.Context, in *RejectUserRequest, opts ...grpc.CallOption) (*RejectUserResponse, error)
+	UnlockUser(ctx context.Context, in *UnlockUserRequest, opts ...grpc.CallOption) (*UnlockUserResponse, error)
+	ListEmailVerificationTokens(ctx context.Context, in *ListEmailVerificationTokensRequest, opts ...grpc.CallOption) (*ListEmailVerificationTokensResponse, error)
+	InvalidateEmailVerificationTokens(ctx context.Context, in *InvalidateEmailVerificationTokensRequest, opts ...grpc.CallOption) (*InvalidateEmailVerificationTokensResponse, error)
+	ForceEmailReverification(ctx context.Context, in *ForceEmailReverificationRequest, opts ...grpc.CallOption) (*ForceEmailReverificationResponse, error)
+	GetEmailVerificationStats(ctx context.Context, in *GetEmailVerificationStatsRequest, opts ...grpc.CallOption) (*GetEmailVerificationStatsResponse, error)
 }
 
 type userServiceClient struct {
@@ -61,6 +83,25 @@ func (c *userServiceClient) QueryUsers(ctx context.Context, in *QueryUsersReques
 	return out, nil
 }
 
+func (c *userServiceClient) StreamUsers(ctx context.Context, in *StreamUsersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamUsersResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &UserService_ServiceDesc.Streams[0], UserService_StreamUsers_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamUsersRequest, StreamUsersResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UserService_StreamUsersClient = grpc.ServerStreamingClient[StreamUsersResponse]
+
 func (c *userServiceClient) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateUserResponse)
@@ -71,6 +112,16 @@ func (c *userServiceClient) CreateUser(ctx context.Context, in *CreateUserReques
 	return out, nil
 }
 
+func (c *userServiceClient) CreateServiceAccount(ctx context.Context, in *CreateServiceAccountRequest, opts ...grpc.CallOption) (*CreateServiceAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateServiceAccountResponse)
+	err := c.cc.Invoke(ctx, UserService_CreateServiceAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetUserResponse)
@@ -101,6 +152,16 @@ func (c *userServiceClient) DeleteUser(ctx context.Context, in *DeleteUserReques
 	return out, nil
 }
 
+func (c *userServiceClient) RestoreUser(ctx context.Context, in *RestoreUserRequest, opts ...grpc.CallOption) (*RestoreUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreUserResponse)
+	err := c.cc.Invoke(ctx, UserService_RestoreUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) ActivateUser(ctx context.Context, in *ActivateUserRequest, opts ...grpc.CallOption) (*ActivateUserResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ActivateUserResponse)
@@ -121,6 +182,86 @@ func (c *userServiceClient) DeactivateUser(ctx context.Context, in *DeactivateUs
 	return out, nil
 }
 
+func (c *userServiceClient) QueryPendingUsers(ctx context.Context, in *QueryPendingUsersRequest, opts ...grpc.CallOption) (*QueryPendingUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPendingUsersResponse)
+	err := c.cc.Invoke(ctx, UserService_QueryPendingUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ApproveUser(ctx context.Context, in *ApproveUserRequest, opts ...grpc.CallOption) (*ApproveUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveUserResponse)
+	err := c.cc.Invoke(ctx, UserService_ApproveUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RejectUser(ctx context.Context, in *RejectUserRequest, opts ...grpc.CallOption) (*RejectUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectUserResponse)
+	err := c.cc.Invoke(ctx, UserService_RejectUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UnlockUser(ctx context.Context, in *UnlockUserRequest, opts ...grpc.CallOption) (*UnlockUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlockUserResponse)
+	err := c.cc.Invoke(ctx, UserService_UnlockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListEmailVerificationTokens(ctx context.Context, in *ListEmailVerificationTokensRequest, opts ...grpc.CallOption) (*ListEmailVerificationTokensResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEmailVerificationTokensResponse)
+	err := c.cc.Invoke(ctx, UserService_ListEmailVerificationTokens_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) InvalidateEmailVerificationTokens(ctx context.Context, in *InvalidateEmailVerificationTokensRequest, opts ...grpc.CallOption) (*InvalidateEmailVerificationTokensResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvalidateEmailVerificationTokensResponse)
+	err := c.cc.Invoke(ctx, UserService_InvalidateEmailVerificationTokens_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ForceEmailReverification(ctx context.Context, in *ForceEmailReverificationRequest, opts ...grpc.CallOption) (*ForceEmailReverificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ForceEmailReverificationResponse)
+	err := c.cc.Invoke(ctx, UserService_ForceEmailReverification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetEmailVerificationStats(ctx context.Context, in *GetEmailVerificationStatsRequest, opts ...grpc.CallOption) (*GetEmailVerificationStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEmailVerificationStatsResponse)
+	err := c.cc.Invoke(ctx, UserService_GetEmailVerificationStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -128,12 +269,23 @@ func (c *userServiceClient) DeactivateUser(ctx context.Context, in *DeactivateUs
 // UserService provides CRUD operations for user management
 type UserServiceServer interface {
 	QueryUsers(context.Context, *QueryUsersRequest) (*QueryUsersResponse, error)
+	StreamUsers(*StreamUsersRequest, grpc.ServerStreamingServer[StreamUsersResponse]) error
 	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
+	CreateServiceAccount(context.Context, *CreateServiceAccountRequest) (*CreateServiceAccountResponse, error)
 	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
 	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
 	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
+	RestoreUser(context.Context, *RestoreUserRequest) (*RestoreUserResponse, error)
 	ActivateUser(context.Context, *ActivateUserRequest) (*ActivateUserResponse, error)
 	DeactivateUser(context.Context, *DeactivateUserRequest) (*DeactivateUserResponse, error)
+	QueryPendingUsers(context.Context, *QueryPendingUsersRequest) (*QueryPendingUsersResponse, error)
+	ApproveUser(context.Context, *ApproveUserRequest) (*ApproveUserResponse, error)
+	RejectUser(context.Context, *RejectUserRequest) (*RejectUserResponse, error)
+	UnlockUser(context.Context, *UnlockUserRequest) (*UnlockUserResponse, error)
+	ListEmailVerificationTokens(context.Context, *ListEmailVerificationTokensRequest) (*ListEmailVerificationTokensResponse, error)
+	InvalidateEmailVerificationTokens(context.Context, *InvalidateEmailVerificationTokensRequest) (*InvalidateEmailVerificationTokensResponse, error)
+	ForceEmailReverification(context.Context, *ForceEmailReverificationRequest) (*ForceEmailReverificationResponse, error)
+	GetEmailVerificationStats(context.Context, *GetEmailVerificationStatsRequest) (*GetEmailVerificationStatsResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -147,9 +299,15 @@ type UnimplementedUserServiceServer struct{}
 func (UnimplementedUserServiceServer) QueryUsers(context.Context, *QueryUsersRequest) (*QueryUsersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryUsers not implemented")
 }
+func (UnimplementedUserServiceServer) StreamUsers(*StreamUsersRequest, grpc.ServerStreamingServer[StreamUsersResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamUsers not implemented")
+}
 func (UnimplementedUserServiceServer) CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
 }
+func (UnimplementedUserServiceServer) CreateServiceAccount(context.Context, *CreateServiceAccountRequest) (*CreateServiceAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServiceAccount not implemented")
+}
 func (UnimplementedUserServiceServer) GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
 }
@@ -159,12 +317,39 @@ func (UnimplementedUserServiceServer) UpdateUser(context.Context, *UpdateUserReq
 func (UnimplementedUserServiceServer) DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
 }
+func (UnimplementedUserServiceServer) RestoreUser(context.Context, *RestoreUserRequest) (*RestoreUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreUser not implemented")
+}
 func (UnimplementedUserServiceServer) ActivateUser(context.Context, *ActivateUserRequest) (*ActivateUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ActivateUser not implemented")
 }
 func (UnimplementedUserServiceServer) DeactivateUser(context.Context, *DeactivateUserRequest) (*DeactivateUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeactivateUser not implemented")
 }
+func (UnimplementedUserServiceServer) QueryPendingUsers(context.Context, *QueryPendingUsersRequest) (*QueryPendingUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPendingUsers not implemented")
+}
+func (UnimplementedUserServiceServer) ApproveUser(context.Context, *ApproveUserRequest) (*ApproveUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveUser not implemented")
+}
+func (UnimplementedUserServiceServer) RejectUser(context.Context, *RejectUserRequest) (*RejectUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectUser not implemented")
+}
+func (UnimplementedUserServiceServer) UnlockUser(context.Context, *UnlockUserRequest) (*UnlockUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockUser not implemented")
+}
+func (UnimplementedUserServiceServer) ListEmailVerificationTokens(context.Context, *ListEmailVerificationTokensRequest) (*ListEmailVerificationTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEmailVerificationTokens not implemented")
+}
+func (UnimplementedUserServiceServer) InvalidateEmailVerificationTokens(context.Context, *InvalidateEmailVerificationTokensRequest) (*InvalidateEmailVerificationTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvalidateEmailVerificationTokens not implemented")
+}
+func (UnimplementedUserServiceServer) ForceEmailReverification(context.Context, *ForceEmailReverificationRequest) (*ForceEmailReverificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceEmailReverification not implemented")
+}
+func (UnimplementedUserServiceServer) GetEmailVerificationStats(context.Context, *GetEmailVerificationStatsRequest) (*GetEmailVerificationStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEmailVerificationStats not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -204,6 +389,17 @@ func _UserService_QueryUsers_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_StreamUsers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamUsersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UserServiceServer).StreamUsers(m, &grpc.GenericServerStream[StreamUsersRequest, StreamUsersResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UserService_StreamUsersServer = grpc.ServerStreamingServer[StreamUsersResponse]
+
 func _UserService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateUserRequest)
 	if err := dec(in); err != nil {
@@ -222,6 +418,24 @@ func _UserService_CreateUser_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_CreateServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateServiceAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateServiceAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateServiceAccount(ctx, req.(*CreateServiceAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetUserRequest)
 	if err := dec(in); err != nil {
@@ -276,6 +490,24 @@ func _UserService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_RestoreUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RestoreUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RestoreUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RestoreUser(ctx, req.(*RestoreUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_ActivateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ActivateUserRequest)
 	if err := dec(in); err != nil {
@@ -312,6 +544,150 @@ func _UserService_DeactivateUser_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_QueryPendingUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).QueryPendingUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_QueryPendingUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).QueryPendingUsers(ctx, req.(*QueryPendingUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ApproveUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ApproveUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ApproveUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ApproveUser(ctx, req.(*ApproveUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RejectUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RejectUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RejectUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RejectUser(ctx, req.(*RejectUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UnlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UnlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UnlockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UnlockUser(ctx, req.(*UnlockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListEmailVerificationTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEmailVerificationTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListEmailVerificationTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListEmailVerificationTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListEmailVerificationTokens(ctx, req.(*ListEmailVerificationTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_InvalidateEmailVerificationTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvalidateEmailVerificationTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).InvalidateEmailVerificationTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_InvalidateEmailVerificationTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).InvalidateEmailVerificationTokens(ctx, req.(*InvalidateEmailVerificationTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ForceEmailReverification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForceEmailReverificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ForceEmailReverification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ForceEmailReverification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ForceEmailReverification(ctx, req.(*ForceEmailReverificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetEmailVerificationStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEmailVerificationStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetEmailVerificationStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetEmailVerificationStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetEmailVerificationStats(ctx, req.(*GetEmailVerificationStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -327,6 +703,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateUser",
 			Handler:    _UserService_CreateUser_Handler,
 		},
+		{
+			MethodName: "CreateServiceAccount",
+			Handler:    _UserService_CreateServiceAccount_Handler,
+		},
 		{
 			MethodName: "GetUser",
 			Handler:    _UserService_GetUser_Handler,
@@ -339,6 +719,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteUser",
 			Handler:    _UserService_DeleteUser_Handler,
 		},
+		{
+			MethodName: "RestoreUser",
+			Handler:    _UserService_RestoreUser_Handler,
+		},
 		{
 			MethodName: "ActivateUser",
 			Handler:    _UserService_ActivateUser_Handler,
@@ -347,7 +731,45 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeactivateUser",
 			Handler:    _UserService_DeactivateUser_Handler,
 		},
+		{
+			MethodName: "QueryPendingUsers",
+			Handler:    _UserService_QueryPendingUsers_Handler,
+		},
+		{
+			MethodName: "ApproveUser",
+			Handler:    _UserService_ApproveUser_Handler,
+		},
+		{
+			MethodName: "RejectUser",
+			Handler:    _UserService_RejectUser_Handler,
+		},
+		{
+			MethodName: "UnlockUser",
+			Handler:    _UserService_UnlockUser_Handler,
+		},
+		{
+			MethodName: "ListEmailVerificationTokens",
+			Handler:    _UserService_ListEmailVerificationTokens_Handler,
+		},
+		{
+			MethodName: "InvalidateEmailVerificationTokens",
+			Handler:    _UserService_InvalidateEmailVerificationTokens_Handler,
+		},
+		{
+			MethodName: "ForceEmailReverification",
+			Handler:    _UserService_ForceEmailReverification_Handler,
+		},
+		{
+			MethodName: "GetEmailVerificationStats",
+			Handler:    _UserService_GetEmailVerificationStats_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamUsers",
+			Handler:       _UserService_StreamUsers_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "altalune/v1/user.proto",
 }
@@ -4,12 +4,13 @@
 // 	protoc        (unknown)
 // source: chatbot/nodes/v1/node.proto
 
-package nodesv1
+package chatbotnodesv1
 
 import (
 	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -41,6 +42,22 @@ type ChatbotNode struct {
 	Triggers []*ChatbotNodeTrigger `protobuf:"bytes,6,rep,name=triggers,proto3" json:"triggers,omitempty"`
 	// messages - responses sent when node is triggered (OpenAI format)
 	Messages []*ChatbotNodeMessage `protobuf:"bytes,7,rep,name=messages,proto3" json:"messages,omitempty"`
+	// is_predefined - system nodes that cannot be deleted (e.g., talk_to_agent, end_chat)
+	IsPredefined bool `protobuf:"varint,8,opt,name=is_predefined,json=isPredefined,proto3" json:"is_predefined,omitempty"`
+	// priority - matching order (higher priority = checked first, default 0)
+	Priority int32 `protobuf:"varint,9,opt,name=priority,proto3" json:"priority,omitempty"`
+	// condition - json-rules-engine conditions for advanced matching
+	Condition *NodeCondition `protobuf:"bytes,10,opt,name=condition,proto3" json:"condition,omitempty"`
+	// effect - immediate action (SINGLE, executed after messages are sent)
+	Effect *NodeEffect `protobuf:"bytes,11,opt,name=effect,proto3" json:"effect,omitempty"`
+	// next_action - deferred action (SINGLE, executed when user replies)
+	NextAction *NodeNextAction `protobuf:"bytes,12,opt,name=next_action,json=nextAction,proto3" json:"next_action,omitempty"`
+	// version - optional version identifier for node variants (e.g., "roundtrip", "oneway")
+	// When null, this is the default version. Multiple versions with same name+lang can exist.
+	Version *string `protobuf:"bytes,13,opt,name=version,proto3,oneof" json:"version,omitempty"`
+	// force_condition - when true, conditions are always evaluated on goto navigation
+	// Use for versioned nodes where condition determines which version to execute
+	ForceCondition bool `protobuf:"varint,14,opt,name=force_condition,json=forceCondition,proto3" json:"force_condition,omitempty"`
 	// created_at - timestamp of creation
 	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,98,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	// updated_at - timestamp of last update
@@ -128,6 +145,55 @@ func (x *ChatbotNode) GetMessages() []*ChatbotNodeMessage {
 	return nil
 }
 
+func (x *ChatbotNode) GetIsPredefined() bool {
+	if x != nil {
+		return x.IsPredefined
+	}
+	return false
+}
+
+func (x *ChatbotNode) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *ChatbotNode) GetCondition() *NodeCondition {
+	if x != nil {
+		return x.Condition
+	}
+	return nil
+}
+
+func (x *ChatbotNode) GetEffect() *NodeEffect {
+	if x != nil {
+		return x.Effect
+	}
+	return nil
+}
+
+func (x *ChatbotNode) GetNextAction() *NodeNextAction {
+	if x != nil {
+		return x.NextAction
+	}
+	return nil
+}
+
+func (x *ChatbotNode) GetVersion() string {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return ""
+}
+
+func (x *ChatbotNode) GetForceCondition() bool {
+	if x != nil {
+		return x.ForceCondition
+	}
+	return false
+}
+
 func (x *ChatbotNode) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
@@ -254,11 +320,449 @@ func (x *ChatbotNodeMessage) GetContent() string {
 	return ""
 }
 
+// NodeCondition defines a json-rules-engine compatible condition structure.
+// Supports composite logic (all/any/not) and atomic fact comparisons.
+// Example: { "all": [{ "fact": "session.mode", "operator": "equal", "value": "liveChat" }] }
+type NodeCondition struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// all - AND logic: all conditions must pass (composite)
+	All []*NodeCondition `protobuf:"bytes,1,rep,name=all,proto3" json:"all,omitempty"`
+	// any - OR logic: at least one condition must pass (composite)
+	Any []*NodeCondition `protobuf:"bytes,2,rep,name=any,proto3" json:"any,omitempty"`
+	// not - NOT logic: condition must fail (composite)
+	Not *NodeCondition `protobuf:"bytes,3,opt,name=not,proto3" json:"not,omitempty"`
+	// fact - fact name for atomic condition (e.g., "session.mode", "message.text")
+	Fact string `protobuf:"bytes,4,opt,name=fact,proto3" json:"fact,omitempty"`
+	// operator - comparison operator (e.g., "equal", "notEqual", "contains", "greaterThan")
+	Operator string `protobuf:"bytes,5,opt,name=operator,proto3" json:"operator,omitempty"`
+	// value - comparison value (can be string, number, boolean, or object)
+	Value *structpb.Value `protobuf:"bytes,6,opt,name=value,proto3" json:"value,omitempty"`
+	// path - JSONPath for nested data access (e.g., "$.stage" for contextLast)
+	Path          string `protobuf:"bytes,7,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeCondition) Reset() {
+	*x = NodeCondition{}
+	mi := &file_chatbot_nodes_v1_node_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeCondition) ProtoMessage() {}
+
+func (x *NodeCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_chatbot_nodes_v1_node_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeCondition.ProtoReflect.Descriptor instead.
+func (*NodeCondition) Descriptor() ([]byte, []int) {
+	return file_chatbot_nodes_v1_node_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *NodeCondition) GetAll() []*NodeCondition {
+	if x != nil {
+		return x.All
+	}
+	return nil
+}
+
+func (x *NodeCondition) GetAny() []*NodeCondition {
+	if x != nil {
+		return x.Any
+	}
+	return nil
+}
+
+func (x *NodeCondition) GetNot() *NodeCondition {
+	if x != nil {
+		return x.Not
+	}
+	return nil
+}
+
+func (x *NodeCondition) GetFact() string {
+	if x != nil {
+		return x.Fact
+	}
+	return ""
+}
+
+func (x *NodeCondition) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *NodeCondition) GetValue() *structpb.Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *NodeCondition) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+// NodeEffect defines an immediate action executed after sending node messages.
+// This is a SINGLE effect (not array) - executes right after response is sent.
+type NodeEffect struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// type - effect type: "set_mode", "set_context", "goto"
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// target - mode name for set_mode (assistant, flow, liveChat), node_id for goto
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// context - key-value pairs for set_context effect
+	Context       map[string]*structpb.Value `protobuf:"bytes,3,rep,name=context,proto3" json:"context,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeEffect) Reset() {
+	*x = NodeEffect{}
+	mi := &file_chatbot_nodes_v1_node_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeEffect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeEffect) ProtoMessage() {}
+
+func (x *NodeEffect) ProtoReflect() protoreflect.Message {
+	mi := &file_chatbot_nodes_v1_node_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeEffect.ProtoReflect.Descriptor instead.
+func (*NodeEffect) Descriptor() ([]byte, []int) {
+	return file_chatbot_nodes_v1_node_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *NodeEffect) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *NodeEffect) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *NodeEffect) GetContext() map[string]*structpb.Value {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+// NodeNextAction defines a deferred action executed when user replies.
+// This is a SINGLE action - either goto or capture.
+type NodeNextAction struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// type - action type: "goto", "capture"
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// target - node_id for goto type
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// capture - configuration for capture type
+	Capture       *NodeCapture `protobuf:"bytes,3,opt,name=capture,proto3" json:"capture,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeNextAction) Reset() {
+	*x = NodeNextAction{}
+	mi := &file_chatbot_nodes_v1_node_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeNextAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeNextAction) ProtoMessage() {}
+
+func (x *NodeNextAction) ProtoReflect() protoreflect.Message {
+	mi := &file_chatbot_nodes_v1_node_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeNextAction.ProtoReflect.Descriptor instead.
+func (*NodeNextAction) Descriptor() ([]byte, []int) {
+	return file_chatbot_nodes_v1_node_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *NodeNextAction) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *NodeNextAction) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *NodeNextAction) GetCapture() *NodeCapture {
+	if x != nil {
+		return x.Capture
+	}
+	return nil
+}
+
+// NodeCapture defines configuration for capturing user input with Zod-style validation.
+type NodeCapture struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// variable_name - where to store captured value in context (e.g., "userEmail")
+	VariableName string `protobuf:"bytes,1,opt,name=variable_name,json=variableName,proto3" json:"variable_name,omitempty"`
+	// validation - Zod-inspired validation rules
+	Validation *NodeCaptureValidation `protobuf:"bytes,2,opt,name=validation,proto3" json:"validation,omitempty"`
+	// on_fail_message - message shown each time validation fails (supports {{variables}})
+	OnFailMessage string `protobuf:"bytes,3,opt,name=on_fail_message,json=onFailMessage,proto3" json:"on_fail_message,omitempty"`
+	// max_retries - max retry attempts (0 = unlimited)
+	MaxRetries int32 `protobuf:"varint,4,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// on_success_context - additional context to set on success (beyond captured value)
+	OnSuccessContext map[string]*structpb.Value `protobuf:"bytes,5,rep,name=on_success_context,json=onSuccessContext,proto3" json:"on_success_context,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// on_success_goto - node to execute after successful capture (optional)
+	OnSuccessGoto string `protobuf:"bytes,6,opt,name=on_success_goto,json=onSuccessGoto,proto3" json:"on_success_goto,omitempty"`
+	// on_fail_goto - node to execute when maxRetries exceeded (optional escape route)
+	OnFailGoto    string `protobuf:"bytes,7,opt,name=on_fail_goto,json=onFailGoto,proto3" json:"on_fail_goto,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeCapture) Reset() {
+	*x = NodeCapture{}
+	mi := &file_chatbot_nodes_v1_node_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeCapture) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeCapture) ProtoMessage() {}
+
+func (x *NodeCapture) ProtoReflect() protoreflect.Message {
+	mi := &file_chatbot_nodes_v1_node_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeCapture.ProtoReflect.Descriptor instead.
+func (*NodeCapture) Descriptor() ([]byte, []int) {
+	return file_chatbot_nodes_v1_node_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *NodeCapture) GetVariableName() string {
+	if x != nil {
+		return x.VariableName
+	}
+	return ""
+}
+
+func (x *NodeCapture) GetValidation() *NodeCaptureValidation {
+	if x != nil {
+		return x.Validation
+	}
+	return nil
+}
+
+func (x *NodeCapture) GetOnFailMessage() string {
+	if x != nil {
+		return x.OnFailMessage
+	}
+	return ""
+}
+
+func (x *NodeCapture) GetMaxRetries() int32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *NodeCapture) GetOnSuccessContext() map[string]*structpb.Value {
+	if x != nil {
+		return x.OnSuccessContext
+	}
+	return nil
+}
+
+func (x *NodeCapture) GetOnSuccessGoto() string {
+	if x != nil {
+		return x.OnSuccessGoto
+	}
+	return ""
+}
+
+func (x *NodeCapture) GetOnFailGoto() string {
+	if x != nil {
+		return x.OnFailGoto
+	}
+	return ""
+}
+
+// NodeCaptureValidation defines Zod-inspired validation rules for captured input.
+type NodeCaptureValidation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// type - validation preset type
+	// "string" - any non-empty string
+	// "email" - valid email format
+	// "phone" - valid phone number (international)
+	// "number" - numeric value (int or float)
+	// "regex" - custom regex pattern
+	// "options" - must match one of provided options
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// pattern - custom regex pattern (for type="regex")
+	Pattern string `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// options - valid option values (for type="options")
+	Options []string `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
+	// min_length - minimum string length (for type="string")
+	MinLength int32 `protobuf:"varint,4,opt,name=min_length,json=minLength,proto3" json:"min_length,omitempty"`
+	// max_length - maximum string length (for type="string")
+	MaxLength int32 `protobuf:"varint,5,opt,name=max_length,json=maxLength,proto3" json:"max_length,omitempty"`
+	// min - minimum numeric value (for type="number")
+	Min float64 `protobuf:"fixed64,6,opt,name=min,proto3" json:"min,omitempty"`
+	// max - maximum numeric value (for type="number")
+	Max           float64 `protobuf:"fixed64,7,opt,name=max,proto3" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeCaptureValidation) Reset() {
+	*x = NodeCaptureValidation{}
+	mi := &file_chatbot_nodes_v1_node_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeCaptureValidation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeCaptureValidation) ProtoMessage() {}
+
+func (x *NodeCaptureValidation) ProtoReflect() protoreflect.Message {
+	mi := &file_chatbot_nodes_v1_node_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeCaptureValidation.ProtoReflect.Descriptor instead.
+func (*NodeCaptureValidation) Descriptor() ([]byte, []int) {
+	return file_chatbot_nodes_v1_node_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *NodeCaptureValidation) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *NodeCaptureValidation) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *NodeCaptureValidation) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *NodeCaptureValidation) GetMinLength() int32 {
+	if x != nil {
+		return x.MinLength
+	}
+	return 0
+}
+
+func (x *NodeCaptureValidation) GetMaxLength() int32 {
+	if x != nil {
+		return x.MaxLength
+	}
+	return 0
+}
+
+func (x *NodeCaptureValidation) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *NodeCaptureValidation) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
 var File_chatbot_nodes_v1_node_proto protoreflect.FileDescriptor
 
 const file_chatbot_nodes_v1_node_proto_rawDesc = "" +
 	"\n" +
-	"\x1bchatbot/nodes/v1/node.proto\x12\x10chatbot.nodes.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\"\xed\x02\n" +
+	"\x1bchatbot/nodes/v1/node.proto\x12\x10chatbot.nodes.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1bbuf/validate/validate.proto\"\xba\x05\n" +
 	"\vChatbotNode\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
@@ -266,11 +770,22 @@ const file_chatbot_nodes_v1_node_proto_rawDesc = "" +
 	"\x04tags\x18\x04 \x03(\tR\x04tags\x12\x18\n" +
 	"\aenabled\x18\x05 \x01(\bR\aenabled\x12@\n" +
 	"\btriggers\x18\x06 \x03(\v2$.chatbot.nodes.v1.ChatbotNodeTriggerR\btriggers\x12@\n" +
-	"\bmessages\x18\a \x03(\v2$.chatbot.nodes.v1.ChatbotNodeMessageR\bmessages\x129\n" +
+	"\bmessages\x18\a \x03(\v2$.chatbot.nodes.v1.ChatbotNodeMessageR\bmessages\x12#\n" +
+	"\ris_predefined\x18\b \x01(\bR\fisPredefined\x12\x1a\n" +
+	"\bpriority\x18\t \x01(\x05R\bpriority\x12=\n" +
+	"\tcondition\x18\n" +
+	" \x01(\v2\x1f.chatbot.nodes.v1.NodeConditionR\tcondition\x124\n" +
+	"\x06effect\x18\v \x01(\v2\x1c.chatbot.nodes.v1.NodeEffectR\x06effect\x12A\n" +
+	"\vnext_action\x18\f \x01(\v2 .chatbot.nodes.v1.NodeNextActionR\n" +
+	"nextAction\x12\x1d\n" +
+	"\aversion\x18\r \x01(\tH\x00R\aversion\x88\x01\x01\x12'\n" +
+	"\x0fforce_condition\x18\x0e \x01(\bR\x0eforceCondition\x129\n" +
 	"\n" +
 	"created_at\x18b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18c \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"s\n" +
+	"updated_at\x18c \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\n" +
+	"\n" +
+	"\b_version\"s\n" +
 	"\x12ChatbotNodeTrigger\x12;\n" +
 	"\x04type\x18\x01 \x01(\tB'\xbaH$r\"R\akeywordR\bcontainsR\x05regexR\x06equalsR\x04type\x12 \n" +
 	"\x05value\x18\x02 \x01(\tB\n" +
@@ -278,8 +793,53 @@ const file_chatbot_nodes_v1_node_proto_rawDesc = "" +
 	"\x12ChatbotNodeMessage\x12$\n" +
 	"\x04role\x18\x01 \x01(\tB\x10\xbaH\rr\vR\tassistantR\x04role\x12$\n" +
 	"\acontent\x18\x02 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\x88'R\acontentB\xba\x01\n" +
-	"\x14com.chatbot.nodes.v1B\tNodeProtoP\x01Z5github.com/hrz8/altalune/gen/chatbot/nodes/v1;nodesv1\xa2\x02\x03CNX\xaa\x02\x10Chatbot.Nodes.V1\xca\x02\x10Chatbot\\Nodes\\V1\xe2\x02\x1cChatbot\\Nodes\\V1\\GPBMetadata\xea\x02\x12Chatbot::Nodes::V1b\x06proto3"
+	"\xbaH\ar\x05\x10\x01\x18\x88'R\acontent\"\x9a\x02\n" +
+	"\rNodeCondition\x121\n" +
+	"\x03all\x18\x01 \x03(\v2\x1f.chatbot.nodes.v1.NodeConditionR\x03all\x121\n" +
+	"\x03any\x18\x02 \x03(\v2\x1f.chatbot.nodes.v1.NodeConditionR\x03any\x121\n" +
+	"\x03not\x18\x03 \x01(\v2\x1f.chatbot.nodes.v1.NodeConditionR\x03not\x12\x12\n" +
+	"\x04fact\x18\x04 \x01(\tR\x04fact\x12\x1a\n" +
+	"\boperator\x18\x05 \x01(\tR\boperator\x12,\n" +
+	"\x05value\x18\x06 \x01(\v2\x16.google.protobuf.ValueR\x05value\x12\x12\n" +
+	"\x04path\x18\a \x01(\tR\x04path\"\xf5\x01\n" +
+	"\n" +
+	"NodeEffect\x126\n" +
+	"\x04type\x18\x01 \x01(\tB\"\xbaH\x1fr\x1dR\bset_modeR\vset_contextR\x04gotoR\x04type\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\x12C\n" +
+	"\acontext\x18\x03 \x03(\v2).chatbot.nodes.v1.NodeEffect.ContextEntryR\acontext\x1aR\n" +
+	"\fContextEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12,\n" +
+	"\x05value\x18\x02 \x01(\v2\x16.google.protobuf.ValueR\x05value:\x028\x01\"\x8b\x01\n" +
+	"\x0eNodeNextAction\x12(\n" +
+	"\x04type\x18\x01 \x01(\tB\x14\xbaH\x11r\x0fR\x04gotoR\acaptureR\x04type\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\x127\n" +
+	"\acapture\x18\x03 \x01(\v2\x1d.chatbot.nodes.v1.NodeCaptureR\acapture\"\xd7\x03\n" +
+	"\vNodeCapture\x12,\n" +
+	"\rvariable_name\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\fvariableName\x12G\n" +
+	"\n" +
+	"validation\x18\x02 \x01(\v2'.chatbot.nodes.v1.NodeCaptureValidationR\n" +
+	"validation\x12&\n" +
+	"\x0fon_fail_message\x18\x03 \x01(\tR\ronFailMessage\x12\x1f\n" +
+	"\vmax_retries\x18\x04 \x01(\x05R\n" +
+	"maxRetries\x12a\n" +
+	"\x12on_success_context\x18\x05 \x03(\v23.chatbot.nodes.v1.NodeCapture.OnSuccessContextEntryR\x10onSuccessContext\x12&\n" +
+	"\x0fon_success_goto\x18\x06 \x01(\tR\ronSuccessGoto\x12 \n" +
+	"\fon_fail_goto\x18\a \x01(\tR\n" +
+	"onFailGoto\x1a[\n" +
+	"\x15OnSuccessContextEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12,\n" +
+	"\x05value\x18\x02 \x01(\v2\x16.google.protobuf.ValueR\x05value:\x028\x01\"\xf6\x01\n" +
+	"\x15NodeCaptureValidation\x12G\n" +
+	"\x04type\x18\x01 \x01(\tB3\xbaH0r.R\x06stringR\x05emailR\x05phoneR\x06numberR\x05regexR\aoptionsR\x04type\x12\x18\n" +
+	"\apattern\x18\x02 \x01(\tR\apattern\x12\x18\n" +
+	"\aoptions\x18\x03 \x03(\tR\aoptions\x12\x1d\n" +
+	"\n" +
+	"min_length\x18\x04 \x01(\x05R\tminLength\x12\x1d\n" +
+	"\n" +
+	"max_length\x18\x05 \x01(\x05R\tmaxLength\x12\x10\n" +
+	"\x03min\x18\x06 \x01(\x01R\x03min\x12\x10\n" +
+	"\x03max\x18\a \x01(\x01R\x03maxB\xc1\x01\n" +
+	"\x14com.chatbot.nodes.v1B\tNodeProtoP\x01Z<github.com/hrz8/altalune/gen/chatbot/nodes/v1;chatbotnodesv1\xa2\x02\x03CNX\xaa\x02\x10Chatbot.Nodes.V1\xca\x02\x10Chatbot\\Nodes\\V1\xe2\x02\x1cChatbot\\Nodes\\V1\\GPBMetadata\xea\x02\x12Chatbot::Nodes::V1b\x06proto3"
 
 var (
 	file_chatbot_nodes_v1_node_proto_rawDescOnce sync.Once
@@ -293,23 +853,44 @@ func file_chatbot_nodes_v1_node_proto_rawDescGZIP() []byte {
 	return file_chatbot_nodes_v1_node_proto_rawDescData
 }
 
-var file_chatbot_nodes_v1_node_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_chatbot_nodes_v1_node_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_chatbot_nodes_v1_node_proto_goTypes = []any{
 	(*ChatbotNode)(nil),           // 0: chatbot.nodes.v1.ChatbotNode
 	(*ChatbotNodeTrigger)(nil),    // 1: chatbot.nodes.v1.ChatbotNodeTrigger
 	(*ChatbotNodeMessage)(nil),    // 2: chatbot.nodes.v1.ChatbotNodeMessage
-	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+	(*NodeCondition)(nil),         // 3: chatbot.nodes.v1.NodeCondition
+	(*NodeEffect)(nil),            // 4: chatbot.nodes.v1.NodeEffect
+	(*NodeNextAction)(nil),        // 5: chatbot.nodes.v1.NodeNextAction
+	(*NodeCapture)(nil),           // 6: chatbot.nodes.v1.NodeCapture
+	(*NodeCaptureValidation)(nil), // 7: chatbot.nodes.v1.NodeCaptureValidation
+	nil,                           // 8: chatbot.nodes.v1.NodeEffect.ContextEntry
+	nil,                           // 9: chatbot.nodes.v1.NodeCapture.OnSuccessContextEntry
+	(*timestamppb.Timestamp)(nil), // 10: google.protobuf.Timestamp
+	(*structpb.Value)(nil),        // 11: google.protobuf.Value
 }
 var file_chatbot_nodes_v1_node_proto_depIdxs = []int32{
-	1, // 0: chatbot.nodes.v1.ChatbotNode.triggers:type_name -> chatbot.nodes.v1.ChatbotNodeTrigger
-	2, // 1: chatbot.nodes.v1.ChatbotNode.messages:type_name -> chatbot.nodes.v1.ChatbotNodeMessage
-	3, // 2: chatbot.nodes.v1.ChatbotNode.created_at:type_name -> google.protobuf.Timestamp
-	3, // 3: chatbot.nodes.v1.ChatbotNode.updated_at:type_name -> google.protobuf.Timestamp
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	1,  // 0: chatbot.nodes.v1.ChatbotNode.triggers:type_name -> chatbot.nodes.v1.ChatbotNodeTrigger
+	2,  // 1: chatbot.nodes.v1.ChatbotNode.messages:type_name -> chatbot.nodes.v1.ChatbotNodeMessage
+	3,  // 2: chatbot.nodes.v1.ChatbotNode.condition:type_name -> chatbot.nodes.v1.NodeCondition
+	4,  // 3: chatbot.nodes.v1.ChatbotNode.effect:type_name -> chatbot.nodes.v1.NodeEffect
+	5,  // 4: chatbot.nodes.v1.ChatbotNode.next_action:type_name -> chatbot.nodes.v1.NodeNextAction
+	10, // 5: chatbot.nodes.v1.ChatbotNode.created_at:type_name -> google.protobuf.Timestamp
+	10, // 6: chatbot.nodes.v1.ChatbotNode.updated_at:type_name -> google.protobuf.Timestamp
+	3,  // 7: chatbot.nodes.v1.NodeCondition.all:type_name -> chatbot.nodes.v1.NodeCondition
+	3,  // 8: chatbot.nodes.v1.NodeCondition.any:type_name -> chatbot.nodes.v1.NodeCondition
+	3,  // 9: chatbot.nodes.v1.NodeCondition.not:type_name -> chatbot.nodes.v1.NodeCondition
+	11, // 10: chatbot.nodes.v1.NodeCondition.value:type_name -> google.protobuf.Value
+	8,  // 11: chatbot.nodes.v1.NodeEffect.context:type_name -> chatbot.nodes.v1.NodeEffect.ContextEntry
+	6,  // 12: chatbot.nodes.v1.NodeNextAction.capture:type_name -> chatbot.nodes.v1.NodeCapture
+	7,  // 13: chatbot.nodes.v1.NodeCapture.validation:type_name -> chatbot.nodes.v1.NodeCaptureValidation
+	9,  // 14: chatbot.nodes.v1.NodeCapture.on_success_context:type_name -> chatbot.nodes.v1.NodeCapture.OnSuccessContextEntry
+	11, // 15: chatbot.nodes.v1.NodeEffect.ContextEntry.value:type_name -> google.protobuf.Value
+	11, // 16: chatbot.nodes.v1.NodeCapture.OnSuccessContextEntry.value:type_name -> google.protobuf.Value
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_chatbot_nodes_v1_node_proto_init() }
@@ -317,13 +898,14 @@ func file_chatbot_nodes_v1_node_proto_init() {
 	if File_chatbot_nodes_v1_node_proto != nil {
 		return
 	}
+	file_chatbot_nodes_v1_node_proto_msgTypes[0].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_chatbot_nodes_v1_node_proto_rawDesc), len(file_chatbot_nodes_v1_node_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -7,6 +7,7 @@
 package greeterv1
 
 import (
+	_ "github.com/hrz8/altalune/gen/altalune/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -25,30 +26,50 @@ var File_greeter_v1_greeter_proto protoreflect.FileDescriptor
 const file_greeter_v1_greeter_proto_rawDesc = "" +
 	"\n" +
 	"\x18greeter/v1/greeter.proto\x12\n" +
-	"greeter.v1\x1a\x16greeter/v1/hello.proto\x1a\x15greeter/v1/name.proto2\xb7\x01\n" +
+	"greeter.v1\x1a\x16greeter/v1/hello.proto\x1a\x15greeter/v1/name.proto\x1a\x19altalune/v1/options.proto2\xf3\x04\n" +
 	"\x0eGreeterService\x12G\n" +
-	"\bSayHello\x12\x1b.greeter.v1.SayHelloRequest\x1a\x1c.greeter.v1.SayHelloResponse\"\x00\x12\\\n" +
-	"\x0fGetAllowedNames\x12\".greeter.v1.GetAllowedNamesRequest\x1a#.greeter.v1.GetAllowedNamesResponse\"\x00B\x9a\x01\n" +
+	"\bSayHello\x12\x1b.greeter.v1.SayHelloRequest\x1a\x1c.greeter.v1.SayHelloResponse\"\x00\x12[\n" +
+	"\x0eSayHelloToMany\x12!.greeter.v1.SayHelloToManyRequest\x1a\".greeter.v1.SayHelloToManyResponse\"\x000\x01\x12\\\n" +
+	"\x0fGetAllowedNames\x12\".greeter.v1.GetAllowedNamesRequest\x1a#.greeter.v1.GetAllowedNamesResponse\"\x00\x12s\n" +
+	"\x11CreateAllowedName\x12$.greeter.v1.CreateAllowedNameRequest\x1a%.greeter.v1.CreateAllowedNameResponse\"\x11\x8a\xb5\x18\rgreeter:write\x12s\n" +
+	"\x11UpdateAllowedName\x12$.greeter.v1.UpdateAllowedNameRequest\x1a%.greeter.v1.UpdateAllowedNameResponse\"\x11\x8a\xb5\x18\rgreeter:write\x12s\n" +
+	"\x11DeleteAllowedName\x12$.greeter.v1.DeleteAllowedNameRequest\x1a%.greeter.v1.DeleteAllowedNameResponse\"\x11\x8a\xb5\x18\rgreeter:writeB\x9a\x01\n" +
 	"\x0ecom.greeter.v1B\fGreeterProtoP\x01Z1github.com/hrz8/altalune/gen/greeter/v1;greeterv1\xa2\x02\x03GXX\xaa\x02\n" +
 	"Greeter.V1\xca\x02\n" +
 	"Greeter\\V1\xe2\x02\x16Greeter\\V1\\GPBMetadata\xea\x02\vGreeter::V1b\x06proto3"
 
 var file_greeter_v1_greeter_proto_goTypes = []any{
-	(*SayHelloRequest)(nil),         // 0: greeter.v1.SayHelloRequest
-	(*GetAllowedNamesRequest)(nil),  // 1: greeter.v1.GetAllowedNamesRequest
-	(*SayHelloResponse)(nil),        // 2: greeter.v1.SayHelloResponse
-	(*GetAllowedNamesResponse)(nil), // 3: greeter.v1.GetAllowedNamesResponse
+	(*SayHelloRequest)(nil),           // 0: greeter.v1.SayHelloRequest
+	(*SayHelloToManyRequest)(nil),     // 1: greeter.v1.SayHelloToManyRequest
+	(*GetAllowedNamesRequest)(nil),    // 2: greeter.v1.GetAllowedNamesRequest
+	(*CreateAllowedNameRequest)(nil),  // 3: greeter.v1.CreateAllowedNameRequest
+	(*UpdateAllowedNameRequest)(nil),  // 4: greeter.v1.UpdateAllowedNameRequest
+	(*DeleteAllowedNameRequest)(nil),  // 5: greeter.v1.DeleteAllowedNameRequest
+	(*SayHelloResponse)(nil),          // 6: greeter.v1.SayHelloResponse
+	(*SayHelloToManyResponse)(nil),    // 7: greeter.v1.SayHelloToManyResponse
+	(*GetAllowedNamesResponse)(nil),   // 8: greeter.v1.GetAllowedNamesResponse
+	(*CreateAllowedNameResponse)(nil), // 9: greeter.v1.CreateAllowedNameResponse
+	(*UpdateAllowedNameResponse)(nil), // 10: greeter.v1.UpdateAllowedNameResponse
+	(*DeleteAllowedNameResponse)(nil), // 11: greeter.v1.DeleteAllowedNameResponse
 }
 var file_greeter_v1_greeter_proto_depIdxs = []int32{
-	0, // 0: greeter.v1.GreeterService.SayHello:input_type -> greeter.v1.SayHelloRequest
-	1, // 1: greeter.v1.GreeterService.GetAllowedNames:input_type -> greeter.v1.GetAllowedNamesRequest
-	2, // 2: greeter.v1.GreeterService.SayHello:output_type -> greeter.v1.SayHelloResponse
-	3, // 3: greeter.v1.GreeterService.GetAllowedNames:output_type -> greeter.v1.GetAllowedNamesResponse
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: greeter.v1.GreeterService.SayHello:input_type -> greeter.v1.SayHelloRequest
+	1,  // 1: greeter.v1.GreeterService.SayHelloToMany:input_type -> greeter.v1.SayHelloToManyRequest
+	2,  // 2: greeter.v1.GreeterService.GetAllowedNames:input_type -> greeter.v1.GetAllowedNamesRequest
+	3,  // 3: greeter.v1.GreeterService.CreateAllowedName:input_type -> greeter.v1.CreateAllowedNameRequest
+	4,  // 4: greeter.v1.GreeterService.UpdateAllowedName:input_type -> greeter.v1.UpdateAllowedNameRequest
+	5,  // 5: greeter.v1.GreeterService.DeleteAllowedName:input_type -> greeter.v1.DeleteAllowedNameRequest
+	6,  // 6: greeter.v1.GreeterService.SayHello:output_type -> greeter.v1.SayHelloResponse
+	7,  // 7: greeter.v1.GreeterService.SayHelloToMany:output_type -> greeter.v1.SayHelloToManyResponse
+	8,  // 8: greeter.v1.GreeterService.GetAllowedNames:output_type -> greeter.v1.GetAllowedNamesResponse
+	9,  // 9: greeter.v1.GreeterService.CreateAllowedName:output_type -> greeter.v1.CreateAllowedNameResponse
+	10, // 10: greeter.v1.GreeterService.UpdateAllowedName:output_type -> greeter.v1.UpdateAllowedNameResponse
+	11, // 11: greeter.v1.GreeterService.DeleteAllowedName:output_type -> greeter.v1.DeleteAllowedNameResponse
+	6,  // [6:12] is the sub-list for method output_type
+	0,  // [0:6] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_greeter_v1_greeter_proto_init() }
@@ -19,8 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	GreeterService_SayHello_FullMethodName        = "/greeter.v1.GreeterService/SayHello"
-	GreeterService_GetAllowedNames_FullMethodName = "/greeter.v1.GreeterService/GetAllowedNames"
+	GreeterService_SayHello_FullMethodName          = "/greeter.v1.GreeterService/SayHello"
+	GreeterService_SayHelloToMany_FullMethodName    = "/greeter.v1.GreeterService/SayHelloToMany"
+	GreeterService_GetAllowedNames_FullMethodName   = "/greeter.v1.GreeterService/GetAllowedNames"
+	GreeterService_CreateAllowedName_FullMethodName = "/greeter.v1.GreeterService/CreateAllowedName"
+	GreeterService_UpdateAllowedName_FullMethodName = "/greeter.v1.GreeterService/UpdateAllowedName"
+	GreeterService_DeleteAllowedName_FullMethodName = "/greeter.v1.GreeterService/DeleteAllowedName"
 )
 
 // GreeterServiceClient is the client API for GreeterService service.
@@ -28,7 +32,11 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type GreeterServiceClient interface {
 	SayHello(ctx context.Context, in *SayHelloRequest, opts ...grpc.CallOption) (*SayHelloResponse, error)
+	SayHelloToMany(ctx context.Context, in *SayHelloToManyRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SayHelloToManyResponse], error)
 	GetAllowedNames(ctx context.Context, in *GetAllowedNamesRequest, opts ...grpc.CallOption) (*GetAllowedNamesResponse, error)
+	CreateAllowedName(ctx context.Context, in *CreateAllowedNameRequest, opts ...grpc.CallOption) (*CreateAllowedNameResponse, error)
+	UpdateAllowedName(ctx context.Context, in *UpdateAllowedNameRequest, opts ...grpc.CallOption) (*UpdateAllowedNameResponse, error)
+	DeleteAllowedName(ctx context.Context, in *DeleteAllowedNameRequest, opts ...grpc.CallOption) (*DeleteAllowedNameResponse, error)
 }
 
 type greeterServiceClient struct {
@@ -49,6 +57,25 @@ func (c *greeterServiceClient) SayHello(ctx context.Context, in *SayHelloRequest
 	return out, nil
 }
 
+func (c *greeterServiceClient) SayHelloToMany(ctx context.Context, in *SayHelloToManyRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SayHelloToManyResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GreeterService_ServiceDesc.Streams[0], GreeterService_SayHelloToMany_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SayHelloToManyRequest, SayHelloToManyResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GreeterService_SayHelloToManyClient = grpc.ServerStreamingClient[SayHelloToManyResponse]
+
 func (c *greeterServiceClient) GetAllowedNames(ctx context.Context, in *GetAllowedNamesRequest, opts ...grpc.CallOption) (*GetAllowedNamesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetAllowedNamesResponse)
@@ -59,12 +86,46 @@ func (c *greeterServiceClient) GetAllowedNames(ctx context.Context, in *GetAllow
 	return out, nil
 }
 
+func (c *greeterServiceClient) CreateAllowedName(ctx context.Context, in *CreateAllowedNameRequest, opts ...grpc.CallOption) (*CreateAllowedNameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAllowedNameResponse)
+	err := c.cc.Invoke(ctx, GreeterService_CreateAllowedName_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterServiceClient) UpdateAllowedName(ctx context.Context, in *UpdateAllowedNameRequest, opts ...grpc.CallOption) (*UpdateAllowedNameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateAllowedNameResponse)
+	err := c.cc.Invoke(ctx, GreeterService_UpdateAllowedName_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterServiceClient) DeleteAllowedName(ctx context.Context, in *DeleteAllowedNameRequest, opts ...grpc.CallOption) (*DeleteAllowedNameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAllowedNameResponse)
+	err := c.cc.Invoke(ctx, GreeterService_DeleteAllowedName_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GreeterServiceServer is the server API for GreeterService service.
 // All implementations must embed UnimplementedGreeterServiceServer
 // for forward compatibility.
 type GreeterServiceServer interface {
 	SayHello(context.Context, *SayHelloRequest) (*SayHelloResponse, error)
+	SayHelloToMany(*SayHelloToManyRequest, grpc.ServerStreamingServer[SayHelloToManyResponse]) error
 	GetAllowedNames(context.Context, *GetAllowedNamesRequest) (*GetAllowedNamesResponse, error)
+	CreateAllowedName(context.Context, *CreateAllowedNameRequest) (*CreateAllowedNameResponse, error)
+	UpdateAllowedName(context.Context, *UpdateAllowedNameRequest) (*UpdateAllowedNameResponse, error)
+	DeleteAllowedName(context.Context, *DeleteAllowedNameRequest) (*DeleteAllowedNameResponse, error)
 	mustEmbedUnimplementedGreeterServiceServer()
 }
 
@@ -78,9 +139,21 @@ type UnimplementedGreeterServiceServer struct{}
 func (UnimplementedGreeterServiceServer) SayHello(context.Context, *SayHelloRequest) (*SayHelloResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SayHello not implemented")
 }
+func (UnimplementedGreeterServiceServer) SayHelloToMany(*SayHelloToManyRequest, grpc.ServerStreamingServer[SayHelloToManyResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method SayHelloToMany not implemented")
+}
 func (UnimplementedGreeterServiceServer) GetAllowedNames(context.Context, *GetAllowedNamesRequest) (*GetAllowedNamesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllowedNames not implemented")
 }
+func (UnimplementedGreeterServiceServer) CreateAllowedName(context.Context, *CreateAllowedNameRequest) (*CreateAllowedNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAllowedName not implemented")
+}
+func (UnimplementedGreeterServiceServer) UpdateAllowedName(context.Context, *UpdateAllowedNameRequest) (*UpdateAllowedNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAllowedName not implemented")
+}
+func (UnimplementedGreeterServiceServer) DeleteAllowedName(context.Context, *DeleteAllowedNameRequest) (*DeleteAllowedNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAllowedName not implemented")
+}
 func (UnimplementedGreeterServiceServer) mustEmbedUnimplementedGreeterServiceServer() {}
 func (UnimplementedGreeterServiceServer) testEmbeddedByValue()                        {}
 
@@ -120,6 +193,17 @@ func _GreeterService_SayHello_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GreeterService_SayHelloToMany_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SayHelloToManyRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GreeterServiceServer).SayHelloToMany(m, &grpc.GenericServerStream[SayHelloToManyRequest, SayHelloToManyResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GreeterService_SayHelloToManyServer = grpc.ServerStreamingServer[SayHelloToManyResponse]
+
 func _GreeterService_GetAllowedNames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetAllowedNamesRequest)
 	if err := dec(in); err != nil {
@@ -138,6 +222,60 @@ func _GreeterService_GetAllowedNames_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GreeterService_CreateAllowedName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAllowedNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServiceServer).CreateAllowedName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GreeterService_CreateAllowedName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServiceServer).CreateAllowedName(ctx, req.(*CreateAllowedNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GreeterService_UpdateAllowedName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAllowedNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServiceServer).UpdateAllowedName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GreeterService_UpdateAllowedName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServiceServer).UpdateAllowedName(ctx, req.(*UpdateAllowedNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GreeterService_DeleteAllowedName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAllowedNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServiceServer).DeleteAllowedName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GreeterService_DeleteAllowedName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServiceServer).DeleteAllowedName(ctx, req.(*DeleteAllowedNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GreeterService_ServiceDesc is the grpc.ServiceDesc for GreeterService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,7 +291,25 @@ var GreeterService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAllowedNames",
 			Handler:    _GreeterService_GetAllowedNames_Handler,
 		},
+		{
+			MethodName: "CreateAllowedName",
+			Handler:    _GreeterService_CreateAllowedName_Handler,
+		},
+		{
+			MethodName: "UpdateAllowedName",
+			Handler:    _GreeterService_UpdateAllowedName_Handler,
+		},
+		{
+			MethodName: "DeleteAllowedName",
+			Handler:    _GreeterService_DeleteAllowedName_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SayHelloToMany",
+			Handler:       _GreeterService_SayHelloToMany_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "greeter/v1/greeter.proto",
 }
@@ -35,22 +35,42 @@ const (
 const (
 	// GreeterServiceSayHelloProcedure is the fully-qualified name of the GreeterService's SayHello RPC.
 	GreeterServiceSayHelloProcedure = "/greeter.v1.GreeterService/SayHello"
+	// GreeterServiceSayHelloToManyProcedure is the fully-qualified name of the GreeterService's
+	// SayHelloToMany RPC.
+	GreeterServiceSayHelloToManyProcedure = "/greeter.v1.GreeterService/SayHelloToMany"
 	// GreeterServiceGetAllowedNamesProcedure is the fully-qualified name of the GreeterService's
 	// GetAllowedNames RPC.
 	GreeterServiceGetAllowedNamesProcedure = "/greeter.v1.GreeterService/GetAllowedNames"
+	// GreeterServiceCreateAllowedNameProcedure is the fully-qualified name of the GreeterService's
+	// CreateAllowedName RPC.
+	GreeterServiceCreateAllowedNameProcedure = "/greeter.v1.GreeterService/CreateAllowedName"
+	// GreeterServiceUpdateAllowedNameProcedure is the fully-qualified name of the GreeterService's
+	// UpdateAllowedName RPC.
+	GreeterServiceUpdateAllowedNameProcedure = "/greeter.v1.GreeterService/UpdateAllowedName"
+	// GreeterServiceDeleteAllowedNameProcedure is the fully-qualified name of the GreeterService's
+	// DeleteAllowedName RPC.
+	GreeterServiceDeleteAllowedNameProcedure = "/greeter.v1.GreeterService/DeleteAllowedName"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	greeterServiceServiceDescriptor               = v1.File_greeter_v1_greeter_proto.Services().ByName("GreeterService")
-	greeterServiceSayHelloMethodDescriptor        = greeterServiceServiceDescriptor.Methods().ByName("SayHello")
-	greeterServiceGetAllowedNamesMethodDescriptor = greeterServiceServiceDescriptor.Methods().ByName("GetAllowedNames")
+	greeterServiceServiceDescriptor                 = v1.File_greeter_v1_greeter_proto.Services().ByName("GreeterService")
+	greeterServiceSayHelloMethodDescriptor          = greeterServiceServiceDescriptor.Methods().ByName("SayHello")
+	greeterServiceSayHelloToManyMethodDescriptor    = greeterServiceServiceDescriptor.Methods().ByName("SayHelloToMany")
+	greeterServiceGetAllowedNamesMethodDescriptor   = greeterServiceServiceDescriptor.Methods().ByName("GetAllowedNames")
+	greeterServiceCreateAllowedNameMethodDescriptor = greeterServiceServiceDescriptor.Methods().ByName("CreateAllowedName")
+	greeterServiceUpdateAllowedNameMethodDescriptor = greeterServiceServiceDescriptor.Methods().ByName("UpdateAllowedName")
+	greeterServiceDeleteAllowedNameMethodDescriptor = greeterServiceServiceDescriptor.Methods().ByName("DeleteAllowedName")
 )
 
 // GreeterServiceClient is a client for the greeter.v1.GreeterService service.
 type GreeterServiceClient interface {
 	SayHello(context.Context, *connect.Request[v1.SayHelloRequest]) (*connect.Response[v1.SayHelloResponse], error)
+	SayHelloToMany(context.Context, *connect.Request[v1.SayHelloToManyRequest]) (*connect.ServerStreamForClient[v1.SayHelloToManyResponse], error)
 	GetAllowedNames(context.Context, *connect.Request[v1.GetAllowedNamesRequest]) (*connect.Response[v1.GetAllowedNamesResponse], error)
+	CreateAllowedName(context.Context, *connect.Request[v1.CreateAllowedNameRequest]) (*connect.Response[v1.CreateAllowedNameResponse], error)
+	UpdateAllowedName(context.Context, *connect.Request[v1.UpdateAllowedNameRequest]) (*connect.Response[v1.UpdateAllowedNameResponse], error)
+	DeleteAllowedName(context.Context, *connect.Request[v1.DeleteAllowedNameRequest]) (*connect.Response[v1.DeleteAllowedNameResponse], error)
 }
 
 // NewGreeterServiceClient constructs a client for the greeter.v1.GreeterService service. By
@@ -69,19 +89,47 @@ func NewGreeterServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(greeterServiceSayHelloMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		sayHelloToMany: connect.NewClient[v1.SayHelloToManyRequest, v1.SayHelloToManyResponse](
+			httpClient,
+			baseURL+GreeterServiceSayHelloToManyProcedure,
+			connect.WithSchema(greeterServiceSayHelloToManyMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		getAllowedNames: connect.NewClient[v1.GetAllowedNamesRequest, v1.GetAllowedNamesResponse](
 			httpClient,
 			baseURL+GreeterServiceGetAllowedNamesProcedure,
 			connect.WithSchema(greeterServiceGetAllowedNamesMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		createAllowedName: connect.NewClient[v1.CreateAllowedNameRequest, v1.CreateAllowedNameResponse](
+			httpClient,
+			baseURL+GreeterServiceCreateAllowedNameProcedure,
+			connect.WithSchema(greeterServiceCreateAllowedNameMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateAllowedName: connect.NewClient[v1.UpdateAllowedNameRequest, v1.UpdateAllowedNameResponse](
+			httpClient,
+			baseURL+GreeterServiceUpdateAllowedNameProcedure,
+			connect.WithSchema(greeterServiceUpdateAllowedNameMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAllowedName: connect.NewClient[v1.DeleteAllowedNameRequest, v1.DeleteAllowedNameResponse](
+			httpClient,
+			baseURL+GreeterServiceDeleteAllowedNameProcedure,
+			connect.WithSchema(greeterServiceDeleteAllowedNameMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // greeterServiceClient implements GreeterServiceClient.
 type greeterServiceClient struct {
-	sayHello        *connect.Client[v1.SayHelloRequest, v1.SayHelloResponse]
-	getAllowedNames *connect.Client[v1.GetAllowedNamesRequest, v1.GetAllowedNamesResponse]
+	sayHello          *connect.Client[v1.SayHelloRequest, v1.SayHelloResponse]
+	sayHelloToMany    *connect.Client[v1.SayHelloToManyRequest, v1.SayHelloToManyResponse]
+	getAllowedNames   *connect.Client[v1.GetAllowedNamesRequest, v1.GetAllowedNamesResponse]
+	createAllowedName *connect.Client[v1.CreateAllowedNameRequest, v1.CreateAllowedNameResponse]
+	updateAllowedName *connect.Client[v1.UpdateAllowedNameRequest, v1.UpdateAllowedNameResponse]
+	deleteAllowedName *connect.Client[v1.DeleteAllowedNameRequest, v1.DeleteAllowedNameResponse]
 }
 
 // SayHello calls greeter.v1.GreeterService.SayHello.
@@ -89,15 +137,39 @@ func (c *greeterServiceClient) SayHello(ctx context.Context, req *connect.Reques
 	return c.sayHello.CallUnary(ctx, req)
 }
 
+// SayHelloToMany calls greeter.v1.GreeterService.SayHelloToMany.
+func (c *greeterServiceClient) SayHelloToMany(ctx context.Context, req *connect.Request[v1.SayHelloToManyRequest]) (*connect.ServerStreamForClient[v1.SayHelloToManyResponse], error) {
+	return c.sayHelloToMany.CallServerStream(ctx, req)
+}
+
 // GetAllowedNames calls greeter.v1.GreeterService.GetAllowedNames.
 func (c *greeterServiceClient) GetAllowedNames(ctx context.Context, req *connect.Request[v1.GetAllowedNamesRequest]) (*connect.Response[v1.GetAllowedNamesResponse], error) {
 	return c.getAllowedNames.CallUnary(ctx, req)
 }
 
+// CreateAllowedName calls greeter.v1.GreeterService.CreateAllowedName.
+func (c *greeterServiceClient) CreateAllowedName(ctx context.Context, req *connect.Request[v1.CreateAllowedNameRequest]) (*connect.Response[v1.CreateAllowedNameResponse], error) {
+	return c.createAllowedName.CallUnary(ctx, req)
+}
+
+// UpdateAllowedName calls greeter.v1.GreeterService.UpdateAllowedName.
+func (c *greeterServiceClient) UpdateAllowedName(ctx context.Context, req *connect.Request[v1.UpdateAllowedNameRequest]) (*connect.Response[v1.UpdateAllowedNameResponse], error) {
+	return c.updateAllowedName.CallUnary(ctx, req)
+}
+
+// DeleteAllowedName calls greeter.v1.GreeterService.DeleteAllowedName.
+func (c *greeterServiceClient) DeleteAllowedName(ctx context.Context, req *connect.Request[v1.DeleteAllowedNameRequest]) (*connect.Response[v1.DeleteAllowedNameResponse], error) {
+	return c.deleteAllowedName.CallUnary(ctx, req)
+}
+
 // GreeterServiceHandler is an implementation of the greeter.v1.GreeterService service.
 type GreeterServiceHandler interface {
 	SayHello(context.Context, *connect.Request[v1.SayHelloRequest]) (*connect.Response[v1.SayHelloResponse], error)
+	SayHelloToMany(context.Context, *connect.Request[v1.SayHelloToManyRequest], *connect.ServerStream[v1.SayHelloToManyResponse]) error
 	GetAllowedNames(context.Context, *connect.Request[v1.GetAllowedNamesRequest]) (*connect.Response[v1.GetAllowedNamesResponse], error)
+	CreateAllowedName(context.Context, *connect.Request[v1.CreateAllowedNameRequest]) (*connect.Response[v1.CreateAllowedNameResponse], error)
+	UpdateAllowedName(context.Context, *connect.Request[v1.UpdateAllowedNameRequest]) (*connect.Response[v1.UpdateAllowedNameResponse], error)
+	DeleteAllowedName(context.Context, *connect.Request[v1.DeleteAllowedNameRequest]) (*connect.Response[v1.DeleteAllowedNameResponse], error)
 }
 
 // NewGreeterServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -112,18 +184,50 @@ func NewGreeterServiceHandler(svc GreeterServiceHandler, opts ...connect.Handler
 		connect.WithSchema(greeterServiceSayHelloMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	greeterServiceSayHelloToManyHandler := connect.NewServerStreamHandler(
+		GreeterServiceSayHelloToManyProcedure,
+		svc.SayHelloToMany,
+		connect.WithSchema(greeterServiceSayHelloToManyMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	greeterServiceGetAllowedNamesHandler := connect.NewUnaryHandler(
 		GreeterServiceGetAllowedNamesProcedure,
 		svc.GetAllowedNames,
 		connect.WithSchema(greeterServiceGetAllowedNamesMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	greeterServiceCreateAllowedNameHandler := connect.NewUnaryHandler(
+		GreeterServiceCreateAllowedNameProcedure,
+		svc.CreateAllowedName,
+		connect.WithSchema(greeterServiceCreateAllowedNameMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	greeterServiceUpdateAllowedNameHandler := connect.NewUnaryHandler(
+		GreeterServiceUpdateAllowedNameProcedure,
+		svc.UpdateAllowedName,
+		connect.WithSchema(greeterServiceUpdateAllowedNameMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	greeterServiceDeleteAllowedNameHandler := connect.NewUnaryHandler(
+		GreeterServiceDeleteAllowedNameProcedure,
+		svc.DeleteAllowedName,
+		connect.WithSchema(greeterServiceDeleteAllowedNameMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/greeter.v1.GreeterService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case GreeterServiceSayHelloProcedure:
 			greeterServiceSayHelloHandler.ServeHTTP(w, r)
+		case GreeterServiceSayHelloToManyProcedure:
+			greeterServiceSayHelloToManyHandler.ServeHTTP(w, r)
 		case GreeterServiceGetAllowedNamesProcedure:
 			greeterServiceGetAllowedNamesHandler.ServeHTTP(w, r)
+		case GreeterServiceCreateAllowedNameProcedure:
+			greeterServiceCreateAllowedNameHandler.ServeHTTP(w, r)
+		case GreeterServiceUpdateAllowedNameProcedure:
+			greeterServiceUpdateAllowedNameHandler.ServeHTTP(w, r)
+		case GreeterServiceDeleteAllowedNameProcedure:
+			greeterServiceDeleteAllowedNameHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -137,6 +241,22 @@ func (UnimplementedGreeterServiceHandler) SayHello(context.Context, *connect.Req
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("greeter.v1.GreeterService.SayHello is not implemented"))
 }
 
+func (UnimplementedGreeterServiceHandler) SayHelloToMany(context.Context, *connect.Request[v1.SayHelloToManyRequest], *connect.ServerStream[v1.SayHelloToManyResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("greeter.v1.GreeterService.SayHelloToMany is not implemented"))
+}
+
 func (UnimplementedGreeterServiceHandler) GetAllowedNames(context.Context, *connect.Request[v1.GetAllowedNamesRequest]) (*connect.Response[v1.GetAllowedNamesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("greeter.v1.GreeterService.GetAllowedNames is not implemented"))
 }
+
+func (UnimplementedGreeterServiceHandler) CreateAllowedName(context.Context, *connect.Request[v1.CreateAllowedNameRequest]) (*connect.Response[v1.CreateAllowedNameResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("greeter.v1.GreeterService.CreateAllowedName is not implemented"))
+}
+
+func (UnimplementedGreeterServiceHandler) UpdateAllowedName(context.Context, *connect.Request[v1.UpdateAllowedNameRequest]) (*connect.Response[v1.UpdateAllowedNameResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("greeter.v1.GreeterService.UpdateAllowedName is not implemented"))
+}
+
+func (UnimplementedGreeterServiceHandler) DeleteAllowedName(context.Context, *connect.Request[v1.DeleteAllowedNameRequest]) (*connect.Response[v1.DeleteAllowedNameResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("greeter.v1.GreeterService.DeleteAllowedName is not implemented"))
+}
@@ -110,6 +110,103 @@ func (x *SayHelloResponse) GetMessage() string {
 	return ""
 }
 
+// SayHelloToManyRequest greets several names; one response is streamed per name.
+type SayHelloToManyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Names         []string               `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SayHelloToManyRequest) Reset() {
+	*x = SayHelloToManyRequest{}
+	mi := &file_greeter_v1_hello_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SayHelloToManyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SayHelloToManyRequest) ProtoMessage() {}
+
+func (x *SayHelloToManyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_greeter_v1_hello_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SayHelloToManyRequest.ProtoReflect.Descriptor instead.
+func (*SayHelloToManyRequest) Descriptor() ([]byte, []int) {
+	return file_greeter_v1_hello_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SayHelloToManyRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type SayHelloToManyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SayHelloToManyResponse) Reset() {
+	*x = SayHelloToManyResponse{}
+	mi := &file_greeter_v1_hello_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SayHelloToManyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SayHelloToManyResponse) ProtoMessage() {}
+
+func (x *SayHelloToManyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_greeter_v1_hello_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SayHelloToManyResponse.ProtoReflect.Descriptor instead.
+func (*SayHelloToManyResponse) Descriptor() ([]byte, []int) {
+	return file_greeter_v1_hello_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SayHelloToManyResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SayHelloToManyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 var File_greeter_v1_hello_proto protoreflect.FileDescriptor
 
 const file_greeter_v1_hello_proto_rawDesc = "" +
@@ -119,7 +216,12 @@ const file_greeter_v1_hello_proto_rawDesc = "" +
 	"\x0fSayHelloRequest\x122\n" +
 	"\x04name\x18\x01 \x01(\tB\x1e\xbaH\x1b\xc8\x01\x01r\x16\x10\x03\x1822\x10^[A-Za-z0-9_-]+$R\x04name\",\n" +
 	"\x10SayHelloResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessageB\x98\x01\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"U\n" +
+	"\x15SayHelloToManyRequest\x12<\n" +
+	"\x05names\x18\x01 \x03(\tB&\xbaH#\x92\x01 \b\x01\x10\x14\x18\x01\"\x18r\x16\x10\x03\x1822\x10^[A-Za-z0-9_-]+$R\x05names\"F\n" +
+	"\x16SayHelloToManyResponse\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessageB\x98\x01\n" +
 	"\x0ecom.greeter.v1B\n" +
 	"HelloProtoP\x01Z1github.com/hrz8/altalune/gen/greeter/v1;greeterv1\xa2\x02\x03GXX\xaa\x02\n" +
 	"Greeter.V1\xca\x02\n" +
@@ -137,10 +239,12 @@ func file_greeter_v1_hello_proto_rawDescGZIP() []byte {
 	return file_greeter_v1_hello_proto_rawDescData
 }
 
-var file_greeter_v1_hello_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_greeter_v1_hello_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_greeter_v1_hello_proto_goTypes = []any{
-	(*SayHelloRequest)(nil),  // 0: greeter.v1.SayHelloRequest
-	(*SayHelloResponse)(nil), // 1: greeter.v1.SayHelloResponse
+	(*SayHelloRequest)(nil),        // 0: greeter.v1.SayHelloRequest
+	(*SayHelloResponse)(nil),       // 1: greeter.v1.SayHelloResponse
+	(*SayHelloToManyRequest)(nil),  // 2: greeter.v1.SayHelloToManyRequest
+	(*SayHelloToManyResponse)(nil), // 3: greeter.v1.SayHelloToManyResponse
 }
 var file_greeter_v1_hello_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -161,7 +265,7 @@ func file_greeter_v1_hello_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_greeter_v1_hello_proto_rawDesc), len(file_greeter_v1_hello_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -126,6 +126,270 @@ func (x *GetAllowedNamesResponse) GetMeta() *PaginationMeta {
 	return nil
 }
 
+type CreateAllowedNameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAllowedNameRequest) Reset() {
+	*x = CreateAllowedNameRequest{}
+	mi := &file_greeter_v1_name_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAllowedNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAllowedNameRequest) ProtoMessage() {}
+
+func (x *CreateAllowedNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_greeter_v1_name_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAllowedNameRequest.ProtoReflect.Descriptor instead.
+func (*CreateAllowedNameRequest) Descriptor() ([]byte, []int) {
+	return file_greeter_v1_name_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateAllowedNameRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateAllowedNameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAllowedNameResponse) Reset() {
+	*x = CreateAllowedNameResponse{}
+	mi := &file_greeter_v1_name_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAllowedNameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAllowedNameResponse) ProtoMessage() {}
+
+func (x *CreateAllowedNameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_greeter_v1_name_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAllowedNameResponse.ProtoReflect.Descriptor instead.
+func (*CreateAllowedNameResponse) Descriptor() ([]byte, []int) {
+	return file_greeter_v1_name_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateAllowedNameResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UpdateAllowedNameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	NewName       string                 `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAllowedNameRequest) Reset() {
+	*x = UpdateAllowedNameRequest{}
+	mi := &file_greeter_v1_name_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAllowedNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAllowedNameRequest) ProtoMessage() {}
+
+func (x *UpdateAllowedNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_greeter_v1_name_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAllowedNameRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAllowedNameRequest) Descriptor() ([]byte, []int) {
+	return file_greeter_v1_name_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateAllowedNameRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateAllowedNameRequest) GetNewName() string {
+	if x != nil {
+		return x.NewName
+	}
+	return ""
+}
+
+type UpdateAllowedNameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAllowedNameResponse) Reset() {
+	*x = UpdateAllowedNameResponse{}
+	mi := &file_greeter_v1_name_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAllowedNameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAllowedNameResponse) ProtoMessage() {}
+
+func (x *UpdateAllowedNameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_greeter_v1_name_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAllowedNameResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAllowedNameResponse) Descriptor() ([]byte, []int) {
+	return file_greeter_v1_name_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateAllowedNameResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteAllowedNameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAllowedNameRequest) Reset() {
+	*x = DeleteAllowedNameRequest{}
+	mi := &file_greeter_v1_name_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAllowedNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAllowedNameRequest) ProtoMessage() {}
+
+func (x *DeleteAllowedNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_greeter_v1_name_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAllowedNameRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAllowedNameRequest) Descriptor() ([]byte, []int) {
+	return file_greeter_v1_name_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteAllowedNameRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteAllowedNameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAllowedNameResponse) Reset() {
+	*x = DeleteAllowedNameResponse{}
+	mi := &file_greeter_v1_name_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAllowedNameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAllowedNameResponse) ProtoMessage() {}
+
+func (x *DeleteAllowedNameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_greeter_v1_name_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAllowedNameResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAllowedNameResponse) Descriptor() ([]byte, []int) {
+	return file_greeter_v1_name_proto_rawDescGZIP(), []int{7}
+}
+
 var File_greeter_v1_name_proto protoreflect.FileDescriptor
 
 const file_greeter_v1_name_proto_rawDesc = "" +
@@ -138,7 +402,19 @@ const file_greeter_v1_name_proto_rawDesc = "" +
 	"\x05limit\x18\x02 \x01(\x05B\f\xbaH\t\xc8\x01\x01\x1a\x04\x18d \x00R\x05limit\"_\n" +
 	"\x17GetAllowedNamesResponse\x12\x14\n" +
 	"\x05names\x18\x01 \x03(\tR\x05names\x12.\n" +
-	"\x04meta\x18\x02 \x01(\v2\x1a.greeter.v1.PaginationMetaR\x04metaB\x97\x01\n" +
+	"\x04meta\x18\x02 \x01(\v2\x1a.greeter.v1.PaginationMetaR\x04meta\"N\n" +
+	"\x18CreateAllowedNameRequest\x122\n" +
+	"\x04name\x18\x01 \x01(\tB\x1e\xbaH\x1b\xc8\x01\x01r\x16\x10\x03\x1822\x10^[A-Za-z0-9_-]+$R\x04name\"/\n" +
+	"\x19CreateAllowedNameResponse\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\x89\x01\n" +
+	"\x18UpdateAllowedNameRequest\x122\n" +
+	"\x04name\x18\x01 \x01(\tB\x1e\xbaH\x1b\xc8\x01\x01r\x16\x10\x03\x1822\x10^[A-Za-z0-9_-]+$R\x04name\x129\n" +
+	"\bnew_name\x18\x02 \x01(\tB\x1e\xbaH\x1b\xc8\x01\x01r\x16\x10\x03\x1822\x10^[A-Za-z0-9_-]+$R\anewName\"/\n" +
+	"\x19UpdateAllowedNameResponse\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"N\n" +
+	"\x18DeleteAllowedNameRequest\x122\n" +
+	"\x04name\x18\x01 \x01(\tB\x1e\xbaH\x1b\xc8\x01\x01r\x16\x10\x03\x1822\x10^[A-Za-z0-9_-]+$R\x04name\"\x1b\n" +
+	"\x19DeleteAllowedNameResponseB\x97\x01\n" +
 	"\x0ecom.greeter.v1B\tNameProtoP\x01Z1github.com/hrz8/altalune/gen/greeter/v1;greeterv1\xa2\x02\x03GXX\xaa\x02\n" +
 	"Greeter.V1\xca\x02\n" +
 	"Greeter\\V1\xe2\x02\x16Greeter\\V1\\GPBMetadata\xea\x02\vGreeter::V1b\x06proto3"
@@ -155,14 +431,20 @@ func file_greeter_v1_name_proto_rawDescGZIP() []byte {
 	return file_greeter_v1_name_proto_rawDescData
 }
 
-var file_greeter_v1_name_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_greeter_v1_name_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_greeter_v1_name_proto_goTypes = []any{
-	(*GetAllowedNamesRequest)(nil),  // 0: greeter.v1.GetAllowedNamesRequest
-	(*GetAllowedNamesResponse)(nil), // 1: greeter.v1.GetAllowedNamesResponse
-	(*PaginationMeta)(nil),          // 2: greeter.v1.PaginationMeta
+	(*GetAllowedNamesRequest)(nil),    // 0: greeter.v1.GetAllowedNamesRequest
+	(*GetAllowedNamesResponse)(nil),   // 1: greeter.v1.GetAllowedNamesResponse
+	(*CreateAllowedNameRequest)(nil),  // 2: greeter.v1.CreateAllowedNameRequest
+	(*CreateAllowedNameResponse)(nil), // 3: greeter.v1.CreateAllowedNameResponse
+	(*UpdateAllowedNameRequest)(nil),  // 4: greeter.v1.UpdateAllowedNameRequest
+	(*UpdateAllowedNameResponse)(nil), // 5: greeter.v1.UpdateAllowedNameResponse
+	(*DeleteAllowedNameRequest)(nil),  // 6: greeter.v1.DeleteAllowedNameRequest
+	(*DeleteAllowedNameResponse)(nil), // 7: greeter.v1.DeleteAllowedNameResponse
+	(*PaginationMeta)(nil),            // 8: greeter.v1.PaginationMeta
 }
 var file_greeter_v1_name_proto_depIdxs = []int32{
-	2, // 0: greeter.v1.GetAllowedNamesResponse.meta:type_name -> greeter.v1.PaginationMeta
+	8, // 0: greeter.v1.GetAllowedNamesResponse.meta:type_name -> greeter.v1.PaginationMeta
 	1, // [1:1] is the sub-list for method output_type
 	1, // [1:1] is the sub-list for method input_type
 	1, // [1:1] is the sub-list for extension type_name
@@ -182,7 +464,7 @@ func file_greeter_v1_name_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_greeter_v1_name_proto_rawDesc), len(file_greeter_v1_name_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
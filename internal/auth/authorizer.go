@@ -51,8 +51,9 @@ func (a *Authorizer) CheckPermission(ctx context.Context, permission string) err
 	return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied: requires %s", permission))
 }
 
-// CheckProjectAccess validates user has permission AND is project member.
-// projectID is the project's public_id.
+// CheckProjectAccess validates user is project member AND has permission.
+// projectID is the project's public_id. Non-members get NotFound, as for an
+// unknown project (see concealProject).
 func (a *Authorizer) CheckProjectAccess(ctx context.Context, permission string, projectID string) error {
 	auth := FromContext(ctx)
 
@@ -65,6 +66,11 @@ func (a *Authorizer) CheckProjectAccess(ctx context.Context, permission string,
 		return nil
 	}
 
+	// Check project membership
+	if _, ok := auth.Memberships[projectID]; !ok {
+		return concealProject(projectID)
+	}
+
 	// Check permission
 	hasPermission := false
 	for _, p := range auth.Permissions {
@@ -78,15 +84,11 @@ func (a *Authorizer) CheckProjectAccess(ctx context.Context, permission string,
 		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied: requires %s", permission))
 	}
 
-	// Check project membership
-	if _, ok := auth.Memberships[projectID]; !ok {
-		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied: not a member of this project"))
-	}
-
 	return nil
 }
 
-// CheckProjectMembership validates user is member of project.
+// CheckProjectMembership validates user is member of project. Non-members get
+// NotFound, as for an unknown project (see concealProject).
 func (a *Authorizer) CheckProjectMembership(ctx context.Context, projectID string) error {
 	auth := FromContext(ctx)
 
@@ -100,7 +102,7 @@ func (a *Authorizer) CheckProjectMembership(ctx context.Context, projectID strin
 	}
 
 	if _, ok := auth.Memberships[projectID]; !ok {
-		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied: not a member of this project"))
+		return concealProject(projectID)
 	}
 
 	return nil
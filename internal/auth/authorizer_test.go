@@ -0,0 +1,41 @@
+package auth_test
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/hrz8/altalune"
+	"github.com/hrz8/altalune/internal/auth"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckProjectAccess(t *testing.T) {
+	authorizer := auth.NewAuthorizer()
+	caller := func(memberships map[string]string, perms ...string) context.Context {
+		return auth.WithAuthContext(context.Background(), &auth.AuthContext{
+			IsAuthenticated: true,
+			Permissions:     perms,
+			Memberships:     memberships,
+		})
+	}
+	member := map[string]string{"project0000001": "member"}
+
+	assert.NoError(t, authorizer.CheckProjectAccess(caller(member, "apikey:read"), "apikey:read", "project0000001"))
+	assert.NoError(t, authorizer.CheckProjectAccess(caller(nil, auth.RootPermission), "apikey:read", "project0000001"))
+
+	err := authorizer.CheckProjectAccess(caller(member), "apikey:read", "project0000001")
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err), "members know the project exists")
+
+	// Outsiders get the answer of an unknown project, whatever their permissions
+	for _, ctx := range []context.Context{caller(member, "apikey:read"), caller(member)} {
+		err := authorizer.CheckProjectAccess(ctx, "apikey:read", "project0000002")
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+		assert.Equal(t, altalune.ToConnectError(altalune.NewProjectNotFound("project0000002")).Error(), err.Error())
+	}
+	err = authorizer.CheckProjectMembership(caller(member), "project0000002")
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	err = authorizer.CheckProjectAccess(context.Background(), "apikey:read", "project0000001")
+	assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+}
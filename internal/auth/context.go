@@ -11,6 +11,7 @@ const authContextKey contextKey = "auth_context"
 // AuthContext holds the authenticated user's information from JWT.
 type AuthContext struct {
 	UserID          string            // JWT subject (user public_id)
+	ClientID        string            // JWT audience (OAuth client_id the token was issued to)
 	Email           string
 	Name            string
 	Permissions     []string
@@ -28,6 +29,16 @@ func FromContext(ctx context.Context) *AuthContext {
 	return auth
 }
 
+// ActorID returns the public ID of the authenticated user, or an empty string
+// when the request is not authenticated (e.g. seeders and CLI commands).
+func ActorID(ctx context.Context) string {
+	auth := FromContext(ctx)
+	if !auth.IsAuthenticated {
+		return ""
+	}
+	return auth.UserID
+}
+
 // WithAuthContext adds AuthContext to request context.
 func WithAuthContext(ctx context.Context, auth *AuthContext) context.Context {
 	return context.WithValue(ctx, authContextKey, auth)
@@ -35,8 +46,13 @@ func WithAuthContext(ctx context.Context, auth *AuthContext) context.Context {
 
 // NewAuthContextFromClaims creates AuthContext from JWT claims.
 func NewAuthContextFromClaims(claims *AccessTokenClaims) *AuthContext {
+	var clientID string
+	if len(claims.Audience) > 0 {
+		clientID = claims.Audience[0]
+	}
 	return &AuthContext{
 		UserID:          claims.Subject,
+		ClientID:        clientID,
 		Email:           claims.Email,
 		Name:            claims.Name,
 		Permissions:     claims.Perms,
@@ -0,0 +1,16 @@
+package auth
+
+import (
+	"github.com/hrz8/altalune"
+)
+
+// concealProject returns the error answering an authenticated caller who is
+// not a member of projectID. It is the disclosure policy of tenant-scoped
+// resources: a project and its resources are invisible outside it, so the
+// caller gets the NotFound error the services answer for an unknown project
+// and probing project IDs tells nothing. Members lacking a permission still
+// get PermissionDenied, as they know the project exists, and global
+// resources, such as users and OAuth clients, keep answering PermissionDenied.
+func concealProject(projectID string) error {
+	return altalune.ToConnectError(altalune.NewProjectNotFound(projectID))
+}
@@ -6,18 +6,44 @@ import (
 	"strings"
 
 	"connectrpc.com/connect"
+	"github.com/hrz8/altalune/logger"
 )
 
+// PermissionResolver loads the permissions of a user, for the tokens that do
+// not list them all.
+type PermissionResolver interface {
+	ResolvePermissions(ctx context.Context, userPublicID string) ([]string, error)
+}
+
 // authInterceptor implements connect.Interceptor for JWT validation.
 type authInterceptor struct {
 	validator *JWTValidator
+	resolver  PermissionResolver // Optional
 }
 
 // NewAuthInterceptor creates a Connect-RPC interceptor for JWT validation.
 // It extracts Bearer tokens from Authorization header or access_token cookie,
-// validates the JWT, and injects AuthContext into the request context.
-func NewAuthInterceptor(validator *JWTValidator) connect.Interceptor {
-	return &authInterceptor{validator: validator}
+// validates the JWT, and injects AuthContext into the request context. When
+// resolver is set, tokens with slimmed permissions get them from it; without
+// it they authorize with the permissions they carry.
+func NewAuthInterceptor(validator *JWTValidator, resolver PermissionResolver) connect.Interceptor {
+	return &authInterceptor{validator: validator, resolver: resolver}
+}
+
+// authContext creates the AuthContext of claims, resolving the permissions a
+// slimmed token leaves out
+func (i *authInterceptor) authContext(ctx context.Context, claims *AccessTokenClaims) (*AuthContext, error) {
+	authCtx := NewAuthContextFromClaims(claims)
+	if i.resolver == nil || claims.HasAllPerms() {
+		return authCtx, nil
+	}
+
+	perms, err := i.resolver.ResolvePermissions(ctx, claims.Subject)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("failed to resolve permissions"))
+	}
+	authCtx.Permissions = perms
+	return authCtx, nil
 }
 
 // WrapUnary implements connect.Interceptor for unary RPC calls.
@@ -40,8 +66,12 @@ func (i *authInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
 		}
 
 		// Create AuthContext from claims
-		authCtx := NewAuthContextFromClaims(claims)
+		authCtx, err := i.authContext(ctx, claims)
+		if err != nil {
+			return nil, err
+		}
 		ctx = WithAuthContext(ctx, authCtx)
+		ctx = logger.WithAttrs(ctx, "user_id", authCtx.UserID)
 
 		return next(ctx, req)
 	}
@@ -69,8 +99,12 @@ func (i *authInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc
 			return connect.NewError(connect.CodeUnauthenticated, err)
 		}
 
-		authCtx := NewAuthContextFromClaims(claims)
+		authCtx, err := i.authContext(ctx, claims)
+		if err != nil {
+			return err
+		}
 		ctx = WithAuthContext(ctx, authCtx)
+		ctx = logger.WithAttrs(ctx, "user_id", authCtx.UserID)
 
 		return next(ctx, conn)
 	}
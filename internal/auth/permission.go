@@ -0,0 +1,70 @@
+package auth
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// MethodPermissions returns the permissions an RPC declares with the
+// altalune.v1.permission option. The caller needs one of them; an empty result
+// means the RPC declares none.
+func MethodPermissions(method protoreflect.MethodDescriptor) []string {
+	perms, _ := proto.GetExtension(method.Options(), altalunev1.E_Permission).([]string)
+	return perms
+}
+
+// permissionInterceptor implements connect.Interceptor for the permissions
+// declared in the proto.
+type permissionInterceptor struct {
+	authorizer *Authorizer
+}
+
+// NewPermissionInterceptor creates a Connect-RPC interceptor that rejects calls
+// to RPCs whose declared permissions the caller has none of. It must run after
+// the auth interceptor. Project membership is still checked by the handlers,
+// which know where the project ID lives in the request.
+func NewPermissionInterceptor(authorizer *Authorizer) connect.Interceptor {
+	return &permissionInterceptor{authorizer: authorizer}
+}
+
+// WrapUnary implements connect.Interceptor for unary RPC calls.
+func (i *permissionInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if err := i.check(ctx, req.Spec()); err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+// WrapStreamingClient implements connect.Interceptor for client streaming.
+// This is a pass-through for server-side interceptors.
+func (i *permissionInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler implements connect.Interceptor for server streaming.
+func (i *permissionInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if err := i.check(ctx, conn.Spec()); err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	}
+}
+
+func (i *permissionInterceptor) check(ctx context.Context, spec connect.Spec) error {
+	method, ok := spec.Schema.(protoreflect.MethodDescriptor)
+	if !ok {
+		return nil
+	}
+	perms := MethodPermissions(method)
+	if len(perms) == 0 {
+		return nil
+	}
+	return i.authorizer.CheckAnyPermission(ctx, perms)
+}
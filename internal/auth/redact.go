@@ -0,0 +1,69 @@
+package auth
+
+import (
+	"context"
+
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// FieldPermission returns the permission a field declares with the
+// altalune.v1.read_permission option, or an empty string when anyone who can
+// read the message can see it.
+func FieldPermission(field protoreflect.FieldDescriptor) string {
+	perm, _ := proto.GetExtension(field.Options(), altalunev1.E_ReadPermission).(string)
+	return perm
+}
+
+// Redact clears the fields of msg, and of every message nested in it, whose
+// read permission the caller does not have. Handlers call it on responses so
+// field-level visibility is declared once in the proto.
+func (a *Authorizer) Redact(ctx context.Context, msg proto.Message) {
+	if msg == nil || a.IsSuperAdmin(ctx) {
+		return
+	}
+	redact(msg.ProtoReflect(), func(perm string) bool { return a.HasPermission(ctx, perm) })
+}
+
+// RedactedFields returns the names of the fields of a message the caller may
+// not see, for responses that are not protobuf messages such as exports.
+func (a *Authorizer) RedactedFields(ctx context.Context, desc protoreflect.MessageDescriptor) []string {
+	if a.IsSuperAdmin(ctx) {
+		return nil
+	}
+
+	var redacted []string
+	fields := desc.Fields()
+	for i := range fields.Len() {
+		if perm := FieldPermission(fields.Get(i)); perm != "" && !a.HasPermission(ctx, perm) {
+			redacted = append(redacted, string(fields.Get(i).Name()))
+		}
+	}
+	return redacted
+}
+
+func redact(msg protoreflect.Message, allowed func(perm string) bool) {
+	msg.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		if perm := FieldPermission(field); perm != "" && !allowed(perm) {
+			msg.Clear(field)
+			return true
+		}
+
+		switch {
+		case field.IsList() && field.Message() != nil:
+			list := value.List()
+			for i := range list.Len() {
+				redact(list.Get(i).Message(), allowed)
+			}
+		case field.IsMap() && field.MapValue().Message() != nil:
+			value.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+				redact(v.Message(), allowed)
+				return true
+			})
+		case !field.IsList() && !field.IsMap() && field.Message() != nil:
+			redact(value.Message(), allowed)
+		}
+		return true
+	})
+}
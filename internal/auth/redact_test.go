@@ -0,0 +1,40 @@
+package auth_test
+
+import (
+	"context"
+	"testing"
+
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	"github.com/hrz8/altalune/internal/auth"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedact(t *testing.T) {
+	authorizer := auth.NewAuthorizer()
+	caller := func(perms ...string) context.Context {
+		return auth.WithAuthContext(context.Background(), &auth.AuthContext{IsAuthenticated: true, Permissions: perms})
+	}
+	response := func() *altalunev1.QueryEmployeesResponse {
+		return &altalunev1.QueryEmployeesResponse{Data: []*altalunev1.Employee{
+			{Id: "a", Name: "Ann", Email: "ann@example.com"},
+			{Id: "b", Name: "Bob", Email: "bob@example.com"},
+		}}
+	}
+
+	redacted := response()
+	authorizer.Redact(caller("employee:read"), redacted)
+	for _, employee := range redacted.Data {
+		assert.Empty(t, employee.Email, "nested fields are redacted")
+		assert.NotEmpty(t, employee.Name)
+	}
+
+	for _, ctx := range []context.Context{caller("employee:read", "employee:read_pii"), caller(auth.RootPermission)} {
+		visible := response()
+		authorizer.Redact(ctx, visible)
+		assert.Equal(t, "ann@example.com", visible.Data[0].Email)
+	}
+
+	desc := (&altalunev1.Employee{}).ProtoReflect().Descriptor()
+	assert.Equal(t, []string{"email"}, authorizer.RedactedFields(caller("employee:read"), desc))
+	assert.Empty(t, authorizer.RedactedFields(caller("employee:read_pii"), desc))
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/hrz8/altalune/jwkscache"
 )
 
 // AccessTokenClaims mirrors the JWT claims structure from internal/shared/jwt.
@@ -15,14 +16,23 @@ type AccessTokenClaims struct {
 	Scope         string            `json:"scope,omitempty"`
 	Email         string            `json:"email,omitempty"`
 	Name          string            `json:"name,omitempty"`
-	Perms         []string          `json:"perms"`
+	Perms         []string          `json:"perms,omitempty"`
+	Roles         []string          `json:"roles,omitempty"`
+	PermsOmitted  bool              `json:"perms_omitted,omitempty"`
+	PermsOverflow bool              `json:"perms_overflow,omitempty"`
 	Memberships   map[string]string `json:"memberships,omitempty"`
 	EmailVerified bool              `json:"email_verified"`
 }
 
+// HasAllPerms reports whether Perms lists every permission of the user. Tokens
+// of clients slimming the claim leave some out.
+func (c *AccessTokenClaims) HasAllPerms() bool {
+	return !c.PermsOmitted && !c.PermsOverflow && len(c.Roles) == 0
+}
+
 // JWTValidator validates JWT tokens using JWKS.
 type JWTValidator struct {
-	fetcher   *JWKSFetcher
+	keys      *jwkscache.Cache
 	issuer    string
 	audiences []string // Optional audience validation
 }
@@ -38,7 +48,10 @@ func NewJWTValidator(jwksURL, issuer string, audiences []string, cacheTTL int, r
 	}
 
 	return &JWTValidator{
-		fetcher:   NewJWKSFetcher(jwksURL, ttl, refreshLimit),
+		keys: jwkscache.New(jwksURL, jwkscache.Options{
+			RefreshInterval:    ttl,
+			MinRefreshInterval: time.Minute / time.Duration(refreshLimit),
+		}),
 		issuer:    issuer,
 		audiences: audiences,
 	}
@@ -59,7 +72,7 @@ func (v *JWTValidator) Validate(ctx context.Context, tokenString string) (*Acces
 	}
 
 	// Get public key from JWKS
-	publicKey, err := v.fetcher.GetPublicKey(ctx, kid)
+	publicKey, err := v.keys.Key(ctx, kid)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get public key: %w", err)
 	}
@@ -76,8 +89,8 @@ func (v *JWTValidator) Validate(ctx context.Context, tokenString string) (*Acces
 	if err != nil {
 		// Try refreshing JWKS on validation failure (key rotation)
 		if strings.Contains(err.Error(), "signature") {
-			if refreshErr := v.fetcher.ForceRefresh(ctx); refreshErr == nil {
-				publicKey, _ = v.fetcher.GetPublicKey(ctx, kid)
+			if refreshErr := v.keys.Refresh(ctx); refreshErr == nil {
+				publicKey, _ = v.keys.Key(ctx, kid)
 				token, err = jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (any, error) {
 					return publicKey, nil
 				})
@@ -125,5 +138,5 @@ func (v *JWTValidator) Validate(ctx context.Context, tokenString string) (*Acces
 
 // RefreshJWKS forces a refresh of the JWKS cache.
 func (v *JWTValidator) RefreshJWKS(ctx context.Context) error {
-	return v.fetcher.ForceRefresh(ctx)
+	return v.keys.Refresh(ctx)
 }
@@ -0,0 +1,35 @@
+package authserver
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+
+	project_branding_domain "github.com/hrz8/altalune/internal/domain/project_branding"
+)
+
+// logoCacheControl lets browsers and CDNs keep a logo for a day; logo URLs
+// carry a version query that changes on every upload.
+const logoCacheControl = "public, max-age=86400"
+
+// handleProjectLogo serves an uploaded project logo with validators so
+// repeated page loads are answered with 304 Not Modified.
+func (s *Server) handleProjectLogo(w http.ResponseWriter, r *http.Request) {
+	logo, err := s.c.GetProjectBrandingRepo().GetLogo(r.Context(), r.PathValue("project_id"))
+	if err != nil {
+		if !errors.Is(err, project_branding_domain.ErrLogoNotFound) {
+			s.log.Error("failed to get project logo", "error", err)
+		}
+		http.NotFound(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", logo.ContentType)
+	w.Header().Set("Cache-Control", logoCacheControl)
+	w.Header().Set("ETag", fmt.Sprintf(`"%x"`, logo.UpdatedAt.UnixNano()))
+	// SVG logos may carry scripts; never let them run when opened directly
+	w.Header().Set("Content-Security-Policy", "default-src 'none'; style-src 'unsafe-inline'; sandbox")
+
+	http.ServeContent(w, r, "", logo.UpdatedAt, bytes.NewReader(logo.Content))
+}
@@ -0,0 +1,135 @@
+// Package form normalizes and validates the HTML form inputs of the auth
+// server. Rules come from the protovalidate constraints of the API messages
+// holding the same fields, so a value accepted by a form is also accepted by
+// the API.
+package form
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+	"unicode"
+
+	"buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	"buf.build/go/protovalidate"
+	"github.com/hrz8/altalune/internal/shared/i18n"
+	"golang.org/x/text/unicode/norm"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// FieldError describes why a field value was rejected. Key is an i18n
+// message key formatted with Args.
+type FieldError struct {
+	Rule string // protovalidate rule ID, e.g. "string.max_len"
+	Key  string
+	Args []any
+}
+
+// Message returns the error translated into locale.
+func (e FieldError) Message(locale string) string {
+	return i18n.T(locale, e.Key, e.Args...)
+}
+
+// Errors maps form field names to the error of their value. A nil Errors
+// means every field is valid.
+type Errors map[string]FieldError
+
+// Has reports whether field was rejected.
+func (e Errors) Has(field string) bool {
+	_, ok := e[field]
+	return ok
+}
+
+// Message returns the translated error of field, or an empty string when the
+// field is valid.
+func (e Errors) Message(field, locale string) string {
+	fieldErr, ok := e[field]
+	if !ok {
+		return ""
+	}
+	return fieldErr.Message(locale)
+}
+
+// Text returns the named single-line form value trimmed, NFC-normalized and
+// without control characters, so visually equal inputs are stored equally.
+func Text(r *http.Request, name string) string {
+	value := strings.Map(func(c rune) rune {
+		if unicode.IsControl(c) {
+			return -1
+		}
+		return c
+	}, r.FormValue(name))
+	return strings.TrimSpace(norm.NFC.String(value))
+}
+
+// Email returns the named form value normalized like Text and lowercased,
+// matching how emails are stored.
+func Email(r *http.Request, name string) string {
+	return strings.ToLower(Text(r, name))
+}
+
+// Validate checks fields of msg against its protovalidate rules. Fields are
+// named by their proto name, which the forms use as input names. Empty fields
+// are only rejected when their rules mark them required, since forms leave
+// optional inputs blank.
+func Validate(msg proto.Message, fields ...string) (Errors, error) {
+	filter := protovalidate.FilterFunc(func(m protoreflect.Message, d protoreflect.Descriptor) bool {
+		fd, ok := d.(protoreflect.FieldDescriptor)
+		if !ok {
+			return false // Message and oneof rules span fields the form may not have
+		}
+		if !slices.Contains(fields, string(fd.Name())) {
+			return false
+		}
+		return m.Has(fd) || isRequired(fd)
+	})
+
+	err := protovalidate.Validate(msg, protovalidate.WithFilter(filter))
+	if err == nil {
+		return nil, nil
+	}
+	var validationErr *protovalidate.ValidationError
+	if !errors.As(err, &validationErr) {
+		return nil, err
+	}
+
+	errs := make(Errors)
+	for _, violation := range validationErr.Violations {
+		if violation.FieldDescriptor == nil {
+			continue
+		}
+		field := string(violation.FieldDescriptor.Name())
+		if !errs.Has(field) {
+			errs[field] = fieldError(violation)
+		}
+	}
+	return errs, nil
+}
+
+func isRequired(fd protoreflect.FieldDescriptor) bool {
+	rules, _ := proto.GetExtension(fd.Options(), validate.E_Field).(*validate.FieldRules)
+	return rules.GetRequired()
+}
+
+func fieldError(violation *protovalidate.Violation) FieldError {
+	rule := violation.Proto.GetRuleId()
+	fieldErr := FieldError{Rule: rule, Key: "Invalid value"}
+
+	switch rule {
+	case "required":
+		fieldErr.Key = "This field is required"
+	case "string.min_len":
+		if n := violation.RuleValue.Uint(); n > 1 {
+			fieldErr.Key, fieldErr.Args = "Must be at least %d characters", []any{n}
+		} else {
+			fieldErr.Key = "This field is required"
+		}
+	case "string.max_len":
+		fieldErr.Key, fieldErr.Args = "Must be at most %d characters", []any{violation.RuleValue.Uint()}
+	case "string.email", "string.email_empty":
+		fieldErr.Key = "Enter a valid email address"
+	}
+	return fieldErr
+}
@@ -0,0 +1,50 @@
+package form
+
+import (
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalize(t *testing.T) {
+	values := url.Values{
+		"name":  {"  Jose\u0301\t\x00 "},
+		"email": {" Jane.Doe@Example.COM\r\n"},
+	}
+	r := httptest.NewRequest("POST", "/", strings.NewReader(values.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	assert.Equal(t, "Jos\u00e9", Text(r, "name"), "trimmed, composed and without control characters")
+	assert.Equal(t, "jane.doe@example.com", Email(r, "email"))
+	assert.Empty(t, Text(r, "missing"))
+}
+
+func TestValidate(t *testing.T) {
+	errs, err := Validate(&altalunev1.UpdateUserRequest{FirstName: "Jane"}, "first_name", "last_name")
+	require.NoError(t, err)
+	assert.Nil(t, errs, "blank optional fields and unlisted fields are not checked")
+
+	errs, err = Validate(&altalunev1.UpdateUserRequest{FirstName: strings.Repeat("é", 101)}, "first_name", "last_name")
+	require.NoError(t, err)
+	require.True(t, errs.Has("first_name"))
+	assert.False(t, errs.Has("last_name"))
+	assert.Equal(t, "string.max_len", errs["first_name"].Rule)
+	assert.Equal(t, "Must be at most 100 characters", errs.Message("first_name", "en"))
+
+	_, err = Validate(&altalunev1.UpdateUserRequest{FirstName: strings.Repeat("é", 100)}, "first_name")
+	require.NoError(t, err)
+
+	errs, err = Validate(&altalunev1.CreateUserRequest{}, "email")
+	require.NoError(t, err)
+	assert.Equal(t, "This field is required", errs.Message("email", "en"), "required fields are checked when blank")
+
+	errs, err = Validate(&altalunev1.CreateUserRequest{Email: "not-an-email"}, "email")
+	require.NoError(t, err)
+	assert.Equal(t, "Enter a valid email address", errs.Message("email", "en"))
+	assert.Empty(t, errs.Message("first_name", "en"))
+}
@@ -0,0 +1,45 @@
+package authserver
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	maintenance_domain "github.com/hrz8/altalune/internal/domain/maintenance"
+)
+
+// servedInMaintenance reports whether r keeps being served during
+// maintenance: health checks, discovery, JWKS, branding assets and the
+// token checks of resource servers, none of which change anything.
+func servedInMaintenance(r *http.Request) bool {
+	switch {
+	case r.Method == http.MethodOptions:
+		return true
+	case r.URL.Path == "/healthz", r.URL.Path == "/oauth/userinfo", r.URL.Path == "/oauth/introspect":
+		return true
+	case strings.HasPrefix(r.URL.Path, "/.well-known/"), strings.HasPrefix(r.URL.Path, "/branding/"):
+		return true
+	}
+	return false
+}
+
+// maintenanceMiddleware turns away the requests that sign users in or issue
+// tokens while the servers are in maintenance, unless they carry a bypass
+// token. render writes the response, with Retry-After already set.
+func maintenanceMiddleware(next http.Handler, maintenance *maintenance_domain.Switch, render func(w http.ResponseWriter, r *http.Request, message string)) http.Handler {
+	retryAfter := strconv.Itoa(int(maintenance.RetryAfter().Seconds()))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if servedInMaintenance(r) || maintenance.Bypassed(r.Header.Get(maintenance_domain.BypassHeader)) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		active, message := maintenance.Active(r.Context())
+		if !active {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Set("Retry-After", retryAfter)
+		render(w, r, message)
+	})
+}
@@ -5,27 +5,36 @@ import (
 	"net/http"
 
 	oauth_auth_domain "github.com/hrz8/altalune/internal/domain/oauth_auth"
+	"github.com/hrz8/altalune/internal/shared/captcha"
 )
 
-func (s *Server) setupRoutes() *http.ServeMux {
-	mux := http.NewServeMux()
-
-	oauthAuthHandler := oauth_auth_domain.NewHandler(
+func (s *Server) newOAuthAuthHandler() *oauth_auth_domain.Handler {
+	return oauth_auth_domain.NewHandler(
 		s.c.GetOAuthAuthService(),
 		s.cfg,
 		s.c.GetJWTSigner(),
 		s.c.GetSessionStore(),
 		s.c.GetOAuthProviderRepo(),
 		s.c.GetUserRepo(),
-		s.c.GetRoleRepo(),
-		s.c.GetIAMMapperRepo(),
+		s.c.GetProjectRepo(),
 		s.c.GetOTPService(),
 		s.c.GetEmailVerificationService(),
+		s.c.GetEventBus(),
+		captcha.NewVerifier(s.cfg.GetCaptchaVerifyTimeout()),
+		s.c.GetRememberMeService(),
+		s.c.GetBillingLimiter(),
 		s.log,
 	)
+}
+
+func (s *Server) setupRoutes(oauthAuthHandler *oauth_auth_domain.Handler) *http.ServeMux {
+	mux := http.NewServeMux()
 
 	mux.HandleFunc("GET /healthz", s.handleHealthz)
 
+	// Project branding assets referenced by the auth pages
+	mux.HandleFunc("GET /branding/{project_id}/logo", s.handleProjectLogo)
+
 	// ============================================================================
 	// Standalone IDP Routes (login without OAuth client)
 	// ============================================================================
@@ -49,6 +58,7 @@ func (s *Server) setupRoutes() *http.ServeMux {
 	mux.HandleFunc("GET /edit-profile", oauthAuthHandler.HandleEditProfile)
 	mux.HandleFunc("POST /edit-profile", oauthAuthHandler.HandleUpdateProfile)
 	mux.HandleFunc("POST /profile/consents/revoke", oauthAuthHandler.HandleRevokeConsent)
+	mux.HandleFunc("POST /profile/devices/revoke", oauthAuthHandler.HandleRevokeDevice)
 	mux.HandleFunc("POST /logout", oauthAuthHandler.HandleLogout)
 
 	// ============================================================================
@@ -61,24 +71,35 @@ func (s *Server) setupRoutes() *http.ServeMux {
 	mux.HandleFunc("POST /oauth/authorize", oauthAuthHandler.HandleAuthorizeProcess)
 
 	// Token endpoint - machine-to-machine
-	mux.HandleFunc("POST /oauth/token", oauthAuthHandler.HandleToken)
+	mux.Handle("POST /oauth/token", s.withCORS(oauthAuthHandler.HandleToken))
+	mux.Handle("OPTIONS /oauth/token", s.withCORS(preflightOnly))
 
 	// UserInfo endpoint - returns user claims based on access token
-	mux.HandleFunc("GET /oauth/userinfo", oauthAuthHandler.HandleUserInfo)
+	mux.Handle("GET /oauth/userinfo", s.withCORS(oauthAuthHandler.HandleUserInfo))
+	mux.Handle("OPTIONS /oauth/userinfo", s.withCORS(preflightOnly))
 
 	// Token management endpoints
-	mux.HandleFunc("POST /oauth/revoke", oauthAuthHandler.HandleRevoke)
+	mux.Handle("POST /oauth/revoke", s.withCORS(oauthAuthHandler.HandleRevoke))
+	mux.Handle("OPTIONS /oauth/revoke", s.withCORS(preflightOnly))
 	mux.HandleFunc("POST /oauth/introspect", oauthAuthHandler.HandleIntrospect)
 
+	// RP-initiated logout - ends the end-user's session on this server
+	mux.HandleFunc("GET /oauth/end_session", oauthAuthHandler.HandleEndSession)
+
 	// JWKS endpoint - public key for token verification
-	mux.HandleFunc("GET /.well-known/jwks.json", oauthAuthHandler.HandleJWKS)
+	mux.Handle("GET /.well-known/jwks.json", s.withCORS(oauthAuthHandler.HandleJWKS))
 
 	// OpenID Connect Discovery endpoint
-	mux.HandleFunc("GET /.well-known/openid-configuration", oauthAuthHandler.HandleOpenIDConfiguration)
+	mux.Handle("GET /.well-known/openid-configuration", s.withCORS(oauthAuthHandler.HandleOpenIDConfiguration))
 
 	return mux
 }
 
+// preflightOnly backs OPTIONS routes whose response is produced entirely by the CORS middleware.
+func preflightOnly(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNoContent)
+}
+
 func (s *Server) handleHealthz(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
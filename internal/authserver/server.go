@@ -5,7 +5,12 @@ import (
 
 	"github.com/hrz8/altalune"
 	"github.com/hrz8/altalune/internal/container"
+	oauth_auth_domain "github.com/hrz8/altalune/internal/domain/oauth_auth"
 	"github.com/hrz8/altalune/internal/server"
+	"github.com/hrz8/altalune/internal/shared/captcha"
+	"github.com/hrz8/altalune/internal/shared/csp"
+	"github.com/hrz8/altalune/internal/shared/realip"
+	"github.com/hrz8/altalune/logger"
 )
 
 type Server struct {
@@ -18,24 +23,59 @@ func NewServer(c *container.Container) *Server {
 	return &Server{
 		c:   c,
 		cfg: c.GetConfig(),
-		log: c.GetLogger(),
+		log: c.GetLogger().Module("oauth"),
 	}
 }
 
 func (s *Server) Bootstrap() http.Handler {
-	mux := s.setupRoutes()
-	handler := s.setupMiddleware(mux)
+	oauthAuthHandler := s.newOAuthAuthHandler()
+	mux := s.setupRoutes(oauthAuthHandler)
+	handler := s.setupMiddleware(mux, oauthAuthHandler)
 	return handler
 }
 
-func (s *Server) setupMiddleware(handler http.Handler) http.Handler {
+func (s *Server) setupMiddleware(handler http.Handler, oauthAuthHandler *oauth_auth_domain.Handler) http.Handler {
+	handler = s.c.GetSessionStore().KeepAlive(handler)
+	handler = oauthAuthHandler.RestoreRememberedSession(handler)
+	handler = maintenanceMiddleware(handler, s.c.GetMaintenanceSwitch(), oauthAuthHandler.RenderMaintenance)
+	handler = tenantMiddleware(handler, newTenantResolver(s.c.GetProjectHostnameRepo(), s.log))
 	handler = server.RecoveryMiddleware(handler, s.log)
 	if s.cfg.IsHTTPLoggingEnabled() {
-		handler = server.LoggingMiddleware(handler, s.log)
-	}
-	handler = server.SecurityMiddleware(handler)
-	if s.cfg.IsCORSEnabled() {
-		handler = server.CORSMiddleware(handler, s.cfg.GetAllowedOrigins())
+		handler = server.LoggingMiddleware(handler, s.log.Module("http"), logger.NewSampler(s.cfg.GetLogSampling()))
 	}
+	handler = server.RequestIDMiddleware(handler)
+	handler = server.SecurityMiddleware(handler, server.NewSecurityHeadersOptions(s.cfg, s.authServerCSP()))
+	handler = realip.Middleware(handler, s.c.GetClientResolver())
 	return handler
 }
+
+// authServerCSP returns the configured policy of the auth pages, allowing the
+// widgets of the captcha providers in use.
+func (s *Server) authServerCSP() string {
+	policy := s.cfg.GetAuthServerCSP()
+	for _, name := range s.cfg.GetCaptchaProviders() {
+		if provider, ok := captcha.Lookup(name); ok {
+			policy = csp.AddSources(policy, []string{"script-src", "frame-src", "style-src", "connect-src"}, provider.Sources...)
+		}
+	}
+	return policy
+}
+
+// withCORS applies the configured CORS policy when CORS is enabled. It is only
+// used for endpoints that browsers may call cross-origin (token, userinfo,
+// revocation, JWKS, discovery); interactive endpoints such as /oauth/authorize
+// are top-level navigations and introspection is reserved for resource servers.
+// The origins of a project are allowed on its hostnames.
+func (s *Server) withCORS(handler http.HandlerFunc) http.Handler {
+	if !s.cfg.IsCORSEnabled() {
+		return handler
+	}
+	opts := server.NewCORSOptions(s.cfg)
+	opts.Project = func(r *http.Request) string {
+		if tenant := oauth_auth_domain.TenantFromContext(r.Context()); tenant != nil {
+			return tenant.ProjectID
+		}
+		return ""
+	}
+	return server.CORSMiddleware(handler, opts)
+}
@@ -0,0 +1,86 @@
+package authserver
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hrz8/altalune"
+	oauth_auth_domain "github.com/hrz8/altalune/internal/domain/oauth_auth"
+	project_hostname_domain "github.com/hrz8/altalune/internal/domain/project_hostname"
+)
+
+// tenantCacheTTL bounds how long a hostname lookup (including a miss) is
+// reused, so branding changes show up without hitting the database per page.
+const tenantCacheTTL = time.Minute
+
+// tenantCacheMaxEntries caps the cache since the Host header is client-controlled.
+const tenantCacheMaxEntries = 1024
+
+type tenantCacheEntry struct {
+	tenant    *project_hostname_domain.Tenant
+	expiresAt time.Time
+}
+
+// tenantResolver maps request hostnames to project tenants.
+type tenantResolver struct {
+	repo  project_hostname_domain.Repositor
+	log   altalune.Logger
+	mu    sync.RWMutex
+	cache map[string]tenantCacheEntry
+}
+
+func newTenantResolver(repo project_hostname_domain.Repositor, log altalune.Logger) *tenantResolver {
+	return &tenantResolver{
+		repo:  repo,
+		log:   log,
+		cache: make(map[string]tenantCacheEntry),
+	}
+}
+
+func (tr *tenantResolver) resolve(ctx context.Context, host string) *project_hostname_domain.Tenant {
+	hostname := project_hostname_domain.NormalizeHostname(host)
+	if hostname == "" {
+		return nil
+	}
+
+	now := time.Now()
+	tr.mu.RLock()
+	entry, ok := tr.cache[hostname]
+	tr.mu.RUnlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.tenant
+	}
+
+	tenant, err := tr.repo.GetTenantByHostname(ctx, hostname)
+	if err != nil {
+		if !errors.Is(err, project_hostname_domain.ErrProjectHostnameNotFound) {
+			// Serve the default branding rather than failing the page
+			tr.log.Error("failed to resolve tenant", "hostname", hostname, "error", err)
+			return nil
+		}
+		tenant = nil
+	}
+
+	tr.mu.Lock()
+	if len(tr.cache) >= tenantCacheMaxEntries {
+		tr.cache = make(map[string]tenantCacheEntry)
+	}
+	tr.cache[hostname] = tenantCacheEntry{tenant: tenant, expiresAt: now.Add(tenantCacheTTL)}
+	tr.mu.Unlock()
+
+	return tenant
+}
+
+// tenantMiddleware attaches the tenant registered for the request Host, if any,
+// so pages can be rendered with the project's branding.
+func tenantMiddleware(next http.Handler, resolver *tenantResolver) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if tenant := resolver.resolve(r.Context(), r.Host); tenant != nil {
+			r = r.WithContext(oauth_auth_domain.WithTenant(r.Context(), tenant))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
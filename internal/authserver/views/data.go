@@ -1,14 +1,31 @@
 package views
 
+import (
+	"time"
+
+	"github.com/hrz8/altalune/internal/authserver/form"
+)
+
 // BrandingData contains branding information for templates.
 type BrandingData struct {
-	Name string // Auth server branding name
+	Name         string // Auth server branding name
+	LogoURL      string // Optional logo shown above page headings
+	PrimaryColor string // Optional hex color overriding the primary theme color
+	FooterLinks  []FooterLink
+}
+
+// FooterLink is a custom link rendered in the page footer.
+type FooterLink struct {
+	Label string
+	URL   string
 }
 
 type BaseData struct {
 	Title    string
 	Message  string
+	Locale   string // Negotiated locale used by the "t" template function
 	Branding BrandingData
+	CSPNonce string // Nonce allowing the page's inline scripts and styles under the CSP
 }
 
 type LoginPageData struct {
@@ -16,6 +33,7 @@ type LoginPageData struct {
 	Providers    []Provider
 	ErrorMessage string
 	ClientName   string
+	RememberMe   bool // Offer "keep me signed in"
 }
 
 type Provider struct {
@@ -36,6 +54,7 @@ type ConsentPageData struct {
 	Nonce               *string
 	CodeChallenge       *string
 	CodeChallengeMethod *string
+	UILocales           string
 }
 
 type ScopeInfo struct {
@@ -48,6 +67,15 @@ type ErrorPageData struct {
 	Error            string
 	ErrorDescription string
 	ShowBackToLogin  bool
+	RetryURL         string       // Shows a try again button leading there when set
+	Diagnostics      []Diagnostic // Details of the failed request, for sandbox projects only
+}
+
+// Diagnostic is a detail of why a request failed, such as the parameter at
+// fault or the value expected of it
+type Diagnostic struct {
+	Label string
+	Value string
 }
 
 type ProfileData struct {
@@ -59,12 +87,41 @@ type ProfileData struct {
 	UserEmail                  string // For resend verification link
 	VerificationEmailSent      bool   // Show success message after resending
 	VerificationEmailError     bool   // Show error message if resend failed
+	RememberMe                 bool   // Show the devices kept signed in
+	Devices                    []RememberedDevice
+	SessionExpiresAt           time.Time // When the session ends and the user signs in again
+	SessionExpiringSoon        bool      // Warn that the session ends soon
+	SessionIdleMinutes         int       // Minutes of inactivity ending the session, 0 if disabled
+}
+
+// RememberedDevice is a device the user is kept signed in on.
+type RememberedDevice struct {
+	Series     string
+	UserAgent  string
+	IPAddress  string
+	LastUsedAt time.Time
+	ExpiresAt  time.Time
+	CreatedAt  time.Time
+	Current    bool // The device the page is viewed on
 }
 
 // EmailLoginPageData is the data structure for the email login page.
 type EmailLoginPageData struct {
 	BaseData
-	Error string
+	Error       string
+	Email       string      // Submitted email, shown again when it was rejected
+	FieldErrors form.Errors // Per-field validation errors of the submitted form
+	Captcha     *CaptchaData
+	RememberMe  bool // Offer "keep me signed in"
+	Remember    bool // Whether "keep me signed in" is checked
+}
+
+// CaptchaData is the bot challenge widget of a form, nil when the challenge
+// is disabled.
+type CaptchaData struct {
+	ScriptURL   string // Widget script of the provider
+	WidgetClass string // Class the script renders the widget in
+	SiteKey     string
 }
 
 // OTPPageData is the data structure for the OTP verification page.
@@ -93,5 +150,6 @@ type EditProfileData struct {
 	BaseData
 	User         any
 	ErrorMessage string
+	FieldErrors  form.Errors // Per-field validation errors of the submitted form
 	Success      bool
 }
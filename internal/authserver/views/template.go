@@ -7,6 +7,8 @@ import (
 	"strings"
 	"sync"
 	"time"
+
+	"github.com/hrz8/altalune/internal/shared/i18n"
 )
 
 //go:embed *.html
@@ -43,6 +45,7 @@ func Load() error {
 			"formatTime": func(t time.Time) string {
 				return t.Format("Jan 2, 2006 at 3:04 PM")
 			},
+			"t": i18n.T,
 		}
 		templates, loadErr = template.New("").Funcs(funcMap).ParseFS(templateFS, "*.html")
 	})
@@ -2,20 +2,31 @@ package config
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/go-playground/validator/v10"
 )
 
+// ServerConfig configures the API and gRPC servers of the serve command.
 type ServerConfig struct {
-	Host           string `yaml:"host" validate:"required,hostname|ip"`
-	Port           int    `yaml:"port" validate:"required,gte=1,lte=65535"`
-	LogLevel       string `yaml:"logLevel" validate:"oneof=debug info warn error"`
-	HTTPLogging    bool   `yaml:"httpLogging"`
-	EnableCORS     bool   `yaml:"enableCORS"`
-	ReadTimeout    int    `yaml:"readTimeout" validate:"gte=1"`
-	WriteTimeout   int    `yaml:"writeTimeout" validate:"gte=1"`
-	IdleTimeout    int    `yaml:"idleTimeout" validate:"gte=1"`
-	CleanupTimeout int    `yaml:"cleanupTimeout" validate:"gte=1,lte=300"`
+	Host              string `yaml:"host" validate:"required,hostname|ip"`                           // Server host (default: localhost)
+	Port              int    `yaml:"port" validate:"required,gte=1,lte=65535"`                       // Server port (default: 3100)
+	LogLevel          string `yaml:"logLevel" validate:"oneof=debug info warn error"`                // debug, info, warn or error (default: info)
+	HTTPLogging       bool   `yaml:"httpLogging"`                                                    // Log HTTP requests and responses (default: false)
+	EnableCORS        bool   `yaml:"enableCORS"`                                                     // Send CORS headers (default: true)
+	ReadTimeout       int    `yaml:"readTimeout" validate:"gte=1"`                                   // HTTP read timeout in seconds (default: 15)
+	ReadHeaderTimeout int    `yaml:"readHeaderTimeout" validate:"gte=1"`                             // HTTP request header read timeout in seconds (default: 5)
+	WriteTimeout      int    `yaml:"writeTimeout" validate:"gte=1"`                                  // HTTP write timeout in seconds (default: 15)
+	IdleTimeout       int    `yaml:"idleTimeout" validate:"gte=1"`                                   // HTTP idle timeout in seconds (default: 60)
+	CleanupTimeout    int    `yaml:"cleanupTimeout" validate:"gte=1,lte=300"`                        // HTTP cleanup timeout on shutdown in seconds (default: 10)
+	HandlerTimeout    int    `yaml:"handlerTimeout" validate:"gte=1"`                                // Max duration of a single unary RPC in seconds
+	MaxRequestBytes   int64  `yaml:"maxRequestBytes" validate:"gte=1024,lte=104857600"`              // Max request body size in bytes
+	RateLimit         int    `yaml:"rateLimit" validate:"gte=0"`                                     // Max API calls per minute per client IP, 0 disables (default: 0)
+	Compression       *bool  `yaml:"compression"`                                                    // gzip/deflate responses (default: true)
+	BindHost          string `yaml:"bindHost" validate:"omitempty,hostname|ip"`                      // Interface to listen on, empty listens on all interfaces
+	H2C               *bool  `yaml:"h2c"`                                                            // Accept HTTP/2 without TLS, e.g. gRPC clients behind a TLS-terminating proxy (default: true)
+	TLSCertFile       string `yaml:"tlsCertFile" validate:"required_with=TLSKeyFile,omitempty,file"` // PEM certificate chain, enables HTTPS together with tlsKeyFile
+	TLSKeyFile        string `yaml:"tlsKeyFile" validate:"required_with=TLSCertFile,omitempty,file"` // PEM private key
 }
 
 func (c *ServerConfig) setDefaults() {
@@ -33,6 +44,9 @@ func (c *ServerConfig) setDefaults() {
 	if c.ReadTimeout == 0 {
 		c.ReadTimeout = 15
 	}
+	if c.ReadHeaderTimeout == 0 {
+		c.ReadHeaderTimeout = 5
+	}
 	if c.WriteTimeout == 0 {
 		c.WriteTimeout = 15
 	}
@@ -42,13 +56,50 @@ func (c *ServerConfig) setDefaults() {
 	if c.CleanupTimeout == 0 {
 		c.CleanupTimeout = 10
 	}
+	if c.HandlerTimeout == 0 {
+		c.HandlerTimeout = 10
+	}
+	if c.MaxRequestBytes == 0 {
+		c.MaxRequestBytes = 4 << 20 // 4 MiB
+	}
+	if c.Compression == nil {
+		defaultCompression := true
+		c.Compression = &defaultCompression
+	}
+	if c.H2C == nil {
+		defaultH2C := true
+		c.H2C = &defaultH2C
+	}
 }
 
+// DatabaseConfig connects to PostgreSQL.
 type DatabaseConfig struct {
-	URL            string `yaml:"url" validate:"omitempty,url"`
-	MaxConnections int    `yaml:"maxConnections" validate:"gte=1,lte=100"`
-	MaxIdleTime    int    `yaml:"maxIdleTime" validate:"gte=1"`
-	ConnectTimeout int    `yaml:"connectTimeout" validate:"gte=1"`
+	URL            string `yaml:"url" validate:"omitempty,url"`            // PostgreSQL connection string
+	MaxConnections int    `yaml:"maxConnections" validate:"gte=1,lte=100"` // Maximum open connections (default: 25)
+	MaxIdleTime    int    `yaml:"maxIdleTime" validate:"gte=1"`            // Seconds a connection may stay idle (default: 300)
+	ConnectTimeout int    `yaml:"connectTimeout" validate:"gte=1"`         // Connection timeout in seconds (default: 10)
+	// Scope project requests to their project with the row-level security
+	// policies, on top of the project_id conditions of the queries; the
+	// server's statements scoped to no project see no row of the project
+	// tables, while other clients such as psql or pg_dump still see them all
+	RowLevelSecurity bool `yaml:"rowLevelSecurity"`
+	// Statements running longer are cancelled by the server, in seconds, 0
+	// disables it (default: 30)
+	StatementTimeout *int `yaml:"statementTimeout" validate:"omitempty,gte=0"`
+	// Statements running longer are logged as slow queries, in milliseconds,
+	// 0 disables it (default: 500)
+	SlowQueryThreshold *int `yaml:"slowQueryThreshold" validate:"omitempty,gte=0"`
+	// How queries count their rows unless the request picks a mode: exact,
+	// estimated or none (default: exact)
+	CountMode string `yaml:"countMode" validate:"oneof=exact estimated none"`
+	// Estimated counts under this many rows are made exact (default: 1000)
+	ExactCountLimit int `yaml:"exactCountLimit" validate:"gte=0"`
+	// What serve does about pending migrations before serving: off, wait for
+	// another process to apply them, or apply them itself under an advisory
+	// lock (default: off)
+	MigrateOnStart string `yaml:"migrateOnStart" validate:"oneof=off wait apply"`
+	// Seconds serve waits for the expected schema before giving up (default: 300)
+	MigrateWaitTimeout int `yaml:"migrateWaitTimeout" validate:"gte=1"`
 }
 
 func (c *DatabaseConfig) setDefaults() {
@@ -61,17 +112,162 @@ func (c *DatabaseConfig) setDefaults() {
 	if c.ConnectTimeout == 0 {
 		c.ConnectTimeout = 10
 	}
+	if c.StatementTimeout == nil {
+		defaultStatementTimeout := 30
+		c.StatementTimeout = &defaultStatementTimeout
+	}
+	if c.SlowQueryThreshold == nil {
+		defaultSlowQueryThreshold := 500
+		c.SlowQueryThreshold = &defaultSlowQueryThreshold
+	}
+	if c.CountMode == "" {
+		c.CountMode = "exact"
+	}
+	if c.ExactCountLimit == 0 {
+		c.ExactCountLimit = 1000
+	}
+	if c.MigrateOnStart == "" {
+		c.MigrateOnStart = "off"
+	}
+	if c.MigrateWaitTimeout == 0 {
+		c.MigrateWaitTimeout = 300
+	}
 }
 
+// SecurityConfig holds the CORS, response header, cookie, encryption and
+// token signing settings.
 type SecurityConfig struct {
-	AllowedOrigins    []string `yaml:"allowedOrigins" validate:"required,min=1,dive,required"`
-	IAMEncryptionKey  string   `yaml:"iamEncryptionKey" validate:"required,len=44"` // base64-encoded 32-byte key = 44 chars
-	JWTPrivateKeyPath string   `yaml:"jwtPrivateKeyPath" validate:"required"`
-	JWTPublicKeyPath  string   `yaml:"jwtPublicKeyPath" validate:"required"`
-	JWKSKid           string   `yaml:"jwksKid" validate:"required"`
+	AllowedOrigins    []string               `yaml:"allowedOrigins" validate:"required,min=1,dive,required"` // CORS allowed origins (default: ["*"])
+	IAMEncryptionKey  string                 `yaml:"iamEncryptionKey" validate:"required,len=44"`            // base64-encoded 32-byte key = 44 chars
+	IAMPreviousKeys   []string               `yaml:"iamPreviousKeys" validate:"dive,len=44"`                 // Keys rotated out, still accepted for decryption
+	JWTPrivateKeyPath string                 `yaml:"jwtPrivateKeyPath" validate:"required"`                  // RSA private key signing the JWTs
+	JWTPublicKeyPath  string                 `yaml:"jwtPublicKeyPath" validate:"required"`                   // RSA public key served by the JWKS endpoint
+	JWKSKid           string                 `yaml:"jwksKid" validate:"required"`                            // Key ID of the JWKS key
+	CORS              *CORSConfig            `yaml:"cors"`
+	Headers           *SecurityHeadersConfig `yaml:"headers"`
+	PasswordHashing   *PasswordHashingConfig `yaml:"passwordHashing"`
+	Cookies           *CookieConfig          `yaml:"cookies"`
+	TrustedProxies    []string               `yaml:"trustedProxies" validate:"dive,cidr|ip"` // Proxies whose X-Forwarded-For and X-Forwarded-Proto are believed
+}
+
+// CookieConfig sets the attributes of the auth server session cookie and the
+// dashboard token cookies. Secure cookies at path "/" without a domain get
+// the __Host- name prefix.
+type CookieConfig struct {
+	Secure   bool   `yaml:"secure"`                                    // Only send cookies over HTTPS (default: false)
+	SameSite string `yaml:"sameSite" validate:"oneof=lax strict none"` // lax, strict or none, which requires secure (default: lax)
+	Domain   string `yaml:"domain" validate:"omitempty,hostname"`      // Share cookies with the subdomains of this domain (default: empty = this host only)
+	Path     string `yaml:"path" validate:"startswith=/"`              // Path cookies are sent for (default: /)
+}
+
+func (c *CookieConfig) setDefaults() {
+	if c.SameSite == "" {
+		c.SameSite = "lax"
+	}
+	if c.Path == "" {
+		c.Path = "/"
+	}
+}
+
+// PasswordHashingConfig is the Argon2id cost of new client secret and password
+// hashes. Hashes made with other parameters are re-hashed when next verified.
+type PasswordHashingConfig struct {
+	Iterations uint32 `yaml:"iterations" validate:"gte=1"` // Time cost (default: 2)
+	Memory     uint32 `yaml:"memory" validate:"gte=8192"`  // Memory cost in KiB (default: 65536)
+	Threads    uint8  `yaml:"threads" validate:"gte=1"`    // Parallelism (default: 4)
+	Length     uint32 `yaml:"length" validate:"gte=16"`    // Hash length in bytes (default: 32)
+
+	// Seconds a verified client secret is accepted again without Argon2id, 0
+	// verifies every time (default: 0). See config.example.yaml before enabling.
+	ClientSecretCacheTTL int `yaml:"clientSecretCacheTTL" validate:"gte=0,lte=3600"`
+}
+
+func (c *PasswordHashingConfig) setDefaults() {
+	if c.Iterations == 0 {
+		c.Iterations = 2
+	}
+	if c.Memory == 0 {
+		c.Memory = 64 * 1024
+	}
+	if c.Threads == 0 {
+		c.Threads = 4
+	}
+	if c.Length == 0 {
+		c.Length = 32
+	}
+}
+
+// CORSConfig contains cross-origin settings for the API and the OAuth endpoints.
+type CORSConfig struct {
+	AllowCredentials *bool               `yaml:"allowCredentials"`                        // Allow cookies/Authorization on cross-origin calls, refused with "*" origins (default: false)
+	MaxAge           int                 `yaml:"maxAge" validate:"gte=0,lte=86400"`       // Preflight cache duration in seconds (default: 600)
+	ProjectOrigins   map[string][]string `yaml:"projectOrigins" validate:"dive,dive,url"` // Extra origins allowed to call only their project (keyed by project public ID)
+}
+
+// DefaultAuthServerCSP allows the auth server pages to load Bootstrap from
+// jsDelivr and run only the inline scripts and styles carrying the request
+// nonce. Inline style attributes stay allowed for icons and logos.
+const DefaultAuthServerCSP = "default-src 'self'; " +
+	"script-src 'self' 'nonce-{nonce}' https://cdn.jsdelivr.net; " +
+	"style-src 'self' 'nonce-{nonce}' https://cdn.jsdelivr.net; " +
+	"style-src-attr 'unsafe-inline'; " +
+	"font-src 'self' https://cdn.jsdelivr.net; " +
+	"img-src 'self' data: https:; " +
+	"connect-src 'self'; " +
+	"object-src 'none'; " +
+	"base-uri 'none'; " +
+	"frame-ancestors 'none'"
+
+// SecurityHeadersConfig controls the protective response headers of the API,
+// dashboard and auth server.
+type SecurityHeadersConfig struct {
+	HSTSMaxAge            int     `yaml:"hstsMaxAge" validate:"gte=0,lte=63072000"`      // Strict-Transport-Security max-age in seconds, 0 omits the header (default: 0)
+	HSTSIncludeSubdomains bool    `yaml:"hstsIncludeSubdomains"`                         // Apply HSTS to every subdomain
+	HSTSPreload           bool    `yaml:"hstsPreload"`                                   // Allow inclusion in browser HSTS preload lists
+	FrameOptions          string  `yaml:"frameOptions" validate:"oneof=DENY SAMEORIGIN"` // X-Frame-Options (default: DENY)
+	ReferrerPolicy        string  `yaml:"referrerPolicy"`                                // Referrer-Policy (default: strict-origin-when-cross-origin)
+	AuthServerCSP         *string `yaml:"authServerCSP"`                                 // Content-Security-Policy of the auth server, "{nonce}" is replaced per request; empty omits it (default: DefaultAuthServerCSP)
+	DashboardCSP          string  `yaml:"dashboardCSP"`                                  // Content-Security-Policy of the API server and dashboard, empty omits it (default: empty)
+	CSPReportOnly         bool    `yaml:"cspReportOnly"`                                 // Send policies as Content-Security-Policy-Report-Only to trial them
+}
+
+func (c *SecurityHeadersConfig) setDefaults() {
+	if c.FrameOptions == "" {
+		c.FrameOptions = "DENY"
+	}
+	if c.ReferrerPolicy == "" {
+		c.ReferrerPolicy = "strict-origin-when-cross-origin"
+	}
+	if c.AuthServerCSP == nil {
+		defaultAuthServerCSP := DefaultAuthServerCSP
+		c.AuthServerCSP = &defaultAuthServerCSP
+	}
 }
 
 func (c *SecurityConfig) setDefaults() {
+	if c.Headers == nil {
+		c.Headers = &SecurityHeadersConfig{}
+	}
+	c.Headers.setDefaults()
+	if c.PasswordHashing == nil {
+		c.PasswordHashing = &PasswordHashingConfig{}
+	}
+	c.PasswordHashing.setDefaults()
+	if c.Cookies == nil {
+		c.Cookies = &CookieConfig{}
+	}
+	c.Cookies.setDefaults()
+	if c.CORS == nil {
+		c.CORS = &CORSConfig{}
+	}
+	if c.CORS.AllowCredentials == nil {
+		defaultAllowCredentials := false
+		c.CORS.AllowCredentials = &defaultAllowCredentials
+	}
+	if c.CORS.MaxAge == 0 {
+		c.CORS.MaxAge = 600 // 10 minutes
+	}
+
 	if len(c.AllowedOrigins) == 0 {
 		c.AllowedOrigins = []string{"*"}
 		return
@@ -84,14 +280,60 @@ func (c *SecurityConfig) setDefaults() {
 	}
 }
 
+// AuthConfig configures the OAuth authorization server of serve-auth.
 type AuthConfig struct {
-	Host               string `yaml:"host" validate:"required,hostname|ip"`
-	Port               int    `yaml:"port" validate:"required,gte=1,lte=65535"`
-	SessionSecret      string `yaml:"sessionSecret" validate:"required,min=32"`
-	CodeExpiry         int    `yaml:"codeExpiry" validate:"gte=1"`
-	AccessTokenExpiry  int    `yaml:"accessTokenExpiry" validate:"gte=1"`
-	RefreshTokenExpiry int    `yaml:"refreshTokenExpiry" validate:"gte=1"`
-	AutoActivate       *bool  `yaml:"autoActivate"` // Whether new users are automatically activated (default: true)
+	Host               string               `yaml:"host" validate:"required,hostname|ip"`                  // Auth server host (default: localhost)
+	Port               int                  `yaml:"port" validate:"required,gte=1,lte=65535"`              // Auth server port (default: 3101)
+	BindHost           string               `yaml:"bindHost" validate:"omitempty,hostname|ip"`             // Interface to listen on, empty listens on all interfaces
+	Embedded           bool                 `yaml:"embedded"`                                              // Run the auth server inside `serve`, on its own listener
+	SessionSecret      string               `yaml:"sessionSecret" validate:"required,min=32"`              // Session encryption secret
+	SessionIdleTimeout *int                 `yaml:"sessionIdleTimeout" validate:"omitempty,gte=0"`         // Seconds without a request after which a session ends, 0 disables it (default: 1800)
+	SessionLifetime    int                  `yaml:"sessionLifetime" validate:"gte=0"`                      // Seconds after sign-in after which a session ends whatever the activity (default: 36000)
+	CodeExpiry         int                  `yaml:"codeExpiry" validate:"gte=1"`                           // Authorization code expiry in seconds (default: 600)
+	AccessTokenExpiry  int                  `yaml:"accessTokenExpiry" validate:"gte=1"`                    // Access token expiry in seconds (default: 3600)
+	RefreshTokenExpiry int                  `yaml:"refreshTokenExpiry" validate:"gte=1"`                   // Refresh token expiry in seconds (default: 2592000)
+	AutoActivate       *bool                `yaml:"autoActivate"`                                          // Whether new users are automatically activated (default: true)
+	DefaultLocale      string               `yaml:"defaultLocale" validate:"omitempty,bcp47_language_tag"` // Auth page locale when the browser language is unsupported (default: en)
+	LockoutMaxAttempts int                  `yaml:"lockoutMaxAttempts" validate:"gte=0"`                   // Failed sign-ins before the account is locked (default: 5)
+	LockoutDuration    int                  `yaml:"lockoutDuration" validate:"gte=0"`                      // How long a locked account stays locked, in seconds (default: 900)
+	HostIssuer         bool                 `yaml:"hostIssuer"`                                            // Use the project hostname a request arrives on as the issuer (default: false)
+	Discovery          *AuthDiscoveryConfig `yaml:"discovery"`
+	Captcha            *CaptchaConfig       `yaml:"captcha"`
+	RememberMe         *RememberMeConfig    `yaml:"rememberMe"`
+}
+
+// RememberMeConfig configures the "keep me signed in" option of the sign-in
+// pages, whose cookie restores the session of a device after it expired.
+type RememberMeConfig struct {
+	Enabled  bool `yaml:"enabled"`                   // Offer the option on the sign-in pages (default: false)
+	Lifetime int  `yaml:"lifetime" validate:"gte=0"` // How long a device stays signed in, in seconds (default: 2592000)
+}
+
+// AuthDiscoveryConfig holds the optional human-readable pages advertised by
+// the OpenID Connect discovery document.
+type AuthDiscoveryConfig struct {
+	ServiceDocumentation string `yaml:"serviceDocumentation" validate:"omitempty,url"` // Developer documentation of the server
+	PolicyURI            string `yaml:"policyURI" validate:"omitempty,url"`            // How relying parties may use the data the server provides
+	TOSURI               string `yaml:"tosURI" validate:"omitempty,url"`               // Terms of service of the server
+}
+
+// CaptchaConfig is the bot challenge of the email sign-in and registration
+// forms, which send an email to the address entered. No provider disables it.
+type CaptchaConfig struct {
+	Provider      string                 `yaml:"provider" validate:"omitempty,oneof=turnstile hcaptcha recaptcha"` // turnstile, hcaptcha or recaptcha; empty disables the challenge
+	SiteKey       string                 `yaml:"siteKey" validate:"required_with=Provider"`                        // Public key the widget is rendered with
+	SecretKey     string                 `yaml:"secretKey" validate:"required_with=Provider"`                      // Secret key verifying the widget responses
+	VerifyTimeout int                    `yaml:"verifyTimeout" validate:"gte=0"`                                   // Seconds to wait for the provider verification (default: 5)
+	Projects      []CaptchaProjectConfig `yaml:"projects" validate:"dive"`                                         // Challenges of the pages served on project hostnames
+}
+
+// CaptchaProjectConfig overrides the bot challenge of the pages served on the
+// hostnames of one project.
+type CaptchaProjectConfig struct {
+	ProjectID string `yaml:"projectId" validate:"required"`                                         // Public ID of the project
+	Provider  string `yaml:"provider" validate:"omitempty,oneof=turnstile hcaptcha recaptcha none"` // Empty inherits the default challenge, none disables it
+	SiteKey   string `yaml:"siteKey"`                                                               // Public key the widget is rendered with, required with a provider
+	SecretKey string `yaml:"secretKey"`                                                             // Secret key verifying the widget responses, required with a provider
 }
 
 func (c *AuthConfig) setDefaults() {
@@ -101,6 +343,13 @@ func (c *AuthConfig) setDefaults() {
 	if c.Port == 0 {
 		c.Port = 3101
 	}
+	if c.SessionIdleTimeout == nil {
+		defaultSessionIdleTimeout := 1800 // 30 minutes
+		c.SessionIdleTimeout = &defaultSessionIdleTimeout
+	}
+	if c.SessionLifetime == 0 {
+		c.SessionLifetime = 36000 // 10 hours
+	}
 	if c.CodeExpiry == 0 {
 		c.CodeExpiry = 600 // 10 minutes
 	}
@@ -110,6 +359,30 @@ func (c *AuthConfig) setDefaults() {
 	if c.RefreshTokenExpiry == 0 {
 		c.RefreshTokenExpiry = 2592000 // 30 days
 	}
+	if c.DefaultLocale == "" {
+		c.DefaultLocale = "en"
+	}
+	if c.LockoutMaxAttempts == 0 {
+		c.LockoutMaxAttempts = 5
+	}
+	if c.LockoutDuration == 0 {
+		c.LockoutDuration = 900 // 15 minutes
+	}
+	if c.Discovery == nil {
+		c.Discovery = &AuthDiscoveryConfig{}
+	}
+	if c.Captcha == nil {
+		c.Captcha = &CaptchaConfig{}
+	}
+	if c.Captcha.VerifyTimeout == 0 {
+		c.Captcha.VerifyTimeout = 5
+	}
+	if c.RememberMe == nil {
+		c.RememberMe = &RememberMeConfig{}
+	}
+	if c.RememberMe.Lifetime == 0 {
+		c.RememberMe.Lifetime = 2592000 // 30 days
+	}
 	// AutoActivate defaults to true if not specified
 	if c.AutoActivate == nil {
 		defaultAutoActivate := true
@@ -125,33 +398,36 @@ func (c *AuthConfig) IsAutoActivate() bool {
 	return *c.AutoActivate
 }
 
+// SuperadminConfig is the user seeded with full access to every project.
 type SuperadminConfig struct {
-	Email string `yaml:"email" validate:"required,email"`
+	Email string `yaml:"email" validate:"required,email"` // Superadmin email address
 }
 
+// OAuthProviderConfig is a sign-in provider seeded into the database.
 type OAuthProviderConfig struct {
-	Provider     string `yaml:"provider" validate:"required,oneof=google github"`
-	ClientID     string `yaml:"clientId" validate:"required"`
-	ClientSecret string `yaml:"clientSecret" validate:"required"`
-	RedirectURL  string `yaml:"redirectUrl" validate:"required,url"`
-	Scopes       string `yaml:"scopes" validate:"required"`
-	Enabled      bool   `yaml:"enabled"`
+	Provider     string `yaml:"provider" validate:"required,oneof=google github"` // google or github
+	ClientID     string `yaml:"clientId" validate:"required"`                     // Client ID at the provider
+	ClientSecret string `yaml:"clientSecret" validate:"required"`                 // Client secret at the provider, encrypted before storage
+	RedirectURL  string `yaml:"redirectUrl" validate:"required,url"`              // Callback URL registered at the provider
+	Scopes       string `yaml:"scopes" validate:"required"`                       // Comma-separated scopes requested from the provider
+	Enabled      bool   `yaml:"enabled"`                                          // Offer the provider on the login page
 }
 
+// SeederConfig lists the data seeded after the migrations.
 type SeederConfig struct {
-	Superadmin     SuperadminConfig      `yaml:"superadmin" validate:"required"`
-	OAuthProviders []OAuthProviderConfig `yaml:"oauthProviders" validate:"dive"`
+	Superadmin     SuperadminConfig      `yaml:"superadmin" validate:"required"` // User created with access to every project
+	OAuthProviders []OAuthProviderConfig `yaml:"oauthProviders" validate:"dive"` // Providers users can sign in with
 }
 
 // DashboardOAuthConfig contains OAuth configuration for the Default Dashboard client
 type DashboardOAuthConfig struct {
-	ExternalServer bool     `yaml:"externalServer"`
-	Server         string   `yaml:"server" validate:"required,url"`
-	Name           string   `yaml:"name" validate:"required"`
-	ClientID       string   `yaml:"clientId" validate:"required,uuid"`
-	ClientSecret   string   `yaml:"clientSecret" validate:"required,min=32"`
-	RedirectURIs   []string `yaml:"redirectUris" validate:"required,min=1,dive,required,url"`
-	PKCERequired   bool     `yaml:"pkceRequired"`
+	ExternalServer bool     `yaml:"externalServer"`                                           // Use an external OAuth server, skipping the client seeding
+	Server         string   `yaml:"server" validate:"required,url"`                           // OAuth authorization server URL
+	Name           string   `yaml:"name" validate:"required"`                                 // OAuth client display name
+	ClientID       string   `yaml:"clientId" validate:"required,uuid"`                        // Fixed UUID of the dashboard client
+	ClientSecret   string   `yaml:"clientSecret" validate:"required,min=32"`                  // Dashboard client secret
+	RedirectURIs   []string `yaml:"redirectUris" validate:"required,min=1,dive,required,url"` // Allowed callback URLs
+	PKCERequired   bool     `yaml:"pkceRequired"`                                             // Must be true for public clients
 }
 
 // NotificationConfig contains notification service settings.
@@ -176,22 +452,22 @@ type VerificationNotificationConfig struct {
 
 // EmailNotificationConfig contains email provider settings.
 type EmailNotificationConfig struct {
-	Provider string        `yaml:"provider" validate:"required,oneof=resend ses"`
+	Provider string        `yaml:"provider" validate:"required,oneof=resend ses"` // resend or ses
 	Resend   *ResendConfig `yaml:"resend"`
 	SES      *SESConfig    `yaml:"ses"`
 }
 
 // ResendConfig contains Resend email provider settings.
 type ResendConfig struct {
-	APIKey    string `yaml:"apiKey"`
-	FromEmail string `yaml:"fromEmail" validate:"omitempty,email"`
-	FromName  string `yaml:"fromName"`
+	APIKey    string `yaml:"apiKey"`                               // Resend API key
+	FromEmail string `yaml:"fromEmail" validate:"omitempty,email"` // Sender email address
+	FromName  string `yaml:"fromName"`                             // Sender display name (default: Altalune)
 }
 
 // SESConfig contains AWS SES email provider settings.
 type SESConfig struct {
-	Region    string `yaml:"region"`
-	FromEmail string `yaml:"fromEmail" validate:"omitempty,email"`
+	Region    string `yaml:"region"`                               // AWS region of SES
+	FromEmail string `yaml:"fromEmail" validate:"omitempty,email"` // Sender email address
 }
 
 func (c *NotificationConfig) setDefaults() {
@@ -237,15 +513,15 @@ func (c *NotificationConfig) setDefaults() {
 // AuthValidationConfig contains configuration for JWT validation on resource server.
 type AuthValidationConfig struct {
 	JWKS      *JWKSValidationConfig `yaml:"jwks" validate:"required"`
-	Issuer    string                `yaml:"issuer" validate:"required,url"`
-	Audiences []string              `yaml:"audiences,omitempty"`
+	Issuer    string                `yaml:"issuer" validate:"required,url"` // Expected JWT issuer
+	Audiences []string              `yaml:"audiences,omitempty"`            // Expected JWT audiences, empty skips the check
 }
 
 // JWKSValidationConfig contains JWKS fetching configuration.
 type JWKSValidationConfig struct {
-	URL               string `yaml:"url" validate:"required,url"`
-	CacheTTL          int    `yaml:"cacheTTL"`          // seconds, default 3600
-	RefreshRetryLimit int    `yaml:"refreshRetryLimit"` // default 3
+	URL               string `yaml:"url" validate:"required,url"` // JWKS endpoint URL
+	CacheTTL          int    `yaml:"cacheTTL"`                    // seconds, default 3600
+	RefreshRetryLimit int    `yaml:"refreshRetryLimit"`           // default 3
 }
 
 func (c *AuthValidationConfig) setDefaults() {
@@ -262,13 +538,13 @@ func (c *AuthValidationConfig) setDefaults() {
 
 // BrandingNameConfig contains name configuration for a specific component.
 type BrandingNameConfig struct {
-	Name string `yaml:"name" validate:"required,min=1,max=100"`
+	Name string `yaml:"name" validate:"required,min=1,max=100"` // Name shown to users
 }
 
 // BrandingConfig contains whitelabel branding configuration.
 type BrandingConfig struct {
-	Dashboard  *BrandingNameConfig `yaml:"dashboard"`
-	AuthServer *BrandingNameConfig `yaml:"authServer"`
+	Dashboard  *BrandingNameConfig `yaml:"dashboard"`  // Dashboard name (default: Altalune Dashboard)
+	AuthServer *BrandingNameConfig `yaml:"authServer"` // Auth server name (default: Authalune)
 }
 
 func (c *BrandingConfig) setDefaults() {
@@ -286,16 +562,336 @@ func (c *BrandingConfig) setDefaults() {
 	}
 }
 
+// FrontendConfig controls how the dashboard SPA is served by the API server.
+type FrontendConfig struct {
+	Enabled *bool  `yaml:"enabled"`                      // Serve the SPA at all (default: true)
+	Dir     string `yaml:"dir" validate:"omitempty,dir"` // Serve from this directory instead of the embedded build
+}
+
+func (c *FrontendConfig) setDefaults() {
+	if c.Enabled == nil {
+		defaultEnabled := true
+		c.Enabled = &defaultEnabled
+	}
+}
+
+// TrashConfig controls how long soft-deleted records are kept before they
+// are permanently removed.
+type TrashConfig struct {
+	RetentionDays        int `yaml:"retentionDays" validate:"gte=1,lte=3650"`        // Days a deleted record stays restorable (default: 30)
+	PurgeIntervalMinutes int `yaml:"purgeIntervalMinutes" validate:"gte=1,lte=1440"` // Minutes between purge runs (default: 60)
+}
+
+func (c *TrashConfig) setDefaults() {
+	if c.RetentionDays == 0 {
+		c.RetentionDays = 30
+	}
+	if c.PurgeIntervalMinutes == 0 {
+		c.PurgeIntervalMinutes = 60
+	}
+}
+
+// QueryExportConfig bounds the background generation of query exports and
+// tells where their files are kept.
+type QueryExportConfig struct {
+	Dir       string `yaml:"dir"`                                   // Directory export files are kept in, shared by every server when several run (default: data/exports)
+	Workers   int    `yaml:"workers" validate:"gte=1,lte=32"`       // Exports generated at once per server (default: 2)
+	MaxRows   int    `yaml:"maxRows" validate:"gte=1,lte=50000000"` // Exports matching more rows fail (default: 1000000)
+	MaxSizeMB int    `yaml:"maxSizeMb" validate:"gte=1,lte=10240"`  // Exports growing larger fail, in megabytes (default: 256)
+}
+
+func (c *QueryExportConfig) setDefaults() {
+	if c.Dir == "" {
+		c.Dir = "data/exports"
+	}
+	if c.Workers == 0 {
+		c.Workers = 2
+	}
+	if c.MaxRows == 0 {
+		c.MaxRows = 1000000
+	}
+	if c.MaxSizeMB == 0 {
+		c.MaxSizeMB = 256
+	}
+}
+
+// DigestConfig emails project owners a periodic summary of their project's
+// activity. It needs an email provider.
+type DigestConfig struct {
+	Enabled   bool   `yaml:"enabled"`                                 // Send digests at all (default: false)
+	Frequency string `yaml:"frequency" validate:"oneof=daily weekly"` // daily or weekly (default: daily)
+}
+
+func (c *DigestConfig) setDefaults() {
+	if c.Frequency == "" {
+		c.Frequency = "daily"
+	}
+}
+
+// TokenStatsConfig keeps hourly counts of the tokens each OAuth client was
+// issued and of its failed token requests, and alerts on unusual hours.
+type TokenStatsConfig struct {
+	RetentionDays int                `yaml:"retentionDays" validate:"gte=0"` // Days hourly counts are kept for charts and baselines (default: 90)
+	Alerts        *TokenAlertsConfig `yaml:"alerts"`
+}
+
+func (c *TokenStatsConfig) setDefaults() {
+	if c.RetentionDays == 0 {
+		c.RetentionDays = 90
+	}
+	if c.Alerts == nil {
+		c.Alerts = &TokenAlertsConfig{}
+	}
+	c.Alerts.setDefaults()
+}
+
+// TokenAlertsConfig checks every hour the last complete hour of each client,
+// alerting when its failure rate or its request volume is unusually high.
+// Alerts are posted to a webhook, emailed, or both.
+type TokenAlertsConfig struct {
+	Enabled        bool     `yaml:"enabled"`                                  // Check the hourly counts at all (default: false)
+	MinRequests    int      `yaml:"minRequests" validate:"gte=0"`             // Requests of an hour below which a client is not checked (default: 20)
+	MaxFailureRate float64  `yaml:"maxFailureRate" validate:"gte=0,lte=1"`    // Failed share of the requests above which to alert (default: 0.5)
+	SpikeFactor    float64  `yaml:"spikeFactor" validate:"gte=0"`             // Alert when an hour has this many times the baseline hourly requests (default: 5)
+	BaselineHours  int      `yaml:"baselineHours" validate:"gte=0"`           // Hours before the checked one the baseline averages (default: 24)
+	WebhookURL     string   `yaml:"webhookURL" validate:"omitempty,http_url"` // Endpoint each alert is posted to as JSON
+	Emails         []string `yaml:"emails" validate:"dive,email"`             // Recipients of alert emails; needs an email provider
+}
+
+func (c *TokenAlertsConfig) setDefaults() {
+	if c.MinRequests == 0 {
+		c.MinRequests = 20
+	}
+	if c.MaxFailureRate == 0 {
+		c.MaxFailureRate = 0.5
+	}
+	if c.SpikeFactor == 0 {
+		c.SpikeFactor = 5
+	}
+	if c.BaselineHours == 0 {
+		c.BaselineHours = 24
+	}
+}
+
+// UsageConfig meters the API calls naming a project, keeping hourly counts by
+// OAuth client and refusing the calls of projects over their monthly quota.
+type UsageConfig struct {
+	Enabled       bool `yaml:"enabled"`                        // Count the calls and enforce the quotas at all (default: false)
+	FlushInterval int  `yaml:"flushInterval" validate:"gte=0"` // Seconds counts are kept in memory before they are stored (default: 60)
+	RetentionDays int  `yaml:"retentionDays" validate:"gte=0"` // Days hourly counts are kept (default: 400)
+}
+
+func (c *UsageConfig) setDefaults() {
+	if c.FlushInterval == 0 {
+		c.FlushInterval = 60
+	}
+	if c.RetentionDays == 0 {
+		c.RetentionDays = 400
+	}
+}
+
+// BillingConfig attaches organizations to Stripe subscriptions, whose plans
+// limit the projects of an organization and the OAuth clients and members of
+// each of its projects. Projects outside organizations get the default plan.
+type BillingConfig struct {
+	Enabled             bool                `yaml:"enabled"`                                                 // Enforce the plan limits and receive Stripe webhooks (default: false)
+	StripeSecretKey     string              `yaml:"stripeSecretKey"`                                         // Secret API key opening customer portal sessions
+	StripeWebhookSecret string              `yaml:"stripeWebhookSecret" validate:"required_if=Enabled true"` // Signing secret of the webhook endpoint (whsec_...)
+	PortalReturnURL     string              `yaml:"portalReturnURL" validate:"omitempty,url"`                // Where the customer portal links back to, unless the request sets one
+	DefaultPlan         string              `yaml:"defaultPlan"`                                             // Plan without an active subscription (default: free)
+	Plans               []BillingPlanConfig `yaml:"plans" validate:"dive"`                                   // Plans, a plan missing from it being unlimited
+}
+
+// BillingPlanConfig is a plan organizations subscribe to. A limit of 0 is
+// unlimited.
+type BillingPlanConfig struct {
+	ID            string `yaml:"id" validate:"required"`       // Plan ID, e.g. free, pro
+	Name          string `yaml:"name"`                         // Shown to users (default: the ID)
+	StripePriceID string `yaml:"stripePriceId"`                // Stripe price of the subscriptions on the plan, empty for the default plan
+	MaxProjects   int    `yaml:"maxProjects" validate:"gte=0"` // Projects of an organization
+	MaxClients    int    `yaml:"maxClients" validate:"gte=0"`  // OAuth clients, billed to the default project
+	MaxMembers    int    `yaml:"maxMembers" validate:"gte=0"`  // Members of a project
+}
+
+func (c *BillingConfig) setDefaults() {
+	if c.DefaultPlan == "" {
+		c.DefaultPlan = "free"
+	}
+	for i := range c.Plans {
+		if c.Plans[i].Name == "" {
+			c.Plans[i].Name = c.Plans[i].ID
+		}
+	}
+}
+
+// MaintenanceConfig puts the servers in maintenance: the API rejects mutating
+// RPCs and the auth server shows a maintenance page. `altalune maintenance`
+// switches it on and off at runtime through the database.
+type MaintenanceConfig struct {
+	Enabled      bool     `yaml:"enabled"`                             // Start in maintenance whatever the database switch says (default: false)
+	Message      string   `yaml:"message"`                             // Shown to clients unless the database switch sets one
+	RetryAfter   int      `yaml:"retryAfter" validate:"gte=0"`         // Retry-After sent with 503 responses, in seconds (default: 300)
+	PollInterval int      `yaml:"pollInterval" validate:"gte=0"`       // Seconds the database switch is cached for (default: 10)
+	BypassTokens []string `yaml:"bypassTokens" validate:"dive,min=16"` // Requests sending one in X-Maintenance-Bypass are served as usual
+}
+
+func (c *MaintenanceConfig) setDefaults() {
+	if c.RetryAfter == 0 {
+		c.RetryAfter = 300
+	}
+	if c.PollInterval == 0 {
+		c.PollInterval = 10
+	}
+}
+
+// MetricsConfig exposes Prometheus metrics on /metrics of the API server: the
+// Go runtime, the process, and the health of the database connection pool,
+// partitions and migrations.
+type MetricsConfig struct {
+	Enabled bool   `yaml:"enabled"`                           // Serve /metrics (default: false)
+	Token   string `yaml:"token" validate:"omitempty,min=16"` // Bearer token scrapers must send, empty serves /metrics to anyone
+}
+
+// FeatureFlagConfig tunes the cache of the feature flags, which are
+// configured through the FeatureFlagService.
+type FeatureFlagConfig struct {
+	RefreshInterval int `yaml:"refreshInterval" validate:"gte=0"` // Seconds the flags are cached for before other replicas' changes are seen (default: 30)
+}
+
+func (c *FeatureFlagConfig) setDefaults() {
+	if c.RefreshInterval == 0 {
+		c.RefreshInterval = 30
+	}
+}
+
+// RedisConfig connects to an optional Redis server shared by all replicas.
+// When disabled, rate limits, sessions and caches are kept per process.
+type RedisConfig struct {
+	Enabled     bool   `yaml:"enabled"`                                                          // Connect to Redis (default: false)
+	Addr        string `yaml:"addr" validate:"required_if=Enabled true,omitempty,hostname_port"` // Redis host:port (default: localhost:6379)
+	Username    string `yaml:"username"`                                                         // ACL username, empty for password-only auth
+	Password    string `yaml:"password"`                                                         // Empty when auth is disabled
+	DB          int    `yaml:"db" validate:"gte=0,lte=15"`                                       // Logical database index (default: 0)
+	TLS         bool   `yaml:"tls"`                                                              // Connect over TLS (default: false)
+	PoolSize    int    `yaml:"poolSize" validate:"gte=1,lte=1000"`                               // Max open connections per replica (default: 10)
+	DialTimeout int    `yaml:"dialTimeout" validate:"gte=1,lte=60"`                              // Connect timeout in seconds (default: 5)
+	IOTimeout   int    `yaml:"ioTimeout" validate:"gte=1,lte=60"`                                // Per-command timeout in seconds (default: 3)
+	KeyPrefix   string `yaml:"keyPrefix"`                                                        // Prepended to every key (default: "altalune:")
+}
+
+func (c *RedisConfig) setDefaults() {
+	if c.Addr == "" && c.Enabled {
+		c.Addr = "localhost:6379"
+	}
+	if c.PoolSize == 0 {
+		c.PoolSize = 10
+	}
+	if c.DialTimeout == 0 {
+		c.DialTimeout = 5
+	}
+	if c.IOTimeout == 0 {
+		c.IOTimeout = 3
+	}
+	if c.KeyPrefix == "" {
+		c.KeyPrefix = "altalune:"
+	}
+}
+
+// LoggingConfig shapes the application logs; the default level is
+// server.logLevel.
+type LoggingConfig struct {
+	Format   string            `yaml:"format" validate:"oneof=console json"`                // console or json (default: console)
+	Modules  map[string]string `yaml:"modules" validate:"dive,oneof=debug info warn error"` // Level overrides per module, e.g. {oauth: debug, http: warn}
+	Sampling map[string]int    `yaml:"sampling" validate:"dive,gte=1"`                      // Log 1 in N HTTP requests to these paths, e.g. {/oauth/token: 100}
+}
+
+func (c *LoggingConfig) setDefaults() {
+	if c.Format == "" {
+		c.Format = "console"
+	}
+}
+
+// ErrorReportConfig sends the unexpected errors, panics and failed background
+// jobs to an error tracker.
+type ErrorReportConfig struct {
+	DSN         string `yaml:"dsn" validate:"omitempty,url"` // Sentry DSN, empty disables reporting
+	Environment string `yaml:"environment"`                  // Environment the errors are tagged with, e.g. production
+}
+
+// EventsConfig forwards the domain events, such as users registering, to a
+// webhook.
+type EventsConfig struct {
+	WebhookURL    string `yaml:"webhookURL" validate:"omitempty,url"` // Endpoint every event is POSTed to as JSON, empty for none
+	WebhookSecret string `yaml:"webhookSecret"`                       // Key of the HMAC-SHA256 signature header, empty for unsigned bodies
+}
+
+// EmailValidationConfig screens the addresses the servers send emails to:
+// those signing in with an emailed code and those of users created by
+// administrators. Malformed addresses are always rejected.
+type EmailValidationConfig struct {
+	CheckMX         bool     `yaml:"checkMX"`                                 // Reject domains without a mail server (default: false)
+	MXTimeout       int      `yaml:"mxTimeout" validate:"gte=0"`              // Seconds to wait for DNS, after which the address is accepted (default: 3)
+	BlockDisposable bool     `yaml:"blockDisposable"`                         // Reject the built-in list of disposable inbox providers (default: false)
+	BlockedDomains  []string `yaml:"blockedDomains" validate:"dive,fqdn"`     // Domains rejected besides the built-in list, with their subdomains
+	AllowedDomains  []string `yaml:"allowedDomains" validate:"dive,fqdn"`     // Domains never rejected, overriding the lists
+	BlocklistFile   string   `yaml:"blocklistFile" validate:"omitempty,file"` // File of rejected domains, one per line with # comments
+}
+
+func (c *EmailValidationConfig) setDefaults() {
+	if c.MXTimeout == 0 {
+		c.MXTimeout = 3
+	}
+}
+
+// ACMEConfig obtains and renews the servers' TLS certificates from an ACME
+// CA such as Let's Encrypt, for deployments terminating TLS themselves.
+type ACMEConfig struct {
+	Enabled      bool     `yaml:"enabled"`                                               // Get and renew certificates from the ACME CA (default: false)
+	Domains      []string `yaml:"domains" validate:"required_if=Enabled true,dive,fqdn"` // Hostnames certificates may be requested for
+	Email        string   `yaml:"email" validate:"omitempty,email"`                      // Contact for expiry and account notices
+	CacheDir     string   `yaml:"cacheDir"`                                              // Directory storing account keys and certificates (default: ".acme")
+	DirectoryURL string   `yaml:"directoryURL" validate:"omitempty,url"`                 // ACME directory (default: Let's Encrypt production)
+	HTTPPort     *int     `yaml:"httpPort" validate:"omitempty,gte=0,lte=65535"`         // Port answering HTTP-01 challenges and redirecting to HTTPS, 0 disables it (default: 80)
+}
+
+func (c *ACMEConfig) setDefaults() {
+	if c.CacheDir == "" {
+		c.CacheDir = ".acme"
+	}
+	if c.HTTPPort == nil {
+		defaultHTTPPort := 80
+		c.HTTPPort = &defaultHTTPPort
+	}
+}
+
+// AppConfig is the config file. Every key can be overridden with an ALTALUNE_*
+// environment variable, see applyEnv; `make config-doc` lists them all.
 type AppConfig struct {
-	Server         *ServerConfig         `yaml:"server" validate:"required"`
-	Database       *DatabaseConfig       `yaml:"database" validate:"required"`
-	Security       *SecurityConfig       `yaml:"security" validate:"required"`
-	Auth           *AuthConfig           `yaml:"auth" validate:"required"`
-	Seeder         *SeederConfig         `yaml:"seeder" validate:"required"`
-	DashboardOAuth *DashboardOAuthConfig `yaml:"dashboardOauth" validate:"required"`
-	Notification   *NotificationConfig   `yaml:"notification"`
-	Branding       *BrandingConfig       `yaml:"branding"`
-	AuthValidation *AuthValidationConfig `yaml:"authValidation"`
+	Server          *ServerConfig          `yaml:"server" validate:"required"`
+	Database        *DatabaseConfig        `yaml:"database" validate:"required"`
+	Security        *SecurityConfig        `yaml:"security" validate:"required"`
+	Auth            *AuthConfig            `yaml:"auth" validate:"required"`
+	Seeder          *SeederConfig          `yaml:"seeder" validate:"required"`
+	DashboardOAuth  *DashboardOAuthConfig  `yaml:"dashboardOauth" validate:"required"`
+	Notification    *NotificationConfig    `yaml:"notification"`
+	Branding        *BrandingConfig        `yaml:"branding"`
+	AuthValidation  *AuthValidationConfig  `yaml:"authValidation"`
+	Frontend        *FrontendConfig        `yaml:"frontend"`
+	Trash           *TrashConfig           `yaml:"trash"`
+	QueryExport     *QueryExportConfig     `yaml:"queryExport"`
+	Digest          *DigestConfig          `yaml:"digest"`
+	TokenStats      *TokenStatsConfig      `yaml:"tokenStats"`
+	Usage           *UsageConfig           `yaml:"usage"`
+	Billing         *BillingConfig         `yaml:"billing"`
+	Maintenance     *MaintenanceConfig     `yaml:"maintenance"`
+	Metrics         *MetricsConfig         `yaml:"metrics"`
+	FeatureFlags    *FeatureFlagConfig     `yaml:"featureFlags"`
+	Redis           *RedisConfig           `yaml:"redis"`
+	ACME            *ACMEConfig            `yaml:"acme"`
+	Logging         *LoggingConfig         `yaml:"logging"`
+	ErrorReport     *ErrorReportConfig     `yaml:"errorReport"`
+	Events          *EventsConfig          `yaml:"events"`
+	EmailValidation *EmailValidationConfig `yaml:"emailValidation"`
 }
 
 func (c *AppConfig) setDefaults() {
@@ -311,6 +907,67 @@ func (c *AppConfig) setDefaults() {
 		c.Branding = &BrandingConfig{}
 	}
 	c.Branding.setDefaults()
+	if c.Frontend == nil {
+		c.Frontend = &FrontendConfig{}
+	}
+	c.Frontend.setDefaults()
+	if c.Trash == nil {
+		c.Trash = &TrashConfig{}
+	}
+	c.Trash.setDefaults()
+	if c.QueryExport == nil {
+		c.QueryExport = &QueryExportConfig{}
+	}
+	c.QueryExport.setDefaults()
+	if c.Digest == nil {
+		c.Digest = &DigestConfig{}
+	}
+	c.Digest.setDefaults()
+	if c.TokenStats == nil {
+		c.TokenStats = &TokenStatsConfig{}
+	}
+	c.TokenStats.setDefaults()
+	if c.Usage == nil {
+		c.Usage = &UsageConfig{}
+	}
+	c.Usage.setDefaults()
+	if c.Billing == nil {
+		c.Billing = &BillingConfig{}
+	}
+	c.Billing.setDefaults()
+	if c.Maintenance == nil {
+		c.Maintenance = &MaintenanceConfig{}
+	}
+	c.Maintenance.setDefaults()
+	if c.Metrics == nil {
+		c.Metrics = &MetricsConfig{}
+	}
+	if c.FeatureFlags == nil {
+		c.FeatureFlags = &FeatureFlagConfig{}
+	}
+	c.FeatureFlags.setDefaults()
+	if c.Redis == nil {
+		c.Redis = &RedisConfig{}
+	}
+	c.Redis.setDefaults()
+	if c.ACME == nil {
+		c.ACME = &ACMEConfig{}
+	}
+	c.ACME.setDefaults()
+	if c.Logging == nil {
+		c.Logging = &LoggingConfig{}
+	}
+	c.Logging.setDefaults()
+	if c.ErrorReport == nil {
+		c.ErrorReport = &ErrorReportConfig{}
+	}
+	if c.Events == nil {
+		c.Events = &EventsConfig{}
+	}
+	if c.EmailValidation == nil {
+		c.EmailValidation = &EmailValidationConfig{}
+	}
+	c.EmailValidation.setDefaults()
 	if c.AuthValidation != nil {
 		c.AuthValidation.setDefaults()
 	}
@@ -334,5 +991,50 @@ func (c *AppConfig) Validate() error {
 		return fmt.Errorf("configuration validation failed: %w", err)
 	}
 
+	if c.Auth.Embedded && c.Auth.Port == c.Server.Port && c.Auth.BindHost == c.Server.BindHost {
+		return fmt.Errorf("auth.embedded requires auth.port or auth.bindHost to differ from the server's")
+	}
+
+	// required_if only rejects a missing list, not the empty one of the example
+	if c.ACME.Enabled && len(c.ACME.Domains) == 0 {
+		return fmt.Errorf("acme.enabled requires acme.domains")
+	}
+
+	if c.ACME.Enabled && (c.Server.TLSCertFile != "" || c.Server.TLSKeyFile != "") {
+		return fmt.Errorf("acme.enabled cannot be combined with server.tlsCertFile and server.tlsKeyFile")
+	}
+
+	for i, project := range c.Auth.Captcha.Projects {
+		if project.Provider != "" && project.Provider != "none" && (project.SiteKey == "" || project.SecretKey == "") {
+			return fmt.Errorf("auth.captcha.projects[%d] with provider %s requires siteKey and secretKey", i, project.Provider)
+		}
+	}
+
+	priceIDs := make(map[string]bool, len(c.Billing.Plans))
+	for i, plan := range c.Billing.Plans {
+		if plan.StripePriceID == "" {
+			continue
+		}
+		if priceIDs[plan.StripePriceID] {
+			return fmt.Errorf("billing.plans[%d] reuses stripePriceId %s", i, plan.StripePriceID)
+		}
+		priceIDs[plan.StripePriceID] = true
+	}
+
+	if c.TokenStats.Alerts.Enabled && c.TokenStats.Alerts.WebhookURL == "" && len(c.TokenStats.Alerts.Emails) == 0 {
+		return fmt.Errorf("tokenStats.alerts.enabled requires webhookURL or emails")
+	}
+
+	// Any site could make credentialed calls on behalf of the signed-in users
+	if c.Security.CORS != nil && c.Security.CORS.AllowCredentials != nil && *c.Security.CORS.AllowCredentials &&
+		slices.Contains(c.Security.AllowedOrigins, "*") {
+		return fmt.Errorf("security.cors.allowCredentials cannot be combined with \"*\" in security.allowedOrigins")
+	}
+
+	// Browsers drop SameSite=None cookies that are not Secure
+	if c.Security.Cookies.SameSite == "none" && !c.Security.Cookies.Secure {
+		return fmt.Errorf("security.cookies.sameSite none requires security.cookies.secure")
+	}
+
 	return nil
 }
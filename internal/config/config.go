@@ -3,6 +3,8 @@ package config
 import (
 	"encoding/base64"
 	"fmt"
+	"slices"
+	"sort"
 	"time"
 
 	"github.com/hrz8/altalune"
@@ -34,6 +36,10 @@ func (c *AppConfig) GetServerReadTimeout() time.Duration {
 	return time.Duration(c.Server.ReadTimeout) * time.Second
 }
 
+func (c *AppConfig) GetServerReadHeaderTimeout() time.Duration {
+	return time.Duration(c.Server.ReadHeaderTimeout) * time.Second
+}
+
 func (c *AppConfig) GetServerWriteTimeout() time.Duration {
 	return time.Duration(c.Server.WriteTimeout) * time.Second
 }
@@ -46,6 +52,44 @@ func (c *AppConfig) GetServerCleanupTimeout() time.Duration {
 	return time.Duration(c.Server.CleanupTimeout) * time.Second
 }
 
+func (c *AppConfig) GetServerHandlerTimeout() time.Duration {
+	return time.Duration(c.Server.HandlerTimeout) * time.Second
+}
+
+func (c *AppConfig) GetServerMaxRequestBytes() int64 {
+	return c.Server.MaxRequestBytes
+}
+
+func (c *AppConfig) GetServerRateLimit() int {
+	return c.Server.RateLimit
+}
+
+func (c *AppConfig) IsCompressionEnabled() bool {
+	if c.Server.Compression == nil {
+		return true
+	}
+	return *c.Server.Compression
+}
+
+func (c *AppConfig) GetServerBindHost() string {
+	return c.Server.BindHost
+}
+
+func (c *AppConfig) IsH2CEnabled() bool {
+	if c.Server.H2C == nil {
+		return true
+	}
+	return *c.Server.H2C
+}
+
+func (c *AppConfig) GetServerTLSCertFile() string {
+	return c.Server.TLSCertFile
+}
+
+func (c *AppConfig) GetServerTLSKeyFile() string {
+	return c.Server.TLSKeyFile
+}
+
 func (c *AppConfig) GetDatabaseURL() string {
 	return c.Database.URL
 }
@@ -62,12 +106,72 @@ func (c *AppConfig) GetDatabaseConnectTimeout() time.Duration {
 	return time.Duration(c.Database.ConnectTimeout) * time.Second
 }
 
+func (c *AppConfig) IsDatabaseRowLevelSecurityEnabled() bool {
+	return c.Database.RowLevelSecurity
+}
+
+func (c *AppConfig) GetDatabaseStatementTimeout() time.Duration {
+	return time.Duration(*c.Database.StatementTimeout) * time.Second
+}
+
+func (c *AppConfig) GetDatabaseSlowQueryThreshold() time.Duration {
+	return time.Duration(*c.Database.SlowQueryThreshold) * time.Millisecond
+}
+
+func (c *AppConfig) GetDatabaseCountMode() string {
+	return c.Database.CountMode
+}
+
+func (c *AppConfig) GetDatabaseExactCountLimit() int64 {
+	return int64(c.Database.ExactCountLimit)
+}
+
+func (c *AppConfig) GetDatabaseMigrateOnStart() string {
+	return c.Database.MigrateOnStart
+}
+
+func (c *AppConfig) GetDatabaseMigrateWaitTimeout() time.Duration {
+	return time.Duration(c.Database.MigrateWaitTimeout) * time.Second
+}
+
 func (c *AppConfig) GetAllowedOrigins() []string {
 	origins := make([]string, len(c.Security.AllowedOrigins))
 	copy(origins, c.Security.AllowedOrigins)
 	return origins
 }
 
+// GetCORSAllowedOrigins returns the origins allowed to call every project.
+func (c *AppConfig) GetCORSAllowedOrigins() []string {
+	return c.GetAllowedOrigins()
+}
+
+// GetCORSProjectOrigins returns the origins allowed to call only one project,
+// keyed by project public ID.
+func (c *AppConfig) GetCORSProjectOrigins() map[string][]string {
+	if c.Security.CORS == nil {
+		return nil
+	}
+	origins := make(map[string][]string, len(c.Security.CORS.ProjectOrigins))
+	for projectID, projectOrigins := range c.Security.CORS.ProjectOrigins {
+		origins[projectID] = slices.Clone(projectOrigins)
+	}
+	return origins
+}
+
+func (c *AppConfig) IsCORSAllowCredentials() bool {
+	if c.Security.CORS == nil || c.Security.CORS.AllowCredentials == nil {
+		return false
+	}
+	return *c.Security.CORS.AllowCredentials
+}
+
+func (c *AppConfig) GetCORSMaxAge() time.Duration {
+	if c.Security.CORS == nil {
+		return 0
+	}
+	return time.Duration(c.Security.CORS.MaxAge) * time.Second
+}
+
 func (c *AppConfig) GetIAMEncryptionKey() []byte {
 	// Return the encryption key from YAML config (no environment variable fallback)
 	// The key is stored as base64-encoded string in config.yaml (44 chars)
@@ -80,6 +184,38 @@ func (c *AppConfig) GetIAMEncryptionKey() []byte {
 	return key
 }
 
+func (c *AppConfig) GetPasswordHashIterations() uint32 {
+	return c.Security.PasswordHashing.Iterations
+}
+
+func (c *AppConfig) GetPasswordHashMemory() uint32 {
+	return c.Security.PasswordHashing.Memory
+}
+
+func (c *AppConfig) GetPasswordHashThreads() uint8 {
+	return c.Security.PasswordHashing.Threads
+}
+
+func (c *AppConfig) GetPasswordHashLength() uint32 {
+	return c.Security.PasswordHashing.Length
+}
+
+func (c *AppConfig) GetClientSecretCacheTTL() time.Duration {
+	return time.Duration(c.Security.PasswordHashing.ClientSecretCacheTTL) * time.Second
+}
+
+func (c *AppConfig) GetIAMPreviousEncryptionKeys() [][]byte {
+	keys := make([][]byte, 0, len(c.Security.IAMPreviousKeys))
+	for _, encoded := range c.Security.IAMPreviousKeys {
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			key = []byte{} // Fails key validation
+		}
+		keys = append(keys, key)
+	}
+	return keys
+}
+
 // JWT configuration
 func (c *AppConfig) GetJWTPrivateKeyPath() string {
 	return c.Security.JWTPrivateKeyPath
@@ -109,6 +245,14 @@ func (c *AppConfig) GetAuthHost() string {
 	return c.Auth.Host
 }
 
+func (c *AppConfig) GetAuthBindHost() string {
+	return c.Auth.BindHost
+}
+
+func (c *AppConfig) IsAuthEmbedded() bool {
+	return c.Auth.Embedded
+}
+
 func (c *AppConfig) GetAuthPort() int {
 	return c.Auth.Port
 }
@@ -117,6 +261,14 @@ func (c *AppConfig) GetSessionSecret() string {
 	return c.Auth.SessionSecret
 }
 
+func (c *AppConfig) GetSessionIdleTimeout() int {
+	return *c.Auth.SessionIdleTimeout
+}
+
+func (c *AppConfig) GetSessionLifetime() int {
+	return c.Auth.SessionLifetime
+}
+
 func (c *AppConfig) GetCodeExpiry() int {
 	return c.Auth.CodeExpiry
 }
@@ -133,6 +285,88 @@ func (c *AppConfig) IsAutoActivate() bool {
 	return c.Auth.IsAutoActivate()
 }
 
+func (c *AppConfig) GetAuthDefaultLocale() string {
+	return c.Auth.DefaultLocale
+}
+
+func (c *AppConfig) GetLockoutMaxAttempts() int {
+	return c.Auth.LockoutMaxAttempts
+}
+
+func (c *AppConfig) GetLockoutDuration() int {
+	return c.Auth.LockoutDuration
+}
+
+func (c *AppConfig) IsAuthHostIssuer() bool {
+	return c.Auth.HostIssuer
+}
+
+func (c *AppConfig) IsRememberMeEnabled() bool {
+	return c.Auth.RememberMe.Enabled
+}
+
+func (c *AppConfig) GetRememberMeLifetime() int {
+	return c.Auth.RememberMe.Lifetime
+}
+
+func (c *AppConfig) GetAuthServiceDocumentation() string {
+	return c.Auth.Discovery.ServiceDocumentation
+}
+
+func (c *AppConfig) GetAuthPolicyURI() string {
+	return c.Auth.Discovery.PolicyURI
+}
+
+func (c *AppConfig) GetAuthTOSURI() string {
+	return c.Auth.Discovery.TOSURI
+}
+
+// GetCaptcha returns the default challenge with the override of projectID
+// applied, a project with the none provider having no challenge.
+func (c *AppConfig) GetCaptcha(projectID string) altalune.CaptchaConfig {
+	captcha := altalune.CaptchaConfig{
+		Provider:  c.Auth.Captcha.Provider,
+		SiteKey:   c.Auth.Captcha.SiteKey,
+		SecretKey: c.Auth.Captcha.SecretKey,
+	}
+	if projectID == "" {
+		return captcha
+	}
+	for _, project := range c.Auth.Captcha.Projects {
+		if project.ProjectID != projectID || project.Provider == "" {
+			continue
+		}
+		if project.Provider == "none" {
+			return altalune.CaptchaConfig{}
+		}
+		return altalune.CaptchaConfig{
+			Provider:  project.Provider,
+			SiteKey:   project.SiteKey,
+			SecretKey: project.SecretKey,
+		}
+	}
+	return captcha
+}
+
+func (c *AppConfig) GetCaptchaProviders() []string {
+	var providers []string
+	add := func(provider string) {
+		if provider != "" && provider != "none" && !slices.Contains(providers, provider) {
+			providers = append(providers, provider)
+		}
+	}
+	add(c.Auth.Captcha.Provider)
+	for _, project := range c.Auth.Captcha.Projects {
+		add(project.Provider)
+	}
+	sort.Strings(providers)
+	return providers
+}
+
+func (c *AppConfig) GetCaptchaVerifyTimeout() time.Duration {
+	return time.Duration(c.Auth.Captcha.VerifyTimeout) * time.Second
+}
+
 // Seeder configuration
 func (c *AppConfig) GetSuperadminEmail() string {
 	return c.Seeder.Superadmin.Email
@@ -279,6 +513,272 @@ func (c *AppConfig) GetAuthServerBrandingName() string {
 	return c.Branding.AuthServer.Name
 }
 
+// Frontend configuration
+func (c *AppConfig) IsFrontendEnabled() bool {
+	if c.Frontend == nil || c.Frontend.Enabled == nil {
+		return true
+	}
+	return *c.Frontend.Enabled
+}
+
+func (c *AppConfig) GetFrontendDir() string {
+	if c.Frontend == nil {
+		return ""
+	}
+	return c.Frontend.Dir
+}
+
+// Trash configuration
+func (c *AppConfig) GetTrashRetentionDays() int {
+	return c.Trash.RetentionDays
+}
+
+func (c *AppConfig) GetTrashPurgeIntervalMinutes() int {
+	return c.Trash.PurgeIntervalMinutes
+}
+
+// Query export configuration
+func (c *AppConfig) GetQueryExportDir() string {
+	return c.QueryExport.Dir
+}
+
+func (c *AppConfig) GetQueryExportWorkers() int {
+	return c.QueryExport.Workers
+}
+
+func (c *AppConfig) GetQueryExportMaxRows() int32 {
+	return int32(c.QueryExport.MaxRows)
+}
+
+func (c *AppConfig) GetQueryExportMaxBytes() int64 {
+	return int64(c.QueryExport.MaxSizeMB) << 20
+}
+
+// Digest configuration
+func (c *AppConfig) IsDigestEnabled() bool {
+	return c.Digest.Enabled
+}
+
+func (c *AppConfig) GetDigestFrequency() string {
+	return c.Digest.Frequency
+}
+
+// Token stats configuration
+func (c *AppConfig) GetTokenStatsRetentionDays() int {
+	return c.TokenStats.RetentionDays
+}
+
+func (c *AppConfig) IsTokenAlertsEnabled() bool {
+	return c.TokenStats.Alerts.Enabled
+}
+
+func (c *AppConfig) GetTokenAlertMinRequests() int {
+	return c.TokenStats.Alerts.MinRequests
+}
+
+func (c *AppConfig) GetTokenAlertMaxFailureRate() float64 {
+	return c.TokenStats.Alerts.MaxFailureRate
+}
+
+func (c *AppConfig) GetTokenAlertSpikeFactor() float64 {
+	return c.TokenStats.Alerts.SpikeFactor
+}
+
+func (c *AppConfig) GetTokenAlertBaselineHours() int {
+	return c.TokenStats.Alerts.BaselineHours
+}
+
+func (c *AppConfig) GetTokenAlertWebhookURL() string {
+	return c.TokenStats.Alerts.WebhookURL
+}
+
+func (c *AppConfig) GetTokenAlertEmails() []string {
+	return c.TokenStats.Alerts.Emails
+}
+
+// Usage configuration
+func (c *AppConfig) IsUsageMeteringEnabled() bool {
+	return c.Usage.Enabled
+}
+
+func (c *AppConfig) GetUsageFlushInterval() time.Duration {
+	return time.Duration(c.Usage.FlushInterval) * time.Second
+}
+
+func (c *AppConfig) GetUsageRetentionDays() int {
+	return c.Usage.RetentionDays
+}
+
+// Billing configuration
+func (c *AppConfig) IsBillingEnabled() bool {
+	return c.Billing.Enabled
+}
+
+func (c *AppConfig) GetStripeSecretKey() string {
+	return c.Billing.StripeSecretKey
+}
+
+func (c *AppConfig) GetStripeWebhookSecret() string {
+	return c.Billing.StripeWebhookSecret
+}
+
+func (c *AppConfig) GetBillingPortalReturnURL() string {
+	return c.Billing.PortalReturnURL
+}
+
+func (c *AppConfig) GetBillingDefaultPlan() string {
+	return c.Billing.DefaultPlan
+}
+
+func (c *AppConfig) GetBillingPlans() []altalune.BillingPlan {
+	plans := make([]altalune.BillingPlan, len(c.Billing.Plans))
+	for i, p := range c.Billing.Plans {
+		plans[i] = altalune.BillingPlan{
+			ID:            p.ID,
+			Name:          p.Name,
+			StripePriceID: p.StripePriceID,
+			MaxProjects:   p.MaxProjects,
+			MaxClients:    p.MaxClients,
+			MaxMembers:    p.MaxMembers,
+		}
+	}
+	return plans
+}
+
+// Maintenance configuration
+func (c *AppConfig) IsMaintenanceEnabled() bool {
+	return c.Maintenance.Enabled
+}
+
+func (c *AppConfig) GetMaintenanceMessage() string {
+	return c.Maintenance.Message
+}
+
+func (c *AppConfig) GetMaintenanceRetryAfter() time.Duration {
+	return time.Duration(c.Maintenance.RetryAfter) * time.Second
+}
+
+func (c *AppConfig) GetMaintenancePollInterval() time.Duration {
+	return time.Duration(c.Maintenance.PollInterval) * time.Second
+}
+
+func (c *AppConfig) GetMaintenanceBypassTokens() []string {
+	return c.Maintenance.BypassTokens
+}
+
+// Metrics configuration
+func (c *AppConfig) IsMetricsEnabled() bool {
+	return c.Metrics.Enabled
+}
+
+func (c *AppConfig) GetMetricsToken() string {
+	return c.Metrics.Token
+}
+
+// Feature flag configuration
+func (c *AppConfig) GetFeatureFlagRefreshInterval() time.Duration {
+	return time.Duration(c.FeatureFlags.RefreshInterval) * time.Second
+}
+
+// Cookie configuration
+func (c *AppConfig) IsCookieSecure() bool {
+	return c.Security.Cookies.Secure
+}
+
+func (c *AppConfig) GetCookieSameSite() string {
+	return c.Security.Cookies.SameSite
+}
+
+func (c *AppConfig) GetCookieDomain() string {
+	return c.Security.Cookies.Domain
+}
+
+func (c *AppConfig) GetCookiePath() string {
+	return c.Security.Cookies.Path
+}
+
+// Trusted proxy configuration
+func (c *AppConfig) GetTrustedProxies() []string {
+	return c.Security.TrustedProxies
+}
+
+// Security headers configuration
+func (c *AppConfig) GetHSTSMaxAge() time.Duration {
+	return time.Duration(c.Security.Headers.HSTSMaxAge) * time.Second
+}
+
+func (c *AppConfig) IsHSTSIncludeSubdomains() bool {
+	return c.Security.Headers.HSTSIncludeSubdomains
+}
+
+func (c *AppConfig) IsHSTSPreload() bool {
+	return c.Security.Headers.HSTSPreload
+}
+
+func (c *AppConfig) GetFrameOptions() string {
+	return c.Security.Headers.FrameOptions
+}
+
+func (c *AppConfig) GetReferrerPolicy() string {
+	return c.Security.Headers.ReferrerPolicy
+}
+
+func (c *AppConfig) GetAuthServerCSP() string {
+	if c.Security.Headers.AuthServerCSP == nil {
+		return DefaultAuthServerCSP
+	}
+	return *c.Security.Headers.AuthServerCSP
+}
+
+func (c *AppConfig) GetDashboardCSP() string {
+	return c.Security.Headers.DashboardCSP
+}
+
+func (c *AppConfig) IsCSPReportOnly() bool {
+	return c.Security.Headers.CSPReportOnly
+}
+
+// Redis configuration
+func (c *AppConfig) IsRedisEnabled() bool {
+	return c.Redis.Enabled
+}
+
+func (c *AppConfig) GetRedisAddr() string {
+	return c.Redis.Addr
+}
+
+func (c *AppConfig) GetRedisUsername() string {
+	return c.Redis.Username
+}
+
+func (c *AppConfig) GetRedisPassword() string {
+	return c.Redis.Password
+}
+
+func (c *AppConfig) GetRedisDB() int {
+	return c.Redis.DB
+}
+
+func (c *AppConfig) IsRedisTLS() bool {
+	return c.Redis.TLS
+}
+
+func (c *AppConfig) GetRedisPoolSize() int {
+	return c.Redis.PoolSize
+}
+
+func (c *AppConfig) GetRedisDialTimeout() time.Duration {
+	return time.Duration(c.Redis.DialTimeout) * time.Second
+}
+
+func (c *AppConfig) GetRedisIOTimeout() time.Duration {
+	return time.Duration(c.Redis.IOTimeout) * time.Second
+}
+
+func (c *AppConfig) GetRedisKeyPrefix() string {
+	return c.Redis.KeyPrefix
+}
+
 // AuthValidation configuration getters
 
 // GetAuthValidationJWKSURL returns the JWKS endpoint URL.
@@ -325,3 +825,84 @@ func (c *AppConfig) GetAuthValidationAudiences() []string {
 func (c *AppConfig) IsAuthValidationEnabled() bool {
 	return c.AuthValidation != nil && c.AuthValidation.JWKS != nil && c.AuthValidation.JWKS.URL != ""
 }
+
+// ACME configuration
+func (c *AppConfig) IsACMEEnabled() bool {
+	return c.ACME.Enabled
+}
+
+func (c *AppConfig) GetACMEDomains() []string {
+	return c.ACME.Domains
+}
+
+func (c *AppConfig) GetACMEEmail() string {
+	return c.ACME.Email
+}
+
+func (c *AppConfig) GetACMECacheDir() string {
+	return c.ACME.CacheDir
+}
+
+func (c *AppConfig) GetACMEDirectoryURL() string {
+	return c.ACME.DirectoryURL
+}
+
+func (c *AppConfig) GetACMEHTTPPort() int {
+	return *c.ACME.HTTPPort
+}
+
+// Logging configuration
+func (c *AppConfig) GetLogFormat() string {
+	return c.Logging.Format
+}
+
+func (c *AppConfig) GetLogModuleLevels() map[string]string {
+	return c.Logging.Modules
+}
+
+func (c *AppConfig) GetLogSampling() map[string]int {
+	return c.Logging.Sampling
+}
+
+// Error report configuration
+func (c *AppConfig) GetErrorReportDSN() string {
+	return c.ErrorReport.DSN
+}
+
+func (c *AppConfig) GetErrorReportEnvironment() string {
+	return c.ErrorReport.Environment
+}
+
+// Domain events configuration
+func (c *AppConfig) GetEventWebhookURL() string {
+	return c.Events.WebhookURL
+}
+
+func (c *AppConfig) GetEventWebhookSecret() string {
+	return c.Events.WebhookSecret
+}
+
+// Email validation configuration
+func (c *AppConfig) IsEmailMXCheckEnabled() bool {
+	return c.EmailValidation.CheckMX
+}
+
+func (c *AppConfig) GetEmailMXTimeout() time.Duration {
+	return time.Duration(c.EmailValidation.MXTimeout) * time.Second
+}
+
+func (c *AppConfig) IsDisposableEmailBlocked() bool {
+	return c.EmailValidation.BlockDisposable
+}
+
+func (c *AppConfig) GetBlockedEmailDomains() []string {
+	return c.EmailValidation.BlockedDomains
+}
+
+func (c *AppConfig) GetAllowedEmailDomains() []string {
+	return c.EmailValidation.AllowedDomains
+}
+
+func (c *AppConfig) GetEmailBlocklistFile() string {
+	return c.EmailValidation.BlocklistFile
+}
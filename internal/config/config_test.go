@@ -0,0 +1,156 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hrz8/altalune"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCaptcha(t *testing.T) {
+	c := &AppConfig{Auth: &AuthConfig{Captcha: &CaptchaConfig{
+		Provider:  "turnstile",
+		SiteKey:   "site",
+		SecretKey: "secret",
+		Projects: []CaptchaProjectConfig{
+			{ProjectID: "abc", Provider: "hcaptcha", SiteKey: "abc-site", SecretKey: "abc-secret"},
+			{ProjectID: "def", Provider: "none"},
+			{ProjectID: "ghi"},
+		},
+	}}}
+
+	assert.Equal(t, altalune.CaptchaConfig{Provider: "turnstile", SiteKey: "site", SecretKey: "secret"}, c.GetCaptcha(""))
+	assert.Equal(t, altalune.CaptchaConfig{Provider: "hcaptcha", SiteKey: "abc-site", SecretKey: "abc-secret"}, c.GetCaptcha("abc"))
+	assert.Equal(t, altalune.CaptchaConfig{}, c.GetCaptcha("def"), "none disables the challenge of the project")
+	assert.Equal(t, c.GetCaptcha(""), c.GetCaptcha("ghi"), "no provider inherits the default challenge")
+	assert.Equal(t, []string{"hcaptcha", "turnstile"}, c.GetCaptchaProviders())
+}
+
+func TestGetCORSOrigins(t *testing.T) {
+	c := &AppConfig{Security: &SecurityConfig{
+		AllowedOrigins: []string{"https://dashboard.example.com"},
+		CORS: &CORSConfig{ProjectOrigins: map[string][]string{
+			"abc": {"https://abc.example.com"},
+			"def": {"https://def.example.com", "https://abc.example.com"},
+		}},
+	}}
+
+	assert.Equal(t, []string{"https://dashboard.example.com"}, c.GetCORSAllowedOrigins(), "project origins are not allowed for every project")
+	assert.Equal(t, map[string][]string{
+		"abc": {"https://abc.example.com"},
+		"def": {"https://def.example.com", "https://abc.example.com"},
+	}, c.GetCORSProjectOrigins())
+	assert.False(t, c.IsCORSAllowCredentials())
+}
+
+func TestValidateCORSCredentials(t *testing.T) {
+	c := &AppConfig{}
+	require.NoError(t, decodeFile("../../config.example.yaml", c))
+	c.setDefaults()
+	require.NoError(t, c.Validate())
+
+	allowCredentials := true
+	c.Security.CORS.AllowCredentials = &allowCredentials
+	assert.EqualError(t, c.Validate(), `security.cors.allowCredentials cannot be combined with "*" in security.allowedOrigins`)
+
+	c.Security.AllowedOrigins = []string{"https://app.example.com"}
+	assert.NoError(t, c.Validate())
+}
+
+func TestFrontendDefaults(t *testing.T) {
+	c := &AppConfig{}
+	assert.True(t, c.IsFrontendEnabled(), "served without a frontend section")
+	assert.Empty(t, c.GetFrontendDir(), "embedded build by default")
+
+	disabled := false
+	c.Frontend = &FrontendConfig{Enabled: &disabled, Dir: "/srv/frontend"}
+	assert.False(t, c.IsFrontendEnabled())
+	assert.Equal(t, "/srv/frontend", c.GetFrontendDir())
+}
+
+func TestServerListenOptions(t *testing.T) {
+	c := &AppConfig{}
+	require.NoError(t, decodeFile("../../config.example.yaml", c))
+	c.setDefaults()
+	require.NoError(t, c.Validate())
+	assert.True(t, c.IsH2CEnabled(), "h2c is on by default")
+	assert.Empty(t, c.GetServerBindHost(), "listens on all interfaces by default")
+
+	certFile := filepath.Join(t.TempDir(), "cert.pem")
+	require.NoError(t, os.WriteFile(certFile, []byte("cert"), 0o600))
+	c.Server.TLSCertFile = certFile
+	assert.Error(t, c.Validate(), "a certificate requires its key")
+
+	c.Server.TLSKeyFile = certFile + ".missing"
+	assert.Error(t, c.Validate(), "the key file must exist")
+
+	c.Server.TLSKeyFile = certFile
+	c.Server.BindHost = "127.0.0.1"
+	assert.NoError(t, c.Validate())
+
+	c.Server.BindHost = "not a host"
+	assert.Error(t, c.Validate())
+}
+
+func TestValidateACME(t *testing.T) {
+	c := &AppConfig{}
+	require.NoError(t, decodeFile("../../config.example.yaml", c))
+	c.setDefaults()
+	assert.False(t, c.IsACMEEnabled())
+	assert.Equal(t, ".acme", c.GetACMECacheDir())
+	assert.Equal(t, 80, c.GetACMEHTTPPort())
+
+	c.ACME.Enabled = true
+	assert.EqualError(t, c.Validate(), "acme.enabled requires acme.domains")
+
+	c.ACME.Domains = []string{"api.example.com"}
+	require.NoError(t, c.Validate())
+
+	c.Server.TLSCertFile = "../../config.example.yaml"
+	c.Server.TLSKeyFile = "../../config.example.yaml"
+	assert.EqualError(t, c.Validate(), "acme.enabled cannot be combined with server.tlsCertFile and server.tlsKeyFile")
+}
+
+func TestValidateAuthEmbedded(t *testing.T) {
+	c := &AppConfig{}
+	require.NoError(t, decodeFile("../../config.example.yaml", c))
+	c.setDefaults()
+	c.Auth.Embedded = true
+	require.NoError(t, c.Validate())
+	assert.True(t, c.IsAuthEmbedded())
+
+	c.Auth.Port = c.Server.Port
+	assert.EqualError(t, c.Validate(), "auth.embedded requires auth.port or auth.bindHost to differ from the server's")
+
+	c.Auth.BindHost = "127.0.0.1"
+	assert.NoError(t, c.Validate(), "the same port on another interface")
+
+	c.Auth.Embedded = false
+	c.Auth.BindHost = ""
+	assert.NoError(t, c.Validate(), "only checked when embedded")
+}
+
+func TestValidateMigrateOnStart(t *testing.T) {
+	c := &AppConfig{}
+	require.NoError(t, decodeFile("../../config.example.yaml", c))
+	c.setDefaults()
+	require.NoError(t, c.Validate())
+	assert.Equal(t, "off", c.GetDatabaseMigrateOnStart())
+	assert.Equal(t, 5*time.Minute, c.GetDatabaseMigrateWaitTimeout())
+
+	for _, mode := range []string{"off", "wait", "apply"} {
+		c.Database.MigrateOnStart = mode
+		assert.NoError(t, c.Validate(), mode)
+	}
+
+	c.Database.MigrateOnStart = "always"
+	assert.Error(t, c.Validate())
+
+	c.Database.MigrateOnStart = "wait"
+	c.Database.MigrateWaitTimeout = -1
+	assert.Error(t, c.Validate())
+}
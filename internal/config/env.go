@@ -0,0 +1,199 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// EnvPrefix starts the name of every environment variable overriding a key
+const EnvPrefix = "ALTALUNE"
+
+// applyEnv overrides the keys of the config file with the ALTALUNE_*
+// environment variables, so one config file can serve several deployments.
+// The variable of a key is its YAML path in upper snake case, e.g.
+// ALTALUNE_SERVER_TLS_CERT_FILE for server.tlsCertFile, unless the field has
+// an env tag. Lists are comma-separated, maps are comma-separated key=value
+// pairs whose list values are space-separated, and list entries such as
+// seeder.oauthProviders are addressed by index, e.g.
+// ALTALUNE_SEEDER_OAUTH_PROVIDERS_0_CLIENT_SECRET. Sections missing from the
+// file are created when a variable sets one of their keys.
+func (c *AppConfig) applyEnv() error {
+	env := make(map[string]string)
+	for _, kv := range os.Environ() {
+		if name, value, ok := strings.Cut(kv, "="); ok && strings.HasPrefix(name, EnvPrefix+"_") {
+			env[name] = value
+		}
+	}
+	return applyEnvStruct(reflect.ValueOf(c).Elem(), EnvPrefix, env)
+}
+
+func applyEnvStruct(v reflect.Value, prefix string, env map[string]string) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		key, ok := yamlKey(t.Field(i))
+		if !ok {
+			continue
+		}
+		if err := applyEnvValue(v.Field(i), envName(t.Field(i), prefix, key), env); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func applyEnvValue(v reflect.Value, name string, env map[string]string) error {
+	switch {
+	case v.Kind() == reflect.Struct:
+		return applyEnvStruct(v, name, env)
+
+	case v.Kind() == reflect.Ptr && v.Type().Elem().Kind() == reflect.Struct:
+		if v.IsNil() {
+			if !hasEnvPrefix(env, name+"_") {
+				return nil
+			}
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return applyEnvStruct(v.Elem(), name, env)
+
+	case v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Struct:
+		for i := 0; ; i++ {
+			entry := name + "_" + strconv.Itoa(i)
+			if i >= v.Len() {
+				if !hasEnvPrefix(env, entry+"_") {
+					return nil
+				}
+				v.Set(reflect.Append(v, reflect.Zero(v.Type().Elem())))
+			}
+			if err := applyEnvStruct(v.Index(i), entry, env); err != nil {
+				return err
+			}
+		}
+	}
+
+	raw, ok := env[name]
+	if !ok {
+		return nil
+	}
+	if err := setEnvValue(v, raw, ","); err != nil {
+		return fmt.Errorf("invalid %s: %w", name, err)
+	}
+	return nil
+}
+
+// setEnvValue parses raw into v, splitting lists on sep
+func setEnvValue(v reflect.Value, raw, sep string) error {
+	switch v.Kind() {
+	case reflect.Ptr:
+		value := reflect.New(v.Type().Elem())
+		if err := setEnvValue(value.Elem(), raw, sep); err != nil {
+			return err
+		}
+		v.Set(value)
+	case reflect.String:
+		v.SetString(raw)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(raw, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Slice:
+		items := splitList(raw, sep)
+		list := reflect.MakeSlice(v.Type(), len(items), len(items))
+		for i, item := range items {
+			if err := setEnvValue(list.Index(i), item, sep); err != nil {
+				return err
+			}
+		}
+		v.Set(list)
+	case reflect.Map:
+		m := reflect.MakeMap(v.Type())
+		for _, pair := range splitList(raw, ",") {
+			k, val, ok := strings.Cut(pair, "=")
+			if !ok {
+				return fmt.Errorf("%q is not a key=value pair", pair)
+			}
+			value := reflect.New(v.Type().Elem()).Elem()
+			if err := setEnvValue(value, strings.TrimSpace(val), " "); err != nil {
+				return err
+			}
+			m.SetMapIndex(reflect.ValueOf(strings.TrimSpace(k)), value)
+		}
+		v.Set(m)
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+	return nil
+}
+
+func splitList(raw, sep string) []string {
+	var items []string
+	for _, item := range strings.Split(raw, sep) {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func hasEnvPrefix(env map[string]string, prefix string) bool {
+	for name := range env {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// yamlKey returns the YAML key of a config field
+func yamlKey(f reflect.StructField) (string, bool) {
+	key, _, _ := strings.Cut(f.Tag.Get("yaml"), ",")
+	if key == "" || key == "-" || !f.IsExported() {
+		return "", false
+	}
+	return key, true
+}
+
+// envName returns the environment variable overriding a config field nested
+// under prefix
+func envName(f reflect.StructField, prefix, key string) string {
+	if name := f.Tag.Get("env"); name != "" {
+		return name
+	}
+	return prefix + "_" + screamingSnake(key)
+}
+
+// screamingSnake converts a camelCase key to upper snake case, keeping
+// acronyms together: tlsCertFile is TLS_CERT_FILE and authServerCSP is
+// AUTH_SERVER_CSP.
+func screamingSnake(key string) string {
+	runes := []rune(key)
+	var b strings.Builder
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			prevLower := unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1])
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if prevLower || (unicode.IsUpper(runes[i-1]) && nextLower) {
+				b.WriteByte('_')
+			}
+		}
+		b.WriteRune(unicode.ToUpper(r))
+	}
+	return b.String()
+}
@@ -0,0 +1,79 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScreamingSnake(t *testing.T) {
+	assert.Equal(t, "TLS_CERT_FILE", screamingSnake("tlsCertFile"))
+	assert.Equal(t, "AUTH_SERVER_CSP", screamingSnake("authServerCSP"))
+	assert.Equal(t, "ENABLE_CORS", screamingSnake("enableCORS"))
+	assert.Equal(t, "AUTH_BASE_URL", screamingSnake("authBaseURL"))
+	assert.Equal(t, "H2C", screamingSnake("h2c"))
+	assert.Equal(t, "DASHBOARD_OAUTH", screamingSnake("dashboardOauth"))
+}
+
+func TestApplyEnv(t *testing.T) {
+	t.Setenv("ALTALUNE_SERVER_BIND_HOST", "0.0.0.0")
+	t.Setenv("ALTALUNE_SERVER_PORT", "8080")
+	t.Setenv("ALTALUNE_SERVER_H2C", "false")
+	t.Setenv("ALTALUNE_SECURITY_ALLOWED_ORIGINS", "https://a.example.com, https://b.example.com")
+	t.Setenv("ALTALUNE_SECURITY_CORS_PROJECT_ORIGINS", "abc=https://a.example.com https://b.example.com,def=https://c.example.com")
+	t.Setenv("ALTALUNE_LOGGING_MODULES", "oauth=debug,http=warn")
+	t.Setenv("ALTALUNE_REDIS_ENABLED", "true")
+	t.Setenv("ALTALUNE_SEEDER_OAUTH_PROVIDERS_0_CLIENT_SECRET", "overridden")
+	t.Setenv("ALTALUNE_SEEDER_OAUTH_PROVIDERS_1_PROVIDER", "github")
+
+	c := &AppConfig{
+		Server:   &ServerConfig{Port: 3100},
+		Security: &SecurityConfig{},
+		Seeder: &SeederConfig{OAuthProviders: []OAuthProviderConfig{
+			{Provider: "google", ClientSecret: "from-file"},
+		}},
+	}
+	require.NoError(t, c.applyEnv())
+
+	assert.Equal(t, "0.0.0.0", c.Server.BindHost)
+	assert.Equal(t, 8080, c.Server.Port)
+	require.NotNil(t, c.Server.H2C)
+	assert.False(t, *c.Server.H2C)
+	assert.Equal(t, []string{"https://a.example.com", "https://b.example.com"}, c.Security.AllowedOrigins)
+	assert.Equal(t, map[string][]string{
+		"abc": {"https://a.example.com", "https://b.example.com"},
+		"def": {"https://c.example.com"},
+	}, c.Security.CORS.ProjectOrigins)
+	assert.Equal(t, map[string]string{"oauth": "debug", "http": "warn"}, c.Logging.Modules)
+
+	// Sections missing from the file are created, untouched ones stay nil
+	require.NotNil(t, c.Redis)
+	assert.True(t, c.Redis.Enabled)
+	assert.Nil(t, c.ACME)
+
+	require.Len(t, c.Seeder.OAuthProviders, 2)
+	assert.Equal(t, "google", c.Seeder.OAuthProviders[0].Provider)
+	assert.Equal(t, "overridden", c.Seeder.OAuthProviders[0].ClientSecret)
+	assert.Equal(t, "github", c.Seeder.OAuthProviders[1].Provider)
+}
+
+func TestApplyEnvInvalid(t *testing.T) {
+	t.Setenv("ALTALUNE_SERVER_PORT", "http")
+
+	c := &AppConfig{Server: &ServerConfig{}}
+	assert.ErrorContains(t, c.applyEnv(), "invalid ALTALUNE_SERVER_PORT")
+}
+
+func TestFields(t *testing.T) {
+	byKey := make(map[string]Field)
+	for _, f := range Fields() {
+		byKey[f.Key] = f
+	}
+
+	assert.Equal(t, "ALTALUNE_SERVER_TLS_CERT_FILE", byKey["server.tlsCertFile"].Env)
+	assert.Equal(t, "TLSCertFile", byKey["server.tlsCertFile"].Name)
+	assert.Equal(t, "SecurityHeadersConfig", byKey["security.headers"].Section)
+	assert.Equal(t, "ALTALUNE_SEEDER_OAUTH_PROVIDERS_<N>_CLIENT_SECRET", byKey["seeder.oauthProviders[N].clientSecret"].Env)
+	assert.Equal(t, "map of list of string", byKey["security.cors.projectOrigins"].Type)
+}
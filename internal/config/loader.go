@@ -1,8 +1,13 @@
 package config
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
 	"sync"
 
 	"github.com/hrz8/altalune"
@@ -15,22 +20,42 @@ var (
 	once         sync.Once
 )
 
+// profilePattern restricts profile names, which become part of a file name
+var profilePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
 func Load(filename string) (altalune.Config, error) {
+	return LoadProfile(filename, "")
+}
+
+// LoadProfile loads filename with the profile file next to it merged on top,
+// e.g. config.staging.yaml for config.yaml and the staging profile, then the
+// ALTALUNE_* environment variables. The profile file only holds the keys that
+// differ: its sections are merged key by key into the base file's, while
+// lists and plain values replace the base ones. An empty profile loads the
+// base file alone.
+func LoadProfile(filename, profile string) (altalune.Config, error) {
 	var loadErr error
 
 	once.Do(func() {
-		file, err := os.Open(filename)
-		if err != nil {
-			loadErr = fmt.Errorf("failed to open config file: %w", err)
-			return
+		files := []string{filename}
+		if profile != "" {
+			if !profilePattern.MatchString(profile) {
+				loadErr = fmt.Errorf("invalid config profile %q", profile)
+				return
+			}
+			files = append(files, ProfileFile(filename, profile))
 		}
-		defer file.Close()
-
-		decoder := yaml.NewDecoder(file)
 
 		cachedConfig = &AppConfig{}
-		if err := decoder.Decode(cachedConfig); err != nil {
-			loadErr = fmt.Errorf("failed to decode config file: %w", err)
+		for _, file := range files {
+			if err := decodeFile(file, cachedConfig); err != nil {
+				loadErr = err
+				return
+			}
+		}
+
+		if err := cachedConfig.applyEnv(); err != nil {
+			loadErr = err
 			return
 		}
 
@@ -44,3 +69,23 @@ func Load(filename string) (altalune.Config, error) {
 
 	return cachedConfig, loadErr
 }
+
+// ProfileFile returns the file of profile next to the base config file
+func ProfileFile(filename, profile string) string {
+	ext := filepath.Ext(filename)
+	return strings.TrimSuffix(filename, ext) + "." + profile + ext
+}
+
+// decodeFile decodes a YAML file into c, keeping the keys it does not set
+func decodeFile(filename string, c *AppConfig) error {
+	file, err := os.Open(filename)
+	if err != nil {
+		return fmt.Errorf("failed to open config file: %w", err)
+	}
+	defer file.Close()
+
+	if err := yaml.NewDecoder(file).Decode(c); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("failed to decode config file %s: %w", filename, err)
+	}
+	return nil
+}
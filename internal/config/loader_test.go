@@ -0,0 +1,52 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfileFile(t *testing.T) {
+	assert.Equal(t, "config.staging.yaml", ProfileFile("config.yaml", "staging"))
+	assert.Equal(t, "deploy/app.prod.yml", ProfileFile("deploy/app.yml", "prod"))
+}
+
+func TestDecodeFileLayers(t *testing.T) {
+	dir := t.TempDir()
+	base := filepath.Join(dir, "config.yaml")
+	require.NoError(t, os.WriteFile(base, []byte(`
+server:
+  host: localhost
+  port: 3100
+security:
+  allowedOrigins: ["http://localhost:8180"]
+logging:
+  modules: {oauth: debug}
+`), 0o600))
+	profile := ProfileFile(base, "prod")
+	require.NoError(t, os.WriteFile(profile, []byte(`
+server:
+  port: 443
+security:
+  allowedOrigins: ["https://app.example.com"]
+logging:
+  format: json
+  modules: {http: warn}
+`), 0o600))
+
+	c := &AppConfig{}
+	require.NoError(t, decodeFile(base, c))
+	require.NoError(t, decodeFile(profile, c))
+
+	// Sections merge key by key, lists and values are replaced
+	assert.Equal(t, "localhost", c.Server.Host)
+	assert.Equal(t, 443, c.Server.Port)
+	assert.Equal(t, []string{"https://app.example.com"}, c.Security.AllowedOrigins)
+	assert.Equal(t, "json", c.Logging.Format)
+	assert.Equal(t, map[string]string{"oauth": "debug", "http": "warn"}, c.Logging.Modules)
+
+	assert.Error(t, decodeFile(ProfileFile(base, "missing"), c))
+}
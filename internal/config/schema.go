@@ -0,0 +1,84 @@
+package config
+
+import "reflect"
+
+// Field describes one key of the config file, as listed by the generated
+// configuration reference
+type Field struct {
+	Key      string // Dotted YAML path, e.g. server.tlsCertFile; list entries are [N]
+	Env      string // Environment variable overriding the key; list entries are _<N>
+	Type     string // YAML type of the value
+	Validate string // Validation rules of the value
+	Struct   string // Go struct declaring the key, e.g. ServerConfig
+	Name     string // Go field of the key, e.g. TLSCertFile
+	Section  string // Go struct of the nested keys when the key is a section
+}
+
+// Fields returns every key of the config file in declaration order, sections
+// before the keys they hold
+func Fields() []Field {
+	return schemaFields(reflect.TypeOf(AppConfig{}), "", EnvPrefix)
+}
+
+func schemaFields(t reflect.Type, keyPrefix, envPrefix string) []Field {
+	var fields []Field
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		key, ok := yamlKey(f)
+		if !ok {
+			continue
+		}
+
+		field := Field{
+			Key:      keyPrefix + key,
+			Env:      envName(f, envPrefix, key),
+			Type:     schemaType(f.Type),
+			Validate: f.Tag.Get("validate"),
+			Struct:   t.Name(),
+			Name:     f.Name,
+		}
+
+		ft := f.Type
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		switch {
+		case ft.Kind() == reflect.Struct:
+			field.Section = ft.Name()
+			field.Env = ""
+			fields = append(fields, field)
+			fields = append(fields, schemaFields(ft, field.Key+".", envName(f, envPrefix, key))...)
+		case ft.Kind() == reflect.Slice && ft.Elem().Kind() == reflect.Struct:
+			field.Section = ft.Elem().Name()
+			field.Env = ""
+			fields = append(fields, field)
+			fields = append(fields, schemaFields(ft.Elem(), field.Key+"[N].", envName(f, envPrefix, key)+"_<N>")...)
+		default:
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// schemaType names the YAML type of a config value
+func schemaType(t reflect.Type) string {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Struct:
+		return "object"
+	case reflect.Slice:
+		return "list of " + schemaType(t.Elem())
+	case reflect.Map:
+		return "map of " + schemaType(t.Elem())
+	}
+	return t.String()
+}
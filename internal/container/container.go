@@ -3,32 +3,60 @@ package container
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"buf.build/go/protovalidate"
 	"github.com/hrz8/altalune"
 	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
-	greeterv1 "github.com/hrz8/altalune/gen/greeter/v1"
 
+	activity_domain "github.com/hrz8/altalune/internal/domain/activity"
 	api_key_domain "github.com/hrz8/altalune/internal/domain/api_key"
+	billing_domain "github.com/hrz8/altalune/internal/domain/billing"
 	chatbot_domain "github.com/hrz8/altalune/internal/domain/chatbot"
 	chatbot_node_domain "github.com/hrz8/altalune/internal/domain/chatbot_node"
+	digest_domain "github.com/hrz8/altalune/internal/domain/digest"
 	employee_domain "github.com/hrz8/altalune/internal/domain/employee"
+	feature_flag_domain "github.com/hrz8/altalune/internal/domain/feature_flag"
 	greeter_domain "github.com/hrz8/altalune/internal/domain/greeter"
 	iam_mapper_domain "github.com/hrz8/altalune/internal/domain/iam_mapper"
+	maintenance_domain "github.com/hrz8/altalune/internal/domain/maintenance"
 	oauth_auth_domain "github.com/hrz8/altalune/internal/domain/oauth_auth"
 	oauth_client_domain "github.com/hrz8/altalune/internal/domain/oauth_client"
 	oauth_provider_domain "github.com/hrz8/altalune/internal/domain/oauth_provider"
 	permission_domain "github.com/hrz8/altalune/internal/domain/permission"
+	organization_domain "github.com/hrz8/altalune/internal/domain/organization"
 	project_domain "github.com/hrz8/altalune/internal/domain/project"
+	project_branding_domain "github.com/hrz8/altalune/internal/domain/project_branding"
+	project_hostname_domain "github.com/hrz8/altalune/internal/domain/project_hostname"
+	query_export_domain "github.com/hrz8/altalune/internal/domain/query_export"
 	role_domain "github.com/hrz8/altalune/internal/domain/role"
+	saved_view_domain "github.com/hrz8/altalune/internal/domain/saved_view"
+	usage_domain "github.com/hrz8/altalune/internal/domain/usage"
 	user_domain "github.com/hrz8/altalune/internal/domain/user"
+	"github.com/hrz8/altalune/internal/featureflag"
 	"github.com/hrz8/altalune/internal/postgres"
+	"github.com/hrz8/altalune/internal/redis"
 	"github.com/hrz8/altalune/internal/session"
 	"github.com/hrz8/altalune/internal/auth"
+	"github.com/hrz8/altalune/internal/shared/cookie"
+	"github.com/hrz8/altalune/internal/shared/crypto"
+	"github.com/hrz8/altalune/internal/shared/dbmetrics"
+	"github.com/hrz8/altalune/internal/shared/emailcheck"
+	"github.com/hrz8/altalune/internal/shared/errorreport"
+	"github.com/hrz8/altalune/internal/shared/events"
+	"github.com/hrz8/altalune/internal/shared/filestore"
 	"github.com/hrz8/altalune/internal/shared/jwt"
 	"github.com/hrz8/altalune/internal/shared/notification"
 	"github.com/hrz8/altalune/internal/shared/notification/email"
+	"github.com/hrz8/altalune/internal/shared/password"
+	"github.com/hrz8/altalune/internal/shared/query"
+	"github.com/hrz8/altalune/internal/shared/realip"
+	"github.com/hrz8/altalune/internal/shared/scheduler"
+	"github.com/hrz8/altalune/internal/shared/secretdelivery"
+	"github.com/hrz8/altalune/internal/shared/trash"
 	"github.com/hrz8/altalune/logger"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
 
 	migration_domain "github.com/hrz8/altalune/internal/domain/migration"
 )
@@ -39,8 +67,17 @@ type Container struct {
 	config altalune.Config
 	logger altalune.Logger
 
-	// Database connection and manager
-	db postgres.DB
+	// Database connection and manager, and the scoped view of it every
+	// repository runs its statements on
+	db       postgres.DB
+	scopedDB *postgres.ScopedDB
+
+	// Shared key-value store, backed by Redis when enabled (redisClient is nil otherwise)
+	redisClient *redis.Client
+	store       redis.Store
+
+	// Hands over the secrets generated on creation as the credential policies require
+	secretDeliverer *secretdelivery.Deliverer
 
 	// Migrations
 	migrationRepo    migration_domain.Migrator
@@ -71,52 +108,108 @@ type Container struct {
 	// OTP and Verification Repositories
 	otpRepo              oauth_auth_domain.OTPRepositor
 	otpUserRepo          oauth_auth_domain.UserLookupRepositor
+	lockoutUserRepo      oauth_auth_domain.UserLockoutRepositor
 	verificationUserRepo oauth_auth_domain.UserEmailVerificationRepositor
 	verificationRepo     oauth_auth_domain.EmailVerificationRepositor
+	rememberTokenRepo    oauth_auth_domain.RememberTokenRepositor
 
 	// Repositories
-	projectRepo project_domain.Repositor
+	projectRepo         project_domain.Repositor
+	projectHostnameRepo project_hostname_domain.Repositor
+	projectBrandingRepo project_branding_domain.Repositor
+	featureFlagRepo     featureflag.Repositor
+	usageRepo           usage_domain.Repositor
+	organizationRepo    organization_domain.Repositor
+	billingRepo         billing_domain.Repositor
+	savedViewRepo       saved_view_domain.Repositor
+	queryExportRepo     query_export_domain.Repositor
+	activityRepo        activity_domain.Repositor
 
 	// Shared Providers (available across the app)
 	notificationService *notification.NotificationService
+	trashJanitor        *trash.Janitor
+	queryExportFiles    filestore.Store
+	queryExportWorker   *query_export_domain.Worker
+	scheduler           *scheduler.Scheduler
+	clientResolver      *realip.Resolver
+	emailChecker        *emailcheck.Checker
+	maintenanceSwitch   *maintenance_domain.Switch
+	featureFlags        *featureflag.Flags
+	usageMeter          *usage_domain.Meter
+	billingCatalog      *billing_domain.Catalog
+	billingLimiter      *billing_domain.Limiter
+	billingWebhook      *billing_domain.Webhook
+	metricsRegistry     *prometheus.Registry
+	errorReporter       errorreport.Reporter
 
 	// Example Services
-	greeterService  greeterv1.GreeterServiceServer
-	employeeService altalunev1.EmployeeServiceServer
+	greeterService  *greeter_domain.Service
+	employeeService *employee_domain.Service
 
 	// Domain Services
-	projectService       altalunev1.ProjectServiceServer
-	apiKeyService        altalunev1.ApiKeyServiceServer
-	chatbotService       altalunev1.ChatbotServiceServer
-	chatbotNodeService   altalunev1.ChatbotNodeServiceServer
-	userService          altalunev1.UserServiceServer
-	roleService          altalunev1.RoleServiceServer
-	permissionService    altalunev1.PermissionServiceServer
-	iamMapperService     altalunev1.IAMMapperServiceServer
-	oauthProviderService altalunev1.OAuthProviderServiceServer
-	oauthClientService   altalunev1.OAuthClientServiceServer
+	projectService         altalunev1.ProjectServiceServer
+	projectHostnameService altalunev1.ProjectHostnameServiceServer
+	projectBrandingService altalunev1.ProjectBrandingServiceServer
+	apiKeyService          altalunev1.ApiKeyServiceServer
+	chatbotService         altalunev1.ChatbotServiceServer
+	chatbotNodeService     altalunev1.ChatbotNodeServiceServer
+	userService            *user_domain.Service
+	roleService            altalunev1.RoleServiceServer
+	permissionService      altalunev1.PermissionServiceServer
+	iamMapperService       altalunev1.IAMMapperServiceServer
+	oauthProviderService   altalunev1.OAuthProviderServiceServer
+	oauthClientService     altalunev1.OAuthClientServiceServer
+	featureFlagService     altalunev1.FeatureFlagServiceServer
+	usageService           altalunev1.UsageServiceServer
+	organizationService    altalunev1.OrganizationServiceServer
+	billingService         altalunev1.BillingServiceServer
+	savedViewService       altalunev1.SavedViewServiceServer
+	queryExportService     altalunev1.QueryExportServiceServer
+	activityService        altalunev1.ActivityServiceServer
 
 	// Auth Server Components (conditionally initialized)
 	jwtSigner                *jwt.Signer
 	sessionStore             *session.Store
 	oauthAuthService         *oauth_auth_domain.Service
 	otpService               *oauth_auth_domain.OTPService
+	approvalNotifier         *oauth_auth_domain.ApprovalNotifier
 	emailVerificationService *oauth_auth_domain.EmailVerificationService
+	rememberMeService        *oauth_auth_domain.RememberMeService
+	eventBus                 *events.Bus
 
 	// Resource Server Auth Components (for JWT validation)
-	jwtValidator *auth.JWTValidator
-	authorizer   *auth.Authorizer
+	jwtValidator       *auth.JWTValidator
+	authorizer         *auth.Authorizer
+	permissionResolver *oauth_auth_domain.PermissionResolver
 }
 
 // CreateContainer creates a new dependency injection container with proper error handling
 func CreateContainer(ctx context.Context, cfg altalune.Config) (*Container, error) {
+	// Errors logged at error level are reported when an error tracker is
+	// configured
+	var reporter errorreport.Reporter = errorreport.Nop{}
+	var onError logger.ErrorHook
+	if dsn := cfg.GetErrorReportDSN(); dsn != "" {
+		sentry, err := errorreport.NewSentry(dsn, errorreport.WithEnvironment(cfg.GetErrorReportEnvironment()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize error reporting: %w", err)
+		}
+		reporter, onError = sentry, errorreport.LogHook(sentry)
+	}
+
 	container := &Container{
 		config: cfg,
-		logger: logger.New(cfg.GetServerLogLevel()),
+		logger: logger.NewWithOptions(logger.Options{
+			Level:   cfg.GetServerLogLevel(),
+			Format:  cfg.GetLogFormat(),
+			Modules: cfg.GetLogModuleLevels(),
+			OnError: onError,
+		}),
+		errorReporter: reporter,
 	}
 
 	// Initialize components in dependency order:
-	// 1. Database connection
+	// 1. Database and Redis connections
 	// 2. Repositories (data access layer)
 	// 3. Providers (shared infrastructure services like notification)
 	// 4. Services (domain business logic)
@@ -124,6 +217,9 @@ func CreateContainer(ctx context.Context, cfg altalune.Config) (*Container, erro
 	if err := container.initDatabase(ctx); err != nil {
 		return nil, fmt.Errorf("failed to initialize database: %w", err)
 	}
+	if err := container.initRedis(ctx); err != nil {
+		return nil, fmt.Errorf("failed to initialize redis: %w", err)
+	}
 	if err := container.initRepositories(); err != nil {
 		return nil, fmt.Errorf("failed to initialize repositories: %w", err)
 	}
@@ -139,40 +235,90 @@ func CreateContainer(ctx context.Context, cfg altalune.Config) (*Container, erro
 // Private initialization methods
 func (c *Container) initDatabase(ctx context.Context) error {
 	conn := postgres.MustConnect(postgres.ConnectionOptions{
-		URL:            c.config.GetDatabaseURL(),
-		MaxConnections: c.config.GetDatabaseMaxConnections(),
-		MaxIdleTime:    c.config.GetDatabaseMaxIdleTime(),
-		ConnectTimeout: c.config.GetDatabaseConnectTimeout(),
+		URL:                c.config.GetDatabaseURL(),
+		MaxConnections:     c.config.GetDatabaseMaxConnections(),
+		MaxIdleTime:        c.config.GetDatabaseMaxIdleTime(),
+		ConnectTimeout:     c.config.GetDatabaseConnectTimeout(),
+		StatementTimeout:   c.config.GetDatabaseStatementTimeout(),
+		SlowQueryThreshold: c.config.GetDatabaseSlowQueryThreshold(),
+		Logger:             c.logger,
+		CountMode:          query.ParseCountMode(c.config.GetDatabaseCountMode()),
+		ExactCountLimit:    c.config.GetDatabaseExactCountLimit(),
+		RowLevelSecurity:   c.config.IsDatabaseRowLevelSecurityEnabled(),
 	})
 	if err := conn.TestConnection(ctx); err != nil {
 		return fmt.Errorf("database connection test failed: %w", err)
 	}
 	c.db = conn
+	c.scopedDB = postgres.NewScopedDB(conn, c.config.IsDatabaseRowLevelSecurityEnabled())
+	return nil
+}
+
+func (c *Container) initRedis(ctx context.Context) error {
+	if !c.config.IsRedisEnabled() {
+		c.store = redis.NewMemoryStore()
+		return nil
+	}
+
+	client := redis.NewClient(redis.ConnectionOptions{
+		Addr:        c.config.GetRedisAddr(),
+		Username:    c.config.GetRedisUsername(),
+		Password:    c.config.GetRedisPassword(),
+		DB:          c.config.GetRedisDB(),
+		TLS:         c.config.IsRedisTLS(),
+		PoolSize:    c.config.GetRedisPoolSize(),
+		DialTimeout: c.config.GetRedisDialTimeout(),
+		IOTimeout:   c.config.GetRedisIOTimeout(),
+		KeyPrefix:   c.config.GetRedisKeyPrefix(),
+	})
+	if err := client.TestConnection(ctx); err != nil {
+		client.Close()
+		return fmt.Errorf("redis connection test failed: %w", err)
+	}
+	c.redisClient = client
+	c.store = client
 	return nil
 }
 
 func (c *Container) initRepositories() error {
 	c.migrationRepo = migration_domain.NewAltaluneMigrationRepo(c.db)
-	c.greeterRepo = greeter_domain.NewRepo()
-	c.employeeRepo = employee_domain.NewRepo(c.db)
-	c.projectRepo = project_domain.NewRepo(c.db)
-	c.apiKeyRepo = api_key_domain.NewRepo(c.db)
-	c.chatbotRepo = chatbot_domain.NewRepo(c.db)
-	c.chatbotNodeRepo = chatbot_node_domain.NewRepo(c.db)
-	c.userRepo = user_domain.NewRepo(c.db)
-	c.roleRepo = role_domain.NewRepo(c.db)
-	c.permissionRepo = permission_domain.NewRepo(c.db)
-	c.iamMapperRepo = iam_mapper_domain.NewRepo(c.db)
-	c.oauthProviderRepo = oauth_provider_domain.NewRepo(c.db, c.config.GetIAMEncryptionKey())
-	c.oauthClientRepo = oauth_client_domain.NewRepo(c.db)
-	c.oauthAuthRepo = oauth_auth_domain.NewRepo(c.db)
+	// Repositories run their statements in the transaction of the project
+	// scope, if any, for the row-level security policies
+	c.greeterRepo = greeter_domain.NewRepo(c.scopedDB)
+	c.employeeRepo = employee_domain.NewRepo(c.scopedDB)
+	c.projectRepo = project_domain.NewRepo(c.scopedDB)
+	c.projectHostnameRepo = project_hostname_domain.NewRepo(c.scopedDB)
+	c.projectBrandingRepo = project_branding_domain.NewRepo(c.scopedDB)
+	c.apiKeyRepo = api_key_domain.NewRepo(c.scopedDB)
+	c.chatbotRepo = chatbot_domain.NewRepo(c.scopedDB)
+	c.chatbotNodeRepo = chatbot_node_domain.NewRepo(c.scopedDB)
+	c.userRepo = user_domain.NewRepo(c.scopedDB)
+	c.roleRepo = role_domain.NewRepo(c.scopedDB)
+	c.permissionRepo = permission_domain.NewRepo(c.scopedDB)
+	c.iamMapperRepo = iam_mapper_domain.NewRepo(c.scopedDB)
+	c.featureFlagRepo = featureflag.NewRepo(c.scopedDB)
+	c.usageRepo = usage_domain.NewRepo(c.scopedDB)
+	c.organizationRepo = organization_domain.NewRepo(c.scopedDB)
+	c.billingRepo = billing_domain.NewRepo(c.scopedDB)
+	c.savedViewRepo = saved_view_domain.NewRepo(c.scopedDB)
+	c.queryExportRepo = query_export_domain.NewRepo(c.scopedDB)
+	c.activityRepo = activity_domain.NewRepo(c.scopedDB)
+	keyring, err := crypto.NewKeyring(c.config.GetIAMEncryptionKey(), c.config.GetIAMPreviousEncryptionKeys()...)
+	if err != nil {
+		return fmt.Errorf("invalid IAM encryption key: %w", err)
+	}
+	c.oauthProviderRepo = oauth_provider_domain.NewRepo(c.scopedDB, keyring)
+	c.oauthClientRepo = oauth_client_domain.NewRepo(c.scopedDB, password.OptionFromConfig(c.config))
+	c.oauthAuthRepo = oauth_auth_domain.NewRepo(c.scopedDB)
 
 	// OTP and Verification repositories
-	c.otpRepo = oauth_auth_domain.NewOTPRepo(c.db)
-	userRepo := oauth_auth_domain.NewUserRepo(c.db)
+	c.otpRepo = oauth_auth_domain.NewOTPRepo(c.scopedDB)
+	userRepo := oauth_auth_domain.NewUserRepo(c.scopedDB)
 	c.otpUserRepo = userRepo          // UserLookupRepositor for OTP service
+	c.lockoutUserRepo = userRepo      // UserLockoutRepositor for account lockout
 	c.verificationUserRepo = userRepo // UserEmailVerificationRepositor for verification service
-	c.verificationRepo = oauth_auth_domain.NewEmailVerificationRepo(c.db)
+	c.verificationRepo = oauth_auth_domain.NewEmailVerificationRepo(c.scopedDB)
+	c.rememberTokenRepo = oauth_auth_domain.NewRememberTokenRepo(c.scopedDB)
 	return nil
 }
 
@@ -210,6 +356,193 @@ func (c *Container) initProviders() error {
 		}
 	}
 
+	// Client resolver believes the forwarding headers of trusted proxies only
+	clientResolver, err := realip.NewResolver(c.config.GetTrustedProxies())
+	if err != nil {
+		return fmt.Errorf("security.trustedProxies: %w", err)
+	}
+	c.clientResolver = clientResolver
+
+	// Email checker screens the addresses the servers send emails to
+	emailChecker, err := emailcheck.New(emailcheck.Options{
+		CheckMX:         c.config.IsEmailMXCheckEnabled(),
+		MXTimeout:       c.config.GetEmailMXTimeout(),
+		BlockDisposable: c.config.IsDisposableEmailBlocked(),
+		BlockedDomains:  c.config.GetBlockedEmailDomains(),
+		AllowedDomains:  c.config.GetAllowedEmailDomains(),
+		BlocklistFile:   c.config.GetEmailBlocklistFile(),
+	})
+	if err != nil {
+		return fmt.Errorf("emailValidation: %w", err)
+	}
+	c.emailChecker = emailChecker
+
+	// Maintenance switch, forced on by the configuration or flipped at
+	// runtime through the database
+	c.maintenanceSwitch = maintenance_domain.NewSwitch(
+		maintenance_domain.NewRepo(c.scopedDB),
+		maintenance_domain.Options{
+			Enabled:      c.config.IsMaintenanceEnabled(),
+			Message:      c.config.GetMaintenanceMessage(),
+			RetryAfter:   c.config.GetMaintenanceRetryAfter(),
+			PollInterval: c.config.GetMaintenancePollInterval(),
+			BypassTokens: c.config.GetMaintenanceBypassTokens(),
+		},
+		c.logger.Module("maintenance"),
+	)
+
+	// Feature flags gating risky features, cached and reloaded periodically
+	c.featureFlags = featureflag.New(c.featureFlagRepo, c.config.GetFeatureFlagRefreshInterval(), c.logger.Module("featureflag"))
+
+	// Usage meter counts the API calls of the projects in memory, storing
+	// them and loading the quotas again every flush interval
+	c.usageMeter = usage_domain.NewMeter(c.usageRepo, c.config.GetUsageFlushInterval(), c.logger.Module("usage"))
+
+	// Billing plans limit the projects, API keys and members created; Stripe
+	// webhooks keep the subscriptions of the organizations up to date
+	c.billingCatalog = billing_domain.NewCatalog(c.config.GetBillingPlans(), c.config.GetBillingDefaultPlan())
+	c.billingLimiter = billing_domain.NewLimiter(c.config.IsBillingEnabled(), c.billingRepo, c.billingCatalog, c.logger.Module("billing"))
+	c.billingWebhook = billing_domain.NewWebhook(c.config.GetStripeWebhookSecret(), c.billingRepo, c.logger.Module("billing"))
+
+	// Trash janitor hard-deletes soft-deleted records past their retention
+	c.trashJanitor = trash.NewJanitor(
+		c.logger.Module("trash"),
+		time.Duration(c.config.GetTrashRetentionDays())*24*time.Hour,
+		time.Duration(c.config.GetTrashPurgeIntervalMinutes())*time.Minute,
+		map[string]trash.Purger{
+			"users":         c.userRepo,
+			"oauth_clients": c.oauthClientRepo,
+			"api_keys":      c.apiKeyRepo,
+			"employees":     c.employeeRepo,
+			// Used or expired email verification tokens
+			"email_verification_tokens": trash.PurgerFunc(c.verificationRepo.PurgeStale),
+			// Revoked or expired remember-me tokens
+			"remember_tokens": trash.PurgerFunc(c.rememberTokenRepo.PurgeStale),
+			// Hourly token request counts, kept for their own retention
+			"oauth_client_token_stats": trash.PurgerFunc(func(ctx context.Context, _ time.Time) (int64, error) {
+				retention := time.Duration(c.config.GetTokenStatsRetentionDays()) * 24 * time.Hour
+				return c.oauthClientRepo.PurgeTokenStats(ctx, time.Now().Add(-retention))
+			}),
+			// Hourly API call counts of the projects, kept for their own retention
+			"project_api_usage": trash.PurgerFunc(func(ctx context.Context, _ time.Time) (int64, error) {
+				retention := time.Duration(c.config.GetUsageRetentionDays()) * 24 * time.Hour
+				return c.usageRepo.Purge(ctx, time.Now().Add(-retention))
+			}),
+		},
+	)
+
+	// Scheduler runs periodic jobs once across replicas; jobs are registered
+	// by the features that need them before the server starts it
+	c.scheduler = scheduler.New(c.logger.Module("scheduler"), scheduler.NewPostgresCoordinator(c.db), scheduler.WithReporter(c.errorReporter))
+
+	// Metrics registry scraped on /metrics, with the database health
+	// collected on every scrape
+	c.metricsRegistry = prometheus.NewRegistry()
+	c.metricsRegistry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		dbmetrics.NewCollector(c.db, project_domain.PartitionedTables(), migration_domain.MigrationsTableName, c.logger.Module("dbmetrics")),
+	)
+
+	// Digest emails summarize each project's activity for its owners
+	if c.config.IsDigestEnabled() {
+		if c.notificationService == nil {
+			return fmt.Errorf("digest.enabled requires a configured email provider")
+		}
+		frequency, err := digest_domain.ParseFrequency(c.config.GetDigestFrequency())
+		if err != nil {
+			return err
+		}
+		job := digest_domain.NewJob(
+			digest_domain.NewRepo(c.scopedDB),
+			c.userRepo,
+			c.notificationService,
+			frequency,
+			c.logger.Module("digest"),
+		)
+		if err := c.scheduler.Register(digest_domain.JobName, frequency.Spec(), job.Run); err != nil {
+			return fmt.Errorf("register digest job: %w", err)
+		}
+	}
+
+	// Token alerts report clients whose token requests fail or spike
+	if c.config.IsTokenAlertsEnabled() {
+		if len(c.config.GetTokenAlertEmails()) > 0 && c.notificationService == nil {
+			return fmt.Errorf("tokenStats.alerts.emails requires a configured email provider")
+		}
+		job := oauth_client_domain.NewTokenAlertJob(
+			c.oauthClientRepo,
+			c.notificationService,
+			oauth_client_domain.TokenAlertThresholds{
+				MinRequests:    int64(c.config.GetTokenAlertMinRequests()),
+				MaxFailureRate: c.config.GetTokenAlertMaxFailureRate(),
+				SpikeFactor:    c.config.GetTokenAlertSpikeFactor(),
+				BaselineHours:  c.config.GetTokenAlertBaselineHours(),
+			},
+			c.config.GetTokenAlertWebhookURL(),
+			c.config.GetTokenAlertEmails(),
+			c.logger.Module("token_alerts"),
+		)
+		if err := c.scheduler.Register(oauth_client_domain.TokenAlertJobName, oauth_client_domain.TokenAlertJobSpec, job.Run); err != nil {
+			return fmt.Errorf("register token alert job: %w", err)
+		}
+	}
+
+	// API key policies flag or disable the keys outliving their project's
+	// maximum lifetime; owners are only emailed with an email provider
+	policyJob := api_key_domain.NewPolicyJob(
+		c.projectRepo,
+		c.apiKeyRepo,
+		c.userRepo,
+		c.notificationService,
+		c.logger.Module("api_key_policy"),
+	)
+	if err := c.scheduler.Register(api_key_domain.PolicyJobName, api_key_domain.PolicyJobSpec, policyJob.Run); err != nil {
+		return fmt.Errorf("register api key policy job: %w", err)
+	}
+
+	// OAuth clients are global, so their secrets follow the maximum lifetime
+	// of the default project, flagged or expired once out of policy
+	secretPolicyJob := oauth_client_domain.NewSecretPolicyJob(
+		c.projectRepo,
+		c.oauthClientRepo,
+		c.logger.Module("oauth_client_policy"),
+	)
+	if err := c.scheduler.Register(oauth_client_domain.SecretPolicyJobName, oauth_client_domain.SecretPolicyJobSpec, secretPolicyJob.Run); err != nil {
+		return fmt.Errorf("register oauth client secret policy job: %w", err)
+	}
+
+	// Query exports are generated by a bounded pool of workers streaming their
+	// files to the disk, and deleted with their files once expired
+	c.queryExportFiles = filestore.NewDisk(c.config.GetQueryExportDir())
+	c.queryExportWorker = query_export_domain.NewWorker(
+		c.queryExportRepo,
+		c.userRepo,
+		c.apiKeyRepo,
+		c.scopedDB,
+		c.queryExportFiles,
+		query_export_domain.WorkerOptions{
+			Concurrency: c.config.GetQueryExportWorkers(),
+			MaxRows:     c.config.GetQueryExportMaxRows(),
+			MaxBytes:    c.config.GetQueryExportMaxBytes(),
+		},
+		c.logger.Module("query_export"),
+	)
+	purgeJob := query_export_domain.NewPurgeJob(c.queryExportRepo, c.queryExportFiles, c.logger.Module("query_export"))
+	if err := c.scheduler.Register(query_export_domain.PurgeJobName, query_export_domain.PurgeJobSpec, purgeJob.Run); err != nil {
+		return fmt.Errorf("register query export purge job: %w", err)
+	}
+
+	// Activities leave the project feeds once past their retention
+	activityPurgeJob := activity_domain.NewPurgeJob(c.activityRepo, c.logger.Module("activity"))
+	if err := c.scheduler.Register(activity_domain.PurgeJobName, activity_domain.PurgeJobSpec, activityPurgeJob.Run); err != nil {
+		return fmt.Errorf("register activity purge job: %w", err)
+	}
+
+	// Domain events: created before the services publishing them, subscribers
+	// are added with the auth components
+	c.eventBus = events.NewBus(c.logger.Module("events"))
+
 	return nil
 }
 
@@ -222,20 +555,40 @@ func (c *Container) initServices() error {
 	c.greeterService = greeter_domain.NewService(validator, c.logger, c.greeterRepo)
 	c.employeeService = employee_domain.NewService(validator, c.logger, c.projectRepo, c.employeeRepo)
 	c.projectService = project_domain.NewService(validator, c.logger, c.projectRepo)
-	c.apiKeyService = api_key_domain.NewService(validator, c.logger, c.projectRepo, c.apiKeyRepo)
+	c.projectHostnameService = project_hostname_domain.NewService(validator, c.logger, c.projectRepo, c.projectHostnameRepo)
+	c.projectBrandingService = project_branding_domain.NewService(validator, c.logger, c.projectRepo, c.projectBrandingRepo)
+	c.secretDeliverer = secretdelivery.NewDeliverer(c.store)
+	c.apiKeyService = api_key_domain.NewService(validator, c.logger, c.projectRepo, c.apiKeyRepo, c.userRepo, c.secretDeliverer, c.eventBus)
 	c.chatbotService = chatbot_domain.NewService(validator, c.logger, c.projectRepo, c.chatbotRepo)
 	c.chatbotNodeService = chatbot_node_domain.NewService(validator, c.logger, c.projectRepo, c.chatbotNodeRepo)
 	c.roleService = role_domain.NewService(validator, c.logger, c.roleRepo)
 	c.permissionService = permission_domain.NewService(validator, c.logger, c.permissionRepo)
-	c.iamMapperService = iam_mapper_domain.NewService(validator, c.logger, c.iamMapperRepo, c.userRepo, c.roleRepo, c.permissionRepo, c.projectRepo)
-	c.oauthProviderService = oauth_provider_domain.NewService(validator, c.logger, c.oauthProviderRepo)
-	c.oauthClientService = oauth_client_domain.NewService(validator, c.logger, c.projectRepo, c.oauthClientRepo)
+	c.iamMapperService = iam_mapper_domain.NewService(validator, c.logger, c.iamMapperRepo, c.userRepo, c.roleRepo, c.permissionRepo, c.projectRepo, c.notificationService, c.organizationRepo, c.billingLimiter, c.eventBus)
+	c.oauthProviderService = oauth_provider_domain.NewService(validator, c.logger, c.oauthProviderRepo, c.store, c.notificationService)
+	c.oauthClientService = oauth_client_domain.NewService(validator, c.logger, c.projectRepo, c.oauthClientRepo, c.secretDeliverer, c.billingLimiter, c.eventBus)
+	c.featureFlagService = feature_flag_domain.NewService(validator, c.logger, c.projectRepo, c.featureFlagRepo, c.featureFlags)
+	c.usageService = usage_domain.NewService(validator, c.logger, c.projectRepo, c.usageRepo, c.usageMeter)
+	organizationService := organization_domain.NewService(validator, c.logger, c.organizationRepo, c.projectRepo, c.userRepo, c.billingLimiter)
+	c.organizationService = organizationService
+	var stripeClient *billing_domain.StripeClient
+	if key := c.config.GetStripeSecretKey(); key != "" {
+		stripeClient = billing_domain.NewStripeClient(key)
+	}
+	c.billingService = billing_domain.NewService(validator, c.logger, organizationService, c.billingRepo, c.billingCatalog, stripeClient, c.config.GetBillingPortalReturnURL())
+	c.savedViewService = saved_view_domain.NewService(validator, c.logger, c.projectRepo, c.userRepo, c.savedViewRepo)
+	c.queryExportService = query_export_domain.NewService(validator, c.logger, c.projectRepo, c.userRepo, c.queryExportRepo, c.queryExportWorker)
+	c.activityService = activity_domain.NewService(validator, c.logger, c.projectRepo, c.activityRepo)
 
 	if err := c.initAuthComponents(); err != nil {
 		return fmt.Errorf("failed to initialize auth components: %w", err)
 	}
 
-	c.userService = user_domain.NewService(validator, c.logger, c.userRepo, c.roleRepo, c.iamMapperRepo, c.emailVerificationService)
+	// A nil *EmailVerificationService must not become a non-nil interface
+	var verificationSender user_domain.EmailVerificationSender
+	if c.emailVerificationService != nil {
+		verificationSender = c.emailVerificationService
+	}
+	c.userService = user_domain.NewService(validator, c.logger, c.userRepo, c.roleRepo, c.iamMapperRepo, verificationSender, c.emailChecker, c.billingLimiter)
 
 	return nil
 }
@@ -257,17 +610,22 @@ func (c *Container) initAuthComponents() error {
 
 	// Session Store - only initialize if session secret is configured
 	if c.config.GetSessionSecret() != "" {
-		c.sessionStore = session.NewStore(c.config.GetSessionSecret(), false, 86400)
+		c.sessionStore = session.NewStore(
+			c.config.GetSessionSecret(),
+			cookie.OptionsFromConfig(c.config),
+			time.Duration(c.config.GetSessionIdleTimeout())*time.Second,
+			time.Duration(c.config.GetSessionLifetime())*time.Second,
+		)
 	}
 
 	// OAuth Auth Service - only initialize if JWT signer is available
 	if c.jwtSigner != nil {
 		permissionProvider := oauth_auth_domain.NewPermissionService(c.iamMapperRepo)
-		membershipProvider := oauth_auth_domain.NewMembershipService(c.iamMapperRepo)
+		membershipProvider := oauth_auth_domain.NewMembershipService(c.iamMapperRepo, c.organizationRepo)
 		scopeHandlerRegistry := oauth_auth_domain.NewScopeHandlerRegistry()
 
 		c.oauthAuthService = oauth_auth_domain.NewService(
-			c.logger,
+			c.logger.Module("oauth"),
 			c.oauthAuthRepo,
 			c.otpUserRepo,
 			c.jwtSigner,
@@ -278,13 +636,25 @@ func (c *Container) initAuthComponents() error {
 		)
 	}
 
+	// Remember-me Service - only initialize if "keep me signed in" is offered
+	if c.config.IsRememberMeEnabled() {
+		c.rememberMeService = oauth_auth_domain.NewRememberMeService(
+			c.rememberTokenRepo,
+			c.otpUserRepo,
+			c.config,
+			c.logger.Module("oauth"),
+		)
+	}
+
 	// Initialize OTP and Email Verification Services if notification service is available
 	if c.notificationService != nil {
 		c.otpService = oauth_auth_domain.NewOTPService(
 			c.otpRepo,
 			c.otpUserRepo,
+			oauth_auth_domain.NewAccountLockout(c.lockoutUserRepo, c.config, c.logger.Module("oauth"), c.eventBus),
+			c.emailChecker,
 			c.notificationService,
-			c.logger,
+			c.logger.Module("oauth"),
 			c.config,
 		)
 
@@ -292,9 +662,26 @@ func (c *Container) initAuthComponents() error {
 			c.verificationRepo,
 			c.verificationUserRepo,
 			c.notificationService,
-			c.logger,
+			c.logger.Module("oauth"),
 			c.config,
 		)
+
+		c.approvalNotifier = oauth_auth_domain.NewApprovalNotifier(
+			c.userRepo,
+			c.projectRepo,
+			c.notificationService,
+			c.logger.Module("oauth"),
+		)
+	}
+
+	// Domain events: registered users are onboarded by subscribers, notable
+	// events are recorded in the project activity feeds, and every event is
+	// logged and forwarded to the webhook when configured
+	oauth_auth_domain.SubscribeRegistration(c.eventBus, c.roleRepo, c.iamMapperRepo, c.emailVerificationService, c.approvalNotifier)
+	activity_domain.Subscribe(c.eventBus, c.activityRepo)
+	c.eventBus.SubscribeAll(events.AuditLog(c.logger.Module("events")))
+	if webhookURL := c.config.GetEventWebhookURL(); webhookURL != "" {
+		c.eventBus.SubscribeAll(events.NewWebhook(webhookURL, c.config.GetEventWebhookSecret(), c.logger.Module("events")).Handle)
 	}
 
 	// Initialize Resource Server Auth Components (for JWT validation)
@@ -312,5 +699,9 @@ func (c *Container) initAuthComponents() error {
 		)
 	}
 
+	// Tokens of clients slimming the perms claim get the permissions of their
+	// user from the database
+	c.permissionResolver = oauth_auth_domain.NewPermissionResolver(c.otpUserRepo, oauth_auth_domain.NewPermissionService(c.iamMapperRepo))
+
 	return nil
 }
@@ -3,17 +3,35 @@ package container
 import (
 	"github.com/hrz8/altalune"
 	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
-	greeterv1 "github.com/hrz8/altalune/gen/greeter/v1"
 	"github.com/hrz8/altalune/internal/auth"
+	billing_domain "github.com/hrz8/altalune/internal/domain/billing"
+	employee_domain "github.com/hrz8/altalune/internal/domain/employee"
+	greeter_domain "github.com/hrz8/altalune/internal/domain/greeter"
 	iam_mapper_domain "github.com/hrz8/altalune/internal/domain/iam_mapper"
+	maintenance_domain "github.com/hrz8/altalune/internal/domain/maintenance"
 	migration_domain "github.com/hrz8/altalune/internal/domain/migration"
 	oauth_auth_domain "github.com/hrz8/altalune/internal/domain/oauth_auth"
 	oauth_provider_domain "github.com/hrz8/altalune/internal/domain/oauth_provider"
+	project_domain "github.com/hrz8/altalune/internal/domain/project"
+	project_branding_domain "github.com/hrz8/altalune/internal/domain/project_branding"
+	project_hostname_domain "github.com/hrz8/altalune/internal/domain/project_hostname"
+	query_export_domain "github.com/hrz8/altalune/internal/domain/query_export"
 	role_domain "github.com/hrz8/altalune/internal/domain/role"
+	usage_domain "github.com/hrz8/altalune/internal/domain/usage"
 	user_domain "github.com/hrz8/altalune/internal/domain/user"
+	"github.com/hrz8/altalune/internal/featureflag"
 	"github.com/hrz8/altalune/internal/postgres"
+	"github.com/hrz8/altalune/internal/redis"
 	"github.com/hrz8/altalune/internal/session"
+	"github.com/hrz8/altalune/internal/shared/errorreport"
+	"github.com/hrz8/altalune/internal/shared/events"
+	"github.com/hrz8/altalune/internal/shared/filestore"
 	"github.com/hrz8/altalune/internal/shared/jwt"
+	"github.com/hrz8/altalune/internal/shared/realip"
+	"github.com/hrz8/altalune/internal/shared/scheduler"
+	"github.com/hrz8/altalune/internal/shared/secretdelivery"
+	"github.com/hrz8/altalune/internal/shared/trash"
+	"github.com/prometheus/client_golang/prometheus"
 )
 
 // Public getter methods for accessing private components
@@ -33,6 +51,12 @@ func (c *Container) GetDB() postgres.DB {
 	return c.db
 }
 
+// GetScopedDB returns the database connection the repositories run their
+// statements on, honoring the project scopes
+func (c *Container) GetScopedDB() *postgres.ScopedDB {
+	return c.scopedDB
+}
+
 // GetDB returns the database connection
 func (c *Container) GetDBManager() postgres.Manager {
 	if mgr, ok := c.db.(postgres.Manager); ok {
@@ -41,18 +65,35 @@ func (c *Container) GetDBManager() postgres.Manager {
 	return nil
 }
 
+// GetStore returns the shared key-value store, backed by Redis when enabled
+// and by process memory otherwise
+func (c *Container) GetStore() redis.Store {
+	return c.store
+}
+
+// GetSecretDeliverer returns the deliverer of the secrets generated on
+// creation, which also serves the one-time retrieval links
+func (c *Container) GetSecretDeliverer() *secretdelivery.Deliverer {
+	return c.secretDeliverer
+}
+
+// GetRedisClient returns the Redis client, or nil when Redis is disabled
+func (c *Container) GetRedisClient() *redis.Client {
+	return c.redisClient
+}
+
 // GetMigrationService returns the migration service
 func (c *Container) GetMigrationService() *migration_domain.Service {
 	return c.migrationService
 }
 
 // GetGreeterService returns the greeter service (Only Example)
-func (c *Container) GetGreeterService() greeterv1.GreeterServiceServer {
+func (c *Container) GetGreeterService() *greeter_domain.Service {
 	return c.greeterService
 }
 
 // GetEmployeeService returns the employee service (Only Example)
-func (c *Container) GetEmployeeService() altalunev1.EmployeeServiceServer {
+func (c *Container) GetEmployeeService() *employee_domain.Service {
 	return c.employeeService
 }
 
@@ -61,6 +102,31 @@ func (c *Container) GetProjectService() altalunev1.ProjectServiceServer {
 	return c.projectService
 }
 
+// GetProjectRepo returns the project repository
+func (c *Container) GetProjectRepo() project_domain.Repositor {
+	return c.projectRepo
+}
+
+// GetProjectHostnameService returns the project hostname service
+func (c *Container) GetProjectHostnameService() altalunev1.ProjectHostnameServiceServer {
+	return c.projectHostnameService
+}
+
+// GetProjectHostnameRepo returns the project hostname repository
+func (c *Container) GetProjectHostnameRepo() project_hostname_domain.Repositor {
+	return c.projectHostnameRepo
+}
+
+// GetProjectBrandingService returns the project branding service
+func (c *Container) GetProjectBrandingService() altalunev1.ProjectBrandingServiceServer {
+	return c.projectBrandingService
+}
+
+// GetProjectBrandingRepo returns the project branding repository
+func (c *Container) GetProjectBrandingRepo() project_branding_domain.Repositor {
+	return c.projectBrandingRepo
+}
+
 // GetApiKeyService returns the API key service
 func (c *Container) GetApiKeyService() altalunev1.ApiKeyServiceServer {
 	return c.apiKeyService
@@ -77,7 +143,7 @@ func (c *Container) GetChatbotNodeService() altalunev1.ChatbotNodeServiceServer
 }
 
 // GetUserService returns the user service
-func (c *Container) GetUserService() altalunev1.UserServiceServer {
+func (c *Container) GetUserService() *user_domain.Service {
 	return c.userService
 }
 
@@ -106,6 +172,61 @@ func (c *Container) GetOAuthClientService() altalunev1.OAuthClientServiceServer
 	return c.oauthClientService
 }
 
+// GetFeatureFlagService returns the feature flag service
+func (c *Container) GetFeatureFlagService() altalunev1.FeatureFlagServiceServer {
+	return c.featureFlagService
+}
+
+// GetUsageService returns the usage service
+func (c *Container) GetUsageService() altalunev1.UsageServiceServer {
+	return c.usageService
+}
+
+// GetOrganizationService returns the organization service
+func (c *Container) GetOrganizationService() altalunev1.OrganizationServiceServer {
+	return c.organizationService
+}
+
+// GetBillingService returns the billing service
+func (c *Container) GetBillingService() altalunev1.BillingServiceServer {
+	return c.billingService
+}
+
+// GetBillingLimiter returns the limiter of the billing plans
+func (c *Container) GetBillingLimiter() *billing_domain.Limiter {
+	return c.billingLimiter
+}
+
+// GetSavedViewService returns the saved view service
+func (c *Container) GetSavedViewService() altalunev1.SavedViewServiceServer {
+	return c.savedViewService
+}
+
+// GetQueryExportService returns the query export service
+func (c *Container) GetQueryExportService() altalunev1.QueryExportServiceServer {
+	return c.queryExportService
+}
+
+// GetQueryExportRepo returns the query export repository
+func (c *Container) GetQueryExportRepo() query_export_domain.Repositor {
+	return c.queryExportRepo
+}
+
+// GetQueryExportFiles returns the store of the query export files
+func (c *Container) GetQueryExportFiles() filestore.Store {
+	return c.queryExportFiles
+}
+
+// GetQueryExportWorker returns the workers generating the query exports
+func (c *Container) GetQueryExportWorker() *query_export_domain.Worker {
+	return c.queryExportWorker
+}
+
+// GetActivityService returns the project activity feed service
+func (c *Container) GetActivityService() altalunev1.ActivityServiceServer {
+	return c.activityService
+}
+
 // GetJWTSigner returns the JWT signer instance, or nil if not configured.
 func (c *Container) GetJWTSigner() *jwt.Signer {
 	return c.jwtSigner
@@ -131,6 +252,16 @@ func (c *Container) GetUserRepo() user_domain.Repository {
 	return c.userRepo
 }
 
+// GetApprovalNotifier returns the approval notifier, or nil if not configured.
+func (c *Container) GetApprovalNotifier() *oauth_auth_domain.ApprovalNotifier {
+	return c.approvalNotifier
+}
+
+// GetEventBus returns the domain event bus.
+func (c *Container) GetEventBus() *events.Bus {
+	return c.eventBus
+}
+
 // GetOTPService returns the OTP service, or nil if not configured.
 func (c *Container) GetOTPService() *oauth_auth_domain.OTPService {
 	return c.otpService
@@ -141,6 +272,11 @@ func (c *Container) GetEmailVerificationService() *oauth_auth_domain.EmailVerifi
 	return c.emailVerificationService
 }
 
+// GetRememberMeService returns the remember-me service, or nil if "keep me signed in" is disabled.
+func (c *Container) GetRememberMeService() *oauth_auth_domain.RememberMeService {
+	return c.rememberMeService
+}
+
 // GetRoleRepo returns the role repository.
 func (c *Container) GetRoleRepo() role_domain.Repository {
 	return c.roleRepo
@@ -156,7 +292,60 @@ func (c *Container) GetJWTValidator() *auth.JWTValidator {
 	return c.jwtValidator
 }
 
+// GetPermissionResolver returns the resolver of the permissions that slimmed
+// access tokens leave out.
+func (c *Container) GetPermissionResolver() *oauth_auth_domain.PermissionResolver {
+	return c.permissionResolver
+}
+
 // GetAuthorizer returns the authorizer for permission checks.
 func (c *Container) GetAuthorizer() *auth.Authorizer {
 	return c.authorizer
 }
+
+// GetTrashJanitor returns the janitor purging expired soft-deleted records.
+func (c *Container) GetTrashJanitor() *trash.Janitor {
+	return c.trashJanitor
+}
+
+// GetScheduler returns the scheduler running periodic background jobs.
+func (c *Container) GetScheduler() *scheduler.Scheduler {
+	return c.scheduler
+}
+
+// GetClientResolver returns the resolver of the client IP and scheme of requests.
+func (c *Container) GetClientResolver() *realip.Resolver {
+	return c.clientResolver
+}
+
+// GetMaintenanceSwitch returns the switch telling whether the servers are in maintenance.
+func (c *Container) GetMaintenanceSwitch() *maintenance_domain.Switch {
+	return c.maintenanceSwitch
+}
+
+// GetMetricsRegistry returns the registry of the Prometheus metrics.
+func (c *Container) GetMetricsRegistry() *prometheus.Registry {
+	return c.metricsRegistry
+}
+
+// GetErrorReporter returns the reporter of unexpected errors, a no-op when
+// no error tracker is configured.
+func (c *Container) GetErrorReporter() errorreport.Reporter {
+	return c.errorReporter
+}
+
+// GetFeatureFlags returns the evaluator of the feature flags.
+func (c *Container) GetFeatureFlags() *featureflag.Flags {
+	return c.featureFlags
+}
+
+// GetUsageMeter returns the meter counting the API calls of the projects and
+// enforcing their monthly quotas.
+func (c *Container) GetUsageMeter() *usage_domain.Meter {
+	return c.usageMeter
+}
+
+// GetBillingWebhook returns the receiver of the Stripe webhook events.
+func (c *Container) GetBillingWebhook() *billing_domain.Webhook {
+	return c.billingWebhook
+}
@@ -3,15 +3,29 @@ package container
 import (
 	"context"
 	"fmt"
+	"time"
 )
 
+// errorFlushTimeout bounds the wait for the queued error reports on shutdown
+const errorFlushTimeout = 5 * time.Second
+
 // Shutdown gracefully shuts down all components
 func (c *Container) Shutdown() error {
+	// Send the errors reported during shutdown too
+	if c.errorReporter != nil {
+		defer c.errorReporter.Flush(errorFlushTimeout)
+	}
+
 	if c.GetDBManager() != nil {
 		if err := c.GetDBManager().Close(); err != nil {
 			return fmt.Errorf("failed to close database connection: %w", err)
 		}
 	}
+	if c.redisClient != nil {
+		if err := c.redisClient.Close(); err != nil {
+			return fmt.Errorf("failed to close redis connection: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -28,6 +42,11 @@ func (c *Container) Health(ctx context.Context) map[string]error {
 		health["database"] = nil
 	}
 
+	// Check redis health, only when enabled
+	if c.redisClient != nil {
+		health["redis"] = c.redisClient.TestConnection(ctx)
+	}
+
 	// Add other health checks as needed
 	health["logger"] = nil
 	health["config"] = nil
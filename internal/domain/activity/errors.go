@@ -0,0 +1,7 @@
+package activity
+
+import "errors"
+
+var (
+	ErrInvalidCursor = errors.New("invalid activity cursor")
+)
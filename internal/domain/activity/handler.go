@@ -0,0 +1,35 @@
+package activity
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/hrz8/altalune"
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	"github.com/hrz8/altalune/internal/auth"
+)
+
+type Handler struct {
+	svc  altalunev1.ActivityServiceServer
+	auth *auth.Authorizer
+}
+
+func NewHandler(svc altalunev1.ActivityServiceServer, authorizer *auth.Authorizer) *Handler {
+	return &Handler{svc: svc, auth: authorizer}
+}
+
+func (h *Handler) ListProjectActivity(
+	ctx context.Context,
+	req *connect.Request[altalunev1.ListProjectActivityRequest],
+) (*connect.Response[altalunev1.ListProjectActivityResponse], error) {
+	// Authorization: requires project:read permission and project membership
+	if err := h.auth.CheckProjectAccess(ctx, "project:read", req.Msg.ProjectId); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.ListProjectActivity(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
@@ -0,0 +1,14 @@
+package activity
+
+import (
+	"context"
+	"time"
+)
+
+type Repositor interface {
+	Record(ctx context.Context, input *RecordActivityInput) error
+	List(ctx context.Context, input *ListActivityInput) ([]*Activity, error)
+	DefaultProjectID(ctx context.Context) (int64, error)                 // Project of the global events
+	MemberProjectIDs(ctx context.Context, userID int64) ([]int64, error) // Projects of the events of a user
+	DeleteBefore(ctx context.Context, before time.Time) (int64, error)   // For the retention of the feed
+}
@@ -0,0 +1,138 @@
+package activity
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// DefaultPageSize is the page size of requests leaving it unset
+	DefaultPageSize = 20
+	// Retention is how long activities stay in the feed
+	Retention = 90 * 24 * time.Hour
+)
+
+// Category is the kind of an activity, the feed filters on
+type Category string
+
+const (
+	CategoryMembers  Category = "members"
+	CategoryClients  Category = "clients"
+	CategoryApiKeys  Category = "api_keys"
+	CategorySecurity Category = "security"
+)
+
+// CategoryFromProto returns the category of c, empty when unspecified
+func CategoryFromProto(c altalunev1.ActivityCategory) Category {
+	switch c {
+	case altalunev1.ActivityCategory_ACTIVITY_CATEGORY_MEMBERS:
+		return CategoryMembers
+	case altalunev1.ActivityCategory_ACTIVITY_CATEGORY_CLIENTS:
+		return CategoryClients
+	case altalunev1.ActivityCategory_ACTIVITY_CATEGORY_API_KEYS:
+		return CategoryApiKeys
+	case altalunev1.ActivityCategory_ACTIVITY_CATEGORY_SECURITY:
+		return CategorySecurity
+	default:
+		return ""
+	}
+}
+
+func (c Category) ToProto() altalunev1.ActivityCategory {
+	switch c {
+	case CategoryMembers:
+		return altalunev1.ActivityCategory_ACTIVITY_CATEGORY_MEMBERS
+	case CategoryClients:
+		return altalunev1.ActivityCategory_ACTIVITY_CATEGORY_CLIENTS
+	case CategoryApiKeys:
+		return altalunev1.ActivityCategory_ACTIVITY_CATEGORY_API_KEYS
+	case CategorySecurity:
+		return altalunev1.ActivityCategory_ACTIVITY_CATEGORY_SECURITY
+	default:
+		return altalunev1.ActivityCategory_ACTIVITY_CATEGORY_UNSPECIFIED
+	}
+}
+
+// Activity represents the domain model with public IDs only
+type Activity struct {
+	ID          string // Public nanoid
+	Category    Category
+	Event       string // Domain event name
+	ActorID     string // Public nanoid of the user, empty for sign-ins and unauthenticated changes
+	SubjectID   string
+	SubjectName string
+	Details     map[string]string
+	CreatedAt   time.Time
+}
+
+func (m *Activity) ToActivityProto() *altalunev1.Activity {
+	return &altalunev1.Activity{
+		Id:          m.ID,
+		Category:    m.Category.ToProto(),
+		Event:       m.Event,
+		ActorId:     m.ActorID,
+		SubjectId:   m.SubjectID,
+		SubjectName: m.SubjectName,
+		Details:     m.Details,
+		CreatedAt:   timestamppb.New(m.CreatedAt),
+	}
+}
+
+// Cursor is the position of a page of the feed: the last activity of the
+// page before it. Unlike page numbers, it stays put as newer activities are
+// recorded while the feed is scrolled.
+type Cursor struct {
+	CreatedAt time.Time `json:"t"`
+	ID        string    `json:"i"`
+}
+
+// CursorAfter returns the cursor of the page following the one ending at a
+func CursorAfter(a *Activity) *Cursor {
+	return &Cursor{CreatedAt: a.CreatedAt, ID: a.ID}
+}
+
+// Encode encodes the cursor opaquely
+func (c *Cursor) Encode() string {
+	body, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(body)
+}
+
+// DecodeCursor decodes an encoded cursor, nil for the first page when encoded
+// is empty
+func DecodeCursor(encoded string) (*Cursor, error) {
+	if encoded == "" {
+		return nil, nil
+	}
+	body, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var c Cursor
+	if err := json.Unmarshal(body, &c); err != nil || c.ID == "" || c.CreatedAt.IsZero() {
+		return nil, ErrInvalidCursor
+	}
+	return &c, nil
+}
+
+// RecordActivityInput is an event recorded in the feed of every project of
+// ProjectIDs
+type RecordActivityInput struct {
+	ProjectIDs  []int64
+	Category    Category
+	Event       string
+	ActorID     string
+	SubjectID   string
+	SubjectName string
+	Details     map[string]string
+}
+
+type ListActivityInput struct {
+	ProjectID  int64
+	Categories []Category // All when empty
+	After      *Cursor    // nil for the newest activities
+	Limit      int32
+}
@@ -0,0 +1,38 @@
+package activity
+
+import (
+	"context"
+	"time"
+
+	"github.com/hrz8/altalune"
+)
+
+// PurgeJobName is the name the purge job is registered with on the scheduler
+const PurgeJobName = "activity.purge"
+
+// PurgeJobSpec runs the job every day
+const PurgeJobSpec = "@daily"
+
+// PurgeJob deletes the activities past the retention of the feed.
+type PurgeJob struct {
+	activities Repositor
+	log        altalune.Logger
+}
+
+// NewPurgeJob creates an activity purge job.
+func NewPurgeJob(activities Repositor, log altalune.Logger) *PurgeJob {
+	return &PurgeJob{activities: activities, log: log}
+}
+
+// Run purges the activities once.
+func (j *PurgeJob) Run(ctx context.Context) error {
+	deleted, err := j.activities.DeleteBefore(ctx, time.Now().Add(-Retention))
+	if err != nil {
+		return err
+	}
+
+	if deleted > 0 {
+		j.log.Info("activities purged", "deleted", deleted)
+	}
+	return nil
+}
@@ -0,0 +1,200 @@
+package activity
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hrz8/altalune/internal/postgres"
+	"github.com/lib/pq"
+)
+
+type Repo struct {
+	db postgres.DB
+}
+
+func NewRepo(db postgres.DB) *Repo {
+	return &Repo{
+		db: db,
+	}
+}
+
+// Record inserts the activity once per project, in a single statement
+func (r *Repo) Record(ctx context.Context, input *RecordActivityInput) error {
+	if len(input.ProjectIDs) == 0 {
+		return nil
+	}
+
+	details := input.Details
+	if details == nil {
+		details = map[string]string{}
+	}
+	detailsJSON, err := json.Marshal(details)
+	if err != nil {
+		return fmt.Errorf("marshal activity details: %w", err)
+	}
+
+	insertQuery := `
+		INSERT INTO altalune_project_activities (
+			public_id,
+			project_id,
+			category,
+			event,
+			actor_id,
+			subject_id,
+			subject_name,
+			details,
+			created_at
+		)
+		SELECT unnest($1::text[]), unnest($2::bigint[]), $3, $4, $5, $6, $7, $8::jsonb, $9::timestamptz
+	`
+
+	now := time.Now()
+	err = postgres.InsertWithPublicIDBatch(len(input.ProjectIDs), func(publicIDs []string) error {
+		_, err := r.db.ExecContext(
+			ctx,
+			insertQuery,
+			pq.Array(publicIDs),
+			pq.Array(input.ProjectIDs),
+			input.Category,
+			input.Event,
+			input.ActorID,
+			input.SubjectID,
+			input.SubjectName,
+			detailsJSON,
+			now,
+		)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("record activity %s: %w", input.Event, err)
+	}
+	return nil
+}
+
+// List returns up to input.Limit activities of a project, newest first, the
+// public ID breaking ties
+func (r *Repo) List(ctx context.Context, input *ListActivityInput) ([]*Activity, error) {
+	conditions := []string{"project_id = $1"}
+	args := []any{input.ProjectID}
+	if len(input.Categories) > 0 {
+		categories := make([]string, len(input.Categories))
+		for i, c := range input.Categories {
+			categories[i] = string(c)
+		}
+		args = append(args, pq.Array(categories))
+		conditions = append(conditions, fmt.Sprintf("category = ANY($%d)", len(args)))
+	}
+	if input.After != nil {
+		args = append(args, input.After.CreatedAt, input.After.ID)
+		conditions = append(conditions, fmt.Sprintf("(created_at, public_id) < ($%d, $%d)", len(args)-1, len(args)))
+	}
+	args = append(args, input.Limit)
+
+	query := fmt.Sprintf(`
+		SELECT
+			public_id,
+			category,
+			event,
+			actor_id,
+			subject_id,
+			subject_name,
+			details,
+			created_at
+		FROM altalune_project_activities
+		WHERE %s
+		ORDER BY created_at DESC, public_id DESC
+		LIMIT $%d
+	`, strings.Join(conditions, " AND "), len(args))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("list activities: %w", err)
+	}
+	defer rows.Close()
+
+	var activities []*Activity
+	for rows.Next() {
+		var a Activity
+		var details []byte
+		if err := rows.Scan(
+			&a.ID,
+			&a.Category,
+			&a.Event,
+			&a.ActorID,
+			&a.SubjectID,
+			&a.SubjectName,
+			&details,
+			&a.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan activity: %w", err)
+		}
+		if err := json.Unmarshal(details, &a.Details); err != nil {
+			return nil, fmt.Errorf("unmarshal activity details: %w", err)
+		}
+		activities = append(activities, &a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list activities: %w", err)
+	}
+	return activities, nil
+}
+
+func (r *Repo) DefaultProjectID(ctx context.Context) (int64, error) {
+	query := `
+		SELECT id
+		FROM altalune_projects
+		WHERE is_default = true
+		ORDER BY id
+		LIMIT 1
+	`
+
+	var id int64
+	if err := r.db.QueryRowContext(ctx, query).Scan(&id); err != nil {
+		return 0, fmt.Errorf("get default project: %w", err)
+	}
+	return id, nil
+}
+
+func (r *Repo) MemberProjectIDs(ctx context.Context, userID int64) ([]int64, error) {
+	query := `
+		SELECT project_id
+		FROM altalune_project_members
+		WHERE user_id = $1
+		ORDER BY project_id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get member projects: %w", err)
+	}
+	defer rows.Close()
+
+	var projectIDs []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("scan member project: %w", err)
+		}
+		projectIDs = append(projectIDs, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get member projects: %w", err)
+	}
+	return projectIDs, nil
+}
+
+func (r *Repo) DeleteBefore(ctx context.Context, before time.Time) (int64, error) {
+	deleteQuery := `
+		DELETE FROM altalune_project_activities
+		WHERE created_at < $1
+	`
+
+	result, err := r.db.ExecContext(ctx, deleteQuery, before)
+	if err != nil {
+		return 0, fmt.Errorf("delete old activities: %w", err)
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,72 @@
+package activity_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hrz8/altalune/internal/domain/activity"
+	"github.com/hrz8/altalune/internal/testdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) { testdb.Main(m) }
+
+func TestRepoIntegration(t *testing.T) {
+	ctx := context.Background()
+	db := testdb.Tx(t)
+	fixtures := testdb.Seed(t, db)
+	repo := activity.NewRepo(db)
+
+	projectIDs, err := repo.MemberProjectIDs(ctx, fixtures.UserID)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{fixtures.ProjectID}, projectIDs)
+
+	require.NoError(t, repo.Record(ctx, &activity.RecordActivityInput{
+		ProjectIDs:  projectIDs,
+		Category:    activity.CategoryMembers,
+		Event:       "project.member_added",
+		ActorID:     fixtures.UserPublicID,
+		SubjectID:   fixtures.UserPublicID,
+		SubjectName: fixtures.UserEmail,
+		Details:     map[string]string{"role": "owner"},
+	}))
+	for range 2 {
+		require.NoError(t, repo.Record(ctx, &activity.RecordActivityInput{
+			ProjectIDs:  projectIDs,
+			Category:    activity.CategorySecurity,
+			Event:       "user.login_failed",
+			SubjectID:   fixtures.UserPublicID,
+			SubjectName: fixtures.UserEmail,
+		}))
+	}
+
+	first, err := repo.List(ctx, &activity.ListActivityInput{ProjectID: fixtures.ProjectID, Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, first, 2)
+	assert.Equal(t, activity.CategorySecurity, first[0].Category, "newest first")
+	assert.Empty(t, first[0].Details)
+
+	rest, err := repo.List(ctx, &activity.ListActivityInput{
+		ProjectID: fixtures.ProjectID,
+		After:     activity.CursorAfter(first[1]),
+		Limit:     2,
+	})
+	require.NoError(t, err)
+	require.Len(t, rest, 1, "the cursor resumes after the last activity of the page")
+	assert.Equal(t, "owner", rest[0].Details["role"])
+
+	members, err := repo.List(ctx, &activity.ListActivityInput{
+		ProjectID:  fixtures.ProjectID,
+		Categories: []activity.Category{activity.CategoryMembers},
+		Limit:      10,
+	})
+	require.NoError(t, err)
+	require.Len(t, members, 1)
+	assert.Equal(t, rest[0].ID, members[0].ID)
+
+	deleted, err := repo.DeleteBefore(ctx, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, deleted, int64(3))
+}
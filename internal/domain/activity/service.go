@@ -0,0 +1,83 @@
+package activity
+
+import (
+	"context"
+
+	"buf.build/go/protovalidate"
+	"github.com/hrz8/altalune"
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	project_domain "github.com/hrz8/altalune/internal/domain/project"
+)
+
+type Service struct {
+	altalunev1.UnimplementedActivityServiceServer
+	validator    protovalidate.Validator
+	log          altalune.Logger
+	projectRepo  project_domain.Repositor
+	activityRepo Repositor
+}
+
+func NewService(v protovalidate.Validator, log altalune.Logger, projectRepo project_domain.Repositor, activityRepo Repositor) *Service {
+	return &Service{
+		validator:    v,
+		log:          log,
+		projectRepo:  projectRepo,
+		activityRepo: activityRepo,
+	}
+}
+
+func (s *Service) ListProjectActivity(ctx context.Context, req *altalunev1.ListProjectActivityRequest) (*altalunev1.ListProjectActivityResponse, error) {
+	// Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	// Extract and validate project ID
+	projectID, err := s.projectRepo.GetIDByPublicID(ctx, req.ProjectId)
+	if err != nil {
+		if err == project_domain.ErrProjectNotFound {
+			return nil, altalune.NewProjectNotFound(req.ProjectId)
+		}
+		return nil, altalune.NewInvalidPayloadError("invalid project_id")
+	}
+
+	after, err := DecodeCursor(req.Cursor)
+	if err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	pageSize := req.PageSize
+	if pageSize == 0 {
+		pageSize = DefaultPageSize
+	}
+	categories := make([]Category, len(req.Categories))
+	for i, c := range req.Categories {
+		categories[i] = CategoryFromProto(c)
+	}
+
+	// One more than the page tells whether another one follows
+	activities, err := s.activityRepo.List(ctx, &ListActivityInput{
+		ProjectID:  projectID,
+		Categories: categories,
+		After:      after,
+		Limit:      pageSize + 1,
+	})
+	if err != nil {
+		s.log.Error("failed to list project activity",
+			"error", err,
+			"project_id", projectID,
+		)
+		return nil, altalune.NewUnexpectedError("failed to list project activity: %w", err)
+	}
+
+	response := &altalunev1.ListProjectActivityResponse{}
+	if len(activities) > int(pageSize) {
+		activities = activities[:pageSize]
+		response.NextCursor = CursorAfter(activities[len(activities)-1]).Encode()
+	}
+	response.Activities = make([]*altalunev1.Activity, len(activities))
+	for i, a := range activities {
+		response.Activities[i] = a.ToActivityProto()
+	}
+	return response, nil
+}
@@ -0,0 +1,76 @@
+package activity
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hrz8/altalune/internal/auth"
+	api_key_domain "github.com/hrz8/altalune/internal/domain/api_key"
+	iam_mapper_domain "github.com/hrz8/altalune/internal/domain/iam_mapper"
+	oauth_auth_domain "github.com/hrz8/altalune/internal/domain/oauth_auth"
+	oauth_client_domain "github.com/hrz8/altalune/internal/domain/oauth_client"
+	"github.com/hrz8/altalune/internal/shared/events"
+)
+
+// Subscribe records the notable events in the feed of their projects. The
+// actor is the user of the request publishing the event.
+func Subscribe(bus *events.Bus, repo Repositor) {
+	events.On(bus, func(ctx context.Context, e iam_mapper_domain.ProjectMemberAdded) error {
+		return repo.Record(ctx, &RecordActivityInput{
+			ProjectIDs:  []int64{e.ProjectID},
+			Category:    CategoryMembers,
+			Event:       e.EventName(),
+			ActorID:     auth.ActorID(ctx),
+			SubjectID:   e.UserPublicID,
+			SubjectName: e.Email,
+			Details:     map[string]string{"role": e.Role},
+		})
+	})
+
+	events.On(bus, func(ctx context.Context, e api_key_domain.ApiKeyCreated) error {
+		return repo.Record(ctx, &RecordActivityInput{
+			ProjectIDs:  []int64{e.ProjectID},
+			Category:    CategoryApiKeys,
+			Event:       e.EventName(),
+			ActorID:     auth.ActorID(ctx),
+			SubjectID:   e.ApiKeyID,
+			SubjectName: e.Name,
+			Details:     map[string]string{"expiration": e.Expiration.UTC().Format("2006-01-02")},
+		})
+	})
+
+	// Clients are global, they show in the feed of the default project
+	events.On(bus, func(ctx context.Context, e oauth_client_domain.OAuthClientCreated) error {
+		projectID, err := repo.DefaultProjectID(ctx)
+		if err != nil {
+			return err
+		}
+		return repo.Record(ctx, &RecordActivityInput{
+			ProjectIDs:  []int64{projectID},
+			Category:    CategoryClients,
+			Event:       e.EventName(),
+			ActorID:     auth.ActorID(ctx),
+			SubjectID:   e.ClientPublicID,
+			SubjectName: e.Name,
+			Details:     map[string]string{"confidential": strconv.FormatBool(e.Confidential)},
+		})
+	})
+
+	// Users sign in to no project in particular, their failures show in the
+	// feed of every project they are a member of
+	events.On(bus, func(ctx context.Context, e oauth_auth_domain.LoginFailed) error {
+		projectIDs, err := repo.MemberProjectIDs(ctx, e.UserID)
+		if err != nil {
+			return fmt.Errorf("get projects of user %d: %w", e.UserID, err)
+		}
+		return repo.Record(ctx, &RecordActivityInput{
+			ProjectIDs:  projectIDs,
+			Category:    CategorySecurity,
+			Event:       e.EventName(),
+			SubjectID:   e.UserPublicID,
+			SubjectName: e.Email,
+			Details:     map[string]string{"locked": strconv.FormatBool(e.Locked)},
+		})
+	})
+}
@@ -3,7 +3,10 @@ package api_key
 import "errors"
 
 var (
-	ErrApiKeyNotFound      = errors.New("api key not found")
-	ErrApiKeyAlreadyExists = errors.New("api key with this name already exists")
-	ErrApiKeyExpired       = errors.New("api key has expired")
+	ErrApiKeyNotFound        = errors.New("api key not found")
+	ErrApiKeyVersionConflict = errors.New("api key was modified concurrently")
+	ErrApiKeyAlreadyExists   = errors.New("api key with this name already exists")
+	ErrApiKeyExpired         = errors.New("api key has expired")
+	ErrExternalIDTaken       = errors.New("external id already identifies another api key of the project")
+	ErrApiKeyAlreadyManaged  = errors.New("api key is already managed under another external id")
 )
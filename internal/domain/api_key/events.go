@@ -0,0 +1,15 @@
+package api_key
+
+import "time"
+
+// ApiKeyCreated is published when a key is created in a project. Keys are
+// rotated by creating their replacement, so it is also how rotations show.
+type ApiKeyCreated struct {
+	ProjectID       int64     `json:"-"`
+	ProjectPublicID string    `json:"project_id"`
+	ApiKeyID        string    `json:"api_key_id"`
+	Name            string    `json:"name"`
+	Expiration      time.Time `json:"expiration"`
+}
+
+func (ApiKeyCreated) EventName() string { return "api_key.created" }
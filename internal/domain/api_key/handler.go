@@ -98,6 +98,54 @@ func (h *Handler) DeleteApiKey(
 	return connect.NewResponse(response), nil
 }
 
+func (h *Handler) RestoreApiKey(
+	ctx context.Context,
+	req *connect.Request[altalunev1.RestoreApiKeyRequest],
+) (*connect.Response[altalunev1.RestoreApiKeyResponse], error) {
+	// Authorization: requires apikey:delete permission and project membership
+	if err := h.auth.CheckProjectAccess(ctx, "apikey:delete", req.Msg.ProjectId); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.RestoreApiKey(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (h *Handler) ApplyApiKey(
+	ctx context.Context,
+	req *connect.Request[altalunev1.ApplyApiKeyRequest],
+) (*connect.Response[altalunev1.ApplyApiKeyResponse], error) {
+	// Authorization: requires apikey:write permission and project membership
+	if err := h.auth.CheckProjectAccess(ctx, "apikey:write", req.Msg.ProjectId); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.ApplyApiKey(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (h *Handler) ImportApiKey(
+	ctx context.Context,
+	req *connect.Request[altalunev1.ImportApiKeyRequest],
+) (*connect.Response[altalunev1.ImportApiKeyResponse], error) {
+	// Authorization: requires apikey:write permission and project membership
+	if err := h.auth.CheckProjectAccess(ctx, "apikey:write", req.Msg.ProjectId); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.ImportApiKey(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
 func (h *Handler) ActivateApiKey(
 	ctx context.Context,
 	req *connect.Request[altalunev1.ActivateApiKeyRequest],
@@ -2,17 +2,30 @@ package api_key
 
 import (
 	"context"
+	"time"
 
+	user_domain "github.com/hrz8/altalune/internal/domain/user"
 	"github.com/hrz8/altalune/internal/shared/query"
 )
 
+// UserRepositor defines the user lookup needed to check API key owners
+type UserRepositor interface {
+	GetByID(ctx context.Context, publicID string) (*user_domain.User, error)
+}
+
 type Repositor interface {
 	Query(ctx context.Context, projectID int64, params *query.QueryParams) (*query.QueryResult[ApiKey], error)
 	Create(ctx context.Context, input *CreateApiKeyInput) (*CreateApiKeyResult, error)
 	GetByID(ctx context.Context, projectID int64, publicID string) (*ApiKey, error)
 	GetByKey(ctx context.Context, key string) (*ApiKey, error) // For authentication
+	GetByExternalID(ctx context.Context, projectID int64, externalID string) (*ApiKey, error)
+	GetByName(ctx context.Context, projectID int64, name string) (*ApiKey, error)
+	SetExternalID(ctx context.Context, projectID int64, publicID, externalID string) (*ApiKey, error)
+	ListActive(ctx context.Context, projectID int64) ([]*ApiKey, error)
 	Update(ctx context.Context, input *UpdateApiKeyInput) (*UpdateApiKeyResult, error)
 	Delete(ctx context.Context, input *DeleteApiKeyInput) error
+	Restore(ctx context.Context, input *RestoreApiKeyInput) (*ApiKey, error)
+	PurgeDeleted(ctx context.Context, before time.Time) (int64, error)
 	Activate(ctx context.Context, input *ActivateApiKeyInput) (*ActivateApiKeyResult, error)
 	Deactivate(ctx context.Context, input *DeactivateApiKeyInput) (*DeactivateApiKeyResult, error)
 }
@@ -1,18 +1,21 @@
 package api_key
 
 import (
+	"time"
+
 	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
 )
 
-// mapApiKeysToProto converts slice of domain ApiKeys to proto ApiKeys
-func mapApiKeysToProto(apiKeys []*ApiKey) []*altalunev1.ApiKey {
+// mapApiKeysToProto converts slice of domain ApiKeys to proto ApiKeys, with
+// their status at now
+func mapApiKeysToProto(apiKeys []*ApiKey, now time.Time) []*altalunev1.ApiKey {
 	if apiKeys == nil {
 		return make([]*altalunev1.ApiKey, 0)
 	}
 
 	result := make([]*altalunev1.ApiKey, 0, len(apiKeys))
 	for _, key := range apiKeys {
-		result = append(result, key.ToApiKeyProto())
+		result = append(result, key.ToApiKeyProto(now))
 	}
 	return result
 }
@@ -36,7 +39,7 @@ func mapFiltersToProto(filters map[string][]string) map[string]*altalunev1.Filte
 	if statuses, ok := filters["statuses"]; ok && statuses != nil {
 		result["statuses"] = &altalunev1.FilterValues{Values: statuses}
 	} else {
-		result["statuses"] = &altalunev1.FilterValues{Values: []string{"active", "inactive", "expired", "expiring_soon"}}
+		result["statuses"] = &altalunev1.FilterValues{Values: StatusValues()}
 	}
 
 	return result
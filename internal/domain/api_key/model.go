@@ -15,6 +15,11 @@ type ApiKeyQueryResult struct {
 	Active     bool
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
+	CreatedBy  string // Public ID of the creator, empty if unknown
+	UpdatedBy  string // Public ID of the last updater, empty if unknown
+	OwnerID    string // Public ID of the owning service account, empty for project keys
+	ExternalID string // Key in a provisioning tool, empty if unmanaged
+	DeletedAt  *time.Time
 }
 
 func (r *ApiKeyQueryResult) ToApiKey() *ApiKey {
@@ -25,6 +30,11 @@ func (r *ApiKeyQueryResult) ToApiKey() *ApiKey {
 		Active:     r.Active,
 		CreatedAt:  r.CreatedAt,
 		UpdatedAt:  r.UpdatedAt,
+		CreatedBy:  r.CreatedBy,
+		UpdatedBy:  r.UpdatedBy,
+		OwnerID:    r.OwnerID,
+		DeletedAt:  r.DeletedAt,
+		ExternalID: r.ExternalID,
 	}
 }
 
@@ -35,23 +45,41 @@ type ApiKey struct {
 	Active     bool
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
+	CreatedBy  string     // Public ID of the creator, empty if unknown
+	UpdatedBy  string     // Public ID of the last updater, empty if unknown
+	OwnerID    string     // Public ID of the owning service account, empty for project keys
+	ExternalID string     // Key in a provisioning tool, empty if unmanaged
+	DeletedAt  *time.Time // Set only for trashed API keys
 }
 
-func (m *ApiKey) ToApiKeyProto() *altalunev1.ApiKey {
-	return &altalunev1.ApiKey{
+// ToApiKeyProto maps the key with its status at now, which should be in the
+// project's time zone
+func (m *ApiKey) ToApiKeyProto(now time.Time) *altalunev1.ApiKey {
+	apiKey := &altalunev1.ApiKey{
 		Id:         m.ID,
 		Name:       m.Name,
 		Expiration: timestamppb.New(m.Expiration),
 		Active:     m.Active,
 		CreatedAt:  timestamppb.New(m.CreatedAt),
 		UpdatedAt:  timestamppb.New(m.UpdatedAt),
+		CreatedBy:  m.CreatedBy,
+		UpdatedBy:  m.UpdatedBy,
+		OwnerId:    m.OwnerID,
+		ExternalId: m.ExternalID,
+		Status:     ComputeStatus(m.Active, m.Expiration, now).ToProto(),
 	}
+	if m.DeletedAt != nil {
+		apiKey.DeletedAt = timestamppb.New(*m.DeletedAt)
+	}
+	return apiKey
 }
 
 type CreateApiKeyInput struct {
 	ProjectID  int64
 	Name       string
 	Expiration time.Time
+	OwnerID    string // Public ID of the owning service account, empty for a project key
+	ExternalID string // Key in a provisioning tool, empty if unmanaged
 }
 
 type CreateApiKeyResult struct {
@@ -62,13 +90,18 @@ type CreateApiKeyResult struct {
 	Expiration time.Time
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
+	CreatedBy  string // Public ID of the creator, empty if unknown
+	UpdatedBy  string // Public ID of the last updater, empty if unknown
+	OwnerID    string // Public ID of the owning service account, empty for project keys
+	ExternalID string // Key in a provisioning tool, empty if unmanaged
 }
 
 type UpdateApiKeyInput struct {
-	ProjectID  int64
-	PublicID   string // API Key's public ID
-	Name       string
-	Expiration time.Time
+	ProjectID         int64
+	PublicID          string // API Key's public ID
+	Name              string
+	Expiration        time.Time
+	ExpectedUpdatedAt *time.Time // Reject the update unless updated_at still matches; nil to skip
 }
 
 type UpdateApiKeyResult struct {
@@ -79,6 +112,10 @@ type UpdateApiKeyResult struct {
 	Active     bool
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
+	CreatedBy  string // Public ID of the creator, empty if unknown
+	UpdatedBy  string // Public ID of the last updater, empty if unknown
+	OwnerID    string // Public ID of the owning service account, empty for project keys
+	ExternalID string // Key in a provisioning tool, empty if unmanaged
 }
 
 type DeleteApiKeyInput struct {
@@ -86,11 +123,17 @@ type DeleteApiKeyInput struct {
 	PublicID  string // API Key's public ID
 }
 
-type ActivateApiKeyInput struct {
+type RestoreApiKeyInput struct {
 	ProjectID int64
 	PublicID  string // API Key's public ID
 }
 
+type ActivateApiKeyInput struct {
+	ProjectID  int64
+	PublicID   string    // API Key's public ID
+	Expiration time.Time // New expiration, a year from now when zero
+}
+
 type ActivateApiKeyResult struct {
 	ID         int64
 	PublicID   string
@@ -99,6 +142,10 @@ type ActivateApiKeyResult struct {
 	Active     bool
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
+	CreatedBy  string // Public ID of the creator, empty if unknown
+	UpdatedBy  string // Public ID of the last updater, empty if unknown
+	OwnerID    string // Public ID of the owning service account, empty for project keys
+	ExternalID string // Key in a provisioning tool, empty if unmanaged
 }
 
 type DeactivateApiKeyInput struct {
@@ -114,4 +161,8 @@ type DeactivateApiKeyResult struct {
 	Active     bool
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
+	CreatedBy  string // Public ID of the creator, empty if unknown
+	UpdatedBy  string // Public ID of the last updater, empty if unknown
+	OwnerID    string // Public ID of the owning service account, empty for project keys
+	ExternalID string // Key in a provisioning tool, empty if unmanaged
 }
@@ -0,0 +1,152 @@
+package api_key
+
+import (
+	"context"
+	"time"
+
+	"github.com/hrz8/altalune"
+	project_domain "github.com/hrz8/altalune/internal/domain/project"
+	user_domain "github.com/hrz8/altalune/internal/domain/user"
+	"github.com/hrz8/altalune/internal/shared/notification"
+	"github.com/hrz8/altalune/internal/shared/tz"
+)
+
+// PolicyJobName is the name the policy job is registered with on the scheduler
+const PolicyJobName = "api_key.policy"
+
+// PolicyJobSpec runs the job once a day
+const PolicyJobSpec = "@daily"
+
+// PolicyProjectRepositor defines the project lookups the policy job needs
+type PolicyProjectRepositor interface {
+	ListPolicyProjects(ctx context.Context) ([]*project_domain.PolicyProject, error)
+}
+
+// ProjectOwnerRepositor defines the interface for looking up the owners of a
+// project (the policy email recipients)
+type ProjectOwnerRepositor interface {
+	GetProjectOwners(ctx context.Context, projectID int64) ([]*user_domain.ProjectOwner, error)
+}
+
+// PolicyJob finds the active API keys of every project with a maximum API key
+// lifetime that expire later than it allows, keys created before the policy.
+// It flags them to the project owners and, when the policy says so, disables
+// those that outlived the maximum.
+type PolicyJob struct {
+	projects     PolicyProjectRepositor
+	keys         Repositor
+	owners       ProjectOwnerRepositor
+	notification *notification.NotificationService // Nil when no email provider is configured
+	log          altalune.Logger
+}
+
+// NewPolicyJob creates an API key policy job.
+func NewPolicyJob(
+	projects PolicyProjectRepositor,
+	keys Repositor,
+	owners ProjectOwnerRepositor,
+	notificationSvc *notification.NotificationService,
+	log altalune.Logger,
+) *PolicyJob {
+	return &PolicyJob{
+		projects:     projects,
+		keys:         keys,
+		owners:       owners,
+		notification: notificationSvc,
+		log:          log,
+	}
+}
+
+// Run enforces the policy of every project. A project failing is logged and
+// skipped so the others are still enforced.
+func (j *PolicyJob) Run(ctx context.Context) error {
+	projects, err := j.projects.ListPolicyProjects(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, project := range projects {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		j.enforceProject(ctx, project, now)
+	}
+	return nil
+}
+
+func (j *PolicyJob) enforceProject(ctx context.Context, project *project_domain.PolicyProject, now time.Time) {
+	loc := tz.Load(project.Timezone)
+
+	active, err := j.keys.ListActive(ctx, project.ID)
+	if err != nil {
+		j.log.Error("failed to list active api keys", "error", err, "projectID", project.ID)
+		return
+	}
+
+	disable := project.Policy.Action == project_domain.CredentialPolicyActionDisable
+	flagged := make([]notification.ApiKeyPolicyEmailKey, 0)
+	disabled := make([]notification.ApiKeyPolicyEmailKey, 0)
+	for _, key := range active {
+		limit, ok := project.Policy.ApiKeyExpirationLimit(key.CreatedAt, loc)
+		if !ok || !key.Expiration.After(limit) {
+			continue
+		}
+		emailKey := notification.ApiKeyPolicyEmailKey{
+			Name:       key.Name,
+			CreatedAt:  tz.Format(key.CreatedAt, loc),
+			Expiration: tz.Format(key.Expiration, loc),
+		}
+		// Keys are only disabled once they outlive the limit, until then
+		// they are flagged
+		if !disable || limit.After(now) {
+			j.log.Warn("api key out of policy",
+				"projectID", project.ID,
+				"apiKeyID", key.ID,
+				"name", key.Name,
+				"expiration", key.Expiration,
+				"maxLifetimeDays", project.Policy.ApiKeyMaxLifetimeDays,
+			)
+			flagged = append(flagged, emailKey)
+			continue
+		}
+		if _, err := j.keys.Deactivate(ctx, &DeactivateApiKeyInput{ProjectID: project.ID, PublicID: key.ID}); err != nil {
+			j.log.Error("failed to disable api key", "error", err, "projectID", project.ID, "apiKeyID", key.ID)
+			continue
+		}
+		j.log.Info("api key disabled by policy",
+			"projectID", project.ID,
+			"apiKeyID", key.ID,
+			"name", key.Name,
+			"maxLifetimeDays", project.Policy.ApiKeyMaxLifetimeDays,
+		)
+		disabled = append(disabled, emailKey)
+	}
+	if j.notification == nil || len(flagged)+len(disabled) == 0 {
+		return
+	}
+
+	owners, err := j.owners.GetProjectOwners(ctx, project.ID)
+	if err != nil {
+		j.log.Error("failed to get project owners for api key policy", "error", err, "projectID", project.ID)
+		return
+	}
+
+	for _, data := range []notification.ApiKeyPolicyEmailData{
+		{ProjectName: project.Name, MaxLifetimeDays: project.Policy.ApiKeyMaxLifetimeDays, Keys: flagged},
+		{ProjectName: project.Name, MaxLifetimeDays: project.Policy.ApiKeyMaxLifetimeDays, Keys: disabled, Disabled: true},
+	} {
+		if len(data.Keys) == 0 {
+			continue
+		}
+		for _, owner := range owners {
+			data.OwnerName = owner.FirstName
+			if data.OwnerName == "" {
+				data.OwnerName = owner.Email
+			}
+			if err := j.notification.SendApiKeyPolicyEmail(ctx, owner.Email, data); err != nil {
+				j.log.Warn("failed to send api key policy email", "error", err, "projectID", project.ID)
+			}
+		}
+	}
+}
@@ -10,8 +10,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hrz8/altalune/internal/auth"
 	"github.com/hrz8/altalune/internal/postgres"
-	"github.com/hrz8/altalune/internal/shared/nanoid"
 	"github.com/hrz8/altalune/internal/shared/query"
 )
 
@@ -25,6 +25,7 @@ func NewRepo(db postgres.DB) *Repo {
 
 func (r *Repo) Query(ctx context.Context, projectID int64, params *query.QueryParams) (*query.QueryResult[ApiKey], error) {
 	// Build the base query
+	sortKey := r.sortKey(params.Sorting)
 	baseQuery := `
 		SELECT
 			id,
@@ -33,11 +34,24 @@ func (r *Repo) Query(ctx context.Context, projectID int64, params *query.QueryPa
 			expiration,
 			active,
 			created_at,
-			updated_at
+			updated_at,
+			deleted_at,
+			COALESCE(created_by, ''),
+			COALESCE(updated_by, ''),
+			COALESCE(owner_id, ''),
+			COALESCE(external_id, ''),
+			` + sortKey.Select() + `
 		FROM altalune_project_api_keys
 		WHERE project_id = $1
 	`
 
+	// Trashed queries list soft-deleted API keys only
+	if params.Trashed {
+		baseQuery += " AND deleted_at IS NOT NULL"
+	} else {
+		baseQuery += " AND deleted_at IS NULL"
+	}
+
 	// Build WHERE conditions for filters and search
 	var whereConditions []string
 	var args []interface{}
@@ -67,9 +81,11 @@ func (r *Repo) Query(ctx context.Context, projectID int64, params *query.QueryPa
 			switch field {
 			case "name", "names":
 				dbColumn = "name"
+			case "owner_id":
+				dbColumn = "owner_id"
 			case "status", "statuses":
 				// Handle combined status as a special case
-				r.handleCombinedStatusFilter(&whereConditions, &args, &argCounter, values)
+				r.handleCombinedStatusFilter(&whereConditions, &args, &argCounter, values, params.Now())
 				continue
 			default:
 				continue // Skip unknown fields
@@ -93,23 +109,24 @@ func (r *Repo) Query(ctx context.Context, projectID int64, params *query.QueryPa
 	}
 
 	// First, get the total count before pagination
-	countQuery := "SELECT COUNT(*) FROM (" + baseQuery + ") as filtered"
-	var totalRows int32
-	err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&totalRows)
+	count, err := postgres.CountRows(ctx, r.db, params.Count, baseQuery, args)
 	if err != nil {
 		return nil, fmt.Errorf("count api keys: %w", err)
 	}
 
+	// Keyset pages start past the last row already fetched
+	if condition, keysetArgs := sortKey.After(params.Keyset, argCounter); condition != "" {
+		baseQuery += " AND " + condition
+		args = append(args, keysetArgs...)
+		argCounter += len(keysetArgs)
+	}
+
 	// Add ORDER BY clause
-	orderClause := r.buildOrderClause(params.Sorting)
-	baseQuery += orderClause
+	baseQuery += sortKey.OrderBy()
 
 	// Add pagination
-	pageSize := params.Pagination.PageSize
-	page := params.Pagination.Page
-	offset := (page - 1) * pageSize
 	baseQuery += fmt.Sprintf(" LIMIT $%d OFFSET $%d", argCounter, argCounter+1)
-	args = append(args, pageSize, offset)
+	args = append(args, params.PageLimit(), params.FetchOffset())
 
 	// Execute query
 	rows, err := r.db.QueryContext(ctx, baseQuery, args...)
@@ -120,6 +137,7 @@ func (r *Repo) Query(ctx context.Context, projectID int64, params *query.QueryPa
 
 	// Scan results
 	var results []*ApiKeyQueryResult
+	var sortValue string
 	for rows.Next() {
 		var result ApiKeyQueryResult
 		err := rows.Scan(
@@ -130,6 +148,12 @@ func (r *Repo) Query(ctx context.Context, projectID int64, params *query.QueryPa
 			&result.Active,
 			&result.CreatedAt,
 			&result.UpdatedAt,
+			&result.DeletedAt,
+			&result.CreatedBy,
+			&result.UpdatedBy,
+			&result.OwnerID,
+			&result.ExternalID,
+			&sortValue,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("scan api key: %w", err)
@@ -147,93 +171,54 @@ func (r *Repo) Query(ctx context.Context, projectID int64, params *query.QueryPa
 		apiKeys = append(apiKeys, result.ToApiKey())
 	}
 
-	// Calculate pagination
-	pageCount := int32((totalRows + pageSize - 1) / pageSize)
-
 	// Get filters (for dropdown values)
 	filters, err := r.getDistinctValues(ctx, projectID)
 	if err != nil {
 		return nil, fmt.Errorf("get distinct values: %w", err)
 	}
 
-	return &query.QueryResult[ApiKey]{
-		Data:       apiKeys,
-		TotalRows:  totalRows,
-		TotalPages: pageCount,
-		Filters:    filters,
-	}, nil
-}
-
-func (r *Repo) handleExpirationFilter(whereConditions *[]string, args *[]interface{}, argCounter *int, values []string) {
-	now := time.Now()
-	var expirationConditions []string
-
-	for _, value := range values {
-		switch strings.ToLower(value) {
-		case "active":
-			expirationConditions = append(expirationConditions, fmt.Sprintf("expiration > $%d", *argCounter))
-			*args = append(*args, now)
-			*argCounter++
-		case "expired":
-			expirationConditions = append(expirationConditions, fmt.Sprintf("expiration <= $%d", *argCounter))
-			*args = append(*args, now)
-			*argCounter++
-		case "expiring_soon":
-			soonThreshold := now.AddDate(0, 0, 30) // 30 days from now
-			expirationConditions = append(expirationConditions, fmt.Sprintf("expiration > $%d AND expiration <= $%d", *argCounter, *argCounter+1))
-			*args = append(*args, now, soonThreshold)
-			*argCounter += 2
-		}
+	result := &query.QueryResult[ApiKey]{
+		Data:    apiKeys,
+		Filters: filters,
 	}
-
-	if len(expirationConditions) > 0 {
-		*whereConditions = append(*whereConditions, "("+strings.Join(expirationConditions, " OR ")+")")
+	query.FillPage(result, params, count)
+	if n := len(results); n > 0 {
+		result.NextKeyset = &query.Keyset{SortKey: sortValue, AfterID: results[n-1].ID}
 	}
+	return result, nil
 }
 
-func (r *Repo) handleCombinedStatusFilter(whereConditions *[]string, args *[]interface{}, argCounter *int, values []string) {
-	now := time.Now()
-	tenDaysFromNow := now.AddDate(0, 0, 10) // 10 days from now
-	var statusConditions []string
-
+// handleCombinedStatusFilter matches the keys whose ComputeStatus at now is one
+// of values, unknown values matching nothing
+func (r *Repo) handleCombinedStatusFilter(whereConditions *[]string, args *[]interface{}, argCounter *int, values []string, now time.Time) {
+	var placeholders []string
+	var statuses []any
 	for _, value := range values {
-		switch strings.ToLower(value) {
-		case "active":
-			// Active: status == active AND expiration time is still far away (>10 days)
-			condition := fmt.Sprintf("(active = $%d AND expiration > $%d)", *argCounter, *argCounter+1)
-			statusConditions = append(statusConditions, condition)
-			*args = append(*args, true, tenDaysFromNow)
-			*argCounter += 2
-		case "inactive":
-			// Inactive: status == inactive AND expiration time is still future (not expired)
-			// This excludes expired items which should only appear in "expired" filter
-			condition := fmt.Sprintf("(active = $%d AND expiration > $%d)", *argCounter, *argCounter+1)
-			statusConditions = append(statusConditions, condition)
-			*args = append(*args, false, now)
-			*argCounter += 2
-		case "expired":
-			// Expired: status == inactive AND expiration time is passed
-			condition := fmt.Sprintf("(active = $%d AND expiration <= $%d)", *argCounter, *argCounter+1)
-			statusConditions = append(statusConditions, condition)
-			*args = append(*args, false, now)
-			*argCounter += 2
-		case "expiring_soon":
-			// Expiring Soon: status == active AND expiration time is near (<=10 days)
-			condition := fmt.Sprintf("(active = $%d AND expiration > $%d AND expiration <= $%d)", *argCounter, *argCounter+1, *argCounter+2)
-			statusConditions = append(statusConditions, condition)
-			*args = append(*args, true, now, tenDaysFromNow)
-			*argCounter += 3
+		if status, ok := ParseStatus(value); ok {
+			placeholders = append(placeholders, fmt.Sprintf("$%d", *argCounter+2+len(statuses)))
+			statuses = append(statuses, string(status))
 		}
 	}
-
-	if len(statusConditions) > 0 {
-		*whereConditions = append(*whereConditions, "("+strings.Join(statusConditions, " OR ")+")")
+	if len(statuses) == 0 {
+		*whereConditions = append(*whereConditions, "FALSE")
+		return
 	}
+
+	condition := fmt.Sprintf("%s IN (%s)",
+		statusSQL(fmt.Sprintf("$%d", *argCounter), fmt.Sprintf("$%d", *argCounter+1)),
+		strings.Join(placeholders, ","),
+	)
+	*whereConditions = append(*whereConditions, condition)
+	*args = append(*args, statusSQLArgs(now)...)
+	*args = append(*args, statuses...)
+	*argCounter += 2 + len(statuses)
 }
 
-func (r *Repo) buildOrderClause(sorting *query.SortingParams) string {
+// sortKey maps the sorting of a query to the order its pages are fetched
+// and resumed in.
+func (r *Repo) sortKey(sorting *query.SortingParams) postgres.SortKey {
 	if sorting == nil {
-		return " ORDER BY updated_at DESC" // Default sorting
+		return postgres.SortKey{Expr: "updated_at", Desc: true} // Default sorting
 	}
 
 	// Map sorting field to database column
@@ -247,19 +232,15 @@ func (r *Repo) buildOrderClause(sorting *query.SortingParams) string {
 		dbColumn = "created_at"
 	case "updatedAt", "updated_at":
 		dbColumn = "updated_at"
+	case "deletedAt", "deleted_at":
+		dbColumn = "COALESCE(deleted_at, 'infinity')"
 	case "id":
 		dbColumn = "id"
 	default:
 		dbColumn = "updated_at" // Fallback to default
 	}
 
-	// Determine sort direction
-	direction := "ASC"
-	if sorting.Order == query.SortOrderDesc {
-		direction = "DESC"
-	}
-
-	return fmt.Sprintf(" ORDER BY %s %s", dbColumn, direction)
+	return postgres.NewSortKey(dbColumn, sorting)
 }
 
 func (r *Repo) getDistinctValues(ctx context.Context, projectID int64) (map[string][]string, error) {
@@ -269,7 +250,7 @@ func (r *Repo) getDistinctValues(ctx context.Context, projectID int64) (map[stri
 	namesQuery := `
 		SELECT DISTINCT name
 		FROM altalune_project_api_keys
-		WHERE project_id = $1 AND name IS NOT NULL
+		WHERE project_id = $1 AND name IS NOT NULL AND deleted_at IS NULL
 		ORDER BY name
 	`
 	names, err := r.queryDistinctValues(ctx, namesQuery, projectID)
@@ -279,7 +260,7 @@ func (r *Repo) getDistinctValues(ctx context.Context, projectID int64) (map[stri
 	filters["names"] = names
 
 	// Set combined statuses (computed based on both active field and expiration)
-	filters["statuses"] = []string{"active", "inactive", "expired", "expiring_soon"}
+	filters["statuses"] = StatusValues()
 
 	return filters, nil
 }
@@ -304,16 +285,14 @@ func (r *Repo) queryDistinctValues(ctx context.Context, query string, projectID
 }
 
 func (r *Repo) Create(ctx context.Context, input *CreateApiKeyInput) (*CreateApiKeyResult, error) {
-	// Generate public ID
-	publicID, _ := nanoid.GeneratePublicID()
-
 	// Generate secure API key
-	key, err := r.generateAPIKey()
+	key, err := generateAPIKey()
 	if err != nil {
 		return nil, fmt.Errorf("generate api key: %w", err)
 	}
 
-	// Check for name uniqueness within project
+	// Check for name uniqueness within project, trashed keys included so
+	// restoring them never creates duplicates
 	nameCheckQuery := `SELECT COUNT(*) FROM altalune_project_api_keys WHERE project_id = $1 AND LOWER(name) = LOWER($2)`
 	var count int
 	err = r.db.QueryRowContext(ctx, nameCheckQuery, input.ProjectID, input.Name).Scan(&count)
@@ -334,27 +313,40 @@ func (r *Repo) Create(ctx context.Context, input *CreateApiKeyInput) (*CreateApi
 			key,
 			active,
 			created_at,
-			updated_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
-		RETURNING id, created_at, updated_at
+			updated_at,
+			created_by,
+			updated_by,
+			owner_id,
+			external_id
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NULLIF($9, ''), NULLIF($9, ''), NULLIF($10, ''), NULLIF($11, ''))
+		RETURNING id, created_at, updated_at, COALESCE(created_by, ''), COALESCE(updated_by, ''),
+		          COALESCE(owner_id, ''), COALESCE(external_id, '')
 	`
 
 	now := time.Now()
 	var result CreateApiKeyResult
-	err = r.db.QueryRowContext(
-		ctx,
-		insertQuery,
-		publicID,
-		input.ProjectID,
-		input.Name,
-		input.Expiration,
-		key,
-		true, // New API keys are active by default
-		now,
-		now,
-	).Scan(&result.ID, &result.CreatedAt, &result.UpdatedAt)
+	publicID, err := postgres.InsertWithPublicID(func(publicID string) error {
+		return r.db.QueryRowContext(
+			ctx,
+			insertQuery,
+			publicID,
+			input.ProjectID,
+			input.Name,
+			input.Expiration,
+			key,
+			true, // New API keys are active by default
+			now,
+			now,
+			auth.ActorID(ctx),
+			input.OwnerID,
+			input.ExternalID,
+		).Scan(&result.ID, &result.CreatedAt, &result.UpdatedAt, &result.CreatedBy, &result.UpdatedBy, &result.OwnerID, &result.ExternalID)
+	})
 
 	if err != nil {
+		if postgres.IsExternalIDViolation(err) {
+			return nil, ErrExternalIDTaken
+		}
 		return nil, fmt.Errorf("insert api key: %w", err)
 	}
 
@@ -366,7 +358,7 @@ func (r *Repo) Create(ctx context.Context, input *CreateApiKeyInput) (*CreateApi
 	return &result, nil
 }
 
-func (r *Repo) generateAPIKey() (string, error) {
+func generateAPIKey() (string, error) {
 	// Generate 32 bytes of randomness
 	randomBytes := make([]byte, 32)
 	_, err := rand.Read(randomBytes)
@@ -390,9 +382,13 @@ func (r *Repo) GetByID(ctx context.Context, projectID int64, publicID string) (*
 			expiration,
 			active,
 			created_at,
-			updated_at
+			updated_at,
+			COALESCE(created_by, ''),
+			COALESCE(updated_by, ''),
+			COALESCE(owner_id, ''),
+			COALESCE(external_id, '')
 		FROM altalune_project_api_keys
-		WHERE project_id = $1 AND public_id = $2
+		WHERE project_id = $1 AND public_id = $2 AND deleted_at IS NULL
 	`
 
 	var result ApiKeyQueryResult
@@ -403,6 +399,10 @@ func (r *Repo) GetByID(ctx context.Context, projectID int64, publicID string) (*
 		&result.Active,
 		&result.CreatedAt,
 		&result.UpdatedAt,
+		&result.CreatedBy,
+		&result.UpdatedBy,
+		&result.OwnerID,
+		&result.ExternalID,
 	)
 
 	if err != nil {
@@ -415,7 +415,9 @@ func (r *Repo) GetByID(ctx context.Context, projectID int64, publicID string) (*
 	return result.ToApiKey(), nil
 }
 
-func (r *Repo) GetByKey(ctx context.Context, key string) (*ApiKey, error) {
+// GetByExternalID retrieves an API key of a project by its key in a
+// provisioning tool, trashed keys included with DeletedAt set
+func (r *Repo) GetByExternalID(ctx context.Context, projectID int64, externalID string) (*ApiKey, error) {
 	query := `
 		SELECT
 			public_id,
@@ -423,21 +425,209 @@ func (r *Repo) GetByKey(ctx context.Context, key string) (*ApiKey, error) {
 			expiration,
 			active,
 			created_at,
-			updated_at
+			updated_at,
+			deleted_at,
+			COALESCE(created_by, ''),
+			COALESCE(updated_by, ''),
+			COALESCE(owner_id, ''),
+			COALESCE(external_id, '')
 		FROM altalune_project_api_keys
-		WHERE key = $1
+		WHERE project_id = $1 AND external_id = $2
 	`
 
 	var result ApiKeyQueryResult
-	err := r.db.QueryRowContext(ctx, query, key).Scan(
+	err := r.db.QueryRowContext(ctx, query, projectID, externalID).Scan(
 		&result.PublicID,
 		&result.Name,
 		&result.Expiration,
 		&result.Active,
 		&result.CreatedAt,
 		&result.UpdatedAt,
+		&result.DeletedAt,
+		&result.CreatedBy,
+		&result.UpdatedBy,
+		&result.OwnerID,
+		&result.ExternalID,
 	)
 
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrApiKeyNotFound
+		}
+		return nil, fmt.Errorf("get api key by external id: %w", err)
+	}
+
+	return result.ToApiKey(), nil
+}
+
+// GetByName retrieves a live API key of a project by its name, ignoring case
+func (r *Repo) GetByName(ctx context.Context, projectID int64, name string) (*ApiKey, error) {
+	query := `
+		SELECT
+			public_id,
+			name,
+			expiration,
+			active,
+			created_at,
+			updated_at,
+			COALESCE(created_by, ''),
+			COALESCE(updated_by, ''),
+			COALESCE(owner_id, ''),
+			COALESCE(external_id, '')
+		FROM altalune_project_api_keys
+		WHERE project_id = $1 AND LOWER(name) = LOWER($2) AND deleted_at IS NULL
+	`
+
+	var result ApiKeyQueryResult
+	err := r.db.QueryRowContext(ctx, query, projectID, name).Scan(
+		&result.PublicID,
+		&result.Name,
+		&result.Expiration,
+		&result.Active,
+		&result.CreatedAt,
+		&result.UpdatedAt,
+		&result.CreatedBy,
+		&result.UpdatedBy,
+		&result.OwnerID,
+		&result.ExternalID,
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrApiKeyNotFound
+		}
+		return nil, fmt.Errorf("get api key by name: %w", err)
+	}
+
+	return result.ToApiKey(), nil
+}
+
+// SetExternalID sets the key of a live API key in a provisioning tool.
+// Setting the key the API key already has changes nothing; one with another
+// key is returned unchanged with ErrApiKeyAlreadyManaged.
+func (r *Repo) SetExternalID(ctx context.Context, projectID int64, publicID, externalID string) (*ApiKey, error) {
+	updateQuery := `
+		UPDATE altalune_project_api_keys
+		SET external_id = $3, updated_at = $4, updated_by = NULLIF($5, '')
+		WHERE project_id = $1 AND public_id = $2 AND deleted_at IS NULL AND external_id IS NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, updateQuery, projectID, publicID, externalID, time.Now(), auth.ActorID(ctx))
+	if err != nil {
+		if postgres.IsExternalIDViolation(err) {
+			return nil, ErrExternalIDTaken
+		}
+		return nil, fmt.Errorf("set api key external id: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("get rows affected: %w", err)
+	}
+
+	apiKey, err := r.GetByID(ctx, projectID, publicID)
+	if err != nil {
+		return nil, err
+	}
+	if rowsAffected == 0 && apiKey.ExternalID != externalID {
+		return apiKey, ErrApiKeyAlreadyManaged
+	}
+
+	return apiKey, nil
+}
+
+// ListActive returns the active, unexpired keys of a project, oldest first
+func (r *Repo) ListActive(ctx context.Context, projectID int64) ([]*ApiKey, error) {
+	query := `
+		SELECT
+			public_id,
+			name,
+			expiration,
+			active,
+			created_at,
+			updated_at,
+			COALESCE(created_by, ''),
+			COALESCE(updated_by, ''),
+			COALESCE(owner_id, ''),
+			COALESCE(external_id, '')
+		FROM altalune_project_api_keys
+		WHERE project_id = $1 AND active = true AND expiration > NOW() AND deleted_at IS NULL
+		ORDER BY created_at, id
+	`
+
+	// Scoped to the project for the policy job, which runs outside requests
+	apiKeys := make([]*ApiKey, 0)
+	err := postgres.WithProject(ctx, r.db, projectID, func(ctx context.Context) error {
+		rows, err := r.db.QueryContext(ctx, query, projectID)
+		if err != nil {
+			return fmt.Errorf("list active api keys: %w", err)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var result ApiKeyQueryResult
+			if err := rows.Scan(
+				&result.PublicID,
+				&result.Name,
+				&result.Expiration,
+				&result.Active,
+				&result.CreatedAt,
+				&result.UpdatedAt,
+				&result.CreatedBy,
+				&result.UpdatedBy,
+				&result.OwnerID,
+				&result.ExternalID,
+			); err != nil {
+				return fmt.Errorf("scan api key: %w", err)
+			}
+			apiKeys = append(apiKeys, result.ToApiKey())
+		}
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("iterate api keys: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return apiKeys, nil
+}
+
+func (r *Repo) GetByKey(ctx context.Context, key string) (*ApiKey, error) {
+	query := `
+		SELECT
+			public_id,
+			name,
+			expiration,
+			active,
+			created_at,
+			updated_at,
+			COALESCE(created_by, ''),
+			COALESCE(updated_by, ''),
+			COALESCE(owner_id, ''),
+			COALESCE(external_id, '')
+		FROM altalune_project_api_keys
+		WHERE key = $1 AND deleted_at IS NULL
+	`
+
+	// The key authenticates before any project is known
+	var result ApiKeyQueryResult
+	err := postgres.WithAllProjects(ctx, r.db, func(ctx context.Context) error {
+		return r.db.QueryRowContext(ctx, query, key).Scan(
+			&result.PublicID,
+			&result.Name,
+			&result.Expiration,
+			&result.Active,
+			&result.CreatedAt,
+			&result.UpdatedAt,
+			&result.CreatedBy,
+			&result.UpdatedBy,
+			&result.OwnerID,
+			&result.ExternalID,
+		)
+	})
+
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, ErrApiKeyNotFound
@@ -449,7 +639,8 @@ func (r *Repo) GetByKey(ctx context.Context, key string) (*ApiKey, error) {
 }
 
 func (r *Repo) Update(ctx context.Context, input *UpdateApiKeyInput) (*UpdateApiKeyResult, error) {
-	// Check for name uniqueness within project (excluding current record)
+	// Check for name uniqueness within project (excluding current record),
+	// trashed keys included
 	nameCheckQuery := `
 		SELECT COUNT(*)
 		FROM altalune_project_api_keys
@@ -464,19 +655,34 @@ func (r *Repo) Update(ctx context.Context, input *UpdateApiKeyInput) (*UpdateApi
 		return nil, ErrApiKeyAlreadyExists
 	}
 
-	// Update query
+	// Update query, returning the current row flagged as a conflict instead
+	// when it changed since the expected version
 	updateQuery := `
-		UPDATE altalune_project_api_keys
-		SET
-			name = $1,
-			expiration = $2,
-			updated_at = $3
-		WHERE project_id = $4 AND public_id = $5
-		RETURNING id, active, created_at, updated_at
+		WITH updated AS (
+			UPDATE altalune_project_api_keys
+			SET
+				name = $1,
+				expiration = $2,
+				updated_at = $3,
+				updated_by = NULLIF($7, '')
+			WHERE project_id = $4 AND public_id = $5 AND deleted_at IS NULL
+				AND ($6::timestamptz IS NULL OR updated_at = $6)
+			RETURNING *
+		), result AS (
+			SELECT false AS conflict, updated.* FROM updated
+			UNION ALL
+			SELECT true, k.* FROM altalune_project_api_keys k
+			WHERE k.project_id = $4 AND k.public_id = $5 AND k.deleted_at IS NULL
+				AND NOT EXISTS (SELECT 1 FROM updated)
+		)
+		SELECT conflict, id, active, created_at, updated_at, COALESCE(created_by, ''), COALESCE(updated_by, ''),
+		       COALESCE(owner_id, ''), COALESCE(external_id, '')
+		FROM result
 	`
 
 	now := time.Now()
 	var result UpdateApiKeyResult
+	var conflict bool
 	err = r.db.QueryRowContext(
 		ctx,
 		updateQuery,
@@ -485,7 +691,9 @@ func (r *Repo) Update(ctx context.Context, input *UpdateApiKeyInput) (*UpdateApi
 		now,
 		input.ProjectID,
 		input.PublicID,
-	).Scan(&result.ID, &result.Active, &result.CreatedAt, &result.UpdatedAt)
+		input.ExpectedUpdatedAt,
+		auth.ActorID(ctx),
+	).Scan(&conflict, &result.ID, &result.Active, &result.CreatedAt, &result.UpdatedAt, &result.CreatedBy, &result.UpdatedBy, &result.OwnerID, &result.ExternalID)
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -493,6 +701,9 @@ func (r *Repo) Update(ctx context.Context, input *UpdateApiKeyInput) (*UpdateApi
 		}
 		return nil, fmt.Errorf("update api key: %w", err)
 	}
+	if conflict {
+		return nil, ErrApiKeyVersionConflict
+	}
 
 	result.PublicID = input.PublicID
 	result.Name = input.Name
@@ -501,13 +712,15 @@ func (r *Repo) Update(ctx context.Context, input *UpdateApiKeyInput) (*UpdateApi
 	return &result, nil
 }
 
+// Delete moves an API key to the trash. Trashed keys no longer authenticate.
 func (r *Repo) Delete(ctx context.Context, input *DeleteApiKeyInput) error {
 	deleteQuery := `
-		DELETE FROM altalune_project_api_keys
-		WHERE project_id = $1 AND public_id = $2
+		UPDATE altalune_project_api_keys
+		SET deleted_at = $3, updated_at = $3, updated_by = NULLIF($4, '')
+		WHERE project_id = $1 AND public_id = $2 AND deleted_at IS NULL
 	`
 
-	result, err := r.db.ExecContext(ctx, deleteQuery, input.ProjectID, input.PublicID)
+	result, err := r.db.ExecContext(ctx, deleteQuery, input.ProjectID, input.PublicID, time.Now(), auth.ActorID(ctx))
 	if err != nil {
 		return fmt.Errorf("delete api key: %w", err)
 	}
@@ -524,26 +737,76 @@ func (r *Repo) Delete(ctx context.Context, input *DeleteApiKeyInput) error {
 	return nil
 }
 
+// Restore moves an API key out of the trash
+func (r *Repo) Restore(ctx context.Context, input *RestoreApiKeyInput) (*ApiKey, error) {
+	restoreQuery := `
+		UPDATE altalune_project_api_keys
+		SET deleted_at = NULL, updated_at = $3, updated_by = NULLIF($4, '')
+		WHERE project_id = $1 AND public_id = $2 AND deleted_at IS NOT NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, restoreQuery, input.ProjectID, input.PublicID, time.Now(), auth.ActorID(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("restore api key: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return nil, ErrApiKeyNotFound
+	}
+
+	return r.GetByID(ctx, input.ProjectID, input.PublicID)
+}
+
+// PurgeDeleted permanently removes API keys trashed before the given time
+func (r *Repo) PurgeDeleted(ctx context.Context, before time.Time) (int64, error) {
+	purgeQuery := `
+		DELETE FROM altalune_project_api_keys
+		WHERE deleted_at < $1
+	`
+
+	var purged int64
+	err := postgres.WithAllProjects(ctx, r.db, func(ctx context.Context) error {
+		result, err := r.db.ExecContext(ctx, purgeQuery, before)
+		if err != nil {
+			return fmt.Errorf("purge deleted api keys: %w", err)
+		}
+		purged, err = result.RowsAffected()
+		return err
+	})
+
+	return purged, err
+}
+
 func (r *Repo) Activate(ctx context.Context, input *ActivateApiKeyInput) (*ActivateApiKeyResult, error) {
 	// Set expiration to 1 year from now when reactivating (in case it was set to epoch time)
 	now := time.Now()
-	oneYearFromNow := now.AddDate(1, 0, 0)
+	expiration := input.Expiration
+	if expiration.IsZero() {
+		expiration = now.AddDate(1, 0, 0)
+	}
 	updateQuery := `
 		UPDATE altalune_project_api_keys
-		SET active = true, expiration = $1, updated_at = $2
-		WHERE project_id = $3 AND public_id = $4
-		RETURNING id, name, expiration, created_at, updated_at
+		SET active = true, expiration = $1, updated_at = $2, updated_by = NULLIF($5, '')
+		WHERE project_id = $3 AND public_id = $4 AND deleted_at IS NULL
+		RETURNING id, name, expiration, created_at, updated_at, COALESCE(created_by, ''), COALESCE(updated_by, ''),
+		          COALESCE(owner_id, ''), COALESCE(external_id, '')
 	`
 
 	var result ActivateApiKeyResult
 	err := r.db.QueryRowContext(
 		ctx,
 		updateQuery,
-		oneYearFromNow,
+		expiration,
 		now,
 		input.ProjectID,
 		input.PublicID,
-	).Scan(&result.ID, &result.Name, &result.Expiration, &result.CreatedAt, &result.UpdatedAt)
+		auth.ActorID(ctx),
+	).Scan(&result.ID, &result.Name, &result.Expiration, &result.CreatedAt, &result.UpdatedAt, &result.CreatedBy, &result.UpdatedBy, &result.OwnerID, &result.ExternalID)
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -563,21 +826,26 @@ func (r *Repo) Deactivate(ctx context.Context, input *DeactivateApiKeyInput) (*D
 	epochTime := time.Unix(0, 0).UTC()
 	updateQuery := `
 		UPDATE altalune_project_api_keys
-		SET active = false, expiration = $1, updated_at = $2
-		WHERE project_id = $3 AND public_id = $4
-		RETURNING id, name, expiration, created_at, updated_at
+		SET active = false, expiration = $1, updated_at = $2, updated_by = NULLIF($5, '')
+		WHERE project_id = $3 AND public_id = $4 AND deleted_at IS NULL
+		RETURNING id, name, expiration, created_at, updated_at, COALESCE(created_by, ''), COALESCE(updated_by, ''),
+		          COALESCE(owner_id, ''), COALESCE(external_id, '')
 	`
 
+	// Scoped to the project for the policy job, which runs outside requests
 	now := time.Now()
 	var result DeactivateApiKeyResult
-	err := r.db.QueryRowContext(
-		ctx,
-		updateQuery,
-		epochTime,
-		now,
-		input.ProjectID,
-		input.PublicID,
-	).Scan(&result.ID, &result.Name, &result.Expiration, &result.CreatedAt, &result.UpdatedAt)
+	err := postgres.WithProject(ctx, r.db, input.ProjectID, func(ctx context.Context) error {
+		return r.db.QueryRowContext(
+			ctx,
+			updateQuery,
+			epochTime,
+			now,
+			input.ProjectID,
+			input.PublicID,
+			auth.ActorID(ctx),
+		).Scan(&result.ID, &result.Name, &result.Expiration, &result.CreatedAt, &result.UpdatedAt, &result.CreatedBy, &result.UpdatedBy, &result.OwnerID, &result.ExternalID)
+	})
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
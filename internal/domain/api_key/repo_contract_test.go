@@ -0,0 +1,328 @@
+package api_key_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hrz8/altalune/internal/auth"
+	"github.com/hrz8/altalune/internal/domain/api_key"
+	"github.com/hrz8/altalune/internal/domain/project"
+	"github.com/hrz8/altalune/internal/shared/query"
+	"github.com/hrz8/altalune/internal/testdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) { testdb.Main(m) }
+
+func TestInMemRepo(t *testing.T) {
+	var lastProjectID int64
+	testRepoContract(t, api_key.NewInMemRepo(), func(t *testing.T) int64 {
+		lastProjectID++
+		return lastProjectID
+	})
+}
+
+func TestRepo(t *testing.T) {
+	db := testdb.Open(t)
+	projects := project.NewRepo(db)
+
+	testRepoContract(t, api_key.NewRepo(db), func(t *testing.T) int64 {
+		prj, err := projects.Create(context.Background(), &project.CreateProjectInput{
+			Name:        "api-key-" + testdb.Token(t),
+			Timezone:    "UTC",
+			Environment: project.EnvironmentStatusSandbox,
+		})
+		require.NoError(t, err)
+		return prj.ID
+	})
+}
+
+// testRepoContract runs the behavior every api_key.Repositor must share
+// against repo. newProjectID returns the internal ID of a new, empty project.
+func testRepoContract(t *testing.T, repo api_key.Repositor, newProjectID func(t *testing.T) int64) {
+	ctx := context.Background()
+	nextMonth := time.Now().AddDate(0, 1, 0).Truncate(time.Microsecond)
+
+	create := func(t *testing.T, projectID int64, name string, expiration time.Time) *api_key.CreateApiKeyResult {
+		t.Helper()
+		created, err := repo.Create(ctx, &api_key.CreateApiKeyInput{
+			ProjectID:  projectID,
+			Name:       name,
+			Expiration: expiration,
+		})
+		require.NoError(t, err)
+		return created
+	}
+
+	t.Run("audit actors", func(t *testing.T) {
+		projectID := newProjectID(t)
+		creator, updater := testdb.Token(t), testdb.Token(t)
+		as := func(actor string) context.Context {
+			return auth.WithAuthContext(ctx, &auth.AuthContext{UserID: actor, IsAuthenticated: true})
+		}
+
+		created, err := repo.Create(as(creator), &api_key.CreateApiKeyInput{ProjectID: projectID, Name: "Audited", Expiration: nextMonth})
+		require.NoError(t, err)
+		assert.Equal(t, creator, created.CreatedBy)
+
+		updated, err := repo.Update(as(updater), &api_key.UpdateApiKeyInput{
+			ProjectID:  projectID,
+			PublicID:   created.PublicID,
+			Name:       "Audited again",
+			Expiration: nextMonth,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, creator, updated.CreatedBy, "the creator is kept")
+		assert.Equal(t, updater, updated.UpdatedBy)
+
+		anonymous := create(t, projectID, "Anonymous", nextMonth)
+		assert.Empty(t, anonymous.CreatedBy, "rows written outside of a request have no actor")
+	})
+
+	t.Run("create and get", func(t *testing.T) {
+		projectID := newProjectID(t)
+		created := create(t, projectID, "Primary", nextMonth)
+		assert.True(t, strings.HasPrefix(created.Key, "sk-"))
+
+		byID, err := repo.GetByID(ctx, projectID, created.PublicID)
+		require.NoError(t, err)
+		assert.Equal(t, "Primary", byID.Name)
+		assert.True(t, byID.Active, "keys are active by default")
+		assert.True(t, nextMonth.Equal(byID.Expiration))
+
+		byKey, err := repo.GetByKey(ctx, created.Key)
+		require.NoError(t, err)
+		assert.Equal(t, created.PublicID, byKey.ID)
+
+		_, err = repo.GetByID(ctx, newProjectID(t), created.PublicID)
+		assert.ErrorIs(t, err, api_key.ErrApiKeyNotFound, "keys are scoped to their project")
+		_, err = repo.GetByKey(ctx, "sk-unknown")
+		assert.ErrorIs(t, err, api_key.ErrApiKeyNotFound)
+
+		_, err = repo.Create(ctx, &api_key.CreateApiKeyInput{ProjectID: projectID, Name: "PRIMARY", Expiration: nextMonth})
+		assert.ErrorIs(t, err, api_key.ErrApiKeyAlreadyExists, "names are unique per project, ignoring case")
+
+		create(t, newProjectID(t), "Primary", nextMonth)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		projectID := newProjectID(t)
+		created := create(t, projectID, "Update", nextMonth)
+		create(t, projectID, "Taken", nextMonth)
+
+		stale := created.UpdatedAt.Add(-time.Second)
+		_, err := repo.Update(ctx, &api_key.UpdateApiKeyInput{
+			ProjectID:         projectID,
+			PublicID:          created.PublicID,
+			Name:              "Stale",
+			Expiration:        nextMonth,
+			ExpectedUpdatedAt: &stale,
+		})
+		assert.ErrorIs(t, err, api_key.ErrApiKeyVersionConflict)
+
+		updated, err := repo.Update(ctx, &api_key.UpdateApiKeyInput{
+			ProjectID:         projectID,
+			PublicID:          created.PublicID,
+			Name:              "Renamed",
+			Expiration:        nextMonth,
+			ExpectedUpdatedAt: &created.UpdatedAt,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Renamed", updated.Name)
+
+		_, err = repo.Update(ctx, &api_key.UpdateApiKeyInput{
+			ProjectID:         projectID,
+			PublicID:          created.PublicID,
+			Name:              "Overwritten",
+			Expiration:        nextMonth,
+			ExpectedUpdatedAt: &created.UpdatedAt,
+		})
+		assert.ErrorIs(t, err, api_key.ErrApiKeyVersionConflict, "a version is only updated once")
+		_, err = repo.Update(ctx, &api_key.UpdateApiKeyInput{
+			ProjectID:         newProjectID(t),
+			PublicID:          created.PublicID,
+			Name:              "Elsewhere",
+			Expiration:        nextMonth,
+			ExpectedUpdatedAt: &updated.UpdatedAt,
+		})
+		assert.ErrorIs(t, err, api_key.ErrApiKeyNotFound, "a key of another project is not a conflict")
+
+		_, err = repo.Update(ctx, &api_key.UpdateApiKeyInput{
+			ProjectID:  projectID,
+			PublicID:   created.PublicID,
+			Name:       "taken",
+			Expiration: nextMonth,
+		})
+		assert.ErrorIs(t, err, api_key.ErrApiKeyAlreadyExists)
+
+		_, err = repo.Update(ctx, &api_key.UpdateApiKeyInput{
+			ProjectID:  projectID,
+			PublicID:   "unknown",
+			Name:       "Unknown",
+			Expiration: nextMonth,
+		})
+		assert.ErrorIs(t, err, api_key.ErrApiKeyNotFound)
+	})
+
+	t.Run("activation", func(t *testing.T) {
+		projectID := newProjectID(t)
+		created := create(t, projectID, "Activation", nextMonth)
+
+		deactivated, err := repo.Deactivate(ctx, &api_key.DeactivateApiKeyInput{ProjectID: projectID, PublicID: created.PublicID})
+		require.NoError(t, err)
+		assert.False(t, deactivated.Active)
+		assert.True(t, deactivated.Expiration.Before(time.Now()), "deactivated keys expire")
+
+		activated, err := repo.Activate(ctx, &api_key.ActivateApiKeyInput{ProjectID: projectID, PublicID: created.PublicID})
+		require.NoError(t, err)
+		assert.True(t, activated.Active)
+		assert.True(t, activated.Expiration.After(time.Now().AddDate(0, 11, 0)), "reactivated keys last a year")
+
+		capped := nextMonth.AddDate(0, 1, 0)
+		activated, err = repo.Activate(ctx, &api_key.ActivateApiKeyInput{ProjectID: projectID, PublicID: created.PublicID, Expiration: capped})
+		require.NoError(t, err)
+		assert.True(t, capped.Equal(activated.Expiration), "an explicit expiration overrides the year")
+
+		_, err = repo.Activate(ctx, &api_key.ActivateApiKeyInput{ProjectID: projectID, PublicID: "unknown"})
+		assert.ErrorIs(t, err, api_key.ErrApiKeyNotFound)
+	})
+
+	t.Run("list active", func(t *testing.T) {
+		projectID := newProjectID(t)
+		first := create(t, projectID, "First", nextMonth)
+		inactive := create(t, projectID, "Inactive", nextMonth)
+		trashed := create(t, projectID, "Trashed", nextMonth)
+		second := create(t, projectID, "Second", nextMonth)
+		create(t, newProjectID(t), "Elsewhere", nextMonth)
+
+		_, err := repo.Deactivate(ctx, &api_key.DeactivateApiKeyInput{ProjectID: projectID, PublicID: inactive.PublicID})
+		require.NoError(t, err)
+		require.NoError(t, repo.Delete(ctx, &api_key.DeleteApiKeyInput{ProjectID: projectID, PublicID: trashed.PublicID}))
+
+		active, err := repo.ListActive(ctx, projectID)
+		require.NoError(t, err)
+		ids := make([]string, 0, len(active))
+		for _, k := range active {
+			ids = append(ids, k.ID)
+		}
+		assert.Equal(t, []string{first.PublicID, second.PublicID}, ids)
+	})
+
+	t.Run("trash", func(t *testing.T) {
+		projectID := newProjectID(t)
+		created := create(t, projectID, "Trash", nextMonth)
+
+		require.NoError(t, repo.Delete(ctx, &api_key.DeleteApiKeyInput{ProjectID: projectID, PublicID: created.PublicID}))
+		assert.ErrorIs(t, repo.Delete(ctx, &api_key.DeleteApiKeyInput{ProjectID: projectID, PublicID: created.PublicID}), api_key.ErrApiKeyNotFound)
+
+		_, err := repo.GetByKey(ctx, created.Key)
+		assert.ErrorIs(t, err, api_key.ErrApiKeyNotFound, "trashed keys no longer authenticate")
+
+		_, err = repo.Create(ctx, &api_key.CreateApiKeyInput{ProjectID: projectID, Name: "Trash", Expiration: nextMonth})
+		assert.ErrorIs(t, err, api_key.ErrApiKeyAlreadyExists, "trashed keys keep their name")
+
+		trashed, err := repo.Query(ctx, projectID, &query.QueryParams{
+			Pagination: query.PaginationParams{Page: 1, PageSize: 10},
+			Trashed:    true,
+		})
+		require.NoError(t, err)
+		require.Len(t, trashed.Data, 1)
+		assert.NotNil(t, trashed.Data[0].DeletedAt)
+
+		restored, err := repo.Restore(ctx, &api_key.RestoreApiKeyInput{ProjectID: projectID, PublicID: created.PublicID})
+		require.NoError(t, err)
+		assert.Equal(t, created.PublicID, restored.ID)
+		_, err = repo.Restore(ctx, &api_key.RestoreApiKeyInput{ProjectID: projectID, PublicID: created.PublicID})
+		assert.ErrorIs(t, err, api_key.ErrApiKeyNotFound)
+
+		require.NoError(t, repo.Delete(ctx, &api_key.DeleteApiKeyInput{ProjectID: projectID, PublicID: created.PublicID}))
+		purged, err := repo.PurgeDeleted(ctx, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, purged, int64(1))
+
+		create(t, projectID, "Trash", nextMonth)
+	})
+
+	t.Run("external ids", func(t *testing.T) {
+		projectID := newProjectID(t)
+		managed, err := repo.Create(ctx, &api_key.CreateApiKeyInput{
+			ProjectID:  projectID,
+			Name:       "Managed",
+			Expiration: nextMonth,
+			ExternalID: "tf:managed",
+		})
+		require.NoError(t, err)
+		unmanaged := create(t, projectID, "Unmanaged", nextMonth)
+
+		byExternalID, err := repo.GetByExternalID(ctx, projectID, "tf:managed")
+		require.NoError(t, err)
+		assert.Equal(t, managed.PublicID, byExternalID.ID)
+		_, err = repo.GetByExternalID(ctx, newProjectID(t), "tf:managed")
+		assert.ErrorIs(t, err, api_key.ErrApiKeyNotFound, "external ids are scoped to their project")
+
+		_, err = repo.Create(ctx, &api_key.CreateApiKeyInput{ProjectID: projectID, Name: "Other", Expiration: nextMonth, ExternalID: "tf:managed"})
+		assert.ErrorIs(t, err, api_key.ErrExternalIDTaken)
+
+		byName, err := repo.GetByName(ctx, projectID, "unmanaged")
+		require.NoError(t, err)
+		assert.Equal(t, unmanaged.PublicID, byName.ID)
+
+		_, err = repo.SetExternalID(ctx, projectID, unmanaged.PublicID, "tf:managed")
+		assert.ErrorIs(t, err, api_key.ErrExternalIDTaken)
+		imported, err := repo.SetExternalID(ctx, projectID, unmanaged.PublicID, "tf:unmanaged")
+		require.NoError(t, err)
+		assert.Equal(t, "tf:unmanaged", imported.ExternalID)
+		_, err = repo.SetExternalID(ctx, projectID, unmanaged.PublicID, "tf:unmanaged")
+		assert.NoError(t, err, "setting the same external id again changes nothing")
+		_, err = repo.SetExternalID(ctx, projectID, unmanaged.PublicID, "tf:other")
+		assert.ErrorIs(t, err, api_key.ErrApiKeyAlreadyManaged)
+
+		require.NoError(t, repo.Delete(ctx, &api_key.DeleteApiKeyInput{ProjectID: projectID, PublicID: managed.PublicID}))
+		trashed, err := repo.GetByExternalID(ctx, projectID, "tf:managed")
+		require.NoError(t, err)
+		assert.NotNil(t, trashed.DeletedAt, "trashed keys keep their external id")
+		_, err = repo.GetByName(ctx, projectID, "Managed")
+		assert.ErrorIs(t, err, api_key.ErrApiKeyNotFound)
+	})
+
+	t.Run("query", func(t *testing.T) {
+		projectID := newProjectID(t)
+		create(t, projectID, "Beta", nextMonth)
+		create(t, projectID, "Alpha", nextMonth)
+		create(t, projectID, "Soon", time.Now().AddDate(0, 0, 5))
+		inactive := create(t, projectID, "Inactive", nextMonth)
+		_, err := repo.Deactivate(ctx, &api_key.DeactivateApiKeyInput{ProjectID: projectID, PublicID: inactive.PublicID})
+		require.NoError(t, err)
+
+		active, err := repo.Query(ctx, projectID, &query.QueryParams{
+			Pagination: query.PaginationParams{Page: 1, PageSize: 10},
+			Filters:    map[string][]string{"statuses": {"active"}},
+			Sorting:    &query.SortingParams{Field: "name", Order: query.SortOrderAsc},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), active.TotalRows)
+		require.Len(t, active.Data, 2)
+		assert.Equal(t, "Alpha", active.Data[0].Name)
+		assert.Equal(t, "Beta", active.Data[1].Name)
+		assert.Equal(t, []string{"Alpha", "Beta", "Inactive", "Soon"}, active.Filters["names"])
+
+		soon, err := repo.Query(ctx, projectID, &query.QueryParams{
+			Pagination: query.PaginationParams{Page: 1, PageSize: 10},
+			Filters:    map[string][]string{"statuses": {"expiring_soon", "expired"}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), soon.TotalRows)
+
+		paged, err := repo.Query(ctx, projectID, &query.QueryParams{
+			Pagination: query.PaginationParams{Page: 2, PageSize: 3},
+			Keyword:    "a",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), paged.TotalRows, "keywords match names ignoring case")
+		assert.Equal(t, int32(1), paged.TotalPages)
+		assert.Empty(t, paged.Data)
+	})
+}
@@ -0,0 +1,450 @@
+package api_key
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hrz8/altalune/internal/auth"
+	"github.com/hrz8/altalune/internal/postgres"
+	"github.com/hrz8/altalune/internal/shared/nanoid"
+	"github.com/hrz8/altalune/internal/shared/query"
+)
+
+// inMemApiKey is a stored API key with the columns ApiKeyQueryResult hides
+type inMemApiKey struct {
+	ApiKeyQueryResult
+	projectID int64
+	key       string
+}
+
+// InMemRepo is an in-memory Repositor for tests. It follows the semantics of
+// Repo: names are unique per project, trashed keys included, trashed keys no
+// longer authenticate, and updates honor ExpectedUpdatedAt.
+type InMemRepo struct {
+	mu     sync.RWMutex
+	keys   []*inMemApiKey // In insertion order
+	lastID int64
+}
+
+var _ Repositor = (*InMemRepo)(nil)
+
+// NewInMemRepo creates an empty in-memory API key repository
+func NewInMemRepo() *InMemRepo {
+	return &InMemRepo{}
+}
+
+// find returns the key of the project matching match, trashed keys included
+func (r *InMemRepo) find(projectID int64, match func(k *inMemApiKey) bool) *inMemApiKey {
+	for _, k := range r.keys {
+		if k.projectID == projectID && match(k) {
+			return k
+		}
+	}
+	return nil
+}
+
+func live(publicID string) func(k *inMemApiKey) bool {
+	return func(k *inMemApiKey) bool { return k.PublicID == publicID && k.DeletedAt == nil }
+}
+
+// liveApiKey converts a live key to the model returned by single-row lookups
+func liveApiKey(k *inMemApiKey) *ApiKey {
+	apiKey := k.ToApiKey()
+	apiKey.DeletedAt = nil
+	return apiKey
+}
+
+func (r *InMemRepo) Query(ctx context.Context, projectID int64, params *query.QueryParams) (*query.QueryResult[ApiKey], error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	now := params.Now()
+	rows := make([]*inMemApiKey, 0)
+	names := make([]string, 0)
+	for _, k := range r.keys {
+		if k.projectID != projectID {
+			continue
+		}
+		if k.DeletedAt == nil && !slices.Contains(names, k.Name) {
+			names = append(names, k.Name)
+		}
+		if (k.DeletedAt != nil) != params.Trashed {
+			continue
+		}
+		if !query.MatchKeyword(params.Keyword, k.Name) {
+			continue
+		}
+		if !matchApiKeyFilters(k, params.Filters, now) {
+			continue
+		}
+		rows = append(rows, k)
+	}
+	slices.Sort(names)
+
+	field, order := "updated_at", query.SortOrderDesc
+	if params.Sorting != nil {
+		field, order = params.Sorting.Field, query.SortOrderAsc
+		if params.Sorting.Order == query.SortOrderDesc {
+			order = query.SortOrderDesc
+		}
+	}
+	query.SortRows(rows, order, query.ThenByID(compareApiKeys(field), inMemApiKeyID))
+
+	page, totalRows, totalPages := query.PaginateAfter(rows, params.Pagination, params.Keyset, inMemApiKeyID)
+	results := make([]*ApiKey, 0, len(page))
+	for _, k := range page {
+		results = append(results, k.ToApiKey())
+	}
+
+	return &query.QueryResult[ApiKey]{
+		Data:       results,
+		TotalRows:  totalRows,
+		TotalPages: totalPages,
+		HasMore:    params.Pagination.Page < totalPages,
+		NextKeyset: query.NextKeyset(page, inMemApiKeyID),
+		Filters: map[string][]string{
+			"names":    names,
+			"statuses": StatusValues(),
+		},
+	}, nil
+}
+
+func inMemApiKeyID(k *inMemApiKey) int64 { return k.ID }
+
+func matchApiKeyFilters(k *inMemApiKey, filters map[string][]string, now time.Time) bool {
+	for field, values := range filters {
+		if len(values) == 0 {
+			continue
+		}
+		switch field {
+		case "name", "names":
+			if !query.MatchAny(k.Name, values) {
+				return false
+			}
+		case "owner_id":
+			if !query.MatchAny(k.OwnerID, values) {
+				return false
+			}
+		case "status", "statuses":
+			if !slices.ContainsFunc(values, func(status string) bool {
+				return strings.EqualFold(status, string(ComputeStatus(k.Active, k.Expiration, now)))
+			}) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func compareApiKeys(field string) func(a, b *inMemApiKey) int {
+	switch field {
+	case "name":
+		return func(a, b *inMemApiKey) int { return cmp.Compare(a.Name, b.Name) }
+	case "expiration":
+		return func(a, b *inMemApiKey) int { return a.Expiration.Compare(b.Expiration) }
+	case "createdAt", "created_at":
+		return func(a, b *inMemApiKey) int { return a.CreatedAt.Compare(b.CreatedAt) }
+	case "deletedAt", "deleted_at":
+		return func(a, b *inMemApiKey) int { return query.CompareNullTime(a.DeletedAt, b.DeletedAt) }
+	case "id":
+		return func(a, b *inMemApiKey) int { return cmp.Compare(a.ID, b.ID) }
+	default:
+		return func(a, b *inMemApiKey) int { return a.UpdatedAt.Compare(b.UpdatedAt) }
+	}
+}
+
+func (r *InMemRepo) Create(ctx context.Context, input *CreateApiKeyInput) (*CreateApiKeyResult, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key, err := generateAPIKey()
+	if err != nil {
+		return nil, fmt.Errorf("generate api key: %w", err)
+	}
+
+	if r.find(input.ProjectID, func(k *inMemApiKey) bool { return strings.EqualFold(k.Name, input.Name) }) != nil {
+		return nil, ErrApiKeyAlreadyExists
+	}
+	if input.ExternalID != "" && r.find(input.ProjectID, byExternalID(input.ExternalID)) != nil {
+		return nil, ErrExternalIDTaken
+	}
+
+	publicID, err := nanoid.GeneratePublicID()
+	if err != nil {
+		return nil, err
+	}
+
+	r.lastID++
+	now := postgres.Now()
+	actorID := auth.ActorID(ctx)
+	k := &inMemApiKey{
+		ApiKeyQueryResult: ApiKeyQueryResult{
+			ID:         r.lastID,
+			PublicID:   publicID,
+			Name:       input.Name,
+			Expiration: input.Expiration,
+			Active:     true,
+			CreatedAt:  now,
+			UpdatedAt:  now,
+			CreatedBy:  actorID,
+			UpdatedBy:  actorID,
+			OwnerID:    input.OwnerID,
+			ExternalID: input.ExternalID,
+		},
+		projectID: input.ProjectID,
+		key:       key,
+	}
+	r.keys = append(r.keys, k)
+
+	return &CreateApiKeyResult{
+		ID:         k.ID,
+		PublicID:   k.PublicID,
+		Name:       k.Name,
+		Key:        key,
+		Expiration: k.Expiration,
+		CreatedAt:  k.CreatedAt,
+		UpdatedAt:  k.UpdatedAt,
+		CreatedBy:  k.CreatedBy,
+		UpdatedBy:  k.UpdatedBy,
+		OwnerID:    k.OwnerID,
+		ExternalID: k.ExternalID,
+	}, nil
+}
+
+func (r *InMemRepo) GetByID(ctx context.Context, projectID int64, publicID string) (*ApiKey, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	k := r.find(projectID, live(publicID))
+	if k == nil {
+		return nil, ErrApiKeyNotFound
+	}
+	return liveApiKey(k), nil
+}
+
+func byExternalID(externalID string) func(k *inMemApiKey) bool {
+	return func(k *inMemApiKey) bool { return k.ExternalID == externalID }
+}
+
+func (r *InMemRepo) GetByExternalID(ctx context.Context, projectID int64, externalID string) (*ApiKey, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	k := r.find(projectID, byExternalID(externalID))
+	if k == nil {
+		return nil, ErrApiKeyNotFound
+	}
+	return k.ToApiKey(), nil
+}
+
+func (r *InMemRepo) GetByName(ctx context.Context, projectID int64, name string) (*ApiKey, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	k := r.find(projectID, func(k *inMemApiKey) bool { return strings.EqualFold(k.Name, name) && k.DeletedAt == nil })
+	if k == nil {
+		return nil, ErrApiKeyNotFound
+	}
+	return liveApiKey(k), nil
+}
+
+func (r *InMemRepo) SetExternalID(ctx context.Context, projectID int64, publicID, externalID string) (*ApiKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	k := r.find(projectID, live(publicID))
+	if k == nil {
+		return nil, ErrApiKeyNotFound
+	}
+	if k.ExternalID != "" {
+		if k.ExternalID != externalID {
+			return liveApiKey(k), ErrApiKeyAlreadyManaged
+		}
+		return liveApiKey(k), nil
+	}
+	if r.find(projectID, byExternalID(externalID)) != nil {
+		return nil, ErrExternalIDTaken
+	}
+
+	k.ExternalID = externalID
+	k.UpdatedAt = postgres.NextTimestamp(k.UpdatedAt)
+	k.UpdatedBy = auth.ActorID(ctx)
+	return liveApiKey(k), nil
+}
+
+func (r *InMemRepo) ListActive(ctx context.Context, projectID int64) ([]*ApiKey, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	now := time.Now()
+	apiKeys := make([]*ApiKey, 0)
+	for _, k := range r.keys {
+		if k.projectID == projectID && k.Active && k.Expiration.After(now) && k.DeletedAt == nil {
+			apiKeys = append(apiKeys, liveApiKey(k))
+		}
+	}
+	return apiKeys, nil
+}
+
+func (r *InMemRepo) GetByKey(ctx context.Context, key string) (*ApiKey, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, k := range r.keys {
+		if k.key == key && k.DeletedAt == nil {
+			return liveApiKey(k), nil
+		}
+	}
+	return nil, ErrApiKeyNotFound
+}
+
+func (r *InMemRepo) Update(ctx context.Context, input *UpdateApiKeyInput) (*UpdateApiKeyResult, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.find(input.ProjectID, func(k *inMemApiKey) bool {
+		return strings.EqualFold(k.Name, input.Name) && k.PublicID != input.PublicID
+	}) != nil {
+		return nil, ErrApiKeyAlreadyExists
+	}
+
+	k := r.find(input.ProjectID, live(input.PublicID))
+	if k == nil {
+		return nil, ErrApiKeyNotFound
+	}
+	if input.ExpectedUpdatedAt != nil && !k.UpdatedAt.Equal(*input.ExpectedUpdatedAt) {
+		return nil, ErrApiKeyVersionConflict
+	}
+
+	k.Name = input.Name
+	k.Expiration = input.Expiration
+	k.UpdatedAt = postgres.NextTimestamp(k.UpdatedAt)
+	k.UpdatedBy = auth.ActorID(ctx)
+
+	return &UpdateApiKeyResult{
+		ID:         k.ID,
+		PublicID:   k.PublicID,
+		Name:       k.Name,
+		Expiration: k.Expiration,
+		Active:     k.Active,
+		CreatedAt:  k.CreatedAt,
+		UpdatedAt:  k.UpdatedAt,
+		CreatedBy:  k.CreatedBy,
+		UpdatedBy:  k.UpdatedBy,
+		OwnerID:    k.OwnerID,
+		ExternalID: k.ExternalID,
+	}, nil
+}
+
+func (r *InMemRepo) Delete(ctx context.Context, input *DeleteApiKeyInput) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	k := r.find(input.ProjectID, live(input.PublicID))
+	if k == nil {
+		return ErrApiKeyNotFound
+	}
+
+	now := postgres.NextTimestamp(k.UpdatedAt)
+	k.DeletedAt = &now
+	k.UpdatedAt = now
+	k.UpdatedBy = auth.ActorID(ctx)
+	return nil
+}
+
+func (r *InMemRepo) Restore(ctx context.Context, input *RestoreApiKeyInput) (*ApiKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	k := r.find(input.ProjectID, func(k *inMemApiKey) bool {
+		return k.PublicID == input.PublicID && k.DeletedAt != nil
+	})
+	if k == nil {
+		return nil, ErrApiKeyNotFound
+	}
+
+	k.DeletedAt = nil
+	k.UpdatedAt = postgres.NextTimestamp(k.UpdatedAt)
+	k.UpdatedBy = auth.ActorID(ctx)
+	return liveApiKey(k), nil
+}
+
+func (r *InMemRepo) PurgeDeleted(ctx context.Context, before time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	n := len(r.keys)
+	r.keys = slices.DeleteFunc(r.keys, func(k *inMemApiKey) bool {
+		return k.DeletedAt != nil && k.DeletedAt.Before(before)
+	})
+	return int64(n - len(r.keys)), nil
+}
+
+// Activate activates a key, setting it to expire in a year like Repo does
+func (r *InMemRepo) Activate(ctx context.Context, input *ActivateApiKeyInput) (*ActivateApiKeyResult, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	k := r.find(input.ProjectID, live(input.PublicID))
+	if k == nil {
+		return nil, ErrApiKeyNotFound
+	}
+
+	k.UpdatedAt = postgres.NextTimestamp(k.UpdatedAt)
+	k.Active = true
+	k.Expiration = input.Expiration
+	if k.Expiration.IsZero() {
+		k.Expiration = k.UpdatedAt.AddDate(1, 0, 0)
+	}
+	k.UpdatedBy = auth.ActorID(ctx)
+
+	return &ActivateApiKeyResult{
+		ID:         k.ID,
+		PublicID:   k.PublicID,
+		Name:       k.Name,
+		Expiration: k.Expiration,
+		Active:     k.Active,
+		CreatedAt:  k.CreatedAt,
+		UpdatedAt:  k.UpdatedAt,
+		CreatedBy:  k.CreatedBy,
+		UpdatedBy:  k.UpdatedBy,
+		OwnerID:    k.OwnerID,
+		ExternalID: k.ExternalID,
+	}, nil
+}
+
+// Deactivate deactivates a key, setting its expiration to the epoch like Repo
+// does
+func (r *InMemRepo) Deactivate(ctx context.Context, input *DeactivateApiKeyInput) (*DeactivateApiKeyResult, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	k := r.find(input.ProjectID, live(input.PublicID))
+	if k == nil {
+		return nil, ErrApiKeyNotFound
+	}
+
+	k.UpdatedAt = postgres.NextTimestamp(k.UpdatedAt)
+	k.Active = false
+	k.Expiration = time.Unix(0, 0).UTC()
+	k.UpdatedBy = auth.ActorID(ctx)
+
+	return &DeactivateApiKeyResult{
+		ID:         k.ID,
+		PublicID:   k.PublicID,
+		Name:       k.Name,
+		Expiration: k.Expiration,
+		Active:     k.Active,
+		CreatedAt:  k.CreatedAt,
+		UpdatedAt:  k.UpdatedAt,
+		CreatedBy:  k.CreatedBy,
+		UpdatedBy:  k.UpdatedBy,
+		OwnerID:    k.OwnerID,
+		ExternalID: k.ExternalID,
+	}, nil
+}
@@ -2,13 +2,19 @@ package api_key
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"buf.build/go/protovalidate"
 	"github.com/hrz8/altalune"
 	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
 	project_domain "github.com/hrz8/altalune/internal/domain/project"
+	user_domain "github.com/hrz8/altalune/internal/domain/user"
+	"github.com/hrz8/altalune/internal/shared/events"
 	"github.com/hrz8/altalune/internal/shared/query"
+	"github.com/hrz8/altalune/internal/shared/secretdelivery"
+	"github.com/hrz8/altalune/internal/shared/tz"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Service struct {
@@ -17,14 +23,20 @@ type Service struct {
 	log         altalune.Logger
 	projectRepo project_domain.Repositor
 	apiKeyRepo  Repositor
+	userRepo    UserRepositor
+	deliverer   *secretdelivery.Deliverer
+	events      *events.Bus
 }
 
-func NewService(v protovalidate.Validator, log altalune.Logger, projectRepo project_domain.Repositor, apiKeyRepo Repositor) *Service {
+func NewService(v protovalidate.Validator, log altalune.Logger, projectRepo project_domain.Repositor, apiKeyRepo Repositor, userRepo UserRepositor, deliverer *secretdelivery.Deliverer, eventBus *events.Bus) *Service {
 	return &Service{
 		validator:   v,
 		log:         log,
 		projectRepo: projectRepo,
 		apiKeyRepo:  apiKeyRepo,
+		userRepo:    userRepo,
+		deliverer:   deliverer,
+		events:      eventBus,
 	}
 }
 
@@ -53,6 +65,12 @@ func (s *Service) QueryApiKeys(ctx context.Context, req *altalunev1.QueryApiKeys
 
 	// Convert proto request to domain query params
 	queryParams := query.DefaultQueryParams(req.Query)
+	queryParams.Trashed = req.Trashed
+	queryParams.Location = s.projectLocation(ctx, projectID)
+
+	if err := queryParams.ApplyCursor(req.Query.GetCursor()); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
 
 	// Query API keys from repository
 	result, err := s.apiKeyRepo.Query(ctx, projectID, queryParams)
@@ -70,19 +88,24 @@ func (s *Service) QueryApiKeys(ctx context.Context, req *altalunev1.QueryApiKeys
 		return &altalunev1.QueryApiKeysResponse{
 			Data: []*altalunev1.ApiKey{},
 			Meta: &altalunev1.QueryMetaResponse{
-				RowCount:  0,
-				PageCount: 0,
-				Filters:   make(map[string]*altalunev1.FilterValues),
+				RowCount:   0,
+				PageCount:  0,
+				ExactTotal: true,
+				Filters:    make(map[string]*altalunev1.FilterValues),
 			},
 		}, nil
 	}
 
 	return &altalunev1.QueryApiKeysResponse{
-		Data: mapApiKeysToProto(result.Data),
+		Data: mapApiKeysToProto(result.Data, queryParams.Now()),
 		Meta: &altalunev1.QueryMetaResponse{
-			RowCount:  result.TotalRows,
-			PageCount: result.TotalPages,
-			Filters:   mapFiltersToProto(result.Filters),
+			RowCount:    result.TotalRows,
+			PageCount:   result.TotalPages,
+			Filters:     mapFiltersToProto(result.Filters),
+			CountMode:   query.CountModeToProto(result.Count),
+			HasNextPage: result.HasMore,
+			NextCursor:  query.NextCursor(queryParams, result),
+			ExactTotal:  result.ExactTotal(),
 		},
 	}, nil
 }
@@ -104,13 +127,35 @@ func (s *Service) CreateApiKey(ctx context.Context, req *altalunev1.CreateApiKey
 
 	// Validate expiration date
 	expiration := req.Expiration.AsTime()
-	now := time.Now()
-	if expiration.Before(now) {
-		return nil, altalune.NewInvalidPayloadError("expiration must be in the future")
+	now := s.projectNow(ctx, projectID)
+	if err := validateExpiration(expiration, now); err != nil {
+		return nil, err
 	}
-	maxExpiration := now.AddDate(2, 0, 0) // 2 years from now
-	if expiration.After(maxExpiration) {
-		return nil, altalune.NewInvalidPayloadError("expiration cannot be more than 2 years in the future")
+	policy, err := s.credentialPolicy(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if err := validatePolicyExpiration(policy, expiration, now); err != nil {
+		return nil, err
+	}
+	// Check the recipient key before the key exists, so a bad one creates nothing
+	if err := secretdelivery.CheckRecipient(policy.SecretDelivery, req.RecipientPublicKey); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	// Only service accounts own API keys, humans sign in instead
+	if req.OwnerId != "" {
+		owner, err := s.userRepo.GetByID(ctx, req.OwnerId)
+		if err != nil {
+			if err == user_domain.ErrUserNotFound {
+				return nil, altalune.NewUserNotFoundError(req.OwnerId)
+			}
+			s.log.Error("failed to get api key owner", "error", err, "owner_id", req.OwnerId)
+			return nil, altalune.NewUnexpectedError("failed to get api key owner", err)
+		}
+		if owner.Type != user_domain.UserTypeServiceAccount {
+			return nil, altalune.NewInvalidPayloadError("owner_id must be a service account")
+		}
 	}
 
 	// Prepare input for repository
@@ -118,6 +163,8 @@ func (s *Service) CreateApiKey(ctx context.Context, req *altalunev1.CreateApiKey
 		ProjectID:  projectID,
 		Name:       req.Name,
 		Expiration: expiration,
+		OwnerID:    req.OwnerId,
+		ExternalID: req.ExternalId,
 	}
 
 	// Create API key
@@ -126,6 +173,9 @@ func (s *Service) CreateApiKey(ctx context.Context, req *altalunev1.CreateApiKey
 		if err == ErrApiKeyAlreadyExists {
 			return nil, altalune.NewApiKeyAlreadyExistsError(req.Name)
 		}
+		if err == ErrExternalIDTaken {
+			return nil, altalune.NewExternalIDTakenError("API key", req.ExternalId)
+		}
 		s.log.Error("failed to create api key",
 			"error", err,
 			"project_id", projectID,
@@ -141,6 +191,13 @@ func (s *Service) CreateApiKey(ctx context.Context, req *altalunev1.CreateApiKey
 		"name", result.Name,
 		"expiration", result.Expiration,
 	)
+	s.events.Publish(ctx, ApiKeyCreated{
+		ProjectID:       projectID,
+		ProjectPublicID: req.ProjectId,
+		ApiKeyID:        result.PublicID,
+		Name:            result.Name,
+		Expiration:      result.Expiration,
+	})
 
 	// Convert to domain model (without the actual key for security)
 	apiKey := &ApiKey{
@@ -150,12 +207,28 @@ func (s *Service) CreateApiKey(ctx context.Context, req *altalunev1.CreateApiKey
 		Active:     true, // New API keys are active by default
 		CreatedAt:  result.CreatedAt,
 		UpdatedAt:  result.UpdatedAt,
+		CreatedBy:  result.CreatedBy,
+		UpdatedBy:  result.UpdatedBy,
+		OwnerID:    result.OwnerID,
+		ExternalID: result.ExternalID,
+	}
+
+	// Hand the key over as the credential policy of the project requires
+	delivery, err := s.deliverer.Deliver(ctx, policy.SecretDelivery, result.Key, req.RecipientPublicKey)
+	if err != nil {
+		s.log.Error("failed to deliver api key",
+			"error", err,
+			"project_id", projectID,
+			"api_key_id", result.PublicID,
+		)
+		return nil, altalune.NewUnexpectedError("failed to deliver api key: %w", err)
 	}
 
 	return &altalunev1.CreateApiKeyResponse{
-		ApiKey:   apiKey.ToApiKeyProto(),
-		KeyValue: result.Key, // Only returned once during creation
-		Message:  "API key created successfully",
+		ApiKey:            apiKey.ToApiKeyProto(now),
+		KeyValue:          delivery.Secret, // Only returned once during creation, when the policy allows
+		Message:           "API key created successfully",
+		DeliveredKeyValue: delivery.ToDeliveredSecretProto(),
 	}, nil
 }
 
@@ -189,7 +262,7 @@ func (s *Service) GetApiKey(ctx context.Context, req *altalunev1.GetApiKeyReques
 	}
 
 	return &altalunev1.GetApiKeyResponse{
-		ApiKey: apiKey.ToApiKeyProto(),
+		ApiKey: apiKey.ToApiKeyProto(s.projectNow(ctx, projectID)),
 	}, nil
 }
 
@@ -210,13 +283,35 @@ func (s *Service) UpdateApiKey(ctx context.Context, req *altalunev1.UpdateApiKey
 
 	// Validate expiration date
 	expiration := req.Expiration.AsTime()
-	now := time.Now()
-	if expiration.Before(now) {
-		return nil, altalune.NewInvalidPayloadError("expiration must be in the future")
+	now := s.projectNow(ctx, projectID)
+	if err := validateExpiration(expiration, now); err != nil {
+		return nil, err
 	}
-	maxExpiration := now.AddDate(2, 0, 0) // 2 years from now
-	if expiration.After(maxExpiration) {
-		return nil, altalune.NewInvalidPayloadError("expiration cannot be more than 2 years in the future")
+
+	// Keys may keep an expiration past the policy they predate, but not
+	// extend it
+	policy, err := s.credentialPolicy(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if policy.ApiKeyMaxLifetimeDays > 0 {
+		current, err := s.apiKeyRepo.GetByID(ctx, projectID, req.ApiKeyId)
+		if err != nil {
+			if err == ErrApiKeyNotFound {
+				return nil, altalune.NewApiKeyNotFoundError(req.ApiKeyId)
+			}
+			s.log.Error("failed to get api key",
+				"error", err,
+				"project_id", projectID,
+				"api_key_id", req.ApiKeyId,
+			)
+			return nil, altalune.NewUnexpectedError("failed to get api key: %w", err)
+		}
+		if expiration.After(current.Expiration) {
+			if err := validatePolicyExpiration(policy, expiration, current.CreatedAt.In(now.Location())); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	// Prepare input for repository
@@ -226,6 +321,10 @@ func (s *Service) UpdateApiKey(ctx context.Context, req *altalunev1.UpdateApiKey
 		Name:       req.Name,
 		Expiration: expiration,
 	}
+	if req.ExpectedUpdatedAt != nil {
+		expectedUpdatedAt := req.ExpectedUpdatedAt.AsTime()
+		input.ExpectedUpdatedAt = &expectedUpdatedAt
+	}
 
 	// Update API key
 	result, err := s.apiKeyRepo.Update(ctx, input)
@@ -233,6 +332,9 @@ func (s *Service) UpdateApiKey(ctx context.Context, req *altalunev1.UpdateApiKey
 		if err == ErrApiKeyNotFound {
 			return nil, altalune.NewApiKeyNotFoundError(req.ApiKeyId)
 		}
+		if err == ErrApiKeyVersionConflict {
+			return nil, altalune.NewVersionConflictError("API key", req.ApiKeyId)
+		}
 		if err == ErrApiKeyAlreadyExists {
 			return nil, altalune.NewApiKeyAlreadyExistsError(req.Name)
 		}
@@ -260,10 +362,14 @@ func (s *Service) UpdateApiKey(ctx context.Context, req *altalunev1.UpdateApiKey
 		Active:     result.Active,
 		CreatedAt:  result.CreatedAt,
 		UpdatedAt:  result.UpdatedAt,
+		CreatedBy:  result.CreatedBy,
+		UpdatedBy:  result.UpdatedBy,
+		OwnerID:    result.OwnerID,
+		ExternalID: result.ExternalID,
 	}
 
 	return &altalunev1.UpdateApiKeyResponse{
-		ApiKey:  apiKey.ToApiKeyProto(),
+		ApiKey:  apiKey.ToApiKeyProto(now),
 		Message: "API key updated successfully",
 	}, nil
 }
@@ -314,6 +420,253 @@ func (s *Service) DeleteApiKey(ctx context.Context, req *altalunev1.DeleteApiKey
 	}, nil
 }
 
+func (s *Service) RestoreApiKey(ctx context.Context, req *altalunev1.RestoreApiKeyRequest) (*altalunev1.RestoreApiKeyResponse, error) {
+	// Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	// Extract and validate project ID
+	projectID, err := s.projectRepo.GetIDByPublicID(ctx, req.ProjectId)
+	if err != nil {
+		if err == project_domain.ErrProjectNotFound {
+			return nil, altalune.NewProjectNotFound(req.ProjectId)
+		}
+		return nil, altalune.NewInvalidPayloadError("invalid project_id")
+	}
+
+	// Restore API key from the trash
+	apiKey, err := s.apiKeyRepo.Restore(ctx, &RestoreApiKeyInput{
+		ProjectID: projectID,
+		PublicID:  req.ApiKeyId,
+	})
+	if err != nil {
+		if err == ErrApiKeyNotFound {
+			return nil, altalune.NewApiKeyNotFoundError(req.ApiKeyId)
+		}
+		s.log.Error("failed to restore api key",
+			"error", err,
+			"project_id", projectID,
+			"api_key_id", req.ApiKeyId,
+		)
+		return nil, altalune.NewUnexpectedError("failed to restore api key: %w", err)
+	}
+
+	// Log successful restore for audit purposes
+	s.log.Info("api key restored",
+		"project_id", projectID,
+		"api_key_id", req.ApiKeyId,
+	)
+
+	return &altalunev1.RestoreApiKeyResponse{
+		ApiKey:  apiKey.ToApiKeyProto(s.projectNow(ctx, projectID)),
+		Message: "API key restored successfully",
+	}, nil
+}
+
+// ApplyApiKey creates the API key with the external ID, or brings the key
+// that has it to the request: restored when trashed, and updated when its name
+// or expiration differ, deactivated or not; the owner of a key cannot change.
+// Concurrent applies of a new external ID converge: the ones losing the race
+// to create the key update the key the winner created.
+func (s *Service) ApplyApiKey(ctx context.Context, req *altalunev1.ApplyApiKeyRequest) (*altalunev1.ApplyApiKeyResponse, error) {
+	// Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	// Extract and validate project ID
+	projectID, err := s.projectRepo.GetIDByPublicID(ctx, req.ProjectId)
+	if err != nil {
+		if err == project_domain.ErrProjectNotFound {
+			return nil, altalune.NewProjectNotFound(req.ProjectId)
+		}
+		return nil, altalune.NewInvalidPayloadError("invalid project_id")
+	}
+
+	// Find the key with the external ID, in the trash or not
+	existing, err := s.getByExternalID(ctx, projectID, req.ExternalId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create it when there is none, the key value being returned this once
+	if existing == nil {
+		created, err := s.CreateApiKey(ctx, &altalunev1.CreateApiKeyRequest{
+			ProjectId:          req.ProjectId,
+			Name:               req.Name,
+			Expiration:         req.Expiration,
+			OwnerId:            req.OwnerId,
+			RecipientPublicKey: req.RecipientPublicKey,
+			ExternalId:         req.ExternalId,
+		})
+		if err == nil {
+			return &altalunev1.ApplyApiKeyResponse{
+				ApiKey:            created.ApiKey,
+				Created:           true,
+				KeyValue:          created.KeyValue,
+				DeliveredKeyValue: created.DeliveredKeyValue,
+				Message:           created.Message,
+			}, nil
+		}
+		if !altalune.HasCode(err, altalune.CodeExternalIDTaken) && !altalune.HasCode(err, altalune.CodeApiKeyAlreadyExists) {
+			return nil, err
+		}
+
+		// A concurrent apply of the external ID created the key first: bring
+		// it to the declared state like any existing key. A name taken by
+		// another key stays an error.
+		existing, lookupErr := s.getByExternalID(ctx, projectID, req.ExternalId)
+		if lookupErr != nil {
+			return nil, lookupErr
+		}
+		if existing == nil {
+			return nil, err
+		}
+		return s.applyExisting(ctx, projectID, req, existing)
+	}
+
+	return s.applyExisting(ctx, projectID, req, existing)
+}
+
+// applyExisting brings existing, the key with the external ID of req, to the
+// state req declares
+func (s *Service) applyExisting(ctx context.Context, projectID int64, req *altalunev1.ApplyApiKeyRequest, existing *ApiKey) (*altalunev1.ApplyApiKeyResponse, error) {
+	if existing.OwnerID != req.OwnerId {
+		return nil, altalune.NewImmutableFieldChangedError("API key", "owner")
+	}
+
+	// Bring a trashed key back, within the limits of the billing plan
+	if existing.DeletedAt != nil {
+		if _, err := s.RestoreApiKey(ctx, &altalunev1.RestoreApiKeyRequest{
+			ProjectId: req.ProjectId,
+			ApiKeyId:  existing.ID,
+		}); err != nil {
+			return nil, err
+		}
+		apiKeyID := existing.ID
+		restored, err := s.apiKeyRepo.GetByID(ctx, projectID, apiKeyID)
+		if err != nil {
+			if err == ErrApiKeyNotFound {
+				return nil, altalune.NewApiKeyNotFoundError(apiKeyID)
+			}
+			s.log.Error("failed to get api key",
+				"error", err,
+				"project_id", projectID,
+				"api_key_id", apiKeyID,
+			)
+			return nil, altalune.NewUnexpectedError("failed to get api key: %w", err)
+		}
+		existing = restored
+	}
+
+	// Update the key only when it differs, whether it is active or not
+	expiration := req.Expiration.AsTime()
+	if existing.Name == req.Name && existing.Expiration.Equal(expiration) {
+		return &altalunev1.ApplyApiKeyResponse{
+			ApiKey:  existing.ToApiKeyProto(s.projectNow(ctx, projectID)),
+			Message: "API key is up to date",
+		}, nil
+	}
+
+	updated, err := s.UpdateApiKey(ctx, &altalunev1.UpdateApiKeyRequest{
+		ProjectId:         req.ProjectId,
+		ApiKeyId:          existing.ID,
+		Name:              req.Name,
+		Expiration:        req.Expiration,
+		ExpectedUpdatedAt: timestamppb.New(existing.UpdatedAt),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &altalunev1.ApplyApiKeyResponse{
+		ApiKey:  updated.ApiKey,
+		Message: updated.Message,
+	}, nil
+}
+
+// getByExternalID returns the key with the external ID, in the trash or not,
+// or nil when there is none
+func (s *Service) getByExternalID(ctx context.Context, projectID int64, externalID string) (*ApiKey, error) {
+	apiKey, err := s.apiKeyRepo.GetByExternalID(ctx, projectID, externalID)
+	if err == ErrApiKeyNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		s.log.Error("failed to get api key by external id",
+			"error", err,
+			"project_id", projectID,
+			"external_id", externalID,
+		)
+		return nil, altalune.NewUnexpectedError("failed to get api key by external id: %w", err)
+	}
+	return apiKey, nil
+}
+
+// ImportApiKey sets the external ID of the live API key with the name, so
+// ApplyApiKey manages it from then on
+func (s *Service) ImportApiKey(ctx context.Context, req *altalunev1.ImportApiKeyRequest) (*altalunev1.ImportApiKeyResponse, error) {
+	// Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	// Extract and validate project ID
+	projectID, err := s.projectRepo.GetIDByPublicID(ctx, req.ProjectId)
+	if err != nil {
+		if err == project_domain.ErrProjectNotFound {
+			return nil, altalune.NewProjectNotFound(req.ProjectId)
+		}
+		return nil, altalune.NewInvalidPayloadError("invalid project_id")
+	}
+
+	// Names are unique within a project
+	apiKey, err := s.apiKeyRepo.GetByName(ctx, projectID, req.Name)
+	if err != nil {
+		if err == ErrApiKeyNotFound {
+			return nil, altalune.NewImportTargetNotFoundError("API key", req.Name)
+		}
+		s.log.Error("failed to get api key by name",
+			"error", err,
+			"project_id", projectID,
+			"name", req.Name,
+		)
+		return nil, altalune.NewUnexpectedError("failed to get api key by name: %w", err)
+	}
+
+	// Set the external ID, unless the key has another one
+	imported, err := s.apiKeyRepo.SetExternalID(ctx, projectID, apiKey.ID, req.ExternalId)
+	if err != nil {
+		if err == ErrApiKeyNotFound {
+			return nil, altalune.NewImportTargetNotFoundError("API key", req.Name)
+		}
+		if err == ErrExternalIDTaken {
+			return nil, altalune.NewExternalIDTakenError("API key", req.ExternalId)
+		}
+		if err == ErrApiKeyAlreadyManaged {
+			return nil, altalune.NewResourceAlreadyManagedError("API key", imported.Name, imported.ExternalID)
+		}
+		s.log.Error("failed to set api key external id",
+			"error", err,
+			"project_id", projectID,
+			"api_key_id", apiKey.ID,
+		)
+		return nil, altalune.NewUnexpectedError("failed to set api key external id: %w", err)
+	}
+
+	// Log successful import for audit purposes
+	s.log.Info("api key imported",
+		"project_id", projectID,
+		"api_key_id", imported.ID,
+		"external_id", imported.ExternalID,
+	)
+
+	return &altalunev1.ImportApiKeyResponse{
+		ApiKey:  imported.ToApiKeyProto(s.projectNow(ctx, projectID)),
+		Message: "API key imported successfully",
+	}, nil
+}
+
 func (s *Service) ActivateApiKey(ctx context.Context, req *altalunev1.ActivateApiKeyRequest) (*altalunev1.ActivateApiKeyResponse, error) {
 	// Validate request
 	if err := s.validator.Validate(req); err != nil {
@@ -335,6 +688,38 @@ func (s *Service) ActivateApiKey(ctx context.Context, req *altalunev1.ActivateAp
 		PublicID:  req.ApiKeyId,
 	}
 
+	// Reactivated keys expire a year from now, or earlier when the policy of
+	// the project caps their lifetime
+	policy, err := s.credentialPolicy(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	now := s.projectNow(ctx, projectID)
+	if policy.ApiKeyMaxLifetimeDays > 0 {
+		current, err := s.apiKeyRepo.GetByID(ctx, projectID, req.ApiKeyId)
+		if err != nil {
+			if err == ErrApiKeyNotFound {
+				return nil, altalune.NewApiKeyNotFoundError(req.ApiKeyId)
+			}
+			s.log.Error("failed to get api key",
+				"error", err,
+				"project_id", projectID,
+				"api_key_id", req.ApiKeyId,
+			)
+			return nil, altalune.NewUnexpectedError("failed to get api key: %w", err)
+		}
+		limit, _ := policy.ApiKeyExpirationLimit(current.CreatedAt, now.Location())
+		if !limit.After(now) {
+			return nil, altalune.NewInvalidPayloadError(fmt.Sprintf(
+				"API key is older than the project's maximum API key lifetime of %d days, create a new key instead",
+				policy.ApiKeyMaxLifetimeDays,
+			))
+		}
+		if limit.Before(now.AddDate(1, 0, 0)) {
+			input.Expiration = limit
+		}
+	}
+
 	// Activate API key
 	result, err := s.apiKeyRepo.Activate(ctx, input)
 	if err != nil {
@@ -364,10 +749,14 @@ func (s *Service) ActivateApiKey(ctx context.Context, req *altalunev1.ActivateAp
 		Active:     result.Active,
 		CreatedAt:  result.CreatedAt,
 		UpdatedAt:  result.UpdatedAt,
+		CreatedBy:  result.CreatedBy,
+		UpdatedBy:  result.UpdatedBy,
+		OwnerID:    result.OwnerID,
+		ExternalID: result.ExternalID,
 	}
 
 	return &altalunev1.ActivateApiKeyResponse{
-		ApiKey:  apiKey.ToApiKeyProto(),
+		ApiKey:  apiKey.ToApiKeyProto(now),
 		Message: "API key activated successfully",
 	}, nil
 }
@@ -422,10 +811,67 @@ func (s *Service) DeactivateApiKey(ctx context.Context, req *altalunev1.Deactiva
 		Active:     result.Active,
 		CreatedAt:  result.CreatedAt,
 		UpdatedAt:  result.UpdatedAt,
+		CreatedBy:  result.CreatedBy,
+		UpdatedBy:  result.UpdatedBy,
+		OwnerID:    result.OwnerID,
+		ExternalID: result.ExternalID,
 	}
 
 	return &altalunev1.DeactivateApiKeyResponse{
-		ApiKey:  apiKey.ToApiKeyProto(),
+		ApiKey:  apiKey.ToApiKeyProto(s.projectNow(ctx, projectID)),
 		Message: "API key deactivated successfully",
 	}, nil
 }
+
+// credentialPolicy returns the credential policy of the project
+func (s *Service) credentialPolicy(ctx context.Context, projectID int64) (*project_domain.CredentialPolicy, error) {
+	policy, err := s.projectRepo.GetCredentialPolicy(ctx, projectID)
+	if err != nil {
+		s.log.Error("failed to get project credential policy", "error", err, "project_id", projectID)
+		return nil, altalune.NewUnexpectedError("failed to get project credential policy: %w", err)
+	}
+	return policy, nil
+}
+
+// projectLocation returns the time zone of the project, UTC when it cannot be
+// read so that a failed lookup does not fail the request
+func (s *Service) projectLocation(ctx context.Context, projectID int64) *time.Location {
+	timezone, err := s.projectRepo.GetTimezone(ctx, projectID)
+	if err != nil {
+		s.log.Warn("failed to get project timezone", "error", err, "project_id", projectID)
+		return time.UTC
+	}
+	return tz.Load(timezone)
+}
+
+// projectNow returns the current time in the time zone of the project
+func (s *Service) projectNow(ctx context.Context, projectID int64) time.Time {
+	return time.Now().In(s.projectLocation(ctx, projectID))
+}
+
+// validateExpiration checks that expiration is after now and no later than the
+// end of the day two years from now, in the location of now
+func validateExpiration(expiration, now time.Time) error {
+	if expiration.Before(now) {
+		return altalune.NewInvalidPayloadError("expiration must be in the future")
+	}
+	maxExpiration := tz.EndOfDay(now.AddDate(2, 0, 0), now.Location())
+	if expiration.After(maxExpiration) {
+		return altalune.NewInvalidPayloadError("expiration cannot be more than 2 years in the future")
+	}
+	return nil
+}
+
+// validatePolicyExpiration checks that expiration is within the maximum API key
+// lifetime of policy for a key created at createdAt, in the location of
+// createdAt
+func validatePolicyExpiration(policy *project_domain.CredentialPolicy, expiration, createdAt time.Time) error {
+	limit, ok := policy.ApiKeyExpirationLimit(createdAt, createdAt.Location())
+	if ok && expiration.After(limit) {
+		return altalune.NewInvalidPayloadError(fmt.Sprintf(
+			"expiration exceeds the project's maximum API key lifetime of %d days",
+			policy.ApiKeyMaxLifetimeDays,
+		))
+	}
+	return nil
+}
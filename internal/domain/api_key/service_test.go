@@ -0,0 +1,165 @@
+package api_key_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"buf.build/go/protovalidate"
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	"github.com/hrz8/altalune/internal/domain/api_key"
+	"github.com/hrz8/altalune/internal/domain/project"
+	"github.com/hrz8/altalune/internal/domain/user"
+	"github.com/hrz8/altalune/internal/redis"
+	"github.com/hrz8/altalune/internal/shared/secretdelivery"
+	"github.com/hrz8/altalune/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// racingRepo misses the key with the external ID on its first lookup, having
+// another apply create it right after, as when two applies of a new external
+// ID run at once
+type racingRepo struct {
+	*api_key.InMemRepo
+	concurrent *api_key.CreateApiKeyInput
+}
+
+func (r *racingRepo) GetByExternalID(ctx context.Context, projectID int64, externalID string) (*api_key.ApiKey, error) {
+	if r.concurrent != nil {
+		input := r.concurrent
+		r.concurrent = nil
+		if _, err := r.InMemRepo.Create(ctx, input); err != nil {
+			return nil, err
+		}
+		return nil, api_key.ErrApiKeyNotFound
+	}
+	return r.InMemRepo.GetByExternalID(ctx, projectID, externalID)
+}
+
+func newApplyService(t *testing.T, repo api_key.Repositor) (*api_key.Service, string, int64) {
+	t.Helper()
+
+	v, err := protovalidate.New()
+	require.NoError(t, err)
+	projects := project.NewInMemRepo()
+	created, err := projects.Create(context.Background(), &project.CreateProjectInput{Name: "Apply", Timezone: "UTC"})
+	require.NoError(t, err)
+
+	svc := api_key.NewService(v, logger.New("error"), projects, repo, user.NewInMemRepo(),
+		secretdelivery.NewDeliverer(redis.NewMemoryStore()), nil)
+	return svc, created.PublicID, created.ID
+}
+
+func TestApplyApiKey(t *testing.T) {
+	ctx := context.Background()
+	repo := api_key.NewInMemRepo()
+	svc, projectID, internalProjectID := newApplyService(t, repo)
+	nextMonth := time.Now().Add(30 * 24 * time.Hour).Truncate(time.Second)
+	apply := func(name string, expiration time.Time) (*altalunev1.ApplyApiKeyResponse, error) {
+		return svc.ApplyApiKey(ctx, &altalunev1.ApplyApiKeyRequest{
+			ProjectId:  projectID,
+			ExternalId: "ci-deployer",
+			Name:       name,
+			Expiration: timestamppb.New(expiration),
+		})
+	}
+
+	created, err := apply("CI deployer", nextMonth)
+	require.NoError(t, err)
+	assert.True(t, created.Created)
+	assert.NotEmpty(t, created.KeyValue, "the key value is returned on creation")
+	assert.Equal(t, "CI deployer", created.ApiKey.Name)
+
+	unchanged, err := apply("CI deployer", nextMonth)
+	require.NoError(t, err)
+	assert.False(t, unchanged.Created)
+	assert.Empty(t, unchanged.KeyValue)
+	assert.Equal(t, created.ApiKey.Id, unchanged.ApiKey.Id)
+	assert.Equal(t, created.ApiKey.UpdatedAt.AsTime(), unchanged.ApiKey.UpdatedAt.AsTime(), "an unchanged key is not written")
+
+	renamed, err := apply("CI deployer prod", nextMonth.Add(24*time.Hour))
+	require.NoError(t, err)
+	assert.False(t, renamed.Created)
+	assert.Equal(t, created.ApiKey.Id, renamed.ApiKey.Id)
+	assert.Equal(t, "CI deployer prod", renamed.ApiKey.Name)
+	assert.True(t, nextMonth.Add(24*time.Hour).Equal(renamed.ApiKey.Expiration.AsTime()))
+
+	// A deactivated key still converges on the declared expiration
+	_, err = repo.Deactivate(ctx, &api_key.DeactivateApiKeyInput{ProjectID: internalProjectID, PublicID: created.ApiKey.Id})
+	require.NoError(t, err)
+	extended, err := apply("CI deployer prod", nextMonth.Add(48*time.Hour))
+	require.NoError(t, err)
+	assert.True(t, nextMonth.Add(48*time.Hour).Equal(extended.ApiKey.Expiration.AsTime()))
+	assert.False(t, extended.ApiKey.Active)
+
+	_, err = svc.ApplyApiKey(ctx, &altalunev1.ApplyApiKeyRequest{
+		ProjectId:  projectID,
+		ExternalId: "ci-deployer",
+		Name:       "CI deployer prod",
+		Expiration: timestamppb.New(nextMonth),
+		OwnerId:    "usr_1234567890ab",
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "the owner of a key cannot change")
+}
+
+func TestApplyApiKeyConcurrentCreate(t *testing.T) {
+	ctx := context.Background()
+	nextMonth := time.Now().Add(30 * 24 * time.Hour).Truncate(time.Second)
+
+	tests := []struct {
+		name       string
+		concurrent func(projectID int64) *api_key.CreateApiKeyInput
+		code       codes.Code
+	}{
+		{
+			name: "same name",
+			concurrent: func(projectID int64) *api_key.CreateApiKeyInput {
+				return &api_key.CreateApiKeyInput{ProjectID: projectID, Name: "CI deployer", Expiration: nextMonth, ExternalID: "ci-deployer"}
+			},
+		},
+		{
+			name: "other name",
+			concurrent: func(projectID int64) *api_key.CreateApiKeyInput {
+				return &api_key.CreateApiKeyInput{ProjectID: projectID, Name: "Deployer", Expiration: nextMonth, ExternalID: "ci-deployer"}
+			},
+		},
+		{
+			name: "name taken by an unmanaged key",
+			concurrent: func(projectID int64) *api_key.CreateApiKeyInput {
+				return &api_key.CreateApiKeyInput{ProjectID: projectID, Name: "CI deployer", Expiration: nextMonth}
+			},
+			code: codes.AlreadyExists,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &racingRepo{InMemRepo: api_key.NewInMemRepo()}
+			svc, projectID, internalProjectID := newApplyService(t, repo)
+			repo.concurrent = tt.concurrent(internalProjectID)
+
+			resp, err := svc.ApplyApiKey(ctx, &altalunev1.ApplyApiKeyRequest{
+				ProjectId:  projectID,
+				ExternalId: "ci-deployer",
+				Name:       "CI deployer",
+				Expiration: timestamppb.New(nextMonth.Add(24 * time.Hour)),
+			})
+			if tt.code != codes.OK {
+				assert.Equal(t, tt.code, status.Code(err))
+				return
+			}
+			require.NoError(t, err, "the apply losing the race updates the key the other one created")
+			assert.False(t, resp.Created)
+			assert.Empty(t, resp.KeyValue)
+			assert.Equal(t, "CI deployer", resp.ApiKey.Name)
+			assert.True(t, nextMonth.Add(24*time.Hour).Equal(resp.ApiKey.Expiration.AsTime()))
+
+			stored, err := repo.GetByExternalID(ctx, internalProjectID, "ci-deployer")
+			require.NoError(t, err)
+			assert.Equal(t, resp.ApiKey.Id, stored.ID, "a single key has the external ID")
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package api_key
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	"github.com/hrz8/altalune/internal/shared/tz"
+)
+
+// Status is the combined status of an API key, derived from whether it is
+// active and how close its expiration is. Its values are the ones of the
+// "statuses" filter.
+type Status string
+
+const (
+	StatusActive       Status = "active"
+	StatusInactive     Status = "inactive"
+	StatusExpired      Status = "expired"
+	StatusExpiringSoon Status = "expiring_soon"
+)
+
+// expiringSoonDays is how many calendar days ahead of today an active key
+// expiring is expiring soon
+const expiringSoonDays = 10
+
+// Statuses lists every status, in the order filters offer them
+var Statuses = []Status{StatusActive, StatusInactive, StatusExpired, StatusExpiringSoon}
+
+// StatusValues returns Statuses as filter values
+func StatusValues() []string {
+	values := make([]string, len(Statuses))
+	for i, status := range Statuses {
+		values[i] = string(status)
+	}
+	return values
+}
+
+// ComputeStatus returns the status at now of a key with the given active flag
+// and expiration. A key past its expiration is expired whether it is active
+// or not. The expiring soon window counts calendar days in the location of
+// now, which should be the project's time zone.
+func ComputeStatus(active bool, expiration, now time.Time) Status {
+	switch {
+	case !expiration.After(now):
+		return StatusExpired
+	case !active:
+		return StatusInactive
+	case expiration.Before(ExpiringSoonThreshold(now)):
+		return StatusExpiringSoon
+	default:
+		return StatusActive
+	}
+}
+
+// ExpiringSoonThreshold returns the start of the first day after the expiring
+// soon window of now
+func ExpiringSoonThreshold(now time.Time) time.Time {
+	return tz.AddDays(now, expiringSoonDays+1, now.Location())
+}
+
+// statusSQL returns the CASE expression computing ComputeStatus in SQL from
+// the active and expiration columns, with now and the expiring soon threshold
+// bound to the given placeholders.
+func statusSQL(nowPlaceholder, soonPlaceholder string) string {
+	return fmt.Sprintf(`(CASE
+		WHEN expiration <= %[1]s THEN '%[3]s'
+		WHEN NOT active THEN '%[4]s'
+		WHEN expiration < %[2]s THEN '%[5]s'
+		ELSE '%[6]s'
+	END)`, nowPlaceholder, soonPlaceholder, StatusExpired, StatusInactive, StatusExpiringSoon, StatusActive)
+}
+
+// statusSQLArgs returns the values statusSQL binds at now
+func statusSQLArgs(now time.Time) []any {
+	return []any{now, ExpiringSoonThreshold(now)}
+}
+
+// ParseStatus parses a filter value, ignoring case
+func ParseStatus(value string) (Status, bool) {
+	status := Status(strings.ToLower(value))
+	for _, s := range Statuses {
+		if s == status {
+			return status, true
+		}
+	}
+	return "", false
+}
+
+func (s Status) ToProto() altalunev1.ApiKeyStatus {
+	switch s {
+	case StatusActive:
+		return altalunev1.ApiKeyStatus_API_KEY_STATUS_ACTIVE
+	case StatusInactive:
+		return altalunev1.ApiKeyStatus_API_KEY_STATUS_INACTIVE
+	case StatusExpired:
+		return altalunev1.ApiKeyStatus_API_KEY_STATUS_EXPIRED
+	case StatusExpiringSoon:
+		return altalunev1.ApiKeyStatus_API_KEY_STATUS_EXPIRING_SOON
+	default:
+		return altalunev1.ApiKeyStatus_API_KEY_STATUS_UNSPECIFIED
+	}
+}
@@ -0,0 +1,48 @@
+package api_key
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hrz8/altalune/internal/shared/tz"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComputeStatus(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, StatusActive, ComputeStatus(true, now.AddDate(0, 1, 0), now))
+	assert.Equal(t, StatusExpiringSoon, ComputeStatus(true, now.AddDate(0, 0, 10), now))
+	assert.Equal(t, StatusInactive, ComputeStatus(false, now.AddDate(0, 1, 0), now))
+	assert.Equal(t, StatusExpired, ComputeStatus(false, now, now))
+	assert.Equal(t, StatusExpired, ComputeStatus(true, now.Add(-time.Second), now), "expired keys are expired even when active")
+}
+
+func TestComputeStatusTimezone(t *testing.T) {
+	// 20:00 UTC on 1 March is already 2 March in Jakarta, so its window of 10
+	// days runs until the end of 12 March there, 17:00 UTC
+	now := time.Date(2026, 3, 1, 20, 0, 0, 0, time.UTC)
+	expiration := time.Date(2026, 3, 12, 16, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, StatusActive, ComputeStatus(true, expiration, now))
+	assert.Equal(t, StatusExpiringSoon, ComputeStatus(true, expiration, now.In(tz.Load("Asia/Jakarta"))))
+}
+
+func TestValidateExpiration(t *testing.T) {
+	now := time.Date(2026, 3, 1, 20, 0, 0, 0, time.UTC).In(tz.Load("Asia/Jakarta"))
+
+	assert.Error(t, validateExpiration(now.Add(-time.Minute), now))
+	assert.NoError(t, validateExpiration(now.AddDate(1, 0, 0), now))
+	// The last day allowed ends two years from today in the project's time zone
+	assert.NoError(t, validateExpiration(time.Date(2028, 3, 2, 23, 0, 0, 0, now.Location()), now))
+	assert.Error(t, validateExpiration(time.Date(2028, 3, 3, 0, 0, 0, 0, now.Location()), now))
+}
+
+func TestParseStatus(t *testing.T) {
+	status, ok := ParseStatus("Expiring_Soon")
+	assert.True(t, ok)
+	assert.Equal(t, StatusExpiringSoon, status)
+
+	_, ok = ParseStatus("revoked")
+	assert.False(t, ok)
+}
@@ -0,0 +1,9 @@
+package billing
+
+import "errors"
+
+var (
+	ErrSubscriptionNotFound = errors.New("subscription not found")
+	ErrCustomerNotFound     = errors.New("no organization has this billing customer")
+	ErrOrganizationNotFound = errors.New("organization not found")
+)
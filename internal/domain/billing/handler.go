@@ -0,0 +1,67 @@
+package billing
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/hrz8/altalune"
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	"github.com/hrz8/altalune/internal/auth"
+)
+
+type Handler struct {
+	svc  altalunev1.BillingServiceServer
+	auth *auth.Authorizer
+}
+
+func NewHandler(svc altalunev1.BillingServiceServer, authorizer *auth.Authorizer) *Handler {
+	return &Handler{svc: svc, auth: authorizer}
+}
+
+func (h *Handler) ListPlans(
+	ctx context.Context,
+	req *connect.Request[altalunev1.ListPlansRequest],
+) (*connect.Response[altalunev1.ListPlansResponse], error) {
+	// Authorization: requires billing:read permission (global)
+	if err := h.auth.CheckPermission(ctx, "billing:read"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.ListPlans(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (h *Handler) GetOrganizationBilling(
+	ctx context.Context,
+	req *connect.Request[altalunev1.GetOrganizationBillingRequest],
+) (*connect.Response[altalunev1.GetOrganizationBillingResponse], error) {
+	// Authorization: requires billing:read permission (global)
+	if err := h.auth.CheckPermission(ctx, "billing:read"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.GetOrganizationBilling(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (h *Handler) CreatePortalSession(
+	ctx context.Context,
+	req *connect.Request[altalunev1.CreatePortalSessionRequest],
+) (*connect.Response[altalunev1.CreatePortalSessionResponse], error) {
+	// Authorization: requires billing:write permission (global)
+	if err := h.auth.CheckPermission(ctx, "billing:write"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.CreatePortalSession(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
@@ -0,0 +1,38 @@
+package billing
+
+import (
+	"context"
+
+	"github.com/hrz8/altalune/internal/domain/organization"
+)
+
+type Repositor interface {
+	GetSubscription(ctx context.Context, organizationID int64) (*Subscription, error)
+	// GetProjectSubscription returns the subscription of the organization of a
+	// project, ErrSubscriptionNotFound when it has none or the project is in
+	// no organization
+	GetProjectSubscription(ctx context.Context, projectID int64) (*Subscription, error)
+	// GetDefaultProjectSubscription returns the subscription of the
+	// organization of the default project, ErrSubscriptionNotFound when it
+	// has none or the project is in no organization
+	GetDefaultProjectSubscription(ctx context.Context) (*Subscription, error)
+	// GetOrganizationIDByCustomer fails with ErrCustomerNotFound when no
+	// organization has the customer
+	GetOrganizationIDByCustomer(ctx context.Context, customerID string) (int64, error)
+	// SetCustomer links an organization to its Stripe customer
+	SetCustomer(ctx context.Context, organizationPublicID string, customerID string) error
+	// ApplySubscription stores a subscription unless an event created later was
+	// applied already, reporting whether it was stored
+	ApplySubscription(ctx context.Context, sub *Subscription) (bool, error)
+
+	// CountOrganizationProjects counts the projects of an organization other
+	// than exceptProjectID
+	CountOrganizationProjects(ctx context.Context, organizationID int64, exceptProjectID int64) (int, error)
+	// CountOAuthClients counts the live OAuth clients but the dashboard one
+	CountOAuthClients(ctx context.Context) (int, error)
+}
+
+// OrganizationAuthorizer checks the role of the caller in an organization
+type OrganizationAuthorizer interface {
+	Authorize(ctx context.Context, publicID string, roles ...string) (*organization.Organization, error)
+}
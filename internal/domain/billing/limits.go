@@ -0,0 +1,97 @@
+package billing
+
+import (
+	"context"
+
+	"github.com/hrz8/altalune"
+)
+
+// Limiter enforces the limits of the billing plans when resources are
+// created. Its checks pass when billing is disabled.
+type Limiter struct {
+	enabled bool
+	repo    Repositor
+	catalog *Catalog
+	log     altalune.Logger
+}
+
+func NewLimiter(enabled bool, repo Repositor, catalog *Catalog, log altalune.Logger) *Limiter {
+	return &Limiter{
+		enabled: enabled,
+		repo:    repo,
+		catalog: catalog,
+		log:     log,
+	}
+}
+
+// CheckProjectAdd fails when adding projectID would take the organization
+// over the projects its plan allows
+func (l *Limiter) CheckProjectAdd(ctx context.Context, organizationID int64, projectID int64) error {
+	if !l.enabled {
+		return nil
+	}
+	sub, err := l.repo.GetSubscription(ctx, organizationID)
+	if err != nil && err != ErrSubscriptionNotFound {
+		return l.unexpected(err, "organization_id", organizationID)
+	}
+	plan := l.catalog.PlanOf(sub)
+	if plan.MaxProjects == 0 {
+		return nil
+	}
+
+	n, err := l.repo.CountOrganizationProjects(ctx, organizationID, projectID)
+	if err != nil {
+		return l.unexpected(err, "organization_id", organizationID)
+	}
+	if n >= plan.MaxProjects {
+		return altalune.NewPlanLimitReachedError("projects", plan.MaxProjects, plan.Name)
+	}
+	return nil
+}
+
+// CheckOAuthClientAdd fails when there are as many OAuth clients as the plan
+// allows. Clients are global and, like their secret policy, belong to the
+// default project: the plan of its organization limits them. The dashboard
+// client does not count.
+func (l *Limiter) CheckOAuthClientAdd(ctx context.Context) error {
+	if !l.enabled {
+		return nil
+	}
+	sub, err := l.repo.GetDefaultProjectSubscription(ctx)
+	if err != nil && err != ErrSubscriptionNotFound {
+		return l.unexpected(err)
+	}
+	plan := l.catalog.PlanOf(sub)
+	if plan.MaxClients == 0 {
+		return nil
+	}
+
+	n, err := l.repo.CountOAuthClients(ctx)
+	if err != nil {
+		return l.unexpected(err)
+	}
+	if n >= plan.MaxClients {
+		return altalune.NewPlanLimitReachedError("clients", plan.MaxClients, plan.Name)
+	}
+	return nil
+}
+
+// ProjectPlan returns the plan of the organization of a project, the default
+// plan for projects outside organizations, and an unlimited one when billing
+// is disabled. The repositories enforce its member limit in the statements
+// inserting memberships, so every way into a project counts against it.
+func (l *Limiter) ProjectPlan(ctx context.Context, projectID int64) (altalune.BillingPlan, error) {
+	if !l.enabled {
+		return altalune.BillingPlan{}, nil
+	}
+	sub, err := l.repo.GetProjectSubscription(ctx, projectID)
+	if err != nil && err != ErrSubscriptionNotFound {
+		return altalune.BillingPlan{}, l.unexpected(err, "project_id", projectID)
+	}
+	return l.catalog.PlanOf(sub), nil
+}
+
+func (l *Limiter) unexpected(err error, args ...any) error {
+	l.log.Error("failed to check plan limits", append([]any{"error", err}, args...)...)
+	return altalune.NewUnexpectedError("failed to check plan limits: %w", err)
+}
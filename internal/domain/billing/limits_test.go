@@ -0,0 +1,43 @@
+package billing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hrz8/altalune"
+	"github.com/hrz8/altalune/logger"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestLimiter(t *testing.T) {
+	ctx := context.Background()
+	catalog := NewCatalog([]altalune.BillingPlan{
+		{ID: "free", Name: "Free", MaxProjects: 1, MaxClients: 2, MaxMembers: 5},
+		{ID: "pro", Name: "Pro", StripePriceID: "price_pro", MaxProjects: 10},
+	}, "free")
+	repo := &fakeRepo{projects: 1, clients: 2}
+	limiter := NewLimiter(true, repo, catalog, logger.New("error"))
+
+	err := limiter.CheckProjectAdd(ctx, 1, 2)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, err.Error(), "Free plan allows 1 projects")
+	assert.Error(t, limiter.CheckOAuthClientAdd(ctx))
+	plan, err := limiter.ProjectPlan(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, plan.MaxMembers)
+
+	repo.sub = &Subscription{StripePriceID: "price_pro", Status: "active"}
+	assert.NoError(t, limiter.CheckProjectAdd(ctx, 1, 2))
+	assert.NoError(t, limiter.CheckOAuthClientAdd(ctx), "the pro plan allows any number of clients")
+
+	repo.sub.Status = "canceled"
+	assert.Error(t, limiter.CheckProjectAdd(ctx, 1, 2), "canceled subscriptions fall back to the default plan")
+
+	disabled := NewLimiter(false, repo, catalog, logger.New("error"))
+	assert.NoError(t, disabled.CheckProjectAdd(ctx, 1, 2))
+	plan, err = disabled.ProjectPlan(ctx, 1)
+	assert.NoError(t, err)
+	assert.Zero(t, plan.MaxMembers, "members are unlimited without billing")
+}
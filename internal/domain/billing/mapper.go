@@ -0,0 +1,17 @@
+package billing
+
+import (
+	"github.com/hrz8/altalune"
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+)
+
+// toBillingPlanProto converts a plan to its protobuf message
+func toBillingPlanProto(p altalune.BillingPlan) *altalunev1.BillingPlan {
+	return &altalunev1.BillingPlan{
+		Id:          p.ID,
+		Name:        p.Name,
+		MaxProjects: int32(p.MaxProjects),
+		MaxClients:  int32(p.MaxClients),
+		MaxMembers:  int32(p.MaxMembers),
+	}
+}
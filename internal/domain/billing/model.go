@@ -0,0 +1,26 @@
+package billing
+
+import (
+	"slices"
+	"time"
+)
+
+// Subscription statuses the plan of a subscription is in effect with. A past
+// due subscription keeps its plan while Stripe retries the payment.
+var activeStatuses = []string{"active", "trialing", "past_due"}
+
+// Subscription is the Stripe subscription of an organization
+type Subscription struct {
+	OrganizationID       int64
+	StripeSubscriptionID string
+	StripePriceID        string
+	Status               string // Stripe status, e.g. active, past_due, canceled
+	CurrentPeriodEnd     *time.Time
+	CancelAtPeriodEnd    bool
+	EventCreatedAt       time.Time // Creation time of the Stripe event last applied
+}
+
+// Active reports whether the plan of the subscription is in effect
+func (s *Subscription) Active() bool {
+	return s != nil && slices.Contains(activeStatuses, s.Status)
+}
@@ -0,0 +1,44 @@
+package billing
+
+import "github.com/hrz8/altalune"
+
+// Catalog holds the configured plans
+type Catalog struct {
+	plans       []altalune.BillingPlan
+	defaultPlan string
+}
+
+// NewCatalog creates a catalog of plans, defaultPlan being the ID of the plan
+// without an active subscription
+func NewCatalog(plans []altalune.BillingPlan, defaultPlan string) *Catalog {
+	return &Catalog{plans: plans, defaultPlan: defaultPlan}
+}
+
+// Plans returns the plans in configuration order
+func (c *Catalog) Plans() []altalune.BillingPlan {
+	return c.plans
+}
+
+// Default returns the plan without an active subscription. A default plan
+// missing from the configuration is unlimited.
+func (c *Catalog) Default() altalune.BillingPlan {
+	for _, p := range c.plans {
+		if p.ID == c.defaultPlan {
+			return p
+		}
+	}
+	return altalune.BillingPlan{ID: c.defaultPlan, Name: c.defaultPlan}
+}
+
+// PlanOf returns the plan in effect with a subscription, nil for none. Active
+// subscriptions to a price no plan has fall back to the default plan.
+func (c *Catalog) PlanOf(sub *Subscription) altalune.BillingPlan {
+	if sub.Active() {
+		for _, p := range c.plans {
+			if p.StripePriceID != "" && p.StripePriceID == sub.StripePriceID {
+				return p
+			}
+		}
+	}
+	return c.Default()
+}
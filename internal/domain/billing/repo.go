@@ -0,0 +1,202 @@
+package billing
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hrz8/altalune/internal/postgres"
+	"github.com/lib/pq"
+)
+
+type Repo struct {
+	db postgres.DB
+}
+
+func NewRepo(db postgres.DB) *Repo {
+	return &Repo{
+		db: db,
+	}
+}
+
+const subscriptionColumns = `
+	organization_id,
+	stripe_subscription_id,
+	stripe_price_id,
+	status,
+	current_period_end,
+	cancel_at_period_end,
+	event_created_at
+`
+
+func scanSubscription(row *sql.Row) (*Subscription, error) {
+	var sub Subscription
+	var periodEnd sql.NullTime
+	err := row.Scan(
+		&sub.OrganizationID,
+		&sub.StripeSubscriptionID,
+		&sub.StripePriceID,
+		&sub.Status,
+		&periodEnd,
+		&sub.CancelAtPeriodEnd,
+		&sub.EventCreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if periodEnd.Valid {
+		sub.CurrentPeriodEnd = &periodEnd.Time
+	}
+	return &sub, nil
+}
+
+func (r *Repo) GetSubscription(ctx context.Context, organizationID int64) (*Subscription, error) {
+	query := `SELECT ` + subscriptionColumns + `
+		FROM altalune_billing_subscriptions
+		WHERE organization_id = $1
+	`
+	sub, err := scanSubscription(r.db.QueryRowContext(ctx, query, organizationID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrSubscriptionNotFound
+		}
+		return nil, fmt.Errorf("get subscription: %w", err)
+	}
+	return sub, nil
+}
+
+func (r *Repo) GetProjectSubscription(ctx context.Context, projectID int64) (*Subscription, error) {
+	query := `SELECT ` + subscriptionColumns + `
+		FROM altalune_billing_subscriptions
+		WHERE organization_id = (SELECT organization_id FROM altalune_projects WHERE id = $1)
+	`
+	sub, err := scanSubscription(r.db.QueryRowContext(ctx, query, projectID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrSubscriptionNotFound
+		}
+		return nil, fmt.Errorf("get project subscription: %w", err)
+	}
+	return sub, nil
+}
+
+func (r *Repo) GetDefaultProjectSubscription(ctx context.Context) (*Subscription, error) {
+	query := `SELECT ` + subscriptionColumns + `
+		FROM altalune_billing_subscriptions
+		WHERE organization_id = (SELECT organization_id FROM altalune_projects WHERE is_default ORDER BY id LIMIT 1)
+	`
+	sub, err := scanSubscription(r.db.QueryRowContext(ctx, query))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrSubscriptionNotFound
+		}
+		return nil, fmt.Errorf("get default project subscription: %w", err)
+	}
+	return sub, nil
+}
+
+func (r *Repo) GetOrganizationIDByCustomer(ctx context.Context, customerID string) (int64, error) {
+	query := `
+		SELECT id FROM altalune_organizations
+		WHERE billing_customer_id = $1 AND billing_customer_id <> ''
+	`
+	var id int64
+	err := r.db.QueryRowContext(ctx, query, customerID).Scan(&id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, ErrCustomerNotFound
+		}
+		return 0, fmt.Errorf("get organization by customer: %w", err)
+	}
+	return id, nil
+}
+
+func (r *Repo) SetCustomer(ctx context.Context, organizationPublicID string, customerID string) error {
+	query := `
+		UPDATE altalune_organizations
+		SET billing_customer_id = $2, updated_at = NOW()
+		WHERE public_id = $1
+	`
+	result, err := r.db.ExecContext(ctx, query, organizationPublicID, customerID)
+	if err != nil {
+		if postgres.IsUniqueViolation(err) && strings.Contains(err.Error(), "billing_customer_id") {
+			return fmt.Errorf("set billing customer: customer %s belongs to another organization", customerID)
+		}
+		return fmt.Errorf("set billing customer: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("rows affected: %w", err)
+	}
+	if n == 0 {
+		return ErrOrganizationNotFound
+	}
+	return nil
+}
+
+// ApplySubscription upserts the subscription of the organization. A stored
+// subscription is only replaced by another one that is active, so the late
+// cancellation of a previous subscription does not end the current one.
+func (r *Repo) ApplySubscription(ctx context.Context, sub *Subscription) (bool, error) {
+	query := `
+		INSERT INTO altalune_billing_subscriptions (
+			organization_id, stripe_subscription_id, stripe_price_id, status,
+			current_period_end, cancel_at_period_end, event_created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (organization_id) DO UPDATE
+		SET stripe_subscription_id = EXCLUDED.stripe_subscription_id,
+		    stripe_price_id = EXCLUDED.stripe_price_id,
+		    status = EXCLUDED.status,
+		    current_period_end = EXCLUDED.current_period_end,
+		    cancel_at_period_end = EXCLUDED.cancel_at_period_end,
+		    event_created_at = EXCLUDED.event_created_at,
+		    updated_at = NOW()
+		WHERE altalune_billing_subscriptions.event_created_at <= EXCLUDED.event_created_at
+		  AND (altalune_billing_subscriptions.stripe_subscription_id = EXCLUDED.stripe_subscription_id
+		       OR EXCLUDED.status = ANY($8))
+	`
+	result, err := r.db.ExecContext(ctx, query,
+		sub.OrganizationID,
+		sub.StripeSubscriptionID,
+		sub.StripePriceID,
+		sub.Status,
+		sub.CurrentPeriodEnd,
+		sub.CancelAtPeriodEnd,
+		sub.EventCreatedAt,
+		pq.Array(activeStatuses),
+	)
+	if err != nil {
+		return false, fmt.Errorf("apply subscription: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("rows affected: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (r *Repo) CountOrganizationProjects(ctx context.Context, organizationID int64, exceptProjectID int64) (int, error) {
+	query := `
+		SELECT COUNT(*) FROM altalune_projects
+		WHERE organization_id = $1 AND id <> $2
+	`
+	var n int
+	if err := r.db.QueryRowContext(ctx, query, organizationID, exceptProjectID).Scan(&n); err != nil {
+		return 0, fmt.Errorf("count organization projects: %w", err)
+	}
+	return n, nil
+}
+
+func (r *Repo) CountOAuthClients(ctx context.Context) (int, error) {
+	query := `
+		SELECT COUNT(*) FROM altalune_oauth_clients
+		WHERE deleted_at IS NULL AND NOT is_default
+	`
+	var n int
+	if err := r.db.QueryRowContext(ctx, query).Scan(&n); err != nil {
+		return 0, fmt.Errorf("count oauth clients: %w", err)
+	}
+	return n, nil
+}
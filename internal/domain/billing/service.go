@@ -0,0 +1,124 @@
+package billing
+
+import (
+	"context"
+
+	"buf.build/go/protovalidate"
+	"github.com/hrz8/altalune"
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	"github.com/hrz8/altalune/internal/domain/organization"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type Service struct {
+	altalunev1.UnimplementedBillingServiceServer
+	validator       protovalidate.Validator
+	log             altalune.Logger
+	orgs            OrganizationAuthorizer
+	billingRepo     Repositor
+	catalog         *Catalog
+	stripe          *StripeClient
+	portalReturnURL string
+}
+
+// NewService creates the billing service, stripe being nil when no Stripe
+// secret key is configured
+func NewService(v protovalidate.Validator, log altalune.Logger, orgs OrganizationAuthorizer, billingRepo Repositor, catalog *Catalog, stripe *StripeClient, portalReturnURL string) *Service {
+	return &Service{
+		validator:       v,
+		log:             log,
+		orgs:            orgs,
+		billingRepo:     billingRepo,
+		catalog:         catalog,
+		stripe:          stripe,
+		portalReturnURL: portalReturnURL,
+	}
+}
+
+func (s *Service) ListPlans(ctx context.Context, req *altalunev1.ListPlansRequest) (*altalunev1.ListPlansResponse, error) {
+	plans := s.catalog.Plans()
+	response := &altalunev1.ListPlansResponse{
+		Plans:       make([]*altalunev1.BillingPlan, 0, len(plans)),
+		DefaultPlan: s.catalog.Default().ID,
+	}
+	for _, p := range plans {
+		response.Plans = append(response.Plans, toBillingPlanProto(p))
+	}
+	return response, nil
+}
+
+// GetOrganizationBilling returns the plan in effect for an organization with
+// its subscription, for its owners and admins
+func (s *Service) GetOrganizationBilling(ctx context.Context, req *altalunev1.GetOrganizationBillingRequest) (*altalunev1.GetOrganizationBillingResponse, error) {
+	// Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	org, err := s.orgs.Authorize(ctx, req.OrganizationId, organization.RoleOwner, organization.RoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	sub, err := s.billingRepo.GetSubscription(ctx, org.ID)
+	if err != nil && err != ErrSubscriptionNotFound {
+		s.log.Error("failed to get subscription",
+			"error", err,
+			"organization_id", org.ID,
+		)
+		return nil, altalune.NewUnexpectedError("failed to get subscription: %w", err)
+	}
+
+	response := &altalunev1.GetOrganizationBillingResponse{
+		Plan:         toBillingPlanProto(s.catalog.PlanOf(sub)),
+		ProjectCount: int32(org.ProjectCount),
+		HasCustomer:  org.BillingCustomerID != "",
+	}
+	if sub != nil {
+		response.SubscriptionStatus = sub.Status
+		response.CancelAtPeriodEnd = sub.CancelAtPeriodEnd
+		if sub.CurrentPeriodEnd != nil {
+			response.CurrentPeriodEnd = timestamppb.New(*sub.CurrentPeriodEnd)
+		}
+	}
+	return response, nil
+}
+
+// CreatePortalSession opens the Stripe customer portal of an organization for
+// one of its owners
+func (s *Service) CreatePortalSession(ctx context.Context, req *altalunev1.CreatePortalSessionRequest) (*altalunev1.CreatePortalSessionResponse, error) {
+	// Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	org, err := s.orgs.Authorize(ctx, req.OrganizationId, organization.RoleOwner)
+	if err != nil {
+		return nil, err
+	}
+	if org.BillingCustomerID == "" {
+		return nil, altalune.NewBillingCustomerMissingError(req.OrganizationId)
+	}
+	returnURL := req.ReturnUrl
+	if returnURL == "" {
+		returnURL = s.portalReturnURL
+	}
+	if returnURL == "" {
+		return nil, altalune.NewInvalidPayloadError("return_url is required, billing.portalReturnURL is not set")
+	}
+	if s.stripe == nil {
+		s.log.Warn("customer portal requested without billing.stripeSecretKey")
+		return nil, altalune.NewBillingProviderUnavailableError()
+	}
+
+	url, err := s.stripe.CreatePortalSession(ctx, org.BillingCustomerID, returnURL)
+	if err != nil {
+		s.log.Error("failed to create customer portal session",
+			"error", err,
+			"organization_id", org.ID,
+		)
+		return nil, altalune.NewBillingProviderUnavailableError()
+	}
+
+	return &altalunev1.CreatePortalSessionResponse{Url: url}, nil
+}
@@ -0,0 +1,177 @@
+package billing
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	stripeAPIURL = "https://api.stripe.com"
+	// signatureTolerance is how old a webhook signature may be, bounding the
+	// replay of captured deliveries
+	signatureTolerance = 5 * time.Minute
+)
+
+var (
+	ErrInvalidSignature = errors.New("invalid stripe signature")
+	ErrExpiredSignature = errors.New("expired stripe signature")
+)
+
+// verifySignature checks the Stripe-Signature header of a webhook delivery:
+// a timestamp and one or more v1 HMAC-SHA256 signatures of
+// "<timestamp>.<payload>" keyed with the endpoint secret.
+func verifySignature(payload []byte, header, secret string, now time.Time) error {
+	var timestamp string
+	var signatures []string
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "t":
+			timestamp = value
+		case "v1":
+			signatures = append(signatures, value)
+		}
+	}
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || len(signatures) == 0 {
+		return ErrInvalidSignature
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	expected := mac.Sum(nil)
+
+	for _, signature := range signatures {
+		decoded, err := hex.DecodeString(signature)
+		if err == nil && hmac.Equal(decoded, expected) {
+			if now.Sub(time.Unix(unix, 0)).Abs() > signatureTolerance {
+				return ErrExpiredSignature
+			}
+			return nil
+		}
+	}
+	return ErrInvalidSignature
+}
+
+// event is a Stripe webhook event
+type event struct {
+	ID      string `json:"id"`
+	Type    string `json:"type"`
+	Created int64  `json:"created"`
+	Data    struct {
+		Object json.RawMessage `json:"object"`
+	} `json:"data"`
+}
+
+// stripeSubscription holds the fields of a Stripe subscription object in use.
+// Recent API versions moved current_period_end to the subscription items.
+type stripeSubscription struct {
+	ID                string `json:"id"`
+	Customer          string `json:"customer"`
+	Status            string `json:"status"`
+	CancelAtPeriodEnd bool   `json:"cancel_at_period_end"`
+	CurrentPeriodEnd  int64  `json:"current_period_end"`
+	Items             struct {
+		Data []struct {
+			CurrentPeriodEnd int64 `json:"current_period_end"`
+			Price            struct {
+				ID string `json:"id"`
+			} `json:"price"`
+		} `json:"data"`
+	} `json:"items"`
+}
+
+// toSubscription converts the object of a subscription event created at
+// created to the subscription of organizationID
+func (s *stripeSubscription) toSubscription(organizationID int64, created time.Time) *Subscription {
+	sub := &Subscription{
+		OrganizationID:       organizationID,
+		StripeSubscriptionID: s.ID,
+		Status:               s.Status,
+		CancelAtPeriodEnd:    s.CancelAtPeriodEnd,
+		EventCreatedAt:       created,
+	}
+	periodEnd := s.CurrentPeriodEnd
+	if len(s.Items.Data) > 0 {
+		sub.StripePriceID = s.Items.Data[0].Price.ID
+		if periodEnd == 0 {
+			periodEnd = s.Items.Data[0].CurrentPeriodEnd
+		}
+	}
+	if periodEnd > 0 {
+		t := time.Unix(periodEnd, 0).UTC()
+		sub.CurrentPeriodEnd = &t
+	}
+	return sub
+}
+
+// stripeCheckoutSession holds the fields of a Stripe Checkout session in use.
+// Checkout links the organization, passed as client_reference_id, to the
+// customer it creates.
+type stripeCheckoutSession struct {
+	Customer          string `json:"customer"`
+	ClientReferenceID string `json:"client_reference_id"`
+}
+
+// StripeClient calls the Stripe API
+type StripeClient struct {
+	secretKey string
+	baseURL   string
+	client    *http.Client
+}
+
+// NewStripeClient creates a client authenticating with a secret API key
+func NewStripeClient(secretKey string) *StripeClient {
+	return &StripeClient{
+		secretKey: secretKey,
+		baseURL:   stripeAPIURL,
+		client:    &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// CreatePortalSession opens a customer portal session for customerID,
+// returning its short-lived URL
+func (c *StripeClient) CreatePortalSession(ctx context.Context, customerID, returnURL string) (string, error) {
+	form := url.Values{"customer": {customerID}, "return_url": {returnURL}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/v1/billing_portal/sessions", strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("create portal session request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Authorization", "Bearer "+c.secretKey)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("create portal session: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		URL   string `json:"url"`
+		Error struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("decode portal session: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("create portal session: status %d: %s", resp.StatusCode, result.Error.Message)
+	}
+	return result.URL, nil
+}
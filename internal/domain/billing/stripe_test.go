@@ -0,0 +1,32 @@
+package billing
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func sign(payload []byte, secret string, at time.Time) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%d.%s", at.Unix(), payload)
+	return fmt.Sprintf("t=%d,v1=%s", at.Unix(), hex.EncodeToString(mac.Sum(nil)))
+}
+
+func TestVerifySignature(t *testing.T) {
+	payload := []byte(`{"id":"evt_1"}`)
+	now := time.Unix(1767225600, 0)
+
+	assert.NoError(t, verifySignature(payload, sign(payload, "whsec_test", now), "whsec_test", now))
+	// Stripe sends a signature per active secret while one is rolled
+	assert.NoError(t, verifySignature(payload, sign(payload, "whsec_test", now)+",v1=00ff", "whsec_test", now))
+
+	assert.ErrorIs(t, verifySignature(payload, sign(payload, "whsec_other", now), "whsec_test", now), ErrInvalidSignature)
+	assert.ErrorIs(t, verifySignature([]byte(`{"id":"evt_2"}`), sign(payload, "whsec_test", now), "whsec_test", now), ErrInvalidSignature)
+	assert.ErrorIs(t, verifySignature(payload, "", "whsec_test", now), ErrInvalidSignature)
+	assert.ErrorIs(t, verifySignature(payload, sign(payload, "whsec_test", now.Add(-10*time.Minute)), "whsec_test", now), ErrExpiredSignature)
+}
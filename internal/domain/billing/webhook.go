@@ -0,0 +1,136 @@
+package billing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hrz8/altalune"
+	"github.com/hrz8/altalune/internal/shared/realip"
+)
+
+// WebhookPath is where Stripe delivers the webhook events
+const WebhookPath = "/webhooks/stripe"
+
+// maxEventSize bounds the webhook payloads read
+const maxEventSize = 1 << 20
+
+// Webhook receives the Stripe events keeping the subscriptions of the
+// organizations up to date. Every event is applied idempotently, so the
+// deliveries Stripe retries are harmless.
+type Webhook struct {
+	secret string
+	repo   Repositor
+	log    altalune.Logger
+	now    func() time.Time
+}
+
+func NewWebhook(secret string, repo Repositor, log altalune.Logger) *Webhook {
+	return &Webhook{
+		secret: secret,
+		repo:   repo,
+		log:    log,
+		now:    time.Now,
+	}
+}
+
+// ServeHTTP answers 2xx once an event is applied or ignored. Other answers
+// make Stripe deliver the event again later.
+func (h *Webhook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	payload, err := io.ReadAll(io.LimitReader(r.Body, maxEventSize))
+	if err != nil {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+	if err := verifySignature(payload, r.Header.Get("Stripe-Signature"), h.secret, h.now()); err != nil {
+		h.log.Warn("stripe webhook rejected", "error", err, "client_ip", realip.IP(r.Context()))
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	var evt event
+	if err := json.Unmarshal(payload, &evt); err != nil {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.handle(r.Context(), &evt); err != nil {
+		if err == ErrCustomerNotFound {
+			// The subscription may be delivered before the checkout linking
+			// its customer to the organization
+			h.log.Warn("stripe webhook for unknown customer", "event_id", evt.ID, "type", evt.Type)
+			http.Error(w, "Unknown Customer", http.StatusConflict)
+			return
+		}
+		h.log.Error("failed to handle stripe webhook",
+			"error", err,
+			"event_id", evt.ID,
+			"type", evt.Type,
+		)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *Webhook) handle(ctx context.Context, evt *event) error {
+	switch {
+	case evt.Type == "checkout.session.completed":
+		var session stripeCheckoutSession
+		if err := json.Unmarshal(evt.Data.Object, &session); err != nil {
+			return fmt.Errorf("decode checkout session: %w", err)
+		}
+		if session.ClientReferenceID == "" || session.Customer == "" {
+			return nil
+		}
+		err := h.repo.SetCustomer(ctx, session.ClientReferenceID, session.Customer)
+		if err == ErrOrganizationNotFound {
+			h.log.Warn("stripe checkout for unknown organization",
+				"event_id", evt.ID,
+				"organization_public_id", session.ClientReferenceID,
+			)
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		h.log.Info("billing customer linked",
+			"organization_public_id", session.ClientReferenceID,
+			"customer_id", session.Customer,
+		)
+		return nil
+
+	case strings.HasPrefix(evt.Type, "customer.subscription."):
+		var stripeSub stripeSubscription
+		if err := json.Unmarshal(evt.Data.Object, &stripeSub); err != nil {
+			return fmt.Errorf("decode subscription: %w", err)
+		}
+		organizationID, err := h.repo.GetOrganizationIDByCustomer(ctx, stripeSub.Customer)
+		if err != nil {
+			return err
+		}
+		sub := stripeSub.toSubscription(organizationID, time.Unix(evt.Created, 0).UTC())
+		applied, err := h.repo.ApplySubscription(ctx, sub)
+		if err != nil {
+			return err
+		}
+		h.log.Info("subscription event received",
+			"event_id", evt.ID,
+			"type", evt.Type,
+			"organization_id", organizationID,
+			"status", sub.Status,
+			"applied", applied,
+		)
+		return nil
+	}
+	return nil
+}
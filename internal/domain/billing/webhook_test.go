@@ -0,0 +1,98 @@
+package billing
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hrz8/altalune/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeRepo struct {
+	Repositor
+	customers map[string]int64
+	sub       *Subscription
+	projects  int
+	clients   int
+}
+
+func (r *fakeRepo) GetOrganizationIDByCustomer(ctx context.Context, customerID string) (int64, error) {
+	id, ok := r.customers[customerID]
+	if !ok {
+		return 0, ErrCustomerNotFound
+	}
+	return id, nil
+}
+
+func (r *fakeRepo) ApplySubscription(ctx context.Context, sub *Subscription) (bool, error) {
+	if r.sub != nil && r.sub.EventCreatedAt.After(sub.EventCreatedAt) {
+		return false, nil
+	}
+	r.sub = sub
+	return true, nil
+}
+
+func (r *fakeRepo) GetSubscription(ctx context.Context, organizationID int64) (*Subscription, error) {
+	if r.sub == nil {
+		return nil, ErrSubscriptionNotFound
+	}
+	return r.sub, nil
+}
+
+func (r *fakeRepo) GetProjectSubscription(ctx context.Context, projectID int64) (*Subscription, error) {
+	return r.GetSubscription(ctx, 0)
+}
+
+func (r *fakeRepo) CountOrganizationProjects(ctx context.Context, organizationID int64, exceptProjectID int64) (int, error) {
+	return r.projects, nil
+}
+
+func (r *fakeRepo) GetDefaultProjectSubscription(ctx context.Context) (*Subscription, error) {
+	return r.GetSubscription(ctx, 0)
+}
+
+func (r *fakeRepo) CountOAuthClients(ctx context.Context) (int, error) {
+	return r.clients, nil
+}
+
+func TestWebhookSubscription(t *testing.T) {
+	repo := &fakeRepo{customers: map[string]int64{"cus_1": 7}}
+	webhook := NewWebhook("whsec_test", repo, logger.New("error"))
+	now := time.Unix(1767225600, 0)
+	webhook.now = func() time.Time { return now }
+
+	deliver := func(payload, signature string) int {
+		req := httptest.NewRequest(http.MethodPost, WebhookPath, strings.NewReader(payload))
+		req.Header.Set("Stripe-Signature", signature)
+		rec := httptest.NewRecorder()
+		webhook.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	updated := `{"id":"evt_2","type":"customer.subscription.updated","created":1767225500,"data":{"object":{
+		"id":"sub_1","customer":"cus_1","status":"active","cancel_at_period_end":true,
+		"items":{"data":[{"current_period_end":1769904000,"price":{"id":"price_pro"}}]}}}}`
+	require.Equal(t, http.StatusOK, deliver(updated, sign([]byte(updated), "whsec_test", now)))
+	require.NotNil(t, repo.sub)
+	assert.Equal(t, int64(7), repo.sub.OrganizationID)
+	assert.Equal(t, "price_pro", repo.sub.StripePriceID)
+	assert.True(t, repo.sub.CancelAtPeriodEnd)
+	assert.Equal(t, time.Unix(1769904000, 0).UTC(), *repo.sub.CurrentPeriodEnd)
+
+	// Events delivered late do not replace later ones
+	created := `{"id":"evt_1","type":"customer.subscription.created","created":1767225400,"data":{"object":{
+		"id":"sub_1","customer":"cus_1","status":"incomplete"}}}`
+	require.Equal(t, http.StatusOK, deliver(created, sign([]byte(created), "whsec_test", now)))
+	assert.Equal(t, "active", repo.sub.Status)
+
+	unknown := `{"id":"evt_3","type":"customer.subscription.updated","created":1767225600,"data":{"object":{
+		"id":"sub_2","customer":"cus_2","status":"active"}}}`
+	assert.Equal(t, http.StatusConflict, deliver(unknown, sign([]byte(unknown), "whsec_test", now)), "retried once checkout links the customer")
+
+	assert.Equal(t, http.StatusBadRequest, deliver(updated, sign([]byte(updated), "whsec_other", now)))
+}
@@ -9,7 +9,6 @@ import (
 	"time"
 
 	"github.com/hrz8/altalune/internal/postgres"
-	"github.com/hrz8/altalune/internal/shared/nanoid"
 )
 
 // defaultChatbotModulesConfig is the default JSONB configuration for new chatbot configs
@@ -109,12 +108,6 @@ func (r *Repo) createDefault(ctx context.Context, projectID int64) (*ChatbotConf
 		return nil, err
 	}
 
-	// Generate public_id for the new config
-	publicID, err := nanoid.GeneratePublicID()
-	if err != nil {
-		return nil, fmt.Errorf("generate public_id: %w", err)
-	}
-
 	// Insert default config
 	insertQuery := `
 		INSERT INTO altalune_chatbot_configs (
@@ -125,22 +118,24 @@ func (r *Repo) createDefault(ctx context.Context, projectID int64) (*ChatbotConf
 
 	now := time.Now()
 	var result ChatbotConfigQueryResult
-	err = r.db.QueryRowContext(
-		ctx,
-		insertQuery,
-		publicID,
-		projectID,
-		defaultChatbotModulesConfig,
-		now,
-		now,
-	).Scan(
-		&result.ID,
-		&result.PublicID,
-		&result.ProjectID,
-		&result.ModulesConfig,
-		&result.CreatedAt,
-		&result.UpdatedAt,
-	)
+	_, err = postgres.InsertWithPublicID(func(publicID string) error {
+		return r.db.QueryRowContext(
+			ctx,
+			insertQuery,
+			publicID,
+			projectID,
+			defaultChatbotModulesConfig,
+			now,
+			now,
+		).Scan(
+			&result.ID,
+			&result.PublicID,
+			&result.ProjectID,
+			&result.ModulesConfig,
+			&result.CreatedAt,
+			&result.UpdatedAt,
+		)
+	})
 
 	if err != nil {
 		return nil, fmt.Errorf("create default chatbot config: %w", err)
@@ -9,7 +9,6 @@ import (
 	"time"
 
 	"github.com/hrz8/altalune/internal/postgres"
-	"github.com/hrz8/altalune/internal/shared/nanoid"
 	"github.com/lib/pq"
 )
 
@@ -84,12 +83,6 @@ func (r *Repo) ListByProjectID(ctx context.Context, projectID int64) ([]*Chatbot
 // Create creates a new chatbot node with default empty triggers and messages
 // Note: Partition is created by migration (for existing projects) or project/repo.go (for new projects)
 func (r *Repo) Create(ctx context.Context, input *CreateNodeInput) (*ChatbotNode, error) {
-	// Generate public_id for the new node
-	publicID, err := nanoid.GeneratePublicID()
-	if err != nil {
-		return nil, fmt.Errorf("generate public_id: %w", err)
-	}
-
 	// Default empty triggers and messages
 	defaultTriggers := "[]"
 	defaultMessages := "[]"
@@ -109,31 +102,33 @@ func (r *Repo) Create(ctx context.Context, input *CreateNodeInput) (*ChatbotNode
 
 	now := time.Now()
 	var result ChatbotNodeQueryResult
-	err = r.db.QueryRowContext(
-		ctx,
-		insertQuery,
-		publicID,
-		input.ProjectID,
-		input.Name,
-		input.Lang,
-		pq.Array(tags),
-		defaultTriggers,
-		defaultMessages,
-		now,
-		now,
-	).Scan(
-		&result.ID,
-		&result.PublicID,
-		&result.ProjectID,
-		&result.Name,
-		&result.Lang,
-		pq.Array(&result.Tags),
-		&result.Enabled,
-		&result.Triggers,
-		&result.Messages,
-		&result.CreatedAt,
-		&result.UpdatedAt,
-	)
+	_, err := postgres.InsertWithPublicID(func(publicID string) error {
+		return r.db.QueryRowContext(
+			ctx,
+			insertQuery,
+			publicID,
+			input.ProjectID,
+			input.Name,
+			input.Lang,
+			pq.Array(tags),
+			defaultTriggers,
+			defaultMessages,
+			now,
+			now,
+		).Scan(
+			&result.ID,
+			&result.PublicID,
+			&result.ProjectID,
+			&result.Name,
+			&result.Lang,
+			pq.Array(&result.Tags),
+			&result.Enabled,
+			&result.Triggers,
+			&result.Messages,
+			&result.CreatedAt,
+			&result.UpdatedAt,
+		)
+	})
 
 	if err != nil {
 		return nil, fmt.Errorf("create node: %w", err)
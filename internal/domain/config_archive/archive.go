@@ -0,0 +1,84 @@
+package config_archive
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/hrz8/altalune/internal/shared/crypto"
+)
+
+// header starts every sealed archive, so other files are told apart from
+// archives sealed with another key
+const header = "altalune-config-archive\n"
+
+// ParseKey decodes a base64-encoded 32-byte archive key, the same format as
+// the IAM encryption key (openssl rand -base64 32).
+func ParseKey(encoded string) ([]byte, error) {
+	if encoded == "" {
+		return nil, errors.New("archive key is required")
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, fmt.Errorf("archive key is not valid base64: %w", err)
+	}
+	if err := crypto.ValidateKey(key); err != nil {
+		return nil, fmt.Errorf("invalid archive key: %w", err)
+	}
+	return key, nil
+}
+
+// Seal serializes, compresses and encrypts an archive with AES-256-GCM.
+func Seal(archive *Archive, key []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if err := json.NewEncoder(zw).Encode(archive); err != nil {
+		return nil, fmt.Errorf("encode archive: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("compress archive: %w", err)
+	}
+
+	sealed, err := crypto.Encrypt(buf.String(), key)
+	if err != nil {
+		return nil, fmt.Errorf("encrypt archive: %w", err)
+	}
+	return []byte(header + sealed + "\n"), nil
+}
+
+// Open decrypts and decodes an archive sealed with Seal.
+func Open(data []byte, key []byte) (*Archive, error) {
+	sealed, ok := strings.CutPrefix(string(data), header)
+	if !ok {
+		return nil, errors.New("not a config archive")
+	}
+
+	compressed, err := crypto.Decrypt(strings.TrimSpace(sealed), key)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt archive (wrong key?): %w", err)
+	}
+
+	zr, err := gzip.NewReader(strings.NewReader(compressed))
+	if err != nil {
+		return nil, fmt.Errorf("decompress archive: %w", err)
+	}
+	defer zr.Close()
+	raw, err := io.ReadAll(zr)
+	if err != nil {
+		return nil, fmt.Errorf("decompress archive: %w", err)
+	}
+
+	var archive Archive
+	if err := json.Unmarshal(raw, &archive); err != nil {
+		return nil, fmt.Errorf("decode archive: %w", err)
+	}
+	if archive.Version < 1 || archive.Version > Version {
+		return nil, fmt.Errorf("unsupported archive version %d", archive.Version)
+	}
+	return &archive, nil
+}
@@ -0,0 +1,67 @@
+package config_archive_test
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/hrz8/altalune/internal/domain/config_archive"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newKey(t *testing.T) []byte {
+	t.Helper()
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	return key
+}
+
+func TestSealOpen(t *testing.T) {
+	key := newKey(t)
+	description := "Main project"
+	archive := &config_archive.Archive{
+		Version:    config_archive.Version,
+		ExportedAt: time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC),
+		Providers: []config_archive.Provider{
+			{PublicID: "prv1", ProviderType: "google", ClientID: "google-client", ClientSecret: "sealed", Enabled: true},
+		},
+		Clients: []config_archive.Client{
+			{PublicID: "cli1", Name: "Dashboard", ClientID: "0b6f2a4e-8d1c-4c3b-9a57-1d2e3f4a5b6c",
+				RedirectURIs: []string{"https://example.com/callback"}, Scopes: []string{"openid", "profile"}},
+		},
+		Projects: []config_archive.Project{
+			{PublicID: "prj1", Name: "Main", Description: &description, Timezone: "Asia/Jakarta",
+				Hostnames: []config_archive.ProjectHostname{{PublicID: "hst1", Hostname: "auth.example.com"}}},
+		},
+	}
+
+	sealed, err := config_archive.Seal(archive, key)
+	require.NoError(t, err)
+	assert.NotContains(t, string(sealed), "google-client")
+
+	opened, err := config_archive.Open(sealed, key)
+	require.NoError(t, err)
+	assert.Equal(t, archive, opened)
+
+	_, err = config_archive.Open(sealed, newKey(t))
+	assert.Error(t, err)
+
+	_, err = config_archive.Open([]byte("not an archive"), key)
+	assert.Error(t, err)
+}
+
+func TestParseKey(t *testing.T) {
+	key := newKey(t)
+
+	parsed, err := config_archive.ParseKey(base64.StdEncoding.EncodeToString(key) + "\n")
+	require.NoError(t, err)
+	assert.Equal(t, key, parsed)
+
+	for _, encoded := range []string{"", "not base64!", base64.StdEncoding.EncodeToString([]byte("short"))} {
+		_, err := config_archive.ParseKey(encoded)
+		assert.Error(t, err, encoded)
+	}
+}
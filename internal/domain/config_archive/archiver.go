@@ -0,0 +1,498 @@
+package config_archive
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hrz8/altalune/internal/postgres"
+	"github.com/hrz8/altalune/internal/shared/crypto"
+	"github.com/lib/pq"
+)
+
+// Archiver exports the OAuth infrastructure configuration of an instance and
+// restores it on another one
+type Archiver struct {
+	db      postgres.DB
+	keyring *crypto.Keyring
+}
+
+// NewArchiver creates an Archiver. The keyring decrypts the provider secrets on
+// export and encrypts them again on import.
+func NewArchiver(db postgres.DB, keyring *crypto.Keyring) *Archiver {
+	return &Archiver{
+		db:      db,
+		keyring: keyring,
+	}
+}
+
+// Export reads the configuration in a single read-only transaction, so the
+// archive is consistent even while the instance is serving. Provider secrets
+// are re-encrypted with the archive key.
+func (a *Archiver) Export(ctx context.Context, archiveKey []byte) (*Archive, error) {
+	tx, err := postgres.BeginAllProjectsTx(ctx, a.db, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	archive := &Archive{
+		Version:    Version,
+		ExportedAt: time.Now().UTC(),
+	}
+	if archive.Providers, err = a.exportProviders(ctx, tx, archiveKey); err != nil {
+		return nil, fmt.Errorf("export providers: %w", err)
+	}
+	if archive.Clients, err = exportClients(ctx, tx); err != nil {
+		return nil, fmt.Errorf("export clients: %w", err)
+	}
+	if archive.Projects, err = exportProjects(ctx, tx); err != nil {
+		return nil, fmt.Errorf("export projects: %w", err)
+	}
+	return archive, nil
+}
+
+func (a *Archiver) exportProviders(ctx context.Context, tx *sql.Tx, archiveKey []byte) ([]Provider, error) {
+	rows, err := tx.QueryContext(ctx, `
+		SELECT public_id, provider_type, client_id, client_secret,
+			redirect_url, COALESCE(scopes, ''), enabled
+		FROM altalune_oauth_providers
+		ORDER BY provider_type
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	providers := make([]Provider, 0)
+	for rows.Next() {
+		var p Provider
+		var encrypted string
+		if err := rows.Scan(&p.PublicID, &p.ProviderType, &p.ClientID, &encrypted,
+			&p.RedirectURL, &p.Scopes, &p.Enabled); err != nil {
+			return nil, err
+		}
+		secret, err := a.keyring.Decrypt(encrypted)
+		if err != nil {
+			return nil, fmt.Errorf("decrypt secret of %s: %w", p.ProviderType, err)
+		}
+		if p.ClientSecret, err = crypto.Encrypt(secret, archiveKey); err != nil {
+			return nil, fmt.Errorf("encrypt secret of %s: %w", p.ProviderType, err)
+		}
+		providers = append(providers, p)
+	}
+	return providers, rows.Err()
+}
+
+func exportClients(ctx context.Context, tx *sql.Tx) ([]Client, error) {
+	scopes := make(map[string][]string)
+	scopeRows, err := tx.QueryContext(ctx, `
+		SELECT cs.client_id::text, s.name
+		FROM altalune_oauth_client_scopes cs
+		JOIN altalune_oauth_scopes s ON s.id = cs.scope_id
+		ORDER BY s.name
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer scopeRows.Close()
+	for scopeRows.Next() {
+		var clientID, name string
+		if err := scopeRows.Scan(&clientID, &name); err != nil {
+			return nil, err
+		}
+		scopes[clientID] = append(scopes[clientID], name)
+	}
+	if err := scopeRows.Err(); err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT public_id, name, client_id::text, client_secret_hash,
+			redirect_uris, pkce_required, is_default, confidential,
+			perms_claim, perms_claim_max_bytes, client_secret_expires_at
+		FROM altalune_oauth_clients
+		WHERE deleted_at IS NULL
+		ORDER BY id
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	clients := make([]Client, 0)
+	for rows.Next() {
+		var c Client
+		var secretHash sql.NullString
+		if err := rows.Scan(&c.PublicID, &c.Name, &c.ClientID, &secretHash,
+			pq.Array(&c.RedirectURIs), &c.PKCERequired, &c.IsDefault, &c.Confidential,
+			&c.PermsClaim, &c.PermsClaimMaxBytes, &c.ClientSecretExpiresAt); err != nil {
+			return nil, err
+		}
+		if secretHash.Valid {
+			c.ClientSecretHash = &secretHash.String
+		}
+		c.Scopes = scopes[c.ClientID]
+		if c.Scopes == nil {
+			c.Scopes = []string{}
+		}
+		clients = append(clients, c)
+	}
+	return clients, rows.Err()
+}
+
+func exportProjects(ctx context.Context, tx *sql.Tx) ([]Project, error) {
+	rows, err := tx.QueryContext(ctx, `
+		SELECT id, public_id, name, description, timezone, environment, is_default,
+			default_member_role, auto_activate, api_key_max_lifetime_days, api_key_policy_action,
+			client_secret_max_lifetime_days
+		FROM altalune_projects
+		ORDER BY id
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	projects := make([]Project, 0)
+	index := make(map[int64]int)
+	for rows.Next() {
+		var p Project
+		var id int64
+		var description, defaultMemberRole sql.NullString
+		var autoActivate sql.NullBool
+		var maxLifetimeDays, secretMaxLifetimeDays sql.NullInt64
+		if err := rows.Scan(&id, &p.PublicID, &p.Name, &description, &p.Timezone, &p.Environment, &p.IsDefault,
+			&defaultMemberRole, &autoActivate, &maxLifetimeDays, &p.ApiKeyPolicyAction,
+			&secretMaxLifetimeDays); err != nil {
+			return nil, err
+		}
+		if description.Valid {
+			p.Description = &description.String
+		}
+		if defaultMemberRole.Valid {
+			p.DefaultMemberRole = &defaultMemberRole.String
+		}
+		if autoActivate.Valid {
+			p.AutoActivate = &autoActivate.Bool
+		}
+		if maxLifetimeDays.Valid {
+			days := int(maxLifetimeDays.Int64)
+			p.ApiKeyMaxLifetimeDays = &days
+		}
+		if secretMaxLifetimeDays.Valid {
+			days := int(secretMaxLifetimeDays.Int64)
+			p.ClientSecretMaxLifetimeDays = &days
+		}
+		p.Hostnames = []ProjectHostname{}
+		index[id] = len(projects)
+		projects = append(projects, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	hostRows, err := tx.QueryContext(ctx, `
+		SELECT h.project_id, h.public_id, h.hostname, h.branding_name, h.logo_url, h.primary_color, c.public_id
+		FROM altalune_project_hostnames h
+		LEFT JOIN altalune_oauth_clients c ON c.id = h.default_oauth_client_id AND c.deleted_at IS NULL
+		ORDER BY h.hostname
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer hostRows.Close()
+	for hostRows.Next() {
+		var projectID int64
+		var h ProjectHostname
+		var brandingName, logoURL, primaryColor, clientID sql.NullString
+		if err := hostRows.Scan(&projectID, &h.PublicID, &h.Hostname,
+			&brandingName, &logoURL, &primaryColor, &clientID); err != nil {
+			return nil, err
+		}
+		h.BrandingName = nullString(brandingName)
+		h.LogoURL = nullString(logoURL)
+		h.PrimaryColor = nullString(primaryColor)
+		h.DefaultOAuthClientID = nullString(clientID)
+		if i, ok := index[projectID]; ok {
+			projects[i].Hostnames = append(projects[i].Hostnames, h)
+		}
+	}
+	if err := hostRows.Err(); err != nil {
+		return nil, err
+	}
+
+	brandingRows, err := tx.QueryContext(ctx, `
+		SELECT project_id, primary_color, logo, logo_content_type, footer_links
+		FROM altalune_project_branding
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer brandingRows.Close()
+	for brandingRows.Next() {
+		var projectID int64
+		var b ProjectBranding
+		var primaryColor, logoContentType sql.NullString
+		var footerLinks []byte
+		if err := brandingRows.Scan(&projectID, &primaryColor, &b.Logo, &logoContentType, &footerLinks); err != nil {
+			return nil, err
+		}
+		b.PrimaryColor = nullString(primaryColor)
+		b.LogoContentType = nullString(logoContentType)
+		b.FooterLinks = json.RawMessage(footerLinks)
+		if i, ok := index[projectID]; ok {
+			projects[i].Branding = &b
+		}
+	}
+	return projects, brandingRows.Err()
+}
+
+// Import restores an archive in a single transaction: either all of it is
+// restored or nothing is. Providers are matched by type, clients by client ID,
+// projects by public ID and hostnames by hostname; matches are overwritten and
+// configuration missing from the archive is kept.
+//
+// The default client and project of the instance are kept: archived ones are
+// restored as regular ones when the instance already has another default.
+func (a *Archiver) Import(ctx context.Context, archive *Archive, archiveKey []byte) (*ImportResult, error) {
+	tx, err := postgres.BeginAllProjectsTx(ctx, a.db, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result := &ImportResult{Notes: make([]string, 0)}
+	if err := a.importProviders(ctx, tx, archive.Providers, archiveKey, result); err != nil {
+		return nil, fmt.Errorf("import providers: %w", err)
+	}
+	clientIDs, err := importClients(ctx, tx, archive.Clients, result)
+	if err != nil {
+		return nil, fmt.Errorf("import clients: %w", err)
+	}
+	if err := importProjects(ctx, tx, archive.Projects, clientIDs, result); err != nil {
+		return nil, fmt.Errorf("import projects: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+	return result, nil
+}
+
+func (a *Archiver) importProviders(ctx context.Context, tx *sql.Tx, providers []Provider, archiveKey []byte, result *ImportResult) error {
+	for _, p := range providers {
+		secret, err := crypto.Decrypt(p.ClientSecret, archiveKey)
+		if err != nil {
+			return fmt.Errorf("decrypt secret of %s: %w", p.ProviderType, err)
+		}
+		encrypted, err := a.keyring.Encrypt(secret)
+		if err != nil {
+			return fmt.Errorf("encrypt secret of %s: %w", p.ProviderType, err)
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO altalune_oauth_providers (
+				public_id, provider_type, client_id, client_secret,
+				redirect_url, scopes, enabled, created_at, updated_at
+			)
+			VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''), $7, NOW(), NOW())
+			ON CONFLICT (provider_type) DO UPDATE SET
+				client_id = EXCLUDED.client_id,
+				client_secret = EXCLUDED.client_secret,
+				redirect_url = EXCLUDED.redirect_url,
+				scopes = EXCLUDED.scopes,
+				enabled = EXCLUDED.enabled,
+				updated_at = NOW()
+		`, p.PublicID, p.ProviderType, p.ClientID, encrypted, p.RedirectURL, p.Scopes, p.Enabled)
+		if err != nil {
+			return fmt.Errorf("restore %s: %w", p.ProviderType, err)
+		}
+		result.Providers++
+	}
+	return nil
+}
+
+// importClients restores the clients and returns their IDs by public ID
+func importClients(ctx context.Context, tx *sql.Tx, clients []Client, result *ImportResult) (map[string]int64, error) {
+	var currentDefault string
+	err := tx.QueryRowContext(ctx, `
+		SELECT client_id::text FROM altalune_oauth_clients
+		WHERE is_default = true AND deleted_at IS NULL
+		LIMIT 1
+	`).Scan(&currentDefault)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, fmt.Errorf("get default client: %w", err)
+	}
+
+	ids := make(map[string]int64, len(clients))
+	for _, c := range clients {
+		isDefault := c.IsDefault
+		if isDefault && currentDefault != "" && currentDefault != c.ClientID {
+			isDefault = false
+			result.Notes = append(result.Notes,
+				fmt.Sprintf("client %q restored as a regular client, the instance already has a default client", c.Name))
+		}
+
+		var id int64
+		err := tx.QueryRowContext(ctx, `
+			INSERT INTO altalune_oauth_clients (
+				public_id, name, client_id, client_secret_hash, redirect_uris,
+				pkce_required, is_default, confidential, perms_claim, perms_claim_max_bytes,
+				client_secret_expires_at, created_at, updated_at
+			)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, NOW(), NOW())
+			ON CONFLICT (client_id) DO UPDATE SET
+				name = EXCLUDED.name,
+				client_secret_hash = EXCLUDED.client_secret_hash,
+				redirect_uris = EXCLUDED.redirect_uris,
+				pkce_required = EXCLUDED.pkce_required,
+				is_default = EXCLUDED.is_default,
+				confidential = EXCLUDED.confidential,
+				perms_claim = EXCLUDED.perms_claim,
+				perms_claim_max_bytes = EXCLUDED.perms_claim_max_bytes,
+				client_secret_expires_at = EXCLUDED.client_secret_expires_at,
+				deleted_at = NULL,
+				updated_at = NOW()
+			RETURNING id
+		`, c.PublicID, c.Name, c.ClientID, c.ClientSecretHash, pq.Array(c.RedirectURIs),
+			c.PKCERequired, isDefault, c.Confidential, c.PermsClaim, c.PermsClaimMaxBytes,
+			c.ClientSecretExpiresAt).Scan(&id)
+		if err != nil {
+			return nil, fmt.Errorf("restore client %q: %w", c.Name, err)
+		}
+		ids[c.PublicID] = id
+
+		if _, err := tx.ExecContext(ctx, `
+			DELETE FROM altalune_oauth_client_scopes WHERE client_id = $1
+		`, c.ClientID); err != nil {
+			return nil, fmt.Errorf("clear scopes of client %q: %w", c.Name, err)
+		}
+		res, err := tx.ExecContext(ctx, `
+			INSERT INTO altalune_oauth_client_scopes (client_id, scope_id)
+			SELECT $1, id FROM altalune_oauth_scopes WHERE name = ANY($2)
+		`, c.ClientID, pq.Array(c.Scopes))
+		if err != nil {
+			return nil, fmt.Errorf("restore scopes of client %q: %w", c.Name, err)
+		}
+		if restored, _ := res.RowsAffected(); int(restored) < len(c.Scopes) {
+			result.Notes = append(result.Notes,
+				fmt.Sprintf("client %q: %d of its scopes do not exist on this instance", c.Name, len(c.Scopes)-int(restored)))
+		}
+		result.Clients++
+	}
+	return ids, nil
+}
+
+func importProjects(ctx context.Context, tx *sql.Tx, projects []Project, clientIDs map[string]int64, result *ImportResult) error {
+	var currentDefault string
+	err := tx.QueryRowContext(ctx, `
+		SELECT public_id FROM altalune_projects WHERE is_default = true LIMIT 1
+	`).Scan(&currentDefault)
+	if err != nil && err != sql.ErrNoRows {
+		return fmt.Errorf("get default project: %w", err)
+	}
+
+	for _, p := range projects {
+		isDefault := p.IsDefault
+		if isDefault && currentDefault != "" && currentDefault != p.PublicID {
+			isDefault = false
+			result.Notes = append(result.Notes,
+				fmt.Sprintf("project %q restored as a regular project, the instance already has a default project", p.Name))
+		}
+
+		var projectID int64
+		err := tx.QueryRowContext(ctx, `
+			INSERT INTO altalune_projects (
+				public_id, name, description, timezone, environment, is_default,
+				default_member_role, auto_activate, api_key_max_lifetime_days, api_key_policy_action,
+				client_secret_max_lifetime_days, created_at, updated_at
+			)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, NOW(), NOW())
+			ON CONFLICT (public_id) DO UPDATE SET
+				name = EXCLUDED.name,
+				description = EXCLUDED.description,
+				timezone = EXCLUDED.timezone,
+				environment = EXCLUDED.environment,
+				is_default = EXCLUDED.is_default,
+				default_member_role = EXCLUDED.default_member_role,
+				auto_activate = EXCLUDED.auto_activate,
+				api_key_max_lifetime_days = EXCLUDED.api_key_max_lifetime_days,
+				api_key_policy_action = EXCLUDED.api_key_policy_action,
+				client_secret_max_lifetime_days = EXCLUDED.client_secret_max_lifetime_days,
+				updated_at = NOW()
+			RETURNING id
+		`, p.PublicID, p.Name, p.Description, p.Timezone, p.Environment, isDefault,
+			p.DefaultMemberRole, p.AutoActivate, p.ApiKeyMaxLifetimeDays, p.ApiKeyPolicyAction,
+			p.ClientSecretMaxLifetimeDays).Scan(&projectID)
+		if err != nil {
+			return fmt.Errorf("restore project %q: %w", p.Name, err)
+		}
+
+		for _, h := range p.Hostnames {
+			var clientID *int64
+			if h.DefaultOAuthClientID != nil {
+				if id, ok := clientIDs[*h.DefaultOAuthClientID]; ok {
+					clientID = &id
+				}
+			}
+			_, err := tx.ExecContext(ctx, `
+				INSERT INTO altalune_project_hostnames (
+					public_id, project_id, hostname, branding_name, logo_url, primary_color,
+					default_oauth_client_id, created_at, updated_at
+				)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), NOW())
+				ON CONFLICT (hostname) DO UPDATE SET
+					project_id = EXCLUDED.project_id,
+					branding_name = EXCLUDED.branding_name,
+					logo_url = EXCLUDED.logo_url,
+					primary_color = EXCLUDED.primary_color,
+					default_oauth_client_id = EXCLUDED.default_oauth_client_id,
+					updated_at = NOW()
+			`, h.PublicID, projectID, h.Hostname, h.BrandingName, h.LogoURL, h.PrimaryColor, clientID)
+			if err != nil {
+				return fmt.Errorf("restore hostname %s: %w", h.Hostname, err)
+			}
+		}
+
+		if b := p.Branding; b != nil {
+			footerLinks := string(b.FooterLinks)
+			if footerLinks == "" {
+				footerLinks = "[]"
+			}
+			var logoUpdatedAt *time.Time
+			if b.Logo != nil {
+				now := time.Now()
+				logoUpdatedAt = &now
+			}
+			_, err := tx.ExecContext(ctx, `
+				INSERT INTO altalune_project_branding (
+					project_id, primary_color, logo, logo_content_type, logo_updated_at,
+					footer_links, created_at, updated_at
+				)
+				VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW())
+				ON CONFLICT (project_id) DO UPDATE SET
+					primary_color = EXCLUDED.primary_color,
+					logo = EXCLUDED.logo,
+					logo_content_type = EXCLUDED.logo_content_type,
+					logo_updated_at = EXCLUDED.logo_updated_at,
+					footer_links = EXCLUDED.footer_links,
+					updated_at = NOW()
+			`, projectID, b.PrimaryColor, b.Logo, b.LogoContentType, logoUpdatedAt, footerLinks)
+			if err != nil {
+				return fmt.Errorf("restore branding of project %q: %w", p.Name, err)
+			}
+		}
+		result.Projects++
+	}
+	return nil
+}
+
+func nullString(s sql.NullString) *string {
+	if !s.Valid {
+		return nil
+	}
+	return &s.String
+}
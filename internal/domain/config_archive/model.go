@@ -0,0 +1,98 @@
+package config_archive
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Version is the version of the archive format written by Export. Import
+// refuses archives of a newer version.
+const Version = 1
+
+// Archive is the OAuth infrastructure configuration of an instance: the
+// identity providers, the OAuth clients and the projects with their settings.
+// Users, memberships, API keys, tokens and audit trails are not part of it.
+type Archive struct {
+	Version    int        `json:"version"`
+	ExportedAt time.Time  `json:"exported_at"`
+	Providers  []Provider `json:"providers"`
+	Clients    []Client   `json:"clients"`
+	Projects   []Project  `json:"projects"`
+}
+
+// Provider is an identity provider. ClientSecret is encrypted with the archive
+// key rather than the IAM encryption key of the instance, so the archive can
+// be restored on an instance with a different key.
+type Provider struct {
+	PublicID     string `json:"public_id"`
+	ProviderType string `json:"provider_type"`
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+	RedirectURL  string `json:"redirect_url"`
+	Scopes       string `json:"scopes"`
+	Enabled      bool   `json:"enabled"`
+}
+
+// Client is an OAuth client. Only the hash of its secret is kept, so restored
+// clients keep working with the secrets already handed to them.
+type Client struct {
+	PublicID           string   `json:"public_id"`
+	Name               string   `json:"name"`
+	ClientID           string   `json:"client_id"`
+	ClientSecretHash   *string  `json:"client_secret_hash,omitempty"`
+	RedirectURIs       []string `json:"redirect_uris"`
+	PKCERequired       bool     `json:"pkce_required"`
+	IsDefault          bool     `json:"is_default"`
+	Confidential       bool     `json:"confidential"`
+	PermsClaim         string   `json:"perms_claim"`
+	PermsClaimMaxBytes int      `json:"perms_claim_max_bytes"`
+	Scopes             []string `json:"scopes"`
+
+	ClientSecretExpiresAt *time.Time `json:"client_secret_expires_at,omitempty"`
+}
+
+// Project is a project with its settings, hostnames and branding.
+type Project struct {
+	PublicID              string  `json:"public_id"`
+	Name                  string  `json:"name"`
+	Description           *string `json:"description,omitempty"`
+	Timezone              string  `json:"timezone"`
+	Environment           string  `json:"environment"`
+	IsDefault             bool    `json:"is_default"`
+	DefaultMemberRole     *string `json:"default_member_role,omitempty"`
+	AutoActivate          *bool   `json:"auto_activate,omitempty"`
+	ApiKeyMaxLifetimeDays *int    `json:"api_key_max_lifetime_days,omitempty"`
+	ApiKeyPolicyAction    string  `json:"api_key_policy_action"`
+	// ClientSecretMaxLifetimeDays is only enforced for the default project
+	ClientSecretMaxLifetimeDays *int              `json:"client_secret_max_lifetime_days,omitempty"`
+	Hostnames                   []ProjectHostname `json:"hostnames"`
+	Branding                    *ProjectBranding  `json:"branding,omitempty"`
+}
+
+// ProjectHostname is a custom hostname of a project. The default OAuth client
+// is referenced by public ID since database IDs differ between instances.
+type ProjectHostname struct {
+	PublicID             string  `json:"public_id"`
+	Hostname             string  `json:"hostname"`
+	BrandingName         *string `json:"branding_name,omitempty"`
+	LogoURL              *string `json:"logo_url,omitempty"`
+	PrimaryColor         *string `json:"primary_color,omitempty"`
+	DefaultOAuthClientID *string `json:"default_oauth_client_id,omitempty"`
+}
+
+// ProjectBranding is the branding of the auth pages of a project.
+type ProjectBranding struct {
+	PrimaryColor    *string         `json:"primary_color,omitempty"`
+	Logo            []byte          `json:"logo,omitempty"`
+	LogoContentType *string         `json:"logo_content_type,omitempty"`
+	FooterLinks     json.RawMessage `json:"footer_links"`
+}
+
+// ImportResult reports what Import restored.
+type ImportResult struct {
+	Providers int
+	Clients   int
+	Projects  int
+	// Notes lists what was restored differently from the archive
+	Notes []string
+}
@@ -0,0 +1,21 @@
+package digest
+
+import (
+	"context"
+	"time"
+
+	user_domain "github.com/hrz8/altalune/internal/domain/user"
+)
+
+type Repositor interface {
+	ListProjects(ctx context.Context) ([]*Project, error)
+	// GetSummary returns the activity of a project between from and to, with
+	// the state of its members and keys at now. Keys expiring before soon are
+	// expiring soon.
+	GetSummary(ctx context.Context, projectID int64, from, to, now, soon time.Time) (*Summary, error)
+}
+
+// ProjectOwnerRepositor defines the interface for looking up the owners of a project (the digest recipients).
+type ProjectOwnerRepositor interface {
+	GetProjectOwners(ctx context.Context, projectID int64) ([]*user_domain.ProjectOwner, error)
+}
@@ -0,0 +1,114 @@
+package digest
+
+import (
+	"context"
+	"time"
+
+	"github.com/hrz8/altalune"
+	api_key_domain "github.com/hrz8/altalune/internal/domain/api_key"
+	"github.com/hrz8/altalune/internal/shared/notification"
+	"github.com/hrz8/altalune/internal/shared/tz"
+)
+
+// JobName is the name the digest job is registered with on the scheduler
+const JobName = "digest.send"
+
+// Job emails the owners of every project a summary of its activity over the
+// last period, in the project's time zone.
+type Job struct {
+	repo         Repositor
+	owners       ProjectOwnerRepositor
+	notification *notification.NotificationService
+	frequency    Frequency
+	log          altalune.Logger
+}
+
+// NewJob creates a digest job sending digests at the given frequency.
+func NewJob(
+	repo Repositor,
+	owners ProjectOwnerRepositor,
+	notificationSvc *notification.NotificationService,
+	frequency Frequency,
+	log altalune.Logger,
+) *Job {
+	return &Job{
+		repo:         repo,
+		owners:       owners,
+		notification: notificationSvc,
+		frequency:    frequency,
+		log:          log,
+	}
+}
+
+// Run sends the digests of every project with something to report. A project
+// failing is logged and skipped so the others still get theirs.
+func (j *Job) Run(ctx context.Context) error {
+	projects, err := j.repo.ListProjects(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, project := range projects {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		j.sendProject(ctx, project, now)
+	}
+	return nil
+}
+
+func (j *Job) sendProject(ctx context.Context, project *Project, now time.Time) {
+	loc := tz.Load(project.Timezone)
+	now = now.In(loc)
+	from, to := j.frequency.Period(now, loc)
+
+	summary, err := j.repo.GetSummary(ctx, project.ID, from, to, now, api_key_domain.ExpiringSoonThreshold(now))
+	if err != nil {
+		j.log.Error("failed to build project digest", "error", err, "projectID", project.ID)
+		return
+	}
+	if summary.IsEmpty() {
+		return
+	}
+
+	owners, err := j.owners.GetProjectOwners(ctx, project.ID)
+	if err != nil {
+		j.log.Error("failed to get project owners for digest", "error", err, "projectID", project.ID)
+		return
+	}
+
+	data := emailData(project, summary, j.frequency, from, to, loc)
+	for _, owner := range owners {
+		data.OwnerName = owner.FirstName
+		if data.OwnerName == "" {
+			data.OwnerName = owner.Email
+		}
+		if err := j.notification.SendDigestEmail(ctx, owner.Email, data); err != nil {
+			j.log.Warn("failed to send digest email", "error", err, "projectID", project.ID)
+		}
+	}
+}
+
+// emailData renders a summary for the digest templates, times in loc
+func emailData(project *Project, summary *Summary, frequency Frequency, from, to time.Time, loc *time.Location) notification.DigestEmailData {
+	keys := make([]notification.DigestExpiringKey, len(summary.ExpiringKeys))
+	for i, key := range summary.ExpiringKeys {
+		keys[i] = notification.DigestExpiringKey{
+			Name:       key.Name,
+			Expiration: tz.Format(key.Expiration, loc),
+		}
+	}
+
+	return notification.DigestEmailData{
+		ProjectName:   project.Name,
+		Frequency:     string(frequency),
+		PeriodStart:   tz.Format(from, loc),
+		PeriodEnd:     tz.Format(to, loc),
+		NewMembers:    summary.NewMembers,
+		TokensIssued:  summary.TokensIssued,
+		FailedLogins:  summary.FailedLogins,
+		LockedMembers: summary.LockedMembers,
+		ExpiringKeys:  keys,
+	}
+}
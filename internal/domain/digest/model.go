@@ -0,0 +1,77 @@
+package digest
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hrz8/altalune/internal/shared/tz"
+)
+
+// Frequency is how often owners receive a digest, each covering the calendar
+// days since the previous one
+type Frequency string
+
+const (
+	FrequencyDaily  Frequency = "daily"
+	FrequencyWeekly Frequency = "weekly"
+)
+
+// ParseFrequency parses a configured frequency
+func ParseFrequency(value string) (Frequency, error) {
+	switch f := Frequency(value); f {
+	case FrequencyDaily, FrequencyWeekly:
+		return f, nil
+	default:
+		return "", fmt.Errorf("invalid digest frequency %q (must be one of: daily, weekly)", value)
+	}
+}
+
+// Spec returns the scheduler spec sending digests at this frequency
+func (f Frequency) Spec() string {
+	if f == FrequencyWeekly {
+		return "@weekly"
+	}
+	return "@daily"
+}
+
+// days returns how many calendar days a digest covers
+func (f Frequency) days() int {
+	if f == FrequencyWeekly {
+		return 7
+	}
+	return 1
+}
+
+// Period returns the calendar days a digest sent at now covers in loc: the
+// last full day, or the last seven, ending at the midnight starting today.
+func (f Frequency) Period(now time.Time, loc *time.Location) (from, to time.Time) {
+	return tz.AddDays(now, -f.days(), loc), tz.StartOfDay(now, loc)
+}
+
+// Project is a project digests are built for
+type Project struct {
+	ID       int64
+	Name     string
+	Timezone string
+}
+
+// ExpiringKey is an active API key of a project expiring soon
+type ExpiringKey struct {
+	Name       string
+	Expiration time.Time
+}
+
+// Summary is the activity of a project over the period of a digest
+type Summary struct {
+	NewMembers    int64         // Members added during the period
+	TokensIssued  int64         // Authorization code and refresh token grants to members during the period
+	FailedLogins  int64         // Failed sign-ins of members since their last successful one
+	LockedMembers int64         // Members locked out at the time of the digest
+	ExpiringKeys  []ExpiringKey // Active keys expiring soon, soonest first
+}
+
+// IsEmpty reports whether there is nothing to tell the owners about
+func (s *Summary) IsEmpty() bool {
+	return s.NewMembers == 0 && s.TokensIssued == 0 && s.FailedLogins == 0 &&
+		s.LockedMembers == 0 && len(s.ExpiringKeys) == 0
+}
@@ -0,0 +1,41 @@
+package digest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hrz8/altalune/internal/shared/tz"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFrequency(t *testing.T) {
+	f, err := ParseFrequency("weekly")
+	require.NoError(t, err)
+	assert.Equal(t, FrequencyWeekly, f)
+	assert.Equal(t, "@weekly", f.Spec())
+	assert.Equal(t, "@daily", FrequencyDaily.Spec())
+
+	_, err = ParseFrequency("hourly")
+	assert.Error(t, err)
+}
+
+func TestPeriod(t *testing.T) {
+	jakarta := tz.Load("Asia/Jakarta")
+	// 20:00 UTC on 1 March is 03:00 on 2 March in Jakarta (UTC+7)
+	now := time.Date(2026, 3, 1, 20, 0, 0, 0, time.UTC)
+
+	from, to := FrequencyDaily.Period(now, jakarta)
+	assert.True(t, time.Date(2026, 3, 1, 0, 0, 0, 0, jakarta).Equal(from))
+	assert.True(t, time.Date(2026, 3, 2, 0, 0, 0, 0, jakarta).Equal(to))
+
+	from, to = FrequencyWeekly.Period(now, time.UTC)
+	assert.True(t, time.Date(2026, 2, 22, 0, 0, 0, 0, time.UTC).Equal(from))
+	assert.True(t, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC).Equal(to))
+}
+
+func TestSummaryIsEmpty(t *testing.T) {
+	assert.True(t, (&Summary{}).IsEmpty())
+	assert.False(t, (&Summary{FailedLogins: 1}).IsEmpty())
+	assert.False(t, (&Summary{ExpiringKeys: []ExpiringKey{{Name: "k"}}}).IsEmpty())
+}
@@ -0,0 +1,121 @@
+package digest
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hrz8/altalune/internal/postgres"
+)
+
+type Repo struct {
+	db postgres.DB
+}
+
+func NewRepo(db postgres.DB) *Repo {
+	return &Repo{
+		db: db,
+	}
+}
+
+// ListProjects returns every project, oldest first
+func (r *Repo) ListProjects(ctx context.Context) ([]*Project, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT id, name, timezone FROM altalune_projects ORDER BY id`)
+	if err != nil {
+		return nil, fmt.Errorf("list projects: %w", err)
+	}
+	defer rows.Close()
+
+	projects := make([]*Project, 0)
+	for rows.Next() {
+		var project Project
+		if err := rows.Scan(&project.ID, &project.Name, &project.Timezone); err != nil {
+			return nil, fmt.Errorf("scan project: %w", err)
+		}
+		projects = append(projects, &project)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate projects: %w", err)
+	}
+
+	return projects, nil
+}
+
+// GetSummary returns the activity of a project over a period. OAuth clients
+// and tokens are not tied to a project, so the tokens counted are the ones
+// granted to its members.
+func (r *Repo) GetSummary(ctx context.Context, projectID int64, from, to, now, soon time.Time) (*Summary, error) {
+	query := `
+		WITH members AS (
+			SELECT m.user_id, m.created_at, u.failed_login_attempts, u.locked_until
+			FROM altalune_project_members m
+			JOIN altalune_users u ON u.id = m.user_id
+			WHERE m.project_id = $1 AND u.deleted_at IS NULL
+		)
+		SELECT
+			(SELECT COUNT(*) FROM members WHERE created_at >= $2 AND created_at < $3),
+			(SELECT COUNT(*) FROM altalune_oauth_authorization_codes c
+			 WHERE c.user_id IN (SELECT user_id FROM members)
+			   AND c.exchange_at >= $2 AND c.exchange_at < $3)
+			+ (SELECT COUNT(*) FROM altalune_oauth_refresh_tokens t
+			   WHERE t.user_id IN (SELECT user_id FROM members)
+			     AND t.exchange_at >= $2 AND t.exchange_at < $3),
+			(SELECT COALESCE(SUM(failed_login_attempts), 0) FROM members),
+			(SELECT COUNT(*) FROM members WHERE locked_until > $4)
+	`
+
+	var summary Summary
+	err := r.db.QueryRowContext(ctx, query, projectID, from, to, now).Scan(
+		&summary.NewMembers,
+		&summary.TokensIssued,
+		&summary.FailedLogins,
+		&summary.LockedMembers,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get project activity: %w", err)
+	}
+
+	keys, err := r.getExpiringKeys(ctx, projectID, now, soon)
+	if err != nil {
+		return nil, err
+	}
+	summary.ExpiringKeys = keys
+
+	return &summary, nil
+}
+
+func (r *Repo) getExpiringKeys(ctx context.Context, projectID int64, now, soon time.Time) ([]ExpiringKey, error) {
+	query := `
+		SELECT name, expiration
+		FROM altalune_project_api_keys
+		WHERE project_id = $1 AND active AND deleted_at IS NULL
+		  AND expiration > $2 AND expiration < $3
+		ORDER BY expiration, name
+	`
+
+	keys := make([]ExpiringKey, 0)
+	err := postgres.WithProject(ctx, r.db, projectID, func(ctx context.Context) error {
+		rows, err := r.db.QueryContext(ctx, query, projectID, now, soon)
+		if err != nil {
+			return fmt.Errorf("get expiring api keys: %w", err)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var key ExpiringKey
+			if err := rows.Scan(&key.Name, &key.Expiration); err != nil {
+				return fmt.Errorf("scan expiring api key: %w", err)
+			}
+			keys = append(keys, key)
+		}
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("iterate expiring api keys: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
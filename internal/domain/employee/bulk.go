@@ -0,0 +1,243 @@
+package employee
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"buf.build/go/protovalidate"
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+)
+
+const (
+	maxImportFileSize  = 10 << 20 // 10 MiB
+	maxImportRows      = 10000
+	maxImportRowErrors = 100
+	exportChunkSize    = 64 << 10 // 64 KiB
+)
+
+var (
+	errImportFileTooLarge = fmt.Errorf("file exceeds %d MiB", maxImportFileSize>>20)
+	errImportTooManyRows  = fmt.Errorf("file exceeds %d rows", maxImportRows)
+)
+
+// importColumns are the CSV columns read by the import, required ones first.
+var importColumns = []string{"name", "email", "role", "department", "status"}
+
+const requiredImportColumns = 4
+
+// importRow is a CSV row that passed validation.
+type importRow struct {
+	line  int
+	input *CreateEmployeeInput
+}
+
+// importReport collects the outcome of parsing an import file.
+type importReport struct {
+	totalRows   int
+	invalidRows int
+	errors      []*altalunev1.ImportEmployeesRowError
+	rows        []importRow
+	lastLine    int // Line of the last row with an error, to count each row once
+}
+
+func (r *importReport) addError(line int, field, message string) {
+	if r.lastLine != line {
+		r.invalidRows++
+		r.lastLine = line
+	}
+	if len(r.errors) < maxImportRowErrors {
+		r.errors = append(r.errors, &altalunev1.ImportEmployeesRowError{
+			Line:    int32(line),
+			Field:   field,
+			Message: message,
+		})
+	}
+}
+
+// parseImportCSV reads and validates an import file. Each row is validated
+// with the CreateEmployee rules, so imported employees are held to the same
+// constraints as employees created one by one. Errors of individual rows are
+// collected in the report; the returned error is for unreadable files.
+func parseImportCSV(r io.Reader, projectPublicID string, v protovalidate.Validator) (*importReport, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("file is empty")
+		}
+		return nil, err
+	}
+
+	columnIndex := make(map[string]int)
+	for i, column := range header {
+		if i == 0 {
+			column = strings.TrimPrefix(column, "\ufeff") // UTF-8 BOM written by spreadsheet apps
+		}
+		columnIndex[strings.ToLower(strings.TrimSpace(column))] = i
+	}
+
+	var missing []string
+	for _, column := range importColumns[:requiredImportColumns] {
+		if _, ok := columnIndex[column]; !ok {
+			missing = append(missing, column)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("missing required column(s): %s", strings.Join(missing, ", "))
+	}
+
+	report := &importReport{}
+	seenEmails := make(map[string]int)
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+		report.totalRows++
+		if report.totalRows > maxImportRows {
+			return nil, errImportTooManyRows
+		}
+
+		value := func(column string) string {
+			i, ok := columnIndex[column]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		req := &altalunev1.CreateEmployeeRequest{
+			ProjectId:  projectPublicID,
+			Name:       value("name"),
+			Email:      value("email"),
+			Role:       value("role"),
+			Department: value("department"),
+			Status:     altalunev1.EmployeeStatus_EMPLOYEE_STATUS_ACTIVE,
+		}
+
+		valid := true
+		switch strings.ToLower(value("status")) {
+		case "", string(EmployeeStatusActive):
+		case string(EmployeeStatusInactive):
+			req.Status = altalunev1.EmployeeStatus_EMPLOYEE_STATUS_INACTIVE
+		default:
+			report.addError(line, "status", "must be active or inactive")
+			valid = false
+		}
+
+		if err := v.Validate(req); err != nil {
+			var validationErr *protovalidate.ValidationError
+			if !errors.As(err, &validationErr) {
+				return nil, err
+			}
+			for _, violation := range validationErr.Violations {
+				report.addError(line, protovalidate.FieldPathString(violation.Proto.GetField()), violation.Proto.GetMessage())
+			}
+			valid = false
+		}
+
+		email := strings.ToLower(req.Email)
+		if firstLine, ok := seenEmails[email]; ok && email != "" {
+			report.addError(line, "email", fmt.Sprintf("duplicates the email on line %d", firstLine))
+			valid = false
+		} else {
+			seenEmails[email] = line
+		}
+
+		if valid {
+			report.rows = append(report.rows, importRow{
+				line: line,
+				input: &CreateEmployeeInput{
+					Name:       req.Name,
+					Email:      req.Email,
+					Role:       req.Role,
+					Department: req.Department,
+					Status:     EmployeeStatusFromProto(req.Status),
+				},
+			})
+		}
+	}
+
+	return report, nil
+}
+
+// chunkReader reads a file uploaded as the chunk field of a client stream.
+// next returns the following chunk, or io.EOF once the stream is drained.
+type chunkReader struct {
+	next func() ([]byte, error)
+	buf  []byte
+	read int64
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		chunk, err := r.next()
+		if err != nil {
+			return 0, err
+		}
+		r.read += int64(len(chunk))
+		if r.read > maxImportFileSize {
+			return 0, errImportFileTooLarge
+		}
+		r.buf = chunk
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// chunkWriter sends a streamed file as ExportEmployeesResponse chunks. The
+// first message also carries the filename and content type.
+type chunkWriter struct {
+	send        func(*altalunev1.ExportEmployeesResponse) error
+	filename    string
+	contentType string
+	sent        bool
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		n := min(len(p), exportChunkSize)
+		msg := &altalunev1.ExportEmployeesResponse{
+			Chunk: p[:n],
+		}
+		if !w.sent {
+			msg.Filename = w.filename
+			msg.ContentType = w.contentType
+			w.sent = true
+		}
+		if err := w.send(msg); err != nil {
+			return written, err
+		}
+		written += n
+		p = p[n:]
+	}
+	return written, nil
+}
+
+// flush sends the metadata message if nothing was written yet, so empty
+// exports still tell the client what they received.
+func (w *chunkWriter) flush() error {
+	if w.sent {
+		return nil
+	}
+	w.sent = true
+	return w.send(&altalunev1.ExportEmployeesResponse{
+		Filename:    w.filename,
+		ContentType: w.contentType,
+	})
+}
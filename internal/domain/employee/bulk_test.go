@@ -0,0 +1,52 @@
+package employee
+
+import (
+	"strings"
+	"testing"
+
+	"buf.build/go/protovalidate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testProjectID = "abcdefghjkmnpq"
+
+func TestParseImportCSV(t *testing.T) {
+	v, err := protovalidate.New()
+	require.NoError(t, err)
+
+	file := "\ufeffName,Email,Role,Department,Status,Notes\n" +
+		"Jane Doe,jane@example.com,Engineer,Platform,active,ignored\n" +
+		"John Smith,john@example.com,Designer,Product,,\n" +
+		"Bad 123,not-an-email,Engineer,Platform,retired,\n" +
+		"Jane Again,JANE@example.com,Engineer,Platform,inactive,\n"
+
+	report, err := parseImportCSV(strings.NewReader(file), testProjectID, v)
+	require.NoError(t, err)
+
+	assert.Equal(t, 4, report.totalRows)
+	assert.Equal(t, 2, report.invalidRows)
+	require.Len(t, report.rows, 2)
+	assert.Equal(t, 2, report.rows[0].line)
+	assert.Equal(t, "jane@example.com", report.rows[0].input.Email)
+	assert.Equal(t, EmployeeStatusActive, report.rows[1].input.Status)
+
+	fields := make([]string, 0, len(report.errors))
+	for _, e := range report.errors {
+		fields = append(fields, e.Field)
+	}
+	assert.ElementsMatch(t, []string{"status", "name", "email", "email"}, fields)
+	assert.EqualValues(t, 5, report.errors[len(report.errors)-1].Line)
+	assert.Contains(t, report.errors[len(report.errors)-1].Message, "line 2")
+}
+
+func TestParseImportCSVMissingColumns(t *testing.T) {
+	v, err := protovalidate.New()
+	require.NoError(t, err)
+
+	_, err = parseImportCSV(strings.NewReader("name,email\nJane,jane@example.com\n"), testProjectID, v)
+	assert.EqualError(t, err, "missing required column(s): role, department")
+
+	_, err = parseImportCSV(strings.NewReader(""), testProjectID, v)
+	assert.Error(t, err)
+}
@@ -3,6 +3,7 @@ package employee
 import "errors"
 
 var (
-	ErrEmployeeNotFound      = errors.New("employee not found")
-	ErrEmployeeAlreadyExists = errors.New("employee with this email already exists")
+	ErrEmployeeNotFound        = errors.New("employee not found")
+	ErrEmployeeVersionConflict = errors.New("employee was modified concurrently")
+	ErrEmployeeAlreadyExists   = errors.New("employee with this email already exists")
 )
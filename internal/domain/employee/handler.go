@@ -2,6 +2,7 @@ package employee
 
 import (
 	"context"
+	"io"
 
 	"connectrpc.com/connect"
 	"github.com/hrz8/altalune"
@@ -10,11 +11,11 @@ import (
 )
 
 type Handler struct {
-	svc  altalunev1.EmployeeServiceServer
+	svc  *Service
 	auth *auth.Authorizer
 }
 
-func NewHandler(svc altalunev1.EmployeeServiceServer, authorizer *auth.Authorizer) *Handler {
+func NewHandler(svc *Service, authorizer *auth.Authorizer) *Handler {
 	return &Handler{svc: svc, auth: authorizer}
 }
 
@@ -31,9 +32,30 @@ func (h *Handler) QueryEmployees(
 	if err != nil {
 		return nil, altalune.ToConnectError(err)
 	}
+	h.auth.Redact(ctx, response)
 	return connect.NewResponse(response), nil
 }
 
+func (h *Handler) StreamEmployees(
+	ctx context.Context,
+	req *connect.Request[altalunev1.StreamEmployeesRequest],
+	stream *connect.ServerStream[altalunev1.StreamEmployeesResponse],
+) error {
+	// Authorization: requires employee:read permission and project membership
+	if err := h.auth.CheckProjectAccess(ctx, "employee:read", req.Msg.ProjectId); err != nil {
+		return err
+	}
+
+	send := func(msg *altalunev1.StreamEmployeesResponse) error {
+		h.auth.Redact(ctx, msg)
+		return stream.Send(msg)
+	}
+	if err := h.svc.StreamEmployeesTo(ctx, req.Msg, send); err != nil {
+		return altalune.ToConnectError(err)
+	}
+	return nil
+}
+
 func (h *Handler) CreateEmployee(
 	ctx context.Context,
 	req *connect.Request[altalunev1.CreateEmployeeRequest],
@@ -47,6 +69,7 @@ func (h *Handler) CreateEmployee(
 	if err != nil {
 		return nil, altalune.ToConnectError(err)
 	}
+	h.auth.Redact(ctx, response)
 	return connect.NewResponse(response), nil
 }
 
@@ -63,6 +86,7 @@ func (h *Handler) GetEmployee(
 	if err != nil {
 		return nil, altalune.ToConnectError(err)
 	}
+	h.auth.Redact(ctx, response)
 	return connect.NewResponse(response), nil
 }
 
@@ -79,6 +103,7 @@ func (h *Handler) UpdateEmployee(
 	if err != nil {
 		return nil, altalune.ToConnectError(err)
 	}
+	h.auth.Redact(ctx, response)
 	return connect.NewResponse(response), nil
 }
 
@@ -97,3 +122,72 @@ func (h *Handler) DeleteEmployee(
 	}
 	return connect.NewResponse(response), nil
 }
+
+func (h *Handler) RestoreEmployee(
+	ctx context.Context,
+	req *connect.Request[altalunev1.RestoreEmployeeRequest],
+) (*connect.Response[altalunev1.RestoreEmployeeResponse], error) {
+	// Authorization: requires employee:delete permission and project membership
+	if err := h.auth.CheckProjectAccess(ctx, "employee:delete", req.Msg.ProjectId); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.RestoreEmployee(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	h.auth.Redact(ctx, response)
+	return connect.NewResponse(response), nil
+}
+
+func (h *Handler) ImportEmployees(
+	ctx context.Context,
+	stream *connect.ClientStream[altalunev1.ImportEmployeesRequest],
+) (*connect.Response[altalunev1.ImportEmployeesResponse], error) {
+	if !stream.Receive() {
+		if err := stream.Err(); err != nil {
+			return nil, err
+		}
+		return nil, altalune.ToConnectError(altalune.NewInvalidPayloadError("metadata message is required"))
+	}
+	first := stream.Msg()
+
+	// Authorization: requires employee:write permission and project membership
+	if err := h.auth.CheckProjectAccess(ctx, "employee:write", first.GetMetadata().GetProjectId()); err != nil {
+		return nil, err
+	}
+
+	recv := func() (*altalunev1.ImportEmployeesRequest, error) {
+		if stream.Receive() {
+			return stream.Msg(), nil
+		}
+		if err := stream.Err(); err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+
+	response, err := h.svc.ImportEmployeesFrom(ctx, first, recv)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (h *Handler) ExportEmployees(
+	ctx context.Context,
+	req *connect.Request[altalunev1.ExportEmployeesRequest],
+	stream *connect.ServerStream[altalunev1.ExportEmployeesResponse],
+) error {
+	// Authorization: requires employee:read permission and project membership
+	if err := h.auth.CheckProjectAccess(ctx, "employee:read", req.Msg.ProjectId); err != nil {
+		return err
+	}
+
+	// Exports are not protobuf messages, the columns are redacted in the query
+	redacted := h.auth.RedactedFields(ctx, (&altalunev1.Employee{}).ProtoReflect().Descriptor())
+	if err := h.svc.ExportEmployeesTo(ctx, req.Msg, redacted, stream.Send); err != nil {
+		return altalune.ToConnectError(err)
+	}
+	return nil
+}
@@ -2,6 +2,8 @@ package employee
 
 import (
 	"context"
+	"io"
+	"time"
 
 	"github.com/hrz8/altalune/internal/shared/query"
 )
@@ -13,4 +15,9 @@ type Repositor interface {
 	GetByID(ctx context.Context, projectID int64, publicID string) (*Employee, error)
 	Update(ctx context.Context, input *UpdateEmployeeInput) (*UpdateEmployeeResult, error)
 	Delete(ctx context.Context, input *DeleteEmployeeInput) error
+	Restore(ctx context.Context, input *RestoreEmployeeInput) (*Employee, error)
+	PurgeDeleted(ctx context.Context, before time.Time) (int64, error)
+	FindExistingEmails(ctx context.Context, projectID int64, emails []string) ([]string, error)
+	BulkCreate(ctx context.Context, inputs []*CreateEmployeeInput) (int64, error)
+	Export(ctx context.Context, projectID int64, redacted []string, w io.Writer) error
 }
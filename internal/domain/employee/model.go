@@ -48,6 +48,7 @@ type EmployeeQueryResult struct {
 	Status     EmployeeStatus
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
+	DeletedAt  *time.Time
 }
 
 func (r *EmployeeQueryResult) ToEmployee() *Employee {
@@ -60,6 +61,7 @@ func (r *EmployeeQueryResult) ToEmployee() *Employee {
 		Status:     r.Status,
 		CreatedAt:  r.CreatedAt,
 		UpdatedAt:  r.UpdatedAt,
+		DeletedAt:  r.DeletedAt,
 	}
 }
 
@@ -72,6 +74,7 @@ type Employee struct {
 	Status     EmployeeStatus
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
+	DeletedAt  *time.Time // Set only for trashed employees
 }
 
 func (m *Employee) ToEmployeeProto() *altalunev1.Employee {
@@ -85,7 +88,7 @@ func (m *Employee) ToEmployeeProto() *altalunev1.Employee {
 		status = altalunev1.EmployeeStatus_EMPLOYEE_STATUS_UNSPECIFIED
 	}
 
-	return &altalunev1.Employee{
+	employee := &altalunev1.Employee{
 		Id:         m.ID,
 		Name:       m.Name,
 		Email:      m.Email,
@@ -95,6 +98,10 @@ func (m *Employee) ToEmployeeProto() *altalunev1.Employee {
 		CreatedAt:  timestamppb.New(m.CreatedAt),
 		UpdatedAt:  timestamppb.New(m.UpdatedAt),
 	}
+	if m.DeletedAt != nil {
+		employee.DeletedAt = timestamppb.New(*m.DeletedAt)
+	}
+	return employee
 }
 
 type CreateEmployeeInput struct {
@@ -119,13 +126,14 @@ type CreateEmployeeResult struct {
 }
 
 type UpdateEmployeeInput struct {
-	ProjectID  int64
-	PublicID   string // Employee's public ID
-	Name       string
-	Email      string
-	Role       string
-	Department string
-	Status     EmployeeStatus
+	ProjectID         int64
+	PublicID          string // Employee's public ID
+	Name              string
+	Email             string
+	Role              string
+	Department        string
+	Status            EmployeeStatus
+	ExpectedUpdatedAt *time.Time // Reject the update unless updated_at still matches; nil to skip
 }
 
 type UpdateEmployeeResult struct {
@@ -144,3 +152,8 @@ type DeleteEmployeeInput struct {
 	ProjectID int64
 	PublicID  string // Employee's public ID
 }
+
+type RestoreEmployeeInput struct {
+	ProjectID int64
+	PublicID  string // Employee's public ID
+}
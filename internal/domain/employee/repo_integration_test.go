@@ -0,0 +1,67 @@
+package employee_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hrz8/altalune/internal/domain/employee"
+	"github.com/hrz8/altalune/internal/testdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) { testdb.Main(m) }
+
+func TestRepoUpdateConflict(t *testing.T) {
+	ctx := context.Background()
+	db := testdb.Tx(t)
+	fixtures := testdb.Seed(t, db)
+	repo := employee.NewRepo(db)
+
+	created, err := repo.Create(ctx, &employee.CreateEmployeeInput{
+		ProjectID:  fixtures.ProjectID,
+		Name:       "Conflict",
+		Email:      "conflict-" + fixtures.ProjectPublicID + "@example.com",
+		Role:       "Engineer",
+		Department: "Platform",
+		Status:     employee.EmployeeStatusActive,
+	})
+	require.NoError(t, err)
+
+	update := func(department string, expectedUpdatedAt time.Time) (*employee.UpdateEmployeeResult, error) {
+		return repo.Update(ctx, &employee.UpdateEmployeeInput{
+			ProjectID:         fixtures.ProjectID,
+			PublicID:          created.PublicID,
+			Name:              created.Name,
+			Email:             created.Email,
+			Role:              created.Role,
+			Department:        department,
+			Status:            employee.EmployeeStatusActive,
+			ExpectedUpdatedAt: &expectedUpdatedAt,
+		})
+	}
+
+	_, err = update("Stale", created.UpdatedAt.Add(-time.Second))
+	assert.ErrorIs(t, err, employee.ErrEmployeeVersionConflict)
+
+	byID, err := repo.GetByID(ctx, fixtures.ProjectID, created.PublicID)
+	require.NoError(t, err)
+	assert.Equal(t, "Platform", byID.Department, "a conflicting update changes nothing")
+
+	updated, err := update("Product", created.UpdatedAt)
+	require.NoError(t, err)
+	assert.Equal(t, "Product", updated.Department)
+
+	_, err = update("Again", created.UpdatedAt)
+	assert.ErrorIs(t, err, employee.ErrEmployeeVersionConflict, "a version is only updated once")
+
+	_, err = repo.Update(ctx, &employee.UpdateEmployeeInput{
+		ProjectID:         fixtures.ProjectID,
+		PublicID:          "unknown",
+		Name:              "Unknown",
+		Email:             "unknown-" + fixtures.ProjectPublicID + "@example.com",
+		ExpectedUpdatedAt: &updated.UpdatedAt,
+	})
+	assert.ErrorIs(t, err, employee.ErrEmployeeNotFound, "a missing employee is not a conflict")
+}
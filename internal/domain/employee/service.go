@@ -1,7 +1,14 @@
 package employee
 
 import (
+	"bufio"
 	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
 	"time"
 
 	"buf.build/go/protovalidate"
@@ -9,6 +16,7 @@ import (
 	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
 	project_domain "github.com/hrz8/altalune/internal/domain/project"
 	"github.com/hrz8/altalune/internal/shared/query"
+	"github.com/hrz8/altalune/internal/shared/xlsx"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -54,6 +62,15 @@ func (s *Service) QueryEmployees(ctx context.Context, req *altalunev1.QueryEmplo
 
 	// Convert proto request to domain query params
 	queryParams := query.DefaultQueryParams(req.Query)
+	queryParams.Trashed = req.Trashed
+	queryParams.Fields, err = query.ReadMaskFields(req.ReadMask, &altalunev1.Employee{})
+	if err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	if err := queryParams.ApplyCursor(req.Query.GetCursor()); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
 
 	// Query employees from repository
 	result, err := s.employeeRepo.Query(ctx, projectID, queryParams)
@@ -71,23 +88,96 @@ func (s *Service) QueryEmployees(ctx context.Context, req *altalunev1.QueryEmplo
 		return &altalunev1.QueryEmployeesResponse{
 			Data: []*altalunev1.Employee{},
 			Meta: &altalunev1.QueryMetaResponse{
-				RowCount:  0,
-				PageCount: 0,
-				Filters:   make(map[string]*altalunev1.FilterValues),
+				RowCount:   0,
+				PageCount:  0,
+				ExactTotal: true,
+				Filters:    make(map[string]*altalunev1.FilterValues),
 			},
 		}, nil
 	}
 
 	return &altalunev1.QueryEmployeesResponse{
-		Data: mapEmployeesToProto(result.Data),
+		Data: query.PruneAll(mapEmployeesToProto(result.Data), queryParams.Fields),
 		Meta: &altalunev1.QueryMetaResponse{
-			RowCount:  result.TotalRows,
-			PageCount: result.TotalPages,
-			Filters:   mapFiltersToProto(result.Filters),
+			RowCount:    result.TotalRows,
+			PageCount:   result.TotalPages,
+			Filters:     mapFiltersToProto(result.Filters),
+			CountMode:   query.CountModeToProto(result.Count),
+			HasNextPage: result.HasMore,
+			NextCursor:  query.NextCursor(queryParams, result),
+			ExactTotal:  result.ExactTotal(),
 		},
 	}, nil
 }
 
+// StreamEmployees implements the gRPC server stream of StreamEmployeesTo.
+func (s *Service) StreamEmployees(req *altalunev1.StreamEmployeesRequest, stream altalunev1.EmployeeService_StreamEmployeesServer) error {
+	return s.StreamEmployeesTo(stream.Context(), req, stream.Send)
+}
+
+// StreamEmployeesTo sends every employee matching the query through send, one
+// page per message.
+func (s *Service) StreamEmployeesTo(
+	ctx context.Context,
+	req *altalunev1.StreamEmployeesRequest,
+	send func(*altalunev1.StreamEmployeesResponse) error,
+) error {
+	// Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	// Extract project ID
+	projectID, err := s.projectRepo.GetIDByPublicID(ctx, req.ProjectId)
+	if err != nil {
+		if err == project_domain.ErrProjectNotFound {
+			return altalune.NewProjectNotFound(req.ProjectId)
+		}
+		return altalune.NewInvalidPayloadError("invalid project_id")
+	}
+
+	queryParams := query.DefaultQueryParams(req.Query)
+	queryParams.Fields, err = query.ReadMaskFields(req.ReadMask, &altalunev1.Employee{})
+	if err != nil {
+		return altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	if err := queryParams.ApplyCursor(req.Query.GetCursor()); err != nil {
+		return altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	return query.StreamPages(queryParams,
+		func(params *query.QueryParams) (*query.QueryResult[Employee], error) {
+			result, err := s.employeeRepo.Query(ctx, projectID, params)
+			if err != nil {
+				s.log.Error("failed to stream employees",
+					"error", err,
+					"project_id", projectID,
+					"page", params.Pagination.Page,
+				)
+				return nil, altalune.NewUnexpectedError("failed to stream employees: %w", err)
+			}
+			return result, nil
+		},
+		func(result *query.QueryResult[Employee], first bool) error {
+			response := &altalunev1.StreamEmployeesResponse{
+				Data: query.PruneAll(mapEmployeesToProto(result.Data), queryParams.Fields),
+			}
+			if first {
+				response.Meta = &altalunev1.QueryMetaResponse{
+					RowCount:    result.TotalRows,
+					PageCount:   result.TotalPages,
+					Filters:     mapFiltersToProto(result.Filters),
+					CountMode:   query.CountModeToProto(result.Count),
+					HasNextPage: result.HasMore,
+					ExactTotal:  result.ExactTotal(),
+				}
+			}
+			return send(response)
+		},
+	)
+}
+
 func (s *Service) CreateEmployee(ctx context.Context, req *altalunev1.CreateEmployeeRequest) (*altalunev1.CreateEmployeeResponse, error) {
 	// Validate request
 	if err := s.validator.Validate(req); err != nil {
@@ -217,39 +307,53 @@ func (s *Service) UpdateEmployee(ctx context.Context, req *altalunev1.UpdateEmpl
 		return nil, altalune.NewUnexpectedError("failed to check employee existence: %w", err)
 	}
 
+	// An empty email keeps the current one
+	email := req.Email
+	if email == "" {
+		email = existingEmployee.Email
+	}
+
 	// Check email uniqueness (exclude current employee)
-	if req.Email != existingEmployee.Email {
-		emailEmployee, err := s.employeeRepo.GetByEmail(ctx, projectID, req.Email)
+	if email != existingEmployee.Email {
+		emailEmployee, err := s.employeeRepo.GetByEmail(ctx, projectID, email)
 		if err != nil && err != ErrEmployeeNotFound {
 			s.log.Error("failed to check email uniqueness",
 				"error", err,
 				"project_id", projectID,
-				"email", req.Email,
+				"email", email,
 			)
 			return nil, altalune.NewUnexpectedError("failed to check email uniqueness: %w", err)
 		}
 		if emailEmployee != nil && emailEmployee.ID != existingEmployee.ID {
-			return nil, altalune.NewAlreadyExistsError(req.Email)
+			return nil, altalune.NewAlreadyExistsError(email)
 		}
 	}
 
 	// Update employee
-	result, err := s.employeeRepo.Update(ctx, &UpdateEmployeeInput{
+	input := &UpdateEmployeeInput{
 		ProjectID:  projectID,
 		PublicID:   req.EmployeeId,
 		Name:       req.Name,
-		Email:      req.Email,
+		Email:      email,
 		Role:       req.Role,
 		Department: req.Department,
 		Status:     EmployeeStatusFromProto(req.Status),
-	})
+	}
+	if req.ExpectedUpdatedAt != nil {
+		expectedUpdatedAt := req.ExpectedUpdatedAt.AsTime()
+		input.ExpectedUpdatedAt = &expectedUpdatedAt
+	}
+	result, err := s.employeeRepo.Update(ctx, input)
 
 	if err != nil {
 		if err == ErrEmployeeNotFound {
 			return nil, altalune.NewEmployeeNotFoundError(req.EmployeeId)
 		}
+		if err == ErrEmployeeVersionConflict {
+			return nil, altalune.NewVersionConflictError("employee", req.EmployeeId)
+		}
 		if err == ErrEmployeeAlreadyExists {
-			return nil, altalune.NewAlreadyExistsError(req.Email)
+			return nil, altalune.NewAlreadyExistsError(email)
 		}
 		s.log.Error("failed to update employee",
 			"error", err,
@@ -311,3 +415,287 @@ func (s *Service) DeleteEmployee(ctx context.Context, req *altalunev1.DeleteEmpl
 		Message: "Employee deleted successfully",
 	}, nil
 }
+
+func (s *Service) RestoreEmployee(ctx context.Context, req *altalunev1.RestoreEmployeeRequest) (*altalunev1.RestoreEmployeeResponse, error) {
+	// Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	// Extract and validate project ID
+	projectID, err := s.projectRepo.GetIDByPublicID(ctx, req.ProjectId)
+	if err != nil {
+		if err == project_domain.ErrProjectNotFound {
+			return nil, altalune.NewProjectNotFound(req.ProjectId)
+		}
+		return nil, altalune.NewInvalidPayloadError("invalid project_id")
+	}
+
+	// Restore employee from the trash
+	employee, err := s.employeeRepo.Restore(ctx, &RestoreEmployeeInput{
+		ProjectID: projectID,
+		PublicID:  req.EmployeeId,
+	})
+	if err != nil {
+		if err == ErrEmployeeNotFound {
+			return nil, altalune.NewEmployeeNotFoundError(req.EmployeeId)
+		}
+		s.log.Error("failed to restore employee",
+			"error", err,
+			"project_id", projectID,
+			"employee_id", req.EmployeeId,
+		)
+		return nil, altalune.NewUnexpectedError("failed to restore employee: %w", err)
+	}
+
+	return &altalunev1.RestoreEmployeeResponse{
+		Employee: employee.ToEmployeeProto(),
+		Message:  "Employee restored successfully",
+	}, nil
+}
+
+// ImportEmployees implements the gRPC client stream of ImportEmployeesFrom.
+func (s *Service) ImportEmployees(stream altalunev1.EmployeeService_ImportEmployeesServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return altalune.NewInvalidPayloadError("metadata message is required")
+		}
+		return err
+	}
+
+	response, err := s.ImportEmployeesFrom(stream.Context(), first, stream.Recv)
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(response)
+}
+
+// ImportEmployeesFrom imports employees from a CSV file uploaded as a client
+// stream. first is the metadata message; recv returns the messages carrying
+// the file and io.EOF once the stream is drained. Nothing is imported unless
+// every row is valid.
+func (s *Service) ImportEmployeesFrom(
+	ctx context.Context,
+	first *altalunev1.ImportEmployeesRequest,
+	recv func() (*altalunev1.ImportEmployeesRequest, error),
+) (*altalunev1.ImportEmployeesResponse, error) {
+	// Validate request
+	if err := s.validator.Validate(first); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+	metadata := first.GetMetadata()
+	if metadata == nil {
+		return nil, altalune.NewInvalidPayloadError("the first message must carry the metadata")
+	}
+
+	projectID, err := s.projectRepo.GetIDByPublicID(ctx, metadata.ProjectId)
+	if err != nil {
+		if err == project_domain.ErrProjectNotFound {
+			return nil, altalune.NewProjectNotFound(metadata.ProjectId)
+		}
+		return nil, altalune.NewInvalidPayloadError("invalid project_id")
+	}
+
+	// Stream failures are kept apart from errors in the file itself
+	var streamErr error
+	file := &chunkReader{
+		next: func() ([]byte, error) {
+			msg, err := recv()
+			if err != nil {
+				if !errors.Is(err, io.EOF) {
+					streamErr = err
+				}
+				return nil, err
+			}
+			if err := s.validator.Validate(msg); err != nil {
+				return nil, err
+			}
+			if msg.GetMetadata() != nil {
+				return nil, errors.New("metadata must only be sent in the first message")
+			}
+			return msg.GetChunk(), nil
+		},
+	}
+
+	report, err := parseImportCSV(file, metadata.ProjectId, s.validator)
+	if streamErr != nil {
+		return nil, streamErr
+	}
+	if err != nil {
+		return nil, altalune.NewInvalidPayloadError(fmt.Sprintf("invalid import file: %s", err.Error()))
+	}
+
+	if len(report.rows) > 0 {
+		emails := make([]string, 0, len(report.rows))
+		for _, row := range report.rows {
+			emails = append(emails, strings.ToLower(row.input.Email))
+		}
+		existing, err := s.employeeRepo.FindExistingEmails(ctx, projectID, emails)
+		if err != nil {
+			s.log.Error("failed to check existing employee emails",
+				"error", err,
+				"project_id", projectID,
+			)
+			return nil, altalune.NewUnexpectedError("failed to import employees: %w", err)
+		}
+
+		existingEmails := make(map[string]bool, len(existing))
+		for _, email := range existing {
+			existingEmails[email] = true
+		}
+		for _, row := range report.rows {
+			if existingEmails[strings.ToLower(row.input.Email)] {
+				report.addError(row.line, "email", "an employee with this email already exists")
+			}
+		}
+		sort.SliceStable(report.errors, func(i, j int) bool {
+			return report.errors[i].Line < report.errors[j].Line
+		})
+	}
+
+	response := &altalunev1.ImportEmployeesResponse{
+		TotalRows:   int32(report.totalRows),
+		InvalidRows: int32(report.invalidRows),
+		Errors:      report.errors,
+		DryRun:      metadata.DryRun,
+	}
+
+	switch {
+	case report.invalidRows > 0:
+		response.Message = fmt.Sprintf("%d of %d rows are invalid, no employees were imported", report.invalidRows, report.totalRows)
+		return response, nil
+	case metadata.DryRun:
+		response.Message = fmt.Sprintf("All %d rows are valid", report.totalRows)
+		return response, nil
+	}
+
+	inputs := make([]*CreateEmployeeInput, 0, len(report.rows))
+	for _, row := range report.rows {
+		row.input.ProjectID = projectID
+		inputs = append(inputs, row.input)
+	}
+
+	imported, err := s.employeeRepo.BulkCreate(ctx, inputs)
+	if err != nil {
+		if err == ErrEmployeeAlreadyExists {
+			return nil, altalune.NewInvalidPayloadError("an imported email was registered during the import, please retry")
+		}
+		s.log.Error("failed to import employees",
+			"error", err,
+			"project_id", projectID,
+			"rows", len(inputs),
+		)
+		return nil, altalune.NewUnexpectedError("failed to import employees: %w", err)
+	}
+
+	// Log successful import for audit purposes
+	s.log.Info("employees imported",
+		"project_id", projectID,
+		"rows", imported,
+	)
+
+	response.ImportedRows = int32(imported)
+	response.Message = fmt.Sprintf("%d employees imported successfully", imported)
+	return response, nil
+}
+
+// ExportEmployees implements the gRPC server stream of ExportEmployeesTo.
+func (s *Service) ExportEmployees(req *altalunev1.ExportEmployeesRequest, stream altalunev1.EmployeeService_ExportEmployeesServer) error {
+	return s.ExportEmployeesTo(stream.Context(), req, nil, stream.Send)
+}
+
+// ExportEmployeesTo streams every employee of a project as a CSV or XLSX
+// file through send, with the redacted columns left empty.
+func (s *Service) ExportEmployeesTo(
+	ctx context.Context,
+	req *altalunev1.ExportEmployeesRequest,
+	redacted []string,
+	send func(*altalunev1.ExportEmployeesResponse) error,
+) error {
+	// Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	projectID, err := s.projectRepo.GetIDByPublicID(ctx, req.ProjectId)
+	if err != nil {
+		if err == project_domain.ErrProjectNotFound {
+			return altalune.NewProjectNotFound(req.ProjectId)
+		}
+		return altalune.NewInvalidPayloadError("invalid project_id")
+	}
+
+	filename := fmt.Sprintf("employees-%s-%s", req.ProjectId, time.Now().UTC().Format("20060102"))
+	out := &chunkWriter{send: send}
+	buffered := bufio.NewWriterSize(out, exportChunkSize)
+
+	switch req.Format {
+	case altalunev1.EmployeeExportFormat_EMPLOYEE_EXPORT_FORMAT_XLSX:
+		out.filename, out.contentType = filename+".xlsx", xlsx.ContentType
+		err = s.exportXLSX(ctx, projectID, redacted, buffered)
+	default:
+		out.filename, out.contentType = filename+".csv", "text/csv"
+		err = s.employeeRepo.Export(ctx, projectID, redacted, buffered)
+	}
+	if err == nil {
+		err = buffered.Flush()
+	}
+	if err == nil {
+		err = out.flush()
+	}
+	if err != nil {
+		s.log.Error("failed to export employees",
+			"error", err,
+			"project_id", projectID,
+			"format", req.Format.String(),
+		)
+		return altalune.NewUnexpectedError("failed to export employees: %w", err)
+	}
+
+	// Log successful export for audit purposes
+	s.log.Info("employees exported",
+		"project_id", projectID,
+		"format", req.Format.String(),
+		"redacted", redacted,
+	)
+
+	return nil
+}
+
+// exportXLSX converts the CSV export into an XLSX workbook as it streams.
+func (s *Service) exportXLSX(ctx context.Context, projectID int64, redacted []string, w io.Writer) error {
+	pr, pw := io.Pipe()
+	defer pr.Close()
+	go func() {
+		pw.CloseWithError(s.employeeRepo.Export(ctx, projectID, redacted, pw))
+	}()
+
+	workbook, err := xlsx.NewWriter(w, "Employees")
+	if err != nil {
+		return err
+	}
+
+	records := csv.NewReader(pr)
+	records.ReuseRecord = true
+	for {
+		record, err := records.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		// Cells are plain text in XLSX, so the CSV formula escaping is undone
+		for i, cell := range record {
+			if len(cell) > 1 && cell[0] == '\'' && strings.ContainsRune("=+-@\t\r", rune(cell[1])) {
+				record[i] = cell[1:]
+			}
+		}
+		if err := workbook.WriteRow(record); err != nil {
+			return err
+		}
+	}
+
+	return workbook.Close()
+}
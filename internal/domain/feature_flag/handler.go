@@ -0,0 +1,83 @@
+package feature_flag
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/hrz8/altalune"
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	"github.com/hrz8/altalune/internal/auth"
+)
+
+type Handler struct {
+	svc  altalunev1.FeatureFlagServiceServer
+	auth *auth.Authorizer
+}
+
+func NewHandler(svc altalunev1.FeatureFlagServiceServer, authorizer *auth.Authorizer) *Handler {
+	return &Handler{svc: svc, auth: authorizer}
+}
+
+func (h *Handler) ListFeatureFlags(
+	ctx context.Context,
+	req *connect.Request[altalunev1.ListFeatureFlagsRequest],
+) (*connect.Response[altalunev1.ListFeatureFlagsResponse], error) {
+	// Authorization: requires featureflag:read permission (global - flags span every project)
+	if err := h.auth.CheckPermission(ctx, "featureflag:read"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.ListFeatureFlags(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (h *Handler) UpdateFeatureFlag(
+	ctx context.Context,
+	req *connect.Request[altalunev1.UpdateFeatureFlagRequest],
+) (*connect.Response[altalunev1.UpdateFeatureFlagResponse], error) {
+	// Authorization: requires featureflag:write permission (global)
+	if err := h.auth.CheckPermission(ctx, "featureflag:write"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.UpdateFeatureFlag(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (h *Handler) SetProjectFeatureFlag(
+	ctx context.Context,
+	req *connect.Request[altalunev1.SetProjectFeatureFlagRequest],
+) (*connect.Response[altalunev1.SetProjectFeatureFlagResponse], error) {
+	// Authorization: requires featureflag:write permission (global)
+	if err := h.auth.CheckPermission(ctx, "featureflag:write"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.SetProjectFeatureFlag(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (h *Handler) ClearProjectFeatureFlag(
+	ctx context.Context,
+	req *connect.Request[altalunev1.ClearProjectFeatureFlagRequest],
+) (*connect.Response[altalunev1.ClearProjectFeatureFlagResponse], error) {
+	// Authorization: requires featureflag:write permission (global)
+	if err := h.auth.CheckPermission(ctx, "featureflag:write"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.ClearProjectFeatureFlag(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
@@ -0,0 +1,46 @@
+package feature_flag
+
+import (
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	"github.com/hrz8/altalune/internal/featureflag"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// initialState is the configuration equivalent to the declared default of a
+// flag, which a flag is stored with when first overridden
+func initialState(definition featureflag.Definition) featureflag.UpdateFlagInput {
+	input := featureflag.UpdateFlagInput{Flag: definition.Flag, Enabled: definition.Default}
+	if definition.Default {
+		input.RolloutPercentage = 100
+	}
+	return input
+}
+
+// mapFeatureFlagToProto converts a declared flag and its stored state, nil
+// while it was never configured, to a proto FeatureFlag
+func mapFeatureFlagToProto(definition featureflag.Definition, state *featureflag.State) *altalunev1.FeatureFlag {
+	result := &altalunev1.FeatureFlag{
+		Name:           string(definition.Flag),
+		Description:    definition.Description,
+		DefaultEnabled: definition.Default,
+		Projects:       make([]*altalunev1.ProjectFeatureFlag, 0),
+	}
+	if state == nil {
+		initial := initialState(definition)
+		result.Enabled = initial.Enabled
+		result.RolloutPercentage = int32(initial.RolloutPercentage)
+		return result
+	}
+
+	result.Configured = true
+	result.Enabled = state.Enabled
+	result.RolloutPercentage = int32(state.RolloutPercentage)
+	result.UpdatedAt = timestamppb.New(state.UpdatedAt)
+	for _, override := range state.Projects {
+		result.Projects = append(result.Projects, &altalunev1.ProjectFeatureFlag{
+			ProjectId: override.ProjectPublicID,
+			Enabled:   override.Enabled,
+		})
+	}
+	return result
+}
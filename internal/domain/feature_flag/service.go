@@ -0,0 +1,221 @@
+package feature_flag
+
+import (
+	"context"
+
+	"buf.build/go/protovalidate"
+	"github.com/hrz8/altalune"
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	project_domain "github.com/hrz8/altalune/internal/domain/project"
+	"github.com/hrz8/altalune/internal/featureflag"
+)
+
+type Service struct {
+	altalunev1.UnimplementedFeatureFlagServiceServer
+	validator   protovalidate.Validator
+	log         altalune.Logger
+	projectRepo project_domain.Repositor
+	flagRepo    featureflag.Repositor
+	flags       *featureflag.Flags
+}
+
+func NewService(
+	v protovalidate.Validator,
+	log altalune.Logger,
+	projectRepo project_domain.Repositor,
+	flagRepo featureflag.Repositor,
+	flags *featureflag.Flags,
+) *Service {
+	return &Service{
+		validator:   v,
+		log:         log,
+		projectRepo: projectRepo,
+		flagRepo:    flagRepo,
+		flags:       flags,
+	}
+}
+
+func (s *Service) ListFeatureFlags(ctx context.Context, req *altalunev1.ListFeatureFlagsRequest) (*altalunev1.ListFeatureFlagsResponse, error) {
+	states, err := s.listStates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	definitions := featureflag.Definitions()
+	data := make([]*altalunev1.FeatureFlag, 0, len(definitions))
+	for _, definition := range definitions {
+		data = append(data, mapFeatureFlagToProto(definition, states[definition.Flag]))
+	}
+
+	return &altalunev1.ListFeatureFlagsResponse{
+		Data: data,
+	}, nil
+}
+
+func (s *Service) UpdateFeatureFlag(ctx context.Context, req *altalunev1.UpdateFeatureFlagRequest) (*altalunev1.UpdateFeatureFlagResponse, error) {
+	// Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	definition, ok := featureflag.Lookup(featureflag.Flag(req.Name))
+	if !ok {
+		return nil, altalune.NewFeatureFlagNotFoundError(req.Name)
+	}
+
+	err := s.flagRepo.UpdateFlag(ctx, &featureflag.UpdateFlagInput{
+		Flag:              definition.Flag,
+		Enabled:           req.Enabled,
+		RolloutPercentage: int(req.RolloutPercentage),
+	})
+	if err != nil {
+		s.log.Error("failed to update feature flag",
+			"error", err,
+			"flag", req.Name,
+		)
+		return nil, altalune.NewUnexpectedError("failed to update feature flag: %w", err)
+	}
+	s.flags.Invalidate()
+
+	// Log successful update for audit purposes
+	s.log.Info("feature flag updated",
+		"flag", req.Name,
+		"enabled", req.Enabled,
+		"rollout_percentage", req.RolloutPercentage,
+	)
+
+	featureFlag, err := s.get(ctx, definition)
+	if err != nil {
+		return nil, err
+	}
+	return &altalunev1.UpdateFeatureFlagResponse{
+		FeatureFlag: featureFlag,
+		Message:     "Feature flag updated successfully",
+	}, nil
+}
+
+func (s *Service) SetProjectFeatureFlag(ctx context.Context, req *altalunev1.SetProjectFeatureFlagRequest) (*altalunev1.SetProjectFeatureFlagResponse, error) {
+	// Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	definition, ok := featureflag.Lookup(featureflag.Flag(req.Name))
+	if !ok {
+		return nil, altalune.NewFeatureFlagNotFoundError(req.Name)
+	}
+	projectID, err := s.resolveProjectID(ctx, req.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.flagRepo.SetProjectOverride(ctx, &featureflag.SetProjectOverrideInput{
+		Flag:      definition.Flag,
+		ProjectID: projectID,
+		Enabled:   req.Enabled,
+		Initial:   initialState(definition),
+	})
+	if err != nil {
+		s.log.Error("failed to set feature flag override",
+			"error", err,
+			"flag", req.Name,
+			"project_id", projectID,
+		)
+		return nil, altalune.NewUnexpectedError("failed to set feature flag override: %w", err)
+	}
+	s.flags.Invalidate()
+
+	// Log successful override for audit purposes
+	s.log.Info("feature flag overridden for project",
+		"flag", req.Name,
+		"project_id", projectID,
+		"enabled", req.Enabled,
+	)
+
+	featureFlag, err := s.get(ctx, definition)
+	if err != nil {
+		return nil, err
+	}
+	return &altalunev1.SetProjectFeatureFlagResponse{
+		FeatureFlag: featureFlag,
+		Message:     "Feature flag override saved successfully",
+	}, nil
+}
+
+func (s *Service) ClearProjectFeatureFlag(ctx context.Context, req *altalunev1.ClearProjectFeatureFlagRequest) (*altalunev1.ClearProjectFeatureFlagResponse, error) {
+	// Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	definition, ok := featureflag.Lookup(featureflag.Flag(req.Name))
+	if !ok {
+		return nil, altalune.NewFeatureFlagNotFoundError(req.Name)
+	}
+	projectID, err := s.resolveProjectID(ctx, req.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.flagRepo.ClearProjectOverride(ctx, definition.Flag, projectID); err != nil {
+		if err == featureflag.ErrOverrideNotFound {
+			return nil, altalune.NewFeatureFlagOverrideNotFoundError(req.Name, req.ProjectId)
+		}
+		s.log.Error("failed to clear feature flag override",
+			"error", err,
+			"flag", req.Name,
+			"project_id", projectID,
+		)
+		return nil, altalune.NewUnexpectedError("failed to clear feature flag override: %w", err)
+	}
+	s.flags.Invalidate()
+
+	// Log successful removal for audit purposes
+	s.log.Info("feature flag override cleared",
+		"flag", req.Name,
+		"project_id", projectID,
+	)
+
+	featureFlag, err := s.get(ctx, definition)
+	if err != nil {
+		return nil, err
+	}
+	return &altalunev1.ClearProjectFeatureFlagResponse{
+		FeatureFlag: featureFlag,
+		Message:     "Feature flag override cleared successfully",
+	}, nil
+}
+
+// get returns a declared flag with its current state
+func (s *Service) get(ctx context.Context, definition featureflag.Definition) (*altalunev1.FeatureFlag, error) {
+	states, err := s.listStates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return mapFeatureFlagToProto(definition, states[definition.Flag]), nil
+}
+
+func (s *Service) listStates(ctx context.Context) (map[featureflag.Flag]*featureflag.State, error) {
+	states, err := s.flagRepo.List(ctx)
+	if err != nil {
+		s.log.Error("failed to list feature flags", "error", err)
+		return nil, altalune.NewUnexpectedError("failed to list feature flags: %w", err)
+	}
+
+	result := make(map[featureflag.Flag]*featureflag.State, len(states))
+	for _, state := range states {
+		result[state.Flag] = state
+	}
+	return result, nil
+}
+
+func (s *Service) resolveProjectID(ctx context.Context, publicID string) (int64, error) {
+	projectID, err := s.projectRepo.GetIDByPublicID(ctx, publicID)
+	if err != nil {
+		if err == project_domain.ErrProjectNotFound {
+			return 0, altalune.NewProjectNotFound(publicID)
+		}
+		return 0, altalune.NewInvalidPayloadError("invalid project_id")
+	}
+	return projectID, nil
+}
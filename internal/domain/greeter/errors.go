@@ -0,0 +1,8 @@
+package greeter
+
+import "errors"
+
+var (
+	ErrAllowedNameNotFound      = errors.New("allowed name not found")
+	ErrAllowedNameAlreadyExists = errors.New("allowed name already exists")
+)
@@ -6,14 +6,16 @@ import (
 	"connectrpc.com/connect"
 	"github.com/hrz8/altalune"
 	greeterv1 "github.com/hrz8/altalune/gen/greeter/v1"
+	"github.com/hrz8/altalune/internal/auth"
 )
 
 type Handler struct {
-	svc greeterv1.GreeterServiceServer
+	svc  *Service
+	auth *auth.Authorizer
 }
 
-func NewHandler(svc greeterv1.GreeterServiceServer) *Handler {
-	return &Handler{svc: svc}
+func NewHandler(svc *Service, authorizer *auth.Authorizer) *Handler {
+	return &Handler{svc: svc, auth: authorizer}
 }
 
 func (h *Handler) SayHello(
@@ -27,6 +29,17 @@ func (h *Handler) SayHello(
 	return connect.NewResponse(response), nil
 }
 
+func (h *Handler) SayHelloToMany(
+	ctx context.Context,
+	req *connect.Request[greeterv1.SayHelloToManyRequest],
+	stream *connect.ServerStream[greeterv1.SayHelloToManyResponse],
+) error {
+	if err := h.svc.SayHelloToManyTo(ctx, req.Msg, stream.Send); err != nil {
+		return altalune.ToConnectError(err)
+	}
+	return nil
+}
+
 func (h *Handler) GetAllowedNames(
 	ctx context.Context,
 	req *connect.Request[greeterv1.GetAllowedNamesRequest],
@@ -37,3 +50,51 @@ func (h *Handler) GetAllowedNames(
 	}
 	return connect.NewResponse(response), nil
 }
+
+func (h *Handler) CreateAllowedName(
+	ctx context.Context,
+	req *connect.Request[greeterv1.CreateAllowedNameRequest],
+) (*connect.Response[greeterv1.CreateAllowedNameResponse], error) {
+	// Authorization: requires greeter:write permission (global)
+	if err := h.auth.CheckPermission(ctx, "greeter:write"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.CreateAllowedName(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (h *Handler) UpdateAllowedName(
+	ctx context.Context,
+	req *connect.Request[greeterv1.UpdateAllowedNameRequest],
+) (*connect.Response[greeterv1.UpdateAllowedNameResponse], error) {
+	// Authorization: requires greeter:write permission (global)
+	if err := h.auth.CheckPermission(ctx, "greeter:write"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.UpdateAllowedName(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (h *Handler) DeleteAllowedName(
+	ctx context.Context,
+	req *connect.Request[greeterv1.DeleteAllowedNameRequest],
+) (*connect.Response[greeterv1.DeleteAllowedNameResponse], error) {
+	// Authorization: requires greeter:write permission (global)
+	if err := h.auth.CheckPermission(ctx, "greeter:write"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.DeleteAllowedName(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
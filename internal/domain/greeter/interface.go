@@ -1,8 +1,12 @@
 package greeter
 
+import "context"
+
 type Repositor interface {
 	GetGreeterTemplate(name string) string
-	GetAllowedNames(page, limit int32) []string
-	GetAllowedNamesWithTotal(page, limit int32) ([]string, int32)
-	GetTotalAllowedNames() int32
+	GetAllowedNamesWithTotal(ctx context.Context, page, limit int32) ([]string, int32, error)
+	FindAllowedNames(ctx context.Context, names []string) ([]string, error)
+	CreateAllowedName(ctx context.Context, name string) error
+	UpdateAllowedName(ctx context.Context, name, newName string) error
+	DeleteAllowedName(ctx context.Context, name string) error
 }
@@ -1,57 +1,144 @@
 package greeter
 
-import "fmt"
-
-var allowedNames = []string{
-	"Alina", "Bryce", "Carmen", "Darius", "Elena",
-	"Felix", "Gianna", "Hassan", "Irene", "Jasper",
-	"Kiana", "Luther", "Maya", "Nolan", "Orlando",
-	"Priya", "Quincy", "Rafael", "Sienna", "Tobias",
-	"Umair", "Vera", "Wesley", "Xavier", "Yasmin",
-	"Zane", "Adriana", "Bennett", "Clarissa", "Devonte",
-	"Estella", "Finnegan", "Gracelyn", "Harvey", "Isidora",
-	"Jovani", "Katarina", "Leonidas", "Mirella", "Nikolas",
-	"Octavia", "Percival", "Quintessa", "Romero", "Salvador",
-	"Theodora", "Ulrich", "Valeria", "Winslow", "Xiomara",
-	"Yuridia", "Zephyrus", "Aurelius", "Bellatrix", "Caspian",
-	"Demetrius", "Evangeline", "Florentino", "Galadriel", "Hermione",
-	"Ignatius", "Julianna", "Kristoffer", "Lysandra", "Maximiliano",
-	"Nefertari", "Olivander", "Philomena", "Quetzalcoatl", "Rhiannon",
-	"Sebastiana", "Thessalonia", "Ulyssiana", "Vladimir", "Wilhelmina",
-	"Xenophilius", "Yggdrasila", "Zaphkiel", "Alejandrina", "Balthazar",
-	"Christabelle", "Domenico", "Euphrosyne", "Featherstone", "Gwendolyn",
-	"Hyacinthus", "Isambard", "Jacqueline", "Kallistrate", "Leontius",
-	"Marcellinus", "Nicomachus", "Ozymandias", "Petronella", "Quintilius",
-	"Rosencrantz", "Seraphimiel", "Timotheus", "Ultraviolet", "Valentinian",
-}
-
-type Repo struct{}
-
-func NewRepo() *Repo {
-	return &Repo{}
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hrz8/altalune/internal/postgres"
+	"github.com/lib/pq"
+)
+
+type Repo struct {
+	db postgres.DB
+}
+
+func NewRepo(db postgres.DB) *Repo {
+	return &Repo{db: db}
 }
 
 func (r *Repo) GetGreeterTemplate(name string) string {
 	return fmt.Sprintf("Hello, %s!", name)
 }
 
-func (r *Repo) GetAllowedNames(page, limit int32) []string {
-	start := int((page - 1) * limit)
-	if start > len(allowedNames) {
-		return []string{}
+// GetAllowedNamesWithTotal returns a page of allowed names, in the order they
+// were added, and the total count
+func (r *Repo) GetAllowedNamesWithTotal(ctx context.Context, page, limit int32) ([]string, int32, error) {
+	var total int32
+	if err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM altalune_example_greeter_names`).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("count allowed names: %w", err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT name
+		FROM altalune_example_greeter_names
+		ORDER BY id
+		LIMIT $1 OFFSET $2
+	`, limit, (page-1)*limit)
+	if err != nil {
+		return nil, 0, fmt.Errorf("query allowed names: %w", err)
+	}
+	defer rows.Close()
+
+	names := []string{}
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, 0, fmt.Errorf("scan allowed name: %w", err)
+		}
+		names = append(names, name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("iterate allowed names: %w", err)
 	}
-	end := min(start+int(limit), len(allowedNames))
-	return allowedNames[start:end]
+
+	return names, total, nil
 }
 
-// New method that returns both paginated names and total count
-func (r *Repo) GetAllowedNamesWithTotal(page, limit int32) ([]string, int32) {
-	total := int32(len(allowedNames))
-	names := r.GetAllowedNames(page, limit)
-	return names, total
+// FindAllowedNames returns which of names are allowed
+func (r *Repo) FindAllowedNames(ctx context.Context, names []string) ([]string, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT name
+		FROM altalune_example_greeter_names
+		WHERE name = ANY($1)
+	`, pq.Array(names))
+	if err != nil {
+		return nil, fmt.Errorf("find allowed names: %w", err)
+	}
+	defer rows.Close()
+
+	var found []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, fmt.Errorf("scan allowed name: %w", err)
+		}
+		found = append(found, name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate allowed names: %w", err)
+	}
+
+	return found, nil
 }
 
-// New method to get total count only
-func (r *Repo) GetTotalAllowedNames() int32 {
-	return int32(len(allowedNames))
+func (r *Repo) CreateAllowedName(ctx context.Context, name string) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO altalune_example_greeter_names (name)
+		VALUES ($1)
+	`, name)
+	if err != nil {
+		if postgres.IsUniqueViolation(err) {
+			return ErrAllowedNameAlreadyExists
+		}
+		return fmt.Errorf("create allowed name: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repo) UpdateAllowedName(ctx context.Context, name, newName string) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE altalune_example_greeter_names
+		SET name = $2, updated_at = $3
+		WHERE name = $1
+	`, name, newName, time.Now())
+	if err != nil {
+		if postgres.IsUniqueViolation(err) {
+			return ErrAllowedNameAlreadyExists
+		}
+		return fmt.Errorf("update allowed name: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("check rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrAllowedNameNotFound
+	}
+
+	return nil
+}
+
+func (r *Repo) DeleteAllowedName(ctx context.Context, name string) error {
+	result, err := r.db.ExecContext(ctx, `
+		DELETE FROM altalune_example_greeter_names
+		WHERE name = $1
+	`, name)
+	if err != nil {
+		return fmt.Errorf("delete allowed name: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("check rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrAllowedNameNotFound
+	}
+
+	return nil
 }
@@ -0,0 +1,48 @@
+package greeter_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hrz8/altalune/internal/domain/greeter"
+	"github.com/hrz8/altalune/internal/testdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) { testdb.Main(m) }
+
+func TestRepoAllowedNames(t *testing.T) {
+	ctx := context.Background()
+	repo := greeter.NewRepo(testdb.Tx(t))
+
+	name := "Greet" + testdb.Token(t)
+	renamed := "Renamed" + testdb.Token(t)
+
+	_, before, err := repo.GetAllowedNamesWithTotal(ctx, 1, 1)
+	require.NoError(t, err)
+	assert.Positive(t, before, "the migration seeds the names that used to be hardcoded")
+
+	require.NoError(t, repo.CreateAllowedName(ctx, name))
+	assert.ErrorIs(t, repo.CreateAllowedName(ctx, name), greeter.ErrAllowedNameAlreadyExists)
+
+	names, total, err := repo.GetAllowedNamesWithTotal(ctx, before+1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, before+1, total)
+	assert.Equal(t, []string{name}, names, "names are listed in the order they were added")
+
+	found, err := repo.FindAllowedNames(ctx, []string{name, "Alina", renamed})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{name, "Alina"}, found)
+
+	require.NoError(t, repo.UpdateAllowedName(ctx, name, renamed))
+	assert.ErrorIs(t, repo.UpdateAllowedName(ctx, name, "Other"), greeter.ErrAllowedNameNotFound)
+	assert.ErrorIs(t, repo.UpdateAllowedName(ctx, renamed, "Alina"), greeter.ErrAllowedNameAlreadyExists)
+
+	require.NoError(t, repo.DeleteAllowedName(ctx, renamed))
+	assert.ErrorIs(t, repo.DeleteAllowedName(ctx, renamed), greeter.ErrAllowedNameNotFound)
+
+	found, err = repo.FindAllowedNames(ctx, []string{name, renamed})
+	require.NoError(t, err)
+	assert.Empty(t, found)
+}
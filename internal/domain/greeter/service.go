@@ -31,9 +31,8 @@ func (s *Service) SayHello(ctx context.Context, req *greeterv1.SayHelloRequest)
 		return nil, altalune.NewInvalidPayloadError(err.Error())
 	}
 
-	allowedNameMap := getAllowedNameMap()
-	if _, ok := allowedNameMap[req.Name]; !ok {
-		return nil, altalune.NewGreetingUnrecognize(req.Name)
+	if err := s.checkAllowedNames(ctx, []string{req.Name}); err != nil {
+		return nil, err
 	}
 
 	msg := s.greeterRepo.GetGreeterTemplate(req.Name)
@@ -43,12 +42,68 @@ func (s *Service) SayHello(ctx context.Context, req *greeterv1.SayHelloRequest)
 	return response, nil
 }
 
-func getAllowedNameMap() map[string]bool {
-	m := make(map[string]bool, len(allowedNames))
-	for _, name := range allowedNames {
-		m[name] = true
+// SayHelloToMany implements the gRPC server stream of SayHelloToManyTo.
+func (s *Service) SayHelloToMany(req *greeterv1.SayHelloToManyRequest, stream greeterv1.GreeterService_SayHelloToManyServer) error {
+	return s.SayHelloToManyTo(stream.Context(), req, stream.Send)
+}
+
+// SayHelloToManyTo sends one greeting per name through send. All names are
+// checked first, so an unrecognized name fails the call before any greeting
+// is sent.
+func (s *Service) SayHelloToManyTo(
+	ctx context.Context,
+	req *greeterv1.SayHelloToManyRequest,
+	send func(*greeterv1.SayHelloToManyResponse) error,
+) error {
+	if err := s.validator.Validate(req); err != nil {
+		return altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	if err := s.checkAllowedNames(ctx, req.Names); err != nil {
+		return err
+	}
+
+	for _, name := range req.Names {
+		// Simulate some processing delay per greeting
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(300 * time.Millisecond):
+		}
+
+		response := &greeterv1.SayHelloToManyResponse{
+			Name:    name,
+			Message: s.greeterRepo.GetGreeterTemplate(name),
+		}
+		if err := send(response); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkAllowedNames returns an error for the first of names that is not
+// allowed.
+func (s *Service) checkAllowedNames(ctx context.Context, names []string) error {
+	found, err := s.greeterRepo.FindAllowedNames(ctx, names)
+	if err != nil {
+		s.log.Error("failed to find allowed names",
+			"error", err,
+			"names", names,
+		)
+		return altalune.NewUnexpectedError("failed to find allowed names: %w", err)
+	}
+
+	allowed := make(map[string]bool, len(found))
+	for _, name := range found {
+		allowed[name] = true
 	}
-	return m
+	for _, name := range names {
+		if !allowed[name] {
+			return altalune.NewGreetingUnrecognize(name)
+		}
+	}
+	return nil
 }
 
 func (s *Service) GetAllowedNames(ctx context.Context, req *greeterv1.GetAllowedNamesRequest) (*greeterv1.GetAllowedNamesResponse, error) {
@@ -59,7 +114,15 @@ func (s *Service) GetAllowedNames(ctx context.Context, req *greeterv1.GetAllowed
 	}
 
 	// Get paginated names and total count
-	names, total := s.greeterRepo.GetAllowedNamesWithTotal(req.Page, req.Limit)
+	names, total, err := s.greeterRepo.GetAllowedNamesWithTotal(ctx, req.Page, req.Limit)
+	if err != nil {
+		s.log.Error("failed to get allowed names",
+			"error", err,
+			"page", req.Page,
+			"limit", req.Limit,
+		)
+		return nil, altalune.NewUnexpectedError("failed to get allowed names: %w", err)
+	}
 
 	// Calculate pagination metadata
 	totalPages := int32(math.Ceil(float64(total) / float64(req.Limit)))
@@ -80,3 +143,64 @@ func (s *Service) GetAllowedNames(ctx context.Context, req *greeterv1.GetAllowed
 		Meta:  meta,
 	}, nil
 }
+
+func (s *Service) CreateAllowedName(ctx context.Context, req *greeterv1.CreateAllowedNameRequest) (*greeterv1.CreateAllowedNameResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	if err := s.greeterRepo.CreateAllowedName(ctx, req.Name); err != nil {
+		if err == ErrAllowedNameAlreadyExists {
+			return nil, altalune.NewGreetingNameAlreadyExistsError(req.Name)
+		}
+		s.log.Error("failed to create allowed name",
+			"error", err,
+			"name", req.Name,
+		)
+		return nil, altalune.NewUnexpectedError("failed to create allowed name: %w", err)
+	}
+
+	return &greeterv1.CreateAllowedNameResponse{Name: req.Name}, nil
+}
+
+func (s *Service) UpdateAllowedName(ctx context.Context, req *greeterv1.UpdateAllowedNameRequest) (*greeterv1.UpdateAllowedNameResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	if err := s.greeterRepo.UpdateAllowedName(ctx, req.Name, req.NewName); err != nil {
+		switch err {
+		case ErrAllowedNameNotFound:
+			return nil, altalune.NewGreetingNameNotFoundError(req.Name)
+		case ErrAllowedNameAlreadyExists:
+			return nil, altalune.NewGreetingNameAlreadyExistsError(req.NewName)
+		}
+		s.log.Error("failed to update allowed name",
+			"error", err,
+			"name", req.Name,
+			"new_name", req.NewName,
+		)
+		return nil, altalune.NewUnexpectedError("failed to update allowed name: %w", err)
+	}
+
+	return &greeterv1.UpdateAllowedNameResponse{Name: req.NewName}, nil
+}
+
+func (s *Service) DeleteAllowedName(ctx context.Context, req *greeterv1.DeleteAllowedNameRequest) (*greeterv1.DeleteAllowedNameResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	if err := s.greeterRepo.DeleteAllowedName(ctx, req.Name); err != nil {
+		if err == ErrAllowedNameNotFound {
+			return nil, altalune.NewGreetingNameNotFoundError(req.Name)
+		}
+		s.log.Error("failed to delete allowed name",
+			"error", err,
+			"name", req.Name,
+		)
+		return nil, altalune.NewUnexpectedError("failed to delete allowed name: %w", err)
+	}
+
+	return &greeterv1.DeleteAllowedNameResponse{}, nil
+}
@@ -0,0 +1,132 @@
+package greeter
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"buf.build/go/protovalidate"
+	greeterv1 "github.com/hrz8/altalune/gen/greeter/v1"
+	"github.com/hrz8/altalune/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeRepo allows a fixed set of names; the CRUD methods are never called
+type fakeRepo struct {
+	Repositor
+	allowed map[string]bool
+	err     error
+}
+
+func (r *fakeRepo) GetGreeterTemplate(name string) string {
+	return (&Repo{}).GetGreeterTemplate(name)
+}
+
+func (r *fakeRepo) FindAllowedNames(_ context.Context, names []string) ([]string, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	var found []string
+	for _, name := range names {
+		if r.allowed[name] {
+			found = append(found, name)
+		}
+	}
+	return found, nil
+}
+
+func newTestService(t *testing.T, repo Repositor) *Service {
+	t.Helper()
+
+	v, err := protovalidate.New()
+	require.NoError(t, err)
+	return NewService(v, logger.New("error"), repo)
+}
+
+func TestSayHelloToManyTo(t *testing.T) {
+	repo := &fakeRepo{allowed: map[string]bool{"Alina": true, "Bryce": true}}
+
+	tests := []struct {
+		name  string
+		names []string
+		repo  *fakeRepo
+		code  codes.Code
+		want  []string
+	}{
+		{
+			name:  "greets every name in order",
+			names: []string{"Bryce", "Alina"},
+			repo:  repo,
+			want:  []string{"Hello, Bryce!", "Hello, Alina!"},
+		},
+		{
+			name:  "unrecognized name fails before any greeting",
+			names: []string{"Alina", "Mallory"},
+			repo:  repo,
+			code:  codes.InvalidArgument,
+		},
+		{
+			name: "no names",
+			repo: repo,
+			code: codes.InvalidArgument,
+		},
+		{
+			name:  "repository failure",
+			names: []string{"Alina"},
+			repo:  &fakeRepo{err: errors.New("connection refused")},
+			code:  codes.Internal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			err := newTestService(t, tt.repo).SayHelloToManyTo(
+				context.Background(),
+				&greeterv1.SayHelloToManyRequest{Names: tt.names},
+				func(resp *greeterv1.SayHelloToManyResponse) error {
+					got = append(got, resp.Message)
+					return nil
+				},
+			)
+
+			if tt.code != codes.OK {
+				assert.Equal(t, tt.code, status.Code(err))
+				assert.Empty(t, got)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSayHelloToManyToStops(t *testing.T) {
+	svc := newTestService(t, &fakeRepo{allowed: map[string]bool{"Alina": true, "Bryce": true}})
+	req := &greeterv1.SayHelloToManyRequest{Names: []string{"Alina", "Bryce"}}
+
+	t.Run("send failure", func(t *testing.T) {
+		sendErr := errors.New("client gone")
+		sent := 0
+		err := svc.SayHelloToManyTo(context.Background(), req, func(*greeterv1.SayHelloToManyResponse) error {
+			sent++
+			return sendErr
+		})
+		assert.ErrorIs(t, err, sendErr)
+		assert.Equal(t, 1, sent)
+	})
+
+	t.Run("canceled call", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		sent := 0
+		err := svc.SayHelloToManyTo(ctx, req, func(*greeterv1.SayHelloToManyResponse) error {
+			sent++
+			cancel()
+			return nil
+		})
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, 1, sent)
+	})
+}
@@ -27,16 +27,24 @@ var (
 
 	// ErrProjectNotFound indicates project was not found during mapping operation
 	ErrProjectNotFound = errors.New("project not found for mapping")
+
+	// ErrOwnershipTransferNotFound indicates no pending ownership transfer matched
+	ErrOwnershipTransferNotFound = errors.New("ownership transfer not found")
+
+	// ErrMemberLimitReached indicates new members would take the project over
+	// the members its plan allows
+	ErrMemberLimitReached = errors.New("project has as many members as its plan allows")
 )
 
 // Error codes for IAM Mapper domain (608XX range)
 const (
-	CodeMappingNotFound        = 60800
-	CodeMappingAlreadyExists   = 60801
-	CodeInvalidProjectRole     = 60802
-	CodeCannotRemoveLastOwner  = 60803
-	CodeUserNotFoundForMapping = 60804
-	CodeRoleNotFoundForMapping = 60805
-	CodePermissionNotFound     = 60806
-	CodeProjectNotFound        = 60807
+	CodeMappingNotFound           = 60800
+	CodeMappingAlreadyExists      = 60801
+	CodeInvalidProjectRole        = 60802
+	CodeCannotRemoveLastOwner     = 60803
+	CodeUserNotFoundForMapping    = 60804
+	CodeRoleNotFoundForMapping    = 60805
+	CodePermissionNotFound        = 60806
+	CodeProjectNotFound           = 60807
+	CodeOwnershipTransferNotFound = 60809
 )
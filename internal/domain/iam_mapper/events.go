@@ -0,0 +1,13 @@
+package iam_mapper
+
+// ProjectMemberAdded is published when a user joins a project through
+// AssignProjectMembers; role changes of existing members are not.
+type ProjectMemberAdded struct {
+	ProjectID       int64  `json:"-"`
+	ProjectPublicID string `json:"project_id"`
+	UserPublicID    string `json:"user_id"`
+	Email           string `json:"email,omitempty"`
+	Role            string `json:"role"`
+}
+
+func (ProjectMemberAdded) EventName() string { return "project.member_added" }
@@ -0,0 +1,27 @@
+package iam_mapper
+
+// projectRoleRanks orders project roles from least to most privileged
+var projectRoleRanks = map[string]int{
+	ProjectRoleUser:   1,
+	ProjectRoleMember: 2,
+	ProjectRoleAdmin:  3,
+	ProjectRoleOwner:  4,
+}
+
+// canGrantProjectRole reports whether a member holding callerRole may grant
+// role, or change or remove a member who holds it. Members can only manage
+// roles at or below their own, so an admin cannot create or demote owners.
+func canGrantProjectRole(callerRole, role string) bool {
+	callerRank, ok := projectRoleRanks[callerRole]
+	return ok && projectRoleRanks[role] <= callerRank
+}
+
+// HigherProjectRole returns the more privileged of two project roles, such as
+// the role a user holds as a project member and the one held through the
+// project's organization
+func HigherProjectRole(a, b string) string {
+	if projectRoleRanks[b] > projectRoleRanks[a] {
+		return b
+	}
+	return a
+}
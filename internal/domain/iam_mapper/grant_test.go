@@ -0,0 +1,34 @@
+package iam_mapper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanGrantProjectRole(t *testing.T) {
+	tests := []struct {
+		caller, role string
+		want         bool
+	}{
+		{ProjectRoleOwner, ProjectRoleOwner, true},
+		{ProjectRoleOwner, ProjectRoleUser, true},
+		{ProjectRoleAdmin, ProjectRoleAdmin, true},
+		{ProjectRoleAdmin, ProjectRoleMember, true},
+		{ProjectRoleAdmin, ProjectRoleOwner, false},
+		{ProjectRoleMember, ProjectRoleAdmin, false},
+		{ProjectRoleUser, ProjectRoleUser, true},
+		{"", ProjectRoleUser, false},
+		{"unknown", ProjectRoleUser, false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, canGrantProjectRole(tt.caller, tt.role), "%s granting %s", tt.caller, tt.role)
+	}
+}
+
+func TestHigherProjectRole(t *testing.T) {
+	assert.Equal(t, ProjectRoleOwner, HigherProjectRole(ProjectRoleMember, ProjectRoleOwner))
+	assert.Equal(t, ProjectRoleAdmin, HigherProjectRole(ProjectRoleAdmin, ProjectRoleUser))
+	assert.Equal(t, ProjectRoleAdmin, HigherProjectRole("", ProjectRoleAdmin))
+	assert.Equal(t, ProjectRoleUser, HigherProjectRole(ProjectRoleUser, ""))
+}
@@ -219,6 +219,75 @@ func (h *Handler) GetProjectMembers(
 	return connect.NewResponse(response), nil
 }
 
+// ==================== Project Ownership Transfers ====================
+
+func (h *Handler) InitiateProjectOwnershipTransfer(
+	ctx context.Context,
+	req *connect.Request[altalunev1.InitiateProjectOwnershipTransferRequest],
+) (*connect.Response[altalunev1.InitiateProjectOwnershipTransferResponse], error) {
+	// Authorization: requires member:write permission and project membership
+	// (the service further requires the caller to own the project)
+	if err := h.auth.CheckProjectAccess(ctx, "member:write", req.Msg.ProjectId); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.InitiateProjectOwnershipTransfer(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (h *Handler) GetProjectOwnershipTransfer(
+	ctx context.Context,
+	req *connect.Request[altalunev1.GetProjectOwnershipTransferRequest],
+) (*connect.Response[altalunev1.GetProjectOwnershipTransferResponse], error) {
+	// Authorization: requires member:read permission and project membership
+	if err := h.auth.CheckProjectAccess(ctx, "member:read", req.Msg.ProjectId); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.GetProjectOwnershipTransfer(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (h *Handler) AcceptProjectOwnershipTransfer(
+	ctx context.Context,
+	req *connect.Request[altalunev1.AcceptProjectOwnershipTransferRequest],
+) (*connect.Response[altalunev1.AcceptProjectOwnershipTransferResponse], error) {
+	// Authorization: requires authentication (the service only lets the
+	// target member accept)
+	if err := h.auth.CheckAuthenticated(ctx); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.AcceptProjectOwnershipTransfer(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (h *Handler) CancelProjectOwnershipTransfer(
+	ctx context.Context,
+	req *connect.Request[altalunev1.CancelProjectOwnershipTransferRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	// Authorization: requires authentication (the service only lets the
+	// parties of the transfer cancel or decline it)
+	if err := h.auth.CheckAuthenticated(ctx); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.CancelProjectOwnershipTransfer(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
 func (h *Handler) GetUserProjects(
 	ctx context.Context,
 	req *connect.Request[altalunev1.GetUserProjectsRequest],
@@ -234,3 +303,37 @@ func (h *Handler) GetUserProjects(
 	}
 	return connect.NewResponse(response), nil
 }
+
+// ==================== IAM Snapshots ====================
+
+func (h *Handler) DumpProjectIAM(
+	ctx context.Context,
+	req *connect.Request[altalunev1.DumpProjectIAMRequest],
+) (*connect.Response[altalunev1.DumpProjectIAMResponse], error) {
+	// Authorization: requires iam:read permission and project membership
+	if err := h.auth.CheckProjectAccess(ctx, "iam:read", req.Msg.ProjectId); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.DumpProjectIAM(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (h *Handler) ApplyProjectIAM(
+	ctx context.Context,
+	req *connect.Request[altalunev1.ApplyProjectIAMRequest],
+) (*connect.Response[altalunev1.ApplyProjectIAMResponse], error) {
+	// Authorization: requires iam:write permission and project membership
+	if err := h.auth.CheckProjectAccess(ctx, "iam:write", req.Msg.ProjectId); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.ApplyProjectIAM(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
@@ -3,6 +3,7 @@ package iam_mapper
 import (
 	"context"
 
+	"github.com/hrz8/altalune"
 	"github.com/hrz8/altalune/internal/domain/permission"
 	"github.com/hrz8/altalune/internal/domain/role"
 )
@@ -23,12 +24,37 @@ type Repository interface {
 	AssignUserPermissions(ctx context.Context, userID int64, permissionIDs []int64) error
 	RemoveUserPermissions(ctx context.Context, userID int64, permissionIDs []int64) error
 	GetUserPermissions(ctx context.Context, userID int64) ([]*permission.Permission, error)
+	GetDirectUserPermissions(ctx context.Context, userID int64) ([]*permission.Permission, error)
 
-	// Project Members
-	AssignProjectMembers(ctx context.Context, projectID int64, members []ProjectMemberInput) error
+	// Project Members. New members taking the project over maxMembers (0
+	// being unlimited) are refused with ErrMemberLimitReached; role changes
+	// of existing members are not.
+	AssignProjectMembers(ctx context.Context, projectID int64, members []ProjectMemberInput, maxMembers int) error
 	RemoveProjectMembers(ctx context.Context, projectID int64, userIDs []int64) error
 	GetProjectMembers(ctx context.Context, projectID int64) ([]*ProjectMemberWithUser, error)
 
+	// Project Ownership Transfers
+	CreateOwnershipTransfer(ctx context.Context, input *CreateOwnershipTransferInput) (*OwnershipTransfer, error)
+	GetPendingOwnershipTransfer(ctx context.Context, projectID int64) (*OwnershipTransfer, error)
+	GetOwnershipTransfer(ctx context.Context, publicID string) (*OwnershipTransfer, error)
+	AcceptOwnershipTransfer(ctx context.Context, id int64) error
+	ResolveOwnershipTransfer(ctx context.Context, id int64, status OwnershipTransferStatus) error
+
 	// User Projects (reverse lookup - projects a user belongs to)
 	GetUserProjects(ctx context.Context, userID int64) ([]*UserProjectMembership, error)
+
+	// IAM Snapshots
+	GetProjectSnapshot(ctx context.Context, projectID int64) (*Snapshot, error)
+}
+
+// OrganizationRoleProvider reads the role a user holds in the organization of
+// a project, which grants the project role of the same name
+type OrganizationRoleProvider interface {
+	GetProjectRole(ctx context.Context, projectID int64, userPublicID string) (string, error)
+}
+
+// PlanLimiter provides the billing plan of a project, whose member limit the
+// repository enforces when assigning members
+type PlanLimiter interface {
+	ProjectPlan(ctx context.Context, projectID int64) (altalune.BillingPlan, error)
 }
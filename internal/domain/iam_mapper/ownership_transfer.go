@@ -0,0 +1,112 @@
+package iam_mapper
+
+import (
+	"strings"
+	"time"
+
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ownershipTransferLifetime is how long the target member has to accept a
+// transfer
+const ownershipTransferLifetime = 7 * 24 * time.Hour
+
+// OwnershipTransferStatus is the status of an ownership transfer. Expired is
+// never stored: it is derived from pending transfers past their expiry.
+type OwnershipTransferStatus string
+
+const (
+	OwnershipTransferStatusPending   OwnershipTransferStatus = "pending"
+	OwnershipTransferStatusAccepted  OwnershipTransferStatus = "accepted"
+	OwnershipTransferStatusCancelled OwnershipTransferStatus = "cancelled"
+	OwnershipTransferStatusDeclined  OwnershipTransferStatus = "declined"
+	OwnershipTransferStatusExpired   OwnershipTransferStatus = "expired"
+)
+
+// OwnershipTransferParty is the owner handing a project over, or the member
+// receiving it
+type OwnershipTransferParty struct {
+	ID        int64
+	PublicID  string
+	Email     string
+	FirstName string
+	LastName  string
+}
+
+// Name returns the full name of the party, their email when they have none
+func (p OwnershipTransferParty) Name() string {
+	if name := strings.TrimSpace(p.FirstName + " " + p.LastName); name != "" {
+		return name
+	}
+	return p.Email
+}
+
+// OwnershipTransfer is a request of a project owner to hand the owner role
+// over to another member, effective once that member accepts it
+type OwnershipTransfer struct {
+	ID              int64
+	PublicID        string
+	ProjectID       int64
+	ProjectPublicID string
+	ProjectName     string
+	From            OwnershipTransferParty
+	To              OwnershipTransferParty
+	Status          OwnershipTransferStatus // As stored, see StatusAt
+	ExpiresAt       time.Time
+	CreatedAt       time.Time
+	ResolvedAt      *time.Time
+}
+
+// CreateOwnershipTransferInput contains data for initiating an ownership transfer
+type CreateOwnershipTransferInput struct {
+	ProjectID  int64
+	FromUserID int64
+	ToUserID   int64
+	ExpiresAt  time.Time
+}
+
+// StatusAt returns the status of the transfer at now
+func (t *OwnershipTransfer) StatusAt(now time.Time) OwnershipTransferStatus {
+	if t.Status == OwnershipTransferStatusPending && !t.ExpiresAt.After(now) {
+		return OwnershipTransferStatusExpired
+	}
+	return t.Status
+}
+
+func (s OwnershipTransferStatus) ToProto() altalunev1.OwnershipTransferStatus {
+	switch s {
+	case OwnershipTransferStatusPending:
+		return altalunev1.OwnershipTransferStatus_OWNERSHIP_TRANSFER_STATUS_PENDING
+	case OwnershipTransferStatusAccepted:
+		return altalunev1.OwnershipTransferStatus_OWNERSHIP_TRANSFER_STATUS_ACCEPTED
+	case OwnershipTransferStatusCancelled:
+		return altalunev1.OwnershipTransferStatus_OWNERSHIP_TRANSFER_STATUS_CANCELLED
+	case OwnershipTransferStatusDeclined:
+		return altalunev1.OwnershipTransferStatus_OWNERSHIP_TRANSFER_STATUS_DECLINED
+	case OwnershipTransferStatusExpired:
+		return altalunev1.OwnershipTransferStatus_OWNERSHIP_TRANSFER_STATUS_EXPIRED
+	default:
+		return altalunev1.OwnershipTransferStatus_OWNERSHIP_TRANSFER_STATUS_UNSPECIFIED
+	}
+}
+
+// ToProto converts the transfer to its protobuf message, with its status at now
+func (t *OwnershipTransfer) ToProto(now time.Time) *altalunev1.ProjectOwnershipTransfer {
+	transfer := &altalunev1.ProjectOwnershipTransfer{
+		Id:          t.PublicID,
+		ProjectId:   t.ProjectPublicID,
+		ProjectName: t.ProjectName,
+		FromUserId:  t.From.PublicID,
+		FromEmail:   t.From.Email,
+		ToUserId:    t.To.PublicID,
+		ToEmail:     t.To.Email,
+		Status:      t.StatusAt(now).ToProto(),
+		ExpiresAt:   timestamppb.New(t.ExpiresAt),
+		CreatedAt:   timestamppb.New(t.CreatedAt),
+	}
+	if t.ResolvedAt != nil {
+		transfer.ResolvedAt = timestamppb.New(*t.ResolvedAt)
+	}
+	return transfer
+}
@@ -283,37 +283,103 @@ func (r *Repo) GetUserPermissions(ctx context.Context, userID int64) ([]*permiss
 	return permissions, nil
 }
 
+// GetDirectUserPermissions returns the permissions assigned to the user
+// directly, without the ones of their roles
+func (r *Repo) GetDirectUserPermissions(ctx context.Context, userID int64) ([]*permission.Permission, error) {
+	query := `
+		SELECT p.id, p.public_id, p.name, p.description, p.created_at, p.updated_at
+		FROM altalune_permissions p
+		INNER JOIN altalune_users_permissions up ON up.permission_id = p.id
+		WHERE up.user_id = $1
+		ORDER BY p.name ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get direct user permissions: %w", err)
+	}
+	defer rows.Close()
+
+	var permissions []*permission.Permission
+	for rows.Next() {
+		var result PermissionQueryResult
+		err := rows.Scan(
+			&result.ID,
+			&result.PublicID,
+			&result.Name,
+			&result.Description,
+			&result.CreatedAt,
+			&result.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scan permission: %w", err)
+		}
+		permissions = append(permissions, result.ToPermission())
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return permissions, nil
+}
+
 // ==================== Project Members ====================
 
-func (r *Repo) AssignProjectMembers(ctx context.Context, projectID int64, members []ProjectMemberInput) error {
+// AssignProjectMembers adds the members to the project, or updates their role
+// when they already are. The member limit is checked by the INSERT itself;
+// two assignments racing for the last seats can both get them.
+func (r *Repo) AssignProjectMembers(ctx context.Context, projectID int64, members []ProjectMemberInput, maxMembers int) error {
 	if len(members) == 0 {
 		return nil
 	}
 
-	// Build multi-row INSERT with ON CONFLICT to update role
-	query := `
-		INSERT INTO altalune_project_members (project_id, user_id, role)
-		VALUES `
-
-	args := []interface{}{projectID}
+	args := []interface{}{projectID, maxMembers}
 	placeholders := []string{}
-	argCounter := 2
+	argCounter := 3
 
 	for _, member := range members {
-		placeholders = append(placeholders, fmt.Sprintf("($1, $%d, $%d)", argCounter, argCounter+1))
+		placeholders = append(placeholders, fmt.Sprintf("($%d::bigint, $%d)", argCounter, argCounter+1))
 		args = append(args, member.UserID, member.Role)
 		argCounter += 2
 	}
 
-	query += strings.Join(placeholders, ", ") + `
+	// Build multi-row INSERT with ON CONFLICT to update role, inserting
+	// nothing when the new members do not fit the limit
+	query := `
+		WITH new_members (user_id, role) AS (VALUES ` + strings.Join(placeholders, ", ") + `)
+		INSERT INTO altalune_project_members (project_id, user_id, role)
+		SELECT $1::bigint, user_id, role FROM new_members
+		WHERE $2::int = 0
+		   OR (
+			SELECT COUNT(*) FROM (
+				SELECT user_id FROM altalune_project_members WHERE project_id = $1
+				UNION
+				SELECT user_id FROM new_members
+			) AS after_assign
+		   ) <= $2::int
+		   OR NOT EXISTS (
+			SELECT 1 FROM new_members n
+			WHERE NOT EXISTS (
+				SELECT 1 FROM altalune_project_members m
+				WHERE m.project_id = $1 AND m.user_id = n.user_id
+			)
+		   )
 		ON CONFLICT (project_id, user_id)
 		DO UPDATE SET role = EXCLUDED.role, updated_at = NOW()
 	`
 
-	_, err := r.db.ExecContext(ctx, query, args...)
+	res, err := r.db.ExecContext(ctx, query, args...)
 	if err != nil {
 		return fmt.Errorf("assign project members: %w", err)
 	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("assign project members: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrMemberLimitReached
+	}
 
 	return nil
 }
@@ -376,7 +442,7 @@ func (r *Repo) GetProjectMembers(ctx context.Context, projectID int64) ([]*Proje
 		SELECT
 			u.id as user_id,
 			u.public_id as user_public_id,
-			u.email,
+			COALESCE(u.email, '') as email,
 			u.first_name,
 			u.last_name,
 			u.is_active,
@@ -385,7 +451,7 @@ func (r *Repo) GetProjectMembers(ctx context.Context, projectID int64) ([]*Proje
 			pm.role,
 			pm.created_at
 		FROM altalune_project_members pm
-		INNER JOIN altalune_users u ON u.id = pm.user_id
+		INNER JOIN altalune_users u ON u.id = pm.user_id AND u.deleted_at IS NULL
 		WHERE pm.project_id = $1
 		ORDER BY pm.created_at DESC
 	`
@@ -464,3 +530,106 @@ func (r *Repo) GetUserProjects(ctx context.Context, userID int64) ([]*UserProjec
 
 	return projects, nil
 }
+
+// ==================== IAM Snapshots ====================
+
+// GetProjectSnapshot reads the IAM configuration of a project in a single
+// statement, so the snapshot is consistent even while it is being changed.
+// The Project field is left for the caller to fill.
+func (r *Repo) GetProjectSnapshot(ctx context.Context, projectID int64) (*Snapshot, error) {
+	query := `
+		WITH members AS (
+			SELECT u.id, u.public_id, COALESCE(u.email, '') AS email, pm.role
+			FROM altalune_project_members pm
+			INNER JOIN altalune_users u ON u.id = pm.user_id AND u.deleted_at IS NULL
+			WHERE pm.project_id = $1
+		), member_roles AS (
+			SELECT m.public_id, r.id, r.name, COALESCE(r.description, '') AS description
+			FROM members m
+			INNER JOIN altalune_users_roles ur ON ur.user_id = m.id
+			INNER JOIN altalune_roles r ON r.id = ur.role_id
+		), roles AS (
+			SELECT DISTINCT id, name, description FROM member_roles
+		), role_permissions AS (
+			SELECT r.name AS role_name, p.id, p.name, COALESCE(p.description, '') AS description
+			FROM roles r
+			INNER JOIN altalune_roles_permissions rp ON rp.role_id = r.id
+			INNER JOIN altalune_permissions p ON p.id = rp.permission_id
+		), member_permissions AS (
+			SELECT m.public_id, p.id, p.name, COALESCE(p.description, '') AS description
+			FROM members m
+			INNER JOIN altalune_users_permissions up ON up.user_id = m.id
+			INNER JOIN altalune_permissions p ON p.id = up.permission_id
+		)
+		SELECT 'member', public_id, email, role FROM members
+		UNION ALL
+		SELECT 'member_role', public_id, name, '' FROM member_roles
+		UNION ALL
+		SELECT 'member_permission', public_id, name, '' FROM member_permissions
+		UNION ALL
+		SELECT 'role', name, description, '' FROM roles
+		UNION ALL
+		SELECT 'role_permission', role_name, name, '' FROM role_permissions
+		UNION ALL
+		SELECT 'permission', name, description, '' FROM (
+			SELECT name, description FROM role_permissions
+			UNION
+			SELECT name, description FROM member_permissions
+		) p
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("get project snapshot: %w", err)
+	}
+	defer rows.Close()
+
+	snapshot := &Snapshot{}
+	members := make(map[string]*SnapshotMember)
+	roles := make(map[string]*SnapshotRole)
+	var memberRoles, memberPermissions, rolePermissions [][2]string
+	for rows.Next() {
+		var kind, a, b, c string
+		if err := rows.Scan(&kind, &a, &b, &c); err != nil {
+			return nil, fmt.Errorf("scan project snapshot row: %w", err)
+		}
+		switch kind {
+		case "member":
+			members[a] = &SnapshotMember{User: a, Email: b, Role: c}
+		case "member_role":
+			memberRoles = append(memberRoles, [2]string{a, b})
+		case "member_permission":
+			memberPermissions = append(memberPermissions, [2]string{a, b})
+		case "role":
+			roles[a] = &SnapshotRole{Name: a, Description: b}
+		case "role_permission":
+			rolePermissions = append(rolePermissions, [2]string{a, b})
+		case "permission":
+			snapshot.Permissions = append(snapshot.Permissions, SnapshotPermission{Name: a, Description: b})
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	// Rows come in any order: attach the mappings once every owner is known
+	for _, mr := range memberRoles {
+		members[mr[0]].Roles = append(members[mr[0]].Roles, mr[1])
+	}
+	for _, mp := range memberPermissions {
+		members[mp[0]].Permissions = append(members[mp[0]].Permissions, mp[1])
+	}
+	for _, rp := range rolePermissions {
+		roles[rp[0]].Permissions = append(roles[rp[0]].Permissions, rp[1])
+	}
+	for _, m := range members {
+		snapshot.Members = append(snapshot.Members, *m)
+	}
+	for _, rl := range roles {
+		snapshot.Roles = append(snapshot.Roles, *rl)
+	}
+	snapshot.Sort()
+
+	return snapshot, nil
+}
@@ -0,0 +1,344 @@
+package iam_mapper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hrz8/altalune/internal/domain/iam_mapper"
+	"github.com/hrz8/altalune/internal/domain/permission"
+	"github.com/hrz8/altalune/internal/domain/project"
+	"github.com/hrz8/altalune/internal/domain/role"
+	"github.com/hrz8/altalune/internal/domain/user"
+	"github.com/hrz8/altalune/internal/testdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fixtures create the entities the mappings reference and return their
+// internal IDs
+type fixtures struct {
+	newUser       func(t *testing.T) int64
+	newRole       func(t *testing.T, name string) int64
+	newPermission func(t *testing.T, name string) int64
+	newProject    func(t *testing.T, name string) int64 // Without members
+}
+
+func TestMain(m *testing.M) { testdb.Main(m) }
+
+func TestInMemRepo(t *testing.T) {
+	repo := iam_mapper.NewInMemRepo()
+
+	var lastID int64
+	nextID := func() int64 {
+		lastID++
+		return lastID
+	}
+	testRepoContract(t, repo, fixtures{
+		newUser: func(t *testing.T) int64 {
+			id := nextID()
+			repo.PutUser(id, &user.User{ID: testdb.Token(t), Email: testdb.Token(t) + "@example.com", IsActive: true})
+			return id
+		},
+		newRole: func(t *testing.T, name string) int64 {
+			id := nextID()
+			repo.PutRole(id, &role.Role{ID: testdb.Token(t), Name: name})
+			return id
+		},
+		newPermission: func(t *testing.T, name string) int64 {
+			id := nextID()
+			repo.PutPermission(id, &permission.Permission{ID: testdb.Token(t), Name: name})
+			return id
+		},
+		newProject: func(t *testing.T, name string) int64 {
+			id := nextID()
+			repo.PutProject(id, testdb.Token(t), name)
+			return id
+		},
+	})
+}
+
+func TestRepo(t *testing.T) {
+	db := testdb.Open(t)
+	ctx := context.Background()
+	users, roles, permissions, projects := user.NewRepo(db), role.NewRepo(db), permission.NewRepo(db), project.NewRepo(db)
+
+	testRepoContract(t, iam_mapper.NewRepo(db), fixtures{
+		newUser: func(t *testing.T) int64 {
+			created, err := users.Create(ctx, &user.CreateUserInput{Email: testdb.Token(t) + "@example.com"})
+			require.NoError(t, err)
+			return created.ID
+		},
+		newRole: func(t *testing.T, name string) int64 {
+			created, err := roles.Create(ctx, &role.CreateRoleInput{Name: name})
+			require.NoError(t, err)
+			return created.ID
+		},
+		newPermission: func(t *testing.T, name string) int64 {
+			created, err := permissions.Create(ctx, &permission.CreatePermissionInput{Name: name})
+			require.NoError(t, err)
+			return created.ID
+		},
+		newProject: func(t *testing.T, name string) int64 {
+			created, err := projects.Create(ctx, &project.CreateProjectInput{
+				Name:        name,
+				Timezone:    "UTC",
+				Environment: project.EnvironmentStatusSandbox,
+			})
+			require.NoError(t, err)
+			// New projects are owned by the superadmin once one exists
+			_, err = db.ExecContext(ctx, "DELETE FROM altalune_project_members WHERE project_id = $1", created.ID)
+			require.NoError(t, err)
+			return created.ID
+		},
+	})
+}
+
+func names[T any](items []*T, name func(*T) string) []string {
+	result := make([]string, 0, len(items))
+	for _, item := range items {
+		result = append(result, name(item))
+	}
+	return result
+}
+
+func roleName(r *role.Role) string                   { return r.Name }
+func permissionName(p *permission.Permission) string { return p.Name }
+
+// testRepoContract runs the behavior every iam_mapper.Repository must share
+// against repo
+func testRepoContract(t *testing.T, repo iam_mapper.Repository, f fixtures) {
+	ctx := context.Background()
+
+	t.Run("user roles", func(t *testing.T) {
+		tok := testdb.Token(t)
+		userID := f.newUser(t)
+		editor, viewer := f.newRole(t, "b-"+tok), f.newRole(t, "a-"+tok)
+
+		require.NoError(t, repo.AssignUserRoles(ctx, userID, []int64{editor, viewer}))
+		require.NoError(t, repo.AssignUserRoles(ctx, userID, []int64{editor}), "assigning twice is a no-op")
+		require.NoError(t, repo.AssignUserRoles(ctx, userID, nil))
+
+		roles, err := repo.GetUserRoles(ctx, userID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a-" + tok, "b-" + tok}, names(roles, roleName), "roles are sorted by name")
+
+		require.NoError(t, repo.RemoveUserRoles(ctx, userID, []int64{viewer}))
+		roles, err = repo.GetUserRoles(ctx, userID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"b-" + tok}, names(roles, roleName))
+
+		assert.Error(t, repo.AssignUserRoles(ctx, userID, []int64{-1}), "roles must exist")
+	})
+
+	t.Run("permissions", func(t *testing.T) {
+		tok := testdb.Token(t)
+		userID := f.newUser(t)
+		roleID := f.newRole(t, "role-"+tok)
+		read, write, admin := f.newPermission(t, tok+":read"), f.newPermission(t, tok+":write"), f.newPermission(t, tok+":admin")
+
+		require.NoError(t, repo.AssignRolePermissions(ctx, roleID, []int64{read, write}))
+		require.NoError(t, repo.AssignRolePermissions(ctx, roleID, []int64{read}))
+		rolePermissions, err := repo.GetRolePermissions(ctx, roleID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{tok + ":read", tok + ":write"}, names(rolePermissions, permissionName))
+
+		require.NoError(t, repo.AssignUserRoles(ctx, userID, []int64{roleID}))
+		require.NoError(t, repo.AssignUserPermissions(ctx, userID, []int64{admin, read}))
+
+		userPermissions, err := repo.GetUserPermissions(ctx, userID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{tok + ":admin", tok + ":read", tok + ":write"}, names(userPermissions, permissionName),
+			"direct and role permissions are merged without duplicates")
+
+		directPermissions, err := repo.GetDirectUserPermissions(ctx, userID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{tok + ":admin", tok + ":read"}, names(directPermissions, permissionName))
+
+		require.NoError(t, repo.RemoveUserPermissions(ctx, userID, []int64{admin, read}))
+		require.NoError(t, repo.RemoveRolePermissions(ctx, roleID, []int64{write}))
+		userPermissions, err = repo.GetUserPermissions(ctx, userID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{tok + ":read"}, names(userPermissions, permissionName))
+
+		assert.Error(t, repo.AssignUserPermissions(ctx, userID, []int64{-1}), "permissions must exist")
+	})
+
+	t.Run("project members", func(t *testing.T) {
+		name := "Members " + testdb.Token(t)
+		projectID := f.newProject(t, name)
+		owner, member := f.newUser(t), f.newUser(t)
+
+		require.NoError(t, repo.AssignProjectMembers(ctx, projectID, []iam_mapper.ProjectMemberInput{
+			{UserID: owner, Role: "owner"},
+			{UserID: member, Role: "member"},
+		}, 0))
+		require.NoError(t, repo.AssignProjectMembers(ctx, projectID, []iam_mapper.ProjectMemberInput{
+			{UserID: member, Role: "admin"},
+		}, 0), "assigning a member again updates their role")
+
+		members, err := repo.GetProjectMembers(ctx, projectID)
+		require.NoError(t, err)
+		roles := make([]string, 0, len(members))
+		for _, m := range members {
+			roles = append(roles, m.Role)
+		}
+		assert.ElementsMatch(t, []string{"owner", "admin"}, roles)
+
+		extra := f.newUser(t)
+		assert.ErrorIs(t, repo.AssignProjectMembers(ctx, projectID, []iam_mapper.ProjectMemberInput{
+			{UserID: extra, Role: "member"},
+		}, 2), iam_mapper.ErrMemberLimitReached)
+		require.NoError(t, repo.AssignProjectMembers(ctx, projectID, []iam_mapper.ProjectMemberInput{
+			{UserID: member, Role: "admin"},
+		}, 2), "role changes pass the member limit")
+
+		memberships, err := repo.GetUserProjects(ctx, member)
+		require.NoError(t, err)
+		require.Len(t, memberships, 1)
+		assert.Equal(t, name, memberships[0].ProjectName)
+		assert.Equal(t, "admin", memberships[0].Role)
+
+		assert.ErrorIs(t, repo.RemoveProjectMembers(ctx, projectID, []int64{owner, member}), iam_mapper.ErrCannotRemoveLastOwner)
+		require.NoError(t, repo.RemoveProjectMembers(ctx, projectID, []int64{member}))
+		members, err = repo.GetProjectMembers(ctx, projectID)
+		require.NoError(t, err)
+		require.Len(t, members, 1)
+		assert.Equal(t, "owner", members[0].Role)
+
+		memberships, err = repo.GetUserProjects(ctx, member)
+		require.NoError(t, err)
+		assert.Empty(t, memberships)
+	})
+
+	t.Run("ownership transfers", func(t *testing.T) {
+		projectID := f.newProject(t, "Transfer "+testdb.Token(t))
+		owner, admin, member := f.newUser(t), f.newUser(t), f.newUser(t)
+		require.NoError(t, repo.AssignProjectMembers(ctx, projectID, []iam_mapper.ProjectMemberInput{
+			{UserID: owner, Role: "owner"},
+			{UserID: admin, Role: "admin"},
+			{UserID: member, Role: "member"},
+		}, 0))
+		roles := func() map[string]string {
+			members, err := repo.GetProjectMembers(ctx, projectID)
+			require.NoError(t, err)
+			byUser := make(map[string]string, len(members))
+			for _, m := range members {
+				byUser[m.User.ID] = m.Role
+			}
+			return byUser
+		}
+		expiresAt := time.Now().Add(time.Hour)
+
+		first, err := repo.CreateOwnershipTransfer(ctx, &iam_mapper.CreateOwnershipTransferInput{
+			ProjectID: projectID, FromUserID: owner, ToUserID: admin, ExpiresAt: expiresAt,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, iam_mapper.OwnershipTransferStatusPending, first.Status)
+		assert.NotEmpty(t, first.PublicID)
+		assert.NotEmpty(t, first.From.Email)
+
+		second, err := repo.CreateOwnershipTransfer(ctx, &iam_mapper.CreateOwnershipTransferInput{
+			ProjectID: projectID, FromUserID: owner, ToUserID: member, ExpiresAt: expiresAt,
+		})
+		require.NoError(t, err)
+
+		replaced, err := repo.GetOwnershipTransfer(ctx, first.PublicID)
+		require.NoError(t, err)
+		assert.Equal(t, iam_mapper.OwnershipTransferStatusCancelled, replaced.Status, "a new transfer replaces the pending one")
+		assert.NotNil(t, replaced.ResolvedAt)
+		assert.ErrorIs(t, repo.AcceptOwnershipTransfer(ctx, first.ID), iam_mapper.ErrOwnershipTransferNotFound)
+
+		pending, err := repo.GetPendingOwnershipTransfer(ctx, projectID)
+		require.NoError(t, err)
+		assert.Equal(t, second.PublicID, pending.PublicID)
+		assert.Equal(t, "member", roles()[pending.To.PublicID])
+
+		require.NoError(t, repo.AcceptOwnershipTransfer(ctx, second.ID))
+		assert.ErrorIs(t, repo.AcceptOwnershipTransfer(ctx, second.ID), iam_mapper.ErrOwnershipTransferNotFound, "a transfer is accepted once")
+		current := roles()
+		assert.Equal(t, "owner", current[second.To.PublicID])
+		assert.Equal(t, "member", current[second.From.PublicID], "the roles of the parties are swapped")
+
+		_, err = repo.GetPendingOwnershipTransfer(ctx, projectID)
+		assert.ErrorIs(t, err, iam_mapper.ErrOwnershipTransferNotFound)
+
+		// The former owner can no longer hand the project over
+		stale, err := repo.CreateOwnershipTransfer(ctx, &iam_mapper.CreateOwnershipTransferInput{
+			ProjectID: projectID, FromUserID: owner, ToUserID: admin, ExpiresAt: expiresAt,
+		})
+		require.NoError(t, err)
+		assert.ErrorIs(t, repo.AcceptOwnershipTransfer(ctx, stale.ID), iam_mapper.ErrOwnershipTransferNotFound)
+
+		require.NoError(t, repo.ResolveOwnershipTransfer(ctx, stale.ID, iam_mapper.OwnershipTransferStatusDeclined))
+		assert.ErrorIs(t, repo.ResolveOwnershipTransfer(ctx, stale.ID, iam_mapper.OwnershipTransferStatusCancelled),
+			iam_mapper.ErrOwnershipTransferNotFound, "only pending transfers are resolved")
+		declined, err := repo.GetOwnershipTransfer(ctx, stale.PublicID)
+		require.NoError(t, err)
+		assert.Equal(t, iam_mapper.OwnershipTransferStatusDeclined, declined.Status)
+
+		_, err = repo.GetOwnershipTransfer(ctx, "unknown")
+		assert.ErrorIs(t, err, iam_mapper.ErrOwnershipTransferNotFound)
+	})
+
+	t.Run("re-initiated ownership transfer", func(t *testing.T) {
+		projectID := f.newProject(t, "Reinitiate "+testdb.Token(t))
+		owner, admin := f.newUser(t), f.newUser(t)
+		require.NoError(t, repo.AssignProjectMembers(ctx, projectID, []iam_mapper.ProjectMemberInput{
+			{UserID: owner, Role: "owner"},
+			{UserID: admin, Role: "admin"},
+		}, 0))
+		input := &iam_mapper.CreateOwnershipTransferInput{
+			ProjectID: projectID, FromUserID: owner, ToUserID: admin, ExpiresAt: time.Now().Add(time.Hour),
+		}
+
+		first, err := repo.CreateOwnershipTransfer(ctx, input)
+		require.NoError(t, err)
+		second, err := repo.CreateOwnershipTransfer(ctx, input)
+		require.NoError(t, err, "initiating a transfer while one is pending replaces it")
+		assert.NotEqual(t, first.PublicID, second.PublicID)
+
+		replaced, err := repo.GetOwnershipTransfer(ctx, first.PublicID)
+		require.NoError(t, err)
+		assert.Equal(t, iam_mapper.OwnershipTransferStatusCancelled, replaced.Status)
+
+		pending, err := repo.GetPendingOwnershipTransfer(ctx, projectID)
+		require.NoError(t, err)
+		assert.Equal(t, second.PublicID, pending.PublicID)
+	})
+
+	t.Run("project snapshot", func(t *testing.T) {
+		tok := testdb.Token(t)
+		projectID := f.newProject(t, "Snapshot "+tok)
+		owner, member, outsider := f.newUser(t), f.newUser(t), f.newUser(t)
+		editor, unused := f.newRole(t, "editor-"+tok), f.newRole(t, "unused-"+tok)
+		read, write, admin := f.newPermission(t, tok+":read"), f.newPermission(t, tok+":write"), f.newPermission(t, tok+":admin")
+
+		require.NoError(t, repo.AssignProjectMembers(ctx, projectID, []iam_mapper.ProjectMemberInput{
+			{UserID: owner, Role: "owner"},
+			{UserID: member, Role: "member"},
+		}, 0))
+		require.NoError(t, repo.AssignRolePermissions(ctx, editor, []int64{write, read}))
+		require.NoError(t, repo.AssignRolePermissions(ctx, unused, []int64{admin}))
+		require.NoError(t, repo.AssignUserRoles(ctx, member, []int64{editor}))
+		require.NoError(t, repo.AssignUserRoles(ctx, outsider, []int64{unused}))
+		require.NoError(t, repo.AssignUserPermissions(ctx, owner, []int64{read}))
+
+		snapshot, err := repo.GetProjectSnapshot(ctx, projectID)
+		require.NoError(t, err)
+		assert.Equal(t, []iam_mapper.SnapshotPermission{{Name: tok + ":read"}, {Name: tok + ":write"}}, snapshot.Permissions,
+			"only the permissions members reach are listed")
+		assert.Equal(t, []iam_mapper.SnapshotRole{{Name: "editor-" + tok, Permissions: []string{tok + ":read", tok + ":write"}}}, snapshot.Roles)
+
+		require.Len(t, snapshot.Members, 2)
+		byRole := make(map[string]iam_mapper.SnapshotMember)
+		for _, m := range snapshot.Members {
+			assert.NotEmpty(t, m.User)
+			byRole[m.Role] = m
+		}
+		assert.Equal(t, []string{tok + ":read"}, byRole["owner"].Permissions)
+		assert.Empty(t, byRole["owner"].Roles)
+		assert.Equal(t, []string{"editor-" + tok}, byRole["member"].Roles)
+		assert.NoError(t, snapshot.Validate())
+	})
+}
@@ -0,0 +1,599 @@
+package iam_mapper
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/hrz8/altalune/internal/domain/permission"
+	"github.com/hrz8/altalune/internal/domain/role"
+	"github.com/hrz8/altalune/internal/domain/user"
+	"github.com/hrz8/altalune/internal/shared/nanoid"
+)
+
+// inMemProject is the part of a project the mappings expose
+type inMemProject struct {
+	PublicID string
+	Name     string
+}
+
+// InMemRepo is an in-memory Repository for tests. Users, roles, permissions
+// and projects live in their own domains, so tests seed the ones they need
+// with PutUser, PutRole, PutPermission and PutProject, keyed by internal ID.
+// Assigning unseeded entities fails, like the foreign keys of Repo do.
+type InMemRepo struct {
+	mu sync.RWMutex
+
+	users       map[int64]*user.User
+	roles       map[int64]*role.Role
+	permissions map[int64]*permission.Permission
+	projects    map[int64]inMemProject
+
+	userRoles       map[[2]int64]struct{} // {userID, roleID}
+	rolePermissions map[[2]int64]struct{} // {roleID, permissionID}
+	userPermissions map[[2]int64]struct{} // {userID, permissionID}
+	members         []*ProjectMemberDB    // In insertion order
+	lastMemberID    int64
+	transfers       []*OwnershipTransfer // Parties and project hold internal IDs only
+	lastTransferID  int64
+}
+
+var _ Repository = (*InMemRepo)(nil)
+
+// NewInMemRepo creates an empty in-memory IAM mapping repository
+func NewInMemRepo() *InMemRepo {
+	return &InMemRepo{
+		users:           make(map[int64]*user.User),
+		roles:           make(map[int64]*role.Role),
+		permissions:     make(map[int64]*permission.Permission),
+		projects:        make(map[int64]inMemProject),
+		userRoles:       make(map[[2]int64]struct{}),
+		rolePermissions: make(map[[2]int64]struct{}),
+		userPermissions: make(map[[2]int64]struct{}),
+	}
+}
+
+// PutUser adds or replaces the user with internal ID id
+func (r *InMemRepo) PutUser(id int64, u *user.User) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored := *u
+	r.users[id] = &stored
+}
+
+// PutRole adds or replaces the role with internal ID id
+func (r *InMemRepo) PutRole(id int64, rl *role.Role) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored := *rl
+	r.roles[id] = &stored
+}
+
+// PutPermission adds or replaces the permission with internal ID id
+func (r *InMemRepo) PutPermission(id int64, p *permission.Permission) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored := *p
+	r.permissions[id] = &stored
+}
+
+// PutProject adds or replaces the project with internal ID id
+func (r *InMemRepo) PutProject(id int64, publicID, name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.projects[id] = inMemProject{PublicID: publicID, Name: name}
+}
+
+// assign adds the {ownerID, id} pairs to mappings once all ids are seeded in
+// targets, leaving existing pairs untouched
+func assign[T any](mappings map[[2]int64]struct{}, ownerID int64, ids []int64, targets map[int64]T, notFound error) error {
+	for _, id := range ids {
+		if _, ok := targets[id]; !ok {
+			return notFound
+		}
+	}
+	for _, id := range ids {
+		mappings[[2]int64{ownerID, id}] = struct{}{}
+	}
+	return nil
+}
+
+// mapped returns the targets mapped to ownerID, sorted by name
+func mapped[T any](mappings map[[2]int64]struct{}, ownerID int64, targets map[int64]*T, name func(*T) string) []*T {
+	var found []*T
+	for pair := range mappings {
+		if pair[0] == ownerID {
+			found = append(found, targets[pair[1]])
+		}
+	}
+	slices.SortFunc(found, func(a, b *T) int { return cmp.Compare(name(a), name(b)) })
+	return found
+}
+
+func roleName(rl *role.Role) string                  { return rl.Name }
+func permissionName(p *permission.Permission) string { return p.Name }
+
+// toRole drops the audit columns the JOIN queries of Repo do not select
+func toRole(rl *role.Role) *role.Role {
+	return &role.Role{ID: rl.ID, Name: rl.Name, Description: rl.Description, CreatedAt: rl.CreatedAt, UpdatedAt: rl.UpdatedAt}
+}
+
+// toPermission drops the audit columns the JOIN queries of Repo do not select
+func toPermission(p *permission.Permission) *permission.Permission {
+	return &permission.Permission{ID: p.ID, Name: p.Name, Description: p.Description, CreatedAt: p.CreatedAt, UpdatedAt: p.UpdatedAt}
+}
+
+// ==================== User-Role Mappings ====================
+
+func (r *InMemRepo) AssignUserRoles(ctx context.Context, userID int64, roleIDs []int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(roleIDs) == 0 {
+		return nil
+	}
+	if _, ok := r.users[userID]; !ok {
+		return fmt.Errorf("assign user roles: %w", ErrUserNotFound)
+	}
+	if err := assign(r.userRoles, userID, roleIDs, r.roles, ErrRoleNotFound); err != nil {
+		return fmt.Errorf("assign user roles: %w", err)
+	}
+	return nil
+}
+
+func (r *InMemRepo) RemoveUserRoles(ctx context.Context, userID int64, roleIDs []int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, roleID := range roleIDs {
+		delete(r.userRoles, [2]int64{userID, roleID})
+	}
+	return nil
+}
+
+func (r *InMemRepo) GetUserRoles(ctx context.Context, userID int64) ([]*role.Role, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var roles []*role.Role
+	for _, rl := range mapped(r.userRoles, userID, r.roles, roleName) {
+		roles = append(roles, toRole(rl))
+	}
+	return roles, nil
+}
+
+// ==================== Role-Permission Mappings ====================
+
+func (r *InMemRepo) AssignRolePermissions(ctx context.Context, roleID int64, permissionIDs []int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(permissionIDs) == 0 {
+		return nil
+	}
+	if _, ok := r.roles[roleID]; !ok {
+		return fmt.Errorf("assign role permissions: %w", ErrRoleNotFound)
+	}
+	if err := assign(r.rolePermissions, roleID, permissionIDs, r.permissions, ErrPermissionNotFound); err != nil {
+		return fmt.Errorf("assign role permissions: %w", err)
+	}
+	return nil
+}
+
+func (r *InMemRepo) RemoveRolePermissions(ctx context.Context, roleID int64, permissionIDs []int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, permissionID := range permissionIDs {
+		delete(r.rolePermissions, [2]int64{roleID, permissionID})
+	}
+	return nil
+}
+
+func (r *InMemRepo) GetRolePermissions(ctx context.Context, roleID int64) ([]*permission.Permission, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var permissions []*permission.Permission
+	for _, p := range mapped(r.rolePermissions, roleID, r.permissions, permissionName) {
+		permissions = append(permissions, toPermission(p))
+	}
+	return permissions, nil
+}
+
+// ==================== User-Permission Mappings (Direct) ====================
+
+func (r *InMemRepo) AssignUserPermissions(ctx context.Context, userID int64, permissionIDs []int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(permissionIDs) == 0 {
+		return nil
+	}
+	if _, ok := r.users[userID]; !ok {
+		return fmt.Errorf("assign user permissions: %w", ErrUserNotFound)
+	}
+	if err := assign(r.userPermissions, userID, permissionIDs, r.permissions, ErrPermissionNotFound); err != nil {
+		return fmt.Errorf("assign user permissions: %w", err)
+	}
+	return nil
+}
+
+func (r *InMemRepo) RemoveUserPermissions(ctx context.Context, userID int64, permissionIDs []int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, permissionID := range permissionIDs {
+		delete(r.userPermissions, [2]int64{userID, permissionID})
+	}
+	return nil
+}
+
+// GetUserPermissions returns the direct permissions of the user along with
+// the permissions of their roles, without duplicates
+func (r *InMemRepo) GetUserPermissions(ctx context.Context, userID int64) ([]*permission.Permission, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ids := make(map[int64]struct{})
+	for pair := range r.userPermissions {
+		if pair[0] == userID {
+			ids[pair[1]] = struct{}{}
+		}
+	}
+	for userRole := range r.userRoles {
+		if userRole[0] != userID {
+			continue
+		}
+		for rolePermission := range r.rolePermissions {
+			if rolePermission[0] == userRole[1] {
+				ids[rolePermission[1]] = struct{}{}
+			}
+		}
+	}
+
+	var permissions []*permission.Permission
+	for id := range ids {
+		permissions = append(permissions, toPermission(r.permissions[id]))
+	}
+	slices.SortFunc(permissions, func(a, b *permission.Permission) int { return cmp.Compare(a.Name, b.Name) })
+	return permissions, nil
+}
+
+func (r *InMemRepo) GetDirectUserPermissions(ctx context.Context, userID int64) ([]*permission.Permission, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var permissions []*permission.Permission
+	for _, p := range mapped(r.userPermissions, userID, r.permissions, permissionName) {
+		permissions = append(permissions, toPermission(p))
+	}
+	return permissions, nil
+}
+
+// ==================== Project Members ====================
+
+func (r *InMemRepo) findMember(projectID, userID int64) *ProjectMemberDB {
+	for _, m := range r.members {
+		if m.ProjectID == projectID && m.UserID == userID {
+			return m
+		}
+	}
+	return nil
+}
+
+func (r *InMemRepo) AssignProjectMembers(ctx context.Context, projectID int64, members []ProjectMemberInput, maxMembers int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(members) == 0 {
+		return nil
+	}
+	if _, ok := r.projects[projectID]; !ok {
+		return fmt.Errorf("assign project members: %w", ErrProjectNotFound)
+	}
+	for _, member := range members {
+		if _, ok := r.users[member.UserID]; !ok {
+			return fmt.Errorf("assign project members: %w", ErrUserNotFound)
+		}
+	}
+	if maxMembers > 0 {
+		current := 0
+		for _, m := range r.members {
+			if m.ProjectID == projectID {
+				current++
+			}
+		}
+		added := make(map[int64]bool)
+		for _, member := range members {
+			if r.findMember(projectID, member.UserID) == nil {
+				added[member.UserID] = true
+			}
+		}
+		if after := current + len(added); after > current && after > maxMembers {
+			return ErrMemberLimitReached
+		}
+	}
+
+	now := time.Now()
+	for _, member := range members {
+		if m := r.findMember(projectID, member.UserID); m != nil {
+			m.Role = member.Role
+			m.UpdatedAt = now
+			continue
+		}
+		r.lastMemberID++
+		r.members = append(r.members, &ProjectMemberDB{
+			ID:        r.lastMemberID,
+			ProjectID: projectID,
+			UserID:    member.UserID,
+			Role:      member.Role,
+			CreatedAt: now,
+			UpdatedAt: now,
+		})
+	}
+	return nil
+}
+
+func (r *InMemRepo) RemoveProjectMembers(ctx context.Context, projectID int64, userIDs []int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	removed := func(m *ProjectMemberDB) bool {
+		return m.ProjectID == projectID && slices.Contains(userIDs, m.UserID)
+	}
+
+	ownersToRemove, totalOwners := 0, 0
+	for _, m := range r.members {
+		if m.ProjectID != projectID || m.Role != "owner" {
+			continue
+		}
+		totalOwners++
+		if removed(m) {
+			ownersToRemove++
+		}
+	}
+	if ownersToRemove > 0 && totalOwners-ownersToRemove < 1 {
+		return ErrCannotRemoveLastOwner
+	}
+
+	r.members = slices.DeleteFunc(r.members, removed)
+	return nil
+}
+
+// newestFirst orders rows by created, newest first, keeping later inserts
+// ahead on ties
+func newestFirst[T any](rows []*T, created func(*T) time.Time) {
+	slices.Reverse(rows)
+	slices.SortStableFunc(rows, func(a, b *T) int { return created(b).Compare(created(a)) })
+}
+
+func (r *InMemRepo) GetProjectMembers(ctx context.Context, projectID int64) ([]*ProjectMemberWithUser, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var members []*ProjectMemberWithUser
+	for _, m := range r.members {
+		u, ok := r.users[m.UserID]
+		if m.ProjectID != projectID || !ok || u.DeletedAt != nil {
+			continue
+		}
+		members = append(members, &ProjectMemberWithUser{
+			User: &user.User{
+				ID:        u.ID,
+				Email:     u.Email,
+				FirstName: u.FirstName,
+				LastName:  u.LastName,
+				IsActive:  u.IsActive,
+				CreatedAt: u.CreatedAt,
+				UpdatedAt: u.UpdatedAt,
+			},
+			Role:      m.Role,
+			CreatedAt: m.CreatedAt,
+		})
+	}
+	newestFirst(members, func(m *ProjectMemberWithUser) time.Time { return m.CreatedAt })
+	return members, nil
+}
+
+func (r *InMemRepo) GetUserProjects(ctx context.Context, userID int64) ([]*UserProjectMembership, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var projects []*UserProjectMembership
+	for _, m := range r.members {
+		p, ok := r.projects[m.ProjectID]
+		if m.UserID != userID || !ok {
+			continue
+		}
+		projects = append(projects, &UserProjectMembership{
+			ProjectID:   p.PublicID,
+			ProjectName: p.Name,
+			Role:        m.Role,
+			JoinedAt:    m.CreatedAt,
+		})
+	}
+	newestFirst(projects, func(p *UserProjectMembership) time.Time { return p.JoinedAt })
+	return projects, nil
+}
+
+func (r *InMemRepo) GetProjectSnapshot(ctx context.Context, projectID int64) (*Snapshot, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	snapshot := &Snapshot{}
+	roles := make(map[int64]bool)
+	permissions := make(map[int64]bool)
+	for _, m := range r.members {
+		u, ok := r.users[m.UserID]
+		if m.ProjectID != projectID || !ok || u.DeletedAt != nil {
+			continue
+		}
+		member := SnapshotMember{User: u.ID, Email: u.Email, Role: m.Role}
+		for key := range r.userRoles {
+			if key[0] == m.UserID {
+				member.Roles = append(member.Roles, r.roles[key[1]].Name)
+				roles[key[1]] = true
+			}
+		}
+		for key := range r.userPermissions {
+			if key[0] == m.UserID {
+				member.Permissions = append(member.Permissions, r.permissions[key[1]].Name)
+				permissions[key[1]] = true
+			}
+		}
+		snapshot.Members = append(snapshot.Members, member)
+	}
+
+	for roleID := range roles {
+		rl := r.roles[roleID]
+		snapshotRole := SnapshotRole{Name: rl.Name, Description: rl.Description}
+		for key := range r.rolePermissions {
+			if key[0] == roleID {
+				snapshotRole.Permissions = append(snapshotRole.Permissions, r.permissions[key[1]].Name)
+				permissions[key[1]] = true
+			}
+		}
+		snapshot.Roles = append(snapshot.Roles, snapshotRole)
+	}
+	for permissionID := range permissions {
+		p := r.permissions[permissionID]
+		snapshot.Permissions = append(snapshot.Permissions, SnapshotPermission{Name: p.Name, Description: p.Description})
+	}
+	snapshot.Sort()
+
+	return snapshot, nil
+}
+
+// ==================== Project Ownership Transfers ====================
+
+// withParties returns a copy of a stored transfer completed with the project
+// and the users it references, like the joins of Repo
+func (r *InMemRepo) withParties(t *OwnershipTransfer) *OwnershipTransfer {
+	found := *t
+	p := r.projects[t.ProjectID]
+	found.ProjectPublicID, found.ProjectName = p.PublicID, p.Name
+	for _, party := range []*OwnershipTransferParty{&found.From, &found.To} {
+		if u, ok := r.users[party.ID]; ok {
+			party.PublicID, party.Email, party.FirstName, party.LastName = u.ID, u.Email, u.FirstName, u.LastName
+		}
+	}
+	return &found
+}
+
+func (r *InMemRepo) CreateOwnershipTransfer(ctx context.Context, input *CreateOwnershipTransferInput) (*OwnershipTransfer, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.projects[input.ProjectID]; !ok {
+		return nil, fmt.Errorf("create ownership transfer: %w", ErrProjectNotFound)
+	}
+	for _, userID := range []int64{input.FromUserID, input.ToUserID} {
+		if _, ok := r.users[userID]; !ok {
+			return nil, fmt.Errorf("create ownership transfer: %w", ErrUserNotFound)
+		}
+	}
+	if input.FromUserID == input.ToUserID {
+		return nil, fmt.Errorf("create ownership transfer: users must differ")
+	}
+
+	publicID, err := nanoid.GeneratePublicID()
+	if err != nil {
+		return nil, fmt.Errorf("generate public_id: %w", err)
+	}
+
+	now := time.Now()
+	for _, t := range r.transfers {
+		if t.ProjectID == input.ProjectID && t.Status == OwnershipTransferStatusPending {
+			t.Status = OwnershipTransferStatusCancelled
+			t.ResolvedAt = &now
+		}
+	}
+
+	r.lastTransferID++
+	transfer := &OwnershipTransfer{
+		ID:        r.lastTransferID,
+		PublicID:  publicID,
+		ProjectID: input.ProjectID,
+		From:      OwnershipTransferParty{ID: input.FromUserID},
+		To:        OwnershipTransferParty{ID: input.ToUserID},
+		Status:    OwnershipTransferStatusPending,
+		ExpiresAt: input.ExpiresAt,
+		CreatedAt: now,
+	}
+	r.transfers = append(r.transfers, transfer)
+	return r.withParties(transfer), nil
+}
+
+func (r *InMemRepo) GetPendingOwnershipTransfer(ctx context.Context, projectID int64) (*OwnershipTransfer, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	now := time.Now()
+	for _, t := range r.transfers {
+		if t.ProjectID == projectID && t.StatusAt(now) == OwnershipTransferStatusPending {
+			return r.withParties(t), nil
+		}
+	}
+	return nil, ErrOwnershipTransferNotFound
+}
+
+func (r *InMemRepo) GetOwnershipTransfer(ctx context.Context, publicID string) (*OwnershipTransfer, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, t := range r.transfers {
+		if t.PublicID == publicID {
+			return r.withParties(t), nil
+		}
+	}
+	return nil, ErrOwnershipTransferNotFound
+}
+
+func (r *InMemRepo) AcceptOwnershipTransfer(ctx context.Context, id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	for _, t := range r.transfers {
+		if t.ID != id || t.StatusAt(now) != OwnershipTransferStatusPending {
+			continue
+		}
+		from, to := r.findMember(t.ProjectID, t.From.ID), r.findMember(t.ProjectID, t.To.ID)
+		if from == nil || from.Role != ProjectRoleOwner || to == nil || to.Role == ProjectRoleOwner {
+			return ErrOwnershipTransferNotFound
+		}
+		from.Role, to.Role = to.Role, ProjectRoleOwner
+		from.UpdatedAt, to.UpdatedAt = now, now
+		t.Status = OwnershipTransferStatusAccepted
+		t.ResolvedAt = &now
+		return nil
+	}
+	return ErrOwnershipTransferNotFound
+}
+
+func (r *InMemRepo) ResolveOwnershipTransfer(ctx context.Context, id int64, status OwnershipTransferStatus) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, t := range r.transfers {
+		if t.ID == id && t.Status == OwnershipTransferStatusPending {
+			now := time.Now()
+			t.Status = status
+			t.ResolvedAt = &now
+			return nil
+		}
+	}
+	return ErrOwnershipTransferNotFound
+}
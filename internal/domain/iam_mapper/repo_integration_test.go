@@ -0,0 +1,46 @@
+package iam_mapper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hrz8/altalune/internal/domain/iam_mapper"
+	"github.com/hrz8/altalune/internal/domain/permission"
+	"github.com/hrz8/altalune/internal/domain/role"
+	"github.com/hrz8/altalune/internal/testdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepoIntegration(t *testing.T) {
+	ctx := context.Background()
+	db := testdb.Tx(t)
+	fixtures := testdb.Seed(t, db)
+	repo := iam_mapper.NewRepo(db)
+
+	members, err := repo.GetProjectMembers(ctx, fixtures.ProjectID)
+	require.NoError(t, err)
+	require.Len(t, members, 1)
+	assert.Equal(t, fixtures.UserPublicID, members[0].User.ID)
+	assert.Equal(t, "owner", members[0].Role)
+
+	projects, err := repo.GetUserProjects(ctx, fixtures.UserID)
+	require.NoError(t, err)
+	require.Len(t, projects, 1)
+	assert.Equal(t, fixtures.ProjectPublicID, projects[0].ProjectID)
+
+	assert.ErrorIs(t, repo.RemoveProjectMembers(ctx, fixtures.ProjectID, []int64{fixtures.UserID}), iam_mapper.ErrCannotRemoveLastOwner)
+
+	tok := testdb.Token(t)
+	createdRole, err := role.NewRepo(db).Create(ctx, &role.CreateRoleInput{Name: "integration-" + tok})
+	require.NoError(t, err)
+	createdPermission, err := permission.NewRepo(db).Create(ctx, &permission.CreatePermissionInput{Name: tok + ":read"})
+	require.NoError(t, err)
+
+	require.NoError(t, repo.AssignRolePermissions(ctx, createdRole.ID, []int64{createdPermission.ID}))
+	require.NoError(t, repo.AssignUserRoles(ctx, fixtures.UserID, []int64{createdRole.ID}))
+
+	permissions, err := repo.GetUserPermissions(ctx, fixtures.UserID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{tok + ":read"}, names(permissions, permissionName))
+}
@@ -0,0 +1,161 @@
+package iam_mapper
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/hrz8/altalune/internal/postgres"
+)
+
+// ==================== Project Ownership Transfers ====================
+
+const ownershipTransferSelect = `
+	SELECT
+		t.id, t.public_id, t.project_id, p.public_id, p.name,
+		f.id, f.public_id, f.email, COALESCE(f.first_name, ''), COALESCE(f.last_name, ''),
+		u.id, u.public_id, u.email, COALESCE(u.first_name, ''), COALESCE(u.last_name, ''),
+		t.status, t.expires_at, t.created_at, t.resolved_at
+	FROM altalune_project_ownership_transfers t
+	INNER JOIN altalune_projects p ON p.id = t.project_id
+	INNER JOIN altalune_users f ON f.id = t.from_user_id
+	INNER JOIN altalune_users u ON u.id = t.to_user_id
+`
+
+func scanOwnershipTransfer(row interface{ Scan(dest ...any) error }) (*OwnershipTransfer, error) {
+	var t OwnershipTransfer
+	err := row.Scan(
+		&t.ID, &t.PublicID, &t.ProjectID, &t.ProjectPublicID, &t.ProjectName,
+		&t.From.ID, &t.From.PublicID, &t.From.Email, &t.From.FirstName, &t.From.LastName,
+		&t.To.ID, &t.To.PublicID, &t.To.Email, &t.To.FirstName, &t.To.LastName,
+		&t.Status, &t.ExpiresAt, &t.CreatedAt, &t.ResolvedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// CreateOwnershipTransfer stores a pending transfer, cancelling the one the
+// project had pending in the same transaction. The cancellation runs as a
+// statement of its own: a data-modifying CTE shares the snapshot of the
+// INSERT, which would not see it and trip the unique index of pending ones.
+func (r *Repo) CreateOwnershipTransfer(ctx context.Context, input *CreateOwnershipTransferInput) (*OwnershipTransfer, error) {
+	cancelQuery := `
+		UPDATE altalune_project_ownership_transfers
+		SET status = 'cancelled', resolved_at = NOW()
+		WHERE project_id = $1 AND status = 'pending'
+	`
+	insertQuery := `
+		INSERT INTO altalune_project_ownership_transfers (public_id, project_id, from_user_id, to_user_id, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+	publicID, err := postgres.InsertWithPublicID(func(publicID string) error {
+		tx, err := r.db.GetDB().BeginTx(ctx, nil)
+		if err != nil {
+			return fmt.Errorf("begin transaction: %w", err)
+		}
+		defer tx.Rollback()
+
+		if _, err := tx.ExecContext(ctx, cancelQuery, input.ProjectID); err != nil {
+			return fmt.Errorf("cancel pending transfer: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, insertQuery, publicID, input.ProjectID, input.FromUserID, input.ToUserID, input.ExpiresAt); err != nil {
+			return err
+		}
+		return tx.Commit()
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create ownership transfer: %w", err)
+	}
+	return r.GetOwnershipTransfer(ctx, publicID)
+}
+
+// GetPendingOwnershipTransfer retrieves the unexpired pending transfer of a project.
+func (r *Repo) GetPendingOwnershipTransfer(ctx context.Context, projectID int64) (*OwnershipTransfer, error) {
+	query := ownershipTransferSelect + `
+		WHERE t.project_id = $1 AND t.status = 'pending' AND t.expires_at > NOW()
+	`
+	transfer, err := scanOwnershipTransfer(r.db.QueryRowContext(ctx, query, projectID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrOwnershipTransferNotFound
+		}
+		return nil, fmt.Errorf("get pending ownership transfer: %w", err)
+	}
+	return transfer, nil
+}
+
+// GetOwnershipTransfer retrieves a transfer by public ID, whatever its status.
+func (r *Repo) GetOwnershipTransfer(ctx context.Context, publicID string) (*OwnershipTransfer, error) {
+	query := ownershipTransferSelect + `
+		WHERE t.public_id = $1
+	`
+	transfer, err := scanOwnershipTransfer(r.db.QueryRowContext(ctx, query, publicID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrOwnershipTransferNotFound
+		}
+		return nil, fmt.Errorf("get ownership transfer: %w", err)
+	}
+	return transfer, nil
+}
+
+// AcceptOwnershipTransfer marks a pending, unexpired transfer accepted and
+// swaps the project roles of its parties in one statement: the target member
+// becomes owner and the initiator takes the role the target held. It returns
+// ErrOwnershipTransferNotFound when the transfer is no longer pending, the
+// initiator no longer owns the project or the target is no longer a member.
+func (r *Repo) AcceptOwnershipTransfer(ctx context.Context, id int64) error {
+	query := `
+		WITH accepted AS (
+			UPDATE altalune_project_ownership_transfers t
+			SET status = 'accepted', resolved_at = NOW()
+			FROM altalune_project_members f, altalune_project_members m
+			WHERE t.id = $1 AND t.status = 'pending' AND t.expires_at > NOW()
+				AND f.project_id = t.project_id AND f.user_id = t.from_user_id AND f.role = 'owner'
+				AND m.project_id = t.project_id AND m.user_id = t.to_user_id AND m.role <> 'owner'
+			RETURNING t.project_id, t.from_user_id, t.to_user_id, m.role AS to_role
+		)
+		UPDATE altalune_project_members pm
+		SET role = CASE WHEN pm.user_id = a.from_user_id THEN a.to_role ELSE 'owner' END,
+			updated_at = NOW()
+		FROM accepted a
+		WHERE pm.project_id = a.project_id AND pm.user_id IN (a.from_user_id, a.to_user_id)
+	`
+	result, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("accept ownership transfer: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrOwnershipTransferNotFound
+	}
+	return nil
+}
+
+// ResolveOwnershipTransfer ends a pending transfer with status, cancelled or
+// declined.
+func (r *Repo) ResolveOwnershipTransfer(ctx context.Context, id int64, status OwnershipTransferStatus) error {
+	query := `
+		UPDATE altalune_project_ownership_transfers
+		SET status = $2, resolved_at = NOW()
+		WHERE id = $1 AND status = 'pending'
+	`
+	result, err := r.db.ExecContext(ctx, query, id, string(status))
+	if err != nil {
+		return fmt.Errorf("resolve ownership transfer: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrOwnershipTransferNotFound
+	}
+	return nil
+}
@@ -2,15 +2,23 @@ package iam_mapper
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"buf.build/go/protovalidate"
 	"github.com/hrz8/altalune"
 	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	"github.com/hrz8/altalune/internal/auth"
 	"github.com/hrz8/altalune/internal/domain/permission"
 	"github.com/hrz8/altalune/internal/domain/project"
 	"github.com/hrz8/altalune/internal/domain/role"
 	"github.com/hrz8/altalune/internal/domain/user"
+	"github.com/hrz8/altalune/internal/shared/events"
+	"github.com/hrz8/altalune/internal/shared/notification"
+	"github.com/hrz8/altalune/internal/shared/tz"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -38,6 +46,10 @@ type Service struct {
 	roleRepo       role.Repository
 	permissionRepo permission.Repository
 	projectRepo    project.Repositor
+	notification   *notification.NotificationService
+	orgRoles       OrganizationRoleProvider
+	limiter        PlanLimiter
+	events         *events.Bus
 }
 
 func NewService(
@@ -48,6 +60,10 @@ func NewService(
 	roleRepo role.Repository,
 	permissionRepo permission.Repository,
 	projectRepo project.Repositor,
+	notificationSvc *notification.NotificationService,
+	orgRoles OrganizationRoleProvider,
+	limiter PlanLimiter,
+	eventBus *events.Bus,
 ) *Service {
 	return &Service{
 		validator:      v,
@@ -57,6 +73,10 @@ func NewService(
 		roleRepo:       roleRepo,
 		permissionRepo: permissionRepo,
 		projectRepo:    projectRepo,
+		notification:   notificationSvc,
+		orgRoles:       orgRoles,
+		limiter:        limiter,
+		events:         eventBus,
 	}
 }
 
@@ -496,8 +516,38 @@ func (s *Service) AssignProjectMembers(ctx context.Context, req *altalunev1.Assi
 		}
 	}
 
-	// Assign members
-	if err := s.mapperRepo.AssignProjectMembers(ctx, projectID, members); err != nil {
+	grants := make(map[string]string, len(req.Members))
+	for _, member := range req.Members {
+		grants[member.UserId] = member.Role
+	}
+	if err := s.checkProjectGrants(ctx, req.ProjectId, projectID, grants); err != nil {
+		return nil, err
+	}
+
+	plan, err := s.limiter.ProjectPlan(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Members already in the project only change role
+	existing, err := s.mapperRepo.GetProjectMembers(ctx, projectID)
+	if err != nil {
+		s.log.Error("failed to get project members",
+			"error", err,
+			"project_id", projectID,
+		)
+		return nil, altalune.NewUnexpectedError("failed to get project members: %w", err)
+	}
+	isMember := make(map[string]bool, len(existing))
+	for _, m := range existing {
+		isMember[m.User.ID] = true
+	}
+
+	// Assign members, within the members the plan of the project allows
+	if err := s.mapperRepo.AssignProjectMembers(ctx, projectID, members, plan.MaxMembers); err != nil {
+		if err == ErrMemberLimitReached {
+			return nil, altalune.NewPlanLimitReachedError("members", plan.MaxMembers, plan.Name)
+		}
 		s.log.Error("failed to assign project members",
 			"error", err,
 			"project_id", projectID,
@@ -505,9 +555,31 @@ func (s *Service) AssignProjectMembers(ctx context.Context, req *altalunev1.Assi
 		return nil, altalune.NewUnexpectedError("failed to assign project members: %w", err)
 	}
 
+	for _, member := range req.Members {
+		if !isMember[member.UserId] {
+			isMember[member.UserId] = true
+			s.publishMemberAdded(ctx, projectID, req.ProjectId, member.UserId, member.Role)
+		}
+	}
+
 	return &emptypb.Empty{}, nil
 }
 
+// publishMemberAdded publishes the ProjectMemberAdded event of a user who
+// joined a project, with their email when it can be found
+func (s *Service) publishMemberAdded(ctx context.Context, projectID int64, projectPublicID, userPublicID, role string) {
+	event := ProjectMemberAdded{
+		ProjectID:       projectID,
+		ProjectPublicID: projectPublicID,
+		UserPublicID:    userPublicID,
+		Role:            role,
+	}
+	if u, err := s.userRepo.GetByID(ctx, userPublicID); err == nil {
+		event.Email = u.Email
+	}
+	s.events.Publish(ctx, event)
+}
+
 func (s *Service) RemoveProjectMembers(ctx context.Context, req *altalunev1.RemoveProjectMembersRequest) (*emptypb.Empty, error) {
 	// Validate request
 	if err := s.validator.Validate(req); err != nil {
@@ -543,6 +615,14 @@ func (s *Service) RemoveProjectMembers(ctx context.Context, req *altalunev1.Remo
 		userIDs[i] = userID
 	}
 
+	grants := make(map[string]string, len(req.UserIds))
+	for _, publicID := range req.UserIds {
+		grants[publicID] = ""
+	}
+	if err := s.checkProjectGrants(ctx, req.ProjectId, projectID, grants); err != nil {
+		return nil, err
+	}
+
 	// Remove members
 	if err := s.mapperRepo.RemoveProjectMembers(ctx, projectID, userIDs); err != nil {
 		if err == ErrCannotRemoveLastOwner {
@@ -559,6 +639,66 @@ func (s *Service) RemoveProjectMembers(ctx context.Context, req *altalunev1.Remo
 	return &emptypb.Empty{}, nil
 }
 
+// checkProjectGrants enforces that the caller only grants roles at or below
+// their own in the project, and only changes or removes members who rank no
+// higher than them. grants maps user public IDs to their new role, empty for
+// removals. The caller's role is read from the database rather than the token
+// so a demotion takes effect immediately, the role held through the project's
+// organization counting when higher. Superadmins and calls without an auth
+// context (CLI, seeders) are not constrained.
+func (s *Service) checkProjectGrants(ctx context.Context, projectPublicID string, projectID int64, grants map[string]string) error {
+	caller := auth.FromContext(ctx)
+	if !caller.IsAuthenticated || slices.Contains(caller.Permissions, auth.RootPermission) {
+		return nil
+	}
+
+	members, err := s.mapperRepo.GetProjectMembers(ctx, projectID)
+	if err != nil {
+		s.log.Error("failed to get project members for grant check",
+			"error", err,
+			"project_id", projectID,
+		)
+		return altalune.NewUnexpectedError("failed to get project members: %w", err)
+	}
+
+	var callerRole string
+	currentRoles := make(map[string]string, len(members))
+	for _, m := range members {
+		currentRoles[m.User.ID] = m.Role
+		if m.User.ID == caller.UserID {
+			callerRole = m.Role
+		}
+	}
+	if s.orgRoles != nil {
+		orgRole, err := s.orgRoles.GetProjectRole(ctx, projectID, caller.UserID)
+		if err != nil {
+			s.log.Error("failed to get organization role for grant check",
+				"error", err,
+				"project_id", projectID,
+			)
+			return altalune.NewUnexpectedError("failed to get organization role: %w", err)
+		}
+		callerRole = HigherProjectRole(callerRole, orgRole)
+	}
+
+	for userID, role := range grants {
+		for _, r := range []string{role, currentRoles[userID]} {
+			if r != "" && !canGrantProjectRole(callerRole, r) {
+				s.log.Warn("project role grant denied",
+					"project_id", projectID,
+					"caller_id", caller.UserID,
+					"caller_role", callerRole,
+					"user_public_id", userID,
+					"role", r,
+				)
+				return altalune.NewProjectRoleGrantDeniedError(projectPublicID, r)
+			}
+		}
+	}
+
+	return nil
+}
+
 func (s *Service) GetProjectMembers(ctx context.Context, req *altalunev1.GetProjectMembersRequest) (*altalunev1.GetProjectMembersResponse, error) {
 	// Validate request
 	if err := s.validator.Validate(req); err != nil {
@@ -617,3 +757,622 @@ func (s *Service) GetUserProjects(ctx context.Context, req *altalunev1.GetUserPr
 		Projects: UserProjectsToProto(projects),
 	}, nil
 }
+
+// ==================== Project Ownership Transfers ====================
+
+func (s *Service) InitiateProjectOwnershipTransfer(ctx context.Context, req *altalunev1.InitiateProjectOwnershipTransferRequest) (*altalunev1.InitiateProjectOwnershipTransferResponse, error) {
+	// 1. Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	// 2. Resolve project public ID to internal ID
+	projectID, err := s.projectRepo.GetIDByPublicID(ctx, req.ProjectId)
+	if err != nil {
+		s.log.Error("project not found for ownership transfer",
+			"error", err,
+			"project_public_id", req.ProjectId,
+		)
+		return nil, altalune.NewProjectNotFound(req.ProjectId)
+	}
+
+	// 3. Only an owner hands the project over, superadmins included: they
+	// assign roles directly instead
+	caller := auth.FromContext(ctx)
+	members, err := s.mapperRepo.GetProjectMembers(ctx, projectID)
+	if err != nil {
+		s.log.Error("failed to get project members for ownership transfer",
+			"error", err,
+			"project_id", projectID,
+		)
+		return nil, altalune.NewUnexpectedError("failed to get project members: %w", err)
+	}
+	roles := make(map[string]string, len(members))
+	for _, m := range members {
+		roles[m.User.ID] = m.Role
+	}
+	if !caller.IsAuthenticated || roles[caller.UserID] != ProjectRoleOwner {
+		s.log.Warn("ownership transfer denied",
+			"project_id", projectID,
+			"caller_id", caller.UserID,
+			"caller_role", roles[caller.UserID],
+		)
+		return nil, altalune.NewProjectRoleGrantDeniedError(req.ProjectId, ProjectRoleOwner)
+	}
+
+	// 4. The target must be another member who does not own the project yet
+	switch role, ok := roles[req.UserId]; {
+	case req.UserId == caller.UserID:
+		return nil, altalune.NewInvalidPayloadError("cannot transfer ownership to yourself")
+	case !ok:
+		return nil, altalune.NewInvalidPayloadError("ownership can only be transferred to a project member")
+	case role == ProjectRoleOwner:
+		return nil, altalune.NewInvalidPayloadError("the member already owns the project")
+	}
+
+	// 5. Resolve user public IDs to internal IDs
+	fromUserID, err := s.userRepo.GetIDByPublicID(ctx, caller.UserID)
+	if err != nil {
+		s.log.Error("caller not found for ownership transfer",
+			"error", err,
+			"user_public_id", caller.UserID,
+		)
+		return nil, altalune.NewUserNotFoundError(caller.UserID)
+	}
+	toUserID, err := s.userRepo.GetIDByPublicID(ctx, req.UserId)
+	if err != nil {
+		s.log.Error("user not found for ownership transfer",
+			"error", err,
+			"user_public_id", req.UserId,
+		)
+		return nil, altalune.NewUserNotFoundError(req.UserId)
+	}
+
+	// 6. Create the transfer, replacing any pending one
+	transfer, err := s.mapperRepo.CreateOwnershipTransfer(ctx, &CreateOwnershipTransferInput{
+		ProjectID:  projectID,
+		FromUserID: fromUserID,
+		ToUserID:   toUserID,
+		ExpiresAt:  time.Now().Add(ownershipTransferLifetime),
+	})
+	if err != nil {
+		s.log.Error("failed to create ownership transfer",
+			"error", err,
+			"project_id", projectID,
+		)
+		return nil, altalune.NewUnexpectedError("failed to create ownership transfer: %w", err)
+	}
+
+	// 7. Log successful initiation for audit purposes
+	s.log.Info("project ownership transfer initiated",
+		"transfer_id", transfer.PublicID,
+		"project_id", req.ProjectId,
+		"from_user_id", transfer.From.PublicID,
+		"to_user_id", transfer.To.PublicID,
+	)
+
+	// 8. Ask the target member to accept it
+	s.notifyOwnershipTransfer(ctx, transfer, []OwnershipTransferParty{transfer.To}, s.notification.SendOwnershipTransferRequestEmail)
+
+	return &altalunev1.InitiateProjectOwnershipTransferResponse{
+		Transfer: transfer.ToProto(time.Now()),
+	}, nil
+}
+
+func (s *Service) GetProjectOwnershipTransfer(ctx context.Context, req *altalunev1.GetProjectOwnershipTransferRequest) (*altalunev1.GetProjectOwnershipTransferResponse, error) {
+	// Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	// Resolve project public ID to internal ID
+	projectID, err := s.projectRepo.GetIDByPublicID(ctx, req.ProjectId)
+	if err != nil {
+		s.log.Error("project not found for get ownership transfer",
+			"error", err,
+			"project_public_id", req.ProjectId,
+		)
+		return nil, altalune.NewProjectNotFound(req.ProjectId)
+	}
+
+	// Get the pending transfer, if any
+	transfer, err := s.mapperRepo.GetPendingOwnershipTransfer(ctx, projectID)
+	if err != nil {
+		if errors.Is(err, ErrOwnershipTransferNotFound) {
+			return &altalunev1.GetProjectOwnershipTransferResponse{}, nil
+		}
+		s.log.Error("failed to get pending ownership transfer",
+			"error", err,
+			"project_id", projectID,
+		)
+		return nil, altalune.NewUnexpectedError("failed to get ownership transfer: %w", err)
+	}
+
+	return &altalunev1.GetProjectOwnershipTransferResponse{
+		Transfer: transfer.ToProto(time.Now()),
+	}, nil
+}
+
+func (s *Service) AcceptProjectOwnershipTransfer(ctx context.Context, req *altalunev1.AcceptProjectOwnershipTransferRequest) (*altalunev1.AcceptProjectOwnershipTransferResponse, error) {
+	// 1. Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	// 2. Only the target member accepts a transfer; to anyone else it does
+	// not exist
+	transfer, err := s.getOwnershipTransfer(ctx, req.TransferId)
+	if err != nil {
+		return nil, err
+	}
+	caller := auth.FromContext(ctx)
+	if !caller.IsAuthenticated || caller.UserID != transfer.To.PublicID ||
+		transfer.StatusAt(time.Now()) != OwnershipTransferStatusPending {
+		return nil, altalune.NewOwnershipTransferNotFoundError(req.TransferId)
+	}
+
+	// 3. Swap the roles of both parties
+	if err := s.mapperRepo.AcceptOwnershipTransfer(ctx, transfer.ID); err != nil {
+		if errors.Is(err, ErrOwnershipTransferNotFound) {
+			// Resolved concurrently, or the parties' roles changed since
+			return nil, altalune.NewOwnershipTransferNotFoundError(req.TransferId)
+		}
+		s.log.Error("failed to accept ownership transfer",
+			"error", err,
+			"transfer_id", req.TransferId,
+		)
+		return nil, altalune.NewUnexpectedError("failed to accept ownership transfer: %w", err)
+	}
+
+	// 4. Log successful transfer for audit purposes
+	s.log.Info("project ownership transferred",
+		"transfer_id", transfer.PublicID,
+		"project_id", transfer.ProjectPublicID,
+		"from_user_id", transfer.From.PublicID,
+		"to_user_id", transfer.To.PublicID,
+	)
+
+	// 5. Tell both parties
+	s.notifyOwnershipTransfer(ctx, transfer, []OwnershipTransferParty{transfer.From, transfer.To}, s.notification.SendOwnershipTransferCompleteEmail)
+
+	accepted, err := s.getOwnershipTransfer(ctx, req.TransferId)
+	if err != nil {
+		return nil, err
+	}
+	return &altalunev1.AcceptProjectOwnershipTransferResponse{
+		Transfer: accepted.ToProto(time.Now()),
+	}, nil
+}
+
+func (s *Service) CancelProjectOwnershipTransfer(ctx context.Context, req *altalunev1.CancelProjectOwnershipTransferRequest) (*emptypb.Empty, error) {
+	// 1. Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	// 2. The initiator cancels a transfer and the target member declines it;
+	// to anyone else it does not exist
+	transfer, err := s.getOwnershipTransfer(ctx, req.TransferId)
+	if err != nil {
+		return nil, err
+	}
+	caller := auth.FromContext(ctx)
+	var status OwnershipTransferStatus
+	switch {
+	case !caller.IsAuthenticated:
+	case caller.UserID == transfer.From.PublicID:
+		status = OwnershipTransferStatusCancelled
+	case caller.UserID == transfer.To.PublicID:
+		status = OwnershipTransferStatusDeclined
+	}
+	if status == "" || transfer.Status != OwnershipTransferStatusPending {
+		return nil, altalune.NewOwnershipTransferNotFoundError(req.TransferId)
+	}
+
+	// 3. Resolve the transfer
+	if err := s.mapperRepo.ResolveOwnershipTransfer(ctx, transfer.ID, status); err != nil {
+		if errors.Is(err, ErrOwnershipTransferNotFound) {
+			return nil, altalune.NewOwnershipTransferNotFoundError(req.TransferId)
+		}
+		s.log.Error("failed to resolve ownership transfer",
+			"error", err,
+			"transfer_id", req.TransferId,
+		)
+		return nil, altalune.NewUnexpectedError("failed to resolve ownership transfer: %w", err)
+	}
+
+	// 4. Log successful resolution for audit purposes
+	s.log.Info("project ownership transfer "+string(status),
+		"transfer_id", transfer.PublicID,
+		"project_id", transfer.ProjectPublicID,
+		"caller_id", caller.UserID,
+	)
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) getOwnershipTransfer(ctx context.Context, publicID string) (*OwnershipTransfer, error) {
+	transfer, err := s.mapperRepo.GetOwnershipTransfer(ctx, publicID)
+	if err != nil {
+		if errors.Is(err, ErrOwnershipTransferNotFound) {
+			return nil, altalune.NewOwnershipTransferNotFoundError(publicID)
+		}
+		s.log.Error("failed to get ownership transfer",
+			"error", err,
+			"transfer_id", publicID,
+		)
+		return nil, altalune.NewUnexpectedError("failed to get ownership transfer: %w", err)
+	}
+	return transfer, nil
+}
+
+// notifyOwnershipTransfer emails the recipients of a transfer with send.
+// Failures are logged only: the transfer stands either way.
+func (s *Service) notifyOwnershipTransfer(
+	ctx context.Context,
+	transfer *OwnershipTransfer,
+	recipients []OwnershipTransferParty,
+	send func(ctx context.Context, toEmail string, data notification.OwnershipTransferEmailData) error,
+) {
+	if s.notification == nil {
+		return
+	}
+
+	// Members read the time in the project's time zone, UTC when unknown
+	timezone, err := s.projectRepo.GetTimezone(ctx, transfer.ProjectID)
+	if err != nil {
+		s.log.Warn("failed to get project timezone for ownership transfer", "error", err, "project_id", transfer.ProjectID)
+	}
+
+	for _, recipient := range recipients {
+		data := notification.OwnershipTransferEmailData{
+			RecipientName: recipient.Name(),
+			ProjectName:   transfer.ProjectName,
+			FromName:      transfer.From.Name(),
+			FromEmail:     transfer.From.Email,
+			ToName:        transfer.To.Name(),
+			ToEmail:       transfer.To.Email,
+			ExpiresAt:     tz.Format(transfer.ExpiresAt, tz.Load(timezone)),
+		}
+		if err := send(ctx, recipient.Email, data); err != nil {
+			s.log.Warn("failed to send ownership transfer email",
+				"error", err,
+				"transfer_id", transfer.PublicID,
+				"user_id", recipient.PublicID,
+			)
+		}
+	}
+}
+
+// ==================== IAM Snapshots ====================
+
+func (s *Service) DumpProjectIAM(ctx context.Context, req *altalunev1.DumpProjectIAMRequest) (*altalunev1.DumpProjectIAMResponse, error) {
+	// Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	// Resolve project public ID to internal ID
+	projectID, err := s.projectRepo.GetIDByPublicID(ctx, req.ProjectId)
+	if err != nil {
+		s.log.Error("project not found for iam dump",
+			"error", err,
+			"project_public_id", req.ProjectId,
+		)
+		return nil, altalune.NewProjectNotFound(req.ProjectId)
+	}
+
+	snapshot, err := s.mapperRepo.GetProjectSnapshot(ctx, projectID)
+	if err != nil {
+		s.log.Error("failed to get project iam snapshot",
+			"error", err,
+			"project_id", projectID,
+		)
+		return nil, altalune.NewUnexpectedError("failed to get project iam snapshot: %w", err)
+	}
+	snapshot.Project = req.ProjectId
+
+	out, err := MarshalSnapshot(snapshot)
+	if err != nil {
+		return nil, altalune.NewUnexpectedError("failed to marshal project iam snapshot: %w", err)
+	}
+
+	return &altalunev1.DumpProjectIAMResponse{
+		Snapshot: string(out),
+	}, nil
+}
+
+// iamChange is one step of reconciling a project to a snapshot. apply resolves
+// the IDs it needs when it runs, so that it can rely on the roles and
+// permissions created by the steps before it.
+type iamChange struct {
+	description string
+	apply       func(ctx context.Context) error
+}
+
+// ApplyProjectIAM reconciles a project to a snapshot: missing roles and
+// permissions are created, role permissions, members and the roles and direct
+// permissions of members are made to match it. Steps run in order and not in
+// one transaction; since the result does not depend on the starting state,
+// applying again after a failure converges.
+func (s *Service) ApplyProjectIAM(ctx context.Context, req *altalunev1.ApplyProjectIAMRequest) (*altalunev1.ApplyProjectIAMResponse, error) {
+	// Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	snapshot, err := UnmarshalSnapshot([]byte(req.Snapshot))
+	if err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	// Resolve project public ID to internal ID
+	projectID, err := s.projectRepo.GetIDByPublicID(ctx, req.ProjectId)
+	if err != nil {
+		s.log.Error("project not found for iam apply",
+			"error", err,
+			"project_public_id", req.ProjectId,
+		)
+		return nil, altalune.NewProjectNotFound(req.ProjectId)
+	}
+
+	changes, grants, err := s.planProjectIAM(ctx, projectID, snapshot)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkProjectGrants(ctx, req.ProjectId, projectID, grants); err != nil {
+		return nil, err
+	}
+
+	descriptions := make([]string, len(changes))
+	for i, change := range changes {
+		descriptions[i] = change.description
+	}
+	if req.DryRun {
+		return &altalunev1.ApplyProjectIAMResponse{Changes: descriptions}, nil
+	}
+
+	for _, change := range changes {
+		if err := change.apply(ctx); err != nil {
+			// Such as the plan limit of the members
+			var appErr *altalune.AppError
+			if errors.As(err, &appErr) {
+				return nil, err
+			}
+			s.log.Error("failed to apply project iam change",
+				"error", err,
+				"project_id", projectID,
+				"change", change.description,
+			)
+			return nil, altalune.NewUnexpectedError("failed to apply project iam change: %w", err)
+		}
+	}
+	s.log.Info("applied project iam snapshot",
+		"project_id", projectID,
+		"changes", len(changes),
+	)
+
+	return &altalunev1.ApplyProjectIAMResponse{Changes: descriptions}, nil
+}
+
+// planProjectIAM diffs a project against a snapshot and returns the changes
+// that reconcile it, along with the project role grants they make, empty for
+// removals, for checkProjectGrants
+func (s *Service) planProjectIAM(ctx context.Context, projectID int64, snapshot *Snapshot) ([]iamChange, map[string]string, error) {
+	var changes []iamChange
+	plan := func(description string, apply func(ctx context.Context) error) {
+		changes = append(changes, iamChange{description: description, apply: apply})
+	}
+
+	for _, p := range snapshot.Permissions {
+		if _, err := s.permissionRepo.GetByName(ctx, p.Name); err == nil {
+			continue
+		} else if !errors.Is(err, permission.ErrPermissionNotFound) {
+			return nil, nil, altalune.NewUnexpectedError("failed to get permission: %w", err)
+		}
+		plan("create permission "+p.Name, func(ctx context.Context) error {
+			_, err := s.permissionRepo.Create(ctx, &permission.CreatePermissionInput{Name: p.Name, Description: p.Description})
+			return err
+		})
+	}
+
+	for _, rl := range snapshot.Roles {
+		var current []*permission.Permission
+		roleID, err := s.roleRepo.GetInternalIDByName(ctx, rl.Name)
+		switch {
+		case errors.Is(err, role.ErrRoleNotFound):
+			plan("create role "+rl.Name, func(ctx context.Context) error {
+				_, err := s.roleRepo.Create(ctx, &role.CreateRoleInput{Name: rl.Name, Description: rl.Description})
+				return err
+			})
+		case err != nil:
+			return nil, nil, altalune.NewUnexpectedError("failed to get role: %w", err)
+		default:
+			if current, err = s.mapperRepo.GetRolePermissions(ctx, roleID); err != nil {
+				return nil, nil, altalune.NewUnexpectedError("failed to get role permissions: %w", err)
+			}
+		}
+
+		added, removed := diffNames(permissionNames(current), rl.Permissions)
+		if len(added) > 0 {
+			plan(fmt.Sprintf("add permissions %s to role %s", strings.Join(added, ", "), rl.Name), func(ctx context.Context) error {
+				roleID, permissionIDs, err := s.resolveRolePermissions(ctx, rl.Name, added)
+				if err != nil {
+					return err
+				}
+				return s.mapperRepo.AssignRolePermissions(ctx, roleID, permissionIDs)
+			})
+		}
+		if len(removed) > 0 {
+			plan(fmt.Sprintf("remove permissions %s from role %s", strings.Join(removed, ", "), rl.Name), func(ctx context.Context) error {
+				roleID, permissionIDs, err := s.resolveRolePermissions(ctx, rl.Name, removed)
+				if err != nil {
+					return err
+				}
+				return s.mapperRepo.RemoveRolePermissions(ctx, roleID, permissionIDs)
+			})
+		}
+	}
+
+	members, err := s.mapperRepo.GetProjectMembers(ctx, projectID)
+	if err != nil {
+		return nil, nil, altalune.NewUnexpectedError("failed to get project members: %w", err)
+	}
+	currentRoles := make(map[string]string, len(members))
+	for _, m := range members {
+		currentRoles[m.User.ID] = m.Role
+	}
+	billingPlan, err := s.limiter.ProjectPlan(ctx, projectID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	grants := make(map[string]string)
+	for _, m := range snapshot.Members {
+		userID, err := s.userRepo.GetIDByPublicID(ctx, m.User)
+		if err != nil {
+			return nil, nil, altalune.NewUserNotFoundError(m.User)
+		}
+
+		if currentRole, ok := currentRoles[m.User]; !ok || currentRole != m.Role {
+			grants[m.User] = m.Role
+			plan(fmt.Sprintf("set project role of user %s to %s", m.User, m.Role), func(ctx context.Context) error {
+				err := s.mapperRepo.AssignProjectMembers(ctx, projectID, []ProjectMemberInput{{UserID: userID, Role: m.Role}}, billingPlan.MaxMembers)
+				if err == ErrMemberLimitReached {
+					return altalune.NewPlanLimitReachedError("members", billingPlan.MaxMembers, billingPlan.Name)
+				}
+				return err
+			})
+		}
+
+		roles, err := s.mapperRepo.GetUserRoles(ctx, userID)
+		if err != nil {
+			return nil, nil, altalune.NewUnexpectedError("failed to get user roles: %w", err)
+		}
+		added, removed := diffNames(roleNames(roles), m.Roles)
+		if len(added) > 0 {
+			plan(fmt.Sprintf("grant roles %s to user %s", strings.Join(added, ", "), m.User), func(ctx context.Context) error {
+				roleIDs, err := s.resolveRoles(ctx, added)
+				if err != nil {
+					return err
+				}
+				return s.mapperRepo.AssignUserRoles(ctx, userID, roleIDs)
+			})
+		}
+		if len(removed) > 0 {
+			plan(fmt.Sprintf("revoke roles %s from user %s", strings.Join(removed, ", "), m.User), func(ctx context.Context) error {
+				roleIDs, err := s.resolveRoles(ctx, removed)
+				if err != nil {
+					return err
+				}
+				return s.mapperRepo.RemoveUserRoles(ctx, userID, roleIDs)
+			})
+		}
+
+		permissions, err := s.mapperRepo.GetDirectUserPermissions(ctx, userID)
+		if err != nil {
+			return nil, nil, altalune.NewUnexpectedError("failed to get user permissions: %w", err)
+		}
+		added, removed = diffNames(permissionNames(permissions), m.Permissions)
+		if len(added) > 0 {
+			plan(fmt.Sprintf("grant permissions %s to user %s", strings.Join(added, ", "), m.User), func(ctx context.Context) error {
+				permissionIDs, err := s.resolvePermissions(ctx, added)
+				if err != nil {
+					return err
+				}
+				return s.mapperRepo.AssignUserPermissions(ctx, userID, permissionIDs)
+			})
+		}
+		if len(removed) > 0 {
+			plan(fmt.Sprintf("revoke permissions %s from user %s", strings.Join(removed, ", "), m.User), func(ctx context.Context) error {
+				permissionIDs, err := s.resolvePermissions(ctx, removed)
+				if err != nil {
+					return err
+				}
+				return s.mapperRepo.RemoveUserPermissions(ctx, userID, permissionIDs)
+			})
+		}
+	}
+
+	// Remove members last so that the new owners are in place first
+	var removedUserIDs []int64
+	var removedMembers []string
+	for _, m := range members {
+		if slices.ContainsFunc(snapshot.Members, func(sm SnapshotMember) bool { return sm.User == m.User.ID }) {
+			continue
+		}
+		userID, err := s.userRepo.GetIDByPublicID(ctx, m.User.ID)
+		if err != nil {
+			return nil, nil, altalune.NewUserNotFoundError(m.User.ID)
+		}
+		grants[m.User.ID] = ""
+		removedUserIDs = append(removedUserIDs, userID)
+		removedMembers = append(removedMembers, m.User.ID)
+	}
+	if len(removedMembers) > 0 {
+		slices.Sort(removedMembers)
+		plan("remove members "+strings.Join(removedMembers, ", "), func(ctx context.Context) error {
+			return s.mapperRepo.RemoveProjectMembers(ctx, projectID, removedUserIDs)
+		})
+	}
+
+	return changes, grants, nil
+}
+
+// resolveRoles returns the internal IDs of the named roles
+func (s *Service) resolveRoles(ctx context.Context, names []string) ([]int64, error) {
+	ids := make([]int64, len(names))
+	for i, name := range names {
+		id, err := s.roleRepo.GetInternalIDByName(ctx, name)
+		if err != nil {
+			return nil, fmt.Errorf("resolve role %s: %w", name, err)
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+// resolvePermissions returns the internal IDs of the named permissions
+func (s *Service) resolvePermissions(ctx context.Context, names []string) ([]int64, error) {
+	ids := make([]int64, len(names))
+	for i, name := range names {
+		p, err := s.permissionRepo.GetByName(ctx, name)
+		if err != nil {
+			return nil, fmt.Errorf("resolve permission %s: %w", name, err)
+		}
+		if ids[i], err = s.permissionRepo.GetIDByPublicID(ctx, p.ID); err != nil {
+			return nil, fmt.Errorf("resolve permission %s: %w", name, err)
+		}
+	}
+	return ids, nil
+}
+
+// resolveRolePermissions returns the internal IDs of a role and of the named
+// permissions
+func (s *Service) resolveRolePermissions(ctx context.Context, roleName string, permissionNames []string) (int64, []int64, error) {
+	roleIDs, err := s.resolveRoles(ctx, []string{roleName})
+	if err != nil {
+		return 0, nil, err
+	}
+	permissionIDs, err := s.resolvePermissions(ctx, permissionNames)
+	if err != nil {
+		return 0, nil, err
+	}
+	return roleIDs[0], permissionIDs, nil
+}
+
+func roleNames(roles []*role.Role) []string {
+	names := make([]string, len(roles))
+	for i, rl := range roles {
+		names[i] = rl.Name
+	}
+	return names
+}
+
+func permissionNames(permissions []*permission.Permission) []string {
+	names := make([]string, len(permissions))
+	for i, p := range permissions {
+		names[i] = p.Name
+	}
+	return names
+}
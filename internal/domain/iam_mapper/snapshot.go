@@ -0,0 +1,161 @@
+package iam_mapper
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Snapshot is the IAM configuration of a project: its members with their
+// project role, the global roles and direct permissions they hold and the
+// permissions of those roles. Users are identified by public ID, roles and
+// permissions by name.
+type Snapshot struct {
+	Project     string               `yaml:"project"` // Public ID of the dumped project, informational
+	Permissions []SnapshotPermission `yaml:"permissions"`
+	Roles       []SnapshotRole       `yaml:"roles"`
+	Members     []SnapshotMember     `yaml:"members"`
+}
+
+// SnapshotPermission is a permission referenced by the roles or members
+type SnapshotPermission struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description,omitempty"`
+}
+
+// SnapshotRole is a role held by a member, with its permissions
+type SnapshotRole struct {
+	Name        string   `yaml:"name"`
+	Description string   `yaml:"description,omitempty"`
+	Permissions []string `yaml:"permissions,omitempty"`
+}
+
+// SnapshotMember is a project member with the roles and direct permissions
+// of the user
+type SnapshotMember struct {
+	User        string   `yaml:"user"`
+	Email       string   `yaml:"email,omitempty"` // Informational, empty for service accounts
+	Role        string   `yaml:"role"`            // Project role
+	Roles       []string `yaml:"roles,omitempty"`
+	Permissions []string `yaml:"permissions,omitempty"`
+}
+
+// Sort orders every list of the snapshot so that equal configurations
+// marshal identically
+func (s *Snapshot) Sort() {
+	slices.SortFunc(s.Permissions, func(a, b SnapshotPermission) int { return cmp.Compare(a.Name, b.Name) })
+	slices.SortFunc(s.Roles, func(a, b SnapshotRole) int { return cmp.Compare(a.Name, b.Name) })
+	for i := range s.Roles {
+		slices.Sort(s.Roles[i].Permissions)
+	}
+	slices.SortFunc(s.Members, func(a, b SnapshotMember) int { return cmp.Compare(a.User, b.User) })
+	for i := range s.Members {
+		slices.Sort(s.Members[i].Roles)
+		slices.Sort(s.Members[i].Permissions)
+	}
+}
+
+// MarshalSnapshot sorts the snapshot and encodes it as YAML
+func MarshalSnapshot(s *Snapshot) ([]byte, error) {
+	s.Sort()
+	out, err := yaml.Marshal(s)
+	if err != nil {
+		return nil, fmt.Errorf("marshal iam snapshot: %w", err)
+	}
+	return out, nil
+}
+
+// UnmarshalSnapshot decodes a YAML snapshot and validates it
+func UnmarshalSnapshot(data []byte) (*Snapshot, error) {
+	var s Snapshot
+	if err := yaml.UnmarshalStrict(data, &s); err != nil {
+		return nil, fmt.Errorf("parse iam snapshot: %w", err)
+	}
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// Validate checks that the snapshot is self-contained: names are unique, every
+// referenced role and permission is declared, project roles are valid and the
+// project keeps an owner.
+func (s *Snapshot) Validate() error {
+	permissions := make(map[string]bool, len(s.Permissions))
+	for _, p := range s.Permissions {
+		if p.Name == "" {
+			return fmt.Errorf("permission without a name")
+		}
+		if permissions[p.Name] {
+			return fmt.Errorf("permission %s declared twice", p.Name)
+		}
+		permissions[p.Name] = true
+	}
+
+	roles := make(map[string]bool, len(s.Roles))
+	for _, r := range s.Roles {
+		if r.Name == "" {
+			return fmt.Errorf("role without a name")
+		}
+		if roles[r.Name] {
+			return fmt.Errorf("role %s declared twice", r.Name)
+		}
+		roles[r.Name] = true
+		for _, p := range r.Permissions {
+			if !permissions[p] {
+				return fmt.Errorf("role %s: permission %s is not declared", r.Name, p)
+			}
+		}
+	}
+
+	users := make(map[string]bool, len(s.Members))
+	hasOwner := false
+	for _, m := range s.Members {
+		if m.User == "" {
+			return fmt.Errorf("member without a user")
+		}
+		if users[m.User] {
+			return fmt.Errorf("member %s listed twice", m.User)
+		}
+		users[m.User] = true
+		if !validProjectRoles[m.Role] {
+			return fmt.Errorf("member %s: invalid project role %q (must be one of: owner, admin, member, user)", m.User, m.Role)
+		}
+		hasOwner = hasOwner || m.Role == ProjectRoleOwner
+		for _, r := range m.Roles {
+			if !roles[r] {
+				return fmt.Errorf("member %s: role %s is not declared", m.User, r)
+			}
+		}
+		for _, p := range m.Permissions {
+			if !permissions[p] {
+				return fmt.Errorf("member %s: permission %s is not declared", m.User, p)
+			}
+		}
+	}
+	if !hasOwner {
+		return fmt.Errorf("the project must keep an owner")
+	}
+
+	return nil
+}
+
+// diffNames returns the names of want missing from have and the names of
+// have missing from want, both sorted
+func diffNames(have, want []string) (added, removed []string) {
+	for _, name := range want {
+		if !slices.Contains(have, name) {
+			added = append(added, name)
+		}
+	}
+	for _, name := range have {
+		if !slices.Contains(want, name) {
+			removed = append(removed, name)
+		}
+	}
+	slices.Sort(added)
+	slices.Sort(removed)
+	return added, removed
+}
@@ -0,0 +1,77 @@
+package iam_mapper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	snapshot := &Snapshot{
+		Project:     "prj00000000001",
+		Permissions: []SnapshotPermission{{Name: "member:write"}, {Name: "employee:read", Description: "Read employees"}},
+		Roles:       []SnapshotRole{{Name: "editor", Permissions: []string{"member:write", "employee:read"}}},
+		Members: []SnapshotMember{
+			{User: "usr00000000002", Role: "member", Roles: []string{"editor"}},
+			{User: "usr00000000001", Email: "owner@example.com", Role: "owner", Permissions: []string{"employee:read"}},
+		},
+	}
+
+	out, err := MarshalSnapshot(snapshot)
+	require.NoError(t, err)
+	assert.Equal(t, `project: prj00000000001
+permissions:
+- name: employee:read
+  description: Read employees
+- name: member:write
+roles:
+- name: editor
+  permissions:
+  - employee:read
+  - member:write
+members:
+- user: usr00000000001
+  email: owner@example.com
+  role: owner
+  permissions:
+  - employee:read
+- user: usr00000000002
+  role: member
+  roles:
+  - editor
+`, string(out))
+
+	parsed, err := UnmarshalSnapshot(out)
+	require.NoError(t, err)
+	assert.Equal(t, snapshot, parsed)
+}
+
+func TestSnapshotValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		yaml    string
+		wantErr string
+	}{
+		{"unknown key", "members: []\nowners: []\n", "field owners not found"},
+		{"no owner", "members:\n- {user: u1, role: admin}\n", "must keep an owner"},
+		{"invalid project role", "members:\n- {user: u1, role: boss}\n", "invalid project role"},
+		{"duplicate member", "members:\n- {user: u1, role: owner}\n- {user: u1, role: user}\n", "listed twice"},
+		{"undeclared role", "members:\n- {user: u1, role: owner, roles: [editor]}\n", "role editor is not declared"},
+		{"undeclared permission", "roles:\n- {name: editor, permissions: [x:read]}\nmembers:\n- {user: u1, role: owner}\n", "permission x:read is not declared"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := UnmarshalSnapshot([]byte(tt.yaml))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestDiffNames(t *testing.T) {
+	added, removed := diffNames([]string{"b", "c"}, []string{"d", "a", "b"})
+	assert.Equal(t, []string{"a", "d"}, added)
+	assert.Equal(t, []string{"c"}, removed)
+}
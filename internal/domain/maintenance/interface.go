@@ -0,0 +1,9 @@
+package maintenance
+
+import "context"
+
+type Repositor interface {
+	// Get returns the stored switch, disabled when it was never set
+	Get(ctx context.Context) (*Mode, error)
+	Set(ctx context.Context, enabled bool, message string) error
+}
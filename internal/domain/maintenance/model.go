@@ -0,0 +1,14 @@
+package maintenance
+
+import "time"
+
+// BypassHeader is the header, or gRPC metadata key, requests carry a bypass
+// token in to be served during maintenance
+const BypassHeader = "X-Maintenance-Bypass"
+
+// Mode is the maintenance switch stored in the database
+type Mode struct {
+	Enabled   bool
+	Message   string // Shown to clients instead of the configured message when set
+	UpdatedAt time.Time
+}
@@ -0,0 +1,46 @@
+package maintenance
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/hrz8/altalune/internal/postgres"
+)
+
+type Repo struct {
+	db postgres.DB
+}
+
+func NewRepo(db postgres.DB) *Repo {
+	return &Repo{
+		db: db,
+	}
+}
+
+func (r *Repo) Get(ctx context.Context) (*Mode, error) {
+	var mode Mode
+	err := r.db.QueryRowContext(ctx, `SELECT enabled, message, updated_at FROM altalune_maintenance`).
+		Scan(&mode.Enabled, &mode.Message, &mode.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &Mode{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get maintenance mode: %w", err)
+	}
+	return &mode, nil
+}
+
+func (r *Repo) Set(ctx context.Context, enabled bool, message string) error {
+	query := `
+		INSERT INTO altalune_maintenance (enabled, message, updated_at)
+		VALUES ($1, $2, CURRENT_TIMESTAMP)
+		ON CONFLICT (id) DO UPDATE
+		SET enabled = EXCLUDED.enabled, message = EXCLUDED.message, updated_at = CURRENT_TIMESTAMP
+	`
+	if _, err := r.db.ExecContext(ctx, query, enabled, message); err != nil {
+		return fmt.Errorf("set maintenance mode: %w", err)
+	}
+	return nil
+}
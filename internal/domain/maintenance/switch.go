@@ -0,0 +1,102 @@
+package maintenance
+
+import (
+	"context"
+	"crypto/subtle"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hrz8/altalune"
+)
+
+// Options configures a Switch
+type Options struct {
+	Enabled      bool          // Maintenance is on whatever the database says
+	Message      string        // Shown to clients unless the database switch has one
+	RetryAfter   time.Duration // How long clients are told to wait
+	PollInterval time.Duration // How long the database switch is cached
+	BypassTokens []string      // Tokens whose requests are served as usual
+}
+
+// Switch tells the servers whether they are in maintenance. It is on when
+// forced by the configuration or the serve flag, or when the database switch
+// is, which is polled so every replica follows it without a restart.
+type Switch struct {
+	repo   Repositor
+	opts   Options
+	log    altalune.Logger
+	forced atomic.Bool
+
+	mu        sync.Mutex
+	mode      Mode
+	checkedAt time.Time
+}
+
+// NewSwitch creates a switch reading the database switch from repo
+func NewSwitch(repo Repositor, opts Options, log altalune.Logger) *Switch {
+	s := &Switch{
+		repo: repo,
+		opts: opts,
+		log:  log,
+	}
+	s.forced.Store(opts.Enabled)
+	return s
+}
+
+// Force turns maintenance on for the life of the process
+func (s *Switch) Force() {
+	s.forced.Store(true)
+}
+
+// Active reports whether the servers are in maintenance, with the message to
+// show clients
+func (s *Switch) Active(ctx context.Context) (bool, string) {
+	mode := s.stored(ctx)
+	if !s.forced.Load() && !mode.Enabled {
+		return false, ""
+	}
+	if mode.Enabled && mode.Message != "" {
+		return true, mode.Message
+	}
+	return true, s.opts.Message
+}
+
+// stored returns the database switch, read again once the cached one is older
+// than the poll interval. A failing read keeps the last known switch, so a
+// migration dropping the connections does not end maintenance.
+func (s *Switch) stored(ctx context.Context) Mode {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.checkedAt.IsZero() && time.Since(s.checkedAt) < s.opts.PollInterval {
+		return s.mode
+	}
+	s.checkedAt = time.Now()
+
+	mode, err := s.repo.Get(context.WithoutCancel(ctx))
+	if err != nil {
+		s.log.WarnContext(ctx, "failed to read maintenance mode", "error", err)
+		return s.mode
+	}
+	s.mode = *mode
+	return s.mode
+}
+
+// RetryAfter returns how long clients are told to wait
+func (s *Switch) RetryAfter() time.Duration {
+	return s.opts.RetryAfter
+}
+
+// Bypassed reports whether token is one of the configured bypass tokens
+func (s *Switch) Bypassed(token string) bool {
+	if token == "" {
+		return false
+	}
+	for _, bypass := range s.opts.BypassTokens {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(bypass)) == 1 {
+			return true
+		}
+	}
+	return false
+}
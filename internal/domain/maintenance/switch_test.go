@@ -0,0 +1,79 @@
+package maintenance
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hrz8/altalune/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeRepo struct {
+	mode  Mode
+	err   error
+	reads int
+}
+
+func (r *fakeRepo) Get(ctx context.Context) (*Mode, error) {
+	r.reads++
+	if r.err != nil {
+		return nil, r.err
+	}
+	mode := r.mode
+	return &mode, nil
+}
+
+func (r *fakeRepo) Set(ctx context.Context, enabled bool, message string) error {
+	r.mode = Mode{Enabled: enabled, Message: message}
+	return nil
+}
+
+func TestSwitchActive(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeRepo{}
+	s := NewSwitch(repo, Options{Message: "Upgrading", PollInterval: time.Hour}, logger.New("error"))
+
+	active, _ := s.Active(ctx)
+	assert.False(t, active)
+
+	// The database switch is cached for the poll interval
+	repo.mode = Mode{Enabled: true}
+	active, _ = s.Active(ctx)
+	assert.False(t, active)
+	assert.Equal(t, 1, repo.reads)
+
+	s.Force()
+	active, message := s.Active(ctx)
+	assert.True(t, active)
+	assert.Equal(t, "Upgrading", message)
+}
+
+func TestSwitchStored(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeRepo{mode: Mode{Enabled: true, Message: "Migrating the database"}}
+	s := NewSwitch(repo, Options{Message: "Upgrading"}, logger.New("error"))
+
+	active, message := s.Active(ctx)
+	assert.True(t, active)
+	assert.Equal(t, "Migrating the database", message)
+
+	// A failing read keeps the last known switch
+	repo.err = errors.New("connection refused")
+	active, _ = s.Active(ctx)
+	assert.True(t, active)
+
+	repo.err = nil
+	repo.mode = Mode{}
+	active, _ = s.Active(ctx)
+	assert.False(t, active)
+}
+
+func TestSwitchBypassed(t *testing.T) {
+	s := NewSwitch(&fakeRepo{}, Options{BypassTokens: []string{"0123456789abcdef"}}, logger.New("error"))
+
+	assert.True(t, s.Bypassed("0123456789abcdef"))
+	assert.False(t, s.Bypassed("0123456789abcdeX"))
+	assert.False(t, s.Bypassed(""))
+}
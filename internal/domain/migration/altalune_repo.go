@@ -4,12 +4,16 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/hrz8/altalune/database"
 	"github.com/hrz8/altalune/internal/postgres"
 	"github.com/pressly/goose/v3"
 )
 
+// lockID is the key of the advisory lock migrations take, "altalune" in ASCII
+const lockID int64 = 0x616c74616c756e65
+
 type AltaluneMigrationRepo struct {
 	db   postgres.DB
 	once sync.Once
@@ -33,7 +37,7 @@ func (r *AltaluneMigrationRepo) Up(ctx context.Context) error {
 	if db == nil {
 		return fmt.Errorf("unknown database connection")
 	}
-	return goose.Up(db, MigrationsDir)
+	return goose.UpContext(ctx, db, MigrationsDir)
 }
 
 func (r *AltaluneMigrationRepo) Down(ctx context.Context) error {
@@ -42,7 +46,7 @@ func (r *AltaluneMigrationRepo) Down(ctx context.Context) error {
 	if db == nil {
 		return fmt.Errorf("unknown database connection")
 	}
-	return goose.Down(db, MigrationsDir)
+	return goose.DownContext(ctx, db, MigrationsDir)
 }
 
 func (r *AltaluneMigrationRepo) PrintStatus(ctx context.Context) error {
@@ -51,5 +55,56 @@ func (r *AltaluneMigrationRepo) PrintStatus(ctx context.Context) error {
 	if db == nil {
 		return fmt.Errorf("unknown database connection")
 	}
-	return goose.Status(db, MigrationsDir)
+	return goose.StatusContext(ctx, db, MigrationsDir)
+}
+
+func (r *AltaluneMigrationRepo) Version(ctx context.Context) (int64, error) {
+	// Read without goose, which would create the table when missing
+	query := fmt.Sprintf(`
+		SELECT CASE WHEN to_regclass('%[1]s') IS NULL THEN 0
+			ELSE (SELECT COALESCE(MAX(version_id), 0) FROM %[1]s WHERE is_applied) END
+	`, MigrationsTableName)
+
+	var version int64
+	if err := r.db.QueryRowContext(ctx, query).Scan(&version); err != nil {
+		return 0, fmt.Errorf("get schema version: %w", err)
+	}
+	return version, nil
+}
+
+func (r *AltaluneMigrationRepo) LatestVersion() (int64, error) {
+	r.configure()
+	migrations, err := goose.CollectMigrations(MigrationsDir, 0, goose.MaxVersion)
+	if err != nil {
+		return 0, fmt.Errorf("collect migrations: %w", err)
+	}
+	latest, err := migrations.Last()
+	if err != nil {
+		return 0, fmt.Errorf("get latest migration: %w", err)
+	}
+	return latest.Version, nil
+}
+
+// Lock holds a session advisory lock on a connection of its own, released by
+// unlock or when the connection drops
+func (r *AltaluneMigrationRepo) Lock(ctx context.Context) (func(), error) {
+	db := r.db.GetDB()
+	if db == nil {
+		return nil, fmt.Errorf("unknown database connection")
+	}
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get lock connection: %w", err)
+	}
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", lockID); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("take migration lock: %w", err)
+	}
+
+	return func() {
+		unlockCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Second)
+		defer cancel()
+		_, _ = conn.ExecContext(unlockCtx, "SELECT pg_advisory_unlock($1)", lockID)
+		conn.Close()
+	}, nil
 }
@@ -6,4 +6,11 @@ type Migrator interface {
 	Down(ctx context.Context) error
 	PrintStatus(ctx context.Context) error
 	Up(ctx context.Context) error
+	// Version returns the latest applied version, 0 before the first migration
+	Version(ctx context.Context) (int64, error)
+	// LatestVersion returns the version of the latest embedded migration
+	LatestVersion() (int64, error)
+	// Lock takes the migration advisory lock, waiting while another process
+	// holds it
+	Lock(ctx context.Context) (unlock func(), err error)
 }
@@ -0,0 +1,101 @@
+package migration_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hrz8/altalune/internal/domain/migration"
+	"github.com/hrz8/altalune/internal/testdb"
+	"github.com/hrz8/altalune/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) { testdb.Main(m) }
+
+func TestRepoVersion(t *testing.T) {
+	ctx := context.Background()
+	db := testdb.Tx(t)
+	repo := migration.NewAltaluneMigrationRepo(db)
+
+	latest, err := repo.LatestVersion()
+	require.NoError(t, err)
+	assert.Positive(t, latest)
+
+	version, err := repo.Version(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, latest, version, "testdb migrates up")
+
+	_, err = db.ExecContext(ctx, `DROP TABLE `+migration.MigrationsTableName)
+	require.NoError(t, err)
+	version, err = repo.Version(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, version, "a database never migrated is at version 0")
+}
+
+func TestRepoLock(t *testing.T) {
+	repo := migration.NewAltaluneMigrationRepo(testdb.Open(t))
+
+	unlock, err := repo.Lock(context.Background())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	_, err = repo.Lock(ctx)
+	assert.Error(t, err, "waits while another connection holds the lock")
+
+	unlock()
+	unlockAgain, err := repo.Lock(context.Background())
+	require.NoError(t, err, "taken once released")
+	unlockAgain()
+}
+
+func TestServiceWithLockMigratesOneAtATime(t *testing.T) {
+	ctx := context.Background()
+	svc := migration.NewService(logger.New("error"), migration.NewAltaluneMigrationRepo(testdb.Open(t)))
+
+	var (
+		wg               sync.WaitGroup
+		active, upToDate atomic.Int32
+		overlapped       atomic.Bool
+		replicas         = 3
+		errs             = make(chan error, replicas)
+	)
+	for range replicas {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- svc.WithLock(ctx, func() error {
+				if active.Add(1) > 1 {
+					overlapped.Store(true)
+				}
+				defer active.Add(-1)
+
+				if err := svc.MigrateUp(ctx); err != nil {
+					return err
+				}
+				current, expected, err := svc.SchemaStatus(ctx)
+				if err != nil {
+					return err
+				}
+				if current >= expected {
+					upToDate.Add(1)
+				}
+				time.Sleep(20 * time.Millisecond)
+				return nil
+			})
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	assert.False(t, overlapped.Load(), "one replica holds the lock at a time")
+	assert.Equal(t, int32(replicas), upToDate.Load())
+	require.NoError(t, svc.WaitForSchema(ctx, time.Millisecond))
+}
@@ -3,6 +3,7 @@ package migration
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/hrz8/altalune"
 )
@@ -55,3 +56,53 @@ func (s *Service) MigrationStatus(ctx context.Context) error {
 	}
 	return nil
 }
+
+// WithLock runs fn holding the migration lock, so that replicas starting
+// together, or migration jobs, migrate one at a time
+func (s *Service) WithLock(ctx context.Context, fn func() error) error {
+	s.log.Info("Waiting for the migration lock...")
+	unlock, err := s.migrationRepo.Lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	return fn()
+}
+
+// SchemaStatus returns the applied schema version and the one this build
+// expects, that of its latest migration
+func (s *Service) SchemaStatus(ctx context.Context) (current, expected int64, err error) {
+	expected, err = s.migrationRepo.LatestVersion()
+	if err != nil {
+		return 0, 0, err
+	}
+	current, err = s.migrationRepo.Version(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	return current, expected, nil
+}
+
+// WaitForSchema returns once the schema is at least at the expected version,
+// checking every interval, or with the error of ctx when it ends first. A
+// newer schema is accepted, so replicas of the previous release keep running
+// during a rolling upgrade.
+func (s *Service) WaitForSchema(ctx context.Context, interval time.Duration) error {
+	for {
+		current, expected, err := s.SchemaStatus(ctx)
+		if err != nil {
+			s.log.Warn("failed to check schema version", "error", err)
+		} else if current >= expected {
+			s.log.Info("Database schema is up to date", "version", current)
+			return nil
+		} else {
+			s.log.Info("Waiting for database migrations", "version", current, "expected_version", expected)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("schema is not at the expected version: %w", ctx.Err())
+		case <-time.After(interval):
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package migration
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hrz8/altalune/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeMigrator reports the versions it is given, one per Version call, the
+// last one repeating; Up, Down and PrintStatus are never called
+type fakeMigrator struct {
+	Migrator
+
+	mu       sync.Mutex
+	versions []int64
+	latest   int64
+	err      error // Returned by the first Version call
+	calls    int
+
+	lockErr  error
+	locked   bool
+	unlocked bool
+}
+
+func (m *fakeMigrator) Version(context.Context) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.calls++
+	if m.err != nil && m.calls == 1 {
+		return 0, m.err
+	}
+	version := m.versions[min(m.calls, len(m.versions))-1]
+	return version, nil
+}
+
+func (m *fakeMigrator) LatestVersion() (int64, error) {
+	return m.latest, nil
+}
+
+func (m *fakeMigrator) Lock(context.Context) (func(), error) {
+	if m.lockErr != nil {
+		return nil, m.lockErr
+	}
+	m.locked = true
+	return func() { m.unlocked = true }, nil
+}
+
+func TestSchemaStatus(t *testing.T) {
+	svc := NewService(logger.New("error"), &fakeMigrator{versions: []int64{3}, latest: 5})
+
+	current, expected, err := svc.SchemaStatus(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), current)
+	assert.Equal(t, int64(5), expected)
+
+	svc = NewService(logger.New("error"), &fakeMigrator{err: errors.New("connection refused")})
+	_, _, err = svc.SchemaStatus(context.Background())
+	assert.EqualError(t, err, "connection refused")
+}
+
+func TestWaitForSchema(t *testing.T) {
+	tests := []struct {
+		name      string
+		migrator  *fakeMigrator
+		wantErr   bool
+		wantCalls int
+	}{
+		{
+			name:      "up to date",
+			migrator:  &fakeMigrator{versions: []int64{5}, latest: 5},
+			wantCalls: 1,
+		},
+		{
+			name:      "newer schema of a rolling upgrade",
+			migrator:  &fakeMigrator{versions: []int64{6}, latest: 5},
+			wantCalls: 1,
+		},
+		{
+			name:      "migrated while waiting",
+			migrator:  &fakeMigrator{versions: []int64{0, 3, 5}, latest: 5},
+			wantCalls: 3,
+		},
+		{
+			name:      "check failures are retried",
+			migrator:  &fakeMigrator{versions: []int64{5}, latest: 5, err: errors.New("connection refused")},
+			wantCalls: 2,
+		},
+		{
+			name:     "never migrated",
+			migrator: &fakeMigrator{versions: []int64{3}, latest: 5},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+			defer cancel()
+
+			err := NewService(logger.New("error"), tt.migrator).WaitForSchema(ctx, time.Millisecond)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, context.DeadlineExceeded)
+				assert.ErrorContains(t, err, "schema is not at the expected version")
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantCalls, tt.migrator.calls)
+		})
+	}
+}
+
+func TestWithLock(t *testing.T) {
+	m := &fakeMigrator{}
+	svc := NewService(logger.New("error"), m)
+
+	err := svc.WithLock(context.Background(), func() error {
+		assert.True(t, m.locked)
+		assert.False(t, m.unlocked, "held while fn runs")
+		return errors.New("migration failed")
+	})
+	assert.EqualError(t, err, "migration failed")
+	assert.True(t, m.unlocked, "released when fn fails")
+
+	m = &fakeMigrator{lockErr: errors.New("take migration lock: canceled")}
+	called := false
+	err = NewService(logger.New("error"), m).WithLock(context.Background(), func() error {
+		called = true
+		return nil
+	})
+	assert.EqualError(t, err, "take migration lock: canceled")
+	assert.False(t, called, "fn does not run without the lock")
+}
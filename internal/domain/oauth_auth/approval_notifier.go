@@ -0,0 +1,76 @@
+package oauth_auth
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hrz8/altalune"
+	"github.com/hrz8/altalune/internal/shared/notification"
+	"github.com/hrz8/altalune/internal/shared/tz"
+)
+
+// ApprovalNotifier emails the owners of a project when a self-registered user
+// awaits approval.
+type ApprovalNotifier struct {
+	owners       ProjectOwnerRepositor
+	projects     ProjectTimezoneRepositor
+	notification *notification.NotificationService
+	log          altalune.Logger
+}
+
+// NewApprovalNotifier creates a new approval notifier.
+func NewApprovalNotifier(
+	owners ProjectOwnerRepositor,
+	projects ProjectTimezoneRepositor,
+	notificationSvc *notification.NotificationService,
+	log altalune.Logger,
+) *ApprovalNotifier {
+	return &ApprovalNotifier{
+		owners:       owners,
+		projects:     projects,
+		notification: notificationSvc,
+		log:          log,
+	}
+}
+
+// NotifyPendingApproval emails every active owner of the project. Failures are
+// logged only: the user stays in the approval queue either way.
+func (n *ApprovalNotifier) NotifyPendingApproval(ctx context.Context, projectID int64, email, firstName, lastName string) {
+	owners, err := n.owners.GetProjectOwners(ctx, projectID)
+	if err != nil {
+		n.log.Error("failed to get project owners for approval request", "error", err, "projectID", projectID)
+		return
+	}
+	if len(owners) == 0 {
+		n.log.Warn("no project owner to notify of pending approval", "projectID", projectID)
+		return
+	}
+
+	// Owners read the time in the project's time zone, UTC when unknown
+	timezone, err := n.projects.GetTimezone(ctx, projectID)
+	if err != nil {
+		n.log.Warn("failed to get project timezone for approval request", "error", err, "projectID", projectID)
+	}
+	requestedAt := tz.Format(time.Now(), tz.Load(timezone))
+
+	userName := strings.TrimSpace(firstName + " " + lastName)
+	if userName == "" {
+		userName = email
+	}
+
+	for _, owner := range owners {
+		ownerName := owner.FirstName
+		if ownerName == "" {
+			ownerName = owner.Email
+		}
+		if err := n.notification.SendApprovalRequestEmail(ctx, owner.Email, notification.ApprovalRequestEmailData{
+			OwnerName:   ownerName,
+			UserName:    userName,
+			UserEmail:   email,
+			RequestedAt: requestedAt,
+		}); err != nil {
+			n.log.Warn("failed to send approval request email", "error", err, "projectID", projectID)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package oauth_auth
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// clientSecretCacheMaxEntries caps the cache, entries are only added for
+// clients whose secret verified so it stays within the number of clients.
+const clientSecretCacheMaxEntries = 4096
+
+type clientSecretCacheEntry struct {
+	secretHash string   // Stored hash the secret was verified against
+	digest     [32]byte // SHA-256 of the secret
+	expiresAt  time.Time
+}
+
+// clientSecretCache remembers the last secret verified for each client so
+// that clients requesting tokens at a high rate skip the Argon2id
+// verification until the entry expires. Entries keep the stored hash they
+// were verified against: once the secret is rotated they no longer match.
+// A nil cache remembers nothing.
+type clientSecretCache struct {
+	ttl     time.Duration
+	mu      sync.RWMutex
+	entries map[uuid.UUID]clientSecretCacheEntry
+}
+
+// newClientSecretCache returns a cache keeping secrets for ttl, or nil when
+// ttl is not positive
+func newClientSecretCache(ttl time.Duration) *clientSecretCache {
+	if ttl <= 0 {
+		return nil
+	}
+	return &clientSecretCache{
+		ttl:     ttl,
+		entries: make(map[uuid.UUID]clientSecretCacheEntry),
+	}
+}
+
+// verified reports whether secret was verified for client against its current
+// hash less than the TTL ago
+func (c *clientSecretCache) verified(client *OAuthClientInfo, secret string) bool {
+	if c == nil || client.SecretHash == nil {
+		return false
+	}
+
+	c.mu.RLock()
+	entry, ok := c.entries[client.ClientID]
+	c.mu.RUnlock()
+	if !ok || time.Now().After(entry.expiresAt) || entry.secretHash != *client.SecretHash {
+		return false
+	}
+
+	digest := sha256.Sum256([]byte(secret))
+	return subtle.ConstantTimeCompare(digest[:], entry.digest[:]) == 1
+}
+
+// remember records that secret verified against the current hash of client
+func (c *clientSecretCache) remember(client *OAuthClientInfo, secret string) {
+	if c == nil || client.SecretHash == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.entries) >= clientSecretCacheMaxEntries {
+		c.entries = make(map[uuid.UUID]clientSecretCacheEntry)
+	}
+	c.entries[client.ClientID] = clientSecretCacheEntry{
+		secretHash: *client.SecretHash,
+		digest:     sha256.Sum256([]byte(secret)),
+		expiresAt:  time.Now().Add(c.ttl),
+	}
+}
@@ -0,0 +1,33 @@
+package oauth_auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientSecretCache(t *testing.T) {
+	hash, rotated := "$argon2id$old", "$argon2id$new"
+	client := &OAuthClientInfo{ClientID: uuid.New(), SecretHash: &hash, Confidential: true}
+
+	disabled := newClientSecretCache(0)
+	disabled.remember(client, "secret")
+	assert.False(t, disabled.verified(client, "secret"), "a zero TTL disables the cache")
+
+	cache := newClientSecretCache(time.Minute)
+	assert.False(t, cache.verified(client, "secret"))
+
+	cache.remember(client, "secret")
+	assert.True(t, cache.verified(client, "secret"))
+	assert.False(t, cache.verified(client, "other-secret"))
+	assert.False(t, cache.verified(&OAuthClientInfo{ClientID: uuid.New(), SecretHash: &hash}, "secret"), "entries are per client")
+	assert.False(t, cache.verified(&OAuthClientInfo{ClientID: client.ClientID, SecretHash: &rotated}, "secret"),
+		"rotating the secret invalidates the entry")
+
+	entry := cache.entries[client.ClientID]
+	entry.expiresAt = time.Now().Add(-time.Second)
+	cache.entries[client.ClientID] = entry
+	assert.False(t, cache.verified(client, "secret"), "entries expire")
+}
@@ -0,0 +1,687 @@
+package oauth_auth_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hrz8/altalune"
+	"github.com/hrz8/altalune/internal/domain/iam_mapper"
+	"github.com/hrz8/altalune/internal/domain/oauth_auth"
+	"github.com/hrz8/altalune/internal/domain/permission"
+	"github.com/hrz8/altalune/internal/domain/project_hostname"
+	"github.com/hrz8/altalune/internal/domain/role"
+	"github.com/hrz8/altalune/internal/domain/user"
+	"github.com/hrz8/altalune/internal/session"
+	"github.com/hrz8/altalune/internal/shared/cookie"
+	"github.com/hrz8/altalune/internal/shared/jwt"
+	"github.com/hrz8/altalune/internal/shared/password"
+	"github.com/hrz8/altalune/internal/shared/pkce"
+	"github.com/hrz8/altalune/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The conformance tests drive the OAuth 2.0 / OpenID Connect endpoints of the
+// handler over HTTP the way a relying party and a browser do, checking the
+// responses the specs mandate: RFC 6749 (authorization code and refresh
+// grants), RFC 7636 (PKCE), RFC 7009 (revocation), RFC 7662 (introspection)
+// and OpenID Connect Core (userinfo) and Discovery.
+
+const (
+	conformanceRedirectURI  = "https://rp.example.com/callback"
+	conformanceClientSecret = "conformance-client-secret"
+	conformanceScope        = "openid profile email offline_access"
+)
+
+// conformanceConfig holds the configuration the handler reads, the other
+// getters are never called
+type conformanceConfig struct {
+	altalune.Config
+	issuer string
+}
+
+func (c *conformanceConfig) GetJWTIssuer() string                   { return c.issuer }
+func (c *conformanceConfig) IsAuthHostIssuer() bool                 { return false }
+func (c *conformanceConfig) GetAuthServiceDocumentation() string    { return "https://docs.example.com" }
+func (c *conformanceConfig) GetAuthPolicyURI() string               { return "" }
+func (c *conformanceConfig) GetAuthTOSURI() string                  { return "" }
+func (c *conformanceConfig) GetCodeExpiry() int                     { return 60 }
+func (c *conformanceConfig) GetAccessTokenExpiry() int              { return 300 }
+func (c *conformanceConfig) GetRefreshTokenExpiry() int             { return 3600 }
+func (c *conformanceConfig) GetAuthServerBrandingName() string      { return "Altalune" }
+func (c *conformanceConfig) GetAuthDefaultLocale() string           { return "en" }
+func (c *conformanceConfig) GetPasswordHashIterations() uint32      { return 1 }
+func (c *conformanceConfig) GetPasswordHashMemory() uint32          { return 1024 }
+func (c *conformanceConfig) GetPasswordHashThreads() uint8          { return 1 }
+func (c *conformanceConfig) GetPasswordHashLength() uint32          { return 32 }
+func (c *conformanceConfig) GetClientSecretCacheTTL() time.Duration { return time.Minute }
+
+// conformanceServer is the handler served in-process with in-memory
+// repositories, one signed-in user and two clients: a confidential one and a
+// public one, both requiring PKCE
+type conformanceServer struct {
+	*httptest.Server
+	signer       *jwt.Signer
+	repo         *oauth_auth.InMemRepo
+	iam          *iam_mapper.InMemRepo
+	user         *user.CreateUserResult
+	confidential uuid.UUID
+	public       uuid.UUID
+}
+
+func newConformanceServer(t *testing.T) *conformanceServer {
+	t.Helper()
+	ctx := context.Background()
+
+	mux := http.NewServeMux()
+	srv := &conformanceServer{Server: httptest.NewServer(mux)}
+	t.Cleanup(srv.Close)
+
+	key, err := jwt.GenerateRSAKeyPair(2048)
+	require.NoError(t, err)
+	dir := t.TempDir()
+	privateKeyPath, publicKeyPath := filepath.Join(dir, "private.pem"), filepath.Join(dir, "public.pem")
+	require.NoError(t, jwt.SavePrivateKeyPEM(key, privateKeyPath))
+	require.NoError(t, jwt.SavePublicKeyPEM(&key.PublicKey, publicKeyPath))
+	srv.signer, err = jwt.NewSigner(privateKeyPath, publicKeyPath, "conformance", srv.URL)
+	require.NoError(t, err)
+
+	users := user.NewInMemRepo()
+	srv.user, err = users.Create(ctx, &user.CreateUserInput{Email: "jane@example.com", FirstName: "Jane", LastName: "Doe"})
+	require.NoError(t, err)
+	userLookup := oauth_auth.NewInMemUserRepo()
+	userLookup.PutUser(&oauth_auth.UserInfo{
+		ID:        srv.user.ID,
+		PublicID:  srv.user.PublicID,
+		Email:     srv.user.Email,
+		FirstName: srv.user.FirstName,
+		LastName:  srv.user.LastName,
+		IsActive:  true,
+	})
+	iamMapper := iam_mapper.NewInMemRepo()
+	iamMapper.PutUser(srv.user.ID, &user.User{ID: srv.user.PublicID, Email: srv.user.Email, IsActive: true})
+	srv.iam = iamMapper
+
+	cfg := &conformanceConfig{issuer: srv.URL}
+	repo := oauth_auth.NewInMemRepo()
+	srv.repo = repo
+	secretHash, err := password.HashPassword(conformanceClientSecret, password.OptionFromConfig(cfg))
+	require.NoError(t, err)
+	srv.confidential, srv.public = uuid.New(), uuid.New()
+	repo.PutOAuthClient(&oauth_auth.OAuthClientInfo{
+		ID:           1,
+		ClientID:     srv.confidential,
+		Name:         "Confidential RP",
+		RedirectURIs: []string{conformanceRedirectURI},
+		PKCERequired: true,
+		SecretHash:   &secretHash,
+		Confidential: true,
+	})
+	repo.PutOAuthClient(&oauth_auth.OAuthClientInfo{
+		ID:           2,
+		ClientID:     srv.public,
+		Name:         "Public RP",
+		RedirectURIs: []string{conformanceRedirectURI},
+		PKCERequired: true,
+	})
+
+	log := logger.NewWithOptions(logger.Options{Level: "error", Output: io.Discard})
+	svc := oauth_auth.NewService(log, repo, userLookup, srv.signer, cfg,
+		oauth_auth.NewPermissionService(iamMapper),
+		oauth_auth.NewMembershipService(iamMapper, nil),
+		oauth_auth.NewScopeHandlerRegistry(),
+	)
+	sessionStore := session.NewStore("conformance-session-secret-0123456789", cookie.Options{}, 0, time.Hour)
+	h := oauth_auth.NewHandler(svc, cfg, srv.signer, sessionStore, nil, users, nil, nil, nil, nil, nil, nil, nil, log)
+
+	mux.HandleFunc("GET /oauth/authorize", h.HandleAuthorize)
+	mux.HandleFunc("POST /oauth/authorize", h.HandleAuthorizeProcess)
+	mux.HandleFunc("POST /oauth/token", h.HandleToken)
+	mux.HandleFunc("GET /oauth/userinfo", h.HandleUserInfo)
+	mux.HandleFunc("POST /oauth/revoke", h.HandleRevoke)
+	mux.HandleFunc("POST /oauth/introspect", h.HandleIntrospect)
+	mux.HandleFunc("GET /.well-known/jwks.json", h.HandleJWKS)
+	mux.HandleFunc("GET /.well-known/openid-configuration", h.HandleOpenIDConfiguration)
+	mux.HandleFunc("GET /auth/callback", h.HandleOAuthCallback)
+
+	// Serves authorization requests as if on a hostname of a sandbox project
+	mux.HandleFunc("GET /sandbox/oauth/authorize", func(w http.ResponseWriter, r *http.Request) {
+		tenant := &project_hostname.Tenant{ProjectID: "sandbox0000001", Sandbox: true}
+		h.HandleAuthorize(w, r.WithContext(oauth_auth.WithTenant(r.Context(), tenant)))
+	})
+
+	// Stands in for the login pages, which need an upstream provider or email
+	mux.HandleFunc("POST /test/sign-in", func(w http.ResponseWriter, r *http.Request) {
+		err := sessionStore.SetData(r, w, &session.Data{UserID: srv.user.ID, AuthenticatedAt: time.Now()})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+
+	// Stands in for the redirection to a sign in provider, started long ago
+	mux.HandleFunc("POST /test/stale-provider-login", func(w http.ResponseWriter, r *http.Request) {
+		err := sessionStore.SetData(r, w, &session.Data{
+			OAuthState:    "stale-state",
+			OAuthStateAt:  time.Now().Add(-time.Hour),
+			OAuthProvider: "google",
+			OriginalURL:   "/oauth/authorize?client_id=abc",
+		})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+
+	return srv
+}
+
+// browser returns a client keeping cookies and not following redirects,
+// signed in as the user of the server
+func (s *conformanceServer) browser(t *testing.T) *http.Client {
+	t.Helper()
+
+	jar, err := cookiejar.New(nil)
+	require.NoError(t, err)
+	b := &http.Client{
+		Jar: jar,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	resp, err := b.Post(s.URL+"/test/sign-in", "", nil)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	return b
+}
+
+var hiddenInputPattern = regexp.MustCompile(`<input type="hidden" name="([a-z_]+)" value="([^"]*)">`)
+
+// authorize runs an authorization request in b, granting consent when asked,
+// and returns the query of the redirection to the client
+func (s *conformanceServer) authorize(t *testing.T, b *http.Client, params url.Values) url.Values {
+	t.Helper()
+
+	resp, err := b.Get(s.URL + "/oauth/authorize?" + params.Encode())
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	require.NoError(t, err)
+
+	if resp.StatusCode == http.StatusOK {
+		consent := url.Values{"decision": {"allow"}}
+		for _, m := range hiddenInputPattern.FindAllStringSubmatch(string(body), -1) {
+			consent.Set(m[1], html.UnescapeString(m[2]))
+		}
+		require.NotEmpty(t, consent.Get("csrf_token"), "the consent page carries a CSRF token")
+
+		resp, err = b.PostForm(s.URL+"/oauth/authorize", consent)
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+
+	require.Equal(t, http.StatusFound, resp.StatusCode)
+	location, err := url.Parse(resp.Header.Get("Location"))
+	require.NoError(t, err)
+	require.Equal(t, conformanceRedirectURI, location.Scheme+"://"+location.Host+location.Path)
+	return location.Query()
+}
+
+// authorizationRequest returns the parameters of an authorization request of
+// clientID with an S256 challenge of verifier
+func authorizationRequest(clientID uuid.UUID, verifier, state string) url.Values {
+	return url.Values{
+		"response_type":         {"code"},
+		"client_id":             {clientID.String()},
+		"redirect_uri":          {conformanceRedirectURI},
+		"scope":                 {conformanceScope},
+		"state":                 {state},
+		"nonce":                 {"n-" + state},
+		"code_challenge":        {pkce.GenerateCodeChallenge(verifier, pkce.MethodS256)},
+		"code_challenge_method": {pkce.MethodS256},
+	}
+}
+
+// post sends a form, authenticated with HTTP Basic when secret is set, and
+// decodes the JSON response
+func (s *conformanceServer) post(t *testing.T, path string, clientID uuid.UUID, secret string, form url.Values) (*http.Response, map[string]any) {
+	t.Helper()
+
+	if secret == "" {
+		form.Set("client_id", clientID.String())
+	}
+	req, err := http.NewRequest(http.MethodPost, s.URL+path, strings.NewReader(form.Encode()))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if secret != "" {
+		req.SetBasicAuth(clientID.String(), secret)
+	}
+
+	return s.do(t, req)
+}
+
+func (s *conformanceServer) do(t *testing.T, req *http.Request) (*http.Response, map[string]any) {
+	t.Helper()
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	var decoded map[string]any
+	if len(body) > 0 {
+		require.NoError(t, json.Unmarshal(body, &decoded), "body: %s", body)
+	}
+	return resp, decoded
+}
+
+// tokens runs the whole authorization code flow for clientID and returns the
+// token response
+func (s *conformanceServer) tokens(t *testing.T, clientID uuid.UUID, secret string) map[string]any {
+	t.Helper()
+
+	verifier := strings.Repeat("v", 43)
+	code := s.authorize(t, s.browser(t), authorizationRequest(clientID, verifier, "xyz")).Get("code")
+	resp, body := s.post(t, "/oauth/token", clientID, secret, url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {conformanceRedirectURI},
+		"code_verifier": {verifier},
+	})
+	require.Equal(t, http.StatusOK, resp.StatusCode, "token response: %v", body)
+	return body
+}
+
+func TestConformanceDiscovery(t *testing.T) {
+	srv := newConformanceServer(t)
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL+"/.well-known/openid-configuration", nil)
+	require.NoError(t, err)
+	resp, config := srv.do(t, req)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, srv.URL, config["issuer"], "the issuer matches the iss claim of the tokens")
+	for _, endpoint := range []string{"authorization_endpoint", "token_endpoint", "userinfo_endpoint", "jwks_uri", "revocation_endpoint", "introspection_endpoint"} {
+		assert.True(t, strings.HasPrefix(config[endpoint].(string), srv.URL+"/"), endpoint)
+	}
+	assert.Contains(t, config["response_types_supported"], "code")
+	assert.Contains(t, config["code_challenge_methods_supported"], pkce.MethodS256)
+	assert.Equal(t, "https://docs.example.com", config["service_documentation"])
+	assert.NotContains(t, config, "op_policy_uri", "unset pages are omitted")
+	assert.Equal(t, false, config["request_parameter_supported"])
+	assert.Equal(t, false, config["request_uri_parameter_supported"], "the spec defaults it to true")
+	assert.Equal(t, false, config["claims_parameter_supported"])
+
+	req, err = http.NewRequest(http.MethodGet, srv.URL+"/.well-known/jwks.json", nil)
+	require.NoError(t, err)
+	resp, jwks := srv.do(t, req)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	keys := jwks["keys"].([]any)
+	require.Len(t, keys, 1)
+	assert.Equal(t, "conformance", keys[0].(map[string]any)["kid"])
+}
+
+func TestConformanceAuthorizationCode(t *testing.T) {
+	srv := newConformanceServer(t)
+	b := srv.browser(t)
+	verifier := strings.Repeat("a", 43)
+
+	redirect := srv.authorize(t, b, authorizationRequest(srv.public, verifier, "state-1"))
+	assert.Equal(t, "state-1", redirect.Get("state"), "the state is returned unchanged")
+	code := redirect.Get("code")
+	require.NotEmpty(t, code)
+
+	exchange := url.Values{
+		"grant_type":   {"authorization_code"},
+		"code":         {code},
+		"redirect_uri": {conformanceRedirectURI},
+	}
+	resp, body := srv.post(t, "/oauth/token", srv.public, "", exchange)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, "invalid_request", body["error"], "public clients must send a code_verifier")
+
+	exchange.Set("code_verifier", strings.Repeat("b", 43))
+	resp, body = srv.post(t, "/oauth/token", srv.public, "", exchange)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, "invalid_grant", body["error"], "the code_verifier must match the code_challenge")
+
+	exchange.Set("code_verifier", verifier)
+	exchange.Set("redirect_uri", "https://rp.example.com/other")
+	resp, body = srv.post(t, "/oauth/token", srv.public, "", exchange)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, "invalid_grant", body["error"], "the redirect_uri must match the authorization request")
+
+	exchange.Set("redirect_uri", conformanceRedirectURI)
+	resp, body = srv.post(t, "/oauth/token", srv.public, "", exchange)
+	require.Equal(t, http.StatusOK, resp.StatusCode, "token response: %v", body)
+	assert.Equal(t, "no-store", resp.Header.Get("Cache-Control"))
+	assert.Equal(t, "Bearer", body["token_type"])
+	assert.Equal(t, conformanceScope, body["scope"])
+	assert.EqualValues(t, 300, body["expires_in"])
+	assert.NotEmpty(t, body["refresh_token"])
+
+	claims, err := srv.signer.ValidateAccessToken(body["access_token"].(string))
+	require.NoError(t, err)
+	assert.Equal(t, srv.URL, claims.Issuer)
+	assert.Equal(t, srv.user.PublicID, claims.Subject)
+	assert.Equal(t, []string{srv.public.String()}, []string(claims.Audience))
+
+	resp, body = srv.post(t, "/oauth/token", srv.public, "", exchange)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, "invalid_grant", body["error"], "codes are single use")
+
+	redirect = srv.authorize(t, b, authorizationRequest(srv.public, verifier, "state-2"))
+	assert.NotEmpty(t, redirect.Get("code"), "consent is remembered")
+
+	params := authorizationRequest(srv.public, verifier, "state-3")
+	params.Del("code_challenge")
+	redirect = srv.authorize(t, b, params)
+	assert.Equal(t, "invalid_request", redirect.Get("error"), "clients requiring PKCE must send a code_challenge")
+	assert.Equal(t, "state-3", redirect.Get("state"))
+
+	resp, body = srv.post(t, "/oauth/token", srv.confidential, "wrong-secret", exchange)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	assert.Equal(t, "invalid_client", body["error"])
+}
+
+func TestConformanceRefreshToken(t *testing.T) {
+	srv := newConformanceServer(t)
+	issued := srv.tokens(t, srv.public, "")
+
+	refresh := url.Values{"grant_type": {"refresh_token"}, "refresh_token": {issued["refresh_token"].(string)}}
+	resp, body := srv.post(t, "/oauth/token", srv.confidential, conformanceClientSecret, refresh)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, "invalid_grant", body["error"], "refresh tokens are bound to their client")
+
+	resp, refreshed := srv.post(t, "/oauth/token", srv.public, "", refresh)
+	require.Equal(t, http.StatusOK, resp.StatusCode, "token response: %v", refreshed)
+	assert.Equal(t, conformanceScope, refreshed["scope"], "the refreshed tokens keep the granted scope")
+	assert.NotEqual(t, issued["refresh_token"], refreshed["refresh_token"], "refresh tokens are rotated")
+	_, err := srv.signer.ValidateAccessToken(refreshed["access_token"].(string))
+	assert.NoError(t, err)
+
+	resp, body = srv.post(t, "/oauth/token", srv.public, "", refresh)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, "invalid_grant", body["error"], "rotated refresh tokens cannot be used again")
+
+	resp, body = srv.post(t, "/oauth/token", srv.public, "", url.Values{"grant_type": {"password"}})
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, "unsupported_grant_type", body["error"])
+
+	assert.Equal(t, oauth_auth.TokenStats{Issued: 2, Failed: 2}, srv.tokenStats(srv.public), "token requests are counted per client")
+	assert.Equal(t, oauth_auth.TokenStats{Failed: 1}, srv.tokenStats(srv.confidential))
+}
+
+// tokenStats returns the token request counts of a client over this hour and
+// the previous one, in case the test crossed the hour
+func (s *conformanceServer) tokenStats(clientID uuid.UUID) oauth_auth.TokenStats {
+	hour := time.Now().UTC().Truncate(time.Hour)
+	current, previous := s.repo.TokenStats(clientID, hour), s.repo.TokenStats(clientID, hour.Add(-time.Hour))
+	return oauth_auth.TokenStats{Issued: current.Issued + previous.Issued, Failed: current.Failed + previous.Failed}
+}
+
+func TestConformanceUserInfo(t *testing.T) {
+	srv := newConformanceServer(t)
+	issued := srv.tokens(t, srv.public, "")
+
+	userInfo := func(authorization string) (*http.Response, map[string]any) {
+		req, err := http.NewRequest(http.MethodGet, srv.URL+"/oauth/userinfo", nil)
+		require.NoError(t, err)
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		return srv.do(t, req)
+	}
+
+	resp, claims := userInfo("Bearer " + issued["access_token"].(string))
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "no-store", resp.Header.Get("Cache-Control"))
+	assert.Equal(t, srv.user.PublicID, claims["sub"], "sub matches the subject of the access token")
+	assert.Equal(t, "jane@example.com", claims["email"])
+	assert.Equal(t, false, claims["email_verified"])
+	assert.Equal(t, "Jane Doe", claims["name"])
+	assert.Equal(t, "Jane", claims["given_name"])
+	assert.Equal(t, "Doe", claims["family_name"])
+
+	for _, authorization := range []string{"", "Basic Zm9vOmJhcg==", "Bearer not-a-jwt"} {
+		resp, body := userInfo(authorization)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode, authorization)
+		assert.True(t, strings.HasPrefix(resp.Header.Get("WWW-Authenticate"), "Bearer"), authorization)
+		assert.Equal(t, "invalid_token", body["error"], authorization)
+	}
+}
+
+func TestConformanceIntrospection(t *testing.T) {
+	srv := newConformanceServer(t)
+	issued := srv.tokens(t, srv.confidential, conformanceClientSecret)
+
+	introspect := func(token string) map[string]any {
+		resp, body := srv.post(t, "/oauth/introspect", srv.confidential, conformanceClientSecret, url.Values{"token": {token}})
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		return body
+	}
+
+	access := introspect(issued["access_token"].(string))
+	assert.Equal(t, true, access["active"])
+	assert.Equal(t, conformanceScope, access["scope"])
+	assert.Equal(t, srv.confidential.String(), access["client_id"])
+	assert.Equal(t, srv.user.PublicID, access["sub"])
+	assert.Equal(t, srv.URL, access["iss"])
+	assert.Greater(t, access["exp"], float64(time.Now().Unix()))
+
+	refresh := introspect(issued["refresh_token"].(string))
+	assert.Equal(t, true, refresh["active"])
+	assert.Equal(t, "refresh_token", refresh["token_type"])
+
+	assert.Equal(t, map[string]any{"active": false}, introspect("not-a-token"), "unknown tokens are only reported inactive")
+
+	other := srv.tokens(t, srv.public, "")
+	assert.Equal(t, map[string]any{"active": false}, introspect(other["access_token"].(string)),
+		"tokens issued to other clients are reported inactive")
+
+	resp, body := srv.post(t, "/oauth/introspect", srv.public, "", url.Values{"token": {issued["access_token"].(string)}})
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode, "introspection requires client authentication")
+	assert.Equal(t, "invalid_client", body["error"])
+}
+
+func TestConformancePermsClaim(t *testing.T) {
+	srv := newConformanceServer(t)
+	ctx := context.Background()
+
+	// Two permissions through the editor role and one granted directly
+	srv.iam.PutRole(1, &role.Role{ID: "editor-role-id", Name: "editor"})
+	for id, name := range map[int64]string{1: "employee:read", 2: "employee:write", 3: "client:read"} {
+		srv.iam.PutPermission(id, &permission.Permission{ID: fmt.Sprintf("permission-%d", id), Name: name})
+	}
+	require.NoError(t, srv.iam.AssignRolePermissions(ctx, 1, []int64{1, 2}))
+	require.NoError(t, srv.iam.AssignUserRoles(ctx, srv.user.ID, []int64{1}))
+	require.NoError(t, srv.iam.AssignUserPermissions(ctx, srv.user.ID, []int64{3}))
+	all := []any{"client:read", "employee:read", "employee:write"}
+
+	claims := func(mode jwt.PermsClaimMode, maxBytes int) (*jwt.AccessTokenClaims, string) {
+		t.Helper()
+		client, err := srv.repo.GetOAuthClientByClientID(ctx, srv.confidential)
+		require.NoError(t, err)
+		client.PermsClaim, client.PermsMaxBytes = mode, maxBytes
+		srv.repo.PutOAuthClient(client)
+
+		accessToken := srv.tokens(t, srv.confidential, conformanceClientSecret)["access_token"].(string)
+		parsed, err := srv.signer.ValidateAccessToken(accessToken)
+		require.NoError(t, err)
+		return parsed, accessToken
+	}
+	introspectedPerms := func(accessToken string) any {
+		t.Helper()
+		resp, body := srv.post(t, "/oauth/introspect", srv.confidential, conformanceClientSecret, url.Values{"token": {accessToken}})
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		return body["perms"]
+	}
+
+	full, _ := claims(jwt.PermsClaimFull, 0)
+	assert.Equal(t, []string{"client:read", "employee:read", "employee:write"}, full.Perms)
+	assert.True(t, full.HasAllPerms())
+
+	omitted, accessToken := claims(jwt.PermsClaimOmit, 0)
+	assert.Empty(t, omitted.Perms)
+	assert.True(t, omitted.PermsOmitted)
+	assert.Equal(t, all, introspectedPerms(accessToken), "introspection lists the permissions left out")
+
+	roles, accessToken := claims(jwt.PermsClaimRoles, 0)
+	assert.Equal(t, []string{"editor"}, roles.Roles)
+	assert.Equal(t, []string{"client:read"}, roles.Perms, "only direct permissions are listed besides roles")
+	assert.Equal(t, all, introspectedPerms(accessToken))
+
+	// ["client:read"] takes 15 bytes, the next permission would take 31
+	capped, accessToken := claims(jwt.PermsClaimFull, 20)
+	assert.Equal(t, []string{"client:read"}, capped.Perms)
+	assert.True(t, capped.PermsOverflow)
+	assert.Equal(t, all, introspectedPerms(accessToken))
+
+	roomy, _ := claims(jwt.PermsClaimFull, 1024)
+	assert.Len(t, roomy.Perms, 3)
+	assert.False(t, roomy.PermsOverflow, "claims under the cap are left whole")
+}
+
+func TestConformanceRevocation(t *testing.T) {
+	srv := newConformanceServer(t)
+	issued := srv.tokens(t, srv.confidential, conformanceClientSecret)
+	refreshToken := issued["refresh_token"].(string)
+
+	revoke := func(form url.Values) *http.Response {
+		resp, _ := srv.post(t, "/oauth/revoke", srv.confidential, conformanceClientSecret, form)
+		return resp
+	}
+
+	assert.Equal(t, http.StatusOK, revoke(url.Values{"token": {refreshToken}, "token_type_hint": {"refresh_token"}}).StatusCode)
+	assert.Equal(t, http.StatusOK, revoke(url.Values{"token": {refreshToken}}).StatusCode, "revoking twice succeeds")
+	assert.Equal(t, http.StatusOK, revoke(url.Values{"token": {uuid.NewString()}}).StatusCode, "revoking unknown tokens succeeds")
+	assert.Equal(t, http.StatusBadRequest, revoke(url.Values{}).StatusCode)
+
+	resp, body := srv.post(t, "/oauth/introspect", srv.confidential, conformanceClientSecret, url.Values{"token": {refreshToken}})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, false, body["active"])
+
+	resp, body = srv.post(t, "/oauth/token", srv.confidential, conformanceClientSecret, url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {refreshToken},
+	})
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, "invalid_grant", body["error"], "revoked refresh tokens cannot be used")
+
+	resp, _ = srv.post(t, "/oauth/revoke", srv.confidential, "wrong-secret", url.Values{"token": {refreshToken}})
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode, "revocation requires client authentication")
+}
+
+func TestConformanceExpiredClientSecret(t *testing.T) {
+	srv := newConformanceServer(t)
+	issued := srv.tokens(t, srv.confidential, conformanceClientSecret)
+
+	client, err := srv.repo.GetOAuthClientByClientID(context.Background(), srv.confidential)
+	require.NoError(t, err)
+	expired := time.Now().Add(-time.Minute)
+	client.SecretExpiresAt = &expired
+	srv.repo.PutOAuthClient(client)
+
+	refresh := url.Values{"grant_type": {"refresh_token"}, "refresh_token": {issued["refresh_token"].(string)}}
+	resp, body := srv.post(t, "/oauth/token", srv.confidential, conformanceClientSecret, refresh)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	assert.Equal(t, "invalid_client", body["error"])
+	assert.Equal(t, "Client secret expired, rotate it", body["error_description"])
+
+	resp, body = srv.post(t, "/oauth/token", srv.confidential, "wrong-secret", refresh)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	assert.Equal(t, "Client authentication failed", body["error_description"], "only the holder of the secret learns it expired")
+
+	resp, _ = srv.post(t, "/oauth/introspect", srv.confidential, conformanceClientSecret, url.Values{"token": {issued["access_token"].(string)}})
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
+
+func TestProviderLoginStateExpiry(t *testing.T) {
+	srv := newConformanceServer(t)
+	jar, err := cookiejar.New(nil)
+	require.NoError(t, err)
+	b := &http.Client{
+		Jar: jar,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	resp, err := b.Get(srv.URL + "/auth/callback?code=c")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, "/login?error=invalid_state", resp.Header.Get("Location"), "a callback without state is rejected")
+
+	resp, err = b.Post(srv.URL+"/test/stale-provider-login", "", nil)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	resp, err = b.Get(srv.URL + "/auth/callback?state=stale-state&code=c")
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Contains(t, string(body), `href="/login/google?next=%2Foauth%2Fauthorize%3Fclient_id%3Dabc"`, "offers to start the login again")
+
+	resp, err = b.Get(srv.URL + "/auth/callback?state=stale-state&code=c")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, "/login?error=invalid_state", resp.Header.Get("Location"), "the state cannot be replayed")
+}
+
+func TestSandboxErrorDiagnostics(t *testing.T) {
+	srv := newConformanceServer(t)
+	b := srv.browser(t)
+	params := url.Values{
+		"response_type":  {"code"},
+		"client_id":      {srv.public.String()},
+		"redirect_uri":   {"https://rp.example.com/elsewhere"},
+		"code_challenge": {"challenge"},
+	}
+
+	page := func(path string) string {
+		t.Helper()
+		resp, err := b.Get(srv.URL + path + "?" + params.Encode())
+		require.NoError(t, err)
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		return html.UnescapeString(string(body))
+	}
+
+	live := page("/oauth/authorize")
+	assert.NotContains(t, live, "Sandbox diagnostics", "live projects keep terse errors")
+	assert.NotContains(t, live, conformanceRedirectURI)
+
+	sandbox := page("/sandbox/oauth/authorize")
+	assert.Contains(t, sandbox, "Sandbox diagnostics")
+	assert.Contains(t, sandbox, conformanceRedirectURI, "shows the expected redirect URI")
+	assert.Contains(t, sandbox, "https://rp.example.com/elsewhere", "and the one received")
+
+	// Errors redirected to the client carry the diagnostics in their description
+	params.Set("redirect_uri", conformanceRedirectURI)
+	params.Del("code_challenge")
+	resp, err := b.Get(srv.URL + "/sandbox/oauth/authorize?" + params.Encode())
+	require.NoError(t, err)
+	resp.Body.Close()
+	location, err := url.Parse(resp.Header.Get("Location"))
+	require.NoError(t, err)
+	assert.Equal(t, "invalid_request", location.Query().Get("error"))
+	assert.Contains(t, location.Query().Get("error_description"), "Client PKCE: Required")
+}
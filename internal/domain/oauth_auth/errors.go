@@ -16,6 +16,7 @@ var (
 	ErrInvalidClientSecret       = errors.New("invalid client_secret")
 	ErrInvalidRefreshToken       = errors.New("invalid refresh token")
 	ErrClientSecretRequired      = errors.New("client_secret required")
+	ErrClientSecretExpired       = errors.New("client_secret has expired")
 	ErrPKCERequired              = errors.New("PKCE is required for this client")
 
 	ErrMissingClientID            = errors.New("client_id is required")
@@ -37,8 +38,15 @@ var (
 	ErrInvalidOTP         = errors.New("invalid or expired OTP")
 	ErrOTPAlreadyUsed     = errors.New("OTP has already been used")
 
+	// Account lockout errors
+	ErrAccountLocked = errors.New("account is temporarily locked after too many failed sign-ins")
+
 	// Email verification errors
 	ErrInvalidVerificationToken = errors.New("invalid or expired verification token")
 	ErrTokenAlreadyUsed         = errors.New("verification token has already been used")
 	ErrUserNotFound             = errors.New("user not found")
+
+	// Remember-me errors
+	ErrInvalidRememberToken  = errors.New("invalid or expired remember-me token")
+	ErrRememberTokenNotFound = errors.New("remember-me token not found")
 )
@@ -0,0 +1,100 @@
+package oauth_auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	iam_mapper_domain "github.com/hrz8/altalune/internal/domain/iam_mapper"
+	role_domain "github.com/hrz8/altalune/internal/domain/role"
+	"github.com/hrz8/altalune/internal/shared/events"
+)
+
+// UserRegistered is published when a user signs up through an identity
+// provider, after joining the registration project.
+type UserRegistered struct {
+	UserID          int64     `json:"-"`
+	UserPublicID    string    `json:"user_id"`
+	Email           string    `json:"email"`
+	FirstName       string    `json:"first_name,omitempty"`
+	LastName        string    `json:"last_name,omitempty"`
+	Provider        string    `json:"provider"`
+	ProjectID       int64     `json:"-"`
+	ProjectPublicID string    `json:"project_id,omitempty"` // Empty for the default project
+	ProjectRole     string    `json:"project_role"`
+	AutoActivated   bool      `json:"auto_activated"` // Otherwise the project owners approve the user
+	OAuthClientID   uuid.UUID `json:"oauth_client_id,omitzero"`
+}
+
+func (UserRegistered) EventName() string { return "user.registered" }
+
+// UserLinkedIdentity is published when a user signs in with an identity
+// provider for the first time, with the email of their account.
+type UserLinkedIdentity struct {
+	UserID        int64     `json:"-"`
+	UserPublicID  string    `json:"user_id"`
+	Email         string    `json:"email"`
+	Provider      string    `json:"provider"`
+	OAuthClientID uuid.UUID `json:"oauth_client_id,omitzero"`
+}
+
+func (UserLinkedIdentity) EventName() string { return "user.identity_linked" }
+
+// ConsentGranted is published when a user consents to the scopes an OAuth
+// client requested.
+type ConsentGranted struct {
+	UserID        int64     `json:"-"`
+	UserPublicID  string    `json:"user_id"`
+	OAuthClientID uuid.UUID `json:"oauth_client_id"`
+	Scope         string    `json:"scope"`
+}
+
+func (ConsentGranted) EventName() string { return "consent.granted" }
+
+// LoginFailed is published for every failed sign-in of a registered user,
+// whatever the method, including the one locking their account.
+type LoginFailed struct {
+	UserID       int64  `json:"-"`
+	UserPublicID string `json:"user_id"`
+	Email        string `json:"email"`
+	Locked       bool   `json:"locked"` // The failure locked the account
+}
+
+func (LoginFailed) EventName() string { return "user.login_failed" }
+
+// SubscribeRegistration subscribes the onboarding of registered users: the
+// global 'user' role, then the verification email of auto-activated users or
+// the approval request to the project owners. Nil dependencies skip their step.
+func SubscribeRegistration(
+	bus *events.Bus,
+	roleRepo role_domain.Repository,
+	iamMapperRepo iam_mapper_domain.Repository,
+	verificationService *EmailVerificationService,
+	approvalNotifier *ApprovalNotifier,
+) {
+	events.On(bus, func(ctx context.Context, e UserRegistered) error {
+		if roleRepo == nil || iamMapperRepo == nil {
+			return nil
+		}
+		userRoleID, err := roleRepo.GetInternalIDByName(ctx, "user")
+		if err != nil {
+			return fmt.Errorf("get 'user' role: %w", err)
+		}
+		if err := iamMapperRepo.AssignUserRoles(ctx, e.UserID, []int64{userRoleID}); err != nil {
+			return fmt.Errorf("assign global 'user' role to user %d: %w", e.UserID, err)
+		}
+		return nil
+	})
+
+	events.On(bus, func(ctx context.Context, e UserRegistered) error {
+		switch {
+		case e.AutoActivated && verificationService != nil:
+			if err := verificationService.GenerateAndSendVerificationEmail(ctx, e.UserID); err != nil {
+				return fmt.Errorf("send verification email to user %d: %w", e.UserID, err)
+			}
+		case !e.AutoActivated && approvalNotifier != nil:
+			approvalNotifier.NotifyPendingApproval(ctx, e.ProjectID, e.Email, e.FirstName, e.LastName)
+		}
+		return nil
+	})
+}
@@ -1,8 +1,11 @@
 package oauth_auth
 
 import (
+	"context"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"net/http"
@@ -12,14 +15,22 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/hrz8/altalune"
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	"github.com/hrz8/altalune/internal/authserver/form"
 	"github.com/hrz8/altalune/internal/authserver/views"
-	iam_mapper_domain "github.com/hrz8/altalune/internal/domain/iam_mapper"
 	oauth_provider_domain "github.com/hrz8/altalune/internal/domain/oauth_provider"
-	role_domain "github.com/hrz8/altalune/internal/domain/role"
+	project_domain "github.com/hrz8/altalune/internal/domain/project"
 	user_domain "github.com/hrz8/altalune/internal/domain/user"
 	"github.com/hrz8/altalune/internal/session"
+	"github.com/hrz8/altalune/internal/shared/captcha"
+	"github.com/hrz8/altalune/internal/shared/cookie"
+	"github.com/hrz8/altalune/internal/shared/csp"
+	"github.com/hrz8/altalune/internal/shared/emailcheck"
+	"github.com/hrz8/altalune/internal/shared/events"
+	"github.com/hrz8/altalune/internal/shared/i18n"
 	"github.com/hrz8/altalune/internal/shared/jwt"
 	"github.com/hrz8/altalune/internal/shared/oauthprovider"
+	"github.com/hrz8/altalune/internal/shared/realip"
 )
 
 type Handler struct {
@@ -29,10 +40,13 @@ type Handler struct {
 	sessionStore        *session.Store
 	oauthProviderRepo   oauth_provider_domain.Repository
 	userRepo            user_domain.Repository
-	roleRepo            role_domain.Repository
-	iamMapperRepo       iam_mapper_domain.Repository
+	projectRepo         project_domain.Repositor
 	otpService          *OTPService
 	verificationService *EmailVerificationService
+	events              *events.Bus
+	captcha             *captcha.Verifier
+	rememberMe          *RememberMeService
+	limiter             user_domain.PlanLimiter
 	log                 altalune.Logger
 }
 
@@ -43,10 +57,13 @@ func NewHandler(
 	sessionStore *session.Store,
 	oauthProviderRepo oauth_provider_domain.Repository,
 	userRepo user_domain.Repository,
-	roleRepo role_domain.Repository,
-	iamMapperRepo iam_mapper_domain.Repository,
+	projectRepo project_domain.Repositor,
 	otpService *OTPService,
 	verificationService *EmailVerificationService,
+	eventBus *events.Bus,
+	captchaVerifier *captcha.Verifier,
+	rememberMe *RememberMeService,
+	limiter user_domain.PlanLimiter,
 	log altalune.Logger,
 ) *Handler {
 	return &Handler{
@@ -56,22 +73,92 @@ func NewHandler(
 		sessionStore:        sessionStore,
 		oauthProviderRepo:   oauthProviderRepo,
 		userRepo:            userRepo,
-		roleRepo:            roleRepo,
-		iamMapperRepo:       iamMapperRepo,
+		projectRepo:         projectRepo,
 		otpService:          otpService,
 		verificationService: verificationService,
+		events:              eventBus,
+		captcha:             captchaVerifier,
+		rememberMe:          rememberMe,
+		limiter:             limiter,
 		log:                 log,
 	}
 }
 
-// baseData creates a BaseData struct with branding information.
-func (h *Handler) baseData(title string) views.BaseData {
+// registrationProject returns the project a self-registered user joins and
+// its onboarding settings: the project owning the request Host, or the default
+// project. Settings that cannot be loaded are treated as inherited.
+func (h *Handler) registrationProject(r *http.Request) (int64, *project_domain.Onboarding) {
+	projectID := int64(user_domain.DefaultProjectID)
+	if h.projectRepo == nil {
+		return projectID, nil
+	}
+
+	if tenant := TenantFromContext(r.Context()); tenant != nil {
+		id, err := h.projectRepo.GetIDByPublicID(r.Context(), tenant.ProjectID)
+		if err != nil {
+			h.log.Warn("failed to resolve tenant project for registration", "error", err, "project", tenant.ProjectID)
+			return projectID, nil
+		}
+		projectID = id
+	}
+
+	onboarding, err := h.projectRepo.GetOnboarding(r.Context(), projectID)
+	if err != nil {
+		h.log.Warn("failed to get project onboarding", "error", err, "projectID", projectID)
+		return projectID, nil
+	}
+	return projectID, onboarding
+}
+
+// baseData creates a BaseData struct with branding information. When the
+// request Host belongs to a project, its branding overrides the defaults.
+// The page locale is taken from the ui_locales parameter of authorization
+// requests, then negotiated from Accept-Language, falling back to the project
+// default locale and then to the configured one.
+func (h *Handler) baseData(r *http.Request, title string) views.BaseData {
+	branding := views.BrandingData{
+		Name: h.cfg.GetAuthServerBrandingName(),
+	}
+	if tenant := TenantFromContext(r.Context()); tenant != nil {
+		if tenant.BrandingName != "" {
+			branding.Name = tenant.BrandingName
+		}
+		branding.LogoURL = tenant.LogoURL
+		branding.PrimaryColor = tenant.PrimaryColor
+		for _, link := range tenant.FooterLinks {
+			branding.FooterLinks = append(branding.FooterLinks, views.FooterLink{Label: link.Label, URL: link.URL})
+		}
+	}
+
+	locale := i18n.Negotiate(r.Header.Get("Accept-Language"), h.defaultLocale(r))
+	if uiLocales := uiLocalesParam(r); uiLocales != "" {
+		locale = i18n.NegotiateUILocales(uiLocales, locale)
+	}
+
 	return views.BaseData{
-		Title: title,
-		Branding: views.BrandingData{
-			Name: h.cfg.GetAuthServerBrandingName(),
-		},
+		Title:    i18n.T(locale, title),
+		Locale:   locale,
+		Branding: branding,
+		CSPNonce: csp.Nonce(r.Context()),
+	}
+}
+
+// defaultLocale returns the default locale of the project the request Host
+// belongs to, falling back to the configured one.
+func (h *Handler) defaultLocale(r *http.Request) string {
+	if tenant := TenantFromContext(r.Context()); tenant != nil && tenant.DefaultLocale != "" {
+		return tenant.DefaultLocale
 	}
+	return h.cfg.GetAuthDefaultLocale()
+}
+
+// uiLocalesParam returns the ui_locales parameter of an authorization request,
+// sent in the query string or, once the consent form is parsed, in the form.
+func uiLocalesParam(r *http.Request) string {
+	if uiLocales := r.URL.Query().Get("ui_locales"); uiLocales != "" {
+		return uiLocales
+	}
+	return r.PostForm.Get("ui_locales")
 }
 
 func (h *Handler) HandleLoginPage(w http.ResponseWriter, r *http.Request) {
@@ -100,19 +187,25 @@ func (h *Handler) HandleLoginPage(w http.ResponseWriter, r *http.Request) {
 
 	errorMsg := r.URL.Query().Get("error")
 
-	// Check if this login is for an OAuth client (UX transparency)
+	// Check if this login is for an OAuth client (UX transparency).
+	// Tenant hostnames fall back to the project's default client.
 	var clientName string
-	if clientID := r.URL.Query().Get("client_id"); clientID != "" {
+	clientID := r.URL.Query().Get("client_id")
+	if tenant := TenantFromContext(r.Context()); clientID == "" && tenant != nil {
+		clientID = tenant.DefaultClientID
+	}
+	if clientID != "" {
 		if client, err := h.svc.GetOAuthClient(r.Context(), clientID); err == nil {
 			clientName = client.Name
 		}
 	}
 
 	data := views.LoginPageData{
-		BaseData:     h.baseData("Sign In"),
-		Providers:    views.GetProviders(),
+		BaseData:     h.baseData(r, "Sign In"),
+		Providers:    h.loginProviders(r.Context()),
 		ErrorMessage: errorMsg,
 		ClientName:   clientName,
+		RememberMe:   h.rememberMe != nil,
 	}
 
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
@@ -122,6 +215,35 @@ func (h *Handler) HandleLoginPage(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// loginProviders returns the sign in buttons of the enabled providers. When
+// they cannot be read the login page still offers the email sign in.
+func (h *Handler) loginProviders(ctx context.Context) []views.Provider {
+	providers, err := h.oauthProviderRepo.ListEnabled(ctx)
+	if err != nil {
+		h.log.Error("failed to list enabled providers", "error", err)
+		return nil
+	}
+
+	buttons := make([]views.Provider, 0, len(providers))
+	for _, provider := range providers {
+		button, ok := views.ProviderButton(string(provider.ProviderType))
+		if !ok {
+			h.log.Warn("enabled provider has no sign in support", "provider", provider.ProviderType)
+			continue
+		}
+		buttons = append(buttons, button)
+	}
+	return buttons
+}
+
+// providerLoginMaxAge is how long the user has to come back from the sign in
+// provider before the pending login is rejected
+const providerLoginMaxAge = 10 * time.Minute
+
+// sessionExpiryWarning is how long before the end of the session the profile
+// warns that the user will sign in again
+const sessionExpiryWarning = 30 * time.Minute
+
 func (h *Handler) HandleLoginProvider(w http.ResponseWriter, r *http.Request) {
 	providerName := r.PathValue("provider")
 
@@ -145,7 +267,9 @@ func (h *Handler) HandleLoginProvider(w http.ResponseWriter, r *http.Request) {
 	}
 
 	sessionData.OAuthState = state
+	sessionData.OAuthStateAt = time.Now()
 	sessionData.OAuthProvider = providerName
+	sessionData.RememberMe = h.rememberMe != nil && r.URL.Query().Get("remember") == "1"
 	if nextURL := r.URL.Query().Get("next"); nextURL != "" {
 		sessionData.OriginalURL = nextURL
 	}
@@ -186,11 +310,27 @@ func (h *Handler) HandleOAuthCallback(w http.ResponseWriter, r *http.Request) {
 	}
 
 	state := r.URL.Query().Get("state")
-	if state != sessionData.OAuthState {
+	if state == "" || state != sessionData.OAuthState {
 		http.Redirect(w, r, "/login?error=invalid_state", http.StatusFound)
 		return
 	}
 
+	// The state is single use: forget it before anything else can fail, so
+	// the callback cannot be replayed
+	providerName := sessionData.OAuthProvider
+	startedAt := sessionData.OAuthStateAt
+	sessionData.ClearOAuthState()
+	if err := h.sessionStore.SetData(r, w, sessionData); err != nil {
+		h.log.Error("failed to save session", "error", err)
+		http.Redirect(w, r, "/login?error=session_error", http.StatusFound)
+		return
+	}
+
+	if time.Since(startedAt) > providerLoginMaxAge {
+		h.renderLoginExpired(w, r, providerName, sessionData.OriginalURL)
+		return
+	}
+
 	code := r.URL.Query().Get("code")
 	if code == "" {
 		errorMsg := r.URL.Query().Get("error")
@@ -199,7 +339,6 @@ func (h *Handler) HandleOAuthCallback(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Get provider from session (set during HandleLoginProvider)
-	providerName := sessionData.OAuthProvider
 	if providerName == "" {
 		providerName = r.URL.Query().Get("provider")
 		if providerName == "" {
@@ -271,125 +410,55 @@ func (h *Handler) HandleOAuthCallback(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
-		// Extract OAuth client info for historical tracking
-		var oauthClientIDStr *string
-		var originClientName *string
-		var clientID string
-		if sessionData.OriginalURL != "" {
-			if params, err := parseAuthorizationParamsFromURL(sessionData.OriginalURL); err == nil && params.ClientID != uuid.Nil {
-				clientIDStr := params.ClientID.String()
-				oauthClientIDStr = &clientIDStr
-				clientID = clientIDStr
-
-				// Look up client to get name for historical snapshot
-				if client, err := h.svc.GetOAuthClient(r.Context(), clientIDStr); err == nil {
-					originClientName = &client.Name
-				}
-			}
+		identity := &user_domain.CreateUserIdentityInput{
+			Provider:       string(provider.ProviderType),
+			ProviderUserID: userInfo.ID,
+			Email:          userInfo.Email,
+			FirstName:      userInfo.FirstName,
+			LastName:       userInfo.LastName,
 		}
+		clientID := h.originClient(r, sessionData.OriginalURL, identity)
 
 		if existingUserID > 0 {
-			// Step 2: User exists with same email - link new identity to existing user
+			// User exists with same email - link new identity to existing user
 			userID = existingUserID
-
-			// Create new identity linked to existing user
-			if err := h.userRepo.CreateUserIdentity(r.Context(), &user_domain.CreateUserIdentityInput{
-				UserID:                userID,
-				Provider:              string(provider.ProviderType),
-				ProviderUserID:        userInfo.ID,
-				Email:                 userInfo.Email,
-				FirstName:             userInfo.FirstName,
-				LastName:              userInfo.LastName,
-				OAuthClientID:         oauthClientIDStr,
-				OriginOAuthClientName: originClientName,
-			}); err != nil {
-				h.log.Error("failed to create linked user identity", "error", err)
-				http.Error(w, "Internal server error", http.StatusInternalServerError)
-				return
-			}
-
-			h.log.Info("linked new OAuth provider to existing user",
-				"userID", userID,
-				"provider", provider.ProviderType,
-				"email", userInfo.Email,
-			)
+			err = h.linkIdentity(r, userID, identity, clientID)
 		} else {
-			// Step 3: No user exists - create new user and identity
-			regCtx := DetermineRegistrationContext(clientID, h.cfg.GetDefaultOAuthClientID())
-			projectRole := GetProjectRoleForContext(regCtx)
-			autoActivate := h.cfg.IsAutoActivate()
-
-			user, err := h.userRepo.Create(r.Context(), &user_domain.CreateUserInput{
-				Email:     userInfo.Email,
-				FirstName: userInfo.FirstName,
-				LastName:  userInfo.LastName,
-				AvatarURL: userInfo.AvatarURL,
-				IsActive:  &autoActivate,
-			})
-			if err != nil {
-				h.log.Error("failed to create user", "error", err)
-				http.Error(w, "Internal server error", http.StatusInternalServerError)
-				return
-			}
-
-			userID = user.ID
-
-			if err := h.userRepo.CreateUserIdentity(r.Context(), &user_domain.CreateUserIdentityInput{
-				UserID:                userID,
-				Provider:              string(provider.ProviderType),
-				ProviderUserID:        userInfo.ID,
-				Email:                 userInfo.Email,
-				FirstName:             userInfo.FirstName,
-				LastName:              userInfo.LastName,
-				OAuthClientID:         oauthClientIDStr,
-				OriginOAuthClientName: originClientName,
-			}); err != nil {
-				h.log.Error("failed to create user identity", "error", err)
-				http.Error(w, "Internal server error", http.StatusInternalServerError)
-				return
-			}
-
-			// Assign user to default project with context-appropriate role
-			if err := h.userRepo.AddProjectMember(r.Context(), 1, userID, projectRole); err != nil {
-				h.log.Error("failed to add project member", "error", err, "role", projectRole)
-			}
-
-			// Assign global 'user' role to new user
-			if h.roleRepo != nil && h.iamMapperRepo != nil {
-				userRoleID, err := h.roleRepo.GetInternalIDByName(r.Context(), "user")
-				if err != nil {
-					h.log.Warn("failed to get 'user' role for assignment", "error", err)
-				} else {
-					if err := h.iamMapperRepo.AssignUserRoles(r.Context(), userID, []int64{userRoleID}); err != nil {
-						h.log.Warn("failed to assign global 'user' role", "error", err, "userID", userID)
-					}
-				}
-			}
-
-			// Send verification email if user is auto-activated
-			if autoActivate && h.verificationService != nil {
-				if err := h.verificationService.GenerateAndSendVerificationEmail(r.Context(), userID); err != nil {
-					h.log.Warn("failed to send verification email", "error", err, "userID", userID)
-				}
-			}
-
-			h.log.Info("created new user via OAuth",
-				"userID", userID,
-				"email", userInfo.Email,
-				"regContext", regCtx,
-				"projectRole", projectRole,
-				"autoActivated", autoActivate,
-			)
+			// No user exists - create new user and identity
+			userID, err = h.registerUser(r, userInfo, identity, clientID)
+		}
+		if err != nil {
+			http.Error(w, "Internal server error", http.StatusInternalServerError)
+			return
 		}
 	}
 
+	// Trashed users keep their identities and email, but cannot sign in until restored
+	existingUser, err := h.userRepo.GetByInternalID(r.Context(), userID)
+	if errors.Is(err, user_domain.ErrUserNotFound) {
+		h.log.Info("rejected sign-in of deleted user", "userID", userID, "provider", provider.ProviderType)
+		h.renderError(w, r, "access_denied", "This account has been deleted")
+		return
+	}
+	// A locked account stays locked whatever the sign-in method
+	if err == nil && existingUser.LockedUntil != nil {
+		h.log.Info("rejected sign-in of locked user", "userID", userID, "provider", provider.ProviderType)
+		h.renderError(w, r, "access_denied", "This account is temporarily locked after too many failed sign-ins")
+		return
+	}
+
+	remember := sessionData.RememberMe
 	sessionData.UserID = userID
 	sessionData.AuthenticatedAt = time.Now()
+	sessionData.RememberMe = false
 	if err := h.sessionStore.SetData(r, w, sessionData); err != nil {
 		h.log.Error("failed to save session", "error", err)
 		http.Error(w, "Internal server error", http.StatusInternalServerError)
 		return
 	}
+	if remember {
+		h.rememberDevice(w, r, userID)
+	}
 
 	// Check user activation status for standalone login redirect
 	var redirectURL string
@@ -412,12 +481,296 @@ func (h *Handler) HandleOAuthCallback(w http.ResponseWriter, r *http.Request) {
 	http.Redirect(w, r, redirectURL, http.StatusFound)
 }
 
+// originClient records on identity the OAuth client the user signed in for,
+// taken from the authorization request of originalURL, and returns its ID,
+// uuid.Nil for standalone logins.
+func (h *Handler) originClient(r *http.Request, originalURL string, identity *user_domain.CreateUserIdentityInput) uuid.UUID {
+	if originalURL == "" {
+		return uuid.Nil
+	}
+	params, err := parseAuthorizationParamsFromURL(originalURL)
+	if err != nil || params.ClientID == uuid.Nil {
+		return uuid.Nil
+	}
+
+	clientID := params.ClientID.String()
+	identity.OAuthClientID = &clientID
+	// The client name is kept as a historical snapshot
+	if client, err := h.svc.GetOAuthClient(r.Context(), clientID); err == nil {
+		identity.OriginOAuthClientName = &client.Name
+	}
+	return params.ClientID
+}
+
+// linkIdentity links identity to the existing user userID and publishes
+// UserLinkedIdentity.
+func (h *Handler) linkIdentity(r *http.Request, userID int64, identity *user_domain.CreateUserIdentityInput, clientID uuid.UUID) error {
+	identity.UserID = userID
+	if err := h.userRepo.CreateUserIdentity(r.Context(), identity); err != nil {
+		h.log.Error("failed to create linked user identity", "error", err)
+		return err
+	}
+
+	h.log.Info("linked new OAuth provider to existing user",
+		"userID", userID,
+		"provider", identity.Provider,
+		"email", identity.Email,
+	)
+
+	event := UserLinkedIdentity{
+		UserID:        userID,
+		Email:         identity.Email,
+		Provider:      identity.Provider,
+		OAuthClientID: clientID,
+	}
+	if user, err := h.userRepo.GetByInternalID(r.Context(), userID); err == nil {
+		event.UserPublicID = user.ID
+	}
+	h.events.Publish(r.Context(), event)
+	return nil
+}
+
+// registerUser creates the user of a first sign-in with identity, adds them
+// to the registration project and publishes UserRegistered, whose subscribers
+// onboard the user.
+func (h *Handler) registerUser(r *http.Request, userInfo *oauthprovider.UserInfo, identity *user_domain.CreateUserIdentityInput, clientID uuid.UUID) (int64, error) {
+	var clientIDStr string
+	if clientID != uuid.Nil {
+		clientIDStr = clientID.String()
+	}
+	regCtx := DetermineRegistrationContext(clientIDStr, h.cfg.GetDefaultOAuthClientID())
+	projectID, onboarding := h.registrationProject(r)
+	projectRole, autoActivate := ResolveRegistrationPolicy(regCtx, onboarding, h.cfg.IsAutoActivate())
+
+	user, err := h.userRepo.Create(r.Context(), &user_domain.CreateUserInput{
+		Email:     userInfo.Email,
+		FirstName: userInfo.FirstName,
+		LastName:  userInfo.LastName,
+		AvatarURL: userInfo.AvatarURL,
+		IsActive:  &autoActivate,
+		// Without auto-activation, the project owners approve the user
+		PendingApproval: !autoActivate,
+	})
+	if err != nil {
+		h.log.Error("failed to create user", "error", err)
+		return 0, err
+	}
+
+	identity.UserID = user.ID
+	if err := h.userRepo.CreateUserIdentity(r.Context(), identity); err != nil {
+		h.log.Error("failed to create user identity", "error", err)
+		return 0, err
+	}
+
+	// Assign user to the registration project with context-appropriate role,
+	// within the members its plan allows
+	var plan altalune.BillingPlan
+	var planErr error
+	if h.limiter != nil {
+		plan, planErr = h.limiter.ProjectPlan(r.Context(), projectID)
+	}
+	if planErr != nil {
+		h.log.Error("failed to get project plan", "error", planErr, "projectID", projectID)
+	} else if err := h.userRepo.AddProjectMember(r.Context(), projectID, user.ID, projectRole, plan.MaxMembers); err != nil {
+		h.log.Error("failed to add project member", "error", err, "projectID", projectID, "role", projectRole)
+	}
+
+	h.log.Info("created new user via OAuth",
+		"userID", user.ID,
+		"email", userInfo.Email,
+		"regContext", regCtx,
+		"projectID", projectID,
+		"projectRole", projectRole,
+		"autoActivated", autoActivate,
+	)
+
+	event := UserRegistered{
+		UserID:        user.ID,
+		UserPublicID:  user.PublicID,
+		Email:         userInfo.Email,
+		FirstName:     userInfo.FirstName,
+		LastName:      userInfo.LastName,
+		Provider:      identity.Provider,
+		ProjectID:     projectID,
+		ProjectRole:   projectRole,
+		AutoActivated: autoActivate,
+		OAuthClientID: clientID,
+	}
+	if tenant := TenantFromContext(r.Context()); tenant != nil && projectID != user_domain.DefaultProjectID {
+		event.ProjectPublicID = tenant.ProjectID
+	}
+	h.events.Publish(r.Context(), event)
+	return user.ID, nil
+}
+
 func (h *Handler) HandleLogout(w http.ResponseWriter, r *http.Request) {
+	h.endSession(w, r)
+	h.renderLoggedOut(w, r)
+}
+
+// HandleEndSession implements RP-initiated logout (OpenID Connect RP-Initiated
+// Logout 1.0): it ends the user's session on the auth server and, when the
+// client passes a post_logout_redirect_uri, sends the user back to it with the
+// client's state. There are no ID tokens, so clients identify themselves with
+// client_id instead of id_token_hint.
+func (h *Handler) HandleEndSession(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	redirectURI := query.Get("post_logout_redirect_uri")
+
+	if redirectURI != "" {
+		client, err := h.svc.GetOAuthClient(r.Context(), query.Get("client_id"))
+		if err != nil {
+			h.renderError(w, r, "invalid_client", "Unknown client_id",
+				paramDiagnostics("client_id", "", query.Get("client_id"))...)
+			return
+		}
+		if !h.svc.ValidatePostLogoutRedirectURI(client, redirectURI) {
+			h.renderError(w, r, "invalid_request", "post_logout_redirect_uri is not registered for this client",
+				paramDiagnostics("post_logout_redirect_uri", strings.Join(redirectOrigins(client), " "), redirectURI)...)
+			return
+		}
+	}
+
+	h.endSession(w, r)
+
+	if redirectURI == "" {
+		h.renderLoggedOut(w, r)
+		return
+	}
+
+	u, _ := url.Parse(redirectURI)
+	if state := query.Get("state"); state != "" {
+		q := u.Query()
+		q.Set("state", state)
+		u.RawQuery = q.Encode()
+	}
+	http.Redirect(w, r, u.String(), http.StatusFound)
+}
+
+// endSession clears the session and revokes the refresh tokens of its user,
+// signing them out of every client: the dashboard BFF finds its refresh token
+// inactive on its next heartbeat and drops its tokens.
+func (h *Handler) endSession(w http.ResponseWriter, r *http.Request) {
+	if sessionData, err := h.sessionStore.GetData(r); err == nil && sessionData.UserID != 0 {
+		// Failures are logged by the service, the session is cleared regardless
+		_ = h.svc.RevokeUserRefreshTokens(r.Context(), sessionData.UserID)
+		h.forgetDevice(w, r, sessionData.UserID)
+	} else {
+		h.forgetDevice(w, r, 0)
+	}
+
 	if err := h.sessionStore.Clear(r, w); err != nil {
 		h.log.Error("failed to clear session", "error", err)
 	}
+}
+
+// RememberCookieName is the cookie keeping a device signed in with "keep me
+// signed in", holding its remember-me series and token.
+const RememberCookieName = "altalune_remember"
+
+func (h *Handler) rememberCookies() cookie.Options {
+	return cookie.OptionsFromConfig(h.cfg)
+}
+
+// rememberCookieValue returns the remember-me cookie of the request, empty
+// when there is none
+func (h *Handler) rememberCookieValue(r *http.Request) string {
+	c, err := r.Cookie(h.rememberCookies().Name(RememberCookieName))
+	if err != nil {
+		return ""
+	}
+	return c.Value
+}
+
+func (h *Handler) setRememberCookie(w http.ResponseWriter, value string) {
+	http.SetCookie(w, h.rememberCookies().New(RememberCookieName, value, int(h.rememberMe.Lifetime().Seconds())))
+}
+
+func rememberDeviceOf(r *http.Request) RememberDevice {
+	return RememberDevice{
+		UserAgent: r.UserAgent(),
+		IPAddress: realip.IP(r.Context()),
+	}
+}
+
+// rememberDevice keeps the device of the request signed in. Failures only
+// cost the user a sign-in later, so they do not fail the one in progress.
+func (h *Handler) rememberDevice(w http.ResponseWriter, r *http.Request, userID int64) {
+	if h.rememberMe == nil {
+		return
+	}
+	value, err := h.rememberMe.Issue(r.Context(), userID, rememberDeviceOf(r))
+	if err != nil {
+		return
+	}
+	h.setRememberCookie(w, value)
+}
+
+// forgetDevice revokes the remember-me token of the device of the request,
+// when it belongs to userID, and deletes its cookie.
+func (h *Handler) forgetDevice(w http.ResponseWriter, r *http.Request, userID int64) {
+	if h.rememberMe == nil {
+		return
+	}
+	value := h.rememberCookieValue(r)
+	if value == "" {
+		return
+	}
+	if series := RememberSeries(value); series != "" && userID != 0 {
+		// Failures are logged by the service, the cookie is deleted regardless
+		_ = h.rememberMe.RevokeDevice(r.Context(), userID, series)
+	}
+	http.SetCookie(w, h.rememberCookies().Expire(RememberCookieName))
+}
+
+// RestoreRememberedSession signs a browser whose session ended back in with
+// its remember-me cookie, before the pages read the session. Only page loads
+// restore sessions; a cookie that no longer signs in is deleted.
+func (h *Handler) RestoreRememberedSession(next http.Handler) http.Handler {
+	if h.rememberMe == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			next.ServeHTTP(w, r)
+			return
+		}
+		value := h.rememberCookieValue(r)
+		if value == "" || h.sessionStore.IsAuthenticated(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		user, newValue, err := h.rememberMe.Restore(r.Context(), value, rememberDeviceOf(r))
+		if err != nil {
+			if errors.Is(err, ErrInvalidRememberToken) {
+				http.SetCookie(w, h.rememberCookies().Expire(RememberCookieName))
+			}
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		sessionData, err := h.sessionStore.GetData(r)
+		if err != nil || sessionData == nil {
+			sessionData = &session.Data{}
+		}
+		sessionData.UserID = user.ID
+		sessionData.AuthenticatedAt = time.Now()
+		if err := h.sessionStore.SetData(r, w, sessionData); err != nil {
+			h.log.Error("failed to save session", "error", err)
+			next.ServeHTTP(w, r)
+			return
+		}
+		if newValue != "" {
+			h.setRememberCookie(w, newValue)
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
 
-	data := h.baseData("Logged Out")
+func (h *Handler) renderLoggedOut(w http.ResponseWriter, r *http.Request) {
+	data := h.baseData(r, "Logged Out")
 
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
 	if err := views.Render(w, "logout.html", data); err != nil {
@@ -442,23 +795,26 @@ func (h *Handler) HandleAuthorize(w http.ResponseWriter, r *http.Request) {
 
 	params, err := parseAuthorizationParams(r)
 	if err != nil {
-		h.renderAuthError(w, r, "", "", err)
+		h.renderAuthError(w, r, "", "", err, authParamDiagnostics(r, err)...)
 		return
 	}
 
 	if params.ResponseType != "code" {
-		h.renderAuthError(w, r, params.RedirectURI, params.State, ErrUnsupportedResponseType)
+		h.renderAuthError(w, r, params.RedirectURI, params.State, ErrUnsupportedResponseType,
+			paramDiagnostics("response_type", "code", params.ResponseType)...)
 		return
 	}
 
 	client, err := h.svc.GetOAuthClient(r.Context(), params.ClientID.String())
 	if err != nil {
-		h.renderError(w, "invalid_client", "Unknown client_id")
+		h.renderError(w, r, "invalid_client", "Unknown client_id",
+			paramDiagnostics("client_id", "", params.ClientID.String())...)
 		return
 	}
 
 	if !h.svc.ValidateRedirectURI(client, params.RedirectURI) {
-		h.renderError(w, "invalid_redirect_uri", "Redirect URI does not match registered URIs")
+		h.renderError(w, r, "invalid_redirect_uri", "Redirect URI does not match registered URIs",
+			paramDiagnostics("redirect_uri", strings.Join(client.RedirectURIs, " "), params.RedirectURI)...)
 		return
 	}
 
@@ -472,17 +828,19 @@ func (h *Handler) HandleAuthorize(w http.ResponseWriter, r *http.Request) {
 	}
 	if !user.IsActive {
 		// Return OAuth error to client - user account is not activated
-		redirectWithError(w, r, params.RedirectURI, "access_denied", "account_not_activated", params.State)
+		h.redirectWithError(w, r, params.RedirectURI, "access_denied", "account_not_activated", params.State)
 		return
 	}
 
 	if client.PKCERequired {
 		if params.CodeChallenge == nil || *params.CodeChallenge == "" {
-			h.renderAuthError(w, r, params.RedirectURI, params.State, ErrMissingCodeChallenge)
+			h.renderAuthError(w, r, params.RedirectURI, params.State, ErrMissingCodeChallenge,
+				append(paramDiagnostics("code_challenge", "", ""), pkceDiagnostic(client))...)
 			return
 		}
 		if params.CodeChallengeMethod != nil && *params.CodeChallengeMethod != "S256" && *params.CodeChallengeMethod != "plain" {
-			h.renderAuthError(w, r, params.RedirectURI, params.State, ErrInvalidCodeChallengeMethod)
+			h.renderAuthError(w, r, params.RedirectURI, params.State, ErrInvalidCodeChallengeMethod,
+				append(paramDiagnostics("code_challenge_method", "S256 plain", *params.CodeChallengeMethod), pkceDiagnostic(client))...)
 			return
 		}
 	}
@@ -492,7 +850,7 @@ func (h *Handler) HandleAuthorize(w http.ResponseWriter, r *http.Request) {
 		csrfToken := generateCSRFToken()
 		sessionData.CSRFToken = csrfToken
 		h.sessionStore.SetData(r, w, sessionData)
-		h.renderConsentPage(w, client, params, csrfToken)
+		h.renderConsentPage(w, r, client, params, csrfToken)
 		return
 	}
 
@@ -527,7 +885,7 @@ func (h *Handler) HandleAuthorize(w http.ResponseWriter, r *http.Request) {
 		h.log.Error("failed to save session", "error", err)
 	}
 
-	h.renderConsentPage(w, client, params, csrfToken)
+	h.renderConsentPage(w, r, client, params, csrfToken)
 }
 
 func (h *Handler) HandleAuthorizeProcess(w http.ResponseWriter, r *http.Request) {
@@ -555,6 +913,7 @@ func (h *Handler) HandleAuthorizeProcess(w http.ResponseWriter, r *http.Request)
 		Nonce:               stringPtr(r.FormValue("nonce")),
 		CodeChallenge:       stringPtr(r.FormValue("code_challenge")),
 		CodeChallengeMethod: stringPtr(r.FormValue("code_challenge_method")),
+		UILocales:           r.FormValue("ui_locales"),
 	}
 
 	clientIDStr := r.FormValue("client_id")
@@ -573,14 +932,14 @@ func (h *Handler) HandleAuthorizeProcess(w http.ResponseWriter, r *http.Request)
 		return
 	}
 	if !user.IsActive {
-		redirectWithError(w, r, params.RedirectURI, "access_denied", "account_not_activated", params.State)
+		h.redirectWithError(w, r, params.RedirectURI, "access_denied", "account_not_activated", params.State)
 		return
 	}
 
 	decision := r.FormValue("decision")
 
 	if decision == "deny" {
-		redirectWithError(w, r, params.RedirectURI, "access_denied", "User denied the request", params.State)
+		h.redirectWithError(w, r, params.RedirectURI, "access_denied", "User denied the request", params.State)
 		return
 	}
 
@@ -600,6 +959,13 @@ func (h *Handler) HandleAuthorizeProcess(w http.ResponseWriter, r *http.Request)
 
 	if err := h.svc.SaveUserConsent(r.Context(), sessionData.UserID, params.ClientID, params.Scope); err != nil {
 		h.log.Warn("failed to save user consent", "error", err)
+	} else {
+		h.events.Publish(r.Context(), ConsentGranted{
+			UserID:        sessionData.UserID,
+			UserPublicID:  user.ID,
+			OAuthClientID: params.ClientID,
+			Scope:         params.Scope,
+		})
 	}
 
 	redirectWithCode(w, r, params.RedirectURI, code.Code.String(), params.State)
@@ -634,7 +1000,13 @@ func (h *Handler) HandleToken(w http.ResponseWriter, r *http.Request) {
 		case ErrInvalidClientID:
 			writeTokenError(w, "invalid_client", "Unknown client", http.StatusUnauthorized)
 		case ErrInvalidClientSecret:
+			// The client exists, so guessed secrets count as its failures
+			if id, err := uuid.Parse(clientID); err == nil {
+				h.svc.RecordTokenRequest(r.Context(), id, false)
+			}
 			writeTokenError(w, "invalid_client", "Client authentication failed", http.StatusUnauthorized)
+		case ErrClientSecretExpired:
+			writeTokenError(w, "invalid_client", "Client secret expired, rotate it", http.StatusUnauthorized)
 		default:
 			h.log.Error("client authentication error", "error", err)
 			writeTokenError(w, "invalid_client", "Client authentication failed", http.StatusUnauthorized)
@@ -642,18 +1014,35 @@ func (h *Handler) HandleToken(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Every grant answers 200 exactly when it issued tokens
+	sw := &tokenStatusWriter{ResponseWriter: w}
+	defer func() {
+		h.svc.RecordTokenRequest(r.Context(), client.ClientID, sw.status == http.StatusOK)
+	}()
+
 	grantType := r.FormValue("grant_type")
 
 	switch grantType {
 	case "authorization_code":
-		h.handleAuthorizationCodeGrant(w, r, client)
+		h.handleAuthorizationCodeGrant(sw, r, client)
 	case "refresh_token":
-		h.handleRefreshTokenGrant(w, r, client)
+		h.handleRefreshTokenGrant(sw, r, client)
 	default:
-		writeTokenError(w, "unsupported_grant_type", "Grant type not supported", http.StatusBadRequest)
+		writeTokenError(sw, "unsupported_grant_type", "Grant type not supported", http.StatusBadRequest)
 	}
 }
 
+// tokenStatusWriter remembers the status of a token response for the stats
+type tokenStatusWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (w *tokenStatusWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
 func (h *Handler) handleAuthorizationCodeGrant(w http.ResponseWriter, r *http.Request, client *OAuthClientInfo) {
 	code := r.FormValue("code")
 	redirectURI := r.FormValue("redirect_uri")
@@ -740,6 +1129,8 @@ func (h *Handler) handleAuthorizationCodeGrant(w http.ResponseWriter, r *http.Re
 		Email:         email,
 		Name:          name,
 		EmailVerified: user.EmailVerified,
+		PermsClaim:    client.PermsClaim,
+		PermsMaxBytes: client.PermsMaxBytes,
 	})
 	if err != nil {
 		h.log.Error("failed to generate tokens", "error", err)
@@ -813,6 +1204,8 @@ func (h *Handler) handleRefreshTokenGrant(w http.ResponseWriter, r *http.Request
 		Email:         email,
 		Name:          name,
 		EmailVerified: user.EmailVerified,
+		PermsClaim:    client.PermsClaim,
+		PermsMaxBytes: client.PermsMaxBytes,
 	})
 	if err != nil {
 		h.log.Error("failed to generate tokens", "error", err)
@@ -830,9 +1223,25 @@ func (h *Handler) HandleJWKS(w http.ResponseWriter, r *http.Request) {
 	}
 
 	jwks := jwt.GenerateJWKS(h.jwtSigner.GetPublicKey(), h.jwtSigner.GetKID())
-	w.Header().Set("Content-Type", "application/json")
+	body, err := json.Marshal(jwks)
+	if err != nil {
+		http.Error(w, "JWKS not available", http.StatusInternalServerError)
+		return
+	}
+
+	// The ETag lets clients revalidate their cached key set without
+	// downloading it again until the signing key changes
+	sum := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+	w.Header().Set("ETag", etag)
 	w.Header().Set("Cache-Control", "public, max-age=3600")
-	json.NewEncoder(w).Encode(jwks)
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(body)
 }
 
 func (h *Handler) HandleUserInfo(w http.ResponseWriter, r *http.Request) {
@@ -974,9 +1383,9 @@ func (h *Handler) HandleIntrospect(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *Handler) HandleOpenIDConfiguration(w http.ResponseWriter, r *http.Request) {
-	// Use configured issuer for consistency with JWT tokens
-	// This must match the "iss" claim in access tokens for JWKS discovery
-	issuer := h.cfg.GetJWTIssuer()
+	// This must match the "iss" claim of the access tokens issued on the same
+	// host for JWKS discovery
+	issuer := Issuer(r.Context(), h.cfg)
 
 	config := map[string]interface{}{
 		"issuer":                 issuer,
@@ -986,6 +1395,7 @@ func (h *Handler) HandleOpenIDConfiguration(w http.ResponseWriter, r *http.Reque
 		"jwks_uri":               issuer + "/.well-known/jwks.json",
 		"revocation_endpoint":    issuer + "/oauth/revoke",
 		"introspection_endpoint": issuer + "/oauth/introspect",
+		"end_session_endpoint":   issuer + "/oauth/end_session",
 		"scopes_supported": []string{
 			"openid",
 			"profile",
@@ -1029,6 +1439,22 @@ func (h *Handler) HandleOpenIDConfiguration(w http.ResponseWriter, r *http.Reque
 			"email",          // email scope
 			"email_verified", // email scope
 		},
+		// Locales the pages and error descriptions can be shown in
+		"ui_locales_supported": i18n.Locales(),
+		// Authorization requests are plain query parameters: request objects
+		// and the claims parameter are not understood
+		"request_parameter_supported":     false,
+		"request_uri_parameter_supported": false,
+		"claims_parameter_supported":      false,
+	}
+	if uri := h.cfg.GetAuthServiceDocumentation(); uri != "" {
+		config["service_documentation"] = uri
+	}
+	if uri := h.cfg.GetAuthPolicyURI(); uri != "" {
+		config["op_policy_uri"] = uri
+	}
+	if uri := h.cfg.GetAuthTOSURI(); uri != "" {
+		config["op_tos_uri"] = uri
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -1052,6 +1478,7 @@ type AuthorizationParams struct {
 	CodeChallenge       *string
 	CodeChallengeMethod *string
 	Prompt              string
+	UILocales           string
 }
 
 func parseAuthorizationParams(r *http.Request) (*AuthorizationParams, error) {
@@ -1061,6 +1488,7 @@ func parseAuthorizationParams(r *http.Request) (*AuthorizationParams, error) {
 		Scope:        r.URL.Query().Get("scope"),
 		State:        r.URL.Query().Get("state"),
 		Prompt:       r.URL.Query().Get("prompt"),
+		UILocales:    r.URL.Query().Get("ui_locales"),
 	}
 
 	nonce := r.URL.Query().Get("nonce")
@@ -1113,6 +1541,7 @@ func parseAuthorizationParamsFromURL(urlStr string) (*AuthorizationParams, error
 		RedirectURI:  u.Query().Get("redirect_uri"),
 		Scope:        u.Query().Get("scope"),
 		State:        u.Query().Get("state"),
+		UILocales:    u.Query().Get("ui_locales"),
 	}
 
 	nonce := u.Query().Get("nonce")
@@ -1155,7 +1584,14 @@ func redirectWithCode(w http.ResponseWriter, r *http.Request, redirectURI, code,
 	http.Redirect(w, r, u.String(), http.StatusFound)
 }
 
-func redirectWithError(w http.ResponseWriter, r *http.Request, redirectURI, errorCode, errorDesc, state string) {
+// redirectWithError sends the user back to the client with an OAuth error.
+// The error_description stays in English unless the client asked for
+// ui_locales, in which case it is translated to the best matching locale.
+func (h *Handler) redirectWithError(w http.ResponseWriter, r *http.Request, redirectURI, errorCode, errorDesc, state string) {
+	if uiLocales := uiLocalesParam(r); uiLocales != "" {
+		errorDesc = i18n.T(i18n.NegotiateUILocales(uiLocales, h.defaultLocale(r)), errorDesc)
+	}
+
 	u, _ := url.Parse(redirectURI)
 	q := u.Query()
 	q.Set("error", errorCode)
@@ -1178,7 +1614,10 @@ func stringPtr(s string) *string {
 	return &s
 }
 
-func (h *Handler) renderAuthError(w http.ResponseWriter, r *http.Request, redirectURI, state string, err error) {
+// renderAuthError answers a failed authorization request: with an error
+// redirect when the redirect URI is known, else with the error page. In
+// sandbox projects the error description or page carries the diagnostics.
+func (h *Handler) renderAuthError(w http.ResponseWriter, r *http.Request, redirectURI, state string, err error, diagnostics ...views.Diagnostic) {
 	if redirectURI != "" {
 		errorCode := "server_error"
 		errorDesc := err.Error()
@@ -1193,21 +1632,33 @@ func (h *Handler) renderAuthError(w http.ResponseWriter, r *http.Request, redire
 			errorCode = "invalid_request"
 			errorDesc = "code_challenge_method must be S256 or plain"
 		}
+		if h.sandbox(r) && len(diagnostics) > 0 {
+			details := make([]string, 0, len(diagnostics))
+			for _, d := range diagnostics {
+				details = append(details, d.Label+": "+d.Value)
+			}
+			errorDesc += " (" + strings.Join(details, "; ") + ")"
+		}
 
-		redirectWithError(w, r, redirectURI, errorCode, errorDesc, state)
+		h.redirectWithError(w, r, redirectURI, errorCode, errorDesc, state)
 		return
 	}
 
-	h.renderError(w, "invalid_request", err.Error())
+	h.renderError(w, r, "invalid_request", err.Error(), diagnostics...)
 }
 
-func (h *Handler) renderError(w http.ResponseWriter, errorCode, errorDesc string) {
+// renderError renders the error page. Diagnostics only show in sandbox
+// projects, live ones keep the terse description.
+func (h *Handler) renderError(w http.ResponseWriter, r *http.Request, errorCode, errorDesc string, diagnostics ...views.Diagnostic) {
 	data := views.ErrorPageData{
-		BaseData:         h.baseData("Error"),
+		BaseData:         h.baseData(r, "Error"),
 		Error:            errorCode,
 		ErrorDescription: errorDesc,
 		ShowBackToLogin:  true,
 	}
+	if h.sandbox(r) {
+		data.Diagnostics = diagnostics
+	}
 
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
 	w.WriteHeader(http.StatusBadRequest)
@@ -1217,11 +1668,120 @@ func (h *Handler) renderError(w http.ResponseWriter, errorCode, errorDesc string
 	}
 }
 
-func (h *Handler) renderConsentPage(w http.ResponseWriter, client *OAuthClientInfo, params *AuthorizationParams, csrfToken string) {
+// sandbox reports whether the request arrived on a hostname of a sandbox
+// project. Requests on no project hostname belong to the default project,
+// which is live.
+func (h *Handler) sandbox(r *http.Request) bool {
+	tenant := TenantFromContext(r.Context())
+	return tenant != nil && tenant.Sandbox
+}
+
+// paramDiagnostics details a failing request parameter: the values expected
+// of it, when known, and the value received
+func paramDiagnostics(param, expected, received string) []views.Diagnostic {
+	diagnostics := []views.Diagnostic{{Label: "Parameter", Value: param}}
+	if expected != "" {
+		diagnostics = append(diagnostics, views.Diagnostic{Label: "Expected", Value: expected})
+	}
+	if received == "" {
+		received = `""`
+	}
+	return append(diagnostics, views.Diagnostic{Label: "Received", Value: received})
+}
+
+// authParamDiagnostics details the parameter of an authorization request that
+// failed parsing with err
+func authParamDiagnostics(r *http.Request, err error) []views.Diagnostic {
+	var param string
+	switch err {
+	case ErrMissingClientID, ErrInvalidClientID:
+		param = "client_id"
+	case ErrMissingResponseType:
+		param = "response_type"
+	case ErrMissingRedirectURI:
+		param = "redirect_uri"
+	default:
+		return nil
+	}
+	return paramDiagnostics(param, "", r.URL.Query().Get(param))
+}
+
+// pkceDiagnostic states whether a client requires PKCE
+func pkceDiagnostic(client *OAuthClientInfo) views.Diagnostic {
+	if client.PKCERequired {
+		return views.Diagnostic{Label: "Client PKCE", Value: "Required"}
+	}
+	return views.Diagnostic{Label: "Client PKCE", Value: "Optional"}
+}
+
+// redirectOrigins returns the origins of the redirect URIs of a client, where
+// it may send users after they log out
+func redirectOrigins(client *OAuthClientInfo) []string {
+	origins := make([]string, 0, len(client.RedirectURIs))
+	for _, registered := range client.RedirectURIs {
+		if u, err := url.Parse(registered); err == nil && u.Host != "" {
+			origins = append(origins, u.Scheme+"://"+u.Host)
+		}
+	}
+	return origins
+}
+
+// renderLoginExpired tells the user that the provider login they come back
+// from is too old and offers to start it again.
+func (h *Handler) renderLoginExpired(w http.ResponseWriter, r *http.Request, providerName, originalURL string) {
+	retryURL := "/login"
+	if providerName != "" {
+		retryURL = "/login/" + url.PathEscape(providerName)
+		if originalURL != "" {
+			retryURL += "?" + url.Values{"next": {originalURL}}.Encode()
+		}
+	}
+
+	data := views.ErrorPageData{
+		BaseData:         h.baseData(r, "Error"),
+		Error:            "Sign in timed out",
+		ErrorDescription: "You took too long to sign in with the provider. Please try again.",
+		ShowBackToLogin:  true,
+		RetryURL:         retryURL,
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusBadRequest)
+	if err := views.Render(w, "error.html", data); err != nil {
+		h.log.Error("failed to render error page", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+	}
+}
+
+// RenderMaintenance answers a request the auth server turns away during
+// maintenance: the token and revocation endpoints with the OAuth
+// temporarily_unavailable error, the pages with the maintenance page.
+func (h *Handler) RenderMaintenance(w http.ResponseWriter, r *http.Request, message string) {
+	if r.URL.Path == "/oauth/token" || r.URL.Path == "/oauth/revoke" {
+		description := message
+		if description == "" {
+			description = "The authorization server is under maintenance"
+		}
+		writeTokenError(w, "temporarily_unavailable", description, http.StatusServiceUnavailable)
+		return
+	}
+
+	data := h.baseData(r, "Down for maintenance")
+	data.Message = message
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusServiceUnavailable)
+	if err := views.Render(w, "maintenance.html", data); err != nil {
+		h.log.Error("failed to render maintenance page", "error", err)
+	}
+}
+
+func (h *Handler) renderConsentPage(w http.ResponseWriter, r *http.Request, client *OAuthClientInfo, params *AuthorizationParams, csrfToken string) {
 	scopes := parseScopes(params.Scope)
 
 	data := views.ConsentPageData{
-		BaseData:            h.baseData("Authorize"),
+		BaseData:            h.baseData(r, "Authorize"),
 		ClientName:          client.Name,
 		Scopes:              scopes,
 		CSRFToken:           csrfToken,
@@ -1232,6 +1792,7 @@ func (h *Handler) renderConsentPage(w http.ResponseWriter, client *OAuthClientIn
 		Nonce:               params.Nonce,
 		CodeChallenge:       params.CodeChallenge,
 		CodeChallengeMethod: params.CodeChallengeMethod,
+		UILocales:           params.UILocales,
 	}
 
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
@@ -1362,7 +1923,7 @@ func (h *Handler) HandleProfile(w http.ResponseWriter, r *http.Request) {
 	verificationEmailError := verificationStatus == "error"
 
 	data := views.ProfileData{
-		BaseData:                   h.baseData("Your Profile"),
+		BaseData:                   h.baseData(r, "Your Profile"),
 		User:                       user,
 		Identities:                 userIdentities,
 		Consents:                   consents,
@@ -1370,6 +1931,32 @@ func (h *Handler) HandleProfile(w http.ResponseWriter, r *http.Request) {
 		UserEmail:                  user.Email,
 		VerificationEmailSent:      verificationEmailSent,
 		VerificationEmailError:     verificationEmailError,
+		RememberMe:                 h.rememberMe != nil,
+		SessionIdleMinutes:         int(h.sessionStore.IdleTimeout().Minutes()),
+	}
+	if !sessionData.AuthenticatedAt.IsZero() {
+		data.SessionExpiresAt = h.sessionStore.ExpiresAt(sessionData)
+		data.SessionExpiringSoon = time.Until(data.SessionExpiresAt) < sessionExpiryWarning
+	}
+
+	if h.rememberMe != nil {
+		devices, err := h.rememberMe.ListDevices(r.Context(), sessionData.UserID)
+		if err != nil {
+			http.Error(w, "Internal server error", http.StatusInternalServerError)
+			return
+		}
+		current := RememberSeries(h.rememberCookieValue(r))
+		for _, device := range devices {
+			data.Devices = append(data.Devices, views.RememberedDevice{
+				Series:     device.Series,
+				UserAgent:  device.UserAgent,
+				IPAddress:  device.IPAddress,
+				LastUsedAt: device.LastUsedAt,
+				ExpiresAt:  device.ExpiresAt,
+				CreatedAt:  device.CreatedAt,
+				Current:    device.Series == current,
+			})
+		}
 	}
 
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
@@ -1417,6 +2004,47 @@ func (h *Handler) HandleRevokeConsent(w http.ResponseWriter, r *http.Request) {
 	http.Redirect(w, r, "/profile", http.StatusFound)
 }
 
+// HandleRevokeDevice signs one of the user's remembered devices out.
+func (h *Handler) HandleRevokeDevice(w http.ResponseWriter, r *http.Request) {
+	sessionData, err := h.sessionStore.GetData(r)
+	if err != nil || sessionData.UserID == 0 {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	if h.rememberMe == nil {
+		http.Error(w, "Not found", http.StatusNotFound)
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Bad request", http.StatusBadRequest)
+		return
+	}
+
+	series := r.FormValue("series")
+	if series == "" {
+		http.Error(w, "Missing series", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.rememberMe.RevokeDevice(r.Context(), sessionData.UserID, series); err != nil {
+		if errors.Is(err, ErrRememberTokenNotFound) {
+			http.Error(w, "Device not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	// The current session stays, but this browser is no longer remembered
+	if RememberSeries(h.rememberCookieValue(r)) == series {
+		http.SetCookie(w, h.rememberCookies().Expire(RememberCookieName))
+	}
+
+	http.Redirect(w, r, "/profile", http.StatusFound)
+}
+
 // HandleRoot redirects based on authentication state.
 func (h *Handler) HandleRoot(w http.ResponseWriter, r *http.Request) {
 	if h.sessionStore.IsAuthenticated(r) {
@@ -1447,8 +2075,11 @@ func (h *Handler) HandleEmailLoginPage(w http.ResponseWriter, r *http.Request) {
 	errorMsg := r.URL.Query().Get("error")
 
 	data := views.EmailLoginPageData{
-		BaseData: h.baseData("Login with Email"),
-		Error:    errorMsg,
+		BaseData:   h.baseData(r, "Login with Email"),
+		Error:      errorMsg,
+		Captcha:    h.captchaData(r),
+		RememberMe: h.rememberMe != nil,
+		Remember:   r.URL.Query().Get("remember") == "1",
 	}
 
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
@@ -1465,9 +2096,27 @@ func (h *Handler) HandleEmailLoginSubmit(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	email := strings.ToLower(strings.TrimSpace(r.FormValue("email")))
-	if email == "" {
-		http.Redirect(w, r, "/login/email?error=email_required", http.StatusFound)
+	email := form.Email(r, "email")
+	fieldErrs, err := form.Validate(&altalunev1.CreateUserRequest{Email: email}, "email")
+	if err != nil {
+		h.log.Error("failed to validate email login form", "error", err)
+		http.Redirect(w, r, "/login/email?error=server_error", http.StatusFound)
+		return
+	}
+	if fieldErrs != nil {
+		data := views.EmailLoginPageData{
+			BaseData:    h.baseData(r, "Login with Email"),
+			Email:       email,
+			FieldErrors: fieldErrs,
+			Captcha:     h.captchaData(r),
+			RememberMe:  h.rememberMe != nil,
+			Remember:    r.PostForm.Get("remember") == "1",
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		if err := views.Render(w, "email_input.html", data); err != nil {
+			h.log.Error("failed to render email login page", "error", err)
+			http.Error(w, "Internal server error", http.StatusInternalServerError)
+		}
 		return
 	}
 
@@ -1478,14 +2127,28 @@ func (h *Handler) HandleEmailLoginSubmit(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	// Every submission sends an email, so bots must solve the challenge first
+	if code := h.verifyCaptcha(r); code != "" {
+		http.Redirect(w, r, "/login/email?error="+code, http.StatusFound)
+		return
+	}
+
 	// Generate and send OTP
-	err := h.otpService.GenerateAndSendOTP(r.Context(), email)
+	err = h.otpService.GenerateAndSendOTP(r.Context(), email)
 	if err != nil {
 		switch {
 		case errors.Is(err, ErrEmailNotRegistered):
 			http.Redirect(w, r, "/login/email?error=email_not_registered", http.StatusFound)
+		case errors.Is(err, ErrAccountLocked):
+			http.Redirect(w, r, "/login/email?error=account_locked", http.StatusFound)
 		case errors.Is(err, ErrOTPRateLimited):
 			http.Redirect(w, r, "/login/email?error=rate_limited", http.StatusFound)
+		case errors.Is(err, emailcheck.ErrInvalidSyntax):
+			http.Redirect(w, r, "/login/email?error=email_invalid", http.StatusFound)
+		case errors.Is(err, emailcheck.ErrBlockedDomain):
+			http.Redirect(w, r, "/login/email?error=email_domain_blocked", http.StatusFound)
+		case errors.Is(err, emailcheck.ErrNoMailServer):
+			http.Redirect(w, r, "/login/email?error=email_undeliverable", http.StatusFound)
 		default:
 			h.log.Error("failed to send OTP", "error", err)
 			http.Redirect(w, r, "/login/email?error=server_error", http.StatusFound)
@@ -1499,6 +2162,7 @@ func (h *Handler) HandleEmailLoginSubmit(w http.ResponseWriter, r *http.Request)
 		sessionData = &session.Data{}
 	}
 	sessionData.PendingOTPEmail = email
+	sessionData.RememberMe = h.rememberMe != nil && r.PostForm.Get("remember") == "1"
 	if err := h.sessionStore.SetData(r, w, sessionData); err != nil {
 		h.log.Error("failed to save session", "error", err)
 		http.Redirect(w, r, "/login/email?error=server_error", http.StatusFound)
@@ -1508,6 +2172,59 @@ func (h *Handler) HandleEmailLoginSubmit(w http.ResponseWriter, r *http.Request)
 	http.Redirect(w, r, "/login/otp", http.StatusFound)
 }
 
+// captchaChallenge returns the bot challenge of the forms served on the
+// request Host: the one of its project, or the default one. ok is false when
+// the challenge is disabled.
+func (h *Handler) captchaChallenge(r *http.Request) (captcha.Provider, altalune.CaptchaConfig, bool) {
+	if h.captcha == nil {
+		return captcha.Provider{}, altalune.CaptchaConfig{}, false
+	}
+	projectID := ""
+	if tenant := TenantFromContext(r.Context()); tenant != nil {
+		projectID = tenant.ProjectID
+	}
+	cfg := h.cfg.GetCaptcha(projectID)
+	provider, ok := captcha.Lookup(cfg.Provider)
+	return provider, cfg, ok
+}
+
+// captchaData returns the challenge widget of the forms served on the request
+// Host, nil when the challenge is disabled.
+func (h *Handler) captchaData(r *http.Request) *views.CaptchaData {
+	provider, cfg, ok := h.captchaChallenge(r)
+	if !ok {
+		return nil
+	}
+	return &views.CaptchaData{
+		ScriptURL:   provider.ScriptURL,
+		WidgetClass: provider.WidgetClass,
+		SiteKey:     cfg.SiteKey,
+	}
+}
+
+// verifyCaptcha checks the challenge response posted with a form sending
+// emails, such as the email sign-in and registration forms. It returns the
+// error code to redirect with when the form must be rejected, empty otherwise.
+// A provider that cannot be reached rejects the form too.
+func (h *Handler) verifyCaptcha(r *http.Request) string {
+	provider, cfg, ok := h.captchaChallenge(r)
+	if !ok {
+		return ""
+	}
+
+	err := h.captcha.Verify(r.Context(), provider, cfg.SecretKey, r.PostForm.Get(provider.ResponseField), realip.IP(r.Context()))
+	switch {
+	case err == nil:
+		return ""
+	case errors.Is(err, captcha.ErrMissingResponse), errors.Is(err, captcha.ErrChallengeFailed):
+		h.log.Info("captcha challenge failed", "error", err, "provider", provider.Name)
+		return "captcha_failed"
+	default:
+		h.log.Error("failed to verify captcha", "error", err, "provider", provider.Name)
+		return "server_error"
+	}
+}
+
 // HandleOTPPage shows the OTP input form.
 func (h *Handler) HandleOTPPage(w http.ResponseWriter, r *http.Request) {
 	sessionData, err := h.sessionStore.GetData(r)
@@ -1521,7 +2238,7 @@ func (h *Handler) HandleOTPPage(w http.ResponseWriter, r *http.Request) {
 	errorMsg := r.URL.Query().Get("error")
 
 	data := views.OTPPageData{
-		BaseData:   h.baseData("Enter Code"),
+		BaseData:   h.baseData(r, "Enter Code"),
 		Email:      maskedEmail,
 		Error:      errorMsg,
 		ExpiryMins: 5,
@@ -1548,9 +2265,9 @@ func (h *Handler) HandleOTPVerify(w http.ResponseWriter, r *http.Request) {
 	}
 
 	email := sessionData.PendingOTPEmail
-	otp := r.FormValue("otp")
+	otp := form.Text(r, "otp")
 
-	if otp == "" {
+	if !isOTPCode(otp) {
 		http.Redirect(w, r, "/login/otp?error=invalid_otp", http.StatusFound)
 		return
 	}
@@ -1564,6 +2281,15 @@ func (h *Handler) HandleOTPVerify(w http.ResponseWriter, r *http.Request) {
 
 	// Validate OTP
 	user, err := h.otpService.ValidateOTP(r.Context(), email, otp)
+	if errors.Is(err, ErrAccountLocked) {
+		// Start over from the email form, which explains the lock
+		sessionData.PendingOTPEmail = ""
+		if err := h.sessionStore.SetData(r, w, sessionData); err != nil {
+			h.log.Error("failed to save session", "error", err)
+		}
+		http.Redirect(w, r, "/login/email?error=account_locked", http.StatusFound)
+		return
+	}
 	if err != nil {
 		h.log.Debug("invalid OTP attempt", "email", email, "error", err)
 		http.Redirect(w, r, "/login/otp?error=invalid_otp", http.StatusFound)
@@ -1571,14 +2297,19 @@ func (h *Handler) HandleOTPVerify(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Create session first (so pending-activation page can access user info)
+	remember := sessionData.RememberMe
 	sessionData.UserID = user.ID
 	sessionData.AuthenticatedAt = time.Now()
 	sessionData.PendingOTPEmail = "" // Clear pending email
+	sessionData.RememberMe = false
 	if err := h.sessionStore.SetData(r, w, sessionData); err != nil {
 		h.log.Error("failed to save session", "error", err)
 		http.Error(w, "Internal server error", http.StatusInternalServerError)
 		return
 	}
+	if remember {
+		h.rememberDevice(w, r, user.ID)
+	}
 
 	// Check if user is active - redirect inactive users to pending activation
 	if !user.IsActive {
@@ -1602,7 +2333,7 @@ func (h *Handler) HandleVerifyEmail(w http.ResponseWriter, r *http.Request) {
 	token := r.URL.Query().Get("token")
 
 	data := views.VerifyEmailResultData{
-		BaseData: h.baseData("Email Verification"),
+		BaseData: h.baseData(r, "Email Verification"),
 	}
 
 	if token == "" {
@@ -1703,7 +2434,7 @@ func (h *Handler) HandlePendingActivation(w http.ResponseWriter, r *http.Request
 	}
 
 	data := views.PendingActivationData{
-		BaseData:  h.baseData("Account Pending"),
+		BaseData:  h.baseData(r, "Account Pending"),
 		UserEmail: user.Email,
 	}
 
@@ -1727,6 +2458,20 @@ func maskEmail(email string) string {
 	return local[0:1] + "***" + local[len(local)-1:] + "@" + parts[1]
 }
 
+// isOTPCode reports whether otp has the shape of a generated code, so
+// malformed input is rejected without a database lookup.
+func isOTPCode(otp string) bool {
+	if len(otp) != otpLength {
+		return false
+	}
+	for _, c := range otp {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
 // HandleEditProfile shows the edit profile form.
 func (h *Handler) HandleEditProfile(w http.ResponseWriter, r *http.Request) {
 	// Require authentication
@@ -1750,7 +2495,7 @@ func (h *Handler) HandleEditProfile(w http.ResponseWriter, r *http.Request) {
 	}
 
 	data := views.EditProfileData{
-		BaseData: h.baseData("Edit Profile"),
+		BaseData: h.baseData(r, "Edit Profile"),
 		User:     user,
 	}
 
@@ -1788,15 +2533,25 @@ func (h *Handler) HandleUpdateProfile(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	firstName := strings.TrimSpace(r.FormValue("first_name"))
-	lastName := strings.TrimSpace(r.FormValue("last_name"))
+	firstName := form.Text(r, "first_name")
+	lastName := form.Text(r, "last_name")
 
-	// Validate input lengths
-	if len(firstName) > 100 || len(lastName) > 100 {
+	// Validate against the same rules as the user API
+	fieldErrs, err := form.Validate(&altalunev1.UpdateUserRequest{FirstName: firstName, LastName: lastName}, "first_name", "last_name")
+	if err != nil {
+		h.log.Error("failed to validate profile form", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	if fieldErrs != nil {
+		// Show the submitted values next to their errors
+		submitted := *user
+		submitted.FirstName = firstName
+		submitted.LastName = lastName
 		data := views.EditProfileData{
-			BaseData:     h.baseData("Edit Profile"),
-			User:         user,
-			ErrorMessage: "Name fields must be 100 characters or less",
+			BaseData:    h.baseData(r, "Edit Profile"),
+			User:        &submitted,
+			FieldErrors: fieldErrs,
 		}
 		w.Header().Set("Content-Type", "text/html; charset=utf-8")
 		views.Render(w, "edit_profile.html", data)
@@ -1808,7 +2563,7 @@ func (h *Handler) HandleUpdateProfile(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		h.log.Error("failed to update user profile", "error", err)
 		data := views.EditProfileData{
-			BaseData:     h.baseData("Edit Profile"),
+			BaseData:     h.baseData(r, "Edit Profile"),
 			User:         user,
 			ErrorMessage: "Failed to update profile. Please try again.",
 		}
@@ -1819,7 +2574,7 @@ func (h *Handler) HandleUpdateProfile(w http.ResponseWriter, r *http.Request) {
 
 	// Show success message
 	data := views.EditProfileData{
-		BaseData: h.baseData("Edit Profile"),
+		BaseData: h.baseData(r, "Edit Profile"),
 		User:     updatedUser,
 		Success:  true,
 	}
@@ -6,16 +6,23 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/hrz8/altalune/internal/domain/permission"
+	"github.com/hrz8/altalune/internal/domain/role"
+	user_domain "github.com/hrz8/altalune/internal/domain/user"
 )
 
 // UserPermissionProvider defines the interface for fetching user permissions.
 type UserPermissionProvider interface {
 	GetUserPermissions(ctx context.Context, userID int64) ([]string, error)
+	// GetUserRoleClaims returns the role names of a user and the permissions
+	// granted directly rather than through them
+	GetUserRoleClaims(ctx context.Context, userID int64) (roles, perms []string, err error)
 }
 
 // IAMMapperRepositor defines the interface for fetching user permissions.
 type IAMMapperRepositor interface {
 	GetUserPermissions(ctx context.Context, userID int64) ([]*permission.Permission, error)
+	GetDirectUserPermissions(ctx context.Context, userID int64) ([]*permission.Permission, error)
+	GetUserRoles(ctx context.Context, userID int64) ([]*role.Role, error)
 }
 
 // Repositor defines the interface for OAuth auth repository operations.
@@ -25,8 +32,9 @@ type Repositor interface {
 	MarkCodeExchanged(ctx context.Context, code uuid.UUID) error
 
 	CreateRefreshToken(ctx context.Context, input *CreateRefreshTokenInput) (*RefreshToken, error)
-	GetRefreshTokenByToken(ctx context.Context, token uuid.UUID) (*RefreshToken, error)
-	MarkRefreshTokenExchanged(ctx context.Context, token uuid.UUID) error
+	GetRefreshTokenByToken(ctx context.Context, token string) (*RefreshToken, error)
+	MarkRefreshTokenExchanged(ctx context.Context, token string) error
+	RevokeUserRefreshTokens(ctx context.Context, userID int64) (int64, error)
 
 	GetUserConsent(ctx context.Context, userID int64, clientID uuid.UUID) (*UserConsent, error)
 	GetUserConsents(ctx context.Context, userID int64) ([]*UserConsentWithClient, error)
@@ -34,6 +42,11 @@ type Repositor interface {
 	RevokeUserConsent(ctx context.Context, userID int64, clientID uuid.UUID) error
 
 	GetOAuthClientByClientID(ctx context.Context, clientID uuid.UUID) (*OAuthClientInfo, error)
+	UpdateOAuthClientSecretHash(ctx context.Context, id int64, oldHash, newHash string) error
+
+	// IncrementTokenStats adds to the counts of token requests of a client
+	// in the hour starting at hour
+	IncrementTokenStats(ctx context.Context, clientID uuid.UUID, hour time.Time, issued, failed int64) error
 }
 
 // OTPRepositor defines the interface for OTP repository operations.
@@ -46,10 +59,26 @@ type OTPRepositor interface {
 
 // EmailVerificationRepositor defines the interface for email verification repository operations.
 type EmailVerificationRepositor interface {
-	CreateVerificationToken(ctx context.Context, userID int64, tokenHash string, expiresAt time.Time) error
+	// CreateVerificationToken stores a token valid for ttl, invalidating the
+	// user's pending one: a user has at most one working verification link
+	CreateVerificationToken(ctx context.Context, userID int64, tokenHash string, ttl time.Duration) error
 	GetValidToken(ctx context.Context, tokenHash string) (*EmailVerificationToken, error)
 	MarkTokenUsed(ctx context.Context, id int64) error
 	InvalidateUserTokens(ctx context.Context, userID int64) error
+	PurgeStale(ctx context.Context, before time.Time) (int64, error)
+}
+
+// RememberTokenRepositor defines the interface for remember-me token repository operations.
+type RememberTokenRepositor interface {
+	CreateRememberToken(ctx context.Context, input *CreateRememberTokenInput) (*RememberToken, error)
+	// GetActiveRememberToken returns the unrevoked, unexpired token of a series
+	GetActiveRememberToken(ctx context.Context, series string) (*RememberToken, error)
+	// RotateRememberToken replaces the token of a series, unless a concurrent
+	// request replaced oldHash first
+	RotateRememberToken(ctx context.Context, id int64, oldHash, newHash string) error
+	ListUserRememberTokens(ctx context.Context, userID int64) ([]*RememberToken, error)
+	RevokeRememberToken(ctx context.Context, userID int64, series string) error
+	PurgeStale(ctx context.Context, before time.Time) (int64, error)
 }
 
 // UserLookupRepositor defines the interface for looking up users by email, public ID, or internal ID (for OTP service and introspection).
@@ -59,8 +88,24 @@ type UserLookupRepositor interface {
 	GetUserByID(ctx context.Context, userID int64) (*UserInfo, error)
 }
 
+// UserLockoutRepositor defines the interface for tracking failed sign-ins of a user.
+type UserLockoutRepositor interface {
+	RecordFailedLogin(ctx context.Context, userID int64, maxAttempts int, lockFor time.Duration) (*time.Time, error)
+	ResetFailedLogins(ctx context.Context, userID int64) error
+}
+
 // UserEmailVerificationRepositor defines the interface for user email verification operations.
 type UserEmailVerificationRepositor interface {
 	GetUserByID(ctx context.Context, userID int64) (*UserInfo, error)
 	SetEmailVerified(ctx context.Context, userID int64, verified bool) error
 }
+
+// ProjectOwnerRepositor defines the interface for looking up the owners of a project (for approval notifications).
+type ProjectOwnerRepositor interface {
+	GetProjectOwners(ctx context.Context, projectID int64) ([]*user_domain.ProjectOwner, error)
+}
+
+// ProjectTimezoneRepositor defines the interface for looking up the time zone of a project (for email timestamps).
+type ProjectTimezoneRepositor interface {
+	GetTimezone(ctx context.Context, projectID int64) (string, error)
+}
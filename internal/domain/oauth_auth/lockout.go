@@ -0,0 +1,68 @@
+package oauth_auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/hrz8/altalune"
+	"github.com/hrz8/altalune/internal/shared/events"
+)
+
+// AccountLockout locks an account for a while after too many failed sign-ins
+// in a row. The count is kept per account, so every sign-in method adds to it.
+type AccountLockout struct {
+	repo        UserLockoutRepositor
+	maxAttempts int
+	duration    time.Duration
+	log         altalune.Logger
+	events      *events.Bus
+}
+
+// NewAccountLockout creates an account lockout with the thresholds from cfg,
+// publishing LoginFailed on eventBus.
+func NewAccountLockout(repo UserLockoutRepositor, cfg altalune.Config, log altalune.Logger, eventBus *events.Bus) *AccountLockout {
+	return &AccountLockout{
+		repo:        repo,
+		maxAttempts: cfg.GetLockoutMaxAttempts(),
+		duration:    time.Duration(cfg.GetLockoutDuration()) * time.Second,
+		log:         log,
+		events:      eventBus,
+	}
+}
+
+// Check returns ErrAccountLocked while the account of user is locked.
+func (l *AccountLockout) Check(user *UserInfo) error {
+	if user.IsLocked(time.Now()) {
+		return ErrAccountLocked
+	}
+	return nil
+}
+
+// RecordFailure counts a failed sign-in of user and returns ErrAccountLocked
+// when it locked the account.
+func (l *AccountLockout) RecordFailure(ctx context.Context, user *UserInfo) error {
+	lockedUntil, err := l.repo.RecordFailedLogin(ctx, user.ID, l.maxAttempts, l.duration)
+	if err != nil {
+		l.log.Error("failed to record failed sign-in", "error", err, "userID", user.ID)
+		return nil
+	}
+	locked := lockedUntil != nil && lockedUntil.After(time.Now())
+	l.events.Publish(ctx, LoginFailed{
+		UserID:       user.ID,
+		UserPublicID: user.PublicID,
+		Email:        user.Email,
+		Locked:       locked,
+	})
+	if locked {
+		l.log.Warn("account locked after too many failed sign-ins", "userID", user.ID, "lockedUntil", lockedUntil)
+		return ErrAccountLocked
+	}
+	return nil
+}
+
+// RecordSuccess clears the failed sign-in count of user.
+func (l *AccountLockout) RecordSuccess(ctx context.Context, user *UserInfo) {
+	if err := l.repo.ResetFailedLogins(ctx, user.ID); err != nil {
+		l.log.Error("failed to reset failed sign-ins", "error", err, "userID", user.ID)
+	}
+}
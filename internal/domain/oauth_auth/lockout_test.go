@@ -0,0 +1,48 @@
+package oauth_auth
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/hrz8/altalune/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountLockout(t *testing.T) {
+	ctx := context.Background()
+	repo := NewInMemUserRepo()
+	repo.PutUser(&UserInfo{ID: 1, Email: "user@example.com", IsActive: true})
+	lockout := &AccountLockout{
+		repo:        repo,
+		maxAttempts: 3,
+		duration:    time.Minute,
+		log:         logger.NewWithOptions(logger.Options{Level: "error", Output: io.Discard}),
+	}
+
+	user, err := repo.GetUserByID(ctx, 1)
+	require.NoError(t, err)
+	assert.NoError(t, lockout.RecordFailure(ctx, user))
+	assert.NoError(t, lockout.RecordFailure(ctx, user))
+
+	// A success starts the count over
+	lockout.RecordSuccess(ctx, user)
+	assert.NoError(t, lockout.RecordFailure(ctx, user))
+	assert.NoError(t, lockout.RecordFailure(ctx, user))
+	assert.ErrorIs(t, lockout.RecordFailure(ctx, user), ErrAccountLocked)
+
+	user, err = repo.GetUserByID(ctx, 1)
+	require.NoError(t, err)
+	assert.ErrorIs(t, lockout.Check(user), ErrAccountLocked)
+
+	lockout.RecordSuccess(ctx, user)
+	user, err = repo.GetUserByID(ctx, 1)
+	require.NoError(t, err)
+	assert.NoError(t, lockout.Check(user))
+
+	expired := time.Now().Add(-time.Second)
+	user.LockedUntil = &expired
+	assert.NoError(t, lockout.Check(user), "expired locks no longer apply")
+}
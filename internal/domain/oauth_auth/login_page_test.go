@@ -0,0 +1,83 @@
+package oauth_auth_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hrz8/altalune/internal/domain/oauth_auth"
+	"github.com/hrz8/altalune/internal/domain/oauth_provider"
+	"github.com/hrz8/altalune/internal/session"
+	"github.com/hrz8/altalune/internal/shared/cookie"
+	"github.com/hrz8/altalune/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+// enabledProviders lists fixed providers; the other methods are never called
+type enabledProviders struct {
+	oauth_provider.Repository
+	types []oauth_provider.ProviderType
+	err   error
+}
+
+func (r *enabledProviders) ListEnabled(context.Context) ([]*oauth_provider.OAuthProvider, error) {
+	providers := make([]*oauth_provider.OAuthProvider, 0, len(r.types))
+	for _, providerType := range r.types {
+		providers = append(providers, &oauth_provider.OAuthProvider{ProviderType: providerType, Enabled: true})
+	}
+	return providers, r.err
+}
+
+func TestHandleLoginPageProviders(t *testing.T) {
+	tests := []struct {
+		name    string
+		repo    *enabledProviders
+		want    []string
+		notWant []string
+	}{
+		{
+			name:    "enabled providers only",
+			repo:    &enabledProviders{types: []oauth_provider.ProviderType{oauth_provider.ProviderTypeGithub}},
+			want:    []string{`formaction="/login/github"`},
+			notWant: []string{`formaction="/login/google"`},
+		},
+		{
+			name: "providers without sign in support are left out",
+			repo: &enabledProviders{types: []oauth_provider.ProviderType{
+				oauth_provider.ProviderTypeGoogle,
+				oauth_provider.ProviderTypeMicrosoft,
+			}},
+			want:    []string{`formaction="/login/google"`},
+			notWant: []string{`formaction="/login/microsoft"`},
+		},
+		{
+			name:    "email sign in when the providers cannot be read",
+			repo:    &enabledProviders{err: errors.New("connection refused")},
+			notWant: []string{`formaction="/login/google"`, `formaction="/login/github"`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log := logger.NewWithOptions(logger.Options{Level: "error", Output: io.Discard})
+			sessionStore := session.NewStore("login-page-session-secret-0123456789", cookie.Options{}, 0, time.Hour)
+			h := oauth_auth.NewHandler(nil, &conformanceConfig{}, nil, sessionStore, tt.repo, nil, nil, nil, nil, nil, nil, nil, nil, log)
+
+			rec := httptest.NewRecorder()
+			h.HandleLoginPage(rec, httptest.NewRequest(http.MethodGet, "/login", nil))
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+			body := rec.Body.String()
+			assert.Contains(t, body, `action="/login/email"`, "email sign in is always offered")
+			for _, s := range tt.want {
+				assert.Contains(t, body, s)
+			}
+			for _, s := range tt.notWant {
+				assert.NotContains(t, body, s)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/hrz8/altalune/internal/domain/iam_mapper"
+	"github.com/hrz8/altalune/internal/domain/organization"
 )
 
 // UserMembershipProvider defines the interface for fetching user project memberships.
@@ -16,17 +17,26 @@ type MembershipRepositor interface {
 	GetUserProjects(ctx context.Context, userID int64) ([]*iam_mapper.UserProjectMembership, error)
 }
 
+// OrganizationMembershipRepositor defines the interface for fetching the
+// projects a user holds a role in through their organizations.
+type OrganizationMembershipRepositor interface {
+	GetUserProjectRoles(ctx context.Context, userID int64) ([]*organization.ProjectRole, error)
+}
+
 // MembershipService adapts the IAM mapper repository to the MembershipProvider interface.
 type MembershipService struct {
-	repo MembershipRepositor
+	repo    MembershipRepositor
+	orgRepo OrganizationMembershipRepositor
 }
 
 // NewMembershipService creates a new membership fetcher adapter.
-func NewMembershipService(repo MembershipRepositor) *MembershipService {
-	return &MembershipService{repo: repo}
+func NewMembershipService(repo MembershipRepositor, orgRepo OrganizationMembershipRepositor) *MembershipService {
+	return &MembershipService{repo: repo, orgRepo: orgRepo}
 }
 
 // GetUserMemberships fetches all project memberships for a user and returns map of project_public_id -> role.
+// Organization owners and admins hold that role in every project of the organization, the higher role
+// kept when the user is also a member of the project.
 func (s *MembershipService) GetUserMemberships(ctx context.Context, userID int64) (map[string]string, error) {
 	projects, err := s.repo.GetUserProjects(ctx, userID)
 	if err != nil {
@@ -37,5 +47,16 @@ func (s *MembershipService) GetUserMemberships(ctx context.Context, userID int64
 	for _, p := range projects {
 		memberships[p.ProjectID] = p.Role
 	}
+
+	if s.orgRepo == nil {
+		return memberships, nil
+	}
+	orgProjects, err := s.orgRepo.GetUserProjectRoles(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range orgProjects {
+		memberships[p.ProjectPublicID] = iam_mapper.HigherProjectRole(memberships[p.ProjectPublicID], p.Role)
+	}
 	return memberships, nil
 }
@@ -0,0 +1,45 @@
+package oauth_auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hrz8/altalune/internal/domain/iam_mapper"
+	"github.com/hrz8/altalune/internal/domain/organization"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeMembershipRepo []*iam_mapper.UserProjectMembership
+
+func (r fakeMembershipRepo) GetUserProjects(ctx context.Context, userID int64) ([]*iam_mapper.UserProjectMembership, error) {
+	return r, nil
+}
+
+type fakeOrganizationMembershipRepo []*organization.ProjectRole
+
+func (r fakeOrganizationMembershipRepo) GetUserProjectRoles(ctx context.Context, userID int64) ([]*organization.ProjectRole, error) {
+	return r, nil
+}
+
+func TestGetUserMembershipsMergesOrganizationRoles(t *testing.T) {
+	svc := NewMembershipService(
+		fakeMembershipRepo{
+			{ProjectID: "prj_member", Role: "member"},
+			{ProjectID: "prj_owner", Role: "owner"},
+		},
+		fakeOrganizationMembershipRepo{
+			{ProjectPublicID: "prj_member", Role: "admin"},
+			{ProjectPublicID: "prj_owner", Role: "admin"},
+			{ProjectPublicID: "prj_org", Role: "admin"},
+		},
+	)
+
+	memberships, err := svc.GetUserMemberships(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"prj_member": "admin",
+		"prj_owner":  "owner",
+		"prj_org":    "admin",
+	}, memberships)
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/hrz8/altalune/internal/shared/jwt"
 )
 
 // AuthorizationCode represents an OAuth authorization code.
@@ -25,7 +26,7 @@ type AuthorizationCode struct {
 // RefreshToken represents an OAuth refresh token.
 type RefreshToken struct {
 	ID         int64
-	Token      uuid.UUID
+	Token      string
 	ClientID   uuid.UUID
 	UserID     int64
 	Scope      string
@@ -115,14 +116,23 @@ type GenerateAuthCodeInput struct {
 
 // OAuthClientInfo holds OAuth client information for authentication.
 type OAuthClientInfo struct {
-	ID           int64
-	ClientID     uuid.UUID
-	Name         string
-	RedirectURIs []string
-	PKCERequired bool
-	IsDefault    bool
-	SecretHash   *string // Nullable for public clients
-	Confidential bool
+	ID            int64
+	ClientID      uuid.UUID
+	Name          string
+	RedirectURIs  []string
+	PKCERequired  bool
+	IsDefault     bool
+	SecretHash    *string // Nullable for public clients
+	Confidential  bool
+	PermsClaim    jwt.PermsClaimMode // Empty is jwt.PermsClaimFull
+	PermsMaxBytes int                // Cap on the perms claim, 0 for none
+
+	SecretExpiresAt *time.Time // Nil when the secret never expires
+}
+
+// SecretExpired reports whether the secret of the client expired at now.
+func (c *OAuthClientInfo) SecretExpired(now time.Time) bool {
+	return c.SecretExpiresAt != nil && !now.Before(*c.SecretExpiresAt)
 }
 
 // OTPToken represents a one-time password token for authentication.
@@ -137,12 +147,42 @@ type OTPToken struct {
 
 // EmailVerificationToken represents a token for verifying user email addresses.
 type EmailVerificationToken struct {
-	ID        int64
-	UserID    int64
-	TokenHash string
-	ExpiresAt time.Time
-	UsedAt    *time.Time
-	CreatedAt time.Time
+	ID            int64
+	UserID        int64
+	TokenHash     string
+	ExpiresAt     time.Time
+	UsedAt        *time.Time // Set once the email is verified with it
+	InvalidatedAt *time.Time // Set once a newer token or an administrator replaced it
+	CreatedAt     time.Time
+}
+
+// RememberToken is a device a user signed in on with "keep me signed in",
+// whose cookie restores the session after it expired.
+type RememberToken struct {
+	ID                int64
+	Series            string // Public identifier of the device, stable across rotations
+	UserID            int64
+	TokenHash         string
+	PreviousTokenHash string     // Token replaced at RotatedAt, accepted for requests racing the rotation
+	RotatedAt         *time.Time // Set once the token was first replaced
+	DeviceHash        string     // Fingerprint of the User-Agent the device signed in with
+	UserAgent         string
+	IPAddress         string
+	ExpiresAt         time.Time
+	LastUsedAt        time.Time
+	RevokedAt         *time.Time
+	CreatedAt         time.Time
+}
+
+// CreateRememberTokenInput holds the data of a new remember-me token
+type CreateRememberTokenInput struct {
+	Series     string
+	UserID     int64
+	TokenHash  string
+	DeviceHash string
+	UserAgent  string
+	IPAddress  string
+	ExpiresAt  time.Time
 }
 
 // UserInfo holds minimal user information for OTP/verification services.
@@ -154,4 +194,17 @@ type UserInfo struct {
 	LastName      string
 	IsActive      bool
 	EmailVerified bool
+	LockedUntil   *time.Time // Set while the account is locked after too many failed sign-ins
+}
+
+// IsLocked reports whether the account is locked at now
+func (u *UserInfo) IsLocked(now time.Time) bool {
+	return u.LockedUntil != nil && u.LockedUntil.After(now)
+}
+
+// TokenStats counts the token requests of a client in an hour: the ones
+// that issued tokens and the ones that failed.
+type TokenStats struct {
+	Issued int64
+	Failed int64
 }
@@ -0,0 +1,79 @@
+package oauth_auth
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// InMemOTPRepo is an in-memory OTPRepositor for tests
+type InMemOTPRepo struct {
+	mu     sync.RWMutex
+	otps   []*OTPToken // In creation order
+	lastID int64
+}
+
+var _ OTPRepositor = (*InMemOTPRepo)(nil)
+
+// NewInMemOTPRepo creates an empty in-memory OTP repository
+func NewInMemOTPRepo() *InMemOTPRepo {
+	return &InMemOTPRepo{}
+}
+
+func (r *InMemOTPRepo) CreateOTP(ctx context.Context, email, otpHash string, expiresAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.lastID++
+	r.otps = append(r.otps, &OTPToken{
+		ID:        r.lastID,
+		Email:     email,
+		OTPHash:   otpHash,
+		ExpiresAt: expiresAt,
+		CreatedAt: time.Now(),
+	})
+	return nil
+}
+
+func (r *InMemOTPRepo) GetValidOTP(ctx context.Context, email, otpHash string) (*OTPToken, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	now := time.Now()
+	// Newest first, like ORDER BY created_at DESC
+	for i := len(r.otps) - 1; i >= 0; i-- {
+		otp := r.otps[i]
+		if otp.Email == email && otp.OTPHash == otpHash && otp.UsedAt == nil && otp.ExpiresAt.After(now) {
+			found := *otp
+			return &found, nil
+		}
+	}
+	return nil, ErrInvalidOTP
+}
+
+func (r *InMemOTPRepo) MarkOTPUsed(ctx context.Context, id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, otp := range r.otps {
+		if otp.ID == id {
+			now := time.Now()
+			otp.UsedAt = &now
+			return nil
+		}
+	}
+	return ErrInvalidOTP
+}
+
+func (r *InMemOTPRepo) CountRecentOTPs(ctx context.Context, email string, since time.Time) (int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	count := 0
+	for _, otp := range r.otps {
+		if otp.Email == email && otp.CreatedAt.After(since) {
+			count++
+		}
+	}
+	return count, nil
+}
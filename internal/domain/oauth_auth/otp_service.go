@@ -9,13 +9,19 @@ import (
 	"time"
 
 	"github.com/hrz8/altalune"
+	"github.com/hrz8/altalune/internal/shared/emailcheck"
 	"github.com/hrz8/altalune/internal/shared/notification"
 )
 
+// otpLength is the number of digits of a login code.
+const otpLength = 6
+
 // OTPService handles OTP generation, validation, and email sending.
 type OTPService struct {
 	repo         OTPRepositor
 	userRepo     UserLookupRepositor
+	lockout      *AccountLockout
+	emailChecker *emailcheck.Checker
 	notification *notification.NotificationService
 	log          altalune.Logger
 	cfg          altalune.Config
@@ -25,6 +31,8 @@ type OTPService struct {
 func NewOTPService(
 	repo OTPRepositor,
 	userRepo UserLookupRepositor,
+	lockout *AccountLockout,
+	emailChecker *emailcheck.Checker,
 	notificationSvc *notification.NotificationService,
 	log altalune.Logger,
 	cfg altalune.Config,
@@ -32,6 +40,8 @@ func NewOTPService(
 	return &OTPService{
 		repo:         repo,
 		userRepo:     userRepo,
+		lockout:      lockout,
+		emailChecker: emailChecker,
 		notification: notificationSvc,
 		log:          log,
 		cfg:          cfg,
@@ -40,14 +50,28 @@ func NewOTPService(
 
 // GenerateAndSendOTP creates an OTP, stores its hash, and sends it via email.
 // Returns ErrEmailNotRegistered if the email is not in the system.
+// Returns ErrAccountLocked if the account is locked.
 // Returns ErrOTPRateLimited if too many OTPs have been requested recently.
+// Returns the emailcheck errors for addresses the checker rejects.
 func (s *OTPService) GenerateAndSendOTP(ctx context.Context, email string) error {
-	// 1. Check if email exists and get user info
+	// 1. Screen the address before anything is looked up or sent
+	if s.emailChecker != nil {
+		if err := s.emailChecker.Check(ctx, email); err != nil {
+			s.log.Info("OTP request for rejected email", "email", email, "reason", err)
+			return err
+		}
+	}
+
+	// Check if email exists and get user info
 	user, err := s.userRepo.GetUserByEmail(ctx, email)
 	if err != nil {
 		s.log.Debug("OTP request for unknown email", "email", email)
 		return ErrEmailNotRegistered
 	}
+	if err := s.lockout.Check(user); err != nil {
+		s.log.Info("OTP request for locked account", "email", email, "userID", user.ID)
+		return err
+	}
 
 	// 2. Check rate limit
 	rateLimitWindow := time.Duration(s.cfg.GetOTPRateLimitWindowMins()) * time.Minute
@@ -64,7 +88,7 @@ func (s *OTPService) GenerateAndSendOTP(ctx context.Context, email string) error
 	}
 
 	// 3. Generate 6-digit OTP
-	otp, err := generateOTP(6)
+	otp, err := generateOTP(otpLength)
 	if err != nil {
 		s.log.Error("failed to generate OTP", "error", err)
 		return fmt.Errorf("failed to generate OTP: %w", err)
@@ -94,14 +118,29 @@ func (s *OTPService) GenerateAndSendOTP(ctx context.Context, email string) error
 }
 
 // ValidateOTP checks if the provided OTP is valid for the email and marks it as used.
+// Every wrong code counts towards the account lockout; returns ErrAccountLocked
+// while the account is locked, even for a valid code.
 // Returns the user info on success for session creation.
 func (s *OTPService) ValidateOTP(ctx context.Context, email, otp string) (*UserInfo, error) {
+	user, err := s.userRepo.GetUserByEmail(ctx, email)
+	if err != nil {
+		s.log.Debug("OTP attempt for unknown email", "email", email)
+		return nil, ErrInvalidOTP
+	}
+	if err := s.lockout.Check(user); err != nil {
+		s.log.Info("OTP attempt for locked account", "email", email, "userID", user.ID)
+		return nil, err
+	}
+
 	otpHash := hashToken(otp)
 
 	// Get and validate OTP
 	otpToken, err := s.repo.GetValidOTP(ctx, email, otpHash)
 	if err != nil {
 		s.log.Debug("invalid OTP attempt", "email", email)
+		if err := s.lockout.RecordFailure(ctx, user); err != nil {
+			return nil, err
+		}
 		return nil, ErrInvalidOTP
 	}
 
@@ -111,12 +150,7 @@ func (s *OTPService) ValidateOTP(ctx context.Context, email, otp string) (*UserI
 		return nil, fmt.Errorf("failed to mark OTP as used: %w", err)
 	}
 
-	// Get user info for session creation
-	user, err := s.userRepo.GetUserByEmail(ctx, email)
-	if err != nil {
-		s.log.Error("user not found after OTP validation", "error", err, "email", email)
-		return nil, err
-	}
+	s.lockout.RecordSuccess(ctx, user)
 
 	s.log.Info("OTP validated successfully", "email", email, "userID", user.ID)
 	return user, nil
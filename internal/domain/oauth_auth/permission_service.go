@@ -2,6 +2,8 @@ package oauth_auth
 
 import (
 	"context"
+	"errors"
+	"fmt"
 )
 
 // PermissionService adapts the IAM mapper repository to the PermissionFetcher interface.
@@ -27,3 +29,51 @@ func (f *PermissionService) GetUserPermissions(ctx context.Context, userID int64
 	}
 	return names, nil
 }
+
+// GetUserRoleClaims fetches the role names of a user and the names of the
+// permissions assigned to it directly.
+func (f *PermissionService) GetUserRoleClaims(ctx context.Context, userID int64) ([]string, []string, error) {
+	userRoles, err := f.repo.GetUserRoles(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	direct, err := f.repo.GetDirectUserPermissions(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	roles := make([]string, 0, len(userRoles))
+	for _, r := range userRoles {
+		roles = append(roles, r.Name)
+	}
+	perms := make([]string, 0, len(direct))
+	for _, p := range direct {
+		perms = append(perms, p.Name)
+	}
+	return roles, perms, nil
+}
+
+// PermissionResolver loads the permissions of users by public ID, for the
+// resource server authorizing tokens that do not list them all.
+type PermissionResolver struct {
+	users UserLookupRepositor
+	perms UserPermissionProvider
+}
+
+// NewPermissionResolver creates a resolver looking users up in users
+func NewPermissionResolver(users UserLookupRepositor, perms UserPermissionProvider) *PermissionResolver {
+	return &PermissionResolver{users: users, perms: perms}
+}
+
+// ResolvePermissions returns the permission names of a user. Unknown users
+// have none.
+func (r *PermissionResolver) ResolvePermissions(ctx context.Context, userPublicID string) ([]string, error) {
+	user, err := r.users.GetUserByPublicID(ctx, userPublicID)
+	if err != nil {
+		if errors.Is(err, ErrUserNotFound) {
+			return []string{}, nil
+		}
+		return nil, fmt.Errorf("get user: %w", err)
+	}
+	return r.perms.GetUserPermissions(ctx, user.ID)
+}
@@ -0,0 +1,48 @@
+package oauth_auth
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// refreshTokenPrefix tells refresh tokens apart from other credentials
+	refreshTokenPrefix = "rt_"
+	// refreshTokenBytes is the entropy of a refresh token
+	refreshTokenBytes = 32
+)
+
+// newRefreshToken generates an opaque refresh token: the prefix followed by
+// 256 random bits, base64url encoded without padding.
+func newRefreshToken() (string, error) {
+	b := make([]byte, refreshTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate refresh token: %w", err)
+	}
+	return refreshTokenPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// parseRefreshToken returns the canonical form of a presented refresh token,
+// or false when it cannot be one.
+//
+// Refresh tokens used to be UUIDs. They are still accepted until the last of
+// them expires, after which the UUID branch can be removed.
+func parseRefreshToken(token string) (string, bool) {
+	if encoded, ok := strings.CutPrefix(token, refreshTokenPrefix); ok {
+		b, err := base64.RawURLEncoding.DecodeString(encoded)
+		if err != nil || len(b) != refreshTokenBytes {
+			return "", false
+		}
+		return token, true
+	}
+
+	legacy, err := uuid.Parse(token)
+	if err != nil {
+		return "", false
+	}
+	return legacy.String(), true
+}
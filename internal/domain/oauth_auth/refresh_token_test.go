@@ -0,0 +1,35 @@
+package oauth_auth
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRefreshToken(t *testing.T) {
+	token, err := newRefreshToken()
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(token, refreshTokenPrefix))
+	assert.Len(t, token, len(refreshTokenPrefix)+43, "256 bits in unpadded base64url")
+
+	other, err := newRefreshToken()
+	require.NoError(t, err)
+	assert.NotEqual(t, token, other)
+
+	parsed, ok := parseRefreshToken(token)
+	assert.True(t, ok)
+	assert.Equal(t, token, parsed)
+
+	legacy := uuid.New()
+	parsed, ok = parseRefreshToken(strings.ToUpper(legacy.String()))
+	assert.True(t, ok, "UUID refresh tokens issued before the rt_ format are still accepted")
+	assert.Equal(t, legacy.String(), parsed, "legacy tokens are looked up in their canonical form")
+
+	for _, invalid := range []string{"", "rt_", "rt_short", token + "A", "rt_" + strings.Repeat("!", 43), "eyJhbGciOiJSUzI1NiJ9.e30.sig"} {
+		_, ok := parseRefreshToken(invalid)
+		assert.False(t, ok, invalid)
+	}
+}
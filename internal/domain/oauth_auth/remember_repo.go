@@ -0,0 +1,157 @@
+package oauth_auth
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hrz8/altalune/internal/postgres"
+)
+
+// RememberTokenRepo implements RememberTokenRepositor for database operations.
+type RememberTokenRepo struct {
+	db postgres.DB
+}
+
+// NewRememberTokenRepo creates a new remember-me token repository.
+func NewRememberTokenRepo(db postgres.DB) *RememberTokenRepo {
+	return &RememberTokenRepo{db: db}
+}
+
+const rememberTokenColumns = `
+	id, series, user_id, token_hash, COALESCE(previous_token_hash, ''), rotated_at,
+	device_hash, user_agent, ip_address, expires_at, last_used_at, revoked_at, created_at
+`
+
+func scanRememberToken(row interface{ Scan(dest ...any) error }) (*RememberToken, error) {
+	var token RememberToken
+	err := row.Scan(
+		&token.ID, &token.Series, &token.UserID, &token.TokenHash, &token.PreviousTokenHash, &token.RotatedAt,
+		&token.DeviceHash, &token.UserAgent, &token.IPAddress, &token.ExpiresAt, &token.LastUsedAt, &token.RevokedAt, &token.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// CreateRememberToken stores a new remember-me token.
+func (r *RememberTokenRepo) CreateRememberToken(ctx context.Context, input *CreateRememberTokenInput) (*RememberToken, error) {
+	query := `
+		INSERT INTO altalune_remember_tokens (series, user_id, token_hash, device_hash, user_agent, ip_address, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING ` + rememberTokenColumns
+	token, err := scanRememberToken(r.db.QueryRowContext(ctx, query,
+		input.Series, input.UserID, input.TokenHash, input.DeviceHash, input.UserAgent, input.IPAddress, input.ExpiresAt,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("create remember token: %w", err)
+	}
+	return token, nil
+}
+
+// GetActiveRememberToken retrieves the unrevoked, unexpired token of a series.
+func (r *RememberTokenRepo) GetActiveRememberToken(ctx context.Context, series string) (*RememberToken, error) {
+	query := `SELECT ` + rememberTokenColumns + `
+		FROM altalune_remember_tokens
+		WHERE series = $1 AND revoked_at IS NULL AND expires_at > NOW()
+	`
+	token, err := scanRememberToken(r.db.QueryRowContext(ctx, query, series))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrRememberTokenNotFound
+		}
+		return nil, fmt.Errorf("get remember token: %w", err)
+	}
+	return token, nil
+}
+
+// RotateRememberToken replaces the token of a series, keeping the replaced one
+// as the previous token. The update only applies while the token is still
+// oldHash, so of two requests racing a rotation only one replaces it.
+func (r *RememberTokenRepo) RotateRememberToken(ctx context.Context, id int64, oldHash, newHash string) error {
+	query := `
+		UPDATE altalune_remember_tokens
+		SET token_hash = $3, previous_token_hash = token_hash, rotated_at = NOW(), last_used_at = NOW()
+		WHERE id = $1 AND token_hash = $2 AND revoked_at IS NULL AND expires_at > NOW()
+	`
+	result, err := r.db.ExecContext(ctx, query, id, oldHash, newHash)
+	if err != nil {
+		return fmt.Errorf("rotate remember token: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrRememberTokenNotFound
+	}
+	return nil
+}
+
+// ListUserRememberTokens returns the active tokens of a user, newest first.
+func (r *RememberTokenRepo) ListUserRememberTokens(ctx context.Context, userID int64) ([]*RememberToken, error) {
+	query := `SELECT ` + rememberTokenColumns + `
+		FROM altalune_remember_tokens
+		WHERE user_id = $1 AND revoked_at IS NULL AND expires_at > NOW()
+		ORDER BY created_at DESC, id DESC
+	`
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list remember tokens: %w", err)
+	}
+	defer rows.Close()
+
+	tokens := make([]*RememberToken, 0)
+	for rows.Next() {
+		token, err := scanRememberToken(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan remember token: %w", err)
+		}
+		tokens = append(tokens, token)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	return tokens, nil
+}
+
+// RevokeRememberToken revokes the active token of a series of the user.
+func (r *RememberTokenRepo) RevokeRememberToken(ctx context.Context, userID int64, series string) error {
+	query := `
+		UPDATE altalune_remember_tokens SET revoked_at = NOW()
+		WHERE user_id = $1 AND series = $2 AND revoked_at IS NULL AND expires_at > NOW()
+	`
+	result, err := r.db.ExecContext(ctx, query, userID, series)
+	if err != nil {
+		return fmt.Errorf("revoke remember token: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrRememberTokenNotFound
+	}
+	return nil
+}
+
+// PurgeStale permanently removes tokens that were revoked or expired before
+// the given time, returning how many were removed.
+func (r *RememberTokenRepo) PurgeStale(ctx context.Context, before time.Time) (int64, error) {
+	query := `
+		DELETE FROM altalune_remember_tokens
+		WHERE revoked_at < $1 OR expires_at < $1
+	`
+	result, err := r.db.ExecContext(ctx, query, before)
+	if err != nil {
+		return 0, fmt.Errorf("purge stale remember tokens: %w", err)
+	}
+	purged, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("get rows affected: %w", err)
+	}
+	return purged, nil
+}
@@ -0,0 +1,140 @@
+package oauth_auth
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// InMemRememberTokenRepo is an in-memory RememberTokenRepositor for tests
+type InMemRememberTokenRepo struct {
+	mu     sync.RWMutex
+	tokens []*RememberToken
+	lastID int64
+}
+
+var _ RememberTokenRepositor = (*InMemRememberTokenRepo)(nil)
+
+// NewInMemRememberTokenRepo creates an empty in-memory remember-me token repository
+func NewInMemRememberTokenRepo() *InMemRememberTokenRepo {
+	return &InMemRememberTokenRepo{}
+}
+
+func (r *InMemRememberTokenRepo) CreateRememberToken(ctx context.Context, input *CreateRememberTokenInput) (*RememberToken, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// series is a unique column
+	for _, token := range r.tokens {
+		if token.Series == input.Series {
+			return nil, fmt.Errorf("create remember token: duplicate series")
+		}
+	}
+
+	now := time.Now()
+	r.lastID++
+	token := &RememberToken{
+		ID:         r.lastID,
+		Series:     input.Series,
+		UserID:     input.UserID,
+		TokenHash:  input.TokenHash,
+		DeviceHash: input.DeviceHash,
+		UserAgent:  input.UserAgent,
+		IPAddress:  input.IPAddress,
+		ExpiresAt:  input.ExpiresAt,
+		LastUsedAt: now,
+		CreatedAt:  now,
+	}
+	r.tokens = append(r.tokens, token)
+	created := *token
+	return &created, nil
+}
+
+func (r *InMemRememberTokenRepo) GetActiveRememberToken(ctx context.Context, series string) (*RememberToken, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	now := time.Now()
+	for _, token := range r.tokens {
+		if token.Series == series && token.active(now) {
+			found := *token
+			return &found, nil
+		}
+	}
+	return nil, ErrRememberTokenNotFound
+}
+
+func (r *InMemRememberTokenRepo) RotateRememberToken(ctx context.Context, id int64, oldHash, newHash string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	for _, token := range r.tokens {
+		if token.ID == id && token.TokenHash == oldHash && token.active(now) {
+			token.PreviousTokenHash = token.TokenHash
+			token.TokenHash = newHash
+			token.RotatedAt = &now
+			token.LastUsedAt = now
+			return nil
+		}
+	}
+	return ErrRememberTokenNotFound
+}
+
+func (r *InMemRememberTokenRepo) ListUserRememberTokens(ctx context.Context, userID int64) ([]*RememberToken, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	now := time.Now()
+	tokens := make([]*RememberToken, 0)
+	for _, token := range r.tokens {
+		if token.UserID == userID && token.active(now) {
+			found := *token
+			tokens = append(tokens, &found)
+		}
+	}
+	sort.SliceStable(tokens, func(i, j int) bool {
+		if !tokens[i].CreatedAt.Equal(tokens[j].CreatedAt) {
+			return tokens[i].CreatedAt.After(tokens[j].CreatedAt)
+		}
+		return tokens[i].ID > tokens[j].ID
+	})
+	return tokens, nil
+}
+
+func (r *InMemRememberTokenRepo) RevokeRememberToken(ctx context.Context, userID int64, series string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	for _, token := range r.tokens {
+		if token.UserID == userID && token.Series == series && token.active(now) {
+			token.RevokedAt = &now
+			return nil
+		}
+	}
+	return ErrRememberTokenNotFound
+}
+
+func (r *InMemRememberTokenRepo) PurgeStale(ctx context.Context, before time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	kept := r.tokens[:0]
+	for _, token := range r.tokens {
+		if (token.RevokedAt != nil && token.RevokedAt.Before(before)) || token.ExpiresAt.Before(before) {
+			continue
+		}
+		kept = append(kept, token)
+	}
+	purged := int64(len(r.tokens) - len(kept))
+	r.tokens = kept
+	return purged, nil
+}
+
+// active reports whether the token can still restore a session
+func (t *RememberToken) active(now time.Time) bool {
+	return t.RevokedAt == nil && t.ExpiresAt.After(now)
+}
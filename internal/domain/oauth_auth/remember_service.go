@@ -0,0 +1,221 @@
+package oauth_auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hrz8/altalune"
+)
+
+// rememberRotationGrace is how long the token a rotation replaced still
+// restores the session, so that the requests a browser sent with it before
+// receiving the new cookie are not taken for a stolen cookie.
+const rememberRotationGrace = time.Minute
+
+// maxRememberUserAgent is the length of the User-Agent kept for display
+const maxRememberUserAgent = 512
+
+// RememberDevice identifies the browser a remember-me token is issued to or
+// presented by.
+type RememberDevice struct {
+	UserAgent string
+	IPAddress string
+}
+
+// fingerprint is the hash the token of the device is bound to. Addresses
+// change as devices roam, so only the User-Agent is part of it.
+func (d RememberDevice) fingerprint() string {
+	return hashToken(d.UserAgent)
+}
+
+// RememberMeService issues the remember-me cookies of "keep me signed in" and
+// restores sessions from them. A cookie holds the series of the device and a
+// token replaced at every use: presenting a replaced token means the cookie
+// was copied, and revokes the series.
+type RememberMeService struct {
+	repo     RememberTokenRepositor
+	userRepo UserLookupRepositor
+	lifetime time.Duration
+	log      altalune.Logger
+}
+
+// NewRememberMeService creates a new remember-me service.
+func NewRememberMeService(
+	repo RememberTokenRepositor,
+	userRepo UserLookupRepositor,
+	cfg altalune.Config,
+	log altalune.Logger,
+) *RememberMeService {
+	return &RememberMeService{
+		repo:     repo,
+		userRepo: userRepo,
+		lifetime: time.Duration(cfg.GetRememberMeLifetime()) * time.Second,
+		log:      log,
+	}
+}
+
+// Lifetime returns how long a device stays signed in
+func (s *RememberMeService) Lifetime() time.Duration {
+	return s.lifetime
+}
+
+// Issue creates a remember-me token for the device a user signed in on,
+// returning the cookie value.
+func (s *RememberMeService) Issue(ctx context.Context, userID int64, device RememberDevice) (string, error) {
+	series, err := generateSecureToken(18)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate series: %w", err)
+	}
+	token, err := generateSecureToken(32)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	userAgent := device.UserAgent
+	if len(userAgent) > maxRememberUserAgent {
+		userAgent = userAgent[:maxRememberUserAgent]
+	}
+
+	_, err = s.repo.CreateRememberToken(ctx, &CreateRememberTokenInput{
+		Series:     series,
+		UserID:     userID,
+		TokenHash:  hashToken(token),
+		DeviceHash: device.fingerprint(),
+		UserAgent:  userAgent,
+		IPAddress:  device.IPAddress,
+		ExpiresAt:  time.Now().Add(s.lifetime),
+	})
+	if err != nil {
+		s.log.Error("failed to store remember token", "error", err, "userID", userID)
+		return "", fmt.Errorf("failed to store remember token: %w", err)
+	}
+
+	s.log.Info("remember-me token issued", "userID", userID, "series", series)
+	return series + "." + token, nil
+}
+
+// Restore signs the device back in with its cookie value. It returns the user
+// and the cookie value replacing the presented one, empty when a concurrent
+// request already replaced it. Invalid, revoked, expired and copied cookies
+// return ErrInvalidRememberToken, as do locked or deleted accounts.
+func (s *RememberMeService) Restore(ctx context.Context, value string, device RememberDevice) (*UserInfo, string, error) {
+	// 1. Parse the cookie
+	series, token, ok := strings.Cut(value, ".")
+	if !ok || series == "" || token == "" {
+		return nil, "", ErrInvalidRememberToken
+	}
+
+	// 2. Find the series
+	remembered, err := s.repo.GetActiveRememberToken(ctx, series)
+	if err != nil {
+		if errors.Is(err, ErrRememberTokenNotFound) {
+			return nil, "", ErrInvalidRememberToken
+		}
+		s.log.Error("failed to get remember token", "error", err)
+		return nil, "", fmt.Errorf("failed to get remember token: %w", err)
+	}
+
+	// 3. A cookie presented by another browser was copied off the device
+	if subtle.ConstantTimeCompare([]byte(remembered.DeviceHash), []byte(device.fingerprint())) != 1 {
+		s.log.Warn("remember-me token presented by another device, revoking it",
+			"userID", remembered.UserID, "series", series, "ip", device.IPAddress)
+		s.revoke(ctx, remembered)
+		return nil, "", ErrInvalidRememberToken
+	}
+
+	// 4. Check the token, replacing the current one
+	tokenHash := hashToken(token)
+	var newValue string
+	switch {
+	case subtle.ConstantTimeCompare([]byte(remembered.TokenHash), []byte(tokenHash)) == 1:
+		newToken, err := generateSecureToken(32)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to generate token: %w", err)
+		}
+		err = s.repo.RotateRememberToken(ctx, remembered.ID, tokenHash, hashToken(newToken))
+		if err == nil {
+			newValue = series + "." + newToken
+		} else if !errors.Is(err, ErrRememberTokenNotFound) {
+			s.log.Error("failed to rotate remember token", "error", err, "series", series)
+			return nil, "", fmt.Errorf("failed to rotate remember token: %w", err)
+		}
+		// A concurrent request rotated it first, whose response carries the new cookie
+	case s.inRotationGrace(remembered, tokenHash):
+		// Sent before the browser received the cookie of the last rotation
+	default:
+		s.log.Warn("replaced remember-me token presented, revoking the series",
+			"userID", remembered.UserID, "series", series, "ip", device.IPAddress)
+		s.revoke(ctx, remembered)
+		return nil, "", ErrInvalidRememberToken
+	}
+
+	// 5. The account must still be able to sign in
+	user, err := s.userRepo.GetUserByID(ctx, remembered.UserID)
+	if err != nil {
+		if errors.Is(err, ErrUserNotFound) {
+			s.revoke(ctx, remembered)
+			return nil, "", ErrInvalidRememberToken
+		}
+		s.log.Error("failed to get remembered user", "error", err, "userID", remembered.UserID)
+		return nil, "", fmt.Errorf("failed to get user: %w", err)
+	}
+	if user.IsLocked(time.Now()) {
+		s.log.Info("rejected remembered sign-in of locked user", "userID", user.ID)
+		return nil, "", ErrInvalidRememberToken
+	}
+
+	s.log.Info("session restored from remember-me token", "userID", user.ID, "series", series)
+	return user, newValue, nil
+}
+
+// inRotationGrace reports whether tokenHash is the token the last rotation
+// replaced, within rememberRotationGrace of it
+func (s *RememberMeService) inRotationGrace(remembered *RememberToken, tokenHash string) bool {
+	return remembered.RotatedAt != nil &&
+		time.Since(*remembered.RotatedAt) < rememberRotationGrace &&
+		subtle.ConstantTimeCompare([]byte(remembered.PreviousTokenHash), []byte(tokenHash)) == 1
+}
+
+func (s *RememberMeService) revoke(ctx context.Context, remembered *RememberToken) {
+	if err := s.repo.RevokeRememberToken(ctx, remembered.UserID, remembered.Series); err != nil && !errors.Is(err, ErrRememberTokenNotFound) {
+		s.log.Error("failed to revoke remember token", "error", err, "series", remembered.Series)
+	}
+}
+
+// ListDevices returns the devices a user stays signed in on, newest first.
+func (s *RememberMeService) ListDevices(ctx context.Context, userID int64) ([]*RememberToken, error) {
+	tokens, err := s.repo.ListUserRememberTokens(ctx, userID)
+	if err != nil {
+		s.log.Error("failed to list remember tokens", "error", err, "userID", userID)
+		return nil, fmt.Errorf("failed to list remember tokens: %w", err)
+	}
+	return tokens, nil
+}
+
+// RevokeDevice signs a device of the user out, returning
+// ErrRememberTokenNotFound for series the user does not stay signed in on.
+func (s *RememberMeService) RevokeDevice(ctx context.Context, userID int64, series string) error {
+	if err := s.repo.RevokeRememberToken(ctx, userID, series); err != nil {
+		if errors.Is(err, ErrRememberTokenNotFound) {
+			return ErrRememberTokenNotFound
+		}
+		s.log.Error("failed to revoke remember token", "error", err, "userID", userID)
+		return fmt.Errorf("failed to revoke remember token: %w", err)
+	}
+
+	s.log.Info("remember-me token revoked", "userID", userID, "series", series)
+	return nil
+}
+
+// RememberSeries returns the series of a cookie value, empty when malformed
+func RememberSeries(value string) string {
+	series, _, ok := strings.Cut(value, ".")
+	if !ok {
+		return ""
+	}
+	return series
+}
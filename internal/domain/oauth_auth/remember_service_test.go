@@ -0,0 +1,129 @@
+package oauth_auth
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/hrz8/altalune/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRememberMeService(t *testing.T) (*RememberMeService, *InMemRememberTokenRepo, *InMemUserRepo) {
+	t.Helper()
+	repo := NewInMemRememberTokenRepo()
+	users := NewInMemUserRepo()
+	users.PutUser(&UserInfo{ID: 1, Email: "user@example.com", IsActive: true})
+	return &RememberMeService{
+		repo:     repo,
+		userRepo: users,
+		lifetime: time.Hour,
+		log:      logger.NewWithOptions(logger.Options{Level: "error", Output: io.Discard}),
+	}, repo, users
+}
+
+func TestRememberMeRotation(t *testing.T) {
+	ctx := context.Background()
+	svc, repo, _ := newTestRememberMeService(t)
+	browser := RememberDevice{UserAgent: "Mozilla/5.0 (X11; Linux x86_64)", IPAddress: "203.0.113.7"}
+
+	value, err := svc.Issue(ctx, 1, browser)
+	require.NoError(t, err)
+
+	user, rotated, err := svc.Restore(ctx, value, browser)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), user.ID)
+	require.NotEmpty(t, rotated)
+	assert.NotEqual(t, value, rotated)
+	assert.Equal(t, RememberSeries(value), RememberSeries(rotated), "the series identifies the device across rotations")
+
+	// A request sent before the browser received the new cookie still gets in
+	_, again, err := svc.Restore(ctx, value, browser)
+	require.NoError(t, err)
+	assert.Empty(t, again, "the grace does not rotate the token again")
+
+	// Past the grace, the replaced token reveals a copied cookie
+	past := time.Now().Add(-2 * rememberRotationGrace)
+	repo.tokens[0].RotatedAt = &past
+
+	_, _, err = svc.Restore(ctx, value, browser)
+	assert.ErrorIs(t, err, ErrInvalidRememberToken)
+	_, _, err = svc.Restore(ctx, rotated, browser)
+	assert.ErrorIs(t, err, ErrInvalidRememberToken, "the whole series is revoked")
+}
+
+func TestRememberMeRejects(t *testing.T) {
+	ctx := context.Background()
+	browser := RememberDevice{UserAgent: "Mozilla/5.0 (X11; Linux x86_64)"}
+
+	t.Run("malformed cookie", func(t *testing.T) {
+		svc, _, _ := newTestRememberMeService(t)
+		for _, value := range []string{"", "series", ".token", "series.", "unknown.token"} {
+			_, _, err := svc.Restore(ctx, value, browser)
+			assert.ErrorIs(t, err, ErrInvalidRememberToken, value)
+		}
+	})
+
+	t.Run("another device", func(t *testing.T) {
+		svc, _, _ := newTestRememberMeService(t)
+		value, err := svc.Issue(ctx, 1, browser)
+		require.NoError(t, err)
+
+		_, _, err = svc.Restore(ctx, value, RememberDevice{UserAgent: "curl/8.5.0"})
+		assert.ErrorIs(t, err, ErrInvalidRememberToken)
+		_, _, err = svc.Restore(ctx, value, browser)
+		assert.ErrorIs(t, err, ErrInvalidRememberToken, "a copied cookie revokes the device")
+	})
+
+	t.Run("locked user", func(t *testing.T) {
+		svc, _, users := newTestRememberMeService(t)
+		value, err := svc.Issue(ctx, 1, browser)
+		require.NoError(t, err)
+
+		lockedUntil := time.Now().Add(time.Minute)
+		users.PutUser(&UserInfo{ID: 1, Email: "user@example.com", IsActive: true, LockedUntil: &lockedUntil})
+		_, _, err = svc.Restore(ctx, value, browser)
+		assert.ErrorIs(t, err, ErrInvalidRememberToken)
+	})
+
+	t.Run("expired token", func(t *testing.T) {
+		svc, repo, _ := newTestRememberMeService(t)
+		value, err := svc.Issue(ctx, 1, browser)
+		require.NoError(t, err)
+
+		repo.tokens[0].ExpiresAt = time.Now().Add(-time.Second)
+		_, _, err = svc.Restore(ctx, value, browser)
+		assert.ErrorIs(t, err, ErrInvalidRememberToken)
+	})
+}
+
+func TestRememberMeDevices(t *testing.T) {
+	ctx := context.Background()
+	svc, _, _ := newTestRememberMeService(t)
+	laptop := RememberDevice{UserAgent: "Mozilla/5.0 (X11; Linux x86_64)"}
+	phone := RememberDevice{UserAgent: "Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X)"}
+
+	laptopValue, err := svc.Issue(ctx, 1, laptop)
+	require.NoError(t, err)
+	phoneValue, err := svc.Issue(ctx, 1, phone)
+	require.NoError(t, err)
+
+	devices, err := svc.ListDevices(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, devices, 2)
+	assert.Equal(t, RememberSeries(phoneValue), devices[0].Series, "newest first")
+
+	assert.ErrorIs(t, svc.RevokeDevice(ctx, 2, RememberSeries(laptopValue)), ErrRememberTokenNotFound, "only the owner revokes a device")
+	require.NoError(t, svc.RevokeDevice(ctx, 1, RememberSeries(laptopValue)))
+
+	_, _, err = svc.Restore(ctx, laptopValue, laptop)
+	assert.ErrorIs(t, err, ErrInvalidRememberToken)
+	_, _, err = svc.Restore(ctx, phoneValue, phone)
+	assert.NoError(t, err)
+
+	devices, err = svc.ListDevices(ctx, 1)
+	require.NoError(t, err)
+	assert.Len(t, devices, 1)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/hrz8/altalune/internal/postgres"
@@ -20,12 +21,13 @@ func NewRepo(db postgres.DB) Repositor {
 }
 
 // CreateAuthorizationCode stores a new authorization code in the database.
+// Only the hash of the code is stored, the returned code is the only copy.
 func (r *repo) CreateAuthorizationCode(ctx context.Context, input *CreateAuthCodeInput) (*AuthorizationCode, error) {
 	code := uuid.New()
 
 	query := `
 		INSERT INTO altalune_oauth_authorization_codes (
-			code, client_id, user_id, redirect_uri, scope,
+			code_hash, client_id, user_id, redirect_uri, scope,
 			nonce, code_challenge, code_challenge_method, expires_at
 		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 		RETURNING id, created_at
@@ -35,7 +37,7 @@ func (r *repo) CreateAuthorizationCode(ctx context.Context, input *CreateAuthCod
 	var createdAt sql.NullTime
 
 	err := r.db.QueryRowContext(ctx, query,
-		code,
+		hashToken(code.String()),
 		input.ClientID,
 		input.UserID,
 		input.RedirectURI,
@@ -68,22 +70,21 @@ func (r *repo) CreateAuthorizationCode(ctx context.Context, input *CreateAuthCod
 // GetAuthorizationCodeByCode retrieves a valid, unexpired, unused authorization code.
 func (r *repo) GetAuthorizationCodeByCode(ctx context.Context, code uuid.UUID) (*AuthorizationCode, error) {
 	query := `
-		SELECT id, code, client_id, user_id, redirect_uri, scope,
+		SELECT id, client_id, user_id, redirect_uri, scope,
 		       nonce, code_challenge, code_challenge_method,
 		       expires_at, exchange_at, created_at
 		FROM altalune_oauth_authorization_codes
-		WHERE code = $1
+		WHERE code_hash = $1
 		  AND exchange_at IS NULL
 		  AND expires_at > NOW()
 	`
 
-	var ac AuthorizationCode
+	ac := AuthorizationCode{Code: code}
 	var nonce, codeChallenge, codeChallengeMethod sql.NullString
 	var exchangeAt sql.NullTime
 
-	err := r.db.QueryRowContext(ctx, query, code).Scan(
+	err := r.db.QueryRowContext(ctx, query, hashToken(code.String())).Scan(
 		&ac.ID,
-		&ac.Code,
 		&ac.ClientID,
 		&ac.UserID,
 		&ac.RedirectURI,
@@ -124,10 +125,10 @@ func (r *repo) MarkCodeExchanged(ctx context.Context, code uuid.UUID) error {
 	query := `
 		UPDATE altalune_oauth_authorization_codes
 		SET exchange_at = NOW(), updated_at = NOW()
-		WHERE code = $1 AND exchange_at IS NULL
+		WHERE code_hash = $1 AND exchange_at IS NULL
 	`
 
-	result, err := r.db.ExecContext(ctx, query, code)
+	result, err := r.db.ExecContext(ctx, query, hashToken(code.String()))
 	if err != nil {
 		return fmt.Errorf("mark code exchanged: %w", err)
 	}
@@ -144,12 +145,16 @@ func (r *repo) MarkCodeExchanged(ctx context.Context, code uuid.UUID) error {
 }
 
 // CreateRefreshToken stores a new refresh token in the database.
+// Only the hash of the token is stored, the returned token is the only copy.
 func (r *repo) CreateRefreshToken(ctx context.Context, input *CreateRefreshTokenInput) (*RefreshToken, error) {
-	token := uuid.New()
+	token, err := newRefreshToken()
+	if err != nil {
+		return nil, err
+	}
 
 	query := `
 		INSERT INTO altalune_oauth_refresh_tokens (
-			token, client_id, user_id, scope, nonce, expires_at
+			token_hash, client_id, user_id, scope, nonce, expires_at
 		) VALUES ($1, $2, $3, $4, $5, $6)
 		RETURNING id, created_at
 	`
@@ -157,8 +162,8 @@ func (r *repo) CreateRefreshToken(ctx context.Context, input *CreateRefreshToken
 	var id int64
 	var createdAt sql.NullTime
 
-	err := r.db.QueryRowContext(ctx, query,
-		token,
+	err = r.db.QueryRowContext(ctx, query,
+		hashToken(token),
 		input.ClientID,
 		input.UserID,
 		input.Scope,
@@ -183,23 +188,22 @@ func (r *repo) CreateRefreshToken(ctx context.Context, input *CreateRefreshToken
 }
 
 // GetRefreshTokenByToken retrieves a valid, unexpired, unused refresh token.
-func (r *repo) GetRefreshTokenByToken(ctx context.Context, token uuid.UUID) (*RefreshToken, error) {
+func (r *repo) GetRefreshTokenByToken(ctx context.Context, token string) (*RefreshToken, error) {
 	query := `
-		SELECT id, token, client_id, user_id, scope, nonce,
+		SELECT id, client_id, user_id, scope, nonce,
 		       expires_at, exchange_at, created_at
 		FROM altalune_oauth_refresh_tokens
-		WHERE token = $1
+		WHERE token_hash = $1
 		  AND exchange_at IS NULL
 		  AND expires_at > NOW()
 	`
 
-	var rt RefreshToken
+	rt := RefreshToken{Token: token}
 	var nonce sql.NullString
 	var exchangeAt sql.NullTime
 
-	err := r.db.QueryRowContext(ctx, query, token).Scan(
+	err := r.db.QueryRowContext(ctx, query, hashToken(token)).Scan(
 		&rt.ID,
-		&rt.Token,
 		&rt.ClientID,
 		&rt.UserID,
 		&rt.Scope,
@@ -227,14 +231,14 @@ func (r *repo) GetRefreshTokenByToken(ctx context.Context, token uuid.UUID) (*Re
 }
 
 // MarkRefreshTokenExchanged marks a refresh token as used by setting exchange_at.
-func (r *repo) MarkRefreshTokenExchanged(ctx context.Context, token uuid.UUID) error {
+func (r *repo) MarkRefreshTokenExchanged(ctx context.Context, token string) error {
 	query := `
 		UPDATE altalune_oauth_refresh_tokens
 		SET exchange_at = NOW(), updated_at = NOW()
-		WHERE token = $1 AND exchange_at IS NULL
+		WHERE token_hash = $1 AND exchange_at IS NULL
 	`
 
-	result, err := r.db.ExecContext(ctx, query, token)
+	result, err := r.db.ExecContext(ctx, query, hashToken(token))
 	if err != nil {
 		return fmt.Errorf("mark refresh token exchanged: %w", err)
 	}
@@ -250,6 +254,28 @@ func (r *repo) MarkRefreshTokenExchanged(ctx context.Context, token uuid.UUID) e
 	return nil
 }
 
+// RevokeUserRefreshTokens marks every usable refresh token of a user as
+// revoked and returns how many were revoked.
+func (r *repo) RevokeUserRefreshTokens(ctx context.Context, userID int64) (int64, error) {
+	query := `
+		UPDATE altalune_oauth_refresh_tokens
+		SET exchange_at = NOW(), revoked_at = NOW(), updated_at = NOW()
+		WHERE user_id = $1 AND exchange_at IS NULL AND expires_at > NOW()
+	`
+
+	result, err := r.db.ExecContext(ctx, query, userID)
+	if err != nil {
+		return 0, fmt.Errorf("revoke user refresh tokens: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("get rows affected: %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
 // GetUserConsent retrieves a user's consent record for a specific client.
 func (r *repo) GetUserConsent(ctx context.Context, userID int64, clientID uuid.UUID) (*UserConsent, error) {
 	query := `
@@ -336,7 +362,7 @@ func (r *repo) GetUserConsents(ctx context.Context, userID int64) ([]*UserConsen
 			uc.revoked_at,
 			uc.created_at
 		FROM altalune_oauth_user_consents uc
-		INNER JOIN altalune_oauth_clients c ON uc.client_id = c.client_id
+		INNER JOIN altalune_oauth_clients c ON uc.client_id = c.client_id AND c.deleted_at IS NULL
 		WHERE uc.user_id = $1 AND uc.revoked_at IS NULL
 		ORDER BY uc.granted_at DESC
 	`
@@ -408,9 +434,10 @@ func (r *repo) RevokeUserConsent(ctx context.Context, userID int64, clientID uui
 func (r *repo) GetOAuthClientByClientID(ctx context.Context, clientID uuid.UUID) (*OAuthClientInfo, error) {
 	query := `
 		SELECT id, client_id, name, client_secret_hash,
-		       redirect_uris, pkce_required, is_default, confidential
+		       redirect_uris, pkce_required, is_default, confidential,
+		       perms_claim, perms_claim_max_bytes, client_secret_expires_at
 		FROM altalune_oauth_clients
-		WHERE client_id = $1
+		WHERE client_id = $1 AND deleted_at IS NULL
 	`
 
 	var oc OAuthClientInfo
@@ -426,6 +453,9 @@ func (r *repo) GetOAuthClientByClientID(ctx context.Context, clientID uuid.UUID)
 		&oc.PKCERequired,
 		&oc.IsDefault,
 		&oc.Confidential,
+		&oc.PermsClaim,
+		&oc.PermsMaxBytes,
+		&oc.SecretExpiresAt,
 	)
 
 	if err != nil {
@@ -442,3 +472,36 @@ func (r *repo) GetOAuthClientByClientID(ctx context.Context, clientID uuid.UUID)
 
 	return &oc, nil
 }
+
+// UpdateOAuthClientSecretHash replaces the secret hash of an OAuth client with
+// one of the same secret, unless the secret was changed since oldHash was read.
+func (r *repo) UpdateOAuthClientSecretHash(ctx context.Context, id int64, oldHash, newHash string) error {
+	query := `
+		UPDATE altalune_oauth_clients
+		SET client_secret_hash = $1
+		WHERE id = $2 AND client_secret_hash = $3
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, newHash, id, oldHash); err != nil {
+		return fmt.Errorf("update oauth client secret hash: %w", err)
+	}
+
+	return nil
+}
+
+// IncrementTokenStats adds to the hourly token request counts of a client,
+// creating the row of the hour on its first request.
+func (r *repo) IncrementTokenStats(ctx context.Context, clientID uuid.UUID, hour time.Time, issued, failed int64) error {
+	query := `
+		INSERT INTO altalune_oauth_client_token_stats (client_id, hour, issued, failed)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (client_id, hour) DO UPDATE
+		SET issued = altalune_oauth_client_token_stats.issued + EXCLUDED.issued,
+		    failed = altalune_oauth_client_token_stats.failed + EXCLUDED.failed
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, clientID, hour, issued, failed); err != nil {
+		return fmt.Errorf("increment token stats: %w", err)
+	}
+	return nil
+}
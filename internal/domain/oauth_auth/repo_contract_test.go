@@ -0,0 +1,478 @@
+package oauth_auth_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hrz8/altalune/internal/domain/oauth_auth"
+	"github.com/hrz8/altalune/internal/domain/oauth_client"
+	"github.com/hrz8/altalune/internal/domain/user"
+	"github.com/hrz8/altalune/internal/shared/password"
+	"github.com/hrz8/altalune/internal/testdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fixtures create the users and OAuth clients the repositories reference
+type fixtures struct {
+	newUser   func(t *testing.T) *oauth_auth.UserInfo
+	newClient func(t *testing.T, name string) uuid.UUID // Confidential client
+}
+
+func TestMain(m *testing.M) { testdb.Main(m) }
+
+func TestInMemRepo(t *testing.T) {
+	repo := oauth_auth.NewInMemRepo()
+	users := oauth_auth.NewInMemUserRepo()
+
+	var lastID int64
+	f := fixtures{
+		newUser: func(t *testing.T) *oauth_auth.UserInfo {
+			lastID++
+			u := &oauth_auth.UserInfo{ID: lastID, PublicID: testdb.Token(t), Email: testdb.Token(t) + "@example.com", IsActive: true}
+			users.PutUser(u)
+			return u
+		},
+		newClient: func(t *testing.T, name string) uuid.UUID {
+			lastID++
+			hash := "hash-" + testdb.Token(t)
+			client := &oauth_auth.OAuthClientInfo{
+				ID:           lastID,
+				ClientID:     uuid.New(),
+				Name:         name,
+				RedirectURIs: []string{"https://example.com/callback"},
+				PKCERequired: true,
+				SecretHash:   &hash,
+				Confidential: true,
+			}
+			repo.PutOAuthClient(client)
+			return client.ClientID
+		},
+	}
+
+	t.Run("repo", func(t *testing.T) { testRepoContract(t, repo, f) })
+	t.Run("otp repo", func(t *testing.T) { testOTPRepoContract(t, oauth_auth.NewInMemOTPRepo()) })
+	t.Run("email verification repo", func(t *testing.T) {
+		testEmailVerificationRepoContract(t, oauth_auth.NewInMemEmailVerificationRepo(), f)
+	})
+	t.Run("remember token repo", func(t *testing.T) {
+		testRememberTokenRepoContract(t, oauth_auth.NewInMemRememberTokenRepo(), f)
+	})
+	t.Run("user repo", func(t *testing.T) { testUserRepoContract(t, users, f) })
+}
+
+func TestRepo(t *testing.T) {
+	db := testdb.Open(t)
+	users := user.NewRepo(db)
+	clients := oauth_client.NewRepo(db, password.HashOption{Iterations: 1, Memory: 1024, Threads: 1, Len: 32})
+
+	f := fixtures{
+		newUser: func(t *testing.T) *oauth_auth.UserInfo {
+			created, err := users.Create(context.Background(), &user.CreateUserInput{Email: testdb.Token(t) + "@example.com"})
+			require.NoError(t, err)
+			return &oauth_auth.UserInfo{
+				ID:            created.ID,
+				PublicID:      created.PublicID,
+				Email:         created.Email,
+				FirstName:     created.FirstName,
+				LastName:      created.LastName,
+				IsActive:      created.IsActive,
+				EmailVerified: created.EmailVerified,
+			}
+		},
+		newClient: func(t *testing.T, name string) uuid.UUID {
+			created, err := clients.Create(context.Background(), &oauth_client.CreateOAuthClientInput{
+				Name:         name,
+				RedirectURIs: []string{"https://example.com/callback"},
+				PKCERequired: true,
+				Confidential: true,
+			})
+			require.NoError(t, err)
+			return created.Client.ClientID
+		},
+	}
+
+	t.Run("repo", func(t *testing.T) { testRepoContract(t, oauth_auth.NewRepo(db), f) })
+	t.Run("stores hashes only", func(t *testing.T) {
+		ctx := context.Background()
+		repo := oauth_auth.NewRepo(db)
+		userID, clientID := f.newUser(t).ID, f.newClient(t, "Hashes "+testdb.Token(t))
+
+		code, err := repo.CreateAuthorizationCode(ctx, &oauth_auth.CreateAuthCodeInput{
+			ClientID:    clientID,
+			UserID:      userID,
+			RedirectURI: "https://example.com/callback",
+			ExpiresAt:   time.Now().Add(time.Minute),
+		})
+		require.NoError(t, err)
+		refresh, err := repo.CreateRefreshToken(ctx, &oauth_auth.CreateRefreshTokenInput{
+			ClientID:  clientID,
+			UserID:    userID,
+			Scope:     "openid",
+			ExpiresAt: time.Now().Add(time.Hour),
+		})
+		require.NoError(t, err)
+
+		var codeHash, tokenHash string
+		require.NoError(t, db.QueryRowContext(ctx,
+			"SELECT code_hash FROM altalune_oauth_authorization_codes WHERE id = $1", code.ID).Scan(&codeHash))
+		require.NoError(t, db.QueryRowContext(ctx,
+			"SELECT token_hash FROM altalune_oauth_refresh_tokens WHERE id = $1", refresh.ID).Scan(&tokenHash))
+		assert.Equal(t, sha256Hex(code.Code.String()), codeHash)
+		assert.Equal(t, sha256Hex(refresh.Token), tokenHash)
+	})
+	t.Run("otp repo", func(t *testing.T) { testOTPRepoContract(t, oauth_auth.NewOTPRepo(db)) })
+	t.Run("email verification repo", func(t *testing.T) {
+		testEmailVerificationRepoContract(t, oauth_auth.NewEmailVerificationRepo(db), f)
+	})
+	t.Run("remember token repo", func(t *testing.T) {
+		testRememberTokenRepoContract(t, oauth_auth.NewRememberTokenRepo(db), f)
+	})
+	t.Run("user repo", func(t *testing.T) { testUserRepoContract(t, oauth_auth.NewUserRepo(db), f) })
+}
+
+func sha256Hex(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+// testRepoContract runs the behavior every oauth_auth.Repositor must share
+// against repo
+func testRepoContract(t *testing.T, repo oauth_auth.Repositor, f fixtures) {
+	ctx := context.Background()
+
+	t.Run("authorization codes", func(t *testing.T) {
+		userID := f.newUser(t).ID
+		clientID := f.newClient(t, "Codes "+testdb.Token(t))
+		nonce := "nonce"
+
+		created, err := repo.CreateAuthorizationCode(ctx, &oauth_auth.CreateAuthCodeInput{
+			ClientID:    clientID,
+			UserID:      userID,
+			RedirectURI: "https://example.com/callback",
+			Scope:       "openid",
+			Nonce:       &nonce,
+			ExpiresAt:   time.Now().Add(time.Minute),
+		})
+		require.NoError(t, err)
+
+		found, err := repo.GetAuthorizationCodeByCode(ctx, created.Code)
+		require.NoError(t, err)
+		assert.Equal(t, created.Code, found.Code)
+		assert.Equal(t, clientID, found.ClientID)
+		require.NotNil(t, found.Nonce)
+		assert.Equal(t, nonce, *found.Nonce)
+		assert.Nil(t, found.CodeChallenge)
+
+		require.NoError(t, repo.MarkCodeExchanged(ctx, created.Code))
+		assert.ErrorIs(t, repo.MarkCodeExchanged(ctx, created.Code), oauth_auth.ErrAuthorizationCodeNotFound, "codes are exchanged once")
+		_, err = repo.GetAuthorizationCodeByCode(ctx, created.Code)
+		assert.ErrorIs(t, err, oauth_auth.ErrAuthorizationCodeNotFound)
+
+		expired, err := repo.CreateAuthorizationCode(ctx, &oauth_auth.CreateAuthCodeInput{
+			ClientID:    clientID,
+			UserID:      userID,
+			RedirectURI: "https://example.com/callback",
+			ExpiresAt:   time.Now().Add(-time.Minute),
+		})
+		require.NoError(t, err)
+		_, err = repo.GetAuthorizationCodeByCode(ctx, expired.Code)
+		assert.ErrorIs(t, err, oauth_auth.ErrAuthorizationCodeNotFound)
+	})
+
+	t.Run("refresh tokens", func(t *testing.T) {
+		userID := f.newUser(t).ID
+		clientID := f.newClient(t, "Tokens "+testdb.Token(t))
+
+		created, err := repo.CreateRefreshToken(ctx, &oauth_auth.CreateRefreshTokenInput{
+			ClientID:  clientID,
+			UserID:    userID,
+			Scope:     "openid offline_access",
+			ExpiresAt: time.Now().Add(time.Hour),
+		})
+		require.NoError(t, err)
+
+		assert.True(t, strings.HasPrefix(created.Token, "rt_"), "refresh tokens are opaque and prefixed")
+
+		found, err := repo.GetRefreshTokenByToken(ctx, created.Token)
+		require.NoError(t, err)
+		assert.Equal(t, created.Token, found.Token)
+		assert.Equal(t, "openid offline_access", found.Scope)
+		assert.Nil(t, found.Nonce)
+
+		require.NoError(t, repo.MarkRefreshTokenExchanged(ctx, created.Token))
+		assert.ErrorIs(t, repo.MarkRefreshTokenExchanged(ctx, created.Token), oauth_auth.ErrRefreshTokenNotFound)
+		_, err = repo.GetRefreshTokenByToken(ctx, created.Token)
+		assert.ErrorIs(t, err, oauth_auth.ErrRefreshTokenNotFound)
+		_, err = repo.GetRefreshTokenByToken(ctx, "rt_"+testdb.Token(t))
+		assert.ErrorIs(t, err, oauth_auth.ErrRefreshTokenNotFound)
+	})
+
+	t.Run("revoke user refresh tokens", func(t *testing.T) {
+		userID, otherUserID := f.newUser(t).ID, f.newUser(t).ID
+		clientID := f.newClient(t, "Revoke "+testdb.Token(t))
+		create := func(userID int64) string {
+			created, err := repo.CreateRefreshToken(ctx, &oauth_auth.CreateRefreshTokenInput{
+				ClientID:  clientID,
+				UserID:    userID,
+				Scope:     "openid",
+				ExpiresAt: time.Now().Add(time.Hour),
+			})
+			require.NoError(t, err)
+			return created.Token
+		}
+		first, second, other := create(userID), create(userID), create(otherUserID)
+		require.NoError(t, repo.MarkRefreshTokenExchanged(ctx, second))
+
+		revoked, err := repo.RevokeUserRefreshTokens(ctx, userID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), revoked, "exchanged tokens are not counted")
+		_, err = repo.GetRefreshTokenByToken(ctx, first)
+		assert.ErrorIs(t, err, oauth_auth.ErrRefreshTokenNotFound)
+		_, err = repo.GetRefreshTokenByToken(ctx, other)
+		assert.NoError(t, err, "tokens of other users stay usable")
+	})
+
+	t.Run("consents", func(t *testing.T) {
+		userID := f.newUser(t).ID
+		name := "Consents " + testdb.Token(t)
+		clientID := f.newClient(t, name)
+
+		_, err := repo.GetUserConsent(ctx, userID, clientID)
+		assert.ErrorIs(t, err, oauth_auth.ErrUserConsentNotFound)
+
+		granted, err := repo.CreateOrUpdateUserConsent(ctx, &oauth_auth.UserConsentInput{UserID: userID, ClientID: clientID, Scope: "openid"})
+		require.NoError(t, err)
+		regranted, err := repo.CreateOrUpdateUserConsent(ctx, &oauth_auth.UserConsentInput{UserID: userID, ClientID: clientID, Scope: "openid email"})
+		require.NoError(t, err)
+		assert.Equal(t, granted.ID, regranted.ID, "a user has one consent per client")
+		assert.Equal(t, "openid email", regranted.Scope)
+
+		consents, err := repo.GetUserConsents(ctx, userID)
+		require.NoError(t, err)
+		require.Len(t, consents, 1)
+		assert.Equal(t, name, consents[0].ClientName)
+
+		require.NoError(t, repo.RevokeUserConsent(ctx, userID, clientID))
+		assert.ErrorIs(t, repo.RevokeUserConsent(ctx, userID, clientID), oauth_auth.ErrUserConsentNotFound)
+
+		revoked, err := repo.GetUserConsent(ctx, userID, clientID)
+		require.NoError(t, err)
+		assert.NotNil(t, revoked.RevokedAt)
+		consents, err = repo.GetUserConsents(ctx, userID)
+		require.NoError(t, err)
+		assert.Empty(t, consents, "revoked consents are not listed")
+
+		restored, err := repo.CreateOrUpdateUserConsent(ctx, &oauth_auth.UserConsentInput{UserID: userID, ClientID: clientID, Scope: "openid"})
+		require.NoError(t, err)
+		assert.Nil(t, restored.RevokedAt)
+	})
+
+	t.Run("oauth clients", func(t *testing.T) {
+		clientID := f.newClient(t, "Clients "+testdb.Token(t))
+
+		client, err := repo.GetOAuthClientByClientID(ctx, clientID)
+		require.NoError(t, err)
+		assert.True(t, client.Confidential)
+		require.NotNil(t, client.SecretHash)
+		oldHash := *client.SecretHash
+
+		require.NoError(t, repo.UpdateOAuthClientSecretHash(ctx, client.ID, "stale", "ignored"))
+		client, err = repo.GetOAuthClientByClientID(ctx, clientID)
+		require.NoError(t, err)
+		assert.Equal(t, oldHash, *client.SecretHash, "a changed secret is not overwritten")
+
+		require.NoError(t, repo.UpdateOAuthClientSecretHash(ctx, client.ID, oldHash, "rehashed"))
+		client, err = repo.GetOAuthClientByClientID(ctx, clientID)
+		require.NoError(t, err)
+		assert.Equal(t, "rehashed", *client.SecretHash)
+
+		_, err = repo.GetOAuthClientByClientID(ctx, uuid.New())
+		assert.ErrorIs(t, err, oauth_auth.ErrOAuthClientNotFound)
+	})
+}
+
+// testOTPRepoContract runs the behavior every oauth_auth.OTPRepositor must
+// share against repo
+func testOTPRepoContract(t *testing.T, repo oauth_auth.OTPRepositor) {
+	ctx := context.Background()
+	email := testdb.Token(t) + "@example.com"
+	since := time.Now().Add(-time.Minute)
+
+	require.NoError(t, repo.CreateOTP(ctx, email, "hash", time.Now().Add(time.Minute)))
+	require.NoError(t, repo.CreateOTP(ctx, email, "hash", time.Now().Add(time.Minute)))
+	require.NoError(t, repo.CreateOTP(ctx, email, "expired", time.Now().Add(-time.Minute)))
+
+	count, err := repo.CountRecentOTPs(ctx, email, since)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+
+	otp, err := repo.GetValidOTP(ctx, email, "hash")
+	require.NoError(t, err)
+	require.NoError(t, repo.MarkOTPUsed(ctx, otp.ID))
+
+	older, err := repo.GetValidOTP(ctx, email, "hash")
+	require.NoError(t, err)
+	assert.Less(t, older.ID, otp.ID, "the newest valid OTP is returned first")
+	require.NoError(t, repo.MarkOTPUsed(ctx, older.ID))
+
+	_, err = repo.GetValidOTP(ctx, email, "hash")
+	assert.ErrorIs(t, err, oauth_auth.ErrInvalidOTP)
+	_, err = repo.GetValidOTP(ctx, email, "expired")
+	assert.ErrorIs(t, err, oauth_auth.ErrInvalidOTP)
+	assert.ErrorIs(t, repo.MarkOTPUsed(ctx, -1), oauth_auth.ErrInvalidOTP)
+}
+
+// testEmailVerificationRepoContract runs the behavior every
+// oauth_auth.EmailVerificationRepositor must share against repo
+func testEmailVerificationRepoContract(t *testing.T, repo oauth_auth.EmailVerificationRepositor, f fixtures) {
+	ctx := context.Background()
+	userID := f.newUser(t).ID
+	first, second := testdb.Token(t), testdb.Token(t)
+
+	require.NoError(t, repo.CreateVerificationToken(ctx, userID, first, time.Hour))
+	assert.Error(t, repo.CreateVerificationToken(ctx, userID, first, time.Hour), "token hashes are unique")
+	found, err := repo.GetValidToken(ctx, first)
+	require.NoError(t, err, "a failed create keeps the pending token")
+	assert.Equal(t, userID, found.UserID)
+
+	require.NoError(t, repo.CreateVerificationToken(ctx, userID, second, time.Hour))
+	_, err = repo.GetValidToken(ctx, first)
+	assert.ErrorIs(t, err, oauth_auth.ErrInvalidVerificationToken, "a new token invalidates the pending one")
+	assert.ErrorIs(t, repo.MarkTokenUsed(ctx, found.ID), oauth_auth.ErrInvalidVerificationToken)
+
+	found, err = repo.GetValidToken(ctx, second)
+	require.NoError(t, err)
+	require.NoError(t, repo.MarkTokenUsed(ctx, found.ID))
+	_, err = repo.GetValidToken(ctx, second)
+	assert.ErrorIs(t, err, oauth_auth.ErrInvalidVerificationToken)
+	assert.ErrorIs(t, repo.MarkTokenUsed(ctx, found.ID), oauth_auth.ErrInvalidVerificationToken, "tokens are used once")
+
+	third := testdb.Token(t)
+	require.NoError(t, repo.CreateVerificationToken(ctx, userID, third, time.Hour))
+	require.NoError(t, repo.InvalidateUserTokens(ctx, userID))
+	_, err = repo.GetValidToken(ctx, third)
+	assert.ErrorIs(t, err, oauth_auth.ErrInvalidVerificationToken)
+	assert.ErrorIs(t, repo.MarkTokenUsed(ctx, -1), oauth_auth.ErrInvalidVerificationToken)
+
+	expired := testdb.Token(t)
+	require.NoError(t, repo.CreateVerificationToken(ctx, userID, expired, -time.Minute))
+	_, err = repo.GetValidToken(ctx, expired)
+	assert.ErrorIs(t, err, oauth_auth.ErrInvalidVerificationToken, "the TTL is enforced on lookup")
+
+	stale := testdb.Token(t)
+	require.NoError(t, repo.CreateVerificationToken(ctx, userID, stale, -2*time.Hour))
+	purged, err := repo.PurgeStale(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, purged, int64(1))
+	purged, err = repo.PurgeStale(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Zero(t, purged, "tokens used or expired after the cutoff are kept")
+}
+
+// testRememberTokenRepoContract runs the behavior every
+// oauth_auth.RememberTokenRepositor must share against repo
+func testRememberTokenRepoContract(t *testing.T, repo oauth_auth.RememberTokenRepositor, f fixtures) {
+	ctx := context.Background()
+	userID := f.newUser(t).ID
+	create := func(series, hash string, expiresAt time.Time) *oauth_auth.RememberToken {
+		created, err := repo.CreateRememberToken(ctx, &oauth_auth.CreateRememberTokenInput{
+			Series:     series,
+			UserID:     userID,
+			TokenHash:  hash,
+			DeviceHash: sha256Hex("Mozilla/5.0"),
+			UserAgent:  "Mozilla/5.0",
+			IPAddress:  "203.0.113.7",
+			ExpiresAt:  expiresAt,
+		})
+		require.NoError(t, err)
+		return created
+	}
+
+	series := testdb.Token(t)
+	first, second, third := sha256Hex(testdb.Token(t)), sha256Hex(testdb.Token(t)), sha256Hex(testdb.Token(t))
+	created := create(series, first, time.Now().Add(time.Hour))
+	assert.Equal(t, "Mozilla/5.0", created.UserAgent)
+	_, err := repo.CreateRememberToken(ctx, &oauth_auth.CreateRememberTokenInput{
+		Series: series, UserID: userID, TokenHash: second, DeviceHash: first, ExpiresAt: time.Now().Add(time.Hour),
+	})
+	assert.Error(t, err, "series are unique")
+
+	found, err := repo.GetActiveRememberToken(ctx, series)
+	require.NoError(t, err)
+	assert.Equal(t, first, found.TokenHash)
+	assert.Nil(t, found.RotatedAt)
+
+	require.NoError(t, repo.RotateRememberToken(ctx, found.ID, first, second))
+	assert.ErrorIs(t, repo.RotateRememberToken(ctx, found.ID, first, third), oauth_auth.ErrRememberTokenNotFound,
+		"of two requests racing a rotation only one replaces the token")
+	found, err = repo.GetActiveRememberToken(ctx, series)
+	require.NoError(t, err)
+	assert.Equal(t, second, found.TokenHash)
+	assert.Equal(t, first, found.PreviousTokenHash)
+	assert.NotNil(t, found.RotatedAt)
+
+	other := testdb.Token(t)
+	create(other, third, time.Now().Add(time.Hour))
+	listed, err := repo.ListUserRememberTokens(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, listed, 2)
+	assert.Equal(t, other, listed[0].Series, "newest first")
+
+	assert.ErrorIs(t, repo.RevokeRememberToken(ctx, f.newUser(t).ID, series), oauth_auth.ErrRememberTokenNotFound,
+		"only the owner revokes a token")
+	require.NoError(t, repo.RevokeRememberToken(ctx, userID, series))
+	assert.ErrorIs(t, repo.RevokeRememberToken(ctx, userID, series), oauth_auth.ErrRememberTokenNotFound)
+	_, err = repo.GetActiveRememberToken(ctx, series)
+	assert.ErrorIs(t, err, oauth_auth.ErrRememberTokenNotFound)
+	listed, err = repo.ListUserRememberTokens(ctx, userID)
+	require.NoError(t, err)
+	assert.Len(t, listed, 1)
+
+	expired := testdb.Token(t)
+	create(expired, sha256Hex(testdb.Token(t)), time.Now().Add(-2*time.Hour))
+	_, err = repo.GetActiveRememberToken(ctx, expired)
+	assert.ErrorIs(t, err, oauth_auth.ErrRememberTokenNotFound, "the expiry is enforced on lookup")
+
+	purged, err := repo.PurgeStale(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, purged, int64(1))
+	purged, err = repo.PurgeStale(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Zero(t, purged, "tokens revoked or expired after the cutoff are kept")
+}
+
+// userRepositor is implemented by oauth_auth.UserRepo and its in-memory twin
+type userRepositor interface {
+	oauth_auth.UserLookupRepositor
+	oauth_auth.UserEmailVerificationRepositor
+}
+
+// testUserRepoContract runs the behavior every user lookup repository must
+// share against repo
+func testUserRepoContract(t *testing.T, repo userRepositor, f fixtures) {
+	ctx := context.Background()
+	u := f.newUser(t)
+
+	byEmail, err := repo.GetUserByEmail(ctx, strings.ToUpper(u.Email))
+	require.NoError(t, err, "emails are matched case-insensitively")
+	assert.Equal(t, u.ID, byEmail.ID)
+
+	byPublicID, err := repo.GetUserByPublicID(ctx, u.PublicID)
+	require.NoError(t, err)
+	assert.Equal(t, u.Email, byPublicID.Email)
+
+	require.NoError(t, repo.SetEmailVerified(ctx, u.ID, true))
+	byID, err := repo.GetUserByID(ctx, u.ID)
+	require.NoError(t, err)
+	assert.True(t, byID.EmailVerified)
+
+	assert.ErrorIs(t, repo.SetEmailVerified(ctx, -1, true), oauth_auth.ErrUserNotFound)
+	_, err = repo.GetUserByEmail(ctx, "unknown@example.com")
+	assert.ErrorIs(t, err, oauth_auth.ErrUserNotFound)
+}
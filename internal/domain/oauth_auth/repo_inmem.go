@@ -0,0 +1,317 @@
+package oauth_auth
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// tokenStatsKey identifies the token request counts of a client in an hour
+type tokenStatsKey struct {
+	ClientID uuid.UUID
+	Hour     time.Time
+}
+
+// InMemRepo is an in-memory Repositor for tests. Codes and refresh tokens are
+// only returned while unexchanged and unexpired, as in the Postgres
+// repository. OAuth clients live in the oauth_client domain, so tests seed the
+// ones they need with PutOAuthClient.
+type InMemRepo struct {
+	mu       sync.RWMutex
+	codes    map[uuid.UUID]*AuthorizationCode
+	tokens   map[string]*RefreshToken
+	consents []*UserConsent
+	clients  map[uuid.UUID]*OAuthClientInfo
+	stats    map[tokenStatsKey]*TokenStats
+	lastID   int64
+}
+
+var _ Repositor = (*InMemRepo)(nil)
+
+// NewInMemRepo creates an empty in-memory OAuth auth repository
+func NewInMemRepo() *InMemRepo {
+	return &InMemRepo{
+		codes:   make(map[uuid.UUID]*AuthorizationCode),
+		tokens:  make(map[string]*RefreshToken),
+		clients: make(map[uuid.UUID]*OAuthClientInfo),
+		stats:   make(map[tokenStatsKey]*TokenStats),
+	}
+}
+
+// PutOAuthClient adds or replaces the OAuth client with client.ClientID
+func (r *InMemRepo) PutOAuthClient(client *OAuthClientInfo) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c := *client
+	c.RedirectURIs = slices.Clone(client.RedirectURIs)
+	r.clients[c.ClientID] = &c
+}
+
+func (r *InMemRepo) nextID() int64 {
+	r.lastID++
+	return r.lastID
+}
+
+func (r *InMemRepo) CreateAuthorizationCode(ctx context.Context, input *CreateAuthCodeInput) (*AuthorizationCode, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ac := &AuthorizationCode{
+		ID:                  r.nextID(),
+		Code:                uuid.New(),
+		ClientID:            input.ClientID,
+		UserID:              input.UserID,
+		RedirectURI:         input.RedirectURI,
+		Scope:               input.Scope,
+		Nonce:               input.Nonce,
+		CodeChallenge:       input.CodeChallenge,
+		CodeChallengeMethod: input.CodeChallengeMethod,
+		ExpiresAt:           input.ExpiresAt,
+		CreatedAt:           time.Now(),
+	}
+	r.codes[ac.Code] = ac
+
+	created := *ac
+	return &created, nil
+}
+
+func (r *InMemRepo) GetAuthorizationCodeByCode(ctx context.Context, code uuid.UUID) (*AuthorizationCode, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ac, ok := r.codes[code]
+	if !ok || ac.ExchangeAt != nil || !ac.ExpiresAt.After(time.Now()) {
+		return nil, ErrAuthorizationCodeNotFound
+	}
+
+	found := *ac
+	return &found, nil
+}
+
+func (r *InMemRepo) MarkCodeExchanged(ctx context.Context, code uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ac, ok := r.codes[code]
+	if !ok || ac.ExchangeAt != nil {
+		return ErrAuthorizationCodeNotFound
+	}
+
+	now := time.Now()
+	ac.ExchangeAt = &now
+	return nil
+}
+
+func (r *InMemRepo) CreateRefreshToken(ctx context.Context, input *CreateRefreshTokenInput) (*RefreshToken, error) {
+	token, err := newRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rt := &RefreshToken{
+		ID:        r.nextID(),
+		Token:     token,
+		ClientID:  input.ClientID,
+		UserID:    input.UserID,
+		Scope:     input.Scope,
+		Nonce:     input.Nonce,
+		ExpiresAt: input.ExpiresAt,
+		CreatedAt: time.Now(),
+	}
+	r.tokens[rt.Token] = rt
+
+	created := *rt
+	return &created, nil
+}
+
+func (r *InMemRepo) GetRefreshTokenByToken(ctx context.Context, token string) (*RefreshToken, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	rt, ok := r.tokens[token]
+	if !ok || rt.ExchangeAt != nil || !rt.ExpiresAt.After(time.Now()) {
+		return nil, ErrRefreshTokenNotFound
+	}
+
+	found := *rt
+	return &found, nil
+}
+
+func (r *InMemRepo) MarkRefreshTokenExchanged(ctx context.Context, token string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rt, ok := r.tokens[token]
+	if !ok || rt.ExchangeAt != nil {
+		return ErrRefreshTokenNotFound
+	}
+
+	now := time.Now()
+	rt.ExchangeAt = &now
+	return nil
+}
+
+func (r *InMemRepo) RevokeUserRefreshTokens(ctx context.Context, userID int64) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	var revoked int64
+	for _, rt := range r.tokens {
+		if rt.UserID == userID && rt.ExchangeAt == nil && rt.ExpiresAt.After(now) {
+			rt.ExchangeAt = &now
+			revoked++
+		}
+	}
+	return revoked, nil
+}
+
+func (r *InMemRepo) findConsent(userID int64, clientID uuid.UUID) *UserConsent {
+	for _, uc := range r.consents {
+		if uc.UserID == userID && uc.ClientID == clientID {
+			return uc
+		}
+	}
+	return nil
+}
+
+func (r *InMemRepo) GetUserConsent(ctx context.Context, userID int64, clientID uuid.UUID) (*UserConsent, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	uc := r.findConsent(userID, clientID)
+	if uc == nil {
+		return nil, ErrUserConsentNotFound
+	}
+
+	found := *uc
+	return &found, nil
+}
+
+func (r *InMemRepo) GetUserConsents(ctx context.Context, userID int64) ([]*UserConsentWithClient, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var consents []*UserConsentWithClient
+	for _, uc := range r.consents {
+		if uc.UserID != userID || uc.RevokedAt != nil {
+			continue
+		}
+		client, ok := r.clients[uc.ClientID]
+		if !ok {
+			continue
+		}
+		consents = append(consents, &UserConsentWithClient{
+			ID:         uc.ID,
+			UserID:     uc.UserID,
+			ClientID:   uc.ClientID,
+			ClientName: client.Name,
+			Scope:      uc.Scope,
+			GrantedAt:  uc.GrantedAt,
+			CreatedAt:  uc.CreatedAt,
+		})
+	}
+	slices.SortStableFunc(consents, func(a, b *UserConsentWithClient) int {
+		return cmp.Compare(b.GrantedAt.UnixNano(), a.GrantedAt.UnixNano())
+	})
+
+	return consents, nil
+}
+
+func (r *InMemRepo) CreateOrUpdateUserConsent(ctx context.Context, input *UserConsentInput) (*UserConsent, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	uc := r.findConsent(input.UserID, input.ClientID)
+	if uc == nil {
+		uc = &UserConsent{
+			ID:        r.nextID(),
+			UserID:    input.UserID,
+			ClientID:  input.ClientID,
+			CreatedAt: now,
+		}
+		r.consents = append(r.consents, uc)
+	}
+	uc.Scope = input.Scope
+	uc.GrantedAt = now
+	uc.RevokedAt = nil
+
+	granted := *uc
+	return &granted, nil
+}
+
+func (r *InMemRepo) RevokeUserConsent(ctx context.Context, userID int64, clientID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	uc := r.findConsent(userID, clientID)
+	if uc == nil || uc.RevokedAt != nil {
+		return ErrUserConsentNotFound
+	}
+
+	now := time.Now()
+	uc.RevokedAt = &now
+	return nil
+}
+
+func (r *InMemRepo) GetOAuthClientByClientID(ctx context.Context, clientID uuid.UUID) (*OAuthClientInfo, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	c, ok := r.clients[clientID]
+	if !ok {
+		return nil, ErrOAuthClientNotFound
+	}
+
+	found := *c
+	found.RedirectURIs = slices.Clone(c.RedirectURIs)
+	return &found, nil
+}
+
+func (r *InMemRepo) UpdateOAuthClientSecretHash(ctx context.Context, id int64, oldHash, newHash string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, c := range r.clients {
+		if c.ID == id && c.SecretHash != nil && *c.SecretHash == oldHash {
+			c.SecretHash = &newHash
+		}
+	}
+	return nil
+}
+
+func (r *InMemRepo) IncrementTokenStats(ctx context.Context, clientID uuid.UUID, hour time.Time, issued, failed int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := tokenStatsKey{ClientID: clientID, Hour: hour.UTC()}
+	stats, ok := r.stats[key]
+	if !ok {
+		stats = &TokenStats{}
+		r.stats[key] = stats
+	}
+	stats.Issued += issued
+	stats.Failed += failed
+	return nil
+}
+
+// TokenStats returns the token request counts of a client in the hour
+// starting at hour, zero when it made none
+func (r *InMemRepo) TokenStats(clientID uuid.UUID, hour time.Time) TokenStats {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if stats, ok := r.stats[tokenStatsKey{ClientID: clientID, Hour: hour.UTC()}]; ok {
+		return *stats
+	}
+	return TokenStats{}
+}
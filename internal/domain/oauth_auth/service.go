@@ -2,12 +2,14 @@ package oauth_auth
 
 import (
 	"context"
+	"net/url"
 	"slices"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/hrz8/altalune"
+	project_domain "github.com/hrz8/altalune/internal/domain/project"
 	"github.com/hrz8/altalune/internal/shared/jwt"
 	"github.com/hrz8/altalune/internal/shared/password"
 	"github.com/hrz8/altalune/internal/shared/pkce"
@@ -50,6 +52,24 @@ func GetProjectRoleForContext(ctx RegistrationContext) string {
 	}
 }
 
+// ResolveRegistrationPolicy returns the project role and activation state of a
+// self-registered user. Project onboarding settings win over the registration
+// context role and the global auto-activate setting. Dashboard and admin
+// registrations always get the context role, so they keep dashboard access.
+func ResolveRegistrationPolicy(ctx RegistrationContext, onboarding *project_domain.Onboarding, autoActivate bool) (string, bool) {
+	role := GetProjectRoleForContext(ctx)
+	if onboarding == nil {
+		return role, autoActivate
+	}
+	if onboarding.DefaultMemberRole != "" && (ctx == RegistrationContextStandalone || ctx == RegistrationContextCustom) {
+		role = onboarding.DefaultMemberRole
+	}
+	if onboarding.AutoActivate != nil {
+		autoActivate = *onboarding.AutoActivate
+	}
+	return role, autoActivate
+}
+
 // Service handles OAuth authorization code and token operations.
 type Service struct {
 	repo                 Repositor
@@ -60,6 +80,7 @@ type Service struct {
 	permissionProvider   UserPermissionProvider
 	membershipProvider   UserMembershipProvider
 	scopeHandlerRegistry *ScopeHandlerRegistry
+	secretCache          *clientSecretCache
 }
 
 // NewService creates a new OAuth auth service.
@@ -82,6 +103,7 @@ func NewService(
 		permissionProvider:   permissionFetcher,
 		membershipProvider:   membershipProvider,
 		scopeHandlerRegistry: scopeHandlerRegistry,
+		secretCache:          newClientSecretCache(cfg.GetClientSecretCacheTTL()),
 	}
 }
 
@@ -163,13 +185,15 @@ func (s *Service) ValidateAndExchangeCode(ctx context.Context, codeStr string, c
 
 // GenerateTokenPairParams holds parameters for token pair generation.
 type GenerateTokenPairParams struct {
-	UserID        int64     // Internal user ID (for DB operations and permission fetching)
-	UserPublicID  string    // Public user ID (nanoid) for JWT subject
-	ClientID      uuid.UUID // OAuth client ID
-	Scope         string    // Space-separated OAuth scopes
-	Email         string    // User email
-	Name          string    // User full name
-	EmailVerified bool      // Whether user's email is verified
+	UserID        int64              // Internal user ID (for DB operations and permission fetching)
+	UserPublicID  string             // Public user ID (nanoid) for JWT subject
+	ClientID      uuid.UUID          // OAuth client ID
+	Scope         string             // Space-separated OAuth scopes
+	Email         string             // User email
+	Name          string             // User full name
+	EmailVerified bool               // Whether user's email is verified
+	PermsClaim    jwt.PermsClaimMode // How the client wants permissions carried, empty for all
+	PermsMaxBytes int                // Cap on the perms claim of the client, 0 for none
 }
 
 // GenerateTokenPair creates an access token and refresh token pair.
@@ -177,10 +201,20 @@ func (s *Service) GenerateTokenPair(ctx context.Context, params *GenerateTokenPa
 	accessTokenExpiry := time.Duration(s.cfg.GetAccessTokenExpiry()) * time.Second
 	refreshTokenExpiry := time.Duration(s.cfg.GetRefreshTokenExpiry()) * time.Second
 
-	// Fetch user permissions (graceful degradation - log warning but continue on error)
-	perms := []string{}
+	// Fetch user permissions as the client wants them carried (graceful
+	// degradation - log warning but continue on error)
+	perms, roles := []string{}, []string(nil)
 	if s.permissionProvider != nil {
-		fetchedPerms, err := s.permissionProvider.GetUserPermissions(ctx, params.UserID)
+		var fetchedPerms []string
+		var err error
+		switch params.PermsClaim {
+		case jwt.PermsClaimOmit:
+			// Resource servers introspect the token instead
+		case jwt.PermsClaimRoles:
+			roles, fetchedPerms, err = s.permissionProvider.GetUserRoleClaims(ctx, params.UserID)
+		default:
+			fetchedPerms, err = s.permissionProvider.GetUserPermissions(ctx, params.UserID)
+		}
 		if err != nil {
 			s.log.Warn("failed to fetch user permissions, continuing with empty permissions",
 				"error", err,
@@ -206,12 +240,16 @@ func (s *Service) GenerateTokenPair(ctx context.Context, params *GenerateTokenPa
 	}
 
 	accessToken, err := s.jwtSigner.GenerateAccessToken(jwt.GenerateTokenParams{
+		Issuer:        Issuer(ctx, s.cfg),
 		UserPublicID:  params.UserPublicID,
 		ClientID:      params.ClientID.String(),
 		Scope:         params.Scope,
 		Email:         params.Email,
 		Name:          params.Name,
 		Perms:         perms,
+		Roles:         roles,
+		PermsOmitted:  params.PermsClaim == jwt.PermsClaimOmit,
+		PermsMaxBytes: params.PermsMaxBytes,
 		Memberships:   memberships,
 		EmailVerified: params.EmailVerified,
 		Expiry:        accessTokenExpiry,
@@ -242,7 +280,7 @@ func (s *Service) GenerateTokenPair(ctx context.Context, params *GenerateTokenPa
 
 	return &TokenPair{
 		AccessToken:  accessToken,
-		RefreshToken: refreshToken.Token.String(),
+		RefreshToken: refreshToken.Token,
 		TokenType:    "Bearer",
 		ExpiresIn:    s.cfg.GetAccessTokenExpiry(),
 		Scope:        params.Scope,
@@ -265,12 +303,12 @@ type RefreshTokenResult struct {
 
 // ValidateRefreshToken validates a refresh token and returns user info for token generation.
 func (s *Service) ValidateRefreshToken(ctx context.Context, refreshTokenStr string, clientID uuid.UUID) (*RefreshTokenResult, error) {
-	tokenUUID, err := uuid.Parse(refreshTokenStr)
-	if err != nil {
+	token, ok := parseRefreshToken(refreshTokenStr)
+	if !ok {
 		return nil, ErrInvalidRefreshToken
 	}
 
-	refreshToken, err := s.repo.GetRefreshTokenByToken(ctx, tokenUUID)
+	refreshToken, err := s.repo.GetRefreshTokenByToken(ctx, token)
 	if err != nil {
 		return nil, ErrInvalidRefreshToken
 	}
@@ -287,10 +325,10 @@ func (s *Service) ValidateRefreshToken(ctx context.Context, refreshTokenStr stri
 		return nil, ErrRefreshTokenUsed
 	}
 
-	if err := s.repo.MarkRefreshTokenExchanged(ctx, tokenUUID); err != nil {
+	if err := s.repo.MarkRefreshTokenExchanged(ctx, token); err != nil {
 		s.log.Error("failed to mark refresh token exchanged",
 			"error", err,
-			"token", tokenUUID,
+			"refresh_token_id", refreshToken.ID,
 		)
 		return nil, err
 	}
@@ -388,14 +426,47 @@ func (s *Service) AuthenticateClient(ctx context.Context, clientIDStr, clientSec
 		return nil, ErrInvalidClientSecret
 	}
 
-	valid, err := password.VerifyPassword(clientSecret, *client.SecretHash)
-	if err != nil || !valid {
-		return nil, ErrInvalidClientSecret
+	if !s.secretCache.verified(client, clientSecret) {
+		valid, err := password.VerifyPassword(clientSecret, *client.SecretHash)
+		if err != nil || !valid {
+			return nil, ErrInvalidClientSecret
+		}
+
+		s.rehashClientSecret(ctx, client, clientSecret)
+		s.secretCache.remember(client, clientSecret)
+	}
+
+	// Only told once the secret is verified, so it does not confirm guesses
+	if client.SecretExpired(time.Now()) {
+		s.log.Warn("expired client secret used", "client_id", clientIDStr, "client_name", client.Name)
+		return nil, ErrClientSecretExpired
 	}
 
 	return client, nil
 }
 
+// rehashClientSecret upgrades a verified client secret hash made with other
+// parameters than the configured ones. Failures are only logged: the old hash
+// keeps verifying.
+func (s *Service) rehashClientSecret(ctx context.Context, client *OAuthClientInfo, clientSecret string) {
+	opt := password.OptionFromConfig(s.cfg)
+	if !password.NeedsRehash(*client.SecretHash, opt) {
+		return
+	}
+
+	hash, err := password.HashPassword(clientSecret, opt)
+	if err == nil {
+		err = s.repo.UpdateOAuthClientSecretHash(ctx, client.ID, *client.SecretHash, hash)
+	}
+	if err != nil {
+		s.log.Warn("failed to rehash client secret", "client_id", client.ClientID.String(), "error", err)
+		return
+	}
+
+	s.log.Info("rehashed client secret with the configured parameters", "client_id", client.ClientID.String())
+	client.SecretHash = &hash
+}
+
 // GetOAuthClient retrieves an OAuth client by client_id.
 func (s *Service) GetOAuthClient(ctx context.Context, clientIDStr string) (*OAuthClientInfo, error) {
 	clientUUID, err := uuid.Parse(clientIDStr)
@@ -416,10 +487,29 @@ func (s *Service) ValidateRedirectURI(client *OAuthClientInfo, redirectURI strin
 	return slices.Contains(client.RedirectURIs, redirectURI)
 }
 
+// ValidatePostLogoutRedirectURI checks if a post-logout redirect URI is on the
+// origin of one of the client's redirect URIs. Clients register no separate
+// logout URIs, and their redirect URIs are callbacks rather than pages to land
+// on after logging out.
+func (s *Service) ValidatePostLogoutRedirectURI(client *OAuthClientInfo, redirectURI string) bool {
+	target, err := url.Parse(redirectURI)
+	if err != nil || target.Scheme == "" || target.Host == "" || target.User != nil {
+		return false
+	}
+
+	for _, registered := range client.RedirectURIs {
+		u, err := url.Parse(registered)
+		if err == nil && u.Scheme == target.Scheme && u.Host == target.Host {
+			return true
+		}
+	}
+	return false
+}
+
 // RevokeToken revokes a refresh token or access token.
 func (s *Service) RevokeToken(ctx context.Context, token, tokenTypeHint string) error {
-	tokenUUID, err := uuid.Parse(token)
-	if err != nil {
+	refreshTokenStr, ok := parseRefreshToken(token)
+	if !ok {
 		return nil
 	}
 
@@ -427,7 +517,7 @@ func (s *Service) RevokeToken(ctx context.Context, token, tokenTypeHint string)
 		return nil
 	}
 
-	refreshToken, err := s.repo.GetRefreshTokenByToken(ctx, tokenUUID)
+	refreshToken, err := s.repo.GetRefreshTokenByToken(ctx, refreshTokenStr)
 	if err != nil {
 		return nil
 	}
@@ -436,14 +526,43 @@ func (s *Service) RevokeToken(ctx context.Context, token, tokenTypeHint string)
 		return nil
 	}
 
-	if err := s.repo.MarkRefreshTokenExchanged(ctx, tokenUUID); err != nil {
-		s.log.Error("failed to revoke refresh token", "error", err, "token", tokenUUID)
+	if err := s.repo.MarkRefreshTokenExchanged(ctx, refreshTokenStr); err != nil {
+		s.log.Error("failed to revoke refresh token", "error", err, "refresh_token_id", refreshToken.ID)
+		return err
+	}
+
+	return nil
+}
+
+// RevokeUserRefreshTokens revokes every refresh token of a user, so that the
+// clients holding them can no longer refresh and see them as inactive when
+// introspecting them.
+func (s *Service) RevokeUserRefreshTokens(ctx context.Context, userID int64) error {
+	revoked, err := s.repo.RevokeUserRefreshTokens(ctx, userID)
+	if err != nil {
+		s.log.Error("failed to revoke user refresh tokens", "error", err, "user_id", userID)
 		return err
 	}
 
+	s.log.Info("revoked user refresh tokens", "user_id", userID, "count", revoked)
 	return nil
 }
 
+// RecordTokenRequest counts a token endpoint request of a client in the
+// hourly stats, as issued or failed. Failing to count is logged only: the
+// request was answered already.
+func (s *Service) RecordTokenRequest(ctx context.Context, clientID uuid.UUID, issued bool) {
+	var issuedCount, failedCount int64 = 1, 0
+	if !issued {
+		issuedCount, failedCount = 0, 1
+	}
+
+	hour := time.Now().UTC().Truncate(time.Hour)
+	if err := s.repo.IncrementTokenStats(ctx, clientID, hour, issuedCount, failedCount); err != nil {
+		s.log.Warn("failed to record token request", "error", err, "client_id", clientID)
+	}
+}
+
 // IntrospectToken inspects a token and returns its metadata.
 func (s *Service) IntrospectToken(ctx context.Context, token string, clientID uuid.UUID) (map[string]interface{}, error) {
 	claims, err := s.jwtSigner.ValidateAccessToken(token)
@@ -459,8 +578,9 @@ func (s *Service) IntrospectToken(ctx context.Context, token string, clientID uu
 
 		// Check user's is_active status from database
 		isActive := true
+		var user *UserInfo
 		if s.userLookup != nil {
-			user, err := s.userLookup.GetUserByPublicID(ctx, claims.Subject)
+			user, err = s.userLookup.GetUserByPublicID(ctx, claims.Subject)
 			if err != nil {
 				// User not found or error - treat as inactive
 				return map[string]interface{}{"active": false}, nil
@@ -494,16 +614,29 @@ func (s *Service) IntrospectToken(ctx context.Context, token string, clientID uu
 		if len(claims.Perms) > 0 {
 			result["perms"] = claims.Perms
 		}
+		if len(claims.Roles) > 0 {
+			result["roles"] = claims.Roles
+		}
+
+		// Tokens of clients slimming the perms claim leave permissions out;
+		// introspection is where resource servers get them all
+		if !claims.HasAllPerms() && user != nil && s.permissionProvider != nil {
+			perms, err := s.permissionProvider.GetUserPermissions(ctx, user.ID)
+			if err != nil {
+				return nil, err
+			}
+			result["perms"] = perms
+		}
 
 		return result, nil
 	}
 
-	tokenUUID, err := uuid.Parse(token)
-	if err != nil {
+	refreshTokenStr, ok := parseRefreshToken(token)
+	if !ok {
 		return map[string]interface{}{"active": false}, nil
 	}
 
-	refreshToken, err := s.repo.GetRefreshTokenByToken(ctx, tokenUUID)
+	refreshToken, err := s.repo.GetRefreshTokenByToken(ctx, refreshTokenStr)
 	if err != nil {
 		return map[string]interface{}{"active": false}, nil
 	}
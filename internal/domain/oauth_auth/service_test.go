@@ -0,0 +1,55 @@
+package oauth_auth
+
+import (
+	"testing"
+
+	project_domain "github.com/hrz8/altalune/internal/domain/project"
+)
+
+func TestValidatePostLogoutRedirectURI(t *testing.T) {
+	svc := &Service{}
+	client := &OAuthClientInfo{RedirectURIs: []string{"http://localhost:8080/callback", "https://app.example.com/auth/callback"}}
+
+	tests := []struct {
+		uri  string
+		want bool
+	}{
+		{"http://localhost:8080/", true},
+		{"https://app.example.com/goodbye?from=idp", true},
+		{"http://localhost:8081/", false},
+		{"http://app.example.com/", false},
+		{"https://evil.example.com/", false},
+		{"https://user@app.example.com/", false},
+		{"/relative", false},
+	}
+	for _, tt := range tests {
+		if got := svc.ValidatePostLogoutRedirectURI(client, tt.uri); got != tt.want {
+			t.Errorf("ValidatePostLogoutRedirectURI(%q) = %v, want %v", tt.uri, got, tt.want)
+		}
+	}
+}
+
+func TestResolveRegistrationPolicy(t *testing.T) {
+	manual := false
+	onboarding := &project_domain.Onboarding{DefaultMemberRole: "member", AutoActivate: &manual}
+
+	tests := []struct {
+		name         string
+		ctx          RegistrationContext
+		onboarding   *project_domain.Onboarding
+		wantRole     string
+		wantActivate bool
+	}{
+		{"inherited", RegistrationContextCustom, nil, "user", true},
+		{"empty settings inherit", RegistrationContextStandalone, &project_domain.Onboarding{}, "user", true},
+		{"custom client", RegistrationContextCustom, onboarding, "member", false},
+		{"standalone", RegistrationContextStandalone, onboarding, "member", false},
+		{"dashboard keeps its role", RegistrationContextDashboard, &project_domain.Onboarding{DefaultMemberRole: "user"}, "member", true},
+	}
+	for _, tt := range tests {
+		role, activate := ResolveRegistrationPolicy(tt.ctx, tt.onboarding, true)
+		if role != tt.wantRole || activate != tt.wantActivate {
+			t.Errorf("%s: ResolveRegistrationPolicy() = (%q, %v), want (%q, %v)", tt.name, role, activate, tt.wantRole, tt.wantActivate)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package oauth_auth
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/hrz8/altalune"
+	project_hostname_domain "github.com/hrz8/altalune/internal/domain/project_hostname"
+)
+
+type tenantContextKey struct{}
+
+// WithTenant adds the tenant resolved from the request Host to the context.
+func WithTenant(ctx context.Context, tenant *project_hostname_domain.Tenant) context.Context {
+	return context.WithValue(ctx, tenantContextKey{}, tenant)
+}
+
+// TenantFromContext returns the tenant of the request, or nil when the Host
+// is not a registered project hostname.
+func TenantFromContext(ctx context.Context) *project_hostname_domain.Tenant {
+	tenant, _ := ctx.Value(tenantContextKey{}).(*project_hostname_domain.Tenant)
+	return tenant
+}
+
+// Issuer returns the issuer of the tokens and discovery document served on
+// requests with ctx: the configured issuer, or the URL of the project hostname
+// the request arrived on when host issuers are enabled. That URL keeps the
+// scheme and port of the configured issuer.
+func Issuer(ctx context.Context, cfg altalune.Config) string {
+	issuer := cfg.GetJWTIssuer()
+	if !cfg.IsAuthHostIssuer() {
+		return issuer
+	}
+	tenant := TenantFromContext(ctx)
+	if tenant == nil {
+		return issuer
+	}
+	return hostIssuer(issuer, tenant.Hostname)
+}
+
+// hostIssuer returns issuer with its host replaced by hostname
+func hostIssuer(issuer, hostname string) string {
+	u, err := url.Parse(issuer)
+	if err != nil || u.Scheme == "" {
+		return issuer
+	}
+	host := hostname
+	if port := u.Port(); port != "" {
+		host = net.JoinHostPort(hostname, port)
+	} else if strings.Contains(hostname, ":") {
+		host = "[" + hostname + "]"
+	}
+	return (&url.URL{Scheme: u.Scheme, Host: host}).String()
+}
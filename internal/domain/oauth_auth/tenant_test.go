@@ -0,0 +1,33 @@
+package oauth_auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hrz8/altalune"
+	project_hostname_domain "github.com/hrz8/altalune/internal/domain/project_hostname"
+	"github.com/stretchr/testify/assert"
+)
+
+type issuerConfig struct {
+	altalune.Config
+	issuer     string
+	hostIssuer bool
+}
+
+func (c *issuerConfig) GetJWTIssuer() string   { return c.issuer }
+func (c *issuerConfig) IsAuthHostIssuer() bool { return c.hostIssuer }
+
+func TestIssuer(t *testing.T) {
+	tenant := WithTenant(context.Background(), &project_hostname_domain.Tenant{Hostname: "auth.acme.com"})
+
+	cfg := &issuerConfig{issuer: "https://auth.example.com"}
+	assert.Equal(t, "https://auth.example.com", Issuer(tenant, cfg), "host issuers disabled")
+
+	cfg.hostIssuer = true
+	assert.Equal(t, "https://auth.acme.com", Issuer(tenant, cfg))
+	assert.Equal(t, "https://auth.example.com", Issuer(context.Background(), cfg), "not a project hostname")
+
+	cfg.issuer = "http://localhost:3300"
+	assert.Equal(t, "http://auth.acme.com:3300", Issuer(tenant, cfg), "keeps the configured port")
+}
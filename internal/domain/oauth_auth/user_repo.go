@@ -5,16 +5,19 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/hrz8/altalune/internal/postgres"
 )
 
-// UserRepo implements UserLookupRepositor and UserEmailVerificationRepositor.
+// UserRepo implements UserLookupRepositor, UserLockoutRepositor and
+// UserEmailVerificationRepositor.
 type UserRepo struct {
 	db postgres.DB
 }
 
 // NewUserRepo creates a new user repository for OTP/verification services.
+// Lookups only find human users: service accounts cannot sign in.
 func NewUserRepo(db postgres.DB) *UserRepo {
 	return &UserRepo{db: db}
 }
@@ -22,13 +25,14 @@ func NewUserRepo(db postgres.DB) *UserRepo {
 // GetUserByEmail retrieves user info by email address.
 func (r *UserRepo) GetUserByEmail(ctx context.Context, email string) (*UserInfo, error) {
 	query := `
-		SELECT id, public_id, email, first_name, last_name, is_active, email_verified
+		SELECT id, public_id, COALESCE(email, ''), first_name, last_name, is_active, email_verified, locked_until
 		FROM altalune_users
-		WHERE LOWER(email) = LOWER($1)
+		WHERE LOWER(email) = LOWER($1) AND deleted_at IS NULL AND user_type = 'human'
 		LIMIT 1
 	`
 	var user UserInfo
 	var firstName, lastName sql.NullString
+	var lockedUntil sql.NullTime
 
 	err := r.db.QueryRowContext(ctx, query, email).Scan(
 		&user.ID,
@@ -38,6 +42,7 @@ func (r *UserRepo) GetUserByEmail(ctx context.Context, email string) (*UserInfo,
 		&lastName,
 		&user.IsActive,
 		&user.EmailVerified,
+		&lockedUntil,
 	)
 
 	if err != nil {
@@ -53,6 +58,9 @@ func (r *UserRepo) GetUserByEmail(ctx context.Context, email string) (*UserInfo,
 	if lastName.Valid {
 		user.LastName = lastName.String
 	}
+	if lockedUntil.Valid {
+		user.LockedUntil = &lockedUntil.Time
+	}
 
 	return &user, nil
 }
@@ -60,12 +68,13 @@ func (r *UserRepo) GetUserByEmail(ctx context.Context, email string) (*UserInfo,
 // GetUserByPublicID retrieves user info by public ID (UUID string).
 func (r *UserRepo) GetUserByPublicID(ctx context.Context, publicID string) (*UserInfo, error) {
 	query := `
-		SELECT id, public_id, email, first_name, last_name, is_active, email_verified
+		SELECT id, public_id, COALESCE(email, ''), first_name, last_name, is_active, email_verified, locked_until
 		FROM altalune_users
-		WHERE public_id = $1
+		WHERE public_id = $1 AND deleted_at IS NULL AND user_type = 'human'
 	`
 	var user UserInfo
 	var firstName, lastName sql.NullString
+	var lockedUntil sql.NullTime
 
 	err := r.db.QueryRowContext(ctx, query, publicID).Scan(
 		&user.ID,
@@ -75,6 +84,7 @@ func (r *UserRepo) GetUserByPublicID(ctx context.Context, publicID string) (*Use
 		&lastName,
 		&user.IsActive,
 		&user.EmailVerified,
+		&lockedUntil,
 	)
 
 	if err != nil {
@@ -90,6 +100,9 @@ func (r *UserRepo) GetUserByPublicID(ctx context.Context, publicID string) (*Use
 	if lastName.Valid {
 		user.LastName = lastName.String
 	}
+	if lockedUntil.Valid {
+		user.LockedUntil = &lockedUntil.Time
+	}
 
 	return &user, nil
 }
@@ -97,12 +110,13 @@ func (r *UserRepo) GetUserByPublicID(ctx context.Context, publicID string) (*Use
 // GetUserByID retrieves user info by internal database ID.
 func (r *UserRepo) GetUserByID(ctx context.Context, userID int64) (*UserInfo, error) {
 	query := `
-		SELECT id, public_id, email, first_name, last_name, is_active, email_verified
+		SELECT id, public_id, COALESCE(email, ''), first_name, last_name, is_active, email_verified, locked_until
 		FROM altalune_users
-		WHERE id = $1
+		WHERE id = $1 AND deleted_at IS NULL AND user_type = 'human'
 	`
 	var user UserInfo
 	var firstName, lastName sql.NullString
+	var lockedUntil sql.NullTime
 
 	err := r.db.QueryRowContext(ctx, query, userID).Scan(
 		&user.ID,
@@ -112,6 +126,7 @@ func (r *UserRepo) GetUserByID(ctx context.Context, userID int64) (*UserInfo, er
 		&lastName,
 		&user.IsActive,
 		&user.EmailVerified,
+		&lockedUntil,
 	)
 
 	if err != nil {
@@ -127,6 +142,9 @@ func (r *UserRepo) GetUserByID(ctx context.Context, userID int64) (*UserInfo, er
 	if lastName.Valid {
 		user.LastName = lastName.String
 	}
+	if lockedUntil.Valid {
+		user.LockedUntil = &lockedUntil.Time
+	}
 
 	return &user, nil
 }
@@ -163,3 +181,49 @@ func (r *UserRepo) SetEmailVerified(ctx context.Context, userID int64, verified
 
 	return nil
 }
+
+// RecordFailedLogin counts a failed sign-in of a user. Reaching maxAttempts
+// locks the account for lockFor and starts the count over. Returns the end of
+// the account lock, which may be in the past, or nil when it was never locked.
+func (r *UserRepo) RecordFailedLogin(ctx context.Context, userID int64, maxAttempts int, lockFor time.Duration) (*time.Time, error) {
+	query := `
+		UPDATE altalune_users
+		SET failed_login_attempts = CASE
+		        WHEN failed_login_attempts + 1 >= $2 THEN 0
+		        ELSE failed_login_attempts + 1
+		    END,
+		    locked_until = CASE
+		        WHEN failed_login_attempts + 1 >= $2 THEN NOW() + make_interval(secs => $3)
+		        ELSE locked_until
+		    END
+		WHERE id = $1 AND deleted_at IS NULL
+		RETURNING locked_until
+	`
+	var lockedUntil sql.NullTime
+
+	err := r.db.QueryRowContext(ctx, query, userID, maxAttempts, lockFor.Seconds()).Scan(&lockedUntil)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("record failed login: %w", err)
+	}
+
+	if !lockedUntil.Valid {
+		return nil, nil
+	}
+	return &lockedUntil.Time, nil
+}
+
+// ResetFailedLogins clears the failed sign-in count and the lock of a user.
+func (r *UserRepo) ResetFailedLogins(ctx context.Context, userID int64) error {
+	query := `
+		UPDATE altalune_users
+		SET failed_login_attempts = 0, locked_until = NULL
+		WHERE id = $1 AND (failed_login_attempts > 0 OR locked_until IS NOT NULL)
+	`
+	if _, err := r.db.ExecContext(ctx, query, userID); err != nil {
+		return fmt.Errorf("reset failed logins: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package oauth_auth
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// InMemUserRepo is an in-memory UserLookupRepositor, UserLockoutRepositor and
+// UserEmailVerificationRepositor for tests. Users live in the user domain, so
+// tests seed the ones they need with PutUser.
+type InMemUserRepo struct {
+	mu     sync.RWMutex
+	users  []*UserInfo
+	failed map[int64]int
+}
+
+var (
+	_ UserLookupRepositor            = (*InMemUserRepo)(nil)
+	_ UserLockoutRepositor           = (*InMemUserRepo)(nil)
+	_ UserEmailVerificationRepositor = (*InMemUserRepo)(nil)
+)
+
+// NewInMemUserRepo creates an empty in-memory user repository
+func NewInMemUserRepo() *InMemUserRepo {
+	return &InMemUserRepo{failed: make(map[int64]int)}
+}
+
+// PutUser adds or replaces the user with user.ID
+func (r *InMemUserRepo) PutUser(user *UserInfo) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	u := *user
+	for i, existing := range r.users {
+		if existing.ID == u.ID {
+			r.users[i] = &u
+			return
+		}
+	}
+	r.users = append(r.users, &u)
+}
+
+func (r *InMemUserRepo) find(match func(u *UserInfo) bool) (*UserInfo, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, u := range r.users {
+		if match(u) {
+			found := *u
+			if u.LockedUntil != nil {
+				lockedUntil := *u.LockedUntil
+				found.LockedUntil = &lockedUntil
+			}
+			return &found, nil
+		}
+	}
+	return nil, ErrUserNotFound
+}
+
+func (r *InMemUserRepo) GetUserByEmail(ctx context.Context, email string) (*UserInfo, error) {
+	return r.find(func(u *UserInfo) bool { return strings.EqualFold(u.Email, email) })
+}
+
+func (r *InMemUserRepo) GetUserByPublicID(ctx context.Context, publicID string) (*UserInfo, error) {
+	return r.find(func(u *UserInfo) bool { return u.PublicID == publicID })
+}
+
+func (r *InMemUserRepo) GetUserByID(ctx context.Context, userID int64) (*UserInfo, error) {
+	return r.find(func(u *UserInfo) bool { return u.ID == userID })
+}
+
+func (r *InMemUserRepo) SetEmailVerified(ctx context.Context, userID int64, verified bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, u := range r.users {
+		if u.ID == userID {
+			u.EmailVerified = verified
+			return nil
+		}
+	}
+	return ErrUserNotFound
+}
+
+func (r *InMemUserRepo) RecordFailedLogin(ctx context.Context, userID int64, maxAttempts int, lockFor time.Duration) (*time.Time, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, u := range r.users {
+		if u.ID != userID {
+			continue
+		}
+		r.failed[userID]++
+		if r.failed[userID] >= maxAttempts {
+			r.failed[userID] = 0
+			lockedUntil := time.Now().Add(lockFor)
+			u.LockedUntil = &lockedUntil
+		}
+		if u.LockedUntil == nil {
+			return nil, nil
+		}
+		lockedUntil := *u.LockedUntil
+		return &lockedUntil, nil
+	}
+	return nil, ErrUserNotFound
+}
+
+func (r *InMemUserRepo) ResetFailedLogins(ctx context.Context, userID int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.failed, userID)
+	for _, u := range r.users {
+		if u.ID == userID {
+			u.LockedUntil = nil
+		}
+	}
+	return nil
+}
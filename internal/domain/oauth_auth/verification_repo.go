@@ -20,29 +20,37 @@ func NewEmailVerificationRepo(db postgres.DB) *EmailVerificationRepo {
 	return &EmailVerificationRepo{db: db}
 }
 
-// CreateVerificationToken stores a new email verification token hash in the database.
-func (r *EmailVerificationRepo) CreateVerificationToken(ctx context.Context, userID int64, tokenHash string, expiresAt time.Time) error {
+// CreateVerificationToken stores a new email verification token hash valid for
+// ttl and invalidates the pending token of the user in the same statement. The
+// expiry is computed by the database, whose clock every check uses.
+func (r *EmailVerificationRepo) CreateVerificationToken(ctx context.Context, userID int64, tokenHash string, ttl time.Duration) error {
 	query := `
+		WITH invalidated AS (
+			UPDATE altalune_email_verification_tokens
+			SET invalidated_at = NOW()
+			WHERE user_id = $1 AND used_at IS NULL AND invalidated_at IS NULL
+		)
 		INSERT INTO altalune_email_verification_tokens (user_id, token_hash, expires_at)
-		VALUES ($1, $2, $3)
+		VALUES ($1, $2, NOW() + make_interval(secs => $3))
 	`
-	_, err := r.db.ExecContext(ctx, query, userID, tokenHash, expiresAt)
+	_, err := r.db.ExecContext(ctx, query, userID, tokenHash, ttl.Seconds())
 	if err != nil {
 		return fmt.Errorf("create verification token: %w", err)
 	}
 	return nil
 }
 
-// GetValidToken retrieves a valid (unused, not expired) verification token by hash.
+// GetValidToken retrieves a valid (unused, not invalidated, not expired)
+// verification token by hash.
 func (r *EmailVerificationRepo) GetValidToken(ctx context.Context, tokenHash string) (*EmailVerificationToken, error) {
 	query := `
-		SELECT id, user_id, token_hash, expires_at, used_at, created_at
+		SELECT id, user_id, token_hash, expires_at, used_at, invalidated_at, created_at
 		FROM altalune_email_verification_tokens
-		WHERE token_hash = $1 AND used_at IS NULL AND expires_at > NOW()
+		WHERE token_hash = $1 AND used_at IS NULL AND invalidated_at IS NULL AND expires_at > NOW()
 	`
 	var token EmailVerificationToken
 	err := r.db.QueryRowContext(ctx, query, tokenHash).Scan(
-		&token.ID, &token.UserID, &token.TokenHash, &token.ExpiresAt, &token.UsedAt, &token.CreatedAt,
+		&token.ID, &token.UserID, &token.TokenHash, &token.ExpiresAt, &token.UsedAt, &token.InvalidatedAt, &token.CreatedAt,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -53,9 +61,13 @@ func (r *EmailVerificationRepo) GetValidToken(ctx context.Context, tokenHash str
 	return &token, nil
 }
 
-// MarkTokenUsed marks a verification token as used.
+// MarkTokenUsed marks a verification token as used, unless it was used,
+// invalidated or expired meanwhile.
 func (r *EmailVerificationRepo) MarkTokenUsed(ctx context.Context, id int64) error {
-	query := `UPDATE altalune_email_verification_tokens SET used_at = NOW() WHERE id = $1`
+	query := `
+		UPDATE altalune_email_verification_tokens SET used_at = NOW()
+		WHERE id = $1 AND used_at IS NULL AND invalidated_at IS NULL AND expires_at > NOW()
+	`
 	result, err := r.db.ExecContext(ctx, query, id)
 	if err != nil {
 		return fmt.Errorf("mark token used: %w", err)
@@ -67,12 +79,33 @@ func (r *EmailVerificationRepo) MarkTokenUsed(ctx context.Context, id int64) err
 	return nil
 }
 
-// InvalidateUserTokens marks all unused tokens for a user as used (invalidates them).
+// InvalidateUserTokens invalidates the pending token of a user.
 func (r *EmailVerificationRepo) InvalidateUserTokens(ctx context.Context, userID int64) error {
-	query := `UPDATE altalune_email_verification_tokens SET used_at = NOW() WHERE user_id = $1 AND used_at IS NULL`
+	query := `
+		UPDATE altalune_email_verification_tokens SET invalidated_at = NOW()
+		WHERE user_id = $1 AND used_at IS NULL AND invalidated_at IS NULL
+	`
 	_, err := r.db.ExecContext(ctx, query, userID)
 	if err != nil {
 		return fmt.Errorf("invalidate user tokens: %w", err)
 	}
 	return nil
 }
+
+// PurgeStale permanently removes tokens that were used, invalidated or expired
+// before the given time, returning how many were removed.
+func (r *EmailVerificationRepo) PurgeStale(ctx context.Context, before time.Time) (int64, error) {
+	query := `
+		DELETE FROM altalune_email_verification_tokens
+		WHERE used_at < $1 OR invalidated_at < $1 OR expires_at < $1
+	`
+	result, err := r.db.ExecContext(ctx, query, before)
+	if err != nil {
+		return 0, fmt.Errorf("purge stale verification tokens: %w", err)
+	}
+	purged, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("get rows affected: %w", err)
+	}
+	return purged, nil
+}
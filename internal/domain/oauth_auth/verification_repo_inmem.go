@@ -0,0 +1,118 @@
+package oauth_auth
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// InMemEmailVerificationRepo is an in-memory EmailVerificationRepositor for tests
+type InMemEmailVerificationRepo struct {
+	mu     sync.RWMutex
+	tokens []*EmailVerificationToken
+	lastID int64
+}
+
+var _ EmailVerificationRepositor = (*InMemEmailVerificationRepo)(nil)
+
+// NewInMemEmailVerificationRepo creates an empty in-memory email verification repository
+func NewInMemEmailVerificationRepo() *InMemEmailVerificationRepo {
+	return &InMemEmailVerificationRepo{}
+}
+
+func (r *InMemEmailVerificationRepo) CreateVerificationToken(ctx context.Context, userID int64, tokenHash string, ttl time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// token_hash is a unique column
+	for _, token := range r.tokens {
+		if token.TokenHash == tokenHash {
+			return fmt.Errorf("create verification token: duplicate token hash")
+		}
+	}
+
+	now := time.Now()
+	for _, token := range r.tokens {
+		if token.UserID == userID && token.UsedAt == nil && token.InvalidatedAt == nil {
+			token.InvalidatedAt = &now
+		}
+	}
+
+	r.lastID++
+	r.tokens = append(r.tokens, &EmailVerificationToken{
+		ID:        r.lastID,
+		UserID:    userID,
+		TokenHash: tokenHash,
+		ExpiresAt: now.Add(ttl),
+		CreatedAt: now,
+	})
+	return nil
+}
+
+func (r *InMemEmailVerificationRepo) GetValidToken(ctx context.Context, tokenHash string) (*EmailVerificationToken, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	now := time.Now()
+	for _, token := range r.tokens {
+		if token.TokenHash == tokenHash && token.active(now) {
+			found := *token
+			return &found, nil
+		}
+	}
+	return nil, ErrInvalidVerificationToken
+}
+
+func (r *InMemEmailVerificationRepo) MarkTokenUsed(ctx context.Context, id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, token := range r.tokens {
+		if token.ID == id {
+			now := time.Now()
+			if !token.active(now) {
+				return ErrInvalidVerificationToken
+			}
+			token.UsedAt = &now
+			return nil
+		}
+	}
+	return ErrInvalidVerificationToken
+}
+
+func (r *InMemEmailVerificationRepo) InvalidateUserTokens(ctx context.Context, userID int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	for _, token := range r.tokens {
+		if token.UserID == userID && token.UsedAt == nil && token.InvalidatedAt == nil {
+			token.InvalidatedAt = &now
+		}
+	}
+	return nil
+}
+
+func (r *InMemEmailVerificationRepo) PurgeStale(ctx context.Context, before time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	kept := r.tokens[:0]
+	for _, token := range r.tokens {
+		if (token.UsedAt != nil && token.UsedAt.Before(before)) ||
+			(token.InvalidatedAt != nil && token.InvalidatedAt.Before(before)) ||
+			token.ExpiresAt.Before(before) {
+			continue
+		}
+		kept = append(kept, token)
+	}
+	purged := int64(len(r.tokens) - len(kept))
+	r.tokens = kept
+	return purged, nil
+}
+
+// active reports whether the token can still verify an email
+func (t *EmailVerificationToken) active(now time.Time) bool {
+	return t.UsedAt == nil && t.InvalidatedAt == nil && t.ExpiresAt.After(now)
+}
@@ -3,7 +3,9 @@ package oauth_auth
 import (
 	"context"
 	"crypto/rand"
+	"crypto/subtle"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"time"
 
@@ -38,7 +40,7 @@ func NewEmailVerificationService(
 }
 
 // GenerateAndSendVerificationEmail creates a verification token and sends it via email.
-// Invalidates any existing tokens for the user before creating a new one.
+// Storing the new token invalidates the one sent before, so only the latest link works.
 func (s *EmailVerificationService) GenerateAndSendVerificationEmail(ctx context.Context, userID int64) error {
 	// Get user info
 	user, err := s.userRepo.GetUserByID(ctx, userID)
@@ -53,11 +55,6 @@ func (s *EmailVerificationService) GenerateAndSendVerificationEmail(ctx context.
 		return nil // Silently succeed - user is already verified
 	}
 
-	// Invalidate any existing tokens (ignore errors)
-	if err := s.repo.InvalidateUserTokens(ctx, userID); err != nil {
-		s.log.Warn("failed to invalidate existing tokens", "error", err, "userID", userID)
-	}
-
 	// Generate secure token (32 bytes = 256 bits of entropy)
 	token, err := generateSecureToken(32)
 	if err != nil {
@@ -68,8 +65,7 @@ func (s *EmailVerificationService) GenerateAndSendVerificationEmail(ctx context.
 	// Hash and store (never log the actual token)
 	tokenHash := hashToken(token)
 	tokenExpiry := time.Duration(s.cfg.GetVerificationTokenExpiryHours()) * time.Hour
-	expiresAt := time.Now().Add(tokenExpiry)
-	if err := s.repo.CreateVerificationToken(ctx, userID, tokenHash, expiresAt); err != nil {
+	if err := s.repo.CreateVerificationToken(ctx, userID, tokenHash, tokenExpiry); err != nil {
 		s.log.Error("failed to store verification token", "error", err, "userID", userID)
 		return fmt.Errorf("failed to store token: %w", err)
 	}
@@ -98,9 +94,17 @@ func (s *EmailVerificationService) VerifyEmail(ctx context.Context, token string
 		s.log.Debug("invalid verification token attempt")
 		return ErrInvalidVerificationToken
 	}
+	if subtle.ConstantTimeCompare([]byte(verificationToken.TokenHash), []byte(tokenHash)) != 1 {
+		s.log.Debug("invalid verification token attempt")
+		return ErrInvalidVerificationToken
+	}
 
-	// Mark token as used first (atomic operation)
+	// Mark token as used first (atomic operation); a token used or replaced
+	// since the lookup loses the race
 	if err := s.repo.MarkTokenUsed(ctx, verificationToken.ID); err != nil {
+		if errors.Is(err, ErrInvalidVerificationToken) {
+			return ErrInvalidVerificationToken
+		}
 		s.log.Error("failed to mark verification token as used", "error", err, "tokenID", verificationToken.ID)
 		return fmt.Errorf("failed to mark token as used: %w", err)
 	}
@@ -111,7 +115,10 @@ func (s *EmailVerificationService) VerifyEmail(ctx context.Context, token string
 		return fmt.Errorf("failed to update verification status: %w", err)
 	}
 
-	s.log.Info("email verified successfully", "userID", verificationToken.UserID)
+	s.log.Info("email verified successfully",
+		"userID", verificationToken.UserID,
+		"secondsToVerify", int64(time.Since(verificationToken.CreatedAt).Seconds()),
+	)
 	return nil
 }
 
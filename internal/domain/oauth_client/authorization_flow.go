@@ -0,0 +1,236 @@
+package oauth_client
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	"github.com/hrz8/altalune/internal/shared/jwt"
+	"github.com/hrz8/altalune/internal/shared/password"
+)
+
+// FlowStepOutcome is how a step of a simulated authorization flow ended
+type FlowStepOutcome string
+
+const (
+	FlowStepPassed  FlowStepOutcome = "passed"
+	FlowStepWarning FlowStepOutcome = "warning" // Passed, but likely not what the client wants
+	FlowStepFailed  FlowStepOutcome = "failed"  // The flow stops here
+	FlowStepSkipped FlowStepOutcome = "skipped" // Not reached after a failed step
+)
+
+func (o FlowStepOutcome) ToProto() altalunev1.AuthorizationFlowStepOutcome {
+	switch o {
+	case FlowStepPassed:
+		return altalunev1.AuthorizationFlowStepOutcome_AUTHORIZATION_FLOW_STEP_OUTCOME_PASSED
+	case FlowStepWarning:
+		return altalunev1.AuthorizationFlowStepOutcome_AUTHORIZATION_FLOW_STEP_OUTCOME_WARNING
+	case FlowStepFailed:
+		return altalunev1.AuthorizationFlowStepOutcome_AUTHORIZATION_FLOW_STEP_OUTCOME_FAILED
+	case FlowStepSkipped:
+		return altalunev1.AuthorizationFlowStepOutcome_AUTHORIZATION_FLOW_STEP_OUTCOME_SKIPPED
+	default:
+		return altalunev1.AuthorizationFlowStepOutcome_AUTHORIZATION_FLOW_STEP_OUTCOME_UNSPECIFIED
+	}
+}
+
+// FlowStep reports a step of a simulated authorization flow
+type FlowStep struct {
+	Name    string
+	Outcome FlowStepOutcome
+	Detail  string
+	Error   string // OAuth error the real flow answers with, empty unless failed
+}
+
+// AuthorizationFlowInput is what a client application sends through an
+// authorization code flow, along with what the server knows of the client
+type AuthorizationFlowInput struct {
+	Client              *OAuthClient
+	SecretHash          string // Hash of the client secret, empty for public clients
+	TokenClaims         *TokenClaims
+	RedirectURI         string
+	Scope               string
+	CodeChallengeMethod string // Empty when the application sends no code_challenge
+	ClientSecret        string // Sent to the token endpoint, empty if none
+}
+
+// AuthorizationFlowResult reports a simulated authorization flow
+type AuthorizationFlowResult struct {
+	Succeeded bool
+	Steps     []*FlowStep
+	Claims    []string // Access token claims a user would get, empty unless succeeded
+}
+
+// supportedScopes are the scopes the authorization server advertises in its
+// discovery document
+var supportedScopes = []string{"openid", "profile", "email", "offline_access"}
+
+// SimulateAuthorizationFlow runs the checks of the authorize and token
+// endpoints on the requests of a client application, for an active user who
+// consents. Steps after a failed one are skipped, as the real flow stops there.
+func SimulateAuthorizationFlow(in *AuthorizationFlowInput) *AuthorizationFlowResult {
+	client := in.Client
+	run := &flowRun{}
+
+	run.step("client", func() *FlowStep {
+		kind := "Public"
+		if client.Confidential {
+			kind = "Confidential"
+		}
+		pkce := "optional"
+		if client.PKCERequired {
+			pkce = "required"
+		}
+		return flowPassed(fmt.Sprintf("%s client %s, PKCE %s", kind, client.ClientID, pkce))
+	})
+
+	run.step("redirect_uri", func() *FlowStep {
+		if slices.Contains(client.RedirectURIs, in.RedirectURI) {
+			return flowPassed("Redirect URI is registered")
+		}
+		for _, registered := range client.RedirectURIs {
+			if strings.EqualFold(strings.TrimSuffix(registered, "/"), strings.TrimSuffix(in.RedirectURI, "/")) {
+				return flowFailed("invalid_redirect_uri", fmt.Sprintf("Redirect URI must match %s exactly", registered))
+			}
+		}
+		return flowFailed("invalid_redirect_uri", fmt.Sprintf("Redirect URI is not registered, the client has %s", strings.Join(client.RedirectURIs, ", ")))
+	})
+
+	run.step("pkce", func() *FlowStep {
+		switch in.CodeChallengeMethod {
+		case "":
+			if client.PKCERequired {
+				return flowFailed("invalid_request", "The client requires PKCE, but the request has no code_challenge")
+			}
+			return flowPassed("No code_challenge sent, PKCE is optional for the client")
+		case "plain":
+			return flowWarning("The plain method does not protect intercepted codes, use S256")
+		default:
+			return flowPassed("Code challenge sent with " + in.CodeChallengeMethod)
+		}
+	})
+
+	run.step("scope", func() *FlowStep {
+		scopes := strings.Fields(in.Scope)
+		if len(scopes) == 0 {
+			return flowWarning("No scope requested, the token carries no scope and the consent page shows openid")
+		}
+		var unsupported []string
+		for _, scope := range scopes {
+			if !slices.Contains(supportedScopes, scope) {
+				unsupported = append(unsupported, scope)
+			}
+		}
+		if len(unsupported) > 0 {
+			return flowWarning(fmt.Sprintf("Scopes %s are not supported and add no claims", strings.Join(unsupported, ", ")))
+		}
+		return flowPassed("Scopes are supported")
+	})
+
+	run.step("consent", func() *FlowStep {
+		return flowPassed("The synthetic user is active and consents")
+	})
+
+	run.step("authorization_code", func() *FlowStep {
+		return flowPassed("Code issued to the redirect URI")
+	})
+
+	run.step("client_authentication", func() *FlowStep {
+		if !client.Confidential {
+			if in.ClientSecret != "" {
+				return flowWarning("Public clients are identified by client_id only, the secret is ignored and must not ship in the application")
+			}
+			return flowPassed("Public client identified by client_id")
+		}
+		if in.ClientSecret == "" {
+			return flowFailed("invalid_client", "Confidential clients must send their client secret")
+		}
+		if in.SecretHash == "" {
+			return flowFailed("invalid_client", "The client has no secret, regenerate it")
+		}
+		if ok, err := password.VerifyPassword(in.ClientSecret, in.SecretHash); err != nil || !ok {
+			return flowFailed("invalid_client", "Client secret does not match")
+		}
+		return flowPassed("Client secret matches")
+	})
+
+	run.step("code_exchange", func() *FlowStep {
+		if in.CodeChallengeMethod != "" {
+			return flowPassed("Code exchanged with the same redirect URI and the code_verifier of the code_challenge")
+		}
+		return flowPassed("Code exchanged with the same redirect URI")
+	})
+
+	run.step("token", func() *FlowStep {
+		return flowPassed(fmt.Sprintf("Tokens issued with permissions carried as %s", permsClaimOf(in.TokenClaims)))
+	})
+
+	result := &AuthorizationFlowResult{Succeeded: !run.failed, Steps: run.steps}
+	if result.Succeeded {
+		result.Claims = accessTokenClaims(in.Scope, permsClaimOf(in.TokenClaims))
+	}
+	return result
+}
+
+// flowRun records the steps of a simulated flow
+type flowRun struct {
+	steps  []*FlowStep
+	failed bool
+}
+
+// step runs check unless a previous step failed
+func (r *flowRun) step(name string, check func() *FlowStep) {
+	step := &FlowStep{Outcome: FlowStepSkipped, Detail: "Not reached"}
+	if !r.failed {
+		step = check()
+		r.failed = step.Outcome == FlowStepFailed
+	}
+	step.Name = name
+	r.steps = append(r.steps, step)
+}
+
+func flowPassed(detail string) *FlowStep {
+	return &FlowStep{Outcome: FlowStepPassed, Detail: detail}
+}
+
+func flowWarning(detail string) *FlowStep {
+	return &FlowStep{Outcome: FlowStepWarning, Detail: detail}
+}
+
+func flowFailed(oauthErr, detail string) *FlowStep {
+	return &FlowStep{Outcome: FlowStepFailed, Detail: detail, Error: oauthErr}
+}
+
+func permsClaimOf(claims *TokenClaims) jwt.PermsClaimMode {
+	if claims == nil || claims.PermsClaim == "" {
+		return jwt.PermsClaimFull
+	}
+	return claims.PermsClaim
+}
+
+// accessTokenClaims returns the claims of the access token of a user with an
+// email and a name, granted scope
+func accessTokenClaims(scope string, mode jwt.PermsClaimMode) []string {
+	claims := []string{"iss", "sub", "aud", "exp", "iat", "nbf", "jti"}
+	scopes := strings.Fields(scope)
+	if len(scopes) > 0 {
+		claims = append(claims, "scope")
+	}
+	if slices.Contains(scopes, "email") {
+		claims = append(claims, "email")
+	}
+	if slices.Contains(scopes, "profile") {
+		claims = append(claims, "name")
+	}
+	claims = append(claims, "email_verified")
+	switch mode {
+	case jwt.PermsClaimOmit:
+		claims = append(claims, "perms_omitted")
+	case jwt.PermsClaimRoles:
+		claims = append(claims, "roles", "perms")
+	default:
+		claims = append(claims, "perms")
+	}
+	return append(claims, "memberships")
+}
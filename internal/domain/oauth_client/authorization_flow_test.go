@@ -0,0 +1,103 @@
+package oauth_client_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hrz8/altalune/internal/domain/oauth_client"
+	"github.com/hrz8/altalune/internal/shared/jwt"
+	"github.com/hrz8/altalune/internal/shared/password"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulateAuthorizationFlow(t *testing.T) {
+	public := &oauth_client.OAuthClient{
+		ClientID:     uuid.New(),
+		RedirectURIs: []string{"https://app.example.com/callback"},
+		PKCERequired: true,
+	}
+	outcomes := func(result *oauth_client.AuthorizationFlowResult) map[string]oauth_client.FlowStepOutcome {
+		byName := make(map[string]oauth_client.FlowStepOutcome, len(result.Steps))
+		for _, step := range result.Steps {
+			byName[step.Name] = step.Outcome
+		}
+		return byName
+	}
+
+	t.Run("succeeds", func(t *testing.T) {
+		result := oauth_client.SimulateAuthorizationFlow(&oauth_client.AuthorizationFlowInput{
+			Client:              public,
+			TokenClaims:         &oauth_client.TokenClaims{PermsClaim: jwt.PermsClaimRoles},
+			RedirectURI:         "https://app.example.com/callback",
+			Scope:               "openid email",
+			CodeChallengeMethod: "S256",
+		})
+		assert.True(t, result.Succeeded)
+		for _, step := range result.Steps {
+			assert.Equal(t, oauth_client.FlowStepPassed, step.Outcome, step.Name)
+		}
+		assert.Subset(t, result.Claims, []string{"scope", "email", "roles", "perms"})
+		assert.NotContains(t, result.Claims, "name", "only the profile scope adds the name")
+	})
+
+	t.Run("stops at a redirect URI not registered", func(t *testing.T) {
+		result := oauth_client.SimulateAuthorizationFlow(&oauth_client.AuthorizationFlowInput{
+			Client:              public,
+			RedirectURI:         "https://app.example.com/callback/",
+			CodeChallengeMethod: "S256",
+		})
+		assert.False(t, result.Succeeded)
+		assert.Empty(t, result.Claims)
+		assert.Equal(t, "invalid_redirect_uri", result.Steps[1].Error)
+		assert.Contains(t, result.Steps[1].Detail, "exactly", "near misses name the registered URI")
+		assert.Equal(t, oauth_client.FlowStepSkipped, outcomes(result)["token"])
+	})
+
+	t.Run("requires PKCE when the client does", func(t *testing.T) {
+		result := oauth_client.SimulateAuthorizationFlow(&oauth_client.AuthorizationFlowInput{
+			Client:      public,
+			RedirectURI: "https://app.example.com/callback",
+		})
+		assert.False(t, result.Succeeded)
+		assert.Equal(t, oauth_client.FlowStepFailed, outcomes(result)["pkce"])
+	})
+
+	t.Run("warns of unsupported scopes and plain PKCE", func(t *testing.T) {
+		result := oauth_client.SimulateAuthorizationFlow(&oauth_client.AuthorizationFlowInput{
+			Client:              public,
+			RedirectURI:         "https://app.example.com/callback",
+			Scope:               "openid admin",
+			CodeChallengeMethod: "plain",
+		})
+		assert.True(t, result.Succeeded, "warnings do not stop the flow")
+		assert.Equal(t, oauth_client.FlowStepWarning, outcomes(result)["scope"])
+		assert.Equal(t, oauth_client.FlowStepWarning, outcomes(result)["pkce"])
+	})
+
+	t.Run("authenticates confidential clients", func(t *testing.T) {
+		hash, err := password.HashPassword("s3cret", password.HashOption{Iterations: 1, Memory: 64, Threads: 1, Len: 32})
+		require.NoError(t, err)
+		confidential := &oauth_client.OAuthClient{
+			ClientID:     uuid.New(),
+			RedirectURIs: []string{"https://app.example.com/callback"},
+			Confidential: true,
+		}
+		simulate := func(secret string) *oauth_client.AuthorizationFlowResult {
+			return oauth_client.SimulateAuthorizationFlow(&oauth_client.AuthorizationFlowInput{
+				Client:       confidential,
+				SecretHash:   hash,
+				RedirectURI:  "https://app.example.com/callback",
+				Scope:        "openid",
+				ClientSecret: secret,
+			})
+		}
+
+		assert.True(t, simulate("s3cret").Succeeded)
+		for _, secret := range []string{"", "wrong"} {
+			result := simulate(secret)
+			assert.False(t, result.Succeeded)
+			assert.Equal(t, oauth_client.FlowStepFailed, outcomes(result)["client_authentication"])
+		}
+	})
+}
@@ -4,6 +4,7 @@ import "errors"
 
 var (
 	ErrOAuthClientNotFound          = errors.New("oauth client not found")
+	ErrOAuthClientVersionConflict   = errors.New("oauth client was modified concurrently")
 	ErrOAuthClientAlreadyExists     = errors.New("oauth client already exists")
 	ErrInvalidRedirectURI           = errors.New("invalid redirect URI")
 	ErrClientSecretMismatch         = errors.New("client secret does not match")
@@ -12,4 +13,7 @@ var (
 	ErrPKCECannotBeDisabled         = errors.New("PKCE cannot be disabled for default client")
 	ErrPublicClientNoSecret         = errors.New("public clients do not have a client secret")
 	ErrPublicClientPKCERequired     = errors.New("public clients require PKCE to be enabled")
+	ErrRefreshTokenNotFound         = errors.New("refresh token not found")
+	ErrExternalIDTaken              = errors.New("external id already identifies another oauth client")
+	ErrOAuthClientAlreadyManaged    = errors.New("oauth client is already managed under another external id")
 )
@@ -0,0 +1,14 @@
+package oauth_client
+
+import "github.com/google/uuid"
+
+// OAuthClientCreated is published when an OAuth client is created. Clients
+// are global, so the event has no project.
+type OAuthClientCreated struct {
+	ClientPublicID string    `json:"id"`
+	OAuthClientID  uuid.UUID `json:"oauth_client_id"`
+	Name           string    `json:"name"`
+	Confidential   bool      `json:"confidential"`
+}
+
+func (OAuthClientCreated) EventName() string { return "oauth_client.created" }
@@ -103,6 +103,23 @@ func (h *Handler) DeleteOAuthClient(
 	return connect.NewResponse(response), nil
 }
 
+// RestoreOAuthClient handles OAuth client restore requests
+func (h *Handler) RestoreOAuthClient(
+	ctx context.Context,
+	req *connect.Request[altalunev1.RestoreOAuthClientRequest],
+) (*connect.Response[altalunev1.RestoreOAuthClientResponse], error) {
+	// Authorization: requires client:delete permission (global - no project_id)
+	if err := h.auth.CheckPermission(ctx, "client:delete"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.RestoreOAuthClient(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
 // RevealOAuthClientSecret handles OAuth client secret reveal requests
 func (h *Handler) RevealOAuthClientSecret(
 	ctx context.Context,
@@ -119,3 +136,157 @@ func (h *Handler) RevealOAuthClientSecret(
 	}
 	return connect.NewResponse(response), nil
 }
+
+// RotateOAuthClientSecret handles OAuth client secret rotation requests
+func (h *Handler) RotateOAuthClientSecret(
+	ctx context.Context,
+	req *connect.Request[altalunev1.RotateOAuthClientSecretRequest],
+) (*connect.Response[altalunev1.RotateOAuthClientSecretResponse], error) {
+	// Authorization: requires client:write permission (global - no project_id)
+	if err := h.auth.CheckPermission(ctx, "client:write"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.RotateOAuthClientSecret(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+// QueryRefreshTokens handles refresh token query requests
+func (h *Handler) QueryRefreshTokens(
+	ctx context.Context,
+	req *connect.Request[altalunev1.QueryRefreshTokensRequest],
+) (*connect.Response[altalunev1.QueryRefreshTokensResponse], error) {
+	// Authorization: requires client:read permission (global - no project_id)
+	if err := h.auth.CheckPermission(ctx, "client:read"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.QueryRefreshTokens(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+// RevokeRefreshToken handles refresh token revocation requests
+func (h *Handler) RevokeRefreshToken(
+	ctx context.Context,
+	req *connect.Request[altalunev1.RevokeRefreshTokenRequest],
+) (*connect.Response[altalunev1.RevokeRefreshTokenResponse], error) {
+	// Authorization: requires client:write permission (global - no project_id)
+	if err := h.auth.CheckPermission(ctx, "client:write"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.RevokeRefreshToken(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+// GetOAuthClientTokenStats handles token stats requests
+func (h *Handler) GetOAuthClientTokenStats(
+	ctx context.Context,
+	req *connect.Request[altalunev1.GetOAuthClientTokenStatsRequest],
+) (*connect.Response[altalunev1.GetOAuthClientTokenStatsResponse], error) {
+	// Authorization: requires client:read permission (global - no project_id)
+	if err := h.auth.CheckPermission(ctx, "client:read"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.GetOAuthClientTokenStats(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+// GetOAuthClientTokenClaims handles token claims requests
+func (h *Handler) GetOAuthClientTokenClaims(
+	ctx context.Context,
+	req *connect.Request[altalunev1.GetOAuthClientTokenClaimsRequest],
+) (*connect.Response[altalunev1.GetOAuthClientTokenClaimsResponse], error) {
+	// Authorization: requires client:read permission (global - no project_id)
+	if err := h.auth.CheckPermission(ctx, "client:read"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.GetOAuthClientTokenClaims(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+// UpdateOAuthClientTokenClaims handles token claims update requests
+func (h *Handler) UpdateOAuthClientTokenClaims(
+	ctx context.Context,
+	req *connect.Request[altalunev1.UpdateOAuthClientTokenClaimsRequest],
+) (*connect.Response[altalunev1.UpdateOAuthClientTokenClaimsResponse], error) {
+	// Authorization: requires client:write permission (global - no project_id)
+	if err := h.auth.CheckPermission(ctx, "client:write"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.UpdateOAuthClientTokenClaims(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+// ApplyOAuthClient handles create-or-update requests by external ID
+func (h *Handler) ApplyOAuthClient(
+	ctx context.Context,
+	req *connect.Request[altalunev1.ApplyOAuthClientRequest],
+) (*connect.Response[altalunev1.ApplyOAuthClientResponse], error) {
+	// Authorization: requires client:write permission (global - no project_id)
+	if err := h.auth.CheckPermission(ctx, "client:write"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.ApplyOAuthClient(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+// ImportOAuthClient handles requests to manage an existing client by external ID
+func (h *Handler) ImportOAuthClient(
+	ctx context.Context,
+	req *connect.Request[altalunev1.ImportOAuthClientRequest],
+) (*connect.Response[altalunev1.ImportOAuthClientResponse], error) {
+	// Authorization: requires client:write permission (global - no project_id)
+	if err := h.auth.CheckPermission(ctx, "client:write"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.ImportOAuthClient(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+// TestAuthorizationFlow handles requests to simulate the authorization code
+// flow of a client in a sandbox project
+func (h *Handler) TestAuthorizationFlow(
+	ctx context.Context,
+	req *connect.Request[altalunev1.TestAuthorizationFlowRequest],
+) (*connect.Response[altalunev1.TestAuthorizationFlowResponse], error) {
+	// Authorization: requires client:write permission and sandbox project membership
+	if err := h.auth.CheckProjectAccess(ctx, "client:write", req.Msg.ProjectId); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.TestAuthorizationFlow(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
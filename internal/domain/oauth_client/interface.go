@@ -2,7 +2,9 @@ package oauth_client
 
 import (
 	"context"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/hrz8/altalune/internal/shared/query"
 )
 
@@ -21,12 +23,75 @@ type Repositor interface {
 	// GetByClientID retrieves an OAuth client by its UUID client_id (for OAuth flows)
 	GetByClientID(ctx context.Context, clientID string) (*OAuthClient, error)
 
+	// GetByExternalID retrieves an OAuth client by its key in a provisioning
+	// tool, trashed clients included
+	GetByExternalID(ctx context.Context, externalID string) (*OAuthClient, error)
+
+	// ListByName returns the live OAuth clients with a name, ignoring case
+	ListByName(ctx context.Context, name string) ([]*OAuthClient, error)
+
+	// SetExternalID sets the key of a live OAuth client in a provisioning tool
+	SetExternalID(ctx context.Context, publicID, externalID string) (*OAuthClient, error)
+
 	// Update updates an existing OAuth client
 	Update(ctx context.Context, input *UpdateOAuthClientInput) (*OAuthClient, error)
 
-	// Delete deletes an OAuth client (with default client protection)
+	// Delete moves an OAuth client to the trash (with default client protection)
 	Delete(ctx context.Context, publicID string) error
 
+	// Restore moves an OAuth client out of the trash
+	Restore(ctx context.Context, publicID string) (*OAuthClient, error)
+
+	// PurgeDeleted permanently removes OAuth clients trashed before the given time
+	PurgeDeleted(ctx context.Context, before time.Time) (int64, error)
+
 	// RevealClientSecret retrieves the hashed client secret (with audit logging)
 	RevealClientSecret(ctx context.Context, publicID string) (string, error)
+
+	// RotateSecret replaces the secret of a live confidential client with a
+	// new one expiring at expiresAt, nil for never
+	RotateSecret(ctx context.Context, publicID string, expiresAt *time.Time) (*CreateOAuthClientResult, error)
+
+	// ListSecrets returns the secrets of the live confidential clients
+	ListSecrets(ctx context.Context) ([]*ClientSecret, error)
+
+	// ExpireSecret brings the expiry of the secret of a live confidential
+	// client forward to expiresAt, unless it expires earlier
+	ExpireSecret(ctx context.Context, publicID string, expiresAt time.Time) error
+
+	// QueryRefreshTokens returns a paginated list of the refresh tokens matching
+	// filter, newest first unless params sort otherwise
+	QueryRefreshTokens(ctx context.Context, filter *RefreshTokenFilter, params *query.QueryParams) (*query.QueryResult[RefreshToken], error)
+
+	// RevokeRefreshToken revokes an active refresh token and returns it with
+	// whether it was revoked now. A token that is no longer active is returned
+	// unchanged.
+	RevokeRefreshToken(ctx context.Context, id int64) (*RefreshToken, bool, error)
+
+	// GetTokenClaims returns how the access tokens of a client carry
+	// permissions
+	GetTokenClaims(ctx context.Context, publicID string) (*TokenClaims, error)
+
+	// UpdateTokenClaims replaces how the access tokens of a client carry
+	// permissions
+	UpdateTokenClaims(ctx context.Context, input *UpdateTokenClaimsInput) (*TokenClaims, error)
+
+	// QueryTokenStats returns the hourly token request counts of a client in
+	// [from, to), oldest first. Hours without requests have no bucket.
+	QueryTokenStats(ctx context.Context, clientID uuid.UUID, from, to time.Time) ([]*TokenStatsBucket, error)
+
+	// SumTokenStats returns the token request counts of every client with
+	// requests in [from, to)
+	SumTokenStats(ctx context.Context, from, to time.Time) ([]*ClientTokenStats, error)
+
+	// PurgeTokenStats permanently removes the token request counts of the hours
+	// before the given time
+	PurgeTokenStats(ctx context.Context, before time.Time) (int64, error)
+}
+
+// PlanLimiter enforces the client limit of the billing plan
+type PlanLimiter interface {
+	// CheckOAuthClientAdd fails when there are as many OAuth clients as the
+	// plan allows
+	CheckOAuthClientAdd(ctx context.Context) error
 }
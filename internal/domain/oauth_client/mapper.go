@@ -1,14 +1,17 @@
 package oauth_client
 
 import (
+	"strings"
+
 	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	"github.com/hrz8/altalune/internal/shared/jwt"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // ToOAuthClientProto converts domain model to protobuf message
 // OAuth clients are GLOBAL entities (not project-scoped)
 func (c *OAuthClient) ToOAuthClientProto() *altalunev1.OAuthClient {
-	return &altalunev1.OAuthClient{
+	client := &altalunev1.OAuthClient{
 		Id:              c.ID,
 		Name:            c.Name,
 		ClientId:        c.ClientID.String(),
@@ -20,5 +23,90 @@ func (c *OAuthClient) ToOAuthClientProto() *altalunev1.OAuthClient {
 		Confidential:    c.Confidential,
 		CreatedAt:       timestamppb.New(c.CreatedAt),
 		UpdatedAt:       timestamppb.New(c.UpdatedAt),
+		CreatedBy:       c.CreatedBy,
+		UpdatedBy:       c.UpdatedBy,
+		ExternalId:      c.ExternalID,
+	}
+	if c.DeletedAt != nil {
+		client.DeletedAt = timestamppb.New(*c.DeletedAt)
+	}
+	if c.SecretExpiresAt != nil {
+		client.ClientSecretExpiresAt = timestamppb.New(*c.SecretExpiresAt)
+	}
+	return client
+}
+
+// ToRefreshTokenProto converts a refresh token to its protobuf message
+func (t *RefreshToken) ToRefreshTokenProto() *altalunev1.RefreshToken {
+	token := &altalunev1.RefreshToken{
+		Id:         t.ID,
+		UserId:     t.UserID,
+		UserEmail:  t.UserEmail,
+		ClientId:   t.ClientID,
+		ClientName: t.ClientName,
+		Scopes:     strings.Fields(t.Scope),
+		Status:     t.Status.ToProto(),
+		ExpiresAt:  timestamppb.New(t.ExpiresAt),
+		CreatedAt:  timestamppb.New(t.CreatedAt),
+	}
+	if t.ExchangedAt != nil {
+		token.ExchangedAt = timestamppb.New(*t.ExchangedAt)
+	}
+	if t.RevokedAt != nil {
+		token.RevokedAt = timestamppb.New(*t.RevokedAt)
+	}
+	return token
+}
+
+// ToTokenStatsBucketProto converts an hour of token requests to its protobuf message
+func (b *TokenStatsBucket) ToTokenStatsBucketProto() *altalunev1.TokenStatsBucket {
+	return &altalunev1.TokenStatsBucket{
+		Hour:   timestamppb.New(b.Hour),
+		Issued: b.Issued,
+		Failed: b.Failed,
+	}
+}
+
+// ToAuthorizationFlowStepProto converts a step of a simulated authorization
+// flow to its protobuf message
+func (s *FlowStep) ToAuthorizationFlowStepProto() *altalunev1.AuthorizationFlowStep {
+	return &altalunev1.AuthorizationFlowStep{
+		Name:    s.Name,
+		Outcome: s.Outcome.ToProto(),
+		Detail:  s.Detail,
+		Error:   s.Error,
+	}
+}
+
+// ToOAuthClientTokenClaimsProto converts token claims to their protobuf message
+func (c *TokenClaims) ToOAuthClientTokenClaimsProto() *altalunev1.OAuthClientTokenClaims {
+	return &altalunev1.OAuthClientTokenClaims{
+		PermsClaim:         permsClaimModeToProto(c.PermsClaim),
+		PermsClaimMaxBytes: int32(c.PermsMaxBytes),
+	}
+}
+
+func permsClaimModeToProto(mode jwt.PermsClaimMode) altalunev1.PermsClaimMode {
+	switch mode {
+	case jwt.PermsClaimFull:
+		return altalunev1.PermsClaimMode_PERMS_CLAIM_MODE_FULL
+	case jwt.PermsClaimOmit:
+		return altalunev1.PermsClaimMode_PERMS_CLAIM_MODE_OMIT
+	case jwt.PermsClaimRoles:
+		return altalunev1.PermsClaimMode_PERMS_CLAIM_MODE_ROLES
+	default:
+		return altalunev1.PermsClaimMode_PERMS_CLAIM_MODE_UNSPECIFIED
+	}
+}
+
+// permsClaimModeFromProto converts a requested mode, unspecified being full
+func permsClaimModeFromProto(mode altalunev1.PermsClaimMode) jwt.PermsClaimMode {
+	switch mode {
+	case altalunev1.PermsClaimMode_PERMS_CLAIM_MODE_OMIT:
+		return jwt.PermsClaimOmit
+	case altalunev1.PermsClaimMode_PERMS_CLAIM_MODE_ROLES:
+		return jwt.PermsClaimRoles
+	default:
+		return jwt.PermsClaimFull
 	}
 }
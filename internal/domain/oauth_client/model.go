@@ -4,44 +4,57 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/hrz8/altalune/internal/shared/jwt"
 )
 
 // OAuthClientQueryResult represents query result with internal database ID
 // OAuth clients are GLOBAL entities (infrastructure-level, like Auth0 Applications)
 type OAuthClientQueryResult struct {
-	ID           int64  // Internal database ID
-	PublicID     string // Public nanoid
-	Name         string
-	ClientID     uuid.UUID // OAuth client_id (UUID)
-	RedirectURIs []string
-	PKCERequired bool
-	IsDefault    bool
-	Confidential bool // true = requires secret (confidential), false = public/SPA
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
+	ID              int64  // Internal database ID
+	PublicID        string // Public nanoid
+	Name            string
+	ClientID        uuid.UUID // OAuth client_id (UUID)
+	RedirectURIs    []string
+	PKCERequired    bool
+	IsDefault       bool
+	Confidential    bool // true = requires secret (confidential), false = public/SPA
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	CreatedBy       string     // Public ID of the creator, empty if unknown
+	UpdatedBy       string     // Public ID of the last updater, empty if unknown
+	DeletedAt       *time.Time // Set only for trashed clients
+	ExternalID      string     // Key in a provisioning tool, empty if unmanaged
+	SecretExpiresAt *time.Time // When the secret stops authenticating the client, nil for never
 }
 
 // OAuthClient represents the domain model with public IDs only
 // OAuth clients are GLOBAL entities (infrastructure-level, like Auth0 Applications)
 type OAuthClient struct {
-	ID           string // Public nanoid
-	Name         string
-	ClientID     uuid.UUID // OAuth client_id (UUID)
-	RedirectURIs []string
-	PKCERequired bool
-	IsDefault    bool
-	Confidential bool // true = requires secret (confidential), false = public/SPA
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
+	ID              string // Public nanoid
+	Name            string
+	ClientID        uuid.UUID // OAuth client_id (UUID)
+	RedirectURIs    []string
+	PKCERequired    bool
+	IsDefault       bool
+	Confidential    bool // true = requires secret (confidential), false = public/SPA
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	CreatedBy       string     // Public ID of the creator, empty if unknown
+	UpdatedBy       string     // Public ID of the last updater, empty if unknown
+	DeletedAt       *time.Time // Set only for trashed clients
+	ExternalID      string     // Key in a provisioning tool, empty if unmanaged
+	SecretExpiresAt *time.Time // When the secret stops authenticating the client, nil for never
 }
 
 // CreateOAuthClientInput represents input for creating an OAuth client
 type CreateOAuthClientInput struct {
-	Name          string
-	RedirectURIs  []string
-	PKCERequired  bool
-	AllowedScopes []string
-	Confidential  bool // true = requires secret (confidential), false = public/SPA
+	Name            string
+	RedirectURIs    []string
+	PKCERequired    bool
+	AllowedScopes   []string
+	Confidential    bool       // true = requires secret (confidential), false = public/SPA
+	ExternalID      string     // Key in a provisioning tool, empty if unmanaged
+	SecretExpiresAt *time.Time // When the secret of a confidential client stops authenticating it, nil for never
 }
 
 // CreateOAuthClientResult represents the result of creating an OAuth client
@@ -52,24 +65,91 @@ type CreateOAuthClientResult struct {
 
 // UpdateOAuthClientInput represents input for updating an OAuth client
 type UpdateOAuthClientInput struct {
-	PublicID      string
-	Name          *string
-	RedirectURIs  []string
-	PKCERequired  *bool
-	AllowedScopes []string
+	PublicID          string
+	Name              *string
+	RedirectURIs      []string
+	PKCERequired      *bool
+	AllowedScopes     []string
+	ExpectedUpdatedAt *time.Time // Reject the update unless updated_at still matches; nil to skip
 }
 
 // ToOAuthClient converts query result to domain model (hides internal IDs)
 func (r *OAuthClientQueryResult) ToOAuthClient() *OAuthClient {
 	return &OAuthClient{
-		ID:           r.PublicID,
-		Name:         r.Name,
-		ClientID:     r.ClientID,
-		RedirectURIs: r.RedirectURIs,
-		PKCERequired: r.PKCERequired,
-		IsDefault:    r.IsDefault,
-		Confidential: r.Confidential,
-		CreatedAt:    r.CreatedAt,
-		UpdatedAt:    r.UpdatedAt,
+		ID:              r.PublicID,
+		Name:            r.Name,
+		ClientID:        r.ClientID,
+		RedirectURIs:    r.RedirectURIs,
+		PKCERequired:    r.PKCERequired,
+		IsDefault:       r.IsDefault,
+		Confidential:    r.Confidential,
+		CreatedAt:       r.CreatedAt,
+		UpdatedAt:       r.UpdatedAt,
+		CreatedBy:       r.CreatedBy,
+		UpdatedBy:       r.UpdatedBy,
+		DeletedAt:       r.DeletedAt,
+		ExternalID:      r.ExternalID,
+		SecretExpiresAt: r.SecretExpiresAt,
 	}
 }
+
+// ClientSecret is the secret of a live confidential client, as the secret
+// policy job sees it
+type ClientSecret struct {
+	PublicID  string // Public nanoid of the client
+	Name      string
+	SetAt     time.Time  // When the secret was generated, on creation or rotation
+	ExpiresAt *time.Time // nil for never
+}
+
+// RefreshToken is a refresh token issued to a client, as administrators see
+// it. Only a hash of the token is stored, so the token itself is never shown.
+type RefreshToken struct {
+	ID          int64  // Internal database ID, the only handle on a token
+	UserID      string // Public ID of the user
+	UserEmail   string
+	ClientID    string // Public nanoid of the client, empty if it was purged
+	ClientName  string
+	Scope       string
+	Status      RefreshTokenStatus
+	ExpiresAt   time.Time
+	ExchangedAt *time.Time // When it was exchanged for a new token or revoked
+	RevokedAt   *time.Time
+	CreatedAt   time.Time
+}
+
+// RefreshTokenFilter selects the refresh tokens of a user, a client or both.
+// Empty fields match every token.
+type RefreshTokenFilter struct {
+	UserID   string // Public ID of the user
+	ClientID string // Public nanoid of the client
+}
+
+// TokenStatsBucket counts the token endpoint requests of a client in an hour
+type TokenStatsBucket struct {
+	Hour   time.Time // Start of the hour, in UTC
+	Issued int64     // Requests that issued tokens
+	Failed int64     // Requests that were rejected
+}
+
+// ClientTokenStats totals the token endpoint requests of a client over a range
+// of hours
+type ClientTokenStats struct {
+	ClientID uuid.UUID // OAuth client_id (UUID)
+	PublicID string    // Public nanoid, empty if the client was purged
+	Name     string
+	Issued   int64
+	Failed   int64
+}
+
+// TokenClaims is how the access tokens of a client carry the permissions of
+// their user, slimmed for gateways limiting header sizes
+type TokenClaims struct {
+	PermsClaim    jwt.PermsClaimMode
+	PermsMaxBytes int // Cap on the encoded perms claim, 0 for none
+}
+
+type UpdateTokenClaimsInput struct {
+	PublicID string
+	TokenClaims
+}
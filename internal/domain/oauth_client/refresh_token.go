@@ -0,0 +1,91 @@
+package oauth_client
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+)
+
+// RefreshTokenStatus is the status of a refresh token, derived from when it
+// was exchanged, revoked and expires. Its values are the ones of the
+// "statuses" filter.
+type RefreshTokenStatus string
+
+const (
+	RefreshTokenStatusActive    RefreshTokenStatus = "active"
+	RefreshTokenStatusExchanged RefreshTokenStatus = "exchanged"
+	RefreshTokenStatusRevoked   RefreshTokenStatus = "revoked"
+	RefreshTokenStatusExpired   RefreshTokenStatus = "expired"
+)
+
+// RefreshTokenStatuses lists every status, in the order filters offer them
+var RefreshTokenStatuses = []RefreshTokenStatus{
+	RefreshTokenStatusActive,
+	RefreshTokenStatusExchanged,
+	RefreshTokenStatusRevoked,
+	RefreshTokenStatusExpired,
+}
+
+// RefreshTokenStatusValues returns RefreshTokenStatuses as filter values
+func RefreshTokenStatusValues() []string {
+	values := make([]string, len(RefreshTokenStatuses))
+	for i, status := range RefreshTokenStatuses {
+		values[i] = string(status)
+	}
+	return values
+}
+
+// ComputeRefreshTokenStatus returns the status at now of a token. A revoked
+// token is also exchanged, so revocation is checked first; a token used or
+// revoked before it expired keeps that status after.
+func ComputeRefreshTokenStatus(exchangedAt, revokedAt *time.Time, expiresAt, now time.Time) RefreshTokenStatus {
+	switch {
+	case revokedAt != nil:
+		return RefreshTokenStatusRevoked
+	case exchangedAt != nil:
+		return RefreshTokenStatusExchanged
+	case !expiresAt.After(now):
+		return RefreshTokenStatusExpired
+	default:
+		return RefreshTokenStatusActive
+	}
+}
+
+// refreshTokenStatusSQL returns the CASE expression computing
+// ComputeRefreshTokenStatus in SQL from the columns of the t alias
+func refreshTokenStatusSQL() string {
+	return fmt.Sprintf(`(CASE
+		WHEN t.revoked_at IS NOT NULL THEN '%[1]s'
+		WHEN t.exchange_at IS NOT NULL THEN '%[2]s'
+		WHEN t.expires_at <= NOW() THEN '%[3]s'
+		ELSE '%[4]s'
+	END)`, RefreshTokenStatusRevoked, RefreshTokenStatusExchanged, RefreshTokenStatusExpired, RefreshTokenStatusActive)
+}
+
+// ParseRefreshTokenStatus parses a filter value, ignoring case
+func ParseRefreshTokenStatus(value string) (RefreshTokenStatus, bool) {
+	status := RefreshTokenStatus(strings.ToLower(value))
+	for _, s := range RefreshTokenStatuses {
+		if s == status {
+			return status, true
+		}
+	}
+	return "", false
+}
+
+func (s RefreshTokenStatus) ToProto() altalunev1.RefreshTokenStatus {
+	switch s {
+	case RefreshTokenStatusActive:
+		return altalunev1.RefreshTokenStatus_REFRESH_TOKEN_STATUS_ACTIVE
+	case RefreshTokenStatusExchanged:
+		return altalunev1.RefreshTokenStatus_REFRESH_TOKEN_STATUS_EXCHANGED
+	case RefreshTokenStatusRevoked:
+		return altalunev1.RefreshTokenStatus_REFRESH_TOKEN_STATUS_REVOKED
+	case RefreshTokenStatusExpired:
+		return altalunev1.RefreshTokenStatus_REFRESH_TOKEN_STATUS_EXPIRED
+	default:
+		return altalunev1.RefreshTokenStatus_REFRESH_TOKEN_STATUS_UNSPECIFIED
+	}
+}
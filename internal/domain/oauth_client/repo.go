@@ -6,103 +6,110 @@ import (
 	"database/sql"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/hrz8/altalune/internal/auth"
 	"github.com/hrz8/altalune/internal/postgres"
-	"github.com/hrz8/altalune/internal/shared/nanoid"
 	"github.com/hrz8/altalune/internal/shared/password"
 	"github.com/hrz8/altalune/internal/shared/query"
+	"github.com/hrz8/altalune/internal/shared/secretpolicy"
 	"github.com/lib/pq"
 )
 
 type repo struct {
-	db postgres.DB
+	db         postgres.DB
+	hashOption password.HashOption
 }
 
-// NewRepo creates a new OAuth client repository
-func NewRepo(db postgres.DB) Repositor {
-	return &repo{db: db}
+// NewRepo creates a new OAuth client repository hashing secrets with hashOption
+func NewRepo(db postgres.DB, hashOption password.HashOption) Repositor {
+	return &repo{db: db, hashOption: hashOption}
 }
 
 // Create creates a new OAuth client with generated client_id and hashed secret
 // OAuth clients are GLOBAL entities (not project-scoped)
 // For public clients (Confidential=false), no secret is generated
 func (r *repo) Create(ctx context.Context, input *CreateOAuthClientInput) (*CreateOAuthClientResult, error) {
-	// 1. Generate public ID (nanoid)
-	publicID, err := nanoid.GeneratePublicID()
-	if err != nil {
-		return nil, fmt.Errorf("generate public id: %w", err)
-	}
-
-	// 2. Generate UUID client_id for OAuth flow
+	// 1. Generate UUID client_id for OAuth flow
 	clientID := uuid.New()
 
-	// 3. Generate secret ONLY for confidential clients
+	// 2. Generate secret ONLY for confidential clients
 	var clientSecret string
 	var hashedSecret *string // Nullable for public clients
 
 	if input.Confidential {
 		// Confidential client: generate and hash secret
-		clientSecret = generateSecureRandom(32)
-
-		// Hash secret with Argon2id (using production parameters from T19/oauth_seeder)
-		hash, err := password.HashPassword(clientSecret, password.HashOption{
-			Iterations: 2,         // Time cost
-			Memory:     64 * 1024, // 64MB
-			Threads:    4,         // Parallelism
-			Len:        32,        // Hash length
-		})
+		secret, hash, err := r.newSecret(ctx)
 		if err != nil {
-			return nil, fmt.Errorf("hash client secret: %w", err)
+			return nil, err
 		}
-		hashedSecret = &hash
+		clientSecret, hashedSecret = secret, &hash
 	}
 	// Public clients: no secret generated (hashedSecret remains nil)
+	secretExpiresAt := input.SecretExpiresAt
+	if !input.Confidential {
+		secretExpiresAt = nil
+	}
 
-	// 4. Insert into global table (no partitioning)
+	// 3. Insert into global table (no partitioning), retrying on public ID collisions
 	insertQuery := `
 		INSERT INTO altalune_oauth_clients (
 			public_id, name, client_id,
-			client_secret_hash, redirect_uris, pkce_required, is_default, confidential
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+			client_secret_hash, redirect_uris, pkce_required, is_default, confidential,
+			created_by, updated_by, external_id, client_secret_expires_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NULLIF($9, ''), NULLIF($9, ''), NULLIF($10, ''), $11)
 		RETURNING id, created_at, updated_at
 	`
 
 	var id int64
 	var createdAt, updatedAt sql.NullTime
-
-	err = r.db.QueryRowContext(ctx, insertQuery,
-		publicID,
-		input.Name,
-		clientID,
-		hashedSecret, // NULL for public clients
-		pq.Array(input.RedirectURIs),
-		input.PKCERequired,
-		false,              // is_default (always false for user-created clients)
-		input.Confidential, // true = confidential, false = public/SPA
-	).Scan(&id, &createdAt, &updatedAt)
+	actorID := auth.ActorID(ctx)
+
+	publicID, err := postgres.InsertWithPublicID(func(publicID string) error {
+		return r.db.QueryRowContext(ctx, insertQuery,
+			publicID,
+			input.Name,
+			clientID,
+			hashedSecret, // NULL for public clients
+			pq.Array(input.RedirectURIs),
+			input.PKCERequired,
+			false,              // is_default (always false for user-created clients)
+			input.Confidential, // true = confidential, false = public/SPA
+			actorID,
+			input.ExternalID,
+			secretExpiresAt,
+		).Scan(&id, &createdAt, &updatedAt)
+	})
 
 	if err != nil {
-		if postgres.IsUniqueViolation(err) {
+		if postgres.IsExternalIDViolation(err) {
+			return nil, ErrExternalIDTaken
+		}
+		if postgres.IsUniqueViolation(err) && !postgres.IsPublicIDViolation(err) {
 			return nil, ErrOAuthClientAlreadyExists
 		}
 		return nil, fmt.Errorf("insert oauth client: %w", err)
 	}
 
-	// 5. Build domain model
+	// 4. Build domain model
 	client := &OAuthClient{
-		ID:           publicID,
-		Name:         input.Name,
-		ClientID:     clientID,
-		RedirectURIs: input.RedirectURIs,
-		PKCERequired: input.PKCERequired,
-		IsDefault:    false,
-		Confidential: input.Confidential,
-		CreatedAt:    createdAt.Time,
-		UpdatedAt:    updatedAt.Time,
-	}
-
-	// 6. Return client with PLAINTEXT secret (ONLY time it's returned)
+		ID:              publicID,
+		Name:            input.Name,
+		ClientID:        clientID,
+		RedirectURIs:    input.RedirectURIs,
+		PKCERequired:    input.PKCERequired,
+		IsDefault:       false,
+		Confidential:    input.Confidential,
+		CreatedAt:       createdAt.Time,
+		UpdatedAt:       updatedAt.Time,
+		CreatedBy:       actorID,
+		UpdatedBy:       actorID,
+		ExternalID:      input.ExternalID,
+		SecretExpiresAt: secretExpiresAt,
+	}
+
+	// 5. Return client with PLAINTEXT secret (ONLY time it's returned)
 	// For public clients, ClientSecret will be empty string
 	return &CreateOAuthClientResult{
 		Client:       client,
@@ -112,15 +119,36 @@ func (r *repo) Create(ctx context.Context, input *CreateOAuthClientInput) (*Crea
 
 // Query returns a paginated list of all OAuth clients (global)
 func (r *repo) Query(ctx context.Context, params *query.QueryParams) (*query.QueryResult[OAuthClient], error) {
+	// Sorting (default: created_at DESC)
+	sortKey := postgres.SortKey{Expr: "created_at", Desc: true}
+	if params.Sorting != nil && params.Sorting.Field != "" {
+		// Validate sort column
+		switch params.Sorting.Field {
+		case "name", "created_at", "updated_at":
+			sortKey = postgres.NewSortKey(params.Sorting.Field, params.Sorting)
+		case "deleted_at":
+			sortKey = postgres.NewSortKey("COALESCE(deleted_at, 'infinity')", params.Sorting)
+		}
+	}
+
 	// Base query WITHOUT client_secret_hash (security: never expose secret hash)
 	baseQuery := `
 		SELECT id, public_id, name, client_id,
 		       redirect_uris, pkce_required, is_default, confidential,
-		       created_at, updated_at
+		       created_at, updated_at, deleted_at,
+		       COALESCE(created_by, ''), COALESCE(updated_by, ''), COALESCE(external_id, ''), client_secret_expires_at,
+		       ` + sortKey.Select() + `
 		FROM altalune_oauth_clients
 		WHERE 1=1
 	`
 
+	// Trashed queries list soft-deleted clients only
+	if params.Trashed {
+		baseQuery += " AND deleted_at IS NOT NULL"
+	} else {
+		baseQuery += " AND deleted_at IS NULL"
+	}
+
 	var whereConditions []string
 	var args []any
 	argCounter := 1
@@ -197,34 +225,24 @@ func (r *repo) Query(ctx context.Context, params *query.QueryParams) (*query.Que
 	}
 
 	// Get total count BEFORE pagination
-	countQuery := "SELECT COUNT(*) FROM (" + baseQuery + ") as filtered"
-	var totalRows int32
-	err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&totalRows)
+	count, err := postgres.CountRows(ctx, r.db, params.Count, baseQuery, args)
 	if err != nil {
 		return nil, fmt.Errorf("count oauth clients: %w", err)
 	}
 
-	// Add sorting (default: created_at DESC)
-	orderBy := "created_at DESC"
-	if params.Sorting != nil && params.Sorting.Field != "" {
-		direction := "ASC"
-		if params.Sorting.Order == query.SortOrderDesc {
-			direction = "DESC"
-		}
-		// Validate sort column
-		switch params.Sorting.Field {
-		case "name", "created_at", "updated_at":
-			orderBy = fmt.Sprintf("%s %s", params.Sorting.Field, direction)
-		}
+	// Keyset pages start past the last row already fetched
+	if condition, keysetArgs := sortKey.After(params.Keyset, argCounter); condition != "" {
+		baseQuery += " AND " + condition
+		args = append(args, keysetArgs...)
+		argCounter += len(keysetArgs)
 	}
-	baseQuery += fmt.Sprintf(" ORDER BY %s", orderBy)
 
-	// Add pagination
-	pageSize := params.Pagination.PageSize
-	offset := (params.Pagination.Page - 1) * pageSize
+	// Add sorting
+	baseQuery += sortKey.OrderBy()
 
+	// Add pagination
 	baseQuery += fmt.Sprintf(" LIMIT $%d OFFSET $%d", argCounter, argCounter+1)
-	args = append(args, pageSize, offset)
+	args = append(args, params.PageLimit(), params.FetchOffset())
 
 	// Execute query
 	rows, err := r.db.QueryContext(ctx, baseQuery, args...)
@@ -235,6 +253,7 @@ func (r *repo) Query(ctx context.Context, params *query.QueryParams) (*query.Que
 
 	// Scan rows
 	data := make([]*OAuthClient, 0)
+	var next query.Keyset
 	for rows.Next() {
 		var result OAuthClientQueryResult
 		var redirectURIs pq.StringArray
@@ -250,10 +269,17 @@ func (r *repo) Query(ctx context.Context, params *query.QueryParams) (*query.Que
 			&result.Confidential,
 			&result.CreatedAt,
 			&result.UpdatedAt,
+			&result.DeletedAt,
+			&result.CreatedBy,
+			&result.UpdatedBy,
+			&result.ExternalID,
+			&result.SecretExpiresAt,
+			&next.SortKey,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("scan oauth client: %w", err)
 		}
+		next.AfterID = result.ID
 
 		result.RedirectURIs = []string(redirectURIs)
 
@@ -264,18 +290,16 @@ func (r *repo) Query(ctx context.Context, params *query.QueryParams) (*query.Que
 		return nil, fmt.Errorf("rows error: %w", err)
 	}
 
-	// Calculate page count
-	pageCount := (totalRows + pageSize - 1) / pageSize
-	if pageCount == 0 {
-		pageCount = 1
+	queryResult := &query.QueryResult[OAuthClient]{
+		Data:    data,
+		Filters: params.Filters,
 	}
-
-	return &query.QueryResult[OAuthClient]{
-		Data:       data,
-		TotalRows:  totalRows,
-		TotalPages: pageCount,
-		Filters:    params.Filters,
-	}, nil
+	query.FillPage(queryResult, params, count)
+	queryResult.TotalPages = max(queryResult.TotalPages, 1)
+	if len(data) > 0 {
+		queryResult.NextKeyset = &next
+	}
+	return queryResult, nil
 }
 
 // GetByPublicID retrieves an OAuth client by its public nanoid (global)
@@ -284,9 +308,10 @@ func (r *repo) GetByPublicID(ctx context.Context, publicID string) (*OAuthClient
 	selectQuery := `
 		SELECT id, public_id, name, client_id,
 		       redirect_uris, pkce_required, is_default, confidential,
-		       created_at, updated_at
+		       created_at, updated_at,
+		       COALESCE(created_by, ''), COALESCE(updated_by, ''), COALESCE(external_id, ''), client_secret_expires_at
 		FROM altalune_oauth_clients
-		WHERE public_id = $1
+		WHERE public_id = $1 AND deleted_at IS NULL
 	`
 
 	var result OAuthClientQueryResult
@@ -303,6 +328,10 @@ func (r *repo) GetByPublicID(ctx context.Context, publicID string) (*OAuthClient
 		&result.Confidential,
 		&result.CreatedAt,
 		&result.UpdatedAt,
+		&result.CreatedBy,
+		&result.UpdatedBy,
+		&result.ExternalID,
+		&result.SecretExpiresAt,
 	)
 
 	if err != nil {
@@ -329,9 +358,10 @@ func (r *repo) GetByClientID(ctx context.Context, clientID string) (*OAuthClient
 	selectQuery := `
 		SELECT id, public_id, name, client_id,
 		       redirect_uris, pkce_required, is_default, confidential,
-		       created_at, updated_at
+		       created_at, updated_at,
+		       COALESCE(created_by, ''), COALESCE(updated_by, ''), COALESCE(external_id, ''), client_secret_expires_at
 		FROM altalune_oauth_clients
-		WHERE client_id = $1
+		WHERE client_id = $1 AND deleted_at IS NULL
 	`
 
 	var result OAuthClientQueryResult
@@ -348,6 +378,10 @@ func (r *repo) GetByClientID(ctx context.Context, clientID string) (*OAuthClient
 		&result.Confidential,
 		&result.CreatedAt,
 		&result.UpdatedAt,
+		&result.CreatedBy,
+		&result.UpdatedBy,
+		&result.ExternalID,
+		&result.SecretExpiresAt,
 	)
 
 	if err != nil {
@@ -387,26 +421,50 @@ func (r *repo) Update(ctx context.Context, input *UpdateOAuthClientInput) (*OAut
 		argCounter++
 	}
 
-	// Always update updated_at
-	setClauses = append(setClauses, "updated_at = CURRENT_TIMESTAMP")
-
-	if len(setClauses) == 1 { // Only updated_at
+	if len(setClauses) == 0 {
 		return nil, fmt.Errorf("no fields to update")
 	}
 
+	// Always update updated_at and updated_by
+	setClauses = append(setClauses, "updated_at = CURRENT_TIMESTAMP")
+	setClauses = append(setClauses, fmt.Sprintf("updated_by = NULLIF($%d, '')", argCounter))
+	args = append(args, auth.ActorID(ctx))
+	argCounter++
+
+	whereClause := "public_id = $1 AND deleted_at IS NULL"
+	if input.ExpectedUpdatedAt != nil {
+		whereClause += fmt.Sprintf(" AND updated_at = $%d", argCounter)
+		args = append(args, *input.ExpectedUpdatedAt)
+	}
+
+	// The current row is returned flagged as a conflict instead when it
+	// changed since the expected version
 	updateQuery := fmt.Sprintf(`
-		UPDATE altalune_oauth_clients
-		SET %s
-		WHERE public_id = $1
-		RETURNING id, public_id, name, client_id,
-		          redirect_uris, pkce_required, is_default, confidential,
-		          created_at, updated_at
-	`, strings.Join(setClauses, ", "))
+		WITH updated AS (
+			UPDATE altalune_oauth_clients
+			SET %s
+			WHERE %s
+			RETURNING *
+		), result AS (
+			SELECT false AS conflict, updated.* FROM updated
+			UNION ALL
+			SELECT true, c.* FROM altalune_oauth_clients c
+			WHERE c.public_id = $1 AND c.deleted_at IS NULL
+				AND NOT EXISTS (SELECT 1 FROM updated)
+		)
+		SELECT conflict, id, public_id, name, client_id,
+		       redirect_uris, pkce_required, is_default, confidential,
+		       created_at, updated_at, COALESCE(created_by, ''), COALESCE(updated_by, ''),
+		       COALESCE(external_id, ''), client_secret_expires_at
+		FROM result
+	`, strings.Join(setClauses, ", "), whereClause)
 
 	var result OAuthClientQueryResult
 	var redirectURIs pq.StringArray
+	var conflict bool
 
 	err := r.db.QueryRowContext(ctx, updateQuery, args...).Scan(
+		&conflict,
 		&result.ID,
 		&result.PublicID,
 		&result.Name,
@@ -417,6 +475,10 @@ func (r *repo) Update(ctx context.Context, input *UpdateOAuthClientInput) (*OAut
 		&result.Confidential,
 		&result.CreatedAt,
 		&result.UpdatedAt,
+		&result.CreatedBy,
+		&result.UpdatedBy,
+		&result.ExternalID,
+		&result.SecretExpiresAt,
 	)
 
 	if err != nil {
@@ -428,17 +490,151 @@ func (r *repo) Update(ctx context.Context, input *UpdateOAuthClientInput) (*OAut
 		}
 		return nil, fmt.Errorf("update oauth client: %w", err)
 	}
+	if conflict {
+		return nil, ErrOAuthClientVersionConflict
+	}
 
 	result.RedirectURIs = []string(redirectURIs)
 
 	return result.ToOAuthClient(), nil
 }
 
-// Delete deletes an OAuth client (with default client protection, global)
+// GetByExternalID retrieves an OAuth client by its key in a provisioning tool,
+// trashed clients included with DeletedAt set
+func (r *repo) GetByExternalID(ctx context.Context, externalID string) (*OAuthClient, error) {
+	selectQuery := `
+		SELECT id, public_id, name, client_id,
+		       redirect_uris, pkce_required, is_default, confidential,
+		       created_at, updated_at, deleted_at,
+		       COALESCE(created_by, ''), COALESCE(updated_by, ''), COALESCE(external_id, ''), client_secret_expires_at
+		FROM altalune_oauth_clients
+		WHERE external_id = $1
+	`
+
+	var result OAuthClientQueryResult
+	var redirectURIs pq.StringArray
+
+	err := r.db.QueryRowContext(ctx, selectQuery, externalID).Scan(
+		&result.ID,
+		&result.PublicID,
+		&result.Name,
+		&result.ClientID,
+		&redirectURIs,
+		&result.PKCERequired,
+		&result.IsDefault,
+		&result.Confidential,
+		&result.CreatedAt,
+		&result.UpdatedAt,
+		&result.DeletedAt,
+		&result.CreatedBy,
+		&result.UpdatedBy,
+		&result.ExternalID,
+		&result.SecretExpiresAt,
+	)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrOAuthClientNotFound
+		}
+		return nil, fmt.Errorf("get oauth client by external_id: %w", err)
+	}
+
+	result.RedirectURIs = []string(redirectURIs)
+
+	return result.ToOAuthClient(), nil
+}
+
+// ListByName returns the live OAuth clients named name, ignoring case, oldest
+// first. Client names are not unique.
+func (r *repo) ListByName(ctx context.Context, name string) ([]*OAuthClient, error) {
+	selectQuery := `
+		SELECT id, public_id, name, client_id,
+		       redirect_uris, pkce_required, is_default, confidential,
+		       created_at, updated_at,
+		       COALESCE(created_by, ''), COALESCE(updated_by, ''), COALESCE(external_id, ''), client_secret_expires_at
+		FROM altalune_oauth_clients
+		WHERE LOWER(name) = LOWER($1) AND deleted_at IS NULL
+		ORDER BY created_at, id
+	`
+
+	rows, err := r.db.QueryContext(ctx, selectQuery, name)
+	if err != nil {
+		return nil, fmt.Errorf("list oauth clients by name: %w", err)
+	}
+	defer rows.Close()
+
+	clients := make([]*OAuthClient, 0)
+	for rows.Next() {
+		var result OAuthClientQueryResult
+		var redirectURIs pq.StringArray
+
+		if err := rows.Scan(
+			&result.ID,
+			&result.PublicID,
+			&result.Name,
+			&result.ClientID,
+			&redirectURIs,
+			&result.PKCERequired,
+			&result.IsDefault,
+			&result.Confidential,
+			&result.CreatedAt,
+			&result.UpdatedAt,
+			&result.CreatedBy,
+			&result.UpdatedBy,
+			&result.ExternalID,
+			&result.SecretExpiresAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan oauth client: %w", err)
+		}
+
+		result.RedirectURIs = []string(redirectURIs)
+		clients = append(clients, result.ToOAuthClient())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return clients, nil
+}
+
+// SetExternalID sets the key of a live OAuth client in a provisioning tool.
+// Setting the key the client already has changes nothing; a client with
+// another key is returned unchanged with ErrOAuthClientAlreadyManaged.
+func (r *repo) SetExternalID(ctx context.Context, publicID, externalID string) (*OAuthClient, error) {
+	updateQuery := `
+		UPDATE altalune_oauth_clients
+		SET external_id = $2, updated_at = CURRENT_TIMESTAMP, updated_by = NULLIF($3, '')
+		WHERE public_id = $1 AND deleted_at IS NULL AND external_id IS NULL
+	`
+	result, err := r.db.ExecContext(ctx, updateQuery, publicID, externalID, auth.ActorID(ctx))
+	if err != nil {
+		if postgres.IsExternalIDViolation(err) {
+			return nil, ErrExternalIDTaken
+		}
+		return nil, fmt.Errorf("set oauth client external_id: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("get rows affected: %w", err)
+	}
+
+	client, err := r.GetByPublicID(ctx, publicID)
+	if err != nil {
+		return nil, err
+	}
+	if rowsAffected == 0 && client.ExternalID != externalID {
+		return client, ErrOAuthClientAlreadyManaged
+	}
+
+	return client, nil
+}
+
+// Delete moves an OAuth client to the trash (with default client protection, global)
 func (r *repo) Delete(ctx context.Context, publicID string) error {
 	// First check if it's the default client
 	var isDefault bool
-	checkQuery := "SELECT is_default FROM altalune_oauth_clients WHERE public_id = $1"
+	checkQuery := "SELECT is_default FROM altalune_oauth_clients WHERE public_id = $1 AND deleted_at IS NULL"
 	err := r.db.QueryRowContext(ctx, checkQuery, publicID).Scan(&isDefault)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -452,9 +648,13 @@ func (r *repo) Delete(ctx context.Context, publicID string) error {
 		return ErrDefaultClientCannotBeDeleted
 	}
 
-	// Delete client
-	deleteQuery := "DELETE FROM altalune_oauth_clients WHERE public_id = $1"
-	result, err := r.db.ExecContext(ctx, deleteQuery, publicID)
+	// Trash client
+	deleteQuery := `
+		UPDATE altalune_oauth_clients
+		SET deleted_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP, updated_by = NULLIF($2, '')
+		WHERE public_id = $1 AND deleted_at IS NULL
+	`
+	result, err := r.db.ExecContext(ctx, deleteQuery, publicID, auth.ActorID(ctx))
 	if err != nil {
 		return fmt.Errorf("delete oauth client: %w", err)
 	}
@@ -470,6 +670,40 @@ func (r *repo) Delete(ctx context.Context, publicID string) error {
 	return nil
 }
 
+// Restore moves an OAuth client out of the trash (global)
+func (r *repo) Restore(ctx context.Context, publicID string) (*OAuthClient, error) {
+	restoreQuery := `
+		UPDATE altalune_oauth_clients
+		SET deleted_at = NULL, updated_at = CURRENT_TIMESTAMP, updated_by = NULLIF($2, '')
+		WHERE public_id = $1 AND deleted_at IS NOT NULL
+	`
+	result, err := r.db.ExecContext(ctx, restoreQuery, publicID, auth.ActorID(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("restore oauth client: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return nil, ErrOAuthClientNotFound
+	}
+
+	return r.GetByPublicID(ctx, publicID)
+}
+
+// PurgeDeleted permanently removes OAuth clients trashed before the given time
+func (r *repo) PurgeDeleted(ctx context.Context, before time.Time) (int64, error) {
+	purgeQuery := "DELETE FROM altalune_oauth_clients WHERE deleted_at < $1"
+	result, err := r.db.ExecContext(ctx, purgeQuery, before)
+	if err != nil {
+		return 0, fmt.Errorf("purge deleted oauth clients: %w", err)
+	}
+
+	return result.RowsAffected()
+}
+
 // RevealClientSecret retrieves the hashed client secret (with audit logging, global)
 // NOTE: Returns the Argon2id hash. In production, you might want to:
 // 1. Add audit logging here
@@ -481,7 +715,7 @@ func (r *repo) RevealClientSecret(ctx context.Context, publicID string) (string,
 	selectQuery := `
 		SELECT client_secret_hash, confidential
 		FROM altalune_oauth_clients
-		WHERE public_id = $1
+		WHERE public_id = $1 AND deleted_at IS NULL
 	`
 
 	var hashedSecret sql.NullString
@@ -513,6 +747,110 @@ func (r *repo) RevealClientSecret(ctx context.Context, publicID string) (string,
 	return hashedSecret.String, nil
 }
 
+// clientSecretPolicy guards generated client secrets against a broken random source
+var clientSecretPolicy = secretpolicy.Policy{MinEntropy: secretpolicy.MinClientSecretEntropy}
+
+// RotateSecret replaces the secret of a live confidential client with a new
+// one expiring at expiresAt, nil for never, returned in plaintext this once
+func (r *repo) RotateSecret(ctx context.Context, publicID string, expiresAt *time.Time) (*CreateOAuthClientResult, error) {
+	clientSecret, hash, err := r.newSecret(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	updateQuery := `
+		UPDATE altalune_oauth_clients
+		SET client_secret_hash = $2, client_secret_set_at = CURRENT_TIMESTAMP, client_secret_expires_at = $3,
+		    updated_at = CURRENT_TIMESTAMP, updated_by = NULLIF($4, '')
+		WHERE public_id = $1 AND deleted_at IS NULL AND confidential
+	`
+	result, err := r.db.ExecContext(ctx, updateQuery, publicID, hash, expiresAt, auth.ActorID(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("rotate oauth client secret: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("get rows affected: %w", err)
+	}
+
+	// Whether a client is confidential never changes, so a live client left
+	// as is is a public one
+	client, err := r.GetByPublicID(ctx, publicID)
+	if err != nil {
+		return nil, err
+	}
+	if rowsAffected == 0 {
+		return nil, ErrPublicClientNoSecret
+	}
+
+	return &CreateOAuthClientResult{
+		Client:       client,
+		ClientSecret: clientSecret,
+	}, nil
+}
+
+// ListSecrets returns the secrets of the live confidential clients, oldest
+// first
+func (r *repo) ListSecrets(ctx context.Context) ([]*ClientSecret, error) {
+	selectQuery := `
+		SELECT public_id, name, client_secret_set_at, client_secret_expires_at
+		FROM altalune_oauth_clients
+		WHERE confidential AND deleted_at IS NULL
+		ORDER BY client_secret_set_at, id
+	`
+
+	rows, err := r.db.QueryContext(ctx, selectQuery)
+	if err != nil {
+		return nil, fmt.Errorf("list oauth client secrets: %w", err)
+	}
+	defer rows.Close()
+
+	secrets := make([]*ClientSecret, 0)
+	for rows.Next() {
+		var secret ClientSecret
+		if err := rows.Scan(&secret.PublicID, &secret.Name, &secret.SetAt, &secret.ExpiresAt); err != nil {
+			return nil, fmt.Errorf("scan oauth client secret: %w", err)
+		}
+		secrets = append(secrets, &secret)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return secrets, nil
+}
+
+// ExpireSecret brings the expiry of the secret of a live confidential client
+// forward to expiresAt. A secret expiring earlier is left as is.
+func (r *repo) ExpireSecret(ctx context.Context, publicID string, expiresAt time.Time) error {
+	updateQuery := `
+		UPDATE altalune_oauth_clients
+		SET client_secret_expires_at = $2
+		WHERE public_id = $1 AND deleted_at IS NULL AND confidential
+		  AND (client_secret_expires_at IS NULL OR client_secret_expires_at > $2)
+	`
+	if _, err := r.db.ExecContext(ctx, updateQuery, publicID, expiresAt); err != nil {
+		return fmt.Errorf("expire oauth client secret: %w", err)
+	}
+	return nil
+}
+
+// newSecret generates a client secret, returning it with its hash
+func (r *repo) newSecret(ctx context.Context) (string, string, error) {
+	clientSecret := generateSecureRandom(32)
+	if err := clientSecretPolicy.Check(ctx, clientSecret); err != nil {
+		return "", "", fmt.Errorf("generated client secret: %w", err)
+	}
+
+	// Hash secret with Argon2id (using the configured security.passwordHashing parameters)
+	hash, err := password.HashPassword(clientSecret, r.hashOption)
+	if err != nil {
+		return "", "", fmt.Errorf("hash client secret: %w", err)
+	}
+	return clientSecret, hash, nil
+}
+
 // generateSecureRandom generates a cryptographically secure random string
 func generateSecureRandom(length int) string {
 	const charset = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
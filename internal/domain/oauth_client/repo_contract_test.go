@@ -0,0 +1,501 @@
+package oauth_client_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hrz8/altalune/internal/auth"
+	"github.com/hrz8/altalune/internal/domain/oauth_auth"
+	"github.com/hrz8/altalune/internal/domain/oauth_client"
+	"github.com/hrz8/altalune/internal/domain/user"
+	"github.com/hrz8/altalune/internal/shared/jwt"
+	"github.com/hrz8/altalune/internal/shared/password"
+	"github.com/hrz8/altalune/internal/shared/query"
+	"github.com/hrz8/altalune/internal/testdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testHashOption keeps secret hashing cheap in tests
+var testHashOption = password.HashOption{Iterations: 1, Memory: 1024, Threads: 1, Len: 32}
+
+func TestMain(m *testing.M) { testdb.Main(m) }
+
+func TestInMemRepo(t *testing.T) {
+	repo := oauth_client.NewInMemRepo(testHashOption)
+	testRepoContract(t, repo, fixtures{
+		newUser: func(t *testing.T) (string, string) {
+			id := testdb.Token(t)
+			return id, id + "@example.com"
+		},
+		newRefreshToken: func(t *testing.T, userID, email string, client *oauth_client.OAuthClient, expiresAt time.Time) int64 {
+			return repo.PutRefreshToken(&oauth_client.RefreshToken{
+				UserID:    userID,
+				UserEmail: email,
+				ClientID:  client.ID,
+				Scope:     "openid profile",
+				ExpiresAt: expiresAt,
+			})
+		},
+		recordTokenStats: func(t *testing.T, clientID uuid.UUID, hour time.Time, issued, failed int64) {
+			repo.PutTokenStats(clientID, oauth_client.TokenStatsBucket{Hour: hour, Issued: issued, Failed: failed})
+		},
+	})
+}
+
+func TestRepo(t *testing.T) {
+	db := testdb.Open(t)
+	users := user.NewRepo(db)
+	tokens := oauth_auth.NewRepo(db)
+
+	testRepoContract(t, oauth_client.NewRepo(db, testHashOption), fixtures{
+		newUser: func(t *testing.T) (string, string) {
+			created, err := users.Create(context.Background(), &user.CreateUserInput{Email: testdb.Token(t) + "@example.com"})
+			require.NoError(t, err)
+			return created.PublicID, created.Email
+		},
+		newRefreshToken: func(t *testing.T, userID, email string, client *oauth_client.OAuthClient, expiresAt time.Time) int64 {
+			id, err := users.GetIDByPublicID(context.Background(), userID)
+			require.NoError(t, err)
+			rt, err := tokens.CreateRefreshToken(context.Background(), &oauth_auth.CreateRefreshTokenInput{
+				ClientID:  client.ClientID,
+				UserID:    id,
+				Scope:     "openid profile",
+				ExpiresAt: expiresAt,
+			})
+			require.NoError(t, err)
+			return rt.ID
+		},
+		recordTokenStats: func(t *testing.T, clientID uuid.UUID, hour time.Time, issued, failed int64) {
+			require.NoError(t, tokens.IncrementTokenStats(context.Background(), clientID, hour, issued, failed))
+		},
+	})
+}
+
+// fixtures create the rows owned by other domains that refresh tokens and
+// token stats reference
+type fixtures struct {
+	newUser          func(t *testing.T) (publicID, email string)
+	newRefreshToken  func(t *testing.T, userID, email string, client *oauth_client.OAuthClient, expiresAt time.Time) int64
+	recordTokenStats func(t *testing.T, clientID uuid.UUID, hour time.Time, issued, failed int64)
+}
+
+// testRepoContract runs the behavior every oauth_client.Repositor must share
+// against repo. It only relies on rows it creates itself and the ones of f.
+func testRepoContract(t *testing.T, repo oauth_client.Repositor, f fixtures) {
+	ctx := context.Background()
+
+	create := func(t *testing.T, name string, confidential bool) *oauth_client.CreateOAuthClientResult {
+		t.Helper()
+		created, err := repo.Create(ctx, &oauth_client.CreateOAuthClientInput{
+			Name:         name,
+			RedirectURIs: []string{"https://example.com/callback"},
+			PKCERequired: true,
+			Confidential: confidential,
+		})
+		require.NoError(t, err)
+		return created
+	}
+
+	t.Run("create and get", func(t *testing.T) {
+		created := create(t, "Get "+testdb.Token(t), true)
+		assert.Len(t, created.ClientSecret, 32)
+		assert.False(t, created.Client.IsDefault)
+
+		byPublicID, err := repo.GetByPublicID(ctx, created.Client.ID)
+		require.NoError(t, err)
+		assert.Equal(t, created.Client.Name, byPublicID.Name)
+		assert.Equal(t, []string{"https://example.com/callback"}, byPublicID.RedirectURIs)
+
+		byClientID, err := repo.GetByClientID(ctx, created.Client.ClientID.String())
+		require.NoError(t, err)
+		assert.Equal(t, created.Client.ID, byClientID.ID)
+
+		_, err = repo.GetByClientID(ctx, "not-a-uuid")
+		assert.Error(t, err)
+		_, err = repo.GetByPublicID(ctx, "unknown")
+		assert.ErrorIs(t, err, oauth_client.ErrOAuthClientNotFound)
+	})
+
+	t.Run("client secrets", func(t *testing.T) {
+		confidential := create(t, "Confidential "+testdb.Token(t), true)
+		hash, err := repo.RevealClientSecret(ctx, confidential.Client.ID)
+		require.NoError(t, err)
+		ok, err := password.VerifyPassword(confidential.ClientSecret, hash)
+		require.NoError(t, err)
+		assert.True(t, ok, "only the hash of the secret is stored")
+
+		public := create(t, "Public "+testdb.Token(t), false)
+		assert.Empty(t, public.ClientSecret)
+		_, err = repo.RevealClientSecret(ctx, public.Client.ID)
+		assert.ErrorIs(t, err, oauth_client.ErrPublicClientNoSecret)
+	})
+
+	t.Run("audit actors", func(t *testing.T) {
+		creator, updater := testdb.Token(t), testdb.Token(t)
+		as := func(actor string) context.Context {
+			return auth.WithAuthContext(ctx, &auth.AuthContext{UserID: actor, IsAuthenticated: true})
+		}
+
+		created, err := repo.Create(as(creator), &oauth_client.CreateOAuthClientInput{
+			Name:         "Audited " + testdb.Token(t),
+			RedirectURIs: []string{"https://example.com/callback"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, creator, created.Client.CreatedBy)
+
+		name := "Audited again " + testdb.Token(t)
+		updated, err := repo.Update(as(updater), &oauth_client.UpdateOAuthClientInput{PublicID: created.Client.ID, Name: &name})
+		require.NoError(t, err)
+		assert.Equal(t, creator, updated.CreatedBy, "the creator is kept")
+		assert.Equal(t, updater, updated.UpdatedBy)
+
+		anonymous := create(t, "Anonymous "+testdb.Token(t), false)
+		assert.Empty(t, anonymous.Client.CreatedBy, "rows written outside of a request have no actor")
+	})
+
+	t.Run("secret rotation and expiry", func(t *testing.T) {
+		confidential := create(t, "Rotated "+testdb.Token(t), true)
+		assert.Nil(t, confidential.Client.SecretExpiresAt, "created without a maximum lifetime")
+
+		expiresAt := time.Now().Add(30 * 24 * time.Hour).UTC().Truncate(time.Second)
+		rotated, err := repo.RotateSecret(ctx, confidential.Client.ID, &expiresAt)
+		require.NoError(t, err)
+		assert.NotEqual(t, confidential.ClientSecret, rotated.ClientSecret)
+		require.NotNil(t, rotated.Client.SecretExpiresAt)
+		assert.True(t, expiresAt.Equal(*rotated.Client.SecretExpiresAt))
+		hash, err := repo.RevealClientSecret(ctx, confidential.Client.ID)
+		require.NoError(t, err)
+		ok, err := password.VerifyPassword(rotated.ClientSecret, hash)
+		require.NoError(t, err)
+		assert.True(t, ok, "the rotated secret replaces the old one")
+
+		secret := func() *oauth_client.ClientSecret {
+			secrets, err := repo.ListSecrets(ctx)
+			require.NoError(t, err)
+			for _, s := range secrets {
+				if s.PublicID == confidential.Client.ID {
+					return s
+				}
+			}
+			return nil
+		}
+		listed := secret()
+		require.NotNil(t, listed)
+		assert.False(t, listed.SetAt.IsZero())
+
+		later := expiresAt.Add(time.Hour)
+		require.NoError(t, repo.ExpireSecret(ctx, confidential.Client.ID, later))
+		assert.True(t, expiresAt.Equal(*secret().ExpiresAt), "an expiry is never pushed back")
+		sooner := expiresAt.Add(-time.Hour)
+		require.NoError(t, repo.ExpireSecret(ctx, confidential.Client.ID, sooner))
+		assert.True(t, sooner.Equal(*secret().ExpiresAt))
+
+		public := create(t, "Public rotated "+testdb.Token(t), false)
+		_, err = repo.RotateSecret(ctx, public.Client.ID, nil)
+		assert.ErrorIs(t, err, oauth_client.ErrPublicClientNoSecret)
+		_, err = repo.RotateSecret(ctx, "unknown", nil)
+		assert.ErrorIs(t, err, oauth_client.ErrOAuthClientNotFound)
+		secrets, err := repo.ListSecrets(ctx)
+		require.NoError(t, err)
+		for _, s := range secrets {
+			assert.NotEqual(t, public.Client.ID, s.PublicID, "public clients have no secret")
+		}
+	})
+
+	t.Run("update", func(t *testing.T) {
+		created := create(t, "Update "+testdb.Token(t), false)
+
+		_, err := repo.Update(ctx, &oauth_client.UpdateOAuthClientInput{PublicID: created.Client.ID})
+		assert.Error(t, err, "an update needs at least one field")
+
+		stale := created.Client.UpdatedAt.Add(-time.Second)
+		name := "Renamed " + testdb.Token(t)
+		_, err = repo.Update(ctx, &oauth_client.UpdateOAuthClientInput{
+			PublicID:          created.Client.ID,
+			Name:              &name,
+			ExpectedUpdatedAt: &stale,
+		})
+		assert.ErrorIs(t, err, oauth_client.ErrOAuthClientVersionConflict)
+
+		updated, err := repo.Update(ctx, &oauth_client.UpdateOAuthClientInput{
+			PublicID:          created.Client.ID,
+			Name:              &name,
+			ExpectedUpdatedAt: &created.Client.UpdatedAt,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, name, updated.Name)
+		assert.True(t, updated.PKCERequired, "fields left nil are unchanged")
+		assert.Equal(t, created.Client.RedirectURIs, updated.RedirectURIs)
+
+		_, err = repo.Update(ctx, &oauth_client.UpdateOAuthClientInput{
+			PublicID:          created.Client.ID,
+			Name:              &name,
+			ExpectedUpdatedAt: &created.Client.UpdatedAt,
+		})
+		assert.ErrorIs(t, err, oauth_client.ErrOAuthClientVersionConflict, "a version is only updated once")
+
+		_, err = repo.Update(ctx, &oauth_client.UpdateOAuthClientInput{PublicID: "unknown", Name: &name})
+		assert.ErrorIs(t, err, oauth_client.ErrOAuthClientNotFound)
+		_, err = repo.Update(ctx, &oauth_client.UpdateOAuthClientInput{
+			PublicID:          "unknown",
+			Name:              &name,
+			ExpectedUpdatedAt: &created.Client.UpdatedAt,
+		})
+		assert.ErrorIs(t, err, oauth_client.ErrOAuthClientNotFound, "a missing client is not a conflict")
+	})
+
+	t.Run("trash", func(t *testing.T) {
+		created := create(t, "Trash "+testdb.Token(t), false)
+
+		require.NoError(t, repo.Delete(ctx, created.Client.ID))
+		assert.ErrorIs(t, repo.Delete(ctx, created.Client.ID), oauth_client.ErrOAuthClientNotFound)
+
+		_, err := repo.GetByClientID(ctx, created.Client.ClientID.String())
+		assert.ErrorIs(t, err, oauth_client.ErrOAuthClientNotFound, "trashed clients cannot be used in OAuth flows")
+
+		trashed, err := repo.Query(ctx, &query.QueryParams{
+			Pagination: query.PaginationParams{Page: 1, PageSize: 10},
+			Keyword:    created.Client.Name,
+			Trashed:    true,
+		})
+		require.NoError(t, err)
+		require.Len(t, trashed.Data, 1)
+		assert.NotNil(t, trashed.Data[0].DeletedAt)
+
+		restored, err := repo.Restore(ctx, created.Client.ID)
+		require.NoError(t, err)
+		assert.Equal(t, created.Client.ID, restored.ID)
+		_, err = repo.Restore(ctx, created.Client.ID)
+		assert.ErrorIs(t, err, oauth_client.ErrOAuthClientNotFound)
+
+		require.NoError(t, repo.Delete(ctx, created.Client.ID))
+		purged, err := repo.PurgeDeleted(ctx, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, purged, int64(1))
+		_, err = repo.Restore(ctx, created.Client.ID)
+		assert.ErrorIs(t, err, oauth_client.ErrOAuthClientNotFound)
+	})
+
+	t.Run("external ids", func(t *testing.T) {
+		tok := testdb.Token(t)
+		managed, err := repo.Create(ctx, &oauth_client.CreateOAuthClientInput{
+			Name:         "Managed " + tok,
+			RedirectURIs: []string{"https://example.com/callback"},
+			ExternalID:   "tf:managed-" + tok,
+		})
+		require.NoError(t, err)
+		first := create(t, "Shared "+tok, false)
+		second := create(t, "SHARED "+tok, false)
+
+		byExternalID, err := repo.GetByExternalID(ctx, "tf:managed-"+tok)
+		require.NoError(t, err)
+		assert.Equal(t, managed.Client.ID, byExternalID.ID)
+		_, err = repo.GetByExternalID(ctx, "tf:unknown-"+tok)
+		assert.ErrorIs(t, err, oauth_client.ErrOAuthClientNotFound)
+
+		_, err = repo.Create(ctx, &oauth_client.CreateOAuthClientInput{
+			Name:         "Other " + tok,
+			RedirectURIs: []string{"https://example.com/callback"},
+			ExternalID:   "tf:managed-" + tok,
+		})
+		assert.ErrorIs(t, err, oauth_client.ErrExternalIDTaken)
+
+		byName, err := repo.ListByName(ctx, "shared "+tok)
+		require.NoError(t, err)
+		require.Len(t, byName, 2, "client names are not unique")
+		assert.Equal(t, first.Client.ID, byName[0].ID)
+		assert.Equal(t, second.Client.ID, byName[1].ID)
+
+		_, err = repo.SetExternalID(ctx, first.Client.ID, "tf:managed-"+tok)
+		assert.ErrorIs(t, err, oauth_client.ErrExternalIDTaken)
+		imported, err := repo.SetExternalID(ctx, first.Client.ID, "tf:first-"+tok)
+		require.NoError(t, err)
+		assert.Equal(t, "tf:first-"+tok, imported.ExternalID)
+		_, err = repo.SetExternalID(ctx, first.Client.ID, "tf:first-"+tok)
+		assert.NoError(t, err, "setting the same external id again changes nothing")
+		_, err = repo.SetExternalID(ctx, first.Client.ID, "tf:other-"+tok)
+		assert.ErrorIs(t, err, oauth_client.ErrOAuthClientAlreadyManaged)
+
+		require.NoError(t, repo.Delete(ctx, managed.Client.ID))
+		trashed, err := repo.GetByExternalID(ctx, "tf:managed-"+tok)
+		require.NoError(t, err)
+		assert.NotNil(t, trashed.DeletedAt, "trashed clients keep their external id")
+		byName, err = repo.ListByName(ctx, "Managed "+tok)
+		require.NoError(t, err)
+		assert.Empty(t, byName)
+	})
+
+	t.Run("query", func(t *testing.T) {
+		tok := testdb.Token(t)
+		for _, name := range []string{"C", "A", "B"} {
+			create(t, name+" "+tok, true)
+		}
+		create(t, "D "+tok, false)
+
+		params := &query.QueryParams{
+			Pagination: query.PaginationParams{Page: 1, PageSize: 2},
+			Keyword:    tok,
+			Filters:    map[string][]string{"confidential": {"true"}},
+			Sorting:    &query.SortingParams{Field: "name", Order: query.SortOrderAsc},
+		}
+		first, err := repo.Query(ctx, params)
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), first.TotalRows)
+		assert.Equal(t, int32(2), first.TotalPages)
+		require.Len(t, first.Data, 2)
+		assert.Equal(t, "A "+tok, first.Data[0].Name)
+		assert.Equal(t, "B "+tok, first.Data[1].Name)
+
+		params.Pagination.Page = 2
+		second, err := repo.Query(ctx, params)
+		require.NoError(t, err)
+		require.Len(t, second.Data, 1)
+		assert.Equal(t, "C "+tok, second.Data[0].Name)
+	})
+
+	t.Run("refresh tokens", func(t *testing.T) {
+		client := create(t, "Tokens "+testdb.Token(t), true).Client
+		other := create(t, "Other "+testdb.Token(t), true).Client
+		userID, email := f.newUser(t)
+		otherUserID, otherEmail := f.newUser(t)
+
+		older := f.newRefreshToken(t, userID, email, client, time.Now().Add(time.Hour))
+		newer := f.newRefreshToken(t, userID, email, other, time.Now().Add(time.Hour))
+		f.newRefreshToken(t, userID, email, client, time.Now().Add(-time.Hour))
+		f.newRefreshToken(t, otherUserID, otherEmail, client, time.Now().Add(time.Hour))
+
+		page := query.PaginationParams{Page: 1, PageSize: 10}
+		byUser, err := repo.QueryRefreshTokens(ctx, &oauth_client.RefreshTokenFilter{UserID: userID}, &query.QueryParams{Pagination: page})
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), byUser.TotalRows)
+		require.Len(t, byUser.Data, 3)
+		assert.Equal(t, newer, byUser.Data[1].ID, "newest first")
+		assert.Equal(t, older, byUser.Data[2].ID)
+		assert.Equal(t, other.ID, byUser.Data[1].ClientID)
+		assert.Equal(t, other.Name, byUser.Data[1].ClientName)
+		assert.Equal(t, email, byUser.Data[1].UserEmail)
+		assert.Equal(t, oauth_client.RefreshTokenStatusExpired, byUser.Data[0].Status)
+
+		byBoth, err := repo.QueryRefreshTokens(ctx, &oauth_client.RefreshTokenFilter{UserID: userID, ClientID: client.ID}, &query.QueryParams{
+			Pagination: page,
+			Filters:    map[string][]string{"statuses": {"active"}},
+		})
+		require.NoError(t, err)
+		require.Len(t, byBoth.Data, 1)
+		assert.Equal(t, older, byBoth.Data[0].ID)
+
+		revoked, ok, err := repo.RevokeRefreshToken(ctx, older)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, oauth_client.RefreshTokenStatusRevoked, revoked.Status)
+		assert.NotNil(t, revoked.RevokedAt)
+		assert.NotNil(t, revoked.ExchangedAt, "revoked tokens can no longer be exchanged")
+
+		again, ok, err := repo.RevokeRefreshToken(ctx, older)
+		require.NoError(t, err)
+		assert.False(t, ok, "revoking is idempotent")
+		assert.Equal(t, revoked.RevokedAt.Unix(), again.RevokedAt.Unix())
+
+		byClient, err := repo.QueryRefreshTokens(ctx, &oauth_client.RefreshTokenFilter{ClientID: client.ID}, &query.QueryParams{
+			Pagination: page,
+			Filters:    map[string][]string{"statuses": {"revoked"}},
+		})
+		require.NoError(t, err)
+		require.Len(t, byClient.Data, 1)
+		assert.Equal(t, older, byClient.Data[0].ID)
+
+		_, _, err = repo.RevokeRefreshToken(ctx, -1)
+		assert.ErrorIs(t, err, oauth_client.ErrRefreshTokenNotFound)
+	})
+
+	t.Run("token claims", func(t *testing.T) {
+		created := create(t, "Claims "+testdb.Token(t), false).Client
+
+		claims, err := repo.GetTokenClaims(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, oauth_client.TokenClaims{PermsClaim: jwt.PermsClaimFull}, *claims, "clients carry every permission by default")
+
+		want := oauth_client.TokenClaims{PermsClaim: jwt.PermsClaimRoles, PermsMaxBytes: 2048}
+		updated, err := repo.UpdateTokenClaims(ctx, &oauth_client.UpdateTokenClaimsInput{PublicID: created.ID, TokenClaims: want})
+		require.NoError(t, err)
+		assert.Equal(t, want, *updated)
+		claims, err = repo.GetTokenClaims(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, want, *claims)
+
+		_, err = repo.UpdateTokenClaims(ctx, &oauth_client.UpdateTokenClaimsInput{PublicID: "missing"})
+		assert.ErrorIs(t, err, oauth_client.ErrOAuthClientNotFound)
+	})
+
+	t.Run("token stats", func(t *testing.T) {
+		created := create(t, "Stats "+testdb.Token(t), false).Client
+		other := create(t, "Stats "+testdb.Token(t), false).Client
+
+		// An hour long past, so the counts of the token endpoint stay out
+		start := time.Date(2001, 2, 3, 4, 0, 0, 0, time.UTC)
+		f.recordTokenStats(t, created.ClientID, start, 3, 0)
+		f.recordTokenStats(t, created.ClientID, start, 2, 1)
+		f.recordTokenStats(t, created.ClientID, start.Add(2*time.Hour), 0, 4)
+		f.recordTokenStats(t, other.ClientID, start.Add(time.Hour), 7, 0)
+
+		buckets, err := repo.QueryTokenStats(ctx, created.ClientID, start, start.Add(3*time.Hour))
+		require.NoError(t, err)
+		require.Len(t, buckets, 2, "hours without requests have no bucket")
+		assert.True(t, start.Equal(buckets[0].Hour))
+		assert.Equal(t, [2]int64{5, 1}, [2]int64{buckets[0].Issued, buckets[0].Failed}, "counts of an hour add up")
+		assert.Equal(t, [2]int64{0, 4}, [2]int64{buckets[1].Issued, buckets[1].Failed})
+
+		sums, err := repo.SumTokenStats(ctx, start, start.Add(2*time.Hour))
+		require.NoError(t, err)
+		totals := make(map[uuid.UUID]oauth_client.ClientTokenStats)
+		for _, sum := range sums {
+			totals[sum.ClientID] = *sum
+		}
+		assert.Equal(t, created.ID, totals[created.ClientID].PublicID)
+		assert.Equal(t, [2]int64{5, 1}, [2]int64{totals[created.ClientID].Issued, totals[created.ClientID].Failed}, "hours past the range are left out")
+		assert.Equal(t, int64(7), totals[other.ClientID].Issued)
+
+		_, err = repo.PurgeTokenStats(ctx, start.Add(time.Hour))
+		require.NoError(t, err)
+		buckets, err = repo.QueryTokenStats(ctx, created.ClientID, start, start.Add(3*time.Hour))
+		require.NoError(t, err)
+		require.Len(t, buckets, 1)
+		assert.True(t, start.Add(2*time.Hour).Equal(buckets[0].Hour))
+	})
+
+	t.Run("concurrent updates", func(t *testing.T) {
+		created := create(t, "Concurrent "+testdb.Token(t), false)
+
+		var wg sync.WaitGroup
+		errs := make(chan error, 8)
+		for range 8 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				pkceRequired := true
+				_, err := repo.Update(ctx, &oauth_client.UpdateOAuthClientInput{
+					PublicID:          created.Client.ID,
+					PKCERequired:      &pkceRequired,
+					ExpectedUpdatedAt: &created.Client.UpdatedAt,
+				})
+				errs <- err
+			}()
+		}
+		wg.Wait()
+		close(errs)
+
+		updated := 0
+		for err := range errs {
+			if err == nil {
+				updated++
+				continue
+			}
+			assert.ErrorIs(t, err, oauth_client.ErrOAuthClientVersionConflict)
+		}
+		assert.Equal(t, 1, updated, "exactly one update of a given version wins")
+	})
+}
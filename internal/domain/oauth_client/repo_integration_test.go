@@ -0,0 +1,49 @@
+package oauth_client_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hrz8/altalune/internal/domain/oauth_client"
+	"github.com/hrz8/altalune/internal/shared/password"
+	"github.com/hrz8/altalune/internal/testdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepoIntegration(t *testing.T) {
+	ctx := context.Background()
+	db := testdb.Tx(t)
+	repo := oauth_client.NewRepo(db, testHashOption)
+
+	created, err := repo.Create(ctx, &oauth_client.CreateOAuthClientInput{
+		Name:         "Integration " + testdb.Token(t),
+		RedirectURIs: []string{"https://example.com/callback", "https://example.com/silent"},
+		PKCERequired: true,
+		Confidential: true,
+	})
+	require.NoError(t, err)
+
+	byClientID, err := repo.GetByClientID(ctx, created.Client.ClientID.String())
+	require.NoError(t, err)
+	assert.Equal(t, created.Client.RedirectURIs, byClientID.RedirectURIs, "redirect URIs round-trip through the array column")
+
+	hash, err := repo.RevealClientSecret(ctx, created.Client.ID)
+	require.NoError(t, err)
+	info, err := password.InspectHash(hash)
+	require.NoError(t, err)
+	assert.Equal(t, testHashOption.Memory, info.Memory, "secrets are hashed with the configured parameters")
+
+	pkceRequired := false
+	updated, err := repo.Update(ctx, &oauth_client.UpdateOAuthClientInput{
+		PublicID:          created.Client.ID,
+		PKCERequired:      &pkceRequired,
+		ExpectedUpdatedAt: &byClientID.UpdatedAt,
+	})
+	require.NoError(t, err)
+	assert.False(t, updated.PKCERequired)
+
+	require.NoError(t, repo.Delete(ctx, created.Client.ID))
+	_, err = repo.GetByPublicID(ctx, created.Client.ID)
+	assert.ErrorIs(t, err, oauth_client.ErrOAuthClientNotFound)
+}
@@ -0,0 +1,192 @@
+package oauth_client
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hrz8/altalune/internal/postgres"
+	"github.com/hrz8/altalune/internal/shared/query"
+)
+
+// refreshTokenSelect selects the columns scanned by scanRefreshToken. Tokens
+// outlive the clients purged from the trash, hence the outer join.
+var refreshTokenSelect = `
+	SELECT t.id, u.public_id, COALESCE(u.email, ''),
+	       COALESCE(c.public_id, ''), COALESCE(c.name, ''),
+	       t.scope, ` + refreshTokenStatusSQL() + `,
+	       t.expires_at, t.exchange_at, t.revoked_at, t.created_at
+	FROM altalune_oauth_refresh_tokens t
+	JOIN altalune_users u ON u.id = t.user_id
+	LEFT JOIN altalune_oauth_clients c ON c.client_id = t.client_id
+`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanRefreshToken(row rowScanner) (*RefreshToken, error) {
+	var rt RefreshToken
+	var exchangedAt, revokedAt sql.NullTime
+	err := row.Scan(
+		&rt.ID,
+		&rt.UserID,
+		&rt.UserEmail,
+		&rt.ClientID,
+		&rt.ClientName,
+		&rt.Scope,
+		&rt.Status,
+		&rt.ExpiresAt,
+		&exchangedAt,
+		&revokedAt,
+		&rt.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if exchangedAt.Valid {
+		rt.ExchangedAt = &exchangedAt.Time
+	}
+	if revokedAt.Valid {
+		rt.RevokedAt = &revokedAt.Time
+	}
+	return &rt, nil
+}
+
+// QueryRefreshTokens returns a paginated list of the refresh tokens matching
+// filter. The keyword searches the user email and the client name.
+func (r *repo) QueryRefreshTokens(ctx context.Context, filter *RefreshTokenFilter, params *query.QueryParams) (*query.QueryResult[RefreshToken], error) {
+	baseQuery := refreshTokenSelect + " WHERE 1=1"
+
+	var whereConditions []string
+	var args []any
+	argCounter := 1
+
+	if filter.UserID != "" {
+		whereConditions = append(whereConditions, fmt.Sprintf("u.public_id = $%d", argCounter))
+		args = append(args, filter.UserID)
+		argCounter++
+	}
+	if filter.ClientID != "" {
+		whereConditions = append(whereConditions, fmt.Sprintf("c.public_id = $%d", argCounter))
+		args = append(args, filter.ClientID)
+		argCounter++
+	}
+
+	if params.Keyword != "" {
+		searchCondition := fmt.Sprintf("(LOWER(u.email) LIKE $%d OR LOWER(c.name) LIKE $%d)", argCounter, argCounter)
+		whereConditions = append(whereConditions, searchCondition)
+		args = append(args, "%"+strings.ToLower(params.Keyword)+"%")
+		argCounter++
+	}
+
+	if values := params.Filters["statuses"]; len(values) > 0 {
+		var placeholders []string
+		for _, value := range values {
+			if status, ok := ParseRefreshTokenStatus(value); ok {
+				placeholders = append(placeholders, fmt.Sprintf("$%d", argCounter))
+				args = append(args, string(status))
+				argCounter++
+			}
+		}
+		if len(placeholders) == 0 {
+			whereConditions = append(whereConditions, "FALSE")
+		} else {
+			whereConditions = append(whereConditions, fmt.Sprintf("%s IN (%s)", refreshTokenStatusSQL(), strings.Join(placeholders, ",")))
+		}
+	}
+
+	if len(whereConditions) > 0 {
+		baseQuery += " AND " + strings.Join(whereConditions, " AND ")
+	}
+
+	count, err := postgres.CountRows(ctx, r.db, params.Count, baseQuery, args)
+	if err != nil {
+		return nil, fmt.Errorf("count refresh tokens: %w", err)
+	}
+
+	// Default sorting matches the (user_id|client_id, created_at, id) indexes
+	sortKey := postgres.SortKey{Expr: "t.created_at", ID: "t.id", Desc: true}
+	if params.Sorting != nil && params.Sorting.Field != "" {
+		switch params.Sorting.Field {
+		case "created_at", "expires_at":
+			sortKey = postgres.NewSortKey("t."+params.Sorting.Field, params.Sorting)
+			sortKey.ID = "t.id"
+		}
+	}
+	if condition, keysetArgs := sortKey.After(params.Keyset, argCounter); condition != "" {
+		baseQuery += " AND " + condition
+		args = append(args, keysetArgs...)
+		argCounter += len(keysetArgs)
+	}
+	baseQuery += sortKey.OrderBy()
+
+	baseQuery += fmt.Sprintf(" LIMIT $%d OFFSET $%d", argCounter, argCounter+1)
+	args = append(args, params.PageLimit(), params.FetchOffset())
+
+	rows, err := r.db.QueryContext(ctx, baseQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query refresh tokens: %w", err)
+	}
+	defer rows.Close()
+
+	data := make([]*RefreshToken, 0)
+	for rows.Next() {
+		rt, err := scanRefreshToken(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan refresh token: %w", err)
+		}
+		data = append(data, rt)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	queryResult := &query.QueryResult[RefreshToken]{
+		Data:    data,
+		Filters: map[string][]string{"statuses": RefreshTokenStatusValues()},
+	}
+	query.FillPage(queryResult, params, count)
+	queryResult.TotalPages = max(queryResult.TotalPages, 1)
+	if n := len(data); n > 0 {
+		// Both sort columns are scanned already; Postgres reads them back
+		// from their RFC 3339 form
+		last := data[n-1]
+		sortValue := last.CreatedAt
+		if sortKey.Expr == "t.expires_at" {
+			sortValue = last.ExpiresAt
+		}
+		queryResult.NextKeyset = &query.Keyset{SortKey: sortValue.Format(time.RFC3339Nano), AfterID: last.ID}
+	}
+	return queryResult, nil
+}
+
+// RevokeRefreshToken revokes an active refresh token. Revocation sets
+// exchange_at too, so the token endpoint rejects the token from then on.
+func (r *repo) RevokeRefreshToken(ctx context.Context, id int64) (*RefreshToken, bool, error) {
+	revokeQuery := `
+		UPDATE altalune_oauth_refresh_tokens
+		SET exchange_at = NOW(), revoked_at = NOW(), updated_at = NOW()
+		WHERE id = $1 AND exchange_at IS NULL AND expires_at > NOW()
+	`
+	result, err := r.db.ExecContext(ctx, revokeQuery, id)
+	if err != nil {
+		return nil, false, fmt.Errorf("revoke refresh token: %w", err)
+	}
+	revoked, err := result.RowsAffected()
+	if err != nil {
+		return nil, false, fmt.Errorf("get rows affected: %w", err)
+	}
+
+	rt, err := scanRefreshToken(r.db.QueryRowContext(ctx, refreshTokenSelect+" WHERE t.id = $1", id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, false, ErrRefreshTokenNotFound
+		}
+		return nil, false, fmt.Errorf("get refresh token: %w", err)
+	}
+	return rt, revoked > 0, nil
+}
@@ -0,0 +1,57 @@
+package oauth_client
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/hrz8/altalune/internal/auth"
+)
+
+// GetTokenClaims returns how the access tokens of a client carry permissions
+func (r *repo) GetTokenClaims(ctx context.Context, publicID string) (*TokenClaims, error) {
+	selectQuery := `
+		SELECT perms_claim, perms_claim_max_bytes
+		FROM altalune_oauth_clients
+		WHERE public_id = $1 AND deleted_at IS NULL
+	`
+
+	var claims TokenClaims
+	err := r.db.QueryRowContext(ctx, selectQuery, publicID).Scan(&claims.PermsClaim, &claims.PermsMaxBytes)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrOAuthClientNotFound
+		}
+		return nil, fmt.Errorf("get oauth client token claims: %w", err)
+	}
+
+	return &claims, nil
+}
+
+// UpdateTokenClaims replaces how the access tokens of a client carry
+// permissions. Tokens issued before keep their claims until they expire.
+func (r *repo) UpdateTokenClaims(ctx context.Context, input *UpdateTokenClaimsInput) (*TokenClaims, error) {
+	updateQuery := `
+		UPDATE altalune_oauth_clients
+		SET perms_claim = $2, perms_claim_max_bytes = $3,
+		    updated_at = CURRENT_TIMESTAMP, updated_by = NULLIF($4, '')
+		WHERE public_id = $1 AND deleted_at IS NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, updateQuery, input.PublicID, input.PermsClaim, input.PermsMaxBytes, auth.ActorID(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("update oauth client token claims: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return nil, ErrOAuthClientNotFound
+	}
+
+	claims := input.TokenClaims
+	return &claims, nil
+}
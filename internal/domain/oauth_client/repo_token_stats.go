@@ -0,0 +1,82 @@
+package oauth_client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// QueryTokenStats returns the hourly token request counts of a client
+func (r *repo) QueryTokenStats(ctx context.Context, clientID uuid.UUID, from, to time.Time) ([]*TokenStatsBucket, error) {
+	selectQuery := `
+		SELECT hour, issued, failed
+		FROM altalune_oauth_client_token_stats
+		WHERE client_id = $1 AND hour >= $2 AND hour < $3
+		ORDER BY hour
+	`
+	rows, err := r.db.QueryContext(ctx, selectQuery, clientID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("query token stats: %w", err)
+	}
+	defer rows.Close()
+
+	buckets := make([]*TokenStatsBucket, 0)
+	for rows.Next() {
+		var b TokenStatsBucket
+		if err := rows.Scan(&b.Hour, &b.Issued, &b.Failed); err != nil {
+			return nil, fmt.Errorf("scan token stats: %w", err)
+		}
+		b.Hour = b.Hour.UTC()
+		buckets = append(buckets, &b)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	return buckets, nil
+}
+
+// SumTokenStats totals the token request counts of every client over a range
+// of hours. Counts outlive the clients purged from the trash, hence the outer
+// join.
+func (r *repo) SumTokenStats(ctx context.Context, from, to time.Time) ([]*ClientTokenStats, error) {
+	selectQuery := `
+		SELECT s.client_id, COALESCE(c.public_id, ''), COALESCE(c.name, ''),
+		       SUM(s.issued), SUM(s.failed)
+		FROM altalune_oauth_client_token_stats s
+		LEFT JOIN altalune_oauth_clients c ON c.client_id = s.client_id
+		WHERE s.hour >= $1 AND s.hour < $2
+		GROUP BY s.client_id, c.public_id, c.name
+		ORDER BY s.client_id
+	`
+	rows, err := r.db.QueryContext(ctx, selectQuery, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("sum token stats: %w", err)
+	}
+	defer rows.Close()
+
+	stats := make([]*ClientTokenStats, 0)
+	for rows.Next() {
+		var s ClientTokenStats
+		if err := rows.Scan(&s.ClientID, &s.PublicID, &s.Name, &s.Issued, &s.Failed); err != nil {
+			return nil, fmt.Errorf("scan token stats: %w", err)
+		}
+		stats = append(stats, &s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	return stats, nil
+}
+
+// PurgeTokenStats permanently removes the token request counts of old hours
+func (r *repo) PurgeTokenStats(ctx context.Context, before time.Time) (int64, error) {
+	purgeQuery := "DELETE FROM altalune_oauth_client_token_stats WHERE hour < $1"
+	result, err := r.db.ExecContext(ctx, purgeQuery, before)
+	if err != nil {
+		return 0, fmt.Errorf("purge token stats: %w", err)
+	}
+
+	return result.RowsAffected()
+}
@@ -0,0 +1,94 @@
+package oauth_client
+
+import (
+	"context"
+	"time"
+
+	"github.com/hrz8/altalune"
+	project_domain "github.com/hrz8/altalune/internal/domain/project"
+)
+
+// SecretPolicyJobName is the name the secret policy job is registered with on
+// the scheduler
+const SecretPolicyJobName = "oauth_client.secret_policy"
+
+// SecretPolicyJobSpec runs the job once a day
+const SecretPolicyJobSpec = "@daily"
+
+// DefaultPolicyRepositor defines the lookup of the credential policy the
+// secrets of the global clients follow
+type DefaultPolicyRepositor interface {
+	GetDefaultCredentialPolicy(ctx context.Context) (*project_domain.CredentialPolicy, error)
+}
+
+// SecretPolicyJob finds the client secrets expiring later than the maximum
+// client secret lifetime of the default project allows, secrets set before
+// the policy. It flags them and, when the policy says so, expires those that
+// outlived the maximum, so they stop authenticating their client.
+type SecretPolicyJob struct {
+	projects DefaultPolicyRepositor
+	clients  Repositor
+	log      altalune.Logger
+	now      func() time.Time
+}
+
+// NewSecretPolicyJob creates an OAuth client secret policy job.
+func NewSecretPolicyJob(projects DefaultPolicyRepositor, clients Repositor, log altalune.Logger) *SecretPolicyJob {
+	return &SecretPolicyJob{
+		projects: projects,
+		clients:  clients,
+		log:      log,
+		now:      time.Now,
+	}
+}
+
+// Run enforces the policy on every client secret. A client failing is logged
+// and skipped so the others are still enforced.
+func (j *SecretPolicyJob) Run(ctx context.Context) error {
+	policy, err := j.projects.GetDefaultCredentialPolicy(ctx)
+	if err != nil {
+		return err
+	}
+	if policy.ClientSecretMaxLifetimeDays <= 0 {
+		return nil
+	}
+
+	secrets, err := j.clients.ListSecrets(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := j.now()
+	disable := policy.Action == project_domain.CredentialPolicyActionDisable
+	for _, secret := range secrets {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		limit, _ := policy.ClientSecretExpiration(secret.SetAt, time.UTC)
+		if secret.ExpiresAt != nil && !secret.ExpiresAt.After(limit) {
+			continue
+		}
+		// Secrets are only expired once they outlive the limit, until then
+		// they are flagged
+		if !disable || limit.After(now) {
+			j.log.Warn("oauth client secret out of policy",
+				"client_public_id", secret.PublicID,
+				"name", secret.Name,
+				"set_at", secret.SetAt,
+				"expires_at", secret.ExpiresAt,
+				"maxLifetimeDays", policy.ClientSecretMaxLifetimeDays,
+			)
+			continue
+		}
+		if err := j.clients.ExpireSecret(ctx, secret.PublicID, limit); err != nil {
+			j.log.Error("failed to expire oauth client secret", "error", err, "client_public_id", secret.PublicID)
+			continue
+		}
+		j.log.Info("oauth client secret expired by policy",
+			"client_public_id", secret.PublicID,
+			"name", secret.Name,
+			"maxLifetimeDays", policy.ClientSecretMaxLifetimeDays,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package oauth_client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	project_domain "github.com/hrz8/altalune/internal/domain/project"
+	"github.com/hrz8/altalune/internal/shared/password"
+	"github.com/hrz8/altalune/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeDefaultPolicy struct {
+	policy project_domain.CredentialPolicy
+}
+
+func (f *fakeDefaultPolicy) GetDefaultCredentialPolicy(context.Context) (*project_domain.CredentialPolicy, error) {
+	policy := f.policy
+	return &policy, nil
+}
+
+func TestSecretPolicyJob(t *testing.T) {
+	ctx := context.Background()
+	day := 24 * time.Hour
+
+	tests := []struct {
+		name    string
+		policy  project_domain.CredentialPolicy
+		after   time.Duration
+		expired bool
+	}{
+		{
+			name:   "no maximum",
+			policy: project_domain.CredentialPolicy{Action: project_domain.CredentialPolicyActionDisable},
+			after:  400 * day,
+		},
+		{
+			name:   "flagged only",
+			policy: project_domain.CredentialPolicy{ClientSecretMaxLifetimeDays: 30, Action: project_domain.CredentialPolicyActionFlag},
+			after:  40 * day,
+		},
+		{
+			name:   "within the maximum",
+			policy: project_domain.CredentialPolicy{ClientSecretMaxLifetimeDays: 30, Action: project_domain.CredentialPolicyActionDisable},
+			after:  20 * day,
+		},
+		{
+			name:    "outlived the maximum",
+			policy:  project_domain.CredentialPolicy{ClientSecretMaxLifetimeDays: 30, Action: project_domain.CredentialPolicyActionDisable},
+			after:   40 * day,
+			expired: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clients := NewInMemRepo(password.HashOption{Iterations: 1, Memory: 1024, Threads: 1, Len: 32})
+			legacy, err := clients.Create(ctx, &CreateOAuthClientInput{Name: "Legacy", Confidential: true})
+			require.NoError(t, err)
+			public, err := clients.Create(ctx, &CreateOAuthClientInput{Name: "Public"})
+			require.NoError(t, err)
+
+			job := NewSecretPolicyJob(&fakeDefaultPolicy{policy: tt.policy}, clients, logger.New("error"))
+			job.now = func() time.Time { return time.Now().Add(tt.after) }
+			require.NoError(t, job.Run(ctx))
+
+			found, err := clients.GetByPublicID(ctx, legacy.Client.ID)
+			require.NoError(t, err)
+			if !tt.expired {
+				assert.Nil(t, found.SecretExpiresAt)
+				return
+			}
+			secrets, err := clients.ListSecrets(ctx)
+			require.NoError(t, err)
+			require.Len(t, secrets, 1)
+			limit, _ := tt.policy.ClientSecretExpiration(secrets[0].SetAt, time.UTC)
+			if assert.NotNil(t, found.SecretExpiresAt) {
+				assert.True(t, limit.Equal(*found.SecretExpiresAt), "expired at the end of its last day")
+			}
+			found, err = clients.GetByPublicID(ctx, public.Client.ID)
+			require.NoError(t, err)
+			assert.Nil(t, found.SecretExpiresAt, "public clients have no secret")
+		})
+	}
+}
+
+func TestSecretPolicyJobKeepsSoonerExpiries(t *testing.T) {
+	ctx := context.Background()
+	clients := NewInMemRepo(password.HashOption{Iterations: 1, Memory: 1024, Threads: 1, Len: 32})
+	soon := time.Now().Add(time.Hour).UTC()
+	created, err := clients.Create(ctx, &CreateOAuthClientInput{Name: "Rotated", Confidential: true, SecretExpiresAt: &soon})
+	require.NoError(t, err)
+
+	policy := project_domain.CredentialPolicy{ClientSecretMaxLifetimeDays: 1, Action: project_domain.CredentialPolicyActionDisable}
+	job := NewSecretPolicyJob(&fakeDefaultPolicy{policy: policy}, clients, logger.New("error"))
+	job.now = func() time.Time { return time.Now().Add(10 * 24 * time.Hour) }
+	require.NoError(t, job.Run(ctx))
+
+	found, err := clients.GetByPublicID(ctx, created.Client.ID)
+	require.NoError(t, err)
+	require.NotNil(t, found.SecretExpiresAt)
+	assert.True(t, soon.Equal(*found.SecretExpiresAt))
+}
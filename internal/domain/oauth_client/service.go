@@ -4,13 +4,19 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"slices"
 	"strings"
+	"time"
 
 	"buf.build/go/protovalidate"
 	"github.com/hrz8/altalune"
 	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
 	project_domain "github.com/hrz8/altalune/internal/domain/project"
+	"github.com/hrz8/altalune/internal/shared/events"
+	"github.com/hrz8/altalune/internal/shared/jwt"
 	"github.com/hrz8/altalune/internal/shared/query"
+	"github.com/hrz8/altalune/internal/shared/secretdelivery"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Service struct {
@@ -19,14 +25,20 @@ type Service struct {
 	log             altalune.Logger
 	projectRepo     project_domain.Repositor
 	oauthClientRepo Repositor
+	deliverer       *secretdelivery.Deliverer
+	limiter         PlanLimiter
+	events          *events.Bus
 }
 
-func NewService(v protovalidate.Validator, log altalune.Logger, projectRepo project_domain.Repositor, oauthClientRepo Repositor) *Service {
+func NewService(v protovalidate.Validator, log altalune.Logger, projectRepo project_domain.Repositor, oauthClientRepo Repositor, deliverer *secretdelivery.Deliverer, limiter PlanLimiter, eventBus *events.Bus) *Service {
 	return &Service{
 		validator:       v,
 		log:             log,
 		projectRepo:     projectRepo,
 		oauthClientRepo: oauthClientRepo,
+		deliverer:       deliverer,
+		limiter:         limiter,
+		events:          eventBus,
 	}
 }
 
@@ -55,13 +67,34 @@ func (s *Service) CreateOAuthClient(ctx context.Context, req *altalunev1.CreateO
 		pkceRequired = true
 	}
 
-	// 4. Create OAuth client with Argon2 hashed secret (only for confidential clients)
+	// 4. Clients are global: their secrets follow the policy of the default
+	// project. Public clients have no secret to deliver.
+	policy, err := s.defaultCredentialPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Confidential {
+		if err := secretdelivery.CheckRecipient(policy.SecretDelivery, req.RecipientPublicKey); err != nil {
+			return nil, altalune.NewInvalidPayloadError(err.Error())
+		}
+	}
+
+	// 5. Clients count against the plan of the default project
+	if err := s.limiter.CheckOAuthClientAdd(ctx); err != nil {
+		return nil, err
+	}
+
+	// 6. Create OAuth client with Argon2 hashed secret (only for confidential clients)
 	input := &CreateOAuthClientInput{
 		Name:          strings.TrimSpace(req.Name),
 		RedirectURIs:  req.RedirectUris,
 		PKCERequired:  pkceRequired,
 		AllowedScopes: req.AllowedScopes,
 		Confidential:  req.Confidential,
+		ExternalID:    req.ExternalId,
+	}
+	if expiresAt, ok := policy.ClientSecretExpiration(time.Now(), time.UTC); ok {
+		input.SecretExpiresAt = &expiresAt
 	}
 
 	result, err := s.oauthClientRepo.Create(ctx, input)
@@ -69,6 +102,9 @@ func (s *Service) CreateOAuthClient(ctx context.Context, req *altalunev1.CreateO
 		if err == ErrOAuthClientAlreadyExists {
 			return nil, altalune.NewOAuthClientAlreadyExistsError(req.Name)
 		}
+		if err == ErrExternalIDTaken {
+			return nil, altalune.NewExternalIDTakenError("OAuth client", req.ExternalId)
+		}
 		s.log.Error("failed to create oauth client",
 			"error", err,
 			"name", req.Name,
@@ -76,21 +112,40 @@ func (s *Service) CreateOAuthClient(ctx context.Context, req *altalunev1.CreateO
 		return nil, altalune.NewUnexpectedError("failed to create oauth client: %w", err)
 	}
 
-	// 5. Log successful creation
+	// 7. Log successful creation
 	s.log.Info("oauth client created",
 		"client_public_id", result.Client.ID,
 		"client_id", result.Client.ClientID.String(),
 		"name", result.Client.Name,
 		"confidential", result.Client.Confidential,
 	)
+	s.events.Publish(ctx, OAuthClientCreated{
+		ClientPublicID: result.Client.ID,
+		OAuthClientID:  result.Client.ClientID,
+		Name:           result.Client.Name,
+		Confidential:   result.Client.Confidential,
+	})
 
-	// 6. Return client with PLAINTEXT secret (ONLY time it's returned)
+	// 8. Return client with PLAINTEXT secret (ONLY time it's returned) when
+	// the policy allows, otherwise hand it over as the policy requires.
 	// For public clients, ClientSecret will be empty string
-	return &altalunev1.CreateOAuthClientResponse{
-		Client:       result.Client.ToOAuthClientProto(),
-		ClientSecret: result.ClientSecret,
-		Message:      "OAuth client created successfully",
-	}, nil
+	response := &altalunev1.CreateOAuthClientResponse{
+		Client:  result.Client.ToOAuthClientProto(),
+		Message: "OAuth client created successfully",
+	}
+	if result.ClientSecret != "" {
+		delivery, err := s.deliverer.Deliver(ctx, policy.SecretDelivery, result.ClientSecret, req.RecipientPublicKey)
+		if err != nil {
+			s.log.Error("failed to deliver oauth client secret",
+				"error", err,
+				"client_public_id", result.Client.ID,
+			)
+			return nil, altalune.NewUnexpectedError("failed to deliver oauth client secret: %w", err)
+		}
+		response.ClientSecret = delivery.Secret
+		response.DeliveredClientSecret = delivery.ToDeliveredSecretProto()
+	}
+	return response, nil
 }
 
 // QueryOAuthClients returns a paginated list of all OAuth clients (global)
@@ -107,6 +162,11 @@ func (s *Service) QueryOAuthClients(ctx context.Context, req *altalunev1.QueryOA
 
 	// 3. Convert proto request to domain query params
 	queryParams := query.DefaultQueryParams(req.Query)
+	queryParams.Trashed = req.Trashed
+
+	if err := queryParams.ApplyCursor(req.Query.GetCursor()); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
 
 	// 4. Query OAuth clients from repository (global, no project filter)
 	result, err := s.oauthClientRepo.Query(ctx, queryParams)
@@ -123,9 +183,10 @@ func (s *Service) QueryOAuthClients(ctx context.Context, req *altalunev1.QueryOA
 		return &altalunev1.QueryOAuthClientsResponse{
 			Clients: []*altalunev1.OAuthClient{},
 			Meta: &altalunev1.QueryMetaResponse{
-				RowCount:  0,
-				PageCount: 0,
-				Filters:   make(map[string]*altalunev1.FilterValues),
+				RowCount:   0,
+				PageCount:  0,
+				ExactTotal: true,
+				Filters:    make(map[string]*altalunev1.FilterValues),
 			},
 			Message: "No OAuth clients found",
 		}, nil
@@ -146,9 +207,13 @@ func (s *Service) QueryOAuthClients(ctx context.Context, req *altalunev1.QueryOA
 	return &altalunev1.QueryOAuthClientsResponse{
 		Clients: clients,
 		Meta: &altalunev1.QueryMetaResponse{
-			RowCount:  result.TotalRows,
-			PageCount: result.TotalPages,
-			Filters:   protoFilters,
+			RowCount:    result.TotalRows,
+			PageCount:   result.TotalPages,
+			Filters:     protoFilters,
+			CountMode:   query.CountModeToProto(result.Count),
+			HasNextPage: result.HasMore,
+			NextCursor:  query.NextCursor(queryParams, result),
+			ExactTotal:  result.ExactTotal(),
 		},
 		Message: fmt.Sprintf("Found %d OAuth clients", result.TotalRows),
 	}, nil
@@ -227,12 +292,20 @@ func (s *Service) UpdateOAuthClient(ctx context.Context, req *altalunev1.UpdateO
 		input.RedirectURIs = req.RedirectUris
 	}
 
+	if req.ExpectedUpdatedAt != nil {
+		expectedUpdatedAt := req.ExpectedUpdatedAt.AsTime()
+		input.ExpectedUpdatedAt = &expectedUpdatedAt
+	}
+
 	// 7. Update OAuth client
 	updatedClient, err := s.oauthClientRepo.Update(ctx, input)
 	if err != nil {
 		if err == ErrOAuthClientNotFound {
 			return nil, altalune.NewOAuthClientNotFoundError(req.Id)
 		}
+		if err == ErrOAuthClientVersionConflict {
+			return nil, altalune.NewVersionConflictError("OAuth client", req.Id)
+		}
 		if err == ErrOAuthClientAlreadyExists {
 			return nil, altalune.NewOAuthClientAlreadyExistsError(*req.Name)
 		}
@@ -303,6 +376,202 @@ func (s *Service) DeleteOAuthClient(ctx context.Context, req *altalunev1.DeleteO
 	}, nil
 }
 
+// RestoreOAuthClient restores an OAuth client from the trash (global)
+func (s *Service) RestoreOAuthClient(ctx context.Context, req *altalunev1.RestoreOAuthClientRequest) (*altalunev1.RestoreOAuthClientResponse, error) {
+	// 1. Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	// 2. A restored client counts against the plan again
+	if err := s.limiter.CheckOAuthClientAdd(ctx); err != nil {
+		return nil, err
+	}
+
+	// 3. Restore OAuth client
+	client, err := s.oauthClientRepo.Restore(ctx, req.Id)
+	if err != nil {
+		if err == ErrOAuthClientNotFound {
+			return nil, altalune.NewOAuthClientNotFoundError(req.Id)
+		}
+		s.log.Error("failed to restore oauth client",
+			"error", err,
+			"client_public_id", req.Id,
+		)
+		return nil, altalune.NewUnexpectedError("failed to restore oauth client: %w", err)
+	}
+
+	// 4. Log successful restore
+	s.log.Info("oauth client restored",
+		"client_public_id", req.Id,
+		"name", client.Name,
+	)
+
+	return &altalunev1.RestoreOAuthClientResponse{
+		Client:  client.ToOAuthClientProto(),
+		Message: "OAuth client restored successfully",
+	}, nil
+}
+
+// ApplyOAuthClient creates the client with the external ID, or brings the
+// client that has it to the request: restored when trashed, and updated when
+// its name, redirect URIs or PKCE differ. Whether the client is confidential
+// cannot change.
+func (s *Service) ApplyOAuthClient(ctx context.Context, req *altalunev1.ApplyOAuthClientRequest) (*altalunev1.ApplyOAuthClientResponse, error) {
+	// 1. Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	// 2. Find the client with the external ID, in the trash or not
+	existing, err := s.oauthClientRepo.GetByExternalID(ctx, req.ExternalId)
+	if err != nil && err != ErrOAuthClientNotFound {
+		s.log.Error("failed to get oauth client by external id",
+			"error", err,
+			"external_id", req.ExternalId,
+		)
+		return nil, altalune.NewUnexpectedError("failed to get oauth client by external id: %w", err)
+	}
+
+	// 3. Create it when there is none, the secret being returned this once
+	if existing == nil {
+		created, err := s.CreateOAuthClient(ctx, &altalunev1.CreateOAuthClientRequest{
+			Name:               req.Name,
+			RedirectUris:       req.RedirectUris,
+			PkceRequired:       req.PkceRequired,
+			AllowedScopes:      req.AllowedScopes,
+			Confidential:       req.Confidential,
+			RecipientPublicKey: req.RecipientPublicKey,
+			ExternalId:         req.ExternalId,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &altalunev1.ApplyOAuthClientResponse{
+			Client:                created.Client,
+			Created:               true,
+			ClientSecret:          created.ClientSecret,
+			DeliveredClientSecret: created.DeliveredClientSecret,
+			Message:               created.Message,
+		}, nil
+	}
+
+	if existing.Confidential != req.Confidential {
+		return nil, altalune.NewImmutableFieldChangedError("OAuth client", "confidential")
+	}
+
+	// 4. Bring a trashed client back
+	if existing.DeletedAt != nil {
+		if err := s.limiter.CheckOAuthClientAdd(ctx); err != nil {
+			return nil, err
+		}
+		restored, err := s.oauthClientRepo.Restore(ctx, existing.ID)
+		if err != nil {
+			if err == ErrOAuthClientNotFound {
+				return nil, altalune.NewOAuthClientNotFoundError(existing.ID)
+			}
+			s.log.Error("failed to restore oauth client",
+				"error", err,
+				"client_public_id", existing.ID,
+			)
+			return nil, altalune.NewUnexpectedError("failed to restore oauth client: %w", err)
+		}
+		s.log.Info("oauth client restored",
+			"client_public_id", restored.ID,
+			"external_id", req.ExternalId,
+		)
+		existing = restored
+	}
+
+	// 5. Update only the fields that differ, public clients always using PKCE
+	name := strings.TrimSpace(req.Name)
+	pkceRequired := req.PkceRequired || !req.Confidential
+	update := &altalunev1.UpdateOAuthClientRequest{
+		Id:                existing.ID,
+		ExpectedUpdatedAt: timestamppb.New(existing.UpdatedAt),
+	}
+	if existing.Name != name {
+		update.Name = &name
+	}
+	if !slices.Equal(existing.RedirectURIs, req.RedirectUris) {
+		update.RedirectUris = req.RedirectUris
+	}
+	if existing.PKCERequired != pkceRequired {
+		update.PkceRequired = &pkceRequired
+	}
+	if update.Name == nil && update.RedirectUris == nil && update.PkceRequired == nil {
+		return &altalunev1.ApplyOAuthClientResponse{
+			Client:  existing.ToOAuthClientProto(),
+			Message: "OAuth client is up to date",
+		}, nil
+	}
+
+	updated, err := s.UpdateOAuthClient(ctx, update)
+	if err != nil {
+		return nil, err
+	}
+	return &altalunev1.ApplyOAuthClientResponse{
+		Client:  updated.Client,
+		Message: updated.Message,
+	}, nil
+}
+
+// ImportOAuthClient sets the external ID of the one live client with the
+// name, so ApplyOAuthClient manages it from then on
+func (s *Service) ImportOAuthClient(ctx context.Context, req *altalunev1.ImportOAuthClientRequest) (*altalunev1.ImportOAuthClientResponse, error) {
+	// 1. Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	// 2. Client names are not unique, so the name must single one out
+	name := strings.TrimSpace(req.Name)
+	clients, err := s.oauthClientRepo.ListByName(ctx, name)
+	if err != nil {
+		s.log.Error("failed to list oauth clients by name",
+			"error", err,
+			"name", name,
+		)
+		return nil, altalune.NewUnexpectedError("failed to list oauth clients by name: %w", err)
+	}
+	if len(clients) == 0 {
+		return nil, altalune.NewImportTargetNotFoundError("OAuth client", name)
+	}
+	if len(clients) > 1 {
+		return nil, altalune.NewImportTargetAmbiguousError("OAuth client", name, len(clients))
+	}
+
+	// 3. Set the external ID, unless the client has another one
+	client, err := s.oauthClientRepo.SetExternalID(ctx, clients[0].ID, req.ExternalId)
+	if err != nil {
+		if err == ErrOAuthClientNotFound {
+			return nil, altalune.NewImportTargetNotFoundError("OAuth client", name)
+		}
+		if err == ErrExternalIDTaken {
+			return nil, altalune.NewExternalIDTakenError("OAuth client", req.ExternalId)
+		}
+		if err == ErrOAuthClientAlreadyManaged {
+			return nil, altalune.NewResourceAlreadyManagedError("OAuth client", client.Name, client.ExternalID)
+		}
+		s.log.Error("failed to set oauth client external id",
+			"error", err,
+			"client_public_id", clients[0].ID,
+		)
+		return nil, altalune.NewUnexpectedError("failed to set oauth client external id: %w", err)
+	}
+
+	// 4. Log successful import
+	s.log.Info("oauth client imported",
+		"client_public_id", client.ID,
+		"external_id", client.ExternalID,
+	)
+
+	return &altalunev1.ImportOAuthClientResponse{
+		Client:  client.ToOAuthClientProto(),
+		Message: "OAuth client imported successfully",
+	}, nil
+}
+
 // RevealOAuthClientSecret reveals the hashed client secret (with audit logging, global)
 func (s *Service) RevealOAuthClientSecret(ctx context.Context, req *altalunev1.RevealOAuthClientSecretRequest) (*altalunev1.RevealOAuthClientSecretResponse, error) {
 	// 1. Validate request
@@ -341,6 +610,403 @@ func (s *Service) RevealOAuthClientSecret(ctx context.Context, req *altalunev1.R
 	}, nil
 }
 
+// RotateOAuthClientSecret replaces the secret of a confidential client. The new
+// secret expires and is handed over as the policy of the default project says,
+// like on creation, and the previous one stops authenticating the client.
+func (s *Service) RotateOAuthClientSecret(ctx context.Context, req *altalunev1.RotateOAuthClientSecretRequest) (*altalunev1.RotateOAuthClientSecretResponse, error) {
+	// 1. Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	// 2. The secret follows the policy of the default project
+	policy, err := s.defaultCredentialPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := secretdelivery.CheckRecipient(policy.SecretDelivery, req.RecipientPublicKey); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+	var expiresAt *time.Time
+	if expiration, ok := policy.ClientSecretExpiration(time.Now(), time.UTC); ok {
+		expiresAt = &expiration
+	}
+
+	// 3. Replace the secret
+	result, err := s.oauthClientRepo.RotateSecret(ctx, req.Id, expiresAt)
+	if err != nil {
+		if err == ErrOAuthClientNotFound {
+			return nil, altalune.NewOAuthClientNotFoundError(req.Id)
+		}
+		if err == ErrPublicClientNoSecret {
+			return nil, altalune.NewInvalidPayloadError("public clients do not have a client secret")
+		}
+		s.log.Error("failed to rotate client secret",
+			"error", err,
+			"client_public_id", req.Id,
+		)
+		return nil, altalune.NewUnexpectedError("failed to rotate client secret: %w", err)
+	}
+
+	// 4. Log audit event
+	s.log.Warn("oauth_client_secret_rotated",
+		"client_public_id", req.Id,
+		"expires_at", expiresAt,
+	)
+
+	// 5. Hand the new secret over as the policy requires
+	delivery, err := s.deliverer.Deliver(ctx, policy.SecretDelivery, result.ClientSecret, req.RecipientPublicKey)
+	if err != nil {
+		s.log.Error("failed to deliver oauth client secret",
+			"error", err,
+			"client_public_id", req.Id,
+		)
+		return nil, altalune.NewUnexpectedError("failed to deliver oauth client secret: %w", err)
+	}
+	return &altalunev1.RotateOAuthClientSecretResponse{
+		Client:                result.Client.ToOAuthClientProto(),
+		ClientSecret:          delivery.Secret,
+		DeliveredClientSecret: delivery.ToDeliveredSecretProto(),
+		Message:               "Client secret rotated successfully",
+	}, nil
+}
+
+// defaultCredentialPolicy returns the credential policy of the default
+// project, which the secrets of the global clients follow
+func (s *Service) defaultCredentialPolicy(ctx context.Context) (*project_domain.CredentialPolicy, error) {
+	policy, err := s.projectRepo.GetDefaultCredentialPolicy(ctx)
+	if err != nil {
+		s.log.Error("failed to get default project credential policy", "error", err)
+		return nil, altalune.NewUnexpectedError("failed to get default project credential policy: %w", err)
+	}
+	return policy, nil
+}
+
+// QueryRefreshTokens returns a paginated list of the refresh tokens of a user,
+// a client or both, for investigating incidents (global)
+func (s *Service) QueryRefreshTokens(ctx context.Context, req *altalunev1.QueryRefreshTokensRequest) (*altalunev1.QueryRefreshTokensResponse, error) {
+	// 1. Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	// 2. Listing every token of every user would scan the whole table
+	if req.UserId == "" && req.ClientId == "" {
+		return nil, altalune.NewInvalidPayloadError("user_id or client_id is required")
+	}
+
+	// 3. Query refresh tokens from repository
+	filter := &RefreshTokenFilter{UserID: req.UserId, ClientID: req.ClientId}
+	queryParams := query.DefaultQueryParams(req.Query)
+
+	if err := queryParams.ApplyCursor(req.Query.GetCursor()); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	result, err := s.oauthClientRepo.QueryRefreshTokens(ctx, filter, queryParams)
+	if err != nil {
+		s.log.Error("failed to query refresh tokens",
+			"error", err,
+			"user_public_id", req.UserId,
+			"client_public_id", req.ClientId,
+		)
+		return nil, altalune.NewUnexpectedError("failed to query refresh tokens: %w", err)
+	}
+
+	// 4. Convert domain models and filters to proto messages
+	tokens := make([]*altalunev1.RefreshToken, 0, len(result.Data))
+	for _, token := range result.Data {
+		tokens = append(tokens, token.ToRefreshTokenProto())
+	}
+
+	protoFilters := make(map[string]*altalunev1.FilterValues)
+	for key, values := range result.Filters {
+		protoFilters[key] = &altalunev1.FilterValues{Values: values}
+	}
+
+	return &altalunev1.QueryRefreshTokensResponse{
+		Tokens: tokens,
+		Meta: &altalunev1.QueryMetaResponse{
+			RowCount:    result.TotalRows,
+			PageCount:   result.TotalPages,
+			Filters:     protoFilters,
+			CountMode:   query.CountModeToProto(result.Count),
+			HasNextPage: result.HasMore,
+			NextCursor:  query.NextCursor(queryParams, result),
+			ExactTotal:  result.ExactTotal(),
+		},
+		Message: fmt.Sprintf("Found %d refresh tokens", result.TotalRows),
+	}, nil
+}
+
+// RevokeRefreshToken revokes a refresh token, so the client can no longer use
+// it to obtain access tokens (global)
+func (s *Service) RevokeRefreshToken(ctx context.Context, req *altalunev1.RevokeRefreshTokenRequest) (*altalunev1.RevokeRefreshTokenResponse, error) {
+	// 1. Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	// 2. Revoke the token if it is still active
+	token, revoked, err := s.oauthClientRepo.RevokeRefreshToken(ctx, req.Id)
+	if err != nil {
+		if err == ErrRefreshTokenNotFound {
+			return nil, altalune.NewRefreshTokenNotFoundError(req.Id)
+		}
+		s.log.Error("failed to revoke refresh token",
+			"error", err,
+			"token_id", req.Id,
+		)
+		return nil, altalune.NewUnexpectedError("failed to revoke refresh token: %w", err)
+	}
+
+	if !revoked {
+		return &altalunev1.RevokeRefreshTokenResponse{
+			Token:   token.ToRefreshTokenProto(),
+			Message: fmt.Sprintf("Refresh token is already %s", token.Status),
+		}, nil
+	}
+
+	// 3. Log audit event
+	s.log.Warn("oauth_refresh_token_revoked",
+		"token_id", token.ID,
+		"user_public_id", token.UserID,
+		"client_public_id", token.ClientID,
+	)
+
+	return &altalunev1.RevokeRefreshTokenResponse{
+		Token:   token.ToRefreshTokenProto(),
+		Revoked: true,
+		Message: "Refresh token revoked successfully",
+	}, nil
+}
+
+// GetOAuthClientTokenStats returns the hourly token requests of a client over
+// the last hours, the current one included, for dashboard charts (global)
+func (s *Service) GetOAuthClientTokenStats(ctx context.Context, req *altalunev1.GetOAuthClientTokenStatsRequest) (*altalunev1.GetOAuthClientTokenStatsResponse, error) {
+	// 1. Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	// 2. Get OAuth client; the stats are keyed by its client_id
+	client, err := s.oauthClientRepo.GetByPublicID(ctx, req.Id)
+	if err != nil {
+		if err == ErrOAuthClientNotFound {
+			return nil, altalune.NewOAuthClientNotFoundError(req.Id)
+		}
+		s.log.Error("failed to get oauth client",
+			"error", err,
+			"client_public_id", req.Id,
+		)
+		return nil, altalune.NewUnexpectedError("failed to get oauth client: %w", err)
+	}
+
+	// 3. Query the hours from the oldest charted one to the current one
+	hours := int(req.Hours)
+	if hours == 0 {
+		hours = defaultTokenStatsHours
+	}
+	to := time.Now().UTC().Truncate(time.Hour).Add(time.Hour)
+	from := to.Add(-time.Duration(hours) * time.Hour)
+	buckets, err := s.oauthClientRepo.QueryTokenStats(ctx, client.ClientID, from, to)
+	if err != nil {
+		s.log.Error("failed to query token stats",
+			"error", err,
+			"client_public_id", req.Id,
+		)
+		return nil, altalune.NewUnexpectedError("failed to query token stats: %w", err)
+	}
+
+	// 4. Chart every hour, the ones without requests included
+	response := &altalunev1.GetOAuthClientTokenStatsResponse{
+		Buckets: make([]*altalunev1.TokenStatsBucket, 0, hours),
+		Message: fmt.Sprintf("Token stats of the last %d hours", hours),
+	}
+	for _, bucket := range fillTokenStats(buckets, from, hours) {
+		response.Buckets = append(response.Buckets, bucket.ToTokenStatsBucketProto())
+		response.TotalIssued += bucket.Issued
+		response.TotalFailed += bucket.Failed
+	}
+	return response, nil
+}
+
+// GetOAuthClientTokenClaims returns how the access tokens of a client carry
+// the permissions of their user (global)
+func (s *Service) GetOAuthClientTokenClaims(ctx context.Context, req *altalunev1.GetOAuthClientTokenClaimsRequest) (*altalunev1.GetOAuthClientTokenClaimsResponse, error) {
+	// 1. Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	// 2. Get token claims from repository
+	claims, err := s.oauthClientRepo.GetTokenClaims(ctx, req.Id)
+	if err != nil {
+		if err == ErrOAuthClientNotFound {
+			return nil, altalune.NewOAuthClientNotFoundError(req.Id)
+		}
+		s.log.Error("failed to get oauth client token claims",
+			"error", err,
+			"client_public_id", req.Id,
+		)
+		return nil, altalune.NewUnexpectedError("failed to get oauth client token claims: %w", err)
+	}
+
+	return &altalunev1.GetOAuthClientTokenClaimsResponse{
+		TokenClaims: claims.ToOAuthClientTokenClaimsProto(),
+	}, nil
+}
+
+// UpdateOAuthClientTokenClaims sets how the access tokens of a client carry
+// the permissions of their user. Tokens already issued keep their claims
+// (global).
+func (s *Service) UpdateOAuthClientTokenClaims(ctx context.Context, req *altalunev1.UpdateOAuthClientTokenClaimsRequest) (*altalunev1.UpdateOAuthClientTokenClaimsResponse, error) {
+	// 1. Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	// 2. Get client to check if default
+	client, err := s.oauthClientRepo.GetByPublicID(ctx, req.Id)
+	if err != nil {
+		if err == ErrOAuthClientNotFound {
+			return nil, altalune.NewOAuthClientNotFoundError(req.Id)
+		}
+		return nil, altalune.NewUnexpectedError("failed to get oauth client: %w", err)
+	}
+
+	// 3. The dashboard reads the permissions of its user from its tokens
+	input := &UpdateTokenClaimsInput{
+		PublicID: req.Id,
+		TokenClaims: TokenClaims{
+			PermsClaim:    permsClaimModeFromProto(req.PermsClaim),
+			PermsMaxBytes: int(req.PermsClaimMaxBytes),
+		},
+	}
+	if client.IsDefault && (input.PermsClaim != jwt.PermsClaimFull || input.PermsMaxBytes > 0) {
+		return nil, altalune.NewInvalidPayloadError("the default dashboard client must carry every permission")
+	}
+
+	// 4. Update token claims
+	claims, err := s.oauthClientRepo.UpdateTokenClaims(ctx, input)
+	if err != nil {
+		if err == ErrOAuthClientNotFound {
+			return nil, altalune.NewOAuthClientNotFoundError(req.Id)
+		}
+		s.log.Error("failed to update oauth client token claims",
+			"error", err,
+			"client_public_id", req.Id,
+		)
+		return nil, altalune.NewUnexpectedError("failed to update oauth client token claims: %w", err)
+	}
+
+	// 5. Log successful update
+	s.log.Info("oauth client token claims updated",
+		"client_public_id", req.Id,
+		"perms_claim", claims.PermsClaim,
+		"perms_claim_max_bytes", claims.PermsMaxBytes,
+	)
+
+	return &altalunev1.UpdateOAuthClientTokenClaimsResponse{
+		TokenClaims: claims.ToOAuthClientTokenClaimsProto(),
+		Message:     "OAuth client token claims updated successfully",
+	}, nil
+}
+
+// TestAuthorizationFlow walks a synthetic user of a sandbox project through
+// the authorization code flow of a client, running the checks of the
+// authorization server on the requests of the client application. Nothing is
+// stored and no token is issued (global client, sandbox project).
+func (s *Service) TestAuthorizationFlow(ctx context.Context, req *altalunev1.TestAuthorizationFlowRequest) (*altalunev1.TestAuthorizationFlowResponse, error) {
+	// 1. Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	// 2. Only sandbox projects run flows as a synthetic user
+	prj, err := s.projectRepo.GetByID(ctx, req.ProjectId)
+	if err != nil {
+		if err == project_domain.ErrProjectNotFound {
+			return nil, altalune.NewProjectNotFound(req.ProjectId)
+		}
+		s.log.Error("failed to get project",
+			"error", err,
+			"project_id", req.ProjectId,
+		)
+		return nil, altalune.NewUnexpectedError("failed to get project: %w", err)
+	}
+	if prj.Environment != project_domain.EnvironmentStatusSandbox {
+		return nil, altalune.NewProjectNotSandboxError(req.ProjectId)
+	}
+
+	// 3. Get the client, with its secret hash and token claims
+	client, err := s.oauthClientRepo.GetByPublicID(ctx, req.Id)
+	if err != nil {
+		if err == ErrOAuthClientNotFound {
+			return nil, altalune.NewOAuthClientNotFoundError(req.Id)
+		}
+		s.log.Error("failed to get oauth client",
+			"error", err,
+			"client_public_id", req.Id,
+		)
+		return nil, altalune.NewUnexpectedError("failed to get oauth client: %w", err)
+	}
+
+	var secretHash string
+	if client.Confidential {
+		secretHash, err = s.oauthClientRepo.RevealClientSecret(ctx, req.Id)
+		if err != nil && err != ErrPublicClientNoSecret {
+			s.log.Error("failed to get oauth client secret",
+				"error", err,
+				"client_public_id", req.Id,
+			)
+			return nil, altalune.NewUnexpectedError("failed to get oauth client secret: %w", err)
+		}
+	}
+
+	tokenClaims, err := s.oauthClientRepo.GetTokenClaims(ctx, req.Id)
+	if err != nil {
+		s.log.Error("failed to get oauth client token claims",
+			"error", err,
+			"client_public_id", req.Id,
+		)
+		return nil, altalune.NewUnexpectedError("failed to get oauth client token claims: %w", err)
+	}
+
+	// 4. Simulate the flow
+	result := SimulateAuthorizationFlow(&AuthorizationFlowInput{
+		Client:              client,
+		SecretHash:          secretHash,
+		TokenClaims:         tokenClaims,
+		RedirectURI:         req.RedirectUri,
+		Scope:               req.Scope,
+		CodeChallengeMethod: req.CodeChallengeMethod,
+		ClientSecret:        req.ClientSecret,
+	})
+
+	s.log.Info("authorization flow tested",
+		"project_id", req.ProjectId,
+		"client_public_id", req.Id,
+		"succeeded", result.Succeeded,
+	)
+
+	steps := make([]*altalunev1.AuthorizationFlowStep, 0, len(result.Steps))
+	message := "Authorization flow succeeded"
+	for _, step := range result.Steps {
+		steps = append(steps, step.ToAuthorizationFlowStepProto())
+		if step.Outcome == FlowStepFailed {
+			message = fmt.Sprintf("Authorization flow failed at %s", step.Name)
+		}
+	}
+
+	return &altalunev1.TestAuthorizationFlowResponse{
+		Succeeded: result.Succeeded,
+		Steps:     steps,
+		Claims:    result.Claims,
+		Message:   message,
+	}, nil
+}
+
 // isValidRedirectURI validates a redirect URI for OAuth 2.0 compliance
 func isValidRedirectURI(uri string) bool {
 	// Parse URI
@@ -0,0 +1,128 @@
+package oauth_client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"buf.build/go/protovalidate"
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	project_domain "github.com/hrz8/altalune/internal/domain/project"
+	"github.com/hrz8/altalune/internal/redis"
+	"github.com/hrz8/altalune/internal/shared/password"
+	"github.com/hrz8/altalune/internal/shared/secretdelivery"
+	"github.com/hrz8/altalune/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultPolicyProjects serves the credential policy of the default project,
+// the only project data the secret handling of the service reads
+type defaultPolicyProjects struct {
+	project_domain.Repositor
+	policy project_domain.CredentialPolicy
+}
+
+func (p *defaultPolicyProjects) GetDefaultCredentialPolicy(context.Context) (*project_domain.CredentialPolicy, error) {
+	policy := p.policy
+	return &policy, nil
+}
+
+// unlimitedPlan lets any number of OAuth clients be created
+type unlimitedPlan struct{}
+
+func (unlimitedPlan) CheckOAuthClientAdd(context.Context) error { return nil }
+
+func newSecretPolicyService(t *testing.T, maxLifetimeDays int) (*Service, *InMemRepo) {
+	t.Helper()
+
+	v, err := protovalidate.New()
+	require.NoError(t, err)
+	clients := NewInMemRepo(password.HashOption{Iterations: 1, Memory: 1024, Threads: 1, Len: 32})
+	projects := &defaultPolicyProjects{policy: project_domain.CredentialPolicy{
+		Action:                      project_domain.CredentialPolicyActionFlag,
+		SecretDelivery:              secretdelivery.ModeReveal,
+		ClientSecretMaxLifetimeDays: maxLifetimeDays,
+	}}
+	svc := NewService(v, logger.New("error"), projects, clients,
+		secretdelivery.NewDeliverer(redis.NewMemoryStore()), unlimitedPlan{}, nil)
+	return svc, clients
+}
+
+func TestClientSecretExpiration(t *testing.T) {
+	ctx := context.Background()
+	// The end of the last day allowed, in UTC
+	endOfDay := func(days int) time.Time {
+		y, m, d := time.Now().UTC().AddDate(0, 0, days).Date()
+		return time.Date(y, m, d+1, 0, 0, 0, 0, time.UTC).Add(-time.Nanosecond)
+	}
+
+	tests := []struct {
+		name         string
+		maxLifetime  int
+		confidential bool
+		expiresAt    time.Time // Zero for never
+	}{
+		{name: "no maximum", confidential: true},
+		{name: "maximum lifetime", maxLifetime: 90, confidential: true, expiresAt: endOfDay(90)},
+		{name: "public client", maxLifetime: 90},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, _ := newSecretPolicyService(t, tt.maxLifetime)
+
+			created, err := svc.CreateOAuthClient(ctx, &altalunev1.CreateOAuthClientRequest{
+				Name:         "Client " + tt.name,
+				RedirectUris: []string{"https://app.example.com/callback"},
+				Confidential: tt.confidential,
+			})
+			require.NoError(t, err)
+			if tt.confidential {
+				assert.NotEmpty(t, created.ClientSecret)
+			}
+			if tt.expiresAt.IsZero() {
+				assert.Nil(t, created.Client.ClientSecretExpiresAt)
+			} else {
+				require.NotNil(t, created.Client.ClientSecretExpiresAt)
+				assert.True(t, tt.expiresAt.Equal(created.Client.ClientSecretExpiresAt.AsTime()),
+					"expires %s, want %s", created.Client.ClientSecretExpiresAt.AsTime(), tt.expiresAt)
+			}
+		})
+	}
+}
+
+func TestRotateOAuthClientSecret(t *testing.T) {
+	ctx := context.Background()
+	svc, clients := newSecretPolicyService(t, 30)
+
+	confidential, err := svc.CreateOAuthClient(ctx, &altalunev1.CreateOAuthClientRequest{
+		Name:         "Confidential",
+		RedirectUris: []string{"https://app.example.com/callback"},
+		Confidential: true,
+	})
+	require.NoError(t, err)
+
+	// An expired secret is recovered by rotating it
+	require.NoError(t, clients.ExpireSecret(ctx, confidential.Client.Id, time.Now().Add(-time.Hour)))
+
+	rotated, err := svc.RotateOAuthClientSecret(ctx, &altalunev1.RotateOAuthClientSecretRequest{Id: confidential.Client.Id})
+	require.NoError(t, err)
+	assert.NotEmpty(t, rotated.ClientSecret)
+	assert.NotEqual(t, confidential.ClientSecret, rotated.ClientSecret)
+	require.NotNil(t, rotated.Client.ClientSecretExpiresAt)
+	assert.True(t, rotated.Client.ClientSecretExpiresAt.AsTime().After(time.Now().Add(29*24*time.Hour)),
+		"the new secret follows the policy")
+
+	public, err := svc.CreateOAuthClient(ctx, &altalunev1.CreateOAuthClientRequest{
+		Name:         "Public",
+		RedirectUris: []string{"https://app.example.com/callback"},
+	})
+	require.NoError(t, err)
+	_, err = svc.RotateOAuthClientSecret(ctx, &altalunev1.RotateOAuthClientSecretRequest{Id: public.Client.Id})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "public clients have no secret")
+
+	_, err = svc.RotateOAuthClientSecret(ctx, &altalunev1.RotateOAuthClientSecretRequest{Id: "unknown_12345a"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
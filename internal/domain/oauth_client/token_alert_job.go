@@ -0,0 +1,176 @@
+package oauth_client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hrz8/altalune"
+	"github.com/hrz8/altalune/internal/shared/notification"
+	"github.com/hrz8/altalune/internal/shared/tz"
+)
+
+// TokenAlertJobName is the name the token alert job is registered with on the
+// scheduler
+const TokenAlertJobName = "oauth_client.token_alerts"
+
+// TokenAlertJobSpec runs the job a few minutes past every hour, once the
+// requests of the last hour are counted
+const TokenAlertJobSpec = "5 * * * *"
+
+// TokenAlertJob checks the token requests of every client in the last complete
+// hour and posts the anomalies to a webhook and emails them.
+type TokenAlertJob struct {
+	repo         Repositor
+	notification *notification.NotificationService // Nil when emails is empty
+	thresholds   TokenAlertThresholds
+	webhookURL   string
+	emails       []string
+	client       *http.Client
+	log          altalune.Logger
+}
+
+// NewTokenAlertJob creates a token alert job delivering to webhookURL and
+// emails, each of which may be empty.
+func NewTokenAlertJob(
+	repo Repositor,
+	notificationSvc *notification.NotificationService,
+	thresholds TokenAlertThresholds,
+	webhookURL string,
+	emails []string,
+	log altalune.Logger,
+) *TokenAlertJob {
+	return &TokenAlertJob{
+		repo:         repo,
+		notification: notificationSvc,
+		thresholds:   thresholds,
+		webhookURL:   webhookURL,
+		emails:       emails,
+		client:       &http.Client{Timeout: 10 * time.Second},
+		log:          log,
+	}
+}
+
+// tokenAlertPayload is the JSON body posted to the webhook
+type tokenAlertPayload struct {
+	Hour   time.Time           `json:"hour"`
+	Alerts []tokenAlertWebhook `json:"alerts"`
+}
+
+type tokenAlertWebhook struct {
+	Kind           TokenAlertKind `json:"kind"`
+	ClientID       string         `json:"client_id"` // OAuth client_id (UUID)
+	ClientPublicID string         `json:"client_public_id,omitempty"`
+	ClientName     string         `json:"client_name,omitempty"`
+	Issued         int64          `json:"issued"`
+	Failed         int64          `json:"failed"`
+	FailureRate    float64        `json:"failure_rate"`
+	BaselineHourly float64        `json:"baseline_hourly"`
+	Summary        string         `json:"summary"`
+}
+
+// Run alerts on the last complete hour. Failing deliveries are logged so the
+// other channel still gets the alerts.
+func (j *TokenAlertJob) Run(ctx context.Context) error {
+	hour := time.Now().UTC().Truncate(time.Hour).Add(-time.Hour)
+
+	current, err := j.repo.SumTokenStats(ctx, hour, hour.Add(time.Hour))
+	if err != nil {
+		return err
+	}
+	baselineFrom := hour.Add(-time.Duration(j.thresholds.BaselineHours) * time.Hour)
+	baseline, err := j.repo.SumTokenStats(ctx, baselineFrom, hour)
+	if err != nil {
+		return err
+	}
+
+	alerts := EvaluateTokenAlerts(current, baseline, j.thresholds)
+	if len(alerts) == 0 {
+		return nil
+	}
+	for _, alert := range alerts {
+		j.log.Warn("oauth_client_token_alert",
+			"kind", alert.Kind,
+			"client_id", alert.Stats.ClientID,
+			"client_public_id", alert.Stats.PublicID,
+			"issued", alert.Stats.Issued,
+			"failed", alert.Stats.Failed,
+			"hour", hour,
+		)
+	}
+
+	if j.webhookURL != "" {
+		if err := j.postWebhook(ctx, hour, alerts); err != nil {
+			j.log.Warn("failed to post token alerts", "error", err)
+		}
+	}
+	if len(j.emails) > 0 {
+		data := tokenAlertEmailData(hour, alerts)
+		for _, email := range j.emails {
+			if err := j.notification.SendTokenAlertEmail(ctx, email, data); err != nil {
+				j.log.Warn("failed to send token alert email", "error", err)
+			}
+		}
+	}
+	return nil
+}
+
+func (j *TokenAlertJob) postWebhook(ctx context.Context, hour time.Time, alerts []*TokenAlert) error {
+	payload := tokenAlertPayload{Hour: hour, Alerts: make([]tokenAlertWebhook, len(alerts))}
+	for i, alert := range alerts {
+		payload.Alerts[i] = tokenAlertWebhook{
+			Kind:           alert.Kind,
+			ClientID:       alert.Stats.ClientID.String(),
+			ClientPublicID: alert.Stats.PublicID,
+			ClientName:     alert.Stats.Name,
+			Issued:         alert.Stats.Issued,
+			Failed:         alert.Stats.Failed,
+			FailureRate:    alert.FailureRate,
+			BaselineHourly: alert.BaselineHourly,
+			Summary:        alert.Summary(),
+		}
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("encode token alerts: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, j.webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := j.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("post webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook responded %s", resp.Status)
+	}
+	return nil
+}
+
+// tokenAlertEmailData renders alerts for the token alert templates
+func tokenAlertEmailData(hour time.Time, alerts []*TokenAlert) notification.TokenAlertEmailData {
+	items := make([]notification.TokenAlertEmailItem, len(alerts))
+	for i, alert := range alerts {
+		name := alert.Stats.Name
+		if name == "" {
+			name = alert.Stats.ClientID.String()
+		}
+		items[i] = notification.TokenAlertEmailItem{
+			ClientName: name,
+			ClientID:   alert.Stats.PublicID,
+			Summary:    alert.Summary(),
+		}
+	}
+	return notification.TokenAlertEmailData{
+		Hour:   tz.Format(hour, time.UTC),
+		Alerts: items,
+	}
+}
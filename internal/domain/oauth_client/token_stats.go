@@ -0,0 +1,95 @@
+package oauth_client
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// defaultTokenStatsHours is the number of hours charted when a request leaves
+// it unset
+const defaultTokenStatsHours = 24
+
+// fillTokenStats returns one bucket per hour from the hour of from, taking the
+// counts of the matching buckets of the sparse, oldest first buckets
+func fillTokenStats(buckets []*TokenStatsBucket, from time.Time, hours int) []*TokenStatsBucket {
+	from = from.UTC().Truncate(time.Hour)
+	filled := make([]*TokenStatsBucket, hours)
+	next := 0
+	for i := range filled {
+		hour := from.Add(time.Duration(i) * time.Hour)
+		filled[i] = &TokenStatsBucket{Hour: hour}
+		for next < len(buckets) && buckets[next].Hour.Before(hour) {
+			next++
+		}
+		if next < len(buckets) && buckets[next].Hour.Equal(hour) {
+			filled[i].Issued, filled[i].Failed = buckets[next].Issued, buckets[next].Failed
+		}
+	}
+	return filled
+}
+
+// TokenAlertKind is the anomaly a token alert reports
+type TokenAlertKind string
+
+const (
+	// TokenAlertFailureRate reports a client whose requests mostly fail
+	TokenAlertFailureRate TokenAlertKind = "failure_rate"
+	// TokenAlertSpike reports a client whose request volume jumped
+	TokenAlertSpike TokenAlertKind = "spike"
+)
+
+// TokenAlertThresholds configures when the token requests of a client in an
+// hour raise alerts
+type TokenAlertThresholds struct {
+	MinRequests    int64   // Requests below which an hour is too quiet to judge
+	MaxFailureRate float64 // Share of failed requests above which to alert
+	SpikeFactor    float64 // Multiple of the baseline hourly average above which to alert
+	BaselineHours  int     // Hours the baseline average is taken over
+}
+
+// TokenAlert reports an anomaly in the token requests of a client in an hour
+type TokenAlert struct {
+	Kind           TokenAlertKind
+	Stats          ClientTokenStats // Requests of the hour
+	FailureRate    float64          // Share of the requests of the hour that failed
+	BaselineHourly float64          // Average requests per hour over the baseline
+}
+
+// EvaluateTokenAlerts compares the requests of every client in an hour,
+// current, with their totals over the baseline hours before it. A client
+// without requests in the baseline spikes as soon as it reaches MinRequests.
+func EvaluateTokenAlerts(current, baseline []*ClientTokenStats, t TokenAlertThresholds) []*TokenAlert {
+	baselineRequests := make(map[uuid.UUID]int64, len(baseline))
+	for _, stats := range baseline {
+		baselineRequests[stats.ClientID] = stats.Issued + stats.Failed
+	}
+
+	alerts := make([]*TokenAlert, 0)
+	for _, stats := range current {
+		requests := stats.Issued + stats.Failed
+		if requests == 0 || requests < t.MinRequests {
+			continue
+		}
+
+		failureRate := float64(stats.Failed) / float64(requests)
+		baselineHourly := float64(baselineRequests[stats.ClientID]) / float64(max(t.BaselineHours, 1))
+		if failureRate > t.MaxFailureRate {
+			alerts = append(alerts, &TokenAlert{Kind: TokenAlertFailureRate, Stats: *stats, FailureRate: failureRate, BaselineHourly: baselineHourly})
+		}
+		if float64(requests) > t.SpikeFactor*baselineHourly {
+			alerts = append(alerts, &TokenAlert{Kind: TokenAlertSpike, Stats: *stats, FailureRate: failureRate, BaselineHourly: baselineHourly})
+		}
+	}
+	return alerts
+}
+
+// Summary describes the alert for people
+func (a *TokenAlert) Summary() string {
+	requests := a.Stats.Issued + a.Stats.Failed
+	if a.Kind == TokenAlertFailureRate {
+		return fmt.Sprintf("%.0f%% of %d token requests failed", a.FailureRate*100, requests)
+	}
+	return fmt.Sprintf("%d token requests against %.1f per hour before", requests, a.BaselineHourly)
+}
@@ -0,0 +1,39 @@
+package oauth_client_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hrz8/altalune/internal/domain/oauth_client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvaluateTokenAlerts(t *testing.T) {
+	thresholds := oauth_client.TokenAlertThresholds{MinRequests: 20, MaxFailureRate: 0.5, SpikeFactor: 5, BaselineHours: 24}
+	steady, failing, spiking, quiet, fresh := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	current := []*oauth_client.ClientTokenStats{
+		{ClientID: steady, Issued: 90, Failed: 10},
+		{ClientID: failing, Issued: 40, Failed: 60},
+		{ClientID: spiking, Issued: 600},
+		{ClientID: quiet, Failed: 19},
+		{ClientID: fresh, Issued: 20},
+	}
+	baseline := []*oauth_client.ClientTokenStats{
+		{ClientID: steady, Issued: 2400},  // 100 an hour
+		{ClientID: failing, Issued: 2400}, // 100 an hour
+		{ClientID: spiking, Issued: 2400}, // 100 an hour
+	}
+
+	alerts := oauth_client.EvaluateTokenAlerts(current, baseline, thresholds)
+	kinds := make(map[uuid.UUID][]oauth_client.TokenAlertKind)
+	for _, alert := range alerts {
+		kinds[alert.Stats.ClientID] = append(kinds[alert.Stats.ClientID], alert.Kind)
+	}
+
+	assert.NotContains(t, kinds, steady)
+	assert.Equal(t, []oauth_client.TokenAlertKind{oauth_client.TokenAlertFailureRate}, kinds[failing])
+	assert.Equal(t, []oauth_client.TokenAlertKind{oauth_client.TokenAlertSpike}, kinds[spiking])
+	assert.NotContains(t, kinds, quiet, "hours below MinRequests are too quiet to judge")
+	assert.Equal(t, []oauth_client.TokenAlertKind{oauth_client.TokenAlertSpike}, kinds[fresh], "clients without a baseline spike once busy")
+}
@@ -5,6 +5,8 @@ import "errors"
 var (
 	// ErrOAuthProviderNotFound is returned when an OAuth provider is not found
 	ErrOAuthProviderNotFound = errors.New("oauth provider not found")
+	// ErrOAuthProviderVersionConflict is returned when an update is based on a stale version
+	ErrOAuthProviderVersionConflict = errors.New("oauth provider was modified concurrently")
 
 	// ErrDuplicateProviderType is returned when trying to create a provider with an existing type
 	ErrDuplicateProviderType = errors.New("oauth provider with this provider type already exists")
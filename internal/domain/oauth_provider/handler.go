@@ -98,6 +98,22 @@ func (h *Handler) DeleteOAuthProvider(
 	return connect.NewResponse(response), nil
 }
 
+func (h *Handler) RequestClientSecretReveal(
+	ctx context.Context,
+	req *connect.Request[altalunev1.RequestClientSecretRevealRequest],
+) (*connect.Response[altalunev1.RequestClientSecretRevealResponse], error) {
+	// Authorization: requires client:read permission (global)
+	if err := h.auth.CheckPermission(ctx, "client:read"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.RequestClientSecretReveal(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
 func (h *Handler) RevealClientSecret(
 	ctx context.Context,
 	req *connect.Request[altalunev1.RevealClientSecretRequest],
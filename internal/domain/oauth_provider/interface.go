@@ -19,6 +19,9 @@ type Repository interface {
 	// GetByProviderType retrieves an OAuth provider by provider type
 	GetByProviderType(ctx context.Context, providerType ProviderType) (*OAuthProvider, error)
 
+	// ListEnabled returns the enabled OAuth providers, oldest first
+	ListEnabled(ctx context.Context) ([]*OAuthProvider, error)
+
 	// Update updates an OAuth provider (re-encrypts client_secret if provided)
 	Update(ctx context.Context, input *UpdateOAuthProviderInput) (*UpdateOAuthProviderResult, error)
 
@@ -27,4 +30,13 @@ type Repository interface {
 
 	// RevealClientSecret decrypts and returns the plaintext client secret
 	RevealClientSecret(ctx context.Context, publicID string) (string, error)
+
+	// RecordSecretReveal adds a client secret reveal to the audit trail
+	RecordSecretReveal(ctx context.Context, input *RecordSecretRevealInput) error
+
+	// ReencryptClientSecrets re-encrypts the client secrets not encrypted with the current key
+	ReencryptClientSecrets(ctx context.Context) (int, error)
+
+	// UndecryptableClientSecrets returns the providers whose client secret the keyring cannot decrypt
+	UndecryptableClientSecrets(ctx context.Context) ([]ProviderType, error)
 }
@@ -111,12 +111,13 @@ func (r *CreateOAuthProviderResult) ToOAuthProvider() *OAuthProvider {
 
 // UpdateOAuthProviderInput contains data for updating an OAuth provider
 type UpdateOAuthProviderInput struct {
-	PublicID     string
-	ClientID     string
-	ClientSecret string // Optional - if empty, retain existing secret
-	RedirectURL  string
-	Scopes       string
-	Enabled      bool
+	PublicID          string
+	ClientID          string
+	ClientSecret      string // Optional - if empty, retain existing secret
+	RedirectURL       string
+	Scopes            string
+	Enabled           bool
+	ExpectedUpdatedAt *time.Time // Reject the update unless updated_at still matches; nil to skip
 }
 
 // UpdateOAuthProviderResult represents the result of updating an OAuth provider
@@ -149,3 +150,11 @@ func (r *UpdateOAuthProviderResult) ToOAuthProvider(providerType ProviderType, c
 type DeleteOAuthProviderInput struct {
 	PublicID string
 }
+
+// RecordSecretRevealInput contains data for recording a client secret reveal
+type RecordSecretRevealInput struct {
+	PublicID  string // Provider public ID
+	ActorID   string // Public ID of the user the secret was revealed to
+	Reason    string
+	IPAddress string // Client address, empty when unresolved
+}
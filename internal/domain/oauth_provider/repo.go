@@ -10,24 +10,24 @@ import (
 
 	"github.com/hrz8/altalune/internal/postgres"
 	"github.com/hrz8/altalune/internal/shared/crypto"
-	"github.com/hrz8/altalune/internal/shared/nanoid"
 	"github.com/hrz8/altalune/internal/shared/query"
 )
 
 type Repo struct {
-	db            postgres.DB
-	encryptionKey []byte
+	db      postgres.DB
+	keyring *crypto.Keyring
 }
 
-func NewRepo(db postgres.DB, encryptionKey []byte) *Repo {
+func NewRepo(db postgres.DB, keyring *crypto.Keyring) *Repo {
 	return &Repo{
-		db:            db,
-		encryptionKey: encryptionKey,
+		db:      db,
+		keyring: keyring,
 	}
 }
 
 func (r *Repo) Query(ctx context.Context, params *query.QueryParams) (*query.QueryResult[OAuthProvider], error) {
 	// Build the base query - NEVER select client_secret
+	sortKey := r.sortKey(params.Sorting)
 	baseQuery := `
 		SELECT
 			id,
@@ -38,7 +38,8 @@ func (r *Repo) Query(ctx context.Context, params *query.QueryParams) (*query.Que
 			scopes,
 			enabled,
 			created_at,
-			updated_at
+			updated_at,
+			` + sortKey.Select() + `
 		FROM altalune_oauth_providers
 		WHERE 1=1
 	`
@@ -110,23 +111,24 @@ func (r *Repo) Query(ctx context.Context, params *query.QueryParams) (*query.Que
 	}
 
 	// First, get the total count before pagination
-	countQuery := "SELECT COUNT(*) FROM (" + baseQuery + ") as filtered"
-	var totalRows int32
-	err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&totalRows)
+	count, err := postgres.CountRows(ctx, r.db, params.Count, baseQuery, args)
 	if err != nil {
 		return nil, fmt.Errorf("count oauth providers: %w", err)
 	}
 
+	// Keyset pages start past the last row already fetched
+	if condition, keysetArgs := sortKey.After(params.Keyset, argCounter); condition != "" {
+		baseQuery += " AND " + condition
+		args = append(args, keysetArgs...)
+		argCounter += len(keysetArgs)
+	}
+
 	// Add ORDER BY clause
-	orderClause := r.buildOrderClause(params.Sorting)
-	baseQuery += orderClause
+	baseQuery += sortKey.OrderBy()
 
 	// Add pagination
-	pageSize := params.Pagination.PageSize
-	page := params.Pagination.Page
-	offset := (page - 1) * pageSize
 	baseQuery += fmt.Sprintf(" LIMIT $%d OFFSET $%d", argCounter, argCounter+1)
-	args = append(args, pageSize, offset)
+	args = append(args, params.PageLimit(), params.FetchOffset())
 
 	// Execute the main query
 	rows, err := r.db.QueryContext(ctx, baseQuery, args...)
@@ -137,6 +139,7 @@ func (r *Repo) Query(ctx context.Context, params *query.QueryParams) (*query.Que
 
 	// Collect queryResults
 	queryResults := make([]*OAuthProviderQueryResult, 0)
+	var sortValue string
 	for rows.Next() {
 		var provider OAuthProviderQueryResult
 
@@ -150,6 +153,7 @@ func (r *Repo) Query(ctx context.Context, params *query.QueryParams) (*query.Que
 			&provider.Enabled,
 			&provider.CreatedAt,
 			&provider.UpdatedAt,
+			&sortValue,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("scan oauth provider row: %w", err)
@@ -162,12 +166,6 @@ func (r *Repo) Query(ctx context.Context, params *query.QueryParams) (*query.Que
 		return nil, fmt.Errorf("iterate oauth provider rows: %w", err)
 	}
 
-	// Calculate total pages
-	var totalPages int32
-	if totalRows > 0 {
-		totalPages = (totalRows + pageSize - 1) / pageSize
-	}
-
 	// Get distinct values for filters
 	filters, err := r.getDistinctValues(ctx)
 	if err != nil {
@@ -181,17 +179,22 @@ func (r *Repo) Query(ctx context.Context, params *query.QueryParams) (*query.Que
 		results = append(results, v.ToOAuthProvider())
 	}
 
-	return &query.QueryResult[OAuthProvider]{
-		Data:       results,
-		TotalRows:  totalRows,
-		TotalPages: totalPages,
-		Filters:    filters,
-	}, nil
+	result := &query.QueryResult[OAuthProvider]{
+		Data:    results,
+		Filters: filters,
+	}
+	query.FillPage(result, params, count)
+	if n := len(queryResults); n > 0 {
+		result.NextKeyset = &query.Keyset{SortKey: sortValue, AfterID: queryResults[n-1].ID}
+	}
+	return result, nil
 }
 
-func (r *Repo) buildOrderClause(sorting *query.SortingParams) string {
+// sortKey maps the sorting of a query to the order its pages are fetched
+// and resumed in.
+func (r *Repo) sortKey(sorting *query.SortingParams) postgres.SortKey {
 	if sorting == nil || sorting.Field == "" {
-		return " ORDER BY created_at DESC" // Default sorting
+		return postgres.SortKey{Expr: "created_at", Desc: true} // Default sorting
 	}
 
 	// Map field to database column
@@ -215,13 +218,7 @@ func (r *Repo) buildOrderClause(sorting *query.SortingParams) string {
 		dbColumn = "created_at" // Fallback to default
 	}
 
-	// Determine sort direction
-	direction := "ASC"
-	if sorting.Order == query.SortOrderDesc {
-		direction = "DESC"
-	}
-
-	return fmt.Sprintf(" ORDER BY %s %s", dbColumn, direction)
+	return postgres.NewSortKey(dbColumn, sorting)
 }
 
 func (r *Repo) getDistinctValues(ctx context.Context) (map[string][]string, error) {
@@ -266,11 +263,8 @@ func (r *Repo) Create(ctx context.Context, input *CreateOAuthProviderInput) (*Cr
 		return nil, fmt.Errorf("check duplicate provider type: %w", err)
 	}
 
-	// Generate public ID
-	publicID, _ := nanoid.GeneratePublicID()
-
 	// CRITICAL: Encrypt client_secret before storing
-	encryptedSecret, err := crypto.Encrypt(input.ClientSecret, r.encryptionKey)
+	encryptedSecret, err := r.keyring.Encrypt(input.ClientSecret)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %v", ErrEncryptionFailed, err)
 	}
@@ -294,29 +288,31 @@ func (r *Repo) Create(ctx context.Context, input *CreateOAuthProviderInput) (*Cr
 	now := time.Now()
 	var result CreateOAuthProviderResult
 
-	err = r.db.QueryRowContext(
-		ctx,
-		insertQuery,
-		publicID,
-		string(input.ProviderType),
-		input.ClientID,
-		encryptedSecret,
-		input.RedirectURL,
-		input.Scopes,
-		input.Enabled,
-		now,
-		now,
-	).Scan(
-		&result.ID,
-		&result.PublicID,
-		&result.ProviderType,
-		&result.ClientID,
-		&result.RedirectURL,
-		&result.Scopes,
-		&result.Enabled,
-		&result.CreatedAt,
-		&result.UpdatedAt,
-	)
+	_, err = postgres.InsertWithPublicID(func(publicID string) error {
+		return r.db.QueryRowContext(
+			ctx,
+			insertQuery,
+			publicID,
+			string(input.ProviderType),
+			input.ClientID,
+			encryptedSecret,
+			input.RedirectURL,
+			input.Scopes,
+			input.Enabled,
+			now,
+			now,
+		).Scan(
+			&result.ID,
+			&result.PublicID,
+			&result.ProviderType,
+			&result.ClientID,
+			&result.RedirectURL,
+			&result.Scopes,
+			&result.Enabled,
+			&result.CreatedAt,
+			&result.UpdatedAt,
+		)
+	})
 
 	if err != nil {
 		// Check for unique constraint violation
@@ -421,54 +417,107 @@ func (r *Repo) GetByProviderType(ctx context.Context, providerType ProviderType)
 	return &provider, nil
 }
 
+// ListEnabled returns the enabled OAuth providers, oldest first
+func (r *Repo) ListEnabled(ctx context.Context) ([]*OAuthProvider, error) {
+	sqlQuery := `
+		SELECT
+			public_id,
+			provider_type,
+			client_id,
+			redirect_url,
+			scopes,
+			enabled,
+			created_at,
+			updated_at
+		FROM altalune_oauth_providers
+		WHERE enabled = true
+		ORDER BY created_at, provider_type
+	`
+
+	rows, err := r.db.QueryContext(ctx, sqlQuery)
+	if err != nil {
+		return nil, fmt.Errorf("list enabled oauth providers: %w", err)
+	}
+	defer rows.Close()
+
+	providers := make([]*OAuthProvider, 0)
+	for rows.Next() {
+		var provider OAuthProvider
+		var providerTypeStr string
+
+		err := rows.Scan(
+			&provider.ID,
+			&providerTypeStr,
+			&provider.ClientID,
+			&provider.RedirectURL,
+			&provider.Scopes,
+			&provider.Enabled,
+			&provider.CreatedAt,
+			&provider.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scan oauth provider row: %w", err)
+		}
+
+		provider.ProviderType = ProviderType(providerTypeStr)
+		provider.ClientSecretSet = true // If record exists, secret is set
+		providers = append(providers, &provider)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate oauth provider rows: %w", err)
+	}
+
+	return providers, nil
+}
+
 // Update updates an OAuth provider
 // CRITICAL: Re-encrypts client_secret if provided (non-empty)
 func (r *Repo) Update(ctx context.Context, input *UpdateOAuthProviderInput) (*UpdateOAuthProviderResult, error) {
 	// Build dynamic UPDATE query based on whether client_secret is provided
-	var sqlQuery string
-	var args []interface{}
+	setClause := "client_id = $3, redirect_url = $4, scopes = $5, enabled = $6, updated_at = CURRENT_TIMESTAMP"
+	args := []interface{}{
+		input.PublicID,
+		input.ExpectedUpdatedAt,
+		input.ClientID,
+		input.RedirectURL,
+		input.Scopes,
+		input.Enabled,
+	}
 
 	if input.ClientSecret != "" {
 		// CRITICAL: Re-encrypt new client_secret
-		encryptedSecret, err := crypto.Encrypt(input.ClientSecret, r.encryptionKey)
+		encryptedSecret, err := r.keyring.Encrypt(input.ClientSecret)
 		if err != nil {
 			return nil, fmt.Errorf("%w: %v", ErrEncryptionFailed, err)
 		}
+		setClause += ", client_secret = $7"
+		args = append(args, encryptedSecret)
+	}
+	// Otherwise keep existing client_secret (don't update it)
 
-		sqlQuery = `
-			UPDATE altalune_oauth_providers
-			SET client_id = $1, client_secret = $2, redirect_url = $3, scopes = $4, enabled = $5, updated_at = CURRENT_TIMESTAMP
-			WHERE public_id = $6
-			RETURNING id, public_id, client_id, redirect_url, scopes, enabled, updated_at
-		`
-		args = []interface{}{
-			input.ClientID,
-			encryptedSecret,
-			input.RedirectURL,
-			input.Scopes,
-			input.Enabled,
-			input.PublicID,
-		}
-	} else {
-		// Keep existing client_secret (don't update it)
-		sqlQuery = `
+	// The current row is returned flagged as a conflict instead when it
+	// changed since the expected version
+	sqlQuery := fmt.Sprintf(`
+		WITH updated AS (
 			UPDATE altalune_oauth_providers
-			SET client_id = $1, redirect_url = $2, scopes = $3, enabled = $4, updated_at = CURRENT_TIMESTAMP
-			WHERE public_id = $5
-			RETURNING id, public_id, client_id, redirect_url, scopes, enabled, updated_at
-		`
-		args = []interface{}{
-			input.ClientID,
-			input.RedirectURL,
-			input.Scopes,
-			input.Enabled,
-			input.PublicID,
-		}
-	}
+			SET %s
+			WHERE public_id = $1 AND ($2::timestamptz IS NULL OR updated_at = $2)
+			RETURNING *
+		), result AS (
+			SELECT false AS conflict, updated.* FROM updated
+			UNION ALL
+			SELECT true, p.* FROM altalune_oauth_providers p
+			WHERE p.public_id = $1 AND NOT EXISTS (SELECT 1 FROM updated)
+		)
+		SELECT conflict, id, public_id, client_id, redirect_url, scopes, enabled, updated_at
+		FROM result
+	`, setClause)
 
 	var result UpdateOAuthProviderResult
+	var conflict bool
 
 	err := r.db.QueryRowContext(ctx, sqlQuery, args...).Scan(
+		&conflict,
 		&result.ID,
 		&result.PublicID,
 		&result.ClientID,
@@ -484,6 +533,9 @@ func (r *Repo) Update(ctx context.Context, input *UpdateOAuthProviderInput) (*Up
 		}
 		return nil, fmt.Errorf("failed to update oauth provider: %w", err)
 	}
+	if conflict {
+		return nil, ErrOAuthProviderVersionConflict
+	}
 
 	return &result, nil
 }
@@ -528,10 +580,124 @@ func (r *Repo) RevealClientSecret(ctx context.Context, publicID string) (string,
 	}
 
 	// CRITICAL: Decrypt the client_secret
-	plaintext, err := crypto.Decrypt(encryptedSecret, r.encryptionKey)
+	plaintext, err := r.keyring.Decrypt(encryptedSecret)
 	if err != nil {
 		return "", fmt.Errorf("%w: %v", ErrDecryptionFailed, err)
 	}
 
 	return plaintext, nil
 }
+
+// RecordSecretReveal adds a client secret reveal to the audit trail
+func (r *Repo) RecordSecretReveal(ctx context.Context, input *RecordSecretRevealInput) error {
+	sqlQuery := `
+		INSERT INTO altalune_oauth_provider_secret_reveals (provider_id, provider_public_id, provider_type, actor_id, reason, ip_address)
+		SELECT id, public_id, provider_type, $2, $3, $4
+		FROM altalune_oauth_providers
+		WHERE public_id = $1
+	`
+
+	result, err := r.db.ExecContext(ctx, sqlQuery, input.PublicID, input.ActorID, input.Reason, input.IPAddress)
+	if err != nil {
+		return fmt.Errorf("record client secret reveal: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrOAuthProviderNotFound
+	}
+
+	return nil
+}
+
+// ReencryptClientSecrets re-encrypts the client secrets not encrypted with the
+// current key, so previous keys can be dropped after a key rotation. It returns
+// how many secrets were rewritten. A secret changed since it was read is left
+// as is, which makes concurrent runs safe.
+func (r *Repo) ReencryptClientSecrets(ctx context.Context) (int, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, client_secret
+		FROM altalune_oauth_providers
+		ORDER BY id
+	`)
+	if err != nil {
+		return 0, fmt.Errorf("query client secrets: %w", err)
+	}
+
+	secrets := make(map[int64]string)
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		var secret string
+		if err := rows.Scan(&id, &secret); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("scan client secret: %w", err)
+		}
+		secrets[id] = secret
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("iterate client secrets: %w", err)
+	}
+
+	reencrypted := 0
+	for _, id := range ids {
+		secret, changed, err := r.keyring.Reencrypt(secrets[id])
+		if err != nil {
+			return reencrypted, fmt.Errorf("%w: provider %d: %v", ErrDecryptionFailed, id, err)
+		}
+		if !changed {
+			continue
+		}
+
+		result, err := r.db.ExecContext(ctx, `
+			UPDATE altalune_oauth_providers
+			SET client_secret = $1
+			WHERE id = $2 AND client_secret = $3
+		`, secret, id, secrets[id])
+		if err != nil {
+			return reencrypted, fmt.Errorf("update client secret: %w", err)
+		}
+		if n, _ := result.RowsAffected(); n > 0 {
+			reencrypted++
+		}
+	}
+
+	return reencrypted, nil
+}
+
+// UndecryptableClientSecrets returns the types of the providers whose client
+// secret none of the keys of the keyring decrypts, which means the IAM
+// encryption key was changed without a rotation.
+func (r *Repo) UndecryptableClientSecrets(ctx context.Context) ([]ProviderType, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT provider_type, client_secret
+		FROM altalune_oauth_providers
+		ORDER BY id
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("query client secrets: %w", err)
+	}
+	defer rows.Close()
+
+	var failed []ProviderType
+	for rows.Next() {
+		var providerType ProviderType
+		var secret string
+		if err := rows.Scan(&providerType, &secret); err != nil {
+			return nil, fmt.Errorf("scan client secret: %w", err)
+		}
+		if _, err := r.keyring.Decrypt(secret); err != nil {
+			failed = append(failed, providerType)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate client secrets: %w", err)
+	}
+
+	return failed, nil
+}
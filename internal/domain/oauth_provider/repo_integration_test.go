@@ -0,0 +1,189 @@
+package oauth_provider_test
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/hrz8/altalune/internal/domain/oauth_provider"
+	"github.com/hrz8/altalune/internal/shared/crypto"
+	"github.com/hrz8/altalune/internal/testdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) { testdb.Main(m) }
+
+func TestRepoUpdateConflict(t *testing.T) {
+	ctx := context.Background()
+	db := testdb.Tx(t)
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	keyring, err := crypto.NewKeyring(key)
+	require.NoError(t, err)
+	repo := oauth_provider.NewRepo(db, keyring)
+
+	created, err := repo.Create(ctx, &oauth_provider.CreateOAuthProviderInput{
+		ProviderType: oauth_provider.ProviderTypeGithub,
+		ClientID:     "conflict-client",
+		ClientSecret: "conflict-secret",
+		RedirectURL:  "https://example.com/callback",
+		Scopes:       "read:user",
+		Enabled:      true,
+	})
+	require.NoError(t, err)
+
+	update := func(secret string, expectedUpdatedAt time.Time) (*oauth_provider.UpdateOAuthProviderResult, error) {
+		return repo.Update(ctx, &oauth_provider.UpdateOAuthProviderInput{
+			PublicID:          created.PublicID,
+			ClientID:          created.ClientID,
+			ClientSecret:      secret,
+			RedirectURL:       created.RedirectURL,
+			Scopes:            "read:user,user:email",
+			Enabled:           true,
+			ExpectedUpdatedAt: &expectedUpdatedAt,
+		})
+	}
+
+	_, err = update("", created.UpdatedAt.Add(-time.Second))
+	assert.ErrorIs(t, err, oauth_provider.ErrOAuthProviderVersionConflict)
+	_, err = update("rotated-secret", created.UpdatedAt.Add(-time.Second))
+	assert.ErrorIs(t, err, oauth_provider.ErrOAuthProviderVersionConflict, "with a new secret too")
+
+	updated, err := update("rotated-secret", created.UpdatedAt)
+	require.NoError(t, err)
+	assert.Equal(t, "read:user,user:email", updated.Scopes)
+
+	_, err = repo.Update(ctx, &oauth_provider.UpdateOAuthProviderInput{
+		PublicID:          "unknown",
+		ClientID:          "unknown",
+		ExpectedUpdatedAt: &updated.UpdatedAt,
+	})
+	assert.ErrorIs(t, err, oauth_provider.ErrOAuthProviderNotFound, "a missing provider is not a conflict")
+}
+
+func TestRepoListEnabled(t *testing.T) {
+	ctx := context.Background()
+	db := testdb.Tx(t)
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	keyring, err := crypto.NewKeyring(key)
+	require.NoError(t, err)
+	repo := oauth_provider.NewRepo(db, keyring)
+
+	_, err = db.ExecContext(ctx, `DELETE FROM altalune_oauth_providers`)
+	require.NoError(t, err)
+	for _, input := range []struct {
+		providerType oauth_provider.ProviderType
+		enabled      bool
+	}{
+		{oauth_provider.ProviderTypeGithub, true},
+		{oauth_provider.ProviderTypeGoogle, false},
+		{oauth_provider.ProviderTypeMicrosoft, true},
+	} {
+		_, err := repo.Create(ctx, &oauth_provider.CreateOAuthProviderInput{
+			ProviderType: input.providerType,
+			ClientID:     "client",
+			ClientSecret: "secret",
+			RedirectURL:  "https://example.com/callback",
+			Enabled:      input.enabled,
+		})
+		require.NoError(t, err)
+	}
+
+	providers, err := repo.ListEnabled(ctx)
+	require.NoError(t, err)
+	types := make([]oauth_provider.ProviderType, 0, len(providers))
+	for _, provider := range providers {
+		types = append(types, provider.ProviderType)
+	}
+	assert.Equal(t, []oauth_provider.ProviderType{oauth_provider.ProviderTypeGithub, oauth_provider.ProviderTypeMicrosoft}, types,
+		"disabled providers are left out, oldest first")
+}
+
+func TestRepoUndecryptableClientSecrets(t *testing.T) {
+	ctx := context.Background()
+	db := testdb.Tx(t)
+	newKeyring := func(keys ...[]byte) *crypto.Keyring {
+		t.Helper()
+		keyring, err := crypto.NewKeyring(keys[0], keys[1:]...)
+		require.NoError(t, err)
+		return keyring
+	}
+	oldKey, newKey := make([]byte, 32), make([]byte, 32)
+	_, err := rand.Read(oldKey)
+	require.NoError(t, err)
+	_, err = rand.Read(newKey)
+	require.NoError(t, err)
+
+	_, err = db.ExecContext(ctx, `DELETE FROM altalune_oauth_providers`)
+	require.NoError(t, err)
+	_, err = oauth_provider.NewRepo(db, newKeyring(oldKey)).Create(ctx, &oauth_provider.CreateOAuthProviderInput{
+		ProviderType: oauth_provider.ProviderTypeGoogle,
+		ClientID:     "client",
+		ClientSecret: "secret",
+		RedirectURL:  "https://example.com/callback",
+		Enabled:      true,
+	})
+	require.NoError(t, err)
+
+	failed, err := oauth_provider.NewRepo(db, newKeyring(oldKey)).UndecryptableClientSecrets(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, failed)
+
+	failed, err = oauth_provider.NewRepo(db, newKeyring(newKey)).UndecryptableClientSecrets(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []oauth_provider.ProviderType{oauth_provider.ProviderTypeGoogle}, failed, "the key was changed without a rotation")
+
+	failed, err = oauth_provider.NewRepo(db, newKeyring(newKey, oldKey)).UndecryptableClientSecrets(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, failed, "previous keys still decrypt")
+}
+
+func TestRepoSecretRevealsOutliveProvider(t *testing.T) {
+	ctx := context.Background()
+	db := testdb.Tx(t)
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	keyring, err := crypto.NewKeyring(key)
+	require.NoError(t, err)
+	repo := oauth_provider.NewRepo(db, keyring)
+
+	_, err = db.ExecContext(ctx, `DELETE FROM altalune_oauth_providers`)
+	require.NoError(t, err)
+	created, err := repo.Create(ctx, &oauth_provider.CreateOAuthProviderInput{
+		ProviderType: oauth_provider.ProviderTypeGithub,
+		ClientID:     "reveal-client",
+		ClientSecret: "reveal-secret",
+		RedirectURL:  "https://example.com/callback",
+		Enabled:      true,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, repo.RecordSecretReveal(ctx, &oauth_provider.RecordSecretRevealInput{
+		PublicID:  created.PublicID,
+		ActorID:   "usr_1234567890ab",
+		Reason:    "rotate the GitHub app",
+		IPAddress: "203.0.113.7",
+	}))
+	assert.ErrorIs(t, repo.RecordSecretReveal(ctx, &oauth_provider.RecordSecretRevealInput{PublicID: "unknown"}),
+		oauth_provider.ErrOAuthProviderNotFound)
+	require.NoError(t, repo.Delete(ctx, &oauth_provider.DeleteOAuthProviderInput{PublicID: created.PublicID}))
+
+	var providerID *int64
+	var providerType, actorID, ipAddress string
+	err = db.QueryRowContext(ctx, `
+		SELECT provider_id, provider_type, actor_id, ip_address
+		FROM altalune_oauth_provider_secret_reveals
+		WHERE provider_public_id = $1
+	`, created.PublicID).Scan(&providerID, &providerType, &actorID, &ipAddress)
+	require.NoError(t, err, "the reveal is kept once the provider is deleted")
+	assert.Nil(t, providerID)
+	assert.Equal(t, "github", providerType)
+	assert.Equal(t, "usr_1234567890ab", actorID)
+	assert.Equal(t, "203.0.113.7", ipAddress)
+}
@@ -2,26 +2,57 @@ package oauth_provider
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"math/big"
 	"strings"
+	"time"
 
 	"buf.build/go/protovalidate"
 	"github.com/hrz8/altalune"
 	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	"github.com/hrz8/altalune/internal/auth"
+	"github.com/hrz8/altalune/internal/redis"
+	"github.com/hrz8/altalune/internal/shared/notification"
 	"github.com/hrz8/altalune/internal/shared/query"
+	"github.com/hrz8/altalune/internal/shared/realip"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// revealCodeLifetime is how long a reveal confirmation code can be used
+	revealCodeLifetime = 5 * time.Minute
+	// revealAttemptLimit caps both the codes a user requests and the reveals
+	// they attempt within revealAttemptWindow
+	revealAttemptLimit  = 5
+	revealAttemptWindow = 15 * time.Minute
 )
 
 type Service struct {
 	altalunev1.UnimplementedOAuthProviderServiceServer
-	validator protovalidate.Validator
-	logger    altalune.Logger
-	repo      Repository
+	validator    protovalidate.Validator
+	logger       altalune.Logger
+	repo         Repository
+	store        redis.Store
+	notification *notification.NotificationService // Nil when no email provider is configured
 }
 
-func NewService(validator protovalidate.Validator, logger altalune.Logger, repo Repository) *Service {
+func NewService(
+	validator protovalidate.Validator,
+	logger altalune.Logger,
+	repo Repository,
+	store redis.Store,
+	notificationSvc *notification.NotificationService,
+) *Service {
 	return &Service{
-		validator: validator,
-		logger:    logger,
-		repo:      repo,
+		validator:    validator,
+		logger:       logger,
+		repo:         repo,
+		store:        store,
+		notification: notificationSvc,
 	}
 }
 
@@ -44,6 +75,10 @@ func (s *Service) QueryOAuthProviders(ctx context.Context, req *altalunev1.Query
 	// Convert proto request to domain query params
 	queryParams := query.DefaultQueryParams(req.Query)
 
+	if err := queryParams.ApplyCursor(req.Query.GetCursor()); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
 	// Query OAuth providers from repository
 	result, err := s.repo.Query(ctx, queryParams)
 	if err != nil {
@@ -59,9 +94,10 @@ func (s *Service) QueryOAuthProviders(ctx context.Context, req *altalunev1.Query
 		return &altalunev1.QueryOAuthProvidersResponse{
 			Data: []*altalunev1.OAuthProvider{},
 			Meta: &altalunev1.QueryMetaResponse{
-				RowCount:  0,
-				PageCount: 0,
-				Filters:   make(map[string]*altalunev1.FilterValues),
+				RowCount:   0,
+				PageCount:  0,
+				ExactTotal: true,
+				Filters:    make(map[string]*altalunev1.FilterValues),
 			},
 		}, nil
 	}
@@ -69,9 +105,13 @@ func (s *Service) QueryOAuthProviders(ctx context.Context, req *altalunev1.Query
 	return &altalunev1.QueryOAuthProvidersResponse{
 		Data: mapOAuthProvidersToProto(result.Data),
 		Meta: &altalunev1.QueryMetaResponse{
-			RowCount:  result.TotalRows,
-			PageCount: result.TotalPages,
-			Filters:   mapFiltersToProto(result.Filters),
+			RowCount:    result.TotalRows,
+			PageCount:   result.TotalPages,
+			Filters:     mapFiltersToProto(result.Filters),
+			CountMode:   query.CountModeToProto(result.Count),
+			HasNextPage: result.HasMore,
+			NextCursor:  query.NextCursor(queryParams, result),
+			ExactTotal:  result.ExactTotal(),
 		},
 	}, nil
 }
@@ -176,12 +216,19 @@ func (s *Service) UpdateOAuthProvider(ctx context.Context, req *altalunev1.Updat
 		Scopes:       strings.TrimSpace(req.Scopes),
 		Enabled:      req.Enabled,
 	}
+	if req.ExpectedUpdatedAt != nil {
+		expectedUpdatedAt := req.ExpectedUpdatedAt.AsTime()
+		input.ExpectedUpdatedAt = &expectedUpdatedAt
+	}
 
 	result, err := s.repo.Update(ctx, input)
 	if err != nil {
 		if err == ErrOAuthProviderNotFound {
 			return nil, altalune.NewOAuthProviderNotFoundError(req.Id)
 		}
+		if err == ErrOAuthProviderVersionConflict {
+			return nil, altalune.NewVersionConflictError("OAuth provider", req.Id)
+		}
 		if err == ErrEncryptionFailed {
 			return nil, altalune.NewOAuthProviderEncryptionError(req.Id)
 		}
@@ -223,11 +270,112 @@ func (s *Service) DeleteOAuthProvider(ctx context.Context, req *altalunev1.Delet
 	}, nil
 }
 
+// RequestClientSecretReveal emails the caller a single-use code confirming
+// that they, and not just their access token, want to reveal a client secret
+func (s *Service) RequestClientSecretReveal(ctx context.Context, req *altalunev1.RequestClientSecretRevealRequest) (*altalunev1.RequestClientSecretRevealResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	caller := auth.FromContext(ctx)
+	if !caller.IsAuthenticated || caller.Email == "" {
+		return nil, altalune.NewInvalidPayloadError("revealing a client secret requires a signed-in user with an email")
+	}
+	if s.notification == nil {
+		return nil, altalune.NewUnexpectedError("failed to request client secret reveal", fmt.Errorf("no email provider is configured"))
+	}
+	if err := s.checkRevealRate(ctx, "requests", caller.UserID, req.Id); err != nil {
+		return nil, err
+	}
+
+	provider, err := s.repo.GetByID(ctx, req.Id)
+	if err != nil {
+		if err == ErrOAuthProviderNotFound {
+			return nil, altalune.NewOAuthProviderNotFoundError(req.Id)
+		}
+		s.logger.Error("failed to get OAuth provider", "error", err, "provider_id", req.Id)
+		return nil, altalune.NewUnexpectedError("failed to get OAuth provider", err)
+	}
+
+	code, err := generateRevealCode()
+	if err != nil {
+		s.logger.Error("failed to generate reveal code", "error", err)
+		return nil, altalune.NewUnexpectedError("failed to generate reveal code", err)
+	}
+	expiresAt := time.Now().Add(revealCodeLifetime)
+	if err := s.store.Set(ctx, revealCodeKey(caller.UserID, req.Id), []byte(hashRevealCode(code)), revealCodeLifetime); err != nil {
+		s.logger.Error("failed to store reveal code", "error", err, "provider_id", req.Id)
+		return nil, altalune.NewUnexpectedError("failed to store reveal code", err)
+	}
+
+	userName := caller.Name
+	if userName == "" {
+		userName = caller.Email
+	}
+	err = s.notification.SendSecretRevealCodeEmail(ctx, caller.Email, notification.SecretRevealCodeEmailData{
+		UserName:      userName,
+		ProviderName:  providerName(provider.ProviderType),
+		Code:          code,
+		ExpiryMinutes: int(revealCodeLifetime / time.Minute),
+	})
+	if err != nil {
+		s.logger.Error("failed to send reveal code email", "error", err, "provider_id", req.Id)
+		return nil, altalune.NewUnexpectedError("failed to send reveal code email", err)
+	}
+
+	s.logger.Info("OAuth provider client secret reveal requested",
+		"provider_id", req.Id,
+		"actor_id", caller.UserID,
+	)
+
+	return &altalunev1.RequestClientSecretRevealResponse{
+		Message:   "A confirmation code was sent to your email",
+		ExpiresAt: timestamppb.New(expiresAt),
+	}, nil
+}
+
+// RevealClientSecret decrypts the client secret for a caller holding a code
+// from RequestClientSecretReveal, recording who revealed it, when and why
 func (s *Service) RevealClientSecret(ctx context.Context, req *altalunev1.RevealClientSecretRequest) (*altalunev1.RevealClientSecretResponse, error) {
 	if err := s.validator.Validate(req); err != nil {
 		return nil, altalune.NewInvalidPayloadError(err.Error())
 	}
 
+	caller := auth.FromContext(ctx)
+	if !caller.IsAuthenticated {
+		return nil, altalune.NewOAuthProviderRevealUnconfirmedError(req.Id)
+	}
+	if err := s.checkRevealRate(ctx, "attempts", caller.UserID, req.Id); err != nil {
+		return nil, err
+	}
+
+	// Codes are single use: a wrong one does not burn it, but the attempt
+	// counts towards the rate limit
+	key := revealCodeKey(caller.UserID, req.Id)
+	stored, found, err := s.store.Get(ctx, key)
+	if err != nil {
+		s.logger.Error("failed to get reveal code", "error", err, "provider_id", req.Id)
+		return nil, altalune.NewUnexpectedError("failed to get reveal code", err)
+	}
+	if !found || subtle.ConstantTimeCompare(stored, []byte(hashRevealCode(req.ConfirmationCode))) != 1 {
+		s.logger.Warn("OAuth provider client secret reveal refused",
+			"provider_id", req.Id,
+			"actor_id", caller.UserID,
+			"reason", "invalid confirmation code",
+		)
+		return nil, altalune.NewOAuthProviderRevealUnconfirmedError(req.Id)
+	}
+	// Taking the code rather than deleting it lets only one of concurrent
+	// reveals with the same code through
+	_, taken, err := s.store.Take(ctx, key)
+	if err != nil {
+		s.logger.Error("failed to take reveal code", "error", err, "provider_id", req.Id)
+		return nil, altalune.NewUnexpectedError("failed to take reveal code", err)
+	}
+	if !taken {
+		return nil, altalune.NewOAuthProviderRevealUnconfirmedError(req.Id)
+	}
+
 	// Call repo to decrypt and return plaintext client secret
 	clientSecret, err := s.repo.RevealClientSecret(ctx, req.Id)
 	if err != nil {
@@ -241,13 +389,84 @@ func (s *Service) RevealClientSecret(ctx context.Context, req *altalunev1.Reveal
 		return nil, altalune.NewUnexpectedError("failed to reveal OAuth provider client secret", err)
 	}
 
-	// SECURITY AUDIT LOG: Log this explicit secret reveal action
+	// SECURITY AUDIT: the secret is only returned once the reveal is recorded
+	err = s.repo.RecordSecretReveal(ctx, &RecordSecretRevealInput{
+		PublicID:  req.Id,
+		ActorID:   caller.UserID,
+		Reason:    req.Reason,
+		IPAddress: realip.IP(ctx),
+	})
+	if err != nil {
+		if err == ErrOAuthProviderNotFound {
+			return nil, altalune.NewOAuthProviderNotFoundError(req.Id)
+		}
+		s.logger.Error("failed to record OAuth provider client secret reveal", "error", err, "provider_id", req.Id)
+		return nil, altalune.NewUnexpectedError("failed to record OAuth provider client secret reveal", err)
+	}
+
 	s.logger.Info("OAuth provider client secret revealed",
 		"provider_id", req.Id,
 		"action", "reveal_client_secret",
+		"actor_id", caller.UserID,
+		"reason", req.Reason,
+		"ip_address", realip.IP(ctx),
+		"revealed_at", time.Now().UTC(),
 	)
 
 	return &altalunev1.RevealClientSecretResponse{
 		ClientSecret: clientSecret,
 	}, nil
 }
+
+// checkRevealRate counts a reveal request or attempt, by kind, of a user and
+// refuses it past revealAttemptLimit within revealAttemptWindow
+func (s *Service) checkRevealRate(ctx context.Context, kind, userID, providerID string) error {
+	count, err := s.store.Incr(ctx, "oauth_provider:reveal_"+kind+":"+userID, revealAttemptWindow)
+	if err != nil {
+		s.logger.Error("failed to check reveal rate limit", "error", err, "provider_id", providerID)
+		return altalune.NewUnexpectedError("failed to check reveal rate limit", err)
+	}
+	if count > revealAttemptLimit {
+		s.logger.Warn("OAuth provider client secret reveal rate limited",
+			"provider_id", providerID,
+			"actor_id", userID,
+			"kind", kind,
+			"count", count,
+		)
+		return altalune.NewOAuthProviderRevealRateLimitedError(providerID)
+	}
+	return nil
+}
+
+// revealCodeKey is the store key of the reveal code of a user for a provider
+func revealCodeKey(userID, providerID string) string {
+	return "oauth_provider:reveal_code:" + userID + ":" + providerID
+}
+
+// generateRevealCode returns a random 6-digit code
+func generateRevealCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1_000_000))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
+
+// hashRevealCode hashes a reveal code so the store never holds it in plaintext
+func hashRevealCode(code string) string {
+	hash := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(hash[:])
+}
+
+// providerName returns the display name of a provider type
+func providerName(providerType ProviderType) string {
+	switch providerType {
+	case ProviderTypeGithub:
+		return "GitHub"
+	case "":
+		return ""
+	default:
+		name := string(providerType)
+		return strings.ToUpper(name[:1]) + name[1:]
+	}
+}
@@ -0,0 +1,316 @@
+package oauth_provider
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"buf.build/go/protovalidate"
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	"github.com/hrz8/altalune/internal/auth"
+	"github.com/hrz8/altalune/internal/redis"
+	"github.com/hrz8/altalune/internal/shared/notification"
+	"github.com/hrz8/altalune/internal/shared/realip"
+	"github.com/hrz8/altalune/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const testProviderID = "prv_1234567890ab"
+
+// fakeRepo serves one provider and records the reveals; the other methods are
+// never called
+type fakeRepo struct {
+	Repository
+	secret    string
+	recordErr error
+	revealed  int
+	recorded  []*RecordSecretRevealInput
+}
+
+func (r *fakeRepo) GetByID(_ context.Context, publicID string) (*OAuthProvider, error) {
+	if publicID != testProviderID {
+		return nil, ErrOAuthProviderNotFound
+	}
+	return &OAuthProvider{ID: publicID, ProviderType: ProviderTypeGithub}, nil
+}
+
+func (r *fakeRepo) RevealClientSecret(_ context.Context, publicID string) (string, error) {
+	if publicID != testProviderID {
+		return "", ErrOAuthProviderNotFound
+	}
+	r.revealed++
+	return r.secret, nil
+}
+
+func (r *fakeRepo) RecordSecretReveal(_ context.Context, input *RecordSecretRevealInput) error {
+	if r.recordErr != nil {
+		return r.recordErr
+	}
+	r.recorded = append(r.recorded, input)
+	return nil
+}
+
+// clockStore is a redis.Store whose entries expire on a clock the test moves
+type clockStore struct {
+	mu      sync.Mutex
+	now     time.Time
+	entries map[string]clockEntry
+}
+
+var _ redis.Store = (*clockStore)(nil)
+
+type clockEntry struct {
+	value     []byte
+	expiresAt time.Time // Zero when the entry does not expire
+}
+
+func newClockStore() *clockStore {
+	return &clockStore{now: time.Unix(1700000000, 0), entries: make(map[string]clockEntry)}
+}
+
+func (s *clockStore) advance(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.now = s.now.Add(d)
+}
+
+func (s *clockStore) lookup(key string) (clockEntry, bool) {
+	entry, ok := s.entries[key]
+	if ok && !entry.expiresAt.IsZero() && !s.now.Before(entry.expiresAt) {
+		delete(s.entries, key)
+		return clockEntry{}, false
+	}
+	return entry, ok
+}
+
+func (s *clockStore) Get(_ context.Context, key string) ([]byte, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entry, ok := s.lookup(key)
+	return entry.value, ok, nil
+}
+
+func (s *clockStore) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entry := clockEntry{value: value}
+	if ttl > 0 {
+		entry.expiresAt = s.now.Add(ttl)
+	}
+	s.entries[key] = entry
+	return nil
+}
+
+func (s *clockStore) Delete(_ context.Context, keys ...string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, key := range keys {
+		delete(s.entries, key)
+	}
+	return nil
+}
+
+func (s *clockStore) Take(_ context.Context, key string) ([]byte, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entry, ok := s.lookup(key)
+	delete(s.entries, key)
+	return entry.value, ok, nil
+}
+
+func (s *clockStore) Incr(_ context.Context, key string, ttl time.Duration) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entry, ok := s.lookup(key)
+	if !ok && ttl > 0 {
+		entry.expiresAt = s.now.Add(ttl)
+	}
+	n, _ := strconv.ParseInt(string(entry.value), 10, 64)
+	n++
+	entry.value = []byte(strconv.FormatInt(n, 10))
+	s.entries[key] = entry
+	return n, nil
+}
+
+// codeSender keeps the last reveal code emailed
+type codeSender struct {
+	code string
+}
+
+var revealCodePattern = regexp.MustCompile(`\b[0-9]{6}\b`)
+
+func (s *codeSender) SendEmail(_ context.Context, _, _, _, textBody string) error {
+	s.code = revealCodePattern.FindString(textBody)
+	return nil
+}
+
+type revealFixture struct {
+	svc    *Service
+	repo   *fakeRepo
+	store  *clockStore
+	sender *codeSender
+	ctx    context.Context
+}
+
+func newRevealFixture(t *testing.T) *revealFixture {
+	t.Helper()
+
+	v, err := protovalidate.New()
+	require.NoError(t, err)
+	sender := &codeSender{}
+	notificationSvc, err := notification.NewNotificationService(sender, "http://localhost:3300")
+	require.NoError(t, err)
+
+	repo := &fakeRepo{secret: "github-client-secret"}
+	store := newClockStore()
+	ctx := auth.WithAuthContext(context.Background(), &auth.AuthContext{
+		UserID:          "usr_1234567890ab",
+		Email:           "jane@example.com",
+		Name:            "Jane",
+		IsAuthenticated: true,
+	})
+	ctx = realip.WithClient(ctx, realip.Client{IP: "203.0.113.7"})
+
+	return &revealFixture{
+		svc:    NewService(v, logger.New("error"), repo, store, notificationSvc),
+		repo:   repo,
+		store:  store,
+		sender: sender,
+		ctx:    ctx,
+	}
+}
+
+// requestCode requests a reveal code and returns the one emailed
+func (f *revealFixture) requestCode(t *testing.T) string {
+	t.Helper()
+
+	_, err := f.svc.RequestClientSecretReveal(f.ctx, &altalunev1.RequestClientSecretRevealRequest{Id: testProviderID})
+	require.NoError(t, err)
+	require.Len(t, f.sender.code, 6, "the email holds the code")
+	return f.sender.code
+}
+
+func (f *revealFixture) reveal(code string) (*altalunev1.RevealClientSecretResponse, error) {
+	return f.svc.RevealClientSecret(f.ctx, &altalunev1.RevealClientSecretRequest{
+		Id:               testProviderID,
+		ConfirmationCode: code,
+		Reason:           "rotate the GitHub app",
+	})
+}
+
+// otherCode returns a well-formed code different from code
+func otherCode(code string) string {
+	if code == "000000" {
+		return "000001"
+	}
+	return "000000"
+}
+
+func TestRevealClientSecret(t *testing.T) {
+	f := newRevealFixture(t)
+	code := f.requestCode(t)
+
+	resp, err := f.reveal(otherCode(code))
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "a wrong code is refused")
+	assert.Nil(t, resp)
+	assert.Zero(t, f.repo.revealed, "the secret is not decrypted for a wrong code")
+
+	resp, err = f.reveal(code)
+	require.NoError(t, err, "a wrong code does not burn the right one")
+	assert.Equal(t, "github-client-secret", resp.ClientSecret)
+	require.Len(t, f.repo.recorded, 1)
+	assert.Equal(t, &RecordSecretRevealInput{
+		PublicID:  testProviderID,
+		ActorID:   "usr_1234567890ab",
+		Reason:    "rotate the GitHub app",
+		IPAddress: "203.0.113.7",
+	}, f.repo.recorded[0])
+
+	resp, err = f.reveal(code)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "a used code cannot be reused")
+	assert.Nil(t, resp)
+	assert.Len(t, f.repo.recorded, 1)
+}
+
+func TestRevealClientSecretExpiredCode(t *testing.T) {
+	f := newRevealFixture(t)
+	code := f.requestCode(t)
+
+	f.store.advance(revealCodeLifetime)
+	resp, err := f.reveal(code)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Nil(t, resp)
+	assert.Zero(t, f.repo.revealed)
+}
+
+func TestRevealClientSecretUnauthenticated(t *testing.T) {
+	f := newRevealFixture(t)
+	code := f.requestCode(t)
+
+	f.ctx = context.Background()
+	_, err := f.reveal(code)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = f.svc.RequestClientSecretReveal(f.ctx, &altalunev1.RequestClientSecretRevealRequest{Id: testProviderID})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestRevealClientSecretRateLimit(t *testing.T) {
+	t.Run("requests", func(t *testing.T) {
+		f := newRevealFixture(t)
+		for range revealAttemptLimit {
+			f.requestCode(t)
+		}
+		f.sender.code = ""
+
+		_, err := f.svc.RequestClientSecretReveal(f.ctx, &altalunev1.RequestClientSecretRevealRequest{Id: testProviderID})
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		assert.Empty(t, f.sender.code, "no code is sent past the limit")
+
+		f.store.advance(revealAttemptWindow)
+		f.requestCode(t)
+	})
+
+	t.Run("attempts", func(t *testing.T) {
+		f := newRevealFixture(t)
+		code := f.requestCode(t)
+		for range revealAttemptLimit {
+			_, err := f.reveal(otherCode(code))
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		}
+
+		resp, err := f.reveal(code)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err), "even the right code is refused past the limit")
+		assert.Nil(t, resp)
+		assert.Zero(t, f.repo.revealed)
+	})
+}
+
+func TestRevealClientSecretRecordFailure(t *testing.T) {
+	tests := []struct {
+		name      string
+		recordErr error
+		code      codes.Code
+	}{
+		{name: "audit trail unavailable", recordErr: errors.New("connection refused"), code: codes.Internal},
+		{name: "provider deleted meanwhile", recordErr: ErrOAuthProviderNotFound, code: codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newRevealFixture(t)
+			f.repo.recordErr = tt.recordErr
+			code := f.requestCode(t)
+
+			resp, err := f.reveal(code)
+			assert.Equal(t, tt.code, status.Code(err))
+			assert.Nil(t, resp, "an unrecorded reveal returns no secret")
+		})
+	}
+}
@@ -5,31 +5,21 @@ import (
 
 	"github.com/hrz8/altalune/internal/shared/crypto"
 	"github.com/hrz8/altalune/internal/shared/password"
+	"github.com/hrz8/altalune/internal/shared/secretpolicy"
 )
 
-const (
-	// Production parameters for Argon2id hashing
-	// These parameters provide strong security while maintaining acceptable performance (~50-100ms)
-	argon2Iterations = 2         // Time cost (t)
-	argon2Memory     = 64 * 1024 // Memory cost in KB (m) = 64MB
-	argon2Threads    = 4         // Parallelism (p)
-	argon2KeyLength  = 32        // Hash length in bytes
-)
-
-// HashClientSecret hashes an OAuth client secret using Argon2id
+// HashClientSecret hashes an OAuth client secret using Argon2id with the configured parameters
 // This is used for dashboard client secrets that need to be verified during authentication
 // Replaces bcrypt with modern Argon2id (PHC 2015 winner, OWASP recommended)
-func HashClientSecret(secret string) (string, error) {
+func HashClientSecret(secret string, opt password.HashOption) (string, error) {
 	if len(secret) < 32 {
 		return "", fmt.Errorf("client secret must be at least 32 characters, got %d", len(secret))
 	}
+	if secretpolicy.Entropy(secret) < secretpolicy.MinClientSecretEntropy {
+		return "", fmt.Errorf("client secret is too predictable, use a random one (openssl rand -base64 24)")
+	}
 
-	hash, err := password.HashPassword(secret, password.HashOption{
-		Iterations: argon2Iterations,
-		Memory:     argon2Memory,
-		Threads:    argon2Threads,
-		Len:        argon2KeyLength,
-	})
+	hash, err := password.HashPassword(secret, opt)
 	if err != nil {
 		return "", fmt.Errorf("hash client secret with argon2: %w", err)
 	}
@@ -50,14 +40,20 @@ func VerifyClientSecret(secret, hash string) (bool, error) {
 
 // EncryptProviderSecret encrypts an OAuth provider secret using AES-256-GCM
 // This is used for Google/GitHub client secrets that need to be retrieved during OAuth flows
+// The result is a keyring envelope tagged with the key ID, like the ones of the oauth_provider repo
 func EncryptProviderSecret(secret string, encryptionKey []byte) (string, error) {
 	// Validate key size (must be 32 bytes for AES-256)
 	if len(encryptionKey) != 32 {
 		return "", fmt.Errorf("encryption key must be 32 bytes, got %d", len(encryptionKey))
 	}
 
+	keyring, err := crypto.NewKeyring(encryptionKey)
+	if err != nil {
+		return "", fmt.Errorf("create keyring: %w", err)
+	}
+
 	// Encrypt using the shared crypto package
-	encrypted, err := crypto.Encrypt(secret, encryptionKey)
+	encrypted, err := keyring.Encrypt(secret)
 	if err != nil {
 		return "", fmt.Errorf("encrypt secret: %w", err)
 	}
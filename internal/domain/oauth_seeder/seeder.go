@@ -9,6 +9,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/hrz8/altalune"
 	"github.com/hrz8/altalune/internal/shared/nanoid"
+	"github.com/hrz8/altalune/internal/shared/password"
 	"github.com/lib/pq"
 )
 
@@ -284,7 +285,7 @@ func (s *Seeder) seedDefaultOAuthClient(ctx context.Context, tx *sql.Tx) error {
 
 	// Hash the client secret
 	s.logger.Info("Creating default OAuth client...", "name", clientName)
-	secretHash, err := HashClientSecret(clientSecret)
+	secretHash, err := HashClientSecret(clientSecret, password.OptionFromConfig(s.config))
 	if err != nil {
 		return fmt.Errorf("hash client secret: %w", err)
 	}
@@ -0,0 +1,12 @@
+package organization
+
+import "errors"
+
+var (
+	ErrOrganizationNotFound       = errors.New("organization not found")
+	ErrOrganizationMemberNotFound = errors.New("organization member not found")
+	ErrCannotRemoveLastOwner      = errors.New("cannot remove the last owner from organization")
+	ErrProjectInOtherOrganization = errors.New("project belongs to another organization")
+	ErrProjectNotInOrganization   = errors.New("project does not belong to organization")
+	ErrBillingCustomerTaken       = errors.New("billing customer belongs to another organization")
+)
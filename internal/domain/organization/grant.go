@@ -0,0 +1,15 @@
+package organization
+
+// roleRanks orders organization roles from least to most privileged
+var roleRanks = map[string]int{
+	RoleAdmin: 1,
+	RoleOwner: 2,
+}
+
+// canGrantRole reports whether a member holding callerRole may grant role, or
+// change or remove a member who holds it. Admins can manage admins only, so
+// only owners make, demote or remove owners.
+func canGrantRole(callerRole, role string) bool {
+	callerRank, ok := roleRanks[callerRole]
+	return ok && roleRanks[role] <= callerRank
+}
@@ -0,0 +1,24 @@
+package organization
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanGrantRole(t *testing.T) {
+	tests := []struct {
+		caller, role string
+		want         bool
+	}{
+		{RoleOwner, RoleOwner, true},
+		{RoleOwner, RoleAdmin, true},
+		{RoleAdmin, RoleAdmin, true},
+		{RoleAdmin, RoleOwner, false},
+		{"", RoleAdmin, false},
+		{"unknown", RoleAdmin, false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, canGrantRole(tt.caller, tt.role), "%s granting %s", tt.caller, tt.role)
+	}
+}
@@ -0,0 +1,189 @@
+package organization
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/hrz8/altalune"
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	"github.com/hrz8/altalune/internal/auth"
+)
+
+type Handler struct {
+	svc  altalunev1.OrganizationServiceServer
+	auth *auth.Authorizer
+}
+
+func NewHandler(svc altalunev1.OrganizationServiceServer, authorizer *auth.Authorizer) *Handler {
+	return &Handler{svc: svc, auth: authorizer}
+}
+
+func (h *Handler) ListOrganizations(
+	ctx context.Context,
+	req *connect.Request[altalunev1.ListOrganizationsRequest],
+) (*connect.Response[altalunev1.ListOrganizationsResponse], error) {
+	// Authorization: requires org:read permission (global - the service checks
+	// the caller's role in the organization)
+	if err := h.auth.CheckPermission(ctx, "org:read"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.ListOrganizations(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (h *Handler) GetOrganization(
+	ctx context.Context,
+	req *connect.Request[altalunev1.GetOrganizationRequest],
+) (*connect.Response[altalunev1.GetOrganizationResponse], error) {
+	// Authorization: requires org:read permission (global - the service checks
+	// the caller's role in the organization)
+	if err := h.auth.CheckPermission(ctx, "org:read"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.GetOrganization(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (h *Handler) CreateOrganization(
+	ctx context.Context,
+	req *connect.Request[altalunev1.CreateOrganizationRequest],
+) (*connect.Response[altalunev1.CreateOrganizationResponse], error) {
+	// Authorization: requires org:write permission (global - the service checks
+	// the caller's role in the organization)
+	if err := h.auth.CheckPermission(ctx, "org:write"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.CreateOrganization(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (h *Handler) UpdateOrganization(
+	ctx context.Context,
+	req *connect.Request[altalunev1.UpdateOrganizationRequest],
+) (*connect.Response[altalunev1.UpdateOrganizationResponse], error) {
+	// Authorization: requires org:write permission (global - the service checks
+	// the caller's role in the organization)
+	if err := h.auth.CheckPermission(ctx, "org:write"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.UpdateOrganization(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (h *Handler) DeleteOrganization(
+	ctx context.Context,
+	req *connect.Request[altalunev1.DeleteOrganizationRequest],
+) (*connect.Response[altalunev1.DeleteOrganizationResponse], error) {
+	// Authorization: requires org:write permission (global - the service checks
+	// the caller's role in the organization)
+	if err := h.auth.CheckPermission(ctx, "org:write"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.DeleteOrganization(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (h *Handler) AddOrganizationProject(
+	ctx context.Context,
+	req *connect.Request[altalunev1.AddOrganizationProjectRequest],
+) (*connect.Response[altalunev1.AddOrganizationProjectResponse], error) {
+	// Authorization: requires org:write permission (global - the service checks
+	// the caller's role in the organization)
+	if err := h.auth.CheckPermission(ctx, "org:write"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.AddOrganizationProject(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (h *Handler) RemoveOrganizationProject(
+	ctx context.Context,
+	req *connect.Request[altalunev1.RemoveOrganizationProjectRequest],
+) (*connect.Response[altalunev1.RemoveOrganizationProjectResponse], error) {
+	// Authorization: requires org:write permission (global - the service checks
+	// the caller's role in the organization)
+	if err := h.auth.CheckPermission(ctx, "org:write"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.RemoveOrganizationProject(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (h *Handler) ListOrganizationMembers(
+	ctx context.Context,
+	req *connect.Request[altalunev1.ListOrganizationMembersRequest],
+) (*connect.Response[altalunev1.ListOrganizationMembersResponse], error) {
+	// Authorization: requires org:read permission (global - the service checks
+	// the caller's role in the organization)
+	if err := h.auth.CheckPermission(ctx, "org:read"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.ListOrganizationMembers(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (h *Handler) SetOrganizationMember(
+	ctx context.Context,
+	req *connect.Request[altalunev1.SetOrganizationMemberRequest],
+) (*connect.Response[altalunev1.SetOrganizationMemberResponse], error) {
+	// Authorization: requires org:write permission (global - the service checks
+	// the caller's role in the organization)
+	if err := h.auth.CheckPermission(ctx, "org:write"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.SetOrganizationMember(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (h *Handler) RemoveOrganizationMember(
+	ctx context.Context,
+	req *connect.Request[altalunev1.RemoveOrganizationMemberRequest],
+) (*connect.Response[altalunev1.RemoveOrganizationMemberResponse], error) {
+	// Authorization: requires org:write permission (global - the service checks
+	// the caller's role in the organization)
+	if err := h.auth.CheckPermission(ctx, "org:write"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.RemoveOrganizationMember(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
@@ -0,0 +1,51 @@
+package organization
+
+import "context"
+
+type Repositor interface {
+	// Create inserts an organization with OwnerID as its owner
+	Create(ctx context.Context, input *CreateOrganizationInput) (*Organization, error)
+	// GetByPublicID returns an organization with the role of userID in it
+	GetByPublicID(ctx context.Context, publicID string, userID int64) (*Organization, error)
+	// List returns the organizations userID is a member of, or every
+	// organization when all is set, with the role of userID in each
+	List(ctx context.Context, userID int64, all bool) ([]*Organization, error)
+	Update(ctx context.Context, input *UpdateOrganizationInput) error
+	// Delete removes an organization, its projects left without one
+	Delete(ctx context.Context, id int64) error
+
+	ListProjects(ctx context.Context, id int64) ([]*Project, error)
+	// AddProject moves a project into an organization. It fails with
+	// ErrProjectInOtherOrganization when the project belongs to another one.
+	AddProject(ctx context.Context, id int64, projectID int64) error
+	RemoveProject(ctx context.Context, id int64, projectID int64) error
+
+	// GetMemberRole returns the role of a user in an organization, empty when
+	// the user is not a member
+	GetMemberRole(ctx context.Context, id int64, userID int64) (string, error)
+	// ListMembers returns the members of an organization and of its projects
+	ListMembers(ctx context.Context, id int64) ([]*Member, error)
+	// SetMember adds a user to an organization or changes their role, failing
+	// with ErrCannotRemoveLastOwner when it demotes the last owner
+	SetMember(ctx context.Context, id int64, userID int64, role string) error
+	// RemoveMember fails with ErrCannotRemoveLastOwner for the last owner
+	RemoveMember(ctx context.Context, id int64, userID int64) error
+
+	// GetProjectMemberRole returns the role of a user as a member of a project,
+	// empty when the user is not one
+	GetProjectMemberRole(ctx context.Context, projectID int64, userID int64) (string, error)
+	// GetUserProjectRoles returns the projects of the organizations of a user,
+	// with the role of the user in their organization
+	GetUserProjectRoles(ctx context.Context, userID int64) ([]*ProjectRole, error)
+	// GetProjectRole returns the role a user holds in the organization of a
+	// project, empty when none
+	GetProjectRole(ctx context.Context, projectID int64, userPublicID string) (string, error)
+}
+
+// PlanLimiter enforces the project limit of the billing plan of an
+// organization
+type PlanLimiter interface {
+	// CheckProjectAdd fails when adding projectID would take the organization
+	// over the projects its plan allows
+	CheckProjectAdd(ctx context.Context, organizationID int64, projectID int64) error
+}
@@ -0,0 +1,50 @@
+package organization
+
+import (
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ToOrganizationProto converts an organization to its protobuf message
+func (o *Organization) ToOrganizationProto() *altalunev1.Organization {
+	return &altalunev1.Organization{
+		Id:                o.PublicID,
+		Name:              o.Name,
+		BillingEmail:      o.BillingEmail,
+		BillingCustomerId: o.BillingCustomerID,
+		ProjectCount:      int32(o.ProjectCount),
+		Role:              o.Role,
+		CreatedAt:         timestamppb.New(o.CreatedAt),
+		UpdatedAt:         timestamppb.New(o.UpdatedAt),
+	}
+}
+
+// ToOrganizationProjectProto converts a project of an organization to its
+// protobuf message
+func (p *Project) ToOrganizationProjectProto() *altalunev1.OrganizationProject {
+	return &altalunev1.OrganizationProject{
+		ProjectId:   p.PublicID,
+		Name:        p.Name,
+		Environment: p.Environment,
+	}
+}
+
+// ToOrganizationMemberProto converts an organization member to its protobuf
+// message
+func (m *Member) ToOrganizationMemberProto() *altalunev1.OrganizationMember {
+	projects := make([]*altalunev1.OrganizationMemberProject, 0, len(m.Projects))
+	for _, p := range m.Projects {
+		projects = append(projects, &altalunev1.OrganizationMemberProject{
+			ProjectId: p.ProjectPublicID,
+			Role:      p.Role,
+		})
+	}
+	return &altalunev1.OrganizationMember{
+		UserId:    m.UserPublicID,
+		Email:     m.Email,
+		FirstName: m.FirstName,
+		LastName:  m.LastName,
+		Role:      m.Role,
+		Projects:  projects,
+	}
+}
@@ -0,0 +1,68 @@
+package organization
+
+import "time"
+
+// Organization roles, each granting the project role of the same name in
+// every project of the organization
+const (
+	RoleOwner = "owner"
+	RoleAdmin = "admin"
+)
+
+type Organization struct {
+	ID                int64
+	PublicID          string
+	Name              string
+	BillingEmail      string
+	BillingCustomerID string // ID of the organization at the billing provider
+	ProjectCount      int
+	Role              string // Role of the user the organization was listed for, empty for none
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+type CreateOrganizationInput struct {
+	Name              string
+	BillingEmail      string
+	BillingCustomerID string
+	OwnerID           int64 // User made the first owner
+}
+
+type UpdateOrganizationInput struct {
+	ID                int64
+	Name              string
+	BillingEmail      string
+	BillingCustomerID string
+}
+
+// Project is a project of an organization
+type Project struct {
+	PublicID    string
+	Name        string
+	Environment string
+}
+
+// Member is a user holding a role in an organization, in one of its
+// projects, or both
+type Member struct {
+	UserPublicID string
+	Email        string
+	FirstName    string
+	LastName     string
+	Role         string // Organization role, empty for project members only
+	Projects     []MemberProject
+}
+
+// MemberProject is the role a member holds as a member of a project
+type MemberProject struct {
+	ProjectPublicID string
+	Role            string
+}
+
+// ProjectRole is a project whose role a user holds through an organization
+type ProjectRole struct {
+	ProjectPublicID string
+	ProjectName     string
+	Role            string
+	JoinedAt        time.Time // When the user joined the organization
+}
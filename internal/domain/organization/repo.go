@@ -0,0 +1,427 @@
+package organization
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hrz8/altalune/internal/postgres"
+)
+
+type Repo struct {
+	db postgres.DB
+}
+
+func NewRepo(db postgres.DB) *Repo {
+	return &Repo{
+		db: db,
+	}
+}
+
+// Create inserts the organization and its owner in one statement. Without an
+// OwnerID, as when created from the CLI, the organization has no member.
+func (r *Repo) Create(ctx context.Context, input *CreateOrganizationInput) (*Organization, error) {
+	query := `
+		WITH org AS (
+			INSERT INTO altalune_organizations (public_id, name, billing_email, billing_customer_id)
+			VALUES ($1, $2, $3, $4)
+			RETURNING id, public_id, name, billing_email, billing_customer_id, created_at, updated_at
+		), owner AS (
+			INSERT INTO altalune_organization_members (organization_id, user_id, role)
+			SELECT id, $5, 'owner' FROM org WHERE $5 > 0
+		)
+		SELECT id, public_id, name, billing_email, billing_customer_id, created_at, updated_at
+		FROM org
+	`
+
+	var result Organization
+	_, err := postgres.InsertWithPublicID(func(publicID string) error {
+		return r.db.QueryRowContext(ctx, query,
+			publicID,
+			input.Name,
+			input.BillingEmail,
+			input.BillingCustomerID,
+			input.OwnerID,
+		).Scan(
+			&result.ID,
+			&result.PublicID,
+			&result.Name,
+			&result.BillingEmail,
+			&result.BillingCustomerID,
+			&result.CreatedAt,
+			&result.UpdatedAt,
+		)
+	})
+	if err != nil {
+		if postgres.IsUniqueViolation(err) && strings.Contains(err.Error(), "billing_customer_id") {
+			return nil, ErrBillingCustomerTaken
+		}
+		return nil, fmt.Errorf("create organization: %w", err)
+	}
+	if input.OwnerID > 0 {
+		result.Role = RoleOwner
+	}
+	return &result, nil
+}
+
+func (r *Repo) GetByPublicID(ctx context.Context, publicID string, userID int64) (*Organization, error) {
+	query := `
+		SELECT
+			o.id, o.public_id, o.name, o.billing_email, o.billing_customer_id,
+			(SELECT COUNT(*) FROM altalune_projects p WHERE p.organization_id = o.id),
+			COALESCE(om.role, ''),
+			o.created_at, o.updated_at
+		FROM altalune_organizations o
+		LEFT JOIN altalune_organization_members om ON om.organization_id = o.id AND om.user_id = $2
+		WHERE o.public_id = $1
+	`
+
+	var org Organization
+	err := r.db.QueryRowContext(ctx, query, publicID, userID).Scan(
+		&org.ID,
+		&org.PublicID,
+		&org.Name,
+		&org.BillingEmail,
+		&org.BillingCustomerID,
+		&org.ProjectCount,
+		&org.Role,
+		&org.CreatedAt,
+		&org.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrOrganizationNotFound
+		}
+		return nil, fmt.Errorf("get organization: %w", err)
+	}
+	return &org, nil
+}
+
+func (r *Repo) List(ctx context.Context, userID int64, all bool) ([]*Organization, error) {
+	query := `
+		SELECT
+			o.id, o.public_id, o.name, o.billing_email, o.billing_customer_id,
+			(SELECT COUNT(*) FROM altalune_projects p WHERE p.organization_id = o.id),
+			COALESCE(om.role, ''),
+			o.created_at, o.updated_at
+		FROM altalune_organizations o
+		LEFT JOIN altalune_organization_members om ON om.organization_id = o.id AND om.user_id = $1
+		WHERE $2 OR om.user_id IS NOT NULL
+		ORDER BY o.name, o.id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID, all)
+	if err != nil {
+		return nil, fmt.Errorf("list organizations: %w", err)
+	}
+	defer rows.Close()
+
+	orgs := make([]*Organization, 0)
+	for rows.Next() {
+		var org Organization
+		if err := rows.Scan(
+			&org.ID,
+			&org.PublicID,
+			&org.Name,
+			&org.BillingEmail,
+			&org.BillingCustomerID,
+			&org.ProjectCount,
+			&org.Role,
+			&org.CreatedAt,
+			&org.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan organization: %w", err)
+		}
+		orgs = append(orgs, &org)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+	return orgs, nil
+}
+
+func (r *Repo) Update(ctx context.Context, input *UpdateOrganizationInput) error {
+	query := `
+		UPDATE altalune_organizations
+		SET name = $2, billing_email = $3, billing_customer_id = $4, updated_at = NOW()
+		WHERE id = $1
+	`
+	result, err := r.db.ExecContext(ctx, query, input.ID, input.Name, input.BillingEmail, input.BillingCustomerID)
+	if err != nil {
+		if postgres.IsUniqueViolation(err) && strings.Contains(err.Error(), "billing_customer_id") {
+			return ErrBillingCustomerTaken
+		}
+		return fmt.Errorf("update organization: %w", err)
+	}
+	return expectRow(result, ErrOrganizationNotFound)
+}
+
+func (r *Repo) Delete(ctx context.Context, id int64) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM altalune_organizations WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("delete organization: %w", err)
+	}
+	return expectRow(result, ErrOrganizationNotFound)
+}
+
+func (r *Repo) ListProjects(ctx context.Context, id int64) ([]*Project, error) {
+	query := `
+		SELECT public_id, name, environment
+		FROM altalune_projects
+		WHERE organization_id = $1
+		ORDER BY name, id
+	`
+	rows, err := r.db.QueryContext(ctx, query, id)
+	if err != nil {
+		return nil, fmt.Errorf("list organization projects: %w", err)
+	}
+	defer rows.Close()
+
+	projects := make([]*Project, 0)
+	for rows.Next() {
+		var p Project
+		if err := rows.Scan(&p.PublicID, &p.Name, &p.Environment); err != nil {
+			return nil, fmt.Errorf("scan organization project: %w", err)
+		}
+		projects = append(projects, &p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+	return projects, nil
+}
+
+// AddProject is idempotent for a project already in the organization
+func (r *Repo) AddProject(ctx context.Context, id int64, projectID int64) error {
+	query := `
+		UPDATE altalune_projects
+		SET organization_id = $1, updated_at = NOW()
+		WHERE id = $2 AND (organization_id IS NULL OR organization_id = $1)
+	`
+	result, err := r.db.ExecContext(ctx, query, id, projectID)
+	if err != nil {
+		return fmt.Errorf("add organization project: %w", err)
+	}
+	return expectRow(result, ErrProjectInOtherOrganization)
+}
+
+func (r *Repo) RemoveProject(ctx context.Context, id int64, projectID int64) error {
+	query := `
+		UPDATE altalune_projects
+		SET organization_id = NULL, updated_at = NOW()
+		WHERE id = $2 AND organization_id = $1
+	`
+	result, err := r.db.ExecContext(ctx, query, id, projectID)
+	if err != nil {
+		return fmt.Errorf("remove organization project: %w", err)
+	}
+	return expectRow(result, ErrProjectNotInOrganization)
+}
+
+func (r *Repo) GetMemberRole(ctx context.Context, id int64, userID int64) (string, error) {
+	query := `
+		SELECT role FROM altalune_organization_members
+		WHERE organization_id = $1 AND user_id = $2
+	`
+	var role string
+	err := r.db.QueryRowContext(ctx, query, id, userID).Scan(&role)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil
+		}
+		return "", fmt.Errorf("get organization member role: %w", err)
+	}
+	return role, nil
+}
+
+// ListMembers reads the organization members and the members of its projects
+// in one statement, a row per project membership, and groups them by user
+func (r *Repo) ListMembers(ctx context.Context, id int64) ([]*Member, error) {
+	query := `
+		WITH org_projects AS (
+			SELECT id, public_id FROM altalune_projects WHERE organization_id = $1
+		), member_ids AS (
+			SELECT user_id FROM altalune_organization_members WHERE organization_id = $1
+			UNION
+			SELECT pm.user_id
+			FROM altalune_project_members pm
+			INNER JOIN org_projects op ON op.id = pm.project_id
+		)
+		SELECT
+			u.public_id,
+			COALESCE(u.email, ''),
+			COALESCE(u.first_name, ''),
+			COALESCE(u.last_name, ''),
+			COALESCE(om.role, ''),
+			COALESCE(op.public_id, ''),
+			COALESCE(pm.role, '')
+		FROM member_ids m
+		INNER JOIN altalune_users u ON u.id = m.user_id AND u.deleted_at IS NULL
+		LEFT JOIN altalune_organization_members om ON om.organization_id = $1 AND om.user_id = m.user_id
+		LEFT JOIN altalune_project_members pm
+			ON pm.user_id = m.user_id AND pm.project_id IN (SELECT id FROM org_projects)
+		LEFT JOIN org_projects op ON op.id = pm.project_id
+		ORDER BY
+			CASE om.role WHEN 'owner' THEN 0 WHEN 'admin' THEN 1 ELSE 2 END,
+			u.email, u.public_id, op.public_id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, id)
+	if err != nil {
+		return nil, fmt.Errorf("list organization members: %w", err)
+	}
+	defer rows.Close()
+
+	members := make([]*Member, 0)
+	var last *Member
+	for rows.Next() {
+		var m Member
+		var projectPublicID, projectRole string
+		if err := rows.Scan(
+			&m.UserPublicID,
+			&m.Email,
+			&m.FirstName,
+			&m.LastName,
+			&m.Role,
+			&projectPublicID,
+			&projectRole,
+		); err != nil {
+			return nil, fmt.Errorf("scan organization member: %w", err)
+		}
+		if last == nil || last.UserPublicID != m.UserPublicID {
+			last = &m
+			members = append(members, last)
+		}
+		if projectPublicID != "" {
+			last.Projects = append(last.Projects, MemberProject{ProjectPublicID: projectPublicID, Role: projectRole})
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+	return members, nil
+}
+
+// SetMember only demotes an owner while another owner remains, checked in the
+// same statement
+func (r *Repo) SetMember(ctx context.Context, id int64, userID int64, role string) error {
+	query := `
+		INSERT INTO altalune_organization_members (organization_id, user_id, role)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (organization_id, user_id) DO UPDATE
+		SET role = EXCLUDED.role, updated_at = NOW()
+		WHERE EXCLUDED.role = 'owner'
+		   OR altalune_organization_members.role <> 'owner'
+		   OR EXISTS (
+			SELECT 1 FROM altalune_organization_members o
+			WHERE o.organization_id = $1 AND o.user_id <> $2 AND o.role = 'owner'
+		   )
+	`
+	result, err := r.db.ExecContext(ctx, query, id, userID, role)
+	if err != nil {
+		return fmt.Errorf("set organization member: %w", err)
+	}
+	return expectRow(result, ErrCannotRemoveLastOwner)
+}
+
+func (r *Repo) RemoveMember(ctx context.Context, id int64, userID int64) error {
+	query := `
+		DELETE FROM altalune_organization_members
+		WHERE organization_id = $1 AND user_id = $2
+		  AND (role <> 'owner' OR EXISTS (
+			SELECT 1 FROM altalune_organization_members o
+			WHERE o.organization_id = $1 AND o.user_id <> $2 AND o.role = 'owner'
+		  ))
+	`
+	result, err := r.db.ExecContext(ctx, query, id, userID)
+	if err != nil {
+		return fmt.Errorf("remove organization member: %w", err)
+	}
+	if err := expectRow(result, ErrCannotRemoveLastOwner); err != nil {
+		role, roleErr := r.GetMemberRole(ctx, id, userID)
+		if roleErr != nil {
+			return roleErr
+		}
+		if role == "" {
+			return ErrOrganizationMemberNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *Repo) GetProjectMemberRole(ctx context.Context, projectID int64, userID int64) (string, error) {
+	query := `
+		SELECT role FROM altalune_project_members
+		WHERE project_id = $1 AND user_id = $2
+	`
+	var role string
+	err := r.db.QueryRowContext(ctx, query, projectID, userID).Scan(&role)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil
+		}
+		return "", fmt.Errorf("get project member role: %w", err)
+	}
+	return role, nil
+}
+
+func (r *Repo) GetUserProjectRoles(ctx context.Context, userID int64) ([]*ProjectRole, error) {
+	query := `
+		SELECT p.public_id, p.name, om.role, om.created_at
+		FROM altalune_organization_members om
+		INNER JOIN altalune_projects p ON p.organization_id = om.organization_id
+		WHERE om.user_id = $1
+	`
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get user organization projects: %w", err)
+	}
+	defer rows.Close()
+
+	var roles []*ProjectRole
+	for rows.Next() {
+		var pr ProjectRole
+		if err := rows.Scan(&pr.ProjectPublicID, &pr.ProjectName, &pr.Role, &pr.JoinedAt); err != nil {
+			return nil, fmt.Errorf("scan user organization project: %w", err)
+		}
+		roles = append(roles, &pr)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+	return roles, nil
+}
+
+func (r *Repo) GetProjectRole(ctx context.Context, projectID int64, userPublicID string) (string, error) {
+	query := `
+		SELECT om.role
+		FROM altalune_projects p
+		INNER JOIN altalune_organization_members om ON om.organization_id = p.organization_id
+		INNER JOIN altalune_users u ON u.id = om.user_id
+		WHERE p.id = $1 AND u.public_id = $2
+	`
+	var role string
+	err := r.db.QueryRowContext(ctx, query, projectID, userPublicID).Scan(&role)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil
+		}
+		return "", fmt.Errorf("get project organization role: %w", err)
+	}
+	return role, nil
+}
+
+// expectRow returns errNone when the statement changed no row
+func expectRow(result sql.Result, errNone error) error {
+	n, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("rows affected: %w", err)
+	}
+	if n == 0 {
+		return errNone
+	}
+	return nil
+}
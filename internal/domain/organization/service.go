@@ -0,0 +1,549 @@
+package organization
+
+import (
+	"context"
+	"slices"
+
+	"buf.build/go/protovalidate"
+	"github.com/hrz8/altalune"
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	"github.com/hrz8/altalune/internal/auth"
+	project_domain "github.com/hrz8/altalune/internal/domain/project"
+	user_domain "github.com/hrz8/altalune/internal/domain/user"
+)
+
+type Service struct {
+	altalunev1.UnimplementedOrganizationServiceServer
+	validator   protovalidate.Validator
+	log         altalune.Logger
+	orgRepo     Repositor
+	projectRepo project_domain.Repositor
+	userRepo    user_domain.Repository
+	limiter     PlanLimiter
+}
+
+func NewService(v protovalidate.Validator, log altalune.Logger, orgRepo Repositor, projectRepo project_domain.Repositor, userRepo user_domain.Repository, limiter PlanLimiter) *Service {
+	return &Service{
+		validator:   v,
+		log:         log,
+		orgRepo:     orgRepo,
+		projectRepo: projectRepo,
+		userRepo:    userRepo,
+		limiter:     limiter,
+	}
+}
+
+// caller identifies the user calling the service
+type caller struct {
+	userID int64
+	// unrestricted callers are superadmins and calls without an auth context
+	// (CLI, seeders), which manage every organization without being members
+	unrestricted bool
+}
+
+// ListOrganizations returns the organizations of the caller, or all of them
+// for superadmins
+func (s *Service) ListOrganizations(ctx context.Context, req *altalunev1.ListOrganizationsRequest) (*altalunev1.ListOrganizationsResponse, error) {
+	c, err := s.caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	orgs, err := s.orgRepo.List(ctx, c.userID, c.unrestricted)
+	if err != nil {
+		s.log.Error("failed to list organizations",
+			"error", err,
+			"user_id", c.userID,
+		)
+		return nil, altalune.NewUnexpectedError("failed to list organizations: %w", err)
+	}
+
+	data := make([]*altalunev1.Organization, 0, len(orgs))
+	for _, org := range orgs {
+		data = append(data, org.ToOrganizationProto())
+	}
+	return &altalunev1.ListOrganizationsResponse{Data: data}, nil
+}
+
+func (s *Service) GetOrganization(ctx context.Context, req *altalunev1.GetOrganizationRequest) (*altalunev1.GetOrganizationResponse, error) {
+	// Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	org, err := s.Authorize(ctx, req.Id, RoleOwner, RoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	projects, err := s.orgRepo.ListProjects(ctx, org.ID)
+	if err != nil {
+		s.log.Error("failed to list organization projects",
+			"error", err,
+			"organization_id", org.ID,
+		)
+		return nil, altalune.NewUnexpectedError("failed to list organization projects: %w", err)
+	}
+
+	response := &altalunev1.GetOrganizationResponse{
+		Organization: org.ToOrganizationProto(),
+		Projects:     make([]*altalunev1.OrganizationProject, 0, len(projects)),
+	}
+	for _, p := range projects {
+		response.Projects = append(response.Projects, p.ToOrganizationProjectProto())
+	}
+	return response, nil
+}
+
+// CreateOrganization creates an organization owned by the caller
+func (s *Service) CreateOrganization(ctx context.Context, req *altalunev1.CreateOrganizationRequest) (*altalunev1.CreateOrganizationResponse, error) {
+	// Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	c, err := s.caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	org, err := s.orgRepo.Create(ctx, &CreateOrganizationInput{
+		Name:              req.Name,
+		BillingEmail:      req.BillingEmail,
+		BillingCustomerID: req.BillingCustomerId,
+		OwnerID:           c.userID,
+	})
+	if err != nil {
+		if err == ErrBillingCustomerTaken {
+			return nil, altalune.NewInvalidPayloadError("billing_customer_id belongs to another organization")
+		}
+		s.log.Error("failed to create organization",
+			"error", err,
+			"name", req.Name,
+		)
+		return nil, altalune.NewUnexpectedError("failed to create organization: %w", err)
+	}
+
+	s.log.Info("organization created",
+		"organization_id", org.ID,
+		"owner_id", c.userID,
+	)
+
+	return &altalunev1.CreateOrganizationResponse{
+		Organization: org.ToOrganizationProto(),
+		Message:      "Organization created successfully",
+	}, nil
+}
+
+func (s *Service) UpdateOrganization(ctx context.Context, req *altalunev1.UpdateOrganizationRequest) (*altalunev1.UpdateOrganizationResponse, error) {
+	// Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	org, err := s.Authorize(ctx, req.Id, RoleOwner, RoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.orgRepo.Update(ctx, &UpdateOrganizationInput{
+		ID:                org.ID,
+		Name:              req.Name,
+		BillingEmail:      req.BillingEmail,
+		BillingCustomerID: req.BillingCustomerId,
+	})
+	if err != nil {
+		switch err {
+		case ErrOrganizationNotFound:
+			return nil, altalune.NewOrganizationNotFoundError(req.Id)
+		case ErrBillingCustomerTaken:
+			return nil, altalune.NewInvalidPayloadError("billing_customer_id belongs to another organization")
+		}
+		s.log.Error("failed to update organization",
+			"error", err,
+			"organization_id", org.ID,
+		)
+		return nil, altalune.NewUnexpectedError("failed to update organization: %w", err)
+	}
+
+	org.Name = req.Name
+	org.BillingEmail = req.BillingEmail
+	org.BillingCustomerID = req.BillingCustomerId
+	return &altalunev1.UpdateOrganizationResponse{
+		Organization: org.ToOrganizationProto(),
+		Message:      "Organization updated successfully",
+	}, nil
+}
+
+// DeleteOrganization deletes an organization, its projects kept without one.
+// Only owners delete an organization.
+func (s *Service) DeleteOrganization(ctx context.Context, req *altalunev1.DeleteOrganizationRequest) (*altalunev1.DeleteOrganizationResponse, error) {
+	// Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	org, err := s.Authorize(ctx, req.Id, RoleOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.orgRepo.Delete(ctx, org.ID); err != nil {
+		if err == ErrOrganizationNotFound {
+			return nil, altalune.NewOrganizationNotFoundError(req.Id)
+		}
+		s.log.Error("failed to delete organization",
+			"error", err,
+			"organization_id", org.ID,
+		)
+		return nil, altalune.NewUnexpectedError("failed to delete organization: %w", err)
+	}
+
+	s.log.Info("organization deleted", "organization_id", org.ID)
+
+	return &altalunev1.DeleteOrganizationResponse{
+		Message: "Organization deleted successfully",
+	}, nil
+}
+
+// AddOrganizationProject moves a project into an organization, within the
+// projects its billing plan allows. Besides managing the organization, the
+// caller must own the project, as its owners and admins gain that role in it.
+func (s *Service) AddOrganizationProject(ctx context.Context, req *altalunev1.AddOrganizationProjectRequest) (*altalunev1.AddOrganizationProjectResponse, error) {
+	// Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	c, err := s.caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	org, err := s.Authorize(ctx, req.Id, RoleOwner, RoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+	projectID, err := s.resolveProjectID(ctx, req.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !c.unrestricted {
+		role, err := s.orgRepo.GetProjectMemberRole(ctx, projectID, c.userID)
+		if err != nil {
+			s.log.Error("failed to get project member role",
+				"error", err,
+				"project_id", projectID,
+			)
+			return nil, altalune.NewUnexpectedError("failed to get project member role: %w", err)
+		}
+		// The organization owners become owners of the project
+		if role != RoleOwner {
+			return nil, altalune.NewProjectRoleGrantDeniedError(req.ProjectId, RoleOwner)
+		}
+	}
+
+	if err := s.limiter.CheckProjectAdd(ctx, org.ID, projectID); err != nil {
+		return nil, err
+	}
+
+	if err := s.orgRepo.AddProject(ctx, org.ID, projectID); err != nil {
+		if err == ErrProjectInOtherOrganization {
+			return nil, altalune.NewProjectInOtherOrganizationError(req.ProjectId)
+		}
+		s.log.Error("failed to add organization project",
+			"error", err,
+			"organization_id", org.ID,
+			"project_id", projectID,
+		)
+		return nil, altalune.NewUnexpectedError("failed to add organization project: %w", err)
+	}
+
+	s.log.Info("project added to organization",
+		"organization_id", org.ID,
+		"project_id", projectID,
+	)
+
+	return &altalunev1.AddOrganizationProjectResponse{
+		Message: "Project added to organization successfully",
+	}, nil
+}
+
+// RemoveOrganizationProject takes a project out of an organization, its
+// owners and admins keeping only the roles they hold as project members
+func (s *Service) RemoveOrganizationProject(ctx context.Context, req *altalunev1.RemoveOrganizationProjectRequest) (*altalunev1.RemoveOrganizationProjectResponse, error) {
+	// Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	org, err := s.Authorize(ctx, req.Id, RoleOwner, RoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+	projectID, err := s.resolveProjectID(ctx, req.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.orgRepo.RemoveProject(ctx, org.ID, projectID); err != nil {
+		if err == ErrProjectNotInOrganization {
+			return nil, altalune.NewProjectNotInOrganizationError(req.Id, req.ProjectId)
+		}
+		s.log.Error("failed to remove organization project",
+			"error", err,
+			"organization_id", org.ID,
+			"project_id", projectID,
+		)
+		return nil, altalune.NewUnexpectedError("failed to remove organization project: %w", err)
+	}
+
+	s.log.Info("project removed from organization",
+		"organization_id", org.ID,
+		"project_id", projectID,
+	)
+
+	return &altalunev1.RemoveOrganizationProjectResponse{
+		Message: "Project removed from organization successfully",
+	}, nil
+}
+
+// ListOrganizationMembers lists the organization owners and admins with the
+// members of each of its projects
+func (s *Service) ListOrganizationMembers(ctx context.Context, req *altalunev1.ListOrganizationMembersRequest) (*altalunev1.ListOrganizationMembersResponse, error) {
+	// Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	org, err := s.Authorize(ctx, req.Id, RoleOwner, RoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := s.orgRepo.ListMembers(ctx, org.ID)
+	if err != nil {
+		s.log.Error("failed to list organization members",
+			"error", err,
+			"organization_id", org.ID,
+		)
+		return nil, altalune.NewUnexpectedError("failed to list organization members: %w", err)
+	}
+
+	response := &altalunev1.ListOrganizationMembersResponse{
+		Members: make([]*altalunev1.OrganizationMember, 0, len(members)),
+	}
+	for _, m := range members {
+		response.Members = append(response.Members, m.ToOrganizationMemberProto())
+	}
+	return response, nil
+}
+
+// SetOrganizationMember adds a user to an organization or changes their role.
+// Members only grant roles at or below their own and only change members
+// ranking no higher than them, so admins neither make nor demote owners.
+func (s *Service) SetOrganizationMember(ctx context.Context, req *altalunev1.SetOrganizationMemberRequest) (*altalunev1.SetOrganizationMemberResponse, error) {
+	// Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	org, err := s.Authorize(ctx, req.Id, RoleOwner, RoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := s.resolveUserID(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkGrant(ctx, org, userID, req.UserId, req.Role); err != nil {
+		return nil, err
+	}
+
+	if err := s.orgRepo.SetMember(ctx, org.ID, userID, req.Role); err != nil {
+		if err == ErrCannotRemoveLastOwner {
+			return nil, altalune.NewCannotRemoveLastOrganizationOwnerError(req.Id)
+		}
+		s.log.Error("failed to set organization member",
+			"error", err,
+			"organization_id", org.ID,
+			"user_id", userID,
+		)
+		return nil, altalune.NewUnexpectedError("failed to set organization member: %w", err)
+	}
+
+	// Log successful update for audit purposes
+	s.log.Info("organization member set",
+		"organization_id", org.ID,
+		"user_id", userID,
+		"role", req.Role,
+	)
+
+	return &altalunev1.SetOrganizationMemberResponse{
+		Message: "Organization member saved successfully",
+	}, nil
+}
+
+// RemoveOrganizationMember removes a user from an organization, which keeps at
+// least one owner. Admins cannot remove owners.
+func (s *Service) RemoveOrganizationMember(ctx context.Context, req *altalunev1.RemoveOrganizationMemberRequest) (*altalunev1.RemoveOrganizationMemberResponse, error) {
+	// Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	org, err := s.Authorize(ctx, req.Id, RoleOwner, RoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := s.resolveUserID(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkGrant(ctx, org, userID, req.UserId, ""); err != nil {
+		return nil, err
+	}
+
+	if err := s.orgRepo.RemoveMember(ctx, org.ID, userID); err != nil {
+		switch err {
+		case ErrOrganizationMemberNotFound:
+			return nil, altalune.NewOrganizationMemberNotFoundError(req.Id, req.UserId)
+		case ErrCannotRemoveLastOwner:
+			return nil, altalune.NewCannotRemoveLastOrganizationOwnerError(req.Id)
+		}
+		s.log.Error("failed to remove organization member",
+			"error", err,
+			"organization_id", org.ID,
+			"user_id", userID,
+		)
+		return nil, altalune.NewUnexpectedError("failed to remove organization member: %w", err)
+	}
+
+	s.log.Info("organization member removed",
+		"organization_id", org.ID,
+		"user_id", userID,
+	)
+
+	return &altalunev1.RemoveOrganizationMemberResponse{
+		Message: "Organization member removed successfully",
+	}, nil
+}
+
+// caller resolves the user of the auth context. Roles are read from the
+// database rather than the token so a demotion takes effect immediately.
+func (s *Service) caller(ctx context.Context) (*caller, error) {
+	a := auth.FromContext(ctx)
+	if !a.IsAuthenticated || slices.Contains(a.Permissions, auth.RootPermission) {
+		c := &caller{unrestricted: true}
+		if a.UserID != "" {
+			c.userID, _ = s.userRepo.GetIDByPublicID(ctx, a.UserID)
+		}
+		return c, nil
+	}
+	if a.UserID == "" {
+		return &caller{}, nil
+	}
+
+	userID, err := s.userRepo.GetIDByPublicID(ctx, a.UserID)
+	if err != nil {
+		if err == user_domain.ErrUserNotFound {
+			return &caller{}, nil
+		}
+		s.log.Error("failed to resolve caller",
+			"error", err,
+			"user_public_id", a.UserID,
+		)
+		return nil, altalune.NewUnexpectedError("failed to resolve caller: %w", err)
+	}
+	return &caller{userID: userID}, nil
+}
+
+// Authorize returns the organization with the caller's role in it, failing
+// unless the caller holds one of roles or is unrestricted. Services acting on
+// an organization, such as billing, authorize their callers with it.
+func (s *Service) Authorize(ctx context.Context, publicID string, roles ...string) (*Organization, error) {
+	c, err := s.caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	org, err := s.orgRepo.GetByPublicID(ctx, publicID, c.userID)
+	if err != nil {
+		if err == ErrOrganizationNotFound {
+			return nil, altalune.NewOrganizationNotFoundError(publicID)
+		}
+		s.log.Error("failed to get organization",
+			"error", err,
+			"organization_public_id", publicID,
+		)
+		return nil, altalune.NewUnexpectedError("failed to get organization: %w", err)
+	}
+
+	if !c.unrestricted && !slices.Contains(roles, org.Role) {
+		s.log.Warn("organization access denied",
+			"organization_id", org.ID,
+			"user_id", c.userID,
+			"role", org.Role,
+		)
+		return nil, altalune.NewOrganizationAccessDeniedError(publicID)
+	}
+	return org, nil
+}
+
+// checkGrant enforces that the caller grants role, empty for a removal, and
+// changes the current role of the member only when both rank no higher than
+// the caller's own role
+func (s *Service) checkGrant(ctx context.Context, org *Organization, userID int64, userPublicID, role string) error {
+	c, err := s.caller(ctx)
+	if err != nil {
+		return err
+	}
+	if c.unrestricted {
+		return nil
+	}
+
+	current, err := s.orgRepo.GetMemberRole(ctx, org.ID, userID)
+	if err != nil {
+		s.log.Error("failed to get organization member role",
+			"error", err,
+			"organization_id", org.ID,
+		)
+		return altalune.NewUnexpectedError("failed to get organization member role: %w", err)
+	}
+
+	for _, r := range []string{role, current} {
+		if r != "" && !canGrantRole(org.Role, r) {
+			s.log.Warn("organization role grant denied",
+				"organization_id", org.ID,
+				"caller_id", c.userID,
+				"caller_role", org.Role,
+				"user_public_id", userPublicID,
+				"role", r,
+			)
+			return altalune.NewOrganizationRoleGrantDeniedError(org.PublicID, r)
+		}
+	}
+	return nil
+}
+
+func (s *Service) resolveProjectID(ctx context.Context, publicID string) (int64, error) {
+	projectID, err := s.projectRepo.GetIDByPublicID(ctx, publicID)
+	if err != nil {
+		if err == project_domain.ErrProjectNotFound {
+			return 0, altalune.NewProjectNotFound(publicID)
+		}
+		return 0, altalune.NewInvalidPayloadError("invalid project_id")
+	}
+	return projectID, nil
+}
+
+func (s *Service) resolveUserID(ctx context.Context, publicID string) (int64, error) {
+	userID, err := s.userRepo.GetIDByPublicID(ctx, publicID)
+	if err != nil {
+		if err == user_domain.ErrUserNotFound {
+			return 0, altalune.NewUserNotFoundError(publicID)
+		}
+		return 0, altalune.NewInvalidPayloadError("invalid user_id")
+	}
+	return userID, nil
+}
@@ -0,0 +1,293 @@
+package organization_test
+
+import (
+	"context"
+	"testing"
+
+	"buf.build/go/protovalidate"
+	"github.com/hrz8/altalune"
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	"github.com/hrz8/altalune/internal/auth"
+	"github.com/hrz8/altalune/internal/domain/iam_mapper"
+	"github.com/hrz8/altalune/internal/domain/oauth_auth"
+	"github.com/hrz8/altalune/internal/domain/organization"
+	"github.com/hrz8/altalune/internal/domain/project"
+	"github.com/hrz8/altalune/internal/domain/user"
+	"github.com/hrz8/altalune/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	orgID      = "org_1234567890"
+	otherOrgID = "org_0987654321"
+)
+
+// fakeRepo keeps the organizations, their roles and projects, and the
+// project members, for the parts of Repositor the service reads. It also
+// serves the project memberships of users, as the IAM mapper repository does.
+type fakeRepo struct {
+	organization.Repositor
+	orgs     map[string]int64           // Internal ID by public ID
+	roles    map[[2]int64]string        // Organization role by organization and user
+	orgOf    map[int64]int64            // Organization of a project
+	members  map[[2]int64]string        // Project role by project and user
+	projects map[int64]*project.Project // By internal ID
+}
+
+func (r *fakeRepo) GetByPublicID(ctx context.Context, publicID string, userID int64) (*organization.Organization, error) {
+	id, ok := r.orgs[publicID]
+	if !ok {
+		return nil, organization.ErrOrganizationNotFound
+	}
+	return &organization.Organization{ID: id, PublicID: publicID, Role: r.roles[[2]int64{id, userID}]}, nil
+}
+
+func (r *fakeRepo) AddProject(ctx context.Context, id int64, projectID int64) error {
+	if current := r.orgOf[projectID]; current != 0 && current != id {
+		return organization.ErrProjectInOtherOrganization
+	}
+	r.orgOf[projectID] = id
+	return nil
+}
+
+func (r *fakeRepo) GetProjectMemberRole(ctx context.Context, projectID int64, userID int64) (string, error) {
+	return r.members[[2]int64{projectID, userID}], nil
+}
+
+func (r *fakeRepo) GetUserProjectRoles(ctx context.Context, userID int64) ([]*organization.ProjectRole, error) {
+	var roles []*organization.ProjectRole
+	for projectID, id := range r.orgOf {
+		if role := r.roles[[2]int64{id, userID}]; role != "" {
+			roles = append(roles, &organization.ProjectRole{ProjectPublicID: r.projects[projectID].ID, Role: role})
+		}
+	}
+	return roles, nil
+}
+
+func (r *fakeRepo) GetUserProjects(ctx context.Context, userID int64) ([]*iam_mapper.UserProjectMembership, error) {
+	var memberships []*iam_mapper.UserProjectMembership
+	for key, role := range r.members {
+		if key[1] == userID {
+			memberships = append(memberships, &iam_mapper.UserProjectMembership{ProjectID: r.projects[key[0]].ID, Role: role})
+		}
+	}
+	return memberships, nil
+}
+
+// fakeLimiter refuses projects with err
+type fakeLimiter struct{ err error }
+
+func (l *fakeLimiter) CheckProjectAdd(context.Context, int64, int64) error { return l.err }
+
+// fixture is an organization with an owner and an admin, a project inside it
+// and one outside of any, a project of another organization, and users who
+// only are project members or nothing at all
+type fixture struct {
+	svc     *organization.Service
+	repo    *fakeRepo
+	limiter *fakeLimiter
+	users   map[string]string // Public ID by name
+	ids     map[string]int64  // Internal ID by name
+
+	inOrg, outside, inOther *project.Project
+}
+
+func newFixture(t *testing.T) *fixture {
+	t.Helper()
+	ctx := context.Background()
+
+	v, err := protovalidate.New()
+	require.NoError(t, err)
+	users := user.NewInMemRepo()
+	projects := project.NewInMemRepo()
+	f := &fixture{
+		repo: &fakeRepo{
+			orgs:     map[string]int64{orgID: 1, otherOrgID: 2},
+			roles:    make(map[[2]int64]string),
+			orgOf:    make(map[int64]int64),
+			members:  make(map[[2]int64]string),
+			projects: make(map[int64]*project.Project),
+		},
+		limiter: &fakeLimiter{},
+		users:   make(map[string]string),
+		ids:     make(map[string]int64),
+	}
+	f.svc = organization.NewService(v, logger.New("error"), f.repo, projects, users, f.limiter)
+
+	for _, name := range []string{"owner", "admin", "member", "outsider"} {
+		created, err := users.Create(ctx, &user.CreateUserInput{Email: name + "@example.com"})
+		require.NoError(t, err)
+		f.users[name], f.ids[name] = created.PublicID, created.ID
+	}
+	newProject := func(name string, org int64) *project.Project {
+		created, err := projects.Create(ctx, &project.CreateProjectInput{Name: name, Timezone: "UTC"})
+		require.NoError(t, err)
+		if org != 0 {
+			f.repo.orgOf[created.ID] = org
+		}
+		f.repo.projects[created.ID] = &project.Project{ID: created.PublicID, Name: name}
+		return f.repo.projects[created.ID]
+	}
+	f.inOrg = newProject("Inside", 1)
+	f.outside = newProject("Outside", 0)
+	f.inOther = newProject("Other", 2)
+
+	f.repo.roles[[2]int64{1, f.ids["owner"]}] = organization.RoleOwner
+	f.repo.roles[[2]int64{1, f.ids["admin"]}] = organization.RoleAdmin
+	f.repo.members[[2]int64{f.projectID(t, f.inOrg), f.ids["member"]}] = "member"
+	for _, name := range []string{"owner", "admin", "member", "outsider"} {
+		f.repo.members[[2]int64{f.projectID(t, f.outside), f.ids[name]}] = "owner"
+	}
+	f.repo.members[[2]int64{f.projectID(t, f.inOther), f.ids["owner"]}] = "owner"
+	return f
+}
+
+func (f *fixture) projectID(t *testing.T, p *project.Project) int64 {
+	t.Helper()
+	for id, candidate := range f.repo.projects {
+		if candidate == p {
+			return id
+		}
+	}
+	t.Fatalf("unknown project %s", p.Name)
+	return 0
+}
+
+// as returns a context authenticated as the named user, "root" being a
+// superadmin and "" no authentication at all
+func (f *fixture) as(name string) context.Context {
+	ctx := context.Background()
+	switch name {
+	case "":
+		return ctx
+	case "root":
+		return auth.WithAuthContext(ctx, &auth.AuthContext{
+			UserID: f.users["outsider"], Permissions: []string{auth.RootPermission}, IsAuthenticated: true,
+		})
+	}
+	return auth.WithAuthContext(ctx, &auth.AuthContext{UserID: f.users[name], IsAuthenticated: true})
+}
+
+func TestAuthorize(t *testing.T) {
+	f := newFixture(t)
+	manage := []string{organization.RoleOwner, organization.RoleAdmin}
+	own := []string{organization.RoleOwner}
+
+	tests := []struct {
+		caller string
+		org    string
+		roles  []string
+		code   codes.Code
+		role   string
+	}{
+		{caller: "owner", org: orgID, roles: manage, role: organization.RoleOwner},
+		{caller: "owner", org: orgID, roles: own, role: organization.RoleOwner},
+		{caller: "admin", org: orgID, roles: manage, role: organization.RoleAdmin},
+		{caller: "admin", org: orgID, roles: own, code: codes.PermissionDenied},
+		{caller: "member", org: orgID, roles: manage, code: codes.PermissionDenied},
+		{caller: "outsider", org: orgID, roles: manage, code: codes.PermissionDenied},
+		{caller: "owner", org: otherOrgID, roles: manage, code: codes.PermissionDenied},
+		{caller: "owner", org: "org_unknown123", roles: manage, code: codes.NotFound},
+		{caller: "root", org: orgID, roles: own},
+		{caller: "root", org: otherOrgID, roles: own},
+		{caller: "", org: orgID, roles: own},
+		{caller: "", org: "org_unknown123", roles: own, code: codes.NotFound},
+	}
+	for _, tt := range tests {
+		org, err := f.svc.Authorize(f.as(tt.caller), tt.org, tt.roles...)
+		if tt.code != codes.OK {
+			assert.Equal(t, tt.code, status.Code(err), "%q on %s requiring %v", tt.caller, tt.org, tt.roles)
+			continue
+		}
+		if assert.NoError(t, err, "%q on %s requiring %v", tt.caller, tt.org, tt.roles) {
+			assert.Equal(t, tt.role, org.Role, "%q on %s", tt.caller, tt.org)
+		}
+	}
+}
+
+func TestProjectRolesThroughOrganization(t *testing.T) {
+	f := newFixture(t)
+	memberships := oauth_auth.NewMembershipService(f.repo, f.repo)
+	authorizer := auth.NewAuthorizer()
+
+	// Organization roles are held in every project of the organization, the
+	// higher role kept where the user also is a project member
+	tests := []struct {
+		caller  string
+		project *project.Project
+		role    string // Empty when the project is out of reach
+	}{
+		{"owner", f.inOrg, "owner"},
+		{"admin", f.inOrg, "admin"},
+		{"member", f.inOrg, "member"},
+		{"outsider", f.inOrg, ""},
+		{"owner", f.outside, "owner"},
+		{"admin", f.outside, "owner"},
+		{"owner", f.inOther, "owner"},
+		{"admin", f.inOther, ""},
+		{"member", f.inOther, ""},
+	}
+	for _, tt := range tests {
+		roles, err := memberships.GetUserMemberships(context.Background(), f.ids[tt.caller])
+		require.NoError(t, err)
+		assert.Equal(t, tt.role, roles[tt.project.ID], "%s in %s", tt.caller, tt.project.Name)
+
+		ctx := auth.WithAuthContext(context.Background(), &auth.AuthContext{
+			UserID: f.users[tt.caller], Memberships: roles, IsAuthenticated: true,
+		})
+		err = authorizer.CheckProjectMembership(ctx, tt.project.ID)
+		if tt.role == "" {
+			assert.Error(t, err, "%s in %s", tt.caller, tt.project.Name)
+		} else {
+			assert.NoError(t, err, "%s in %s", tt.caller, tt.project.Name)
+		}
+	}
+
+	// An admin of the organization who owns a project keeps owning it
+	f.repo.members[[2]int64{f.projectID(t, f.inOrg), f.ids["admin"]}] = "owner"
+	roles, err := memberships.GetUserMemberships(context.Background(), f.ids["admin"])
+	require.NoError(t, err)
+	assert.Equal(t, "owner", roles[f.inOrg.ID])
+}
+
+func TestAddOrganizationProject(t *testing.T) {
+	tests := []struct {
+		name    string
+		caller  string
+		project func(f *fixture) *project.Project
+		limit   error
+		code    codes.Code
+	}{
+		{name: "owner owning the project", caller: "owner", project: func(f *fixture) *project.Project { return f.outside }},
+		{name: "admin owning the project", caller: "admin", project: func(f *fixture) *project.Project { return f.outside }},
+		{name: "project not owned", caller: "owner", project: func(f *fixture) *project.Project { return f.inOrg }, code: codes.PermissionDenied},
+		{name: "member of the project only", caller: "member", project: func(f *fixture) *project.Project { return f.outside }, code: codes.PermissionDenied},
+		{name: "outsider", caller: "outsider", project: func(f *fixture) *project.Project { return f.outside }, code: codes.PermissionDenied},
+		{name: "project of another organization", caller: "owner", project: func(f *fixture) *project.Project { return f.inOther }, code: codes.FailedPrecondition},
+		{name: "root", caller: "root", project: func(f *fixture) *project.Project { return f.outside }},
+		{name: "unauthenticated", caller: "", project: func(f *fixture) *project.Project { return f.outside }},
+		{name: "plan limit", caller: "owner", project: func(f *fixture) *project.Project { return f.outside },
+			limit: altalune.NewPlanLimitReachedError("projects", 1, "Free"), code: codes.FailedPrecondition},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture(t)
+			f.limiter.err = tt.limit
+			p := tt.project(f)
+
+			_, err := f.svc.AddOrganizationProject(f.as(tt.caller), &altalunev1.AddOrganizationProjectRequest{
+				Id:        orgID,
+				ProjectId: p.ID,
+			})
+			if tt.code != codes.OK {
+				assert.Equal(t, tt.code, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, int64(1), f.repo.orgOf[f.projectID(t, p)])
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package permission
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hrz8/altalune/internal/auth"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// CatalogEntry is a permission declared on RPCs with the
+// altalune.v1.permission option, or on fields with altalune.v1.read_permission
+type CatalogEntry struct {
+	Name    string
+	Methods []string // Full names of the RPCs declaring it, sorted
+	Fields  []string // Full names of the fields declaring it, sorted
+}
+
+// Description is used for permissions created by SyncCatalog
+func (e CatalogEntry) Description() string {
+	parts := make([]string, 0, 2)
+	if len(e.Methods) > 0 {
+		parts = append(parts, "Required by "+strings.Join(e.Methods, ", "))
+	}
+	if len(e.Fields) > 0 {
+		parts = append(parts, "Reveals "+strings.Join(e.Fields, ", "))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// Catalog collects the permissions declared on the RPCs and message fields of
+// every file in the registry, sorted by name. Pass protoregistry.GlobalFiles
+// for the protos compiled into the binary.
+func Catalog(files *protoregistry.Files) []CatalogEntry {
+	entries := make(map[string]*CatalogEntry)
+	entry := func(name string) *CatalogEntry {
+		if entries[name] == nil {
+			entries[name] = &CatalogEntry{Name: name}
+		}
+		return entries[name]
+	}
+
+	var addFields func(messages protoreflect.MessageDescriptors)
+	addFields = func(messages protoreflect.MessageDescriptors) {
+		for i := range messages.Len() {
+			fields := messages.Get(i).Fields()
+			for j := range fields.Len() {
+				if name := auth.FieldPermission(fields.Get(j)); name != "" {
+					e := entry(name)
+					e.Fields = append(e.Fields, string(fields.Get(j).FullName()))
+				}
+			}
+			addFields(messages.Get(i).Messages())
+		}
+	}
+
+	files.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		services := fd.Services()
+		for i := range services.Len() {
+			rpcs := services.Get(i).Methods()
+			for j := range rpcs.Len() {
+				for _, name := range auth.MethodPermissions(rpcs.Get(j)) {
+					e := entry(name)
+					e.Methods = append(e.Methods, string(rpcs.Get(j).FullName()))
+				}
+			}
+		}
+		addFields(fd.Messages())
+		return true
+	})
+
+	catalog := make([]CatalogEntry, 0, len(entries))
+	for _, e := range entries {
+		slices.Sort(e.Methods)
+		slices.Sort(e.Fields)
+		catalog = append(catalog, *e)
+	}
+	slices.SortFunc(catalog, func(a, b CatalogEntry) int { return strings.Compare(a.Name, b.Name) })
+	return catalog
+}
+
+// SyncResult reports what SyncCatalog found and changed, by permission name
+type SyncResult struct {
+	Created []string
+	Unused  []string // Not declared on any RPC and left in place
+	Pruned  []string
+}
+
+// SyncCatalog creates the catalog permissions missing from the table, so that
+// every permission the RPCs require can be assigned. With prune it also
+// deletes the permissions no RPC declares, except root and the ones still
+// assigned to a role or user, which are reported as unused instead.
+func SyncCatalog(ctx context.Context, repo Repository, catalog []CatalogEntry, prune bool) (*SyncResult, error) {
+	existing, err := repo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list permissions: %w", err)
+	}
+
+	known := make(map[string]bool, len(existing))
+	for _, p := range existing {
+		known[p.Name] = true
+	}
+
+	result := &SyncResult{}
+	declared := make(map[string]bool, len(catalog))
+	for _, entry := range catalog {
+		declared[entry.Name] = true
+		if known[entry.Name] {
+			continue
+		}
+		if _, err := repo.Create(ctx, &CreatePermissionInput{
+			Name:        entry.Name,
+			Description: entry.Description(),
+		}); err != nil {
+			return result, fmt.Errorf("create permission %s: %w", entry.Name, err)
+		}
+		result.Created = append(result.Created, entry.Name)
+	}
+
+	for _, p := range existing {
+		if declared[p.Name] || p.Name == auth.RootPermission {
+			continue
+		}
+		if !prune {
+			result.Unused = append(result.Unused, p.Name)
+			continue
+		}
+		err := repo.Delete(ctx, p.ID)
+		switch {
+		case err == nil:
+			result.Pruned = append(result.Pruned, p.Name)
+		case errors.Is(err, ErrPermissionInUse):
+			result.Unused = append(result.Unused, p.Name)
+		default:
+			return result, fmt.Errorf("delete permission %s: %w", p.Name, err)
+		}
+	}
+
+	return result, nil
+}
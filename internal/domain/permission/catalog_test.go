@@ -0,0 +1,36 @@
+package permission_test
+
+import (
+	"testing"
+
+	_ "github.com/hrz8/altalune/gen/altalune/v1/altalunev1connect"
+	_ "github.com/hrz8/altalune/gen/greeter/v1"
+	"github.com/hrz8/altalune/internal/domain/permission"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+func TestCatalog(t *testing.T) {
+	catalog := permission.Catalog(protoregistry.GlobalFiles)
+
+	byName := make(map[string]permission.CatalogEntry, len(catalog))
+	for i, entry := range catalog {
+		if i > 0 {
+			assert.Less(t, catalog[i-1].Name, entry.Name, "catalog is sorted by name")
+		}
+		assert.True(t, len(entry.Methods) > 0 || len(entry.Fields) > 0)
+		byName[entry.Name] = entry
+	}
+
+	require.Contains(t, byName, "user:read")
+	assert.Contains(t, byName["user:read"].Methods, "altalune.v1.UserService.GetUser")
+	assert.Contains(t, byName["greeter:write"].Methods, "greeter.v1.GreeterService.CreateAllowedName")
+	// Both allow querying projects
+	assert.Contains(t, byName["project:read"].Methods, "altalune.v1.ProjectService.QueryProjects")
+	assert.Contains(t, byName["dashboard:read"].Methods, "altalune.v1.ProjectService.QueryProjects")
+	// Declared on a field only
+	assert.Equal(t, []string{"altalune.v1.Employee.email"}, byName["employee:read_pii"].Fields)
+	assert.Empty(t, byName["employee:read_pii"].Methods)
+	assert.NotContains(t, byName, "root", "root is never declared, it bypasses every check")
+}
@@ -14,4 +14,5 @@ type Repository interface {
 	GetByID(ctx context.Context, publicID string) (*Permission, error)
 	Update(ctx context.Context, input *UpdatePermissionInput) (*UpdatePermissionResult, error)
 	Delete(ctx context.Context, publicID string) error
+	List(ctx context.Context) ([]*Permission, error)
 }
@@ -14,6 +14,8 @@ type Permission struct {
 	Description string // Human-readable (optional)
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	CreatedBy   string // Public ID of the creator, empty if unknown
+	UpdatedBy   string // Public ID of the last updater, empty if unknown
 }
 
 func (m *Permission) ToPermissionProto() *altalunev1.Permission {
@@ -23,6 +25,8 @@ func (m *Permission) ToPermissionProto() *altalunev1.Permission {
 		Description: m.Description,
 		CreatedAt:   timestamppb.New(m.CreatedAt),
 		UpdatedAt:   timestamppb.New(m.UpdatedAt),
+		CreatedBy:   m.CreatedBy,
+		UpdatedBy:   m.UpdatedBy,
 	}
 }
 
@@ -34,6 +38,8 @@ type PermissionQueryResult struct {
 	Description string
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	CreatedBy   string // Public ID of the creator, empty if unknown
+	UpdatedBy   string // Public ID of the last updater, empty if unknown
 }
 
 func (r *PermissionQueryResult) ToPermission() *Permission {
@@ -43,6 +49,8 @@ func (r *PermissionQueryResult) ToPermission() *Permission {
 		Description: r.Description,
 		CreatedAt:   r.CreatedAt,
 		UpdatedAt:   r.UpdatedAt,
+		CreatedBy:   r.CreatedBy,
+		UpdatedBy:   r.UpdatedBy,
 	}
 }
 
@@ -60,6 +68,8 @@ type CreatePermissionResult struct {
 	Description string
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	CreatedBy   string // Public ID of the creator, empty if unknown
+	UpdatedBy   string // Public ID of the last updater, empty if unknown
 }
 
 func (r *CreatePermissionResult) ToPermission() *Permission {
@@ -69,15 +79,18 @@ func (r *CreatePermissionResult) ToPermission() *Permission {
 		Description: r.Description,
 		CreatedAt:   r.CreatedAt,
 		UpdatedAt:   r.UpdatedAt,
+		CreatedBy:   r.CreatedBy,
+		UpdatedBy:   r.UpdatedBy,
 	}
 }
 
 // UpdatePermissionInput contains data for updating a permission
 type UpdatePermissionInput struct {
-	ID          int64  // Internal ID
-	PublicID    string // Public ID
-	Name        string
-	Description string
+	ID                int64  // Internal ID
+	PublicID          string // Public ID
+	Name              string
+	Description       string
+	ExpectedUpdatedAt *time.Time // Reject the update unless updated_at still matches; nil to skip
 }
 
 // UpdatePermissionResult represents the result of updating a permission
@@ -88,6 +101,8 @@ type UpdatePermissionResult struct {
 	Description string
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	CreatedBy   string // Public ID of the creator, empty if unknown
+	UpdatedBy   string // Public ID of the last updater, empty if unknown
 }
 
 func (r *UpdatePermissionResult) ToPermission() *Permission {
@@ -97,5 +112,7 @@ func (r *UpdatePermissionResult) ToPermission() *Permission {
 		Description: r.Description,
 		CreatedAt:   r.CreatedAt,
 		UpdatedAt:   r.UpdatedAt,
+		CreatedBy:   r.CreatedBy,
+		UpdatedBy:   r.UpdatedBy,
 	}
 }
@@ -8,8 +8,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hrz8/altalune/internal/auth"
 	"github.com/hrz8/altalune/internal/postgres"
-	"github.com/hrz8/altalune/internal/shared/nanoid"
 	"github.com/hrz8/altalune/internal/shared/query"
 )
 
@@ -42,6 +42,7 @@ func (r *Repo) GetIDByPublicID(ctx context.Context, publicID string) (int64, err
 
 func (r *Repo) Query(ctx context.Context, params *query.QueryParams) (*query.QueryResult[Permission], error) {
 	// Build the base query - NO project_id filtering
+	sortKey := r.sortKey(params.Sorting)
 	baseQuery := `
 		SELECT
 			id,
@@ -49,7 +50,10 @@ func (r *Repo) Query(ctx context.Context, params *query.QueryParams) (*query.Que
 			name,
 			description,
 			created_at,
-			updated_at
+			updated_at,
+			COALESCE(created_by, ''),
+			COALESCE(updated_by, ''),
+			` + sortKey.Select() + `
 		FROM altalune_permissions
 		WHERE 1=1
 	`
@@ -105,23 +109,24 @@ func (r *Repo) Query(ctx context.Context, params *query.QueryParams) (*query.Que
 	}
 
 	// First, get the total count before pagination
-	countQuery := "SELECT COUNT(*) FROM (" + baseQuery + ") as filtered"
-	var totalRows int32
-	err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&totalRows)
+	count, err := postgres.CountRows(ctx, r.db, params.Count, baseQuery, args)
 	if err != nil {
 		return nil, fmt.Errorf("count permissions: %w", err)
 	}
 
+	// Keyset pages start past the last row already fetched
+	if condition, keysetArgs := sortKey.After(params.Keyset, argCounter); condition != "" {
+		baseQuery += " AND " + condition
+		args = append(args, keysetArgs...)
+		argCounter += len(keysetArgs)
+	}
+
 	// Add ORDER BY clause
-	orderClause := r.buildOrderClause(params.Sorting)
-	baseQuery += orderClause
+	baseQuery += sortKey.OrderBy()
 
 	// Add pagination
-	pageSize := params.Pagination.PageSize
-	page := params.Pagination.Page
-	offset := (page - 1) * pageSize
 	baseQuery += fmt.Sprintf(" LIMIT $%d OFFSET $%d", argCounter, argCounter+1)
-	args = append(args, pageSize, offset)
+	args = append(args, params.PageLimit(), params.FetchOffset())
 
 	// Execute the main query
 	rows, err := r.db.QueryContext(ctx, baseQuery, args...)
@@ -132,6 +137,7 @@ func (r *Repo) Query(ctx context.Context, params *query.QueryParams) (*query.Que
 
 	// Collect queryResults
 	queryResults := make([]*PermissionQueryResult, 0)
+	var sortValue string
 	for rows.Next() {
 		var perm PermissionQueryResult
 		var description sql.NullString
@@ -143,6 +149,9 @@ func (r *Repo) Query(ctx context.Context, params *query.QueryParams) (*query.Que
 			&description,
 			&perm.CreatedAt,
 			&perm.UpdatedAt,
+			&perm.CreatedBy,
+			&perm.UpdatedBy,
+			&sortValue,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("scan permission row: %w", err)
@@ -160,12 +169,6 @@ func (r *Repo) Query(ctx context.Context, params *query.QueryParams) (*query.Que
 		return nil, fmt.Errorf("iterate permission rows: %w", err)
 	}
 
-	// Calculate total pages
-	var totalPages int32
-	if totalRows > 0 {
-		totalPages = (totalRows + pageSize - 1) / pageSize
-	}
-
 	// Get distinct values for filters
 	filters, err := r.getDistinctValues(ctx)
 	if err != nil {
@@ -179,17 +182,22 @@ func (r *Repo) Query(ctx context.Context, params *query.QueryParams) (*query.Que
 		results = append(results, v.ToPermission())
 	}
 
-	return &query.QueryResult[Permission]{
-		Data:       results,
-		TotalRows:  totalRows,
-		TotalPages: totalPages,
-		Filters:    filters,
-	}, nil
+	result := &query.QueryResult[Permission]{
+		Data:    results,
+		Filters: filters,
+	}
+	query.FillPage(result, params, count)
+	if n := len(queryResults); n > 0 {
+		result.NextKeyset = &query.Keyset{SortKey: sortValue, AfterID: queryResults[n-1].ID}
+	}
+	return result, nil
 }
 
-func (r *Repo) buildOrderClause(sorting *query.SortingParams) string {
+// sortKey maps the sorting of a query to the order its pages are fetched
+// and resumed in.
+func (r *Repo) sortKey(sorting *query.SortingParams) postgres.SortKey {
 	if sorting == nil || sorting.Field == "" {
-		return " ORDER BY name ASC" // Default sorting
+		return postgres.SortKey{Expr: "name"} // Default sorting
 	}
 
 	// Map field to database column
@@ -198,7 +206,7 @@ func (r *Repo) buildOrderClause(sorting *query.SortingParams) string {
 	case "name":
 		dbColumn = "name"
 	case "description":
-		dbColumn = "description"
+		dbColumn = "COALESCE(description, '')"
 	case "createdAt", "created_at":
 		dbColumn = "created_at"
 	case "updatedAt", "updated_at":
@@ -209,13 +217,7 @@ func (r *Repo) buildOrderClause(sorting *query.SortingParams) string {
 		dbColumn = "name" // Fallback to default
 	}
 
-	// Determine sort direction
-	direction := "ASC"
-	if sorting.Order == query.SortOrderDesc {
-		direction = "DESC"
-	}
-
-	return fmt.Sprintf(" ORDER BY %s %s", dbColumn, direction)
+	return postgres.NewSortKey(dbColumn, sorting)
 }
 
 func (r *Repo) getDistinctValues(ctx context.Context) (map[string][]string, error) {
@@ -228,9 +230,6 @@ func (r *Repo) getDistinctValues(ctx context.Context) (map[string][]string, erro
 
 // Create creates a new permission in the database
 func (r *Repo) Create(ctx context.Context, input *CreatePermissionInput) (*CreatePermissionResult, error) {
-	// Generate public ID
-	publicID, _ := nanoid.GeneratePublicID()
-
 	// Insert query - NO project_id
 	insertQuery := `
 		INSERT INTO altalune_permissions (
@@ -238,31 +237,39 @@ func (r *Repo) Create(ctx context.Context, input *CreatePermissionInput) (*Creat
 			name,
 			description,
 			created_at,
-			updated_at
-		) VALUES ($1, $2, $3, $4, $5)
-		RETURNING id, public_id, name, description, created_at, updated_at
+			updated_at,
+			created_by,
+			updated_by
+		) VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''), NULLIF($6, ''))
+		RETURNING id, public_id, name, description, created_at, updated_at,
+		          COALESCE(created_by, ''), COALESCE(updated_by, '')
 	`
 
 	now := time.Now()
 	var result CreatePermissionResult
 	var description sql.NullString
 
-	err := r.db.QueryRowContext(
-		ctx,
-		insertQuery,
-		publicID,
-		input.Name,
-		input.Description,
-		now,
-		now,
-	).Scan(
-		&result.ID,
-		&result.PublicID,
-		&result.Name,
-		&description,
-		&result.CreatedAt,
-		&result.UpdatedAt,
-	)
+	_, err := postgres.InsertWithPublicID(func(publicID string) error {
+		return r.db.QueryRowContext(
+			ctx,
+			insertQuery,
+			publicID,
+			input.Name,
+			input.Description,
+			now,
+			now,
+			auth.ActorID(ctx),
+		).Scan(
+			&result.ID,
+			&result.PublicID,
+			&result.Name,
+			&description,
+			&result.CreatedAt,
+			&result.UpdatedAt,
+			&result.CreatedBy,
+			&result.UpdatedBy,
+		)
+	})
 
 	if err != nil {
 		// Check for unique constraint violation
@@ -291,7 +298,9 @@ func (r *Repo) GetByName(ctx context.Context, name string) (*Permission, error)
 			name,
 			description,
 			created_at,
-			updated_at
+			updated_at,
+			COALESCE(created_by, ''),
+			COALESCE(updated_by, '')
 		FROM altalune_permissions
 		WHERE LOWER(name) = LOWER($1)
 		LIMIT 1
@@ -306,6 +315,8 @@ func (r *Repo) GetByName(ctx context.Context, name string) (*Permission, error)
 		&description,
 		&perm.CreatedAt,
 		&perm.UpdatedAt,
+		&perm.CreatedBy,
+		&perm.UpdatedBy,
 	)
 
 	if err != nil {
@@ -331,7 +342,9 @@ func (r *Repo) GetByID(ctx context.Context, publicID string) (*Permission, error
 			name,
 			description,
 			created_at,
-			updated_at
+			updated_at,
+			COALESCE(created_by, ''),
+			COALESCE(updated_by, '')
 		FROM altalune_permissions
 		WHERE public_id = $1
 	`
@@ -345,6 +358,8 @@ func (r *Repo) GetByID(ctx context.Context, publicID string) (*Permission, error
 		&description,
 		&perm.CreatedAt,
 		&perm.UpdatedAt,
+		&perm.CreatedBy,
+		&perm.UpdatedBy,
 	)
 
 	if err != nil {
@@ -370,27 +385,45 @@ func (r *Repo) Update(ctx context.Context, input *UpdatePermissionInput) (*Updat
 		return nil, ErrPermissionAlreadyExists
 	}
 
+	// The current row is returned flagged as a conflict instead when it
+	// changed since the expected version
 	sqlQuery := `
-		UPDATE altalune_permissions
-		SET name = $1, description = $2, updated_at = CURRENT_TIMESTAMP
-		WHERE public_id = $3
-		RETURNING id, public_id, name, description, created_at, updated_at
+		WITH updated AS (
+			UPDATE altalune_permissions
+			SET name = $1, description = $2, updated_at = CURRENT_TIMESTAMP, updated_by = NULLIF($5, '')
+			WHERE public_id = $3 AND ($4::timestamptz IS NULL OR updated_at = $4)
+			RETURNING *
+		), result AS (
+			SELECT false AS conflict, updated.* FROM updated
+			UNION ALL
+			SELECT true, p.* FROM altalune_permissions p
+			WHERE p.public_id = $3 AND NOT EXISTS (SELECT 1 FROM updated)
+		)
+		SELECT conflict, id, public_id, name, description, created_at, updated_at,
+		       COALESCE(created_by, ''), COALESCE(updated_by, '')
+		FROM result
 	`
 
 	var result UpdatePermissionResult
 	var description sql.NullString
+	var conflict bool
 
 	err = r.db.QueryRowContext(ctx, sqlQuery,
 		input.Name,
 		input.Description,
 		input.PublicID,
+		input.ExpectedUpdatedAt,
+		auth.ActorID(ctx),
 	).Scan(
+		&conflict,
 		&result.ID,
 		&result.PublicID,
 		&result.Name,
 		&description,
 		&result.CreatedAt,
 		&result.UpdatedAt,
+		&result.CreatedBy,
+		&result.UpdatedBy,
 	)
 
 	if err != nil {
@@ -402,6 +435,9 @@ func (r *Repo) Update(ctx context.Context, input *UpdatePermissionInput) (*Updat
 		}
 		return nil, fmt.Errorf("failed to update permission: %w", err)
 	}
+	if conflict {
+		return nil, ErrPermissionVersionConflict
+	}
 
 	// Handle nullable description
 	if description.Valid {
@@ -435,3 +471,51 @@ func (r *Repo) Delete(ctx context.Context, publicID string) error {
 
 	return nil
 }
+
+// List returns every permission, ordered by name
+func (r *Repo) List(ctx context.Context) ([]*Permission, error) {
+	query := `
+		SELECT
+			public_id,
+			name,
+			description,
+			created_at,
+			updated_at,
+			COALESCE(created_by, ''),
+			COALESCE(updated_by, '')
+		FROM altalune_permissions
+		ORDER BY name
+	`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("list permissions: %w", err)
+	}
+	defer rows.Close()
+
+	permissions := make([]*Permission, 0)
+	for rows.Next() {
+		var perm Permission
+		var description sql.NullString
+		if err := rows.Scan(
+			&perm.ID,
+			&perm.Name,
+			&description,
+			&perm.CreatedAt,
+			&perm.UpdatedAt,
+			&perm.CreatedBy,
+			&perm.UpdatedBy,
+		); err != nil {
+			return nil, fmt.Errorf("scan permission: %w", err)
+		}
+		if description.Valid {
+			perm.Description = description.String
+		}
+		permissions = append(permissions, &perm)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate permissions: %w", err)
+	}
+
+	return permissions, nil
+}
@@ -0,0 +1,53 @@
+package permission_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hrz8/altalune/internal/domain/permission"
+	"github.com/hrz8/altalune/internal/testdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) { testdb.Main(m) }
+
+func TestRepoUpdateConflict(t *testing.T) {
+	ctx := context.Background()
+	db := testdb.Tx(t)
+	fixtures := testdb.Seed(t, db)
+	repo := permission.NewRepo(db)
+
+	created, err := repo.Create(ctx, &permission.CreatePermissionInput{Name: "conflict_" + fixtures.ProjectPublicID})
+	require.NoError(t, err)
+
+	stale := created.UpdatedAt.Add(-time.Second)
+	_, err = repo.Update(ctx, &permission.UpdatePermissionInput{
+		PublicID:          created.PublicID,
+		Name:              created.Name,
+		Description:       "stale",
+		ExpectedUpdatedAt: &stale,
+	})
+	assert.ErrorIs(t, err, permission.ErrPermissionVersionConflict)
+
+	byID, err := repo.GetByID(ctx, created.PublicID)
+	require.NoError(t, err)
+	assert.Empty(t, byID.Description, "a conflicting update changes nothing")
+
+	updated, err := repo.Update(ctx, &permission.UpdatePermissionInput{
+		PublicID:          created.PublicID,
+		Name:              created.Name,
+		Description:       "fresh",
+		ExpectedUpdatedAt: &created.UpdatedAt,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "fresh", updated.Description)
+
+	_, err = repo.Update(ctx, &permission.UpdatePermissionInput{
+		PublicID:          "unknown",
+		Name:              "unknown_" + fixtures.ProjectPublicID,
+		ExpectedUpdatedAt: &updated.UpdatedAt,
+	})
+	assert.ErrorIs(t, err, permission.ErrPermissionNotFound, "a missing permission is not a conflict")
+}
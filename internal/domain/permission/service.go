@@ -49,6 +49,10 @@ func (s *Service) QueryPermissions(ctx context.Context, req *altalunev1.QueryPer
 	// Convert proto request to domain query params
 	queryParams := query.DefaultQueryParams(req.Query)
 
+	if err := queryParams.ApplyCursor(req.Query.GetCursor()); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
 	// Query permissions from repository
 	result, err := s.permissionRepo.Query(ctx, queryParams)
 	if err != nil {
@@ -64,9 +68,10 @@ func (s *Service) QueryPermissions(ctx context.Context, req *altalunev1.QueryPer
 		return &altalunev1.QueryPermissionsResponse{
 			Data: []*altalunev1.Permission{},
 			Meta: &altalunev1.QueryMetaResponse{
-				RowCount:  0,
-				PageCount: 0,
-				Filters:   make(map[string]*altalunev1.FilterValues),
+				RowCount:   0,
+				PageCount:  0,
+				ExactTotal: true,
+				Filters:    make(map[string]*altalunev1.FilterValues),
 			},
 		}, nil
 	}
@@ -74,9 +79,13 @@ func (s *Service) QueryPermissions(ctx context.Context, req *altalunev1.QueryPer
 	return &altalunev1.QueryPermissionsResponse{
 		Data: mapPermissionsToProto(result.Data),
 		Meta: &altalunev1.QueryMetaResponse{
-			RowCount:  result.TotalRows,
-			PageCount: result.TotalPages,
-			Filters:   mapFiltersToProto(result.Filters),
+			RowCount:    result.TotalRows,
+			PageCount:   result.TotalPages,
+			Filters:     mapFiltersToProto(result.Filters),
+			CountMode:   query.CountModeToProto(result.Count),
+			HasNextPage: result.HasMore,
+			NextCursor:  query.NextCursor(queryParams, result),
+			ExactTotal:  result.ExactTotal(),
 		},
 	}, nil
 }
@@ -179,12 +188,19 @@ func (s *Service) UpdatePermission(ctx context.Context, req *altalunev1.UpdatePe
 		Name:        name,
 		Description: strings.TrimSpace(req.Description),
 	}
+	if req.ExpectedUpdatedAt != nil {
+		expectedUpdatedAt := req.ExpectedUpdatedAt.AsTime()
+		input.ExpectedUpdatedAt = &expectedUpdatedAt
+	}
 
 	result, err := s.permissionRepo.Update(ctx, input)
 	if err != nil {
 		if err == ErrPermissionNotFound {
 			return nil, altalune.NewPermissionNotFoundError(req.Id)
 		}
+		if err == ErrPermissionVersionConflict {
+			return nil, altalune.NewVersionConflictError("permission", req.Id)
+		}
 		if err == ErrPermissionAlreadyExists {
 			return nil, altalune.NewPermissionAlreadyExistsError(name)
 		}
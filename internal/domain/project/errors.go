@@ -3,6 +3,7 @@ package project
 import "errors"
 
 var (
-	ErrProjectNotFound      = errors.New("project not found")
-	ErrProjectAlreadyExists = errors.New("project with this name already exists")
+	ErrProjectNotFound        = errors.New("project not found")
+	ErrProjectVersionConflict = errors.New("project was modified concurrently")
+	ErrProjectAlreadyExists   = errors.New("project with this name already exists")
 )
@@ -99,3 +99,67 @@ func (h *Handler) DeleteProject(
 	}
 	return connect.NewResponse(response), nil
 }
+
+func (h *Handler) GetProjectOnboarding(
+	ctx context.Context,
+	req *connect.Request[altalunev1.GetProjectOnboardingRequest],
+) (*connect.Response[altalunev1.GetProjectOnboardingResponse], error) {
+	// Authorization: requires project:read permission (global, not project-scoped)
+	if err := h.auth.CheckPermission(ctx, "project:read"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.GetProjectOnboarding(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (h *Handler) UpdateProjectOnboarding(
+	ctx context.Context,
+	req *connect.Request[altalunev1.UpdateProjectOnboardingRequest],
+) (*connect.Response[altalunev1.UpdateProjectOnboardingResponse], error) {
+	// Authorization: requires project:write permission (global, not project-scoped)
+	if err := h.auth.CheckPermission(ctx, "project:write"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.UpdateProjectOnboarding(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (h *Handler) GetProjectCredentialPolicy(
+	ctx context.Context,
+	req *connect.Request[altalunev1.GetProjectCredentialPolicyRequest],
+) (*connect.Response[altalunev1.GetProjectCredentialPolicyResponse], error) {
+	// Authorization: requires project:read permission (global, not project-scoped)
+	if err := h.auth.CheckPermission(ctx, "project:read"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.GetProjectCredentialPolicy(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (h *Handler) UpdateProjectCredentialPolicy(
+	ctx context.Context,
+	req *connect.Request[altalunev1.UpdateProjectCredentialPolicyRequest],
+) (*connect.Response[altalunev1.UpdateProjectCredentialPolicyResponse], error) {
+	// Authorization: requires project:write permission (global, not project-scoped)
+	if err := h.auth.CheckPermission(ctx, "project:write"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.UpdateProjectCredentialPolicy(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
@@ -0,0 +1,97 @@
+package project
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// idCacheTTL bounds how long a resolved project ID is reused. The ID of a
+// public ID never changes while the project exists, so this only bounds how
+// long a replica keeps resolving a project another replica deleted.
+const idCacheTTL = 5 * time.Minute
+
+// idCacheMaxEntries caps the cache since public IDs come from requests.
+const idCacheMaxEntries = 10000
+
+type idCacheEntry struct {
+	publicID  string
+	id        int64
+	expiresAt time.Time
+}
+
+// idCache maps project public IDs to internal IDs, so the lookup most
+// requests start with skips the database. Once full, the least recently used
+// entry makes room for the new one.
+//
+// Only committed rows are cached: the repository statements run on their own
+// rather than in a transaction, the request-wide one being gone since project
+// scopes hold a connection instead, so a project an aborted request created
+// is never read. Unknown public IDs are not cached, projects created on
+// another replica resolve at once.
+//
+// Each replica caches on its own and forgets a project only when it deletes
+// it. Others keep resolving a deleted project for up to idCacheTTL, to an ID
+// no row has anymore: identity IDs are not reused, so the statements that
+// follow find nothing rather than another project.
+type idCache struct {
+	mu      sync.Mutex
+	entries map[string]*list.Element // Of order, holding an *idCacheEntry
+	order   *list.List               // Most recently used first
+	now     func() time.Time
+}
+
+func newIDCache() *idCache {
+	return &idCache{
+		entries: make(map[string]*list.Element),
+		order:   list.New(),
+		now:     time.Now,
+	}
+}
+
+// get returns the internal ID of publicID and whether it was cached
+func (c *idCache) get(publicID string) (int64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.entries[publicID]
+	if !ok {
+		return 0, false
+	}
+	entry := elem.Value.(*idCacheEntry)
+	if c.now().After(entry.expiresAt) {
+		c.remove(elem)
+		return 0, false
+	}
+	c.order.MoveToFront(elem)
+	return entry.id, true
+}
+
+func (c *idCache) put(publicID string, id int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	expiresAt := c.now().Add(idCacheTTL)
+	if elem, ok := c.entries[publicID]; ok {
+		entry := elem.Value.(*idCacheEntry)
+		entry.id, entry.expiresAt = id, expiresAt
+		c.order.MoveToFront(elem)
+		return
+	}
+	if c.order.Len() >= idCacheMaxEntries {
+		c.remove(c.order.Back())
+	}
+	c.entries[publicID] = c.order.PushFront(&idCacheEntry{publicID: publicID, id: id, expiresAt: expiresAt})
+}
+
+// forget drops publicID, once its project is deleted
+func (c *idCache) forget(publicID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.entries[publicID]; ok {
+		c.remove(elem)
+	}
+}
+
+func (c *idCache) remove(elem *list.Element) {
+	c.order.Remove(elem)
+	delete(c.entries, elem.Value.(*idCacheEntry).publicID)
+}
@@ -0,0 +1,85 @@
+package project
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/hrz8/altalune/internal/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIDCacheExpiry(t *testing.T) {
+	cache := newIDCache()
+	now := time.Unix(1700000000, 0)
+	cache.now = func() time.Time { return now }
+
+	cache.put("prj_1234567890", 42)
+	id, ok := cache.get("prj_1234567890")
+	require.True(t, ok)
+	assert.Equal(t, int64(42), id)
+
+	now = now.Add(idCacheTTL)
+	_, ok = cache.get("prj_1234567890")
+	assert.True(t, ok, "an entry is reused up to its TTL")
+
+	now = now.Add(time.Second)
+	_, ok = cache.get("prj_1234567890")
+	assert.False(t, ok, "an entry past its TTL is resolved again")
+
+	cache.put("prj_1234567890", 42)
+	_, ok = cache.get("prj_1234567890")
+	assert.True(t, ok, "resolving again renews the entry")
+}
+
+func TestIDCacheEviction(t *testing.T) {
+	cache := newIDCache()
+	for i := range idCacheMaxEntries {
+		cache.put("prj_"+strconv.Itoa(i), int64(i))
+	}
+	_, ok := cache.get("prj_0")
+	require.True(t, ok, "the cache holds up to its cap")
+
+	cache.put("prj_over", idCacheMaxEntries)
+	assert.Len(t, cache.entries, idCacheMaxEntries, "the cache stays at its cap")
+	_, ok = cache.get("prj_1")
+	assert.False(t, ok, "the least recently used entry is evicted")
+	_, ok = cache.get("prj_0")
+	assert.True(t, ok, "a recently read entry is kept")
+	_, ok = cache.get("prj_2")
+	assert.True(t, ok)
+	id, ok := cache.get("prj_over")
+	require.True(t, ok)
+	assert.Equal(t, int64(idCacheMaxEntries), id)
+
+	cache.put("prj_again", 1)
+	_, ok = cache.get("prj_3")
+	assert.False(t, ok, "evictions go on in order of use")
+	_, ok = cache.get("prj_2")
+	assert.True(t, ok)
+}
+
+// deleteDB deletes one row on every exec; Delete runs nothing else
+type deleteDB struct {
+	postgres.DB
+}
+
+func (deleteDB) ExecContext(context.Context, string, ...any) (sql.Result, error) {
+	return driver.RowsAffected(1), nil
+}
+
+func TestRepoDeleteForgetsID(t *testing.T) {
+	repo := &Repo{db: deleteDB{}, ids: newIDCache()}
+	repo.ids.put("prj_1234567890", 42)
+	repo.ids.put("prj_0987654321", 43)
+
+	require.NoError(t, repo.Delete(context.Background(), "prj_1234567890"))
+	_, ok := repo.ids.get("prj_1234567890")
+	assert.False(t, ok, "a deleted project no longer resolves from the cache")
+	_, ok = repo.ids.get("prj_0987654321")
+	assert.True(t, ok, "other projects stay cached")
+}
@@ -8,10 +8,20 @@ import (
 
 type Repositor interface {
 	GetIDByPublicID(ctx context.Context, publicID string) (int64, error)
+	GetTimezone(ctx context.Context, projectID int64) (string, error)
 	Query(ctx context.Context, params *query.QueryParams) (*query.QueryResult[Project], error)
 	Create(ctx context.Context, input *CreateProjectInput) (*CreateProjectResult, error)
 	GetByName(ctx context.Context, name string) (*Project, error)
 	GetByID(ctx context.Context, publicID string) (*Project, error)
 	Update(ctx context.Context, input *UpdateProjectInput) (*UpdateProjectResult, error)
 	Delete(ctx context.Context, publicID string) error
+	GetOnboarding(ctx context.Context, projectID int64) (*Onboarding, error)
+	UpdateOnboarding(ctx context.Context, input *UpdateOnboardingInput) (*Onboarding, error)
+	GetCredentialPolicy(ctx context.Context, projectID int64) (*CredentialPolicy, error)
+	UpdateCredentialPolicy(ctx context.Context, input *UpdateCredentialPolicyInput) (*CredentialPolicy, error)
+	// GetDefaultCredentialPolicy returns the credential policy of the default
+	// project, which global entities such as OAuth clients follow
+	GetDefaultCredentialPolicy(ctx context.Context) (*CredentialPolicy, error)
+	// ListPolicyProjects returns the projects with a maximum API key lifetime
+	ListPolicyProjects(ctx context.Context) ([]*PolicyProject, error)
 }
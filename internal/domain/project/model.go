@@ -4,6 +4,8 @@ import (
 	"time"
 
 	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	"github.com/hrz8/altalune/internal/shared/secretdelivery"
+	"github.com/hrz8/altalune/internal/shared/tz"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -48,6 +50,8 @@ type ProjectQueryResult struct {
 	IsDefault   bool
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	CreatedBy   string // Public ID of the creator, empty if unknown
+	UpdatedBy   string // Public ID of the last updater, empty if unknown
 }
 
 func (r *ProjectQueryResult) ToProject() *Project {
@@ -60,6 +64,8 @@ func (r *ProjectQueryResult) ToProject() *Project {
 		IsDefault:   r.IsDefault,
 		CreatedAt:   r.CreatedAt,
 		UpdatedAt:   r.UpdatedAt,
+		CreatedBy:   r.CreatedBy,
+		UpdatedBy:   r.UpdatedBy,
 	}
 }
 
@@ -72,6 +78,8 @@ type Project struct {
 	IsDefault   bool
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	CreatedBy   string // Public ID of the creator, empty if unknown
+	UpdatedBy   string // Public ID of the last updater, empty if unknown
 }
 
 func (m *Project) ToProjectProto() *altalunev1.Project {
@@ -84,6 +92,8 @@ func (m *Project) ToProjectProto() *altalunev1.Project {
 		IsDefault:   m.IsDefault,
 		CreatedAt:   timestamppb.New(m.CreatedAt),
 		UpdatedAt:   timestamppb.New(m.UpdatedAt),
+		CreatedBy:   m.CreatedBy,
+		UpdatedBy:   m.UpdatedBy,
 	}
 }
 
@@ -104,6 +114,8 @@ type CreateProjectResult struct {
 	IsDefault   bool
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	CreatedBy   string // Public ID of the creator, empty if unknown
+	UpdatedBy   string // Public ID of the last updater, empty if unknown
 }
 
 func (r *CreateProjectResult) ToProject() *Project {
@@ -116,15 +128,18 @@ func (r *CreateProjectResult) ToProject() *Project {
 		IsDefault:   r.IsDefault,
 		CreatedAt:   r.CreatedAt,
 		UpdatedAt:   r.UpdatedAt,
+		CreatedBy:   r.CreatedBy,
+		UpdatedBy:   r.UpdatedBy,
 	}
 }
 
 type UpdateProjectInput struct {
-	ID          int64
-	PublicID    string
-	Name        string
-	Description string
-	Timezone    string
+	ID                int64
+	PublicID          string
+	Name              string
+	Description       string
+	Timezone          string
+	ExpectedUpdatedAt *time.Time // Reject the update unless updated_at still matches; nil to skip
 }
 
 type UpdateProjectResult struct {
@@ -137,6 +152,8 @@ type UpdateProjectResult struct {
 	IsDefault   bool
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	CreatedBy   string // Public ID of the creator, empty if unknown
+	UpdatedBy   string // Public ID of the last updater, empty if unknown
 }
 
 func (r *UpdateProjectResult) ToProject() *Project {
@@ -149,9 +166,111 @@ func (r *UpdateProjectResult) ToProject() *Project {
 		IsDefault:   r.IsDefault,
 		CreatedAt:   r.CreatedAt,
 		UpdatedAt:   r.UpdatedAt,
+		CreatedBy:   r.CreatedBy,
+		UpdatedBy:   r.UpdatedBy,
 	}
 }
 
 type DeleteProjectInput struct {
 	PublicID string
 }
+
+// Onboarding is how self-registered users join a project. The zero value
+// inherits the auth server configuration.
+type Onboarding struct {
+	DefaultMemberRole string // Empty picks the role from the registration context
+	AutoActivate      *bool  // nil follows the auth server auto-activate setting
+}
+
+func (m *Onboarding) ToProjectOnboardingProto() *altalunev1.ProjectOnboarding {
+	return &altalunev1.ProjectOnboarding{
+		DefaultMemberRole: m.DefaultMemberRole,
+		AutoActivate:      m.AutoActivate,
+	}
+}
+
+type UpdateOnboardingInput struct {
+	ProjectID         int64
+	DefaultMemberRole string // Empty to inherit
+	AutoActivate      *bool  // nil to inherit
+}
+
+// CredentialPolicyAction is what the credential policy jobs do with the API
+// keys of a project that are out of policy, and with the OAuth client secrets
+// for the default project
+type CredentialPolicyAction string
+
+const (
+	CredentialPolicyActionFlag    CredentialPolicyAction = "flag"    // Report them to the owners
+	CredentialPolicyActionDisable CredentialPolicyAction = "disable" // Deactivate them
+)
+
+// CredentialPolicy caps the lifetime of the API keys of a project and sets
+// how the secrets generated on creation are handed over. Without a maximum
+// lifetime only the two years any key is limited to apply. OAuth clients are
+// global and follow the policy of the default project.
+type CredentialPolicy struct {
+	ApiKeyMaxLifetimeDays       int // 0 for no maximum
+	Action                      CredentialPolicyAction
+	SecretDelivery              secretdelivery.Mode
+	ClientSecretMaxLifetimeDays int // 0 for no maximum
+}
+
+// ApiKeyExpirationLimit returns the latest expiration the policy allows for a
+// key created at createdAt: the end of the day ApiKeyMaxLifetimeDays later, in
+// loc. ok is false when the policy sets no maximum.
+func (m *CredentialPolicy) ApiKeyExpirationLimit(createdAt time.Time, loc *time.Location) (limit time.Time, ok bool) {
+	if m.ApiKeyMaxLifetimeDays <= 0 {
+		return time.Time{}, false
+	}
+	return tz.EndOfDay(tz.AddDays(createdAt, m.ApiKeyMaxLifetimeDays, loc), loc), true
+}
+
+// ClientSecretExpiration returns when the policy expires an OAuth client
+// secret set at setAt: the end of the day ClientSecretMaxLifetimeDays later,
+// in loc. ok is false when the policy sets no maximum.
+func (m *CredentialPolicy) ClientSecretExpiration(setAt time.Time, loc *time.Location) (expiration time.Time, ok bool) {
+	if m.ClientSecretMaxLifetimeDays <= 0 {
+		return time.Time{}, false
+	}
+	return tz.EndOfDay(tz.AddDays(setAt, m.ClientSecretMaxLifetimeDays, loc), loc), true
+}
+
+func (m *CredentialPolicy) ToProjectCredentialPolicyProto() *altalunev1.ProjectCredentialPolicy {
+	action := altalunev1.CredentialPolicyAction_CREDENTIAL_POLICY_ACTION_FLAG
+	if m.Action == CredentialPolicyActionDisable {
+		action = altalunev1.CredentialPolicyAction_CREDENTIAL_POLICY_ACTION_DISABLE
+	}
+	return &altalunev1.ProjectCredentialPolicy{
+		ApiKeyMaxLifetimeDays:       int32(m.ApiKeyMaxLifetimeDays),
+		Action:                      action,
+		SecretDelivery:              m.SecretDelivery.ToProto(),
+		ClientSecretMaxLifetimeDays: int32(m.ClientSecretMaxLifetimeDays),
+	}
+}
+
+// CredentialPolicyActionFromProto returns the action of a request, flag when
+// unspecified
+func CredentialPolicyActionFromProto(action altalunev1.CredentialPolicyAction) CredentialPolicyAction {
+	if action == altalunev1.CredentialPolicyAction_CREDENTIAL_POLICY_ACTION_DISABLE {
+		return CredentialPolicyActionDisable
+	}
+	return CredentialPolicyActionFlag
+}
+
+type UpdateCredentialPolicyInput struct {
+	ProjectID                   int64
+	ApiKeyMaxLifetimeDays       int // 0 to remove the maximum
+	Action                      CredentialPolicyAction
+	SecretDelivery              secretdelivery.Mode
+	ClientSecretMaxLifetimeDays int // 0 to remove the maximum
+}
+
+// PolicyProject is a project with a maximum API key lifetime, as enforced by
+// the credential policy job
+type PolicyProject struct {
+	ID       int64
+	Name     string
+	Timezone string
+	Policy   CredentialPolicy
+}
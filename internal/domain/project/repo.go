@@ -8,22 +8,30 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hrz8/altalune/internal/auth"
 	"github.com/hrz8/altalune/internal/postgres"
-	"github.com/hrz8/altalune/internal/shared/nanoid"
 	"github.com/hrz8/altalune/internal/shared/query"
 )
 
 type Repo struct {
-	db postgres.DB
+	db  postgres.DB
+	ids *idCache
 }
 
 func NewRepo(db postgres.DB) *Repo {
 	return &Repo{
-		db: db,
+		db:  db,
+		ids: newIDCache(),
 	}
 }
 
+// GetIDByPublicID resolves the internal ID of a project. Resolved IDs are
+// cached, as nearly every request naming a project starts with this lookup.
 func (r *Repo) GetIDByPublicID(ctx context.Context, publicID string) (int64, error) {
+	if id, ok := r.ids.get(publicID); ok {
+		return id, nil
+	}
+
 	query := `
 		SELECT id 
 		FROM altalune_projects 
@@ -39,11 +47,27 @@ func (r *Repo) GetIDByPublicID(ctx context.Context, publicID string) (int64, err
 		return 0, fmt.Errorf("get project ID by public ID: %w", err)
 	}
 
+	r.ids.put(publicID, projectID)
 	return projectID, nil
 }
 
+// GetTimezone returns the time zone name the project is configured with
+func (r *Repo) GetTimezone(ctx context.Context, projectID int64) (string, error) {
+	var timezone string
+	err := r.db.QueryRowContext(ctx, `SELECT timezone FROM altalune_projects WHERE id = $1`, projectID).Scan(&timezone)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", ErrProjectNotFound
+		}
+		return "", fmt.Errorf("get project timezone: %w", err)
+	}
+
+	return timezone, nil
+}
+
 func (r *Repo) Query(ctx context.Context, params *query.QueryParams) (*query.QueryResult[Project], error) {
 	// Build the base query
+	sortKey := r.sortKey(params.Sorting)
 	baseQuery := `
 		SELECT
 			id,
@@ -54,7 +78,10 @@ func (r *Repo) Query(ctx context.Context, params *query.QueryParams) (*query.Que
 			environment,
 			is_default,
 			created_at,
-			updated_at
+			updated_at,
+			COALESCE(created_by, ''),
+			COALESCE(updated_by, ''),
+			` + sortKey.Select() + `
 		FROM altalune_projects
 		WHERE 1=1
 	`
@@ -114,23 +141,24 @@ func (r *Repo) Query(ctx context.Context, params *query.QueryParams) (*query.Que
 	}
 
 	// First, get the total count before pagination
-	countQuery := "SELECT COUNT(*) FROM (" + baseQuery + ") as filtered"
-	var totalRows int32
-	err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&totalRows)
+	count, err := postgres.CountRows(ctx, r.db, params.Count, baseQuery, args)
 	if err != nil {
 		return nil, fmt.Errorf("count projects: %w", err)
 	}
 
+	// Keyset pages start past the last row already fetched
+	if condition, keysetArgs := sortKey.After(params.Keyset, argCounter); condition != "" {
+		baseQuery += " AND " + condition
+		args = append(args, keysetArgs...)
+		argCounter += len(keysetArgs)
+	}
+
 	// Add ORDER BY clause
-	orderClause := r.buildOrderClause(params.Sorting)
-	baseQuery += orderClause
+	baseQuery += sortKey.OrderBy()
 
 	// Add pagination
-	pageSize := params.Pagination.PageSize
-	page := params.Pagination.Page
-	offset := (page - 1) * pageSize
 	baseQuery += fmt.Sprintf(" LIMIT $%d OFFSET $%d", argCounter, argCounter+1)
-	args = append(args, pageSize, offset)
+	args = append(args, params.PageLimit(), params.FetchOffset())
 
 	// Execute the main query
 	rows, err := r.db.QueryContext(ctx, baseQuery, args...)
@@ -141,6 +169,7 @@ func (r *Repo) Query(ctx context.Context, params *query.QueryParams) (*query.Que
 
 	// Collect queryResults
 	queryResults := make([]*ProjectQueryResult, 0)
+	var sortValue string
 	for rows.Next() {
 		var prj ProjectQueryResult
 		var description sql.NullString
@@ -156,6 +185,9 @@ func (r *Repo) Query(ctx context.Context, params *query.QueryParams) (*query.Que
 			&prj.IsDefault,
 			&prj.CreatedAt,
 			&prj.UpdatedAt,
+			&prj.CreatedBy,
+			&prj.UpdatedBy,
+			&sortValue,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("scan project row: %w", err)
@@ -183,12 +215,6 @@ func (r *Repo) Query(ctx context.Context, params *query.QueryParams) (*query.Que
 		return nil, fmt.Errorf("iterate project rows: %w", err)
 	}
 
-	// Calculate total pages
-	var totalPages int32
-	if totalRows > 0 {
-		totalPages = (totalRows + pageSize - 1) / pageSize
-	}
-
 	// Get distinct values for filters
 	filters, err := r.getDistinctValues(ctx)
 	if err != nil {
@@ -202,17 +228,22 @@ func (r *Repo) Query(ctx context.Context, params *query.QueryParams) (*query.Que
 		results = append(results, v.ToProject())
 	}
 
-	return &query.QueryResult[Project]{
-		Data:       results,
-		TotalRows:  totalRows,
-		TotalPages: totalPages,
-		Filters:    filters,
-	}, nil
+	result := &query.QueryResult[Project]{
+		Data:    results,
+		Filters: filters,
+	}
+	query.FillPage(result, params, count)
+	if n := len(queryResults); n > 0 {
+		result.NextKeyset = &query.Keyset{SortKey: sortValue, AfterID: queryResults[n-1].ID}
+	}
+	return result, nil
 }
 
-func (r *Repo) buildOrderClause(sorting *query.SortingParams) string {
+// sortKey maps the sorting of a query to the order its pages are fetched
+// and resumed in.
+func (r *Repo) sortKey(sorting *query.SortingParams) postgres.SortKey {
 	if sorting == nil || sorting.Field == "" {
-		return " ORDER BY name ASC" // Default sorting
+		return postgres.SortKey{Expr: "name"} // Default sorting
 	}
 
 	// Map field to database column
@@ -234,13 +265,7 @@ func (r *Repo) buildOrderClause(sorting *query.SortingParams) string {
 		dbColumn = "name" // Fallback to default
 	}
 
-	// Determine sort direction
-	direction := "ASC"
-	if sorting.Order == query.SortOrderDesc {
-		direction = "DESC"
-	}
-
-	return fmt.Sprintf(" ORDER BY %s %s", dbColumn, direction)
+	return postgres.NewSortKey(dbColumn, sorting)
 }
 
 func (r *Repo) getDistinctValues(ctx context.Context) (map[string][]string, error) {
@@ -290,9 +315,6 @@ func (r *Repo) queryDistinctValues(ctx context.Context, query string) ([]string,
 
 // Create creates a new project in the database
 func (r *Repo) Create(ctx context.Context, input *CreateProjectInput) (*CreateProjectResult, error) {
-	// Generate public ID
-	publicID, _ := nanoid.GeneratePublicID()
-
 	// Map domain environment to database string
 	var environmentStr string
 	switch input.Environment {
@@ -313,9 +335,12 @@ func (r *Repo) Create(ctx context.Context, input *CreateProjectInput) (*CreatePr
 			timezone,
 			environment,
 			created_at,
-			updated_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7)
-		RETURNING id, public_id, name, description, timezone, environment, is_default, created_at, updated_at
+			updated_at,
+			created_by,
+			updated_by
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, NULLIF($8, ''), NULLIF($8, ''))
+		RETURNING id, public_id, name, description, timezone, environment, is_default, created_at, updated_at,
+		          COALESCE(created_by, ''), COALESCE(updated_by, '')
 	`
 
 	now := time.Now()
@@ -323,27 +348,32 @@ func (r *Repo) Create(ctx context.Context, input *CreateProjectInput) (*CreatePr
 	var description sql.NullString
 	var returnedEnvironment string
 
-	err := r.db.QueryRowContext(
-		ctx,
-		insertQuery,
-		publicID,
-		input.Name,
-		input.Description,
-		input.Timezone,
-		environmentStr,
-		now,
-		now,
-	).Scan(
-		&result.ID,
-		&result.PublicID,
-		&result.Name,
-		&description,
-		&result.Timezone,
-		&returnedEnvironment,
-		&result.IsDefault,
-		&result.CreatedAt,
-		&result.UpdatedAt,
-	)
+	_, err := postgres.InsertWithPublicID(func(publicID string) error {
+		return r.db.QueryRowContext(
+			ctx,
+			insertQuery,
+			publicID,
+			input.Name,
+			input.Description,
+			input.Timezone,
+			environmentStr,
+			now,
+			now,
+			auth.ActorID(ctx),
+		).Scan(
+			&result.ID,
+			&result.PublicID,
+			&result.Name,
+			&description,
+			&result.Timezone,
+			&returnedEnvironment,
+			&result.IsDefault,
+			&result.CreatedAt,
+			&result.UpdatedAt,
+			&result.CreatedBy,
+			&result.UpdatedBy,
+		)
+	})
 
 	if err != nil {
 		// Check for unique constraint violation
@@ -384,14 +414,21 @@ func (r *Repo) Create(ctx context.Context, input *CreateProjectInput) (*CreatePr
 		fmt.Printf("Warning: failed to register superadmin to project %d: %v\n", result.ID, err)
 	}
 
-	// Create default chatbot config for the new project
-	if err := r.createDefaultChatbotConfig(ctx, result.ID); err != nil {
+	// Create default chatbot config for the new project, in the scope of the
+	// project for the row-level security policies
+	err = postgres.WithProject(ctx, r.db, result.ID, func(ctx context.Context) error {
+		return r.createDefaultChatbotConfig(ctx, result.ID)
+	})
+	if err != nil {
 		// Log error but don't fail the project creation
 		fmt.Printf("Warning: failed to create default chatbot config for project %d: %v\n", result.ID, err)
 	}
 
 	// Create default chatbot node (start_conversation_en) for the new project
-	if err := r.createDefaultChatbotNode(ctx, result.ID); err != nil {
+	err = postgres.WithProject(ctx, r.db, result.ID, func(ctx context.Context) error {
+		return r.createDefaultChatbotNode(ctx, result.ID)
+	})
+	if err != nil {
 		// Log error but don't fail the project creation
 		fmt.Printf("Warning: failed to create default chatbot node for project %d: %v\n", result.ID, err)
 	}
@@ -410,7 +447,9 @@ func (r *Repo) GetByName(ctx context.Context, name string) (*Project, error) {
 			environment,
 			is_default,
 			created_at,
-			updated_at
+			updated_at,
+			COALESCE(created_by, ''),
+			COALESCE(updated_by, '')
 		FROM altalune_projects
 		WHERE LOWER(name) = LOWER($1)
 		LIMIT 1
@@ -429,6 +468,8 @@ func (r *Repo) GetByName(ctx context.Context, name string) (*Project, error) {
 		&prj.IsDefault,
 		&prj.CreatedAt,
 		&prj.UpdatedAt,
+		&prj.CreatedBy,
+		&prj.UpdatedBy,
 	)
 
 	if err != nil {
@@ -467,7 +508,9 @@ func (r *Repo) GetByID(ctx context.Context, publicID string) (*Project, error) {
 			environment,
 			is_default,
 			created_at,
-			updated_at
+			updated_at,
+			COALESCE(created_by, ''),
+			COALESCE(updated_by, '')
 		FROM altalune_projects
 		WHERE public_id = $1
 	`
@@ -485,6 +528,8 @@ func (r *Repo) GetByID(ctx context.Context, publicID string) (*Project, error) {
 		&prj.IsDefault,
 		&prj.CreatedAt,
 		&prj.UpdatedAt,
+		&prj.CreatedBy,
+		&prj.UpdatedBy,
 	)
 
 	if err != nil {
@@ -520,24 +565,40 @@ func (r *Repo) Update(ctx context.Context, input *UpdateProjectInput) (*UpdatePr
 		return nil, ErrProjectAlreadyExists
 	}
 
+	// The current row is returned flagged as a conflict instead when it
+	// changed since the expected version
 	sqlQuery := `
-		UPDATE altalune_projects
-		SET name = $1, description = $2, timezone = $3, updated_at = CURRENT_TIMESTAMP
-		WHERE public_id = $4
-		RETURNING id, public_id, name, description, timezone, environment, is_default,
-		          created_at, updated_at
+		WITH updated AS (
+			UPDATE altalune_projects
+			SET name = $1, description = $2, timezone = $3, updated_at = CURRENT_TIMESTAMP,
+			    updated_by = NULLIF($6, '')
+			WHERE public_id = $4 AND ($5::timestamptz IS NULL OR updated_at = $5)
+			RETURNING *
+		), result AS (
+			SELECT false AS conflict, updated.* FROM updated
+			UNION ALL
+			SELECT true, p.* FROM altalune_projects p
+			WHERE p.public_id = $4 AND NOT EXISTS (SELECT 1 FROM updated)
+		)
+		SELECT conflict, id, public_id, name, description, timezone, environment, is_default,
+		       created_at, updated_at, COALESCE(created_by, ''), COALESCE(updated_by, '')
+		FROM result
 	`
 
 	var result UpdateProjectResult
 	var description sql.NullString
 	var environment string
+	var conflict bool
 
 	err = r.db.QueryRowContext(ctx, sqlQuery,
 		input.Name,
 		input.Description,
 		input.Timezone,
 		input.PublicID,
+		input.ExpectedUpdatedAt,
+		auth.ActorID(ctx),
 	).Scan(
+		&conflict,
 		&result.ID,
 		&result.PublicID,
 		&result.Name,
@@ -547,6 +608,8 @@ func (r *Repo) Update(ctx context.Context, input *UpdateProjectInput) (*UpdatePr
 		&result.IsDefault,
 		&result.CreatedAt,
 		&result.UpdatedAt,
+		&result.CreatedBy,
+		&result.UpdatedBy,
 	)
 
 	if err != nil {
@@ -558,6 +621,9 @@ func (r *Repo) Update(ctx context.Context, input *UpdateProjectInput) (*UpdatePr
 		}
 		return nil, fmt.Errorf("failed to update project: %w", err)
 	}
+	if conflict {
+		return nil, ErrProjectVersionConflict
+	}
 
 	// Handle nullable description
 	if description.Valid {
@@ -594,6 +660,7 @@ func (r *Repo) Delete(ctx context.Context, publicID string) error {
 	if rowsAffected == 0 {
 		return ErrProjectNotFound
 	}
+	r.ids.forget(publicID)
 
 	// CASCADE DELETE handles:
 	// - altalune_project_api_keys partitions
@@ -618,6 +685,12 @@ var partitionedTables = []string{
 	// etc.
 }
 
+// PartitionedTables returns the tables partitioned by project, which have a
+// <table>_p<project ID> partition per project
+func PartitionedTables() []string {
+	return append([]string(nil), partitionedTables...)
+}
+
 // createPartitionsForProject creates the necessary database partitions for a new project
 func (r *Repo) createPartitionsForProject(ctx context.Context, projectID int64) error {
 	// Create partitions for all configured tables
@@ -678,18 +751,15 @@ func (r *Repo) registerSuperadminAsOwner(ctx context.Context, projectID int64) e
 		return nil
 	}
 
-	// Generate public_id for the new membership
-	publicID, err := nanoid.GeneratePublicID()
-	if err != nil {
-		return fmt.Errorf("generate public_id for project membership: %w", err)
-	}
-
 	// Create project membership with owner role
-	_, err = r.db.ExecContext(ctx, `
-		INSERT INTO altalune_project_members (
-			public_id, project_id, user_id, role, created_at, updated_at
-		) VALUES ($1, $2, $3, 'owner', NOW(), NOW())
-	`, publicID, projectID, superadminID)
+	_, err = postgres.InsertWithPublicID(func(publicID string) error {
+		_, err := r.db.ExecContext(ctx, `
+			INSERT INTO altalune_project_members (
+				public_id, project_id, user_id, role, created_at, updated_at
+			) VALUES ($1, $2, $3, 'owner', NOW(), NOW())
+		`, publicID, projectID, superadminID)
+		return err
+	})
 
 	if err != nil {
 		return fmt.Errorf("create project membership for superadmin: %w", err)
@@ -725,18 +795,15 @@ func (r *Repo) createDefaultChatbotConfig(ctx context.Context, projectID int64)
 		return nil
 	}
 
-	// Generate public_id for the new config
-	publicID, err := nanoid.GeneratePublicID()
-	if err != nil {
-		return fmt.Errorf("generate public_id for chatbot config: %w", err)
-	}
-
 	// Create default chatbot config
-	_, err = r.db.ExecContext(ctx, `
-		INSERT INTO altalune_chatbot_configs (
-			public_id, project_id, modules_config, created_at, updated_at
-		) VALUES ($1, $2, $3::jsonb, NOW(), NOW())
-	`, publicID, projectID, defaultChatbotModulesConfig)
+	_, err = postgres.InsertWithPublicID(func(publicID string) error {
+		_, err := r.db.ExecContext(ctx, `
+			INSERT INTO altalune_chatbot_configs (
+				public_id, project_id, modules_config, created_at, updated_at
+			) VALUES ($1, $2, $3::jsonb, NOW(), NOW())
+		`, publicID, projectID, defaultChatbotModulesConfig)
+		return err
+	})
 
 	if err != nil {
 		return fmt.Errorf("create default chatbot config: %w", err)
@@ -773,18 +840,15 @@ func (r *Repo) createDefaultChatbotNode(ctx context.Context, projectID int64) er
 		return nil
 	}
 
-	// Generate public_id for the new node
-	publicID, err := nanoid.GeneratePublicID()
-	if err != nil {
-		return fmt.Errorf("generate public_id for chatbot node: %w", err)
-	}
-
 	// Create default chatbot node (start_conversation_en-US)
-	_, err = r.db.ExecContext(ctx, `
-		INSERT INTO altalune_chatbot_nodes (
-			public_id, project_id, name, lang, tags, enabled, triggers, messages, created_at, updated_at
-		) VALUES ($1, $2, 'start_conversation', 'en-US', '{}', true, $3::jsonb, $4::jsonb, NOW(), NOW())
-	`, publicID, projectID, defaultChatbotNodeTriggers, defaultChatbotNodeMessages)
+	_, err = postgres.InsertWithPublicID(func(publicID string) error {
+		_, err := r.db.ExecContext(ctx, `
+			INSERT INTO altalune_chatbot_nodes (
+				public_id, project_id, name, lang, tags, enabled, triggers, messages, created_at, updated_at
+			) VALUES ($1, $2, 'start_conversation', 'en-US', '{}', true, $3::jsonb, $4::jsonb, NOW(), NOW())
+		`, publicID, projectID, defaultChatbotNodeTriggers, defaultChatbotNodeMessages)
+		return err
+	})
 
 	if err != nil {
 		return fmt.Errorf("create default chatbot node: %w", err)
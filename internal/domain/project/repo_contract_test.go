@@ -0,0 +1,300 @@
+package project_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hrz8/altalune/internal/auth"
+	"github.com/hrz8/altalune/internal/domain/project"
+	"github.com/hrz8/altalune/internal/shared/query"
+	"github.com/hrz8/altalune/internal/shared/secretdelivery"
+	"github.com/hrz8/altalune/internal/testdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) { testdb.Main(m) }
+
+func TestInMemRepo(t *testing.T) {
+	testRepoContract(t, project.NewInMemRepo())
+}
+
+func TestRepo(t *testing.T) {
+	testRepoContract(t, project.NewRepo(testdb.Open(t)))
+}
+
+// testRepoContract runs the behavior every project.Repositor must share
+// against repo. It only relies on rows it creates itself.
+func testRepoContract(t *testing.T, repo project.Repositor) {
+	ctx := context.Background()
+
+	create := func(t *testing.T, name, timezone string, environment project.EnvironmentStatus) *project.CreateProjectResult {
+		t.Helper()
+		created, err := repo.Create(ctx, &project.CreateProjectInput{
+			Name:        name,
+			Description: "contract test",
+			Timezone:    timezone,
+			Environment: environment,
+		})
+		require.NoError(t, err)
+		return created
+	}
+
+	t.Run("create and get", func(t *testing.T) {
+		name := "Get " + testdb.Token(t)
+		created := create(t, name, "UTC", project.EnvironmentStatusLive)
+		assert.Equal(t, project.EnvironmentStatusLive, created.Environment)
+		assert.False(t, created.IsDefault)
+
+		byID, err := repo.GetByID(ctx, created.PublicID)
+		require.NoError(t, err)
+		assert.Equal(t, created.ToProject(), byID)
+
+		byName, err := repo.GetByName(ctx, "get "+name[4:])
+		require.NoError(t, err, "names are matched case-insensitively")
+		assert.Equal(t, created.PublicID, byName.ID)
+
+		id, err := repo.GetIDByPublicID(ctx, created.PublicID)
+		require.NoError(t, err)
+		assert.Equal(t, created.ID, id)
+
+		timezone, err := repo.GetTimezone(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "UTC", timezone)
+
+		_, err = repo.GetByID(ctx, "unknown")
+		assert.ErrorIs(t, err, project.ErrProjectNotFound)
+		_, err = repo.GetIDByPublicID(ctx, "unknown")
+		assert.ErrorIs(t, err, project.ErrProjectNotFound)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		created := create(t, "Update "+testdb.Token(t), "UTC", project.EnvironmentStatusSandbox)
+		other := create(t, "Other "+testdb.Token(t), "UTC", project.EnvironmentStatusSandbox)
+
+		stale := created.UpdatedAt.Add(-time.Second)
+		_, err := repo.Update(ctx, &project.UpdateProjectInput{
+			PublicID:          created.PublicID,
+			Name:              created.Name,
+			Timezone:          "Asia/Jakarta",
+			ExpectedUpdatedAt: &stale,
+		})
+		assert.ErrorIs(t, err, project.ErrProjectVersionConflict)
+
+		updated, err := repo.Update(ctx, &project.UpdateProjectInput{
+			PublicID:          created.PublicID,
+			Name:              created.Name,
+			Description:       "updated",
+			Timezone:          "Asia/Jakarta",
+			ExpectedUpdatedAt: &created.UpdatedAt,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Asia/Jakarta", updated.Timezone)
+		assert.Equal(t, project.EnvironmentStatusSandbox, updated.Environment)
+
+		_, err = repo.Update(ctx, &project.UpdateProjectInput{PublicID: created.PublicID, Name: other.Name, Timezone: "UTC"})
+		assert.ErrorIs(t, err, project.ErrProjectAlreadyExists)
+
+		_, err = repo.Update(ctx, &project.UpdateProjectInput{PublicID: "unknown", Name: "Unknown " + testdb.Token(t), Timezone: "UTC"})
+		assert.ErrorIs(t, err, project.ErrProjectNotFound)
+		_, err = repo.Update(ctx, &project.UpdateProjectInput{
+			PublicID:          "unknown",
+			Name:              "Unknown " + testdb.Token(t),
+			Timezone:          "UTC",
+			ExpectedUpdatedAt: &updated.UpdatedAt,
+		})
+		assert.ErrorIs(t, err, project.ErrProjectNotFound, "a missing project is not a conflict")
+	})
+
+	t.Run("audit actors", func(t *testing.T) {
+		creator, updater := testdb.Token(t), testdb.Token(t)
+		as := func(actor string) context.Context {
+			return auth.WithAuthContext(ctx, &auth.AuthContext{UserID: actor, IsAuthenticated: true})
+		}
+
+		created, err := repo.Create(as(creator), &project.CreateProjectInput{
+			Name:        "Audited " + testdb.Token(t),
+			Timezone:    "UTC",
+			Environment: project.EnvironmentStatusSandbox,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, creator, created.CreatedBy)
+		assert.Equal(t, creator, created.UpdatedBy)
+
+		updated, err := repo.Update(as(updater), &project.UpdateProjectInput{PublicID: created.PublicID, Name: created.Name, Timezone: "UTC"})
+		require.NoError(t, err)
+		assert.Equal(t, creator, updated.CreatedBy, "the creator is kept")
+		assert.Equal(t, updater, updated.UpdatedBy)
+
+		anonymous := create(t, "Anonymous "+testdb.Token(t), "UTC", project.EnvironmentStatusSandbox)
+		assert.Empty(t, anonymous.CreatedBy, "rows written outside of a request have no actor")
+	})
+
+	t.Run("onboarding", func(t *testing.T) {
+		created := create(t, "Onboarding "+testdb.Token(t), "UTC", project.EnvironmentStatusSandbox)
+
+		inherited, err := repo.GetOnboarding(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, &project.Onboarding{}, inherited, "new projects inherit the server configuration")
+
+		manual := false
+		updated, err := repo.UpdateOnboarding(ctx, &project.UpdateOnboardingInput{
+			ProjectID:         created.ID,
+			DefaultMemberRole: "member",
+			AutoActivate:      &manual,
+		})
+		require.NoError(t, err)
+
+		found, err := repo.GetOnboarding(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, updated, found)
+		assert.Equal(t, "member", found.DefaultMemberRole)
+		require.NotNil(t, found.AutoActivate)
+		assert.False(t, *found.AutoActivate)
+
+		_, err = repo.UpdateOnboarding(ctx, &project.UpdateOnboardingInput{ProjectID: created.ID})
+		require.NoError(t, err)
+		found, err = repo.GetOnboarding(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, &project.Onboarding{}, found, "empty fields clear the overrides")
+
+		_, err = repo.GetOnboarding(ctx, -1)
+		assert.ErrorIs(t, err, project.ErrProjectNotFound)
+		_, err = repo.UpdateOnboarding(ctx, &project.UpdateOnboardingInput{ProjectID: -1})
+		assert.ErrorIs(t, err, project.ErrProjectNotFound)
+	})
+
+	t.Run("credential policy", func(t *testing.T) {
+		created := create(t, "Policy "+testdb.Token(t), "Asia/Jakarta", project.EnvironmentStatusSandbox)
+
+		policy, err := repo.GetCredentialPolicy(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, &project.CredentialPolicy{
+			Action:         project.CredentialPolicyActionFlag,
+			SecretDelivery: secretdelivery.ModeReveal,
+		}, policy, "new projects have no maximum and reveal secrets")
+
+		listed := func() *project.PolicyProject {
+			projects, err := repo.ListPolicyProjects(ctx)
+			require.NoError(t, err)
+			for _, p := range projects {
+				if p.ID == created.ID {
+					return p
+				}
+			}
+			return nil
+		}
+		assert.Nil(t, listed())
+
+		updated, err := repo.UpdateCredentialPolicy(ctx, &project.UpdateCredentialPolicyInput{
+			ProjectID:                   created.ID,
+			ApiKeyMaxLifetimeDays:       90,
+			ClientSecretMaxLifetimeDays: 365,
+			Action:                      project.CredentialPolicyActionDisable,
+			SecretDelivery:              secretdelivery.ModeLink,
+		})
+		require.NoError(t, err)
+
+		found, err := repo.GetCredentialPolicy(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, updated, found)
+		assert.Equal(t, 90, found.ApiKeyMaxLifetimeDays)
+		assert.Equal(t, 365, found.ClientSecretMaxLifetimeDays)
+		assert.Equal(t, project.CredentialPolicyActionDisable, found.Action)
+		assert.Equal(t, secretdelivery.ModeLink, found.SecretDelivery)
+		if p := listed(); assert.NotNil(t, p) {
+			assert.Equal(t, "Asia/Jakarta", p.Timezone)
+			assert.Equal(t, *found, p.Policy)
+		}
+
+		_, err = repo.UpdateCredentialPolicy(ctx, &project.UpdateCredentialPolicyInput{ProjectID: created.ID, Action: project.CredentialPolicyActionFlag, SecretDelivery: secretdelivery.ModeReveal})
+		require.NoError(t, err)
+		assert.Nil(t, listed(), "a zero maximum clears the policy")
+
+		defaultPolicy, err := repo.GetDefaultCredentialPolicy(ctx)
+		require.NoError(t, err)
+		assert.NotEmpty(t, defaultPolicy.SecretDelivery, "with or without a default project")
+
+		_, err = repo.GetCredentialPolicy(ctx, -1)
+		assert.ErrorIs(t, err, project.ErrProjectNotFound)
+		_, err = repo.UpdateCredentialPolicy(ctx, &project.UpdateCredentialPolicyInput{ProjectID: -1, Action: project.CredentialPolicyActionFlag})
+		assert.ErrorIs(t, err, project.ErrProjectNotFound)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		created := create(t, "Delete "+testdb.Token(t), "UTC", project.EnvironmentStatusSandbox)
+		_, err := repo.GetIDByPublicID(ctx, created.PublicID)
+		require.NoError(t, err)
+
+		require.NoError(t, repo.Delete(ctx, created.PublicID))
+		assert.ErrorIs(t, repo.Delete(ctx, created.PublicID), project.ErrProjectNotFound)
+
+		_, err = repo.GetByID(ctx, created.PublicID)
+		assert.ErrorIs(t, err, project.ErrProjectNotFound)
+		_, err = repo.GetIDByPublicID(ctx, created.PublicID)
+		assert.ErrorIs(t, err, project.ErrProjectNotFound, "deleted projects no longer resolve")
+	})
+
+	t.Run("query", func(t *testing.T) {
+		tok := testdb.Token(t)
+		for _, name := range []string{"C", "A", "B"} {
+			create(t, name+" "+tok, "Europe/Paris", project.EnvironmentStatusLive)
+		}
+		create(t, "D "+tok, "Europe/Paris", project.EnvironmentStatusSandbox)
+
+		params := &query.QueryParams{
+			Pagination: query.PaginationParams{Page: 1, PageSize: 2},
+			Keyword:    tok,
+			Filters:    map[string][]string{"environments": {"live"}},
+		}
+		first, err := repo.Query(ctx, params)
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), first.TotalRows)
+		assert.Equal(t, int32(2), first.TotalPages)
+		require.Len(t, first.Data, 2)
+		assert.Equal(t, "A "+tok, first.Data[0].Name, "projects are sorted by name by default")
+		assert.Equal(t, "B "+tok, first.Data[1].Name)
+		assert.Contains(t, first.Filters["timezones"], "Europe/Paris")
+
+		params.Pagination.Page = 2
+		params.Sorting = &query.SortingParams{Field: "name", Order: query.SortOrderDesc}
+		second, err := repo.Query(ctx, params)
+		require.NoError(t, err)
+		require.Len(t, second.Data, 1)
+		assert.Equal(t, "A "+tok, second.Data[0].Name)
+	})
+
+	t.Run("concurrent updates", func(t *testing.T) {
+		created := create(t, "Concurrent "+testdb.Token(t), "UTC", project.EnvironmentStatusSandbox)
+
+		var wg sync.WaitGroup
+		errs := make(chan error, 8)
+		for range 8 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := repo.Update(ctx, &project.UpdateProjectInput{
+					PublicID:          created.PublicID,
+					Name:              created.Name,
+					Timezone:          "UTC",
+					ExpectedUpdatedAt: &created.UpdatedAt,
+				})
+				errs <- err
+			}()
+		}
+		wg.Wait()
+		close(errs)
+
+		updated := 0
+		for err := range errs {
+			if err == nil {
+				updated++
+				continue
+			}
+			assert.ErrorIs(t, err, project.ErrProjectVersionConflict)
+		}
+		assert.Equal(t, 1, updated, "exactly one update of a given version wins")
+	})
+}
@@ -0,0 +1,119 @@
+package project
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/hrz8/altalune/internal/auth"
+	"github.com/hrz8/altalune/internal/shared/secretdelivery"
+)
+
+// GetCredentialPolicy returns the credential policy of a project
+func (r *Repo) GetCredentialPolicy(ctx context.Context, projectID int64) (*CredentialPolicy, error) {
+	query := `
+		SELECT COALESCE(api_key_max_lifetime_days, 0), api_key_policy_action, secret_delivery,
+		       COALESCE(client_secret_max_lifetime_days, 0)
+		FROM altalune_projects
+		WHERE id = $1
+	`
+
+	var policy CredentialPolicy
+	err := r.db.QueryRowContext(ctx, query, projectID).Scan(&policy.ApiKeyMaxLifetimeDays, &policy.Action, &policy.SecretDelivery, &policy.ClientSecretMaxLifetimeDays)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrProjectNotFound
+		}
+		return nil, fmt.Errorf("get project credential policy: %w", err)
+	}
+
+	return &policy, nil
+}
+
+// UpdateCredentialPolicy replaces the credential policy of a project
+func (r *Repo) UpdateCredentialPolicy(ctx context.Context, input *UpdateCredentialPolicyInput) (*CredentialPolicy, error) {
+	query := `
+		UPDATE altalune_projects
+		SET api_key_max_lifetime_days = NULLIF($2, 0), api_key_policy_action = $3, secret_delivery = $4,
+		    client_secret_max_lifetime_days = NULLIF($6, 0),
+		    updated_at = CURRENT_TIMESTAMP, updated_by = NULLIF($5, '')
+		WHERE id = $1
+	`
+
+	result, err := r.db.ExecContext(ctx, query, input.ProjectID, input.ApiKeyMaxLifetimeDays, string(input.Action), string(input.SecretDelivery), auth.ActorID(ctx), input.ClientSecretMaxLifetimeDays)
+	if err != nil {
+		return nil, fmt.Errorf("update project credential policy: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return nil, ErrProjectNotFound
+	}
+
+	return &CredentialPolicy{
+		ApiKeyMaxLifetimeDays:       input.ApiKeyMaxLifetimeDays,
+		Action:                      input.Action,
+		SecretDelivery:              input.SecretDelivery,
+		ClientSecretMaxLifetimeDays: input.ClientSecretMaxLifetimeDays,
+	}, nil
+}
+
+// GetDefaultCredentialPolicy returns the credential policy of the default
+// project, the oldest one if several are flagged default, and the policy of a
+// new project when none is
+func (r *Repo) GetDefaultCredentialPolicy(ctx context.Context) (*CredentialPolicy, error) {
+	query := `
+		SELECT COALESCE(api_key_max_lifetime_days, 0), api_key_policy_action, secret_delivery,
+		       COALESCE(client_secret_max_lifetime_days, 0)
+		FROM altalune_projects
+		WHERE is_default
+		ORDER BY id
+		LIMIT 1
+	`
+
+	var policy CredentialPolicy
+	err := r.db.QueryRowContext(ctx, query).Scan(&policy.ApiKeyMaxLifetimeDays, &policy.Action, &policy.SecretDelivery, &policy.ClientSecretMaxLifetimeDays)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return &CredentialPolicy{Action: CredentialPolicyActionFlag, SecretDelivery: secretdelivery.ModeReveal}, nil
+		}
+		return nil, fmt.Errorf("get default project credential policy: %w", err)
+	}
+
+	return &policy, nil
+}
+
+// ListPolicyProjects returns the projects with a maximum API key lifetime,
+// oldest first
+func (r *Repo) ListPolicyProjects(ctx context.Context) ([]*PolicyProject, error) {
+	query := `
+		SELECT id, name, timezone, api_key_max_lifetime_days, api_key_policy_action, secret_delivery
+		FROM altalune_projects
+		WHERE api_key_max_lifetime_days IS NOT NULL
+		ORDER BY id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("list policy projects: %w", err)
+	}
+	defer rows.Close()
+
+	projects := make([]*PolicyProject, 0)
+	for rows.Next() {
+		var p PolicyProject
+		if err := rows.Scan(&p.ID, &p.Name, &p.Timezone, &p.Policy.ApiKeyMaxLifetimeDays, &p.Policy.Action, &p.Policy.SecretDelivery); err != nil {
+			return nil, fmt.Errorf("scan policy project: %w", err)
+		}
+		projects = append(projects, &p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate policy projects: %w", err)
+	}
+
+	return projects, nil
+}
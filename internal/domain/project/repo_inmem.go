@@ -0,0 +1,374 @@
+package project
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hrz8/altalune/internal/auth"
+	"github.com/hrz8/altalune/internal/postgres"
+	"github.com/hrz8/altalune/internal/shared/nanoid"
+	"github.com/hrz8/altalune/internal/shared/query"
+	"github.com/hrz8/altalune/internal/shared/secretdelivery"
+)
+
+// InMemRepo is an in-memory Repositor for tests. It follows the semantics of
+// Repo, without the partitions, memberships and chatbot defaults Repo sets up
+// for new projects.
+type InMemRepo struct {
+	mu         sync.RWMutex
+	projects   []*ProjectQueryResult      // In insertion order
+	onboarding map[int64]Onboarding       // By project ID, absent when inherited
+	policies   map[int64]CredentialPolicy // By project ID, absent without a maximum
+	lastID     int64
+}
+
+var _ Repositor = (*InMemRepo)(nil)
+
+// NewInMemRepo creates an empty in-memory project repository
+func NewInMemRepo() *InMemRepo {
+	return &InMemRepo{onboarding: make(map[int64]Onboarding), policies: make(map[int64]CredentialPolicy)}
+}
+
+func (r *InMemRepo) find(match func(p *ProjectQueryResult) bool) *ProjectQueryResult {
+	for _, p := range r.projects {
+		if match(p) {
+			return p
+		}
+	}
+	return nil
+}
+
+func byPublicID(publicID string) func(p *ProjectQueryResult) bool {
+	return func(p *ProjectQueryResult) bool { return p.PublicID == publicID }
+}
+
+func (r *InMemRepo) GetIDByPublicID(ctx context.Context, publicID string) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	p := r.find(byPublicID(publicID))
+	if p == nil {
+		return 0, ErrProjectNotFound
+	}
+	return p.ID, nil
+}
+
+func (r *InMemRepo) GetTimezone(ctx context.Context, projectID int64) (string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	p := r.find(func(p *ProjectQueryResult) bool { return p.ID == projectID })
+	if p == nil {
+		return "", ErrProjectNotFound
+	}
+	return p.Timezone, nil
+}
+
+func (r *InMemRepo) Query(ctx context.Context, params *query.QueryParams) (*query.QueryResult[Project], error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	rows := make([]*ProjectQueryResult, 0)
+	timezones := make([]string, 0)
+	for _, p := range r.projects {
+		if !slices.Contains(timezones, p.Timezone) {
+			timezones = append(timezones, p.Timezone)
+		}
+		if !query.MatchKeyword(params.Keyword, p.Name, p.Description) {
+			continue
+		}
+		if !matchProjectFilters(p, params.Filters) {
+			continue
+		}
+		rows = append(rows, p)
+	}
+	slices.Sort(timezones)
+
+	field, order := "name", query.SortOrderAsc
+	if params.Sorting != nil && params.Sorting.Field != "" {
+		field = params.Sorting.Field
+		if params.Sorting.Order == query.SortOrderDesc {
+			order = query.SortOrderDesc
+		}
+	}
+	query.SortRows(rows, order, query.ThenByID(compareProjects(field), projectQueryResultID))
+
+	page, totalRows, totalPages := query.PaginateAfter(rows, params.Pagination, params.Keyset, projectQueryResultID)
+	results := make([]*Project, 0, len(page))
+	for _, p := range page {
+		results = append(results, p.ToProject())
+	}
+
+	return &query.QueryResult[Project]{
+		Data:       results,
+		TotalRows:  totalRows,
+		TotalPages: totalPages,
+		HasMore:    params.Pagination.Page < totalPages,
+		NextKeyset: query.NextKeyset(page, projectQueryResultID),
+		Filters: map[string][]string{
+			"environments": {"live", "sandbox"},
+			"timezones":    timezones,
+		},
+	}, nil
+}
+
+func matchProjectFilters(p *ProjectQueryResult, filters map[string][]string) bool {
+	for field, values := range filters {
+		if len(values) == 0 {
+			continue
+		}
+		var value string
+		switch field {
+		case "environment", "environments":
+			value = string(p.Environment)
+		case "timezone", "timezones":
+			value = p.Timezone
+		case "name":
+			value = p.Name
+		default:
+			continue
+		}
+		if !query.MatchAny(value, values) {
+			return false
+		}
+	}
+	return true
+}
+
+func projectQueryResultID(p *ProjectQueryResult) int64 { return p.ID }
+
+func compareProjects(field string) func(a, b *ProjectQueryResult) int {
+	switch field {
+	case "environment":
+		return func(a, b *ProjectQueryResult) int { return cmp.Compare(a.Environment, b.Environment) }
+	case "timezone":
+		return func(a, b *ProjectQueryResult) int { return cmp.Compare(a.Timezone, b.Timezone) }
+	case "createdAt", "created_at":
+		return func(a, b *ProjectQueryResult) int { return a.CreatedAt.Compare(b.CreatedAt) }
+	case "updatedAt", "updated_at":
+		return func(a, b *ProjectQueryResult) int { return a.UpdatedAt.Compare(b.UpdatedAt) }
+	case "id":
+		return func(a, b *ProjectQueryResult) int { return cmp.Compare(a.ID, b.ID) }
+	default:
+		return func(a, b *ProjectQueryResult) int { return cmp.Compare(a.Name, b.Name) }
+	}
+}
+
+func (r *InMemRepo) Create(ctx context.Context, input *CreateProjectInput) (*CreateProjectResult, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	publicID, err := nanoid.GeneratePublicID()
+	if err != nil {
+		return nil, err
+	}
+
+	environment := input.Environment
+	if environment != EnvironmentStatusLive {
+		environment = EnvironmentStatusSandbox
+	}
+
+	r.lastID++
+	now := postgres.Now()
+	actorID := auth.ActorID(ctx)
+	p := &ProjectQueryResult{
+		ID:          r.lastID,
+		PublicID:    publicID,
+		Name:        input.Name,
+		Description: input.Description,
+		Timezone:    input.Timezone,
+		Environment: environment,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		CreatedBy:   actorID,
+		UpdatedBy:   actorID,
+	}
+	r.projects = append(r.projects, p)
+
+	return &CreateProjectResult{
+		ID:          p.ID,
+		PublicID:    p.PublicID,
+		Name:        p.Name,
+		Description: p.Description,
+		Timezone:    p.Timezone,
+		Environment: p.Environment,
+		IsDefault:   p.IsDefault,
+		CreatedAt:   p.CreatedAt,
+		UpdatedAt:   p.UpdatedAt,
+		CreatedBy:   p.CreatedBy,
+		UpdatedBy:   p.UpdatedBy,
+	}, nil
+}
+
+func (r *InMemRepo) GetByName(ctx context.Context, name string) (*Project, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	p := r.find(func(p *ProjectQueryResult) bool { return strings.EqualFold(p.Name, name) })
+	if p == nil {
+		return nil, ErrProjectNotFound
+	}
+	return p.ToProject(), nil
+}
+
+func (r *InMemRepo) GetByID(ctx context.Context, publicID string) (*Project, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	p := r.find(byPublicID(publicID))
+	if p == nil {
+		return nil, ErrProjectNotFound
+	}
+	return p.ToProject(), nil
+}
+
+func (r *InMemRepo) Update(ctx context.Context, input *UpdateProjectInput) (*UpdateProjectResult, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing := r.find(func(p *ProjectQueryResult) bool { return strings.EqualFold(p.Name, input.Name) })
+	if existing != nil && existing.PublicID != input.PublicID {
+		return nil, ErrProjectAlreadyExists
+	}
+
+	p := r.find(byPublicID(input.PublicID))
+	if p == nil {
+		return nil, ErrProjectNotFound
+	}
+	if input.ExpectedUpdatedAt != nil && !p.UpdatedAt.Equal(*input.ExpectedUpdatedAt) {
+		return nil, ErrProjectVersionConflict
+	}
+
+	p.Name = input.Name
+	p.Description = input.Description
+	p.Timezone = input.Timezone
+	p.UpdatedAt = postgres.NextTimestamp(p.UpdatedAt)
+	p.UpdatedBy = auth.ActorID(ctx)
+
+	return &UpdateProjectResult{
+		ID:          p.ID,
+		PublicID:    p.PublicID,
+		Name:        p.Name,
+		Description: p.Description,
+		Timezone:    p.Timezone,
+		Environment: p.Environment,
+		IsDefault:   p.IsDefault,
+		CreatedAt:   p.CreatedAt,
+		UpdatedAt:   p.UpdatedAt,
+		CreatedBy:   p.CreatedBy,
+		UpdatedBy:   p.UpdatedBy,
+	}, nil
+}
+
+func (r *InMemRepo) Delete(ctx context.Context, publicID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	i := slices.IndexFunc(r.projects, byPublicID(publicID))
+	if i < 0 {
+		return ErrProjectNotFound
+	}
+	delete(r.onboarding, r.projects[i].ID)
+	delete(r.policies, r.projects[i].ID)
+	r.projects = slices.Delete(r.projects, i, i+1)
+	return nil
+}
+
+func (r *InMemRepo) GetOnboarding(ctx context.Context, projectID int64) (*Onboarding, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if r.find(func(p *ProjectQueryResult) bool { return p.ID == projectID }) == nil {
+		return nil, ErrProjectNotFound
+	}
+	onboarding := r.onboarding[projectID]
+	return &onboarding, nil
+}
+
+func (r *InMemRepo) UpdateOnboarding(ctx context.Context, input *UpdateOnboardingInput) (*Onboarding, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	p := r.find(func(p *ProjectQueryResult) bool { return p.ID == input.ProjectID })
+	if p == nil {
+		return nil, ErrProjectNotFound
+	}
+
+	onboarding := Onboarding{DefaultMemberRole: input.DefaultMemberRole}
+	if input.AutoActivate != nil {
+		autoActivate := *input.AutoActivate
+		onboarding.AutoActivate = &autoActivate
+	}
+	r.onboarding[p.ID] = onboarding
+	p.UpdatedAt = postgres.NextTimestamp(p.UpdatedAt)
+	p.UpdatedBy = auth.ActorID(ctx)
+
+	return &onboarding, nil
+}
+
+func (r *InMemRepo) GetCredentialPolicy(ctx context.Context, projectID int64) (*CredentialPolicy, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if r.find(func(p *ProjectQueryResult) bool { return p.ID == projectID }) == nil {
+		return nil, ErrProjectNotFound
+	}
+	policy, ok := r.policies[projectID]
+	if !ok {
+		policy = CredentialPolicy{Action: CredentialPolicyActionFlag, SecretDelivery: secretdelivery.ModeReveal}
+	}
+	return &policy, nil
+}
+
+func (r *InMemRepo) GetDefaultCredentialPolicy(ctx context.Context) (*CredentialPolicy, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	policy := CredentialPolicy{Action: CredentialPolicyActionFlag, SecretDelivery: secretdelivery.ModeReveal}
+	if p := r.find(func(p *ProjectQueryResult) bool { return p.IsDefault }); p != nil {
+		if found, ok := r.policies[p.ID]; ok {
+			policy = found
+		}
+	}
+	return &policy, nil
+}
+
+func (r *InMemRepo) UpdateCredentialPolicy(ctx context.Context, input *UpdateCredentialPolicyInput) (*CredentialPolicy, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	p := r.find(func(p *ProjectQueryResult) bool { return p.ID == input.ProjectID })
+	if p == nil {
+		return nil, ErrProjectNotFound
+	}
+
+	policy := CredentialPolicy{
+		ApiKeyMaxLifetimeDays:       input.ApiKeyMaxLifetimeDays,
+		Action:                      input.Action,
+		SecretDelivery:              input.SecretDelivery,
+		ClientSecretMaxLifetimeDays: input.ClientSecretMaxLifetimeDays,
+	}
+	r.policies[p.ID] = policy
+	p.UpdatedAt = postgres.NextTimestamp(p.UpdatedAt)
+	p.UpdatedBy = auth.ActorID(ctx)
+
+	return &policy, nil
+}
+
+func (r *InMemRepo) ListPolicyProjects(ctx context.Context) ([]*PolicyProject, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	projects := make([]*PolicyProject, 0)
+	for _, p := range r.projects {
+		policy, ok := r.policies[p.ID]
+		if !ok || policy.ApiKeyMaxLifetimeDays == 0 {
+			continue
+		}
+		projects = append(projects, &PolicyProject{ID: p.ID, Name: p.Name, Timezone: p.Timezone, Policy: policy})
+	}
+	return projects, nil
+}
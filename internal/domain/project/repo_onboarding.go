@@ -0,0 +1,63 @@
+package project
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/hrz8/altalune/internal/auth"
+)
+
+// GetOnboarding returns the onboarding policy of a project
+func (r *Repo) GetOnboarding(ctx context.Context, projectID int64) (*Onboarding, error) {
+	query := `
+		SELECT COALESCE(default_member_role, ''), auto_activate
+		FROM altalune_projects
+		WHERE id = $1
+	`
+
+	var onboarding Onboarding
+	var autoActivate sql.NullBool
+	err := r.db.QueryRowContext(ctx, query, projectID).Scan(&onboarding.DefaultMemberRole, &autoActivate)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrProjectNotFound
+		}
+		return nil, fmt.Errorf("get project onboarding: %w", err)
+	}
+
+	if autoActivate.Valid {
+		onboarding.AutoActivate = &autoActivate.Bool
+	}
+
+	return &onboarding, nil
+}
+
+// UpdateOnboarding replaces the onboarding policy of a project
+func (r *Repo) UpdateOnboarding(ctx context.Context, input *UpdateOnboardingInput) (*Onboarding, error) {
+	query := `
+		UPDATE altalune_projects
+		SET default_member_role = NULLIF($2, ''), auto_activate = $3,
+		    updated_at = CURRENT_TIMESTAMP, updated_by = NULLIF($4, '')
+		WHERE id = $1
+	`
+
+	result, err := r.db.ExecContext(ctx, query, input.ProjectID, input.DefaultMemberRole, input.AutoActivate, auth.ActorID(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("update project onboarding: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return nil, ErrProjectNotFound
+	}
+
+	return &Onboarding{
+		DefaultMemberRole: input.DefaultMemberRole,
+		AutoActivate:      input.AutoActivate,
+	}, nil
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/hrz8/altalune"
 	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
 	"github.com/hrz8/altalune/internal/shared/query"
+	"github.com/hrz8/altalune/internal/shared/secretdelivery"
+	"github.com/hrz8/altalune/internal/shared/tz"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -45,6 +47,10 @@ func (s *Service) QueryProjects(ctx context.Context, req *altalunev1.QueryProjec
 	// Convert proto request to domain query params
 	queryParams := query.DefaultQueryParams(req.Query)
 
+	if err := queryParams.ApplyCursor(req.Query.GetCursor()); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
 	// Query projects from repository
 	result, err := s.projectRepo.Query(ctx, queryParams)
 	if err != nil {
@@ -60,9 +66,10 @@ func (s *Service) QueryProjects(ctx context.Context, req *altalunev1.QueryProjec
 		return &altalunev1.QueryProjectsResponse{
 			Data: []*altalunev1.Project{},
 			Meta: &altalunev1.QueryMetaResponse{
-				RowCount:  0,
-				PageCount: 0,
-				Filters:   make(map[string]*altalunev1.FilterValues),
+				RowCount:   0,
+				PageCount:  0,
+				ExactTotal: true,
+				Filters:    make(map[string]*altalunev1.FilterValues),
 			},
 		}, nil
 	}
@@ -70,9 +77,13 @@ func (s *Service) QueryProjects(ctx context.Context, req *altalunev1.QueryProjec
 	return &altalunev1.QueryProjectsResponse{
 		Data: mapProjectsToProto(result.Data),
 		Meta: &altalunev1.QueryMetaResponse{
-			RowCount:  result.TotalRows,
-			PageCount: result.TotalPages,
-			Filters:   mapFiltersToProto(result.Filters),
+			RowCount:    result.TotalRows,
+			PageCount:   result.TotalPages,
+			Filters:     mapFiltersToProto(result.Filters),
+			CountMode:   query.CountModeToProto(result.Count),
+			HasNextPage: result.HasMore,
+			NextCursor:  query.NextCursor(queryParams, result),
+			ExactTotal:  result.ExactTotal(),
 		},
 	}, nil
 }
@@ -82,6 +93,9 @@ func (s *Service) CreateProject(ctx context.Context, req *altalunev1.CreateProje
 	if err := s.validator.Validate(req); err != nil {
 		return nil, altalune.NewInvalidPayloadError(err.Error())
 	}
+	if err := tz.Validate(req.Timezone); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
 
 	// Check if project with same name already exists
 	existingProject, err := s.projectRepo.GetByName(ctx, req.Name)
@@ -152,6 +166,9 @@ func (s *Service) UpdateProject(ctx context.Context, req *altalunev1.UpdateProje
 	if err := s.validator.Validate(req); err != nil {
 		return nil, altalune.NewInvalidPayloadError(err.Error())
 	}
+	if err := tz.Validate(req.Timezone); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
 
 	// Get internal ID
 	internalID, err := s.projectRepo.GetIDByPublicID(ctx, req.Id)
@@ -169,12 +186,19 @@ func (s *Service) UpdateProject(ctx context.Context, req *altalunev1.UpdateProje
 		Description: req.Description,
 		Timezone:    req.Timezone,
 	}
+	if req.ExpectedUpdatedAt != nil {
+		expectedUpdatedAt := req.ExpectedUpdatedAt.AsTime()
+		input.ExpectedUpdatedAt = &expectedUpdatedAt
+	}
 
 	result, err := s.projectRepo.Update(ctx, input)
 	if err != nil {
 		if err == ErrProjectNotFound {
 			return nil, altalune.NewProjectNotFound(req.Id)
 		}
+		if err == ErrProjectVersionConflict {
+			return nil, altalune.NewVersionConflictError("project", req.Id)
+		}
 		if err == ErrProjectAlreadyExists {
 			return nil, altalune.NewAlreadyExistsError(req.Name)
 		}
@@ -226,3 +250,137 @@ func (s *Service) DeleteProject(ctx context.Context, req *altalunev1.DeleteProje
 		Message: "Project deleted successfully",
 	}, nil
 }
+
+func (s *Service) GetProjectOnboarding(ctx context.Context, req *altalunev1.GetProjectOnboardingRequest) (*altalunev1.GetProjectOnboardingResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	projectID, err := s.projectRepo.GetIDByPublicID(ctx, req.ProjectId)
+	if err != nil {
+		if err == ErrProjectNotFound {
+			return nil, altalune.NewProjectNotFound(req.ProjectId)
+		}
+		return nil, altalune.NewUnexpectedError("failed to resolve project ID", err)
+	}
+
+	onboarding, err := s.projectRepo.GetOnboarding(ctx, projectID)
+	if err != nil {
+		if err == ErrProjectNotFound {
+			return nil, altalune.NewProjectNotFound(req.ProjectId)
+		}
+		s.log.Error("failed to get project onboarding", "error", err, "project_id", req.ProjectId)
+		return nil, altalune.NewUnexpectedError("failed to get project onboarding", err)
+	}
+
+	return &altalunev1.GetProjectOnboardingResponse{
+		Onboarding: onboarding.ToProjectOnboardingProto(),
+	}, nil
+}
+
+func (s *Service) UpdateProjectOnboarding(ctx context.Context, req *altalunev1.UpdateProjectOnboardingRequest) (*altalunev1.UpdateProjectOnboardingResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	projectID, err := s.projectRepo.GetIDByPublicID(ctx, req.ProjectId)
+	if err != nil {
+		if err == ErrProjectNotFound {
+			return nil, altalune.NewProjectNotFound(req.ProjectId)
+		}
+		return nil, altalune.NewUnexpectedError("failed to resolve project ID", err)
+	}
+
+	onboarding, err := s.projectRepo.UpdateOnboarding(ctx, &UpdateOnboardingInput{
+		ProjectID:         projectID,
+		DefaultMemberRole: req.DefaultMemberRole,
+		AutoActivate:      req.AutoActivate,
+	})
+	if err != nil {
+		if err == ErrProjectNotFound {
+			return nil, altalune.NewProjectNotFound(req.ProjectId)
+		}
+		s.log.Error("failed to update project onboarding", "error", err, "project_id", req.ProjectId)
+		return nil, altalune.NewUnexpectedError("failed to update project onboarding", err)
+	}
+
+	s.log.Info("project onboarding updated",
+		"project_id", req.ProjectId,
+		"default_member_role", req.DefaultMemberRole,
+		"auto_activate", req.AutoActivate,
+	)
+
+	return &altalunev1.UpdateProjectOnboardingResponse{
+		Onboarding: onboarding.ToProjectOnboardingProto(),
+		Message:    "Project onboarding updated successfully",
+	}, nil
+}
+
+func (s *Service) GetProjectCredentialPolicy(ctx context.Context, req *altalunev1.GetProjectCredentialPolicyRequest) (*altalunev1.GetProjectCredentialPolicyResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	projectID, err := s.projectRepo.GetIDByPublicID(ctx, req.ProjectId)
+	if err != nil {
+		if err == ErrProjectNotFound {
+			return nil, altalune.NewProjectNotFound(req.ProjectId)
+		}
+		return nil, altalune.NewUnexpectedError("failed to resolve project ID", err)
+	}
+
+	policy, err := s.projectRepo.GetCredentialPolicy(ctx, projectID)
+	if err != nil {
+		if err == ErrProjectNotFound {
+			return nil, altalune.NewProjectNotFound(req.ProjectId)
+		}
+		s.log.Error("failed to get project credential policy", "error", err, "project_id", req.ProjectId)
+		return nil, altalune.NewUnexpectedError("failed to get project credential policy", err)
+	}
+
+	return &altalunev1.GetProjectCredentialPolicyResponse{
+		Policy: policy.ToProjectCredentialPolicyProto(),
+	}, nil
+}
+
+func (s *Service) UpdateProjectCredentialPolicy(ctx context.Context, req *altalunev1.UpdateProjectCredentialPolicyRequest) (*altalunev1.UpdateProjectCredentialPolicyResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	projectID, err := s.projectRepo.GetIDByPublicID(ctx, req.ProjectId)
+	if err != nil {
+		if err == ErrProjectNotFound {
+			return nil, altalune.NewProjectNotFound(req.ProjectId)
+		}
+		return nil, altalune.NewUnexpectedError("failed to resolve project ID", err)
+	}
+
+	policy, err := s.projectRepo.UpdateCredentialPolicy(ctx, &UpdateCredentialPolicyInput{
+		ProjectID:                   projectID,
+		ApiKeyMaxLifetimeDays:       int(req.ApiKeyMaxLifetimeDays),
+		Action:                      CredentialPolicyActionFromProto(req.Action),
+		SecretDelivery:              secretdelivery.ModeFromProto(req.SecretDelivery),
+		ClientSecretMaxLifetimeDays: int(req.ClientSecretMaxLifetimeDays),
+	})
+	if err != nil {
+		if err == ErrProjectNotFound {
+			return nil, altalune.NewProjectNotFound(req.ProjectId)
+		}
+		s.log.Error("failed to update project credential policy", "error", err, "project_id", req.ProjectId)
+		return nil, altalune.NewUnexpectedError("failed to update project credential policy", err)
+	}
+
+	s.log.Info("project credential policy updated",
+		"project_id", req.ProjectId,
+		"api_key_max_lifetime_days", policy.ApiKeyMaxLifetimeDays,
+		"action", policy.Action,
+		"secret_delivery", policy.SecretDelivery,
+		"client_secret_max_lifetime_days", policy.ClientSecretMaxLifetimeDays,
+	)
+
+	return &altalunev1.UpdateProjectCredentialPolicyResponse{
+		Policy:  policy.ToProjectCredentialPolicyProto(),
+		Message: "Project credential policy updated successfully",
+	}, nil
+}
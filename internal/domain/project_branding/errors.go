@@ -0,0 +1,7 @@
+package project_branding
+
+import "errors"
+
+var (
+	ErrLogoNotFound = errors.New("project logo not found")
+)
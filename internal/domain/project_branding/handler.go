@@ -0,0 +1,83 @@
+package project_branding
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/hrz8/altalune"
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	"github.com/hrz8/altalune/internal/auth"
+)
+
+type Handler struct {
+	svc  altalunev1.ProjectBrandingServiceServer
+	auth *auth.Authorizer
+}
+
+func NewHandler(svc altalunev1.ProjectBrandingServiceServer, authorizer *auth.Authorizer) *Handler {
+	return &Handler{svc: svc, auth: authorizer}
+}
+
+func (h *Handler) GetProjectBranding(
+	ctx context.Context,
+	req *connect.Request[altalunev1.GetProjectBrandingRequest],
+) (*connect.Response[altalunev1.GetProjectBrandingResponse], error) {
+	// Authorization: requires project:read permission and project membership
+	if err := h.auth.CheckProjectAccess(ctx, "project:read", req.Msg.ProjectId); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.GetProjectBranding(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (h *Handler) UpdateProjectBranding(
+	ctx context.Context,
+	req *connect.Request[altalunev1.UpdateProjectBrandingRequest],
+) (*connect.Response[altalunev1.UpdateProjectBrandingResponse], error) {
+	// Authorization: requires project:write permission and project membership
+	if err := h.auth.CheckProjectAccess(ctx, "project:write", req.Msg.ProjectId); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.UpdateProjectBranding(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (h *Handler) UploadProjectLogo(
+	ctx context.Context,
+	req *connect.Request[altalunev1.UploadProjectLogoRequest],
+) (*connect.Response[altalunev1.UploadProjectLogoResponse], error) {
+	// Authorization: requires project:write permission and project membership
+	if err := h.auth.CheckProjectAccess(ctx, "project:write", req.Msg.ProjectId); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.UploadProjectLogo(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (h *Handler) DeleteProjectLogo(
+	ctx context.Context,
+	req *connect.Request[altalunev1.DeleteProjectLogoRequest],
+) (*connect.Response[altalunev1.DeleteProjectLogoResponse], error) {
+	// Authorization: requires project:write permission and project membership
+	if err := h.auth.CheckProjectAccess(ctx, "project:write", req.Msg.ProjectId); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.DeleteProjectLogo(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
@@ -0,0 +1,13 @@
+package project_branding
+
+import (
+	"context"
+)
+
+type Repositor interface {
+	Get(ctx context.Context, projectID int64) (*ProjectBranding, error)
+	Update(ctx context.Context, input *UpdateProjectBrandingInput) (*ProjectBranding, error)
+	SetLogo(ctx context.Context, input *SetProjectLogoInput) (*ProjectBranding, error)
+	DeleteLogo(ctx context.Context, projectID int64) (*ProjectBranding, error)
+	GetLogo(ctx context.Context, projectPublicID string) (*Logo, error) // For auth server logo serving
+}
@@ -0,0 +1,29 @@
+package project_branding
+
+import (
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+)
+
+// mapFooterLinksToProto converts domain footer links to proto FooterLinks
+func mapFooterLinksToProto(links []FooterLink) []*altalunev1.FooterLink {
+	result := make([]*altalunev1.FooterLink, 0, len(links))
+	for _, l := range links {
+		result = append(result, &altalunev1.FooterLink{
+			Label: l.Label,
+			Url:   l.URL,
+		})
+	}
+	return result
+}
+
+// mapFooterLinksFromProto converts proto FooterLinks to domain footer links
+func mapFooterLinksFromProto(links []*altalunev1.FooterLink) []FooterLink {
+	result := make([]FooterLink, 0, len(links))
+	for _, l := range links {
+		result = append(result, FooterLink{
+			Label: l.Label,
+			URL:   l.Url,
+		})
+	}
+	return result
+}
@@ -0,0 +1,66 @@
+package project_branding
+
+import (
+	"fmt"
+	"time"
+
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// FooterLink is a custom link shown in the footer of the auth pages.
+type FooterLink struct {
+	Label string `json:"label"`
+	URL   string `json:"url"`
+}
+
+// ProjectBranding is the branding of a project's auth pages. A project
+// without a branding row gets the zero value (auth server defaults).
+type ProjectBranding struct {
+	PrimaryColor  string
+	LogoUpdatedAt *time.Time // nil when no logo is uploaded
+	FooterLinks   []FooterLink
+	DefaultLocale string // Empty falls back to the auth server default
+	UpdatedAt     time.Time
+}
+
+func (m *ProjectBranding) ToProjectBrandingProto(projectPublicID string) *altalunev1.ProjectBranding {
+	branding := &altalunev1.ProjectBranding{
+		PrimaryColor:  m.PrimaryColor,
+		FooterLinks:   mapFooterLinksToProto(m.FooterLinks),
+		DefaultLocale: m.DefaultLocale,
+	}
+	if m.LogoUpdatedAt != nil {
+		branding.LogoUrl = LogoPath(projectPublicID, *m.LogoUpdatedAt)
+	}
+	if !m.UpdatedAt.IsZero() {
+		branding.UpdatedAt = timestamppb.New(m.UpdatedAt)
+	}
+	return branding
+}
+
+// Logo is an uploaded project logo as served by the auth server.
+type Logo struct {
+	Content     []byte
+	ContentType string
+	UpdatedAt   time.Time
+}
+
+// LogoPath returns the auth server path of a project logo. The version query
+// changes on every upload so the logo can be cached aggressively.
+func LogoPath(projectPublicID string, updatedAt time.Time) string {
+	return fmt.Sprintf("/branding/%s/logo?v=%d", projectPublicID, updatedAt.Unix())
+}
+
+type UpdateProjectBrandingInput struct {
+	ProjectID     int64
+	PrimaryColor  string
+	FooterLinks   []FooterLink
+	DefaultLocale string
+}
+
+type SetProjectLogoInput struct {
+	ProjectID   int64
+	Content     []byte
+	ContentType string
+}
@@ -0,0 +1,172 @@
+package project_branding
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hrz8/altalune/internal/postgres"
+)
+
+type Repo struct {
+	db postgres.DB
+}
+
+func NewRepo(db postgres.DB) *Repo {
+	return &Repo{
+		db: db,
+	}
+}
+
+// Get returns the branding of a project, or the zero value when the project
+// has never been branded.
+func (r *Repo) Get(ctx context.Context, projectID int64) (*ProjectBranding, error) {
+	query := `
+		SELECT
+			COALESCE(primary_color, ''),
+			logo_updated_at,
+			footer_links,
+			COALESCE(default_locale, ''),
+			updated_at
+		FROM altalune_project_branding
+		WHERE project_id = $1
+	`
+
+	var b ProjectBranding
+	var logoUpdatedAt sql.NullTime
+	var footerLinks []byte
+	err := r.db.QueryRowContext(ctx, query, projectID).Scan(
+		&b.PrimaryColor,
+		&logoUpdatedAt,
+		&footerLinks,
+		&b.DefaultLocale,
+		&b.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return &ProjectBranding{FooterLinks: []FooterLink{}}, nil
+		}
+		return nil, fmt.Errorf("get project branding: %w", err)
+	}
+
+	if logoUpdatedAt.Valid {
+		b.LogoUpdatedAt = &logoUpdatedAt.Time
+	}
+	if b.FooterLinks, err = UnmarshalFooterLinks(footerLinks); err != nil {
+		return nil, err
+	}
+
+	return &b, nil
+}
+
+func (r *Repo) Update(ctx context.Context, input *UpdateProjectBrandingInput) (*ProjectBranding, error) {
+	footerLinks, err := json.Marshal(input.FooterLinks)
+	if err != nil {
+		return nil, fmt.Errorf("marshal footer links: %w", err)
+	}
+
+	upsertQuery := `
+		INSERT INTO altalune_project_branding (
+			project_id,
+			primary_color,
+			footer_links,
+			default_locale,
+			created_at,
+			updated_at
+		) VALUES ($1, $2, $3, $4, $5, $5)
+		ON CONFLICT (project_id) DO UPDATE SET
+			primary_color = EXCLUDED.primary_color,
+			footer_links = EXCLUDED.footer_links,
+			default_locale = EXCLUDED.default_locale,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	primaryColor := sql.NullString{String: input.PrimaryColor, Valid: input.PrimaryColor != ""}
+	defaultLocale := sql.NullString{String: input.DefaultLocale, Valid: input.DefaultLocale != ""}
+	if _, err := r.db.ExecContext(ctx, upsertQuery, input.ProjectID, primaryColor, footerLinks, defaultLocale, time.Now()); err != nil {
+		return nil, fmt.Errorf("update project branding: %w", err)
+	}
+
+	return r.Get(ctx, input.ProjectID)
+}
+
+func (r *Repo) SetLogo(ctx context.Context, input *SetProjectLogoInput) (*ProjectBranding, error) {
+	upsertQuery := `
+		INSERT INTO altalune_project_branding (
+			project_id,
+			logo,
+			logo_content_type,
+			logo_updated_at,
+			created_at,
+			updated_at
+		) VALUES ($1, $2, $3, $4, $4, $4)
+		ON CONFLICT (project_id) DO UPDATE SET
+			logo = EXCLUDED.logo,
+			logo_content_type = EXCLUDED.logo_content_type,
+			logo_updated_at = EXCLUDED.logo_updated_at,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	if _, err := r.db.ExecContext(ctx, upsertQuery, input.ProjectID, input.Content, input.ContentType, time.Now()); err != nil {
+		return nil, fmt.Errorf("set project logo: %w", err)
+	}
+
+	return r.Get(ctx, input.ProjectID)
+}
+
+// DeleteLogo removes the project logo. Deleting a missing logo is a no-op.
+func (r *Repo) DeleteLogo(ctx context.Context, projectID int64) (*ProjectBranding, error) {
+	updateQuery := `
+		UPDATE altalune_project_branding
+		SET
+			logo = NULL,
+			logo_content_type = NULL,
+			logo_updated_at = NULL,
+			updated_at = $1
+		WHERE project_id = $2 AND logo IS NOT NULL
+	`
+
+	if _, err := r.db.ExecContext(ctx, updateQuery, time.Now(), projectID); err != nil {
+		return nil, fmt.Errorf("delete project logo: %w", err)
+	}
+
+	return r.Get(ctx, projectID)
+}
+
+func (r *Repo) GetLogo(ctx context.Context, projectPublicID string) (*Logo, error) {
+	query := `
+		SELECT b.logo, b.logo_content_type, b.logo_updated_at
+		FROM altalune_project_branding b
+		JOIN altalune_projects p ON p.id = b.project_id
+		WHERE p.public_id = $1 AND b.logo IS NOT NULL
+	`
+
+	var logo Logo
+	// Logos are public, served by the public ID of their project
+	err := postgres.WithAllProjects(ctx, r.db, func(ctx context.Context) error {
+		return r.db.QueryRowContext(ctx, query, projectPublicID).Scan(&logo.Content, &logo.ContentType, &logo.UpdatedAt)
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrLogoNotFound
+		}
+		return nil, fmt.Errorf("get project logo: %w", err)
+	}
+
+	return &logo, nil
+}
+
+// UnmarshalFooterLinks decodes the footer_links JSONB column.
+func UnmarshalFooterLinks(raw []byte) ([]FooterLink, error) {
+	links := make([]FooterLink, 0)
+	if len(raw) == 0 {
+		return links, nil
+	}
+	if err := json.Unmarshal(raw, &links); err != nil {
+		return nil, fmt.Errorf("unmarshal footer links: %w", err)
+	}
+	return links, nil
+}
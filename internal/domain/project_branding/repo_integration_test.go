@@ -0,0 +1,64 @@
+package project_branding_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hrz8/altalune/internal/domain/project_branding"
+	"github.com/hrz8/altalune/internal/testdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) { testdb.Main(m) }
+
+func TestRepoIntegration(t *testing.T) {
+	ctx := context.Background()
+	db := testdb.Tx(t)
+	fixtures := testdb.Seed(t, db)
+	repo := project_branding.NewRepo(db)
+
+	branding, err := repo.Get(ctx, fixtures.ProjectID)
+	require.NoError(t, err)
+	assert.Equal(t, &project_branding.ProjectBranding{FooterLinks: []project_branding.FooterLink{}}, branding, "unbranded projects get the defaults")
+
+	links := []project_branding.FooterLink{{Label: "Privacy", URL: "https://example.com/privacy"}}
+	branding, err = repo.Update(ctx, &project_branding.UpdateProjectBrandingInput{
+		ProjectID:     fixtures.ProjectID,
+		PrimaryColor:  "#336699",
+		FooterLinks:   links,
+		DefaultLocale: "id-ID",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "#336699", branding.PrimaryColor)
+	assert.Equal(t, links, branding.FooterLinks, "footer links round-trip through the JSONB column")
+	assert.Equal(t, "id-ID", branding.DefaultLocale)
+	assert.Nil(t, branding.LogoUpdatedAt)
+
+	_, err = repo.GetLogo(ctx, fixtures.ProjectPublicID)
+	assert.ErrorIs(t, err, project_branding.ErrLogoNotFound)
+
+	svg := []byte(`<svg xmlns="http://www.w3.org/2000/svg"/>`)
+	branding, err = repo.SetLogo(ctx, &project_branding.SetProjectLogoInput{
+		ProjectID:   fixtures.ProjectID,
+		Content:     svg,
+		ContentType: "image/svg+xml",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, branding.LogoUpdatedAt)
+	assert.Equal(t, "#336699", branding.PrimaryColor, "uploading a logo keeps the rest of the branding")
+
+	logo, err := repo.GetLogo(ctx, fixtures.ProjectPublicID)
+	require.NoError(t, err)
+	assert.Equal(t, svg, logo.Content)
+	assert.Equal(t, "image/svg+xml", logo.ContentType)
+	assert.True(t, branding.LogoUpdatedAt.Equal(logo.UpdatedAt))
+
+	branding, err = repo.DeleteLogo(ctx, fixtures.ProjectID)
+	require.NoError(t, err)
+	assert.Nil(t, branding.LogoUpdatedAt)
+	_, err = repo.GetLogo(ctx, fixtures.ProjectPublicID)
+	assert.ErrorIs(t, err, project_branding.ErrLogoNotFound)
+	_, err = repo.DeleteLogo(ctx, fixtures.ProjectID)
+	assert.NoError(t, err, "deleting a missing logo is a no-op")
+}
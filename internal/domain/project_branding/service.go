@@ -0,0 +1,193 @@
+package project_branding
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"buf.build/go/protovalidate"
+	"github.com/hrz8/altalune"
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	project_domain "github.com/hrz8/altalune/internal/domain/project"
+	"github.com/hrz8/altalune/internal/shared/i18n"
+)
+
+type Service struct {
+	altalunev1.UnimplementedProjectBrandingServiceServer
+	validator    protovalidate.Validator
+	log          altalune.Logger
+	projectRepo  project_domain.Repositor
+	brandingRepo Repositor
+}
+
+func NewService(v protovalidate.Validator, log altalune.Logger, projectRepo project_domain.Repositor, brandingRepo Repositor) *Service {
+	return &Service{
+		validator:    v,
+		log:          log,
+		projectRepo:  projectRepo,
+		brandingRepo: brandingRepo,
+	}
+}
+
+func (s *Service) GetProjectBranding(ctx context.Context, req *altalunev1.GetProjectBrandingRequest) (*altalunev1.GetProjectBrandingResponse, error) {
+	// Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	projectID, err := s.resolveProjectID(ctx, req.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	branding, err := s.brandingRepo.Get(ctx, projectID)
+	if err != nil {
+		s.log.Error("failed to get project branding",
+			"error", err,
+			"project_id", projectID,
+		)
+		return nil, altalune.NewUnexpectedError("failed to get project branding: %w", err)
+	}
+
+	return &altalunev1.GetProjectBrandingResponse{
+		Branding: branding.ToProjectBrandingProto(req.ProjectId),
+	}, nil
+}
+
+func (s *Service) UpdateProjectBranding(ctx context.Context, req *altalunev1.UpdateProjectBrandingRequest) (*altalunev1.UpdateProjectBrandingResponse, error) {
+	// Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	if req.DefaultLocale != "" && !i18n.IsSupported(req.DefaultLocale) {
+		return nil, altalune.NewInvalidPayloadError(fmt.Sprintf("unsupported default_locale, expected one of: %s", strings.Join(i18n.Locales(), ", ")))
+	}
+
+	projectID, err := s.resolveProjectID(ctx, req.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	branding, err := s.brandingRepo.Update(ctx, &UpdateProjectBrandingInput{
+		ProjectID:     projectID,
+		PrimaryColor:  req.PrimaryColor,
+		FooterLinks:   mapFooterLinksFromProto(req.FooterLinks),
+		DefaultLocale: req.DefaultLocale,
+	})
+	if err != nil {
+		s.log.Error("failed to update project branding",
+			"error", err,
+			"project_id", projectID,
+		)
+		return nil, altalune.NewUnexpectedError("failed to update project branding: %w", err)
+	}
+
+	// Log successful update for audit purposes
+	s.log.Info("project branding updated",
+		"project_id", projectID,
+	)
+
+	return &altalunev1.UpdateProjectBrandingResponse{
+		Branding: branding.ToProjectBrandingProto(req.ProjectId),
+		Message:  "Branding updated successfully",
+	}, nil
+}
+
+func (s *Service) UploadProjectLogo(ctx context.Context, req *altalunev1.UploadProjectLogoRequest) (*altalunev1.UploadProjectLogoResponse, error) {
+	// Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	if !logoMatchesContentType(req.Content, req.ContentType) {
+		return nil, altalune.NewInvalidPayloadError("logo content does not match content_type")
+	}
+
+	projectID, err := s.resolveProjectID(ctx, req.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	branding, err := s.brandingRepo.SetLogo(ctx, &SetProjectLogoInput{
+		ProjectID:   projectID,
+		Content:     req.Content,
+		ContentType: req.ContentType,
+	})
+	if err != nil {
+		s.log.Error("failed to upload project logo",
+			"error", err,
+			"project_id", projectID,
+		)
+		return nil, altalune.NewUnexpectedError("failed to upload project logo: %w", err)
+	}
+
+	// Log successful upload for audit purposes
+	s.log.Info("project logo uploaded",
+		"project_id", projectID,
+		"content_type", req.ContentType,
+		"size", len(req.Content),
+	)
+
+	return &altalunev1.UploadProjectLogoResponse{
+		Branding: branding.ToProjectBrandingProto(req.ProjectId),
+		Message:  "Logo uploaded successfully",
+	}, nil
+}
+
+func (s *Service) DeleteProjectLogo(ctx context.Context, req *altalunev1.DeleteProjectLogoRequest) (*altalunev1.DeleteProjectLogoResponse, error) {
+	// Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	projectID, err := s.resolveProjectID(ctx, req.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	branding, err := s.brandingRepo.DeleteLogo(ctx, projectID)
+	if err != nil {
+		s.log.Error("failed to delete project logo",
+			"error", err,
+			"project_id", projectID,
+		)
+		return nil, altalune.NewUnexpectedError("failed to delete project logo: %w", err)
+	}
+
+	// Log successful deletion for audit purposes
+	s.log.Info("project logo deleted",
+		"project_id", projectID,
+	)
+
+	return &altalunev1.DeleteProjectLogoResponse{
+		Branding: branding.ToProjectBrandingProto(req.ProjectId),
+		Message:  "Logo deleted successfully",
+	}, nil
+}
+
+func (s *Service) resolveProjectID(ctx context.Context, publicID string) (int64, error) {
+	projectID, err := s.projectRepo.GetIDByPublicID(ctx, publicID)
+	if err != nil {
+		if err == project_domain.ErrProjectNotFound {
+			return 0, altalune.NewProjectNotFound(publicID)
+		}
+		return 0, altalune.NewInvalidPayloadError("invalid project_id")
+	}
+	return projectID, nil
+}
+
+// logoMatchesContentType sniffs the uploaded bytes so a logo cannot be served
+// under a content type it does not have.
+func logoMatchesContentType(content []byte, contentType string) bool {
+	if contentType == "image/svg+xml" {
+		head := content
+		if len(head) > 1024 {
+			head = head[:1024]
+		}
+		return bytes.Contains(bytes.ToLower(head), []byte("<svg"))
+	}
+	return http.DetectContentType(content) == contentType
+}
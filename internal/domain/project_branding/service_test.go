@@ -0,0 +1,52 @@
+package project_branding
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogoMatchesContentType(t *testing.T) {
+	png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+	tests := []struct {
+		name        string
+		content     []byte
+		contentType string
+		want        bool
+	}{
+		{name: "png", content: png, contentType: "image/png", want: true},
+		{name: "png declared as jpeg", content: png, contentType: "image/jpeg", want: false},
+		{name: "svg", content: []byte(`<?xml version="1.0"?><SVG xmlns="http://www.w3.org/2000/svg"/>`), contentType: "image/svg+xml", want: true},
+		{name: "html declared as svg", content: []byte(`<html><script>alert(1)</script></html>`), contentType: "image/svg+xml", want: false},
+		{name: "svg tag past the sniffed head", content: append(bytes.Repeat([]byte(" "), 1024), []byte("<svg/>")...), contentType: "image/svg+xml", want: false},
+		{name: "html declared as png", content: []byte("<html></html>"), contentType: "image/png", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, logoMatchesContentType(tt.content, tt.contentType))
+		})
+	}
+}
+
+func TestToProjectBrandingProto(t *testing.T) {
+	updatedAt := time.Unix(1700000000, 0)
+
+	unbranded := (&ProjectBranding{}).ToProjectBrandingProto("abc")
+	assert.Empty(t, unbranded.LogoUrl)
+	assert.Nil(t, unbranded.UpdatedAt)
+
+	branded := (&ProjectBranding{
+		PrimaryColor:  "#336699",
+		LogoUpdatedAt: &updatedAt,
+		FooterLinks:   []FooterLink{{Label: "Privacy", URL: "https://example.com/privacy"}},
+		UpdatedAt:     updatedAt,
+	}).ToProjectBrandingProto("abc")
+	assert.Equal(t, "/branding/abc/logo?v=1700000000", branded.LogoUrl, "the logo URL changes with every upload")
+	assert.Equal(t, "#336699", branded.PrimaryColor)
+	if assert.Len(t, branded.FooterLinks, 1) {
+		assert.Equal(t, "Privacy", branded.FooterLinks[0].Label)
+	}
+}
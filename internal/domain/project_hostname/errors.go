@@ -0,0 +1,10 @@
+package project_hostname
+
+import "errors"
+
+var (
+	ErrProjectHostnameNotFound        = errors.New("project hostname not found")
+	ErrProjectHostnameVersionConflict = errors.New("project hostname was modified concurrently")
+	ErrProjectHostnameAlreadyExists   = errors.New("hostname is already registered")
+	ErrDefaultOAuthClientNotFound     = errors.New("default oauth client not found")
+)
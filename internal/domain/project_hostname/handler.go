@@ -0,0 +1,83 @@
+package project_hostname
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/hrz8/altalune"
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	"github.com/hrz8/altalune/internal/auth"
+)
+
+type Handler struct {
+	svc  altalunev1.ProjectHostnameServiceServer
+	auth *auth.Authorizer
+}
+
+func NewHandler(svc altalunev1.ProjectHostnameServiceServer, authorizer *auth.Authorizer) *Handler {
+	return &Handler{svc: svc, auth: authorizer}
+}
+
+func (h *Handler) ListProjectHostnames(
+	ctx context.Context,
+	req *connect.Request[altalunev1.ListProjectHostnamesRequest],
+) (*connect.Response[altalunev1.ListProjectHostnamesResponse], error) {
+	// Authorization: requires project:read permission and project membership
+	if err := h.auth.CheckProjectAccess(ctx, "project:read", req.Msg.ProjectId); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.ListProjectHostnames(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (h *Handler) CreateProjectHostname(
+	ctx context.Context,
+	req *connect.Request[altalunev1.CreateProjectHostnameRequest],
+) (*connect.Response[altalunev1.CreateProjectHostnameResponse], error) {
+	// Authorization: requires project:write permission and project membership
+	if err := h.auth.CheckProjectAccess(ctx, "project:write", req.Msg.ProjectId); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.CreateProjectHostname(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (h *Handler) UpdateProjectHostname(
+	ctx context.Context,
+	req *connect.Request[altalunev1.UpdateProjectHostnameRequest],
+) (*connect.Response[altalunev1.UpdateProjectHostnameResponse], error) {
+	// Authorization: requires project:write permission and project membership
+	if err := h.auth.CheckProjectAccess(ctx, "project:write", req.Msg.ProjectId); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.UpdateProjectHostname(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (h *Handler) DeleteProjectHostname(
+	ctx context.Context,
+	req *connect.Request[altalunev1.DeleteProjectHostnameRequest],
+) (*connect.Response[altalunev1.DeleteProjectHostnameResponse], error) {
+	// Authorization: requires project:write permission and project membership
+	if err := h.auth.CheckProjectAccess(ctx, "project:write", req.Msg.ProjectId); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.DeleteProjectHostname(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
@@ -0,0 +1,13 @@
+package project_hostname
+
+import (
+	"context"
+)
+
+type Repositor interface {
+	List(ctx context.Context, projectID int64) ([]*ProjectHostname, error)
+	Create(ctx context.Context, input *CreateProjectHostnameInput) (*ProjectHostname, error)
+	Update(ctx context.Context, input *UpdateProjectHostnameInput) (*ProjectHostname, error)
+	Delete(ctx context.Context, input *DeleteProjectHostnameInput) error
+	GetTenantByHostname(ctx context.Context, hostname string) (*Tenant, error) // For auth server host routing
+}
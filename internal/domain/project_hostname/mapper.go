@@ -0,0 +1,18 @@
+package project_hostname
+
+import (
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+)
+
+// mapProjectHostnamesToProto converts slice of domain ProjectHostnames to proto ProjectHostnames
+func mapProjectHostnamesToProto(hostnames []*ProjectHostname) []*altalunev1.ProjectHostname {
+	if hostnames == nil {
+		return make([]*altalunev1.ProjectHostname, 0)
+	}
+
+	result := make([]*altalunev1.ProjectHostname, 0, len(hostnames))
+	for _, h := range hostnames {
+		result = append(result, h.ToProjectHostnameProto())
+	}
+	return result
+}
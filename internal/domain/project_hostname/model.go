@@ -0,0 +1,86 @@
+package project_hostname
+
+import (
+	"net"
+	"strings"
+	"time"
+
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	project_branding_domain "github.com/hrz8/altalune/internal/domain/project_branding"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ProjectHostname represents the domain model with public IDs only
+type ProjectHostname struct {
+	ID                   string // Public nanoid
+	Hostname             string
+	BrandingName         string
+	LogoURL              string
+	PrimaryColor         string
+	DefaultOAuthClientID string // Public nanoid of the OAuth client
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+}
+
+func (m *ProjectHostname) ToProjectHostnameProto() *altalunev1.ProjectHostname {
+	return &altalunev1.ProjectHostname{
+		Id:                   m.ID,
+		Hostname:             m.Hostname,
+		BrandingName:         m.BrandingName,
+		LogoUrl:              m.LogoURL,
+		PrimaryColor:         m.PrimaryColor,
+		DefaultOauthClientId: m.DefaultOAuthClientID,
+		CreatedAt:            timestamppb.New(m.CreatedAt),
+		UpdatedAt:            timestamppb.New(m.UpdatedAt),
+	}
+}
+
+// Tenant is what the auth server resolves from a request Host:
+// the project owning the hostname, its branding and its default OAuth client.
+// Hostname-level branding wins over the project branding.
+type Tenant struct {
+	ProjectID       string // Public nanoid
+	Hostname        string
+	BrandingName    string
+	LogoURL         string
+	PrimaryColor    string
+	FooterLinks     []project_branding_domain.FooterLink
+	DefaultLocale   string // Project default locale, empty if none
+	DefaultClientID string // OAuth client_id (UUID), empty if none
+	Sandbox         bool   // Whether the project is a sandbox, whose auth pages show diagnostics
+}
+
+type CreateProjectHostnameInput struct {
+	ProjectID            int64
+	Hostname             string
+	BrandingName         string
+	LogoURL              string
+	PrimaryColor         string
+	DefaultOAuthClientID string // Public nanoid, empty for none
+}
+
+type UpdateProjectHostnameInput struct {
+	ProjectID            int64
+	PublicID             string
+	BrandingName         string
+	LogoURL              string
+	PrimaryColor         string
+	DefaultOAuthClientID string     // Public nanoid, empty for none
+	ExpectedUpdatedAt    *time.Time // Reject the update unless updated_at still matches; nil to skip
+}
+
+type DeleteProjectHostnameInput struct {
+	ProjectID int64
+	PublicID  string
+}
+
+// NormalizeHostname lowercases a hostname and strips any port and trailing dot,
+// so "Auth.Example.com.:443" and "auth.example.com" match the same row.
+func NormalizeHostname(host string) string {
+	host = strings.TrimSpace(host)
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	host = strings.TrimSuffix(strings.Trim(host, "[]"), ".")
+	return strings.ToLower(host)
+}
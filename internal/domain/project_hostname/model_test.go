@@ -0,0 +1,23 @@
+package project_hostname
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeHostname(t *testing.T) {
+	tests := map[string]string{
+		"auth.example.com":       "auth.example.com",
+		"Auth.Example.COM":       "auth.example.com",
+		"auth.example.com:8443":  "auth.example.com",
+		"auth.example.com.":      "auth.example.com",
+		" auth.example.com.:443": "auth.example.com",
+		"[::1]:3300":             "::1",
+		"":                       "",
+	}
+
+	for input, want := range tests {
+		assert.Equal(t, want, NormalizeHostname(input), "input %q", input)
+	}
+}
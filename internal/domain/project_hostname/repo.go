@@ -0,0 +1,310 @@
+package project_hostname
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	project_branding_domain "github.com/hrz8/altalune/internal/domain/project_branding"
+	"github.com/hrz8/altalune/internal/postgres"
+)
+
+type Repo struct {
+	db postgres.DB
+}
+
+func NewRepo(db postgres.DB) *Repo {
+	return &Repo{
+		db: db,
+	}
+}
+
+// selectHostnameColumns is shared by every query returning a ProjectHostname.
+// The default OAuth client is exposed by its public ID.
+const selectHostnameColumns = `
+		SELECT
+			h.public_id,
+			h.hostname,
+			COALESCE(h.branding_name, ''),
+			COALESCE(h.logo_url, ''),
+			COALESCE(h.primary_color, ''),
+			COALESCE(c.public_id, ''),
+			h.created_at,
+			h.updated_at
+		FROM altalune_project_hostnames h
+		LEFT JOIN altalune_oauth_clients c ON c.id = h.default_oauth_client_id
+`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanProjectHostname(row rowScanner) (*ProjectHostname, error) {
+	var h ProjectHostname
+	err := row.Scan(
+		&h.ID,
+		&h.Hostname,
+		&h.BrandingName,
+		&h.LogoURL,
+		&h.PrimaryColor,
+		&h.DefaultOAuthClientID,
+		&h.CreatedAt,
+		&h.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &h, nil
+}
+
+func (r *Repo) List(ctx context.Context, projectID int64) ([]*ProjectHostname, error) {
+	query := selectHostnameColumns + `
+		WHERE h.project_id = $1
+		ORDER BY h.hostname ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("list project hostnames: %w", err)
+	}
+	defer rows.Close()
+
+	results := make([]*ProjectHostname, 0)
+	for rows.Next() {
+		h, err := scanProjectHostname(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan project hostname: %w", err)
+		}
+		results = append(results, h)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate project hostnames: %w", err)
+	}
+
+	return results, nil
+}
+
+func (r *Repo) Create(ctx context.Context, input *CreateProjectHostnameInput) (*ProjectHostname, error) {
+	clientID, err := r.resolveOAuthClientID(ctx, input.DefaultOAuthClientID)
+	if err != nil {
+		return nil, err
+	}
+
+	insertQuery := `
+		INSERT INTO altalune_project_hostnames (
+			public_id,
+			project_id,
+			hostname,
+			branding_name,
+			logo_url,
+			primary_color,
+			default_oauth_client_id,
+			created_at,
+			updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+
+	now := time.Now()
+	publicID, err := postgres.InsertWithPublicID(func(publicID string) error {
+		_, err := r.db.ExecContext(
+			ctx,
+			insertQuery,
+			publicID,
+			input.ProjectID,
+			input.Hostname,
+			nullString(input.BrandingName),
+			nullString(input.LogoURL),
+			nullString(input.PrimaryColor),
+			clientID,
+			now,
+			now,
+		)
+		return err
+	})
+	if err != nil {
+		if postgres.IsUniqueViolation(err) && !postgres.IsPublicIDViolation(err) {
+			return nil, ErrProjectHostnameAlreadyExists
+		}
+		return nil, fmt.Errorf("create project hostname: %w", err)
+	}
+
+	return r.getByPublicID(ctx, input.ProjectID, publicID)
+}
+
+func (r *Repo) Update(ctx context.Context, input *UpdateProjectHostnameInput) (*ProjectHostname, error) {
+	clientID, err := r.resolveOAuthClientID(ctx, input.DefaultOAuthClientID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Whether the hostname was updated, and whether it exists at all: one
+	// existing but not updated changed since the expected version
+	updateQuery := `
+		WITH updated AS (
+			UPDATE altalune_project_hostnames
+			SET
+				branding_name = $1,
+				logo_url = $2,
+				primary_color = $3,
+				default_oauth_client_id = $4,
+				updated_at = $5
+			WHERE project_id = $6 AND public_id = $7
+				AND ($8::timestamptz IS NULL OR updated_at = $8)
+			RETURNING id
+		)
+		SELECT
+			EXISTS (SELECT 1 FROM updated),
+			EXISTS (SELECT 1 FROM altalune_project_hostnames WHERE project_id = $6 AND public_id = $7)
+	`
+
+	var updated, exists bool
+	err = r.db.QueryRowContext(
+		ctx,
+		updateQuery,
+		nullString(input.BrandingName),
+		nullString(input.LogoURL),
+		nullString(input.PrimaryColor),
+		clientID,
+		time.Now(),
+		input.ProjectID,
+		input.PublicID,
+		input.ExpectedUpdatedAt,
+	).Scan(&updated, &exists)
+	if err != nil {
+		return nil, fmt.Errorf("update project hostname: %w", err)
+	}
+	if !exists {
+		return nil, ErrProjectHostnameNotFound
+	}
+	if !updated {
+		return nil, ErrProjectHostnameVersionConflict
+	}
+
+	return r.getByPublicID(ctx, input.ProjectID, input.PublicID)
+}
+
+func (r *Repo) Delete(ctx context.Context, input *DeleteProjectHostnameInput) error {
+	deleteQuery := `
+		DELETE FROM altalune_project_hostnames
+		WHERE project_id = $1 AND public_id = $2
+	`
+
+	result, err := r.db.ExecContext(ctx, deleteQuery, input.ProjectID, input.PublicID)
+	if err != nil {
+		return fmt.Errorf("delete project hostname: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrProjectHostnameNotFound
+	}
+
+	return nil
+}
+
+// GetTenantByHostname resolves the project, branding and default OAuth client
+// registered for a hostname. The hostname must already be normalized.
+// Branding not set on the hostname falls back to the project branding.
+func (r *Repo) GetTenantByHostname(ctx context.Context, hostname string) (*Tenant, error) {
+	query := `
+		SELECT
+			p.public_id,
+			h.hostname,
+			COALESCE(h.branding_name, ''),
+			COALESCE(h.logo_url, ''),
+			COALESCE(h.primary_color, b.primary_color, ''),
+			b.logo_updated_at,
+			b.footer_links,
+			COALESCE(b.default_locale, ''),
+			COALESCE(c.client_id::text, ''),
+			p.environment = 'sandbox'
+		FROM altalune_project_hostnames h
+		JOIN altalune_projects p ON p.id = h.project_id
+		LEFT JOIN altalune_project_branding b ON b.project_id = h.project_id
+		LEFT JOIN altalune_oauth_clients c ON c.id = h.default_oauth_client_id AND c.deleted_at IS NULL
+		WHERE h.hostname = $1
+	`
+
+	var t Tenant
+	var projectLogoUpdatedAt sql.NullTime
+	var footerLinks []byte
+	// The hostname tells the project, so it is looked up across projects
+	err := postgres.WithAllProjects(ctx, r.db, func(ctx context.Context) error {
+		return r.db.QueryRowContext(ctx, query, hostname).Scan(
+			&t.ProjectID,
+			&t.Hostname,
+			&t.BrandingName,
+			&t.LogoURL,
+			&t.PrimaryColor,
+			&projectLogoUpdatedAt,
+			&footerLinks,
+			&t.DefaultLocale,
+			&t.DefaultClientID,
+			&t.Sandbox,
+		)
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrProjectHostnameNotFound
+		}
+		return nil, fmt.Errorf("get tenant by hostname: %w", err)
+	}
+
+	if t.LogoURL == "" && projectLogoUpdatedAt.Valid {
+		t.LogoURL = project_branding_domain.LogoPath(t.ProjectID, projectLogoUpdatedAt.Time)
+	}
+	if t.FooterLinks, err = project_branding_domain.UnmarshalFooterLinks(footerLinks); err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+func (r *Repo) getByPublicID(ctx context.Context, projectID int64, publicID string) (*ProjectHostname, error) {
+	query := selectHostnameColumns + `
+		WHERE h.project_id = $1 AND h.public_id = $2
+	`
+
+	h, err := scanProjectHostname(r.db.QueryRowContext(ctx, query, projectID, publicID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrProjectHostnameNotFound
+		}
+		return nil, fmt.Errorf("get project hostname: %w", err)
+	}
+	return h, nil
+}
+
+// resolveOAuthClientID maps an OAuth client public ID to its internal ID.
+// An empty public ID clears the default client.
+func (r *Repo) resolveOAuthClientID(ctx context.Context, publicID string) (sql.NullInt64, error) {
+	if publicID == "" {
+		return sql.NullInt64{}, nil
+	}
+
+	query := `
+		SELECT id
+		FROM altalune_oauth_clients
+		WHERE public_id = $1 AND deleted_at IS NULL
+	`
+
+	var id int64
+	if err := r.db.QueryRowContext(ctx, query, publicID).Scan(&id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return sql.NullInt64{}, ErrDefaultOAuthClientNotFound
+		}
+		return sql.NullInt64{}, fmt.Errorf("resolve oauth client: %w", err)
+	}
+	return sql.NullInt64{Int64: id, Valid: true}, nil
+}
+
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
@@ -0,0 +1,54 @@
+package project_hostname_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hrz8/altalune/internal/domain/project_hostname"
+	"github.com/hrz8/altalune/internal/testdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) { testdb.Main(m) }
+
+func TestRepoUpdateConflict(t *testing.T) {
+	ctx := context.Background()
+	db := testdb.Tx(t)
+	fixtures := testdb.Seed(t, db)
+	repo := project_hostname.NewRepo(db)
+
+	created, err := repo.Create(ctx, &project_hostname.CreateProjectHostnameInput{
+		ProjectID:    fixtures.ProjectID,
+		Hostname:     fixtures.ProjectPublicID + ".example.com",
+		BrandingName: "Conflict",
+	})
+	require.NoError(t, err)
+
+	update := func(brandingName string, expectedUpdatedAt time.Time) (*project_hostname.ProjectHostname, error) {
+		return repo.Update(ctx, &project_hostname.UpdateProjectHostnameInput{
+			ProjectID:         fixtures.ProjectID,
+			PublicID:          created.ID,
+			BrandingName:      brandingName,
+			ExpectedUpdatedAt: &expectedUpdatedAt,
+		})
+	}
+
+	_, err = update("Stale", created.UpdatedAt.Add(-time.Second))
+	assert.ErrorIs(t, err, project_hostname.ErrProjectHostnameVersionConflict)
+
+	updated, err := update("Fresh", created.UpdatedAt)
+	require.NoError(t, err)
+	assert.Equal(t, "Fresh", updated.BrandingName)
+
+	_, err = update("Again", created.UpdatedAt)
+	assert.ErrorIs(t, err, project_hostname.ErrProjectHostnameVersionConflict, "a version is only updated once")
+
+	_, err = repo.Update(ctx, &project_hostname.UpdateProjectHostnameInput{
+		ProjectID:         fixtures.ProjectID,
+		PublicID:          "unknown",
+		ExpectedUpdatedAt: &updated.UpdatedAt,
+	})
+	assert.ErrorIs(t, err, project_hostname.ErrProjectHostnameNotFound, "a missing hostname is not a conflict")
+}
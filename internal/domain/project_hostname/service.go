@@ -0,0 +1,205 @@
+package project_hostname
+
+import (
+	"context"
+
+	"buf.build/go/protovalidate"
+	"github.com/hrz8/altalune"
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	project_domain "github.com/hrz8/altalune/internal/domain/project"
+)
+
+type Service struct {
+	altalunev1.UnimplementedProjectHostnameServiceServer
+	validator    protovalidate.Validator
+	log          altalune.Logger
+	projectRepo  project_domain.Repositor
+	hostnameRepo Repositor
+}
+
+func NewService(v protovalidate.Validator, log altalune.Logger, projectRepo project_domain.Repositor, hostnameRepo Repositor) *Service {
+	return &Service{
+		validator:    v,
+		log:          log,
+		projectRepo:  projectRepo,
+		hostnameRepo: hostnameRepo,
+	}
+}
+
+func (s *Service) ListProjectHostnames(ctx context.Context, req *altalunev1.ListProjectHostnamesRequest) (*altalunev1.ListProjectHostnamesResponse, error) {
+	// Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	projectID, err := s.resolveProjectID(ctx, req.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	hostnames, err := s.hostnameRepo.List(ctx, projectID)
+	if err != nil {
+		s.log.Error("failed to list project hostnames",
+			"error", err,
+			"project_id", projectID,
+		)
+		return nil, altalune.NewUnexpectedError("failed to list project hostnames: %w", err)
+	}
+
+	return &altalunev1.ListProjectHostnamesResponse{
+		Data: mapProjectHostnamesToProto(hostnames),
+	}, nil
+}
+
+func (s *Service) CreateProjectHostname(ctx context.Context, req *altalunev1.CreateProjectHostnameRequest) (*altalunev1.CreateProjectHostnameResponse, error) {
+	// Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	projectID, err := s.resolveProjectID(ctx, req.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	hostname := NormalizeHostname(req.Hostname)
+
+	result, err := s.hostnameRepo.Create(ctx, &CreateProjectHostnameInput{
+		ProjectID:            projectID,
+		Hostname:             hostname,
+		BrandingName:         req.BrandingName,
+		LogoURL:              req.LogoUrl,
+		PrimaryColor:         req.PrimaryColor,
+		DefaultOAuthClientID: req.DefaultOauthClientId,
+	})
+	if err != nil {
+		switch err {
+		case ErrProjectHostnameAlreadyExists:
+			return nil, altalune.NewProjectHostnameAlreadyExistsError(hostname)
+		case ErrDefaultOAuthClientNotFound:
+			return nil, altalune.NewOAuthClientNotFoundError(req.DefaultOauthClientId)
+		}
+		s.log.Error("failed to create project hostname",
+			"error", err,
+			"project_id", projectID,
+			"hostname", hostname,
+		)
+		return nil, altalune.NewUnexpectedError("failed to create project hostname: %w", err)
+	}
+
+	// Log successful creation for audit purposes
+	s.log.Info("project hostname created",
+		"project_id", projectID,
+		"hostname_id", result.ID,
+		"hostname", result.Hostname,
+	)
+
+	return &altalunev1.CreateProjectHostnameResponse{
+		Hostname: result.ToProjectHostnameProto(),
+		Message:  "Hostname registered successfully",
+	}, nil
+}
+
+func (s *Service) UpdateProjectHostname(ctx context.Context, req *altalunev1.UpdateProjectHostnameRequest) (*altalunev1.UpdateProjectHostnameResponse, error) {
+	// Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	projectID, err := s.resolveProjectID(ctx, req.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &UpdateProjectHostnameInput{
+		ProjectID:            projectID,
+		PublicID:             req.HostnameId,
+		BrandingName:         req.BrandingName,
+		LogoURL:              req.LogoUrl,
+		PrimaryColor:         req.PrimaryColor,
+		DefaultOAuthClientID: req.DefaultOauthClientId,
+	}
+	if req.ExpectedUpdatedAt != nil {
+		expectedUpdatedAt := req.ExpectedUpdatedAt.AsTime()
+		input.ExpectedUpdatedAt = &expectedUpdatedAt
+	}
+
+	result, err := s.hostnameRepo.Update(ctx, input)
+	if err != nil {
+		switch err {
+		case ErrProjectHostnameNotFound:
+			return nil, altalune.NewProjectHostnameNotFoundError(req.HostnameId)
+		case ErrProjectHostnameVersionConflict:
+			return nil, altalune.NewVersionConflictError("hostname", req.HostnameId)
+		case ErrDefaultOAuthClientNotFound:
+			return nil, altalune.NewOAuthClientNotFoundError(req.DefaultOauthClientId)
+		}
+		s.log.Error("failed to update project hostname",
+			"error", err,
+			"project_id", projectID,
+			"hostname_id", req.HostnameId,
+		)
+		return nil, altalune.NewUnexpectedError("failed to update project hostname: %w", err)
+	}
+
+	// Log successful update for audit purposes
+	s.log.Info("project hostname updated",
+		"project_id", projectID,
+		"hostname_id", result.ID,
+		"hostname", result.Hostname,
+	)
+
+	return &altalunev1.UpdateProjectHostnameResponse{
+		Hostname: result.ToProjectHostnameProto(),
+		Message:  "Hostname updated successfully",
+	}, nil
+}
+
+func (s *Service) DeleteProjectHostname(ctx context.Context, req *altalunev1.DeleteProjectHostnameRequest) (*altalunev1.DeleteProjectHostnameResponse, error) {
+	// Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	projectID, err := s.resolveProjectID(ctx, req.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.hostnameRepo.Delete(ctx, &DeleteProjectHostnameInput{
+		ProjectID: projectID,
+		PublicID:  req.HostnameId,
+	})
+	if err != nil {
+		if err == ErrProjectHostnameNotFound {
+			return nil, altalune.NewProjectHostnameNotFoundError(req.HostnameId)
+		}
+		s.log.Error("failed to delete project hostname",
+			"error", err,
+			"project_id", projectID,
+			"hostname_id", req.HostnameId,
+		)
+		return nil, altalune.NewUnexpectedError("failed to delete project hostname: %w", err)
+	}
+
+	// Log successful deletion for audit purposes
+	s.log.Info("project hostname deleted",
+		"project_id", projectID,
+		"hostname_id", req.HostnameId,
+	)
+
+	return &altalunev1.DeleteProjectHostnameResponse{
+		Message: "Hostname deleted successfully",
+	}, nil
+}
+
+func (s *Service) resolveProjectID(ctx context.Context, publicID string) (int64, error) {
+	projectID, err := s.projectRepo.GetIDByPublicID(ctx, publicID)
+	if err != nil {
+		if err == project_domain.ErrProjectNotFound {
+			return 0, altalune.NewProjectNotFound(publicID)
+		}
+		return 0, altalune.NewInvalidPayloadError("invalid project_id")
+	}
+	return projectID, nil
+}
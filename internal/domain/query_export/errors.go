@@ -0,0 +1,11 @@
+package query_export
+
+import "errors"
+
+var (
+	ErrQueryExportNotFound = errors.New("query export not found")
+	ErrQueryExportNotReady = errors.New("query export file is not generated yet")
+	ErrNoPendingExport     = errors.New("no pending query export")
+	ErrTooManyRows         = errors.New("query export exceeds the row limit")
+	ErrTooLarge            = errors.New("query export exceeds the size limit")
+)
@@ -0,0 +1,58 @@
+package query_export
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/hrz8/altalune"
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	"github.com/hrz8/altalune/internal/auth"
+)
+
+type Handler struct {
+	svc  altalunev1.QueryExportServiceServer
+	auth *auth.Authorizer
+}
+
+func NewHandler(svc altalunev1.QueryExportServiceServer, authorizer *auth.Authorizer) *Handler {
+	return &Handler{svc: svc, auth: authorizer}
+}
+
+func (h *Handler) ExportQuery(
+	ctx context.Context,
+	req *connect.Request[altalunev1.ExportQueryRequest],
+) (*connect.Response[altalunev1.ExportQueryResponse], error) {
+	// Authorization: requires the read permission of the resource, and project
+	// membership for project-scoped resources
+	r := ResourceFromProto(req.Msg.Resource)
+	switch {
+	case r.ReadPermission() == "":
+		// Unknown resources are rejected by the validation of the service
+	case r.ProjectScoped():
+		if err := h.auth.CheckProjectAccess(ctx, r.ReadPermission(), req.Msg.ProjectId); err != nil {
+			return nil, err
+		}
+	default:
+		if err := h.auth.CheckPermission(ctx, r.ReadPermission()); err != nil {
+			return nil, err
+		}
+	}
+
+	response, err := h.svc.ExportQuery(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (h *Handler) GetQueryExport(
+	ctx context.Context,
+	req *connect.Request[altalunev1.GetQueryExportRequest],
+) (*connect.Response[altalunev1.GetQueryExportResponse], error) {
+	// Authorization: exports are private, the service only finds the caller's
+	response, err := h.svc.GetQueryExport(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
@@ -0,0 +1,21 @@
+package query_export
+
+import (
+	"context"
+	"time"
+)
+
+type Repositor interface {
+	Create(ctx context.Context, input *CreateQueryExportInput) (*QueryExport, error)
+	GetByPublicID(ctx context.Context, userID int64, publicID string) (*QueryExport, error)
+	Claim(ctx context.Context) (*Job, error) // For the workers
+	UpdateProgress(ctx context.Context, publicID string, written, total int32) error
+	Complete(ctx context.Context, publicID string, rows int32, size int64) error
+	Fail(ctx context.Context, publicID string, reason string) error
+	Release(ctx context.Context, publicID string) error
+	// GetFile is for the download links. The token is returned by Create
+	// only, the repository keeping its hash.
+	GetFile(ctx context.Context, downloadToken string) (*File, error)
+	FailStale(ctx context.Context, before time.Time) (int64, error)
+	DeleteExpired(ctx context.Context, now time.Time) ([]string, error)
+}
@@ -0,0 +1,164 @@
+package query_export
+
+import (
+	"time"
+
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Lifetime is how long an export can be polled and downloaded
+	Lifetime = 24 * time.Hour
+	// DownloadPath prefixes the download links on the API server
+	DownloadPath = "/exports/"
+)
+
+// Resource is the Query endpoint an export runs
+type Resource string
+
+const (
+	ResourceUsers   Resource = "users"
+	ResourceApiKeys Resource = "api_keys"
+)
+
+// ResourceFromProto returns the resource of r, empty when unspecified
+func ResourceFromProto(r altalunev1.QueryExportResource) Resource {
+	switch r {
+	case altalunev1.QueryExportResource_QUERY_EXPORT_RESOURCE_USERS:
+		return ResourceUsers
+	case altalunev1.QueryExportResource_QUERY_EXPORT_RESOURCE_API_KEYS:
+		return ResourceApiKeys
+	default:
+		return ""
+	}
+}
+
+func (r Resource) ToProto() altalunev1.QueryExportResource {
+	switch r {
+	case ResourceUsers:
+		return altalunev1.QueryExportResource_QUERY_EXPORT_RESOURCE_USERS
+	case ResourceApiKeys:
+		return altalunev1.QueryExportResource_QUERY_EXPORT_RESOURCE_API_KEYS
+	default:
+		return altalunev1.QueryExportResource_QUERY_EXPORT_RESOURCE_UNSPECIFIED
+	}
+}
+
+// ReadPermission returns the permission querying the resource requires, which
+// exporting it requires too. It is empty for unknown resources.
+func (r Resource) ReadPermission() string {
+	switch r {
+	case ResourceUsers:
+		return "user:read"
+	case ResourceApiKeys:
+		return "apikey:read"
+	default:
+		return ""
+	}
+}
+
+// ProjectScoped reports whether the rows of the resource belong to a project
+func (r Resource) ProjectScoped() bool {
+	return r == ResourceApiKeys
+}
+
+// Status is where an export is in its generation
+type Status string
+
+const (
+	StatusPending   Status = "pending"
+	StatusRunning   Status = "running"
+	StatusCompleted Status = "completed"
+	StatusFailed    Status = "failed"
+)
+
+func (s Status) ToProto() altalunev1.QueryExportStatus {
+	switch s {
+	case StatusPending:
+		return altalunev1.QueryExportStatus_QUERY_EXPORT_STATUS_PENDING
+	case StatusRunning:
+		return altalunev1.QueryExportStatus_QUERY_EXPORT_STATUS_RUNNING
+	case StatusCompleted:
+		return altalunev1.QueryExportStatus_QUERY_EXPORT_STATUS_COMPLETED
+	case StatusFailed:
+		return altalunev1.QueryExportStatus_QUERY_EXPORT_STATUS_FAILED
+	default:
+		return altalunev1.QueryExportStatus_QUERY_EXPORT_STATUS_UNSPECIFIED
+	}
+}
+
+// QueryExport represents the domain model with public IDs only
+type QueryExport struct {
+	ID            string // Public nanoid
+	Resource      Resource
+	ProjectID     string // Public nanoid, empty for global resources
+	Status        Status
+	RowsWritten   int32
+	RowsTotal     int32 // Estimated until the export completes
+	Error         string
+	DownloadToken string // Only known when created, the database keeping its hash
+	CreatedAt     time.Time
+	CompletedAt   *time.Time
+	ExpiresAt     time.Time
+}
+
+func (m *QueryExport) ToQueryExportProto() *altalunev1.QueryExport {
+	export := &altalunev1.QueryExport{
+		Id:          m.ID,
+		Resource:    m.Resource.ToProto(),
+		ProjectId:   m.ProjectID,
+		Status:      m.Status.ToProto(),
+		RowsWritten: m.RowsWritten,
+		RowsTotal:   m.RowsTotal,
+		Error:       m.Error,
+		CreatedAt:   timestamppb.New(m.CreatedAt),
+		ExpiresAt:   timestamppb.New(m.ExpiresAt),
+	}
+	if m.DownloadToken != "" {
+		export.DownloadUrl = DownloadPath + m.DownloadToken
+	}
+	if m.CompletedAt != nil {
+		export.CompletedAt = timestamppb.New(*m.CompletedAt)
+	}
+	return export
+}
+
+// fileName returns the suggested name of the file of an export
+func fileName(resource Resource, projectID string, createdAt time.Time) string {
+	name := string(resource)
+	if projectID != "" {
+		name += "-" + projectID
+	}
+	return name + "-" + createdAt.UTC().Format("20060102") + ".csv"
+}
+
+// fileKey returns the key of the file of an export in the file store
+func fileKey(publicID string) string {
+	return publicID + ".csv"
+}
+
+// File is the CSV file of a completed export, kept in the file store
+type File struct {
+	Filename string
+	Key      string
+}
+
+// Job is a pending export claimed by a worker to generate its file
+type Job struct {
+	PublicID  string
+	ProjectID int64 // 0 for global resources
+	Resource  Resource
+	Query     []byte // QueryRequest as protojson
+	Trashed   bool
+}
+
+type CreateQueryExportInput struct {
+	UserID        int64 // 0 when authentication is disabled
+	ProjectID     int64 // 0 for global resources
+	Resource      Resource
+	Query         []byte // QueryRequest as protojson
+	Trashed       bool
+	DownloadToken string
+	ExpiresAt     time.Time
+}
@@ -0,0 +1,57 @@
+package query_export
+
+import (
+	"context"
+	"time"
+
+	"github.com/hrz8/altalune"
+	"github.com/hrz8/altalune/internal/shared/filestore"
+)
+
+// PurgeJobName is the name the purge job is registered with on the scheduler
+const PurgeJobName = "query_export.purge"
+
+// PurgeJobSpec runs the job every hour
+const PurgeJobSpec = "@hourly"
+
+// staleAfter is how long an export goes without progress before it is
+// considered stopped; a page of rows takes far less
+const staleAfter = 15 * time.Minute
+
+// PurgeJob deletes the expired exports along with their files, and fails the
+// exports whose generation stopped with the server running it.
+type PurgeJob struct {
+	exports Repositor
+	files   filestore.Store
+	log     altalune.Logger
+}
+
+// NewPurgeJob creates a query export purge job.
+func NewPurgeJob(exports Repositor, files filestore.Store, log altalune.Logger) *PurgeJob {
+	return &PurgeJob{exports: exports, files: files, log: log}
+}
+
+// Run purges the exports once.
+func (j *PurgeJob) Run(ctx context.Context) error {
+	now := time.Now()
+
+	stale, err := j.exports.FailStale(ctx, now.Add(-staleAfter))
+	if err != nil {
+		return err
+	}
+	keys, err := j.exports.DeleteExpired(ctx, now)
+	if err != nil {
+		return err
+	}
+	// A file left behind is only disk space: its link died with the export
+	for _, key := range keys {
+		if err := j.files.Delete(key); err != nil {
+			j.log.Error("failed to delete query export file", "error", err, "key", key)
+		}
+	}
+
+	if stale > 0 || len(keys) > 0 {
+		j.log.Info("query exports purged", "stale", stale, "files", len(keys))
+	}
+	return nil
+}
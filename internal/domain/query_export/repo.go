@@ -0,0 +1,363 @@
+package query_export
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hrz8/altalune/internal/postgres"
+)
+
+type Repo struct {
+	db postgres.DB
+}
+
+func NewRepo(db postgres.DB) *Repo {
+	return &Repo{
+		db: db,
+	}
+}
+
+// selectExportColumns is shared by every query returning a QueryExport.
+// The project is exposed by its public ID.
+const selectExportColumns = `
+		SELECT
+			e.public_id,
+			e.resource,
+			COALESCE(p.public_id, ''),
+			e.status,
+			e.rows_written,
+			e.rows_total,
+			e.error,
+			e.created_at,
+			e.completed_at,
+			e.expires_at
+		FROM altalune_query_exports e
+		LEFT JOIN altalune_projects p ON p.id = e.project_id
+`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanQueryExport(row rowScanner) (*QueryExport, error) {
+	var e QueryExport
+	var completedAt sql.NullTime
+	err := row.Scan(
+		&e.ID,
+		&e.Resource,
+		&e.ProjectID,
+		&e.Status,
+		&e.RowsWritten,
+		&e.RowsTotal,
+		&e.Error,
+		&e.CreatedAt,
+		&completedAt,
+		&e.ExpiresAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if completedAt.Valid {
+		e.CompletedAt = &completedAt.Time
+	}
+	return &e, nil
+}
+
+func (r *Repo) Create(ctx context.Context, input *CreateQueryExportInput) (*QueryExport, error) {
+	insertQuery := `
+		INSERT INTO altalune_query_exports (
+			public_id,
+			user_id,
+			project_id,
+			resource,
+			query,
+			trashed,
+			status,
+			download_token_hash,
+			created_at,
+			updated_at,
+			expires_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`
+
+	now := time.Now()
+	userID := nullID(input.UserID)
+	publicID, err := postgres.InsertWithPublicID(func(publicID string) error {
+		_, err := r.db.ExecContext(
+			ctx,
+			insertQuery,
+			publicID,
+			userID,
+			nullID(input.ProjectID),
+			input.Resource,
+			input.Query,
+			input.Trashed,
+			StatusPending,
+			hashToken(input.DownloadToken),
+			now,
+			now,
+			input.ExpiresAt,
+		)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create query export: %w", err)
+	}
+
+	e, err := r.getByPublicID(ctx, userID, publicID)
+	if err != nil {
+		return nil, err
+	}
+	e.DownloadToken = input.DownloadToken
+	return e, nil
+}
+
+// GetByPublicID returns an export of userID, 0 for the exports started with
+// authentication disabled, until it expires
+func (r *Repo) GetByPublicID(ctx context.Context, userID int64, publicID string) (*QueryExport, error) {
+	return r.getByPublicID(ctx, nullID(userID), publicID)
+}
+
+// Claim marks the oldest pending export as running and returns it,
+// ErrNoPendingExport when there is none. Concurrent workers skip the exports
+// claimed by one another.
+func (r *Repo) Claim(ctx context.Context) (*Job, error) {
+	updateQuery := `
+		UPDATE altalune_query_exports
+		SET
+			status = $1,
+			updated_at = $2
+		WHERE id = (
+			SELECT id
+			FROM altalune_query_exports
+			WHERE status = $3 AND expires_at > $2
+			ORDER BY created_at, id
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING public_id, COALESCE(project_id, 0), resource, query, trashed
+	`
+
+	var job Job
+	err := r.db.QueryRowContext(ctx, updateQuery, StatusRunning, time.Now(), StatusPending).Scan(
+		&job.PublicID,
+		&job.ProjectID,
+		&job.Resource,
+		&job.Query,
+		&job.Trashed,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNoPendingExport
+		}
+		return nil, fmt.Errorf("claim query export: %w", err)
+	}
+	return &job, nil
+}
+
+func (r *Repo) UpdateProgress(ctx context.Context, publicID string, written, total int32) error {
+	updateQuery := `
+		UPDATE altalune_query_exports
+		SET
+			rows_written = $1,
+			rows_total = $2,
+			updated_at = $3
+		WHERE public_id = $4 AND status = $5
+	`
+
+	_, err := r.db.ExecContext(ctx, updateQuery, written, total, time.Now(), publicID, StatusRunning)
+	if err != nil {
+		return fmt.Errorf("update query export progress: %w", err)
+	}
+	return nil
+}
+
+func (r *Repo) Complete(ctx context.Context, publicID string, rows int32, size int64) error {
+	updateQuery := `
+		UPDATE altalune_query_exports
+		SET
+			status = $1,
+			rows_written = $2,
+			rows_total = $2,
+			size_bytes = $3,
+			updated_at = $4,
+			completed_at = $4
+		WHERE public_id = $5 AND status = $6
+	`
+
+	// An export failed as stale meanwhile stays failed
+	_, err := r.db.ExecContext(ctx, updateQuery, StatusCompleted, rows, size, time.Now(), publicID, StatusRunning)
+	if err != nil {
+		return fmt.Errorf("complete query export: %w", err)
+	}
+	return nil
+}
+
+func (r *Repo) Fail(ctx context.Context, publicID string, reason string) error {
+	updateQuery := `
+		UPDATE altalune_query_exports
+		SET
+			status = $1,
+			error = $2,
+			updated_at = $3,
+			completed_at = $3
+		WHERE public_id = $4
+	`
+
+	_, err := r.db.ExecContext(ctx, updateQuery, StatusFailed, reason, time.Now(), publicID)
+	if err != nil {
+		return fmt.Errorf("fail query export: %w", err)
+	}
+	return nil
+}
+
+// Release puts a running export back in the pending ones, for a worker to
+// start it over
+func (r *Repo) Release(ctx context.Context, publicID string) error {
+	updateQuery := `
+		UPDATE altalune_query_exports
+		SET
+			status = $1,
+			rows_written = 0,
+			updated_at = $2
+		WHERE public_id = $3 AND status = $4
+	`
+
+	_, err := r.db.ExecContext(ctx, updateQuery, StatusPending, time.Now(), publicID, StatusRunning)
+	if err != nil {
+		return fmt.Errorf("release query export: %w", err)
+	}
+	return nil
+}
+
+// GetFile returns the file of the completed export of a download token,
+// looked up by its hash. It fails with ErrQueryExportNotReady until the file
+// is generated, and ErrQueryExportNotFound once the export failed or expired.
+func (r *Repo) GetFile(ctx context.Context, downloadToken string) (*File, error) {
+	query := `
+		SELECT
+			e.public_id,
+			e.resource,
+			COALESCE(p.public_id, ''),
+			e.status,
+			e.created_at
+		FROM altalune_query_exports e
+		LEFT JOIN altalune_projects p ON p.id = e.project_id
+		WHERE e.download_token_hash = $1 AND e.expires_at > $2
+	`
+
+	var publicID string
+	var resource Resource
+	var projectID string
+	var status Status
+	var createdAt time.Time
+	err := r.db.QueryRowContext(ctx, query, hashToken(downloadToken), time.Now()).Scan(
+		&publicID,
+		&resource,
+		&projectID,
+		&status,
+		&createdAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrQueryExportNotFound
+		}
+		return nil, fmt.Errorf("get query export file: %w", err)
+	}
+	switch status {
+	case StatusPending, StatusRunning:
+		return nil, ErrQueryExportNotReady
+	case StatusFailed:
+		return nil, ErrQueryExportNotFound
+	}
+
+	return &File{
+		Filename: fileName(resource, projectID, createdAt),
+		Key:      fileKey(publicID),
+	}, nil
+}
+
+// FailStale fails the exports left running without progress since before,
+// whose generation stopped with the server running it
+func (r *Repo) FailStale(ctx context.Context, before time.Time) (int64, error) {
+	updateQuery := `
+		UPDATE altalune_query_exports
+		SET
+			status = $1,
+			error = 'The export was interrupted, start it again',
+			updated_at = $2,
+			completed_at = $2
+		WHERE status = $3 AND updated_at < $4
+	`
+
+	result, err := r.db.ExecContext(ctx, updateQuery, StatusFailed, time.Now(), StatusRunning, before)
+	if err != nil {
+		return 0, fmt.Errorf("fail stale query exports: %w", err)
+	}
+	return result.RowsAffected()
+}
+
+// DeleteExpired deletes the exports expired at now, returning the keys of
+// the files of the completed ones
+func (r *Repo) DeleteExpired(ctx context.Context, now time.Time) ([]string, error) {
+	deleteQuery := `
+		DELETE FROM altalune_query_exports
+		WHERE expires_at <= $1
+		RETURNING public_id, status
+	`
+
+	rows, err := r.db.QueryContext(ctx, deleteQuery, now)
+	if err != nil {
+		return nil, fmt.Errorf("delete expired query exports: %w", err)
+	}
+	defer rows.Close()
+
+	keys := make([]string, 0)
+	for rows.Next() {
+		var publicID string
+		var status Status
+		if err := rows.Scan(&publicID, &status); err != nil {
+			return nil, fmt.Errorf("scan expired query export: %w", err)
+		}
+		if status == StatusCompleted {
+			keys = append(keys, fileKey(publicID))
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("delete expired query exports: %w", err)
+	}
+	return keys, nil
+}
+
+func (r *Repo) getByPublicID(ctx context.Context, userID sql.NullInt64, publicID string) (*QueryExport, error) {
+	query := selectExportColumns + `
+		WHERE e.user_id IS NOT DISTINCT FROM $1 AND e.public_id = $2
+			AND e.expires_at > $3
+	`
+
+	e, err := scanQueryExport(r.db.QueryRowContext(ctx, query, userID, publicID, time.Now()))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrQueryExportNotFound
+		}
+		return nil, fmt.Errorf("get query export: %w", err)
+	}
+	return e, nil
+}
+
+// hashToken returns the hex SHA-256 of a download token, the only form of it
+// the database keeps
+func hashToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+// nullID stores the internal ID 0 as NULL
+func nullID(id int64) sql.NullInt64 {
+	return sql.NullInt64{Int64: id, Valid: id != 0}
+}
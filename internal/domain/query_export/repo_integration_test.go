@@ -0,0 +1,85 @@
+package query_export_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hrz8/altalune/internal/domain/query_export"
+	"github.com/hrz8/altalune/internal/testdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) { testdb.Main(m) }
+
+func TestRepoIntegration(t *testing.T) {
+	ctx := context.Background()
+	db := testdb.Tx(t)
+	fixtures := testdb.Seed(t, db)
+	repo := query_export.NewRepo(db)
+
+	export, err := repo.Create(ctx, &query_export.CreateQueryExportInput{
+		UserID:        fixtures.UserID,
+		ProjectID:     fixtures.ProjectID,
+		Resource:      query_export.ResourceApiKeys,
+		Query:         []byte(`{"keyword":"ci"}`),
+		Trashed:       true,
+		DownloadToken: "token-" + fixtures.UserPublicID,
+		ExpiresAt:     time.Now().Add(query_export.Lifetime),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, query_export.StatusPending, export.Status)
+	token := export.DownloadToken
+	assert.Equal(t, query_export.DownloadPath+token, export.ToQueryExportProto().DownloadUrl, "the link is handed out when created")
+
+	var stored string
+	require.NoError(t, db.QueryRowContext(ctx,
+		`SELECT download_token_hash FROM altalune_query_exports WHERE public_id = $1`, export.ID).Scan(&stored))
+	assert.NotContains(t, stored, token, "only the hash of the token is stored")
+
+	_, err = repo.GetByPublicID(ctx, 0, export.ID)
+	assert.ErrorIs(t, err, query_export.ErrQueryExportNotFound, "exports of other users are never found")
+	_, err = repo.GetFile(ctx, token)
+	assert.ErrorIs(t, err, query_export.ErrQueryExportNotReady)
+
+	job, err := repo.Claim(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, export.ID, job.PublicID)
+	assert.Equal(t, fixtures.ProjectID, job.ProjectID)
+	assert.JSONEq(t, `{"keyword":"ci"}`, string(job.Query))
+	assert.True(t, job.Trashed)
+	_, err = repo.Claim(ctx)
+	assert.ErrorIs(t, err, query_export.ErrNoPendingExport, "a claimed export is not claimed twice")
+
+	require.NoError(t, repo.UpdateProgress(ctx, export.ID, 500, 1200))
+	export, err = repo.GetByPublicID(ctx, fixtures.UserID, export.ID)
+	require.NoError(t, err)
+	assert.Equal(t, query_export.StatusRunning, export.Status)
+	assert.Equal(t, int32(1200), export.RowsTotal)
+
+	require.NoError(t, repo.Release(ctx, export.ID))
+	job, err = repo.Claim(ctx)
+	require.NoError(t, err, "a released export is claimed again")
+	assert.Equal(t, export.ID, job.PublicID)
+
+	require.NoError(t, repo.Complete(ctx, export.ID, 1042, 8))
+	export, err = repo.GetByPublicID(ctx, fixtures.UserID, export.ID)
+	require.NoError(t, err)
+	assert.Equal(t, query_export.StatusCompleted, export.Status)
+	assert.Equal(t, int32(1042), export.RowsTotal)
+	assert.Empty(t, export.ToQueryExportProto().DownloadUrl, "the token is not known anymore")
+
+	_, err = repo.GetFile(ctx, "token-unknown")
+	assert.ErrorIs(t, err, query_export.ErrQueryExportNotFound)
+	file, err := repo.GetFile(ctx, token)
+	require.NoError(t, err)
+	assert.Equal(t, export.ID+".csv", file.Key)
+	assert.Contains(t, file.Filename, "api_keys-"+fixtures.ProjectPublicID)
+
+	keys, err := repo.DeleteExpired(ctx, time.Now().Add(query_export.Lifetime+time.Minute))
+	require.NoError(t, err)
+	assert.Contains(t, keys, file.Key)
+	_, err = repo.GetFile(ctx, token)
+	assert.ErrorIs(t, err, query_export.ErrQueryExportNotFound)
+}
@@ -0,0 +1,87 @@
+package query_export
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	api_key_domain "github.com/hrz8/altalune/internal/domain/api_key"
+	user_domain "github.com/hrz8/altalune/internal/domain/user"
+	"github.com/hrz8/altalune/internal/postgres"
+	"github.com/hrz8/altalune/internal/shared/query"
+)
+
+var (
+	userColumns   = []string{"id", "type", "email", "first_name", "last_name", "is_active", "email_verified", "created_at", "updated_at"}
+	apiKeyColumns = []string{"id", "name", "expiration", "active", "owner_id", "external_id", "created_by", "created_at", "updated_at"}
+)
+
+// write writes the rows of the resource of job matching params to out as
+// CSV, with the repository query of its Query endpoint. The pages of
+// project-scoped resources are fetched in the scope of their project.
+func (w *Worker) write(
+	ctx context.Context,
+	job *Job,
+	params *query.QueryParams,
+	out io.Writer,
+	progress func(written, total int32) error,
+) error {
+	switch job.Resource {
+	case ResourceUsers:
+		return query.ExportCSV(params,
+			func(params *query.QueryParams) (*query.QueryResult[user_domain.User], error) {
+				return w.users.Query(ctx, params)
+			},
+			userColumns, userRecord, out, progress,
+		)
+	case ResourceApiKeys:
+		return query.ExportCSV(params,
+			func(params *query.QueryParams) (*query.QueryResult[api_key_domain.ApiKey], error) {
+				var result *query.QueryResult[api_key_domain.ApiKey]
+				err := postgres.WithProject(ctx, w.db, job.ProjectID, func(ctx context.Context) error {
+					var err error
+					result, err = w.apiKeys.Query(ctx, job.ProjectID, params)
+					return err
+				})
+				return result, err
+			},
+			apiKeyColumns, apiKeyRecord, out, progress,
+		)
+	default:
+		return fmt.Errorf("unknown export resource %q", job.Resource)
+	}
+}
+
+func userRecord(u *user_domain.User) []string {
+	return []string{
+		u.ID,
+		string(u.Type),
+		u.Email,
+		u.FirstName,
+		u.LastName,
+		strconv.FormatBool(u.IsActive),
+		strconv.FormatBool(u.EmailVerified),
+		formatTime(u.CreatedAt),
+		formatTime(u.UpdatedAt),
+	}
+}
+
+func apiKeyRecord(k *api_key_domain.ApiKey) []string {
+	return []string{
+		k.ID,
+		k.Name,
+		formatTime(k.Expiration),
+		strconv.FormatBool(k.Active),
+		k.OwnerID,
+		k.ExternalID,
+		k.CreatedBy,
+		formatTime(k.CreatedAt),
+		formatTime(k.UpdatedAt),
+	}
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
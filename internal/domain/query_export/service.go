@@ -0,0 +1,181 @@
+package query_export
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"buf.build/go/protovalidate"
+	"github.com/hrz8/altalune"
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	"github.com/hrz8/altalune/internal/auth"
+	project_domain "github.com/hrz8/altalune/internal/domain/project"
+	user_domain "github.com/hrz8/altalune/internal/domain/user"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+type Service struct {
+	altalunev1.UnimplementedQueryExportServiceServer
+	validator   protovalidate.Validator
+	log         altalune.Logger
+	projectRepo project_domain.Repositor
+	userRepo    user_domain.Repository
+	exportRepo  Repositor
+	worker      *Worker
+}
+
+func NewService(
+	v protovalidate.Validator,
+	log altalune.Logger,
+	projectRepo project_domain.Repositor,
+	userRepo user_domain.Repository,
+	exportRepo Repositor,
+	worker *Worker,
+) *Service {
+	return &Service{
+		validator:   v,
+		log:         log,
+		projectRepo: projectRepo,
+		userRepo:    userRepo,
+		exportRepo:  exportRepo,
+		worker:      worker,
+	}
+}
+
+func (s *Service) ExportQuery(ctx context.Context, req *altalunev1.ExportQueryRequest) (*altalunev1.ExportQueryResponse, error) {
+	// Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	userID, err := s.owner(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := ResourceFromProto(req.Resource)
+	projectID, err := s.resolveProjectID(ctx, resource, req.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	queryRequest := req.GetQuery()
+	if queryRequest == nil {
+		queryRequest = &altalunev1.QueryRequest{}
+	}
+	queryJSON, err := protojson.Marshal(queryRequest)
+	if err != nil {
+		return nil, altalune.NewUnexpectedError("failed to encode export query: %w", err)
+	}
+
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		return nil, altalune.NewUnexpectedError("failed to generate download token: %w", err)
+	}
+
+	export, err := s.exportRepo.Create(ctx, &CreateQueryExportInput{
+		UserID:        userID,
+		ProjectID:     projectID,
+		Resource:      resource,
+		Query:         queryJSON,
+		Trashed:       req.Trashed,
+		DownloadToken: base64.RawURLEncoding.EncodeToString(token),
+		ExpiresAt:     time.Now().Add(Lifetime),
+	})
+	if err != nil {
+		s.log.Error("failed to create query export",
+			"error", err,
+			"user_id", userID,
+			"resource", resource,
+		)
+		return nil, altalune.NewUnexpectedError("failed to create query export: %w", err)
+	}
+
+	// A worker generates the file, right away unless they are all busy
+	s.worker.Notify()
+
+	// Log the export for audit purposes
+	s.log.Info("query export started",
+		"user_id", userID,
+		"export_id", export.ID,
+		"resource", resource,
+		"project_id", projectID,
+	)
+
+	return &altalunev1.ExportQueryResponse{
+		Export:  export.ToQueryExportProto(),
+		Message: "Export started",
+	}, nil
+}
+
+func (s *Service) GetQueryExport(ctx context.Context, req *altalunev1.GetQueryExportRequest) (*altalunev1.GetQueryExportResponse, error) {
+	// Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	userID, err := s.owner(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	export, err := s.exportRepo.GetByPublicID(ctx, userID, req.ExportId)
+	if err != nil {
+		if err == ErrQueryExportNotFound {
+			return nil, altalune.NewQueryExportNotFoundError(req.ExportId)
+		}
+		s.log.Error("failed to get query export",
+			"error", err,
+			"export_id", req.ExportId,
+		)
+		return nil, altalune.NewUnexpectedError("failed to get query export: %w", err)
+	}
+
+	return &altalunev1.GetQueryExportResponse{
+		Export: export.ToQueryExportProto(),
+	}, nil
+}
+
+// owner resolves the user of the auth context, who alone sees the exports
+// it starts; 0 when authentication is disabled
+func (s *Service) owner(ctx context.Context) (int64, error) {
+	publicID := auth.ActorID(ctx)
+	if publicID == "" {
+		return 0, nil
+	}
+
+	userID, err := s.userRepo.GetIDByPublicID(ctx, publicID)
+	if err != nil {
+		if err == user_domain.ErrUserNotFound {
+			return 0, altalune.NewUserNotFoundError(publicID)
+		}
+		s.log.Error("failed to resolve query export owner",
+			"error", err,
+			"user_public_id", publicID,
+		)
+		return 0, altalune.NewUnexpectedError("failed to resolve query export owner: %w", err)
+	}
+	return userID, nil
+}
+
+// resolveProjectID returns the project of the rows of resource, 0 for global
+// resources whatever publicID is
+func (s *Service) resolveProjectID(ctx context.Context, resource Resource, publicID string) (int64, error) {
+	if !resource.ProjectScoped() {
+		return 0, nil
+	}
+	if publicID == "" {
+		return 0, altalune.NewInvalidPayloadError(fmt.Sprintf("project_id is required to export %s", resource))
+	}
+
+	projectID, err := s.projectRepo.GetIDByPublicID(ctx, publicID)
+	if err != nil {
+		if err == project_domain.ErrProjectNotFound {
+			return 0, altalune.NewProjectNotFound(publicID)
+		}
+		return 0, altalune.NewInvalidPayloadError("invalid project_id")
+	}
+	return projectID, nil
+}
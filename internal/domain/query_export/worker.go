@@ -0,0 +1,235 @@
+package query_export
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/hrz8/altalune"
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	api_key_domain "github.com/hrz8/altalune/internal/domain/api_key"
+	user_domain "github.com/hrz8/altalune/internal/domain/user"
+	"github.com/hrz8/altalune/internal/postgres"
+	"github.com/hrz8/altalune/internal/shared/filestore"
+	"github.com/hrz8/altalune/internal/shared/query"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// pollInterval is how often idle workers look for pending exports they were
+// not notified of, such as the ones started on another server
+const pollInterval = 5 * time.Second
+
+// releaseTimeout bounds putting an interrupted export back on shutdown
+const releaseTimeout = 5 * time.Second
+
+// WorkerOptions bounds the work of the export workers.
+type WorkerOptions struct {
+	// Concurrency is how many exports are generated at once.
+	Concurrency int
+	// MaxRows fails the exports matching more rows.
+	MaxRows int32
+	// MaxBytes fails the exports whose file grows larger.
+	MaxBytes int64
+}
+
+// Worker generates the files of the pending exports in the background, at
+// most Concurrency at once, streaming them to the file store. Exports are
+// claimed from the database, so every server runs a Worker and an export
+// started on one can be generated by any.
+type Worker struct {
+	exports Repositor
+	users   user_domain.Repository
+	apiKeys api_key_domain.Repositor
+	db      postgres.DB
+	files   filestore.Store
+	opts    WorkerOptions
+	log     altalune.Logger
+
+	wake   chan struct{}
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewWorker creates an export worker. db scopes the queries of
+// project-scoped resources to their project.
+func NewWorker(
+	exports Repositor,
+	users user_domain.Repository,
+	apiKeys api_key_domain.Repositor,
+	db postgres.DB,
+	files filestore.Store,
+	opts WorkerOptions,
+	log altalune.Logger,
+) *Worker {
+	return &Worker{
+		exports: exports,
+		users:   users,
+		apiKeys: apiKeys,
+		db:      db,
+		files:   files,
+		opts:    opts,
+		log:     log,
+		wake:    make(chan struct{}, 1),
+	}
+}
+
+// Start runs the workers until ctx is done or Stop is called.
+func (w *Worker) Start(ctx context.Context) {
+	ctx, w.cancel = context.WithCancel(ctx)
+	for range max(w.opts.Concurrency, 1) {
+		w.wg.Add(1)
+		go w.loop(ctx)
+	}
+}
+
+// Stop stops the workers and waits for them to return. The exports they were
+// generating are put back in the pending ones.
+func (w *Worker) Stop() {
+	if w.cancel == nil {
+		return
+	}
+	w.cancel()
+	w.wg.Wait()
+}
+
+// Notify wakes an idle worker up to look for pending exports.
+func (w *Worker) Notify() {
+	select {
+	case w.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (w *Worker) loop(ctx context.Context) {
+	defer w.wg.Done()
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		for w.RunOnce(ctx) {
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-w.wake:
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce claims a pending export and generates its file, reporting whether
+// there was one.
+func (w *Worker) RunOnce(ctx context.Context) bool {
+	job, err := w.exports.Claim(ctx)
+	if err != nil {
+		if !errors.Is(err, ErrNoPendingExport) && ctx.Err() == nil {
+			w.log.Error("failed to claim query export", "error", err)
+		}
+		return false
+	}
+
+	rows, size, err := w.generate(ctx, job)
+	if err == nil {
+		if err = w.exports.Complete(ctx, job.PublicID, rows, size); err == nil {
+			w.log.Info("query export completed",
+				"export_id", job.PublicID,
+				"resource", job.Resource,
+				"rows", rows,
+				"bytes", size,
+			)
+			return true
+		}
+		_ = w.files.Delete(fileKey(job.PublicID))
+	}
+	if ctx.Err() != nil {
+		// Interrupted by the shutdown: another run starts it over
+		releaseCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), releaseTimeout)
+		defer cancel()
+		if err := w.exports.Release(releaseCtx, job.PublicID); err != nil {
+			w.log.Error("failed to release query export",
+				"error", err,
+				"export_id", job.PublicID,
+			)
+		}
+		return false
+	}
+
+	reason := "The export could not be generated"
+	switch {
+	case errors.Is(err, ErrTooManyRows):
+		reason = fmt.Sprintf("The export matches more than %d rows, narrow the filters", w.opts.MaxRows)
+	case errors.Is(err, ErrTooLarge):
+		reason = fmt.Sprintf("The export is larger than %d MB, narrow the filters", w.opts.MaxBytes>>20)
+	default:
+		w.log.Error("failed to generate query export",
+			"error", err,
+			"export_id", job.PublicID,
+			"resource", job.Resource,
+		)
+	}
+	if err := w.exports.Fail(ctx, job.PublicID, reason); err != nil {
+		w.log.Error("failed to record query export failure",
+			"error", err,
+			"export_id", job.PublicID,
+		)
+	}
+	return true
+}
+
+// generate streams the file of job to the file store within the limits of
+// the worker, returning the rows and bytes written. Nothing is stored when it
+// fails.
+func (w *Worker) generate(ctx context.Context, job *Job) (int32, int64, error) {
+	var req altalunev1.QueryRequest
+	if err := protojson.Unmarshal(job.Query, &req); err != nil {
+		return 0, 0, fmt.Errorf("decode export query: %w", err)
+	}
+	params := query.DefaultQueryParams(&req)
+	params.Trashed = job.Trashed
+
+	file, err := w.files.Create(fileKey(job.PublicID))
+	if err != nil {
+		return 0, 0, err
+	}
+	out := &limitedWriter{w: file, max: w.opts.MaxBytes}
+
+	var rows int32
+	progress := func(written, total int32) error {
+		if w.opts.MaxRows > 0 && written > w.opts.MaxRows {
+			return ErrTooManyRows
+		}
+		rows = written
+		return w.exports.UpdateProgress(ctx, job.PublicID, written, total)
+	}
+
+	if err := w.write(ctx, job, params, out, progress); err != nil {
+		_ = file.Abort()
+		return 0, 0, err
+	}
+	if err := file.Commit(); err != nil {
+		return 0, 0, err
+	}
+	return rows, out.n, nil
+}
+
+// limitedWriter fails the writes growing the file over max bytes, unless max
+// is 0
+type limitedWriter struct {
+	w   io.Writer
+	max int64
+	n   int64
+}
+
+func (l *limitedWriter) Write(p []byte) (int, error) {
+	if l.max > 0 && l.n+int64(len(p)) > l.max {
+		return 0, ErrTooLarge
+	}
+	n, err := l.w.Write(p)
+	l.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,165 @@
+package query_export
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+
+	user_domain "github.com/hrz8/altalune/internal/domain/user"
+	"github.com/hrz8/altalune/internal/shared/filestore"
+	"github.com/hrz8/altalune/internal/shared/query"
+	"github.com/hrz8/altalune/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeExports holds a single pending export of the users
+type fakeExports struct {
+	Repositor
+	job      *Job
+	status   Status
+	rows     int32
+	size     int64
+	reason   string
+	progress []int32
+}
+
+func (f *fakeExports) Claim(context.Context) (*Job, error) {
+	if f.status != StatusPending {
+		return nil, ErrNoPendingExport
+	}
+	f.status = StatusRunning
+	return f.job, nil
+}
+
+func (f *fakeExports) UpdateProgress(_ context.Context, _ string, written, _ int32) error {
+	f.progress = append(f.progress, written)
+	return nil
+}
+
+func (f *fakeExports) Complete(_ context.Context, _ string, rows int32, size int64) error {
+	f.status, f.rows, f.size = StatusCompleted, rows, size
+	return nil
+}
+
+func (f *fakeExports) Fail(_ context.Context, _ string, reason string) error {
+	f.status, f.reason = StatusFailed, reason
+	return nil
+}
+
+func (f *fakeExports) Release(context.Context, string) error {
+	f.status = StatusPending
+	return nil
+}
+
+// fakeUsers pages through count users, whose internal IDs are their position
+// from 1, calling cancel once a page is served
+type fakeUsers struct {
+	user_domain.Repository
+	count  int
+	cancel context.CancelFunc
+}
+
+func (f *fakeUsers) Query(ctx context.Context, params *query.QueryParams) (*query.QueryResult[user_domain.User], error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if f.cancel != nil {
+		f.cancel()
+	}
+	from := params.FetchOffset()
+	if params.Keyset != nil {
+		from += int32(params.Keyset.AfterID)
+	}
+	to := min(from+params.Pagination.PageSize, int32(f.count))
+	result := &query.QueryResult[user_domain.User]{
+		TotalRows:  int32(f.count),
+		TotalPages: (int32(f.count) + params.Pagination.PageSize - 1) / params.Pagination.PageSize,
+		HasMore:    to < int32(f.count),
+		NextKeyset: &query.Keyset{AfterID: int64(to)},
+	}
+	for i := from; i < to; i++ {
+		result.Data = append(result.Data, &user_domain.User{ID: fmt.Sprintf("usr_%d", i), Email: fmt.Sprintf("user%d@example.com", i)})
+	}
+	return result, nil
+}
+
+func newTestWorker(t *testing.T, users *fakeUsers, opts WorkerOptions) (*Worker, *fakeExports, filestore.Store) {
+	t.Helper()
+	exports := &fakeExports{
+		job:    &Job{PublicID: "exp_1", Resource: ResourceUsers, Query: []byte(`{}`)},
+		status: StatusPending,
+	}
+	files := filestore.NewDisk(t.TempDir())
+	return NewWorker(exports, users, nil, nil, files, opts, logger.New("error")), exports, files
+}
+
+func TestWorkerRunOnce(t *testing.T) {
+	worker, exports, files := newTestWorker(t, &fakeUsers{count: query.ExportPageSize + 1}, WorkerOptions{})
+
+	assert.True(t, worker.RunOnce(context.Background()))
+	assert.Equal(t, StatusCompleted, exports.status)
+	assert.Equal(t, int32(query.ExportPageSize+1), exports.rows)
+	assert.Equal(t, []int32{query.ExportPageSize, query.ExportPageSize + 1}, exports.progress)
+
+	f, err := files.Open(fileKey("exp_1"))
+	require.NoError(t, err)
+	defer f.Close()
+	content, err := io.ReadAll(f)
+	require.NoError(t, err)
+	assert.Equal(t, exports.size, int64(len(content)))
+	assert.Contains(t, string(content), "usr_0,,user0@example.com")
+
+	assert.False(t, worker.RunOnce(context.Background()), "there is no export left")
+}
+
+func TestWorkerRunOnceLimits(t *testing.T) {
+	tests := []struct {
+		name   string
+		opts   WorkerOptions
+		reason string
+	}{
+		{
+			name:   "too many rows",
+			opts:   WorkerOptions{MaxRows: 10},
+			reason: "The export matches more than 10 rows, narrow the filters",
+		},
+		{
+			name:   "too large",
+			opts:   WorkerOptions{MaxBytes: 1 << 20},
+			reason: "The export is larger than 1 MB, narrow the filters",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			worker, exports, files := newTestWorker(t, &fakeUsers{count: 50000}, tt.opts)
+
+			assert.True(t, worker.RunOnce(context.Background()))
+			assert.Equal(t, StatusFailed, exports.status)
+			assert.Equal(t, tt.reason, exports.reason)
+			_, err := files.Open(fileKey("exp_1"))
+			assert.ErrorIs(t, err, filestore.ErrNotFound, "nothing is stored for a failed export")
+		})
+	}
+}
+
+func TestWorkerRunOnceReleasesOnShutdown(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	worker, exports, files := newTestWorker(t, &fakeUsers{count: query.ExportPageSize + 1, cancel: cancel}, WorkerOptions{})
+
+	worker.RunOnce(ctx)
+	assert.Equal(t, StatusPending, exports.status, "an interrupted export is put back")
+	_, err := files.Open(fileKey("exp_1"))
+	assert.ErrorIs(t, err, filestore.ErrNotFound)
+}
+
+func TestWorkerStartStop(t *testing.T) {
+	worker, exports, _ := newTestWorker(t, &fakeUsers{}, WorkerOptions{Concurrency: 2})
+	exports.status = StatusCompleted
+
+	worker.Start(context.Background())
+	worker.Notify()
+	worker.Stop()
+}
@@ -3,9 +3,12 @@ package role
 import "errors"
 
 var (
-	ErrRoleNotFound      = errors.New("role not found")
-	ErrRoleAlreadyExists = errors.New("role with this name already exists")
-	ErrRoleInvalidName   = errors.New("invalid role name")
-	ErrRoleInUse         = errors.New("role is in use and cannot be deleted")
-	ErrRoleProtected     = errors.New("role is protected and cannot be deleted or modified")
+	ErrRoleNotFound        = errors.New("role not found")
+	ErrRoleVersionConflict = errors.New("role was modified concurrently")
+	ErrRoleAlreadyExists   = errors.New("role with this name already exists")
+	ErrRoleInvalidName     = errors.New("invalid role name")
+	ErrRoleInUse           = errors.New("role is in use and cannot be deleted")
+	ErrRoleProtected       = errors.New("role is protected and cannot be deleted or modified")
+	ErrExternalIDTaken     = errors.New("external id already identifies another role")
+	ErrRoleAlreadyManaged  = errors.New("role is already managed under another external id")
 )
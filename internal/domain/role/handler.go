@@ -97,3 +97,35 @@ func (h *Handler) DeleteRole(
 	}
 	return connect.NewResponse(response), nil
 }
+
+func (h *Handler) ApplyRole(
+	ctx context.Context,
+	req *connect.Request[altalunev1.ApplyRoleRequest],
+) (*connect.Response[altalunev1.ApplyRoleResponse], error) {
+	// Authorization: requires role:write permission (global)
+	if err := h.auth.CheckPermission(ctx, "role:write"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.ApplyRole(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (h *Handler) ImportRole(
+	ctx context.Context,
+	req *connect.Request[altalunev1.ImportRoleRequest],
+) (*connect.Response[altalunev1.ImportRoleResponse], error) {
+	// Authorization: requires role:write permission (global)
+	if err := h.auth.CheckPermission(ctx, "role:write"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.ImportRole(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
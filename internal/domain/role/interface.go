@@ -13,6 +13,8 @@ type Repository interface {
 	Create(ctx context.Context, input *CreateRoleInput) (*CreateRoleResult, error)
 	GetByName(ctx context.Context, name string) (*Role, error)
 	GetByID(ctx context.Context, publicID string) (*Role, error)
+	GetByExternalID(ctx context.Context, externalID string) (*Role, error)
+	SetExternalID(ctx context.Context, publicID, externalID string) (*Role, error)
 	Update(ctx context.Context, input *UpdateRoleInput) (*UpdateRoleResult, error)
 	Delete(ctx context.Context, publicID string) error
 }
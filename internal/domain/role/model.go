@@ -14,6 +14,9 @@ type Role struct {
 	Description string // Optional
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	CreatedBy   string // Public ID of the creator, empty if unknown
+	UpdatedBy   string // Public ID of the last updater, empty if unknown
+	ExternalID  string // Key in a provisioning tool, empty if unmanaged
 }
 
 func (m *Role) ToRoleProto() *altalunev1.Role {
@@ -23,6 +26,9 @@ func (m *Role) ToRoleProto() *altalunev1.Role {
 		Description: m.Description,
 		CreatedAt:   timestamppb.New(m.CreatedAt),
 		UpdatedAt:   timestamppb.New(m.UpdatedAt),
+		CreatedBy:   m.CreatedBy,
+		UpdatedBy:   m.UpdatedBy,
+		ExternalId:  m.ExternalID,
 	}
 }
 
@@ -34,6 +40,9 @@ type RoleQueryResult struct {
 	Description string
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	CreatedBy   string // Public ID of the creator, empty if unknown
+	UpdatedBy   string // Public ID of the last updater, empty if unknown
+	ExternalID  string // Key in a provisioning tool, empty if unmanaged
 }
 
 func (r *RoleQueryResult) ToRole() *Role {
@@ -43,6 +52,9 @@ func (r *RoleQueryResult) ToRole() *Role {
 		Description: r.Description,
 		CreatedAt:   r.CreatedAt,
 		UpdatedAt:   r.UpdatedAt,
+		CreatedBy:   r.CreatedBy,
+		UpdatedBy:   r.UpdatedBy,
+		ExternalID:  r.ExternalID,
 	}
 }
 
@@ -50,6 +62,7 @@ func (r *RoleQueryResult) ToRole() *Role {
 type CreateRoleInput struct {
 	Name        string
 	Description string
+	ExternalID  string // Key in a provisioning tool, empty if unmanaged
 }
 
 // CreateRoleResult represents the result of creating a role
@@ -60,6 +73,9 @@ type CreateRoleResult struct {
 	Description string
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	CreatedBy   string // Public ID of the creator, empty if unknown
+	UpdatedBy   string // Public ID of the last updater, empty if unknown
+	ExternalID  string // Key in a provisioning tool, empty if unmanaged
 }
 
 func (r *CreateRoleResult) ToRole() *Role {
@@ -69,15 +85,19 @@ func (r *CreateRoleResult) ToRole() *Role {
 		Description: r.Description,
 		CreatedAt:   r.CreatedAt,
 		UpdatedAt:   r.UpdatedAt,
+		CreatedBy:   r.CreatedBy,
+		UpdatedBy:   r.UpdatedBy,
+		ExternalID:  r.ExternalID,
 	}
 }
 
 // UpdateRoleInput contains data for updating a role
 type UpdateRoleInput struct {
-	ID          int64  // Internal ID
-	PublicID    string // Public ID
-	Name        string
-	Description string
+	ID                int64  // Internal ID
+	PublicID          string // Public ID
+	Name              string
+	Description       string
+	ExpectedUpdatedAt *time.Time // Reject the update unless updated_at still matches; nil to skip
 }
 
 // UpdateRoleResult represents the result of updating a role
@@ -88,6 +108,9 @@ type UpdateRoleResult struct {
 	Description string
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	CreatedBy   string // Public ID of the creator, empty if unknown
+	UpdatedBy   string // Public ID of the last updater, empty if unknown
+	ExternalID  string // Key in a provisioning tool, empty if unmanaged
 }
 
 func (r *UpdateRoleResult) ToRole() *Role {
@@ -97,5 +120,8 @@ func (r *UpdateRoleResult) ToRole() *Role {
 		Description: r.Description,
 		CreatedAt:   r.CreatedAt,
 		UpdatedAt:   r.UpdatedAt,
+		CreatedBy:   r.CreatedBy,
+		UpdatedBy:   r.UpdatedBy,
+		ExternalID:  r.ExternalID,
 	}
 }
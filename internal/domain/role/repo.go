@@ -8,8 +8,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hrz8/altalune/internal/auth"
 	"github.com/hrz8/altalune/internal/postgres"
-	"github.com/hrz8/altalune/internal/shared/nanoid"
 	"github.com/hrz8/altalune/internal/shared/query"
 )
 
@@ -62,6 +62,7 @@ func (r *Repo) GetInternalIDByName(ctx context.Context, name string) (int64, err
 
 func (r *Repo) Query(ctx context.Context, params *query.QueryParams) (*query.QueryResult[Role], error) {
 	// Build the base query - NO project_id filtering
+	sortKey := r.sortKey(params.Sorting)
 	baseQuery := `
 		SELECT
 			id,
@@ -69,7 +70,11 @@ func (r *Repo) Query(ctx context.Context, params *query.QueryParams) (*query.Que
 			name,
 			description,
 			created_at,
-			updated_at
+			updated_at,
+			COALESCE(created_by, ''),
+			COALESCE(updated_by, ''),
+			COALESCE(external_id, ''),
+			` + sortKey.Select() + `
 		FROM altalune_roles
 		WHERE 1=1
 	`
@@ -125,23 +130,24 @@ func (r *Repo) Query(ctx context.Context, params *query.QueryParams) (*query.Que
 	}
 
 	// First, get the total count before pagination
-	countQuery := "SELECT COUNT(*) FROM (" + baseQuery + ") as filtered"
-	var totalRows int32
-	err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&totalRows)
+	count, err := postgres.CountRows(ctx, r.db, params.Count, baseQuery, args)
 	if err != nil {
 		return nil, fmt.Errorf("count roles: %w", err)
 	}
 
+	// Keyset pages start past the last row already fetched
+	if condition, keysetArgs := sortKey.After(params.Keyset, argCounter); condition != "" {
+		baseQuery += " AND " + condition
+		args = append(args, keysetArgs...)
+		argCounter += len(keysetArgs)
+	}
+
 	// Add ORDER BY clause
-	orderClause := r.buildOrderClause(params.Sorting)
-	baseQuery += orderClause
+	baseQuery += sortKey.OrderBy()
 
 	// Add pagination
-	pageSize := params.Pagination.PageSize
-	page := params.Pagination.Page
-	offset := (page - 1) * pageSize
 	baseQuery += fmt.Sprintf(" LIMIT $%d OFFSET $%d", argCounter, argCounter+1)
-	args = append(args, pageSize, offset)
+	args = append(args, params.PageLimit(), params.FetchOffset())
 
 	// Execute the main query
 	rows, err := r.db.QueryContext(ctx, baseQuery, args...)
@@ -152,6 +158,7 @@ func (r *Repo) Query(ctx context.Context, params *query.QueryParams) (*query.Que
 
 	// Collect queryResults
 	queryResults := make([]*RoleQueryResult, 0)
+	var sortValue string
 	for rows.Next() {
 		var role RoleQueryResult
 		var description sql.NullString
@@ -163,6 +170,10 @@ func (r *Repo) Query(ctx context.Context, params *query.QueryParams) (*query.Que
 			&description,
 			&role.CreatedAt,
 			&role.UpdatedAt,
+			&role.CreatedBy,
+			&role.UpdatedBy,
+			&role.ExternalID,
+			&sortValue,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("scan role row: %w", err)
@@ -180,12 +191,6 @@ func (r *Repo) Query(ctx context.Context, params *query.QueryParams) (*query.Que
 		return nil, fmt.Errorf("iterate role rows: %w", err)
 	}
 
-	// Calculate total pages
-	var totalPages int32
-	if totalRows > 0 {
-		totalPages = (totalRows + pageSize - 1) / pageSize
-	}
-
 	// Get distinct values for filters
 	filters, err := r.getDistinctValues(ctx)
 	if err != nil {
@@ -199,17 +204,22 @@ func (r *Repo) Query(ctx context.Context, params *query.QueryParams) (*query.Que
 		results = append(results, v.ToRole())
 	}
 
-	return &query.QueryResult[Role]{
-		Data:       results,
-		TotalRows:  totalRows,
-		TotalPages: totalPages,
-		Filters:    filters,
-	}, nil
+	result := &query.QueryResult[Role]{
+		Data:    results,
+		Filters: filters,
+	}
+	query.FillPage(result, params, count)
+	if n := len(queryResults); n > 0 {
+		result.NextKeyset = &query.Keyset{SortKey: sortValue, AfterID: queryResults[n-1].ID}
+	}
+	return result, nil
 }
 
-func (r *Repo) buildOrderClause(sorting *query.SortingParams) string {
+// sortKey maps the sorting of a query to the order its pages are fetched
+// and resumed in.
+func (r *Repo) sortKey(sorting *query.SortingParams) postgres.SortKey {
 	if sorting == nil || sorting.Field == "" {
-		return " ORDER BY name ASC" // Default sorting
+		return postgres.SortKey{Expr: "name"} // Default sorting
 	}
 
 	// Map field to database column
@@ -218,7 +228,7 @@ func (r *Repo) buildOrderClause(sorting *query.SortingParams) string {
 	case "name":
 		dbColumn = "name"
 	case "description":
-		dbColumn = "description"
+		dbColumn = "COALESCE(description, '')"
 	case "createdAt", "created_at":
 		dbColumn = "created_at"
 	case "updatedAt", "updated_at":
@@ -229,13 +239,7 @@ func (r *Repo) buildOrderClause(sorting *query.SortingParams) string {
 		dbColumn = "name" // Fallback to default
 	}
 
-	// Determine sort direction
-	direction := "ASC"
-	if sorting.Order == query.SortOrderDesc {
-		direction = "DESC"
-	}
-
-	return fmt.Sprintf(" ORDER BY %s %s", dbColumn, direction)
+	return postgres.NewSortKey(dbColumn, sorting)
 }
 
 func (r *Repo) getDistinctValues(ctx context.Context) (map[string][]string, error) {
@@ -249,9 +253,6 @@ func (r *Repo) getDistinctValues(ctx context.Context) (map[string][]string, erro
 
 // Create creates a new role in the database
 func (r *Repo) Create(ctx context.Context, input *CreateRoleInput) (*CreateRoleResult, error) {
-	// Generate public ID
-	publicID, _ := nanoid.GeneratePublicID()
-
 	// Insert query - NO project_id
 	insertQuery := `
 		INSERT INTO altalune_roles (
@@ -259,31 +260,42 @@ func (r *Repo) Create(ctx context.Context, input *CreateRoleInput) (*CreateRoleR
 			name,
 			description,
 			created_at,
-			updated_at
-		) VALUES ($1, $2, $3, $4, $5)
-		RETURNING id, public_id, name, description, created_at, updated_at
+			updated_at,
+			created_by,
+			updated_by,
+			external_id
+		) VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''), NULLIF($6, ''), NULLIF($7, ''))
+		RETURNING id, public_id, name, description, created_at, updated_at,
+		          COALESCE(created_by, ''), COALESCE(updated_by, ''), COALESCE(external_id, '')
 	`
 
 	now := time.Now()
 	var result CreateRoleResult
 	var description sql.NullString
 
-	err := r.db.QueryRowContext(
-		ctx,
-		insertQuery,
-		publicID,
-		input.Name,
-		input.Description,
-		now,
-		now,
-	).Scan(
-		&result.ID,
-		&result.PublicID,
-		&result.Name,
-		&description,
-		&result.CreatedAt,
-		&result.UpdatedAt,
-	)
+	_, err := postgres.InsertWithPublicID(func(publicID string) error {
+		return r.db.QueryRowContext(
+			ctx,
+			insertQuery,
+			publicID,
+			input.Name,
+			input.Description,
+			now,
+			now,
+			auth.ActorID(ctx),
+			input.ExternalID,
+		).Scan(
+			&result.ID,
+			&result.PublicID,
+			&result.Name,
+			&description,
+			&result.CreatedAt,
+			&result.UpdatedAt,
+			&result.CreatedBy,
+			&result.UpdatedBy,
+			&result.ExternalID,
+		)
+	})
 
 	if err != nil {
 		// Check for unique constraint violation
@@ -292,6 +304,9 @@ func (r *Repo) Create(ctx context.Context, input *CreateRoleInput) (*CreateRoleR
 			if strings.Contains(err.Error(), "ux_altalune_roles_name") {
 				return nil, ErrRoleAlreadyExists
 			}
+			if postgres.IsExternalIDViolation(err) {
+				return nil, ErrExternalIDTaken
+			}
 		}
 		return nil, fmt.Errorf("create role: %w", err)
 	}
@@ -312,7 +327,10 @@ func (r *Repo) GetByName(ctx context.Context, name string) (*Role, error) {
 			name,
 			description,
 			created_at,
-			updated_at
+			updated_at,
+			COALESCE(created_by, ''),
+			COALESCE(updated_by, ''),
+			COALESCE(external_id, '')
 		FROM altalune_roles
 		WHERE LOWER(name) = LOWER($1)
 		LIMIT 1
@@ -327,6 +345,9 @@ func (r *Repo) GetByName(ctx context.Context, name string) (*Role, error) {
 		&description,
 		&role.CreatedAt,
 		&role.UpdatedAt,
+		&role.CreatedBy,
+		&role.UpdatedBy,
+		&role.ExternalID,
 	)
 
 	if err != nil {
@@ -352,7 +373,10 @@ func (r *Repo) GetByID(ctx context.Context, publicID string) (*Role, error) {
 			name,
 			description,
 			created_at,
-			updated_at
+			updated_at,
+			COALESCE(created_by, ''),
+			COALESCE(updated_by, ''),
+			COALESCE(external_id, '')
 		FROM altalune_roles
 		WHERE public_id = $1
 	`
@@ -366,6 +390,9 @@ func (r *Repo) GetByID(ctx context.Context, publicID string) (*Role, error) {
 		&description,
 		&role.CreatedAt,
 		&role.UpdatedAt,
+		&role.CreatedBy,
+		&role.UpdatedBy,
+		&role.ExternalID,
 	)
 
 	if err != nil {
@@ -391,27 +418,46 @@ func (r *Repo) Update(ctx context.Context, input *UpdateRoleInput) (*UpdateRoleR
 		return nil, ErrRoleAlreadyExists
 	}
 
+	// The current row is returned flagged as a conflict instead when it
+	// changed since the expected version
 	sqlQuery := `
-		UPDATE altalune_roles
-		SET name = $1, description = $2, updated_at = CURRENT_TIMESTAMP
-		WHERE public_id = $3
-		RETURNING id, public_id, name, description, created_at, updated_at
+		WITH updated AS (
+			UPDATE altalune_roles
+			SET name = $1, description = $2, updated_at = CURRENT_TIMESTAMP, updated_by = NULLIF($5, '')
+			WHERE public_id = $3 AND ($4::timestamptz IS NULL OR updated_at = $4)
+			RETURNING *
+		), result AS (
+			SELECT false AS conflict, updated.* FROM updated
+			UNION ALL
+			SELECT true, r.* FROM altalune_roles r
+			WHERE r.public_id = $3 AND NOT EXISTS (SELECT 1 FROM updated)
+		)
+		SELECT conflict, id, public_id, name, description, created_at, updated_at,
+		       COALESCE(created_by, ''), COALESCE(updated_by, ''), COALESCE(external_id, '')
+		FROM result
 	`
 
 	var result UpdateRoleResult
 	var description sql.NullString
+	var conflict bool
 
 	err = r.db.QueryRowContext(ctx, sqlQuery,
 		input.Name,
 		input.Description,
 		input.PublicID,
+		input.ExpectedUpdatedAt,
+		auth.ActorID(ctx),
 	).Scan(
+		&conflict,
 		&result.ID,
 		&result.PublicID,
 		&result.Name,
 		&description,
 		&result.CreatedAt,
 		&result.UpdatedAt,
+		&result.CreatedBy,
+		&result.UpdatedBy,
+		&result.ExternalID,
 	)
 
 	if err != nil {
@@ -423,6 +469,9 @@ func (r *Repo) Update(ctx context.Context, input *UpdateRoleInput) (*UpdateRoleR
 		}
 		return nil, fmt.Errorf("failed to update role: %w", err)
 	}
+	if conflict {
+		return nil, ErrRoleVersionConflict
+	}
 
 	// Handle nullable description
 	if description.Valid {
@@ -432,6 +481,85 @@ func (r *Repo) Update(ctx context.Context, input *UpdateRoleInput) (*UpdateRoleR
 	return &result, nil
 }
 
+// GetByExternalID retrieves a role by its key in a provisioning tool
+func (r *Repo) GetByExternalID(ctx context.Context, externalID string) (*Role, error) {
+	sqlQuery := `
+		SELECT
+			public_id,
+			name,
+			description,
+			created_at,
+			updated_at,
+			COALESCE(created_by, ''),
+			COALESCE(updated_by, ''),
+			COALESCE(external_id, '')
+		FROM altalune_roles
+		WHERE external_id = $1
+	`
+
+	var role Role
+	var description sql.NullString
+
+	err := r.db.QueryRowContext(ctx, sqlQuery, externalID).Scan(
+		&role.ID,
+		&role.Name,
+		&description,
+		&role.CreatedAt,
+		&role.UpdatedAt,
+		&role.CreatedBy,
+		&role.UpdatedBy,
+		&role.ExternalID,
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrRoleNotFound
+		}
+		return nil, fmt.Errorf("get role by external id: %w", err)
+	}
+
+	// Handle nullable description
+	if description.Valid {
+		role.Description = description.String
+	}
+
+	return &role, nil
+}
+
+// SetExternalID sets the key of a role in a provisioning tool. Setting the
+// key the role already has changes nothing; a role with another key is left
+// as is with ErrRoleAlreadyManaged.
+func (r *Repo) SetExternalID(ctx context.Context, publicID, externalID string) (*Role, error) {
+	sqlQuery := `
+		UPDATE altalune_roles
+		SET external_id = $2, updated_at = CURRENT_TIMESTAMP, updated_by = NULLIF($3, '')
+		WHERE public_id = $1 AND external_id IS NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, sqlQuery, publicID, externalID, auth.ActorID(ctx))
+	if err != nil {
+		if postgres.IsExternalIDViolation(err) {
+			return nil, ErrExternalIDTaken
+		}
+		return nil, fmt.Errorf("set role external id: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	role, err := r.GetByID(ctx, publicID)
+	if err != nil {
+		return nil, err
+	}
+	if rowsAffected == 0 && role.ExternalID != externalID {
+		return role, ErrRoleAlreadyManaged
+	}
+
+	return role, nil
+}
+
 // Delete deletes a role from the database
 func (r *Repo) Delete(ctx context.Context, publicID string) error {
 	sqlQuery := `DELETE FROM altalune_roles WHERE public_id = $1`
@@ -0,0 +1,72 @@
+package role_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hrz8/altalune/internal/auth"
+	"github.com/hrz8/altalune/internal/domain/role"
+	"github.com/hrz8/altalune/internal/testdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) { testdb.Main(m) }
+
+func TestRepoUpdateConflict(t *testing.T) {
+	ctx := context.Background()
+	db := testdb.Tx(t)
+	fixtures := testdb.Seed(t, db)
+	repo := role.NewRepo(db)
+
+	created, err := repo.Create(ctx, &role.CreateRoleInput{Name: "conflict_" + fixtures.ProjectPublicID})
+	require.NoError(t, err)
+
+	stale := created.UpdatedAt.Add(-time.Second)
+	_, err = repo.Update(ctx, &role.UpdateRoleInput{
+		PublicID:          created.PublicID,
+		Name:              created.Name,
+		Description:       "stale",
+		ExpectedUpdatedAt: &stale,
+	})
+	assert.ErrorIs(t, err, role.ErrRoleVersionConflict)
+
+	byID, err := repo.GetByID(ctx, created.PublicID)
+	require.NoError(t, err)
+	assert.Empty(t, byID.Description, "a conflicting update changes nothing")
+
+	updated, err := repo.Update(ctx, &role.UpdateRoleInput{
+		PublicID:          created.PublicID,
+		Name:              created.Name,
+		Description:       "fresh",
+		ExpectedUpdatedAt: &created.UpdatedAt,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "fresh", updated.Description)
+
+	_, err = repo.Update(ctx, &role.UpdateRoleInput{
+		PublicID:          "unknown",
+		Name:              "unknown_" + fixtures.ProjectPublicID,
+		ExpectedUpdatedAt: &updated.UpdatedAt,
+	})
+	assert.ErrorIs(t, err, role.ErrRoleNotFound, "a missing role is not a conflict")
+}
+
+func TestRepoAuditActors(t *testing.T) {
+	ctx := context.Background()
+	db := testdb.Tx(t)
+	fixtures := testdb.Seed(t, db)
+	repo := role.NewRepo(db)
+	as := auth.WithAuthContext(ctx, &auth.AuthContext{UserID: fixtures.UserPublicID, IsAuthenticated: true})
+
+	created, err := repo.Create(as, &role.CreateRoleInput{Name: "audited_" + fixtures.ProjectPublicID})
+	require.NoError(t, err)
+	assert.Equal(t, fixtures.UserPublicID, created.CreatedBy)
+	assert.Equal(t, fixtures.UserPublicID, created.UpdatedBy)
+
+	updated, err := repo.Update(ctx, &role.UpdateRoleInput{PublicID: created.PublicID, Name: created.Name, Description: "anonymous"})
+	require.NoError(t, err)
+	assert.Equal(t, fixtures.UserPublicID, updated.CreatedBy, "the creator is kept")
+	assert.Empty(t, updated.UpdatedBy, "rows written outside of a request have no actor")
+}
@@ -45,6 +45,10 @@ func (s *Service) QueryRoles(ctx context.Context, req *altalunev1.QueryRolesRequ
 	// Convert proto request to domain query params
 	queryParams := query.DefaultQueryParams(req.Query)
 
+	if err := queryParams.ApplyCursor(req.Query.GetCursor()); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
 	// Query roles from repository
 	result, err := s.roleRepo.Query(ctx, queryParams)
 	if err != nil {
@@ -60,9 +64,10 @@ func (s *Service) QueryRoles(ctx context.Context, req *altalunev1.QueryRolesRequ
 		return &altalunev1.QueryRolesResponse{
 			Data: []*altalunev1.Role{},
 			Meta: &altalunev1.QueryMetaResponse{
-				RowCount:  0,
-				PageCount: 0,
-				Filters:   make(map[string]*altalunev1.FilterValues),
+				RowCount:   0,
+				PageCount:  0,
+				ExactTotal: true,
+				Filters:    make(map[string]*altalunev1.FilterValues),
 			},
 		}, nil
 	}
@@ -70,9 +75,13 @@ func (s *Service) QueryRoles(ctx context.Context, req *altalunev1.QueryRolesRequ
 	return &altalunev1.QueryRolesResponse{
 		Data: mapRolesToProto(result.Data),
 		Meta: &altalunev1.QueryMetaResponse{
-			RowCount:  result.TotalRows,
-			PageCount: result.TotalPages,
-			Filters:   mapFiltersToProto(result.Filters),
+			RowCount:    result.TotalRows,
+			PageCount:   result.TotalPages,
+			Filters:     mapFiltersToProto(result.Filters),
+			CountMode:   query.CountModeToProto(result.Count),
+			HasNextPage: result.HasMore,
+			NextCursor:  query.NextCursor(queryParams, result),
+			ExactTotal:  result.ExactTotal(),
 		},
 	}, nil
 }
@@ -103,11 +112,15 @@ func (s *Service) CreateRole(ctx context.Context, req *altalunev1.CreateRoleRequ
 	result, err := s.roleRepo.Create(ctx, &CreateRoleInput{
 		Name:        name,
 		Description: strings.TrimSpace(req.Description),
+		ExternalID:  req.ExternalId,
 	})
 	if err != nil {
 		if err == ErrRoleAlreadyExists {
 			return nil, altalune.NewRoleAlreadyExistsError(name)
 		}
+		if err == ErrExternalIDTaken {
+			return nil, altalune.NewExternalIDTakenError("role", req.ExternalId)
+		}
 		s.log.Error("failed to create role",
 			"error", err,
 			"name", name,
@@ -120,6 +133,7 @@ func (s *Service) CreateRole(ctx context.Context, req *altalunev1.CreateRoleRequ
 			Id:          result.PublicID,
 			Name:        result.Name,
 			Description: result.Description,
+			ExternalId:  result.ExternalID,
 			CreatedAt:   timestamppb.New(result.CreatedAt),
 			UpdatedAt:   timestamppb.New(result.UpdatedAt),
 		},
@@ -169,12 +183,19 @@ func (s *Service) UpdateRole(ctx context.Context, req *altalunev1.UpdateRoleRequ
 		Name:        name,
 		Description: strings.TrimSpace(req.Description),
 	}
+	if req.ExpectedUpdatedAt != nil {
+		expectedUpdatedAt := req.ExpectedUpdatedAt.AsTime()
+		input.ExpectedUpdatedAt = &expectedUpdatedAt
+	}
 
 	result, err := s.roleRepo.Update(ctx, input)
 	if err != nil {
 		if err == ErrRoleNotFound {
 			return nil, altalune.NewRoleNotFoundError(req.Id)
 		}
+		if err == ErrRoleVersionConflict {
+			return nil, altalune.NewVersionConflictError("role", req.Id)
+		}
 		if err == ErrRoleAlreadyExists {
 			return nil, altalune.NewRoleAlreadyExistsError(name)
 		}
@@ -232,3 +253,96 @@ func (s *Service) DeleteRole(ctx context.Context, req *altalunev1.DeleteRoleRequ
 		Message: "Role deleted successfully",
 	}, nil
 }
+
+// ApplyRole creates the role with the external ID, or updates the role that
+// has it when its name or description differ
+func (s *Service) ApplyRole(ctx context.Context, req *altalunev1.ApplyRoleRequest) (*altalunev1.ApplyRoleResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	existing, err := s.roleRepo.GetByExternalID(ctx, req.ExternalId)
+	if err != nil && err != ErrRoleNotFound {
+		s.log.Error("failed to get role by external id", "error", err, "external_id", req.ExternalId)
+		return nil, altalune.NewUnexpectedError("failed to get role by external id: %w", err)
+	}
+
+	if existing == nil {
+		created, err := s.CreateRole(ctx, &altalunev1.CreateRoleRequest{
+			Name:        req.Name,
+			Description: req.Description,
+			ExternalId:  req.ExternalId,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &altalunev1.ApplyRoleResponse{
+			Role:    created.Role,
+			Created: true,
+			Message: created.Message,
+		}, nil
+	}
+
+	name := strings.TrimSpace(req.Name)
+	description := strings.TrimSpace(req.Description)
+	if existing.Name == name && existing.Description == description {
+		return &altalunev1.ApplyRoleResponse{
+			Role:    existing.ToRoleProto(),
+			Message: "Role is up to date",
+		}, nil
+	}
+
+	updated, err := s.UpdateRole(ctx, &altalunev1.UpdateRoleRequest{
+		Id:                existing.ID,
+		Name:              name,
+		Description:       description,
+		ExpectedUpdatedAt: timestamppb.New(existing.UpdatedAt),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &altalunev1.ApplyRoleResponse{
+		Role:    updated.Role,
+		Message: updated.Message,
+	}, nil
+}
+
+// ImportRole sets the external ID of the role with the name, so ApplyRole
+// manages it from then on
+func (s *Service) ImportRole(ctx context.Context, req *altalunev1.ImportRoleRequest) (*altalunev1.ImportRoleResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	name := strings.TrimSpace(req.Name)
+	role, err := s.roleRepo.GetByName(ctx, name)
+	if err != nil {
+		if err == ErrRoleNotFound {
+			return nil, altalune.NewImportTargetNotFoundError("role", name)
+		}
+		s.log.Error("failed to get role by name", "error", err, "name", name)
+		return nil, altalune.NewUnexpectedError("failed to get role by name: %w", err)
+	}
+
+	imported, err := s.roleRepo.SetExternalID(ctx, role.ID, req.ExternalId)
+	if err != nil {
+		if err == ErrRoleNotFound {
+			return nil, altalune.NewImportTargetNotFoundError("role", name)
+		}
+		if err == ErrExternalIDTaken {
+			return nil, altalune.NewExternalIDTakenError("role", req.ExternalId)
+		}
+		if err == ErrRoleAlreadyManaged {
+			return nil, altalune.NewResourceAlreadyManagedError("role", role.Name, imported.ExternalID)
+		}
+		s.log.Error("failed to set role external id", "error", err, "role_id", role.ID)
+		return nil, altalune.NewUnexpectedError("failed to set role external id: %w", err)
+	}
+
+	s.log.Info("role imported", "role_id", imported.ID, "external_id", imported.ExternalID)
+
+	return &altalunev1.ImportRoleResponse{
+		Role:    imported.ToRoleProto(),
+		Message: "Role imported successfully",
+	}, nil
+}
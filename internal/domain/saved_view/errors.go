@@ -0,0 +1,8 @@
+package saved_view
+
+import "errors"
+
+var (
+	ErrSavedViewNotFound      = errors.New("saved view not found")
+	ErrSavedViewAlreadyExists = errors.New("saved view name already exists")
+)
@@ -0,0 +1,92 @@
+package saved_view
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/hrz8/altalune"
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	"github.com/hrz8/altalune/internal/auth"
+)
+
+type Handler struct {
+	svc  altalunev1.SavedViewServiceServer
+	auth *auth.Authorizer
+}
+
+func NewHandler(svc altalunev1.SavedViewServiceServer, authorizer *auth.Authorizer) *Handler {
+	return &Handler{svc: svc, auth: authorizer}
+}
+
+func (h *Handler) ListSavedViews(
+	ctx context.Context,
+	req *connect.Request[altalunev1.ListSavedViewsRequest],
+) (*connect.Response[altalunev1.ListSavedViewsResponse], error) {
+	// Authorization: requires the read permission of the resource, and project
+	// membership for project-scoped resources
+	if err := h.checkResourceAccess(ctx, req.Msg.Resource, req.Msg.ProjectId); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.ListSavedViews(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (h *Handler) CreateSavedView(
+	ctx context.Context,
+	req *connect.Request[altalunev1.CreateSavedViewRequest],
+) (*connect.Response[altalunev1.CreateSavedViewResponse], error) {
+	// Authorization: requires the read permission of the resource, and project
+	// membership for project-scoped resources
+	if err := h.checkResourceAccess(ctx, req.Msg.Resource, req.Msg.ProjectId); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.CreateSavedView(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (h *Handler) UpdateSavedView(
+	ctx context.Context,
+	req *connect.Request[altalunev1.UpdateSavedViewRequest],
+) (*connect.Response[altalunev1.UpdateSavedViewResponse], error) {
+	// Authorization: views are private, the service only finds the caller's
+	response, err := h.svc.UpdateSavedView(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (h *Handler) DeleteSavedView(
+	ctx context.Context,
+	req *connect.Request[altalunev1.DeleteSavedViewRequest],
+) (*connect.Response[altalunev1.DeleteSavedViewResponse], error) {
+	// Authorization: views are private, the service only finds the caller's
+	response, err := h.svc.DeleteSavedView(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+// checkResourceAccess authorizes the caller to see the table of resource, as
+// its Query endpoint would. Unknown resources are left to the validation of
+// the service.
+func (h *Handler) checkResourceAccess(ctx context.Context, resource altalunev1.SavedViewResource, projectID string) error {
+	r := ResourceFromProto(resource)
+	switch {
+	case r.ReadPermission() == "":
+		return nil
+	case r.ProjectScoped():
+		return h.auth.CheckProjectAccess(ctx, r.ReadPermission(), projectID)
+	default:
+		return h.auth.CheckPermission(ctx, r.ReadPermission())
+	}
+}
@@ -0,0 +1,12 @@
+package saved_view
+
+import (
+	"context"
+)
+
+type Repositor interface {
+	List(ctx context.Context, userID int64, resource Resource, projectID int64) ([]*SavedView, error)
+	Create(ctx context.Context, input *CreateSavedViewInput) (*SavedView, error)
+	Update(ctx context.Context, input *UpdateSavedViewInput) (*SavedView, error)
+	Delete(ctx context.Context, input *DeleteSavedViewInput) error
+}
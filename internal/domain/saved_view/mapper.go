@@ -0,0 +1,60 @@
+package saved_view
+
+import (
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	"github.com/hrz8/altalune/internal/shared/query"
+)
+
+// mapSavedViewsToProto converts slice of domain SavedViews to proto SavedViews
+func mapSavedViewsToProto(views []*SavedView) []*altalunev1.SavedView {
+	if views == nil {
+		return make([]*altalunev1.SavedView, 0)
+	}
+
+	result := make([]*altalunev1.SavedView, 0, len(views))
+	for _, v := range views {
+		result = append(result, v.ToSavedViewProto())
+	}
+	return result
+}
+
+// filtersFromProto converts the filters of a request, the way
+// query.DefaultQueryParams reads them
+func filtersFromProto(filters map[string]*altalunev1.StringList) map[string][]string {
+	result := make(map[string][]string, len(filters))
+	for field, list := range filters {
+		result[field] = list.GetValues()
+	}
+	return result
+}
+
+func filtersToProto(filters map[string][]string) map[string]*altalunev1.StringList {
+	result := make(map[string]*altalunev1.StringList, len(filters))
+	for field, values := range filters {
+		result[field] = &altalunev1.StringList{Values: values}
+	}
+	return result
+}
+
+// sortingFromProto converts the sorting of a request, nil when unset
+func sortingFromProto(sorting *altalunev1.Sorting) *query.SortingParams {
+	if sorting == nil {
+		return nil
+	}
+	order := query.SortOrderAsc
+	if sorting.Order == altalunev1.SortOrder_SORT_ORDER_DESC {
+		order = query.SortOrderDesc
+	}
+	return &query.SortingParams{Field: sorting.Field, Order: order}
+}
+
+func sortingToProto(sorting *query.SortingParams) *altalunev1.Sorting {
+	if sorting == nil {
+		return nil
+	}
+	order := altalunev1.SortOrder_SORT_ORDER_ASC
+	if sorting.Order == query.SortOrderDesc {
+		order = altalunev1.SortOrder_SORT_ORDER_DESC
+	}
+	return &altalunev1.Sorting{Field: sorting.Field, Order: order}
+}
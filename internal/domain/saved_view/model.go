@@ -0,0 +1,116 @@
+package saved_view
+
+import (
+	"time"
+
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	"github.com/hrz8/altalune/internal/shared/query"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Resource is the Query endpoint a saved view applies to
+type Resource string
+
+const (
+	ResourceApiKeys   Resource = "api_keys"
+	ResourceUsers     Resource = "users"
+	ResourceEmployees Resource = "employees"
+)
+
+// ResourceFromProto returns the resource of r, empty when unspecified
+func ResourceFromProto(r altalunev1.SavedViewResource) Resource {
+	switch r {
+	case altalunev1.SavedViewResource_SAVED_VIEW_RESOURCE_API_KEYS:
+		return ResourceApiKeys
+	case altalunev1.SavedViewResource_SAVED_VIEW_RESOURCE_USERS:
+		return ResourceUsers
+	case altalunev1.SavedViewResource_SAVED_VIEW_RESOURCE_EMPLOYEES:
+		return ResourceEmployees
+	default:
+		return ""
+	}
+}
+
+func (r Resource) ToProto() altalunev1.SavedViewResource {
+	switch r {
+	case ResourceApiKeys:
+		return altalunev1.SavedViewResource_SAVED_VIEW_RESOURCE_API_KEYS
+	case ResourceUsers:
+		return altalunev1.SavedViewResource_SAVED_VIEW_RESOURCE_USERS
+	case ResourceEmployees:
+		return altalunev1.SavedViewResource_SAVED_VIEW_RESOURCE_EMPLOYEES
+	default:
+		return altalunev1.SavedViewResource_SAVED_VIEW_RESOURCE_UNSPECIFIED
+	}
+}
+
+// ReadPermission returns the permission querying the resource requires, which
+// listing and saving its views require too. It is empty for unknown resources.
+func (r Resource) ReadPermission() string {
+	switch r {
+	case ResourceApiKeys:
+		return "apikey:read"
+	case ResourceUsers:
+		return "user:read"
+	case ResourceEmployees:
+		return "employee:read"
+	default:
+		return ""
+	}
+}
+
+// ProjectScoped reports whether the views of the resource belong to a project
+func (r Resource) ProjectScoped() bool {
+	return r == ResourceApiKeys || r == ResourceEmployees
+}
+
+// SavedView represents the domain model with public IDs only
+type SavedView struct {
+	ID        string // Public nanoid
+	Resource  Resource
+	ProjectID string // Public nanoid, empty for global resources
+	Name      string
+	Keyword   string
+	Filters   map[string][]string
+	Sorting   *query.SortingParams // nil for the default order
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (m *SavedView) ToSavedViewProto() *altalunev1.SavedView {
+	return &altalunev1.SavedView{
+		Id:        m.ID,
+		Resource:  m.Resource.ToProto(),
+		ProjectId: m.ProjectID,
+		Name:      m.Name,
+		Keyword:   m.Keyword,
+		Filters:   filtersToProto(m.Filters),
+		Sorting:   sortingToProto(m.Sorting),
+		CreatedAt: timestamppb.New(m.CreatedAt),
+		UpdatedAt: timestamppb.New(m.UpdatedAt),
+	}
+}
+
+type CreateSavedViewInput struct {
+	UserID    int64
+	ProjectID int64 // 0 for global resources
+	Resource  Resource
+	Name      string
+	Keyword   string
+	Filters   map[string][]string
+	Sorting   *query.SortingParams
+}
+
+type UpdateSavedViewInput struct {
+	UserID   int64
+	PublicID string
+	Name     string
+	Keyword  string
+	Filters  map[string][]string
+	Sorting  *query.SortingParams
+}
+
+type DeleteSavedViewInput struct {
+	UserID   int64
+	PublicID string
+}
@@ -0,0 +1,262 @@
+package saved_view
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hrz8/altalune/internal/postgres"
+	"github.com/hrz8/altalune/internal/shared/query"
+)
+
+type Repo struct {
+	db postgres.DB
+}
+
+func NewRepo(db postgres.DB) *Repo {
+	return &Repo{
+		db: db,
+	}
+}
+
+// selectViewColumns is shared by every query returning a SavedView.
+// The project is exposed by its public ID.
+const selectViewColumns = `
+		SELECT
+			v.public_id,
+			v.resource,
+			COALESCE(p.public_id, ''),
+			v.name,
+			v.keyword,
+			v.filters,
+			v.sort_field,
+			v.sort_order,
+			v.created_at,
+			v.updated_at
+		FROM altalune_saved_views v
+		LEFT JOIN altalune_projects p ON p.id = v.project_id
+`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanSavedView(row rowScanner) (*SavedView, error) {
+	var v SavedView
+	var filters []byte
+	var sortField, sortOrder string
+	err := row.Scan(
+		&v.ID,
+		&v.Resource,
+		&v.ProjectID,
+		&v.Name,
+		&v.Keyword,
+		&filters,
+		&sortField,
+		&sortOrder,
+		&v.CreatedAt,
+		&v.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(filters, &v.Filters); err != nil {
+		return nil, fmt.Errorf("unmarshal saved view filters: %w", err)
+	}
+	if sortField != "" {
+		v.Sorting = &query.SortingParams{Field: sortField, Order: query.SortOrder(sortOrder)}
+	}
+	return &v, nil
+}
+
+func (r *Repo) List(ctx context.Context, userID int64, resource Resource, projectID int64) ([]*SavedView, error) {
+	query := selectViewColumns + `
+		WHERE v.user_id = $1 AND v.resource = $2
+			AND COALESCE(v.project_id, 0) = $3
+		ORDER BY lower(v.name) ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID, resource, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("list saved views: %w", err)
+	}
+	defer rows.Close()
+
+	results := make([]*SavedView, 0)
+	for rows.Next() {
+		v, err := scanSavedView(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan saved view: %w", err)
+		}
+		results = append(results, v)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate saved views: %w", err)
+	}
+
+	return results, nil
+}
+
+func (r *Repo) Create(ctx context.Context, input *CreateSavedViewInput) (*SavedView, error) {
+	filters, err := marshalFilters(input.Filters)
+	if err != nil {
+		return nil, err
+	}
+	sortField, sortOrder := sortColumns(input.Sorting)
+
+	insertQuery := `
+		INSERT INTO altalune_saved_views (
+			public_id,
+			user_id,
+			project_id,
+			resource,
+			name,
+			keyword,
+			filters,
+			sort_field,
+			sort_order,
+			created_at,
+			updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`
+
+	now := time.Now()
+	publicID, err := postgres.InsertWithPublicID(func(publicID string) error {
+		_, err := r.db.ExecContext(
+			ctx,
+			insertQuery,
+			publicID,
+			input.UserID,
+			sql.NullInt64{Int64: input.ProjectID, Valid: input.ProjectID != 0},
+			input.Resource,
+			input.Name,
+			input.Keyword,
+			filters,
+			sortField,
+			sortOrder,
+			now,
+			now,
+		)
+		return err
+	})
+	if err != nil {
+		if postgres.IsUniqueViolation(err) && !postgres.IsPublicIDViolation(err) {
+			return nil, ErrSavedViewAlreadyExists
+		}
+		return nil, fmt.Errorf("create saved view: %w", err)
+	}
+
+	return r.getByPublicID(ctx, input.UserID, publicID)
+}
+
+func (r *Repo) Update(ctx context.Context, input *UpdateSavedViewInput) (*SavedView, error) {
+	filters, err := marshalFilters(input.Filters)
+	if err != nil {
+		return nil, err
+	}
+	sortField, sortOrder := sortColumns(input.Sorting)
+
+	updateQuery := `
+		UPDATE altalune_saved_views
+		SET
+			name = $1,
+			keyword = $2,
+			filters = $3,
+			sort_field = $4,
+			sort_order = $5,
+			updated_at = $6
+		WHERE user_id = $7 AND public_id = $8
+	`
+
+	result, err := r.db.ExecContext(
+		ctx,
+		updateQuery,
+		input.Name,
+		input.Keyword,
+		filters,
+		sortField,
+		sortOrder,
+		time.Now(),
+		input.UserID,
+		input.PublicID,
+	)
+	if err != nil {
+		if postgres.IsUniqueViolation(err) {
+			return nil, ErrSavedViewAlreadyExists
+		}
+		return nil, fmt.Errorf("update saved view: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return nil, ErrSavedViewNotFound
+	}
+
+	return r.getByPublicID(ctx, input.UserID, input.PublicID)
+}
+
+func (r *Repo) Delete(ctx context.Context, input *DeleteSavedViewInput) error {
+	deleteQuery := `
+		DELETE FROM altalune_saved_views
+		WHERE user_id = $1 AND public_id = $2
+	`
+
+	result, err := r.db.ExecContext(ctx, deleteQuery, input.UserID, input.PublicID)
+	if err != nil {
+		return fmt.Errorf("delete saved view: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrSavedViewNotFound
+	}
+
+	return nil
+}
+
+func (r *Repo) getByPublicID(ctx context.Context, userID int64, publicID string) (*SavedView, error) {
+	query := selectViewColumns + `
+		WHERE v.user_id = $1 AND v.public_id = $2
+	`
+
+	v, err := scanSavedView(r.db.QueryRowContext(ctx, query, userID, publicID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrSavedViewNotFound
+		}
+		return nil, fmt.Errorf("get saved view: %w", err)
+	}
+	return v, nil
+}
+
+func marshalFilters(filters map[string][]string) ([]byte, error) {
+	if filters == nil {
+		filters = map[string][]string{}
+	}
+	b, err := json.Marshal(filters)
+	if err != nil {
+		return nil, fmt.Errorf("marshal saved view filters: %w", err)
+	}
+	return b, nil
+}
+
+// sortColumns returns the sort_field and sort_order of sorting, both empty
+// for the default order
+func sortColumns(sorting *query.SortingParams) (string, string) {
+	if sorting == nil || sorting.Field == "" {
+		return "", ""
+	}
+	return sorting.Field, string(sorting.Order)
+}
@@ -0,0 +1,73 @@
+package saved_view_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hrz8/altalune/internal/domain/saved_view"
+	"github.com/hrz8/altalune/internal/shared/query"
+	"github.com/hrz8/altalune/internal/testdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) { testdb.Main(m) }
+
+func TestRepoIntegration(t *testing.T) {
+	ctx := context.Background()
+	db := testdb.Tx(t)
+	fixtures := testdb.Seed(t, db)
+	repo := saved_view.NewRepo(db)
+
+	expiring, err := repo.Create(ctx, &saved_view.CreateSavedViewInput{
+		UserID:    fixtures.UserID,
+		ProjectID: fixtures.ProjectID,
+		Resource:  saved_view.ResourceApiKeys,
+		Name:      "My expiring keys",
+		Filters:   map[string][]string{"status": {"expiring"}},
+		Sorting:   &query.SortingParams{Field: "expiration", Order: query.SortOrderAsc},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, fixtures.ProjectPublicID, expiring.ProjectID)
+	assert.Equal(t, []string{"expiring"}, expiring.Filters["status"])
+
+	_, err = repo.Create(ctx, &saved_view.CreateSavedViewInput{
+		UserID:   fixtures.UserID,
+		Resource: saved_view.ResourceUsers,
+		Name:     "My expiring keys",
+		Keyword:  "ann",
+	})
+	require.NoError(t, err, "names are unique per table")
+
+	views, err := repo.List(ctx, fixtures.UserID, saved_view.ResourceApiKeys, fixtures.ProjectID)
+	require.NoError(t, err)
+	require.Len(t, views, 1)
+	assert.Equal(t, expiring.ID, views[0].ID)
+
+	views, err = repo.List(ctx, fixtures.UserID, saved_view.ResourceUsers, 0)
+	require.NoError(t, err)
+	require.Len(t, views, 1)
+	assert.Empty(t, views[0].ProjectID)
+	assert.Nil(t, views[0].Sorting)
+
+	updated, err := repo.Update(ctx, &saved_view.UpdateSavedViewInput{
+		UserID:   fixtures.UserID,
+		PublicID: expiring.ID,
+		Name:     "Expiring soon",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Expiring soon", updated.Name)
+	assert.Empty(t, updated.Filters)
+
+	err = repo.Delete(ctx, &saved_view.DeleteSavedViewInput{UserID: fixtures.UserID + 1, PublicID: expiring.ID})
+	assert.ErrorIs(t, err, saved_view.ErrSavedViewNotFound, "views of other users are never found")
+	require.NoError(t, repo.Delete(ctx, &saved_view.DeleteSavedViewInput{UserID: fixtures.UserID, PublicID: expiring.ID}))
+
+	// Last, as the violation aborts the transaction
+	_, err = repo.Create(ctx, &saved_view.CreateSavedViewInput{
+		UserID:   fixtures.UserID,
+		Resource: saved_view.ResourceUsers,
+		Name:     "MY EXPIRING KEYS",
+	})
+	assert.ErrorIs(t, err, saved_view.ErrSavedViewAlreadyExists)
+}
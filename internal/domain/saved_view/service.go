@@ -0,0 +1,241 @@
+package saved_view
+
+import (
+	"context"
+	"fmt"
+
+	"buf.build/go/protovalidate"
+	"github.com/hrz8/altalune"
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	"github.com/hrz8/altalune/internal/auth"
+	project_domain "github.com/hrz8/altalune/internal/domain/project"
+	user_domain "github.com/hrz8/altalune/internal/domain/user"
+)
+
+type Service struct {
+	altalunev1.UnimplementedSavedViewServiceServer
+	validator   protovalidate.Validator
+	log         altalune.Logger
+	projectRepo project_domain.Repositor
+	userRepo    user_domain.Repository
+	viewRepo    Repositor
+}
+
+func NewService(v protovalidate.Validator, log altalune.Logger, projectRepo project_domain.Repositor, userRepo user_domain.Repository, viewRepo Repositor) *Service {
+	return &Service{
+		validator:   v,
+		log:         log,
+		projectRepo: projectRepo,
+		userRepo:    userRepo,
+		viewRepo:    viewRepo,
+	}
+}
+
+func (s *Service) ListSavedViews(ctx context.Context, req *altalunev1.ListSavedViewsRequest) (*altalunev1.ListSavedViewsResponse, error) {
+	// Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	userID, err := s.owner(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := ResourceFromProto(req.Resource)
+	projectID, err := s.resolveProjectID(ctx, resource, req.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	views, err := s.viewRepo.List(ctx, userID, resource, projectID)
+	if err != nil {
+		s.log.Error("failed to list saved views",
+			"error", err,
+			"user_id", userID,
+			"resource", resource,
+		)
+		return nil, altalune.NewUnexpectedError("failed to list saved views: %w", err)
+	}
+
+	return &altalunev1.ListSavedViewsResponse{
+		Data: mapSavedViewsToProto(views),
+	}, nil
+}
+
+func (s *Service) CreateSavedView(ctx context.Context, req *altalunev1.CreateSavedViewRequest) (*altalunev1.CreateSavedViewResponse, error) {
+	// Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	userID, err := s.owner(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := ResourceFromProto(req.Resource)
+	projectID, err := s.resolveProjectID(ctx, resource, req.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.viewRepo.Create(ctx, &CreateSavedViewInput{
+		UserID:    userID,
+		ProjectID: projectID,
+		Resource:  resource,
+		Name:      req.Name,
+		Keyword:   req.Keyword,
+		Filters:   filtersFromProto(req.Filters),
+		Sorting:   sortingFromProto(req.Sorting),
+	})
+	if err != nil {
+		if err == ErrSavedViewAlreadyExists {
+			return nil, altalune.NewSavedViewAlreadyExistsError(req.Name)
+		}
+		s.log.Error("failed to create saved view",
+			"error", err,
+			"user_id", userID,
+			"resource", resource,
+		)
+		return nil, altalune.NewUnexpectedError("failed to create saved view: %w", err)
+	}
+
+	// Log successful creation for audit purposes
+	s.log.Info("saved view created",
+		"user_id", userID,
+		"view_id", result.ID,
+		"resource", resource,
+	)
+
+	return &altalunev1.CreateSavedViewResponse{
+		View:    result.ToSavedViewProto(),
+		Message: "View saved successfully",
+	}, nil
+}
+
+func (s *Service) UpdateSavedView(ctx context.Context, req *altalunev1.UpdateSavedViewRequest) (*altalunev1.UpdateSavedViewResponse, error) {
+	// Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	userID, err := s.owner(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.viewRepo.Update(ctx, &UpdateSavedViewInput{
+		UserID:   userID,
+		PublicID: req.ViewId,
+		Name:     req.Name,
+		Keyword:  req.Keyword,
+		Filters:  filtersFromProto(req.Filters),
+		Sorting:  sortingFromProto(req.Sorting),
+	})
+	if err != nil {
+		switch err {
+		case ErrSavedViewNotFound:
+			return nil, altalune.NewSavedViewNotFoundError(req.ViewId)
+		case ErrSavedViewAlreadyExists:
+			return nil, altalune.NewSavedViewAlreadyExistsError(req.Name)
+		}
+		s.log.Error("failed to update saved view",
+			"error", err,
+			"user_id", userID,
+			"view_id", req.ViewId,
+		)
+		return nil, altalune.NewUnexpectedError("failed to update saved view: %w", err)
+	}
+
+	// Log successful update for audit purposes
+	s.log.Info("saved view updated",
+		"user_id", userID,
+		"view_id", result.ID,
+	)
+
+	return &altalunev1.UpdateSavedViewResponse{
+		View:    result.ToSavedViewProto(),
+		Message: "View updated successfully",
+	}, nil
+}
+
+func (s *Service) DeleteSavedView(ctx context.Context, req *altalunev1.DeleteSavedViewRequest) (*altalunev1.DeleteSavedViewResponse, error) {
+	// Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	userID, err := s.owner(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.viewRepo.Delete(ctx, &DeleteSavedViewInput{
+		UserID:   userID,
+		PublicID: req.ViewId,
+	})
+	if err != nil {
+		if err == ErrSavedViewNotFound {
+			return nil, altalune.NewSavedViewNotFoundError(req.ViewId)
+		}
+		s.log.Error("failed to delete saved view",
+			"error", err,
+			"user_id", userID,
+			"view_id", req.ViewId,
+		)
+		return nil, altalune.NewUnexpectedError("failed to delete saved view: %w", err)
+	}
+
+	// Log successful deletion for audit purposes
+	s.log.Info("saved view deleted",
+		"user_id", userID,
+		"view_id", req.ViewId,
+	)
+
+	return &altalunev1.DeleteSavedViewResponse{
+		Message: "View deleted successfully",
+	}, nil
+}
+
+// owner resolves the user of the auth context, who owns the views the
+// service reads and writes. Views of another user are never found.
+func (s *Service) owner(ctx context.Context) (int64, error) {
+	publicID := auth.ActorID(ctx)
+	if publicID == "" {
+		return 0, altalune.NewSavedViewOwnerRequiredError()
+	}
+
+	userID, err := s.userRepo.GetIDByPublicID(ctx, publicID)
+	if err != nil {
+		if err == user_domain.ErrUserNotFound {
+			return 0, altalune.NewSavedViewOwnerRequiredError()
+		}
+		s.log.Error("failed to resolve saved view owner",
+			"error", err,
+			"user_public_id", publicID,
+		)
+		return 0, altalune.NewUnexpectedError("failed to resolve saved view owner: %w", err)
+	}
+	return userID, nil
+}
+
+// resolveProjectID returns the project of the views of resource, 0 for
+// global resources whatever publicID is
+func (s *Service) resolveProjectID(ctx context.Context, resource Resource, publicID string) (int64, error) {
+	if !resource.ProjectScoped() {
+		return 0, nil
+	}
+	if publicID == "" {
+		return 0, altalune.NewInvalidPayloadError(fmt.Sprintf("project_id is required for %s views", resource))
+	}
+
+	projectID, err := s.projectRepo.GetIDByPublicID(ctx, publicID)
+	if err != nil {
+		if err == project_domain.ErrProjectNotFound {
+			return 0, altalune.NewProjectNotFound(publicID)
+		}
+		return 0, altalune.NewInvalidPayloadError("invalid project_id")
+	}
+	return projectID, nil
+}
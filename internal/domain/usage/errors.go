@@ -0,0 +1,7 @@
+package usage
+
+import "errors"
+
+var (
+	ErrProjectNotFound = errors.New("project not found")
+)
@@ -0,0 +1,52 @@
+package usage
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/hrz8/altalune"
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	"github.com/hrz8/altalune/internal/auth"
+)
+
+type Handler struct {
+	svc  altalunev1.UsageServiceServer
+	auth *auth.Authorizer
+}
+
+func NewHandler(svc altalunev1.UsageServiceServer, authorizer *auth.Authorizer) *Handler {
+	return &Handler{svc: svc, auth: authorizer}
+}
+
+func (h *Handler) GetUsage(
+	ctx context.Context,
+	req *connect.Request[altalunev1.GetUsageRequest],
+) (*connect.Response[altalunev1.GetUsageResponse], error) {
+	// Authorization: requires project:read permission and project membership
+	if err := h.auth.CheckProjectAccess(ctx, "project:read", req.Msg.ProjectId); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.GetUsage(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (h *Handler) UpdateUsageQuota(
+	ctx context.Context,
+	req *connect.Request[altalunev1.UpdateUsageQuotaRequest],
+) (*connect.Response[altalunev1.UpdateUsageQuotaResponse], error) {
+	// Authorization: requires usage:write permission (global - quotas are set
+	// by the operators, not by the members of the project)
+	if err := h.auth.CheckPermission(ctx, "usage:write"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.UpdateUsageQuota(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
@@ -0,0 +1,26 @@
+package usage
+
+import (
+	"context"
+	"time"
+)
+
+type Repositor interface {
+	// Increment adds counts to the stored hourly counts
+	Increment(ctx context.Context, counts []*Count) error
+	// QueryHourly returns the hourly counts of a project in [from, to), oldest
+	// hour first, without the hours it made no calls in
+	QueryHourly(ctx context.Context, projectID int64, from, to time.Time) ([]*Bucket, error)
+	// SumByClient totals the calls of a project in [from, to) by OAuth client,
+	// busiest first
+	SumByClient(ctx context.Context, projectID int64, from, to time.Time) ([]*ClientUsage, error)
+	// MonthRequests returns the calls served to a project since monthStart
+	MonthRequests(ctx context.Context, projectID int64, monthStart time.Time) (int64, error)
+	GetQuota(ctx context.Context, projectID int64) (int64, error)
+	SetQuota(ctx context.Context, projectID int64, quota int64) error
+	// ListQuotas returns the projects with a monthly quota, with the calls
+	// served to them since monthStart
+	ListQuotas(ctx context.Context, monthStart time.Time) ([]*Quota, error)
+	// Purge permanently removes the counts of the hours before before
+	Purge(ctx context.Context, before time.Time) (int64, error)
+}
@@ -0,0 +1,31 @@
+package usage
+
+import (
+	"github.com/google/uuid"
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ToUsageBucketProto converts an hour of API calls to its protobuf message
+func (b *Bucket) ToUsageBucketProto() *altalunev1.UsageBucket {
+	return &altalunev1.UsageBucket{
+		Hour:     timestamppb.New(b.Hour),
+		Requests: b.Requests,
+		Rejected: b.Rejected,
+	}
+}
+
+// ToClientUsageProto converts the API calls of a client to its protobuf
+// message, the calls without a client having an empty client_id
+func (c *ClientUsage) ToClientUsageProto() *altalunev1.ClientUsage {
+	var clientID string
+	if c.ClientID != uuid.Nil {
+		clientID = c.ClientID.String()
+	}
+	return &altalunev1.ClientUsage{
+		ClientId:   clientID,
+		ClientName: c.Name,
+		Requests:   c.Requests,
+		Rejected:   c.Rejected,
+	}
+}
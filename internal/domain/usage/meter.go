@@ -0,0 +1,221 @@
+package usage
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hrz8/altalune"
+)
+
+// stopFlushTimeout bounds storing the last counts when the meter stops
+const stopFlushTimeout = 10 * time.Second
+
+type countKey struct {
+	projectID int64
+	clientID  uuid.UUID
+	hour      time.Time
+}
+
+// Meter counts the API calls of the projects in memory and stores the counts
+// every flush interval, so calls do not each write to the database. After
+// every flush it loads the quotas again with the calls stored for this month,
+// so replicas see each other's calls and quota changes within the interval; a
+// project may go over its quota by the calls served in that time.
+type Meter struct {
+	repo     Repositor
+	interval time.Duration
+	log      altalune.Logger
+	now      func() time.Time
+
+	// syncing keeps a flush and a load of the quotas apart, so the loaded
+	// month totals never miss the counts of a flush in progress
+	syncing sync.Mutex
+
+	mu      sync.Mutex
+	pending map[countKey]*Count
+	quotas  map[int64]*Quota // Projects with a quota, by ID
+	month   time.Time        // Start of the month of the calls in quotas
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewMeter creates a meter storing its counts in repo every interval
+func NewMeter(repo Repositor, interval time.Duration, log altalune.Logger) *Meter {
+	return &Meter{
+		repo:     repo,
+		interval: interval,
+		log:      log,
+		now:      time.Now,
+		pending:  make(map[countKey]*Count),
+		quotas:   make(map[int64]*Quota),
+	}
+}
+
+// Allow reports whether the project with the internal ID projectID may make
+// another call this month, with its quota (0 for none) and when it resets
+func (m *Meter) Allow(projectID int64) (allowed bool, quota int64, resetsAt time.Time) {
+	now := m.now()
+	resetsAt = MonthStart(now).AddDate(0, 1, 0)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.rollover(now)
+
+	q, ok := m.quotas[projectID]
+	if !ok {
+		return true, 0, resetsAt
+	}
+	return q.Used < q.MonthlyQuota, q.MonthlyQuota, resetsAt
+}
+
+// Record counts a call of the project made with a token of clientID
+// (uuid.Nil for none), as rejected when it was refused over the quota
+func (m *Meter) Record(projectID int64, clientID uuid.UUID, rejected bool) {
+	now := m.now()
+	key := countKey{projectID: projectID, clientID: clientID, hour: now.UTC().Truncate(time.Hour)}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.rollover(now)
+
+	count, ok := m.pending[key]
+	if !ok {
+		count = &Count{ProjectID: projectID, ClientID: clientID, Hour: key.hour}
+		m.pending[key] = count
+	}
+	if rejected {
+		count.Rejected++
+		return
+	}
+	count.Requests++
+	if q, ok := m.quotas[projectID]; ok {
+		q.Used++
+	}
+}
+
+// rollover resets the calls of the quotas once a new month starts. m.mu must
+// be held.
+func (m *Meter) rollover(now time.Time) {
+	month := MonthStart(now)
+	if !month.After(m.month) {
+		return
+	}
+	m.month = month
+	for _, q := range m.quotas {
+		q.Used = 0
+	}
+}
+
+// Flush stores the counts of the calls recorded since the last flush. Counts
+// failing to be stored are kept for the next one.
+func (m *Meter) Flush(ctx context.Context) error {
+	m.syncing.Lock()
+	defer m.syncing.Unlock()
+
+	m.mu.Lock()
+	counts := make([]*Count, 0, len(m.pending))
+	for _, count := range m.pending {
+		counts = append(counts, count)
+	}
+	m.pending = make(map[countKey]*Count)
+	m.mu.Unlock()
+
+	if err := m.repo.Increment(ctx, counts); err != nil {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		for _, count := range counts {
+			key := countKey{projectID: count.ProjectID, clientID: count.ClientID, hour: count.Hour}
+			if pending, ok := m.pending[key]; ok {
+				pending.Requests += count.Requests
+				pending.Rejected += count.Rejected
+				continue
+			}
+			m.pending[key] = count
+		}
+		return err
+	}
+	return nil
+}
+
+// Refresh loads the quotas again, with the calls of this month stored by
+// every replica and those of this one not stored yet
+func (m *Meter) Refresh(ctx context.Context) error {
+	m.syncing.Lock()
+	defer m.syncing.Unlock()
+
+	month := MonthStart(m.now())
+	quotas, err := m.repo.ListQuotas(ctx, month)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	unstored := make(map[int64]int64)
+	for key, count := range m.pending {
+		if !key.hour.Before(month) {
+			unstored[key.projectID] += count.Requests
+		}
+	}
+	m.month = month
+	m.quotas = make(map[int64]*Quota, len(quotas))
+	for _, q := range quotas {
+		q.Used += unstored[q.ProjectID]
+		m.quotas[q.ProjectID] = q
+	}
+	return nil
+}
+
+// Start loads the quotas right away and then flushes and loads them again
+// every interval, until ctx is done or Stop is called.
+func (m *Meter) Start(ctx context.Context) {
+	ctx, m.cancel = context.WithCancel(ctx)
+	m.done = make(chan struct{})
+
+	go func() {
+		defer close(m.done)
+
+		ticker := time.NewTicker(m.interval)
+		defer ticker.Stop()
+
+		for {
+			m.sync(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop stops the meter and stores the counts not stored yet.
+func (m *Meter) Stop() {
+	if m.cancel == nil {
+		return
+	}
+	m.cancel()
+	<-m.done
+
+	ctx, cancel := context.WithTimeout(context.Background(), stopFlushTimeout)
+	defer cancel()
+	if err := m.Flush(ctx); err != nil {
+		m.log.Error("failed to store api usage", "error", err)
+	}
+}
+
+// sync flushes the counts and loads the quotas again. A failure is logged and
+// retried on the next tick, the meter keeping its last known quotas.
+func (m *Meter) sync(ctx context.Context) {
+	if err := m.Flush(ctx); err != nil && ctx.Err() == nil {
+		m.log.Error("failed to store api usage", "error", err)
+	}
+	if err := m.Refresh(ctx); err != nil && ctx.Err() == nil {
+		m.log.Warn("failed to load api quotas", "error", err)
+	}
+}
@@ -0,0 +1,102 @@
+package usage
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hrz8/altalune/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeRepo struct {
+	Repositor
+	quotas  map[int64]int64
+	stored  []*Count
+	failing bool
+}
+
+func (r *fakeRepo) Increment(ctx context.Context, counts []*Count) error {
+	if r.failing {
+		return errors.New("connection refused")
+	}
+	for _, c := range counts {
+		copied := *c
+		r.stored = append(r.stored, &copied)
+	}
+	return nil
+}
+
+func (r *fakeRepo) ListQuotas(ctx context.Context, monthStart time.Time) ([]*Quota, error) {
+	quotas := make([]*Quota, 0, len(r.quotas))
+	for projectID, quota := range r.quotas {
+		q := &Quota{ProjectID: projectID, MonthlyQuota: quota}
+		for _, c := range r.stored {
+			if c.ProjectID == projectID && !c.Hour.Before(monthStart) {
+				q.Used += c.Requests
+			}
+		}
+		quotas = append(quotas, q)
+	}
+	return quotas, nil
+}
+
+func TestMeterQuota(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeRepo{quotas: map[int64]int64{1: 2}}
+	meter := NewMeter(repo, time.Minute, logger.New("error"))
+	now := time.Date(2026, 3, 31, 23, 30, 0, 0, time.UTC)
+	meter.now = func() time.Time { return now }
+	client := uuid.New()
+
+	require.NoError(t, meter.Refresh(ctx))
+	for range 2 {
+		allowed, _, _ := meter.Allow(1)
+		require.True(t, allowed)
+		meter.Record(1, client, false)
+	}
+	allowed, quota, resetsAt := meter.Allow(1)
+	assert.False(t, allowed)
+	assert.Equal(t, int64(2), quota)
+	assert.Equal(t, time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC), resetsAt)
+	meter.Record(1, client, true)
+
+	allowed, quota, _ = meter.Allow(2)
+	assert.True(t, allowed, "projects without a quota are not limited")
+	assert.Zero(t, quota)
+
+	// Unstored calls still count once the quotas are loaded again
+	require.NoError(t, meter.Refresh(ctx))
+	allowed, _, _ = meter.Allow(1)
+	assert.False(t, allowed)
+
+	require.NoError(t, meter.Flush(ctx))
+	require.Len(t, repo.stored, 1)
+	assert.Equal(t, Count{ProjectID: 1, ClientID: client, Hour: now.Truncate(time.Hour), Requests: 2, Rejected: 1}, *repo.stored[0])
+	require.NoError(t, meter.Refresh(ctx))
+	allowed, _, _ = meter.Allow(1)
+	assert.False(t, allowed)
+
+	// The quota resets with the month
+	now = now.Add(time.Hour)
+	allowed, _, _ = meter.Allow(1)
+	assert.True(t, allowed)
+}
+
+func TestMeterFlushFailure(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeRepo{failing: true}
+	meter := NewMeter(repo, time.Minute, logger.New("error"))
+
+	meter.Record(1, uuid.Nil, false)
+	require.Error(t, meter.Flush(ctx))
+	meter.Record(1, uuid.Nil, false)
+
+	repo.failing = false
+	require.NoError(t, meter.Flush(ctx))
+	require.Len(t, repo.stored, 1)
+	assert.Equal(t, int64(2), repo.stored[0].Requests, "counts failing to be stored are kept")
+}
@@ -0,0 +1,41 @@
+package usage
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Count is the number of API calls of a project made with the tokens of an
+// OAuth client in an hour
+type Count struct {
+	ProjectID int64
+	ClientID  uuid.UUID // OAuth client_id, uuid.Nil for the calls without one
+	Hour      time.Time // Start of the hour, in UTC
+	Requests  int64     // Calls served
+	Rejected  int64     // Calls refused over the monthly quota
+}
+
+// Bucket counts the API calls of a project in an hour
+type Bucket struct {
+	Hour     time.Time // Start of the hour, in UTC
+	Requests int64
+	Rejected int64
+}
+
+// ClientUsage totals the API calls of a project made with the tokens of an
+// OAuth client over a range of hours
+type ClientUsage struct {
+	ClientID uuid.UUID // OAuth client_id, uuid.Nil for the calls without one
+	Name     string    // Empty if the client was purged
+	Requests int64
+	Rejected int64
+}
+
+// Quota is the monthly quota of a project and the calls stored for it since
+// the start of the month
+type Quota struct {
+	ProjectID    int64
+	MonthlyQuota int64
+	Used         int64
+}
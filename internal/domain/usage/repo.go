@@ -0,0 +1,198 @@
+package usage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hrz8/altalune/internal/postgres"
+	"github.com/lib/pq"
+)
+
+type Repo struct {
+	db postgres.DB
+}
+
+func NewRepo(db postgres.DB) *Repo {
+	return &Repo{
+		db: db,
+	}
+}
+
+// Increment adds counts to the stored hourly counts in one statement, the
+// hours passed as Unix seconds as pq cannot encode time arrays. Counts of
+// projects deleted since are dropped rather than failing the whole batch.
+func (r *Repo) Increment(ctx context.Context, counts []*Count) error {
+	if len(counts) == 0 {
+		return nil
+	}
+
+	projectIDs := make([]int64, len(counts))
+	clientIDs := make([]string, len(counts))
+	hours := make([]int64, len(counts))
+	requests := make([]int64, len(counts))
+	rejected := make([]int64, len(counts))
+	for i, c := range counts {
+		projectIDs[i] = c.ProjectID
+		clientIDs[i] = c.ClientID.String()
+		hours[i] = c.Hour.Unix()
+		requests[i] = c.Requests
+		rejected[i] = c.Rejected
+	}
+
+	query := `
+		INSERT INTO altalune_project_api_usage (project_id, client_id, hour, requests, rejected)
+		SELECT u.project_id, u.client_id, to_timestamp(u.hour), u.requests, u.rejected
+		FROM unnest($1::bigint[], $2::uuid[], $3::bigint[], $4::bigint[], $5::bigint[])
+		  AS u(project_id, client_id, hour, requests, rejected)
+		WHERE EXISTS (SELECT 1 FROM altalune_projects p WHERE p.id = u.project_id)
+		ON CONFLICT (project_id, hour, client_id) DO UPDATE
+		SET requests = altalune_project_api_usage.requests + EXCLUDED.requests,
+		    rejected = altalune_project_api_usage.rejected + EXCLUDED.rejected
+	`
+	_, err := r.db.ExecContext(ctx, query,
+		pq.Array(projectIDs), pq.Array(clientIDs), pq.Array(hours), pq.Array(requests), pq.Array(rejected),
+	)
+	if err != nil {
+		return fmt.Errorf("increment api usage: %w", err)
+	}
+	return nil
+}
+
+func (r *Repo) QueryHourly(ctx context.Context, projectID int64, from, to time.Time) ([]*Bucket, error) {
+	query := `
+		SELECT hour, SUM(requests), SUM(rejected)
+		FROM altalune_project_api_usage
+		WHERE project_id = $1 AND hour >= $2 AND hour < $3
+		GROUP BY hour
+		ORDER BY hour
+	`
+	rows, err := r.db.QueryContext(ctx, query, projectID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("query api usage: %w", err)
+	}
+	defer rows.Close()
+
+	buckets := make([]*Bucket, 0)
+	for rows.Next() {
+		var b Bucket
+		if err := rows.Scan(&b.Hour, &b.Requests, &b.Rejected); err != nil {
+			return nil, fmt.Errorf("scan api usage: %w", err)
+		}
+		b.Hour = b.Hour.UTC()
+		buckets = append(buckets, &b)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	return buckets, nil
+}
+
+// SumByClient totals the calls by client. Counts outlive the clients purged
+// from the trash, hence the outer join.
+func (r *Repo) SumByClient(ctx context.Context, projectID int64, from, to time.Time) ([]*ClientUsage, error) {
+	query := `
+		SELECT u.client_id, COALESCE(c.name, ''), SUM(u.requests), SUM(u.rejected)
+		FROM altalune_project_api_usage u
+		LEFT JOIN altalune_oauth_clients c ON c.client_id = u.client_id
+		WHERE u.project_id = $1 AND u.hour >= $2 AND u.hour < $3
+		GROUP BY u.client_id, c.name
+		ORDER BY SUM(u.requests) + SUM(u.rejected) DESC, u.client_id
+	`
+	rows, err := r.db.QueryContext(ctx, query, projectID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("sum api usage: %w", err)
+	}
+	defer rows.Close()
+
+	clients := make([]*ClientUsage, 0)
+	for rows.Next() {
+		var c ClientUsage
+		if err := rows.Scan(&c.ClientID, &c.Name, &c.Requests, &c.Rejected); err != nil {
+			return nil, fmt.Errorf("scan api usage: %w", err)
+		}
+		clients = append(clients, &c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	return clients, nil
+}
+
+func (r *Repo) MonthRequests(ctx context.Context, projectID int64, monthStart time.Time) (int64, error) {
+	query := `
+		SELECT COALESCE(SUM(requests), 0)
+		FROM altalune_project_api_usage
+		WHERE project_id = $1 AND hour >= $2
+	`
+	var requests int64
+	if err := r.db.QueryRowContext(ctx, query, projectID, monthStart).Scan(&requests); err != nil {
+		return 0, fmt.Errorf("sum month api usage: %w", err)
+	}
+	return requests, nil
+}
+
+func (r *Repo) GetQuota(ctx context.Context, projectID int64) (int64, error) {
+	var quota int64
+	err := r.db.QueryRowContext(ctx, `SELECT api_monthly_quota FROM altalune_projects WHERE id = $1`, projectID).Scan(&quota)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, ErrProjectNotFound
+	}
+	if err != nil {
+		return 0, fmt.Errorf("get api quota: %w", err)
+	}
+	return quota, nil
+}
+
+func (r *Repo) SetQuota(ctx context.Context, projectID int64, quota int64) error {
+	result, err := r.db.ExecContext(ctx, `UPDATE altalune_projects SET api_monthly_quota = $2 WHERE id = $1`, projectID, quota)
+	if err != nil {
+		return fmt.Errorf("set api quota: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return ErrProjectNotFound
+	}
+	return nil
+}
+
+func (r *Repo) ListQuotas(ctx context.Context, monthStart time.Time) ([]*Quota, error) {
+	query := `
+		SELECT p.id, p.api_monthly_quota, COALESCE(SUM(u.requests), 0)
+		FROM altalune_projects p
+		LEFT JOIN altalune_project_api_usage u ON u.project_id = p.id AND u.hour >= $1
+		WHERE p.api_monthly_quota > 0
+		GROUP BY p.id, p.api_monthly_quota
+	`
+	rows, err := r.db.QueryContext(ctx, query, monthStart)
+	if err != nil {
+		return nil, fmt.Errorf("list api quotas: %w", err)
+	}
+	defer rows.Close()
+
+	quotas := make([]*Quota, 0)
+	for rows.Next() {
+		var q Quota
+		if err := rows.Scan(&q.ProjectID, &q.MonthlyQuota, &q.Used); err != nil {
+			return nil, fmt.Errorf("scan api quota: %w", err)
+		}
+		quotas = append(quotas, &q)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	return quotas, nil
+}
+
+func (r *Repo) Purge(ctx context.Context, before time.Time) (int64, error) {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM altalune_project_api_usage WHERE hour < $1", before)
+	if err != nil {
+		return 0, fmt.Errorf("purge api usage: %w", err)
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,159 @@
+package usage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"buf.build/go/protovalidate"
+	"github.com/hrz8/altalune"
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	project_domain "github.com/hrz8/altalune/internal/domain/project"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type Service struct {
+	altalunev1.UnimplementedUsageServiceServer
+	validator   protovalidate.Validator
+	log         altalune.Logger
+	projectRepo project_domain.Repositor
+	usageRepo   Repositor
+	meter       *Meter
+}
+
+func NewService(v protovalidate.Validator, log altalune.Logger, projectRepo project_domain.Repositor, usageRepo Repositor, meter *Meter) *Service {
+	return &Service{
+		validator:   v,
+		log:         log,
+		projectRepo: projectRepo,
+		usageRepo:   usageRepo,
+		meter:       meter,
+	}
+}
+
+// GetUsage returns the hourly API calls of a project over the last hours, the
+// current one included, with its calls this month against its quota. Counts
+// are stored every usage.flushInterval, so the last minutes may be missing.
+func (s *Service) GetUsage(ctx context.Context, req *altalunev1.GetUsageRequest) (*altalunev1.GetUsageResponse, error) {
+	// 1. Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	projectID, err := s.resolveProjectID(ctx, req.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Query the hours from the oldest charted one to the current one
+	hours := int(req.Hours)
+	if hours == 0 {
+		hours = defaultHours
+	}
+	now := time.Now().UTC()
+	to := now.Truncate(time.Hour).Add(time.Hour)
+	from := to.Add(-time.Duration(hours) * time.Hour)
+	buckets, err := s.usageRepo.QueryHourly(ctx, projectID, from, to)
+	if err != nil {
+		s.log.Error("failed to query api usage",
+			"error", err,
+			"project_id", projectID,
+		)
+		return nil, altalune.NewUnexpectedError("failed to query api usage: %w", err)
+	}
+	clients, err := s.usageRepo.SumByClient(ctx, projectID, from, to)
+	if err != nil {
+		s.log.Error("failed to sum api usage by client",
+			"error", err,
+			"project_id", projectID,
+		)
+		return nil, altalune.NewUnexpectedError("failed to sum api usage by client: %w", err)
+	}
+
+	// 3. Month to date against the quota
+	month := MonthStart(now)
+	monthRequests, err := s.usageRepo.MonthRequests(ctx, projectID, month)
+	if err != nil {
+		s.log.Error("failed to sum month api usage",
+			"error", err,
+			"project_id", projectID,
+		)
+		return nil, altalune.NewUnexpectedError("failed to sum month api usage: %w", err)
+	}
+	quota, err := s.usageRepo.GetQuota(ctx, projectID)
+	if err != nil {
+		s.log.Error("failed to get api quota",
+			"error", err,
+			"project_id", projectID,
+		)
+		return nil, altalune.NewUnexpectedError("failed to get api quota: %w", err)
+	}
+
+	// 4. Chart every hour, the ones without calls included
+	response := &altalunev1.GetUsageResponse{
+		Buckets:       make([]*altalunev1.UsageBucket, 0, hours),
+		Clients:       make([]*altalunev1.ClientUsage, 0, len(clients)),
+		MonthRequests: monthRequests,
+		MonthlyQuota:  quota,
+		QuotaResetsAt: timestamppb.New(month.AddDate(0, 1, 0)),
+		Message:       fmt.Sprintf("API usage of the last %d hours", hours),
+	}
+	for _, bucket := range fillBuckets(buckets, from, hours) {
+		response.Buckets = append(response.Buckets, bucket.ToUsageBucketProto())
+	}
+	for _, client := range clients {
+		response.Clients = append(response.Clients, client.ToClientUsageProto())
+	}
+	return response, nil
+}
+
+// UpdateUsageQuota sets the monthly quota of a project, 0 removing it. This
+// replica enforces it right away, the others within usage.flushInterval.
+func (s *Service) UpdateUsageQuota(ctx context.Context, req *altalunev1.UpdateUsageQuotaRequest) (*altalunev1.UpdateUsageQuotaResponse, error) {
+	// Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	projectID, err := s.resolveProjectID(ctx, req.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.usageRepo.SetQuota(ctx, projectID, req.MonthlyQuota); err != nil {
+		if err == ErrProjectNotFound {
+			return nil, altalune.NewProjectNotFound(req.ProjectId)
+		}
+		s.log.Error("failed to set api quota",
+			"error", err,
+			"project_id", projectID,
+		)
+		return nil, altalune.NewUnexpectedError("failed to set api quota: %w", err)
+	}
+
+	if err := s.meter.Refresh(ctx); err != nil {
+		s.log.Warn("failed to load api quotas", "error", err)
+	}
+
+	// Log successful update for audit purposes
+	s.log.Info("api quota updated",
+		"project_id", projectID,
+		"monthly_quota", req.MonthlyQuota,
+	)
+
+	return &altalunev1.UpdateUsageQuotaResponse{
+		MonthlyQuota: req.MonthlyQuota,
+		Message:      "Usage quota updated successfully",
+	}, nil
+}
+
+func (s *Service) resolveProjectID(ctx context.Context, publicID string) (int64, error) {
+	projectID, err := s.projectRepo.GetIDByPublicID(ctx, publicID)
+	if err != nil {
+		if err == project_domain.ErrProjectNotFound {
+			return 0, altalune.NewProjectNotFound(publicID)
+		}
+		return 0, altalune.NewInvalidPayloadError("invalid project_id")
+	}
+	return projectID, nil
+}
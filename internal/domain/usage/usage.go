@@ -0,0 +1,34 @@
+// Package usage meters the API calls naming a project: hourly counts by
+// OAuth client, and the monthly quotas refusing calls once used up.
+package usage
+
+import "time"
+
+// defaultHours is the number of hours charted when a request leaves it unset
+const defaultHours = 24
+
+// MonthStart returns the start of the calendar month (UTC) of t, when the
+// quotas are reset
+func MonthStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// fillBuckets returns one bucket per hour from the hour of from, taking the
+// counts of the matching buckets of the sparse, oldest first buckets
+func fillBuckets(buckets []*Bucket, from time.Time, hours int) []*Bucket {
+	from = from.UTC().Truncate(time.Hour)
+	filled := make([]*Bucket, hours)
+	next := 0
+	for i := range filled {
+		hour := from.Add(time.Duration(i) * time.Hour)
+		filled[i] = &Bucket{Hour: hour}
+		for next < len(buckets) && buckets[next].Hour.Before(hour) {
+			next++
+		}
+		if next < len(buckets) && buckets[next].Hour.Equal(hour) {
+			filled[i].Requests, filled[i].Rejected = buckets[next].Requests, buckets[next].Rejected
+		}
+	}
+	return filled
+}
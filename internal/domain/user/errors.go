@@ -4,9 +4,13 @@ import "errors"
 
 var (
 	ErrUserNotFound         = errors.New("user not found")
+	ErrUserVersionConflict  = errors.New("user was modified concurrently")
 	ErrUserAlreadyExists    = errors.New("user with this email already exists")
 	ErrUserInvalidEmail     = errors.New("invalid email format")
 	ErrUserAlreadyActive    = errors.New("user is already active")
 	ErrUserAlreadyInactive  = errors.New("user is already inactive")
 	ErrUserCannotDeleteSelf = errors.New("cannot delete your own user account")
+	ErrUserNotPending       = errors.New("user is not awaiting approval")
+	ErrUserNotLocked        = errors.New("user is not locked")
+	ErrMemberLimitReached   = errors.New("project has as many members as its plan allows")
 )
@@ -10,11 +10,11 @@ import (
 )
 
 type Handler struct {
-	svc  altalunev1.UserServiceServer
+	svc  *Service
 	auth *auth.Authorizer
 }
 
-func NewHandler(svc altalunev1.UserServiceServer, authorizer *auth.Authorizer) *Handler {
+func NewHandler(svc *Service, authorizer *auth.Authorizer) *Handler {
 	return &Handler{svc: svc, auth: authorizer}
 }
 
@@ -34,6 +34,22 @@ func (h *Handler) QueryUsers(
 	return connect.NewResponse(response), nil
 }
 
+func (h *Handler) StreamUsers(
+	ctx context.Context,
+	req *connect.Request[altalunev1.StreamUsersRequest],
+	stream *connect.ServerStream[altalunev1.StreamUsersResponse],
+) error {
+	// Authorization: requires user:read permission (global)
+	if err := h.auth.CheckPermission(ctx, "user:read"); err != nil {
+		return err
+	}
+
+	if err := h.svc.StreamUsersTo(ctx, req.Msg, stream.Send); err != nil {
+		return altalune.ToConnectError(err)
+	}
+	return nil
+}
+
 func (h *Handler) CreateUser(
 	ctx context.Context,
 	req *connect.Request[altalunev1.CreateUserRequest],
@@ -50,6 +66,22 @@ func (h *Handler) CreateUser(
 	return connect.NewResponse(response), nil
 }
 
+func (h *Handler) CreateServiceAccount(
+	ctx context.Context,
+	req *connect.Request[altalunev1.CreateServiceAccountRequest],
+) (*connect.Response[altalunev1.CreateServiceAccountResponse], error) {
+	// Authorization: requires user:write permission (global)
+	if err := h.auth.CheckPermission(ctx, "user:write"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.CreateServiceAccount(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
 func (h *Handler) GetUser(
 	ctx context.Context,
 	req *connect.Request[altalunev1.GetUserRequest],
@@ -98,6 +130,22 @@ func (h *Handler) DeleteUser(
 	return connect.NewResponse(response), nil
 }
 
+func (h *Handler) RestoreUser(
+	ctx context.Context,
+	req *connect.Request[altalunev1.RestoreUserRequest],
+) (*connect.Response[altalunev1.RestoreUserResponse], error) {
+	// Authorization: requires user:delete permission (global)
+	if err := h.auth.CheckPermission(ctx, "user:delete"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.RestoreUser(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
 func (h *Handler) ActivateUser(
 	ctx context.Context,
 	req *connect.Request[altalunev1.ActivateUserRequest],
@@ -129,3 +177,131 @@ func (h *Handler) DeactivateUser(
 	}
 	return connect.NewResponse(response), nil
 }
+
+func (h *Handler) QueryPendingUsers(
+	ctx context.Context,
+	req *connect.Request[altalunev1.QueryPendingUsersRequest],
+) (*connect.Response[altalunev1.QueryPendingUsersResponse], error) {
+	// Authorization: requires user:read permission (global)
+	if err := h.auth.CheckPermission(ctx, "user:read"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.QueryPendingUsers(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (h *Handler) ApproveUser(
+	ctx context.Context,
+	req *connect.Request[altalunev1.ApproveUserRequest],
+) (*connect.Response[altalunev1.ApproveUserResponse], error) {
+	// Authorization: requires user:write permission (global)
+	if err := h.auth.CheckPermission(ctx, "user:write"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.ApproveUser(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (h *Handler) RejectUser(
+	ctx context.Context,
+	req *connect.Request[altalunev1.RejectUserRequest],
+) (*connect.Response[altalunev1.RejectUserResponse], error) {
+	// Authorization: requires user:write permission (global)
+	if err := h.auth.CheckPermission(ctx, "user:write"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.RejectUser(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (h *Handler) UnlockUser(
+	ctx context.Context,
+	req *connect.Request[altalunev1.UnlockUserRequest],
+) (*connect.Response[altalunev1.UnlockUserResponse], error) {
+	// Authorization: requires user:write permission (global)
+	if err := h.auth.CheckPermission(ctx, "user:write"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.UnlockUser(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (h *Handler) ListEmailVerificationTokens(
+	ctx context.Context,
+	req *connect.Request[altalunev1.ListEmailVerificationTokensRequest],
+) (*connect.Response[altalunev1.ListEmailVerificationTokensResponse], error) {
+	// Authorization: requires user:read permission (global)
+	if err := h.auth.CheckPermission(ctx, "user:read"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.ListEmailVerificationTokens(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (h *Handler) InvalidateEmailVerificationTokens(
+	ctx context.Context,
+	req *connect.Request[altalunev1.InvalidateEmailVerificationTokensRequest],
+) (*connect.Response[altalunev1.InvalidateEmailVerificationTokensResponse], error) {
+	// Authorization: requires user:write permission (global)
+	if err := h.auth.CheckPermission(ctx, "user:write"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.InvalidateEmailVerificationTokens(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (h *Handler) ForceEmailReverification(
+	ctx context.Context,
+	req *connect.Request[altalunev1.ForceEmailReverificationRequest],
+) (*connect.Response[altalunev1.ForceEmailReverificationResponse], error) {
+	// Authorization: requires user:write permission (global)
+	if err := h.auth.CheckPermission(ctx, "user:write"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.ForceEmailReverification(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (h *Handler) GetEmailVerificationStats(
+	ctx context.Context,
+	req *connect.Request[altalunev1.GetEmailVerificationStatsRequest],
+) (*connect.Response[altalunev1.GetEmailVerificationStatsResponse], error) {
+	// Authorization: requires user:read permission (global)
+	if err := h.auth.CheckPermission(ctx, "user:read"); err != nil {
+		return nil, err
+	}
+
+	response, err := h.svc.GetEmailVerificationStats(ctx, req.Msg)
+	if err != nil {
+		return nil, altalune.ToConnectError(err)
+	}
+	return connect.NewResponse(response), nil
+}
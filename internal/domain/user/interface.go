@@ -2,6 +2,7 @@ package user
 
 import (
 	"context"
+	"time"
 
 	"github.com/hrz8/altalune/internal/shared/query"
 )
@@ -17,15 +18,33 @@ type Repository interface {
 	Update(ctx context.Context, input *UpdateUserInput) (*UpdateUserResult, error)
 	UpdateProfileByInternalID(ctx context.Context, internalID int64, firstName, lastName string) (*User, error)
 	Delete(ctx context.Context, publicID string) error
+	Restore(ctx context.Context, publicID string) (*User, error)
+	PurgeDeleted(ctx context.Context, before time.Time) (int64, error)
 	Activate(ctx context.Context, publicID string) (*User, error)
 	Deactivate(ctx context.Context, publicID string) (*User, error)
 
+	// Approval queue of self-registered users
+	Approve(ctx context.Context, publicID string) (*User, error)
+	Reject(ctx context.Context, publicID string) error
+
+	// Account lockout after failed sign-ins, tracked by the oauth_auth domain
+	Unlock(ctx context.Context, publicID string) (*User, error)
+
+	// Email verification tokens, issued by the oauth_auth domain
+	GetPendingVerificationTokens(ctx context.Context, userID int64) ([]*VerificationToken, error)
+	InvalidateVerificationTokens(ctx context.Context, userID int64) (int64, error)
+	GetVerificationStats(ctx context.Context, since time.Time) (*VerificationStats, error)
+	ResetEmailVerified(ctx context.Context, publicID string) (*User, error)
+
 	// User Identity operations for OAuth authentication
 	GetUserIdentityByProvider(ctx context.Context, provider, providerUserID string) (*UserIdentity, error)
 	GetUserIdentities(ctx context.Context, userID int64) ([]*UserIdentity, error)
 	CreateUserIdentity(ctx context.Context, input *CreateUserIdentityInput) error
 	UpdateUserIdentityLastLogin(ctx context.Context, userID int64, provider string) error
 
-	// Project membership for OAuth user onboarding
-	AddProjectMember(ctx context.Context, projectID, userID int64, role string) error
+	// Project membership for OAuth user onboarding. A new member is refused
+	// with ErrMemberLimitReached once the project has maxMembers (0 being
+	// unlimited).
+	AddProjectMember(ctx context.Context, projectID, userID int64, role string, maxMembers int) error
+	GetProjectOwners(ctx context.Context, projectID int64) ([]*ProjectOwner, error)
 }
@@ -7,22 +7,48 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// UserType tells human users apart from service accounts
+type UserType string
+
+const (
+	UserTypeHuman UserType = "human"
+	// UserTypeServiceAccount users are automation identities: they own API
+	// keys and hold roles and permissions, but have no email and cannot sign in
+	UserTypeServiceAccount UserType = "service_account"
+)
+
+var userTypesToProto = map[UserType]altalunev1.UserType{
+	UserTypeHuman:          altalunev1.UserType_USER_TYPE_HUMAN,
+	UserTypeServiceAccount: altalunev1.UserType_USER_TYPE_SERVICE_ACCOUNT,
+}
+
+func UserTypeToProto(t UserType) altalunev1.UserType {
+	if v, ok := userTypesToProto[t]; ok {
+		return v
+	}
+	return altalunev1.UserType_USER_TYPE_UNSPECIFIED
+}
+
 // User represents a system user with OAuth-only authentication
 type User struct {
-	ID            string // Public nanoid
-	Email         string // Unique, lowercase
-	FirstName     string // Optional
-	LastName      string // Optional
-	AvatarURL     string // Optional, from OAuth provider
-	IsActive      bool   // User activation status
-	EmailVerified bool   // Email verification status
+	ID            string     // Public nanoid
+	Type          UserType   // Human or service account
+	Email         string     // Unique, lowercase; empty for service accounts
+	FirstName     string     // Optional
+	LastName      string     // Optional
+	AvatarURL     string     // Optional, from OAuth provider
+	IsActive      bool       // User activation status
+	EmailVerified bool       // Email verification status
+	LockedUntil   *time.Time // Set only while locked after too many failed sign-ins
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
+	DeletedAt     *time.Time // Set only for trashed users
 }
 
 func (m *User) ToUserProto() *altalunev1.User {
-	return &altalunev1.User{
+	user := &altalunev1.User{
 		Id:            m.ID,
+		Type:          UserTypeToProto(m.Type),
 		Email:         m.Email,
 		FirstName:     m.FirstName,
 		LastName:      m.LastName,
@@ -31,49 +57,67 @@ func (m *User) ToUserProto() *altalunev1.User {
 		CreatedAt:     timestamppb.New(m.CreatedAt),
 		UpdatedAt:     timestamppb.New(m.UpdatedAt),
 	}
+	if m.DeletedAt != nil {
+		user.DeletedAt = timestamppb.New(*m.DeletedAt)
+	}
+	if m.LockedUntil != nil {
+		user.LockedUntil = timestamppb.New(*m.LockedUntil)
+	}
+	return user
 }
 
 // UserQueryResult represents a single user query result
 type UserQueryResult struct {
 	ID            int64  // Internal ID
 	PublicID      string // Public nanoid
+	Type          UserType
 	Email         string
 	FirstName     string
 	LastName      string
 	AvatarURL     string
 	IsActive      bool
 	EmailVerified bool
+	LockedUntil   *time.Time
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
+	DeletedAt     *time.Time
 }
 
 func (r *UserQueryResult) ToUser() *User {
 	return &User{
 		ID:            r.PublicID,
+		Type:          r.Type,
 		Email:         r.Email,
 		FirstName:     r.FirstName,
 		LastName:      r.LastName,
 		AvatarURL:     r.AvatarURL,
 		IsActive:      r.IsActive,
 		EmailVerified: r.EmailVerified,
+		LockedUntil:   r.LockedUntil,
 		CreatedAt:     r.CreatedAt,
 		UpdatedAt:     r.UpdatedAt,
+		DeletedAt:     r.DeletedAt,
 	}
 }
 
 // CreateUserInput contains data for creating a new user
 type CreateUserInput struct {
-	Email     string
+	Type      UserType // If empty, defaults to UserTypeHuman
+	Email     string   // Empty for service accounts
 	FirstName string
 	LastName  string
 	AvatarURL string
 	IsActive  *bool // If nil, defaults to true; allows explicit control for autoActivate feature
+	// PendingApproval queues a self-registered user for approval by the
+	// project owners. Such users are created inactive.
+	PendingApproval bool
 }
 
 // CreateUserResult represents the result of creating a user
 type CreateUserResult struct {
 	ID            int64
 	PublicID      string
+	Type          UserType
 	Email         string
 	FirstName     string
 	LastName      string
@@ -87,6 +131,7 @@ type CreateUserResult struct {
 func (r *CreateUserResult) ToUser() *User {
 	return &User{
 		ID:            r.PublicID,
+		Type:          r.Type,
 		Email:         r.Email,
 		FirstName:     r.FirstName,
 		LastName:      r.LastName,
@@ -100,23 +145,26 @@ func (r *CreateUserResult) ToUser() *User {
 
 // UpdateUserInput contains data for updating a user
 type UpdateUserInput struct {
-	ID        int64  // Internal ID
-	PublicID  string // Public ID
-	Email     string
-	FirstName string
-	LastName  string
+	ID                int64  // Internal ID
+	PublicID          string // Public ID
+	Email             string
+	FirstName         string
+	LastName          string
+	ExpectedUpdatedAt *time.Time // Reject the update unless updated_at still matches; nil to skip
 }
 
 // UpdateUserResult represents the result of updating a user
 type UpdateUserResult struct {
 	ID            int64
 	PublicID      string
+	Type          UserType
 	Email         string
 	FirstName     string
 	LastName      string
 	AvatarURL     string
 	IsActive      bool
 	EmailVerified bool
+	LockedUntil   *time.Time
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
 }
@@ -124,12 +172,14 @@ type UpdateUserResult struct {
 func (r *UpdateUserResult) ToUser() *User {
 	return &User{
 		ID:            r.PublicID,
+		Type:          r.Type,
 		Email:         r.Email,
 		FirstName:     r.FirstName,
 		LastName:      r.LastName,
 		AvatarURL:     r.AvatarURL,
 		IsActive:      r.IsActive,
 		EmailVerified: r.EmailVerified,
+		LockedUntil:   r.LockedUntil,
 		CreatedAt:     r.CreatedAt,
 		UpdatedAt:     r.UpdatedAt,
 	}
@@ -173,3 +223,45 @@ type CreateUserIdentityInput struct {
 	OAuthClientID         *string // UUID of OAuth client (nullable)
 	OriginOAuthClientName *string // Client name at signup time (historical snapshot, nullable)
 }
+
+// VerificationToken represents a pending email verification token of a user.
+// Tokens are issued by the oauth_auth domain, which only stores their hash.
+type VerificationToken struct {
+	ID        int64
+	UserID    int64
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+func (m *VerificationToken) ToVerificationTokenProto() *altalunev1.EmailVerificationToken {
+	return &altalunev1.EmailVerificationToken{
+		ExpiresAt: timestamppb.New(m.ExpiresAt),
+		CreatedAt: timestamppb.New(m.CreatedAt),
+	}
+}
+
+// VerificationStats counts the email verification tokens sent over a period
+// by outcome. Every token is either verified, pending, expired or superseded
+// by a newer token or an administrator.
+type VerificationStats struct {
+	Sent               int64
+	Verified           int64
+	Pending            int64
+	Expired            int64
+	Superseded         int64
+	MedianTimeToVerify time.Duration // Zero when no token was verified
+}
+
+// ConversionRate returns the share of sent tokens that verified an email
+func (m *VerificationStats) ConversionRate() float64 {
+	if m.Sent == 0 {
+		return 0
+	}
+	return float64(m.Verified) / float64(m.Sent)
+}
+
+// ProjectOwner is an owner of a project, notified of registrations awaiting approval
+type ProjectOwner struct {
+	Email     string
+	FirstName string
+}
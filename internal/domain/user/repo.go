@@ -9,7 +9,6 @@ import (
 	"time"
 
 	"github.com/hrz8/altalune/internal/postgres"
-	"github.com/hrz8/altalune/internal/shared/nanoid"
 	"github.com/hrz8/altalune/internal/shared/query"
 )
 
@@ -25,7 +24,7 @@ func (r *Repo) GetIDByPublicID(ctx context.Context, publicID string) (int64, err
 	query := `
 		SELECT id
 		FROM altalune_users
-		WHERE public_id = $1
+		WHERE public_id = $1 AND deleted_at IS NULL
 	`
 
 	var userID int64
@@ -40,7 +39,8 @@ func (r *Repo) GetIDByPublicID(ctx context.Context, publicID string) (int64, err
 	return userID, nil
 }
 
-// GetInternalIDByEmail retrieves the internal user ID by email (case-insensitive)
+// GetInternalIDByEmail retrieves the internal user ID by email (case-insensitive).
+// Trashed users are included, as their emails stay taken until they are purged.
 func (r *Repo) GetInternalIDByEmail(ctx context.Context, email string) (int64, error) {
 	query := `
 		SELECT id
@@ -61,23 +61,23 @@ func (r *Repo) GetInternalIDByEmail(ctx context.Context, email string) (int64, e
 }
 
 func (r *Repo) Query(ctx context.Context, params *query.QueryParams) (*query.QueryResult[User], error) {
-	// Build the base query - NO project_id filtering
+	// Build the base query - NO project_id filtering, only the requested fields
+	var nullString sql.NullString
+	selectList, _ := query.SelectColumns(userColumns(&UserQueryResult{}, &nullString, &nullString, &nullString), params.Fields)
+	sortKey := r.sortKey(params.Sorting)
 	baseQuery := `
-		SELECT
-			id,
-			public_id,
-			email,
-			first_name,
-			last_name,
-			avatar_url,
-			is_active,
-			email_verified,
-			created_at,
-			updated_at
+		SELECT ` + selectList + `, ` + sortKey.Select() + `
 		FROM altalune_users
 		WHERE 1=1
 	`
 
+	// Trashed queries list soft-deleted users only
+	if params.Trashed {
+		baseQuery += " AND deleted_at IS NOT NULL"
+	} else {
+		baseQuery += " AND deleted_at IS NULL"
+	}
+
 	// Build WHERE conditions for filters and search
 	var whereConditions []string
 	var args []interface{}
@@ -108,11 +108,16 @@ func (r *Repo) Query(ctx context.Context, params *query.QueryParams) (*query.Que
 			switch field {
 			case "is_active", "active":
 				dbColumn = "is_active"
+			case "pending_approval":
+				dbColumn = "(pending_approval_since IS NOT NULL)"
 			case "email":
 				dbColumn = "email"
+			case "user_type", "type":
+				dbColumn = "user_type"
 			default:
 				continue // Skip unknown fields
 			}
+			isText := dbColumn == "email" || dbColumn == "user_type"
 
 			// Build IN clause for multiple values
 			placeholders := make([]string, len(values))
@@ -120,7 +125,7 @@ func (r *Repo) Query(ctx context.Context, params *query.QueryParams) (*query.Que
 				placeholders[i] = fmt.Sprintf("$%d", argCounter)
 
 				// For boolean fields, convert string to boolean
-				if dbColumn == "is_active" {
+				if !isText {
 					boolValue := value == "true" || value == "1"
 					args = append(args, boolValue)
 				} else {
@@ -129,7 +134,7 @@ func (r *Repo) Query(ctx context.Context, params *query.QueryParams) (*query.Que
 				argCounter++
 			}
 
-			if dbColumn == "is_active" {
+			if !isText {
 				filterCondition := fmt.Sprintf("%s IN (%s)", dbColumn, strings.Join(placeholders, ","))
 				whereConditions = append(whereConditions, filterCondition)
 			} else {
@@ -145,23 +150,24 @@ func (r *Repo) Query(ctx context.Context, params *query.QueryParams) (*query.Que
 	}
 
 	// First, get the total count before pagination
-	countQuery := "SELECT COUNT(*) FROM (" + baseQuery + ") as filtered"
-	var totalRows int32
-	err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&totalRows)
+	count, err := postgres.CountRows(ctx, r.db, params.Count, baseQuery, args)
 	if err != nil {
 		return nil, fmt.Errorf("count users: %w", err)
 	}
 
+	// Keyset pages start past the last row already fetched
+	if condition, keysetArgs := sortKey.After(params.Keyset, argCounter); condition != "" {
+		baseQuery += " AND " + condition
+		args = append(args, keysetArgs...)
+		argCounter += len(keysetArgs)
+	}
+
 	// Add ORDER BY clause
-	orderClause := r.buildOrderClause(params.Sorting)
-	baseQuery += orderClause
+	baseQuery += sortKey.OrderBy()
 
 	// Add pagination
-	pageSize := params.Pagination.PageSize
-	page := params.Pagination.Page
-	offset := (page - 1) * pageSize
 	baseQuery += fmt.Sprintf(" LIMIT $%d OFFSET $%d", argCounter, argCounter+1)
-	args = append(args, pageSize, offset)
+	args = append(args, params.PageLimit(), params.FetchOffset())
 
 	// Execute the main query
 	rows, err := r.db.QueryContext(ctx, baseQuery, args...)
@@ -172,23 +178,13 @@ func (r *Repo) Query(ctx context.Context, params *query.QueryParams) (*query.Que
 
 	// Collect queryResults
 	queryResults := make([]*UserQueryResult, 0)
+	var sortValue string
 	for rows.Next() {
 		var usr UserQueryResult
 		var firstName, lastName, avatarURL sql.NullString
 
-		err := rows.Scan(
-			&usr.ID,
-			&usr.PublicID,
-			&usr.Email,
-			&firstName,
-			&lastName,
-			&avatarURL,
-			&usr.IsActive,
-			&usr.EmailVerified,
-			&usr.CreatedAt,
-			&usr.UpdatedAt,
-		)
-		if err != nil {
+		_, dests := query.SelectColumns(userColumns(&usr, &firstName, &lastName, &avatarURL), params.Fields)
+		if err := rows.Scan(append(dests, &sortValue)...); err != nil {
 			return nil, fmt.Errorf("scan user row: %w", err)
 		}
 
@@ -210,12 +206,6 @@ func (r *Repo) Query(ctx context.Context, params *query.QueryParams) (*query.Que
 		return nil, fmt.Errorf("iterate user rows: %w", err)
 	}
 
-	// Calculate total pages
-	var totalPages int32
-	if totalRows > 0 {
-		totalPages = (totalRows + pageSize - 1) / pageSize
-	}
-
 	// Get distinct values for filters
 	filters, err := r.getDistinctValues(ctx)
 	if err != nil {
@@ -229,47 +219,69 @@ func (r *Repo) Query(ctx context.Context, params *query.QueryParams) (*query.Que
 		results = append(results, v.ToUser())
 	}
 
-	return &query.QueryResult[User]{
-		Data:       results,
-		TotalRows:  totalRows,
-		TotalPages: totalPages,
-		Filters:    filters,
-	}, nil
+	result := &query.QueryResult[User]{
+		Data:    results,
+		Filters: filters,
+	}
+	query.FillPage(result, params, count)
+	if n := len(queryResults); n > 0 {
+		result.NextKeyset = &query.Keyset{SortKey: sortValue, AfterID: queryResults[n-1].ID}
+	}
+	return result, nil
+}
+
+// userColumns lists the columns Query can select, keyed by the User proto
+// fields they load, scanning into usr and its nullable name and avatar columns.
+// The avatar is not part of the proto and is only loaded without a read mask.
+func userColumns(usr *UserQueryResult, firstName, lastName, avatarURL *sql.NullString) []query.Column {
+	return []query.Column{
+		{SQL: "id", Dest: &usr.ID},
+		{SQL: "public_id", Dest: &usr.PublicID},
+		{Field: "type", SQL: "user_type", Dest: &usr.Type},
+		{Field: "email", SQL: "COALESCE(email, '') AS email", Dest: &usr.Email},
+		{Field: "first_name", SQL: "first_name", Dest: firstName},
+		{Field: "last_name", SQL: "last_name", Dest: lastName},
+		{Field: "avatar_url", SQL: "avatar_url", Dest: avatarURL},
+		{Field: "is_active", SQL: "is_active", Dest: &usr.IsActive},
+		{Field: "email_verified", SQL: "email_verified", Dest: &usr.EmailVerified},
+		{Field: "locked_until", SQL: "CASE WHEN locked_until > NOW() THEN locked_until END AS locked_until", Dest: &usr.LockedUntil},
+		{Field: "created_at", SQL: "created_at", Dest: &usr.CreatedAt},
+		{Field: "updated_at", SQL: "updated_at", Dest: &usr.UpdatedAt},
+		{Field: "deleted_at", SQL: "deleted_at", Dest: &usr.DeletedAt},
+	}
 }
 
-func (r *Repo) buildOrderClause(sorting *query.SortingParams) string {
+// sortKey maps the sorting of a query to the order its pages are fetched
+// and resumed in.
+func (r *Repo) sortKey(sorting *query.SortingParams) postgres.SortKey {
 	if sorting == nil || sorting.Field == "" {
-		return " ORDER BY created_at DESC" // Default sorting
+		return postgres.SortKey{Expr: "created_at", Desc: true} // Default sorting
 	}
 
 	// Map field to database column
 	var dbColumn string
 	switch sorting.Field {
 	case "email":
-		dbColumn = "email"
+		dbColumn = "COALESCE(email, '')"
 	case "firstName", "first_name":
-		dbColumn = "first_name"
+		dbColumn = "COALESCE(first_name, '')"
 	case "lastName", "last_name":
-		dbColumn = "last_name"
+		dbColumn = "COALESCE(last_name, '')"
 	case "isActive", "is_active":
 		dbColumn = "is_active"
 	case "createdAt", "created_at":
 		dbColumn = "created_at"
 	case "updatedAt", "updated_at":
 		dbColumn = "updated_at"
+	case "deletedAt", "deleted_at":
+		dbColumn = "COALESCE(deleted_at, 'infinity')"
 	case "id":
 		dbColumn = "id"
 	default:
 		dbColumn = "created_at" // Fallback to default
 	}
 
-	// Determine sort direction
-	direction := "ASC"
-	if sorting.Order == query.SortOrderDesc {
-		direction = "DESC"
-	}
-
-	return fmt.Sprintf(" ORDER BY %s %s", dbColumn, direction)
+	return postgres.NewSortKey(dbColumn, sorting)
 }
 
 func (r *Repo) getDistinctValues(ctx context.Context) (map[string][]string, error) {
@@ -278,17 +290,22 @@ func (r *Repo) getDistinctValues(ctx context.Context) (map[string][]string, erro
 	// is_active filter (boolean)
 	filters["is_active"] = []string{"true", "false"}
 
+	// user_type filter
+	filters["user_type"] = []string{string(UserTypeHuman), string(UserTypeServiceAccount)}
+
 	return filters, nil
 }
 
 // Create creates a new user in the database
 func (r *Repo) Create(ctx context.Context, input *CreateUserInput) (*CreateUserResult, error) {
-	// Generate public ID
-	publicID, _ := nanoid.GeneratePublicID()
-
 	// Email is already lowercased by service layer, but ensure it here too
 	email := strings.ToLower(input.Email)
 
+	userType := input.Type
+	if userType == "" {
+		userType = UserTypeHuman
+	}
+
 	// Determine is_active value: use input.IsActive if provided, otherwise default to true
 	isActive := true
 	if input.IsActive != nil {
@@ -299,52 +316,57 @@ func (r *Repo) Create(ctx context.Context, input *CreateUserInput) (*CreateUserR
 	insertQuery := `
 		INSERT INTO altalune_users (
 			public_id,
+			user_type,
 			email,
 			first_name,
 			last_name,
 			avatar_url,
 			is_active,
+			pending_approval_since,
 			created_at,
 			updated_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
-		RETURNING id, public_id, email, first_name, last_name, avatar_url, is_active, email_verified, created_at, updated_at
+		) VALUES ($1, $10, NULLIF($2, ''), $3, $4, $5, $6, CASE WHEN $7 THEN $8::timestamptz END, $8, $9)
+		RETURNING id, public_id, user_type, COALESCE(email, ''), first_name, last_name, avatar_url, is_active, email_verified,
+		          created_at, updated_at
 	`
 
 	now := time.Now()
 	var result CreateUserResult
 	var firstName, lastName, avatarURL sql.NullString
 
-	err := r.db.QueryRowContext(
-		ctx,
-		insertQuery,
-		publicID,
-		email,
-		input.FirstName,
-		input.LastName,
-		input.AvatarURL,
-		isActive,
-		now,
-		now,
-	).Scan(
-		&result.ID,
-		&result.PublicID,
-		&result.Email,
-		&firstName,
-		&lastName,
-		&avatarURL,
-		&result.IsActive,
-		&result.EmailVerified,
-		&result.CreatedAt,
-		&result.UpdatedAt,
-	)
+	_, err := postgres.InsertWithPublicID(func(publicID string) error {
+		return r.db.QueryRowContext(
+			ctx,
+			insertQuery,
+			publicID,
+			email,
+			input.FirstName,
+			input.LastName,
+			input.AvatarURL,
+			isActive,
+			input.PendingApproval,
+			now,
+			now,
+			userType,
+		).Scan(
+			&result.ID,
+			&result.PublicID,
+			&result.Type,
+			&result.Email,
+			&firstName,
+			&lastName,
+			&avatarURL,
+			&result.IsActive,
+			&result.EmailVerified,
+			&result.CreatedAt,
+			&result.UpdatedAt,
+		)
+	})
 
 	if err != nil {
-		// Check for unique constraint violation
-		if postgres.IsUniqueViolation(err) {
-			// Check if it's the email constraint
-			if strings.Contains(err.Error(), "ux_altalune_users_email") {
-				return nil, ErrUserAlreadyExists
-			}
+		// The email is the only other unique column
+		if postgres.IsUniqueViolation(err) && !postgres.IsPublicIDViolation(err) {
+			return nil, ErrUserAlreadyExists
 		}
 		return nil, fmt.Errorf("create user: %w", err)
 	}
@@ -363,17 +385,20 @@ func (r *Repo) Create(ctx context.Context, input *CreateUserInput) (*CreateUserR
 	return &result, nil
 }
 
-// GetByEmail retrieves a user by email (case-insensitive)
+// GetByEmail retrieves a user by email (case-insensitive). Trashed users are
+// included, as their emails stay taken until they are purged.
 func (r *Repo) GetByEmail(ctx context.Context, email string) (*User, error) {
 	query := `
 		SELECT
 			public_id,
-			email,
+			user_type,
+			COALESCE(email, '') AS email,
 			first_name,
 			last_name,
 			avatar_url,
 			is_active,
 			email_verified,
+			CASE WHEN locked_until > NOW() THEN locked_until END AS locked_until,
 			created_at,
 			updated_at
 		FROM altalune_users
@@ -386,12 +411,14 @@ func (r *Repo) GetByEmail(ctx context.Context, email string) (*User, error) {
 
 	err := r.db.QueryRowContext(ctx, query, email).Scan(
 		&usr.ID,
+		&usr.Type,
 		&usr.Email,
 		&firstName,
 		&lastName,
 		&avatarURL,
 		&usr.IsActive,
 		&usr.EmailVerified,
+		&usr.LockedUntil,
 		&usr.CreatedAt,
 		&usr.UpdatedAt,
 	)
@@ -422,16 +449,18 @@ func (r *Repo) GetByID(ctx context.Context, publicID string) (*User, error) {
 	sqlQuery := `
 		SELECT
 			public_id,
-			email,
+			user_type,
+			COALESCE(email, '') AS email,
 			first_name,
 			last_name,
 			avatar_url,
 			is_active,
 			email_verified,
+			CASE WHEN locked_until > NOW() THEN locked_until END AS locked_until,
 			created_at,
 			updated_at
 		FROM altalune_users
-		WHERE public_id = $1
+		WHERE public_id = $1 AND deleted_at IS NULL
 	`
 
 	var usr User
@@ -439,12 +468,14 @@ func (r *Repo) GetByID(ctx context.Context, publicID string) (*User, error) {
 
 	err := r.db.QueryRowContext(ctx, sqlQuery, publicID).Scan(
 		&usr.ID,
+		&usr.Type,
 		&usr.Email,
 		&firstName,
 		&lastName,
 		&avatarURL,
 		&usr.IsActive,
 		&usr.EmailVerified,
+		&usr.LockedUntil,
 		&usr.CreatedAt,
 		&usr.UpdatedAt,
 	)
@@ -475,16 +506,18 @@ func (r *Repo) GetByInternalID(ctx context.Context, internalID int64) (*User, er
 	sqlQuery := `
 		SELECT
 			public_id,
-			email,
+			user_type,
+			COALESCE(email, '') AS email,
 			first_name,
 			last_name,
 			avatar_url,
 			is_active,
 			email_verified,
+			CASE WHEN locked_until > NOW() THEN locked_until END AS locked_until,
 			created_at,
 			updated_at
 		FROM altalune_users
-		WHERE id = $1
+		WHERE id = $1 AND deleted_at IS NULL
 	`
 
 	var usr User
@@ -492,12 +525,14 @@ func (r *Repo) GetByInternalID(ctx context.Context, internalID int64) (*User, er
 
 	err := r.db.QueryRowContext(ctx, sqlQuery, internalID).Scan(
 		&usr.ID,
+		&usr.Type,
 		&usr.Email,
 		&firstName,
 		&lastName,
 		&avatarURL,
 		&usr.IsActive,
 		&usr.EmailVerified,
+		&usr.LockedUntil,
 		&usr.CreatedAt,
 		&usr.UpdatedAt,
 	)
@@ -533,31 +568,48 @@ func (r *Repo) Update(ctx context.Context, input *UpdateUserInput) (*UpdateUserR
 		return nil, ErrUserAlreadyExists
 	}
 
+	// The current row is returned flagged as a conflict instead when it
+	// changed since the expected version
 	sqlQuery := `
-		UPDATE altalune_users
-		SET email = $1, first_name = $2, last_name = $3, updated_at = CURRENT_TIMESTAMP
-		WHERE public_id = $4
-		RETURNING id, public_id, email, first_name, last_name, avatar_url, is_active, email_verified,
-		          created_at, updated_at
+		WITH updated AS (
+			UPDATE altalune_users
+			SET email = $1, first_name = $2, last_name = $3, updated_at = CURRENT_TIMESTAMP
+			WHERE public_id = $4 AND deleted_at IS NULL
+				AND ($5::timestamptz IS NULL OR updated_at = $5)
+			RETURNING *
+		), result AS (
+			SELECT false AS conflict, updated.* FROM updated
+			UNION ALL
+			SELECT true, u.* FROM altalune_users u
+			WHERE u.public_id = $4 AND u.deleted_at IS NULL AND NOT EXISTS (SELECT 1 FROM updated)
+		)
+		SELECT conflict, id, public_id, user_type, COALESCE(email, ''), first_name, last_name, avatar_url, is_active, email_verified,
+		       CASE WHEN locked_until > NOW() THEN locked_until END, created_at, updated_at
+		FROM result
 	`
 
 	var result UpdateUserResult
 	var firstName, lastName, avatarURL sql.NullString
+	var conflict bool
 
 	err = r.db.QueryRowContext(ctx, sqlQuery,
 		email,
 		input.FirstName,
 		input.LastName,
 		input.PublicID,
+		input.ExpectedUpdatedAt,
 	).Scan(
+		&conflict,
 		&result.ID,
 		&result.PublicID,
+		&result.Type,
 		&result.Email,
 		&firstName,
 		&lastName,
 		&avatarURL,
 		&result.IsActive,
 		&result.EmailVerified,
+		&result.LockedUntil,
 		&result.CreatedAt,
 		&result.UpdatedAt,
 	)
@@ -571,6 +623,9 @@ func (r *Repo) Update(ctx context.Context, input *UpdateUserInput) (*UpdateUserR
 		}
 		return nil, fmt.Errorf("failed to update user: %w", err)
 	}
+	if conflict {
+		return nil, ErrUserVersionConflict
+	}
 
 	// Handle nullable fields
 	if firstName.Valid {
@@ -586,9 +641,13 @@ func (r *Repo) Update(ctx context.Context, input *UpdateUserInput) (*UpdateUserR
 	return &result, nil
 }
 
-// Delete deletes a user from the database
+// Delete moves a user to the trash. Trashed users can no longer sign in.
 func (r *Repo) Delete(ctx context.Context, publicID string) error {
-	sqlQuery := `DELETE FROM altalune_users WHERE public_id = $1`
+	sqlQuery := `
+		UPDATE altalune_users
+		SET deleted_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+		WHERE public_id = $1 AND deleted_at IS NULL
+	`
 
 	result, err := r.db.ExecContext(ctx, sqlQuery, publicID)
 	if err != nil {
@@ -607,6 +666,43 @@ func (r *Repo) Delete(ctx context.Context, publicID string) error {
 	return nil
 }
 
+// Restore moves a user out of the trash
+func (r *Repo) Restore(ctx context.Context, publicID string) (*User, error) {
+	sqlQuery := `
+		UPDATE altalune_users
+		SET deleted_at = NULL, updated_at = CURRENT_TIMESTAMP
+		WHERE public_id = $1 AND deleted_at IS NOT NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, sqlQuery, publicID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore user: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return nil, ErrUserNotFound
+	}
+
+	return r.GetByID(ctx, publicID)
+}
+
+// PurgeDeleted permanently removes users trashed before the given time
+func (r *Repo) PurgeDeleted(ctx context.Context, before time.Time) (int64, error) {
+	sqlQuery := `DELETE FROM altalune_users WHERE deleted_at < $1`
+
+	result, err := r.db.ExecContext(ctx, sqlQuery, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge deleted users: %w", err)
+	}
+
+	return result.RowsAffected()
+}
+
 // Activate activates a user
 func (r *Repo) Activate(ctx context.Context, publicID string) (*User, error) {
 	// First check current state
@@ -621,9 +717,10 @@ func (r *Repo) Activate(ctx context.Context, publicID string) (*User, error) {
 
 	sqlQuery := `
 		UPDATE altalune_users
-		SET is_active = true, updated_at = CURRENT_TIMESTAMP
-		WHERE public_id = $1
-		RETURNING public_id, email, first_name, last_name, avatar_url, is_active, email_verified, created_at, updated_at
+		SET is_active = true, pending_approval_since = NULL, updated_at = CURRENT_TIMESTAMP
+		WHERE public_id = $1 AND deleted_at IS NULL
+		RETURNING public_id, user_type, COALESCE(email, ''), first_name, last_name, avatar_url, is_active, email_verified,
+		          CASE WHEN locked_until > NOW() THEN locked_until END, created_at, updated_at
 	`
 
 	var usr User
@@ -631,12 +728,14 @@ func (r *Repo) Activate(ctx context.Context, publicID string) (*User, error) {
 
 	err = r.db.QueryRowContext(ctx, sqlQuery, publicID).Scan(
 		&usr.ID,
+		&usr.Type,
 		&usr.Email,
 		&firstName,
 		&lastName,
 		&avatarURL,
 		&usr.IsActive,
 		&usr.EmailVerified,
+		&usr.LockedUntil,
 		&usr.CreatedAt,
 		&usr.UpdatedAt,
 	)
@@ -677,8 +776,9 @@ func (r *Repo) Deactivate(ctx context.Context, publicID string) (*User, error) {
 	sqlQuery := `
 		UPDATE altalune_users
 		SET is_active = false, updated_at = CURRENT_TIMESTAMP
-		WHERE public_id = $1
-		RETURNING public_id, email, first_name, last_name, avatar_url, is_active, email_verified, created_at, updated_at
+		WHERE public_id = $1 AND deleted_at IS NULL
+		RETURNING public_id, user_type, COALESCE(email, ''), first_name, last_name, avatar_url, is_active, email_verified,
+		          CASE WHEN locked_until > NOW() THEN locked_until END, created_at, updated_at
 	`
 
 	var usr User
@@ -686,12 +786,14 @@ func (r *Repo) Deactivate(ctx context.Context, publicID string) (*User, error) {
 
 	err = r.db.QueryRowContext(ctx, sqlQuery, publicID).Scan(
 		&usr.ID,
+		&usr.Type,
 		&usr.Email,
 		&firstName,
 		&lastName,
 		&avatarURL,
 		&usr.IsActive,
 		&usr.EmailVerified,
+		&usr.LockedUntil,
 		&usr.CreatedAt,
 		&usr.UpdatedAt,
 	)
@@ -723,8 +825,9 @@ func (r *Repo) UpdateProfileByInternalID(ctx context.Context, internalID int64,
 	sqlQuery := `
 		UPDATE altalune_users
 		SET first_name = $1, last_name = $2, updated_at = CURRENT_TIMESTAMP
-		WHERE id = $3
-		RETURNING public_id, email, first_name, last_name, avatar_url, is_active, email_verified, created_at, updated_at
+		WHERE id = $3 AND deleted_at IS NULL
+		RETURNING public_id, user_type, COALESCE(email, ''), first_name, last_name, avatar_url, is_active, email_verified,
+		          CASE WHEN locked_until > NOW() THEN locked_until END, created_at, updated_at
 	`
 
 	var usr User
@@ -732,12 +835,14 @@ func (r *Repo) UpdateProfileByInternalID(ctx context.Context, internalID int64,
 
 	err := r.db.QueryRowContext(ctx, sqlQuery, firstName, lastName, internalID).Scan(
 		&usr.ID,
+		&usr.Type,
 		&usr.Email,
 		&firstNameDB,
 		&lastNameDB,
 		&avatarURL,
 		&usr.IsActive,
 		&usr.EmailVerified,
+		&usr.LockedUntil,
 		&usr.CreatedAt,
 		&usr.UpdatedAt,
 	)
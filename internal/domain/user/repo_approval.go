@@ -0,0 +1,91 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+// Approve activates a user whose registration awaits approval
+func (r *Repo) Approve(ctx context.Context, publicID string) (*User, error) {
+	sqlQuery := `
+		UPDATE altalune_users
+		SET is_active = true, pending_approval_since = NULL, updated_at = CURRENT_TIMESTAMP
+		WHERE public_id = $1 AND deleted_at IS NULL AND pending_approval_since IS NOT NULL
+		RETURNING public_id, user_type, COALESCE(email, ''), first_name, last_name, avatar_url, is_active, email_verified,
+		          CASE WHEN locked_until > NOW() THEN locked_until END, created_at, updated_at
+	`
+
+	var usr User
+	var firstName, lastName, avatarURL sql.NullString
+
+	err := r.db.QueryRowContext(ctx, sqlQuery, publicID).Scan(
+		&usr.ID,
+		&usr.Type,
+		&usr.Email,
+		&firstName,
+		&lastName,
+		&avatarURL,
+		&usr.IsActive,
+		&usr.EmailVerified,
+		&usr.LockedUntil,
+		&usr.CreatedAt,
+		&usr.UpdatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, r.notPendingError(ctx, publicID)
+		}
+		return nil, fmt.Errorf("failed to approve user: %w", err)
+	}
+
+	// Handle nullable fields
+	if firstName.Valid {
+		usr.FirstName = firstName.String
+	}
+	if lastName.Valid {
+		usr.LastName = lastName.String
+	}
+	if avatarURL.Valid {
+		usr.AvatarURL = avatarURL.String
+	}
+
+	return &usr, nil
+}
+
+// Reject moves a user whose registration awaits approval to the trash, so the
+// janitor purges it and its email can register again afterwards
+func (r *Repo) Reject(ctx context.Context, publicID string) error {
+	sqlQuery := `
+		UPDATE altalune_users
+		SET pending_approval_since = NULL, deleted_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+		WHERE public_id = $1 AND deleted_at IS NULL AND pending_approval_since IS NOT NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, sqlQuery, publicID)
+	if err != nil {
+		return fmt.Errorf("failed to reject user: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return r.notPendingError(ctx, publicID)
+	}
+
+	return nil
+}
+
+// notPendingError tells a user that is not awaiting approval apart from a
+// missing one
+func (r *Repo) notPendingError(ctx context.Context, publicID string) error {
+	if _, err := r.GetIDByPublicID(ctx, publicID); err != nil {
+		return err
+	}
+	return ErrUserNotPending
+}
@@ -0,0 +1,494 @@
+package user_test
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hrz8/altalune/internal/domain/oauth_auth"
+	"github.com/hrz8/altalune/internal/domain/project"
+	"github.com/hrz8/altalune/internal/domain/user"
+	"github.com/hrz8/altalune/internal/shared/query"
+	"github.com/hrz8/altalune/internal/testdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) { testdb.Main(m) }
+
+func TestInMemRepo(t *testing.T) {
+	repo := user.NewInMemRepo()
+	testRepoContract(t, repo, fixtures{
+		newProjectID: func(t *testing.T) int64 { return 1 },
+		newVerificationToken: func(t *testing.T, userID int64, expiresAt time.Time) {
+			repo.PutVerificationToken(userID, expiresAt)
+		},
+		lockUser: func(t *testing.T, userID int64) {
+			repo.LockUser(userID, time.Now().Add(time.Hour))
+		},
+	})
+}
+
+func TestRepo(t *testing.T) {
+	db := testdb.Open(t)
+	projects := project.NewRepo(db)
+	verifications := oauth_auth.NewEmailVerificationRepo(db)
+	lockouts := oauth_auth.NewUserRepo(db)
+
+	testRepoContract(t, user.NewRepo(db), fixtures{
+		newProjectID: func(t *testing.T) int64 {
+			prj, err := projects.Create(context.Background(), &project.CreateProjectInput{
+				Name:        "user-" + testdb.Token(t),
+				Timezone:    "UTC",
+				Environment: project.EnvironmentStatusSandbox,
+			})
+			require.NoError(t, err)
+			return prj.ID
+		},
+		newVerificationToken: func(t *testing.T, userID int64, expiresAt time.Time) {
+			require.NoError(t, verifications.CreateVerificationToken(context.Background(), userID, testdb.Token(t), time.Until(expiresAt)))
+		},
+		lockUser: func(t *testing.T, userID int64) {
+			_, err := lockouts.RecordFailedLogin(context.Background(), userID, 1, time.Hour)
+			require.NoError(t, err)
+		},
+	})
+}
+
+// fixtures create the rows owned by other domains that users reference
+type fixtures struct {
+	newProjectID         func(t *testing.T) int64 // Internal ID of an existing project
+	newVerificationToken func(t *testing.T, userID int64, expiresAt time.Time)
+	lockUser             func(t *testing.T, userID int64) // Lock the account as failed sign-ins do
+}
+
+// testRepoContract runs the behavior every user.Repository must share against
+// repo. It only relies on rows it creates itself and the ones of f.
+func testRepoContract(t *testing.T, repo user.Repository, f fixtures) {
+	ctx := context.Background()
+
+	create := func(t *testing.T, lastName string) *user.CreateUserResult {
+		t.Helper()
+		created, err := repo.Create(ctx, &user.CreateUserInput{
+			Email:     testdb.Token(t) + "@Example.com",
+			FirstName: "Test",
+			LastName:  lastName,
+		})
+		require.NoError(t, err)
+		return created
+	}
+
+	t.Run("create and get", func(t *testing.T) {
+		created := create(t, "Get")
+		assert.True(t, strings.HasSuffix(created.Email, "@example.com"), "emails are stored lowercase")
+		assert.True(t, created.IsActive, "users are active by default")
+		assert.False(t, created.EmailVerified)
+
+		byID, err := repo.GetByID(ctx, created.PublicID)
+		require.NoError(t, err)
+		assert.Equal(t, created.ToUser(), byID)
+
+		byEmail, err := repo.GetByEmail(ctx, created.Email)
+		require.NoError(t, err)
+		assert.Equal(t, created.PublicID, byEmail.ID)
+
+		byInternalID, err := repo.GetByInternalID(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, created.PublicID, byInternalID.ID)
+
+		id, err := repo.GetIDByPublicID(ctx, created.PublicID)
+		require.NoError(t, err)
+		assert.Equal(t, created.ID, id)
+
+		_, err = repo.Create(ctx, &user.CreateUserInput{Email: created.Email})
+		assert.ErrorIs(t, err, user.ErrUserAlreadyExists)
+
+		_, err = repo.GetByID(ctx, "unknown")
+		assert.ErrorIs(t, err, user.ErrUserNotFound)
+		_, err = repo.GetByEmail(ctx, "unknown@example.com")
+		assert.ErrorIs(t, err, user.ErrUserNotFound)
+	})
+
+	t.Run("create inactive", func(t *testing.T) {
+		inactive := false
+		created, err := repo.Create(ctx, &user.CreateUserInput{Email: testdb.Token(t) + "@example.com", IsActive: &inactive})
+		require.NoError(t, err)
+		assert.False(t, created.IsActive)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		created := create(t, "Update")
+		other := create(t, "Other")
+
+		stale := created.UpdatedAt.Add(-time.Second)
+		_, err := repo.Update(ctx, &user.UpdateUserInput{
+			PublicID:          created.PublicID,
+			Email:             created.Email,
+			FirstName:         "Stale",
+			ExpectedUpdatedAt: &stale,
+		})
+		assert.ErrorIs(t, err, user.ErrUserVersionConflict)
+
+		updated, err := repo.Update(ctx, &user.UpdateUserInput{
+			PublicID:          created.PublicID,
+			Email:             created.Email,
+			FirstName:         "Fresh",
+			LastName:          "Name",
+			ExpectedUpdatedAt: &created.UpdatedAt,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Fresh", updated.FirstName)
+		assert.False(t, updated.UpdatedAt.Before(created.UpdatedAt))
+
+		_, err = repo.Update(ctx, &user.UpdateUserInput{
+			PublicID:          created.PublicID,
+			Email:             created.Email,
+			FirstName:         "Overwritten",
+			ExpectedUpdatedAt: &created.UpdatedAt,
+		})
+		assert.ErrorIs(t, err, user.ErrUserVersionConflict, "a version is only updated once")
+		_, err = repo.Update(ctx, &user.UpdateUserInput{
+			PublicID:          "unknown",
+			Email:             testdb.Token(t) + "@example.com",
+			ExpectedUpdatedAt: &updated.UpdatedAt,
+		})
+		assert.ErrorIs(t, err, user.ErrUserNotFound, "a missing user is not a conflict")
+
+		_, err = repo.Update(ctx, &user.UpdateUserInput{PublicID: created.PublicID, Email: other.Email})
+		assert.ErrorIs(t, err, user.ErrUserAlreadyExists)
+
+		_, err = repo.Update(ctx, &user.UpdateUserInput{PublicID: "unknown", Email: testdb.Token(t) + "@example.com"})
+		assert.ErrorIs(t, err, user.ErrUserNotFound)
+
+		profile, err := repo.UpdateProfileByInternalID(ctx, created.ID, "Profile", "Edit")
+		require.NoError(t, err)
+		assert.Equal(t, "Profile", profile.FirstName)
+		assert.Equal(t, "Edit", profile.LastName)
+	})
+
+	t.Run("activation", func(t *testing.T) {
+		created := create(t, "Activation")
+
+		_, err := repo.Activate(ctx, created.PublicID)
+		assert.ErrorIs(t, err, user.ErrUserAlreadyActive)
+
+		deactivated, err := repo.Deactivate(ctx, created.PublicID)
+		require.NoError(t, err)
+		assert.False(t, deactivated.IsActive)
+
+		_, err = repo.Deactivate(ctx, created.PublicID)
+		assert.ErrorIs(t, err, user.ErrUserAlreadyInactive)
+
+		activated, err := repo.Activate(ctx, created.PublicID)
+		require.NoError(t, err)
+		assert.True(t, activated.IsActive)
+	})
+
+	t.Run("approval queue", func(t *testing.T) {
+		lastName := "Pending" + testdb.Token(t)
+		inactive := false
+		pending := func(t *testing.T) *user.CreateUserResult {
+			t.Helper()
+			created, err := repo.Create(ctx, &user.CreateUserInput{
+				Email:           testdb.Token(t) + "@example.com",
+				LastName:        lastName,
+				IsActive:        &inactive,
+				PendingApproval: true,
+			})
+			require.NoError(t, err)
+			return created
+		}
+		approved, rejected, activated := pending(t), pending(t), pending(t)
+		deactivated := create(t, lastName)
+		_, err := repo.Deactivate(ctx, deactivated.PublicID)
+		require.NoError(t, err)
+
+		queue, err := repo.Query(ctx, &query.QueryParams{
+			Pagination: query.PaginationParams{Page: 1, PageSize: 10},
+			Keyword:    lastName,
+			Filters:    map[string][]string{"pending_approval": {"true"}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), queue.TotalRows, "deactivated users are not awaiting approval")
+
+		usr, err := repo.Approve(ctx, approved.PublicID)
+		require.NoError(t, err)
+		assert.True(t, usr.IsActive)
+		_, err = repo.Approve(ctx, approved.PublicID)
+		assert.ErrorIs(t, err, user.ErrUserNotPending)
+		assert.ErrorIs(t, repo.Reject(ctx, approved.PublicID), user.ErrUserNotPending)
+
+		require.NoError(t, repo.Reject(ctx, rejected.PublicID))
+		_, err = repo.GetByID(ctx, rejected.PublicID)
+		assert.ErrorIs(t, err, user.ErrUserNotFound, "rejected users are trashed")
+
+		_, err = repo.Activate(ctx, activated.PublicID)
+		require.NoError(t, err)
+		_, err = repo.Approve(ctx, activated.PublicID)
+		assert.ErrorIs(t, err, user.ErrUserNotPending, "activation approves the user")
+
+		_, err = repo.Approve(ctx, deactivated.PublicID)
+		assert.ErrorIs(t, err, user.ErrUserNotPending)
+		_, err = repo.Approve(ctx, "unknown")
+		assert.ErrorIs(t, err, user.ErrUserNotFound)
+		assert.ErrorIs(t, repo.Reject(ctx, "unknown"), user.ErrUserNotFound)
+
+		queue, err = repo.Query(ctx, &query.QueryParams{
+			Pagination: query.PaginationParams{Page: 1, PageSize: 10},
+			Keyword:    lastName,
+			Filters:    map[string][]string{"pending_approval": {"true"}},
+		})
+		require.NoError(t, err)
+		assert.Zero(t, queue.TotalRows)
+	})
+
+	t.Run("lockout", func(t *testing.T) {
+		created := create(t, "Lockout")
+
+		usr, err := repo.GetByID(ctx, created.PublicID)
+		require.NoError(t, err)
+		assert.Nil(t, usr.LockedUntil)
+		_, err = repo.Unlock(ctx, created.PublicID)
+		assert.ErrorIs(t, err, user.ErrUserNotLocked)
+
+		f.lockUser(t, created.ID)
+		usr, err = repo.GetByID(ctx, created.PublicID)
+		require.NoError(t, err)
+		require.NotNil(t, usr.LockedUntil)
+		assert.True(t, usr.LockedUntil.After(time.Now()))
+
+		usr, err = repo.Unlock(ctx, created.PublicID)
+		require.NoError(t, err)
+		assert.Nil(t, usr.LockedUntil)
+		usr, err = repo.GetByID(ctx, created.PublicID)
+		require.NoError(t, err)
+		assert.Nil(t, usr.LockedUntil)
+
+		_, err = repo.Unlock(ctx, created.PublicID)
+		assert.ErrorIs(t, err, user.ErrUserNotLocked)
+		_, err = repo.Unlock(ctx, "unknown")
+		assert.ErrorIs(t, err, user.ErrUserNotFound)
+	})
+
+	t.Run("service accounts", func(t *testing.T) {
+		name := "Bot" + testdb.Token(t)
+		created, err := repo.Create(ctx, &user.CreateUserInput{Type: user.UserTypeServiceAccount, FirstName: name})
+		require.NoError(t, err)
+		assert.Equal(t, user.UserTypeServiceAccount, created.Type)
+		assert.Empty(t, created.Email)
+
+		// Service accounts have no email, so they never collide on it
+		_, err = repo.Create(ctx, &user.CreateUserInput{Type: user.UserTypeServiceAccount, FirstName: name})
+		require.NoError(t, err)
+
+		usr, err := repo.GetByID(ctx, created.PublicID)
+		require.NoError(t, err)
+		assert.Equal(t, user.UserTypeServiceAccount, usr.Type)
+		_, err = repo.GetByEmail(ctx, "")
+		assert.ErrorIs(t, err, user.ErrUserNotFound)
+
+		assert.Equal(t, user.UserTypeHuman, create(t, "Human").Type, "users are human by default")
+
+		result, err := repo.Query(ctx, &query.QueryParams{
+			Pagination: query.PaginationParams{Page: 1, PageSize: 10},
+			Keyword:    name,
+			Filters:    map[string][]string{"user_type": {string(user.UserTypeServiceAccount)}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), result.TotalRows)
+	})
+
+	t.Run("email verification", func(t *testing.T) {
+		created := create(t, "Verification")
+		since := time.Now().Add(-time.Minute)
+		before, err := repo.GetVerificationStats(ctx, since)
+		require.NoError(t, err)
+
+		f.newVerificationToken(t, created.ID, time.Now().Add(-time.Hour))
+		tokens, err := repo.GetPendingVerificationTokens(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Empty(t, tokens, "expired tokens are not pending")
+
+		f.newVerificationToken(t, created.ID, time.Now().Add(time.Hour))
+		f.newVerificationToken(t, created.ID, time.Now().Add(2*time.Hour))
+		tokens, err = repo.GetPendingVerificationTokens(ctx, created.ID)
+		require.NoError(t, err)
+		require.Len(t, tokens, 1, "a new token supersedes the pending one")
+		assert.True(t, tokens[0].ExpiresAt.After(time.Now().Add(time.Hour)), "the newest token is pending")
+
+		after, err := repo.GetVerificationStats(ctx, since)
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, after.Sent-before.Sent, int64(3))
+		assert.GreaterOrEqual(t, after.Superseded-before.Superseded, int64(1))
+		assert.Equal(t, after.Sent, after.Verified+after.Pending+after.Expired+after.Superseded,
+			"every token sent has one outcome")
+		empty, err := repo.GetVerificationStats(ctx, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		assert.Zero(t, empty.Sent)
+		assert.Zero(t, empty.ConversionRate())
+
+		invalidated, err := repo.InvalidateVerificationTokens(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), invalidated)
+		tokens, err = repo.GetPendingVerificationTokens(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Empty(t, tokens)
+
+		reset, err := repo.ResetEmailVerified(ctx, created.PublicID)
+		require.NoError(t, err)
+		assert.Equal(t, created.PublicID, reset.ID)
+		assert.False(t, reset.EmailVerified)
+		_, err = repo.ResetEmailVerified(ctx, testdb.Token(t))
+		assert.ErrorIs(t, err, user.ErrUserNotFound)
+	})
+
+	t.Run("trash", func(t *testing.T) {
+		created := create(t, "Trash")
+
+		require.NoError(t, repo.Delete(ctx, created.PublicID))
+		assert.ErrorIs(t, repo.Delete(ctx, created.PublicID), user.ErrUserNotFound)
+
+		_, err := repo.GetByID(ctx, created.PublicID)
+		assert.ErrorIs(t, err, user.ErrUserNotFound)
+		_, err = repo.GetIDByPublicID(ctx, created.PublicID)
+		assert.ErrorIs(t, err, user.ErrUserNotFound)
+
+		// The email stays taken while the user is in the trash
+		_, err = repo.GetInternalIDByEmail(ctx, created.Email)
+		assert.NoError(t, err)
+		_, err = repo.Create(ctx, &user.CreateUserInput{Email: created.Email})
+		assert.ErrorIs(t, err, user.ErrUserAlreadyExists)
+
+		trashed, err := repo.Query(ctx, &query.QueryParams{
+			Pagination: query.PaginationParams{Page: 1, PageSize: 10},
+			Keyword:    created.Email,
+			Trashed:    true,
+		})
+		require.NoError(t, err)
+		require.Len(t, trashed.Data, 1)
+		assert.NotNil(t, trashed.Data[0].DeletedAt)
+
+		restored, err := repo.Restore(ctx, created.PublicID)
+		require.NoError(t, err)
+		assert.Equal(t, created.PublicID, restored.ID)
+		_, err = repo.Restore(ctx, created.PublicID)
+		assert.ErrorIs(t, err, user.ErrUserNotFound)
+
+		require.NoError(t, repo.Delete(ctx, created.PublicID))
+		purged, err := repo.PurgeDeleted(ctx, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, purged, int64(1))
+		_, err = repo.GetInternalIDByEmail(ctx, created.Email)
+		assert.ErrorIs(t, err, user.ErrUserNotFound)
+	})
+
+	t.Run("query", func(t *testing.T) {
+		lastName := "Query" + testdb.Token(t)
+		for range 3 {
+			create(t, lastName)
+		}
+		inactive := create(t, lastName)
+		_, err := repo.Deactivate(ctx, inactive.PublicID)
+		require.NoError(t, err)
+
+		params := &query.QueryParams{
+			Pagination: query.PaginationParams{Page: 1, PageSize: 2},
+			Keyword:    lastName,
+			Filters:    map[string][]string{"is_active": {"true"}},
+			Sorting:    &query.SortingParams{Field: "email", Order: query.SortOrderAsc},
+		}
+		first, err := repo.Query(ctx, params)
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), first.TotalRows)
+		assert.Equal(t, int32(2), first.TotalPages)
+		require.Len(t, first.Data, 2)
+		assert.Less(t, first.Data[0].Email, first.Data[1].Email)
+
+		params.Pagination.Page = 2
+		second, err := repo.Query(ctx, params)
+		require.NoError(t, err)
+		require.Len(t, second.Data, 1)
+		assert.Less(t, first.Data[1].Email, second.Data[0].Email)
+	})
+
+	t.Run("identities", func(t *testing.T) {
+		created := create(t, "Identity")
+		providerUserID := testdb.Token(t)
+
+		require.NoError(t, repo.CreateUserIdentity(ctx, &user.CreateUserIdentityInput{
+			UserID:         created.ID,
+			Provider:       "google",
+			ProviderUserID: providerUserID,
+			Email:          created.Email,
+		}))
+		assert.Error(t, repo.CreateUserIdentity(ctx, &user.CreateUserIdentityInput{
+			UserID:         created.ID,
+			Provider:       "google",
+			ProviderUserID: providerUserID,
+		}), "a provider identity links to one user only")
+
+		identity, err := repo.GetUserIdentityByProvider(ctx, "google", providerUserID)
+		require.NoError(t, err)
+		assert.Equal(t, created.ID, identity.UserID)
+		assert.Equal(t, created.Email, identity.Email)
+
+		_, err = repo.GetUserIdentityByProvider(ctx, "github", providerUserID)
+		assert.ErrorIs(t, err, user.ErrUserNotFound)
+
+		identities, err := repo.GetUserIdentities(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Len(t, identities, 1)
+
+		require.NoError(t, repo.UpdateUserIdentityLastLogin(ctx, created.ID, "google"))
+		assert.ErrorIs(t, repo.UpdateUserIdentityLastLogin(ctx, created.ID, "github"), user.ErrUserNotFound)
+	})
+
+	t.Run("project members", func(t *testing.T) {
+		created := create(t, "Member")
+		projectID := f.newProjectID(t)
+
+		require.NoError(t, repo.AddProjectMember(ctx, projectID, created.ID, "member", 0))
+		require.NoError(t, repo.AddProjectMember(ctx, projectID, created.ID, "admin", 0), "adding a member twice is a no-op")
+
+		owner := create(t, "Owner")
+		require.NoError(t, repo.AddProjectMember(ctx, projectID, owner.ID, "owner", 0))
+
+		// The project has at least these two members now
+		extra := create(t, "Extra")
+		assert.ErrorIs(t, repo.AddProjectMember(ctx, projectID, extra.ID, "member", 2), user.ErrMemberLimitReached)
+		require.NoError(t, repo.AddProjectMember(ctx, projectID, owner.ID, "owner", 2), "an existing member is not refused")
+		owners, err := repo.GetProjectOwners(ctx, projectID)
+		require.NoError(t, err)
+		assert.Contains(t, owners, &user.ProjectOwner{Email: owner.Email, FirstName: "Test"})
+		assert.NotContains(t, owners, &user.ProjectOwner{Email: created.Email, FirstName: "Test"})
+	})
+
+	t.Run("concurrent creates", func(t *testing.T) {
+		email := testdb.Token(t) + "@example.com"
+
+		var wg sync.WaitGroup
+		errs := make(chan error, 8)
+		for range 8 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := repo.Create(ctx, &user.CreateUserInput{Email: email})
+				errs <- err
+			}()
+		}
+		wg.Wait()
+		close(errs)
+
+		created := 0
+		for err := range errs {
+			if err == nil {
+				created++
+				continue
+			}
+			assert.ErrorIs(t, err, user.ErrUserAlreadyExists)
+		}
+		assert.Equal(t, 1, created, "exactly one of the concurrent creates wins")
+	})
+}
@@ -7,7 +7,6 @@ import (
 	"fmt"
 
 	"github.com/hrz8/altalune/internal/postgres"
-	"github.com/hrz8/altalune/internal/shared/nanoid"
 )
 
 // GetUserIdentityByProvider retrieves a user identity by provider and provider user ID
@@ -146,8 +145,6 @@ func (r *Repo) GetUserIdentities(ctx context.Context, userID int64) ([]*UserIden
 
 // CreateUserIdentity creates a new user identity record
 func (r *Repo) CreateUserIdentity(ctx context.Context, input *CreateUserIdentityInput) error {
-	publicID, _ := nanoid.GeneratePublicID()
-
 	query := `
 		INSERT INTO altalune_user_identities (
 			public_id, user_id, provider, provider_user_id,
@@ -156,17 +153,20 @@ func (r *Repo) CreateUserIdentity(ctx context.Context, input *CreateUserIdentity
 		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NOW(), NOW(), NOW())
 	`
 
-	_, err := r.db.ExecContext(ctx, query,
-		publicID,
-		input.UserID,
-		input.Provider,
-		input.ProviderUserID,
-		input.Email,
-		input.FirstName,
-		input.LastName,
-		input.OAuthClientID,
-		input.OriginOAuthClientName,
-	)
+	_, err := postgres.InsertWithPublicID(func(publicID string) error {
+		_, err := r.db.ExecContext(ctx, query,
+			publicID,
+			input.UserID,
+			input.Provider,
+			input.ProviderUserID,
+			input.Email,
+			input.FirstName,
+			input.LastName,
+			input.OAuthClientID,
+			input.OriginOAuthClientName,
+		)
+		return err
+	})
 
 	if err != nil {
 		return fmt.Errorf("create user identity: %w", err)
@@ -200,23 +200,70 @@ func (r *Repo) UpdateUserIdentityLastLogin(ctx context.Context, userID int64, pr
 	return nil
 }
 
-// AddProjectMember adds a user to a project with the specified role
-func (r *Repo) AddProjectMember(ctx context.Context, projectID, userID int64, role string) error {
-	publicID, _ := nanoid.GeneratePublicID()
-
+// AddProjectMember adds a user to a project with the specified role. The
+// member limit is checked by the INSERT itself; two additions racing for the
+// last seat can both get it.
+func (r *Repo) AddProjectMember(ctx context.Context, projectID, userID int64, role string, maxMembers int) error {
 	query := `
 		INSERT INTO altalune_project_members (
 			public_id, project_id, user_id, role, created_at, updated_at
-		) VALUES ($1, $2, $3, $4, NOW(), NOW())
+		)
+		SELECT $1, $2::bigint, $3::bigint, $4, NOW(), NOW()
+		WHERE $5::int = 0
+		   OR (SELECT COUNT(*) FROM altalune_project_members WHERE project_id = $2) < $5::int
+		   OR EXISTS (SELECT 1 FROM altalune_project_members WHERE project_id = $2 AND user_id = $3)
 	`
 
-	_, err := r.db.ExecContext(ctx, query, publicID, projectID, userID, role)
+	var rowsAffected int64
+	_, err := postgres.InsertWithPublicID(func(publicID string) error {
+		res, err := r.db.ExecContext(ctx, query, publicID, projectID, userID, role, maxMembers)
+		if err != nil {
+			return err
+		}
+		rowsAffected, err = res.RowsAffected()
+		return err
+	})
 	if err != nil {
-		if postgres.IsUniqueViolation(err) {
+		if postgres.IsUniqueViolation(err) && !postgres.IsPublicIDViolation(err) {
 			return nil
 		}
 		return fmt.Errorf("add project member: %w", err)
 	}
+	if rowsAffected == 0 {
+		return ErrMemberLimitReached
+	}
 
 	return nil
 }
+
+// GetProjectOwners returns the active owners of a project that have an email
+func (r *Repo) GetProjectOwners(ctx context.Context, projectID int64) ([]*ProjectOwner, error) {
+	query := `
+		SELECT u.email, COALESCE(u.first_name, '')
+		FROM altalune_project_members m
+		JOIN altalune_users u ON u.id = m.user_id
+		WHERE m.project_id = $1 AND m.role = 'owner'
+		  AND u.is_active = true AND u.deleted_at IS NULL AND u.email IS NOT NULL
+		ORDER BY u.id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("get project owners: %w", err)
+	}
+	defer rows.Close()
+
+	owners := make([]*ProjectOwner, 0)
+	for rows.Next() {
+		var owner ProjectOwner
+		if err := rows.Scan(&owner.Email, &owner.FirstName); err != nil {
+			return nil, fmt.Errorf("scan project owner: %w", err)
+		}
+		owners = append(owners, &owner)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate project owners: %w", err)
+	}
+
+	return owners, nil
+}
@@ -0,0 +1,774 @@
+package user
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hrz8/altalune/internal/postgres"
+	"github.com/hrz8/altalune/internal/shared/nanoid"
+	"github.com/hrz8/altalune/internal/shared/query"
+)
+
+// InMemRepo is an in-memory Repository for tests. It follows the semantics of
+// Repo: emails are unique and lowercase, trashed users keep their email but
+// are hidden from lookups by ID, and updates honor ExpectedUpdatedAt.
+type InMemRepo struct {
+	mu         sync.RWMutex
+	users      []*UserQueryResult // In insertion order
+	identities []*UserIdentity
+	members    map[[2]int64]string // Role by project ID and user ID
+	pending    map[int64]bool      // Users awaiting approval, by user ID
+	tokens     []*inMemVerificationToken
+	lastID     int64
+}
+
+type inMemVerificationToken struct {
+	VerificationToken
+	usedAt        *time.Time
+	invalidatedAt *time.Time
+}
+
+var _ Repository = (*InMemRepo)(nil)
+
+// NewInMemRepo creates an empty in-memory user repository
+func NewInMemRepo() *InMemRepo {
+	return &InMemRepo{members: make(map[[2]int64]string), pending: make(map[int64]bool)}
+}
+
+func (r *InMemRepo) nextID() int64 {
+	r.lastID++
+	return r.lastID
+}
+
+// find returns the user matching match, trashed users included
+func (r *InMemRepo) find(match func(u *UserQueryResult) bool) *UserQueryResult {
+	for _, u := range r.users {
+		if match(u) {
+			return u
+		}
+	}
+	return nil
+}
+
+func (r *InMemRepo) findLive(match func(u *UserQueryResult) bool) *UserQueryResult {
+	return r.find(func(u *UserQueryResult) bool {
+		return u.DeletedAt == nil && match(u)
+	})
+}
+
+func byPublicID(publicID string) func(u *UserQueryResult) bool {
+	return func(u *UserQueryResult) bool { return u.PublicID == publicID }
+}
+
+func byInternalID(id int64) func(u *UserQueryResult) bool {
+	return func(u *UserQueryResult) bool { return u.ID == id }
+}
+
+func byEmail(email string) func(u *UserQueryResult) bool {
+	// Service accounts have no email to match
+	return func(u *UserQueryResult) bool { return email != "" && strings.EqualFold(u.Email, email) }
+}
+
+// liveUser converts a live user to the model returned by single-row lookups
+func liveUser(u *UserQueryResult) *User {
+	usr := u.ToUser()
+	usr.DeletedAt = nil
+	usr.LockedUntil = activeLock(u)
+	return usr
+}
+
+// activeLock returns the end of the lock of a user, or nil once it is over
+func activeLock(u *UserQueryResult) *time.Time {
+	if u.LockedUntil == nil || !u.LockedUntil.After(time.Now()) {
+		return nil
+	}
+	return u.LockedUntil
+}
+
+func (r *InMemRepo) GetIDByPublicID(ctx context.Context, publicID string) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	u := r.findLive(byPublicID(publicID))
+	if u == nil {
+		return 0, ErrUserNotFound
+	}
+	return u.ID, nil
+}
+
+func (r *InMemRepo) GetInternalIDByEmail(ctx context.Context, email string) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	u := r.find(byEmail(email))
+	if u == nil {
+		return 0, ErrUserNotFound
+	}
+	return u.ID, nil
+}
+
+func (r *InMemRepo) Query(ctx context.Context, params *query.QueryParams) (*query.QueryResult[User], error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	rows := make([]*UserQueryResult, 0)
+	for _, u := range r.users {
+		if (u.DeletedAt != nil) != params.Trashed {
+			continue
+		}
+		if !query.MatchKeyword(params.Keyword, u.Email, u.FirstName, u.LastName) {
+			continue
+		}
+		if !matchUserFilters(u, r.pending[u.ID], params.Filters) {
+			continue
+		}
+		rows = append(rows, u)
+	}
+
+	field, order := "created_at", query.SortOrderDesc
+	if params.Sorting != nil && params.Sorting.Field != "" {
+		field, order = params.Sorting.Field, query.SortOrderAsc
+		if params.Sorting.Order == query.SortOrderDesc {
+			order = query.SortOrderDesc
+		}
+	}
+	query.SortRows(rows, order, query.ThenByID(compareUsers(field), userQueryResultID))
+
+	page, totalRows, totalPages := query.PaginateAfter(rows, params.Pagination, params.Keyset, userQueryResultID)
+	results := make([]*User, 0, len(page))
+	for _, u := range page {
+		usr := u.ToUser()
+		usr.LockedUntil = activeLock(u)
+		results = append(results, usr)
+	}
+
+	return &query.QueryResult[User]{
+		Data:       results,
+		TotalRows:  totalRows,
+		TotalPages: totalPages,
+		HasMore:    params.Pagination.Page < totalPages,
+		NextKeyset: query.NextKeyset(page, userQueryResultID),
+		Filters: map[string][]string{
+			"is_active": {"true", "false"},
+			"user_type": {string(UserTypeHuman), string(UserTypeServiceAccount)},
+		},
+	}, nil
+}
+
+func userQueryResultID(u *UserQueryResult) int64 { return u.ID }
+
+func matchUserFilters(u *UserQueryResult, pending bool, filters map[string][]string) bool {
+	for field, values := range filters {
+		if len(values) == 0 {
+			continue
+		}
+		switch field {
+		case "is_active", "active":
+			if !matchBool(u.IsActive, values) {
+				return false
+			}
+		case "pending_approval":
+			if !matchBool(pending, values) {
+				return false
+			}
+		case "email":
+			if !query.MatchAny(u.Email, values) {
+				return false
+			}
+		case "user_type", "type":
+			if !query.MatchAny(string(u.Type), values) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func matchBool(v bool, values []string) bool {
+	for _, value := range values {
+		if v == (value == "true" || value == "1") {
+			return true
+		}
+	}
+	return false
+}
+
+func compareUsers(field string) func(a, b *UserQueryResult) int {
+	switch field {
+	case "email":
+		return func(a, b *UserQueryResult) int { return cmp.Compare(a.Email, b.Email) }
+	case "firstName", "first_name":
+		return func(a, b *UserQueryResult) int { return cmp.Compare(a.FirstName, b.FirstName) }
+	case "lastName", "last_name":
+		return func(a, b *UserQueryResult) int { return cmp.Compare(a.LastName, b.LastName) }
+	case "isActive", "is_active":
+		return func(a, b *UserQueryResult) int { return query.CompareBool(a.IsActive, b.IsActive) }
+	case "updatedAt", "updated_at":
+		return func(a, b *UserQueryResult) int { return a.UpdatedAt.Compare(b.UpdatedAt) }
+	case "deletedAt", "deleted_at":
+		return func(a, b *UserQueryResult) int { return query.CompareNullTime(a.DeletedAt, b.DeletedAt) }
+	case "id":
+		return func(a, b *UserQueryResult) int { return cmp.Compare(a.ID, b.ID) }
+	default:
+		return func(a, b *UserQueryResult) int { return a.CreatedAt.Compare(b.CreatedAt) }
+	}
+}
+
+func (r *InMemRepo) Create(ctx context.Context, input *CreateUserInput) (*CreateUserResult, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	email := strings.ToLower(input.Email)
+	if r.find(byEmail(email)) != nil {
+		return nil, ErrUserAlreadyExists
+	}
+
+	publicID, err := nanoid.GeneratePublicID()
+	if err != nil {
+		return nil, err
+	}
+
+	userType := input.Type
+	if userType == "" {
+		userType = UserTypeHuman
+	}
+
+	isActive := true
+	if input.IsActive != nil {
+		isActive = *input.IsActive
+	}
+
+	now := postgres.Now()
+	u := &UserQueryResult{
+		ID:        r.nextID(),
+		PublicID:  publicID,
+		Type:      userType,
+		Email:     email,
+		FirstName: input.FirstName,
+		LastName:  input.LastName,
+		AvatarURL: input.AvatarURL,
+		IsActive:  isActive,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	r.users = append(r.users, u)
+	if input.PendingApproval {
+		r.pending[u.ID] = true
+	}
+
+	return &CreateUserResult{
+		ID:            u.ID,
+		PublicID:      u.PublicID,
+		Type:          u.Type,
+		Email:         u.Email,
+		FirstName:     u.FirstName,
+		LastName:      u.LastName,
+		AvatarURL:     u.AvatarURL,
+		IsActive:      u.IsActive,
+		EmailVerified: u.EmailVerified,
+		CreatedAt:     u.CreatedAt,
+		UpdatedAt:     u.UpdatedAt,
+	}, nil
+}
+
+func (r *InMemRepo) GetByEmail(ctx context.Context, email string) (*User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	u := r.find(byEmail(email))
+	if u == nil {
+		return nil, ErrUserNotFound
+	}
+	return liveUser(u), nil
+}
+
+func (r *InMemRepo) GetByID(ctx context.Context, publicID string) (*User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	u := r.findLive(byPublicID(publicID))
+	if u == nil {
+		return nil, ErrUserNotFound
+	}
+	return liveUser(u), nil
+}
+
+func (r *InMemRepo) GetByInternalID(ctx context.Context, internalID int64) (*User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	u := r.findLive(byInternalID(internalID))
+	if u == nil {
+		return nil, ErrUserNotFound
+	}
+	return liveUser(u), nil
+}
+
+func (r *InMemRepo) Update(ctx context.Context, input *UpdateUserInput) (*UpdateUserResult, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	email := strings.ToLower(input.Email)
+	if existing := r.find(byEmail(email)); existing != nil && existing.PublicID != input.PublicID {
+		return nil, ErrUserAlreadyExists
+	}
+
+	u := r.findLive(byPublicID(input.PublicID))
+	if u == nil {
+		return nil, ErrUserNotFound
+	}
+	if input.ExpectedUpdatedAt != nil && !u.UpdatedAt.Equal(*input.ExpectedUpdatedAt) {
+		return nil, ErrUserVersionConflict
+	}
+
+	u.Email = email
+	u.FirstName = input.FirstName
+	u.LastName = input.LastName
+	u.UpdatedAt = postgres.NextTimestamp(u.UpdatedAt)
+
+	return &UpdateUserResult{
+		ID:            u.ID,
+		PublicID:      u.PublicID,
+		Type:          u.Type,
+		Email:         u.Email,
+		FirstName:     u.FirstName,
+		LastName:      u.LastName,
+		AvatarURL:     u.AvatarURL,
+		IsActive:      u.IsActive,
+		EmailVerified: u.EmailVerified,
+		LockedUntil:   activeLock(u),
+		CreatedAt:     u.CreatedAt,
+		UpdatedAt:     u.UpdatedAt,
+	}, nil
+}
+
+func (r *InMemRepo) UpdateProfileByInternalID(ctx context.Context, internalID int64, firstName, lastName string) (*User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	u := r.findLive(byInternalID(internalID))
+	if u == nil {
+		return nil, ErrUserNotFound
+	}
+
+	u.FirstName = firstName
+	u.LastName = lastName
+	u.UpdatedAt = postgres.NextTimestamp(u.UpdatedAt)
+
+	return liveUser(u), nil
+}
+
+func (r *InMemRepo) Delete(ctx context.Context, publicID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	u := r.findLive(byPublicID(publicID))
+	if u == nil {
+		return ErrUserNotFound
+	}
+
+	now := postgres.NextTimestamp(u.UpdatedAt)
+	u.DeletedAt = &now
+	u.UpdatedAt = now
+	return nil
+}
+
+func (r *InMemRepo) Restore(ctx context.Context, publicID string) (*User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	u := r.find(func(u *UserQueryResult) bool {
+		return u.PublicID == publicID && u.DeletedAt != nil
+	})
+	if u == nil {
+		return nil, ErrUserNotFound
+	}
+
+	u.DeletedAt = nil
+	u.UpdatedAt = postgres.NextTimestamp(u.UpdatedAt)
+	return liveUser(u), nil
+}
+
+// PurgeDeleted permanently removes users trashed before the given time, along
+// with their identities and project memberships
+func (r *InMemRepo) PurgeDeleted(ctx context.Context, before time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	purged := make(map[int64]bool)
+	kept := r.users[:0]
+	for _, u := range r.users {
+		if u.DeletedAt != nil && u.DeletedAt.Before(before) {
+			purged[u.ID] = true
+			continue
+		}
+		kept = append(kept, u)
+	}
+	r.users = kept
+	for id := range purged {
+		delete(r.pending, id)
+	}
+
+	identities := r.identities[:0]
+	for _, identity := range r.identities {
+		if !purged[identity.UserID] {
+			identities = append(identities, identity)
+		}
+	}
+	r.identities = identities
+
+	tokens := r.tokens[:0]
+	for _, token := range r.tokens {
+		if !purged[token.UserID] {
+			tokens = append(tokens, token)
+		}
+	}
+	r.tokens = tokens
+
+	for key := range r.members {
+		if purged[key[1]] {
+			delete(r.members, key)
+		}
+	}
+
+	return int64(len(purged)), nil
+}
+
+// PutVerificationToken adds a pending email verification token of a user, as
+// issued by the oauth_auth domain: the previous pending token is invalidated
+func (r *InMemRepo) PutVerificationToken(userID int64, expiresAt time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	for _, token := range r.tokens {
+		if token.UserID == userID && token.usedAt == nil && token.invalidatedAt == nil {
+			token.invalidatedAt = &now
+		}
+	}
+
+	r.tokens = append(r.tokens, &inMemVerificationToken{VerificationToken: VerificationToken{
+		ID:        r.nextID(),
+		UserID:    userID,
+		ExpiresAt: expiresAt,
+		CreatedAt: now,
+	}})
+}
+
+// pendingTokens returns the unused, unexpired tokens of a user in insertion order
+func (r *InMemRepo) pendingTokens(userID int64) []*inMemVerificationToken {
+	now := time.Now()
+	var pending []*inMemVerificationToken
+	for _, token := range r.tokens {
+		if token.UserID == userID && token.usedAt == nil && token.invalidatedAt == nil && token.ExpiresAt.After(now) {
+			pending = append(pending, token)
+		}
+	}
+	return pending
+}
+
+func (r *InMemRepo) GetPendingVerificationTokens(ctx context.Context, userID int64) ([]*VerificationToken, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	pending := r.pendingTokens(userID)
+	tokens := make([]*VerificationToken, 0, len(pending))
+	for i := len(pending) - 1; i >= 0; i-- {
+		token := pending[i].VerificationToken
+		tokens = append(tokens, &token)
+	}
+	return tokens, nil
+}
+
+func (r *InMemRepo) InvalidateVerificationTokens(ctx context.Context, userID int64) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	pending := r.pendingTokens(userID)
+	for _, token := range pending {
+		token.invalidatedAt = &now
+	}
+	return int64(len(pending)), nil
+}
+
+func (r *InMemRepo) GetVerificationStats(ctx context.Context, since time.Time) (*VerificationStats, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	now := time.Now()
+	var stats VerificationStats
+	var durations []time.Duration
+	for _, token := range r.tokens {
+		if token.CreatedAt.Before(since) {
+			continue
+		}
+		stats.Sent++
+		switch {
+		case token.usedAt != nil:
+			stats.Verified++
+			durations = append(durations, token.usedAt.Sub(token.CreatedAt))
+		case token.invalidatedAt != nil:
+			stats.Superseded++
+		case token.ExpiresAt.After(now):
+			stats.Pending++
+		default:
+			stats.Expired++
+		}
+	}
+
+	// Interpolated median, as percentile_cont computes it
+	if n := len(durations); n > 0 {
+		slices.Sort(durations)
+		stats.MedianTimeToVerify = (durations[(n-1)/2] + durations[n/2]) / 2
+	}
+	return &stats, nil
+}
+
+func (r *InMemRepo) ResetEmailVerified(ctx context.Context, publicID string) (*User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	u := r.findLive(byPublicID(publicID))
+	if u == nil {
+		return nil, ErrUserNotFound
+	}
+
+	u.EmailVerified = false
+	u.UpdatedAt = postgres.NextTimestamp(u.UpdatedAt)
+	return liveUser(u), nil
+}
+
+func (r *InMemRepo) Activate(ctx context.Context, publicID string) (*User, error) {
+	return r.setActive(publicID, true)
+}
+
+func (r *InMemRepo) Deactivate(ctx context.Context, publicID string) (*User, error) {
+	return r.setActive(publicID, false)
+}
+
+func (r *InMemRepo) Approve(ctx context.Context, publicID string) (*User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	u, err := r.findPending(publicID)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(r.pending, u.ID)
+	u.IsActive = true
+	u.UpdatedAt = postgres.NextTimestamp(u.UpdatedAt)
+	return liveUser(u), nil
+}
+
+func (r *InMemRepo) Reject(ctx context.Context, publicID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	u, err := r.findPending(publicID)
+	if err != nil {
+		return err
+	}
+
+	delete(r.pending, u.ID)
+	now := postgres.NextTimestamp(u.UpdatedAt)
+	u.DeletedAt = &now
+	u.UpdatedAt = now
+	return nil
+}
+
+// LockUser locks a user until the given time, as the oauth_auth domain does
+// after too many failed sign-ins
+func (r *InMemRepo) LockUser(userID int64, until time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if u := r.find(byInternalID(userID)); u != nil {
+		u.LockedUntil = &until
+	}
+}
+
+func (r *InMemRepo) Unlock(ctx context.Context, publicID string) (*User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	u := r.findLive(byPublicID(publicID))
+	if u == nil {
+		return nil, ErrUserNotFound
+	}
+	if activeLock(u) == nil {
+		return nil, ErrUserNotLocked
+	}
+
+	u.LockedUntil = nil
+	u.UpdatedAt = postgres.NextTimestamp(u.UpdatedAt)
+	return liveUser(u), nil
+}
+
+func (r *InMemRepo) findPending(publicID string) (*UserQueryResult, error) {
+	u := r.findLive(byPublicID(publicID))
+	if u == nil {
+		return nil, ErrUserNotFound
+	}
+	if !r.pending[u.ID] {
+		return nil, ErrUserNotPending
+	}
+	return u, nil
+}
+
+func (r *InMemRepo) setActive(publicID string, active bool) (*User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	u := r.findLive(byPublicID(publicID))
+	if u == nil {
+		return nil, ErrUserNotFound
+	}
+	if u.IsActive == active {
+		if active {
+			return nil, ErrUserAlreadyActive
+		}
+		return nil, ErrUserAlreadyInactive
+	}
+
+	u.IsActive = active
+	u.UpdatedAt = postgres.NextTimestamp(u.UpdatedAt)
+	if active {
+		delete(r.pending, u.ID)
+	}
+	return liveUser(u), nil
+}
+
+func (r *InMemRepo) GetUserIdentityByProvider(ctx context.Context, provider, providerUserID string) (*UserIdentity, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, identity := range r.identities {
+		if identity.Provider == provider && identity.ProviderUserID == providerUserID {
+			clone := *identity
+			return &clone, nil
+		}
+	}
+	return nil, ErrUserNotFound
+}
+
+func (r *InMemRepo) GetUserIdentities(ctx context.Context, userID int64) ([]*UserIdentity, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var identities []*UserIdentity
+	for _, identity := range r.identities {
+		if identity.UserID == userID {
+			clone := *identity
+			identities = append(identities, &clone)
+		}
+	}
+	query.SortRows(identities, query.SortOrderDesc, func(a, b *UserIdentity) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+	return identities, nil
+}
+
+func (r *InMemRepo) CreateUserIdentity(ctx context.Context, input *CreateUserIdentityInput) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.find(byInternalID(input.UserID)) == nil {
+		return fmt.Errorf("create user identity: %w", ErrUserNotFound)
+	}
+	for _, identity := range r.identities {
+		if identity.Provider == input.Provider && identity.ProviderUserID == input.ProviderUserID {
+			return fmt.Errorf("create user identity: %s identity %s is already linked", input.Provider, input.ProviderUserID)
+		}
+	}
+
+	publicID, err := nanoid.GeneratePublicID()
+	if err != nil {
+		return err
+	}
+
+	now := postgres.Now()
+	r.identities = append(r.identities, &UserIdentity{
+		ID:                    r.nextID(),
+		PublicID:              publicID,
+		UserID:                input.UserID,
+		Provider:              input.Provider,
+		ProviderUserID:        input.ProviderUserID,
+		Email:                 input.Email,
+		FirstName:             input.FirstName,
+		LastName:              input.LastName,
+		OAuthClientID:         input.OAuthClientID,
+		OriginOAuthClientName: input.OriginOAuthClientName,
+		LastLoginAt:           &now,
+		CreatedAt:             now,
+		UpdatedAt:             now,
+	})
+	return nil
+}
+
+func (r *InMemRepo) UpdateUserIdentityLastLogin(ctx context.Context, userID int64, provider string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := postgres.Now()
+	updated := false
+	for _, identity := range r.identities {
+		if identity.UserID == userID && identity.Provider == provider {
+			identity.LastLoginAt = &now
+			identity.UpdatedAt = now
+			updated = true
+		}
+	}
+	if !updated {
+		return ErrUserNotFound
+	}
+	return nil
+}
+
+// AddProjectMember adds a user to a project, keeping the existing role when
+// the user already is a member
+func (r *InMemRepo) AddProjectMember(ctx context.Context, projectID, userID int64, role string, maxMembers int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.find(byInternalID(userID)) == nil {
+		return fmt.Errorf("add project member: %w", ErrUserNotFound)
+	}
+	key := [2]int64{projectID, userID}
+	if _, ok := r.members[key]; ok {
+		return nil
+	}
+	if maxMembers > 0 {
+		count := 0
+		for k := range r.members {
+			if k[0] == projectID {
+				count++
+			}
+		}
+		if count >= maxMembers {
+			return ErrMemberLimitReached
+		}
+	}
+	r.members[key] = role
+	return nil
+}
+
+// GetProjectOwners returns the active owners of a project that have an email
+func (r *InMemRepo) GetProjectOwners(ctx context.Context, projectID int64) ([]*ProjectOwner, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	owners := make([]*ProjectOwner, 0)
+	for _, u := range r.users {
+		if u.DeletedAt == nil && u.IsActive && u.Email != "" && r.members[[2]int64{projectID, u.ID}] == "owner" {
+			owners = append(owners, &ProjectOwner{Email: u.Email, FirstName: u.FirstName})
+		}
+	}
+	return owners, nil
+}
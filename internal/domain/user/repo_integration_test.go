@@ -0,0 +1,45 @@
+package user_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hrz8/altalune/internal/domain/user"
+	"github.com/hrz8/altalune/internal/shared/query"
+	"github.com/hrz8/altalune/internal/testdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepoIntegration(t *testing.T) {
+	ctx := context.Background()
+	db := testdb.Tx(t)
+	fixtures := testdb.Seed(t, db)
+	repo := user.NewRepo(db)
+
+	seeded, err := repo.GetByID(ctx, fixtures.UserPublicID)
+	require.NoError(t, err)
+	assert.Equal(t, fixtures.UserEmail, seeded.Email)
+	assert.True(t, seeded.EmailVerified)
+
+	created, err := repo.Create(ctx, &user.CreateUserInput{Email: "Integration-" + fixtures.UserPublicID + "@Example.com"})
+	require.NoError(t, err)
+	assert.Equal(t, "integration-"+fixtures.UserPublicID+"@example.com", created.Email)
+
+	updated, err := repo.UpdateProfileByInternalID(ctx, created.ID, "Inte", "Gration")
+	require.NoError(t, err)
+	assert.Equal(t, "Inte", updated.FirstName)
+
+	result, err := repo.Query(ctx, &query.QueryParams{
+		Pagination: query.PaginationParams{Page: 1, PageSize: 10},
+		Keyword:    fixtures.UserPublicID,
+		Sorting:    &query.SortingParams{Field: "email", Order: query.SortOrderAsc},
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Data, 2, "the query sees the writes of the test transaction")
+	assert.Equal(t, fixtures.UserEmail, result.Data[0].Email)
+
+	require.NoError(t, repo.Delete(ctx, created.PublicID))
+	_, err = repo.GetByID(ctx, created.PublicID)
+	assert.ErrorIs(t, err, user.ErrUserNotFound)
+}
@@ -0,0 +1,59 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+// Unlock lifts the lock of a user locked after too many failed sign-ins and
+// starts the failed sign-in count over
+func (r *Repo) Unlock(ctx context.Context, publicID string) (*User, error) {
+	sqlQuery := `
+		UPDATE altalune_users
+		SET failed_login_attempts = 0, locked_until = NULL, updated_at = CURRENT_TIMESTAMP
+		WHERE public_id = $1 AND deleted_at IS NULL AND locked_until > NOW()
+		RETURNING public_id, user_type, COALESCE(email, ''), first_name, last_name, avatar_url, is_active, email_verified, created_at, updated_at
+	`
+
+	var usr User
+	var firstName, lastName, avatarURL sql.NullString
+
+	err := r.db.QueryRowContext(ctx, sqlQuery, publicID).Scan(
+		&usr.ID,
+		&usr.Type,
+		&usr.Email,
+		&firstName,
+		&lastName,
+		&avatarURL,
+		&usr.IsActive,
+		&usr.EmailVerified,
+		&usr.CreatedAt,
+		&usr.UpdatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// Tell a user that is not locked apart from a missing one
+			if _, err := r.GetIDByPublicID(ctx, publicID); err != nil {
+				return nil, err
+			}
+			return nil, ErrUserNotLocked
+		}
+		return nil, fmt.Errorf("failed to unlock user: %w", err)
+	}
+
+	// Handle nullable fields
+	if firstName.Valid {
+		usr.FirstName = firstName.String
+	}
+	if lastName.Valid {
+		usr.LastName = lastName.String
+	}
+	if avatarURL.Valid {
+		usr.AvatarURL = avatarURL.String
+	}
+
+	return &usr, nil
+}
@@ -0,0 +1,145 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// GetPendingVerificationTokens returns the unused, unexpired email verification
+// tokens of a user, newest first. Issuing a token invalidates the previous
+// one, so there is at most one.
+func (r *Repo) GetPendingVerificationTokens(ctx context.Context, userID int64) ([]*VerificationToken, error) {
+	query := `
+		SELECT id, user_id, expires_at, created_at
+		FROM altalune_email_verification_tokens
+		WHERE user_id = $1 AND used_at IS NULL AND invalidated_at IS NULL AND expires_at > NOW()
+		ORDER BY created_at DESC, id DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get pending verification tokens: %w", err)
+	}
+	defer rows.Close()
+
+	tokens := make([]*VerificationToken, 0)
+	for rows.Next() {
+		var token VerificationToken
+		if err := rows.Scan(&token.ID, &token.UserID, &token.ExpiresAt, &token.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan verification token: %w", err)
+		}
+		tokens = append(tokens, &token)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate verification tokens: %w", err)
+	}
+
+	return tokens, nil
+}
+
+// InvalidateVerificationTokens invalidates every pending email verification
+// token of a user and returns how many were invalidated
+func (r *Repo) InvalidateVerificationTokens(ctx context.Context, userID int64) (int64, error) {
+	query := `
+		UPDATE altalune_email_verification_tokens
+		SET invalidated_at = NOW()
+		WHERE user_id = $1 AND used_at IS NULL AND invalidated_at IS NULL AND expires_at > NOW()
+	`
+
+	result, err := r.db.ExecContext(ctx, query, userID)
+	if err != nil {
+		return 0, fmt.Errorf("invalidate verification tokens: %w", err)
+	}
+
+	invalidated, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("get rows affected: %w", err)
+	}
+
+	return invalidated, nil
+}
+
+// GetVerificationStats counts the email verification tokens sent since the
+// given time by outcome
+func (r *Repo) GetVerificationStats(ctx context.Context, since time.Time) (*VerificationStats, error) {
+	query := `
+		SELECT
+			COUNT(*),
+			COUNT(*) FILTER (WHERE used_at IS NOT NULL),
+			COUNT(*) FILTER (WHERE used_at IS NULL AND invalidated_at IS NULL AND expires_at > NOW()),
+			COUNT(*) FILTER (WHERE used_at IS NULL AND invalidated_at IS NULL AND expires_at <= NOW()),
+			COUNT(*) FILTER (WHERE invalidated_at IS NOT NULL),
+			COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM used_at - created_at))
+				FILTER (WHERE used_at IS NOT NULL), 0)
+		FROM altalune_email_verification_tokens
+		WHERE created_at >= $1
+	`
+
+	var stats VerificationStats
+	var medianSeconds float64
+	err := r.db.QueryRowContext(ctx, query, since).Scan(
+		&stats.Sent,
+		&stats.Verified,
+		&stats.Pending,
+		&stats.Expired,
+		&stats.Superseded,
+		&medianSeconds,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get verification stats: %w", err)
+	}
+	stats.MedianTimeToVerify = time.Duration(medianSeconds * float64(time.Second))
+
+	return &stats, nil
+}
+
+// ResetEmailVerified marks the email of a user as unverified
+func (r *Repo) ResetEmailVerified(ctx context.Context, publicID string) (*User, error) {
+	sqlQuery := `
+		UPDATE altalune_users
+		SET email_verified = false, updated_at = CURRENT_TIMESTAMP
+		WHERE public_id = $1 AND deleted_at IS NULL
+		RETURNING public_id, user_type, COALESCE(email, ''), first_name, last_name, avatar_url, is_active, email_verified,
+		          CASE WHEN locked_until > NOW() THEN locked_until END, created_at, updated_at
+	`
+
+	var usr User
+	var firstName, lastName, avatarURL sql.NullString
+
+	err := r.db.QueryRowContext(ctx, sqlQuery, publicID).Scan(
+		&usr.ID,
+		&usr.Type,
+		&usr.Email,
+		&firstName,
+		&lastName,
+		&avatarURL,
+		&usr.IsActive,
+		&usr.EmailVerified,
+		&usr.LockedUntil,
+		&usr.CreatedAt,
+		&usr.UpdatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to reset email verification: %w", err)
+	}
+
+	// Handle nullable fields
+	if firstName.Valid {
+		usr.FirstName = firstName.String
+	}
+	if lastName.Valid {
+		usr.LastName = lastName.String
+	}
+	if avatarURL.Valid {
+		usr.AvatarURL = avatarURL.String
+	}
+
+	return &usr, nil
+}
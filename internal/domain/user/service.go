@@ -2,11 +2,14 @@ package user
 
 import (
 	"context"
+	"errors"
 	"strings"
+	"time"
 
 	"buf.build/go/protovalidate"
 	"github.com/hrz8/altalune"
 	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	"github.com/hrz8/altalune/internal/shared/emailcheck"
 	"github.com/hrz8/altalune/internal/shared/query"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -29,6 +32,12 @@ type EmailVerificationSender interface {
 	GenerateAndSendVerificationEmail(ctx context.Context, userID int64) error
 }
 
+// PlanLimiter provides the billing plan of a project, whose member limit
+// applies to the users added to the default project
+type PlanLimiter interface {
+	ProjectPlan(ctx context.Context, projectID int64) (altalune.BillingPlan, error)
+}
+
 // Default project ID for new users created by admin
 const DefaultProjectID = 1
 
@@ -43,6 +52,8 @@ type Service struct {
 	roleLookup          RoleLookup
 	userRoleAssigner    UserRoleAssigner
 	verificationService EmailVerificationSender
+	emailChecker        *emailcheck.Checker
+	limiter             PlanLimiter
 }
 
 func NewService(
@@ -52,6 +63,8 @@ func NewService(
 	roleLookup RoleLookup,
 	userRoleAssigner UserRoleAssigner,
 	verificationService EmailVerificationSender,
+	emailChecker *emailcheck.Checker,
+	limiter PlanLimiter,
 ) *Service {
 	return &Service{
 		validator:           v,
@@ -60,6 +73,8 @@ func NewService(
 		roleLookup:          roleLookup,
 		userRoleAssigner:    userRoleAssigner,
 		verificationService: verificationService,
+		emailChecker:        emailChecker,
+		limiter:             limiter,
 	}
 }
 
@@ -79,8 +94,19 @@ func (s *Service) QueryUsers(ctx context.Context, req *altalunev1.QueryUsersRequ
 		}
 	}
 
+	fields, err := query.ReadMaskFields(req.ReadMask, &altalunev1.User{})
+	if err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
 	// Convert proto request to domain query params
 	queryParams := query.DefaultQueryParams(req.Query)
+	queryParams.Trashed = req.Trashed
+	queryParams.Fields = fields
+
+	if err := queryParams.ApplyCursor(req.Query.GetCursor()); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
 
 	// Query users from repository
 	result, err := s.userRepo.Query(ctx, queryParams)
@@ -97,23 +123,88 @@ func (s *Service) QueryUsers(ctx context.Context, req *altalunev1.QueryUsersRequ
 		return &altalunev1.QueryUsersResponse{
 			Data: []*altalunev1.User{},
 			Meta: &altalunev1.QueryMetaResponse{
-				RowCount:  0,
-				PageCount: 0,
-				Filters:   make(map[string]*altalunev1.FilterValues),
+				RowCount:   0,
+				PageCount:  0,
+				ExactTotal: true,
+				Filters:    make(map[string]*altalunev1.FilterValues),
 			},
 		}, nil
 	}
 
 	return &altalunev1.QueryUsersResponse{
-		Data: mapUsersToProto(result.Data),
+		Data: query.PruneAll(mapUsersToProto(result.Data), queryParams.Fields),
 		Meta: &altalunev1.QueryMetaResponse{
-			RowCount:  result.TotalRows,
-			PageCount: result.TotalPages,
-			Filters:   mapFiltersToProto(result.Filters),
+			RowCount:    result.TotalRows,
+			PageCount:   result.TotalPages,
+			Filters:     mapFiltersToProto(result.Filters),
+			CountMode:   query.CountModeToProto(result.Count),
+			HasNextPage: result.HasMore,
+			NextCursor:  query.NextCursor(queryParams, result),
+			ExactTotal:  result.ExactTotal(),
 		},
 	}, nil
 }
 
+// StreamUsers implements the gRPC server stream of StreamUsersTo.
+func (s *Service) StreamUsers(req *altalunev1.StreamUsersRequest, stream altalunev1.UserService_StreamUsersServer) error {
+	return s.StreamUsersTo(stream.Context(), req, stream.Send)
+}
+
+// StreamUsersTo sends every user matching the query through send, one page
+// per message.
+func (s *Service) StreamUsersTo(
+	ctx context.Context,
+	req *altalunev1.StreamUsersRequest,
+	send func(*altalunev1.StreamUsersResponse) error,
+) error {
+	// Validate request
+	if err := s.validator.Validate(req); err != nil {
+		return altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	fields, err := query.ReadMaskFields(req.ReadMask, &altalunev1.User{})
+	if err != nil {
+		return altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	queryParams := query.DefaultQueryParams(req.Query)
+	queryParams.Fields = fields
+
+	if err := queryParams.ApplyCursor(req.Query.GetCursor()); err != nil {
+		return altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	return query.StreamPages(queryParams,
+		func(params *query.QueryParams) (*query.QueryResult[User], error) {
+			result, err := s.userRepo.Query(ctx, params)
+			if err != nil {
+				s.log.Error("failed to stream users",
+					"error", err,
+					"page", params.Pagination.Page,
+				)
+				return nil, altalune.NewUnexpectedError("failed to stream users: %w", err)
+			}
+			return result, nil
+		},
+		func(result *query.QueryResult[User], first bool) error {
+			response := &altalunev1.StreamUsersResponse{
+				Data: query.PruneAll(mapUsersToProto(result.Data), queryParams.Fields),
+			}
+			if first {
+				response.Meta = &altalunev1.QueryMetaResponse{
+					RowCount:    result.TotalRows,
+					PageCount:   result.TotalPages,
+					Filters:     mapFiltersToProto(result.Filters),
+					CountMode:   query.CountModeToProto(result.Count),
+					HasNextPage: result.HasMore,
+					ExactTotal:  result.ExactTotal(),
+				}
+			}
+			return send(response)
+		},
+	)
+}
+
 func (s *Service) CreateUser(ctx context.Context, req *altalunev1.CreateUserRequest) (*altalunev1.CreateUserResponse, error) {
 	// Validate request
 	if err := s.validator.Validate(req); err != nil {
@@ -123,6 +214,11 @@ func (s *Service) CreateUser(ctx context.Context, req *altalunev1.CreateUserRequ
 	// Lowercase email for consistency
 	email := strings.ToLower(strings.TrimSpace(req.Email))
 
+	// The user is sent a verification email, so the address must receive it
+	if err := s.checkEmail(ctx, email); err != nil {
+		return nil, err
+	}
+
 	// Check if user with same email already exists
 	existingUser, err := s.userRepo.GetByEmail(ctx, email)
 	if err != nil && err != ErrUserNotFound {
@@ -170,8 +266,11 @@ func (s *Service) CreateUser(ctx context.Context, req *altalunev1.CreateUserRequ
 		}
 	}
 
-	// Assign user to default project with role 'member'
-	if err := s.userRepo.AddProjectMember(ctx, DefaultProjectID, result.ID, DefaultProjectRoleMember); err != nil {
+	// Assign user to default project with role 'member', within the members
+	// its plan allows
+	if plan, err := s.limiter.ProjectPlan(ctx, DefaultProjectID); err != nil {
+		s.log.Warn("failed to get plan of default project", "error", err, "userID", result.ID, "email", email)
+	} else if err := s.userRepo.AddProjectMember(ctx, DefaultProjectID, result.ID, DefaultProjectRoleMember, plan.MaxMembers); err != nil {
 		s.log.Warn("failed to add user to default project", "error", err, "userID", result.ID, "email", email)
 	} else {
 		s.log.Info("assigned user to default project", "userID", result.ID, "projectID", DefaultProjectID, "role", DefaultProjectRoleMember)
@@ -189,6 +288,7 @@ func (s *Service) CreateUser(ctx context.Context, req *altalunev1.CreateUserRequ
 	return &altalunev1.CreateUserResponse{
 		User: &altalunev1.User{
 			Id:        result.PublicID,
+			Type:      UserTypeToProto(result.Type),
 			Email:     result.Email,
 			FirstName: result.FirstName,
 			LastName:  result.LastName,
@@ -200,6 +300,33 @@ func (s *Service) CreateUser(ctx context.Context, req *altalunev1.CreateUserRequ
 	}, nil
 }
 
+// CreateServiceAccount creates an active service account. Unlike users it
+// gets no default role or project membership: automation is granted exactly
+// what it needs.
+func (s *Service) CreateServiceAccount(ctx context.Context, req *altalunev1.CreateServiceAccountRequest) (*altalunev1.CreateServiceAccountResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	isActive := true
+	result, err := s.userRepo.Create(ctx, &CreateUserInput{
+		Type:      UserTypeServiceAccount,
+		FirstName: strings.TrimSpace(req.Name),
+		IsActive:  &isActive,
+	})
+	if err != nil {
+		s.log.Error("failed to create service account", "error", err, "name", req.Name)
+		return nil, altalune.NewUnexpectedError("failed to create service account: %w", err)
+	}
+
+	s.log.Info("service account created", "user_id", result.PublicID, "name", result.FirstName)
+
+	return &altalunev1.CreateServiceAccountResponse{
+		User:    result.ToUser().ToUserProto(),
+		Message: "Service account created successfully",
+	}, nil
+}
+
 func (s *Service) GetUser(ctx context.Context, req *altalunev1.GetUserRequest) (*altalunev1.GetUserResponse, error) {
 	if err := s.validator.Validate(req); err != nil {
 		return nil, altalune.NewInvalidPayloadError(err.Error())
@@ -271,6 +398,9 @@ func (s *Service) UpdateUser(ctx context.Context, req *altalunev1.UpdateUserRequ
 		}
 		return nil, altalune.NewUnexpectedError("failed to resolve user ID", err)
 	}
+	if err := s.requireHuman(ctx, req.Id); err != nil {
+		return nil, err
+	}
 
 	// Lowercase email for consistency
 	email := strings.ToLower(strings.TrimSpace(req.Email))
@@ -282,12 +412,19 @@ func (s *Service) UpdateUser(ctx context.Context, req *altalunev1.UpdateUserRequ
 		FirstName: strings.TrimSpace(req.FirstName),
 		LastName:  strings.TrimSpace(req.LastName),
 	}
+	if req.ExpectedUpdatedAt != nil {
+		expectedUpdatedAt := req.ExpectedUpdatedAt.AsTime()
+		input.ExpectedUpdatedAt = &expectedUpdatedAt
+	}
 
 	result, err := s.userRepo.Update(ctx, input)
 	if err != nil {
 		if err == ErrUserNotFound {
 			return nil, altalune.NewUserNotFoundError(req.Id)
 		}
+		if err == ErrUserVersionConflict {
+			return nil, altalune.NewVersionConflictError("user", req.Id)
+		}
 		if err == ErrUserAlreadyExists {
 			return nil, altalune.NewUserAlreadyExistsError(email)
 		}
@@ -324,6 +461,28 @@ func (s *Service) DeleteUser(ctx context.Context, req *altalunev1.DeleteUserRequ
 	}, nil
 }
 
+func (s *Service) RestoreUser(ctx context.Context, req *altalunev1.RestoreUserRequest) (*altalunev1.RestoreUserResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	user, err := s.userRepo.Restore(ctx, req.Id)
+	if err != nil {
+		if err == ErrUserNotFound {
+			return nil, altalune.NewUserNotFoundError(req.Id)
+		}
+		s.log.Error("failed to restore user", "error", err, "user_id", req.Id)
+		return nil, altalune.NewUnexpectedError("failed to restore user", err)
+	}
+
+	s.log.Info("user restored successfully", "user_id", req.Id)
+
+	return &altalunev1.RestoreUserResponse{
+		User:    user.ToUserProto(),
+		Message: "User restored successfully",
+	}, nil
+}
+
 func (s *Service) ActivateUser(ctx context.Context, req *altalunev1.ActivateUserRequest) (*altalunev1.ActivateUserResponse, error) {
 	if err := s.validator.Validate(req); err != nil {
 		return nil, altalune.NewInvalidPayloadError(err.Error())
@@ -373,3 +532,331 @@ func (s *Service) DeactivateUser(ctx context.Context, req *altalunev1.Deactivate
 		Message: "User deactivated successfully",
 	}, nil
 }
+
+func (s *Service) QueryPendingUsers(ctx context.Context, req *altalunev1.QueryPendingUsersRequest) (*altalunev1.QueryPendingUsersResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	if req.Query == nil {
+		req.Query = &altalunev1.QueryRequest{
+			Pagination: &altalunev1.Pagination{
+				Page:     1,
+				PageSize: 10,
+			},
+		}
+	}
+
+	// The queue is served oldest registration first unless sorted otherwise
+	queryParams := query.DefaultQueryParams(req.Query)
+	queryParams.Filters["pending_approval"] = []string{"true"}
+	if queryParams.Sorting == nil || queryParams.Sorting.Field == "" {
+		queryParams.Sorting = &query.SortingParams{Field: "created_at", Order: query.SortOrderAsc}
+	}
+
+	if err := queryParams.ApplyCursor(req.Query.GetCursor()); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	result, err := s.userRepo.Query(ctx, queryParams)
+	if err != nil {
+		s.log.Error("failed to query pending users",
+			"error", err,
+			"keyword", queryParams.Keyword,
+		)
+		return nil, altalune.NewUnexpectedError("failed to query pending users: %w", err)
+	}
+
+	return &altalunev1.QueryPendingUsersResponse{
+		Data: mapUsersToProto(result.Data),
+		Meta: &altalunev1.QueryMetaResponse{
+			RowCount:    result.TotalRows,
+			PageCount:   result.TotalPages,
+			Filters:     mapFiltersToProto(result.Filters),
+			CountMode:   query.CountModeToProto(result.Count),
+			HasNextPage: result.HasMore,
+			NextCursor:  query.NextCursor(queryParams, result),
+			ExactTotal:  result.ExactTotal(),
+		},
+	}, nil
+}
+
+func (s *Service) ApproveUser(ctx context.Context, req *altalunev1.ApproveUserRequest) (*altalunev1.ApproveUserResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	userID, err := s.userRepo.GetIDByPublicID(ctx, req.Id)
+	if err != nil {
+		if err == ErrUserNotFound {
+			return nil, altalune.NewUserNotFoundError(req.Id)
+		}
+		s.log.Error("failed to get user", "error", err, "user_id", req.Id)
+		return nil, altalune.NewUnexpectedError("failed to get user", err)
+	}
+
+	user, err := s.userRepo.Approve(ctx, req.Id)
+	if err != nil {
+		if err == ErrUserNotFound {
+			return nil, altalune.NewUserNotFoundError(req.Id)
+		}
+		if err == ErrUserNotPending {
+			return nil, altalune.NewUserNotPendingError(req.Id)
+		}
+		s.log.Error("failed to approve user", "error", err, "user_id", req.Id)
+		return nil, altalune.NewUnexpectedError("failed to approve user", err)
+	}
+
+	// Approved users get the verification email auto-activated users get on sign-up
+	emailSent := false
+	message := "User approved"
+	if s.verificationService != nil && !user.EmailVerified {
+		if err := s.verificationService.GenerateAndSendVerificationEmail(ctx, userID); err != nil {
+			s.log.Warn("failed to send verification email", "error", err, "user_id", req.Id)
+			message = "User approved, but the verification email could not be sent"
+		} else {
+			emailSent = true
+			message = "User approved and verification email sent"
+		}
+	}
+
+	s.log.Info("user approved", "user_id", req.Id, "email_sent", emailSent)
+
+	return &altalunev1.ApproveUserResponse{
+		User:      user.ToUserProto(),
+		EmailSent: emailSent,
+		Message:   message,
+	}, nil
+}
+
+func (s *Service) RejectUser(ctx context.Context, req *altalunev1.RejectUserRequest) (*altalunev1.RejectUserResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	if err := s.userRepo.Reject(ctx, req.Id); err != nil {
+		if err == ErrUserNotFound {
+			return nil, altalune.NewUserNotFoundError(req.Id)
+		}
+		if err == ErrUserNotPending {
+			return nil, altalune.NewUserNotPendingError(req.Id)
+		}
+		s.log.Error("failed to reject user", "error", err, "user_id", req.Id)
+		return nil, altalune.NewUnexpectedError("failed to reject user", err)
+	}
+
+	s.log.Info("user rejected", "user_id", req.Id)
+
+	return &altalunev1.RejectUserResponse{
+		Message: "User rejected and moved to trash",
+	}, nil
+}
+
+func (s *Service) UnlockUser(ctx context.Context, req *altalunev1.UnlockUserRequest) (*altalunev1.UnlockUserResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	user, err := s.userRepo.Unlock(ctx, req.Id)
+	if err != nil {
+		if err == ErrUserNotFound {
+			return nil, altalune.NewUserNotFoundError(req.Id)
+		}
+		if err == ErrUserNotLocked {
+			return nil, altalune.NewUserNotLockedError(req.Id)
+		}
+		s.log.Error("failed to unlock user", "error", err, "user_id", req.Id)
+		return nil, altalune.NewUnexpectedError("failed to unlock user", err)
+	}
+
+	s.log.Info("user unlocked", "user_id", req.Id)
+
+	return &altalunev1.UnlockUserResponse{
+		User:    user.ToUserProto(),
+		Message: "User unlocked successfully",
+	}, nil
+}
+
+func (s *Service) ListEmailVerificationTokens(ctx context.Context, req *altalunev1.ListEmailVerificationTokensRequest) (*altalunev1.ListEmailVerificationTokensResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	userID, err := s.userRepo.GetIDByPublicID(ctx, req.Id)
+	if err != nil {
+		if err == ErrUserNotFound {
+			return nil, altalune.NewUserNotFoundError(req.Id)
+		}
+		s.log.Error("failed to get user", "error", err, "user_id", req.Id)
+		return nil, altalune.NewUnexpectedError("failed to get user", err)
+	}
+
+	tokens, err := s.userRepo.GetPendingVerificationTokens(ctx, userID)
+	if err != nil {
+		s.log.Error("failed to list verification tokens", "error", err, "user_id", req.Id)
+		return nil, altalune.NewUnexpectedError("failed to list verification tokens", err)
+	}
+
+	result := make([]*altalunev1.EmailVerificationToken, 0, len(tokens))
+	for _, token := range tokens {
+		result = append(result, token.ToVerificationTokenProto())
+	}
+
+	return &altalunev1.ListEmailVerificationTokensResponse{
+		Tokens: result,
+	}, nil
+}
+
+func (s *Service) InvalidateEmailVerificationTokens(ctx context.Context, req *altalunev1.InvalidateEmailVerificationTokensRequest) (*altalunev1.InvalidateEmailVerificationTokensResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	userID, err := s.userRepo.GetIDByPublicID(ctx, req.Id)
+	if err != nil {
+		if err == ErrUserNotFound {
+			return nil, altalune.NewUserNotFoundError(req.Id)
+		}
+		s.log.Error("failed to get user", "error", err, "user_id", req.Id)
+		return nil, altalune.NewUnexpectedError("failed to get user", err)
+	}
+
+	invalidated, err := s.userRepo.InvalidateVerificationTokens(ctx, userID)
+	if err != nil {
+		s.log.Error("failed to invalidate verification tokens", "error", err, "user_id", req.Id)
+		return nil, altalune.NewUnexpectedError("failed to invalidate verification tokens", err)
+	}
+
+	s.log.Info("verification tokens invalidated", "user_id", req.Id, "count", invalidated)
+
+	return &altalunev1.InvalidateEmailVerificationTokensResponse{
+		InvalidatedCount: int32(invalidated),
+		Message:          "Verification tokens invalidated successfully",
+	}, nil
+}
+
+// ForceEmailReverification marks the email of a user unverified, invalidates
+// the verification links already sent and sends a new one when an email
+// provider is configured. A failing email is logged and reported through
+// email_sent: the email stays unverified either way.
+func (s *Service) ForceEmailReverification(ctx context.Context, req *altalunev1.ForceEmailReverificationRequest) (*altalunev1.ForceEmailReverificationResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	userID, err := s.userRepo.GetIDByPublicID(ctx, req.Id)
+	if err != nil {
+		if err == ErrUserNotFound {
+			return nil, altalune.NewUserNotFoundError(req.Id)
+		}
+		s.log.Error("failed to get user", "error", err, "user_id", req.Id)
+		return nil, altalune.NewUnexpectedError("failed to get user", err)
+	}
+	if err := s.requireHuman(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepo.ResetEmailVerified(ctx, req.Id)
+	if err != nil {
+		if err == ErrUserNotFound {
+			return nil, altalune.NewUserNotFoundError(req.Id)
+		}
+		s.log.Error("failed to reset email verification", "error", err, "user_id", req.Id)
+		return nil, altalune.NewUnexpectedError("failed to reset email verification", err)
+	}
+
+	if _, err := s.userRepo.InvalidateVerificationTokens(ctx, userID); err != nil {
+		s.log.Error("failed to invalidate verification tokens", "error", err, "user_id", req.Id)
+		return nil, altalune.NewUnexpectedError("failed to invalidate verification tokens", err)
+	}
+
+	emailSent := false
+	message := "Email marked unverified, no email provider is configured to send a verification email"
+	if s.verificationService != nil {
+		if err := s.verificationService.GenerateAndSendVerificationEmail(ctx, userID); err != nil {
+			s.log.Warn("failed to send verification email", "error", err, "user_id", req.Id)
+			message = "Email marked unverified, but the verification email could not be sent"
+		} else {
+			emailSent = true
+			message = "Email marked unverified and verification email sent"
+		}
+	}
+
+	s.log.Info("forced email reverification", "user_id", req.Id, "email_sent", emailSent)
+
+	return &altalunev1.ForceEmailReverificationResponse{
+		User:      user.ToUserProto(),
+		EmailSent: emailSent,
+		Message:   message,
+	}, nil
+}
+
+// defaultVerificationStatsDays is the period of the verification statistics
+// when the request leaves it out
+const defaultVerificationStatsDays = 30
+
+// GetEmailVerificationStats reports how the verification emails sent over the
+// last days converted into verified emails
+func (s *Service) GetEmailVerificationStats(ctx context.Context, req *altalunev1.GetEmailVerificationStatsRequest) (*altalunev1.GetEmailVerificationStatsResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, altalune.NewInvalidPayloadError(err.Error())
+	}
+
+	days := req.Days
+	if days == 0 {
+		days = defaultVerificationStatsDays
+	}
+
+	stats, err := s.userRepo.GetVerificationStats(ctx, time.Now().AddDate(0, 0, -int(days)))
+	if err != nil {
+		s.log.Error("failed to get verification stats", "error", err, "days", days)
+		return nil, altalune.NewUnexpectedError("failed to get verification stats", err)
+	}
+
+	return &altalunev1.GetEmailVerificationStatsResponse{
+		Sent:                  stats.Sent,
+		Verified:              stats.Verified,
+		Pending:               stats.Pending,
+		Expired:               stats.Expired,
+		Superseded:            stats.Superseded,
+		ConversionRate:        stats.ConversionRate(),
+		MedianSecondsToVerify: int64(stats.MedianTimeToVerify.Seconds()),
+		Days:                  days,
+	}, nil
+}
+
+// checkEmail rejects the addresses the email checker screens out
+func (s *Service) checkEmail(ctx context.Context, email string) error {
+	if s.emailChecker == nil {
+		return nil
+	}
+	err := s.emailChecker.Check(ctx, email)
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, emailcheck.ErrInvalidSyntax):
+		return altalune.NewUserInvalidEmailError(email)
+	case errors.Is(err, emailcheck.ErrBlockedDomain):
+		return altalune.NewUserEmailDomainBlockedError(email)
+	case errors.Is(err, emailcheck.ErrNoMailServer):
+		return altalune.NewUserEmailUndeliverableError(email)
+	}
+	s.log.Error("failed to check email", "error", err, "email", email)
+	return altalune.NewUnexpectedError("failed to check email", err)
+}
+
+// requireHuman fails for service accounts, which have no email to work with
+func (s *Service) requireHuman(ctx context.Context, publicID string) error {
+	user, err := s.userRepo.GetByID(ctx, publicID)
+	if err != nil {
+		if err == ErrUserNotFound {
+			return altalune.NewUserNotFoundError(publicID)
+		}
+		s.log.Error("failed to get user", "error", err, "user_id", publicID)
+		return altalune.NewUnexpectedError("failed to get user", err)
+	}
+	if user.Type == UserTypeServiceAccount {
+		return altalune.NewUserIsServiceAccountError(publicID)
+	}
+	return nil
+}
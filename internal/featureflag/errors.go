@@ -0,0 +1,5 @@
+package featureflag
+
+import "errors"
+
+var ErrOverrideNotFound = errors.New("feature flag override not found")
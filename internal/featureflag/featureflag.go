@@ -0,0 +1,105 @@
+// Package featureflag gates risky features behind flags declared in code and
+// configured in the database: on or off for everyone, rolled out to a share
+// of the projects, and overridden for single projects.
+package featureflag
+
+import (
+	"context"
+	"hash/fnv"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hrz8/altalune"
+)
+
+// Flags evaluates the flags from a cache of their stored state, loaded again
+// once older than the refresh interval. Other replicas see a change within
+// that interval.
+type Flags struct {
+	repo    Repositor
+	refresh time.Duration
+	log     altalune.Logger
+
+	mu       sync.Mutex
+	states   map[Flag]*State
+	loadedAt time.Time
+}
+
+// New creates flags reading their state from repo
+func New(repo Repositor, refresh time.Duration, log altalune.Logger) *Flags {
+	return &Flags{
+		repo:    repo,
+		refresh: refresh,
+		log:     log,
+	}
+}
+
+// Enabled reports whether flag is on outside of any project: configured flags
+// are only on when enabled and rolled out to every project.
+func (f *Flags) Enabled(ctx context.Context, flag Flag) bool {
+	definition, ok := Lookup(flag)
+	if !ok {
+		return false
+	}
+	state := f.state(ctx, flag)
+	if state == nil {
+		return definition.Default
+	}
+	return state.Enabled && state.RolloutPercentage >= 100
+}
+
+// EnabledForProject reports whether flag is on for the project with the
+// internal ID projectID
+func (f *Flags) EnabledForProject(ctx context.Context, flag Flag, projectID int64) bool {
+	definition, ok := Lookup(flag)
+	if !ok {
+		return false
+	}
+	state := f.state(ctx, flag)
+	if state == nil {
+		return definition.Default
+	}
+	for _, override := range state.Projects {
+		if override.ProjectID == projectID {
+			return override.Enabled
+		}
+	}
+	return state.Enabled && bucket(flag, projectID) < state.RolloutPercentage
+}
+
+// Invalidate drops the cache, so the next evaluation loads the state again
+func (f *Flags) Invalidate() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.loadedAt = time.Time{}
+}
+
+// state returns the stored state of flag, nil when it was never configured.
+// A failing load keeps the last known states.
+func (f *Flags) state(ctx context.Context, flag Flag) *State {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.loadedAt.IsZero() || time.Since(f.loadedAt) >= f.refresh {
+		f.loadedAt = time.Now()
+		states, err := f.repo.List(context.WithoutCancel(ctx))
+		if err != nil {
+			f.log.WarnContext(ctx, "failed to load feature flags", "error", err)
+		} else {
+			f.states = make(map[Flag]*State, len(states))
+			for _, state := range states {
+				f.states[state.Flag] = state
+			}
+		}
+	}
+	return f.states[flag]
+}
+
+// bucket places a project in one of 100 buckets, stable for a flag and
+// independent between flags, so raising a rollout only adds projects
+func bucket(flag Flag, projectID int64) int {
+	h := fnv.New32a()
+	h.Write([]byte(string(flag) + ":" + strconv.FormatInt(projectID, 10)))
+	return int(h.Sum32() % 100)
+}
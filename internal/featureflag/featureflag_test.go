@@ -0,0 +1,86 @@
+package featureflag
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hrz8/altalune/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeRepo struct {
+	states []*State
+	loads  int
+}
+
+func (r *fakeRepo) List(ctx context.Context) ([]*State, error) {
+	r.loads++
+	return r.states, nil
+}
+
+func (r *fakeRepo) UpdateFlag(ctx context.Context, input *UpdateFlagInput) error { return nil }
+
+func (r *fakeRepo) SetProjectOverride(ctx context.Context, input *SetProjectOverrideInput) error {
+	return nil
+}
+
+func (r *fakeRepo) ClearProjectOverride(ctx context.Context, flag Flag, projectID int64) error {
+	return nil
+}
+
+func TestFlagsDefaults(t *testing.T) {
+	ctx := context.Background()
+	flags := New(&fakeRepo{}, time.Minute, logger.New("error"))
+
+	assert.True(t, flags.Enabled(ctx, RowLevelSecurity))
+	assert.True(t, flags.EnabledForProject(ctx, RowLevelSecurity, 1))
+	assert.False(t, flags.Enabled(ctx, Flag("undeclared")))
+}
+
+func TestFlagsEnabledForProject(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeRepo{states: []*State{{
+		Flag:              RowLevelSecurity,
+		Enabled:           true,
+		RolloutPercentage: 0,
+		Projects: []*ProjectOverride{
+			{ProjectID: 7, Enabled: true},
+		},
+	}}}
+	flags := New(repo, time.Minute, logger.New("error"))
+
+	assert.False(t, flags.Enabled(ctx, RowLevelSecurity))
+	assert.False(t, flags.EnabledForProject(ctx, RowLevelSecurity, 1))
+	assert.True(t, flags.EnabledForProject(ctx, RowLevelSecurity, 7))
+
+	// Cached until invalidated
+	repo.states = []*State{{Flag: RowLevelSecurity}}
+	assert.True(t, flags.EnabledForProject(ctx, RowLevelSecurity, 7))
+	flags.Invalidate()
+	assert.False(t, flags.EnabledForProject(ctx, RowLevelSecurity, 7))
+	assert.Equal(t, 2, repo.loads)
+}
+
+func TestFlagsRollout(t *testing.T) {
+	ctx := context.Background()
+	state := &State{Flag: RowLevelSecurity, Enabled: true, RolloutPercentage: 30}
+	flags := New(&fakeRepo{states: []*State{state}}, time.Hour, logger.New("error"))
+
+	on := make(map[int64]bool)
+	for id := int64(1); id <= 1000; id++ {
+		if flags.EnabledForProject(ctx, RowLevelSecurity, id) {
+			on[id] = true
+		}
+	}
+	assert.InDelta(t, 300, len(on), 60)
+
+	// Raising the rollout keeps the projects that already had the flag
+	state.RolloutPercentage = 60
+	for id := range on {
+		assert.True(t, flags.EnabledForProject(ctx, RowLevelSecurity, id))
+	}
+
+	state.RolloutPercentage = 100
+	assert.True(t, flags.Enabled(ctx, RowLevelSecurity))
+}
@@ -0,0 +1,39 @@
+package featureflag
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Flags declared by the features they gate. Declare a flag here before
+// reading it; only declared flags can be configured.
+const (
+	// RowLevelSecurity scopes the requests of a project with the row-level
+	// security policies when database.rowLevelSecurity is enabled
+	RowLevelSecurity Flag = "row_level_security"
+)
+
+var definitions = []Definition{
+	{
+		Flag:        RowLevelSecurity,
+		Description: "Scope project requests with the row-level security policies (needs database.rowLevelSecurity)",
+		Default:     true,
+	},
+}
+
+// Definitions returns every declared flag, ordered by name
+func Definitions() []Definition {
+	result := slices.Clone(definitions)
+	slices.SortFunc(result, func(a, b Definition) int { return cmp.Compare(a.Flag, b.Flag) })
+	return result
+}
+
+// Lookup returns the definition of a declared flag
+func Lookup(flag Flag) (Definition, bool) {
+	for _, d := range definitions {
+		if d.Flag == flag {
+			return d, true
+		}
+	}
+	return Definition{}, false
+}
@@ -0,0 +1,14 @@
+package featureflag
+
+import "context"
+
+type Repositor interface {
+	// List returns the stored state of every configured flag, with its
+	// project overrides
+	List(ctx context.Context) ([]*State, error)
+	UpdateFlag(ctx context.Context, input *UpdateFlagInput) error
+	SetProjectOverride(ctx context.Context, input *SetProjectOverrideInput) error
+	// ClearProjectOverride returns ErrOverrideNotFound when the project has no
+	// override of the flag
+	ClearProjectOverride(ctx context.Context, flag Flag, projectID int64) error
+}
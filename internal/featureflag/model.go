@@ -0,0 +1,46 @@
+package featureflag
+
+import "time"
+
+// Flag names a feature gated by a flag
+type Flag string
+
+// Definition is a flag declared in code, with the value it has until an
+// administrator configures it
+type Definition struct {
+	Flag        Flag
+	Description string
+	Default     bool
+}
+
+// State is the configuration of a flag stored in the database
+type State struct {
+	Flag              Flag
+	Enabled           bool // Off turns the flag off for every project without an override
+	RolloutPercentage int  // Share of the projects without an override the flag is on for
+	Projects          []*ProjectOverride
+	UpdatedAt         time.Time
+}
+
+// ProjectOverride turns a flag on or off for one project, whatever the
+// rollout says
+type ProjectOverride struct {
+	ProjectID       int64
+	ProjectPublicID string
+	Enabled         bool
+}
+
+type UpdateFlagInput struct {
+	Flag              Flag
+	Enabled           bool
+	RolloutPercentage int
+}
+
+type SetProjectOverrideInput struct {
+	Flag      Flag
+	ProjectID int64
+	Enabled   bool
+	// Configuration the flag is stored with when it was never configured, so
+	// the override does not change it for the other projects
+	Initial UpdateFlagInput
+}
@@ -0,0 +1,130 @@
+package featureflag
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hrz8/altalune/internal/postgres"
+)
+
+type Repo struct {
+	db postgres.DB
+}
+
+func NewRepo(db postgres.DB) *Repo {
+	return &Repo{
+		db: db,
+	}
+}
+
+func (r *Repo) List(ctx context.Context) ([]*State, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT name, enabled, rollout_percentage, updated_at
+		FROM altalune_feature_flags
+		ORDER BY name
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("list feature flags: %w", err)
+	}
+	defer rows.Close()
+
+	states := make([]*State, 0)
+	byFlag := make(map[Flag]*State)
+	for rows.Next() {
+		var state State
+		if err := rows.Scan(&state.Flag, &state.Enabled, &state.RolloutPercentage, &state.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("scan feature flag: %w", err)
+		}
+		states = append(states, &state)
+		byFlag[state.Flag] = &state
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate feature flags: %w", err)
+	}
+	rows.Close()
+
+	overrideRows, err := r.db.QueryContext(ctx, `
+		SELECT o.flag_name, o.project_id, p.public_id, o.enabled
+		FROM altalune_feature_flag_projects o
+		JOIN altalune_projects p ON p.id = o.project_id
+		ORDER BY o.flag_name, p.public_id
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("list feature flag overrides: %w", err)
+	}
+	defer overrideRows.Close()
+
+	for overrideRows.Next() {
+		var flag Flag
+		var override ProjectOverride
+		if err := overrideRows.Scan(&flag, &override.ProjectID, &override.ProjectPublicID, &override.Enabled); err != nil {
+			return nil, fmt.Errorf("scan feature flag override: %w", err)
+		}
+		if state, ok := byFlag[flag]; ok {
+			state.Projects = append(state.Projects, &override)
+		}
+	}
+	if err := overrideRows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate feature flag overrides: %w", err)
+	}
+
+	return states, nil
+}
+
+func (r *Repo) UpdateFlag(ctx context.Context, input *UpdateFlagInput) error {
+	query := `
+		INSERT INTO altalune_feature_flags (name, enabled, rollout_percentage)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (name) DO UPDATE
+		SET enabled = EXCLUDED.enabled,
+			rollout_percentage = EXCLUDED.rollout_percentage,
+			updated_at = CURRENT_TIMESTAMP
+	`
+	if _, err := r.db.ExecContext(ctx, query, input.Flag, input.Enabled, input.RolloutPercentage); err != nil {
+		return fmt.Errorf("update feature flag: %w", err)
+	}
+	return nil
+}
+
+func (r *Repo) SetProjectOverride(ctx context.Context, input *SetProjectOverrideInput) error {
+	// The flag row is created with its initial configuration on its first
+	// override, which the override references
+	query := `
+		WITH flag AS (
+			INSERT INTO altalune_feature_flags (name, enabled, rollout_percentage)
+			VALUES ($1, $4, $5)
+			ON CONFLICT (name) DO UPDATE SET updated_at = CURRENT_TIMESTAMP
+			RETURNING name
+		)
+		INSERT INTO altalune_feature_flag_projects (flag_name, project_id, enabled)
+		SELECT name, $2, $3 FROM flag
+		ON CONFLICT (flag_name, project_id) DO UPDATE
+		SET enabled = EXCLUDED.enabled, updated_at = CURRENT_TIMESTAMP
+	`
+	_, err := r.db.ExecContext(ctx, query,
+		input.Flag, input.ProjectID, input.Enabled,
+		input.Initial.Enabled, input.Initial.RolloutPercentage,
+	)
+	if err != nil {
+		return fmt.Errorf("set feature flag override: %w", err)
+	}
+	return nil
+}
+
+func (r *Repo) ClearProjectOverride(ctx context.Context, flag Flag, projectID int64) error {
+	result, err := r.db.ExecContext(ctx, `
+		DELETE FROM altalune_feature_flag_projects
+		WHERE flag_name = $1 AND project_id = $2
+	`, flag, projectID)
+	if err != nil {
+		return fmt.Errorf("clear feature flag override: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("clear feature flag override: %w", err)
+	}
+	if affected == 0 {
+		return ErrOverrideNotFound
+	}
+	return nil
+}
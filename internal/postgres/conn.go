@@ -0,0 +1,45 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+)
+
+// WithPgxConn runs fn on a dedicated pgx connection taken from the pool.
+// It exposes features database/sql has no API for, such as COPY.
+func WithPgxConn(ctx context.Context, db DB, fn func(conn *pgx.Conn) error) error {
+	conn, err := db.GetDB().Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("acquire connection: %w", err)
+	}
+	defer conn.Close()
+
+	return conn.Raw(func(driverConn any) error {
+		stdlibConn, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			return fmt.Errorf("unsupported driver connection %T", driverConn)
+		}
+		return fn(stdlibConn.Conn())
+	})
+}
+
+// WithoutStatementTimeout runs fn with the statement timeout of conn lifted,
+// for statements such as COPY whose duration grows with the data they move.
+// The session default is restored afterwards, or conn is closed so the pool
+// discards it when that fails.
+func WithoutStatementTimeout(ctx context.Context, conn *pgx.Conn, fn func() error) error {
+	if _, err := conn.Exec(ctx, "SET statement_timeout = 0"); err != nil {
+		return fmt.Errorf("lift statement timeout: %w", err)
+	}
+	defer func() {
+		ctx := context.WithoutCancel(ctx)
+		if _, err := conn.Exec(ctx, "RESET statement_timeout"); err != nil {
+			_ = conn.Close(ctx)
+		}
+	}()
+
+	return fn()
+}
@@ -0,0 +1,78 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hrz8/altalune/internal/shared/query"
+)
+
+// CountRows counts the rows matched by baseQuery in the given mode, the
+// connection's CountMode when it is query.CountDefault.
+//
+// Estimates come from the planner's row estimate for baseQuery, so they cost
+// a plan rather than a scan. Estimates under the connection's ExactCountLimit
+// are replaced by an exact count, which is cheap there and spares small
+// results the planner's rounding.
+func CountRows(ctx context.Context, db DB, mode query.CountMode, baseQuery string, args []any) (query.Count, error) {
+	opts := connectionOptions(db)
+	if mode == query.CountDefault {
+		mode = opts.CountMode
+	}
+
+	switch mode {
+	case query.CountNone:
+		return query.Count{Mode: query.CountNone}, nil
+	case query.CountEstimated:
+		rows, err := estimateRows(ctx, db, baseQuery, args)
+		if err != nil {
+			return query.Count{}, err
+		}
+		if rows >= opts.ExactCountLimit {
+			return query.Count{Rows: rows, Mode: query.CountEstimated}, nil
+		}
+	}
+
+	var rows int64
+	countQuery := "SELECT COUNT(*) FROM (" + baseQuery + ") as filtered"
+	if err := db.QueryRowContext(ctx, countQuery, args...).Scan(&rows); err != nil {
+		return query.Count{}, fmt.Errorf("count rows: %w", err)
+	}
+	return query.Count{Rows: rows, Mode: query.CountExact}, nil
+}
+
+// estimateRows returns the number of rows the planner expects baseQuery to
+// return
+func estimateRows(ctx context.Context, db DB, baseQuery string, args []any) (int64, error) {
+	var plan []byte
+	if err := db.QueryRowContext(ctx, "EXPLAIN (FORMAT JSON) "+baseQuery, args...).Scan(&plan); err != nil {
+		return 0, fmt.Errorf("explain rows: %w", err)
+	}
+
+	var explained []struct {
+		Plan struct {
+			Rows float64 `json:"Plan Rows"`
+		} `json:"Plan"`
+	}
+	if err := json.Unmarshal(plan, &explained); err != nil {
+		return 0, fmt.Errorf("parse query plan: %w", err)
+	}
+	if len(explained) == 0 {
+		return 0, fmt.Errorf("parse query plan: empty plan")
+	}
+	return int64(explained[0].Plan.Rows), nil
+}
+
+// connectionOptions returns the options db was connected with, the zero ones
+// when it was not connected by this package
+func connectionOptions(db DB) ConnectionOptions {
+	switch db := db.(type) {
+	case *SQLConnection:
+		return db.config
+	case *ScopedDB:
+		return connectionOptions(db.DB)
+	default:
+		return ConnectionOptions{}
+	}
+}
@@ -0,0 +1,32 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hrz8/altalune/internal/postgres"
+	"github.com/hrz8/altalune/internal/shared/query"
+	"github.com/hrz8/altalune/internal/testdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCountRows(t *testing.T) {
+	ctx := context.Background()
+	db := testdb.Open(t)
+	baseQuery := "SELECT n FROM generate_series(1, $1::int) AS n"
+	args := []any{5000}
+
+	count, err := postgres.CountRows(ctx, db, query.CountDefault, baseQuery, args)
+	require.NoError(t, err)
+	assert.Equal(t, query.Count{Rows: 5000, Mode: query.CountExact}, count)
+
+	count, err = postgres.CountRows(ctx, db, query.CountEstimated, baseQuery, args)
+	require.NoError(t, err)
+	assert.Equal(t, query.CountEstimated, count.Mode)
+	assert.Positive(t, count.Rows)
+
+	count, err = postgres.CountRows(ctx, db, query.CountNone, baseQuery, args)
+	require.NoError(t, err)
+	assert.Equal(t, query.Count{Mode: query.CountNone}, count)
+}
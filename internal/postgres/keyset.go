@@ -0,0 +1,60 @@
+package postgres
+
+import (
+	"fmt"
+
+	"github.com/hrz8/altalune/internal/shared/query"
+)
+
+// SortKey is the order of a paginated query: an expression, never NULL, and
+// the internal ID column breaking its ties, so that every row has a position
+// a query.Keyset can resume after.
+type SortKey struct {
+	Expr string // Sort expression; nullable columns are wrapped in COALESCE
+	ID   string // Internal ID column, "id" when empty
+	Desc bool
+}
+
+// NewSortKey returns the SortKey sorting by expr in the order of sorting,
+// descending only when it says so.
+func NewSortKey(expr string, sorting *query.SortingParams) SortKey {
+	return SortKey{Expr: expr, Desc: sorting != nil && sorting.Order == query.SortOrderDesc}
+}
+
+func (k SortKey) id() string {
+	if k.ID == "" {
+		return "id"
+	}
+	return k.ID
+}
+
+// Select is the select-list expression rendering the sort value of a row as
+// text, the SortKey of the query.Keyset of the page following it.
+func (k SortKey) Select() string {
+	return "(" + k.Expr + ")::text"
+}
+
+// OrderBy is the ORDER BY clause of k.
+func (k SortKey) OrderBy() string {
+	direction := "ASC"
+	if k.Desc {
+		direction = "DESC"
+	}
+	return fmt.Sprintf(" ORDER BY %s %s, %s %s", k.Expr, direction, k.id(), direction)
+}
+
+// After returns the condition selecting the rows following keyset in the
+// order of k, with its arguments numbered from argCounter, or an empty
+// condition when keyset names no row. The sort value is passed as text for
+// Postgres to read as the type of the sort expression.
+func (k SortKey) After(keyset *query.Keyset, argCounter int) (string, []any) {
+	if keyset == nil || keyset.AfterID == 0 {
+		return "", nil
+	}
+	op := ">"
+	if k.Desc {
+		op = "<"
+	}
+	condition := fmt.Sprintf("(%s, %s) %s ($%d, $%d)", k.Expr, k.id(), op, argCounter, argCounter+1)
+	return condition, []any{keyset.SortKey, keyset.AfterID}
+}
@@ -0,0 +1,98 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hrz8/altalune/internal/postgres"
+	"github.com/hrz8/altalune/internal/shared/query"
+	"github.com/hrz8/altalune/internal/testdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSortKey(t *testing.T) {
+	ctx := context.Background()
+	db := testdb.Tx(t)
+
+	_, err := db.ExecContext(ctx, "CREATE TEMPORARY TABLE keyset_rows (id BIGINT PRIMARY KEY, name TEXT, created_at TIMESTAMPTZ NOT NULL)")
+	require.NoError(t, err)
+	_, err = db.ExecContext(ctx, `
+		INSERT INTO keyset_rows VALUES
+			(1, 'b', '2026-01-01 10:00:00.123456+00'),
+			(2, 'a', '2026-01-01 10:00:00.123456+00'),
+			(3, 'b', '2026-01-02 08:30:00+00'),
+			(4, NULL, '2026-01-01 10:00:00.123456+00'),
+			(5, 'c', '2026-01-03 00:00:00+00'),
+			(6, 'a', '2026-01-02 08:30:00+00'),
+			(7, 'b', '2026-01-01 09:59:59.999999+00')
+	`)
+	require.NoError(t, err)
+
+	// page returns the IDs of the page of two rows after keyset and the
+	// keyset of its last row
+	page := func(t *testing.T, key postgres.SortKey, keyset *query.Keyset) ([]int64, *query.Keyset) {
+		t.Helper()
+		q := "SELECT id, " + key.Select() + " FROM keyset_rows WHERE 1=1"
+		condition, args := key.After(keyset, 1)
+		if condition != "" {
+			q += " AND " + condition
+		}
+		rows, err := db.QueryContext(ctx, q+key.OrderBy()+" LIMIT 2", args...)
+		require.NoError(t, err)
+		defer rows.Close()
+
+		var ids []int64
+		next := &query.Keyset{}
+		for rows.Next() {
+			require.NoError(t, rows.Scan(&next.AfterID, &next.SortKey))
+			ids = append(ids, next.AfterID)
+		}
+		require.NoError(t, rows.Err())
+		return ids, next
+	}
+
+	tests := []struct {
+		name     string
+		key      postgres.SortKey
+		inserted string // Row inserted after the first page, sorting before it; rows are kept for the next cases
+		want     []int64
+	}{
+		{
+			name: "text ascending",
+			key:  postgres.NewSortKey("COALESCE(name, '')", &query.SortingParams{Field: "name", Order: query.SortOrderAsc}),
+			want: []int64{4, 2, 6, 1, 3, 7, 5},
+		},
+		{
+			name:     "time ascending",
+			key:      postgres.NewSortKey("created_at", nil),
+			inserted: "(8, 'z', '2025-01-01 00:00:00+00')",
+			want:     []int64{7, 1, 2, 4, 3, 6, 5},
+		},
+		{
+			name:     "time descending",
+			key:      postgres.NewSortKey("created_at", &query.SortingParams{Field: "created_at", Order: query.SortOrderDesc}),
+			inserted: "(9, 'z', '2027-01-01 00:00:00+00')",
+			want:     []int64{5, 6, 3, 4, 2, 1, 7, 8},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []int64
+			var keyset *query.Keyset
+			for {
+				ids, next := page(t, tt.key, keyset)
+				got = append(got, ids...)
+				if len(ids) < 2 {
+					break
+				}
+				if keyset == nil && tt.inserted != "" {
+					_, err := db.ExecContext(ctx, "INSERT INTO keyset_rows VALUES "+tt.inserted)
+					require.NoError(t, err)
+				}
+				keyset = next
+			}
+			assert.Equal(t, tt.want, got, "every row comes once, in order, whatever is inserted before the position")
+		})
+	}
+}
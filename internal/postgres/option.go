@@ -1,10 +1,34 @@
 package postgres
 
-import "time"
+import (
+	"time"
+
+	"github.com/hrz8/altalune"
+	"github.com/hrz8/altalune/internal/shared/query"
+)
 
 type ConnectionOptions struct {
 	URL            string
 	MaxConnections int
 	MaxIdleTime    time.Duration
 	ConnectTimeout time.Duration
+	// StatementTimeout is set as the statement_timeout of every connection,
+	// so the server cancels statements running longer. Zero leaves the
+	// server's default.
+	StatementTimeout time.Duration
+	// SlowQueryThreshold is how long a statement runs before Logger reports
+	// it as a slow query. Zero, or a nil Logger, disables it.
+	SlowQueryThreshold time.Duration
+	Logger             altalune.Logger
+	// CountMode is how queries asking for query.CountDefault count their
+	// rows, exactly when it is query.CountDefault too.
+	CountMode query.CountMode
+	// ExactCountLimit is the estimate under which estimated counts are made
+	// exact.
+	ExactCountLimit int64
+	// RowLevelSecurity connects with ProjectSetting set to NoProject, so the
+	// row-level security policies only expose rows to the statements run
+	// within WithProject or WithAllProjects. Otherwise every connection sees
+	// all projects, as do the sessions of other clients either way.
+	RowLevelSecurity bool
 }
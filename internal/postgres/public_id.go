@@ -0,0 +1,62 @@
+package postgres
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hrz8/altalune/internal/shared/nanoid"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// publicIDAttempts is how many times an insert is tried with fresh public IDs
+// before its public_id unique violation is returned.
+const publicIDAttempts = 3
+
+// IsPublicIDViolation reports whether err is a unique violation of a public_id
+// constraint. Every public_id constraint and unique index has public_id in its
+// name.
+func IsPublicIDViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == pgerrcode.UniqueViolation && strings.Contains(pgErr.ConstraintName, "public_id")
+	}
+	return false
+}
+
+// InsertWithPublicID calls insert with a newly generated public ID, retrying
+// with another one when insert fails because the ID is already taken. It
+// returns the public ID of the last attempt and insert's error unwrapped, so
+// callers can still match their own constraint violations.
+//
+// insert must not run inside a transaction: the violation aborts it.
+func InsertWithPublicID(insert func(publicID string) error) (string, error) {
+	for attempt := 1; ; attempt++ {
+		publicID, err := nanoid.GeneratePublicID()
+		if err != nil {
+			return "", fmt.Errorf("generate public_id: %w", err)
+		}
+
+		err = insert(publicID)
+		if attempt == publicIDAttempts || !IsPublicIDViolation(err) {
+			return publicID, err
+		}
+	}
+}
+
+// InsertWithPublicIDBatch is InsertWithPublicID for inserts of n rows at once,
+// such as COPY. Every ID is regenerated when any of them is already taken.
+func InsertWithPublicIDBatch(n int, insert func(publicIDs []string) error) error {
+	for attempt := 1; ; attempt++ {
+		publicIDs, err := nanoid.GeneratePublicIDBatch(n)
+		if err != nil {
+			return fmt.Errorf("generate public_ids: %w", err)
+		}
+
+		err = insert(publicIDs)
+		if attempt == publicIDAttempts || !IsPublicIDViolation(err) {
+			return err
+		}
+	}
+}
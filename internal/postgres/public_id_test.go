@@ -0,0 +1,57 @@
+package postgres
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsPublicIDViolation(t *testing.T) {
+	publicID := &pgconn.PgError{Code: pgerrcode.UniqueViolation, ConstraintName: "ux_altalune_projects_public_id"}
+	name := &pgconn.PgError{Code: pgerrcode.UniqueViolation, ConstraintName: "ux_altalune_roles_name"}
+
+	assert.True(t, IsPublicIDViolation(publicID))
+	assert.True(t, IsPublicIDViolation(fmt.Errorf("insert: %w", publicID)))
+	assert.False(t, IsPublicIDViolation(name))
+	assert.False(t, IsPublicIDViolation(errors.New("public_id")))
+	assert.False(t, IsPublicIDViolation(nil))
+}
+
+func TestInsertWithPublicID(t *testing.T) {
+	violation := &pgconn.PgError{Code: pgerrcode.UniqueViolation, ConstraintName: "altalune_users_public_id_key"}
+
+	var tried []string
+	publicID, err := InsertWithPublicID(func(publicID string) error {
+		tried = append(tried, publicID)
+		if len(tried) < 2 {
+			return violation
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, tried, 2)
+	assert.NotEqual(t, tried[0], tried[1])
+	assert.Equal(t, tried[1], publicID)
+
+	attempts := 0
+	_, err = InsertWithPublicID(func(string) error {
+		attempts++
+		return violation
+	})
+	assert.ErrorIs(t, err, violation)
+	assert.Equal(t, publicIDAttempts, attempts)
+
+	other := errors.New("connection reset")
+	attempts = 0
+	_, err = InsertWithPublicID(func(string) error {
+		attempts++
+		return other
+	})
+	assert.ErrorIs(t, err, other)
+	assert.Equal(t, 1, attempts)
+}
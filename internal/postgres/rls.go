@@ -0,0 +1,254 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ProjectSetting is the setting the row-level security policies read the
+// current project from. Rows of other projects are hidden while it holds a
+// project ID, and every row is visible while it is AllProjects or was never
+// set in the session, as for clients other than the server. Any other value,
+// such as NoProject, hides every row.
+const ProjectSetting = "app.current_project"
+
+// AllProjects is the value of ProjectSetting exposing the rows of every
+// project, for the statements that work across projects.
+const AllProjects = "all"
+
+// NoProject is the value of ProjectSetting the server connects with when it
+// enforces row-level security, so that its statements outside WithProject and
+// WithAllProjects see no row of the project tables.
+const NoProject = "none"
+
+type projectScopeKey struct{}
+
+// projectScope is the connection the statements of a scope run on, with the
+// value ProjectSetting currently has on it. conn is nil until the scope of a
+// deferred one is known.
+type projectScope struct {
+	mu      sync.Mutex
+	conn    *sql.Conn
+	setting string
+}
+
+func scopeFrom(ctx context.Context) *projectScope {
+	scope, _ := ctx.Value(projectScopeKey{}).(*projectScope)
+	return scope
+}
+
+// WithProject runs fn with the statements a ScopedDB receives under its
+// context on one connection on which ProjectSetting is projectID, so that the
+// row-level security policies only expose the rows of that project.
+//
+// The scope holds no transaction: each statement commits on its own as it
+// would outside the scope, so a failing one leaves the others be, and retries
+// such as InsertWithPublicID's keep working. The setting is reset when fn
+// returns, and the connection discarded rather than pooled when it cannot be.
+// Within a scope already, the setting of its connection is switched for the
+// time of fn instead, holding no other connection. As on any single
+// connection, the statements of fn must not overlap and rows have to be
+// closed before the next statement runs.
+//
+// fn runs as is when db is not a ScopedDB enforcing row-level security.
+func WithProject(ctx context.Context, db DB, projectID int64, fn func(ctx context.Context) error) error {
+	return withScope(ctx, db, strconv.FormatInt(projectID, 10), fn)
+}
+
+// WithAllProjects is WithProject exposing the rows of every project, for the
+// lookups and jobs working across projects.
+func WithAllProjects(ctx context.Context, db DB, fn func(ctx context.Context) error) error {
+	return withScope(ctx, db, AllProjects, fn)
+}
+
+// WithDeferredProject runs fn in a scope whose project is only known once fn
+// starts it through scope, as for streams naming it in their first message.
+// The statements run before that are unscoped.
+func WithDeferredProject(ctx context.Context, db DB, fn func(ctx context.Context, scope *DeferredScope) error) error {
+	deferred := &DeferredScope{ctx: ctx, db: db, scope: &projectScope{}}
+	if d, ok := db.(*ScopedDB); !ok || !d.enabled {
+		return fn(ctx, deferred)
+	}
+
+	err := fn(context.WithValue(ctx, projectScopeKey{}, deferred.scope), deferred)
+	endScope(ctx, deferred.scope.conn)
+	return err
+}
+
+// DeferredScope starts the scope of WithDeferredProject. Only the first start
+// counts.
+type DeferredScope struct {
+	ctx   context.Context
+	db    DB
+	scope *projectScope
+}
+
+// Project starts the scope of projectID.
+func (s *DeferredScope) Project(projectID int64) error {
+	return s.start(strconv.FormatInt(projectID, 10))
+}
+
+// AllProjects starts a scope exposing the rows of every project.
+func (s *DeferredScope) AllProjects() error {
+	return s.start(AllProjects)
+}
+
+func (s *DeferredScope) start(setting string) error {
+	if d, ok := s.db.(*ScopedDB); !ok || !d.enabled {
+		return nil
+	}
+
+	s.scope.mu.Lock()
+	defer s.scope.mu.Unlock()
+	if s.scope.conn != nil {
+		return nil
+	}
+	conn, err := beginScope(s.ctx, s.db.GetDB(), setting)
+	if err != nil {
+		return err
+	}
+	s.scope.conn, s.scope.setting = conn, setting
+	return nil
+}
+
+// BeginAllProjectsTx begins a transaction of db exposing the rows of every
+// project, for the statements run on a *sql.Tx rather than a ScopedDB.
+func BeginAllProjectsTx(ctx context.Context, db DB, opts *sql.TxOptions) (*sql.Tx, error) {
+	tx, err := db.GetDB().BeginTx(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, "SELECT set_config($1, $2, true)", ProjectSetting, AllProjects); err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("set current project: %w", err)
+	}
+	return tx, nil
+}
+
+func withScope(ctx context.Context, db DB, setting string, fn func(ctx context.Context) error) error {
+	d, ok := db.(*ScopedDB)
+	if !ok || !d.enabled {
+		return fn(ctx)
+	}
+
+	if outer, outerSetting := scopeConn(ctx); outer != nil {
+		if outerSetting == setting {
+			return fn(ctx)
+		}
+		if err := setProject(ctx, outer, setting); err != nil {
+			return err
+		}
+		err := fn(context.WithValue(ctx, projectScopeKey{}, &projectScope{conn: outer, setting: setting}))
+		if resetErr := setProject(context.WithoutCancel(ctx), outer, outerSetting); err == nil {
+			err = resetErr
+		}
+		return err
+	}
+
+	conn, err := beginScope(ctx, db.GetDB(), setting)
+	if err != nil {
+		return err
+	}
+	defer endScope(ctx, conn)
+	return fn(context.WithValue(ctx, projectScopeKey{}, &projectScope{conn: conn, setting: setting}))
+}
+
+// beginScope takes a connection of db off the pool with ProjectSetting set to
+// setting for its session
+func beginScope(ctx context.Context, db *sql.DB, setting string) (*sql.Conn, error) {
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get scope connection: %w", err)
+	}
+	if err := setProject(ctx, conn, setting); err != nil {
+		endScope(ctx, conn)
+		return nil, err
+	}
+	return conn, nil
+}
+
+// endScope resets ProjectSetting on conn and hands it back to the pool. A
+// connection whose setting cannot be reset is discarded instead, so that no
+// statement outside the scope ever runs with it.
+func endScope(ctx context.Context, conn *sql.Conn) {
+	if conn == nil {
+		return
+	}
+	if _, err := conn.ExecContext(context.WithoutCancel(ctx), "RESET "+ProjectSetting); err != nil {
+		_ = conn.Raw(func(any) error { return driver.ErrBadConn })
+	}
+	_ = conn.Close()
+}
+
+func setProject(ctx context.Context, conn *sql.Conn, setting string) error {
+	if _, err := conn.ExecContext(ctx, "SELECT set_config($1, $2, false)", ProjectSetting, setting); err != nil {
+		return fmt.Errorf("set current project: %w", err)
+	}
+	return nil
+}
+
+// ScopedDB is a DB running the statements issued within WithProject and
+// WithAllProjects on their connection, and all others on the wrapped DB.
+type ScopedDB struct {
+	DB
+	enabled bool
+}
+
+var _ DB = (*ScopedDB)(nil)
+
+// NewScopedDB wraps db so that repositories built on it honor WithProject.
+// Unless rowLevelSecurity is set, as with a connection opened without
+// ConnectionOptions.RowLevelSecurity, scopes hold no connection and every
+// statement runs on db.
+func NewScopedDB(db DB, rowLevelSecurity bool) *ScopedDB {
+	return &ScopedDB{DB: db, enabled: rowLevelSecurity}
+}
+
+// scopeConn returns the connection of the scope of ctx and the setting it
+// has, a nil one outside scopes
+func scopeConn(ctx context.Context) (*sql.Conn, string) {
+	scope := scopeFrom(ctx)
+	if scope == nil {
+		return nil, ""
+	}
+	scope.mu.Lock()
+	defer scope.mu.Unlock()
+	return scope.conn, scope.setting
+}
+
+func (d *ScopedDB) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	if conn, _ := scopeConn(ctx); conn != nil {
+		defer d.logSlow(ctx, query, time.Now())
+		return conn.QueryContext(ctx, query, args...)
+	}
+	return d.DB.QueryContext(ctx, query, args...)
+}
+
+func (d *ScopedDB) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	if conn, _ := scopeConn(ctx); conn != nil {
+		defer d.logSlow(ctx, query, time.Now())
+		return conn.QueryRowContext(ctx, query, args...)
+	}
+	return d.DB.QueryRowContext(ctx, query, args...)
+}
+
+func (d *ScopedDB) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	if conn, _ := scopeConn(ctx); conn != nil {
+		defer d.logSlow(ctx, query, time.Now())
+		return conn.ExecContext(ctx, query, args...)
+	}
+	return d.DB.ExecContext(ctx, query, args...)
+}
+
+// logSlow reports the slow statements run in a scope the way the wrapped DB
+// reports its own
+func (d *ScopedDB) logSlow(ctx context.Context, query string, start time.Time) {
+	if c, ok := d.DB.(*SQLConnection); ok {
+		c.logSlow(ctx, query, start)
+	}
+}
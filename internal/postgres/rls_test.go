@@ -0,0 +1,112 @@
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/hrz8/altalune/internal/postgres"
+	"github.com/hrz8/altalune/internal/testdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) { testdb.Main(m) }
+
+type projectSetting struct {
+	current sql.NullInt64
+	all     bool
+}
+
+func currentSetting(t *testing.T, ctx context.Context, db postgres.DB) projectSetting {
+	t.Helper()
+	var s projectSetting
+	require.NoError(t, db.QueryRowContext(ctx, "SELECT altalune_current_project(), altalune_all_projects()").Scan(&s.current, &s.all))
+	return s
+}
+
+func TestWithProject(t *testing.T) {
+	ctx := context.Background()
+	db := postgres.NewScopedDB(testdb.Open(t), true)
+
+	// With a single connection, a scope holding a second one would deadlock
+	pool := db.GetDB()
+	maxOpen := pool.Stats().MaxOpenConnections
+	pool.SetMaxOpenConns(1)
+	t.Cleanup(func() { pool.SetMaxOpenConns(maxOpen) })
+
+	var inner, restored projectSetting
+	err := postgres.WithProject(ctx, db, 42, func(ctx context.Context) error {
+		assert.Equal(t, projectSetting{current: sql.NullInt64{Int64: 42, Valid: true}}, currentSetting(t, ctx, db))
+		err := postgres.WithAllProjects(ctx, db, func(ctx context.Context) error {
+			inner = currentSetting(t, ctx, db)
+			return nil
+		})
+		restored = currentSetting(t, ctx, db)
+		return err
+	})
+	require.NoError(t, err)
+	assert.Equal(t, projectSetting{all: true}, inner, "a nested scope switches the connection of the outer one")
+	assert.Equal(t, projectSetting{current: sql.NullInt64{Int64: 42, Valid: true}}, restored)
+
+	assert.Equal(t, projectSetting{all: true}, currentSetting(t, ctx, db), "the setting is reset when the scope ends")
+}
+
+func TestWithProjectFailsClosed(t *testing.T) {
+	ctx := context.Background()
+	db := postgres.NewScopedDB(testdb.Open(t), true)
+
+	err := postgres.WithAllProjects(ctx, db, func(ctx context.Context) error {
+		_, err := db.ExecContext(ctx, "SELECT set_config($1, $2, false)", postgres.ProjectSetting, postgres.NoProject)
+		require.NoError(t, err)
+		assert.Equal(t, projectSetting{}, currentSetting(t, ctx, db), "no project exposes no project")
+
+		_, err = db.ExecContext(ctx, "SELECT set_config($1, '', false)", postgres.ProjectSetting)
+		require.NoError(t, err)
+		assert.Equal(t, projectSetting{}, currentSetting(t, ctx, db), "an emptied setting exposes no project")
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestWithProjectCommitsEachStatement(t *testing.T) {
+	ctx := context.Background()
+	db := postgres.NewScopedDB(testdb.Open(t), true)
+
+	// The temporary table lives on a single connection
+	pool := db.GetDB()
+	maxOpen := pool.Stats().MaxOpenConnections
+	pool.SetMaxOpenConns(1)
+	t.Cleanup(func() { pool.SetMaxOpenConns(maxOpen) })
+
+	_, err := db.ExecContext(ctx, "CREATE TEMPORARY TABLE IF NOT EXISTS rls_scope (id INT)")
+	require.NoError(t, err)
+
+	failure := errors.New("failure")
+	err = postgres.WithProject(ctx, db, 42, func(ctx context.Context) error {
+		_, err := db.ExecContext(ctx, "INSERT INTO rls_scope VALUES (1)")
+		require.NoError(t, err)
+		_, err = db.ExecContext(ctx, "SELECT 1 / 0")
+		require.Error(t, err)
+		_, err = db.ExecContext(ctx, "INSERT INTO rls_scope VALUES (2)")
+		require.NoError(t, err, "a failed statement does not abort the scope")
+		return failure
+	})
+	assert.ErrorIs(t, err, failure)
+
+	var count int
+	require.NoError(t, db.QueryRowContext(ctx, "SELECT COUNT(*) FROM rls_scope").Scan(&count))
+	assert.Equal(t, 2, count, "the statements of a scope commit as they run")
+}
+
+func TestWithProjectDisabled(t *testing.T) {
+	ctx := context.Background()
+	db := postgres.NewScopedDB(testdb.Tx(t), false)
+
+	err := postgres.WithProject(ctx, db, 42, func(ctx context.Context) error {
+		assert.Equal(t, projectSetting{all: true}, currentSetting(t, ctx, db), "scopes are no-ops without row-level security")
+		return nil
+	})
+	require.NoError(t, err)
+}
@@ -4,8 +4,12 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
-	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
 )
 
 type SQLConnection struct {
@@ -17,10 +21,19 @@ var _ DB = (*SQLConnection)(nil)
 
 // MustConnect creates a new database connection manager
 func MustConnect(cfg ConnectionOptions) *SQLConnection {
-	db, err := sql.Open("pgx", cfg.URL)
+	connConfig, err := pgx.ParseConfig(cfg.URL)
 	if err != nil {
-		panic(fmt.Errorf("failed opening database connection: %w", err))
+		panic(fmt.Errorf("failed parsing database url: %w", err))
 	}
+	if cfg.StatementTimeout > 0 {
+		connConfig.RuntimeParams["statement_timeout"] = strconv.FormatInt(cfg.StatementTimeout.Milliseconds(), 10)
+	}
+	if cfg.RowLevelSecurity {
+		connConfig.RuntimeParams[ProjectSetting] = NoProject
+	} else {
+		connConfig.RuntimeParams[ProjectSetting] = AllProjects
+	}
+	db := stdlib.OpenDB(*connConfig)
 
 	// Configure connection pool
 	db.SetMaxOpenConns(cfg.MaxConnections)
@@ -62,16 +75,19 @@ func (cm *SQLConnection) GetDB() *sql.DB {
 
 // QueryContext implements DB
 func (c *SQLConnection) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	defer c.logSlow(ctx, query, time.Now())
 	return c.db.QueryContext(ctx, query, args...)
 }
 
 // QueryRowContext implements DB
 func (c *SQLConnection) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	defer c.logSlow(ctx, query, time.Now())
 	return c.db.QueryRowContext(ctx, query, args...)
 }
 
 // ExecContext implements DB.
 func (c *SQLConnection) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	defer c.logSlow(ctx, query, time.Now())
 	return c.db.ExecContext(ctx, query, args...)
 }
 
@@ -87,3 +103,26 @@ func (c *SQLConnection) Close() error {
 	}
 	return nil
 }
+
+// logSlow reports query when it ran for at least the slow query threshold
+// since start. Queries are timed until their first rows are available, not
+// while they are read. Their arguments are left out as they may hold personal
+// data.
+func (c *SQLConnection) logSlow(ctx context.Context, query string, start time.Time) {
+	if c.config.Logger == nil || c.config.SlowQueryThreshold <= 0 {
+		return
+	}
+	elapsed := time.Since(start)
+	if elapsed < c.config.SlowQueryThreshold {
+		return
+	}
+	c.config.Logger.WarnContext(ctx, "slow query",
+		"elapsed", elapsed.String(),
+		"query", compactQuery(query),
+	)
+}
+
+// compactQuery collapses the whitespace of query onto a single line
+func compactQuery(query string) string {
+	return strings.Join(strings.Fields(query), " ")
+}
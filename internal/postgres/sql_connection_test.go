@@ -0,0 +1,36 @@
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hrz8/altalune/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogSlow(t *testing.T) {
+	var out bytes.Buffer
+	c := &SQLConnection{config: ConnectionOptions{
+		SlowQueryThreshold: 100 * time.Millisecond,
+		Logger:             logger.NewWithOptions(logger.Options{Format: "json", Output: &out}),
+	}}
+	query := `
+		SELECT id
+		FROM altalune_users
+		WHERE email = $1
+	`
+
+	c.logSlow(context.Background(), query, time.Now())
+	assert.Empty(t, out.String(), "fast queries are not logged")
+
+	c.logSlow(context.Background(), query, time.Now().Add(-time.Second))
+	assert.Contains(t, out.String(), `"msg":"slow query"`)
+	assert.Contains(t, out.String(), `"query":"SELECT id FROM altalune_users WHERE email = $1"`)
+
+	out.Reset()
+	c.config.SlowQueryThreshold = 0
+	c.logSlow(context.Background(), query, time.Now().Add(-time.Second))
+	assert.Empty(t, out.String(), "a zero threshold disables the log")
+}
@@ -2,6 +2,8 @@ package postgres
 
 import (
 	"errors"
+	"strings"
+	"time"
 
 	"github.com/jackc/pgerrcode"
 	"github.com/jackc/pgx/v5/pgconn"
@@ -15,6 +17,17 @@ func IsUniqueViolation(err error) bool {
 	return false
 }
 
+// IsExternalIDViolation reports whether err is a unique violation of an
+// external_id index. Every external_id unique index has external_id in its
+// name.
+func IsExternalIDViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == pgerrcode.UniqueViolation && strings.Contains(pgErr.ConstraintName, "external_id")
+	}
+	return false
+}
+
 func IsForeignKeyViolation(err error) bool {
 	var pgErr *pgconn.PgError
 	if errors.As(err, &pgErr) {
@@ -22,3 +35,21 @@ func IsForeignKeyViolation(err error) bool {
 	}
 	return false
 }
+
+// Now returns the current time at the microsecond precision of Postgres
+// timestamps, so in-memory repositories store the same values the database
+// would and version checks on updated_at compare equal after a round trip.
+func Now() time.Time {
+	return time.Now().Truncate(time.Microsecond)
+}
+
+// NextTimestamp returns Now, or prev plus a microsecond when the clock has not
+// moved past prev yet, so successive versions of a row in an in-memory
+// repository never share an updated_at.
+func NextTimestamp(prev time.Time) time.Time {
+	now := Now()
+	if now.After(prev) {
+		return now
+	}
+	return prev.Add(time.Microsecond)
+}
@@ -0,0 +1,205 @@
+// Package redis is a small Redis client and the Store abstraction shared by
+// features that need state visible to every replica, such as rate limits,
+// sessions and response caches.
+//
+// Redis is optional. Without it, NewMemoryStore provides the same Store
+// backed by process memory, which is only correct for single-replica
+// deployments.
+package redis
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ErrClosed is returned by commands issued after Close.
+var ErrClosed = errors.New("redis: client closed")
+
+// Client is a pooled Redis connection. It is safe for concurrent use.
+type Client struct {
+	config ConnectionOptions
+
+	slots chan struct{} // One token per connection allowed to be open
+	idle  chan *conn
+
+	mu     sync.Mutex
+	closed bool
+}
+
+var _ Store = (*Client)(nil)
+
+type conn struct {
+	netConn net.Conn
+	r       *bufio.Reader
+	w       *bufio.Writer
+}
+
+// NewClient creates a client. Connections are dialed lazily; call
+// TestConnection to check the server is reachable.
+func NewClient(cfg ConnectionOptions) *Client {
+	if cfg.PoolSize <= 0 {
+		cfg.PoolSize = 10
+	}
+	return &Client{
+		config: cfg,
+		slots:  make(chan struct{}, cfg.PoolSize),
+		idle:   make(chan *conn, cfg.PoolSize),
+	}
+}
+
+// TestConnection tests Redis connectivity
+func (c *Client) TestConnection(ctx context.Context) error {
+	reply, err := c.Do(ctx, "PING")
+	if err != nil {
+		return fmt.Errorf("failed to ping redis: %w", err)
+	}
+	if reply != "PONG" {
+		return fmt.Errorf("unexpected ping reply: %v", reply)
+	}
+	return nil
+}
+
+// Do sends a raw command and returns its decoded reply (see readReply). Keys
+// passed to Do are used as is, without the configured key prefix.
+func (c *Client) Do(ctx context.Context, args ...string) (any, error) {
+	cn, err := c.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reply, err := cn.do(ctx, c.config.IOTimeout, args)
+	var redisErr Error
+	c.put(cn, err == nil || errors.As(err, &redisErr))
+	return reply, err
+}
+
+// Close closes idle connections. Connections in use are closed when they are
+// returned.
+func (c *Client) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return nil
+	}
+	c.closed = true
+
+	for {
+		select {
+		case cn := <-c.idle:
+			cn.netConn.Close()
+		default:
+			return nil
+		}
+	}
+}
+
+func (c *Client) get(ctx context.Context) (*conn, error) {
+	select {
+	case c.slots <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	c.mu.Lock()
+	closed := c.closed
+	c.mu.Unlock()
+	if closed {
+		<-c.slots
+		return nil, ErrClosed
+	}
+
+	select {
+	case cn := <-c.idle:
+		return cn, nil
+	default:
+	}
+
+	cn, err := c.dial(ctx)
+	if err != nil {
+		<-c.slots
+		return nil, err
+	}
+	return cn, nil
+}
+
+// put returns cn to the pool, or closes it when it may be in a broken state.
+func (c *Client) put(cn *conn, reusable bool) {
+	defer func() { <-c.slots }()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !reusable || c.closed {
+		cn.netConn.Close()
+		return
+	}
+	c.idle <- cn // Never blocks: at most PoolSize connections exist
+}
+
+func (c *Client) dial(ctx context.Context) (*conn, error) {
+	dialer := &net.Dialer{Timeout: c.config.DialTimeout}
+
+	var netConn net.Conn
+	var err error
+	if c.config.TLS {
+		tlsDialer := &tls.Dialer{NetDialer: dialer}
+		netConn, err = tlsDialer.DialContext(ctx, "tcp", c.config.Addr)
+	} else {
+		netConn, err = dialer.DialContext(ctx, "tcp", c.config.Addr)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("dial redis: %w", err)
+	}
+
+	cn := &conn{
+		netConn: netConn,
+		r:       bufio.NewReader(netConn),
+		w:       bufio.NewWriter(netConn),
+	}
+
+	if c.config.Password != "" {
+		args := []string{"AUTH", c.config.Password}
+		if c.config.Username != "" {
+			args = []string{"AUTH", c.config.Username, c.config.Password}
+		}
+		if _, err := cn.do(ctx, c.config.IOTimeout, args); err != nil {
+			netConn.Close()
+			return nil, fmt.Errorf("authenticate: %w", err)
+		}
+	}
+	if c.config.DB != 0 {
+		if _, err := cn.do(ctx, c.config.IOTimeout, []string{"SELECT", strconv.Itoa(c.config.DB)}); err != nil {
+			netConn.Close()
+			return nil, fmt.Errorf("select db %d: %w", c.config.DB, err)
+		}
+	}
+
+	return cn, nil
+}
+
+func (cn *conn) do(ctx context.Context, timeout time.Duration, args []string) (any, error) {
+	deadline, ok := ctx.Deadline()
+	if timeout > 0 {
+		if ioDeadline := time.Now().Add(timeout); !ok || ioDeadline.Before(deadline) {
+			deadline, ok = ioDeadline, true
+		}
+	}
+	if ok {
+		cn.netConn.SetDeadline(deadline)
+	} else {
+		cn.netConn.SetDeadline(time.Time{})
+	}
+
+	if err := writeCommand(cn.w, args); err != nil {
+		return nil, err
+	}
+	return readReply(cn.r)
+}
@@ -0,0 +1,15 @@
+package redis
+
+import "time"
+
+type ConnectionOptions struct {
+	Addr        string // host:port of the Redis server
+	Username    string // ACL user; empty authenticates with Password only
+	Password    string
+	DB          int
+	TLS         bool
+	PoolSize    int // Max open connections
+	DialTimeout time.Duration
+	IOTimeout   time.Duration // Per-command read/write deadline when ctx has none sooner
+	KeyPrefix   string        // Prepended to every key written through Store methods
+}
@@ -0,0 +1,239 @@
+package redis
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeServer speaks enough RESP to exercise the client: AUTH, SELECT, PING,
+// GET, GETDEL, SET, DEL and the EVAL used by Incr.
+type fakeServer struct {
+	listener net.Listener
+	password string
+
+	mu       sync.Mutex
+	data     map[string]string
+	commands [][]string
+}
+
+func newFakeServer(t *testing.T, password string) *fakeServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	s := &fakeServer{listener: listener, password: password, data: make(map[string]string)}
+	go func() {
+		for {
+			nc, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(nc)
+		}
+	}()
+	return s
+}
+
+func (s *fakeServer) serve(nc net.Conn) {
+	defer nc.Close()
+	r, w := bufio.NewReader(nc), bufio.NewWriter(nc)
+	authenticated := s.password == ""
+
+	for {
+		request, err := readReply(r)
+		if err != nil {
+			return
+		}
+		var args []string
+		for _, arg := range request.([]any) {
+			args = append(args, string(arg.([]byte)))
+		}
+
+		s.mu.Lock()
+		s.commands = append(s.commands, args)
+		var reply string
+		switch {
+		case args[0] == "AUTH":
+			authenticated = args[len(args)-1] == s.password
+			reply = "+OK\r\n"
+			if !authenticated {
+				reply = "-WRONGPASS invalid password\r\n"
+			}
+		case !authenticated:
+			reply = "-NOAUTH Authentication required.\r\n"
+		case args[0] == "PING":
+			reply = "+PONG\r\n"
+		case args[0] == "SELECT":
+			reply = "+OK\r\n"
+		case args[0] == "GET":
+			if value, ok := s.data[args[1]]; ok {
+				reply = "$" + strconv.Itoa(len(value)) + "\r\n" + value + "\r\n"
+			} else {
+				reply = "$-1\r\n"
+			}
+		case args[0] == "GETDEL":
+			if value, ok := s.data[args[1]]; ok {
+				delete(s.data, args[1])
+				reply = "$" + strconv.Itoa(len(value)) + "\r\n" + value + "\r\n"
+			} else {
+				reply = "$-1\r\n"
+			}
+		case args[0] == "SET":
+			s.data[args[1]] = args[2]
+			reply = "+OK\r\n"
+		case args[0] == "DEL":
+			deleted := 0
+			for _, key := range args[1:] {
+				if _, ok := s.data[key]; ok {
+					delete(s.data, key)
+					deleted++
+				}
+			}
+			reply = ":" + strconv.Itoa(deleted) + "\r\n"
+		case args[0] == "EVAL":
+			n, _ := strconv.Atoi(s.data[args[3]])
+			n++
+			s.data[args[3]] = strconv.Itoa(n)
+			reply = ":" + strconv.Itoa(n) + "\r\n"
+		default:
+			reply = "-ERR unknown command '" + args[0] + "'\r\n"
+		}
+		s.mu.Unlock()
+
+		w.WriteString(reply)
+		if err := w.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+func TestClient(t *testing.T) {
+	server := newFakeServer(t, "secret")
+	client := NewClient(ConnectionOptions{
+		Addr:        server.listener.Addr().String(),
+		Password:    "secret",
+		DB:          2,
+		PoolSize:    2,
+		DialTimeout: time.Second,
+		IOTimeout:   time.Second,
+		KeyPrefix:   "test:",
+	})
+	defer client.Close()
+	ctx := context.Background()
+
+	require.NoError(t, client.TestConnection(ctx))
+
+	_, found, err := client.Get(ctx, "missing")
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	require.NoError(t, client.Set(ctx, "greeting", []byte("hello\r\nworld"), time.Minute))
+	value, found, err := client.Get(ctx, "greeting")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "hello\r\nworld", string(value))
+
+	require.NoError(t, client.Set(ctx, "once", []byte("secret"), time.Minute))
+	value, found, err = client.Take(ctx, "once")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "secret", string(value))
+	_, found, err = client.Take(ctx, "once")
+	require.NoError(t, err)
+	assert.False(t, found, "taken values are gone")
+
+	for want := int64(1); want <= 3; want++ {
+		n, err := client.Incr(ctx, "hits", time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, want, n)
+	}
+
+	require.NoError(t, client.Delete(ctx, "greeting", "hits"))
+	_, found, err = client.Get(ctx, "greeting")
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	_, err = client.Do(ctx, "FLUSHALL")
+	var redisErr Error
+	require.ErrorAs(t, err, &redisErr)
+	require.NoError(t, client.TestConnection(ctx), "error replies keep the connection usable")
+
+	server.mu.Lock()
+	assert.Equal(t, []string{"AUTH", "secret"}, server.commands[0])
+	assert.Equal(t, []string{"SELECT", "2"}, server.commands[1])
+	assert.Equal(t, []string{"SET", "test:greeting", "hello\r\nworld", "PX", "60000"}, server.commands[4])
+	server.mu.Unlock()
+
+	require.NoError(t, client.Close())
+	_, err = client.Do(ctx, "PING")
+	assert.ErrorIs(t, err, ErrClosed)
+}
+
+func TestClientWrongPassword(t *testing.T) {
+	server := newFakeServer(t, "secret")
+	client := NewClient(ConnectionOptions{Addr: server.listener.Addr().String(), Password: "nope"})
+	defer client.Close()
+
+	assert.Error(t, client.TestConnection(context.Background()))
+}
+
+func TestMemoryStore(t *testing.T) {
+	now := time.Date(2026, 2, 9, 12, 0, 0, 0, time.UTC)
+	store := NewMemoryStore()
+	store.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	require.NoError(t, store.Set(ctx, "session", []byte("data"), time.Minute))
+	require.NoError(t, store.Set(ctx, "forever", []byte("data"), 0))
+
+	for want := int64(1); want <= 3; want++ {
+		n, err := store.Incr(ctx, "hits", 10*time.Second)
+		require.NoError(t, err)
+		assert.Equal(t, want, n)
+	}
+
+	value, found, err := store.Get(ctx, "hits")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "3", string(value))
+
+	now = now.Add(10 * time.Second)
+	n, err := store.Incr(ctx, "hits", 10*time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), n, "a new window starts once the counter expired")
+
+	now = now.Add(time.Minute)
+	_, found, err = store.Get(ctx, "session")
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	_, found, err = store.Get(ctx, "forever")
+	require.NoError(t, err)
+	assert.True(t, found)
+
+	require.NoError(t, store.Delete(ctx, "forever"))
+	_, found, err = store.Get(ctx, "forever")
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	require.NoError(t, store.Set(ctx, "once", []byte("secret"), time.Minute))
+	value, found, err = store.Take(ctx, "once")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "secret", string(value))
+	_, found, err = store.Take(ctx, "once")
+	require.NoError(t, err)
+	assert.False(t, found, "taken values are gone")
+
+	require.NoError(t, store.Set(ctx, "name", []byte("altalune"), 0))
+	_, err = store.Incr(ctx, "name", 0)
+	assert.Error(t, err)
+}
@@ -0,0 +1,105 @@
+package redis
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// Error is an error reply sent by the server, such as WRONGTYPE. The
+// connection stays usable after one.
+type Error string
+
+func (e Error) Error() string { return string(e) }
+
+var errProtocol = errors.New("redis: protocol error")
+
+// writeCommand encodes args as a RESP array of bulk strings.
+func writeCommand(w *bufio.Writer, args []string) error {
+	w.WriteString("*")
+	w.WriteString(strconv.Itoa(len(args)))
+	w.WriteString("\r\n")
+	for _, arg := range args {
+		w.WriteString("$")
+		w.WriteString(strconv.Itoa(len(arg)))
+		w.WriteString("\r\n")
+		w.WriteString(arg)
+		w.WriteString("\r\n")
+	}
+	return w.Flush()
+}
+
+// readReply decodes one RESP2 reply. Simple strings decode to string,
+// integers to int64, bulk strings to []byte, arrays to []any and null
+// replies to nil. Error replies are returned as an Error.
+func readReply(r *bufio.Reader) (any, error) {
+	line, err := readLine(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(line) == 0 {
+		return nil, errProtocol
+	}
+
+	switch line[0] {
+	case '+':
+		return string(line[1:]), nil
+	case '-':
+		return nil, Error(line[1:])
+	case ':':
+		n, err := strconv.ParseInt(string(line[1:]), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid integer %q", errProtocol, line[1:])
+		}
+		return n, nil
+	case '$':
+		size, err := strconv.Atoi(string(line[1:]))
+		if err != nil || size < -1 {
+			return nil, fmt.Errorf("%w: invalid bulk length %q", errProtocol, line[1:])
+		}
+		if size == -1 {
+			return nil, nil
+		}
+		buf := make([]byte, size+2)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return nil, err
+		}
+		return buf[:size], nil
+	case '*':
+		count, err := strconv.Atoi(string(line[1:]))
+		if err != nil || count < -1 {
+			return nil, fmt.Errorf("%w: invalid array length %q", errProtocol, line[1:])
+		}
+		if count == -1 {
+			return nil, nil
+		}
+		items := make([]any, count)
+		for i := range items {
+			item, err := readReply(r)
+			if err != nil {
+				var redisErr Error
+				if !errors.As(err, &redisErr) {
+					return nil, err
+				}
+				item = redisErr // Errors nested in arrays (e.g. EXEC) are values
+			}
+			items[i] = item
+		}
+		return items, nil
+	default:
+		return nil, fmt.Errorf("%w: unexpected reply type %q", errProtocol, line[0])
+	}
+}
+
+func readLine(r *bufio.Reader) ([]byte, error) {
+	line, err := r.ReadSlice('\n')
+	if err != nil {
+		return nil, err
+	}
+	if len(line) < 2 || line[len(line)-2] != '\r' {
+		return nil, fmt.Errorf("%w: malformed line", errProtocol)
+	}
+	return line[:len(line)-2], nil
+}
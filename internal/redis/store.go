@@ -0,0 +1,239 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Store is a key-value store with expiring entries. Rate limiters, session
+// backends and response caches build on it so they work the same whether or
+// not Redis is configured.
+type Store interface {
+	// Get returns the value at key. found is false when the key is missing or
+	// expired.
+	Get(ctx context.Context, key string) (value []byte, found bool, err error)
+	// Set stores value at key. A ttl of zero keeps it until deleted.
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// Delete removes keys, ignoring missing ones.
+	Delete(ctx context.Context, keys ...string) error
+	// Take returns the value at key and removes it, so that only one caller
+	// gets it. found is false when the key is missing or expired.
+	Take(ctx context.Context, key string) (value []byte, found bool, err error)
+	// Incr increments the counter at key and returns its new value. A new
+	// counter expires ttl after its first increment, which makes it a fixed
+	// window for rate limiting.
+	Incr(ctx context.Context, key string, ttl time.Duration) (int64, error)
+}
+
+// incrScript increments and sets the expiry in one round trip, so a crash
+// between the two cannot leave a counter that never expires.
+const incrScript = `
+local n = redis.call('INCR', KEYS[1])
+if n == 1 and tonumber(ARGV[1]) > 0 then
+	redis.call('PEXPIRE', KEYS[1], ARGV[1])
+end
+return n
+`
+
+func (c *Client) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	reply, err := c.Do(ctx, "GET", c.config.KeyPrefix+key)
+	if err != nil {
+		return nil, false, fmt.Errorf("get %s: %w", key, err)
+	}
+	if reply == nil {
+		return nil, false, nil
+	}
+	value, ok := reply.([]byte)
+	if !ok {
+		return nil, false, fmt.Errorf("get %s: unexpected reply %T", key, reply)
+	}
+	return value, true, nil
+}
+
+func (c *Client) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	args := []string{"SET", c.config.KeyPrefix + key, string(value)}
+	if ttl > 0 {
+		args = append(args, "PX", strconv.FormatInt(ttl.Milliseconds(), 10))
+	}
+	if _, err := c.Do(ctx, args...); err != nil {
+		return fmt.Errorf("set %s: %w", key, err)
+	}
+	return nil
+}
+
+func (c *Client) Delete(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	args := make([]string, 0, len(keys)+1)
+	args = append(args, "DEL")
+	for _, key := range keys {
+		args = append(args, c.config.KeyPrefix+key)
+	}
+	if _, err := c.Do(ctx, args...); err != nil {
+		return fmt.Errorf("delete: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) Take(ctx context.Context, key string) ([]byte, bool, error) {
+	reply, err := c.Do(ctx, "GETDEL", c.config.KeyPrefix+key)
+	if err != nil {
+		return nil, false, fmt.Errorf("take %s: %w", key, err)
+	}
+	if reply == nil {
+		return nil, false, nil
+	}
+	value, ok := reply.([]byte)
+	if !ok {
+		return nil, false, fmt.Errorf("take %s: unexpected reply %T", key, reply)
+	}
+	return value, true, nil
+}
+
+func (c *Client) Incr(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	reply, err := c.Do(ctx, "EVAL", incrScript, "1", c.config.KeyPrefix+key, strconv.FormatInt(ttl.Milliseconds(), 10))
+	if err != nil {
+		return 0, fmt.Errorf("incr %s: %w", key, err)
+	}
+	n, ok := reply.(int64)
+	if !ok {
+		return 0, fmt.Errorf("incr %s: unexpected reply %T", key, reply)
+	}
+	return n, nil
+}
+
+// MemoryStore is a Store kept in process memory, used when Redis is not
+// configured. Its state is not shared between replicas.
+type MemoryStore struct {
+	mu        sync.Mutex
+	entries   map[string]memoryEntry
+	now       func() time.Time
+	lastSweep time.Time
+}
+
+var _ Store = (*MemoryStore)(nil)
+
+type memoryEntry struct {
+	value     []byte
+	counter   int64
+	expiresAt time.Time // Zero when the entry does not expire
+}
+
+// memorySweepInterval is how often writes drop expired entries, so keys that
+// are never read again do not accumulate.
+const memorySweepInterval = time.Minute
+
+// NewMemoryStore creates an empty in-memory store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		entries: make(map[string]memoryEntry),
+		now:     time.Now,
+	}
+}
+
+func (s *MemoryStore) Get(_ context.Context, key string) ([]byte, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.lookup(key)
+	if !ok {
+		return nil, false, nil
+	}
+	if entry.value == nil {
+		return []byte(strconv.FormatInt(entry.counter, 10)), true, nil
+	}
+	return append([]byte(nil), entry.value...), true, nil
+}
+
+func (s *MemoryStore) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweep()
+	entry := memoryEntry{value: append([]byte{}, value...)}
+	if ttl > 0 {
+		entry.expiresAt = s.now().Add(ttl)
+	}
+	s.entries[key] = entry
+	return nil
+}
+
+func (s *MemoryStore) Delete(_ context.Context, keys ...string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, key := range keys {
+		delete(s.entries, key)
+	}
+	return nil
+}
+
+func (s *MemoryStore) Take(_ context.Context, key string) ([]byte, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.lookup(key)
+	if !ok {
+		return nil, false, nil
+	}
+	delete(s.entries, key)
+	if entry.value == nil {
+		return []byte(strconv.FormatInt(entry.counter, 10)), true, nil
+	}
+	return entry.value, true, nil
+}
+
+func (s *MemoryStore) Incr(_ context.Context, key string, ttl time.Duration) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweep()
+	entry, ok := s.lookup(key)
+	if !ok {
+		entry = memoryEntry{}
+		if ttl > 0 {
+			entry.expiresAt = s.now().Add(ttl)
+		}
+	} else if entry.value != nil {
+		n, err := strconv.ParseInt(string(entry.value), 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("incr %s: value is not an integer", key)
+		}
+		entry.value, entry.counter = nil, n
+	}
+
+	entry.counter++
+	s.entries[key] = entry
+	return entry.counter, nil
+}
+
+// lookup returns the live entry at key, dropping it if it expired.
+func (s *MemoryStore) lookup(key string) (memoryEntry, bool) {
+	entry, ok := s.entries[key]
+	if !ok {
+		return memoryEntry{}, false
+	}
+	if !entry.expiresAt.IsZero() && !s.now().Before(entry.expiresAt) {
+		delete(s.entries, key)
+		return memoryEntry{}, false
+	}
+	return entry, true
+}
+
+func (s *MemoryStore) sweep() {
+	now := s.now()
+	if now.Sub(s.lastSweep) < memorySweepInterval {
+		return
+	}
+	s.lastSweep = now
+
+	for key, entry := range s.entries {
+		if !entry.expiresAt.IsZero() && !now.Before(entry.expiresAt) {
+			delete(s.entries, key)
+		}
+	}
+}
@@ -0,0 +1,230 @@
+package server
+
+import (
+	"bufio"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// compressMinBytes is the smallest response worth compressing; below this the
+// encoding overhead outweighs the savings.
+const compressMinBytes = 1024
+
+var gzipWriterPool = sync.Pool{
+	New: func() any {
+		return gzip.NewWriter(io.Discard)
+	},
+}
+
+// CompressionMiddleware compresses responses with gzip or deflate according to
+// the request's Accept-Encoding. Responses that already carry a Content-Encoding
+// (Connect negotiates its own gzip, precompressed static assets), gRPC traffic,
+// range requests and small bodies are passed through untouched.
+func CompressionMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Vary", "Accept-Encoding")
+
+		encoding := negotiateEncoding(r.Header.Get("Accept-Encoding"))
+		if encoding == "" || r.Header.Get("Range") != "" || isGRPCContentType(r.Header.Get("Content-Type")) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		cw := &compressResponseWriter{ResponseWriter: w, encoding: encoding}
+		defer cw.Close()
+
+		next.ServeHTTP(cw, r)
+	})
+}
+
+// negotiateEncoding picks gzip or deflate from an Accept-Encoding header,
+// preferring gzip when both are acceptable.
+func negotiateEncoding(header string) string {
+	var gzipOK, deflateOK bool
+	for _, part := range strings.Split(header, ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if v, err := strconv.ParseFloat(q, 64); err == nil && v == 0 {
+				continue
+			}
+		}
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "gzip", "*":
+			gzipOK = true
+		case "deflate":
+			deflateOK = true
+		}
+	}
+
+	switch {
+	case gzipOK:
+		return "gzip"
+	case deflateOK:
+		return "deflate"
+	default:
+		return ""
+	}
+}
+
+func isGRPCContentType(contentType string) bool {
+	return strings.HasPrefix(contentType, "application/grpc") ||
+		strings.HasPrefix(contentType, "application/connect+")
+}
+
+// compressResponseWriter compresses the body once it is known to reach
+// compressMinBytes: right away when the handler declares a large enough
+// Content-Length, otherwise once that much is written. Until then the status
+// and body are held back, and a body ending below it is sent as is.
+type compressResponseWriter struct {
+	http.ResponseWriter
+	encoding    string
+	writer      io.WriteCloser
+	wroteHeader bool
+	passthrough bool
+	pending     bool   // status held back until the size of the body is known
+	code        int    // status held back
+	buf         []byte // body held back, shorter than compressMinBytes
+}
+
+func (cw *compressResponseWriter) WriteHeader(code int) {
+	if cw.wroteHeader {
+		return
+	}
+	cw.wroteHeader = true
+
+	h := cw.Header()
+	cw.passthrough = code < http.StatusOK ||
+		code == http.StatusNoContent ||
+		code == http.StatusNotModified ||
+		code == http.StatusPartialContent ||
+		h.Get("Content-Encoding") != "" ||
+		isGRPCContentType(h.Get("Content-Type"))
+
+	if !cw.passthrough {
+		if cl, err := strconv.Atoi(h.Get("Content-Length")); err == nil {
+			cw.passthrough = cl < compressMinBytes
+		} else {
+			cw.pending, cw.code = true, code
+			return
+		}
+	}
+
+	if !cw.passthrough {
+		cw.startCompression()
+	}
+	cw.ResponseWriter.WriteHeader(code)
+}
+
+// startCompression sets the headers of the compressed response and the
+// compressor writing it
+func (cw *compressResponseWriter) startCompression() {
+	h := cw.Header()
+	h.Set("Content-Encoding", cw.encoding)
+	h.Del("Content-Length")
+	h.Del("Accept-Ranges")
+	if etag := h.Get("ETag"); etag != "" && !strings.HasPrefix(etag, "W/") {
+		// the compressed representation is no longer byte-identical
+		h.Set("ETag", "W/"+etag)
+	}
+
+	switch cw.encoding {
+	case "gzip":
+		gz := gzipWriterPool.Get().(*gzip.Writer)
+		gz.Reset(cw.ResponseWriter)
+		cw.writer = gz
+	case "deflate":
+		cw.writer = zlib.NewWriter(cw.ResponseWriter)
+	}
+}
+
+// release sends the held back status, compressed or not, and the body
+// written so far
+func (cw *compressResponseWriter) release(compress bool) error {
+	cw.pending = false
+	buf := cw.buf
+	cw.buf = nil
+
+	if compress {
+		cw.startCompression()
+		cw.ResponseWriter.WriteHeader(cw.code)
+		_, err := cw.writer.Write(buf)
+		return err
+	}
+	cw.passthrough = true
+	cw.Header().Set("Content-Length", strconv.Itoa(len(buf)))
+	cw.ResponseWriter.WriteHeader(cw.code)
+	_, err := cw.ResponseWriter.Write(buf)
+	return err
+}
+
+func (cw *compressResponseWriter) Write(b []byte) (int, error) {
+	if !cw.wroteHeader {
+		if cw.Header().Get("Content-Type") == "" {
+			cw.Header().Set("Content-Type", http.DetectContentType(b))
+		}
+		cw.WriteHeader(http.StatusOK)
+	}
+	if cw.pending {
+		cw.buf = append(cw.buf, b...)
+		if len(cw.buf) < compressMinBytes {
+			return len(b), nil
+		}
+		if err := cw.release(true); err != nil {
+			return 0, err
+		}
+		return len(b), nil
+	}
+	if cw.writer == nil {
+		return cw.ResponseWriter.Write(b)
+	}
+	return cw.writer.Write(b)
+}
+
+// Flush implements http.Flusher so streaming responses keep working. A
+// flushed response is taken for a stream and compressed whatever its size.
+func (cw *compressResponseWriter) Flush() {
+	if cw.pending {
+		cw.release(true)
+	}
+	if f, ok := cw.writer.(interface{ Flush() error }); ok {
+		f.Flush()
+	}
+	if f, ok := cw.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Hijack implements http.Hijacker for protocol upgrades.
+func (cw *compressResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	if h, ok := cw.ResponseWriter.(http.Hijacker); ok {
+		return h.Hijack()
+	}
+	return nil, nil, http.ErrNotSupported
+}
+
+// Unwrap exposes the underlying writer to http.ResponseController.
+func (cw *compressResponseWriter) Unwrap() http.ResponseWriter {
+	return cw.ResponseWriter
+}
+
+func (cw *compressResponseWriter) Close() error {
+	if cw.pending {
+		// The whole body is shorter than compressMinBytes
+		return cw.release(false)
+	}
+	if cw.writer == nil {
+		return nil
+	}
+	err := cw.writer.Close()
+	if gz, ok := cw.writer.(*gzip.Writer); ok {
+		gzipWriterPool.Put(gz)
+	}
+	cw.writer = nil
+	return err
+}
@@ -0,0 +1,201 @@
+package server
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNegotiateEncoding(t *testing.T) {
+	tests := []struct {
+		header string
+		want   string
+	}{
+		{header: "", want: ""},
+		{header: "gzip", want: "gzip"},
+		{header: "deflate", want: "deflate"},
+		{header: "deflate, gzip", want: "gzip"},
+		{header: "GZIP;q=0.5", want: "gzip"},
+		{header: "*", want: "gzip"},
+		{header: "gzip;q=0, deflate", want: "deflate"},
+		{header: "gzip;q=0, deflate;q=0", want: ""},
+		{header: "br, identity", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.header, func(t *testing.T) {
+			assert.Equal(t, tt.want, negotiateEncoding(tt.header))
+		})
+	}
+}
+
+func TestCompressionMiddleware(t *testing.T) {
+	large := strings.Repeat("a", compressMinBytes)
+	small := strings.Repeat("a", compressMinBytes-1)
+
+	tests := []struct {
+		name           string
+		acceptEncoding string
+		requestHeader  http.Header
+		header         http.Header
+		status         int
+		body           []string // written in as many writes
+		flush          bool
+		wantEncoding   string
+	}{
+		{
+			name:           "large body",
+			acceptEncoding: "gzip",
+			body:           []string{large},
+			wantEncoding:   "gzip",
+		},
+		{
+			name:           "large body with deflate",
+			acceptEncoding: "deflate",
+			body:           []string{large},
+			wantEncoding:   "deflate",
+		},
+		{
+			name:           "small body without Content-Length",
+			acceptEncoding: "gzip",
+			body:           []string{small},
+		},
+		{
+			name:           "small writes adding up to a large body",
+			acceptEncoding: "gzip",
+			body:           []string{small, "a", small},
+			wantEncoding:   "gzip",
+		},
+		{
+			name:           "small declared Content-Length",
+			acceptEncoding: "gzip",
+			header:         http.Header{"Content-Length": {strconv.Itoa(len(small))}},
+			body:           []string{small},
+		},
+		{
+			name:           "large declared Content-Length",
+			acceptEncoding: "gzip",
+			header:         http.Header{"Content-Length": {strconv.Itoa(len(large))}},
+			body:           []string{large},
+			wantEncoding:   "gzip",
+		},
+		{
+			name:           "flushed stream",
+			acceptEncoding: "gzip",
+			body:           []string{"data: 1\n\n"},
+			flush:          true,
+			wantEncoding:   "gzip",
+		},
+		{
+			name: "no acceptable encoding",
+			body: []string{large},
+		},
+		{
+			name:           "already encoded",
+			acceptEncoding: "gzip",
+			header:         http.Header{"Content-Encoding": {"br"}},
+			body:           []string{large},
+			wantEncoding:   "br",
+		},
+		{
+			name:           "range request",
+			acceptEncoding: "gzip",
+			requestHeader:  http.Header{"Range": {"bytes=0-"}},
+			body:           []string{large},
+		},
+		{
+			name:           "grpc",
+			acceptEncoding: "gzip",
+			header:         http.Header{"Content-Type": {"application/grpc"}},
+			body:           []string{large},
+		},
+		{
+			name:           "no content",
+			acceptEncoding: "gzip",
+			status:         http.StatusNoContent,
+		},
+		{
+			name:           "empty body",
+			acceptEncoding: "gzip",
+			status:         http.StatusOK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := CompressionMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				for k, v := range tt.header {
+					w.Header()[k] = v
+				}
+				if tt.status != 0 {
+					w.WriteHeader(tt.status)
+				}
+				for _, b := range tt.body {
+					_, err := io.WriteString(w, b)
+					require.NoError(t, err)
+				}
+				if tt.flush {
+					w.(http.Flusher).Flush()
+				}
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			for k, v := range tt.requestHeader {
+				req.Header[k] = v
+			}
+			if tt.acceptEncoding != "" {
+				req.Header.Set("Accept-Encoding", tt.acceptEncoding)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			want := strings.Join(tt.body, "")
+			wantStatus := tt.status
+			if wantStatus == 0 {
+				wantStatus = http.StatusOK
+			}
+			assert.Equal(t, wantStatus, rec.Code)
+			assert.Equal(t, tt.wantEncoding, rec.Header().Get("Content-Encoding"))
+			assert.Contains(t, rec.Header().Values("Vary"), "Accept-Encoding")
+
+			body := rec.Body.Bytes()
+			switch tt.wantEncoding {
+			case "gzip":
+				r, err := gzip.NewReader(bytes.NewReader(body))
+				require.NoError(t, err)
+				body, err = io.ReadAll(r)
+				require.NoError(t, err)
+				assert.Empty(t, rec.Header().Get("Content-Length"))
+			case "deflate":
+				r, err := zlib.NewReader(bytes.NewReader(body))
+				require.NoError(t, err)
+				body, err = io.ReadAll(r)
+				require.NoError(t, err)
+			}
+			assert.Equal(t, want, string(body))
+		})
+	}
+}
+
+func TestCompressionMiddlewareWeakensETag(t *testing.T) {
+	handler := CompressionMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `"v1"`)
+		w.Header().Set("Accept-Ranges", "bytes")
+		io.WriteString(w, strings.Repeat("a", compressMinBytes))
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, `W/"v1"`, rec.Header().Get("ETag"))
+	assert.Empty(t, rec.Header().Get("Accept-Ranges"))
+}
@@ -0,0 +1,95 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+)
+
+type corsProjectKey struct{}
+
+// withCORSProject records that the call was allowed from an origin of the
+// project only
+func withCORSProject(ctx context.Context, projectID string) context.Context {
+	return context.WithValue(ctx, corsProjectKey{}, projectID)
+}
+
+// CORSProject returns the public ID of the project the call was allowed for,
+// false when its origin is allowed for every project or it is not
+// cross-origin.
+func CORSProject(ctx context.Context) (string, bool) {
+	projectID, ok := ctx.Value(corsProjectKey{}).(string)
+	return projectID, ok
+}
+
+// errCORSProject refuses the messages of a call allowed for a project naming
+// another one, or none
+var errCORSProject = connect.NewError(connect.CodePermissionDenied, errors.New("this origin may only call the project named in the X-Project-ID header"))
+
+// corsProjectInterceptor implements connect.Interceptor to hold the calls
+// allowed from an origin of a single project to the messages naming that
+// project, as CORSMiddleware only sees the X-Project-ID header.
+type corsProjectInterceptor struct{}
+
+// newCORSProjectInterceptor creates a Connect-RPC interceptor refusing the
+// messages naming another project than the one their call was allowed for
+// with CORSProject, and those naming none.
+func newCORSProjectInterceptor() connect.Interceptor {
+	return &corsProjectInterceptor{}
+}
+
+// WrapUnary implements connect.Interceptor for unary RPC calls.
+func (i *corsProjectInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if !corsProjectAllows(ctx, req.Any()) {
+			return nil, errCORSProject
+		}
+		return next(ctx, req)
+	}
+}
+
+// WrapStreamingClient implements connect.Interceptor for client streaming.
+// This is a pass-through for server-side interceptors.
+func (i *corsProjectInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler implements connect.Interceptor for server streaming.
+// Every message received is checked.
+func (i *corsProjectInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if _, ok := CORSProject(ctx); !ok {
+			return next(ctx, conn)
+		}
+		return next(ctx, &corsProjectConn{StreamingHandlerConn: conn, ctx: ctx})
+	}
+}
+
+// corsProjectAllows reports whether msg names the project the call of ctx was
+// allowed for, if any
+func corsProjectAllows(ctx context.Context, msg any) bool {
+	projectID, ok := CORSProject(ctx)
+	if !ok {
+		return true
+	}
+	m, ok := msg.(proto.Message)
+	return ok && requestProjectID(m) == projectID
+}
+
+// corsProjectConn checks the messages received on a stream
+type corsProjectConn struct {
+	connect.StreamingHandlerConn
+	ctx context.Context
+}
+
+func (c *corsProjectConn) Receive(msg any) error {
+	if err := c.StreamingHandlerConn.Receive(msg); err != nil {
+		return err
+	}
+	if !corsProjectAllows(c.ctx, msg) {
+		return errCORSProject
+	}
+	return nil
+}
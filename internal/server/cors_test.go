@@ -0,0 +1,150 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCORSMiddleware(t *testing.T) {
+	projectOrigins := map[string][]string{"abc": {"https://abc.example.com"}}
+	global := CORSOptions{
+		AllowedOrigins:   []string{"https://dashboard.example.com"},
+		ProjectOrigins:   projectOrigins,
+		Project:          func(r *http.Request) string { return r.Header.Get(ProjectIDHeader) },
+		AllowCredentials: true,
+		MaxAge:           10 * time.Minute,
+	}
+	wildcard := global
+	wildcard.AllowedOrigins = []string{"*"}
+
+	tests := []struct {
+		name        string
+		opts        CORSOptions
+		method      string
+		origin      string
+		project     string
+		status      int
+		allowOrigin string
+		credentials bool
+		wantProject string
+	}{
+		{
+			name:        "global origin",
+			opts:        global,
+			origin:      "https://dashboard.example.com",
+			status:      http.StatusOK,
+			allowOrigin: "https://dashboard.example.com",
+			credentials: true,
+		},
+		{
+			name:   "unknown origin",
+			opts:   global,
+			origin: "https://evil.example.com",
+			status: http.StatusOK,
+		},
+		{
+			name:        "project origin calling its project",
+			opts:        global,
+			origin:      "https://abc.example.com",
+			project:     "abc",
+			status:      http.StatusOK,
+			allowOrigin: "https://abc.example.com",
+			credentials: true,
+			wantProject: "abc",
+		},
+		{
+			name:    "project origin calling another project",
+			opts:    global,
+			origin:  "https://abc.example.com",
+			project: "def",
+			status:  http.StatusForbidden,
+		},
+		{
+			name:   "project origin naming no project",
+			opts:   global,
+			origin: "https://abc.example.com",
+			status: http.StatusForbidden,
+		},
+		{
+			name:        "project origin preflight naming no project",
+			opts:        global,
+			method:      http.MethodOptions,
+			origin:      "https://abc.example.com",
+			status:      http.StatusNoContent,
+			allowOrigin: "https://abc.example.com",
+			credentials: true,
+		},
+		{
+			name:    "project origin preflight naming another project",
+			opts:    global,
+			method:  http.MethodOptions,
+			origin:  "https://abc.example.com",
+			project: "def",
+			status:  http.StatusNoContent,
+		},
+		{
+			name:        "wildcard never allows credentials",
+			opts:        wildcard,
+			origin:      "https://evil.example.com",
+			status:      http.StatusOK,
+			allowOrigin: "*",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var project string
+			var projectOK bool
+			handler := CORSMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				project, projectOK = CORSProject(r.Context())
+			}), tt.opts)
+
+			method := tt.method
+			if method == "" {
+				method = http.MethodPost
+			}
+			req := httptest.NewRequest(method, "/api/altalune.v1.ApiKeyService/GetApiKey", nil)
+			req.Header.Set("Origin", tt.origin)
+			if tt.project != "" {
+				req.Header.Set(ProjectIDHeader, tt.project)
+			}
+			if method == http.MethodOptions {
+				req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.status, rec.Code)
+			assert.Equal(t, tt.allowOrigin, rec.Header().Get("Access-Control-Allow-Origin"))
+			assert.Equal(t, tt.credentials, rec.Header().Get("Access-Control-Allow-Credentials") == "true")
+			if method == http.MethodOptions && tt.allowOrigin != "" {
+				assert.Equal(t, "600", rec.Header().Get("Access-Control-Max-Age"))
+				assert.Contains(t, rec.Header().Get("Access-Control-Allow-Headers"), ProjectIDHeader)
+			}
+			assert.Equal(t, tt.wantProject, project)
+			assert.Equal(t, tt.wantProject != "", projectOK)
+		})
+	}
+}
+
+func TestCORSProjectInterceptor(t *testing.T) {
+	next := newCORSProjectInterceptor().WrapUnary(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		return nil, nil
+	})
+	call := func(ctx context.Context, projectID string) error {
+		_, err := next(ctx, connect.NewRequest(&altalunev1.GetApiKeyRequest{ProjectId: projectID}))
+		return err
+	}
+
+	assert.NoError(t, call(context.Background(), "def"), "calls allowed for every project are not held")
+	ctx := withCORSProject(context.Background(), "abc")
+	assert.NoError(t, call(ctx, "abc"))
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(call(ctx, "def")))
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(call(ctx, "")))
+}
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	query_export_domain "github.com/hrz8/altalune/internal/domain/query_export"
+	"github.com/hrz8/altalune/internal/shared/filestore"
+)
+
+// queryExportDownloadHandler serves the files of the completed query exports
+// on their download links, which need no credentials: the token of a link is
+// only handed to the user who started the export, when starting it.
+func (s *Server) queryExportDownloadHandler() http.Handler {
+	exports := s.c.GetQueryExportRepo()
+	files := s.c.GetQueryExportFiles()
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", "GET")
+			http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Cache-Control", "no-store")
+		w.Header().Set("Referrer-Policy", "no-referrer")
+		w.Header().Set("X-Robots-Tag", "noindex")
+
+		token := strings.TrimPrefix(r.URL.Path, query_export_domain.DownloadPath)
+		file, err := exports.GetFile(r.Context(), token)
+		if err != nil {
+			if err == query_export_domain.ErrQueryExportNotFound {
+				http.Error(w, "This export expired, start it again", http.StatusGone)
+				return
+			}
+			if err == query_export_domain.ErrQueryExportNotReady {
+				w.Header().Set("Retry-After", "5")
+				http.Error(w, "This export is not ready yet, try again shortly", http.StatusConflict)
+				return
+			}
+			s.log.Error("failed to get query export file", "error", err)
+			http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+			return
+		}
+		content, err := files.Open(file.Key)
+		if err != nil {
+			if errors.Is(err, filestore.ErrNotFound) {
+				http.Error(w, "This export expired, start it again", http.StatusGone)
+				return
+			}
+			s.log.Error("failed to open query export file", "error", err)
+			http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+			return
+		}
+		defer content.Close()
+
+		// Streamed from the file store, with range requests for resumed downloads
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", file.Filename))
+		http.ServeContent(w, r, "", time.Time{}, content)
+	})
+}
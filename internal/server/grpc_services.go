@@ -8,7 +8,10 @@ import (
 )
 
 func (s *Server) setupGRPCServices() *grpc.Server {
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(
+		grpc.MaxRecvMsgSize(int(s.cfg.GetServerMaxRequestBytes())),
+		grpc.UnaryInterceptor(maintenanceUnaryInterceptor(s.c.GetMaintenanceSwitch())),
+	)
 
 	// Examples
 	greeterv1.RegisterGreeterServiceServer(grpcServer, s.c.GetGreeterService())
@@ -16,6 +19,8 @@ func (s *Server) setupGRPCServices() *grpc.Server {
 
 	// Domains
 	altalunev1.RegisterProjectServiceServer(grpcServer, s.c.GetProjectService())
+	altalunev1.RegisterProjectHostnameServiceServer(grpcServer, s.c.GetProjectHostnameService())
+	altalunev1.RegisterProjectBrandingServiceServer(grpcServer, s.c.GetProjectBrandingService())
 	altalunev1.RegisterApiKeyServiceServer(grpcServer, s.c.GetApiKeyService())
 
 	// IAM Domains
@@ -28,6 +33,27 @@ func (s *Server) setupGRPCServices() *grpc.Server {
 	altalunev1.RegisterOAuthProviderServiceServer(grpcServer, s.c.GetOAuthProviderService())
 	altalunev1.RegisterOAuthClientServiceServer(grpcServer, s.c.GetOAuthClientService())
 
+	// Feature Flags
+	altalunev1.RegisterFeatureFlagServiceServer(grpcServer, s.c.GetFeatureFlagService())
+
+	// Usage
+	altalunev1.RegisterUsageServiceServer(grpcServer, s.c.GetUsageService())
+
+	// Organizations
+	altalunev1.RegisterOrganizationServiceServer(grpcServer, s.c.GetOrganizationService())
+
+	// Billing
+	altalunev1.RegisterBillingServiceServer(grpcServer, s.c.GetBillingService())
+
+	// Saved Views
+	altalunev1.RegisterSavedViewServiceServer(grpcServer, s.c.GetSavedViewService())
+
+	// Query Exports
+	altalunev1.RegisterQueryExportServiceServer(grpcServer, s.c.GetQueryExportService())
+
+	// Project Activity
+	altalunev1.RegisterActivityServiceServer(grpcServer, s.c.GetActivityService())
+
 	reflection.Register(grpcServer)
 
 	return grpcServer
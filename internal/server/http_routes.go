@@ -3,41 +3,56 @@ package server
 import (
 	"encoding/json"
 	"net/http"
+	"slices"
 
 	"connectrpc.com/connect"
 	"github.com/hrz8/altalune/gen/altalune/v1/altalunev1connect"
 	"github.com/hrz8/altalune/gen/greeter/v1/greeterv1connect"
-	"github.com/hrz8/altalune/internal/auth"
+	activity_domain "github.com/hrz8/altalune/internal/domain/activity"
 	api_key_domain "github.com/hrz8/altalune/internal/domain/api_key"
+	billing_domain "github.com/hrz8/altalune/internal/domain/billing"
 	chatbot_domain "github.com/hrz8/altalune/internal/domain/chatbot"
 	chatbot_node_domain "github.com/hrz8/altalune/internal/domain/chatbot_node"
 	config_domain "github.com/hrz8/altalune/internal/domain/config"
 	employee_domain "github.com/hrz8/altalune/internal/domain/employee"
+	feature_flag_domain "github.com/hrz8/altalune/internal/domain/feature_flag"
 	greeter_domain "github.com/hrz8/altalune/internal/domain/greeter"
 	iam_mapper_domain "github.com/hrz8/altalune/internal/domain/iam_mapper"
 	oauth_client_domain "github.com/hrz8/altalune/internal/domain/oauth_client"
 	oauth_provider_domain "github.com/hrz8/altalune/internal/domain/oauth_provider"
+	organization_domain "github.com/hrz8/altalune/internal/domain/organization"
 	permission_domain "github.com/hrz8/altalune/internal/domain/permission"
 	project_domain "github.com/hrz8/altalune/internal/domain/project"
+	project_branding_domain "github.com/hrz8/altalune/internal/domain/project_branding"
+	project_hostname_domain "github.com/hrz8/altalune/internal/domain/project_hostname"
+	query_export_domain "github.com/hrz8/altalune/internal/domain/query_export"
 	role_domain "github.com/hrz8/altalune/internal/domain/role"
+	saved_view_domain "github.com/hrz8/altalune/internal/domain/saved_view"
+	usage_domain "github.com/hrz8/altalune/internal/domain/usage"
 	user_domain "github.com/hrz8/altalune/internal/domain/user"
+	"github.com/hrz8/altalune/internal/shared/secretdelivery"
 )
 
 func (s *Server) setupRoutes() *http.ServeMux {
 	connectrpcMux := http.NewServeMux()
 
-	// Setup auth interceptor if JWT validator is configured
-	var handlerOptions []connect.HandlerOption
-	if validator := s.c.GetJWTValidator(); validator != nil {
-		authInterceptor := auth.NewAuthInterceptor(validator)
-		handlerOptions = append(handlerOptions, connect.WithInterceptors(authInterceptor))
+	// Request limits shared by every Connect handler
+	limits := []connect.HandlerOption{
+		connect.WithReadMaxBytes(int(s.cfg.GetServerMaxRequestBytes())),
+		connect.WithCompressMinBytes(compressMinBytes),
 	}
 
+	// Interceptors, see interceptorChain for their order; the public services
+	// skip those of authentication
+	chain := s.interceptorChain()
+	baseOptions := append(slices.Clone(limits), chain.handlerOption(true))
+	handlerOptions := append(slices.Clone(limits), chain.handlerOption(false))
+
 	// Get authorizer for handlers that need authorization checks
 	authorizer := s.c.GetAuthorizer()
 
 	// Examples
-	greeterHandler := greeter_domain.NewHandler(s.c.GetGreeterService())
+	greeterHandler := greeter_domain.NewHandler(s.c.GetGreeterService(), authorizer)
 	employeeHandler := employee_domain.NewHandler(s.c.GetEmployeeService(), authorizer)
 	greeterPath, greeterConnectHandler := greeterv1connect.NewGreeterServiceHandler(greeterHandler, handlerOptions...)
 	employeePath, employeeConnectHandler := altalunev1connect.NewEmployeeServiceHandler(employeeHandler, handlerOptions...)
@@ -49,6 +64,14 @@ func (s *Server) setupRoutes() *http.ServeMux {
 	projectPath, projectConnectHandler := altalunev1connect.NewProjectServiceHandler(projectHandler, handlerOptions...)
 	connectrpcMux.Handle(projectPath, projectConnectHandler)
 
+	projectHostnameHandler := project_hostname_domain.NewHandler(s.c.GetProjectHostnameService(), authorizer)
+	projectHostnamePath, projectHostnameConnectHandler := altalunev1connect.NewProjectHostnameServiceHandler(projectHostnameHandler, handlerOptions...)
+	connectrpcMux.Handle(projectHostnamePath, projectHostnameConnectHandler)
+
+	projectBrandingHandler := project_branding_domain.NewHandler(s.c.GetProjectBrandingService(), authorizer)
+	projectBrandingPath, projectBrandingConnectHandler := altalunev1connect.NewProjectBrandingServiceHandler(projectBrandingHandler, handlerOptions...)
+	connectrpcMux.Handle(projectBrandingPath, projectBrandingConnectHandler)
+
 	apiKeyHandler := api_key_domain.NewHandler(s.c.GetApiKeyService(), authorizer)
 	apiKeyPath, apiKeyConnectHandler := altalunev1connect.NewApiKeyServiceHandler(apiKeyHandler, handlerOptions...)
 	connectrpcMux.Handle(apiKeyPath, apiKeyConnectHandler)
@@ -86,9 +109,37 @@ func (s *Server) setupRoutes() *http.ServeMux {
 	oauthClientPath, oauthClientConnectHandler := altalunev1connect.NewOAuthClientServiceHandler(oauthClientHandler, handlerOptions...)
 	connectrpcMux.Handle(oauthClientPath, oauthClientConnectHandler)
 
+	featureFlagHandler := feature_flag_domain.NewHandler(s.c.GetFeatureFlagService(), authorizer)
+	featureFlagPath, featureFlagConnectHandler := altalunev1connect.NewFeatureFlagServiceHandler(featureFlagHandler, handlerOptions...)
+	connectrpcMux.Handle(featureFlagPath, featureFlagConnectHandler)
+
+	usageHandler := usage_domain.NewHandler(s.c.GetUsageService(), authorizer)
+	usagePath, usageConnectHandler := altalunev1connect.NewUsageServiceHandler(usageHandler, handlerOptions...)
+	connectrpcMux.Handle(usagePath, usageConnectHandler)
+
+	organizationHandler := organization_domain.NewHandler(s.c.GetOrganizationService(), authorizer)
+	organizationPath, organizationConnectHandler := altalunev1connect.NewOrganizationServiceHandler(organizationHandler, handlerOptions...)
+	connectrpcMux.Handle(organizationPath, organizationConnectHandler)
+
+	billingHandler := billing_domain.NewHandler(s.c.GetBillingService(), authorizer)
+	billingPath, billingConnectHandler := altalunev1connect.NewBillingServiceHandler(billingHandler, handlerOptions...)
+	connectrpcMux.Handle(billingPath, billingConnectHandler)
+
+	savedViewHandler := saved_view_domain.NewHandler(s.c.GetSavedViewService(), authorizer)
+	savedViewPath, savedViewConnectHandler := altalunev1connect.NewSavedViewServiceHandler(savedViewHandler, handlerOptions...)
+	connectrpcMux.Handle(savedViewPath, savedViewConnectHandler)
+
+	queryExportHandler := query_export_domain.NewHandler(s.c.GetQueryExportService(), authorizer)
+	queryExportPath, queryExportConnectHandler := altalunev1connect.NewQueryExportServiceHandler(queryExportHandler, handlerOptions...)
+	connectrpcMux.Handle(queryExportPath, queryExportConnectHandler)
+
+	activityHandler := activity_domain.NewHandler(s.c.GetActivityService(), authorizer)
+	activityPath, activityConnectHandler := altalunev1connect.NewActivityServiceHandler(activityHandler, handlerOptions...)
+	connectrpcMux.Handle(activityPath, activityConnectHandler)
+
 	// Public Config (no auth required - register without auth interceptor)
 	configHandler := config_domain.NewHandler(s.cfg)
-	configPath, configConnectHandler := altalunev1connect.NewConfigServiceHandler(configHandler)
+	configPath, configConnectHandler := altalunev1connect.NewConfigServiceHandler(configHandler, baseOptions...)
 	connectrpcMux.Handle(configPath, configConnectHandler)
 
 	// main server mux
@@ -98,7 +149,7 @@ func (s *Server) setupRoutes() *http.ServeMux {
 	s.registerBFFRoutes(mux)
 
 	// Connect-RPC API routes
-	mux.Handle("/api/", http.StripPrefix("/api", connectrpcMux))
+	mux.Handle("/api/", s.withCORS(http.StripPrefix("/api", connectrpcMux)))
 
 	// Health check endpoint
 	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
@@ -115,8 +166,38 @@ func (s *Server) setupRoutes() *http.ServeMux {
 		}
 	})
 
+	// Readiness probe, failing while the database is down or migrations are
+	// pending
+	mux.Handle("/readyz", readinessHandler(s.c.GetDB(), s.c.GetMigrationService(), s.log))
+
+	// Prometheus metrics, including the database pool and partition health
+	if s.cfg.IsMetricsEnabled() {
+		mux.Handle("/metrics", s.metricsHandler())
+	}
+
+	// One-time links of the secrets the credential policies keep out of the
+	// create responses
+	mux.Handle(secretdelivery.RetrievalPath, s.secretRetrievalHandler())
+
+	// Download links of the completed query exports
+	mux.Handle(query_export_domain.DownloadPath, s.queryExportDownloadHandler())
+
+	// Stripe webhooks, authenticated by their signature
+	if s.cfg.IsBillingEnabled() {
+		mux.Handle(billing_domain.WebhookPath, s.c.GetBillingWebhook())
+	}
+
 	// Static file serving for SPA frontend
 	s.registerStaticRoutes(mux)
 
 	return mux
 }
+
+// withCORS applies the configured CORS policy when CORS is enabled.
+// Only routes meant for cross-origin callers (API, BFF) should be wrapped.
+func (s *Server) withCORS(handler http.Handler) http.Handler {
+	if !s.cfg.IsCORSEnabled() {
+		return handler
+	}
+	return CORSMiddleware(handler, NewCORSOptions(s.cfg))
+}
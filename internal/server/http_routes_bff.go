@@ -10,6 +10,11 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 )
 
+// legacyRefreshCookiePath is where the refresh token cookie used to be
+// scoped. It is now sent to the session heartbeat as well, so the cookie is
+// scoped to the root and the old one is expired when tokens are set or cleared.
+const legacyRefreshCookiePath = "/oauth"
+
 // AuthExchangeRequest is the request body for token exchange
 type AuthExchangeRequest struct {
 	Code         string `json:"code"`
@@ -44,6 +49,12 @@ type AuthExchangeSuccessResponse struct {
 	ExpiresIn int          `json:"expires_in"`
 }
 
+// SessionHeartbeatResponse is the response of the session heartbeat
+type SessionHeartbeatResponse struct {
+	Active    bool `json:"active"`
+	ExpiresIn int  `json:"expires_in"`
+}
+
 // AuthErrorResponse is the error response for auth endpoints
 type AuthErrorResponse struct {
 	Error            string `json:"error"`
@@ -61,10 +72,11 @@ func (e *AuthServerError) Error() string {
 
 // registerBFFRoutes registers OAuth BFF endpoints
 func (s *Server) registerBFFRoutes(mux *http.ServeMux) {
-	mux.HandleFunc("/oauth/exchange", s.handleAuthExchange)
-	mux.HandleFunc("/oauth/logout", s.handleAuthLogout)
-	mux.HandleFunc("/oauth/refresh", s.handleAuthRefresh)
-	mux.HandleFunc("/oauth/me", s.handleAuthMe)
+	mux.Handle("/oauth/exchange", s.withCORS(http.HandlerFunc(s.handleAuthExchange)))
+	mux.Handle("/oauth/logout", s.withCORS(http.HandlerFunc(s.handleAuthLogout)))
+	mux.Handle("/oauth/refresh", s.withCORS(http.HandlerFunc(s.handleAuthRefresh)))
+	mux.Handle("/oauth/me", s.withCORS(http.HandlerFunc(s.handleAuthMe)))
+	mux.Handle("/api/session/heartbeat", s.withCORS(http.HandlerFunc(s.handleSessionHeartbeat)))
 }
 
 // handleAuthExchange proxies OAuth token exchange requests to the auth server.
@@ -143,7 +155,7 @@ func (s *Server) handleAuthRefresh(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	refreshCookie, err := r.Cookie("refresh_token")
+	refreshCookie, err := r.Cookie(s.cookies.Name("refresh_token"))
 	if err != nil {
 		s.writeAuthError(w, http.StatusUnauthorized, "unauthorized", "No refresh token")
 		return
@@ -179,7 +191,7 @@ func (s *Server) handleAuthMe(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	accessCookie, err := r.Cookie("access_token")
+	accessCookie, err := r.Cookie(s.cookies.Name("access_token"))
 	if err != nil {
 		s.writeAuthError(w, http.StatusUnauthorized, "unauthorized", "Not authenticated")
 		return
@@ -203,6 +215,57 @@ func (s *Server) handleAuthMe(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// handleSessionHeartbeat tells the dashboard whether its session is still
+// alive. The refresh token is introspected at the auth server: once it is
+// revoked, by a logout on the auth server or through the revocation endpoint,
+// the cookies are cleared and the dashboard drops its tokens instead of
+// keeping them until they expire. Failing to reach the auth server is not
+// treated as the end of the session.
+//
+// GET /api/session/heartbeat
+// Response: { "active": true, "expires_in": 1800 }
+func (s *Server) handleSessionHeartbeat(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		s.writeAuthError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET method is allowed")
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+
+	accessCookie, accessErr := r.Cookie(s.cookies.Name("access_token"))
+	refreshCookie, refreshErr := r.Cookie(s.cookies.Name("refresh_token"))
+	if accessErr != nil && refreshErr != nil {
+		s.writeAuthError(w, http.StatusUnauthorized, "unauthorized", "Not authenticated")
+		return
+	}
+
+	// Without a refresh token the session ends with the access token
+	if refreshErr == nil {
+		active, err := s.introspectToken(refreshCookie.Value)
+		if err != nil {
+			s.log.Error("Session heartbeat introspection failed", "error", err)
+			s.writeAuthError(w, http.StatusServiceUnavailable, "temporarily_unavailable", "Could not check the session")
+			return
+		}
+		if !active {
+			s.clearAuthCookies(w)
+			s.writeAuthError(w, http.StatusUnauthorized, "session_ended", "The session has ended")
+			return
+		}
+	}
+
+	expiresIn := 0
+	if accessErr == nil {
+		expiresIn = getTokenExpirySeconds(accessCookie.Value)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(SessionHeartbeatResponse{
+		Active:    true,
+		ExpiresIn: expiresIn,
+	})
+}
+
 // exchangeCodeForTokens exchanges an authorization code for tokens with the auth server
 func (s *Server) exchangeCodeForTokens(code, codeVerifier, redirectURI string) (*TokenResponse, error) {
 	authServerURL := s.cfg.GetDashboardOAuthServerURL()
@@ -283,6 +346,47 @@ func (s *Server) refreshTokens(refreshToken string) (*TokenResponse, error) {
 	return &tokenResp, nil
 }
 
+// introspectToken asks the auth server whether a token is active
+func (s *Server) introspectToken(token string) (bool, error) {
+	authServerURL := s.cfg.GetDashboardOAuthServerURL()
+	clientID := s.cfg.GetDefaultOAuthClientID()
+	clientSecret := s.cfg.GetDefaultOAuthClientSecret()
+
+	data := url.Values{
+		"token":           {token},
+		"token_type_hint": {"refresh_token"},
+	}
+
+	introspectURL := authServerURL + "/oauth/introspect"
+	req, err := http.NewRequest("POST", introspectURL, strings.NewReader(data.Encode()))
+	if err != nil {
+		return false, err
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(clientID, clientSecret)
+
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return false, &AuthServerError{StatusCode: resp.StatusCode}
+	}
+
+	var introspection struct {
+		Active bool `json:"active"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&introspection); err != nil {
+		return false, err
+	}
+
+	return introspection.Active, nil
+}
+
 // fetchUserInfo calls the OAuth server's /userinfo endpoint to get user profile
 func (s *Server) fetchUserInfo(accessToken string) (*AuthUserInfo, error) {
 	authServerURL := s.cfg.GetDashboardOAuthServerURL()
@@ -316,42 +420,28 @@ func (s *Server) fetchUserInfo(accessToken string) (*AuthUserInfo, error) {
 
 // setAuthCookies sets httpOnly cookies for access and refresh tokens
 func (s *Server) setAuthCookies(w http.ResponseWriter, tokenResp *TokenResponse) {
-	http.SetCookie(w, &http.Cookie{
-		Name:     "access_token",
-		Value:    tokenResp.AccessToken,
-		Path:     "/",
-		HttpOnly: true,
-		Secure:   false, // TODO: true in production with HTTPS
-		SameSite: http.SameSiteLaxMode,
-		MaxAge:   tokenResp.ExpiresIn,
-	})
+	http.SetCookie(w, s.cookies.New("access_token", tokenResp.AccessToken, tokenResp.ExpiresIn))
 
 	if tokenResp.RefreshToken != "" {
+		http.SetCookie(w, s.cookies.New("refresh_token", tokenResp.RefreshToken, 86400*7)) // 7 days
 		http.SetCookie(w, &http.Cookie{
 			Name:     "refresh_token",
-			Value:    tokenResp.RefreshToken,
-			Path:     "/oauth",
+			Value:    "",
+			Path:     legacyRefreshCookiePath,
 			HttpOnly: true,
-			Secure:   false, // TODO: true in production with HTTPS
-			SameSite: http.SameSiteLaxMode,
-			MaxAge:   86400 * 7, // 7 days
+			MaxAge:   -1,
 		})
 	}
 }
 
 // clearAuthCookies clears auth cookies by setting MaxAge to -1
 func (s *Server) clearAuthCookies(w http.ResponseWriter) {
-	http.SetCookie(w, &http.Cookie{
-		Name:     "access_token",
-		Value:    "",
-		Path:     "/",
-		HttpOnly: true,
-		MaxAge:   -1,
-	})
+	http.SetCookie(w, s.cookies.Expire("access_token"))
+	http.SetCookie(w, s.cookies.Expire("refresh_token"))
 	http.SetCookie(w, &http.Cookie{
 		Name:     "refresh_token",
 		Value:    "",
-		Path:     "/oauth",
+		Path:     legacyRefreshCookiePath,
 		HttpOnly: true,
 		MaxAge:   -1,
 	})
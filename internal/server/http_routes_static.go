@@ -3,6 +3,8 @@ package server
 import (
 	"io/fs"
 	"net/http"
+	"net/http/httputil"
+	"os"
 	"path"
 	"strings"
 
@@ -11,11 +13,29 @@ import (
 
 // registerStaticRoutes registers static file serving routes for the SPA frontend
 func (s *Server) registerStaticRoutes(mux *http.ServeMux) {
-	websiteFS, _ := fs.Sub(altalune.FrontendEmbeddedFiles, "frontend/.output/public")
+	if !s.cfg.IsFrontendEnabled() {
+		s.log.Info("frontend serving disabled, running API-only")
+		return
+	}
+
+	if s.frontendProxy != nil {
+		s.log.Info("proxying frontend", "target", s.frontendProxy.String())
+		proxy := httputil.NewSingleHostReverseProxy(s.frontendProxy)
+		mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+			if isBackendPath(r.URL.Path) {
+				http.NotFound(w, r)
+				return
+			}
+			proxy.ServeHTTP(w, r)
+		})
+		return
+	}
+
+	websiteFS := s.frontendFS()
 
 	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
 		// Exclude API and OAuth endpoints from SPA serving
-		if strings.HasPrefix(r.URL.Path, "/api/") || strings.HasPrefix(r.URL.Path, "/oauth/") {
+		if isBackendPath(r.URL.Path) {
 			http.NotFound(w, r)
 			return
 		}
@@ -39,3 +59,21 @@ func (s *Server) registerStaticRoutes(mux *http.ServeMux) {
 		serve404Page(w, r, websiteFS)
 	})
 }
+
+// isBackendPath reports whether p belongs to the API or OAuth endpoints rather
+// than the SPA.
+func isBackendPath(p string) bool {
+	return strings.HasPrefix(p, "/api/") || strings.HasPrefix(p, "/oauth/")
+}
+
+// frontendFS returns the configured frontend directory, read from disk on every
+// request so rebuilds show up without a restart, or the embedded build otherwise.
+func (s *Server) frontendFS() fs.FS {
+	if dir := s.cfg.GetFrontendDir(); dir != "" {
+		s.log.Info("serving frontend from directory", "dir", dir)
+		return os.DirFS(dir)
+	}
+
+	websiteFS, _ := fs.Sub(altalune.FrontendEmbeddedFiles, "frontend/.output/public")
+	return websiteFS
+}
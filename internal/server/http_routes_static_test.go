@@ -0,0 +1,111 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hrz8/altalune"
+	"github.com/hrz8/altalune/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// frontendConfig holds the frontend configuration, the other getters are
+// never called
+type frontendConfig struct {
+	altalune.Config
+	enabled bool
+	dir     string
+}
+
+func (c *frontendConfig) IsFrontendEnabled() bool { return c.enabled }
+func (c *frontendConfig) GetFrontendDir() string  { return c.dir }
+
+func TestRegisterStaticRoutes(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "index.html"), []byte("home"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "404.html"), []byte("not found"), 0o600))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "docs"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "docs", "index.html"), []byte("docs"), 0o600))
+
+	mux := http.NewServeMux()
+	s := &Server{cfg: &frontendConfig{enabled: true, dir: dir}, log: logger.New("error")}
+	s.registerStaticRoutes(mux)
+
+	get := func(path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec
+	}
+
+	tests := []struct {
+		path   string
+		status int
+		body   string
+	}{
+		{path: "/", status: http.StatusOK, body: "home"},
+		{path: "/docs", status: http.StatusOK, body: "docs"},
+		{path: "/missing", status: http.StatusNotFound, body: "not found"},
+		{path: "/api/unknown", status: http.StatusNotFound, body: "404 page not found\n"},
+		{path: "/oauth/unknown", status: http.StatusNotFound, body: "404 page not found\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			rec := get(tt.path)
+			assert.Equal(t, tt.status, rec.Code)
+			assert.Equal(t, tt.body, rec.Body.String())
+		})
+	}
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "new.txt"), []byte("rebuilt"), 0o600))
+	assert.Equal(t, "rebuilt", get("/new.txt").Body.String(), "the directory is read on every request")
+}
+
+func TestRegisterStaticRoutesDisabled(t *testing.T) {
+	mux := http.NewServeMux()
+	s := &Server{cfg: &frontendConfig{enabled: false, dir: t.TempDir()}, log: logger.New("error")}
+	s.registerStaticRoutes(mux)
+
+	_, pattern := mux.Handler(httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Empty(t, pattern, "nothing is served when the frontend is disabled")
+}
+
+func TestRegisterStaticRoutesProxy(t *testing.T) {
+	devServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("nuxt " + r.URL.Path))
+	}))
+	defer devServer.Close()
+	target, err := url.Parse(devServer.URL)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "index.html"), []byte("build"), 0o600))
+
+	mux := http.NewServeMux()
+	s := &Server{cfg: &frontendConfig{enabled: true, dir: dir}, log: logger.New("error")}
+	WithFrontendProxy(target)(s)
+	s.registerStaticRoutes(mux)
+
+	tests := []struct {
+		path   string
+		status int
+		body   string
+	}{
+		{path: "/", status: http.StatusOK, body: "nuxt /"},
+		{path: "/_nuxt/entry.js", status: http.StatusOK, body: "nuxt /_nuxt/entry.js"},
+		{path: "/api/unknown", status: http.StatusNotFound, body: "404 page not found\n"},
+		{path: "/oauth/unknown", status: http.StatusNotFound, body: "404 page not found\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.path, nil))
+			assert.Equal(t, tt.status, rec.Code)
+			assert.Equal(t, tt.body, rec.Body.String(), "the proxy replaces the configured build")
+		})
+	}
+}
@@ -0,0 +1,299 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"runtime/debug"
+	"strconv"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/hrz8/altalune"
+	"github.com/hrz8/altalune/internal/redis"
+	"github.com/hrz8/altalune/internal/shared/errorreport"
+	"github.com/hrz8/altalune/internal/shared/realip"
+	"github.com/hrz8/altalune/logger"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// timeoutInterceptor implements connect.Interceptor to bound unary handler execution.
+type timeoutInterceptor struct {
+	timeout time.Duration
+}
+
+// newTimeoutInterceptor creates a Connect-RPC interceptor that cancels the
+// handler context after the given timeout. A shorter client deadline
+// (Connect-Timeout-Ms / grpc-timeout) still takes precedence.
+func newTimeoutInterceptor(timeout time.Duration) connect.Interceptor {
+	return &timeoutInterceptor{timeout: timeout}
+}
+
+// WrapUnary implements connect.Interceptor for unary RPC calls.
+func (i *timeoutInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		ctx, cancel := context.WithTimeout(ctx, i.timeout)
+		defer cancel()
+
+		return next(ctx, req)
+	}
+}
+
+// WrapStreamingClient implements connect.Interceptor for client streaming.
+// This is a pass-through for server-side interceptors.
+func (i *timeoutInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler implements connect.Interceptor for server streaming.
+// Streams are long-lived by design, so they are bounded by client deadlines only.
+func (i *timeoutInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+// recoveryInterceptor implements connect.Interceptor to turn the panics of
+// handlers into Internal errors, which Connect clients can read, instead of
+// the plain 500 of RecoveryMiddleware.
+type recoveryInterceptor struct {
+	log      altalune.Logger
+	reporter errorreport.Reporter
+}
+
+// newRecoveryInterceptor creates a Connect-RPC interceptor recovering the
+// panics of handlers, logged with their stack and reported to reporter.
+func newRecoveryInterceptor(log altalune.Logger, reporter errorreport.Reporter) connect.Interceptor {
+	return &recoveryInterceptor{log: log, reporter: reporter}
+}
+
+// recover turns a recovered panic into the error of the call
+func (i *recoveryInterceptor) recover(ctx context.Context, procedure string, err *error) {
+	if r := recover(); r != nil {
+		i.log.ErrorContext(ctx, "panic recovered",
+			"error", errorreport.Panic(ctx, i.reporter, r, map[string]string{"procedure": procedure}),
+			"procedure", procedure,
+			"stack", string(debug.Stack()),
+		)
+		*err = connect.NewError(connect.CodeInternal, errors.New("an unexpected error occurred"))
+	}
+}
+
+// WrapUnary implements connect.Interceptor for unary RPC calls.
+func (i *recoveryInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (res connect.AnyResponse, err error) {
+		defer i.recover(ctx, req.Spec().Procedure, &err)
+		return next(ctx, req)
+	}
+}
+
+// WrapStreamingClient implements connect.Interceptor for client streaming.
+// This is a pass-through for server-side interceptors.
+func (i *recoveryInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler implements connect.Interceptor for server streaming.
+func (i *recoveryInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) (err error) {
+		defer i.recover(ctx, conn.Spec().Procedure, &err)
+		return next(ctx, conn)
+	}
+}
+
+// loggingInterceptor implements connect.Interceptor to log the outcome of
+// RPCs with their procedure and code, which the HTTP logs do not tell apart.
+type loggingInterceptor struct {
+	log     altalune.Logger
+	sampler *logger.Sampler
+}
+
+// newLoggingInterceptor creates a Connect-RPC interceptor logging every RPC.
+// The procedures thinned out by sampler are only logged when sampled or
+// failing.
+func newLoggingInterceptor(log altalune.Logger, sampler *logger.Sampler) connect.Interceptor {
+	return &loggingInterceptor{log: log, sampler: sampler}
+}
+
+func (i *loggingInterceptor) logCall(ctx context.Context, procedure string, start time.Time, err error) {
+	code := connect.CodeOf(err)
+	attrs := []any{
+		"procedure", procedure,
+		"duration_ms", time.Since(start).Milliseconds(),
+	}
+
+	switch {
+	case err == nil:
+		if i.sampler.Sample(procedure) {
+			i.log.InfoContext(ctx, "rpc completed", append(attrs, "code", "ok")...)
+		}
+	case code == connect.CodeInternal || code == connect.CodeUnknown || code == connect.CodeDataLoss:
+		i.log.ErrorContext(ctx, "rpc failed", append(attrs, "code", code.String(), "error", err)...)
+	default:
+		i.log.WarnContext(ctx, "rpc failed", append(attrs, "code", code.String(), "error", err)...)
+	}
+}
+
+// WrapUnary implements connect.Interceptor for unary RPC calls.
+func (i *loggingInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		start := time.Now()
+		res, err := next(ctx, req)
+		i.logCall(ctx, req.Spec().Procedure, start, err)
+		return res, err
+	}
+}
+
+// WrapStreamingClient implements connect.Interceptor for client streaming.
+// This is a pass-through for server-side interceptors.
+func (i *loggingInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler implements connect.Interceptor for server streaming.
+func (i *loggingInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		start := time.Now()
+		err := next(ctx, conn)
+		i.logCall(ctx, conn.Spec().Procedure, start, err)
+		return err
+	}
+}
+
+// metricsInterceptor implements connect.Interceptor to count RPCs by
+// procedure and code and measure their duration.
+type metricsInterceptor struct {
+	calls    *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+}
+
+// newMetricsInterceptor creates a Connect-RPC interceptor exporting the RPC
+// metrics to registry.
+func newMetricsInterceptor(registry prometheus.Registerer) connect.Interceptor {
+	calls := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "altalune_rpc_requests_total",
+		Help: "RPCs handled, by procedure and code.",
+	}, []string{"procedure", "code"})
+	duration := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "altalune_rpc_duration_seconds",
+		Help:    "Duration of the RPCs, by procedure.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"procedure"})
+
+	return &metricsInterceptor{
+		calls:    registerOrExisting(registry, calls),
+		duration: registerOrExisting(registry, duration),
+	}
+}
+
+// registerOrExisting registers collector, or returns the one already
+// registered under its name, e.g. by a previous server of the process
+func registerOrExisting[C prometheus.Collector](registry prometheus.Registerer, collector C) C {
+	if err := registry.Register(collector); err != nil {
+		var registered prometheus.AlreadyRegisteredError
+		if errors.As(err, &registered) {
+			if existing, ok := registered.ExistingCollector.(C); ok {
+				return existing
+			}
+		}
+		panic(err)
+	}
+	return collector
+}
+
+func (i *metricsInterceptor) observe(procedure string, start time.Time, err error) {
+	code := "ok"
+	if err != nil {
+		code = connect.CodeOf(err).String()
+	}
+	i.calls.WithLabelValues(procedure, code).Inc()
+	i.duration.WithLabelValues(procedure).Observe(time.Since(start).Seconds())
+}
+
+// WrapUnary implements connect.Interceptor for unary RPC calls.
+func (i *metricsInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		start := time.Now()
+		res, err := next(ctx, req)
+		i.observe(req.Spec().Procedure, start, err)
+		return res, err
+	}
+}
+
+// WrapStreamingClient implements connect.Interceptor for client streaming.
+// This is a pass-through for server-side interceptors.
+func (i *metricsInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler implements connect.Interceptor for server streaming.
+func (i *metricsInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		start := time.Now()
+		err := next(ctx, conn)
+		i.observe(conn.Spec().Procedure, start, err)
+		return err
+	}
+}
+
+// rateLimitWindow is the window of the RPC rate limit
+const rateLimitWindow = time.Minute
+
+// rateLimitInterceptor implements connect.Interceptor to reject with
+// ResourceExhausted the RPCs of a client IP past limit calls a minute. The
+// counts live in the shared store, so the limit holds across replicas when
+// Redis is enabled.
+type rateLimitInterceptor struct {
+	store redis.Store
+	limit int64
+	log   altalune.Logger
+}
+
+// newRateLimitInterceptor creates a Connect-RPC interceptor allowing limit
+// calls a minute per client IP.
+func newRateLimitInterceptor(store redis.Store, limit int, log altalune.Logger) connect.Interceptor {
+	return &rateLimitInterceptor{store: store, limit: int64(limit), log: log}
+}
+
+// allow counts a call of the client of ctx. It fails open: an unreachable
+// store does not take the API down.
+func (i *rateLimitInterceptor) allow(ctx context.Context) error {
+	ip := realip.IP(ctx)
+	if ip == "" {
+		return nil
+	}
+	count, err := i.store.Incr(ctx, "rpc_rate:"+ip, rateLimitWindow)
+	if err != nil {
+		i.log.WarnContext(ctx, "failed to check rate limit", "error", err)
+		return nil
+	}
+	if count > i.limit {
+		err := connect.NewError(connect.CodeResourceExhausted, errors.New("too many requests, retry later"))
+		err.Meta().Set("Retry-After", strconv.Itoa(int(rateLimitWindow.Seconds())))
+		return err
+	}
+	return nil
+}
+
+// WrapUnary implements connect.Interceptor for unary RPC calls.
+func (i *rateLimitInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if err := i.allow(ctx); err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+// WrapStreamingClient implements connect.Interceptor for client streaming.
+// This is a pass-through for server-side interceptors.
+func (i *rateLimitInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler implements connect.Interceptor for server streaming.
+func (i *rateLimitInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if err := i.allow(ctx); err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	}
+}
@@ -0,0 +1,195 @@
+package server
+
+import (
+	"fmt"
+	"slices"
+
+	"connectrpc.com/connect"
+	"github.com/hrz8/altalune/internal/auth"
+	"github.com/hrz8/altalune/logger"
+)
+
+// Names of the interceptors of the default chain, outermost first
+const (
+	InterceptorLogging      = "logging"
+	InterceptorMetrics      = "metrics"
+	InterceptorRecovery     = "recovery"
+	InterceptorRateLimit    = "rate_limit"
+	InterceptorTimeout      = "timeout"
+	InterceptorMaintenance  = "maintenance"
+	InterceptorCORSProject  = "cors_project"
+	InterceptorAuth         = "auth"
+	InterceptorPermission   = "permission"
+	InterceptorUsage        = "usage"
+	InterceptorProjectScope = "project_scope"
+)
+
+// ChainedInterceptor is a named interceptor of an InterceptorChain. A nil
+// Interceptor keeps the place of a disabled one, e.g. metrics when they are
+// off, so that the chain is laid out alike whatever the configuration.
+type ChainedInterceptor struct {
+	Name        string
+	Interceptor connect.Interceptor
+	// Public also wraps the services open to anonymous callers, such as
+	// ConfigService, not only those behind authentication
+	Public bool
+}
+
+// InterceptorChain lists, outermost first, the interceptors wrapping the
+// Connect handlers. Forks reshape it with WithInterceptors instead of editing
+// setupRoutes. Referring to a name the chain lacks panics, like registering a
+// pattern twice on an http.ServeMux, as the chain is only built on startup.
+type InterceptorChain struct {
+	entries []ChainedInterceptor
+}
+
+// Names returns the names of the interceptors, outermost first, disabled ones
+// included.
+func (c *InterceptorChain) Names() []string {
+	names := make([]string, len(c.entries))
+	for i, entry := range c.entries {
+		names[i] = entry.Name
+	}
+	return names
+}
+
+// Get returns the interceptor named name, nil when it is disabled.
+func (c *InterceptorChain) Get(name string) connect.Interceptor {
+	return c.entries[c.index(name)].Interceptor
+}
+
+// Append adds entries innermost, right before the handlers.
+func (c *InterceptorChain) Append(entries ...ChainedInterceptor) {
+	c.insert(len(c.entries), entries)
+}
+
+// InsertBefore adds entries outside of the interceptor named name, so that
+// they run before it.
+func (c *InterceptorChain) InsertBefore(name string, entries ...ChainedInterceptor) {
+	c.insert(c.index(name), entries)
+}
+
+// InsertAfter adds entries inside of the interceptor named name, so that they
+// run after it.
+func (c *InterceptorChain) InsertAfter(name string, entries ...ChainedInterceptor) {
+	c.insert(c.index(name)+1, entries)
+}
+
+// Replace swaps the interceptor named name, keeping its place and scope. A
+// nil interceptor disables it.
+func (c *InterceptorChain) Replace(name string, interceptor connect.Interceptor) {
+	c.entries[c.index(name)].Interceptor = interceptor
+}
+
+// Remove takes the interceptor named name out of the chain.
+func (c *InterceptorChain) Remove(name string) {
+	i := c.index(name)
+	c.entries = slices.Delete(c.entries, i, i+1)
+}
+
+func (c *InterceptorChain) index(name string) int {
+	i := slices.IndexFunc(c.entries, func(entry ChainedInterceptor) bool { return entry.Name == name })
+	if i < 0 {
+		panic(fmt.Sprintf("server: no interceptor named %q in the chain %v", name, c.Names()))
+	}
+	return i
+}
+
+func (c *InterceptorChain) insert(i int, entries []ChainedInterceptor) {
+	for _, entry := range entries {
+		if slices.Contains(c.Names(), entry.Name) {
+			panic(fmt.Sprintf("server: interceptor %q is already in the chain", entry.Name))
+		}
+	}
+	c.entries = slices.Insert(c.entries, i, entries...)
+}
+
+// interceptors returns the enabled interceptors, outermost first, those of the
+// public services only when public
+func (c *InterceptorChain) interceptors(public bool) []connect.Interceptor {
+	var interceptors []connect.Interceptor
+	for _, entry := range c.entries {
+		if entry.Interceptor != nil && (entry.Public || !public) {
+			interceptors = append(interceptors, entry.Interceptor)
+		}
+	}
+	return interceptors
+}
+
+// handlerOption returns the option wrapping handlers in the interceptors
+func (c *InterceptorChain) handlerOption(public bool) connect.HandlerOption {
+	return connect.WithInterceptors(c.interceptors(public)...)
+}
+
+// WithInterceptors lets configure reshape the interceptor chain of the
+// Connect handlers once the default one is built, e.g. to insert one after
+// InterceptorAuth, which sees the AuthContext of the caller.
+func WithInterceptors(configure func(*InterceptorChain)) Option {
+	return func(s *Server) {
+		s.configureInterceptors = append(s.configureInterceptors, configure)
+	}
+}
+
+// interceptorChain builds the default chain, from the configuration, and
+// applies the WithInterceptors options to it.
+func (s *Server) interceptorChain() *InterceptorChain {
+	chain := &InterceptorChain{}
+	public := func(name string, interceptor connect.Interceptor) ChainedInterceptor {
+		return ChainedInterceptor{Name: name, Interceptor: interceptor, Public: true}
+	}
+	authenticated := func(name string, interceptor connect.Interceptor) ChainedInterceptor {
+		return ChainedInterceptor{Name: name, Interceptor: interceptor}
+	}
+
+	// Logging and metrics see the outcome of every RPC, panics included
+	logging := public(InterceptorLogging, nil)
+	if s.cfg.IsHTTPLoggingEnabled() {
+		logging.Interceptor = newLoggingInterceptor(s.log.Module("rpc"), logger.NewSampler(s.cfg.GetLogSampling()))
+	}
+	metrics := public(InterceptorMetrics, nil)
+	if s.cfg.IsMetricsEnabled() {
+		metrics.Interceptor = newMetricsInterceptor(s.c.GetMetricsRegistry())
+	}
+	rateLimit := public(InterceptorRateLimit, nil)
+	if limit := s.cfg.GetServerRateLimit(); limit > 0 {
+		rateLimit.Interceptor = newRateLimitInterceptor(s.c.GetStore(), limit, s.log)
+	}
+	chain.Append(
+		logging,
+		metrics,
+		public(InterceptorRecovery, newRecoveryInterceptor(s.log, s.c.GetErrorReporter())),
+		rateLimit,
+		public(InterceptorTimeout, newTimeoutInterceptor(s.cfg.GetServerHandlerTimeout())),
+		public(InterceptorMaintenance, newMaintenanceInterceptor(s.c.GetMaintenanceSwitch())),
+		// Hold the callers of an origin allowed for one project to it
+		public(InterceptorCORSProject, newCORSProjectInterceptor()),
+	)
+
+	// JWT validation when a validator is configured, followed by the check of
+	// the permissions declared on the RPCs
+	authInterceptor, permissionInterceptor := authenticated(InterceptorAuth, nil), authenticated(InterceptorPermission, nil)
+	if validator := s.c.GetJWTValidator(); validator != nil {
+		authInterceptor.Interceptor = auth.NewAuthInterceptor(validator, s.c.GetPermissionResolver())
+		permissionInterceptor.Interceptor = auth.NewPermissionInterceptor(s.c.GetAuthorizer())
+	}
+
+	// Meter project requests and refuse them over the monthly quota of the
+	// project, after authentication so anonymous requests are not counted
+	usage := authenticated(InterceptorUsage, nil)
+	if s.cfg.IsUsageMeteringEnabled() {
+		usage.Interceptor = newUsageInterceptor(s.c.GetUsageMeter(), s.c.GetProjectRepo(), s.c.GetAuthorizer())
+	}
+
+	// Scope project requests to their project with the row-level security
+	// policies, after authorization so rejected requests open no transaction
+	projectScope := authenticated(InterceptorProjectScope, nil)
+	if s.cfg.IsDatabaseRowLevelSecurityEnabled() {
+		projectScope.Interceptor = newProjectScopeInterceptor(s.c.GetScopedDB(), s.c.GetProjectRepo(), s.c.GetFeatureFlags())
+	}
+	chain.Append(authInterceptor, permissionInterceptor, usage, projectScope)
+
+	for _, configure := range s.configureInterceptors {
+		configure(chain)
+	}
+	return chain
+}
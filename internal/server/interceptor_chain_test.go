@@ -0,0 +1,73 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/hrz8/altalune/internal/shared/errorreport"
+	"github.com/hrz8/altalune/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingInterceptor appends its name to calls when it runs
+type recordingInterceptor struct {
+	connect.Interceptor
+	name  string
+	calls *[]string
+}
+
+func (i *recordingInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		*i.calls = append(*i.calls, i.name)
+		return next(ctx, req)
+	}
+}
+
+func TestInterceptorChain(t *testing.T) {
+	var calls []string
+	entry := func(name string, public bool) ChainedInterceptor {
+		return ChainedInterceptor{Name: name, Interceptor: &recordingInterceptor{name: name, calls: &calls}, Public: public}
+	}
+
+	chain := &InterceptorChain{}
+	chain.Append(entry(InterceptorRecovery, true), ChainedInterceptor{Name: InterceptorMetrics, Public: true}, entry(InterceptorAuth, false))
+	chain.InsertBefore(InterceptorRecovery, entry("tracing", true))
+	chain.InsertAfter(InterceptorAuth, entry("audit", false))
+	assert.Equal(t, []string{"tracing", InterceptorRecovery, InterceptorMetrics, InterceptorAuth, "audit"}, chain.Names())
+	assert.Nil(t, chain.Get(InterceptorMetrics))
+
+	assert.PanicsWithValue(t, `server: no interceptor named "missing" in the chain [tracing recovery metrics auth audit]`, func() {
+		chain.InsertAfter("missing", entry("other", true))
+	})
+	assert.Panics(t, func() { chain.Append(entry("audit", false)) }, "names are unique")
+
+	chain.Remove(InterceptorRecovery)
+	chain.Replace("tracing", nil)
+
+	call := func(public bool) []string {
+		calls = nil
+		next := connect.UnaryFunc(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			return nil, nil
+		})
+		interceptors := chain.interceptors(public)
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			next = interceptors[i].WrapUnary(next)
+		}
+		_, err := next(context.Background(), connect.NewRequest(&struct{}{}))
+		require.NoError(t, err)
+		return calls
+	}
+	assert.Equal(t, []string{InterceptorAuth, "audit"}, call(false))
+	assert.Empty(t, call(true))
+}
+
+func TestRecoveryInterceptor(t *testing.T) {
+	next := newRecoveryInterceptor(logger.New("error"), errorreport.Nop{}).WrapUnary(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		panic("boom")
+	})
+
+	_, err := next(context.Background(), connect.NewRequest(&struct{}{}))
+	assert.Equal(t, connect.CodeInternal, connect.CodeOf(err))
+}
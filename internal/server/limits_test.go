@@ -0,0 +1,89 @@
+package server
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaxBytesMiddleware(t *testing.T) {
+	const maxBytes = 16
+
+	tests := []struct {
+		name   string
+		body   string
+		length int64 // Content-Length sent, -1 for a body of unknown length
+		status int
+	}{
+		{name: "within the limit", body: strings.Repeat("a", maxBytes), length: maxBytes, status: http.StatusOK},
+		{name: "declared oversize", body: strings.Repeat("a", maxBytes+1), length: maxBytes + 1, status: http.StatusRequestEntityTooLarge},
+		{name: "undeclared within the limit", body: strings.Repeat("a", maxBytes), length: -1, status: http.StatusOK},
+		{name: "undeclared oversize", body: strings.Repeat("a", maxBytes+1), length: -1, status: http.StatusRequestEntityTooLarge},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reached := false
+			handler := MaxBytesMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				reached = true
+				if _, err := io.ReadAll(r.Body); err != nil {
+					http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+				}
+			}), maxBytes)
+
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tt.body))
+			req.ContentLength = tt.length
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.status, rec.Code)
+			assert.Equal(t, tt.length <= maxBytes, reached, "declared oversize bodies are refused before the handler")
+		})
+	}
+}
+
+func TestTimeoutInterceptor(t *testing.T) {
+	const timeout = time.Minute
+
+	tests := []struct {
+		name         string
+		callDeadline time.Duration // deadline of the caller, 0 for none
+		want         time.Duration
+	}{
+		{name: "no caller deadline", want: timeout},
+		{name: "longer caller deadline", callDeadline: time.Hour, want: timeout},
+		{name: "shorter caller deadline", callDeadline: time.Second, want: time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.callDeadline > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.callDeadline)
+				defer cancel()
+			}
+
+			var handlerCtx context.Context
+			next := func(ctx context.Context, _ connect.AnyRequest) (connect.AnyResponse, error) {
+				handlerCtx = ctx
+				return nil, nil
+			}
+			start := time.Now()
+			_, err := newTimeoutInterceptor(timeout).WrapUnary(next)(ctx, connect.NewRequest(&altalunev1.QueryRequest{}))
+			require.NoError(t, err)
+
+			deadline, ok := handlerCtx.Deadline()
+			require.True(t, ok)
+			assert.WithinDuration(t, start.Add(tt.want), deadline, time.Second)
+			assert.ErrorIs(t, handlerCtx.Err(), context.Canceled, "the handler context ends with the call")
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"connectrpc.com/connect"
+	maintenance_domain "github.com/hrz8/altalune/internal/domain/maintenance"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// defaultMaintenanceMessage is the error message of rejected RPCs when the
+// maintenance switch has none
+const defaultMaintenanceMessage = "the service is under maintenance"
+
+// readOnlyMethodPrefixes are the verbs starting the names of the RPCs that do
+// not change anything, which keep being served during maintenance
+var readOnlyMethodPrefixes = []string{"Get", "List", "Query", "Export", "Dump", "Reveal", "Stream", "Say"}
+
+// isReadOnlyProcedure reports whether procedure, such as
+// "/altalune.v1.UserService/GetUser", names a read-only RPC
+func isReadOnlyProcedure(procedure string) bool {
+	method := procedure[strings.LastIndex(procedure, "/")+1:]
+	for _, prefix := range readOnlyMethodPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// maintenanceInterceptor implements connect.Interceptor to reject mutating
+// RPCs with Unavailable (HTTP 503) and a Retry-After header while the servers
+// are in maintenance.
+type maintenanceInterceptor struct {
+	maintenance *maintenance_domain.Switch
+}
+
+// newMaintenanceInterceptor creates a Connect-RPC interceptor rejecting the
+// mutating RPCs not carrying a bypass token during maintenance.
+func newMaintenanceInterceptor(maintenance *maintenance_domain.Switch) connect.Interceptor {
+	return &maintenanceInterceptor{maintenance: maintenance}
+}
+
+// WrapUnary implements connect.Interceptor for unary RPC calls.
+func (i *maintenanceInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if isReadOnlyProcedure(req.Spec().Procedure) || i.maintenance.Bypassed(req.Header().Get(maintenance_domain.BypassHeader)) {
+			return next(ctx, req)
+		}
+		active, message := i.maintenance.Active(ctx)
+		if !active {
+			return next(ctx, req)
+		}
+
+		if message == "" {
+			message = defaultMaintenanceMessage
+		}
+		err := connect.NewError(connect.CodeUnavailable, errors.New(message))
+		err.Meta().Set("Retry-After", strconv.Itoa(int(i.maintenance.RetryAfter().Seconds())))
+		return nil, err
+	}
+}
+
+// WrapStreamingClient implements connect.Interceptor for client streaming.
+// This is a pass-through for server-side interceptors.
+func (i *maintenanceInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler implements connect.Interceptor for server streaming.
+// Streams only read, so they keep being served.
+func (i *maintenanceInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+// maintenanceUnaryInterceptor is the gRPC counterpart of
+// maintenanceInterceptor, the bypass token being read from the metadata.
+func maintenanceUnaryInterceptor(maintenance *maintenance_domain.Switch) grpc.UnaryServerInterceptor {
+	bypassKey := strings.ToLower(maintenance_domain.BypassHeader)
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if isReadOnlyProcedure(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if tokens := md.Get(bypassKey); len(tokens) > 0 && maintenance.Bypassed(tokens[0]) {
+				return handler(ctx, req)
+			}
+		}
+		active, message := maintenance.Active(ctx)
+		if !active {
+			return handler(ctx, req)
+		}
+
+		if message == "" {
+			message = defaultMaintenanceMessage
+		}
+		_ = grpc.SetHeader(ctx, metadata.Pairs("retry-after", strconv.Itoa(int(maintenance.RetryAfter().Seconds()))))
+		return nil, status.Error(codes.Unavailable, message)
+	}
+}
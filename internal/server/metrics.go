@@ -0,0 +1,28 @@
+package server
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// metricsHandler serves the Prometheus metrics, to scrapers sending the
+// configured bearer token when there is one.
+func (s *Server) metricsHandler() http.Handler {
+	handler := promhttp.HandlerFor(s.c.GetMetricsRegistry(), promhttp.HandlerOpts{})
+	token := s.cfg.GetMetricsToken()
+	if token == "" {
+		return handler
+	}
+
+	expected := []byte("Bearer " + token)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), expected) != 1 {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="metrics"`)
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
@@ -3,54 +3,133 @@ package server
 import (
 	"net/http"
 	"runtime/debug"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/hrz8/altalune"
+	"github.com/hrz8/altalune/internal/shared/csp"
+	"github.com/hrz8/altalune/internal/shared/realip"
+	"github.com/hrz8/altalune/logger"
 )
 
 func (s *Server) setupMiddleware(handler http.Handler) http.Handler {
 	// apply middleware in reverse order (last applied executes first)
 	handler = RecoveryMiddleware(handler, s.log)
-	if s.cfg.IsHTTPLoggingEnabled() {
-		handler = LoggingMiddleware(handler, s.log)
+	handler = MaxBytesMiddleware(handler, s.cfg.GetServerMaxRequestBytes())
+	if s.cfg.IsCompressionEnabled() {
+		handler = CompressionMiddleware(handler)
 	}
-	handler = SecurityMiddleware(handler)
-	if s.cfg.IsCORSEnabled() {
-		handler = CORSMiddleware(handler, s.cfg.GetAllowedOrigins())
+	if s.cfg.IsHTTPLoggingEnabled() {
+		handler = LoggingMiddleware(handler, s.log.Module("http"), logger.NewSampler(s.cfg.GetLogSampling()))
 	}
+	handler = RequestIDMiddleware(handler)
+	handler = SecurityMiddleware(handler, NewSecurityHeadersOptions(s.cfg, s.cfg.GetDashboardCSP()))
+	handler = realip.Middleware(handler, s.c.GetClientResolver())
 
 	return handler
 }
 
-func CORSMiddleware(next http.Handler, allowedOrigins []string) http.Handler {
+// CORSOptions configures CORSMiddleware.
+type CORSOptions struct {
+	AllowedOrigins   []string                     // origins allowed to call every project, "*" allows any origin
+	ProjectOrigins   map[string][]string          // origins allowed to call only one project, by project public ID
+	Project          func(r *http.Request) string // public ID of the project r calls, empty when unknown
+	AllowCredentials bool                         // send Access-Control-Allow-Credentials, never with "*"
+	MaxAge           time.Duration                // preflight cache duration, zero omits the header
+}
+
+// NewCORSOptions builds CORSOptions from the application configuration. The
+// project of a request is read from its X-Project-ID header.
+func NewCORSOptions(cfg altalune.Config) CORSOptions {
+	return CORSOptions{
+		AllowedOrigins:   cfg.GetCORSAllowedOrigins(),
+		ProjectOrigins:   cfg.GetCORSProjectOrigins(),
+		Project:          func(r *http.Request) string { return r.Header.Get(ProjectIDHeader) },
+		AllowCredentials: cfg.IsCORSAllowCredentials(),
+		MaxAge:           cfg.GetCORSMaxAge(),
+	}
+}
+
+// ProjectIDHeader names the project a cross-origin call is for, which the
+// origins allowed for a single project must send.
+const ProjectIDHeader = "X-Project-ID"
+
+// CORSMiddleware answers the preflight requests and sets the CORS headers of
+// the calls from the allowed origins. The origins allowed for a project are
+// only allowed for the calls naming it with CORSOptions.Project, whose
+// context then carries it for CORSProject; their calls for other projects
+// are refused before reaching next. Preflights naming no project are allowed
+// for them, as browsers do not send the headers of the call with these.
+func CORSMiddleware(next http.Handler, opts CORSOptions) http.Handler {
 	allowAll := false
 	originMap := make(map[string]bool)
-	for _, origin := range allowedOrigins {
+	for _, origin := range opts.AllowedOrigins {
 		if origin == "*" {
 			allowAll = true
 			break
 		}
 		originMap[origin] = true
 	}
+	projectOrigins := make(map[string]map[string]bool)
+	for projectID, origins := range opts.ProjectOrigins {
+		for _, origin := range origins {
+			if projectOrigins[origin] == nil {
+				projectOrigins[origin] = make(map[string]bool)
+			}
+			projectOrigins[origin][projectID] = true
+		}
+	}
+	// Any site could make credentialed calls on behalf of the signed-in users
+	allowCredentials := opts.AllowCredentials && !allowAll
+	maxAge := strconv.Itoa(int(opts.MaxAge.Seconds()))
 
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		origin := r.Header.Get("Origin")
+		w.Header().Add("Vary", "Origin")
+		preflight := r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != ""
 
-		if allowAll || originMap[origin] {
-			if origin != "" {
-				w.Header().Set("Access-Control-Allow-Origin", origin)
-			} else if allowAll {
+		allowed := origin != "" && (allowAll || originMap[origin])
+		if projects := projectOrigins[origin]; !allowed && projects != nil {
+			var project string
+			if opts.Project != nil {
+				project = opts.Project(r)
+			}
+			switch {
+			case projects[project]:
+				allowed = true
+				r = r.WithContext(withCORSProject(r.Context(), project))
+			case preflight && project == "":
+				allowed = true
+			case !preflight:
+				http.Error(w, "origin not allowed for this project", http.StatusForbidden)
+				return
+			}
+		}
+
+		if allowed {
+			if allowAll {
 				w.Header().Set("Access-Control-Allow-Origin", "*")
+			} else {
+				w.Header().Set("Access-Control-Allow-Origin", origin)
+			}
+			if allowCredentials {
+				w.Header().Set("Access-Control-Allow-Credentials", "true")
 			}
-			w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-			w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, Connect-Protocol-Version, Connect-Timeout-Ms")
-			w.Header().Set("Access-Control-Expose-Headers", "Connect-Protocol-Version, Connect-Timeout-Ms")
-			w.Header().Set("Access-Control-Allow-Credentials", "true")
-			w.Header().Set("Vary", "Origin")
+			w.Header().Set("Access-Control-Expose-Headers", "Connect-Protocol-Version, Connect-Timeout-Ms, Grpc-Status, Grpc-Message, Grpc-Status-Details-Bin, X-Request-ID")
 		}
 
-		if r.Method == "OPTIONS" {
-			w.WriteHeader(http.StatusOK)
+		// Preflight request
+		if preflight {
+			if allowed {
+				w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
+				w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, Connect-Protocol-Version, Connect-Timeout-Ms, Connect-Accept-Encoding, Connect-Content-Encoding, Grpc-Timeout, X-Grpc-Web, X-User-Agent, X-Request-ID, X-Project-ID")
+				if opts.MaxAge > 0 {
+					w.Header().Set("Access-Control-Max-Age", maxAge)
+				}
+			}
+			w.WriteHeader(http.StatusNoContent)
 			return
 		}
 
@@ -58,35 +137,116 @@ func CORSMiddleware(next http.Handler, allowedOrigins []string) http.Handler {
 	})
 }
 
-func SecurityMiddleware(next http.Handler) http.Handler {
+// SecurityHeadersOptions configures SecurityMiddleware.
+type SecurityHeadersOptions struct {
+	HSTS           string // Strict-Transport-Security value, empty omits the header
+	FrameOptions   string
+	ReferrerPolicy string
+	CSP            string // Content-Security-Policy, empty omits the header; csp.NoncePlaceholder is replaced per request
+	CSPReportOnly  bool   // send CSP as Content-Security-Policy-Report-Only
+}
+
+// NewSecurityHeadersOptions builds SecurityHeadersOptions from the application
+// configuration. Each server passes its own content security policy.
+func NewSecurityHeadersOptions(cfg altalune.Config, contentSecurityPolicy string) SecurityHeadersOptions {
+	var hsts string
+	if maxAge := cfg.GetHSTSMaxAge(); maxAge > 0 {
+		hsts = "max-age=" + strconv.Itoa(int(maxAge.Seconds()))
+		if cfg.IsHSTSIncludeSubdomains() {
+			hsts += "; includeSubDomains"
+		}
+		if cfg.IsHSTSPreload() {
+			hsts += "; preload"
+		}
+	}
+
+	return SecurityHeadersOptions{
+		HSTS:           hsts,
+		FrameOptions:   cfg.GetFrameOptions(),
+		ReferrerPolicy: cfg.GetReferrerPolicy(),
+		CSP:            contentSecurityPolicy,
+		CSPReportOnly:  cfg.IsCSPReportOnly(),
+	}
+}
+
+// SecurityMiddleware sets protective response headers. When the policy uses a
+// nonce, a fresh one is generated per request and made available to
+// templates through csp.Nonce.
+func SecurityMiddleware(next http.Handler, opts SecurityHeadersOptions) http.Handler {
+	cspHeader := "Content-Security-Policy"
+	if opts.CSPReportOnly {
+		cspHeader = "Content-Security-Policy-Report-Only"
+	}
+	usesNonce := strings.Contains(opts.CSP, csp.NoncePlaceholder)
+
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("X-Content-Type-Options", "nosniff")
-		w.Header().Set("X-Frame-Options", "DENY")
+		w.Header().Set("X-Frame-Options", opts.FrameOptions)
 		w.Header().Set("X-XSS-Protection", "1; mode=block")
-		w.Header().Set("Referrer-Policy", "strict-origin-when-cross-origin")
+		w.Header().Set("Referrer-Policy", opts.ReferrerPolicy)
+		if opts.HSTS != "" {
+			w.Header().Set("Strict-Transport-Security", opts.HSTS)
+		}
+
+		if opts.CSP != "" {
+			policy := opts.CSP
+			if usesNonce {
+				nonce, err := csp.NewNonce()
+				if err != nil {
+					http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+					return
+				}
+				policy = strings.ReplaceAll(policy, csp.NoncePlaceholder, nonce)
+				r = r.WithContext(csp.WithNonce(r.Context(), nonce))
+			}
+			w.Header().Set(cspHeader, policy)
+		}
 
 		next.ServeHTTP(w, r)
 	})
 }
 
-func LoggingMiddleware(next http.Handler, log altalune.Logger) http.Handler {
+// MaxBytesMiddleware rejects request bodies larger than maxBytes.
+// Declared oversized bodies are refused upfront; others fail once the limit is read.
+func MaxBytesMiddleware(next http.Handler, maxBytes int64) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ContentLength > maxBytes {
+			http.Error(w, "Request Entity Too Large", http.StatusRequestEntityTooLarge)
+			return
+		}
+
+		r.Body = http.MaxBytesReader(w, r.Body, maxBytes)
+		next.ServeHTTP(w, r)
+	})
+}
+
+// LoggingMiddleware logs every request and its outcome. Requests to the
+// paths thinned out by sampler are only logged when sampled or failing.
+func LoggingMiddleware(next http.Handler, log altalune.Logger, sampler *logger.Sampler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		start := time.Now()
+		ctx := r.Context()
+		sampled := sampler.Sample(r.URL.Path)
 
 		rw := &responseWriter{ResponseWriter: w, statusCode: http.StatusOK}
 
-		log.Info("incoming request",
-			"method", r.Method,
-			"path", r.URL.Path,
-			"remote_addr", r.RemoteAddr,
-			"user_agent", r.Header.Get("User-Agent"),
-			"content_type", r.Header.Get("Content-Type"),
-		)
+		if sampled {
+			log.InfoContext(ctx, "incoming request",
+				"method", r.Method,
+				"path", r.URL.Path,
+				"client_ip", clientIP(r),
+				"user_agent", r.Header.Get("User-Agent"),
+				"content_type", r.Header.Get("Content-Type"),
+			)
+		}
 
 		next.ServeHTTP(rw, r)
 
+		if !sampled && rw.statusCode < http.StatusInternalServerError {
+			return
+		}
 		duration := time.Since(start)
-		log.Info("request completed",
+		log.InfoContext(ctx, "request completed",
 			"method", r.Method,
 			"path", r.URL.Path,
 			"status_code", rw.statusCode,
@@ -95,15 +255,51 @@ func LoggingMiddleware(next http.Handler, log altalune.Logger) http.Handler {
 	})
 }
 
+// RequestIDMiddleware tags the request with the caller's X-Request-ID, or a
+// new one, echoed in the response and attached to the context logs.
+func RequestIDMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get("X-Request-ID")
+		if !isValidRequestID(id) {
+			id = uuid.NewString()
+		}
+		w.Header().Set("X-Request-ID", id)
+		next.ServeHTTP(w, r.WithContext(logger.WithAttrs(r.Context(), "request_id", id)))
+	})
+}
+
+// isValidRequestID accepts up to 64 letters, digits, dashes and underscores,
+// so client-supplied IDs cannot inject content into the logs.
+func isValidRequestID(id string) bool {
+	if id == "" || len(id) > 64 {
+		return false
+	}
+	for _, c := range id {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_') {
+			return false
+		}
+	}
+	return true
+}
+
+// clientIP returns the client address resolved by realip.Middleware, the peer
+// address when the request did not go through it
+func clientIP(r *http.Request) string {
+	if ip := realip.IP(r.Context()); ip != "" {
+		return ip
+	}
+	return r.RemoteAddr
+}
+
 func RecoveryMiddleware(next http.Handler, log altalune.Logger) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		defer func() {
 			if err := recover(); err != nil {
-				log.Error("panic recovered",
+				log.ErrorContext(r.Context(), "panic recovered",
 					"error", err,
 					"method", r.Method,
 					"path", r.URL.Path,
-					"remote_addr", r.RemoteAddr,
+					"client_ip", clientIP(r),
 					"stack", string(debug.Stack()),
 				)
 
@@ -0,0 +1,134 @@
+package server
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	project_domain "github.com/hrz8/altalune/internal/domain/project"
+	"github.com/hrz8/altalune/internal/featureflag"
+	"github.com/hrz8/altalune/internal/postgres"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// projectScopeInterceptor implements connect.Interceptor to run the requests
+// naming a project within postgres.WithProject, so the row-level security
+// policies hide the rows of other projects from their handlers.
+type projectScopeInterceptor struct {
+	db       *postgres.ScopedDB
+	projects project_domain.Repositor
+	flags    *featureflag.Flags
+}
+
+// newProjectScopeInterceptor creates a Connect-RPC interceptor scoping requests
+// with a project_id field to that project. The scope holds a connection, not a
+// transaction: the statements of the handler commit as they run, whether it
+// succeeds or not. Requests naming an unknown project run unscoped, for their
+// handler to reject, and the requests of projects the
+// featureflag.RowLevelSecurity flag is off for run with every project visible.
+// Requests naming no project see no row of the project tables but through
+// the repositories scoping their own lookups.
+func newProjectScopeInterceptor(db *postgres.ScopedDB, projects project_domain.Repositor, flags *featureflag.Flags) connect.Interceptor {
+	return &projectScopeInterceptor{db: db, projects: projects, flags: flags}
+}
+
+// WrapUnary implements connect.Interceptor for unary RPC calls.
+func (i *projectScopeInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		projectID, ok := i.projectOf(ctx, req.Any())
+		if !ok {
+			return next(ctx, req)
+		}
+
+		var resp connect.AnyResponse
+		err := i.scope(ctx, projectID, func(ctx context.Context) error {
+			var err error
+			resp, err = next(ctx, req)
+			return err
+		})
+		return resp, err
+	}
+}
+
+// WrapStreamingClient implements connect.Interceptor for client streaming.
+// This is a pass-through for server-side interceptors.
+func (i *projectScopeInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler implements connect.Interceptor for server streaming.
+// Streams name their project in their first message, so the scope starts when
+// the handler receives it and lasts until the stream ends.
+func (i *projectScopeInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		return postgres.WithDeferredProject(ctx, i.db, func(ctx context.Context, scope *postgres.DeferredScope) error {
+			return next(ctx, &projectScopeConn{StreamingHandlerConn: conn, ctx: ctx, interceptor: i, scope: scope})
+		})
+	}
+}
+
+// scope runs fn within the scope of projectID, or of every project when the
+// row-level security flag is off for it
+func (i *projectScopeInterceptor) scope(ctx context.Context, projectID int64, fn func(ctx context.Context) error) error {
+	if !i.flags.EnabledForProject(ctx, featureflag.RowLevelSecurity, projectID) {
+		return postgres.WithAllProjects(ctx, i.db, fn)
+	}
+	return postgres.WithProject(ctx, i.db, projectID, fn)
+}
+
+// projectOf returns the ID of the project msg names, false when it names
+// none or an unknown one
+func (i *projectScopeInterceptor) projectOf(ctx context.Context, msg any) (int64, bool) {
+	m, ok := msg.(proto.Message)
+	if !ok {
+		return 0, false
+	}
+	publicID := requestProjectID(m)
+	if publicID == "" {
+		return 0, false
+	}
+	projectID, err := i.projects.GetIDByPublicID(ctx, publicID)
+	if err != nil {
+		return 0, false
+	}
+	return projectID, true
+}
+
+// projectScopeConn starts the scope of a stream when its first message
+// naming a project is received
+type projectScopeConn struct {
+	connect.StreamingHandlerConn
+	ctx         context.Context
+	interceptor *projectScopeInterceptor
+	scope       *postgres.DeferredScope
+	scoped      bool
+}
+
+func (c *projectScopeConn) Receive(msg any) error {
+	if err := c.StreamingHandlerConn.Receive(msg); err != nil {
+		return err
+	}
+	if c.scoped {
+		return nil
+	}
+	projectID, ok := c.interceptor.projectOf(c.ctx, msg)
+	if !ok {
+		return nil
+	}
+	c.scoped = true
+	if !c.interceptor.flags.EnabledForProject(c.ctx, featureflag.RowLevelSecurity, projectID) {
+		return c.scope.AllProjects()
+	}
+	return c.scope.Project(projectID)
+}
+
+// requestProjectID returns the project_id string field of msg, empty when it
+// has none
+func requestProjectID(msg proto.Message) string {
+	m := msg.ProtoReflect()
+	fd := m.Descriptor().Fields().ByName("project_id")
+	if fd == nil || fd.Kind() != protoreflect.StringKind || fd.IsList() {
+		return ""
+	}
+	return m.Get(fd).String()
+}
@@ -0,0 +1,52 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/hrz8/altalune"
+	"github.com/hrz8/altalune/internal/postgres"
+)
+
+// readinessTimeout bounds the checks of a readiness probe
+const readinessTimeout = 3 * time.Second
+
+// schemaChecker reports the applied schema version and the one this build
+// expects, as the migration service does
+type schemaChecker interface {
+	SchemaStatus(ctx context.Context) (current, expected int64, err error)
+}
+
+// readinessHandler tells load balancers and orchestrators whether to route
+// traffic here: the database answers and its schema is at least the version
+// this build expects. /healthz only tells that the process is up.
+func readinessHandler(db postgres.DB, schema schemaChecker, log altalune.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), readinessTimeout)
+		defer cancel()
+
+		status := map[string]any{"status": "ready"}
+		code := http.StatusOK
+
+		if err := db.PingContext(ctx); err != nil {
+			log.Warn("readiness check failed", "check", "database", "error", err)
+			status["status"], code = "database unavailable", http.StatusServiceUnavailable
+		} else if current, expected, err := schema.SchemaStatus(ctx); err != nil {
+			log.Warn("readiness check failed", "check", "schema", "error", err)
+			status["status"], code = "schema unknown", http.StatusServiceUnavailable
+		} else {
+			status["schema_version"] = current
+			status["expected_schema_version"] = expected
+			if current < expected {
+				status["status"], code = "migrations pending", http.StatusServiceUnavailable
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+		w.WriteHeader(code)
+		_ = json.NewEncoder(w).Encode(status)
+	}
+}
@@ -0,0 +1,93 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hrz8/altalune/internal/postgres"
+	"github.com/hrz8/altalune/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pingDB answers pings with err; the other methods are never called
+type pingDB struct {
+	postgres.DB
+	err error
+}
+
+func (d *pingDB) PingContext(context.Context) error { return d.err }
+
+// fixedSchema reports fixed schema versions
+type fixedSchema struct {
+	current, expected int64
+	err               error
+}
+
+func (s *fixedSchema) SchemaStatus(context.Context) (int64, int64, error) {
+	return s.current, s.expected, s.err
+}
+
+func TestReadinessHandler(t *testing.T) {
+	tests := []struct {
+		name     string
+		db       *pingDB
+		schema   *fixedSchema
+		wantCode int
+		want     map[string]any
+	}{
+		{
+			name:     "ready",
+			db:       &pingDB{},
+			schema:   &fixedSchema{current: 5, expected: 5},
+			wantCode: http.StatusOK,
+			want:     map[string]any{"status": "ready", "schema_version": 5.0, "expected_schema_version": 5.0},
+		},
+		{
+			name:     "newer schema of a rolling upgrade",
+			db:       &pingDB{},
+			schema:   &fixedSchema{current: 6, expected: 5},
+			wantCode: http.StatusOK,
+			want:     map[string]any{"status": "ready", "schema_version": 6.0, "expected_schema_version": 5.0},
+		},
+		{
+			name:     "migrations pending",
+			db:       &pingDB{},
+			schema:   &fixedSchema{current: 3, expected: 5},
+			wantCode: http.StatusServiceUnavailable,
+			want:     map[string]any{"status": "migrations pending", "schema_version": 3.0, "expected_schema_version": 5.0},
+		},
+		{
+			name:     "database unavailable",
+			db:       &pingDB{err: errors.New("connection refused")},
+			schema:   &fixedSchema{current: 5, expected: 5},
+			wantCode: http.StatusServiceUnavailable,
+			want:     map[string]any{"status": "database unavailable"},
+		},
+		{
+			name:     "schema unknown",
+			db:       &pingDB{},
+			schema:   &fixedSchema{err: errors.New("permission denied")},
+			wantCode: http.StatusServiceUnavailable,
+			want:     map[string]any{"status": "schema unknown"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			readinessHandler(tt.db, tt.schema, logger.New("error")).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+			assert.Equal(t, tt.wantCode, rec.Code)
+			assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+			assert.Equal(t, "no-store", rec.Header().Get("Cache-Control"))
+
+			var got map[string]any
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
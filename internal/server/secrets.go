@@ -0,0 +1,85 @@
+package server
+
+import (
+	"html/template"
+	"net/http"
+	"strings"
+
+	"github.com/hrz8/altalune/internal/shared/secretdelivery"
+)
+
+// secretRetrievalPage confirms before revealing, so link previews and
+// scanners fetching the link do not burn the secret
+var secretRetrievalPage = template.Must(template.New("secret").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<meta name="robots" content="noindex">
+<title>Retrieve secret</title>
+<style>
+body { font-family: system-ui, sans-serif; max-width: 40rem; margin: 4rem auto; padding: 0 1rem; color: #111827; }
+pre { background: #f3f4f6; padding: 1rem; border-radius: .5rem; white-space: pre-wrap; word-break: break-all; }
+button { padding: .5rem 1rem; border-radius: .375rem; border: 0; background: #2563eb; color: #fff; cursor: pointer; }
+</style>
+</head>
+<body>
+{{if .Secret}}
+<h1>Your secret</h1>
+<pre>{{.Secret}}</pre>
+<p>Store it now: this link no longer works.</p>
+{{else if .Gone}}
+<h1>Link expired</h1>
+<p>This secret was already retrieved, or its link expired. Ask for a new secret if you did not retrieve it.</p>
+{{else}}
+<h1>Retrieve secret</h1>
+<p>The secret can be revealed once. Make sure nobody else can see your screen.</p>
+<form method="POST"><button type="submit">Reveal secret</button></form>
+{{end}}
+</body>
+</html>
+`))
+
+// secretRetrievalHandler serves the one-time links of the secrets delivered
+// by link: GET asks for confirmation, POST reveals the secret and burns it.
+func (s *Server) secretRetrievalHandler() http.Handler {
+	deliverer := s.c.GetSecretDeliverer()
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Cache-Control", "no-store")
+		w.Header().Set("Referrer-Policy", "no-referrer")
+		w.Header().Set("X-Robots-Tag", "noindex")
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+
+		token := strings.TrimPrefix(r.URL.Path, secretdelivery.RetrievalPath)
+		var data struct {
+			Secret string
+			Gone   bool
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPost:
+			secret, found, err := deliverer.Retrieve(r.Context(), token)
+			if err != nil {
+				s.log.Error("failed to retrieve delivered secret", "error", err)
+				http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+				return
+			}
+			if !found {
+				w.WriteHeader(http.StatusGone)
+				data.Gone = true
+				break
+			}
+			s.log.Info("delivered secret retrieved", "client_ip", clientIP(r))
+			data.Secret = secret
+		default:
+			w.Header().Set("Allow", "GET, POST")
+			http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		if err := secretRetrievalPage.Execute(w, data); err != nil {
+			s.log.Error("failed to render secret retrieval page", "error", err)
+		}
+	})
+}
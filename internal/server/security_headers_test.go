@@ -0,0 +1,120 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hrz8/altalune"
+	"github.com/hrz8/altalune/internal/shared/csp"
+	"github.com/stretchr/testify/assert"
+)
+
+// securityConfig holds the security headers configuration, the other getters
+// are never called
+type securityConfig struct {
+	altalune.Config
+	hstsMaxAge        time.Duration
+	includeSubdomains bool
+	preload           bool
+}
+
+func (c *securityConfig) GetHSTSMaxAge() time.Duration  { return c.hstsMaxAge }
+func (c *securityConfig) IsHSTSIncludeSubdomains() bool { return c.includeSubdomains }
+func (c *securityConfig) IsHSTSPreload() bool           { return c.preload }
+func (c *securityConfig) GetFrameOptions() string       { return "DENY" }
+func (c *securityConfig) GetReferrerPolicy() string     { return "no-referrer" }
+func (c *securityConfig) IsCSPReportOnly() bool         { return false }
+
+func TestNewSecurityHeadersOptionsHSTS(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  *securityConfig
+		want string
+	}{
+		{name: "disabled", cfg: &securityConfig{includeSubdomains: true}, want: ""},
+		{name: "max age", cfg: &securityConfig{hstsMaxAge: time.Hour}, want: "max-age=3600"},
+		{
+			name: "subdomains and preload",
+			cfg:  &securityConfig{hstsMaxAge: 365 * 24 * time.Hour, includeSubdomains: true, preload: true},
+			want: "max-age=31536000; includeSubDomains; preload",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := NewSecurityHeadersOptions(tt.cfg, "default-src 'self'")
+			assert.Equal(t, tt.want, opts.HSTS)
+			assert.Equal(t, "default-src 'self'", opts.CSP)
+		})
+	}
+}
+
+func TestSecurityMiddleware(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       SecurityHeadersOptions
+		cspHeader  string
+		wantNonce  bool
+		wantHSTS   string
+		wantPolicy string
+	}{
+		{
+			name:      "no policy",
+			opts:      SecurityHeadersOptions{FrameOptions: "DENY", ReferrerPolicy: "no-referrer"},
+			cspHeader: "Content-Security-Policy",
+		},
+		{
+			name:       "static policy with HSTS",
+			opts:       SecurityHeadersOptions{HSTS: "max-age=3600", FrameOptions: "DENY", ReferrerPolicy: "no-referrer", CSP: "default-src 'self'"},
+			cspHeader:  "Content-Security-Policy",
+			wantHSTS:   "max-age=3600",
+			wantPolicy: "default-src 'self'",
+		},
+		{
+			name:       "nonce policy",
+			opts:       SecurityHeadersOptions{FrameOptions: "DENY", ReferrerPolicy: "no-referrer", CSP: "script-src 'nonce-{nonce}'"},
+			cspHeader:  "Content-Security-Policy",
+			wantNonce:  true,
+			wantPolicy: "script-src 'nonce-{nonce}'",
+		},
+		{
+			name:       "report only",
+			opts:       SecurityHeadersOptions{FrameOptions: "DENY", ReferrerPolicy: "no-referrer", CSP: "default-src 'self'", CSPReportOnly: true},
+			cspHeader:  "Content-Security-Policy-Report-Only",
+			wantPolicy: "default-src 'self'",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var nonces []string
+			handler := SecurityMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				nonces = append(nonces, csp.Nonce(r.Context()))
+			}), tt.opts)
+
+			var policies []string
+			for range 2 {
+				rec := httptest.NewRecorder()
+				handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+				assert.Equal(t, "nosniff", rec.Header().Get("X-Content-Type-Options"))
+				assert.Equal(t, "DENY", rec.Header().Get("X-Frame-Options"))
+				assert.Equal(t, "no-referrer", rec.Header().Get("Referrer-Policy"))
+				assert.Equal(t, tt.wantHSTS, rec.Header().Get("Strict-Transport-Security"))
+				policies = append(policies, rec.Header().Get(tt.cspHeader))
+			}
+
+			if !tt.wantNonce {
+				assert.Equal(t, []string{"", ""}, nonces)
+				assert.Equal(t, []string{tt.wantPolicy, tt.wantPolicy}, policies)
+				return
+			}
+			assert.NotEmpty(t, nonces[0])
+			assert.NotEqual(t, nonces[0], nonces[1], "every response gets a fresh nonce")
+			for i, nonce := range nonces {
+				assert.Equal(t, strings.ReplaceAll(tt.wantPolicy, csp.NoncePlaceholder, nonce), policies[i], "the policy carries the nonce the handler sees")
+			}
+		})
+	}
+}
@@ -2,36 +2,61 @@ package server
 
 import (
 	"net/http"
+	"net/url"
 
 	"github.com/hrz8/altalune"
 	"github.com/hrz8/altalune/internal/container"
+	"github.com/hrz8/altalune/internal/shared/cookie"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 	"google.golang.org/grpc"
 )
 
 type Server struct {
-	c   *container.Container
-	cfg altalune.Config
-	log altalune.Logger
+	c       *container.Container
+	cfg     altalune.Config
+	log     altalune.Logger
+	cookies cookie.Options // Attributes of the dashboard token cookies
 
 	httpHandler http.Handler
 	grpcServer  *grpc.Server
+
+	frontendProxy         *url.URL
+	configureInterceptors []func(*InterceptorChain) // WithInterceptors options
 }
 
-func NewServer(c *container.Container) *Server {
-	return &Server{
-		c:   c,
-		cfg: c.GetConfig(),
-		log: c.GetLogger(),
+// Option configures a Server.
+type Option func(*Server)
+
+// WithFrontendProxy serves the dashboard by proxying to target, e.g. the Nuxt
+// dev server, instead of the embedded or configured build.
+func WithFrontendProxy(target *url.URL) Option {
+	return func(s *Server) {
+		s.frontendProxy = target
 	}
 }
 
+func NewServer(c *container.Container, opts ...Option) *Server {
+	s := &Server{
+		c:       c,
+		cfg:     c.GetConfig(),
+		log:     c.GetLogger(),
+		cookies: cookie.OptionsFromConfig(c.GetConfig()),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
 func (s *Server) Bootstrap() (http.Handler, http.Handler) {
 	mux := s.setupRoutes()
 	handler := s.setupMiddleware(mux)
 
-	s.httpHandler = h2c.NewHandler(handler, &http2.Server{})
+	s.httpHandler = handler
+	if s.cfg.IsH2CEnabled() {
+		s.httpHandler = h2c.NewHandler(handler, &http2.Server{})
+	}
 	s.grpcServer = s.setupGRPCServices()
 
 	return s.httpHandler, s.grpcServer
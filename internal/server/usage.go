@@ -0,0 +1,91 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/hrz8/altalune"
+	"github.com/hrz8/altalune/gen/altalune/v1/altalunev1connect"
+	"github.com/hrz8/altalune/internal/auth"
+	project_domain "github.com/hrz8/altalune/internal/domain/project"
+	usage_domain "github.com/hrz8/altalune/internal/domain/usage"
+	"google.golang.org/protobuf/proto"
+)
+
+// usageInterceptor implements connect.Interceptor to meter the unary requests
+// naming a project and to refuse them with ResourceExhausted (HTTP 429) once
+// the project has used its monthly quota.
+type usageInterceptor struct {
+	meter      *usage_domain.Meter
+	projects   project_domain.Repositor
+	authorizer *auth.Authorizer
+}
+
+// newUsageInterceptor creates a Connect-RPC interceptor metering the requests
+// with a project_id field. The calls of UsageService are not metered, so a
+// project over its quota can still see its usage and have its quota raised.
+// Nor are the requests of callers outside the project, which are answered as
+// for an unknown project whatever its quota.
+func newUsageInterceptor(meter *usage_domain.Meter, projects project_domain.Repositor, authorizer *auth.Authorizer) connect.Interceptor {
+	return &usageInterceptor{meter: meter, projects: projects, authorizer: authorizer}
+}
+
+// WrapUnary implements connect.Interceptor for unary RPC calls.
+func (i *usageInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if strings.HasPrefix(req.Spec().Procedure, "/"+altalunev1connect.UsageServiceName+"/") {
+			return next(ctx, req)
+		}
+		msg, ok := req.Any().(proto.Message)
+		if !ok {
+			return next(ctx, req)
+		}
+		publicID := requestProjectID(msg)
+		if publicID == "" {
+			return next(ctx, req)
+		}
+		if connect.CodeOf(i.authorizer.CheckProjectMembership(ctx, publicID)) == connect.CodeNotFound {
+			return next(ctx, req)
+		}
+		projectID, err := i.projects.GetIDByPublicID(ctx, publicID)
+		if err != nil {
+			return next(ctx, req)
+		}
+		clientID, _ := uuid.Parse(auth.FromContext(ctx).ClientID)
+
+		allowed, quota, resetsAt := i.meter.Allow(projectID)
+		if !allowed {
+			i.meter.Record(projectID, clientID, true)
+			err := altalune.ToConnectError(altalune.NewProjectQuotaExceededError(publicID, quota, resetsAt))
+			var connectErr *connect.Error
+			if errors.As(err, &connectErr) {
+				connectErr.Meta().Set("Retry-After", strconv.Itoa(int(time.Until(resetsAt).Seconds())))
+			}
+			return nil, err
+		}
+
+		resp, err := next(ctx, req)
+		// Requests the handler refused to the caller are not the project's
+		if code := connect.CodeOf(err); code != connect.CodePermissionDenied && code != connect.CodeUnauthenticated {
+			i.meter.Record(projectID, clientID, false)
+		}
+		return resp, err
+	}
+}
+
+// WrapStreamingClient implements connect.Interceptor for client streaming.
+// This is a pass-through for server-side interceptors.
+func (i *usageInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler implements connect.Interceptor for server streaming.
+// Streams are counted by neither calls nor messages, so they are not metered.
+func (i *usageInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
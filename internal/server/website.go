@@ -1,14 +1,40 @@
 package server
 
 import (
-	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"io"
 	"io/fs"
 	"mime"
 	"net/http"
+	"path"
 	"path/filepath"
+	"strings"
+	"sync"
+	"time"
 )
 
+const (
+	cacheControlImmutable  = "public, max-age=31536000, immutable"
+	cacheControlRevalidate = "no-cache"
+	cacheControlShortLived = "public, max-age=3600"
+)
+
+// etagCache memoizes content hashes so files are only hashed once per version.
+// It is keyed by the name of the file served, which only files that opened
+// get, and a new version replaces the entry of its file: it holds at most one
+// entry per asset however many paths are requested.
+var etagCache sync.Map // map[string]etagEntry
+
+// etagEntry is the ETag of the version of a file with the size and
+// modification time
+type etagEntry struct {
+	size    int64
+	modTime time.Time
+	etag    string
+}
+
 func exists(fsys fs.FS, name string) bool {
 	_, err := fs.Stat(fsys, name)
 	return err == nil
@@ -22,16 +48,26 @@ func isDir(fsys fs.FS, name string) bool {
 	return info.IsDir()
 }
 
+// serveFileOr404 streams a file with caching headers, preferring a precompressed
+// .br/.gz sibling when the client accepts it. Falls back to the 404 page.
 func serveFileOr404(w http.ResponseWriter, r *http.Request, fsys fs.FS, name string) {
-	f, err := fsys.Open(name)
+	servedName, encoding := precompressedVariant(r, fsys, name)
+
+	f, err := fsys.Open(servedName)
 	if err != nil {
 		serve404Page(w, r, fsys)
 		return
 	}
 	defer f.Close()
 
-	fi, err := fs.Stat(fsys, name)
-	if err != nil {
+	fi, err := f.Stat()
+	if err != nil || fi.IsDir() {
+		serve404Page(w, r, fsys)
+		return
+	}
+
+	content, ok := f.(io.ReadSeeker)
+	if !ok {
 		serve404Page(w, r, fsys)
 		return
 	}
@@ -40,8 +76,16 @@ func serveFileOr404(w http.ResponseWriter, r *http.Request, fsys fs.FS, name str
 	if ctype := mime.TypeByExtension(ext); ctype != "" {
 		w.Header().Set("Content-Type", ctype)
 	}
+	w.Header().Set("Cache-Control", cacheControlFor(name))
+	w.Header().Add("Vary", "Accept-Encoding")
+	if encoding != "" {
+		w.Header().Set("Content-Encoding", encoding)
+	}
+	if etag, err := fileETag(servedName, fi, content); err == nil {
+		w.Header().Set("ETag", etag)
+	}
 
-	http.ServeContent(w, r, name, fi.ModTime(), bytes.NewReader(mustReadAll(f)))
+	http.ServeContent(w, r, name, fi.ModTime(), content)
 }
 
 func serve404Page(w http.ResponseWriter, r *http.Request, fsys fs.FS) {
@@ -54,14 +98,75 @@ func serve404Page(w http.ResponseWriter, r *http.Request, fsys fs.FS) {
 	}
 	defer f.Close()
 
-	data, _ := io.ReadAll(f)
-
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", cacheControlRevalidate)
 	w.WriteHeader(http.StatusNotFound)
-	w.Write(data)
+	io.Copy(w, f)
 }
 
-func mustReadAll(f fs.File) []byte {
-	data, _ := io.ReadAll(f)
-	return data
+// precompressedVariant returns the name of a precompressed sibling of name
+// (brotli preferred over gzip) and its Content-Encoding, if the client accepts
+// it and the file exists. Otherwise name is returned unchanged.
+func precompressedVariant(r *http.Request, fsys fs.FS, name string) (string, string) {
+	if r.Header.Get("Range") != "" {
+		return name, ""
+	}
+
+	accept := r.Header.Get("Accept-Encoding")
+	if acceptsEncoding(accept, "br") && exists(fsys, name+".br") {
+		return name + ".br", "br"
+	}
+	if acceptsEncoding(accept, "gzip") && exists(fsys, name+".gz") {
+		return name + ".gz", "gzip"
+	}
+	return name, ""
+}
+
+func acceptsEncoding(header, encoding string) bool {
+	for _, part := range strings.Split(header, ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if !strings.EqualFold(strings.TrimSpace(name), encoding) {
+			continue
+		}
+		q := strings.TrimSpace(params)
+		return q != "q=0" && q != "q=0.0" && q != "q=0.00" && q != "q=0.000"
+	}
+	return false
+}
+
+// cacheControlFor returns the Cache-Control policy for a static file:
+// fingerprinted build assets (everything Nuxt emits under _nuxt/, except the
+// build manifest) never change, HTML must always be revalidated.
+func cacheControlFor(name string) string {
+	switch {
+	case path.Ext(name) == ".html", strings.HasPrefix(name, "_nuxt/builds/"):
+		return cacheControlRevalidate
+	case strings.HasPrefix(name, "_nuxt/"):
+		return cacheControlImmutable
+	default:
+		return cacheControlShortLived
+	}
+}
+
+// fileETag returns a strong ETag derived from the file content. The content is
+// rewound afterwards so it can be served.
+func fileETag(name string, fi fs.FileInfo, content io.ReadSeeker) (string, error) {
+	if cached, ok := etagCache.Load(name); ok {
+		entry := cached.(etagEntry)
+		if entry.size == fi.Size() && entry.modTime.Equal(fi.ModTime()) {
+			return entry.etag, nil
+		}
+	}
+
+	h := sha256.New()
+	if _, err := io.Copy(h, content); err != nil {
+		return "", err
+	}
+	if _, err := content.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	etag := fmt.Sprintf(`"%s"`, hex.EncodeToString(h.Sum(nil)[:16]))
+	etagCache.Store(name, etagEntry{size: fi.Size(), modTime: fi.ModTime(), etag: etag})
+	return etag, nil
 }
@@ -0,0 +1,162 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServeFileOr404(t *testing.T) {
+	fsys := fstest.MapFS{
+		"index.html":               {Data: []byte("<h1>Home</h1>")},
+		"404.html":                 {Data: []byte("<h1>Not found</h1>")},
+		"robots.txt":               {Data: []byte("User-agent: *")},
+		"_nuxt/entry.js":           {Data: []byte("console.log('entry')")},
+		"_nuxt/entry.js.br":        {Data: []byte("brotli")},
+		"_nuxt/entry.js.gz":        {Data: []byte("gzip")},
+		"_nuxt/builds/latest.json": {Data: []byte(`{"id":"1"}`)},
+	}
+
+	tests := []struct {
+		name         string
+		file         string
+		header       http.Header
+		status       int
+		body         string
+		cacheControl string
+		encoding     string
+	}{
+		{
+			name:         "html is revalidated",
+			file:         "index.html",
+			status:       http.StatusOK,
+			body:         "<h1>Home</h1>",
+			cacheControl: cacheControlRevalidate,
+		},
+		{
+			name:         "fingerprinted assets are immutable",
+			file:         "_nuxt/entry.js",
+			status:       http.StatusOK,
+			body:         "console.log('entry')",
+			cacheControl: cacheControlImmutable,
+		},
+		{
+			name:         "build manifest is revalidated",
+			file:         "_nuxt/builds/latest.json",
+			status:       http.StatusOK,
+			body:         `{"id":"1"}`,
+			cacheControl: cacheControlRevalidate,
+		},
+		{
+			name:         "other files are short lived",
+			file:         "robots.txt",
+			status:       http.StatusOK,
+			body:         "User-agent: *",
+			cacheControl: cacheControlShortLived,
+		},
+		{
+			name:         "brotli preferred",
+			file:         "_nuxt/entry.js",
+			header:       http.Header{"Accept-Encoding": {"gzip, br"}},
+			status:       http.StatusOK,
+			body:         "brotli",
+			cacheControl: cacheControlImmutable,
+			encoding:     "br",
+		},
+		{
+			name:         "gzip when brotli is refused",
+			file:         "_nuxt/entry.js",
+			header:       http.Header{"Accept-Encoding": {"gzip, br;q=0"}},
+			status:       http.StatusOK,
+			body:         "gzip",
+			cacheControl: cacheControlImmutable,
+			encoding:     "gzip",
+		},
+		{
+			name:         "range requests get the identity",
+			file:         "_nuxt/entry.js",
+			header:       http.Header{"Accept-Encoding": {"br"}, "Range": {"bytes=0-6"}},
+			status:       http.StatusPartialContent,
+			body:         "console",
+			cacheControl: cacheControlImmutable,
+		},
+		{
+			name:         "missing file",
+			file:         "missing.html",
+			status:       http.StatusNotFound,
+			body:         "<h1>Not found</h1>",
+			cacheControl: cacheControlRevalidate,
+		},
+		{
+			name:         "directory",
+			file:         "_nuxt",
+			status:       http.StatusNotFound,
+			body:         "<h1>Not found</h1>",
+			cacheControl: cacheControlRevalidate,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/"+tt.file, nil)
+			for k, v := range tt.header {
+				req.Header[k] = v
+			}
+			rec := httptest.NewRecorder()
+			serveFileOr404(rec, req, fsys, tt.file)
+
+			assert.Equal(t, tt.status, rec.Code)
+			assert.Equal(t, tt.body, rec.Body.String())
+			assert.Equal(t, tt.cacheControl, rec.Header().Get("Cache-Control"))
+			assert.Equal(t, tt.encoding, rec.Header().Get("Content-Encoding"))
+		})
+	}
+}
+
+func TestServeFileOr404ETag(t *testing.T) {
+	fsys := fstest.MapFS{
+		"app.js":    {Data: []byte("one")},
+		"app.js.gz": {Data: []byte("gzipped one")},
+	}
+	serve := func(header http.Header) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/app.js", nil)
+		for k, v := range header {
+			req.Header[k] = v
+		}
+		rec := httptest.NewRecorder()
+		serveFileOr404(rec, req, fsys, "app.js")
+		return rec
+	}
+
+	first := serve(nil)
+	etag := first.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+	assert.Equal(t, "one", first.Body.String(), "the content is rewound after hashing")
+
+	revalidated := serve(http.Header{"If-None-Match": {etag}})
+	assert.Equal(t, http.StatusNotModified, revalidated.Code)
+	assert.Empty(t, revalidated.Body.String())
+
+	gzipped := serve(http.Header{"Accept-Encoding": {"gzip"}})
+	assert.NotEqual(t, etag, gzipped.Header().Get("ETag"), "each encoding has its own ETag")
+
+	fsys["app.js"] = &fstest.MapFile{Data: []byte("two"), ModTime: time.Now()}
+	assert.NotEqual(t, etag, serve(nil).Header().Get("ETag"), "a new version gets a new ETag")
+
+	entries := func() int {
+		n := 0
+		etagCache.Range(func(any, any) bool { n++; return true })
+		return n
+	}
+	before := entries()
+	for i := range 10 {
+		fsys["app.js"] = &fstest.MapFile{Data: []byte(strconv.Itoa(i)), ModTime: time.Now().Add(time.Duration(i) * time.Second)}
+		serve(nil)
+	}
+	assert.Equal(t, before, entries(), "new versions replace the ETag of their file")
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/gorilla/sessions"
+	"github.com/hrz8/altalune/internal/shared/cookie"
 )
 
 const (
@@ -14,10 +15,17 @@ const (
 	keyUserID          = "user_id"
 	keyAuthenticatedAt = "authenticated_at"
 	keyOAuthState      = "oauth_state"
+	keyOAuthStateAt    = "oauth_state_at"
 	keyOAuthProvider   = "oauth_provider"
 	keyOriginalURL     = "original_url"
 	keyCSRFToken       = "csrf_token"
 	keyPendingOTPEmail = "pending_otp_email"
+	keyRememberMe      = "remember_me"
+	keyLastSeenAt      = "last_seen_at"
+
+	// touchInterval is how stale the last activity of a session gets before
+	// KeepAlive saves it again, so that not every request rewrites the cookie
+	touchInterval = time.Minute
 )
 
 type ctxKey string
@@ -29,33 +37,82 @@ type Data struct {
 	UserID          int64
 	AuthenticatedAt time.Time
 	OAuthState      string
+	OAuthStateAt    time.Time // When the pending provider login of OAuthState started
 	OAuthProvider   string
 	OriginalURL     string
 	CSRFToken       string
 	PendingOTPEmail string
+	RememberMe      bool      // Whether the pending sign-in asked to keep the device signed in
+	LastSeenAt      time.Time // Last activity of the signed-in user, which the idle timeout counts from
+}
+
+// ClearOAuthState forgets the pending provider login, so that its state cannot
+// be used again.
+func (d *Data) ClearOAuthState() {
+	d.OAuthState = ""
+	d.OAuthStateAt = time.Time{}
+	d.OAuthProvider = ""
 }
 
 // Store wraps gorilla/sessions for cookie-based session management.
+//
+// A signed-in session ends after idleTimeout without activity, or lifetime
+// after the sign-in whatever the activity. An ended session reads as signed
+// out, so the user signs in again.
 type Store struct {
-	store *sessions.CookieStore
+	store       *sessions.CookieStore
+	name        string        // CookieName, with the __Host- prefix when the cookie allows it
+	idleTimeout time.Duration // Zero disables the idle timeout
+	lifetime    time.Duration
+	now         func() time.Time
 }
 
-// NewStore creates a new session store with the given secret and options.
-func NewStore(secret string, secure bool, maxAge int) *Store {
+// NewStore creates a new session store with the given secret, cookie
+// attributes and session timeouts. A zero idleTimeout disables it.
+func NewStore(secret string, opts cookie.Options, idleTimeout, lifetime time.Duration) *Store {
+	c := opts.New(CookieName, "", int(lifetime.Seconds()))
 	store := sessions.NewCookieStore([]byte(secret))
 	store.Options = &sessions.Options{
-		Path:     "/",
-		MaxAge:   maxAge,
-		HttpOnly: true,
-		Secure:   secure,
-		SameSite: http.SameSiteLaxMode,
+		Path:     c.Path,
+		Domain:   c.Domain,
+		HttpOnly: c.HttpOnly,
+		Secure:   c.Secure,
+		SameSite: c.SameSite,
+	}
+	// Sets the cookie max age and the one the signed value is accepted for
+	store.MaxAge(c.MaxAge)
+	return &Store{
+		store:       store,
+		name:        c.Name,
+		idleTimeout: idleTimeout,
+		lifetime:    lifetime,
+		now:         time.Now,
+	}
+}
+
+// IdleTimeout returns how long a session lasts without activity, zero when
+// it does not time out.
+func (s *Store) IdleTimeout() time.Duration {
+	return s.idleTimeout
+}
+
+// ExpiresAt returns when the session of data ends at the latest: lifetime
+// after its sign-in. Activity does not extend it.
+func (s *Store) ExpiresAt(data *Data) time.Time {
+	return data.AuthenticatedAt.Add(s.lifetime)
+}
+
+// expired reports whether the signed-in session of data ended
+func (s *Store) expired(data *Data, now time.Time) bool {
+	if !now.Before(s.ExpiresAt(data)) {
+		return true
 	}
-	return &Store{store: store}
+	return s.idleTimeout > 0 && !now.Before(data.LastSeenAt.Add(s.idleTimeout))
 }
 
 // Get retrieves the session from the request cookie.
 func (s *Store) Get(r *http.Request) (*sessions.Session, error) {
-	return s.store.Get(r, CookieName)
+	return s.store.Get(r, s.name)
 }
 
 // Save persists the session to the response cookie.
@@ -81,6 +138,9 @@ func (s *Store) GetData(r *http.Request) (*Data, error) {
 	if v, ok := sess.Values[keyOAuthState].(string); ok {
 		data.OAuthState = v
 	}
+	if v, ok := sess.Values[keyOAuthStateAt].(int64); ok {
+		data.OAuthStateAt = time.Unix(v, 0)
+	}
 	if v, ok := sess.Values[keyOAuthProvider].(string); ok {
 		data.OAuthProvider = v
 	}
@@ -93,28 +153,74 @@ func (s *Store) GetData(r *http.Request) (*Data, error) {
 	if v, ok := sess.Values[keyPendingOTPEmail].(string); ok {
 		data.PendingOTPEmail = v
 	}
+	if v, ok := sess.Values[keyRememberMe].(bool); ok {
+		data.RememberMe = v
+	}
+	if v, ok := sess.Values[keyLastSeenAt].(int64); ok && v > 0 {
+		data.LastSeenAt = time.Unix(v, 0)
+	} else {
+		// Sessions saved before the idle timeout count from their sign-in
+		data.LastSeenAt = data.AuthenticatedAt
+	}
+
+	// An ended session is signed out, what else it holds is kept
+	if data.UserID != 0 && s.expired(data, s.now()) {
+		data.UserID = 0
+		data.AuthenticatedAt = time.Time{}
+		data.LastSeenAt = time.Time{}
+	}
 
 	return data, nil
 }
 
-// SetData stores session data in the response cookie.
+// SetData stores session data in the response cookie. Saving the session of
+// a signed-in user counts as activity.
 func (s *Store) SetData(r *http.Request, w http.ResponseWriter, data *Data) error {
 	sess, err := s.Get(r)
 	if err != nil {
 		return err
 	}
 
+	if data.UserID != 0 {
+		now := s.now()
+		data.LastSeenAt = now
+		// The cookie expires with the session at the latest
+		opts := *sess.Options
+		opts.MaxAge = int(s.ExpiresAt(data).Sub(now).Seconds())
+		if opts.MaxAge < 1 {
+			opts.MaxAge = 1
+		}
+		sess.Options = &opts
+	}
+
 	sess.Values[keyUserID] = data.UserID
 	sess.Values[keyAuthenticatedAt] = data.AuthenticatedAt.Unix()
 	sess.Values[keyOAuthState] = data.OAuthState
+	sess.Values[keyOAuthStateAt] = data.OAuthStateAt.Unix()
 	sess.Values[keyOAuthProvider] = data.OAuthProvider
 	sess.Values[keyOriginalURL] = data.OriginalURL
 	sess.Values[keyCSRFToken] = data.CSRFToken
 	sess.Values[keyPendingOTPEmail] = data.PendingOTPEmail
+	sess.Values[keyRememberMe] = data.RememberMe
+	sess.Values[keyLastSeenAt] = data.LastSeenAt.Unix()
 
 	return s.Save(r, w, sess)
 }
 
+// KeepAlive saves the activity of signed-in sessions, so that they do not
+// reach the idle timeout while in use. It saves at most once a touchInterval.
+func (s *Store) KeepAlive(next http.Handler) http.Handler {
+	if s.idleTimeout == 0 {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if data, err := s.GetData(r); err == nil && data.UserID != 0 && s.now().Sub(data.LastSeenAt) >= touchInterval {
+			_ = s.SetData(r, w, data)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
 // Clear removes all session data and invalidates the cookie.
 func (s *Store) Clear(r *http.Request, w http.ResponseWriter) error {
 	sess, err := s.Get(r)
@@ -0,0 +1,60 @@
+package session
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hrz8/altalune/internal/shared/cookie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// save signs the user in at the current time of s and returns the cookies of
+// the session
+func save(t *testing.T, s *Store, data *Data) []*http.Cookie {
+	t.Helper()
+	w := httptest.NewRecorder()
+	require.NoError(t, s.SetData(httptest.NewRequest(http.MethodGet, "/", nil), w, data))
+	return w.Result().Cookies()
+}
+
+func load(t *testing.T, s *Store, cookies []*http.Cookie) *Data {
+	t.Helper()
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	for _, c := range cookies {
+		r.AddCookie(c)
+	}
+	data, err := s.GetData(r)
+	require.NoError(t, err)
+	return data
+}
+
+func TestStoreExpiry(t *testing.T) {
+	signedInAt := time.Now().Truncate(time.Second)
+	s := NewStore("0123456789abcdef0123456789abcdef", cookie.Options{}, 30*time.Minute, 10*time.Hour)
+
+	s.now = func() time.Time { return signedInAt }
+	cookies := save(t, s, &Data{UserID: 42, AuthenticatedAt: signedInAt, CSRFToken: "token"})
+
+	s.now = func() time.Time { return signedInAt.Add(29 * time.Minute) }
+	data := load(t, s, cookies)
+	assert.Equal(t, int64(42), data.UserID, "active within the idle timeout")
+	assert.Equal(t, signedInAt.Add(10*time.Hour), s.ExpiresAt(data))
+
+	s.now = func() time.Time { return signedInAt.Add(31 * time.Minute) }
+	data = load(t, s, cookies)
+	assert.Zero(t, data.UserID, "idle for longer than the timeout")
+	assert.Equal(t, "token", data.CSRFToken, "the rest of the session is kept")
+
+	// Activity every 20 minutes keeps the session up to its lifetime
+	for at := 20 * time.Minute; at < 10*time.Hour; at += 20 * time.Minute {
+		s.now = func() time.Time { return signedInAt.Add(at) }
+		data = load(t, s, cookies)
+		require.Equal(t, int64(42), data.UserID, at)
+		cookies = save(t, s, data)
+	}
+	s.now = func() time.Time { return signedInAt.Add(10 * time.Hour) }
+	assert.Zero(t, load(t, s, cookies).UserID, "past the absolute lifetime")
+}
@@ -0,0 +1,117 @@
+// Package captcha verifies the bot challenges of the auth server forms. The
+// supported providers, Cloudflare Turnstile, hCaptcha and Google reCAPTCHA,
+// share the siteverify protocol: the widget posts a response token with the
+// form, which the server exchanges with its secret key for a verdict.
+package captcha
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrMissingResponse is returned when the form carries no widget response
+	ErrMissingResponse = errors.New("captcha: missing challenge response")
+	// ErrChallengeFailed is returned when the provider rejects the response
+	ErrChallengeFailed = errors.New("captcha: challenge failed")
+)
+
+// Provider is a siteverify-compatible challenge provider
+type Provider struct {
+	Name          string   // Provider name in the configuration
+	ScriptURL     string   // Widget script the form loads
+	WidgetClass   string   // Class of the element the script renders the widget in
+	ResponseField string   // Form field the widget posts its response in
+	VerifyURL     string   // siteverify endpoint
+	Sources       []string // Origins the widget loads scripts and frames from
+}
+
+var providers = map[string]Provider{
+	"turnstile": {
+		Name:          "turnstile",
+		ScriptURL:     "https://challenges.cloudflare.com/turnstile/v0/api.js",
+		WidgetClass:   "cf-turnstile",
+		ResponseField: "cf-turnstile-response",
+		VerifyURL:     "https://challenges.cloudflare.com/turnstile/v0/siteverify",
+		Sources:       []string{"https://challenges.cloudflare.com"},
+	},
+	"hcaptcha": {
+		Name:          "hcaptcha",
+		ScriptURL:     "https://js.hcaptcha.com/1/api.js",
+		WidgetClass:   "h-captcha",
+		ResponseField: "h-captcha-response",
+		VerifyURL:     "https://api.hcaptcha.com/siteverify",
+		Sources:       []string{"https://hcaptcha.com", "https://*.hcaptcha.com"},
+	},
+	"recaptcha": {
+		Name:          "recaptcha",
+		ScriptURL:     "https://www.google.com/recaptcha/api.js",
+		WidgetClass:   "g-recaptcha",
+		ResponseField: "g-recaptcha-response",
+		VerifyURL:     "https://www.google.com/recaptcha/api/siteverify",
+		Sources:       []string{"https://www.google.com/recaptcha/", "https://www.gstatic.com/recaptcha/"},
+	},
+}
+
+// Lookup returns the provider configured as name
+func Lookup(name string) (Provider, bool) {
+	p, ok := providers[name]
+	return p, ok
+}
+
+// Verifier checks widget responses with their provider
+type Verifier struct {
+	client *http.Client
+}
+
+// NewVerifier creates a Verifier giving up on providers after timeout
+func NewVerifier(timeout time.Duration) *Verifier {
+	return &Verifier{client: &http.Client{Timeout: timeout}}
+}
+
+// Verify checks the response posted by the widget of p, secret being the key
+// paired with the site key the widget was rendered with. remoteIP, when known,
+// lets the provider match the client that solved the challenge.
+func (v *Verifier) Verify(ctx context.Context, p Provider, secret, response, remoteIP string) error {
+	if response == "" {
+		return ErrMissingResponse
+	}
+
+	form := url.Values{"secret": {secret}, "response": {response}}
+	if remoteIP != "" {
+		form.Set("remoteip", remoteIP)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.VerifyURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("create %s verification request: %w", p.Name, err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("verify %s response: %w", p.Name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("verify %s response: unexpected status %d", p.Name, resp.StatusCode)
+	}
+
+	var result struct {
+		Success    bool     `json:"success"`
+		ErrorCodes []string `json:"error-codes"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("decode %s verification: %w", p.Name, err)
+	}
+	if !result.Success {
+		return fmt.Errorf("%w: %s", ErrChallengeFailed, strings.Join(result.ErrorCodes, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package captcha
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookup(t *testing.T) {
+	for _, name := range []string{"turnstile", "hcaptcha", "recaptcha"} {
+		p, ok := Lookup(name)
+		require.True(t, ok, name)
+		assert.Equal(t, name, p.Name)
+		assert.NotEmpty(t, p.ResponseField)
+		assert.NotEmpty(t, p.Sources)
+	}
+
+	_, ok := Lookup("none")
+	assert.False(t, ok)
+}
+
+func TestVerify(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "secret", r.PostForm.Get("secret"))
+		if r.PostForm.Get("response") != "solved" {
+			json.NewEncoder(w).Encode(map[string]any{"success": false, "error-codes": []string{"invalid-input-response"}})
+			return
+		}
+		assert.Equal(t, "203.0.113.7", r.PostForm.Get("remoteip"))
+		json.NewEncoder(w).Encode(map[string]any{"success": true})
+	}))
+	defer srv.Close()
+
+	p := Provider{Name: "test", VerifyURL: srv.URL}
+	v := NewVerifier(time.Second)
+	ctx := context.Background()
+
+	assert.NoError(t, v.Verify(ctx, p, "secret", "solved", "203.0.113.7"))
+
+	err := v.Verify(ctx, p, "secret", "forged", "")
+	assert.ErrorIs(t, err, ErrChallengeFailed)
+	assert.ErrorContains(t, err, "invalid-input-response")
+
+	assert.ErrorIs(t, v.Verify(ctx, p, "secret", "", ""), ErrMissingResponse)
+}
+
+func TestVerifyUnavailable(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	err := NewVerifier(time.Second).Verify(context.Background(), Provider{Name: "test", VerifyURL: srv.URL}, "secret", "solved", "")
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ErrChallengeFailed, "an unavailable provider is not a failed challenge")
+}
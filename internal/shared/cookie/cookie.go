@@ -0,0 +1,86 @@
+// Package cookie builds the cookies the servers set, so their attributes
+// follow the deployment (HTTPS, parent domain, sub path) rather than being
+// repeated at every call site.
+package cookie
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hrz8/altalune"
+)
+
+// HostPrefix is the name prefix browsers only accept on Secure cookies set
+// for the exact host, at path "/"
+const HostPrefix = "__Host-"
+
+// Options are the attributes shared by the cookies of a server
+type Options struct {
+	Secure   bool
+	SameSite http.SameSite
+	Domain   string // Empty scopes cookies to the host that set them
+	Path     string
+}
+
+// OptionsFromConfig returns the cookie attributes configured in
+// security.cookies
+func OptionsFromConfig(cfg altalune.Config) Options {
+	sameSite, _ := ParseSameSite(cfg.GetCookieSameSite())
+	return Options{
+		Secure:   cfg.IsCookieSecure(),
+		SameSite: sameSite,
+		Domain:   cfg.GetCookieDomain(),
+		Path:     cfg.GetCookiePath(),
+	}
+}
+
+// ParseSameSite parses a SameSite mode: lax, strict or none
+func ParseSameSite(mode string) (http.SameSite, error) {
+	switch strings.ToLower(mode) {
+	case "", "lax":
+		return http.SameSiteLaxMode, nil
+	case "strict":
+		return http.SameSiteStrictMode, nil
+	case "none":
+		return http.SameSiteNoneMode, nil
+	default:
+		return 0, fmt.Errorf("invalid SameSite mode %q (must be one of: lax, strict, none)", mode)
+	}
+}
+
+// Name returns name with HostPrefix when the options allow it, which keeps
+// other hosts of the domain from overwriting the cookie
+func (o Options) Name(name string) string {
+	if o.Secure && o.Domain == "" && o.path() == "/" {
+		return HostPrefix + name
+	}
+	return name
+}
+
+// New returns an HttpOnly cookie named Name(name) with the options applied,
+// kept for maxAge seconds
+func (o Options) New(name, value string, maxAge int) *http.Cookie {
+	return &http.Cookie{
+		Name:     o.Name(name),
+		Value:    value,
+		Path:     o.path(),
+		Domain:   o.Domain,
+		MaxAge:   maxAge,
+		HttpOnly: true,
+		Secure:   o.Secure,
+		SameSite: o.SameSite,
+	}
+}
+
+// Expire returns the cookie deleting the one New(name, ...) sets
+func (o Options) Expire(name string) *http.Cookie {
+	return o.New(name, "", -1)
+}
+
+func (o Options) path() string {
+	if o.Path == "" {
+		return "/"
+	}
+	return o.Path
+}
@@ -0,0 +1,46 @@
+package cookie
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestName(t *testing.T) {
+	assert.Equal(t, "session", Options{}.Name("session"), "not secure")
+	assert.Equal(t, "__Host-session", Options{Secure: true}.Name("session"))
+	assert.Equal(t, "__Host-session", Options{Secure: true, Path: "/"}.Name("session"))
+	assert.Equal(t, "session", Options{Secure: true, Domain: "example.com"}.Name("session"), "shared with subdomains")
+	assert.Equal(t, "session", Options{Secure: true, Path: "/app"}.Name("session"), "not at the root path")
+}
+
+func TestNew(t *testing.T) {
+	o := Options{Secure: true, SameSite: http.SameSiteNoneMode, Domain: "example.com", Path: "/app"}
+	c := o.New("token", "v", 60)
+	assert.Equal(t, "token", c.Name)
+	assert.Equal(t, "example.com", c.Domain)
+	assert.Equal(t, "/app", c.Path)
+	assert.True(t, c.Secure)
+	assert.True(t, c.HttpOnly)
+	assert.Equal(t, http.SameSiteNoneMode, c.SameSite)
+	assert.Equal(t, 60, c.MaxAge)
+
+	expired := Options{}.Expire("token")
+	assert.Equal(t, "/", expired.Path)
+	assert.Equal(t, -1, expired.MaxAge)
+}
+
+func TestParseSameSite(t *testing.T) {
+	mode, err := ParseSameSite("Strict")
+	require.NoError(t, err)
+	assert.Equal(t, http.SameSiteStrictMode, mode)
+
+	mode, err = ParseSameSite("")
+	require.NoError(t, err)
+	assert.Equal(t, http.SameSiteLaxMode, mode)
+
+	_, err = ParseSameSite("sometimes")
+	assert.Error(t, err)
+}
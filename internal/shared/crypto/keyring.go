@@ -0,0 +1,135 @@
+package crypto
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Key rotation:
+//
+// A Keyring encrypts with its current key and decrypts with any of its keys.
+// Its ciphertexts are envelopes prefixed with the ID of the key they were
+// encrypted with:
+//
+//	<key id>:<base64 nonce + ciphertext>
+//
+// The key ID is derived from the key itself, so rotating only takes adding the
+// new key as current and moving the old one to the previous keys. Existing
+// secrets keep decrypting while they are re-encrypted with Reencrypt, after
+// which the old key can be dropped.
+//
+// Ciphertexts produced by Encrypt have no prefix (base64 never contains ':').
+// They are decrypted by trying every key, and Reencrypt turns them into
+// envelopes.
+
+// keyIDSeparator separates the key ID from the ciphertext in an envelope.
+const keyIDSeparator = ":"
+
+// ErrUnknownKey is returned when decrypting an envelope whose key is not in
+// the keyring.
+var ErrUnknownKey = errors.New("ciphertext encrypted with a key not in the keyring")
+
+// KeyID returns the ID of key used in envelopes: the first 8 hex characters
+// of its SHA-256 digest.
+func KeyID(key []byte) string {
+	sum := sha256.Sum256(key)
+	return hex.EncodeToString(sum[:4])
+}
+
+// Keyring holds the current encryption key and the previous keys still
+// accepted for decryption.
+type Keyring struct {
+	currentID string
+	ids       []string // Key IDs, current first
+	keys      map[string][]byte
+}
+
+// NewKeyring creates a keyring encrypting with current and decrypting with
+// current or any of previous. Every key must be 32 bytes.
+func NewKeyring(current []byte, previous ...[]byte) (*Keyring, error) {
+	k := &Keyring{keys: make(map[string][]byte, len(previous)+1)}
+	for i, key := range append([][]byte{current}, previous...) {
+		if err := ValidateKey(key); err != nil {
+			if i == 0 {
+				return nil, fmt.Errorf("current key: %w", err)
+			}
+			return nil, fmt.Errorf("previous key %d: %w", i, err)
+		}
+		id := KeyID(key)
+		if _, ok := k.keys[id]; ok {
+			continue
+		}
+		k.keys[id] = key
+		k.ids = append(k.ids, id)
+	}
+	k.currentID = k.ids[0]
+	return k, nil
+}
+
+// CurrentKeyID returns the ID of the key new ciphertexts are encrypted with.
+func (k *Keyring) CurrentKeyID() string {
+	return k.currentID
+}
+
+// Encrypt encrypts plaintext with the current key and returns the envelope.
+func (k *Keyring) Encrypt(plaintext string) (string, error) {
+	ciphertext, err := Encrypt(plaintext, k.keys[k.currentID])
+	if err != nil {
+		return "", err
+	}
+	return k.currentID + keyIDSeparator + ciphertext, nil
+}
+
+// Decrypt decrypts an envelope with the key it names, or an unprefixed
+// ciphertext with whichever key of the keyring opens it.
+func (k *Keyring) Decrypt(ciphertext string) (string, error) {
+	id, data, ok := strings.Cut(ciphertext, keyIDSeparator)
+	if ok {
+		key, known := k.keys[id]
+		if !known {
+			return "", fmt.Errorf("%w: %s", ErrUnknownKey, id)
+		}
+		return Decrypt(data, key)
+	}
+
+	var firstErr error
+	for _, id := range k.ids {
+		plaintext, err := Decrypt(ciphertext, k.keys[id])
+		if err == nil {
+			return plaintext, nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	return "", firstErr
+}
+
+// NeedsReencrypt reports whether ciphertext is not an envelope of the current
+// key.
+func (k *Keyring) NeedsReencrypt(ciphertext string) bool {
+	id, _, ok := strings.Cut(ciphertext, keyIDSeparator)
+	return !ok || id != k.currentID
+}
+
+// Reencrypt returns ciphertext re-encrypted with the current key, or
+// ciphertext itself when it already is an envelope of the current key. The
+// boolean reports whether it changed.
+func (k *Keyring) Reencrypt(ciphertext string) (string, bool, error) {
+	if !k.NeedsReencrypt(ciphertext) {
+		return ciphertext, false, nil
+	}
+
+	plaintext, err := k.Decrypt(ciphertext)
+	if err != nil {
+		return "", false, err
+	}
+	encrypted, err := k.Encrypt(plaintext)
+	if err != nil {
+		return "", false, err
+	}
+	return encrypted, true, nil
+}
@@ -0,0 +1,87 @@
+package crypto_test
+
+import (
+	"crypto/rand"
+	"strings"
+	"testing"
+
+	"github.com/hrz8/altalune/internal/shared/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newKey(t *testing.T) []byte {
+	t.Helper()
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	return key
+}
+
+// TestKeyring_Rotation walks through a rotation from oldKey to newKey
+func TestKeyring_Rotation(t *testing.T) {
+	oldKey, newKey := newKey(t), newKey(t)
+
+	legacy, err := crypto.Encrypt("legacy-secret", oldKey)
+	require.NoError(t, err)
+
+	before, err := crypto.NewKeyring(oldKey)
+	require.NoError(t, err)
+	envelope, err := before.Encrypt("enveloped-secret")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(envelope, crypto.KeyID(oldKey)+":"))
+
+	// The new key is current, the old one still decrypts
+	during, err := crypto.NewKeyring(newKey, oldKey)
+	require.NoError(t, err)
+	assert.Equal(t, crypto.KeyID(newKey), during.CurrentKeyID())
+
+	for ciphertext, want := range map[string]string{legacy: "legacy-secret", envelope: "enveloped-secret"} {
+		plaintext, err := during.Decrypt(ciphertext)
+		require.NoError(t, err)
+		assert.Equal(t, want, plaintext)
+		assert.True(t, during.NeedsReencrypt(ciphertext))
+
+		rotated, changed, err := during.Reencrypt(ciphertext)
+		require.NoError(t, err)
+		assert.True(t, changed)
+		assert.False(t, during.NeedsReencrypt(rotated))
+
+		again, changed, err := during.Reencrypt(rotated)
+		require.NoError(t, err)
+		assert.False(t, changed)
+		assert.Equal(t, rotated, again)
+
+		// Once re-encrypted, the old key can be dropped
+		after, err := crypto.NewKeyring(newKey)
+		require.NoError(t, err)
+		plaintext, err = after.Decrypt(rotated)
+		require.NoError(t, err)
+		assert.Equal(t, want, plaintext)
+
+		_, err = after.Decrypt(ciphertext)
+		assert.Error(t, err)
+	}
+}
+
+// TestKeyring_UnknownKey verifies envelopes of keys outside the keyring are rejected
+func TestKeyring_UnknownKey(t *testing.T) {
+	other, err := crypto.NewKeyring(newKey(t))
+	require.NoError(t, err)
+	envelope, err := other.Encrypt("secret")
+	require.NoError(t, err)
+
+	k, err := crypto.NewKeyring(newKey(t))
+	require.NoError(t, err)
+	_, err = k.Decrypt(envelope)
+	assert.ErrorIs(t, err, crypto.ErrUnknownKey)
+}
+
+// TestNewKeyring_InvalidKey verifies every key is validated
+func TestNewKeyring_InvalidKey(t *testing.T) {
+	_, err := crypto.NewKeyring(make([]byte, 16))
+	assert.ErrorContains(t, err, "current key")
+
+	_, err = crypto.NewKeyring(newKey(t), newKey(t), make([]byte, 16))
+	assert.ErrorContains(t, err, "previous key 2")
+}
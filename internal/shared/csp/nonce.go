@@ -0,0 +1,37 @@
+// Package csp carries the per-request Content-Security-Policy nonce from the
+// security headers middleware to the templates that render inline code.
+package csp
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+)
+
+type contextKey string
+
+const nonceContextKey contextKey = "csp_nonce"
+
+// NoncePlaceholder is replaced with the request nonce in configured policies.
+const NoncePlaceholder = "{nonce}"
+
+// NewNonce returns a random base64 nonce, fresh for every response.
+func NewNonce() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(b), nil
+}
+
+// WithNonce adds the request nonce to ctx.
+func WithNonce(ctx context.Context, nonce string) context.Context {
+	return context.WithValue(ctx, nonceContextKey, nonce)
+}
+
+// Nonce returns the request nonce, or an empty string when the policy of the
+// request has none.
+func Nonce(ctx context.Context) string {
+	nonce, _ := ctx.Value(nonceContextKey).(string)
+	return nonce
+}
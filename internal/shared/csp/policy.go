@@ -0,0 +1,46 @@
+package csp
+
+import "strings"
+
+// AddSources allows sources in the given directives of policy. A directive the
+// policy lacks is added with the sources of default-src, which it falls back
+// to otherwise. An empty policy stays empty, as it restricts nothing.
+func AddSources(policy string, directives []string, sources ...string) string {
+	policy = strings.TrimRight(strings.TrimSpace(policy), ";")
+	if policy == "" || len(sources) == 0 {
+		return policy
+	}
+
+	var parts [][]string
+	for _, part := range strings.Split(policy, ";") {
+		if fields := strings.Fields(part); len(fields) > 0 {
+			parts = append(parts, fields)
+		}
+	}
+	find := func(directive string) int {
+		for i, fields := range parts {
+			if strings.EqualFold(fields[0], directive) {
+				return i
+			}
+		}
+		return -1
+	}
+
+	for _, directive := range directives {
+		if i := find(directive); i >= 0 {
+			parts[i] = append(parts[i], sources...)
+			continue
+		}
+		fields := []string{directive}
+		if i := find("default-src"); i >= 0 {
+			fields = append(fields, parts[i][1:]...)
+		}
+		parts = append(parts, append(fields, sources...))
+	}
+
+	out := make([]string, len(parts))
+	for i, fields := range parts {
+		out[i] = strings.Join(fields, " ")
+	}
+	return strings.Join(out, "; ")
+}
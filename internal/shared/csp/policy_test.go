@@ -0,0 +1,18 @@
+package csp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddSources(t *testing.T) {
+	policy := "default-src 'self'; script-src 'self' 'nonce-{nonce}';"
+
+	assert.Equal(t,
+		"default-src 'self'; script-src 'self' 'nonce-{nonce}' https://a.example.com; frame-src 'self' https://a.example.com",
+		AddSources(policy, []string{"script-src", "frame-src"}, "https://a.example.com"),
+	)
+	assert.Equal(t, "script-src 'self'", AddSources("script-src 'self'", []string{"script-src"}))
+	assert.Empty(t, AddSources("", []string{"script-src"}, "https://a.example.com"))
+}
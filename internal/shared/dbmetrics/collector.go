@@ -0,0 +1,182 @@
+// Package dbmetrics reports the health of the database as Prometheus metrics:
+// the saturation of the connection pool, the partitions of the tables
+// partitioned by project, and the applied migration version.
+package dbmetrics
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hrz8/altalune"
+	"github.com/hrz8/altalune/internal/postgres"
+	"github.com/lib/pq"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// queryTimeout bounds the queries of a scrape, so a stuck database does not
+// hold the scrape until Prometheus gives up
+const queryTimeout = 5 * time.Second
+
+var (
+	poolMaxOpenDesc = prometheus.NewDesc("altalune_db_pool_max_open_connections",
+		"Maximum number of open connections of the pool, 0 for unlimited.", nil, nil)
+	poolOpenDesc = prometheus.NewDesc("altalune_db_pool_open_connections",
+		"Number of open connections, in use and idle.", nil, nil)
+	poolInUseDesc = prometheus.NewDesc("altalune_db_pool_in_use_connections",
+		"Number of connections in use.", nil, nil)
+	poolIdleDesc = prometheus.NewDesc("altalune_db_pool_idle_connections",
+		"Number of idle connections.", nil, nil)
+	poolSaturationDesc = prometheus.NewDesc("altalune_db_pool_saturation_ratio",
+		"Connections in use over the maximum of the pool, 0 when unlimited.", nil, nil)
+	poolWaitCountDesc = prometheus.NewDesc("altalune_db_pool_wait_count_total",
+		"Number of times a query waited for a free connection.", nil, nil)
+	poolWaitSecondsDesc = prometheus.NewDesc("altalune_db_pool_wait_seconds_total",
+		"Time spent waiting for a free connection.", nil, nil)
+	upDesc = prometheus.NewDesc("altalune_db_up",
+		"Whether the database answered the queries of the last scrape.", nil, nil)
+	partitionsDesc = prometheus.NewDesc("altalune_db_partitions",
+		"Number of partitions of a table partitioned by project.", []string{"table"}, nil)
+	missingPartitionsDesc = prometheus.NewDesc("altalune_db_missing_partitions",
+		"Number of projects without a partition of a table partitioned by project.", []string{"table"}, nil)
+	migrationVersionDesc = prometheus.NewDesc("altalune_db_migration_version",
+		"Version of the latest applied migration.", nil, nil)
+)
+
+// Collector queries the database on every scrape. It implements
+// prometheus.Collector.
+type Collector struct {
+	db              postgres.DB
+	tables          []string
+	migrationsTable string
+	log             altalune.Logger
+}
+
+// NewCollector creates a Collector reporting the partitions of tables and the
+// latest version applied according to migrationsTable.
+func NewCollector(db postgres.DB, tables []string, migrationsTable string, log altalune.Logger) *Collector {
+	return &Collector{
+		db:              db,
+		tables:          tables,
+		migrationsTable: migrationsTable,
+		log:             log,
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	for _, desc := range []*prometheus.Desc{
+		poolMaxOpenDesc, poolOpenDesc, poolInUseDesc, poolIdleDesc, poolSaturationDesc,
+		poolWaitCountDesc, poolWaitSecondsDesc, upDesc,
+		partitionsDesc, missingPartitionsDesc, migrationVersionDesc,
+	} {
+		ch <- desc
+	}
+}
+
+// Collect implements prometheus.Collector. The pool metrics are always
+// reported; the partition and migration ones only when the database answers,
+// which altalune_db_up tells.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	stats := c.db.GetDB().Stats()
+	saturation := 0.0
+	if stats.MaxOpenConnections > 0 {
+		saturation = float64(stats.InUse) / float64(stats.MaxOpenConnections)
+	}
+	ch <- prometheus.MustNewConstMetric(poolMaxOpenDesc, prometheus.GaugeValue, float64(stats.MaxOpenConnections))
+	ch <- prometheus.MustNewConstMetric(poolOpenDesc, prometheus.GaugeValue, float64(stats.OpenConnections))
+	ch <- prometheus.MustNewConstMetric(poolInUseDesc, prometheus.GaugeValue, float64(stats.InUse))
+	ch <- prometheus.MustNewConstMetric(poolIdleDesc, prometheus.GaugeValue, float64(stats.Idle))
+	ch <- prometheus.MustNewConstMetric(poolSaturationDesc, prometheus.GaugeValue, saturation)
+	ch <- prometheus.MustNewConstMetric(poolWaitCountDesc, prometheus.CounterValue, float64(stats.WaitCount))
+	ch <- prometheus.MustNewConstMetric(poolWaitSecondsDesc, prometheus.CounterValue, stats.WaitDuration.Seconds())
+
+	ctx, cancel := context.WithTimeout(context.Background(), queryTimeout)
+	defer cancel()
+
+	partitions, missing, err := c.partitions(ctx)
+	if err != nil {
+		c.log.Error("failed to collect partition metrics", "error", err)
+		ch <- prometheus.MustNewConstMetric(upDesc, prometheus.GaugeValue, 0)
+		return
+	}
+	version, err := c.migrationVersion(ctx)
+	if err != nil {
+		c.log.Error("failed to collect migration metrics", "error", err)
+		ch <- prometheus.MustNewConstMetric(upDesc, prometheus.GaugeValue, 0)
+		return
+	}
+
+	ch <- prometheus.MustNewConstMetric(upDesc, prometheus.GaugeValue, 1)
+	for _, table := range c.tables {
+		ch <- prometheus.MustNewConstMetric(partitionsDesc, prometheus.GaugeValue, float64(partitions[table]), table)
+		ch <- prometheus.MustNewConstMetric(missingPartitionsDesc, prometheus.GaugeValue, float64(missing[table]), table)
+	}
+	ch <- prometheus.MustNewConstMetric(migrationVersionDesc, prometheus.GaugeValue, float64(version))
+}
+
+// partitions returns the number of partitions of every table, and the number
+// of projects missing theirs. Partitions are named <table>_p<project ID>, as
+// the project repository creates them.
+func (c *Collector) partitions(ctx context.Context) (map[string]int64, map[string]int64, error) {
+	partitions := make(map[string]int64, len(c.tables))
+	rows, err := c.db.QueryContext(ctx, `
+		SELECT parent.relname, COUNT(i.inhrelid)
+		FROM pg_class parent
+		LEFT JOIN pg_inherits i ON i.inhparent = parent.oid
+		WHERE parent.relname = ANY($1)
+			AND parent.relkind = 'p'
+			AND pg_table_is_visible(parent.oid)
+		GROUP BY parent.relname
+	`, pq.Array(c.tables))
+	if err != nil {
+		return nil, nil, fmt.Errorf("count partitions: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var table string
+		var count int64
+		if err := rows.Scan(&table, &count); err != nil {
+			return nil, nil, fmt.Errorf("scan partitions: %w", err)
+		}
+		partitions[table] = count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("iterate partitions: %w", err)
+	}
+
+	missing := make(map[string]int64, len(c.tables))
+	missingRows, err := c.db.QueryContext(ctx, `
+		SELECT t.name, COUNT(*)
+		FROM unnest($1::text[]) AS t(name)
+		CROSS JOIN altalune_projects p
+		WHERE to_regclass(t.name || '_p' || p.id) IS NULL
+		GROUP BY t.name
+	`, pq.Array(c.tables))
+	if err != nil {
+		return nil, nil, fmt.Errorf("count missing partitions: %w", err)
+	}
+	defer missingRows.Close()
+	for missingRows.Next() {
+		var table string
+		var count int64
+		if err := missingRows.Scan(&table, &count); err != nil {
+			return nil, nil, fmt.Errorf("scan missing partitions: %w", err)
+		}
+		missing[table] = count
+	}
+	if err := missingRows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("iterate missing partitions: %w", err)
+	}
+
+	return partitions, missing, nil
+}
+
+func (c *Collector) migrationVersion(ctx context.Context) (int64, error) {
+	var version int64
+	query := fmt.Sprintf(`SELECT COALESCE(MAX(version_id), 0) FROM %s WHERE is_applied`, c.migrationsTable)
+	if err := c.db.QueryRowContext(ctx, query).Scan(&version); err != nil {
+		return 0, fmt.Errorf("get migration version: %w", err)
+	}
+	return version, nil
+}
@@ -0,0 +1,47 @@
+package dbmetrics_test
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/hrz8/altalune/internal/shared/dbmetrics"
+	"github.com/hrz8/altalune/logger"
+	_ "github.com/lib/pq"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type unreachableDB struct {
+	*sql.DB
+}
+
+func (db unreachableDB) GetDB() *sql.DB {
+	return db.DB
+}
+
+// TestCollector_Unreachable checks the pool metrics are still reported when
+// the database does not answer, with altalune_db_up telling it
+func TestCollector_Unreachable(t *testing.T) {
+	conn, err := sql.Open("postgres", "postgres://altalune@127.0.0.1:1/altalune?sslmode=disable&connect_timeout=1")
+	require.NoError(t, err)
+	defer conn.Close()
+	conn.SetMaxOpenConns(10)
+
+	collector := dbmetrics.NewCollector(unreachableDB{conn}, []string{"altalune_project_api_keys"}, "altalune_migrations", logger.New("error"))
+
+	err = testutil.CollectAndCompare(collector, strings.NewReader(`
+# HELP altalune_db_pool_max_open_connections Maximum number of open connections of the pool, 0 for unlimited.
+# TYPE altalune_db_pool_max_open_connections gauge
+altalune_db_pool_max_open_connections 10
+# HELP altalune_db_pool_saturation_ratio Connections in use over the maximum of the pool, 0 when unlimited.
+# TYPE altalune_db_pool_saturation_ratio gauge
+altalune_db_pool_saturation_ratio 0
+# HELP altalune_db_up Whether the database answered the queries of the last scrape.
+# TYPE altalune_db_up gauge
+altalune_db_up 0
+`), "altalune_db_pool_max_open_connections", "altalune_db_pool_saturation_ratio", "altalune_db_up")
+	assert.NoError(t, err)
+	assert.Zero(t, testutil.CollectAndCount(collector, "altalune_db_partitions"))
+}
@@ -0,0 +1,186 @@
+// Package emailcheck rejects email addresses that cannot or should not receive
+// the emails of the servers: malformed addresses, domains on the blocklist
+// such as disposable inbox providers, and optionally domains without a mail
+// server.
+package emailcheck
+
+import (
+	"bufio"
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/mail"
+	"os"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrInvalidSyntax is returned for addresses that are not a bare
+	// local@domain address
+	ErrInvalidSyntax = errors.New("emailcheck: invalid email address")
+	// ErrBlockedDomain is returned for addresses of a blocked domain
+	ErrBlockedDomain = errors.New("emailcheck: email domain is blocked")
+	// ErrNoMailServer is returned for domains that accept no email
+	ErrNoMailServer = errors.New("emailcheck: email domain has no mail server")
+)
+
+//go:embed disposable_domains.txt
+var disposableDomains string
+
+// Options configures a Checker
+type Options struct {
+	CheckMX         bool          // Reject domains without a mail server
+	MXTimeout       time.Duration // How long to wait for DNS, after which the domain is accepted
+	BlockDisposable bool          // Block the built-in list of disposable inbox providers
+	BlockedDomains  []string      // Blocked domains besides the built-in list
+	AllowedDomains  []string      // Domains never blocked, overriding the lists
+	BlocklistFile   string        // File of blocked domains, one per line with # comments
+}
+
+// Checker validates email addresses. Blocking a domain blocks its subdomains.
+type Checker struct {
+	blocked   map[string]bool
+	allowed   map[string]bool
+	checkMX   bool
+	mxTimeout time.Duration
+
+	lookupMX   func(ctx context.Context, name string) ([]*net.MX, error)
+	lookupHost func(ctx context.Context, host string) ([]string, error)
+}
+
+// New creates a Checker, reading opts.BlocklistFile when set
+func New(opts Options) (*Checker, error) {
+	c := &Checker{
+		blocked:    make(map[string]bool),
+		allowed:    make(map[string]bool),
+		checkMX:    opts.CheckMX,
+		mxTimeout:  opts.MXTimeout,
+		lookupMX:   net.DefaultResolver.LookupMX,
+		lookupHost: net.DefaultResolver.LookupHost,
+	}
+
+	if opts.BlockDisposable {
+		_ = addDomains(c.blocked, strings.NewReader(disposableDomains))
+	}
+	if opts.BlocklistFile != "" {
+		f, err := os.Open(opts.BlocklistFile)
+		if err != nil {
+			return nil, fmt.Errorf("open email blocklist: %w", err)
+		}
+		defer f.Close()
+		if err := addDomains(c.blocked, f); err != nil {
+			return nil, fmt.Errorf("read email blocklist: %w", err)
+		}
+	}
+	for _, domain := range opts.BlockedDomains {
+		c.blocked[normalizeDomain(domain)] = true
+	}
+	for _, domain := range opts.AllowedDomains {
+		c.allowed[normalizeDomain(domain)] = true
+	}
+
+	return c, nil
+}
+
+// addDomains adds the domains listed in r, one per line, to set
+func addDomains(set map[string]bool, r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		if domain := normalizeDomain(line); domain != "" {
+			set[domain] = true
+		}
+	}
+	return scanner.Err()
+}
+
+// Check returns nil when email may receive emails, or an error wrapping
+// ErrInvalidSyntax, ErrBlockedDomain or ErrNoMailServer. DNS failures other
+// than a missing domain accept the address, so an unreachable resolver does
+// not lock users out.
+func (c *Checker) Check(ctx context.Context, email string) error {
+	domain, err := Domain(email)
+	if err != nil {
+		return err
+	}
+
+	if c.isBlocked(domain) {
+		return fmt.Errorf("%w: %s", ErrBlockedDomain, domain)
+	}
+
+	if c.checkMX && !c.allowed[domain] {
+		return c.checkMailServer(ctx, domain)
+	}
+	return nil
+}
+
+// isBlocked reports whether domain or one of its parents is blocked and none
+// is allowed
+func (c *Checker) isBlocked(domain string) bool {
+	for d := domain; d != ""; {
+		if c.allowed[d] {
+			return false
+		}
+		if c.blocked[d] {
+			return true
+		}
+		_, d, _ = strings.Cut(d, ".")
+	}
+	return false
+}
+
+// checkMailServer looks for the MX records of domain, falling back to its
+// address records as mail servers do (RFC 5321 section 5.1). A null MX record
+// (RFC 7505) declares the domain accepts no email.
+func (c *Checker) checkMailServer(ctx context.Context, domain string) error {
+	if c.mxTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.mxTimeout)
+		defer cancel()
+	}
+
+	records, err := c.lookupMX(ctx, domain)
+	if err == nil && len(records) > 0 {
+		if len(records) == 1 && records[0].Host == "." {
+			return fmt.Errorf("%w: %s", ErrNoMailServer, domain)
+		}
+		return nil
+	}
+	if err != nil && !isNotFound(err) {
+		return nil
+	}
+
+	if _, err := c.lookupHost(ctx, domain); isNotFound(err) {
+		return fmt.Errorf("%w: %s", ErrNoMailServer, domain)
+	}
+	return nil
+}
+
+func isNotFound(err error) bool {
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr) && dnsErr.IsNotFound
+}
+
+// Domain returns the lowercase domain of email, or ErrInvalidSyntax when
+// email is not a bare local@domain address
+func Domain(email string) (string, error) {
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Name != "" || addr.Address != strings.TrimSpace(email) || len(addr.Address) > 254 {
+		return "", ErrInvalidSyntax
+	}
+
+	local, domain, _ := strings.Cut(addr.Address, "@")
+	domain = normalizeDomain(domain)
+	if len(local) > 64 || !strings.Contains(domain, ".") || strings.HasPrefix(domain, "[") {
+		return "", ErrInvalidSyntax
+	}
+	return domain, nil
+}
+
+func normalizeDomain(domain string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(domain)), ".")
+}
@@ -0,0 +1,83 @@
+package emailcheck
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDomain(t *testing.T) {
+	domain, err := Domain("jane@Example.COM")
+	require.NoError(t, err)
+	assert.Equal(t, "example.com", domain)
+
+	for _, email := range []string{"", "jane", "jane@", "Jane <jane@example.com>", "jane@localhost", "jane@[192.0.2.1]", "a@b@example.com"} {
+		_, err := Domain(email)
+		assert.ErrorIs(t, err, ErrInvalidSyntax, email)
+	}
+}
+
+func TestCheckBlocklist(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "blocklist.txt")
+	require.NoError(t, os.WriteFile(file, []byte("# spam\nspam.example # from file\n"), 0o600))
+
+	c, err := New(Options{
+		BlockDisposable: true,
+		BlockedDomains:  []string{"Blocked.Example"},
+		AllowedDomains:  []string{"yopmail.com"},
+		BlocklistFile:   file,
+	})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	assert.NoError(t, c.Check(ctx, "jane@example.com"))
+	assert.ErrorIs(t, c.Check(ctx, "jane@mailinator.com"), ErrBlockedDomain)
+	assert.ErrorIs(t, c.Check(ctx, "jane@eu.mailinator.com"), ErrBlockedDomain, "subdomains are blocked with their domain")
+	assert.ErrorIs(t, c.Check(ctx, "jane@blocked.example"), ErrBlockedDomain)
+	assert.ErrorIs(t, c.Check(ctx, "jane@spam.example"), ErrBlockedDomain)
+	assert.NoError(t, c.Check(ctx, "jane@yopmail.com"), "allowed domains override the lists")
+	assert.ErrorIs(t, c.Check(ctx, "not an email"), ErrInvalidSyntax)
+
+	c, err = New(Options{})
+	require.NoError(t, err)
+	assert.NoError(t, c.Check(ctx, "jane@mailinator.com"), "the built-in list is opt-in")
+
+	_, err = New(Options{BlocklistFile: filepath.Join(t.TempDir(), "missing.txt")})
+	assert.Error(t, err)
+}
+
+func TestCheckMX(t *testing.T) {
+	notFound := &net.DNSError{Err: "no such host", IsNotFound: true}
+	c, err := New(Options{CheckMX: true, AllowedDomains: []string{"allowed.example"}})
+	require.NoError(t, err)
+	c.lookupMX = func(ctx context.Context, name string) ([]*net.MX, error) {
+		switch name {
+		case "mx.example":
+			return []*net.MX{{Host: "mail.mx.example.", Pref: 10}}, nil
+		case "null.example":
+			return []*net.MX{{Host: "."}}, nil
+		case "flaky.example":
+			return nil, &net.DNSError{Err: "timeout", IsTimeout: true}
+		}
+		return nil, notFound
+	}
+	c.lookupHost = func(ctx context.Context, host string) ([]string, error) {
+		if host == "a.example" {
+			return []string{"192.0.2.1"}, nil
+		}
+		return nil, notFound
+	}
+	ctx := context.Background()
+
+	assert.NoError(t, c.Check(ctx, "jane@mx.example"))
+	assert.NoError(t, c.Check(ctx, "jane@a.example"), "address records are the implicit mail server")
+	assert.NoError(t, c.Check(ctx, "jane@flaky.example"), "DNS failures accept the address")
+	assert.NoError(t, c.Check(ctx, "jane@allowed.example"))
+	assert.ErrorIs(t, c.Check(ctx, "jane@null.example"), ErrNoMailServer)
+	assert.ErrorIs(t, c.Check(ctx, "jane@missing.example"), ErrNoMailServer)
+}
@@ -0,0 +1,161 @@
+// Package errorreport sends the unexpected errors of the servers, panics,
+// failed background jobs and the errors logged at error level, to an error
+// tracker such as Sentry.
+package errorreport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/hrz8/altalune/logger"
+)
+
+// Event is an error to report.
+type Event struct {
+	Message string            // What failed, e.g. the log message
+	Err     error             // The error, may be nil
+	Tags    map[string]string // Searchable context, e.g. the job or the RPC procedure
+	Extra   map[string]any    // Other context
+	// Stack holds the program counters of the failing goroutine, as
+	// runtime.Callers returns them. Report captures the caller's when nil.
+	Stack []uintptr
+}
+
+// Reporter sends events to an error tracker.
+type Reporter interface {
+	// Report queues event without blocking the caller. Events are dropped
+	// when the tracker cannot keep up.
+	Report(ctx context.Context, event Event)
+	// Flush waits up to timeout for the queued events to be sent and reports
+	// whether they were.
+	Flush(timeout time.Duration) bool
+}
+
+// Nop is the Reporter used when reporting is disabled.
+type Nop struct{}
+
+func (Nop) Report(context.Context, Event) {}
+
+func (Nop) Flush(time.Duration) bool { return true }
+
+// tagKeys are the log attributes turned into tags rather than extra context
+var tagKeys = map[string]bool{
+	"module":     true,
+	"job":        true,
+	"procedure":  true,
+	"request_id": true,
+	"user_id":    true,
+}
+
+// reportedError marks an error already reported, so that logging it does not
+// report it again
+type reportedError struct {
+	error
+}
+
+func (e reportedError) Unwrap() error { return e.error }
+
+// LogHook returns the hook reporting the records logged at error level with
+// an "error" attribute, the convention of the unexpected failures. Errors of
+// canceled requests and errors already reported are skipped.
+func LogHook(r Reporter) logger.ErrorHook {
+	return func(ctx context.Context, msg string, attrs []slog.Attr) {
+		event := Event{Message: msg, Tags: map[string]string{}, Extra: map[string]any{}}
+		for _, a := range attrs {
+			value := a.Value.Resolve()
+			switch {
+			case a.Key == "error":
+				err, ok := value.Any().(error)
+				if !ok {
+					event.Extra[a.Key] = value.String()
+					continue
+				}
+				event.Err = err
+			case tagKeys[a.Key]:
+				event.Tags[a.Key] = value.String()
+			default:
+				event.Extra[a.Key] = value.Any()
+			}
+		}
+
+		var reported reportedError
+		if event.Err == nil || errors.As(event.Err, &reported) || errors.Is(event.Err, context.Canceled) {
+			return
+		}
+		r.Report(ctx, event)
+	}
+}
+
+// Panic reports value, recovered from a panic, with the stack of the
+// panicking goroutine, and returns it as an error that LogHook skips. It must
+// be called from the deferred function that recovered.
+func Panic(ctx context.Context, r Reporter, value any, tags map[string]string) error {
+	err, ok := value.(error)
+	if !ok {
+		err = fmt.Errorf("%v", value)
+	}
+	err = fmt.Errorf("panic: %w", err)
+
+	r.Report(ctx, Event{Message: err.Error(), Err: err, Tags: tags, Stack: callers(3)})
+	return reportedError{err}
+}
+
+// callers returns the program counters of the stack, skipping skip frames
+// (callers itself being 1)
+func callers(skip int) []uintptr {
+	pcs := make([]uintptr, 64)
+	return pcs[:runtime.Callers(skip+1, pcs)]
+}
+
+// Frame is a function call of a stack trace.
+type Frame struct {
+	Function string
+	Package  string
+	File     string
+	Line     int
+}
+
+// frames resolves pcs, outermost call first, without the frames of the
+// runtime, of the logging and of this package
+func frames(pcs []uintptr) []Frame {
+	var out []Frame
+	iter := runtime.CallersFrames(pcs)
+	for {
+		f, more := iter.Next()
+		pkg, function := splitFunction(f.Function)
+		if !internalPackage(pkg) {
+			out = append(out, Frame{Function: function, Package: pkg, File: f.File, Line: f.Line})
+		}
+		if !more {
+			break
+		}
+	}
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+	return out
+}
+
+// splitFunction splits "github.com/a/b.(*T).M" into "github.com/a/b" and
+// "(*T).M"
+func splitFunction(name string) (pkg, function string) {
+	slash := strings.LastIndex(name, "/")
+	dot := strings.Index(name[slash+1:], ".")
+	if dot < 0 {
+		return "", name
+	}
+	return name[:slash+1+dot], name[slash+1+dot+1:]
+}
+
+func internalPackage(pkg string) bool {
+	switch pkg {
+	case "runtime", "log/slog", "github.com/hrz8/altalune/logger", "github.com/hrz8/altalune/internal/shared/errorreport":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,106 @@
+package errorreport_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hrz8/altalune/internal/shared/errorreport"
+	"github.com/hrz8/altalune/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type sentryServer struct {
+	mu     sync.Mutex
+	events []map[string]any
+	auth   []string
+}
+
+func (s *sentryServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/api/42/envelope/" {
+		http.NotFound(w, r)
+		return
+	}
+	body, _ := io.ReadAll(r.Body)
+	lines := bufio.NewScanner(bytes.NewReader(body))
+	var items []string
+	for lines.Scan() {
+		items = append(items, lines.Text())
+	}
+
+	var event map[string]any
+	if len(items) == 3 && json.Unmarshal([]byte(items[2]), &event) == nil {
+		s.mu.Lock()
+		s.events = append(s.events, event)
+		s.auth = append(s.auth, r.Header.Get("X-Sentry-Auth"))
+		s.mu.Unlock()
+	}
+}
+
+func TestSentry(t *testing.T) {
+	server := &sentryServer{}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	dsn := strings.Replace(ts.URL, "http://", "http://public@", 1) + "/42"
+	reporter, err := errorreport.NewSentry(dsn, errorreport.WithEnvironment("test"), errorreport.WithRelease("v1"))
+	require.NoError(t, err)
+
+	log := logger.NewWithOptions(logger.Options{Level: "error", Output: io.Discard, OnError: errorreport.LogHook(reporter)}).Module("user")
+	ctx := logger.WithAttrs(context.Background(), "request_id", "req-1")
+
+	log.ErrorContext(ctx, "failed to create user", "error", errors.New("connection refused"), "email", "a@example.com")
+	log.Error("no error attribute")
+	log.Warn("not at error level", "error", errors.New("ignored"))
+	log.Error("request canceled", "error", context.Canceled)
+
+	func() {
+		defer func() {
+			err := errorreport.Panic(ctx, reporter, recover(), map[string]string{"job": "digest"})
+			log.Error("job failed", "error", err) // Already reported
+		}()
+		panic("boom")
+	}()
+
+	require.True(t, reporter.Flush(5*time.Second))
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	require.Len(t, server.events, 2)
+	assert.Contains(t, server.auth[0], "sentry_key=public")
+
+	logged := server.events[0]
+	assert.Equal(t, "failed to create user", logged["message"].(map[string]any)["formatted"])
+	assert.Equal(t, "test", logged["environment"])
+	assert.Equal(t, "v1", logged["release"])
+	assert.Equal(t, map[string]any{"module": "user", "request_id": "req-1"}, logged["tags"])
+	assert.Equal(t, map[string]any{"email": "a@example.com"}, logged["extra"])
+	exception := logged["exception"].([]any)[0].(map[string]any)
+	assert.Equal(t, "connection refused", exception["value"])
+	frames := exception["stacktrace"].(map[string]any)["frames"].([]any)
+	require.NotEmpty(t, frames)
+	assert.Equal(t, "TestSentry", frames[len(frames)-1].(map[string]any)["function"], "the frames end at the logging call")
+
+	panicked := server.events[1]
+	assert.Equal(t, "panic: boom", panicked["message"].(map[string]any)["formatted"])
+	assert.Equal(t, map[string]any{"job": "digest"}, panicked["tags"])
+	frames = panicked["exception"].([]any)[0].(map[string]any)["stacktrace"].(map[string]any)["frames"].([]any)
+	assert.Equal(t, "TestSentry.func1", frames[len(frames)-1].(map[string]any)["function"], "the frames end at the panic")
+}
+
+func TestNewSentry_InvalidDSN(t *testing.T) {
+	for _, dsn := range []string{"", "https://o0.ingest.sentry.io/42", "https://key@o0.ingest.sentry.io/"} {
+		_, err := errorreport.NewSentry(dsn)
+		assert.Error(t, err, dsn)
+	}
+}
@@ -0,0 +1,296 @@
+package errorreport
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"runtime/debug"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// sentryQueueSize is how many events wait to be sent before new ones are
+	// dropped
+	sentryQueueSize = 64
+	// appPackage prefixes the frames of this application, shown first by Sentry
+	appPackage = "github.com/hrz8/altalune"
+)
+
+// Sentry reports events to Sentry through its envelope API, from a single
+// background goroutine.
+type Sentry struct {
+	client      *http.Client
+	dsn         string
+	endpoint    string
+	auth        string
+	environment string
+	release     string
+	serverName  string
+
+	queue   chan []byte
+	pending atomic.Int64
+}
+
+// SentryOption configures a Sentry reporter.
+type SentryOption func(*Sentry)
+
+// WithEnvironment tags the events with env, e.g. production.
+func WithEnvironment(env string) SentryOption {
+	return func(s *Sentry) {
+		s.environment = env
+	}
+}
+
+// WithRelease tags the events with release (default: the VCS revision the
+// binary was built from).
+func WithRelease(release string) SentryOption {
+	return func(s *Sentry) {
+		s.release = release
+	}
+}
+
+// WithHTTPClient sets the HTTP client sending the events (default: 10s
+// timeout).
+func WithHTTPClient(client *http.Client) SentryOption {
+	return func(s *Sentry) {
+		s.client = client
+	}
+}
+
+// NewSentry creates a reporter sending to the project of dsn, such as
+// https://<key>@o0.ingest.sentry.io/<project>.
+func NewSentry(dsn string, opts ...SentryOption) (*Sentry, error) {
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("parse sentry DSN: %w", err)
+	}
+	key := u.User.Username()
+	path := strings.Trim(u.Path, "/")
+	slash := strings.LastIndex(path, "/")
+	prefix, project := path[:max(slash, 0)], path[slash+1:]
+	if u.Scheme == "" || u.Host == "" || key == "" || project == "" {
+		return nil, errors.New("invalid sentry DSN: expected https://<key>@<host>/<project>")
+	}
+	if prefix != "" {
+		prefix = "/" + prefix
+	}
+
+	hostname, _ := os.Hostname()
+	s := &Sentry{
+		client:     &http.Client{Timeout: 10 * time.Second},
+		dsn:        dsn,
+		endpoint:   fmt.Sprintf("%s://%s%s/api/%s/envelope/", u.Scheme, u.Host, prefix, project),
+		auth:       "Sentry sentry_version=7, sentry_client=altalune/1.0, sentry_key=" + key,
+		release:    vcsRevision(),
+		serverName: hostname,
+		queue:      make(chan []byte, sentryQueueSize),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	go s.run()
+	return s, nil
+}
+
+// Report implements Reporter.
+func (s *Sentry) Report(_ context.Context, event Event) {
+	if event.Stack == nil {
+		event.Stack = callers(2)
+	}
+	envelope, err := s.envelope(event)
+	if err != nil {
+		return
+	}
+
+	s.pending.Add(1)
+	select {
+	case s.queue <- envelope:
+	default:
+		s.pending.Add(-1) // Sentry is not keeping up
+	}
+}
+
+// Flush implements Reporter.
+func (s *Sentry) Flush(timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for s.pending.Load() > 0 {
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return true
+}
+
+func (s *Sentry) run() {
+	for envelope := range s.queue {
+		s.send(envelope)
+		s.pending.Add(-1)
+	}
+}
+
+// send posts envelope, dropping it on failure: reporting must not retry into
+// an outage of its own
+func (s *Sentry) send(envelope []byte) {
+	req, err := http.NewRequest(http.MethodPost, s.endpoint, bytes.NewReader(envelope))
+	if err != nil {
+		return
+	}
+	req.Header.Set("Content-Type", "application/x-sentry-envelope")
+	req.Header.Set("X-Sentry-Auth", s.auth)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+}
+
+type sentryEvent struct {
+	EventID     string            `json:"event_id"`
+	Timestamp   time.Time         `json:"timestamp"`
+	Platform    string            `json:"platform"`
+	Level       string            `json:"level"`
+	Logger      string            `json:"logger,omitempty"`
+	Message     sentryMessage     `json:"message"`
+	Environment string            `json:"environment,omitempty"`
+	Release     string            `json:"release,omitempty"`
+	ServerName  string            `json:"server_name,omitempty"`
+	Tags        map[string]string `json:"tags,omitempty"`
+	Extra       map[string]any    `json:"extra,omitempty"`
+	Exception   []sentryException `json:"exception,omitempty"`
+}
+
+type sentryMessage struct {
+	Formatted string `json:"formatted"`
+}
+
+type sentryException struct {
+	Type       string           `json:"type"`
+	Value      string           `json:"value"`
+	Stacktrace sentryStacktrace `json:"stacktrace"`
+}
+
+type sentryStacktrace struct {
+	Frames []sentryFrame `json:"frames"`
+}
+
+type sentryFrame struct {
+	Function string `json:"function"`
+	Module   string `json:"module"`
+	AbsPath  string `json:"abs_path"`
+	Lineno   int    `json:"lineno"`
+	InApp    bool   `json:"in_app"`
+}
+
+// envelope encodes event as a Sentry envelope of one event item
+func (s *Sentry) envelope(event Event) ([]byte, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+
+	payload := sentryEvent{
+		EventID:     hex.EncodeToString(id),
+		Timestamp:   time.Now().UTC(),
+		Platform:    "go",
+		Level:       "error",
+		Logger:      event.Tags["module"],
+		Message:     sentryMessage{Formatted: event.Message},
+		Environment: s.environment,
+		Release:     s.release,
+		ServerName:  s.serverName,
+		Tags:        event.Tags,
+		Extra:       make(map[string]any, len(event.Extra)),
+	}
+	for key, value := range event.Extra {
+		payload.Extra[key] = jsonValue(value)
+	}
+
+	var stacktrace sentryStacktrace
+	for _, f := range frames(event.Stack) {
+		stacktrace.Frames = append(stacktrace.Frames, sentryFrame{
+			Function: f.Function,
+			Module:   f.Package,
+			AbsPath:  f.File,
+			Lineno:   f.Line,
+			InApp:    strings.HasPrefix(f.Package, appPackage),
+		})
+	}
+	exception := sentryException{Type: "error", Value: event.Message, Stacktrace: stacktrace}
+	if event.Err != nil {
+		exception.Type = errorType(event.Err)
+		exception.Value = event.Err.Error()
+	}
+	payload.Exception = []sentryException{exception}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	header, _ := json.Marshal(map[string]any{
+		"event_id": payload.EventID,
+		"sent_at":  payload.Timestamp,
+		"dsn":      s.dsn,
+	})
+	item, _ := json.Marshal(map[string]any{"type": "event", "length": len(body)})
+
+	var buf bytes.Buffer
+	for _, line := range [][]byte{header, item, body} {
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes(), nil
+}
+
+// errorType names the type of the innermost error, more telling than the
+// wrappers around it
+func errorType(err error) string {
+	for {
+		next := errors.Unwrap(err)
+		if next == nil {
+			return fmt.Sprintf("%T", err)
+		}
+		err = next
+	}
+}
+
+// jsonValue returns value as JSON encodes it, or its text when it does not
+func jsonValue(value any) any {
+	switch v := value.(type) {
+	case error:
+		return v.Error()
+	case fmt.Stringer:
+		return v.String()
+	}
+	if _, err := json.Marshal(value); err != nil {
+		return fmt.Sprint(value)
+	}
+	return value
+}
+
+// vcsRevision is the revision the binary was built from, empty when unknown
+func vcsRevision() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return ""
+	}
+	for _, setting := range info.Settings {
+		if setting.Key == "vcs.revision" {
+			return setting.Value
+		}
+	}
+	return ""
+}
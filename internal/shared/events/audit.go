@@ -0,0 +1,16 @@
+package events
+
+import (
+	"context"
+
+	"github.com/hrz8/altalune"
+)
+
+// AuditLog logs every event at info level, with its fields, as the audit
+// trail of what happened.
+func AuditLog(log altalune.Logger) Handler {
+	return func(ctx context.Context, event Event) error {
+		log.InfoContext(ctx, "domain event", "event", event.EventName(), "data", event)
+		return nil
+	}
+}
@@ -0,0 +1,98 @@
+// Package events is an in-process bus of domain events.
+//
+// The code changing the state publishes what happened, such as a user
+// registering, and subscribers carry out the side effects, such as emails,
+// webhooks and audit logs. Publishing never fails: the change already
+// happened, so failing subscribers are logged and the others still run.
+package events
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hrz8/altalune"
+)
+
+// Event is something that happened. Events are marshaled to JSON for
+// webhooks, so internal IDs should be omitted from it.
+type Event interface {
+	// EventName is the name subscribers subscribe to, e.g. "user.registered"
+	EventName() string
+}
+
+// Handler handles a published event.
+type Handler func(ctx context.Context, event Event) error
+
+// Bus dispatches published events to their subscribers. Subscribers run
+// synchronously, in the order they subscribed, on the goroutine of the
+// publisher; long work should be handed to a goroutine.
+type Bus struct {
+	log altalune.Logger
+
+	mu       sync.RWMutex
+	handlers map[string][]Handler
+	all      []Handler
+}
+
+// NewBus creates a bus without subscribers.
+func NewBus(log altalune.Logger) *Bus {
+	return &Bus{log: log, handlers: make(map[string][]Handler)}
+}
+
+// Subscribe calls handler for every event named name.
+func (b *Bus) Subscribe(name string, handler Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers[name] = append(b.handlers[name], handler)
+}
+
+// SubscribeAll calls handler for every event.
+func (b *Bus) SubscribeAll(handler Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.all = append(b.all, handler)
+}
+
+// On subscribes handler to the events of type E. The name is taken from the
+// zero value of E, so EventName must not depend on the fields.
+func On[E Event](b *Bus, handler func(ctx context.Context, event E) error) {
+	var zero E
+	b.Subscribe(zero.EventName(), func(ctx context.Context, event Event) error {
+		typed, ok := event.(E)
+		if !ok {
+			return fmt.Errorf("event %s is a %T", event.EventName(), event)
+		}
+		return handler(ctx, typed)
+	})
+}
+
+// Publish calls the subscribers of event. Failing subscribers are logged at
+// warn level and panicking ones at error level. A nil bus drops the event.
+func (b *Bus) Publish(ctx context.Context, event Event) {
+	if b == nil {
+		return
+	}
+
+	name := event.EventName()
+	b.mu.RLock()
+	handlers := make([]Handler, 0, len(b.handlers[name])+len(b.all))
+	handlers = append(handlers, b.handlers[name]...)
+	handlers = append(handlers, b.all...)
+	b.mu.RUnlock()
+
+	for _, handler := range handlers {
+		b.call(ctx, handler, event)
+	}
+}
+
+func (b *Bus) call(ctx context.Context, handler Handler, event Event) {
+	defer func() {
+		if v := recover(); v != nil {
+			b.log.ErrorContext(ctx, "event subscriber panicked", "error", fmt.Errorf("panic: %v", v), "event", event.EventName())
+		}
+	}()
+	if err := handler(ctx, event); err != nil {
+		b.log.WarnContext(ctx, "event subscriber failed", "error", err, "event", event.EventName())
+	}
+}
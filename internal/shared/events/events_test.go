@@ -0,0 +1,88 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hrz8/altalune/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type signedUp struct {
+	Email string `json:"email"`
+}
+
+func (signedUp) EventName() string { return "user.signed_up" }
+
+type loggedOut struct{}
+
+func (loggedOut) EventName() string { return "user.logged_out" }
+
+func TestBus(t *testing.T) {
+	bus := NewBus(logger.New("error"))
+	var calls []string
+
+	On(bus, func(_ context.Context, e signedUp) error {
+		calls = append(calls, "typed "+e.Email)
+		return errors.New("mail server down")
+	})
+	bus.Subscribe("user.signed_up", func(context.Context, Event) error {
+		panic("boom")
+	})
+	bus.SubscribeAll(func(_ context.Context, e Event) error {
+		calls = append(calls, "all "+e.EventName())
+		return nil
+	})
+
+	bus.Publish(context.Background(), signedUp{Email: "a@example.com"})
+	bus.Publish(context.Background(), loggedOut{})
+	assert.Equal(t, []string{
+		"typed a@example.com", // Failing and panicking subscribers do not stop the others
+		"all user.signed_up",
+		"all user.logged_out",
+	}, calls)
+
+	var nilBus *Bus
+	assert.NotPanics(t, func() { nilBus.Publish(context.Background(), loggedOut{}) })
+}
+
+func TestWebhook(t *testing.T) {
+	received := make(chan *http.Request, 1)
+	bodies := make(chan []byte, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received <- r
+		bodies <- body
+	}))
+	defer srv.Close()
+
+	bus := NewBus(logger.New("error"))
+	bus.SubscribeAll(NewWebhook(srv.URL, "s3cret", logger.New("error")).Handle)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	bus.Publish(ctx, signedUp{Email: "a@example.com"})
+	cancel() // Deliveries outlive the request publishing the event
+
+	select {
+	case r := <-received:
+		body := <-bodies
+		assert.Equal(t, Sign("s3cret", body), r.Header.Get(SignatureHeader))
+
+		var payload struct {
+			Event string   `json:"event"`
+			Data  signedUp `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(body, &payload))
+		assert.Equal(t, "user.signed_up", payload.Event)
+		assert.Equal(t, "a@example.com", payload.Data.Email)
+	case <-time.After(5 * time.Second):
+		t.Fatal("webhook not delivered")
+	}
+}
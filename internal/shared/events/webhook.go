@@ -0,0 +1,94 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hrz8/altalune"
+)
+
+// SignatureHeader carries the hex HMAC-SHA256 of the webhook body, keyed with
+// the webhook secret, prefixed with "sha256="
+const SignatureHeader = "X-Altalune-Signature"
+
+// webhookTimeout bounds a webhook delivery
+const webhookTimeout = 10 * time.Second
+
+// webhookPayload is the body POSTed for an event
+type webhookPayload struct {
+	Event      string    `json:"event"`
+	OccurredAt time.Time `json:"occurred_at"`
+	Data       Event     `json:"data"`
+}
+
+// Webhook POSTs events as JSON to a URL.
+type Webhook struct {
+	url    string
+	secret string
+	client *http.Client
+	log    altalune.Logger
+}
+
+// NewWebhook creates a webhook subscriber posting to url. Bodies are signed
+// with secret when set.
+func NewWebhook(url, secret string, log altalune.Logger) *Webhook {
+	return &Webhook{
+		url:    url,
+		secret: secret,
+		client: &http.Client{Timeout: webhookTimeout},
+		log:    log,
+	}
+}
+
+// Handle delivers event in the background, so slow receivers do not hold up
+// the publisher. Failed deliveries are logged and not retried.
+func (w *Webhook) Handle(ctx context.Context, event Event) error {
+	body, err := json.Marshal(webhookPayload{Event: event.EventName(), OccurredAt: time.Now().UTC(), Data: event})
+	if err != nil {
+		return fmt.Errorf("encode event: %w", err)
+	}
+
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		if err := w.post(ctx, body); err != nil {
+			w.log.WarnContext(ctx, "failed to deliver event webhook", "error", err, "event", event.EventName())
+		}
+	}()
+	return nil
+}
+
+func (w *Webhook) post(ctx context.Context, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if w.secret != "" {
+		req.Header.Set(SignatureHeader, Sign(w.secret, body))
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("post webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook responded %s", resp.Status)
+	}
+	return nil
+}
+
+// Sign returns the SignatureHeader value of body, for receivers to compare
+// with hmac.Equal.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,150 @@
+// Package filestore keeps the files the server generates, such as query
+// exports, out of the database.
+//
+// Files are written as a stream and only become visible once committed, so a
+// reader never sees a partial file. Disk keeps them in a local directory; an
+// object store can stand in for it behind Store.
+package filestore
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ErrNotFound is returned when a file does not exist.
+var ErrNotFound = errors.New("file not found")
+
+// Store keeps files by key.
+type Store interface {
+	// Create starts writing the file of key, replacing it once committed.
+	Create(key string) (Writer, error)
+	// Open returns the committed file of key, ErrNotFound when there is none.
+	Open(key string) (File, error)
+	// Delete removes the file of key, if any.
+	Delete(key string) error
+}
+
+// Writer writes a file. Exactly one of Commit and Abort must be called.
+type Writer interface {
+	io.Writer
+	// Commit stores the file written.
+	Commit() error
+	// Abort discards the file written.
+	Abort() error
+}
+
+// File is a stored file, readable from any offset.
+type File interface {
+	io.ReadSeekCloser
+	Size() int64
+}
+
+// Disk is a Store keeping files in a directory of the local disk. Files are
+// written to a temporary file renamed on commit, which is atomic.
+type Disk struct {
+	dir string
+}
+
+var _ Store = (*Disk)(nil)
+
+// NewDisk creates a Disk store in dir, which is created with the first file.
+func NewDisk(dir string) *Disk {
+	return &Disk{dir: dir}
+}
+
+func (d *Disk) Create(key string) (Writer, error) {
+	path, err := d.path(key)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(d.dir, 0o700); err != nil {
+		return nil, fmt.Errorf("create file store directory: %w", err)
+	}
+	f, err := os.CreateTemp(d.dir, ".tmp-*")
+	if err != nil {
+		return nil, fmt.Errorf("create file: %w", err)
+	}
+	return &diskWriter{File: f, path: path}, nil
+}
+
+func (d *Disk) Open(key string) (File, error) {
+	path, err := d.path(key)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("open file: %w", err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("stat file: %w", err)
+	}
+	return &diskFile{File: f, size: info.Size()}, nil
+}
+
+func (d *Disk) Delete(key string) error {
+	path, err := d.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("delete file: %w", err)
+	}
+	return nil
+}
+
+// path returns the path of the file of key, refusing keys leaving the
+// directory
+func (d *Disk) path(key string) (string, error) {
+	if key == "" || strings.HasPrefix(key, ".") || strings.ContainsAny(key, `/\`) {
+		return "", fmt.Errorf("invalid file key %q", key)
+	}
+	return filepath.Join(d.dir, key), nil
+}
+
+type diskWriter struct {
+	*os.File
+	path string
+}
+
+func (w *diskWriter) Commit() error {
+	if err := w.File.Sync(); err != nil {
+		w.Abort()
+		return fmt.Errorf("sync file: %w", err)
+	}
+	if err := w.File.Close(); err != nil {
+		os.Remove(w.File.Name())
+		return fmt.Errorf("close file: %w", err)
+	}
+	if err := os.Rename(w.File.Name(), w.path); err != nil {
+		os.Remove(w.File.Name())
+		return fmt.Errorf("commit file: %w", err)
+	}
+	return nil
+}
+
+func (w *diskWriter) Abort() error {
+	w.File.Close()
+	if err := os.Remove(w.File.Name()); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("discard file: %w", err)
+	}
+	return nil
+}
+
+type diskFile struct {
+	*os.File
+	size int64
+}
+
+func (f *diskFile) Size() int64 {
+	return f.size
+}
@@ -0,0 +1,57 @@
+package filestore
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDisk(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "exports")
+	store := NewDisk(dir)
+
+	w, err := store.Create("export.csv")
+	require.NoError(t, err)
+	_, err = io.WriteString(w, "id,name\n")
+	require.NoError(t, err)
+
+	_, err = store.Open("export.csv")
+	assert.ErrorIs(t, err, ErrNotFound, "files are only visible once committed")
+
+	require.NoError(t, w.Commit())
+	f, err := store.Open("export.csv")
+	require.NoError(t, err)
+	content, err := io.ReadAll(f)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	assert.Equal(t, "id,name\n", string(content))
+	assert.Equal(t, int64(len(content)), f.Size())
+
+	aborted, err := store.Create("aborted.csv")
+	require.NoError(t, err)
+	_, err = io.WriteString(aborted, "partial")
+	require.NoError(t, err)
+	require.NoError(t, aborted.Abort())
+	_, err = store.Open("aborted.csv")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, store.Delete("export.csv"))
+	require.NoError(t, store.Delete("export.csv"), "deleting a missing file is not an error")
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, entries, "no temporary file is left behind")
+}
+
+func TestDiskRefusesKeysLeavingTheDirectory(t *testing.T) {
+	store := NewDisk(t.TempDir())
+
+	for _, key := range []string{"", "../secret", "a/b", `a\b`, ".tmp-1"} {
+		_, err := store.Open(key)
+		assert.Error(t, err, key)
+		assert.NotErrorIs(t, err, ErrNotFound, key)
+	}
+}
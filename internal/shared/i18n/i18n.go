@@ -0,0 +1,140 @@
+// Package i18n localizes user-facing auth server text.
+//
+// Messages are keyed by their English source text (or by a stable code such as
+// an OAuth error code), so untranslated keys render as-is. Each catalog in
+// locales/ maps keys to translated text; the English catalog only holds
+// entries whose key is a code rather than readable text.
+package i18n
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// DefaultLocale is the source language of every message.
+const DefaultLocale = "en"
+
+//go:embed locales/*.json
+var localesFS embed.FS
+
+var (
+	catalogs map[string]map[string]string
+	locales  []string
+	matcher  language.Matcher
+)
+
+func init() {
+	catalogs = make(map[string]map[string]string)
+
+	entries, err := localesFS.ReadDir("locales")
+	if err != nil {
+		panic(fmt.Sprintf("i18n: read locales: %v", err))
+	}
+	for _, entry := range entries {
+		raw, err := localesFS.ReadFile(path.Join("locales", entry.Name()))
+		if err != nil {
+			panic(fmt.Sprintf("i18n: read %s: %v", entry.Name(), err))
+		}
+		messages := make(map[string]string)
+		if err := json.Unmarshal(raw, &messages); err != nil {
+			panic(fmt.Sprintf("i18n: parse %s: %v", entry.Name(), err))
+		}
+		catalogs[strings.TrimSuffix(entry.Name(), ".json")] = messages
+	}
+	if _, ok := catalogs[DefaultLocale]; !ok {
+		catalogs[DefaultLocale] = map[string]string{}
+	}
+
+	// The default locale goes first so it wins when nothing matches
+	locales = []string{DefaultLocale}
+	for locale := range catalogs {
+		if locale != DefaultLocale {
+			locales = append(locales, locale)
+		}
+	}
+	sort.Strings(locales[1:])
+
+	tags := make([]language.Tag, 0, len(locales))
+	for _, locale := range locales {
+		tags = append(tags, language.MustParse(locale))
+	}
+	matcher = language.NewMatcher(tags)
+}
+
+// Locales returns the supported locales, default locale first.
+func Locales() []string {
+	return append([]string(nil), locales...)
+}
+
+// IsSupported reports whether a catalog exists for locale.
+func IsSupported(locale string) bool {
+	_, ok := catalogs[locale]
+	return ok
+}
+
+// T translates key into locale, falling back to the default locale and then
+// to the key itself. Args are applied with fmt.Sprintf.
+func T(locale, key string, args ...any) string {
+	msg, ok := catalogs[locale][key]
+	if !ok {
+		if msg, ok = catalogs[DefaultLocale][key]; !ok {
+			msg = key
+		}
+	}
+	if len(args) > 0 {
+		return fmt.Sprintf(msg, args...)
+	}
+	return msg
+}
+
+// Negotiate picks the supported locale that best matches an Accept-Language
+// header. When the header matches nothing, fallback is used if supported,
+// otherwise the default locale.
+func Negotiate(acceptLanguage, fallback string) string {
+	if !IsSupported(fallback) {
+		fallback = DefaultLocale
+	}
+
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(tags) == 0 {
+		return fallback
+	}
+
+	_, index, confidence := matcher.Match(tags...)
+	if confidence == language.No {
+		return fallback
+	}
+	return locales[index]
+}
+
+// NegotiateUILocales picks the supported locale that best matches an OpenID
+// Connect ui_locales parameter, a space-separated list of language tags in
+// order of preference. Malformed tags are skipped; when nothing matches,
+// fallback is used if supported, otherwise the default locale.
+func NegotiateUILocales(uiLocales, fallback string) string {
+	if !IsSupported(fallback) {
+		fallback = DefaultLocale
+	}
+
+	var tags []language.Tag
+	for _, field := range strings.Fields(uiLocales) {
+		if tag, err := language.Parse(field); err == nil {
+			tags = append(tags, tag)
+		}
+	}
+	if len(tags) == 0 {
+		return fallback
+	}
+
+	_, index, confidence := matcher.Match(tags...)
+	if confidence == language.No {
+		return fallback
+	}
+	return locales[index]
+}
@@ -0,0 +1,69 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		fallback       string
+		want           string
+	}{
+		{"exact match", "id", "en", "id"},
+		{"regional variant", "id-ID,id;q=0.9", "en", "id"},
+		{"quality order", "fr;q=0.9,id;q=0.8,en;q=0.1", "en", "id"},
+		{"no match uses fallback", "fr-FR", "id", "id"},
+		{"empty header uses fallback", "", "id", "id"},
+		{"unsupported fallback", "fr-FR", "xx", DefaultLocale},
+		{"malformed header", "@@@", "en", "en"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Negotiate(tt.acceptLanguage, tt.fallback))
+		})
+	}
+}
+
+func TestNegotiateUILocales(t *testing.T) {
+	tests := []struct {
+		name      string
+		uiLocales string
+		fallback  string
+		want      string
+	}{
+		{"exact match", "id", "en", "id"},
+		{"preference order", "fr-CA id en", "en", "id"},
+		{"regional variant", "id-ID", "en", "id"},
+		{"malformed tags skipped", "@@@ id", "en", "id"},
+		{"no match uses fallback", "fr de", "id", "id"},
+		{"empty uses fallback", "", "id", "id"},
+		{"unsupported fallback", "fr", "xx", DefaultLocale},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, NegotiateUILocales(tt.uiLocales, tt.fallback))
+		})
+	}
+}
+
+func TestT(t *testing.T) {
+	assert.Equal(t, "Masuk ke Acme", T("id", "Sign in to %s", "Acme"))
+	assert.Equal(t, "Sign in to Acme", T("en", "Sign in to %s", "Acme"))
+	// Codes fall back to the English catalog, unknown keys to themselves
+	assert.Equal(t, "Invalid request", T("xx", "invalid_request"))
+	assert.Equal(t, "access_denied", T("id", "access_denied"))
+}
+
+func TestLocales(t *testing.T) {
+	locales := Locales()
+	assert.Equal(t, DefaultLocale, locales[0])
+	assert.Contains(t, locales, "id")
+	assert.True(t, IsSupported("id"))
+	assert.False(t, IsSupported("xx"))
+}
@@ -1,6 +1,8 @@
 package jwt
 
 import (
+	"strings"
+
 	"github.com/golang-jwt/jwt/v5"
 )
 
@@ -10,7 +12,47 @@ type AccessTokenClaims struct {
 	Scope         string            `json:"scope,omitempty"`
 	Email         string            `json:"email,omitempty"`
 	Name          string            `json:"name,omitempty"`
-	Perms         []string          `json:"perms"`
-	Memberships   map[string]string `json:"memberships,omitempty"` // project_public_id -> role
+	Perms         []string          `json:"perms,omitempty"`
+	Roles         []string          `json:"roles,omitempty"`          // Role names standing for the permissions they grant
+	PermsOmitted  bool              `json:"perms_omitted,omitempty"`  // Permissions left out, to be introspected
+	PermsOverflow bool              `json:"perms_overflow,omitempty"` // Permissions cut to fit the size cap
+	Memberships   map[string]string `json:"memberships,omitempty"`    // project_public_id -> role
 	EmailVerified bool              `json:"email_verified"`
 }
+
+// HasAllPerms reports whether perms lists every permission of the user.
+// Otherwise resource servers introspect the token for the full list.
+func (c *AccessTokenClaims) HasAllPerms() bool {
+	return !c.PermsOmitted && !c.PermsOverflow && len(c.Roles) == 0
+}
+
+// PermsClaimMode is how an access token carries the permissions of its user,
+// set per OAuth client for gateways limiting header sizes.
+type PermsClaimMode string
+
+const (
+	// PermsClaimFull lists every permission in perms
+	PermsClaimFull PermsClaimMode = "full"
+	// PermsClaimOmit leaves perms out and sets perms_omitted
+	PermsClaimOmit PermsClaimMode = "omit"
+	// PermsClaimRoles lists the role names in roles and only the directly
+	// granted permissions in perms
+	PermsClaimRoles PermsClaimMode = "roles"
+)
+
+// PermsClaimModes lists every mode
+var PermsClaimModes = []PermsClaimMode{PermsClaimFull, PermsClaimOmit, PermsClaimRoles}
+
+// ParsePermsClaimMode parses a mode, ignoring case. Empty is PermsClaimFull.
+func ParsePermsClaimMode(value string) (PermsClaimMode, bool) {
+	if value == "" {
+		return PermsClaimFull, true
+	}
+	mode := PermsClaimMode(strings.ToLower(value))
+	for _, m := range PermsClaimModes {
+		if m == mode {
+			return mode, true
+		}
+	}
+	return "", false
+}
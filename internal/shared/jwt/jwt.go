@@ -2,6 +2,7 @@ package jwt
 
 import (
 	"crypto/rsa"
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -56,12 +57,16 @@ func (s *Signer) GetIssuer() string {
 
 // GenerateTokenParams holds parameters for access token generation.
 type GenerateTokenParams struct {
+	Issuer        string            // Overrides the signer's issuer when set
 	UserPublicID  string            // User's public_id (nanoid) - used as JWT subject
 	ClientID      string            // OAuth client ID (UUID string)
 	Scope         string            // Space-separated OAuth scopes
 	Email         string            // User email (if scope includes "email")
 	Name          string            // User full name (if scope includes "profile")
 	Perms         []string          // User permissions for stateless authorization
+	Roles         []string          // Role names standing for permissions left out of Perms
+	PermsOmitted  bool              // Whether Perms was left out for introspection
+	PermsMaxBytes int               // Cap on the encoded perms claim, 0 for none
 	Memberships   map[string]string // Project memberships: project_public_id -> role
 	EmailVerified bool              // Whether user's email is verified
 	Expiry        time.Duration     // Token validity duration
@@ -71,9 +76,16 @@ type GenerateTokenParams struct {
 func (s *Signer) GenerateAccessToken(params GenerateTokenParams) (string, error) {
 	now := time.Now()
 
+	issuer := s.issuer
+	if params.Issuer != "" {
+		issuer = params.Issuer
+	}
+
+	perms, overflow := capPerms(params.Perms, params.PermsMaxBytes)
+
 	claims := AccessTokenClaims{
 		RegisteredClaims: jwt.RegisteredClaims{
-			Issuer:    s.issuer,
+			Issuer:    issuer,
 			Subject:   params.UserPublicID,
 			Audience:  jwt.ClaimStrings{params.ClientID},
 			ExpiresAt: jwt.NewNumericDate(now.Add(params.Expiry)),
@@ -84,7 +96,10 @@ func (s *Signer) GenerateAccessToken(params GenerateTokenParams) (string, error)
 		Scope:         params.Scope,
 		Email:         params.Email,
 		Name:          params.Name,
-		Perms:         params.Perms,
+		Perms:         perms,
+		Roles:         params.Roles,
+		PermsOmitted:  params.PermsOmitted,
+		PermsOverflow: overflow,
 		Memberships:   params.Memberships,
 		EmailVerified: params.EmailVerified,
 	}
@@ -95,6 +110,27 @@ func (s *Signer) GenerateAccessToken(params GenerateTokenParams) (string, error)
 	return token.SignedString(s.privateKey)
 }
 
+// capPerms returns the leading permissions whose JSON array fits in maxBytes,
+// and whether some were cut. maxBytes 0 keeps them all.
+func capPerms(perms []string, maxBytes int) ([]string, bool) {
+	if maxBytes <= 0 {
+		return perms, false
+	}
+
+	size := len("[]")
+	for i, perm := range perms {
+		encoded, _ := json.Marshal(perm)
+		size += len(encoded)
+		if i > 0 {
+			size += len(",")
+		}
+		if size > maxBytes {
+			return perms[:i], true
+		}
+	}
+	return perms, false
+}
+
 // ValidateAccessToken parses and validates a JWT access token.
 func (s *Signer) ValidateAccessToken(tokenString string) (*AccessTokenClaims, error) {
 	token, err := jwt.ParseWithClaims(tokenString, &AccessTokenClaims{}, func(token *jwt.Token) (any, error) {
@@ -97,9 +97,9 @@ func SavePublicKeyPEM(key *rsa.PublicKey, filepath string) error {
 		return fmt.Errorf("marshal public key: %w", err)
 	}
 
-	// Create PEM block
+	// Create PEM block, labelled as PKIX so that LoadPublicKeyPEM can read it
 	block := &pem.Block{
-		Type:  "RSA PUBLIC KEY",
+		Type:  "PUBLIC KEY",
 		Bytes: publicKeyBytes,
 	}
 
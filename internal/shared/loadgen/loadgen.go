@@ -0,0 +1,121 @@
+// Package loadgen drives an operation at a fixed rate and summarizes how it
+// performed.
+//
+// Calls are started by a ticker and handed to a fixed pool of workers. A tick
+// finding every worker busy is counted as dropped instead of queued, so a
+// saturated target shows up as a lower throughput and dropped calls rather
+// than as latencies hiding the queueing time.
+package loadgen
+
+import (
+	"context"
+	"math"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Op is one call of the operation under load. Its error, if any, is counted
+// by message so that it should not contain per-call details.
+type Op func(ctx context.Context) error
+
+// Options configure a run.
+type Options struct {
+	Workers  int           // Concurrent callers
+	Rate     int           // Calls started per second
+	Duration time.Duration // How long calls are started
+}
+
+// Report summarizes a run.
+type Report struct {
+	Calls    int            // Completed calls
+	Failures map[string]int // Failed calls per error message
+	Dropped  int            // Calls not started because every worker was busy
+	Elapsed  time.Duration
+
+	latencies []time.Duration // Sorted
+}
+
+// Run calls op at opts.Rate from opts.Workers workers for opts.Duration, or
+// until ctx is done, and waits for the calls in flight.
+func Run(ctx context.Context, opts Options, op Op) *Report {
+	report := &Report{Failures: make(map[string]int)}
+	var mu sync.Mutex
+
+	calls := make(chan struct{})
+	var wg sync.WaitGroup
+	for range opts.Workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range calls {
+				start := time.Now()
+				err := op(ctx)
+				latency := time.Since(start)
+
+				mu.Lock()
+				report.Calls++
+				report.latencies = append(report.latencies, latency)
+				if err != nil {
+					report.Failures[err.Error()]++
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	start := time.Now()
+	ticker := time.NewTicker(time.Second / time.Duration(opts.Rate))
+	defer ticker.Stop()
+	deadline := time.NewTimer(opts.Duration)
+	defer deadline.Stop()
+
+loop:
+	for {
+		select {
+		case <-ctx.Done():
+			break loop
+		case <-deadline.C:
+			break loop
+		case <-ticker.C:
+			select {
+			case calls <- struct{}{}:
+			default:
+				report.Dropped++
+			}
+		}
+	}
+	close(calls)
+	wg.Wait()
+
+	report.Elapsed = time.Since(start)
+	slices.Sort(report.latencies)
+	return report
+}
+
+// Errors returns the number of failed calls.
+func (r *Report) Errors() int {
+	n := 0
+	for _, count := range r.Failures {
+		n += count
+	}
+	return n
+}
+
+// Throughput returns the completed calls per second.
+func (r *Report) Throughput() float64 {
+	if r.Elapsed <= 0 {
+		return 0
+	}
+	return float64(r.Calls) / r.Elapsed.Seconds()
+}
+
+// Percentile returns the latency under which p percent of the calls
+// completed, using the nearest-rank method, or zero without calls.
+func (r *Report) Percentile(p float64) time.Duration {
+	if len(r.latencies) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p / 100 * float64(len(r.latencies))))
+	return r.latencies[min(max(rank, 1), len(r.latencies))-1]
+}
@@ -0,0 +1,49 @@
+package loadgen
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	var n atomic.Int64
+	report := Run(context.Background(), Options{Workers: 4, Rate: 200, Duration: 200 * time.Millisecond}, func(ctx context.Context) error {
+		if n.Add(1)%4 == 0 {
+			return errors.New("401 invalid_client")
+		}
+		return nil
+	})
+
+	assert.InDelta(t, 40, report.Calls+report.Dropped, 10, "calls are started at the rate")
+	assert.Equal(t, report.Calls/4, report.Failures["401 invalid_client"])
+	assert.Equal(t, report.Calls/4, report.Errors())
+	assert.GreaterOrEqual(t, report.Elapsed, 200*time.Millisecond)
+}
+
+func TestRunDropsCallsWhenWorkersAreBusy(t *testing.T) {
+	report := Run(context.Background(), Options{Workers: 1, Rate: 100, Duration: 100 * time.Millisecond}, func(ctx context.Context) error {
+		time.Sleep(50 * time.Millisecond)
+		return nil
+	})
+
+	assert.LessOrEqual(t, report.Calls, 3)
+	assert.Positive(t, report.Dropped)
+}
+
+func TestPercentile(t *testing.T) {
+	r := &Report{}
+	assert.Zero(t, r.Percentile(50))
+
+	for i := 1; i <= 100; i++ {
+		r.latencies = append(r.latencies, time.Duration(i)*time.Millisecond)
+	}
+	assert.Equal(t, time.Millisecond, r.Percentile(0))
+	assert.Equal(t, 50*time.Millisecond, r.Percentile(50))
+	assert.Equal(t, 99*time.Millisecond, r.Percentile(99))
+	assert.Equal(t, 100*time.Millisecond, r.Percentile(100))
+}
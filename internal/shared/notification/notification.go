@@ -30,6 +30,83 @@ type OTPEmailData struct {
 	ExpiryMinutes int
 }
 
+// SecretRevealCodeEmailData contains data for secret reveal code email templates.
+type SecretRevealCodeEmailData struct {
+	UserName      string
+	ProviderName  string // Sign-in provider whose client secret is revealed
+	Code          string
+	ExpiryMinutes int
+}
+
+// ApprovalRequestEmailData contains data for approval request email templates.
+type ApprovalRequestEmailData struct {
+	OwnerName   string
+	UserName    string
+	UserEmail   string
+	RequestedAt string // When the user signed up, in the project's time zone
+}
+
+// DigestEmailData contains data for digest email templates. Times are
+// rendered in the project's time zone.
+type DigestEmailData struct {
+	OwnerName     string
+	ProjectName   string
+	Frequency     string // daily or weekly
+	PeriodStart   string
+	PeriodEnd     string
+	NewMembers    int64
+	TokensIssued  int64
+	FailedLogins  int64
+	LockedMembers int64
+	ExpiringKeys  []DigestExpiringKey
+}
+
+// DigestExpiringKey is an API key listed in a digest as expiring soon.
+type DigestExpiringKey struct {
+	Name       string
+	Expiration string
+}
+
+// TokenAlertEmailData contains data for token alert email templates.
+type TokenAlertEmailData struct {
+	Hour   string // The hour the alerts are about, in UTC
+	Alerts []TokenAlertEmailItem
+}
+
+// TokenAlertEmailItem is an anomaly listed in a token alert.
+type TokenAlertEmailItem struct {
+	ClientName string
+	ClientID   string // Public ID of the client, empty if it was purged
+	Summary    string
+}
+
+// OwnershipTransferEmailData contains data for ownership transfer email templates.
+type OwnershipTransferEmailData struct {
+	RecipientName string
+	ProjectName   string
+	FromName      string // The owner who initiated the transfer
+	FromEmail     string
+	ToName        string // The member receiving ownership
+	ToEmail       string
+	ExpiresAt     string // When the transfer lapses, in the project's time zone
+}
+
+// ApiKeyPolicyEmailData contains data for API key policy email templates.
+type ApiKeyPolicyEmailData struct {
+	OwnerName       string
+	ProjectName     string
+	MaxLifetimeDays int
+	Disabled        bool // Whether the keys were disabled rather than flagged
+	Keys            []ApiKeyPolicyEmailKey
+}
+
+// ApiKeyPolicyEmailKey is an API key listed in an API key policy email.
+type ApiKeyPolicyEmailKey struct {
+	Name       string
+	CreatedAt  string // In the project's time zone
+	Expiration string // In the project's time zone
+}
+
 // NewNotificationService creates a new notification service with embedded templates.
 func NewNotificationService(sender email.EmailSender, baseURL string) (*NotificationService, error) {
 	// Parse HTML templates
@@ -98,6 +175,117 @@ func (n *NotificationService) SendOTPEmail(ctx context.Context, toEmail, otp, us
 	return nil
 }
 
+// SendApprovalRequestEmail tells a project owner that a new registration awaits approval.
+func (n *NotificationService) SendApprovalRequestEmail(ctx context.Context, toEmail string, data ApprovalRequestEmailData) error {
+	htmlBody, textBody, err := n.renderTemplates("approval_request", data)
+	if err != nil {
+		return fmt.Errorf("failed to render approval request templates: %w", err)
+	}
+
+	if err := n.emailSender.SendEmail(ctx, toEmail, "A new registration awaits your approval", htmlBody, textBody); err != nil {
+		return fmt.Errorf("failed to send approval request email: %w", err)
+	}
+
+	return nil
+}
+
+// SendDigestEmail sends a project owner the summary of their project's activity.
+func (n *NotificationService) SendDigestEmail(ctx context.Context, toEmail string, data DigestEmailData) error {
+	htmlBody, textBody, err := n.renderTemplates("digest", data)
+	if err != nil {
+		return fmt.Errorf("failed to render digest templates: %w", err)
+	}
+
+	subject := fmt.Sprintf("Your %s digest for %s", data.Frequency, data.ProjectName)
+	if err := n.emailSender.SendEmail(ctx, toEmail, subject, htmlBody, textBody); err != nil {
+		return fmt.Errorf("failed to send digest email: %w", err)
+	}
+
+	return nil
+}
+
+// SendTokenAlertEmail sends the anomalies found in the token requests of the
+// OAuth clients in an hour.
+func (n *NotificationService) SendTokenAlertEmail(ctx context.Context, toEmail string, data TokenAlertEmailData) error {
+	htmlBody, textBody, err := n.renderTemplates("token_alert", data)
+	if err != nil {
+		return fmt.Errorf("failed to render token alert templates: %w", err)
+	}
+
+	subject := fmt.Sprintf("Token request alerts for %s", data.Hour)
+	if err := n.emailSender.SendEmail(ctx, toEmail, subject, htmlBody, textBody); err != nil {
+		return fmt.Errorf("failed to send token alert email: %w", err)
+	}
+
+	return nil
+}
+
+// SendOwnershipTransferRequestEmail asks a project member to accept the
+// ownership of a project.
+func (n *NotificationService) SendOwnershipTransferRequestEmail(ctx context.Context, toEmail string, data OwnershipTransferEmailData) error {
+	htmlBody, textBody, err := n.renderTemplates("ownership_transfer_request", data)
+	if err != nil {
+		return fmt.Errorf("failed to render ownership transfer request templates: %w", err)
+	}
+
+	subject := fmt.Sprintf("%s wants to transfer the ownership of %s to you", data.FromName, data.ProjectName)
+	if err := n.emailSender.SendEmail(ctx, toEmail, subject, htmlBody, textBody); err != nil {
+		return fmt.Errorf("failed to send ownership transfer request email: %w", err)
+	}
+
+	return nil
+}
+
+// SendOwnershipTransferCompleteEmail tells a party of an ownership transfer
+// that it was accepted.
+func (n *NotificationService) SendOwnershipTransferCompleteEmail(ctx context.Context, toEmail string, data OwnershipTransferEmailData) error {
+	htmlBody, textBody, err := n.renderTemplates("ownership_transfer_complete", data)
+	if err != nil {
+		return fmt.Errorf("failed to render ownership transfer complete templates: %w", err)
+	}
+
+	subject := fmt.Sprintf("The ownership of %s was transferred", data.ProjectName)
+	if err := n.emailSender.SendEmail(ctx, toEmail, subject, htmlBody, textBody); err != nil {
+		return fmt.Errorf("failed to send ownership transfer complete email: %w", err)
+	}
+
+	return nil
+}
+
+// SendApiKeyPolicyEmail tells a project owner about the API keys outside the
+// project's maximum API key lifetime.
+func (n *NotificationService) SendApiKeyPolicyEmail(ctx context.Context, toEmail string, data ApiKeyPolicyEmailData) error {
+	htmlBody, textBody, err := n.renderTemplates("api_key_policy", data)
+	if err != nil {
+		return fmt.Errorf("failed to render api key policy templates: %w", err)
+	}
+
+	subject := fmt.Sprintf("API keys out of policy in %s", data.ProjectName)
+	if data.Disabled {
+		subject = fmt.Sprintf("API keys disabled in %s", data.ProjectName)
+	}
+	if err := n.emailSender.SendEmail(ctx, toEmail, subject, htmlBody, textBody); err != nil {
+		return fmt.Errorf("failed to send api key policy email: %w", err)
+	}
+
+	return nil
+}
+
+// SendSecretRevealCodeEmail sends the code confirming a client secret reveal
+// to the user who asked for it.
+func (n *NotificationService) SendSecretRevealCodeEmail(ctx context.Context, toEmail string, data SecretRevealCodeEmailData) error {
+	htmlBody, textBody, err := n.renderTemplates("secret_reveal_code", data)
+	if err != nil {
+		return fmt.Errorf("failed to render secret reveal code templates: %w", err)
+	}
+
+	if err := n.emailSender.SendEmail(ctx, toEmail, "Your client secret reveal code", htmlBody, textBody); err != nil {
+		return fmt.Errorf("failed to send secret reveal code email: %w", err)
+	}
+
+	return nil
+}
+
 // renderTemplates renders both HTML and text versions of a template.
 func (n *NotificationService) renderTemplates(name string, data any) (string, string, error) {
 	var htmlBuf, textBuf bytes.Buffer
@@ -96,3 +96,202 @@ func TestSendOTPEmail(t *testing.T) {
 		t.Error("Text body should contain OTP code")
 	}
 }
+
+func TestSendApprovalRequestEmail(t *testing.T) {
+	sender := &mockEmailSender{}
+	svc, err := NewNotificationService(sender, "http://localhost:3300")
+	if err != nil {
+		t.Fatalf("Failed to create notification service: %v", err)
+	}
+
+	err = svc.SendApprovalRequestEmail(context.Background(), "owner@example.com", ApprovalRequestEmailData{
+		OwnerName:   "Olive Owner",
+		UserName:    "Jane Doe",
+		UserEmail:   "jane@example.com",
+		RequestedAt: "Mon, 02 Mar 2026 03:00 WIB",
+	})
+	if err != nil {
+		t.Fatalf("Failed to send approval request email: %v", err)
+	}
+
+	if sender.lastTo != "owner@example.com" {
+		t.Errorf("Expected to=owner@example.com, got %s", sender.lastTo)
+	}
+	if sender.lastSubject != "A new registration awaits your approval" {
+		t.Errorf("Expected subject='A new registration awaits your approval', got %s", sender.lastSubject)
+	}
+	for _, body := range []string{sender.lastHTML, sender.lastText} {
+		if !strings.Contains(body, "Olive Owner") || !strings.Contains(body, "Jane Doe") || !strings.Contains(body, "jane@example.com") {
+			t.Errorf("Body should contain owner name, user name and email:\n%s", body)
+		}
+		if !strings.Contains(body, "Mon, 02 Mar 2026 03:00 WIB") {
+			t.Errorf("Body should contain the sign up time:\n%s", body)
+		}
+	}
+}
+
+func TestSendDigestEmail(t *testing.T) {
+	sender := &mockEmailSender{}
+	svc, err := NewNotificationService(sender, "http://localhost:3300")
+	if err != nil {
+		t.Fatalf("Failed to create notification service: %v", err)
+	}
+
+	err = svc.SendDigestEmail(context.Background(), "owner@example.com", DigestEmailData{
+		OwnerName:    "Olive Owner",
+		ProjectName:  "Acme",
+		Frequency:    "weekly",
+		PeriodStart:  "Mon, 23 Feb 2026 00:00 WIB",
+		PeriodEnd:    "Mon, 02 Mar 2026 00:00 WIB",
+		NewMembers:   3,
+		TokensIssued: 42,
+		ExpiringKeys: []DigestExpiringKey{{Name: "billing-sync", Expiration: "Thu, 05 Mar 2026 00:00 WIB"}},
+	})
+	if err != nil {
+		t.Fatalf("Failed to send digest email: %v", err)
+	}
+
+	if sender.lastSubject != "Your weekly digest for Acme" {
+		t.Errorf("Expected subject='Your weekly digest for Acme', got %s", sender.lastSubject)
+	}
+	for _, body := range []string{sender.lastHTML, sender.lastText} {
+		if !strings.Contains(body, "Olive Owner") || !strings.Contains(body, "Mon, 23 Feb 2026 00:00 WIB") {
+			t.Errorf("Body should contain owner name and period:\n%s", body)
+		}
+		if !strings.Contains(body, "42") || !strings.Contains(body, "billing-sync") {
+			t.Errorf("Body should contain the counts and expiring keys:\n%s", body)
+		}
+	}
+}
+
+func TestSendTokenAlertEmail(t *testing.T) {
+	sender := &mockEmailSender{}
+	svc, err := NewNotificationService(sender, "http://localhost:3300")
+	if err != nil {
+		t.Fatalf("Failed to create notification service: %v", err)
+	}
+
+	err = svc.SendTokenAlertEmail(context.Background(), "ops@example.com", TokenAlertEmailData{
+		Hour:   "Mon, 02 Mar 2026 14:00 UTC",
+		Alerts: []TokenAlertEmailItem{{ClientName: "Billing", ClientID: "abcdefghijklmn", Summary: "80% of 50 token requests failed"}},
+	})
+	if err != nil {
+		t.Fatalf("Failed to send token alert email: %v", err)
+	}
+
+	if sender.lastSubject != "Token request alerts for Mon, 02 Mar 2026 14:00 UTC" {
+		t.Errorf("Expected subject='Token request alerts for Mon, 02 Mar 2026 14:00 UTC', got %s", sender.lastSubject)
+	}
+	for _, body := range []string{sender.lastHTML, sender.lastText} {
+		if !strings.Contains(body, "Billing") || !strings.Contains(body, "80% of 50 token requests failed") {
+			t.Errorf("Body should contain the client and the alert:\n%s", body)
+		}
+	}
+}
+
+func TestSendOwnershipTransferEmails(t *testing.T) {
+	sender := &mockEmailSender{}
+	svc, err := NewNotificationService(sender, "http://localhost:3300")
+	if err != nil {
+		t.Fatalf("Failed to create notification service: %v", err)
+	}
+
+	data := OwnershipTransferEmailData{
+		RecipientName: "Jane Doe",
+		ProjectName:   "Billing",
+		FromName:      "John Doe",
+		FromEmail:     "john@example.com",
+		ToName:        "Jane Doe",
+		ToEmail:       "jane@example.com",
+		ExpiresAt:     "Mon, 09 Mar 2026 14:00 UTC",
+	}
+
+	if err := svc.SendOwnershipTransferRequestEmail(context.Background(), "jane@example.com", data); err != nil {
+		t.Fatalf("Failed to send ownership transfer request email: %v", err)
+	}
+	if sender.lastSubject != "John Doe wants to transfer the ownership of Billing to you" {
+		t.Errorf("Expected subject='John Doe wants to transfer the ownership of Billing to you', got %s", sender.lastSubject)
+	}
+	for _, body := range []string{sender.lastHTML, sender.lastText} {
+		if !strings.Contains(body, "john@example.com") || !strings.Contains(body, "Mon, 09 Mar 2026 14:00 UTC") {
+			t.Errorf("Body should contain the initiator and the expiry:\n%s", body)
+		}
+	}
+
+	if err := svc.SendOwnershipTransferCompleteEmail(context.Background(), "john@example.com", data); err != nil {
+		t.Fatalf("Failed to send ownership transfer complete email: %v", err)
+	}
+	if sender.lastSubject != "The ownership of Billing was transferred" {
+		t.Errorf("Expected subject='The ownership of Billing was transferred', got %s", sender.lastSubject)
+	}
+	for _, body := range []string{sender.lastHTML, sender.lastText} {
+		if !strings.Contains(body, "jane@example.com") || !strings.Contains(body, "Billing") {
+			t.Errorf("Body should contain the new owner and the project:\n%s", body)
+		}
+	}
+}
+
+func TestSendApiKeyPolicyEmail(t *testing.T) {
+	sender := &mockEmailSender{}
+	svc, err := NewNotificationService(sender, "http://localhost:3300")
+	if err != nil {
+		t.Fatalf("Failed to create notification service: %v", err)
+	}
+
+	data := ApiKeyPolicyEmailData{
+		OwnerName:       "Jane",
+		ProjectName:     "Billing",
+		MaxLifetimeDays: 90,
+		Keys:            []ApiKeyPolicyEmailKey{{Name: "Legacy", CreatedAt: "Mon, 02 Mar 2026 14:00 UTC", Expiration: "Tue, 02 Mar 2027 14:00 UTC"}},
+	}
+
+	if err := svc.SendApiKeyPolicyEmail(context.Background(), "jane@example.com", data); err != nil {
+		t.Fatalf("Failed to send api key policy email: %v", err)
+	}
+	if sender.lastSubject != "API keys out of policy in Billing" {
+		t.Errorf("Expected subject='API keys out of policy in Billing', got %s", sender.lastSubject)
+	}
+	for _, body := range []string{sender.lastHTML, sender.lastText} {
+		if !strings.Contains(body, "Legacy") || !strings.Contains(body, "90 days") {
+			t.Errorf("Body should contain the key and the maximum lifetime:\n%s", body)
+		}
+	}
+
+	data.Disabled = true
+	if err := svc.SendApiKeyPolicyEmail(context.Background(), "jane@example.com", data); err != nil {
+		t.Fatalf("Failed to send api key policy email: %v", err)
+	}
+	if sender.lastSubject != "API keys disabled in Billing" {
+		t.Errorf("Expected subject='API keys disabled in Billing', got %s", sender.lastSubject)
+	}
+	if !strings.Contains(sender.lastText, "were disabled") {
+		t.Errorf("Body should say the keys were disabled:\n%s", sender.lastText)
+	}
+}
+
+func TestSendSecretRevealCodeEmail(t *testing.T) {
+	sender := &mockEmailSender{}
+	svc, err := NewNotificationService(sender, "http://localhost:3300")
+	if err != nil {
+		t.Fatalf("Failed to create notification service: %v", err)
+	}
+
+	err = svc.SendSecretRevealCodeEmail(context.Background(), "jane@example.com", SecretRevealCodeEmailData{
+		UserName:      "Jane",
+		ProviderName:  "Google",
+		Code:          "482913",
+		ExpiryMinutes: 5,
+	})
+	if err != nil {
+		t.Fatalf("Failed to send secret reveal code email: %v", err)
+	}
+
+	if sender.lastSubject != "Your client secret reveal code" {
+		t.Errorf("Expected subject='Your client secret reveal code', got %s", sender.lastSubject)
+	}
+	for _, body := range []string{sender.lastHTML, sender.lastText} {
+		if !strings.Contains(body, "482913") || !strings.Contains(body, "Google") {
+			t.Errorf("Body should contain the code and the provider:\n%s", body)
+		}
+	}
+}
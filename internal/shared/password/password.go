@@ -2,6 +2,8 @@ package password
 
 import (
 	"errors"
+
+	"github.com/hrz8/altalune"
 )
 
 var (
@@ -27,3 +29,14 @@ type HashOption struct {
 	Threads    uint8  // Parallelism (p)
 	Len        uint32 // Hash length in bytes
 }
+
+// OptionFromConfig returns the hashing parameters configured in
+// security.passwordHashing
+func OptionFromConfig(cfg altalune.Config) HashOption {
+	return HashOption{
+		Iterations: cfg.GetPasswordHashIterations(),
+		Memory:     cfg.GetPasswordHashMemory(),
+		Threads:    cfg.GetPasswordHashThreads(),
+		Len:        cfg.GetPasswordHashLength(),
+	}
+}
@@ -191,3 +191,51 @@ func TestHashPasswordProducesUniqueHashes(t *testing.T) {
 		t.Error("both hashes should verify correctly")
 	}
 }
+
+func TestInspectHash(t *testing.T) {
+	opt := HashOption{Iterations: 3, Memory: 32 * 1024, Threads: 2, Len: 64}
+	hash, err := HashPassword("mysecretpassword", opt)
+	if err != nil {
+		t.Fatalf("HashPassword failed: %v", err)
+	}
+
+	info, err := InspectHash(hash)
+	if err != nil {
+		t.Fatalf("InspectHash returned an unexpected error: %v", err)
+	}
+	if info.Algorithm != "argon2id" || info.HashOption != opt || info.SaltLen != saltLen {
+		t.Errorf("unexpected hash info: %+v", info)
+	}
+
+	if _, err := InspectHash("not-a-hash"); err != ErrInvalidHashedString {
+		t.Errorf("expected ErrInvalidHashedString, got %v", err)
+	}
+}
+
+func TestNeedsRehash(t *testing.T) {
+	opt := HashOption{Iterations: 2, Memory: 32 * 1024, Threads: 2, Len: 32}
+	hash, err := HashPassword("mysecretpassword", opt)
+	if err != nil {
+		t.Fatalf("HashPassword failed: %v", err)
+	}
+
+	if NeedsRehash(hash, opt) {
+		t.Error("expected no rehash with the parameters the hash was made with")
+	}
+
+	stronger := opt
+	stronger.Iterations = 3
+	if !NeedsRehash(hash, stronger) {
+		t.Error("expected rehash when the iterations changed")
+	}
+
+	stronger = opt
+	stronger.Memory = 64 * 1024
+	if !NeedsRehash(hash, stronger) {
+		t.Error("expected rehash when the memory changed")
+	}
+
+	if NeedsRehash("not-a-hash", opt) {
+		t.Error("expected no rehash of an invalid hash")
+	}
+}
@@ -67,3 +67,37 @@ func VerifyPassword(password, encodedHash string) (bool, error) {
 	}
 	return false, nil
 }
+
+// HashInfo describes the parameters of an encoded hash
+type HashInfo struct {
+	Algorithm string
+	Version   int
+	HashOption
+	SaltLen int // Salt length in bytes
+}
+
+// InspectHash returns the parameters of a PHC format hash without verifying
+// anything against it
+func InspectHash(encodedHash string) (*HashInfo, error) {
+	o, salt, _, err := decodeHash(encodedHash)
+	if err != nil {
+		return nil, err
+	}
+	return &HashInfo{
+		Algorithm:  strings.Split(encodedHash, "$")[1],
+		Version:    argon2.Version,
+		HashOption: *o,
+		SaltLen:    len(salt),
+	}, nil
+}
+
+// NeedsRehash reports whether encodedHash was made with parameters other than
+// opt, so the password should be hashed again with opt once verified. Hashes
+// that cannot be decoded are reported as not needing it: they never verify.
+func NeedsRehash(encodedHash string, opt HashOption) bool {
+	o, salt, _, err := decodeHash(encodedHash)
+	if err != nil {
+		return false
+	}
+	return *o != opt || len(salt) != saltLen
+}
@@ -5,6 +5,7 @@ type QueryParamsBuilder struct {
 	keyword    string
 	filters    map[string][]string
 	sorting    *SortingParams
+	count      CountMode
 }
 
 func NewQueryParamsBuilder() *QueryParamsBuilder {
@@ -38,11 +39,17 @@ func (b *QueryParamsBuilder) WithSorting(field string, order SortOrder) *QueryPa
 	return b
 }
 
+func (b *QueryParamsBuilder) WithCount(mode CountMode) *QueryParamsBuilder {
+	b.count = mode
+	return b
+}
+
 func (b *QueryParamsBuilder) Build() *QueryParams {
 	return &QueryParams{
 		Pagination: b.pagination,
 		Keyword:    b.keyword,
 		Filters:    b.filters,
 		Sorting:    b.sorting,
+		Count:      b.count,
 	}
 }
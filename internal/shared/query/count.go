@@ -0,0 +1,125 @@
+package query
+
+import altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+
+// CountMode is how a query counts the rows it matches. Exact counts scan every
+// matching row, which gets slow on large tables.
+type CountMode int
+
+const (
+	CountDefault   CountMode = iota // The server default
+	CountExact                      // COUNT(*) over the matching rows
+	CountEstimated                  // The planner's estimate
+	CountNone                       // No count, only whether another page follows
+)
+
+// Count is the number of rows a query matches, as far as its mode tells
+type Count struct {
+	Rows int64
+	Mode CountMode
+}
+
+// ParseCountMode maps a configured count mode: exact, estimated or none
+func ParseCountMode(mode string) CountMode {
+	switch mode {
+	case "exact":
+		return CountExact
+	case "estimated":
+		return CountEstimated
+	case "none":
+		return CountNone
+	default:
+		return CountDefault
+	}
+}
+
+func CountModeFromProto(mode altalunev1.CountMode) CountMode {
+	switch mode {
+	case altalunev1.CountMode_COUNT_MODE_EXACT:
+		return CountExact
+	case altalunev1.CountMode_COUNT_MODE_ESTIMATED:
+		return CountEstimated
+	case altalunev1.CountMode_COUNT_MODE_NONE:
+		return CountNone
+	default:
+		return CountDefault
+	}
+}
+
+// CountModeToProto maps the mode of a result, CountDefault meaning an exact
+// count.
+func CountModeToProto(mode CountMode) altalunev1.CountMode {
+	switch mode {
+	case CountEstimated:
+		return altalunev1.CountMode_COUNT_MODE_ESTIMATED
+	case CountNone:
+		return altalunev1.CountMode_COUNT_MODE_NONE
+	default:
+		return altalunev1.CountMode_COUNT_MODE_EXACT
+	}
+}
+
+// PageLimit is the LIMIT fetching a page of params: one row over the page
+// size, telling FillPage whether another page follows.
+func (p *QueryParams) PageLimit() int32 {
+	return p.Pagination.PageSize + 1
+}
+
+// Offset is the OFFSET fetching a page of params
+func (p *QueryParams) Offset() int32 {
+	return (p.Pagination.Page - 1) * p.Pagination.PageSize
+}
+
+// FetchOffset is the OFFSET the repositories fetch a page of params with: none
+// past a Keyset, which starts the page already, and Offset otherwise.
+func (p *QueryParams) FetchOffset() int32 {
+	if p.Keyset != nil && p.Keyset.AfterID != 0 {
+		return 0
+	}
+	return p.Offset()
+}
+
+// FillPage trims result.Data, fetched with PageLimit, to the page of params
+// and fills in the totals of result from count.
+//
+// Without a count, the totals cover the rows up to this page and one more page
+// when another follows. Estimates are corrected with what the page tells: they
+// become exact on the last page and cover at least the rows seen so far.
+func FillPage[T any](result *QueryResult[T], params *QueryParams, count Count) {
+	page := params.Pagination.Page
+	pageSize := params.Pagination.PageSize
+	offset := int64(params.Offset())
+
+	result.HasMore = int32(len(result.Data)) > pageSize
+	if result.HasMore {
+		result.Data = result.Data[:pageSize]
+	}
+	seen := offset + int64(len(result.Data))
+
+	rows := count.Rows
+	result.Count = count.Mode
+	switch count.Mode {
+	case CountNone:
+		rows = seen
+	case CountEstimated:
+		switch {
+		case result.HasMore:
+			rows = max(rows, seen+1)
+		case len(result.Data) > 0 || page == 1:
+			rows = seen
+			result.Count = CountExact
+		default:
+			// Past the last page: every row is before this one
+			rows = min(rows, offset)
+		}
+	}
+
+	result.TotalRows = int32(rows)
+	result.TotalPages = 0
+	if rows > 0 {
+		result.TotalPages = int32((rows + int64(pageSize) - 1) / int64(pageSize))
+	}
+	if count.Mode == CountNone && result.HasMore {
+		result.TotalPages = page + 1
+	}
+}
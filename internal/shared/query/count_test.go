@@ -0,0 +1,67 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func fetched(n int) []*int {
+	rows := make([]*int, n)
+	for i := range rows {
+		rows[i] = new(int)
+	}
+	return rows
+}
+
+func TestFillPageExact(t *testing.T) {
+	params := NewQueryParamsBuilder().WithPagination(2, 10).Build()
+
+	result := &QueryResult[int]{Data: fetched(11)}
+	FillPage(result, params, Count{Rows: 35, Mode: CountExact})
+	assert.Len(t, result.Data, 10)
+	assert.True(t, result.HasMore)
+	assert.Equal(t, int32(35), result.TotalRows)
+	assert.Equal(t, int32(4), result.TotalPages)
+}
+
+func TestFillPageNone(t *testing.T) {
+	params := NewQueryParamsBuilder().WithPagination(2, 10).Build()
+
+	result := &QueryResult[int]{Data: fetched(11)}
+	FillPage(result, params, Count{Mode: CountNone})
+	assert.True(t, result.HasMore)
+	assert.Equal(t, int32(20), result.TotalRows)
+	assert.Equal(t, int32(3), result.TotalPages)
+
+	result = &QueryResult[int]{Data: fetched(4)}
+	FillPage(result, params, Count{Mode: CountNone})
+	assert.False(t, result.HasMore)
+	assert.Equal(t, int32(14), result.TotalRows)
+	assert.Equal(t, int32(2), result.TotalPages)
+	assert.Equal(t, CountNone, result.Count)
+}
+
+func TestFillPageEstimated(t *testing.T) {
+	params := NewQueryParamsBuilder().WithPagination(2, 10).Build()
+
+	// An underestimate still covers the rows seen
+	result := &QueryResult[int]{Data: fetched(11)}
+	FillPage(result, params, Count{Rows: 12, Mode: CountEstimated})
+	assert.Equal(t, int32(21), result.TotalRows)
+	assert.Equal(t, CountEstimated, result.Count)
+
+	// The last page makes it exact
+	result = &QueryResult[int]{Data: fetched(3)}
+	FillPage(result, params, Count{Rows: 500, Mode: CountEstimated})
+	assert.Equal(t, int32(13), result.TotalRows)
+	assert.Equal(t, int32(2), result.TotalPages)
+	assert.Equal(t, CountExact, result.Count)
+
+	// Past the last page, every row is on the pages before
+	params = NewQueryParamsBuilder().WithPagination(5, 10).Build()
+	result = &QueryResult[int]{Data: fetched(0)}
+	FillPage(result, params, Count{Rows: 500, Mode: CountEstimated})
+	assert.Equal(t, int32(40), result.TotalRows)
+	assert.Equal(t, int32(4), result.TotalPages)
+}
@@ -0,0 +1,120 @@
+package query
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ErrInvalidCursor is returned for a cursor that was not issued for the query
+// it is sent with
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// cursor is the position of a page, encoded opaquely so clients scrolling
+// through results do not depend on how pages are found. It carries a digest
+// of the query, rejecting cursors replayed with other filters or another
+// order.
+//
+// A cursor holds the Keyset of the last row sent, so the next page starts
+// right after it whatever rows were written in between: none is returned
+// twice or skipped. The page number only numbers the page for its totals.
+type cursor struct {
+	Page     int32  `json:"p"`
+	PageSize int32  `json:"s"`
+	Query    string `json:"q"`
+	SortKey  string `json:"k,omitempty"`
+	AfterID  int64  `json:"i,omitempty"`
+}
+
+// NextCursor returns the cursor of the page following result, fetched with
+// params, or an empty string on the last page
+func NextCursor[T any](params *QueryParams, result *QueryResult[T]) string {
+	if result == nil || !result.HasMore {
+		return ""
+	}
+	c := cursor{
+		Page:     params.Pagination.Page + 1,
+		PageSize: params.Pagination.PageSize,
+		Query:    params.digest(),
+	}
+	if result.NextKeyset != nil {
+		c.SortKey, c.AfterID = result.NextKeyset.SortKey, result.NextKeyset.AfterID
+	}
+	body, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(body)
+}
+
+// ApplyCursor moves params to the page of cursor, after the row it names,
+// leaving them as is when cursor is empty. It must be called once every field
+// but the pagination is set, as the cursor only applies to the query it was
+// issued for.
+func (p *QueryParams) ApplyCursor(encoded string) error {
+	if encoded == "" {
+		return nil
+	}
+	body, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return ErrInvalidCursor
+	}
+	var c cursor
+	if err := json.Unmarshal(body, &c); err != nil || c.Page < 1 || c.AfterID < 0 {
+		return ErrInvalidCursor
+	}
+	if c.PageSize != p.Pagination.PageSize || c.Query != p.digest() {
+		return fmt.Errorf("%w: the query changed since it was issued", ErrInvalidCursor)
+	}
+	p.Pagination.Page = c.Page
+	if c.AfterID != 0 {
+		p.Keyset = &Keyset{SortKey: c.SortKey, AfterID: c.AfterID}
+	}
+	return nil
+}
+
+// digest identifies the rows and the order of params, whatever the page
+func (p *QueryParams) digest() string {
+	h := sha256.New()
+	write := func(s string) {
+		_ = binary.Write(h, binary.BigEndian, uint32(len(s)))
+		h.Write([]byte(s))
+	}
+
+	write(p.Keyword)
+	fields := make([]string, 0, len(p.Filters))
+	for field := range p.Filters {
+		fields = append(fields, field)
+	}
+	slices.Sort(fields)
+	for _, field := range fields {
+		write(field)
+		values := slices.Clone(p.Filters[field])
+		slices.Sort(values)
+		write(strings.Join(values, "\x00"))
+	}
+	if p.Sorting != nil {
+		write(p.Sorting.Field)
+		write(string(p.Sorting.Order))
+	}
+	if p.Trashed {
+		write("trashed")
+	}
+	return hex.EncodeToString(h.Sum(nil)[:8])
+}
+
+// ExactTotal reports whether TotalRows is the exact number of matching rows:
+// always with an exact count, and with the other modes once no page follows
+func (r *QueryResult[T]) ExactTotal() bool {
+	switch r.Count {
+	case CountEstimated:
+		return false
+	case CountNone:
+		return !r.HasMore
+	default:
+		return true
+	}
+}
@@ -0,0 +1,61 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor(t *testing.T) {
+	params := func(page int32) *QueryParams {
+		return NewQueryParamsBuilder().
+			WithPagination(page, 10).
+			WithKeyword("ann").
+			WithFilter("status", []string{"active", "pending"}).
+			WithSorting("name", SortOrderDesc).
+			Build()
+	}
+
+	first := params(1)
+	result := &QueryResult[int]{Data: fetched(11)}
+	FillPage(result, first, Count{Mode: CountNone})
+	result.NextKeyset = &Keyset{SortKey: "Zoe", AfterID: 42}
+	cursor := NextCursor(first, result)
+	require.NotEmpty(t, cursor)
+	assert.False(t, result.ExactTotal())
+
+	next := params(1)
+	require.NoError(t, next.ApplyCursor(cursor))
+	assert.Equal(t, int32(2), next.Pagination.Page)
+	assert.Equal(t, &Keyset{SortKey: "Zoe", AfterID: 42}, next.Keyset, "the next page starts after the last row sent")
+	assert.Zero(t, next.FetchOffset(), "no row is skipped past the keyset")
+	assert.Equal(t, int32(10), next.Offset(), "the totals still count the rows of the previous pages")
+
+	result = &QueryResult[int]{Data: fetched(3)}
+	FillPage(result, next, Count{Mode: CountNone})
+	assert.Empty(t, NextCursor(next, result), "no cursor past the last page")
+	assert.True(t, result.ExactTotal(), "the last page tells the total")
+
+	reordered := NewQueryParamsBuilder().
+		WithPagination(1, 10).
+		WithKeyword("ann").
+		WithFilter("status", []string{"pending", "active"}).
+		WithSorting("name", SortOrderDesc).
+		Build()
+	assert.NoError(t, reordered.ApplyCursor(cursor), "filter values are a set")
+
+	changed := params(1)
+	changed.Keyword = "bob"
+	assert.ErrorIs(t, changed.ApplyCursor(cursor), ErrInvalidCursor)
+
+	resized := NewQueryParamsBuilder().WithPagination(1, 20).WithKeyword("ann").Build()
+	assert.ErrorIs(t, resized.ApplyCursor(cursor), ErrInvalidCursor)
+
+	resorted := params(1)
+	resorted.Sorting.Order = SortOrderAsc
+	assert.ErrorIs(t, resorted.ApplyCursor(cursor), ErrInvalidCursor, "a keyset only holds in its own order")
+
+	assert.ErrorIs(t, params(1).ApplyCursor("not a cursor"), ErrInvalidCursor)
+	assert.NoError(t, params(1).ApplyCursor(""))
+}
@@ -0,0 +1,74 @@
+package query
+
+import (
+	"encoding/csv"
+	"io"
+	"strings"
+)
+
+// ExportPageSize is the page size exports fetch their rows with
+const ExportPageSize = 500
+
+// ExportCSV writes every row matching params as CSV to w, a header row first
+// and then the cells record returns for each row. Rows are fetched page by
+// page with StreamPages in the order of params, from the first page whatever
+// their pagination and cursor, and counted once with an estimate: after each
+// page, progress gets the rows written so far and the rows matching, exact
+// once the last page is written.
+// Cells starting with a spreadsheet formula character are prefixed with a
+// single quote so they open as plain text.
+func ExportCSV[T any](
+	params *QueryParams,
+	fetch func(params *QueryParams) (*QueryResult[T], error),
+	header []string,
+	record func(row *T) []string,
+	w io.Writer,
+	progress func(written, total int32) error,
+) error {
+	params.Pagination = PaginationParams{Page: 1, PageSize: ExportPageSize}
+	params.Keyset = nil
+	params.Count = CountEstimated
+
+	out := csv.NewWriter(w)
+	if err := out.Write(header); err != nil {
+		return err
+	}
+
+	var written, estimate int32
+	return StreamPages(params, fetch, func(result *QueryResult[T], first bool) error {
+		for _, row := range result.Data {
+			cells := record(row)
+			for i, cell := range cells {
+				cells[i] = escapeFormula(cell)
+			}
+			if err := out.Write(cells); err != nil {
+				return err
+			}
+		}
+		out.Flush()
+		if err := out.Error(); err != nil {
+			return err
+		}
+
+		written += int32(len(result.Data))
+		if first {
+			estimate = result.TotalRows
+		}
+		if progress == nil {
+			return nil
+		}
+		total := max(estimate, written)
+		if !result.HasMore {
+			total = written
+		}
+		return progress(written, total)
+	})
+}
+
+// escapeFormula neutralizes CSV formula injection in a cell
+func escapeFormula(cell string) string {
+	if cell != "" && strings.ContainsRune("=+-@\t\r", rune(cell[0])) {
+		return "'" + cell
+	}
+	return cell
+}
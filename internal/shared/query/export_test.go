@@ -0,0 +1,56 @@
+package query
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportCSV(t *testing.T) {
+	rows := make([]*string, 0, ExportPageSize+2)
+	for i := range ExportPageSize + 1 {
+		name := "row " + strconv.Itoa(i)
+		rows = append(rows, &name)
+	}
+	formula := "=HYPERLINK(\"http://example.com\")"
+	rows = append(rows, &formula)
+
+	params := NewQueryParamsBuilder().WithPagination(3, 10).WithKeyword("row").Build()
+	var progress [][2]int32
+	var out strings.Builder
+	err := ExportCSV(params,
+		func(p *QueryParams) (*QueryResult[string], error) {
+			assert.Equal(t, "row", p.Keyword, "the query is kept")
+			// The ID of a row is its position from 1
+			start := int(p.FetchOffset())
+			if p.Keyset != nil {
+				start = int(p.Keyset.AfterID)
+			}
+			end := min(start+int(p.PageLimit()), len(rows))
+			result := &QueryResult[string]{Data: rows[start:end]}
+			FillPage(result, p, Count{Rows: 400, Mode: p.Count})
+			result.NextKeyset = &Keyset{AfterID: int64(start + len(result.Data))}
+			return result, nil
+		},
+		[]string{"name"},
+		func(row *string) []string { return []string{*row} },
+		&out,
+		func(written, total int32) error {
+			progress = append(progress, [2]int32{written, total})
+			return nil
+		},
+	)
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, len(rows)+1, "every page is exported from the first one")
+	assert.Equal(t, "name", lines[0])
+	assert.Equal(t, "row 0", lines[1])
+	assert.Equal(t, `"'=HYPERLINK(""http://example.com"")"`, lines[len(lines)-1])
+
+	assert.Equal(t, [][2]int32{{ExportPageSize, ExportPageSize + 1}, {ExportPageSize + 2, ExportPageSize + 2}}, progress,
+		"the estimate is corrected as rows are written")
+}
@@ -0,0 +1,128 @@
+package query
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// The helpers below let in-memory repositories answer QueryParams the way the
+// Postgres ones do: case-insensitive LIKE keyword search, case-insensitive IN
+// filters, ORDER BY and LIMIT/OFFSET or keyset pagination.
+
+// MatchKeyword reports whether keyword is empty or contained, ignoring case,
+// in any of fields.
+func MatchKeyword(keyword string, fields ...string) bool {
+	if keyword == "" {
+		return true
+	}
+	keyword = strings.ToLower(keyword)
+	for _, field := range fields {
+		if strings.Contains(strings.ToLower(field), keyword) {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchAny reports whether value equals, ignoring case, any of values.
+func MatchAny(value string, values []string) bool {
+	for _, v := range values {
+		if strings.EqualFold(value, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// SortRows stably sorts rows by compare in the given order.
+func SortRows[T any](rows []*T, order SortOrder, compare func(a, b *T) int) {
+	slices.SortStableFunc(rows, func(a, b *T) int {
+		if order == SortOrderDesc {
+			return compare(b, a)
+		}
+		return compare(a, b)
+	})
+}
+
+// ThenByID breaks the ties of compare by the internal ID of the rows, like the
+// Postgres repositories do, so that every row has a position to resume after.
+func ThenByID[T any](compare func(a, b *T) int, id func(*T) int64) func(a, b *T) int {
+	return func(a, b *T) int {
+		if c := compare(a, b); c != 0 {
+			return c
+		}
+		return cmp.Compare(id(a), id(b))
+	}
+}
+
+// CompareBool orders false before true, like Postgres does.
+func CompareBool(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case a:
+		return 1
+	default:
+		return -1
+	}
+}
+
+// CompareNullTime orders nil after every time, like NULLs in an ascending
+// Postgres sort.
+func CompareNullTime(a, b *time.Time) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return 1
+	case b == nil:
+		return -1
+	default:
+		return a.Compare(*b)
+	}
+}
+
+// Paginate returns the page of rows selected by pagination along with the
+// total number of rows and pages.
+func Paginate[T any](rows []*T, pagination PaginationParams) (page []*T, totalRows, totalPages int32) {
+	totalRows = int32(len(rows))
+	pageSize := pagination.PageSize
+	if pageSize <= 0 {
+		return []*T{}, totalRows, 0
+	}
+	totalPages = (totalRows + pageSize - 1) / pageSize
+
+	offset := (max(pagination.Page, 1) - 1) * pageSize
+	if offset >= totalRows {
+		return []*T{}, totalRows, totalPages
+	}
+	end := min(offset+pageSize, totalRows)
+	return rows[offset:end], totalRows, totalPages
+}
+
+// PaginateAfter is Paginate resuming after the row of keyset, identified by
+// id, instead of skipping the rows of the previous pages. Without a keyset,
+// or when its row is no longer among rows, it falls back to Paginate.
+func PaginateAfter[T any](rows []*T, pagination PaginationParams, keyset *Keyset, id func(*T) int64) (page []*T, totalRows, totalPages int32) {
+	page, totalRows, totalPages = Paginate(rows, pagination)
+	if keyset == nil || keyset.AfterID == 0 || pagination.PageSize <= 0 {
+		return page, totalRows, totalPages
+	}
+	i := slices.IndexFunc(rows, func(row *T) bool { return id(row) == keyset.AfterID })
+	if i < 0 {
+		return page, totalRows, totalPages
+	}
+	end := min(i+1+int(pagination.PageSize), len(rows))
+	return rows[i+1 : end], totalRows, totalPages
+}
+
+// NextKeyset returns the keyset of the last row of page, identified by id,
+// or nil when page is empty. In-memory rows are found again by ID alone.
+func NextKeyset[T any](page []*T, id func(*T) int64) *Keyset {
+	if len(page) == 0 {
+		return nil
+	}
+	return &Keyset{AfterID: id(page[len(page)-1])}
+}
@@ -0,0 +1,79 @@
+package query
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// ReadMaskFields returns the top-level fields of msg named by a read mask, or
+// nil when the mask is empty and every field should be returned.
+func ReadMaskFields(mask *fieldmaskpb.FieldMask, msg proto.Message) ([]string, error) {
+	paths := mask.GetPaths()
+	if len(paths) == 0 {
+		return nil, nil
+	}
+
+	fields := msg.ProtoReflect().Descriptor().Fields()
+	for _, path := range paths {
+		if strings.Contains(path, ".") {
+			return nil, fmt.Errorf("read mask path %q: nested fields are not supported", path)
+		}
+		if fields.ByName(protoreflect.Name(path)) == nil {
+			return nil, fmt.Errorf("read mask path %q: unknown field", path)
+		}
+	}
+	return paths, nil
+}
+
+// Prune clears every field of msg not listed in fields. It leaves msg as is
+// when fields is empty.
+func Prune(msg proto.Message, fields []string) {
+	if len(fields) == 0 {
+		return
+	}
+
+	m := msg.ProtoReflect()
+	m.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		if !slices.Contains(fields, string(fd.Name())) {
+			m.Clear(fd)
+		}
+		return true
+	})
+}
+
+// PruneAll prunes every message of msgs to fields and returns msgs.
+func PruneAll[T proto.Message](msgs []T, fields []string) []T {
+	for _, msg := range msgs {
+		Prune(msg, fields)
+	}
+	return msgs
+}
+
+// Column is a selectable column of a query: the proto field it loads, the SQL
+// expression that selects it and where a row scans it to. Columns without a
+// field, such as internal ids, are always selected.
+type Column struct {
+	Field string
+	SQL   string
+	Dest  any
+}
+
+// SelectColumns narrows columns to the ones loading fields, all of them when
+// fields is empty, and returns their SELECT list and scan destinations.
+func SelectColumns(columns []Column, fields []string) (string, []any) {
+	exprs := make([]string, 0, len(columns))
+	dests := make([]any, 0, len(columns))
+	for _, column := range columns {
+		if column.Field != "" && len(fields) > 0 && !slices.Contains(fields, column.Field) {
+			continue
+		}
+		exprs = append(exprs, column.SQL)
+		dests = append(dests, column.Dest)
+	}
+	return strings.Join(exprs, ", "), dests
+}
@@ -0,0 +1,57 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+)
+
+func TestReadMaskFields(t *testing.T) {
+	fields, err := ReadMaskFields(nil, &altalunev1.Employee{})
+	require.NoError(t, err)
+	assert.Nil(t, fields)
+
+	fields, err = ReadMaskFields(&fieldmaskpb.FieldMask{Paths: []string{"name", "created_at"}}, &altalunev1.Employee{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"name", "created_at"}, fields)
+
+	_, err = ReadMaskFields(&fieldmaskpb.FieldMask{Paths: []string{"salary"}}, &altalunev1.Employee{})
+	assert.Error(t, err)
+
+	_, err = ReadMaskFields(&fieldmaskpb.FieldMask{Paths: []string{"created_at.seconds"}}, &altalunev1.Employee{})
+	assert.Error(t, err)
+}
+
+func TestPrune(t *testing.T) {
+	emp := &altalunev1.Employee{Id: "abc", Name: "Ada", Email: "ada@example.com", CreatedAt: timestamppb.Now()}
+
+	Prune(emp, nil)
+	assert.Equal(t, "ada@example.com", emp.Email)
+
+	Prune(emp, []string{"id", "name"})
+	assert.True(t, proto.Equal(&altalunev1.Employee{Id: "abc", Name: "Ada"}, emp))
+}
+
+func TestSelectColumns(t *testing.T) {
+	var id int64
+	var name, email string
+	columns := []Column{
+		{SQL: "id", Dest: &id},
+		{Field: "name", SQL: "name", Dest: &name},
+		{Field: "email", SQL: "email", Dest: &email},
+	}
+
+	selectList, dests := SelectColumns(columns, nil)
+	assert.Equal(t, "id, name, email", selectList)
+	assert.Equal(t, []any{&id, &name, &email}, dests)
+
+	selectList, dests = SelectColumns(columns, []string{"email"})
+	assert.Equal(t, "id, email", selectList)
+	assert.Equal(t, []any{&id, &email}, dests)
+}
@@ -1,6 +1,10 @@
 package query
 
-import altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+import (
+	"time"
+
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+)
 
 type PaginationParams struct {
 	Page     int32
@@ -12,6 +16,30 @@ type QueryParams struct {
 	Keyword    string
 	Filters    map[string][]string
 	Sorting    *SortingParams
+	Trashed    bool     // Query soft-deleted rows instead of live ones
+	Fields     []string // Top-level proto fields to load, all when empty
+	Count      CountMode
+	Location   *time.Location // Time zone of date-relative filters, UTC when nil
+	Keyset     *Keyset        // Position to resume after instead of skipping the rows of the previous pages
+}
+
+// Keyset is the position of a row in the order of a query: the value the
+// query sorts it by and its internal ID, which breaks the ties. A query with a
+// Keyset returns the rows following that position, found through the sort
+// order rather than by skipping the rows of the previous pages, so rows
+// written between two pages neither shift them nor get repeated or skipped,
+// and deep pages cost no more than the first.
+type Keyset struct {
+	SortKey string // Sort value of the last row fetched, in the text form the repository renders it in
+	AfterID int64  // Internal ID of the last row fetched, 0 for none
+}
+
+// Now returns the current time in the Location of the params
+func (p *QueryParams) Now() time.Time {
+	if p.Location == nil {
+		return time.Now().UTC()
+	}
+	return time.Now().In(p.Location)
 }
 
 func DefaultQueryParams(req *altalunev1.QueryRequest) *QueryParams {
@@ -37,5 +65,7 @@ func DefaultQueryParams(req *altalunev1.QueryRequest) *QueryParams {
 		queryParamsBuilder.WithSorting(req.Sorting.Field, order)
 	}
 
+	queryParamsBuilder.WithCount(CountModeFromProto(req.CountMode))
+
 	return queryParamsBuilder.Build()
 }
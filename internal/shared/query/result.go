@@ -5,4 +5,7 @@ type QueryResult[T interface{}] struct {
 	TotalRows  int32
 	TotalPages int32
 	Filters    map[string][]string
+	Count      CountMode // How TotalRows was obtained, CountDefault meaning exactly
+	HasMore    bool      // Whether a page follows this one
+	NextKeyset *Keyset   // Position of the last row of Data, to fetch the page following it; nil without rows
 }
@@ -0,0 +1,37 @@
+package query
+
+// StreamPages fetches every page from params.Pagination.Page on and hands each
+// to send, so large result sets can be streamed with the regular paginated
+// repository queries. first is true for the first page only, which is the one
+// to carry metadata. A nil result counts as an empty last page.
+//
+// Pages are fetched in the order of params: the first one after its Keyset or
+// at its offset, the following ones from the NextKeyset of the page before,
+// uncounted. Streaming stops once a page has no more after it, so the rows
+// matching are neither recounted nor skipped over again for each page.
+func StreamPages[T any](
+	params *QueryParams,
+	fetch func(params *QueryParams) (*QueryResult[T], error),
+	send func(result *QueryResult[T], first bool) error,
+) error {
+	for first := true; ; first = false {
+		result, err := fetch(params)
+		if err != nil {
+			return err
+		}
+		if result == nil {
+			result = &QueryResult[T]{Data: []*T{}, Filters: map[string][]string{}}
+		}
+
+		if err := send(result, first); err != nil {
+			return err
+		}
+
+		if !result.HasMore || result.NextKeyset == nil {
+			return nil
+		}
+		params.Keyset = result.NextKeyset
+		params.Pagination.Page++
+		params.Count = CountNone
+	}
+}
@@ -0,0 +1,98 @@
+package query
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// streamFetch is a fetch made by StreamPages in the tests below
+type streamFetch struct {
+	page   int32
+	offset int32
+	after  int64
+	count  CountMode
+}
+
+// streamRows streams rows 1 to 35, with the IDs 1 to 35, recording the fetches
+// and checking each keeps the sorting of params.
+func streamRows(t *testing.T, params *QueryParams) (fetched []streamFetch, firsts []bool) {
+	t.Helper()
+	sorting := *params.Sorting
+	err := StreamPages(params,
+		func(p *QueryParams) (*QueryResult[int], error) {
+			assert.Equal(t, sorting, *p.Sorting)
+			f := streamFetch{page: p.Pagination.Page, offset: p.FetchOffset(), count: p.Count}
+			first := int64(f.offset) + 1
+			if p.Keyset != nil {
+				f.after = p.Keyset.AfterID
+				first = p.Keyset.AfterID + 1
+			}
+			fetched = append(fetched, f)
+			last := min(first+9, 35)
+			return &QueryResult[int]{
+				Data:       make([]*int, last-first+1),
+				HasMore:    last < 35,
+				NextKeyset: &Keyset{SortKey: "key", AfterID: last},
+			}, nil
+		},
+		func(_ *QueryResult[int], first bool) error {
+			firsts = append(firsts, first)
+			return nil
+		},
+	)
+
+	require.NoError(t, err)
+	return fetched, firsts
+}
+
+func TestStreamPages(t *testing.T) {
+	params := NewQueryParamsBuilder().WithPagination(2, 10).WithSorting("name", SortOrderDesc).Build()
+
+	fetched, firsts := streamRows(t, params)
+	assert.Equal(t, []streamFetch{
+		{page: 2, offset: 10, count: CountDefault},
+		{page: 3, after: 20, count: CountNone},
+		{page: 4, after: 30, count: CountNone},
+	}, fetched)
+	assert.Equal(t, []bool{true, false, false}, firsts)
+}
+
+func TestStreamPagesFromCursor(t *testing.T) {
+	params := NewQueryParamsBuilder().WithPagination(2, 10).WithSorting("name", SortOrderAsc).Build()
+	params.Keyset = &Keyset{SortKey: "key", AfterID: 12}
+
+	fetched, firsts := streamRows(t, params)
+	assert.Equal(t, []streamFetch{
+		{page: 2, after: 12, count: CountDefault},
+		{page: 3, after: 22, count: CountNone},
+		{page: 4, after: 32, count: CountNone},
+	}, fetched)
+	assert.Equal(t, []bool{true, false, false}, firsts)
+}
+
+func TestStreamPagesEmptyAndErrors(t *testing.T) {
+	sends := 0
+	err := StreamPages(NewQueryParamsBuilder().WithPagination(1, 10).Build(),
+		func(*QueryParams) (*QueryResult[int], error) { return nil, nil },
+		func(result *QueryResult[int], first bool) error {
+			sends++
+			assert.True(t, first)
+			assert.Empty(t, result.Data)
+			return nil
+		},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, 1, sends)
+
+	errSend := errors.New("client gone")
+	err = StreamPages(NewQueryParamsBuilder().WithPagination(1, 10).Build(),
+		func(*QueryParams) (*QueryResult[int], error) {
+			return &QueryResult[int]{Data: []*int{new(int)}, HasMore: true, NextKeyset: &Keyset{AfterID: 1}}, nil
+		},
+		func(*QueryResult[int], bool) error { return errSend },
+	)
+	assert.ErrorIs(t, err, errSend)
+}
@@ -0,0 +1,142 @@
+// Package realip resolves the address and scheme a client used to reach the
+// servers when requests arrive through load balancers or reverse proxies.
+// Forwarding headers are only believed when the peer is a trusted proxy, so
+// that clients connecting directly cannot spoof them.
+package realip
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+// Client is the resolved origin of a request
+type Client struct {
+	IP     string // Address of the client, without port
+	Scheme string // http or https, as seen by the client
+}
+
+// Resolver resolves the client of requests forwarded by trusted proxies
+type Resolver struct {
+	trusted []netip.Prefix
+}
+
+// NewResolver creates a Resolver trusting the forwarding headers set by the
+// proxies in trusted, each an IP address or a CIDR range. An empty list trusts
+// no proxy, so requests resolve to their peer.
+func NewResolver(trusted []string) (*Resolver, error) {
+	r := &Resolver{}
+	for _, s := range trusted {
+		if prefix, err := netip.ParsePrefix(s); err == nil {
+			r.trusted = append(r.trusted, prefix.Masked())
+			continue
+		}
+		addr, err := netip.ParseAddr(s)
+		if err != nil {
+			return nil, fmt.Errorf("trusted proxy %q is neither an IP address nor a CIDR range", s)
+		}
+		addr = addr.Unmap()
+		r.trusted = append(r.trusted, netip.PrefixFrom(addr, addr.BitLen()))
+	}
+	return r, nil
+}
+
+func (r *Resolver) isTrusted(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	for _, prefix := range r.trusted {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// Resolve returns the client of req. When the peer is a trusted proxy the
+// X-Forwarded-For chain is walked from the right, skipping the trusted hops,
+// and the scheme is taken from X-Forwarded-Proto.
+func (r *Resolver) Resolve(req *http.Request) Client {
+	client := Client{IP: peerIP(req.RemoteAddr), Scheme: "http"}
+	if req.TLS != nil {
+		client.Scheme = "https"
+	}
+
+	peer, err := netip.ParseAddr(client.IP)
+	if err != nil || !r.isTrusted(peer) {
+		return client
+	}
+
+	hops := forwardedFor(req.Header)
+	for i := len(hops) - 1; i >= 0; i-- {
+		addr, err := netip.ParseAddr(hops[i])
+		if err != nil {
+			// A malformed hop cannot be vouched for, stop at the last good one
+			break
+		}
+		client.IP = addr.Unmap().String()
+		if !r.isTrusted(addr) {
+			break
+		}
+	}
+
+	if proto := strings.ToLower(firstValue(req.Header.Get("X-Forwarded-Proto"))); proto == "http" || proto == "https" {
+		client.Scheme = proto
+	}
+	return client
+}
+
+// forwardedFor returns the hops of every X-Forwarded-For header, in order
+func forwardedFor(h http.Header) []string {
+	var hops []string
+	for _, value := range h.Values("X-Forwarded-For") {
+		for _, hop := range strings.Split(value, ",") {
+			if hop = strings.TrimSpace(hop); hop != "" {
+				hops = append(hops, hop)
+			}
+		}
+	}
+	return hops
+}
+
+func firstValue(value string) string {
+	first, _, _ := strings.Cut(value, ",")
+	return strings.TrimSpace(first)
+}
+
+// peerIP strips the port of a RemoteAddr
+func peerIP(remoteAddr string) string {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		return remoteAddr
+	}
+	return host
+}
+
+type clientKey struct{}
+
+// WithClient returns a copy of ctx carrying client
+func WithClient(ctx context.Context, client Client) context.Context {
+	return context.WithValue(ctx, clientKey{}, client)
+}
+
+// FromContext returns the client stored by Middleware. Outside of it, the
+// client is empty.
+func FromContext(ctx context.Context) Client {
+	client, _ := ctx.Value(clientKey{}).(Client)
+	return client
+}
+
+// IP returns the address of the client of the request ctx belongs to
+func IP(ctx context.Context) string {
+	return FromContext(ctx).IP
+}
+
+// Middleware resolves the client of every request and stores it in the
+// request context for the handlers after it.
+func Middleware(next http.Handler, resolver *Resolver) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r.WithContext(WithClient(r.Context(), resolver.Resolve(r))))
+	})
+}
@@ -0,0 +1,80 @@
+package realip
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewResolver(t *testing.T) {
+	_, err := NewResolver([]string{"10.0.0.0/8", "192.168.1.1", "::1"})
+	assert.NoError(t, err)
+	_, err = NewResolver([]string{"proxy.internal"})
+	assert.Error(t, err)
+}
+
+func TestResolve(t *testing.T) {
+	resolver, err := NewResolver([]string{"10.0.0.0/8"})
+	require.NoError(t, err)
+
+	request := func(remoteAddr string, headers ...string) *http.Request {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.RemoteAddr = remoteAddr
+		for i := 0; i < len(headers); i += 2 {
+			r.Header.Add(headers[i], headers[i+1])
+		}
+		return r
+	}
+
+	// Untrusted peers cannot spoof the forwarding headers
+	assert.Equal(t, Client{IP: "203.0.113.9", Scheme: "http"},
+		resolver.Resolve(request("203.0.113.9:4000", "X-Forwarded-For", "198.51.100.1", "X-Forwarded-Proto", "https")))
+
+	// The rightmost untrusted hop is the client, whatever it prepended
+	assert.Equal(t, Client{IP: "198.51.100.1", Scheme: "https"},
+		resolver.Resolve(request("10.0.0.2:4000", "X-Forwarded-For", "1.2.3.4, 198.51.100.1, 10.0.0.3", "X-Forwarded-Proto", "https")))
+
+	// Repeated headers form one chain
+	assert.Equal(t, "198.51.100.1",
+		resolver.Resolve(request("10.0.0.2:4000", "X-Forwarded-For", "198.51.100.1", "X-Forwarded-For", "10.0.0.3")).IP)
+
+	// A chain of trusted hops resolves to the leftmost
+	assert.Equal(t, "10.0.0.5",
+		resolver.Resolve(request("10.0.0.2:4000", "X-Forwarded-For", "10.0.0.5, 10.0.0.3")).IP)
+
+	// A malformed hop stops the walk at the last good one
+	assert.Equal(t, "10.0.0.3",
+		resolver.Resolve(request("10.0.0.2:4000", "X-Forwarded-For", "198.51.100.1, garbage, 10.0.0.3")).IP)
+
+	// Trusted peers without forwarding headers are the client
+	assert.Equal(t, Client{IP: "10.0.0.2", Scheme: "http"}, resolver.Resolve(request("10.0.0.2:4000")))
+
+	// Unknown schemes are ignored
+	assert.Equal(t, "http",
+		resolver.Resolve(request("10.0.0.2:4000", "X-Forwarded-Proto", "javascript")).Scheme)
+
+	tlsRequest := request("203.0.113.9:4000")
+	tlsRequest.TLS = &tls.ConnectionState{}
+	assert.Equal(t, "https", resolver.Resolve(tlsRequest).Scheme)
+}
+
+func TestMiddleware(t *testing.T) {
+	resolver, err := NewResolver(nil)
+	require.NoError(t, err)
+
+	var got Client
+	handler := Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = FromContext(r.Context())
+	}), resolver)
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.RemoteAddr = "[2001:db8::1]:4000"
+	r.Header.Set("X-Forwarded-For", "198.51.100.1")
+	handler.ServeHTTP(httptest.NewRecorder(), r)
+
+	assert.Equal(t, Client{IP: "2001:db8::1", Scheme: "http"}, got)
+}
@@ -0,0 +1,93 @@
+package scheduler
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"hash/fnv"
+	"time"
+
+	"github.com/hrz8/altalune/internal/postgres"
+)
+
+// PostgresCoordinator elects the replica running a job occurrence with
+// Postgres. A session-level advisory lock per job keeps runs from overlapping,
+// and the occurrence is claimed in altalune_scheduled_jobs so a replica whose
+// clock lags behind does not run it again after the lock was released.
+type PostgresCoordinator struct {
+	db postgres.DB
+}
+
+var _ Coordinator = (*PostgresCoordinator)(nil)
+
+// NewPostgresCoordinator creates a coordinator backed by db.
+func NewPostgresCoordinator(db postgres.DB) *PostgresCoordinator {
+	return &PostgresCoordinator{db: db}
+}
+
+func (c *PostgresCoordinator) Acquire(ctx context.Context, job string, at time.Time) (func(), bool, error) {
+	// Advisory locks belong to the session, so the lock, the claim and the
+	// unlock must all go through the same connection.
+	conn, err := c.db.GetDB().Conn(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("acquire connection: %w", err)
+	}
+
+	key := lockKey(job)
+	var locked bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", key).Scan(&locked); err != nil {
+		conn.Close()
+		return nil, false, fmt.Errorf("try advisory lock: %w", err)
+	}
+	if !locked {
+		conn.Close()
+		return nil, false, nil
+	}
+
+	release := func() {
+		// The lock must be released even when the job context was cancelled.
+		// Closing the connection returns it to the pool, so unlock explicitly.
+		_, _ = conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", key)
+		conn.Close()
+	}
+
+	claimed, err := claimOccurrence(ctx, conn, job, at)
+	if err != nil || !claimed {
+		release()
+		return nil, false, err
+	}
+
+	return release, true, nil
+}
+
+// claimOccurrence records at as the latest occurrence of job. It reports false
+// when that occurrence, or a later one, was already claimed.
+func claimOccurrence(ctx context.Context, conn *sql.Conn, job string, at time.Time) (bool, error) {
+	claimQuery := `
+		INSERT INTO altalune_scheduled_jobs (name, last_run_at, updated_at)
+		VALUES ($1, $2, CURRENT_TIMESTAMP)
+		ON CONFLICT (name) DO UPDATE
+		SET last_run_at = EXCLUDED.last_run_at, updated_at = CURRENT_TIMESTAMP
+		WHERE altalune_scheduled_jobs.last_run_at < EXCLUDED.last_run_at
+	`
+
+	result, err := conn.ExecContext(ctx, claimQuery, job, at)
+	if err != nil {
+		return false, fmt.Errorf("claim occurrence: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("get rows affected: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
+// lockKey maps a job name to an advisory lock key. The prefix keeps the keys
+// apart from advisory locks taken by anything else in the database.
+func lockKey(job string) int64 {
+	h := fnv.New64a()
+	h.Write([]byte("altalune:scheduler:" + job))
+	return int64(h.Sum64())
+}
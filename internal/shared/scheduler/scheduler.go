@@ -0,0 +1,259 @@
+// Package scheduler runs periodic background jobs.
+//
+// Jobs are registered with a cron-like schedule and every replica runs the
+// same schedule. Before an occurrence runs, the replicas elect one of them
+// through a Coordinator, so each occurrence runs exactly once across the
+// deployment.
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hrz8/altalune"
+	"github.com/hrz8/altalune/internal/shared/errorreport"
+)
+
+// JobFunc is the work of a job. It should return promptly once ctx is done.
+type JobFunc func(ctx context.Context) error
+
+// Coordinator elects the replica that runs a job occurrence.
+type Coordinator interface {
+	// Acquire reports whether this replica runs the occurrence of job
+	// scheduled at at. When it does, release must be called once the job
+	// finished.
+	Acquire(ctx context.Context, job string, at time.Time) (release func(), acquired bool, err error)
+}
+
+// JobStats are the metrics of a registered job, as seen by this replica.
+type JobStats struct {
+	Name         string
+	Schedule     string
+	Runs         int64 // Occurrences run by this replica
+	Failures     int64 // Runs that returned an error
+	Skips        int64 // Occurrences run by another replica
+	LastRunAt    time.Time
+	LastDuration time.Duration
+	LastError    string
+	NextRunAt    time.Time
+}
+
+type job struct {
+	name     string
+	spec     string
+	schedule Schedule
+	run      JobFunc
+	stats    JobStats
+}
+
+// Scheduler runs registered jobs on their schedules until stopped.
+type Scheduler struct {
+	log         altalune.Logger
+	coordinator Coordinator
+	reporter    errorreport.Reporter
+	now         func() time.Time
+
+	mu      sync.Mutex
+	jobs    map[string]*job
+	started bool
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+// Option configures a Scheduler.
+type Option func(*Scheduler)
+
+// WithReporter reports the panics of jobs, with their stack, to reporter. The
+// other failures are logged at error level, which the log hook of the
+// reporter picks up.
+func WithReporter(reporter errorreport.Reporter) Option {
+	return func(s *Scheduler) {
+		s.reporter = reporter
+	}
+}
+
+// New creates a scheduler electing job runners through coordinator.
+func New(log altalune.Logger, coordinator Coordinator, opts ...Option) *Scheduler {
+	s := &Scheduler{
+		log:         log,
+		coordinator: coordinator,
+		reporter:    errorreport.Nop{},
+		now:         func() time.Time { return time.Now().UTC() },
+		jobs:        make(map[string]*job),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Register adds a job running on the schedule described by spec (see Parse).
+// Names identify a job across replicas and must be unique. Jobs must be
+// registered before Start.
+func (s *Scheduler) Register(name, spec string, run JobFunc) error {
+	schedule, err := Parse(spec)
+	if err != nil {
+		return fmt.Errorf("register job %s: %w", name, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.started {
+		return fmt.Errorf("register job %s: scheduler already started", name)
+	}
+	if _, ok := s.jobs[name]; ok {
+		return fmt.Errorf("register job %s: already registered", name)
+	}
+
+	s.jobs[name] = &job{
+		name:     name,
+		spec:     spec,
+		schedule: schedule,
+		run:      run,
+		stats:    JobStats{Name: name, Schedule: spec},
+	}
+	return nil
+}
+
+// Start runs every registered job on its schedule until ctx is done or Stop
+// is called.
+func (s *Scheduler) Start(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.started {
+		return
+	}
+	s.started = true
+
+	ctx, s.cancel = context.WithCancel(ctx)
+	for _, j := range s.jobs {
+		s.wg.Add(1)
+		go s.loop(ctx, j)
+	}
+
+	if len(s.jobs) > 0 {
+		s.log.Info("scheduler started", "jobs", len(s.jobs))
+	}
+}
+
+// Stop stops scheduling new runs and waits for running jobs to return.
+func (s *Scheduler) Stop() {
+	s.mu.Lock()
+	cancel := s.cancel
+	s.mu.Unlock()
+
+	if cancel == nil {
+		return
+	}
+	cancel()
+	s.wg.Wait()
+}
+
+// Stats returns the metrics of every registered job, ordered by name.
+func (s *Scheduler) Stats() []JobStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stats := make([]JobStats, 0, len(s.jobs))
+	for _, j := range s.jobs {
+		stats = append(stats, j.stats)
+	}
+	sort.Slice(stats, func(a, b int) bool {
+		return stats[a].Name < stats[b].Name
+	})
+	return stats
+}
+
+func (s *Scheduler) loop(ctx context.Context, j *job) {
+	defer s.wg.Done()
+
+	for {
+		next := j.schedule.Next(s.now())
+		if next.IsZero() {
+			s.log.Error("job schedule never fires, not running it", "job", j.name, "schedule", j.spec)
+			return
+		}
+		s.updateStats(j, func(stats *JobStats) {
+			stats.NextRunAt = next
+		})
+
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		s.runOnce(ctx, j, next)
+	}
+}
+
+// runOnce runs the occurrence of j scheduled at at, if this replica is
+// elected to.
+func (s *Scheduler) runOnce(ctx context.Context, j *job, at time.Time) {
+	release, acquired, err := s.coordinator.Acquire(ctx, j.name, at)
+	if err != nil {
+		if ctx.Err() == nil {
+			s.log.Error("failed to elect job runner", "error", err, "job", j.name)
+		}
+		return
+	}
+	if !acquired {
+		s.updateStats(j, func(stats *JobStats) {
+			stats.Skips++
+		})
+		s.log.Debug("job run by another replica", "job", j.name, "scheduled_at", at)
+		return
+	}
+	defer release()
+
+	started := time.Now()
+	err = s.runJob(ctx, j)
+	duration := time.Since(started)
+
+	s.updateStats(j, func(stats *JobStats) {
+		stats.Runs++
+		stats.LastRunAt = started
+		stats.LastDuration = duration
+		stats.LastError = ""
+		if err != nil {
+			stats.Failures++
+			stats.LastError = err.Error()
+		}
+	})
+
+	if err != nil {
+		s.log.Error("job failed", "error", err, "job", j.name, "duration", duration)
+		return
+	}
+	s.log.Debug("job finished", "job", j.name, "duration", duration)
+}
+
+// runJob runs j, turning a panic into a reported error so one faulty job
+// cannot take the server down.
+func (s *Scheduler) runJob(ctx context.Context, j *job) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errorreport.Panic(ctx, s.reporter, r, map[string]string{"job": j.name})
+		}
+	}()
+
+	err = j.run(ctx)
+	if err != nil && errors.Is(err, context.Canceled) && ctx.Err() != nil {
+		return nil // Stopped during shutdown
+	}
+	return err
+}
+
+func (s *Scheduler) updateStats(j *job, fn func(stats *JobStats)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fn(&j.stats)
+}
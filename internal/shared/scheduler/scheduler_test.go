@@ -0,0 +1,119 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hrz8/altalune/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	from := time.Date(2026, 2, 6, 10, 7, 30, 0, time.UTC) // Friday
+
+	tests := []struct {
+		spec string
+		next time.Time
+	}{
+		{"@every 10m", time.Date(2026, 2, 6, 10, 10, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2026, 2, 6, 10, 15, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2026, 2, 6, 11, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2026, 2, 7, 0, 0, 0, 0, time.UTC)},
+		{"30 9 * * 1-5", time.Date(2026, 2, 9, 9, 30, 0, 0, time.UTC)},
+		{"0 0 1 * *", time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 12 13 * 0", time.Date(2026, 2, 8, 12, 0, 0, 0, time.UTC)}, // Either day field matches
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			schedule, err := Parse(tt.spec)
+			require.NoError(t, err)
+			assert.Equal(t, tt.next, schedule.Next(from))
+		})
+	}
+
+	for _, spec := range []string{"", "* * * *", "60 * * * *", "*/0 * * * *", "5-1 * * * *", "@every -1m"} {
+		_, err := Parse(spec)
+		assert.Error(t, err, spec)
+	}
+
+	never, err := Parse("0 0 31 2 *")
+	require.NoError(t, err)
+	assert.True(t, never.Next(from).IsZero())
+}
+
+// replicaCoordinator lets the first replica acquiring an occurrence run it.
+type replicaCoordinator struct {
+	mu      sync.Mutex
+	claimed map[string]time.Time
+}
+
+func (c *replicaCoordinator) Acquire(_ context.Context, job string, at time.Time) (func(), bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !at.After(c.claimed[job]) {
+		return nil, false, nil
+	}
+	c.claimed[job] = at
+	return func() {}, true, nil
+}
+
+func TestSchedulerRunsEachOccurrenceOnce(t *testing.T) {
+	coordinator := &replicaCoordinator{claimed: make(map[string]time.Time)}
+
+	var mu sync.Mutex
+	runs := 0
+	job := func(context.Context) error {
+		mu.Lock()
+		defer mu.Unlock()
+		runs++
+		return errors.New("boom")
+	}
+
+	replicas := []*Scheduler{
+		New(logger.New("error"), coordinator),
+		New(logger.New("error"), coordinator),
+	}
+	for _, s := range replicas {
+		require.NoError(t, s.Register("test.job", "@every 20ms", job))
+		s.Start(context.Background())
+	}
+
+	time.Sleep(110 * time.Millisecond)
+	for _, s := range replicas {
+		s.Stop()
+	}
+
+	var total, failures, skips int64
+	for _, s := range replicas {
+		stats := s.Stats()
+		require.Len(t, stats, 1)
+		total += stats[0].Runs
+		failures += stats[0].Failures
+		skips += stats[0].Skips
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Positive(t, runs)
+	assert.Equal(t, int64(runs), total)
+	assert.Equal(t, total, failures)
+	assert.Equal(t, total, skips, "the other replica skips every occurrence")
+}
+
+func TestRegister(t *testing.T) {
+	s := New(logger.New("error"), &replicaCoordinator{})
+	noop := func(context.Context) error { return nil }
+
+	require.NoError(t, s.Register("a", "@hourly", noop))
+	assert.Error(t, s.Register("a", "@daily", noop), "duplicate name")
+	assert.Error(t, s.Register("b", "not a schedule", noop))
+
+	s.Start(context.Background())
+	defer s.Stop()
+	assert.Error(t, s.Register("c", "@daily", noop), "registered after start")
+}
@@ -0,0 +1,256 @@
+// Package secretdelivery hands over the secrets generated on creation, API
+// keys and OAuth client secrets, without returning them in plaintext when the
+// credential policy of the project forbids it: through a one-time retrieval
+// link, or encrypted to a public key of the recipient.
+package secretdelivery
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	altalunev1 "github.com/hrz8/altalune/gen/altalune/v1"
+	"github.com/hrz8/altalune/internal/redis"
+	"github.com/hrz8/altalune/internal/shared/crypto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Mode is how a secret is handed over
+type Mode string
+
+const (
+	ModeReveal    Mode = "reveal"     // Returned in plaintext, once
+	ModeLink      Mode = "link"       // Retrieved once from a link
+	ModePublicKey Mode = "public_key" // Encrypted to a public key of the recipient
+)
+
+const (
+	// LinkLifetime is how long a retrieval link can be used
+	LinkLifetime = 24 * time.Hour
+	// RetrievalPath prefixes the retrieval links on the API server
+	RetrievalPath = "/secrets/"
+	// minRSABits is the smallest public key secrets are encrypted to
+	minRSABits = 2048
+)
+
+var (
+	// ErrPublicKeyRequired is returned when the mode is ModePublicKey and no
+	// public key is given
+	ErrPublicKeyRequired = errors.New("a recipient public key is required")
+	// ErrInvalidPublicKey is returned for a public key that is not a PEM RSA
+	// public key of at least 2048 bits
+	ErrInvalidPublicKey = errors.New("recipient public key must be a PEM RSA public key of at least 2048 bits")
+)
+
+// Delivery is a handed over secret. Only the field of its mode is set.
+type Delivery struct {
+	Mode               Mode
+	Secret             string // ModeReveal
+	RetrievalURL       string // ModeLink, relative to the API server
+	RetrievalExpiresAt time.Time
+	EncryptedSecret    string // ModePublicKey, base64 RSA-OAEP ciphertext
+}
+
+// ToDeliveredSecretProto returns the delivery for a create response, nil when
+// the secret is revealed in plaintext
+func (d *Delivery) ToDeliveredSecretProto() *altalunev1.DeliveredSecret {
+	switch d.Mode {
+	case ModeLink:
+		return &altalunev1.DeliveredSecret{
+			Mode:               altalunev1.SecretDeliveryMode_SECRET_DELIVERY_MODE_LINK,
+			RetrievalUrl:       d.RetrievalURL,
+			RetrievalExpiresAt: timestamppb.New(d.RetrievalExpiresAt),
+		}
+	case ModePublicKey:
+		return &altalunev1.DeliveredSecret{
+			Mode:            altalunev1.SecretDeliveryMode_SECRET_DELIVERY_MODE_PUBLIC_KEY,
+			EncryptedSecret: d.EncryptedSecret,
+		}
+	default:
+		return nil
+	}
+}
+
+// ModeFromProto returns the mode of a request, ModeReveal when unspecified
+func ModeFromProto(mode altalunev1.SecretDeliveryMode) Mode {
+	switch mode {
+	case altalunev1.SecretDeliveryMode_SECRET_DELIVERY_MODE_LINK:
+		return ModeLink
+	case altalunev1.SecretDeliveryMode_SECRET_DELIVERY_MODE_PUBLIC_KEY:
+		return ModePublicKey
+	default:
+		return ModeReveal
+	}
+}
+
+// ToProto returns the proto enum of the mode
+func (m Mode) ToProto() altalunev1.SecretDeliveryMode {
+	switch m {
+	case ModeLink:
+		return altalunev1.SecretDeliveryMode_SECRET_DELIVERY_MODE_LINK
+	case ModePublicKey:
+		return altalunev1.SecretDeliveryMode_SECRET_DELIVERY_MODE_PUBLIC_KEY
+	default:
+		return altalunev1.SecretDeliveryMode_SECRET_DELIVERY_MODE_REVEAL
+	}
+}
+
+// Deliverer hands over secrets, keeping those of retrieval links in the
+// shared key-value store until they are retrieved or expire.
+type Deliverer struct {
+	store redis.Store
+	now   func() time.Time
+}
+
+// NewDeliverer creates a Deliverer keeping retrieval links in store.
+func NewDeliverer(store redis.Store) *Deliverer {
+	return &Deliverer{store: store, now: time.Now}
+}
+
+// CheckRecipient checks that a secret can be delivered as mode requires to
+// publicKeyPEM, which is optional unless mode is ModePublicKey.
+func CheckRecipient(mode Mode, publicKeyPEM string) error {
+	if strings.TrimSpace(publicKeyPEM) == "" {
+		if mode == ModePublicKey {
+			return ErrPublicKeyRequired
+		}
+		return nil
+	}
+	_, err := parsePublicKey(publicKeyPEM)
+	return err
+}
+
+// Deliver hands over secret as mode requires. A public key is always
+// honored, whatever the mode, as it never exposes the secret either.
+func (d *Deliverer) Deliver(ctx context.Context, mode Mode, secret, publicKeyPEM string) (*Delivery, error) {
+	if strings.TrimSpace(publicKeyPEM) != "" {
+		encrypted, err := EncryptTo(publicKeyPEM, secret)
+		if err != nil {
+			return nil, err
+		}
+		return &Delivery{Mode: ModePublicKey, EncryptedSecret: encrypted}, nil
+	}
+
+	switch mode {
+	case ModeLink:
+		return d.link(ctx, secret)
+	case ModePublicKey:
+		return nil, ErrPublicKeyRequired
+	default:
+		return &Delivery{Mode: ModeReveal, Secret: secret}, nil
+	}
+}
+
+// link stores secret encrypted with a random token, which only the link
+// holds: the store keeps the hash of the token and the ciphertext
+func (d *Deliverer) link(ctx context.Context, secret string) (*Delivery, error) {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("generate retrieval token: %w", err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(key)
+
+	sealed, err := crypto.Encrypt(secret, key)
+	if err != nil {
+		return nil, fmt.Errorf("encrypt secret: %w", err)
+	}
+	if err := d.store.Set(ctx, storeKey(token), []byte(sealed), LinkLifetime); err != nil {
+		return nil, fmt.Errorf("store secret: %w", err)
+	}
+
+	return &Delivery{
+		Mode:               ModeLink,
+		RetrievalURL:       RetrievalPath + token,
+		RetrievalExpiresAt: d.now().Add(LinkLifetime),
+	}, nil
+}
+
+// Retrieve returns the secret of a retrieval link token and burns it. found
+// is false when the token is unknown, expired or already retrieved.
+func (d *Deliverer) Retrieve(ctx context.Context, token string) (secret string, found bool, err error) {
+	key, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(key) != 32 {
+		return "", false, nil
+	}
+
+	sealed, found, err := d.store.Take(ctx, storeKey(token))
+	if err != nil {
+		return "", false, fmt.Errorf("take secret: %w", err)
+	}
+	if !found {
+		return "", false, nil
+	}
+
+	secret, err = crypto.Decrypt(string(sealed), key)
+	if err != nil {
+		return "", false, fmt.Errorf("decrypt secret: %w", err)
+	}
+	return secret, true, nil
+}
+
+// EncryptTo encrypts secret with RSA-OAEP (SHA-256) to a PEM RSA public key,
+// in PKIX ("PUBLIC KEY") or PKCS #1 ("RSA PUBLIC KEY") form, and returns the
+// base64 ciphertext. It decrypts with:
+//
+//	base64 -d | openssl pkeyutl -decrypt -inkey private.pem \
+//		-pkeyopt rsa_padding_mode:oaep -pkeyopt rsa_oaep_md:sha256
+func EncryptTo(publicKeyPEM, secret string) (string, error) {
+	pub, err := parsePublicKey(publicKeyPEM)
+	if err != nil {
+		return "", err
+	}
+	ciphertext, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, pub, []byte(secret), nil)
+	if err != nil {
+		return "", fmt.Errorf("encrypt secret: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(ciphertext), nil
+}
+
+func parsePublicKey(publicKeyPEM string) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode([]byte(strings.TrimSpace(publicKeyPEM)))
+	if block == nil {
+		return nil, ErrInvalidPublicKey
+	}
+
+	var pub *rsa.PublicKey
+	switch block.Type {
+	case "PUBLIC KEY":
+		parsed, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, ErrInvalidPublicKey
+		}
+		rsaKey, ok := parsed.(*rsa.PublicKey)
+		if !ok {
+			return nil, ErrInvalidPublicKey
+		}
+		pub = rsaKey
+	case "RSA PUBLIC KEY":
+		parsed, err := x509.ParsePKCS1PublicKey(block.Bytes)
+		if err != nil {
+			return nil, ErrInvalidPublicKey
+		}
+		pub = parsed
+	default:
+		return nil, ErrInvalidPublicKey
+	}
+
+	if pub.N.BitLen() < minRSABits {
+		return nil, ErrInvalidPublicKey
+	}
+	return pub, nil
+}
+
+// storeKey is the store key of the secret of a retrieval link token
+func storeKey(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return "secret_delivery:" + hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,89 @@
+package secretdelivery_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"strings"
+	"testing"
+
+	"github.com/hrz8/altalune/internal/redis"
+	"github.com/hrz8/altalune/internal/shared/secretdelivery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newKeyPEM(t *testing.T, bits int) (*rsa.PrivateKey, string) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, bits)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	return key, string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func TestDeliver(t *testing.T) {
+	ctx := context.Background()
+	store := redis.NewMemoryStore()
+	d := secretdelivery.NewDeliverer(store)
+
+	delivery, err := d.Deliver(ctx, secretdelivery.ModeReveal, "sk_secret", "")
+	require.NoError(t, err)
+	assert.Equal(t, "sk_secret", delivery.Secret)
+	assert.Nil(t, delivery.ToDeliveredSecretProto())
+
+	_, err = d.Deliver(ctx, secretdelivery.ModePublicKey, "sk_secret", "")
+	assert.ErrorIs(t, err, secretdelivery.ErrPublicKeyRequired)
+
+	t.Run("link", func(t *testing.T) {
+		delivery, err := d.Deliver(ctx, secretdelivery.ModeLink, "sk_secret", "")
+		require.NoError(t, err)
+		assert.Empty(t, delivery.Secret)
+		token, ok := strings.CutPrefix(delivery.RetrievalURL, secretdelivery.RetrievalPath)
+		require.True(t, ok)
+
+		secret, found, err := d.Retrieve(ctx, token)
+		require.NoError(t, err)
+		assert.True(t, found)
+		assert.Equal(t, "sk_secret", secret)
+
+		_, found, err = d.Retrieve(ctx, token)
+		require.NoError(t, err)
+		assert.False(t, found, "links are single use")
+
+		_, found, err = d.Retrieve(ctx, "not-a-token")
+		require.NoError(t, err)
+		assert.False(t, found)
+	})
+
+	t.Run("public key", func(t *testing.T) {
+		private, publicPEM := newKeyPEM(t, 2048)
+
+		// A public key is honored whatever the mode
+		delivery, err := d.Deliver(ctx, secretdelivery.ModeReveal, "sk_secret", publicPEM)
+		require.NoError(t, err)
+		assert.Equal(t, secretdelivery.ModePublicKey, delivery.Mode)
+		assert.Empty(t, delivery.Secret)
+
+		ciphertext, err := base64.StdEncoding.DecodeString(delivery.EncryptedSecret)
+		require.NoError(t, err)
+		plaintext, err := rsa.DecryptOAEP(sha256.New(), nil, private, ciphertext, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "sk_secret", string(plaintext))
+
+		assert.NoError(t, secretdelivery.CheckRecipient(secretdelivery.ModePublicKey, publicPEM))
+		assert.ErrorIs(t, secretdelivery.CheckRecipient(secretdelivery.ModePublicKey, ""), secretdelivery.ErrPublicKeyRequired)
+		assert.NoError(t, secretdelivery.CheckRecipient(secretdelivery.ModeLink, ""))
+
+		_, weakPEM := newKeyPEM(t, 1024)
+		for _, invalid := range []string{"not a key", weakPEM} {
+			assert.ErrorIs(t, secretdelivery.CheckRecipient(secretdelivery.ModeReveal, invalid), secretdelivery.ErrInvalidPublicKey)
+			_, err := d.Deliver(ctx, secretdelivery.ModePublicKey, "sk_secret", invalid)
+			assert.ErrorIs(t, err, secretdelivery.ErrInvalidPublicKey)
+		}
+	})
+}
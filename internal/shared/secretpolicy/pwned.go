@@ -0,0 +1,93 @@
+package secretpolicy
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultPwnedURL is the Pwned Passwords range API.
+const DefaultPwnedURL = "https://api.pwnedpasswords.com/range/"
+
+// PwnedChecker looks secrets up in the Pwned Passwords corpus with its
+// k-anonymity API: only the first 5 hex characters of the secret's SHA-1 leave
+// the process, and the matching suffixes are compared locally.
+type PwnedChecker struct {
+	client *http.Client
+	url    string
+}
+
+// PwnedOption configures a PwnedChecker.
+type PwnedOption func(*PwnedChecker)
+
+// WithHTTPClient sets the HTTP client of the lookups (default: 5s timeout).
+func WithHTTPClient(client *http.Client) PwnedOption {
+	return func(c *PwnedChecker) {
+		c.client = client
+	}
+}
+
+// WithPwnedURL sets the range API URL the hash prefix is appended to.
+func WithPwnedURL(url string) PwnedOption {
+	return func(c *PwnedChecker) {
+		c.url = url
+	}
+}
+
+// NewPwnedChecker creates a checker of the Pwned Passwords API.
+func NewPwnedChecker(opts ...PwnedOption) *PwnedChecker {
+	c := &PwnedChecker{
+		client: &http.Client{Timeout: 5 * time.Second},
+		url:    DefaultPwnedURL,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Count returns how many times secret appears in the breach corpus, 0 when it
+// does not.
+func (c *PwnedChecker) Count(ctx context.Context, secret string) (int, error) {
+	sum := sha1.Sum([]byte(secret))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	prefix, suffix := hash[:5], hash[5:]
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url+prefix, nil)
+	if err != nil {
+		return 0, err
+	}
+	// Padding hides the number of matching suffixes from observers
+	req.Header.Set("Add-Padding", "true")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("pwned passwords API returned %s", resp.Status)
+	}
+
+	// Each line is SUFFIX:COUNT, padding entries have a count of 0
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		lineSuffix, count, ok := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if !ok || lineSuffix != suffix {
+			continue
+		}
+		n, err := strconv.Atoi(count)
+		if err != nil {
+			return 0, fmt.Errorf("parse pwned passwords count: %w", err)
+		}
+		return n, nil
+	}
+	return 0, scanner.Err()
+}
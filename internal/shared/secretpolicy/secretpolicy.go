@@ -0,0 +1,102 @@
+// Package secretpolicy rejects weak client secrets and passwords: those too
+// predictable for their length, and optionally those found in data breaches
+// through the Have I Been Pwned Pwned Passwords API.
+package secretpolicy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"unicode"
+)
+
+// Minimum estimated entropy, in bits.
+const (
+	// MinClientSecretEntropy is met by every 32-character random alphanumeric
+	// secret, the length the OAuth client repository generates.
+	MinClientSecretEntropy = 112
+	// MinPasswordEntropy suits secrets typed by people.
+	MinPasswordEntropy = 50
+)
+
+var (
+	// ErrLowEntropy is returned for a secret too predictable for the policy.
+	ErrLowEntropy = errors.New("secret is too predictable")
+	// ErrPwned is returned for a secret found in a known data breach.
+	ErrPwned = errors.New("secret appears in a known data breach")
+)
+
+// Policy is a set of requirements for a secret.
+type Policy struct {
+	MinEntropy float64       // Minimum estimated entropy in bits
+	Pwned      *PwnedChecker // Breach corpus lookup, nil skips it
+}
+
+// Check returns an error wrapping ErrLowEntropy or ErrPwned when secret does
+// not meet the policy.
+func (p Policy) Check(ctx context.Context, secret string) error {
+	if bits := Entropy(secret); bits < p.MinEntropy {
+		return fmt.Errorf("%w: about %.0f bits of entropy, %.0f required", ErrLowEntropy, bits, p.MinEntropy)
+	}
+
+	if p.Pwned != nil {
+		count, err := p.Pwned.Count(ctx, secret)
+		if err != nil {
+			return fmt.Errorf("check breached secrets: %w", err)
+		}
+		if count > 0 {
+			return fmt.Errorf("%w: seen %d times", ErrPwned, count)
+		}
+	}
+
+	return nil
+}
+
+// Entropy estimates the entropy of secret in bits. Each character counts for
+// the smaller of the size of the character classes used (lower, upper, digit,
+// symbol, other) and the Shannon entropy of the secret's own characters, so
+// repeated characters and patterns lower the estimate.
+func Entropy(secret string) float64 {
+	runes := []rune(secret)
+	if len(runes) == 0 {
+		return 0
+	}
+
+	var lower, upper, digit, symbol, other bool
+	freq := make(map[rune]int)
+	for _, r := range runes {
+		freq[r]++
+		switch {
+		case r >= 'a' && r <= 'z':
+			lower = true
+		case r >= 'A' && r <= 'Z':
+			upper = true
+		case r >= '0' && r <= '9':
+			digit = true
+		case r < unicode.MaxASCII:
+			symbol = true
+		default:
+			other = true
+		}
+	}
+
+	pool := 0
+	for _, class := range []struct {
+		used bool
+		size int
+	}{{lower, 26}, {upper, 26}, {digit, 10}, {symbol, 33}, {other, 100}} {
+		if class.used {
+			pool += class.size
+		}
+	}
+
+	n := float64(len(runes))
+	shannon := 0.0
+	for _, count := range freq {
+		p := float64(count) / n
+		shannon -= p * math.Log2(p)
+	}
+
+	return n * math.Min(math.Log2(float64(pool)), shannon)
+}
@@ -0,0 +1,57 @@
+package secretpolicy
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntropy(t *testing.T) {
+	assert.Zero(t, Entropy(""))
+	assert.Zero(t, Entropy(strings.Repeat("a", 32)))
+	assert.Less(t, Entropy("Password1!Password1!"), float64(MinPasswordEntropy+20))
+	assert.Greater(t, Entropy("0cMw4XzRZcRI4YDEqoY9AYWui3y4eZTQ"), float64(MinClientSecretEntropy))
+}
+
+func TestPolicyCheck(t *testing.T) {
+	ctx := context.Background()
+	policy := Policy{MinEntropy: MinClientSecretEntropy}
+
+	assert.NoError(t, policy.Check(ctx, "0cMw4XzRZcRI4YDEqoY9AYWui3y4eZTQ"))
+	assert.ErrorIs(t, policy.Check(ctx, "short-secret"), ErrLowEntropy)
+}
+
+func TestPwnedChecker(t *testing.T) {
+	sum := sha1.Sum([]byte("hunter2hunter2"))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+
+	var requested string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = r.URL.Path
+		assert.Equal(t, "true", r.Header.Get("Add-Padding"))
+		fmt.Fprintf(w, "0018A45C4D1DEF81644B54AB7F969B88D65:0\r\n%s:42\r\n", hash[5:])
+	}))
+	defer srv.Close()
+
+	checker := NewPwnedChecker(WithPwnedURL(srv.URL + "/range/"))
+
+	count, err := checker.Count(context.Background(), "hunter2hunter2")
+	require.NoError(t, err)
+	assert.Equal(t, 42, count)
+	assert.Equal(t, "/range/"+hash[:5], requested)
+
+	count, err = checker.Count(context.Background(), "something else")
+	require.NoError(t, err)
+	assert.Zero(t, count)
+
+	policy := Policy{Pwned: checker}
+	assert.ErrorIs(t, policy.Check(context.Background(), "hunter2hunter2"), ErrPwned)
+}
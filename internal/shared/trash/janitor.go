@@ -0,0 +1,114 @@
+// Package trash permanently removes soft-deleted records once their
+// retention period has passed.
+package trash
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/hrz8/altalune"
+)
+
+// Purger permanently removes the records of one entity that were trashed
+// before a cutoff, returning how many were removed.
+type Purger interface {
+	PurgeDeleted(ctx context.Context, before time.Time) (int64, error)
+}
+
+// PurgerFunc adapts a function to a Purger, for records that expire rather
+// than being trashed.
+type PurgerFunc func(ctx context.Context, before time.Time) (int64, error)
+
+// PurgeDeleted calls f.
+func (f PurgerFunc) PurgeDeleted(ctx context.Context, before time.Time) (int64, error) {
+	return f(ctx, before)
+}
+
+// Janitor periodically purges trashed records older than the retention period.
+type Janitor struct {
+	log       altalune.Logger
+	retention time.Duration
+	interval  time.Duration
+	purgers   map[string]Purger
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewJanitor creates a janitor purging the given entities, keyed by a name
+// used in logs.
+func NewJanitor(log altalune.Logger, retention, interval time.Duration, purgers map[string]Purger) *Janitor {
+	return &Janitor{
+		log:       log,
+		retention: retention,
+		interval:  interval,
+		purgers:   purgers,
+	}
+}
+
+// Start purges once right away and then every interval, until ctx is done or
+// Stop is called.
+func (j *Janitor) Start(ctx context.Context) {
+	ctx, j.cancel = context.WithCancel(ctx)
+	j.done = make(chan struct{})
+
+	go func() {
+		defer close(j.done)
+
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+
+		for {
+			j.Purge(ctx, time.Now())
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop stops the janitor and waits for a running purge to finish.
+func (j *Janitor) Stop() {
+	if j.cancel == nil {
+		return
+	}
+	j.cancel()
+	<-j.done
+}
+
+// Purge removes every record trashed before now minus the retention period.
+// A failing entity is logged and skipped so it does not block the others.
+func (j *Janitor) Purge(ctx context.Context, now time.Time) {
+	before := now.Add(-j.retention)
+
+	names := make([]string, 0, len(j.purgers))
+	for name := range j.purgers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		purged, err := j.purgers[name].PurgeDeleted(ctx, before)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			j.log.Error("failed to purge trash",
+				"error", err,
+				"entity", name,
+			)
+			continue
+		}
+		if purged > 0 {
+			j.log.Info("purged trash",
+				"entity", name,
+				"count", purged,
+				"deleted_before", before,
+			)
+		}
+	}
+}
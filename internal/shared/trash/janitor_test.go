@@ -0,0 +1,49 @@
+package trash
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hrz8/altalune/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakePurger struct {
+	before []time.Time
+	err    error
+}
+
+func (p *fakePurger) PurgeDeleted(_ context.Context, before time.Time) (int64, error) {
+	p.before = append(p.before, before)
+	return 1, p.err
+}
+
+func TestPurge(t *testing.T) {
+	users := &fakePurger{err: errors.New("connection reset")}
+	employees := &fakePurger{}
+	janitor := NewJanitor(logger.New("error"), 30*24*time.Hour, time.Hour, map[string]Purger{
+		"users":     users,
+		"employees": employees,
+	})
+
+	now := time.Date(2026, 2, 6, 12, 0, 0, 0, time.UTC)
+	janitor.Purge(context.Background(), now)
+
+	cutoff := time.Date(2026, 1, 7, 12, 0, 0, 0, time.UTC)
+	assert.Equal(t, []time.Time{cutoff}, users.before)
+	assert.Equal(t, []time.Time{cutoff}, employees.before, "a failing entity must not block the others")
+}
+
+func TestStartStop(t *testing.T) {
+	employees := &fakePurger{}
+	janitor := NewJanitor(logger.New("error"), time.Hour, time.Hour, map[string]Purger{
+		"employees": employees,
+	})
+
+	janitor.Start(context.Background())
+	janitor.Stop()
+
+	assert.Len(t, employees.before, 1, "the first purge runs right away")
+}
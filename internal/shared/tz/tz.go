@@ -0,0 +1,81 @@
+// Package tz resolves the time zones projects are configured with and does the
+// calendar arithmetic that has to follow them, such as day boundaries and
+// date-relative windows, along with formatting times for people to read.
+package tz
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	// Embed the zone database so project time zones resolve on hosts and
+	// images without one
+	_ "time/tzdata"
+)
+
+// DisplayLayout is the layout Format renders times with.
+const DisplayLayout = "Mon, 02 Jan 2006 15:04 MST"
+
+var locations sync.Map // name -> *time.Location
+
+// Validate returns an error unless name is an IANA time zone, such as
+// "Asia/Jakarta" or "UTC".
+func Validate(name string) error {
+	if name == "" {
+		return fmt.Errorf("time zone is empty")
+	}
+	if _, err := load(name); err != nil {
+		return fmt.Errorf("unknown time zone %q", name)
+	}
+	return nil
+}
+
+// Load returns the location named name, UTC when it is empty or unknown so
+// that a project stored with a bad zone still behaves as before zones were
+// honored.
+func Load(name string) *time.Location {
+	loc, err := load(name)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+func load(name string) (*time.Location, error) {
+	if loc, ok := locations.Load(name); ok {
+		return loc.(*time.Location), nil
+	}
+	// LoadLocation maps both to the host's zone rather than a named one
+	if name == "" || name == "Local" {
+		return nil, fmt.Errorf("load location %q: not an IANA time zone", name)
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("load location %q: %w", name, err)
+	}
+	locations.Store(name, loc)
+	return loc, nil
+}
+
+// StartOfDay returns the midnight starting the day of t in loc.
+func StartOfDay(t time.Time, loc *time.Location) time.Time {
+	t = t.In(loc)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+}
+
+// EndOfDay returns the last instant of the day of t in loc, the midnight
+// ending it excluded.
+func EndOfDay(t time.Time, loc *time.Location) time.Time {
+	return AddDays(t, 1, loc).Add(-time.Nanosecond)
+}
+
+// AddDays returns the midnight starting the day n calendar days after the day
+// of t in loc. Days across a daylight saving change are 23 or 25 hours long.
+func AddDays(t time.Time, n int, loc *time.Location) time.Time {
+	return StartOfDay(t, loc).AddDate(0, 0, n)
+}
+
+// Format renders t in loc with DisplayLayout.
+func Format(t time.Time, loc *time.Location) string {
+	return t.In(loc).Format(DisplayLayout)
+}
@@ -0,0 +1,43 @@
+package tz
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, Validate("Asia/Jakarta"))
+	assert.NoError(t, Validate("UTC"))
+	assert.Error(t, Validate(""))
+	assert.Error(t, Validate("Local"))
+	assert.Error(t, Validate("Mars/Olympus_Mons"))
+}
+
+func TestLoad(t *testing.T) {
+	assert.Equal(t, "Asia/Jakarta", Load("Asia/Jakarta").String())
+	assert.Equal(t, time.UTC, Load("Mars/Olympus_Mons"))
+	assert.Equal(t, time.UTC, Load(""))
+}
+
+func TestDays(t *testing.T) {
+	jakarta := Load("Asia/Jakarta")
+	// 20:00 UTC on 1 March is 03:00 on 2 March in Jakarta (UTC+7)
+	now := time.Date(2026, 3, 1, 20, 0, 0, 0, time.UTC)
+
+	assert.True(t, time.Date(2026, 3, 2, 0, 0, 0, 0, jakarta).Equal(StartOfDay(now, jakarta)))
+	assert.True(t, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC).Equal(StartOfDay(now, time.UTC)))
+	assert.True(t, time.Date(2026, 3, 12, 0, 0, 0, 0, jakarta).Equal(AddDays(now, 10, jakarta)))
+	assert.True(t, time.Date(2026, 3, 3, 0, 0, 0, 0, jakarta).Add(-time.Nanosecond).Equal(EndOfDay(now, jakarta)))
+
+	// The day daylight saving starts in New York is 23 hours long
+	newYork := Load("America/New_York")
+	dst := time.Date(2026, 3, 8, 12, 0, 0, 0, newYork)
+	assert.Equal(t, 23*time.Hour, AddDays(dst, 1, newYork).Sub(StartOfDay(dst, newYork)))
+}
+
+func TestFormat(t *testing.T) {
+	now := time.Date(2026, 3, 1, 20, 0, 0, 0, time.UTC)
+	assert.Equal(t, "Mon, 02 Mar 2026 03:00 WIB", Format(now, Load("Asia/Jakarta")))
+}
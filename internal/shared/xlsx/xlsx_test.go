@@ -0,0 +1,43 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, "Employees & Co")
+	require.NoError(t, err)
+	require.NoError(t, w.WriteRow([]string{"name", "email"}))
+	require.NoError(t, w.WriteRow([]string{"Jane <Doe>", " padded "}))
+	require.NoError(t, w.Close())
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+
+	parts := make(map[string]string)
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		content, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		rc.Close()
+		parts[f.Name] = string(content)
+	}
+
+	assert.Contains(t, parts, "[Content_Types].xml")
+	assert.Contains(t, parts, "_rels/.rels")
+	assert.Contains(t, parts, "xl/_rels/workbook.xml.rels")
+	assert.Contains(t, parts["xl/workbook.xml"], `name="Employees &amp; Co"`)
+
+	sheet := parts["xl/worksheets/sheet1.xml"]
+	assert.Contains(t, sheet, `<t xml:space="preserve">Jane &lt;Doe&gt;</t>`)
+	assert.Contains(t, sheet, `<t xml:space="preserve"> padded </t>`)
+	assert.Equal(t, 2, bytes.Count([]byte(sheet), []byte("<row>")))
+}
@@ -0,0 +1,77 @@
+package testdb
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// Image is the Postgres image of the test container
+const Image = "postgres:17-alpine"
+
+// postgresContainer is a Postgres container started with the docker CLI
+type postgresContainer struct {
+	id  string
+	url string
+}
+
+// dockerAvailable reports whether the docker CLI can reach a daemon
+func dockerAvailable() bool {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	return exec.CommandContext(ctx, "docker", "info").Run() == nil
+}
+
+// startPostgresContainer starts a Postgres container publishing its port on a
+// random loopback port. It does not wait for Postgres to accept connections.
+func startPostgresContainer(ctx context.Context) (*postgresContainer, error) {
+	id, err := docker(ctx, "run", "--detach", "--rm",
+		"--label", "altalune.testdb=true",
+		"--env", "POSTGRES_DB=altalune",
+		"--env", "POSTGRES_USER=altalune",
+		"--env", "POSTGRES_PASSWORD=altalune",
+		"--publish", "127.0.0.1::5432",
+		Image,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("start postgres container: %w", err)
+	}
+	c := &postgresContainer{id: id}
+
+	// Prints 127.0.0.1:<port>
+	address, err := docker(ctx, "port", id, "5432/tcp")
+	if err != nil {
+		_ = c.remove()
+		return nil, fmt.Errorf("get postgres container port: %w", err)
+	}
+	address, _, _ = strings.Cut(address, "\n")
+	c.url = fmt.Sprintf("postgres://altalune:altalune@%s/altalune?sslmode=disable", address)
+
+	return c, nil
+}
+
+// remove stops and removes the container
+func (c *postgresContainer) remove() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if _, err := docker(ctx, "rm", "--force", "--volumes", c.id); err != nil {
+		return fmt.Errorf("remove postgres container %s: %w", c.id, err)
+	}
+	return nil
+}
+
+// docker runs the docker CLI and returns its trimmed standard output
+func docker(ctx context.Context, args ...string) (string, error) {
+	out, err := exec.CommandContext(ctx, "docker", args...).Output()
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			return "", fmt.Errorf("docker %s: %w: %s", args[0], err, strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return "", fmt.Errorf("docker %s: %w", args[0], err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
@@ -0,0 +1,70 @@
+package testdb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hrz8/altalune/internal/postgres"
+	"github.com/hrz8/altalune/internal/shared/nanoid"
+)
+
+// Fixtures are the rows Seed inserts, identified by internal and public ID
+type Fixtures struct {
+	UserID          int64 // Active, verified user owning the project
+	UserPublicID    string
+	UserEmail       string
+	ProjectID       int64 // Sandbox project without partitions
+	ProjectPublicID string
+}
+
+// Seed inserts a user owning a project through db, typically a Tx. Rows get
+// random public IDs, names and emails so that seeds never collide.
+func Seed(t testing.TB, db postgres.DB) *Fixtures {
+	t.Helper()
+	ctx := context.Background()
+
+	f := &Fixtures{
+		UserPublicID:    Token(t),
+		ProjectPublicID: Token(t),
+	}
+	f.UserEmail = "fixture-" + f.UserPublicID + "@example.com"
+
+	err := db.QueryRowContext(ctx, `
+		INSERT INTO altalune_users (public_id, email, first_name, last_name, is_active, email_verified, activated_at)
+		VALUES ($1, $2, 'Fixture', 'User', true, true, NOW())
+		RETURNING id
+	`, f.UserPublicID, f.UserEmail).Scan(&f.UserID)
+	if err != nil {
+		t.Fatalf("seed user: %v", err)
+	}
+
+	err = db.QueryRowContext(ctx, `
+		INSERT INTO altalune_projects (public_id, name, description, timezone, environment)
+		VALUES ($1, $2, 'Seeded by testdb', 'UTC', 'sandbox')
+		RETURNING id
+	`, f.ProjectPublicID, "Fixture "+f.ProjectPublicID).Scan(&f.ProjectID)
+	if err != nil {
+		t.Fatalf("seed project: %v", err)
+	}
+
+	_, err = db.ExecContext(ctx, `
+		INSERT INTO altalune_project_members (public_id, project_id, user_id, role)
+		VALUES ($1, $2, $3, 'owner')
+	`, Token(t), f.ProjectID, f.UserID)
+	if err != nil {
+		t.Fatalf("seed project member: %v", err)
+	}
+
+	return f
+}
+
+// Token returns a random lowercase token keeping rows of a test run apart,
+// for the names and emails that must be unique.
+func Token(t testing.TB) string {
+	t.Helper()
+	id, err := nanoid.GeneratePublicID()
+	if err != nil {
+		t.Fatalf("generate token: %v", err)
+	}
+	return id
+}
@@ -0,0 +1,113 @@
+// Package testdb runs integration tests against a real, migrated Postgres.
+//
+// The database is a throwaway Docker container started the first time a test
+// of the package asks for it, or the database at EnvDatabaseURL when set.
+// Tests either share it through Open or get a transaction of their own through
+// Tx, rolled back when the test ends. Tests are skipped when neither Docker
+// nor EnvDatabaseURL is available.
+//
+// Packages using testdb remove the container once their tests are done by
+// running them through Main:
+//
+//	func TestMain(m *testing.M) { testdb.Main(m) }
+package testdb
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hrz8/altalune/internal/domain/migration"
+	"github.com/hrz8/altalune/internal/postgres"
+)
+
+// EnvDatabaseURL names the environment variable holding the URL of an existing
+// test database to use instead of a container. Its content is migrated up and
+// never cleaned, so it must not point to a database whose data matters.
+const EnvDatabaseURL = "ALTALUNE_TEST_DATABASE_URL"
+
+var (
+	once      sync.Once
+	shared    *postgres.SQLConnection
+	container *postgresContainer
+	openErr   error
+)
+
+// Main runs the tests of the package then removes the database container,
+// if one was started
+func Main(m *testing.M) {
+	code := m.Run()
+	if container != nil {
+		if err := container.remove(); err != nil {
+			fmt.Fprintf(os.Stderr, "testdb: %v\n", err)
+		}
+	}
+	os.Exit(code)
+}
+
+// Open returns a connection to the test database, migrated to the latest
+// version. The connection is shared by every test of the package and stays
+// open until the process exits.
+func Open(t testing.TB) postgres.DB {
+	t.Helper()
+
+	url := os.Getenv(EnvDatabaseURL)
+	if url == "" && !dockerAvailable() {
+		t.Skipf("neither %s nor Docker is available", EnvDatabaseURL)
+	}
+
+	once.Do(func() {
+		shared, openErr = open(context.Background(), url)
+	})
+	if openErr != nil {
+		t.Fatalf("open test database: %v", openErr)
+	}
+
+	return shared
+}
+
+func open(ctx context.Context, url string) (*postgres.SQLConnection, error) {
+	if url == "" {
+		var err error
+		if container, err = startPostgresContainer(ctx); err != nil {
+			return nil, err
+		}
+		url = container.url
+	}
+
+	conn := postgres.MustConnect(postgres.ConnectionOptions{
+		URL:            url,
+		MaxConnections: 10,
+		MaxIdleTime:    time.Minute,
+		ConnectTimeout: 10 * time.Second,
+	})
+	if err := waitReady(ctx, conn); err != nil {
+		return nil, err
+	}
+	if err := migration.NewAltaluneMigrationRepo(conn).Up(ctx); err != nil {
+		return nil, fmt.Errorf("migrate: %w", err)
+	}
+
+	return conn, nil
+}
+
+// waitReady waits for a freshly started Postgres to accept connections
+func waitReady(ctx context.Context, conn *postgres.SQLConnection) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+
+	for {
+		err := conn.TestConnection(ctx)
+		if err == nil {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("wait for database: %w", err)
+		case <-time.After(250 * time.Millisecond):
+		}
+	}
+}
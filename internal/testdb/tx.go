@@ -0,0 +1,61 @@
+package testdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/hrz8/altalune/internal/postgres"
+)
+
+// txDB is a postgres.DB running every statement in one transaction
+type txDB struct {
+	db *sql.DB
+	tx *sql.Tx
+}
+
+// Tx returns a postgres.DB whose statements all run in a transaction of the
+// test database that is rolled back when t ends, so the test sees its own
+// writes and leaves nothing behind.
+//
+// A failed statement aborts the whole transaction in Postgres: tests asserting
+// database errors, such as unique violations, or running statements
+// concurrently should use Open instead.
+func Tx(t testing.TB) postgres.DB {
+	t.Helper()
+
+	db := Open(t).GetDB()
+	tx, err := db.BeginTx(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("begin test transaction: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := tx.Rollback(); err != nil {
+			t.Errorf("roll back test transaction: %v", err)
+		}
+	})
+
+	return &txDB{db: db, tx: tx}
+}
+
+// GetDB returns the pool of the test database. Statements run on it are
+// outside the test transaction.
+func (d *txDB) GetDB() *sql.DB {
+	return d.db
+}
+
+func (d *txDB) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	return d.tx.QueryContext(ctx, query, args...)
+}
+
+func (d *txDB) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	return d.tx.QueryRowContext(ctx, query, args...)
+}
+
+func (d *txDB) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	return d.tx.ExecContext(ctx, query, args...)
+}
+
+func (d *txDB) PingContext(ctx context.Context) error {
+	return d.db.PingContext(ctx)
+}
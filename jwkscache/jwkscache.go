@@ -0,0 +1,188 @@
+// Package jwkscache fetches and caches the JSON Web Key Set (JWKS) of an
+// OAuth authorization server, for resource servers and clients verifying the
+// tokens it signs.
+//
+// Keys are looked up by key ID. The set is revalidated with a conditional
+// request (ETag / If-None-Match) once it is older than the refresh interval,
+// and refetched when a token names an unknown key ID, which is how key
+// rotations are picked up. Refreshes are throttled so tokens with made-up key
+// IDs cannot flood the authorization server.
+package jwkscache
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var (
+	// ErrKeyNotFound is returned when the key set has no key with the
+	// requested key ID, even after a refresh.
+	ErrKeyNotFound = errors.New("jwkscache: key not found")
+
+	// ErrRefreshThrottled is returned by Refresh when the set was fetched less
+	// than MinRefreshInterval ago.
+	ErrRefreshThrottled = errors.New("jwkscache: refresh throttled")
+)
+
+const (
+	defaultRefreshInterval    = time.Hour
+	defaultMinRefreshInterval = 20 * time.Second
+	maxResponseBytes          = 1 << 20
+)
+
+// Options configures a Cache. Zero values select the defaults.
+type Options struct {
+	RefreshInterval    time.Duration // How long fetched keys are used before the set is revalidated (default: 1h)
+	MinRefreshInterval time.Duration // Minimum time between two fetches (default: 20s)
+	HTTPClient         *http.Client  // Client used to fetch the set (default: 10s timeout)
+}
+
+// Cache holds the key set of one JWKS endpoint. It is safe for concurrent use.
+type Cache struct {
+	url  string
+	opts Options
+	now  func() time.Time
+
+	mu           sync.RWMutex
+	keys         map[string]crypto.PublicKey // kid -> public key
+	etag         string
+	lastModified string
+	fetchedAt    time.Time // Last successful fetch or revalidation
+	attemptedAt  time.Time // Last fetch attempt, successful or not
+}
+
+// New creates a cache for the key set served at url. Nothing is fetched
+// until a key is requested.
+func New(url string, opts Options) *Cache {
+	if opts.RefreshInterval <= 0 {
+		opts.RefreshInterval = defaultRefreshInterval
+	}
+	if opts.MinRefreshInterval <= 0 {
+		opts.MinRefreshInterval = defaultMinRefreshInterval
+	}
+	if opts.HTTPClient == nil {
+		opts.HTTPClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &Cache{
+		url:  url,
+		opts: opts,
+		now:  time.Now,
+		keys: make(map[string]crypto.PublicKey),
+	}
+}
+
+// Key returns the public key with the given key ID, fetching or
+// revalidating the set as needed. When the authorization server cannot be
+// reached, a previously fetched key keeps being served.
+func (c *Cache) Key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	c.mu.RLock()
+	key, ok := c.keys[kid]
+	fresh := c.now().Sub(c.fetchedAt) < c.opts.RefreshInterval
+	c.mu.RUnlock()
+	if ok && fresh {
+		return key, nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Another caller may have refreshed the set while we waited for the lock
+	key, ok = c.keys[kid]
+	if ok && c.now().Sub(c.fetchedAt) < c.opts.RefreshInterval {
+		return key, nil
+	}
+
+	if err := c.refreshLocked(ctx); err != nil && !errors.Is(err, ErrRefreshThrottled) {
+		if ok {
+			return key, nil // Stale beats unavailable
+		}
+		return nil, err
+	}
+
+	key, ok = c.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrKeyNotFound, kid)
+	}
+	return key, nil
+}
+
+// Keyfunc returns a jwt.Keyfunc resolving the verification key of a token
+// from its "kid" header. Callers should still restrict the accepted signing
+// methods with jwt.WithValidMethods.
+func (c *Cache) Keyfunc(ctx context.Context) jwt.Keyfunc {
+	return func(token *jwt.Token) (any, error) {
+		kid, _ := token.Header["kid"].(string)
+		if kid == "" {
+			return nil, errors.New("jwkscache: token has no kid header")
+		}
+		return c.Key(ctx, kid)
+	}
+}
+
+// Refresh revalidates the key set now. It returns ErrRefreshThrottled when
+// the set was fetched less than MinRefreshInterval ago.
+func (c *Cache) Refresh(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.refreshLocked(ctx)
+}
+
+func (c *Cache) refreshLocked(ctx context.Context) error {
+	now := c.now()
+	if !c.attemptedAt.IsZero() && now.Sub(c.attemptedAt) < c.opts.MinRefreshInterval {
+		return ErrRefreshThrottled
+	}
+	c.attemptedAt = now
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url, nil)
+	if err != nil {
+		return fmt.Errorf("jwkscache: create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if len(c.keys) > 0 {
+		if c.etag != "" {
+			req.Header.Set("If-None-Match", c.etag)
+		}
+		if c.lastModified != "" {
+			req.Header.Set("If-Modified-Since", c.lastModified)
+		}
+	}
+
+	resp, err := c.opts.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("jwkscache: fetch %s: %w", c.url, err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusNotModified:
+		c.fetchedAt = now
+		return nil
+	case http.StatusOK:
+	default:
+		return fmt.Errorf("jwkscache: fetch %s: unexpected status %d", c.url, resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes))
+	if err != nil {
+		return fmt.Errorf("jwkscache: read %s: %w", c.url, err)
+	}
+	keys, err := parseKeySet(body)
+	if err != nil {
+		return fmt.Errorf("jwkscache: parse %s: %w", c.url, err)
+	}
+
+	c.keys = keys
+	c.etag = resp.Header.Get("ETag")
+	c.lastModified = resp.Header.Get("Last-Modified")
+	c.fetchedAt = now
+	return nil
+}
@@ -0,0 +1,160 @@
+package jwkscache
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// jwksServer serves a key set with an ETag and counts full and conditional
+// responses.
+type jwksServer struct {
+	mu          sync.Mutex
+	keys        map[string]*rsa.PublicKey
+	down        bool
+	fetches     int
+	notModified int
+}
+
+func (s *jwksServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.down {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	set := map[string][]map[string]string{"keys": {}}
+	etag := `"`
+	for kid, key := range s.keys {
+		set["keys"] = append(set["keys"], map[string]string{
+			"kty": "RSA",
+			"use": "sig",
+			"kid": kid,
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		})
+		etag += kid
+	}
+	etag += `"`
+
+	if r.Header.Get("If-None-Match") == etag {
+		s.notModified++
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	s.fetches++
+	w.Header().Set("ETag", etag)
+	json.NewEncoder(w).Encode(set)
+}
+
+func newKey(t *testing.T) *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return key
+}
+
+func TestCache(t *testing.T) {
+	first, second := newKey(t), newKey(t)
+	server := &jwksServer{keys: map[string]*rsa.PublicKey{"first": &first.PublicKey}}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	now := time.Date(2026, 2, 10, 9, 0, 0, 0, time.UTC)
+	cache := New(ts.URL, Options{RefreshInterval: time.Hour, MinRefreshInterval: time.Minute})
+	cache.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	key, err := cache.Key(ctx, "first")
+	require.NoError(t, err)
+	assert.True(t, first.PublicKey.Equal(key))
+
+	_, err = cache.Key(ctx, "first")
+	require.NoError(t, err)
+	assert.Equal(t, 1, server.fetches, "fresh keys are served from the cache")
+
+	// Past the refresh interval, the set is revalidated with its ETag
+	now = now.Add(time.Hour)
+	_, err = cache.Key(ctx, "first")
+	require.NoError(t, err)
+	assert.Equal(t, 1, server.fetches)
+	assert.Equal(t, 1, server.notModified)
+
+	// A rotated key is picked up on the first token naming it
+	server.mu.Lock()
+	server.keys["second"] = &second.PublicKey
+	server.mu.Unlock()
+	now = now.Add(time.Minute)
+	key, err = cache.Key(ctx, "second")
+	require.NoError(t, err)
+	assert.True(t, second.PublicKey.Equal(key))
+	assert.Equal(t, 2, server.fetches)
+
+	// Unknown key IDs cannot trigger more than one fetch per interval
+	_, err = cache.Key(ctx, "unknown")
+	assert.ErrorIs(t, err, ErrKeyNotFound)
+	assert.Equal(t, 2, server.fetches)
+	assert.ErrorIs(t, cache.Refresh(ctx), ErrRefreshThrottled)
+
+	// Stale keys keep being served while the server is down
+	server.mu.Lock()
+	server.down = true
+	server.mu.Unlock()
+	now = now.Add(2 * time.Hour)
+	key, err = cache.Key(ctx, "first")
+	require.NoError(t, err)
+	assert.True(t, first.PublicKey.Equal(key))
+}
+
+func TestKeyfunc(t *testing.T) {
+	signer := newKey(t)
+	ts := httptest.NewServer(&jwksServer{keys: map[string]*rsa.PublicKey{"kid-1": &signer.PublicKey}})
+	defer ts.Close()
+
+	cache := New(ts.URL, Options{})
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.RegisteredClaims{Subject: "user"})
+	token.Header["kid"] = "kid-1"
+	signed, err := token.SignedString(signer)
+	require.NoError(t, err)
+
+	parsed, err := jwt.Parse(signed, cache.Keyfunc(context.Background()), jwt.WithValidMethods([]string{"RS256"}))
+	require.NoError(t, err)
+	assert.True(t, parsed.Valid)
+
+	unsigned := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.RegisteredClaims{})
+	signed, err = unsigned.SignedString(signer)
+	require.NoError(t, err)
+	_, err = jwt.Parse(signed, cache.Keyfunc(context.Background()))
+	assert.Error(t, err, "tokens without a kid are rejected")
+}
+
+func TestParseKeySet(t *testing.T) {
+	keys, err := parseKeySet([]byte(`{"keys": [
+		{"kty": "EC", "kid": "ec", "crv": "P-256",
+		 "x": "f83OJ3D2xF1Bg8vub9tLe1gHMzV76e8Tus9uPHvRVEU",
+		 "y": "x_FEzRu9m36HLN_tue659LNpXW6pCyStikYjKIWI5a0"},
+		{"kty": "RSA", "kid": "enc", "use": "enc", "n": "AQAB", "e": "AQAB"},
+		{"kty": "RSA", "kid": "broken", "n": "", "e": "AQAB"},
+		{"kty": "oct", "kid": "secret", "k": "c2VjcmV0"}
+	]}`))
+	require.NoError(t, err)
+	assert.Len(t, keys, 1)
+	assert.Contains(t, keys, "ec")
+
+	_, err = parseKeySet([]byte(`{"keys": []}`))
+	assert.Error(t, err)
+}
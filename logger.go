@@ -17,4 +17,8 @@ type Logger interface {
 
 	// JSON logging
 	JSON() Logger
+
+	// Module returns a logger tagging records with the module name, at the
+	// level configured for that module
+	Module(name string) Logger
 }
@@ -0,0 +1,136 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+)
+
+// levelHandler drops the records below level.
+type levelHandler struct {
+	next  slog.Handler
+	level slog.Level
+}
+
+func (h *levelHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return level >= h.level
+}
+
+func (h *levelHandler) Handle(ctx context.Context, r slog.Record) error {
+	return h.next.Handle(ctx, r)
+}
+
+func (h *levelHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &levelHandler{next: h.next.WithAttrs(attrs), level: h.level}
+}
+
+func (h *levelHandler) WithGroup(name string) slog.Handler {
+	return &levelHandler{next: h.next.WithGroup(name), level: h.level}
+}
+
+// contextHandler adds the attributes attached to the context with WithAttrs.
+type contextHandler struct {
+	next slog.Handler
+}
+
+func (h *contextHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+func (h *contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if args, ok := ctx.Value(attrsKey{}).([]any); ok {
+		r = r.Clone()
+		r.Add(args...)
+	}
+	return h.next.Handle(ctx, r)
+}
+
+func (h *contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &contextHandler{next: h.next.WithAttrs(attrs)}
+}
+
+func (h *contextHandler) WithGroup(name string) slog.Handler {
+	return &contextHandler{next: h.next.WithGroup(name)}
+}
+
+// ErrorHook receives the records logged at error level, with the attributes
+// of the record and of its logger, e.g. to report them to an error tracker.
+type ErrorHook func(ctx context.Context, msg string, attrs []slog.Attr)
+
+// hookHandler passes the error records to hook before handling them.
+type hookHandler struct {
+	next  slog.Handler
+	hook  ErrorHook
+	attrs []slog.Attr
+}
+
+func (h *hookHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+func (h *hookHandler) Handle(ctx context.Context, r slog.Record) error {
+	if r.Level >= slog.LevelError {
+		attrs := make([]slog.Attr, 0, len(h.attrs)+r.NumAttrs())
+		attrs = append(attrs, h.attrs...)
+		r.Attrs(func(a slog.Attr) bool {
+			attrs = append(attrs, a)
+			return true
+		})
+		h.hook(ctx, r.Message, attrs)
+	}
+	return h.next.Handle(ctx, r)
+}
+
+func (h *hookHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &hookHandler{next: h.next.WithAttrs(attrs), hook: h.hook, attrs: append(h.attrs[:len(h.attrs):len(h.attrs)], attrs...)}
+}
+
+// WithGroup keeps the attributes flat for the hook.
+func (h *hookHandler) WithGroup(name string) slog.Handler {
+	return &hookHandler{next: h.next.WithGroup(name), hook: h.hook, attrs: h.attrs}
+}
+
+type attrsKey struct{}
+
+// WithAttrs returns a copy of ctx whose records, logged with the *Context
+// methods, carry args (alternating keys and values) in addition to those
+// already attached, e.g. the request and user IDs.
+func WithAttrs(ctx context.Context, args ...any) context.Context {
+	parent, _ := ctx.Value(attrsKey{}).([]any)
+	return context.WithValue(ctx, attrsKey{}, append(parent[:len(parent):len(parent)], args...))
+}
+
+// Sampler thins out the logs of noisy keys, such as the request path of the
+// token endpoint. It is safe for concurrent use; a nil Sampler keeps
+// everything.
+type Sampler struct {
+	rates  map[string]uint64
+	counts sync.Map // key -> *atomic.Uint64
+}
+
+// NewSampler returns a sampler keeping one in every rates[key] calls of Sample
+// with key. Keys without a rate above 1 are always kept.
+func NewSampler(rates map[string]int) *Sampler {
+	s := &Sampler{rates: make(map[string]uint64, len(rates))}
+	for key, n := range rates {
+		if n > 1 {
+			s.rates[key] = uint64(n)
+		}
+	}
+	return s
+}
+
+// Sample reports whether the current occurrence of key should be logged. The
+// first occurrence always is.
+func (s *Sampler) Sample(key string) bool {
+	if s == nil {
+		return true
+	}
+	n, ok := s.rates[key]
+	if !ok {
+		return true
+	}
+	v, _ := s.counts.LoadOrStore(key, new(atomic.Uint64))
+	return (v.(*atomic.Uint64).Add(1)-1)%n == 0
+}
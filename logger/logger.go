@@ -15,13 +15,18 @@ import (
 type SlogLogger struct {
 	*slog.Logger
 	jsonLogger *SlogLogger
+
+	base    slog.Handler // unfiltered handler the module loggers derive from
+	level   slog.Level
+	modules map[string]slog.Level
 }
 
 var _ altalune.Logger = (*SlogLogger)(nil)
 
 type logHandler struct {
 	slog.Handler
-	l *log.Logger
+	l     *log.Logger
+	attrs []slog.Attr
 }
 
 func (h *logHandler) Handle(_ context.Context, r slog.Record) error {
@@ -37,7 +42,10 @@ func (h *logHandler) Handle(_ context.Context, r slog.Record) error {
 		level = color.RedString(level)
 	}
 
-	fields := make(map[string]any, r.NumAttrs())
+	fields := make(map[string]any, len(h.attrs)+r.NumAttrs())
+	for _, a := range h.attrs {
+		fields[a.Key] = a.Value.Any()
+	}
 	r.Attrs(func(a slog.Attr) bool {
 		fields[a.Key] = a.Value.Any()
 		return true
@@ -58,6 +66,19 @@ func (h *logHandler) Handle(_ context.Context, r slog.Record) error {
 	return nil
 }
 
+func (h *logHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &logHandler{
+		Handler: h.Handler,
+		l:       h.l,
+		attrs:   append(h.attrs[:len(h.attrs):len(h.attrs)], attrs...),
+	}
+}
+
+// WithGroup is a no-op: the console output is flat.
+func (h *logHandler) WithGroup(string) slog.Handler {
+	return h
+}
+
 func newLogHandler(w io.Writer, opts *slog.HandlerOptions) *logHandler {
 	return &logHandler{
 		Handler: slog.NewJSONHandler(w, opts),
@@ -65,32 +86,73 @@ func newLogHandler(w io.Writer, opts *slog.HandlerOptions) *logHandler {
 	}
 }
 
-func New(lvl string) *SlogLogger {
-	var level slog.Level
+// Options configures a logger.
+type Options struct {
+	Level   string            // debug, info, warn or error (default: warn)
+	Format  string            // console or json (default: console)
+	Modules map[string]string // Level overrides of the loggers returned by Module
+	Output  io.Writer         // Destination of the records (default: os.Stdout)
+	OnError ErrorHook         // Called with the records logged at error level (optional)
+}
+
+func parseLevel(lvl string) slog.Level {
 	switch lvl {
 	case "debug", "DEBUG", "verbose", "VERBOSE":
-		level = slog.LevelDebug
+		return slog.LevelDebug
 	case "info", "INFO":
-		level = slog.LevelInfo
+		return slog.LevelInfo
 	case "warn", "WARN", "warning", "WARNING":
-		level = slog.LevelWarn
+		return slog.LevelWarn
 	case "err", "ERR", "error", "ERROR":
-		level = slog.LevelError
+		return slog.LevelError
 	default:
-		level = slog.LevelWarn
+		return slog.LevelWarn
+	}
+}
+
+func New(lvl string) *SlogLogger {
+	return NewWithOptions(Options{Level: lvl})
+}
+
+// NewWithOptions creates a logger writing in the given format. The console
+// format also mirrors records as JSON to stderr through JSON().
+func NewWithOptions(opts Options) *SlogLogger {
+	out := opts.Output
+	if out == nil {
+		out = os.Stdout
+	}
+
+	level := parseLevel(opts.Level)
+	modules := make(map[string]slog.Level, len(opts.Modules))
+	for name, lvl := range opts.Modules {
+		modules[name] = parseLevel(lvl)
 	}
 
-	consoleLog := slog.New(newLogHandler(os.Stdout, &slog.HandlerOptions{Level: level}))
-	jsonLog := slog.New(slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{Level: level}))
+	// Levels are enforced by levelHandler so modules can go below the default.
+	handlerOpts := &slog.HandlerOptions{Level: slog.LevelDebug}
+
+	withHook := func(h slog.Handler) slog.Handler {
+		if opts.OnError == nil {
+			return h
+		}
+		return &hookHandler{next: h, hook: opts.OnError}
+	}
 
-	jsonLogger := &SlogLogger{
-		Logger:     jsonLog,
-		jsonLogger: nil,
+	if opts.Format == "json" {
+		return newSlogLogger(withHook(slog.NewJSONHandler(out, handlerOpts)), level, modules, nil)
 	}
 
+	jsonLogger := newSlogLogger(withHook(slog.NewJSONHandler(os.Stderr, handlerOpts)), level, modules, nil)
+	return newSlogLogger(withHook(newLogHandler(out, handlerOpts)), level, modules, jsonLogger)
+}
+
+func newSlogLogger(base slog.Handler, level slog.Level, modules map[string]slog.Level, jsonLogger *SlogLogger) *SlogLogger {
 	return &SlogLogger{
-		Logger:     consoleLog,
+		Logger:     slog.New(&levelHandler{next: &contextHandler{next: base}, level: level}),
 		jsonLogger: jsonLogger,
+		base:       base,
+		level:      level,
+		modules:    modules,
 	}
 }
 
@@ -132,3 +194,20 @@ func (l *SlogLogger) JSON() altalune.Logger {
 	}
 	return l.jsonLogger
 }
+
+// Module returns a logger tagging its records with module=name, at the level
+// configured for that module or the default level otherwise.
+func (l *SlogLogger) Module(name string) altalune.Logger {
+	level, ok := l.modules[name]
+	if !ok {
+		level = l.level
+	}
+
+	var jsonLogger *SlogLogger
+	if l.jsonLogger != nil {
+		jsonLogger = l.jsonLogger.Module(name).(*SlogLogger)
+	}
+
+	base := l.base.WithAttrs([]slog.Attr{slog.String("module", name)})
+	return newSlogLogger(base, level, l.modules, jsonLogger)
+}
@@ -0,0 +1,68 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModuleLevels(t *testing.T) {
+	var buf bytes.Buffer
+	log := NewWithOptions(Options{
+		Level:   "info",
+		Format:  "json",
+		Modules: map[string]string{"oauth": "debug", "http": "error"},
+		Output:  &buf,
+	})
+
+	log.Debug("root debug")
+	log.Module("oauth").Debug("oauth debug")
+	log.Module("http").Warn("http warn")
+	log.Module("scheduler").Info("scheduler info")
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+
+	var first, second map[string]any
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &first))
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &second))
+	assert.Equal(t, "oauth debug", first["msg"])
+	assert.Equal(t, "oauth", first["module"])
+	assert.Equal(t, "scheduler info", second["msg"])
+	assert.Equal(t, "scheduler", second["module"])
+}
+
+func TestContextAttrs(t *testing.T) {
+	var buf bytes.Buffer
+	log := NewWithOptions(Options{Level: "info", Format: "json", Output: &buf})
+
+	ctx := WithAttrs(context.Background(), "request_id", "req-1")
+	ctx = WithAttrs(ctx, "user_id", "user-1")
+	log.Module("http").InfoContext(ctx, "handled", "status", 200)
+
+	var record map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+	assert.Equal(t, "req-1", record["request_id"])
+	assert.Equal(t, "user-1", record["user_id"])
+	assert.Equal(t, "http", record["module"])
+	assert.EqualValues(t, 200, record["status"])
+}
+
+func TestSampler(t *testing.T) {
+	s := NewSampler(map[string]int{"/oauth/token": 3})
+
+	var kept []bool
+	for range 6 {
+		kept = append(kept, s.Sample("/oauth/token"))
+	}
+	assert.Equal(t, []bool{true, false, false, true, false, false}, kept)
+	assert.True(t, s.Sample("/api/other"))
+
+	var nilSampler *Sampler
+	assert.True(t, nilSampler.Sample("/oauth/token"))
+}
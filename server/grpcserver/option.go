@@ -1,6 +1,7 @@
 package grpcserver
 
 import (
+	"crypto/tls"
 	"net/http"
 	"time"
 
@@ -8,7 +9,11 @@ import (
 )
 
 type options struct {
+	host           string
 	port           int
+	tlsCertFile    string
+	tlsKeyFile     string
+	tlsConfig      *tls.Config
 	cleanupTimeout time.Duration
 }
 
@@ -37,6 +42,31 @@ func WithPort(port int) Option {
 	}
 }
 
+// WithHost sets the interface to listen on. An empty host listens on all
+// interfaces.
+func WithHost(host string) Option {
+	return func(s *Server) {
+		s.opts.host = host
+	}
+}
+
+// WithTLS serves gRPC over TLS with the given PEM certificate and key files.
+// Empty files serve plaintext gRPC.
+func WithTLS(certFile, keyFile string) Option {
+	return func(s *Server) {
+		s.opts.tlsCertFile = certFile
+		s.opts.tlsKeyFile = keyFile
+	}
+}
+
+// WithTLSConfig serves gRPC over TLS with certificates from cfg, e.g. an
+// ACME manager's GetCertificate.
+func WithTLSConfig(cfg *tls.Config) Option {
+	return func(s *Server) {
+		s.opts.tlsConfig = cfg
+	}
+}
+
 func WithCleanupTimeout(timeout time.Duration) Option {
 	return func(s *Server) {
 		s.opts.cleanupTimeout = timeout
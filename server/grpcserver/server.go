@@ -1,9 +1,11 @@
 package grpcserver
 
 import (
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"net"
+	"strconv"
 	"time"
 
 	"google.golang.org/grpc"
@@ -37,12 +39,28 @@ func NewGRPCServer(opts ...Option) *Server {
 
 func (s *Server) Start() {
 	// Create listener
-	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", s.opts.port))
+	listener, err := net.Listen("tcp", net.JoinHostPort(s.opts.host, strconv.Itoa(s.opts.port)))
 	if err != nil {
 		s.notify <- fmt.Errorf("failed to create listener: %w", err)
 		close(s.notify)
 		return
 	}
+	if tlsConfig := s.opts.tlsConfig; tlsConfig != nil {
+		listener = tls.NewListener(listener, tlsConfig)
+	} else if s.opts.tlsCertFile != "" && s.opts.tlsKeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(s.opts.tlsCertFile, s.opts.tlsKeyFile)
+		if err != nil {
+			listener.Close()
+			s.notify <- fmt.Errorf("failed to load TLS certificate: %w", err)
+			close(s.notify)
+			return
+		}
+		listener = tls.NewListener(listener, &tls.Config{
+			Certificates: []tls.Certificate{cert},
+			NextProtos:   []string{"h2"},
+			MinVersion:   tls.VersionTLS12,
+		})
+	}
 	s.listener = listener
 
 	go func() {
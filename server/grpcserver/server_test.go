@@ -0,0 +1,117 @@
+package grpcserver
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// writeCertPair writes a self-signed certificate for 127.0.0.1 and its key as
+// PEM files, and returns their paths and a pool trusting the certificate.
+func writeCertPair(t *testing.T) (certFile, keyFile string, roots *x509.CertPool) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "127.0.0.1"},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	certFile = filepath.Join(dir, "cert.pem")
+	keyFile = filepath.Join(dir, "key.pem")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	roots = x509.NewCertPool()
+	roots.AddCert(cert)
+	return certFile, keyFile, roots
+}
+
+// freePort returns a port nothing listens on at the moment
+func freePort(t *testing.T) int {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+	return l.Addr().(*net.TCPAddr).Port
+}
+
+// invoke calls a method no service implements: an Unimplemented status
+// proves the call reached the server.
+func invoke(t *testing.T, addr string, creds credentials.TransportCredentials) error {
+	t.Helper()
+
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(creds))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return conn.Invoke(ctx, "/test.v1.Test/Ping", &emptypb.Empty{}, &emptypb.Empty{}, grpc.WaitForReady(true))
+}
+
+func TestServerHost(t *testing.T) {
+	port := freePort(t)
+	s := NewGRPCServer(WithHost("127.0.0.1"), WithPort(port))
+	s.Start()
+	defer s.Stop()
+
+	err := invoke(t, "127.0.0.1:"+strconv.Itoa(port), insecure.NewCredentials())
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+func TestServerTLS(t *testing.T) {
+	certFile, keyFile, roots := writeCertPair(t)
+	port := freePort(t)
+	s := NewGRPCServer(WithHost("127.0.0.1"), WithPort(port), WithTLS(certFile, keyFile))
+	s.Start()
+	defer s.Stop()
+
+	addr := "127.0.0.1:" + strconv.Itoa(port)
+	err := invoke(t, addr, credentials.NewTLS(&tls.Config{RootCAs: roots}))
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+func TestServerTLSInvalidCertificate(t *testing.T) {
+	certFile, _, _ := writeCertPair(t)
+	s := NewGRPCServer(WithHost("127.0.0.1"), WithPort(freePort(t)), WithTLS(certFile, certFile))
+	s.Start()
+
+	err := <-s.Notify()
+	assert.ErrorContains(t, err, "failed to load TLS certificate")
+}
@@ -1,6 +1,7 @@
 package httpserver
 
 import (
+	"crypto/tls"
 	"net/http"
 	"time"
 )
@@ -8,20 +9,26 @@ import (
 type Option func(*Server)
 
 type options struct {
-	port           int
-	readTimeout    time.Duration
-	writeTimeout   time.Duration
-	idleTimeout    time.Duration
-	cleanupTimeout time.Duration
+	host              string
+	port              int
+	tlsCertFile       string
+	tlsKeyFile        string
+	tlsConfig         *tls.Config
+	readTimeout       time.Duration
+	readHeaderTimeout time.Duration
+	writeTimeout      time.Duration
+	idleTimeout       time.Duration
+	cleanupTimeout    time.Duration
 }
 
 func defaultOptions() *options {
 	return &options{
-		port:           3100,
-		readTimeout:    15 * time.Second,
-		writeTimeout:   15 * time.Second,
-		idleTimeout:    60 * time.Second,
-		cleanupTimeout: 10 * time.Second,
+		port:              3100,
+		readTimeout:       15 * time.Second,
+		readHeaderTimeout: 5 * time.Second,
+		writeTimeout:      15 * time.Second,
+		idleTimeout:       60 * time.Second,
+		cleanupTimeout:    10 * time.Second,
 	}
 }
 
@@ -39,12 +46,43 @@ func WithPort(port int) Option {
 	}
 }
 
+// WithHost sets the interface to listen on. An empty host listens on all
+// interfaces.
+func WithHost(host string) Option {
+	return func(s *Server) {
+		s.opts.host = host
+	}
+}
+
+// WithTLS serves HTTPS (and HTTP/2) with the given PEM certificate and key
+// files. Empty files serve plain HTTP.
+func WithTLS(certFile, keyFile string) Option {
+	return func(s *Server) {
+		s.opts.tlsCertFile = certFile
+		s.opts.tlsKeyFile = keyFile
+	}
+}
+
+// WithTLSConfig serves HTTPS with certificates from cfg, e.g. an ACME
+// manager's GetCertificate.
+func WithTLSConfig(cfg *tls.Config) Option {
+	return func(s *Server) {
+		s.opts.tlsConfig = cfg
+	}
+}
+
 func WithReadTimeout(timeout time.Duration) Option {
 	return func(s *Server) {
 		s.opts.readTimeout = timeout
 	}
 }
 
+func WithReadHeaderTimeout(timeout time.Duration) Option {
+	return func(s *Server) {
+		s.opts.readHeaderTimeout = timeout
+	}
+}
+
 func WithWriteTimeout(timeout time.Duration) Option {
 	return func(s *Server) {
 		s.opts.writeTimeout = timeout
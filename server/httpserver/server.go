@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"strconv"
 )
 
 type Server struct {
@@ -39,11 +40,13 @@ func (s *Server) Start() {
 	baseCtx, cancel := context.WithCancel(context.Background())
 
 	s.httpServer = &http.Server{
-		Addr:         fmt.Sprintf(":%d", s.opts.port),
-		Handler:      s.httpHandler,
-		ReadTimeout:  s.opts.readTimeout,
-		WriteTimeout: s.opts.writeTimeout,
-		IdleTimeout:  s.opts.idleTimeout,
+		Addr:              net.JoinHostPort(s.opts.host, strconv.Itoa(s.opts.port)),
+		Handler:           s.httpHandler,
+		ReadTimeout:       s.opts.readTimeout,
+		ReadHeaderTimeout: s.opts.readHeaderTimeout,
+		WriteTimeout:      s.opts.writeTimeout,
+		IdleTimeout:       s.opts.idleTimeout,
+		TLSConfig:         s.opts.tlsConfig,
 	}
 	s.httpServer.BaseContext = func(net.Listener) context.Context {
 		return baseCtx
@@ -52,10 +55,19 @@ func (s *Server) Start() {
 
 	go func() {
 		defer close(s.notify)
+		if s.TLSEnabled() {
+			s.notify <- s.httpServer.ListenAndServeTLS(s.opts.tlsCertFile, s.opts.tlsKeyFile)
+			return
+		}
 		s.notify <- s.httpServer.ListenAndServe()
 	}()
 }
 
+// TLSEnabled reports whether the server serves HTTPS.
+func (s *Server) TLSEnabled() bool {
+	return s.opts.tlsConfig != nil || (s.opts.tlsCertFile != "" && s.opts.tlsKeyFile != "")
+}
+
 func (s *Server) Notify() <-chan error {
 	return s.notify
 }
@@ -0,0 +1,127 @@
+package httpserver
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeCertPair writes a self-signed certificate for 127.0.0.1 and its key as
+// PEM files, and returns their paths and a pool trusting the certificate.
+func writeCertPair(t *testing.T) (certFile, keyFile string, roots *x509.CertPool) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "127.0.0.1"},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	certFile = filepath.Join(dir, "cert.pem")
+	keyFile = filepath.Join(dir, "key.pem")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	roots = x509.NewCertPool()
+	roots.AddCert(cert)
+	return certFile, keyFile, roots
+}
+
+// freePort returns a port nothing listens on at the moment
+func freePort(t *testing.T) int {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+	return l.Addr().(*net.TCPAddr).Port
+}
+
+// get retries url until the server started by Start accepts connections
+func get(t *testing.T, client *http.Client, url string) *http.Response {
+	t.Helper()
+
+	var resp *http.Response
+	require.Eventually(t, func() bool {
+		var err error
+		resp, err = client.Get(url)
+		return err == nil
+	}, 5*time.Second, 20*time.Millisecond)
+	t.Cleanup(func() { resp.Body.Close() })
+	return resp
+}
+
+func protoHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, r.Proto)
+	})
+}
+
+func TestServerHost(t *testing.T) {
+	port := freePort(t)
+	s := NewHTTPServer(WithHandler(protoHandler()), WithHost("127.0.0.1"), WithPort(port))
+	assert.False(t, s.TLSEnabled())
+	s.Start()
+
+	resp := get(t, http.DefaultClient, "http://127.0.0.1:"+strconv.Itoa(port))
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "HTTP/1.1", string(body))
+
+	require.NoError(t, s.Stop())
+	assert.True(t, errors.Is(<-s.Notify(), http.ErrServerClosed))
+}
+
+func TestServerTLS(t *testing.T) {
+	certFile, keyFile, roots := writeCertPair(t)
+	port := freePort(t)
+	s := NewHTTPServer(WithHandler(protoHandler()), WithHost("127.0.0.1"), WithPort(port), WithTLS(certFile, keyFile))
+	assert.True(t, s.TLSEnabled())
+	s.Start()
+	defer s.Stop()
+
+	client := &http.Client{Transport: &http.Transport{
+		TLSClientConfig:   &tls.Config{RootCAs: roots},
+		ForceAttemptHTTP2: true,
+	}}
+	resp := get(t, client, "https://127.0.0.1:"+strconv.Itoa(port))
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "HTTP/2.0", string(body), "HTTPS negotiates HTTP/2")
+}
+
+func TestServerTLSHalfConfigured(t *testing.T) {
+	certFile, _, _ := writeCertPair(t)
+	s := NewHTTPServer(WithTLS(certFile, ""))
+	assert.False(t, s.TLSEnabled(), "a certificate without its key serves plain HTTP")
+}
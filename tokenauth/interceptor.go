@@ -0,0 +1,94 @@
+package tokenauth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
+)
+
+type interceptor struct {
+	verifier *Verifier
+}
+
+// NewInterceptor returns a Connect interceptor requiring a valid bearer
+// token on every call of the handlers it is installed on. The token claims
+// are available to handlers through FromContext.
+func NewInterceptor(verifier *Verifier) connect.Interceptor {
+	return &interceptor{verifier: verifier}
+}
+
+// WrapUnary implements connect.Interceptor.
+func (i *interceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			return next(ctx, req)
+		}
+		ctx, err := i.authenticate(ctx, req.Header())
+		if err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+// WrapStreamingClient implements connect.Interceptor. Client streams are
+// passed through.
+func (i *interceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler implements connect.Interceptor.
+func (i *interceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ctx, err := i.authenticate(ctx, conn.RequestHeader())
+		if err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	}
+}
+
+func (i *interceptor) authenticate(ctx context.Context, header http.Header) (context.Context, error) {
+	token, ok := strings.CutPrefix(header.Get("Authorization"), "Bearer ")
+	if !ok || token == "" {
+		return ctx, unauthenticated(ErrMissingToken)
+	}
+
+	claims, err := i.verifier.Verify(ctx, token)
+	if err != nil {
+		return ctx, unauthenticated(err)
+	}
+	return WithClaims(ctx, claims), nil
+}
+
+// unauthenticated returns a CodeUnauthenticated error challenging the client
+// for a bearer token (RFC 6750).
+func unauthenticated(err error) error {
+	connectErr := connect.NewError(connect.CodeUnauthenticated, err)
+	challenge := `Bearer error="invalid_token"`
+	if errors.Is(err, ErrMissingToken) {
+		challenge = "Bearer"
+	}
+	connectErr.Meta().Set("WWW-Authenticate", challenge)
+	return connectErr
+}
+
+// RequirePerm returns a CodePermissionDenied error unless the request token
+// grants every one of perms, or a CodeUnauthenticated error when the request
+// has no verified token.
+func RequirePerm(ctx context.Context, perms ...string) error {
+	claims, ok := FromContext(ctx)
+	if !ok {
+		return connect.NewError(connect.CodeUnauthenticated, ErrMissingToken)
+	}
+	for _, perm := range perms {
+		if !claims.HasPerm(perm) {
+			return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied: requires %s", perm))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package tokenauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifier(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": "kid-1",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	}))
+	defer ts.Close()
+
+	sign := func(claims Claims) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+		token.Header["kid"] = "kid-1"
+		signed, err := token.SignedString(key)
+		require.NoError(t, err)
+		return signed
+	}
+	valid := func() Claims {
+		return Claims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				Issuer:    ts.URL,
+				Subject:   "user-1",
+				Audience:  jwt.ClaimStrings{"client-1"},
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			},
+			Perms: []string{"notes:read"},
+		}
+	}
+
+	verifier := NewVerifier(Options{Issuer: ts.URL, Audiences: []string{"client-1"}})
+	ctx := context.Background()
+
+	claims, err := verifier.Verify(ctx, sign(valid()))
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", claims.Subject)
+
+	expired := valid()
+	expired.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+	_, err = verifier.Verify(ctx, sign(expired))
+	assert.ErrorIs(t, err, ErrInvalidToken)
+
+	otherIssuer := valid()
+	otherIssuer.Issuer = "https://elsewhere.example.com"
+	_, err = verifier.Verify(ctx, sign(otherIssuer))
+	assert.ErrorIs(t, err, ErrInvalidToken)
+
+	otherClient := valid()
+	otherClient.Audience = jwt.ClaimStrings{"client-2"}
+	_, err = verifier.Verify(ctx, sign(otherClient))
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestRequirePerm(t *testing.T) {
+	err := RequirePerm(context.Background(), "notes:read")
+	assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+
+	ctx := WithClaims(context.Background(), &Claims{Perms: []string{"notes:read"}})
+	assert.NoError(t, RequirePerm(ctx, "notes:read"))
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(RequirePerm(ctx, "notes:read", "notes:write")))
+
+	root := WithClaims(context.Background(), &Claims{Perms: []string{RootPermission}})
+	assert.NoError(t, RequirePerm(root, "notes:write"))
+}